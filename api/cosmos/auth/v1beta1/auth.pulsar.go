@@ -3,6 +3,7 @@ package authv1beta1
 
 import (
 	_ "cosmossdk.io/api/amino"
+	v1beta1 "cosmossdk.io/api/cosmos/base/v1beta1"
 	fmt "fmt"
 	_ "github.com/cosmos/cosmos-proto"
 	runtime "github.com/cosmos/cosmos-proto/runtime"
@@ -1778,6 +1779,57 @@ func (x *fastReflection_ModuleCredential) ProtoMethods() *protoiface.Methods {
 	}
 }
 
+var _ protoreflect.List = (*_Params_6_list)(nil)
+
+type _Params_6_list struct {
+	list *[]*v1beta1.DecCoin
+}
+
+func (x *_Params_6_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_Params_6_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_Params_6_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*v1beta1.DecCoin)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_Params_6_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*v1beta1.DecCoin)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_Params_6_list) AppendMutable() protoreflect.Value {
+	v := new(v1beta1.DecCoin)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_Params_6_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_Params_6_list) NewElement() protoreflect.Value {
+	v := new(v1beta1.DecCoin)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_Params_6_list) IsValid() bool {
+	return x.list != nil
+}
+
 var (
 	md_Params                           protoreflect.MessageDescriptor
 	fd_Params_max_memo_characters       protoreflect.FieldDescriptor
@@ -1785,6 +1837,8 @@ var (
 	fd_Params_tx_size_cost_per_byte     protoreflect.FieldDescriptor
 	fd_Params_sig_verify_cost_ed25519   protoreflect.FieldDescriptor
 	fd_Params_sig_verify_cost_secp256k1 protoreflect.FieldDescriptor
+	fd_Params_min_gas_prices            protoreflect.FieldDescriptor
+	fd_Params_min_gas_prices_all_of     protoreflect.FieldDescriptor
 )
 
 func init() {
@@ -1795,6 +1849,8 @@ func init() {
 	fd_Params_tx_size_cost_per_byte = md_Params.Fields().ByName("tx_size_cost_per_byte")
 	fd_Params_sig_verify_cost_ed25519 = md_Params.Fields().ByName("sig_verify_cost_ed25519")
 	fd_Params_sig_verify_cost_secp256k1 = md_Params.Fields().ByName("sig_verify_cost_secp256k1")
+	fd_Params_min_gas_prices = md_Params.Fields().ByName("min_gas_prices")
+	fd_Params_min_gas_prices_all_of = md_Params.Fields().ByName("min_gas_prices_all_of")
 }
 
 var _ protoreflect.Message = (*fastReflection_Params)(nil)
@@ -1892,6 +1948,18 @@ func (x *fastReflection_Params) Range(f func(protoreflect.FieldDescriptor, proto
 			return
 		}
 	}
+	if len(x.MinGasPrices) != 0 {
+		value := protoreflect.ValueOfList(&_Params_6_list{list: &x.MinGasPrices})
+		if !f(fd_Params_min_gas_prices, value) {
+			return
+		}
+	}
+	if x.MinGasPricesAllOf != false {
+		value := protoreflect.ValueOfBool(x.MinGasPricesAllOf)
+		if !f(fd_Params_min_gas_prices_all_of, value) {
+			return
+		}
+	}
 }
 
 // Has reports whether a field is populated.
@@ -1917,6 +1985,10 @@ func (x *fastReflection_Params) Has(fd protoreflect.FieldDescriptor) bool {
 		return x.SigVerifyCostEd25519 != uint64(0)
 	case "cosmos.auth.v1beta1.Params.sig_verify_cost_secp256k1":
 		return x.SigVerifyCostSecp256K1 != uint64(0)
+	case "cosmos.auth.v1beta1.Params.min_gas_prices":
+		return len(x.MinGasPrices) != 0
+	case "cosmos.auth.v1beta1.Params.min_gas_prices_all_of":
+		return x.MinGasPricesAllOf != false
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.auth.v1beta1.Params"))
@@ -1943,6 +2015,10 @@ func (x *fastReflection_Params) Clear(fd protoreflect.FieldDescriptor) {
 		x.SigVerifyCostEd25519 = uint64(0)
 	case "cosmos.auth.v1beta1.Params.sig_verify_cost_secp256k1":
 		x.SigVerifyCostSecp256K1 = uint64(0)
+	case "cosmos.auth.v1beta1.Params.min_gas_prices":
+		x.MinGasPrices = nil
+	case "cosmos.auth.v1beta1.Params.min_gas_prices_all_of":
+		x.MinGasPricesAllOf = false
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.auth.v1beta1.Params"))
@@ -1974,6 +2050,15 @@ func (x *fastReflection_Params) Get(descriptor protoreflect.FieldDescriptor) pro
 	case "cosmos.auth.v1beta1.Params.sig_verify_cost_secp256k1":
 		value := x.SigVerifyCostSecp256K1
 		return protoreflect.ValueOfUint64(value)
+	case "cosmos.auth.v1beta1.Params.min_gas_prices":
+		if len(x.MinGasPrices) == 0 {
+			return protoreflect.ValueOfList(&_Params_6_list{})
+		}
+		listValue := &_Params_6_list{list: &x.MinGasPrices}
+		return protoreflect.ValueOfList(listValue)
+	case "cosmos.auth.v1beta1.Params.min_gas_prices_all_of":
+		value := x.MinGasPricesAllOf
+		return protoreflect.ValueOfBool(value)
 	default:
 		if descriptor.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.auth.v1beta1.Params"))
@@ -2004,6 +2089,12 @@ func (x *fastReflection_Params) Set(fd protoreflect.FieldDescriptor, value proto
 		x.SigVerifyCostEd25519 = value.Uint()
 	case "cosmos.auth.v1beta1.Params.sig_verify_cost_secp256k1":
 		x.SigVerifyCostSecp256K1 = value.Uint()
+	case "cosmos.auth.v1beta1.Params.min_gas_prices":
+		lv := value.List()
+		clv := lv.(*_Params_6_list)
+		x.MinGasPrices = *clv.list
+	case "cosmos.auth.v1beta1.Params.min_gas_prices_all_of":
+		x.MinGasPricesAllOf = value.Bool()
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.auth.v1beta1.Params"))
@@ -2024,6 +2115,12 @@ func (x *fastReflection_Params) Set(fd protoreflect.FieldDescriptor, value proto
 // Mutable is a mutating operation and unsafe for concurrent use.
 func (x *fastReflection_Params) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
+	case "cosmos.auth.v1beta1.Params.min_gas_prices":
+		if x.MinGasPrices == nil {
+			x.MinGasPrices = []*v1beta1.DecCoin{}
+		}
+		value := &_Params_6_list{list: &x.MinGasPrices}
+		return protoreflect.ValueOfList(value)
 	case "cosmos.auth.v1beta1.Params.max_memo_characters":
 		panic(fmt.Errorf("field max_memo_characters of message cosmos.auth.v1beta1.Params is not mutable"))
 	case "cosmos.auth.v1beta1.Params.tx_sig_limit":
@@ -2034,6 +2131,8 @@ func (x *fastReflection_Params) Mutable(fd protoreflect.FieldDescriptor) protore
 		panic(fmt.Errorf("field sig_verify_cost_ed25519 of message cosmos.auth.v1beta1.Params is not mutable"))
 	case "cosmos.auth.v1beta1.Params.sig_verify_cost_secp256k1":
 		panic(fmt.Errorf("field sig_verify_cost_secp256k1 of message cosmos.auth.v1beta1.Params is not mutable"))
+	case "cosmos.auth.v1beta1.Params.min_gas_prices_all_of":
+		panic(fmt.Errorf("field min_gas_prices_all_of of message cosmos.auth.v1beta1.Params is not mutable"))
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.auth.v1beta1.Params"))
@@ -2057,6 +2156,11 @@ func (x *fastReflection_Params) NewField(fd protoreflect.FieldDescriptor) protor
 		return protoreflect.ValueOfUint64(uint64(0))
 	case "cosmos.auth.v1beta1.Params.sig_verify_cost_secp256k1":
 		return protoreflect.ValueOfUint64(uint64(0))
+	case "cosmos.auth.v1beta1.Params.min_gas_prices":
+		list := []*v1beta1.DecCoin{}
+		return protoreflect.ValueOfList(&_Params_6_list{list: &list})
+	case "cosmos.auth.v1beta1.Params.min_gas_prices_all_of":
+		return protoreflect.ValueOfBool(false)
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.auth.v1beta1.Params"))
@@ -2141,6 +2245,15 @@ func (x *fastReflection_Params) ProtoMethods() *protoiface.Methods {
 		if x.SigVerifyCostSecp256K1 != 0 {
 			n += 1 + runtime.Sov(uint64(x.SigVerifyCostSecp256K1))
 		}
+		if len(x.MinGasPrices) > 0 {
+			for _, e := range x.MinGasPrices {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if x.MinGasPricesAllOf {
+			n += 2
+		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
 		}
@@ -2170,6 +2283,32 @@ func (x *fastReflection_Params) ProtoMethods() *protoiface.Methods {
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
+		if x.MinGasPricesAllOf {
+			i--
+			if x.MinGasPricesAllOf {
+				dAtA[i] = 1
+			} else {
+				dAtA[i] = 0
+			}
+			i--
+			dAtA[i] = 0x38
+		}
+		if len(x.MinGasPrices) > 0 {
+			for iNdEx := len(x.MinGasPrices) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.MinGasPrices[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x32
+			}
+		}
 		if x.SigVerifyCostSecp256K1 != 0 {
 			i = runtime.EncodeVarint(dAtA, i, uint64(x.SigVerifyCostSecp256K1))
 			i--
@@ -2339,6 +2478,60 @@ func (x *fastReflection_Params) ProtoMethods() *protoiface.Methods {
 						break
 					}
 				}
+			case 6:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field MinGasPrices", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.MinGasPrices = append(x.MinGasPrices, &v1beta1.DecCoin{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.MinGasPrices[len(x.MinGasPrices)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 7:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field MinGasPricesAllOf", wireType)
+				}
+				var v int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				x.MinGasPricesAllOf = bool(v != 0)
 			default:
 				iNdEx = preIndex
 				skippy, err := runtime.Skip(dAtA[iNdEx:])
@@ -2561,6 +2754,18 @@ type Params struct {
 	TxSizeCostPerByte      uint64 `protobuf:"varint,3,opt,name=tx_size_cost_per_byte,json=txSizeCostPerByte,proto3" json:"tx_size_cost_per_byte,omitempty"`
 	SigVerifyCostEd25519   uint64 `protobuf:"varint,4,opt,name=sig_verify_cost_ed25519,json=sigVerifyCostEd25519,proto3" json:"sig_verify_cost_ed25519,omitempty"`
 	SigVerifyCostSecp256K1 uint64 `protobuf:"varint,5,opt,name=sig_verify_cost_secp256k1,json=sigVerifyCostSecp256k1,proto3" json:"sig_verify_cost_secp256k1,omitempty"`
+	// min_gas_prices defines the minimum gas prices the fee of every transaction
+	// must meet, enforced in consensus on top of the minimum gas prices of each
+	// validator. An empty list disables the requirement.
+	//
+	// Since: cosmos-sdk 0.48
+	MinGasPrices []*v1beta1.DecCoin `protobuf:"bytes,6,rep,name=min_gas_prices,json=minGasPrices,proto3" json:"min_gas_prices,omitempty"`
+	// min_gas_prices_all_of requires the fee to meet the minimum of all the
+	// denoms of min_gas_prices, e.g. on dual-token chains, instead of any of
+	// them.
+	//
+	// Since: cosmos-sdk 0.48
+	MinGasPricesAllOf bool `protobuf:"varint,7,opt,name=min_gas_prices_all_of,json=minGasPricesAllOf,proto3" json:"min_gas_prices_all_of,omitempty"`
 }
 
 func (x *Params) Reset() {
@@ -2618,6 +2823,20 @@ func (x *Params) GetSigVerifyCostSecp256K1() uint64 {
 	return 0
 }
 
+func (x *Params) GetMinGasPrices() []*v1beta1.DecCoin {
+	if x != nil {
+		return x.MinGasPrices
+	}
+	return nil
+}
+
+func (x *Params) GetMinGasPricesAllOf() bool {
+	if x != nil {
+		return x.MinGasPricesAllOf
+	}
+	return false
+}
+
 var File_cosmos_auth_v1beta1_auth_proto protoreflect.FileDescriptor
 
 var file_cosmos_auth_v1beta1_auth_proto_rawDesc = []byte{
@@ -2630,6 +2849,8 @@ var file_cosmos_auth_v1beta1_auth_proto_rawDesc = []byte{
 	0x6f, 0x74, 0x6f, 0x1a, 0x14, 0x67, 0x6f, 0x67, 0x6f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x67,
 	0x6f, 0x67, 0x6f, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x19, 0x67, 0x6f, 0x6f, 0x67, 0x6c,
 	0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x61, 0x6e, 0x79, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2f, 0x62, 0x61, 0x73,
+	0x65, 0x2f, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2f, 0x63, 0x6f, 0x69, 0x6e, 0x2e, 0x70,
 	0x72, 0x6f, 0x74, 0x6f, 0x22, 0xa1, 0x02, 0x0a, 0x0b, 0x42, 0x61, 0x73, 0x65, 0x41, 0x63, 0x63,
 	0x6f, 0x75, 0x6e, 0x74, 0x12, 0x32, 0x0a, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18,
 	0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x18, 0xd2, 0xb4, 0x2d, 0x14, 0x63, 0x6f, 0x73, 0x6d, 0x6f,
@@ -2671,8 +2892,8 @@ var file_cosmos_auth_v1beta1_auth_proto_rawDesc = []byte{
 	0x18, 0x02, 0x20, 0x03, 0x28, 0x0c, 0x52, 0x0e, 0x64, 0x65, 0x72, 0x69, 0x76, 0x61, 0x74, 0x69,
 	0x6f, 0x6e, 0x4b, 0x65, 0x79, 0x73, 0x3a, 0x26, 0x8a, 0xe7, 0xb0, 0x2a, 0x21, 0x63, 0x6f, 0x73,
 	0x6d, 0x6f, 0x73, 0x2d, 0x73, 0x64, 0x6b, 0x2f, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x41, 0x63, 0x63,
-	0x6f, 0x75, 0x6e, 0x74, 0x43, 0x72, 0x65, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x61, 0x6c, 0x22, 0xd7,
-	0x02, 0x0a, 0x06, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x12, 0x2e, 0x0a, 0x13, 0x6d, 0x61, 0x78,
+	0x6f, 0x75, 0x6e, 0x74, 0x43, 0x72, 0x65, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x61, 0x6c, 0x22, 0x87,
+	0x04, 0x0a, 0x06, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x12, 0x2e, 0x0a, 0x13, 0x6d, 0x61, 0x78,
 	0x5f, 0x6d, 0x65, 0x6d, 0x6f, 0x5f, 0x63, 0x68, 0x61, 0x72, 0x61, 0x63, 0x74, 0x65, 0x72, 0x73,
 	0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x11, 0x6d, 0x61, 0x78, 0x4d, 0x65, 0x6d, 0x6f, 0x43,
 	0x68, 0x61, 0x72, 0x61, 0x63, 0x74, 0x65, 0x72, 0x73, 0x12, 0x20, 0x0a, 0x0c, 0x74, 0x78, 0x5f,
@@ -2691,7 +2912,18 @@ var file_cosmos_auth_v1beta1_auth_proto_rawDesc = []byte{
 	0x04, 0x42, 0x1a, 0xe2, 0xde, 0x1f, 0x16, 0x53, 0x69, 0x67, 0x56, 0x65, 0x72, 0x69, 0x66, 0x79,
 	0x43, 0x6f, 0x73, 0x74, 0x53, 0x65, 0x63, 0x70, 0x32, 0x35, 0x36, 0x6b, 0x31, 0x52, 0x16, 0x73,
 	0x69, 0x67, 0x56, 0x65, 0x72, 0x69, 0x66, 0x79, 0x43, 0x6f, 0x73, 0x74, 0x53, 0x65, 0x63, 0x70,
-	0x32, 0x35, 0x36, 0x6b, 0x31, 0x3a, 0x21, 0xe8, 0xa0, 0x1f, 0x01, 0x8a, 0xe7, 0xb0, 0x2a, 0x18,
+	0x32, 0x35, 0x36, 0x6b, 0x31, 0x12, 0x7c, 0x0a, 0x0e, 0x6d, 0x69, 0x6e, 0x5f, 0x67, 0x61, 0x73,
+	0x5f, 0x70, 0x72, 0x69, 0x63, 0x65, 0x73, 0x18, 0x06, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1c, 0x2e,
+	0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x76, 0x31, 0x62, 0x65,
+	0x74, 0x61, 0x31, 0x2e, 0x44, 0x65, 0x63, 0x43, 0x6f, 0x69, 0x6e, 0x42, 0x38, 0xc8, 0xde, 0x1f,
+	0x00, 0xaa, 0xdf, 0x1f, 0x2b, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f,
+	0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2d, 0x73, 0x64,
+	0x6b, 0x2f, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x44, 0x65, 0x63, 0x43, 0x6f, 0x69, 0x6e, 0x73,
+	0xa8, 0xe7, 0xb0, 0x2a, 0x01, 0x52, 0x0c, 0x6d, 0x69, 0x6e, 0x47, 0x61, 0x73, 0x50, 0x72, 0x69,
+	0x63, 0x65, 0x73, 0x12, 0x30, 0x0a, 0x15, 0x6d, 0x69, 0x6e, 0x5f, 0x67, 0x61, 0x73, 0x5f, 0x70,
+	0x72, 0x69, 0x63, 0x65, 0x73, 0x5f, 0x61, 0x6c, 0x6c, 0x5f, 0x6f, 0x66, 0x18, 0x07, 0x20, 0x01,
+	0x28, 0x08, 0x52, 0x11, 0x6d, 0x69, 0x6e, 0x47, 0x61, 0x73, 0x50, 0x72, 0x69, 0x63, 0x65, 0x73,
+	0x41, 0x6c, 0x6c, 0x4f, 0x66, 0x3a, 0x21, 0xe8, 0xa0, 0x1f, 0x01, 0x8a, 0xe7, 0xb0, 0x2a, 0x18,
 	0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2d, 0x73, 0x64, 0x6b, 0x2f, 0x78, 0x2f, 0x61, 0x75, 0x74,
 	0x68, 0x2f, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x42, 0xc4, 0x01, 0x0a, 0x17, 0x63, 0x6f, 0x6d,
 	0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x61, 0x75, 0x74, 0x68, 0x2e, 0x76, 0x31, 0x62,
@@ -2728,15 +2960,17 @@ var file_cosmos_auth_v1beta1_auth_proto_goTypes = []interface{}{
 	(*ModuleCredential)(nil), // 2: cosmos.auth.v1beta1.ModuleCredential
 	(*Params)(nil),           // 3: cosmos.auth.v1beta1.Params
 	(*anypb.Any)(nil),        // 4: google.protobuf.Any
+	(*v1beta1.DecCoin)(nil),  // 5: cosmos.base.v1beta1.DecCoin
 }
 var file_cosmos_auth_v1beta1_auth_proto_depIdxs = []int32{
 	4, // 0: cosmos.auth.v1beta1.BaseAccount.pub_key:type_name -> google.protobuf.Any
 	0, // 1: cosmos.auth.v1beta1.ModuleAccount.base_account:type_name -> cosmos.auth.v1beta1.BaseAccount
-	2, // [2:2] is the sub-list for method output_type
-	2, // [2:2] is the sub-list for method input_type
-	2, // [2:2] is the sub-list for extension type_name
-	2, // [2:2] is the sub-list for extension extendee
-	0, // [0:2] is the sub-list for field type_name
+	5, // 2: cosmos.auth.v1beta1.Params.min_gas_prices:type_name -> cosmos.base.v1beta1.DecCoin
+	3, // [3:3] is the sub-list for method output_type
+	3, // [3:3] is the sub-list for method input_type
+	3, // [3:3] is the sub-list for extension type_name
+	3, // [3:3] is the sub-list for extension extendee
+	0, // [0:3] is the sub-list for field type_name
 }
 
 func init() { file_cosmos_auth_v1beta1_auth_proto_init() }
@@ -21,9 +21,10 @@ import (
 const _ = grpc.SupportPackageIsVersion7
 
 const (
-	Query_Grants_FullMethodName        = "/cosmos.authz.v1beta1.Query/Grants"
-	Query_GranterGrants_FullMethodName = "/cosmos.authz.v1beta1.Query/GranterGrants"
-	Query_GranteeGrants_FullMethodName = "/cosmos.authz.v1beta1.Query/GranteeGrants"
+	Query_Grants_FullMethodName         = "/cosmos.authz.v1beta1.Query/Grants"
+	Query_GranterGrants_FullMethodName  = "/cosmos.authz.v1beta1.Query/GranterGrants"
+	Query_GranteeGrants_FullMethodName  = "/cosmos.authz.v1beta1.Query/GranteeGrants"
+	Query_ExpiringGrants_FullMethodName = "/cosmos.authz.v1beta1.Query/ExpiringGrants"
 )
 
 // QueryClient is the client API for Query service.
@@ -40,6 +41,11 @@ type QueryClient interface {
 	//
 	// Since: cosmos-sdk 0.46
 	GranteeGrants(ctx context.Context, in *QueryGranteeGrantsRequest, opts ...grpc.CallOption) (*QueryGranteeGrantsResponse, error)
+	// ExpiringGrants returns a list of `GrantAuthorization` expiring within a
+	// window after the current block time, ordered by expiration.
+	//
+	// Since: cosmos-sdk 0.48
+	ExpiringGrants(ctx context.Context, in *QueryExpiringGrantsRequest, opts ...grpc.CallOption) (*QueryExpiringGrantsResponse, error)
 }
 
 type queryClient struct {
@@ -77,6 +83,15 @@ func (c *queryClient) GranteeGrants(ctx context.Context, in *QueryGranteeGrantsR
 	return out, nil
 }
 
+func (c *queryClient) ExpiringGrants(ctx context.Context, in *QueryExpiringGrantsRequest, opts ...grpc.CallOption) (*QueryExpiringGrantsResponse, error) {
+	out := new(QueryExpiringGrantsResponse)
+	err := c.cc.Invoke(ctx, Query_ExpiringGrants_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 // All implementations must embed UnimplementedQueryServer
 // for forward compatibility
@@ -91,6 +106,11 @@ type QueryServer interface {
 	//
 	// Since: cosmos-sdk 0.46
 	GranteeGrants(context.Context, *QueryGranteeGrantsRequest) (*QueryGranteeGrantsResponse, error)
+	// ExpiringGrants returns a list of `GrantAuthorization` expiring within a
+	// window after the current block time, ordered by expiration.
+	//
+	// Since: cosmos-sdk 0.48
+	ExpiringGrants(context.Context, *QueryExpiringGrantsRequest) (*QueryExpiringGrantsResponse, error)
 	mustEmbedUnimplementedQueryServer()
 }
 
@@ -107,6 +127,9 @@ func (UnimplementedQueryServer) GranterGrants(context.Context, *QueryGranterGran
 func (UnimplementedQueryServer) GranteeGrants(context.Context, *QueryGranteeGrantsRequest) (*QueryGranteeGrantsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GranteeGrants not implemented")
 }
+func (UnimplementedQueryServer) ExpiringGrants(context.Context, *QueryExpiringGrantsRequest) (*QueryExpiringGrantsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ExpiringGrants not implemented")
+}
 func (UnimplementedQueryServer) mustEmbedUnimplementedQueryServer() {}
 
 // UnsafeQueryServer may be embedded to opt out of forward compatibility for this service.
@@ -174,6 +197,24 @@ func _Query_GranteeGrants_Handler(srv interface{}, ctx context.Context, dec func
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_ExpiringGrants_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryExpiringGrantsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ExpiringGrants(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_ExpiringGrants_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ExpiringGrants(ctx, req.(*QueryExpiringGrantsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // Query_ServiceDesc is the grpc.ServiceDesc for Query service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -193,6 +234,10 @@ var Query_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GranteeGrants",
 			Handler:    _Query_GranteeGrants_Handler,
 		},
+		{
+			MethodName: "ExpiringGrants",
+			Handler:    _Query_ExpiringGrants_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "cosmos/authz/v1beta1/query.proto",
@@ -12,6 +12,8 @@ import (
 	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
 	protoiface "google.golang.org/protobuf/runtime/protoiface"
 	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	durationpb "google.golang.org/protobuf/types/known/durationpb"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
 	io "io"
 	reflect "reflect"
 	sync "sync"
@@ -72,6 +74,7 @@ var (
 	md_Params                      protoreflect.MessageDescriptor
 	fd_Params_send_enabled         protoreflect.FieldDescriptor
 	fd_Params_default_send_enabled protoreflect.FieldDescriptor
+	fd_Params_dust_sweep           protoreflect.FieldDescriptor
 )
 
 func init() {
@@ -79,6 +82,7 @@ func init() {
 	md_Params = File_cosmos_bank_v1beta1_bank_proto.Messages().ByName("Params")
 	fd_Params_send_enabled = md_Params.Fields().ByName("send_enabled")
 	fd_Params_default_send_enabled = md_Params.Fields().ByName("default_send_enabled")
+	fd_Params_dust_sweep = md_Params.Fields().ByName("dust_sweep")
 }
 
 var _ protoreflect.Message = (*fastReflection_Params)(nil)
@@ -158,6 +162,12 @@ func (x *fastReflection_Params) Range(f func(protoreflect.FieldDescriptor, proto
 			return
 		}
 	}
+	if x.DustSweep != nil {
+		value := protoreflect.ValueOfMessage(x.DustSweep.ProtoReflect())
+		if !f(fd_Params_dust_sweep, value) {
+			return
+		}
+	}
 }
 
 // Has reports whether a field is populated.
@@ -177,6 +187,8 @@ func (x *fastReflection_Params) Has(fd protoreflect.FieldDescriptor) bool {
 		return len(x.SendEnabled) != 0
 	case "cosmos.bank.v1beta1.Params.default_send_enabled":
 		return x.DefaultSendEnabled != false
+	case "cosmos.bank.v1beta1.Params.dust_sweep":
+		return x.DustSweep != nil
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.bank.v1beta1.Params"))
@@ -197,6 +209,8 @@ func (x *fastReflection_Params) Clear(fd protoreflect.FieldDescriptor) {
 		x.SendEnabled = nil
 	case "cosmos.bank.v1beta1.Params.default_send_enabled":
 		x.DefaultSendEnabled = false
+	case "cosmos.bank.v1beta1.Params.dust_sweep":
+		x.DustSweep = nil
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.bank.v1beta1.Params"))
@@ -222,6 +236,9 @@ func (x *fastReflection_Params) Get(descriptor protoreflect.FieldDescriptor) pro
 	case "cosmos.bank.v1beta1.Params.default_send_enabled":
 		value := x.DefaultSendEnabled
 		return protoreflect.ValueOfBool(value)
+	case "cosmos.bank.v1beta1.Params.dust_sweep":
+		value := x.DustSweep
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
 	default:
 		if descriptor.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.bank.v1beta1.Params"))
@@ -248,6 +265,8 @@ func (x *fastReflection_Params) Set(fd protoreflect.FieldDescriptor, value proto
 		x.SendEnabled = *clv.list
 	case "cosmos.bank.v1beta1.Params.default_send_enabled":
 		x.DefaultSendEnabled = value.Bool()
+	case "cosmos.bank.v1beta1.Params.dust_sweep":
+		x.DustSweep = value.Message().Interface().(*DustSweepParams)
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.bank.v1beta1.Params"))
@@ -274,6 +293,11 @@ func (x *fastReflection_Params) Mutable(fd protoreflect.FieldDescriptor) protore
 		}
 		value := &_Params_1_list{list: &x.SendEnabled}
 		return protoreflect.ValueOfList(value)
+	case "cosmos.bank.v1beta1.Params.dust_sweep":
+		if x.DustSweep == nil {
+			x.DustSweep = new(DustSweepParams)
+		}
+		return protoreflect.ValueOfMessage(x.DustSweep.ProtoReflect())
 	case "cosmos.bank.v1beta1.Params.default_send_enabled":
 		panic(fmt.Errorf("field default_send_enabled of message cosmos.bank.v1beta1.Params is not mutable"))
 	default:
@@ -294,6 +318,9 @@ func (x *fastReflection_Params) NewField(fd protoreflect.FieldDescriptor) protor
 		return protoreflect.ValueOfList(&_Params_1_list{list: &list})
 	case "cosmos.bank.v1beta1.Params.default_send_enabled":
 		return protoreflect.ValueOfBool(false)
+	case "cosmos.bank.v1beta1.Params.dust_sweep":
+		m := new(DustSweepParams)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.bank.v1beta1.Params"))
@@ -372,6 +399,10 @@ func (x *fastReflection_Params) ProtoMethods() *protoiface.Methods {
 		if x.DefaultSendEnabled {
 			n += 2
 		}
+		if x.DustSweep != nil {
+			l = options.Size(x.DustSweep)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
 		}
@@ -401,6 +432,20 @@ func (x *fastReflection_Params) ProtoMethods() *protoiface.Methods {
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
+		if x.DustSweep != nil {
+			encoded, err := options.Marshal(x.DustSweep)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x1a
+		}
 		if x.DefaultSendEnabled {
 			i--
 			if x.DefaultSendEnabled {
@@ -530,6 +575,42 @@ func (x *fastReflection_Params) ProtoMethods() *protoiface.Methods {
 					}
 				}
 				x.DefaultSendEnabled = bool(v != 0)
+			case 3:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field DustSweep", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.DustSweep == nil {
+					x.DustSweep = &DustSweepParams{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.DustSweep); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
 			default:
 				iNdEx = preIndex
 				skippy, err := runtime.Skip(dAtA[iNdEx:])
@@ -565,28 +646,133 @@ func (x *fastReflection_Params) ProtoMethods() *protoiface.Methods {
 	}
 }
 
+var _ protoreflect.List = (*_DustSweepParams_1_list)(nil)
+
+type _DustSweepParams_1_list struct {
+	list *[]*v1beta1.Coin
+}
+
+func (x *_DustSweepParams_1_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_DustSweepParams_1_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_DustSweepParams_1_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*v1beta1.Coin)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_DustSweepParams_1_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*v1beta1.Coin)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_DustSweepParams_1_list) AppendMutable() protoreflect.Value {
+	v := new(v1beta1.Coin)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_DustSweepParams_1_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_DustSweepParams_1_list) NewElement() protoreflect.Value {
+	v := new(v1beta1.Coin)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_DustSweepParams_1_list) IsValid() bool {
+	return x.list != nil
+}
+
+var _ protoreflect.List = (*_DustSweepParams_5_list)(nil)
+
+type _DustSweepParams_5_list struct {
+	list *[]string
+}
+
+func (x *_DustSweepParams_5_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_DustSweepParams_5_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfString((*x.list)[i])
+}
+
+func (x *_DustSweepParams_5_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.String()
+	concreteValue := valueUnwrapped
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_DustSweepParams_5_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.String()
+	concreteValue := valueUnwrapped
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_DustSweepParams_5_list) AppendMutable() protoreflect.Value {
+	panic(fmt.Errorf("AppendMutable can not be called on message DustSweepParams at list field ExemptAddresses as it is not of Message kind"))
+}
+
+func (x *_DustSweepParams_5_list) Truncate(n int) {
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_DustSweepParams_5_list) NewElement() protoreflect.Value {
+	v := ""
+	return protoreflect.ValueOfString(v)
+}
+
+func (x *_DustSweepParams_5_list) IsValid() bool {
+	return x.list != nil
+}
+
 var (
-	md_SendEnabled         protoreflect.MessageDescriptor
-	fd_SendEnabled_denom   protoreflect.FieldDescriptor
-	fd_SendEnabled_enabled protoreflect.FieldDescriptor
+	md_DustSweepParams                        protoreflect.MessageDescriptor
+	fd_DustSweepParams_thresholds             protoreflect.FieldDescriptor
+	fd_DustSweepParams_epoch_length           protoreflect.FieldDescriptor
+	fd_DustSweepParams_chain_wide             protoreflect.FieldDescriptor
+	fd_DustSweepParams_burn                   protoreflect.FieldDescriptor
+	fd_DustSweepParams_exempt_addresses       protoreflect.FieldDescriptor
+	fd_DustSweepParams_max_accounts_per_epoch protoreflect.FieldDescriptor
 )
 
 func init() {
 	file_cosmos_bank_v1beta1_bank_proto_init()
-	md_SendEnabled = File_cosmos_bank_v1beta1_bank_proto.Messages().ByName("SendEnabled")
-	fd_SendEnabled_denom = md_SendEnabled.Fields().ByName("denom")
-	fd_SendEnabled_enabled = md_SendEnabled.Fields().ByName("enabled")
+	md_DustSweepParams = File_cosmos_bank_v1beta1_bank_proto.Messages().ByName("DustSweepParams")
+	fd_DustSweepParams_thresholds = md_DustSweepParams.Fields().ByName("thresholds")
+	fd_DustSweepParams_epoch_length = md_DustSweepParams.Fields().ByName("epoch_length")
+	fd_DustSweepParams_chain_wide = md_DustSweepParams.Fields().ByName("chain_wide")
+	fd_DustSweepParams_burn = md_DustSweepParams.Fields().ByName("burn")
+	fd_DustSweepParams_exempt_addresses = md_DustSweepParams.Fields().ByName("exempt_addresses")
+	fd_DustSweepParams_max_accounts_per_epoch = md_DustSweepParams.Fields().ByName("max_accounts_per_epoch")
 }
 
-var _ protoreflect.Message = (*fastReflection_SendEnabled)(nil)
+var _ protoreflect.Message = (*fastReflection_DustSweepParams)(nil)
 
-type fastReflection_SendEnabled SendEnabled
+type fastReflection_DustSweepParams DustSweepParams
 
-func (x *SendEnabled) ProtoReflect() protoreflect.Message {
-	return (*fastReflection_SendEnabled)(x)
+func (x *DustSweepParams) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_DustSweepParams)(x)
 }
 
-func (x *SendEnabled) slowProtoReflect() protoreflect.Message {
+func (x *DustSweepParams) slowProtoReflect() protoreflect.Message {
 	mi := &file_cosmos_bank_v1beta1_bank_proto_msgTypes[1]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -598,43 +784,43 @@ func (x *SendEnabled) slowProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-var _fastReflection_SendEnabled_messageType fastReflection_SendEnabled_messageType
-var _ protoreflect.MessageType = fastReflection_SendEnabled_messageType{}
+var _fastReflection_DustSweepParams_messageType fastReflection_DustSweepParams_messageType
+var _ protoreflect.MessageType = fastReflection_DustSweepParams_messageType{}
 
-type fastReflection_SendEnabled_messageType struct{}
+type fastReflection_DustSweepParams_messageType struct{}
 
-func (x fastReflection_SendEnabled_messageType) Zero() protoreflect.Message {
-	return (*fastReflection_SendEnabled)(nil)
+func (x fastReflection_DustSweepParams_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_DustSweepParams)(nil)
 }
-func (x fastReflection_SendEnabled_messageType) New() protoreflect.Message {
-	return new(fastReflection_SendEnabled)
+func (x fastReflection_DustSweepParams_messageType) New() protoreflect.Message {
+	return new(fastReflection_DustSweepParams)
 }
-func (x fastReflection_SendEnabled_messageType) Descriptor() protoreflect.MessageDescriptor {
-	return md_SendEnabled
+func (x fastReflection_DustSweepParams_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_DustSweepParams
 }
 
 // Descriptor returns message descriptor, which contains only the protobuf
 // type information for the message.
-func (x *fastReflection_SendEnabled) Descriptor() protoreflect.MessageDescriptor {
-	return md_SendEnabled
+func (x *fastReflection_DustSweepParams) Descriptor() protoreflect.MessageDescriptor {
+	return md_DustSweepParams
 }
 
 // Type returns the message type, which encapsulates both Go and protobuf
 // type information. If the Go type information is not needed,
 // it is recommended that the message descriptor be used instead.
-func (x *fastReflection_SendEnabled) Type() protoreflect.MessageType {
-	return _fastReflection_SendEnabled_messageType
+func (x *fastReflection_DustSweepParams) Type() protoreflect.MessageType {
+	return _fastReflection_DustSweepParams_messageType
 }
 
 // New returns a newly allocated and mutable empty message.
-func (x *fastReflection_SendEnabled) New() protoreflect.Message {
-	return new(fastReflection_SendEnabled)
+func (x *fastReflection_DustSweepParams) New() protoreflect.Message {
+	return new(fastReflection_DustSweepParams)
 }
 
 // Interface unwraps the message reflection interface and
 // returns the underlying ProtoMessage interface.
-func (x *fastReflection_SendEnabled) Interface() protoreflect.ProtoMessage {
-	return (*SendEnabled)(x)
+func (x *fastReflection_DustSweepParams) Interface() protoreflect.ProtoMessage {
+	return (*DustSweepParams)(x)
 }
 
 // Range iterates over every populated field in an undefined order,
@@ -642,16 +828,40 @@ func (x *fastReflection_SendEnabled) Interface() protoreflect.ProtoMessage {
 // Range returns immediately if f returns false.
 // While iterating, mutating operations may only be performed
 // on the current field descriptor.
-func (x *fastReflection_SendEnabled) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
-	if x.Denom != "" {
-		value := protoreflect.ValueOfString(x.Denom)
-		if !f(fd_SendEnabled_denom, value) {
+func (x *fastReflection_DustSweepParams) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if len(x.Thresholds) != 0 {
+		value := protoreflect.ValueOfList(&_DustSweepParams_1_list{list: &x.Thresholds})
+		if !f(fd_DustSweepParams_thresholds, value) {
 			return
 		}
 	}
-	if x.Enabled != false {
-		value := protoreflect.ValueOfBool(x.Enabled)
-		if !f(fd_SendEnabled_enabled, value) {
+	if x.EpochLength != int64(0) {
+		value := protoreflect.ValueOfInt64(x.EpochLength)
+		if !f(fd_DustSweepParams_epoch_length, value) {
+			return
+		}
+	}
+	if x.ChainWide != false {
+		value := protoreflect.ValueOfBool(x.ChainWide)
+		if !f(fd_DustSweepParams_chain_wide, value) {
+			return
+		}
+	}
+	if x.Burn != false {
+		value := protoreflect.ValueOfBool(x.Burn)
+		if !f(fd_DustSweepParams_burn, value) {
+			return
+		}
+	}
+	if len(x.ExemptAddresses) != 0 {
+		value := protoreflect.ValueOfList(&_DustSweepParams_5_list{list: &x.ExemptAddresses})
+		if !f(fd_DustSweepParams_exempt_addresses, value) {
+			return
+		}
+	}
+	if x.MaxAccountsPerEpoch != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.MaxAccountsPerEpoch)
+		if !f(fd_DustSweepParams_max_accounts_per_epoch, value) {
 			return
 		}
 	}
@@ -668,17 +878,25 @@ func (x *fastReflection_SendEnabled) Range(f func(protoreflect.FieldDescriptor,
 // In other cases (aside from the nullable cases above),
 // a proto3 scalar field is populated if it contains a non-zero value, and
 // a repeated field is populated if it is non-empty.
-func (x *fastReflection_SendEnabled) Has(fd protoreflect.FieldDescriptor) bool {
+func (x *fastReflection_DustSweepParams) Has(fd protoreflect.FieldDescriptor) bool {
 	switch fd.FullName() {
-	case "cosmos.bank.v1beta1.SendEnabled.denom":
-		return x.Denom != ""
-	case "cosmos.bank.v1beta1.SendEnabled.enabled":
-		return x.Enabled != false
+	case "cosmos.bank.v1beta1.DustSweepParams.thresholds":
+		return len(x.Thresholds) != 0
+	case "cosmos.bank.v1beta1.DustSweepParams.epoch_length":
+		return x.EpochLength != int64(0)
+	case "cosmos.bank.v1beta1.DustSweepParams.chain_wide":
+		return x.ChainWide != false
+	case "cosmos.bank.v1beta1.DustSweepParams.burn":
+		return x.Burn != false
+	case "cosmos.bank.v1beta1.DustSweepParams.exempt_addresses":
+		return len(x.ExemptAddresses) != 0
+	case "cosmos.bank.v1beta1.DustSweepParams.max_accounts_per_epoch":
+		return x.MaxAccountsPerEpoch != uint64(0)
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.bank.v1beta1.SendEnabled"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.bank.v1beta1.DustSweepParams"))
 		}
-		panic(fmt.Errorf("message cosmos.bank.v1beta1.SendEnabled does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.bank.v1beta1.DustSweepParams does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -688,17 +906,25 @@ func (x *fastReflection_SendEnabled) Has(fd protoreflect.FieldDescriptor) bool {
 // associated with the given field number.
 //
 // Clear is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_SendEnabled) Clear(fd protoreflect.FieldDescriptor) {
+func (x *fastReflection_DustSweepParams) Clear(fd protoreflect.FieldDescriptor) {
 	switch fd.FullName() {
-	case "cosmos.bank.v1beta1.SendEnabled.denom":
-		x.Denom = ""
-	case "cosmos.bank.v1beta1.SendEnabled.enabled":
-		x.Enabled = false
+	case "cosmos.bank.v1beta1.DustSweepParams.thresholds":
+		x.Thresholds = nil
+	case "cosmos.bank.v1beta1.DustSweepParams.epoch_length":
+		x.EpochLength = int64(0)
+	case "cosmos.bank.v1beta1.DustSweepParams.chain_wide":
+		x.ChainWide = false
+	case "cosmos.bank.v1beta1.DustSweepParams.burn":
+		x.Burn = false
+	case "cosmos.bank.v1beta1.DustSweepParams.exempt_addresses":
+		x.ExemptAddresses = nil
+	case "cosmos.bank.v1beta1.DustSweepParams.max_accounts_per_epoch":
+		x.MaxAccountsPerEpoch = uint64(0)
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.bank.v1beta1.SendEnabled"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.bank.v1beta1.DustSweepParams"))
 		}
-		panic(fmt.Errorf("message cosmos.bank.v1beta1.SendEnabled does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.bank.v1beta1.DustSweepParams does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -708,19 +934,37 @@ func (x *fastReflection_SendEnabled) Clear(fd protoreflect.FieldDescriptor) {
 // the default value of a bytes scalar is guaranteed to be a copy.
 // For unpopulated composite types, it returns an empty, read-only view
 // of the value; to obtain a mutable reference, use Mutable.
-func (x *fastReflection_SendEnabled) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_DustSweepParams) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
 	switch descriptor.FullName() {
-	case "cosmos.bank.v1beta1.SendEnabled.denom":
-		value := x.Denom
-		return protoreflect.ValueOfString(value)
-	case "cosmos.bank.v1beta1.SendEnabled.enabled":
-		value := x.Enabled
+	case "cosmos.bank.v1beta1.DustSweepParams.thresholds":
+		if len(x.Thresholds) == 0 {
+			return protoreflect.ValueOfList(&_DustSweepParams_1_list{})
+		}
+		listValue := &_DustSweepParams_1_list{list: &x.Thresholds}
+		return protoreflect.ValueOfList(listValue)
+	case "cosmos.bank.v1beta1.DustSweepParams.epoch_length":
+		value := x.EpochLength
+		return protoreflect.ValueOfInt64(value)
+	case "cosmos.bank.v1beta1.DustSweepParams.chain_wide":
+		value := x.ChainWide
+		return protoreflect.ValueOfBool(value)
+	case "cosmos.bank.v1beta1.DustSweepParams.burn":
+		value := x.Burn
 		return protoreflect.ValueOfBool(value)
+	case "cosmos.bank.v1beta1.DustSweepParams.exempt_addresses":
+		if len(x.ExemptAddresses) == 0 {
+			return protoreflect.ValueOfList(&_DustSweepParams_5_list{})
+		}
+		listValue := &_DustSweepParams_5_list{list: &x.ExemptAddresses}
+		return protoreflect.ValueOfList(listValue)
+	case "cosmos.bank.v1beta1.DustSweepParams.max_accounts_per_epoch":
+		value := x.MaxAccountsPerEpoch
+		return protoreflect.ValueOfUint64(value)
 	default:
 		if descriptor.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.bank.v1beta1.SendEnabled"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.bank.v1beta1.DustSweepParams"))
 		}
-		panic(fmt.Errorf("message cosmos.bank.v1beta1.SendEnabled does not contain field %s", descriptor.FullName()))
+		panic(fmt.Errorf("message cosmos.bank.v1beta1.DustSweepParams does not contain field %s", descriptor.FullName()))
 	}
 }
 
@@ -734,17 +978,29 @@ func (x *fastReflection_SendEnabled) Get(descriptor protoreflect.FieldDescriptor
 // empty, read-only value, then it panics.
 //
 // Set is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_SendEnabled) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+func (x *fastReflection_DustSweepParams) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
 	switch fd.FullName() {
-	case "cosmos.bank.v1beta1.SendEnabled.denom":
-		x.Denom = value.Interface().(string)
-	case "cosmos.bank.v1beta1.SendEnabled.enabled":
-		x.Enabled = value.Bool()
+	case "cosmos.bank.v1beta1.DustSweepParams.thresholds":
+		lv := value.List()
+		clv := lv.(*_DustSweepParams_1_list)
+		x.Thresholds = *clv.list
+	case "cosmos.bank.v1beta1.DustSweepParams.epoch_length":
+		x.EpochLength = value.Int()
+	case "cosmos.bank.v1beta1.DustSweepParams.chain_wide":
+		x.ChainWide = value.Bool()
+	case "cosmos.bank.v1beta1.DustSweepParams.burn":
+		x.Burn = value.Bool()
+	case "cosmos.bank.v1beta1.DustSweepParams.exempt_addresses":
+		lv := value.List()
+		clv := lv.(*_DustSweepParams_5_list)
+		x.ExemptAddresses = *clv.list
+	case "cosmos.bank.v1beta1.DustSweepParams.max_accounts_per_epoch":
+		x.MaxAccountsPerEpoch = value.Uint()
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.bank.v1beta1.SendEnabled"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.bank.v1beta1.DustSweepParams"))
 		}
-		panic(fmt.Errorf("message cosmos.bank.v1beta1.SendEnabled does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.bank.v1beta1.DustSweepParams does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -758,44 +1014,70 @@ func (x *fastReflection_SendEnabled) Set(fd protoreflect.FieldDescriptor, value
 // It panics if the field does not contain a composite type.
 //
 // Mutable is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_SendEnabled) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_DustSweepParams) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "cosmos.bank.v1beta1.SendEnabled.denom":
-		panic(fmt.Errorf("field denom of message cosmos.bank.v1beta1.SendEnabled is not mutable"))
-	case "cosmos.bank.v1beta1.SendEnabled.enabled":
-		panic(fmt.Errorf("field enabled of message cosmos.bank.v1beta1.SendEnabled is not mutable"))
+	case "cosmos.bank.v1beta1.DustSweepParams.thresholds":
+		if x.Thresholds == nil {
+			x.Thresholds = []*v1beta1.Coin{}
+		}
+		value := &_DustSweepParams_1_list{list: &x.Thresholds}
+		return protoreflect.ValueOfList(value)
+	case "cosmos.bank.v1beta1.DustSweepParams.exempt_addresses":
+		if x.ExemptAddresses == nil {
+			x.ExemptAddresses = []string{}
+		}
+		value := &_DustSweepParams_5_list{list: &x.ExemptAddresses}
+		return protoreflect.ValueOfList(value)
+	case "cosmos.bank.v1beta1.DustSweepParams.epoch_length":
+		panic(fmt.Errorf("field epoch_length of message cosmos.bank.v1beta1.DustSweepParams is not mutable"))
+	case "cosmos.bank.v1beta1.DustSweepParams.chain_wide":
+		panic(fmt.Errorf("field chain_wide of message cosmos.bank.v1beta1.DustSweepParams is not mutable"))
+	case "cosmos.bank.v1beta1.DustSweepParams.burn":
+		panic(fmt.Errorf("field burn of message cosmos.bank.v1beta1.DustSweepParams is not mutable"))
+	case "cosmos.bank.v1beta1.DustSweepParams.max_accounts_per_epoch":
+		panic(fmt.Errorf("field max_accounts_per_epoch of message cosmos.bank.v1beta1.DustSweepParams is not mutable"))
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.bank.v1beta1.SendEnabled"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.bank.v1beta1.DustSweepParams"))
 		}
-		panic(fmt.Errorf("message cosmos.bank.v1beta1.SendEnabled does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.bank.v1beta1.DustSweepParams does not contain field %s", fd.FullName()))
 	}
 }
 
 // NewField returns a new value that is assignable to the field
 // for the given descriptor. For scalars, this returns the default value.
 // For lists, maps, and messages, this returns a new, empty, mutable value.
-func (x *fastReflection_SendEnabled) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_DustSweepParams) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "cosmos.bank.v1beta1.SendEnabled.denom":
-		return protoreflect.ValueOfString("")
-	case "cosmos.bank.v1beta1.SendEnabled.enabled":
+	case "cosmos.bank.v1beta1.DustSweepParams.thresholds":
+		list := []*v1beta1.Coin{}
+		return protoreflect.ValueOfList(&_DustSweepParams_1_list{list: &list})
+	case "cosmos.bank.v1beta1.DustSweepParams.epoch_length":
+		return protoreflect.ValueOfInt64(int64(0))
+	case "cosmos.bank.v1beta1.DustSweepParams.chain_wide":
+		return protoreflect.ValueOfBool(false)
+	case "cosmos.bank.v1beta1.DustSweepParams.burn":
 		return protoreflect.ValueOfBool(false)
+	case "cosmos.bank.v1beta1.DustSweepParams.exempt_addresses":
+		list := []string{}
+		return protoreflect.ValueOfList(&_DustSweepParams_5_list{list: &list})
+	case "cosmos.bank.v1beta1.DustSweepParams.max_accounts_per_epoch":
+		return protoreflect.ValueOfUint64(uint64(0))
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.bank.v1beta1.SendEnabled"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.bank.v1beta1.DustSweepParams"))
 		}
-		panic(fmt.Errorf("message cosmos.bank.v1beta1.SendEnabled does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.bank.v1beta1.DustSweepParams does not contain field %s", fd.FullName()))
 	}
 }
 
 // WhichOneof reports which field within the oneof is populated,
 // returning nil if none are populated.
 // It panics if the oneof descriptor does not belong to this message.
-func (x *fastReflection_SendEnabled) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+func (x *fastReflection_DustSweepParams) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
 	switch d.FullName() {
 	default:
-		panic(fmt.Errorf("%s is not a oneof field in cosmos.bank.v1beta1.SendEnabled", d.FullName()))
+		panic(fmt.Errorf("%s is not a oneof field in cosmos.bank.v1beta1.DustSweepParams", d.FullName()))
 	}
 	panic("unreachable")
 }
@@ -803,7 +1085,7 @@ func (x *fastReflection_SendEnabled) WhichOneof(d protoreflect.OneofDescriptor)
 // GetUnknown retrieves the entire list of unknown fields.
 // The caller may only mutate the contents of the RawFields
 // if the mutated bytes are stored back into the message with SetUnknown.
-func (x *fastReflection_SendEnabled) GetUnknown() protoreflect.RawFields {
+func (x *fastReflection_DustSweepParams) GetUnknown() protoreflect.RawFields {
 	return x.unknownFields
 }
 
@@ -814,7 +1096,7 @@ func (x *fastReflection_SendEnabled) GetUnknown() protoreflect.RawFields {
 // An empty RawFields may be passed to clear the fields.
 //
 // SetUnknown is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_SendEnabled) SetUnknown(fields protoreflect.RawFields) {
+func (x *fastReflection_DustSweepParams) SetUnknown(fields protoreflect.RawFields) {
 	x.unknownFields = fields
 }
 
@@ -826,7 +1108,7 @@ func (x *fastReflection_SendEnabled) SetUnknown(fields protoreflect.RawFields) {
 // message type, but the details are implementation dependent.
 // Validity is not part of the protobuf data model, and may not
 // be preserved in marshaling or other operations.
-func (x *fastReflection_SendEnabled) IsValid() bool {
+func (x *fastReflection_DustSweepParams) IsValid() bool {
 	return x != nil
 }
 
@@ -836,9 +1118,9 @@ func (x *fastReflection_SendEnabled) IsValid() bool {
 // The returned methods type is identical to
 // "google.golang.org/protobuf/runtime/protoiface".Methods.
 // Consult the protoiface package documentation for details.
-func (x *fastReflection_SendEnabled) ProtoMethods() *protoiface.Methods {
+func (x *fastReflection_DustSweepParams) ProtoMethods() *protoiface.Methods {
 	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
-		x := input.Message.Interface().(*SendEnabled)
+		x := input.Message.Interface().(*DustSweepParams)
 		if x == nil {
 			return protoiface.SizeOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -850,13 +1132,30 @@ func (x *fastReflection_SendEnabled) ProtoMethods() *protoiface.Methods {
 		var n int
 		var l int
 		_ = l
-		l = len(x.Denom)
-		if l > 0 {
-			n += 1 + l + runtime.Sov(uint64(l))
+		if len(x.Thresholds) > 0 {
+			for _, e := range x.Thresholds {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
 		}
-		if x.Enabled {
+		if x.EpochLength != 0 {
+			n += 1 + runtime.Sov(uint64(x.EpochLength))
+		}
+		if x.ChainWide {
 			n += 2
 		}
+		if x.Burn {
+			n += 2
+		}
+		if len(x.ExemptAddresses) > 0 {
+			for _, s := range x.ExemptAddresses {
+				l = len(s)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if x.MaxAccountsPerEpoch != 0 {
+			n += 1 + runtime.Sov(uint64(x.MaxAccountsPerEpoch))
+		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
 		}
@@ -867,7 +1166,7 @@ func (x *fastReflection_SendEnabled) ProtoMethods() *protoiface.Methods {
 	}
 
 	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
-		x := input.Message.Interface().(*SendEnabled)
+		x := input.Message.Interface().(*DustSweepParams)
 		if x == nil {
 			return protoiface.MarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -886,22 +1185,60 @@ func (x *fastReflection_SendEnabled) ProtoMethods() *protoiface.Methods {
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
-		if x.Enabled {
+		if x.MaxAccountsPerEpoch != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.MaxAccountsPerEpoch))
 			i--
-			if x.Enabled {
+			dAtA[i] = 0x30
+		}
+		if len(x.ExemptAddresses) > 0 {
+			for iNdEx := len(x.ExemptAddresses) - 1; iNdEx >= 0; iNdEx-- {
+				i -= len(x.ExemptAddresses[iNdEx])
+				copy(dAtA[i:], x.ExemptAddresses[iNdEx])
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(x.ExemptAddresses[iNdEx])))
+				i--
+				dAtA[i] = 0x2a
+			}
+		}
+		if x.Burn {
+			i--
+			if x.Burn {
 				dAtA[i] = 1
 			} else {
 				dAtA[i] = 0
 			}
 			i--
-			dAtA[i] = 0x10
+			dAtA[i] = 0x20
 		}
-		if len(x.Denom) > 0 {
-			i -= len(x.Denom)
-			copy(dAtA[i:], x.Denom)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Denom)))
+		if x.ChainWide {
 			i--
-			dAtA[i] = 0xa
+			if x.ChainWide {
+				dAtA[i] = 1
+			} else {
+				dAtA[i] = 0
+			}
+			i--
+			dAtA[i] = 0x18
+		}
+		if x.EpochLength != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.EpochLength))
+			i--
+			dAtA[i] = 0x10
+		}
+		if len(x.Thresholds) > 0 {
+			for iNdEx := len(x.Thresholds) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.Thresholds[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0xa
+			}
 		}
 		if input.Buf != nil {
 			input.Buf = append(input.Buf, dAtA...)
@@ -914,7 +1251,7 @@ func (x *fastReflection_SendEnabled) ProtoMethods() *protoiface.Methods {
 		}, nil
 	}
 	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
-		x := input.Message.Interface().(*SendEnabled)
+		x := input.Message.Interface().(*DustSweepParams)
 		if x == nil {
 			return protoiface.UnmarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -946,15 +1283,108 @@ func (x *fastReflection_SendEnabled) ProtoMethods() *protoiface.Methods {
 			fieldNum := int32(wire >> 3)
 			wireType := int(wire & 0x7)
 			if wireType == 4 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: SendEnabled: wiretype end group for non-group")
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: DustSweepParams: wiretype end group for non-group")
 			}
 			if fieldNum <= 0 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: SendEnabled: illegal tag %d (wire type %d)", fieldNum, wire)
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: DustSweepParams: illegal tag %d (wire type %d)", fieldNum, wire)
 			}
 			switch fieldNum {
 			case 1:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Thresholds", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Thresholds = append(x.Thresholds, &v1beta1.Coin{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Thresholds[len(x.Thresholds)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 2:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field EpochLength", wireType)
+				}
+				x.EpochLength = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.EpochLength |= int64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 3:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ChainWide", wireType)
+				}
+				var v int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				x.ChainWide = bool(v != 0)
+			case 4:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Burn", wireType)
+				}
+				var v int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				x.Burn = bool(v != 0)
+			case 5:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ExemptAddresses", wireType)
 				}
 				var stringLen uint64
 				for shift := uint(0); ; shift += 7 {
@@ -982,13 +1412,13 @@ func (x *fastReflection_SendEnabled) ProtoMethods() *protoiface.Methods {
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				x.Denom = string(dAtA[iNdEx:postIndex])
+				x.ExemptAddresses = append(x.ExemptAddresses, string(dAtA[iNdEx:postIndex]))
 				iNdEx = postIndex
-			case 2:
+			case 6:
 				if wireType != 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Enabled", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field MaxAccountsPerEpoch", wireType)
 				}
-				var v int
+				x.MaxAccountsPerEpoch = 0
 				for shift := uint(0); ; shift += 7 {
 					if shift >= 64 {
 						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
@@ -998,12 +1428,11 @@ func (x *fastReflection_SendEnabled) ProtoMethods() *protoiface.Methods {
 					}
 					b := dAtA[iNdEx]
 					iNdEx++
-					v |= int(b&0x7F) << shift
+					x.MaxAccountsPerEpoch |= uint64(b&0x7F) << shift
 					if b < 0x80 {
 						break
 					}
 				}
-				x.Enabled = bool(v != 0)
 			default:
 				iNdEx = preIndex
 				skippy, err := runtime.Skip(dAtA[iNdEx:])
@@ -1039,79 +1468,28 @@ func (x *fastReflection_SendEnabled) ProtoMethods() *protoiface.Methods {
 	}
 }
 
-var _ protoreflect.List = (*_Input_2_list)(nil)
-
-type _Input_2_list struct {
-	list *[]*v1beta1.Coin
-}
-
-func (x *_Input_2_list) Len() int {
-	if x.list == nil {
-		return 0
-	}
-	return len(*x.list)
-}
-
-func (x *_Input_2_list) Get(i int) protoreflect.Value {
-	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
-}
-
-func (x *_Input_2_list) Set(i int, value protoreflect.Value) {
-	valueUnwrapped := value.Message()
-	concreteValue := valueUnwrapped.Interface().(*v1beta1.Coin)
-	(*x.list)[i] = concreteValue
-}
-
-func (x *_Input_2_list) Append(value protoreflect.Value) {
-	valueUnwrapped := value.Message()
-	concreteValue := valueUnwrapped.Interface().(*v1beta1.Coin)
-	*x.list = append(*x.list, concreteValue)
-}
-
-func (x *_Input_2_list) AppendMutable() protoreflect.Value {
-	v := new(v1beta1.Coin)
-	*x.list = append(*x.list, v)
-	return protoreflect.ValueOfMessage(v.ProtoReflect())
-}
-
-func (x *_Input_2_list) Truncate(n int) {
-	for i := n; i < len(*x.list); i++ {
-		(*x.list)[i] = nil
-	}
-	*x.list = (*x.list)[:n]
-}
-
-func (x *_Input_2_list) NewElement() protoreflect.Value {
-	v := new(v1beta1.Coin)
-	return protoreflect.ValueOfMessage(v.ProtoReflect())
-}
-
-func (x *_Input_2_list) IsValid() bool {
-	return x.list != nil
-}
-
 var (
-	md_Input         protoreflect.MessageDescriptor
-	fd_Input_address protoreflect.FieldDescriptor
-	fd_Input_coins   protoreflect.FieldDescriptor
+	md_SendEnabled         protoreflect.MessageDescriptor
+	fd_SendEnabled_denom   protoreflect.FieldDescriptor
+	fd_SendEnabled_enabled protoreflect.FieldDescriptor
 )
 
 func init() {
 	file_cosmos_bank_v1beta1_bank_proto_init()
-	md_Input = File_cosmos_bank_v1beta1_bank_proto.Messages().ByName("Input")
-	fd_Input_address = md_Input.Fields().ByName("address")
-	fd_Input_coins = md_Input.Fields().ByName("coins")
+	md_SendEnabled = File_cosmos_bank_v1beta1_bank_proto.Messages().ByName("SendEnabled")
+	fd_SendEnabled_denom = md_SendEnabled.Fields().ByName("denom")
+	fd_SendEnabled_enabled = md_SendEnabled.Fields().ByName("enabled")
 }
 
-var _ protoreflect.Message = (*fastReflection_Input)(nil)
+var _ protoreflect.Message = (*fastReflection_SendEnabled)(nil)
 
-type fastReflection_Input Input
+type fastReflection_SendEnabled SendEnabled
 
-func (x *Input) ProtoReflect() protoreflect.Message {
-	return (*fastReflection_Input)(x)
+func (x *SendEnabled) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_SendEnabled)(x)
 }
 
-func (x *Input) slowProtoReflect() protoreflect.Message {
+func (x *SendEnabled) slowProtoReflect() protoreflect.Message {
 	mi := &file_cosmos_bank_v1beta1_bank_proto_msgTypes[2]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1123,43 +1501,43 @@ func (x *Input) slowProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-var _fastReflection_Input_messageType fastReflection_Input_messageType
-var _ protoreflect.MessageType = fastReflection_Input_messageType{}
+var _fastReflection_SendEnabled_messageType fastReflection_SendEnabled_messageType
+var _ protoreflect.MessageType = fastReflection_SendEnabled_messageType{}
 
-type fastReflection_Input_messageType struct{}
+type fastReflection_SendEnabled_messageType struct{}
 
-func (x fastReflection_Input_messageType) Zero() protoreflect.Message {
-	return (*fastReflection_Input)(nil)
+func (x fastReflection_SendEnabled_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_SendEnabled)(nil)
 }
-func (x fastReflection_Input_messageType) New() protoreflect.Message {
-	return new(fastReflection_Input)
+func (x fastReflection_SendEnabled_messageType) New() protoreflect.Message {
+	return new(fastReflection_SendEnabled)
 }
-func (x fastReflection_Input_messageType) Descriptor() protoreflect.MessageDescriptor {
-	return md_Input
+func (x fastReflection_SendEnabled_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_SendEnabled
 }
 
 // Descriptor returns message descriptor, which contains only the protobuf
 // type information for the message.
-func (x *fastReflection_Input) Descriptor() protoreflect.MessageDescriptor {
-	return md_Input
+func (x *fastReflection_SendEnabled) Descriptor() protoreflect.MessageDescriptor {
+	return md_SendEnabled
 }
 
 // Type returns the message type, which encapsulates both Go and protobuf
 // type information. If the Go type information is not needed,
 // it is recommended that the message descriptor be used instead.
-func (x *fastReflection_Input) Type() protoreflect.MessageType {
-	return _fastReflection_Input_messageType
+func (x *fastReflection_SendEnabled) Type() protoreflect.MessageType {
+	return _fastReflection_SendEnabled_messageType
 }
 
 // New returns a newly allocated and mutable empty message.
-func (x *fastReflection_Input) New() protoreflect.Message {
-	return new(fastReflection_Input)
+func (x *fastReflection_SendEnabled) New() protoreflect.Message {
+	return new(fastReflection_SendEnabled)
 }
 
 // Interface unwraps the message reflection interface and
 // returns the underlying ProtoMessage interface.
-func (x *fastReflection_Input) Interface() protoreflect.ProtoMessage {
-	return (*Input)(x)
+func (x *fastReflection_SendEnabled) Interface() protoreflect.ProtoMessage {
+	return (*SendEnabled)(x)
 }
 
 // Range iterates over every populated field in an undefined order,
@@ -1167,16 +1545,16 @@ func (x *fastReflection_Input) Interface() protoreflect.ProtoMessage {
 // Range returns immediately if f returns false.
 // While iterating, mutating operations may only be performed
 // on the current field descriptor.
-func (x *fastReflection_Input) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
-	if x.Address != "" {
-		value := protoreflect.ValueOfString(x.Address)
-		if !f(fd_Input_address, value) {
+func (x *fastReflection_SendEnabled) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Denom != "" {
+		value := protoreflect.ValueOfString(x.Denom)
+		if !f(fd_SendEnabled_denom, value) {
 			return
 		}
 	}
-	if len(x.Coins) != 0 {
-		value := protoreflect.ValueOfList(&_Input_2_list{list: &x.Coins})
-		if !f(fd_Input_coins, value) {
+	if x.Enabled != false {
+		value := protoreflect.ValueOfBool(x.Enabled)
+		if !f(fd_SendEnabled_enabled, value) {
 			return
 		}
 	}
@@ -1193,17 +1571,17 @@ func (x *fastReflection_Input) Range(f func(protoreflect.FieldDescriptor, protor
 // In other cases (aside from the nullable cases above),
 // a proto3 scalar field is populated if it contains a non-zero value, and
 // a repeated field is populated if it is non-empty.
-func (x *fastReflection_Input) Has(fd protoreflect.FieldDescriptor) bool {
+func (x *fastReflection_SendEnabled) Has(fd protoreflect.FieldDescriptor) bool {
 	switch fd.FullName() {
-	case "cosmos.bank.v1beta1.Input.address":
-		return x.Address != ""
-	case "cosmos.bank.v1beta1.Input.coins":
-		return len(x.Coins) != 0
+	case "cosmos.bank.v1beta1.SendEnabled.denom":
+		return x.Denom != ""
+	case "cosmos.bank.v1beta1.SendEnabled.enabled":
+		return x.Enabled != false
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.bank.v1beta1.Input"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.bank.v1beta1.SendEnabled"))
 		}
-		panic(fmt.Errorf("message cosmos.bank.v1beta1.Input does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.bank.v1beta1.SendEnabled does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -1213,17 +1591,17 @@ func (x *fastReflection_Input) Has(fd protoreflect.FieldDescriptor) bool {
 // associated with the given field number.
 //
 // Clear is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_Input) Clear(fd protoreflect.FieldDescriptor) {
+func (x *fastReflection_SendEnabled) Clear(fd protoreflect.FieldDescriptor) {
 	switch fd.FullName() {
-	case "cosmos.bank.v1beta1.Input.address":
-		x.Address = ""
-	case "cosmos.bank.v1beta1.Input.coins":
-		x.Coins = nil
+	case "cosmos.bank.v1beta1.SendEnabled.denom":
+		x.Denom = ""
+	case "cosmos.bank.v1beta1.SendEnabled.enabled":
+		x.Enabled = false
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.bank.v1beta1.Input"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.bank.v1beta1.SendEnabled"))
 		}
-		panic(fmt.Errorf("message cosmos.bank.v1beta1.Input does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.bank.v1beta1.SendEnabled does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -1233,22 +1611,19 @@ func (x *fastReflection_Input) Clear(fd protoreflect.FieldDescriptor) {
 // the default value of a bytes scalar is guaranteed to be a copy.
 // For unpopulated composite types, it returns an empty, read-only view
 // of the value; to obtain a mutable reference, use Mutable.
-func (x *fastReflection_Input) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_SendEnabled) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
 	switch descriptor.FullName() {
-	case "cosmos.bank.v1beta1.Input.address":
-		value := x.Address
+	case "cosmos.bank.v1beta1.SendEnabled.denom":
+		value := x.Denom
 		return protoreflect.ValueOfString(value)
-	case "cosmos.bank.v1beta1.Input.coins":
-		if len(x.Coins) == 0 {
-			return protoreflect.ValueOfList(&_Input_2_list{})
-		}
-		listValue := &_Input_2_list{list: &x.Coins}
-		return protoreflect.ValueOfList(listValue)
+	case "cosmos.bank.v1beta1.SendEnabled.enabled":
+		value := x.Enabled
+		return protoreflect.ValueOfBool(value)
 	default:
 		if descriptor.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.bank.v1beta1.Input"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.bank.v1beta1.SendEnabled"))
 		}
-		panic(fmt.Errorf("message cosmos.bank.v1beta1.Input does not contain field %s", descriptor.FullName()))
+		panic(fmt.Errorf("message cosmos.bank.v1beta1.SendEnabled does not contain field %s", descriptor.FullName()))
 	}
 }
 
@@ -1262,19 +1637,17 @@ func (x *fastReflection_Input) Get(descriptor protoreflect.FieldDescriptor) prot
 // empty, read-only value, then it panics.
 //
 // Set is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_Input) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+func (x *fastReflection_SendEnabled) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
 	switch fd.FullName() {
-	case "cosmos.bank.v1beta1.Input.address":
-		x.Address = value.Interface().(string)
-	case "cosmos.bank.v1beta1.Input.coins":
-		lv := value.List()
-		clv := lv.(*_Input_2_list)
-		x.Coins = *clv.list
+	case "cosmos.bank.v1beta1.SendEnabled.denom":
+		x.Denom = value.Interface().(string)
+	case "cosmos.bank.v1beta1.SendEnabled.enabled":
+		x.Enabled = value.Bool()
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.bank.v1beta1.Input"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.bank.v1beta1.SendEnabled"))
 		}
-		panic(fmt.Errorf("message cosmos.bank.v1beta1.Input does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.bank.v1beta1.SendEnabled does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -1288,49 +1661,44 @@ func (x *fastReflection_Input) Set(fd protoreflect.FieldDescriptor, value protor
 // It panics if the field does not contain a composite type.
 //
 // Mutable is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_Input) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_SendEnabled) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "cosmos.bank.v1beta1.Input.coins":
-		if x.Coins == nil {
-			x.Coins = []*v1beta1.Coin{}
-		}
-		value := &_Input_2_list{list: &x.Coins}
-		return protoreflect.ValueOfList(value)
-	case "cosmos.bank.v1beta1.Input.address":
-		panic(fmt.Errorf("field address of message cosmos.bank.v1beta1.Input is not mutable"))
+	case "cosmos.bank.v1beta1.SendEnabled.denom":
+		panic(fmt.Errorf("field denom of message cosmos.bank.v1beta1.SendEnabled is not mutable"))
+	case "cosmos.bank.v1beta1.SendEnabled.enabled":
+		panic(fmt.Errorf("field enabled of message cosmos.bank.v1beta1.SendEnabled is not mutable"))
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.bank.v1beta1.Input"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.bank.v1beta1.SendEnabled"))
 		}
-		panic(fmt.Errorf("message cosmos.bank.v1beta1.Input does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.bank.v1beta1.SendEnabled does not contain field %s", fd.FullName()))
 	}
 }
 
 // NewField returns a new value that is assignable to the field
 // for the given descriptor. For scalars, this returns the default value.
 // For lists, maps, and messages, this returns a new, empty, mutable value.
-func (x *fastReflection_Input) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_SendEnabled) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "cosmos.bank.v1beta1.Input.address":
+	case "cosmos.bank.v1beta1.SendEnabled.denom":
 		return protoreflect.ValueOfString("")
-	case "cosmos.bank.v1beta1.Input.coins":
-		list := []*v1beta1.Coin{}
-		return protoreflect.ValueOfList(&_Input_2_list{list: &list})
+	case "cosmos.bank.v1beta1.SendEnabled.enabled":
+		return protoreflect.ValueOfBool(false)
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.bank.v1beta1.Input"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.bank.v1beta1.SendEnabled"))
 		}
-		panic(fmt.Errorf("message cosmos.bank.v1beta1.Input does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.bank.v1beta1.SendEnabled does not contain field %s", fd.FullName()))
 	}
 }
 
 // WhichOneof reports which field within the oneof is populated,
 // returning nil if none are populated.
 // It panics if the oneof descriptor does not belong to this message.
-func (x *fastReflection_Input) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+func (x *fastReflection_SendEnabled) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
 	switch d.FullName() {
 	default:
-		panic(fmt.Errorf("%s is not a oneof field in cosmos.bank.v1beta1.Input", d.FullName()))
+		panic(fmt.Errorf("%s is not a oneof field in cosmos.bank.v1beta1.SendEnabled", d.FullName()))
 	}
 	panic("unreachable")
 }
@@ -1338,7 +1706,7 @@ func (x *fastReflection_Input) WhichOneof(d protoreflect.OneofDescriptor) protor
 // GetUnknown retrieves the entire list of unknown fields.
 // The caller may only mutate the contents of the RawFields
 // if the mutated bytes are stored back into the message with SetUnknown.
-func (x *fastReflection_Input) GetUnknown() protoreflect.RawFields {
+func (x *fastReflection_SendEnabled) GetUnknown() protoreflect.RawFields {
 	return x.unknownFields
 }
 
@@ -1349,7 +1717,7 @@ func (x *fastReflection_Input) GetUnknown() protoreflect.RawFields {
 // An empty RawFields may be passed to clear the fields.
 //
 // SetUnknown is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_Input) SetUnknown(fields protoreflect.RawFields) {
+func (x *fastReflection_SendEnabled) SetUnknown(fields protoreflect.RawFields) {
 	x.unknownFields = fields
 }
 
@@ -1361,7 +1729,7 @@ func (x *fastReflection_Input) SetUnknown(fields protoreflect.RawFields) {
 // message type, but the details are implementation dependent.
 // Validity is not part of the protobuf data model, and may not
 // be preserved in marshaling or other operations.
-func (x *fastReflection_Input) IsValid() bool {
+func (x *fastReflection_SendEnabled) IsValid() bool {
 	return x != nil
 }
 
@@ -1371,9 +1739,9 @@ func (x *fastReflection_Input) IsValid() bool {
 // The returned methods type is identical to
 // "google.golang.org/protobuf/runtime/protoiface".Methods.
 // Consult the protoiface package documentation for details.
-func (x *fastReflection_Input) ProtoMethods() *protoiface.Methods {
+func (x *fastReflection_SendEnabled) ProtoMethods() *protoiface.Methods {
 	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
-		x := input.Message.Interface().(*Input)
+		x := input.Message.Interface().(*SendEnabled)
 		if x == nil {
 			return protoiface.SizeOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -1385,15 +1753,12 @@ func (x *fastReflection_Input) ProtoMethods() *protoiface.Methods {
 		var n int
 		var l int
 		_ = l
-		l = len(x.Address)
+		l = len(x.Denom)
 		if l > 0 {
 			n += 1 + l + runtime.Sov(uint64(l))
 		}
-		if len(x.Coins) > 0 {
-			for _, e := range x.Coins {
-				l = options.Size(e)
-				n += 1 + l + runtime.Sov(uint64(l))
-			}
+		if x.Enabled {
+			n += 2
 		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
@@ -1405,7 +1770,7 @@ func (x *fastReflection_Input) ProtoMethods() *protoiface.Methods {
 	}
 
 	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
-		x := input.Message.Interface().(*Input)
+		x := input.Message.Interface().(*SendEnabled)
 		if x == nil {
 			return protoiface.MarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -1424,26 +1789,20 @@ func (x *fastReflection_Input) ProtoMethods() *protoiface.Methods {
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
-		if len(x.Coins) > 0 {
-			for iNdEx := len(x.Coins) - 1; iNdEx >= 0; iNdEx-- {
-				encoded, err := options.Marshal(x.Coins[iNdEx])
-				if err != nil {
-					return protoiface.MarshalOutput{
-						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
-						Buf:               input.Buf,
-					}, err
-				}
-				i -= len(encoded)
-				copy(dAtA[i:], encoded)
-				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
-				i--
-				dAtA[i] = 0x12
+		if x.Enabled {
+			i--
+			if x.Enabled {
+				dAtA[i] = 1
+			} else {
+				dAtA[i] = 0
 			}
+			i--
+			dAtA[i] = 0x10
 		}
-		if len(x.Address) > 0 {
-			i -= len(x.Address)
-			copy(dAtA[i:], x.Address)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Address)))
+		if len(x.Denom) > 0 {
+			i -= len(x.Denom)
+			copy(dAtA[i:], x.Denom)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Denom)))
 			i--
 			dAtA[i] = 0xa
 		}
@@ -1458,7 +1817,7 @@ func (x *fastReflection_Input) ProtoMethods() *protoiface.Methods {
 		}, nil
 	}
 	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
-		x := input.Message.Interface().(*Input)
+		x := input.Message.Interface().(*SendEnabled)
 		if x == nil {
 			return protoiface.UnmarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -1490,15 +1849,15 @@ func (x *fastReflection_Input) ProtoMethods() *protoiface.Methods {
 			fieldNum := int32(wire >> 3)
 			wireType := int(wire & 0x7)
 			if wireType == 4 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: Input: wiretype end group for non-group")
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: SendEnabled: wiretype end group for non-group")
 			}
 			if fieldNum <= 0 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: Input: illegal tag %d (wire type %d)", fieldNum, wire)
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: SendEnabled: illegal tag %d (wire type %d)", fieldNum, wire)
 			}
 			switch fieldNum {
 			case 1:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
 				}
 				var stringLen uint64
 				for shift := uint(0); ; shift += 7 {
@@ -1526,13 +1885,13 @@ func (x *fastReflection_Input) ProtoMethods() *protoiface.Methods {
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				x.Address = string(dAtA[iNdEx:postIndex])
+				x.Denom = string(dAtA[iNdEx:postIndex])
 				iNdEx = postIndex
 			case 2:
-				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Coins", wireType)
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Enabled", wireType)
 				}
-				var msglen int
+				var v int
 				for shift := uint(0); ; shift += 7 {
 					if shift >= 64 {
 						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
@@ -1542,26 +1901,12 @@ func (x *fastReflection_Input) ProtoMethods() *protoiface.Methods {
 					}
 					b := dAtA[iNdEx]
 					iNdEx++
-					msglen |= int(b&0x7F) << shift
+					v |= int(b&0x7F) << shift
 					if b < 0x80 {
 						break
 					}
 				}
-				if msglen < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				postIndex := iNdEx + msglen
-				if postIndex < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				if postIndex > l {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-				}
-				x.Coins = append(x.Coins, &v1beta1.Coin{})
-				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Coins[len(x.Coins)-1]); err != nil {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
-				}
-				iNdEx = postIndex
+				x.Enabled = bool(v != 0)
 			default:
 				iNdEx = preIndex
 				skippy, err := runtime.Skip(dAtA[iNdEx:])
@@ -1597,79 +1942,79 @@ func (x *fastReflection_Input) ProtoMethods() *protoiface.Methods {
 	}
 }
 
-var _ protoreflect.List = (*_Output_2_list)(nil)
+var _ protoreflect.List = (*_Input_2_list)(nil)
 
-type _Output_2_list struct {
+type _Input_2_list struct {
 	list *[]*v1beta1.Coin
 }
 
-func (x *_Output_2_list) Len() int {
+func (x *_Input_2_list) Len() int {
 	if x.list == nil {
 		return 0
 	}
 	return len(*x.list)
 }
 
-func (x *_Output_2_list) Get(i int) protoreflect.Value {
+func (x *_Input_2_list) Get(i int) protoreflect.Value {
 	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
 }
 
-func (x *_Output_2_list) Set(i int, value protoreflect.Value) {
+func (x *_Input_2_list) Set(i int, value protoreflect.Value) {
 	valueUnwrapped := value.Message()
 	concreteValue := valueUnwrapped.Interface().(*v1beta1.Coin)
 	(*x.list)[i] = concreteValue
 }
 
-func (x *_Output_2_list) Append(value protoreflect.Value) {
+func (x *_Input_2_list) Append(value protoreflect.Value) {
 	valueUnwrapped := value.Message()
 	concreteValue := valueUnwrapped.Interface().(*v1beta1.Coin)
 	*x.list = append(*x.list, concreteValue)
 }
 
-func (x *_Output_2_list) AppendMutable() protoreflect.Value {
+func (x *_Input_2_list) AppendMutable() protoreflect.Value {
 	v := new(v1beta1.Coin)
 	*x.list = append(*x.list, v)
 	return protoreflect.ValueOfMessage(v.ProtoReflect())
 }
 
-func (x *_Output_2_list) Truncate(n int) {
+func (x *_Input_2_list) Truncate(n int) {
 	for i := n; i < len(*x.list); i++ {
 		(*x.list)[i] = nil
 	}
 	*x.list = (*x.list)[:n]
 }
 
-func (x *_Output_2_list) NewElement() protoreflect.Value {
+func (x *_Input_2_list) NewElement() protoreflect.Value {
 	v := new(v1beta1.Coin)
 	return protoreflect.ValueOfMessage(v.ProtoReflect())
 }
 
-func (x *_Output_2_list) IsValid() bool {
+func (x *_Input_2_list) IsValid() bool {
 	return x.list != nil
 }
 
 var (
-	md_Output         protoreflect.MessageDescriptor
-	fd_Output_address protoreflect.FieldDescriptor
-	fd_Output_coins   protoreflect.FieldDescriptor
+	md_Input         protoreflect.MessageDescriptor
+	fd_Input_address protoreflect.FieldDescriptor
+	fd_Input_coins   protoreflect.FieldDescriptor
 )
 
 func init() {
 	file_cosmos_bank_v1beta1_bank_proto_init()
-	md_Output = File_cosmos_bank_v1beta1_bank_proto.Messages().ByName("Output")
-	fd_Output_address = md_Output.Fields().ByName("address")
-	fd_Output_coins = md_Output.Fields().ByName("coins")
+	md_Input = File_cosmos_bank_v1beta1_bank_proto.Messages().ByName("Input")
+	fd_Input_address = md_Input.Fields().ByName("address")
+	fd_Input_coins = md_Input.Fields().ByName("coins")
 }
 
-var _ protoreflect.Message = (*fastReflection_Output)(nil)
+var _ protoreflect.Message = (*fastReflection_Input)(nil)
 
-type fastReflection_Output Output
+type fastReflection_Input Input
 
-func (x *Output) ProtoReflect() protoreflect.Message {
-	return (*fastReflection_Output)(x)
+func (x *Input) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_Input)(x)
 }
 
-func (x *Output) slowProtoReflect() protoreflect.Message {
+func (x *Input) slowProtoReflect() protoreflect.Message {
 	mi := &file_cosmos_bank_v1beta1_bank_proto_msgTypes[3]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1681,43 +2026,43 @@ func (x *Output) slowProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-var _fastReflection_Output_messageType fastReflection_Output_messageType
-var _ protoreflect.MessageType = fastReflection_Output_messageType{}
+var _fastReflection_Input_messageType fastReflection_Input_messageType
+var _ protoreflect.MessageType = fastReflection_Input_messageType{}
 
-type fastReflection_Output_messageType struct{}
+type fastReflection_Input_messageType struct{}
 
-func (x fastReflection_Output_messageType) Zero() protoreflect.Message {
-	return (*fastReflection_Output)(nil)
+func (x fastReflection_Input_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_Input)(nil)
 }
-func (x fastReflection_Output_messageType) New() protoreflect.Message {
-	return new(fastReflection_Output)
+func (x fastReflection_Input_messageType) New() protoreflect.Message {
+	return new(fastReflection_Input)
 }
-func (x fastReflection_Output_messageType) Descriptor() protoreflect.MessageDescriptor {
-	return md_Output
+func (x fastReflection_Input_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_Input
 }
 
 // Descriptor returns message descriptor, which contains only the protobuf
 // type information for the message.
-func (x *fastReflection_Output) Descriptor() protoreflect.MessageDescriptor {
-	return md_Output
+func (x *fastReflection_Input) Descriptor() protoreflect.MessageDescriptor {
+	return md_Input
 }
 
 // Type returns the message type, which encapsulates both Go and protobuf
 // type information. If the Go type information is not needed,
 // it is recommended that the message descriptor be used instead.
-func (x *fastReflection_Output) Type() protoreflect.MessageType {
-	return _fastReflection_Output_messageType
+func (x *fastReflection_Input) Type() protoreflect.MessageType {
+	return _fastReflection_Input_messageType
 }
 
 // New returns a newly allocated and mutable empty message.
-func (x *fastReflection_Output) New() protoreflect.Message {
-	return new(fastReflection_Output)
+func (x *fastReflection_Input) New() protoreflect.Message {
+	return new(fastReflection_Input)
 }
 
 // Interface unwraps the message reflection interface and
 // returns the underlying ProtoMessage interface.
-func (x *fastReflection_Output) Interface() protoreflect.ProtoMessage {
-	return (*Output)(x)
+func (x *fastReflection_Input) Interface() protoreflect.ProtoMessage {
+	return (*Input)(x)
 }
 
 // Range iterates over every populated field in an undefined order,
@@ -1725,16 +2070,16 @@ func (x *fastReflection_Output) Interface() protoreflect.ProtoMessage {
 // Range returns immediately if f returns false.
 // While iterating, mutating operations may only be performed
 // on the current field descriptor.
-func (x *fastReflection_Output) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+func (x *fastReflection_Input) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
 	if x.Address != "" {
 		value := protoreflect.ValueOfString(x.Address)
-		if !f(fd_Output_address, value) {
+		if !f(fd_Input_address, value) {
 			return
 		}
 	}
 	if len(x.Coins) != 0 {
-		value := protoreflect.ValueOfList(&_Output_2_list{list: &x.Coins})
-		if !f(fd_Output_coins, value) {
+		value := protoreflect.ValueOfList(&_Input_2_list{list: &x.Coins})
+		if !f(fd_Input_coins, value) {
 			return
 		}
 	}
@@ -1751,17 +2096,17 @@ func (x *fastReflection_Output) Range(f func(protoreflect.FieldDescriptor, proto
 // In other cases (aside from the nullable cases above),
 // a proto3 scalar field is populated if it contains a non-zero value, and
 // a repeated field is populated if it is non-empty.
-func (x *fastReflection_Output) Has(fd protoreflect.FieldDescriptor) bool {
+func (x *fastReflection_Input) Has(fd protoreflect.FieldDescriptor) bool {
 	switch fd.FullName() {
-	case "cosmos.bank.v1beta1.Output.address":
+	case "cosmos.bank.v1beta1.Input.address":
 		return x.Address != ""
-	case "cosmos.bank.v1beta1.Output.coins":
+	case "cosmos.bank.v1beta1.Input.coins":
 		return len(x.Coins) != 0
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.bank.v1beta1.Output"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.bank.v1beta1.Input"))
 		}
-		panic(fmt.Errorf("message cosmos.bank.v1beta1.Output does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.bank.v1beta1.Input does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -1771,17 +2116,17 @@ func (x *fastReflection_Output) Has(fd protoreflect.FieldDescriptor) bool {
 // associated with the given field number.
 //
 // Clear is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_Output) Clear(fd protoreflect.FieldDescriptor) {
+func (x *fastReflection_Input) Clear(fd protoreflect.FieldDescriptor) {
 	switch fd.FullName() {
-	case "cosmos.bank.v1beta1.Output.address":
+	case "cosmos.bank.v1beta1.Input.address":
 		x.Address = ""
-	case "cosmos.bank.v1beta1.Output.coins":
+	case "cosmos.bank.v1beta1.Input.coins":
 		x.Coins = nil
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.bank.v1beta1.Output"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.bank.v1beta1.Input"))
 		}
-		panic(fmt.Errorf("message cosmos.bank.v1beta1.Output does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.bank.v1beta1.Input does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -1791,22 +2136,22 @@ func (x *fastReflection_Output) Clear(fd protoreflect.FieldDescriptor) {
 // the default value of a bytes scalar is guaranteed to be a copy.
 // For unpopulated composite types, it returns an empty, read-only view
 // of the value; to obtain a mutable reference, use Mutable.
-func (x *fastReflection_Output) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_Input) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
 	switch descriptor.FullName() {
-	case "cosmos.bank.v1beta1.Output.address":
+	case "cosmos.bank.v1beta1.Input.address":
 		value := x.Address
 		return protoreflect.ValueOfString(value)
-	case "cosmos.bank.v1beta1.Output.coins":
+	case "cosmos.bank.v1beta1.Input.coins":
 		if len(x.Coins) == 0 {
-			return protoreflect.ValueOfList(&_Output_2_list{})
+			return protoreflect.ValueOfList(&_Input_2_list{})
 		}
-		listValue := &_Output_2_list{list: &x.Coins}
+		listValue := &_Input_2_list{list: &x.Coins}
 		return protoreflect.ValueOfList(listValue)
 	default:
 		if descriptor.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.bank.v1beta1.Output"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.bank.v1beta1.Input"))
 		}
-		panic(fmt.Errorf("message cosmos.bank.v1beta1.Output does not contain field %s", descriptor.FullName()))
+		panic(fmt.Errorf("message cosmos.bank.v1beta1.Input does not contain field %s", descriptor.FullName()))
 	}
 }
 
@@ -1820,19 +2165,19 @@ func (x *fastReflection_Output) Get(descriptor protoreflect.FieldDescriptor) pro
 // empty, read-only value, then it panics.
 //
 // Set is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_Output) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+func (x *fastReflection_Input) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
 	switch fd.FullName() {
-	case "cosmos.bank.v1beta1.Output.address":
+	case "cosmos.bank.v1beta1.Input.address":
 		x.Address = value.Interface().(string)
-	case "cosmos.bank.v1beta1.Output.coins":
+	case "cosmos.bank.v1beta1.Input.coins":
 		lv := value.List()
-		clv := lv.(*_Output_2_list)
+		clv := lv.(*_Input_2_list)
 		x.Coins = *clv.list
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.bank.v1beta1.Output"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.bank.v1beta1.Input"))
 		}
-		panic(fmt.Errorf("message cosmos.bank.v1beta1.Output does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.bank.v1beta1.Input does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -1846,49 +2191,49 @@ func (x *fastReflection_Output) Set(fd protoreflect.FieldDescriptor, value proto
 // It panics if the field does not contain a composite type.
 //
 // Mutable is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_Output) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_Input) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "cosmos.bank.v1beta1.Output.coins":
+	case "cosmos.bank.v1beta1.Input.coins":
 		if x.Coins == nil {
 			x.Coins = []*v1beta1.Coin{}
 		}
-		value := &_Output_2_list{list: &x.Coins}
+		value := &_Input_2_list{list: &x.Coins}
 		return protoreflect.ValueOfList(value)
-	case "cosmos.bank.v1beta1.Output.address":
-		panic(fmt.Errorf("field address of message cosmos.bank.v1beta1.Output is not mutable"))
+	case "cosmos.bank.v1beta1.Input.address":
+		panic(fmt.Errorf("field address of message cosmos.bank.v1beta1.Input is not mutable"))
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.bank.v1beta1.Output"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.bank.v1beta1.Input"))
 		}
-		panic(fmt.Errorf("message cosmos.bank.v1beta1.Output does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.bank.v1beta1.Input does not contain field %s", fd.FullName()))
 	}
 }
 
 // NewField returns a new value that is assignable to the field
 // for the given descriptor. For scalars, this returns the default value.
 // For lists, maps, and messages, this returns a new, empty, mutable value.
-func (x *fastReflection_Output) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_Input) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "cosmos.bank.v1beta1.Output.address":
+	case "cosmos.bank.v1beta1.Input.address":
 		return protoreflect.ValueOfString("")
-	case "cosmos.bank.v1beta1.Output.coins":
+	case "cosmos.bank.v1beta1.Input.coins":
 		list := []*v1beta1.Coin{}
-		return protoreflect.ValueOfList(&_Output_2_list{list: &list})
+		return protoreflect.ValueOfList(&_Input_2_list{list: &list})
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.bank.v1beta1.Output"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.bank.v1beta1.Input"))
 		}
-		panic(fmt.Errorf("message cosmos.bank.v1beta1.Output does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.bank.v1beta1.Input does not contain field %s", fd.FullName()))
 	}
 }
 
 // WhichOneof reports which field within the oneof is populated,
 // returning nil if none are populated.
 // It panics if the oneof descriptor does not belong to this message.
-func (x *fastReflection_Output) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+func (x *fastReflection_Input) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
 	switch d.FullName() {
 	default:
-		panic(fmt.Errorf("%s is not a oneof field in cosmos.bank.v1beta1.Output", d.FullName()))
+		panic(fmt.Errorf("%s is not a oneof field in cosmos.bank.v1beta1.Input", d.FullName()))
 	}
 	panic("unreachable")
 }
@@ -1896,7 +2241,7 @@ func (x *fastReflection_Output) WhichOneof(d protoreflect.OneofDescriptor) proto
 // GetUnknown retrieves the entire list of unknown fields.
 // The caller may only mutate the contents of the RawFields
 // if the mutated bytes are stored back into the message with SetUnknown.
-func (x *fastReflection_Output) GetUnknown() protoreflect.RawFields {
+func (x *fastReflection_Input) GetUnknown() protoreflect.RawFields {
 	return x.unknownFields
 }
 
@@ -1907,7 +2252,7 @@ func (x *fastReflection_Output) GetUnknown() protoreflect.RawFields {
 // An empty RawFields may be passed to clear the fields.
 //
 // SetUnknown is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_Output) SetUnknown(fields protoreflect.RawFields) {
+func (x *fastReflection_Input) SetUnknown(fields protoreflect.RawFields) {
 	x.unknownFields = fields
 }
 
@@ -1919,7 +2264,7 @@ func (x *fastReflection_Output) SetUnknown(fields protoreflect.RawFields) {
 // message type, but the details are implementation dependent.
 // Validity is not part of the protobuf data model, and may not
 // be preserved in marshaling or other operations.
-func (x *fastReflection_Output) IsValid() bool {
+func (x *fastReflection_Input) IsValid() bool {
 	return x != nil
 }
 
@@ -1929,9 +2274,9 @@ func (x *fastReflection_Output) IsValid() bool {
 // The returned methods type is identical to
 // "google.golang.org/protobuf/runtime/protoiface".Methods.
 // Consult the protoiface package documentation for details.
-func (x *fastReflection_Output) ProtoMethods() *protoiface.Methods {
+func (x *fastReflection_Input) ProtoMethods() *protoiface.Methods {
 	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
-		x := input.Message.Interface().(*Output)
+		x := input.Message.Interface().(*Input)
 		if x == nil {
 			return protoiface.SizeOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -1963,7 +2308,7 @@ func (x *fastReflection_Output) ProtoMethods() *protoiface.Methods {
 	}
 
 	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
-		x := input.Message.Interface().(*Output)
+		x := input.Message.Interface().(*Input)
 		if x == nil {
 			return protoiface.MarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -2016,7 +2361,7 @@ func (x *fastReflection_Output) ProtoMethods() *protoiface.Methods {
 		}, nil
 	}
 	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
-		x := input.Message.Interface().(*Output)
+		x := input.Message.Interface().(*Input)
 		if x == nil {
 			return protoiface.UnmarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -2048,10 +2393,10 @@ func (x *fastReflection_Output) ProtoMethods() *protoiface.Methods {
 			fieldNum := int32(wire >> 3)
 			wireType := int(wire & 0x7)
 			if wireType == 4 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: Output: wiretype end group for non-group")
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: Input: wiretype end group for non-group")
 			}
 			if fieldNum <= 0 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: Output: illegal tag %d (wire type %d)", fieldNum, wire)
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: Input: illegal tag %d (wire type %d)", fieldNum, wire)
 			}
 			switch fieldNum {
 			case 1:
@@ -2155,77 +2500,79 @@ func (x *fastReflection_Output) ProtoMethods() *protoiface.Methods {
 	}
 }
 
-var _ protoreflect.List = (*_Supply_1_list)(nil)
+var _ protoreflect.List = (*_Output_2_list)(nil)
 
-type _Supply_1_list struct {
+type _Output_2_list struct {
 	list *[]*v1beta1.Coin
 }
 
-func (x *_Supply_1_list) Len() int {
+func (x *_Output_2_list) Len() int {
 	if x.list == nil {
 		return 0
 	}
 	return len(*x.list)
 }
 
-func (x *_Supply_1_list) Get(i int) protoreflect.Value {
+func (x *_Output_2_list) Get(i int) protoreflect.Value {
 	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
 }
 
-func (x *_Supply_1_list) Set(i int, value protoreflect.Value) {
+func (x *_Output_2_list) Set(i int, value protoreflect.Value) {
 	valueUnwrapped := value.Message()
 	concreteValue := valueUnwrapped.Interface().(*v1beta1.Coin)
 	(*x.list)[i] = concreteValue
 }
 
-func (x *_Supply_1_list) Append(value protoreflect.Value) {
+func (x *_Output_2_list) Append(value protoreflect.Value) {
 	valueUnwrapped := value.Message()
 	concreteValue := valueUnwrapped.Interface().(*v1beta1.Coin)
 	*x.list = append(*x.list, concreteValue)
 }
 
-func (x *_Supply_1_list) AppendMutable() protoreflect.Value {
+func (x *_Output_2_list) AppendMutable() protoreflect.Value {
 	v := new(v1beta1.Coin)
 	*x.list = append(*x.list, v)
 	return protoreflect.ValueOfMessage(v.ProtoReflect())
 }
 
-func (x *_Supply_1_list) Truncate(n int) {
+func (x *_Output_2_list) Truncate(n int) {
 	for i := n; i < len(*x.list); i++ {
 		(*x.list)[i] = nil
 	}
 	*x.list = (*x.list)[:n]
 }
 
-func (x *_Supply_1_list) NewElement() protoreflect.Value {
+func (x *_Output_2_list) NewElement() protoreflect.Value {
 	v := new(v1beta1.Coin)
 	return protoreflect.ValueOfMessage(v.ProtoReflect())
 }
 
-func (x *_Supply_1_list) IsValid() bool {
+func (x *_Output_2_list) IsValid() bool {
 	return x.list != nil
 }
 
 var (
-	md_Supply       protoreflect.MessageDescriptor
-	fd_Supply_total protoreflect.FieldDescriptor
+	md_Output         protoreflect.MessageDescriptor
+	fd_Output_address protoreflect.FieldDescriptor
+	fd_Output_coins   protoreflect.FieldDescriptor
 )
 
 func init() {
 	file_cosmos_bank_v1beta1_bank_proto_init()
-	md_Supply = File_cosmos_bank_v1beta1_bank_proto.Messages().ByName("Supply")
-	fd_Supply_total = md_Supply.Fields().ByName("total")
+	md_Output = File_cosmos_bank_v1beta1_bank_proto.Messages().ByName("Output")
+	fd_Output_address = md_Output.Fields().ByName("address")
+	fd_Output_coins = md_Output.Fields().ByName("coins")
 }
 
-var _ protoreflect.Message = (*fastReflection_Supply)(nil)
+var _ protoreflect.Message = (*fastReflection_Output)(nil)
 
-type fastReflection_Supply Supply
+type fastReflection_Output Output
 
-func (x *Supply) ProtoReflect() protoreflect.Message {
-	return (*fastReflection_Supply)(x)
+func (x *Output) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_Output)(x)
 }
 
-func (x *Supply) slowProtoReflect() protoreflect.Message {
+func (x *Output) slowProtoReflect() protoreflect.Message {
 	mi := &file_cosmos_bank_v1beta1_bank_proto_msgTypes[4]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2237,43 +2584,43 @@ func (x *Supply) slowProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-var _fastReflection_Supply_messageType fastReflection_Supply_messageType
-var _ protoreflect.MessageType = fastReflection_Supply_messageType{}
+var _fastReflection_Output_messageType fastReflection_Output_messageType
+var _ protoreflect.MessageType = fastReflection_Output_messageType{}
 
-type fastReflection_Supply_messageType struct{}
+type fastReflection_Output_messageType struct{}
 
-func (x fastReflection_Supply_messageType) Zero() protoreflect.Message {
-	return (*fastReflection_Supply)(nil)
+func (x fastReflection_Output_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_Output)(nil)
 }
-func (x fastReflection_Supply_messageType) New() protoreflect.Message {
-	return new(fastReflection_Supply)
+func (x fastReflection_Output_messageType) New() protoreflect.Message {
+	return new(fastReflection_Output)
 }
-func (x fastReflection_Supply_messageType) Descriptor() protoreflect.MessageDescriptor {
-	return md_Supply
+func (x fastReflection_Output_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_Output
 }
 
 // Descriptor returns message descriptor, which contains only the protobuf
 // type information for the message.
-func (x *fastReflection_Supply) Descriptor() protoreflect.MessageDescriptor {
-	return md_Supply
+func (x *fastReflection_Output) Descriptor() protoreflect.MessageDescriptor {
+	return md_Output
 }
 
 // Type returns the message type, which encapsulates both Go and protobuf
 // type information. If the Go type information is not needed,
 // it is recommended that the message descriptor be used instead.
-func (x *fastReflection_Supply) Type() protoreflect.MessageType {
-	return _fastReflection_Supply_messageType
+func (x *fastReflection_Output) Type() protoreflect.MessageType {
+	return _fastReflection_Output_messageType
 }
 
 // New returns a newly allocated and mutable empty message.
-func (x *fastReflection_Supply) New() protoreflect.Message {
-	return new(fastReflection_Supply)
+func (x *fastReflection_Output) New() protoreflect.Message {
+	return new(fastReflection_Output)
 }
 
 // Interface unwraps the message reflection interface and
 // returns the underlying ProtoMessage interface.
-func (x *fastReflection_Supply) Interface() protoreflect.ProtoMessage {
-	return (*Supply)(x)
+func (x *fastReflection_Output) Interface() protoreflect.ProtoMessage {
+	return (*Output)(x)
 }
 
 // Range iterates over every populated field in an undefined order,
@@ -2281,10 +2628,16 @@ func (x *fastReflection_Supply) Interface() protoreflect.ProtoMessage {
 // Range returns immediately if f returns false.
 // While iterating, mutating operations may only be performed
 // on the current field descriptor.
-func (x *fastReflection_Supply) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
-	if len(x.Total) != 0 {
-		value := protoreflect.ValueOfList(&_Supply_1_list{list: &x.Total})
-		if !f(fd_Supply_total, value) {
+func (x *fastReflection_Output) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Address != "" {
+		value := protoreflect.ValueOfString(x.Address)
+		if !f(fd_Output_address, value) {
+			return
+		}
+	}
+	if len(x.Coins) != 0 {
+		value := protoreflect.ValueOfList(&_Output_2_list{list: &x.Coins})
+		if !f(fd_Output_coins, value) {
 			return
 		}
 	}
@@ -2301,15 +2654,17 @@ func (x *fastReflection_Supply) Range(f func(protoreflect.FieldDescriptor, proto
 // In other cases (aside from the nullable cases above),
 // a proto3 scalar field is populated if it contains a non-zero value, and
 // a repeated field is populated if it is non-empty.
-func (x *fastReflection_Supply) Has(fd protoreflect.FieldDescriptor) bool {
+func (x *fastReflection_Output) Has(fd protoreflect.FieldDescriptor) bool {
 	switch fd.FullName() {
-	case "cosmos.bank.v1beta1.Supply.total":
-		return len(x.Total) != 0
+	case "cosmos.bank.v1beta1.Output.address":
+		return x.Address != ""
+	case "cosmos.bank.v1beta1.Output.coins":
+		return len(x.Coins) != 0
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.bank.v1beta1.Supply"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.bank.v1beta1.Output"))
 		}
-		panic(fmt.Errorf("message cosmos.bank.v1beta1.Supply does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.bank.v1beta1.Output does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -2319,15 +2674,17 @@ func (x *fastReflection_Supply) Has(fd protoreflect.FieldDescriptor) bool {
 // associated with the given field number.
 //
 // Clear is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_Supply) Clear(fd protoreflect.FieldDescriptor) {
+func (x *fastReflection_Output) Clear(fd protoreflect.FieldDescriptor) {
 	switch fd.FullName() {
-	case "cosmos.bank.v1beta1.Supply.total":
-		x.Total = nil
+	case "cosmos.bank.v1beta1.Output.address":
+		x.Address = ""
+	case "cosmos.bank.v1beta1.Output.coins":
+		x.Coins = nil
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.bank.v1beta1.Supply"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.bank.v1beta1.Output"))
 		}
-		panic(fmt.Errorf("message cosmos.bank.v1beta1.Supply does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.bank.v1beta1.Output does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -2337,19 +2694,22 @@ func (x *fastReflection_Supply) Clear(fd protoreflect.FieldDescriptor) {
 // the default value of a bytes scalar is guaranteed to be a copy.
 // For unpopulated composite types, it returns an empty, read-only view
 // of the value; to obtain a mutable reference, use Mutable.
-func (x *fastReflection_Supply) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_Output) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
 	switch descriptor.FullName() {
-	case "cosmos.bank.v1beta1.Supply.total":
-		if len(x.Total) == 0 {
-			return protoreflect.ValueOfList(&_Supply_1_list{})
+	case "cosmos.bank.v1beta1.Output.address":
+		value := x.Address
+		return protoreflect.ValueOfString(value)
+	case "cosmos.bank.v1beta1.Output.coins":
+		if len(x.Coins) == 0 {
+			return protoreflect.ValueOfList(&_Output_2_list{})
 		}
-		listValue := &_Supply_1_list{list: &x.Total}
+		listValue := &_Output_2_list{list: &x.Coins}
 		return protoreflect.ValueOfList(listValue)
 	default:
 		if descriptor.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.bank.v1beta1.Supply"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.bank.v1beta1.Output"))
 		}
-		panic(fmt.Errorf("message cosmos.bank.v1beta1.Supply does not contain field %s", descriptor.FullName()))
+		panic(fmt.Errorf("message cosmos.bank.v1beta1.Output does not contain field %s", descriptor.FullName()))
 	}
 }
 
@@ -2363,17 +2723,19 @@ func (x *fastReflection_Supply) Get(descriptor protoreflect.FieldDescriptor) pro
 // empty, read-only value, then it panics.
 //
 // Set is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_Supply) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+func (x *fastReflection_Output) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
 	switch fd.FullName() {
-	case "cosmos.bank.v1beta1.Supply.total":
+	case "cosmos.bank.v1beta1.Output.address":
+		x.Address = value.Interface().(string)
+	case "cosmos.bank.v1beta1.Output.coins":
 		lv := value.List()
-		clv := lv.(*_Supply_1_list)
-		x.Total = *clv.list
+		clv := lv.(*_Output_2_list)
+		x.Coins = *clv.list
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.bank.v1beta1.Supply"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.bank.v1beta1.Output"))
 		}
-		panic(fmt.Errorf("message cosmos.bank.v1beta1.Supply does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.bank.v1beta1.Output does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -2387,45 +2749,49 @@ func (x *fastReflection_Supply) Set(fd protoreflect.FieldDescriptor, value proto
 // It panics if the field does not contain a composite type.
 //
 // Mutable is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_Supply) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_Output) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "cosmos.bank.v1beta1.Supply.total":
-		if x.Total == nil {
-			x.Total = []*v1beta1.Coin{}
+	case "cosmos.bank.v1beta1.Output.coins":
+		if x.Coins == nil {
+			x.Coins = []*v1beta1.Coin{}
 		}
-		value := &_Supply_1_list{list: &x.Total}
+		value := &_Output_2_list{list: &x.Coins}
 		return protoreflect.ValueOfList(value)
+	case "cosmos.bank.v1beta1.Output.address":
+		panic(fmt.Errorf("field address of message cosmos.bank.v1beta1.Output is not mutable"))
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.bank.v1beta1.Supply"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.bank.v1beta1.Output"))
 		}
-		panic(fmt.Errorf("message cosmos.bank.v1beta1.Supply does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.bank.v1beta1.Output does not contain field %s", fd.FullName()))
 	}
 }
 
 // NewField returns a new value that is assignable to the field
 // for the given descriptor. For scalars, this returns the default value.
 // For lists, maps, and messages, this returns a new, empty, mutable value.
-func (x *fastReflection_Supply) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_Output) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "cosmos.bank.v1beta1.Supply.total":
+	case "cosmos.bank.v1beta1.Output.address":
+		return protoreflect.ValueOfString("")
+	case "cosmos.bank.v1beta1.Output.coins":
 		list := []*v1beta1.Coin{}
-		return protoreflect.ValueOfList(&_Supply_1_list{list: &list})
+		return protoreflect.ValueOfList(&_Output_2_list{list: &list})
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.bank.v1beta1.Supply"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.bank.v1beta1.Output"))
 		}
-		panic(fmt.Errorf("message cosmos.bank.v1beta1.Supply does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.bank.v1beta1.Output does not contain field %s", fd.FullName()))
 	}
 }
 
 // WhichOneof reports which field within the oneof is populated,
 // returning nil if none are populated.
 // It panics if the oneof descriptor does not belong to this message.
-func (x *fastReflection_Supply) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+func (x *fastReflection_Output) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
 	switch d.FullName() {
 	default:
-		panic(fmt.Errorf("%s is not a oneof field in cosmos.bank.v1beta1.Supply", d.FullName()))
+		panic(fmt.Errorf("%s is not a oneof field in cosmos.bank.v1beta1.Output", d.FullName()))
 	}
 	panic("unreachable")
 }
@@ -2433,7 +2799,7 @@ func (x *fastReflection_Supply) WhichOneof(d protoreflect.OneofDescriptor) proto
 // GetUnknown retrieves the entire list of unknown fields.
 // The caller may only mutate the contents of the RawFields
 // if the mutated bytes are stored back into the message with SetUnknown.
-func (x *fastReflection_Supply) GetUnknown() protoreflect.RawFields {
+func (x *fastReflection_Output) GetUnknown() protoreflect.RawFields {
 	return x.unknownFields
 }
 
@@ -2444,7 +2810,7 @@ func (x *fastReflection_Supply) GetUnknown() protoreflect.RawFields {
 // An empty RawFields may be passed to clear the fields.
 //
 // SetUnknown is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_Supply) SetUnknown(fields protoreflect.RawFields) {
+func (x *fastReflection_Output) SetUnknown(fields protoreflect.RawFields) {
 	x.unknownFields = fields
 }
 
@@ -2456,7 +2822,7 @@ func (x *fastReflection_Supply) SetUnknown(fields protoreflect.RawFields) {
 // message type, but the details are implementation dependent.
 // Validity is not part of the protobuf data model, and may not
 // be preserved in marshaling or other operations.
-func (x *fastReflection_Supply) IsValid() bool {
+func (x *fastReflection_Output) IsValid() bool {
 	return x != nil
 }
 
@@ -2466,9 +2832,9 @@ func (x *fastReflection_Supply) IsValid() bool {
 // The returned methods type is identical to
 // "google.golang.org/protobuf/runtime/protoiface".Methods.
 // Consult the protoiface package documentation for details.
-func (x *fastReflection_Supply) ProtoMethods() *protoiface.Methods {
+func (x *fastReflection_Output) ProtoMethods() *protoiface.Methods {
 	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
-		x := input.Message.Interface().(*Supply)
+		x := input.Message.Interface().(*Output)
 		if x == nil {
 			return protoiface.SizeOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -2480,8 +2846,12 @@ func (x *fastReflection_Supply) ProtoMethods() *protoiface.Methods {
 		var n int
 		var l int
 		_ = l
-		if len(x.Total) > 0 {
-			for _, e := range x.Total {
+		l = len(x.Address)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if len(x.Coins) > 0 {
+			for _, e := range x.Coins {
 				l = options.Size(e)
 				n += 1 + l + runtime.Sov(uint64(l))
 			}
@@ -2496,7 +2866,7 @@ func (x *fastReflection_Supply) ProtoMethods() *protoiface.Methods {
 	}
 
 	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
-		x := input.Message.Interface().(*Supply)
+		x := input.Message.Interface().(*Output)
 		if x == nil {
 			return protoiface.MarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -2515,9 +2885,9 @@ func (x *fastReflection_Supply) ProtoMethods() *protoiface.Methods {
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
-		if len(x.Total) > 0 {
-			for iNdEx := len(x.Total) - 1; iNdEx >= 0; iNdEx-- {
-				encoded, err := options.Marshal(x.Total[iNdEx])
+		if len(x.Coins) > 0 {
+			for iNdEx := len(x.Coins) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.Coins[iNdEx])
 				if err != nil {
 					return protoiface.MarshalOutput{
 						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -2528,9 +2898,16 @@ func (x *fastReflection_Supply) ProtoMethods() *protoiface.Methods {
 				copy(dAtA[i:], encoded)
 				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
 				i--
-				dAtA[i] = 0xa
+				dAtA[i] = 0x12
 			}
 		}
+		if len(x.Address) > 0 {
+			i -= len(x.Address)
+			copy(dAtA[i:], x.Address)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Address)))
+			i--
+			dAtA[i] = 0xa
+		}
 		if input.Buf != nil {
 			input.Buf = append(input.Buf, dAtA...)
 		} else {
@@ -2542,7 +2919,7 @@ func (x *fastReflection_Supply) ProtoMethods() *protoiface.Methods {
 		}, nil
 	}
 	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
-		x := input.Message.Interface().(*Supply)
+		x := input.Message.Interface().(*Output)
 		if x == nil {
 			return protoiface.UnmarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -2574,15 +2951,47 @@ func (x *fastReflection_Supply) ProtoMethods() *protoiface.Methods {
 			fieldNum := int32(wire >> 3)
 			wireType := int(wire & 0x7)
 			if wireType == 4 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: Supply: wiretype end group for non-group")
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: Output: wiretype end group for non-group")
 			}
 			if fieldNum <= 0 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: Supply: illegal tag %d (wire type %d)", fieldNum, wire)
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: Output: illegal tag %d (wire type %d)", fieldNum, wire)
 			}
 			switch fieldNum {
 			case 1:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Total", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Address = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Coins", wireType)
 				}
 				var msglen int
 				for shift := uint(0); ; shift += 7 {
@@ -2609,8 +3018,8 @@ func (x *fastReflection_Supply) ProtoMethods() *protoiface.Methods {
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				x.Total = append(x.Total, &v1beta1.Coin{})
-				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Total[len(x.Total)-1]); err != nil {
+				x.Coins = append(x.Coins, &v1beta1.Coin{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Coins[len(x.Coins)-1]); err != nil {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
 				}
 				iNdEx = postIndex
@@ -2649,76 +3058,81 @@ func (x *fastReflection_Supply) ProtoMethods() *protoiface.Methods {
 	}
 }
 
-var _ protoreflect.List = (*_DenomUnit_3_list)(nil)
+var _ protoreflect.List = (*_BatchOutput_2_list)(nil)
 
-type _DenomUnit_3_list struct {
-	list *[]string
+type _BatchOutput_2_list struct {
+	list *[]*v1beta1.Coin
 }
 
-func (x *_DenomUnit_3_list) Len() int {
+func (x *_BatchOutput_2_list) Len() int {
 	if x.list == nil {
 		return 0
 	}
 	return len(*x.list)
 }
 
-func (x *_DenomUnit_3_list) Get(i int) protoreflect.Value {
-	return protoreflect.ValueOfString((*x.list)[i])
+func (x *_BatchOutput_2_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
 }
 
-func (x *_DenomUnit_3_list) Set(i int, value protoreflect.Value) {
-	valueUnwrapped := value.String()
-	concreteValue := valueUnwrapped
+func (x *_BatchOutput_2_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*v1beta1.Coin)
 	(*x.list)[i] = concreteValue
 }
 
-func (x *_DenomUnit_3_list) Append(value protoreflect.Value) {
-	valueUnwrapped := value.String()
-	concreteValue := valueUnwrapped
+func (x *_BatchOutput_2_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*v1beta1.Coin)
 	*x.list = append(*x.list, concreteValue)
 }
 
-func (x *_DenomUnit_3_list) AppendMutable() protoreflect.Value {
-	panic(fmt.Errorf("AppendMutable can not be called on message DenomUnit at list field Aliases as it is not of Message kind"))
+func (x *_BatchOutput_2_list) AppendMutable() protoreflect.Value {
+	v := new(v1beta1.Coin)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
 }
 
-func (x *_DenomUnit_3_list) Truncate(n int) {
+func (x *_BatchOutput_2_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
 	*x.list = (*x.list)[:n]
 }
 
-func (x *_DenomUnit_3_list) NewElement() protoreflect.Value {
-	v := ""
-	return protoreflect.ValueOfString(v)
+func (x *_BatchOutput_2_list) NewElement() protoreflect.Value {
+	v := new(v1beta1.Coin)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
 }
 
-func (x *_DenomUnit_3_list) IsValid() bool {
+func (x *_BatchOutput_2_list) IsValid() bool {
 	return x.list != nil
 }
 
 var (
-	md_DenomUnit          protoreflect.MessageDescriptor
-	fd_DenomUnit_denom    protoreflect.FieldDescriptor
-	fd_DenomUnit_exponent protoreflect.FieldDescriptor
-	fd_DenomUnit_aliases  protoreflect.FieldDescriptor
+	md_BatchOutput         protoreflect.MessageDescriptor
+	fd_BatchOutput_address protoreflect.FieldDescriptor
+	fd_BatchOutput_coins   protoreflect.FieldDescriptor
+	fd_BatchOutput_memo    protoreflect.FieldDescriptor
 )
 
 func init() {
 	file_cosmos_bank_v1beta1_bank_proto_init()
-	md_DenomUnit = File_cosmos_bank_v1beta1_bank_proto.Messages().ByName("DenomUnit")
-	fd_DenomUnit_denom = md_DenomUnit.Fields().ByName("denom")
-	fd_DenomUnit_exponent = md_DenomUnit.Fields().ByName("exponent")
-	fd_DenomUnit_aliases = md_DenomUnit.Fields().ByName("aliases")
+	md_BatchOutput = File_cosmos_bank_v1beta1_bank_proto.Messages().ByName("BatchOutput")
+	fd_BatchOutput_address = md_BatchOutput.Fields().ByName("address")
+	fd_BatchOutput_coins = md_BatchOutput.Fields().ByName("coins")
+	fd_BatchOutput_memo = md_BatchOutput.Fields().ByName("memo")
 }
 
-var _ protoreflect.Message = (*fastReflection_DenomUnit)(nil)
+var _ protoreflect.Message = (*fastReflection_BatchOutput)(nil)
 
-type fastReflection_DenomUnit DenomUnit
+type fastReflection_BatchOutput BatchOutput
 
-func (x *DenomUnit) ProtoReflect() protoreflect.Message {
-	return (*fastReflection_DenomUnit)(x)
+func (x *BatchOutput) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_BatchOutput)(x)
 }
 
-func (x *DenomUnit) slowProtoReflect() protoreflect.Message {
+func (x *BatchOutput) slowProtoReflect() protoreflect.Message {
 	mi := &file_cosmos_bank_v1beta1_bank_proto_msgTypes[5]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2730,43 +3144,43 @@ func (x *DenomUnit) slowProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-var _fastReflection_DenomUnit_messageType fastReflection_DenomUnit_messageType
-var _ protoreflect.MessageType = fastReflection_DenomUnit_messageType{}
+var _fastReflection_BatchOutput_messageType fastReflection_BatchOutput_messageType
+var _ protoreflect.MessageType = fastReflection_BatchOutput_messageType{}
 
-type fastReflection_DenomUnit_messageType struct{}
+type fastReflection_BatchOutput_messageType struct{}
 
-func (x fastReflection_DenomUnit_messageType) Zero() protoreflect.Message {
-	return (*fastReflection_DenomUnit)(nil)
+func (x fastReflection_BatchOutput_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_BatchOutput)(nil)
 }
-func (x fastReflection_DenomUnit_messageType) New() protoreflect.Message {
-	return new(fastReflection_DenomUnit)
+func (x fastReflection_BatchOutput_messageType) New() protoreflect.Message {
+	return new(fastReflection_BatchOutput)
 }
-func (x fastReflection_DenomUnit_messageType) Descriptor() protoreflect.MessageDescriptor {
-	return md_DenomUnit
+func (x fastReflection_BatchOutput_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_BatchOutput
 }
 
 // Descriptor returns message descriptor, which contains only the protobuf
 // type information for the message.
-func (x *fastReflection_DenomUnit) Descriptor() protoreflect.MessageDescriptor {
-	return md_DenomUnit
+func (x *fastReflection_BatchOutput) Descriptor() protoreflect.MessageDescriptor {
+	return md_BatchOutput
 }
 
 // Type returns the message type, which encapsulates both Go and protobuf
 // type information. If the Go type information is not needed,
 // it is recommended that the message descriptor be used instead.
-func (x *fastReflection_DenomUnit) Type() protoreflect.MessageType {
-	return _fastReflection_DenomUnit_messageType
+func (x *fastReflection_BatchOutput) Type() protoreflect.MessageType {
+	return _fastReflection_BatchOutput_messageType
 }
 
 // New returns a newly allocated and mutable empty message.
-func (x *fastReflection_DenomUnit) New() protoreflect.Message {
-	return new(fastReflection_DenomUnit)
+func (x *fastReflection_BatchOutput) New() protoreflect.Message {
+	return new(fastReflection_BatchOutput)
 }
 
 // Interface unwraps the message reflection interface and
 // returns the underlying ProtoMessage interface.
-func (x *fastReflection_DenomUnit) Interface() protoreflect.ProtoMessage {
-	return (*DenomUnit)(x)
+func (x *fastReflection_BatchOutput) Interface() protoreflect.ProtoMessage {
+	return (*BatchOutput)(x)
 }
 
 // Range iterates over every populated field in an undefined order,
@@ -2774,22 +3188,22 @@ func (x *fastReflection_DenomUnit) Interface() protoreflect.ProtoMessage {
 // Range returns immediately if f returns false.
 // While iterating, mutating operations may only be performed
 // on the current field descriptor.
-func (x *fastReflection_DenomUnit) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
-	if x.Denom != "" {
-		value := protoreflect.ValueOfString(x.Denom)
-		if !f(fd_DenomUnit_denom, value) {
+func (x *fastReflection_BatchOutput) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Address != "" {
+		value := protoreflect.ValueOfString(x.Address)
+		if !f(fd_BatchOutput_address, value) {
 			return
 		}
 	}
-	if x.Exponent != uint32(0) {
-		value := protoreflect.ValueOfUint32(x.Exponent)
-		if !f(fd_DenomUnit_exponent, value) {
+	if len(x.Coins) != 0 {
+		value := protoreflect.ValueOfList(&_BatchOutput_2_list{list: &x.Coins})
+		if !f(fd_BatchOutput_coins, value) {
 			return
 		}
 	}
-	if len(x.Aliases) != 0 {
-		value := protoreflect.ValueOfList(&_DenomUnit_3_list{list: &x.Aliases})
-		if !f(fd_DenomUnit_aliases, value) {
+	if x.Memo != "" {
+		value := protoreflect.ValueOfString(x.Memo)
+		if !f(fd_BatchOutput_memo, value) {
 			return
 		}
 	}
@@ -2806,19 +3220,19 @@ func (x *fastReflection_DenomUnit) Range(f func(protoreflect.FieldDescriptor, pr
 // In other cases (aside from the nullable cases above),
 // a proto3 scalar field is populated if it contains a non-zero value, and
 // a repeated field is populated if it is non-empty.
-func (x *fastReflection_DenomUnit) Has(fd protoreflect.FieldDescriptor) bool {
+func (x *fastReflection_BatchOutput) Has(fd protoreflect.FieldDescriptor) bool {
 	switch fd.FullName() {
-	case "cosmos.bank.v1beta1.DenomUnit.denom":
-		return x.Denom != ""
-	case "cosmos.bank.v1beta1.DenomUnit.exponent":
-		return x.Exponent != uint32(0)
-	case "cosmos.bank.v1beta1.DenomUnit.aliases":
-		return len(x.Aliases) != 0
+	case "cosmos.bank.v1beta1.BatchOutput.address":
+		return x.Address != ""
+	case "cosmos.bank.v1beta1.BatchOutput.coins":
+		return len(x.Coins) != 0
+	case "cosmos.bank.v1beta1.BatchOutput.memo":
+		return x.Memo != ""
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.bank.v1beta1.DenomUnit"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.bank.v1beta1.BatchOutput"))
 		}
-		panic(fmt.Errorf("message cosmos.bank.v1beta1.DenomUnit does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.bank.v1beta1.BatchOutput does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -2828,19 +3242,19 @@ func (x *fastReflection_DenomUnit) Has(fd protoreflect.FieldDescriptor) bool {
 // associated with the given field number.
 //
 // Clear is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_DenomUnit) Clear(fd protoreflect.FieldDescriptor) {
+func (x *fastReflection_BatchOutput) Clear(fd protoreflect.FieldDescriptor) {
 	switch fd.FullName() {
-	case "cosmos.bank.v1beta1.DenomUnit.denom":
-		x.Denom = ""
-	case "cosmos.bank.v1beta1.DenomUnit.exponent":
-		x.Exponent = uint32(0)
-	case "cosmos.bank.v1beta1.DenomUnit.aliases":
-		x.Aliases = nil
+	case "cosmos.bank.v1beta1.BatchOutput.address":
+		x.Address = ""
+	case "cosmos.bank.v1beta1.BatchOutput.coins":
+		x.Coins = nil
+	case "cosmos.bank.v1beta1.BatchOutput.memo":
+		x.Memo = ""
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.bank.v1beta1.DenomUnit"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.bank.v1beta1.BatchOutput"))
 		}
-		panic(fmt.Errorf("message cosmos.bank.v1beta1.DenomUnit does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.bank.v1beta1.BatchOutput does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -2850,25 +3264,25 @@ func (x *fastReflection_DenomUnit) Clear(fd protoreflect.FieldDescriptor) {
 // the default value of a bytes scalar is guaranteed to be a copy.
 // For unpopulated composite types, it returns an empty, read-only view
 // of the value; to obtain a mutable reference, use Mutable.
-func (x *fastReflection_DenomUnit) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_BatchOutput) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
 	switch descriptor.FullName() {
-	case "cosmos.bank.v1beta1.DenomUnit.denom":
-		value := x.Denom
+	case "cosmos.bank.v1beta1.BatchOutput.address":
+		value := x.Address
 		return protoreflect.ValueOfString(value)
-	case "cosmos.bank.v1beta1.DenomUnit.exponent":
-		value := x.Exponent
-		return protoreflect.ValueOfUint32(value)
-	case "cosmos.bank.v1beta1.DenomUnit.aliases":
-		if len(x.Aliases) == 0 {
-			return protoreflect.ValueOfList(&_DenomUnit_3_list{})
+	case "cosmos.bank.v1beta1.BatchOutput.coins":
+		if len(x.Coins) == 0 {
+			return protoreflect.ValueOfList(&_BatchOutput_2_list{})
 		}
-		listValue := &_DenomUnit_3_list{list: &x.Aliases}
+		listValue := &_BatchOutput_2_list{list: &x.Coins}
 		return protoreflect.ValueOfList(listValue)
+	case "cosmos.bank.v1beta1.BatchOutput.memo":
+		value := x.Memo
+		return protoreflect.ValueOfString(value)
 	default:
 		if descriptor.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.bank.v1beta1.DenomUnit"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.bank.v1beta1.BatchOutput"))
 		}
-		panic(fmt.Errorf("message cosmos.bank.v1beta1.DenomUnit does not contain field %s", descriptor.FullName()))
+		panic(fmt.Errorf("message cosmos.bank.v1beta1.BatchOutput does not contain field %s", descriptor.FullName()))
 	}
 }
 
@@ -2882,21 +3296,21 @@ func (x *fastReflection_DenomUnit) Get(descriptor protoreflect.FieldDescriptor)
 // empty, read-only value, then it panics.
 //
 // Set is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_DenomUnit) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+func (x *fastReflection_BatchOutput) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
 	switch fd.FullName() {
-	case "cosmos.bank.v1beta1.DenomUnit.denom":
-		x.Denom = value.Interface().(string)
-	case "cosmos.bank.v1beta1.DenomUnit.exponent":
-		x.Exponent = uint32(value.Uint())
-	case "cosmos.bank.v1beta1.DenomUnit.aliases":
+	case "cosmos.bank.v1beta1.BatchOutput.address":
+		x.Address = value.Interface().(string)
+	case "cosmos.bank.v1beta1.BatchOutput.coins":
 		lv := value.List()
-		clv := lv.(*_DenomUnit_3_list)
-		x.Aliases = *clv.list
+		clv := lv.(*_BatchOutput_2_list)
+		x.Coins = *clv.list
+	case "cosmos.bank.v1beta1.BatchOutput.memo":
+		x.Memo = value.Interface().(string)
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.bank.v1beta1.DenomUnit"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.bank.v1beta1.BatchOutput"))
 		}
-		panic(fmt.Errorf("message cosmos.bank.v1beta1.DenomUnit does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.bank.v1beta1.BatchOutput does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -2910,53 +3324,53 @@ func (x *fastReflection_DenomUnit) Set(fd protoreflect.FieldDescriptor, value pr
 // It panics if the field does not contain a composite type.
 //
 // Mutable is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_DenomUnit) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_BatchOutput) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "cosmos.bank.v1beta1.DenomUnit.aliases":
-		if x.Aliases == nil {
-			x.Aliases = []string{}
+	case "cosmos.bank.v1beta1.BatchOutput.coins":
+		if x.Coins == nil {
+			x.Coins = []*v1beta1.Coin{}
 		}
-		value := &_DenomUnit_3_list{list: &x.Aliases}
+		value := &_BatchOutput_2_list{list: &x.Coins}
 		return protoreflect.ValueOfList(value)
-	case "cosmos.bank.v1beta1.DenomUnit.denom":
-		panic(fmt.Errorf("field denom of message cosmos.bank.v1beta1.DenomUnit is not mutable"))
-	case "cosmos.bank.v1beta1.DenomUnit.exponent":
-		panic(fmt.Errorf("field exponent of message cosmos.bank.v1beta1.DenomUnit is not mutable"))
+	case "cosmos.bank.v1beta1.BatchOutput.address":
+		panic(fmt.Errorf("field address of message cosmos.bank.v1beta1.BatchOutput is not mutable"))
+	case "cosmos.bank.v1beta1.BatchOutput.memo":
+		panic(fmt.Errorf("field memo of message cosmos.bank.v1beta1.BatchOutput is not mutable"))
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.bank.v1beta1.DenomUnit"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.bank.v1beta1.BatchOutput"))
 		}
-		panic(fmt.Errorf("message cosmos.bank.v1beta1.DenomUnit does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.bank.v1beta1.BatchOutput does not contain field %s", fd.FullName()))
 	}
 }
 
 // NewField returns a new value that is assignable to the field
 // for the given descriptor. For scalars, this returns the default value.
 // For lists, maps, and messages, this returns a new, empty, mutable value.
-func (x *fastReflection_DenomUnit) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_BatchOutput) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "cosmos.bank.v1beta1.DenomUnit.denom":
+	case "cosmos.bank.v1beta1.BatchOutput.address":
+		return protoreflect.ValueOfString("")
+	case "cosmos.bank.v1beta1.BatchOutput.coins":
+		list := []*v1beta1.Coin{}
+		return protoreflect.ValueOfList(&_BatchOutput_2_list{list: &list})
+	case "cosmos.bank.v1beta1.BatchOutput.memo":
 		return protoreflect.ValueOfString("")
-	case "cosmos.bank.v1beta1.DenomUnit.exponent":
-		return protoreflect.ValueOfUint32(uint32(0))
-	case "cosmos.bank.v1beta1.DenomUnit.aliases":
-		list := []string{}
-		return protoreflect.ValueOfList(&_DenomUnit_3_list{list: &list})
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.bank.v1beta1.DenomUnit"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.bank.v1beta1.BatchOutput"))
 		}
-		panic(fmt.Errorf("message cosmos.bank.v1beta1.DenomUnit does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.bank.v1beta1.BatchOutput does not contain field %s", fd.FullName()))
 	}
 }
 
 // WhichOneof reports which field within the oneof is populated,
 // returning nil if none are populated.
 // It panics if the oneof descriptor does not belong to this message.
-func (x *fastReflection_DenomUnit) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+func (x *fastReflection_BatchOutput) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
 	switch d.FullName() {
 	default:
-		panic(fmt.Errorf("%s is not a oneof field in cosmos.bank.v1beta1.DenomUnit", d.FullName()))
+		panic(fmt.Errorf("%s is not a oneof field in cosmos.bank.v1beta1.BatchOutput", d.FullName()))
 	}
 	panic("unreachable")
 }
@@ -2964,7 +3378,7 @@ func (x *fastReflection_DenomUnit) WhichOneof(d protoreflect.OneofDescriptor) pr
 // GetUnknown retrieves the entire list of unknown fields.
 // The caller may only mutate the contents of the RawFields
 // if the mutated bytes are stored back into the message with SetUnknown.
-func (x *fastReflection_DenomUnit) GetUnknown() protoreflect.RawFields {
+func (x *fastReflection_BatchOutput) GetUnknown() protoreflect.RawFields {
 	return x.unknownFields
 }
 
@@ -2975,7 +3389,7 @@ func (x *fastReflection_DenomUnit) GetUnknown() protoreflect.RawFields {
 // An empty RawFields may be passed to clear the fields.
 //
 // SetUnknown is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_DenomUnit) SetUnknown(fields protoreflect.RawFields) {
+func (x *fastReflection_BatchOutput) SetUnknown(fields protoreflect.RawFields) {
 	x.unknownFields = fields
 }
 
@@ -2987,7 +3401,7 @@ func (x *fastReflection_DenomUnit) SetUnknown(fields protoreflect.RawFields) {
 // message type, but the details are implementation dependent.
 // Validity is not part of the protobuf data model, and may not
 // be preserved in marshaling or other operations.
-func (x *fastReflection_DenomUnit) IsValid() bool {
+func (x *fastReflection_BatchOutput) IsValid() bool {
 	return x != nil
 }
 
@@ -2997,9 +3411,9 @@ func (x *fastReflection_DenomUnit) IsValid() bool {
 // The returned methods type is identical to
 // "google.golang.org/protobuf/runtime/protoiface".Methods.
 // Consult the protoiface package documentation for details.
-func (x *fastReflection_DenomUnit) ProtoMethods() *protoiface.Methods {
+func (x *fastReflection_BatchOutput) ProtoMethods() *protoiface.Methods {
 	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
-		x := input.Message.Interface().(*DenomUnit)
+		x := input.Message.Interface().(*BatchOutput)
 		if x == nil {
 			return protoiface.SizeOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -3011,19 +3425,20 @@ func (x *fastReflection_DenomUnit) ProtoMethods() *protoiface.Methods {
 		var n int
 		var l int
 		_ = l
-		l = len(x.Denom)
+		l = len(x.Address)
 		if l > 0 {
 			n += 1 + l + runtime.Sov(uint64(l))
 		}
-		if x.Exponent != 0 {
-			n += 1 + runtime.Sov(uint64(x.Exponent))
-		}
-		if len(x.Aliases) > 0 {
-			for _, s := range x.Aliases {
-				l = len(s)
+		if len(x.Coins) > 0 {
+			for _, e := range x.Coins {
+				l = options.Size(e)
 				n += 1 + l + runtime.Sov(uint64(l))
 			}
 		}
+		l = len(x.Memo)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
 		}
@@ -3034,7 +3449,7 @@ func (x *fastReflection_DenomUnit) ProtoMethods() *protoiface.Methods {
 	}
 
 	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
-		x := input.Message.Interface().(*DenomUnit)
+		x := input.Message.Interface().(*BatchOutput)
 		if x == nil {
 			return protoiface.MarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -3053,24 +3468,33 @@ func (x *fastReflection_DenomUnit) ProtoMethods() *protoiface.Methods {
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
-		if len(x.Aliases) > 0 {
-			for iNdEx := len(x.Aliases) - 1; iNdEx >= 0; iNdEx-- {
-				i -= len(x.Aliases[iNdEx])
-				copy(dAtA[i:], x.Aliases[iNdEx])
-				i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Aliases[iNdEx])))
+		if len(x.Memo) > 0 {
+			i -= len(x.Memo)
+			copy(dAtA[i:], x.Memo)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Memo)))
+			i--
+			dAtA[i] = 0x1a
+		}
+		if len(x.Coins) > 0 {
+			for iNdEx := len(x.Coins) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.Coins[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
 				i--
-				dAtA[i] = 0x1a
+				dAtA[i] = 0x12
 			}
 		}
-		if x.Exponent != 0 {
-			i = runtime.EncodeVarint(dAtA, i, uint64(x.Exponent))
-			i--
-			dAtA[i] = 0x10
-		}
-		if len(x.Denom) > 0 {
-			i -= len(x.Denom)
-			copy(dAtA[i:], x.Denom)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Denom)))
+		if len(x.Address) > 0 {
+			i -= len(x.Address)
+			copy(dAtA[i:], x.Address)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Address)))
 			i--
 			dAtA[i] = 0xa
 		}
@@ -3085,7 +3509,7 @@ func (x *fastReflection_DenomUnit) ProtoMethods() *protoiface.Methods {
 		}, nil
 	}
 	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
-		x := input.Message.Interface().(*DenomUnit)
+		x := input.Message.Interface().(*BatchOutput)
 		if x == nil {
 			return protoiface.UnmarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -3117,15 +3541,15 @@ func (x *fastReflection_DenomUnit) ProtoMethods() *protoiface.Methods {
 			fieldNum := int32(wire >> 3)
 			wireType := int(wire & 0x7)
 			if wireType == 4 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: DenomUnit: wiretype end group for non-group")
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: BatchOutput: wiretype end group for non-group")
 			}
 			if fieldNum <= 0 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: DenomUnit: illegal tag %d (wire type %d)", fieldNum, wire)
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: BatchOutput: illegal tag %d (wire type %d)", fieldNum, wire)
 			}
 			switch fieldNum {
 			case 1:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
 				}
 				var stringLen uint64
 				for shift := uint(0); ; shift += 7 {
@@ -3153,13 +3577,13 @@ func (x *fastReflection_DenomUnit) ProtoMethods() *protoiface.Methods {
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				x.Denom = string(dAtA[iNdEx:postIndex])
+				x.Address = string(dAtA[iNdEx:postIndex])
 				iNdEx = postIndex
 			case 2:
-				if wireType != 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Exponent", wireType)
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Coins", wireType)
 				}
-				x.Exponent = 0
+				var msglen int
 				for shift := uint(0); ; shift += 7 {
 					if shift >= 64 {
 						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
@@ -3169,14 +3593,29 @@ func (x *fastReflection_DenomUnit) ProtoMethods() *protoiface.Methods {
 					}
 					b := dAtA[iNdEx]
 					iNdEx++
-					x.Exponent |= uint32(b&0x7F) << shift
+					msglen |= int(b&0x7F) << shift
 					if b < 0x80 {
 						break
 					}
 				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Coins = append(x.Coins, &v1beta1.Coin{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Coins[len(x.Coins)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
 			case 3:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Aliases", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Memo", wireType)
 				}
 				var stringLen uint64
 				for shift := uint(0); ; shift += 7 {
@@ -3204,7 +3643,7 @@ func (x *fastReflection_DenomUnit) ProtoMethods() *protoiface.Methods {
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				x.Aliases = append(x.Aliases, string(dAtA[iNdEx:postIndex]))
+				x.Memo = string(dAtA[iNdEx:postIndex])
 				iNdEx = postIndex
 			default:
 				iNdEx = preIndex
@@ -3241,91 +3680,77 @@ func (x *fastReflection_DenomUnit) ProtoMethods() *protoiface.Methods {
 	}
 }
 
-var _ protoreflect.List = (*_Metadata_2_list)(nil)
+var _ protoreflect.List = (*_Supply_1_list)(nil)
 
-type _Metadata_2_list struct {
-	list *[]*DenomUnit
+type _Supply_1_list struct {
+	list *[]*v1beta1.Coin
 }
 
-func (x *_Metadata_2_list) Len() int {
+func (x *_Supply_1_list) Len() int {
 	if x.list == nil {
 		return 0
 	}
 	return len(*x.list)
 }
 
-func (x *_Metadata_2_list) Get(i int) protoreflect.Value {
+func (x *_Supply_1_list) Get(i int) protoreflect.Value {
 	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
 }
 
-func (x *_Metadata_2_list) Set(i int, value protoreflect.Value) {
+func (x *_Supply_1_list) Set(i int, value protoreflect.Value) {
 	valueUnwrapped := value.Message()
-	concreteValue := valueUnwrapped.Interface().(*DenomUnit)
+	concreteValue := valueUnwrapped.Interface().(*v1beta1.Coin)
 	(*x.list)[i] = concreteValue
 }
 
-func (x *_Metadata_2_list) Append(value protoreflect.Value) {
+func (x *_Supply_1_list) Append(value protoreflect.Value) {
 	valueUnwrapped := value.Message()
-	concreteValue := valueUnwrapped.Interface().(*DenomUnit)
+	concreteValue := valueUnwrapped.Interface().(*v1beta1.Coin)
 	*x.list = append(*x.list, concreteValue)
 }
 
-func (x *_Metadata_2_list) AppendMutable() protoreflect.Value {
-	v := new(DenomUnit)
+func (x *_Supply_1_list) AppendMutable() protoreflect.Value {
+	v := new(v1beta1.Coin)
 	*x.list = append(*x.list, v)
 	return protoreflect.ValueOfMessage(v.ProtoReflect())
 }
 
-func (x *_Metadata_2_list) Truncate(n int) {
+func (x *_Supply_1_list) Truncate(n int) {
 	for i := n; i < len(*x.list); i++ {
 		(*x.list)[i] = nil
 	}
 	*x.list = (*x.list)[:n]
 }
 
-func (x *_Metadata_2_list) NewElement() protoreflect.Value {
-	v := new(DenomUnit)
+func (x *_Supply_1_list) NewElement() protoreflect.Value {
+	v := new(v1beta1.Coin)
 	return protoreflect.ValueOfMessage(v.ProtoReflect())
 }
 
-func (x *_Metadata_2_list) IsValid() bool {
+func (x *_Supply_1_list) IsValid() bool {
 	return x.list != nil
 }
 
 var (
-	md_Metadata             protoreflect.MessageDescriptor
-	fd_Metadata_description protoreflect.FieldDescriptor
-	fd_Metadata_denom_units protoreflect.FieldDescriptor
-	fd_Metadata_base        protoreflect.FieldDescriptor
-	fd_Metadata_display     protoreflect.FieldDescriptor
-	fd_Metadata_name        protoreflect.FieldDescriptor
-	fd_Metadata_symbol      protoreflect.FieldDescriptor
-	fd_Metadata_uri         protoreflect.FieldDescriptor
-	fd_Metadata_uri_hash    protoreflect.FieldDescriptor
+	md_Supply       protoreflect.MessageDescriptor
+	fd_Supply_total protoreflect.FieldDescriptor
 )
 
 func init() {
 	file_cosmos_bank_v1beta1_bank_proto_init()
-	md_Metadata = File_cosmos_bank_v1beta1_bank_proto.Messages().ByName("Metadata")
-	fd_Metadata_description = md_Metadata.Fields().ByName("description")
-	fd_Metadata_denom_units = md_Metadata.Fields().ByName("denom_units")
-	fd_Metadata_base = md_Metadata.Fields().ByName("base")
-	fd_Metadata_display = md_Metadata.Fields().ByName("display")
-	fd_Metadata_name = md_Metadata.Fields().ByName("name")
-	fd_Metadata_symbol = md_Metadata.Fields().ByName("symbol")
-	fd_Metadata_uri = md_Metadata.Fields().ByName("uri")
-	fd_Metadata_uri_hash = md_Metadata.Fields().ByName("uri_hash")
+	md_Supply = File_cosmos_bank_v1beta1_bank_proto.Messages().ByName("Supply")
+	fd_Supply_total = md_Supply.Fields().ByName("total")
 }
 
-var _ protoreflect.Message = (*fastReflection_Metadata)(nil)
+var _ protoreflect.Message = (*fastReflection_Supply)(nil)
 
-type fastReflection_Metadata Metadata
+type fastReflection_Supply Supply
 
-func (x *Metadata) ProtoReflect() protoreflect.Message {
-	return (*fastReflection_Metadata)(x)
+func (x *Supply) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_Supply)(x)
 }
 
-func (x *Metadata) slowProtoReflect() protoreflect.Message {
+func (x *Supply) slowProtoReflect() protoreflect.Message {
 	mi := &file_cosmos_bank_v1beta1_bank_proto_msgTypes[6]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3337,43 +3762,43 @@ func (x *Metadata) slowProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-var _fastReflection_Metadata_messageType fastReflection_Metadata_messageType
-var _ protoreflect.MessageType = fastReflection_Metadata_messageType{}
+var _fastReflection_Supply_messageType fastReflection_Supply_messageType
+var _ protoreflect.MessageType = fastReflection_Supply_messageType{}
 
-type fastReflection_Metadata_messageType struct{}
+type fastReflection_Supply_messageType struct{}
 
-func (x fastReflection_Metadata_messageType) Zero() protoreflect.Message {
-	return (*fastReflection_Metadata)(nil)
+func (x fastReflection_Supply_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_Supply)(nil)
 }
-func (x fastReflection_Metadata_messageType) New() protoreflect.Message {
-	return new(fastReflection_Metadata)
+func (x fastReflection_Supply_messageType) New() protoreflect.Message {
+	return new(fastReflection_Supply)
 }
-func (x fastReflection_Metadata_messageType) Descriptor() protoreflect.MessageDescriptor {
-	return md_Metadata
+func (x fastReflection_Supply_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_Supply
 }
 
 // Descriptor returns message descriptor, which contains only the protobuf
 // type information for the message.
-func (x *fastReflection_Metadata) Descriptor() protoreflect.MessageDescriptor {
-	return md_Metadata
+func (x *fastReflection_Supply) Descriptor() protoreflect.MessageDescriptor {
+	return md_Supply
 }
 
 // Type returns the message type, which encapsulates both Go and protobuf
 // type information. If the Go type information is not needed,
 // it is recommended that the message descriptor be used instead.
-func (x *fastReflection_Metadata) Type() protoreflect.MessageType {
-	return _fastReflection_Metadata_messageType
+func (x *fastReflection_Supply) Type() protoreflect.MessageType {
+	return _fastReflection_Supply_messageType
 }
 
 // New returns a newly allocated and mutable empty message.
-func (x *fastReflection_Metadata) New() protoreflect.Message {
-	return new(fastReflection_Metadata)
+func (x *fastReflection_Supply) New() protoreflect.Message {
+	return new(fastReflection_Supply)
 }
 
 // Interface unwraps the message reflection interface and
 // returns the underlying ProtoMessage interface.
-func (x *fastReflection_Metadata) Interface() protoreflect.ProtoMessage {
-	return (*Metadata)(x)
+func (x *fastReflection_Supply) Interface() protoreflect.ProtoMessage {
+	return (*Supply)(x)
 }
 
 // Range iterates over every populated field in an undefined order,
@@ -3381,52 +3806,10 @@ func (x *fastReflection_Metadata) Interface() protoreflect.ProtoMessage {
 // Range returns immediately if f returns false.
 // While iterating, mutating operations may only be performed
 // on the current field descriptor.
-func (x *fastReflection_Metadata) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
-	if x.Description != "" {
-		value := protoreflect.ValueOfString(x.Description)
-		if !f(fd_Metadata_description, value) {
-			return
-		}
-	}
-	if len(x.DenomUnits) != 0 {
-		value := protoreflect.ValueOfList(&_Metadata_2_list{list: &x.DenomUnits})
-		if !f(fd_Metadata_denom_units, value) {
-			return
-		}
-	}
-	if x.Base != "" {
-		value := protoreflect.ValueOfString(x.Base)
-		if !f(fd_Metadata_base, value) {
-			return
-		}
-	}
-	if x.Display != "" {
-		value := protoreflect.ValueOfString(x.Display)
-		if !f(fd_Metadata_display, value) {
-			return
-		}
-	}
-	if x.Name != "" {
-		value := protoreflect.ValueOfString(x.Name)
-		if !f(fd_Metadata_name, value) {
-			return
-		}
-	}
-	if x.Symbol != "" {
-		value := protoreflect.ValueOfString(x.Symbol)
-		if !f(fd_Metadata_symbol, value) {
-			return
-		}
-	}
-	if x.Uri != "" {
-		value := protoreflect.ValueOfString(x.Uri)
-		if !f(fd_Metadata_uri, value) {
-			return
-		}
-	}
-	if x.UriHash != "" {
-		value := protoreflect.ValueOfString(x.UriHash)
-		if !f(fd_Metadata_uri_hash, value) {
+func (x *fastReflection_Supply) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if len(x.Total) != 0 {
+		value := protoreflect.ValueOfList(&_Supply_1_list{list: &x.Total})
+		if !f(fd_Supply_total, value) {
 			return
 		}
 	}
@@ -3443,29 +3826,15 @@ func (x *fastReflection_Metadata) Range(f func(protoreflect.FieldDescriptor, pro
 // In other cases (aside from the nullable cases above),
 // a proto3 scalar field is populated if it contains a non-zero value, and
 // a repeated field is populated if it is non-empty.
-func (x *fastReflection_Metadata) Has(fd protoreflect.FieldDescriptor) bool {
+func (x *fastReflection_Supply) Has(fd protoreflect.FieldDescriptor) bool {
 	switch fd.FullName() {
-	case "cosmos.bank.v1beta1.Metadata.description":
-		return x.Description != ""
-	case "cosmos.bank.v1beta1.Metadata.denom_units":
-		return len(x.DenomUnits) != 0
-	case "cosmos.bank.v1beta1.Metadata.base":
-		return x.Base != ""
-	case "cosmos.bank.v1beta1.Metadata.display":
-		return x.Display != ""
-	case "cosmos.bank.v1beta1.Metadata.name":
-		return x.Name != ""
-	case "cosmos.bank.v1beta1.Metadata.symbol":
-		return x.Symbol != ""
-	case "cosmos.bank.v1beta1.Metadata.uri":
-		return x.Uri != ""
-	case "cosmos.bank.v1beta1.Metadata.uri_hash":
-		return x.UriHash != ""
+	case "cosmos.bank.v1beta1.Supply.total":
+		return len(x.Total) != 0
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.bank.v1beta1.Metadata"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.bank.v1beta1.Supply"))
 		}
-		panic(fmt.Errorf("message cosmos.bank.v1beta1.Metadata does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.bank.v1beta1.Supply does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -3475,29 +3844,15 @@ func (x *fastReflection_Metadata) Has(fd protoreflect.FieldDescriptor) bool {
 // associated with the given field number.
 //
 // Clear is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_Metadata) Clear(fd protoreflect.FieldDescriptor) {
+func (x *fastReflection_Supply) Clear(fd protoreflect.FieldDescriptor) {
 	switch fd.FullName() {
-	case "cosmos.bank.v1beta1.Metadata.description":
-		x.Description = ""
-	case "cosmos.bank.v1beta1.Metadata.denom_units":
-		x.DenomUnits = nil
-	case "cosmos.bank.v1beta1.Metadata.base":
-		x.Base = ""
-	case "cosmos.bank.v1beta1.Metadata.display":
-		x.Display = ""
-	case "cosmos.bank.v1beta1.Metadata.name":
-		x.Name = ""
-	case "cosmos.bank.v1beta1.Metadata.symbol":
-		x.Symbol = ""
-	case "cosmos.bank.v1beta1.Metadata.uri":
-		x.Uri = ""
-	case "cosmos.bank.v1beta1.Metadata.uri_hash":
-		x.UriHash = ""
+	case "cosmos.bank.v1beta1.Supply.total":
+		x.Total = nil
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.bank.v1beta1.Metadata"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.bank.v1beta1.Supply"))
 		}
-		panic(fmt.Errorf("message cosmos.bank.v1beta1.Metadata does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.bank.v1beta1.Supply does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -3507,40 +3862,19 @@ func (x *fastReflection_Metadata) Clear(fd protoreflect.FieldDescriptor) {
 // the default value of a bytes scalar is guaranteed to be a copy.
 // For unpopulated composite types, it returns an empty, read-only view
 // of the value; to obtain a mutable reference, use Mutable.
-func (x *fastReflection_Metadata) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_Supply) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
 	switch descriptor.FullName() {
-	case "cosmos.bank.v1beta1.Metadata.description":
-		value := x.Description
-		return protoreflect.ValueOfString(value)
-	case "cosmos.bank.v1beta1.Metadata.denom_units":
-		if len(x.DenomUnits) == 0 {
-			return protoreflect.ValueOfList(&_Metadata_2_list{})
+	case "cosmos.bank.v1beta1.Supply.total":
+		if len(x.Total) == 0 {
+			return protoreflect.ValueOfList(&_Supply_1_list{})
 		}
-		listValue := &_Metadata_2_list{list: &x.DenomUnits}
+		listValue := &_Supply_1_list{list: &x.Total}
 		return protoreflect.ValueOfList(listValue)
-	case "cosmos.bank.v1beta1.Metadata.base":
-		value := x.Base
-		return protoreflect.ValueOfString(value)
-	case "cosmos.bank.v1beta1.Metadata.display":
-		value := x.Display
-		return protoreflect.ValueOfString(value)
-	case "cosmos.bank.v1beta1.Metadata.name":
-		value := x.Name
-		return protoreflect.ValueOfString(value)
-	case "cosmos.bank.v1beta1.Metadata.symbol":
-		value := x.Symbol
-		return protoreflect.ValueOfString(value)
-	case "cosmos.bank.v1beta1.Metadata.uri":
-		value := x.Uri
-		return protoreflect.ValueOfString(value)
-	case "cosmos.bank.v1beta1.Metadata.uri_hash":
-		value := x.UriHash
-		return protoreflect.ValueOfString(value)
 	default:
 		if descriptor.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.bank.v1beta1.Metadata"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.bank.v1beta1.Supply"))
 		}
-		panic(fmt.Errorf("message cosmos.bank.v1beta1.Metadata does not contain field %s", descriptor.FullName()))
+		panic(fmt.Errorf("message cosmos.bank.v1beta1.Supply does not contain field %s", descriptor.FullName()))
 	}
 }
 
@@ -3554,31 +3888,17 @@ func (x *fastReflection_Metadata) Get(descriptor protoreflect.FieldDescriptor) p
 // empty, read-only value, then it panics.
 //
 // Set is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_Metadata) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+func (x *fastReflection_Supply) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
 	switch fd.FullName() {
-	case "cosmos.bank.v1beta1.Metadata.description":
-		x.Description = value.Interface().(string)
-	case "cosmos.bank.v1beta1.Metadata.denom_units":
+	case "cosmos.bank.v1beta1.Supply.total":
 		lv := value.List()
-		clv := lv.(*_Metadata_2_list)
-		x.DenomUnits = *clv.list
-	case "cosmos.bank.v1beta1.Metadata.base":
-		x.Base = value.Interface().(string)
-	case "cosmos.bank.v1beta1.Metadata.display":
-		x.Display = value.Interface().(string)
-	case "cosmos.bank.v1beta1.Metadata.name":
-		x.Name = value.Interface().(string)
-	case "cosmos.bank.v1beta1.Metadata.symbol":
-		x.Symbol = value.Interface().(string)
-	case "cosmos.bank.v1beta1.Metadata.uri":
-		x.Uri = value.Interface().(string)
-	case "cosmos.bank.v1beta1.Metadata.uri_hash":
-		x.UriHash = value.Interface().(string)
+		clv := lv.(*_Supply_1_list)
+		x.Total = *clv.list
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.bank.v1beta1.Metadata"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.bank.v1beta1.Supply"))
 		}
-		panic(fmt.Errorf("message cosmos.bank.v1beta1.Metadata does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.bank.v1beta1.Supply does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -3592,73 +3912,45 @@ func (x *fastReflection_Metadata) Set(fd protoreflect.FieldDescriptor, value pro
 // It panics if the field does not contain a composite type.
 //
 // Mutable is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_Metadata) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_Supply) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "cosmos.bank.v1beta1.Metadata.denom_units":
-		if x.DenomUnits == nil {
-			x.DenomUnits = []*DenomUnit{}
+	case "cosmos.bank.v1beta1.Supply.total":
+		if x.Total == nil {
+			x.Total = []*v1beta1.Coin{}
 		}
-		value := &_Metadata_2_list{list: &x.DenomUnits}
+		value := &_Supply_1_list{list: &x.Total}
 		return protoreflect.ValueOfList(value)
-	case "cosmos.bank.v1beta1.Metadata.description":
-		panic(fmt.Errorf("field description of message cosmos.bank.v1beta1.Metadata is not mutable"))
-	case "cosmos.bank.v1beta1.Metadata.base":
-		panic(fmt.Errorf("field base of message cosmos.bank.v1beta1.Metadata is not mutable"))
-	case "cosmos.bank.v1beta1.Metadata.display":
-		panic(fmt.Errorf("field display of message cosmos.bank.v1beta1.Metadata is not mutable"))
-	case "cosmos.bank.v1beta1.Metadata.name":
-		panic(fmt.Errorf("field name of message cosmos.bank.v1beta1.Metadata is not mutable"))
-	case "cosmos.bank.v1beta1.Metadata.symbol":
-		panic(fmt.Errorf("field symbol of message cosmos.bank.v1beta1.Metadata is not mutable"))
-	case "cosmos.bank.v1beta1.Metadata.uri":
-		panic(fmt.Errorf("field uri of message cosmos.bank.v1beta1.Metadata is not mutable"))
-	case "cosmos.bank.v1beta1.Metadata.uri_hash":
-		panic(fmt.Errorf("field uri_hash of message cosmos.bank.v1beta1.Metadata is not mutable"))
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.bank.v1beta1.Metadata"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.bank.v1beta1.Supply"))
 		}
-		panic(fmt.Errorf("message cosmos.bank.v1beta1.Metadata does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.bank.v1beta1.Supply does not contain field %s", fd.FullName()))
 	}
 }
 
 // NewField returns a new value that is assignable to the field
 // for the given descriptor. For scalars, this returns the default value.
 // For lists, maps, and messages, this returns a new, empty, mutable value.
-func (x *fastReflection_Metadata) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_Supply) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "cosmos.bank.v1beta1.Metadata.description":
-		return protoreflect.ValueOfString("")
-	case "cosmos.bank.v1beta1.Metadata.denom_units":
-		list := []*DenomUnit{}
-		return protoreflect.ValueOfList(&_Metadata_2_list{list: &list})
-	case "cosmos.bank.v1beta1.Metadata.base":
-		return protoreflect.ValueOfString("")
-	case "cosmos.bank.v1beta1.Metadata.display":
-		return protoreflect.ValueOfString("")
-	case "cosmos.bank.v1beta1.Metadata.name":
-		return protoreflect.ValueOfString("")
-	case "cosmos.bank.v1beta1.Metadata.symbol":
-		return protoreflect.ValueOfString("")
-	case "cosmos.bank.v1beta1.Metadata.uri":
-		return protoreflect.ValueOfString("")
-	case "cosmos.bank.v1beta1.Metadata.uri_hash":
-		return protoreflect.ValueOfString("")
+	case "cosmos.bank.v1beta1.Supply.total":
+		list := []*v1beta1.Coin{}
+		return protoreflect.ValueOfList(&_Supply_1_list{list: &list})
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.bank.v1beta1.Metadata"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.bank.v1beta1.Supply"))
 		}
-		panic(fmt.Errorf("message cosmos.bank.v1beta1.Metadata does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.bank.v1beta1.Supply does not contain field %s", fd.FullName()))
 	}
 }
 
 // WhichOneof reports which field within the oneof is populated,
 // returning nil if none are populated.
 // It panics if the oneof descriptor does not belong to this message.
-func (x *fastReflection_Metadata) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+func (x *fastReflection_Supply) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
 	switch d.FullName() {
 	default:
-		panic(fmt.Errorf("%s is not a oneof field in cosmos.bank.v1beta1.Metadata", d.FullName()))
+		panic(fmt.Errorf("%s is not a oneof field in cosmos.bank.v1beta1.Supply", d.FullName()))
 	}
 	panic("unreachable")
 }
@@ -3666,7 +3958,7 @@ func (x *fastReflection_Metadata) WhichOneof(d protoreflect.OneofDescriptor) pro
 // GetUnknown retrieves the entire list of unknown fields.
 // The caller may only mutate the contents of the RawFields
 // if the mutated bytes are stored back into the message with SetUnknown.
-func (x *fastReflection_Metadata) GetUnknown() protoreflect.RawFields {
+func (x *fastReflection_Supply) GetUnknown() protoreflect.RawFields {
 	return x.unknownFields
 }
 
@@ -3677,7 +3969,7 @@ func (x *fastReflection_Metadata) GetUnknown() protoreflect.RawFields {
 // An empty RawFields may be passed to clear the fields.
 //
 // SetUnknown is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_Metadata) SetUnknown(fields protoreflect.RawFields) {
+func (x *fastReflection_Supply) SetUnknown(fields protoreflect.RawFields) {
 	x.unknownFields = fields
 }
 
@@ -3689,7 +3981,7 @@ func (x *fastReflection_Metadata) SetUnknown(fields protoreflect.RawFields) {
 // message type, but the details are implementation dependent.
 // Validity is not part of the protobuf data model, and may not
 // be preserved in marshaling or other operations.
-func (x *fastReflection_Metadata) IsValid() bool {
+func (x *fastReflection_Supply) IsValid() bool {
 	return x != nil
 }
 
@@ -3699,9 +3991,9 @@ func (x *fastReflection_Metadata) IsValid() bool {
 // The returned methods type is identical to
 // "google.golang.org/protobuf/runtime/protoiface".Methods.
 // Consult the protoiface package documentation for details.
-func (x *fastReflection_Metadata) ProtoMethods() *protoiface.Methods {
+func (x *fastReflection_Supply) ProtoMethods() *protoiface.Methods {
 	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
-		x := input.Message.Interface().(*Metadata)
+		x := input.Message.Interface().(*Supply)
 		if x == nil {
 			return protoiface.SizeOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -3713,40 +4005,12 @@ func (x *fastReflection_Metadata) ProtoMethods() *protoiface.Methods {
 		var n int
 		var l int
 		_ = l
-		l = len(x.Description)
-		if l > 0 {
-			n += 1 + l + runtime.Sov(uint64(l))
-		}
-		if len(x.DenomUnits) > 0 {
-			for _, e := range x.DenomUnits {
+		if len(x.Total) > 0 {
+			for _, e := range x.Total {
 				l = options.Size(e)
 				n += 1 + l + runtime.Sov(uint64(l))
 			}
 		}
-		l = len(x.Base)
-		if l > 0 {
-			n += 1 + l + runtime.Sov(uint64(l))
-		}
-		l = len(x.Display)
-		if l > 0 {
-			n += 1 + l + runtime.Sov(uint64(l))
-		}
-		l = len(x.Name)
-		if l > 0 {
-			n += 1 + l + runtime.Sov(uint64(l))
-		}
-		l = len(x.Symbol)
-		if l > 0 {
-			n += 1 + l + runtime.Sov(uint64(l))
-		}
-		l = len(x.Uri)
-		if l > 0 {
-			n += 1 + l + runtime.Sov(uint64(l))
-		}
-		l = len(x.UriHash)
-		if l > 0 {
-			n += 1 + l + runtime.Sov(uint64(l))
-		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
 		}
@@ -3757,7 +4021,7 @@ func (x *fastReflection_Metadata) ProtoMethods() *protoiface.Methods {
 	}
 
 	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
-		x := input.Message.Interface().(*Metadata)
+		x := input.Message.Interface().(*Supply)
 		if x == nil {
 			return protoiface.MarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -3776,51 +4040,9 @@ func (x *fastReflection_Metadata) ProtoMethods() *protoiface.Methods {
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
-		if len(x.UriHash) > 0 {
-			i -= len(x.UriHash)
-			copy(dAtA[i:], x.UriHash)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.UriHash)))
-			i--
-			dAtA[i] = 0x42
-		}
-		if len(x.Uri) > 0 {
-			i -= len(x.Uri)
-			copy(dAtA[i:], x.Uri)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Uri)))
-			i--
-			dAtA[i] = 0x3a
-		}
-		if len(x.Symbol) > 0 {
-			i -= len(x.Symbol)
-			copy(dAtA[i:], x.Symbol)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Symbol)))
-			i--
-			dAtA[i] = 0x32
-		}
-		if len(x.Name) > 0 {
-			i -= len(x.Name)
-			copy(dAtA[i:], x.Name)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Name)))
-			i--
-			dAtA[i] = 0x2a
-		}
-		if len(x.Display) > 0 {
-			i -= len(x.Display)
-			copy(dAtA[i:], x.Display)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Display)))
-			i--
-			dAtA[i] = 0x22
-		}
-		if len(x.Base) > 0 {
-			i -= len(x.Base)
-			copy(dAtA[i:], x.Base)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Base)))
-			i--
-			dAtA[i] = 0x1a
-		}
-		if len(x.DenomUnits) > 0 {
-			for iNdEx := len(x.DenomUnits) - 1; iNdEx >= 0; iNdEx-- {
-				encoded, err := options.Marshal(x.DenomUnits[iNdEx])
+		if len(x.Total) > 0 {
+			for iNdEx := len(x.Total) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.Total[iNdEx])
 				if err != nil {
 					return protoiface.MarshalOutput{
 						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -3831,16 +4053,9 @@ func (x *fastReflection_Metadata) ProtoMethods() *protoiface.Methods {
 				copy(dAtA[i:], encoded)
 				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
 				i--
-				dAtA[i] = 0x12
+				dAtA[i] = 0xa
 			}
 		}
-		if len(x.Description) > 0 {
-			i -= len(x.Description)
-			copy(dAtA[i:], x.Description)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Description)))
-			i--
-			dAtA[i] = 0xa
-		}
 		if input.Buf != nil {
 			input.Buf = append(input.Buf, dAtA...)
 		} else {
@@ -3852,7 +4067,7 @@ func (x *fastReflection_Metadata) ProtoMethods() *protoiface.Methods {
 		}, nil
 	}
 	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
-		x := input.Message.Interface().(*Metadata)
+		x := input.Message.Interface().(*Supply)
 		if x == nil {
 			return protoiface.UnmarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -3884,17 +4099,17 @@ func (x *fastReflection_Metadata) ProtoMethods() *protoiface.Methods {
 			fieldNum := int32(wire >> 3)
 			wireType := int(wire & 0x7)
 			if wireType == 4 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: Metadata: wiretype end group for non-group")
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: Supply: wiretype end group for non-group")
 			}
 			if fieldNum <= 0 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: Metadata: illegal tag %d (wire type %d)", fieldNum, wire)
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: Supply: illegal tag %d (wire type %d)", fieldNum, wire)
 			}
 			switch fieldNum {
 			case 1:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Total", wireType)
 				}
-				var stringLen uint64
+				var msglen int
 				for shift := uint(0); ; shift += 7 {
 					if shift >= 64 {
 						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
@@ -3904,255 +4119,31 @@ func (x *fastReflection_Metadata) ProtoMethods() *protoiface.Methods {
 					}
 					b := dAtA[iNdEx]
 					iNdEx++
-					stringLen |= uint64(b&0x7F) << shift
+					msglen |= int(b&0x7F) << shift
 					if b < 0x80 {
 						break
 					}
 				}
-				intStringLen := int(stringLen)
-				if intStringLen < 0 {
+				if msglen < 0 {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 				}
-				postIndex := iNdEx + intStringLen
+				postIndex := iNdEx + msglen
 				if postIndex < 0 {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 				}
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				x.Description = string(dAtA[iNdEx:postIndex])
+				x.Total = append(x.Total, &v1beta1.Coin{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Total[len(x.Total)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
 				iNdEx = postIndex
-			case 2:
-				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field DenomUnits", wireType)
-				}
-				var msglen int
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
-					}
-					if iNdEx >= l {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					msglen |= int(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				if msglen < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				postIndex := iNdEx + msglen
-				if postIndex < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				if postIndex > l {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-				}
-				x.DenomUnits = append(x.DenomUnits, &DenomUnit{})
-				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.DenomUnits[len(x.DenomUnits)-1]); err != nil {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
-				}
-				iNdEx = postIndex
-			case 3:
-				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Base", wireType)
-				}
-				var stringLen uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
-					}
-					if iNdEx >= l {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					stringLen |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				intStringLen := int(stringLen)
-				if intStringLen < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				postIndex := iNdEx + intStringLen
-				if postIndex < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				if postIndex > l {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-				}
-				x.Base = string(dAtA[iNdEx:postIndex])
-				iNdEx = postIndex
-			case 4:
-				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Display", wireType)
-				}
-				var stringLen uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
-					}
-					if iNdEx >= l {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					stringLen |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				intStringLen := int(stringLen)
-				if intStringLen < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				postIndex := iNdEx + intStringLen
-				if postIndex < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				if postIndex > l {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-				}
-				x.Display = string(dAtA[iNdEx:postIndex])
-				iNdEx = postIndex
-			case 5:
-				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
-				}
-				var stringLen uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
-					}
-					if iNdEx >= l {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					stringLen |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				intStringLen := int(stringLen)
-				if intStringLen < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				postIndex := iNdEx + intStringLen
-				if postIndex < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				if postIndex > l {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-				}
-				x.Name = string(dAtA[iNdEx:postIndex])
-				iNdEx = postIndex
-			case 6:
-				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Symbol", wireType)
-				}
-				var stringLen uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
-					}
-					if iNdEx >= l {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					stringLen |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				intStringLen := int(stringLen)
-				if intStringLen < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				postIndex := iNdEx + intStringLen
-				if postIndex < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				if postIndex > l {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-				}
-				x.Symbol = string(dAtA[iNdEx:postIndex])
-				iNdEx = postIndex
-			case 7:
-				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Uri", wireType)
-				}
-				var stringLen uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
-					}
-					if iNdEx >= l {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					stringLen |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				intStringLen := int(stringLen)
-				if intStringLen < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				postIndex := iNdEx + intStringLen
-				if postIndex < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				if postIndex > l {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-				}
-				x.Uri = string(dAtA[iNdEx:postIndex])
-				iNdEx = postIndex
-			case 8:
-				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field UriHash", wireType)
-				}
-				var stringLen uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
-					}
-					if iNdEx >= l {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					stringLen |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				intStringLen := int(stringLen)
-				if intStringLen < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				postIndex := iNdEx + intStringLen
-				if postIndex < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				if postIndex > l {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-				}
-				x.UriHash = string(dAtA[iNdEx:postIndex])
-				iNdEx = postIndex
-			default:
-				iNdEx = preIndex
-				skippy, err := runtime.Skip(dAtA[iNdEx:])
-				if err != nil {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
 				}
 				if (skippy < 0) || (iNdEx+skippy) < 0 {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
@@ -4172,422 +4163,10740 @@ func (x *fastReflection_Metadata) ProtoMethods() *protoiface.Methods {
 		}
 		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
 	}
-	return &protoiface.Methods{
-		NoUnkeyedLiterals: struct{}{},
-		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
-		Size:              size,
-		Marshal:           marshal,
-		Unmarshal:         unmarshal,
-		Merge:             nil,
-		CheckInitialized:  nil,
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var _ protoreflect.List = (*_DenomUnit_3_list)(nil)
+
+type _DenomUnit_3_list struct {
+	list *[]string
+}
+
+func (x *_DenomUnit_3_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_DenomUnit_3_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfString((*x.list)[i])
+}
+
+func (x *_DenomUnit_3_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.String()
+	concreteValue := valueUnwrapped
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_DenomUnit_3_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.String()
+	concreteValue := valueUnwrapped
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_DenomUnit_3_list) AppendMutable() protoreflect.Value {
+	panic(fmt.Errorf("AppendMutable can not be called on message DenomUnit at list field Aliases as it is not of Message kind"))
+}
+
+func (x *_DenomUnit_3_list) Truncate(n int) {
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_DenomUnit_3_list) NewElement() protoreflect.Value {
+	v := ""
+	return protoreflect.ValueOfString(v)
+}
+
+func (x *_DenomUnit_3_list) IsValid() bool {
+	return x.list != nil
+}
+
+var (
+	md_DenomUnit          protoreflect.MessageDescriptor
+	fd_DenomUnit_denom    protoreflect.FieldDescriptor
+	fd_DenomUnit_exponent protoreflect.FieldDescriptor
+	fd_DenomUnit_aliases  protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_cosmos_bank_v1beta1_bank_proto_init()
+	md_DenomUnit = File_cosmos_bank_v1beta1_bank_proto.Messages().ByName("DenomUnit")
+	fd_DenomUnit_denom = md_DenomUnit.Fields().ByName("denom")
+	fd_DenomUnit_exponent = md_DenomUnit.Fields().ByName("exponent")
+	fd_DenomUnit_aliases = md_DenomUnit.Fields().ByName("aliases")
+}
+
+var _ protoreflect.Message = (*fastReflection_DenomUnit)(nil)
+
+type fastReflection_DenomUnit DenomUnit
+
+func (x *DenomUnit) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_DenomUnit)(x)
+}
+
+func (x *DenomUnit) slowProtoReflect() protoreflect.Message {
+	mi := &file_cosmos_bank_v1beta1_bank_proto_msgTypes[7]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_DenomUnit_messageType fastReflection_DenomUnit_messageType
+var _ protoreflect.MessageType = fastReflection_DenomUnit_messageType{}
+
+type fastReflection_DenomUnit_messageType struct{}
+
+func (x fastReflection_DenomUnit_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_DenomUnit)(nil)
+}
+func (x fastReflection_DenomUnit_messageType) New() protoreflect.Message {
+	return new(fastReflection_DenomUnit)
+}
+func (x fastReflection_DenomUnit_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_DenomUnit
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_DenomUnit) Descriptor() protoreflect.MessageDescriptor {
+	return md_DenomUnit
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_DenomUnit) Type() protoreflect.MessageType {
+	return _fastReflection_DenomUnit_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_DenomUnit) New() protoreflect.Message {
+	return new(fastReflection_DenomUnit)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_DenomUnit) Interface() protoreflect.ProtoMessage {
+	return (*DenomUnit)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_DenomUnit) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Denom != "" {
+		value := protoreflect.ValueOfString(x.Denom)
+		if !f(fd_DenomUnit_denom, value) {
+			return
+		}
+	}
+	if x.Exponent != uint32(0) {
+		value := protoreflect.ValueOfUint32(x.Exponent)
+		if !f(fd_DenomUnit_exponent, value) {
+			return
+		}
+	}
+	if len(x.Aliases) != 0 {
+		value := protoreflect.ValueOfList(&_DenomUnit_3_list{list: &x.Aliases})
+		if !f(fd_DenomUnit_aliases, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_DenomUnit) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "cosmos.bank.v1beta1.DenomUnit.denom":
+		return x.Denom != ""
+	case "cosmos.bank.v1beta1.DenomUnit.exponent":
+		return x.Exponent != uint32(0)
+	case "cosmos.bank.v1beta1.DenomUnit.aliases":
+		return len(x.Aliases) != 0
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.bank.v1beta1.DenomUnit"))
+		}
+		panic(fmt.Errorf("message cosmos.bank.v1beta1.DenomUnit does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_DenomUnit) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "cosmos.bank.v1beta1.DenomUnit.denom":
+		x.Denom = ""
+	case "cosmos.bank.v1beta1.DenomUnit.exponent":
+		x.Exponent = uint32(0)
+	case "cosmos.bank.v1beta1.DenomUnit.aliases":
+		x.Aliases = nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.bank.v1beta1.DenomUnit"))
+		}
+		panic(fmt.Errorf("message cosmos.bank.v1beta1.DenomUnit does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_DenomUnit) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "cosmos.bank.v1beta1.DenomUnit.denom":
+		value := x.Denom
+		return protoreflect.ValueOfString(value)
+	case "cosmos.bank.v1beta1.DenomUnit.exponent":
+		value := x.Exponent
+		return protoreflect.ValueOfUint32(value)
+	case "cosmos.bank.v1beta1.DenomUnit.aliases":
+		if len(x.Aliases) == 0 {
+			return protoreflect.ValueOfList(&_DenomUnit_3_list{})
+		}
+		listValue := &_DenomUnit_3_list{list: &x.Aliases}
+		return protoreflect.ValueOfList(listValue)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.bank.v1beta1.DenomUnit"))
+		}
+		panic(fmt.Errorf("message cosmos.bank.v1beta1.DenomUnit does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_DenomUnit) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "cosmos.bank.v1beta1.DenomUnit.denom":
+		x.Denom = value.Interface().(string)
+	case "cosmos.bank.v1beta1.DenomUnit.exponent":
+		x.Exponent = uint32(value.Uint())
+	case "cosmos.bank.v1beta1.DenomUnit.aliases":
+		lv := value.List()
+		clv := lv.(*_DenomUnit_3_list)
+		x.Aliases = *clv.list
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.bank.v1beta1.DenomUnit"))
+		}
+		panic(fmt.Errorf("message cosmos.bank.v1beta1.DenomUnit does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_DenomUnit) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "cosmos.bank.v1beta1.DenomUnit.aliases":
+		if x.Aliases == nil {
+			x.Aliases = []string{}
+		}
+		value := &_DenomUnit_3_list{list: &x.Aliases}
+		return protoreflect.ValueOfList(value)
+	case "cosmos.bank.v1beta1.DenomUnit.denom":
+		panic(fmt.Errorf("field denom of message cosmos.bank.v1beta1.DenomUnit is not mutable"))
+	case "cosmos.bank.v1beta1.DenomUnit.exponent":
+		panic(fmt.Errorf("field exponent of message cosmos.bank.v1beta1.DenomUnit is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.bank.v1beta1.DenomUnit"))
+		}
+		panic(fmt.Errorf("message cosmos.bank.v1beta1.DenomUnit does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_DenomUnit) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "cosmos.bank.v1beta1.DenomUnit.denom":
+		return protoreflect.ValueOfString("")
+	case "cosmos.bank.v1beta1.DenomUnit.exponent":
+		return protoreflect.ValueOfUint32(uint32(0))
+	case "cosmos.bank.v1beta1.DenomUnit.aliases":
+		list := []string{}
+		return protoreflect.ValueOfList(&_DenomUnit_3_list{list: &list})
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.bank.v1beta1.DenomUnit"))
+		}
+		panic(fmt.Errorf("message cosmos.bank.v1beta1.DenomUnit does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_DenomUnit) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in cosmos.bank.v1beta1.DenomUnit", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_DenomUnit) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_DenomUnit) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_DenomUnit) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_DenomUnit) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*DenomUnit)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		l = len(x.Denom)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.Exponent != 0 {
+			n += 1 + runtime.Sov(uint64(x.Exponent))
+		}
+		if len(x.Aliases) > 0 {
+			for _, s := range x.Aliases {
+				l = len(s)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*DenomUnit)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if len(x.Aliases) > 0 {
+			for iNdEx := len(x.Aliases) - 1; iNdEx >= 0; iNdEx-- {
+				i -= len(x.Aliases[iNdEx])
+				copy(dAtA[i:], x.Aliases[iNdEx])
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Aliases[iNdEx])))
+				i--
+				dAtA[i] = 0x1a
+			}
+		}
+		if x.Exponent != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.Exponent))
+			i--
+			dAtA[i] = 0x10
+		}
+		if len(x.Denom) > 0 {
+			i -= len(x.Denom)
+			copy(dAtA[i:], x.Denom)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Denom)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*DenomUnit)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: DenomUnit: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: DenomUnit: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Denom = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 2:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Exponent", wireType)
+				}
+				x.Exponent = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.Exponent |= uint32(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 3:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Aliases", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Aliases = append(x.Aliases, string(dAtA[iNdEx:postIndex]))
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var _ protoreflect.List = (*_Metadata_2_list)(nil)
+
+type _Metadata_2_list struct {
+	list *[]*DenomUnit
+}
+
+func (x *_Metadata_2_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_Metadata_2_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_Metadata_2_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*DenomUnit)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_Metadata_2_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*DenomUnit)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_Metadata_2_list) AppendMutable() protoreflect.Value {
+	v := new(DenomUnit)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_Metadata_2_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_Metadata_2_list) NewElement() protoreflect.Value {
+	v := new(DenomUnit)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_Metadata_2_list) IsValid() bool {
+	return x.list != nil
+}
+
+var (
+	md_Metadata             protoreflect.MessageDescriptor
+	fd_Metadata_description protoreflect.FieldDescriptor
+	fd_Metadata_denom_units protoreflect.FieldDescriptor
+	fd_Metadata_base        protoreflect.FieldDescriptor
+	fd_Metadata_display     protoreflect.FieldDescriptor
+	fd_Metadata_name        protoreflect.FieldDescriptor
+	fd_Metadata_symbol      protoreflect.FieldDescriptor
+	fd_Metadata_uri         protoreflect.FieldDescriptor
+	fd_Metadata_uri_hash    protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_cosmos_bank_v1beta1_bank_proto_init()
+	md_Metadata = File_cosmos_bank_v1beta1_bank_proto.Messages().ByName("Metadata")
+	fd_Metadata_description = md_Metadata.Fields().ByName("description")
+	fd_Metadata_denom_units = md_Metadata.Fields().ByName("denom_units")
+	fd_Metadata_base = md_Metadata.Fields().ByName("base")
+	fd_Metadata_display = md_Metadata.Fields().ByName("display")
+	fd_Metadata_name = md_Metadata.Fields().ByName("name")
+	fd_Metadata_symbol = md_Metadata.Fields().ByName("symbol")
+	fd_Metadata_uri = md_Metadata.Fields().ByName("uri")
+	fd_Metadata_uri_hash = md_Metadata.Fields().ByName("uri_hash")
+}
+
+var _ protoreflect.Message = (*fastReflection_Metadata)(nil)
+
+type fastReflection_Metadata Metadata
+
+func (x *Metadata) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_Metadata)(x)
+}
+
+func (x *Metadata) slowProtoReflect() protoreflect.Message {
+	mi := &file_cosmos_bank_v1beta1_bank_proto_msgTypes[8]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_Metadata_messageType fastReflection_Metadata_messageType
+var _ protoreflect.MessageType = fastReflection_Metadata_messageType{}
+
+type fastReflection_Metadata_messageType struct{}
+
+func (x fastReflection_Metadata_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_Metadata)(nil)
+}
+func (x fastReflection_Metadata_messageType) New() protoreflect.Message {
+	return new(fastReflection_Metadata)
+}
+func (x fastReflection_Metadata_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_Metadata
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_Metadata) Descriptor() protoreflect.MessageDescriptor {
+	return md_Metadata
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_Metadata) Type() protoreflect.MessageType {
+	return _fastReflection_Metadata_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_Metadata) New() protoreflect.Message {
+	return new(fastReflection_Metadata)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_Metadata) Interface() protoreflect.ProtoMessage {
+	return (*Metadata)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_Metadata) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Description != "" {
+		value := protoreflect.ValueOfString(x.Description)
+		if !f(fd_Metadata_description, value) {
+			return
+		}
+	}
+	if len(x.DenomUnits) != 0 {
+		value := protoreflect.ValueOfList(&_Metadata_2_list{list: &x.DenomUnits})
+		if !f(fd_Metadata_denom_units, value) {
+			return
+		}
+	}
+	if x.Base != "" {
+		value := protoreflect.ValueOfString(x.Base)
+		if !f(fd_Metadata_base, value) {
+			return
+		}
+	}
+	if x.Display != "" {
+		value := protoreflect.ValueOfString(x.Display)
+		if !f(fd_Metadata_display, value) {
+			return
+		}
+	}
+	if x.Name != "" {
+		value := protoreflect.ValueOfString(x.Name)
+		if !f(fd_Metadata_name, value) {
+			return
+		}
+	}
+	if x.Symbol != "" {
+		value := protoreflect.ValueOfString(x.Symbol)
+		if !f(fd_Metadata_symbol, value) {
+			return
+		}
+	}
+	if x.Uri != "" {
+		value := protoreflect.ValueOfString(x.Uri)
+		if !f(fd_Metadata_uri, value) {
+			return
+		}
+	}
+	if x.UriHash != "" {
+		value := protoreflect.ValueOfString(x.UriHash)
+		if !f(fd_Metadata_uri_hash, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_Metadata) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "cosmos.bank.v1beta1.Metadata.description":
+		return x.Description != ""
+	case "cosmos.bank.v1beta1.Metadata.denom_units":
+		return len(x.DenomUnits) != 0
+	case "cosmos.bank.v1beta1.Metadata.base":
+		return x.Base != ""
+	case "cosmos.bank.v1beta1.Metadata.display":
+		return x.Display != ""
+	case "cosmos.bank.v1beta1.Metadata.name":
+		return x.Name != ""
+	case "cosmos.bank.v1beta1.Metadata.symbol":
+		return x.Symbol != ""
+	case "cosmos.bank.v1beta1.Metadata.uri":
+		return x.Uri != ""
+	case "cosmos.bank.v1beta1.Metadata.uri_hash":
+		return x.UriHash != ""
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.bank.v1beta1.Metadata"))
+		}
+		panic(fmt.Errorf("message cosmos.bank.v1beta1.Metadata does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_Metadata) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "cosmos.bank.v1beta1.Metadata.description":
+		x.Description = ""
+	case "cosmos.bank.v1beta1.Metadata.denom_units":
+		x.DenomUnits = nil
+	case "cosmos.bank.v1beta1.Metadata.base":
+		x.Base = ""
+	case "cosmos.bank.v1beta1.Metadata.display":
+		x.Display = ""
+	case "cosmos.bank.v1beta1.Metadata.name":
+		x.Name = ""
+	case "cosmos.bank.v1beta1.Metadata.symbol":
+		x.Symbol = ""
+	case "cosmos.bank.v1beta1.Metadata.uri":
+		x.Uri = ""
+	case "cosmos.bank.v1beta1.Metadata.uri_hash":
+		x.UriHash = ""
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.bank.v1beta1.Metadata"))
+		}
+		panic(fmt.Errorf("message cosmos.bank.v1beta1.Metadata does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_Metadata) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "cosmos.bank.v1beta1.Metadata.description":
+		value := x.Description
+		return protoreflect.ValueOfString(value)
+	case "cosmos.bank.v1beta1.Metadata.denom_units":
+		if len(x.DenomUnits) == 0 {
+			return protoreflect.ValueOfList(&_Metadata_2_list{})
+		}
+		listValue := &_Metadata_2_list{list: &x.DenomUnits}
+		return protoreflect.ValueOfList(listValue)
+	case "cosmos.bank.v1beta1.Metadata.base":
+		value := x.Base
+		return protoreflect.ValueOfString(value)
+	case "cosmos.bank.v1beta1.Metadata.display":
+		value := x.Display
+		return protoreflect.ValueOfString(value)
+	case "cosmos.bank.v1beta1.Metadata.name":
+		value := x.Name
+		return protoreflect.ValueOfString(value)
+	case "cosmos.bank.v1beta1.Metadata.symbol":
+		value := x.Symbol
+		return protoreflect.ValueOfString(value)
+	case "cosmos.bank.v1beta1.Metadata.uri":
+		value := x.Uri
+		return protoreflect.ValueOfString(value)
+	case "cosmos.bank.v1beta1.Metadata.uri_hash":
+		value := x.UriHash
+		return protoreflect.ValueOfString(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.bank.v1beta1.Metadata"))
+		}
+		panic(fmt.Errorf("message cosmos.bank.v1beta1.Metadata does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_Metadata) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "cosmos.bank.v1beta1.Metadata.description":
+		x.Description = value.Interface().(string)
+	case "cosmos.bank.v1beta1.Metadata.denom_units":
+		lv := value.List()
+		clv := lv.(*_Metadata_2_list)
+		x.DenomUnits = *clv.list
+	case "cosmos.bank.v1beta1.Metadata.base":
+		x.Base = value.Interface().(string)
+	case "cosmos.bank.v1beta1.Metadata.display":
+		x.Display = value.Interface().(string)
+	case "cosmos.bank.v1beta1.Metadata.name":
+		x.Name = value.Interface().(string)
+	case "cosmos.bank.v1beta1.Metadata.symbol":
+		x.Symbol = value.Interface().(string)
+	case "cosmos.bank.v1beta1.Metadata.uri":
+		x.Uri = value.Interface().(string)
+	case "cosmos.bank.v1beta1.Metadata.uri_hash":
+		x.UriHash = value.Interface().(string)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.bank.v1beta1.Metadata"))
+		}
+		panic(fmt.Errorf("message cosmos.bank.v1beta1.Metadata does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_Metadata) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "cosmos.bank.v1beta1.Metadata.denom_units":
+		if x.DenomUnits == nil {
+			x.DenomUnits = []*DenomUnit{}
+		}
+		value := &_Metadata_2_list{list: &x.DenomUnits}
+		return protoreflect.ValueOfList(value)
+	case "cosmos.bank.v1beta1.Metadata.description":
+		panic(fmt.Errorf("field description of message cosmos.bank.v1beta1.Metadata is not mutable"))
+	case "cosmos.bank.v1beta1.Metadata.base":
+		panic(fmt.Errorf("field base of message cosmos.bank.v1beta1.Metadata is not mutable"))
+	case "cosmos.bank.v1beta1.Metadata.display":
+		panic(fmt.Errorf("field display of message cosmos.bank.v1beta1.Metadata is not mutable"))
+	case "cosmos.bank.v1beta1.Metadata.name":
+		panic(fmt.Errorf("field name of message cosmos.bank.v1beta1.Metadata is not mutable"))
+	case "cosmos.bank.v1beta1.Metadata.symbol":
+		panic(fmt.Errorf("field symbol of message cosmos.bank.v1beta1.Metadata is not mutable"))
+	case "cosmos.bank.v1beta1.Metadata.uri":
+		panic(fmt.Errorf("field uri of message cosmos.bank.v1beta1.Metadata is not mutable"))
+	case "cosmos.bank.v1beta1.Metadata.uri_hash":
+		panic(fmt.Errorf("field uri_hash of message cosmos.bank.v1beta1.Metadata is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.bank.v1beta1.Metadata"))
+		}
+		panic(fmt.Errorf("message cosmos.bank.v1beta1.Metadata does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_Metadata) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "cosmos.bank.v1beta1.Metadata.description":
+		return protoreflect.ValueOfString("")
+	case "cosmos.bank.v1beta1.Metadata.denom_units":
+		list := []*DenomUnit{}
+		return protoreflect.ValueOfList(&_Metadata_2_list{list: &list})
+	case "cosmos.bank.v1beta1.Metadata.base":
+		return protoreflect.ValueOfString("")
+	case "cosmos.bank.v1beta1.Metadata.display":
+		return protoreflect.ValueOfString("")
+	case "cosmos.bank.v1beta1.Metadata.name":
+		return protoreflect.ValueOfString("")
+	case "cosmos.bank.v1beta1.Metadata.symbol":
+		return protoreflect.ValueOfString("")
+	case "cosmos.bank.v1beta1.Metadata.uri":
+		return protoreflect.ValueOfString("")
+	case "cosmos.bank.v1beta1.Metadata.uri_hash":
+		return protoreflect.ValueOfString("")
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.bank.v1beta1.Metadata"))
+		}
+		panic(fmt.Errorf("message cosmos.bank.v1beta1.Metadata does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_Metadata) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in cosmos.bank.v1beta1.Metadata", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_Metadata) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_Metadata) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_Metadata) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_Metadata) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*Metadata)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		l = len(x.Description)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if len(x.DenomUnits) > 0 {
+			for _, e := range x.DenomUnits {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		l = len(x.Base)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.Display)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.Name)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.Symbol)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.Uri)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.UriHash)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*Metadata)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if len(x.UriHash) > 0 {
+			i -= len(x.UriHash)
+			copy(dAtA[i:], x.UriHash)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.UriHash)))
+			i--
+			dAtA[i] = 0x42
+		}
+		if len(x.Uri) > 0 {
+			i -= len(x.Uri)
+			copy(dAtA[i:], x.Uri)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Uri)))
+			i--
+			dAtA[i] = 0x3a
+		}
+		if len(x.Symbol) > 0 {
+			i -= len(x.Symbol)
+			copy(dAtA[i:], x.Symbol)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Symbol)))
+			i--
+			dAtA[i] = 0x32
+		}
+		if len(x.Name) > 0 {
+			i -= len(x.Name)
+			copy(dAtA[i:], x.Name)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Name)))
+			i--
+			dAtA[i] = 0x2a
+		}
+		if len(x.Display) > 0 {
+			i -= len(x.Display)
+			copy(dAtA[i:], x.Display)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Display)))
+			i--
+			dAtA[i] = 0x22
+		}
+		if len(x.Base) > 0 {
+			i -= len(x.Base)
+			copy(dAtA[i:], x.Base)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Base)))
+			i--
+			dAtA[i] = 0x1a
+		}
+		if len(x.DenomUnits) > 0 {
+			for iNdEx := len(x.DenomUnits) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.DenomUnits[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x12
+			}
+		}
+		if len(x.Description) > 0 {
+			i -= len(x.Description)
+			copy(dAtA[i:], x.Description)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Description)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*Metadata)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: Metadata: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: Metadata: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Description = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field DenomUnits", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.DenomUnits = append(x.DenomUnits, &DenomUnit{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.DenomUnits[len(x.DenomUnits)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 3:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Base", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Base = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 4:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Display", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Display = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 5:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Name = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 6:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Symbol", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Symbol = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 7:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Uri", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Uri = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 8:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field UriHash", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.UriHash = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var _ protoreflect.List = (*_Hold_3_list)(nil)
+
+type _Hold_3_list struct {
+	list *[]*v1beta1.Coin
+}
+
+func (x *_Hold_3_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_Hold_3_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_Hold_3_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*v1beta1.Coin)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_Hold_3_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*v1beta1.Coin)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_Hold_3_list) AppendMutable() protoreflect.Value {
+	v := new(v1beta1.Coin)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_Hold_3_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_Hold_3_list) NewElement() protoreflect.Value {
+	v := new(v1beta1.Coin)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_Hold_3_list) IsValid() bool {
+	return x.list != nil
+}
+
+var (
+	md_Hold            protoreflect.MessageDescriptor
+	fd_Hold_address    protoreflect.FieldDescriptor
+	fd_Hold_holder     protoreflect.FieldDescriptor
+	fd_Hold_amount     protoreflect.FieldDescriptor
+	fd_Hold_expiration protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_cosmos_bank_v1beta1_bank_proto_init()
+	md_Hold = File_cosmos_bank_v1beta1_bank_proto.Messages().ByName("Hold")
+	fd_Hold_address = md_Hold.Fields().ByName("address")
+	fd_Hold_holder = md_Hold.Fields().ByName("holder")
+	fd_Hold_amount = md_Hold.Fields().ByName("amount")
+	fd_Hold_expiration = md_Hold.Fields().ByName("expiration")
+}
+
+var _ protoreflect.Message = (*fastReflection_Hold)(nil)
+
+type fastReflection_Hold Hold
+
+func (x *Hold) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_Hold)(x)
+}
+
+func (x *Hold) slowProtoReflect() protoreflect.Message {
+	mi := &file_cosmos_bank_v1beta1_bank_proto_msgTypes[9]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_Hold_messageType fastReflection_Hold_messageType
+var _ protoreflect.MessageType = fastReflection_Hold_messageType{}
+
+type fastReflection_Hold_messageType struct{}
+
+func (x fastReflection_Hold_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_Hold)(nil)
+}
+func (x fastReflection_Hold_messageType) New() protoreflect.Message {
+	return new(fastReflection_Hold)
+}
+func (x fastReflection_Hold_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_Hold
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_Hold) Descriptor() protoreflect.MessageDescriptor {
+	return md_Hold
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_Hold) Type() protoreflect.MessageType {
+	return _fastReflection_Hold_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_Hold) New() protoreflect.Message {
+	return new(fastReflection_Hold)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_Hold) Interface() protoreflect.ProtoMessage {
+	return (*Hold)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_Hold) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Address != "" {
+		value := protoreflect.ValueOfString(x.Address)
+		if !f(fd_Hold_address, value) {
+			return
+		}
+	}
+	if x.Holder != "" {
+		value := protoreflect.ValueOfString(x.Holder)
+		if !f(fd_Hold_holder, value) {
+			return
+		}
+	}
+	if len(x.Amount) != 0 {
+		value := protoreflect.ValueOfList(&_Hold_3_list{list: &x.Amount})
+		if !f(fd_Hold_amount, value) {
+			return
+		}
+	}
+	if x.Expiration != nil {
+		value := protoreflect.ValueOfMessage(x.Expiration.ProtoReflect())
+		if !f(fd_Hold_expiration, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_Hold) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "cosmos.bank.v1beta1.Hold.address":
+		return x.Address != ""
+	case "cosmos.bank.v1beta1.Hold.holder":
+		return x.Holder != ""
+	case "cosmos.bank.v1beta1.Hold.amount":
+		return len(x.Amount) != 0
+	case "cosmos.bank.v1beta1.Hold.expiration":
+		return x.Expiration != nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.bank.v1beta1.Hold"))
+		}
+		panic(fmt.Errorf("message cosmos.bank.v1beta1.Hold does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_Hold) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "cosmos.bank.v1beta1.Hold.address":
+		x.Address = ""
+	case "cosmos.bank.v1beta1.Hold.holder":
+		x.Holder = ""
+	case "cosmos.bank.v1beta1.Hold.amount":
+		x.Amount = nil
+	case "cosmos.bank.v1beta1.Hold.expiration":
+		x.Expiration = nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.bank.v1beta1.Hold"))
+		}
+		panic(fmt.Errorf("message cosmos.bank.v1beta1.Hold does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_Hold) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "cosmos.bank.v1beta1.Hold.address":
+		value := x.Address
+		return protoreflect.ValueOfString(value)
+	case "cosmos.bank.v1beta1.Hold.holder":
+		value := x.Holder
+		return protoreflect.ValueOfString(value)
+	case "cosmos.bank.v1beta1.Hold.amount":
+		if len(x.Amount) == 0 {
+			return protoreflect.ValueOfList(&_Hold_3_list{})
+		}
+		listValue := &_Hold_3_list{list: &x.Amount}
+		return protoreflect.ValueOfList(listValue)
+	case "cosmos.bank.v1beta1.Hold.expiration":
+		value := x.Expiration
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.bank.v1beta1.Hold"))
+		}
+		panic(fmt.Errorf("message cosmos.bank.v1beta1.Hold does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_Hold) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "cosmos.bank.v1beta1.Hold.address":
+		x.Address = value.Interface().(string)
+	case "cosmos.bank.v1beta1.Hold.holder":
+		x.Holder = value.Interface().(string)
+	case "cosmos.bank.v1beta1.Hold.amount":
+		lv := value.List()
+		clv := lv.(*_Hold_3_list)
+		x.Amount = *clv.list
+	case "cosmos.bank.v1beta1.Hold.expiration":
+		x.Expiration = value.Message().Interface().(*timestamppb.Timestamp)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.bank.v1beta1.Hold"))
+		}
+		panic(fmt.Errorf("message cosmos.bank.v1beta1.Hold does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_Hold) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "cosmos.bank.v1beta1.Hold.amount":
+		if x.Amount == nil {
+			x.Amount = []*v1beta1.Coin{}
+		}
+		value := &_Hold_3_list{list: &x.Amount}
+		return protoreflect.ValueOfList(value)
+	case "cosmos.bank.v1beta1.Hold.expiration":
+		if x.Expiration == nil {
+			x.Expiration = new(timestamppb.Timestamp)
+		}
+		return protoreflect.ValueOfMessage(x.Expiration.ProtoReflect())
+	case "cosmos.bank.v1beta1.Hold.address":
+		panic(fmt.Errorf("field address of message cosmos.bank.v1beta1.Hold is not mutable"))
+	case "cosmos.bank.v1beta1.Hold.holder":
+		panic(fmt.Errorf("field holder of message cosmos.bank.v1beta1.Hold is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.bank.v1beta1.Hold"))
+		}
+		panic(fmt.Errorf("message cosmos.bank.v1beta1.Hold does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_Hold) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "cosmos.bank.v1beta1.Hold.address":
+		return protoreflect.ValueOfString("")
+	case "cosmos.bank.v1beta1.Hold.holder":
+		return protoreflect.ValueOfString("")
+	case "cosmos.bank.v1beta1.Hold.amount":
+		list := []*v1beta1.Coin{}
+		return protoreflect.ValueOfList(&_Hold_3_list{list: &list})
+	case "cosmos.bank.v1beta1.Hold.expiration":
+		m := new(timestamppb.Timestamp)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.bank.v1beta1.Hold"))
+		}
+		panic(fmt.Errorf("message cosmos.bank.v1beta1.Hold does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_Hold) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in cosmos.bank.v1beta1.Hold", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_Hold) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_Hold) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_Hold) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_Hold) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*Hold)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		l = len(x.Address)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.Holder)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if len(x.Amount) > 0 {
+			for _, e := range x.Amount {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if x.Expiration != nil {
+			l = options.Size(x.Expiration)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*Hold)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if x.Expiration != nil {
+			encoded, err := options.Marshal(x.Expiration)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x22
+		}
+		if len(x.Amount) > 0 {
+			for iNdEx := len(x.Amount) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.Amount[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x1a
+			}
+		}
+		if len(x.Holder) > 0 {
+			i -= len(x.Holder)
+			copy(dAtA[i:], x.Holder)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Holder)))
+			i--
+			dAtA[i] = 0x12
+		}
+		if len(x.Address) > 0 {
+			i -= len(x.Address)
+			copy(dAtA[i:], x.Address)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Address)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*Hold)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: Hold: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: Hold: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Address = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Holder", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Holder = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 3:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Amount = append(x.Amount, &v1beta1.Coin{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Amount[len(x.Amount)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 4:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Expiration", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.Expiration == nil {
+					x.Expiration = &timestamppb.Timestamp{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Expiration); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_BalanceSnapshot        protoreflect.MessageDescriptor
+	fd_BalanceSnapshot_id     protoreflect.FieldDescriptor
+	fd_BalanceSnapshot_denom  protoreflect.FieldDescriptor
+	fd_BalanceSnapshot_height protoreflect.FieldDescriptor
+	fd_BalanceSnapshot_taken  protoreflect.FieldDescriptor
+	fd_BalanceSnapshot_root   protoreflect.FieldDescriptor
+	fd_BalanceSnapshot_count  protoreflect.FieldDescriptor
+	fd_BalanceSnapshot_total  protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_cosmos_bank_v1beta1_bank_proto_init()
+	md_BalanceSnapshot = File_cosmos_bank_v1beta1_bank_proto.Messages().ByName("BalanceSnapshot")
+	fd_BalanceSnapshot_id = md_BalanceSnapshot.Fields().ByName("id")
+	fd_BalanceSnapshot_denom = md_BalanceSnapshot.Fields().ByName("denom")
+	fd_BalanceSnapshot_height = md_BalanceSnapshot.Fields().ByName("height")
+	fd_BalanceSnapshot_taken = md_BalanceSnapshot.Fields().ByName("taken")
+	fd_BalanceSnapshot_root = md_BalanceSnapshot.Fields().ByName("root")
+	fd_BalanceSnapshot_count = md_BalanceSnapshot.Fields().ByName("count")
+	fd_BalanceSnapshot_total = md_BalanceSnapshot.Fields().ByName("total")
+}
+
+var _ protoreflect.Message = (*fastReflection_BalanceSnapshot)(nil)
+
+type fastReflection_BalanceSnapshot BalanceSnapshot
+
+func (x *BalanceSnapshot) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_BalanceSnapshot)(x)
+}
+
+func (x *BalanceSnapshot) slowProtoReflect() protoreflect.Message {
+	mi := &file_cosmos_bank_v1beta1_bank_proto_msgTypes[10]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_BalanceSnapshot_messageType fastReflection_BalanceSnapshot_messageType
+var _ protoreflect.MessageType = fastReflection_BalanceSnapshot_messageType{}
+
+type fastReflection_BalanceSnapshot_messageType struct{}
+
+func (x fastReflection_BalanceSnapshot_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_BalanceSnapshot)(nil)
+}
+func (x fastReflection_BalanceSnapshot_messageType) New() protoreflect.Message {
+	return new(fastReflection_BalanceSnapshot)
+}
+func (x fastReflection_BalanceSnapshot_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_BalanceSnapshot
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_BalanceSnapshot) Descriptor() protoreflect.MessageDescriptor {
+	return md_BalanceSnapshot
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_BalanceSnapshot) Type() protoreflect.MessageType {
+	return _fastReflection_BalanceSnapshot_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_BalanceSnapshot) New() protoreflect.Message {
+	return new(fastReflection_BalanceSnapshot)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_BalanceSnapshot) Interface() protoreflect.ProtoMessage {
+	return (*BalanceSnapshot)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_BalanceSnapshot) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Id != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.Id)
+		if !f(fd_BalanceSnapshot_id, value) {
+			return
+		}
+	}
+	if x.Denom != "" {
+		value := protoreflect.ValueOfString(x.Denom)
+		if !f(fd_BalanceSnapshot_denom, value) {
+			return
+		}
+	}
+	if x.Height != int64(0) {
+		value := protoreflect.ValueOfInt64(x.Height)
+		if !f(fd_BalanceSnapshot_height, value) {
+			return
+		}
+	}
+	if x.Taken != false {
+		value := protoreflect.ValueOfBool(x.Taken)
+		if !f(fd_BalanceSnapshot_taken, value) {
+			return
+		}
+	}
+	if len(x.Root) != 0 {
+		value := protoreflect.ValueOfBytes(x.Root)
+		if !f(fd_BalanceSnapshot_root, value) {
+			return
+		}
+	}
+	if x.Count != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.Count)
+		if !f(fd_BalanceSnapshot_count, value) {
+			return
+		}
+	}
+	if x.Total != "" {
+		value := protoreflect.ValueOfString(x.Total)
+		if !f(fd_BalanceSnapshot_total, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_BalanceSnapshot) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "cosmos.bank.v1beta1.BalanceSnapshot.id":
+		return x.Id != uint64(0)
+	case "cosmos.bank.v1beta1.BalanceSnapshot.denom":
+		return x.Denom != ""
+	case "cosmos.bank.v1beta1.BalanceSnapshot.height":
+		return x.Height != int64(0)
+	case "cosmos.bank.v1beta1.BalanceSnapshot.taken":
+		return x.Taken != false
+	case "cosmos.bank.v1beta1.BalanceSnapshot.root":
+		return len(x.Root) != 0
+	case "cosmos.bank.v1beta1.BalanceSnapshot.count":
+		return x.Count != uint64(0)
+	case "cosmos.bank.v1beta1.BalanceSnapshot.total":
+		return x.Total != ""
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.bank.v1beta1.BalanceSnapshot"))
+		}
+		panic(fmt.Errorf("message cosmos.bank.v1beta1.BalanceSnapshot does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_BalanceSnapshot) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "cosmos.bank.v1beta1.BalanceSnapshot.id":
+		x.Id = uint64(0)
+	case "cosmos.bank.v1beta1.BalanceSnapshot.denom":
+		x.Denom = ""
+	case "cosmos.bank.v1beta1.BalanceSnapshot.height":
+		x.Height = int64(0)
+	case "cosmos.bank.v1beta1.BalanceSnapshot.taken":
+		x.Taken = false
+	case "cosmos.bank.v1beta1.BalanceSnapshot.root":
+		x.Root = nil
+	case "cosmos.bank.v1beta1.BalanceSnapshot.count":
+		x.Count = uint64(0)
+	case "cosmos.bank.v1beta1.BalanceSnapshot.total":
+		x.Total = ""
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.bank.v1beta1.BalanceSnapshot"))
+		}
+		panic(fmt.Errorf("message cosmos.bank.v1beta1.BalanceSnapshot does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_BalanceSnapshot) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "cosmos.bank.v1beta1.BalanceSnapshot.id":
+		value := x.Id
+		return protoreflect.ValueOfUint64(value)
+	case "cosmos.bank.v1beta1.BalanceSnapshot.denom":
+		value := x.Denom
+		return protoreflect.ValueOfString(value)
+	case "cosmos.bank.v1beta1.BalanceSnapshot.height":
+		value := x.Height
+		return protoreflect.ValueOfInt64(value)
+	case "cosmos.bank.v1beta1.BalanceSnapshot.taken":
+		value := x.Taken
+		return protoreflect.ValueOfBool(value)
+	case "cosmos.bank.v1beta1.BalanceSnapshot.root":
+		value := x.Root
+		return protoreflect.ValueOfBytes(value)
+	case "cosmos.bank.v1beta1.BalanceSnapshot.count":
+		value := x.Count
+		return protoreflect.ValueOfUint64(value)
+	case "cosmos.bank.v1beta1.BalanceSnapshot.total":
+		value := x.Total
+		return protoreflect.ValueOfString(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.bank.v1beta1.BalanceSnapshot"))
+		}
+		panic(fmt.Errorf("message cosmos.bank.v1beta1.BalanceSnapshot does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_BalanceSnapshot) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "cosmos.bank.v1beta1.BalanceSnapshot.id":
+		x.Id = value.Uint()
+	case "cosmos.bank.v1beta1.BalanceSnapshot.denom":
+		x.Denom = value.Interface().(string)
+	case "cosmos.bank.v1beta1.BalanceSnapshot.height":
+		x.Height = value.Int()
+	case "cosmos.bank.v1beta1.BalanceSnapshot.taken":
+		x.Taken = value.Bool()
+	case "cosmos.bank.v1beta1.BalanceSnapshot.root":
+		x.Root = value.Bytes()
+	case "cosmos.bank.v1beta1.BalanceSnapshot.count":
+		x.Count = value.Uint()
+	case "cosmos.bank.v1beta1.BalanceSnapshot.total":
+		x.Total = value.Interface().(string)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.bank.v1beta1.BalanceSnapshot"))
+		}
+		panic(fmt.Errorf("message cosmos.bank.v1beta1.BalanceSnapshot does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_BalanceSnapshot) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "cosmos.bank.v1beta1.BalanceSnapshot.id":
+		panic(fmt.Errorf("field id of message cosmos.bank.v1beta1.BalanceSnapshot is not mutable"))
+	case "cosmos.bank.v1beta1.BalanceSnapshot.denom":
+		panic(fmt.Errorf("field denom of message cosmos.bank.v1beta1.BalanceSnapshot is not mutable"))
+	case "cosmos.bank.v1beta1.BalanceSnapshot.height":
+		panic(fmt.Errorf("field height of message cosmos.bank.v1beta1.BalanceSnapshot is not mutable"))
+	case "cosmos.bank.v1beta1.BalanceSnapshot.taken":
+		panic(fmt.Errorf("field taken of message cosmos.bank.v1beta1.BalanceSnapshot is not mutable"))
+	case "cosmos.bank.v1beta1.BalanceSnapshot.root":
+		panic(fmt.Errorf("field root of message cosmos.bank.v1beta1.BalanceSnapshot is not mutable"))
+	case "cosmos.bank.v1beta1.BalanceSnapshot.count":
+		panic(fmt.Errorf("field count of message cosmos.bank.v1beta1.BalanceSnapshot is not mutable"))
+	case "cosmos.bank.v1beta1.BalanceSnapshot.total":
+		panic(fmt.Errorf("field total of message cosmos.bank.v1beta1.BalanceSnapshot is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.bank.v1beta1.BalanceSnapshot"))
+		}
+		panic(fmt.Errorf("message cosmos.bank.v1beta1.BalanceSnapshot does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_BalanceSnapshot) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "cosmos.bank.v1beta1.BalanceSnapshot.id":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "cosmos.bank.v1beta1.BalanceSnapshot.denom":
+		return protoreflect.ValueOfString("")
+	case "cosmos.bank.v1beta1.BalanceSnapshot.height":
+		return protoreflect.ValueOfInt64(int64(0))
+	case "cosmos.bank.v1beta1.BalanceSnapshot.taken":
+		return protoreflect.ValueOfBool(false)
+	case "cosmos.bank.v1beta1.BalanceSnapshot.root":
+		return protoreflect.ValueOfBytes(nil)
+	case "cosmos.bank.v1beta1.BalanceSnapshot.count":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "cosmos.bank.v1beta1.BalanceSnapshot.total":
+		return protoreflect.ValueOfString("")
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.bank.v1beta1.BalanceSnapshot"))
+		}
+		panic(fmt.Errorf("message cosmos.bank.v1beta1.BalanceSnapshot does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_BalanceSnapshot) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in cosmos.bank.v1beta1.BalanceSnapshot", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_BalanceSnapshot) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_BalanceSnapshot) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_BalanceSnapshot) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_BalanceSnapshot) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*BalanceSnapshot)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if x.Id != 0 {
+			n += 1 + runtime.Sov(uint64(x.Id))
+		}
+		l = len(x.Denom)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.Height != 0 {
+			n += 1 + runtime.Sov(uint64(x.Height))
+		}
+		if x.Taken {
+			n += 2
+		}
+		l = len(x.Root)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.Count != 0 {
+			n += 1 + runtime.Sov(uint64(x.Count))
+		}
+		l = len(x.Total)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*BalanceSnapshot)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if len(x.Total) > 0 {
+			i -= len(x.Total)
+			copy(dAtA[i:], x.Total)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Total)))
+			i--
+			dAtA[i] = 0x3a
+		}
+		if x.Count != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.Count))
+			i--
+			dAtA[i] = 0x30
+		}
+		if len(x.Root) > 0 {
+			i -= len(x.Root)
+			copy(dAtA[i:], x.Root)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Root)))
+			i--
+			dAtA[i] = 0x2a
+		}
+		if x.Taken {
+			i--
+			if x.Taken {
+				dAtA[i] = 1
+			} else {
+				dAtA[i] = 0
+			}
+			i--
+			dAtA[i] = 0x20
+		}
+		if x.Height != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.Height))
+			i--
+			dAtA[i] = 0x18
+		}
+		if len(x.Denom) > 0 {
+			i -= len(x.Denom)
+			copy(dAtA[i:], x.Denom)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Denom)))
+			i--
+			dAtA[i] = 0x12
+		}
+		if x.Id != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.Id))
+			i--
+			dAtA[i] = 0x8
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*BalanceSnapshot)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: BalanceSnapshot: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: BalanceSnapshot: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+				}
+				x.Id = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.Id |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Denom = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 3:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+				}
+				x.Height = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.Height |= int64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 4:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Taken", wireType)
+				}
+				var v int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				x.Taken = bool(v != 0)
+			case 5:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Root", wireType)
+				}
+				var byteLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					byteLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if byteLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + byteLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Root = append(x.Root[:0], dAtA[iNdEx:postIndex]...)
+				if x.Root == nil {
+					x.Root = []byte{}
+				}
+				iNdEx = postIndex
+			case 6:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Count", wireType)
+				}
+				x.Count = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.Count |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 7:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Total", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Total = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_SnapshotBalance         protoreflect.MessageDescriptor
+	fd_SnapshotBalance_address protoreflect.FieldDescriptor
+	fd_SnapshotBalance_amount  protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_cosmos_bank_v1beta1_bank_proto_init()
+	md_SnapshotBalance = File_cosmos_bank_v1beta1_bank_proto.Messages().ByName("SnapshotBalance")
+	fd_SnapshotBalance_address = md_SnapshotBalance.Fields().ByName("address")
+	fd_SnapshotBalance_amount = md_SnapshotBalance.Fields().ByName("amount")
+}
+
+var _ protoreflect.Message = (*fastReflection_SnapshotBalance)(nil)
+
+type fastReflection_SnapshotBalance SnapshotBalance
+
+func (x *SnapshotBalance) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_SnapshotBalance)(x)
+}
+
+func (x *SnapshotBalance) slowProtoReflect() protoreflect.Message {
+	mi := &file_cosmos_bank_v1beta1_bank_proto_msgTypes[11]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_SnapshotBalance_messageType fastReflection_SnapshotBalance_messageType
+var _ protoreflect.MessageType = fastReflection_SnapshotBalance_messageType{}
+
+type fastReflection_SnapshotBalance_messageType struct{}
+
+func (x fastReflection_SnapshotBalance_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_SnapshotBalance)(nil)
+}
+func (x fastReflection_SnapshotBalance_messageType) New() protoreflect.Message {
+	return new(fastReflection_SnapshotBalance)
+}
+func (x fastReflection_SnapshotBalance_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_SnapshotBalance
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_SnapshotBalance) Descriptor() protoreflect.MessageDescriptor {
+	return md_SnapshotBalance
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_SnapshotBalance) Type() protoreflect.MessageType {
+	return _fastReflection_SnapshotBalance_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_SnapshotBalance) New() protoreflect.Message {
+	return new(fastReflection_SnapshotBalance)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_SnapshotBalance) Interface() protoreflect.ProtoMessage {
+	return (*SnapshotBalance)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_SnapshotBalance) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Address != "" {
+		value := protoreflect.ValueOfString(x.Address)
+		if !f(fd_SnapshotBalance_address, value) {
+			return
+		}
+	}
+	if x.Amount != "" {
+		value := protoreflect.ValueOfString(x.Amount)
+		if !f(fd_SnapshotBalance_amount, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_SnapshotBalance) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "cosmos.bank.v1beta1.SnapshotBalance.address":
+		return x.Address != ""
+	case "cosmos.bank.v1beta1.SnapshotBalance.amount":
+		return x.Amount != ""
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.bank.v1beta1.SnapshotBalance"))
+		}
+		panic(fmt.Errorf("message cosmos.bank.v1beta1.SnapshotBalance does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_SnapshotBalance) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "cosmos.bank.v1beta1.SnapshotBalance.address":
+		x.Address = ""
+	case "cosmos.bank.v1beta1.SnapshotBalance.amount":
+		x.Amount = ""
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.bank.v1beta1.SnapshotBalance"))
+		}
+		panic(fmt.Errorf("message cosmos.bank.v1beta1.SnapshotBalance does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_SnapshotBalance) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "cosmos.bank.v1beta1.SnapshotBalance.address":
+		value := x.Address
+		return protoreflect.ValueOfString(value)
+	case "cosmos.bank.v1beta1.SnapshotBalance.amount":
+		value := x.Amount
+		return protoreflect.ValueOfString(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.bank.v1beta1.SnapshotBalance"))
+		}
+		panic(fmt.Errorf("message cosmos.bank.v1beta1.SnapshotBalance does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_SnapshotBalance) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "cosmos.bank.v1beta1.SnapshotBalance.address":
+		x.Address = value.Interface().(string)
+	case "cosmos.bank.v1beta1.SnapshotBalance.amount":
+		x.Amount = value.Interface().(string)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.bank.v1beta1.SnapshotBalance"))
+		}
+		panic(fmt.Errorf("message cosmos.bank.v1beta1.SnapshotBalance does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_SnapshotBalance) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "cosmos.bank.v1beta1.SnapshotBalance.address":
+		panic(fmt.Errorf("field address of message cosmos.bank.v1beta1.SnapshotBalance is not mutable"))
+	case "cosmos.bank.v1beta1.SnapshotBalance.amount":
+		panic(fmt.Errorf("field amount of message cosmos.bank.v1beta1.SnapshotBalance is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.bank.v1beta1.SnapshotBalance"))
+		}
+		panic(fmt.Errorf("message cosmos.bank.v1beta1.SnapshotBalance does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_SnapshotBalance) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "cosmos.bank.v1beta1.SnapshotBalance.address":
+		return protoreflect.ValueOfString("")
+	case "cosmos.bank.v1beta1.SnapshotBalance.amount":
+		return protoreflect.ValueOfString("")
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.bank.v1beta1.SnapshotBalance"))
+		}
+		panic(fmt.Errorf("message cosmos.bank.v1beta1.SnapshotBalance does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_SnapshotBalance) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in cosmos.bank.v1beta1.SnapshotBalance", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_SnapshotBalance) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_SnapshotBalance) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_SnapshotBalance) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_SnapshotBalance) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*SnapshotBalance)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		l = len(x.Address)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.Amount)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*SnapshotBalance)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if len(x.Amount) > 0 {
+			i -= len(x.Amount)
+			copy(dAtA[i:], x.Amount)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Amount)))
+			i--
+			dAtA[i] = 0x12
+		}
+		if len(x.Address) > 0 {
+			i -= len(x.Address)
+			copy(dAtA[i:], x.Address)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Address)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*SnapshotBalance)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: SnapshotBalance: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: SnapshotBalance: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Address = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Amount = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var _ protoreflect.List = (*_BalanceSnapshotProof_4_list)(nil)
+
+type _BalanceSnapshotProof_4_list struct {
+	list *[][]byte
+}
+
+func (x *_BalanceSnapshotProof_4_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_BalanceSnapshotProof_4_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfBytes((*x.list)[i])
+}
+
+func (x *_BalanceSnapshotProof_4_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Bytes()
+	concreteValue := valueUnwrapped
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_BalanceSnapshotProof_4_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Bytes()
+	concreteValue := valueUnwrapped
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_BalanceSnapshotProof_4_list) AppendMutable() protoreflect.Value {
+	panic(fmt.Errorf("AppendMutable can not be called on message BalanceSnapshotProof at list field Aunts as it is not of Message kind"))
+}
+
+func (x *_BalanceSnapshotProof_4_list) Truncate(n int) {
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_BalanceSnapshotProof_4_list) NewElement() protoreflect.Value {
+	var v []byte
+	return protoreflect.ValueOfBytes(v)
+}
+
+func (x *_BalanceSnapshotProof_4_list) IsValid() bool {
+	return x.list != nil
+}
+
+var (
+	md_BalanceSnapshotProof           protoreflect.MessageDescriptor
+	fd_BalanceSnapshotProof_total     protoreflect.FieldDescriptor
+	fd_BalanceSnapshotProof_index     protoreflect.FieldDescriptor
+	fd_BalanceSnapshotProof_leaf_hash protoreflect.FieldDescriptor
+	fd_BalanceSnapshotProof_aunts     protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_cosmos_bank_v1beta1_bank_proto_init()
+	md_BalanceSnapshotProof = File_cosmos_bank_v1beta1_bank_proto.Messages().ByName("BalanceSnapshotProof")
+	fd_BalanceSnapshotProof_total = md_BalanceSnapshotProof.Fields().ByName("total")
+	fd_BalanceSnapshotProof_index = md_BalanceSnapshotProof.Fields().ByName("index")
+	fd_BalanceSnapshotProof_leaf_hash = md_BalanceSnapshotProof.Fields().ByName("leaf_hash")
+	fd_BalanceSnapshotProof_aunts = md_BalanceSnapshotProof.Fields().ByName("aunts")
+}
+
+var _ protoreflect.Message = (*fastReflection_BalanceSnapshotProof)(nil)
+
+type fastReflection_BalanceSnapshotProof BalanceSnapshotProof
+
+func (x *BalanceSnapshotProof) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_BalanceSnapshotProof)(x)
+}
+
+func (x *BalanceSnapshotProof) slowProtoReflect() protoreflect.Message {
+	mi := &file_cosmos_bank_v1beta1_bank_proto_msgTypes[12]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_BalanceSnapshotProof_messageType fastReflection_BalanceSnapshotProof_messageType
+var _ protoreflect.MessageType = fastReflection_BalanceSnapshotProof_messageType{}
+
+type fastReflection_BalanceSnapshotProof_messageType struct{}
+
+func (x fastReflection_BalanceSnapshotProof_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_BalanceSnapshotProof)(nil)
+}
+func (x fastReflection_BalanceSnapshotProof_messageType) New() protoreflect.Message {
+	return new(fastReflection_BalanceSnapshotProof)
+}
+func (x fastReflection_BalanceSnapshotProof_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_BalanceSnapshotProof
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_BalanceSnapshotProof) Descriptor() protoreflect.MessageDescriptor {
+	return md_BalanceSnapshotProof
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_BalanceSnapshotProof) Type() protoreflect.MessageType {
+	return _fastReflection_BalanceSnapshotProof_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_BalanceSnapshotProof) New() protoreflect.Message {
+	return new(fastReflection_BalanceSnapshotProof)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_BalanceSnapshotProof) Interface() protoreflect.ProtoMessage {
+	return (*BalanceSnapshotProof)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_BalanceSnapshotProof) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Total != int64(0) {
+		value := protoreflect.ValueOfInt64(x.Total)
+		if !f(fd_BalanceSnapshotProof_total, value) {
+			return
+		}
+	}
+	if x.Index != int64(0) {
+		value := protoreflect.ValueOfInt64(x.Index)
+		if !f(fd_BalanceSnapshotProof_index, value) {
+			return
+		}
+	}
+	if len(x.LeafHash) != 0 {
+		value := protoreflect.ValueOfBytes(x.LeafHash)
+		if !f(fd_BalanceSnapshotProof_leaf_hash, value) {
+			return
+		}
+	}
+	if len(x.Aunts) != 0 {
+		value := protoreflect.ValueOfList(&_BalanceSnapshotProof_4_list{list: &x.Aunts})
+		if !f(fd_BalanceSnapshotProof_aunts, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_BalanceSnapshotProof) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "cosmos.bank.v1beta1.BalanceSnapshotProof.total":
+		return x.Total != int64(0)
+	case "cosmos.bank.v1beta1.BalanceSnapshotProof.index":
+		return x.Index != int64(0)
+	case "cosmos.bank.v1beta1.BalanceSnapshotProof.leaf_hash":
+		return len(x.LeafHash) != 0
+	case "cosmos.bank.v1beta1.BalanceSnapshotProof.aunts":
+		return len(x.Aunts) != 0
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.bank.v1beta1.BalanceSnapshotProof"))
+		}
+		panic(fmt.Errorf("message cosmos.bank.v1beta1.BalanceSnapshotProof does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_BalanceSnapshotProof) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "cosmos.bank.v1beta1.BalanceSnapshotProof.total":
+		x.Total = int64(0)
+	case "cosmos.bank.v1beta1.BalanceSnapshotProof.index":
+		x.Index = int64(0)
+	case "cosmos.bank.v1beta1.BalanceSnapshotProof.leaf_hash":
+		x.LeafHash = nil
+	case "cosmos.bank.v1beta1.BalanceSnapshotProof.aunts":
+		x.Aunts = nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.bank.v1beta1.BalanceSnapshotProof"))
+		}
+		panic(fmt.Errorf("message cosmos.bank.v1beta1.BalanceSnapshotProof does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_BalanceSnapshotProof) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "cosmos.bank.v1beta1.BalanceSnapshotProof.total":
+		value := x.Total
+		return protoreflect.ValueOfInt64(value)
+	case "cosmos.bank.v1beta1.BalanceSnapshotProof.index":
+		value := x.Index
+		return protoreflect.ValueOfInt64(value)
+	case "cosmos.bank.v1beta1.BalanceSnapshotProof.leaf_hash":
+		value := x.LeafHash
+		return protoreflect.ValueOfBytes(value)
+	case "cosmos.bank.v1beta1.BalanceSnapshotProof.aunts":
+		if len(x.Aunts) == 0 {
+			return protoreflect.ValueOfList(&_BalanceSnapshotProof_4_list{})
+		}
+		listValue := &_BalanceSnapshotProof_4_list{list: &x.Aunts}
+		return protoreflect.ValueOfList(listValue)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.bank.v1beta1.BalanceSnapshotProof"))
+		}
+		panic(fmt.Errorf("message cosmos.bank.v1beta1.BalanceSnapshotProof does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_BalanceSnapshotProof) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "cosmos.bank.v1beta1.BalanceSnapshotProof.total":
+		x.Total = value.Int()
+	case "cosmos.bank.v1beta1.BalanceSnapshotProof.index":
+		x.Index = value.Int()
+	case "cosmos.bank.v1beta1.BalanceSnapshotProof.leaf_hash":
+		x.LeafHash = value.Bytes()
+	case "cosmos.bank.v1beta1.BalanceSnapshotProof.aunts":
+		lv := value.List()
+		clv := lv.(*_BalanceSnapshotProof_4_list)
+		x.Aunts = *clv.list
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.bank.v1beta1.BalanceSnapshotProof"))
+		}
+		panic(fmt.Errorf("message cosmos.bank.v1beta1.BalanceSnapshotProof does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_BalanceSnapshotProof) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "cosmos.bank.v1beta1.BalanceSnapshotProof.aunts":
+		if x.Aunts == nil {
+			x.Aunts = [][]byte{}
+		}
+		value := &_BalanceSnapshotProof_4_list{list: &x.Aunts}
+		return protoreflect.ValueOfList(value)
+	case "cosmos.bank.v1beta1.BalanceSnapshotProof.total":
+		panic(fmt.Errorf("field total of message cosmos.bank.v1beta1.BalanceSnapshotProof is not mutable"))
+	case "cosmos.bank.v1beta1.BalanceSnapshotProof.index":
+		panic(fmt.Errorf("field index of message cosmos.bank.v1beta1.BalanceSnapshotProof is not mutable"))
+	case "cosmos.bank.v1beta1.BalanceSnapshotProof.leaf_hash":
+		panic(fmt.Errorf("field leaf_hash of message cosmos.bank.v1beta1.BalanceSnapshotProof is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.bank.v1beta1.BalanceSnapshotProof"))
+		}
+		panic(fmt.Errorf("message cosmos.bank.v1beta1.BalanceSnapshotProof does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_BalanceSnapshotProof) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "cosmos.bank.v1beta1.BalanceSnapshotProof.total":
+		return protoreflect.ValueOfInt64(int64(0))
+	case "cosmos.bank.v1beta1.BalanceSnapshotProof.index":
+		return protoreflect.ValueOfInt64(int64(0))
+	case "cosmos.bank.v1beta1.BalanceSnapshotProof.leaf_hash":
+		return protoreflect.ValueOfBytes(nil)
+	case "cosmos.bank.v1beta1.BalanceSnapshotProof.aunts":
+		list := [][]byte{}
+		return protoreflect.ValueOfList(&_BalanceSnapshotProof_4_list{list: &list})
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.bank.v1beta1.BalanceSnapshotProof"))
+		}
+		panic(fmt.Errorf("message cosmos.bank.v1beta1.BalanceSnapshotProof does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_BalanceSnapshotProof) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in cosmos.bank.v1beta1.BalanceSnapshotProof", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_BalanceSnapshotProof) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_BalanceSnapshotProof) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_BalanceSnapshotProof) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_BalanceSnapshotProof) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*BalanceSnapshotProof)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if x.Total != 0 {
+			n += 1 + runtime.Sov(uint64(x.Total))
+		}
+		if x.Index != 0 {
+			n += 1 + runtime.Sov(uint64(x.Index))
+		}
+		l = len(x.LeafHash)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if len(x.Aunts) > 0 {
+			for _, b := range x.Aunts {
+				l = len(b)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*BalanceSnapshotProof)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if len(x.Aunts) > 0 {
+			for iNdEx := len(x.Aunts) - 1; iNdEx >= 0; iNdEx-- {
+				i -= len(x.Aunts[iNdEx])
+				copy(dAtA[i:], x.Aunts[iNdEx])
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Aunts[iNdEx])))
+				i--
+				dAtA[i] = 0x22
+			}
+		}
+		if len(x.LeafHash) > 0 {
+			i -= len(x.LeafHash)
+			copy(dAtA[i:], x.LeafHash)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.LeafHash)))
+			i--
+			dAtA[i] = 0x1a
+		}
+		if x.Index != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.Index))
+			i--
+			dAtA[i] = 0x10
+		}
+		if x.Total != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.Total))
+			i--
+			dAtA[i] = 0x8
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*BalanceSnapshotProof)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: BalanceSnapshotProof: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: BalanceSnapshotProof: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Total", wireType)
+				}
+				x.Total = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.Total |= int64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 2:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Index", wireType)
+				}
+				x.Index = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.Index |= int64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 3:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field LeafHash", wireType)
+				}
+				var byteLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					byteLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if byteLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + byteLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.LeafHash = append(x.LeafHash[:0], dAtA[iNdEx:postIndex]...)
+				if x.LeafHash == nil {
+					x.LeafHash = []byte{}
+				}
+				iNdEx = postIndex
+			case 4:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Aunts", wireType)
+				}
+				var byteLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					byteLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if byteLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + byteLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Aunts = append(x.Aunts, make([]byte, postIndex-iNdEx))
+				copy(x.Aunts[len(x.Aunts)-1], dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var _ protoreflect.List = (*_MintBurnPermissions_2_list)(nil)
+
+type _MintBurnPermissions_2_list struct {
+	list *[]string
+}
+
+func (x *_MintBurnPermissions_2_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_MintBurnPermissions_2_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfString((*x.list)[i])
+}
+
+func (x *_MintBurnPermissions_2_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.String()
+	concreteValue := valueUnwrapped
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_MintBurnPermissions_2_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.String()
+	concreteValue := valueUnwrapped
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_MintBurnPermissions_2_list) AppendMutable() protoreflect.Value {
+	panic(fmt.Errorf("AppendMutable can not be called on message MintBurnPermissions at list field Minters as it is not of Message kind"))
+}
+
+func (x *_MintBurnPermissions_2_list) Truncate(n int) {
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_MintBurnPermissions_2_list) NewElement() protoreflect.Value {
+	v := ""
+	return protoreflect.ValueOfString(v)
+}
+
+func (x *_MintBurnPermissions_2_list) IsValid() bool {
+	return x.list != nil
+}
+
+var _ protoreflect.List = (*_MintBurnPermissions_3_list)(nil)
+
+type _MintBurnPermissions_3_list struct {
+	list *[]string
+}
+
+func (x *_MintBurnPermissions_3_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_MintBurnPermissions_3_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfString((*x.list)[i])
+}
+
+func (x *_MintBurnPermissions_3_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.String()
+	concreteValue := valueUnwrapped
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_MintBurnPermissions_3_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.String()
+	concreteValue := valueUnwrapped
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_MintBurnPermissions_3_list) AppendMutable() protoreflect.Value {
+	panic(fmt.Errorf("AppendMutable can not be called on message MintBurnPermissions at list field Burners as it is not of Message kind"))
+}
+
+func (x *_MintBurnPermissions_3_list) Truncate(n int) {
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_MintBurnPermissions_3_list) NewElement() protoreflect.Value {
+	v := ""
+	return protoreflect.ValueOfString(v)
+}
+
+func (x *_MintBurnPermissions_3_list) IsValid() bool {
+	return x.list != nil
+}
+
+var (
+	md_MintBurnPermissions         protoreflect.MessageDescriptor
+	fd_MintBurnPermissions_denom   protoreflect.FieldDescriptor
+	fd_MintBurnPermissions_minters protoreflect.FieldDescriptor
+	fd_MintBurnPermissions_burners protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_cosmos_bank_v1beta1_bank_proto_init()
+	md_MintBurnPermissions = File_cosmos_bank_v1beta1_bank_proto.Messages().ByName("MintBurnPermissions")
+	fd_MintBurnPermissions_denom = md_MintBurnPermissions.Fields().ByName("denom")
+	fd_MintBurnPermissions_minters = md_MintBurnPermissions.Fields().ByName("minters")
+	fd_MintBurnPermissions_burners = md_MintBurnPermissions.Fields().ByName("burners")
+}
+
+var _ protoreflect.Message = (*fastReflection_MintBurnPermissions)(nil)
+
+type fastReflection_MintBurnPermissions MintBurnPermissions
+
+func (x *MintBurnPermissions) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_MintBurnPermissions)(x)
+}
+
+func (x *MintBurnPermissions) slowProtoReflect() protoreflect.Message {
+	mi := &file_cosmos_bank_v1beta1_bank_proto_msgTypes[13]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_MintBurnPermissions_messageType fastReflection_MintBurnPermissions_messageType
+var _ protoreflect.MessageType = fastReflection_MintBurnPermissions_messageType{}
+
+type fastReflection_MintBurnPermissions_messageType struct{}
+
+func (x fastReflection_MintBurnPermissions_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_MintBurnPermissions)(nil)
+}
+func (x fastReflection_MintBurnPermissions_messageType) New() protoreflect.Message {
+	return new(fastReflection_MintBurnPermissions)
+}
+func (x fastReflection_MintBurnPermissions_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_MintBurnPermissions
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_MintBurnPermissions) Descriptor() protoreflect.MessageDescriptor {
+	return md_MintBurnPermissions
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_MintBurnPermissions) Type() protoreflect.MessageType {
+	return _fastReflection_MintBurnPermissions_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_MintBurnPermissions) New() protoreflect.Message {
+	return new(fastReflection_MintBurnPermissions)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_MintBurnPermissions) Interface() protoreflect.ProtoMessage {
+	return (*MintBurnPermissions)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_MintBurnPermissions) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Denom != "" {
+		value := protoreflect.ValueOfString(x.Denom)
+		if !f(fd_MintBurnPermissions_denom, value) {
+			return
+		}
+	}
+	if len(x.Minters) != 0 {
+		value := protoreflect.ValueOfList(&_MintBurnPermissions_2_list{list: &x.Minters})
+		if !f(fd_MintBurnPermissions_minters, value) {
+			return
+		}
+	}
+	if len(x.Burners) != 0 {
+		value := protoreflect.ValueOfList(&_MintBurnPermissions_3_list{list: &x.Burners})
+		if !f(fd_MintBurnPermissions_burners, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_MintBurnPermissions) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "cosmos.bank.v1beta1.MintBurnPermissions.denom":
+		return x.Denom != ""
+	case "cosmos.bank.v1beta1.MintBurnPermissions.minters":
+		return len(x.Minters) != 0
+	case "cosmos.bank.v1beta1.MintBurnPermissions.burners":
+		return len(x.Burners) != 0
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.bank.v1beta1.MintBurnPermissions"))
+		}
+		panic(fmt.Errorf("message cosmos.bank.v1beta1.MintBurnPermissions does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MintBurnPermissions) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "cosmos.bank.v1beta1.MintBurnPermissions.denom":
+		x.Denom = ""
+	case "cosmos.bank.v1beta1.MintBurnPermissions.minters":
+		x.Minters = nil
+	case "cosmos.bank.v1beta1.MintBurnPermissions.burners":
+		x.Burners = nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.bank.v1beta1.MintBurnPermissions"))
+		}
+		panic(fmt.Errorf("message cosmos.bank.v1beta1.MintBurnPermissions does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_MintBurnPermissions) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "cosmos.bank.v1beta1.MintBurnPermissions.denom":
+		value := x.Denom
+		return protoreflect.ValueOfString(value)
+	case "cosmos.bank.v1beta1.MintBurnPermissions.minters":
+		if len(x.Minters) == 0 {
+			return protoreflect.ValueOfList(&_MintBurnPermissions_2_list{})
+		}
+		listValue := &_MintBurnPermissions_2_list{list: &x.Minters}
+		return protoreflect.ValueOfList(listValue)
+	case "cosmos.bank.v1beta1.MintBurnPermissions.burners":
+		if len(x.Burners) == 0 {
+			return protoreflect.ValueOfList(&_MintBurnPermissions_3_list{})
+		}
+		listValue := &_MintBurnPermissions_3_list{list: &x.Burners}
+		return protoreflect.ValueOfList(listValue)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.bank.v1beta1.MintBurnPermissions"))
+		}
+		panic(fmt.Errorf("message cosmos.bank.v1beta1.MintBurnPermissions does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MintBurnPermissions) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "cosmos.bank.v1beta1.MintBurnPermissions.denom":
+		x.Denom = value.Interface().(string)
+	case "cosmos.bank.v1beta1.MintBurnPermissions.minters":
+		lv := value.List()
+		clv := lv.(*_MintBurnPermissions_2_list)
+		x.Minters = *clv.list
+	case "cosmos.bank.v1beta1.MintBurnPermissions.burners":
+		lv := value.List()
+		clv := lv.(*_MintBurnPermissions_3_list)
+		x.Burners = *clv.list
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.bank.v1beta1.MintBurnPermissions"))
+		}
+		panic(fmt.Errorf("message cosmos.bank.v1beta1.MintBurnPermissions does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MintBurnPermissions) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "cosmos.bank.v1beta1.MintBurnPermissions.minters":
+		if x.Minters == nil {
+			x.Minters = []string{}
+		}
+		value := &_MintBurnPermissions_2_list{list: &x.Minters}
+		return protoreflect.ValueOfList(value)
+	case "cosmos.bank.v1beta1.MintBurnPermissions.burners":
+		if x.Burners == nil {
+			x.Burners = []string{}
+		}
+		value := &_MintBurnPermissions_3_list{list: &x.Burners}
+		return protoreflect.ValueOfList(value)
+	case "cosmos.bank.v1beta1.MintBurnPermissions.denom":
+		panic(fmt.Errorf("field denom of message cosmos.bank.v1beta1.MintBurnPermissions is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.bank.v1beta1.MintBurnPermissions"))
+		}
+		panic(fmt.Errorf("message cosmos.bank.v1beta1.MintBurnPermissions does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_MintBurnPermissions) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "cosmos.bank.v1beta1.MintBurnPermissions.denom":
+		return protoreflect.ValueOfString("")
+	case "cosmos.bank.v1beta1.MintBurnPermissions.minters":
+		list := []string{}
+		return protoreflect.ValueOfList(&_MintBurnPermissions_2_list{list: &list})
+	case "cosmos.bank.v1beta1.MintBurnPermissions.burners":
+		list := []string{}
+		return protoreflect.ValueOfList(&_MintBurnPermissions_3_list{list: &list})
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.bank.v1beta1.MintBurnPermissions"))
+		}
+		panic(fmt.Errorf("message cosmos.bank.v1beta1.MintBurnPermissions does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_MintBurnPermissions) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in cosmos.bank.v1beta1.MintBurnPermissions", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_MintBurnPermissions) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MintBurnPermissions) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_MintBurnPermissions) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_MintBurnPermissions) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*MintBurnPermissions)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		l = len(x.Denom)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if len(x.Minters) > 0 {
+			for _, s := range x.Minters {
+				l = len(s)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if len(x.Burners) > 0 {
+			for _, s := range x.Burners {
+				l = len(s)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*MintBurnPermissions)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if len(x.Burners) > 0 {
+			for iNdEx := len(x.Burners) - 1; iNdEx >= 0; iNdEx-- {
+				i -= len(x.Burners[iNdEx])
+				copy(dAtA[i:], x.Burners[iNdEx])
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Burners[iNdEx])))
+				i--
+				dAtA[i] = 0x1a
+			}
+		}
+		if len(x.Minters) > 0 {
+			for iNdEx := len(x.Minters) - 1; iNdEx >= 0; iNdEx-- {
+				i -= len(x.Minters[iNdEx])
+				copy(dAtA[i:], x.Minters[iNdEx])
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Minters[iNdEx])))
+				i--
+				dAtA[i] = 0x12
+			}
+		}
+		if len(x.Denom) > 0 {
+			i -= len(x.Denom)
+			copy(dAtA[i:], x.Denom)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Denom)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*MintBurnPermissions)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MintBurnPermissions: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MintBurnPermissions: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Denom = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Minters", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Minters = append(x.Minters, string(dAtA[iNdEx:postIndex]))
+				iNdEx = postIndex
+			case 3:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Burners", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Burners = append(x.Burners, string(dAtA[iNdEx:postIndex]))
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var _ protoreflect.List = (*_ModuleEscrow_2_list)(nil)
+
+type _ModuleEscrow_2_list struct {
+	list *[]*v1beta1.Coin
+}
+
+func (x *_ModuleEscrow_2_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_ModuleEscrow_2_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_ModuleEscrow_2_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*v1beta1.Coin)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_ModuleEscrow_2_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*v1beta1.Coin)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_ModuleEscrow_2_list) AppendMutable() protoreflect.Value {
+	v := new(v1beta1.Coin)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_ModuleEscrow_2_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_ModuleEscrow_2_list) NewElement() protoreflect.Value {
+	v := new(v1beta1.Coin)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_ModuleEscrow_2_list) IsValid() bool {
+	return x.list != nil
+}
+
+var (
+	md_ModuleEscrow         protoreflect.MessageDescriptor
+	fd_ModuleEscrow_purpose protoreflect.FieldDescriptor
+	fd_ModuleEscrow_amount  protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_cosmos_bank_v1beta1_bank_proto_init()
+	md_ModuleEscrow = File_cosmos_bank_v1beta1_bank_proto.Messages().ByName("ModuleEscrow")
+	fd_ModuleEscrow_purpose = md_ModuleEscrow.Fields().ByName("purpose")
+	fd_ModuleEscrow_amount = md_ModuleEscrow.Fields().ByName("amount")
+}
+
+var _ protoreflect.Message = (*fastReflection_ModuleEscrow)(nil)
+
+type fastReflection_ModuleEscrow ModuleEscrow
+
+func (x *ModuleEscrow) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_ModuleEscrow)(x)
+}
+
+func (x *ModuleEscrow) slowProtoReflect() protoreflect.Message {
+	mi := &file_cosmos_bank_v1beta1_bank_proto_msgTypes[14]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_ModuleEscrow_messageType fastReflection_ModuleEscrow_messageType
+var _ protoreflect.MessageType = fastReflection_ModuleEscrow_messageType{}
+
+type fastReflection_ModuleEscrow_messageType struct{}
+
+func (x fastReflection_ModuleEscrow_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_ModuleEscrow)(nil)
+}
+func (x fastReflection_ModuleEscrow_messageType) New() protoreflect.Message {
+	return new(fastReflection_ModuleEscrow)
+}
+func (x fastReflection_ModuleEscrow_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_ModuleEscrow
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_ModuleEscrow) Descriptor() protoreflect.MessageDescriptor {
+	return md_ModuleEscrow
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_ModuleEscrow) Type() protoreflect.MessageType {
+	return _fastReflection_ModuleEscrow_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_ModuleEscrow) New() protoreflect.Message {
+	return new(fastReflection_ModuleEscrow)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_ModuleEscrow) Interface() protoreflect.ProtoMessage {
+	return (*ModuleEscrow)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_ModuleEscrow) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Purpose != "" {
+		value := protoreflect.ValueOfString(x.Purpose)
+		if !f(fd_ModuleEscrow_purpose, value) {
+			return
+		}
+	}
+	if len(x.Amount) != 0 {
+		value := protoreflect.ValueOfList(&_ModuleEscrow_2_list{list: &x.Amount})
+		if !f(fd_ModuleEscrow_amount, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_ModuleEscrow) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "cosmos.bank.v1beta1.ModuleEscrow.purpose":
+		return x.Purpose != ""
+	case "cosmos.bank.v1beta1.ModuleEscrow.amount":
+		return len(x.Amount) != 0
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.bank.v1beta1.ModuleEscrow"))
+		}
+		panic(fmt.Errorf("message cosmos.bank.v1beta1.ModuleEscrow does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_ModuleEscrow) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "cosmos.bank.v1beta1.ModuleEscrow.purpose":
+		x.Purpose = ""
+	case "cosmos.bank.v1beta1.ModuleEscrow.amount":
+		x.Amount = nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.bank.v1beta1.ModuleEscrow"))
+		}
+		panic(fmt.Errorf("message cosmos.bank.v1beta1.ModuleEscrow does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_ModuleEscrow) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "cosmos.bank.v1beta1.ModuleEscrow.purpose":
+		value := x.Purpose
+		return protoreflect.ValueOfString(value)
+	case "cosmos.bank.v1beta1.ModuleEscrow.amount":
+		if len(x.Amount) == 0 {
+			return protoreflect.ValueOfList(&_ModuleEscrow_2_list{})
+		}
+		listValue := &_ModuleEscrow_2_list{list: &x.Amount}
+		return protoreflect.ValueOfList(listValue)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.bank.v1beta1.ModuleEscrow"))
+		}
+		panic(fmt.Errorf("message cosmos.bank.v1beta1.ModuleEscrow does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_ModuleEscrow) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "cosmos.bank.v1beta1.ModuleEscrow.purpose":
+		x.Purpose = value.Interface().(string)
+	case "cosmos.bank.v1beta1.ModuleEscrow.amount":
+		lv := value.List()
+		clv := lv.(*_ModuleEscrow_2_list)
+		x.Amount = *clv.list
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.bank.v1beta1.ModuleEscrow"))
+		}
+		panic(fmt.Errorf("message cosmos.bank.v1beta1.ModuleEscrow does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_ModuleEscrow) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "cosmos.bank.v1beta1.ModuleEscrow.amount":
+		if x.Amount == nil {
+			x.Amount = []*v1beta1.Coin{}
+		}
+		value := &_ModuleEscrow_2_list{list: &x.Amount}
+		return protoreflect.ValueOfList(value)
+	case "cosmos.bank.v1beta1.ModuleEscrow.purpose":
+		panic(fmt.Errorf("field purpose of message cosmos.bank.v1beta1.ModuleEscrow is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.bank.v1beta1.ModuleEscrow"))
+		}
+		panic(fmt.Errorf("message cosmos.bank.v1beta1.ModuleEscrow does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_ModuleEscrow) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "cosmos.bank.v1beta1.ModuleEscrow.purpose":
+		return protoreflect.ValueOfString("")
+	case "cosmos.bank.v1beta1.ModuleEscrow.amount":
+		list := []*v1beta1.Coin{}
+		return protoreflect.ValueOfList(&_ModuleEscrow_2_list{list: &list})
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.bank.v1beta1.ModuleEscrow"))
+		}
+		panic(fmt.Errorf("message cosmos.bank.v1beta1.ModuleEscrow does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_ModuleEscrow) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in cosmos.bank.v1beta1.ModuleEscrow", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_ModuleEscrow) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_ModuleEscrow) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_ModuleEscrow) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_ModuleEscrow) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*ModuleEscrow)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		l = len(x.Purpose)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if len(x.Amount) > 0 {
+			for _, e := range x.Amount {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*ModuleEscrow)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if len(x.Amount) > 0 {
+			for iNdEx := len(x.Amount) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.Amount[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x12
+			}
+		}
+		if len(x.Purpose) > 0 {
+			i -= len(x.Purpose)
+			copy(dAtA[i:], x.Purpose)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Purpose)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*ModuleEscrow)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: ModuleEscrow: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: ModuleEscrow: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Purpose", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Purpose = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Amount = append(x.Amount, &v1beta1.Coin{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Amount[len(x.Amount)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var _ protoreflect.List = (*_TimelockedTransfer_4_list)(nil)
+
+type _TimelockedTransfer_4_list struct {
+	list *[]*v1beta1.Coin
+}
+
+func (x *_TimelockedTransfer_4_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_TimelockedTransfer_4_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_TimelockedTransfer_4_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*v1beta1.Coin)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_TimelockedTransfer_4_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*v1beta1.Coin)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_TimelockedTransfer_4_list) AppendMutable() protoreflect.Value {
+	v := new(v1beta1.Coin)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_TimelockedTransfer_4_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_TimelockedTransfer_4_list) NewElement() protoreflect.Value {
+	v := new(v1beta1.Coin)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_TimelockedTransfer_4_list) IsValid() bool {
+	return x.list != nil
+}
+
+var (
+	md_TimelockedTransfer             protoreflect.MessageDescriptor
+	fd_TimelockedTransfer_id          protoreflect.FieldDescriptor
+	fd_TimelockedTransfer_sender      protoreflect.FieldDescriptor
+	fd_TimelockedTransfer_recipient   protoreflect.FieldDescriptor
+	fd_TimelockedTransfer_amount      protoreflect.FieldDescriptor
+	fd_TimelockedTransfer_unlock_time protoreflect.FieldDescriptor
+	fd_TimelockedTransfer_expiry_time protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_cosmos_bank_v1beta1_bank_proto_init()
+	md_TimelockedTransfer = File_cosmos_bank_v1beta1_bank_proto.Messages().ByName("TimelockedTransfer")
+	fd_TimelockedTransfer_id = md_TimelockedTransfer.Fields().ByName("id")
+	fd_TimelockedTransfer_sender = md_TimelockedTransfer.Fields().ByName("sender")
+	fd_TimelockedTransfer_recipient = md_TimelockedTransfer.Fields().ByName("recipient")
+	fd_TimelockedTransfer_amount = md_TimelockedTransfer.Fields().ByName("amount")
+	fd_TimelockedTransfer_unlock_time = md_TimelockedTransfer.Fields().ByName("unlock_time")
+	fd_TimelockedTransfer_expiry_time = md_TimelockedTransfer.Fields().ByName("expiry_time")
+}
+
+var _ protoreflect.Message = (*fastReflection_TimelockedTransfer)(nil)
+
+type fastReflection_TimelockedTransfer TimelockedTransfer
+
+func (x *TimelockedTransfer) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_TimelockedTransfer)(x)
+}
+
+func (x *TimelockedTransfer) slowProtoReflect() protoreflect.Message {
+	mi := &file_cosmos_bank_v1beta1_bank_proto_msgTypes[15]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_TimelockedTransfer_messageType fastReflection_TimelockedTransfer_messageType
+var _ protoreflect.MessageType = fastReflection_TimelockedTransfer_messageType{}
+
+type fastReflection_TimelockedTransfer_messageType struct{}
+
+func (x fastReflection_TimelockedTransfer_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_TimelockedTransfer)(nil)
+}
+func (x fastReflection_TimelockedTransfer_messageType) New() protoreflect.Message {
+	return new(fastReflection_TimelockedTransfer)
+}
+func (x fastReflection_TimelockedTransfer_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_TimelockedTransfer
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_TimelockedTransfer) Descriptor() protoreflect.MessageDescriptor {
+	return md_TimelockedTransfer
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_TimelockedTransfer) Type() protoreflect.MessageType {
+	return _fastReflection_TimelockedTransfer_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_TimelockedTransfer) New() protoreflect.Message {
+	return new(fastReflection_TimelockedTransfer)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_TimelockedTransfer) Interface() protoreflect.ProtoMessage {
+	return (*TimelockedTransfer)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_TimelockedTransfer) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Id != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.Id)
+		if !f(fd_TimelockedTransfer_id, value) {
+			return
+		}
+	}
+	if x.Sender != "" {
+		value := protoreflect.ValueOfString(x.Sender)
+		if !f(fd_TimelockedTransfer_sender, value) {
+			return
+		}
+	}
+	if x.Recipient != "" {
+		value := protoreflect.ValueOfString(x.Recipient)
+		if !f(fd_TimelockedTransfer_recipient, value) {
+			return
+		}
+	}
+	if len(x.Amount) != 0 {
+		value := protoreflect.ValueOfList(&_TimelockedTransfer_4_list{list: &x.Amount})
+		if !f(fd_TimelockedTransfer_amount, value) {
+			return
+		}
+	}
+	if x.UnlockTime != nil {
+		value := protoreflect.ValueOfMessage(x.UnlockTime.ProtoReflect())
+		if !f(fd_TimelockedTransfer_unlock_time, value) {
+			return
+		}
+	}
+	if x.ExpiryTime != nil {
+		value := protoreflect.ValueOfMessage(x.ExpiryTime.ProtoReflect())
+		if !f(fd_TimelockedTransfer_expiry_time, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_TimelockedTransfer) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "cosmos.bank.v1beta1.TimelockedTransfer.id":
+		return x.Id != uint64(0)
+	case "cosmos.bank.v1beta1.TimelockedTransfer.sender":
+		return x.Sender != ""
+	case "cosmos.bank.v1beta1.TimelockedTransfer.recipient":
+		return x.Recipient != ""
+	case "cosmos.bank.v1beta1.TimelockedTransfer.amount":
+		return len(x.Amount) != 0
+	case "cosmos.bank.v1beta1.TimelockedTransfer.unlock_time":
+		return x.UnlockTime != nil
+	case "cosmos.bank.v1beta1.TimelockedTransfer.expiry_time":
+		return x.ExpiryTime != nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.bank.v1beta1.TimelockedTransfer"))
+		}
+		panic(fmt.Errorf("message cosmos.bank.v1beta1.TimelockedTransfer does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_TimelockedTransfer) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "cosmos.bank.v1beta1.TimelockedTransfer.id":
+		x.Id = uint64(0)
+	case "cosmos.bank.v1beta1.TimelockedTransfer.sender":
+		x.Sender = ""
+	case "cosmos.bank.v1beta1.TimelockedTransfer.recipient":
+		x.Recipient = ""
+	case "cosmos.bank.v1beta1.TimelockedTransfer.amount":
+		x.Amount = nil
+	case "cosmos.bank.v1beta1.TimelockedTransfer.unlock_time":
+		x.UnlockTime = nil
+	case "cosmos.bank.v1beta1.TimelockedTransfer.expiry_time":
+		x.ExpiryTime = nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.bank.v1beta1.TimelockedTransfer"))
+		}
+		panic(fmt.Errorf("message cosmos.bank.v1beta1.TimelockedTransfer does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_TimelockedTransfer) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "cosmos.bank.v1beta1.TimelockedTransfer.id":
+		value := x.Id
+		return protoreflect.ValueOfUint64(value)
+	case "cosmos.bank.v1beta1.TimelockedTransfer.sender":
+		value := x.Sender
+		return protoreflect.ValueOfString(value)
+	case "cosmos.bank.v1beta1.TimelockedTransfer.recipient":
+		value := x.Recipient
+		return protoreflect.ValueOfString(value)
+	case "cosmos.bank.v1beta1.TimelockedTransfer.amount":
+		if len(x.Amount) == 0 {
+			return protoreflect.ValueOfList(&_TimelockedTransfer_4_list{})
+		}
+		listValue := &_TimelockedTransfer_4_list{list: &x.Amount}
+		return protoreflect.ValueOfList(listValue)
+	case "cosmos.bank.v1beta1.TimelockedTransfer.unlock_time":
+		value := x.UnlockTime
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "cosmos.bank.v1beta1.TimelockedTransfer.expiry_time":
+		value := x.ExpiryTime
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.bank.v1beta1.TimelockedTransfer"))
+		}
+		panic(fmt.Errorf("message cosmos.bank.v1beta1.TimelockedTransfer does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_TimelockedTransfer) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "cosmos.bank.v1beta1.TimelockedTransfer.id":
+		x.Id = value.Uint()
+	case "cosmos.bank.v1beta1.TimelockedTransfer.sender":
+		x.Sender = value.Interface().(string)
+	case "cosmos.bank.v1beta1.TimelockedTransfer.recipient":
+		x.Recipient = value.Interface().(string)
+	case "cosmos.bank.v1beta1.TimelockedTransfer.amount":
+		lv := value.List()
+		clv := lv.(*_TimelockedTransfer_4_list)
+		x.Amount = *clv.list
+	case "cosmos.bank.v1beta1.TimelockedTransfer.unlock_time":
+		x.UnlockTime = value.Message().Interface().(*timestamppb.Timestamp)
+	case "cosmos.bank.v1beta1.TimelockedTransfer.expiry_time":
+		x.ExpiryTime = value.Message().Interface().(*timestamppb.Timestamp)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.bank.v1beta1.TimelockedTransfer"))
+		}
+		panic(fmt.Errorf("message cosmos.bank.v1beta1.TimelockedTransfer does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_TimelockedTransfer) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "cosmos.bank.v1beta1.TimelockedTransfer.amount":
+		if x.Amount == nil {
+			x.Amount = []*v1beta1.Coin{}
+		}
+		value := &_TimelockedTransfer_4_list{list: &x.Amount}
+		return protoreflect.ValueOfList(value)
+	case "cosmos.bank.v1beta1.TimelockedTransfer.unlock_time":
+		if x.UnlockTime == nil {
+			x.UnlockTime = new(timestamppb.Timestamp)
+		}
+		return protoreflect.ValueOfMessage(x.UnlockTime.ProtoReflect())
+	case "cosmos.bank.v1beta1.TimelockedTransfer.expiry_time":
+		if x.ExpiryTime == nil {
+			x.ExpiryTime = new(timestamppb.Timestamp)
+		}
+		return protoreflect.ValueOfMessage(x.ExpiryTime.ProtoReflect())
+	case "cosmos.bank.v1beta1.TimelockedTransfer.id":
+		panic(fmt.Errorf("field id of message cosmos.bank.v1beta1.TimelockedTransfer is not mutable"))
+	case "cosmos.bank.v1beta1.TimelockedTransfer.sender":
+		panic(fmt.Errorf("field sender of message cosmos.bank.v1beta1.TimelockedTransfer is not mutable"))
+	case "cosmos.bank.v1beta1.TimelockedTransfer.recipient":
+		panic(fmt.Errorf("field recipient of message cosmos.bank.v1beta1.TimelockedTransfer is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.bank.v1beta1.TimelockedTransfer"))
+		}
+		panic(fmt.Errorf("message cosmos.bank.v1beta1.TimelockedTransfer does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_TimelockedTransfer) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "cosmos.bank.v1beta1.TimelockedTransfer.id":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "cosmos.bank.v1beta1.TimelockedTransfer.sender":
+		return protoreflect.ValueOfString("")
+	case "cosmos.bank.v1beta1.TimelockedTransfer.recipient":
+		return protoreflect.ValueOfString("")
+	case "cosmos.bank.v1beta1.TimelockedTransfer.amount":
+		list := []*v1beta1.Coin{}
+		return protoreflect.ValueOfList(&_TimelockedTransfer_4_list{list: &list})
+	case "cosmos.bank.v1beta1.TimelockedTransfer.unlock_time":
+		m := new(timestamppb.Timestamp)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case "cosmos.bank.v1beta1.TimelockedTransfer.expiry_time":
+		m := new(timestamppb.Timestamp)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.bank.v1beta1.TimelockedTransfer"))
+		}
+		panic(fmt.Errorf("message cosmos.bank.v1beta1.TimelockedTransfer does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_TimelockedTransfer) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in cosmos.bank.v1beta1.TimelockedTransfer", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_TimelockedTransfer) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_TimelockedTransfer) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_TimelockedTransfer) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_TimelockedTransfer) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*TimelockedTransfer)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if x.Id != 0 {
+			n += 1 + runtime.Sov(uint64(x.Id))
+		}
+		l = len(x.Sender)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.Recipient)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if len(x.Amount) > 0 {
+			for _, e := range x.Amount {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if x.UnlockTime != nil {
+			l = options.Size(x.UnlockTime)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.ExpiryTime != nil {
+			l = options.Size(x.ExpiryTime)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*TimelockedTransfer)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if x.ExpiryTime != nil {
+			encoded, err := options.Marshal(x.ExpiryTime)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x32
+		}
+		if x.UnlockTime != nil {
+			encoded, err := options.Marshal(x.UnlockTime)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x2a
+		}
+		if len(x.Amount) > 0 {
+			for iNdEx := len(x.Amount) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.Amount[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x22
+			}
+		}
+		if len(x.Recipient) > 0 {
+			i -= len(x.Recipient)
+			copy(dAtA[i:], x.Recipient)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Recipient)))
+			i--
+			dAtA[i] = 0x1a
+		}
+		if len(x.Sender) > 0 {
+			i -= len(x.Sender)
+			copy(dAtA[i:], x.Sender)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Sender)))
+			i--
+			dAtA[i] = 0x12
+		}
+		if x.Id != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.Id))
+			i--
+			dAtA[i] = 0x8
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*TimelockedTransfer)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: TimelockedTransfer: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: TimelockedTransfer: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+				}
+				x.Id = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.Id |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Sender = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 3:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Recipient", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Recipient = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 4:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Amount = append(x.Amount, &v1beta1.Coin{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Amount[len(x.Amount)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 5:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field UnlockTime", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.UnlockTime == nil {
+					x.UnlockTime = &timestamppb.Timestamp{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.UnlockTime); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 6:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ExpiryTime", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.ExpiryTime == nil {
+					x.ExpiryTime = &timestamppb.Timestamp{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.ExpiryTime); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var _ protoreflect.List = (*_SpendingLimit_2_list)(nil)
+
+type _SpendingLimit_2_list struct {
+	list *[]*v1beta1.Coin
+}
+
+func (x *_SpendingLimit_2_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_SpendingLimit_2_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_SpendingLimit_2_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*v1beta1.Coin)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_SpendingLimit_2_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*v1beta1.Coin)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_SpendingLimit_2_list) AppendMutable() protoreflect.Value {
+	v := new(v1beta1.Coin)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_SpendingLimit_2_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_SpendingLimit_2_list) NewElement() protoreflect.Value {
+	v := new(v1beta1.Coin)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_SpendingLimit_2_list) IsValid() bool {
+	return x.list != nil
+}
+
+var _ protoreflect.List = (*_SpendingLimit_4_list)(nil)
+
+type _SpendingLimit_4_list struct {
+	list *[]*SpendingRecord
+}
+
+func (x *_SpendingLimit_4_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_SpendingLimit_4_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_SpendingLimit_4_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*SpendingRecord)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_SpendingLimit_4_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*SpendingRecord)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_SpendingLimit_4_list) AppendMutable() protoreflect.Value {
+	v := new(SpendingRecord)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_SpendingLimit_4_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_SpendingLimit_4_list) NewElement() protoreflect.Value {
+	v := new(SpendingRecord)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_SpendingLimit_4_list) IsValid() bool {
+	return x.list != nil
+}
+
+var (
+	md_SpendingLimit         protoreflect.MessageDescriptor
+	fd_SpendingLimit_address protoreflect.FieldDescriptor
+	fd_SpendingLimit_limits  protoreflect.FieldDescriptor
+	fd_SpendingLimit_window  protoreflect.FieldDescriptor
+	fd_SpendingLimit_spent   protoreflect.FieldDescriptor
+	fd_SpendingLimit_pending protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_cosmos_bank_v1beta1_bank_proto_init()
+	md_SpendingLimit = File_cosmos_bank_v1beta1_bank_proto.Messages().ByName("SpendingLimit")
+	fd_SpendingLimit_address = md_SpendingLimit.Fields().ByName("address")
+	fd_SpendingLimit_limits = md_SpendingLimit.Fields().ByName("limits")
+	fd_SpendingLimit_window = md_SpendingLimit.Fields().ByName("window")
+	fd_SpendingLimit_spent = md_SpendingLimit.Fields().ByName("spent")
+	fd_SpendingLimit_pending = md_SpendingLimit.Fields().ByName("pending")
+}
+
+var _ protoreflect.Message = (*fastReflection_SpendingLimit)(nil)
+
+type fastReflection_SpendingLimit SpendingLimit
+
+func (x *SpendingLimit) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_SpendingLimit)(x)
+}
+
+func (x *SpendingLimit) slowProtoReflect() protoreflect.Message {
+	mi := &file_cosmos_bank_v1beta1_bank_proto_msgTypes[16]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_SpendingLimit_messageType fastReflection_SpendingLimit_messageType
+var _ protoreflect.MessageType = fastReflection_SpendingLimit_messageType{}
+
+type fastReflection_SpendingLimit_messageType struct{}
+
+func (x fastReflection_SpendingLimit_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_SpendingLimit)(nil)
+}
+func (x fastReflection_SpendingLimit_messageType) New() protoreflect.Message {
+	return new(fastReflection_SpendingLimit)
+}
+func (x fastReflection_SpendingLimit_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_SpendingLimit
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_SpendingLimit) Descriptor() protoreflect.MessageDescriptor {
+	return md_SpendingLimit
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_SpendingLimit) Type() protoreflect.MessageType {
+	return _fastReflection_SpendingLimit_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_SpendingLimit) New() protoreflect.Message {
+	return new(fastReflection_SpendingLimit)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_SpendingLimit) Interface() protoreflect.ProtoMessage {
+	return (*SpendingLimit)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_SpendingLimit) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Address != "" {
+		value := protoreflect.ValueOfString(x.Address)
+		if !f(fd_SpendingLimit_address, value) {
+			return
+		}
+	}
+	if len(x.Limits) != 0 {
+		value := protoreflect.ValueOfList(&_SpendingLimit_2_list{list: &x.Limits})
+		if !f(fd_SpendingLimit_limits, value) {
+			return
+		}
+	}
+	if x.Window != nil {
+		value := protoreflect.ValueOfMessage(x.Window.ProtoReflect())
+		if !f(fd_SpendingLimit_window, value) {
+			return
+		}
+	}
+	if len(x.Spent) != 0 {
+		value := protoreflect.ValueOfList(&_SpendingLimit_4_list{list: &x.Spent})
+		if !f(fd_SpendingLimit_spent, value) {
+			return
+		}
+	}
+	if x.Pending != nil {
+		value := protoreflect.ValueOfMessage(x.Pending.ProtoReflect())
+		if !f(fd_SpendingLimit_pending, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_SpendingLimit) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "cosmos.bank.v1beta1.SpendingLimit.address":
+		return x.Address != ""
+	case "cosmos.bank.v1beta1.SpendingLimit.limits":
+		return len(x.Limits) != 0
+	case "cosmos.bank.v1beta1.SpendingLimit.window":
+		return x.Window != nil
+	case "cosmos.bank.v1beta1.SpendingLimit.spent":
+		return len(x.Spent) != 0
+	case "cosmos.bank.v1beta1.SpendingLimit.pending":
+		return x.Pending != nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.bank.v1beta1.SpendingLimit"))
+		}
+		panic(fmt.Errorf("message cosmos.bank.v1beta1.SpendingLimit does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_SpendingLimit) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "cosmos.bank.v1beta1.SpendingLimit.address":
+		x.Address = ""
+	case "cosmos.bank.v1beta1.SpendingLimit.limits":
+		x.Limits = nil
+	case "cosmos.bank.v1beta1.SpendingLimit.window":
+		x.Window = nil
+	case "cosmos.bank.v1beta1.SpendingLimit.spent":
+		x.Spent = nil
+	case "cosmos.bank.v1beta1.SpendingLimit.pending":
+		x.Pending = nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.bank.v1beta1.SpendingLimit"))
+		}
+		panic(fmt.Errorf("message cosmos.bank.v1beta1.SpendingLimit does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_SpendingLimit) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "cosmos.bank.v1beta1.SpendingLimit.address":
+		value := x.Address
+		return protoreflect.ValueOfString(value)
+	case "cosmos.bank.v1beta1.SpendingLimit.limits":
+		if len(x.Limits) == 0 {
+			return protoreflect.ValueOfList(&_SpendingLimit_2_list{})
+		}
+		listValue := &_SpendingLimit_2_list{list: &x.Limits}
+		return protoreflect.ValueOfList(listValue)
+	case "cosmos.bank.v1beta1.SpendingLimit.window":
+		value := x.Window
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "cosmos.bank.v1beta1.SpendingLimit.spent":
+		if len(x.Spent) == 0 {
+			return protoreflect.ValueOfList(&_SpendingLimit_4_list{})
+		}
+		listValue := &_SpendingLimit_4_list{list: &x.Spent}
+		return protoreflect.ValueOfList(listValue)
+	case "cosmos.bank.v1beta1.SpendingLimit.pending":
+		value := x.Pending
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.bank.v1beta1.SpendingLimit"))
+		}
+		panic(fmt.Errorf("message cosmos.bank.v1beta1.SpendingLimit does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_SpendingLimit) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "cosmos.bank.v1beta1.SpendingLimit.address":
+		x.Address = value.Interface().(string)
+	case "cosmos.bank.v1beta1.SpendingLimit.limits":
+		lv := value.List()
+		clv := lv.(*_SpendingLimit_2_list)
+		x.Limits = *clv.list
+	case "cosmos.bank.v1beta1.SpendingLimit.window":
+		x.Window = value.Message().Interface().(*durationpb.Duration)
+	case "cosmos.bank.v1beta1.SpendingLimit.spent":
+		lv := value.List()
+		clv := lv.(*_SpendingLimit_4_list)
+		x.Spent = *clv.list
+	case "cosmos.bank.v1beta1.SpendingLimit.pending":
+		x.Pending = value.Message().Interface().(*PendingSpendingLimit)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.bank.v1beta1.SpendingLimit"))
+		}
+		panic(fmt.Errorf("message cosmos.bank.v1beta1.SpendingLimit does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_SpendingLimit) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "cosmos.bank.v1beta1.SpendingLimit.limits":
+		if x.Limits == nil {
+			x.Limits = []*v1beta1.Coin{}
+		}
+		value := &_SpendingLimit_2_list{list: &x.Limits}
+		return protoreflect.ValueOfList(value)
+	case "cosmos.bank.v1beta1.SpendingLimit.window":
+		if x.Window == nil {
+			x.Window = new(durationpb.Duration)
+		}
+		return protoreflect.ValueOfMessage(x.Window.ProtoReflect())
+	case "cosmos.bank.v1beta1.SpendingLimit.spent":
+		if x.Spent == nil {
+			x.Spent = []*SpendingRecord{}
+		}
+		value := &_SpendingLimit_4_list{list: &x.Spent}
+		return protoreflect.ValueOfList(value)
+	case "cosmos.bank.v1beta1.SpendingLimit.pending":
+		if x.Pending == nil {
+			x.Pending = new(PendingSpendingLimit)
+		}
+		return protoreflect.ValueOfMessage(x.Pending.ProtoReflect())
+	case "cosmos.bank.v1beta1.SpendingLimit.address":
+		panic(fmt.Errorf("field address of message cosmos.bank.v1beta1.SpendingLimit is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.bank.v1beta1.SpendingLimit"))
+		}
+		panic(fmt.Errorf("message cosmos.bank.v1beta1.SpendingLimit does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_SpendingLimit) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "cosmos.bank.v1beta1.SpendingLimit.address":
+		return protoreflect.ValueOfString("")
+	case "cosmos.bank.v1beta1.SpendingLimit.limits":
+		list := []*v1beta1.Coin{}
+		return protoreflect.ValueOfList(&_SpendingLimit_2_list{list: &list})
+	case "cosmos.bank.v1beta1.SpendingLimit.window":
+		m := new(durationpb.Duration)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case "cosmos.bank.v1beta1.SpendingLimit.spent":
+		list := []*SpendingRecord{}
+		return protoreflect.ValueOfList(&_SpendingLimit_4_list{list: &list})
+	case "cosmos.bank.v1beta1.SpendingLimit.pending":
+		m := new(PendingSpendingLimit)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.bank.v1beta1.SpendingLimit"))
+		}
+		panic(fmt.Errorf("message cosmos.bank.v1beta1.SpendingLimit does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_SpendingLimit) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in cosmos.bank.v1beta1.SpendingLimit", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_SpendingLimit) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_SpendingLimit) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_SpendingLimit) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_SpendingLimit) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*SpendingLimit)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		l = len(x.Address)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if len(x.Limits) > 0 {
+			for _, e := range x.Limits {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if x.Window != nil {
+			l = options.Size(x.Window)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if len(x.Spent) > 0 {
+			for _, e := range x.Spent {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if x.Pending != nil {
+			l = options.Size(x.Pending)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*SpendingLimit)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if x.Pending != nil {
+			encoded, err := options.Marshal(x.Pending)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x2a
+		}
+		if len(x.Spent) > 0 {
+			for iNdEx := len(x.Spent) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.Spent[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x22
+			}
+		}
+		if x.Window != nil {
+			encoded, err := options.Marshal(x.Window)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x1a
+		}
+		if len(x.Limits) > 0 {
+			for iNdEx := len(x.Limits) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.Limits[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x12
+			}
+		}
+		if len(x.Address) > 0 {
+			i -= len(x.Address)
+			copy(dAtA[i:], x.Address)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Address)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*SpendingLimit)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: SpendingLimit: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: SpendingLimit: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Address = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Limits", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Limits = append(x.Limits, &v1beta1.Coin{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Limits[len(x.Limits)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 3:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Window", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.Window == nil {
+					x.Window = &durationpb.Duration{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Window); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 4:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Spent", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Spent = append(x.Spent, &SpendingRecord{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Spent[len(x.Spent)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 5:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Pending", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.Pending == nil {
+					x.Pending = &PendingSpendingLimit{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Pending); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var _ protoreflect.List = (*_SpendingRecord_2_list)(nil)
+
+type _SpendingRecord_2_list struct {
+	list *[]*v1beta1.Coin
+}
+
+func (x *_SpendingRecord_2_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_SpendingRecord_2_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_SpendingRecord_2_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*v1beta1.Coin)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_SpendingRecord_2_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*v1beta1.Coin)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_SpendingRecord_2_list) AppendMutable() protoreflect.Value {
+	v := new(v1beta1.Coin)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_SpendingRecord_2_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_SpendingRecord_2_list) NewElement() protoreflect.Value {
+	v := new(v1beta1.Coin)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_SpendingRecord_2_list) IsValid() bool {
+	return x.list != nil
+}
+
+var (
+	md_SpendingRecord        protoreflect.MessageDescriptor
+	fd_SpendingRecord_time   protoreflect.FieldDescriptor
+	fd_SpendingRecord_amount protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_cosmos_bank_v1beta1_bank_proto_init()
+	md_SpendingRecord = File_cosmos_bank_v1beta1_bank_proto.Messages().ByName("SpendingRecord")
+	fd_SpendingRecord_time = md_SpendingRecord.Fields().ByName("time")
+	fd_SpendingRecord_amount = md_SpendingRecord.Fields().ByName("amount")
+}
+
+var _ protoreflect.Message = (*fastReflection_SpendingRecord)(nil)
+
+type fastReflection_SpendingRecord SpendingRecord
+
+func (x *SpendingRecord) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_SpendingRecord)(x)
+}
+
+func (x *SpendingRecord) slowProtoReflect() protoreflect.Message {
+	mi := &file_cosmos_bank_v1beta1_bank_proto_msgTypes[17]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_SpendingRecord_messageType fastReflection_SpendingRecord_messageType
+var _ protoreflect.MessageType = fastReflection_SpendingRecord_messageType{}
+
+type fastReflection_SpendingRecord_messageType struct{}
+
+func (x fastReflection_SpendingRecord_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_SpendingRecord)(nil)
+}
+func (x fastReflection_SpendingRecord_messageType) New() protoreflect.Message {
+	return new(fastReflection_SpendingRecord)
+}
+func (x fastReflection_SpendingRecord_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_SpendingRecord
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_SpendingRecord) Descriptor() protoreflect.MessageDescriptor {
+	return md_SpendingRecord
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_SpendingRecord) Type() protoreflect.MessageType {
+	return _fastReflection_SpendingRecord_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_SpendingRecord) New() protoreflect.Message {
+	return new(fastReflection_SpendingRecord)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_SpendingRecord) Interface() protoreflect.ProtoMessage {
+	return (*SpendingRecord)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_SpendingRecord) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Time != nil {
+		value := protoreflect.ValueOfMessage(x.Time.ProtoReflect())
+		if !f(fd_SpendingRecord_time, value) {
+			return
+		}
+	}
+	if len(x.Amount) != 0 {
+		value := protoreflect.ValueOfList(&_SpendingRecord_2_list{list: &x.Amount})
+		if !f(fd_SpendingRecord_amount, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_SpendingRecord) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "cosmos.bank.v1beta1.SpendingRecord.time":
+		return x.Time != nil
+	case "cosmos.bank.v1beta1.SpendingRecord.amount":
+		return len(x.Amount) != 0
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.bank.v1beta1.SpendingRecord"))
+		}
+		panic(fmt.Errorf("message cosmos.bank.v1beta1.SpendingRecord does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_SpendingRecord) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "cosmos.bank.v1beta1.SpendingRecord.time":
+		x.Time = nil
+	case "cosmos.bank.v1beta1.SpendingRecord.amount":
+		x.Amount = nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.bank.v1beta1.SpendingRecord"))
+		}
+		panic(fmt.Errorf("message cosmos.bank.v1beta1.SpendingRecord does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_SpendingRecord) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "cosmos.bank.v1beta1.SpendingRecord.time":
+		value := x.Time
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "cosmos.bank.v1beta1.SpendingRecord.amount":
+		if len(x.Amount) == 0 {
+			return protoreflect.ValueOfList(&_SpendingRecord_2_list{})
+		}
+		listValue := &_SpendingRecord_2_list{list: &x.Amount}
+		return protoreflect.ValueOfList(listValue)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.bank.v1beta1.SpendingRecord"))
+		}
+		panic(fmt.Errorf("message cosmos.bank.v1beta1.SpendingRecord does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_SpendingRecord) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "cosmos.bank.v1beta1.SpendingRecord.time":
+		x.Time = value.Message().Interface().(*timestamppb.Timestamp)
+	case "cosmos.bank.v1beta1.SpendingRecord.amount":
+		lv := value.List()
+		clv := lv.(*_SpendingRecord_2_list)
+		x.Amount = *clv.list
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.bank.v1beta1.SpendingRecord"))
+		}
+		panic(fmt.Errorf("message cosmos.bank.v1beta1.SpendingRecord does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_SpendingRecord) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "cosmos.bank.v1beta1.SpendingRecord.time":
+		if x.Time == nil {
+			x.Time = new(timestamppb.Timestamp)
+		}
+		return protoreflect.ValueOfMessage(x.Time.ProtoReflect())
+	case "cosmos.bank.v1beta1.SpendingRecord.amount":
+		if x.Amount == nil {
+			x.Amount = []*v1beta1.Coin{}
+		}
+		value := &_SpendingRecord_2_list{list: &x.Amount}
+		return protoreflect.ValueOfList(value)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.bank.v1beta1.SpendingRecord"))
+		}
+		panic(fmt.Errorf("message cosmos.bank.v1beta1.SpendingRecord does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_SpendingRecord) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "cosmos.bank.v1beta1.SpendingRecord.time":
+		m := new(timestamppb.Timestamp)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case "cosmos.bank.v1beta1.SpendingRecord.amount":
+		list := []*v1beta1.Coin{}
+		return protoreflect.ValueOfList(&_SpendingRecord_2_list{list: &list})
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.bank.v1beta1.SpendingRecord"))
+		}
+		panic(fmt.Errorf("message cosmos.bank.v1beta1.SpendingRecord does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_SpendingRecord) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in cosmos.bank.v1beta1.SpendingRecord", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_SpendingRecord) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_SpendingRecord) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_SpendingRecord) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_SpendingRecord) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*SpendingRecord)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if x.Time != nil {
+			l = options.Size(x.Time)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if len(x.Amount) > 0 {
+			for _, e := range x.Amount {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*SpendingRecord)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if len(x.Amount) > 0 {
+			for iNdEx := len(x.Amount) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.Amount[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x12
+			}
+		}
+		if x.Time != nil {
+			encoded, err := options.Marshal(x.Time)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*SpendingRecord)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: SpendingRecord: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: SpendingRecord: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Time", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.Time == nil {
+					x.Time = &timestamppb.Timestamp{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Time); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Amount = append(x.Amount, &v1beta1.Coin{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Amount[len(x.Amount)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var _ protoreflect.List = (*_PendingSpendingLimit_1_list)(nil)
+
+type _PendingSpendingLimit_1_list struct {
+	list *[]*v1beta1.Coin
+}
+
+func (x *_PendingSpendingLimit_1_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_PendingSpendingLimit_1_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_PendingSpendingLimit_1_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*v1beta1.Coin)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_PendingSpendingLimit_1_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*v1beta1.Coin)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_PendingSpendingLimit_1_list) AppendMutable() protoreflect.Value {
+	v := new(v1beta1.Coin)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_PendingSpendingLimit_1_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_PendingSpendingLimit_1_list) NewElement() protoreflect.Value {
+	v := new(v1beta1.Coin)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_PendingSpendingLimit_1_list) IsValid() bool {
+	return x.list != nil
+}
+
+var (
+	md_PendingSpendingLimit                protoreflect.MessageDescriptor
+	fd_PendingSpendingLimit_limits         protoreflect.FieldDescriptor
+	fd_PendingSpendingLimit_window         protoreflect.FieldDescriptor
+	fd_PendingSpendingLimit_effective_time protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_cosmos_bank_v1beta1_bank_proto_init()
+	md_PendingSpendingLimit = File_cosmos_bank_v1beta1_bank_proto.Messages().ByName("PendingSpendingLimit")
+	fd_PendingSpendingLimit_limits = md_PendingSpendingLimit.Fields().ByName("limits")
+	fd_PendingSpendingLimit_window = md_PendingSpendingLimit.Fields().ByName("window")
+	fd_PendingSpendingLimit_effective_time = md_PendingSpendingLimit.Fields().ByName("effective_time")
+}
+
+var _ protoreflect.Message = (*fastReflection_PendingSpendingLimit)(nil)
+
+type fastReflection_PendingSpendingLimit PendingSpendingLimit
+
+func (x *PendingSpendingLimit) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_PendingSpendingLimit)(x)
+}
+
+func (x *PendingSpendingLimit) slowProtoReflect() protoreflect.Message {
+	mi := &file_cosmos_bank_v1beta1_bank_proto_msgTypes[18]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_PendingSpendingLimit_messageType fastReflection_PendingSpendingLimit_messageType
+var _ protoreflect.MessageType = fastReflection_PendingSpendingLimit_messageType{}
+
+type fastReflection_PendingSpendingLimit_messageType struct{}
+
+func (x fastReflection_PendingSpendingLimit_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_PendingSpendingLimit)(nil)
+}
+func (x fastReflection_PendingSpendingLimit_messageType) New() protoreflect.Message {
+	return new(fastReflection_PendingSpendingLimit)
+}
+func (x fastReflection_PendingSpendingLimit_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_PendingSpendingLimit
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_PendingSpendingLimit) Descriptor() protoreflect.MessageDescriptor {
+	return md_PendingSpendingLimit
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_PendingSpendingLimit) Type() protoreflect.MessageType {
+	return _fastReflection_PendingSpendingLimit_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_PendingSpendingLimit) New() protoreflect.Message {
+	return new(fastReflection_PendingSpendingLimit)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_PendingSpendingLimit) Interface() protoreflect.ProtoMessage {
+	return (*PendingSpendingLimit)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_PendingSpendingLimit) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if len(x.Limits) != 0 {
+		value := protoreflect.ValueOfList(&_PendingSpendingLimit_1_list{list: &x.Limits})
+		if !f(fd_PendingSpendingLimit_limits, value) {
+			return
+		}
+	}
+	if x.Window != nil {
+		value := protoreflect.ValueOfMessage(x.Window.ProtoReflect())
+		if !f(fd_PendingSpendingLimit_window, value) {
+			return
+		}
+	}
+	if x.EffectiveTime != nil {
+		value := protoreflect.ValueOfMessage(x.EffectiveTime.ProtoReflect())
+		if !f(fd_PendingSpendingLimit_effective_time, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_PendingSpendingLimit) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "cosmos.bank.v1beta1.PendingSpendingLimit.limits":
+		return len(x.Limits) != 0
+	case "cosmos.bank.v1beta1.PendingSpendingLimit.window":
+		return x.Window != nil
+	case "cosmos.bank.v1beta1.PendingSpendingLimit.effective_time":
+		return x.EffectiveTime != nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.bank.v1beta1.PendingSpendingLimit"))
+		}
+		panic(fmt.Errorf("message cosmos.bank.v1beta1.PendingSpendingLimit does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_PendingSpendingLimit) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "cosmos.bank.v1beta1.PendingSpendingLimit.limits":
+		x.Limits = nil
+	case "cosmos.bank.v1beta1.PendingSpendingLimit.window":
+		x.Window = nil
+	case "cosmos.bank.v1beta1.PendingSpendingLimit.effective_time":
+		x.EffectiveTime = nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.bank.v1beta1.PendingSpendingLimit"))
+		}
+		panic(fmt.Errorf("message cosmos.bank.v1beta1.PendingSpendingLimit does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_PendingSpendingLimit) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "cosmos.bank.v1beta1.PendingSpendingLimit.limits":
+		if len(x.Limits) == 0 {
+			return protoreflect.ValueOfList(&_PendingSpendingLimit_1_list{})
+		}
+		listValue := &_PendingSpendingLimit_1_list{list: &x.Limits}
+		return protoreflect.ValueOfList(listValue)
+	case "cosmos.bank.v1beta1.PendingSpendingLimit.window":
+		value := x.Window
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "cosmos.bank.v1beta1.PendingSpendingLimit.effective_time":
+		value := x.EffectiveTime
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.bank.v1beta1.PendingSpendingLimit"))
+		}
+		panic(fmt.Errorf("message cosmos.bank.v1beta1.PendingSpendingLimit does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_PendingSpendingLimit) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "cosmos.bank.v1beta1.PendingSpendingLimit.limits":
+		lv := value.List()
+		clv := lv.(*_PendingSpendingLimit_1_list)
+		x.Limits = *clv.list
+	case "cosmos.bank.v1beta1.PendingSpendingLimit.window":
+		x.Window = value.Message().Interface().(*durationpb.Duration)
+	case "cosmos.bank.v1beta1.PendingSpendingLimit.effective_time":
+		x.EffectiveTime = value.Message().Interface().(*timestamppb.Timestamp)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.bank.v1beta1.PendingSpendingLimit"))
+		}
+		panic(fmt.Errorf("message cosmos.bank.v1beta1.PendingSpendingLimit does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_PendingSpendingLimit) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "cosmos.bank.v1beta1.PendingSpendingLimit.limits":
+		if x.Limits == nil {
+			x.Limits = []*v1beta1.Coin{}
+		}
+		value := &_PendingSpendingLimit_1_list{list: &x.Limits}
+		return protoreflect.ValueOfList(value)
+	case "cosmos.bank.v1beta1.PendingSpendingLimit.window":
+		if x.Window == nil {
+			x.Window = new(durationpb.Duration)
+		}
+		return protoreflect.ValueOfMessage(x.Window.ProtoReflect())
+	case "cosmos.bank.v1beta1.PendingSpendingLimit.effective_time":
+		if x.EffectiveTime == nil {
+			x.EffectiveTime = new(timestamppb.Timestamp)
+		}
+		return protoreflect.ValueOfMessage(x.EffectiveTime.ProtoReflect())
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.bank.v1beta1.PendingSpendingLimit"))
+		}
+		panic(fmt.Errorf("message cosmos.bank.v1beta1.PendingSpendingLimit does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_PendingSpendingLimit) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "cosmos.bank.v1beta1.PendingSpendingLimit.limits":
+		list := []*v1beta1.Coin{}
+		return protoreflect.ValueOfList(&_PendingSpendingLimit_1_list{list: &list})
+	case "cosmos.bank.v1beta1.PendingSpendingLimit.window":
+		m := new(durationpb.Duration)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case "cosmos.bank.v1beta1.PendingSpendingLimit.effective_time":
+		m := new(timestamppb.Timestamp)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.bank.v1beta1.PendingSpendingLimit"))
+		}
+		panic(fmt.Errorf("message cosmos.bank.v1beta1.PendingSpendingLimit does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_PendingSpendingLimit) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in cosmos.bank.v1beta1.PendingSpendingLimit", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_PendingSpendingLimit) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_PendingSpendingLimit) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_PendingSpendingLimit) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_PendingSpendingLimit) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*PendingSpendingLimit)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if len(x.Limits) > 0 {
+			for _, e := range x.Limits {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if x.Window != nil {
+			l = options.Size(x.Window)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.EffectiveTime != nil {
+			l = options.Size(x.EffectiveTime)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*PendingSpendingLimit)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if x.EffectiveTime != nil {
+			encoded, err := options.Marshal(x.EffectiveTime)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x1a
+		}
+		if x.Window != nil {
+			encoded, err := options.Marshal(x.Window)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x12
+		}
+		if len(x.Limits) > 0 {
+			for iNdEx := len(x.Limits) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.Limits[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0xa
+			}
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*PendingSpendingLimit)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: PendingSpendingLimit: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: PendingSpendingLimit: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Limits", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Limits = append(x.Limits, &v1beta1.Coin{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Limits[len(x.Limits)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Window", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.Window == nil {
+					x.Window = &durationpb.Duration{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Window); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 3:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field EffectiveTime", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.EffectiveTime == nil {
+					x.EffectiveTime = &timestamppb.Timestamp{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.EffectiveTime); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var _ protoreflect.List = (*_DemurrageSchedule_4_list)(nil)
+
+type _DemurrageSchedule_4_list struct {
+	list *[]*DemurrageSegment
+}
+
+func (x *_DemurrageSchedule_4_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_DemurrageSchedule_4_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_DemurrageSchedule_4_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*DemurrageSegment)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_DemurrageSchedule_4_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*DemurrageSegment)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_DemurrageSchedule_4_list) AppendMutable() protoreflect.Value {
+	v := new(DemurrageSegment)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_DemurrageSchedule_4_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_DemurrageSchedule_4_list) NewElement() protoreflect.Value {
+	v := new(DemurrageSegment)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_DemurrageSchedule_4_list) IsValid() bool {
+	return x.list != nil
+}
+
+var (
+	md_DemurrageSchedule              protoreflect.MessageDescriptor
+	fd_DemurrageSchedule_denom        protoreflect.FieldDescriptor
+	fd_DemurrageSchedule_epoch_length protoreflect.FieldDescriptor
+	fd_DemurrageSchedule_epoch        protoreflect.FieldDescriptor
+	fd_DemurrageSchedule_segments     protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_cosmos_bank_v1beta1_bank_proto_init()
+	md_DemurrageSchedule = File_cosmos_bank_v1beta1_bank_proto.Messages().ByName("DemurrageSchedule")
+	fd_DemurrageSchedule_denom = md_DemurrageSchedule.Fields().ByName("denom")
+	fd_DemurrageSchedule_epoch_length = md_DemurrageSchedule.Fields().ByName("epoch_length")
+	fd_DemurrageSchedule_epoch = md_DemurrageSchedule.Fields().ByName("epoch")
+	fd_DemurrageSchedule_segments = md_DemurrageSchedule.Fields().ByName("segments")
+}
+
+var _ protoreflect.Message = (*fastReflection_DemurrageSchedule)(nil)
+
+type fastReflection_DemurrageSchedule DemurrageSchedule
+
+func (x *DemurrageSchedule) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_DemurrageSchedule)(x)
+}
+
+func (x *DemurrageSchedule) slowProtoReflect() protoreflect.Message {
+	mi := &file_cosmos_bank_v1beta1_bank_proto_msgTypes[19]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_DemurrageSchedule_messageType fastReflection_DemurrageSchedule_messageType
+var _ protoreflect.MessageType = fastReflection_DemurrageSchedule_messageType{}
+
+type fastReflection_DemurrageSchedule_messageType struct{}
+
+func (x fastReflection_DemurrageSchedule_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_DemurrageSchedule)(nil)
+}
+func (x fastReflection_DemurrageSchedule_messageType) New() protoreflect.Message {
+	return new(fastReflection_DemurrageSchedule)
+}
+func (x fastReflection_DemurrageSchedule_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_DemurrageSchedule
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_DemurrageSchedule) Descriptor() protoreflect.MessageDescriptor {
+	return md_DemurrageSchedule
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_DemurrageSchedule) Type() protoreflect.MessageType {
+	return _fastReflection_DemurrageSchedule_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_DemurrageSchedule) New() protoreflect.Message {
+	return new(fastReflection_DemurrageSchedule)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_DemurrageSchedule) Interface() protoreflect.ProtoMessage {
+	return (*DemurrageSchedule)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_DemurrageSchedule) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Denom != "" {
+		value := protoreflect.ValueOfString(x.Denom)
+		if !f(fd_DemurrageSchedule_denom, value) {
+			return
+		}
+	}
+	if x.EpochLength != int64(0) {
+		value := protoreflect.ValueOfInt64(x.EpochLength)
+		if !f(fd_DemurrageSchedule_epoch_length, value) {
+			return
+		}
+	}
+	if x.Epoch != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.Epoch)
+		if !f(fd_DemurrageSchedule_epoch, value) {
+			return
+		}
+	}
+	if len(x.Segments) != 0 {
+		value := protoreflect.ValueOfList(&_DemurrageSchedule_4_list{list: &x.Segments})
+		if !f(fd_DemurrageSchedule_segments, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_DemurrageSchedule) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "cosmos.bank.v1beta1.DemurrageSchedule.denom":
+		return x.Denom != ""
+	case "cosmos.bank.v1beta1.DemurrageSchedule.epoch_length":
+		return x.EpochLength != int64(0)
+	case "cosmos.bank.v1beta1.DemurrageSchedule.epoch":
+		return x.Epoch != uint64(0)
+	case "cosmos.bank.v1beta1.DemurrageSchedule.segments":
+		return len(x.Segments) != 0
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.bank.v1beta1.DemurrageSchedule"))
+		}
+		panic(fmt.Errorf("message cosmos.bank.v1beta1.DemurrageSchedule does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_DemurrageSchedule) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "cosmos.bank.v1beta1.DemurrageSchedule.denom":
+		x.Denom = ""
+	case "cosmos.bank.v1beta1.DemurrageSchedule.epoch_length":
+		x.EpochLength = int64(0)
+	case "cosmos.bank.v1beta1.DemurrageSchedule.epoch":
+		x.Epoch = uint64(0)
+	case "cosmos.bank.v1beta1.DemurrageSchedule.segments":
+		x.Segments = nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.bank.v1beta1.DemurrageSchedule"))
+		}
+		panic(fmt.Errorf("message cosmos.bank.v1beta1.DemurrageSchedule does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_DemurrageSchedule) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "cosmos.bank.v1beta1.DemurrageSchedule.denom":
+		value := x.Denom
+		return protoreflect.ValueOfString(value)
+	case "cosmos.bank.v1beta1.DemurrageSchedule.epoch_length":
+		value := x.EpochLength
+		return protoreflect.ValueOfInt64(value)
+	case "cosmos.bank.v1beta1.DemurrageSchedule.epoch":
+		value := x.Epoch
+		return protoreflect.ValueOfUint64(value)
+	case "cosmos.bank.v1beta1.DemurrageSchedule.segments":
+		if len(x.Segments) == 0 {
+			return protoreflect.ValueOfList(&_DemurrageSchedule_4_list{})
+		}
+		listValue := &_DemurrageSchedule_4_list{list: &x.Segments}
+		return protoreflect.ValueOfList(listValue)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.bank.v1beta1.DemurrageSchedule"))
+		}
+		panic(fmt.Errorf("message cosmos.bank.v1beta1.DemurrageSchedule does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_DemurrageSchedule) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "cosmos.bank.v1beta1.DemurrageSchedule.denom":
+		x.Denom = value.Interface().(string)
+	case "cosmos.bank.v1beta1.DemurrageSchedule.epoch_length":
+		x.EpochLength = value.Int()
+	case "cosmos.bank.v1beta1.DemurrageSchedule.epoch":
+		x.Epoch = value.Uint()
+	case "cosmos.bank.v1beta1.DemurrageSchedule.segments":
+		lv := value.List()
+		clv := lv.(*_DemurrageSchedule_4_list)
+		x.Segments = *clv.list
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.bank.v1beta1.DemurrageSchedule"))
+		}
+		panic(fmt.Errorf("message cosmos.bank.v1beta1.DemurrageSchedule does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_DemurrageSchedule) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "cosmos.bank.v1beta1.DemurrageSchedule.segments":
+		if x.Segments == nil {
+			x.Segments = []*DemurrageSegment{}
+		}
+		value := &_DemurrageSchedule_4_list{list: &x.Segments}
+		return protoreflect.ValueOfList(value)
+	case "cosmos.bank.v1beta1.DemurrageSchedule.denom":
+		panic(fmt.Errorf("field denom of message cosmos.bank.v1beta1.DemurrageSchedule is not mutable"))
+	case "cosmos.bank.v1beta1.DemurrageSchedule.epoch_length":
+		panic(fmt.Errorf("field epoch_length of message cosmos.bank.v1beta1.DemurrageSchedule is not mutable"))
+	case "cosmos.bank.v1beta1.DemurrageSchedule.epoch":
+		panic(fmt.Errorf("field epoch of message cosmos.bank.v1beta1.DemurrageSchedule is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.bank.v1beta1.DemurrageSchedule"))
+		}
+		panic(fmt.Errorf("message cosmos.bank.v1beta1.DemurrageSchedule does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_DemurrageSchedule) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "cosmos.bank.v1beta1.DemurrageSchedule.denom":
+		return protoreflect.ValueOfString("")
+	case "cosmos.bank.v1beta1.DemurrageSchedule.epoch_length":
+		return protoreflect.ValueOfInt64(int64(0))
+	case "cosmos.bank.v1beta1.DemurrageSchedule.epoch":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "cosmos.bank.v1beta1.DemurrageSchedule.segments":
+		list := []*DemurrageSegment{}
+		return protoreflect.ValueOfList(&_DemurrageSchedule_4_list{list: &list})
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.bank.v1beta1.DemurrageSchedule"))
+		}
+		panic(fmt.Errorf("message cosmos.bank.v1beta1.DemurrageSchedule does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_DemurrageSchedule) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in cosmos.bank.v1beta1.DemurrageSchedule", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_DemurrageSchedule) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_DemurrageSchedule) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_DemurrageSchedule) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_DemurrageSchedule) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*DemurrageSchedule)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		l = len(x.Denom)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.EpochLength != 0 {
+			n += 1 + runtime.Sov(uint64(x.EpochLength))
+		}
+		if x.Epoch != 0 {
+			n += 1 + runtime.Sov(uint64(x.Epoch))
+		}
+		if len(x.Segments) > 0 {
+			for _, e := range x.Segments {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*DemurrageSchedule)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if len(x.Segments) > 0 {
+			for iNdEx := len(x.Segments) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.Segments[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x22
+			}
+		}
+		if x.Epoch != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.Epoch))
+			i--
+			dAtA[i] = 0x18
+		}
+		if x.EpochLength != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.EpochLength))
+			i--
+			dAtA[i] = 0x10
+		}
+		if len(x.Denom) > 0 {
+			i -= len(x.Denom)
+			copy(dAtA[i:], x.Denom)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Denom)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*DemurrageSchedule)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: DemurrageSchedule: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: DemurrageSchedule: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Denom = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 2:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field EpochLength", wireType)
+				}
+				x.EpochLength = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.EpochLength |= int64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 3:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Epoch", wireType)
+				}
+				x.Epoch = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.Epoch |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 4:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Segments", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Segments = append(x.Segments, &DemurrageSegment{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Segments[len(x.Segments)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_DemurrageSegment             protoreflect.MessageDescriptor
+	fd_DemurrageSegment_start_epoch protoreflect.FieldDescriptor
+	fd_DemurrageSegment_rate        protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_cosmos_bank_v1beta1_bank_proto_init()
+	md_DemurrageSegment = File_cosmos_bank_v1beta1_bank_proto.Messages().ByName("DemurrageSegment")
+	fd_DemurrageSegment_start_epoch = md_DemurrageSegment.Fields().ByName("start_epoch")
+	fd_DemurrageSegment_rate = md_DemurrageSegment.Fields().ByName("rate")
+}
+
+var _ protoreflect.Message = (*fastReflection_DemurrageSegment)(nil)
+
+type fastReflection_DemurrageSegment DemurrageSegment
+
+func (x *DemurrageSegment) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_DemurrageSegment)(x)
+}
+
+func (x *DemurrageSegment) slowProtoReflect() protoreflect.Message {
+	mi := &file_cosmos_bank_v1beta1_bank_proto_msgTypes[20]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_DemurrageSegment_messageType fastReflection_DemurrageSegment_messageType
+var _ protoreflect.MessageType = fastReflection_DemurrageSegment_messageType{}
+
+type fastReflection_DemurrageSegment_messageType struct{}
+
+func (x fastReflection_DemurrageSegment_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_DemurrageSegment)(nil)
+}
+func (x fastReflection_DemurrageSegment_messageType) New() protoreflect.Message {
+	return new(fastReflection_DemurrageSegment)
+}
+func (x fastReflection_DemurrageSegment_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_DemurrageSegment
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_DemurrageSegment) Descriptor() protoreflect.MessageDescriptor {
+	return md_DemurrageSegment
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_DemurrageSegment) Type() protoreflect.MessageType {
+	return _fastReflection_DemurrageSegment_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_DemurrageSegment) New() protoreflect.Message {
+	return new(fastReflection_DemurrageSegment)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_DemurrageSegment) Interface() protoreflect.ProtoMessage {
+	return (*DemurrageSegment)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_DemurrageSegment) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.StartEpoch != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.StartEpoch)
+		if !f(fd_DemurrageSegment_start_epoch, value) {
+			return
+		}
+	}
+	if x.Rate != "" {
+		value := protoreflect.ValueOfString(x.Rate)
+		if !f(fd_DemurrageSegment_rate, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_DemurrageSegment) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "cosmos.bank.v1beta1.DemurrageSegment.start_epoch":
+		return x.StartEpoch != uint64(0)
+	case "cosmos.bank.v1beta1.DemurrageSegment.rate":
+		return x.Rate != ""
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.bank.v1beta1.DemurrageSegment"))
+		}
+		panic(fmt.Errorf("message cosmos.bank.v1beta1.DemurrageSegment does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_DemurrageSegment) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "cosmos.bank.v1beta1.DemurrageSegment.start_epoch":
+		x.StartEpoch = uint64(0)
+	case "cosmos.bank.v1beta1.DemurrageSegment.rate":
+		x.Rate = ""
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.bank.v1beta1.DemurrageSegment"))
+		}
+		panic(fmt.Errorf("message cosmos.bank.v1beta1.DemurrageSegment does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_DemurrageSegment) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "cosmos.bank.v1beta1.DemurrageSegment.start_epoch":
+		value := x.StartEpoch
+		return protoreflect.ValueOfUint64(value)
+	case "cosmos.bank.v1beta1.DemurrageSegment.rate":
+		value := x.Rate
+		return protoreflect.ValueOfString(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.bank.v1beta1.DemurrageSegment"))
+		}
+		panic(fmt.Errorf("message cosmos.bank.v1beta1.DemurrageSegment does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_DemurrageSegment) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "cosmos.bank.v1beta1.DemurrageSegment.start_epoch":
+		x.StartEpoch = value.Uint()
+	case "cosmos.bank.v1beta1.DemurrageSegment.rate":
+		x.Rate = value.Interface().(string)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.bank.v1beta1.DemurrageSegment"))
+		}
+		panic(fmt.Errorf("message cosmos.bank.v1beta1.DemurrageSegment does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_DemurrageSegment) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "cosmos.bank.v1beta1.DemurrageSegment.start_epoch":
+		panic(fmt.Errorf("field start_epoch of message cosmos.bank.v1beta1.DemurrageSegment is not mutable"))
+	case "cosmos.bank.v1beta1.DemurrageSegment.rate":
+		panic(fmt.Errorf("field rate of message cosmos.bank.v1beta1.DemurrageSegment is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.bank.v1beta1.DemurrageSegment"))
+		}
+		panic(fmt.Errorf("message cosmos.bank.v1beta1.DemurrageSegment does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_DemurrageSegment) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "cosmos.bank.v1beta1.DemurrageSegment.start_epoch":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "cosmos.bank.v1beta1.DemurrageSegment.rate":
+		return protoreflect.ValueOfString("")
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.bank.v1beta1.DemurrageSegment"))
+		}
+		panic(fmt.Errorf("message cosmos.bank.v1beta1.DemurrageSegment does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_DemurrageSegment) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in cosmos.bank.v1beta1.DemurrageSegment", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_DemurrageSegment) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_DemurrageSegment) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_DemurrageSegment) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_DemurrageSegment) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*DemurrageSegment)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if x.StartEpoch != 0 {
+			n += 1 + runtime.Sov(uint64(x.StartEpoch))
+		}
+		l = len(x.Rate)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*DemurrageSegment)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if len(x.Rate) > 0 {
+			i -= len(x.Rate)
+			copy(dAtA[i:], x.Rate)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Rate)))
+			i--
+			dAtA[i] = 0x12
+		}
+		if x.StartEpoch != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.StartEpoch))
+			i--
+			dAtA[i] = 0x8
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*DemurrageSegment)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: DemurrageSegment: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: DemurrageSegment: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field StartEpoch", wireType)
+				}
+				x.StartEpoch = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.StartEpoch |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Rate", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Rate = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.27.0
+// 	protoc        (unknown)
+// source: cosmos/bank/v1beta1/bank.proto
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// Params defines the parameters for the bank module.
+type Params struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Deprecated: Use of SendEnabled in params is deprecated.
+	// For genesis, use the newly added send_enabled field in the genesis object.
+	// Storage, lookup, and manipulation of this information is now in the keeper.
+	//
+	// As of cosmos-sdk 0.47, this only exists for backwards compatibility of genesis files.
+	//
+	// Deprecated: Do not use.
+	SendEnabled        []*SendEnabled `protobuf:"bytes,1,rep,name=send_enabled,json=sendEnabled,proto3" json:"send_enabled,omitempty"`
+	DefaultSendEnabled bool           `protobuf:"varint,2,opt,name=default_send_enabled,json=defaultSendEnabled,proto3" json:"default_send_enabled,omitempty"`
+	// dust_sweep defines the sweeping of the dust balances.
+	//
+	// Since: cosmos-sdk 0.48
+	DustSweep *DustSweepParams `protobuf:"bytes,3,opt,name=dust_sweep,json=dustSweep,proto3" json:"dust_sweep,omitempty"`
+}
+
+func (x *Params) Reset() {
+	*x = Params{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cosmos_bank_v1beta1_bank_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Params) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Params) ProtoMessage() {}
+
+// Deprecated: Use Params.ProtoReflect.Descriptor instead.
+func (*Params) Descriptor() ([]byte, []int) {
+	return file_cosmos_bank_v1beta1_bank_proto_rawDescGZIP(), []int{0}
+}
+
+// Deprecated: Do not use.
+func (x *Params) GetSendEnabled() []*SendEnabled {
+	if x != nil {
+		return x.SendEnabled
+	}
+	return nil
+}
+
+func (x *Params) GetDefaultSendEnabled() bool {
+	if x != nil {
+		return x.DefaultSendEnabled
+	}
+	return false
+}
+
+func (x *Params) GetDustSweep() *DustSweepParams {
+	if x != nil {
+		return x.DustSweep
+	}
+	return nil
+}
+
+// DustSweepParams defines the sweeping of the dust balances, i.e. the balances
+// below the dust threshold of their denomination, which are transferred to the
+// community pool or burned at the end of each sweep epoch to curb the state
+// growth.
+//
+// Since: cosmos-sdk 0.48
+type DustSweepParams struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// thresholds are the dust thresholds of the swept denominations. A positive
+	// balance of a denomination below its threshold is dust.
+	Thresholds []*v1beta1.Coin `protobuf:"bytes,1,rep,name=thresholds,proto3" json:"thresholds,omitempty"`
+	// epoch_length is the number of blocks between two sweeps. Zero disables the
+	// sweeping.
+	EpochLength int64 `protobuf:"varint,2,opt,name=epoch_length,json=epochLength,proto3" json:"epoch_length,omitempty"`
+	// chain_wide defines whether the balances of all the accounts are swept, or
+	// only the balances of the accounts which opted in.
+	ChainWide bool `protobuf:"varint,3,opt,name=chain_wide,json=chainWide,proto3" json:"chain_wide,omitempty"`
+	// burn defines whether the dust is burned instead of being transferred to
+	// the community pool.
+	Burn bool `protobuf:"varint,4,opt,name=burn,proto3" json:"burn,omitempty"`
+	// exempt_addresses are the addresses whose balances are never swept. The
+	// module accounts are always exempt.
+	ExemptAddresses []string `protobuf:"bytes,5,rep,name=exempt_addresses,json=exemptAddresses,proto3" json:"exempt_addresses,omitempty"`
+	// max_accounts_per_epoch is the maximum number of accounts visited by a
+	// sweep, the next sweep resuming after the last visited account. Zero
+	// disables the limit.
+	MaxAccountsPerEpoch uint64 `protobuf:"varint,6,opt,name=max_accounts_per_epoch,json=maxAccountsPerEpoch,proto3" json:"max_accounts_per_epoch,omitempty"`
+}
+
+func (x *DustSweepParams) Reset() {
+	*x = DustSweepParams{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cosmos_bank_v1beta1_bank_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DustSweepParams) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DustSweepParams) ProtoMessage() {}
+
+// Deprecated: Use DustSweepParams.ProtoReflect.Descriptor instead.
+func (*DustSweepParams) Descriptor() ([]byte, []int) {
+	return file_cosmos_bank_v1beta1_bank_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *DustSweepParams) GetThresholds() []*v1beta1.Coin {
+	if x != nil {
+		return x.Thresholds
+	}
+	return nil
+}
+
+func (x *DustSweepParams) GetEpochLength() int64 {
+	if x != nil {
+		return x.EpochLength
+	}
+	return 0
+}
+
+func (x *DustSweepParams) GetChainWide() bool {
+	if x != nil {
+		return x.ChainWide
+	}
+	return false
+}
+
+func (x *DustSweepParams) GetBurn() bool {
+	if x != nil {
+		return x.Burn
+	}
+	return false
+}
+
+func (x *DustSweepParams) GetExemptAddresses() []string {
+	if x != nil {
+		return x.ExemptAddresses
+	}
+	return nil
+}
+
+func (x *DustSweepParams) GetMaxAccountsPerEpoch() uint64 {
+	if x != nil {
+		return x.MaxAccountsPerEpoch
+	}
+	return 0
+}
+
+// SendEnabled maps coin denom to a send_enabled status (whether a denom is
+// sendable).
+type SendEnabled struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Denom   string `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty"`
+	Enabled bool   `protobuf:"varint,2,opt,name=enabled,proto3" json:"enabled,omitempty"`
+}
+
+func (x *SendEnabled) Reset() {
+	*x = SendEnabled{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cosmos_bank_v1beta1_bank_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SendEnabled) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SendEnabled) ProtoMessage() {}
+
+// Deprecated: Use SendEnabled.ProtoReflect.Descriptor instead.
+func (*SendEnabled) Descriptor() ([]byte, []int) {
+	return file_cosmos_bank_v1beta1_bank_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *SendEnabled) GetDenom() string {
+	if x != nil {
+		return x.Denom
+	}
+	return ""
+}
+
+func (x *SendEnabled) GetEnabled() bool {
+	if x != nil {
+		return x.Enabled
+	}
+	return false
+}
+
+// Input models transaction input.
+type Input struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Address string          `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	Coins   []*v1beta1.Coin `protobuf:"bytes,2,rep,name=coins,proto3" json:"coins,omitempty"`
+}
+
+func (x *Input) Reset() {
+	*x = Input{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cosmos_bank_v1beta1_bank_proto_msgTypes[3]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Input) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Input) ProtoMessage() {}
+
+// Deprecated: Use Input.ProtoReflect.Descriptor instead.
+func (*Input) Descriptor() ([]byte, []int) {
+	return file_cosmos_bank_v1beta1_bank_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *Input) GetAddress() string {
+	if x != nil {
+		return x.Address
+	}
+	return ""
+}
+
+func (x *Input) GetCoins() []*v1beta1.Coin {
+	if x != nil {
+		return x.Coins
+	}
+	return nil
+}
+
+// Output models transaction outputs.
+type Output struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Address string          `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	Coins   []*v1beta1.Coin `protobuf:"bytes,2,rep,name=coins,proto3" json:"coins,omitempty"`
+}
+
+func (x *Output) Reset() {
+	*x = Output{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cosmos_bank_v1beta1_bank_proto_msgTypes[4]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Output) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Output) ProtoMessage() {}
+
+// Deprecated: Use Output.ProtoReflect.Descriptor instead.
+func (*Output) Descriptor() ([]byte, []int) {
+	return file_cosmos_bank_v1beta1_bank_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *Output) GetAddress() string {
+	if x != nil {
+		return x.Address
+	}
+	return ""
+}
+
+func (x *Output) GetCoins() []*v1beta1.Coin {
+	if x != nil {
+		return x.Coins
+	}
+	return nil
+}
+
+// BatchOutput models an output of a batch send, along with its memo.
+//
+// Since: cosmos-sdk 0.48
+type BatchOutput struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Address string          `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	Coins   []*v1beta1.Coin `protobuf:"bytes,2,rep,name=coins,proto3" json:"coins,omitempty"`
+	// memo is an optional reference of the output, e.g. the identifier of a
+	// withdrawal, emitted in its transfer event.
+	Memo string `protobuf:"bytes,3,opt,name=memo,proto3" json:"memo,omitempty"`
+}
+
+func (x *BatchOutput) Reset() {
+	*x = BatchOutput{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cosmos_bank_v1beta1_bank_proto_msgTypes[5]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BatchOutput) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BatchOutput) ProtoMessage() {}
+
+// Deprecated: Use BatchOutput.ProtoReflect.Descriptor instead.
+func (*BatchOutput) Descriptor() ([]byte, []int) {
+	return file_cosmos_bank_v1beta1_bank_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *BatchOutput) GetAddress() string {
+	if x != nil {
+		return x.Address
+	}
+	return ""
+}
+
+func (x *BatchOutput) GetCoins() []*v1beta1.Coin {
+	if x != nil {
+		return x.Coins
+	}
+	return nil
+}
+
+func (x *BatchOutput) GetMemo() string {
+	if x != nil {
+		return x.Memo
+	}
+	return ""
+}
+
+// Supply represents a struct that passively keeps track of the total supply
+// amounts in the network.
+// This message is deprecated now that supply is indexed by denom.
+//
+// Deprecated: Do not use.
+type Supply struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Total []*v1beta1.Coin `protobuf:"bytes,1,rep,name=total,proto3" json:"total,omitempty"`
+}
+
+func (x *Supply) Reset() {
+	*x = Supply{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cosmos_bank_v1beta1_bank_proto_msgTypes[6]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Supply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Supply) ProtoMessage() {}
+
+// Deprecated: Use Supply.ProtoReflect.Descriptor instead.
+func (*Supply) Descriptor() ([]byte, []int) {
+	return file_cosmos_bank_v1beta1_bank_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *Supply) GetTotal() []*v1beta1.Coin {
+	if x != nil {
+		return x.Total
+	}
+	return nil
+}
+
+// DenomUnit represents a struct that describes a given
+// denomination unit of the basic token.
+type DenomUnit struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// denom represents the string name of the given denom unit (e.g uatom).
+	Denom string `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty"`
+	// exponent represents power of 10 exponent that one must
+	// raise the base_denom to in order to equal the given DenomUnit's denom
+	// 1 denom = 10^exponent base_denom
+	// (e.g. with a base_denom of uatom, one can create a DenomUnit of 'atom' with
+	// exponent = 6, thus: 1 atom = 10^6 uatom).
+	Exponent uint32 `protobuf:"varint,2,opt,name=exponent,proto3" json:"exponent,omitempty"`
+	// aliases is a list of string aliases for the given denom
+	Aliases []string `protobuf:"bytes,3,rep,name=aliases,proto3" json:"aliases,omitempty"`
+}
+
+func (x *DenomUnit) Reset() {
+	*x = DenomUnit{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cosmos_bank_v1beta1_bank_proto_msgTypes[7]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DenomUnit) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DenomUnit) ProtoMessage() {}
+
+// Deprecated: Use DenomUnit.ProtoReflect.Descriptor instead.
+func (*DenomUnit) Descriptor() ([]byte, []int) {
+	return file_cosmos_bank_v1beta1_bank_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *DenomUnit) GetDenom() string {
+	if x != nil {
+		return x.Denom
+	}
+	return ""
+}
+
+func (x *DenomUnit) GetExponent() uint32 {
+	if x != nil {
+		return x.Exponent
+	}
+	return 0
+}
+
+func (x *DenomUnit) GetAliases() []string {
+	if x != nil {
+		return x.Aliases
+	}
+	return nil
+}
+
+// Metadata represents a struct that describes
+// a basic token.
+type Metadata struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Description string `protobuf:"bytes,1,opt,name=description,proto3" json:"description,omitempty"`
+	// denom_units represents the list of DenomUnit's for a given coin
+	DenomUnits []*DenomUnit `protobuf:"bytes,2,rep,name=denom_units,json=denomUnits,proto3" json:"denom_units,omitempty"`
+	// base represents the base denom (should be the DenomUnit with exponent = 0).
+	Base string `protobuf:"bytes,3,opt,name=base,proto3" json:"base,omitempty"`
+	// display indicates the suggested denom that should be
+	// displayed in clients.
+	Display string `protobuf:"bytes,4,opt,name=display,proto3" json:"display,omitempty"`
+	// name defines the name of the token (eg: Cosmos Atom)
+	//
+	// Since: cosmos-sdk 0.43
+	Name string `protobuf:"bytes,5,opt,name=name,proto3" json:"name,omitempty"`
+	// symbol is the token symbol usually shown on exchanges (eg: ATOM). This can
+	// be the same as the display.
+	//
+	// Since: cosmos-sdk 0.43
+	Symbol string `protobuf:"bytes,6,opt,name=symbol,proto3" json:"symbol,omitempty"`
+	// URI to a document (on or off-chain) that contains additional information. Optional.
+	//
+	// Since: cosmos-sdk 0.46
+	Uri string `protobuf:"bytes,7,opt,name=uri,proto3" json:"uri,omitempty"`
+	// URIHash is a sha256 hash of a document pointed by URI. It's used to verify that
+	// the document didn't change. Optional.
+	//
+	// Since: cosmos-sdk 0.46
+	UriHash string `protobuf:"bytes,8,opt,name=uri_hash,json=uriHash,proto3" json:"uri_hash,omitempty"`
+}
+
+func (x *Metadata) Reset() {
+	*x = Metadata{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cosmos_bank_v1beta1_bank_proto_msgTypes[8]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Metadata) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Metadata) ProtoMessage() {}
+
+// Deprecated: Use Metadata.ProtoReflect.Descriptor instead.
+func (*Metadata) Descriptor() ([]byte, []int) {
+	return file_cosmos_bank_v1beta1_bank_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *Metadata) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *Metadata) GetDenomUnits() []*DenomUnit {
+	if x != nil {
+		return x.DenomUnits
+	}
+	return nil
+}
+
+func (x *Metadata) GetBase() string {
+	if x != nil {
+		return x.Base
+	}
+	return ""
+}
+
+func (x *Metadata) GetDisplay() string {
+	if x != nil {
+		return x.Display
+	}
+	return ""
+}
+
+func (x *Metadata) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Metadata) GetSymbol() string {
+	if x != nil {
+		return x.Symbol
+	}
+	return ""
+}
+
+func (x *Metadata) GetUri() string {
+	if x != nil {
+		return x.Uri
+	}
+	return ""
+}
+
+func (x *Metadata) GetUriHash() string {
+	if x != nil {
+		return x.UriHash
+	}
+	return ""
+}
+
+// Hold defines a portion of the balance of an account held by a holder, e.g. a
+// module escrowing it. The held coins stay in the account but cannot be spent
+// until they are released.
+//
+// Since: cosmos-sdk 0.48
+type Hold struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// address is the address of the account whose balance is held.
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	// holder is the name of the holder of the hold, e.g. a module name.
+	Holder string `protobuf:"bytes,2,opt,name=holder,proto3" json:"holder,omitempty"`
+	// amount is the amount of coins held.
+	Amount []*v1beta1.Coin `protobuf:"bytes,3,rep,name=amount,proto3" json:"amount,omitempty"`
+	// expiration is the time at which the hold is released automatically, if
+	// any.
+	Expiration *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=expiration,proto3" json:"expiration,omitempty"`
+}
+
+func (x *Hold) Reset() {
+	*x = Hold{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cosmos_bank_v1beta1_bank_proto_msgTypes[9]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Hold) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Hold) ProtoMessage() {}
+
+// Deprecated: Use Hold.ProtoReflect.Descriptor instead.
+func (*Hold) Descriptor() ([]byte, []int) {
+	return file_cosmos_bank_v1beta1_bank_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *Hold) GetAddress() string {
+	if x != nil {
+		return x.Address
+	}
+	return ""
+}
+
+func (x *Hold) GetHolder() string {
+	if x != nil {
+		return x.Holder
+	}
+	return ""
+}
+
+func (x *Hold) GetAmount() []*v1beta1.Coin {
+	if x != nil {
+		return x.Amount
+	}
+	return nil
+}
+
+func (x *Hold) GetExpiration() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Expiration
+	}
+	return nil
+}
+
+// BalanceSnapshot defines a merkleized snapshot of the balances of all the
+// holders of a denomination at a given height, e.g. for an airdrop.
+//
+// Since: cosmos-sdk 0.48
+type BalanceSnapshot struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// id is the unique identifier of the snapshot.
+	Id uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	// denom is the denomination of the balances of the snapshot.
+	Denom string `protobuf:"bytes,2,opt,name=denom,proto3" json:"denom,omitempty"`
+	// height is the height at the end of which the balances are recorded.
+	Height int64 `protobuf:"varint,3,opt,name=height,proto3" json:"height,omitempty"`
+	// taken is whether the balances were recorded, i.e. whether the height was
+	// reached.
+	Taken bool `protobuf:"varint,4,opt,name=taken,proto3" json:"taken,omitempty"`
+	// root is the merkle root of the balances of the snapshot, set once taken.
+	Root []byte `protobuf:"bytes,5,opt,name=root,proto3" json:"root,omitempty"`
+	// count is the number of holders of the denomination in the snapshot.
+	Count uint64 `protobuf:"varint,6,opt,name=count,proto3" json:"count,omitempty"`
+	// total is the sum of the balances of the snapshot.
+	Total string `protobuf:"bytes,7,opt,name=total,proto3" json:"total,omitempty"`
+}
+
+func (x *BalanceSnapshot) Reset() {
+	*x = BalanceSnapshot{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cosmos_bank_v1beta1_bank_proto_msgTypes[10]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BalanceSnapshot) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BalanceSnapshot) ProtoMessage() {}
+
+// Deprecated: Use BalanceSnapshot.ProtoReflect.Descriptor instead.
+func (*BalanceSnapshot) Descriptor() ([]byte, []int) {
+	return file_cosmos_bank_v1beta1_bank_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *BalanceSnapshot) GetId() uint64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *BalanceSnapshot) GetDenom() string {
+	if x != nil {
+		return x.Denom
+	}
+	return ""
+}
+
+func (x *BalanceSnapshot) GetHeight() int64 {
+	if x != nil {
+		return x.Height
+	}
+	return 0
+}
+
+func (x *BalanceSnapshot) GetTaken() bool {
+	if x != nil {
+		return x.Taken
+	}
+	return false
+}
+
+func (x *BalanceSnapshot) GetRoot() []byte {
+	if x != nil {
+		return x.Root
+	}
+	return nil
+}
+
+func (x *BalanceSnapshot) GetCount() uint64 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
+func (x *BalanceSnapshot) GetTotal() string {
+	if x != nil {
+		return x.Total
+	}
+	return ""
+}
+
+// SnapshotBalance defines the balance of an account recorded in a balance
+// snapshot.
+//
+// Since: cosmos-sdk 0.48
+type SnapshotBalance struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// address is the address of the account.
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	// amount is the balance of the account in the denomination of the snapshot.
+	Amount string `protobuf:"bytes,2,opt,name=amount,proto3" json:"amount,omitempty"`
+}
+
+func (x *SnapshotBalance) Reset() {
+	*x = SnapshotBalance{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cosmos_bank_v1beta1_bank_proto_msgTypes[11]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
 	}
 }
 
-// Code generated by protoc-gen-go. DO NOT EDIT.
-// versions:
-// 	protoc-gen-go v1.27.0
-// 	protoc        (unknown)
-// source: cosmos/bank/v1beta1/bank.proto
+func (x *SnapshotBalance) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
 
-const (
-	// Verify that this generated code is sufficiently up-to-date.
-	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
-	// Verify that runtime/protoimpl is sufficiently up-to-date.
-	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
-)
+func (*SnapshotBalance) ProtoMessage() {}
 
-// Params defines the parameters for the bank module.
-type Params struct {
+// Deprecated: Use SnapshotBalance.ProtoReflect.Descriptor instead.
+func (*SnapshotBalance) Descriptor() ([]byte, []int) {
+	return file_cosmos_bank_v1beta1_bank_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *SnapshotBalance) GetAddress() string {
+	if x != nil {
+		return x.Address
+	}
+	return ""
+}
+
+func (x *SnapshotBalance) GetAmount() string {
+	if x != nil {
+		return x.Amount
+	}
+	return ""
+}
+
+// BalanceSnapshotProof defines the merkle proof of inclusion of a balance in
+// the root of a balance snapshot. The leaves of the merkle tree are the
+// length-prefixed addresses of the holders followed by the decimal string of
+// their balances, in address order, and the tree is hashed as the CometBFT
+// simple merkle tree.
+//
+// Since: cosmos-sdk 0.48
+type BalanceSnapshotProof struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// Deprecated: Use of SendEnabled in params is deprecated.
-	// For genesis, use the newly added send_enabled field in the genesis object.
-	// Storage, lookup, and manipulation of this information is now in the keeper.
-	//
-	// As of cosmos-sdk 0.47, this only exists for backwards compatibility of genesis files.
-	//
-	// Deprecated: Do not use.
-	SendEnabled        []*SendEnabled `protobuf:"bytes,1,rep,name=send_enabled,json=sendEnabled,proto3" json:"send_enabled,omitempty"`
-	DefaultSendEnabled bool           `protobuf:"varint,2,opt,name=default_send_enabled,json=defaultSendEnabled,proto3" json:"default_send_enabled,omitempty"`
+	// total is the number of leaves of the merkle tree.
+	Total int64 `protobuf:"varint,1,opt,name=total,proto3" json:"total,omitempty"`
+	// index is the index of the leaf of the balance.
+	Index int64 `protobuf:"varint,2,opt,name=index,proto3" json:"index,omitempty"`
+	// leaf_hash is the hash of the leaf of the balance.
+	LeafHash []byte `protobuf:"bytes,3,opt,name=leaf_hash,json=leafHash,proto3" json:"leaf_hash,omitempty"`
+	// aunts are the hashes of the sibling subtrees from the leaf to the root.
+	Aunts [][]byte `protobuf:"bytes,4,rep,name=aunts,proto3" json:"aunts,omitempty"`
 }
 
-func (x *Params) Reset() {
-	*x = Params{}
+func (x *BalanceSnapshotProof) Reset() {
+	*x = BalanceSnapshotProof{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_cosmos_bank_v1beta1_bank_proto_msgTypes[0]
+		mi := &file_cosmos_bank_v1beta1_bank_proto_msgTypes[12]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *Params) String() string {
+func (x *BalanceSnapshotProof) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Params) ProtoMessage() {}
+func (*BalanceSnapshotProof) ProtoMessage() {}
 
-// Deprecated: Use Params.ProtoReflect.Descriptor instead.
-func (*Params) Descriptor() ([]byte, []int) {
-	return file_cosmos_bank_v1beta1_bank_proto_rawDescGZIP(), []int{0}
+// Deprecated: Use BalanceSnapshotProof.ProtoReflect.Descriptor instead.
+func (*BalanceSnapshotProof) Descriptor() ([]byte, []int) {
+	return file_cosmos_bank_v1beta1_bank_proto_rawDescGZIP(), []int{12}
 }
 
-// Deprecated: Do not use.
-func (x *Params) GetSendEnabled() []*SendEnabled {
+func (x *BalanceSnapshotProof) GetTotal() int64 {
 	if x != nil {
-		return x.SendEnabled
+		return x.Total
+	}
+	return 0
+}
+
+func (x *BalanceSnapshotProof) GetIndex() int64 {
+	if x != nil {
+		return x.Index
+	}
+	return 0
+}
+
+func (x *BalanceSnapshotProof) GetLeafHash() []byte {
+	if x != nil {
+		return x.LeafHash
 	}
 	return nil
 }
 
-func (x *Params) GetDefaultSendEnabled() bool {
+func (x *BalanceSnapshotProof) GetAunts() [][]byte {
 	if x != nil {
-		return x.DefaultSendEnabled
+		return x.Aunts
 	}
-	return false
+	return nil
 }
 
-// SendEnabled maps coin denom to a send_enabled status (whether a denom is
-// sendable).
-type SendEnabled struct {
+// MintBurnPermissions defines the module accounts or addresses allowed to mint
+// and to burn a denomination. A denomination without mint/burn permissions can
+// be minted and burned by any module account with the minter and burner
+// permissions. Each entry is either the name of a module or the address of its
+// module account.
+//
+// Since: cosmos-sdk 0.48
+type MintBurnPermissions struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Denom   string `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty"`
-	Enabled bool   `protobuf:"varint,2,opt,name=enabled,proto3" json:"enabled,omitempty"`
+	// denom is the denomination the permissions apply to.
+	Denom string `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty"`
+	// minters are the modules allowed to mint the denomination.
+	Minters []string `protobuf:"bytes,2,rep,name=minters,proto3" json:"minters,omitempty"`
+	// burners are the modules allowed to burn the denomination.
+	Burners []string `protobuf:"bytes,3,rep,name=burners,proto3" json:"burners,omitempty"`
 }
 
-func (x *SendEnabled) Reset() {
-	*x = SendEnabled{}
+func (x *MintBurnPermissions) Reset() {
+	*x = MintBurnPermissions{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_cosmos_bank_v1beta1_bank_proto_msgTypes[1]
+		mi := &file_cosmos_bank_v1beta1_bank_proto_msgTypes[13]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *SendEnabled) String() string {
+func (x *MintBurnPermissions) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SendEnabled) ProtoMessage() {}
+func (*MintBurnPermissions) ProtoMessage() {}
 
-// Deprecated: Use SendEnabled.ProtoReflect.Descriptor instead.
-func (*SendEnabled) Descriptor() ([]byte, []int) {
-	return file_cosmos_bank_v1beta1_bank_proto_rawDescGZIP(), []int{1}
+// Deprecated: Use MintBurnPermissions.ProtoReflect.Descriptor instead.
+func (*MintBurnPermissions) Descriptor() ([]byte, []int) {
+	return file_cosmos_bank_v1beta1_bank_proto_rawDescGZIP(), []int{13}
 }
 
-func (x *SendEnabled) GetDenom() string {
+func (x *MintBurnPermissions) GetDenom() string {
 	if x != nil {
 		return x.Denom
 	}
 	return ""
 }
 
-func (x *SendEnabled) GetEnabled() bool {
+func (x *MintBurnPermissions) GetMinters() []string {
 	if x != nil {
-		return x.Enabled
+		return x.Minters
 	}
-	return false
+	return nil
 }
 
-// Input models transaction input.
-type Input struct {
+func (x *MintBurnPermissions) GetBurners() []string {
+	if x != nil {
+		return x.Burners
+	}
+	return nil
+}
+
+// ModuleEscrow defines the amount of coins escrowed by a module account for a
+// purpose, e.g. the deposits of a governance proposal. The escrows are tracked
+// by the modules at deposit and withdrawal time, so that the balance of their
+// module accounts can be reconciled with the escrowed amounts.
+//
+// Since: cosmos-sdk 0.48
+type ModuleEscrow struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Address string          `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
-	Coins   []*v1beta1.Coin `protobuf:"bytes,2,rep,name=coins,proto3" json:"coins,omitempty"`
+	// purpose is the purpose of the escrow, e.g. "proposal/1".
+	Purpose string `protobuf:"bytes,1,opt,name=purpose,proto3" json:"purpose,omitempty"`
+	// amount is the amount of coins escrowed for the purpose.
+	Amount []*v1beta1.Coin `protobuf:"bytes,2,rep,name=amount,proto3" json:"amount,omitempty"`
 }
 
-func (x *Input) Reset() {
-	*x = Input{}
+func (x *ModuleEscrow) Reset() {
+	*x = ModuleEscrow{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_cosmos_bank_v1beta1_bank_proto_msgTypes[2]
+		mi := &file_cosmos_bank_v1beta1_bank_proto_msgTypes[14]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *Input) String() string {
+func (x *ModuleEscrow) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Input) ProtoMessage() {}
+func (*ModuleEscrow) ProtoMessage() {}
 
-// Deprecated: Use Input.ProtoReflect.Descriptor instead.
-func (*Input) Descriptor() ([]byte, []int) {
-	return file_cosmos_bank_v1beta1_bank_proto_rawDescGZIP(), []int{2}
+// Deprecated: Use ModuleEscrow.ProtoReflect.Descriptor instead.
+func (*ModuleEscrow) Descriptor() ([]byte, []int) {
+	return file_cosmos_bank_v1beta1_bank_proto_rawDescGZIP(), []int{14}
 }
 
-func (x *Input) GetAddress() string {
+func (x *ModuleEscrow) GetPurpose() string {
 	if x != nil {
-		return x.Address
+		return x.Purpose
 	}
 	return ""
 }
 
-func (x *Input) GetCoins() []*v1beta1.Coin {
+func (x *ModuleEscrow) GetAmount() []*v1beta1.Coin {
 	if x != nil {
-		return x.Coins
+		return x.Amount
 	}
 	return nil
 }
 
-// Output models transaction outputs.
-type Output struct {
+// TimelockedTransfer defines a transfer of coins which its recipient can claim
+// once its unlock time is reached, and its sender can reclaim once its expiry
+// time is reached if it is still unclaimed. The coins stay held in the account
+// of the sender until the transfer is claimed or reclaimed.
+//
+// Since: cosmos-sdk 0.48
+type TimelockedTransfer struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Address string          `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
-	Coins   []*v1beta1.Coin `protobuf:"bytes,2,rep,name=coins,proto3" json:"coins,omitempty"`
+	// id is the unique identifier of the transfer.
+	Id uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	// sender is the address of the account sending the coins.
+	Sender string `protobuf:"bytes,2,opt,name=sender,proto3" json:"sender,omitempty"`
+	// recipient is the address of the account allowed to claim the coins.
+	Recipient string `protobuf:"bytes,3,opt,name=recipient,proto3" json:"recipient,omitempty"`
+	// amount is the amount of coins transferred.
+	Amount []*v1beta1.Coin `protobuf:"bytes,4,rep,name=amount,proto3" json:"amount,omitempty"`
+	// unlock_time is the time from which the recipient can claim the coins.
+	UnlockTime *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=unlock_time,json=unlockTime,proto3" json:"unlock_time,omitempty"`
+	// expiry_time is the time from which the recipient can no longer claim the
+	// coins, and the sender can reclaim them.
+	ExpiryTime *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=expiry_time,json=expiryTime,proto3" json:"expiry_time,omitempty"`
+}
+
+func (x *TimelockedTransfer) Reset() {
+	*x = TimelockedTransfer{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cosmos_bank_v1beta1_bank_proto_msgTypes[15]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
 }
 
-func (x *Output) Reset() {
-	*x = Output{}
+func (x *TimelockedTransfer) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TimelockedTransfer) ProtoMessage() {}
+
+// Deprecated: Use TimelockedTransfer.ProtoReflect.Descriptor instead.
+func (*TimelockedTransfer) Descriptor() ([]byte, []int) {
+	return file_cosmos_bank_v1beta1_bank_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *TimelockedTransfer) GetId() uint64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *TimelockedTransfer) GetSender() string {
+	if x != nil {
+		return x.Sender
+	}
+	return ""
+}
+
+func (x *TimelockedTransfer) GetRecipient() string {
+	if x != nil {
+		return x.Recipient
+	}
+	return ""
+}
+
+func (x *TimelockedTransfer) GetAmount() []*v1beta1.Coin {
+	if x != nil {
+		return x.Amount
+	}
+	return nil
+}
+
+func (x *TimelockedTransfer) GetUnlockTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UnlockTime
+	}
+	return nil
+}
+
+func (x *TimelockedTransfer) GetExpiryTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ExpiryTime
+	}
+	return nil
+}
+
+// SpendingLimit defines an opt-in policy limiting the coins an account can
+// send, per denomination, within any rolling window, e.g. to cap the losses of
+// a compromised hot wallet.
+//
+// Since: cosmos-sdk 0.48
+type SpendingLimit struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// address is the address of the account whose transfers are limited.
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	// limits are the maximum amounts of the denominations the account can send
+	// within a window. The other denominations are not limited.
+	Limits []*v1beta1.Coin `protobuf:"bytes,2,rep,name=limits,proto3" json:"limits,omitempty"`
+	// window is the duration of the rolling window.
+	Window *durationpb.Duration `protobuf:"bytes,3,opt,name=window,proto3" json:"window,omitempty"`
+	// spent are the coins sent by the account within the last window, in the
+	// order of their transfer times.
+	Spent []*SpendingRecord `protobuf:"bytes,4,rep,name=spent,proto3" json:"spent,omitempty"`
+	// pending is the change of the policy loosening it, if any, which takes
+	// effect once its effective time is reached.
+	Pending *PendingSpendingLimit `protobuf:"bytes,5,opt,name=pending,proto3" json:"pending,omitempty"`
+}
+
+func (x *SpendingLimit) Reset() {
+	*x = SpendingLimit{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_cosmos_bank_v1beta1_bank_proto_msgTypes[3]
+		mi := &file_cosmos_bank_v1beta1_bank_proto_msgTypes[16]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *Output) String() string {
+func (x *SpendingLimit) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Output) ProtoMessage() {}
+func (*SpendingLimit) ProtoMessage() {}
 
-// Deprecated: Use Output.ProtoReflect.Descriptor instead.
-func (*Output) Descriptor() ([]byte, []int) {
-	return file_cosmos_bank_v1beta1_bank_proto_rawDescGZIP(), []int{3}
+// Deprecated: Use SpendingLimit.ProtoReflect.Descriptor instead.
+func (*SpendingLimit) Descriptor() ([]byte, []int) {
+	return file_cosmos_bank_v1beta1_bank_proto_rawDescGZIP(), []int{16}
 }
 
-func (x *Output) GetAddress() string {
+func (x *SpendingLimit) GetAddress() string {
 	if x != nil {
 		return x.Address
 	}
 	return ""
 }
 
-func (x *Output) GetCoins() []*v1beta1.Coin {
+func (x *SpendingLimit) GetLimits() []*v1beta1.Coin {
 	if x != nil {
-		return x.Coins
+		return x.Limits
 	}
 	return nil
 }
 
-// Supply represents a struct that passively keeps track of the total supply
-// amounts in the network.
-// This message is deprecated now that supply is indexed by denom.
+func (x *SpendingLimit) GetWindow() *durationpb.Duration {
+	if x != nil {
+		return x.Window
+	}
+	return nil
+}
+
+func (x *SpendingLimit) GetSpent() []*SpendingRecord {
+	if x != nil {
+		return x.Spent
+	}
+	return nil
+}
+
+func (x *SpendingLimit) GetPending() *PendingSpendingLimit {
+	if x != nil {
+		return x.Pending
+	}
+	return nil
+}
+
+// SpendingRecord defines the coins sent by an account limited by a spending
+// limit at a given time.
 //
-// Deprecated: Do not use.
-type Supply struct {
+// Since: cosmos-sdk 0.48
+type SpendingRecord struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Total []*v1beta1.Coin `protobuf:"bytes,1,rep,name=total,proto3" json:"total,omitempty"`
+	// time is the time of the transfers.
+	Time *timestamppb.Timestamp `protobuf:"bytes,1,opt,name=time,proto3" json:"time,omitempty"`
+	// amount is the amount of coins sent at that time.
+	Amount []*v1beta1.Coin `protobuf:"bytes,2,rep,name=amount,proto3" json:"amount,omitempty"`
 }
 
-func (x *Supply) Reset() {
-	*x = Supply{}
+func (x *SpendingRecord) Reset() {
+	*x = SpendingRecord{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_cosmos_bank_v1beta1_bank_proto_msgTypes[4]
+		mi := &file_cosmos_bank_v1beta1_bank_proto_msgTypes[17]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *Supply) String() string {
+func (x *SpendingRecord) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Supply) ProtoMessage() {}
+func (*SpendingRecord) ProtoMessage() {}
 
-// Deprecated: Use Supply.ProtoReflect.Descriptor instead.
-func (*Supply) Descriptor() ([]byte, []int) {
-	return file_cosmos_bank_v1beta1_bank_proto_rawDescGZIP(), []int{4}
+// Deprecated: Use SpendingRecord.ProtoReflect.Descriptor instead.
+func (*SpendingRecord) Descriptor() ([]byte, []int) {
+	return file_cosmos_bank_v1beta1_bank_proto_rawDescGZIP(), []int{17}
 }
 
-func (x *Supply) GetTotal() []*v1beta1.Coin {
+func (x *SpendingRecord) GetTime() *timestamppb.Timestamp {
 	if x != nil {
-		return x.Total
+		return x.Time
 	}
 	return nil
 }
 
-// DenomUnit represents a struct that describes a given
-// denomination unit of the basic token.
-type DenomUnit struct {
+func (x *SpendingRecord) GetAmount() []*v1beta1.Coin {
+	if x != nil {
+		return x.Amount
+	}
+	return nil
+}
+
+// PendingSpendingLimit defines a change of a spending limit loosening it,
+// which only takes effect after a window of the current spending limit, so
+// that a compromised key cannot lift the limit at once.
+//
+// Since: cosmos-sdk 0.48
+type PendingSpendingLimit struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// denom represents the string name of the given denom unit (e.g uatom).
-	Denom string `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty"`
-	// exponent represents power of 10 exponent that one must
-	// raise the base_denom to in order to equal the given DenomUnit's denom
-	// 1 denom = 10^exponent base_denom
-	// (e.g. with a base_denom of uatom, one can create a DenomUnit of 'atom' with
-	// exponent = 6, thus: 1 atom = 10^6 uatom).
-	Exponent uint32 `protobuf:"varint,2,opt,name=exponent,proto3" json:"exponent,omitempty"`
-	// aliases is a list of string aliases for the given denom
-	Aliases []string `protobuf:"bytes,3,rep,name=aliases,proto3" json:"aliases,omitempty"`
+	// limits are the new limits of the spending limit. The spending limit is
+	// removed if empty.
+	Limits []*v1beta1.Coin `protobuf:"bytes,1,rep,name=limits,proto3" json:"limits,omitempty"`
+	// window is the new duration of the rolling window.
+	Window *durationpb.Duration `protobuf:"bytes,2,opt,name=window,proto3" json:"window,omitempty"`
+	// effective_time is the time from which the change takes effect.
+	EffectiveTime *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=effective_time,json=effectiveTime,proto3" json:"effective_time,omitempty"`
 }
 
-func (x *DenomUnit) Reset() {
-	*x = DenomUnit{}
+func (x *PendingSpendingLimit) Reset() {
+	*x = PendingSpendingLimit{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_cosmos_bank_v1beta1_bank_proto_msgTypes[5]
+		mi := &file_cosmos_bank_v1beta1_bank_proto_msgTypes[18]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *DenomUnit) String() string {
+func (x *PendingSpendingLimit) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DenomUnit) ProtoMessage() {}
+func (*PendingSpendingLimit) ProtoMessage() {}
 
-// Deprecated: Use DenomUnit.ProtoReflect.Descriptor instead.
-func (*DenomUnit) Descriptor() ([]byte, []int) {
-	return file_cosmos_bank_v1beta1_bank_proto_rawDescGZIP(), []int{5}
+// Deprecated: Use PendingSpendingLimit.ProtoReflect.Descriptor instead.
+func (*PendingSpendingLimit) Descriptor() ([]byte, []int) {
+	return file_cosmos_bank_v1beta1_bank_proto_rawDescGZIP(), []int{18}
 }
 
-func (x *DenomUnit) GetDenom() string {
+func (x *PendingSpendingLimit) GetLimits() []*v1beta1.Coin {
 	if x != nil {
-		return x.Denom
+		return x.Limits
 	}
-	return ""
+	return nil
 }
 
-func (x *DenomUnit) GetExponent() uint32 {
+func (x *PendingSpendingLimit) GetWindow() *durationpb.Duration {
 	if x != nil {
-		return x.Exponent
+		return x.Window
 	}
-	return 0
+	return nil
 }
 
-func (x *DenomUnit) GetAliases() []string {
+func (x *PendingSpendingLimit) GetEffectiveTime() *timestamppb.Timestamp {
 	if x != nil {
-		return x.Aliases
+		return x.EffectiveTime
 	}
 	return nil
 }
 
-// Metadata represents a struct that describes
-// a basic token.
-type Metadata struct {
+// DemurrageSchedule defines the decay, or the expiry, of the balances of a
+// denomination, e.g. loyalty points, which are reduced at the end of each
+// epoch. The balances are not iterated: the decay of a balance is settled
+// lazily, from the epoch of its last settlement, when it is next changed.
+//
+// Since: cosmos-sdk 0.48
+type DemurrageSchedule struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Description string `protobuf:"bytes,1,opt,name=description,proto3" json:"description,omitempty"`
-	// denom_units represents the list of DenomUnit's for a given coin
-	DenomUnits []*DenomUnit `protobuf:"bytes,2,rep,name=denom_units,json=denomUnits,proto3" json:"denom_units,omitempty"`
-	// base represents the base denom (should be the DenomUnit with exponent = 0).
-	Base string `protobuf:"bytes,3,opt,name=base,proto3" json:"base,omitempty"`
-	// display indicates the suggested denom that should be
-	// displayed in clients.
-	Display string `protobuf:"bytes,4,opt,name=display,proto3" json:"display,omitempty"`
-	// name defines the name of the token (eg: Cosmos Atom)
-	//
-	// Since: cosmos-sdk 0.43
-	Name string `protobuf:"bytes,5,opt,name=name,proto3" json:"name,omitempty"`
-	// symbol is the token symbol usually shown on exchanges (eg: ATOM). This can
-	// be the same as the display.
-	//
-	// Since: cosmos-sdk 0.43
-	Symbol string `protobuf:"bytes,6,opt,name=symbol,proto3" json:"symbol,omitempty"`
-	// URI to a document (on or off-chain) that contains additional information. Optional.
-	//
-	// Since: cosmos-sdk 0.46
-	Uri string `protobuf:"bytes,7,opt,name=uri,proto3" json:"uri,omitempty"`
-	// URIHash is a sha256 hash of a document pointed by URI. It's used to verify that
-	// the document didn't change. Optional.
-	//
-	// Since: cosmos-sdk 0.46
-	UriHash string `protobuf:"bytes,8,opt,name=uri_hash,json=uriHash,proto3" json:"uri_hash,omitempty"`
+	// denom is the denomination whose balances decay.
+	Denom string `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty"`
+	// epoch_length is the number of blocks of an epoch.
+	EpochLength int64 `protobuf:"varint,2,opt,name=epoch_length,json=epochLength,proto3" json:"epoch_length,omitempty"`
+	// epoch is the number of epochs elapsed since the schedule was created.
+	Epoch uint64 `protobuf:"varint,3,opt,name=epoch,proto3" json:"epoch,omitempty"`
+	// segments are the rates of the schedule, in the order of their start
+	// epochs, the last one being the current rate.
+	Segments []*DemurrageSegment `protobuf:"bytes,4,rep,name=segments,proto3" json:"segments,omitempty"`
 }
 
-func (x *Metadata) Reset() {
-	*x = Metadata{}
+func (x *DemurrageSchedule) Reset() {
+	*x = DemurrageSchedule{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_cosmos_bank_v1beta1_bank_proto_msgTypes[6]
+		mi := &file_cosmos_bank_v1beta1_bank_proto_msgTypes[19]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *Metadata) String() string {
+func (x *DemurrageSchedule) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Metadata) ProtoMessage() {}
+func (*DemurrageSchedule) ProtoMessage() {}
 
-// Deprecated: Use Metadata.ProtoReflect.Descriptor instead.
-func (*Metadata) Descriptor() ([]byte, []int) {
-	return file_cosmos_bank_v1beta1_bank_proto_rawDescGZIP(), []int{6}
+// Deprecated: Use DemurrageSchedule.ProtoReflect.Descriptor instead.
+func (*DemurrageSchedule) Descriptor() ([]byte, []int) {
+	return file_cosmos_bank_v1beta1_bank_proto_rawDescGZIP(), []int{19}
 }
 
-func (x *Metadata) GetDescription() string {
+func (x *DemurrageSchedule) GetDenom() string {
 	if x != nil {
-		return x.Description
+		return x.Denom
 	}
 	return ""
 }
 
-func (x *Metadata) GetDenomUnits() []*DenomUnit {
+func (x *DemurrageSchedule) GetEpochLength() int64 {
 	if x != nil {
-		return x.DenomUnits
+		return x.EpochLength
 	}
-	return nil
+	return 0
 }
 
-func (x *Metadata) GetBase() string {
+func (x *DemurrageSchedule) GetEpoch() uint64 {
 	if x != nil {
-		return x.Base
+		return x.Epoch
 	}
-	return ""
+	return 0
 }
 
-func (x *Metadata) GetDisplay() string {
+func (x *DemurrageSchedule) GetSegments() []*DemurrageSegment {
 	if x != nil {
-		return x.Display
+		return x.Segments
 	}
-	return ""
+	return nil
 }
 
-func (x *Metadata) GetName() string {
-	if x != nil {
-		return x.Name
-	}
-	return ""
+// DemurrageSegment defines the rate of a demurrage schedule from an epoch,
+// until the start epoch of the next segment, if any.
+//
+// Since: cosmos-sdk 0.48
+type DemurrageSegment struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// start_epoch is the first epoch the rate applies to.
+	StartEpoch uint64 `protobuf:"varint,1,opt,name=start_epoch,json=startEpoch,proto3" json:"start_epoch,omitempty"`
+	// rate is the fraction of the balances burnt at the end of each epoch,
+	// between 0 and 1. A rate of 1 expires the balances at the end of each
+	// epoch, and a rate of 0 pauses the schedule.
+	Rate string `protobuf:"bytes,2,opt,name=rate,proto3" json:"rate,omitempty"`
 }
 
-func (x *Metadata) GetSymbol() string {
-	if x != nil {
-		return x.Symbol
+func (x *DemurrageSegment) Reset() {
+	*x = DemurrageSegment{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cosmos_bank_v1beta1_bank_proto_msgTypes[20]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
 	}
-	return ""
 }
 
-func (x *Metadata) GetUri() string {
+func (x *DemurrageSegment) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DemurrageSegment) ProtoMessage() {}
+
+// Deprecated: Use DemurrageSegment.ProtoReflect.Descriptor instead.
+func (*DemurrageSegment) Descriptor() ([]byte, []int) {
+	return file_cosmos_bank_v1beta1_bank_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *DemurrageSegment) GetStartEpoch() uint64 {
 	if x != nil {
-		return x.Uri
+		return x.StartEpoch
 	}
-	return ""
+	return 0
 }
 
-func (x *Metadata) GetUriHash() string {
+func (x *DemurrageSegment) GetRate() string {
 	if x != nil {
-		return x.UriHash
+		return x.Rate
 	}
 	return ""
 }
@@ -4606,34 +14915,78 @@ var file_cosmos_bank_v1beta1_bank_proto_rawDesc = []byte{
 	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x17, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2f, 0x6d,
 	0x73, 0x67, 0x2f, 0x76, 0x31, 0x2f, 0x6d, 0x73, 0x67, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a,
 	0x11, 0x61, 0x6d, 0x69, 0x6e, 0x6f, 0x2f, 0x61, 0x6d, 0x69, 0x6e, 0x6f, 0x2e, 0x70, 0x72, 0x6f,
-	0x74, 0x6f, 0x22, 0xa2, 0x01, 0x0a, 0x06, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x12, 0x47, 0x0a,
-	0x0c, 0x73, 0x65, 0x6e, 0x64, 0x5f, 0x65, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x18, 0x01, 0x20,
-	0x03, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x62, 0x61, 0x6e,
-	0x6b, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x53, 0x65, 0x6e, 0x64, 0x45, 0x6e,
-	0x61, 0x62, 0x6c, 0x65, 0x64, 0x42, 0x02, 0x18, 0x01, 0x52, 0x0b, 0x73, 0x65, 0x6e, 0x64, 0x45,
-	0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x12, 0x30, 0x0a, 0x14, 0x64, 0x65, 0x66, 0x61, 0x75, 0x6c,
-	0x74, 0x5f, 0x73, 0x65, 0x6e, 0x64, 0x5f, 0x65, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x18, 0x02,
-	0x20, 0x01, 0x28, 0x08, 0x52, 0x12, 0x64, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x53, 0x65, 0x6e,
-	0x64, 0x45, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x3a, 0x1d, 0x8a, 0xe7, 0xb0, 0x2a, 0x18, 0x63,
-	0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2d, 0x73, 0x64, 0x6b, 0x2f, 0x78, 0x2f, 0x62, 0x61, 0x6e, 0x6b,
-	0x2f, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x22, 0x43, 0x0a, 0x0b, 0x53, 0x65, 0x6e, 0x64, 0x45,
-	0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x64, 0x65, 0x6e, 0x6f, 0x6d, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x64, 0x65, 0x6e, 0x6f, 0x6d, 0x12, 0x18, 0x0a, 0x07,
-	0x65, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x65,
-	0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x3a, 0x04, 0xe8, 0xa0, 0x1f, 0x01, 0x22, 0xca, 0x01, 0x0a,
-	0x05, 0x49, 0x6e, 0x70, 0x75, 0x74, 0x12, 0x32, 0x0a, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73,
-	0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x18, 0xd2, 0xb4, 0x2d, 0x14, 0x63, 0x6f, 0x73,
-	0x6d, 0x6f, 0x73, 0x2e, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x53, 0x74, 0x72, 0x69, 0x6e,
-	0x67, 0x52, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x77, 0x0a, 0x05, 0x63, 0x6f,
-	0x69, 0x6e, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x63, 0x6f, 0x73, 0x6d,
-	0x6f, 0x73, 0x2e, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e,
-	0x43, 0x6f, 0x69, 0x6e, 0x42, 0x46, 0xc8, 0xde, 0x1f, 0x00, 0xaa, 0xdf, 0x1f, 0x28, 0x67, 0x69,
-	0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2f,
-	0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2d, 0x73, 0x64, 0x6b, 0x2f, 0x74, 0x79, 0x70, 0x65, 0x73,
-	0x2e, 0x43, 0x6f, 0x69, 0x6e, 0x73, 0x9a, 0xe7, 0xb0, 0x2a, 0x0c, 0x6c, 0x65, 0x67, 0x61, 0x63,
-	0x79, 0x5f, 0x63, 0x6f, 0x69, 0x6e, 0x73, 0xa8, 0xe7, 0xb0, 0x2a, 0x01, 0x52, 0x05, 0x63, 0x6f,
-	0x69, 0x6e, 0x73, 0x3a, 0x14, 0x88, 0xa0, 0x1f, 0x00, 0xe8, 0xa0, 0x1f, 0x00, 0x82, 0xe7, 0xb0,
-	0x2a, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x22, 0xbf, 0x01, 0x0a, 0x06, 0x4f, 0x75,
+	0x74, 0x6f, 0x1a, 0x1f, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x62, 0x75, 0x66, 0x2f, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x1a, 0x1e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x62, 0x75, 0x66, 0x2f, 0x64, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x22, 0xf2, 0x01, 0x0a, 0x06, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x12, 0x47,
+	0x0a, 0x0c, 0x73, 0x65, 0x6e, 0x64, 0x5f, 0x65, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x18, 0x01,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x62, 0x61,
+	0x6e, 0x6b, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x53, 0x65, 0x6e, 0x64, 0x45,
+	0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x42, 0x02, 0x18, 0x01, 0x52, 0x0b, 0x73, 0x65, 0x6e, 0x64,
+	0x45, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x12, 0x30, 0x0a, 0x14, 0x64, 0x65, 0x66, 0x61, 0x75,
+	0x6c, 0x74, 0x5f, 0x73, 0x65, 0x6e, 0x64, 0x5f, 0x65, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x12, 0x64, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x53, 0x65,
+	0x6e, 0x64, 0x45, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x12, 0x4e, 0x0a, 0x0a, 0x64, 0x75, 0x73,
+	0x74, 0x5f, 0x73, 0x77, 0x65, 0x65, 0x70, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x24, 0x2e,
+	0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x62, 0x61, 0x6e, 0x6b, 0x2e, 0x76, 0x31, 0x62, 0x65,
+	0x74, 0x61, 0x31, 0x2e, 0x44, 0x75, 0x73, 0x74, 0x53, 0x77, 0x65, 0x65, 0x70, 0x50, 0x61, 0x72,
+	0x61, 0x6d, 0x73, 0x42, 0x09, 0xc8, 0xde, 0x1f, 0x00, 0xa8, 0xe7, 0xb0, 0x2a, 0x01, 0x52, 0x09,
+	0x64, 0x75, 0x73, 0x74, 0x53, 0x77, 0x65, 0x65, 0x70, 0x3a, 0x1d, 0x8a, 0xe7, 0xb0, 0x2a, 0x18,
+	0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2d, 0x73, 0x64, 0x6b, 0x2f, 0x78, 0x2f, 0x62, 0x61, 0x6e,
+	0x6b, 0x2f, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x22, 0xe5, 0x02, 0x0a, 0x0f, 0x44, 0x75, 0x73,
+	0x74, 0x53, 0x77, 0x65, 0x65, 0x70, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x12, 0x81, 0x01, 0x0a,
+	0x0a, 0x74, 0x68, 0x72, 0x65, 0x73, 0x68, 0x6f, 0x6c, 0x64, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x19, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x62, 0x61, 0x73, 0x65, 0x2e,
+	0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x43, 0x6f, 0x69, 0x6e, 0x42, 0x46, 0xc8, 0xde,
+	0x1f, 0x00, 0xaa, 0xdf, 0x1f, 0x28, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d,
+	0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2d, 0x73,
+	0x64, 0x6b, 0x2f, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x43, 0x6f, 0x69, 0x6e, 0x73, 0x9a, 0xe7,
+	0xb0, 0x2a, 0x0c, 0x6c, 0x65, 0x67, 0x61, 0x63, 0x79, 0x5f, 0x63, 0x6f, 0x69, 0x6e, 0x73, 0xa8,
+	0xe7, 0xb0, 0x2a, 0x01, 0x52, 0x0a, 0x74, 0x68, 0x72, 0x65, 0x73, 0x68, 0x6f, 0x6c, 0x64, 0x73,
+	0x12, 0x21, 0x0a, 0x0c, 0x65, 0x70, 0x6f, 0x63, 0x68, 0x5f, 0x6c, 0x65, 0x6e, 0x67, 0x74, 0x68,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0b, 0x65, 0x70, 0x6f, 0x63, 0x68, 0x4c, 0x65, 0x6e,
+	0x67, 0x74, 0x68, 0x12, 0x1d, 0x0a, 0x0a, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x5f, 0x77, 0x69, 0x64,
+	0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x09, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x57, 0x69,
+	0x64, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x62, 0x75, 0x72, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08,
+	0x52, 0x04, 0x62, 0x75, 0x72, 0x6e, 0x12, 0x43, 0x0a, 0x10, 0x65, 0x78, 0x65, 0x6d, 0x70, 0x74,
+	0x5f, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x65, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x09,
+	0x42, 0x18, 0xd2, 0xb4, 0x2d, 0x14, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x41, 0x64, 0x64,
+	0x72, 0x65, 0x73, 0x73, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x52, 0x0f, 0x65, 0x78, 0x65, 0x6d,
+	0x70, 0x74, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x65, 0x73, 0x12, 0x33, 0x0a, 0x16, 0x6d,
+	0x61, 0x78, 0x5f, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x73, 0x5f, 0x70, 0x65, 0x72, 0x5f,
+	0x65, 0x70, 0x6f, 0x63, 0x68, 0x18, 0x06, 0x20, 0x01, 0x28, 0x04, 0x52, 0x13, 0x6d, 0x61, 0x78,
+	0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x73, 0x50, 0x65, 0x72, 0x45, 0x70, 0x6f, 0x63, 0x68,
+	0x22, 0x43, 0x0a, 0x0b, 0x53, 0x65, 0x6e, 0x64, 0x45, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x12,
+	0x14, 0x0a, 0x05, 0x64, 0x65, 0x6e, 0x6f, 0x6d, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05,
+	0x64, 0x65, 0x6e, 0x6f, 0x6d, 0x12, 0x18, 0x0a, 0x07, 0x65, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x65, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x3a,
+	0x04, 0xe8, 0xa0, 0x1f, 0x01, 0x22, 0xca, 0x01, 0x0a, 0x05, 0x49, 0x6e, 0x70, 0x75, 0x74, 0x12,
+	0x32, 0x0a, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x42, 0x18, 0xd2, 0xb4, 0x2d, 0x14, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x41, 0x64, 0x64,
+	0x72, 0x65, 0x73, 0x73, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x52, 0x07, 0x61, 0x64, 0x64, 0x72,
+	0x65, 0x73, 0x73, 0x12, 0x77, 0x0a, 0x05, 0x63, 0x6f, 0x69, 0x6e, 0x73, 0x18, 0x02, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x19, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x62, 0x61, 0x73, 0x65,
+	0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x43, 0x6f, 0x69, 0x6e, 0x42, 0x46, 0xc8,
+	0xde, 0x1f, 0x00, 0xaa, 0xdf, 0x1f, 0x28, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f,
+	0x6d, 0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2d,
+	0x73, 0x64, 0x6b, 0x2f, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x43, 0x6f, 0x69, 0x6e, 0x73, 0x9a,
+	0xe7, 0xb0, 0x2a, 0x0c, 0x6c, 0x65, 0x67, 0x61, 0x63, 0x79, 0x5f, 0x63, 0x6f, 0x69, 0x6e, 0x73,
+	0xa8, 0xe7, 0xb0, 0x2a, 0x01, 0x52, 0x05, 0x63, 0x6f, 0x69, 0x6e, 0x73, 0x3a, 0x14, 0x88, 0xa0,
+	0x1f, 0x00, 0xe8, 0xa0, 0x1f, 0x00, 0x82, 0xe7, 0xb0, 0x2a, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65,
+	0x73, 0x73, 0x22, 0xbf, 0x01, 0x0a, 0x06, 0x4f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x12, 0x32, 0x0a,
+	0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x18,
+	0xd2, 0xb4, 0x2d, 0x14, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x41, 0x64, 0x64, 0x72, 0x65,
+	0x73, 0x73, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x52, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73,
+	0x73, 0x12, 0x77, 0x0a, 0x05, 0x63, 0x6f, 0x69, 0x6e, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x19, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x76,
+	0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x43, 0x6f, 0x69, 0x6e, 0x42, 0x46, 0xc8, 0xde, 0x1f,
+	0x00, 0xaa, 0xdf, 0x1f, 0x28, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f,
+	0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2d, 0x73, 0x64,
+	0x6b, 0x2f, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x43, 0x6f, 0x69, 0x6e, 0x73, 0x9a, 0xe7, 0xb0,
+	0x2a, 0x0c, 0x6c, 0x65, 0x67, 0x61, 0x63, 0x79, 0x5f, 0x63, 0x6f, 0x69, 0x6e, 0x73, 0xa8, 0xe7,
+	0xb0, 0x2a, 0x01, 0x52, 0x05, 0x63, 0x6f, 0x69, 0x6e, 0x73, 0x3a, 0x08, 0x88, 0xa0, 0x1f, 0x00,
+	0xe8, 0xa0, 0x1f, 0x00, 0x22, 0xd8, 0x01, 0x0a, 0x0b, 0x42, 0x61, 0x74, 0x63, 0x68, 0x4f, 0x75,
 	0x74, 0x70, 0x75, 0x74, 0x12, 0x32, 0x0a, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18,
 	0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x18, 0xd2, 0xb4, 0x2d, 0x14, 0x63, 0x6f, 0x73, 0x6d, 0x6f,
 	0x73, 0x2e, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x52,
@@ -4645,53 +14998,220 @@ var file_cosmos_bank_v1beta1_bank_proto_rawDesc = []byte{
 	0x73, 0x6d, 0x6f, 0x73, 0x2d, 0x73, 0x64, 0x6b, 0x2f, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x43,
 	0x6f, 0x69, 0x6e, 0x73, 0x9a, 0xe7, 0xb0, 0x2a, 0x0c, 0x6c, 0x65, 0x67, 0x61, 0x63, 0x79, 0x5f,
 	0x63, 0x6f, 0x69, 0x6e, 0x73, 0xa8, 0xe7, 0xb0, 0x2a, 0x01, 0x52, 0x05, 0x63, 0x6f, 0x69, 0x6e,
-	0x73, 0x3a, 0x08, 0x88, 0xa0, 0x1f, 0x00, 0xe8, 0xa0, 0x1f, 0x00, 0x22, 0xac, 0x01, 0x0a, 0x06,
-	0x53, 0x75, 0x70, 0x70, 0x6c, 0x79, 0x12, 0x77, 0x0a, 0x05, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x18,
-	0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x62,
+	0x73, 0x12, 0x12, 0x0a, 0x04, 0x6d, 0x65, 0x6d, 0x6f, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x04, 0x6d, 0x65, 0x6d, 0x6f, 0x3a, 0x08, 0x88, 0xa0, 0x1f, 0x00, 0xe8, 0xa0, 0x1f, 0x00, 0x22,
+	0xac, 0x01, 0x0a, 0x06, 0x53, 0x75, 0x70, 0x70, 0x6c, 0x79, 0x12, 0x77, 0x0a, 0x05, 0x74, 0x6f,
+	0x74, 0x61, 0x6c, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x63, 0x6f, 0x73, 0x6d,
+	0x6f, 0x73, 0x2e, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e,
+	0x43, 0x6f, 0x69, 0x6e, 0x42, 0x46, 0xc8, 0xde, 0x1f, 0x00, 0xaa, 0xdf, 0x1f, 0x28, 0x67, 0x69,
+	0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2f,
+	0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2d, 0x73, 0x64, 0x6b, 0x2f, 0x74, 0x79, 0x70, 0x65, 0x73,
+	0x2e, 0x43, 0x6f, 0x69, 0x6e, 0x73, 0x9a, 0xe7, 0xb0, 0x2a, 0x0c, 0x6c, 0x65, 0x67, 0x61, 0x63,
+	0x79, 0x5f, 0x63, 0x6f, 0x69, 0x6e, 0x73, 0xa8, 0xe7, 0xb0, 0x2a, 0x01, 0x52, 0x05, 0x74, 0x6f,
+	0x74, 0x61, 0x6c, 0x3a, 0x29, 0x18, 0x01, 0x88, 0xa0, 0x1f, 0x00, 0xe8, 0xa0, 0x1f, 0x01, 0xca,
+	0xb4, 0x2d, 0x1b, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x62, 0x61, 0x6e, 0x6b, 0x2e, 0x76,
+	0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x53, 0x75, 0x70, 0x70, 0x6c, 0x79, 0x49, 0x22, 0x57,
+	0x0a, 0x09, 0x44, 0x65, 0x6e, 0x6f, 0x6d, 0x55, 0x6e, 0x69, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x64,
+	0x65, 0x6e, 0x6f, 0x6d, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x64, 0x65, 0x6e, 0x6f,
+	0x6d, 0x12, 0x1a, 0x0a, 0x08, 0x65, 0x78, 0x70, 0x6f, 0x6e, 0x65, 0x6e, 0x74, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x0d, 0x52, 0x08, 0x65, 0x78, 0x70, 0x6f, 0x6e, 0x65, 0x6e, 0x74, 0x12, 0x18, 0x0a,
+	0x07, 0x61, 0x6c, 0x69, 0x61, 0x73, 0x65, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x09, 0x52, 0x07,
+	0x61, 0x6c, 0x69, 0x61, 0x73, 0x65, 0x73, 0x22, 0x8a, 0x02, 0x0a, 0x08, 0x4d, 0x65, 0x74, 0x61,
+	0x64, 0x61, 0x74, 0x61, 0x12, 0x20, 0x0a, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74,
+	0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72,
+	0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x3f, 0x0a, 0x0b, 0x64, 0x65, 0x6e, 0x6f, 0x6d, 0x5f,
+	0x75, 0x6e, 0x69, 0x74, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x63, 0x6f,
+	0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x62, 0x61, 0x6e, 0x6b, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61,
+	0x31, 0x2e, 0x44, 0x65, 0x6e, 0x6f, 0x6d, 0x55, 0x6e, 0x69, 0x74, 0x52, 0x0a, 0x64, 0x65, 0x6e,
+	0x6f, 0x6d, 0x55, 0x6e, 0x69, 0x74, 0x73, 0x12, 0x12, 0x0a, 0x04, 0x62, 0x61, 0x73, 0x65, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x62, 0x61, 0x73, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x64,
+	0x69, 0x73, 0x70, 0x6c, 0x61, 0x79, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x64, 0x69,
+	0x73, 0x70, 0x6c, 0x61, 0x79, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x05, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x79, 0x6d,
+	0x62, 0x6f, 0x6c, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x79, 0x6d, 0x62, 0x6f,
+	0x6c, 0x12, 0x19, 0x0a, 0x03, 0x75, 0x72, 0x69, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x42, 0x07,
+	0xe2, 0xde, 0x1f, 0x03, 0x55, 0x52, 0x49, 0x52, 0x03, 0x75, 0x72, 0x69, 0x12, 0x26, 0x0a, 0x08,
+	0x75, 0x72, 0x69, 0x5f, 0x68, 0x61, 0x73, 0x68, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x42, 0x0b,
+	0xe2, 0xde, 0x1f, 0x07, 0x55, 0x52, 0x49, 0x48, 0x61, 0x73, 0x68, 0x52, 0x07, 0x75, 0x72, 0x69,
+	0x48, 0x61, 0x73, 0x68, 0x22, 0x8f, 0x02, 0x0a, 0x04, 0x48, 0x6f, 0x6c, 0x64, 0x12, 0x32, 0x0a,
+	0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x18,
+	0xd2, 0xb4, 0x2d, 0x14, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x41, 0x64, 0x64, 0x72, 0x65,
+	0x73, 0x73, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x52, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73,
+	0x73, 0x12, 0x16, 0x0a, 0x06, 0x68, 0x6f, 0x6c, 0x64, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x06, 0x68, 0x6f, 0x6c, 0x64, 0x65, 0x72, 0x12, 0x79, 0x0a, 0x06, 0x61, 0x6d, 0x6f,
+	0x75, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x63, 0x6f, 0x73, 0x6d,
+	0x6f, 0x73, 0x2e, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e,
+	0x43, 0x6f, 0x69, 0x6e, 0x42, 0x46, 0xc8, 0xde, 0x1f, 0x00, 0xaa, 0xdf, 0x1f, 0x28, 0x67, 0x69,
+	0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2f,
+	0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2d, 0x73, 0x64, 0x6b, 0x2f, 0x74, 0x79, 0x70, 0x65, 0x73,
+	0x2e, 0x43, 0x6f, 0x69, 0x6e, 0x73, 0x9a, 0xe7, 0xb0, 0x2a, 0x0c, 0x6c, 0x65, 0x67, 0x61, 0x63,
+	0x79, 0x5f, 0x63, 0x6f, 0x69, 0x6e, 0x73, 0xa8, 0xe7, 0xb0, 0x2a, 0x01, 0x52, 0x06, 0x61, 0x6d,
+	0x6f, 0x75, 0x6e, 0x74, 0x12, 0x40, 0x0a, 0x0a, 0x65, 0x78, 0x70, 0x69, 0x72, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c,
+	0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73,
+	0x74, 0x61, 0x6d, 0x70, 0x42, 0x04, 0x90, 0xdf, 0x1f, 0x01, 0x52, 0x0a, 0x65, 0x78, 0x70, 0x69,
+	0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0xd7, 0x01, 0x0a, 0x0f, 0x42, 0x61, 0x6c, 0x61, 0x6e,
+	0x63, 0x65, 0x53, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x02, 0x69, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x64, 0x65,
+	0x6e, 0x6f, 0x6d, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x64, 0x65, 0x6e, 0x6f, 0x6d,
+	0x12, 0x16, 0x0a, 0x06, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03,
+	0x52, 0x06, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x61, 0x6b, 0x65,
+	0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x05, 0x74, 0x61, 0x6b, 0x65, 0x6e, 0x12, 0x12,
+	0x0a, 0x04, 0x72, 0x6f, 0x6f, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x04, 0x72, 0x6f,
+	0x6f, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28,
+	0x04, 0x52, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x46, 0x0a, 0x05, 0x74, 0x6f, 0x74, 0x61,
+	0x6c, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x42, 0x30, 0xc8, 0xde, 0x1f, 0x00, 0xda, 0xde, 0x1f,
+	0x15, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f, 0x2f, 0x6d, 0x61,
+	0x74, 0x68, 0x2e, 0x49, 0x6e, 0x74, 0xd2, 0xb4, 0x2d, 0x0a, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73,
+	0x2e, 0x49, 0x6e, 0x74, 0xa8, 0xe7, 0xb0, 0x2a, 0x01, 0x52, 0x05, 0x74, 0x6f, 0x74, 0x61, 0x6c,
+	0x22, 0x8f, 0x01, 0x0a, 0x0f, 0x53, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x42, 0x61, 0x6c,
+	0x61, 0x6e, 0x63, 0x65, 0x12, 0x32, 0x0a, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x18, 0xd2, 0xb4, 0x2d, 0x14, 0x63, 0x6f, 0x73, 0x6d, 0x6f,
+	0x73, 0x2e, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x52,
+	0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x48, 0x0a, 0x06, 0x61, 0x6d, 0x6f, 0x75,
+	0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x30, 0xc8, 0xde, 0x1f, 0x00, 0xda, 0xde,
+	0x1f, 0x15, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f, 0x2f, 0x6d,
+	0x61, 0x74, 0x68, 0x2e, 0x49, 0x6e, 0x74, 0xd2, 0xb4, 0x2d, 0x0a, 0x63, 0x6f, 0x73, 0x6d, 0x6f,
+	0x73, 0x2e, 0x49, 0x6e, 0x74, 0xa8, 0xe7, 0xb0, 0x2a, 0x01, 0x52, 0x06, 0x61, 0x6d, 0x6f, 0x75,
+	0x6e, 0x74, 0x22, 0x75, 0x0a, 0x14, 0x42, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x53, 0x6e, 0x61,
+	0x70, 0x73, 0x68, 0x6f, 0x74, 0x50, 0x72, 0x6f, 0x6f, 0x66, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x6f,
+	0x74, 0x61, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x74, 0x6f, 0x74, 0x61, 0x6c,
+	0x12, 0x14, 0x0a, 0x05, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x05, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x12, 0x1b, 0x0a, 0x09, 0x6c, 0x65, 0x61, 0x66, 0x5f, 0x68,
+	0x61, 0x73, 0x68, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x08, 0x6c, 0x65, 0x61, 0x66, 0x48,
+	0x61, 0x73, 0x68, 0x12, 0x14, 0x0a, 0x05, 0x61, 0x75, 0x6e, 0x74, 0x73, 0x18, 0x04, 0x20, 0x03,
+	0x28, 0x0c, 0x52, 0x05, 0x61, 0x75, 0x6e, 0x74, 0x73, 0x22, 0x5f, 0x0a, 0x13, 0x4d, 0x69, 0x6e,
+	0x74, 0x42, 0x75, 0x72, 0x6e, 0x50, 0x65, 0x72, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73,
+	0x12, 0x14, 0x0a, 0x05, 0x64, 0x65, 0x6e, 0x6f, 0x6d, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x05, 0x64, 0x65, 0x6e, 0x6f, 0x6d, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x69, 0x6e, 0x74, 0x65, 0x72,
+	0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52, 0x07, 0x6d, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x73,
+	0x12, 0x18, 0x0a, 0x07, 0x62, 0x75, 0x72, 0x6e, 0x65, 0x72, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28,
+	0x09, 0x52, 0x07, 0x62, 0x75, 0x72, 0x6e, 0x65, 0x72, 0x73, 0x22, 0xa3, 0x01, 0x0a, 0x0c, 0x4d,
+	0x6f, 0x64, 0x75, 0x6c, 0x65, 0x45, 0x73, 0x63, 0x72, 0x6f, 0x77, 0x12, 0x18, 0x0a, 0x07, 0x70,
+	0x75, 0x72, 0x70, 0x6f, 0x73, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x70, 0x75,
+	0x72, 0x70, 0x6f, 0x73, 0x65, 0x12, 0x79, 0x0a, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18,
+	0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x62,
 	0x61, 0x73, 0x65, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x43, 0x6f, 0x69, 0x6e,
 	0x42, 0x46, 0xc8, 0xde, 0x1f, 0x00, 0xaa, 0xdf, 0x1f, 0x28, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62,
 	0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2f, 0x63, 0x6f, 0x73, 0x6d,
 	0x6f, 0x73, 0x2d, 0x73, 0x64, 0x6b, 0x2f, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x43, 0x6f, 0x69,
 	0x6e, 0x73, 0x9a, 0xe7, 0xb0, 0x2a, 0x0c, 0x6c, 0x65, 0x67, 0x61, 0x63, 0x79, 0x5f, 0x63, 0x6f,
-	0x69, 0x6e, 0x73, 0xa8, 0xe7, 0xb0, 0x2a, 0x01, 0x52, 0x05, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x3a,
-	0x29, 0x18, 0x01, 0x88, 0xa0, 0x1f, 0x00, 0xe8, 0xa0, 0x1f, 0x01, 0xca, 0xb4, 0x2d, 0x1b, 0x63,
-	0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x62, 0x61, 0x6e, 0x6b, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74,
-	0x61, 0x31, 0x2e, 0x53, 0x75, 0x70, 0x70, 0x6c, 0x79, 0x49, 0x22, 0x57, 0x0a, 0x09, 0x44, 0x65,
-	0x6e, 0x6f, 0x6d, 0x55, 0x6e, 0x69, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x64, 0x65, 0x6e, 0x6f, 0x6d,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x64, 0x65, 0x6e, 0x6f, 0x6d, 0x12, 0x1a, 0x0a,
-	0x08, 0x65, 0x78, 0x70, 0x6f, 0x6e, 0x65, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52,
-	0x08, 0x65, 0x78, 0x70, 0x6f, 0x6e, 0x65, 0x6e, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x6c, 0x69,
-	0x61, 0x73, 0x65, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x09, 0x52, 0x07, 0x61, 0x6c, 0x69, 0x61,
-	0x73, 0x65, 0x73, 0x22, 0x8a, 0x02, 0x0a, 0x08, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61,
-	0x12, 0x20, 0x0a, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69,
-	0x6f, 0x6e, 0x12, 0x3f, 0x0a, 0x0b, 0x64, 0x65, 0x6e, 0x6f, 0x6d, 0x5f, 0x75, 0x6e, 0x69, 0x74,
-	0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73,
-	0x2e, 0x62, 0x61, 0x6e, 0x6b, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x44, 0x65,
-	0x6e, 0x6f, 0x6d, 0x55, 0x6e, 0x69, 0x74, 0x52, 0x0a, 0x64, 0x65, 0x6e, 0x6f, 0x6d, 0x55, 0x6e,
-	0x69, 0x74, 0x73, 0x12, 0x12, 0x0a, 0x04, 0x62, 0x61, 0x73, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x04, 0x62, 0x61, 0x73, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x64, 0x69, 0x73, 0x70, 0x6c,
-	0x61, 0x79, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x64, 0x69, 0x73, 0x70, 0x6c, 0x61,
-	0x79, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x79, 0x6d, 0x62, 0x6f, 0x6c, 0x18,
-	0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x79, 0x6d, 0x62, 0x6f, 0x6c, 0x12, 0x19, 0x0a,
-	0x03, 0x75, 0x72, 0x69, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x42, 0x07, 0xe2, 0xde, 0x1f, 0x03,
-	0x55, 0x52, 0x49, 0x52, 0x03, 0x75, 0x72, 0x69, 0x12, 0x26, 0x0a, 0x08, 0x75, 0x72, 0x69, 0x5f,
-	0x68, 0x61, 0x73, 0x68, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x42, 0x0b, 0xe2, 0xde, 0x1f, 0x07,
-	0x55, 0x52, 0x49, 0x48, 0x61, 0x73, 0x68, 0x52, 0x07, 0x75, 0x72, 0x69, 0x48, 0x61, 0x73, 0x68,
-	0x42, 0xc4, 0x01, 0x0a, 0x17, 0x63, 0x6f, 0x6d, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e,
-	0x62, 0x61, 0x6e, 0x6b, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x42, 0x09, 0x42, 0x61,
-	0x6e, 0x6b, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50, 0x01, 0x5a, 0x30, 0x63, 0x6f, 0x73, 0x6d, 0x6f,
-	0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x63, 0x6f, 0x73, 0x6d,
-	0x6f, 0x73, 0x2f, 0x62, 0x61, 0x6e, 0x6b, 0x2f, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x3b,
-	0x62, 0x61, 0x6e, 0x6b, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0xa2, 0x02, 0x03, 0x43, 0x42,
-	0x58, 0xaa, 0x02, 0x13, 0x43, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x42, 0x61, 0x6e, 0x6b, 0x2e,
-	0x56, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0xca, 0x02, 0x13, 0x43, 0x6f, 0x73, 0x6d, 0x6f, 0x73,
-	0x5c, 0x42, 0x61, 0x6e, 0x6b, 0x5c, 0x56, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0xe2, 0x02, 0x1f,
-	0x43, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x5c, 0x42, 0x61, 0x6e, 0x6b, 0x5c, 0x56, 0x31, 0x62, 0x65,
-	0x74, 0x61, 0x31, 0x5c, 0x47, 0x50, 0x42, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0xea,
-	0x02, 0x15, 0x43, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x3a, 0x3a, 0x42, 0x61, 0x6e, 0x6b, 0x3a, 0x3a,
-	0x56, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x69, 0x6e, 0x73, 0xa8, 0xe7, 0xb0, 0x2a, 0x01, 0x52, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74,
+	0x22, 0xa1, 0x03, 0x0a, 0x12, 0x54, 0x69, 0x6d, 0x65, 0x6c, 0x6f, 0x63, 0x6b, 0x65, 0x64, 0x54,
+	0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x04, 0x52, 0x02, 0x69, 0x64, 0x12, 0x30, 0x0a, 0x06, 0x73, 0x65, 0x6e, 0x64, 0x65,
+	0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x18, 0xd2, 0xb4, 0x2d, 0x14, 0x63, 0x6f, 0x73,
+	0x6d, 0x6f, 0x73, 0x2e, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x53, 0x74, 0x72, 0x69, 0x6e,
+	0x67, 0x52, 0x06, 0x73, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x12, 0x36, 0x0a, 0x09, 0x72, 0x65, 0x63,
+	0x69, 0x70, 0x69, 0x65, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x42, 0x18, 0xd2, 0xb4,
+	0x2d, 0x14, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73,
+	0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x52, 0x09, 0x72, 0x65, 0x63, 0x69, 0x70, 0x69, 0x65, 0x6e,
+	0x74, 0x12, 0x79, 0x0a, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x04, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x19, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x62, 0x61, 0x73, 0x65, 0x2e,
+	0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x43, 0x6f, 0x69, 0x6e, 0x42, 0x46, 0xc8, 0xde,
+	0x1f, 0x00, 0xaa, 0xdf, 0x1f, 0x28, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d,
+	0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2d, 0x73,
+	0x64, 0x6b, 0x2f, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x43, 0x6f, 0x69, 0x6e, 0x73, 0x9a, 0xe7,
+	0xb0, 0x2a, 0x0c, 0x6c, 0x65, 0x67, 0x61, 0x63, 0x79, 0x5f, 0x63, 0x6f, 0x69, 0x6e, 0x73, 0xa8,
+	0xe7, 0xb0, 0x2a, 0x01, 0x52, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x4a, 0x0a, 0x0b,
+	0x75, 0x6e, 0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x42, 0x0d, 0xc8,
+	0xde, 0x1f, 0x00, 0x90, 0xdf, 0x1f, 0x01, 0xa8, 0xe7, 0xb0, 0x2a, 0x01, 0x52, 0x0a, 0x75, 0x6e,
+	0x6c, 0x6f, 0x63, 0x6b, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x4a, 0x0a, 0x0b, 0x65, 0x78, 0x70, 0x69,
+	0x72, 0x79, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e,
+	0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e,
+	0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x42, 0x0d, 0xc8, 0xde, 0x1f, 0x00, 0x90,
+	0xdf, 0x1f, 0x01, 0xa8, 0xe7, 0xb0, 0x2a, 0x01, 0x52, 0x0a, 0x65, 0x78, 0x70, 0x69, 0x72, 0x79,
+	0x54, 0x69, 0x6d, 0x65, 0x22, 0x8b, 0x03, 0x0a, 0x0d, 0x53, 0x70, 0x65, 0x6e, 0x64, 0x69, 0x6e,
+	0x67, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x12, 0x32, 0x0a, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73,
+	0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x18, 0xd2, 0xb4, 0x2d, 0x14, 0x63, 0x6f, 0x73,
+	0x6d, 0x6f, 0x73, 0x2e, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x53, 0x74, 0x72, 0x69, 0x6e,
+	0x67, 0x52, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x79, 0x0a, 0x06, 0x6c, 0x69,
+	0x6d, 0x69, 0x74, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x63, 0x6f, 0x73,
+	0x6d, 0x6f, 0x73, 0x2e, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31,
+	0x2e, 0x43, 0x6f, 0x69, 0x6e, 0x42, 0x46, 0xc8, 0xde, 0x1f, 0x00, 0xaa, 0xdf, 0x1f, 0x28, 0x67,
+	0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73,
+	0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2d, 0x73, 0x64, 0x6b, 0x2f, 0x74, 0x79, 0x70, 0x65,
+	0x73, 0x2e, 0x43, 0x6f, 0x69, 0x6e, 0x73, 0x9a, 0xe7, 0xb0, 0x2a, 0x0c, 0x6c, 0x65, 0x67, 0x61,
+	0x63, 0x79, 0x5f, 0x63, 0x6f, 0x69, 0x6e, 0x73, 0xa8, 0xe7, 0xb0, 0x2a, 0x01, 0x52, 0x06, 0x6c,
+	0x69, 0x6d, 0x69, 0x74, 0x73, 0x12, 0x40, 0x0a, 0x06, 0x77, 0x69, 0x6e, 0x64, 0x6f, 0x77, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x42, 0x0d, 0xc8, 0xde, 0x1f, 0x00, 0x98, 0xdf, 0x1f, 0x01, 0xa8, 0xe7, 0xb0, 0x2a, 0x01, 0x52,
+	0x06, 0x77, 0x69, 0x6e, 0x64, 0x6f, 0x77, 0x12, 0x44, 0x0a, 0x05, 0x73, 0x70, 0x65, 0x6e, 0x74,
+	0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x23, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e,
+	0x62, 0x61, 0x6e, 0x6b, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x53, 0x70, 0x65,
+	0x6e, 0x64, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x42, 0x09, 0xc8, 0xde, 0x1f,
+	0x00, 0xa8, 0xe7, 0xb0, 0x2a, 0x01, 0x52, 0x05, 0x73, 0x70, 0x65, 0x6e, 0x74, 0x12, 0x43, 0x0a,
+	0x07, 0x70, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x29,
+	0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x62, 0x61, 0x6e, 0x6b, 0x2e, 0x76, 0x31, 0x62,
+	0x65, 0x74, 0x61, 0x31, 0x2e, 0x50, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x53, 0x70, 0x65, 0x6e,
+	0x64, 0x69, 0x6e, 0x67, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x52, 0x07, 0x70, 0x65, 0x6e, 0x64, 0x69,
+	0x6e, 0x67, 0x22, 0xca, 0x01, 0x0a, 0x0e, 0x53, 0x70, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x52,
+	0x65, 0x63, 0x6f, 0x72, 0x64, 0x12, 0x3d, 0x0a, 0x04, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x42,
+	0x0d, 0xc8, 0xde, 0x1f, 0x00, 0x90, 0xdf, 0x1f, 0x01, 0xa8, 0xe7, 0xb0, 0x2a, 0x01, 0x52, 0x04,
+	0x74, 0x69, 0x6d, 0x65, 0x12, 0x79, 0x0a, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x02,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x62, 0x61,
+	0x73, 0x65, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x43, 0x6f, 0x69, 0x6e, 0x42,
+	0x46, 0xc8, 0xde, 0x1f, 0x00, 0xaa, 0xdf, 0x1f, 0x28, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e,
+	0x63, 0x6f, 0x6d, 0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f,
+	0x73, 0x2d, 0x73, 0x64, 0x6b, 0x2f, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x43, 0x6f, 0x69, 0x6e,
+	0x73, 0x9a, 0xe7, 0xb0, 0x2a, 0x0c, 0x6c, 0x65, 0x67, 0x61, 0x63, 0x79, 0x5f, 0x63, 0x6f, 0x69,
+	0x6e, 0x73, 0xa8, 0xe7, 0xb0, 0x2a, 0x01, 0x52, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x22,
+	0xa5, 0x02, 0x0a, 0x14, 0x50, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x53, 0x70, 0x65, 0x6e, 0x64,
+	0x69, 0x6e, 0x67, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x12, 0x79, 0x0a, 0x06, 0x6c, 0x69, 0x6d, 0x69,
+	0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f,
+	0x73, 0x2e, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x43,
+	0x6f, 0x69, 0x6e, 0x42, 0x46, 0xc8, 0xde, 0x1f, 0x00, 0xaa, 0xdf, 0x1f, 0x28, 0x67, 0x69, 0x74,
+	0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2f, 0x63,
+	0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2d, 0x73, 0x64, 0x6b, 0x2f, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e,
+	0x43, 0x6f, 0x69, 0x6e, 0x73, 0x9a, 0xe7, 0xb0, 0x2a, 0x0c, 0x6c, 0x65, 0x67, 0x61, 0x63, 0x79,
+	0x5f, 0x63, 0x6f, 0x69, 0x6e, 0x73, 0xa8, 0xe7, 0xb0, 0x2a, 0x01, 0x52, 0x06, 0x6c, 0x69, 0x6d,
+	0x69, 0x74, 0x73, 0x12, 0x40, 0x0a, 0x06, 0x77, 0x69, 0x6e, 0x64, 0x6f, 0x77, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x42, 0x0d,
+	0xc8, 0xde, 0x1f, 0x00, 0x98, 0xdf, 0x1f, 0x01, 0xa8, 0xe7, 0xb0, 0x2a, 0x01, 0x52, 0x06, 0x77,
+	0x69, 0x6e, 0x64, 0x6f, 0x77, 0x12, 0x50, 0x0a, 0x0e, 0x65, 0x66, 0x66, 0x65, 0x63, 0x74, 0x69,
+	0x76, 0x65, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e,
+	0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e,
+	0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x42, 0x0d, 0xc8, 0xde, 0x1f, 0x00, 0x90,
+	0xdf, 0x1f, 0x01, 0xa8, 0xe7, 0xb0, 0x2a, 0x01, 0x52, 0x0d, 0x65, 0x66, 0x66, 0x65, 0x63, 0x74,
+	0x69, 0x76, 0x65, 0x54, 0x69, 0x6d, 0x65, 0x22, 0xb0, 0x01, 0x0a, 0x11, 0x44, 0x65, 0x6d, 0x75,
+	0x72, 0x72, 0x61, 0x67, 0x65, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x12, 0x14, 0x0a,
+	0x05, 0x64, 0x65, 0x6e, 0x6f, 0x6d, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x64, 0x65,
+	0x6e, 0x6f, 0x6d, 0x12, 0x21, 0x0a, 0x0c, 0x65, 0x70, 0x6f, 0x63, 0x68, 0x5f, 0x6c, 0x65, 0x6e,
+	0x67, 0x74, 0x68, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0b, 0x65, 0x70, 0x6f, 0x63, 0x68,
+	0x4c, 0x65, 0x6e, 0x67, 0x74, 0x68, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x70, 0x6f, 0x63, 0x68, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x05, 0x65, 0x70, 0x6f, 0x63, 0x68, 0x12, 0x4c, 0x0a, 0x08,
+	0x73, 0x65, 0x67, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x25,
+	0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x62, 0x61, 0x6e, 0x6b, 0x2e, 0x76, 0x31, 0x62,
+	0x65, 0x74, 0x61, 0x31, 0x2e, 0x44, 0x65, 0x6d, 0x75, 0x72, 0x72, 0x61, 0x67, 0x65, 0x53, 0x65,
+	0x67, 0x6d, 0x65, 0x6e, 0x74, 0x42, 0x09, 0xc8, 0xde, 0x1f, 0x00, 0xa8, 0xe7, 0xb0, 0x2a, 0x01,
+	0x52, 0x08, 0x73, 0x65, 0x67, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x22, 0x7f, 0x0a, 0x10, 0x44, 0x65,
+	0x6d, 0x75, 0x72, 0x72, 0x61, 0x67, 0x65, 0x53, 0x65, 0x67, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x1f,
+	0x0a, 0x0b, 0x73, 0x74, 0x61, 0x72, 0x74, 0x5f, 0x65, 0x70, 0x6f, 0x63, 0x68, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x04, 0x52, 0x0a, 0x73, 0x74, 0x61, 0x72, 0x74, 0x45, 0x70, 0x6f, 0x63, 0x68, 0x12,
+	0x4a, 0x0a, 0x04, 0x72, 0x61, 0x74, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x36, 0xc8,
+	0xde, 0x1f, 0x00, 0xda, 0xde, 0x1f, 0x1b, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b,
+	0x2e, 0x69, 0x6f, 0x2f, 0x6d, 0x61, 0x74, 0x68, 0x2e, 0x4c, 0x65, 0x67, 0x61, 0x63, 0x79, 0x44,
+	0x65, 0x63, 0xd2, 0xb4, 0x2d, 0x0a, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x44, 0x65, 0x63,
+	0xa8, 0xe7, 0xb0, 0x2a, 0x01, 0x52, 0x04, 0x72, 0x61, 0x74, 0x65, 0x42, 0xc4, 0x01, 0x0a, 0x17,
+	0x63, 0x6f, 0x6d, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x62, 0x61, 0x6e, 0x6b, 0x2e,
+	0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x42, 0x09, 0x42, 0x61, 0x6e, 0x6b, 0x50, 0x72, 0x6f,
+	0x74, 0x6f, 0x50, 0x01, 0x5a, 0x30, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e,
+	0x69, 0x6f, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2f, 0x62, 0x61,
+	0x6e, 0x6b, 0x2f, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x3b, 0x62, 0x61, 0x6e, 0x6b, 0x76,
+	0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0xa2, 0x02, 0x03, 0x43, 0x42, 0x58, 0xaa, 0x02, 0x13, 0x43,
+	0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x42, 0x61, 0x6e, 0x6b, 0x2e, 0x56, 0x31, 0x62, 0x65, 0x74,
+	0x61, 0x31, 0xca, 0x02, 0x13, 0x43, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x5c, 0x42, 0x61, 0x6e, 0x6b,
+	0x5c, 0x56, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0xe2, 0x02, 0x1f, 0x43, 0x6f, 0x73, 0x6d, 0x6f,
+	0x73, 0x5c, 0x42, 0x61, 0x6e, 0x6b, 0x5c, 0x56, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x5c, 0x47,
+	0x50, 0x42, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0xea, 0x02, 0x15, 0x43, 0x6f, 0x73,
+	0x6d, 0x6f, 0x73, 0x3a, 0x3a, 0x42, 0x61, 0x6e, 0x6b, 0x3a, 0x3a, 0x56, 0x31, 0x62, 0x65, 0x74,
+	0x61, 0x31, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -4706,28 +15226,63 @@ func file_cosmos_bank_v1beta1_bank_proto_rawDescGZIP() []byte {
 	return file_cosmos_bank_v1beta1_bank_proto_rawDescData
 }
 
-var file_cosmos_bank_v1beta1_bank_proto_msgTypes = make([]protoimpl.MessageInfo, 7)
+var file_cosmos_bank_v1beta1_bank_proto_msgTypes = make([]protoimpl.MessageInfo, 21)
 var file_cosmos_bank_v1beta1_bank_proto_goTypes = []interface{}{
-	(*Params)(nil),       // 0: cosmos.bank.v1beta1.Params
-	(*SendEnabled)(nil),  // 1: cosmos.bank.v1beta1.SendEnabled
-	(*Input)(nil),        // 2: cosmos.bank.v1beta1.Input
-	(*Output)(nil),       // 3: cosmos.bank.v1beta1.Output
-	(*Supply)(nil),       // 4: cosmos.bank.v1beta1.Supply
-	(*DenomUnit)(nil),    // 5: cosmos.bank.v1beta1.DenomUnit
-	(*Metadata)(nil),     // 6: cosmos.bank.v1beta1.Metadata
-	(*v1beta1.Coin)(nil), // 7: cosmos.base.v1beta1.Coin
+	(*Params)(nil),                // 0: cosmos.bank.v1beta1.Params
+	(*DustSweepParams)(nil),       // 1: cosmos.bank.v1beta1.DustSweepParams
+	(*SendEnabled)(nil),           // 2: cosmos.bank.v1beta1.SendEnabled
+	(*Input)(nil),                 // 3: cosmos.bank.v1beta1.Input
+	(*Output)(nil),                // 4: cosmos.bank.v1beta1.Output
+	(*BatchOutput)(nil),           // 5: cosmos.bank.v1beta1.BatchOutput
+	(*Supply)(nil),                // 6: cosmos.bank.v1beta1.Supply
+	(*DenomUnit)(nil),             // 7: cosmos.bank.v1beta1.DenomUnit
+	(*Metadata)(nil),              // 8: cosmos.bank.v1beta1.Metadata
+	(*Hold)(nil),                  // 9: cosmos.bank.v1beta1.Hold
+	(*BalanceSnapshot)(nil),       // 10: cosmos.bank.v1beta1.BalanceSnapshot
+	(*SnapshotBalance)(nil),       // 11: cosmos.bank.v1beta1.SnapshotBalance
+	(*BalanceSnapshotProof)(nil),  // 12: cosmos.bank.v1beta1.BalanceSnapshotProof
+	(*MintBurnPermissions)(nil),   // 13: cosmos.bank.v1beta1.MintBurnPermissions
+	(*ModuleEscrow)(nil),          // 14: cosmos.bank.v1beta1.ModuleEscrow
+	(*TimelockedTransfer)(nil),    // 15: cosmos.bank.v1beta1.TimelockedTransfer
+	(*SpendingLimit)(nil),         // 16: cosmos.bank.v1beta1.SpendingLimit
+	(*SpendingRecord)(nil),        // 17: cosmos.bank.v1beta1.SpendingRecord
+	(*PendingSpendingLimit)(nil),  // 18: cosmos.bank.v1beta1.PendingSpendingLimit
+	(*DemurrageSchedule)(nil),     // 19: cosmos.bank.v1beta1.DemurrageSchedule
+	(*DemurrageSegment)(nil),      // 20: cosmos.bank.v1beta1.DemurrageSegment
+	(*v1beta1.Coin)(nil),          // 21: cosmos.base.v1beta1.Coin
+	(*timestamppb.Timestamp)(nil), // 22: google.protobuf.Timestamp
+	(*durationpb.Duration)(nil),   // 23: google.protobuf.Duration
 }
 var file_cosmos_bank_v1beta1_bank_proto_depIdxs = []int32{
-	1, // 0: cosmos.bank.v1beta1.Params.send_enabled:type_name -> cosmos.bank.v1beta1.SendEnabled
-	7, // 1: cosmos.bank.v1beta1.Input.coins:type_name -> cosmos.base.v1beta1.Coin
-	7, // 2: cosmos.bank.v1beta1.Output.coins:type_name -> cosmos.base.v1beta1.Coin
-	7, // 3: cosmos.bank.v1beta1.Supply.total:type_name -> cosmos.base.v1beta1.Coin
-	5, // 4: cosmos.bank.v1beta1.Metadata.denom_units:type_name -> cosmos.bank.v1beta1.DenomUnit
-	5, // [5:5] is the sub-list for method output_type
-	5, // [5:5] is the sub-list for method input_type
-	5, // [5:5] is the sub-list for extension type_name
-	5, // [5:5] is the sub-list for extension extendee
-	0, // [0:5] is the sub-list for field type_name
+	2,  // 0: cosmos.bank.v1beta1.Params.send_enabled:type_name -> cosmos.bank.v1beta1.SendEnabled
+	1,  // 1: cosmos.bank.v1beta1.Params.dust_sweep:type_name -> cosmos.bank.v1beta1.DustSweepParams
+	21, // 2: cosmos.bank.v1beta1.DustSweepParams.thresholds:type_name -> cosmos.base.v1beta1.Coin
+	21, // 3: cosmos.bank.v1beta1.Input.coins:type_name -> cosmos.base.v1beta1.Coin
+	21, // 4: cosmos.bank.v1beta1.Output.coins:type_name -> cosmos.base.v1beta1.Coin
+	21, // 5: cosmos.bank.v1beta1.BatchOutput.coins:type_name -> cosmos.base.v1beta1.Coin
+	21, // 6: cosmos.bank.v1beta1.Supply.total:type_name -> cosmos.base.v1beta1.Coin
+	7,  // 7: cosmos.bank.v1beta1.Metadata.denom_units:type_name -> cosmos.bank.v1beta1.DenomUnit
+	21, // 8: cosmos.bank.v1beta1.Hold.amount:type_name -> cosmos.base.v1beta1.Coin
+	22, // 9: cosmos.bank.v1beta1.Hold.expiration:type_name -> google.protobuf.Timestamp
+	21, // 10: cosmos.bank.v1beta1.ModuleEscrow.amount:type_name -> cosmos.base.v1beta1.Coin
+	21, // 11: cosmos.bank.v1beta1.TimelockedTransfer.amount:type_name -> cosmos.base.v1beta1.Coin
+	22, // 12: cosmos.bank.v1beta1.TimelockedTransfer.unlock_time:type_name -> google.protobuf.Timestamp
+	22, // 13: cosmos.bank.v1beta1.TimelockedTransfer.expiry_time:type_name -> google.protobuf.Timestamp
+	21, // 14: cosmos.bank.v1beta1.SpendingLimit.limits:type_name -> cosmos.base.v1beta1.Coin
+	23, // 15: cosmos.bank.v1beta1.SpendingLimit.window:type_name -> google.protobuf.Duration
+	17, // 16: cosmos.bank.v1beta1.SpendingLimit.spent:type_name -> cosmos.bank.v1beta1.SpendingRecord
+	18, // 17: cosmos.bank.v1beta1.SpendingLimit.pending:type_name -> cosmos.bank.v1beta1.PendingSpendingLimit
+	22, // 18: cosmos.bank.v1beta1.SpendingRecord.time:type_name -> google.protobuf.Timestamp
+	21, // 19: cosmos.bank.v1beta1.SpendingRecord.amount:type_name -> cosmos.base.v1beta1.Coin
+	21, // 20: cosmos.bank.v1beta1.PendingSpendingLimit.limits:type_name -> cosmos.base.v1beta1.Coin
+	23, // 21: cosmos.bank.v1beta1.PendingSpendingLimit.window:type_name -> google.protobuf.Duration
+	22, // 22: cosmos.bank.v1beta1.PendingSpendingLimit.effective_time:type_name -> google.protobuf.Timestamp
+	20, // 23: cosmos.bank.v1beta1.DemurrageSchedule.segments:type_name -> cosmos.bank.v1beta1.DemurrageSegment
+	24, // [24:24] is the sub-list for method output_type
+	24, // [24:24] is the sub-list for method input_type
+	24, // [24:24] is the sub-list for extension type_name
+	24, // [24:24] is the sub-list for extension extendee
+	0,  // [0:24] is the sub-list for field type_name
 }
 
 func init() { file_cosmos_bank_v1beta1_bank_proto_init() }
@@ -4749,7 +15304,7 @@ func file_cosmos_bank_v1beta1_bank_proto_init() {
 			}
 		}
 		file_cosmos_bank_v1beta1_bank_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SendEnabled); i {
+			switch v := v.(*DustSweepParams); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4761,7 +15316,7 @@ func file_cosmos_bank_v1beta1_bank_proto_init() {
 			}
 		}
 		file_cosmos_bank_v1beta1_bank_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Input); i {
+			switch v := v.(*SendEnabled); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4773,7 +15328,7 @@ func file_cosmos_bank_v1beta1_bank_proto_init() {
 			}
 		}
 		file_cosmos_bank_v1beta1_bank_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Output); i {
+			switch v := v.(*Input); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4785,7 +15340,7 @@ func file_cosmos_bank_v1beta1_bank_proto_init() {
 			}
 		}
 		file_cosmos_bank_v1beta1_bank_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Supply); i {
+			switch v := v.(*Output); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4797,7 +15352,7 @@ func file_cosmos_bank_v1beta1_bank_proto_init() {
 			}
 		}
 		file_cosmos_bank_v1beta1_bank_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*DenomUnit); i {
+			switch v := v.(*BatchOutput); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4809,6 +15364,30 @@ func file_cosmos_bank_v1beta1_bank_proto_init() {
 			}
 		}
 		file_cosmos_bank_v1beta1_bank_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Supply); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_cosmos_bank_v1beta1_bank_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DenomUnit); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_cosmos_bank_v1beta1_bank_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*Metadata); i {
 			case 0:
 				return &v.state
@@ -4820,6 +15399,150 @@ func file_cosmos_bank_v1beta1_bank_proto_init() {
 				return nil
 			}
 		}
+		file_cosmos_bank_v1beta1_bank_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Hold); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_cosmos_bank_v1beta1_bank_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BalanceSnapshot); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_cosmos_bank_v1beta1_bank_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SnapshotBalance); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_cosmos_bank_v1beta1_bank_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BalanceSnapshotProof); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_cosmos_bank_v1beta1_bank_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MintBurnPermissions); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_cosmos_bank_v1beta1_bank_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ModuleEscrow); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_cosmos_bank_v1beta1_bank_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TimelockedTransfer); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_cosmos_bank_v1beta1_bank_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SpendingLimit); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_cosmos_bank_v1beta1_bank_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SpendingRecord); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_cosmos_bank_v1beta1_bank_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PendingSpendingLimit); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_cosmos_bank_v1beta1_bank_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DemurrageSchedule); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_cosmos_bank_v1beta1_bank_proto_msgTypes[20].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DemurrageSegment); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
 	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
@@ -4827,7 +15550,7 @@ func file_cosmos_bank_v1beta1_bank_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_cosmos_bank_v1beta1_bank_proto_rawDesc,
 			NumEnums:      0,
-			NumMessages:   7,
+			NumMessages:   21,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
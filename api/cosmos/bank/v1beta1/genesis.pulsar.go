@@ -220,35 +220,2477 @@ func (x *_GenesisState_5_list) IsValid() bool {
 	return x.list != nil
 }
 
+var _ protoreflect.List = (*_GenesisState_6_list)(nil)
+
+type _GenesisState_6_list struct {
+	list *[]*Hold
+}
+
+func (x *_GenesisState_6_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_GenesisState_6_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_GenesisState_6_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*Hold)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_GenesisState_6_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*Hold)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_GenesisState_6_list) AppendMutable() protoreflect.Value {
+	v := new(Hold)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_GenesisState_6_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_GenesisState_6_list) NewElement() protoreflect.Value {
+	v := new(Hold)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_GenesisState_6_list) IsValid() bool {
+	return x.list != nil
+}
+
+var _ protoreflect.List = (*_GenesisState_7_list)(nil)
+
+type _GenesisState_7_list struct {
+	list *[]*GenesisBalanceSnapshot
+}
+
+func (x *_GenesisState_7_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_GenesisState_7_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_GenesisState_7_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*GenesisBalanceSnapshot)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_GenesisState_7_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*GenesisBalanceSnapshot)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_GenesisState_7_list) AppendMutable() protoreflect.Value {
+	v := new(GenesisBalanceSnapshot)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_GenesisState_7_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_GenesisState_7_list) NewElement() protoreflect.Value {
+	v := new(GenesisBalanceSnapshot)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_GenesisState_7_list) IsValid() bool {
+	return x.list != nil
+}
+
+var _ protoreflect.List = (*_GenesisState_8_list)(nil)
+
+type _GenesisState_8_list struct {
+	list *[]*MintBurnPermissions
+}
+
+func (x *_GenesisState_8_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_GenesisState_8_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_GenesisState_8_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*MintBurnPermissions)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_GenesisState_8_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*MintBurnPermissions)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_GenesisState_8_list) AppendMutable() protoreflect.Value {
+	v := new(MintBurnPermissions)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_GenesisState_8_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_GenesisState_8_list) NewElement() protoreflect.Value {
+	v := new(MintBurnPermissions)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_GenesisState_8_list) IsValid() bool {
+	return x.list != nil
+}
+
+var _ protoreflect.List = (*_GenesisState_9_list)(nil)
+
+type _GenesisState_9_list struct {
+	list *[]string
+}
+
+func (x *_GenesisState_9_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_GenesisState_9_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfString((*x.list)[i])
+}
+
+func (x *_GenesisState_9_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.String()
+	concreteValue := valueUnwrapped
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_GenesisState_9_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.String()
+	concreteValue := valueUnwrapped
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_GenesisState_9_list) AppendMutable() protoreflect.Value {
+	panic(fmt.Errorf("AppendMutable can not be called on message GenesisState at list field DustSweepOptIns as it is not of Message kind"))
+}
+
+func (x *_GenesisState_9_list) Truncate(n int) {
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_GenesisState_9_list) NewElement() protoreflect.Value {
+	v := ""
+	return protoreflect.ValueOfString(v)
+}
+
+func (x *_GenesisState_9_list) IsValid() bool {
+	return x.list != nil
+}
+
+var _ protoreflect.List = (*_GenesisState_10_list)(nil)
+
+type _GenesisState_10_list struct {
+	list *[]*TimelockedTransfer
+}
+
+func (x *_GenesisState_10_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_GenesisState_10_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_GenesisState_10_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*TimelockedTransfer)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_GenesisState_10_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*TimelockedTransfer)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_GenesisState_10_list) AppendMutable() protoreflect.Value {
+	v := new(TimelockedTransfer)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_GenesisState_10_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_GenesisState_10_list) NewElement() protoreflect.Value {
+	v := new(TimelockedTransfer)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_GenesisState_10_list) IsValid() bool {
+	return x.list != nil
+}
+
+var _ protoreflect.List = (*_GenesisState_11_list)(nil)
+
+type _GenesisState_11_list struct {
+	list *[]*SpendingLimit
+}
+
+func (x *_GenesisState_11_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_GenesisState_11_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_GenesisState_11_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*SpendingLimit)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_GenesisState_11_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*SpendingLimit)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_GenesisState_11_list) AppendMutable() protoreflect.Value {
+	v := new(SpendingLimit)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_GenesisState_11_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_GenesisState_11_list) NewElement() protoreflect.Value {
+	v := new(SpendingLimit)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_GenesisState_11_list) IsValid() bool {
+	return x.list != nil
+}
+
+var _ protoreflect.List = (*_GenesisState_12_list)(nil)
+
+type _GenesisState_12_list struct {
+	list *[]*DemurrageSchedule
+}
+
+func (x *_GenesisState_12_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_GenesisState_12_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_GenesisState_12_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*DemurrageSchedule)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_GenesisState_12_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*DemurrageSchedule)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_GenesisState_12_list) AppendMutable() protoreflect.Value {
+	v := new(DemurrageSchedule)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_GenesisState_12_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_GenesisState_12_list) NewElement() protoreflect.Value {
+	v := new(DemurrageSchedule)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_GenesisState_12_list) IsValid() bool {
+	return x.list != nil
+}
+
+var _ protoreflect.List = (*_GenesisState_13_list)(nil)
+
+type _GenesisState_13_list struct {
+	list *[]*DemurrageAccountEpoch
+}
+
+func (x *_GenesisState_13_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_GenesisState_13_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_GenesisState_13_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*DemurrageAccountEpoch)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_GenesisState_13_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*DemurrageAccountEpoch)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_GenesisState_13_list) AppendMutable() protoreflect.Value {
+	v := new(DemurrageAccountEpoch)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_GenesisState_13_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_GenesisState_13_list) NewElement() protoreflect.Value {
+	v := new(DemurrageAccountEpoch)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_GenesisState_13_list) IsValid() bool {
+	return x.list != nil
+}
+
+var (
+	md_GenesisState                          protoreflect.MessageDescriptor
+	fd_GenesisState_params                   protoreflect.FieldDescriptor
+	fd_GenesisState_balances                 protoreflect.FieldDescriptor
+	fd_GenesisState_supply                   protoreflect.FieldDescriptor
+	fd_GenesisState_denom_metadata           protoreflect.FieldDescriptor
+	fd_GenesisState_send_enabled             protoreflect.FieldDescriptor
+	fd_GenesisState_holds                    protoreflect.FieldDescriptor
+	fd_GenesisState_balance_snapshots        protoreflect.FieldDescriptor
+	fd_GenesisState_mint_burn_permissions    protoreflect.FieldDescriptor
+	fd_GenesisState_dust_sweep_opt_ins       protoreflect.FieldDescriptor
+	fd_GenesisState_timelocked_transfers     protoreflect.FieldDescriptor
+	fd_GenesisState_spending_limits          protoreflect.FieldDescriptor
+	fd_GenesisState_demurrage_schedules      protoreflect.FieldDescriptor
+	fd_GenesisState_demurrage_account_epochs protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_cosmos_bank_v1beta1_genesis_proto_init()
+	md_GenesisState = File_cosmos_bank_v1beta1_genesis_proto.Messages().ByName("GenesisState")
+	fd_GenesisState_params = md_GenesisState.Fields().ByName("params")
+	fd_GenesisState_balances = md_GenesisState.Fields().ByName("balances")
+	fd_GenesisState_supply = md_GenesisState.Fields().ByName("supply")
+	fd_GenesisState_denom_metadata = md_GenesisState.Fields().ByName("denom_metadata")
+	fd_GenesisState_send_enabled = md_GenesisState.Fields().ByName("send_enabled")
+	fd_GenesisState_holds = md_GenesisState.Fields().ByName("holds")
+	fd_GenesisState_balance_snapshots = md_GenesisState.Fields().ByName("balance_snapshots")
+	fd_GenesisState_mint_burn_permissions = md_GenesisState.Fields().ByName("mint_burn_permissions")
+	fd_GenesisState_dust_sweep_opt_ins = md_GenesisState.Fields().ByName("dust_sweep_opt_ins")
+	fd_GenesisState_timelocked_transfers = md_GenesisState.Fields().ByName("timelocked_transfers")
+	fd_GenesisState_spending_limits = md_GenesisState.Fields().ByName("spending_limits")
+	fd_GenesisState_demurrage_schedules = md_GenesisState.Fields().ByName("demurrage_schedules")
+	fd_GenesisState_demurrage_account_epochs = md_GenesisState.Fields().ByName("demurrage_account_epochs")
+}
+
+var _ protoreflect.Message = (*fastReflection_GenesisState)(nil)
+
+type fastReflection_GenesisState GenesisState
+
+func (x *GenesisState) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_GenesisState)(x)
+}
+
+func (x *GenesisState) slowProtoReflect() protoreflect.Message {
+	mi := &file_cosmos_bank_v1beta1_genesis_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_GenesisState_messageType fastReflection_GenesisState_messageType
+var _ protoreflect.MessageType = fastReflection_GenesisState_messageType{}
+
+type fastReflection_GenesisState_messageType struct{}
+
+func (x fastReflection_GenesisState_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_GenesisState)(nil)
+}
+func (x fastReflection_GenesisState_messageType) New() protoreflect.Message {
+	return new(fastReflection_GenesisState)
+}
+func (x fastReflection_GenesisState_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_GenesisState
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_GenesisState) Descriptor() protoreflect.MessageDescriptor {
+	return md_GenesisState
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_GenesisState) Type() protoreflect.MessageType {
+	return _fastReflection_GenesisState_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_GenesisState) New() protoreflect.Message {
+	return new(fastReflection_GenesisState)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_GenesisState) Interface() protoreflect.ProtoMessage {
+	return (*GenesisState)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_GenesisState) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Params != nil {
+		value := protoreflect.ValueOfMessage(x.Params.ProtoReflect())
+		if !f(fd_GenesisState_params, value) {
+			return
+		}
+	}
+	if len(x.Balances) != 0 {
+		value := protoreflect.ValueOfList(&_GenesisState_2_list{list: &x.Balances})
+		if !f(fd_GenesisState_balances, value) {
+			return
+		}
+	}
+	if len(x.Supply) != 0 {
+		value := protoreflect.ValueOfList(&_GenesisState_3_list{list: &x.Supply})
+		if !f(fd_GenesisState_supply, value) {
+			return
+		}
+	}
+	if len(x.DenomMetadata) != 0 {
+		value := protoreflect.ValueOfList(&_GenesisState_4_list{list: &x.DenomMetadata})
+		if !f(fd_GenesisState_denom_metadata, value) {
+			return
+		}
+	}
+	if len(x.SendEnabled) != 0 {
+		value := protoreflect.ValueOfList(&_GenesisState_5_list{list: &x.SendEnabled})
+		if !f(fd_GenesisState_send_enabled, value) {
+			return
+		}
+	}
+	if len(x.Holds) != 0 {
+		value := protoreflect.ValueOfList(&_GenesisState_6_list{list: &x.Holds})
+		if !f(fd_GenesisState_holds, value) {
+			return
+		}
+	}
+	if len(x.BalanceSnapshots) != 0 {
+		value := protoreflect.ValueOfList(&_GenesisState_7_list{list: &x.BalanceSnapshots})
+		if !f(fd_GenesisState_balance_snapshots, value) {
+			return
+		}
+	}
+	if len(x.MintBurnPermissions) != 0 {
+		value := protoreflect.ValueOfList(&_GenesisState_8_list{list: &x.MintBurnPermissions})
+		if !f(fd_GenesisState_mint_burn_permissions, value) {
+			return
+		}
+	}
+	if len(x.DustSweepOptIns) != 0 {
+		value := protoreflect.ValueOfList(&_GenesisState_9_list{list: &x.DustSweepOptIns})
+		if !f(fd_GenesisState_dust_sweep_opt_ins, value) {
+			return
+		}
+	}
+	if len(x.TimelockedTransfers) != 0 {
+		value := protoreflect.ValueOfList(&_GenesisState_10_list{list: &x.TimelockedTransfers})
+		if !f(fd_GenesisState_timelocked_transfers, value) {
+			return
+		}
+	}
+	if len(x.SpendingLimits) != 0 {
+		value := protoreflect.ValueOfList(&_GenesisState_11_list{list: &x.SpendingLimits})
+		if !f(fd_GenesisState_spending_limits, value) {
+			return
+		}
+	}
+	if len(x.DemurrageSchedules) != 0 {
+		value := protoreflect.ValueOfList(&_GenesisState_12_list{list: &x.DemurrageSchedules})
+		if !f(fd_GenesisState_demurrage_schedules, value) {
+			return
+		}
+	}
+	if len(x.DemurrageAccountEpochs) != 0 {
+		value := protoreflect.ValueOfList(&_GenesisState_13_list{list: &x.DemurrageAccountEpochs})
+		if !f(fd_GenesisState_demurrage_account_epochs, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_GenesisState) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "cosmos.bank.v1beta1.GenesisState.params":
+		return x.Params != nil
+	case "cosmos.bank.v1beta1.GenesisState.balances":
+		return len(x.Balances) != 0
+	case "cosmos.bank.v1beta1.GenesisState.supply":
+		return len(x.Supply) != 0
+	case "cosmos.bank.v1beta1.GenesisState.denom_metadata":
+		return len(x.DenomMetadata) != 0
+	case "cosmos.bank.v1beta1.GenesisState.send_enabled":
+		return len(x.SendEnabled) != 0
+	case "cosmos.bank.v1beta1.GenesisState.holds":
+		return len(x.Holds) != 0
+	case "cosmos.bank.v1beta1.GenesisState.balance_snapshots":
+		return len(x.BalanceSnapshots) != 0
+	case "cosmos.bank.v1beta1.GenesisState.mint_burn_permissions":
+		return len(x.MintBurnPermissions) != 0
+	case "cosmos.bank.v1beta1.GenesisState.dust_sweep_opt_ins":
+		return len(x.DustSweepOptIns) != 0
+	case "cosmos.bank.v1beta1.GenesisState.timelocked_transfers":
+		return len(x.TimelockedTransfers) != 0
+	case "cosmos.bank.v1beta1.GenesisState.spending_limits":
+		return len(x.SpendingLimits) != 0
+	case "cosmos.bank.v1beta1.GenesisState.demurrage_schedules":
+		return len(x.DemurrageSchedules) != 0
+	case "cosmos.bank.v1beta1.GenesisState.demurrage_account_epochs":
+		return len(x.DemurrageAccountEpochs) != 0
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.bank.v1beta1.GenesisState"))
+		}
+		panic(fmt.Errorf("message cosmos.bank.v1beta1.GenesisState does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_GenesisState) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "cosmos.bank.v1beta1.GenesisState.params":
+		x.Params = nil
+	case "cosmos.bank.v1beta1.GenesisState.balances":
+		x.Balances = nil
+	case "cosmos.bank.v1beta1.GenesisState.supply":
+		x.Supply = nil
+	case "cosmos.bank.v1beta1.GenesisState.denom_metadata":
+		x.DenomMetadata = nil
+	case "cosmos.bank.v1beta1.GenesisState.send_enabled":
+		x.SendEnabled = nil
+	case "cosmos.bank.v1beta1.GenesisState.holds":
+		x.Holds = nil
+	case "cosmos.bank.v1beta1.GenesisState.balance_snapshots":
+		x.BalanceSnapshots = nil
+	case "cosmos.bank.v1beta1.GenesisState.mint_burn_permissions":
+		x.MintBurnPermissions = nil
+	case "cosmos.bank.v1beta1.GenesisState.dust_sweep_opt_ins":
+		x.DustSweepOptIns = nil
+	case "cosmos.bank.v1beta1.GenesisState.timelocked_transfers":
+		x.TimelockedTransfers = nil
+	case "cosmos.bank.v1beta1.GenesisState.spending_limits":
+		x.SpendingLimits = nil
+	case "cosmos.bank.v1beta1.GenesisState.demurrage_schedules":
+		x.DemurrageSchedules = nil
+	case "cosmos.bank.v1beta1.GenesisState.demurrage_account_epochs":
+		x.DemurrageAccountEpochs = nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.bank.v1beta1.GenesisState"))
+		}
+		panic(fmt.Errorf("message cosmos.bank.v1beta1.GenesisState does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_GenesisState) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "cosmos.bank.v1beta1.GenesisState.params":
+		value := x.Params
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "cosmos.bank.v1beta1.GenesisState.balances":
+		if len(x.Balances) == 0 {
+			return protoreflect.ValueOfList(&_GenesisState_2_list{})
+		}
+		listValue := &_GenesisState_2_list{list: &x.Balances}
+		return protoreflect.ValueOfList(listValue)
+	case "cosmos.bank.v1beta1.GenesisState.supply":
+		if len(x.Supply) == 0 {
+			return protoreflect.ValueOfList(&_GenesisState_3_list{})
+		}
+		listValue := &_GenesisState_3_list{list: &x.Supply}
+		return protoreflect.ValueOfList(listValue)
+	case "cosmos.bank.v1beta1.GenesisState.denom_metadata":
+		if len(x.DenomMetadata) == 0 {
+			return protoreflect.ValueOfList(&_GenesisState_4_list{})
+		}
+		listValue := &_GenesisState_4_list{list: &x.DenomMetadata}
+		return protoreflect.ValueOfList(listValue)
+	case "cosmos.bank.v1beta1.GenesisState.send_enabled":
+		if len(x.SendEnabled) == 0 {
+			return protoreflect.ValueOfList(&_GenesisState_5_list{})
+		}
+		listValue := &_GenesisState_5_list{list: &x.SendEnabled}
+		return protoreflect.ValueOfList(listValue)
+	case "cosmos.bank.v1beta1.GenesisState.holds":
+		if len(x.Holds) == 0 {
+			return protoreflect.ValueOfList(&_GenesisState_6_list{})
+		}
+		listValue := &_GenesisState_6_list{list: &x.Holds}
+		return protoreflect.ValueOfList(listValue)
+	case "cosmos.bank.v1beta1.GenesisState.balance_snapshots":
+		if len(x.BalanceSnapshots) == 0 {
+			return protoreflect.ValueOfList(&_GenesisState_7_list{})
+		}
+		listValue := &_GenesisState_7_list{list: &x.BalanceSnapshots}
+		return protoreflect.ValueOfList(listValue)
+	case "cosmos.bank.v1beta1.GenesisState.mint_burn_permissions":
+		if len(x.MintBurnPermissions) == 0 {
+			return protoreflect.ValueOfList(&_GenesisState_8_list{})
+		}
+		listValue := &_GenesisState_8_list{list: &x.MintBurnPermissions}
+		return protoreflect.ValueOfList(listValue)
+	case "cosmos.bank.v1beta1.GenesisState.dust_sweep_opt_ins":
+		if len(x.DustSweepOptIns) == 0 {
+			return protoreflect.ValueOfList(&_GenesisState_9_list{})
+		}
+		listValue := &_GenesisState_9_list{list: &x.DustSweepOptIns}
+		return protoreflect.ValueOfList(listValue)
+	case "cosmos.bank.v1beta1.GenesisState.timelocked_transfers":
+		if len(x.TimelockedTransfers) == 0 {
+			return protoreflect.ValueOfList(&_GenesisState_10_list{})
+		}
+		listValue := &_GenesisState_10_list{list: &x.TimelockedTransfers}
+		return protoreflect.ValueOfList(listValue)
+	case "cosmos.bank.v1beta1.GenesisState.spending_limits":
+		if len(x.SpendingLimits) == 0 {
+			return protoreflect.ValueOfList(&_GenesisState_11_list{})
+		}
+		listValue := &_GenesisState_11_list{list: &x.SpendingLimits}
+		return protoreflect.ValueOfList(listValue)
+	case "cosmos.bank.v1beta1.GenesisState.demurrage_schedules":
+		if len(x.DemurrageSchedules) == 0 {
+			return protoreflect.ValueOfList(&_GenesisState_12_list{})
+		}
+		listValue := &_GenesisState_12_list{list: &x.DemurrageSchedules}
+		return protoreflect.ValueOfList(listValue)
+	case "cosmos.bank.v1beta1.GenesisState.demurrage_account_epochs":
+		if len(x.DemurrageAccountEpochs) == 0 {
+			return protoreflect.ValueOfList(&_GenesisState_13_list{})
+		}
+		listValue := &_GenesisState_13_list{list: &x.DemurrageAccountEpochs}
+		return protoreflect.ValueOfList(listValue)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.bank.v1beta1.GenesisState"))
+		}
+		panic(fmt.Errorf("message cosmos.bank.v1beta1.GenesisState does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_GenesisState) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "cosmos.bank.v1beta1.GenesisState.params":
+		x.Params = value.Message().Interface().(*Params)
+	case "cosmos.bank.v1beta1.GenesisState.balances":
+		lv := value.List()
+		clv := lv.(*_GenesisState_2_list)
+		x.Balances = *clv.list
+	case "cosmos.bank.v1beta1.GenesisState.supply":
+		lv := value.List()
+		clv := lv.(*_GenesisState_3_list)
+		x.Supply = *clv.list
+	case "cosmos.bank.v1beta1.GenesisState.denom_metadata":
+		lv := value.List()
+		clv := lv.(*_GenesisState_4_list)
+		x.DenomMetadata = *clv.list
+	case "cosmos.bank.v1beta1.GenesisState.send_enabled":
+		lv := value.List()
+		clv := lv.(*_GenesisState_5_list)
+		x.SendEnabled = *clv.list
+	case "cosmos.bank.v1beta1.GenesisState.holds":
+		lv := value.List()
+		clv := lv.(*_GenesisState_6_list)
+		x.Holds = *clv.list
+	case "cosmos.bank.v1beta1.GenesisState.balance_snapshots":
+		lv := value.List()
+		clv := lv.(*_GenesisState_7_list)
+		x.BalanceSnapshots = *clv.list
+	case "cosmos.bank.v1beta1.GenesisState.mint_burn_permissions":
+		lv := value.List()
+		clv := lv.(*_GenesisState_8_list)
+		x.MintBurnPermissions = *clv.list
+	case "cosmos.bank.v1beta1.GenesisState.dust_sweep_opt_ins":
+		lv := value.List()
+		clv := lv.(*_GenesisState_9_list)
+		x.DustSweepOptIns = *clv.list
+	case "cosmos.bank.v1beta1.GenesisState.timelocked_transfers":
+		lv := value.List()
+		clv := lv.(*_GenesisState_10_list)
+		x.TimelockedTransfers = *clv.list
+	case "cosmos.bank.v1beta1.GenesisState.spending_limits":
+		lv := value.List()
+		clv := lv.(*_GenesisState_11_list)
+		x.SpendingLimits = *clv.list
+	case "cosmos.bank.v1beta1.GenesisState.demurrage_schedules":
+		lv := value.List()
+		clv := lv.(*_GenesisState_12_list)
+		x.DemurrageSchedules = *clv.list
+	case "cosmos.bank.v1beta1.GenesisState.demurrage_account_epochs":
+		lv := value.List()
+		clv := lv.(*_GenesisState_13_list)
+		x.DemurrageAccountEpochs = *clv.list
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.bank.v1beta1.GenesisState"))
+		}
+		panic(fmt.Errorf("message cosmos.bank.v1beta1.GenesisState does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_GenesisState) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "cosmos.bank.v1beta1.GenesisState.params":
+		if x.Params == nil {
+			x.Params = new(Params)
+		}
+		return protoreflect.ValueOfMessage(x.Params.ProtoReflect())
+	case "cosmos.bank.v1beta1.GenesisState.balances":
+		if x.Balances == nil {
+			x.Balances = []*Balance{}
+		}
+		value := &_GenesisState_2_list{list: &x.Balances}
+		return protoreflect.ValueOfList(value)
+	case "cosmos.bank.v1beta1.GenesisState.supply":
+		if x.Supply == nil {
+			x.Supply = []*v1beta1.Coin{}
+		}
+		value := &_GenesisState_3_list{list: &x.Supply}
+		return protoreflect.ValueOfList(value)
+	case "cosmos.bank.v1beta1.GenesisState.denom_metadata":
+		if x.DenomMetadata == nil {
+			x.DenomMetadata = []*Metadata{}
+		}
+		value := &_GenesisState_4_list{list: &x.DenomMetadata}
+		return protoreflect.ValueOfList(value)
+	case "cosmos.bank.v1beta1.GenesisState.send_enabled":
+		if x.SendEnabled == nil {
+			x.SendEnabled = []*SendEnabled{}
+		}
+		value := &_GenesisState_5_list{list: &x.SendEnabled}
+		return protoreflect.ValueOfList(value)
+	case "cosmos.bank.v1beta1.GenesisState.holds":
+		if x.Holds == nil {
+			x.Holds = []*Hold{}
+		}
+		value := &_GenesisState_6_list{list: &x.Holds}
+		return protoreflect.ValueOfList(value)
+	case "cosmos.bank.v1beta1.GenesisState.balance_snapshots":
+		if x.BalanceSnapshots == nil {
+			x.BalanceSnapshots = []*GenesisBalanceSnapshot{}
+		}
+		value := &_GenesisState_7_list{list: &x.BalanceSnapshots}
+		return protoreflect.ValueOfList(value)
+	case "cosmos.bank.v1beta1.GenesisState.mint_burn_permissions":
+		if x.MintBurnPermissions == nil {
+			x.MintBurnPermissions = []*MintBurnPermissions{}
+		}
+		value := &_GenesisState_8_list{list: &x.MintBurnPermissions}
+		return protoreflect.ValueOfList(value)
+	case "cosmos.bank.v1beta1.GenesisState.dust_sweep_opt_ins":
+		if x.DustSweepOptIns == nil {
+			x.DustSweepOptIns = []string{}
+		}
+		value := &_GenesisState_9_list{list: &x.DustSweepOptIns}
+		return protoreflect.ValueOfList(value)
+	case "cosmos.bank.v1beta1.GenesisState.timelocked_transfers":
+		if x.TimelockedTransfers == nil {
+			x.TimelockedTransfers = []*TimelockedTransfer{}
+		}
+		value := &_GenesisState_10_list{list: &x.TimelockedTransfers}
+		return protoreflect.ValueOfList(value)
+	case "cosmos.bank.v1beta1.GenesisState.spending_limits":
+		if x.SpendingLimits == nil {
+			x.SpendingLimits = []*SpendingLimit{}
+		}
+		value := &_GenesisState_11_list{list: &x.SpendingLimits}
+		return protoreflect.ValueOfList(value)
+	case "cosmos.bank.v1beta1.GenesisState.demurrage_schedules":
+		if x.DemurrageSchedules == nil {
+			x.DemurrageSchedules = []*DemurrageSchedule{}
+		}
+		value := &_GenesisState_12_list{list: &x.DemurrageSchedules}
+		return protoreflect.ValueOfList(value)
+	case "cosmos.bank.v1beta1.GenesisState.demurrage_account_epochs":
+		if x.DemurrageAccountEpochs == nil {
+			x.DemurrageAccountEpochs = []*DemurrageAccountEpoch{}
+		}
+		value := &_GenesisState_13_list{list: &x.DemurrageAccountEpochs}
+		return protoreflect.ValueOfList(value)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.bank.v1beta1.GenesisState"))
+		}
+		panic(fmt.Errorf("message cosmos.bank.v1beta1.GenesisState does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_GenesisState) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "cosmos.bank.v1beta1.GenesisState.params":
+		m := new(Params)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case "cosmos.bank.v1beta1.GenesisState.balances":
+		list := []*Balance{}
+		return protoreflect.ValueOfList(&_GenesisState_2_list{list: &list})
+	case "cosmos.bank.v1beta1.GenesisState.supply":
+		list := []*v1beta1.Coin{}
+		return protoreflect.ValueOfList(&_GenesisState_3_list{list: &list})
+	case "cosmos.bank.v1beta1.GenesisState.denom_metadata":
+		list := []*Metadata{}
+		return protoreflect.ValueOfList(&_GenesisState_4_list{list: &list})
+	case "cosmos.bank.v1beta1.GenesisState.send_enabled":
+		list := []*SendEnabled{}
+		return protoreflect.ValueOfList(&_GenesisState_5_list{list: &list})
+	case "cosmos.bank.v1beta1.GenesisState.holds":
+		list := []*Hold{}
+		return protoreflect.ValueOfList(&_GenesisState_6_list{list: &list})
+	case "cosmos.bank.v1beta1.GenesisState.balance_snapshots":
+		list := []*GenesisBalanceSnapshot{}
+		return protoreflect.ValueOfList(&_GenesisState_7_list{list: &list})
+	case "cosmos.bank.v1beta1.GenesisState.mint_burn_permissions":
+		list := []*MintBurnPermissions{}
+		return protoreflect.ValueOfList(&_GenesisState_8_list{list: &list})
+	case "cosmos.bank.v1beta1.GenesisState.dust_sweep_opt_ins":
+		list := []string{}
+		return protoreflect.ValueOfList(&_GenesisState_9_list{list: &list})
+	case "cosmos.bank.v1beta1.GenesisState.timelocked_transfers":
+		list := []*TimelockedTransfer{}
+		return protoreflect.ValueOfList(&_GenesisState_10_list{list: &list})
+	case "cosmos.bank.v1beta1.GenesisState.spending_limits":
+		list := []*SpendingLimit{}
+		return protoreflect.ValueOfList(&_GenesisState_11_list{list: &list})
+	case "cosmos.bank.v1beta1.GenesisState.demurrage_schedules":
+		list := []*DemurrageSchedule{}
+		return protoreflect.ValueOfList(&_GenesisState_12_list{list: &list})
+	case "cosmos.bank.v1beta1.GenesisState.demurrage_account_epochs":
+		list := []*DemurrageAccountEpoch{}
+		return protoreflect.ValueOfList(&_GenesisState_13_list{list: &list})
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.bank.v1beta1.GenesisState"))
+		}
+		panic(fmt.Errorf("message cosmos.bank.v1beta1.GenesisState does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_GenesisState) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in cosmos.bank.v1beta1.GenesisState", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_GenesisState) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_GenesisState) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_GenesisState) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_GenesisState) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*GenesisState)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if x.Params != nil {
+			l = options.Size(x.Params)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if len(x.Balances) > 0 {
+			for _, e := range x.Balances {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if len(x.Supply) > 0 {
+			for _, e := range x.Supply {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if len(x.DenomMetadata) > 0 {
+			for _, e := range x.DenomMetadata {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if len(x.SendEnabled) > 0 {
+			for _, e := range x.SendEnabled {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if len(x.Holds) > 0 {
+			for _, e := range x.Holds {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if len(x.BalanceSnapshots) > 0 {
+			for _, e := range x.BalanceSnapshots {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if len(x.MintBurnPermissions) > 0 {
+			for _, e := range x.MintBurnPermissions {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if len(x.DustSweepOptIns) > 0 {
+			for _, s := range x.DustSweepOptIns {
+				l = len(s)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if len(x.TimelockedTransfers) > 0 {
+			for _, e := range x.TimelockedTransfers {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if len(x.SpendingLimits) > 0 {
+			for _, e := range x.SpendingLimits {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if len(x.DemurrageSchedules) > 0 {
+			for _, e := range x.DemurrageSchedules {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if len(x.DemurrageAccountEpochs) > 0 {
+			for _, e := range x.DemurrageAccountEpochs {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*GenesisState)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if len(x.DemurrageAccountEpochs) > 0 {
+			for iNdEx := len(x.DemurrageAccountEpochs) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.DemurrageAccountEpochs[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x6a
+			}
+		}
+		if len(x.DemurrageSchedules) > 0 {
+			for iNdEx := len(x.DemurrageSchedules) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.DemurrageSchedules[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x62
+			}
+		}
+		if len(x.SpendingLimits) > 0 {
+			for iNdEx := len(x.SpendingLimits) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.SpendingLimits[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x5a
+			}
+		}
+		if len(x.TimelockedTransfers) > 0 {
+			for iNdEx := len(x.TimelockedTransfers) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.TimelockedTransfers[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x52
+			}
+		}
+		if len(x.DustSweepOptIns) > 0 {
+			for iNdEx := len(x.DustSweepOptIns) - 1; iNdEx >= 0; iNdEx-- {
+				i -= len(x.DustSweepOptIns[iNdEx])
+				copy(dAtA[i:], x.DustSweepOptIns[iNdEx])
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(x.DustSweepOptIns[iNdEx])))
+				i--
+				dAtA[i] = 0x4a
+			}
+		}
+		if len(x.MintBurnPermissions) > 0 {
+			for iNdEx := len(x.MintBurnPermissions) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.MintBurnPermissions[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x42
+			}
+		}
+		if len(x.BalanceSnapshots) > 0 {
+			for iNdEx := len(x.BalanceSnapshots) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.BalanceSnapshots[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x3a
+			}
+		}
+		if len(x.Holds) > 0 {
+			for iNdEx := len(x.Holds) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.Holds[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x32
+			}
+		}
+		if len(x.SendEnabled) > 0 {
+			for iNdEx := len(x.SendEnabled) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.SendEnabled[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x2a
+			}
+		}
+		if len(x.DenomMetadata) > 0 {
+			for iNdEx := len(x.DenomMetadata) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.DenomMetadata[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x22
+			}
+		}
+		if len(x.Supply) > 0 {
+			for iNdEx := len(x.Supply) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.Supply[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x1a
+			}
+		}
+		if len(x.Balances) > 0 {
+			for iNdEx := len(x.Balances) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.Balances[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x12
+			}
+		}
+		if x.Params != nil {
+			encoded, err := options.Marshal(x.Params)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*GenesisState)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: GenesisState: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: GenesisState: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.Params == nil {
+					x.Params = &Params{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Params); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Balances", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Balances = append(x.Balances, &Balance{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Balances[len(x.Balances)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 3:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Supply", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Supply = append(x.Supply, &v1beta1.Coin{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Supply[len(x.Supply)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 4:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field DenomMetadata", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.DenomMetadata = append(x.DenomMetadata, &Metadata{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.DenomMetadata[len(x.DenomMetadata)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 5:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field SendEnabled", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.SendEnabled = append(x.SendEnabled, &SendEnabled{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.SendEnabled[len(x.SendEnabled)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 6:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Holds", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Holds = append(x.Holds, &Hold{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Holds[len(x.Holds)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 7:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field BalanceSnapshots", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.BalanceSnapshots = append(x.BalanceSnapshots, &GenesisBalanceSnapshot{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.BalanceSnapshots[len(x.BalanceSnapshots)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 8:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field MintBurnPermissions", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.MintBurnPermissions = append(x.MintBurnPermissions, &MintBurnPermissions{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.MintBurnPermissions[len(x.MintBurnPermissions)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 9:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field DustSweepOptIns", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.DustSweepOptIns = append(x.DustSweepOptIns, string(dAtA[iNdEx:postIndex]))
+				iNdEx = postIndex
+			case 10:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field TimelockedTransfers", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.TimelockedTransfers = append(x.TimelockedTransfers, &TimelockedTransfer{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.TimelockedTransfers[len(x.TimelockedTransfers)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 11:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field SpendingLimits", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.SpendingLimits = append(x.SpendingLimits, &SpendingLimit{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.SpendingLimits[len(x.SpendingLimits)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 12:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field DemurrageSchedules", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.DemurrageSchedules = append(x.DemurrageSchedules, &DemurrageSchedule{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.DemurrageSchedules[len(x.DemurrageSchedules)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 13:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field DemurrageAccountEpochs", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.DemurrageAccountEpochs = append(x.DemurrageAccountEpochs, &DemurrageAccountEpoch{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.DemurrageAccountEpochs[len(x.DemurrageAccountEpochs)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var _ protoreflect.List = (*_GenesisBalanceSnapshot_2_list)(nil)
+
+type _GenesisBalanceSnapshot_2_list struct {
+	list *[]*SnapshotBalance
+}
+
+func (x *_GenesisBalanceSnapshot_2_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_GenesisBalanceSnapshot_2_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_GenesisBalanceSnapshot_2_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*SnapshotBalance)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_GenesisBalanceSnapshot_2_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*SnapshotBalance)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_GenesisBalanceSnapshot_2_list) AppendMutable() protoreflect.Value {
+	v := new(SnapshotBalance)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_GenesisBalanceSnapshot_2_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_GenesisBalanceSnapshot_2_list) NewElement() protoreflect.Value {
+	v := new(SnapshotBalance)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_GenesisBalanceSnapshot_2_list) IsValid() bool {
+	return x.list != nil
+}
+
+var (
+	md_GenesisBalanceSnapshot          protoreflect.MessageDescriptor
+	fd_GenesisBalanceSnapshot_snapshot protoreflect.FieldDescriptor
+	fd_GenesisBalanceSnapshot_balances protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_cosmos_bank_v1beta1_genesis_proto_init()
+	md_GenesisBalanceSnapshot = File_cosmos_bank_v1beta1_genesis_proto.Messages().ByName("GenesisBalanceSnapshot")
+	fd_GenesisBalanceSnapshot_snapshot = md_GenesisBalanceSnapshot.Fields().ByName("snapshot")
+	fd_GenesisBalanceSnapshot_balances = md_GenesisBalanceSnapshot.Fields().ByName("balances")
+}
+
+var _ protoreflect.Message = (*fastReflection_GenesisBalanceSnapshot)(nil)
+
+type fastReflection_GenesisBalanceSnapshot GenesisBalanceSnapshot
+
+func (x *GenesisBalanceSnapshot) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_GenesisBalanceSnapshot)(x)
+}
+
+func (x *GenesisBalanceSnapshot) slowProtoReflect() protoreflect.Message {
+	mi := &file_cosmos_bank_v1beta1_genesis_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_GenesisBalanceSnapshot_messageType fastReflection_GenesisBalanceSnapshot_messageType
+var _ protoreflect.MessageType = fastReflection_GenesisBalanceSnapshot_messageType{}
+
+type fastReflection_GenesisBalanceSnapshot_messageType struct{}
+
+func (x fastReflection_GenesisBalanceSnapshot_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_GenesisBalanceSnapshot)(nil)
+}
+func (x fastReflection_GenesisBalanceSnapshot_messageType) New() protoreflect.Message {
+	return new(fastReflection_GenesisBalanceSnapshot)
+}
+func (x fastReflection_GenesisBalanceSnapshot_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_GenesisBalanceSnapshot
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_GenesisBalanceSnapshot) Descriptor() protoreflect.MessageDescriptor {
+	return md_GenesisBalanceSnapshot
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_GenesisBalanceSnapshot) Type() protoreflect.MessageType {
+	return _fastReflection_GenesisBalanceSnapshot_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_GenesisBalanceSnapshot) New() protoreflect.Message {
+	return new(fastReflection_GenesisBalanceSnapshot)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_GenesisBalanceSnapshot) Interface() protoreflect.ProtoMessage {
+	return (*GenesisBalanceSnapshot)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_GenesisBalanceSnapshot) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Snapshot != nil {
+		value := protoreflect.ValueOfMessage(x.Snapshot.ProtoReflect())
+		if !f(fd_GenesisBalanceSnapshot_snapshot, value) {
+			return
+		}
+	}
+	if len(x.Balances) != 0 {
+		value := protoreflect.ValueOfList(&_GenesisBalanceSnapshot_2_list{list: &x.Balances})
+		if !f(fd_GenesisBalanceSnapshot_balances, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_GenesisBalanceSnapshot) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "cosmos.bank.v1beta1.GenesisBalanceSnapshot.snapshot":
+		return x.Snapshot != nil
+	case "cosmos.bank.v1beta1.GenesisBalanceSnapshot.balances":
+		return len(x.Balances) != 0
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.bank.v1beta1.GenesisBalanceSnapshot"))
+		}
+		panic(fmt.Errorf("message cosmos.bank.v1beta1.GenesisBalanceSnapshot does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_GenesisBalanceSnapshot) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "cosmos.bank.v1beta1.GenesisBalanceSnapshot.snapshot":
+		x.Snapshot = nil
+	case "cosmos.bank.v1beta1.GenesisBalanceSnapshot.balances":
+		x.Balances = nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.bank.v1beta1.GenesisBalanceSnapshot"))
+		}
+		panic(fmt.Errorf("message cosmos.bank.v1beta1.GenesisBalanceSnapshot does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_GenesisBalanceSnapshot) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "cosmos.bank.v1beta1.GenesisBalanceSnapshot.snapshot":
+		value := x.Snapshot
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "cosmos.bank.v1beta1.GenesisBalanceSnapshot.balances":
+		if len(x.Balances) == 0 {
+			return protoreflect.ValueOfList(&_GenesisBalanceSnapshot_2_list{})
+		}
+		listValue := &_GenesisBalanceSnapshot_2_list{list: &x.Balances}
+		return protoreflect.ValueOfList(listValue)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.bank.v1beta1.GenesisBalanceSnapshot"))
+		}
+		panic(fmt.Errorf("message cosmos.bank.v1beta1.GenesisBalanceSnapshot does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_GenesisBalanceSnapshot) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "cosmos.bank.v1beta1.GenesisBalanceSnapshot.snapshot":
+		x.Snapshot = value.Message().Interface().(*BalanceSnapshot)
+	case "cosmos.bank.v1beta1.GenesisBalanceSnapshot.balances":
+		lv := value.List()
+		clv := lv.(*_GenesisBalanceSnapshot_2_list)
+		x.Balances = *clv.list
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.bank.v1beta1.GenesisBalanceSnapshot"))
+		}
+		panic(fmt.Errorf("message cosmos.bank.v1beta1.GenesisBalanceSnapshot does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_GenesisBalanceSnapshot) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "cosmos.bank.v1beta1.GenesisBalanceSnapshot.snapshot":
+		if x.Snapshot == nil {
+			x.Snapshot = new(BalanceSnapshot)
+		}
+		return protoreflect.ValueOfMessage(x.Snapshot.ProtoReflect())
+	case "cosmos.bank.v1beta1.GenesisBalanceSnapshot.balances":
+		if x.Balances == nil {
+			x.Balances = []*SnapshotBalance{}
+		}
+		value := &_GenesisBalanceSnapshot_2_list{list: &x.Balances}
+		return protoreflect.ValueOfList(value)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.bank.v1beta1.GenesisBalanceSnapshot"))
+		}
+		panic(fmt.Errorf("message cosmos.bank.v1beta1.GenesisBalanceSnapshot does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_GenesisBalanceSnapshot) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "cosmos.bank.v1beta1.GenesisBalanceSnapshot.snapshot":
+		m := new(BalanceSnapshot)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case "cosmos.bank.v1beta1.GenesisBalanceSnapshot.balances":
+		list := []*SnapshotBalance{}
+		return protoreflect.ValueOfList(&_GenesisBalanceSnapshot_2_list{list: &list})
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.bank.v1beta1.GenesisBalanceSnapshot"))
+		}
+		panic(fmt.Errorf("message cosmos.bank.v1beta1.GenesisBalanceSnapshot does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_GenesisBalanceSnapshot) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in cosmos.bank.v1beta1.GenesisBalanceSnapshot", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_GenesisBalanceSnapshot) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_GenesisBalanceSnapshot) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_GenesisBalanceSnapshot) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_GenesisBalanceSnapshot) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*GenesisBalanceSnapshot)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if x.Snapshot != nil {
+			l = options.Size(x.Snapshot)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if len(x.Balances) > 0 {
+			for _, e := range x.Balances {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*GenesisBalanceSnapshot)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if len(x.Balances) > 0 {
+			for iNdEx := len(x.Balances) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.Balances[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x12
+			}
+		}
+		if x.Snapshot != nil {
+			encoded, err := options.Marshal(x.Snapshot)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*GenesisBalanceSnapshot)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: GenesisBalanceSnapshot: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: GenesisBalanceSnapshot: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Snapshot", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.Snapshot == nil {
+					x.Snapshot = &BalanceSnapshot{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Snapshot); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Balances", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Balances = append(x.Balances, &SnapshotBalance{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Balances[len(x.Balances)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
 var (
-	md_GenesisState                protoreflect.MessageDescriptor
-	fd_GenesisState_params         protoreflect.FieldDescriptor
-	fd_GenesisState_balances       protoreflect.FieldDescriptor
-	fd_GenesisState_supply         protoreflect.FieldDescriptor
-	fd_GenesisState_denom_metadata protoreflect.FieldDescriptor
-	fd_GenesisState_send_enabled   protoreflect.FieldDescriptor
+	md_DemurrageAccountEpoch         protoreflect.MessageDescriptor
+	fd_DemurrageAccountEpoch_address protoreflect.FieldDescriptor
+	fd_DemurrageAccountEpoch_denom   protoreflect.FieldDescriptor
+	fd_DemurrageAccountEpoch_epoch   protoreflect.FieldDescriptor
 )
 
 func init() {
 	file_cosmos_bank_v1beta1_genesis_proto_init()
-	md_GenesisState = File_cosmos_bank_v1beta1_genesis_proto.Messages().ByName("GenesisState")
-	fd_GenesisState_params = md_GenesisState.Fields().ByName("params")
-	fd_GenesisState_balances = md_GenesisState.Fields().ByName("balances")
-	fd_GenesisState_supply = md_GenesisState.Fields().ByName("supply")
-	fd_GenesisState_denom_metadata = md_GenesisState.Fields().ByName("denom_metadata")
-	fd_GenesisState_send_enabled = md_GenesisState.Fields().ByName("send_enabled")
+	md_DemurrageAccountEpoch = File_cosmos_bank_v1beta1_genesis_proto.Messages().ByName("DemurrageAccountEpoch")
+	fd_DemurrageAccountEpoch_address = md_DemurrageAccountEpoch.Fields().ByName("address")
+	fd_DemurrageAccountEpoch_denom = md_DemurrageAccountEpoch.Fields().ByName("denom")
+	fd_DemurrageAccountEpoch_epoch = md_DemurrageAccountEpoch.Fields().ByName("epoch")
 }
 
-var _ protoreflect.Message = (*fastReflection_GenesisState)(nil)
+var _ protoreflect.Message = (*fastReflection_DemurrageAccountEpoch)(nil)
 
-type fastReflection_GenesisState GenesisState
+type fastReflection_DemurrageAccountEpoch DemurrageAccountEpoch
 
-func (x *GenesisState) ProtoReflect() protoreflect.Message {
-	return (*fastReflection_GenesisState)(x)
+func (x *DemurrageAccountEpoch) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_DemurrageAccountEpoch)(x)
 }
 
-func (x *GenesisState) slowProtoReflect() protoreflect.Message {
-	mi := &file_cosmos_bank_v1beta1_genesis_proto_msgTypes[0]
+func (x *DemurrageAccountEpoch) slowProtoReflect() protoreflect.Message {
+	mi := &file_cosmos_bank_v1beta1_genesis_proto_msgTypes[2]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -259,43 +2701,43 @@ func (x *GenesisState) slowProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-var _fastReflection_GenesisState_messageType fastReflection_GenesisState_messageType
-var _ protoreflect.MessageType = fastReflection_GenesisState_messageType{}
+var _fastReflection_DemurrageAccountEpoch_messageType fastReflection_DemurrageAccountEpoch_messageType
+var _ protoreflect.MessageType = fastReflection_DemurrageAccountEpoch_messageType{}
 
-type fastReflection_GenesisState_messageType struct{}
+type fastReflection_DemurrageAccountEpoch_messageType struct{}
 
-func (x fastReflection_GenesisState_messageType) Zero() protoreflect.Message {
-	return (*fastReflection_GenesisState)(nil)
+func (x fastReflection_DemurrageAccountEpoch_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_DemurrageAccountEpoch)(nil)
 }
-func (x fastReflection_GenesisState_messageType) New() protoreflect.Message {
-	return new(fastReflection_GenesisState)
+func (x fastReflection_DemurrageAccountEpoch_messageType) New() protoreflect.Message {
+	return new(fastReflection_DemurrageAccountEpoch)
 }
-func (x fastReflection_GenesisState_messageType) Descriptor() protoreflect.MessageDescriptor {
-	return md_GenesisState
+func (x fastReflection_DemurrageAccountEpoch_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_DemurrageAccountEpoch
 }
 
 // Descriptor returns message descriptor, which contains only the protobuf
 // type information for the message.
-func (x *fastReflection_GenesisState) Descriptor() protoreflect.MessageDescriptor {
-	return md_GenesisState
+func (x *fastReflection_DemurrageAccountEpoch) Descriptor() protoreflect.MessageDescriptor {
+	return md_DemurrageAccountEpoch
 }
 
 // Type returns the message type, which encapsulates both Go and protobuf
 // type information. If the Go type information is not needed,
 // it is recommended that the message descriptor be used instead.
-func (x *fastReflection_GenesisState) Type() protoreflect.MessageType {
-	return _fastReflection_GenesisState_messageType
+func (x *fastReflection_DemurrageAccountEpoch) Type() protoreflect.MessageType {
+	return _fastReflection_DemurrageAccountEpoch_messageType
 }
 
 // New returns a newly allocated and mutable empty message.
-func (x *fastReflection_GenesisState) New() protoreflect.Message {
-	return new(fastReflection_GenesisState)
+func (x *fastReflection_DemurrageAccountEpoch) New() protoreflect.Message {
+	return new(fastReflection_DemurrageAccountEpoch)
 }
 
 // Interface unwraps the message reflection interface and
 // returns the underlying ProtoMessage interface.
-func (x *fastReflection_GenesisState) Interface() protoreflect.ProtoMessage {
-	return (*GenesisState)(x)
+func (x *fastReflection_DemurrageAccountEpoch) Interface() protoreflect.ProtoMessage {
+	return (*DemurrageAccountEpoch)(x)
 }
 
 // Range iterates over every populated field in an undefined order,
@@ -303,34 +2745,22 @@ func (x *fastReflection_GenesisState) Interface() protoreflect.ProtoMessage {
 // Range returns immediately if f returns false.
 // While iterating, mutating operations may only be performed
 // on the current field descriptor.
-func (x *fastReflection_GenesisState) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
-	if x.Params != nil {
-		value := protoreflect.ValueOfMessage(x.Params.ProtoReflect())
-		if !f(fd_GenesisState_params, value) {
-			return
-		}
-	}
-	if len(x.Balances) != 0 {
-		value := protoreflect.ValueOfList(&_GenesisState_2_list{list: &x.Balances})
-		if !f(fd_GenesisState_balances, value) {
-			return
-		}
-	}
-	if len(x.Supply) != 0 {
-		value := protoreflect.ValueOfList(&_GenesisState_3_list{list: &x.Supply})
-		if !f(fd_GenesisState_supply, value) {
+func (x *fastReflection_DemurrageAccountEpoch) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Address != "" {
+		value := protoreflect.ValueOfString(x.Address)
+		if !f(fd_DemurrageAccountEpoch_address, value) {
 			return
 		}
 	}
-	if len(x.DenomMetadata) != 0 {
-		value := protoreflect.ValueOfList(&_GenesisState_4_list{list: &x.DenomMetadata})
-		if !f(fd_GenesisState_denom_metadata, value) {
+	if x.Denom != "" {
+		value := protoreflect.ValueOfString(x.Denom)
+		if !f(fd_DemurrageAccountEpoch_denom, value) {
 			return
 		}
 	}
-	if len(x.SendEnabled) != 0 {
-		value := protoreflect.ValueOfList(&_GenesisState_5_list{list: &x.SendEnabled})
-		if !f(fd_GenesisState_send_enabled, value) {
+	if x.Epoch != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.Epoch)
+		if !f(fd_DemurrageAccountEpoch_epoch, value) {
 			return
 		}
 	}
@@ -347,23 +2777,19 @@ func (x *fastReflection_GenesisState) Range(f func(protoreflect.FieldDescriptor,
 // In other cases (aside from the nullable cases above),
 // a proto3 scalar field is populated if it contains a non-zero value, and
 // a repeated field is populated if it is non-empty.
-func (x *fastReflection_GenesisState) Has(fd protoreflect.FieldDescriptor) bool {
+func (x *fastReflection_DemurrageAccountEpoch) Has(fd protoreflect.FieldDescriptor) bool {
 	switch fd.FullName() {
-	case "cosmos.bank.v1beta1.GenesisState.params":
-		return x.Params != nil
-	case "cosmos.bank.v1beta1.GenesisState.balances":
-		return len(x.Balances) != 0
-	case "cosmos.bank.v1beta1.GenesisState.supply":
-		return len(x.Supply) != 0
-	case "cosmos.bank.v1beta1.GenesisState.denom_metadata":
-		return len(x.DenomMetadata) != 0
-	case "cosmos.bank.v1beta1.GenesisState.send_enabled":
-		return len(x.SendEnabled) != 0
+	case "cosmos.bank.v1beta1.DemurrageAccountEpoch.address":
+		return x.Address != ""
+	case "cosmos.bank.v1beta1.DemurrageAccountEpoch.denom":
+		return x.Denom != ""
+	case "cosmos.bank.v1beta1.DemurrageAccountEpoch.epoch":
+		return x.Epoch != uint64(0)
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.bank.v1beta1.GenesisState"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.bank.v1beta1.DemurrageAccountEpoch"))
 		}
-		panic(fmt.Errorf("message cosmos.bank.v1beta1.GenesisState does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.bank.v1beta1.DemurrageAccountEpoch does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -373,23 +2799,19 @@ func (x *fastReflection_GenesisState) Has(fd protoreflect.FieldDescriptor) bool
 // associated with the given field number.
 //
 // Clear is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_GenesisState) Clear(fd protoreflect.FieldDescriptor) {
+func (x *fastReflection_DemurrageAccountEpoch) Clear(fd protoreflect.FieldDescriptor) {
 	switch fd.FullName() {
-	case "cosmos.bank.v1beta1.GenesisState.params":
-		x.Params = nil
-	case "cosmos.bank.v1beta1.GenesisState.balances":
-		x.Balances = nil
-	case "cosmos.bank.v1beta1.GenesisState.supply":
-		x.Supply = nil
-	case "cosmos.bank.v1beta1.GenesisState.denom_metadata":
-		x.DenomMetadata = nil
-	case "cosmos.bank.v1beta1.GenesisState.send_enabled":
-		x.SendEnabled = nil
+	case "cosmos.bank.v1beta1.DemurrageAccountEpoch.address":
+		x.Address = ""
+	case "cosmos.bank.v1beta1.DemurrageAccountEpoch.denom":
+		x.Denom = ""
+	case "cosmos.bank.v1beta1.DemurrageAccountEpoch.epoch":
+		x.Epoch = uint64(0)
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.bank.v1beta1.GenesisState"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.bank.v1beta1.DemurrageAccountEpoch"))
 		}
-		panic(fmt.Errorf("message cosmos.bank.v1beta1.GenesisState does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.bank.v1beta1.DemurrageAccountEpoch does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -399,40 +2821,22 @@ func (x *fastReflection_GenesisState) Clear(fd protoreflect.FieldDescriptor) {
 // the default value of a bytes scalar is guaranteed to be a copy.
 // For unpopulated composite types, it returns an empty, read-only view
 // of the value; to obtain a mutable reference, use Mutable.
-func (x *fastReflection_GenesisState) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_DemurrageAccountEpoch) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
 	switch descriptor.FullName() {
-	case "cosmos.bank.v1beta1.GenesisState.params":
-		value := x.Params
-		return protoreflect.ValueOfMessage(value.ProtoReflect())
-	case "cosmos.bank.v1beta1.GenesisState.balances":
-		if len(x.Balances) == 0 {
-			return protoreflect.ValueOfList(&_GenesisState_2_list{})
-		}
-		listValue := &_GenesisState_2_list{list: &x.Balances}
-		return protoreflect.ValueOfList(listValue)
-	case "cosmos.bank.v1beta1.GenesisState.supply":
-		if len(x.Supply) == 0 {
-			return protoreflect.ValueOfList(&_GenesisState_3_list{})
-		}
-		listValue := &_GenesisState_3_list{list: &x.Supply}
-		return protoreflect.ValueOfList(listValue)
-	case "cosmos.bank.v1beta1.GenesisState.denom_metadata":
-		if len(x.DenomMetadata) == 0 {
-			return protoreflect.ValueOfList(&_GenesisState_4_list{})
-		}
-		listValue := &_GenesisState_4_list{list: &x.DenomMetadata}
-		return protoreflect.ValueOfList(listValue)
-	case "cosmos.bank.v1beta1.GenesisState.send_enabled":
-		if len(x.SendEnabled) == 0 {
-			return protoreflect.ValueOfList(&_GenesisState_5_list{})
-		}
-		listValue := &_GenesisState_5_list{list: &x.SendEnabled}
-		return protoreflect.ValueOfList(listValue)
+	case "cosmos.bank.v1beta1.DemurrageAccountEpoch.address":
+		value := x.Address
+		return protoreflect.ValueOfString(value)
+	case "cosmos.bank.v1beta1.DemurrageAccountEpoch.denom":
+		value := x.Denom
+		return protoreflect.ValueOfString(value)
+	case "cosmos.bank.v1beta1.DemurrageAccountEpoch.epoch":
+		value := x.Epoch
+		return protoreflect.ValueOfUint64(value)
 	default:
 		if descriptor.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.bank.v1beta1.GenesisState"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.bank.v1beta1.DemurrageAccountEpoch"))
 		}
-		panic(fmt.Errorf("message cosmos.bank.v1beta1.GenesisState does not contain field %s", descriptor.FullName()))
+		panic(fmt.Errorf("message cosmos.bank.v1beta1.DemurrageAccountEpoch does not contain field %s", descriptor.FullName()))
 	}
 }
 
@@ -446,118 +2850,74 @@ func (x *fastReflection_GenesisState) Get(descriptor protoreflect.FieldDescripto
 // empty, read-only value, then it panics.
 //
 // Set is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_GenesisState) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+func (x *fastReflection_DemurrageAccountEpoch) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
 	switch fd.FullName() {
-	case "cosmos.bank.v1beta1.GenesisState.params":
-		x.Params = value.Message().Interface().(*Params)
-	case "cosmos.bank.v1beta1.GenesisState.balances":
-		lv := value.List()
-		clv := lv.(*_GenesisState_2_list)
-		x.Balances = *clv.list
-	case "cosmos.bank.v1beta1.GenesisState.supply":
-		lv := value.List()
-		clv := lv.(*_GenesisState_3_list)
-		x.Supply = *clv.list
-	case "cosmos.bank.v1beta1.GenesisState.denom_metadata":
-		lv := value.List()
-		clv := lv.(*_GenesisState_4_list)
-		x.DenomMetadata = *clv.list
-	case "cosmos.bank.v1beta1.GenesisState.send_enabled":
-		lv := value.List()
-		clv := lv.(*_GenesisState_5_list)
-		x.SendEnabled = *clv.list
+	case "cosmos.bank.v1beta1.DemurrageAccountEpoch.address":
+		x.Address = value.Interface().(string)
+	case "cosmos.bank.v1beta1.DemurrageAccountEpoch.denom":
+		x.Denom = value.Interface().(string)
+	case "cosmos.bank.v1beta1.DemurrageAccountEpoch.epoch":
+		x.Epoch = value.Uint()
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.bank.v1beta1.GenesisState"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.bank.v1beta1.DemurrageAccountEpoch"))
 		}
-		panic(fmt.Errorf("message cosmos.bank.v1beta1.GenesisState does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.bank.v1beta1.DemurrageAccountEpoch does not contain field %s", fd.FullName()))
 	}
 }
 
 // Mutable returns a mutable reference to a composite type.
 //
-// If the field is unpopulated, it may allocate a composite value.
-// For a field belonging to a oneof, it implicitly clears any other field
-// that may be currently set within the same oneof.
-// For extension fields, it implicitly stores the provided ExtensionType
-// if not already stored.
-// It panics if the field does not contain a composite type.
-//
-// Mutable is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_GenesisState) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
-	switch fd.FullName() {
-	case "cosmos.bank.v1beta1.GenesisState.params":
-		if x.Params == nil {
-			x.Params = new(Params)
-		}
-		return protoreflect.ValueOfMessage(x.Params.ProtoReflect())
-	case "cosmos.bank.v1beta1.GenesisState.balances":
-		if x.Balances == nil {
-			x.Balances = []*Balance{}
-		}
-		value := &_GenesisState_2_list{list: &x.Balances}
-		return protoreflect.ValueOfList(value)
-	case "cosmos.bank.v1beta1.GenesisState.supply":
-		if x.Supply == nil {
-			x.Supply = []*v1beta1.Coin{}
-		}
-		value := &_GenesisState_3_list{list: &x.Supply}
-		return protoreflect.ValueOfList(value)
-	case "cosmos.bank.v1beta1.GenesisState.denom_metadata":
-		if x.DenomMetadata == nil {
-			x.DenomMetadata = []*Metadata{}
-		}
-		value := &_GenesisState_4_list{list: &x.DenomMetadata}
-		return protoreflect.ValueOfList(value)
-	case "cosmos.bank.v1beta1.GenesisState.send_enabled":
-		if x.SendEnabled == nil {
-			x.SendEnabled = []*SendEnabled{}
-		}
-		value := &_GenesisState_5_list{list: &x.SendEnabled}
-		return protoreflect.ValueOfList(value)
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_DemurrageAccountEpoch) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "cosmos.bank.v1beta1.DemurrageAccountEpoch.address":
+		panic(fmt.Errorf("field address of message cosmos.bank.v1beta1.DemurrageAccountEpoch is not mutable"))
+	case "cosmos.bank.v1beta1.DemurrageAccountEpoch.denom":
+		panic(fmt.Errorf("field denom of message cosmos.bank.v1beta1.DemurrageAccountEpoch is not mutable"))
+	case "cosmos.bank.v1beta1.DemurrageAccountEpoch.epoch":
+		panic(fmt.Errorf("field epoch of message cosmos.bank.v1beta1.DemurrageAccountEpoch is not mutable"))
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.bank.v1beta1.GenesisState"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.bank.v1beta1.DemurrageAccountEpoch"))
 		}
-		panic(fmt.Errorf("message cosmos.bank.v1beta1.GenesisState does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.bank.v1beta1.DemurrageAccountEpoch does not contain field %s", fd.FullName()))
 	}
 }
 
 // NewField returns a new value that is assignable to the field
 // for the given descriptor. For scalars, this returns the default value.
 // For lists, maps, and messages, this returns a new, empty, mutable value.
-func (x *fastReflection_GenesisState) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_DemurrageAccountEpoch) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "cosmos.bank.v1beta1.GenesisState.params":
-		m := new(Params)
-		return protoreflect.ValueOfMessage(m.ProtoReflect())
-	case "cosmos.bank.v1beta1.GenesisState.balances":
-		list := []*Balance{}
-		return protoreflect.ValueOfList(&_GenesisState_2_list{list: &list})
-	case "cosmos.bank.v1beta1.GenesisState.supply":
-		list := []*v1beta1.Coin{}
-		return protoreflect.ValueOfList(&_GenesisState_3_list{list: &list})
-	case "cosmos.bank.v1beta1.GenesisState.denom_metadata":
-		list := []*Metadata{}
-		return protoreflect.ValueOfList(&_GenesisState_4_list{list: &list})
-	case "cosmos.bank.v1beta1.GenesisState.send_enabled":
-		list := []*SendEnabled{}
-		return protoreflect.ValueOfList(&_GenesisState_5_list{list: &list})
+	case "cosmos.bank.v1beta1.DemurrageAccountEpoch.address":
+		return protoreflect.ValueOfString("")
+	case "cosmos.bank.v1beta1.DemurrageAccountEpoch.denom":
+		return protoreflect.ValueOfString("")
+	case "cosmos.bank.v1beta1.DemurrageAccountEpoch.epoch":
+		return protoreflect.ValueOfUint64(uint64(0))
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.bank.v1beta1.GenesisState"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.bank.v1beta1.DemurrageAccountEpoch"))
 		}
-		panic(fmt.Errorf("message cosmos.bank.v1beta1.GenesisState does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.bank.v1beta1.DemurrageAccountEpoch does not contain field %s", fd.FullName()))
 	}
 }
 
 // WhichOneof reports which field within the oneof is populated,
 // returning nil if none are populated.
 // It panics if the oneof descriptor does not belong to this message.
-func (x *fastReflection_GenesisState) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+func (x *fastReflection_DemurrageAccountEpoch) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
 	switch d.FullName() {
 	default:
-		panic(fmt.Errorf("%s is not a oneof field in cosmos.bank.v1beta1.GenesisState", d.FullName()))
+		panic(fmt.Errorf("%s is not a oneof field in cosmos.bank.v1beta1.DemurrageAccountEpoch", d.FullName()))
 	}
 	panic("unreachable")
 }
@@ -565,7 +2925,7 @@ func (x *fastReflection_GenesisState) WhichOneof(d protoreflect.OneofDescriptor)
 // GetUnknown retrieves the entire list of unknown fields.
 // The caller may only mutate the contents of the RawFields
 // if the mutated bytes are stored back into the message with SetUnknown.
-func (x *fastReflection_GenesisState) GetUnknown() protoreflect.RawFields {
+func (x *fastReflection_DemurrageAccountEpoch) GetUnknown() protoreflect.RawFields {
 	return x.unknownFields
 }
 
@@ -576,7 +2936,7 @@ func (x *fastReflection_GenesisState) GetUnknown() protoreflect.RawFields {
 // An empty RawFields may be passed to clear the fields.
 //
 // SetUnknown is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_GenesisState) SetUnknown(fields protoreflect.RawFields) {
+func (x *fastReflection_DemurrageAccountEpoch) SetUnknown(fields protoreflect.RawFields) {
 	x.unknownFields = fields
 }
 
@@ -588,7 +2948,7 @@ func (x *fastReflection_GenesisState) SetUnknown(fields protoreflect.RawFields)
 // message type, but the details are implementation dependent.
 // Validity is not part of the protobuf data model, and may not
 // be preserved in marshaling or other operations.
-func (x *fastReflection_GenesisState) IsValid() bool {
+func (x *fastReflection_DemurrageAccountEpoch) IsValid() bool {
 	return x != nil
 }
 
@@ -598,9 +2958,9 @@ func (x *fastReflection_GenesisState) IsValid() bool {
 // The returned methods type is identical to
 // "google.golang.org/protobuf/runtime/protoiface".Methods.
 // Consult the protoiface package documentation for details.
-func (x *fastReflection_GenesisState) ProtoMethods() *protoiface.Methods {
+func (x *fastReflection_DemurrageAccountEpoch) ProtoMethods() *protoiface.Methods {
 	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
-		x := input.Message.Interface().(*GenesisState)
+		x := input.Message.Interface().(*DemurrageAccountEpoch)
 		if x == nil {
 			return protoiface.SizeOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -612,33 +2972,16 @@ func (x *fastReflection_GenesisState) ProtoMethods() *protoiface.Methods {
 		var n int
 		var l int
 		_ = l
-		if x.Params != nil {
-			l = options.Size(x.Params)
+		l = len(x.Address)
+		if l > 0 {
 			n += 1 + l + runtime.Sov(uint64(l))
 		}
-		if len(x.Balances) > 0 {
-			for _, e := range x.Balances {
-				l = options.Size(e)
-				n += 1 + l + runtime.Sov(uint64(l))
-			}
-		}
-		if len(x.Supply) > 0 {
-			for _, e := range x.Supply {
-				l = options.Size(e)
-				n += 1 + l + runtime.Sov(uint64(l))
-			}
-		}
-		if len(x.DenomMetadata) > 0 {
-			for _, e := range x.DenomMetadata {
-				l = options.Size(e)
-				n += 1 + l + runtime.Sov(uint64(l))
-			}
+		l = len(x.Denom)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
 		}
-		if len(x.SendEnabled) > 0 {
-			for _, e := range x.SendEnabled {
-				l = options.Size(e)
-				n += 1 + l + runtime.Sov(uint64(l))
-			}
+		if x.Epoch != 0 {
+			n += 1 + runtime.Sov(uint64(x.Epoch))
 		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
@@ -650,7 +2993,7 @@ func (x *fastReflection_GenesisState) ProtoMethods() *protoiface.Methods {
 	}
 
 	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
-		x := input.Message.Interface().(*GenesisState)
+		x := input.Message.Interface().(*DemurrageAccountEpoch)
 		if x == nil {
 			return protoiface.MarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -668,209 +3011,80 @@ func (x *fastReflection_GenesisState) ProtoMethods() *protoiface.Methods {
 		if x.unknownFields != nil {
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
-		}
-		if len(x.SendEnabled) > 0 {
-			for iNdEx := len(x.SendEnabled) - 1; iNdEx >= 0; iNdEx-- {
-				encoded, err := options.Marshal(x.SendEnabled[iNdEx])
-				if err != nil {
-					return protoiface.MarshalOutput{
-						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
-						Buf:               input.Buf,
-					}, err
-				}
-				i -= len(encoded)
-				copy(dAtA[i:], encoded)
-				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
-				i--
-				dAtA[i] = 0x2a
-			}
-		}
-		if len(x.DenomMetadata) > 0 {
-			for iNdEx := len(x.DenomMetadata) - 1; iNdEx >= 0; iNdEx-- {
-				encoded, err := options.Marshal(x.DenomMetadata[iNdEx])
-				if err != nil {
-					return protoiface.MarshalOutput{
-						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
-						Buf:               input.Buf,
-					}, err
-				}
-				i -= len(encoded)
-				copy(dAtA[i:], encoded)
-				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
-				i--
-				dAtA[i] = 0x22
-			}
-		}
-		if len(x.Supply) > 0 {
-			for iNdEx := len(x.Supply) - 1; iNdEx >= 0; iNdEx-- {
-				encoded, err := options.Marshal(x.Supply[iNdEx])
-				if err != nil {
-					return protoiface.MarshalOutput{
-						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
-						Buf:               input.Buf,
-					}, err
-				}
-				i -= len(encoded)
-				copy(dAtA[i:], encoded)
-				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
-				i--
-				dAtA[i] = 0x1a
-			}
-		}
-		if len(x.Balances) > 0 {
-			for iNdEx := len(x.Balances) - 1; iNdEx >= 0; iNdEx-- {
-				encoded, err := options.Marshal(x.Balances[iNdEx])
-				if err != nil {
-					return protoiface.MarshalOutput{
-						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
-						Buf:               input.Buf,
-					}, err
-				}
-				i -= len(encoded)
-				copy(dAtA[i:], encoded)
-				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
-				i--
-				dAtA[i] = 0x12
-			}
-		}
-		if x.Params != nil {
-			encoded, err := options.Marshal(x.Params)
-			if err != nil {
-				return protoiface.MarshalOutput{
-					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
-					Buf:               input.Buf,
-				}, err
-			}
-			i -= len(encoded)
-			copy(dAtA[i:], encoded)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
-			i--
-			dAtA[i] = 0xa
-		}
-		if input.Buf != nil {
-			input.Buf = append(input.Buf, dAtA...)
-		} else {
-			input.Buf = dAtA
-		}
-		return protoiface.MarshalOutput{
-			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
-			Buf:               input.Buf,
-		}, nil
-	}
-	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
-		x := input.Message.Interface().(*GenesisState)
-		if x == nil {
-			return protoiface.UnmarshalOutput{
-				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
-				Flags:             input.Flags,
-			}, nil
-		}
-		options := runtime.UnmarshalInputToOptions(input)
-		_ = options
-		dAtA := input.Buf
-		l := len(dAtA)
-		iNdEx := 0
-		for iNdEx < l {
-			preIndex := iNdEx
-			var wire uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
-				}
-				if iNdEx >= l {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				wire |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			fieldNum := int32(wire >> 3)
-			wireType := int(wire & 0x7)
-			if wireType == 4 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: GenesisState: wiretype end group for non-group")
-			}
-			if fieldNum <= 0 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: GenesisState: illegal tag %d (wire type %d)", fieldNum, wire)
-			}
-			switch fieldNum {
-			case 1:
-				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
-				}
-				var msglen int
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
-					}
-					if iNdEx >= l {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					msglen |= int(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				if msglen < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				postIndex := iNdEx + msglen
-				if postIndex < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				if postIndex > l {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-				}
-				if x.Params == nil {
-					x.Params = &Params{}
-				}
-				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Params); err != nil {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
-				}
-				iNdEx = postIndex
-			case 2:
-				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Balances", wireType)
-				}
-				var msglen int
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
-					}
-					if iNdEx >= l {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					msglen |= int(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				if msglen < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				postIndex := iNdEx + msglen
-				if postIndex < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+		}
+		if x.Epoch != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.Epoch))
+			i--
+			dAtA[i] = 0x18
+		}
+		if len(x.Denom) > 0 {
+			i -= len(x.Denom)
+			copy(dAtA[i:], x.Denom)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Denom)))
+			i--
+			dAtA[i] = 0x12
+		}
+		if len(x.Address) > 0 {
+			i -= len(x.Address)
+			copy(dAtA[i:], x.Address)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Address)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*DemurrageAccountEpoch)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
 				}
-				if postIndex > l {
+				if iNdEx >= l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				x.Balances = append(x.Balances, &Balance{})
-				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Balances[len(x.Balances)-1]); err != nil {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
 				}
-				iNdEx = postIndex
-			case 3:
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: DemurrageAccountEpoch: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: DemurrageAccountEpoch: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Supply", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
 				}
-				var msglen int
+				var stringLen uint64
 				for shift := uint(0); ; shift += 7 {
 					if shift >= 64 {
 						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
@@ -880,31 +3094,29 @@ func (x *fastReflection_GenesisState) ProtoMethods() *protoiface.Methods {
 					}
 					b := dAtA[iNdEx]
 					iNdEx++
-					msglen |= int(b&0x7F) << shift
+					stringLen |= uint64(b&0x7F) << shift
 					if b < 0x80 {
 						break
 					}
 				}
-				if msglen < 0 {
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 				}
-				postIndex := iNdEx + msglen
+				postIndex := iNdEx + intStringLen
 				if postIndex < 0 {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 				}
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				x.Supply = append(x.Supply, &v1beta1.Coin{})
-				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Supply[len(x.Supply)-1]); err != nil {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
-				}
+				x.Address = string(dAtA[iNdEx:postIndex])
 				iNdEx = postIndex
-			case 4:
+			case 2:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field DenomMetadata", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
 				}
-				var msglen int
+				var stringLen uint64
 				for shift := uint(0); ; shift += 7 {
 					if shift >= 64 {
 						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
@@ -914,31 +3126,29 @@ func (x *fastReflection_GenesisState) ProtoMethods() *protoiface.Methods {
 					}
 					b := dAtA[iNdEx]
 					iNdEx++
-					msglen |= int(b&0x7F) << shift
+					stringLen |= uint64(b&0x7F) << shift
 					if b < 0x80 {
 						break
 					}
 				}
-				if msglen < 0 {
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 				}
-				postIndex := iNdEx + msglen
+				postIndex := iNdEx + intStringLen
 				if postIndex < 0 {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 				}
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				x.DenomMetadata = append(x.DenomMetadata, &Metadata{})
-				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.DenomMetadata[len(x.DenomMetadata)-1]); err != nil {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
-				}
+				x.Denom = string(dAtA[iNdEx:postIndex])
 				iNdEx = postIndex
-			case 5:
-				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field SendEnabled", wireType)
+			case 3:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Epoch", wireType)
 				}
-				var msglen int
+				x.Epoch = 0
 				for shift := uint(0); ; shift += 7 {
 					if shift >= 64 {
 						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
@@ -948,26 +3158,11 @@ func (x *fastReflection_GenesisState) ProtoMethods() *protoiface.Methods {
 					}
 					b := dAtA[iNdEx]
 					iNdEx++
-					msglen |= int(b&0x7F) << shift
+					x.Epoch |= uint64(b&0x7F) << shift
 					if b < 0x80 {
 						break
 					}
 				}
-				if msglen < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				postIndex := iNdEx + msglen
-				if postIndex < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				if postIndex > l {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-				}
-				x.SendEnabled = append(x.SendEnabled, &SendEnabled{})
-				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.SendEnabled[len(x.SendEnabled)-1]); err != nil {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
-				}
-				iNdEx = postIndex
 			default:
 				iNdEx = preIndex
 				skippy, err := runtime.Skip(dAtA[iNdEx:])
@@ -1076,7 +3271,7 @@ func (x *Balance) ProtoReflect() protoreflect.Message {
 }
 
 func (x *Balance) slowProtoReflect() protoreflect.Message {
-	mi := &file_cosmos_bank_v1beta1_genesis_proto_msgTypes[1]
+	mi := &file_cosmos_bank_v1beta1_genesis_proto_msgTypes[3]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1593,6 +3788,43 @@ type GenesisState struct {
 	//
 	// Since: cosmos-sdk 0.47
 	SendEnabled []*SendEnabled `protobuf:"bytes,5,rep,name=send_enabled,json=sendEnabled,proto3" json:"send_enabled,omitempty"`
+	// holds defines the portions of the balances held by holders.
+	//
+	// Since: cosmos-sdk 0.48
+	Holds []*Hold `protobuf:"bytes,6,rep,name=holds,proto3" json:"holds,omitempty"`
+	// balance_snapshots defines the balance snapshots, along with their recorded
+	// balances.
+	//
+	// Since: cosmos-sdk 0.48
+	BalanceSnapshots []*GenesisBalanceSnapshot `protobuf:"bytes,7,rep,name=balance_snapshots,json=balanceSnapshots,proto3" json:"balance_snapshots,omitempty"`
+	// mint_burn_permissions defines the modules allowed to mint and burn the
+	// denominations.
+	//
+	// Since: cosmos-sdk 0.48
+	MintBurnPermissions []*MintBurnPermissions `protobuf:"bytes,8,rep,name=mint_burn_permissions,json=mintBurnPermissions,proto3" json:"mint_burn_permissions,omitempty"`
+	// dust_sweep_opt_ins defines the addresses of the accounts which opted in
+	// the sweeping of their dust balances.
+	//
+	// Since: cosmos-sdk 0.48
+	DustSweepOptIns []string `protobuf:"bytes,9,rep,name=dust_sweep_opt_ins,json=dustSweepOptIns,proto3" json:"dust_sweep_opt_ins,omitempty"`
+	// timelocked_transfers defines the time-locked transfers not claimed nor
+	// reclaimed yet.
+	//
+	// Since: cosmos-sdk 0.48
+	TimelockedTransfers []*TimelockedTransfer `protobuf:"bytes,10,rep,name=timelocked_transfers,json=timelockedTransfers,proto3" json:"timelocked_transfers,omitempty"`
+	// spending_limits defines the spending limits of the accounts.
+	//
+	// Since: cosmos-sdk 0.48
+	SpendingLimits []*SpendingLimit `protobuf:"bytes,11,rep,name=spending_limits,json=spendingLimits,proto3" json:"spending_limits,omitempty"`
+	// demurrage_schedules defines the demurrage schedules of the denominations.
+	//
+	// Since: cosmos-sdk 0.48
+	DemurrageSchedules []*DemurrageSchedule `protobuf:"bytes,12,rep,name=demurrage_schedules,json=demurrageSchedules,proto3" json:"demurrage_schedules,omitempty"`
+	// demurrage_account_epochs defines the epochs the decay of the balances of
+	// the demurrage denominations was last settled at.
+	//
+	// Since: cosmos-sdk 0.48
+	DemurrageAccountEpochs []*DemurrageAccountEpoch `protobuf:"bytes,13,rep,name=demurrage_account_epochs,json=demurrageAccountEpochs,proto3" json:"demurrage_account_epochs,omitempty"`
 }
 
 func (x *GenesisState) Reset() {
@@ -1650,6 +3882,170 @@ func (x *GenesisState) GetSendEnabled() []*SendEnabled {
 	return nil
 }
 
+func (x *GenesisState) GetHolds() []*Hold {
+	if x != nil {
+		return x.Holds
+	}
+	return nil
+}
+
+func (x *GenesisState) GetBalanceSnapshots() []*GenesisBalanceSnapshot {
+	if x != nil {
+		return x.BalanceSnapshots
+	}
+	return nil
+}
+
+func (x *GenesisState) GetMintBurnPermissions() []*MintBurnPermissions {
+	if x != nil {
+		return x.MintBurnPermissions
+	}
+	return nil
+}
+
+func (x *GenesisState) GetDustSweepOptIns() []string {
+	if x != nil {
+		return x.DustSweepOptIns
+	}
+	return nil
+}
+
+func (x *GenesisState) GetTimelockedTransfers() []*TimelockedTransfer {
+	if x != nil {
+		return x.TimelockedTransfers
+	}
+	return nil
+}
+
+func (x *GenesisState) GetSpendingLimits() []*SpendingLimit {
+	if x != nil {
+		return x.SpendingLimits
+	}
+	return nil
+}
+
+func (x *GenesisState) GetDemurrageSchedules() []*DemurrageSchedule {
+	if x != nil {
+		return x.DemurrageSchedules
+	}
+	return nil
+}
+
+func (x *GenesisState) GetDemurrageAccountEpochs() []*DemurrageAccountEpoch {
+	if x != nil {
+		return x.DemurrageAccountEpochs
+	}
+	return nil
+}
+
+// GenesisBalanceSnapshot defines a balance snapshot, along with its recorded
+// balances, in the bank module's genesis state.
+//
+// Since: cosmos-sdk 0.48
+type GenesisBalanceSnapshot struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// snapshot is the balance snapshot.
+	Snapshot *BalanceSnapshot `protobuf:"bytes,1,opt,name=snapshot,proto3" json:"snapshot,omitempty"`
+	// balances are the balances recorded in the snapshot, in address order.
+	Balances []*SnapshotBalance `protobuf:"bytes,2,rep,name=balances,proto3" json:"balances,omitempty"`
+}
+
+func (x *GenesisBalanceSnapshot) Reset() {
+	*x = GenesisBalanceSnapshot{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cosmos_bank_v1beta1_genesis_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GenesisBalanceSnapshot) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GenesisBalanceSnapshot) ProtoMessage() {}
+
+// Deprecated: Use GenesisBalanceSnapshot.ProtoReflect.Descriptor instead.
+func (*GenesisBalanceSnapshot) Descriptor() ([]byte, []int) {
+	return file_cosmos_bank_v1beta1_genesis_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *GenesisBalanceSnapshot) GetSnapshot() *BalanceSnapshot {
+	if x != nil {
+		return x.Snapshot
+	}
+	return nil
+}
+
+func (x *GenesisBalanceSnapshot) GetBalances() []*SnapshotBalance {
+	if x != nil {
+		return x.Balances
+	}
+	return nil
+}
+
+// DemurrageAccountEpoch defines the epoch of the demurrage schedule of a
+// denomination the decay of the balance of an account was last settled at, in
+// the bank module's genesis state.
+//
+// Since: cosmos-sdk 0.48
+type DemurrageAccountEpoch struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// address is the address of the account.
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	// denom is the denomination of the demurrage schedule.
+	Denom string `protobuf:"bytes,2,opt,name=denom,proto3" json:"denom,omitempty"`
+	// epoch is the epoch the decay of the balance was last settled at.
+	Epoch uint64 `protobuf:"varint,3,opt,name=epoch,proto3" json:"epoch,omitempty"`
+}
+
+func (x *DemurrageAccountEpoch) Reset() {
+	*x = DemurrageAccountEpoch{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cosmos_bank_v1beta1_genesis_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DemurrageAccountEpoch) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DemurrageAccountEpoch) ProtoMessage() {}
+
+// Deprecated: Use DemurrageAccountEpoch.ProtoReflect.Descriptor instead.
+func (*DemurrageAccountEpoch) Descriptor() ([]byte, []int) {
+	return file_cosmos_bank_v1beta1_genesis_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *DemurrageAccountEpoch) GetAddress() string {
+	if x != nil {
+		return x.Address
+	}
+	return ""
+}
+
+func (x *DemurrageAccountEpoch) GetDenom() string {
+	if x != nil {
+		return x.Denom
+	}
+	return ""
+}
+
+func (x *DemurrageAccountEpoch) GetEpoch() uint64 {
+	if x != nil {
+		return x.Epoch
+	}
+	return 0
+}
+
 // Balance defines an account address and balance pair used in the bank module's
 // genesis state.
 type Balance struct {
@@ -1666,7 +4062,7 @@ type Balance struct {
 func (x *Balance) Reset() {
 	*x = Balance{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_cosmos_bank_v1beta1_genesis_proto_msgTypes[1]
+		mi := &file_cosmos_bank_v1beta1_genesis_proto_msgTypes[3]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1680,7 +4076,7 @@ func (*Balance) ProtoMessage() {}
 
 // Deprecated: Use Balance.ProtoReflect.Descriptor instead.
 func (*Balance) Descriptor() ([]byte, []int) {
-	return file_cosmos_bank_v1beta1_genesis_proto_rawDescGZIP(), []int{1}
+	return file_cosmos_bank_v1beta1_genesis_proto_rawDescGZIP(), []int{3}
 }
 
 func (x *Balance) GetAddress() string {
@@ -1711,7 +4107,7 @@ var file_cosmos_bank_v1beta1_genesis_proto_rawDesc = []byte{
 	0x74, 0x61, 0x31, 0x2f, 0x62, 0x61, 0x6e, 0x6b, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x19,
 	0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x5f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x63, 0x6f, 0x73,
 	0x6d, 0x6f, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x11, 0x61, 0x6d, 0x69, 0x6e, 0x6f,
-	0x2f, 0x61, 0x6d, 0x69, 0x6e, 0x6f, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0xaf, 0x03, 0x0a,
+	0x2f, 0x61, 0x6d, 0x69, 0x6e, 0x6f, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x94, 0x09, 0x0a,
 	0x0c, 0x47, 0x65, 0x6e, 0x65, 0x73, 0x69, 0x73, 0x53, 0x74, 0x61, 0x74, 0x65, 0x12, 0x3e, 0x0a,
 	0x06, 0x70, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e,
 	0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x62, 0x61, 0x6e, 0x6b, 0x2e, 0x76, 0x31, 0x62, 0x65,
@@ -1738,33 +4134,98 @@ var file_cosmos_bank_v1beta1_genesis_proto_rawDesc = []byte{
 	0x20, 0x03, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x62, 0x61,
 	0x6e, 0x6b, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x53, 0x65, 0x6e, 0x64, 0x45,
 	0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x42, 0x09, 0xc8, 0xde, 0x1f, 0x00, 0xa8, 0xe7, 0xb0, 0x2a,
-	0x01, 0x52, 0x0b, 0x73, 0x65, 0x6e, 0x64, 0x45, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x22, 0xc0,
-	0x01, 0x0a, 0x07, 0x42, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x12, 0x32, 0x0a, 0x07, 0x61, 0x64,
-	0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x18, 0xd2, 0xb4, 0x2d,
-	0x14, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x53,
-	0x74, 0x72, 0x69, 0x6e, 0x67, 0x52, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x77,
-	0x0a, 0x05, 0x63, 0x6f, 0x69, 0x6e, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x19, 0x2e,
-	0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x76, 0x31, 0x62, 0x65,
-	0x74, 0x61, 0x31, 0x2e, 0x43, 0x6f, 0x69, 0x6e, 0x42, 0x46, 0xc8, 0xde, 0x1f, 0x00, 0xaa, 0xdf,
-	0x1f, 0x28, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x63, 0x6f, 0x73,
-	0x6d, 0x6f, 0x73, 0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2d, 0x73, 0x64, 0x6b, 0x2f, 0x74,
-	0x79, 0x70, 0x65, 0x73, 0x2e, 0x43, 0x6f, 0x69, 0x6e, 0x73, 0x9a, 0xe7, 0xb0, 0x2a, 0x0c, 0x6c,
-	0x65, 0x67, 0x61, 0x63, 0x79, 0x5f, 0x63, 0x6f, 0x69, 0x6e, 0x73, 0xa8, 0xe7, 0xb0, 0x2a, 0x01,
-	0x52, 0x05, 0x63, 0x6f, 0x69, 0x6e, 0x73, 0x3a, 0x08, 0x88, 0xa0, 0x1f, 0x00, 0xe8, 0xa0, 0x1f,
-	0x00, 0x42, 0xc7, 0x01, 0x0a, 0x17, 0x63, 0x6f, 0x6d, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73,
-	0x2e, 0x62, 0x61, 0x6e, 0x6b, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x42, 0x0c, 0x47,
-	0x65, 0x6e, 0x65, 0x73, 0x69, 0x73, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50, 0x01, 0x5a, 0x30, 0x63,
-	0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f, 0x2f, 0x61, 0x70, 0x69, 0x2f,
-	0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2f, 0x62, 0x61, 0x6e, 0x6b, 0x2f, 0x76, 0x31, 0x62, 0x65,
-	0x74, 0x61, 0x31, 0x3b, 0x62, 0x61, 0x6e, 0x6b, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0xa2,
-	0x02, 0x03, 0x43, 0x42, 0x58, 0xaa, 0x02, 0x13, 0x43, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x42,
-	0x61, 0x6e, 0x6b, 0x2e, 0x56, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0xca, 0x02, 0x13, 0x43, 0x6f,
-	0x73, 0x6d, 0x6f, 0x73, 0x5c, 0x42, 0x61, 0x6e, 0x6b, 0x5c, 0x56, 0x31, 0x62, 0x65, 0x74, 0x61,
-	0x31, 0xe2, 0x02, 0x1f, 0x43, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x5c, 0x42, 0x61, 0x6e, 0x6b, 0x5c,
-	0x56, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x5c, 0x47, 0x50, 0x42, 0x4d, 0x65, 0x74, 0x61, 0x64,
-	0x61, 0x74, 0x61, 0xea, 0x02, 0x15, 0x43, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x3a, 0x3a, 0x42, 0x61,
-	0x6e, 0x6b, 0x3a, 0x3a, 0x56, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x62, 0x06, 0x70, 0x72, 0x6f,
-	0x74, 0x6f, 0x33,
+	0x01, 0x52, 0x0b, 0x73, 0x65, 0x6e, 0x64, 0x45, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x12, 0x3a,
+	0x0a, 0x05, 0x68, 0x6f, 0x6c, 0x64, 0x73, 0x18, 0x06, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x19, 0x2e,
+	0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x62, 0x61, 0x6e, 0x6b, 0x2e, 0x76, 0x31, 0x62, 0x65,
+	0x74, 0x61, 0x31, 0x2e, 0x48, 0x6f, 0x6c, 0x64, 0x42, 0x09, 0xc8, 0xde, 0x1f, 0x00, 0xa8, 0xe7,
+	0xb0, 0x2a, 0x01, 0x52, 0x05, 0x68, 0x6f, 0x6c, 0x64, 0x73, 0x12, 0x63, 0x0a, 0x11, 0x62, 0x61,
+	0x6c, 0x61, 0x6e, 0x63, 0x65, 0x5f, 0x73, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x73, 0x18,
+	0x07, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2b, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x62,
+	0x61, 0x6e, 0x6b, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x47, 0x65, 0x6e, 0x65,
+	0x73, 0x69, 0x73, 0x42, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x53, 0x6e, 0x61, 0x70, 0x73, 0x68,
+	0x6f, 0x74, 0x42, 0x09, 0xc8, 0xde, 0x1f, 0x00, 0xa8, 0xe7, 0xb0, 0x2a, 0x01, 0x52, 0x10, 0x62,
+	0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x53, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x73, 0x12,
+	0x67, 0x0a, 0x15, 0x6d, 0x69, 0x6e, 0x74, 0x5f, 0x62, 0x75, 0x72, 0x6e, 0x5f, 0x70, 0x65, 0x72,
+	0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x08, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x28,
+	0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x62, 0x61, 0x6e, 0x6b, 0x2e, 0x76, 0x31, 0x62,
+	0x65, 0x74, 0x61, 0x31, 0x2e, 0x4d, 0x69, 0x6e, 0x74, 0x42, 0x75, 0x72, 0x6e, 0x50, 0x65, 0x72,
+	0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x42, 0x09, 0xc8, 0xde, 0x1f, 0x00, 0xa8, 0xe7,
+	0xb0, 0x2a, 0x01, 0x52, 0x13, 0x6d, 0x69, 0x6e, 0x74, 0x42, 0x75, 0x72, 0x6e, 0x50, 0x65, 0x72,
+	0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x45, 0x0a, 0x12, 0x64, 0x75, 0x73, 0x74,
+	0x5f, 0x73, 0x77, 0x65, 0x65, 0x70, 0x5f, 0x6f, 0x70, 0x74, 0x5f, 0x69, 0x6e, 0x73, 0x18, 0x09,
+	0x20, 0x03, 0x28, 0x09, 0x42, 0x18, 0xd2, 0xb4, 0x2d, 0x14, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73,
+	0x2e, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x52, 0x0f,
+	0x64, 0x75, 0x73, 0x74, 0x53, 0x77, 0x65, 0x65, 0x70, 0x4f, 0x70, 0x74, 0x49, 0x6e, 0x73, 0x12,
+	0x65, 0x0a, 0x14, 0x74, 0x69, 0x6d, 0x65, 0x6c, 0x6f, 0x63, 0x6b, 0x65, 0x64, 0x5f, 0x74, 0x72,
+	0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x73, 0x18, 0x0a, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x27, 0x2e,
+	0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x62, 0x61, 0x6e, 0x6b, 0x2e, 0x76, 0x31, 0x62, 0x65,
+	0x74, 0x61, 0x31, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x6c, 0x6f, 0x63, 0x6b, 0x65, 0x64, 0x54, 0x72,
+	0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x42, 0x09, 0xc8, 0xde, 0x1f, 0x00, 0xa8, 0xe7, 0xb0, 0x2a,
+	0x01, 0x52, 0x13, 0x74, 0x69, 0x6d, 0x65, 0x6c, 0x6f, 0x63, 0x6b, 0x65, 0x64, 0x54, 0x72, 0x61,
+	0x6e, 0x73, 0x66, 0x65, 0x72, 0x73, 0x12, 0x56, 0x0a, 0x0f, 0x73, 0x70, 0x65, 0x6e, 0x64, 0x69,
+	0x6e, 0x67, 0x5f, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x73, 0x18, 0x0b, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x22, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x62, 0x61, 0x6e, 0x6b, 0x2e, 0x76, 0x31,
+	0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x53, 0x70, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x4c, 0x69,
+	0x6d, 0x69, 0x74, 0x42, 0x09, 0xc8, 0xde, 0x1f, 0x00, 0xa8, 0xe7, 0xb0, 0x2a, 0x01, 0x52, 0x0e,
+	0x73, 0x70, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x73, 0x12, 0x62,
+	0x0a, 0x13, 0x64, 0x65, 0x6d, 0x75, 0x72, 0x72, 0x61, 0x67, 0x65, 0x5f, 0x73, 0x63, 0x68, 0x65,
+	0x64, 0x75, 0x6c, 0x65, 0x73, 0x18, 0x0c, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x26, 0x2e, 0x63, 0x6f,
+	0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x62, 0x61, 0x6e, 0x6b, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61,
+	0x31, 0x2e, 0x44, 0x65, 0x6d, 0x75, 0x72, 0x72, 0x61, 0x67, 0x65, 0x53, 0x63, 0x68, 0x65, 0x64,
+	0x75, 0x6c, 0x65, 0x42, 0x09, 0xc8, 0xde, 0x1f, 0x00, 0xa8, 0xe7, 0xb0, 0x2a, 0x01, 0x52, 0x12,
+	0x64, 0x65, 0x6d, 0x75, 0x72, 0x72, 0x61, 0x67, 0x65, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c,
+	0x65, 0x73, 0x12, 0x6f, 0x0a, 0x18, 0x64, 0x65, 0x6d, 0x75, 0x72, 0x72, 0x61, 0x67, 0x65, 0x5f,
+	0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x5f, 0x65, 0x70, 0x6f, 0x63, 0x68, 0x73, 0x18, 0x0d,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x2a, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x62, 0x61,
+	0x6e, 0x6b, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x44, 0x65, 0x6d, 0x75, 0x72,
+	0x72, 0x61, 0x67, 0x65, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x45, 0x70, 0x6f, 0x63, 0x68,
+	0x42, 0x09, 0xc8, 0xde, 0x1f, 0x00, 0xa8, 0xe7, 0xb0, 0x2a, 0x01, 0x52, 0x16, 0x64, 0x65, 0x6d,
+	0x75, 0x72, 0x72, 0x61, 0x67, 0x65, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x45, 0x70, 0x6f,
+	0x63, 0x68, 0x73, 0x22, 0xb2, 0x01, 0x0a, 0x16, 0x47, 0x65, 0x6e, 0x65, 0x73, 0x69, 0x73, 0x42,
+	0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x53, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x12, 0x4b,
+	0x0a, 0x08, 0x73, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x24, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x62, 0x61, 0x6e, 0x6b, 0x2e, 0x76,
+	0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x42, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x53, 0x6e,
+	0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x42, 0x09, 0xc8, 0xde, 0x1f, 0x00, 0xa8, 0xe7, 0xb0, 0x2a,
+	0x01, 0x52, 0x08, 0x73, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x12, 0x4b, 0x0a, 0x08, 0x62,
+	0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x24, 0x2e,
+	0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x62, 0x61, 0x6e, 0x6b, 0x2e, 0x76, 0x31, 0x62, 0x65,
+	0x74, 0x61, 0x31, 0x2e, 0x53, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x42, 0x61, 0x6c, 0x61,
+	0x6e, 0x63, 0x65, 0x42, 0x09, 0xc8, 0xde, 0x1f, 0x00, 0xa8, 0xe7, 0xb0, 0x2a, 0x01, 0x52, 0x08,
+	0x62, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x73, 0x22, 0x77, 0x0a, 0x15, 0x44, 0x65, 0x6d, 0x75,
+	0x72, 0x72, 0x61, 0x67, 0x65, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x45, 0x70, 0x6f, 0x63,
+	0x68, 0x12, 0x32, 0x0a, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x42, 0x18, 0xd2, 0xb4, 0x2d, 0x14, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x41,
+	0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x52, 0x07, 0x61, 0x64,
+	0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x64, 0x65, 0x6e, 0x6f, 0x6d, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x64, 0x65, 0x6e, 0x6f, 0x6d, 0x12, 0x14, 0x0a, 0x05, 0x65,
+	0x70, 0x6f, 0x63, 0x68, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x05, 0x65, 0x70, 0x6f, 0x63,
+	0x68, 0x22, 0xc0, 0x01, 0x0a, 0x07, 0x42, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x12, 0x32, 0x0a,
+	0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x18,
+	0xd2, 0xb4, 0x2d, 0x14, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x41, 0x64, 0x64, 0x72, 0x65,
+	0x73, 0x73, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x52, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73,
+	0x73, 0x12, 0x77, 0x0a, 0x05, 0x63, 0x6f, 0x69, 0x6e, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x19, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x76,
+	0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x43, 0x6f, 0x69, 0x6e, 0x42, 0x46, 0xc8, 0xde, 0x1f,
+	0x00, 0xaa, 0xdf, 0x1f, 0x28, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f,
+	0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2d, 0x73, 0x64,
+	0x6b, 0x2f, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x43, 0x6f, 0x69, 0x6e, 0x73, 0x9a, 0xe7, 0xb0,
+	0x2a, 0x0c, 0x6c, 0x65, 0x67, 0x61, 0x63, 0x79, 0x5f, 0x63, 0x6f, 0x69, 0x6e, 0x73, 0xa8, 0xe7,
+	0xb0, 0x2a, 0x01, 0x52, 0x05, 0x63, 0x6f, 0x69, 0x6e, 0x73, 0x3a, 0x08, 0x88, 0xa0, 0x1f, 0x00,
+	0xe8, 0xa0, 0x1f, 0x00, 0x42, 0xc7, 0x01, 0x0a, 0x17, 0x63, 0x6f, 0x6d, 0x2e, 0x63, 0x6f, 0x73,
+	0x6d, 0x6f, 0x73, 0x2e, 0x62, 0x61, 0x6e, 0x6b, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31,
+	0x42, 0x0c, 0x47, 0x65, 0x6e, 0x65, 0x73, 0x69, 0x73, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50, 0x01,
+	0x5a, 0x30, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f, 0x2f, 0x61,
+	0x70, 0x69, 0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2f, 0x62, 0x61, 0x6e, 0x6b, 0x2f, 0x76,
+	0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x3b, 0x62, 0x61, 0x6e, 0x6b, 0x76, 0x31, 0x62, 0x65, 0x74,
+	0x61, 0x31, 0xa2, 0x02, 0x03, 0x43, 0x42, 0x58, 0xaa, 0x02, 0x13, 0x43, 0x6f, 0x73, 0x6d, 0x6f,
+	0x73, 0x2e, 0x42, 0x61, 0x6e, 0x6b, 0x2e, 0x56, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0xca, 0x02,
+	0x13, 0x43, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x5c, 0x42, 0x61, 0x6e, 0x6b, 0x5c, 0x56, 0x31, 0x62,
+	0x65, 0x74, 0x61, 0x31, 0xe2, 0x02, 0x1f, 0x43, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x5c, 0x42, 0x61,
+	0x6e, 0x6b, 0x5c, 0x56, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x5c, 0x47, 0x50, 0x42, 0x4d, 0x65,
+	0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0xea, 0x02, 0x15, 0x43, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x3a,
+	0x3a, 0x42, 0x61, 0x6e, 0x6b, 0x3a, 0x3a, 0x56, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x62, 0x06,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -1779,27 +4240,45 @@ func file_cosmos_bank_v1beta1_genesis_proto_rawDescGZIP() []byte {
 	return file_cosmos_bank_v1beta1_genesis_proto_rawDescData
 }
 
-var file_cosmos_bank_v1beta1_genesis_proto_msgTypes = make([]protoimpl.MessageInfo, 2)
+var file_cosmos_bank_v1beta1_genesis_proto_msgTypes = make([]protoimpl.MessageInfo, 4)
 var file_cosmos_bank_v1beta1_genesis_proto_goTypes = []interface{}{
-	(*GenesisState)(nil), // 0: cosmos.bank.v1beta1.GenesisState
-	(*Balance)(nil),      // 1: cosmos.bank.v1beta1.Balance
-	(*Params)(nil),       // 2: cosmos.bank.v1beta1.Params
-	(*v1beta1.Coin)(nil), // 3: cosmos.base.v1beta1.Coin
-	(*Metadata)(nil),     // 4: cosmos.bank.v1beta1.Metadata
-	(*SendEnabled)(nil),  // 5: cosmos.bank.v1beta1.SendEnabled
+	(*GenesisState)(nil),           // 0: cosmos.bank.v1beta1.GenesisState
+	(*GenesisBalanceSnapshot)(nil), // 1: cosmos.bank.v1beta1.GenesisBalanceSnapshot
+	(*DemurrageAccountEpoch)(nil),  // 2: cosmos.bank.v1beta1.DemurrageAccountEpoch
+	(*Balance)(nil),                // 3: cosmos.bank.v1beta1.Balance
+	(*Params)(nil),                 // 4: cosmos.bank.v1beta1.Params
+	(*v1beta1.Coin)(nil),           // 5: cosmos.base.v1beta1.Coin
+	(*Metadata)(nil),               // 6: cosmos.bank.v1beta1.Metadata
+	(*SendEnabled)(nil),            // 7: cosmos.bank.v1beta1.SendEnabled
+	(*Hold)(nil),                   // 8: cosmos.bank.v1beta1.Hold
+	(*MintBurnPermissions)(nil),    // 9: cosmos.bank.v1beta1.MintBurnPermissions
+	(*TimelockedTransfer)(nil),     // 10: cosmos.bank.v1beta1.TimelockedTransfer
+	(*SpendingLimit)(nil),          // 11: cosmos.bank.v1beta1.SpendingLimit
+	(*DemurrageSchedule)(nil),      // 12: cosmos.bank.v1beta1.DemurrageSchedule
+	(*BalanceSnapshot)(nil),        // 13: cosmos.bank.v1beta1.BalanceSnapshot
+	(*SnapshotBalance)(nil),        // 14: cosmos.bank.v1beta1.SnapshotBalance
 }
 var file_cosmos_bank_v1beta1_genesis_proto_depIdxs = []int32{
-	2, // 0: cosmos.bank.v1beta1.GenesisState.params:type_name -> cosmos.bank.v1beta1.Params
-	1, // 1: cosmos.bank.v1beta1.GenesisState.balances:type_name -> cosmos.bank.v1beta1.Balance
-	3, // 2: cosmos.bank.v1beta1.GenesisState.supply:type_name -> cosmos.base.v1beta1.Coin
-	4, // 3: cosmos.bank.v1beta1.GenesisState.denom_metadata:type_name -> cosmos.bank.v1beta1.Metadata
-	5, // 4: cosmos.bank.v1beta1.GenesisState.send_enabled:type_name -> cosmos.bank.v1beta1.SendEnabled
-	3, // 5: cosmos.bank.v1beta1.Balance.coins:type_name -> cosmos.base.v1beta1.Coin
-	6, // [6:6] is the sub-list for method output_type
-	6, // [6:6] is the sub-list for method input_type
-	6, // [6:6] is the sub-list for extension type_name
-	6, // [6:6] is the sub-list for extension extendee
-	0, // [0:6] is the sub-list for field type_name
+	4,  // 0: cosmos.bank.v1beta1.GenesisState.params:type_name -> cosmos.bank.v1beta1.Params
+	3,  // 1: cosmos.bank.v1beta1.GenesisState.balances:type_name -> cosmos.bank.v1beta1.Balance
+	5,  // 2: cosmos.bank.v1beta1.GenesisState.supply:type_name -> cosmos.base.v1beta1.Coin
+	6,  // 3: cosmos.bank.v1beta1.GenesisState.denom_metadata:type_name -> cosmos.bank.v1beta1.Metadata
+	7,  // 4: cosmos.bank.v1beta1.GenesisState.send_enabled:type_name -> cosmos.bank.v1beta1.SendEnabled
+	8,  // 5: cosmos.bank.v1beta1.GenesisState.holds:type_name -> cosmos.bank.v1beta1.Hold
+	1,  // 6: cosmos.bank.v1beta1.GenesisState.balance_snapshots:type_name -> cosmos.bank.v1beta1.GenesisBalanceSnapshot
+	9,  // 7: cosmos.bank.v1beta1.GenesisState.mint_burn_permissions:type_name -> cosmos.bank.v1beta1.MintBurnPermissions
+	10, // 8: cosmos.bank.v1beta1.GenesisState.timelocked_transfers:type_name -> cosmos.bank.v1beta1.TimelockedTransfer
+	11, // 9: cosmos.bank.v1beta1.GenesisState.spending_limits:type_name -> cosmos.bank.v1beta1.SpendingLimit
+	12, // 10: cosmos.bank.v1beta1.GenesisState.demurrage_schedules:type_name -> cosmos.bank.v1beta1.DemurrageSchedule
+	2,  // 11: cosmos.bank.v1beta1.GenesisState.demurrage_account_epochs:type_name -> cosmos.bank.v1beta1.DemurrageAccountEpoch
+	13, // 12: cosmos.bank.v1beta1.GenesisBalanceSnapshot.snapshot:type_name -> cosmos.bank.v1beta1.BalanceSnapshot
+	14, // 13: cosmos.bank.v1beta1.GenesisBalanceSnapshot.balances:type_name -> cosmos.bank.v1beta1.SnapshotBalance
+	5,  // 14: cosmos.bank.v1beta1.Balance.coins:type_name -> cosmos.base.v1beta1.Coin
+	15, // [15:15] is the sub-list for method output_type
+	15, // [15:15] is the sub-list for method input_type
+	15, // [15:15] is the sub-list for extension type_name
+	15, // [15:15] is the sub-list for extension extendee
+	0,  // [0:15] is the sub-list for field type_name
 }
 
 func init() { file_cosmos_bank_v1beta1_genesis_proto_init() }
@@ -1822,6 +4301,30 @@ func file_cosmos_bank_v1beta1_genesis_proto_init() {
 			}
 		}
 		file_cosmos_bank_v1beta1_genesis_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GenesisBalanceSnapshot); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_cosmos_bank_v1beta1_genesis_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DemurrageAccountEpoch); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_cosmos_bank_v1beta1_genesis_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*Balance); i {
 			case 0:
 				return &v.state
@@ -1840,7 +4343,7 @@ func file_cosmos_bank_v1beta1_genesis_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_cosmos_bank_v1beta1_genesis_proto_rawDesc,
 			NumEnums:      0,
-			NumMessages:   2,
+			NumMessages:   4,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
@@ -19,17 +19,30 @@ import (
 const _ = grpc.SupportPackageIsVersion7
 
 const (
-	Query_Balance_FullMethodName                 = "/cosmos.bank.v1beta1.Query/Balance"
-	Query_AllBalances_FullMethodName             = "/cosmos.bank.v1beta1.Query/AllBalances"
-	Query_SpendableBalances_FullMethodName       = "/cosmos.bank.v1beta1.Query/SpendableBalances"
-	Query_SpendableBalanceByDenom_FullMethodName = "/cosmos.bank.v1beta1.Query/SpendableBalanceByDenom"
-	Query_TotalSupply_FullMethodName             = "/cosmos.bank.v1beta1.Query/TotalSupply"
-	Query_SupplyOf_FullMethodName                = "/cosmos.bank.v1beta1.Query/SupplyOf"
-	Query_Params_FullMethodName                  = "/cosmos.bank.v1beta1.Query/Params"
-	Query_DenomMetadata_FullMethodName           = "/cosmos.bank.v1beta1.Query/DenomMetadata"
-	Query_DenomsMetadata_FullMethodName          = "/cosmos.bank.v1beta1.Query/DenomsMetadata"
-	Query_DenomOwners_FullMethodName             = "/cosmos.bank.v1beta1.Query/DenomOwners"
-	Query_SendEnabled_FullMethodName             = "/cosmos.bank.v1beta1.Query/SendEnabled"
+	Query_Balance_FullMethodName                        = "/cosmos.bank.v1beta1.Query/Balance"
+	Query_AllBalances_FullMethodName                    = "/cosmos.bank.v1beta1.Query/AllBalances"
+	Query_SpendableBalances_FullMethodName              = "/cosmos.bank.v1beta1.Query/SpendableBalances"
+	Query_SpendableBalanceByDenom_FullMethodName        = "/cosmos.bank.v1beta1.Query/SpendableBalanceByDenom"
+	Query_TotalSupply_FullMethodName                    = "/cosmos.bank.v1beta1.Query/TotalSupply"
+	Query_SupplyOf_FullMethodName                       = "/cosmos.bank.v1beta1.Query/SupplyOf"
+	Query_Params_FullMethodName                         = "/cosmos.bank.v1beta1.Query/Params"
+	Query_DenomMetadata_FullMethodName                  = "/cosmos.bank.v1beta1.Query/DenomMetadata"
+	Query_DenomsMetadata_FullMethodName                 = "/cosmos.bank.v1beta1.Query/DenomsMetadata"
+	Query_DenomOwners_FullMethodName                    = "/cosmos.bank.v1beta1.Query/DenomOwners"
+	Query_SendEnabled_FullMethodName                    = "/cosmos.bank.v1beta1.Query/SendEnabled"
+	Query_Holds_FullMethodName                          = "/cosmos.bank.v1beta1.Query/Holds"
+	Query_DisplayAmount_FullMethodName                  = "/cosmos.bank.v1beta1.Query/DisplayAmount"
+	Query_BalanceSnapshot_FullMethodName                = "/cosmos.bank.v1beta1.Query/BalanceSnapshot"
+	Query_BalanceSnapshots_FullMethodName               = "/cosmos.bank.v1beta1.Query/BalanceSnapshots"
+	Query_BalanceSnapshotProof_FullMethodName           = "/cosmos.bank.v1beta1.Query/BalanceSnapshotProof"
+	Query_SpendableBalanceProjection_FullMethodName     = "/cosmos.bank.v1beta1.Query/SpendableBalanceProjection"
+	Query_MintBurnPermissions_FullMethodName            = "/cosmos.bank.v1beta1.Query/MintBurnPermissions"
+	Query_ModuleEscrows_FullMethodName                  = "/cosmos.bank.v1beta1.Query/ModuleEscrows"
+	Query_TimelockedTransfer_FullMethodName             = "/cosmos.bank.v1beta1.Query/TimelockedTransfer"
+	Query_TimelockedTransfersBySender_FullMethodName    = "/cosmos.bank.v1beta1.Query/TimelockedTransfersBySender"
+	Query_TimelockedTransfersByRecipient_FullMethodName = "/cosmos.bank.v1beta1.Query/TimelockedTransfersByRecipient"
+	Query_SpendingLimit_FullMethodName                  = "/cosmos.bank.v1beta1.Query/SpendingLimit"
+	Query_DemurrageSchedules_FullMethodName             = "/cosmos.bank.v1beta1.Query/DemurrageSchedules"
 )
 
 // QueryClient is the client API for Query service.
@@ -92,6 +105,77 @@ type QueryClient interface {
 	//
 	// Since: cosmos-sdk 0.47
 	SendEnabled(ctx context.Context, in *QuerySendEnabledRequest, opts ...grpc.CallOption) (*QuerySendEnabledResponse, error)
+	// Holds queries the holds on the balance of an account, along with its held
+	// and spendable balances.
+	//
+	// Since: cosmos-sdk 0.48
+	Holds(ctx context.Context, in *QueryHoldsRequest, opts ...grpc.CallOption) (*QueryHoldsResponse, error)
+	// DisplayAmount converts an amount of the base unit of a denomination to one
+	// of its units, by default its display unit, using the exponents of its
+	// client metadata.
+	//
+	// Since: cosmos-sdk 0.48
+	DisplayAmount(ctx context.Context, in *QueryDisplayAmountRequest, opts ...grpc.CallOption) (*QueryDisplayAmountResponse, error)
+	// BalanceSnapshot queries a balance snapshot by its identifier.
+	//
+	// Since: cosmos-sdk 0.48
+	BalanceSnapshot(ctx context.Context, in *QueryBalanceSnapshotRequest, opts ...grpc.CallOption) (*QueryBalanceSnapshotResponse, error)
+	// BalanceSnapshots queries all the balance snapshots.
+	//
+	// Since: cosmos-sdk 0.48
+	BalanceSnapshots(ctx context.Context, in *QueryBalanceSnapshotsRequest, opts ...grpc.CallOption) (*QueryBalanceSnapshotsResponse, error)
+	// BalanceSnapshotProof queries the balance of an account recorded in a
+	// balance snapshot, along with its merkle proof of inclusion in the root of
+	// the snapshot.
+	//
+	// Since: cosmos-sdk 0.48
+	BalanceSnapshotProof(ctx context.Context, in *QueryBalanceSnapshotProofRequest, opts ...grpc.CallOption) (*QueryBalanceSnapshotProofResponse, error)
+	// SpendableBalanceProjection queries the projected spendable balance of an
+	// account at a future time, accounting for its vesting schedule and for the
+	// expiration of the holds on its balance, assuming its balance is unchanged.
+	//
+	// Since: cosmos-sdk 0.48
+	SpendableBalanceProjection(ctx context.Context, in *QuerySpendableBalanceProjectionRequest, opts ...grpc.CallOption) (*QuerySpendableBalanceProjectionResponse, error)
+	// MintBurnPermissions queries the mint/burn permissions of denominations.
+	//
+	// This query only returns the denominations with mint/burn permissions. Any
+	// other denomination can be minted and burned by any module with the minter
+	// and burner permissions.
+	//
+	// Since: cosmos-sdk 0.48
+	MintBurnPermissions(ctx context.Context, in *QueryMintBurnPermissionsRequest, opts ...grpc.CallOption) (*QueryMintBurnPermissionsResponse, error)
+	// ModuleEscrows queries the breakdown of the balance of a module account by
+	// purpose, e.g. the governance deposits by proposal, along with the amounts
+	// of its balance which aren't accounted for by its escrows.
+	//
+	// Since: cosmos-sdk 0.48
+	ModuleEscrows(ctx context.Context, in *QueryModuleEscrowsRequest, opts ...grpc.CallOption) (*QueryModuleEscrowsResponse, error)
+	// TimelockedTransfer queries a time-locked transfer by its identifier.
+	//
+	// Since: cosmos-sdk 0.48
+	TimelockedTransfer(ctx context.Context, in *QueryTimelockedTransferRequest, opts ...grpc.CallOption) (*QueryTimelockedTransferResponse, error)
+	// TimelockedTransfersBySender queries the time-locked transfers sent by an
+	// account.
+	//
+	// Since: cosmos-sdk 0.48
+	TimelockedTransfersBySender(ctx context.Context, in *QueryTimelockedTransfersBySenderRequest, opts ...grpc.CallOption) (*QueryTimelockedTransfersBySenderResponse, error)
+	// TimelockedTransfersByRecipient queries the time-locked transfers
+	// claimable by an account.
+	//
+	// Since: cosmos-sdk 0.48
+	TimelockedTransfersByRecipient(ctx context.Context, in *QueryTimelockedTransfersByRecipientRequest, opts ...grpc.CallOption) (*QueryTimelockedTransfersByRecipientResponse, error)
+	// SpendingLimit queries the spending limit of an account, and the coins it
+	// can still send within the current window.
+	//
+	// Since: cosmos-sdk 0.48
+	SpendingLimit(ctx context.Context, in *QuerySpendingLimitRequest, opts ...grpc.CallOption) (*QuerySpendingLimitResponse, error)
+	// DemurrageSchedules queries the demurrage schedules of denominations.
+	//
+	// This query only returns the denominations with a demurrage schedule. The
+	// balances of any other denomination don't decay.
+	//
+	// Since: cosmos-sdk 0.48
+	DemurrageSchedules(ctx context.Context, in *QueryDemurrageSchedulesRequest, opts ...grpc.CallOption) (*QueryDemurrageSchedulesResponse, error)
 }
 
 type queryClient struct {
@@ -201,6 +285,123 @@ func (c *queryClient) SendEnabled(ctx context.Context, in *QuerySendEnabledReque
 	return out, nil
 }
 
+func (c *queryClient) Holds(ctx context.Context, in *QueryHoldsRequest, opts ...grpc.CallOption) (*QueryHoldsResponse, error) {
+	out := new(QueryHoldsResponse)
+	err := c.cc.Invoke(ctx, Query_Holds_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) DisplayAmount(ctx context.Context, in *QueryDisplayAmountRequest, opts ...grpc.CallOption) (*QueryDisplayAmountResponse, error) {
+	out := new(QueryDisplayAmountResponse)
+	err := c.cc.Invoke(ctx, Query_DisplayAmount_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) BalanceSnapshot(ctx context.Context, in *QueryBalanceSnapshotRequest, opts ...grpc.CallOption) (*QueryBalanceSnapshotResponse, error) {
+	out := new(QueryBalanceSnapshotResponse)
+	err := c.cc.Invoke(ctx, Query_BalanceSnapshot_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) BalanceSnapshots(ctx context.Context, in *QueryBalanceSnapshotsRequest, opts ...grpc.CallOption) (*QueryBalanceSnapshotsResponse, error) {
+	out := new(QueryBalanceSnapshotsResponse)
+	err := c.cc.Invoke(ctx, Query_BalanceSnapshots_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) BalanceSnapshotProof(ctx context.Context, in *QueryBalanceSnapshotProofRequest, opts ...grpc.CallOption) (*QueryBalanceSnapshotProofResponse, error) {
+	out := new(QueryBalanceSnapshotProofResponse)
+	err := c.cc.Invoke(ctx, Query_BalanceSnapshotProof_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) SpendableBalanceProjection(ctx context.Context, in *QuerySpendableBalanceProjectionRequest, opts ...grpc.CallOption) (*QuerySpendableBalanceProjectionResponse, error) {
+	out := new(QuerySpendableBalanceProjectionResponse)
+	err := c.cc.Invoke(ctx, Query_SpendableBalanceProjection_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) MintBurnPermissions(ctx context.Context, in *QueryMintBurnPermissionsRequest, opts ...grpc.CallOption) (*QueryMintBurnPermissionsResponse, error) {
+	out := new(QueryMintBurnPermissionsResponse)
+	err := c.cc.Invoke(ctx, Query_MintBurnPermissions_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) ModuleEscrows(ctx context.Context, in *QueryModuleEscrowsRequest, opts ...grpc.CallOption) (*QueryModuleEscrowsResponse, error) {
+	out := new(QueryModuleEscrowsResponse)
+	err := c.cc.Invoke(ctx, Query_ModuleEscrows_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) TimelockedTransfer(ctx context.Context, in *QueryTimelockedTransferRequest, opts ...grpc.CallOption) (*QueryTimelockedTransferResponse, error) {
+	out := new(QueryTimelockedTransferResponse)
+	err := c.cc.Invoke(ctx, Query_TimelockedTransfer_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) TimelockedTransfersBySender(ctx context.Context, in *QueryTimelockedTransfersBySenderRequest, opts ...grpc.CallOption) (*QueryTimelockedTransfersBySenderResponse, error) {
+	out := new(QueryTimelockedTransfersBySenderResponse)
+	err := c.cc.Invoke(ctx, Query_TimelockedTransfersBySender_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) TimelockedTransfersByRecipient(ctx context.Context, in *QueryTimelockedTransfersByRecipientRequest, opts ...grpc.CallOption) (*QueryTimelockedTransfersByRecipientResponse, error) {
+	out := new(QueryTimelockedTransfersByRecipientResponse)
+	err := c.cc.Invoke(ctx, Query_TimelockedTransfersByRecipient_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) SpendingLimit(ctx context.Context, in *QuerySpendingLimitRequest, opts ...grpc.CallOption) (*QuerySpendingLimitResponse, error) {
+	out := new(QuerySpendingLimitResponse)
+	err := c.cc.Invoke(ctx, Query_SpendingLimit_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) DemurrageSchedules(ctx context.Context, in *QueryDemurrageSchedulesRequest, opts ...grpc.CallOption) (*QueryDemurrageSchedulesResponse, error) {
+	out := new(QueryDemurrageSchedulesResponse)
+	err := c.cc.Invoke(ctx, Query_DemurrageSchedules_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 // All implementations must embed UnimplementedQueryServer
 // for forward compatibility
@@ -261,6 +462,77 @@ type QueryServer interface {
 	//
 	// Since: cosmos-sdk 0.47
 	SendEnabled(context.Context, *QuerySendEnabledRequest) (*QuerySendEnabledResponse, error)
+	// Holds queries the holds on the balance of an account, along with its held
+	// and spendable balances.
+	//
+	// Since: cosmos-sdk 0.48
+	Holds(context.Context, *QueryHoldsRequest) (*QueryHoldsResponse, error)
+	// DisplayAmount converts an amount of the base unit of a denomination to one
+	// of its units, by default its display unit, using the exponents of its
+	// client metadata.
+	//
+	// Since: cosmos-sdk 0.48
+	DisplayAmount(context.Context, *QueryDisplayAmountRequest) (*QueryDisplayAmountResponse, error)
+	// BalanceSnapshot queries a balance snapshot by its identifier.
+	//
+	// Since: cosmos-sdk 0.48
+	BalanceSnapshot(context.Context, *QueryBalanceSnapshotRequest) (*QueryBalanceSnapshotResponse, error)
+	// BalanceSnapshots queries all the balance snapshots.
+	//
+	// Since: cosmos-sdk 0.48
+	BalanceSnapshots(context.Context, *QueryBalanceSnapshotsRequest) (*QueryBalanceSnapshotsResponse, error)
+	// BalanceSnapshotProof queries the balance of an account recorded in a
+	// balance snapshot, along with its merkle proof of inclusion in the root of
+	// the snapshot.
+	//
+	// Since: cosmos-sdk 0.48
+	BalanceSnapshotProof(context.Context, *QueryBalanceSnapshotProofRequest) (*QueryBalanceSnapshotProofResponse, error)
+	// SpendableBalanceProjection queries the projected spendable balance of an
+	// account at a future time, accounting for its vesting schedule and for the
+	// expiration of the holds on its balance, assuming its balance is unchanged.
+	//
+	// Since: cosmos-sdk 0.48
+	SpendableBalanceProjection(context.Context, *QuerySpendableBalanceProjectionRequest) (*QuerySpendableBalanceProjectionResponse, error)
+	// MintBurnPermissions queries the mint/burn permissions of denominations.
+	//
+	// This query only returns the denominations with mint/burn permissions. Any
+	// other denomination can be minted and burned by any module with the minter
+	// and burner permissions.
+	//
+	// Since: cosmos-sdk 0.48
+	MintBurnPermissions(context.Context, *QueryMintBurnPermissionsRequest) (*QueryMintBurnPermissionsResponse, error)
+	// ModuleEscrows queries the breakdown of the balance of a module account by
+	// purpose, e.g. the governance deposits by proposal, along with the amounts
+	// of its balance which aren't accounted for by its escrows.
+	//
+	// Since: cosmos-sdk 0.48
+	ModuleEscrows(context.Context, *QueryModuleEscrowsRequest) (*QueryModuleEscrowsResponse, error)
+	// TimelockedTransfer queries a time-locked transfer by its identifier.
+	//
+	// Since: cosmos-sdk 0.48
+	TimelockedTransfer(context.Context, *QueryTimelockedTransferRequest) (*QueryTimelockedTransferResponse, error)
+	// TimelockedTransfersBySender queries the time-locked transfers sent by an
+	// account.
+	//
+	// Since: cosmos-sdk 0.48
+	TimelockedTransfersBySender(context.Context, *QueryTimelockedTransfersBySenderRequest) (*QueryTimelockedTransfersBySenderResponse, error)
+	// TimelockedTransfersByRecipient queries the time-locked transfers
+	// claimable by an account.
+	//
+	// Since: cosmos-sdk 0.48
+	TimelockedTransfersByRecipient(context.Context, *QueryTimelockedTransfersByRecipientRequest) (*QueryTimelockedTransfersByRecipientResponse, error)
+	// SpendingLimit queries the spending limit of an account, and the coins it
+	// can still send within the current window.
+	//
+	// Since: cosmos-sdk 0.48
+	SpendingLimit(context.Context, *QuerySpendingLimitRequest) (*QuerySpendingLimitResponse, error)
+	// DemurrageSchedules queries the demurrage schedules of denominations.
+	//
+	// This query only returns the denominations with a demurrage schedule. The
+	// balances of any other denomination don't decay.
+	//
+	// Since: cosmos-sdk 0.48
+	DemurrageSchedules(context.Context, *QueryDemurrageSchedulesRequest) (*QueryDemurrageSchedulesResponse, error)
 	mustEmbedUnimplementedQueryServer()
 }
 
@@ -301,6 +573,45 @@ func (UnimplementedQueryServer) DenomOwners(context.Context, *QueryDenomOwnersRe
 func (UnimplementedQueryServer) SendEnabled(context.Context, *QuerySendEnabledRequest) (*QuerySendEnabledResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method SendEnabled not implemented")
 }
+func (UnimplementedQueryServer) Holds(context.Context, *QueryHoldsRequest) (*QueryHoldsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Holds not implemented")
+}
+func (UnimplementedQueryServer) DisplayAmount(context.Context, *QueryDisplayAmountRequest) (*QueryDisplayAmountResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DisplayAmount not implemented")
+}
+func (UnimplementedQueryServer) BalanceSnapshot(context.Context, *QueryBalanceSnapshotRequest) (*QueryBalanceSnapshotResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BalanceSnapshot not implemented")
+}
+func (UnimplementedQueryServer) BalanceSnapshots(context.Context, *QueryBalanceSnapshotsRequest) (*QueryBalanceSnapshotsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BalanceSnapshots not implemented")
+}
+func (UnimplementedQueryServer) BalanceSnapshotProof(context.Context, *QueryBalanceSnapshotProofRequest) (*QueryBalanceSnapshotProofResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BalanceSnapshotProof not implemented")
+}
+func (UnimplementedQueryServer) SpendableBalanceProjection(context.Context, *QuerySpendableBalanceProjectionRequest) (*QuerySpendableBalanceProjectionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SpendableBalanceProjection not implemented")
+}
+func (UnimplementedQueryServer) MintBurnPermissions(context.Context, *QueryMintBurnPermissionsRequest) (*QueryMintBurnPermissionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MintBurnPermissions not implemented")
+}
+func (UnimplementedQueryServer) ModuleEscrows(context.Context, *QueryModuleEscrowsRequest) (*QueryModuleEscrowsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ModuleEscrows not implemented")
+}
+func (UnimplementedQueryServer) TimelockedTransfer(context.Context, *QueryTimelockedTransferRequest) (*QueryTimelockedTransferResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TimelockedTransfer not implemented")
+}
+func (UnimplementedQueryServer) TimelockedTransfersBySender(context.Context, *QueryTimelockedTransfersBySenderRequest) (*QueryTimelockedTransfersBySenderResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TimelockedTransfersBySender not implemented")
+}
+func (UnimplementedQueryServer) TimelockedTransfersByRecipient(context.Context, *QueryTimelockedTransfersByRecipientRequest) (*QueryTimelockedTransfersByRecipientResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TimelockedTransfersByRecipient not implemented")
+}
+func (UnimplementedQueryServer) SpendingLimit(context.Context, *QuerySpendingLimitRequest) (*QuerySpendingLimitResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SpendingLimit not implemented")
+}
+func (UnimplementedQueryServer) DemurrageSchedules(context.Context, *QueryDemurrageSchedulesRequest) (*QueryDemurrageSchedulesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DemurrageSchedules not implemented")
+}
 func (UnimplementedQueryServer) mustEmbedUnimplementedQueryServer() {}
 
 // UnsafeQueryServer may be embedded to opt out of forward compatibility for this service.
@@ -512,6 +823,240 @@ func _Query_SendEnabled_Handler(srv interface{}, ctx context.Context, dec func(i
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_Holds_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryHoldsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Holds(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_Holds_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Holds(ctx, req.(*QueryHoldsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_DisplayAmount_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryDisplayAmountRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).DisplayAmount(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_DisplayAmount_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).DisplayAmount(ctx, req.(*QueryDisplayAmountRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_BalanceSnapshot_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryBalanceSnapshotRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).BalanceSnapshot(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_BalanceSnapshot_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).BalanceSnapshot(ctx, req.(*QueryBalanceSnapshotRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_BalanceSnapshots_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryBalanceSnapshotsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).BalanceSnapshots(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_BalanceSnapshots_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).BalanceSnapshots(ctx, req.(*QueryBalanceSnapshotsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_BalanceSnapshotProof_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryBalanceSnapshotProofRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).BalanceSnapshotProof(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_BalanceSnapshotProof_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).BalanceSnapshotProof(ctx, req.(*QueryBalanceSnapshotProofRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_SpendableBalanceProjection_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QuerySpendableBalanceProjectionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).SpendableBalanceProjection(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_SpendableBalanceProjection_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).SpendableBalanceProjection(ctx, req.(*QuerySpendableBalanceProjectionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_MintBurnPermissions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryMintBurnPermissionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).MintBurnPermissions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_MintBurnPermissions_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).MintBurnPermissions(ctx, req.(*QueryMintBurnPermissionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ModuleEscrows_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryModuleEscrowsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ModuleEscrows(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_ModuleEscrows_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ModuleEscrows(ctx, req.(*QueryModuleEscrowsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_TimelockedTransfer_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryTimelockedTransferRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).TimelockedTransfer(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_TimelockedTransfer_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).TimelockedTransfer(ctx, req.(*QueryTimelockedTransferRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_TimelockedTransfersBySender_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryTimelockedTransfersBySenderRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).TimelockedTransfersBySender(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_TimelockedTransfersBySender_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).TimelockedTransfersBySender(ctx, req.(*QueryTimelockedTransfersBySenderRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_TimelockedTransfersByRecipient_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryTimelockedTransfersByRecipientRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).TimelockedTransfersByRecipient(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_TimelockedTransfersByRecipient_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).TimelockedTransfersByRecipient(ctx, req.(*QueryTimelockedTransfersByRecipientRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_SpendingLimit_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QuerySpendingLimitRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).SpendingLimit(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_SpendingLimit_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).SpendingLimit(ctx, req.(*QuerySpendingLimitRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_DemurrageSchedules_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryDemurrageSchedulesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).DemurrageSchedules(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_DemurrageSchedules_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).DemurrageSchedules(ctx, req.(*QueryDemurrageSchedulesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // Query_ServiceDesc is the grpc.ServiceDesc for Query service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -563,6 +1108,58 @@ var Query_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "SendEnabled",
 			Handler:    _Query_SendEnabled_Handler,
 		},
+		{
+			MethodName: "Holds",
+			Handler:    _Query_Holds_Handler,
+		},
+		{
+			MethodName: "DisplayAmount",
+			Handler:    _Query_DisplayAmount_Handler,
+		},
+		{
+			MethodName: "BalanceSnapshot",
+			Handler:    _Query_BalanceSnapshot_Handler,
+		},
+		{
+			MethodName: "BalanceSnapshots",
+			Handler:    _Query_BalanceSnapshots_Handler,
+		},
+		{
+			MethodName: "BalanceSnapshotProof",
+			Handler:    _Query_BalanceSnapshotProof_Handler,
+		},
+		{
+			MethodName: "SpendableBalanceProjection",
+			Handler:    _Query_SpendableBalanceProjection_Handler,
+		},
+		{
+			MethodName: "MintBurnPermissions",
+			Handler:    _Query_MintBurnPermissions_Handler,
+		},
+		{
+			MethodName: "ModuleEscrows",
+			Handler:    _Query_ModuleEscrows_Handler,
+		},
+		{
+			MethodName: "TimelockedTransfer",
+			Handler:    _Query_TimelockedTransfer_Handler,
+		},
+		{
+			MethodName: "TimelockedTransfersBySender",
+			Handler:    _Query_TimelockedTransfersBySender_Handler,
+		},
+		{
+			MethodName: "TimelockedTransfersByRecipient",
+			Handler:    _Query_TimelockedTransfersByRecipient_Handler,
+		},
+		{
+			MethodName: "SpendingLimit",
+			Handler:    _Query_SpendingLimit_Handler,
+		},
+		{
+			MethodName: "DemurrageSchedules",
+			Handler:    _Query_DemurrageSchedules_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "cosmos/bank/v1beta1/query.proto",
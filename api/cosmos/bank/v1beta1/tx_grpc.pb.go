@@ -19,10 +19,21 @@ import (
 const _ = grpc.SupportPackageIsVersion7
 
 const (
-	Msg_Send_FullMethodName           = "/cosmos.bank.v1beta1.Msg/Send"
-	Msg_MultiSend_FullMethodName      = "/cosmos.bank.v1beta1.Msg/MultiSend"
-	Msg_UpdateParams_FullMethodName   = "/cosmos.bank.v1beta1.Msg/UpdateParams"
-	Msg_SetSendEnabled_FullMethodName = "/cosmos.bank.v1beta1.Msg/SetSendEnabled"
+	Msg_Send_FullMethodName                      = "/cosmos.bank.v1beta1.Msg/Send"
+	Msg_MultiSend_FullMethodName                 = "/cosmos.bank.v1beta1.Msg/MultiSend"
+	Msg_UpdateParams_FullMethodName              = "/cosmos.bank.v1beta1.Msg/UpdateParams"
+	Msg_SetSendEnabled_FullMethodName            = "/cosmos.bank.v1beta1.Msg/SetSendEnabled"
+	Msg_UpdateDenomMetadata_FullMethodName       = "/cosmos.bank.v1beta1.Msg/UpdateDenomMetadata"
+	Msg_CreateBalanceSnapshot_FullMethodName     = "/cosmos.bank.v1beta1.Msg/CreateBalanceSnapshot"
+	Msg_DeleteBalanceSnapshot_FullMethodName     = "/cosmos.bank.v1beta1.Msg/DeleteBalanceSnapshot"
+	Msg_BatchSend_FullMethodName                 = "/cosmos.bank.v1beta1.Msg/BatchSend"
+	Msg_SetMintBurnPermissions_FullMethodName    = "/cosmos.bank.v1beta1.Msg/SetMintBurnPermissions"
+	Msg_SetDustSweepOptIn_FullMethodName         = "/cosmos.bank.v1beta1.Msg/SetDustSweepOptIn"
+	Msg_SendWithTimelock_FullMethodName          = "/cosmos.bank.v1beta1.Msg/SendWithTimelock"
+	Msg_ClaimTimelockedTransfer_FullMethodName   = "/cosmos.bank.v1beta1.Msg/ClaimTimelockedTransfer"
+	Msg_ReclaimTimelockedTransfer_FullMethodName = "/cosmos.bank.v1beta1.Msg/ReclaimTimelockedTransfer"
+	Msg_SetSpendingLimit_FullMethodName          = "/cosmos.bank.v1beta1.Msg/SetSpendingLimit"
+	Msg_SetDemurrageSchedule_FullMethodName      = "/cosmos.bank.v1beta1.Msg/SetDemurrageSchedule"
 )
 
 // MsgClient is the client API for Msg service.
@@ -45,6 +56,64 @@ type MsgClient interface {
 	//
 	// Since: cosmos-sdk 0.47
 	SetSendEnabled(ctx context.Context, in *MsgSetSendEnabled, opts ...grpc.CallOption) (*MsgSetSendEnabledResponse, error)
+	// UpdateDenomMetadata is a governance operation for setting the client
+	// metadata of a denomination, e.g. to fix a wrong display exponent without a
+	// software upgrade.
+	//
+	// Since: cosmos-sdk 0.48
+	UpdateDenomMetadata(ctx context.Context, in *MsgUpdateDenomMetadata, opts ...grpc.CallOption) (*MsgUpdateDenomMetadataResponse, error)
+	// CreateBalanceSnapshot is a governance operation for recording a merkleized
+	// snapshot of the balances of all the holders of a denomination at a given
+	// height, e.g. for an airdrop.
+	//
+	// Since: cosmos-sdk 0.48
+	CreateBalanceSnapshot(ctx context.Context, in *MsgCreateBalanceSnapshot, opts ...grpc.CallOption) (*MsgCreateBalanceSnapshotResponse, error)
+	// DeleteBalanceSnapshot is a governance operation for deleting a balance
+	// snapshot and its recorded balances once they are no longer needed.
+	//
+	// Since: cosmos-sdk 0.48
+	DeleteBalanceSnapshot(ctx context.Context, in *MsgDeleteBalanceSnapshot, opts ...grpc.CallOption) (*MsgDeleteBalanceSnapshotResponse, error)
+	// BatchSend defines a method for sending coins from one account to many
+	// accounts, with an optional memo per output emitted in its transfer event.
+	//
+	// Since: cosmos-sdk 0.48
+	BatchSend(ctx context.Context, in *MsgBatchSend, opts ...grpc.CallOption) (*MsgBatchSendResponse, error)
+	// SetMintBurnPermissions is a governance operation for setting the modules
+	// allowed to mint and burn denominations.
+	//
+	// Since: cosmos-sdk 0.48
+	SetMintBurnPermissions(ctx context.Context, in *MsgSetMintBurnPermissions, opts ...grpc.CallOption) (*MsgSetMintBurnPermissionsResponse, error)
+	// SetDustSweepOptIn defines a method for an account to opt in, or out of,
+	// the sweeping of its dust balances.
+	//
+	// Since: cosmos-sdk 0.48
+	SetDustSweepOptIn(ctx context.Context, in *MsgSetDustSweepOptIn, opts ...grpc.CallOption) (*MsgSetDustSweepOptInResponse, error)
+	// SendWithTimelock defines a method for sending coins claimable by their
+	// recipient once an unlock time is reached, and reclaimable by their sender
+	// once an expiry time is reached if still unclaimed.
+	//
+	// Since: cosmos-sdk 0.48
+	SendWithTimelock(ctx context.Context, in *MsgSendWithTimelock, opts ...grpc.CallOption) (*MsgSendWithTimelockResponse, error)
+	// ClaimTimelockedTransfer defines a method for the recipient of a
+	// time-locked transfer to claim its coins.
+	//
+	// Since: cosmos-sdk 0.48
+	ClaimTimelockedTransfer(ctx context.Context, in *MsgClaimTimelockedTransfer, opts ...grpc.CallOption) (*MsgClaimTimelockedTransferResponse, error)
+	// ReclaimTimelockedTransfer defines a method for the sender of an expired
+	// time-locked transfer to reclaim its coins.
+	//
+	// Since: cosmos-sdk 0.48
+	ReclaimTimelockedTransfer(ctx context.Context, in *MsgReclaimTimelockedTransfer, opts ...grpc.CallOption) (*MsgReclaimTimelockedTransferResponse, error)
+	// SetSpendingLimit defines a method for an account to attach, change or
+	// remove a spending limit limiting its outgoing transfers.
+	//
+	// Since: cosmos-sdk 0.48
+	SetSpendingLimit(ctx context.Context, in *MsgSetSpendingLimit, opts ...grpc.CallOption) (*MsgSetSpendingLimitResponse, error)
+	// SetDemurrageSchedule is a governance operation for setting the decay, or
+	// the expiry, schedule of the balances of a denomination.
+	//
+	// Since: cosmos-sdk 0.48
+	SetDemurrageSchedule(ctx context.Context, in *MsgSetDemurrageSchedule, opts ...grpc.CallOption) (*MsgSetDemurrageScheduleResponse, error)
 }
 
 type msgClient struct {
@@ -91,6 +160,105 @@ func (c *msgClient) SetSendEnabled(ctx context.Context, in *MsgSetSendEnabled, o
 	return out, nil
 }
 
+func (c *msgClient) UpdateDenomMetadata(ctx context.Context, in *MsgUpdateDenomMetadata, opts ...grpc.CallOption) (*MsgUpdateDenomMetadataResponse, error) {
+	out := new(MsgUpdateDenomMetadataResponse)
+	err := c.cc.Invoke(ctx, Msg_UpdateDenomMetadata_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) CreateBalanceSnapshot(ctx context.Context, in *MsgCreateBalanceSnapshot, opts ...grpc.CallOption) (*MsgCreateBalanceSnapshotResponse, error) {
+	out := new(MsgCreateBalanceSnapshotResponse)
+	err := c.cc.Invoke(ctx, Msg_CreateBalanceSnapshot_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) DeleteBalanceSnapshot(ctx context.Context, in *MsgDeleteBalanceSnapshot, opts ...grpc.CallOption) (*MsgDeleteBalanceSnapshotResponse, error) {
+	out := new(MsgDeleteBalanceSnapshotResponse)
+	err := c.cc.Invoke(ctx, Msg_DeleteBalanceSnapshot_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) BatchSend(ctx context.Context, in *MsgBatchSend, opts ...grpc.CallOption) (*MsgBatchSendResponse, error) {
+	out := new(MsgBatchSendResponse)
+	err := c.cc.Invoke(ctx, Msg_BatchSend_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) SetMintBurnPermissions(ctx context.Context, in *MsgSetMintBurnPermissions, opts ...grpc.CallOption) (*MsgSetMintBurnPermissionsResponse, error) {
+	out := new(MsgSetMintBurnPermissionsResponse)
+	err := c.cc.Invoke(ctx, Msg_SetMintBurnPermissions_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) SetDustSweepOptIn(ctx context.Context, in *MsgSetDustSweepOptIn, opts ...grpc.CallOption) (*MsgSetDustSweepOptInResponse, error) {
+	out := new(MsgSetDustSweepOptInResponse)
+	err := c.cc.Invoke(ctx, Msg_SetDustSweepOptIn_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) SendWithTimelock(ctx context.Context, in *MsgSendWithTimelock, opts ...grpc.CallOption) (*MsgSendWithTimelockResponse, error) {
+	out := new(MsgSendWithTimelockResponse)
+	err := c.cc.Invoke(ctx, Msg_SendWithTimelock_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) ClaimTimelockedTransfer(ctx context.Context, in *MsgClaimTimelockedTransfer, opts ...grpc.CallOption) (*MsgClaimTimelockedTransferResponse, error) {
+	out := new(MsgClaimTimelockedTransferResponse)
+	err := c.cc.Invoke(ctx, Msg_ClaimTimelockedTransfer_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) ReclaimTimelockedTransfer(ctx context.Context, in *MsgReclaimTimelockedTransfer, opts ...grpc.CallOption) (*MsgReclaimTimelockedTransferResponse, error) {
+	out := new(MsgReclaimTimelockedTransferResponse)
+	err := c.cc.Invoke(ctx, Msg_ReclaimTimelockedTransfer_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) SetSpendingLimit(ctx context.Context, in *MsgSetSpendingLimit, opts ...grpc.CallOption) (*MsgSetSpendingLimitResponse, error) {
+	out := new(MsgSetSpendingLimitResponse)
+	err := c.cc.Invoke(ctx, Msg_SetSpendingLimit_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) SetDemurrageSchedule(ctx context.Context, in *MsgSetDemurrageSchedule, opts ...grpc.CallOption) (*MsgSetDemurrageScheduleResponse, error) {
+	out := new(MsgSetDemurrageScheduleResponse)
+	err := c.cc.Invoke(ctx, Msg_SetDemurrageSchedule_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MsgServer is the server API for Msg service.
 // All implementations must embed UnimplementedMsgServer
 // for forward compatibility
@@ -111,6 +279,64 @@ type MsgServer interface {
 	//
 	// Since: cosmos-sdk 0.47
 	SetSendEnabled(context.Context, *MsgSetSendEnabled) (*MsgSetSendEnabledResponse, error)
+	// UpdateDenomMetadata is a governance operation for setting the client
+	// metadata of a denomination, e.g. to fix a wrong display exponent without a
+	// software upgrade.
+	//
+	// Since: cosmos-sdk 0.48
+	UpdateDenomMetadata(context.Context, *MsgUpdateDenomMetadata) (*MsgUpdateDenomMetadataResponse, error)
+	// CreateBalanceSnapshot is a governance operation for recording a merkleized
+	// snapshot of the balances of all the holders of a denomination at a given
+	// height, e.g. for an airdrop.
+	//
+	// Since: cosmos-sdk 0.48
+	CreateBalanceSnapshot(context.Context, *MsgCreateBalanceSnapshot) (*MsgCreateBalanceSnapshotResponse, error)
+	// DeleteBalanceSnapshot is a governance operation for deleting a balance
+	// snapshot and its recorded balances once they are no longer needed.
+	//
+	// Since: cosmos-sdk 0.48
+	DeleteBalanceSnapshot(context.Context, *MsgDeleteBalanceSnapshot) (*MsgDeleteBalanceSnapshotResponse, error)
+	// BatchSend defines a method for sending coins from one account to many
+	// accounts, with an optional memo per output emitted in its transfer event.
+	//
+	// Since: cosmos-sdk 0.48
+	BatchSend(context.Context, *MsgBatchSend) (*MsgBatchSendResponse, error)
+	// SetMintBurnPermissions is a governance operation for setting the modules
+	// allowed to mint and burn denominations.
+	//
+	// Since: cosmos-sdk 0.48
+	SetMintBurnPermissions(context.Context, *MsgSetMintBurnPermissions) (*MsgSetMintBurnPermissionsResponse, error)
+	// SetDustSweepOptIn defines a method for an account to opt in, or out of,
+	// the sweeping of its dust balances.
+	//
+	// Since: cosmos-sdk 0.48
+	SetDustSweepOptIn(context.Context, *MsgSetDustSweepOptIn) (*MsgSetDustSweepOptInResponse, error)
+	// SendWithTimelock defines a method for sending coins claimable by their
+	// recipient once an unlock time is reached, and reclaimable by their sender
+	// once an expiry time is reached if still unclaimed.
+	//
+	// Since: cosmos-sdk 0.48
+	SendWithTimelock(context.Context, *MsgSendWithTimelock) (*MsgSendWithTimelockResponse, error)
+	// ClaimTimelockedTransfer defines a method for the recipient of a
+	// time-locked transfer to claim its coins.
+	//
+	// Since: cosmos-sdk 0.48
+	ClaimTimelockedTransfer(context.Context, *MsgClaimTimelockedTransfer) (*MsgClaimTimelockedTransferResponse, error)
+	// ReclaimTimelockedTransfer defines a method for the sender of an expired
+	// time-locked transfer to reclaim its coins.
+	//
+	// Since: cosmos-sdk 0.48
+	ReclaimTimelockedTransfer(context.Context, *MsgReclaimTimelockedTransfer) (*MsgReclaimTimelockedTransferResponse, error)
+	// SetSpendingLimit defines a method for an account to attach, change or
+	// remove a spending limit limiting its outgoing transfers.
+	//
+	// Since: cosmos-sdk 0.48
+	SetSpendingLimit(context.Context, *MsgSetSpendingLimit) (*MsgSetSpendingLimitResponse, error)
+	// SetDemurrageSchedule is a governance operation for setting the decay, or
+	// the expiry, schedule of the balances of a denomination.
+	//
+	// Since: cosmos-sdk 0.48
+	SetDemurrageSchedule(context.Context, *MsgSetDemurrageSchedule) (*MsgSetDemurrageScheduleResponse, error)
 	mustEmbedUnimplementedMsgServer()
 }
 
@@ -130,6 +356,39 @@ func (UnimplementedMsgServer) UpdateParams(context.Context, *MsgUpdateParams) (*
 func (UnimplementedMsgServer) SetSendEnabled(context.Context, *MsgSetSendEnabled) (*MsgSetSendEnabledResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method SetSendEnabled not implemented")
 }
+func (UnimplementedMsgServer) UpdateDenomMetadata(context.Context, *MsgUpdateDenomMetadata) (*MsgUpdateDenomMetadataResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateDenomMetadata not implemented")
+}
+func (UnimplementedMsgServer) CreateBalanceSnapshot(context.Context, *MsgCreateBalanceSnapshot) (*MsgCreateBalanceSnapshotResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateBalanceSnapshot not implemented")
+}
+func (UnimplementedMsgServer) DeleteBalanceSnapshot(context.Context, *MsgDeleteBalanceSnapshot) (*MsgDeleteBalanceSnapshotResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteBalanceSnapshot not implemented")
+}
+func (UnimplementedMsgServer) BatchSend(context.Context, *MsgBatchSend) (*MsgBatchSendResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BatchSend not implemented")
+}
+func (UnimplementedMsgServer) SetMintBurnPermissions(context.Context, *MsgSetMintBurnPermissions) (*MsgSetMintBurnPermissionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetMintBurnPermissions not implemented")
+}
+func (UnimplementedMsgServer) SetDustSweepOptIn(context.Context, *MsgSetDustSweepOptIn) (*MsgSetDustSweepOptInResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetDustSweepOptIn not implemented")
+}
+func (UnimplementedMsgServer) SendWithTimelock(context.Context, *MsgSendWithTimelock) (*MsgSendWithTimelockResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SendWithTimelock not implemented")
+}
+func (UnimplementedMsgServer) ClaimTimelockedTransfer(context.Context, *MsgClaimTimelockedTransfer) (*MsgClaimTimelockedTransferResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ClaimTimelockedTransfer not implemented")
+}
+func (UnimplementedMsgServer) ReclaimTimelockedTransfer(context.Context, *MsgReclaimTimelockedTransfer) (*MsgReclaimTimelockedTransferResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReclaimTimelockedTransfer not implemented")
+}
+func (UnimplementedMsgServer) SetSpendingLimit(context.Context, *MsgSetSpendingLimit) (*MsgSetSpendingLimitResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetSpendingLimit not implemented")
+}
+func (UnimplementedMsgServer) SetDemurrageSchedule(context.Context, *MsgSetDemurrageSchedule) (*MsgSetDemurrageScheduleResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetDemurrageSchedule not implemented")
+}
 func (UnimplementedMsgServer) mustEmbedUnimplementedMsgServer() {}
 
 // UnsafeMsgServer may be embedded to opt out of forward compatibility for this service.
@@ -215,6 +474,204 @@ func _Msg_SetSendEnabled_Handler(srv interface{}, ctx context.Context, dec func(
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_UpdateDenomMetadata_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUpdateDenomMetadata)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).UpdateDenomMetadata(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_UpdateDenomMetadata_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UpdateDenomMetadata(ctx, req.(*MsgUpdateDenomMetadata))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_CreateBalanceSnapshot_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgCreateBalanceSnapshot)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).CreateBalanceSnapshot(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_CreateBalanceSnapshot_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).CreateBalanceSnapshot(ctx, req.(*MsgCreateBalanceSnapshot))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_DeleteBalanceSnapshot_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgDeleteBalanceSnapshot)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).DeleteBalanceSnapshot(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_DeleteBalanceSnapshot_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).DeleteBalanceSnapshot(ctx, req.(*MsgDeleteBalanceSnapshot))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_BatchSend_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgBatchSend)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).BatchSend(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_BatchSend_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).BatchSend(ctx, req.(*MsgBatchSend))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_SetMintBurnPermissions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSetMintBurnPermissions)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SetMintBurnPermissions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_SetMintBurnPermissions_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SetMintBurnPermissions(ctx, req.(*MsgSetMintBurnPermissions))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_SetDustSweepOptIn_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSetDustSweepOptIn)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SetDustSweepOptIn(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_SetDustSweepOptIn_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SetDustSweepOptIn(ctx, req.(*MsgSetDustSweepOptIn))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_SendWithTimelock_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSendWithTimelock)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SendWithTimelock(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_SendWithTimelock_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SendWithTimelock(ctx, req.(*MsgSendWithTimelock))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_ClaimTimelockedTransfer_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgClaimTimelockedTransfer)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).ClaimTimelockedTransfer(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_ClaimTimelockedTransfer_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).ClaimTimelockedTransfer(ctx, req.(*MsgClaimTimelockedTransfer))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_ReclaimTimelockedTransfer_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgReclaimTimelockedTransfer)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).ReclaimTimelockedTransfer(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_ReclaimTimelockedTransfer_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).ReclaimTimelockedTransfer(ctx, req.(*MsgReclaimTimelockedTransfer))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_SetSpendingLimit_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSetSpendingLimit)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SetSpendingLimit(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_SetSpendingLimit_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SetSpendingLimit(ctx, req.(*MsgSetSpendingLimit))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_SetDemurrageSchedule_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSetDemurrageSchedule)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SetDemurrageSchedule(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_SetDemurrageSchedule_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SetDemurrageSchedule(ctx, req.(*MsgSetDemurrageSchedule))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // Msg_ServiceDesc is the grpc.ServiceDesc for Msg service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -238,6 +695,50 @@ var Msg_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "SetSendEnabled",
 			Handler:    _Msg_SetSendEnabled_Handler,
 		},
+		{
+			MethodName: "UpdateDenomMetadata",
+			Handler:    _Msg_UpdateDenomMetadata_Handler,
+		},
+		{
+			MethodName: "CreateBalanceSnapshot",
+			Handler:    _Msg_CreateBalanceSnapshot_Handler,
+		},
+		{
+			MethodName: "DeleteBalanceSnapshot",
+			Handler:    _Msg_DeleteBalanceSnapshot_Handler,
+		},
+		{
+			MethodName: "BatchSend",
+			Handler:    _Msg_BatchSend_Handler,
+		},
+		{
+			MethodName: "SetMintBurnPermissions",
+			Handler:    _Msg_SetMintBurnPermissions_Handler,
+		},
+		{
+			MethodName: "SetDustSweepOptIn",
+			Handler:    _Msg_SetDustSweepOptIn_Handler,
+		},
+		{
+			MethodName: "SendWithTimelock",
+			Handler:    _Msg_SendWithTimelock_Handler,
+		},
+		{
+			MethodName: "ClaimTimelockedTransfer",
+			Handler:    _Msg_ClaimTimelockedTransfer_Handler,
+		},
+		{
+			MethodName: "ReclaimTimelockedTransfer",
+			Handler:    _Msg_ReclaimTimelockedTransfer_Handler,
+		},
+		{
+			MethodName: "SetSpendingLimit",
+			Handler:    _Msg_SetSpendingLimit_Handler,
+		},
+		{
+			MethodName: "SetDemurrageSchedule",
+			Handler:    _Msg_SetDemurrageSchedule_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "cosmos/bank/v1beta1/tx.proto",
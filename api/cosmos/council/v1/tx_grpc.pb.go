@@ -0,0 +1,279 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.3.0
+// - protoc             (unknown)
+// source: cosmos/council/v1/tx.proto
+
+package councilv1
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.32.0 or later.
+const _ = grpc.SupportPackageIsVersion7
+
+const (
+	Msg_TripCircuit_FullMethodName      = "/cosmos.council.v1.Msg/TripCircuit"
+	Msg_PauseModule_FullMethodName      = "/cosmos.council.v1.Msg/PauseModule"
+	Msg_ExpediteProposal_FullMethodName = "/cosmos.council.v1.Msg/ExpediteProposal"
+	Msg_RevokeAction_FullMethodName     = "/cosmos.council.v1.Msg/RevokeAction"
+	Msg_UpdateParams_FullMethodName     = "/cosmos.council.v1.Msg/UpdateParams"
+)
+
+// MsgClient is the client API for Msg service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type MsgClient interface {
+	// TripCircuit defines an operation for a council member to reject the
+	// transactions containing a given message type until the action expires.
+	TripCircuit(ctx context.Context, in *MsgTripCircuit, opts ...grpc.CallOption) (*MsgTripCircuitResponse, error)
+	// PauseModule defines an operation for a council member to pause one of the
+	// pausable modules until the action expires.
+	PauseModule(ctx context.Context, in *MsgPauseModule, opts ...grpc.CallOption) (*MsgPauseModuleResponse, error)
+	// ExpediteProposal defines an operation for a council member to expedite a
+	// governance proposal which is in its discussion or voting period.
+	ExpediteProposal(ctx context.Context, in *MsgExpediteProposal, opts ...grpc.CallOption) (*MsgExpediteProposalResponse, error)
+	// RevokeAction defines a governance operation for revoking an action taken
+	// by the council before its expiry, undoing its effects.
+	RevokeAction(ctx context.Context, in *MsgRevokeAction, opts ...grpc.CallOption) (*MsgRevokeActionResponse, error)
+	// UpdateParams defines a governance operation for updating the x/council
+	// module parameters, including the council members. The authority is
+	// defined in the keeper.
+	UpdateParams(ctx context.Context, in *MsgUpdateParams, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error)
+}
+
+type msgClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewMsgClient(cc grpc.ClientConnInterface) MsgClient {
+	return &msgClient{cc}
+}
+
+func (c *msgClient) TripCircuit(ctx context.Context, in *MsgTripCircuit, opts ...grpc.CallOption) (*MsgTripCircuitResponse, error) {
+	out := new(MsgTripCircuitResponse)
+	err := c.cc.Invoke(ctx, Msg_TripCircuit_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) PauseModule(ctx context.Context, in *MsgPauseModule, opts ...grpc.CallOption) (*MsgPauseModuleResponse, error) {
+	out := new(MsgPauseModuleResponse)
+	err := c.cc.Invoke(ctx, Msg_PauseModule_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) ExpediteProposal(ctx context.Context, in *MsgExpediteProposal, opts ...grpc.CallOption) (*MsgExpediteProposalResponse, error) {
+	out := new(MsgExpediteProposalResponse)
+	err := c.cc.Invoke(ctx, Msg_ExpediteProposal_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) RevokeAction(ctx context.Context, in *MsgRevokeAction, opts ...grpc.CallOption) (*MsgRevokeActionResponse, error) {
+	out := new(MsgRevokeActionResponse)
+	err := c.cc.Invoke(ctx, Msg_RevokeAction_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) UpdateParams(ctx context.Context, in *MsgUpdateParams, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error) {
+	out := new(MsgUpdateParamsResponse)
+	err := c.cc.Invoke(ctx, Msg_UpdateParams_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// MsgServer is the server API for Msg service.
+// All implementations must embed UnimplementedMsgServer
+// for forward compatibility
+type MsgServer interface {
+	// TripCircuit defines an operation for a council member to reject the
+	// transactions containing a given message type until the action expires.
+	TripCircuit(context.Context, *MsgTripCircuit) (*MsgTripCircuitResponse, error)
+	// PauseModule defines an operation for a council member to pause one of the
+	// pausable modules until the action expires.
+	PauseModule(context.Context, *MsgPauseModule) (*MsgPauseModuleResponse, error)
+	// ExpediteProposal defines an operation for a council member to expedite a
+	// governance proposal which is in its discussion or voting period.
+	ExpediteProposal(context.Context, *MsgExpediteProposal) (*MsgExpediteProposalResponse, error)
+	// RevokeAction defines a governance operation for revoking an action taken
+	// by the council before its expiry, undoing its effects.
+	RevokeAction(context.Context, *MsgRevokeAction) (*MsgRevokeActionResponse, error)
+	// UpdateParams defines a governance operation for updating the x/council
+	// module parameters, including the council members. The authority is
+	// defined in the keeper.
+	UpdateParams(context.Context, *MsgUpdateParams) (*MsgUpdateParamsResponse, error)
+	mustEmbedUnimplementedMsgServer()
+}
+
+// UnimplementedMsgServer must be embedded to have forward compatible implementations.
+type UnimplementedMsgServer struct {
+}
+
+func (UnimplementedMsgServer) TripCircuit(context.Context, *MsgTripCircuit) (*MsgTripCircuitResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TripCircuit not implemented")
+}
+func (UnimplementedMsgServer) PauseModule(context.Context, *MsgPauseModule) (*MsgPauseModuleResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PauseModule not implemented")
+}
+func (UnimplementedMsgServer) ExpediteProposal(context.Context, *MsgExpediteProposal) (*MsgExpediteProposalResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ExpediteProposal not implemented")
+}
+func (UnimplementedMsgServer) RevokeAction(context.Context, *MsgRevokeAction) (*MsgRevokeActionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RevokeAction not implemented")
+}
+func (UnimplementedMsgServer) UpdateParams(context.Context, *MsgUpdateParams) (*MsgUpdateParamsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateParams not implemented")
+}
+func (UnimplementedMsgServer) mustEmbedUnimplementedMsgServer() {}
+
+// UnsafeMsgServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to MsgServer will
+// result in compilation errors.
+type UnsafeMsgServer interface {
+	mustEmbedUnimplementedMsgServer()
+}
+
+func RegisterMsgServer(s grpc.ServiceRegistrar, srv MsgServer) {
+	s.RegisterService(&Msg_ServiceDesc, srv)
+}
+
+func _Msg_TripCircuit_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgTripCircuit)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).TripCircuit(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_TripCircuit_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).TripCircuit(ctx, req.(*MsgTripCircuit))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_PauseModule_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgPauseModule)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).PauseModule(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_PauseModule_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).PauseModule(ctx, req.(*MsgPauseModule))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_ExpediteProposal_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgExpediteProposal)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).ExpediteProposal(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_ExpediteProposal_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).ExpediteProposal(ctx, req.(*MsgExpediteProposal))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_RevokeAction_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgRevokeAction)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).RevokeAction(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_RevokeAction_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).RevokeAction(ctx, req.(*MsgRevokeAction))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_UpdateParams_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUpdateParams)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).UpdateParams(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_UpdateParams_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UpdateParams(ctx, req.(*MsgUpdateParams))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// Msg_ServiceDesc is the grpc.ServiceDesc for Msg service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var Msg_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "cosmos.council.v1.Msg",
+	HandlerType: (*MsgServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "TripCircuit",
+			Handler:    _Msg_TripCircuit_Handler,
+		},
+		{
+			MethodName: "PauseModule",
+			Handler:    _Msg_PauseModule_Handler,
+		},
+		{
+			MethodName: "ExpediteProposal",
+			Handler:    _Msg_ExpediteProposal_Handler,
+		},
+		{
+			MethodName: "RevokeAction",
+			Handler:    _Msg_RevokeAction_Handler,
+		},
+		{
+			MethodName: "UpdateParams",
+			Handler:    _Msg_UpdateParams_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "cosmos/council/v1/tx.proto",
+}
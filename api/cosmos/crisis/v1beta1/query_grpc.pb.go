@@ -0,0 +1,154 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.3.0
+// - protoc             (unknown)
+// source: cosmos/crisis/v1beta1/query.proto
+
+package crisisv1beta1
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.32.0 or later.
+const _ = grpc.SupportPackageIsVersion7
+
+const (
+	Query_Invariants_FullMethodName      = "/cosmos.crisis.v1beta1.Query/Invariants"
+	Query_CheckInvariants_FullMethodName = "/cosmos.crisis.v1beta1.Query/CheckInvariants"
+)
+
+// QueryClient is the client API for Query service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type QueryClient interface {
+	// Invariants returns the registered invariant routes.
+	Invariants(ctx context.Context, in *QueryInvariantsRequest, opts ...grpc.CallOption) (*QueryInvariantsResponse, error)
+	// CheckInvariants runs a full scan of the registered invariants, or of the
+	// ones of a module, against the latest state and returns their results.
+	// Broken invariants are reported without halting the chain.
+	CheckInvariants(ctx context.Context, in *QueryCheckInvariantsRequest, opts ...grpc.CallOption) (*QueryCheckInvariantsResponse, error)
+}
+
+type queryClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewQueryClient(cc grpc.ClientConnInterface) QueryClient {
+	return &queryClient{cc}
+}
+
+func (c *queryClient) Invariants(ctx context.Context, in *QueryInvariantsRequest, opts ...grpc.CallOption) (*QueryInvariantsResponse, error) {
+	out := new(QueryInvariantsResponse)
+	err := c.cc.Invoke(ctx, Query_Invariants_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) CheckInvariants(ctx context.Context, in *QueryCheckInvariantsRequest, opts ...grpc.CallOption) (*QueryCheckInvariantsResponse, error) {
+	out := new(QueryCheckInvariantsResponse)
+	err := c.cc.Invoke(ctx, Query_CheckInvariants_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// QueryServer is the server API for Query service.
+// All implementations must embed UnimplementedQueryServer
+// for forward compatibility
+type QueryServer interface {
+	// Invariants returns the registered invariant routes.
+	Invariants(context.Context, *QueryInvariantsRequest) (*QueryInvariantsResponse, error)
+	// CheckInvariants runs a full scan of the registered invariants, or of the
+	// ones of a module, against the latest state and returns their results.
+	// Broken invariants are reported without halting the chain.
+	CheckInvariants(context.Context, *QueryCheckInvariantsRequest) (*QueryCheckInvariantsResponse, error)
+	mustEmbedUnimplementedQueryServer()
+}
+
+// UnimplementedQueryServer must be embedded to have forward compatible implementations.
+type UnimplementedQueryServer struct {
+}
+
+func (UnimplementedQueryServer) Invariants(context.Context, *QueryInvariantsRequest) (*QueryInvariantsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Invariants not implemented")
+}
+func (UnimplementedQueryServer) CheckInvariants(context.Context, *QueryCheckInvariantsRequest) (*QueryCheckInvariantsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CheckInvariants not implemented")
+}
+func (UnimplementedQueryServer) mustEmbedUnimplementedQueryServer() {}
+
+// UnsafeQueryServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to QueryServer will
+// result in compilation errors.
+type UnsafeQueryServer interface {
+	mustEmbedUnimplementedQueryServer()
+}
+
+func RegisterQueryServer(s grpc.ServiceRegistrar, srv QueryServer) {
+	s.RegisterService(&Query_ServiceDesc, srv)
+}
+
+func _Query_Invariants_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryInvariantsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Invariants(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_Invariants_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Invariants(ctx, req.(*QueryInvariantsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_CheckInvariants_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryCheckInvariantsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).CheckInvariants(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_CheckInvariants_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).CheckInvariants(ctx, req.(*QueryCheckInvariantsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// Query_ServiceDesc is the grpc.ServiceDesc for Query service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var Query_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "cosmos.crisis.v1beta1.Query",
+	HandlerType: (*QueryServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Invariants",
+			Handler:    _Query_Invariants_Handler,
+		},
+		{
+			MethodName: "CheckInvariants",
+			Handler:    _Query_CheckInvariants_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "cosmos/crisis/v1beta1/query.proto",
+}
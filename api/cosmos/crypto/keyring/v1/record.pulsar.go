@@ -964,7 +964,7 @@ func (x *Record_Local) ProtoReflect() protoreflect.Message {
 }
 
 func (x *Record_Local) slowProtoReflect() protoreflect.Message {
-	mi := &file_cosmos_crypto_keyring_v1_record_proto_msgTypes[1]
+	mi := &file_cosmos_crypto_keyring_v1_record_proto_msgTypes[2]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1399,7 +1399,7 @@ func (x *Record_Ledger) ProtoReflect() protoreflect.Message {
 }
 
 func (x *Record_Ledger) slowProtoReflect() protoreflect.Message {
-	mi := &file_cosmos_crypto_keyring_v1_record_proto_msgTypes[2]
+	mi := &file_cosmos_crypto_keyring_v1_record_proto_msgTypes[3]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1832,7 +1832,7 @@ func (x *Record_Multi) ProtoReflect() protoreflect.Message {
 }
 
 func (x *Record_Multi) slowProtoReflect() protoreflect.Message {
-	mi := &file_cosmos_crypto_keyring_v1_record_proto_msgTypes[3]
+	mi := &file_cosmos_crypto_keyring_v1_record_proto_msgTypes[4]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2188,7 +2188,7 @@ func (x *Record_Offline) ProtoReflect() protoreflect.Message {
 }
 
 func (x *Record_Offline) slowProtoReflect() protoreflect.Message {
-	mi := &file_cosmos_crypto_keyring_v1_record_proto_msgTypes[4]
+	mi := &file_cosmos_crypto_keyring_v1_record_proto_msgTypes[5]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2526,245 +2526,1341 @@ func (x *fastReflection_Record_Offline) ProtoMethods() *protoiface.Methods {
 	}
 }
 
-// Since: cosmos-sdk 0.46
-
-// Code generated by protoc-gen-go. DO NOT EDIT.
-// versions:
-// 	protoc-gen-go v1.27.0
-// 	protoc        (unknown)
-// source: cosmos/crypto/keyring/v1/record.proto
-
-const (
-	// Verify that this generated code is sufficiently up-to-date.
-	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
-	// Verify that runtime/protoimpl is sufficiently up-to-date.
-	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
-)
-
-// Record is used for representing a key in the keyring.
-type Record struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
-
-	// name represents a name of Record
-	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
-	// pub_key represents a public key in any format
-	PubKey *anypb.Any `protobuf:"bytes,2,opt,name=pub_key,json=pubKey,proto3" json:"pub_key,omitempty"`
-	// Record contains one of the following items
-	//
-	// Types that are assignable to Item:
-	//
-	//	*Record_Local_
-	//	*Record_Ledger_
-	//	*Record_Multi_
-	//	*Record_Offline_
-	Item isRecord_Item `protobuf_oneof:"item"`
-}
-
-func (x *Record) Reset() {
-	*x = Record{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_cosmos_crypto_keyring_v1_record_proto_msgTypes[0]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
-	}
-}
-
-func (x *Record) String() string {
-	return protoimpl.X.MessageStringOf(x)
-}
-
-func (*Record) ProtoMessage() {}
-
-// Deprecated: Use Record.ProtoReflect.Descriptor instead.
-func (*Record) Descriptor() ([]byte, []int) {
-	return file_cosmos_crypto_keyring_v1_record_proto_rawDescGZIP(), []int{0}
-}
+var _ protoreflect.List = (*_RecordBundle_1_list)(nil)
 
-func (x *Record) GetName() string {
-	if x != nil {
-		return x.Name
-	}
-	return ""
+type _RecordBundle_1_list struct {
+	list *[]*RecordBundle_Entry
 }
 
-func (x *Record) GetPubKey() *anypb.Any {
-	if x != nil {
-		return x.PubKey
+func (x *_RecordBundle_1_list) Len() int {
+	if x.list == nil {
+		return 0
 	}
-	return nil
+	return len(*x.list)
 }
 
-func (x *Record) GetItem() isRecord_Item {
-	if x != nil {
-		return x.Item
-	}
-	return nil
+func (x *_RecordBundle_1_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
 }
 
-func (x *Record) GetLocal() *Record_Local {
-	if x, ok := x.GetItem().(*Record_Local_); ok {
-		return x.Local
-	}
-	return nil
+func (x *_RecordBundle_1_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*RecordBundle_Entry)
+	(*x.list)[i] = concreteValue
 }
 
-func (x *Record) GetLedger() *Record_Ledger {
-	if x, ok := x.GetItem().(*Record_Ledger_); ok {
-		return x.Ledger
-	}
-	return nil
+func (x *_RecordBundle_1_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*RecordBundle_Entry)
+	*x.list = append(*x.list, concreteValue)
 }
 
-func (x *Record) GetMulti() *Record_Multi {
-	if x, ok := x.GetItem().(*Record_Multi_); ok {
-		return x.Multi
-	}
-	return nil
+func (x *_RecordBundle_1_list) AppendMutable() protoreflect.Value {
+	v := new(RecordBundle_Entry)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
 }
 
-func (x *Record) GetOffline() *Record_Offline {
-	if x, ok := x.GetItem().(*Record_Offline_); ok {
-		return x.Offline
+func (x *_RecordBundle_1_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
 	}
-	return nil
-}
-
-type isRecord_Item interface {
-	isRecord_Item()
+	*x.list = (*x.list)[:n]
 }
 
-type Record_Local_ struct {
-	// local stores the private key locally.
-	Local *Record_Local `protobuf:"bytes,3,opt,name=local,proto3,oneof"`
+func (x *_RecordBundle_1_list) NewElement() protoreflect.Value {
+	v := new(RecordBundle_Entry)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
 }
 
-type Record_Ledger_ struct {
-	// ledger stores the information about a Ledger key.
-	Ledger *Record_Ledger `protobuf:"bytes,4,opt,name=ledger,proto3,oneof"`
+func (x *_RecordBundle_1_list) IsValid() bool {
+	return x.list != nil
 }
 
-type Record_Multi_ struct {
-	// Multi does not store any other information.
-	Multi *Record_Multi `protobuf:"bytes,5,opt,name=multi,proto3,oneof"`
-}
+var (
+	md_RecordBundle         protoreflect.MessageDescriptor
+	fd_RecordBundle_entries protoreflect.FieldDescriptor
+)
 
-type Record_Offline_ struct {
-	// Offline does not store any other information.
-	Offline *Record_Offline `protobuf:"bytes,6,opt,name=offline,proto3,oneof"`
+func init() {
+	file_cosmos_crypto_keyring_v1_record_proto_init()
+	md_RecordBundle = File_cosmos_crypto_keyring_v1_record_proto.Messages().ByName("RecordBundle")
+	fd_RecordBundle_entries = md_RecordBundle.Fields().ByName("entries")
 }
 
-func (*Record_Local_) isRecord_Item() {}
-
-func (*Record_Ledger_) isRecord_Item() {}
-
-func (*Record_Multi_) isRecord_Item() {}
-
-func (*Record_Offline_) isRecord_Item() {}
+var _ protoreflect.Message = (*fastReflection_RecordBundle)(nil)
 
-// Item is a keyring item stored in a keyring backend.
-// Local item
-type Record_Local struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
+type fastReflection_RecordBundle RecordBundle
 
-	PrivKey *anypb.Any `protobuf:"bytes,1,opt,name=priv_key,json=privKey,proto3" json:"priv_key,omitempty"`
+func (x *RecordBundle) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_RecordBundle)(x)
 }
 
-func (x *Record_Local) Reset() {
-	*x = Record_Local{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_cosmos_crypto_keyring_v1_record_proto_msgTypes[1]
+func (x *RecordBundle) slowProtoReflect() protoreflect.Message {
+	mi := &file_cosmos_crypto_keyring_v1_record_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
+	return mi.MessageOf(x)
 }
 
-func (x *Record_Local) String() string {
-	return protoimpl.X.MessageStringOf(x)
-}
+var _fastReflection_RecordBundle_messageType fastReflection_RecordBundle_messageType
+var _ protoreflect.MessageType = fastReflection_RecordBundle_messageType{}
 
-func (*Record_Local) ProtoMessage() {}
+type fastReflection_RecordBundle_messageType struct{}
 
-// Deprecated: Use Record_Local.ProtoReflect.Descriptor instead.
-func (*Record_Local) Descriptor() ([]byte, []int) {
-	return file_cosmos_crypto_keyring_v1_record_proto_rawDescGZIP(), []int{0, 0}
+func (x fastReflection_RecordBundle_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_RecordBundle)(nil)
 }
-
-func (x *Record_Local) GetPrivKey() *anypb.Any {
-	if x != nil {
-		return x.PrivKey
-	}
-	return nil
+func (x fastReflection_RecordBundle_messageType) New() protoreflect.Message {
+	return new(fastReflection_RecordBundle)
 }
-
-// Ledger item
-type Record_Ledger struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
-
-	Path *v1.BIP44Params `protobuf:"bytes,1,opt,name=path,proto3" json:"path,omitempty"`
+func (x fastReflection_RecordBundle_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_RecordBundle
 }
 
-func (x *Record_Ledger) Reset() {
-	*x = Record_Ledger{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_cosmos_crypto_keyring_v1_record_proto_msgTypes[2]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
-	}
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_RecordBundle) Descriptor() protoreflect.MessageDescriptor {
+	return md_RecordBundle
 }
 
-func (x *Record_Ledger) String() string {
-	return protoimpl.X.MessageStringOf(x)
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_RecordBundle) Type() protoreflect.MessageType {
+	return _fastReflection_RecordBundle_messageType
 }
 
-func (*Record_Ledger) ProtoMessage() {}
-
-// Deprecated: Use Record_Ledger.ProtoReflect.Descriptor instead.
-func (*Record_Ledger) Descriptor() ([]byte, []int) {
-	return file_cosmos_crypto_keyring_v1_record_proto_rawDescGZIP(), []int{0, 1}
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_RecordBundle) New() protoreflect.Message {
+	return new(fastReflection_RecordBundle)
 }
 
-func (x *Record_Ledger) GetPath() *v1.BIP44Params {
-	if x != nil {
-		return x.Path
-	}
-	return nil
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_RecordBundle) Interface() protoreflect.ProtoMessage {
+	return (*RecordBundle)(x)
 }
 
-// Multi item
-type Record_Multi struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_RecordBundle) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if len(x.Entries) != 0 {
+		value := protoreflect.ValueOfList(&_RecordBundle_1_list{list: &x.Entries})
+		if !f(fd_RecordBundle_entries, value) {
+			return
+		}
+	}
 }
 
-func (x *Record_Multi) Reset() {
-	*x = Record_Multi{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_cosmos_crypto_keyring_v1_record_proto_msgTypes[3]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_RecordBundle) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "cosmos.crypto.keyring.v1.RecordBundle.entries":
+		return len(x.Entries) != 0
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.crypto.keyring.v1.RecordBundle"))
+		}
+		panic(fmt.Errorf("message cosmos.crypto.keyring.v1.RecordBundle does not contain field %s", fd.FullName()))
 	}
 }
 
-func (x *Record_Multi) String() string {
-	return protoimpl.X.MessageStringOf(x)
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_RecordBundle) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "cosmos.crypto.keyring.v1.RecordBundle.entries":
+		x.Entries = nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.crypto.keyring.v1.RecordBundle"))
+		}
+		panic(fmt.Errorf("message cosmos.crypto.keyring.v1.RecordBundle does not contain field %s", fd.FullName()))
+	}
 }
 
-func (*Record_Multi) ProtoMessage() {}
-
-// Deprecated: Use Record_Multi.ProtoReflect.Descriptor instead.
-func (*Record_Multi) Descriptor() ([]byte, []int) {
-	return file_cosmos_crypto_keyring_v1_record_proto_rawDescGZIP(), []int{0, 2}
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_RecordBundle) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "cosmos.crypto.keyring.v1.RecordBundle.entries":
+		if len(x.Entries) == 0 {
+			return protoreflect.ValueOfList(&_RecordBundle_1_list{})
+		}
+		listValue := &_RecordBundle_1_list{list: &x.Entries}
+		return protoreflect.ValueOfList(listValue)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.crypto.keyring.v1.RecordBundle"))
+		}
+		panic(fmt.Errorf("message cosmos.crypto.keyring.v1.RecordBundle does not contain field %s", descriptor.FullName()))
+	}
 }
 
-// Offline item
-type Record_Offline struct {
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_RecordBundle) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "cosmos.crypto.keyring.v1.RecordBundle.entries":
+		lv := value.List()
+		clv := lv.(*_RecordBundle_1_list)
+		x.Entries = *clv.list
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.crypto.keyring.v1.RecordBundle"))
+		}
+		panic(fmt.Errorf("message cosmos.crypto.keyring.v1.RecordBundle does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_RecordBundle) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "cosmos.crypto.keyring.v1.RecordBundle.entries":
+		if x.Entries == nil {
+			x.Entries = []*RecordBundle_Entry{}
+		}
+		value := &_RecordBundle_1_list{list: &x.Entries}
+		return protoreflect.ValueOfList(value)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.crypto.keyring.v1.RecordBundle"))
+		}
+		panic(fmt.Errorf("message cosmos.crypto.keyring.v1.RecordBundle does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_RecordBundle) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "cosmos.crypto.keyring.v1.RecordBundle.entries":
+		list := []*RecordBundle_Entry{}
+		return protoreflect.ValueOfList(&_RecordBundle_1_list{list: &list})
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.crypto.keyring.v1.RecordBundle"))
+		}
+		panic(fmt.Errorf("message cosmos.crypto.keyring.v1.RecordBundle does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_RecordBundle) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in cosmos.crypto.keyring.v1.RecordBundle", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_RecordBundle) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_RecordBundle) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_RecordBundle) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_RecordBundle) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*RecordBundle)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if len(x.Entries) > 0 {
+			for _, e := range x.Entries {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*RecordBundle)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if len(x.Entries) > 0 {
+			for iNdEx := len(x.Entries) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.Entries[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0xa
+			}
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*RecordBundle)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: RecordBundle: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: RecordBundle: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Entries", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Entries = append(x.Entries, &RecordBundle_Entry{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Entries[len(x.Entries)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_RecordBundle_Entry         protoreflect.MessageDescriptor
+	fd_RecordBundle_Entry_record  protoreflect.FieldDescriptor
+	fd_RecordBundle_Entry_algo    protoreflect.FieldDescriptor
+	fd_RecordBundle_Entry_hd_path protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_cosmos_crypto_keyring_v1_record_proto_init()
+	md_RecordBundle_Entry = File_cosmos_crypto_keyring_v1_record_proto.Messages().ByName("RecordBundle").Messages().ByName("Entry")
+	fd_RecordBundle_Entry_record = md_RecordBundle_Entry.Fields().ByName("record")
+	fd_RecordBundle_Entry_algo = md_RecordBundle_Entry.Fields().ByName("algo")
+	fd_RecordBundle_Entry_hd_path = md_RecordBundle_Entry.Fields().ByName("hd_path")
+}
+
+var _ protoreflect.Message = (*fastReflection_RecordBundle_Entry)(nil)
+
+type fastReflection_RecordBundle_Entry RecordBundle_Entry
+
+func (x *RecordBundle_Entry) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_RecordBundle_Entry)(x)
+}
+
+func (x *RecordBundle_Entry) slowProtoReflect() protoreflect.Message {
+	mi := &file_cosmos_crypto_keyring_v1_record_proto_msgTypes[6]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_RecordBundle_Entry_messageType fastReflection_RecordBundle_Entry_messageType
+var _ protoreflect.MessageType = fastReflection_RecordBundle_Entry_messageType{}
+
+type fastReflection_RecordBundle_Entry_messageType struct{}
+
+func (x fastReflection_RecordBundle_Entry_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_RecordBundle_Entry)(nil)
+}
+func (x fastReflection_RecordBundle_Entry_messageType) New() protoreflect.Message {
+	return new(fastReflection_RecordBundle_Entry)
+}
+func (x fastReflection_RecordBundle_Entry_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_RecordBundle_Entry
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_RecordBundle_Entry) Descriptor() protoreflect.MessageDescriptor {
+	return md_RecordBundle_Entry
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_RecordBundle_Entry) Type() protoreflect.MessageType {
+	return _fastReflection_RecordBundle_Entry_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_RecordBundle_Entry) New() protoreflect.Message {
+	return new(fastReflection_RecordBundle_Entry)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_RecordBundle_Entry) Interface() protoreflect.ProtoMessage {
+	return (*RecordBundle_Entry)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_RecordBundle_Entry) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Record != nil {
+		value := protoreflect.ValueOfMessage(x.Record.ProtoReflect())
+		if !f(fd_RecordBundle_Entry_record, value) {
+			return
+		}
+	}
+	if x.Algo != "" {
+		value := protoreflect.ValueOfString(x.Algo)
+		if !f(fd_RecordBundle_Entry_algo, value) {
+			return
+		}
+	}
+	if x.HdPath != "" {
+		value := protoreflect.ValueOfString(x.HdPath)
+		if !f(fd_RecordBundle_Entry_hd_path, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_RecordBundle_Entry) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "cosmos.crypto.keyring.v1.RecordBundle.Entry.record":
+		return x.Record != nil
+	case "cosmos.crypto.keyring.v1.RecordBundle.Entry.algo":
+		return x.Algo != ""
+	case "cosmos.crypto.keyring.v1.RecordBundle.Entry.hd_path":
+		return x.HdPath != ""
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.crypto.keyring.v1.RecordBundle.Entry"))
+		}
+		panic(fmt.Errorf("message cosmos.crypto.keyring.v1.RecordBundle.Entry does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_RecordBundle_Entry) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "cosmos.crypto.keyring.v1.RecordBundle.Entry.record":
+		x.Record = nil
+	case "cosmos.crypto.keyring.v1.RecordBundle.Entry.algo":
+		x.Algo = ""
+	case "cosmos.crypto.keyring.v1.RecordBundle.Entry.hd_path":
+		x.HdPath = ""
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.crypto.keyring.v1.RecordBundle.Entry"))
+		}
+		panic(fmt.Errorf("message cosmos.crypto.keyring.v1.RecordBundle.Entry does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_RecordBundle_Entry) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "cosmos.crypto.keyring.v1.RecordBundle.Entry.record":
+		value := x.Record
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "cosmos.crypto.keyring.v1.RecordBundle.Entry.algo":
+		value := x.Algo
+		return protoreflect.ValueOfString(value)
+	case "cosmos.crypto.keyring.v1.RecordBundle.Entry.hd_path":
+		value := x.HdPath
+		return protoreflect.ValueOfString(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.crypto.keyring.v1.RecordBundle.Entry"))
+		}
+		panic(fmt.Errorf("message cosmos.crypto.keyring.v1.RecordBundle.Entry does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_RecordBundle_Entry) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "cosmos.crypto.keyring.v1.RecordBundle.Entry.record":
+		x.Record = value.Message().Interface().(*Record)
+	case "cosmos.crypto.keyring.v1.RecordBundle.Entry.algo":
+		x.Algo = value.Interface().(string)
+	case "cosmos.crypto.keyring.v1.RecordBundle.Entry.hd_path":
+		x.HdPath = value.Interface().(string)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.crypto.keyring.v1.RecordBundle.Entry"))
+		}
+		panic(fmt.Errorf("message cosmos.crypto.keyring.v1.RecordBundle.Entry does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_RecordBundle_Entry) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "cosmos.crypto.keyring.v1.RecordBundle.Entry.record":
+		if x.Record == nil {
+			x.Record = new(Record)
+		}
+		return protoreflect.ValueOfMessage(x.Record.ProtoReflect())
+	case "cosmos.crypto.keyring.v1.RecordBundle.Entry.algo":
+		panic(fmt.Errorf("field algo of message cosmos.crypto.keyring.v1.RecordBundle.Entry is not mutable"))
+	case "cosmos.crypto.keyring.v1.RecordBundle.Entry.hd_path":
+		panic(fmt.Errorf("field hd_path of message cosmos.crypto.keyring.v1.RecordBundle.Entry is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.crypto.keyring.v1.RecordBundle.Entry"))
+		}
+		panic(fmt.Errorf("message cosmos.crypto.keyring.v1.RecordBundle.Entry does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_RecordBundle_Entry) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "cosmos.crypto.keyring.v1.RecordBundle.Entry.record":
+		m := new(Record)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case "cosmos.crypto.keyring.v1.RecordBundle.Entry.algo":
+		return protoreflect.ValueOfString("")
+	case "cosmos.crypto.keyring.v1.RecordBundle.Entry.hd_path":
+		return protoreflect.ValueOfString("")
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.crypto.keyring.v1.RecordBundle.Entry"))
+		}
+		panic(fmt.Errorf("message cosmos.crypto.keyring.v1.RecordBundle.Entry does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_RecordBundle_Entry) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in cosmos.crypto.keyring.v1.RecordBundle.Entry", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_RecordBundle_Entry) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_RecordBundle_Entry) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_RecordBundle_Entry) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_RecordBundle_Entry) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*RecordBundle_Entry)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if x.Record != nil {
+			l = options.Size(x.Record)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.Algo)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.HdPath)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*RecordBundle_Entry)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if len(x.HdPath) > 0 {
+			i -= len(x.HdPath)
+			copy(dAtA[i:], x.HdPath)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.HdPath)))
+			i--
+			dAtA[i] = 0x1a
+		}
+		if len(x.Algo) > 0 {
+			i -= len(x.Algo)
+			copy(dAtA[i:], x.Algo)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Algo)))
+			i--
+			dAtA[i] = 0x12
+		}
+		if x.Record != nil {
+			encoded, err := options.Marshal(x.Record)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*RecordBundle_Entry)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: RecordBundle_Entry: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: RecordBundle_Entry: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Record", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.Record == nil {
+					x.Record = &Record{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Record); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Algo", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Algo = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 3:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field HdPath", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.HdPath = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+// Since: cosmos-sdk 0.46
+
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.27.0
+// 	protoc        (unknown)
+// source: cosmos/crypto/keyring/v1/record.proto
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// Record is used for representing a key in the keyring.
+type Record struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// name represents a name of Record
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// pub_key represents a public key in any format
+	PubKey *anypb.Any `protobuf:"bytes,2,opt,name=pub_key,json=pubKey,proto3" json:"pub_key,omitempty"`
+	// Record contains one of the following items
+	//
+	// Types that are assignable to Item:
+	//	*Record_Local_
+	//	*Record_Ledger_
+	//	*Record_Multi_
+	//	*Record_Offline_
+	Item isRecord_Item `protobuf_oneof:"item"`
+}
+
+func (x *Record) Reset() {
+	*x = Record{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cosmos_crypto_keyring_v1_record_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Record) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Record) ProtoMessage() {}
+
+// Deprecated: Use Record.ProtoReflect.Descriptor instead.
+func (*Record) Descriptor() ([]byte, []int) {
+	return file_cosmos_crypto_keyring_v1_record_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *Record) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Record) GetPubKey() *anypb.Any {
+	if x != nil {
+		return x.PubKey
+	}
+	return nil
+}
+
+func (x *Record) GetItem() isRecord_Item {
+	if x != nil {
+		return x.Item
+	}
+	return nil
+}
+
+func (x *Record) GetLocal() *Record_Local {
+	if x, ok := x.GetItem().(*Record_Local_); ok {
+		return x.Local
+	}
+	return nil
+}
+
+func (x *Record) GetLedger() *Record_Ledger {
+	if x, ok := x.GetItem().(*Record_Ledger_); ok {
+		return x.Ledger
+	}
+	return nil
+}
+
+func (x *Record) GetMulti() *Record_Multi {
+	if x, ok := x.GetItem().(*Record_Multi_); ok {
+		return x.Multi
+	}
+	return nil
+}
+
+func (x *Record) GetOffline() *Record_Offline {
+	if x, ok := x.GetItem().(*Record_Offline_); ok {
+		return x.Offline
+	}
+	return nil
+}
+
+type isRecord_Item interface {
+	isRecord_Item()
+}
+
+type Record_Local_ struct {
+	// local stores the private key locally.
+	Local *Record_Local `protobuf:"bytes,3,opt,name=local,proto3,oneof"`
+}
+
+type Record_Ledger_ struct {
+	// ledger stores the information about a Ledger key.
+	Ledger *Record_Ledger `protobuf:"bytes,4,opt,name=ledger,proto3,oneof"`
+}
+
+type Record_Multi_ struct {
+	// Multi does not store any other information.
+	Multi *Record_Multi `protobuf:"bytes,5,opt,name=multi,proto3,oneof"`
+}
+
+type Record_Offline_ struct {
+	// Offline does not store any other information.
+	Offline *Record_Offline `protobuf:"bytes,6,opt,name=offline,proto3,oneof"`
+}
+
+func (*Record_Local_) isRecord_Item() {}
+
+func (*Record_Ledger_) isRecord_Item() {}
+
+func (*Record_Multi_) isRecord_Item() {}
+
+func (*Record_Offline_) isRecord_Item() {}
+
+// RecordBundle is a set of keyring records exported together, along with
+// their metadata, to be imported into another keyring.
+//
+// Since: cosmos-sdk 0.48
+type RecordBundle struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// entries are the bundled records.
+	Entries []*RecordBundle_Entry `protobuf:"bytes,1,rep,name=entries,proto3" json:"entries,omitempty"`
+}
+
+func (x *RecordBundle) Reset() {
+	*x = RecordBundle{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cosmos_crypto_keyring_v1_record_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RecordBundle) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RecordBundle) ProtoMessage() {}
+
+// Deprecated: Use RecordBundle.ProtoReflect.Descriptor instead.
+func (*RecordBundle) Descriptor() ([]byte, []int) {
+	return file_cosmos_crypto_keyring_v1_record_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *RecordBundle) GetEntries() []*RecordBundle_Entry {
+	if x != nil {
+		return x.Entries
+	}
+	return nil
+}
+
+// Item is a keyring item stored in a keyring backend.
+// Local item
+type Record_Local struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	PrivKey *anypb.Any `protobuf:"bytes,1,opt,name=priv_key,json=privKey,proto3" json:"priv_key,omitempty"`
+}
+
+func (x *Record_Local) Reset() {
+	*x = Record_Local{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cosmos_crypto_keyring_v1_record_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Record_Local) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Record_Local) ProtoMessage() {}
+
+// Deprecated: Use Record_Local.ProtoReflect.Descriptor instead.
+func (*Record_Local) Descriptor() ([]byte, []int) {
+	return file_cosmos_crypto_keyring_v1_record_proto_rawDescGZIP(), []int{0, 0}
+}
+
+func (x *Record_Local) GetPrivKey() *anypb.Any {
+	if x != nil {
+		return x.PrivKey
+	}
+	return nil
+}
+
+// Ledger item
+type Record_Ledger struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Path *v1.BIP44Params `protobuf:"bytes,1,opt,name=path,proto3" json:"path,omitempty"`
+}
+
+func (x *Record_Ledger) Reset() {
+	*x = Record_Ledger{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cosmos_crypto_keyring_v1_record_proto_msgTypes[3]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Record_Ledger) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Record_Ledger) ProtoMessage() {}
+
+// Deprecated: Use Record_Ledger.ProtoReflect.Descriptor instead.
+func (*Record_Ledger) Descriptor() ([]byte, []int) {
+	return file_cosmos_crypto_keyring_v1_record_proto_rawDescGZIP(), []int{0, 1}
+}
+
+func (x *Record_Ledger) GetPath() *v1.BIP44Params {
+	if x != nil {
+		return x.Path
+	}
+	return nil
+}
+
+// Multi item
+type Record_Multi struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *Record_Multi) Reset() {
+	*x = Record_Multi{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cosmos_crypto_keyring_v1_record_proto_msgTypes[4]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Record_Multi) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Record_Multi) ProtoMessage() {}
+
+// Deprecated: Use Record_Multi.ProtoReflect.Descriptor instead.
+func (*Record_Multi) Descriptor() ([]byte, []int) {
+	return file_cosmos_crypto_keyring_v1_record_proto_rawDescGZIP(), []int{0, 2}
+}
+
+// Offline item
+type Record_Offline struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
@@ -2773,7 +3869,7 @@ type Record_Offline struct {
 func (x *Record_Offline) Reset() {
 	*x = Record_Offline{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_cosmos_crypto_keyring_v1_record_proto_msgTypes[4]
+		mi := &file_cosmos_crypto_keyring_v1_record_proto_msgTypes[5]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2790,6 +3886,62 @@ func (*Record_Offline) Descriptor() ([]byte, []int) {
 	return file_cosmos_crypto_keyring_v1_record_proto_rawDescGZIP(), []int{0, 3}
 }
 
+// Entry is a bundled record.
+type RecordBundle_Entry struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// record is the keyring record, including the private key of local keys.
+	Record *Record `protobuf:"bytes,1,opt,name=record,proto3" json:"record,omitempty"`
+	// algo is the name of the signing algorithm of the key.
+	Algo string `protobuf:"bytes,2,opt,name=algo,proto3" json:"algo,omitempty"`
+	// hd_path is the HD derivation path of the key, when recorded in the
+	// keyring.
+	HdPath string `protobuf:"bytes,3,opt,name=hd_path,json=hdPath,proto3" json:"hd_path,omitempty"`
+}
+
+func (x *RecordBundle_Entry) Reset() {
+	*x = RecordBundle_Entry{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cosmos_crypto_keyring_v1_record_proto_msgTypes[6]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RecordBundle_Entry) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RecordBundle_Entry) ProtoMessage() {}
+
+// Deprecated: Use RecordBundle_Entry.ProtoReflect.Descriptor instead.
+func (*RecordBundle_Entry) Descriptor() ([]byte, []int) {
+	return file_cosmos_crypto_keyring_v1_record_proto_rawDescGZIP(), []int{1, 0}
+}
+
+func (x *RecordBundle_Entry) GetRecord() *Record {
+	if x != nil {
+		return x.Record
+	}
+	return nil
+}
+
+func (x *RecordBundle_Entry) GetAlgo() string {
+	if x != nil {
+		return x.Algo
+	}
+	return ""
+}
+
+func (x *RecordBundle_Entry) GetHdPath() string {
+	if x != nil {
+		return x.HdPath
+	}
+	return ""
+}
+
 var File_cosmos_crypto_keyring_v1_record_proto protoreflect.FileDescriptor
 
 var file_cosmos_crypto_keyring_v1_record_proto_rawDesc = []byte{
@@ -2832,23 +3984,35 @@ var file_cosmos_crypto_keyring_v1_record_proto_rawDesc = []byte{
 	0x6f, 0x73, 0x2e, 0x63, 0x72, 0x79, 0x70, 0x74, 0x6f, 0x2e, 0x68, 0x64, 0x2e, 0x76, 0x31, 0x2e,
 	0x42, 0x49, 0x50, 0x34, 0x34, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x52, 0x04, 0x70, 0x61, 0x74,
 	0x68, 0x1a, 0x07, 0x0a, 0x05, 0x4d, 0x75, 0x6c, 0x74, 0x69, 0x1a, 0x09, 0x0a, 0x07, 0x4f, 0x66,
-	0x66, 0x6c, 0x69, 0x6e, 0x65, 0x42, 0x06, 0x0a, 0x04, 0x69, 0x74, 0x65, 0x6d, 0x42, 0xeb, 0x01,
-	0x0a, 0x1c, 0x63, 0x6f, 0x6d, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x63, 0x72, 0x79,
-	0x70, 0x74, 0x6f, 0x2e, 0x6b, 0x65, 0x79, 0x72, 0x69, 0x6e, 0x67, 0x2e, 0x76, 0x31, 0x42, 0x0b,
-	0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50, 0x01, 0x5a, 0x33, 0x63,
-	0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f, 0x2f, 0x61, 0x70, 0x69, 0x2f,
-	0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2f, 0x63, 0x72, 0x79, 0x70, 0x74, 0x6f, 0x2f, 0x6b, 0x65,
-	0x79, 0x72, 0x69, 0x6e, 0x67, 0x2f, 0x76, 0x31, 0x3b, 0x6b, 0x65, 0x79, 0x72, 0x69, 0x6e, 0x67,
-	0x76, 0x31, 0xa2, 0x02, 0x03, 0x43, 0x43, 0x4b, 0xaa, 0x02, 0x18, 0x43, 0x6f, 0x73, 0x6d, 0x6f,
-	0x73, 0x2e, 0x43, 0x72, 0x79, 0x70, 0x74, 0x6f, 0x2e, 0x4b, 0x65, 0x79, 0x72, 0x69, 0x6e, 0x67,
-	0x2e, 0x56, 0x31, 0xca, 0x02, 0x18, 0x43, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x5c, 0x43, 0x72, 0x79,
-	0x70, 0x74, 0x6f, 0x5c, 0x4b, 0x65, 0x79, 0x72, 0x69, 0x6e, 0x67, 0x5c, 0x56, 0x31, 0xe2, 0x02,
-	0x24, 0x43, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x5c, 0x43, 0x72, 0x79, 0x70, 0x74, 0x6f, 0x5c, 0x4b,
-	0x65, 0x79, 0x72, 0x69, 0x6e, 0x67, 0x5c, 0x56, 0x31, 0x5c, 0x47, 0x50, 0x42, 0x4d, 0x65, 0x74,
-	0x61, 0x64, 0x61, 0x74, 0x61, 0xea, 0x02, 0x1b, 0x43, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x3a, 0x3a,
-	0x43, 0x72, 0x79, 0x70, 0x74, 0x6f, 0x3a, 0x3a, 0x4b, 0x65, 0x79, 0x72, 0x69, 0x6e, 0x67, 0x3a,
-	0x3a, 0x56, 0x31, 0xc8, 0xe1, 0x1e, 0x00, 0x98, 0xe3, 0x1e, 0x00, 0x62, 0x06, 0x70, 0x72, 0x6f,
-	0x74, 0x6f, 0x33,
+	0x66, 0x6c, 0x69, 0x6e, 0x65, 0x42, 0x06, 0x0a, 0x04, 0x69, 0x74, 0x65, 0x6d, 0x22, 0xc6, 0x01,
+	0x0a, 0x0c, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x42, 0x75, 0x6e, 0x64, 0x6c, 0x65, 0x12, 0x46,
+	0x0a, 0x07, 0x65, 0x6e, 0x74, 0x72, 0x69, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x2c, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x63, 0x72, 0x79, 0x70, 0x74, 0x6f, 0x2e,
+	0x6b, 0x65, 0x79, 0x72, 0x69, 0x6e, 0x67, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x63, 0x6f, 0x72,
+	0x64, 0x42, 0x75, 0x6e, 0x64, 0x6c, 0x65, 0x2e, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x07, 0x65,
+	0x6e, 0x74, 0x72, 0x69, 0x65, 0x73, 0x1a, 0x6e, 0x0a, 0x05, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12,
+	0x38, 0x0a, 0x06, 0x72, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x20, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x63, 0x72, 0x79, 0x70, 0x74, 0x6f, 0x2e,
+	0x6b, 0x65, 0x79, 0x72, 0x69, 0x6e, 0x67, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x63, 0x6f, 0x72,
+	0x64, 0x52, 0x06, 0x72, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x61, 0x6c, 0x67,
+	0x6f, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x61, 0x6c, 0x67, 0x6f, 0x12, 0x17, 0x0a,
+	0x07, 0x68, 0x64, 0x5f, 0x70, 0x61, 0x74, 0x68, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06,
+	0x68, 0x64, 0x50, 0x61, 0x74, 0x68, 0x42, 0xeb, 0x01, 0x0a, 0x1c, 0x63, 0x6f, 0x6d, 0x2e, 0x63,
+	0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x63, 0x72, 0x79, 0x70, 0x74, 0x6f, 0x2e, 0x6b, 0x65, 0x79,
+	0x72, 0x69, 0x6e, 0x67, 0x2e, 0x76, 0x31, 0x42, 0x0b, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x50,
+	0x72, 0x6f, 0x74, 0x6f, 0x50, 0x01, 0x5a, 0x33, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64,
+	0x6b, 0x2e, 0x69, 0x6f, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2f,
+	0x63, 0x72, 0x79, 0x70, 0x74, 0x6f, 0x2f, 0x6b, 0x65, 0x79, 0x72, 0x69, 0x6e, 0x67, 0x2f, 0x76,
+	0x31, 0x3b, 0x6b, 0x65, 0x79, 0x72, 0x69, 0x6e, 0x67, 0x76, 0x31, 0xa2, 0x02, 0x03, 0x43, 0x43,
+	0x4b, 0xaa, 0x02, 0x18, 0x43, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x43, 0x72, 0x79, 0x70, 0x74,
+	0x6f, 0x2e, 0x4b, 0x65, 0x79, 0x72, 0x69, 0x6e, 0x67, 0x2e, 0x56, 0x31, 0xca, 0x02, 0x18, 0x43,
+	0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x5c, 0x43, 0x72, 0x79, 0x70, 0x74, 0x6f, 0x5c, 0x4b, 0x65, 0x79,
+	0x72, 0x69, 0x6e, 0x67, 0x5c, 0x56, 0x31, 0xe2, 0x02, 0x24, 0x43, 0x6f, 0x73, 0x6d, 0x6f, 0x73,
+	0x5c, 0x43, 0x72, 0x79, 0x70, 0x74, 0x6f, 0x5c, 0x4b, 0x65, 0x79, 0x72, 0x69, 0x6e, 0x67, 0x5c,
+	0x56, 0x31, 0x5c, 0x47, 0x50, 0x42, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0xea, 0x02,
+	0x1b, 0x43, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x3a, 0x3a, 0x43, 0x72, 0x79, 0x70, 0x74, 0x6f, 0x3a,
+	0x3a, 0x4b, 0x65, 0x79, 0x72, 0x69, 0x6e, 0x67, 0x3a, 0x3a, 0x56, 0x31, 0xc8, 0xe1, 0x1e, 0x00,
+	0x98, 0xe3, 0x1e, 0x00, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -2863,29 +4027,33 @@ func file_cosmos_crypto_keyring_v1_record_proto_rawDescGZIP() []byte {
 	return file_cosmos_crypto_keyring_v1_record_proto_rawDescData
 }
 
-var file_cosmos_crypto_keyring_v1_record_proto_msgTypes = make([]protoimpl.MessageInfo, 5)
+var file_cosmos_crypto_keyring_v1_record_proto_msgTypes = make([]protoimpl.MessageInfo, 7)
 var file_cosmos_crypto_keyring_v1_record_proto_goTypes = []interface{}{
-	(*Record)(nil),         // 0: cosmos.crypto.keyring.v1.Record
-	(*Record_Local)(nil),   // 1: cosmos.crypto.keyring.v1.Record.Local
-	(*Record_Ledger)(nil),  // 2: cosmos.crypto.keyring.v1.Record.Ledger
-	(*Record_Multi)(nil),   // 3: cosmos.crypto.keyring.v1.Record.Multi
-	(*Record_Offline)(nil), // 4: cosmos.crypto.keyring.v1.Record.Offline
-	(*anypb.Any)(nil),      // 5: google.protobuf.Any
-	(*v1.BIP44Params)(nil), // 6: cosmos.crypto.hd.v1.BIP44Params
+	(*Record)(nil),             // 0: cosmos.crypto.keyring.v1.Record
+	(*RecordBundle)(nil),       // 1: cosmos.crypto.keyring.v1.RecordBundle
+	(*Record_Local)(nil),       // 2: cosmos.crypto.keyring.v1.Record.Local
+	(*Record_Ledger)(nil),      // 3: cosmos.crypto.keyring.v1.Record.Ledger
+	(*Record_Multi)(nil),       // 4: cosmos.crypto.keyring.v1.Record.Multi
+	(*Record_Offline)(nil),     // 5: cosmos.crypto.keyring.v1.Record.Offline
+	(*RecordBundle_Entry)(nil), // 6: cosmos.crypto.keyring.v1.RecordBundle.Entry
+	(*anypb.Any)(nil),          // 7: google.protobuf.Any
+	(*v1.BIP44Params)(nil),     // 8: cosmos.crypto.hd.v1.BIP44Params
 }
 var file_cosmos_crypto_keyring_v1_record_proto_depIdxs = []int32{
-	5, // 0: cosmos.crypto.keyring.v1.Record.pub_key:type_name -> google.protobuf.Any
-	1, // 1: cosmos.crypto.keyring.v1.Record.local:type_name -> cosmos.crypto.keyring.v1.Record.Local
-	2, // 2: cosmos.crypto.keyring.v1.Record.ledger:type_name -> cosmos.crypto.keyring.v1.Record.Ledger
-	3, // 3: cosmos.crypto.keyring.v1.Record.multi:type_name -> cosmos.crypto.keyring.v1.Record.Multi
-	4, // 4: cosmos.crypto.keyring.v1.Record.offline:type_name -> cosmos.crypto.keyring.v1.Record.Offline
-	5, // 5: cosmos.crypto.keyring.v1.Record.Local.priv_key:type_name -> google.protobuf.Any
-	6, // 6: cosmos.crypto.keyring.v1.Record.Ledger.path:type_name -> cosmos.crypto.hd.v1.BIP44Params
-	7, // [7:7] is the sub-list for method output_type
-	7, // [7:7] is the sub-list for method input_type
-	7, // [7:7] is the sub-list for extension type_name
-	7, // [7:7] is the sub-list for extension extendee
-	0, // [0:7] is the sub-list for field type_name
+	7, // 0: cosmos.crypto.keyring.v1.Record.pub_key:type_name -> google.protobuf.Any
+	2, // 1: cosmos.crypto.keyring.v1.Record.local:type_name -> cosmos.crypto.keyring.v1.Record.Local
+	3, // 2: cosmos.crypto.keyring.v1.Record.ledger:type_name -> cosmos.crypto.keyring.v1.Record.Ledger
+	4, // 3: cosmos.crypto.keyring.v1.Record.multi:type_name -> cosmos.crypto.keyring.v1.Record.Multi
+	5, // 4: cosmos.crypto.keyring.v1.Record.offline:type_name -> cosmos.crypto.keyring.v1.Record.Offline
+	6, // 5: cosmos.crypto.keyring.v1.RecordBundle.entries:type_name -> cosmos.crypto.keyring.v1.RecordBundle.Entry
+	7, // 6: cosmos.crypto.keyring.v1.Record.Local.priv_key:type_name -> google.protobuf.Any
+	8, // 7: cosmos.crypto.keyring.v1.Record.Ledger.path:type_name -> cosmos.crypto.hd.v1.BIP44Params
+	0, // 8: cosmos.crypto.keyring.v1.RecordBundle.Entry.record:type_name -> cosmos.crypto.keyring.v1.Record
+	9, // [9:9] is the sub-list for method output_type
+	9, // [9:9] is the sub-list for method input_type
+	9, // [9:9] is the sub-list for extension type_name
+	9, // [9:9] is the sub-list for extension extendee
+	0, // [0:9] is the sub-list for field type_name
 }
 
 func init() { file_cosmos_crypto_keyring_v1_record_proto_init() }
@@ -2907,7 +4075,7 @@ func file_cosmos_crypto_keyring_v1_record_proto_init() {
 			}
 		}
 		file_cosmos_crypto_keyring_v1_record_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Record_Local); i {
+			switch v := v.(*RecordBundle); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2919,7 +4087,7 @@ func file_cosmos_crypto_keyring_v1_record_proto_init() {
 			}
 		}
 		file_cosmos_crypto_keyring_v1_record_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Record_Ledger); i {
+			switch v := v.(*Record_Local); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2931,7 +4099,7 @@ func file_cosmos_crypto_keyring_v1_record_proto_init() {
 			}
 		}
 		file_cosmos_crypto_keyring_v1_record_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Record_Multi); i {
+			switch v := v.(*Record_Ledger); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2943,6 +4111,18 @@ func file_cosmos_crypto_keyring_v1_record_proto_init() {
 			}
 		}
 		file_cosmos_crypto_keyring_v1_record_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Record_Multi); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_cosmos_crypto_keyring_v1_record_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*Record_Offline); i {
 			case 0:
 				return &v.state
@@ -2954,6 +4134,18 @@ func file_cosmos_crypto_keyring_v1_record_proto_init() {
 				return nil
 			}
 		}
+		file_cosmos_crypto_keyring_v1_record_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RecordBundle_Entry); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
 	}
 	file_cosmos_crypto_keyring_v1_record_proto_msgTypes[0].OneofWrappers = []interface{}{
 		(*Record_Local_)(nil),
@@ -2967,7 +4159,7 @@ func file_cosmos_crypto_keyring_v1_record_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_cosmos_crypto_keyring_v1_record_proto_rawDesc,
 			NumEnums:      0,
-			NumMessages:   5,
+			NumMessages:   7,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
@@ -28,6 +28,7 @@ const (
 	Query_DelegationTotalRewards_FullMethodName      = "/cosmos.distribution.v1beta1.Query/DelegationTotalRewards"
 	Query_DelegatorValidators_FullMethodName         = "/cosmos.distribution.v1beta1.Query/DelegatorValidators"
 	Query_DelegatorWithdrawAddress_FullMethodName    = "/cosmos.distribution.v1beta1.Query/DelegatorWithdrawAddress"
+	Query_DelegatorRewardsDestination_FullMethodName = "/cosmos.distribution.v1beta1.Query/DelegatorRewardsDestination"
 	Query_CommunityPool_FullMethodName               = "/cosmos.distribution.v1beta1.Query/CommunityPool"
 )
 
@@ -54,6 +55,11 @@ type QueryClient interface {
 	DelegatorValidators(ctx context.Context, in *QueryDelegatorValidatorsRequest, opts ...grpc.CallOption) (*QueryDelegatorValidatorsResponse, error)
 	// DelegatorWithdrawAddress queries withdraw address of a delegator.
 	DelegatorWithdrawAddress(ctx context.Context, in *QueryDelegatorWithdrawAddressRequest, opts ...grpc.CallOption) (*QueryDelegatorWithdrawAddressResponse, error)
+	// DelegatorRewardsDestination queries where the rewards of a delegator are
+	// credited.
+	//
+	// Since: cosmos-sdk 0.48
+	DelegatorRewardsDestination(ctx context.Context, in *QueryDelegatorRewardsDestinationRequest, opts ...grpc.CallOption) (*QueryDelegatorRewardsDestinationResponse, error)
 	// CommunityPool queries the community pool coins.
 	CommunityPool(ctx context.Context, in *QueryCommunityPoolRequest, opts ...grpc.CallOption) (*QueryCommunityPoolResponse, error)
 }
@@ -147,6 +153,15 @@ func (c *queryClient) DelegatorWithdrawAddress(ctx context.Context, in *QueryDel
 	return out, nil
 }
 
+func (c *queryClient) DelegatorRewardsDestination(ctx context.Context, in *QueryDelegatorRewardsDestinationRequest, opts ...grpc.CallOption) (*QueryDelegatorRewardsDestinationResponse, error) {
+	out := new(QueryDelegatorRewardsDestinationResponse)
+	err := c.cc.Invoke(ctx, Query_DelegatorRewardsDestination_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *queryClient) CommunityPool(ctx context.Context, in *QueryCommunityPoolRequest, opts ...grpc.CallOption) (*QueryCommunityPoolResponse, error) {
 	out := new(QueryCommunityPoolResponse)
 	err := c.cc.Invoke(ctx, Query_CommunityPool_FullMethodName, in, out, opts...)
@@ -179,6 +194,11 @@ type QueryServer interface {
 	DelegatorValidators(context.Context, *QueryDelegatorValidatorsRequest) (*QueryDelegatorValidatorsResponse, error)
 	// DelegatorWithdrawAddress queries withdraw address of a delegator.
 	DelegatorWithdrawAddress(context.Context, *QueryDelegatorWithdrawAddressRequest) (*QueryDelegatorWithdrawAddressResponse, error)
+	// DelegatorRewardsDestination queries where the rewards of a delegator are
+	// credited.
+	//
+	// Since: cosmos-sdk 0.48
+	DelegatorRewardsDestination(context.Context, *QueryDelegatorRewardsDestinationRequest) (*QueryDelegatorRewardsDestinationResponse, error)
 	// CommunityPool queries the community pool coins.
 	CommunityPool(context.Context, *QueryCommunityPoolRequest) (*QueryCommunityPoolResponse, error)
 	mustEmbedUnimplementedQueryServer()
@@ -215,6 +235,9 @@ func (UnimplementedQueryServer) DelegatorValidators(context.Context, *QueryDeleg
 func (UnimplementedQueryServer) DelegatorWithdrawAddress(context.Context, *QueryDelegatorWithdrawAddressRequest) (*QueryDelegatorWithdrawAddressResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method DelegatorWithdrawAddress not implemented")
 }
+func (UnimplementedQueryServer) DelegatorRewardsDestination(context.Context, *QueryDelegatorRewardsDestinationRequest) (*QueryDelegatorRewardsDestinationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DelegatorRewardsDestination not implemented")
+}
 func (UnimplementedQueryServer) CommunityPool(context.Context, *QueryCommunityPoolRequest) (*QueryCommunityPoolResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method CommunityPool not implemented")
 }
@@ -393,6 +416,24 @@ func _Query_DelegatorWithdrawAddress_Handler(srv interface{}, ctx context.Contex
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_DelegatorRewardsDestination_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryDelegatorRewardsDestinationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).DelegatorRewardsDestination(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_DelegatorRewardsDestination_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).DelegatorRewardsDestination(ctx, req.(*QueryDelegatorRewardsDestinationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Query_CommunityPool_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(QueryCommunityPoolRequest)
 	if err := dec(in); err != nil {
@@ -454,6 +495,10 @@ var Query_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "DelegatorWithdrawAddress",
 			Handler:    _Query_DelegatorWithdrawAddress_Handler,
 		},
+		{
+			MethodName: "DelegatorRewardsDestination",
+			Handler:    _Query_DelegatorRewardsDestination_Handler,
+		},
 		{
 			MethodName: "CommunityPool",
 			Handler:    _Query_CommunityPool_Handler,
@@ -26,6 +26,9 @@ const (
 	Msg_UpdateParams_FullMethodName                = "/cosmos.distribution.v1beta1.Msg/UpdateParams"
 	Msg_CommunityPoolSpend_FullMethodName          = "/cosmos.distribution.v1beta1.Msg/CommunityPoolSpend"
 	Msg_DepositValidatorRewardsPool_FullMethodName = "/cosmos.distribution.v1beta1.Msg/DepositValidatorRewardsPool"
+	Msg_SetAutoCompound_FullMethodName             = "/cosmos.distribution.v1beta1.Msg/SetAutoCompound"
+	Msg_WithdrawAndDelegate_FullMethodName         = "/cosmos.distribution.v1beta1.Msg/WithdrawAndDelegate"
+	Msg_SetRewardsDestination_FullMethodName       = "/cosmos.distribution.v1beta1.Msg/SetRewardsDestination"
 )
 
 // MsgClient is the client API for Msg service.
@@ -61,6 +64,21 @@ type MsgClient interface {
 	//
 	// Since: cosmos-sdk 0.48
 	DepositValidatorRewardsPool(ctx context.Context, in *MsgDepositValidatorRewardsPool, opts ...grpc.CallOption) (*MsgDepositValidatorRewardsPoolResponse, error)
+	// SetAutoCompound defines a method for a delegator to opt in or out of the
+	// automatic restaking of the rewards of a delegation.
+	SetAutoCompound(ctx context.Context, in *MsgSetAutoCompound, opts ...grpc.CallOption) (*MsgSetAutoCompoundResponse, error)
+	// WithdrawAndDelegate defines a method to withdraw the rewards of a
+	// delegator from one or all validators and delegate them in a single atomic
+	// message.
+	//
+	// Since: cosmos-sdk 0.48
+	WithdrawAndDelegate(ctx context.Context, in *MsgWithdrawAndDelegate, opts ...grpc.CallOption) (*MsgWithdrawAndDelegateResponse, error)
+	// SetRewardsDestination defines a method for a vesting account holder to
+	// credit its staking rewards to its spendable balance or to its vesting
+	// amount.
+	//
+	// Since: cosmos-sdk 0.48
+	SetRewardsDestination(ctx context.Context, in *MsgSetRewardsDestination, opts ...grpc.CallOption) (*MsgSetRewardsDestinationResponse, error)
 }
 
 type msgClient struct {
@@ -134,6 +152,33 @@ func (c *msgClient) DepositValidatorRewardsPool(ctx context.Context, in *MsgDepo
 	return out, nil
 }
 
+func (c *msgClient) SetAutoCompound(ctx context.Context, in *MsgSetAutoCompound, opts ...grpc.CallOption) (*MsgSetAutoCompoundResponse, error) {
+	out := new(MsgSetAutoCompoundResponse)
+	err := c.cc.Invoke(ctx, Msg_SetAutoCompound_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) WithdrawAndDelegate(ctx context.Context, in *MsgWithdrawAndDelegate, opts ...grpc.CallOption) (*MsgWithdrawAndDelegateResponse, error) {
+	out := new(MsgWithdrawAndDelegateResponse)
+	err := c.cc.Invoke(ctx, Msg_WithdrawAndDelegate_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) SetRewardsDestination(ctx context.Context, in *MsgSetRewardsDestination, opts ...grpc.CallOption) (*MsgSetRewardsDestinationResponse, error) {
+	out := new(MsgSetRewardsDestinationResponse)
+	err := c.cc.Invoke(ctx, Msg_SetRewardsDestination_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MsgServer is the server API for Msg service.
 // All implementations must embed UnimplementedMsgServer
 // for forward compatibility
@@ -167,6 +212,21 @@ type MsgServer interface {
 	//
 	// Since: cosmos-sdk 0.48
 	DepositValidatorRewardsPool(context.Context, *MsgDepositValidatorRewardsPool) (*MsgDepositValidatorRewardsPoolResponse, error)
+	// SetAutoCompound defines a method for a delegator to opt in or out of the
+	// automatic restaking of the rewards of a delegation.
+	SetAutoCompound(context.Context, *MsgSetAutoCompound) (*MsgSetAutoCompoundResponse, error)
+	// WithdrawAndDelegate defines a method to withdraw the rewards of a
+	// delegator from one or all validators and delegate them in a single atomic
+	// message.
+	//
+	// Since: cosmos-sdk 0.48
+	WithdrawAndDelegate(context.Context, *MsgWithdrawAndDelegate) (*MsgWithdrawAndDelegateResponse, error)
+	// SetRewardsDestination defines a method for a vesting account holder to
+	// credit its staking rewards to its spendable balance or to its vesting
+	// amount.
+	//
+	// Since: cosmos-sdk 0.48
+	SetRewardsDestination(context.Context, *MsgSetRewardsDestination) (*MsgSetRewardsDestinationResponse, error)
 	mustEmbedUnimplementedMsgServer()
 }
 
@@ -195,6 +255,15 @@ func (UnimplementedMsgServer) CommunityPoolSpend(context.Context, *MsgCommunityP
 func (UnimplementedMsgServer) DepositValidatorRewardsPool(context.Context, *MsgDepositValidatorRewardsPool) (*MsgDepositValidatorRewardsPoolResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method DepositValidatorRewardsPool not implemented")
 }
+func (UnimplementedMsgServer) SetAutoCompound(context.Context, *MsgSetAutoCompound) (*MsgSetAutoCompoundResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetAutoCompound not implemented")
+}
+func (UnimplementedMsgServer) WithdrawAndDelegate(context.Context, *MsgWithdrawAndDelegate) (*MsgWithdrawAndDelegateResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method WithdrawAndDelegate not implemented")
+}
+func (UnimplementedMsgServer) SetRewardsDestination(context.Context, *MsgSetRewardsDestination) (*MsgSetRewardsDestinationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetRewardsDestination not implemented")
+}
 func (UnimplementedMsgServer) mustEmbedUnimplementedMsgServer() {}
 
 // UnsafeMsgServer may be embedded to opt out of forward compatibility for this service.
@@ -334,6 +403,60 @@ func _Msg_DepositValidatorRewardsPool_Handler(srv interface{}, ctx context.Conte
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_SetAutoCompound_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSetAutoCompound)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SetAutoCompound(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_SetAutoCompound_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SetAutoCompound(ctx, req.(*MsgSetAutoCompound))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_WithdrawAndDelegate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgWithdrawAndDelegate)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).WithdrawAndDelegate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_WithdrawAndDelegate_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).WithdrawAndDelegate(ctx, req.(*MsgWithdrawAndDelegate))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_SetRewardsDestination_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSetRewardsDestination)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SetRewardsDestination(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_SetRewardsDestination_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SetRewardsDestination(ctx, req.(*MsgSetRewardsDestination))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // Msg_ServiceDesc is the grpc.ServiceDesc for Msg service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -369,6 +492,18 @@ var Msg_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "DepositValidatorRewardsPool",
 			Handler:    _Msg_DepositValidatorRewardsPool_Handler,
 		},
+		{
+			MethodName: "SetAutoCompound",
+			Handler:    _Msg_SetAutoCompound_Handler,
+		},
+		{
+			MethodName: "WithdrawAndDelegate",
+			Handler:    _Msg_WithdrawAndDelegate_Handler,
+		},
+		{
+			MethodName: "SetRewardsDestination",
+			Handler:    _Msg_SetRewardsDestination_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "cosmos/distribution/v1beta1/tx.proto",
@@ -0,0 +1,156 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.3.0
+// - protoc             (unknown)
+// source: cosmos/featureflag/v1/tx.proto
+
+package featureflagv1
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.32.0 or later.
+const _ = grpc.SupportPackageIsVersion7
+
+const (
+	Msg_ScheduleFeature_FullMethodName = "/cosmos.featureflag.v1.Msg/ScheduleFeature"
+	Msg_CancelFeature_FullMethodName   = "/cosmos.featureflag.v1.Msg/CancelFeature"
+)
+
+// MsgClient is the client API for Msg service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type MsgClient interface {
+	// ScheduleFeature defines a governance operation for setting the activation
+	// height of a named feature. Scheduling an existing feature overwrites its
+	// activation height, as long as the feature is not active yet.
+	ScheduleFeature(ctx context.Context, in *MsgScheduleFeature, opts ...grpc.CallOption) (*MsgScheduleFeatureResponse, error)
+	// CancelFeature defines a governance operation for removing a feature that
+	// has been scheduled but is not active yet.
+	CancelFeature(ctx context.Context, in *MsgCancelFeature, opts ...grpc.CallOption) (*MsgCancelFeatureResponse, error)
+}
+
+type msgClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewMsgClient(cc grpc.ClientConnInterface) MsgClient {
+	return &msgClient{cc}
+}
+
+func (c *msgClient) ScheduleFeature(ctx context.Context, in *MsgScheduleFeature, opts ...grpc.CallOption) (*MsgScheduleFeatureResponse, error) {
+	out := new(MsgScheduleFeatureResponse)
+	err := c.cc.Invoke(ctx, Msg_ScheduleFeature_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) CancelFeature(ctx context.Context, in *MsgCancelFeature, opts ...grpc.CallOption) (*MsgCancelFeatureResponse, error) {
+	out := new(MsgCancelFeatureResponse)
+	err := c.cc.Invoke(ctx, Msg_CancelFeature_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// MsgServer is the server API for Msg service.
+// All implementations must embed UnimplementedMsgServer
+// for forward compatibility
+type MsgServer interface {
+	// ScheduleFeature defines a governance operation for setting the activation
+	// height of a named feature. Scheduling an existing feature overwrites its
+	// activation height, as long as the feature is not active yet.
+	ScheduleFeature(context.Context, *MsgScheduleFeature) (*MsgScheduleFeatureResponse, error)
+	// CancelFeature defines a governance operation for removing a feature that
+	// has been scheduled but is not active yet.
+	CancelFeature(context.Context, *MsgCancelFeature) (*MsgCancelFeatureResponse, error)
+	mustEmbedUnimplementedMsgServer()
+}
+
+// UnimplementedMsgServer must be embedded to have forward compatible implementations.
+type UnimplementedMsgServer struct {
+}
+
+func (UnimplementedMsgServer) ScheduleFeature(context.Context, *MsgScheduleFeature) (*MsgScheduleFeatureResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ScheduleFeature not implemented")
+}
+func (UnimplementedMsgServer) CancelFeature(context.Context, *MsgCancelFeature) (*MsgCancelFeatureResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CancelFeature not implemented")
+}
+func (UnimplementedMsgServer) mustEmbedUnimplementedMsgServer() {}
+
+// UnsafeMsgServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to MsgServer will
+// result in compilation errors.
+type UnsafeMsgServer interface {
+	mustEmbedUnimplementedMsgServer()
+}
+
+func RegisterMsgServer(s grpc.ServiceRegistrar, srv MsgServer) {
+	s.RegisterService(&Msg_ServiceDesc, srv)
+}
+
+func _Msg_ScheduleFeature_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgScheduleFeature)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).ScheduleFeature(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_ScheduleFeature_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).ScheduleFeature(ctx, req.(*MsgScheduleFeature))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_CancelFeature_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgCancelFeature)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).CancelFeature(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_CancelFeature_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).CancelFeature(ctx, req.(*MsgCancelFeature))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// Msg_ServiceDesc is the grpc.ServiceDesc for Msg service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var Msg_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "cosmos.featureflag.v1.Msg",
+	HandlerType: (*MsgServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "ScheduleFeature",
+			Handler:    _Msg_ScheduleFeature_Handler,
+		},
+		{
+			MethodName: "CancelFeature",
+			Handler:    _Msg_CancelFeature_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "cosmos/featureflag/v1/tx.proto",
+}
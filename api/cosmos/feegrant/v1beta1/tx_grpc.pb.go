@@ -21,8 +21,10 @@ import (
 const _ = grpc.SupportPackageIsVersion7
 
 const (
-	Msg_GrantAllowance_FullMethodName  = "/cosmos.feegrant.v1beta1.Msg/GrantAllowance"
-	Msg_RevokeAllowance_FullMethodName = "/cosmos.feegrant.v1beta1.Msg/RevokeAllowance"
+	Msg_GrantAllowance_FullMethodName            = "/cosmos.feegrant.v1beta1.Msg/GrantAllowance"
+	Msg_RevokeAllowance_FullMethodName           = "/cosmos.feegrant.v1beta1.Msg/RevokeAllowance"
+	Msg_GrantAllowanceFromModule_FullMethodName  = "/cosmos.feegrant.v1beta1.Msg/GrantAllowanceFromModule"
+	Msg_RevokeAllowanceFromModule_FullMethodName = "/cosmos.feegrant.v1beta1.Msg/RevokeAllowanceFromModule"
 )
 
 // MsgClient is the client API for Msg service.
@@ -35,6 +37,18 @@ type MsgClient interface {
 	// RevokeAllowance revokes any fee allowance of granter's account that
 	// has been granted to the grantee.
 	RevokeAllowance(ctx context.Context, in *MsgRevokeAllowance, opts ...grpc.CallOption) (*MsgRevokeAllowanceResponse, error)
+	// GrantAllowanceFromModule grants fee allowance to the grantee on the
+	// account of a module, so that the module funds the fees of the grantee.
+	// It must be executed by the feegrant module authority.
+	//
+	// Since: cosmos-sdk 0.50
+	GrantAllowanceFromModule(ctx context.Context, in *MsgGrantAllowanceFromModule, opts ...grpc.CallOption) (*MsgGrantAllowanceFromModuleResponse, error)
+	// RevokeAllowanceFromModule revokes any fee allowance of a module account
+	// that has been granted to the grantee. It must be executed by the feegrant
+	// module authority.
+	//
+	// Since: cosmos-sdk 0.50
+	RevokeAllowanceFromModule(ctx context.Context, in *MsgRevokeAllowanceFromModule, opts ...grpc.CallOption) (*MsgRevokeAllowanceFromModuleResponse, error)
 }
 
 type msgClient struct {
@@ -63,6 +77,24 @@ func (c *msgClient) RevokeAllowance(ctx context.Context, in *MsgRevokeAllowance,
 	return out, nil
 }
 
+func (c *msgClient) GrantAllowanceFromModule(ctx context.Context, in *MsgGrantAllowanceFromModule, opts ...grpc.CallOption) (*MsgGrantAllowanceFromModuleResponse, error) {
+	out := new(MsgGrantAllowanceFromModuleResponse)
+	err := c.cc.Invoke(ctx, Msg_GrantAllowanceFromModule_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) RevokeAllowanceFromModule(ctx context.Context, in *MsgRevokeAllowanceFromModule, opts ...grpc.CallOption) (*MsgRevokeAllowanceFromModuleResponse, error) {
+	out := new(MsgRevokeAllowanceFromModuleResponse)
+	err := c.cc.Invoke(ctx, Msg_RevokeAllowanceFromModule_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MsgServer is the server API for Msg service.
 // All implementations must embed UnimplementedMsgServer
 // for forward compatibility
@@ -73,6 +105,18 @@ type MsgServer interface {
 	// RevokeAllowance revokes any fee allowance of granter's account that
 	// has been granted to the grantee.
 	RevokeAllowance(context.Context, *MsgRevokeAllowance) (*MsgRevokeAllowanceResponse, error)
+	// GrantAllowanceFromModule grants fee allowance to the grantee on the
+	// account of a module, so that the module funds the fees of the grantee.
+	// It must be executed by the feegrant module authority.
+	//
+	// Since: cosmos-sdk 0.50
+	GrantAllowanceFromModule(context.Context, *MsgGrantAllowanceFromModule) (*MsgGrantAllowanceFromModuleResponse, error)
+	// RevokeAllowanceFromModule revokes any fee allowance of a module account
+	// that has been granted to the grantee. It must be executed by the feegrant
+	// module authority.
+	//
+	// Since: cosmos-sdk 0.50
+	RevokeAllowanceFromModule(context.Context, *MsgRevokeAllowanceFromModule) (*MsgRevokeAllowanceFromModuleResponse, error)
 	mustEmbedUnimplementedMsgServer()
 }
 
@@ -86,6 +130,12 @@ func (UnimplementedMsgServer) GrantAllowance(context.Context, *MsgGrantAllowance
 func (UnimplementedMsgServer) RevokeAllowance(context.Context, *MsgRevokeAllowance) (*MsgRevokeAllowanceResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method RevokeAllowance not implemented")
 }
+func (UnimplementedMsgServer) GrantAllowanceFromModule(context.Context, *MsgGrantAllowanceFromModule) (*MsgGrantAllowanceFromModuleResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GrantAllowanceFromModule not implemented")
+}
+func (UnimplementedMsgServer) RevokeAllowanceFromModule(context.Context, *MsgRevokeAllowanceFromModule) (*MsgRevokeAllowanceFromModuleResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RevokeAllowanceFromModule not implemented")
+}
 func (UnimplementedMsgServer) mustEmbedUnimplementedMsgServer() {}
 
 // UnsafeMsgServer may be embedded to opt out of forward compatibility for this service.
@@ -135,6 +185,42 @@ func _Msg_RevokeAllowance_Handler(srv interface{}, ctx context.Context, dec func
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_GrantAllowanceFromModule_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgGrantAllowanceFromModule)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).GrantAllowanceFromModule(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_GrantAllowanceFromModule_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).GrantAllowanceFromModule(ctx, req.(*MsgGrantAllowanceFromModule))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_RevokeAllowanceFromModule_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgRevokeAllowanceFromModule)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).RevokeAllowanceFromModule(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_RevokeAllowanceFromModule_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).RevokeAllowanceFromModule(ctx, req.(*MsgRevokeAllowanceFromModule))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // Msg_ServiceDesc is the grpc.ServiceDesc for Msg service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -150,6 +236,14 @@ var Msg_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "RevokeAllowance",
 			Handler:    _Msg_RevokeAllowance_Handler,
 		},
+		{
+			MethodName: "GrantAllowanceFromModule",
+			Handler:    _Msg_GrantAllowanceFromModule_Handler,
+		},
+		{
+			MethodName: "RevokeAllowanceFromModule",
+			Handler:    _Msg_RevokeAllowanceFromModule_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "cosmos/feegrant/v1beta1/tx.proto",
@@ -165,17 +165,225 @@ func (x *_GenesisState_4_list) IsValid() bool {
 	return x.list != nil
 }
 
+var _ protoreflect.List = (*_GenesisState_10_list)(nil)
+
+type _GenesisState_10_list struct {
+	list *[]*ConstitutionAmendment
+}
+
+func (x *_GenesisState_10_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_GenesisState_10_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_GenesisState_10_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*ConstitutionAmendment)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_GenesisState_10_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*ConstitutionAmendment)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_GenesisState_10_list) AppendMutable() protoreflect.Value {
+	v := new(ConstitutionAmendment)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_GenesisState_10_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_GenesisState_10_list) NewElement() protoreflect.Value {
+	v := new(ConstitutionAmendment)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_GenesisState_10_list) IsValid() bool {
+	return x.list != nil
+}
+
+var _ protoreflect.List = (*_GenesisState_11_list)(nil)
+
+type _GenesisState_11_list struct {
+	list *[]*VoteCommitment
+}
+
+func (x *_GenesisState_11_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_GenesisState_11_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_GenesisState_11_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*VoteCommitment)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_GenesisState_11_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*VoteCommitment)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_GenesisState_11_list) AppendMutable() protoreflect.Value {
+	v := new(VoteCommitment)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_GenesisState_11_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_GenesisState_11_list) NewElement() protoreflect.Value {
+	v := new(VoteCommitment)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_GenesisState_11_list) IsValid() bool {
+	return x.list != nil
+}
+
+var _ protoreflect.List = (*_GenesisState_12_list)(nil)
+
+type _GenesisState_12_list struct {
+	list *[]*ProposalExecutionReceipt
+}
+
+func (x *_GenesisState_12_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_GenesisState_12_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_GenesisState_12_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*ProposalExecutionReceipt)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_GenesisState_12_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*ProposalExecutionReceipt)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_GenesisState_12_list) AppendMutable() protoreflect.Value {
+	v := new(ProposalExecutionReceipt)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_GenesisState_12_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_GenesisState_12_list) NewElement() protoreflect.Value {
+	v := new(ProposalExecutionReceipt)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_GenesisState_12_list) IsValid() bool {
+	return x.list != nil
+}
+
+var _ protoreflect.List = (*_GenesisState_13_list)(nil)
+
+type _GenesisState_13_list struct {
+	list *[]*MetadataSchema
+}
+
+func (x *_GenesisState_13_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_GenesisState_13_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_GenesisState_13_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*MetadataSchema)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_GenesisState_13_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*MetadataSchema)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_GenesisState_13_list) AppendMutable() protoreflect.Value {
+	v := new(MetadataSchema)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_GenesisState_13_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_GenesisState_13_list) NewElement() protoreflect.Value {
+	v := new(MetadataSchema)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_GenesisState_13_list) IsValid() bool {
+	return x.list != nil
+}
+
 var (
-	md_GenesisState                      protoreflect.MessageDescriptor
-	fd_GenesisState_starting_proposal_id protoreflect.FieldDescriptor
-	fd_GenesisState_deposits             protoreflect.FieldDescriptor
-	fd_GenesisState_votes                protoreflect.FieldDescriptor
-	fd_GenesisState_proposals            protoreflect.FieldDescriptor
-	fd_GenesisState_deposit_params       protoreflect.FieldDescriptor
-	fd_GenesisState_voting_params        protoreflect.FieldDescriptor
-	fd_GenesisState_tally_params         protoreflect.FieldDescriptor
-	fd_GenesisState_params               protoreflect.FieldDescriptor
-	fd_GenesisState_constitution         protoreflect.FieldDescriptor
+	md_GenesisState                         protoreflect.MessageDescriptor
+	fd_GenesisState_starting_proposal_id    protoreflect.FieldDescriptor
+	fd_GenesisState_deposits                protoreflect.FieldDescriptor
+	fd_GenesisState_votes                   protoreflect.FieldDescriptor
+	fd_GenesisState_proposals               protoreflect.FieldDescriptor
+	fd_GenesisState_deposit_params          protoreflect.FieldDescriptor
+	fd_GenesisState_voting_params           protoreflect.FieldDescriptor
+	fd_GenesisState_tally_params            protoreflect.FieldDescriptor
+	fd_GenesisState_params                  protoreflect.FieldDescriptor
+	fd_GenesisState_constitution            protoreflect.FieldDescriptor
+	fd_GenesisState_constitution_amendments protoreflect.FieldDescriptor
+	fd_GenesisState_vote_commitments        protoreflect.FieldDescriptor
+	fd_GenesisState_execution_receipts      protoreflect.FieldDescriptor
+	fd_GenesisState_metadata_schemas        protoreflect.FieldDescriptor
 )
 
 func init() {
@@ -190,6 +398,10 @@ func init() {
 	fd_GenesisState_tally_params = md_GenesisState.Fields().ByName("tally_params")
 	fd_GenesisState_params = md_GenesisState.Fields().ByName("params")
 	fd_GenesisState_constitution = md_GenesisState.Fields().ByName("constitution")
+	fd_GenesisState_constitution_amendments = md_GenesisState.Fields().ByName("constitution_amendments")
+	fd_GenesisState_vote_commitments = md_GenesisState.Fields().ByName("vote_commitments")
+	fd_GenesisState_execution_receipts = md_GenesisState.Fields().ByName("execution_receipts")
+	fd_GenesisState_metadata_schemas = md_GenesisState.Fields().ByName("metadata_schemas")
 }
 
 var _ protoreflect.Message = (*fastReflection_GenesisState)(nil)
@@ -311,6 +523,30 @@ func (x *fastReflection_GenesisState) Range(f func(protoreflect.FieldDescriptor,
 			return
 		}
 	}
+	if len(x.ConstitutionAmendments) != 0 {
+		value := protoreflect.ValueOfList(&_GenesisState_10_list{list: &x.ConstitutionAmendments})
+		if !f(fd_GenesisState_constitution_amendments, value) {
+			return
+		}
+	}
+	if len(x.VoteCommitments) != 0 {
+		value := protoreflect.ValueOfList(&_GenesisState_11_list{list: &x.VoteCommitments})
+		if !f(fd_GenesisState_vote_commitments, value) {
+			return
+		}
+	}
+	if len(x.ExecutionReceipts) != 0 {
+		value := protoreflect.ValueOfList(&_GenesisState_12_list{list: &x.ExecutionReceipts})
+		if !f(fd_GenesisState_execution_receipts, value) {
+			return
+		}
+	}
+	if len(x.MetadataSchemas) != 0 {
+		value := protoreflect.ValueOfList(&_GenesisState_13_list{list: &x.MetadataSchemas})
+		if !f(fd_GenesisState_metadata_schemas, value) {
+			return
+		}
+	}
 }
 
 // Has reports whether a field is populated.
@@ -344,6 +580,14 @@ func (x *fastReflection_GenesisState) Has(fd protoreflect.FieldDescriptor) bool
 		return x.Params != nil
 	case "cosmos.gov.v1.GenesisState.constitution":
 		return x.Constitution != ""
+	case "cosmos.gov.v1.GenesisState.constitution_amendments":
+		return len(x.ConstitutionAmendments) != 0
+	case "cosmos.gov.v1.GenesisState.vote_commitments":
+		return len(x.VoteCommitments) != 0
+	case "cosmos.gov.v1.GenesisState.execution_receipts":
+		return len(x.ExecutionReceipts) != 0
+	case "cosmos.gov.v1.GenesisState.metadata_schemas":
+		return len(x.MetadataSchemas) != 0
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.gov.v1.GenesisState"))
@@ -378,6 +622,14 @@ func (x *fastReflection_GenesisState) Clear(fd protoreflect.FieldDescriptor) {
 		x.Params = nil
 	case "cosmos.gov.v1.GenesisState.constitution":
 		x.Constitution = ""
+	case "cosmos.gov.v1.GenesisState.constitution_amendments":
+		x.ConstitutionAmendments = nil
+	case "cosmos.gov.v1.GenesisState.vote_commitments":
+		x.VoteCommitments = nil
+	case "cosmos.gov.v1.GenesisState.execution_receipts":
+		x.ExecutionReceipts = nil
+	case "cosmos.gov.v1.GenesisState.metadata_schemas":
+		x.MetadataSchemas = nil
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.gov.v1.GenesisState"))
@@ -430,6 +682,30 @@ func (x *fastReflection_GenesisState) Get(descriptor protoreflect.FieldDescripto
 	case "cosmos.gov.v1.GenesisState.constitution":
 		value := x.Constitution
 		return protoreflect.ValueOfString(value)
+	case "cosmos.gov.v1.GenesisState.constitution_amendments":
+		if len(x.ConstitutionAmendments) == 0 {
+			return protoreflect.ValueOfList(&_GenesisState_10_list{})
+		}
+		listValue := &_GenesisState_10_list{list: &x.ConstitutionAmendments}
+		return protoreflect.ValueOfList(listValue)
+	case "cosmos.gov.v1.GenesisState.vote_commitments":
+		if len(x.VoteCommitments) == 0 {
+			return protoreflect.ValueOfList(&_GenesisState_11_list{})
+		}
+		listValue := &_GenesisState_11_list{list: &x.VoteCommitments}
+		return protoreflect.ValueOfList(listValue)
+	case "cosmos.gov.v1.GenesisState.execution_receipts":
+		if len(x.ExecutionReceipts) == 0 {
+			return protoreflect.ValueOfList(&_GenesisState_12_list{})
+		}
+		listValue := &_GenesisState_12_list{list: &x.ExecutionReceipts}
+		return protoreflect.ValueOfList(listValue)
+	case "cosmos.gov.v1.GenesisState.metadata_schemas":
+		if len(x.MetadataSchemas) == 0 {
+			return protoreflect.ValueOfList(&_GenesisState_13_list{})
+		}
+		listValue := &_GenesisState_13_list{list: &x.MetadataSchemas}
+		return protoreflect.ValueOfList(listValue)
 	default:
 		if descriptor.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.gov.v1.GenesisState"))
@@ -474,6 +750,22 @@ func (x *fastReflection_GenesisState) Set(fd protoreflect.FieldDescriptor, value
 		x.Params = value.Message().Interface().(*Params)
 	case "cosmos.gov.v1.GenesisState.constitution":
 		x.Constitution = value.Interface().(string)
+	case "cosmos.gov.v1.GenesisState.constitution_amendments":
+		lv := value.List()
+		clv := lv.(*_GenesisState_10_list)
+		x.ConstitutionAmendments = *clv.list
+	case "cosmos.gov.v1.GenesisState.vote_commitments":
+		lv := value.List()
+		clv := lv.(*_GenesisState_11_list)
+		x.VoteCommitments = *clv.list
+	case "cosmos.gov.v1.GenesisState.execution_receipts":
+		lv := value.List()
+		clv := lv.(*_GenesisState_12_list)
+		x.ExecutionReceipts = *clv.list
+	case "cosmos.gov.v1.GenesisState.metadata_schemas":
+		lv := value.List()
+		clv := lv.(*_GenesisState_13_list)
+		x.MetadataSchemas = *clv.list
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.gov.v1.GenesisState"))
@@ -532,6 +824,30 @@ func (x *fastReflection_GenesisState) Mutable(fd protoreflect.FieldDescriptor) p
 			x.Params = new(Params)
 		}
 		return protoreflect.ValueOfMessage(x.Params.ProtoReflect())
+	case "cosmos.gov.v1.GenesisState.constitution_amendments":
+		if x.ConstitutionAmendments == nil {
+			x.ConstitutionAmendments = []*ConstitutionAmendment{}
+		}
+		value := &_GenesisState_10_list{list: &x.ConstitutionAmendments}
+		return protoreflect.ValueOfList(value)
+	case "cosmos.gov.v1.GenesisState.vote_commitments":
+		if x.VoteCommitments == nil {
+			x.VoteCommitments = []*VoteCommitment{}
+		}
+		value := &_GenesisState_11_list{list: &x.VoteCommitments}
+		return protoreflect.ValueOfList(value)
+	case "cosmos.gov.v1.GenesisState.execution_receipts":
+		if x.ExecutionReceipts == nil {
+			x.ExecutionReceipts = []*ProposalExecutionReceipt{}
+		}
+		value := &_GenesisState_12_list{list: &x.ExecutionReceipts}
+		return protoreflect.ValueOfList(value)
+	case "cosmos.gov.v1.GenesisState.metadata_schemas":
+		if x.MetadataSchemas == nil {
+			x.MetadataSchemas = []*MetadataSchema{}
+		}
+		value := &_GenesisState_13_list{list: &x.MetadataSchemas}
+		return protoreflect.ValueOfList(value)
 	case "cosmos.gov.v1.GenesisState.starting_proposal_id":
 		panic(fmt.Errorf("field starting_proposal_id of message cosmos.gov.v1.GenesisState is not mutable"))
 	case "cosmos.gov.v1.GenesisState.constitution":
@@ -574,6 +890,18 @@ func (x *fastReflection_GenesisState) NewField(fd protoreflect.FieldDescriptor)
 		return protoreflect.ValueOfMessage(m.ProtoReflect())
 	case "cosmos.gov.v1.GenesisState.constitution":
 		return protoreflect.ValueOfString("")
+	case "cosmos.gov.v1.GenesisState.constitution_amendments":
+		list := []*ConstitutionAmendment{}
+		return protoreflect.ValueOfList(&_GenesisState_10_list{list: &list})
+	case "cosmos.gov.v1.GenesisState.vote_commitments":
+		list := []*VoteCommitment{}
+		return protoreflect.ValueOfList(&_GenesisState_11_list{list: &list})
+	case "cosmos.gov.v1.GenesisState.execution_receipts":
+		list := []*ProposalExecutionReceipt{}
+		return protoreflect.ValueOfList(&_GenesisState_12_list{list: &list})
+	case "cosmos.gov.v1.GenesisState.metadata_schemas":
+		list := []*MetadataSchema{}
+		return protoreflect.ValueOfList(&_GenesisState_13_list{list: &list})
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.gov.v1.GenesisState"))
@@ -684,6 +1012,30 @@ func (x *fastReflection_GenesisState) ProtoMethods() *protoiface.Methods {
 		if l > 0 {
 			n += 1 + l + runtime.Sov(uint64(l))
 		}
+		if len(x.ConstitutionAmendments) > 0 {
+			for _, e := range x.ConstitutionAmendments {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if len(x.VoteCommitments) > 0 {
+			for _, e := range x.VoteCommitments {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if len(x.ExecutionReceipts) > 0 {
+			for _, e := range x.ExecutionReceipts {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if len(x.MetadataSchemas) > 0 {
+			for _, e := range x.MetadataSchemas {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
 		}
@@ -713,6 +1065,70 @@ func (x *fastReflection_GenesisState) ProtoMethods() *protoiface.Methods {
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
+		if len(x.MetadataSchemas) > 0 {
+			for iNdEx := len(x.MetadataSchemas) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.MetadataSchemas[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x6a
+			}
+		}
+		if len(x.ExecutionReceipts) > 0 {
+			for iNdEx := len(x.ExecutionReceipts) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.ExecutionReceipts[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x62
+			}
+		}
+		if len(x.VoteCommitments) > 0 {
+			for iNdEx := len(x.VoteCommitments) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.VoteCommitments[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x5a
+			}
+		}
+		if len(x.ConstitutionAmendments) > 0 {
+			for iNdEx := len(x.ConstitutionAmendments) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.ConstitutionAmendments[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x52
+			}
+		}
 		if len(x.Constitution) > 0 {
 			i -= len(x.Constitution)
 			copy(dAtA[i:], x.Constitution)
@@ -1175,6 +1591,142 @@ func (x *fastReflection_GenesisState) ProtoMethods() *protoiface.Methods {
 				}
 				x.Constitution = string(dAtA[iNdEx:postIndex])
 				iNdEx = postIndex
+			case 10:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ConstitutionAmendments", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.ConstitutionAmendments = append(x.ConstitutionAmendments, &ConstitutionAmendment{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.ConstitutionAmendments[len(x.ConstitutionAmendments)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 11:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field VoteCommitments", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.VoteCommitments = append(x.VoteCommitments, &VoteCommitment{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.VoteCommitments[len(x.VoteCommitments)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 12:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ExecutionReceipts", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.ExecutionReceipts = append(x.ExecutionReceipts, &ProposalExecutionReceipt{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.ExecutionReceipts[len(x.ExecutionReceipts)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 13:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field MetadataSchemas", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.MetadataSchemas = append(x.MetadataSchemas, &MetadataSchema{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.MetadataSchemas[len(x.MetadataSchemas)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
 			default:
 				iNdEx = preIndex
 				skippy, err := runtime.Skip(dAtA[iNdEx:])
@@ -1259,12 +1811,28 @@ type GenesisState struct {
 	// Since: cosmos-sdk 0.47
 	Params *Params `protobuf:"bytes,8,opt,name=params,proto3" json:"params,omitempty"`
 	// The constitution allows builders to lay a foundation and define purpose.
-	// This is an immutable string set in genesis.
-	// There are no amendments, to go outside of scope, just fork.
-	// constitution is an immutable string in genesis for a chain builder to lay out their vision, ideas and ideals.
+	// constitution is a string set in genesis for a chain builder to lay out their vision, ideas and ideals.
+	// It can only be amended by constitution amendment proposals.
 	//
 	// Since: cosmos-sdk 0.48
 	Constitution string `protobuf:"bytes,9,opt,name=constitution,proto3" json:"constitution,omitempty"`
+	// constitution_amendments defines the amendments made to the constitution.
+	//
+	// Since: cosmos-sdk 0.48
+	ConstitutionAmendments []*ConstitutionAmendment `protobuf:"bytes,10,rep,name=constitution_amendments,json=constitutionAmendments,proto3" json:"constitution_amendments,omitempty"`
+	// vote_commitments defines the unrevealed vote commitments present at genesis.
+	//
+	// Since: cosmos-sdk 0.48
+	VoteCommitments []*VoteCommitment `protobuf:"bytes,11,rep,name=vote_commitments,json=voteCommitments,proto3" json:"vote_commitments,omitempty"`
+	// execution_receipts defines the execution receipts of the passed proposals
+	// present at genesis.
+	//
+	// Since: cosmos-sdk 0.48
+	ExecutionReceipts []*ProposalExecutionReceipt `protobuf:"bytes,12,rep,name=execution_receipts,json=executionReceipts,proto3" json:"execution_receipts,omitempty"`
+	// metadata_schemas defines the registered metadata schemas.
+	//
+	// Since: cosmos-sdk 0.48
+	MetadataSchemas []*MetadataSchema `protobuf:"bytes,13,rep,name=metadata_schemas,json=metadataSchemas,proto3" json:"metadata_schemas,omitempty"`
 }
 
 func (x *GenesisState) Reset() {
@@ -1353,6 +1921,34 @@ func (x *GenesisState) GetConstitution() string {
 	return ""
 }
 
+func (x *GenesisState) GetConstitutionAmendments() []*ConstitutionAmendment {
+	if x != nil {
+		return x.ConstitutionAmendments
+	}
+	return nil
+}
+
+func (x *GenesisState) GetVoteCommitments() []*VoteCommitment {
+	if x != nil {
+		return x.VoteCommitments
+	}
+	return nil
+}
+
+func (x *GenesisState) GetExecutionReceipts() []*ProposalExecutionReceipt {
+	if x != nil {
+		return x.ExecutionReceipts
+	}
+	return nil
+}
+
+func (x *GenesisState) GetMetadataSchemas() []*MetadataSchema {
+	if x != nil {
+		return x.MetadataSchemas
+	}
+	return nil
+}
+
 var File_cosmos_gov_v1_genesis_proto protoreflect.FileDescriptor
 
 var file_cosmos_gov_v1_genesis_proto_rawDesc = []byte{
@@ -1360,7 +1956,7 @@ var file_cosmos_gov_v1_genesis_proto_rawDesc = []byte{
 	0x67, 0x65, 0x6e, 0x65, 0x73, 0x69, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x0d, 0x63,
 	0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x67, 0x6f, 0x76, 0x2e, 0x76, 0x31, 0x1a, 0x17, 0x63, 0x6f,
 	0x73, 0x6d, 0x6f, 0x73, 0x2f, 0x67, 0x6f, 0x76, 0x2f, 0x76, 0x31, 0x2f, 0x67, 0x6f, 0x76, 0x2e,
-	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0xfb, 0x03, 0x0a, 0x0c, 0x47, 0x65, 0x6e, 0x65, 0x73, 0x69,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0xc6, 0x06, 0x0a, 0x0c, 0x47, 0x65, 0x6e, 0x65, 0x73, 0x69,
 	0x73, 0x53, 0x74, 0x61, 0x74, 0x65, 0x12, 0x30, 0x0a, 0x14, 0x73, 0x74, 0x61, 0x72, 0x74, 0x69,
 	0x6e, 0x67, 0x5f, 0x70, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x61, 0x6c, 0x5f, 0x69, 0x64, 0x18, 0x01,
 	0x20, 0x01, 0x28, 0x04, 0x52, 0x12, 0x73, 0x74, 0x61, 0x72, 0x74, 0x69, 0x6e, 0x67, 0x50, 0x72,
@@ -1392,17 +1988,38 @@ var file_cosmos_gov_v1_genesis_proto_rawDesc = []byte{
 	0x2e, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x52, 0x06, 0x70, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x12,
 	0x22, 0x0a, 0x0c, 0x63, 0x6f, 0x6e, 0x73, 0x74, 0x69, 0x74, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x18,
 	0x09, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x63, 0x6f, 0x6e, 0x73, 0x74, 0x69, 0x74, 0x75, 0x74,
-	0x69, 0x6f, 0x6e, 0x42, 0x9d, 0x01, 0x0a, 0x11, 0x63, 0x6f, 0x6d, 0x2e, 0x63, 0x6f, 0x73, 0x6d,
-	0x6f, 0x73, 0x2e, 0x67, 0x6f, 0x76, 0x2e, 0x76, 0x31, 0x42, 0x0c, 0x47, 0x65, 0x6e, 0x65, 0x73,
-	0x69, 0x73, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50, 0x01, 0x5a, 0x24, 0x63, 0x6f, 0x73, 0x6d, 0x6f,
-	0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x63, 0x6f, 0x73, 0x6d,
-	0x6f, 0x73, 0x2f, 0x67, 0x6f, 0x76, 0x2f, 0x76, 0x31, 0x3b, 0x67, 0x6f, 0x76, 0x76, 0x31, 0xa2,
-	0x02, 0x03, 0x43, 0x47, 0x58, 0xaa, 0x02, 0x0d, 0x43, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x47,
-	0x6f, 0x76, 0x2e, 0x56, 0x31, 0xca, 0x02, 0x0d, 0x43, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x5c, 0x47,
-	0x6f, 0x76, 0x5c, 0x56, 0x31, 0xe2, 0x02, 0x19, 0x43, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x5c, 0x47,
-	0x6f, 0x76, 0x5c, 0x56, 0x31, 0x5c, 0x47, 0x50, 0x42, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74,
-	0x61, 0xea, 0x02, 0x0f, 0x43, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x3a, 0x3a, 0x47, 0x6f, 0x76, 0x3a,
-	0x3a, 0x56, 0x31, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x69, 0x6f, 0x6e, 0x12, 0x5d, 0x0a, 0x17, 0x63, 0x6f, 0x6e, 0x73, 0x74, 0x69, 0x74, 0x75, 0x74,
+	0x69, 0x6f, 0x6e, 0x5f, 0x61, 0x6d, 0x65, 0x6e, 0x64, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x18, 0x0a,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x24, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x67, 0x6f,
+	0x76, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x6f, 0x6e, 0x73, 0x74, 0x69, 0x74, 0x75, 0x74, 0x69, 0x6f,
+	0x6e, 0x41, 0x6d, 0x65, 0x6e, 0x64, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x16, 0x63, 0x6f, 0x6e, 0x73,
+	0x74, 0x69, 0x74, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x41, 0x6d, 0x65, 0x6e, 0x64, 0x6d, 0x65, 0x6e,
+	0x74, 0x73, 0x12, 0x48, 0x0a, 0x10, 0x76, 0x6f, 0x74, 0x65, 0x5f, 0x63, 0x6f, 0x6d, 0x6d, 0x69,
+	0x74, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x18, 0x0b, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x63,
+	0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x67, 0x6f, 0x76, 0x2e, 0x76, 0x31, 0x2e, 0x56, 0x6f, 0x74,
+	0x65, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x0f, 0x76, 0x6f, 0x74,
+	0x65, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x12, 0x56, 0x0a, 0x12,
+	0x65, 0x78, 0x65, 0x63, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x72, 0x65, 0x63, 0x65, 0x69, 0x70,
+	0x74, 0x73, 0x18, 0x0c, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x27, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f,
+	0x73, 0x2e, 0x67, 0x6f, 0x76, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x61,
+	0x6c, 0x45, 0x78, 0x65, 0x63, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x63, 0x65, 0x69, 0x70,
+	0x74, 0x52, 0x11, 0x65, 0x78, 0x65, 0x63, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x63, 0x65,
+	0x69, 0x70, 0x74, 0x73, 0x12, 0x48, 0x0a, 0x10, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61,
+	0x5f, 0x73, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x73, 0x18, 0x0d, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1d,
+	0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x67, 0x6f, 0x76, 0x2e, 0x76, 0x31, 0x2e, 0x4d,
+	0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x53, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x52, 0x0f, 0x6d,
+	0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x53, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x73, 0x42, 0x9d,
+	0x01, 0x0a, 0x11, 0x63, 0x6f, 0x6d, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x67, 0x6f,
+	0x76, 0x2e, 0x76, 0x31, 0x42, 0x0c, 0x47, 0x65, 0x6e, 0x65, 0x73, 0x69, 0x73, 0x50, 0x72, 0x6f,
+	0x74, 0x6f, 0x50, 0x01, 0x5a, 0x24, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e,
+	0x69, 0x6f, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2f, 0x67, 0x6f,
+	0x76, 0x2f, 0x76, 0x31, 0x3b, 0x67, 0x6f, 0x76, 0x76, 0x31, 0xa2, 0x02, 0x03, 0x43, 0x47, 0x58,
+	0xaa, 0x02, 0x0d, 0x43, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x47, 0x6f, 0x76, 0x2e, 0x56, 0x31,
+	0xca, 0x02, 0x0d, 0x43, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x5c, 0x47, 0x6f, 0x76, 0x5c, 0x56, 0x31,
+	0xe2, 0x02, 0x19, 0x43, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x5c, 0x47, 0x6f, 0x76, 0x5c, 0x56, 0x31,
+	0x5c, 0x47, 0x50, 0x42, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0xea, 0x02, 0x0f, 0x43,
+	0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x3a, 0x3a, 0x47, 0x6f, 0x76, 0x3a, 0x3a, 0x56, 0x31, 0x62, 0x06,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -1419,28 +2036,36 @@ func file_cosmos_gov_v1_genesis_proto_rawDescGZIP() []byte {
 
 var file_cosmos_gov_v1_genesis_proto_msgTypes = make([]protoimpl.MessageInfo, 1)
 var file_cosmos_gov_v1_genesis_proto_goTypes = []interface{}{
-	(*GenesisState)(nil),  // 0: cosmos.gov.v1.GenesisState
-	(*Deposit)(nil),       // 1: cosmos.gov.v1.Deposit
-	(*Vote)(nil),          // 2: cosmos.gov.v1.Vote
-	(*Proposal)(nil),      // 3: cosmos.gov.v1.Proposal
-	(*DepositParams)(nil), // 4: cosmos.gov.v1.DepositParams
-	(*VotingParams)(nil),  // 5: cosmos.gov.v1.VotingParams
-	(*TallyParams)(nil),   // 6: cosmos.gov.v1.TallyParams
-	(*Params)(nil),        // 7: cosmos.gov.v1.Params
+	(*GenesisState)(nil),             // 0: cosmos.gov.v1.GenesisState
+	(*Deposit)(nil),                  // 1: cosmos.gov.v1.Deposit
+	(*Vote)(nil),                     // 2: cosmos.gov.v1.Vote
+	(*Proposal)(nil),                 // 3: cosmos.gov.v1.Proposal
+	(*DepositParams)(nil),            // 4: cosmos.gov.v1.DepositParams
+	(*VotingParams)(nil),             // 5: cosmos.gov.v1.VotingParams
+	(*TallyParams)(nil),              // 6: cosmos.gov.v1.TallyParams
+	(*Params)(nil),                   // 7: cosmos.gov.v1.Params
+	(*ConstitutionAmendment)(nil),    // 8: cosmos.gov.v1.ConstitutionAmendment
+	(*VoteCommitment)(nil),           // 9: cosmos.gov.v1.VoteCommitment
+	(*ProposalExecutionReceipt)(nil), // 10: cosmos.gov.v1.ProposalExecutionReceipt
+	(*MetadataSchema)(nil),           // 11: cosmos.gov.v1.MetadataSchema
 }
 var file_cosmos_gov_v1_genesis_proto_depIdxs = []int32{
-	1, // 0: cosmos.gov.v1.GenesisState.deposits:type_name -> cosmos.gov.v1.Deposit
-	2, // 1: cosmos.gov.v1.GenesisState.votes:type_name -> cosmos.gov.v1.Vote
-	3, // 2: cosmos.gov.v1.GenesisState.proposals:type_name -> cosmos.gov.v1.Proposal
-	4, // 3: cosmos.gov.v1.GenesisState.deposit_params:type_name -> cosmos.gov.v1.DepositParams
-	5, // 4: cosmos.gov.v1.GenesisState.voting_params:type_name -> cosmos.gov.v1.VotingParams
-	6, // 5: cosmos.gov.v1.GenesisState.tally_params:type_name -> cosmos.gov.v1.TallyParams
-	7, // 6: cosmos.gov.v1.GenesisState.params:type_name -> cosmos.gov.v1.Params
-	7, // [7:7] is the sub-list for method output_type
-	7, // [7:7] is the sub-list for method input_type
-	7, // [7:7] is the sub-list for extension type_name
-	7, // [7:7] is the sub-list for extension extendee
-	0, // [0:7] is the sub-list for field type_name
+	1,  // 0: cosmos.gov.v1.GenesisState.deposits:type_name -> cosmos.gov.v1.Deposit
+	2,  // 1: cosmos.gov.v1.GenesisState.votes:type_name -> cosmos.gov.v1.Vote
+	3,  // 2: cosmos.gov.v1.GenesisState.proposals:type_name -> cosmos.gov.v1.Proposal
+	4,  // 3: cosmos.gov.v1.GenesisState.deposit_params:type_name -> cosmos.gov.v1.DepositParams
+	5,  // 4: cosmos.gov.v1.GenesisState.voting_params:type_name -> cosmos.gov.v1.VotingParams
+	6,  // 5: cosmos.gov.v1.GenesisState.tally_params:type_name -> cosmos.gov.v1.TallyParams
+	7,  // 6: cosmos.gov.v1.GenesisState.params:type_name -> cosmos.gov.v1.Params
+	8,  // 7: cosmos.gov.v1.GenesisState.constitution_amendments:type_name -> cosmos.gov.v1.ConstitutionAmendment
+	9,  // 8: cosmos.gov.v1.GenesisState.vote_commitments:type_name -> cosmos.gov.v1.VoteCommitment
+	10, // 9: cosmos.gov.v1.GenesisState.execution_receipts:type_name -> cosmos.gov.v1.ProposalExecutionReceipt
+	11, // 10: cosmos.gov.v1.GenesisState.metadata_schemas:type_name -> cosmos.gov.v1.MetadataSchema
+	11, // [11:11] is the sub-list for method output_type
+	11, // [11:11] is the sub-list for method input_type
+	11, // [11:11] is the sub-list for extension type_name
+	11, // [11:11] is the sub-list for extension extendee
+	0,  // [0:11] is the sub-list for field type_name
 }
 
 func init() { file_cosmos_gov_v1_genesis_proto_init() }
@@ -539,10 +539,12 @@ func (x *_Deposit_3_list) IsValid() bool {
 }
 
 var (
-	md_Deposit             protoreflect.MessageDescriptor
-	fd_Deposit_proposal_id protoreflect.FieldDescriptor
-	fd_Deposit_depositor   protoreflect.FieldDescriptor
-	fd_Deposit_amount      protoreflect.FieldDescriptor
+	md_Deposit                    protoreflect.MessageDescriptor
+	fd_Deposit_proposal_id        protoreflect.FieldDescriptor
+	fd_Deposit_depositor          protoreflect.FieldDescriptor
+	fd_Deposit_amount             protoreflect.FieldDescriptor
+	fd_Deposit_metadata           protoreflect.FieldDescriptor
+	fd_Deposit_metadata_schema_id protoreflect.FieldDescriptor
 )
 
 func init() {
@@ -551,6 +553,8 @@ func init() {
 	fd_Deposit_proposal_id = md_Deposit.Fields().ByName("proposal_id")
 	fd_Deposit_depositor = md_Deposit.Fields().ByName("depositor")
 	fd_Deposit_amount = md_Deposit.Fields().ByName("amount")
+	fd_Deposit_metadata = md_Deposit.Fields().ByName("metadata")
+	fd_Deposit_metadata_schema_id = md_Deposit.Fields().ByName("metadata_schema_id")
 }
 
 var _ protoreflect.Message = (*fastReflection_Deposit)(nil)
@@ -636,6 +640,18 @@ func (x *fastReflection_Deposit) Range(f func(protoreflect.FieldDescriptor, prot
 			return
 		}
 	}
+	if x.Metadata != "" {
+		value := protoreflect.ValueOfString(x.Metadata)
+		if !f(fd_Deposit_metadata, value) {
+			return
+		}
+	}
+	if x.MetadataSchemaId != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.MetadataSchemaId)
+		if !f(fd_Deposit_metadata_schema_id, value) {
+			return
+		}
+	}
 }
 
 // Has reports whether a field is populated.
@@ -657,6 +673,10 @@ func (x *fastReflection_Deposit) Has(fd protoreflect.FieldDescriptor) bool {
 		return x.Depositor != ""
 	case "cosmos.gov.v1.Deposit.amount":
 		return len(x.Amount) != 0
+	case "cosmos.gov.v1.Deposit.metadata":
+		return x.Metadata != ""
+	case "cosmos.gov.v1.Deposit.metadata_schema_id":
+		return x.MetadataSchemaId != uint64(0)
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.gov.v1.Deposit"))
@@ -679,6 +699,10 @@ func (x *fastReflection_Deposit) Clear(fd protoreflect.FieldDescriptor) {
 		x.Depositor = ""
 	case "cosmos.gov.v1.Deposit.amount":
 		x.Amount = nil
+	case "cosmos.gov.v1.Deposit.metadata":
+		x.Metadata = ""
+	case "cosmos.gov.v1.Deposit.metadata_schema_id":
+		x.MetadataSchemaId = uint64(0)
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.gov.v1.Deposit"))
@@ -707,6 +731,12 @@ func (x *fastReflection_Deposit) Get(descriptor protoreflect.FieldDescriptor) pr
 		}
 		listValue := &_Deposit_3_list{list: &x.Amount}
 		return protoreflect.ValueOfList(listValue)
+	case "cosmos.gov.v1.Deposit.metadata":
+		value := x.Metadata
+		return protoreflect.ValueOfString(value)
+	case "cosmos.gov.v1.Deposit.metadata_schema_id":
+		value := x.MetadataSchemaId
+		return protoreflect.ValueOfUint64(value)
 	default:
 		if descriptor.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.gov.v1.Deposit"))
@@ -735,6 +765,10 @@ func (x *fastReflection_Deposit) Set(fd protoreflect.FieldDescriptor, value prot
 		lv := value.List()
 		clv := lv.(*_Deposit_3_list)
 		x.Amount = *clv.list
+	case "cosmos.gov.v1.Deposit.metadata":
+		x.Metadata = value.Interface().(string)
+	case "cosmos.gov.v1.Deposit.metadata_schema_id":
+		x.MetadataSchemaId = value.Uint()
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.gov.v1.Deposit"))
@@ -765,6 +799,10 @@ func (x *fastReflection_Deposit) Mutable(fd protoreflect.FieldDescriptor) protor
 		panic(fmt.Errorf("field proposal_id of message cosmos.gov.v1.Deposit is not mutable"))
 	case "cosmos.gov.v1.Deposit.depositor":
 		panic(fmt.Errorf("field depositor of message cosmos.gov.v1.Deposit is not mutable"))
+	case "cosmos.gov.v1.Deposit.metadata":
+		panic(fmt.Errorf("field metadata of message cosmos.gov.v1.Deposit is not mutable"))
+	case "cosmos.gov.v1.Deposit.metadata_schema_id":
+		panic(fmt.Errorf("field metadata_schema_id of message cosmos.gov.v1.Deposit is not mutable"))
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.gov.v1.Deposit"))
@@ -785,6 +823,10 @@ func (x *fastReflection_Deposit) NewField(fd protoreflect.FieldDescriptor) proto
 	case "cosmos.gov.v1.Deposit.amount":
 		list := []*v1beta1.Coin{}
 		return protoreflect.ValueOfList(&_Deposit_3_list{list: &list})
+	case "cosmos.gov.v1.Deposit.metadata":
+		return protoreflect.ValueOfString("")
+	case "cosmos.gov.v1.Deposit.metadata_schema_id":
+		return protoreflect.ValueOfUint64(uint64(0))
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.gov.v1.Deposit"))
@@ -867,6 +909,13 @@ func (x *fastReflection_Deposit) ProtoMethods() *protoiface.Methods {
 				n += 1 + l + runtime.Sov(uint64(l))
 			}
 		}
+		l = len(x.Metadata)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.MetadataSchemaId != 0 {
+			n += 1 + runtime.Sov(uint64(x.MetadataSchemaId))
+		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
 		}
@@ -896,6 +945,18 @@ func (x *fastReflection_Deposit) ProtoMethods() *protoiface.Methods {
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
+		if x.MetadataSchemaId != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.MetadataSchemaId))
+			i--
+			dAtA[i] = 0x28
+		}
+		if len(x.Metadata) > 0 {
+			i -= len(x.Metadata)
+			copy(dAtA[i:], x.Metadata)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Metadata)))
+			i--
+			dAtA[i] = 0x22
+		}
 		if len(x.Amount) > 0 {
 			for iNdEx := len(x.Amount) - 1; iNdEx >= 0; iNdEx-- {
 				encoded, err := options.Marshal(x.Amount[iNdEx])
@@ -1058,6 +1119,57 @@ func (x *fastReflection_Deposit) ProtoMethods() *protoiface.Methods {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
 				}
 				iNdEx = postIndex
+			case 4:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Metadata", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Metadata = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 5:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field MetadataSchemaId", wireType)
+				}
+				x.MetadataSchemaId = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.MetadataSchemaId |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
 			default:
 				iNdEx = preIndex
 				skippy, err := runtime.Skip(dAtA[iNdEx:])
@@ -1195,22 +1307,82 @@ func (x *_Proposal_7_list) IsValid() bool {
 	return x.list != nil
 }
 
+var _ protoreflect.List = (*_Proposal_15_list)(nil)
+
+type _Proposal_15_list struct {
+	list *[]*ParamsUpdateFailure
+}
+
+func (x *_Proposal_15_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_Proposal_15_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_Proposal_15_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*ParamsUpdateFailure)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_Proposal_15_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*ParamsUpdateFailure)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_Proposal_15_list) AppendMutable() protoreflect.Value {
+	v := new(ParamsUpdateFailure)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_Proposal_15_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_Proposal_15_list) NewElement() protoreflect.Value {
+	v := new(ParamsUpdateFailure)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_Proposal_15_list) IsValid() bool {
+	return x.list != nil
+}
+
 var (
-	md_Proposal                    protoreflect.MessageDescriptor
-	fd_Proposal_id                 protoreflect.FieldDescriptor
-	fd_Proposal_messages           protoreflect.FieldDescriptor
-	fd_Proposal_status             protoreflect.FieldDescriptor
-	fd_Proposal_final_tally_result protoreflect.FieldDescriptor
-	fd_Proposal_submit_time        protoreflect.FieldDescriptor
-	fd_Proposal_deposit_end_time   protoreflect.FieldDescriptor
-	fd_Proposal_total_deposit      protoreflect.FieldDescriptor
-	fd_Proposal_voting_start_time  protoreflect.FieldDescriptor
-	fd_Proposal_voting_end_time    protoreflect.FieldDescriptor
-	fd_Proposal_metadata           protoreflect.FieldDescriptor
-	fd_Proposal_title              protoreflect.FieldDescriptor
-	fd_Proposal_summary            protoreflect.FieldDescriptor
-	fd_Proposal_proposer           protoreflect.FieldDescriptor
-	fd_Proposal_expedited          protoreflect.FieldDescriptor
+	md_Proposal                         protoreflect.MessageDescriptor
+	fd_Proposal_id                      protoreflect.FieldDescriptor
+	fd_Proposal_messages                protoreflect.FieldDescriptor
+	fd_Proposal_status                  protoreflect.FieldDescriptor
+	fd_Proposal_final_tally_result      protoreflect.FieldDescriptor
+	fd_Proposal_submit_time             protoreflect.FieldDescriptor
+	fd_Proposal_deposit_end_time        protoreflect.FieldDescriptor
+	fd_Proposal_total_deposit           protoreflect.FieldDescriptor
+	fd_Proposal_voting_start_time       protoreflect.FieldDescriptor
+	fd_Proposal_voting_end_time         protoreflect.FieldDescriptor
+	fd_Proposal_metadata                protoreflect.FieldDescriptor
+	fd_Proposal_title                   protoreflect.FieldDescriptor
+	fd_Proposal_summary                 protoreflect.FieldDescriptor
+	fd_Proposal_proposer                protoreflect.FieldDescriptor
+	fd_Proposal_expedited               protoreflect.FieldDescriptor
+	fd_Proposal_params_update_failures  protoreflect.FieldDescriptor
+	fd_Proposal_deposit_period_extended protoreflect.FieldDescriptor
+	fd_Proposal_execution_authority     protoreflect.FieldDescriptor
+	fd_Proposal_discussion_end_time     protoreflect.FieldDescriptor
+	fd_Proposal_execution_failure       protoreflect.FieldDescriptor
+	fd_Proposal_invalidation_failure    protoreflect.FieldDescriptor
+	fd_Proposal_commit_reveal           protoreflect.FieldDescriptor
+	fd_Proposal_commit_end_time         protoreflect.FieldDescriptor
+	fd_Proposal_metadata_schema_id      protoreflect.FieldDescriptor
 )
 
 func init() {
@@ -1230,6 +1402,15 @@ func init() {
 	fd_Proposal_summary = md_Proposal.Fields().ByName("summary")
 	fd_Proposal_proposer = md_Proposal.Fields().ByName("proposer")
 	fd_Proposal_expedited = md_Proposal.Fields().ByName("expedited")
+	fd_Proposal_params_update_failures = md_Proposal.Fields().ByName("params_update_failures")
+	fd_Proposal_deposit_period_extended = md_Proposal.Fields().ByName("deposit_period_extended")
+	fd_Proposal_execution_authority = md_Proposal.Fields().ByName("execution_authority")
+	fd_Proposal_discussion_end_time = md_Proposal.Fields().ByName("discussion_end_time")
+	fd_Proposal_execution_failure = md_Proposal.Fields().ByName("execution_failure")
+	fd_Proposal_invalidation_failure = md_Proposal.Fields().ByName("invalidation_failure")
+	fd_Proposal_commit_reveal = md_Proposal.Fields().ByName("commit_reveal")
+	fd_Proposal_commit_end_time = md_Proposal.Fields().ByName("commit_end_time")
+	fd_Proposal_metadata_schema_id = md_Proposal.Fields().ByName("metadata_schema_id")
 }
 
 var _ protoreflect.Message = (*fastReflection_Proposal)(nil)
@@ -1381,6 +1562,60 @@ func (x *fastReflection_Proposal) Range(f func(protoreflect.FieldDescriptor, pro
 			return
 		}
 	}
+	if len(x.ParamsUpdateFailures) != 0 {
+		value := protoreflect.ValueOfList(&_Proposal_15_list{list: &x.ParamsUpdateFailures})
+		if !f(fd_Proposal_params_update_failures, value) {
+			return
+		}
+	}
+	if x.DepositPeriodExtended != false {
+		value := protoreflect.ValueOfBool(x.DepositPeriodExtended)
+		if !f(fd_Proposal_deposit_period_extended, value) {
+			return
+		}
+	}
+	if x.ExecutionAuthority != "" {
+		value := protoreflect.ValueOfString(x.ExecutionAuthority)
+		if !f(fd_Proposal_execution_authority, value) {
+			return
+		}
+	}
+	if x.DiscussionEndTime != nil {
+		value := protoreflect.ValueOfMessage(x.DiscussionEndTime.ProtoReflect())
+		if !f(fd_Proposal_discussion_end_time, value) {
+			return
+		}
+	}
+	if x.ExecutionFailure != nil {
+		value := protoreflect.ValueOfMessage(x.ExecutionFailure.ProtoReflect())
+		if !f(fd_Proposal_execution_failure, value) {
+			return
+		}
+	}
+	if x.InvalidationFailure != nil {
+		value := protoreflect.ValueOfMessage(x.InvalidationFailure.ProtoReflect())
+		if !f(fd_Proposal_invalidation_failure, value) {
+			return
+		}
+	}
+	if x.CommitReveal != false {
+		value := protoreflect.ValueOfBool(x.CommitReveal)
+		if !f(fd_Proposal_commit_reveal, value) {
+			return
+		}
+	}
+	if x.CommitEndTime != nil {
+		value := protoreflect.ValueOfMessage(x.CommitEndTime.ProtoReflect())
+		if !f(fd_Proposal_commit_end_time, value) {
+			return
+		}
+	}
+	if x.MetadataSchemaId != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.MetadataSchemaId)
+		if !f(fd_Proposal_metadata_schema_id, value) {
+			return
+		}
+	}
 }
 
 // Has reports whether a field is populated.
@@ -1424,6 +1659,24 @@ func (x *fastReflection_Proposal) Has(fd protoreflect.FieldDescriptor) bool {
 		return x.Proposer != ""
 	case "cosmos.gov.v1.Proposal.expedited":
 		return x.Expedited != false
+	case "cosmos.gov.v1.Proposal.params_update_failures":
+		return len(x.ParamsUpdateFailures) != 0
+	case "cosmos.gov.v1.Proposal.deposit_period_extended":
+		return x.DepositPeriodExtended != false
+	case "cosmos.gov.v1.Proposal.execution_authority":
+		return x.ExecutionAuthority != ""
+	case "cosmos.gov.v1.Proposal.discussion_end_time":
+		return x.DiscussionEndTime != nil
+	case "cosmos.gov.v1.Proposal.execution_failure":
+		return x.ExecutionFailure != nil
+	case "cosmos.gov.v1.Proposal.invalidation_failure":
+		return x.InvalidationFailure != nil
+	case "cosmos.gov.v1.Proposal.commit_reveal":
+		return x.CommitReveal != false
+	case "cosmos.gov.v1.Proposal.commit_end_time":
+		return x.CommitEndTime != nil
+	case "cosmos.gov.v1.Proposal.metadata_schema_id":
+		return x.MetadataSchemaId != uint64(0)
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.gov.v1.Proposal"))
@@ -1468,6 +1721,24 @@ func (x *fastReflection_Proposal) Clear(fd protoreflect.FieldDescriptor) {
 		x.Proposer = ""
 	case "cosmos.gov.v1.Proposal.expedited":
 		x.Expedited = false
+	case "cosmos.gov.v1.Proposal.params_update_failures":
+		x.ParamsUpdateFailures = nil
+	case "cosmos.gov.v1.Proposal.deposit_period_extended":
+		x.DepositPeriodExtended = false
+	case "cosmos.gov.v1.Proposal.execution_authority":
+		x.ExecutionAuthority = ""
+	case "cosmos.gov.v1.Proposal.discussion_end_time":
+		x.DiscussionEndTime = nil
+	case "cosmos.gov.v1.Proposal.execution_failure":
+		x.ExecutionFailure = nil
+	case "cosmos.gov.v1.Proposal.invalidation_failure":
+		x.InvalidationFailure = nil
+	case "cosmos.gov.v1.Proposal.commit_reveal":
+		x.CommitReveal = false
+	case "cosmos.gov.v1.Proposal.commit_end_time":
+		x.CommitEndTime = nil
+	case "cosmos.gov.v1.Proposal.metadata_schema_id":
+		x.MetadataSchemaId = uint64(0)
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.gov.v1.Proposal"))
@@ -1532,6 +1803,36 @@ func (x *fastReflection_Proposal) Get(descriptor protoreflect.FieldDescriptor) p
 	case "cosmos.gov.v1.Proposal.expedited":
 		value := x.Expedited
 		return protoreflect.ValueOfBool(value)
+	case "cosmos.gov.v1.Proposal.params_update_failures":
+		if len(x.ParamsUpdateFailures) == 0 {
+			return protoreflect.ValueOfList(&_Proposal_15_list{})
+		}
+		listValue := &_Proposal_15_list{list: &x.ParamsUpdateFailures}
+		return protoreflect.ValueOfList(listValue)
+	case "cosmos.gov.v1.Proposal.deposit_period_extended":
+		value := x.DepositPeriodExtended
+		return protoreflect.ValueOfBool(value)
+	case "cosmos.gov.v1.Proposal.execution_authority":
+		value := x.ExecutionAuthority
+		return protoreflect.ValueOfString(value)
+	case "cosmos.gov.v1.Proposal.discussion_end_time":
+		value := x.DiscussionEndTime
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "cosmos.gov.v1.Proposal.execution_failure":
+		value := x.ExecutionFailure
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "cosmos.gov.v1.Proposal.invalidation_failure":
+		value := x.InvalidationFailure
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "cosmos.gov.v1.Proposal.commit_reveal":
+		value := x.CommitReveal
+		return protoreflect.ValueOfBool(value)
+	case "cosmos.gov.v1.Proposal.commit_end_time":
+		value := x.CommitEndTime
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "cosmos.gov.v1.Proposal.metadata_schema_id":
+		value := x.MetadataSchemaId
+		return protoreflect.ValueOfUint64(value)
 	default:
 		if descriptor.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.gov.v1.Proposal"))
@@ -1584,6 +1885,26 @@ func (x *fastReflection_Proposal) Set(fd protoreflect.FieldDescriptor, value pro
 		x.Proposer = value.Interface().(string)
 	case "cosmos.gov.v1.Proposal.expedited":
 		x.Expedited = value.Bool()
+	case "cosmos.gov.v1.Proposal.params_update_failures":
+		lv := value.List()
+		clv := lv.(*_Proposal_15_list)
+		x.ParamsUpdateFailures = *clv.list
+	case "cosmos.gov.v1.Proposal.deposit_period_extended":
+		x.DepositPeriodExtended = value.Bool()
+	case "cosmos.gov.v1.Proposal.execution_authority":
+		x.ExecutionAuthority = value.Interface().(string)
+	case "cosmos.gov.v1.Proposal.discussion_end_time":
+		x.DiscussionEndTime = value.Message().Interface().(*timestamppb.Timestamp)
+	case "cosmos.gov.v1.Proposal.execution_failure":
+		x.ExecutionFailure = value.Message().Interface().(*ProposalExecutionFailure)
+	case "cosmos.gov.v1.Proposal.invalidation_failure":
+		x.InvalidationFailure = value.Message().Interface().(*ProposalExecutionFailure)
+	case "cosmos.gov.v1.Proposal.commit_reveal":
+		x.CommitReveal = value.Bool()
+	case "cosmos.gov.v1.Proposal.commit_end_time":
+		x.CommitEndTime = value.Message().Interface().(*timestamppb.Timestamp)
+	case "cosmos.gov.v1.Proposal.metadata_schema_id":
+		x.MetadataSchemaId = value.Uint()
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.gov.v1.Proposal"))
@@ -1641,6 +1962,32 @@ func (x *fastReflection_Proposal) Mutable(fd protoreflect.FieldDescriptor) proto
 			x.VotingEndTime = new(timestamppb.Timestamp)
 		}
 		return protoreflect.ValueOfMessage(x.VotingEndTime.ProtoReflect())
+	case "cosmos.gov.v1.Proposal.params_update_failures":
+		if x.ParamsUpdateFailures == nil {
+			x.ParamsUpdateFailures = []*ParamsUpdateFailure{}
+		}
+		value := &_Proposal_15_list{list: &x.ParamsUpdateFailures}
+		return protoreflect.ValueOfList(value)
+	case "cosmos.gov.v1.Proposal.discussion_end_time":
+		if x.DiscussionEndTime == nil {
+			x.DiscussionEndTime = new(timestamppb.Timestamp)
+		}
+		return protoreflect.ValueOfMessage(x.DiscussionEndTime.ProtoReflect())
+	case "cosmos.gov.v1.Proposal.execution_failure":
+		if x.ExecutionFailure == nil {
+			x.ExecutionFailure = new(ProposalExecutionFailure)
+		}
+		return protoreflect.ValueOfMessage(x.ExecutionFailure.ProtoReflect())
+	case "cosmos.gov.v1.Proposal.invalidation_failure":
+		if x.InvalidationFailure == nil {
+			x.InvalidationFailure = new(ProposalExecutionFailure)
+		}
+		return protoreflect.ValueOfMessage(x.InvalidationFailure.ProtoReflect())
+	case "cosmos.gov.v1.Proposal.commit_end_time":
+		if x.CommitEndTime == nil {
+			x.CommitEndTime = new(timestamppb.Timestamp)
+		}
+		return protoreflect.ValueOfMessage(x.CommitEndTime.ProtoReflect())
 	case "cosmos.gov.v1.Proposal.id":
 		panic(fmt.Errorf("field id of message cosmos.gov.v1.Proposal is not mutable"))
 	case "cosmos.gov.v1.Proposal.status":
@@ -1655,6 +2002,14 @@ func (x *fastReflection_Proposal) Mutable(fd protoreflect.FieldDescriptor) proto
 		panic(fmt.Errorf("field proposer of message cosmos.gov.v1.Proposal is not mutable"))
 	case "cosmos.gov.v1.Proposal.expedited":
 		panic(fmt.Errorf("field expedited of message cosmos.gov.v1.Proposal is not mutable"))
+	case "cosmos.gov.v1.Proposal.deposit_period_extended":
+		panic(fmt.Errorf("field deposit_period_extended of message cosmos.gov.v1.Proposal is not mutable"))
+	case "cosmos.gov.v1.Proposal.execution_authority":
+		panic(fmt.Errorf("field execution_authority of message cosmos.gov.v1.Proposal is not mutable"))
+	case "cosmos.gov.v1.Proposal.commit_reveal":
+		panic(fmt.Errorf("field commit_reveal of message cosmos.gov.v1.Proposal is not mutable"))
+	case "cosmos.gov.v1.Proposal.metadata_schema_id":
+		panic(fmt.Errorf("field metadata_schema_id of message cosmos.gov.v1.Proposal is not mutable"))
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.gov.v1.Proposal"))
@@ -1703,6 +2058,29 @@ func (x *fastReflection_Proposal) NewField(fd protoreflect.FieldDescriptor) prot
 		return protoreflect.ValueOfString("")
 	case "cosmos.gov.v1.Proposal.expedited":
 		return protoreflect.ValueOfBool(false)
+	case "cosmos.gov.v1.Proposal.params_update_failures":
+		list := []*ParamsUpdateFailure{}
+		return protoreflect.ValueOfList(&_Proposal_15_list{list: &list})
+	case "cosmos.gov.v1.Proposal.deposit_period_extended":
+		return protoreflect.ValueOfBool(false)
+	case "cosmos.gov.v1.Proposal.execution_authority":
+		return protoreflect.ValueOfString("")
+	case "cosmos.gov.v1.Proposal.discussion_end_time":
+		m := new(timestamppb.Timestamp)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case "cosmos.gov.v1.Proposal.execution_failure":
+		m := new(ProposalExecutionFailure)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case "cosmos.gov.v1.Proposal.invalidation_failure":
+		m := new(ProposalExecutionFailure)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case "cosmos.gov.v1.Proposal.commit_reveal":
+		return protoreflect.ValueOfBool(false)
+	case "cosmos.gov.v1.Proposal.commit_end_time":
+		m := new(timestamppb.Timestamp)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case "cosmos.gov.v1.Proposal.metadata_schema_id":
+		return protoreflect.ValueOfUint64(uint64(0))
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.gov.v1.Proposal"))
@@ -1829,6 +2207,41 @@ func (x *fastReflection_Proposal) ProtoMethods() *protoiface.Methods {
 		if x.Expedited {
 			n += 2
 		}
+		if len(x.ParamsUpdateFailures) > 0 {
+			for _, e := range x.ParamsUpdateFailures {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if x.DepositPeriodExtended {
+			n += 3
+		}
+		l = len(x.ExecutionAuthority)
+		if l > 0 {
+			n += 2 + l + runtime.Sov(uint64(l))
+		}
+		if x.DiscussionEndTime != nil {
+			l = options.Size(x.DiscussionEndTime)
+			n += 2 + l + runtime.Sov(uint64(l))
+		}
+		if x.ExecutionFailure != nil {
+			l = options.Size(x.ExecutionFailure)
+			n += 2 + l + runtime.Sov(uint64(l))
+		}
+		if x.InvalidationFailure != nil {
+			l = options.Size(x.InvalidationFailure)
+			n += 2 + l + runtime.Sov(uint64(l))
+		}
+		if x.CommitReveal {
+			n += 3
+		}
+		if x.CommitEndTime != nil {
+			l = options.Size(x.CommitEndTime)
+			n += 2 + l + runtime.Sov(uint64(l))
+		}
+		if x.MetadataSchemaId != 0 {
+			n += 2 + runtime.Sov(uint64(x.MetadataSchemaId))
+		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
 		}
@@ -1858,46 +2271,15 @@ func (x *fastReflection_Proposal) ProtoMethods() *protoiface.Methods {
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
-		if x.Expedited {
-			i--
-			if x.Expedited {
-				dAtA[i] = 1
-			} else {
-				dAtA[i] = 0
-			}
-			i--
-			dAtA[i] = 0x70
-		}
-		if len(x.Proposer) > 0 {
-			i -= len(x.Proposer)
-			copy(dAtA[i:], x.Proposer)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Proposer)))
-			i--
-			dAtA[i] = 0x6a
-		}
-		if len(x.Summary) > 0 {
-			i -= len(x.Summary)
-			copy(dAtA[i:], x.Summary)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Summary)))
-			i--
-			dAtA[i] = 0x62
-		}
-		if len(x.Title) > 0 {
-			i -= len(x.Title)
-			copy(dAtA[i:], x.Title)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Title)))
+		if x.MetadataSchemaId != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.MetadataSchemaId))
 			i--
-			dAtA[i] = 0x5a
-		}
-		if len(x.Metadata) > 0 {
-			i -= len(x.Metadata)
-			copy(dAtA[i:], x.Metadata)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Metadata)))
+			dAtA[i] = 0x1
 			i--
-			dAtA[i] = 0x52
+			dAtA[i] = 0xb8
 		}
-		if x.VotingEndTime != nil {
-			encoded, err := options.Marshal(x.VotingEndTime)
+		if x.CommitEndTime != nil {
+			encoded, err := options.Marshal(x.CommitEndTime)
 			if err != nil {
 				return protoiface.MarshalOutput{
 					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -1908,10 +2290,24 @@ func (x *fastReflection_Proposal) ProtoMethods() *protoiface.Methods {
 			copy(dAtA[i:], encoded)
 			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
 			i--
-			dAtA[i] = 0x4a
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0xb2
 		}
-		if x.VotingStartTime != nil {
-			encoded, err := options.Marshal(x.VotingStartTime)
+		if x.CommitReveal {
+			i--
+			if x.CommitReveal {
+				dAtA[i] = 1
+			} else {
+				dAtA[i] = 0
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0xa8
+		}
+		if x.InvalidationFailure != nil {
+			encoded, err := options.Marshal(x.InvalidationFailure)
 			if err != nil {
 				return protoiface.MarshalOutput{
 					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -1922,26 +2318,12 @@ func (x *fastReflection_Proposal) ProtoMethods() *protoiface.Methods {
 			copy(dAtA[i:], encoded)
 			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
 			i--
-			dAtA[i] = 0x42
-		}
-		if len(x.TotalDeposit) > 0 {
-			for iNdEx := len(x.TotalDeposit) - 1; iNdEx >= 0; iNdEx-- {
-				encoded, err := options.Marshal(x.TotalDeposit[iNdEx])
-				if err != nil {
-					return protoiface.MarshalOutput{
-						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
-						Buf:               input.Buf,
-					}, err
-				}
-				i -= len(encoded)
-				copy(dAtA[i:], encoded)
-				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
-				i--
-				dAtA[i] = 0x3a
-			}
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0xa2
 		}
-		if x.DepositEndTime != nil {
-			encoded, err := options.Marshal(x.DepositEndTime)
+		if x.ExecutionFailure != nil {
+			encoded, err := options.Marshal(x.ExecutionFailure)
 			if err != nil {
 				return protoiface.MarshalOutput{
 					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -1952,10 +2334,12 @@ func (x *fastReflection_Proposal) ProtoMethods() *protoiface.Methods {
 			copy(dAtA[i:], encoded)
 			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
 			i--
-			dAtA[i] = 0x32
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0x9a
 		}
-		if x.SubmitTime != nil {
-			encoded, err := options.Marshal(x.SubmitTime)
+		if x.DiscussionEndTime != nil {
+			encoded, err := options.Marshal(x.DiscussionEndTime)
 			if err != nil {
 				return protoiface.MarshalOutput{
 					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -1966,12 +2350,161 @@ func (x *fastReflection_Proposal) ProtoMethods() *protoiface.Methods {
 			copy(dAtA[i:], encoded)
 			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
 			i--
-			dAtA[i] = 0x2a
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0x92
 		}
-		if x.FinalTallyResult != nil {
-			encoded, err := options.Marshal(x.FinalTallyResult)
-			if err != nil {
-				return protoiface.MarshalOutput{
+		if len(x.ExecutionAuthority) > 0 {
+			i -= len(x.ExecutionAuthority)
+			copy(dAtA[i:], x.ExecutionAuthority)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.ExecutionAuthority)))
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0x8a
+		}
+		if x.DepositPeriodExtended {
+			i--
+			if x.DepositPeriodExtended {
+				dAtA[i] = 1
+			} else {
+				dAtA[i] = 0
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0x80
+		}
+		if len(x.ParamsUpdateFailures) > 0 {
+			for iNdEx := len(x.ParamsUpdateFailures) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.ParamsUpdateFailures[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x7a
+			}
+		}
+		if x.Expedited {
+			i--
+			if x.Expedited {
+				dAtA[i] = 1
+			} else {
+				dAtA[i] = 0
+			}
+			i--
+			dAtA[i] = 0x70
+		}
+		if len(x.Proposer) > 0 {
+			i -= len(x.Proposer)
+			copy(dAtA[i:], x.Proposer)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Proposer)))
+			i--
+			dAtA[i] = 0x6a
+		}
+		if len(x.Summary) > 0 {
+			i -= len(x.Summary)
+			copy(dAtA[i:], x.Summary)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Summary)))
+			i--
+			dAtA[i] = 0x62
+		}
+		if len(x.Title) > 0 {
+			i -= len(x.Title)
+			copy(dAtA[i:], x.Title)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Title)))
+			i--
+			dAtA[i] = 0x5a
+		}
+		if len(x.Metadata) > 0 {
+			i -= len(x.Metadata)
+			copy(dAtA[i:], x.Metadata)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Metadata)))
+			i--
+			dAtA[i] = 0x52
+		}
+		if x.VotingEndTime != nil {
+			encoded, err := options.Marshal(x.VotingEndTime)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x4a
+		}
+		if x.VotingStartTime != nil {
+			encoded, err := options.Marshal(x.VotingStartTime)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x42
+		}
+		if len(x.TotalDeposit) > 0 {
+			for iNdEx := len(x.TotalDeposit) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.TotalDeposit[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x3a
+			}
+		}
+		if x.DepositEndTime != nil {
+			encoded, err := options.Marshal(x.DepositEndTime)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x32
+		}
+		if x.SubmitTime != nil {
+			encoded, err := options.Marshal(x.SubmitTime)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x2a
+		}
+		if x.FinalTallyResult != nil {
+			encoded, err := options.Marshal(x.FinalTallyResult)
+			if err != nil {
+				return protoiface.MarshalOutput{
 					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
 					Buf:               input.Buf,
 				}, err
@@ -2491,67 +3024,334 @@ func (x *fastReflection_Proposal) ProtoMethods() *protoiface.Methods {
 					}
 				}
 				x.Expedited = bool(v != 0)
-			default:
-				iNdEx = preIndex
-				skippy, err := runtime.Skip(dAtA[iNdEx:])
-				if err != nil {
+			case 15:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ParamsUpdateFailures", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.ParamsUpdateFailures = append(x.ParamsUpdateFailures, &ParamsUpdateFailure{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.ParamsUpdateFailures[len(x.ParamsUpdateFailures)-1]); err != nil {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
 				}
-				if (skippy < 0) || (iNdEx+skippy) < 0 {
+				iNdEx = postIndex
+			case 16:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field DepositPeriodExtended", wireType)
+				}
+				var v int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				x.DepositPeriodExtended = bool(v != 0)
+			case 17:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ExecutionAuthority", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 				}
-				if (iNdEx + skippy) > l {
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				if !options.DiscardUnknown {
-					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				x.ExecutionAuthority = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 18:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field DiscussionEndTime", wireType)
 				}
-				iNdEx += skippy
-			}
-		}
-
-		if iNdEx > l {
-			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-		}
-		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
-	}
-	return &protoiface.Methods{
-		NoUnkeyedLiterals: struct{}{},
-		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
-		Size:              size,
-		Marshal:           marshal,
-		Unmarshal:         unmarshal,
-		Merge:             nil,
-		CheckInitialized:  nil,
-	}
-}
-
-var (
-	md_TallyResult                    protoreflect.MessageDescriptor
-	fd_TallyResult_yes_count          protoreflect.FieldDescriptor
-	fd_TallyResult_abstain_count      protoreflect.FieldDescriptor
-	fd_TallyResult_no_count           protoreflect.FieldDescriptor
-	fd_TallyResult_no_with_veto_count protoreflect.FieldDescriptor
-)
-
-func init() {
-	file_cosmos_gov_v1_gov_proto_init()
-	md_TallyResult = File_cosmos_gov_v1_gov_proto.Messages().ByName("TallyResult")
-	fd_TallyResult_yes_count = md_TallyResult.Fields().ByName("yes_count")
-	fd_TallyResult_abstain_count = md_TallyResult.Fields().ByName("abstain_count")
-	fd_TallyResult_no_count = md_TallyResult.Fields().ByName("no_count")
-	fd_TallyResult_no_with_veto_count = md_TallyResult.Fields().ByName("no_with_veto_count")
-}
-
-var _ protoreflect.Message = (*fastReflection_TallyResult)(nil)
-
-type fastReflection_TallyResult TallyResult
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.DiscussionEndTime == nil {
+					x.DiscussionEndTime = &timestamppb.Timestamp{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.DiscussionEndTime); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 19:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ExecutionFailure", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.ExecutionFailure == nil {
+					x.ExecutionFailure = &ProposalExecutionFailure{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.ExecutionFailure); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 20:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field InvalidationFailure", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.InvalidationFailure == nil {
+					x.InvalidationFailure = &ProposalExecutionFailure{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.InvalidationFailure); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 21:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field CommitReveal", wireType)
+				}
+				var v int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				x.CommitReveal = bool(v != 0)
+			case 22:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field CommitEndTime", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.CommitEndTime == nil {
+					x.CommitEndTime = &timestamppb.Timestamp{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.CommitEndTime); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 23:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field MetadataSchemaId", wireType)
+				}
+				x.MetadataSchemaId = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.MetadataSchemaId |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
 
-func (x *TallyResult) ProtoReflect() protoreflect.Message {
-	return (*fastReflection_TallyResult)(x)
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
 }
 
-func (x *TallyResult) slowProtoReflect() protoreflect.Message {
+var (
+	md_ProposalExecutionFailure              protoreflect.MessageDescriptor
+	fd_ProposalExecutionFailure_msg_index    protoreflect.FieldDescriptor
+	fd_ProposalExecutionFailure_msg_type_url protoreflect.FieldDescriptor
+	fd_ProposalExecutionFailure_reason       protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_cosmos_gov_v1_gov_proto_init()
+	md_ProposalExecutionFailure = File_cosmos_gov_v1_gov_proto.Messages().ByName("ProposalExecutionFailure")
+	fd_ProposalExecutionFailure_msg_index = md_ProposalExecutionFailure.Fields().ByName("msg_index")
+	fd_ProposalExecutionFailure_msg_type_url = md_ProposalExecutionFailure.Fields().ByName("msg_type_url")
+	fd_ProposalExecutionFailure_reason = md_ProposalExecutionFailure.Fields().ByName("reason")
+}
+
+var _ protoreflect.Message = (*fastReflection_ProposalExecutionFailure)(nil)
+
+type fastReflection_ProposalExecutionFailure ProposalExecutionFailure
+
+func (x *ProposalExecutionFailure) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_ProposalExecutionFailure)(x)
+}
+
+func (x *ProposalExecutionFailure) slowProtoReflect() protoreflect.Message {
 	mi := &file_cosmos_gov_v1_gov_proto_msgTypes[3]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2563,43 +3363,43 @@ func (x *TallyResult) slowProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-var _fastReflection_TallyResult_messageType fastReflection_TallyResult_messageType
-var _ protoreflect.MessageType = fastReflection_TallyResult_messageType{}
+var _fastReflection_ProposalExecutionFailure_messageType fastReflection_ProposalExecutionFailure_messageType
+var _ protoreflect.MessageType = fastReflection_ProposalExecutionFailure_messageType{}
 
-type fastReflection_TallyResult_messageType struct{}
+type fastReflection_ProposalExecutionFailure_messageType struct{}
 
-func (x fastReflection_TallyResult_messageType) Zero() protoreflect.Message {
-	return (*fastReflection_TallyResult)(nil)
+func (x fastReflection_ProposalExecutionFailure_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_ProposalExecutionFailure)(nil)
 }
-func (x fastReflection_TallyResult_messageType) New() protoreflect.Message {
-	return new(fastReflection_TallyResult)
+func (x fastReflection_ProposalExecutionFailure_messageType) New() protoreflect.Message {
+	return new(fastReflection_ProposalExecutionFailure)
 }
-func (x fastReflection_TallyResult_messageType) Descriptor() protoreflect.MessageDescriptor {
-	return md_TallyResult
+func (x fastReflection_ProposalExecutionFailure_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_ProposalExecutionFailure
 }
 
 // Descriptor returns message descriptor, which contains only the protobuf
 // type information for the message.
-func (x *fastReflection_TallyResult) Descriptor() protoreflect.MessageDescriptor {
-	return md_TallyResult
+func (x *fastReflection_ProposalExecutionFailure) Descriptor() protoreflect.MessageDescriptor {
+	return md_ProposalExecutionFailure
 }
 
 // Type returns the message type, which encapsulates both Go and protobuf
 // type information. If the Go type information is not needed,
 // it is recommended that the message descriptor be used instead.
-func (x *fastReflection_TallyResult) Type() protoreflect.MessageType {
-	return _fastReflection_TallyResult_messageType
+func (x *fastReflection_ProposalExecutionFailure) Type() protoreflect.MessageType {
+	return _fastReflection_ProposalExecutionFailure_messageType
 }
 
 // New returns a newly allocated and mutable empty message.
-func (x *fastReflection_TallyResult) New() protoreflect.Message {
-	return new(fastReflection_TallyResult)
+func (x *fastReflection_ProposalExecutionFailure) New() protoreflect.Message {
+	return new(fastReflection_ProposalExecutionFailure)
 }
 
 // Interface unwraps the message reflection interface and
 // returns the underlying ProtoMessage interface.
-func (x *fastReflection_TallyResult) Interface() protoreflect.ProtoMessage {
-	return (*TallyResult)(x)
+func (x *fastReflection_ProposalExecutionFailure) Interface() protoreflect.ProtoMessage {
+	return (*ProposalExecutionFailure)(x)
 }
 
 // Range iterates over every populated field in an undefined order,
@@ -2607,28 +3407,22 @@ func (x *fastReflection_TallyResult) Interface() protoreflect.ProtoMessage {
 // Range returns immediately if f returns false.
 // While iterating, mutating operations may only be performed
 // on the current field descriptor.
-func (x *fastReflection_TallyResult) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
-	if x.YesCount != "" {
-		value := protoreflect.ValueOfString(x.YesCount)
-		if !f(fd_TallyResult_yes_count, value) {
-			return
-		}
-	}
-	if x.AbstainCount != "" {
-		value := protoreflect.ValueOfString(x.AbstainCount)
-		if !f(fd_TallyResult_abstain_count, value) {
+func (x *fastReflection_ProposalExecutionFailure) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.MsgIndex != uint32(0) {
+		value := protoreflect.ValueOfUint32(x.MsgIndex)
+		if !f(fd_ProposalExecutionFailure_msg_index, value) {
 			return
 		}
 	}
-	if x.NoCount != "" {
-		value := protoreflect.ValueOfString(x.NoCount)
-		if !f(fd_TallyResult_no_count, value) {
+	if x.MsgTypeUrl != "" {
+		value := protoreflect.ValueOfString(x.MsgTypeUrl)
+		if !f(fd_ProposalExecutionFailure_msg_type_url, value) {
 			return
 		}
 	}
-	if x.NoWithVetoCount != "" {
-		value := protoreflect.ValueOfString(x.NoWithVetoCount)
-		if !f(fd_TallyResult_no_with_veto_count, value) {
+	if x.Reason != "" {
+		value := protoreflect.ValueOfString(x.Reason)
+		if !f(fd_ProposalExecutionFailure_reason, value) {
 			return
 		}
 	}
@@ -2645,21 +3439,19 @@ func (x *fastReflection_TallyResult) Range(f func(protoreflect.FieldDescriptor,
 // In other cases (aside from the nullable cases above),
 // a proto3 scalar field is populated if it contains a non-zero value, and
 // a repeated field is populated if it is non-empty.
-func (x *fastReflection_TallyResult) Has(fd protoreflect.FieldDescriptor) bool {
+func (x *fastReflection_ProposalExecutionFailure) Has(fd protoreflect.FieldDescriptor) bool {
 	switch fd.FullName() {
-	case "cosmos.gov.v1.TallyResult.yes_count":
-		return x.YesCount != ""
-	case "cosmos.gov.v1.TallyResult.abstain_count":
-		return x.AbstainCount != ""
-	case "cosmos.gov.v1.TallyResult.no_count":
-		return x.NoCount != ""
-	case "cosmos.gov.v1.TallyResult.no_with_veto_count":
-		return x.NoWithVetoCount != ""
+	case "cosmos.gov.v1.ProposalExecutionFailure.msg_index":
+		return x.MsgIndex != uint32(0)
+	case "cosmos.gov.v1.ProposalExecutionFailure.msg_type_url":
+		return x.MsgTypeUrl != ""
+	case "cosmos.gov.v1.ProposalExecutionFailure.reason":
+		return x.Reason != ""
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.gov.v1.TallyResult"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.gov.v1.ProposalExecutionFailure"))
 		}
-		panic(fmt.Errorf("message cosmos.gov.v1.TallyResult does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.gov.v1.ProposalExecutionFailure does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -2669,21 +3461,19 @@ func (x *fastReflection_TallyResult) Has(fd protoreflect.FieldDescriptor) bool {
 // associated with the given field number.
 //
 // Clear is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_TallyResult) Clear(fd protoreflect.FieldDescriptor) {
+func (x *fastReflection_ProposalExecutionFailure) Clear(fd protoreflect.FieldDescriptor) {
 	switch fd.FullName() {
-	case "cosmos.gov.v1.TallyResult.yes_count":
-		x.YesCount = ""
-	case "cosmos.gov.v1.TallyResult.abstain_count":
-		x.AbstainCount = ""
-	case "cosmos.gov.v1.TallyResult.no_count":
-		x.NoCount = ""
-	case "cosmos.gov.v1.TallyResult.no_with_veto_count":
-		x.NoWithVetoCount = ""
+	case "cosmos.gov.v1.ProposalExecutionFailure.msg_index":
+		x.MsgIndex = uint32(0)
+	case "cosmos.gov.v1.ProposalExecutionFailure.msg_type_url":
+		x.MsgTypeUrl = ""
+	case "cosmos.gov.v1.ProposalExecutionFailure.reason":
+		x.Reason = ""
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.gov.v1.TallyResult"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.gov.v1.ProposalExecutionFailure"))
 		}
-		panic(fmt.Errorf("message cosmos.gov.v1.TallyResult does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.gov.v1.ProposalExecutionFailure does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -2693,25 +3483,22 @@ func (x *fastReflection_TallyResult) Clear(fd protoreflect.FieldDescriptor) {
 // the default value of a bytes scalar is guaranteed to be a copy.
 // For unpopulated composite types, it returns an empty, read-only view
 // of the value; to obtain a mutable reference, use Mutable.
-func (x *fastReflection_TallyResult) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_ProposalExecutionFailure) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
 	switch descriptor.FullName() {
-	case "cosmos.gov.v1.TallyResult.yes_count":
-		value := x.YesCount
-		return protoreflect.ValueOfString(value)
-	case "cosmos.gov.v1.TallyResult.abstain_count":
-		value := x.AbstainCount
-		return protoreflect.ValueOfString(value)
-	case "cosmos.gov.v1.TallyResult.no_count":
-		value := x.NoCount
+	case "cosmos.gov.v1.ProposalExecutionFailure.msg_index":
+		value := x.MsgIndex
+		return protoreflect.ValueOfUint32(value)
+	case "cosmos.gov.v1.ProposalExecutionFailure.msg_type_url":
+		value := x.MsgTypeUrl
 		return protoreflect.ValueOfString(value)
-	case "cosmos.gov.v1.TallyResult.no_with_veto_count":
-		value := x.NoWithVetoCount
+	case "cosmos.gov.v1.ProposalExecutionFailure.reason":
+		value := x.Reason
 		return protoreflect.ValueOfString(value)
 	default:
 		if descriptor.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.gov.v1.TallyResult"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.gov.v1.ProposalExecutionFailure"))
 		}
-		panic(fmt.Errorf("message cosmos.gov.v1.TallyResult does not contain field %s", descriptor.FullName()))
+		panic(fmt.Errorf("message cosmos.gov.v1.ProposalExecutionFailure does not contain field %s", descriptor.FullName()))
 	}
 }
 
@@ -2725,21 +3512,19 @@ func (x *fastReflection_TallyResult) Get(descriptor protoreflect.FieldDescriptor
 // empty, read-only value, then it panics.
 //
 // Set is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_TallyResult) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+func (x *fastReflection_ProposalExecutionFailure) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
 	switch fd.FullName() {
-	case "cosmos.gov.v1.TallyResult.yes_count":
-		x.YesCount = value.Interface().(string)
-	case "cosmos.gov.v1.TallyResult.abstain_count":
-		x.AbstainCount = value.Interface().(string)
-	case "cosmos.gov.v1.TallyResult.no_count":
-		x.NoCount = value.Interface().(string)
-	case "cosmos.gov.v1.TallyResult.no_with_veto_count":
-		x.NoWithVetoCount = value.Interface().(string)
+	case "cosmos.gov.v1.ProposalExecutionFailure.msg_index":
+		x.MsgIndex = uint32(value.Uint())
+	case "cosmos.gov.v1.ProposalExecutionFailure.msg_type_url":
+		x.MsgTypeUrl = value.Interface().(string)
+	case "cosmos.gov.v1.ProposalExecutionFailure.reason":
+		x.Reason = value.Interface().(string)
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.gov.v1.TallyResult"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.gov.v1.ProposalExecutionFailure"))
 		}
-		panic(fmt.Errorf("message cosmos.gov.v1.TallyResult does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.gov.v1.ProposalExecutionFailure does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -2753,52 +3538,48 @@ func (x *fastReflection_TallyResult) Set(fd protoreflect.FieldDescriptor, value
 // It panics if the field does not contain a composite type.
 //
 // Mutable is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_TallyResult) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_ProposalExecutionFailure) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "cosmos.gov.v1.TallyResult.yes_count":
-		panic(fmt.Errorf("field yes_count of message cosmos.gov.v1.TallyResult is not mutable"))
-	case "cosmos.gov.v1.TallyResult.abstain_count":
-		panic(fmt.Errorf("field abstain_count of message cosmos.gov.v1.TallyResult is not mutable"))
-	case "cosmos.gov.v1.TallyResult.no_count":
-		panic(fmt.Errorf("field no_count of message cosmos.gov.v1.TallyResult is not mutable"))
-	case "cosmos.gov.v1.TallyResult.no_with_veto_count":
-		panic(fmt.Errorf("field no_with_veto_count of message cosmos.gov.v1.TallyResult is not mutable"))
+	case "cosmos.gov.v1.ProposalExecutionFailure.msg_index":
+		panic(fmt.Errorf("field msg_index of message cosmos.gov.v1.ProposalExecutionFailure is not mutable"))
+	case "cosmos.gov.v1.ProposalExecutionFailure.msg_type_url":
+		panic(fmt.Errorf("field msg_type_url of message cosmos.gov.v1.ProposalExecutionFailure is not mutable"))
+	case "cosmos.gov.v1.ProposalExecutionFailure.reason":
+		panic(fmt.Errorf("field reason of message cosmos.gov.v1.ProposalExecutionFailure is not mutable"))
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.gov.v1.TallyResult"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.gov.v1.ProposalExecutionFailure"))
 		}
-		panic(fmt.Errorf("message cosmos.gov.v1.TallyResult does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.gov.v1.ProposalExecutionFailure does not contain field %s", fd.FullName()))
 	}
 }
 
 // NewField returns a new value that is assignable to the field
 // for the given descriptor. For scalars, this returns the default value.
 // For lists, maps, and messages, this returns a new, empty, mutable value.
-func (x *fastReflection_TallyResult) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_ProposalExecutionFailure) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "cosmos.gov.v1.TallyResult.yes_count":
-		return protoreflect.ValueOfString("")
-	case "cosmos.gov.v1.TallyResult.abstain_count":
-		return protoreflect.ValueOfString("")
-	case "cosmos.gov.v1.TallyResult.no_count":
+	case "cosmos.gov.v1.ProposalExecutionFailure.msg_index":
+		return protoreflect.ValueOfUint32(uint32(0))
+	case "cosmos.gov.v1.ProposalExecutionFailure.msg_type_url":
 		return protoreflect.ValueOfString("")
-	case "cosmos.gov.v1.TallyResult.no_with_veto_count":
+	case "cosmos.gov.v1.ProposalExecutionFailure.reason":
 		return protoreflect.ValueOfString("")
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.gov.v1.TallyResult"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.gov.v1.ProposalExecutionFailure"))
 		}
-		panic(fmt.Errorf("message cosmos.gov.v1.TallyResult does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.gov.v1.ProposalExecutionFailure does not contain field %s", fd.FullName()))
 	}
 }
 
 // WhichOneof reports which field within the oneof is populated,
 // returning nil if none are populated.
 // It panics if the oneof descriptor does not belong to this message.
-func (x *fastReflection_TallyResult) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+func (x *fastReflection_ProposalExecutionFailure) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
 	switch d.FullName() {
 	default:
-		panic(fmt.Errorf("%s is not a oneof field in cosmos.gov.v1.TallyResult", d.FullName()))
+		panic(fmt.Errorf("%s is not a oneof field in cosmos.gov.v1.ProposalExecutionFailure", d.FullName()))
 	}
 	panic("unreachable")
 }
@@ -2806,7 +3587,7 @@ func (x *fastReflection_TallyResult) WhichOneof(d protoreflect.OneofDescriptor)
 // GetUnknown retrieves the entire list of unknown fields.
 // The caller may only mutate the contents of the RawFields
 // if the mutated bytes are stored back into the message with SetUnknown.
-func (x *fastReflection_TallyResult) GetUnknown() protoreflect.RawFields {
+func (x *fastReflection_ProposalExecutionFailure) GetUnknown() protoreflect.RawFields {
 	return x.unknownFields
 }
 
@@ -2817,7 +3598,7 @@ func (x *fastReflection_TallyResult) GetUnknown() protoreflect.RawFields {
 // An empty RawFields may be passed to clear the fields.
 //
 // SetUnknown is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_TallyResult) SetUnknown(fields protoreflect.RawFields) {
+func (x *fastReflection_ProposalExecutionFailure) SetUnknown(fields protoreflect.RawFields) {
 	x.unknownFields = fields
 }
 
@@ -2829,7 +3610,7 @@ func (x *fastReflection_TallyResult) SetUnknown(fields protoreflect.RawFields) {
 // message type, but the details are implementation dependent.
 // Validity is not part of the protobuf data model, and may not
 // be preserved in marshaling or other operations.
-func (x *fastReflection_TallyResult) IsValid() bool {
+func (x *fastReflection_ProposalExecutionFailure) IsValid() bool {
 	return x != nil
 }
 
@@ -2839,9 +3620,9 @@ func (x *fastReflection_TallyResult) IsValid() bool {
 // The returned methods type is identical to
 // "google.golang.org/protobuf/runtime/protoiface".Methods.
 // Consult the protoiface package documentation for details.
-func (x *fastReflection_TallyResult) ProtoMethods() *protoiface.Methods {
+func (x *fastReflection_ProposalExecutionFailure) ProtoMethods() *protoiface.Methods {
 	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
-		x := input.Message.Interface().(*TallyResult)
+		x := input.Message.Interface().(*ProposalExecutionFailure)
 		if x == nil {
 			return protoiface.SizeOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -2853,19 +3634,14 @@ func (x *fastReflection_TallyResult) ProtoMethods() *protoiface.Methods {
 		var n int
 		var l int
 		_ = l
-		l = len(x.YesCount)
-		if l > 0 {
-			n += 1 + l + runtime.Sov(uint64(l))
-		}
-		l = len(x.AbstainCount)
-		if l > 0 {
-			n += 1 + l + runtime.Sov(uint64(l))
+		if x.MsgIndex != 0 {
+			n += 1 + runtime.Sov(uint64(x.MsgIndex))
 		}
-		l = len(x.NoCount)
+		l = len(x.MsgTypeUrl)
 		if l > 0 {
 			n += 1 + l + runtime.Sov(uint64(l))
 		}
-		l = len(x.NoWithVetoCount)
+		l = len(x.Reason)
 		if l > 0 {
 			n += 1 + l + runtime.Sov(uint64(l))
 		}
@@ -2879,7 +3655,7 @@ func (x *fastReflection_TallyResult) ProtoMethods() *protoiface.Methods {
 	}
 
 	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
-		x := input.Message.Interface().(*TallyResult)
+		x := input.Message.Interface().(*ProposalExecutionFailure)
 		if x == nil {
 			return protoiface.MarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -2898,33 +3674,24 @@ func (x *fastReflection_TallyResult) ProtoMethods() *protoiface.Methods {
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
-		if len(x.NoWithVetoCount) > 0 {
-			i -= len(x.NoWithVetoCount)
-			copy(dAtA[i:], x.NoWithVetoCount)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.NoWithVetoCount)))
-			i--
-			dAtA[i] = 0x22
-		}
-		if len(x.NoCount) > 0 {
-			i -= len(x.NoCount)
-			copy(dAtA[i:], x.NoCount)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.NoCount)))
+		if len(x.Reason) > 0 {
+			i -= len(x.Reason)
+			copy(dAtA[i:], x.Reason)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Reason)))
 			i--
 			dAtA[i] = 0x1a
 		}
-		if len(x.AbstainCount) > 0 {
-			i -= len(x.AbstainCount)
-			copy(dAtA[i:], x.AbstainCount)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.AbstainCount)))
+		if len(x.MsgTypeUrl) > 0 {
+			i -= len(x.MsgTypeUrl)
+			copy(dAtA[i:], x.MsgTypeUrl)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.MsgTypeUrl)))
 			i--
 			dAtA[i] = 0x12
 		}
-		if len(x.YesCount) > 0 {
-			i -= len(x.YesCount)
-			copy(dAtA[i:], x.YesCount)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.YesCount)))
+		if x.MsgIndex != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.MsgIndex))
 			i--
-			dAtA[i] = 0xa
+			dAtA[i] = 0x8
 		}
 		if input.Buf != nil {
 			input.Buf = append(input.Buf, dAtA...)
@@ -2937,7 +3704,7 @@ func (x *fastReflection_TallyResult) ProtoMethods() *protoiface.Methods {
 		}, nil
 	}
 	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
-		x := input.Message.Interface().(*TallyResult)
+		x := input.Message.Interface().(*ProposalExecutionFailure)
 		if x == nil {
 			return protoiface.UnmarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -2969,17 +3736,17 @@ func (x *fastReflection_TallyResult) ProtoMethods() *protoiface.Methods {
 			fieldNum := int32(wire >> 3)
 			wireType := int(wire & 0x7)
 			if wireType == 4 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: TallyResult: wiretype end group for non-group")
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: ProposalExecutionFailure: wiretype end group for non-group")
 			}
 			if fieldNum <= 0 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: TallyResult: illegal tag %d (wire type %d)", fieldNum, wire)
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: ProposalExecutionFailure: illegal tag %d (wire type %d)", fieldNum, wire)
 			}
 			switch fieldNum {
 			case 1:
-				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field YesCount", wireType)
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field MsgIndex", wireType)
 				}
-				var stringLen uint64
+				x.MsgIndex = 0
 				for shift := uint(0); ; shift += 7 {
 					if shift >= 64 {
 						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
@@ -2989,27 +3756,14 @@ func (x *fastReflection_TallyResult) ProtoMethods() *protoiface.Methods {
 					}
 					b := dAtA[iNdEx]
 					iNdEx++
-					stringLen |= uint64(b&0x7F) << shift
+					x.MsgIndex |= uint32(b&0x7F) << shift
 					if b < 0x80 {
 						break
 					}
 				}
-				intStringLen := int(stringLen)
-				if intStringLen < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				postIndex := iNdEx + intStringLen
-				if postIndex < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				if postIndex > l {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-				}
-				x.YesCount = string(dAtA[iNdEx:postIndex])
-				iNdEx = postIndex
 			case 2:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field AbstainCount", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field MsgTypeUrl", wireType)
 				}
 				var stringLen uint64
 				for shift := uint(0); ; shift += 7 {
@@ -3037,43 +3791,11 @@ func (x *fastReflection_TallyResult) ProtoMethods() *protoiface.Methods {
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				x.AbstainCount = string(dAtA[iNdEx:postIndex])
+				x.MsgTypeUrl = string(dAtA[iNdEx:postIndex])
 				iNdEx = postIndex
 			case 3:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field NoCount", wireType)
-				}
-				var stringLen uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
-					}
-					if iNdEx >= l {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					stringLen |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				intStringLen := int(stringLen)
-				if intStringLen < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				postIndex := iNdEx + intStringLen
-				if postIndex < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				if postIndex > l {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-				}
-				x.NoCount = string(dAtA[iNdEx:postIndex])
-				iNdEx = postIndex
-			case 4:
-				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field NoWithVetoCount", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
 				}
 				var stringLen uint64
 				for shift := uint(0); ; shift += 7 {
@@ -3101,7 +3823,7 @@ func (x *fastReflection_TallyResult) ProtoMethods() *protoiface.Methods {
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				x.NoWithVetoCount = string(dAtA[iNdEx:postIndex])
+				x.Reason = string(dAtA[iNdEx:postIndex])
 				iNdEx = postIndex
 			default:
 				iNdEx = preIndex
@@ -3138,83 +3860,83 @@ func (x *fastReflection_TallyResult) ProtoMethods() *protoiface.Methods {
 	}
 }
 
-var _ protoreflect.List = (*_Vote_4_list)(nil)
+var _ protoreflect.List = (*_ProposalExecutionReceipt_4_list)(nil)
 
-type _Vote_4_list struct {
-	list *[]*WeightedVoteOption
+type _ProposalExecutionReceipt_4_list struct {
+	list *[]*anypb.Any
 }
 
-func (x *_Vote_4_list) Len() int {
+func (x *_ProposalExecutionReceipt_4_list) Len() int {
 	if x.list == nil {
 		return 0
 	}
 	return len(*x.list)
 }
 
-func (x *_Vote_4_list) Get(i int) protoreflect.Value {
+func (x *_ProposalExecutionReceipt_4_list) Get(i int) protoreflect.Value {
 	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
 }
 
-func (x *_Vote_4_list) Set(i int, value protoreflect.Value) {
+func (x *_ProposalExecutionReceipt_4_list) Set(i int, value protoreflect.Value) {
 	valueUnwrapped := value.Message()
-	concreteValue := valueUnwrapped.Interface().(*WeightedVoteOption)
+	concreteValue := valueUnwrapped.Interface().(*anypb.Any)
 	(*x.list)[i] = concreteValue
 }
 
-func (x *_Vote_4_list) Append(value protoreflect.Value) {
+func (x *_ProposalExecutionReceipt_4_list) Append(value protoreflect.Value) {
 	valueUnwrapped := value.Message()
-	concreteValue := valueUnwrapped.Interface().(*WeightedVoteOption)
+	concreteValue := valueUnwrapped.Interface().(*anypb.Any)
 	*x.list = append(*x.list, concreteValue)
 }
 
-func (x *_Vote_4_list) AppendMutable() protoreflect.Value {
-	v := new(WeightedVoteOption)
+func (x *_ProposalExecutionReceipt_4_list) AppendMutable() protoreflect.Value {
+	v := new(anypb.Any)
 	*x.list = append(*x.list, v)
 	return protoreflect.ValueOfMessage(v.ProtoReflect())
 }
 
-func (x *_Vote_4_list) Truncate(n int) {
+func (x *_ProposalExecutionReceipt_4_list) Truncate(n int) {
 	for i := n; i < len(*x.list); i++ {
 		(*x.list)[i] = nil
 	}
 	*x.list = (*x.list)[:n]
 }
 
-func (x *_Vote_4_list) NewElement() protoreflect.Value {
-	v := new(WeightedVoteOption)
+func (x *_ProposalExecutionReceipt_4_list) NewElement() protoreflect.Value {
+	v := new(anypb.Any)
 	return protoreflect.ValueOfMessage(v.ProtoReflect())
 }
 
-func (x *_Vote_4_list) IsValid() bool {
+func (x *_ProposalExecutionReceipt_4_list) IsValid() bool {
 	return x.list != nil
 }
 
 var (
-	md_Vote             protoreflect.MessageDescriptor
-	fd_Vote_proposal_id protoreflect.FieldDescriptor
-	fd_Vote_voter       protoreflect.FieldDescriptor
-	fd_Vote_options     protoreflect.FieldDescriptor
-	fd_Vote_metadata    protoreflect.FieldDescriptor
+	md_ProposalExecutionReceipt               protoreflect.MessageDescriptor
+	fd_ProposalExecutionReceipt_proposal_id   protoreflect.FieldDescriptor
+	fd_ProposalExecutionReceipt_height        protoreflect.FieldDescriptor
+	fd_ProposalExecutionReceipt_time          protoreflect.FieldDescriptor
+	fd_ProposalExecutionReceipt_msg_responses protoreflect.FieldDescriptor
 )
 
 func init() {
 	file_cosmos_gov_v1_gov_proto_init()
-	md_Vote = File_cosmos_gov_v1_gov_proto.Messages().ByName("Vote")
-	fd_Vote_proposal_id = md_Vote.Fields().ByName("proposal_id")
-	fd_Vote_voter = md_Vote.Fields().ByName("voter")
-	fd_Vote_options = md_Vote.Fields().ByName("options")
-	fd_Vote_metadata = md_Vote.Fields().ByName("metadata")
+	md_ProposalExecutionReceipt = File_cosmos_gov_v1_gov_proto.Messages().ByName("ProposalExecutionReceipt")
+	fd_ProposalExecutionReceipt_proposal_id = md_ProposalExecutionReceipt.Fields().ByName("proposal_id")
+	fd_ProposalExecutionReceipt_height = md_ProposalExecutionReceipt.Fields().ByName("height")
+	fd_ProposalExecutionReceipt_time = md_ProposalExecutionReceipt.Fields().ByName("time")
+	fd_ProposalExecutionReceipt_msg_responses = md_ProposalExecutionReceipt.Fields().ByName("msg_responses")
 }
 
-var _ protoreflect.Message = (*fastReflection_Vote)(nil)
+var _ protoreflect.Message = (*fastReflection_ProposalExecutionReceipt)(nil)
 
-type fastReflection_Vote Vote
+type fastReflection_ProposalExecutionReceipt ProposalExecutionReceipt
 
-func (x *Vote) ProtoReflect() protoreflect.Message {
-	return (*fastReflection_Vote)(x)
+func (x *ProposalExecutionReceipt) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_ProposalExecutionReceipt)(x)
 }
 
-func (x *Vote) slowProtoReflect() protoreflect.Message {
+func (x *ProposalExecutionReceipt) slowProtoReflect() protoreflect.Message {
 	mi := &file_cosmos_gov_v1_gov_proto_msgTypes[4]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3226,43 +3948,43 @@ func (x *Vote) slowProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-var _fastReflection_Vote_messageType fastReflection_Vote_messageType
-var _ protoreflect.MessageType = fastReflection_Vote_messageType{}
+var _fastReflection_ProposalExecutionReceipt_messageType fastReflection_ProposalExecutionReceipt_messageType
+var _ protoreflect.MessageType = fastReflection_ProposalExecutionReceipt_messageType{}
 
-type fastReflection_Vote_messageType struct{}
+type fastReflection_ProposalExecutionReceipt_messageType struct{}
 
-func (x fastReflection_Vote_messageType) Zero() protoreflect.Message {
-	return (*fastReflection_Vote)(nil)
+func (x fastReflection_ProposalExecutionReceipt_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_ProposalExecutionReceipt)(nil)
 }
-func (x fastReflection_Vote_messageType) New() protoreflect.Message {
-	return new(fastReflection_Vote)
+func (x fastReflection_ProposalExecutionReceipt_messageType) New() protoreflect.Message {
+	return new(fastReflection_ProposalExecutionReceipt)
 }
-func (x fastReflection_Vote_messageType) Descriptor() protoreflect.MessageDescriptor {
-	return md_Vote
+func (x fastReflection_ProposalExecutionReceipt_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_ProposalExecutionReceipt
 }
 
 // Descriptor returns message descriptor, which contains only the protobuf
 // type information for the message.
-func (x *fastReflection_Vote) Descriptor() protoreflect.MessageDescriptor {
-	return md_Vote
+func (x *fastReflection_ProposalExecutionReceipt) Descriptor() protoreflect.MessageDescriptor {
+	return md_ProposalExecutionReceipt
 }
 
 // Type returns the message type, which encapsulates both Go and protobuf
 // type information. If the Go type information is not needed,
 // it is recommended that the message descriptor be used instead.
-func (x *fastReflection_Vote) Type() protoreflect.MessageType {
-	return _fastReflection_Vote_messageType
+func (x *fastReflection_ProposalExecutionReceipt) Type() protoreflect.MessageType {
+	return _fastReflection_ProposalExecutionReceipt_messageType
 }
 
 // New returns a newly allocated and mutable empty message.
-func (x *fastReflection_Vote) New() protoreflect.Message {
-	return new(fastReflection_Vote)
+func (x *fastReflection_ProposalExecutionReceipt) New() protoreflect.Message {
+	return new(fastReflection_ProposalExecutionReceipt)
 }
 
 // Interface unwraps the message reflection interface and
 // returns the underlying ProtoMessage interface.
-func (x *fastReflection_Vote) Interface() protoreflect.ProtoMessage {
-	return (*Vote)(x)
+func (x *fastReflection_ProposalExecutionReceipt) Interface() protoreflect.ProtoMessage {
+	return (*ProposalExecutionReceipt)(x)
 }
 
 // Range iterates over every populated field in an undefined order,
@@ -3270,28 +3992,28 @@ func (x *fastReflection_Vote) Interface() protoreflect.ProtoMessage {
 // Range returns immediately if f returns false.
 // While iterating, mutating operations may only be performed
 // on the current field descriptor.
-func (x *fastReflection_Vote) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+func (x *fastReflection_ProposalExecutionReceipt) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
 	if x.ProposalId != uint64(0) {
 		value := protoreflect.ValueOfUint64(x.ProposalId)
-		if !f(fd_Vote_proposal_id, value) {
+		if !f(fd_ProposalExecutionReceipt_proposal_id, value) {
 			return
 		}
 	}
-	if x.Voter != "" {
-		value := protoreflect.ValueOfString(x.Voter)
-		if !f(fd_Vote_voter, value) {
+	if x.Height != int64(0) {
+		value := protoreflect.ValueOfInt64(x.Height)
+		if !f(fd_ProposalExecutionReceipt_height, value) {
 			return
 		}
 	}
-	if len(x.Options) != 0 {
-		value := protoreflect.ValueOfList(&_Vote_4_list{list: &x.Options})
-		if !f(fd_Vote_options, value) {
+	if x.Time != nil {
+		value := protoreflect.ValueOfMessage(x.Time.ProtoReflect())
+		if !f(fd_ProposalExecutionReceipt_time, value) {
 			return
 		}
 	}
-	if x.Metadata != "" {
-		value := protoreflect.ValueOfString(x.Metadata)
-		if !f(fd_Vote_metadata, value) {
+	if len(x.MsgResponses) != 0 {
+		value := protoreflect.ValueOfList(&_ProposalExecutionReceipt_4_list{list: &x.MsgResponses})
+		if !f(fd_ProposalExecutionReceipt_msg_responses, value) {
 			return
 		}
 	}
@@ -3308,21 +4030,21 @@ func (x *fastReflection_Vote) Range(f func(protoreflect.FieldDescriptor, protore
 // In other cases (aside from the nullable cases above),
 // a proto3 scalar field is populated if it contains a non-zero value, and
 // a repeated field is populated if it is non-empty.
-func (x *fastReflection_Vote) Has(fd protoreflect.FieldDescriptor) bool {
+func (x *fastReflection_ProposalExecutionReceipt) Has(fd protoreflect.FieldDescriptor) bool {
 	switch fd.FullName() {
-	case "cosmos.gov.v1.Vote.proposal_id":
+	case "cosmos.gov.v1.ProposalExecutionReceipt.proposal_id":
 		return x.ProposalId != uint64(0)
-	case "cosmos.gov.v1.Vote.voter":
-		return x.Voter != ""
-	case "cosmos.gov.v1.Vote.options":
-		return len(x.Options) != 0
-	case "cosmos.gov.v1.Vote.metadata":
-		return x.Metadata != ""
+	case "cosmos.gov.v1.ProposalExecutionReceipt.height":
+		return x.Height != int64(0)
+	case "cosmos.gov.v1.ProposalExecutionReceipt.time":
+		return x.Time != nil
+	case "cosmos.gov.v1.ProposalExecutionReceipt.msg_responses":
+		return len(x.MsgResponses) != 0
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.gov.v1.Vote"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.gov.v1.ProposalExecutionReceipt"))
 		}
-		panic(fmt.Errorf("message cosmos.gov.v1.Vote does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.gov.v1.ProposalExecutionReceipt does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -3332,21 +4054,21 @@ func (x *fastReflection_Vote) Has(fd protoreflect.FieldDescriptor) bool {
 // associated with the given field number.
 //
 // Clear is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_Vote) Clear(fd protoreflect.FieldDescriptor) {
+func (x *fastReflection_ProposalExecutionReceipt) Clear(fd protoreflect.FieldDescriptor) {
 	switch fd.FullName() {
-	case "cosmos.gov.v1.Vote.proposal_id":
+	case "cosmos.gov.v1.ProposalExecutionReceipt.proposal_id":
 		x.ProposalId = uint64(0)
-	case "cosmos.gov.v1.Vote.voter":
-		x.Voter = ""
-	case "cosmos.gov.v1.Vote.options":
-		x.Options = nil
-	case "cosmos.gov.v1.Vote.metadata":
-		x.Metadata = ""
+	case "cosmos.gov.v1.ProposalExecutionReceipt.height":
+		x.Height = int64(0)
+	case "cosmos.gov.v1.ProposalExecutionReceipt.time":
+		x.Time = nil
+	case "cosmos.gov.v1.ProposalExecutionReceipt.msg_responses":
+		x.MsgResponses = nil
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.gov.v1.Vote"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.gov.v1.ProposalExecutionReceipt"))
 		}
-		panic(fmt.Errorf("message cosmos.gov.v1.Vote does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.gov.v1.ProposalExecutionReceipt does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -3356,28 +4078,28 @@ func (x *fastReflection_Vote) Clear(fd protoreflect.FieldDescriptor) {
 // the default value of a bytes scalar is guaranteed to be a copy.
 // For unpopulated composite types, it returns an empty, read-only view
 // of the value; to obtain a mutable reference, use Mutable.
-func (x *fastReflection_Vote) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_ProposalExecutionReceipt) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
 	switch descriptor.FullName() {
-	case "cosmos.gov.v1.Vote.proposal_id":
+	case "cosmos.gov.v1.ProposalExecutionReceipt.proposal_id":
 		value := x.ProposalId
 		return protoreflect.ValueOfUint64(value)
-	case "cosmos.gov.v1.Vote.voter":
-		value := x.Voter
-		return protoreflect.ValueOfString(value)
-	case "cosmos.gov.v1.Vote.options":
-		if len(x.Options) == 0 {
-			return protoreflect.ValueOfList(&_Vote_4_list{})
+	case "cosmos.gov.v1.ProposalExecutionReceipt.height":
+		value := x.Height
+		return protoreflect.ValueOfInt64(value)
+	case "cosmos.gov.v1.ProposalExecutionReceipt.time":
+		value := x.Time
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "cosmos.gov.v1.ProposalExecutionReceipt.msg_responses":
+		if len(x.MsgResponses) == 0 {
+			return protoreflect.ValueOfList(&_ProposalExecutionReceipt_4_list{})
 		}
-		listValue := &_Vote_4_list{list: &x.Options}
+		listValue := &_ProposalExecutionReceipt_4_list{list: &x.MsgResponses}
 		return protoreflect.ValueOfList(listValue)
-	case "cosmos.gov.v1.Vote.metadata":
-		value := x.Metadata
-		return protoreflect.ValueOfString(value)
 	default:
 		if descriptor.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.gov.v1.Vote"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.gov.v1.ProposalExecutionReceipt"))
 		}
-		panic(fmt.Errorf("message cosmos.gov.v1.Vote does not contain field %s", descriptor.FullName()))
+		panic(fmt.Errorf("message cosmos.gov.v1.ProposalExecutionReceipt does not contain field %s", descriptor.FullName()))
 	}
 }
 
@@ -3391,23 +4113,23 @@ func (x *fastReflection_Vote) Get(descriptor protoreflect.FieldDescriptor) proto
 // empty, read-only value, then it panics.
 //
 // Set is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_Vote) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+func (x *fastReflection_ProposalExecutionReceipt) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
 	switch fd.FullName() {
-	case "cosmos.gov.v1.Vote.proposal_id":
+	case "cosmos.gov.v1.ProposalExecutionReceipt.proposal_id":
 		x.ProposalId = value.Uint()
-	case "cosmos.gov.v1.Vote.voter":
-		x.Voter = value.Interface().(string)
-	case "cosmos.gov.v1.Vote.options":
+	case "cosmos.gov.v1.ProposalExecutionReceipt.height":
+		x.Height = value.Int()
+	case "cosmos.gov.v1.ProposalExecutionReceipt.time":
+		x.Time = value.Message().Interface().(*timestamppb.Timestamp)
+	case "cosmos.gov.v1.ProposalExecutionReceipt.msg_responses":
 		lv := value.List()
-		clv := lv.(*_Vote_4_list)
-		x.Options = *clv.list
-	case "cosmos.gov.v1.Vote.metadata":
-		x.Metadata = value.Interface().(string)
+		clv := lv.(*_ProposalExecutionReceipt_4_list)
+		x.MsgResponses = *clv.list
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.gov.v1.Vote"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.gov.v1.ProposalExecutionReceipt"))
 		}
-		panic(fmt.Errorf("message cosmos.gov.v1.Vote does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.gov.v1.ProposalExecutionReceipt does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -3421,57 +4143,61 @@ func (x *fastReflection_Vote) Set(fd protoreflect.FieldDescriptor, value protore
 // It panics if the field does not contain a composite type.
 //
 // Mutable is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_Vote) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_ProposalExecutionReceipt) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "cosmos.gov.v1.Vote.options":
-		if x.Options == nil {
-			x.Options = []*WeightedVoteOption{}
+	case "cosmos.gov.v1.ProposalExecutionReceipt.time":
+		if x.Time == nil {
+			x.Time = new(timestamppb.Timestamp)
 		}
-		value := &_Vote_4_list{list: &x.Options}
+		return protoreflect.ValueOfMessage(x.Time.ProtoReflect())
+	case "cosmos.gov.v1.ProposalExecutionReceipt.msg_responses":
+		if x.MsgResponses == nil {
+			x.MsgResponses = []*anypb.Any{}
+		}
+		value := &_ProposalExecutionReceipt_4_list{list: &x.MsgResponses}
 		return protoreflect.ValueOfList(value)
-	case "cosmos.gov.v1.Vote.proposal_id":
-		panic(fmt.Errorf("field proposal_id of message cosmos.gov.v1.Vote is not mutable"))
-	case "cosmos.gov.v1.Vote.voter":
-		panic(fmt.Errorf("field voter of message cosmos.gov.v1.Vote is not mutable"))
-	case "cosmos.gov.v1.Vote.metadata":
-		panic(fmt.Errorf("field metadata of message cosmos.gov.v1.Vote is not mutable"))
+	case "cosmos.gov.v1.ProposalExecutionReceipt.proposal_id":
+		panic(fmt.Errorf("field proposal_id of message cosmos.gov.v1.ProposalExecutionReceipt is not mutable"))
+	case "cosmos.gov.v1.ProposalExecutionReceipt.height":
+		panic(fmt.Errorf("field height of message cosmos.gov.v1.ProposalExecutionReceipt is not mutable"))
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.gov.v1.Vote"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.gov.v1.ProposalExecutionReceipt"))
 		}
-		panic(fmt.Errorf("message cosmos.gov.v1.Vote does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.gov.v1.ProposalExecutionReceipt does not contain field %s", fd.FullName()))
 	}
 }
 
 // NewField returns a new value that is assignable to the field
 // for the given descriptor. For scalars, this returns the default value.
 // For lists, maps, and messages, this returns a new, empty, mutable value.
-func (x *fastReflection_Vote) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_ProposalExecutionReceipt) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "cosmos.gov.v1.Vote.proposal_id":
+	case "cosmos.gov.v1.ProposalExecutionReceipt.proposal_id":
 		return protoreflect.ValueOfUint64(uint64(0))
-	case "cosmos.gov.v1.Vote.voter":
-		return protoreflect.ValueOfString("")
-	case "cosmos.gov.v1.Vote.options":
-		list := []*WeightedVoteOption{}
-		return protoreflect.ValueOfList(&_Vote_4_list{list: &list})
-	case "cosmos.gov.v1.Vote.metadata":
-		return protoreflect.ValueOfString("")
+	case "cosmos.gov.v1.ProposalExecutionReceipt.height":
+		return protoreflect.ValueOfInt64(int64(0))
+	case "cosmos.gov.v1.ProposalExecutionReceipt.time":
+		m := new(timestamppb.Timestamp)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case "cosmos.gov.v1.ProposalExecutionReceipt.msg_responses":
+		list := []*anypb.Any{}
+		return protoreflect.ValueOfList(&_ProposalExecutionReceipt_4_list{list: &list})
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.gov.v1.Vote"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.gov.v1.ProposalExecutionReceipt"))
 		}
-		panic(fmt.Errorf("message cosmos.gov.v1.Vote does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.gov.v1.ProposalExecutionReceipt does not contain field %s", fd.FullName()))
 	}
 }
 
 // WhichOneof reports which field within the oneof is populated,
 // returning nil if none are populated.
 // It panics if the oneof descriptor does not belong to this message.
-func (x *fastReflection_Vote) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+func (x *fastReflection_ProposalExecutionReceipt) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
 	switch d.FullName() {
 	default:
-		panic(fmt.Errorf("%s is not a oneof field in cosmos.gov.v1.Vote", d.FullName()))
+		panic(fmt.Errorf("%s is not a oneof field in cosmos.gov.v1.ProposalExecutionReceipt", d.FullName()))
 	}
 	panic("unreachable")
 }
@@ -3479,7 +4205,7 @@ func (x *fastReflection_Vote) WhichOneof(d protoreflect.OneofDescriptor) protore
 // GetUnknown retrieves the entire list of unknown fields.
 // The caller may only mutate the contents of the RawFields
 // if the mutated bytes are stored back into the message with SetUnknown.
-func (x *fastReflection_Vote) GetUnknown() protoreflect.RawFields {
+func (x *fastReflection_ProposalExecutionReceipt) GetUnknown() protoreflect.RawFields {
 	return x.unknownFields
 }
 
@@ -3490,7 +4216,7 @@ func (x *fastReflection_Vote) GetUnknown() protoreflect.RawFields {
 // An empty RawFields may be passed to clear the fields.
 //
 // SetUnknown is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_Vote) SetUnknown(fields protoreflect.RawFields) {
+func (x *fastReflection_ProposalExecutionReceipt) SetUnknown(fields protoreflect.RawFields) {
 	x.unknownFields = fields
 }
 
@@ -3502,7 +4228,7 @@ func (x *fastReflection_Vote) SetUnknown(fields protoreflect.RawFields) {
 // message type, but the details are implementation dependent.
 // Validity is not part of the protobuf data model, and may not
 // be preserved in marshaling or other operations.
-func (x *fastReflection_Vote) IsValid() bool {
+func (x *fastReflection_ProposalExecutionReceipt) IsValid() bool {
 	return x != nil
 }
 
@@ -3512,9 +4238,9 @@ func (x *fastReflection_Vote) IsValid() bool {
 // The returned methods type is identical to
 // "google.golang.org/protobuf/runtime/protoiface".Methods.
 // Consult the protoiface package documentation for details.
-func (x *fastReflection_Vote) ProtoMethods() *protoiface.Methods {
+func (x *fastReflection_ProposalExecutionReceipt) ProtoMethods() *protoiface.Methods {
 	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
-		x := input.Message.Interface().(*Vote)
+		x := input.Message.Interface().(*ProposalExecutionReceipt)
 		if x == nil {
 			return protoiface.SizeOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -3529,20 +4255,19 @@ func (x *fastReflection_Vote) ProtoMethods() *protoiface.Methods {
 		if x.ProposalId != 0 {
 			n += 1 + runtime.Sov(uint64(x.ProposalId))
 		}
-		l = len(x.Voter)
-		if l > 0 {
+		if x.Height != 0 {
+			n += 1 + runtime.Sov(uint64(x.Height))
+		}
+		if x.Time != nil {
+			l = options.Size(x.Time)
 			n += 1 + l + runtime.Sov(uint64(l))
 		}
-		if len(x.Options) > 0 {
-			for _, e := range x.Options {
+		if len(x.MsgResponses) > 0 {
+			for _, e := range x.MsgResponses {
 				l = options.Size(e)
 				n += 1 + l + runtime.Sov(uint64(l))
 			}
 		}
-		l = len(x.Metadata)
-		if l > 0 {
-			n += 1 + l + runtime.Sov(uint64(l))
-		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
 		}
@@ -3553,7 +4278,7 @@ func (x *fastReflection_Vote) ProtoMethods() *protoiface.Methods {
 	}
 
 	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
-		x := input.Message.Interface().(*Vote)
+		x := input.Message.Interface().(*ProposalExecutionReceipt)
 		if x == nil {
 			return protoiface.MarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -3572,16 +4297,9 @@ func (x *fastReflection_Vote) ProtoMethods() *protoiface.Methods {
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
-		if len(x.Metadata) > 0 {
-			i -= len(x.Metadata)
-			copy(dAtA[i:], x.Metadata)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Metadata)))
-			i--
-			dAtA[i] = 0x2a
-		}
-		if len(x.Options) > 0 {
-			for iNdEx := len(x.Options) - 1; iNdEx >= 0; iNdEx-- {
-				encoded, err := options.Marshal(x.Options[iNdEx])
+		if len(x.MsgResponses) > 0 {
+			for iNdEx := len(x.MsgResponses) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.MsgResponses[iNdEx])
 				if err != nil {
 					return protoiface.MarshalOutput{
 						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -3595,12 +4313,24 @@ func (x *fastReflection_Vote) ProtoMethods() *protoiface.Methods {
 				dAtA[i] = 0x22
 			}
 		}
-		if len(x.Voter) > 0 {
-			i -= len(x.Voter)
-			copy(dAtA[i:], x.Voter)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Voter)))
+		if x.Time != nil {
+			encoded, err := options.Marshal(x.Time)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
 			i--
-			dAtA[i] = 0x12
+			dAtA[i] = 0x1a
+		}
+		if x.Height != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.Height))
+			i--
+			dAtA[i] = 0x10
 		}
 		if x.ProposalId != 0 {
 			i = runtime.EncodeVarint(dAtA, i, uint64(x.ProposalId))
@@ -3618,7 +4348,7 @@ func (x *fastReflection_Vote) ProtoMethods() *protoiface.Methods {
 		}, nil
 	}
 	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
-		x := input.Message.Interface().(*Vote)
+		x := input.Message.Interface().(*ProposalExecutionReceipt)
 		if x == nil {
 			return protoiface.UnmarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -3650,10 +4380,10 @@ func (x *fastReflection_Vote) ProtoMethods() *protoiface.Methods {
 			fieldNum := int32(wire >> 3)
 			wireType := int(wire & 0x7)
 			if wireType == 4 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: Vote: wiretype end group for non-group")
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: ProposalExecutionReceipt: wiretype end group for non-group")
 			}
 			if fieldNum <= 0 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: Vote: illegal tag %d (wire type %d)", fieldNum, wire)
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: ProposalExecutionReceipt: illegal tag %d (wire type %d)", fieldNum, wire)
 			}
 			switch fieldNum {
 			case 1:
@@ -3676,10 +4406,10 @@ func (x *fastReflection_Vote) ProtoMethods() *protoiface.Methods {
 					}
 				}
 			case 2:
-				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Voter", wireType)
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
 				}
-				var stringLen uint64
+				x.Height = 0
 				for shift := uint(0); ; shift += 7 {
 					if shift >= 64 {
 						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
@@ -3689,27 +4419,14 @@ func (x *fastReflection_Vote) ProtoMethods() *protoiface.Methods {
 					}
 					b := dAtA[iNdEx]
 					iNdEx++
-					stringLen |= uint64(b&0x7F) << shift
+					x.Height |= int64(b&0x7F) << shift
 					if b < 0x80 {
 						break
 					}
 				}
-				intStringLen := int(stringLen)
-				if intStringLen < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				postIndex := iNdEx + intStringLen
-				if postIndex < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				if postIndex > l {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-				}
-				x.Voter = string(dAtA[iNdEx:postIndex])
-				iNdEx = postIndex
-			case 4:
+			case 3:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Options", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Time", wireType)
 				}
 				var msglen int
 				for shift := uint(0); ; shift += 7 {
@@ -3736,16 +4453,18 @@ func (x *fastReflection_Vote) ProtoMethods() *protoiface.Methods {
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				x.Options = append(x.Options, &WeightedVoteOption{})
-				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Options[len(x.Options)-1]); err != nil {
+				if x.Time == nil {
+					x.Time = &timestamppb.Timestamp{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Time); err != nil {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
 				}
 				iNdEx = postIndex
-			case 5:
+			case 4:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Metadata", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field MsgResponses", wireType)
 				}
-				var stringLen uint64
+				var msglen int
 				for shift := uint(0); ; shift += 7 {
 					if shift >= 64 {
 						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
@@ -3755,23 +4474,25 @@ func (x *fastReflection_Vote) ProtoMethods() *protoiface.Methods {
 					}
 					b := dAtA[iNdEx]
 					iNdEx++
-					stringLen |= uint64(b&0x7F) << shift
+					msglen |= int(b&0x7F) << shift
 					if b < 0x80 {
 						break
 					}
 				}
-				intStringLen := int(stringLen)
-				if intStringLen < 0 {
+				if msglen < 0 {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 				}
-				postIndex := iNdEx + intStringLen
+				postIndex := iNdEx + msglen
 				if postIndex < 0 {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 				}
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				x.Metadata = string(dAtA[iNdEx:postIndex])
+				x.MsgResponses = append(x.MsgResponses, &anypb.Any{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.MsgResponses[len(x.MsgResponses)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
 				iNdEx = postIndex
 			default:
 				iNdEx = preIndex
@@ -3808,79 +4529,30 @@ func (x *fastReflection_Vote) ProtoMethods() *protoiface.Methods {
 	}
 }
 
-var _ protoreflect.List = (*_DepositParams_1_list)(nil)
-
-type _DepositParams_1_list struct {
-	list *[]*v1beta1.Coin
-}
-
-func (x *_DepositParams_1_list) Len() int {
-	if x.list == nil {
-		return 0
-	}
-	return len(*x.list)
-}
-
-func (x *_DepositParams_1_list) Get(i int) protoreflect.Value {
-	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
-}
-
-func (x *_DepositParams_1_list) Set(i int, value protoreflect.Value) {
-	valueUnwrapped := value.Message()
-	concreteValue := valueUnwrapped.Interface().(*v1beta1.Coin)
-	(*x.list)[i] = concreteValue
-}
-
-func (x *_DepositParams_1_list) Append(value protoreflect.Value) {
-	valueUnwrapped := value.Message()
-	concreteValue := valueUnwrapped.Interface().(*v1beta1.Coin)
-	*x.list = append(*x.list, concreteValue)
-}
-
-func (x *_DepositParams_1_list) AppendMutable() protoreflect.Value {
-	v := new(v1beta1.Coin)
-	*x.list = append(*x.list, v)
-	return protoreflect.ValueOfMessage(v.ProtoReflect())
-}
-
-func (x *_DepositParams_1_list) Truncate(n int) {
-	for i := n; i < len(*x.list); i++ {
-		(*x.list)[i] = nil
-	}
-	*x.list = (*x.list)[:n]
-}
-
-func (x *_DepositParams_1_list) NewElement() protoreflect.Value {
-	v := new(v1beta1.Coin)
-	return protoreflect.ValueOfMessage(v.ProtoReflect())
-}
-
-func (x *_DepositParams_1_list) IsValid() bool {
-	return x.list != nil
-}
-
 var (
-	md_DepositParams                    protoreflect.MessageDescriptor
-	fd_DepositParams_min_deposit        protoreflect.FieldDescriptor
-	fd_DepositParams_max_deposit_period protoreflect.FieldDescriptor
+	md_ParamsUpdateFailure              protoreflect.MessageDescriptor
+	fd_ParamsUpdateFailure_index        protoreflect.FieldDescriptor
+	fd_ParamsUpdateFailure_msg_type_url protoreflect.FieldDescriptor
+	fd_ParamsUpdateFailure_reason       protoreflect.FieldDescriptor
 )
 
 func init() {
 	file_cosmos_gov_v1_gov_proto_init()
-	md_DepositParams = File_cosmos_gov_v1_gov_proto.Messages().ByName("DepositParams")
-	fd_DepositParams_min_deposit = md_DepositParams.Fields().ByName("min_deposit")
-	fd_DepositParams_max_deposit_period = md_DepositParams.Fields().ByName("max_deposit_period")
+	md_ParamsUpdateFailure = File_cosmos_gov_v1_gov_proto.Messages().ByName("ParamsUpdateFailure")
+	fd_ParamsUpdateFailure_index = md_ParamsUpdateFailure.Fields().ByName("index")
+	fd_ParamsUpdateFailure_msg_type_url = md_ParamsUpdateFailure.Fields().ByName("msg_type_url")
+	fd_ParamsUpdateFailure_reason = md_ParamsUpdateFailure.Fields().ByName("reason")
 }
 
-var _ protoreflect.Message = (*fastReflection_DepositParams)(nil)
+var _ protoreflect.Message = (*fastReflection_ParamsUpdateFailure)(nil)
 
-type fastReflection_DepositParams DepositParams
+type fastReflection_ParamsUpdateFailure ParamsUpdateFailure
 
-func (x *DepositParams) ProtoReflect() protoreflect.Message {
-	return (*fastReflection_DepositParams)(x)
+func (x *ParamsUpdateFailure) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_ParamsUpdateFailure)(x)
 }
 
-func (x *DepositParams) slowProtoReflect() protoreflect.Message {
+func (x *ParamsUpdateFailure) slowProtoReflect() protoreflect.Message {
 	mi := &file_cosmos_gov_v1_gov_proto_msgTypes[5]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3892,43 +4564,43 @@ func (x *DepositParams) slowProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-var _fastReflection_DepositParams_messageType fastReflection_DepositParams_messageType
-var _ protoreflect.MessageType = fastReflection_DepositParams_messageType{}
+var _fastReflection_ParamsUpdateFailure_messageType fastReflection_ParamsUpdateFailure_messageType
+var _ protoreflect.MessageType = fastReflection_ParamsUpdateFailure_messageType{}
 
-type fastReflection_DepositParams_messageType struct{}
+type fastReflection_ParamsUpdateFailure_messageType struct{}
 
-func (x fastReflection_DepositParams_messageType) Zero() protoreflect.Message {
-	return (*fastReflection_DepositParams)(nil)
+func (x fastReflection_ParamsUpdateFailure_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_ParamsUpdateFailure)(nil)
 }
-func (x fastReflection_DepositParams_messageType) New() protoreflect.Message {
-	return new(fastReflection_DepositParams)
+func (x fastReflection_ParamsUpdateFailure_messageType) New() protoreflect.Message {
+	return new(fastReflection_ParamsUpdateFailure)
 }
-func (x fastReflection_DepositParams_messageType) Descriptor() protoreflect.MessageDescriptor {
-	return md_DepositParams
+func (x fastReflection_ParamsUpdateFailure_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_ParamsUpdateFailure
 }
 
 // Descriptor returns message descriptor, which contains only the protobuf
 // type information for the message.
-func (x *fastReflection_DepositParams) Descriptor() protoreflect.MessageDescriptor {
-	return md_DepositParams
+func (x *fastReflection_ParamsUpdateFailure) Descriptor() protoreflect.MessageDescriptor {
+	return md_ParamsUpdateFailure
 }
 
 // Type returns the message type, which encapsulates both Go and protobuf
 // type information. If the Go type information is not needed,
 // it is recommended that the message descriptor be used instead.
-func (x *fastReflection_DepositParams) Type() protoreflect.MessageType {
-	return _fastReflection_DepositParams_messageType
+func (x *fastReflection_ParamsUpdateFailure) Type() protoreflect.MessageType {
+	return _fastReflection_ParamsUpdateFailure_messageType
 }
 
 // New returns a newly allocated and mutable empty message.
-func (x *fastReflection_DepositParams) New() protoreflect.Message {
-	return new(fastReflection_DepositParams)
+func (x *fastReflection_ParamsUpdateFailure) New() protoreflect.Message {
+	return new(fastReflection_ParamsUpdateFailure)
 }
 
 // Interface unwraps the message reflection interface and
 // returns the underlying ProtoMessage interface.
-func (x *fastReflection_DepositParams) Interface() protoreflect.ProtoMessage {
-	return (*DepositParams)(x)
+func (x *fastReflection_ParamsUpdateFailure) Interface() protoreflect.ProtoMessage {
+	return (*ParamsUpdateFailure)(x)
 }
 
 // Range iterates over every populated field in an undefined order,
@@ -3936,16 +4608,22 @@ func (x *fastReflection_DepositParams) Interface() protoreflect.ProtoMessage {
 // Range returns immediately if f returns false.
 // While iterating, mutating operations may only be performed
 // on the current field descriptor.
-func (x *fastReflection_DepositParams) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
-	if len(x.MinDeposit) != 0 {
-		value := protoreflect.ValueOfList(&_DepositParams_1_list{list: &x.MinDeposit})
-		if !f(fd_DepositParams_min_deposit, value) {
+func (x *fastReflection_ParamsUpdateFailure) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Index != uint32(0) {
+		value := protoreflect.ValueOfUint32(x.Index)
+		if !f(fd_ParamsUpdateFailure_index, value) {
 			return
 		}
 	}
-	if x.MaxDepositPeriod != nil {
-		value := protoreflect.ValueOfMessage(x.MaxDepositPeriod.ProtoReflect())
-		if !f(fd_DepositParams_max_deposit_period, value) {
+	if x.MsgTypeUrl != "" {
+		value := protoreflect.ValueOfString(x.MsgTypeUrl)
+		if !f(fd_ParamsUpdateFailure_msg_type_url, value) {
+			return
+		}
+	}
+	if x.Reason != "" {
+		value := protoreflect.ValueOfString(x.Reason)
+		if !f(fd_ParamsUpdateFailure_reason, value) {
 			return
 		}
 	}
@@ -3962,17 +4640,19 @@ func (x *fastReflection_DepositParams) Range(f func(protoreflect.FieldDescriptor
 // In other cases (aside from the nullable cases above),
 // a proto3 scalar field is populated if it contains a non-zero value, and
 // a repeated field is populated if it is non-empty.
-func (x *fastReflection_DepositParams) Has(fd protoreflect.FieldDescriptor) bool {
+func (x *fastReflection_ParamsUpdateFailure) Has(fd protoreflect.FieldDescriptor) bool {
 	switch fd.FullName() {
-	case "cosmos.gov.v1.DepositParams.min_deposit":
-		return len(x.MinDeposit) != 0
-	case "cosmos.gov.v1.DepositParams.max_deposit_period":
-		return x.MaxDepositPeriod != nil
+	case "cosmos.gov.v1.ParamsUpdateFailure.index":
+		return x.Index != uint32(0)
+	case "cosmos.gov.v1.ParamsUpdateFailure.msg_type_url":
+		return x.MsgTypeUrl != ""
+	case "cosmos.gov.v1.ParamsUpdateFailure.reason":
+		return x.Reason != ""
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.gov.v1.DepositParams"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.gov.v1.ParamsUpdateFailure"))
 		}
-		panic(fmt.Errorf("message cosmos.gov.v1.DepositParams does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.gov.v1.ParamsUpdateFailure does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -3982,17 +4662,19 @@ func (x *fastReflection_DepositParams) Has(fd protoreflect.FieldDescriptor) bool
 // associated with the given field number.
 //
 // Clear is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_DepositParams) Clear(fd protoreflect.FieldDescriptor) {
+func (x *fastReflection_ParamsUpdateFailure) Clear(fd protoreflect.FieldDescriptor) {
 	switch fd.FullName() {
-	case "cosmos.gov.v1.DepositParams.min_deposit":
-		x.MinDeposit = nil
-	case "cosmos.gov.v1.DepositParams.max_deposit_period":
-		x.MaxDepositPeriod = nil
+	case "cosmos.gov.v1.ParamsUpdateFailure.index":
+		x.Index = uint32(0)
+	case "cosmos.gov.v1.ParamsUpdateFailure.msg_type_url":
+		x.MsgTypeUrl = ""
+	case "cosmos.gov.v1.ParamsUpdateFailure.reason":
+		x.Reason = ""
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.gov.v1.DepositParams"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.gov.v1.ParamsUpdateFailure"))
 		}
-		panic(fmt.Errorf("message cosmos.gov.v1.DepositParams does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.gov.v1.ParamsUpdateFailure does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -4002,22 +4684,22 @@ func (x *fastReflection_DepositParams) Clear(fd protoreflect.FieldDescriptor) {
 // the default value of a bytes scalar is guaranteed to be a copy.
 // For unpopulated composite types, it returns an empty, read-only view
 // of the value; to obtain a mutable reference, use Mutable.
-func (x *fastReflection_DepositParams) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_ParamsUpdateFailure) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
 	switch descriptor.FullName() {
-	case "cosmos.gov.v1.DepositParams.min_deposit":
-		if len(x.MinDeposit) == 0 {
-			return protoreflect.ValueOfList(&_DepositParams_1_list{})
-		}
-		listValue := &_DepositParams_1_list{list: &x.MinDeposit}
-		return protoreflect.ValueOfList(listValue)
-	case "cosmos.gov.v1.DepositParams.max_deposit_period":
-		value := x.MaxDepositPeriod
-		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "cosmos.gov.v1.ParamsUpdateFailure.index":
+		value := x.Index
+		return protoreflect.ValueOfUint32(value)
+	case "cosmos.gov.v1.ParamsUpdateFailure.msg_type_url":
+		value := x.MsgTypeUrl
+		return protoreflect.ValueOfString(value)
+	case "cosmos.gov.v1.ParamsUpdateFailure.reason":
+		value := x.Reason
+		return protoreflect.ValueOfString(value)
 	default:
 		if descriptor.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.gov.v1.DepositParams"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.gov.v1.ParamsUpdateFailure"))
 		}
-		panic(fmt.Errorf("message cosmos.gov.v1.DepositParams does not contain field %s", descriptor.FullName()))
+		panic(fmt.Errorf("message cosmos.gov.v1.ParamsUpdateFailure does not contain field %s", descriptor.FullName()))
 	}
 }
 
@@ -4031,19 +4713,19 @@ func (x *fastReflection_DepositParams) Get(descriptor protoreflect.FieldDescript
 // empty, read-only value, then it panics.
 //
 // Set is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_DepositParams) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+func (x *fastReflection_ParamsUpdateFailure) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
 	switch fd.FullName() {
-	case "cosmos.gov.v1.DepositParams.min_deposit":
-		lv := value.List()
-		clv := lv.(*_DepositParams_1_list)
-		x.MinDeposit = *clv.list
-	case "cosmos.gov.v1.DepositParams.max_deposit_period":
-		x.MaxDepositPeriod = value.Message().Interface().(*durationpb.Duration)
+	case "cosmos.gov.v1.ParamsUpdateFailure.index":
+		x.Index = uint32(value.Uint())
+	case "cosmos.gov.v1.ParamsUpdateFailure.msg_type_url":
+		x.MsgTypeUrl = value.Interface().(string)
+	case "cosmos.gov.v1.ParamsUpdateFailure.reason":
+		x.Reason = value.Interface().(string)
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.gov.v1.DepositParams"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.gov.v1.ParamsUpdateFailure"))
 		}
-		panic(fmt.Errorf("message cosmos.gov.v1.DepositParams does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.gov.v1.ParamsUpdateFailure does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -4057,53 +4739,48 @@ func (x *fastReflection_DepositParams) Set(fd protoreflect.FieldDescriptor, valu
 // It panics if the field does not contain a composite type.
 //
 // Mutable is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_DepositParams) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_ParamsUpdateFailure) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "cosmos.gov.v1.DepositParams.min_deposit":
-		if x.MinDeposit == nil {
-			x.MinDeposit = []*v1beta1.Coin{}
-		}
-		value := &_DepositParams_1_list{list: &x.MinDeposit}
-		return protoreflect.ValueOfList(value)
-	case "cosmos.gov.v1.DepositParams.max_deposit_period":
-		if x.MaxDepositPeriod == nil {
-			x.MaxDepositPeriod = new(durationpb.Duration)
-		}
-		return protoreflect.ValueOfMessage(x.MaxDepositPeriod.ProtoReflect())
+	case "cosmos.gov.v1.ParamsUpdateFailure.index":
+		panic(fmt.Errorf("field index of message cosmos.gov.v1.ParamsUpdateFailure is not mutable"))
+	case "cosmos.gov.v1.ParamsUpdateFailure.msg_type_url":
+		panic(fmt.Errorf("field msg_type_url of message cosmos.gov.v1.ParamsUpdateFailure is not mutable"))
+	case "cosmos.gov.v1.ParamsUpdateFailure.reason":
+		panic(fmt.Errorf("field reason of message cosmos.gov.v1.ParamsUpdateFailure is not mutable"))
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.gov.v1.DepositParams"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.gov.v1.ParamsUpdateFailure"))
 		}
-		panic(fmt.Errorf("message cosmos.gov.v1.DepositParams does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.gov.v1.ParamsUpdateFailure does not contain field %s", fd.FullName()))
 	}
 }
 
 // NewField returns a new value that is assignable to the field
 // for the given descriptor. For scalars, this returns the default value.
 // For lists, maps, and messages, this returns a new, empty, mutable value.
-func (x *fastReflection_DepositParams) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_ParamsUpdateFailure) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "cosmos.gov.v1.DepositParams.min_deposit":
-		list := []*v1beta1.Coin{}
-		return protoreflect.ValueOfList(&_DepositParams_1_list{list: &list})
-	case "cosmos.gov.v1.DepositParams.max_deposit_period":
-		m := new(durationpb.Duration)
-		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case "cosmos.gov.v1.ParamsUpdateFailure.index":
+		return protoreflect.ValueOfUint32(uint32(0))
+	case "cosmos.gov.v1.ParamsUpdateFailure.msg_type_url":
+		return protoreflect.ValueOfString("")
+	case "cosmos.gov.v1.ParamsUpdateFailure.reason":
+		return protoreflect.ValueOfString("")
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.gov.v1.DepositParams"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.gov.v1.ParamsUpdateFailure"))
 		}
-		panic(fmt.Errorf("message cosmos.gov.v1.DepositParams does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.gov.v1.ParamsUpdateFailure does not contain field %s", fd.FullName()))
 	}
 }
 
 // WhichOneof reports which field within the oneof is populated,
 // returning nil if none are populated.
 // It panics if the oneof descriptor does not belong to this message.
-func (x *fastReflection_DepositParams) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+func (x *fastReflection_ParamsUpdateFailure) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
 	switch d.FullName() {
 	default:
-		panic(fmt.Errorf("%s is not a oneof field in cosmos.gov.v1.DepositParams", d.FullName()))
+		panic(fmt.Errorf("%s is not a oneof field in cosmos.gov.v1.ParamsUpdateFailure", d.FullName()))
 	}
 	panic("unreachable")
 }
@@ -4111,7 +4788,7 @@ func (x *fastReflection_DepositParams) WhichOneof(d protoreflect.OneofDescriptor
 // GetUnknown retrieves the entire list of unknown fields.
 // The caller may only mutate the contents of the RawFields
 // if the mutated bytes are stored back into the message with SetUnknown.
-func (x *fastReflection_DepositParams) GetUnknown() protoreflect.RawFields {
+func (x *fastReflection_ParamsUpdateFailure) GetUnknown() protoreflect.RawFields {
 	return x.unknownFields
 }
 
@@ -4122,7 +4799,7 @@ func (x *fastReflection_DepositParams) GetUnknown() protoreflect.RawFields {
 // An empty RawFields may be passed to clear the fields.
 //
 // SetUnknown is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_DepositParams) SetUnknown(fields protoreflect.RawFields) {
+func (x *fastReflection_ParamsUpdateFailure) SetUnknown(fields protoreflect.RawFields) {
 	x.unknownFields = fields
 }
 
@@ -4134,7 +4811,7 @@ func (x *fastReflection_DepositParams) SetUnknown(fields protoreflect.RawFields)
 // message type, but the details are implementation dependent.
 // Validity is not part of the protobuf data model, and may not
 // be preserved in marshaling or other operations.
-func (x *fastReflection_DepositParams) IsValid() bool {
+func (x *fastReflection_ParamsUpdateFailure) IsValid() bool {
 	return x != nil
 }
 
@@ -4144,9 +4821,9 @@ func (x *fastReflection_DepositParams) IsValid() bool {
 // The returned methods type is identical to
 // "google.golang.org/protobuf/runtime/protoiface".Methods.
 // Consult the protoiface package documentation for details.
-func (x *fastReflection_DepositParams) ProtoMethods() *protoiface.Methods {
+func (x *fastReflection_ParamsUpdateFailure) ProtoMethods() *protoiface.Methods {
 	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
-		x := input.Message.Interface().(*DepositParams)
+		x := input.Message.Interface().(*ParamsUpdateFailure)
 		if x == nil {
 			return protoiface.SizeOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -4158,14 +4835,15 @@ func (x *fastReflection_DepositParams) ProtoMethods() *protoiface.Methods {
 		var n int
 		var l int
 		_ = l
-		if len(x.MinDeposit) > 0 {
-			for _, e := range x.MinDeposit {
-				l = options.Size(e)
-				n += 1 + l + runtime.Sov(uint64(l))
-			}
+		if x.Index != 0 {
+			n += 1 + runtime.Sov(uint64(x.Index))
 		}
-		if x.MaxDepositPeriod != nil {
-			l = options.Size(x.MaxDepositPeriod)
+		l = len(x.MsgTypeUrl)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.Reason)
+		if l > 0 {
 			n += 1 + l + runtime.Sov(uint64(l))
 		}
 		if x.unknownFields != nil {
@@ -4178,7 +4856,7 @@ func (x *fastReflection_DepositParams) ProtoMethods() *protoiface.Methods {
 	}
 
 	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
-		x := input.Message.Interface().(*DepositParams)
+		x := input.Message.Interface().(*ParamsUpdateFailure)
 		if x == nil {
 			return protoiface.MarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -4197,35 +4875,24 @@ func (x *fastReflection_DepositParams) ProtoMethods() *protoiface.Methods {
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
-		if x.MaxDepositPeriod != nil {
-			encoded, err := options.Marshal(x.MaxDepositPeriod)
-			if err != nil {
-				return protoiface.MarshalOutput{
-					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
-					Buf:               input.Buf,
-				}, err
-			}
-			i -= len(encoded)
-			copy(dAtA[i:], encoded)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+		if len(x.Reason) > 0 {
+			i -= len(x.Reason)
+			copy(dAtA[i:], x.Reason)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Reason)))
+			i--
+			dAtA[i] = 0x1a
+		}
+		if len(x.MsgTypeUrl) > 0 {
+			i -= len(x.MsgTypeUrl)
+			copy(dAtA[i:], x.MsgTypeUrl)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.MsgTypeUrl)))
 			i--
 			dAtA[i] = 0x12
 		}
-		if len(x.MinDeposit) > 0 {
-			for iNdEx := len(x.MinDeposit) - 1; iNdEx >= 0; iNdEx-- {
-				encoded, err := options.Marshal(x.MinDeposit[iNdEx])
-				if err != nil {
-					return protoiface.MarshalOutput{
-						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
-						Buf:               input.Buf,
-					}, err
-				}
-				i -= len(encoded)
-				copy(dAtA[i:], encoded)
-				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
-				i--
-				dAtA[i] = 0xa
-			}
+		if x.Index != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.Index))
+			i--
+			dAtA[i] = 0x8
 		}
 		if input.Buf != nil {
 			input.Buf = append(input.Buf, dAtA...)
@@ -4238,7 +4905,7 @@ func (x *fastReflection_DepositParams) ProtoMethods() *protoiface.Methods {
 		}, nil
 	}
 	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
-		x := input.Message.Interface().(*DepositParams)
+		x := input.Message.Interface().(*ParamsUpdateFailure)
 		if x == nil {
 			return protoiface.UnmarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -4270,17 +4937,36 @@ func (x *fastReflection_DepositParams) ProtoMethods() *protoiface.Methods {
 			fieldNum := int32(wire >> 3)
 			wireType := int(wire & 0x7)
 			if wireType == 4 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: DepositParams: wiretype end group for non-group")
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: ParamsUpdateFailure: wiretype end group for non-group")
 			}
 			if fieldNum <= 0 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: DepositParams: illegal tag %d (wire type %d)", fieldNum, wire)
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: ParamsUpdateFailure: illegal tag %d (wire type %d)", fieldNum, wire)
 			}
 			switch fieldNum {
 			case 1:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Index", wireType)
+				}
+				x.Index = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.Index |= uint32(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 2:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field MinDeposit", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field MsgTypeUrl", wireType)
 				}
-				var msglen int
+				var stringLen uint64
 				for shift := uint(0); ; shift += 7 {
 					if shift >= 64 {
 						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
@@ -4290,31 +4976,29 @@ func (x *fastReflection_DepositParams) ProtoMethods() *protoiface.Methods {
 					}
 					b := dAtA[iNdEx]
 					iNdEx++
-					msglen |= int(b&0x7F) << shift
+					stringLen |= uint64(b&0x7F) << shift
 					if b < 0x80 {
 						break
 					}
 				}
-				if msglen < 0 {
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 				}
-				postIndex := iNdEx + msglen
+				postIndex := iNdEx + intStringLen
 				if postIndex < 0 {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 				}
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				x.MinDeposit = append(x.MinDeposit, &v1beta1.Coin{})
-				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.MinDeposit[len(x.MinDeposit)-1]); err != nil {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
-				}
+				x.MsgTypeUrl = string(dAtA[iNdEx:postIndex])
 				iNdEx = postIndex
-			case 2:
+			case 3:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field MaxDepositPeriod", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
 				}
-				var msglen int
+				var stringLen uint64
 				for shift := uint(0); ; shift += 7 {
 					if shift >= 64 {
 						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
@@ -4324,27 +5008,23 @@ func (x *fastReflection_DepositParams) ProtoMethods() *protoiface.Methods {
 					}
 					b := dAtA[iNdEx]
 					iNdEx++
-					msglen |= int(b&0x7F) << shift
+					stringLen |= uint64(b&0x7F) << shift
 					if b < 0x80 {
 						break
 					}
 				}
-				if msglen < 0 {
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 				}
-				postIndex := iNdEx + msglen
+				postIndex := iNdEx + intStringLen
 				if postIndex < 0 {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 				}
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				if x.MaxDepositPeriod == nil {
-					x.MaxDepositPeriod = &durationpb.Duration{}
-				}
-				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.MaxDepositPeriod); err != nil {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
-				}
+				x.Reason = string(dAtA[iNdEx:postIndex])
 				iNdEx = postIndex
 			default:
 				iNdEx = preIndex
@@ -4382,25 +5062,31 @@ func (x *fastReflection_DepositParams) ProtoMethods() *protoiface.Methods {
 }
 
 var (
-	md_VotingParams               protoreflect.MessageDescriptor
-	fd_VotingParams_voting_period protoreflect.FieldDescriptor
+	md_TallyResult                    protoreflect.MessageDescriptor
+	fd_TallyResult_yes_count          protoreflect.FieldDescriptor
+	fd_TallyResult_abstain_count      protoreflect.FieldDescriptor
+	fd_TallyResult_no_count           protoreflect.FieldDescriptor
+	fd_TallyResult_no_with_veto_count protoreflect.FieldDescriptor
 )
 
 func init() {
 	file_cosmos_gov_v1_gov_proto_init()
-	md_VotingParams = File_cosmos_gov_v1_gov_proto.Messages().ByName("VotingParams")
-	fd_VotingParams_voting_period = md_VotingParams.Fields().ByName("voting_period")
+	md_TallyResult = File_cosmos_gov_v1_gov_proto.Messages().ByName("TallyResult")
+	fd_TallyResult_yes_count = md_TallyResult.Fields().ByName("yes_count")
+	fd_TallyResult_abstain_count = md_TallyResult.Fields().ByName("abstain_count")
+	fd_TallyResult_no_count = md_TallyResult.Fields().ByName("no_count")
+	fd_TallyResult_no_with_veto_count = md_TallyResult.Fields().ByName("no_with_veto_count")
 }
 
-var _ protoreflect.Message = (*fastReflection_VotingParams)(nil)
+var _ protoreflect.Message = (*fastReflection_TallyResult)(nil)
 
-type fastReflection_VotingParams VotingParams
+type fastReflection_TallyResult TallyResult
 
-func (x *VotingParams) ProtoReflect() protoreflect.Message {
-	return (*fastReflection_VotingParams)(x)
+func (x *TallyResult) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_TallyResult)(x)
 }
 
-func (x *VotingParams) slowProtoReflect() protoreflect.Message {
+func (x *TallyResult) slowProtoReflect() protoreflect.Message {
 	mi := &file_cosmos_gov_v1_gov_proto_msgTypes[6]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -4412,43 +5098,43 @@ func (x *VotingParams) slowProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-var _fastReflection_VotingParams_messageType fastReflection_VotingParams_messageType
-var _ protoreflect.MessageType = fastReflection_VotingParams_messageType{}
+var _fastReflection_TallyResult_messageType fastReflection_TallyResult_messageType
+var _ protoreflect.MessageType = fastReflection_TallyResult_messageType{}
 
-type fastReflection_VotingParams_messageType struct{}
+type fastReflection_TallyResult_messageType struct{}
 
-func (x fastReflection_VotingParams_messageType) Zero() protoreflect.Message {
-	return (*fastReflection_VotingParams)(nil)
+func (x fastReflection_TallyResult_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_TallyResult)(nil)
 }
-func (x fastReflection_VotingParams_messageType) New() protoreflect.Message {
-	return new(fastReflection_VotingParams)
+func (x fastReflection_TallyResult_messageType) New() protoreflect.Message {
+	return new(fastReflection_TallyResult)
 }
-func (x fastReflection_VotingParams_messageType) Descriptor() protoreflect.MessageDescriptor {
-	return md_VotingParams
+func (x fastReflection_TallyResult_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_TallyResult
 }
 
 // Descriptor returns message descriptor, which contains only the protobuf
 // type information for the message.
-func (x *fastReflection_VotingParams) Descriptor() protoreflect.MessageDescriptor {
-	return md_VotingParams
+func (x *fastReflection_TallyResult) Descriptor() protoreflect.MessageDescriptor {
+	return md_TallyResult
 }
 
 // Type returns the message type, which encapsulates both Go and protobuf
 // type information. If the Go type information is not needed,
 // it is recommended that the message descriptor be used instead.
-func (x *fastReflection_VotingParams) Type() protoreflect.MessageType {
-	return _fastReflection_VotingParams_messageType
+func (x *fastReflection_TallyResult) Type() protoreflect.MessageType {
+	return _fastReflection_TallyResult_messageType
 }
 
 // New returns a newly allocated and mutable empty message.
-func (x *fastReflection_VotingParams) New() protoreflect.Message {
-	return new(fastReflection_VotingParams)
+func (x *fastReflection_TallyResult) New() protoreflect.Message {
+	return new(fastReflection_TallyResult)
 }
 
 // Interface unwraps the message reflection interface and
 // returns the underlying ProtoMessage interface.
-func (x *fastReflection_VotingParams) Interface() protoreflect.ProtoMessage {
-	return (*VotingParams)(x)
+func (x *fastReflection_TallyResult) Interface() protoreflect.ProtoMessage {
+	return (*TallyResult)(x)
 }
 
 // Range iterates over every populated field in an undefined order,
@@ -4456,10 +5142,28 @@ func (x *fastReflection_VotingParams) Interface() protoreflect.ProtoMessage {
 // Range returns immediately if f returns false.
 // While iterating, mutating operations may only be performed
 // on the current field descriptor.
-func (x *fastReflection_VotingParams) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
-	if x.VotingPeriod != nil {
-		value := protoreflect.ValueOfMessage(x.VotingPeriod.ProtoReflect())
-		if !f(fd_VotingParams_voting_period, value) {
+func (x *fastReflection_TallyResult) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.YesCount != "" {
+		value := protoreflect.ValueOfString(x.YesCount)
+		if !f(fd_TallyResult_yes_count, value) {
+			return
+		}
+	}
+	if x.AbstainCount != "" {
+		value := protoreflect.ValueOfString(x.AbstainCount)
+		if !f(fd_TallyResult_abstain_count, value) {
+			return
+		}
+	}
+	if x.NoCount != "" {
+		value := protoreflect.ValueOfString(x.NoCount)
+		if !f(fd_TallyResult_no_count, value) {
+			return
+		}
+	}
+	if x.NoWithVetoCount != "" {
+		value := protoreflect.ValueOfString(x.NoWithVetoCount)
+		if !f(fd_TallyResult_no_with_veto_count, value) {
 			return
 		}
 	}
@@ -4476,15 +5180,21 @@ func (x *fastReflection_VotingParams) Range(f func(protoreflect.FieldDescriptor,
 // In other cases (aside from the nullable cases above),
 // a proto3 scalar field is populated if it contains a non-zero value, and
 // a repeated field is populated if it is non-empty.
-func (x *fastReflection_VotingParams) Has(fd protoreflect.FieldDescriptor) bool {
+func (x *fastReflection_TallyResult) Has(fd protoreflect.FieldDescriptor) bool {
 	switch fd.FullName() {
-	case "cosmos.gov.v1.VotingParams.voting_period":
-		return x.VotingPeriod != nil
+	case "cosmos.gov.v1.TallyResult.yes_count":
+		return x.YesCount != ""
+	case "cosmos.gov.v1.TallyResult.abstain_count":
+		return x.AbstainCount != ""
+	case "cosmos.gov.v1.TallyResult.no_count":
+		return x.NoCount != ""
+	case "cosmos.gov.v1.TallyResult.no_with_veto_count":
+		return x.NoWithVetoCount != ""
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.gov.v1.VotingParams"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.gov.v1.TallyResult"))
 		}
-		panic(fmt.Errorf("message cosmos.gov.v1.VotingParams does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.gov.v1.TallyResult does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -4494,15 +5204,21 @@ func (x *fastReflection_VotingParams) Has(fd protoreflect.FieldDescriptor) bool
 // associated with the given field number.
 //
 // Clear is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_VotingParams) Clear(fd protoreflect.FieldDescriptor) {
+func (x *fastReflection_TallyResult) Clear(fd protoreflect.FieldDescriptor) {
 	switch fd.FullName() {
-	case "cosmos.gov.v1.VotingParams.voting_period":
-		x.VotingPeriod = nil
+	case "cosmos.gov.v1.TallyResult.yes_count":
+		x.YesCount = ""
+	case "cosmos.gov.v1.TallyResult.abstain_count":
+		x.AbstainCount = ""
+	case "cosmos.gov.v1.TallyResult.no_count":
+		x.NoCount = ""
+	case "cosmos.gov.v1.TallyResult.no_with_veto_count":
+		x.NoWithVetoCount = ""
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.gov.v1.VotingParams"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.gov.v1.TallyResult"))
 		}
-		panic(fmt.Errorf("message cosmos.gov.v1.VotingParams does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.gov.v1.TallyResult does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -4512,16 +5228,25 @@ func (x *fastReflection_VotingParams) Clear(fd protoreflect.FieldDescriptor) {
 // the default value of a bytes scalar is guaranteed to be a copy.
 // For unpopulated composite types, it returns an empty, read-only view
 // of the value; to obtain a mutable reference, use Mutable.
-func (x *fastReflection_VotingParams) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_TallyResult) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
 	switch descriptor.FullName() {
-	case "cosmos.gov.v1.VotingParams.voting_period":
-		value := x.VotingPeriod
-		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "cosmos.gov.v1.TallyResult.yes_count":
+		value := x.YesCount
+		return protoreflect.ValueOfString(value)
+	case "cosmos.gov.v1.TallyResult.abstain_count":
+		value := x.AbstainCount
+		return protoreflect.ValueOfString(value)
+	case "cosmos.gov.v1.TallyResult.no_count":
+		value := x.NoCount
+		return protoreflect.ValueOfString(value)
+	case "cosmos.gov.v1.TallyResult.no_with_veto_count":
+		value := x.NoWithVetoCount
+		return protoreflect.ValueOfString(value)
 	default:
 		if descriptor.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.gov.v1.VotingParams"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.gov.v1.TallyResult"))
 		}
-		panic(fmt.Errorf("message cosmos.gov.v1.VotingParams does not contain field %s", descriptor.FullName()))
+		panic(fmt.Errorf("message cosmos.gov.v1.TallyResult does not contain field %s", descriptor.FullName()))
 	}
 }
 
@@ -4535,15 +5260,21 @@ func (x *fastReflection_VotingParams) Get(descriptor protoreflect.FieldDescripto
 // empty, read-only value, then it panics.
 //
 // Set is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_VotingParams) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+func (x *fastReflection_TallyResult) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
 	switch fd.FullName() {
-	case "cosmos.gov.v1.VotingParams.voting_period":
-		x.VotingPeriod = value.Message().Interface().(*durationpb.Duration)
+	case "cosmos.gov.v1.TallyResult.yes_count":
+		x.YesCount = value.Interface().(string)
+	case "cosmos.gov.v1.TallyResult.abstain_count":
+		x.AbstainCount = value.Interface().(string)
+	case "cosmos.gov.v1.TallyResult.no_count":
+		x.NoCount = value.Interface().(string)
+	case "cosmos.gov.v1.TallyResult.no_with_veto_count":
+		x.NoWithVetoCount = value.Interface().(string)
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.gov.v1.VotingParams"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.gov.v1.TallyResult"))
 		}
-		panic(fmt.Errorf("message cosmos.gov.v1.VotingParams does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.gov.v1.TallyResult does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -4557,44 +5288,52 @@ func (x *fastReflection_VotingParams) Set(fd protoreflect.FieldDescriptor, value
 // It panics if the field does not contain a composite type.
 //
 // Mutable is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_VotingParams) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_TallyResult) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "cosmos.gov.v1.VotingParams.voting_period":
-		if x.VotingPeriod == nil {
-			x.VotingPeriod = new(durationpb.Duration)
-		}
-		return protoreflect.ValueOfMessage(x.VotingPeriod.ProtoReflect())
+	case "cosmos.gov.v1.TallyResult.yes_count":
+		panic(fmt.Errorf("field yes_count of message cosmos.gov.v1.TallyResult is not mutable"))
+	case "cosmos.gov.v1.TallyResult.abstain_count":
+		panic(fmt.Errorf("field abstain_count of message cosmos.gov.v1.TallyResult is not mutable"))
+	case "cosmos.gov.v1.TallyResult.no_count":
+		panic(fmt.Errorf("field no_count of message cosmos.gov.v1.TallyResult is not mutable"))
+	case "cosmos.gov.v1.TallyResult.no_with_veto_count":
+		panic(fmt.Errorf("field no_with_veto_count of message cosmos.gov.v1.TallyResult is not mutable"))
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.gov.v1.VotingParams"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.gov.v1.TallyResult"))
 		}
-		panic(fmt.Errorf("message cosmos.gov.v1.VotingParams does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.gov.v1.TallyResult does not contain field %s", fd.FullName()))
 	}
 }
 
 // NewField returns a new value that is assignable to the field
 // for the given descriptor. For scalars, this returns the default value.
 // For lists, maps, and messages, this returns a new, empty, mutable value.
-func (x *fastReflection_VotingParams) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_TallyResult) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "cosmos.gov.v1.VotingParams.voting_period":
-		m := new(durationpb.Duration)
-		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case "cosmos.gov.v1.TallyResult.yes_count":
+		return protoreflect.ValueOfString("")
+	case "cosmos.gov.v1.TallyResult.abstain_count":
+		return protoreflect.ValueOfString("")
+	case "cosmos.gov.v1.TallyResult.no_count":
+		return protoreflect.ValueOfString("")
+	case "cosmos.gov.v1.TallyResult.no_with_veto_count":
+		return protoreflect.ValueOfString("")
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.gov.v1.VotingParams"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.gov.v1.TallyResult"))
 		}
-		panic(fmt.Errorf("message cosmos.gov.v1.VotingParams does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.gov.v1.TallyResult does not contain field %s", fd.FullName()))
 	}
 }
 
 // WhichOneof reports which field within the oneof is populated,
 // returning nil if none are populated.
 // It panics if the oneof descriptor does not belong to this message.
-func (x *fastReflection_VotingParams) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+func (x *fastReflection_TallyResult) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
 	switch d.FullName() {
 	default:
-		panic(fmt.Errorf("%s is not a oneof field in cosmos.gov.v1.VotingParams", d.FullName()))
+		panic(fmt.Errorf("%s is not a oneof field in cosmos.gov.v1.TallyResult", d.FullName()))
 	}
 	panic("unreachable")
 }
@@ -4602,7 +5341,7 @@ func (x *fastReflection_VotingParams) WhichOneof(d protoreflect.OneofDescriptor)
 // GetUnknown retrieves the entire list of unknown fields.
 // The caller may only mutate the contents of the RawFields
 // if the mutated bytes are stored back into the message with SetUnknown.
-func (x *fastReflection_VotingParams) GetUnknown() protoreflect.RawFields {
+func (x *fastReflection_TallyResult) GetUnknown() protoreflect.RawFields {
 	return x.unknownFields
 }
 
@@ -4613,7 +5352,7 @@ func (x *fastReflection_VotingParams) GetUnknown() protoreflect.RawFields {
 // An empty RawFields may be passed to clear the fields.
 //
 // SetUnknown is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_VotingParams) SetUnknown(fields protoreflect.RawFields) {
+func (x *fastReflection_TallyResult) SetUnknown(fields protoreflect.RawFields) {
 	x.unknownFields = fields
 }
 
@@ -4625,7 +5364,7 @@ func (x *fastReflection_VotingParams) SetUnknown(fields protoreflect.RawFields)
 // message type, but the details are implementation dependent.
 // Validity is not part of the protobuf data model, and may not
 // be preserved in marshaling or other operations.
-func (x *fastReflection_VotingParams) IsValid() bool {
+func (x *fastReflection_TallyResult) IsValid() bool {
 	return x != nil
 }
 
@@ -4635,9 +5374,9 @@ func (x *fastReflection_VotingParams) IsValid() bool {
 // The returned methods type is identical to
 // "google.golang.org/protobuf/runtime/protoiface".Methods.
 // Consult the protoiface package documentation for details.
-func (x *fastReflection_VotingParams) ProtoMethods() *protoiface.Methods {
+func (x *fastReflection_TallyResult) ProtoMethods() *protoiface.Methods {
 	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
-		x := input.Message.Interface().(*VotingParams)
+		x := input.Message.Interface().(*TallyResult)
 		if x == nil {
 			return protoiface.SizeOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -4649,8 +5388,20 @@ func (x *fastReflection_VotingParams) ProtoMethods() *protoiface.Methods {
 		var n int
 		var l int
 		_ = l
-		if x.VotingPeriod != nil {
-			l = options.Size(x.VotingPeriod)
+		l = len(x.YesCount)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.AbstainCount)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.NoCount)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.NoWithVetoCount)
+		if l > 0 {
 			n += 1 + l + runtime.Sov(uint64(l))
 		}
 		if x.unknownFields != nil {
@@ -4663,7 +5414,7 @@ func (x *fastReflection_VotingParams) ProtoMethods() *protoiface.Methods {
 	}
 
 	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
-		x := input.Message.Interface().(*VotingParams)
+		x := input.Message.Interface().(*TallyResult)
 		if x == nil {
 			return protoiface.MarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -4682,17 +5433,31 @@ func (x *fastReflection_VotingParams) ProtoMethods() *protoiface.Methods {
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
-		if x.VotingPeriod != nil {
-			encoded, err := options.Marshal(x.VotingPeriod)
-			if err != nil {
-				return protoiface.MarshalOutput{
-					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
-					Buf:               input.Buf,
-				}, err
-			}
-			i -= len(encoded)
-			copy(dAtA[i:], encoded)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+		if len(x.NoWithVetoCount) > 0 {
+			i -= len(x.NoWithVetoCount)
+			copy(dAtA[i:], x.NoWithVetoCount)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.NoWithVetoCount)))
+			i--
+			dAtA[i] = 0x22
+		}
+		if len(x.NoCount) > 0 {
+			i -= len(x.NoCount)
+			copy(dAtA[i:], x.NoCount)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.NoCount)))
+			i--
+			dAtA[i] = 0x1a
+		}
+		if len(x.AbstainCount) > 0 {
+			i -= len(x.AbstainCount)
+			copy(dAtA[i:], x.AbstainCount)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.AbstainCount)))
+			i--
+			dAtA[i] = 0x12
+		}
+		if len(x.YesCount) > 0 {
+			i -= len(x.YesCount)
+			copy(dAtA[i:], x.YesCount)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.YesCount)))
 			i--
 			dAtA[i] = 0xa
 		}
@@ -4707,7 +5472,7 @@ func (x *fastReflection_VotingParams) ProtoMethods() *protoiface.Methods {
 		}, nil
 	}
 	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
-		x := input.Message.Interface().(*VotingParams)
+		x := input.Message.Interface().(*TallyResult)
 		if x == nil {
 			return protoiface.UnmarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -4739,17 +5504,17 @@ func (x *fastReflection_VotingParams) ProtoMethods() *protoiface.Methods {
 			fieldNum := int32(wire >> 3)
 			wireType := int(wire & 0x7)
 			if wireType == 4 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: VotingParams: wiretype end group for non-group")
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: TallyResult: wiretype end group for non-group")
 			}
 			if fieldNum <= 0 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: VotingParams: illegal tag %d (wire type %d)", fieldNum, wire)
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: TallyResult: illegal tag %d (wire type %d)", fieldNum, wire)
 			}
 			switch fieldNum {
 			case 1:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field VotingPeriod", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field YesCount", wireType)
 				}
-				var msglen int
+				var stringLen uint64
 				for shift := uint(0); ; shift += 7 {
 					if shift >= 64 {
 						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
@@ -4759,27 +5524,119 @@ func (x *fastReflection_VotingParams) ProtoMethods() *protoiface.Methods {
 					}
 					b := dAtA[iNdEx]
 					iNdEx++
-					msglen |= int(b&0x7F) << shift
+					stringLen |= uint64(b&0x7F) << shift
 					if b < 0x80 {
 						break
 					}
 				}
-				if msglen < 0 {
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 				}
-				postIndex := iNdEx + msglen
+				postIndex := iNdEx + intStringLen
 				if postIndex < 0 {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 				}
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				if x.VotingPeriod == nil {
-					x.VotingPeriod = &durationpb.Duration{}
+				x.YesCount = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field AbstainCount", wireType)
 				}
-				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.VotingPeriod); err != nil {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.AbstainCount = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 3:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field NoCount", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.NoCount = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 4:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field NoWithVetoCount", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
+				x.NoWithVetoCount = string(dAtA[iNdEx:postIndex])
 				iNdEx = postIndex
 			default:
 				iNdEx = preIndex
@@ -4816,78 +5673,133 @@ func (x *fastReflection_VotingParams) ProtoMethods() *protoiface.Methods {
 	}
 }
 
-var (
-	md_TallyParams                protoreflect.MessageDescriptor
-	fd_TallyParams_quorum         protoreflect.FieldDescriptor
-	fd_TallyParams_threshold      protoreflect.FieldDescriptor
-	fd_TallyParams_veto_threshold protoreflect.FieldDescriptor
-)
+var _ protoreflect.List = (*_Vote_4_list)(nil)
 
-func init() {
-	file_cosmos_gov_v1_gov_proto_init()
-	md_TallyParams = File_cosmos_gov_v1_gov_proto.Messages().ByName("TallyParams")
-	fd_TallyParams_quorum = md_TallyParams.Fields().ByName("quorum")
-	fd_TallyParams_threshold = md_TallyParams.Fields().ByName("threshold")
-	fd_TallyParams_veto_threshold = md_TallyParams.Fields().ByName("veto_threshold")
+type _Vote_4_list struct {
+	list *[]*WeightedVoteOption
 }
 
-var _ protoreflect.Message = (*fastReflection_TallyParams)(nil)
+func (x *_Vote_4_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
 
-type fastReflection_TallyParams TallyParams
+func (x *_Vote_4_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
 
-func (x *TallyParams) ProtoReflect() protoreflect.Message {
-	return (*fastReflection_TallyParams)(x)
+func (x *_Vote_4_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*WeightedVoteOption)
+	(*x.list)[i] = concreteValue
 }
 
-func (x *TallyParams) slowProtoReflect() protoreflect.Message {
-	mi := &file_cosmos_gov_v1_gov_proto_msgTypes[7]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
-	}
-	return mi.MessageOf(x)
+func (x *_Vote_4_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*WeightedVoteOption)
+	*x.list = append(*x.list, concreteValue)
 }
 
-var _fastReflection_TallyParams_messageType fastReflection_TallyParams_messageType
-var _ protoreflect.MessageType = fastReflection_TallyParams_messageType{}
+func (x *_Vote_4_list) AppendMutable() protoreflect.Value {
+	v := new(WeightedVoteOption)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
 
-type fastReflection_TallyParams_messageType struct{}
+func (x *_Vote_4_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
 
-func (x fastReflection_TallyParams_messageType) Zero() protoreflect.Message {
-	return (*fastReflection_TallyParams)(nil)
+func (x *_Vote_4_list) NewElement() protoreflect.Value {
+	v := new(WeightedVoteOption)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
 }
-func (x fastReflection_TallyParams_messageType) New() protoreflect.Message {
-	return new(fastReflection_TallyParams)
+
+func (x *_Vote_4_list) IsValid() bool {
+	return x.list != nil
 }
-func (x fastReflection_TallyParams_messageType) Descriptor() protoreflect.MessageDescriptor {
-	return md_TallyParams
+
+var (
+	md_Vote                    protoreflect.MessageDescriptor
+	fd_Vote_proposal_id        protoreflect.FieldDescriptor
+	fd_Vote_voter              protoreflect.FieldDescriptor
+	fd_Vote_options            protoreflect.FieldDescriptor
+	fd_Vote_metadata           protoreflect.FieldDescriptor
+	fd_Vote_metadata_schema_id protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_cosmos_gov_v1_gov_proto_init()
+	md_Vote = File_cosmos_gov_v1_gov_proto.Messages().ByName("Vote")
+	fd_Vote_proposal_id = md_Vote.Fields().ByName("proposal_id")
+	fd_Vote_voter = md_Vote.Fields().ByName("voter")
+	fd_Vote_options = md_Vote.Fields().ByName("options")
+	fd_Vote_metadata = md_Vote.Fields().ByName("metadata")
+	fd_Vote_metadata_schema_id = md_Vote.Fields().ByName("metadata_schema_id")
+}
+
+var _ protoreflect.Message = (*fastReflection_Vote)(nil)
+
+type fastReflection_Vote Vote
+
+func (x *Vote) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_Vote)(x)
+}
+
+func (x *Vote) slowProtoReflect() protoreflect.Message {
+	mi := &file_cosmos_gov_v1_gov_proto_msgTypes[7]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_Vote_messageType fastReflection_Vote_messageType
+var _ protoreflect.MessageType = fastReflection_Vote_messageType{}
+
+type fastReflection_Vote_messageType struct{}
+
+func (x fastReflection_Vote_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_Vote)(nil)
+}
+func (x fastReflection_Vote_messageType) New() protoreflect.Message {
+	return new(fastReflection_Vote)
+}
+func (x fastReflection_Vote_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_Vote
 }
 
 // Descriptor returns message descriptor, which contains only the protobuf
 // type information for the message.
-func (x *fastReflection_TallyParams) Descriptor() protoreflect.MessageDescriptor {
-	return md_TallyParams
+func (x *fastReflection_Vote) Descriptor() protoreflect.MessageDescriptor {
+	return md_Vote
 }
 
 // Type returns the message type, which encapsulates both Go and protobuf
 // type information. If the Go type information is not needed,
 // it is recommended that the message descriptor be used instead.
-func (x *fastReflection_TallyParams) Type() protoreflect.MessageType {
-	return _fastReflection_TallyParams_messageType
+func (x *fastReflection_Vote) Type() protoreflect.MessageType {
+	return _fastReflection_Vote_messageType
 }
 
 // New returns a newly allocated and mutable empty message.
-func (x *fastReflection_TallyParams) New() protoreflect.Message {
-	return new(fastReflection_TallyParams)
+func (x *fastReflection_Vote) New() protoreflect.Message {
+	return new(fastReflection_Vote)
 }
 
 // Interface unwraps the message reflection interface and
 // returns the underlying ProtoMessage interface.
-func (x *fastReflection_TallyParams) Interface() protoreflect.ProtoMessage {
-	return (*TallyParams)(x)
+func (x *fastReflection_Vote) Interface() protoreflect.ProtoMessage {
+	return (*Vote)(x)
 }
 
 // Range iterates over every populated field in an undefined order,
@@ -4895,22 +5807,34 @@ func (x *fastReflection_TallyParams) Interface() protoreflect.ProtoMessage {
 // Range returns immediately if f returns false.
 // While iterating, mutating operations may only be performed
 // on the current field descriptor.
-func (x *fastReflection_TallyParams) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
-	if x.Quorum != "" {
-		value := protoreflect.ValueOfString(x.Quorum)
-		if !f(fd_TallyParams_quorum, value) {
+func (x *fastReflection_Vote) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.ProposalId != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.ProposalId)
+		if !f(fd_Vote_proposal_id, value) {
 			return
 		}
 	}
-	if x.Threshold != "" {
-		value := protoreflect.ValueOfString(x.Threshold)
-		if !f(fd_TallyParams_threshold, value) {
+	if x.Voter != "" {
+		value := protoreflect.ValueOfString(x.Voter)
+		if !f(fd_Vote_voter, value) {
 			return
 		}
 	}
-	if x.VetoThreshold != "" {
-		value := protoreflect.ValueOfString(x.VetoThreshold)
-		if !f(fd_TallyParams_veto_threshold, value) {
+	if len(x.Options) != 0 {
+		value := protoreflect.ValueOfList(&_Vote_4_list{list: &x.Options})
+		if !f(fd_Vote_options, value) {
+			return
+		}
+	}
+	if x.Metadata != "" {
+		value := protoreflect.ValueOfString(x.Metadata)
+		if !f(fd_Vote_metadata, value) {
+			return
+		}
+	}
+	if x.MetadataSchemaId != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.MetadataSchemaId)
+		if !f(fd_Vote_metadata_schema_id, value) {
 			return
 		}
 	}
@@ -4927,19 +5851,23 @@ func (x *fastReflection_TallyParams) Range(f func(protoreflect.FieldDescriptor,
 // In other cases (aside from the nullable cases above),
 // a proto3 scalar field is populated if it contains a non-zero value, and
 // a repeated field is populated if it is non-empty.
-func (x *fastReflection_TallyParams) Has(fd protoreflect.FieldDescriptor) bool {
+func (x *fastReflection_Vote) Has(fd protoreflect.FieldDescriptor) bool {
 	switch fd.FullName() {
-	case "cosmos.gov.v1.TallyParams.quorum":
-		return x.Quorum != ""
-	case "cosmos.gov.v1.TallyParams.threshold":
-		return x.Threshold != ""
-	case "cosmos.gov.v1.TallyParams.veto_threshold":
-		return x.VetoThreshold != ""
+	case "cosmos.gov.v1.Vote.proposal_id":
+		return x.ProposalId != uint64(0)
+	case "cosmos.gov.v1.Vote.voter":
+		return x.Voter != ""
+	case "cosmos.gov.v1.Vote.options":
+		return len(x.Options) != 0
+	case "cosmos.gov.v1.Vote.metadata":
+		return x.Metadata != ""
+	case "cosmos.gov.v1.Vote.metadata_schema_id":
+		return x.MetadataSchemaId != uint64(0)
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.gov.v1.TallyParams"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.gov.v1.Vote"))
 		}
-		panic(fmt.Errorf("message cosmos.gov.v1.TallyParams does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.gov.v1.Vote does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -4949,19 +5877,23 @@ func (x *fastReflection_TallyParams) Has(fd protoreflect.FieldDescriptor) bool {
 // associated with the given field number.
 //
 // Clear is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_TallyParams) Clear(fd protoreflect.FieldDescriptor) {
+func (x *fastReflection_Vote) Clear(fd protoreflect.FieldDescriptor) {
 	switch fd.FullName() {
-	case "cosmos.gov.v1.TallyParams.quorum":
-		x.Quorum = ""
-	case "cosmos.gov.v1.TallyParams.threshold":
-		x.Threshold = ""
-	case "cosmos.gov.v1.TallyParams.veto_threshold":
-		x.VetoThreshold = ""
+	case "cosmos.gov.v1.Vote.proposal_id":
+		x.ProposalId = uint64(0)
+	case "cosmos.gov.v1.Vote.voter":
+		x.Voter = ""
+	case "cosmos.gov.v1.Vote.options":
+		x.Options = nil
+	case "cosmos.gov.v1.Vote.metadata":
+		x.Metadata = ""
+	case "cosmos.gov.v1.Vote.metadata_schema_id":
+		x.MetadataSchemaId = uint64(0)
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.gov.v1.TallyParams"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.gov.v1.Vote"))
 		}
-		panic(fmt.Errorf("message cosmos.gov.v1.TallyParams does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.gov.v1.Vote does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -4971,22 +5903,31 @@ func (x *fastReflection_TallyParams) Clear(fd protoreflect.FieldDescriptor) {
 // the default value of a bytes scalar is guaranteed to be a copy.
 // For unpopulated composite types, it returns an empty, read-only view
 // of the value; to obtain a mutable reference, use Mutable.
-func (x *fastReflection_TallyParams) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_Vote) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
 	switch descriptor.FullName() {
-	case "cosmos.gov.v1.TallyParams.quorum":
-		value := x.Quorum
-		return protoreflect.ValueOfString(value)
-	case "cosmos.gov.v1.TallyParams.threshold":
-		value := x.Threshold
+	case "cosmos.gov.v1.Vote.proposal_id":
+		value := x.ProposalId
+		return protoreflect.ValueOfUint64(value)
+	case "cosmos.gov.v1.Vote.voter":
+		value := x.Voter
 		return protoreflect.ValueOfString(value)
-	case "cosmos.gov.v1.TallyParams.veto_threshold":
-		value := x.VetoThreshold
+	case "cosmos.gov.v1.Vote.options":
+		if len(x.Options) == 0 {
+			return protoreflect.ValueOfList(&_Vote_4_list{})
+		}
+		listValue := &_Vote_4_list{list: &x.Options}
+		return protoreflect.ValueOfList(listValue)
+	case "cosmos.gov.v1.Vote.metadata":
+		value := x.Metadata
 		return protoreflect.ValueOfString(value)
+	case "cosmos.gov.v1.Vote.metadata_schema_id":
+		value := x.MetadataSchemaId
+		return protoreflect.ValueOfUint64(value)
 	default:
 		if descriptor.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.gov.v1.TallyParams"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.gov.v1.Vote"))
 		}
-		panic(fmt.Errorf("message cosmos.gov.v1.TallyParams does not contain field %s", descriptor.FullName()))
+		panic(fmt.Errorf("message cosmos.gov.v1.Vote does not contain field %s", descriptor.FullName()))
 	}
 }
 
@@ -5000,19 +5941,25 @@ func (x *fastReflection_TallyParams) Get(descriptor protoreflect.FieldDescriptor
 // empty, read-only value, then it panics.
 //
 // Set is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_TallyParams) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+func (x *fastReflection_Vote) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
 	switch fd.FullName() {
-	case "cosmos.gov.v1.TallyParams.quorum":
-		x.Quorum = value.Interface().(string)
-	case "cosmos.gov.v1.TallyParams.threshold":
-		x.Threshold = value.Interface().(string)
-	case "cosmos.gov.v1.TallyParams.veto_threshold":
-		x.VetoThreshold = value.Interface().(string)
+	case "cosmos.gov.v1.Vote.proposal_id":
+		x.ProposalId = value.Uint()
+	case "cosmos.gov.v1.Vote.voter":
+		x.Voter = value.Interface().(string)
+	case "cosmos.gov.v1.Vote.options":
+		lv := value.List()
+		clv := lv.(*_Vote_4_list)
+		x.Options = *clv.list
+	case "cosmos.gov.v1.Vote.metadata":
+		x.Metadata = value.Interface().(string)
+	case "cosmos.gov.v1.Vote.metadata_schema_id":
+		x.MetadataSchemaId = value.Uint()
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.gov.v1.TallyParams"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.gov.v1.Vote"))
 		}
-		panic(fmt.Errorf("message cosmos.gov.v1.TallyParams does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.gov.v1.Vote does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -5026,48 +5973,61 @@ func (x *fastReflection_TallyParams) Set(fd protoreflect.FieldDescriptor, value
 // It panics if the field does not contain a composite type.
 //
 // Mutable is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_TallyParams) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_Vote) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "cosmos.gov.v1.TallyParams.quorum":
-		panic(fmt.Errorf("field quorum of message cosmos.gov.v1.TallyParams is not mutable"))
-	case "cosmos.gov.v1.TallyParams.threshold":
-		panic(fmt.Errorf("field threshold of message cosmos.gov.v1.TallyParams is not mutable"))
-	case "cosmos.gov.v1.TallyParams.veto_threshold":
-		panic(fmt.Errorf("field veto_threshold of message cosmos.gov.v1.TallyParams is not mutable"))
+	case "cosmos.gov.v1.Vote.options":
+		if x.Options == nil {
+			x.Options = []*WeightedVoteOption{}
+		}
+		value := &_Vote_4_list{list: &x.Options}
+		return protoreflect.ValueOfList(value)
+	case "cosmos.gov.v1.Vote.proposal_id":
+		panic(fmt.Errorf("field proposal_id of message cosmos.gov.v1.Vote is not mutable"))
+	case "cosmos.gov.v1.Vote.voter":
+		panic(fmt.Errorf("field voter of message cosmos.gov.v1.Vote is not mutable"))
+	case "cosmos.gov.v1.Vote.metadata":
+		panic(fmt.Errorf("field metadata of message cosmos.gov.v1.Vote is not mutable"))
+	case "cosmos.gov.v1.Vote.metadata_schema_id":
+		panic(fmt.Errorf("field metadata_schema_id of message cosmos.gov.v1.Vote is not mutable"))
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.gov.v1.TallyParams"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.gov.v1.Vote"))
 		}
-		panic(fmt.Errorf("message cosmos.gov.v1.TallyParams does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.gov.v1.Vote does not contain field %s", fd.FullName()))
 	}
 }
 
 // NewField returns a new value that is assignable to the field
 // for the given descriptor. For scalars, this returns the default value.
 // For lists, maps, and messages, this returns a new, empty, mutable value.
-func (x *fastReflection_TallyParams) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_Vote) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "cosmos.gov.v1.TallyParams.quorum":
-		return protoreflect.ValueOfString("")
-	case "cosmos.gov.v1.TallyParams.threshold":
+	case "cosmos.gov.v1.Vote.proposal_id":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "cosmos.gov.v1.Vote.voter":
 		return protoreflect.ValueOfString("")
-	case "cosmos.gov.v1.TallyParams.veto_threshold":
+	case "cosmos.gov.v1.Vote.options":
+		list := []*WeightedVoteOption{}
+		return protoreflect.ValueOfList(&_Vote_4_list{list: &list})
+	case "cosmos.gov.v1.Vote.metadata":
 		return protoreflect.ValueOfString("")
+	case "cosmos.gov.v1.Vote.metadata_schema_id":
+		return protoreflect.ValueOfUint64(uint64(0))
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.gov.v1.TallyParams"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.gov.v1.Vote"))
 		}
-		panic(fmt.Errorf("message cosmos.gov.v1.TallyParams does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.gov.v1.Vote does not contain field %s", fd.FullName()))
 	}
 }
 
 // WhichOneof reports which field within the oneof is populated,
 // returning nil if none are populated.
 // It panics if the oneof descriptor does not belong to this message.
-func (x *fastReflection_TallyParams) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+func (x *fastReflection_Vote) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
 	switch d.FullName() {
 	default:
-		panic(fmt.Errorf("%s is not a oneof field in cosmos.gov.v1.TallyParams", d.FullName()))
+		panic(fmt.Errorf("%s is not a oneof field in cosmos.gov.v1.Vote", d.FullName()))
 	}
 	panic("unreachable")
 }
@@ -5075,7 +6035,7 @@ func (x *fastReflection_TallyParams) WhichOneof(d protoreflect.OneofDescriptor)
 // GetUnknown retrieves the entire list of unknown fields.
 // The caller may only mutate the contents of the RawFields
 // if the mutated bytes are stored back into the message with SetUnknown.
-func (x *fastReflection_TallyParams) GetUnknown() protoreflect.RawFields {
+func (x *fastReflection_Vote) GetUnknown() protoreflect.RawFields {
 	return x.unknownFields
 }
 
@@ -5086,7 +6046,7 @@ func (x *fastReflection_TallyParams) GetUnknown() protoreflect.RawFields {
 // An empty RawFields may be passed to clear the fields.
 //
 // SetUnknown is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_TallyParams) SetUnknown(fields protoreflect.RawFields) {
+func (x *fastReflection_Vote) SetUnknown(fields protoreflect.RawFields) {
 	x.unknownFields = fields
 }
 
@@ -5098,7 +6058,7 @@ func (x *fastReflection_TallyParams) SetUnknown(fields protoreflect.RawFields) {
 // message type, but the details are implementation dependent.
 // Validity is not part of the protobuf data model, and may not
 // be preserved in marshaling or other operations.
-func (x *fastReflection_TallyParams) IsValid() bool {
+func (x *fastReflection_Vote) IsValid() bool {
 	return x != nil
 }
 
@@ -5108,9 +6068,9 @@ func (x *fastReflection_TallyParams) IsValid() bool {
 // The returned methods type is identical to
 // "google.golang.org/protobuf/runtime/protoiface".Methods.
 // Consult the protoiface package documentation for details.
-func (x *fastReflection_TallyParams) ProtoMethods() *protoiface.Methods {
+func (x *fastReflection_Vote) ProtoMethods() *protoiface.Methods {
 	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
-		x := input.Message.Interface().(*TallyParams)
+		x := input.Message.Interface().(*Vote)
 		if x == nil {
 			return protoiface.SizeOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -5122,18 +6082,26 @@ func (x *fastReflection_TallyParams) ProtoMethods() *protoiface.Methods {
 		var n int
 		var l int
 		_ = l
-		l = len(x.Quorum)
-		if l > 0 {
-			n += 1 + l + runtime.Sov(uint64(l))
+		if x.ProposalId != 0 {
+			n += 1 + runtime.Sov(uint64(x.ProposalId))
 		}
-		l = len(x.Threshold)
+		l = len(x.Voter)
 		if l > 0 {
 			n += 1 + l + runtime.Sov(uint64(l))
 		}
-		l = len(x.VetoThreshold)
+		if len(x.Options) > 0 {
+			for _, e := range x.Options {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		l = len(x.Metadata)
 		if l > 0 {
 			n += 1 + l + runtime.Sov(uint64(l))
 		}
+		if x.MetadataSchemaId != 0 {
+			n += 1 + runtime.Sov(uint64(x.MetadataSchemaId))
+		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
 		}
@@ -5144,7 +6112,7 @@ func (x *fastReflection_TallyParams) ProtoMethods() *protoiface.Methods {
 	}
 
 	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
-		x := input.Message.Interface().(*TallyParams)
+		x := input.Message.Interface().(*Vote)
 		if x == nil {
 			return protoiface.MarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -5163,26 +6131,45 @@ func (x *fastReflection_TallyParams) ProtoMethods() *protoiface.Methods {
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
-		if len(x.VetoThreshold) > 0 {
-			i -= len(x.VetoThreshold)
-			copy(dAtA[i:], x.VetoThreshold)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.VetoThreshold)))
+		if x.MetadataSchemaId != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.MetadataSchemaId))
 			i--
-			dAtA[i] = 0x1a
+			dAtA[i] = 0x30
 		}
-		if len(x.Threshold) > 0 {
-			i -= len(x.Threshold)
-			copy(dAtA[i:], x.Threshold)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Threshold)))
+		if len(x.Metadata) > 0 {
+			i -= len(x.Metadata)
+			copy(dAtA[i:], x.Metadata)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Metadata)))
+			i--
+			dAtA[i] = 0x2a
+		}
+		if len(x.Options) > 0 {
+			for iNdEx := len(x.Options) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.Options[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x22
+			}
+		}
+		if len(x.Voter) > 0 {
+			i -= len(x.Voter)
+			copy(dAtA[i:], x.Voter)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Voter)))
 			i--
 			dAtA[i] = 0x12
 		}
-		if len(x.Quorum) > 0 {
-			i -= len(x.Quorum)
-			copy(dAtA[i:], x.Quorum)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Quorum)))
+		if x.ProposalId != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.ProposalId))
 			i--
-			dAtA[i] = 0xa
+			dAtA[i] = 0x8
 		}
 		if input.Buf != nil {
 			input.Buf = append(input.Buf, dAtA...)
@@ -5195,7 +6182,7 @@ func (x *fastReflection_TallyParams) ProtoMethods() *protoiface.Methods {
 		}, nil
 	}
 	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
-		x := input.Message.Interface().(*TallyParams)
+		x := input.Message.Interface().(*Vote)
 		if x == nil {
 			return protoiface.UnmarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -5227,15 +6214,34 @@ func (x *fastReflection_TallyParams) ProtoMethods() *protoiface.Methods {
 			fieldNum := int32(wire >> 3)
 			wireType := int(wire & 0x7)
 			if wireType == 4 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: TallyParams: wiretype end group for non-group")
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: Vote: wiretype end group for non-group")
 			}
 			if fieldNum <= 0 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: TallyParams: illegal tag %d (wire type %d)", fieldNum, wire)
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: Vote: illegal tag %d (wire type %d)", fieldNum, wire)
 			}
 			switch fieldNum {
 			case 1:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ProposalId", wireType)
+				}
+				x.ProposalId = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.ProposalId |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 2:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Quorum", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Voter", wireType)
 				}
 				var stringLen uint64
 				for shift := uint(0); ; shift += 7 {
@@ -5263,13 +6269,13 @@ func (x *fastReflection_TallyParams) ProtoMethods() *protoiface.Methods {
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				x.Quorum = string(dAtA[iNdEx:postIndex])
+				x.Voter = string(dAtA[iNdEx:postIndex])
 				iNdEx = postIndex
-			case 2:
+			case 4:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Threshold", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Options", wireType)
 				}
-				var stringLen uint64
+				var msglen int
 				for shift := uint(0); ; shift += 7 {
 					if shift >= 64 {
 						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
@@ -5279,27 +6285,29 @@ func (x *fastReflection_TallyParams) ProtoMethods() *protoiface.Methods {
 					}
 					b := dAtA[iNdEx]
 					iNdEx++
-					stringLen |= uint64(b&0x7F) << shift
+					msglen |= int(b&0x7F) << shift
 					if b < 0x80 {
 						break
 					}
 				}
-				intStringLen := int(stringLen)
-				if intStringLen < 0 {
+				if msglen < 0 {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 				}
-				postIndex := iNdEx + intStringLen
+				postIndex := iNdEx + msglen
 				if postIndex < 0 {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 				}
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				x.Threshold = string(dAtA[iNdEx:postIndex])
+				x.Options = append(x.Options, &WeightedVoteOption{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Options[len(x.Options)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
 				iNdEx = postIndex
-			case 3:
+			case 5:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field VetoThreshold", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Metadata", wireType)
 				}
 				var stringLen uint64
 				for shift := uint(0); ; shift += 7 {
@@ -5327,8 +6335,27 @@ func (x *fastReflection_TallyParams) ProtoMethods() *protoiface.Methods {
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				x.VetoThreshold = string(dAtA[iNdEx:postIndex])
+				x.Metadata = string(dAtA[iNdEx:postIndex])
 				iNdEx = postIndex
+			case 6:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field MetadataSchemaId", wireType)
+				}
+				x.MetadataSchemaId = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.MetadataSchemaId |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
 			default:
 				iNdEx = preIndex
 				skippy, err := runtime.Skip(dAtA[iNdEx:])
@@ -5364,302 +6391,104 @@ func (x *fastReflection_TallyParams) ProtoMethods() *protoiface.Methods {
 	}
 }
 
-var _ protoreflect.List = (*_Params_1_list)(nil)
-
-type _Params_1_list struct {
-	list *[]*v1beta1.Coin
-}
-
-func (x *_Params_1_list) Len() int {
-	if x.list == nil {
-		return 0
-	}
-	return len(*x.list)
-}
+var (
+	md_VoteCommitment             protoreflect.MessageDescriptor
+	fd_VoteCommitment_proposal_id protoreflect.FieldDescriptor
+	fd_VoteCommitment_voter       protoreflect.FieldDescriptor
+	fd_VoteCommitment_commitment  protoreflect.FieldDescriptor
+)
 
-func (x *_Params_1_list) Get(i int) protoreflect.Value {
-	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+func init() {
+	file_cosmos_gov_v1_gov_proto_init()
+	md_VoteCommitment = File_cosmos_gov_v1_gov_proto.Messages().ByName("VoteCommitment")
+	fd_VoteCommitment_proposal_id = md_VoteCommitment.Fields().ByName("proposal_id")
+	fd_VoteCommitment_voter = md_VoteCommitment.Fields().ByName("voter")
+	fd_VoteCommitment_commitment = md_VoteCommitment.Fields().ByName("commitment")
 }
 
-func (x *_Params_1_list) Set(i int, value protoreflect.Value) {
-	valueUnwrapped := value.Message()
-	concreteValue := valueUnwrapped.Interface().(*v1beta1.Coin)
-	(*x.list)[i] = concreteValue
-}
+var _ protoreflect.Message = (*fastReflection_VoteCommitment)(nil)
 
-func (x *_Params_1_list) Append(value protoreflect.Value) {
-	valueUnwrapped := value.Message()
-	concreteValue := valueUnwrapped.Interface().(*v1beta1.Coin)
-	*x.list = append(*x.list, concreteValue)
-}
+type fastReflection_VoteCommitment VoteCommitment
 
-func (x *_Params_1_list) AppendMutable() protoreflect.Value {
-	v := new(v1beta1.Coin)
-	*x.list = append(*x.list, v)
-	return protoreflect.ValueOfMessage(v.ProtoReflect())
+func (x *VoteCommitment) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_VoteCommitment)(x)
 }
 
-func (x *_Params_1_list) Truncate(n int) {
-	for i := n; i < len(*x.list); i++ {
-		(*x.list)[i] = nil
+func (x *VoteCommitment) slowProtoReflect() protoreflect.Message {
+	mi := &file_cosmos_gov_v1_gov_proto_msgTypes[8]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	*x.list = (*x.list)[:n]
-}
-
-func (x *_Params_1_list) NewElement() protoreflect.Value {
-	v := new(v1beta1.Coin)
-	return protoreflect.ValueOfMessage(v.ProtoReflect())
-}
-
-func (x *_Params_1_list) IsValid() bool {
-	return x.list != nil
+	return mi.MessageOf(x)
 }
 
-var _ protoreflect.List = (*_Params_12_list)(nil)
+var _fastReflection_VoteCommitment_messageType fastReflection_VoteCommitment_messageType
+var _ protoreflect.MessageType = fastReflection_VoteCommitment_messageType{}
 
-type _Params_12_list struct {
-	list *[]*v1beta1.Coin
-}
+type fastReflection_VoteCommitment_messageType struct{}
 
-func (x *_Params_12_list) Len() int {
-	if x.list == nil {
-		return 0
-	}
-	return len(*x.list)
+func (x fastReflection_VoteCommitment_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_VoteCommitment)(nil)
 }
-
-func (x *_Params_12_list) Get(i int) protoreflect.Value {
-	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+func (x fastReflection_VoteCommitment_messageType) New() protoreflect.Message {
+	return new(fastReflection_VoteCommitment)
 }
-
-func (x *_Params_12_list) Set(i int, value protoreflect.Value) {
-	valueUnwrapped := value.Message()
-	concreteValue := valueUnwrapped.Interface().(*v1beta1.Coin)
-	(*x.list)[i] = concreteValue
+func (x fastReflection_VoteCommitment_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_VoteCommitment
 }
 
-func (x *_Params_12_list) Append(value protoreflect.Value) {
-	valueUnwrapped := value.Message()
-	concreteValue := valueUnwrapped.Interface().(*v1beta1.Coin)
-	*x.list = append(*x.list, concreteValue)
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_VoteCommitment) Descriptor() protoreflect.MessageDescriptor {
+	return md_VoteCommitment
 }
 
-func (x *_Params_12_list) AppendMutable() protoreflect.Value {
-	v := new(v1beta1.Coin)
-	*x.list = append(*x.list, v)
-	return protoreflect.ValueOfMessage(v.ProtoReflect())
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_VoteCommitment) Type() protoreflect.MessageType {
+	return _fastReflection_VoteCommitment_messageType
 }
 
-func (x *_Params_12_list) Truncate(n int) {
-	for i := n; i < len(*x.list); i++ {
-		(*x.list)[i] = nil
-	}
-	*x.list = (*x.list)[:n]
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_VoteCommitment) New() protoreflect.Message {
+	return new(fastReflection_VoteCommitment)
 }
 
-func (x *_Params_12_list) NewElement() protoreflect.Value {
-	v := new(v1beta1.Coin)
-	return protoreflect.ValueOfMessage(v.ProtoReflect())
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_VoteCommitment) Interface() protoreflect.ProtoMessage {
+	return (*VoteCommitment)(x)
 }
 
-func (x *_Params_12_list) IsValid() bool {
-	return x.list != nil
-}
-
-var (
-	md_Params                               protoreflect.MessageDescriptor
-	fd_Params_min_deposit                   protoreflect.FieldDescriptor
-	fd_Params_max_deposit_period            protoreflect.FieldDescriptor
-	fd_Params_voting_period                 protoreflect.FieldDescriptor
-	fd_Params_quorum                        protoreflect.FieldDescriptor
-	fd_Params_threshold                     protoreflect.FieldDescriptor
-	fd_Params_veto_threshold                protoreflect.FieldDescriptor
-	fd_Params_min_initial_deposit_ratio     protoreflect.FieldDescriptor
-	fd_Params_proposal_cancel_ratio         protoreflect.FieldDescriptor
-	fd_Params_proposal_cancel_dest          protoreflect.FieldDescriptor
-	fd_Params_expedited_voting_period       protoreflect.FieldDescriptor
-	fd_Params_expedited_threshold           protoreflect.FieldDescriptor
-	fd_Params_expedited_min_deposit         protoreflect.FieldDescriptor
-	fd_Params_burn_vote_quorum              protoreflect.FieldDescriptor
-	fd_Params_burn_proposal_deposit_prevote protoreflect.FieldDescriptor
-	fd_Params_burn_vote_veto                protoreflect.FieldDescriptor
-)
-
-func init() {
-	file_cosmos_gov_v1_gov_proto_init()
-	md_Params = File_cosmos_gov_v1_gov_proto.Messages().ByName("Params")
-	fd_Params_min_deposit = md_Params.Fields().ByName("min_deposit")
-	fd_Params_max_deposit_period = md_Params.Fields().ByName("max_deposit_period")
-	fd_Params_voting_period = md_Params.Fields().ByName("voting_period")
-	fd_Params_quorum = md_Params.Fields().ByName("quorum")
-	fd_Params_threshold = md_Params.Fields().ByName("threshold")
-	fd_Params_veto_threshold = md_Params.Fields().ByName("veto_threshold")
-	fd_Params_min_initial_deposit_ratio = md_Params.Fields().ByName("min_initial_deposit_ratio")
-	fd_Params_proposal_cancel_ratio = md_Params.Fields().ByName("proposal_cancel_ratio")
-	fd_Params_proposal_cancel_dest = md_Params.Fields().ByName("proposal_cancel_dest")
-	fd_Params_expedited_voting_period = md_Params.Fields().ByName("expedited_voting_period")
-	fd_Params_expedited_threshold = md_Params.Fields().ByName("expedited_threshold")
-	fd_Params_expedited_min_deposit = md_Params.Fields().ByName("expedited_min_deposit")
-	fd_Params_burn_vote_quorum = md_Params.Fields().ByName("burn_vote_quorum")
-	fd_Params_burn_proposal_deposit_prevote = md_Params.Fields().ByName("burn_proposal_deposit_prevote")
-	fd_Params_burn_vote_veto = md_Params.Fields().ByName("burn_vote_veto")
-}
-
-var _ protoreflect.Message = (*fastReflection_Params)(nil)
-
-type fastReflection_Params Params
-
-func (x *Params) ProtoReflect() protoreflect.Message {
-	return (*fastReflection_Params)(x)
-}
-
-func (x *Params) slowProtoReflect() protoreflect.Message {
-	mi := &file_cosmos_gov_v1_gov_proto_msgTypes[8]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
-	}
-	return mi.MessageOf(x)
-}
-
-var _fastReflection_Params_messageType fastReflection_Params_messageType
-var _ protoreflect.MessageType = fastReflection_Params_messageType{}
-
-type fastReflection_Params_messageType struct{}
-
-func (x fastReflection_Params_messageType) Zero() protoreflect.Message {
-	return (*fastReflection_Params)(nil)
-}
-func (x fastReflection_Params_messageType) New() protoreflect.Message {
-	return new(fastReflection_Params)
-}
-func (x fastReflection_Params_messageType) Descriptor() protoreflect.MessageDescriptor {
-	return md_Params
-}
-
-// Descriptor returns message descriptor, which contains only the protobuf
-// type information for the message.
-func (x *fastReflection_Params) Descriptor() protoreflect.MessageDescriptor {
-	return md_Params
-}
-
-// Type returns the message type, which encapsulates both Go and protobuf
-// type information. If the Go type information is not needed,
-// it is recommended that the message descriptor be used instead.
-func (x *fastReflection_Params) Type() protoreflect.MessageType {
-	return _fastReflection_Params_messageType
-}
-
-// New returns a newly allocated and mutable empty message.
-func (x *fastReflection_Params) New() protoreflect.Message {
-	return new(fastReflection_Params)
-}
-
-// Interface unwraps the message reflection interface and
-// returns the underlying ProtoMessage interface.
-func (x *fastReflection_Params) Interface() protoreflect.ProtoMessage {
-	return (*Params)(x)
-}
-
-// Range iterates over every populated field in an undefined order,
-// calling f for each field descriptor and value encountered.
-// Range returns immediately if f returns false.
-// While iterating, mutating operations may only be performed
-// on the current field descriptor.
-func (x *fastReflection_Params) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
-	if len(x.MinDeposit) != 0 {
-		value := protoreflect.ValueOfList(&_Params_1_list{list: &x.MinDeposit})
-		if !f(fd_Params_min_deposit, value) {
-			return
-		}
-	}
-	if x.MaxDepositPeriod != nil {
-		value := protoreflect.ValueOfMessage(x.MaxDepositPeriod.ProtoReflect())
-		if !f(fd_Params_max_deposit_period, value) {
-			return
-		}
-	}
-	if x.VotingPeriod != nil {
-		value := protoreflect.ValueOfMessage(x.VotingPeriod.ProtoReflect())
-		if !f(fd_Params_voting_period, value) {
-			return
-		}
-	}
-	if x.Quorum != "" {
-		value := protoreflect.ValueOfString(x.Quorum)
-		if !f(fd_Params_quorum, value) {
-			return
-		}
-	}
-	if x.Threshold != "" {
-		value := protoreflect.ValueOfString(x.Threshold)
-		if !f(fd_Params_threshold, value) {
-			return
-		}
-	}
-	if x.VetoThreshold != "" {
-		value := protoreflect.ValueOfString(x.VetoThreshold)
-		if !f(fd_Params_veto_threshold, value) {
-			return
-		}
-	}
-	if x.MinInitialDepositRatio != "" {
-		value := protoreflect.ValueOfString(x.MinInitialDepositRatio)
-		if !f(fd_Params_min_initial_deposit_ratio, value) {
-			return
-		}
-	}
-	if x.ProposalCancelRatio != "" {
-		value := protoreflect.ValueOfString(x.ProposalCancelRatio)
-		if !f(fd_Params_proposal_cancel_ratio, value) {
-			return
-		}
-	}
-	if x.ProposalCancelDest != "" {
-		value := protoreflect.ValueOfString(x.ProposalCancelDest)
-		if !f(fd_Params_proposal_cancel_dest, value) {
-			return
-		}
-	}
-	if x.ExpeditedVotingPeriod != nil {
-		value := protoreflect.ValueOfMessage(x.ExpeditedVotingPeriod.ProtoReflect())
-		if !f(fd_Params_expedited_voting_period, value) {
-			return
-		}
-	}
-	if x.ExpeditedThreshold != "" {
-		value := protoreflect.ValueOfString(x.ExpeditedThreshold)
-		if !f(fd_Params_expedited_threshold, value) {
-			return
-		}
-	}
-	if len(x.ExpeditedMinDeposit) != 0 {
-		value := protoreflect.ValueOfList(&_Params_12_list{list: &x.ExpeditedMinDeposit})
-		if !f(fd_Params_expedited_min_deposit, value) {
-			return
-		}
-	}
-	if x.BurnVoteQuorum != false {
-		value := protoreflect.ValueOfBool(x.BurnVoteQuorum)
-		if !f(fd_Params_burn_vote_quorum, value) {
-			return
-		}
-	}
-	if x.BurnProposalDepositPrevote != false {
-		value := protoreflect.ValueOfBool(x.BurnProposalDepositPrevote)
-		if !f(fd_Params_burn_proposal_deposit_prevote, value) {
-			return
-		}
-	}
-	if x.BurnVoteVeto != false {
-		value := protoreflect.ValueOfBool(x.BurnVoteVeto)
-		if !f(fd_Params_burn_vote_veto, value) {
-			return
-		}
-	}
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_VoteCommitment) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.ProposalId != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.ProposalId)
+		if !f(fd_VoteCommitment_proposal_id, value) {
+			return
+		}
+	}
+	if x.Voter != "" {
+		value := protoreflect.ValueOfString(x.Voter)
+		if !f(fd_VoteCommitment_voter, value) {
+			return
+		}
+	}
+	if len(x.Commitment) != 0 {
+		value := protoreflect.ValueOfBytes(x.Commitment)
+		if !f(fd_VoteCommitment_commitment, value) {
+			return
+		}
+	}
 }
 
 // Has reports whether a field is populated.
@@ -5673,43 +6502,19 @@ func (x *fastReflection_Params) Range(f func(protoreflect.FieldDescriptor, proto
 // In other cases (aside from the nullable cases above),
 // a proto3 scalar field is populated if it contains a non-zero value, and
 // a repeated field is populated if it is non-empty.
-func (x *fastReflection_Params) Has(fd protoreflect.FieldDescriptor) bool {
+func (x *fastReflection_VoteCommitment) Has(fd protoreflect.FieldDescriptor) bool {
 	switch fd.FullName() {
-	case "cosmos.gov.v1.Params.min_deposit":
-		return len(x.MinDeposit) != 0
-	case "cosmos.gov.v1.Params.max_deposit_period":
-		return x.MaxDepositPeriod != nil
-	case "cosmos.gov.v1.Params.voting_period":
-		return x.VotingPeriod != nil
-	case "cosmos.gov.v1.Params.quorum":
-		return x.Quorum != ""
-	case "cosmos.gov.v1.Params.threshold":
-		return x.Threshold != ""
-	case "cosmos.gov.v1.Params.veto_threshold":
-		return x.VetoThreshold != ""
-	case "cosmos.gov.v1.Params.min_initial_deposit_ratio":
-		return x.MinInitialDepositRatio != ""
-	case "cosmos.gov.v1.Params.proposal_cancel_ratio":
-		return x.ProposalCancelRatio != ""
-	case "cosmos.gov.v1.Params.proposal_cancel_dest":
-		return x.ProposalCancelDest != ""
-	case "cosmos.gov.v1.Params.expedited_voting_period":
-		return x.ExpeditedVotingPeriod != nil
-	case "cosmos.gov.v1.Params.expedited_threshold":
-		return x.ExpeditedThreshold != ""
-	case "cosmos.gov.v1.Params.expedited_min_deposit":
-		return len(x.ExpeditedMinDeposit) != 0
-	case "cosmos.gov.v1.Params.burn_vote_quorum":
-		return x.BurnVoteQuorum != false
-	case "cosmos.gov.v1.Params.burn_proposal_deposit_prevote":
-		return x.BurnProposalDepositPrevote != false
-	case "cosmos.gov.v1.Params.burn_vote_veto":
-		return x.BurnVoteVeto != false
+	case "cosmos.gov.v1.VoteCommitment.proposal_id":
+		return x.ProposalId != uint64(0)
+	case "cosmos.gov.v1.VoteCommitment.voter":
+		return x.Voter != ""
+	case "cosmos.gov.v1.VoteCommitment.commitment":
+		return len(x.Commitment) != 0
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.gov.v1.Params"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.gov.v1.VoteCommitment"))
 		}
-		panic(fmt.Errorf("message cosmos.gov.v1.Params does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.gov.v1.VoteCommitment does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -5719,43 +6524,19 @@ func (x *fastReflection_Params) Has(fd protoreflect.FieldDescriptor) bool {
 // associated with the given field number.
 //
 // Clear is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_Params) Clear(fd protoreflect.FieldDescriptor) {
+func (x *fastReflection_VoteCommitment) Clear(fd protoreflect.FieldDescriptor) {
 	switch fd.FullName() {
-	case "cosmos.gov.v1.Params.min_deposit":
-		x.MinDeposit = nil
-	case "cosmos.gov.v1.Params.max_deposit_period":
-		x.MaxDepositPeriod = nil
-	case "cosmos.gov.v1.Params.voting_period":
-		x.VotingPeriod = nil
-	case "cosmos.gov.v1.Params.quorum":
-		x.Quorum = ""
-	case "cosmos.gov.v1.Params.threshold":
-		x.Threshold = ""
-	case "cosmos.gov.v1.Params.veto_threshold":
-		x.VetoThreshold = ""
-	case "cosmos.gov.v1.Params.min_initial_deposit_ratio":
-		x.MinInitialDepositRatio = ""
-	case "cosmos.gov.v1.Params.proposal_cancel_ratio":
-		x.ProposalCancelRatio = ""
-	case "cosmos.gov.v1.Params.proposal_cancel_dest":
-		x.ProposalCancelDest = ""
-	case "cosmos.gov.v1.Params.expedited_voting_period":
-		x.ExpeditedVotingPeriod = nil
-	case "cosmos.gov.v1.Params.expedited_threshold":
-		x.ExpeditedThreshold = ""
-	case "cosmos.gov.v1.Params.expedited_min_deposit":
-		x.ExpeditedMinDeposit = nil
-	case "cosmos.gov.v1.Params.burn_vote_quorum":
-		x.BurnVoteQuorum = false
-	case "cosmos.gov.v1.Params.burn_proposal_deposit_prevote":
-		x.BurnProposalDepositPrevote = false
-	case "cosmos.gov.v1.Params.burn_vote_veto":
-		x.BurnVoteVeto = false
+	case "cosmos.gov.v1.VoteCommitment.proposal_id":
+		x.ProposalId = uint64(0)
+	case "cosmos.gov.v1.VoteCommitment.voter":
+		x.Voter = ""
+	case "cosmos.gov.v1.VoteCommitment.commitment":
+		x.Commitment = nil
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.gov.v1.Params"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.gov.v1.VoteCommitment"))
 		}
-		panic(fmt.Errorf("message cosmos.gov.v1.Params does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.gov.v1.VoteCommitment does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -5765,64 +6546,22 @@ func (x *fastReflection_Params) Clear(fd protoreflect.FieldDescriptor) {
 // the default value of a bytes scalar is guaranteed to be a copy.
 // For unpopulated composite types, it returns an empty, read-only view
 // of the value; to obtain a mutable reference, use Mutable.
-func (x *fastReflection_Params) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_VoteCommitment) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
 	switch descriptor.FullName() {
-	case "cosmos.gov.v1.Params.min_deposit":
-		if len(x.MinDeposit) == 0 {
-			return protoreflect.ValueOfList(&_Params_1_list{})
+	case "cosmos.gov.v1.VoteCommitment.proposal_id":
+		value := x.ProposalId
+		return protoreflect.ValueOfUint64(value)
+	case "cosmos.gov.v1.VoteCommitment.voter":
+		value := x.Voter
+		return protoreflect.ValueOfString(value)
+	case "cosmos.gov.v1.VoteCommitment.commitment":
+		value := x.Commitment
+		return protoreflect.ValueOfBytes(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.gov.v1.VoteCommitment"))
 		}
-		listValue := &_Params_1_list{list: &x.MinDeposit}
-		return protoreflect.ValueOfList(listValue)
-	case "cosmos.gov.v1.Params.max_deposit_period":
-		value := x.MaxDepositPeriod
-		return protoreflect.ValueOfMessage(value.ProtoReflect())
-	case "cosmos.gov.v1.Params.voting_period":
-		value := x.VotingPeriod
-		return protoreflect.ValueOfMessage(value.ProtoReflect())
-	case "cosmos.gov.v1.Params.quorum":
-		value := x.Quorum
-		return protoreflect.ValueOfString(value)
-	case "cosmos.gov.v1.Params.threshold":
-		value := x.Threshold
-		return protoreflect.ValueOfString(value)
-	case "cosmos.gov.v1.Params.veto_threshold":
-		value := x.VetoThreshold
-		return protoreflect.ValueOfString(value)
-	case "cosmos.gov.v1.Params.min_initial_deposit_ratio":
-		value := x.MinInitialDepositRatio
-		return protoreflect.ValueOfString(value)
-	case "cosmos.gov.v1.Params.proposal_cancel_ratio":
-		value := x.ProposalCancelRatio
-		return protoreflect.ValueOfString(value)
-	case "cosmos.gov.v1.Params.proposal_cancel_dest":
-		value := x.ProposalCancelDest
-		return protoreflect.ValueOfString(value)
-	case "cosmos.gov.v1.Params.expedited_voting_period":
-		value := x.ExpeditedVotingPeriod
-		return protoreflect.ValueOfMessage(value.ProtoReflect())
-	case "cosmos.gov.v1.Params.expedited_threshold":
-		value := x.ExpeditedThreshold
-		return protoreflect.ValueOfString(value)
-	case "cosmos.gov.v1.Params.expedited_min_deposit":
-		if len(x.ExpeditedMinDeposit) == 0 {
-			return protoreflect.ValueOfList(&_Params_12_list{})
-		}
-		listValue := &_Params_12_list{list: &x.ExpeditedMinDeposit}
-		return protoreflect.ValueOfList(listValue)
-	case "cosmos.gov.v1.Params.burn_vote_quorum":
-		value := x.BurnVoteQuorum
-		return protoreflect.ValueOfBool(value)
-	case "cosmos.gov.v1.Params.burn_proposal_deposit_prevote":
-		value := x.BurnProposalDepositPrevote
-		return protoreflect.ValueOfBool(value)
-	case "cosmos.gov.v1.Params.burn_vote_veto":
-		value := x.BurnVoteVeto
-		return protoreflect.ValueOfBool(value)
-	default:
-		if descriptor.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.gov.v1.Params"))
-		}
-		panic(fmt.Errorf("message cosmos.gov.v1.Params does not contain field %s", descriptor.FullName()))
+		panic(fmt.Errorf("message cosmos.gov.v1.VoteCommitment does not contain field %s", descriptor.FullName()))
 	}
 }
 
@@ -5836,47 +6575,19 @@ func (x *fastReflection_Params) Get(descriptor protoreflect.FieldDescriptor) pro
 // empty, read-only value, then it panics.
 //
 // Set is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_Params) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+func (x *fastReflection_VoteCommitment) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
 	switch fd.FullName() {
-	case "cosmos.gov.v1.Params.min_deposit":
-		lv := value.List()
-		clv := lv.(*_Params_1_list)
-		x.MinDeposit = *clv.list
-	case "cosmos.gov.v1.Params.max_deposit_period":
-		x.MaxDepositPeriod = value.Message().Interface().(*durationpb.Duration)
-	case "cosmos.gov.v1.Params.voting_period":
-		x.VotingPeriod = value.Message().Interface().(*durationpb.Duration)
-	case "cosmos.gov.v1.Params.quorum":
-		x.Quorum = value.Interface().(string)
-	case "cosmos.gov.v1.Params.threshold":
-		x.Threshold = value.Interface().(string)
-	case "cosmos.gov.v1.Params.veto_threshold":
-		x.VetoThreshold = value.Interface().(string)
-	case "cosmos.gov.v1.Params.min_initial_deposit_ratio":
-		x.MinInitialDepositRatio = value.Interface().(string)
-	case "cosmos.gov.v1.Params.proposal_cancel_ratio":
-		x.ProposalCancelRatio = value.Interface().(string)
-	case "cosmos.gov.v1.Params.proposal_cancel_dest":
-		x.ProposalCancelDest = value.Interface().(string)
-	case "cosmos.gov.v1.Params.expedited_voting_period":
-		x.ExpeditedVotingPeriod = value.Message().Interface().(*durationpb.Duration)
-	case "cosmos.gov.v1.Params.expedited_threshold":
-		x.ExpeditedThreshold = value.Interface().(string)
-	case "cosmos.gov.v1.Params.expedited_min_deposit":
-		lv := value.List()
-		clv := lv.(*_Params_12_list)
-		x.ExpeditedMinDeposit = *clv.list
-	case "cosmos.gov.v1.Params.burn_vote_quorum":
-		x.BurnVoteQuorum = value.Bool()
-	case "cosmos.gov.v1.Params.burn_proposal_deposit_prevote":
-		x.BurnProposalDepositPrevote = value.Bool()
-	case "cosmos.gov.v1.Params.burn_vote_veto":
-		x.BurnVoteVeto = value.Bool()
+	case "cosmos.gov.v1.VoteCommitment.proposal_id":
+		x.ProposalId = value.Uint()
+	case "cosmos.gov.v1.VoteCommitment.voter":
+		x.Voter = value.Interface().(string)
+	case "cosmos.gov.v1.VoteCommitment.commitment":
+		x.Commitment = value.Bytes()
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.gov.v1.Params"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.gov.v1.VoteCommitment"))
 		}
-		panic(fmt.Errorf("message cosmos.gov.v1.Params does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.gov.v1.VoteCommitment does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -5890,118 +6601,48 @@ func (x *fastReflection_Params) Set(fd protoreflect.FieldDescriptor, value proto
 // It panics if the field does not contain a composite type.
 //
 // Mutable is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_Params) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_VoteCommitment) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "cosmos.gov.v1.Params.min_deposit":
-		if x.MinDeposit == nil {
-			x.MinDeposit = []*v1beta1.Coin{}
-		}
-		value := &_Params_1_list{list: &x.MinDeposit}
-		return protoreflect.ValueOfList(value)
-	case "cosmos.gov.v1.Params.max_deposit_period":
-		if x.MaxDepositPeriod == nil {
-			x.MaxDepositPeriod = new(durationpb.Duration)
-		}
-		return protoreflect.ValueOfMessage(x.MaxDepositPeriod.ProtoReflect())
-	case "cosmos.gov.v1.Params.voting_period":
-		if x.VotingPeriod == nil {
-			x.VotingPeriod = new(durationpb.Duration)
-		}
-		return protoreflect.ValueOfMessage(x.VotingPeriod.ProtoReflect())
-	case "cosmos.gov.v1.Params.expedited_voting_period":
-		if x.ExpeditedVotingPeriod == nil {
-			x.ExpeditedVotingPeriod = new(durationpb.Duration)
-		}
-		return protoreflect.ValueOfMessage(x.ExpeditedVotingPeriod.ProtoReflect())
-	case "cosmos.gov.v1.Params.expedited_min_deposit":
-		if x.ExpeditedMinDeposit == nil {
-			x.ExpeditedMinDeposit = []*v1beta1.Coin{}
-		}
-		value := &_Params_12_list{list: &x.ExpeditedMinDeposit}
-		return protoreflect.ValueOfList(value)
-	case "cosmos.gov.v1.Params.quorum":
-		panic(fmt.Errorf("field quorum of message cosmos.gov.v1.Params is not mutable"))
-	case "cosmos.gov.v1.Params.threshold":
-		panic(fmt.Errorf("field threshold of message cosmos.gov.v1.Params is not mutable"))
-	case "cosmos.gov.v1.Params.veto_threshold":
-		panic(fmt.Errorf("field veto_threshold of message cosmos.gov.v1.Params is not mutable"))
-	case "cosmos.gov.v1.Params.min_initial_deposit_ratio":
-		panic(fmt.Errorf("field min_initial_deposit_ratio of message cosmos.gov.v1.Params is not mutable"))
-	case "cosmos.gov.v1.Params.proposal_cancel_ratio":
-		panic(fmt.Errorf("field proposal_cancel_ratio of message cosmos.gov.v1.Params is not mutable"))
-	case "cosmos.gov.v1.Params.proposal_cancel_dest":
-		panic(fmt.Errorf("field proposal_cancel_dest of message cosmos.gov.v1.Params is not mutable"))
-	case "cosmos.gov.v1.Params.expedited_threshold":
-		panic(fmt.Errorf("field expedited_threshold of message cosmos.gov.v1.Params is not mutable"))
-	case "cosmos.gov.v1.Params.burn_vote_quorum":
-		panic(fmt.Errorf("field burn_vote_quorum of message cosmos.gov.v1.Params is not mutable"))
-	case "cosmos.gov.v1.Params.burn_proposal_deposit_prevote":
-		panic(fmt.Errorf("field burn_proposal_deposit_prevote of message cosmos.gov.v1.Params is not mutable"))
-	case "cosmos.gov.v1.Params.burn_vote_veto":
-		panic(fmt.Errorf("field burn_vote_veto of message cosmos.gov.v1.Params is not mutable"))
+	case "cosmos.gov.v1.VoteCommitment.proposal_id":
+		panic(fmt.Errorf("field proposal_id of message cosmos.gov.v1.VoteCommitment is not mutable"))
+	case "cosmos.gov.v1.VoteCommitment.voter":
+		panic(fmt.Errorf("field voter of message cosmos.gov.v1.VoteCommitment is not mutable"))
+	case "cosmos.gov.v1.VoteCommitment.commitment":
+		panic(fmt.Errorf("field commitment of message cosmos.gov.v1.VoteCommitment is not mutable"))
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.gov.v1.Params"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.gov.v1.VoteCommitment"))
 		}
-		panic(fmt.Errorf("message cosmos.gov.v1.Params does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.gov.v1.VoteCommitment does not contain field %s", fd.FullName()))
 	}
 }
 
 // NewField returns a new value that is assignable to the field
 // for the given descriptor. For scalars, this returns the default value.
 // For lists, maps, and messages, this returns a new, empty, mutable value.
-func (x *fastReflection_Params) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_VoteCommitment) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "cosmos.gov.v1.Params.min_deposit":
-		list := []*v1beta1.Coin{}
-		return protoreflect.ValueOfList(&_Params_1_list{list: &list})
-	case "cosmos.gov.v1.Params.max_deposit_period":
-		m := new(durationpb.Duration)
-		return protoreflect.ValueOfMessage(m.ProtoReflect())
-	case "cosmos.gov.v1.Params.voting_period":
-		m := new(durationpb.Duration)
-		return protoreflect.ValueOfMessage(m.ProtoReflect())
-	case "cosmos.gov.v1.Params.quorum":
-		return protoreflect.ValueOfString("")
-	case "cosmos.gov.v1.Params.threshold":
-		return protoreflect.ValueOfString("")
-	case "cosmos.gov.v1.Params.veto_threshold":
-		return protoreflect.ValueOfString("")
-	case "cosmos.gov.v1.Params.min_initial_deposit_ratio":
-		return protoreflect.ValueOfString("")
-	case "cosmos.gov.v1.Params.proposal_cancel_ratio":
-		return protoreflect.ValueOfString("")
-	case "cosmos.gov.v1.Params.proposal_cancel_dest":
-		return protoreflect.ValueOfString("")
-	case "cosmos.gov.v1.Params.expedited_voting_period":
-		m := new(durationpb.Duration)
-		return protoreflect.ValueOfMessage(m.ProtoReflect())
-	case "cosmos.gov.v1.Params.expedited_threshold":
+	case "cosmos.gov.v1.VoteCommitment.proposal_id":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "cosmos.gov.v1.VoteCommitment.voter":
 		return protoreflect.ValueOfString("")
-	case "cosmos.gov.v1.Params.expedited_min_deposit":
-		list := []*v1beta1.Coin{}
-		return protoreflect.ValueOfList(&_Params_12_list{list: &list})
-	case "cosmos.gov.v1.Params.burn_vote_quorum":
-		return protoreflect.ValueOfBool(false)
-	case "cosmos.gov.v1.Params.burn_proposal_deposit_prevote":
-		return protoreflect.ValueOfBool(false)
-	case "cosmos.gov.v1.Params.burn_vote_veto":
-		return protoreflect.ValueOfBool(false)
+	case "cosmos.gov.v1.VoteCommitment.commitment":
+		return protoreflect.ValueOfBytes(nil)
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.gov.v1.Params"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.gov.v1.VoteCommitment"))
 		}
-		panic(fmt.Errorf("message cosmos.gov.v1.Params does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.gov.v1.VoteCommitment does not contain field %s", fd.FullName()))
 	}
 }
 
 // WhichOneof reports which field within the oneof is populated,
 // returning nil if none are populated.
 // It panics if the oneof descriptor does not belong to this message.
-func (x *fastReflection_Params) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+func (x *fastReflection_VoteCommitment) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
 	switch d.FullName() {
 	default:
-		panic(fmt.Errorf("%s is not a oneof field in cosmos.gov.v1.Params", d.FullName()))
+		panic(fmt.Errorf("%s is not a oneof field in cosmos.gov.v1.VoteCommitment", d.FullName()))
 	}
 	panic("unreachable")
 }
@@ -6009,7 +6650,7 @@ func (x *fastReflection_Params) WhichOneof(d protoreflect.OneofDescriptor) proto
 // GetUnknown retrieves the entire list of unknown fields.
 // The caller may only mutate the contents of the RawFields
 // if the mutated bytes are stored back into the message with SetUnknown.
-func (x *fastReflection_Params) GetUnknown() protoreflect.RawFields {
+func (x *fastReflection_VoteCommitment) GetUnknown() protoreflect.RawFields {
 	return x.unknownFields
 }
 
@@ -6020,7 +6661,7 @@ func (x *fastReflection_Params) GetUnknown() protoreflect.RawFields {
 // An empty RawFields may be passed to clear the fields.
 //
 // SetUnknown is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_Params) SetUnknown(fields protoreflect.RawFields) {
+func (x *fastReflection_VoteCommitment) SetUnknown(fields protoreflect.RawFields) {
 	x.unknownFields = fields
 }
 
@@ -6032,7 +6673,7 @@ func (x *fastReflection_Params) SetUnknown(fields protoreflect.RawFields) {
 // message type, but the details are implementation dependent.
 // Validity is not part of the protobuf data model, and may not
 // be preserved in marshaling or other operations.
-func (x *fastReflection_Params) IsValid() bool {
+func (x *fastReflection_VoteCommitment) IsValid() bool {
 	return x != nil
 }
 
@@ -6042,9 +6683,9 @@ func (x *fastReflection_Params) IsValid() bool {
 // The returned methods type is identical to
 // "google.golang.org/protobuf/runtime/protoiface".Methods.
 // Consult the protoiface package documentation for details.
-func (x *fastReflection_Params) ProtoMethods() *protoiface.Methods {
+func (x *fastReflection_VoteCommitment) ProtoMethods() *protoiface.Methods {
 	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
-		x := input.Message.Interface().(*Params)
+		x := input.Message.Interface().(*VoteCommitment)
 		if x == nil {
 			return protoiface.SizeOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -6056,67 +6697,17 @@ func (x *fastReflection_Params) ProtoMethods() *protoiface.Methods {
 		var n int
 		var l int
 		_ = l
-		if len(x.MinDeposit) > 0 {
-			for _, e := range x.MinDeposit {
-				l = options.Size(e)
-				n += 1 + l + runtime.Sov(uint64(l))
-			}
-		}
-		if x.MaxDepositPeriod != nil {
-			l = options.Size(x.MaxDepositPeriod)
-			n += 1 + l + runtime.Sov(uint64(l))
-		}
-		if x.VotingPeriod != nil {
-			l = options.Size(x.VotingPeriod)
-			n += 1 + l + runtime.Sov(uint64(l))
-		}
-		l = len(x.Quorum)
-		if l > 0 {
-			n += 1 + l + runtime.Sov(uint64(l))
-		}
-		l = len(x.Threshold)
-		if l > 0 {
-			n += 1 + l + runtime.Sov(uint64(l))
-		}
-		l = len(x.VetoThreshold)
-		if l > 0 {
-			n += 1 + l + runtime.Sov(uint64(l))
-		}
-		l = len(x.MinInitialDepositRatio)
-		if l > 0 {
-			n += 1 + l + runtime.Sov(uint64(l))
-		}
-		l = len(x.ProposalCancelRatio)
-		if l > 0 {
-			n += 1 + l + runtime.Sov(uint64(l))
+		if x.ProposalId != 0 {
+			n += 1 + runtime.Sov(uint64(x.ProposalId))
 		}
-		l = len(x.ProposalCancelDest)
+		l = len(x.Voter)
 		if l > 0 {
 			n += 1 + l + runtime.Sov(uint64(l))
 		}
-		if x.ExpeditedVotingPeriod != nil {
-			l = options.Size(x.ExpeditedVotingPeriod)
-			n += 1 + l + runtime.Sov(uint64(l))
-		}
-		l = len(x.ExpeditedThreshold)
+		l = len(x.Commitment)
 		if l > 0 {
 			n += 1 + l + runtime.Sov(uint64(l))
 		}
-		if len(x.ExpeditedMinDeposit) > 0 {
-			for _, e := range x.ExpeditedMinDeposit {
-				l = options.Size(e)
-				n += 1 + l + runtime.Sov(uint64(l))
-			}
-		}
-		if x.BurnVoteQuorum {
-			n += 2
-		}
-		if x.BurnProposalDepositPrevote {
-			n += 2
-		}
-		if x.BurnVoteVeto {
-			n += 2
-		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
 		}
@@ -6127,7 +6718,7 @@ func (x *fastReflection_Params) ProtoMethods() *protoiface.Methods {
 	}
 
 	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
-		x := input.Message.Interface().(*Params)
+		x := input.Message.Interface().(*VoteCommitment)
 		if x == nil {
 			return protoiface.MarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -6146,158 +6737,24 @@ func (x *fastReflection_Params) ProtoMethods() *protoiface.Methods {
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
-		if x.BurnVoteVeto {
-			i--
-			if x.BurnVoteVeto {
-				dAtA[i] = 1
-			} else {
-				dAtA[i] = 0
-			}
+		if len(x.Commitment) > 0 {
+			i -= len(x.Commitment)
+			copy(dAtA[i:], x.Commitment)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Commitment)))
 			i--
-			dAtA[i] = 0x78
+			dAtA[i] = 0x1a
 		}
-		if x.BurnProposalDepositPrevote {
-			i--
-			if x.BurnProposalDepositPrevote {
-				dAtA[i] = 1
-			} else {
-				dAtA[i] = 0
-			}
-			i--
-			dAtA[i] = 0x70
-		}
-		if x.BurnVoteQuorum {
-			i--
-			if x.BurnVoteQuorum {
-				dAtA[i] = 1
-			} else {
-				dAtA[i] = 0
-			}
-			i--
-			dAtA[i] = 0x68
-		}
-		if len(x.ExpeditedMinDeposit) > 0 {
-			for iNdEx := len(x.ExpeditedMinDeposit) - 1; iNdEx >= 0; iNdEx-- {
-				encoded, err := options.Marshal(x.ExpeditedMinDeposit[iNdEx])
-				if err != nil {
-					return protoiface.MarshalOutput{
-						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
-						Buf:               input.Buf,
-					}, err
-				}
-				i -= len(encoded)
-				copy(dAtA[i:], encoded)
-				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
-				i--
-				dAtA[i] = 0x62
-			}
-		}
-		if len(x.ExpeditedThreshold) > 0 {
-			i -= len(x.ExpeditedThreshold)
-			copy(dAtA[i:], x.ExpeditedThreshold)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.ExpeditedThreshold)))
-			i--
-			dAtA[i] = 0x5a
-		}
-		if x.ExpeditedVotingPeriod != nil {
-			encoded, err := options.Marshal(x.ExpeditedVotingPeriod)
-			if err != nil {
-				return protoiface.MarshalOutput{
-					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
-					Buf:               input.Buf,
-				}, err
-			}
-			i -= len(encoded)
-			copy(dAtA[i:], encoded)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
-			i--
-			dAtA[i] = 0x52
-		}
-		if len(x.ProposalCancelDest) > 0 {
-			i -= len(x.ProposalCancelDest)
-			copy(dAtA[i:], x.ProposalCancelDest)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.ProposalCancelDest)))
-			i--
-			dAtA[i] = 0x4a
-		}
-		if len(x.ProposalCancelRatio) > 0 {
-			i -= len(x.ProposalCancelRatio)
-			copy(dAtA[i:], x.ProposalCancelRatio)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.ProposalCancelRatio)))
-			i--
-			dAtA[i] = 0x42
-		}
-		if len(x.MinInitialDepositRatio) > 0 {
-			i -= len(x.MinInitialDepositRatio)
-			copy(dAtA[i:], x.MinInitialDepositRatio)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.MinInitialDepositRatio)))
-			i--
-			dAtA[i] = 0x3a
-		}
-		if len(x.VetoThreshold) > 0 {
-			i -= len(x.VetoThreshold)
-			copy(dAtA[i:], x.VetoThreshold)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.VetoThreshold)))
-			i--
-			dAtA[i] = 0x32
-		}
-		if len(x.Threshold) > 0 {
-			i -= len(x.Threshold)
-			copy(dAtA[i:], x.Threshold)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Threshold)))
-			i--
-			dAtA[i] = 0x2a
-		}
-		if len(x.Quorum) > 0 {
-			i -= len(x.Quorum)
-			copy(dAtA[i:], x.Quorum)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Quorum)))
-			i--
-			dAtA[i] = 0x22
-		}
-		if x.VotingPeriod != nil {
-			encoded, err := options.Marshal(x.VotingPeriod)
-			if err != nil {
-				return protoiface.MarshalOutput{
-					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
-					Buf:               input.Buf,
-				}, err
-			}
-			i -= len(encoded)
-			copy(dAtA[i:], encoded)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
-			i--
-			dAtA[i] = 0x1a
-		}
-		if x.MaxDepositPeriod != nil {
-			encoded, err := options.Marshal(x.MaxDepositPeriod)
-			if err != nil {
-				return protoiface.MarshalOutput{
-					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
-					Buf:               input.Buf,
-				}, err
-			}
-			i -= len(encoded)
-			copy(dAtA[i:], encoded)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+		if len(x.Voter) > 0 {
+			i -= len(x.Voter)
+			copy(dAtA[i:], x.Voter)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Voter)))
 			i--
 			dAtA[i] = 0x12
 		}
-		if len(x.MinDeposit) > 0 {
-			for iNdEx := len(x.MinDeposit) - 1; iNdEx >= 0; iNdEx-- {
-				encoded, err := options.Marshal(x.MinDeposit[iNdEx])
-				if err != nil {
-					return protoiface.MarshalOutput{
-						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
-						Buf:               input.Buf,
-					}, err
-				}
-				i -= len(encoded)
-				copy(dAtA[i:], encoded)
-				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
-				i--
-				dAtA[i] = 0xa
-			}
+		if x.ProposalId != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.ProposalId))
+			i--
+			dAtA[i] = 0x8
 		}
 		if input.Buf != nil {
 			input.Buf = append(input.Buf, dAtA...)
@@ -6310,7 +6767,7 @@ func (x *fastReflection_Params) ProtoMethods() *protoiface.Methods {
 		}, nil
 	}
 	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
-		x := input.Message.Interface().(*Params)
+		x := input.Message.Interface().(*VoteCommitment)
 		if x == nil {
 			return protoiface.UnmarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -6342,17 +6799,17 @@ func (x *fastReflection_Params) ProtoMethods() *protoiface.Methods {
 			fieldNum := int32(wire >> 3)
 			wireType := int(wire & 0x7)
 			if wireType == 4 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: Params: wiretype end group for non-group")
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: VoteCommitment: wiretype end group for non-group")
 			}
 			if fieldNum <= 0 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: Params: illegal tag %d (wire type %d)", fieldNum, wire)
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: VoteCommitment: illegal tag %d (wire type %d)", fieldNum, wire)
 			}
 			switch fieldNum {
 			case 1:
-				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field MinDeposit", wireType)
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ProposalId", wireType)
 				}
-				var msglen int
+				x.ProposalId = 0
 				for shift := uint(0); ; shift += 7 {
 					if shift >= 64 {
 						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
@@ -6362,31 +6819,16 @@ func (x *fastReflection_Params) ProtoMethods() *protoiface.Methods {
 					}
 					b := dAtA[iNdEx]
 					iNdEx++
-					msglen |= int(b&0x7F) << shift
+					x.ProposalId |= uint64(b&0x7F) << shift
 					if b < 0x80 {
 						break
 					}
 				}
-				if msglen < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				postIndex := iNdEx + msglen
-				if postIndex < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				if postIndex > l {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-				}
-				x.MinDeposit = append(x.MinDeposit, &v1beta1.Coin{})
-				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.MinDeposit[len(x.MinDeposit)-1]); err != nil {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
-				}
-				iNdEx = postIndex
 			case 2:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field MaxDepositPeriod", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Voter", wireType)
 				}
-				var msglen int
+				var stringLen uint64
 				for shift := uint(0); ; shift += 7 {
 					if shift >= 64 {
 						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
@@ -6396,33 +6838,29 @@ func (x *fastReflection_Params) ProtoMethods() *protoiface.Methods {
 					}
 					b := dAtA[iNdEx]
 					iNdEx++
-					msglen |= int(b&0x7F) << shift
+					stringLen |= uint64(b&0x7F) << shift
 					if b < 0x80 {
 						break
 					}
 				}
-				if msglen < 0 {
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 				}
-				postIndex := iNdEx + msglen
+				postIndex := iNdEx + intStringLen
 				if postIndex < 0 {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 				}
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				if x.MaxDepositPeriod == nil {
-					x.MaxDepositPeriod = &durationpb.Duration{}
-				}
-				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.MaxDepositPeriod); err != nil {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
-				}
+				x.Voter = string(dAtA[iNdEx:postIndex])
 				iNdEx = postIndex
 			case 3:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field VotingPeriod", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Commitment", wireType)
 				}
-				var msglen int
+				var byteLen int
 				for shift := uint(0); ; shift += 7 {
 					if shift >= 64 {
 						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
@@ -6432,95 +6870,7579 @@ func (x *fastReflection_Params) ProtoMethods() *protoiface.Methods {
 					}
 					b := dAtA[iNdEx]
 					iNdEx++
-					msglen |= int(b&0x7F) << shift
+					byteLen |= int(b&0x7F) << shift
 					if b < 0x80 {
 						break
 					}
 				}
-				if msglen < 0 {
+				if byteLen < 0 {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 				}
-				postIndex := iNdEx + msglen
+				postIndex := iNdEx + byteLen
 				if postIndex < 0 {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 				}
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				if x.VotingPeriod == nil {
-					x.VotingPeriod = &durationpb.Duration{}
-				}
-				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.VotingPeriod); err != nil {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				x.Commitment = append(x.Commitment[:0], dAtA[iNdEx:postIndex]...)
+				if x.Commitment == nil {
+					x.Commitment = []byte{}
 				}
 				iNdEx = postIndex
-			case 4:
-				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Quorum", wireType)
-				}
-				var stringLen uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
-					}
-					if iNdEx >= l {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					stringLen |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				intStringLen := int(stringLen)
-				if intStringLen < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
 				}
-				postIndex := iNdEx + intStringLen
-				if postIndex < 0 {
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 				}
-				if postIndex > l {
+				if (iNdEx + skippy) > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				x.Quorum = string(dAtA[iNdEx:postIndex])
-				iNdEx = postIndex
-			case 5:
-				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Threshold", wireType)
-				}
-				var stringLen uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
-					}
-					if iNdEx >= l {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					stringLen |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				intStringLen := int(stringLen)
-				if intStringLen < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
 				}
-				postIndex := iNdEx + intStringLen
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var _ protoreflect.List = (*_ValidatorVoteBreakdown_3_list)(nil)
+
+type _ValidatorVoteBreakdown_3_list struct {
+	list *[]*WeightedVoteOption
+}
+
+func (x *_ValidatorVoteBreakdown_3_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_ValidatorVoteBreakdown_3_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_ValidatorVoteBreakdown_3_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*WeightedVoteOption)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_ValidatorVoteBreakdown_3_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*WeightedVoteOption)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_ValidatorVoteBreakdown_3_list) AppendMutable() protoreflect.Value {
+	v := new(WeightedVoteOption)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_ValidatorVoteBreakdown_3_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_ValidatorVoteBreakdown_3_list) NewElement() protoreflect.Value {
+	v := new(WeightedVoteOption)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_ValidatorVoteBreakdown_3_list) IsValid() bool {
+	return x.list != nil
+}
+
+var (
+	md_ValidatorVoteBreakdown                      protoreflect.MessageDescriptor
+	fd_ValidatorVoteBreakdown_proposal_id          protoreflect.FieldDescriptor
+	fd_ValidatorVoteBreakdown_validator_address    protoreflect.FieldDescriptor
+	fd_ValidatorVoteBreakdown_options              protoreflect.FieldDescriptor
+	fd_ValidatorVoteBreakdown_inherited_power      protoreflect.FieldDescriptor
+	fd_ValidatorVoteBreakdown_overridden_power     protoreflect.FieldDescriptor
+	fd_ValidatorVoteBreakdown_participation_exempt protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_cosmos_gov_v1_gov_proto_init()
+	md_ValidatorVoteBreakdown = File_cosmos_gov_v1_gov_proto.Messages().ByName("ValidatorVoteBreakdown")
+	fd_ValidatorVoteBreakdown_proposal_id = md_ValidatorVoteBreakdown.Fields().ByName("proposal_id")
+	fd_ValidatorVoteBreakdown_validator_address = md_ValidatorVoteBreakdown.Fields().ByName("validator_address")
+	fd_ValidatorVoteBreakdown_options = md_ValidatorVoteBreakdown.Fields().ByName("options")
+	fd_ValidatorVoteBreakdown_inherited_power = md_ValidatorVoteBreakdown.Fields().ByName("inherited_power")
+	fd_ValidatorVoteBreakdown_overridden_power = md_ValidatorVoteBreakdown.Fields().ByName("overridden_power")
+	fd_ValidatorVoteBreakdown_participation_exempt = md_ValidatorVoteBreakdown.Fields().ByName("participation_exempt")
+}
+
+var _ protoreflect.Message = (*fastReflection_ValidatorVoteBreakdown)(nil)
+
+type fastReflection_ValidatorVoteBreakdown ValidatorVoteBreakdown
+
+func (x *ValidatorVoteBreakdown) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_ValidatorVoteBreakdown)(x)
+}
+
+func (x *ValidatorVoteBreakdown) slowProtoReflect() protoreflect.Message {
+	mi := &file_cosmos_gov_v1_gov_proto_msgTypes[9]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_ValidatorVoteBreakdown_messageType fastReflection_ValidatorVoteBreakdown_messageType
+var _ protoreflect.MessageType = fastReflection_ValidatorVoteBreakdown_messageType{}
+
+type fastReflection_ValidatorVoteBreakdown_messageType struct{}
+
+func (x fastReflection_ValidatorVoteBreakdown_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_ValidatorVoteBreakdown)(nil)
+}
+func (x fastReflection_ValidatorVoteBreakdown_messageType) New() protoreflect.Message {
+	return new(fastReflection_ValidatorVoteBreakdown)
+}
+func (x fastReflection_ValidatorVoteBreakdown_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_ValidatorVoteBreakdown
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_ValidatorVoteBreakdown) Descriptor() protoreflect.MessageDescriptor {
+	return md_ValidatorVoteBreakdown
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_ValidatorVoteBreakdown) Type() protoreflect.MessageType {
+	return _fastReflection_ValidatorVoteBreakdown_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_ValidatorVoteBreakdown) New() protoreflect.Message {
+	return new(fastReflection_ValidatorVoteBreakdown)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_ValidatorVoteBreakdown) Interface() protoreflect.ProtoMessage {
+	return (*ValidatorVoteBreakdown)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_ValidatorVoteBreakdown) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.ProposalId != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.ProposalId)
+		if !f(fd_ValidatorVoteBreakdown_proposal_id, value) {
+			return
+		}
+	}
+	if x.ValidatorAddress != "" {
+		value := protoreflect.ValueOfString(x.ValidatorAddress)
+		if !f(fd_ValidatorVoteBreakdown_validator_address, value) {
+			return
+		}
+	}
+	if len(x.Options) != 0 {
+		value := protoreflect.ValueOfList(&_ValidatorVoteBreakdown_3_list{list: &x.Options})
+		if !f(fd_ValidatorVoteBreakdown_options, value) {
+			return
+		}
+	}
+	if x.InheritedPower != "" {
+		value := protoreflect.ValueOfString(x.InheritedPower)
+		if !f(fd_ValidatorVoteBreakdown_inherited_power, value) {
+			return
+		}
+	}
+	if x.OverriddenPower != "" {
+		value := protoreflect.ValueOfString(x.OverriddenPower)
+		if !f(fd_ValidatorVoteBreakdown_overridden_power, value) {
+			return
+		}
+	}
+	if x.ParticipationExempt != false {
+		value := protoreflect.ValueOfBool(x.ParticipationExempt)
+		if !f(fd_ValidatorVoteBreakdown_participation_exempt, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_ValidatorVoteBreakdown) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "cosmos.gov.v1.ValidatorVoteBreakdown.proposal_id":
+		return x.ProposalId != uint64(0)
+	case "cosmos.gov.v1.ValidatorVoteBreakdown.validator_address":
+		return x.ValidatorAddress != ""
+	case "cosmos.gov.v1.ValidatorVoteBreakdown.options":
+		return len(x.Options) != 0
+	case "cosmos.gov.v1.ValidatorVoteBreakdown.inherited_power":
+		return x.InheritedPower != ""
+	case "cosmos.gov.v1.ValidatorVoteBreakdown.overridden_power":
+		return x.OverriddenPower != ""
+	case "cosmos.gov.v1.ValidatorVoteBreakdown.participation_exempt":
+		return x.ParticipationExempt != false
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.gov.v1.ValidatorVoteBreakdown"))
+		}
+		panic(fmt.Errorf("message cosmos.gov.v1.ValidatorVoteBreakdown does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_ValidatorVoteBreakdown) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "cosmos.gov.v1.ValidatorVoteBreakdown.proposal_id":
+		x.ProposalId = uint64(0)
+	case "cosmos.gov.v1.ValidatorVoteBreakdown.validator_address":
+		x.ValidatorAddress = ""
+	case "cosmos.gov.v1.ValidatorVoteBreakdown.options":
+		x.Options = nil
+	case "cosmos.gov.v1.ValidatorVoteBreakdown.inherited_power":
+		x.InheritedPower = ""
+	case "cosmos.gov.v1.ValidatorVoteBreakdown.overridden_power":
+		x.OverriddenPower = ""
+	case "cosmos.gov.v1.ValidatorVoteBreakdown.participation_exempt":
+		x.ParticipationExempt = false
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.gov.v1.ValidatorVoteBreakdown"))
+		}
+		panic(fmt.Errorf("message cosmos.gov.v1.ValidatorVoteBreakdown does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_ValidatorVoteBreakdown) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "cosmos.gov.v1.ValidatorVoteBreakdown.proposal_id":
+		value := x.ProposalId
+		return protoreflect.ValueOfUint64(value)
+	case "cosmos.gov.v1.ValidatorVoteBreakdown.validator_address":
+		value := x.ValidatorAddress
+		return protoreflect.ValueOfString(value)
+	case "cosmos.gov.v1.ValidatorVoteBreakdown.options":
+		if len(x.Options) == 0 {
+			return protoreflect.ValueOfList(&_ValidatorVoteBreakdown_3_list{})
+		}
+		listValue := &_ValidatorVoteBreakdown_3_list{list: &x.Options}
+		return protoreflect.ValueOfList(listValue)
+	case "cosmos.gov.v1.ValidatorVoteBreakdown.inherited_power":
+		value := x.InheritedPower
+		return protoreflect.ValueOfString(value)
+	case "cosmos.gov.v1.ValidatorVoteBreakdown.overridden_power":
+		value := x.OverriddenPower
+		return protoreflect.ValueOfString(value)
+	case "cosmos.gov.v1.ValidatorVoteBreakdown.participation_exempt":
+		value := x.ParticipationExempt
+		return protoreflect.ValueOfBool(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.gov.v1.ValidatorVoteBreakdown"))
+		}
+		panic(fmt.Errorf("message cosmos.gov.v1.ValidatorVoteBreakdown does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_ValidatorVoteBreakdown) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "cosmos.gov.v1.ValidatorVoteBreakdown.proposal_id":
+		x.ProposalId = value.Uint()
+	case "cosmos.gov.v1.ValidatorVoteBreakdown.validator_address":
+		x.ValidatorAddress = value.Interface().(string)
+	case "cosmos.gov.v1.ValidatorVoteBreakdown.options":
+		lv := value.List()
+		clv := lv.(*_ValidatorVoteBreakdown_3_list)
+		x.Options = *clv.list
+	case "cosmos.gov.v1.ValidatorVoteBreakdown.inherited_power":
+		x.InheritedPower = value.Interface().(string)
+	case "cosmos.gov.v1.ValidatorVoteBreakdown.overridden_power":
+		x.OverriddenPower = value.Interface().(string)
+	case "cosmos.gov.v1.ValidatorVoteBreakdown.participation_exempt":
+		x.ParticipationExempt = value.Bool()
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.gov.v1.ValidatorVoteBreakdown"))
+		}
+		panic(fmt.Errorf("message cosmos.gov.v1.ValidatorVoteBreakdown does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_ValidatorVoteBreakdown) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "cosmos.gov.v1.ValidatorVoteBreakdown.options":
+		if x.Options == nil {
+			x.Options = []*WeightedVoteOption{}
+		}
+		value := &_ValidatorVoteBreakdown_3_list{list: &x.Options}
+		return protoreflect.ValueOfList(value)
+	case "cosmos.gov.v1.ValidatorVoteBreakdown.proposal_id":
+		panic(fmt.Errorf("field proposal_id of message cosmos.gov.v1.ValidatorVoteBreakdown is not mutable"))
+	case "cosmos.gov.v1.ValidatorVoteBreakdown.validator_address":
+		panic(fmt.Errorf("field validator_address of message cosmos.gov.v1.ValidatorVoteBreakdown is not mutable"))
+	case "cosmos.gov.v1.ValidatorVoteBreakdown.inherited_power":
+		panic(fmt.Errorf("field inherited_power of message cosmos.gov.v1.ValidatorVoteBreakdown is not mutable"))
+	case "cosmos.gov.v1.ValidatorVoteBreakdown.overridden_power":
+		panic(fmt.Errorf("field overridden_power of message cosmos.gov.v1.ValidatorVoteBreakdown is not mutable"))
+	case "cosmos.gov.v1.ValidatorVoteBreakdown.participation_exempt":
+		panic(fmt.Errorf("field participation_exempt of message cosmos.gov.v1.ValidatorVoteBreakdown is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.gov.v1.ValidatorVoteBreakdown"))
+		}
+		panic(fmt.Errorf("message cosmos.gov.v1.ValidatorVoteBreakdown does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_ValidatorVoteBreakdown) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "cosmos.gov.v1.ValidatorVoteBreakdown.proposal_id":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "cosmos.gov.v1.ValidatorVoteBreakdown.validator_address":
+		return protoreflect.ValueOfString("")
+	case "cosmos.gov.v1.ValidatorVoteBreakdown.options":
+		list := []*WeightedVoteOption{}
+		return protoreflect.ValueOfList(&_ValidatorVoteBreakdown_3_list{list: &list})
+	case "cosmos.gov.v1.ValidatorVoteBreakdown.inherited_power":
+		return protoreflect.ValueOfString("")
+	case "cosmos.gov.v1.ValidatorVoteBreakdown.overridden_power":
+		return protoreflect.ValueOfString("")
+	case "cosmos.gov.v1.ValidatorVoteBreakdown.participation_exempt":
+		return protoreflect.ValueOfBool(false)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.gov.v1.ValidatorVoteBreakdown"))
+		}
+		panic(fmt.Errorf("message cosmos.gov.v1.ValidatorVoteBreakdown does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_ValidatorVoteBreakdown) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in cosmos.gov.v1.ValidatorVoteBreakdown", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_ValidatorVoteBreakdown) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_ValidatorVoteBreakdown) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_ValidatorVoteBreakdown) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_ValidatorVoteBreakdown) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*ValidatorVoteBreakdown)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if x.ProposalId != 0 {
+			n += 1 + runtime.Sov(uint64(x.ProposalId))
+		}
+		l = len(x.ValidatorAddress)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if len(x.Options) > 0 {
+			for _, e := range x.Options {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		l = len(x.InheritedPower)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.OverriddenPower)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.ParticipationExempt {
+			n += 2
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*ValidatorVoteBreakdown)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if x.ParticipationExempt {
+			i--
+			if x.ParticipationExempt {
+				dAtA[i] = 1
+			} else {
+				dAtA[i] = 0
+			}
+			i--
+			dAtA[i] = 0x30
+		}
+		if len(x.OverriddenPower) > 0 {
+			i -= len(x.OverriddenPower)
+			copy(dAtA[i:], x.OverriddenPower)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.OverriddenPower)))
+			i--
+			dAtA[i] = 0x2a
+		}
+		if len(x.InheritedPower) > 0 {
+			i -= len(x.InheritedPower)
+			copy(dAtA[i:], x.InheritedPower)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.InheritedPower)))
+			i--
+			dAtA[i] = 0x22
+		}
+		if len(x.Options) > 0 {
+			for iNdEx := len(x.Options) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.Options[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x1a
+			}
+		}
+		if len(x.ValidatorAddress) > 0 {
+			i -= len(x.ValidatorAddress)
+			copy(dAtA[i:], x.ValidatorAddress)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.ValidatorAddress)))
+			i--
+			dAtA[i] = 0x12
+		}
+		if x.ProposalId != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.ProposalId))
+			i--
+			dAtA[i] = 0x8
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*ValidatorVoteBreakdown)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: ValidatorVoteBreakdown: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: ValidatorVoteBreakdown: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ProposalId", wireType)
+				}
+				x.ProposalId = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.ProposalId |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddress", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.ValidatorAddress = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 3:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Options", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Options = append(x.Options, &WeightedVoteOption{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Options[len(x.Options)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 4:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field InheritedPower", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.InheritedPower = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 5:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field OverriddenPower", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.OverriddenPower = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 6:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ParticipationExempt", wireType)
+				}
+				var v int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				x.ParticipationExempt = bool(v != 0)
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_ValidatorVotedShares              protoreflect.MessageDescriptor
+	fd_ValidatorVotedShares_yes          protoreflect.FieldDescriptor
+	fd_ValidatorVotedShares_abstain      protoreflect.FieldDescriptor
+	fd_ValidatorVotedShares_no           protoreflect.FieldDescriptor
+	fd_ValidatorVotedShares_no_with_veto protoreflect.FieldDescriptor
+	fd_ValidatorVotedShares_voted        protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_cosmos_gov_v1_gov_proto_init()
+	md_ValidatorVotedShares = File_cosmos_gov_v1_gov_proto.Messages().ByName("ValidatorVotedShares")
+	fd_ValidatorVotedShares_yes = md_ValidatorVotedShares.Fields().ByName("yes")
+	fd_ValidatorVotedShares_abstain = md_ValidatorVotedShares.Fields().ByName("abstain")
+	fd_ValidatorVotedShares_no = md_ValidatorVotedShares.Fields().ByName("no")
+	fd_ValidatorVotedShares_no_with_veto = md_ValidatorVotedShares.Fields().ByName("no_with_veto")
+	fd_ValidatorVotedShares_voted = md_ValidatorVotedShares.Fields().ByName("voted")
+}
+
+var _ protoreflect.Message = (*fastReflection_ValidatorVotedShares)(nil)
+
+type fastReflection_ValidatorVotedShares ValidatorVotedShares
+
+func (x *ValidatorVotedShares) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_ValidatorVotedShares)(x)
+}
+
+func (x *ValidatorVotedShares) slowProtoReflect() protoreflect.Message {
+	mi := &file_cosmos_gov_v1_gov_proto_msgTypes[10]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_ValidatorVotedShares_messageType fastReflection_ValidatorVotedShares_messageType
+var _ protoreflect.MessageType = fastReflection_ValidatorVotedShares_messageType{}
+
+type fastReflection_ValidatorVotedShares_messageType struct{}
+
+func (x fastReflection_ValidatorVotedShares_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_ValidatorVotedShares)(nil)
+}
+func (x fastReflection_ValidatorVotedShares_messageType) New() protoreflect.Message {
+	return new(fastReflection_ValidatorVotedShares)
+}
+func (x fastReflection_ValidatorVotedShares_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_ValidatorVotedShares
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_ValidatorVotedShares) Descriptor() protoreflect.MessageDescriptor {
+	return md_ValidatorVotedShares
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_ValidatorVotedShares) Type() protoreflect.MessageType {
+	return _fastReflection_ValidatorVotedShares_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_ValidatorVotedShares) New() protoreflect.Message {
+	return new(fastReflection_ValidatorVotedShares)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_ValidatorVotedShares) Interface() protoreflect.ProtoMessage {
+	return (*ValidatorVotedShares)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_ValidatorVotedShares) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Yes != "" {
+		value := protoreflect.ValueOfString(x.Yes)
+		if !f(fd_ValidatorVotedShares_yes, value) {
+			return
+		}
+	}
+	if x.Abstain != "" {
+		value := protoreflect.ValueOfString(x.Abstain)
+		if !f(fd_ValidatorVotedShares_abstain, value) {
+			return
+		}
+	}
+	if x.No != "" {
+		value := protoreflect.ValueOfString(x.No)
+		if !f(fd_ValidatorVotedShares_no, value) {
+			return
+		}
+	}
+	if x.NoWithVeto != "" {
+		value := protoreflect.ValueOfString(x.NoWithVeto)
+		if !f(fd_ValidatorVotedShares_no_with_veto, value) {
+			return
+		}
+	}
+	if x.Voted != "" {
+		value := protoreflect.ValueOfString(x.Voted)
+		if !f(fd_ValidatorVotedShares_voted, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_ValidatorVotedShares) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "cosmos.gov.v1.ValidatorVotedShares.yes":
+		return x.Yes != ""
+	case "cosmos.gov.v1.ValidatorVotedShares.abstain":
+		return x.Abstain != ""
+	case "cosmos.gov.v1.ValidatorVotedShares.no":
+		return x.No != ""
+	case "cosmos.gov.v1.ValidatorVotedShares.no_with_veto":
+		return x.NoWithVeto != ""
+	case "cosmos.gov.v1.ValidatorVotedShares.voted":
+		return x.Voted != ""
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.gov.v1.ValidatorVotedShares"))
+		}
+		panic(fmt.Errorf("message cosmos.gov.v1.ValidatorVotedShares does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_ValidatorVotedShares) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "cosmos.gov.v1.ValidatorVotedShares.yes":
+		x.Yes = ""
+	case "cosmos.gov.v1.ValidatorVotedShares.abstain":
+		x.Abstain = ""
+	case "cosmos.gov.v1.ValidatorVotedShares.no":
+		x.No = ""
+	case "cosmos.gov.v1.ValidatorVotedShares.no_with_veto":
+		x.NoWithVeto = ""
+	case "cosmos.gov.v1.ValidatorVotedShares.voted":
+		x.Voted = ""
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.gov.v1.ValidatorVotedShares"))
+		}
+		panic(fmt.Errorf("message cosmos.gov.v1.ValidatorVotedShares does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_ValidatorVotedShares) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "cosmos.gov.v1.ValidatorVotedShares.yes":
+		value := x.Yes
+		return protoreflect.ValueOfString(value)
+	case "cosmos.gov.v1.ValidatorVotedShares.abstain":
+		value := x.Abstain
+		return protoreflect.ValueOfString(value)
+	case "cosmos.gov.v1.ValidatorVotedShares.no":
+		value := x.No
+		return protoreflect.ValueOfString(value)
+	case "cosmos.gov.v1.ValidatorVotedShares.no_with_veto":
+		value := x.NoWithVeto
+		return protoreflect.ValueOfString(value)
+	case "cosmos.gov.v1.ValidatorVotedShares.voted":
+		value := x.Voted
+		return protoreflect.ValueOfString(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.gov.v1.ValidatorVotedShares"))
+		}
+		panic(fmt.Errorf("message cosmos.gov.v1.ValidatorVotedShares does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_ValidatorVotedShares) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "cosmos.gov.v1.ValidatorVotedShares.yes":
+		x.Yes = value.Interface().(string)
+	case "cosmos.gov.v1.ValidatorVotedShares.abstain":
+		x.Abstain = value.Interface().(string)
+	case "cosmos.gov.v1.ValidatorVotedShares.no":
+		x.No = value.Interface().(string)
+	case "cosmos.gov.v1.ValidatorVotedShares.no_with_veto":
+		x.NoWithVeto = value.Interface().(string)
+	case "cosmos.gov.v1.ValidatorVotedShares.voted":
+		x.Voted = value.Interface().(string)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.gov.v1.ValidatorVotedShares"))
+		}
+		panic(fmt.Errorf("message cosmos.gov.v1.ValidatorVotedShares does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_ValidatorVotedShares) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "cosmos.gov.v1.ValidatorVotedShares.yes":
+		panic(fmt.Errorf("field yes of message cosmos.gov.v1.ValidatorVotedShares is not mutable"))
+	case "cosmos.gov.v1.ValidatorVotedShares.abstain":
+		panic(fmt.Errorf("field abstain of message cosmos.gov.v1.ValidatorVotedShares is not mutable"))
+	case "cosmos.gov.v1.ValidatorVotedShares.no":
+		panic(fmt.Errorf("field no of message cosmos.gov.v1.ValidatorVotedShares is not mutable"))
+	case "cosmos.gov.v1.ValidatorVotedShares.no_with_veto":
+		panic(fmt.Errorf("field no_with_veto of message cosmos.gov.v1.ValidatorVotedShares is not mutable"))
+	case "cosmos.gov.v1.ValidatorVotedShares.voted":
+		panic(fmt.Errorf("field voted of message cosmos.gov.v1.ValidatorVotedShares is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.gov.v1.ValidatorVotedShares"))
+		}
+		panic(fmt.Errorf("message cosmos.gov.v1.ValidatorVotedShares does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_ValidatorVotedShares) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "cosmos.gov.v1.ValidatorVotedShares.yes":
+		return protoreflect.ValueOfString("")
+	case "cosmos.gov.v1.ValidatorVotedShares.abstain":
+		return protoreflect.ValueOfString("")
+	case "cosmos.gov.v1.ValidatorVotedShares.no":
+		return protoreflect.ValueOfString("")
+	case "cosmos.gov.v1.ValidatorVotedShares.no_with_veto":
+		return protoreflect.ValueOfString("")
+	case "cosmos.gov.v1.ValidatorVotedShares.voted":
+		return protoreflect.ValueOfString("")
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.gov.v1.ValidatorVotedShares"))
+		}
+		panic(fmt.Errorf("message cosmos.gov.v1.ValidatorVotedShares does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_ValidatorVotedShares) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in cosmos.gov.v1.ValidatorVotedShares", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_ValidatorVotedShares) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_ValidatorVotedShares) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_ValidatorVotedShares) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_ValidatorVotedShares) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*ValidatorVotedShares)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		l = len(x.Yes)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.Abstain)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.No)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.NoWithVeto)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.Voted)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*ValidatorVotedShares)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if len(x.Voted) > 0 {
+			i -= len(x.Voted)
+			copy(dAtA[i:], x.Voted)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Voted)))
+			i--
+			dAtA[i] = 0x2a
+		}
+		if len(x.NoWithVeto) > 0 {
+			i -= len(x.NoWithVeto)
+			copy(dAtA[i:], x.NoWithVeto)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.NoWithVeto)))
+			i--
+			dAtA[i] = 0x22
+		}
+		if len(x.No) > 0 {
+			i -= len(x.No)
+			copy(dAtA[i:], x.No)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.No)))
+			i--
+			dAtA[i] = 0x1a
+		}
+		if len(x.Abstain) > 0 {
+			i -= len(x.Abstain)
+			copy(dAtA[i:], x.Abstain)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Abstain)))
+			i--
+			dAtA[i] = 0x12
+		}
+		if len(x.Yes) > 0 {
+			i -= len(x.Yes)
+			copy(dAtA[i:], x.Yes)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Yes)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*ValidatorVotedShares)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: ValidatorVotedShares: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: ValidatorVotedShares: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Yes", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Yes = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Abstain", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Abstain = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 3:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field No", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.No = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 4:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field NoWithVeto", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.NoWithVeto = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 5:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Voted", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Voted = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_ConstitutionAmendment              protoreflect.MessageDescriptor
+	fd_ConstitutionAmendment_id           protoreflect.FieldDescriptor
+	fd_ConstitutionAmendment_proposal_id  protoreflect.FieldDescriptor
+	fd_ConstitutionAmendment_constitution protoreflect.FieldDescriptor
+	fd_ConstitutionAmendment_amended_at   protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_cosmos_gov_v1_gov_proto_init()
+	md_ConstitutionAmendment = File_cosmos_gov_v1_gov_proto.Messages().ByName("ConstitutionAmendment")
+	fd_ConstitutionAmendment_id = md_ConstitutionAmendment.Fields().ByName("id")
+	fd_ConstitutionAmendment_proposal_id = md_ConstitutionAmendment.Fields().ByName("proposal_id")
+	fd_ConstitutionAmendment_constitution = md_ConstitutionAmendment.Fields().ByName("constitution")
+	fd_ConstitutionAmendment_amended_at = md_ConstitutionAmendment.Fields().ByName("amended_at")
+}
+
+var _ protoreflect.Message = (*fastReflection_ConstitutionAmendment)(nil)
+
+type fastReflection_ConstitutionAmendment ConstitutionAmendment
+
+func (x *ConstitutionAmendment) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_ConstitutionAmendment)(x)
+}
+
+func (x *ConstitutionAmendment) slowProtoReflect() protoreflect.Message {
+	mi := &file_cosmos_gov_v1_gov_proto_msgTypes[11]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_ConstitutionAmendment_messageType fastReflection_ConstitutionAmendment_messageType
+var _ protoreflect.MessageType = fastReflection_ConstitutionAmendment_messageType{}
+
+type fastReflection_ConstitutionAmendment_messageType struct{}
+
+func (x fastReflection_ConstitutionAmendment_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_ConstitutionAmendment)(nil)
+}
+func (x fastReflection_ConstitutionAmendment_messageType) New() protoreflect.Message {
+	return new(fastReflection_ConstitutionAmendment)
+}
+func (x fastReflection_ConstitutionAmendment_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_ConstitutionAmendment
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_ConstitutionAmendment) Descriptor() protoreflect.MessageDescriptor {
+	return md_ConstitutionAmendment
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_ConstitutionAmendment) Type() protoreflect.MessageType {
+	return _fastReflection_ConstitutionAmendment_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_ConstitutionAmendment) New() protoreflect.Message {
+	return new(fastReflection_ConstitutionAmendment)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_ConstitutionAmendment) Interface() protoreflect.ProtoMessage {
+	return (*ConstitutionAmendment)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_ConstitutionAmendment) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Id != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.Id)
+		if !f(fd_ConstitutionAmendment_id, value) {
+			return
+		}
+	}
+	if x.ProposalId != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.ProposalId)
+		if !f(fd_ConstitutionAmendment_proposal_id, value) {
+			return
+		}
+	}
+	if x.Constitution != "" {
+		value := protoreflect.ValueOfString(x.Constitution)
+		if !f(fd_ConstitutionAmendment_constitution, value) {
+			return
+		}
+	}
+	if x.AmendedAt != nil {
+		value := protoreflect.ValueOfMessage(x.AmendedAt.ProtoReflect())
+		if !f(fd_ConstitutionAmendment_amended_at, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_ConstitutionAmendment) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "cosmos.gov.v1.ConstitutionAmendment.id":
+		return x.Id != uint64(0)
+	case "cosmos.gov.v1.ConstitutionAmendment.proposal_id":
+		return x.ProposalId != uint64(0)
+	case "cosmos.gov.v1.ConstitutionAmendment.constitution":
+		return x.Constitution != ""
+	case "cosmos.gov.v1.ConstitutionAmendment.amended_at":
+		return x.AmendedAt != nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.gov.v1.ConstitutionAmendment"))
+		}
+		panic(fmt.Errorf("message cosmos.gov.v1.ConstitutionAmendment does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_ConstitutionAmendment) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "cosmos.gov.v1.ConstitutionAmendment.id":
+		x.Id = uint64(0)
+	case "cosmos.gov.v1.ConstitutionAmendment.proposal_id":
+		x.ProposalId = uint64(0)
+	case "cosmos.gov.v1.ConstitutionAmendment.constitution":
+		x.Constitution = ""
+	case "cosmos.gov.v1.ConstitutionAmendment.amended_at":
+		x.AmendedAt = nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.gov.v1.ConstitutionAmendment"))
+		}
+		panic(fmt.Errorf("message cosmos.gov.v1.ConstitutionAmendment does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_ConstitutionAmendment) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "cosmos.gov.v1.ConstitutionAmendment.id":
+		value := x.Id
+		return protoreflect.ValueOfUint64(value)
+	case "cosmos.gov.v1.ConstitutionAmendment.proposal_id":
+		value := x.ProposalId
+		return protoreflect.ValueOfUint64(value)
+	case "cosmos.gov.v1.ConstitutionAmendment.constitution":
+		value := x.Constitution
+		return protoreflect.ValueOfString(value)
+	case "cosmos.gov.v1.ConstitutionAmendment.amended_at":
+		value := x.AmendedAt
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.gov.v1.ConstitutionAmendment"))
+		}
+		panic(fmt.Errorf("message cosmos.gov.v1.ConstitutionAmendment does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_ConstitutionAmendment) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "cosmos.gov.v1.ConstitutionAmendment.id":
+		x.Id = value.Uint()
+	case "cosmos.gov.v1.ConstitutionAmendment.proposal_id":
+		x.ProposalId = value.Uint()
+	case "cosmos.gov.v1.ConstitutionAmendment.constitution":
+		x.Constitution = value.Interface().(string)
+	case "cosmos.gov.v1.ConstitutionAmendment.amended_at":
+		x.AmendedAt = value.Message().Interface().(*timestamppb.Timestamp)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.gov.v1.ConstitutionAmendment"))
+		}
+		panic(fmt.Errorf("message cosmos.gov.v1.ConstitutionAmendment does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_ConstitutionAmendment) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "cosmos.gov.v1.ConstitutionAmendment.amended_at":
+		if x.AmendedAt == nil {
+			x.AmendedAt = new(timestamppb.Timestamp)
+		}
+		return protoreflect.ValueOfMessage(x.AmendedAt.ProtoReflect())
+	case "cosmos.gov.v1.ConstitutionAmendment.id":
+		panic(fmt.Errorf("field id of message cosmos.gov.v1.ConstitutionAmendment is not mutable"))
+	case "cosmos.gov.v1.ConstitutionAmendment.proposal_id":
+		panic(fmt.Errorf("field proposal_id of message cosmos.gov.v1.ConstitutionAmendment is not mutable"))
+	case "cosmos.gov.v1.ConstitutionAmendment.constitution":
+		panic(fmt.Errorf("field constitution of message cosmos.gov.v1.ConstitutionAmendment is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.gov.v1.ConstitutionAmendment"))
+		}
+		panic(fmt.Errorf("message cosmos.gov.v1.ConstitutionAmendment does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_ConstitutionAmendment) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "cosmos.gov.v1.ConstitutionAmendment.id":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "cosmos.gov.v1.ConstitutionAmendment.proposal_id":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "cosmos.gov.v1.ConstitutionAmendment.constitution":
+		return protoreflect.ValueOfString("")
+	case "cosmos.gov.v1.ConstitutionAmendment.amended_at":
+		m := new(timestamppb.Timestamp)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.gov.v1.ConstitutionAmendment"))
+		}
+		panic(fmt.Errorf("message cosmos.gov.v1.ConstitutionAmendment does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_ConstitutionAmendment) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in cosmos.gov.v1.ConstitutionAmendment", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_ConstitutionAmendment) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_ConstitutionAmendment) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_ConstitutionAmendment) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_ConstitutionAmendment) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*ConstitutionAmendment)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if x.Id != 0 {
+			n += 1 + runtime.Sov(uint64(x.Id))
+		}
+		if x.ProposalId != 0 {
+			n += 1 + runtime.Sov(uint64(x.ProposalId))
+		}
+		l = len(x.Constitution)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.AmendedAt != nil {
+			l = options.Size(x.AmendedAt)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*ConstitutionAmendment)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if x.AmendedAt != nil {
+			encoded, err := options.Marshal(x.AmendedAt)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x22
+		}
+		if len(x.Constitution) > 0 {
+			i -= len(x.Constitution)
+			copy(dAtA[i:], x.Constitution)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Constitution)))
+			i--
+			dAtA[i] = 0x1a
+		}
+		if x.ProposalId != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.ProposalId))
+			i--
+			dAtA[i] = 0x10
+		}
+		if x.Id != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.Id))
+			i--
+			dAtA[i] = 0x8
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*ConstitutionAmendment)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: ConstitutionAmendment: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: ConstitutionAmendment: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+				}
+				x.Id = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.Id |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 2:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ProposalId", wireType)
+				}
+				x.ProposalId = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.ProposalId |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 3:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Constitution", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Constitution = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 4:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field AmendedAt", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.AmendedAt == nil {
+					x.AmendedAt = &timestamppb.Timestamp{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.AmendedAt); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_MetadataSchema      protoreflect.MessageDescriptor
+	fd_MetadataSchema_id   protoreflect.FieldDescriptor
+	fd_MetadataSchema_name protoreflect.FieldDescriptor
+	fd_MetadataSchema_uri  protoreflect.FieldDescriptor
+	fd_MetadataSchema_hash protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_cosmos_gov_v1_gov_proto_init()
+	md_MetadataSchema = File_cosmos_gov_v1_gov_proto.Messages().ByName("MetadataSchema")
+	fd_MetadataSchema_id = md_MetadataSchema.Fields().ByName("id")
+	fd_MetadataSchema_name = md_MetadataSchema.Fields().ByName("name")
+	fd_MetadataSchema_uri = md_MetadataSchema.Fields().ByName("uri")
+	fd_MetadataSchema_hash = md_MetadataSchema.Fields().ByName("hash")
+}
+
+var _ protoreflect.Message = (*fastReflection_MetadataSchema)(nil)
+
+type fastReflection_MetadataSchema MetadataSchema
+
+func (x *MetadataSchema) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_MetadataSchema)(x)
+}
+
+func (x *MetadataSchema) slowProtoReflect() protoreflect.Message {
+	mi := &file_cosmos_gov_v1_gov_proto_msgTypes[12]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_MetadataSchema_messageType fastReflection_MetadataSchema_messageType
+var _ protoreflect.MessageType = fastReflection_MetadataSchema_messageType{}
+
+type fastReflection_MetadataSchema_messageType struct{}
+
+func (x fastReflection_MetadataSchema_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_MetadataSchema)(nil)
+}
+func (x fastReflection_MetadataSchema_messageType) New() protoreflect.Message {
+	return new(fastReflection_MetadataSchema)
+}
+func (x fastReflection_MetadataSchema_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_MetadataSchema
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_MetadataSchema) Descriptor() protoreflect.MessageDescriptor {
+	return md_MetadataSchema
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_MetadataSchema) Type() protoreflect.MessageType {
+	return _fastReflection_MetadataSchema_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_MetadataSchema) New() protoreflect.Message {
+	return new(fastReflection_MetadataSchema)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_MetadataSchema) Interface() protoreflect.ProtoMessage {
+	return (*MetadataSchema)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_MetadataSchema) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Id != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.Id)
+		if !f(fd_MetadataSchema_id, value) {
+			return
+		}
+	}
+	if x.Name != "" {
+		value := protoreflect.ValueOfString(x.Name)
+		if !f(fd_MetadataSchema_name, value) {
+			return
+		}
+	}
+	if x.Uri != "" {
+		value := protoreflect.ValueOfString(x.Uri)
+		if !f(fd_MetadataSchema_uri, value) {
+			return
+		}
+	}
+	if x.Hash != "" {
+		value := protoreflect.ValueOfString(x.Hash)
+		if !f(fd_MetadataSchema_hash, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_MetadataSchema) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "cosmos.gov.v1.MetadataSchema.id":
+		return x.Id != uint64(0)
+	case "cosmos.gov.v1.MetadataSchema.name":
+		return x.Name != ""
+	case "cosmos.gov.v1.MetadataSchema.uri":
+		return x.Uri != ""
+	case "cosmos.gov.v1.MetadataSchema.hash":
+		return x.Hash != ""
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.gov.v1.MetadataSchema"))
+		}
+		panic(fmt.Errorf("message cosmos.gov.v1.MetadataSchema does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MetadataSchema) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "cosmos.gov.v1.MetadataSchema.id":
+		x.Id = uint64(0)
+	case "cosmos.gov.v1.MetadataSchema.name":
+		x.Name = ""
+	case "cosmos.gov.v1.MetadataSchema.uri":
+		x.Uri = ""
+	case "cosmos.gov.v1.MetadataSchema.hash":
+		x.Hash = ""
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.gov.v1.MetadataSchema"))
+		}
+		panic(fmt.Errorf("message cosmos.gov.v1.MetadataSchema does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_MetadataSchema) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "cosmos.gov.v1.MetadataSchema.id":
+		value := x.Id
+		return protoreflect.ValueOfUint64(value)
+	case "cosmos.gov.v1.MetadataSchema.name":
+		value := x.Name
+		return protoreflect.ValueOfString(value)
+	case "cosmos.gov.v1.MetadataSchema.uri":
+		value := x.Uri
+		return protoreflect.ValueOfString(value)
+	case "cosmos.gov.v1.MetadataSchema.hash":
+		value := x.Hash
+		return protoreflect.ValueOfString(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.gov.v1.MetadataSchema"))
+		}
+		panic(fmt.Errorf("message cosmos.gov.v1.MetadataSchema does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MetadataSchema) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "cosmos.gov.v1.MetadataSchema.id":
+		x.Id = value.Uint()
+	case "cosmos.gov.v1.MetadataSchema.name":
+		x.Name = value.Interface().(string)
+	case "cosmos.gov.v1.MetadataSchema.uri":
+		x.Uri = value.Interface().(string)
+	case "cosmos.gov.v1.MetadataSchema.hash":
+		x.Hash = value.Interface().(string)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.gov.v1.MetadataSchema"))
+		}
+		panic(fmt.Errorf("message cosmos.gov.v1.MetadataSchema does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MetadataSchema) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "cosmos.gov.v1.MetadataSchema.id":
+		panic(fmt.Errorf("field id of message cosmos.gov.v1.MetadataSchema is not mutable"))
+	case "cosmos.gov.v1.MetadataSchema.name":
+		panic(fmt.Errorf("field name of message cosmos.gov.v1.MetadataSchema is not mutable"))
+	case "cosmos.gov.v1.MetadataSchema.uri":
+		panic(fmt.Errorf("field uri of message cosmos.gov.v1.MetadataSchema is not mutable"))
+	case "cosmos.gov.v1.MetadataSchema.hash":
+		panic(fmt.Errorf("field hash of message cosmos.gov.v1.MetadataSchema is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.gov.v1.MetadataSchema"))
+		}
+		panic(fmt.Errorf("message cosmos.gov.v1.MetadataSchema does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_MetadataSchema) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "cosmos.gov.v1.MetadataSchema.id":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "cosmos.gov.v1.MetadataSchema.name":
+		return protoreflect.ValueOfString("")
+	case "cosmos.gov.v1.MetadataSchema.uri":
+		return protoreflect.ValueOfString("")
+	case "cosmos.gov.v1.MetadataSchema.hash":
+		return protoreflect.ValueOfString("")
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.gov.v1.MetadataSchema"))
+		}
+		panic(fmt.Errorf("message cosmos.gov.v1.MetadataSchema does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_MetadataSchema) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in cosmos.gov.v1.MetadataSchema", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_MetadataSchema) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MetadataSchema) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_MetadataSchema) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_MetadataSchema) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*MetadataSchema)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if x.Id != 0 {
+			n += 1 + runtime.Sov(uint64(x.Id))
+		}
+		l = len(x.Name)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.Uri)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.Hash)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*MetadataSchema)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if len(x.Hash) > 0 {
+			i -= len(x.Hash)
+			copy(dAtA[i:], x.Hash)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Hash)))
+			i--
+			dAtA[i] = 0x22
+		}
+		if len(x.Uri) > 0 {
+			i -= len(x.Uri)
+			copy(dAtA[i:], x.Uri)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Uri)))
+			i--
+			dAtA[i] = 0x1a
+		}
+		if len(x.Name) > 0 {
+			i -= len(x.Name)
+			copy(dAtA[i:], x.Name)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Name)))
+			i--
+			dAtA[i] = 0x12
+		}
+		if x.Id != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.Id))
+			i--
+			dAtA[i] = 0x8
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*MetadataSchema)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MetadataSchema: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MetadataSchema: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+				}
+				x.Id = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.Id |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Name = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 3:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Uri", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Uri = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 4:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Hash", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Hash = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var _ protoreflect.List = (*_DepositParams_1_list)(nil)
+
+type _DepositParams_1_list struct {
+	list *[]*v1beta1.Coin
+}
+
+func (x *_DepositParams_1_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_DepositParams_1_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_DepositParams_1_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*v1beta1.Coin)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_DepositParams_1_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*v1beta1.Coin)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_DepositParams_1_list) AppendMutable() protoreflect.Value {
+	v := new(v1beta1.Coin)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_DepositParams_1_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_DepositParams_1_list) NewElement() protoreflect.Value {
+	v := new(v1beta1.Coin)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_DepositParams_1_list) IsValid() bool {
+	return x.list != nil
+}
+
+var (
+	md_DepositParams                    protoreflect.MessageDescriptor
+	fd_DepositParams_min_deposit        protoreflect.FieldDescriptor
+	fd_DepositParams_max_deposit_period protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_cosmos_gov_v1_gov_proto_init()
+	md_DepositParams = File_cosmos_gov_v1_gov_proto.Messages().ByName("DepositParams")
+	fd_DepositParams_min_deposit = md_DepositParams.Fields().ByName("min_deposit")
+	fd_DepositParams_max_deposit_period = md_DepositParams.Fields().ByName("max_deposit_period")
+}
+
+var _ protoreflect.Message = (*fastReflection_DepositParams)(nil)
+
+type fastReflection_DepositParams DepositParams
+
+func (x *DepositParams) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_DepositParams)(x)
+}
+
+func (x *DepositParams) slowProtoReflect() protoreflect.Message {
+	mi := &file_cosmos_gov_v1_gov_proto_msgTypes[13]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_DepositParams_messageType fastReflection_DepositParams_messageType
+var _ protoreflect.MessageType = fastReflection_DepositParams_messageType{}
+
+type fastReflection_DepositParams_messageType struct{}
+
+func (x fastReflection_DepositParams_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_DepositParams)(nil)
+}
+func (x fastReflection_DepositParams_messageType) New() protoreflect.Message {
+	return new(fastReflection_DepositParams)
+}
+func (x fastReflection_DepositParams_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_DepositParams
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_DepositParams) Descriptor() protoreflect.MessageDescriptor {
+	return md_DepositParams
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_DepositParams) Type() protoreflect.MessageType {
+	return _fastReflection_DepositParams_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_DepositParams) New() protoreflect.Message {
+	return new(fastReflection_DepositParams)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_DepositParams) Interface() protoreflect.ProtoMessage {
+	return (*DepositParams)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_DepositParams) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if len(x.MinDeposit) != 0 {
+		value := protoreflect.ValueOfList(&_DepositParams_1_list{list: &x.MinDeposit})
+		if !f(fd_DepositParams_min_deposit, value) {
+			return
+		}
+	}
+	if x.MaxDepositPeriod != nil {
+		value := protoreflect.ValueOfMessage(x.MaxDepositPeriod.ProtoReflect())
+		if !f(fd_DepositParams_max_deposit_period, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_DepositParams) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "cosmos.gov.v1.DepositParams.min_deposit":
+		return len(x.MinDeposit) != 0
+	case "cosmos.gov.v1.DepositParams.max_deposit_period":
+		return x.MaxDepositPeriod != nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.gov.v1.DepositParams"))
+		}
+		panic(fmt.Errorf("message cosmos.gov.v1.DepositParams does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_DepositParams) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "cosmos.gov.v1.DepositParams.min_deposit":
+		x.MinDeposit = nil
+	case "cosmos.gov.v1.DepositParams.max_deposit_period":
+		x.MaxDepositPeriod = nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.gov.v1.DepositParams"))
+		}
+		panic(fmt.Errorf("message cosmos.gov.v1.DepositParams does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_DepositParams) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "cosmos.gov.v1.DepositParams.min_deposit":
+		if len(x.MinDeposit) == 0 {
+			return protoreflect.ValueOfList(&_DepositParams_1_list{})
+		}
+		listValue := &_DepositParams_1_list{list: &x.MinDeposit}
+		return protoreflect.ValueOfList(listValue)
+	case "cosmos.gov.v1.DepositParams.max_deposit_period":
+		value := x.MaxDepositPeriod
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.gov.v1.DepositParams"))
+		}
+		panic(fmt.Errorf("message cosmos.gov.v1.DepositParams does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_DepositParams) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "cosmos.gov.v1.DepositParams.min_deposit":
+		lv := value.List()
+		clv := lv.(*_DepositParams_1_list)
+		x.MinDeposit = *clv.list
+	case "cosmos.gov.v1.DepositParams.max_deposit_period":
+		x.MaxDepositPeriod = value.Message().Interface().(*durationpb.Duration)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.gov.v1.DepositParams"))
+		}
+		panic(fmt.Errorf("message cosmos.gov.v1.DepositParams does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_DepositParams) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "cosmos.gov.v1.DepositParams.min_deposit":
+		if x.MinDeposit == nil {
+			x.MinDeposit = []*v1beta1.Coin{}
+		}
+		value := &_DepositParams_1_list{list: &x.MinDeposit}
+		return protoreflect.ValueOfList(value)
+	case "cosmos.gov.v1.DepositParams.max_deposit_period":
+		if x.MaxDepositPeriod == nil {
+			x.MaxDepositPeriod = new(durationpb.Duration)
+		}
+		return protoreflect.ValueOfMessage(x.MaxDepositPeriod.ProtoReflect())
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.gov.v1.DepositParams"))
+		}
+		panic(fmt.Errorf("message cosmos.gov.v1.DepositParams does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_DepositParams) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "cosmos.gov.v1.DepositParams.min_deposit":
+		list := []*v1beta1.Coin{}
+		return protoreflect.ValueOfList(&_DepositParams_1_list{list: &list})
+	case "cosmos.gov.v1.DepositParams.max_deposit_period":
+		m := new(durationpb.Duration)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.gov.v1.DepositParams"))
+		}
+		panic(fmt.Errorf("message cosmos.gov.v1.DepositParams does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_DepositParams) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in cosmos.gov.v1.DepositParams", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_DepositParams) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_DepositParams) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_DepositParams) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_DepositParams) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*DepositParams)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if len(x.MinDeposit) > 0 {
+			for _, e := range x.MinDeposit {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if x.MaxDepositPeriod != nil {
+			l = options.Size(x.MaxDepositPeriod)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*DepositParams)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if x.MaxDepositPeriod != nil {
+			encoded, err := options.Marshal(x.MaxDepositPeriod)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x12
+		}
+		if len(x.MinDeposit) > 0 {
+			for iNdEx := len(x.MinDeposit) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.MinDeposit[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0xa
+			}
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*DepositParams)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: DepositParams: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: DepositParams: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field MinDeposit", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.MinDeposit = append(x.MinDeposit, &v1beta1.Coin{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.MinDeposit[len(x.MinDeposit)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field MaxDepositPeriod", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.MaxDepositPeriod == nil {
+					x.MaxDepositPeriod = &durationpb.Duration{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.MaxDepositPeriod); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_VotingParams               protoreflect.MessageDescriptor
+	fd_VotingParams_voting_period protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_cosmos_gov_v1_gov_proto_init()
+	md_VotingParams = File_cosmos_gov_v1_gov_proto.Messages().ByName("VotingParams")
+	fd_VotingParams_voting_period = md_VotingParams.Fields().ByName("voting_period")
+}
+
+var _ protoreflect.Message = (*fastReflection_VotingParams)(nil)
+
+type fastReflection_VotingParams VotingParams
+
+func (x *VotingParams) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_VotingParams)(x)
+}
+
+func (x *VotingParams) slowProtoReflect() protoreflect.Message {
+	mi := &file_cosmos_gov_v1_gov_proto_msgTypes[14]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_VotingParams_messageType fastReflection_VotingParams_messageType
+var _ protoreflect.MessageType = fastReflection_VotingParams_messageType{}
+
+type fastReflection_VotingParams_messageType struct{}
+
+func (x fastReflection_VotingParams_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_VotingParams)(nil)
+}
+func (x fastReflection_VotingParams_messageType) New() protoreflect.Message {
+	return new(fastReflection_VotingParams)
+}
+func (x fastReflection_VotingParams_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_VotingParams
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_VotingParams) Descriptor() protoreflect.MessageDescriptor {
+	return md_VotingParams
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_VotingParams) Type() protoreflect.MessageType {
+	return _fastReflection_VotingParams_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_VotingParams) New() protoreflect.Message {
+	return new(fastReflection_VotingParams)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_VotingParams) Interface() protoreflect.ProtoMessage {
+	return (*VotingParams)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_VotingParams) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.VotingPeriod != nil {
+		value := protoreflect.ValueOfMessage(x.VotingPeriod.ProtoReflect())
+		if !f(fd_VotingParams_voting_period, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_VotingParams) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "cosmos.gov.v1.VotingParams.voting_period":
+		return x.VotingPeriod != nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.gov.v1.VotingParams"))
+		}
+		panic(fmt.Errorf("message cosmos.gov.v1.VotingParams does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_VotingParams) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "cosmos.gov.v1.VotingParams.voting_period":
+		x.VotingPeriod = nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.gov.v1.VotingParams"))
+		}
+		panic(fmt.Errorf("message cosmos.gov.v1.VotingParams does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_VotingParams) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "cosmos.gov.v1.VotingParams.voting_period":
+		value := x.VotingPeriod
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.gov.v1.VotingParams"))
+		}
+		panic(fmt.Errorf("message cosmos.gov.v1.VotingParams does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_VotingParams) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "cosmos.gov.v1.VotingParams.voting_period":
+		x.VotingPeriod = value.Message().Interface().(*durationpb.Duration)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.gov.v1.VotingParams"))
+		}
+		panic(fmt.Errorf("message cosmos.gov.v1.VotingParams does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_VotingParams) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "cosmos.gov.v1.VotingParams.voting_period":
+		if x.VotingPeriod == nil {
+			x.VotingPeriod = new(durationpb.Duration)
+		}
+		return protoreflect.ValueOfMessage(x.VotingPeriod.ProtoReflect())
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.gov.v1.VotingParams"))
+		}
+		panic(fmt.Errorf("message cosmos.gov.v1.VotingParams does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_VotingParams) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "cosmos.gov.v1.VotingParams.voting_period":
+		m := new(durationpb.Duration)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.gov.v1.VotingParams"))
+		}
+		panic(fmt.Errorf("message cosmos.gov.v1.VotingParams does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_VotingParams) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in cosmos.gov.v1.VotingParams", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_VotingParams) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_VotingParams) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_VotingParams) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_VotingParams) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*VotingParams)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if x.VotingPeriod != nil {
+			l = options.Size(x.VotingPeriod)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*VotingParams)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if x.VotingPeriod != nil {
+			encoded, err := options.Marshal(x.VotingPeriod)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*VotingParams)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: VotingParams: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: VotingParams: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field VotingPeriod", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.VotingPeriod == nil {
+					x.VotingPeriod = &durationpb.Duration{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.VotingPeriod); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var _ protoreflect.List = (*_TallyParams_5_list)(nil)
+
+type _TallyParams_5_list struct {
+	list *[]*ProposalKindQuorum
+}
+
+func (x *_TallyParams_5_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_TallyParams_5_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_TallyParams_5_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*ProposalKindQuorum)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_TallyParams_5_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*ProposalKindQuorum)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_TallyParams_5_list) AppendMutable() protoreflect.Value {
+	v := new(ProposalKindQuorum)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_TallyParams_5_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_TallyParams_5_list) NewElement() protoreflect.Value {
+	v := new(ProposalKindQuorum)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_TallyParams_5_list) IsValid() bool {
+	return x.list != nil
+}
+
+var (
+	md_TallyParams                       protoreflect.MessageDescriptor
+	fd_TallyParams_quorum                protoreflect.FieldDescriptor
+	fd_TallyParams_threshold             protoreflect.FieldDescriptor
+	fd_TallyParams_veto_threshold        protoreflect.FieldDescriptor
+	fd_TallyParams_veto_quorum           protoreflect.FieldDescriptor
+	fd_TallyParams_proposal_kind_quorums protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_cosmos_gov_v1_gov_proto_init()
+	md_TallyParams = File_cosmos_gov_v1_gov_proto.Messages().ByName("TallyParams")
+	fd_TallyParams_quorum = md_TallyParams.Fields().ByName("quorum")
+	fd_TallyParams_threshold = md_TallyParams.Fields().ByName("threshold")
+	fd_TallyParams_veto_threshold = md_TallyParams.Fields().ByName("veto_threshold")
+	fd_TallyParams_veto_quorum = md_TallyParams.Fields().ByName("veto_quorum")
+	fd_TallyParams_proposal_kind_quorums = md_TallyParams.Fields().ByName("proposal_kind_quorums")
+}
+
+var _ protoreflect.Message = (*fastReflection_TallyParams)(nil)
+
+type fastReflection_TallyParams TallyParams
+
+func (x *TallyParams) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_TallyParams)(x)
+}
+
+func (x *TallyParams) slowProtoReflect() protoreflect.Message {
+	mi := &file_cosmos_gov_v1_gov_proto_msgTypes[15]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_TallyParams_messageType fastReflection_TallyParams_messageType
+var _ protoreflect.MessageType = fastReflection_TallyParams_messageType{}
+
+type fastReflection_TallyParams_messageType struct{}
+
+func (x fastReflection_TallyParams_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_TallyParams)(nil)
+}
+func (x fastReflection_TallyParams_messageType) New() protoreflect.Message {
+	return new(fastReflection_TallyParams)
+}
+func (x fastReflection_TallyParams_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_TallyParams
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_TallyParams) Descriptor() protoreflect.MessageDescriptor {
+	return md_TallyParams
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_TallyParams) Type() protoreflect.MessageType {
+	return _fastReflection_TallyParams_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_TallyParams) New() protoreflect.Message {
+	return new(fastReflection_TallyParams)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_TallyParams) Interface() protoreflect.ProtoMessage {
+	return (*TallyParams)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_TallyParams) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Quorum != "" {
+		value := protoreflect.ValueOfString(x.Quorum)
+		if !f(fd_TallyParams_quorum, value) {
+			return
+		}
+	}
+	if x.Threshold != "" {
+		value := protoreflect.ValueOfString(x.Threshold)
+		if !f(fd_TallyParams_threshold, value) {
+			return
+		}
+	}
+	if x.VetoThreshold != "" {
+		value := protoreflect.ValueOfString(x.VetoThreshold)
+		if !f(fd_TallyParams_veto_threshold, value) {
+			return
+		}
+	}
+	if x.VetoQuorum != "" {
+		value := protoreflect.ValueOfString(x.VetoQuorum)
+		if !f(fd_TallyParams_veto_quorum, value) {
+			return
+		}
+	}
+	if len(x.ProposalKindQuorums) != 0 {
+		value := protoreflect.ValueOfList(&_TallyParams_5_list{list: &x.ProposalKindQuorums})
+		if !f(fd_TallyParams_proposal_kind_quorums, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_TallyParams) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "cosmos.gov.v1.TallyParams.quorum":
+		return x.Quorum != ""
+	case "cosmos.gov.v1.TallyParams.threshold":
+		return x.Threshold != ""
+	case "cosmos.gov.v1.TallyParams.veto_threshold":
+		return x.VetoThreshold != ""
+	case "cosmos.gov.v1.TallyParams.veto_quorum":
+		return x.VetoQuorum != ""
+	case "cosmos.gov.v1.TallyParams.proposal_kind_quorums":
+		return len(x.ProposalKindQuorums) != 0
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.gov.v1.TallyParams"))
+		}
+		panic(fmt.Errorf("message cosmos.gov.v1.TallyParams does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_TallyParams) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "cosmos.gov.v1.TallyParams.quorum":
+		x.Quorum = ""
+	case "cosmos.gov.v1.TallyParams.threshold":
+		x.Threshold = ""
+	case "cosmos.gov.v1.TallyParams.veto_threshold":
+		x.VetoThreshold = ""
+	case "cosmos.gov.v1.TallyParams.veto_quorum":
+		x.VetoQuorum = ""
+	case "cosmos.gov.v1.TallyParams.proposal_kind_quorums":
+		x.ProposalKindQuorums = nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.gov.v1.TallyParams"))
+		}
+		panic(fmt.Errorf("message cosmos.gov.v1.TallyParams does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_TallyParams) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "cosmos.gov.v1.TallyParams.quorum":
+		value := x.Quorum
+		return protoreflect.ValueOfString(value)
+	case "cosmos.gov.v1.TallyParams.threshold":
+		value := x.Threshold
+		return protoreflect.ValueOfString(value)
+	case "cosmos.gov.v1.TallyParams.veto_threshold":
+		value := x.VetoThreshold
+		return protoreflect.ValueOfString(value)
+	case "cosmos.gov.v1.TallyParams.veto_quorum":
+		value := x.VetoQuorum
+		return protoreflect.ValueOfString(value)
+	case "cosmos.gov.v1.TallyParams.proposal_kind_quorums":
+		if len(x.ProposalKindQuorums) == 0 {
+			return protoreflect.ValueOfList(&_TallyParams_5_list{})
+		}
+		listValue := &_TallyParams_5_list{list: &x.ProposalKindQuorums}
+		return protoreflect.ValueOfList(listValue)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.gov.v1.TallyParams"))
+		}
+		panic(fmt.Errorf("message cosmos.gov.v1.TallyParams does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_TallyParams) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "cosmos.gov.v1.TallyParams.quorum":
+		x.Quorum = value.Interface().(string)
+	case "cosmos.gov.v1.TallyParams.threshold":
+		x.Threshold = value.Interface().(string)
+	case "cosmos.gov.v1.TallyParams.veto_threshold":
+		x.VetoThreshold = value.Interface().(string)
+	case "cosmos.gov.v1.TallyParams.veto_quorum":
+		x.VetoQuorum = value.Interface().(string)
+	case "cosmos.gov.v1.TallyParams.proposal_kind_quorums":
+		lv := value.List()
+		clv := lv.(*_TallyParams_5_list)
+		x.ProposalKindQuorums = *clv.list
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.gov.v1.TallyParams"))
+		}
+		panic(fmt.Errorf("message cosmos.gov.v1.TallyParams does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_TallyParams) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "cosmos.gov.v1.TallyParams.proposal_kind_quorums":
+		if x.ProposalKindQuorums == nil {
+			x.ProposalKindQuorums = []*ProposalKindQuorum{}
+		}
+		value := &_TallyParams_5_list{list: &x.ProposalKindQuorums}
+		return protoreflect.ValueOfList(value)
+	case "cosmos.gov.v1.TallyParams.quorum":
+		panic(fmt.Errorf("field quorum of message cosmos.gov.v1.TallyParams is not mutable"))
+	case "cosmos.gov.v1.TallyParams.threshold":
+		panic(fmt.Errorf("field threshold of message cosmos.gov.v1.TallyParams is not mutable"))
+	case "cosmos.gov.v1.TallyParams.veto_threshold":
+		panic(fmt.Errorf("field veto_threshold of message cosmos.gov.v1.TallyParams is not mutable"))
+	case "cosmos.gov.v1.TallyParams.veto_quorum":
+		panic(fmt.Errorf("field veto_quorum of message cosmos.gov.v1.TallyParams is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.gov.v1.TallyParams"))
+		}
+		panic(fmt.Errorf("message cosmos.gov.v1.TallyParams does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_TallyParams) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "cosmos.gov.v1.TallyParams.quorum":
+		return protoreflect.ValueOfString("")
+	case "cosmos.gov.v1.TallyParams.threshold":
+		return protoreflect.ValueOfString("")
+	case "cosmos.gov.v1.TallyParams.veto_threshold":
+		return protoreflect.ValueOfString("")
+	case "cosmos.gov.v1.TallyParams.veto_quorum":
+		return protoreflect.ValueOfString("")
+	case "cosmos.gov.v1.TallyParams.proposal_kind_quorums":
+		list := []*ProposalKindQuorum{}
+		return protoreflect.ValueOfList(&_TallyParams_5_list{list: &list})
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.gov.v1.TallyParams"))
+		}
+		panic(fmt.Errorf("message cosmos.gov.v1.TallyParams does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_TallyParams) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in cosmos.gov.v1.TallyParams", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_TallyParams) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_TallyParams) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_TallyParams) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_TallyParams) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*TallyParams)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		l = len(x.Quorum)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.Threshold)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.VetoThreshold)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.VetoQuorum)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if len(x.ProposalKindQuorums) > 0 {
+			for _, e := range x.ProposalKindQuorums {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*TallyParams)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if len(x.ProposalKindQuorums) > 0 {
+			for iNdEx := len(x.ProposalKindQuorums) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.ProposalKindQuorums[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x2a
+			}
+		}
+		if len(x.VetoQuorum) > 0 {
+			i -= len(x.VetoQuorum)
+			copy(dAtA[i:], x.VetoQuorum)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.VetoQuorum)))
+			i--
+			dAtA[i] = 0x22
+		}
+		if len(x.VetoThreshold) > 0 {
+			i -= len(x.VetoThreshold)
+			copy(dAtA[i:], x.VetoThreshold)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.VetoThreshold)))
+			i--
+			dAtA[i] = 0x1a
+		}
+		if len(x.Threshold) > 0 {
+			i -= len(x.Threshold)
+			copy(dAtA[i:], x.Threshold)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Threshold)))
+			i--
+			dAtA[i] = 0x12
+		}
+		if len(x.Quorum) > 0 {
+			i -= len(x.Quorum)
+			copy(dAtA[i:], x.Quorum)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Quorum)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*TallyParams)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: TallyParams: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: TallyParams: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Quorum", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Quorum = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Threshold", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Threshold = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 3:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field VetoThreshold", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.VetoThreshold = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 4:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field VetoQuorum", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.VetoQuorum = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 5:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ProposalKindQuorums", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.ProposalKindQuorums = append(x.ProposalKindQuorums, &ProposalKindQuorum{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.ProposalKindQuorums[len(x.ProposalKindQuorums)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var _ protoreflect.List = (*_Params_1_list)(nil)
+
+type _Params_1_list struct {
+	list *[]*v1beta1.Coin
+}
+
+func (x *_Params_1_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_Params_1_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_Params_1_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*v1beta1.Coin)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_Params_1_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*v1beta1.Coin)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_Params_1_list) AppendMutable() protoreflect.Value {
+	v := new(v1beta1.Coin)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_Params_1_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_Params_1_list) NewElement() protoreflect.Value {
+	v := new(v1beta1.Coin)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_Params_1_list) IsValid() bool {
+	return x.list != nil
+}
+
+var _ protoreflect.List = (*_Params_12_list)(nil)
+
+type _Params_12_list struct {
+	list *[]*v1beta1.Coin
+}
+
+func (x *_Params_12_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_Params_12_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_Params_12_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*v1beta1.Coin)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_Params_12_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*v1beta1.Coin)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_Params_12_list) AppendMutable() protoreflect.Value {
+	v := new(v1beta1.Coin)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_Params_12_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_Params_12_list) NewElement() protoreflect.Value {
+	v := new(v1beta1.Coin)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_Params_12_list) IsValid() bool {
+	return x.list != nil
+}
+
+var _ protoreflect.List = (*_Params_18_list)(nil)
+
+type _Params_18_list struct {
+	list *[]string
+}
+
+func (x *_Params_18_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_Params_18_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfString((*x.list)[i])
+}
+
+func (x *_Params_18_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.String()
+	concreteValue := valueUnwrapped
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_Params_18_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.String()
+	concreteValue := valueUnwrapped
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_Params_18_list) AppendMutable() protoreflect.Value {
+	panic(fmt.Errorf("AppendMutable can not be called on message Params at list field ExecutionAuthorities as it is not of Message kind"))
+}
+
+func (x *_Params_18_list) Truncate(n int) {
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_Params_18_list) NewElement() protoreflect.Value {
+	v := ""
+	return protoreflect.ValueOfString(v)
+}
+
+func (x *_Params_18_list) IsValid() bool {
+	return x.list != nil
+}
+
+var _ protoreflect.List = (*_Params_24_list)(nil)
+
+type _Params_24_list struct {
+	list *[]*AcceptedDepositDenom
+}
+
+func (x *_Params_24_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_Params_24_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_Params_24_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*AcceptedDepositDenom)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_Params_24_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*AcceptedDepositDenom)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_Params_24_list) AppendMutable() protoreflect.Value {
+	v := new(AcceptedDepositDenom)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_Params_24_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_Params_24_list) NewElement() protoreflect.Value {
+	v := new(AcceptedDepositDenom)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_Params_24_list) IsValid() bool {
+	return x.list != nil
+}
+
+var _ protoreflect.List = (*_Params_30_list)(nil)
+
+type _Params_30_list struct {
+	list *[]*ProposalKindQuorum
+}
+
+func (x *_Params_30_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_Params_30_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_Params_30_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*ProposalKindQuorum)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_Params_30_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*ProposalKindQuorum)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_Params_30_list) AppendMutable() protoreflect.Value {
+	v := new(ProposalKindQuorum)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_Params_30_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_Params_30_list) NewElement() protoreflect.Value {
+	v := new(ProposalKindQuorum)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_Params_30_list) IsValid() bool {
+	return x.list != nil
+}
+
+var (
+	md_Params                                       protoreflect.MessageDescriptor
+	fd_Params_min_deposit                           protoreflect.FieldDescriptor
+	fd_Params_max_deposit_period                    protoreflect.FieldDescriptor
+	fd_Params_voting_period                         protoreflect.FieldDescriptor
+	fd_Params_quorum                                protoreflect.FieldDescriptor
+	fd_Params_threshold                             protoreflect.FieldDescriptor
+	fd_Params_veto_threshold                        protoreflect.FieldDescriptor
+	fd_Params_min_initial_deposit_ratio             protoreflect.FieldDescriptor
+	fd_Params_proposal_cancel_ratio                 protoreflect.FieldDescriptor
+	fd_Params_proposal_cancel_dest                  protoreflect.FieldDescriptor
+	fd_Params_expedited_voting_period               protoreflect.FieldDescriptor
+	fd_Params_expedited_threshold                   protoreflect.FieldDescriptor
+	fd_Params_expedited_min_deposit                 protoreflect.FieldDescriptor
+	fd_Params_burn_vote_quorum                      protoreflect.FieldDescriptor
+	fd_Params_burn_proposal_deposit_prevote         protoreflect.FieldDescriptor
+	fd_Params_burn_vote_veto                        protoreflect.FieldDescriptor
+	fd_Params_deposit_extension_ratio               protoreflect.FieldDescriptor
+	fd_Params_deposit_extension_period              protoreflect.FieldDescriptor
+	fd_Params_execution_authorities                 protoreflect.FieldDescriptor
+	fd_Params_discussion_period                     protoreflect.FieldDescriptor
+	fd_Params_early_resolution                      protoreflect.FieldDescriptor
+	fd_Params_max_proposals_processed_per_end_block protoreflect.FieldDescriptor
+	fd_Params_constitution_amendment_threshold      protoreflect.FieldDescriptor
+	fd_Params_abstain_semantics                     protoreflect.FieldDescriptor
+	fd_Params_accepted_deposit_denoms               protoreflect.FieldDescriptor
+	fd_Params_amendment_period                      protoreflect.FieldDescriptor
+	fd_Params_participation_exemption_threshold     protoreflect.FieldDescriptor
+	fd_Params_min_deposit_any_denom                 protoreflect.FieldDescriptor
+	fd_Params_reveal_period                         protoreflect.FieldDescriptor
+	fd_Params_veto_quorum                           protoreflect.FieldDescriptor
+	fd_Params_proposal_kind_quorums                 protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_cosmos_gov_v1_gov_proto_init()
+	md_Params = File_cosmos_gov_v1_gov_proto.Messages().ByName("Params")
+	fd_Params_min_deposit = md_Params.Fields().ByName("min_deposit")
+	fd_Params_max_deposit_period = md_Params.Fields().ByName("max_deposit_period")
+	fd_Params_voting_period = md_Params.Fields().ByName("voting_period")
+	fd_Params_quorum = md_Params.Fields().ByName("quorum")
+	fd_Params_threshold = md_Params.Fields().ByName("threshold")
+	fd_Params_veto_threshold = md_Params.Fields().ByName("veto_threshold")
+	fd_Params_min_initial_deposit_ratio = md_Params.Fields().ByName("min_initial_deposit_ratio")
+	fd_Params_proposal_cancel_ratio = md_Params.Fields().ByName("proposal_cancel_ratio")
+	fd_Params_proposal_cancel_dest = md_Params.Fields().ByName("proposal_cancel_dest")
+	fd_Params_expedited_voting_period = md_Params.Fields().ByName("expedited_voting_period")
+	fd_Params_expedited_threshold = md_Params.Fields().ByName("expedited_threshold")
+	fd_Params_expedited_min_deposit = md_Params.Fields().ByName("expedited_min_deposit")
+	fd_Params_burn_vote_quorum = md_Params.Fields().ByName("burn_vote_quorum")
+	fd_Params_burn_proposal_deposit_prevote = md_Params.Fields().ByName("burn_proposal_deposit_prevote")
+	fd_Params_burn_vote_veto = md_Params.Fields().ByName("burn_vote_veto")
+	fd_Params_deposit_extension_ratio = md_Params.Fields().ByName("deposit_extension_ratio")
+	fd_Params_deposit_extension_period = md_Params.Fields().ByName("deposit_extension_period")
+	fd_Params_execution_authorities = md_Params.Fields().ByName("execution_authorities")
+	fd_Params_discussion_period = md_Params.Fields().ByName("discussion_period")
+	fd_Params_early_resolution = md_Params.Fields().ByName("early_resolution")
+	fd_Params_max_proposals_processed_per_end_block = md_Params.Fields().ByName("max_proposals_processed_per_end_block")
+	fd_Params_constitution_amendment_threshold = md_Params.Fields().ByName("constitution_amendment_threshold")
+	fd_Params_abstain_semantics = md_Params.Fields().ByName("abstain_semantics")
+	fd_Params_accepted_deposit_denoms = md_Params.Fields().ByName("accepted_deposit_denoms")
+	fd_Params_amendment_period = md_Params.Fields().ByName("amendment_period")
+	fd_Params_participation_exemption_threshold = md_Params.Fields().ByName("participation_exemption_threshold")
+	fd_Params_min_deposit_any_denom = md_Params.Fields().ByName("min_deposit_any_denom")
+	fd_Params_reveal_period = md_Params.Fields().ByName("reveal_period")
+	fd_Params_veto_quorum = md_Params.Fields().ByName("veto_quorum")
+	fd_Params_proposal_kind_quorums = md_Params.Fields().ByName("proposal_kind_quorums")
+}
+
+var _ protoreflect.Message = (*fastReflection_Params)(nil)
+
+type fastReflection_Params Params
+
+func (x *Params) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_Params)(x)
+}
+
+func (x *Params) slowProtoReflect() protoreflect.Message {
+	mi := &file_cosmos_gov_v1_gov_proto_msgTypes[16]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_Params_messageType fastReflection_Params_messageType
+var _ protoreflect.MessageType = fastReflection_Params_messageType{}
+
+type fastReflection_Params_messageType struct{}
+
+func (x fastReflection_Params_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_Params)(nil)
+}
+func (x fastReflection_Params_messageType) New() protoreflect.Message {
+	return new(fastReflection_Params)
+}
+func (x fastReflection_Params_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_Params
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_Params) Descriptor() protoreflect.MessageDescriptor {
+	return md_Params
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_Params) Type() protoreflect.MessageType {
+	return _fastReflection_Params_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_Params) New() protoreflect.Message {
+	return new(fastReflection_Params)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_Params) Interface() protoreflect.ProtoMessage {
+	return (*Params)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_Params) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if len(x.MinDeposit) != 0 {
+		value := protoreflect.ValueOfList(&_Params_1_list{list: &x.MinDeposit})
+		if !f(fd_Params_min_deposit, value) {
+			return
+		}
+	}
+	if x.MaxDepositPeriod != nil {
+		value := protoreflect.ValueOfMessage(x.MaxDepositPeriod.ProtoReflect())
+		if !f(fd_Params_max_deposit_period, value) {
+			return
+		}
+	}
+	if x.VotingPeriod != nil {
+		value := protoreflect.ValueOfMessage(x.VotingPeriod.ProtoReflect())
+		if !f(fd_Params_voting_period, value) {
+			return
+		}
+	}
+	if x.Quorum != "" {
+		value := protoreflect.ValueOfString(x.Quorum)
+		if !f(fd_Params_quorum, value) {
+			return
+		}
+	}
+	if x.Threshold != "" {
+		value := protoreflect.ValueOfString(x.Threshold)
+		if !f(fd_Params_threshold, value) {
+			return
+		}
+	}
+	if x.VetoThreshold != "" {
+		value := protoreflect.ValueOfString(x.VetoThreshold)
+		if !f(fd_Params_veto_threshold, value) {
+			return
+		}
+	}
+	if x.MinInitialDepositRatio != "" {
+		value := protoreflect.ValueOfString(x.MinInitialDepositRatio)
+		if !f(fd_Params_min_initial_deposit_ratio, value) {
+			return
+		}
+	}
+	if x.ProposalCancelRatio != "" {
+		value := protoreflect.ValueOfString(x.ProposalCancelRatio)
+		if !f(fd_Params_proposal_cancel_ratio, value) {
+			return
+		}
+	}
+	if x.ProposalCancelDest != "" {
+		value := protoreflect.ValueOfString(x.ProposalCancelDest)
+		if !f(fd_Params_proposal_cancel_dest, value) {
+			return
+		}
+	}
+	if x.ExpeditedVotingPeriod != nil {
+		value := protoreflect.ValueOfMessage(x.ExpeditedVotingPeriod.ProtoReflect())
+		if !f(fd_Params_expedited_voting_period, value) {
+			return
+		}
+	}
+	if x.ExpeditedThreshold != "" {
+		value := protoreflect.ValueOfString(x.ExpeditedThreshold)
+		if !f(fd_Params_expedited_threshold, value) {
+			return
+		}
+	}
+	if len(x.ExpeditedMinDeposit) != 0 {
+		value := protoreflect.ValueOfList(&_Params_12_list{list: &x.ExpeditedMinDeposit})
+		if !f(fd_Params_expedited_min_deposit, value) {
+			return
+		}
+	}
+	if x.BurnVoteQuorum != false {
+		value := protoreflect.ValueOfBool(x.BurnVoteQuorum)
+		if !f(fd_Params_burn_vote_quorum, value) {
+			return
+		}
+	}
+	if x.BurnProposalDepositPrevote != false {
+		value := protoreflect.ValueOfBool(x.BurnProposalDepositPrevote)
+		if !f(fd_Params_burn_proposal_deposit_prevote, value) {
+			return
+		}
+	}
+	if x.BurnVoteVeto != false {
+		value := protoreflect.ValueOfBool(x.BurnVoteVeto)
+		if !f(fd_Params_burn_vote_veto, value) {
+			return
+		}
+	}
+	if x.DepositExtensionRatio != "" {
+		value := protoreflect.ValueOfString(x.DepositExtensionRatio)
+		if !f(fd_Params_deposit_extension_ratio, value) {
+			return
+		}
+	}
+	if x.DepositExtensionPeriod != nil {
+		value := protoreflect.ValueOfMessage(x.DepositExtensionPeriod.ProtoReflect())
+		if !f(fd_Params_deposit_extension_period, value) {
+			return
+		}
+	}
+	if len(x.ExecutionAuthorities) != 0 {
+		value := protoreflect.ValueOfList(&_Params_18_list{list: &x.ExecutionAuthorities})
+		if !f(fd_Params_execution_authorities, value) {
+			return
+		}
+	}
+	if x.DiscussionPeriod != nil {
+		value := protoreflect.ValueOfMessage(x.DiscussionPeriod.ProtoReflect())
+		if !f(fd_Params_discussion_period, value) {
+			return
+		}
+	}
+	if x.EarlyResolution != false {
+		value := protoreflect.ValueOfBool(x.EarlyResolution)
+		if !f(fd_Params_early_resolution, value) {
+			return
+		}
+	}
+	if x.MaxProposalsProcessedPerEndBlock != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.MaxProposalsProcessedPerEndBlock)
+		if !f(fd_Params_max_proposals_processed_per_end_block, value) {
+			return
+		}
+	}
+	if x.ConstitutionAmendmentThreshold != "" {
+		value := protoreflect.ValueOfString(x.ConstitutionAmendmentThreshold)
+		if !f(fd_Params_constitution_amendment_threshold, value) {
+			return
+		}
+	}
+	if x.AbstainSemantics != 0 {
+		value := protoreflect.ValueOfEnum((protoreflect.EnumNumber)(x.AbstainSemantics))
+		if !f(fd_Params_abstain_semantics, value) {
+			return
+		}
+	}
+	if len(x.AcceptedDepositDenoms) != 0 {
+		value := protoreflect.ValueOfList(&_Params_24_list{list: &x.AcceptedDepositDenoms})
+		if !f(fd_Params_accepted_deposit_denoms, value) {
+			return
+		}
+	}
+	if x.AmendmentPeriod != nil {
+		value := protoreflect.ValueOfMessage(x.AmendmentPeriod.ProtoReflect())
+		if !f(fd_Params_amendment_period, value) {
+			return
+		}
+	}
+	if x.ParticipationExemptionThreshold != "" {
+		value := protoreflect.ValueOfString(x.ParticipationExemptionThreshold)
+		if !f(fd_Params_participation_exemption_threshold, value) {
+			return
+		}
+	}
+	if x.MinDepositAnyDenom != false {
+		value := protoreflect.ValueOfBool(x.MinDepositAnyDenom)
+		if !f(fd_Params_min_deposit_any_denom, value) {
+			return
+		}
+	}
+	if x.RevealPeriod != nil {
+		value := protoreflect.ValueOfMessage(x.RevealPeriod.ProtoReflect())
+		if !f(fd_Params_reveal_period, value) {
+			return
+		}
+	}
+	if x.VetoQuorum != "" {
+		value := protoreflect.ValueOfString(x.VetoQuorum)
+		if !f(fd_Params_veto_quorum, value) {
+			return
+		}
+	}
+	if len(x.ProposalKindQuorums) != 0 {
+		value := protoreflect.ValueOfList(&_Params_30_list{list: &x.ProposalKindQuorums})
+		if !f(fd_Params_proposal_kind_quorums, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_Params) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "cosmos.gov.v1.Params.min_deposit":
+		return len(x.MinDeposit) != 0
+	case "cosmos.gov.v1.Params.max_deposit_period":
+		return x.MaxDepositPeriod != nil
+	case "cosmos.gov.v1.Params.voting_period":
+		return x.VotingPeriod != nil
+	case "cosmos.gov.v1.Params.quorum":
+		return x.Quorum != ""
+	case "cosmos.gov.v1.Params.threshold":
+		return x.Threshold != ""
+	case "cosmos.gov.v1.Params.veto_threshold":
+		return x.VetoThreshold != ""
+	case "cosmos.gov.v1.Params.min_initial_deposit_ratio":
+		return x.MinInitialDepositRatio != ""
+	case "cosmos.gov.v1.Params.proposal_cancel_ratio":
+		return x.ProposalCancelRatio != ""
+	case "cosmos.gov.v1.Params.proposal_cancel_dest":
+		return x.ProposalCancelDest != ""
+	case "cosmos.gov.v1.Params.expedited_voting_period":
+		return x.ExpeditedVotingPeriod != nil
+	case "cosmos.gov.v1.Params.expedited_threshold":
+		return x.ExpeditedThreshold != ""
+	case "cosmos.gov.v1.Params.expedited_min_deposit":
+		return len(x.ExpeditedMinDeposit) != 0
+	case "cosmos.gov.v1.Params.burn_vote_quorum":
+		return x.BurnVoteQuorum != false
+	case "cosmos.gov.v1.Params.burn_proposal_deposit_prevote":
+		return x.BurnProposalDepositPrevote != false
+	case "cosmos.gov.v1.Params.burn_vote_veto":
+		return x.BurnVoteVeto != false
+	case "cosmos.gov.v1.Params.deposit_extension_ratio":
+		return x.DepositExtensionRatio != ""
+	case "cosmos.gov.v1.Params.deposit_extension_period":
+		return x.DepositExtensionPeriod != nil
+	case "cosmos.gov.v1.Params.execution_authorities":
+		return len(x.ExecutionAuthorities) != 0
+	case "cosmos.gov.v1.Params.discussion_period":
+		return x.DiscussionPeriod != nil
+	case "cosmos.gov.v1.Params.early_resolution":
+		return x.EarlyResolution != false
+	case "cosmos.gov.v1.Params.max_proposals_processed_per_end_block":
+		return x.MaxProposalsProcessedPerEndBlock != uint64(0)
+	case "cosmos.gov.v1.Params.constitution_amendment_threshold":
+		return x.ConstitutionAmendmentThreshold != ""
+	case "cosmos.gov.v1.Params.abstain_semantics":
+		return x.AbstainSemantics != 0
+	case "cosmos.gov.v1.Params.accepted_deposit_denoms":
+		return len(x.AcceptedDepositDenoms) != 0
+	case "cosmos.gov.v1.Params.amendment_period":
+		return x.AmendmentPeriod != nil
+	case "cosmos.gov.v1.Params.participation_exemption_threshold":
+		return x.ParticipationExemptionThreshold != ""
+	case "cosmos.gov.v1.Params.min_deposit_any_denom":
+		return x.MinDepositAnyDenom != false
+	case "cosmos.gov.v1.Params.reveal_period":
+		return x.RevealPeriod != nil
+	case "cosmos.gov.v1.Params.veto_quorum":
+		return x.VetoQuorum != ""
+	case "cosmos.gov.v1.Params.proposal_kind_quorums":
+		return len(x.ProposalKindQuorums) != 0
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.gov.v1.Params"))
+		}
+		panic(fmt.Errorf("message cosmos.gov.v1.Params does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_Params) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "cosmos.gov.v1.Params.min_deposit":
+		x.MinDeposit = nil
+	case "cosmos.gov.v1.Params.max_deposit_period":
+		x.MaxDepositPeriod = nil
+	case "cosmos.gov.v1.Params.voting_period":
+		x.VotingPeriod = nil
+	case "cosmos.gov.v1.Params.quorum":
+		x.Quorum = ""
+	case "cosmos.gov.v1.Params.threshold":
+		x.Threshold = ""
+	case "cosmos.gov.v1.Params.veto_threshold":
+		x.VetoThreshold = ""
+	case "cosmos.gov.v1.Params.min_initial_deposit_ratio":
+		x.MinInitialDepositRatio = ""
+	case "cosmos.gov.v1.Params.proposal_cancel_ratio":
+		x.ProposalCancelRatio = ""
+	case "cosmos.gov.v1.Params.proposal_cancel_dest":
+		x.ProposalCancelDest = ""
+	case "cosmos.gov.v1.Params.expedited_voting_period":
+		x.ExpeditedVotingPeriod = nil
+	case "cosmos.gov.v1.Params.expedited_threshold":
+		x.ExpeditedThreshold = ""
+	case "cosmos.gov.v1.Params.expedited_min_deposit":
+		x.ExpeditedMinDeposit = nil
+	case "cosmos.gov.v1.Params.burn_vote_quorum":
+		x.BurnVoteQuorum = false
+	case "cosmos.gov.v1.Params.burn_proposal_deposit_prevote":
+		x.BurnProposalDepositPrevote = false
+	case "cosmos.gov.v1.Params.burn_vote_veto":
+		x.BurnVoteVeto = false
+	case "cosmos.gov.v1.Params.deposit_extension_ratio":
+		x.DepositExtensionRatio = ""
+	case "cosmos.gov.v1.Params.deposit_extension_period":
+		x.DepositExtensionPeriod = nil
+	case "cosmos.gov.v1.Params.execution_authorities":
+		x.ExecutionAuthorities = nil
+	case "cosmos.gov.v1.Params.discussion_period":
+		x.DiscussionPeriod = nil
+	case "cosmos.gov.v1.Params.early_resolution":
+		x.EarlyResolution = false
+	case "cosmos.gov.v1.Params.max_proposals_processed_per_end_block":
+		x.MaxProposalsProcessedPerEndBlock = uint64(0)
+	case "cosmos.gov.v1.Params.constitution_amendment_threshold":
+		x.ConstitutionAmendmentThreshold = ""
+	case "cosmos.gov.v1.Params.abstain_semantics":
+		x.AbstainSemantics = 0
+	case "cosmos.gov.v1.Params.accepted_deposit_denoms":
+		x.AcceptedDepositDenoms = nil
+	case "cosmos.gov.v1.Params.amendment_period":
+		x.AmendmentPeriod = nil
+	case "cosmos.gov.v1.Params.participation_exemption_threshold":
+		x.ParticipationExemptionThreshold = ""
+	case "cosmos.gov.v1.Params.min_deposit_any_denom":
+		x.MinDepositAnyDenom = false
+	case "cosmos.gov.v1.Params.reveal_period":
+		x.RevealPeriod = nil
+	case "cosmos.gov.v1.Params.veto_quorum":
+		x.VetoQuorum = ""
+	case "cosmos.gov.v1.Params.proposal_kind_quorums":
+		x.ProposalKindQuorums = nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.gov.v1.Params"))
+		}
+		panic(fmt.Errorf("message cosmos.gov.v1.Params does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_Params) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "cosmos.gov.v1.Params.min_deposit":
+		if len(x.MinDeposit) == 0 {
+			return protoreflect.ValueOfList(&_Params_1_list{})
+		}
+		listValue := &_Params_1_list{list: &x.MinDeposit}
+		return protoreflect.ValueOfList(listValue)
+	case "cosmos.gov.v1.Params.max_deposit_period":
+		value := x.MaxDepositPeriod
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "cosmos.gov.v1.Params.voting_period":
+		value := x.VotingPeriod
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "cosmos.gov.v1.Params.quorum":
+		value := x.Quorum
+		return protoreflect.ValueOfString(value)
+	case "cosmos.gov.v1.Params.threshold":
+		value := x.Threshold
+		return protoreflect.ValueOfString(value)
+	case "cosmos.gov.v1.Params.veto_threshold":
+		value := x.VetoThreshold
+		return protoreflect.ValueOfString(value)
+	case "cosmos.gov.v1.Params.min_initial_deposit_ratio":
+		value := x.MinInitialDepositRatio
+		return protoreflect.ValueOfString(value)
+	case "cosmos.gov.v1.Params.proposal_cancel_ratio":
+		value := x.ProposalCancelRatio
+		return protoreflect.ValueOfString(value)
+	case "cosmos.gov.v1.Params.proposal_cancel_dest":
+		value := x.ProposalCancelDest
+		return protoreflect.ValueOfString(value)
+	case "cosmos.gov.v1.Params.expedited_voting_period":
+		value := x.ExpeditedVotingPeriod
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "cosmos.gov.v1.Params.expedited_threshold":
+		value := x.ExpeditedThreshold
+		return protoreflect.ValueOfString(value)
+	case "cosmos.gov.v1.Params.expedited_min_deposit":
+		if len(x.ExpeditedMinDeposit) == 0 {
+			return protoreflect.ValueOfList(&_Params_12_list{})
+		}
+		listValue := &_Params_12_list{list: &x.ExpeditedMinDeposit}
+		return protoreflect.ValueOfList(listValue)
+	case "cosmos.gov.v1.Params.burn_vote_quorum":
+		value := x.BurnVoteQuorum
+		return protoreflect.ValueOfBool(value)
+	case "cosmos.gov.v1.Params.burn_proposal_deposit_prevote":
+		value := x.BurnProposalDepositPrevote
+		return protoreflect.ValueOfBool(value)
+	case "cosmos.gov.v1.Params.burn_vote_veto":
+		value := x.BurnVoteVeto
+		return protoreflect.ValueOfBool(value)
+	case "cosmos.gov.v1.Params.deposit_extension_ratio":
+		value := x.DepositExtensionRatio
+		return protoreflect.ValueOfString(value)
+	case "cosmos.gov.v1.Params.deposit_extension_period":
+		value := x.DepositExtensionPeriod
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "cosmos.gov.v1.Params.execution_authorities":
+		if len(x.ExecutionAuthorities) == 0 {
+			return protoreflect.ValueOfList(&_Params_18_list{})
+		}
+		listValue := &_Params_18_list{list: &x.ExecutionAuthorities}
+		return protoreflect.ValueOfList(listValue)
+	case "cosmos.gov.v1.Params.discussion_period":
+		value := x.DiscussionPeriod
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "cosmos.gov.v1.Params.early_resolution":
+		value := x.EarlyResolution
+		return protoreflect.ValueOfBool(value)
+	case "cosmos.gov.v1.Params.max_proposals_processed_per_end_block":
+		value := x.MaxProposalsProcessedPerEndBlock
+		return protoreflect.ValueOfUint64(value)
+	case "cosmos.gov.v1.Params.constitution_amendment_threshold":
+		value := x.ConstitutionAmendmentThreshold
+		return protoreflect.ValueOfString(value)
+	case "cosmos.gov.v1.Params.abstain_semantics":
+		value := x.AbstainSemantics
+		return protoreflect.ValueOfEnum((protoreflect.EnumNumber)(value))
+	case "cosmos.gov.v1.Params.accepted_deposit_denoms":
+		if len(x.AcceptedDepositDenoms) == 0 {
+			return protoreflect.ValueOfList(&_Params_24_list{})
+		}
+		listValue := &_Params_24_list{list: &x.AcceptedDepositDenoms}
+		return protoreflect.ValueOfList(listValue)
+	case "cosmos.gov.v1.Params.amendment_period":
+		value := x.AmendmentPeriod
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "cosmos.gov.v1.Params.participation_exemption_threshold":
+		value := x.ParticipationExemptionThreshold
+		return protoreflect.ValueOfString(value)
+	case "cosmos.gov.v1.Params.min_deposit_any_denom":
+		value := x.MinDepositAnyDenom
+		return protoreflect.ValueOfBool(value)
+	case "cosmos.gov.v1.Params.reveal_period":
+		value := x.RevealPeriod
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "cosmos.gov.v1.Params.veto_quorum":
+		value := x.VetoQuorum
+		return protoreflect.ValueOfString(value)
+	case "cosmos.gov.v1.Params.proposal_kind_quorums":
+		if len(x.ProposalKindQuorums) == 0 {
+			return protoreflect.ValueOfList(&_Params_30_list{})
+		}
+		listValue := &_Params_30_list{list: &x.ProposalKindQuorums}
+		return protoreflect.ValueOfList(listValue)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.gov.v1.Params"))
+		}
+		panic(fmt.Errorf("message cosmos.gov.v1.Params does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_Params) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "cosmos.gov.v1.Params.min_deposit":
+		lv := value.List()
+		clv := lv.(*_Params_1_list)
+		x.MinDeposit = *clv.list
+	case "cosmos.gov.v1.Params.max_deposit_period":
+		x.MaxDepositPeriod = value.Message().Interface().(*durationpb.Duration)
+	case "cosmos.gov.v1.Params.voting_period":
+		x.VotingPeriod = value.Message().Interface().(*durationpb.Duration)
+	case "cosmos.gov.v1.Params.quorum":
+		x.Quorum = value.Interface().(string)
+	case "cosmos.gov.v1.Params.threshold":
+		x.Threshold = value.Interface().(string)
+	case "cosmos.gov.v1.Params.veto_threshold":
+		x.VetoThreshold = value.Interface().(string)
+	case "cosmos.gov.v1.Params.min_initial_deposit_ratio":
+		x.MinInitialDepositRatio = value.Interface().(string)
+	case "cosmos.gov.v1.Params.proposal_cancel_ratio":
+		x.ProposalCancelRatio = value.Interface().(string)
+	case "cosmos.gov.v1.Params.proposal_cancel_dest":
+		x.ProposalCancelDest = value.Interface().(string)
+	case "cosmos.gov.v1.Params.expedited_voting_period":
+		x.ExpeditedVotingPeriod = value.Message().Interface().(*durationpb.Duration)
+	case "cosmos.gov.v1.Params.expedited_threshold":
+		x.ExpeditedThreshold = value.Interface().(string)
+	case "cosmos.gov.v1.Params.expedited_min_deposit":
+		lv := value.List()
+		clv := lv.(*_Params_12_list)
+		x.ExpeditedMinDeposit = *clv.list
+	case "cosmos.gov.v1.Params.burn_vote_quorum":
+		x.BurnVoteQuorum = value.Bool()
+	case "cosmos.gov.v1.Params.burn_proposal_deposit_prevote":
+		x.BurnProposalDepositPrevote = value.Bool()
+	case "cosmos.gov.v1.Params.burn_vote_veto":
+		x.BurnVoteVeto = value.Bool()
+	case "cosmos.gov.v1.Params.deposit_extension_ratio":
+		x.DepositExtensionRatio = value.Interface().(string)
+	case "cosmos.gov.v1.Params.deposit_extension_period":
+		x.DepositExtensionPeriod = value.Message().Interface().(*durationpb.Duration)
+	case "cosmos.gov.v1.Params.execution_authorities":
+		lv := value.List()
+		clv := lv.(*_Params_18_list)
+		x.ExecutionAuthorities = *clv.list
+	case "cosmos.gov.v1.Params.discussion_period":
+		x.DiscussionPeriod = value.Message().Interface().(*durationpb.Duration)
+	case "cosmos.gov.v1.Params.early_resolution":
+		x.EarlyResolution = value.Bool()
+	case "cosmos.gov.v1.Params.max_proposals_processed_per_end_block":
+		x.MaxProposalsProcessedPerEndBlock = value.Uint()
+	case "cosmos.gov.v1.Params.constitution_amendment_threshold":
+		x.ConstitutionAmendmentThreshold = value.Interface().(string)
+	case "cosmos.gov.v1.Params.abstain_semantics":
+		x.AbstainSemantics = (AbstainSemantics)(value.Enum())
+	case "cosmos.gov.v1.Params.accepted_deposit_denoms":
+		lv := value.List()
+		clv := lv.(*_Params_24_list)
+		x.AcceptedDepositDenoms = *clv.list
+	case "cosmos.gov.v1.Params.amendment_period":
+		x.AmendmentPeriod = value.Message().Interface().(*durationpb.Duration)
+	case "cosmos.gov.v1.Params.participation_exemption_threshold":
+		x.ParticipationExemptionThreshold = value.Interface().(string)
+	case "cosmos.gov.v1.Params.min_deposit_any_denom":
+		x.MinDepositAnyDenom = value.Bool()
+	case "cosmos.gov.v1.Params.reveal_period":
+		x.RevealPeriod = value.Message().Interface().(*durationpb.Duration)
+	case "cosmos.gov.v1.Params.veto_quorum":
+		x.VetoQuorum = value.Interface().(string)
+	case "cosmos.gov.v1.Params.proposal_kind_quorums":
+		lv := value.List()
+		clv := lv.(*_Params_30_list)
+		x.ProposalKindQuorums = *clv.list
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.gov.v1.Params"))
+		}
+		panic(fmt.Errorf("message cosmos.gov.v1.Params does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_Params) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "cosmos.gov.v1.Params.min_deposit":
+		if x.MinDeposit == nil {
+			x.MinDeposit = []*v1beta1.Coin{}
+		}
+		value := &_Params_1_list{list: &x.MinDeposit}
+		return protoreflect.ValueOfList(value)
+	case "cosmos.gov.v1.Params.max_deposit_period":
+		if x.MaxDepositPeriod == nil {
+			x.MaxDepositPeriod = new(durationpb.Duration)
+		}
+		return protoreflect.ValueOfMessage(x.MaxDepositPeriod.ProtoReflect())
+	case "cosmos.gov.v1.Params.voting_period":
+		if x.VotingPeriod == nil {
+			x.VotingPeriod = new(durationpb.Duration)
+		}
+		return protoreflect.ValueOfMessage(x.VotingPeriod.ProtoReflect())
+	case "cosmos.gov.v1.Params.expedited_voting_period":
+		if x.ExpeditedVotingPeriod == nil {
+			x.ExpeditedVotingPeriod = new(durationpb.Duration)
+		}
+		return protoreflect.ValueOfMessage(x.ExpeditedVotingPeriod.ProtoReflect())
+	case "cosmos.gov.v1.Params.expedited_min_deposit":
+		if x.ExpeditedMinDeposit == nil {
+			x.ExpeditedMinDeposit = []*v1beta1.Coin{}
+		}
+		value := &_Params_12_list{list: &x.ExpeditedMinDeposit}
+		return protoreflect.ValueOfList(value)
+	case "cosmos.gov.v1.Params.deposit_extension_period":
+		if x.DepositExtensionPeriod == nil {
+			x.DepositExtensionPeriod = new(durationpb.Duration)
+		}
+		return protoreflect.ValueOfMessage(x.DepositExtensionPeriod.ProtoReflect())
+	case "cosmos.gov.v1.Params.execution_authorities":
+		if x.ExecutionAuthorities == nil {
+			x.ExecutionAuthorities = []string{}
+		}
+		value := &_Params_18_list{list: &x.ExecutionAuthorities}
+		return protoreflect.ValueOfList(value)
+	case "cosmos.gov.v1.Params.discussion_period":
+		if x.DiscussionPeriod == nil {
+			x.DiscussionPeriod = new(durationpb.Duration)
+		}
+		return protoreflect.ValueOfMessage(x.DiscussionPeriod.ProtoReflect())
+	case "cosmos.gov.v1.Params.accepted_deposit_denoms":
+		if x.AcceptedDepositDenoms == nil {
+			x.AcceptedDepositDenoms = []*AcceptedDepositDenom{}
+		}
+		value := &_Params_24_list{list: &x.AcceptedDepositDenoms}
+		return protoreflect.ValueOfList(value)
+	case "cosmos.gov.v1.Params.amendment_period":
+		if x.AmendmentPeriod == nil {
+			x.AmendmentPeriod = new(durationpb.Duration)
+		}
+		return protoreflect.ValueOfMessage(x.AmendmentPeriod.ProtoReflect())
+	case "cosmos.gov.v1.Params.reveal_period":
+		if x.RevealPeriod == nil {
+			x.RevealPeriod = new(durationpb.Duration)
+		}
+		return protoreflect.ValueOfMessage(x.RevealPeriod.ProtoReflect())
+	case "cosmos.gov.v1.Params.proposal_kind_quorums":
+		if x.ProposalKindQuorums == nil {
+			x.ProposalKindQuorums = []*ProposalKindQuorum{}
+		}
+		value := &_Params_30_list{list: &x.ProposalKindQuorums}
+		return protoreflect.ValueOfList(value)
+	case "cosmos.gov.v1.Params.quorum":
+		panic(fmt.Errorf("field quorum of message cosmos.gov.v1.Params is not mutable"))
+	case "cosmos.gov.v1.Params.threshold":
+		panic(fmt.Errorf("field threshold of message cosmos.gov.v1.Params is not mutable"))
+	case "cosmos.gov.v1.Params.veto_threshold":
+		panic(fmt.Errorf("field veto_threshold of message cosmos.gov.v1.Params is not mutable"))
+	case "cosmos.gov.v1.Params.min_initial_deposit_ratio":
+		panic(fmt.Errorf("field min_initial_deposit_ratio of message cosmos.gov.v1.Params is not mutable"))
+	case "cosmos.gov.v1.Params.proposal_cancel_ratio":
+		panic(fmt.Errorf("field proposal_cancel_ratio of message cosmos.gov.v1.Params is not mutable"))
+	case "cosmos.gov.v1.Params.proposal_cancel_dest":
+		panic(fmt.Errorf("field proposal_cancel_dest of message cosmos.gov.v1.Params is not mutable"))
+	case "cosmos.gov.v1.Params.expedited_threshold":
+		panic(fmt.Errorf("field expedited_threshold of message cosmos.gov.v1.Params is not mutable"))
+	case "cosmos.gov.v1.Params.burn_vote_quorum":
+		panic(fmt.Errorf("field burn_vote_quorum of message cosmos.gov.v1.Params is not mutable"))
+	case "cosmos.gov.v1.Params.burn_proposal_deposit_prevote":
+		panic(fmt.Errorf("field burn_proposal_deposit_prevote of message cosmos.gov.v1.Params is not mutable"))
+	case "cosmos.gov.v1.Params.burn_vote_veto":
+		panic(fmt.Errorf("field burn_vote_veto of message cosmos.gov.v1.Params is not mutable"))
+	case "cosmos.gov.v1.Params.deposit_extension_ratio":
+		panic(fmt.Errorf("field deposit_extension_ratio of message cosmos.gov.v1.Params is not mutable"))
+	case "cosmos.gov.v1.Params.early_resolution":
+		panic(fmt.Errorf("field early_resolution of message cosmos.gov.v1.Params is not mutable"))
+	case "cosmos.gov.v1.Params.max_proposals_processed_per_end_block":
+		panic(fmt.Errorf("field max_proposals_processed_per_end_block of message cosmos.gov.v1.Params is not mutable"))
+	case "cosmos.gov.v1.Params.constitution_amendment_threshold":
+		panic(fmt.Errorf("field constitution_amendment_threshold of message cosmos.gov.v1.Params is not mutable"))
+	case "cosmos.gov.v1.Params.abstain_semantics":
+		panic(fmt.Errorf("field abstain_semantics of message cosmos.gov.v1.Params is not mutable"))
+	case "cosmos.gov.v1.Params.participation_exemption_threshold":
+		panic(fmt.Errorf("field participation_exemption_threshold of message cosmos.gov.v1.Params is not mutable"))
+	case "cosmos.gov.v1.Params.min_deposit_any_denom":
+		panic(fmt.Errorf("field min_deposit_any_denom of message cosmos.gov.v1.Params is not mutable"))
+	case "cosmos.gov.v1.Params.veto_quorum":
+		panic(fmt.Errorf("field veto_quorum of message cosmos.gov.v1.Params is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.gov.v1.Params"))
+		}
+		panic(fmt.Errorf("message cosmos.gov.v1.Params does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_Params) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "cosmos.gov.v1.Params.min_deposit":
+		list := []*v1beta1.Coin{}
+		return protoreflect.ValueOfList(&_Params_1_list{list: &list})
+	case "cosmos.gov.v1.Params.max_deposit_period":
+		m := new(durationpb.Duration)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case "cosmos.gov.v1.Params.voting_period":
+		m := new(durationpb.Duration)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case "cosmos.gov.v1.Params.quorum":
+		return protoreflect.ValueOfString("")
+	case "cosmos.gov.v1.Params.threshold":
+		return protoreflect.ValueOfString("")
+	case "cosmos.gov.v1.Params.veto_threshold":
+		return protoreflect.ValueOfString("")
+	case "cosmos.gov.v1.Params.min_initial_deposit_ratio":
+		return protoreflect.ValueOfString("")
+	case "cosmos.gov.v1.Params.proposal_cancel_ratio":
+		return protoreflect.ValueOfString("")
+	case "cosmos.gov.v1.Params.proposal_cancel_dest":
+		return protoreflect.ValueOfString("")
+	case "cosmos.gov.v1.Params.expedited_voting_period":
+		m := new(durationpb.Duration)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case "cosmos.gov.v1.Params.expedited_threshold":
+		return protoreflect.ValueOfString("")
+	case "cosmos.gov.v1.Params.expedited_min_deposit":
+		list := []*v1beta1.Coin{}
+		return protoreflect.ValueOfList(&_Params_12_list{list: &list})
+	case "cosmos.gov.v1.Params.burn_vote_quorum":
+		return protoreflect.ValueOfBool(false)
+	case "cosmos.gov.v1.Params.burn_proposal_deposit_prevote":
+		return protoreflect.ValueOfBool(false)
+	case "cosmos.gov.v1.Params.burn_vote_veto":
+		return protoreflect.ValueOfBool(false)
+	case "cosmos.gov.v1.Params.deposit_extension_ratio":
+		return protoreflect.ValueOfString("")
+	case "cosmos.gov.v1.Params.deposit_extension_period":
+		m := new(durationpb.Duration)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case "cosmos.gov.v1.Params.execution_authorities":
+		list := []string{}
+		return protoreflect.ValueOfList(&_Params_18_list{list: &list})
+	case "cosmos.gov.v1.Params.discussion_period":
+		m := new(durationpb.Duration)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case "cosmos.gov.v1.Params.early_resolution":
+		return protoreflect.ValueOfBool(false)
+	case "cosmos.gov.v1.Params.max_proposals_processed_per_end_block":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "cosmos.gov.v1.Params.constitution_amendment_threshold":
+		return protoreflect.ValueOfString("")
+	case "cosmos.gov.v1.Params.abstain_semantics":
+		return protoreflect.ValueOfEnum(0)
+	case "cosmos.gov.v1.Params.accepted_deposit_denoms":
+		list := []*AcceptedDepositDenom{}
+		return protoreflect.ValueOfList(&_Params_24_list{list: &list})
+	case "cosmos.gov.v1.Params.amendment_period":
+		m := new(durationpb.Duration)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case "cosmos.gov.v1.Params.participation_exemption_threshold":
+		return protoreflect.ValueOfString("")
+	case "cosmos.gov.v1.Params.min_deposit_any_denom":
+		return protoreflect.ValueOfBool(false)
+	case "cosmos.gov.v1.Params.reveal_period":
+		m := new(durationpb.Duration)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case "cosmos.gov.v1.Params.veto_quorum":
+		return protoreflect.ValueOfString("")
+	case "cosmos.gov.v1.Params.proposal_kind_quorums":
+		list := []*ProposalKindQuorum{}
+		return protoreflect.ValueOfList(&_Params_30_list{list: &list})
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.gov.v1.Params"))
+		}
+		panic(fmt.Errorf("message cosmos.gov.v1.Params does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_Params) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in cosmos.gov.v1.Params", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_Params) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_Params) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_Params) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_Params) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*Params)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if len(x.MinDeposit) > 0 {
+			for _, e := range x.MinDeposit {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if x.MaxDepositPeriod != nil {
+			l = options.Size(x.MaxDepositPeriod)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.VotingPeriod != nil {
+			l = options.Size(x.VotingPeriod)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.Quorum)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.Threshold)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.VetoThreshold)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.MinInitialDepositRatio)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.ProposalCancelRatio)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.ProposalCancelDest)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.ExpeditedVotingPeriod != nil {
+			l = options.Size(x.ExpeditedVotingPeriod)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.ExpeditedThreshold)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if len(x.ExpeditedMinDeposit) > 0 {
+			for _, e := range x.ExpeditedMinDeposit {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if x.BurnVoteQuorum {
+			n += 2
+		}
+		if x.BurnProposalDepositPrevote {
+			n += 2
+		}
+		if x.BurnVoteVeto {
+			n += 2
+		}
+		l = len(x.DepositExtensionRatio)
+		if l > 0 {
+			n += 2 + l + runtime.Sov(uint64(l))
+		}
+		if x.DepositExtensionPeriod != nil {
+			l = options.Size(x.DepositExtensionPeriod)
+			n += 2 + l + runtime.Sov(uint64(l))
+		}
+		if len(x.ExecutionAuthorities) > 0 {
+			for _, s := range x.ExecutionAuthorities {
+				l = len(s)
+				n += 2 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if x.DiscussionPeriod != nil {
+			l = options.Size(x.DiscussionPeriod)
+			n += 2 + l + runtime.Sov(uint64(l))
+		}
+		if x.EarlyResolution {
+			n += 3
+		}
+		if x.MaxProposalsProcessedPerEndBlock != 0 {
+			n += 2 + runtime.Sov(uint64(x.MaxProposalsProcessedPerEndBlock))
+		}
+		l = len(x.ConstitutionAmendmentThreshold)
+		if l > 0 {
+			n += 2 + l + runtime.Sov(uint64(l))
+		}
+		if x.AbstainSemantics != 0 {
+			n += 2 + runtime.Sov(uint64(x.AbstainSemantics))
+		}
+		if len(x.AcceptedDepositDenoms) > 0 {
+			for _, e := range x.AcceptedDepositDenoms {
+				l = options.Size(e)
+				n += 2 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if x.AmendmentPeriod != nil {
+			l = options.Size(x.AmendmentPeriod)
+			n += 2 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.ParticipationExemptionThreshold)
+		if l > 0 {
+			n += 2 + l + runtime.Sov(uint64(l))
+		}
+		if x.MinDepositAnyDenom {
+			n += 3
+		}
+		if x.RevealPeriod != nil {
+			l = options.Size(x.RevealPeriod)
+			n += 2 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.VetoQuorum)
+		if l > 0 {
+			n += 2 + l + runtime.Sov(uint64(l))
+		}
+		if len(x.ProposalKindQuorums) > 0 {
+			for _, e := range x.ProposalKindQuorums {
+				l = options.Size(e)
+				n += 2 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*Params)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if len(x.ProposalKindQuorums) > 0 {
+			for iNdEx := len(x.ProposalKindQuorums) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.ProposalKindQuorums[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x1
+				i--
+				dAtA[i] = 0xf2
+			}
+		}
+		if len(x.VetoQuorum) > 0 {
+			i -= len(x.VetoQuorum)
+			copy(dAtA[i:], x.VetoQuorum)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.VetoQuorum)))
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0xea
+		}
+		if x.RevealPeriod != nil {
+			encoded, err := options.Marshal(x.RevealPeriod)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0xe2
+		}
+		if x.MinDepositAnyDenom {
+			i--
+			if x.MinDepositAnyDenom {
+				dAtA[i] = 1
+			} else {
+				dAtA[i] = 0
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0xd8
+		}
+		if len(x.ParticipationExemptionThreshold) > 0 {
+			i -= len(x.ParticipationExemptionThreshold)
+			copy(dAtA[i:], x.ParticipationExemptionThreshold)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.ParticipationExemptionThreshold)))
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0xd2
+		}
+		if x.AmendmentPeriod != nil {
+			encoded, err := options.Marshal(x.AmendmentPeriod)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0xca
+		}
+		if len(x.AcceptedDepositDenoms) > 0 {
+			for iNdEx := len(x.AcceptedDepositDenoms) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.AcceptedDepositDenoms[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x1
+				i--
+				dAtA[i] = 0xc2
+			}
+		}
+		if x.AbstainSemantics != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.AbstainSemantics))
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0xb8
+		}
+		if len(x.ConstitutionAmendmentThreshold) > 0 {
+			i -= len(x.ConstitutionAmendmentThreshold)
+			copy(dAtA[i:], x.ConstitutionAmendmentThreshold)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.ConstitutionAmendmentThreshold)))
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0xb2
+		}
+		if x.MaxProposalsProcessedPerEndBlock != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.MaxProposalsProcessedPerEndBlock))
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0xa8
+		}
+		if x.EarlyResolution {
+			i--
+			if x.EarlyResolution {
+				dAtA[i] = 1
+			} else {
+				dAtA[i] = 0
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0xa0
+		}
+		if x.DiscussionPeriod != nil {
+			encoded, err := options.Marshal(x.DiscussionPeriod)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0x9a
+		}
+		if len(x.ExecutionAuthorities) > 0 {
+			for iNdEx := len(x.ExecutionAuthorities) - 1; iNdEx >= 0; iNdEx-- {
+				i -= len(x.ExecutionAuthorities[iNdEx])
+				copy(dAtA[i:], x.ExecutionAuthorities[iNdEx])
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(x.ExecutionAuthorities[iNdEx])))
+				i--
+				dAtA[i] = 0x1
+				i--
+				dAtA[i] = 0x92
+			}
+		}
+		if x.DepositExtensionPeriod != nil {
+			encoded, err := options.Marshal(x.DepositExtensionPeriod)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0x8a
+		}
+		if len(x.DepositExtensionRatio) > 0 {
+			i -= len(x.DepositExtensionRatio)
+			copy(dAtA[i:], x.DepositExtensionRatio)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.DepositExtensionRatio)))
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0x82
+		}
+		if x.BurnVoteVeto {
+			i--
+			if x.BurnVoteVeto {
+				dAtA[i] = 1
+			} else {
+				dAtA[i] = 0
+			}
+			i--
+			dAtA[i] = 0x78
+		}
+		if x.BurnProposalDepositPrevote {
+			i--
+			if x.BurnProposalDepositPrevote {
+				dAtA[i] = 1
+			} else {
+				dAtA[i] = 0
+			}
+			i--
+			dAtA[i] = 0x70
+		}
+		if x.BurnVoteQuorum {
+			i--
+			if x.BurnVoteQuorum {
+				dAtA[i] = 1
+			} else {
+				dAtA[i] = 0
+			}
+			i--
+			dAtA[i] = 0x68
+		}
+		if len(x.ExpeditedMinDeposit) > 0 {
+			for iNdEx := len(x.ExpeditedMinDeposit) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.ExpeditedMinDeposit[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x62
+			}
+		}
+		if len(x.ExpeditedThreshold) > 0 {
+			i -= len(x.ExpeditedThreshold)
+			copy(dAtA[i:], x.ExpeditedThreshold)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.ExpeditedThreshold)))
+			i--
+			dAtA[i] = 0x5a
+		}
+		if x.ExpeditedVotingPeriod != nil {
+			encoded, err := options.Marshal(x.ExpeditedVotingPeriod)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x52
+		}
+		if len(x.ProposalCancelDest) > 0 {
+			i -= len(x.ProposalCancelDest)
+			copy(dAtA[i:], x.ProposalCancelDest)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.ProposalCancelDest)))
+			i--
+			dAtA[i] = 0x4a
+		}
+		if len(x.ProposalCancelRatio) > 0 {
+			i -= len(x.ProposalCancelRatio)
+			copy(dAtA[i:], x.ProposalCancelRatio)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.ProposalCancelRatio)))
+			i--
+			dAtA[i] = 0x42
+		}
+		if len(x.MinInitialDepositRatio) > 0 {
+			i -= len(x.MinInitialDepositRatio)
+			copy(dAtA[i:], x.MinInitialDepositRatio)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.MinInitialDepositRatio)))
+			i--
+			dAtA[i] = 0x3a
+		}
+		if len(x.VetoThreshold) > 0 {
+			i -= len(x.VetoThreshold)
+			copy(dAtA[i:], x.VetoThreshold)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.VetoThreshold)))
+			i--
+			dAtA[i] = 0x32
+		}
+		if len(x.Threshold) > 0 {
+			i -= len(x.Threshold)
+			copy(dAtA[i:], x.Threshold)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Threshold)))
+			i--
+			dAtA[i] = 0x2a
+		}
+		if len(x.Quorum) > 0 {
+			i -= len(x.Quorum)
+			copy(dAtA[i:], x.Quorum)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Quorum)))
+			i--
+			dAtA[i] = 0x22
+		}
+		if x.VotingPeriod != nil {
+			encoded, err := options.Marshal(x.VotingPeriod)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x1a
+		}
+		if x.MaxDepositPeriod != nil {
+			encoded, err := options.Marshal(x.MaxDepositPeriod)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x12
+		}
+		if len(x.MinDeposit) > 0 {
+			for iNdEx := len(x.MinDeposit) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.MinDeposit[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0xa
+			}
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*Params)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: Params: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: Params: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field MinDeposit", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.MinDeposit = append(x.MinDeposit, &v1beta1.Coin{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.MinDeposit[len(x.MinDeposit)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field MaxDepositPeriod", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.MaxDepositPeriod == nil {
+					x.MaxDepositPeriod = &durationpb.Duration{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.MaxDepositPeriod); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 3:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field VotingPeriod", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.VotingPeriod == nil {
+					x.VotingPeriod = &durationpb.Duration{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.VotingPeriod); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 4:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Quorum", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Quorum = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 5:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Threshold", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Threshold = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 6:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field VetoThreshold", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.VetoThreshold = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 7:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field MinInitialDepositRatio", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.MinInitialDepositRatio = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 8:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ProposalCancelRatio", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.ProposalCancelRatio = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 9:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ProposalCancelDest", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.ProposalCancelDest = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 10:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ExpeditedVotingPeriod", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.ExpeditedVotingPeriod == nil {
+					x.ExpeditedVotingPeriod = &durationpb.Duration{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.ExpeditedVotingPeriod); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 11:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ExpeditedThreshold", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.ExpeditedThreshold = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 12:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ExpeditedMinDeposit", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.ExpeditedMinDeposit = append(x.ExpeditedMinDeposit, &v1beta1.Coin{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.ExpeditedMinDeposit[len(x.ExpeditedMinDeposit)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 13:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field BurnVoteQuorum", wireType)
+				}
+				var v int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				x.BurnVoteQuorum = bool(v != 0)
+			case 14:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field BurnProposalDepositPrevote", wireType)
+				}
+				var v int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				x.BurnProposalDepositPrevote = bool(v != 0)
+			case 15:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field BurnVoteVeto", wireType)
+				}
+				var v int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				x.BurnVoteVeto = bool(v != 0)
+			case 16:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field DepositExtensionRatio", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.DepositExtensionRatio = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 17:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field DepositExtensionPeriod", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.DepositExtensionPeriod == nil {
+					x.DepositExtensionPeriod = &durationpb.Duration{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.DepositExtensionPeriod); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 18:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ExecutionAuthorities", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.ExecutionAuthorities = append(x.ExecutionAuthorities, string(dAtA[iNdEx:postIndex]))
+				iNdEx = postIndex
+			case 19:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field DiscussionPeriod", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.DiscussionPeriod == nil {
+					x.DiscussionPeriod = &durationpb.Duration{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.DiscussionPeriod); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 20:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field EarlyResolution", wireType)
+				}
+				var v int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				x.EarlyResolution = bool(v != 0)
+			case 21:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field MaxProposalsProcessedPerEndBlock", wireType)
+				}
+				x.MaxProposalsProcessedPerEndBlock = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.MaxProposalsProcessedPerEndBlock |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 22:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ConstitutionAmendmentThreshold", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.ConstitutionAmendmentThreshold = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 23:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field AbstainSemantics", wireType)
+				}
+				x.AbstainSemantics = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.AbstainSemantics |= AbstainSemantics(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 24:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field AcceptedDepositDenoms", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.AcceptedDepositDenoms = append(x.AcceptedDepositDenoms, &AcceptedDepositDenom{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.AcceptedDepositDenoms[len(x.AcceptedDepositDenoms)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 25:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field AmendmentPeriod", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.AmendmentPeriod == nil {
+					x.AmendmentPeriod = &durationpb.Duration{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.AmendmentPeriod); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 26:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ParticipationExemptionThreshold", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.ParticipationExemptionThreshold = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 27:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field MinDepositAnyDenom", wireType)
+				}
+				var v int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				x.MinDepositAnyDenom = bool(v != 0)
+			case 28:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field RevealPeriod", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.RevealPeriod == nil {
+					x.RevealPeriod = &durationpb.Duration{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.RevealPeriod); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 29:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field VetoQuorum", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.VetoQuorum = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 30:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ProposalKindQuorums", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
 				if postIndex < 0 {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 				}
-				if postIndex > l {
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.ProposalKindQuorums = append(x.ProposalKindQuorums, &ProposalKindQuorum{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.ProposalKindQuorums[len(x.ProposalKindQuorums)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_ProposalKindQuorum             protoreflect.MessageDescriptor
+	fd_ProposalKindQuorum_kind        protoreflect.FieldDescriptor
+	fd_ProposalKindQuorum_quorum      protoreflect.FieldDescriptor
+	fd_ProposalKindQuorum_veto_quorum protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_cosmos_gov_v1_gov_proto_init()
+	md_ProposalKindQuorum = File_cosmos_gov_v1_gov_proto.Messages().ByName("ProposalKindQuorum")
+	fd_ProposalKindQuorum_kind = md_ProposalKindQuorum.Fields().ByName("kind")
+	fd_ProposalKindQuorum_quorum = md_ProposalKindQuorum.Fields().ByName("quorum")
+	fd_ProposalKindQuorum_veto_quorum = md_ProposalKindQuorum.Fields().ByName("veto_quorum")
+}
+
+var _ protoreflect.Message = (*fastReflection_ProposalKindQuorum)(nil)
+
+type fastReflection_ProposalKindQuorum ProposalKindQuorum
+
+func (x *ProposalKindQuorum) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_ProposalKindQuorum)(x)
+}
+
+func (x *ProposalKindQuorum) slowProtoReflect() protoreflect.Message {
+	mi := &file_cosmos_gov_v1_gov_proto_msgTypes[17]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_ProposalKindQuorum_messageType fastReflection_ProposalKindQuorum_messageType
+var _ protoreflect.MessageType = fastReflection_ProposalKindQuorum_messageType{}
+
+type fastReflection_ProposalKindQuorum_messageType struct{}
+
+func (x fastReflection_ProposalKindQuorum_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_ProposalKindQuorum)(nil)
+}
+func (x fastReflection_ProposalKindQuorum_messageType) New() protoreflect.Message {
+	return new(fastReflection_ProposalKindQuorum)
+}
+func (x fastReflection_ProposalKindQuorum_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_ProposalKindQuorum
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_ProposalKindQuorum) Descriptor() protoreflect.MessageDescriptor {
+	return md_ProposalKindQuorum
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_ProposalKindQuorum) Type() protoreflect.MessageType {
+	return _fastReflection_ProposalKindQuorum_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_ProposalKindQuorum) New() protoreflect.Message {
+	return new(fastReflection_ProposalKindQuorum)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_ProposalKindQuorum) Interface() protoreflect.ProtoMessage {
+	return (*ProposalKindQuorum)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_ProposalKindQuorum) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Kind != "" {
+		value := protoreflect.ValueOfString(x.Kind)
+		if !f(fd_ProposalKindQuorum_kind, value) {
+			return
+		}
+	}
+	if x.Quorum != "" {
+		value := protoreflect.ValueOfString(x.Quorum)
+		if !f(fd_ProposalKindQuorum_quorum, value) {
+			return
+		}
+	}
+	if x.VetoQuorum != "" {
+		value := protoreflect.ValueOfString(x.VetoQuorum)
+		if !f(fd_ProposalKindQuorum_veto_quorum, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_ProposalKindQuorum) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "cosmos.gov.v1.ProposalKindQuorum.kind":
+		return x.Kind != ""
+	case "cosmos.gov.v1.ProposalKindQuorum.quorum":
+		return x.Quorum != ""
+	case "cosmos.gov.v1.ProposalKindQuorum.veto_quorum":
+		return x.VetoQuorum != ""
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.gov.v1.ProposalKindQuorum"))
+		}
+		panic(fmt.Errorf("message cosmos.gov.v1.ProposalKindQuorum does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_ProposalKindQuorum) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "cosmos.gov.v1.ProposalKindQuorum.kind":
+		x.Kind = ""
+	case "cosmos.gov.v1.ProposalKindQuorum.quorum":
+		x.Quorum = ""
+	case "cosmos.gov.v1.ProposalKindQuorum.veto_quorum":
+		x.VetoQuorum = ""
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.gov.v1.ProposalKindQuorum"))
+		}
+		panic(fmt.Errorf("message cosmos.gov.v1.ProposalKindQuorum does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_ProposalKindQuorum) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "cosmos.gov.v1.ProposalKindQuorum.kind":
+		value := x.Kind
+		return protoreflect.ValueOfString(value)
+	case "cosmos.gov.v1.ProposalKindQuorum.quorum":
+		value := x.Quorum
+		return protoreflect.ValueOfString(value)
+	case "cosmos.gov.v1.ProposalKindQuorum.veto_quorum":
+		value := x.VetoQuorum
+		return protoreflect.ValueOfString(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.gov.v1.ProposalKindQuorum"))
+		}
+		panic(fmt.Errorf("message cosmos.gov.v1.ProposalKindQuorum does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_ProposalKindQuorum) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "cosmos.gov.v1.ProposalKindQuorum.kind":
+		x.Kind = value.Interface().(string)
+	case "cosmos.gov.v1.ProposalKindQuorum.quorum":
+		x.Quorum = value.Interface().(string)
+	case "cosmos.gov.v1.ProposalKindQuorum.veto_quorum":
+		x.VetoQuorum = value.Interface().(string)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.gov.v1.ProposalKindQuorum"))
+		}
+		panic(fmt.Errorf("message cosmos.gov.v1.ProposalKindQuorum does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_ProposalKindQuorum) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "cosmos.gov.v1.ProposalKindQuorum.kind":
+		panic(fmt.Errorf("field kind of message cosmos.gov.v1.ProposalKindQuorum is not mutable"))
+	case "cosmos.gov.v1.ProposalKindQuorum.quorum":
+		panic(fmt.Errorf("field quorum of message cosmos.gov.v1.ProposalKindQuorum is not mutable"))
+	case "cosmos.gov.v1.ProposalKindQuorum.veto_quorum":
+		panic(fmt.Errorf("field veto_quorum of message cosmos.gov.v1.ProposalKindQuorum is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.gov.v1.ProposalKindQuorum"))
+		}
+		panic(fmt.Errorf("message cosmos.gov.v1.ProposalKindQuorum does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_ProposalKindQuorum) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "cosmos.gov.v1.ProposalKindQuorum.kind":
+		return protoreflect.ValueOfString("")
+	case "cosmos.gov.v1.ProposalKindQuorum.quorum":
+		return protoreflect.ValueOfString("")
+	case "cosmos.gov.v1.ProposalKindQuorum.veto_quorum":
+		return protoreflect.ValueOfString("")
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.gov.v1.ProposalKindQuorum"))
+		}
+		panic(fmt.Errorf("message cosmos.gov.v1.ProposalKindQuorum does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_ProposalKindQuorum) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in cosmos.gov.v1.ProposalKindQuorum", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_ProposalKindQuorum) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_ProposalKindQuorum) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_ProposalKindQuorum) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_ProposalKindQuorum) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*ProposalKindQuorum)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		l = len(x.Kind)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.Quorum)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.VetoQuorum)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*ProposalKindQuorum)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if len(x.VetoQuorum) > 0 {
+			i -= len(x.VetoQuorum)
+			copy(dAtA[i:], x.VetoQuorum)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.VetoQuorum)))
+			i--
+			dAtA[i] = 0x1a
+		}
+		if len(x.Quorum) > 0 {
+			i -= len(x.Quorum)
+			copy(dAtA[i:], x.Quorum)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Quorum)))
+			i--
+			dAtA[i] = 0x12
+		}
+		if len(x.Kind) > 0 {
+			i -= len(x.Kind)
+			copy(dAtA[i:], x.Kind)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Kind)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*ProposalKindQuorum)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				x.Threshold = string(dAtA[iNdEx:postIndex])
-				iNdEx = postIndex
-			case 6:
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: ProposalKindQuorum: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: ProposalKindQuorum: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field VetoThreshold", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Kind", wireType)
 				}
 				var stringLen uint64
 				for shift := uint(0); ; shift += 7 {
@@ -6548,11 +14470,11 @@ func (x *fastReflection_Params) ProtoMethods() *protoiface.Methods {
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				x.VetoThreshold = string(dAtA[iNdEx:postIndex])
+				x.Kind = string(dAtA[iNdEx:postIndex])
 				iNdEx = postIndex
-			case 7:
+			case 2:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field MinInitialDepositRatio", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Quorum", wireType)
 				}
 				var stringLen uint64
 				for shift := uint(0); ; shift += 7 {
@@ -6580,11 +14502,11 @@ func (x *fastReflection_Params) ProtoMethods() *protoiface.Methods {
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				x.MinInitialDepositRatio = string(dAtA[iNdEx:postIndex])
+				x.Quorum = string(dAtA[iNdEx:postIndex])
 				iNdEx = postIndex
-			case 8:
+			case 3:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ProposalCancelRatio", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field VetoQuorum", wireType)
 				}
 				var stringLen uint64
 				for shift := uint(0); ; shift += 7 {
@@ -6612,79 +14534,497 @@ func (x *fastReflection_Params) ProtoMethods() *protoiface.Methods {
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				x.ProposalCancelRatio = string(dAtA[iNdEx:postIndex])
+				x.VetoQuorum = string(dAtA[iNdEx:postIndex])
 				iNdEx = postIndex
-			case 9:
-				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ProposalCancelDest", wireType)
-				}
-				var stringLen uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
-					}
-					if iNdEx >= l {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					stringLen |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				intStringLen := int(stringLen)
-				if intStringLen < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
 				}
-				postIndex := iNdEx + intStringLen
-				if postIndex < 0 {
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 				}
-				if postIndex > l {
+				if (iNdEx + skippy) > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				x.ProposalCancelDest = string(dAtA[iNdEx:postIndex])
-				iNdEx = postIndex
-			case 10:
-				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ExpeditedVotingPeriod", wireType)
-				}
-				var msglen int
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
-					}
-					if iNdEx >= l {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					msglen |= int(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				if msglen < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
 				}
-				postIndex := iNdEx + msglen
-				if postIndex < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_AcceptedDepositDenom            protoreflect.MessageDescriptor
+	fd_AcceptedDepositDenom_denom      protoreflect.FieldDescriptor
+	fd_AcceptedDepositDenom_base_denom protoreflect.FieldDescriptor
+	fd_AcceptedDepositDenom_ratio      protoreflect.FieldDescriptor
+	fd_AcceptedDepositDenom_use_oracle protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_cosmos_gov_v1_gov_proto_init()
+	md_AcceptedDepositDenom = File_cosmos_gov_v1_gov_proto.Messages().ByName("AcceptedDepositDenom")
+	fd_AcceptedDepositDenom_denom = md_AcceptedDepositDenom.Fields().ByName("denom")
+	fd_AcceptedDepositDenom_base_denom = md_AcceptedDepositDenom.Fields().ByName("base_denom")
+	fd_AcceptedDepositDenom_ratio = md_AcceptedDepositDenom.Fields().ByName("ratio")
+	fd_AcceptedDepositDenom_use_oracle = md_AcceptedDepositDenom.Fields().ByName("use_oracle")
+}
+
+var _ protoreflect.Message = (*fastReflection_AcceptedDepositDenom)(nil)
+
+type fastReflection_AcceptedDepositDenom AcceptedDepositDenom
+
+func (x *AcceptedDepositDenom) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_AcceptedDepositDenom)(x)
+}
+
+func (x *AcceptedDepositDenom) slowProtoReflect() protoreflect.Message {
+	mi := &file_cosmos_gov_v1_gov_proto_msgTypes[18]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_AcceptedDepositDenom_messageType fastReflection_AcceptedDepositDenom_messageType
+var _ protoreflect.MessageType = fastReflection_AcceptedDepositDenom_messageType{}
+
+type fastReflection_AcceptedDepositDenom_messageType struct{}
+
+func (x fastReflection_AcceptedDepositDenom_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_AcceptedDepositDenom)(nil)
+}
+func (x fastReflection_AcceptedDepositDenom_messageType) New() protoreflect.Message {
+	return new(fastReflection_AcceptedDepositDenom)
+}
+func (x fastReflection_AcceptedDepositDenom_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_AcceptedDepositDenom
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_AcceptedDepositDenom) Descriptor() protoreflect.MessageDescriptor {
+	return md_AcceptedDepositDenom
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_AcceptedDepositDenom) Type() protoreflect.MessageType {
+	return _fastReflection_AcceptedDepositDenom_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_AcceptedDepositDenom) New() protoreflect.Message {
+	return new(fastReflection_AcceptedDepositDenom)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_AcceptedDepositDenom) Interface() protoreflect.ProtoMessage {
+	return (*AcceptedDepositDenom)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_AcceptedDepositDenom) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Denom != "" {
+		value := protoreflect.ValueOfString(x.Denom)
+		if !f(fd_AcceptedDepositDenom_denom, value) {
+			return
+		}
+	}
+	if x.BaseDenom != "" {
+		value := protoreflect.ValueOfString(x.BaseDenom)
+		if !f(fd_AcceptedDepositDenom_base_denom, value) {
+			return
+		}
+	}
+	if x.Ratio != "" {
+		value := protoreflect.ValueOfString(x.Ratio)
+		if !f(fd_AcceptedDepositDenom_ratio, value) {
+			return
+		}
+	}
+	if x.UseOracle != false {
+		value := protoreflect.ValueOfBool(x.UseOracle)
+		if !f(fd_AcceptedDepositDenom_use_oracle, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_AcceptedDepositDenom) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "cosmos.gov.v1.AcceptedDepositDenom.denom":
+		return x.Denom != ""
+	case "cosmos.gov.v1.AcceptedDepositDenom.base_denom":
+		return x.BaseDenom != ""
+	case "cosmos.gov.v1.AcceptedDepositDenom.ratio":
+		return x.Ratio != ""
+	case "cosmos.gov.v1.AcceptedDepositDenom.use_oracle":
+		return x.UseOracle != false
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.gov.v1.AcceptedDepositDenom"))
+		}
+		panic(fmt.Errorf("message cosmos.gov.v1.AcceptedDepositDenom does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_AcceptedDepositDenom) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "cosmos.gov.v1.AcceptedDepositDenom.denom":
+		x.Denom = ""
+	case "cosmos.gov.v1.AcceptedDepositDenom.base_denom":
+		x.BaseDenom = ""
+	case "cosmos.gov.v1.AcceptedDepositDenom.ratio":
+		x.Ratio = ""
+	case "cosmos.gov.v1.AcceptedDepositDenom.use_oracle":
+		x.UseOracle = false
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.gov.v1.AcceptedDepositDenom"))
+		}
+		panic(fmt.Errorf("message cosmos.gov.v1.AcceptedDepositDenom does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_AcceptedDepositDenom) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "cosmos.gov.v1.AcceptedDepositDenom.denom":
+		value := x.Denom
+		return protoreflect.ValueOfString(value)
+	case "cosmos.gov.v1.AcceptedDepositDenom.base_denom":
+		value := x.BaseDenom
+		return protoreflect.ValueOfString(value)
+	case "cosmos.gov.v1.AcceptedDepositDenom.ratio":
+		value := x.Ratio
+		return protoreflect.ValueOfString(value)
+	case "cosmos.gov.v1.AcceptedDepositDenom.use_oracle":
+		value := x.UseOracle
+		return protoreflect.ValueOfBool(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.gov.v1.AcceptedDepositDenom"))
+		}
+		panic(fmt.Errorf("message cosmos.gov.v1.AcceptedDepositDenom does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_AcceptedDepositDenom) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "cosmos.gov.v1.AcceptedDepositDenom.denom":
+		x.Denom = value.Interface().(string)
+	case "cosmos.gov.v1.AcceptedDepositDenom.base_denom":
+		x.BaseDenom = value.Interface().(string)
+	case "cosmos.gov.v1.AcceptedDepositDenom.ratio":
+		x.Ratio = value.Interface().(string)
+	case "cosmos.gov.v1.AcceptedDepositDenom.use_oracle":
+		x.UseOracle = value.Bool()
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.gov.v1.AcceptedDepositDenom"))
+		}
+		panic(fmt.Errorf("message cosmos.gov.v1.AcceptedDepositDenom does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_AcceptedDepositDenom) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "cosmos.gov.v1.AcceptedDepositDenom.denom":
+		panic(fmt.Errorf("field denom of message cosmos.gov.v1.AcceptedDepositDenom is not mutable"))
+	case "cosmos.gov.v1.AcceptedDepositDenom.base_denom":
+		panic(fmt.Errorf("field base_denom of message cosmos.gov.v1.AcceptedDepositDenom is not mutable"))
+	case "cosmos.gov.v1.AcceptedDepositDenom.ratio":
+		panic(fmt.Errorf("field ratio of message cosmos.gov.v1.AcceptedDepositDenom is not mutable"))
+	case "cosmos.gov.v1.AcceptedDepositDenom.use_oracle":
+		panic(fmt.Errorf("field use_oracle of message cosmos.gov.v1.AcceptedDepositDenom is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.gov.v1.AcceptedDepositDenom"))
+		}
+		panic(fmt.Errorf("message cosmos.gov.v1.AcceptedDepositDenom does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_AcceptedDepositDenom) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "cosmos.gov.v1.AcceptedDepositDenom.denom":
+		return protoreflect.ValueOfString("")
+	case "cosmos.gov.v1.AcceptedDepositDenom.base_denom":
+		return protoreflect.ValueOfString("")
+	case "cosmos.gov.v1.AcceptedDepositDenom.ratio":
+		return protoreflect.ValueOfString("")
+	case "cosmos.gov.v1.AcceptedDepositDenom.use_oracle":
+		return protoreflect.ValueOfBool(false)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.gov.v1.AcceptedDepositDenom"))
+		}
+		panic(fmt.Errorf("message cosmos.gov.v1.AcceptedDepositDenom does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_AcceptedDepositDenom) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in cosmos.gov.v1.AcceptedDepositDenom", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_AcceptedDepositDenom) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_AcceptedDepositDenom) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_AcceptedDepositDenom) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_AcceptedDepositDenom) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*AcceptedDepositDenom)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		l = len(x.Denom)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.BaseDenom)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.Ratio)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.UseOracle {
+			n += 2
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*AcceptedDepositDenom)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if x.UseOracle {
+			i--
+			if x.UseOracle {
+				dAtA[i] = 1
+			} else {
+				dAtA[i] = 0
+			}
+			i--
+			dAtA[i] = 0x20
+		}
+		if len(x.Ratio) > 0 {
+			i -= len(x.Ratio)
+			copy(dAtA[i:], x.Ratio)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Ratio)))
+			i--
+			dAtA[i] = 0x1a
+		}
+		if len(x.BaseDenom) > 0 {
+			i -= len(x.BaseDenom)
+			copy(dAtA[i:], x.BaseDenom)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.BaseDenom)))
+			i--
+			dAtA[i] = 0x12
+		}
+		if len(x.Denom) > 0 {
+			i -= len(x.Denom)
+			copy(dAtA[i:], x.Denom)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Denom)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*AcceptedDepositDenom)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
 				}
-				if postIndex > l {
+				if iNdEx >= l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				if x.ExpeditedVotingPeriod == nil {
-					x.ExpeditedVotingPeriod = &durationpb.Duration{}
-				}
-				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.ExpeditedVotingPeriod); err != nil {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
 				}
-				iNdEx = postIndex
-			case 11:
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: AcceptedDepositDenom: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: AcceptedDepositDenom: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ExpeditedThreshold", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
 				}
 				var stringLen uint64
 				for shift := uint(0); ; shift += 7 {
@@ -6712,13 +15052,13 @@ func (x *fastReflection_Params) ProtoMethods() *protoiface.Methods {
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				x.ExpeditedThreshold = string(dAtA[iNdEx:postIndex])
+				x.Denom = string(dAtA[iNdEx:postIndex])
 				iNdEx = postIndex
-			case 12:
+			case 2:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ExpeditedMinDeposit", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field BaseDenom", wireType)
 				}
-				var msglen int
+				var stringLen uint64
 				for shift := uint(0); ; shift += 7 {
 					if shift >= 64 {
 						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
@@ -6728,31 +15068,29 @@ func (x *fastReflection_Params) ProtoMethods() *protoiface.Methods {
 					}
 					b := dAtA[iNdEx]
 					iNdEx++
-					msglen |= int(b&0x7F) << shift
+					stringLen |= uint64(b&0x7F) << shift
 					if b < 0x80 {
 						break
 					}
 				}
-				if msglen < 0 {
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 				}
-				postIndex := iNdEx + msglen
+				postIndex := iNdEx + intStringLen
 				if postIndex < 0 {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 				}
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				x.ExpeditedMinDeposit = append(x.ExpeditedMinDeposit, &v1beta1.Coin{})
-				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.ExpeditedMinDeposit[len(x.ExpeditedMinDeposit)-1]); err != nil {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
-				}
+				x.BaseDenom = string(dAtA[iNdEx:postIndex])
 				iNdEx = postIndex
-			case 13:
-				if wireType != 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field BurnVoteQuorum", wireType)
+			case 3:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Ratio", wireType)
 				}
-				var v int
+				var stringLen uint64
 				for shift := uint(0); ; shift += 7 {
 					if shift >= 64 {
 						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
@@ -6762,35 +15100,27 @@ func (x *fastReflection_Params) ProtoMethods() *protoiface.Methods {
 					}
 					b := dAtA[iNdEx]
 					iNdEx++
-					v |= int(b&0x7F) << shift
+					stringLen |= uint64(b&0x7F) << shift
 					if b < 0x80 {
 						break
 					}
 				}
-				x.BurnVoteQuorum = bool(v != 0)
-			case 14:
-				if wireType != 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field BurnProposalDepositPrevote", wireType)
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 				}
-				var v int
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
-					}
-					if iNdEx >= l {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					v |= int(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 				}
-				x.BurnProposalDepositPrevote = bool(v != 0)
-			case 15:
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Ratio = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 4:
 				if wireType != 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field BurnVoteVeto", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field UseOracle", wireType)
 				}
 				var v int
 				for shift := uint(0); ; shift += 7 {
@@ -6807,7 +15137,7 @@ func (x *fastReflection_Params) ProtoMethods() *protoiface.Methods {
 						break
 					}
 				}
-				x.BurnVoteVeto = bool(v != 0)
+				x.UseOracle = bool(v != 0)
 			default:
 				iNdEx = preIndex
 				skippy, err := runtime.Skip(dAtA[iNdEx:])
@@ -6827,559 +15157,1334 @@ func (x *fastReflection_Params) ProtoMethods() *protoiface.Methods {
 			}
 		}
 
-		if iNdEx > l {
-			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-		}
-		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+// Since: cosmos-sdk 0.46
+
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.27.0
+// 	protoc        (unknown)
+// source: cosmos/gov/v1/gov.proto
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// VoteOption enumerates the valid vote options for a given governance proposal.
+type VoteOption int32
+
+const (
+	// VOTE_OPTION_UNSPECIFIED defines a no-op vote option.
+	VoteOption_VOTE_OPTION_UNSPECIFIED VoteOption = 0
+	// VOTE_OPTION_YES defines a yes vote option.
+	VoteOption_VOTE_OPTION_YES VoteOption = 1
+	// VOTE_OPTION_ABSTAIN defines an abstain vote option.
+	VoteOption_VOTE_OPTION_ABSTAIN VoteOption = 2
+	// VOTE_OPTION_NO defines a no vote option.
+	VoteOption_VOTE_OPTION_NO VoteOption = 3
+	// VOTE_OPTION_NO_WITH_VETO defines a no with veto vote option.
+	VoteOption_VOTE_OPTION_NO_WITH_VETO VoteOption = 4
+)
+
+// Enum value maps for VoteOption.
+var (
+	VoteOption_name = map[int32]string{
+		0: "VOTE_OPTION_UNSPECIFIED",
+		1: "VOTE_OPTION_YES",
+		2: "VOTE_OPTION_ABSTAIN",
+		3: "VOTE_OPTION_NO",
+		4: "VOTE_OPTION_NO_WITH_VETO",
+	}
+	VoteOption_value = map[string]int32{
+		"VOTE_OPTION_UNSPECIFIED":  0,
+		"VOTE_OPTION_YES":          1,
+		"VOTE_OPTION_ABSTAIN":      2,
+		"VOTE_OPTION_NO":           3,
+		"VOTE_OPTION_NO_WITH_VETO": 4,
+	}
+)
+
+func (x VoteOption) Enum() *VoteOption {
+	p := new(VoteOption)
+	*p = x
+	return p
+}
+
+func (x VoteOption) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (VoteOption) Descriptor() protoreflect.EnumDescriptor {
+	return file_cosmos_gov_v1_gov_proto_enumTypes[0].Descriptor()
+}
+
+func (VoteOption) Type() protoreflect.EnumType {
+	return &file_cosmos_gov_v1_gov_proto_enumTypes[0]
+}
+
+func (x VoteOption) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use VoteOption.Descriptor instead.
+func (VoteOption) EnumDescriptor() ([]byte, []int) {
+	return file_cosmos_gov_v1_gov_proto_rawDescGZIP(), []int{0}
+}
+
+// ProposalStatus enumerates the valid statuses of a proposal.
+type ProposalStatus int32
+
+const (
+	// PROPOSAL_STATUS_UNSPECIFIED defines the default proposal status.
+	ProposalStatus_PROPOSAL_STATUS_UNSPECIFIED ProposalStatus = 0
+	// PROPOSAL_STATUS_DEPOSIT_PERIOD defines a proposal status during the deposit
+	// period.
+	ProposalStatus_PROPOSAL_STATUS_DEPOSIT_PERIOD ProposalStatus = 1
+	// PROPOSAL_STATUS_VOTING_PERIOD defines a proposal status during the voting
+	// period.
+	ProposalStatus_PROPOSAL_STATUS_VOTING_PERIOD ProposalStatus = 2
+	// PROPOSAL_STATUS_PASSED defines a proposal status of a proposal that has
+	// passed.
+	ProposalStatus_PROPOSAL_STATUS_PASSED ProposalStatus = 3
+	// PROPOSAL_STATUS_REJECTED defines a proposal status of a proposal that has
+	// been rejected.
+	ProposalStatus_PROPOSAL_STATUS_REJECTED ProposalStatus = 4
+	// PROPOSAL_STATUS_FAILED defines a proposal status of a proposal that has
+	// failed.
+	ProposalStatus_PROPOSAL_STATUS_FAILED ProposalStatus = 5
+	// PROPOSAL_STATUS_DISCUSSION_PERIOD defines a proposal status during the
+	// discussion period, between the deposit period and the voting period.
+	//
+	// Since: cosmos-sdk 0.48
+	ProposalStatus_PROPOSAL_STATUS_DISCUSSION_PERIOD ProposalStatus = 6
+)
+
+// Enum value maps for ProposalStatus.
+var (
+	ProposalStatus_name = map[int32]string{
+		0: "PROPOSAL_STATUS_UNSPECIFIED",
+		1: "PROPOSAL_STATUS_DEPOSIT_PERIOD",
+		2: "PROPOSAL_STATUS_VOTING_PERIOD",
+		3: "PROPOSAL_STATUS_PASSED",
+		4: "PROPOSAL_STATUS_REJECTED",
+		5: "PROPOSAL_STATUS_FAILED",
+		6: "PROPOSAL_STATUS_DISCUSSION_PERIOD",
+	}
+	ProposalStatus_value = map[string]int32{
+		"PROPOSAL_STATUS_UNSPECIFIED":       0,
+		"PROPOSAL_STATUS_DEPOSIT_PERIOD":    1,
+		"PROPOSAL_STATUS_VOTING_PERIOD":     2,
+		"PROPOSAL_STATUS_PASSED":            3,
+		"PROPOSAL_STATUS_REJECTED":          4,
+		"PROPOSAL_STATUS_FAILED":            5,
+		"PROPOSAL_STATUS_DISCUSSION_PERIOD": 6,
+	}
+)
+
+func (x ProposalStatus) Enum() *ProposalStatus {
+	p := new(ProposalStatus)
+	*p = x
+	return p
+}
+
+func (x ProposalStatus) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ProposalStatus) Descriptor() protoreflect.EnumDescriptor {
+	return file_cosmos_gov_v1_gov_proto_enumTypes[1].Descriptor()
+}
+
+func (ProposalStatus) Type() protoreflect.EnumType {
+	return &file_cosmos_gov_v1_gov_proto_enumTypes[1]
+}
+
+func (x ProposalStatus) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ProposalStatus.Descriptor instead.
+func (ProposalStatus) EnumDescriptor() ([]byte, []int) {
+	return file_cosmos_gov_v1_gov_proto_rawDescGZIP(), []int{1}
+}
+
+// AbstainSemantics enumerates the ways Abstain votes are counted in the tally
+// of a proposal.
+//
+// Since: cosmos-sdk 0.48
+type AbstainSemantics int32
+
+const (
+	// ABSTAIN_SEMANTICS_UNSPECIFIED defines a no-op semantics, treated as
+	// ABSTAIN_SEMANTICS_QUORUM_ONLY.
+	AbstainSemantics_ABSTAIN_SEMANTICS_UNSPECIFIED AbstainSemantics = 0
+	// ABSTAIN_SEMANTICS_QUORUM_ONLY counts Abstain votes toward the quorum and
+	// the veto threshold, but not toward the threshold of Yes votes.
+	AbstainSemantics_ABSTAIN_SEMANTICS_QUORUM_ONLY AbstainSemantics = 1
+	// ABSTAIN_SEMANTICS_THRESHOLD counts Abstain votes toward the quorum, the
+	// veto threshold and the threshold of Yes votes.
+	AbstainSemantics_ABSTAIN_SEMANTICS_THRESHOLD AbstainSemantics = 2
+	// ABSTAIN_SEMANTICS_IGNORED does not count Abstain votes at all, as if they
+	// had not been cast.
+	AbstainSemantics_ABSTAIN_SEMANTICS_IGNORED AbstainSemantics = 3
+)
+
+// Enum value maps for AbstainSemantics.
+var (
+	AbstainSemantics_name = map[int32]string{
+		0: "ABSTAIN_SEMANTICS_UNSPECIFIED",
+		1: "ABSTAIN_SEMANTICS_QUORUM_ONLY",
+		2: "ABSTAIN_SEMANTICS_THRESHOLD",
+		3: "ABSTAIN_SEMANTICS_IGNORED",
+	}
+	AbstainSemantics_value = map[string]int32{
+		"ABSTAIN_SEMANTICS_UNSPECIFIED": 0,
+		"ABSTAIN_SEMANTICS_QUORUM_ONLY": 1,
+		"ABSTAIN_SEMANTICS_THRESHOLD":   2,
+		"ABSTAIN_SEMANTICS_IGNORED":     3,
+	}
+)
+
+func (x AbstainSemantics) Enum() *AbstainSemantics {
+	p := new(AbstainSemantics)
+	*p = x
+	return p
+}
+
+func (x AbstainSemantics) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (AbstainSemantics) Descriptor() protoreflect.EnumDescriptor {
+	return file_cosmos_gov_v1_gov_proto_enumTypes[2].Descriptor()
+}
+
+func (AbstainSemantics) Type() protoreflect.EnumType {
+	return &file_cosmos_gov_v1_gov_proto_enumTypes[2]
+}
+
+func (x AbstainSemantics) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use AbstainSemantics.Descriptor instead.
+func (AbstainSemantics) EnumDescriptor() ([]byte, []int) {
+	return file_cosmos_gov_v1_gov_proto_rawDescGZIP(), []int{2}
+}
+
+// WeightedVoteOption defines a unit of vote for vote split.
+type WeightedVoteOption struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// option defines the valid vote options, it must not contain duplicate vote options.
+	Option VoteOption `protobuf:"varint,1,opt,name=option,proto3,enum=cosmos.gov.v1.VoteOption" json:"option,omitempty"`
+	// weight is the vote weight associated with the vote option.
+	Weight string `protobuf:"bytes,2,opt,name=weight,proto3" json:"weight,omitempty"`
+}
+
+func (x *WeightedVoteOption) Reset() {
+	*x = WeightedVoteOption{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cosmos_gov_v1_gov_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *WeightedVoteOption) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WeightedVoteOption) ProtoMessage() {}
+
+// Deprecated: Use WeightedVoteOption.ProtoReflect.Descriptor instead.
+func (*WeightedVoteOption) Descriptor() ([]byte, []int) {
+	return file_cosmos_gov_v1_gov_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *WeightedVoteOption) GetOption() VoteOption {
+	if x != nil {
+		return x.Option
+	}
+	return VoteOption_VOTE_OPTION_UNSPECIFIED
+}
+
+func (x *WeightedVoteOption) GetWeight() string {
+	if x != nil {
+		return x.Weight
+	}
+	return ""
+}
+
+// Deposit defines an amount deposited by an account address to an active
+// proposal.
+type Deposit struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// proposal_id defines the unique id of the proposal.
+	ProposalId uint64 `protobuf:"varint,1,opt,name=proposal_id,json=proposalId,proto3" json:"proposal_id,omitempty"`
+	// depositor defines the deposit addresses from the proposals.
+	Depositor string `protobuf:"bytes,2,opt,name=depositor,proto3" json:"depositor,omitempty"`
+	// amount to be deposited by depositor.
+	Amount []*v1beta1.Coin `protobuf:"bytes,3,rep,name=amount,proto3" json:"amount,omitempty"`
+	// metadata is any arbitrary metadata attached to the deposits of the
+	// depositor. It is replaced by the metadata of a later deposit, unless that
+	// deposit has none.
+	//
+	// Since: cosmos-sdk 0.48
+	Metadata string `protobuf:"bytes,4,opt,name=metadata,proto3" json:"metadata,omitempty"`
+	// metadata_schema_id is the id of the registered metadata schema the
+	// metadata of the deposit conforms to, or 0 if none.
+	//
+	// Since: cosmos-sdk 0.48
+	MetadataSchemaId uint64 `protobuf:"varint,5,opt,name=metadata_schema_id,json=metadataSchemaId,proto3" json:"metadata_schema_id,omitempty"`
+}
+
+func (x *Deposit) Reset() {
+	*x = Deposit{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cosmos_gov_v1_gov_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Deposit) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Deposit) ProtoMessage() {}
+
+// Deprecated: Use Deposit.ProtoReflect.Descriptor instead.
+func (*Deposit) Descriptor() ([]byte, []int) {
+	return file_cosmos_gov_v1_gov_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *Deposit) GetProposalId() uint64 {
+	if x != nil {
+		return x.ProposalId
+	}
+	return 0
+}
+
+func (x *Deposit) GetDepositor() string {
+	if x != nil {
+		return x.Depositor
+	}
+	return ""
+}
+
+func (x *Deposit) GetAmount() []*v1beta1.Coin {
+	if x != nil {
+		return x.Amount
+	}
+	return nil
+}
+
+func (x *Deposit) GetMetadata() string {
+	if x != nil {
+		return x.Metadata
+	}
+	return ""
+}
+
+func (x *Deposit) GetMetadataSchemaId() uint64 {
+	if x != nil {
+		return x.MetadataSchemaId
+	}
+	return 0
+}
+
+// Proposal defines the core field members of a governance proposal.
+type Proposal struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// id defines the unique id of the proposal.
+	Id uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	// messages are the arbitrary messages to be executed if the proposal passes.
+	Messages []*anypb.Any `protobuf:"bytes,2,rep,name=messages,proto3" json:"messages,omitempty"`
+	// status defines the proposal status.
+	Status ProposalStatus `protobuf:"varint,3,opt,name=status,proto3,enum=cosmos.gov.v1.ProposalStatus" json:"status,omitempty"`
+	// final_tally_result is the final tally result of the proposal. When
+	// querying a proposal via gRPC, this field is not populated until the
+	// proposal's voting period has ended.
+	FinalTallyResult *TallyResult `protobuf:"bytes,4,opt,name=final_tally_result,json=finalTallyResult,proto3" json:"final_tally_result,omitempty"`
+	// submit_time is the time of proposal submission.
+	SubmitTime *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=submit_time,json=submitTime,proto3" json:"submit_time,omitempty"`
+	// deposit_end_time is the end time for deposition.
+	DepositEndTime *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=deposit_end_time,json=depositEndTime,proto3" json:"deposit_end_time,omitempty"`
+	// total_deposit is the total deposit on the proposal.
+	TotalDeposit []*v1beta1.Coin `protobuf:"bytes,7,rep,name=total_deposit,json=totalDeposit,proto3" json:"total_deposit,omitempty"`
+	// voting_start_time is the starting time to vote on a proposal.
+	VotingStartTime *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=voting_start_time,json=votingStartTime,proto3" json:"voting_start_time,omitempty"`
+	// voting_end_time is the end time of voting on a proposal.
+	VotingEndTime *timestamppb.Timestamp `protobuf:"bytes,9,opt,name=voting_end_time,json=votingEndTime,proto3" json:"voting_end_time,omitempty"`
+	// metadata is any arbitrary metadata attached to the proposal.
+	Metadata string `protobuf:"bytes,10,opt,name=metadata,proto3" json:"metadata,omitempty"`
+	// title is the title of the proposal
+	//
+	// Since: cosmos-sdk 0.47
+	Title string `protobuf:"bytes,11,opt,name=title,proto3" json:"title,omitempty"`
+	// summary is a short summary of the proposal
+	//
+	// Since: cosmos-sdk 0.47
+	Summary string `protobuf:"bytes,12,opt,name=summary,proto3" json:"summary,omitempty"`
+	// proposer is the address of the proposal sumbitter
+	//
+	// Since: cosmos-sdk 0.47
+	Proposer string `protobuf:"bytes,13,opt,name=proposer,proto3" json:"proposer,omitempty"`
+	// expedited defines if the proposal is expedited
+	//
+	// Since: cosmos-sdk 0.48
+	Expedited bool `protobuf:"varint,14,opt,name=expedited,proto3" json:"expedited,omitempty"`
+	// params_update_failures records, for a proposal executing a
+	// MsgBatchUpdateParams, why each parameter update could not be applied.
+	//
+	// Since: cosmos-sdk 0.48
+	ParamsUpdateFailures []*ParamsUpdateFailure `protobuf:"bytes,15,rep,name=params_update_failures,json=paramsUpdateFailures,proto3" json:"params_update_failures,omitempty"`
+	// deposit_period_extended defines if the deposit end time of the proposal
+	// has already been extended because its deposit neared the minimum deposit.
+	//
+	// Since: cosmos-sdk 0.48
+	DepositPeriodExtended bool `protobuf:"varint,16,opt,name=deposit_period_extended,json=depositPeriodExtended,proto3" json:"deposit_period_extended,omitempty"`
+	// execution_authority is the address the messages of the proposal are
+	// executed on behalf of. It must be one of the execution authorities allowed
+	// by the params. If empty, the messages are executed by the gov module account.
+	//
+	// Since: cosmos-sdk 0.48
+	ExecutionAuthority string `protobuf:"bytes,17,opt,name=execution_authority,json=executionAuthority,proto3" json:"execution_authority,omitempty"`
+	// discussion_end_time is the end time of the discussion period of the
+	// proposal, after which its voting period starts.
+	//
+	// Since: cosmos-sdk 0.48
+	DiscussionEndTime *timestamppb.Timestamp `protobuf:"bytes,18,opt,name=discussion_end_time,json=discussionEndTime,proto3" json:"discussion_end_time,omitempty"`
+	// execution_failure records why the execution of the proposal failed. It is
+	// only set for passed proposals whose execution failed.
+	//
+	// Since: cosmos-sdk 0.48
+	ExecutionFailure *ProposalExecutionFailure `protobuf:"bytes,19,opt,name=execution_failure,json=executionFailure,proto3" json:"execution_failure,omitempty"`
+	// invalidation_failure records why the proposal failed when its voting
+	// period was about to start, because one of its messages was no longer
+	// valid.
+	//
+	// Since: cosmos-sdk 0.48
+	InvalidationFailure *ProposalExecutionFailure `protobuf:"bytes,20,opt,name=invalidation_failure,json=invalidationFailure,proto3" json:"invalidation_failure,omitempty"`
+	// commit_reveal defines if the proposal uses commit-reveal voting: voters
+	// first commit to a hash of their vote, then reveal it after the commit
+	// period. Unrevealed commitments are counted as abstain votes.
+	//
+	// Since: cosmos-sdk 0.48
+	CommitReveal bool `protobuf:"varint,21,opt,name=commit_reveal,json=commitReveal,proto3" json:"commit_reveal,omitempty"`
+	// commit_end_time is the end time of the commit period of a commit-reveal
+	// proposal, after which its votes are revealed until the voting end time.
+	//
+	// Since: cosmos-sdk 0.48
+	CommitEndTime *timestamppb.Timestamp `protobuf:"bytes,22,opt,name=commit_end_time,json=commitEndTime,proto3" json:"commit_end_time,omitempty"`
+	// metadata_schema_id is the id of the registered metadata schema the
+	// metadata of the proposal conforms to, or 0 if none.
+	//
+	// Since: cosmos-sdk 0.48
+	MetadataSchemaId uint64 `protobuf:"varint,23,opt,name=metadata_schema_id,json=metadataSchemaId,proto3" json:"metadata_schema_id,omitempty"`
+}
+
+func (x *Proposal) Reset() {
+	*x = Proposal{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cosmos_gov_v1_gov_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Proposal) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Proposal) ProtoMessage() {}
+
+// Deprecated: Use Proposal.ProtoReflect.Descriptor instead.
+func (*Proposal) Descriptor() ([]byte, []int) {
+	return file_cosmos_gov_v1_gov_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *Proposal) GetId() uint64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *Proposal) GetMessages() []*anypb.Any {
+	if x != nil {
+		return x.Messages
+	}
+	return nil
+}
+
+func (x *Proposal) GetStatus() ProposalStatus {
+	if x != nil {
+		return x.Status
+	}
+	return ProposalStatus_PROPOSAL_STATUS_UNSPECIFIED
+}
+
+func (x *Proposal) GetFinalTallyResult() *TallyResult {
+	if x != nil {
+		return x.FinalTallyResult
+	}
+	return nil
+}
+
+func (x *Proposal) GetSubmitTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.SubmitTime
+	}
+	return nil
+}
+
+func (x *Proposal) GetDepositEndTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.DepositEndTime
+	}
+	return nil
+}
+
+func (x *Proposal) GetTotalDeposit() []*v1beta1.Coin {
+	if x != nil {
+		return x.TotalDeposit
+	}
+	return nil
+}
+
+func (x *Proposal) GetVotingStartTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.VotingStartTime
+	}
+	return nil
+}
+
+func (x *Proposal) GetVotingEndTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.VotingEndTime
+	}
+	return nil
+}
+
+func (x *Proposal) GetMetadata() string {
+	if x != nil {
+		return x.Metadata
+	}
+	return ""
+}
+
+func (x *Proposal) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *Proposal) GetSummary() string {
+	if x != nil {
+		return x.Summary
 	}
-	return &protoiface.Methods{
-		NoUnkeyedLiterals: struct{}{},
-		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
-		Size:              size,
-		Marshal:           marshal,
-		Unmarshal:         unmarshal,
-		Merge:             nil,
-		CheckInitialized:  nil,
+	return ""
+}
+
+func (x *Proposal) GetProposer() string {
+	if x != nil {
+		return x.Proposer
 	}
+	return ""
 }
 
-// Since: cosmos-sdk 0.46
+func (x *Proposal) GetExpedited() bool {
+	if x != nil {
+		return x.Expedited
+	}
+	return false
+}
 
-// Code generated by protoc-gen-go. DO NOT EDIT.
-// versions:
-// 	protoc-gen-go v1.27.0
-// 	protoc        (unknown)
-// source: cosmos/gov/v1/gov.proto
+func (x *Proposal) GetParamsUpdateFailures() []*ParamsUpdateFailure {
+	if x != nil {
+		return x.ParamsUpdateFailures
+	}
+	return nil
+}
 
-const (
-	// Verify that this generated code is sufficiently up-to-date.
-	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
-	// Verify that runtime/protoimpl is sufficiently up-to-date.
-	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
-)
+func (x *Proposal) GetDepositPeriodExtended() bool {
+	if x != nil {
+		return x.DepositPeriodExtended
+	}
+	return false
+}
 
-// VoteOption enumerates the valid vote options for a given governance proposal.
-type VoteOption int32
+func (x *Proposal) GetExecutionAuthority() string {
+	if x != nil {
+		return x.ExecutionAuthority
+	}
+	return ""
+}
 
-const (
-	// VOTE_OPTION_UNSPECIFIED defines a no-op vote option.
-	VoteOption_VOTE_OPTION_UNSPECIFIED VoteOption = 0
-	// VOTE_OPTION_YES defines a yes vote option.
-	VoteOption_VOTE_OPTION_YES VoteOption = 1
-	// VOTE_OPTION_ABSTAIN defines an abstain vote option.
-	VoteOption_VOTE_OPTION_ABSTAIN VoteOption = 2
-	// VOTE_OPTION_NO defines a no vote option.
-	VoteOption_VOTE_OPTION_NO VoteOption = 3
-	// VOTE_OPTION_NO_WITH_VETO defines a no with veto vote option.
-	VoteOption_VOTE_OPTION_NO_WITH_VETO VoteOption = 4
-)
+func (x *Proposal) GetDiscussionEndTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.DiscussionEndTime
+	}
+	return nil
+}
 
-// Enum value maps for VoteOption.
-var (
-	VoteOption_name = map[int32]string{
-		0: "VOTE_OPTION_UNSPECIFIED",
-		1: "VOTE_OPTION_YES",
-		2: "VOTE_OPTION_ABSTAIN",
-		3: "VOTE_OPTION_NO",
-		4: "VOTE_OPTION_NO_WITH_VETO",
+func (x *Proposal) GetExecutionFailure() *ProposalExecutionFailure {
+	if x != nil {
+		return x.ExecutionFailure
 	}
-	VoteOption_value = map[string]int32{
-		"VOTE_OPTION_UNSPECIFIED":  0,
-		"VOTE_OPTION_YES":          1,
-		"VOTE_OPTION_ABSTAIN":      2,
-		"VOTE_OPTION_NO":           3,
-		"VOTE_OPTION_NO_WITH_VETO": 4,
+	return nil
+}
+
+func (x *Proposal) GetInvalidationFailure() *ProposalExecutionFailure {
+	if x != nil {
+		return x.InvalidationFailure
 	}
-)
+	return nil
+}
 
-func (x VoteOption) Enum() *VoteOption {
-	p := new(VoteOption)
-	*p = x
-	return p
+func (x *Proposal) GetCommitReveal() bool {
+	if x != nil {
+		return x.CommitReveal
+	}
+	return false
 }
 
-func (x VoteOption) String() string {
-	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+func (x *Proposal) GetCommitEndTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CommitEndTime
+	}
+	return nil
 }
 
-func (VoteOption) Descriptor() protoreflect.EnumDescriptor {
-	return file_cosmos_gov_v1_gov_proto_enumTypes[0].Descriptor()
+func (x *Proposal) GetMetadataSchemaId() uint64 {
+	if x != nil {
+		return x.MetadataSchemaId
+	}
+	return 0
 }
 
-func (VoteOption) Type() protoreflect.EnumType {
-	return &file_cosmos_gov_v1_gov_proto_enumTypes[0]
+// ProposalExecutionFailure defines why the execution of a passed proposal
+// failed.
+//
+// Since: cosmos-sdk 0.48
+type ProposalExecutionFailure struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// msg_index is the position of the failing message in the proposal messages.
+	// It is only meaningful if msg_type_url is set.
+	MsgIndex uint32 `protobuf:"varint,1,opt,name=msg_index,json=msgIndex,proto3" json:"msg_index,omitempty"`
+	// msg_type_url is the type URL of the failing message. It is empty if the
+	// proposal failed before any of its messages was executed.
+	MsgTypeUrl string `protobuf:"bytes,2,opt,name=msg_type_url,json=msgTypeUrl,proto3" json:"msg_type_url,omitempty"`
+	// reason is the error which made the execution fail.
+	Reason string `protobuf:"bytes,3,opt,name=reason,proto3" json:"reason,omitempty"`
 }
 
-func (x VoteOption) Number() protoreflect.EnumNumber {
-	return protoreflect.EnumNumber(x)
+func (x *ProposalExecutionFailure) Reset() {
+	*x = ProposalExecutionFailure{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cosmos_gov_v1_gov_proto_msgTypes[3]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
 }
 
-// Deprecated: Use VoteOption.Descriptor instead.
-func (VoteOption) EnumDescriptor() ([]byte, []int) {
-	return file_cosmos_gov_v1_gov_proto_rawDescGZIP(), []int{0}
+func (x *ProposalExecutionFailure) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-// ProposalStatus enumerates the valid statuses of a proposal.
-type ProposalStatus int32
+func (*ProposalExecutionFailure) ProtoMessage() {}
 
-const (
-	// PROPOSAL_STATUS_UNSPECIFIED defines the default proposal status.
-	ProposalStatus_PROPOSAL_STATUS_UNSPECIFIED ProposalStatus = 0
-	// PROPOSAL_STATUS_DEPOSIT_PERIOD defines a proposal status during the deposit
-	// period.
-	ProposalStatus_PROPOSAL_STATUS_DEPOSIT_PERIOD ProposalStatus = 1
-	// PROPOSAL_STATUS_VOTING_PERIOD defines a proposal status during the voting
-	// period.
-	ProposalStatus_PROPOSAL_STATUS_VOTING_PERIOD ProposalStatus = 2
-	// PROPOSAL_STATUS_PASSED defines a proposal status of a proposal that has
-	// passed.
-	ProposalStatus_PROPOSAL_STATUS_PASSED ProposalStatus = 3
-	// PROPOSAL_STATUS_REJECTED defines a proposal status of a proposal that has
-	// been rejected.
-	ProposalStatus_PROPOSAL_STATUS_REJECTED ProposalStatus = 4
-	// PROPOSAL_STATUS_FAILED defines a proposal status of a proposal that has
-	// failed.
-	ProposalStatus_PROPOSAL_STATUS_FAILED ProposalStatus = 5
-)
+// Deprecated: Use ProposalExecutionFailure.ProtoReflect.Descriptor instead.
+func (*ProposalExecutionFailure) Descriptor() ([]byte, []int) {
+	return file_cosmos_gov_v1_gov_proto_rawDescGZIP(), []int{3}
+}
 
-// Enum value maps for ProposalStatus.
-var (
-	ProposalStatus_name = map[int32]string{
-		0: "PROPOSAL_STATUS_UNSPECIFIED",
-		1: "PROPOSAL_STATUS_DEPOSIT_PERIOD",
-		2: "PROPOSAL_STATUS_VOTING_PERIOD",
-		3: "PROPOSAL_STATUS_PASSED",
-		4: "PROPOSAL_STATUS_REJECTED",
-		5: "PROPOSAL_STATUS_FAILED",
+func (x *ProposalExecutionFailure) GetMsgIndex() uint32 {
+	if x != nil {
+		return x.MsgIndex
+	}
+	return 0
+}
+
+func (x *ProposalExecutionFailure) GetMsgTypeUrl() string {
+	if x != nil {
+		return x.MsgTypeUrl
+	}
+	return ""
+}
+
+func (x *ProposalExecutionFailure) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+// ProposalExecutionReceipt records the execution of the messages of a passed
+// proposal.
+//
+// Since: cosmos-sdk 0.48
+type ProposalExecutionReceipt struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// proposal_id is the id of the executed proposal.
+	ProposalId uint64 `protobuf:"varint,1,opt,name=proposal_id,json=proposalId,proto3" json:"proposal_id,omitempty"`
+	// height is the block height at which the proposal was executed.
+	Height int64 `protobuf:"varint,2,opt,name=height,proto3" json:"height,omitempty"`
+	// time is the block time at which the proposal was executed.
+	Time *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=time,proto3" json:"time,omitempty"`
+	// msg_responses are the responses of the executed messages. It is empty if
+	// the execution failed, see the execution_failure of the proposal.
+	MsgResponses []*anypb.Any `protobuf:"bytes,4,rep,name=msg_responses,json=msgResponses,proto3" json:"msg_responses,omitempty"`
+}
+
+func (x *ProposalExecutionReceipt) Reset() {
+	*x = ProposalExecutionReceipt{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cosmos_gov_v1_gov_proto_msgTypes[4]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ProposalExecutionReceipt) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ProposalExecutionReceipt) ProtoMessage() {}
+
+// Deprecated: Use ProposalExecutionReceipt.ProtoReflect.Descriptor instead.
+func (*ProposalExecutionReceipt) Descriptor() ([]byte, []int) {
+	return file_cosmos_gov_v1_gov_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *ProposalExecutionReceipt) GetProposalId() uint64 {
+	if x != nil {
+		return x.ProposalId
+	}
+	return 0
+}
+
+func (x *ProposalExecutionReceipt) GetHeight() int64 {
+	if x != nil {
+		return x.Height
+	}
+	return 0
+}
+
+func (x *ProposalExecutionReceipt) GetTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Time
+	}
+	return nil
+}
+
+func (x *ProposalExecutionReceipt) GetMsgResponses() []*anypb.Any {
+	if x != nil {
+		return x.MsgResponses
+	}
+	return nil
+}
+
+// ParamsUpdateFailure defines the reason a single parameter update message of
+// a MsgBatchUpdateParams failed.
+//
+// Since: cosmos-sdk 0.48
+type ParamsUpdateFailure struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// index is the position of the failing message in the batch.
+	Index uint32 `protobuf:"varint,1,opt,name=index,proto3" json:"index,omitempty"`
+	// msg_type_url is the type URL of the failing message.
+	MsgTypeUrl string `protobuf:"bytes,2,opt,name=msg_type_url,json=msgTypeUrl,proto3" json:"msg_type_url,omitempty"`
+	// reason is the error returned by the module params validator or handler.
+	Reason string `protobuf:"bytes,3,opt,name=reason,proto3" json:"reason,omitempty"`
+}
+
+func (x *ParamsUpdateFailure) Reset() {
+	*x = ParamsUpdateFailure{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cosmos_gov_v1_gov_proto_msgTypes[5]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ParamsUpdateFailure) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ParamsUpdateFailure) ProtoMessage() {}
+
+// Deprecated: Use ParamsUpdateFailure.ProtoReflect.Descriptor instead.
+func (*ParamsUpdateFailure) Descriptor() ([]byte, []int) {
+	return file_cosmos_gov_v1_gov_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *ParamsUpdateFailure) GetIndex() uint32 {
+	if x != nil {
+		return x.Index
+	}
+	return 0
+}
+
+func (x *ParamsUpdateFailure) GetMsgTypeUrl() string {
+	if x != nil {
+		return x.MsgTypeUrl
+	}
+	return ""
+}
+
+func (x *ParamsUpdateFailure) GetReason() string {
+	if x != nil {
+		return x.Reason
 	}
-	ProposalStatus_value = map[string]int32{
-		"PROPOSAL_STATUS_UNSPECIFIED":    0,
-		"PROPOSAL_STATUS_DEPOSIT_PERIOD": 1,
-		"PROPOSAL_STATUS_VOTING_PERIOD":  2,
-		"PROPOSAL_STATUS_PASSED":         3,
-		"PROPOSAL_STATUS_REJECTED":       4,
-		"PROPOSAL_STATUS_FAILED":         5,
+	return ""
+}
+
+// TallyResult defines a standard tally for a governance proposal.
+type TallyResult struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// yes_count is the number of yes votes on a proposal.
+	YesCount string `protobuf:"bytes,1,opt,name=yes_count,json=yesCount,proto3" json:"yes_count,omitempty"`
+	// abstain_count is the number of abstain votes on a proposal.
+	AbstainCount string `protobuf:"bytes,2,opt,name=abstain_count,json=abstainCount,proto3" json:"abstain_count,omitempty"`
+	// no_count is the number of no votes on a proposal.
+	NoCount string `protobuf:"bytes,3,opt,name=no_count,json=noCount,proto3" json:"no_count,omitempty"`
+	// no_with_veto_count is the number of no with veto votes on a proposal.
+	NoWithVetoCount string `protobuf:"bytes,4,opt,name=no_with_veto_count,json=noWithVetoCount,proto3" json:"no_with_veto_count,omitempty"`
+}
+
+func (x *TallyResult) Reset() {
+	*x = TallyResult{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cosmos_gov_v1_gov_proto_msgTypes[6]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
 	}
-)
+}
 
-func (x ProposalStatus) Enum() *ProposalStatus {
-	p := new(ProposalStatus)
-	*p = x
-	return p
+func (x *TallyResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-func (x ProposalStatus) String() string {
-	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+func (*TallyResult) ProtoMessage() {}
+
+// Deprecated: Use TallyResult.ProtoReflect.Descriptor instead.
+func (*TallyResult) Descriptor() ([]byte, []int) {
+	return file_cosmos_gov_v1_gov_proto_rawDescGZIP(), []int{6}
 }
 
-func (ProposalStatus) Descriptor() protoreflect.EnumDescriptor {
-	return file_cosmos_gov_v1_gov_proto_enumTypes[1].Descriptor()
+func (x *TallyResult) GetYesCount() string {
+	if x != nil {
+		return x.YesCount
+	}
+	return ""
 }
 
-func (ProposalStatus) Type() protoreflect.EnumType {
-	return &file_cosmos_gov_v1_gov_proto_enumTypes[1]
+func (x *TallyResult) GetAbstainCount() string {
+	if x != nil {
+		return x.AbstainCount
+	}
+	return ""
 }
 
-func (x ProposalStatus) Number() protoreflect.EnumNumber {
-	return protoreflect.EnumNumber(x)
+func (x *TallyResult) GetNoCount() string {
+	if x != nil {
+		return x.NoCount
+	}
+	return ""
 }
 
-// Deprecated: Use ProposalStatus.Descriptor instead.
-func (ProposalStatus) EnumDescriptor() ([]byte, []int) {
-	return file_cosmos_gov_v1_gov_proto_rawDescGZIP(), []int{1}
+func (x *TallyResult) GetNoWithVetoCount() string {
+	if x != nil {
+		return x.NoWithVetoCount
+	}
+	return ""
 }
 
-// WeightedVoteOption defines a unit of vote for vote split.
-type WeightedVoteOption struct {
+// Vote defines a vote on a governance proposal.
+// A Vote consists of a proposal ID, the voter, and the vote option.
+type Vote struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// option defines the valid vote options, it must not contain duplicate vote options.
-	Option VoteOption `protobuf:"varint,1,opt,name=option,proto3,enum=cosmos.gov.v1.VoteOption" json:"option,omitempty"`
-	// weight is the vote weight associated with the vote option.
-	Weight string `protobuf:"bytes,2,opt,name=weight,proto3" json:"weight,omitempty"`
+	// proposal_id defines the unique id of the proposal.
+	ProposalId uint64 `protobuf:"varint,1,opt,name=proposal_id,json=proposalId,proto3" json:"proposal_id,omitempty"`
+	// voter is the voter address of the proposal.
+	Voter string `protobuf:"bytes,2,opt,name=voter,proto3" json:"voter,omitempty"`
+	// options is the weighted vote options.
+	Options []*WeightedVoteOption `protobuf:"bytes,4,rep,name=options,proto3" json:"options,omitempty"`
+	// metadata is any  arbitrary metadata to attached to the vote.
+	Metadata string `protobuf:"bytes,5,opt,name=metadata,proto3" json:"metadata,omitempty"`
+	// metadata_schema_id is the id of the registered metadata schema the
+	// metadata of the vote conforms to, or 0 if none.
+	//
+	// Since: cosmos-sdk 0.48
+	MetadataSchemaId uint64 `protobuf:"varint,6,opt,name=metadata_schema_id,json=metadataSchemaId,proto3" json:"metadata_schema_id,omitempty"`
 }
 
-func (x *WeightedVoteOption) Reset() {
-	*x = WeightedVoteOption{}
+func (x *Vote) Reset() {
+	*x = Vote{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_cosmos_gov_v1_gov_proto_msgTypes[0]
+		mi := &file_cosmos_gov_v1_gov_proto_msgTypes[7]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *WeightedVoteOption) String() string {
+func (x *Vote) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*WeightedVoteOption) ProtoMessage() {}
+func (*Vote) ProtoMessage() {}
 
-// Deprecated: Use WeightedVoteOption.ProtoReflect.Descriptor instead.
-func (*WeightedVoteOption) Descriptor() ([]byte, []int) {
-	return file_cosmos_gov_v1_gov_proto_rawDescGZIP(), []int{0}
+// Deprecated: Use Vote.ProtoReflect.Descriptor instead.
+func (*Vote) Descriptor() ([]byte, []int) {
+	return file_cosmos_gov_v1_gov_proto_rawDescGZIP(), []int{7}
 }
 
-func (x *WeightedVoteOption) GetOption() VoteOption {
+func (x *Vote) GetProposalId() uint64 {
 	if x != nil {
-		return x.Option
+		return x.ProposalId
 	}
-	return VoteOption_VOTE_OPTION_UNSPECIFIED
+	return 0
 }
 
-func (x *WeightedVoteOption) GetWeight() string {
+func (x *Vote) GetVoter() string {
 	if x != nil {
-		return x.Weight
+		return x.Voter
 	}
 	return ""
 }
 
-// Deposit defines an amount deposited by an account address to an active
-// proposal.
-type Deposit struct {
+func (x *Vote) GetOptions() []*WeightedVoteOption {
+	if x != nil {
+		return x.Options
+	}
+	return nil
+}
+
+func (x *Vote) GetMetadata() string {
+	if x != nil {
+		return x.Metadata
+	}
+	return ""
+}
+
+func (x *Vote) GetMetadataSchemaId() uint64 {
+	if x != nil {
+		return x.MetadataSchemaId
+	}
+	return 0
+}
+
+// VoteCommitment defines the commitment of a voter to its vote on a
+// commit-reveal proposal.
+//
+// Since: cosmos-sdk 0.48
+type VoteCommitment struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
 	// proposal_id defines the unique id of the proposal.
 	ProposalId uint64 `protobuf:"varint,1,opt,name=proposal_id,json=proposalId,proto3" json:"proposal_id,omitempty"`
-	// depositor defines the deposit addresses from the proposals.
-	Depositor string `protobuf:"bytes,2,opt,name=depositor,proto3" json:"depositor,omitempty"`
-	// amount to be deposited by depositor.
-	Amount []*v1beta1.Coin `protobuf:"bytes,3,rep,name=amount,proto3" json:"amount,omitempty"`
+	// voter is the voter address of the proposal.
+	Voter string `protobuf:"bytes,2,opt,name=voter,proto3" json:"voter,omitempty"`
+	// commitment is the SHA-256 hash of the proposal id, the voter, the vote
+	// options and the salt of the vote.
+	Commitment []byte `protobuf:"bytes,3,opt,name=commitment,proto3" json:"commitment,omitempty"`
 }
 
-func (x *Deposit) Reset() {
-	*x = Deposit{}
+func (x *VoteCommitment) Reset() {
+	*x = VoteCommitment{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_cosmos_gov_v1_gov_proto_msgTypes[1]
+		mi := &file_cosmos_gov_v1_gov_proto_msgTypes[8]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *Deposit) String() string {
+func (x *VoteCommitment) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Deposit) ProtoMessage() {}
+func (*VoteCommitment) ProtoMessage() {}
 
-// Deprecated: Use Deposit.ProtoReflect.Descriptor instead.
-func (*Deposit) Descriptor() ([]byte, []int) {
-	return file_cosmos_gov_v1_gov_proto_rawDescGZIP(), []int{1}
+// Deprecated: Use VoteCommitment.ProtoReflect.Descriptor instead.
+func (*VoteCommitment) Descriptor() ([]byte, []int) {
+	return file_cosmos_gov_v1_gov_proto_rawDescGZIP(), []int{8}
 }
 
-func (x *Deposit) GetProposalId() uint64 {
+func (x *VoteCommitment) GetProposalId() uint64 {
 	if x != nil {
 		return x.ProposalId
 	}
 	return 0
 }
 
-func (x *Deposit) GetDepositor() string {
+func (x *VoteCommitment) GetVoter() string {
 	if x != nil {
-		return x.Depositor
+		return x.Voter
 	}
 	return ""
 }
 
-func (x *Deposit) GetAmount() []*v1beta1.Coin {
+func (x *VoteCommitment) GetCommitment() []byte {
 	if x != nil {
-		return x.Amount
+		return x.Commitment
 	}
 	return nil
 }
 
-// Proposal defines the core field members of a governance proposal.
-type Proposal struct {
+// ValidatorVoteBreakdown defines, for a given proposal, how the voting power of
+// a bonded validator is split between the delegations inheriting the
+// validator's vote and the delegations whose delegators voted themselves.
+type ValidatorVoteBreakdown struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// id defines the unique id of the proposal.
-	Id uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
-	// messages are the arbitrary messages to be executed if the proposal passes.
-	Messages []*anypb.Any `protobuf:"bytes,2,rep,name=messages,proto3" json:"messages,omitempty"`
-	// status defines the proposal status.
-	Status ProposalStatus `protobuf:"varint,3,opt,name=status,proto3,enum=cosmos.gov.v1.ProposalStatus" json:"status,omitempty"`
-	// final_tally_result is the final tally result of the proposal. When
-	// querying a proposal via gRPC, this field is not populated until the
-	// proposal's voting period has ended.
-	FinalTallyResult *TallyResult `protobuf:"bytes,4,opt,name=final_tally_result,json=finalTallyResult,proto3" json:"final_tally_result,omitempty"`
-	// submit_time is the time of proposal submission.
-	SubmitTime *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=submit_time,json=submitTime,proto3" json:"submit_time,omitempty"`
-	// deposit_end_time is the end time for deposition.
-	DepositEndTime *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=deposit_end_time,json=depositEndTime,proto3" json:"deposit_end_time,omitempty"`
-	// total_deposit is the total deposit on the proposal.
-	TotalDeposit []*v1beta1.Coin `protobuf:"bytes,7,rep,name=total_deposit,json=totalDeposit,proto3" json:"total_deposit,omitempty"`
-	// voting_start_time is the starting time to vote on a proposal.
-	VotingStartTime *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=voting_start_time,json=votingStartTime,proto3" json:"voting_start_time,omitempty"`
-	// voting_end_time is the end time of voting on a proposal.
-	VotingEndTime *timestamppb.Timestamp `protobuf:"bytes,9,opt,name=voting_end_time,json=votingEndTime,proto3" json:"voting_end_time,omitempty"`
-	// metadata is any arbitrary metadata attached to the proposal.
-	Metadata string `protobuf:"bytes,10,opt,name=metadata,proto3" json:"metadata,omitempty"`
-	// title is the title of the proposal
-	//
-	// Since: cosmos-sdk 0.47
-	Title string `protobuf:"bytes,11,opt,name=title,proto3" json:"title,omitempty"`
-	// summary is a short summary of the proposal
-	//
-	// Since: cosmos-sdk 0.47
-	Summary string `protobuf:"bytes,12,opt,name=summary,proto3" json:"summary,omitempty"`
-	// proposer is the address of the proposal sumbitter
-	//
-	// Since: cosmos-sdk 0.47
-	Proposer string `protobuf:"bytes,13,opt,name=proposer,proto3" json:"proposer,omitempty"`
-	// expedited defines if the proposal is expedited
+	// proposal_id defines the unique id of the proposal.
+	ProposalId uint64 `protobuf:"varint,1,opt,name=proposal_id,json=proposalId,proto3" json:"proposal_id,omitempty"`
+	// validator_address is the operator address of the validator.
+	ValidatorAddress string `protobuf:"bytes,2,opt,name=validator_address,json=validatorAddress,proto3" json:"validator_address,omitempty"`
+	// options is the weighted vote options of the validator. It is empty if the
+	// validator did not vote.
+	Options []*WeightedVoteOption `protobuf:"bytes,3,rep,name=options,proto3" json:"options,omitempty"`
+	// inherited_power is the voting power of the delegations which did not
+	// override the validator's vote. It only counts towards the tally when the
+	// validator voted.
+	InheritedPower string `protobuf:"bytes,4,opt,name=inherited_power,json=inheritedPower,proto3" json:"inherited_power,omitempty"`
+	// overridden_power is the voting power of the delegations whose delegators
+	// cast their own vote.
+	OverriddenPower string `protobuf:"bytes,5,opt,name=overridden_power,json=overriddenPower,proto3" json:"overridden_power,omitempty"`
+	// participation_exempt is true if the voting power of the validator was
+	// below the participation exemption threshold at tally time, in which case
+	// its participation is not tracked.
 	//
 	// Since: cosmos-sdk 0.48
-	Expedited bool `protobuf:"varint,14,opt,name=expedited,proto3" json:"expedited,omitempty"`
+	ParticipationExempt bool `protobuf:"varint,6,opt,name=participation_exempt,json=participationExempt,proto3" json:"participation_exempt,omitempty"`
 }
 
-func (x *Proposal) Reset() {
-	*x = Proposal{}
+func (x *ValidatorVoteBreakdown) Reset() {
+	*x = ValidatorVoteBreakdown{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_cosmos_gov_v1_gov_proto_msgTypes[2]
+		mi := &file_cosmos_gov_v1_gov_proto_msgTypes[9]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *Proposal) String() string {
+func (x *ValidatorVoteBreakdown) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Proposal) ProtoMessage() {}
+func (*ValidatorVoteBreakdown) ProtoMessage() {}
 
-// Deprecated: Use Proposal.ProtoReflect.Descriptor instead.
-func (*Proposal) Descriptor() ([]byte, []int) {
-	return file_cosmos_gov_v1_gov_proto_rawDescGZIP(), []int{2}
+// Deprecated: Use ValidatorVoteBreakdown.ProtoReflect.Descriptor instead.
+func (*ValidatorVoteBreakdown) Descriptor() ([]byte, []int) {
+	return file_cosmos_gov_v1_gov_proto_rawDescGZIP(), []int{9}
 }
 
-func (x *Proposal) GetId() uint64 {
+func (x *ValidatorVoteBreakdown) GetProposalId() uint64 {
 	if x != nil {
-		return x.Id
+		return x.ProposalId
 	}
 	return 0
 }
 
-func (x *Proposal) GetMessages() []*anypb.Any {
+func (x *ValidatorVoteBreakdown) GetValidatorAddress() string {
 	if x != nil {
-		return x.Messages
+		return x.ValidatorAddress
 	}
-	return nil
+	return ""
 }
 
-func (x *Proposal) GetStatus() ProposalStatus {
+func (x *ValidatorVoteBreakdown) GetOptions() []*WeightedVoteOption {
 	if x != nil {
-		return x.Status
+		return x.Options
 	}
-	return ProposalStatus_PROPOSAL_STATUS_UNSPECIFIED
+	return nil
 }
 
-func (x *Proposal) GetFinalTallyResult() *TallyResult {
+func (x *ValidatorVoteBreakdown) GetInheritedPower() string {
 	if x != nil {
-		return x.FinalTallyResult
+		return x.InheritedPower
 	}
-	return nil
+	return ""
 }
 
-func (x *Proposal) GetSubmitTime() *timestamppb.Timestamp {
+func (x *ValidatorVoteBreakdown) GetOverriddenPower() string {
 	if x != nil {
-		return x.SubmitTime
+		return x.OverriddenPower
 	}
-	return nil
+	return ""
 }
 
-func (x *Proposal) GetDepositEndTime() *timestamppb.Timestamp {
+func (x *ValidatorVoteBreakdown) GetParticipationExempt() bool {
 	if x != nil {
-		return x.DepositEndTime
+		return x.ParticipationExempt
 	}
-	return nil
+	return false
 }
 
-func (x *Proposal) GetTotalDeposit() []*v1beta1.Coin {
-	if x != nil {
-		return x.TotalDeposit
+// ValidatorVotedShares defines, for a given proposal in its voting period, the
+// delegation shares of a validator whose delegators cast their own vote. It is
+// kept up to date as votes are cast and delegations change, so that the tally
+// does not need to iterate over the delegations of the voters.
+//
+// Since: cosmos-sdk 0.48
+type ValidatorVotedShares struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// yes is the sum of the voted shares weighted by their Yes option weight.
+	Yes string `protobuf:"bytes,1,opt,name=yes,proto3" json:"yes,omitempty"`
+	// abstain is the sum of the voted shares weighted by their Abstain option
+	// weight.
+	Abstain string `protobuf:"bytes,2,opt,name=abstain,proto3" json:"abstain,omitempty"`
+	// no is the sum of the voted shares weighted by their No option weight.
+	No string `protobuf:"bytes,3,opt,name=no,proto3" json:"no,omitempty"`
+	// no_with_veto is the sum of the voted shares weighted by their NoWithVeto
+	// option weight.
+	NoWithVeto string `protobuf:"bytes,4,opt,name=no_with_veto,json=noWithVeto,proto3" json:"no_with_veto,omitempty"`
+	// voted is the sum of the voted shares.
+	Voted string `protobuf:"bytes,5,opt,name=voted,proto3" json:"voted,omitempty"`
+}
+
+func (x *ValidatorVotedShares) Reset() {
+	*x = ValidatorVotedShares{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cosmos_gov_v1_gov_proto_msgTypes[10]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
 	}
-	return nil
 }
 
-func (x *Proposal) GetVotingStartTime() *timestamppb.Timestamp {
-	if x != nil {
-		return x.VotingStartTime
-	}
-	return nil
+func (x *ValidatorVotedShares) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-func (x *Proposal) GetVotingEndTime() *timestamppb.Timestamp {
-	if x != nil {
-		return x.VotingEndTime
-	}
-	return nil
+func (*ValidatorVotedShares) ProtoMessage() {}
+
+// Deprecated: Use ValidatorVotedShares.ProtoReflect.Descriptor instead.
+func (*ValidatorVotedShares) Descriptor() ([]byte, []int) {
+	return file_cosmos_gov_v1_gov_proto_rawDescGZIP(), []int{10}
 }
 
-func (x *Proposal) GetMetadata() string {
+func (x *ValidatorVotedShares) GetYes() string {
 	if x != nil {
-		return x.Metadata
+		return x.Yes
 	}
 	return ""
 }
 
-func (x *Proposal) GetTitle() string {
+func (x *ValidatorVotedShares) GetAbstain() string {
 	if x != nil {
-		return x.Title
+		return x.Abstain
 	}
 	return ""
 }
 
-func (x *Proposal) GetSummary() string {
+func (x *ValidatorVotedShares) GetNo() string {
 	if x != nil {
-		return x.Summary
+		return x.No
 	}
 	return ""
 }
 
-func (x *Proposal) GetProposer() string {
+func (x *ValidatorVotedShares) GetNoWithVeto() string {
 	if x != nil {
-		return x.Proposer
+		return x.NoWithVeto
 	}
 	return ""
 }
 
-func (x *Proposal) GetExpedited() bool {
+func (x *ValidatorVotedShares) GetVoted() string {
 	if x != nil {
-		return x.Expedited
+		return x.Voted
 	}
-	return false
+	return ""
 }
 
-// TallyResult defines a standard tally for a governance proposal.
-type TallyResult struct {
+// ConstitutionAmendment defines an amendment of the chain's constitution made by
+// a constitution amendment proposal.
+//
+// Since: cosmos-sdk 0.48
+type ConstitutionAmendment struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// yes_count is the number of yes votes on a proposal.
-	YesCount string `protobuf:"bytes,1,opt,name=yes_count,json=yesCount,proto3" json:"yes_count,omitempty"`
-	// abstain_count is the number of abstain votes on a proposal.
-	AbstainCount string `protobuf:"bytes,2,opt,name=abstain_count,json=abstainCount,proto3" json:"abstain_count,omitempty"`
-	// no_count is the number of no votes on a proposal.
-	NoCount string `protobuf:"bytes,3,opt,name=no_count,json=noCount,proto3" json:"no_count,omitempty"`
-	// no_with_veto_count is the number of no with veto votes on a proposal.
-	NoWithVetoCount string `protobuf:"bytes,4,opt,name=no_with_veto_count,json=noWithVetoCount,proto3" json:"no_with_veto_count,omitempty"`
+	// id defines the unique id of the amendment, amendments are numbered in the
+	// order they were made.
+	Id uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	// proposal_id defines the unique id of the proposal which made the amendment.
+	ProposalId uint64 `protobuf:"varint,2,opt,name=proposal_id,json=proposalId,proto3" json:"proposal_id,omitempty"`
+	// constitution is the text of the constitution after the amendment.
+	Constitution string `protobuf:"bytes,3,opt,name=constitution,proto3" json:"constitution,omitempty"`
+	// amended_at is the block time at which the amendment was made.
+	AmendedAt *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=amended_at,json=amendedAt,proto3" json:"amended_at,omitempty"`
 }
 
-func (x *TallyResult) Reset() {
-	*x = TallyResult{}
+func (x *ConstitutionAmendment) Reset() {
+	*x = ConstitutionAmendment{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_cosmos_gov_v1_gov_proto_msgTypes[3]
+		mi := &file_cosmos_gov_v1_gov_proto_msgTypes[11]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *TallyResult) String() string {
+func (x *ConstitutionAmendment) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*TallyResult) ProtoMessage() {}
+func (*ConstitutionAmendment) ProtoMessage() {}
 
-// Deprecated: Use TallyResult.ProtoReflect.Descriptor instead.
-func (*TallyResult) Descriptor() ([]byte, []int) {
-	return file_cosmos_gov_v1_gov_proto_rawDescGZIP(), []int{3}
+// Deprecated: Use ConstitutionAmendment.ProtoReflect.Descriptor instead.
+func (*ConstitutionAmendment) Descriptor() ([]byte, []int) {
+	return file_cosmos_gov_v1_gov_proto_rawDescGZIP(), []int{11}
 }
 
-func (x *TallyResult) GetYesCount() string {
+func (x *ConstitutionAmendment) GetId() uint64 {
 	if x != nil {
-		return x.YesCount
+		return x.Id
 	}
-	return ""
+	return 0
 }
 
-func (x *TallyResult) GetAbstainCount() string {
+func (x *ConstitutionAmendment) GetProposalId() uint64 {
 	if x != nil {
-		return x.AbstainCount
+		return x.ProposalId
 	}
-	return ""
+	return 0
 }
 
-func (x *TallyResult) GetNoCount() string {
+func (x *ConstitutionAmendment) GetConstitution() string {
 	if x != nil {
-		return x.NoCount
+		return x.Constitution
 	}
 	return ""
 }
 
-func (x *TallyResult) GetNoWithVetoCount() string {
+func (x *ConstitutionAmendment) GetAmendedAt() *timestamppb.Timestamp {
 	if x != nil {
-		return x.NoWithVetoCount
+		return x.AmendedAt
 	}
-	return ""
+	return nil
 }
 
-// Vote defines a vote on a governance proposal.
-// A Vote consists of a proposal ID, the voter, and the vote option.
-type Vote struct {
+// MetadataSchema defines a schema registered on chain, which the metadata of
+// the proposals, votes and deposits can reference so that governance frontends
+// agree on its structure. Only the hash of the schema document is stored on
+// chain, the document itself is published at its uri.
+//
+// Since: cosmos-sdk 0.48
+type MetadataSchema struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// proposal_id defines the unique id of the proposal.
-	ProposalId uint64 `protobuf:"varint,1,opt,name=proposal_id,json=proposalId,proto3" json:"proposal_id,omitempty"`
-	// voter is the voter address of the proposal.
-	Voter string `protobuf:"bytes,2,opt,name=voter,proto3" json:"voter,omitempty"`
-	// options is the weighted vote options.
-	Options []*WeightedVoteOption `protobuf:"bytes,4,rep,name=options,proto3" json:"options,omitempty"`
-	// metadata is any  arbitrary metadata to attached to the vote.
-	Metadata string `protobuf:"bytes,5,opt,name=metadata,proto3" json:"metadata,omitempty"`
+	// id defines the unique id of the schema, schemas are numbered in the order
+	// they were registered.
+	Id uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	// name is the human readable name of the schema, e.g. "proposal-v1".
+	Name string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	// uri is the location the schema document is published at.
+	Uri string `protobuf:"bytes,3,opt,name=uri,proto3" json:"uri,omitempty"`
+	// hash is the hex encoded SHA-256 hash of the schema document, typically a
+	// JSON schema.
+	Hash string `protobuf:"bytes,4,opt,name=hash,proto3" json:"hash,omitempty"`
 }
 
-func (x *Vote) Reset() {
-	*x = Vote{}
+func (x *MetadataSchema) Reset() {
+	*x = MetadataSchema{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_cosmos_gov_v1_gov_proto_msgTypes[4]
+		mi := &file_cosmos_gov_v1_gov_proto_msgTypes[12]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *Vote) String() string {
+func (x *MetadataSchema) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Vote) ProtoMessage() {}
+func (*MetadataSchema) ProtoMessage() {}
 
-// Deprecated: Use Vote.ProtoReflect.Descriptor instead.
-func (*Vote) Descriptor() ([]byte, []int) {
-	return file_cosmos_gov_v1_gov_proto_rawDescGZIP(), []int{4}
+// Deprecated: Use MetadataSchema.ProtoReflect.Descriptor instead.
+func (*MetadataSchema) Descriptor() ([]byte, []int) {
+	return file_cosmos_gov_v1_gov_proto_rawDescGZIP(), []int{12}
 }
 
-func (x *Vote) GetProposalId() uint64 {
+func (x *MetadataSchema) GetId() uint64 {
 	if x != nil {
-		return x.ProposalId
+		return x.Id
 	}
 	return 0
 }
 
-func (x *Vote) GetVoter() string {
+func (x *MetadataSchema) GetName() string {
 	if x != nil {
-		return x.Voter
+		return x.Name
 	}
 	return ""
 }
 
-func (x *Vote) GetOptions() []*WeightedVoteOption {
+func (x *MetadataSchema) GetUri() string {
 	if x != nil {
-		return x.Options
+		return x.Uri
 	}
-	return nil
+	return ""
 }
 
-func (x *Vote) GetMetadata() string {
+func (x *MetadataSchema) GetHash() string {
 	if x != nil {
-		return x.Metadata
+		return x.Hash
 	}
 	return ""
 }
@@ -7402,7 +16507,7 @@ type DepositParams struct {
 func (x *DepositParams) Reset() {
 	*x = DepositParams{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_cosmos_gov_v1_gov_proto_msgTypes[5]
+		mi := &file_cosmos_gov_v1_gov_proto_msgTypes[13]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -7416,7 +16521,7 @@ func (*DepositParams) ProtoMessage() {}
 
 // Deprecated: Use DepositParams.ProtoReflect.Descriptor instead.
 func (*DepositParams) Descriptor() ([]byte, []int) {
-	return file_cosmos_gov_v1_gov_proto_rawDescGZIP(), []int{5}
+	return file_cosmos_gov_v1_gov_proto_rawDescGZIP(), []int{13}
 }
 
 func (x *DepositParams) GetMinDeposit() []*v1beta1.Coin {
@@ -7448,7 +16553,7 @@ type VotingParams struct {
 func (x *VotingParams) Reset() {
 	*x = VotingParams{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_cosmos_gov_v1_gov_proto_msgTypes[6]
+		mi := &file_cosmos_gov_v1_gov_proto_msgTypes[14]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -7462,7 +16567,7 @@ func (*VotingParams) ProtoMessage() {}
 
 // Deprecated: Use VotingParams.ProtoReflect.Descriptor instead.
 func (*VotingParams) Descriptor() ([]byte, []int) {
-	return file_cosmos_gov_v1_gov_proto_rawDescGZIP(), []int{6}
+	return file_cosmos_gov_v1_gov_proto_rawDescGZIP(), []int{14}
 }
 
 func (x *VotingParams) GetVotingPeriod() *durationpb.Duration {
@@ -7488,12 +16593,22 @@ type TallyParams struct {
 	// Minimum value of Veto votes to Total votes ratio for proposal to be
 	// vetoed. Default value: 1/3.
 	VetoThreshold string `protobuf:"bytes,3,opt,name=veto_threshold,json=vetoThreshold,proto3" json:"veto_threshold,omitempty"`
+	// Minimum percentage of total stake needed to vote for the Veto votes to
+	// count. Default value: 0.
+	//
+	// Since: cosmos-sdk 0.48
+	VetoQuorum string `protobuf:"bytes,4,opt,name=veto_quorum,json=vetoQuorum,proto3" json:"veto_quorum,omitempty"`
+	// The quorums overriding quorum and veto_quorum for the proposals of given
+	// kinds.
+	//
+	// Since: cosmos-sdk 0.48
+	ProposalKindQuorums []*ProposalKindQuorum `protobuf:"bytes,5,rep,name=proposal_kind_quorums,json=proposalKindQuorums,proto3" json:"proposal_kind_quorums,omitempty"`
 }
 
 func (x *TallyParams) Reset() {
 	*x = TallyParams{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_cosmos_gov_v1_gov_proto_msgTypes[7]
+		mi := &file_cosmos_gov_v1_gov_proto_msgTypes[15]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -7507,7 +16622,7 @@ func (*TallyParams) ProtoMessage() {}
 
 // Deprecated: Use TallyParams.ProtoReflect.Descriptor instead.
 func (*TallyParams) Descriptor() ([]byte, []int) {
-	return file_cosmos_gov_v1_gov_proto_rawDescGZIP(), []int{7}
+	return file_cosmos_gov_v1_gov_proto_rawDescGZIP(), []int{15}
 }
 
 func (x *TallyParams) GetQuorum() string {
@@ -7531,6 +16646,20 @@ func (x *TallyParams) GetVetoThreshold() string {
 	return ""
 }
 
+func (x *TallyParams) GetVetoQuorum() string {
+	if x != nil {
+		return x.VetoQuorum
+	}
+	return ""
+}
+
+func (x *TallyParams) GetProposalKindQuorums() []*ProposalKindQuorum {
+	if x != nil {
+		return x.ProposalKindQuorums
+	}
+	return nil
+}
+
 // Params defines the parameters for the x/gov module.
 //
 // Since: cosmos-sdk 0.47
@@ -7546,15 +16675,15 @@ type Params struct {
 	MaxDepositPeriod *durationpb.Duration `protobuf:"bytes,2,opt,name=max_deposit_period,json=maxDepositPeriod,proto3" json:"max_deposit_period,omitempty"`
 	// Duration of the voting period.
 	VotingPeriod *durationpb.Duration `protobuf:"bytes,3,opt,name=voting_period,json=votingPeriod,proto3" json:"voting_period,omitempty"`
-	// Minimum percentage of total stake needed to vote for a result to be
-	// considered valid.
+	//  Minimum percentage of total stake needed to vote for a result to be
+	//  considered valid.
 	Quorum string `protobuf:"bytes,4,opt,name=quorum,proto3" json:"quorum,omitempty"`
-	// Minimum proportion of Yes votes for proposal to pass. Default value: 0.5.
+	//  Minimum proportion of Yes votes for proposal to pass. Default value: 0.5.
 	Threshold string `protobuf:"bytes,5,opt,name=threshold,proto3" json:"threshold,omitempty"`
-	// Minimum value of Veto votes to Total votes ratio for proposal to be
-	// vetoed. Default value: 1/3.
+	//  Minimum value of Veto votes to Total votes ratio for proposal to be
+	//  vetoed. Default value: 1/3.
 	VetoThreshold string `protobuf:"bytes,6,opt,name=veto_threshold,json=vetoThreshold,proto3" json:"veto_threshold,omitempty"`
-	// The ratio representing the proportion of the deposit value that must be paid at proposal submission.
+	//  The ratio representing the proportion of the deposit value that must be paid at proposal submission.
 	MinInitialDepositRatio string `protobuf:"bytes,7,opt,name=min_initial_deposit_ratio,json=minInitialDepositRatio,proto3" json:"min_initial_deposit_ratio,omitempty"`
 	// The cancel ratio which will not be returned back to the depositors when a proposal is cancelled.
 	//
@@ -7573,7 +16702,7 @@ type Params struct {
 	//
 	// Since: cosmos-sdk 0.48
 	ExpeditedThreshold string `protobuf:"bytes,11,opt,name=expedited_threshold,json=expeditedThreshold,proto3" json:"expedited_threshold,omitempty"`
-	// Minimum expedited deposit for a proposal to enter voting period.
+	//  Minimum expedited deposit for a proposal to enter voting period.
 	ExpeditedMinDeposit []*v1beta1.Coin `protobuf:"bytes,12,rep,name=expedited_min_deposit,json=expeditedMinDeposit,proto3" json:"expedited_min_deposit,omitempty"`
 	// burn deposits if a proposal does not meet quorum
 	BurnVoteQuorum bool `protobuf:"varint,13,opt,name=burn_vote_quorum,json=burnVoteQuorum,proto3" json:"burn_vote_quorum,omitempty"`
@@ -7581,129 +16710,451 @@ type Params struct {
 	BurnProposalDepositPrevote bool `protobuf:"varint,14,opt,name=burn_proposal_deposit_prevote,json=burnProposalDepositPrevote,proto3" json:"burn_proposal_deposit_prevote,omitempty"`
 	// burn deposits if quorum with vote type no_veto is met
 	BurnVoteVeto bool `protobuf:"varint,15,opt,name=burn_vote_veto,json=burnVoteVeto,proto3" json:"burn_vote_veto,omitempty"`
+	// The proportion of the minimum deposit that a proposal must reach for its
+	// deposit period to be extended when it is about to end.
+	//
+	// Since: cosmos-sdk 0.48
+	DepositExtensionRatio string `protobuf:"bytes,16,opt,name=deposit_extension_ratio,json=depositExtensionRatio,proto3" json:"deposit_extension_ratio,omitempty"`
+	// Duration by which the deposit period of a proposal is extended, once, when
+	// its deposit reaches deposit_extension_ratio of the minimum deposit less
+	// than this duration before the deposit period ends. Zero disables extensions.
+	//
+	// Since: cosmos-sdk 0.48
+	DepositExtensionPeriod *durationpb.Duration `protobuf:"bytes,17,opt,name=deposit_extension_period,json=depositExtensionPeriod,proto3" json:"deposit_extension_period,omitempty"`
+	// The addresses, other than the gov module account, which proposals may
+	// designate as the authority executing their messages, e.g. the module
+	// account of a subDAO.
+	//
+	// Since: cosmos-sdk 0.48
+	ExecutionAuthorities []string `protobuf:"bytes,18,rep,name=execution_authorities,json=executionAuthorities,proto3" json:"execution_authorities,omitempty"`
+	// Duration of the discussion period between the deposit period and the
+	// voting period of a proposal, during which the proposer can amend the
+	// proposal metadata and depositors can withdraw their deposits. Zero disables
+	// the discussion period.
+	//
+	// Since: cosmos-sdk 0.48
+	DiscussionPeriod *durationpb.Duration `protobuf:"bytes,19,opt,name=discussion_period,json=discussionPeriod,proto3" json:"discussion_period,omitempty"`
+	// early_resolution enables finalizing proposals before the end of their
+	// voting period once the voting power which has not voted yet cannot change
+	// their outcome anymore.
+	//
+	// Since: cosmos-sdk 0.48
+	EarlyResolution bool `protobuf:"varint,20,opt,name=early_resolution,json=earlyResolution,proto3" json:"early_resolution,omitempty"`
+	// The maximum number of proposals whose voting period ended which are
+	// tallied in a single EndBlocker. The remaining proposals are carried over to
+	// the following blocks, in the order of their voting end time and proposal
+	// ID. Zero means no limit.
+	//
+	// Since: cosmos-sdk 0.48
+	MaxProposalsProcessedPerEndBlock uint64 `protobuf:"varint,21,opt,name=max_proposals_processed_per_end_block,json=maxProposalsProcessedPerEndBlock,proto3" json:"max_proposals_processed_per_end_block,omitempty"`
+	// Minimum proportion of Yes votes for a constitution amendment proposal to
+	// pass. It must be at least the regular threshold.
+	//
+	// Since: cosmos-sdk 0.48
+	ConstitutionAmendmentThreshold string `protobuf:"bytes,22,opt,name=constitution_amendment_threshold,json=constitutionAmendmentThreshold,proto3" json:"constitution_amendment_threshold,omitempty"`
+	// The way Abstain votes are counted in the tally of proposals.
+	//
+	// Since: cosmos-sdk 0.48
+	AbstainSemantics AbstainSemantics `protobuf:"varint,23,opt,name=abstain_semantics,json=abstainSemantics,proto3,enum=cosmos.gov.v1.AbstainSemantics" json:"abstain_semantics,omitempty"`
+	// The denoms, other than the ones of the minimum deposits, accepted for
+	// proposal deposits, with their exchange ratio to a minimum deposit denom.
+	//
+	// Since: cosmos-sdk 0.48
+	AcceptedDepositDenoms []*AcceptedDepositDenom `protobuf:"bytes,24,rep,name=accepted_deposit_denoms,json=acceptedDepositDenoms,proto3" json:"accepted_deposit_denoms,omitempty"`
+	// Minimum duration left in the discussion period of a proposal after it is
+	// amended by its proposer. Amending a proposal extends its discussion period
+	// so that it does not end sooner than amendment_period after the amendment.
+	// Zero disables the extension.
+	//
+	// Since: cosmos-sdk 0.48
+	AmendmentPeriod *durationpb.Duration `protobuf:"bytes,25,opt,name=amendment_period,json=amendmentPeriod,proto3" json:"amendment_period,omitempty"`
+	// Fraction of the total bonded tokens below which a validator's voting power
+	// exempts it from governance participation tracking, evaluated at tally
+	// time. The votes of exempt validators still count towards the tally. Zero
+	// disables the exemption.
+	//
+	// Since: cosmos-sdk 0.48
+	ParticipationExemptionThreshold string `protobuf:"bytes,26,opt,name=participation_exemption_threshold,json=participationExemptionThreshold,proto3" json:"participation_exemption_threshold,omitempty"`
+	// min_deposit_any_denom makes a deposit threshold met once the value of the
+	// deposit reaches the amount of any one of its denoms, instead of all of
+	// them, for chains whose users hold different native assets.
+	//
+	// Since: cosmos-sdk 0.48
+	MinDepositAnyDenom bool `protobuf:"varint,27,opt,name=min_deposit_any_denom,json=minDepositAnyDenom,proto3" json:"min_deposit_any_denom,omitempty"`
+	// Duration of the reveal period following the commit period of commit-reveal
+	// proposals, during which voters reveal the votes they committed to. The
+	// commit period lasts the voting period. Zero disables commit-reveal voting.
+	//
+	// Since: cosmos-sdk 0.48
+	RevealPeriod *durationpb.Duration `protobuf:"bytes,28,opt,name=reveal_period,json=revealPeriod,proto3" json:"reveal_period,omitempty"`
+	// Minimum percentage of total stake needed to vote for the Veto votes to
+	// count, so that a small turnout cannot veto a proposal. Below it, the Veto
+	// votes count as No votes. Zero makes the Veto votes always count.
+	//
+	// Since: cosmos-sdk 0.48
+	VetoQuorum string `protobuf:"bytes,29,opt,name=veto_quorum,json=vetoQuorum,proto3" json:"veto_quorum,omitempty"`
+	// The quorums overriding quorum and veto_quorum for the proposals of given
+	// kinds, e.g. "expedited".
+	//
+	// Since: cosmos-sdk 0.48
+	ProposalKindQuorums []*ProposalKindQuorum `protobuf:"bytes,30,rep,name=proposal_kind_quorums,json=proposalKindQuorums,proto3" json:"proposal_kind_quorums,omitempty"`
+}
+
+func (x *Params) Reset() {
+	*x = Params{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cosmos_gov_v1_gov_proto_msgTypes[16]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Params) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Params) ProtoMessage() {}
+
+// Deprecated: Use Params.ProtoReflect.Descriptor instead.
+func (*Params) Descriptor() ([]byte, []int) {
+	return file_cosmos_gov_v1_gov_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *Params) GetMinDeposit() []*v1beta1.Coin {
+	if x != nil {
+		return x.MinDeposit
+	}
+	return nil
+}
+
+func (x *Params) GetMaxDepositPeriod() *durationpb.Duration {
+	if x != nil {
+		return x.MaxDepositPeriod
+	}
+	return nil
+}
+
+func (x *Params) GetVotingPeriod() *durationpb.Duration {
+	if x != nil {
+		return x.VotingPeriod
+	}
+	return nil
+}
+
+func (x *Params) GetQuorum() string {
+	if x != nil {
+		return x.Quorum
+	}
+	return ""
+}
+
+func (x *Params) GetThreshold() string {
+	if x != nil {
+		return x.Threshold
+	}
+	return ""
+}
+
+func (x *Params) GetVetoThreshold() string {
+	if x != nil {
+		return x.VetoThreshold
+	}
+	return ""
+}
+
+func (x *Params) GetMinInitialDepositRatio() string {
+	if x != nil {
+		return x.MinInitialDepositRatio
+	}
+	return ""
+}
+
+func (x *Params) GetProposalCancelRatio() string {
+	if x != nil {
+		return x.ProposalCancelRatio
+	}
+	return ""
+}
+
+func (x *Params) GetProposalCancelDest() string {
+	if x != nil {
+		return x.ProposalCancelDest
+	}
+	return ""
+}
+
+func (x *Params) GetExpeditedVotingPeriod() *durationpb.Duration {
+	if x != nil {
+		return x.ExpeditedVotingPeriod
+	}
+	return nil
+}
+
+func (x *Params) GetExpeditedThreshold() string {
+	if x != nil {
+		return x.ExpeditedThreshold
+	}
+	return ""
+}
+
+func (x *Params) GetExpeditedMinDeposit() []*v1beta1.Coin {
+	if x != nil {
+		return x.ExpeditedMinDeposit
+	}
+	return nil
+}
+
+func (x *Params) GetBurnVoteQuorum() bool {
+	if x != nil {
+		return x.BurnVoteQuorum
+	}
+	return false
+}
+
+func (x *Params) GetBurnProposalDepositPrevote() bool {
+	if x != nil {
+		return x.BurnProposalDepositPrevote
+	}
+	return false
+}
+
+func (x *Params) GetBurnVoteVeto() bool {
+	if x != nil {
+		return x.BurnVoteVeto
+	}
+	return false
 }
 
-func (x *Params) Reset() {
-	*x = Params{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_cosmos_gov_v1_gov_proto_msgTypes[8]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
+func (x *Params) GetDepositExtensionRatio() string {
+	if x != nil {
+		return x.DepositExtensionRatio
 	}
+	return ""
 }
 
-func (x *Params) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *Params) GetDepositExtensionPeriod() *durationpb.Duration {
+	if x != nil {
+		return x.DepositExtensionPeriod
+	}
+	return nil
 }
 
-func (*Params) ProtoMessage() {}
-
-// Deprecated: Use Params.ProtoReflect.Descriptor instead.
-func (*Params) Descriptor() ([]byte, []int) {
-	return file_cosmos_gov_v1_gov_proto_rawDescGZIP(), []int{8}
+func (x *Params) GetExecutionAuthorities() []string {
+	if x != nil {
+		return x.ExecutionAuthorities
+	}
+	return nil
 }
 
-func (x *Params) GetMinDeposit() []*v1beta1.Coin {
+func (x *Params) GetDiscussionPeriod() *durationpb.Duration {
 	if x != nil {
-		return x.MinDeposit
+		return x.DiscussionPeriod
 	}
 	return nil
 }
 
-func (x *Params) GetMaxDepositPeriod() *durationpb.Duration {
+func (x *Params) GetEarlyResolution() bool {
 	if x != nil {
-		return x.MaxDepositPeriod
+		return x.EarlyResolution
+	}
+	return false
+}
+
+func (x *Params) GetMaxProposalsProcessedPerEndBlock() uint64 {
+	if x != nil {
+		return x.MaxProposalsProcessedPerEndBlock
+	}
+	return 0
+}
+
+func (x *Params) GetConstitutionAmendmentThreshold() string {
+	if x != nil {
+		return x.ConstitutionAmendmentThreshold
+	}
+	return ""
+}
+
+func (x *Params) GetAbstainSemantics() AbstainSemantics {
+	if x != nil {
+		return x.AbstainSemantics
+	}
+	return AbstainSemantics_ABSTAIN_SEMANTICS_UNSPECIFIED
+}
+
+func (x *Params) GetAcceptedDepositDenoms() []*AcceptedDepositDenom {
+	if x != nil {
+		return x.AcceptedDepositDenoms
 	}
 	return nil
 }
 
-func (x *Params) GetVotingPeriod() *durationpb.Duration {
+func (x *Params) GetAmendmentPeriod() *durationpb.Duration {
 	if x != nil {
-		return x.VotingPeriod
+		return x.AmendmentPeriod
 	}
 	return nil
 }
 
-func (x *Params) GetQuorum() string {
+func (x *Params) GetParticipationExemptionThreshold() string {
 	if x != nil {
-		return x.Quorum
+		return x.ParticipationExemptionThreshold
 	}
 	return ""
 }
 
-func (x *Params) GetThreshold() string {
+func (x *Params) GetMinDepositAnyDenom() bool {
 	if x != nil {
-		return x.Threshold
+		return x.MinDepositAnyDenom
 	}
-	return ""
+	return false
 }
 
-func (x *Params) GetVetoThreshold() string {
+func (x *Params) GetRevealPeriod() *durationpb.Duration {
 	if x != nil {
-		return x.VetoThreshold
+		return x.RevealPeriod
 	}
-	return ""
+	return nil
 }
 
-func (x *Params) GetMinInitialDepositRatio() string {
+func (x *Params) GetVetoQuorum() string {
 	if x != nil {
-		return x.MinInitialDepositRatio
+		return x.VetoQuorum
 	}
 	return ""
 }
 
-func (x *Params) GetProposalCancelRatio() string {
+func (x *Params) GetProposalKindQuorums() []*ProposalKindQuorum {
 	if x != nil {
-		return x.ProposalCancelRatio
+		return x.ProposalKindQuorums
 	}
-	return ""
+	return nil
 }
 
-func (x *Params) GetProposalCancelDest() string {
+// ProposalKindQuorum defines the quorums of the proposals of a given kind.
+//
+// Since: cosmos-sdk 0.48
+type ProposalKindQuorum struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// kind is the kind of the proposals, e.g. "standard" or "expedited".
+	Kind string `protobuf:"bytes,1,opt,name=kind,proto3" json:"kind,omitempty"`
+	// quorum is the minimum percentage of total stake needed to vote for a
+	// result to be considered valid. The quorum of the params applies if empty.
+	Quorum string `protobuf:"bytes,2,opt,name=quorum,proto3" json:"quorum,omitempty"`
+	// veto_quorum is the minimum percentage of total stake needed to vote for
+	// the Veto votes to count. The veto quorum of the params applies if empty.
+	VetoQuorum string `protobuf:"bytes,3,opt,name=veto_quorum,json=vetoQuorum,proto3" json:"veto_quorum,omitempty"`
+}
+
+func (x *ProposalKindQuorum) Reset() {
+	*x = ProposalKindQuorum{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cosmos_gov_v1_gov_proto_msgTypes[17]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ProposalKindQuorum) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ProposalKindQuorum) ProtoMessage() {}
+
+// Deprecated: Use ProposalKindQuorum.ProtoReflect.Descriptor instead.
+func (*ProposalKindQuorum) Descriptor() ([]byte, []int) {
+	return file_cosmos_gov_v1_gov_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *ProposalKindQuorum) GetKind() string {
 	if x != nil {
-		return x.ProposalCancelDest
+		return x.Kind
 	}
 	return ""
 }
 
-func (x *Params) GetExpeditedVotingPeriod() *durationpb.Duration {
+func (x *ProposalKindQuorum) GetQuorum() string {
 	if x != nil {
-		return x.ExpeditedVotingPeriod
+		return x.Quorum
 	}
-	return nil
+	return ""
 }
 
-func (x *Params) GetExpeditedThreshold() string {
+func (x *ProposalKindQuorum) GetVetoQuorum() string {
 	if x != nil {
-		return x.ExpeditedThreshold
+		return x.VetoQuorum
 	}
 	return ""
 }
 
-func (x *Params) GetExpeditedMinDeposit() []*v1beta1.Coin {
+// AcceptedDepositDenom defines a denom accepted for proposal deposits in place
+// of a minimum deposit denom.
+//
+// Since: cosmos-sdk 0.48
+type AcceptedDepositDenom struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// denom is the denom accepted for deposits.
+	Denom string `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty"`
+	// base_denom is the minimum deposit denom towards which deposits of denom
+	// count.
+	BaseDenom string `protobuf:"bytes,2,opt,name=base_denom,json=baseDenom,proto3" json:"base_denom,omitempty"`
+	// ratio is the amount of base_denom which one unit of denom is worth.
+	Ratio string `protobuf:"bytes,3,opt,name=ratio,proto3" json:"ratio,omitempty"`
+	// use_oracle makes the ratio read from the deposit ratio oracle set by the
+	// application, if any, falling back to ratio when the oracle has no ratio
+	// for the denom.
+	UseOracle bool `protobuf:"varint,4,opt,name=use_oracle,json=useOracle,proto3" json:"use_oracle,omitempty"`
+}
+
+func (x *AcceptedDepositDenom) Reset() {
+	*x = AcceptedDepositDenom{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cosmos_gov_v1_gov_proto_msgTypes[18]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AcceptedDepositDenom) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AcceptedDepositDenom) ProtoMessage() {}
+
+// Deprecated: Use AcceptedDepositDenom.ProtoReflect.Descriptor instead.
+func (*AcceptedDepositDenom) Descriptor() ([]byte, []int) {
+	return file_cosmos_gov_v1_gov_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *AcceptedDepositDenom) GetDenom() string {
 	if x != nil {
-		return x.ExpeditedMinDeposit
+		return x.Denom
 	}
-	return nil
+	return ""
 }
 
-func (x *Params) GetBurnVoteQuorum() bool {
+func (x *AcceptedDepositDenom) GetBaseDenom() string {
 	if x != nil {
-		return x.BurnVoteQuorum
+		return x.BaseDenom
 	}
-	return false
+	return ""
 }
 
-func (x *Params) GetBurnProposalDepositPrevote() bool {
+func (x *AcceptedDepositDenom) GetRatio() string {
 	if x != nil {
-		return x.BurnProposalDepositPrevote
+		return x.Ratio
 	}
-	return false
+	return ""
 }
 
-func (x *Params) GetBurnVoteVeto() bool {
+func (x *AcceptedDepositDenom) GetUseOracle() bool {
 	if x != nil {
-		return x.BurnVoteVeto
+		return x.UseOracle
 	}
 	return false
 }
@@ -7732,7 +17183,7 @@ var file_cosmos_gov_v1_gov_proto_rawDesc = []byte{
 	0x6f, 0x74, 0x65, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x06, 0x6f, 0x70, 0x74, 0x69, 0x6f,
 	0x6e, 0x12, 0x26, 0x0a, 0x06, 0x77, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28,
 	0x09, 0x42, 0x0e, 0xd2, 0xb4, 0x2d, 0x0a, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x44, 0x65,
-	0x63, 0x52, 0x06, 0x77, 0x65, 0x69, 0x67, 0x68, 0x74, 0x22, 0xa0, 0x01, 0x0a, 0x07, 0x44, 0x65,
+	0x63, 0x52, 0x06, 0x77, 0x65, 0x69, 0x67, 0x68, 0x74, 0x22, 0xea, 0x01, 0x0a, 0x07, 0x44, 0x65,
 	0x70, 0x6f, 0x73, 0x69, 0x74, 0x12, 0x1f, 0x0a, 0x0b, 0x70, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x61,
 	0x6c, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0a, 0x70, 0x72, 0x6f, 0x70,
 	0x6f, 0x73, 0x61, 0x6c, 0x49, 0x64, 0x12, 0x36, 0x0a, 0x09, 0x64, 0x65, 0x70, 0x6f, 0x73, 0x69,
@@ -7742,202 +17193,448 @@ var file_cosmos_gov_v1_gov_proto_rawDesc = []byte{
 	0x0a, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x19,
 	0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x76, 0x31, 0x62,
 	0x65, 0x74, 0x61, 0x31, 0x2e, 0x43, 0x6f, 0x69, 0x6e, 0x42, 0x09, 0xc8, 0xde, 0x1f, 0x00, 0xa8,
-	0xe7, 0xb0, 0x2a, 0x01, 0x52, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x22, 0xdf, 0x05, 0x0a,
-	0x08, 0x50, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x61, 0x6c, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x02, 0x69, 0x64, 0x12, 0x30, 0x0a, 0x08, 0x6d, 0x65, 0x73,
-	0x73, 0x61, 0x67, 0x65, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x67, 0x6f,
-	0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x41, 0x6e,
-	0x79, 0x52, 0x08, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x12, 0x35, 0x0a, 0x06, 0x73,
-	0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1d, 0x2e, 0x63, 0x6f,
-	0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x67, 0x6f, 0x76, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x72, 0x6f, 0x70,
-	0x6f, 0x73, 0x61, 0x6c, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74,
-	0x75, 0x73, 0x12, 0x48, 0x0a, 0x12, 0x66, 0x69, 0x6e, 0x61, 0x6c, 0x5f, 0x74, 0x61, 0x6c, 0x6c,
-	0x79, 0x5f, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a,
-	0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x67, 0x6f, 0x76, 0x2e, 0x76, 0x31, 0x2e, 0x54,
-	0x61, 0x6c, 0x6c, 0x79, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x52, 0x10, 0x66, 0x69, 0x6e, 0x61,
-	0x6c, 0x54, 0x61, 0x6c, 0x6c, 0x79, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x12, 0x41, 0x0a, 0x0b,
-	0x73, 0x75, 0x62, 0x6d, 0x69, 0x74, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28,
-	0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
-	0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x42, 0x04, 0x90,
-	0xdf, 0x1f, 0x01, 0x52, 0x0a, 0x73, 0x75, 0x62, 0x6d, 0x69, 0x74, 0x54, 0x69, 0x6d, 0x65, 0x12,
-	0x4a, 0x0a, 0x10, 0x64, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x5f, 0x65, 0x6e, 0x64, 0x5f, 0x74,
-	0x69, 0x6d, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67,
-	0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65,
-	0x73, 0x74, 0x61, 0x6d, 0x70, 0x42, 0x04, 0x90, 0xdf, 0x1f, 0x01, 0x52, 0x0e, 0x64, 0x65, 0x70,
-	0x6f, 0x73, 0x69, 0x74, 0x45, 0x6e, 0x64, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x49, 0x0a, 0x0d, 0x74,
-	0x6f, 0x74, 0x61, 0x6c, 0x5f, 0x64, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x18, 0x07, 0x20, 0x03,
-	0x28, 0x0b, 0x32, 0x19, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x62, 0x61, 0x73, 0x65,
-	0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x43, 0x6f, 0x69, 0x6e, 0x42, 0x09, 0xc8,
-	0xde, 0x1f, 0x00, 0xa8, 0xe7, 0xb0, 0x2a, 0x01, 0x52, 0x0c, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x44,
-	0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x12, 0x4c, 0x0a, 0x11, 0x76, 0x6f, 0x74, 0x69, 0x6e, 0x67,
-	0x5f, 0x73, 0x74, 0x61, 0x72, 0x74, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x08, 0x20, 0x01, 0x28,
-	0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
-	0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x42, 0x04, 0x90,
-	0xdf, 0x1f, 0x01, 0x52, 0x0f, 0x76, 0x6f, 0x74, 0x69, 0x6e, 0x67, 0x53, 0x74, 0x61, 0x72, 0x74,
-	0x54, 0x69, 0x6d, 0x65, 0x12, 0x48, 0x0a, 0x0f, 0x76, 0x6f, 0x74, 0x69, 0x6e, 0x67, 0x5f, 0x65,
-	0x6e, 0x64, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e,
-	0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e,
-	0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x42, 0x04, 0x90, 0xdf, 0x1f, 0x01, 0x52,
-	0x0d, 0x76, 0x6f, 0x74, 0x69, 0x6e, 0x67, 0x45, 0x6e, 0x64, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x1a,
-	0x0a, 0x08, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x08, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x69,
-	0x74, 0x6c, 0x65, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x74, 0x69, 0x74, 0x6c, 0x65,
-	0x12, 0x18, 0x0a, 0x07, 0x73, 0x75, 0x6d, 0x6d, 0x61, 0x72, 0x79, 0x18, 0x0c, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x07, 0x73, 0x75, 0x6d, 0x6d, 0x61, 0x72, 0x79, 0x12, 0x34, 0x0a, 0x08, 0x70, 0x72,
-	0x6f, 0x70, 0x6f, 0x73, 0x65, 0x72, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x09, 0x42, 0x18, 0xd2, 0xb4,
-	0x2d, 0x14, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73,
-	0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x52, 0x08, 0x70, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x65, 0x72,
-	0x12, 0x1c, 0x0a, 0x09, 0x65, 0x78, 0x70, 0x65, 0x64, 0x69, 0x74, 0x65, 0x64, 0x18, 0x0e, 0x20,
-	0x01, 0x28, 0x08, 0x52, 0x09, 0x65, 0x78, 0x70, 0x65, 0x64, 0x69, 0x74, 0x65, 0x64, 0x22, 0xd7,
-	0x01, 0x0a, 0x0b, 0x54, 0x61, 0x6c, 0x6c, 0x79, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x12, 0x2b,
-	0x0a, 0x09, 0x79, 0x65, 0x73, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x09, 0x42, 0x0e, 0xd2, 0xb4, 0x2d, 0x0a, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x49, 0x6e,
-	0x74, 0x52, 0x08, 0x79, 0x65, 0x73, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x33, 0x0a, 0x0d, 0x61,
-	0x62, 0x73, 0x74, 0x61, 0x69, 0x6e, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01,
-	0x28, 0x09, 0x42, 0x0e, 0xd2, 0xb4, 0x2d, 0x0a, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x49,
-	0x6e, 0x74, 0x52, 0x0c, 0x61, 0x62, 0x73, 0x74, 0x61, 0x69, 0x6e, 0x43, 0x6f, 0x75, 0x6e, 0x74,
-	0x12, 0x29, 0x0a, 0x08, 0x6e, 0x6f, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x01,
-	0x28, 0x09, 0x42, 0x0e, 0xd2, 0xb4, 0x2d, 0x0a, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x49,
-	0x6e, 0x74, 0x52, 0x07, 0x6e, 0x6f, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x3b, 0x0a, 0x12, 0x6e,
-	0x6f, 0x5f, 0x77, 0x69, 0x74, 0x68, 0x5f, 0x76, 0x65, 0x74, 0x6f, 0x5f, 0x63, 0x6f, 0x75, 0x6e,
-	0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x42, 0x0e, 0xd2, 0xb4, 0x2d, 0x0a, 0x63, 0x6f, 0x73,
-	0x6d, 0x6f, 0x73, 0x2e, 0x49, 0x6e, 0x74, 0x52, 0x0f, 0x6e, 0x6f, 0x57, 0x69, 0x74, 0x68, 0x56,
-	0x65, 0x74, 0x6f, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x22, 0xb6, 0x01, 0x0a, 0x04, 0x56, 0x6f, 0x74,
-	0x65, 0x12, 0x1f, 0x0a, 0x0b, 0x70, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x61, 0x6c, 0x5f, 0x69, 0x64,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0a, 0x70, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x61, 0x6c,
-	0x49, 0x64, 0x12, 0x2e, 0x0a, 0x05, 0x76, 0x6f, 0x74, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28,
-	0x09, 0x42, 0x18, 0xd2, 0xb4, 0x2d, 0x14, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x41, 0x64,
-	0x64, 0x72, 0x65, 0x73, 0x73, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x52, 0x05, 0x76, 0x6f, 0x74,
-	0x65, 0x72, 0x12, 0x3b, 0x0a, 0x07, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x04, 0x20,
-	0x03, 0x28, 0x0b, 0x32, 0x21, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x67, 0x6f, 0x76,
-	0x2e, 0x76, 0x31, 0x2e, 0x57, 0x65, 0x69, 0x67, 0x68, 0x74, 0x65, 0x64, 0x56, 0x6f, 0x74, 0x65,
-	0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x07, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12,
-	0x1a, 0x0a, 0x08, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x18, 0x05, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x08, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x4a, 0x04, 0x08, 0x03, 0x10,
-	0x04, 0x22, 0xdd, 0x01, 0x0a, 0x0d, 0x44, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x50, 0x61, 0x72,
-	0x61, 0x6d, 0x73, 0x12, 0x59, 0x0a, 0x0b, 0x6d, 0x69, 0x6e, 0x5f, 0x64, 0x65, 0x70, 0x6f, 0x73,
-	0x69, 0x74, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f,
-	0x73, 0x2e, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x43,
-	0x6f, 0x69, 0x6e, 0x42, 0x1d, 0xc8, 0xde, 0x1f, 0x00, 0xea, 0xde, 0x1f, 0x15, 0x6d, 0x69, 0x6e,
-	0x5f, 0x64, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x2c, 0x6f, 0x6d, 0x69, 0x74, 0x65, 0x6d, 0x70,
-	0x74, 0x79, 0x52, 0x0a, 0x6d, 0x69, 0x6e, 0x44, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x12, 0x6d,
-	0x0a, 0x12, 0x6d, 0x61, 0x78, 0x5f, 0x64, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x5f, 0x70, 0x65,
-	0x72, 0x69, 0x6f, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x67, 0x6f, 0x6f,
-	0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x44, 0x75, 0x72,
-	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x42, 0x24, 0xea, 0xde, 0x1f, 0x1c, 0x6d, 0x61, 0x78, 0x5f, 0x64,
-	0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x5f, 0x70, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x2c, 0x6f, 0x6d,
-	0x69, 0x74, 0x65, 0x6d, 0x70, 0x74, 0x79, 0x98, 0xdf, 0x1f, 0x01, 0x52, 0x10, 0x6d, 0x61, 0x78,
-	0x44, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x50, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x3a, 0x02, 0x18,
-	0x01, 0x22, 0x58, 0x0a, 0x0c, 0x56, 0x6f, 0x74, 0x69, 0x6e, 0x67, 0x50, 0x61, 0x72, 0x61, 0x6d,
-	0x73, 0x12, 0x44, 0x0a, 0x0d, 0x76, 0x6f, 0x74, 0x69, 0x6e, 0x67, 0x5f, 0x70, 0x65, 0x72, 0x69,
-	0x6f, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c,
-	0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x44, 0x75, 0x72, 0x61, 0x74,
-	0x69, 0x6f, 0x6e, 0x42, 0x04, 0x98, 0xdf, 0x1f, 0x01, 0x52, 0x0c, 0x76, 0x6f, 0x74, 0x69, 0x6e,
-	0x67, 0x50, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x3a, 0x02, 0x18, 0x01, 0x22, 0x9e, 0x01, 0x0a, 0x0b,
-	0x54, 0x61, 0x6c, 0x6c, 0x79, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x12, 0x26, 0x0a, 0x06, 0x71,
-	0x75, 0x6f, 0x72, 0x75, 0x6d, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x0e, 0xd2, 0xb4, 0x2d,
-	0x0a, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x44, 0x65, 0x63, 0x52, 0x06, 0x71, 0x75, 0x6f,
-	0x72, 0x75, 0x6d, 0x12, 0x2c, 0x0a, 0x09, 0x74, 0x68, 0x72, 0x65, 0x73, 0x68, 0x6f, 0x6c, 0x64,
-	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x0e, 0xd2, 0xb4, 0x2d, 0x0a, 0x63, 0x6f, 0x73, 0x6d,
-	0x6f, 0x73, 0x2e, 0x44, 0x65, 0x63, 0x52, 0x09, 0x74, 0x68, 0x72, 0x65, 0x73, 0x68, 0x6f, 0x6c,
-	0x64, 0x12, 0x35, 0x0a, 0x0e, 0x76, 0x65, 0x74, 0x6f, 0x5f, 0x74, 0x68, 0x72, 0x65, 0x73, 0x68,
-	0x6f, 0x6c, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x42, 0x0e, 0xd2, 0xb4, 0x2d, 0x0a, 0x63,
-	0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x44, 0x65, 0x63, 0x52, 0x0d, 0x76, 0x65, 0x74, 0x6f, 0x54,
-	0x68, 0x72, 0x65, 0x73, 0x68, 0x6f, 0x6c, 0x64, 0x3a, 0x02, 0x18, 0x01, 0x22, 0xd3, 0x07, 0x0a,
-	0x06, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x12, 0x45, 0x0a, 0x0b, 0x6d, 0x69, 0x6e, 0x5f, 0x64,
-	0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x63,
+	0xe7, 0xb0, 0x2a, 0x01, 0x52, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x1a, 0x0a, 0x08,
+	0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08,
+	0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x12, 0x2c, 0x0a, 0x12, 0x6d, 0x65, 0x74, 0x61,
+	0x64, 0x61, 0x74, 0x61, 0x5f, 0x73, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x5f, 0x69, 0x64, 0x18, 0x05,
+	0x20, 0x01, 0x28, 0x04, 0x52, 0x10, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x53, 0x63,
+	0x68, 0x65, 0x6d, 0x61, 0x49, 0x64, 0x22, 0xe3, 0x0a, 0x0a, 0x08, 0x50, 0x72, 0x6f, 0x70, 0x6f,
+	0x73, 0x61, 0x6c, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52,
+	0x02, 0x69, 0x64, 0x12, 0x30, 0x0a, 0x08, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x18,
+	0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x41, 0x6e, 0x79, 0x52, 0x08, 0x6d, 0x65, 0x73,
+	0x73, 0x61, 0x67, 0x65, 0x73, 0x12, 0x35, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1d, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x67,
+	0x6f, 0x76, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x61, 0x6c, 0x53, 0x74,
+	0x61, 0x74, 0x75, 0x73, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x48, 0x0a, 0x12,
+	0x66, 0x69, 0x6e, 0x61, 0x6c, 0x5f, 0x74, 0x61, 0x6c, 0x6c, 0x79, 0x5f, 0x72, 0x65, 0x73, 0x75,
+	0x6c, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f,
+	0x73, 0x2e, 0x67, 0x6f, 0x76, 0x2e, 0x76, 0x31, 0x2e, 0x54, 0x61, 0x6c, 0x6c, 0x79, 0x52, 0x65,
+	0x73, 0x75, 0x6c, 0x74, 0x52, 0x10, 0x66, 0x69, 0x6e, 0x61, 0x6c, 0x54, 0x61, 0x6c, 0x6c, 0x79,
+	0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x12, 0x41, 0x0a, 0x0b, 0x73, 0x75, 0x62, 0x6d, 0x69, 0x74,
+	0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f,
+	0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69,
+	0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x42, 0x04, 0x90, 0xdf, 0x1f, 0x01, 0x52, 0x0a, 0x73,
+	0x75, 0x62, 0x6d, 0x69, 0x74, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x4a, 0x0a, 0x10, 0x64, 0x65, 0x70,
+	0x6f, 0x73, 0x69, 0x74, 0x5f, 0x65, 0x6e, 0x64, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x06, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x42,
+	0x04, 0x90, 0xdf, 0x1f, 0x01, 0x52, 0x0e, 0x64, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x45, 0x6e,
+	0x64, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x49, 0x0a, 0x0d, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x5f, 0x64,
+	0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x18, 0x07, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x63,
 	0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74,
 	0x61, 0x31, 0x2e, 0x43, 0x6f, 0x69, 0x6e, 0x42, 0x09, 0xc8, 0xde, 0x1f, 0x00, 0xa8, 0xe7, 0xb0,
-	0x2a, 0x01, 0x52, 0x0a, 0x6d, 0x69, 0x6e, 0x44, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x12, 0x4d,
-	0x0a, 0x12, 0x6d, 0x61, 0x78, 0x5f, 0x64, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x5f, 0x70, 0x65,
-	0x72, 0x69, 0x6f, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x67, 0x6f, 0x6f,
-	0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x44, 0x75, 0x72,
-	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x42, 0x04, 0x98, 0xdf, 0x1f, 0x01, 0x52, 0x10, 0x6d, 0x61, 0x78,
-	0x44, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x50, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x12, 0x44, 0x0a,
-	0x0d, 0x76, 0x6f, 0x74, 0x69, 0x6e, 0x67, 0x5f, 0x70, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x18, 0x03,
-	0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72,
-	0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x42,
-	0x04, 0x98, 0xdf, 0x1f, 0x01, 0x52, 0x0c, 0x76, 0x6f, 0x74, 0x69, 0x6e, 0x67, 0x50, 0x65, 0x72,
-	0x69, 0x6f, 0x64, 0x12, 0x26, 0x0a, 0x06, 0x71, 0x75, 0x6f, 0x72, 0x75, 0x6d, 0x18, 0x04, 0x20,
-	0x01, 0x28, 0x09, 0x42, 0x0e, 0xd2, 0xb4, 0x2d, 0x0a, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e,
-	0x44, 0x65, 0x63, 0x52, 0x06, 0x71, 0x75, 0x6f, 0x72, 0x75, 0x6d, 0x12, 0x2c, 0x0a, 0x09, 0x74,
-	0x68, 0x72, 0x65, 0x73, 0x68, 0x6f, 0x6c, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x42, 0x0e,
-	0xd2, 0xb4, 0x2d, 0x0a, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x44, 0x65, 0x63, 0x52, 0x09,
-	0x74, 0x68, 0x72, 0x65, 0x73, 0x68, 0x6f, 0x6c, 0x64, 0x12, 0x35, 0x0a, 0x0e, 0x76, 0x65, 0x74,
-	0x6f, 0x5f, 0x74, 0x68, 0x72, 0x65, 0x73, 0x68, 0x6f, 0x6c, 0x64, 0x18, 0x06, 0x20, 0x01, 0x28,
-	0x09, 0x42, 0x0e, 0xd2, 0xb4, 0x2d, 0x0a, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x44, 0x65,
-	0x63, 0x52, 0x0d, 0x76, 0x65, 0x74, 0x6f, 0x54, 0x68, 0x72, 0x65, 0x73, 0x68, 0x6f, 0x6c, 0x64,
-	0x12, 0x49, 0x0a, 0x19, 0x6d, 0x69, 0x6e, 0x5f, 0x69, 0x6e, 0x69, 0x74, 0x69, 0x61, 0x6c, 0x5f,
-	0x64, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x5f, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x18, 0x07, 0x20,
-	0x01, 0x28, 0x09, 0x42, 0x0e, 0xd2, 0xb4, 0x2d, 0x0a, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e,
-	0x44, 0x65, 0x63, 0x52, 0x16, 0x6d, 0x69, 0x6e, 0x49, 0x6e, 0x69, 0x74, 0x69, 0x61, 0x6c, 0x44,
-	0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x52, 0x61, 0x74, 0x69, 0x6f, 0x12, 0x42, 0x0a, 0x15, 0x70,
-	0x72, 0x6f, 0x70, 0x6f, 0x73, 0x61, 0x6c, 0x5f, 0x63, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x5f, 0x72,
-	0x61, 0x74, 0x69, 0x6f, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x42, 0x0e, 0xd2, 0xb4, 0x2d, 0x0a,
-	0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x44, 0x65, 0x63, 0x52, 0x13, 0x70, 0x72, 0x6f, 0x70,
-	0x6f, 0x73, 0x61, 0x6c, 0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x52, 0x61, 0x74, 0x69, 0x6f, 0x12,
-	0x4a, 0x0a, 0x14, 0x70, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x61, 0x6c, 0x5f, 0x63, 0x61, 0x6e, 0x63,
-	0x65, 0x6c, 0x5f, 0x64, 0x65, 0x73, 0x74, 0x18, 0x09, 0x20, 0x01, 0x28, 0x09, 0x42, 0x18, 0xd2,
+	0x2a, 0x01, 0x52, 0x0c, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x44, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74,
+	0x12, 0x4c, 0x0a, 0x11, 0x76, 0x6f, 0x74, 0x69, 0x6e, 0x67, 0x5f, 0x73, 0x74, 0x61, 0x72, 0x74,
+	0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f,
+	0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69,
+	0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x42, 0x04, 0x90, 0xdf, 0x1f, 0x01, 0x52, 0x0f, 0x76,
+	0x6f, 0x74, 0x69, 0x6e, 0x67, 0x53, 0x74, 0x61, 0x72, 0x74, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x48,
+	0x0a, 0x0f, 0x76, 0x6f, 0x74, 0x69, 0x6e, 0x67, 0x5f, 0x65, 0x6e, 0x64, 0x5f, 0x74, 0x69, 0x6d,
+	0x65, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74,
+	0x61, 0x6d, 0x70, 0x42, 0x04, 0x90, 0xdf, 0x1f, 0x01, 0x52, 0x0d, 0x76, 0x6f, 0x74, 0x69, 0x6e,
+	0x67, 0x45, 0x6e, 0x64, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x6d, 0x65, 0x74, 0x61,
+	0x64, 0x61, 0x74, 0x61, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x6d, 0x65, 0x74, 0x61,
+	0x64, 0x61, 0x74, 0x61, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x18, 0x0b, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x05, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x75,
+	0x6d, 0x6d, 0x61, 0x72, 0x79, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x73, 0x75, 0x6d,
+	0x6d, 0x61, 0x72, 0x79, 0x12, 0x34, 0x0a, 0x08, 0x70, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x65, 0x72,
+	0x18, 0x0d, 0x20, 0x01, 0x28, 0x09, 0x42, 0x18, 0xd2, 0xb4, 0x2d, 0x14, 0x63, 0x6f, 0x73, 0x6d,
+	0x6f, 0x73, 0x2e, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67,
+	0x52, 0x08, 0x70, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x65, 0x72, 0x12, 0x1c, 0x0a, 0x09, 0x65, 0x78,
+	0x70, 0x65, 0x64, 0x69, 0x74, 0x65, 0x64, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x08, 0x52, 0x09, 0x65,
+	0x78, 0x70, 0x65, 0x64, 0x69, 0x74, 0x65, 0x64, 0x12, 0x5e, 0x0a, 0x16, 0x70, 0x61, 0x72, 0x61,
+	0x6d, 0x73, 0x5f, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x5f, 0x66, 0x61, 0x69, 0x6c, 0x75, 0x72,
+	0x65, 0x73, 0x18, 0x0f, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x22, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f,
+	0x73, 0x2e, 0x67, 0x6f, 0x76, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x55,
+	0x70, 0x64, 0x61, 0x74, 0x65, 0x46, 0x61, 0x69, 0x6c, 0x75, 0x72, 0x65, 0x42, 0x04, 0xc8, 0xde,
+	0x1f, 0x00, 0x52, 0x14, 0x70, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65,
+	0x46, 0x61, 0x69, 0x6c, 0x75, 0x72, 0x65, 0x73, 0x12, 0x36, 0x0a, 0x17, 0x64, 0x65, 0x70, 0x6f,
+	0x73, 0x69, 0x74, 0x5f, 0x70, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x5f, 0x65, 0x78, 0x74, 0x65, 0x6e,
+	0x64, 0x65, 0x64, 0x18, 0x10, 0x20, 0x01, 0x28, 0x08, 0x52, 0x15, 0x64, 0x65, 0x70, 0x6f, 0x73,
+	0x69, 0x74, 0x50, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x45, 0x78, 0x74, 0x65, 0x6e, 0x64, 0x65, 0x64,
+	0x12, 0x49, 0x0a, 0x13, 0x65, 0x78, 0x65, 0x63, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x61, 0x75,
+	0x74, 0x68, 0x6f, 0x72, 0x69, 0x74, 0x79, 0x18, 0x11, 0x20, 0x01, 0x28, 0x09, 0x42, 0x18, 0xd2,
 	0xb4, 0x2d, 0x14, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73,
-	0x73, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x52, 0x12, 0x70, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x61,
-	0x6c, 0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x44, 0x65, 0x73, 0x74, 0x12, 0x57, 0x0a, 0x17, 0x65,
-	0x78, 0x70, 0x65, 0x64, 0x69, 0x74, 0x65, 0x64, 0x5f, 0x76, 0x6f, 0x74, 0x69, 0x6e, 0x67, 0x5f,
-	0x70, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x67,
-	0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x44,
-	0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x42, 0x04, 0x98, 0xdf, 0x1f, 0x01, 0x52, 0x15, 0x65,
-	0x78, 0x70, 0x65, 0x64, 0x69, 0x74, 0x65, 0x64, 0x56, 0x6f, 0x74, 0x69, 0x6e, 0x67, 0x50, 0x65,
-	0x72, 0x69, 0x6f, 0x64, 0x12, 0x3f, 0x0a, 0x13, 0x65, 0x78, 0x70, 0x65, 0x64, 0x69, 0x74, 0x65,
-	0x64, 0x5f, 0x74, 0x68, 0x72, 0x65, 0x73, 0x68, 0x6f, 0x6c, 0x64, 0x18, 0x0b, 0x20, 0x01, 0x28,
+	0x73, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x52, 0x12, 0x65, 0x78, 0x65, 0x63, 0x75, 0x74, 0x69,
+	0x6f, 0x6e, 0x41, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x69, 0x74, 0x79, 0x12, 0x50, 0x0a, 0x13, 0x64,
+	0x69, 0x73, 0x63, 0x75, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x5f, 0x65, 0x6e, 0x64, 0x5f, 0x74, 0x69,
+	0x6d, 0x65, 0x18, 0x12, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c,
+	0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73,
+	0x74, 0x61, 0x6d, 0x70, 0x42, 0x04, 0x90, 0xdf, 0x1f, 0x01, 0x52, 0x11, 0x64, 0x69, 0x73, 0x63,
+	0x75, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x45, 0x6e, 0x64, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x54, 0x0a,
+	0x11, 0x65, 0x78, 0x65, 0x63, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x66, 0x61, 0x69, 0x6c, 0x75,
+	0x72, 0x65, 0x18, 0x13, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x27, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f,
+	0x73, 0x2e, 0x67, 0x6f, 0x76, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x61,
+	0x6c, 0x45, 0x78, 0x65, 0x63, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x46, 0x61, 0x69, 0x6c, 0x75, 0x72,
+	0x65, 0x52, 0x10, 0x65, 0x78, 0x65, 0x63, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x46, 0x61, 0x69, 0x6c,
+	0x75, 0x72, 0x65, 0x12, 0x5a, 0x0a, 0x14, 0x69, 0x6e, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x5f, 0x66, 0x61, 0x69, 0x6c, 0x75, 0x72, 0x65, 0x18, 0x14, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x27, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x67, 0x6f, 0x76, 0x2e, 0x76,
+	0x31, 0x2e, 0x50, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x61, 0x6c, 0x45, 0x78, 0x65, 0x63, 0x75, 0x74,
+	0x69, 0x6f, 0x6e, 0x46, 0x61, 0x69, 0x6c, 0x75, 0x72, 0x65, 0x52, 0x13, 0x69, 0x6e, 0x76, 0x61,
+	0x6c, 0x69, 0x64, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x46, 0x61, 0x69, 0x6c, 0x75, 0x72, 0x65, 0x12,
+	0x23, 0x0a, 0x0d, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x5f, 0x72, 0x65, 0x76, 0x65, 0x61, 0x6c,
+	0x18, 0x15, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0c, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x52, 0x65,
+	0x76, 0x65, 0x61, 0x6c, 0x12, 0x48, 0x0a, 0x0f, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x5f, 0x65,
+	0x6e, 0x64, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x16, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e,
+	0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e,
+	0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x42, 0x04, 0x90, 0xdf, 0x1f, 0x01, 0x52,
+	0x0d, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x45, 0x6e, 0x64, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x2c,
+	0x0a, 0x12, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x5f, 0x73, 0x63, 0x68, 0x65, 0x6d,
+	0x61, 0x5f, 0x69, 0x64, 0x18, 0x17, 0x20, 0x01, 0x28, 0x04, 0x52, 0x10, 0x6d, 0x65, 0x74, 0x61,
+	0x64, 0x61, 0x74, 0x61, 0x53, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x49, 0x64, 0x22, 0x71, 0x0a, 0x18,
+	0x50, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x61, 0x6c, 0x45, 0x78, 0x65, 0x63, 0x75, 0x74, 0x69, 0x6f,
+	0x6e, 0x46, 0x61, 0x69, 0x6c, 0x75, 0x72, 0x65, 0x12, 0x1b, 0x0a, 0x09, 0x6d, 0x73, 0x67, 0x5f,
+	0x69, 0x6e, 0x64, 0x65, 0x78, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x08, 0x6d, 0x73, 0x67,
+	0x49, 0x6e, 0x64, 0x65, 0x78, 0x12, 0x20, 0x0a, 0x0c, 0x6d, 0x73, 0x67, 0x5f, 0x74, 0x79, 0x70,
+	0x65, 0x5f, 0x75, 0x72, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x6d, 0x73, 0x67,
+	0x54, 0x79, 0x70, 0x65, 0x55, 0x72, 0x6c, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f,
+	0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x22,
+	0xcd, 0x01, 0x0a, 0x18, 0x50, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x61, 0x6c, 0x45, 0x78, 0x65, 0x63,
+	0x75, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x63, 0x65, 0x69, 0x70, 0x74, 0x12, 0x1f, 0x0a, 0x0b,
+	0x70, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x61, 0x6c, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x04, 0x52, 0x0a, 0x70, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x61, 0x6c, 0x49, 0x64, 0x12, 0x16, 0x0a,
+	0x06, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x68,
+	0x65, 0x69, 0x67, 0x68, 0x74, 0x12, 0x3d, 0x0a, 0x04, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x42,
+	0x0d, 0xc8, 0xde, 0x1f, 0x00, 0x90, 0xdf, 0x1f, 0x01, 0xa8, 0xe7, 0xb0, 0x2a, 0x01, 0x52, 0x04,
+	0x74, 0x69, 0x6d, 0x65, 0x12, 0x39, 0x0a, 0x0d, 0x6d, 0x73, 0x67, 0x5f, 0x72, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x67, 0x6f,
+	0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x41, 0x6e,
+	0x79, 0x52, 0x0c, 0x6d, 0x73, 0x67, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x73, 0x22,
+	0x65, 0x0a, 0x13, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x46,
+	0x61, 0x69, 0x6c, 0x75, 0x72, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x05, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x12, 0x20, 0x0a, 0x0c,
+	0x6d, 0x73, 0x67, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x5f, 0x75, 0x72, 0x6c, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x0a, 0x6d, 0x73, 0x67, 0x54, 0x79, 0x70, 0x65, 0x55, 0x72, 0x6c, 0x12, 0x16,
+	0x0a, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06,
+	0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x22, 0xd7, 0x01, 0x0a, 0x0b, 0x54, 0x61, 0x6c, 0x6c, 0x79,
+	0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x12, 0x2b, 0x0a, 0x09, 0x79, 0x65, 0x73, 0x5f, 0x63, 0x6f,
+	0x75, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x0e, 0xd2, 0xb4, 0x2d, 0x0a, 0x63,
+	0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x49, 0x6e, 0x74, 0x52, 0x08, 0x79, 0x65, 0x73, 0x43, 0x6f,
+	0x75, 0x6e, 0x74, 0x12, 0x33, 0x0a, 0x0d, 0x61, 0x62, 0x73, 0x74, 0x61, 0x69, 0x6e, 0x5f, 0x63,
+	0x6f, 0x75, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x0e, 0xd2, 0xb4, 0x2d, 0x0a,
+	0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x49, 0x6e, 0x74, 0x52, 0x0c, 0x61, 0x62, 0x73, 0x74,
+	0x61, 0x69, 0x6e, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x29, 0x0a, 0x08, 0x6e, 0x6f, 0x5f, 0x63,
+	0x6f, 0x75, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x42, 0x0e, 0xd2, 0xb4, 0x2d, 0x0a,
+	0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x49, 0x6e, 0x74, 0x52, 0x07, 0x6e, 0x6f, 0x43, 0x6f,
+	0x75, 0x6e, 0x74, 0x12, 0x3b, 0x0a, 0x12, 0x6e, 0x6f, 0x5f, 0x77, 0x69, 0x74, 0x68, 0x5f, 0x76,
+	0x65, 0x74, 0x6f, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x42,
+	0x0e, 0xd2, 0xb4, 0x2d, 0x0a, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x49, 0x6e, 0x74, 0x52,
+	0x0f, 0x6e, 0x6f, 0x57, 0x69, 0x74, 0x68, 0x56, 0x65, 0x74, 0x6f, 0x43, 0x6f, 0x75, 0x6e, 0x74,
+	0x22, 0xe4, 0x01, 0x0a, 0x04, 0x56, 0x6f, 0x74, 0x65, 0x12, 0x1f, 0x0a, 0x0b, 0x70, 0x72, 0x6f,
+	0x70, 0x6f, 0x73, 0x61, 0x6c, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0a,
+	0x70, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x61, 0x6c, 0x49, 0x64, 0x12, 0x2e, 0x0a, 0x05, 0x76, 0x6f,
+	0x74, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x18, 0xd2, 0xb4, 0x2d, 0x14, 0x63,
+	0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x53, 0x74, 0x72,
+	0x69, 0x6e, 0x67, 0x52, 0x05, 0x76, 0x6f, 0x74, 0x65, 0x72, 0x12, 0x3b, 0x0a, 0x07, 0x6f, 0x70,
+	0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x21, 0x2e, 0x63, 0x6f,
+	0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x67, 0x6f, 0x76, 0x2e, 0x76, 0x31, 0x2e, 0x57, 0x65, 0x69, 0x67,
+	0x68, 0x74, 0x65, 0x64, 0x56, 0x6f, 0x74, 0x65, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x07,
+	0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x1a, 0x0a, 0x08, 0x6d, 0x65, 0x74, 0x61, 0x64,
+	0x61, 0x74, 0x61, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x6d, 0x65, 0x74, 0x61, 0x64,
+	0x61, 0x74, 0x61, 0x12, 0x2c, 0x0a, 0x12, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x5f,
+	0x73, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x5f, 0x69, 0x64, 0x18, 0x06, 0x20, 0x01, 0x28, 0x04, 0x52,
+	0x10, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x53, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x49,
+	0x64, 0x4a, 0x04, 0x08, 0x03, 0x10, 0x04, 0x22, 0x81, 0x01, 0x0a, 0x0e, 0x56, 0x6f, 0x74, 0x65,
+	0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x1f, 0x0a, 0x0b, 0x70, 0x72,
+	0x6f, 0x70, 0x6f, 0x73, 0x61, 0x6c, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52,
+	0x0a, 0x70, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x61, 0x6c, 0x49, 0x64, 0x12, 0x2e, 0x0a, 0x05, 0x76,
+	0x6f, 0x74, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x18, 0xd2, 0xb4, 0x2d, 0x14,
+	0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x53, 0x74,
+	0x72, 0x69, 0x6e, 0x67, 0x52, 0x05, 0x76, 0x6f, 0x74, 0x65, 0x72, 0x12, 0x1e, 0x0a, 0x0a, 0x63,
+	0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x6d, 0x65, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0c, 0x52,
+	0x0a, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x6d, 0x65, 0x6e, 0x74, 0x22, 0xed, 0x02, 0x0a, 0x16,
+	0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x56, 0x6f, 0x74, 0x65, 0x42, 0x72, 0x65,
+	0x61, 0x6b, 0x64, 0x6f, 0x77, 0x6e, 0x12, 0x1f, 0x0a, 0x0b, 0x70, 0x72, 0x6f, 0x70, 0x6f, 0x73,
+	0x61, 0x6c, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0a, 0x70, 0x72, 0x6f,
+	0x70, 0x6f, 0x73, 0x61, 0x6c, 0x49, 0x64, 0x12, 0x4e, 0x0a, 0x11, 0x76, 0x61, 0x6c, 0x69, 0x64,
+	0x61, 0x74, 0x6f, 0x72, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x42, 0x21, 0xd2, 0xb4, 0x2d, 0x1d, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x56,
+	0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x53,
+	0x74, 0x72, 0x69, 0x6e, 0x67, 0x52, 0x10, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72,
+	0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x3b, 0x0a, 0x07, 0x6f, 0x70, 0x74, 0x69, 0x6f,
+	0x6e, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x21, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f,
+	0x73, 0x2e, 0x67, 0x6f, 0x76, 0x2e, 0x76, 0x31, 0x2e, 0x57, 0x65, 0x69, 0x67, 0x68, 0x74, 0x65,
+	0x64, 0x56, 0x6f, 0x74, 0x65, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x07, 0x6f, 0x70, 0x74,
+	0x69, 0x6f, 0x6e, 0x73, 0x12, 0x37, 0x0a, 0x0f, 0x69, 0x6e, 0x68, 0x65, 0x72, 0x69, 0x74, 0x65,
+	0x64, 0x5f, 0x70, 0x6f, 0x77, 0x65, 0x72, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x42, 0x0e, 0xd2,
+	0xb4, 0x2d, 0x0a, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x49, 0x6e, 0x74, 0x52, 0x0e, 0x69,
+	0x6e, 0x68, 0x65, 0x72, 0x69, 0x74, 0x65, 0x64, 0x50, 0x6f, 0x77, 0x65, 0x72, 0x12, 0x39, 0x0a,
+	0x10, 0x6f, 0x76, 0x65, 0x72, 0x72, 0x69, 0x64, 0x64, 0x65, 0x6e, 0x5f, 0x70, 0x6f, 0x77, 0x65,
+	0x72, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x42, 0x0e, 0xd2, 0xb4, 0x2d, 0x0a, 0x63, 0x6f, 0x73,
+	0x6d, 0x6f, 0x73, 0x2e, 0x49, 0x6e, 0x74, 0x52, 0x0f, 0x6f, 0x76, 0x65, 0x72, 0x72, 0x69, 0x64,
+	0x64, 0x65, 0x6e, 0x50, 0x6f, 0x77, 0x65, 0x72, 0x12, 0x31, 0x0a, 0x14, 0x70, 0x61, 0x72, 0x74,
+	0x69, 0x63, 0x69, 0x70, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x65, 0x78, 0x65, 0x6d, 0x70, 0x74,
+	0x18, 0x06, 0x20, 0x01, 0x28, 0x08, 0x52, 0x13, 0x70, 0x61, 0x72, 0x74, 0x69, 0x63, 0x69, 0x70,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x45, 0x78, 0x65, 0x6d, 0x70, 0x74, 0x22, 0xda, 0x01, 0x0a, 0x14,
+	0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x56, 0x6f, 0x74, 0x65, 0x64, 0x53, 0x68,
+	0x61, 0x72, 0x65, 0x73, 0x12, 0x20, 0x0a, 0x03, 0x79, 0x65, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28,
 	0x09, 0x42, 0x0e, 0xd2, 0xb4, 0x2d, 0x0a, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x44, 0x65,
-	0x63, 0x52, 0x12, 0x65, 0x78, 0x70, 0x65, 0x64, 0x69, 0x74, 0x65, 0x64, 0x54, 0x68, 0x72, 0x65,
-	0x73, 0x68, 0x6f, 0x6c, 0x64, 0x12, 0x58, 0x0a, 0x15, 0x65, 0x78, 0x70, 0x65, 0x64, 0x69, 0x74,
-	0x65, 0x64, 0x5f, 0x6d, 0x69, 0x6e, 0x5f, 0x64, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x18, 0x0c,
+	0x63, 0x52, 0x03, 0x79, 0x65, 0x73, 0x12, 0x28, 0x0a, 0x07, 0x61, 0x62, 0x73, 0x74, 0x61, 0x69,
+	0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x0e, 0xd2, 0xb4, 0x2d, 0x0a, 0x63, 0x6f, 0x73,
+	0x6d, 0x6f, 0x73, 0x2e, 0x44, 0x65, 0x63, 0x52, 0x07, 0x61, 0x62, 0x73, 0x74, 0x61, 0x69, 0x6e,
+	0x12, 0x1e, 0x0a, 0x02, 0x6e, 0x6f, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x42, 0x0e, 0xd2, 0xb4,
+	0x2d, 0x0a, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x44, 0x65, 0x63, 0x52, 0x02, 0x6e, 0x6f,
+	0x12, 0x30, 0x0a, 0x0c, 0x6e, 0x6f, 0x5f, 0x77, 0x69, 0x74, 0x68, 0x5f, 0x76, 0x65, 0x74, 0x6f,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x42, 0x0e, 0xd2, 0xb4, 0x2d, 0x0a, 0x63, 0x6f, 0x73, 0x6d,
+	0x6f, 0x73, 0x2e, 0x44, 0x65, 0x63, 0x52, 0x0a, 0x6e, 0x6f, 0x57, 0x69, 0x74, 0x68, 0x56, 0x65,
+	0x74, 0x6f, 0x12, 0x24, 0x0a, 0x05, 0x76, 0x6f, 0x74, 0x65, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28,
+	0x09, 0x42, 0x0e, 0xd2, 0xb4, 0x2d, 0x0a, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x44, 0x65,
+	0x63, 0x52, 0x05, 0x76, 0x6f, 0x74, 0x65, 0x64, 0x22, 0xb6, 0x01, 0x0a, 0x15, 0x43, 0x6f, 0x6e,
+	0x73, 0x74, 0x69, 0x74, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x41, 0x6d, 0x65, 0x6e, 0x64, 0x6d, 0x65,
+	0x6e, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x02,
+	0x69, 0x64, 0x12, 0x1f, 0x0a, 0x0b, 0x70, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x61, 0x6c, 0x5f, 0x69,
+	0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0a, 0x70, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x61,
+	0x6c, 0x49, 0x64, 0x12, 0x22, 0x0a, 0x0c, 0x63, 0x6f, 0x6e, 0x73, 0x74, 0x69, 0x74, 0x75, 0x74,
+	0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x63, 0x6f, 0x6e, 0x73, 0x74,
+	0x69, 0x74, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x48, 0x0a, 0x0a, 0x61, 0x6d, 0x65, 0x6e, 0x64,
+	0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f,
+	0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69,
+	0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x42, 0x0d, 0xc8, 0xde, 0x1f, 0x00, 0x90, 0xdf, 0x1f,
+	0x01, 0xa8, 0xe7, 0xb0, 0x2a, 0x01, 0x52, 0x09, 0x61, 0x6d, 0x65, 0x6e, 0x64, 0x65, 0x64, 0x41,
+	0x74, 0x22, 0x5a, 0x0a, 0x0e, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x53, 0x63, 0x68,
+	0x65, 0x6d, 0x61, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52,
+	0x02, 0x69, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x10, 0x0a, 0x03, 0x75, 0x72, 0x69, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x75, 0x72, 0x69, 0x12, 0x12, 0x0a, 0x04, 0x68, 0x61, 0x73,
+	0x68, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x68, 0x61, 0x73, 0x68, 0x22, 0xdd, 0x01,
+	0x0a, 0x0d, 0x44, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x12,
+	0x59, 0x0a, 0x0b, 0x6d, 0x69, 0x6e, 0x5f, 0x64, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x18, 0x01,
 	0x20, 0x03, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x62, 0x61,
 	0x73, 0x65, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x43, 0x6f, 0x69, 0x6e, 0x42,
-	0x09, 0xc8, 0xde, 0x1f, 0x00, 0xa8, 0xe7, 0xb0, 0x2a, 0x01, 0x52, 0x13, 0x65, 0x78, 0x70, 0x65,
-	0x64, 0x69, 0x74, 0x65, 0x64, 0x4d, 0x69, 0x6e, 0x44, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x12,
-	0x28, 0x0a, 0x10, 0x62, 0x75, 0x72, 0x6e, 0x5f, 0x76, 0x6f, 0x74, 0x65, 0x5f, 0x71, 0x75, 0x6f,
-	0x72, 0x75, 0x6d, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0e, 0x62, 0x75, 0x72, 0x6e, 0x56,
-	0x6f, 0x74, 0x65, 0x51, 0x75, 0x6f, 0x72, 0x75, 0x6d, 0x12, 0x41, 0x0a, 0x1d, 0x62, 0x75, 0x72,
-	0x6e, 0x5f, 0x70, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x61, 0x6c, 0x5f, 0x64, 0x65, 0x70, 0x6f, 0x73,
-	0x69, 0x74, 0x5f, 0x70, 0x72, 0x65, 0x76, 0x6f, 0x74, 0x65, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x08,
-	0x52, 0x1a, 0x62, 0x75, 0x72, 0x6e, 0x50, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x61, 0x6c, 0x44, 0x65,
-	0x70, 0x6f, 0x73, 0x69, 0x74, 0x50, 0x72, 0x65, 0x76, 0x6f, 0x74, 0x65, 0x12, 0x24, 0x0a, 0x0e,
-	0x62, 0x75, 0x72, 0x6e, 0x5f, 0x76, 0x6f, 0x74, 0x65, 0x5f, 0x76, 0x65, 0x74, 0x6f, 0x18, 0x0f,
-	0x20, 0x01, 0x28, 0x08, 0x52, 0x0c, 0x62, 0x75, 0x72, 0x6e, 0x56, 0x6f, 0x74, 0x65, 0x56, 0x65,
-	0x74, 0x6f, 0x2a, 0x89, 0x01, 0x0a, 0x0a, 0x56, 0x6f, 0x74, 0x65, 0x4f, 0x70, 0x74, 0x69, 0x6f,
-	0x6e, 0x12, 0x1b, 0x0a, 0x17, 0x56, 0x4f, 0x54, 0x45, 0x5f, 0x4f, 0x50, 0x54, 0x49, 0x4f, 0x4e,
-	0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x13,
-	0x0a, 0x0f, 0x56, 0x4f, 0x54, 0x45, 0x5f, 0x4f, 0x50, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x59, 0x45,
-	0x53, 0x10, 0x01, 0x12, 0x17, 0x0a, 0x13, 0x56, 0x4f, 0x54, 0x45, 0x5f, 0x4f, 0x50, 0x54, 0x49,
-	0x4f, 0x4e, 0x5f, 0x41, 0x42, 0x53, 0x54, 0x41, 0x49, 0x4e, 0x10, 0x02, 0x12, 0x12, 0x0a, 0x0e,
-	0x56, 0x4f, 0x54, 0x45, 0x5f, 0x4f, 0x50, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x4e, 0x4f, 0x10, 0x03,
-	0x12, 0x1c, 0x0a, 0x18, 0x56, 0x4f, 0x54, 0x45, 0x5f, 0x4f, 0x50, 0x54, 0x49, 0x4f, 0x4e, 0x5f,
-	0x4e, 0x4f, 0x5f, 0x57, 0x49, 0x54, 0x48, 0x5f, 0x56, 0x45, 0x54, 0x4f, 0x10, 0x04, 0x2a, 0xce,
-	0x01, 0x0a, 0x0e, 0x50, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x61, 0x6c, 0x53, 0x74, 0x61, 0x74, 0x75,
-	0x73, 0x12, 0x1f, 0x0a, 0x1b, 0x50, 0x52, 0x4f, 0x50, 0x4f, 0x53, 0x41, 0x4c, 0x5f, 0x53, 0x54,
-	0x41, 0x54, 0x55, 0x53, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44,
-	0x10, 0x00, 0x12, 0x22, 0x0a, 0x1e, 0x50, 0x52, 0x4f, 0x50, 0x4f, 0x53, 0x41, 0x4c, 0x5f, 0x53,
-	0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x44, 0x45, 0x50, 0x4f, 0x53, 0x49, 0x54, 0x5f, 0x50, 0x45,
-	0x52, 0x49, 0x4f, 0x44, 0x10, 0x01, 0x12, 0x21, 0x0a, 0x1d, 0x50, 0x52, 0x4f, 0x50, 0x4f, 0x53,
-	0x41, 0x4c, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x56, 0x4f, 0x54, 0x49, 0x4e, 0x47,
-	0x5f, 0x50, 0x45, 0x52, 0x49, 0x4f, 0x44, 0x10, 0x02, 0x12, 0x1a, 0x0a, 0x16, 0x50, 0x52, 0x4f,
-	0x50, 0x4f, 0x53, 0x41, 0x4c, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x50, 0x41, 0x53,
-	0x53, 0x45, 0x44, 0x10, 0x03, 0x12, 0x1c, 0x0a, 0x18, 0x50, 0x52, 0x4f, 0x50, 0x4f, 0x53, 0x41,
-	0x4c, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x52, 0x45, 0x4a, 0x45, 0x43, 0x54, 0x45,
-	0x44, 0x10, 0x04, 0x12, 0x1a, 0x0a, 0x16, 0x50, 0x52, 0x4f, 0x50, 0x4f, 0x53, 0x41, 0x4c, 0x5f,
-	0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x46, 0x41, 0x49, 0x4c, 0x45, 0x44, 0x10, 0x05, 0x42,
-	0x99, 0x01, 0x0a, 0x11, 0x63, 0x6f, 0x6d, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x67,
-	0x6f, 0x76, 0x2e, 0x76, 0x31, 0x42, 0x08, 0x47, 0x6f, 0x76, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50,
-	0x01, 0x5a, 0x24, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f, 0x2f,
-	0x61, 0x70, 0x69, 0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2f, 0x67, 0x6f, 0x76, 0x2f, 0x76,
-	0x31, 0x3b, 0x67, 0x6f, 0x76, 0x76, 0x31, 0xa2, 0x02, 0x03, 0x43, 0x47, 0x58, 0xaa, 0x02, 0x0d,
-	0x43, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x47, 0x6f, 0x76, 0x2e, 0x56, 0x31, 0xca, 0x02, 0x0d,
-	0x43, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x5c, 0x47, 0x6f, 0x76, 0x5c, 0x56, 0x31, 0xe2, 0x02, 0x19,
-	0x43, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x5c, 0x47, 0x6f, 0x76, 0x5c, 0x56, 0x31, 0x5c, 0x47, 0x50,
-	0x42, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0xea, 0x02, 0x0f, 0x43, 0x6f, 0x73, 0x6d,
-	0x6f, 0x73, 0x3a, 0x3a, 0x47, 0x6f, 0x76, 0x3a, 0x3a, 0x56, 0x31, 0x62, 0x06, 0x70, 0x72, 0x6f,
-	0x74, 0x6f, 0x33,
+	0x1d, 0xc8, 0xde, 0x1f, 0x00, 0xea, 0xde, 0x1f, 0x15, 0x6d, 0x69, 0x6e, 0x5f, 0x64, 0x65, 0x70,
+	0x6f, 0x73, 0x69, 0x74, 0x2c, 0x6f, 0x6d, 0x69, 0x74, 0x65, 0x6d, 0x70, 0x74, 0x79, 0x52, 0x0a,
+	0x6d, 0x69, 0x6e, 0x44, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x12, 0x6d, 0x0a, 0x12, 0x6d, 0x61,
+	0x78, 0x5f, 0x64, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x5f, 0x70, 0x65, 0x72, 0x69, 0x6f, 0x64,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x42, 0x24, 0xea, 0xde, 0x1f, 0x1c, 0x6d, 0x61, 0x78, 0x5f, 0x64, 0x65, 0x70, 0x6f, 0x73,
+	0x69, 0x74, 0x5f, 0x70, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x2c, 0x6f, 0x6d, 0x69, 0x74, 0x65, 0x6d,
+	0x70, 0x74, 0x79, 0x98, 0xdf, 0x1f, 0x01, 0x52, 0x10, 0x6d, 0x61, 0x78, 0x44, 0x65, 0x70, 0x6f,
+	0x73, 0x69, 0x74, 0x50, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x3a, 0x02, 0x18, 0x01, 0x22, 0x58, 0x0a,
+	0x0c, 0x56, 0x6f, 0x74, 0x69, 0x6e, 0x67, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x12, 0x44, 0x0a,
+	0x0d, 0x76, 0x6f, 0x74, 0x69, 0x6e, 0x67, 0x5f, 0x70, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x42,
+	0x04, 0x98, 0xdf, 0x1f, 0x01, 0x52, 0x0c, 0x76, 0x6f, 0x74, 0x69, 0x6e, 0x67, 0x50, 0x65, 0x72,
+	0x69, 0x6f, 0x64, 0x3a, 0x02, 0x18, 0x01, 0x22, 0xac, 0x02, 0x0a, 0x0b, 0x54, 0x61, 0x6c, 0x6c,
+	0x79, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x12, 0x26, 0x0a, 0x06, 0x71, 0x75, 0x6f, 0x72, 0x75,
+	0x6d, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x0e, 0xd2, 0xb4, 0x2d, 0x0a, 0x63, 0x6f, 0x73,
+	0x6d, 0x6f, 0x73, 0x2e, 0x44, 0x65, 0x63, 0x52, 0x06, 0x71, 0x75, 0x6f, 0x72, 0x75, 0x6d, 0x12,
+	0x2c, 0x0a, 0x09, 0x74, 0x68, 0x72, 0x65, 0x73, 0x68, 0x6f, 0x6c, 0x64, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x42, 0x0e, 0xd2, 0xb4, 0x2d, 0x0a, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x44,
+	0x65, 0x63, 0x52, 0x09, 0x74, 0x68, 0x72, 0x65, 0x73, 0x68, 0x6f, 0x6c, 0x64, 0x12, 0x35, 0x0a,
+	0x0e, 0x76, 0x65, 0x74, 0x6f, 0x5f, 0x74, 0x68, 0x72, 0x65, 0x73, 0x68, 0x6f, 0x6c, 0x64, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x09, 0x42, 0x0e, 0xd2, 0xb4, 0x2d, 0x0a, 0x63, 0x6f, 0x73, 0x6d, 0x6f,
+	0x73, 0x2e, 0x44, 0x65, 0x63, 0x52, 0x0d, 0x76, 0x65, 0x74, 0x6f, 0x54, 0x68, 0x72, 0x65, 0x73,
+	0x68, 0x6f, 0x6c, 0x64, 0x12, 0x2f, 0x0a, 0x0b, 0x76, 0x65, 0x74, 0x6f, 0x5f, 0x71, 0x75, 0x6f,
+	0x72, 0x75, 0x6d, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x42, 0x0e, 0xd2, 0xb4, 0x2d, 0x0a, 0x63,
+	0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x44, 0x65, 0x63, 0x52, 0x0a, 0x76, 0x65, 0x74, 0x6f, 0x51,
+	0x75, 0x6f, 0x72, 0x75, 0x6d, 0x12, 0x5b, 0x0a, 0x15, 0x70, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x61,
+	0x6c, 0x5f, 0x6b, 0x69, 0x6e, 0x64, 0x5f, 0x71, 0x75, 0x6f, 0x72, 0x75, 0x6d, 0x73, 0x18, 0x05,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x21, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x67, 0x6f,
+	0x76, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x61, 0x6c, 0x4b, 0x69, 0x6e,
+	0x64, 0x51, 0x75, 0x6f, 0x72, 0x75, 0x6d, 0x42, 0x04, 0xc8, 0xde, 0x1f, 0x00, 0x52, 0x13, 0x70,
+	0x72, 0x6f, 0x70, 0x6f, 0x73, 0x61, 0x6c, 0x4b, 0x69, 0x6e, 0x64, 0x51, 0x75, 0x6f, 0x72, 0x75,
+	0x6d, 0x73, 0x3a, 0x02, 0x18, 0x01, 0x22, 0xc9, 0x10, 0x0a, 0x06, 0x50, 0x61, 0x72, 0x61, 0x6d,
+	0x73, 0x12, 0x45, 0x0a, 0x0b, 0x6d, 0x69, 0x6e, 0x5f, 0x64, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74,
+	0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e,
+	0x62, 0x61, 0x73, 0x65, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x43, 0x6f, 0x69,
+	0x6e, 0x42, 0x09, 0xc8, 0xde, 0x1f, 0x00, 0xa8, 0xe7, 0xb0, 0x2a, 0x01, 0x52, 0x0a, 0x6d, 0x69,
+	0x6e, 0x44, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x12, 0x4d, 0x0a, 0x12, 0x6d, 0x61, 0x78, 0x5f,
+	0x64, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x5f, 0x70, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x42,
+	0x04, 0x98, 0xdf, 0x1f, 0x01, 0x52, 0x10, 0x6d, 0x61, 0x78, 0x44, 0x65, 0x70, 0x6f, 0x73, 0x69,
+	0x74, 0x50, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x12, 0x44, 0x0a, 0x0d, 0x76, 0x6f, 0x74, 0x69, 0x6e,
+	0x67, 0x5f, 0x70, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19,
+	0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66,
+	0x2e, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x42, 0x04, 0x98, 0xdf, 0x1f, 0x01, 0x52,
+	0x0c, 0x76, 0x6f, 0x74, 0x69, 0x6e, 0x67, 0x50, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x12, 0x26, 0x0a,
+	0x06, 0x71, 0x75, 0x6f, 0x72, 0x75, 0x6d, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x42, 0x0e, 0xd2,
+	0xb4, 0x2d, 0x0a, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x44, 0x65, 0x63, 0x52, 0x06, 0x71,
+	0x75, 0x6f, 0x72, 0x75, 0x6d, 0x12, 0x2c, 0x0a, 0x09, 0x74, 0x68, 0x72, 0x65, 0x73, 0x68, 0x6f,
+	0x6c, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x42, 0x0e, 0xd2, 0xb4, 0x2d, 0x0a, 0x63, 0x6f,
+	0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x44, 0x65, 0x63, 0x52, 0x09, 0x74, 0x68, 0x72, 0x65, 0x73, 0x68,
+	0x6f, 0x6c, 0x64, 0x12, 0x35, 0x0a, 0x0e, 0x76, 0x65, 0x74, 0x6f, 0x5f, 0x74, 0x68, 0x72, 0x65,
+	0x73, 0x68, 0x6f, 0x6c, 0x64, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x42, 0x0e, 0xd2, 0xb4, 0x2d,
+	0x0a, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x44, 0x65, 0x63, 0x52, 0x0d, 0x76, 0x65, 0x74,
+	0x6f, 0x54, 0x68, 0x72, 0x65, 0x73, 0x68, 0x6f, 0x6c, 0x64, 0x12, 0x49, 0x0a, 0x19, 0x6d, 0x69,
+	0x6e, 0x5f, 0x69, 0x6e, 0x69, 0x74, 0x69, 0x61, 0x6c, 0x5f, 0x64, 0x65, 0x70, 0x6f, 0x73, 0x69,
+	0x74, 0x5f, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x42, 0x0e, 0xd2,
+	0xb4, 0x2d, 0x0a, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x44, 0x65, 0x63, 0x52, 0x16, 0x6d,
+	0x69, 0x6e, 0x49, 0x6e, 0x69, 0x74, 0x69, 0x61, 0x6c, 0x44, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74,
+	0x52, 0x61, 0x74, 0x69, 0x6f, 0x12, 0x42, 0x0a, 0x15, 0x70, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x61,
+	0x6c, 0x5f, 0x63, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x5f, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x18, 0x08,
+	0x20, 0x01, 0x28, 0x09, 0x42, 0x0e, 0xd2, 0xb4, 0x2d, 0x0a, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73,
+	0x2e, 0x44, 0x65, 0x63, 0x52, 0x13, 0x70, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x61, 0x6c, 0x43, 0x61,
+	0x6e, 0x63, 0x65, 0x6c, 0x52, 0x61, 0x74, 0x69, 0x6f, 0x12, 0x4a, 0x0a, 0x14, 0x70, 0x72, 0x6f,
+	0x70, 0x6f, 0x73, 0x61, 0x6c, 0x5f, 0x63, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x5f, 0x64, 0x65, 0x73,
+	0x74, 0x18, 0x09, 0x20, 0x01, 0x28, 0x09, 0x42, 0x18, 0xd2, 0xb4, 0x2d, 0x14, 0x63, 0x6f, 0x73,
+	0x6d, 0x6f, 0x73, 0x2e, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x53, 0x74, 0x72, 0x69, 0x6e,
+	0x67, 0x52, 0x12, 0x70, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x61, 0x6c, 0x43, 0x61, 0x6e, 0x63, 0x65,
+	0x6c, 0x44, 0x65, 0x73, 0x74, 0x12, 0x57, 0x0a, 0x17, 0x65, 0x78, 0x70, 0x65, 0x64, 0x69, 0x74,
+	0x65, 0x64, 0x5f, 0x76, 0x6f, 0x74, 0x69, 0x6e, 0x67, 0x5f, 0x70, 0x65, 0x72, 0x69, 0x6f, 0x64,
+	0x18, 0x0a, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x42, 0x04, 0x98, 0xdf, 0x1f, 0x01, 0x52, 0x15, 0x65, 0x78, 0x70, 0x65, 0x64, 0x69, 0x74,
+	0x65, 0x64, 0x56, 0x6f, 0x74, 0x69, 0x6e, 0x67, 0x50, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x12, 0x3f,
+	0x0a, 0x13, 0x65, 0x78, 0x70, 0x65, 0x64, 0x69, 0x74, 0x65, 0x64, 0x5f, 0x74, 0x68, 0x72, 0x65,
+	0x73, 0x68, 0x6f, 0x6c, 0x64, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x09, 0x42, 0x0e, 0xd2, 0xb4, 0x2d,
+	0x0a, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x44, 0x65, 0x63, 0x52, 0x12, 0x65, 0x78, 0x70,
+	0x65, 0x64, 0x69, 0x74, 0x65, 0x64, 0x54, 0x68, 0x72, 0x65, 0x73, 0x68, 0x6f, 0x6c, 0x64, 0x12,
+	0x58, 0x0a, 0x15, 0x65, 0x78, 0x70, 0x65, 0x64, 0x69, 0x74, 0x65, 0x64, 0x5f, 0x6d, 0x69, 0x6e,
+	0x5f, 0x64, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x18, 0x0c, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x19,
+	0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x76, 0x31, 0x62,
+	0x65, 0x74, 0x61, 0x31, 0x2e, 0x43, 0x6f, 0x69, 0x6e, 0x42, 0x09, 0xc8, 0xde, 0x1f, 0x00, 0xa8,
+	0xe7, 0xb0, 0x2a, 0x01, 0x52, 0x13, 0x65, 0x78, 0x70, 0x65, 0x64, 0x69, 0x74, 0x65, 0x64, 0x4d,
+	0x69, 0x6e, 0x44, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x12, 0x28, 0x0a, 0x10, 0x62, 0x75, 0x72,
+	0x6e, 0x5f, 0x76, 0x6f, 0x74, 0x65, 0x5f, 0x71, 0x75, 0x6f, 0x72, 0x75, 0x6d, 0x18, 0x0d, 0x20,
+	0x01, 0x28, 0x08, 0x52, 0x0e, 0x62, 0x75, 0x72, 0x6e, 0x56, 0x6f, 0x74, 0x65, 0x51, 0x75, 0x6f,
+	0x72, 0x75, 0x6d, 0x12, 0x41, 0x0a, 0x1d, 0x62, 0x75, 0x72, 0x6e, 0x5f, 0x70, 0x72, 0x6f, 0x70,
+	0x6f, 0x73, 0x61, 0x6c, 0x5f, 0x64, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x5f, 0x70, 0x72, 0x65,
+	0x76, 0x6f, 0x74, 0x65, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x08, 0x52, 0x1a, 0x62, 0x75, 0x72, 0x6e,
+	0x50, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x61, 0x6c, 0x44, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x50,
+	0x72, 0x65, 0x76, 0x6f, 0x74, 0x65, 0x12, 0x24, 0x0a, 0x0e, 0x62, 0x75, 0x72, 0x6e, 0x5f, 0x76,
+	0x6f, 0x74, 0x65, 0x5f, 0x76, 0x65, 0x74, 0x6f, 0x18, 0x0f, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0c,
+	0x62, 0x75, 0x72, 0x6e, 0x56, 0x6f, 0x74, 0x65, 0x56, 0x65, 0x74, 0x6f, 0x12, 0x46, 0x0a, 0x17,
+	0x64, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x5f, 0x65, 0x78, 0x74, 0x65, 0x6e, 0x73, 0x69, 0x6f,
+	0x6e, 0x5f, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x18, 0x10, 0x20, 0x01, 0x28, 0x09, 0x42, 0x0e, 0xd2,
+	0xb4, 0x2d, 0x0a, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x44, 0x65, 0x63, 0x52, 0x15, 0x64,
+	0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x45, 0x78, 0x74, 0x65, 0x6e, 0x73, 0x69, 0x6f, 0x6e, 0x52,
+	0x61, 0x74, 0x69, 0x6f, 0x12, 0x59, 0x0a, 0x18, 0x64, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x5f,
+	0x65, 0x78, 0x74, 0x65, 0x6e, 0x73, 0x69, 0x6f, 0x6e, 0x5f, 0x70, 0x65, 0x72, 0x69, 0x6f, 0x64,
+	0x18, 0x11, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x42, 0x04, 0x98, 0xdf, 0x1f, 0x01, 0x52, 0x16, 0x64, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74,
+	0x45, 0x78, 0x74, 0x65, 0x6e, 0x73, 0x69, 0x6f, 0x6e, 0x50, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x12,
+	0x4d, 0x0a, 0x15, 0x65, 0x78, 0x65, 0x63, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x61, 0x75, 0x74,
+	0x68, 0x6f, 0x72, 0x69, 0x74, 0x69, 0x65, 0x73, 0x18, 0x12, 0x20, 0x03, 0x28, 0x09, 0x42, 0x18,
+	0xd2, 0xb4, 0x2d, 0x14, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x41, 0x64, 0x64, 0x72, 0x65,
+	0x73, 0x73, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x52, 0x14, 0x65, 0x78, 0x65, 0x63, 0x75, 0x74,
+	0x69, 0x6f, 0x6e, 0x41, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x69, 0x74, 0x69, 0x65, 0x73, 0x12, 0x4c,
+	0x0a, 0x11, 0x64, 0x69, 0x73, 0x63, 0x75, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x5f, 0x70, 0x65, 0x72,
+	0x69, 0x6f, 0x64, 0x18, 0x13, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x67, 0x6f, 0x6f, 0x67,
+	0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x44, 0x75, 0x72, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x42, 0x04, 0x98, 0xdf, 0x1f, 0x01, 0x52, 0x10, 0x64, 0x69, 0x73, 0x63,
+	0x75, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x50, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x12, 0x29, 0x0a, 0x10,
+	0x65, 0x61, 0x72, 0x6c, 0x79, 0x5f, 0x72, 0x65, 0x73, 0x6f, 0x6c, 0x75, 0x74, 0x69, 0x6f, 0x6e,
+	0x18, 0x14, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0f, 0x65, 0x61, 0x72, 0x6c, 0x79, 0x52, 0x65, 0x73,
+	0x6f, 0x6c, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x4f, 0x0a, 0x25, 0x6d, 0x61, 0x78, 0x5f, 0x70,
+	0x72, 0x6f, 0x70, 0x6f, 0x73, 0x61, 0x6c, 0x73, 0x5f, 0x70, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73,
+	0x65, 0x64, 0x5f, 0x70, 0x65, 0x72, 0x5f, 0x65, 0x6e, 0x64, 0x5f, 0x62, 0x6c, 0x6f, 0x63, 0x6b,
+	0x18, 0x15, 0x20, 0x01, 0x28, 0x04, 0x52, 0x20, 0x6d, 0x61, 0x78, 0x50, 0x72, 0x6f, 0x70, 0x6f,
+	0x73, 0x61, 0x6c, 0x73, 0x50, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x65, 0x64, 0x50, 0x65, 0x72,
+	0x45, 0x6e, 0x64, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x12, 0x58, 0x0a, 0x20, 0x63, 0x6f, 0x6e, 0x73,
+	0x74, 0x69, 0x74, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x61, 0x6d, 0x65, 0x6e, 0x64, 0x6d, 0x65,
+	0x6e, 0x74, 0x5f, 0x74, 0x68, 0x72, 0x65, 0x73, 0x68, 0x6f, 0x6c, 0x64, 0x18, 0x16, 0x20, 0x01,
+	0x28, 0x09, 0x42, 0x0e, 0xd2, 0xb4, 0x2d, 0x0a, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x44,
+	0x65, 0x63, 0x52, 0x1e, 0x63, 0x6f, 0x6e, 0x73, 0x74, 0x69, 0x74, 0x75, 0x74, 0x69, 0x6f, 0x6e,
+	0x41, 0x6d, 0x65, 0x6e, 0x64, 0x6d, 0x65, 0x6e, 0x74, 0x54, 0x68, 0x72, 0x65, 0x73, 0x68, 0x6f,
+	0x6c, 0x64, 0x12, 0x4c, 0x0a, 0x11, 0x61, 0x62, 0x73, 0x74, 0x61, 0x69, 0x6e, 0x5f, 0x73, 0x65,
+	0x6d, 0x61, 0x6e, 0x74, 0x69, 0x63, 0x73, 0x18, 0x17, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1f, 0x2e,
+	0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x67, 0x6f, 0x76, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x62,
+	0x73, 0x74, 0x61, 0x69, 0x6e, 0x53, 0x65, 0x6d, 0x61, 0x6e, 0x74, 0x69, 0x63, 0x73, 0x52, 0x10,
+	0x61, 0x62, 0x73, 0x74, 0x61, 0x69, 0x6e, 0x53, 0x65, 0x6d, 0x61, 0x6e, 0x74, 0x69, 0x63, 0x73,
+	0x12, 0x61, 0x0a, 0x17, 0x61, 0x63, 0x63, 0x65, 0x70, 0x74, 0x65, 0x64, 0x5f, 0x64, 0x65, 0x70,
+	0x6f, 0x73, 0x69, 0x74, 0x5f, 0x64, 0x65, 0x6e, 0x6f, 0x6d, 0x73, 0x18, 0x18, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x23, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x67, 0x6f, 0x76, 0x2e, 0x76,
+	0x31, 0x2e, 0x41, 0x63, 0x63, 0x65, 0x70, 0x74, 0x65, 0x64, 0x44, 0x65, 0x70, 0x6f, 0x73, 0x69,
+	0x74, 0x44, 0x65, 0x6e, 0x6f, 0x6d, 0x42, 0x04, 0xc8, 0xde, 0x1f, 0x00, 0x52, 0x15, 0x61, 0x63,
+	0x63, 0x65, 0x70, 0x74, 0x65, 0x64, 0x44, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x44, 0x65, 0x6e,
+	0x6f, 0x6d, 0x73, 0x12, 0x4a, 0x0a, 0x10, 0x61, 0x6d, 0x65, 0x6e, 0x64, 0x6d, 0x65, 0x6e, 0x74,
+	0x5f, 0x70, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x18, 0x19, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e,
+	0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e,
+	0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x42, 0x04, 0x98, 0xdf, 0x1f, 0x01, 0x52, 0x0f,
+	0x61, 0x6d, 0x65, 0x6e, 0x64, 0x6d, 0x65, 0x6e, 0x74, 0x50, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x12,
+	0x5a, 0x0a, 0x21, 0x70, 0x61, 0x72, 0x74, 0x69, 0x63, 0x69, 0x70, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x5f, 0x65, 0x78, 0x65, 0x6d, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x74, 0x68, 0x72, 0x65, 0x73,
+	0x68, 0x6f, 0x6c, 0x64, 0x18, 0x1a, 0x20, 0x01, 0x28, 0x09, 0x42, 0x0e, 0xd2, 0xb4, 0x2d, 0x0a,
+	0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x44, 0x65, 0x63, 0x52, 0x1f, 0x70, 0x61, 0x72, 0x74,
+	0x69, 0x63, 0x69, 0x70, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x45, 0x78, 0x65, 0x6d, 0x70, 0x74, 0x69,
+	0x6f, 0x6e, 0x54, 0x68, 0x72, 0x65, 0x73, 0x68, 0x6f, 0x6c, 0x64, 0x12, 0x31, 0x0a, 0x15, 0x6d,
+	0x69, 0x6e, 0x5f, 0x64, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x5f, 0x61, 0x6e, 0x79, 0x5f, 0x64,
+	0x65, 0x6e, 0x6f, 0x6d, 0x18, 0x1b, 0x20, 0x01, 0x28, 0x08, 0x52, 0x12, 0x6d, 0x69, 0x6e, 0x44,
+	0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x41, 0x6e, 0x79, 0x44, 0x65, 0x6e, 0x6f, 0x6d, 0x12, 0x44,
+	0x0a, 0x0d, 0x72, 0x65, 0x76, 0x65, 0x61, 0x6c, 0x5f, 0x70, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x18,
+	0x1c, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x42, 0x04, 0x98, 0xdf, 0x1f, 0x01, 0x52, 0x0c, 0x72, 0x65, 0x76, 0x65, 0x61, 0x6c, 0x50, 0x65,
+	0x72, 0x69, 0x6f, 0x64, 0x12, 0x2f, 0x0a, 0x0b, 0x76, 0x65, 0x74, 0x6f, 0x5f, 0x71, 0x75, 0x6f,
+	0x72, 0x75, 0x6d, 0x18, 0x1d, 0x20, 0x01, 0x28, 0x09, 0x42, 0x0e, 0xd2, 0xb4, 0x2d, 0x0a, 0x63,
+	0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x44, 0x65, 0x63, 0x52, 0x0a, 0x76, 0x65, 0x74, 0x6f, 0x51,
+	0x75, 0x6f, 0x72, 0x75, 0x6d, 0x12, 0x5b, 0x0a, 0x15, 0x70, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x61,
+	0x6c, 0x5f, 0x6b, 0x69, 0x6e, 0x64, 0x5f, 0x71, 0x75, 0x6f, 0x72, 0x75, 0x6d, 0x73, 0x18, 0x1e,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x21, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x67, 0x6f,
+	0x76, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x61, 0x6c, 0x4b, 0x69, 0x6e,
+	0x64, 0x51, 0x75, 0x6f, 0x72, 0x75, 0x6d, 0x42, 0x04, 0xc8, 0xde, 0x1f, 0x00, 0x52, 0x13, 0x70,
+	0x72, 0x6f, 0x70, 0x6f, 0x73, 0x61, 0x6c, 0x4b, 0x69, 0x6e, 0x64, 0x51, 0x75, 0x6f, 0x72, 0x75,
+	0x6d, 0x73, 0x22, 0x81, 0x01, 0x0a, 0x12, 0x50, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x61, 0x6c, 0x4b,
+	0x69, 0x6e, 0x64, 0x51, 0x75, 0x6f, 0x72, 0x75, 0x6d, 0x12, 0x12, 0x0a, 0x04, 0x6b, 0x69, 0x6e,
+	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6b, 0x69, 0x6e, 0x64, 0x12, 0x26, 0x0a,
+	0x06, 0x71, 0x75, 0x6f, 0x72, 0x75, 0x6d, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x0e, 0xd2,
+	0xb4, 0x2d, 0x0a, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x44, 0x65, 0x63, 0x52, 0x06, 0x71,
+	0x75, 0x6f, 0x72, 0x75, 0x6d, 0x12, 0x2f, 0x0a, 0x0b, 0x76, 0x65, 0x74, 0x6f, 0x5f, 0x71, 0x75,
+	0x6f, 0x72, 0x75, 0x6d, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x42, 0x0e, 0xd2, 0xb4, 0x2d, 0x0a,
+	0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x44, 0x65, 0x63, 0x52, 0x0a, 0x76, 0x65, 0x74, 0x6f,
+	0x51, 0x75, 0x6f, 0x72, 0x75, 0x6d, 0x22, 0x90, 0x01, 0x0a, 0x14, 0x41, 0x63, 0x63, 0x65, 0x70,
+	0x74, 0x65, 0x64, 0x44, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x44, 0x65, 0x6e, 0x6f, 0x6d, 0x12,
+	0x14, 0x0a, 0x05, 0x64, 0x65, 0x6e, 0x6f, 0x6d, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05,
+	0x64, 0x65, 0x6e, 0x6f, 0x6d, 0x12, 0x1d, 0x0a, 0x0a, 0x62, 0x61, 0x73, 0x65, 0x5f, 0x64, 0x65,
+	0x6e, 0x6f, 0x6d, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x62, 0x61, 0x73, 0x65, 0x44,
+	0x65, 0x6e, 0x6f, 0x6d, 0x12, 0x24, 0x0a, 0x05, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x09, 0x42, 0x0e, 0xd2, 0xb4, 0x2d, 0x0a, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e,
+	0x44, 0x65, 0x63, 0x52, 0x05, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x12, 0x1d, 0x0a, 0x0a, 0x75, 0x73,
+	0x65, 0x5f, 0x6f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x09,
+	0x75, 0x73, 0x65, 0x4f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x2a, 0x89, 0x01, 0x0a, 0x0a, 0x56, 0x6f,
+	0x74, 0x65, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1b, 0x0a, 0x17, 0x56, 0x4f, 0x54, 0x45,
+	0x5f, 0x4f, 0x50, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46,
+	0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x13, 0x0a, 0x0f, 0x56, 0x4f, 0x54, 0x45, 0x5f, 0x4f, 0x50,
+	0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x59, 0x45, 0x53, 0x10, 0x01, 0x12, 0x17, 0x0a, 0x13, 0x56, 0x4f,
+	0x54, 0x45, 0x5f, 0x4f, 0x50, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x41, 0x42, 0x53, 0x54, 0x41, 0x49,
+	0x4e, 0x10, 0x02, 0x12, 0x12, 0x0a, 0x0e, 0x56, 0x4f, 0x54, 0x45, 0x5f, 0x4f, 0x50, 0x54, 0x49,
+	0x4f, 0x4e, 0x5f, 0x4e, 0x4f, 0x10, 0x03, 0x12, 0x1c, 0x0a, 0x18, 0x56, 0x4f, 0x54, 0x45, 0x5f,
+	0x4f, 0x50, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x4e, 0x4f, 0x5f, 0x57, 0x49, 0x54, 0x48, 0x5f, 0x56,
+	0x45, 0x54, 0x4f, 0x10, 0x04, 0x2a, 0xf5, 0x01, 0x0a, 0x0e, 0x50, 0x72, 0x6f, 0x70, 0x6f, 0x73,
+	0x61, 0x6c, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x1f, 0x0a, 0x1b, 0x50, 0x52, 0x4f, 0x50,
+	0x4f, 0x53, 0x41, 0x4c, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x55, 0x4e, 0x53, 0x50,
+	0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x22, 0x0a, 0x1e, 0x50, 0x52, 0x4f,
+	0x50, 0x4f, 0x53, 0x41, 0x4c, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x44, 0x45, 0x50,
+	0x4f, 0x53, 0x49, 0x54, 0x5f, 0x50, 0x45, 0x52, 0x49, 0x4f, 0x44, 0x10, 0x01, 0x12, 0x21, 0x0a,
+	0x1d, 0x50, 0x52, 0x4f, 0x50, 0x4f, 0x53, 0x41, 0x4c, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53,
+	0x5f, 0x56, 0x4f, 0x54, 0x49, 0x4e, 0x47, 0x5f, 0x50, 0x45, 0x52, 0x49, 0x4f, 0x44, 0x10, 0x02,
+	0x12, 0x1a, 0x0a, 0x16, 0x50, 0x52, 0x4f, 0x50, 0x4f, 0x53, 0x41, 0x4c, 0x5f, 0x53, 0x54, 0x41,
+	0x54, 0x55, 0x53, 0x5f, 0x50, 0x41, 0x53, 0x53, 0x45, 0x44, 0x10, 0x03, 0x12, 0x1c, 0x0a, 0x18,
+	0x50, 0x52, 0x4f, 0x50, 0x4f, 0x53, 0x41, 0x4c, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f,
+	0x52, 0x45, 0x4a, 0x45, 0x43, 0x54, 0x45, 0x44, 0x10, 0x04, 0x12, 0x1a, 0x0a, 0x16, 0x50, 0x52,
+	0x4f, 0x50, 0x4f, 0x53, 0x41, 0x4c, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x46, 0x41,
+	0x49, 0x4c, 0x45, 0x44, 0x10, 0x05, 0x12, 0x25, 0x0a, 0x21, 0x50, 0x52, 0x4f, 0x50, 0x4f, 0x53,
+	0x41, 0x4c, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x44, 0x49, 0x53, 0x43, 0x55, 0x53,
+	0x53, 0x49, 0x4f, 0x4e, 0x5f, 0x50, 0x45, 0x52, 0x49, 0x4f, 0x44, 0x10, 0x06, 0x2a, 0x98, 0x01,
+	0x0a, 0x10, 0x41, 0x62, 0x73, 0x74, 0x61, 0x69, 0x6e, 0x53, 0x65, 0x6d, 0x61, 0x6e, 0x74, 0x69,
+	0x63, 0x73, 0x12, 0x21, 0x0a, 0x1d, 0x41, 0x42, 0x53, 0x54, 0x41, 0x49, 0x4e, 0x5f, 0x53, 0x45,
+	0x4d, 0x41, 0x4e, 0x54, 0x49, 0x43, 0x53, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46,
+	0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x21, 0x0a, 0x1d, 0x41, 0x42, 0x53, 0x54, 0x41, 0x49, 0x4e,
+	0x5f, 0x53, 0x45, 0x4d, 0x41, 0x4e, 0x54, 0x49, 0x43, 0x53, 0x5f, 0x51, 0x55, 0x4f, 0x52, 0x55,
+	0x4d, 0x5f, 0x4f, 0x4e, 0x4c, 0x59, 0x10, 0x01, 0x12, 0x1f, 0x0a, 0x1b, 0x41, 0x42, 0x53, 0x54,
+	0x41, 0x49, 0x4e, 0x5f, 0x53, 0x45, 0x4d, 0x41, 0x4e, 0x54, 0x49, 0x43, 0x53, 0x5f, 0x54, 0x48,
+	0x52, 0x45, 0x53, 0x48, 0x4f, 0x4c, 0x44, 0x10, 0x02, 0x12, 0x1d, 0x0a, 0x19, 0x41, 0x42, 0x53,
+	0x54, 0x41, 0x49, 0x4e, 0x5f, 0x53, 0x45, 0x4d, 0x41, 0x4e, 0x54, 0x49, 0x43, 0x53, 0x5f, 0x49,
+	0x47, 0x4e, 0x4f, 0x52, 0x45, 0x44, 0x10, 0x03, 0x42, 0x99, 0x01, 0x0a, 0x11, 0x63, 0x6f, 0x6d,
+	0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x67, 0x6f, 0x76, 0x2e, 0x76, 0x31, 0x42, 0x08,
+	0x47, 0x6f, 0x76, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50, 0x01, 0x5a, 0x24, 0x63, 0x6f, 0x73, 0x6d,
+	0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x63, 0x6f, 0x73,
+	0x6d, 0x6f, 0x73, 0x2f, 0x67, 0x6f, 0x76, 0x2f, 0x76, 0x31, 0x3b, 0x67, 0x6f, 0x76, 0x76, 0x31,
+	0xa2, 0x02, 0x03, 0x43, 0x47, 0x58, 0xaa, 0x02, 0x0d, 0x43, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e,
+	0x47, 0x6f, 0x76, 0x2e, 0x56, 0x31, 0xca, 0x02, 0x0d, 0x43, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x5c,
+	0x47, 0x6f, 0x76, 0x5c, 0x56, 0x31, 0xe2, 0x02, 0x19, 0x43, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x5c,
+	0x47, 0x6f, 0x76, 0x5c, 0x56, 0x31, 0x5c, 0x47, 0x50, 0x42, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61,
+	0x74, 0x61, 0xea, 0x02, 0x0f, 0x43, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x3a, 0x3a, 0x47, 0x6f, 0x76,
+	0x3a, 0x3a, 0x56, 0x31, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -7952,50 +17649,78 @@ func file_cosmos_gov_v1_gov_proto_rawDescGZIP() []byte {
 	return file_cosmos_gov_v1_gov_proto_rawDescData
 }
 
-var file_cosmos_gov_v1_gov_proto_enumTypes = make([]protoimpl.EnumInfo, 2)
-var file_cosmos_gov_v1_gov_proto_msgTypes = make([]protoimpl.MessageInfo, 9)
+var file_cosmos_gov_v1_gov_proto_enumTypes = make([]protoimpl.EnumInfo, 3)
+var file_cosmos_gov_v1_gov_proto_msgTypes = make([]protoimpl.MessageInfo, 19)
 var file_cosmos_gov_v1_gov_proto_goTypes = []interface{}{
-	(VoteOption)(0),               // 0: cosmos.gov.v1.VoteOption
-	(ProposalStatus)(0),           // 1: cosmos.gov.v1.ProposalStatus
-	(*WeightedVoteOption)(nil),    // 2: cosmos.gov.v1.WeightedVoteOption
-	(*Deposit)(nil),               // 3: cosmos.gov.v1.Deposit
-	(*Proposal)(nil),              // 4: cosmos.gov.v1.Proposal
-	(*TallyResult)(nil),           // 5: cosmos.gov.v1.TallyResult
-	(*Vote)(nil),                  // 6: cosmos.gov.v1.Vote
-	(*DepositParams)(nil),         // 7: cosmos.gov.v1.DepositParams
-	(*VotingParams)(nil),          // 8: cosmos.gov.v1.VotingParams
-	(*TallyParams)(nil),           // 9: cosmos.gov.v1.TallyParams
-	(*Params)(nil),                // 10: cosmos.gov.v1.Params
-	(*v1beta1.Coin)(nil),          // 11: cosmos.base.v1beta1.Coin
-	(*anypb.Any)(nil),             // 12: google.protobuf.Any
-	(*timestamppb.Timestamp)(nil), // 13: google.protobuf.Timestamp
-	(*durationpb.Duration)(nil),   // 14: google.protobuf.Duration
+	(VoteOption)(0),                  // 0: cosmos.gov.v1.VoteOption
+	(ProposalStatus)(0),              // 1: cosmos.gov.v1.ProposalStatus
+	(AbstainSemantics)(0),            // 2: cosmos.gov.v1.AbstainSemantics
+	(*WeightedVoteOption)(nil),       // 3: cosmos.gov.v1.WeightedVoteOption
+	(*Deposit)(nil),                  // 4: cosmos.gov.v1.Deposit
+	(*Proposal)(nil),                 // 5: cosmos.gov.v1.Proposal
+	(*ProposalExecutionFailure)(nil), // 6: cosmos.gov.v1.ProposalExecutionFailure
+	(*ProposalExecutionReceipt)(nil), // 7: cosmos.gov.v1.ProposalExecutionReceipt
+	(*ParamsUpdateFailure)(nil),      // 8: cosmos.gov.v1.ParamsUpdateFailure
+	(*TallyResult)(nil),              // 9: cosmos.gov.v1.TallyResult
+	(*Vote)(nil),                     // 10: cosmos.gov.v1.Vote
+	(*VoteCommitment)(nil),           // 11: cosmos.gov.v1.VoteCommitment
+	(*ValidatorVoteBreakdown)(nil),   // 12: cosmos.gov.v1.ValidatorVoteBreakdown
+	(*ValidatorVotedShares)(nil),     // 13: cosmos.gov.v1.ValidatorVotedShares
+	(*ConstitutionAmendment)(nil),    // 14: cosmos.gov.v1.ConstitutionAmendment
+	(*MetadataSchema)(nil),           // 15: cosmos.gov.v1.MetadataSchema
+	(*DepositParams)(nil),            // 16: cosmos.gov.v1.DepositParams
+	(*VotingParams)(nil),             // 17: cosmos.gov.v1.VotingParams
+	(*TallyParams)(nil),              // 18: cosmos.gov.v1.TallyParams
+	(*Params)(nil),                   // 19: cosmos.gov.v1.Params
+	(*ProposalKindQuorum)(nil),       // 20: cosmos.gov.v1.ProposalKindQuorum
+	(*AcceptedDepositDenom)(nil),     // 21: cosmos.gov.v1.AcceptedDepositDenom
+	(*v1beta1.Coin)(nil),             // 22: cosmos.base.v1beta1.Coin
+	(*anypb.Any)(nil),                // 23: google.protobuf.Any
+	(*timestamppb.Timestamp)(nil),    // 24: google.protobuf.Timestamp
+	(*durationpb.Duration)(nil),      // 25: google.protobuf.Duration
 }
 var file_cosmos_gov_v1_gov_proto_depIdxs = []int32{
 	0,  // 0: cosmos.gov.v1.WeightedVoteOption.option:type_name -> cosmos.gov.v1.VoteOption
-	11, // 1: cosmos.gov.v1.Deposit.amount:type_name -> cosmos.base.v1beta1.Coin
-	12, // 2: cosmos.gov.v1.Proposal.messages:type_name -> google.protobuf.Any
+	22, // 1: cosmos.gov.v1.Deposit.amount:type_name -> cosmos.base.v1beta1.Coin
+	23, // 2: cosmos.gov.v1.Proposal.messages:type_name -> google.protobuf.Any
 	1,  // 3: cosmos.gov.v1.Proposal.status:type_name -> cosmos.gov.v1.ProposalStatus
-	5,  // 4: cosmos.gov.v1.Proposal.final_tally_result:type_name -> cosmos.gov.v1.TallyResult
-	13, // 5: cosmos.gov.v1.Proposal.submit_time:type_name -> google.protobuf.Timestamp
-	13, // 6: cosmos.gov.v1.Proposal.deposit_end_time:type_name -> google.protobuf.Timestamp
-	11, // 7: cosmos.gov.v1.Proposal.total_deposit:type_name -> cosmos.base.v1beta1.Coin
-	13, // 8: cosmos.gov.v1.Proposal.voting_start_time:type_name -> google.protobuf.Timestamp
-	13, // 9: cosmos.gov.v1.Proposal.voting_end_time:type_name -> google.protobuf.Timestamp
-	2,  // 10: cosmos.gov.v1.Vote.options:type_name -> cosmos.gov.v1.WeightedVoteOption
-	11, // 11: cosmos.gov.v1.DepositParams.min_deposit:type_name -> cosmos.base.v1beta1.Coin
-	14, // 12: cosmos.gov.v1.DepositParams.max_deposit_period:type_name -> google.protobuf.Duration
-	14, // 13: cosmos.gov.v1.VotingParams.voting_period:type_name -> google.protobuf.Duration
-	11, // 14: cosmos.gov.v1.Params.min_deposit:type_name -> cosmos.base.v1beta1.Coin
-	14, // 15: cosmos.gov.v1.Params.max_deposit_period:type_name -> google.protobuf.Duration
-	14, // 16: cosmos.gov.v1.Params.voting_period:type_name -> google.protobuf.Duration
-	14, // 17: cosmos.gov.v1.Params.expedited_voting_period:type_name -> google.protobuf.Duration
-	11, // 18: cosmos.gov.v1.Params.expedited_min_deposit:type_name -> cosmos.base.v1beta1.Coin
-	19, // [19:19] is the sub-list for method output_type
-	19, // [19:19] is the sub-list for method input_type
-	19, // [19:19] is the sub-list for extension type_name
-	19, // [19:19] is the sub-list for extension extendee
-	0,  // [0:19] is the sub-list for field type_name
+	9,  // 4: cosmos.gov.v1.Proposal.final_tally_result:type_name -> cosmos.gov.v1.TallyResult
+	24, // 5: cosmos.gov.v1.Proposal.submit_time:type_name -> google.protobuf.Timestamp
+	24, // 6: cosmos.gov.v1.Proposal.deposit_end_time:type_name -> google.protobuf.Timestamp
+	22, // 7: cosmos.gov.v1.Proposal.total_deposit:type_name -> cosmos.base.v1beta1.Coin
+	24, // 8: cosmos.gov.v1.Proposal.voting_start_time:type_name -> google.protobuf.Timestamp
+	24, // 9: cosmos.gov.v1.Proposal.voting_end_time:type_name -> google.protobuf.Timestamp
+	8,  // 10: cosmos.gov.v1.Proposal.params_update_failures:type_name -> cosmos.gov.v1.ParamsUpdateFailure
+	24, // 11: cosmos.gov.v1.Proposal.discussion_end_time:type_name -> google.protobuf.Timestamp
+	6,  // 12: cosmos.gov.v1.Proposal.execution_failure:type_name -> cosmos.gov.v1.ProposalExecutionFailure
+	6,  // 13: cosmos.gov.v1.Proposal.invalidation_failure:type_name -> cosmos.gov.v1.ProposalExecutionFailure
+	24, // 14: cosmos.gov.v1.Proposal.commit_end_time:type_name -> google.protobuf.Timestamp
+	24, // 15: cosmos.gov.v1.ProposalExecutionReceipt.time:type_name -> google.protobuf.Timestamp
+	23, // 16: cosmos.gov.v1.ProposalExecutionReceipt.msg_responses:type_name -> google.protobuf.Any
+	3,  // 17: cosmos.gov.v1.Vote.options:type_name -> cosmos.gov.v1.WeightedVoteOption
+	3,  // 18: cosmos.gov.v1.ValidatorVoteBreakdown.options:type_name -> cosmos.gov.v1.WeightedVoteOption
+	24, // 19: cosmos.gov.v1.ConstitutionAmendment.amended_at:type_name -> google.protobuf.Timestamp
+	22, // 20: cosmos.gov.v1.DepositParams.min_deposit:type_name -> cosmos.base.v1beta1.Coin
+	25, // 21: cosmos.gov.v1.DepositParams.max_deposit_period:type_name -> google.protobuf.Duration
+	25, // 22: cosmos.gov.v1.VotingParams.voting_period:type_name -> google.protobuf.Duration
+	20, // 23: cosmos.gov.v1.TallyParams.proposal_kind_quorums:type_name -> cosmos.gov.v1.ProposalKindQuorum
+	22, // 24: cosmos.gov.v1.Params.min_deposit:type_name -> cosmos.base.v1beta1.Coin
+	25, // 25: cosmos.gov.v1.Params.max_deposit_period:type_name -> google.protobuf.Duration
+	25, // 26: cosmos.gov.v1.Params.voting_period:type_name -> google.protobuf.Duration
+	25, // 27: cosmos.gov.v1.Params.expedited_voting_period:type_name -> google.protobuf.Duration
+	22, // 28: cosmos.gov.v1.Params.expedited_min_deposit:type_name -> cosmos.base.v1beta1.Coin
+	25, // 29: cosmos.gov.v1.Params.deposit_extension_period:type_name -> google.protobuf.Duration
+	25, // 30: cosmos.gov.v1.Params.discussion_period:type_name -> google.protobuf.Duration
+	2,  // 31: cosmos.gov.v1.Params.abstain_semantics:type_name -> cosmos.gov.v1.AbstainSemantics
+	21, // 32: cosmos.gov.v1.Params.accepted_deposit_denoms:type_name -> cosmos.gov.v1.AcceptedDepositDenom
+	25, // 33: cosmos.gov.v1.Params.amendment_period:type_name -> google.protobuf.Duration
+	25, // 34: cosmos.gov.v1.Params.reveal_period:type_name -> google.protobuf.Duration
+	20, // 35: cosmos.gov.v1.Params.proposal_kind_quorums:type_name -> cosmos.gov.v1.ProposalKindQuorum
+	36, // [36:36] is the sub-list for method output_type
+	36, // [36:36] is the sub-list for method input_type
+	36, // [36:36] is the sub-list for extension type_name
+	36, // [36:36] is the sub-list for extension extendee
+	0,  // [0:36] is the sub-list for field type_name
 }
 
 func init() { file_cosmos_gov_v1_gov_proto_init() }
@@ -8041,7 +17766,7 @@ func file_cosmos_gov_v1_gov_proto_init() {
 			}
 		}
 		file_cosmos_gov_v1_gov_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*TallyResult); i {
+			switch v := v.(*ProposalExecutionFailure); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -8053,7 +17778,7 @@ func file_cosmos_gov_v1_gov_proto_init() {
 			}
 		}
 		file_cosmos_gov_v1_gov_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Vote); i {
+			switch v := v.(*ProposalExecutionReceipt); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -8065,7 +17790,7 @@ func file_cosmos_gov_v1_gov_proto_init() {
 			}
 		}
 		file_cosmos_gov_v1_gov_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*DepositParams); i {
+			switch v := v.(*ParamsUpdateFailure); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -8077,7 +17802,7 @@ func file_cosmos_gov_v1_gov_proto_init() {
 			}
 		}
 		file_cosmos_gov_v1_gov_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*VotingParams); i {
+			switch v := v.(*TallyResult); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -8089,7 +17814,7 @@ func file_cosmos_gov_v1_gov_proto_init() {
 			}
 		}
 		file_cosmos_gov_v1_gov_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*TallyParams); i {
+			switch v := v.(*Vote); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -8101,6 +17826,102 @@ func file_cosmos_gov_v1_gov_proto_init() {
 			}
 		}
 		file_cosmos_gov_v1_gov_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*VoteCommitment); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_cosmos_gov_v1_gov_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ValidatorVoteBreakdown); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_cosmos_gov_v1_gov_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ValidatorVotedShares); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_cosmos_gov_v1_gov_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ConstitutionAmendment); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_cosmos_gov_v1_gov_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MetadataSchema); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_cosmos_gov_v1_gov_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DepositParams); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_cosmos_gov_v1_gov_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*VotingParams); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_cosmos_gov_v1_gov_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TallyParams); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_cosmos_gov_v1_gov_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*Params); i {
 			case 0:
 				return &v.state
@@ -8112,14 +17933,38 @@ func file_cosmos_gov_v1_gov_proto_init() {
 				return nil
 			}
 		}
+		file_cosmos_gov_v1_gov_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ProposalKindQuorum); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_cosmos_gov_v1_gov_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AcceptedDepositDenom); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
 	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_cosmos_gov_v1_gov_proto_rawDesc,
-			NumEnums:      2,
-			NumMessages:   9,
+			NumEnums:      3,
+			NumMessages:   19,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
@@ -3,13 +3,16 @@ package govv1
 
 import (
 	v1beta1 "cosmossdk.io/api/cosmos/base/query/v1beta1"
+	abci "cosmossdk.io/api/tendermint/abci"
 	fmt "fmt"
 	_ "github.com/cosmos/cosmos-proto"
 	runtime "github.com/cosmos/cosmos-proto/runtime"
+	_ "github.com/cosmos/gogoproto/gogoproto"
 	_ "google.golang.org/genproto/googleapis/api/annotations"
 	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
 	protoiface "google.golang.org/protobuf/runtime/protoiface"
 	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	anypb "google.golang.org/protobuf/types/known/anypb"
 	io "io"
 	reflect "reflect"
 	sync "sync"
@@ -792,25 +795,25 @@ func (x *fastReflection_QueryConstitutionResponse) ProtoMethods() *protoiface.Me
 }
 
 var (
-	md_QueryProposalRequest             protoreflect.MessageDescriptor
-	fd_QueryProposalRequest_proposal_id protoreflect.FieldDescriptor
+	md_QueryConstitutionAmendmentsRequest            protoreflect.MessageDescriptor
+	fd_QueryConstitutionAmendmentsRequest_pagination protoreflect.FieldDescriptor
 )
 
 func init() {
 	file_cosmos_gov_v1_query_proto_init()
-	md_QueryProposalRequest = File_cosmos_gov_v1_query_proto.Messages().ByName("QueryProposalRequest")
-	fd_QueryProposalRequest_proposal_id = md_QueryProposalRequest.Fields().ByName("proposal_id")
+	md_QueryConstitutionAmendmentsRequest = File_cosmos_gov_v1_query_proto.Messages().ByName("QueryConstitutionAmendmentsRequest")
+	fd_QueryConstitutionAmendmentsRequest_pagination = md_QueryConstitutionAmendmentsRequest.Fields().ByName("pagination")
 }
 
-var _ protoreflect.Message = (*fastReflection_QueryProposalRequest)(nil)
+var _ protoreflect.Message = (*fastReflection_QueryConstitutionAmendmentsRequest)(nil)
 
-type fastReflection_QueryProposalRequest QueryProposalRequest
+type fastReflection_QueryConstitutionAmendmentsRequest QueryConstitutionAmendmentsRequest
 
-func (x *QueryProposalRequest) ProtoReflect() protoreflect.Message {
-	return (*fastReflection_QueryProposalRequest)(x)
+func (x *QueryConstitutionAmendmentsRequest) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_QueryConstitutionAmendmentsRequest)(x)
 }
 
-func (x *QueryProposalRequest) slowProtoReflect() protoreflect.Message {
+func (x *QueryConstitutionAmendmentsRequest) slowProtoReflect() protoreflect.Message {
 	mi := &file_cosmos_gov_v1_query_proto_msgTypes[2]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -822,43 +825,43 @@ func (x *QueryProposalRequest) slowProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-var _fastReflection_QueryProposalRequest_messageType fastReflection_QueryProposalRequest_messageType
-var _ protoreflect.MessageType = fastReflection_QueryProposalRequest_messageType{}
+var _fastReflection_QueryConstitutionAmendmentsRequest_messageType fastReflection_QueryConstitutionAmendmentsRequest_messageType
+var _ protoreflect.MessageType = fastReflection_QueryConstitutionAmendmentsRequest_messageType{}
 
-type fastReflection_QueryProposalRequest_messageType struct{}
+type fastReflection_QueryConstitutionAmendmentsRequest_messageType struct{}
 
-func (x fastReflection_QueryProposalRequest_messageType) Zero() protoreflect.Message {
-	return (*fastReflection_QueryProposalRequest)(nil)
+func (x fastReflection_QueryConstitutionAmendmentsRequest_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_QueryConstitutionAmendmentsRequest)(nil)
 }
-func (x fastReflection_QueryProposalRequest_messageType) New() protoreflect.Message {
-	return new(fastReflection_QueryProposalRequest)
+func (x fastReflection_QueryConstitutionAmendmentsRequest_messageType) New() protoreflect.Message {
+	return new(fastReflection_QueryConstitutionAmendmentsRequest)
 }
-func (x fastReflection_QueryProposalRequest_messageType) Descriptor() protoreflect.MessageDescriptor {
-	return md_QueryProposalRequest
+func (x fastReflection_QueryConstitutionAmendmentsRequest_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryConstitutionAmendmentsRequest
 }
 
 // Descriptor returns message descriptor, which contains only the protobuf
 // type information for the message.
-func (x *fastReflection_QueryProposalRequest) Descriptor() protoreflect.MessageDescriptor {
-	return md_QueryProposalRequest
+func (x *fastReflection_QueryConstitutionAmendmentsRequest) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryConstitutionAmendmentsRequest
 }
 
 // Type returns the message type, which encapsulates both Go and protobuf
 // type information. If the Go type information is not needed,
 // it is recommended that the message descriptor be used instead.
-func (x *fastReflection_QueryProposalRequest) Type() protoreflect.MessageType {
-	return _fastReflection_QueryProposalRequest_messageType
+func (x *fastReflection_QueryConstitutionAmendmentsRequest) Type() protoreflect.MessageType {
+	return _fastReflection_QueryConstitutionAmendmentsRequest_messageType
 }
 
 // New returns a newly allocated and mutable empty message.
-func (x *fastReflection_QueryProposalRequest) New() protoreflect.Message {
-	return new(fastReflection_QueryProposalRequest)
+func (x *fastReflection_QueryConstitutionAmendmentsRequest) New() protoreflect.Message {
+	return new(fastReflection_QueryConstitutionAmendmentsRequest)
 }
 
 // Interface unwraps the message reflection interface and
 // returns the underlying ProtoMessage interface.
-func (x *fastReflection_QueryProposalRequest) Interface() protoreflect.ProtoMessage {
-	return (*QueryProposalRequest)(x)
+func (x *fastReflection_QueryConstitutionAmendmentsRequest) Interface() protoreflect.ProtoMessage {
+	return (*QueryConstitutionAmendmentsRequest)(x)
 }
 
 // Range iterates over every populated field in an undefined order,
@@ -866,10 +869,10 @@ func (x *fastReflection_QueryProposalRequest) Interface() protoreflect.ProtoMess
 // Range returns immediately if f returns false.
 // While iterating, mutating operations may only be performed
 // on the current field descriptor.
-func (x *fastReflection_QueryProposalRequest) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
-	if x.ProposalId != uint64(0) {
-		value := protoreflect.ValueOfUint64(x.ProposalId)
-		if !f(fd_QueryProposalRequest_proposal_id, value) {
+func (x *fastReflection_QueryConstitutionAmendmentsRequest) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Pagination != nil {
+		value := protoreflect.ValueOfMessage(x.Pagination.ProtoReflect())
+		if !f(fd_QueryConstitutionAmendmentsRequest_pagination, value) {
 			return
 		}
 	}
@@ -886,15 +889,15 @@ func (x *fastReflection_QueryProposalRequest) Range(f func(protoreflect.FieldDes
 // In other cases (aside from the nullable cases above),
 // a proto3 scalar field is populated if it contains a non-zero value, and
 // a repeated field is populated if it is non-empty.
-func (x *fastReflection_QueryProposalRequest) Has(fd protoreflect.FieldDescriptor) bool {
+func (x *fastReflection_QueryConstitutionAmendmentsRequest) Has(fd protoreflect.FieldDescriptor) bool {
 	switch fd.FullName() {
-	case "cosmos.gov.v1.QueryProposalRequest.proposal_id":
-		return x.ProposalId != uint64(0)
+	case "cosmos.gov.v1.QueryConstitutionAmendmentsRequest.pagination":
+		return x.Pagination != nil
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.gov.v1.QueryProposalRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.gov.v1.QueryConstitutionAmendmentsRequest"))
 		}
-		panic(fmt.Errorf("message cosmos.gov.v1.QueryProposalRequest does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.gov.v1.QueryConstitutionAmendmentsRequest does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -904,15 +907,15 @@ func (x *fastReflection_QueryProposalRequest) Has(fd protoreflect.FieldDescripto
 // associated with the given field number.
 //
 // Clear is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryProposalRequest) Clear(fd protoreflect.FieldDescriptor) {
+func (x *fastReflection_QueryConstitutionAmendmentsRequest) Clear(fd protoreflect.FieldDescriptor) {
 	switch fd.FullName() {
-	case "cosmos.gov.v1.QueryProposalRequest.proposal_id":
-		x.ProposalId = uint64(0)
+	case "cosmos.gov.v1.QueryConstitutionAmendmentsRequest.pagination":
+		x.Pagination = nil
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.gov.v1.QueryProposalRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.gov.v1.QueryConstitutionAmendmentsRequest"))
 		}
-		panic(fmt.Errorf("message cosmos.gov.v1.QueryProposalRequest does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.gov.v1.QueryConstitutionAmendmentsRequest does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -922,16 +925,16 @@ func (x *fastReflection_QueryProposalRequest) Clear(fd protoreflect.FieldDescrip
 // the default value of a bytes scalar is guaranteed to be a copy.
 // For unpopulated composite types, it returns an empty, read-only view
 // of the value; to obtain a mutable reference, use Mutable.
-func (x *fastReflection_QueryProposalRequest) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_QueryConstitutionAmendmentsRequest) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
 	switch descriptor.FullName() {
-	case "cosmos.gov.v1.QueryProposalRequest.proposal_id":
-		value := x.ProposalId
-		return protoreflect.ValueOfUint64(value)
+	case "cosmos.gov.v1.QueryConstitutionAmendmentsRequest.pagination":
+		value := x.Pagination
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
 	default:
 		if descriptor.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.gov.v1.QueryProposalRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.gov.v1.QueryConstitutionAmendmentsRequest"))
 		}
-		panic(fmt.Errorf("message cosmos.gov.v1.QueryProposalRequest does not contain field %s", descriptor.FullName()))
+		panic(fmt.Errorf("message cosmos.gov.v1.QueryConstitutionAmendmentsRequest does not contain field %s", descriptor.FullName()))
 	}
 }
 
@@ -945,15 +948,15 @@ func (x *fastReflection_QueryProposalRequest) Get(descriptor protoreflect.FieldD
 // empty, read-only value, then it panics.
 //
 // Set is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryProposalRequest) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+func (x *fastReflection_QueryConstitutionAmendmentsRequest) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
 	switch fd.FullName() {
-	case "cosmos.gov.v1.QueryProposalRequest.proposal_id":
-		x.ProposalId = value.Uint()
+	case "cosmos.gov.v1.QueryConstitutionAmendmentsRequest.pagination":
+		x.Pagination = value.Message().Interface().(*v1beta1.PageRequest)
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.gov.v1.QueryProposalRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.gov.v1.QueryConstitutionAmendmentsRequest"))
 		}
-		panic(fmt.Errorf("message cosmos.gov.v1.QueryProposalRequest does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.gov.v1.QueryConstitutionAmendmentsRequest does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -967,40 +970,44 @@ func (x *fastReflection_QueryProposalRequest) Set(fd protoreflect.FieldDescripto
 // It panics if the field does not contain a composite type.
 //
 // Mutable is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryProposalRequest) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_QueryConstitutionAmendmentsRequest) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "cosmos.gov.v1.QueryProposalRequest.proposal_id":
-		panic(fmt.Errorf("field proposal_id of message cosmos.gov.v1.QueryProposalRequest is not mutable"))
+	case "cosmos.gov.v1.QueryConstitutionAmendmentsRequest.pagination":
+		if x.Pagination == nil {
+			x.Pagination = new(v1beta1.PageRequest)
+		}
+		return protoreflect.ValueOfMessage(x.Pagination.ProtoReflect())
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.gov.v1.QueryProposalRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.gov.v1.QueryConstitutionAmendmentsRequest"))
 		}
-		panic(fmt.Errorf("message cosmos.gov.v1.QueryProposalRequest does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.gov.v1.QueryConstitutionAmendmentsRequest does not contain field %s", fd.FullName()))
 	}
 }
 
 // NewField returns a new value that is assignable to the field
 // for the given descriptor. For scalars, this returns the default value.
 // For lists, maps, and messages, this returns a new, empty, mutable value.
-func (x *fastReflection_QueryProposalRequest) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_QueryConstitutionAmendmentsRequest) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "cosmos.gov.v1.QueryProposalRequest.proposal_id":
-		return protoreflect.ValueOfUint64(uint64(0))
+	case "cosmos.gov.v1.QueryConstitutionAmendmentsRequest.pagination":
+		m := new(v1beta1.PageRequest)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.gov.v1.QueryProposalRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.gov.v1.QueryConstitutionAmendmentsRequest"))
 		}
-		panic(fmt.Errorf("message cosmos.gov.v1.QueryProposalRequest does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.gov.v1.QueryConstitutionAmendmentsRequest does not contain field %s", fd.FullName()))
 	}
 }
 
 // WhichOneof reports which field within the oneof is populated,
 // returning nil if none are populated.
 // It panics if the oneof descriptor does not belong to this message.
-func (x *fastReflection_QueryProposalRequest) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+func (x *fastReflection_QueryConstitutionAmendmentsRequest) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
 	switch d.FullName() {
 	default:
-		panic(fmt.Errorf("%s is not a oneof field in cosmos.gov.v1.QueryProposalRequest", d.FullName()))
+		panic(fmt.Errorf("%s is not a oneof field in cosmos.gov.v1.QueryConstitutionAmendmentsRequest", d.FullName()))
 	}
 	panic("unreachable")
 }
@@ -1008,7 +1015,7 @@ func (x *fastReflection_QueryProposalRequest) WhichOneof(d protoreflect.OneofDes
 // GetUnknown retrieves the entire list of unknown fields.
 // The caller may only mutate the contents of the RawFields
 // if the mutated bytes are stored back into the message with SetUnknown.
-func (x *fastReflection_QueryProposalRequest) GetUnknown() protoreflect.RawFields {
+func (x *fastReflection_QueryConstitutionAmendmentsRequest) GetUnknown() protoreflect.RawFields {
 	return x.unknownFields
 }
 
@@ -1019,7 +1026,7 @@ func (x *fastReflection_QueryProposalRequest) GetUnknown() protoreflect.RawField
 // An empty RawFields may be passed to clear the fields.
 //
 // SetUnknown is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryProposalRequest) SetUnknown(fields protoreflect.RawFields) {
+func (x *fastReflection_QueryConstitutionAmendmentsRequest) SetUnknown(fields protoreflect.RawFields) {
 	x.unknownFields = fields
 }
 
@@ -1031,7 +1038,7 @@ func (x *fastReflection_QueryProposalRequest) SetUnknown(fields protoreflect.Raw
 // message type, but the details are implementation dependent.
 // Validity is not part of the protobuf data model, and may not
 // be preserved in marshaling or other operations.
-func (x *fastReflection_QueryProposalRequest) IsValid() bool {
+func (x *fastReflection_QueryConstitutionAmendmentsRequest) IsValid() bool {
 	return x != nil
 }
 
@@ -1041,9 +1048,9 @@ func (x *fastReflection_QueryProposalRequest) IsValid() bool {
 // The returned methods type is identical to
 // "google.golang.org/protobuf/runtime/protoiface".Methods.
 // Consult the protoiface package documentation for details.
-func (x *fastReflection_QueryProposalRequest) ProtoMethods() *protoiface.Methods {
+func (x *fastReflection_QueryConstitutionAmendmentsRequest) ProtoMethods() *protoiface.Methods {
 	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
-		x := input.Message.Interface().(*QueryProposalRequest)
+		x := input.Message.Interface().(*QueryConstitutionAmendmentsRequest)
 		if x == nil {
 			return protoiface.SizeOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -1055,8 +1062,9 @@ func (x *fastReflection_QueryProposalRequest) ProtoMethods() *protoiface.Methods
 		var n int
 		var l int
 		_ = l
-		if x.ProposalId != 0 {
-			n += 1 + runtime.Sov(uint64(x.ProposalId))
+		if x.Pagination != nil {
+			l = options.Size(x.Pagination)
+			n += 1 + l + runtime.Sov(uint64(l))
 		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
@@ -1068,7 +1076,7 @@ func (x *fastReflection_QueryProposalRequest) ProtoMethods() *protoiface.Methods
 	}
 
 	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
-		x := input.Message.Interface().(*QueryProposalRequest)
+		x := input.Message.Interface().(*QueryConstitutionAmendmentsRequest)
 		if x == nil {
 			return protoiface.MarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -1087,10 +1095,19 @@ func (x *fastReflection_QueryProposalRequest) ProtoMethods() *protoiface.Methods
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
-		if x.ProposalId != 0 {
-			i = runtime.EncodeVarint(dAtA, i, uint64(x.ProposalId))
+		if x.Pagination != nil {
+			encoded, err := options.Marshal(x.Pagination)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
 			i--
-			dAtA[i] = 0x8
+			dAtA[i] = 0xa
 		}
 		if input.Buf != nil {
 			input.Buf = append(input.Buf, dAtA...)
@@ -1103,7 +1120,7 @@ func (x *fastReflection_QueryProposalRequest) ProtoMethods() *protoiface.Methods
 		}, nil
 	}
 	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
-		x := input.Message.Interface().(*QueryProposalRequest)
+		x := input.Message.Interface().(*QueryConstitutionAmendmentsRequest)
 		if x == nil {
 			return protoiface.UnmarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -1135,17 +1152,17 @@ func (x *fastReflection_QueryProposalRequest) ProtoMethods() *protoiface.Methods
 			fieldNum := int32(wire >> 3)
 			wireType := int(wire & 0x7)
 			if wireType == 4 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryProposalRequest: wiretype end group for non-group")
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryConstitutionAmendmentsRequest: wiretype end group for non-group")
 			}
 			if fieldNum <= 0 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryProposalRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryConstitutionAmendmentsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 			}
 			switch fieldNum {
 			case 1:
-				if wireType != 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ProposalId", wireType)
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
 				}
-				x.ProposalId = 0
+				var msglen int
 				for shift := uint(0); ; shift += 7 {
 					if shift >= 64 {
 						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
@@ -1155,11 +1172,28 @@ func (x *fastReflection_QueryProposalRequest) ProtoMethods() *protoiface.Methods
 					}
 					b := dAtA[iNdEx]
 					iNdEx++
-					x.ProposalId |= uint64(b&0x7F) << shift
+					msglen |= int(b&0x7F) << shift
 					if b < 0x80 {
 						break
 					}
 				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.Pagination == nil {
+					x.Pagination = &v1beta1.PageRequest{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Pagination); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
 			default:
 				iNdEx = preIndex
 				skippy, err := runtime.Skip(dAtA[iNdEx:])
@@ -1195,26 +1229,79 @@ func (x *fastReflection_QueryProposalRequest) ProtoMethods() *protoiface.Methods
 	}
 }
 
+var _ protoreflect.List = (*_QueryConstitutionAmendmentsResponse_1_list)(nil)
+
+type _QueryConstitutionAmendmentsResponse_1_list struct {
+	list *[]*ConstitutionAmendment
+}
+
+func (x *_QueryConstitutionAmendmentsResponse_1_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_QueryConstitutionAmendmentsResponse_1_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_QueryConstitutionAmendmentsResponse_1_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*ConstitutionAmendment)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_QueryConstitutionAmendmentsResponse_1_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*ConstitutionAmendment)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_QueryConstitutionAmendmentsResponse_1_list) AppendMutable() protoreflect.Value {
+	v := new(ConstitutionAmendment)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_QueryConstitutionAmendmentsResponse_1_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_QueryConstitutionAmendmentsResponse_1_list) NewElement() protoreflect.Value {
+	v := new(ConstitutionAmendment)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_QueryConstitutionAmendmentsResponse_1_list) IsValid() bool {
+	return x.list != nil
+}
+
 var (
-	md_QueryProposalResponse          protoreflect.MessageDescriptor
-	fd_QueryProposalResponse_proposal protoreflect.FieldDescriptor
+	md_QueryConstitutionAmendmentsResponse            protoreflect.MessageDescriptor
+	fd_QueryConstitutionAmendmentsResponse_amendments protoreflect.FieldDescriptor
+	fd_QueryConstitutionAmendmentsResponse_pagination protoreflect.FieldDescriptor
 )
 
 func init() {
 	file_cosmos_gov_v1_query_proto_init()
-	md_QueryProposalResponse = File_cosmos_gov_v1_query_proto.Messages().ByName("QueryProposalResponse")
-	fd_QueryProposalResponse_proposal = md_QueryProposalResponse.Fields().ByName("proposal")
+	md_QueryConstitutionAmendmentsResponse = File_cosmos_gov_v1_query_proto.Messages().ByName("QueryConstitutionAmendmentsResponse")
+	fd_QueryConstitutionAmendmentsResponse_amendments = md_QueryConstitutionAmendmentsResponse.Fields().ByName("amendments")
+	fd_QueryConstitutionAmendmentsResponse_pagination = md_QueryConstitutionAmendmentsResponse.Fields().ByName("pagination")
 }
 
-var _ protoreflect.Message = (*fastReflection_QueryProposalResponse)(nil)
+var _ protoreflect.Message = (*fastReflection_QueryConstitutionAmendmentsResponse)(nil)
 
-type fastReflection_QueryProposalResponse QueryProposalResponse
+type fastReflection_QueryConstitutionAmendmentsResponse QueryConstitutionAmendmentsResponse
 
-func (x *QueryProposalResponse) ProtoReflect() protoreflect.Message {
-	return (*fastReflection_QueryProposalResponse)(x)
+func (x *QueryConstitutionAmendmentsResponse) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_QueryConstitutionAmendmentsResponse)(x)
 }
 
-func (x *QueryProposalResponse) slowProtoReflect() protoreflect.Message {
+func (x *QueryConstitutionAmendmentsResponse) slowProtoReflect() protoreflect.Message {
 	mi := &file_cosmos_gov_v1_query_proto_msgTypes[3]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1226,43 +1313,43 @@ func (x *QueryProposalResponse) slowProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-var _fastReflection_QueryProposalResponse_messageType fastReflection_QueryProposalResponse_messageType
-var _ protoreflect.MessageType = fastReflection_QueryProposalResponse_messageType{}
+var _fastReflection_QueryConstitutionAmendmentsResponse_messageType fastReflection_QueryConstitutionAmendmentsResponse_messageType
+var _ protoreflect.MessageType = fastReflection_QueryConstitutionAmendmentsResponse_messageType{}
 
-type fastReflection_QueryProposalResponse_messageType struct{}
+type fastReflection_QueryConstitutionAmendmentsResponse_messageType struct{}
 
-func (x fastReflection_QueryProposalResponse_messageType) Zero() protoreflect.Message {
-	return (*fastReflection_QueryProposalResponse)(nil)
+func (x fastReflection_QueryConstitutionAmendmentsResponse_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_QueryConstitutionAmendmentsResponse)(nil)
 }
-func (x fastReflection_QueryProposalResponse_messageType) New() protoreflect.Message {
-	return new(fastReflection_QueryProposalResponse)
+func (x fastReflection_QueryConstitutionAmendmentsResponse_messageType) New() protoreflect.Message {
+	return new(fastReflection_QueryConstitutionAmendmentsResponse)
 }
-func (x fastReflection_QueryProposalResponse_messageType) Descriptor() protoreflect.MessageDescriptor {
-	return md_QueryProposalResponse
+func (x fastReflection_QueryConstitutionAmendmentsResponse_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryConstitutionAmendmentsResponse
 }
 
 // Descriptor returns message descriptor, which contains only the protobuf
 // type information for the message.
-func (x *fastReflection_QueryProposalResponse) Descriptor() protoreflect.MessageDescriptor {
-	return md_QueryProposalResponse
+func (x *fastReflection_QueryConstitutionAmendmentsResponse) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryConstitutionAmendmentsResponse
 }
 
 // Type returns the message type, which encapsulates both Go and protobuf
 // type information. If the Go type information is not needed,
 // it is recommended that the message descriptor be used instead.
-func (x *fastReflection_QueryProposalResponse) Type() protoreflect.MessageType {
-	return _fastReflection_QueryProposalResponse_messageType
+func (x *fastReflection_QueryConstitutionAmendmentsResponse) Type() protoreflect.MessageType {
+	return _fastReflection_QueryConstitutionAmendmentsResponse_messageType
 }
 
 // New returns a newly allocated and mutable empty message.
-func (x *fastReflection_QueryProposalResponse) New() protoreflect.Message {
-	return new(fastReflection_QueryProposalResponse)
+func (x *fastReflection_QueryConstitutionAmendmentsResponse) New() protoreflect.Message {
+	return new(fastReflection_QueryConstitutionAmendmentsResponse)
 }
 
 // Interface unwraps the message reflection interface and
 // returns the underlying ProtoMessage interface.
-func (x *fastReflection_QueryProposalResponse) Interface() protoreflect.ProtoMessage {
-	return (*QueryProposalResponse)(x)
+func (x *fastReflection_QueryConstitutionAmendmentsResponse) Interface() protoreflect.ProtoMessage {
+	return (*QueryConstitutionAmendmentsResponse)(x)
 }
 
 // Range iterates over every populated field in an undefined order,
@@ -1270,10 +1357,16 @@ func (x *fastReflection_QueryProposalResponse) Interface() protoreflect.ProtoMes
 // Range returns immediately if f returns false.
 // While iterating, mutating operations may only be performed
 // on the current field descriptor.
-func (x *fastReflection_QueryProposalResponse) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
-	if x.Proposal != nil {
-		value := protoreflect.ValueOfMessage(x.Proposal.ProtoReflect())
-		if !f(fd_QueryProposalResponse_proposal, value) {
+func (x *fastReflection_QueryConstitutionAmendmentsResponse) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if len(x.Amendments) != 0 {
+		value := protoreflect.ValueOfList(&_QueryConstitutionAmendmentsResponse_1_list{list: &x.Amendments})
+		if !f(fd_QueryConstitutionAmendmentsResponse_amendments, value) {
+			return
+		}
+	}
+	if x.Pagination != nil {
+		value := protoreflect.ValueOfMessage(x.Pagination.ProtoReflect())
+		if !f(fd_QueryConstitutionAmendmentsResponse_pagination, value) {
 			return
 		}
 	}
@@ -1290,15 +1383,17 @@ func (x *fastReflection_QueryProposalResponse) Range(f func(protoreflect.FieldDe
 // In other cases (aside from the nullable cases above),
 // a proto3 scalar field is populated if it contains a non-zero value, and
 // a repeated field is populated if it is non-empty.
-func (x *fastReflection_QueryProposalResponse) Has(fd protoreflect.FieldDescriptor) bool {
+func (x *fastReflection_QueryConstitutionAmendmentsResponse) Has(fd protoreflect.FieldDescriptor) bool {
 	switch fd.FullName() {
-	case "cosmos.gov.v1.QueryProposalResponse.proposal":
-		return x.Proposal != nil
+	case "cosmos.gov.v1.QueryConstitutionAmendmentsResponse.amendments":
+		return len(x.Amendments) != 0
+	case "cosmos.gov.v1.QueryConstitutionAmendmentsResponse.pagination":
+		return x.Pagination != nil
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.gov.v1.QueryProposalResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.gov.v1.QueryConstitutionAmendmentsResponse"))
 		}
-		panic(fmt.Errorf("message cosmos.gov.v1.QueryProposalResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.gov.v1.QueryConstitutionAmendmentsResponse does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -1308,15 +1403,17 @@ func (x *fastReflection_QueryProposalResponse) Has(fd protoreflect.FieldDescript
 // associated with the given field number.
 //
 // Clear is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryProposalResponse) Clear(fd protoreflect.FieldDescriptor) {
+func (x *fastReflection_QueryConstitutionAmendmentsResponse) Clear(fd protoreflect.FieldDescriptor) {
 	switch fd.FullName() {
-	case "cosmos.gov.v1.QueryProposalResponse.proposal":
-		x.Proposal = nil
+	case "cosmos.gov.v1.QueryConstitutionAmendmentsResponse.amendments":
+		x.Amendments = nil
+	case "cosmos.gov.v1.QueryConstitutionAmendmentsResponse.pagination":
+		x.Pagination = nil
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.gov.v1.QueryProposalResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.gov.v1.QueryConstitutionAmendmentsResponse"))
 		}
-		panic(fmt.Errorf("message cosmos.gov.v1.QueryProposalResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.gov.v1.QueryConstitutionAmendmentsResponse does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -1326,16 +1423,22 @@ func (x *fastReflection_QueryProposalResponse) Clear(fd protoreflect.FieldDescri
 // the default value of a bytes scalar is guaranteed to be a copy.
 // For unpopulated composite types, it returns an empty, read-only view
 // of the value; to obtain a mutable reference, use Mutable.
-func (x *fastReflection_QueryProposalResponse) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_QueryConstitutionAmendmentsResponse) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
 	switch descriptor.FullName() {
-	case "cosmos.gov.v1.QueryProposalResponse.proposal":
-		value := x.Proposal
+	case "cosmos.gov.v1.QueryConstitutionAmendmentsResponse.amendments":
+		if len(x.Amendments) == 0 {
+			return protoreflect.ValueOfList(&_QueryConstitutionAmendmentsResponse_1_list{})
+		}
+		listValue := &_QueryConstitutionAmendmentsResponse_1_list{list: &x.Amendments}
+		return protoreflect.ValueOfList(listValue)
+	case "cosmos.gov.v1.QueryConstitutionAmendmentsResponse.pagination":
+		value := x.Pagination
 		return protoreflect.ValueOfMessage(value.ProtoReflect())
 	default:
 		if descriptor.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.gov.v1.QueryProposalResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.gov.v1.QueryConstitutionAmendmentsResponse"))
 		}
-		panic(fmt.Errorf("message cosmos.gov.v1.QueryProposalResponse does not contain field %s", descriptor.FullName()))
+		panic(fmt.Errorf("message cosmos.gov.v1.QueryConstitutionAmendmentsResponse does not contain field %s", descriptor.FullName()))
 	}
 }
 
@@ -1349,15 +1452,19 @@ func (x *fastReflection_QueryProposalResponse) Get(descriptor protoreflect.Field
 // empty, read-only value, then it panics.
 //
 // Set is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryProposalResponse) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+func (x *fastReflection_QueryConstitutionAmendmentsResponse) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
 	switch fd.FullName() {
-	case "cosmos.gov.v1.QueryProposalResponse.proposal":
-		x.Proposal = value.Message().Interface().(*Proposal)
+	case "cosmos.gov.v1.QueryConstitutionAmendmentsResponse.amendments":
+		lv := value.List()
+		clv := lv.(*_QueryConstitutionAmendmentsResponse_1_list)
+		x.Amendments = *clv.list
+	case "cosmos.gov.v1.QueryConstitutionAmendmentsResponse.pagination":
+		x.Pagination = value.Message().Interface().(*v1beta1.PageResponse)
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.gov.v1.QueryProposalResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.gov.v1.QueryConstitutionAmendmentsResponse"))
 		}
-		panic(fmt.Errorf("message cosmos.gov.v1.QueryProposalResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.gov.v1.QueryConstitutionAmendmentsResponse does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -1371,44 +1478,53 @@ func (x *fastReflection_QueryProposalResponse) Set(fd protoreflect.FieldDescript
 // It panics if the field does not contain a composite type.
 //
 // Mutable is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryProposalResponse) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_QueryConstitutionAmendmentsResponse) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "cosmos.gov.v1.QueryProposalResponse.proposal":
-		if x.Proposal == nil {
-			x.Proposal = new(Proposal)
+	case "cosmos.gov.v1.QueryConstitutionAmendmentsResponse.amendments":
+		if x.Amendments == nil {
+			x.Amendments = []*ConstitutionAmendment{}
 		}
-		return protoreflect.ValueOfMessage(x.Proposal.ProtoReflect())
+		value := &_QueryConstitutionAmendmentsResponse_1_list{list: &x.Amendments}
+		return protoreflect.ValueOfList(value)
+	case "cosmos.gov.v1.QueryConstitutionAmendmentsResponse.pagination":
+		if x.Pagination == nil {
+			x.Pagination = new(v1beta1.PageResponse)
+		}
+		return protoreflect.ValueOfMessage(x.Pagination.ProtoReflect())
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.gov.v1.QueryProposalResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.gov.v1.QueryConstitutionAmendmentsResponse"))
 		}
-		panic(fmt.Errorf("message cosmos.gov.v1.QueryProposalResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.gov.v1.QueryConstitutionAmendmentsResponse does not contain field %s", fd.FullName()))
 	}
 }
 
 // NewField returns a new value that is assignable to the field
 // for the given descriptor. For scalars, this returns the default value.
 // For lists, maps, and messages, this returns a new, empty, mutable value.
-func (x *fastReflection_QueryProposalResponse) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_QueryConstitutionAmendmentsResponse) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "cosmos.gov.v1.QueryProposalResponse.proposal":
-		m := new(Proposal)
+	case "cosmos.gov.v1.QueryConstitutionAmendmentsResponse.amendments":
+		list := []*ConstitutionAmendment{}
+		return protoreflect.ValueOfList(&_QueryConstitutionAmendmentsResponse_1_list{list: &list})
+	case "cosmos.gov.v1.QueryConstitutionAmendmentsResponse.pagination":
+		m := new(v1beta1.PageResponse)
 		return protoreflect.ValueOfMessage(m.ProtoReflect())
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.gov.v1.QueryProposalResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.gov.v1.QueryConstitutionAmendmentsResponse"))
 		}
-		panic(fmt.Errorf("message cosmos.gov.v1.QueryProposalResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.gov.v1.QueryConstitutionAmendmentsResponse does not contain field %s", fd.FullName()))
 	}
 }
 
 // WhichOneof reports which field within the oneof is populated,
 // returning nil if none are populated.
 // It panics if the oneof descriptor does not belong to this message.
-func (x *fastReflection_QueryProposalResponse) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+func (x *fastReflection_QueryConstitutionAmendmentsResponse) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
 	switch d.FullName() {
 	default:
-		panic(fmt.Errorf("%s is not a oneof field in cosmos.gov.v1.QueryProposalResponse", d.FullName()))
+		panic(fmt.Errorf("%s is not a oneof field in cosmos.gov.v1.QueryConstitutionAmendmentsResponse", d.FullName()))
 	}
 	panic("unreachable")
 }
@@ -1416,7 +1532,7 @@ func (x *fastReflection_QueryProposalResponse) WhichOneof(d protoreflect.OneofDe
 // GetUnknown retrieves the entire list of unknown fields.
 // The caller may only mutate the contents of the RawFields
 // if the mutated bytes are stored back into the message with SetUnknown.
-func (x *fastReflection_QueryProposalResponse) GetUnknown() protoreflect.RawFields {
+func (x *fastReflection_QueryConstitutionAmendmentsResponse) GetUnknown() protoreflect.RawFields {
 	return x.unknownFields
 }
 
@@ -1427,7 +1543,7 @@ func (x *fastReflection_QueryProposalResponse) GetUnknown() protoreflect.RawFiel
 // An empty RawFields may be passed to clear the fields.
 //
 // SetUnknown is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryProposalResponse) SetUnknown(fields protoreflect.RawFields) {
+func (x *fastReflection_QueryConstitutionAmendmentsResponse) SetUnknown(fields protoreflect.RawFields) {
 	x.unknownFields = fields
 }
 
@@ -1439,7 +1555,7 @@ func (x *fastReflection_QueryProposalResponse) SetUnknown(fields protoreflect.Ra
 // message type, but the details are implementation dependent.
 // Validity is not part of the protobuf data model, and may not
 // be preserved in marshaling or other operations.
-func (x *fastReflection_QueryProposalResponse) IsValid() bool {
+func (x *fastReflection_QueryConstitutionAmendmentsResponse) IsValid() bool {
 	return x != nil
 }
 
@@ -1449,9 +1565,9 @@ func (x *fastReflection_QueryProposalResponse) IsValid() bool {
 // The returned methods type is identical to
 // "google.golang.org/protobuf/runtime/protoiface".Methods.
 // Consult the protoiface package documentation for details.
-func (x *fastReflection_QueryProposalResponse) ProtoMethods() *protoiface.Methods {
+func (x *fastReflection_QueryConstitutionAmendmentsResponse) ProtoMethods() *protoiface.Methods {
 	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
-		x := input.Message.Interface().(*QueryProposalResponse)
+		x := input.Message.Interface().(*QueryConstitutionAmendmentsResponse)
 		if x == nil {
 			return protoiface.SizeOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -1463,9 +1579,15 @@ func (x *fastReflection_QueryProposalResponse) ProtoMethods() *protoiface.Method
 		var n int
 		var l int
 		_ = l
-		if x.Proposal != nil {
-			l = options.Size(x.Proposal)
-			n += 1 + l + runtime.Sov(uint64(l))
+		if len(x.Amendments) > 0 {
+			for _, e := range x.Amendments {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if x.Pagination != nil {
+			l = options.Size(x.Pagination)
+			n += 1 + l + runtime.Sov(uint64(l))
 		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
@@ -1477,7 +1599,7 @@ func (x *fastReflection_QueryProposalResponse) ProtoMethods() *protoiface.Method
 	}
 
 	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
-		x := input.Message.Interface().(*QueryProposalResponse)
+		x := input.Message.Interface().(*QueryConstitutionAmendmentsResponse)
 		if x == nil {
 			return protoiface.MarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -1496,8 +1618,8 @@ func (x *fastReflection_QueryProposalResponse) ProtoMethods() *protoiface.Method
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
-		if x.Proposal != nil {
-			encoded, err := options.Marshal(x.Proposal)
+		if x.Pagination != nil {
+			encoded, err := options.Marshal(x.Pagination)
 			if err != nil {
 				return protoiface.MarshalOutput{
 					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -1508,7 +1630,23 @@ func (x *fastReflection_QueryProposalResponse) ProtoMethods() *protoiface.Method
 			copy(dAtA[i:], encoded)
 			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
 			i--
-			dAtA[i] = 0xa
+			dAtA[i] = 0x12
+		}
+		if len(x.Amendments) > 0 {
+			for iNdEx := len(x.Amendments) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.Amendments[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0xa
+			}
 		}
 		if input.Buf != nil {
 			input.Buf = append(input.Buf, dAtA...)
@@ -1521,7 +1659,7 @@ func (x *fastReflection_QueryProposalResponse) ProtoMethods() *protoiface.Method
 		}, nil
 	}
 	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
-		x := input.Message.Interface().(*QueryProposalResponse)
+		x := input.Message.Interface().(*QueryConstitutionAmendmentsResponse)
 		if x == nil {
 			return protoiface.UnmarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -1553,15 +1691,15 @@ func (x *fastReflection_QueryProposalResponse) ProtoMethods() *protoiface.Method
 			fieldNum := int32(wire >> 3)
 			wireType := int(wire & 0x7)
 			if wireType == 4 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryProposalResponse: wiretype end group for non-group")
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryConstitutionAmendmentsResponse: wiretype end group for non-group")
 			}
 			if fieldNum <= 0 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryProposalResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryConstitutionAmendmentsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 			}
 			switch fieldNum {
 			case 1:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Proposal", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Amendments", wireType)
 				}
 				var msglen int
 				for shift := uint(0); ; shift += 7 {
@@ -1588,10 +1726,44 @@ func (x *fastReflection_QueryProposalResponse) ProtoMethods() *protoiface.Method
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				if x.Proposal == nil {
-					x.Proposal = &Proposal{}
+				x.Amendments = append(x.Amendments, &ConstitutionAmendment{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Amendments[len(x.Amendments)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
 				}
-				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Proposal); err != nil {
+				iNdEx = postIndex
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.Pagination == nil {
+					x.Pagination = &v1beta1.PageResponse{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Pagination); err != nil {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
 				}
 				iNdEx = postIndex
@@ -1631,31 +1803,25 @@ func (x *fastReflection_QueryProposalResponse) ProtoMethods() *protoiface.Method
 }
 
 var (
-	md_QueryProposalsRequest                 protoreflect.MessageDescriptor
-	fd_QueryProposalsRequest_proposal_status protoreflect.FieldDescriptor
-	fd_QueryProposalsRequest_voter           protoreflect.FieldDescriptor
-	fd_QueryProposalsRequest_depositor       protoreflect.FieldDescriptor
-	fd_QueryProposalsRequest_pagination      protoreflect.FieldDescriptor
+	md_QueryProposalRequest             protoreflect.MessageDescriptor
+	fd_QueryProposalRequest_proposal_id protoreflect.FieldDescriptor
 )
 
 func init() {
 	file_cosmos_gov_v1_query_proto_init()
-	md_QueryProposalsRequest = File_cosmos_gov_v1_query_proto.Messages().ByName("QueryProposalsRequest")
-	fd_QueryProposalsRequest_proposal_status = md_QueryProposalsRequest.Fields().ByName("proposal_status")
-	fd_QueryProposalsRequest_voter = md_QueryProposalsRequest.Fields().ByName("voter")
-	fd_QueryProposalsRequest_depositor = md_QueryProposalsRequest.Fields().ByName("depositor")
-	fd_QueryProposalsRequest_pagination = md_QueryProposalsRequest.Fields().ByName("pagination")
+	md_QueryProposalRequest = File_cosmos_gov_v1_query_proto.Messages().ByName("QueryProposalRequest")
+	fd_QueryProposalRequest_proposal_id = md_QueryProposalRequest.Fields().ByName("proposal_id")
 }
 
-var _ protoreflect.Message = (*fastReflection_QueryProposalsRequest)(nil)
+var _ protoreflect.Message = (*fastReflection_QueryProposalRequest)(nil)
 
-type fastReflection_QueryProposalsRequest QueryProposalsRequest
+type fastReflection_QueryProposalRequest QueryProposalRequest
 
-func (x *QueryProposalsRequest) ProtoReflect() protoreflect.Message {
-	return (*fastReflection_QueryProposalsRequest)(x)
+func (x *QueryProposalRequest) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_QueryProposalRequest)(x)
 }
 
-func (x *QueryProposalsRequest) slowProtoReflect() protoreflect.Message {
+func (x *QueryProposalRequest) slowProtoReflect() protoreflect.Message {
 	mi := &file_cosmos_gov_v1_query_proto_msgTypes[4]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1667,43 +1833,43 @@ func (x *QueryProposalsRequest) slowProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-var _fastReflection_QueryProposalsRequest_messageType fastReflection_QueryProposalsRequest_messageType
-var _ protoreflect.MessageType = fastReflection_QueryProposalsRequest_messageType{}
+var _fastReflection_QueryProposalRequest_messageType fastReflection_QueryProposalRequest_messageType
+var _ protoreflect.MessageType = fastReflection_QueryProposalRequest_messageType{}
 
-type fastReflection_QueryProposalsRequest_messageType struct{}
+type fastReflection_QueryProposalRequest_messageType struct{}
 
-func (x fastReflection_QueryProposalsRequest_messageType) Zero() protoreflect.Message {
-	return (*fastReflection_QueryProposalsRequest)(nil)
+func (x fastReflection_QueryProposalRequest_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_QueryProposalRequest)(nil)
 }
-func (x fastReflection_QueryProposalsRequest_messageType) New() protoreflect.Message {
-	return new(fastReflection_QueryProposalsRequest)
+func (x fastReflection_QueryProposalRequest_messageType) New() protoreflect.Message {
+	return new(fastReflection_QueryProposalRequest)
 }
-func (x fastReflection_QueryProposalsRequest_messageType) Descriptor() protoreflect.MessageDescriptor {
-	return md_QueryProposalsRequest
+func (x fastReflection_QueryProposalRequest_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryProposalRequest
 }
 
 // Descriptor returns message descriptor, which contains only the protobuf
 // type information for the message.
-func (x *fastReflection_QueryProposalsRequest) Descriptor() protoreflect.MessageDescriptor {
-	return md_QueryProposalsRequest
+func (x *fastReflection_QueryProposalRequest) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryProposalRequest
 }
 
 // Type returns the message type, which encapsulates both Go and protobuf
 // type information. If the Go type information is not needed,
 // it is recommended that the message descriptor be used instead.
-func (x *fastReflection_QueryProposalsRequest) Type() protoreflect.MessageType {
-	return _fastReflection_QueryProposalsRequest_messageType
+func (x *fastReflection_QueryProposalRequest) Type() protoreflect.MessageType {
+	return _fastReflection_QueryProposalRequest_messageType
 }
 
 // New returns a newly allocated and mutable empty message.
-func (x *fastReflection_QueryProposalsRequest) New() protoreflect.Message {
-	return new(fastReflection_QueryProposalsRequest)
+func (x *fastReflection_QueryProposalRequest) New() protoreflect.Message {
+	return new(fastReflection_QueryProposalRequest)
 }
 
 // Interface unwraps the message reflection interface and
 // returns the underlying ProtoMessage interface.
-func (x *fastReflection_QueryProposalsRequest) Interface() protoreflect.ProtoMessage {
-	return (*QueryProposalsRequest)(x)
+func (x *fastReflection_QueryProposalRequest) Interface() protoreflect.ProtoMessage {
+	return (*QueryProposalRequest)(x)
 }
 
 // Range iterates over every populated field in an undefined order,
@@ -1711,28 +1877,10 @@ func (x *fastReflection_QueryProposalsRequest) Interface() protoreflect.ProtoMes
 // Range returns immediately if f returns false.
 // While iterating, mutating operations may only be performed
 // on the current field descriptor.
-func (x *fastReflection_QueryProposalsRequest) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
-	if x.ProposalStatus != 0 {
-		value := protoreflect.ValueOfEnum((protoreflect.EnumNumber)(x.ProposalStatus))
-		if !f(fd_QueryProposalsRequest_proposal_status, value) {
-			return
-		}
-	}
-	if x.Voter != "" {
-		value := protoreflect.ValueOfString(x.Voter)
-		if !f(fd_QueryProposalsRequest_voter, value) {
-			return
-		}
-	}
-	if x.Depositor != "" {
-		value := protoreflect.ValueOfString(x.Depositor)
-		if !f(fd_QueryProposalsRequest_depositor, value) {
-			return
-		}
-	}
-	if x.Pagination != nil {
-		value := protoreflect.ValueOfMessage(x.Pagination.ProtoReflect())
-		if !f(fd_QueryProposalsRequest_pagination, value) {
+func (x *fastReflection_QueryProposalRequest) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.ProposalId != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.ProposalId)
+		if !f(fd_QueryProposalRequest_proposal_id, value) {
 			return
 		}
 	}
@@ -1749,21 +1897,15 @@ func (x *fastReflection_QueryProposalsRequest) Range(f func(protoreflect.FieldDe
 // In other cases (aside from the nullable cases above),
 // a proto3 scalar field is populated if it contains a non-zero value, and
 // a repeated field is populated if it is non-empty.
-func (x *fastReflection_QueryProposalsRequest) Has(fd protoreflect.FieldDescriptor) bool {
+func (x *fastReflection_QueryProposalRequest) Has(fd protoreflect.FieldDescriptor) bool {
 	switch fd.FullName() {
-	case "cosmos.gov.v1.QueryProposalsRequest.proposal_status":
-		return x.ProposalStatus != 0
-	case "cosmos.gov.v1.QueryProposalsRequest.voter":
-		return x.Voter != ""
-	case "cosmos.gov.v1.QueryProposalsRequest.depositor":
-		return x.Depositor != ""
-	case "cosmos.gov.v1.QueryProposalsRequest.pagination":
-		return x.Pagination != nil
+	case "cosmos.gov.v1.QueryProposalRequest.proposal_id":
+		return x.ProposalId != uint64(0)
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.gov.v1.QueryProposalsRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.gov.v1.QueryProposalRequest"))
 		}
-		panic(fmt.Errorf("message cosmos.gov.v1.QueryProposalsRequest does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.gov.v1.QueryProposalRequest does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -1773,21 +1915,15 @@ func (x *fastReflection_QueryProposalsRequest) Has(fd protoreflect.FieldDescript
 // associated with the given field number.
 //
 // Clear is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryProposalsRequest) Clear(fd protoreflect.FieldDescriptor) {
+func (x *fastReflection_QueryProposalRequest) Clear(fd protoreflect.FieldDescriptor) {
 	switch fd.FullName() {
-	case "cosmos.gov.v1.QueryProposalsRequest.proposal_status":
-		x.ProposalStatus = 0
-	case "cosmos.gov.v1.QueryProposalsRequest.voter":
-		x.Voter = ""
-	case "cosmos.gov.v1.QueryProposalsRequest.depositor":
-		x.Depositor = ""
-	case "cosmos.gov.v1.QueryProposalsRequest.pagination":
-		x.Pagination = nil
+	case "cosmos.gov.v1.QueryProposalRequest.proposal_id":
+		x.ProposalId = uint64(0)
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.gov.v1.QueryProposalsRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.gov.v1.QueryProposalRequest"))
 		}
-		panic(fmt.Errorf("message cosmos.gov.v1.QueryProposalsRequest does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.gov.v1.QueryProposalRequest does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -1797,25 +1933,16 @@ func (x *fastReflection_QueryProposalsRequest) Clear(fd protoreflect.FieldDescri
 // the default value of a bytes scalar is guaranteed to be a copy.
 // For unpopulated composite types, it returns an empty, read-only view
 // of the value; to obtain a mutable reference, use Mutable.
-func (x *fastReflection_QueryProposalsRequest) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_QueryProposalRequest) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
 	switch descriptor.FullName() {
-	case "cosmos.gov.v1.QueryProposalsRequest.proposal_status":
-		value := x.ProposalStatus
-		return protoreflect.ValueOfEnum((protoreflect.EnumNumber)(value))
-	case "cosmos.gov.v1.QueryProposalsRequest.voter":
-		value := x.Voter
-		return protoreflect.ValueOfString(value)
-	case "cosmos.gov.v1.QueryProposalsRequest.depositor":
-		value := x.Depositor
-		return protoreflect.ValueOfString(value)
-	case "cosmos.gov.v1.QueryProposalsRequest.pagination":
-		value := x.Pagination
-		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "cosmos.gov.v1.QueryProposalRequest.proposal_id":
+		value := x.ProposalId
+		return protoreflect.ValueOfUint64(value)
 	default:
 		if descriptor.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.gov.v1.QueryProposalsRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.gov.v1.QueryProposalRequest"))
 		}
-		panic(fmt.Errorf("message cosmos.gov.v1.QueryProposalsRequest does not contain field %s", descriptor.FullName()))
+		panic(fmt.Errorf("message cosmos.gov.v1.QueryProposalRequest does not contain field %s", descriptor.FullName()))
 	}
 }
 
@@ -1829,21 +1956,15 @@ func (x *fastReflection_QueryProposalsRequest) Get(descriptor protoreflect.Field
 // empty, read-only value, then it panics.
 //
 // Set is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryProposalsRequest) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+func (x *fastReflection_QueryProposalRequest) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
 	switch fd.FullName() {
-	case "cosmos.gov.v1.QueryProposalsRequest.proposal_status":
-		x.ProposalStatus = (ProposalStatus)(value.Enum())
-	case "cosmos.gov.v1.QueryProposalsRequest.voter":
-		x.Voter = value.Interface().(string)
-	case "cosmos.gov.v1.QueryProposalsRequest.depositor":
-		x.Depositor = value.Interface().(string)
-	case "cosmos.gov.v1.QueryProposalsRequest.pagination":
-		x.Pagination = value.Message().Interface().(*v1beta1.PageRequest)
+	case "cosmos.gov.v1.QueryProposalRequest.proposal_id":
+		x.ProposalId = value.Uint()
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.gov.v1.QueryProposalsRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.gov.v1.QueryProposalRequest"))
 		}
-		panic(fmt.Errorf("message cosmos.gov.v1.QueryProposalsRequest does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.gov.v1.QueryProposalRequest does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -1857,56 +1978,40 @@ func (x *fastReflection_QueryProposalsRequest) Set(fd protoreflect.FieldDescript
 // It panics if the field does not contain a composite type.
 //
 // Mutable is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryProposalsRequest) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_QueryProposalRequest) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "cosmos.gov.v1.QueryProposalsRequest.pagination":
-		if x.Pagination == nil {
-			x.Pagination = new(v1beta1.PageRequest)
-		}
-		return protoreflect.ValueOfMessage(x.Pagination.ProtoReflect())
-	case "cosmos.gov.v1.QueryProposalsRequest.proposal_status":
-		panic(fmt.Errorf("field proposal_status of message cosmos.gov.v1.QueryProposalsRequest is not mutable"))
-	case "cosmos.gov.v1.QueryProposalsRequest.voter":
-		panic(fmt.Errorf("field voter of message cosmos.gov.v1.QueryProposalsRequest is not mutable"))
-	case "cosmos.gov.v1.QueryProposalsRequest.depositor":
-		panic(fmt.Errorf("field depositor of message cosmos.gov.v1.QueryProposalsRequest is not mutable"))
+	case "cosmos.gov.v1.QueryProposalRequest.proposal_id":
+		panic(fmt.Errorf("field proposal_id of message cosmos.gov.v1.QueryProposalRequest is not mutable"))
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.gov.v1.QueryProposalsRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.gov.v1.QueryProposalRequest"))
 		}
-		panic(fmt.Errorf("message cosmos.gov.v1.QueryProposalsRequest does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.gov.v1.QueryProposalRequest does not contain field %s", fd.FullName()))
 	}
 }
 
 // NewField returns a new value that is assignable to the field
 // for the given descriptor. For scalars, this returns the default value.
 // For lists, maps, and messages, this returns a new, empty, mutable value.
-func (x *fastReflection_QueryProposalsRequest) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_QueryProposalRequest) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "cosmos.gov.v1.QueryProposalsRequest.proposal_status":
-		return protoreflect.ValueOfEnum(0)
-	case "cosmos.gov.v1.QueryProposalsRequest.voter":
-		return protoreflect.ValueOfString("")
-	case "cosmos.gov.v1.QueryProposalsRequest.depositor":
-		return protoreflect.ValueOfString("")
-	case "cosmos.gov.v1.QueryProposalsRequest.pagination":
-		m := new(v1beta1.PageRequest)
-		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case "cosmos.gov.v1.QueryProposalRequest.proposal_id":
+		return protoreflect.ValueOfUint64(uint64(0))
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.gov.v1.QueryProposalsRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.gov.v1.QueryProposalRequest"))
 		}
-		panic(fmt.Errorf("message cosmos.gov.v1.QueryProposalsRequest does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.gov.v1.QueryProposalRequest does not contain field %s", fd.FullName()))
 	}
 }
 
 // WhichOneof reports which field within the oneof is populated,
 // returning nil if none are populated.
 // It panics if the oneof descriptor does not belong to this message.
-func (x *fastReflection_QueryProposalsRequest) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+func (x *fastReflection_QueryProposalRequest) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
 	switch d.FullName() {
 	default:
-		panic(fmt.Errorf("%s is not a oneof field in cosmos.gov.v1.QueryProposalsRequest", d.FullName()))
+		panic(fmt.Errorf("%s is not a oneof field in cosmos.gov.v1.QueryProposalRequest", d.FullName()))
 	}
 	panic("unreachable")
 }
@@ -1914,7 +2019,7 @@ func (x *fastReflection_QueryProposalsRequest) WhichOneof(d protoreflect.OneofDe
 // GetUnknown retrieves the entire list of unknown fields.
 // The caller may only mutate the contents of the RawFields
 // if the mutated bytes are stored back into the message with SetUnknown.
-func (x *fastReflection_QueryProposalsRequest) GetUnknown() protoreflect.RawFields {
+func (x *fastReflection_QueryProposalRequest) GetUnknown() protoreflect.RawFields {
 	return x.unknownFields
 }
 
@@ -1925,7 +2030,7 @@ func (x *fastReflection_QueryProposalsRequest) GetUnknown() protoreflect.RawFiel
 // An empty RawFields may be passed to clear the fields.
 //
 // SetUnknown is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryProposalsRequest) SetUnknown(fields protoreflect.RawFields) {
+func (x *fastReflection_QueryProposalRequest) SetUnknown(fields protoreflect.RawFields) {
 	x.unknownFields = fields
 }
 
@@ -1937,7 +2042,7 @@ func (x *fastReflection_QueryProposalsRequest) SetUnknown(fields protoreflect.Ra
 // message type, but the details are implementation dependent.
 // Validity is not part of the protobuf data model, and may not
 // be preserved in marshaling or other operations.
-func (x *fastReflection_QueryProposalsRequest) IsValid() bool {
+func (x *fastReflection_QueryProposalRequest) IsValid() bool {
 	return x != nil
 }
 
@@ -1947,9 +2052,9 @@ func (x *fastReflection_QueryProposalsRequest) IsValid() bool {
 // The returned methods type is identical to
 // "google.golang.org/protobuf/runtime/protoiface".Methods.
 // Consult the protoiface package documentation for details.
-func (x *fastReflection_QueryProposalsRequest) ProtoMethods() *protoiface.Methods {
+func (x *fastReflection_QueryProposalRequest) ProtoMethods() *protoiface.Methods {
 	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
-		x := input.Message.Interface().(*QueryProposalsRequest)
+		x := input.Message.Interface().(*QueryProposalRequest)
 		if x == nil {
 			return protoiface.SizeOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -1961,20 +2066,8 @@ func (x *fastReflection_QueryProposalsRequest) ProtoMethods() *protoiface.Method
 		var n int
 		var l int
 		_ = l
-		if x.ProposalStatus != 0 {
-			n += 1 + runtime.Sov(uint64(x.ProposalStatus))
-		}
-		l = len(x.Voter)
-		if l > 0 {
-			n += 1 + l + runtime.Sov(uint64(l))
-		}
-		l = len(x.Depositor)
-		if l > 0 {
-			n += 1 + l + runtime.Sov(uint64(l))
-		}
-		if x.Pagination != nil {
-			l = options.Size(x.Pagination)
-			n += 1 + l + runtime.Sov(uint64(l))
+		if x.ProposalId != 0 {
+			n += 1 + runtime.Sov(uint64(x.ProposalId))
 		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
@@ -1986,7 +2079,7 @@ func (x *fastReflection_QueryProposalsRequest) ProtoMethods() *protoiface.Method
 	}
 
 	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
-		x := input.Message.Interface().(*QueryProposalsRequest)
+		x := input.Message.Interface().(*QueryProposalRequest)
 		if x == nil {
 			return protoiface.MarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -2005,36 +2098,8 @@ func (x *fastReflection_QueryProposalsRequest) ProtoMethods() *protoiface.Method
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
-		if x.Pagination != nil {
-			encoded, err := options.Marshal(x.Pagination)
-			if err != nil {
-				return protoiface.MarshalOutput{
-					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
-					Buf:               input.Buf,
-				}, err
-			}
-			i -= len(encoded)
-			copy(dAtA[i:], encoded)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
-			i--
-			dAtA[i] = 0x22
-		}
-		if len(x.Depositor) > 0 {
-			i -= len(x.Depositor)
-			copy(dAtA[i:], x.Depositor)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Depositor)))
-			i--
-			dAtA[i] = 0x1a
-		}
-		if len(x.Voter) > 0 {
-			i -= len(x.Voter)
-			copy(dAtA[i:], x.Voter)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Voter)))
-			i--
-			dAtA[i] = 0x12
-		}
-		if x.ProposalStatus != 0 {
-			i = runtime.EncodeVarint(dAtA, i, uint64(x.ProposalStatus))
+		if x.ProposalId != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.ProposalId))
 			i--
 			dAtA[i] = 0x8
 		}
@@ -2049,7 +2114,7 @@ func (x *fastReflection_QueryProposalsRequest) ProtoMethods() *protoiface.Method
 		}, nil
 	}
 	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
-		x := input.Message.Interface().(*QueryProposalsRequest)
+		x := input.Message.Interface().(*QueryProposalRequest)
 		if x == nil {
 			return protoiface.UnmarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -2081,100 +2146,17 @@ func (x *fastReflection_QueryProposalsRequest) ProtoMethods() *protoiface.Method
 			fieldNum := int32(wire >> 3)
 			wireType := int(wire & 0x7)
 			if wireType == 4 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryProposalsRequest: wiretype end group for non-group")
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryProposalRequest: wiretype end group for non-group")
 			}
 			if fieldNum <= 0 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryProposalsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryProposalRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 			}
 			switch fieldNum {
 			case 1:
 				if wireType != 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ProposalStatus", wireType)
-				}
-				x.ProposalStatus = 0
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
-					}
-					if iNdEx >= l {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					x.ProposalStatus |= ProposalStatus(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-			case 2:
-				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Voter", wireType)
-				}
-				var stringLen uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
-					}
-					if iNdEx >= l {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					stringLen |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				intStringLen := int(stringLen)
-				if intStringLen < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				postIndex := iNdEx + intStringLen
-				if postIndex < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				if postIndex > l {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-				}
-				x.Voter = string(dAtA[iNdEx:postIndex])
-				iNdEx = postIndex
-			case 3:
-				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Depositor", wireType)
-				}
-				var stringLen uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
-					}
-					if iNdEx >= l {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					stringLen |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				intStringLen := int(stringLen)
-				if intStringLen < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				postIndex := iNdEx + intStringLen
-				if postIndex < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				if postIndex > l {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-				}
-				x.Depositor = string(dAtA[iNdEx:postIndex])
-				iNdEx = postIndex
-			case 4:
-				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ProposalId", wireType)
 				}
-				var msglen int
+				x.ProposalId = 0
 				for shift := uint(0); ; shift += 7 {
 					if shift >= 64 {
 						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
@@ -2184,28 +2166,11 @@ func (x *fastReflection_QueryProposalsRequest) ProtoMethods() *protoiface.Method
 					}
 					b := dAtA[iNdEx]
 					iNdEx++
-					msglen |= int(b&0x7F) << shift
+					x.ProposalId |= uint64(b&0x7F) << shift
 					if b < 0x80 {
 						break
 					}
 				}
-				if msglen < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				postIndex := iNdEx + msglen
-				if postIndex < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				if postIndex > l {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-				}
-				if x.Pagination == nil {
-					x.Pagination = &v1beta1.PageRequest{}
-				}
-				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Pagination); err != nil {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
-				}
-				iNdEx = postIndex
 			default:
 				iNdEx = preIndex
 				skippy, err := runtime.Skip(dAtA[iNdEx:])
@@ -2241,79 +2206,26 @@ func (x *fastReflection_QueryProposalsRequest) ProtoMethods() *protoiface.Method
 	}
 }
 
-var _ protoreflect.List = (*_QueryProposalsResponse_1_list)(nil)
-
-type _QueryProposalsResponse_1_list struct {
-	list *[]*Proposal
-}
-
-func (x *_QueryProposalsResponse_1_list) Len() int {
-	if x.list == nil {
-		return 0
-	}
-	return len(*x.list)
-}
-
-func (x *_QueryProposalsResponse_1_list) Get(i int) protoreflect.Value {
-	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
-}
-
-func (x *_QueryProposalsResponse_1_list) Set(i int, value protoreflect.Value) {
-	valueUnwrapped := value.Message()
-	concreteValue := valueUnwrapped.Interface().(*Proposal)
-	(*x.list)[i] = concreteValue
-}
-
-func (x *_QueryProposalsResponse_1_list) Append(value protoreflect.Value) {
-	valueUnwrapped := value.Message()
-	concreteValue := valueUnwrapped.Interface().(*Proposal)
-	*x.list = append(*x.list, concreteValue)
-}
-
-func (x *_QueryProposalsResponse_1_list) AppendMutable() protoreflect.Value {
-	v := new(Proposal)
-	*x.list = append(*x.list, v)
-	return protoreflect.ValueOfMessage(v.ProtoReflect())
-}
-
-func (x *_QueryProposalsResponse_1_list) Truncate(n int) {
-	for i := n; i < len(*x.list); i++ {
-		(*x.list)[i] = nil
-	}
-	*x.list = (*x.list)[:n]
-}
-
-func (x *_QueryProposalsResponse_1_list) NewElement() protoreflect.Value {
-	v := new(Proposal)
-	return protoreflect.ValueOfMessage(v.ProtoReflect())
-}
-
-func (x *_QueryProposalsResponse_1_list) IsValid() bool {
-	return x.list != nil
-}
-
 var (
-	md_QueryProposalsResponse            protoreflect.MessageDescriptor
-	fd_QueryProposalsResponse_proposals  protoreflect.FieldDescriptor
-	fd_QueryProposalsResponse_pagination protoreflect.FieldDescriptor
+	md_QueryProposalResponse          protoreflect.MessageDescriptor
+	fd_QueryProposalResponse_proposal protoreflect.FieldDescriptor
 )
 
 func init() {
 	file_cosmos_gov_v1_query_proto_init()
-	md_QueryProposalsResponse = File_cosmos_gov_v1_query_proto.Messages().ByName("QueryProposalsResponse")
-	fd_QueryProposalsResponse_proposals = md_QueryProposalsResponse.Fields().ByName("proposals")
-	fd_QueryProposalsResponse_pagination = md_QueryProposalsResponse.Fields().ByName("pagination")
+	md_QueryProposalResponse = File_cosmos_gov_v1_query_proto.Messages().ByName("QueryProposalResponse")
+	fd_QueryProposalResponse_proposal = md_QueryProposalResponse.Fields().ByName("proposal")
 }
 
-var _ protoreflect.Message = (*fastReflection_QueryProposalsResponse)(nil)
+var _ protoreflect.Message = (*fastReflection_QueryProposalResponse)(nil)
 
-type fastReflection_QueryProposalsResponse QueryProposalsResponse
+type fastReflection_QueryProposalResponse QueryProposalResponse
 
-func (x *QueryProposalsResponse) ProtoReflect() protoreflect.Message {
-	return (*fastReflection_QueryProposalsResponse)(x)
+func (x *QueryProposalResponse) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_QueryProposalResponse)(x)
 }
 
-func (x *QueryProposalsResponse) slowProtoReflect() protoreflect.Message {
+func (x *QueryProposalResponse) slowProtoReflect() protoreflect.Message {
 	mi := &file_cosmos_gov_v1_query_proto_msgTypes[5]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2325,43 +2237,43 @@ func (x *QueryProposalsResponse) slowProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-var _fastReflection_QueryProposalsResponse_messageType fastReflection_QueryProposalsResponse_messageType
-var _ protoreflect.MessageType = fastReflection_QueryProposalsResponse_messageType{}
+var _fastReflection_QueryProposalResponse_messageType fastReflection_QueryProposalResponse_messageType
+var _ protoreflect.MessageType = fastReflection_QueryProposalResponse_messageType{}
 
-type fastReflection_QueryProposalsResponse_messageType struct{}
+type fastReflection_QueryProposalResponse_messageType struct{}
 
-func (x fastReflection_QueryProposalsResponse_messageType) Zero() protoreflect.Message {
-	return (*fastReflection_QueryProposalsResponse)(nil)
+func (x fastReflection_QueryProposalResponse_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_QueryProposalResponse)(nil)
 }
-func (x fastReflection_QueryProposalsResponse_messageType) New() protoreflect.Message {
-	return new(fastReflection_QueryProposalsResponse)
+func (x fastReflection_QueryProposalResponse_messageType) New() protoreflect.Message {
+	return new(fastReflection_QueryProposalResponse)
 }
-func (x fastReflection_QueryProposalsResponse_messageType) Descriptor() protoreflect.MessageDescriptor {
-	return md_QueryProposalsResponse
+func (x fastReflection_QueryProposalResponse_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryProposalResponse
 }
 
 // Descriptor returns message descriptor, which contains only the protobuf
 // type information for the message.
-func (x *fastReflection_QueryProposalsResponse) Descriptor() protoreflect.MessageDescriptor {
-	return md_QueryProposalsResponse
+func (x *fastReflection_QueryProposalResponse) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryProposalResponse
 }
 
 // Type returns the message type, which encapsulates both Go and protobuf
 // type information. If the Go type information is not needed,
 // it is recommended that the message descriptor be used instead.
-func (x *fastReflection_QueryProposalsResponse) Type() protoreflect.MessageType {
-	return _fastReflection_QueryProposalsResponse_messageType
+func (x *fastReflection_QueryProposalResponse) Type() protoreflect.MessageType {
+	return _fastReflection_QueryProposalResponse_messageType
 }
 
 // New returns a newly allocated and mutable empty message.
-func (x *fastReflection_QueryProposalsResponse) New() protoreflect.Message {
-	return new(fastReflection_QueryProposalsResponse)
+func (x *fastReflection_QueryProposalResponse) New() protoreflect.Message {
+	return new(fastReflection_QueryProposalResponse)
 }
 
 // Interface unwraps the message reflection interface and
 // returns the underlying ProtoMessage interface.
-func (x *fastReflection_QueryProposalsResponse) Interface() protoreflect.ProtoMessage {
-	return (*QueryProposalsResponse)(x)
+func (x *fastReflection_QueryProposalResponse) Interface() protoreflect.ProtoMessage {
+	return (*QueryProposalResponse)(x)
 }
 
 // Range iterates over every populated field in an undefined order,
@@ -2369,16 +2281,10 @@ func (x *fastReflection_QueryProposalsResponse) Interface() protoreflect.ProtoMe
 // Range returns immediately if f returns false.
 // While iterating, mutating operations may only be performed
 // on the current field descriptor.
-func (x *fastReflection_QueryProposalsResponse) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
-	if len(x.Proposals) != 0 {
-		value := protoreflect.ValueOfList(&_QueryProposalsResponse_1_list{list: &x.Proposals})
-		if !f(fd_QueryProposalsResponse_proposals, value) {
-			return
-		}
-	}
-	if x.Pagination != nil {
-		value := protoreflect.ValueOfMessage(x.Pagination.ProtoReflect())
-		if !f(fd_QueryProposalsResponse_pagination, value) {
+func (x *fastReflection_QueryProposalResponse) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Proposal != nil {
+		value := protoreflect.ValueOfMessage(x.Proposal.ProtoReflect())
+		if !f(fd_QueryProposalResponse_proposal, value) {
 			return
 		}
 	}
@@ -2395,17 +2301,15 @@ func (x *fastReflection_QueryProposalsResponse) Range(f func(protoreflect.FieldD
 // In other cases (aside from the nullable cases above),
 // a proto3 scalar field is populated if it contains a non-zero value, and
 // a repeated field is populated if it is non-empty.
-func (x *fastReflection_QueryProposalsResponse) Has(fd protoreflect.FieldDescriptor) bool {
+func (x *fastReflection_QueryProposalResponse) Has(fd protoreflect.FieldDescriptor) bool {
 	switch fd.FullName() {
-	case "cosmos.gov.v1.QueryProposalsResponse.proposals":
-		return len(x.Proposals) != 0
-	case "cosmos.gov.v1.QueryProposalsResponse.pagination":
-		return x.Pagination != nil
+	case "cosmos.gov.v1.QueryProposalResponse.proposal":
+		return x.Proposal != nil
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.gov.v1.QueryProposalsResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.gov.v1.QueryProposalResponse"))
 		}
-		panic(fmt.Errorf("message cosmos.gov.v1.QueryProposalsResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.gov.v1.QueryProposalResponse does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -2415,17 +2319,15 @@ func (x *fastReflection_QueryProposalsResponse) Has(fd protoreflect.FieldDescrip
 // associated with the given field number.
 //
 // Clear is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryProposalsResponse) Clear(fd protoreflect.FieldDescriptor) {
+func (x *fastReflection_QueryProposalResponse) Clear(fd protoreflect.FieldDescriptor) {
 	switch fd.FullName() {
-	case "cosmos.gov.v1.QueryProposalsResponse.proposals":
-		x.Proposals = nil
-	case "cosmos.gov.v1.QueryProposalsResponse.pagination":
-		x.Pagination = nil
+	case "cosmos.gov.v1.QueryProposalResponse.proposal":
+		x.Proposal = nil
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.gov.v1.QueryProposalsResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.gov.v1.QueryProposalResponse"))
 		}
-		panic(fmt.Errorf("message cosmos.gov.v1.QueryProposalsResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.gov.v1.QueryProposalResponse does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -2435,22 +2337,16 @@ func (x *fastReflection_QueryProposalsResponse) Clear(fd protoreflect.FieldDescr
 // the default value of a bytes scalar is guaranteed to be a copy.
 // For unpopulated composite types, it returns an empty, read-only view
 // of the value; to obtain a mutable reference, use Mutable.
-func (x *fastReflection_QueryProposalsResponse) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_QueryProposalResponse) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
 	switch descriptor.FullName() {
-	case "cosmos.gov.v1.QueryProposalsResponse.proposals":
-		if len(x.Proposals) == 0 {
-			return protoreflect.ValueOfList(&_QueryProposalsResponse_1_list{})
-		}
-		listValue := &_QueryProposalsResponse_1_list{list: &x.Proposals}
-		return protoreflect.ValueOfList(listValue)
-	case "cosmos.gov.v1.QueryProposalsResponse.pagination":
-		value := x.Pagination
+	case "cosmos.gov.v1.QueryProposalResponse.proposal":
+		value := x.Proposal
 		return protoreflect.ValueOfMessage(value.ProtoReflect())
 	default:
 		if descriptor.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.gov.v1.QueryProposalsResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.gov.v1.QueryProposalResponse"))
 		}
-		panic(fmt.Errorf("message cosmos.gov.v1.QueryProposalsResponse does not contain field %s", descriptor.FullName()))
+		panic(fmt.Errorf("message cosmos.gov.v1.QueryProposalResponse does not contain field %s", descriptor.FullName()))
 	}
 }
 
@@ -2464,19 +2360,15 @@ func (x *fastReflection_QueryProposalsResponse) Get(descriptor protoreflect.Fiel
 // empty, read-only value, then it panics.
 //
 // Set is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryProposalsResponse) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+func (x *fastReflection_QueryProposalResponse) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
 	switch fd.FullName() {
-	case "cosmos.gov.v1.QueryProposalsResponse.proposals":
-		lv := value.List()
-		clv := lv.(*_QueryProposalsResponse_1_list)
-		x.Proposals = *clv.list
-	case "cosmos.gov.v1.QueryProposalsResponse.pagination":
-		x.Pagination = value.Message().Interface().(*v1beta1.PageResponse)
+	case "cosmos.gov.v1.QueryProposalResponse.proposal":
+		x.Proposal = value.Message().Interface().(*Proposal)
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.gov.v1.QueryProposalsResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.gov.v1.QueryProposalResponse"))
 		}
-		panic(fmt.Errorf("message cosmos.gov.v1.QueryProposalsResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.gov.v1.QueryProposalResponse does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -2490,53 +2382,44 @@ func (x *fastReflection_QueryProposalsResponse) Set(fd protoreflect.FieldDescrip
 // It panics if the field does not contain a composite type.
 //
 // Mutable is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryProposalsResponse) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_QueryProposalResponse) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "cosmos.gov.v1.QueryProposalsResponse.proposals":
-		if x.Proposals == nil {
-			x.Proposals = []*Proposal{}
-		}
-		value := &_QueryProposalsResponse_1_list{list: &x.Proposals}
-		return protoreflect.ValueOfList(value)
-	case "cosmos.gov.v1.QueryProposalsResponse.pagination":
-		if x.Pagination == nil {
-			x.Pagination = new(v1beta1.PageResponse)
+	case "cosmos.gov.v1.QueryProposalResponse.proposal":
+		if x.Proposal == nil {
+			x.Proposal = new(Proposal)
 		}
-		return protoreflect.ValueOfMessage(x.Pagination.ProtoReflect())
+		return protoreflect.ValueOfMessage(x.Proposal.ProtoReflect())
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.gov.v1.QueryProposalsResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.gov.v1.QueryProposalResponse"))
 		}
-		panic(fmt.Errorf("message cosmos.gov.v1.QueryProposalsResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.gov.v1.QueryProposalResponse does not contain field %s", fd.FullName()))
 	}
 }
 
 // NewField returns a new value that is assignable to the field
 // for the given descriptor. For scalars, this returns the default value.
 // For lists, maps, and messages, this returns a new, empty, mutable value.
-func (x *fastReflection_QueryProposalsResponse) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_QueryProposalResponse) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "cosmos.gov.v1.QueryProposalsResponse.proposals":
-		list := []*Proposal{}
-		return protoreflect.ValueOfList(&_QueryProposalsResponse_1_list{list: &list})
-	case "cosmos.gov.v1.QueryProposalsResponse.pagination":
-		m := new(v1beta1.PageResponse)
+	case "cosmos.gov.v1.QueryProposalResponse.proposal":
+		m := new(Proposal)
 		return protoreflect.ValueOfMessage(m.ProtoReflect())
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.gov.v1.QueryProposalsResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.gov.v1.QueryProposalResponse"))
 		}
-		panic(fmt.Errorf("message cosmos.gov.v1.QueryProposalsResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.gov.v1.QueryProposalResponse does not contain field %s", fd.FullName()))
 	}
 }
 
 // WhichOneof reports which field within the oneof is populated,
 // returning nil if none are populated.
 // It panics if the oneof descriptor does not belong to this message.
-func (x *fastReflection_QueryProposalsResponse) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+func (x *fastReflection_QueryProposalResponse) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
 	switch d.FullName() {
 	default:
-		panic(fmt.Errorf("%s is not a oneof field in cosmos.gov.v1.QueryProposalsResponse", d.FullName()))
+		panic(fmt.Errorf("%s is not a oneof field in cosmos.gov.v1.QueryProposalResponse", d.FullName()))
 	}
 	panic("unreachable")
 }
@@ -2544,7 +2427,7 @@ func (x *fastReflection_QueryProposalsResponse) WhichOneof(d protoreflect.OneofD
 // GetUnknown retrieves the entire list of unknown fields.
 // The caller may only mutate the contents of the RawFields
 // if the mutated bytes are stored back into the message with SetUnknown.
-func (x *fastReflection_QueryProposalsResponse) GetUnknown() protoreflect.RawFields {
+func (x *fastReflection_QueryProposalResponse) GetUnknown() protoreflect.RawFields {
 	return x.unknownFields
 }
 
@@ -2555,7 +2438,7 @@ func (x *fastReflection_QueryProposalsResponse) GetUnknown() protoreflect.RawFie
 // An empty RawFields may be passed to clear the fields.
 //
 // SetUnknown is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryProposalsResponse) SetUnknown(fields protoreflect.RawFields) {
+func (x *fastReflection_QueryProposalResponse) SetUnknown(fields protoreflect.RawFields) {
 	x.unknownFields = fields
 }
 
@@ -2567,7 +2450,7 @@ func (x *fastReflection_QueryProposalsResponse) SetUnknown(fields protoreflect.R
 // message type, but the details are implementation dependent.
 // Validity is not part of the protobuf data model, and may not
 // be preserved in marshaling or other operations.
-func (x *fastReflection_QueryProposalsResponse) IsValid() bool {
+func (x *fastReflection_QueryProposalResponse) IsValid() bool {
 	return x != nil
 }
 
@@ -2577,9 +2460,9 @@ func (x *fastReflection_QueryProposalsResponse) IsValid() bool {
 // The returned methods type is identical to
 // "google.golang.org/protobuf/runtime/protoiface".Methods.
 // Consult the protoiface package documentation for details.
-func (x *fastReflection_QueryProposalsResponse) ProtoMethods() *protoiface.Methods {
+func (x *fastReflection_QueryProposalResponse) ProtoMethods() *protoiface.Methods {
 	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
-		x := input.Message.Interface().(*QueryProposalsResponse)
+		x := input.Message.Interface().(*QueryProposalResponse)
 		if x == nil {
 			return protoiface.SizeOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -2591,14 +2474,8 @@ func (x *fastReflection_QueryProposalsResponse) ProtoMethods() *protoiface.Metho
 		var n int
 		var l int
 		_ = l
-		if len(x.Proposals) > 0 {
-			for _, e := range x.Proposals {
-				l = options.Size(e)
-				n += 1 + l + runtime.Sov(uint64(l))
-			}
-		}
-		if x.Pagination != nil {
-			l = options.Size(x.Pagination)
+		if x.Proposal != nil {
+			l = options.Size(x.Proposal)
 			n += 1 + l + runtime.Sov(uint64(l))
 		}
 		if x.unknownFields != nil {
@@ -2611,7 +2488,7 @@ func (x *fastReflection_QueryProposalsResponse) ProtoMethods() *protoiface.Metho
 	}
 
 	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
-		x := input.Message.Interface().(*QueryProposalsResponse)
+		x := input.Message.Interface().(*QueryProposalResponse)
 		if x == nil {
 			return protoiface.MarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -2630,8 +2507,8 @@ func (x *fastReflection_QueryProposalsResponse) ProtoMethods() *protoiface.Metho
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
-		if x.Pagination != nil {
-			encoded, err := options.Marshal(x.Pagination)
+		if x.Proposal != nil {
+			encoded, err := options.Marshal(x.Proposal)
 			if err != nil {
 				return protoiface.MarshalOutput{
 					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -2642,23 +2519,7 @@ func (x *fastReflection_QueryProposalsResponse) ProtoMethods() *protoiface.Metho
 			copy(dAtA[i:], encoded)
 			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
 			i--
-			dAtA[i] = 0x12
-		}
-		if len(x.Proposals) > 0 {
-			for iNdEx := len(x.Proposals) - 1; iNdEx >= 0; iNdEx-- {
-				encoded, err := options.Marshal(x.Proposals[iNdEx])
-				if err != nil {
-					return protoiface.MarshalOutput{
-						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
-						Buf:               input.Buf,
-					}, err
-				}
-				i -= len(encoded)
-				copy(dAtA[i:], encoded)
-				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
-				i--
-				dAtA[i] = 0xa
-			}
+			dAtA[i] = 0xa
 		}
 		if input.Buf != nil {
 			input.Buf = append(input.Buf, dAtA...)
@@ -2671,7 +2532,7 @@ func (x *fastReflection_QueryProposalsResponse) ProtoMethods() *protoiface.Metho
 		}, nil
 	}
 	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
-		x := input.Message.Interface().(*QueryProposalsResponse)
+		x := input.Message.Interface().(*QueryProposalResponse)
 		if x == nil {
 			return protoiface.UnmarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -2703,49 +2564,15 @@ func (x *fastReflection_QueryProposalsResponse) ProtoMethods() *protoiface.Metho
 			fieldNum := int32(wire >> 3)
 			wireType := int(wire & 0x7)
 			if wireType == 4 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryProposalsResponse: wiretype end group for non-group")
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryProposalResponse: wiretype end group for non-group")
 			}
 			if fieldNum <= 0 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryProposalsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryProposalResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 			}
 			switch fieldNum {
 			case 1:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Proposals", wireType)
-				}
-				var msglen int
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
-					}
-					if iNdEx >= l {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					msglen |= int(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				if msglen < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				postIndex := iNdEx + msglen
-				if postIndex < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				if postIndex > l {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-				}
-				x.Proposals = append(x.Proposals, &Proposal{})
-				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Proposals[len(x.Proposals)-1]); err != nil {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
-				}
-				iNdEx = postIndex
-			case 2:
-				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Proposal", wireType)
 				}
 				var msglen int
 				for shift := uint(0); ; shift += 7 {
@@ -2772,10 +2599,10 @@ func (x *fastReflection_QueryProposalsResponse) ProtoMethods() *protoiface.Metho
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				if x.Pagination == nil {
-					x.Pagination = &v1beta1.PageResponse{}
+				if x.Proposal == nil {
+					x.Proposal = &Proposal{}
 				}
-				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Pagination); err != nil {
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Proposal); err != nil {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
 				}
 				iNdEx = postIndex
@@ -2815,27 +2642,31 @@ func (x *fastReflection_QueryProposalsResponse) ProtoMethods() *protoiface.Metho
 }
 
 var (
-	md_QueryVoteRequest             protoreflect.MessageDescriptor
-	fd_QueryVoteRequest_proposal_id protoreflect.FieldDescriptor
-	fd_QueryVoteRequest_voter       protoreflect.FieldDescriptor
+	md_QueryProposalsRequest                 protoreflect.MessageDescriptor
+	fd_QueryProposalsRequest_proposal_status protoreflect.FieldDescriptor
+	fd_QueryProposalsRequest_voter           protoreflect.FieldDescriptor
+	fd_QueryProposalsRequest_depositor       protoreflect.FieldDescriptor
+	fd_QueryProposalsRequest_pagination      protoreflect.FieldDescriptor
 )
 
 func init() {
 	file_cosmos_gov_v1_query_proto_init()
-	md_QueryVoteRequest = File_cosmos_gov_v1_query_proto.Messages().ByName("QueryVoteRequest")
-	fd_QueryVoteRequest_proposal_id = md_QueryVoteRequest.Fields().ByName("proposal_id")
-	fd_QueryVoteRequest_voter = md_QueryVoteRequest.Fields().ByName("voter")
+	md_QueryProposalsRequest = File_cosmos_gov_v1_query_proto.Messages().ByName("QueryProposalsRequest")
+	fd_QueryProposalsRequest_proposal_status = md_QueryProposalsRequest.Fields().ByName("proposal_status")
+	fd_QueryProposalsRequest_voter = md_QueryProposalsRequest.Fields().ByName("voter")
+	fd_QueryProposalsRequest_depositor = md_QueryProposalsRequest.Fields().ByName("depositor")
+	fd_QueryProposalsRequest_pagination = md_QueryProposalsRequest.Fields().ByName("pagination")
 }
 
-var _ protoreflect.Message = (*fastReflection_QueryVoteRequest)(nil)
+var _ protoreflect.Message = (*fastReflection_QueryProposalsRequest)(nil)
 
-type fastReflection_QueryVoteRequest QueryVoteRequest
+type fastReflection_QueryProposalsRequest QueryProposalsRequest
 
-func (x *QueryVoteRequest) ProtoReflect() protoreflect.Message {
-	return (*fastReflection_QueryVoteRequest)(x)
+func (x *QueryProposalsRequest) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_QueryProposalsRequest)(x)
 }
 
-func (x *QueryVoteRequest) slowProtoReflect() protoreflect.Message {
+func (x *QueryProposalsRequest) slowProtoReflect() protoreflect.Message {
 	mi := &file_cosmos_gov_v1_query_proto_msgTypes[6]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2847,43 +2678,43 @@ func (x *QueryVoteRequest) slowProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-var _fastReflection_QueryVoteRequest_messageType fastReflection_QueryVoteRequest_messageType
-var _ protoreflect.MessageType = fastReflection_QueryVoteRequest_messageType{}
+var _fastReflection_QueryProposalsRequest_messageType fastReflection_QueryProposalsRequest_messageType
+var _ protoreflect.MessageType = fastReflection_QueryProposalsRequest_messageType{}
 
-type fastReflection_QueryVoteRequest_messageType struct{}
+type fastReflection_QueryProposalsRequest_messageType struct{}
 
-func (x fastReflection_QueryVoteRequest_messageType) Zero() protoreflect.Message {
-	return (*fastReflection_QueryVoteRequest)(nil)
+func (x fastReflection_QueryProposalsRequest_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_QueryProposalsRequest)(nil)
 }
-func (x fastReflection_QueryVoteRequest_messageType) New() protoreflect.Message {
-	return new(fastReflection_QueryVoteRequest)
+func (x fastReflection_QueryProposalsRequest_messageType) New() protoreflect.Message {
+	return new(fastReflection_QueryProposalsRequest)
 }
-func (x fastReflection_QueryVoteRequest_messageType) Descriptor() protoreflect.MessageDescriptor {
-	return md_QueryVoteRequest
+func (x fastReflection_QueryProposalsRequest_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryProposalsRequest
 }
 
 // Descriptor returns message descriptor, which contains only the protobuf
 // type information for the message.
-func (x *fastReflection_QueryVoteRequest) Descriptor() protoreflect.MessageDescriptor {
-	return md_QueryVoteRequest
+func (x *fastReflection_QueryProposalsRequest) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryProposalsRequest
 }
 
 // Type returns the message type, which encapsulates both Go and protobuf
 // type information. If the Go type information is not needed,
 // it is recommended that the message descriptor be used instead.
-func (x *fastReflection_QueryVoteRequest) Type() protoreflect.MessageType {
-	return _fastReflection_QueryVoteRequest_messageType
+func (x *fastReflection_QueryProposalsRequest) Type() protoreflect.MessageType {
+	return _fastReflection_QueryProposalsRequest_messageType
 }
 
 // New returns a newly allocated and mutable empty message.
-func (x *fastReflection_QueryVoteRequest) New() protoreflect.Message {
-	return new(fastReflection_QueryVoteRequest)
+func (x *fastReflection_QueryProposalsRequest) New() protoreflect.Message {
+	return new(fastReflection_QueryProposalsRequest)
 }
 
 // Interface unwraps the message reflection interface and
 // returns the underlying ProtoMessage interface.
-func (x *fastReflection_QueryVoteRequest) Interface() protoreflect.ProtoMessage {
-	return (*QueryVoteRequest)(x)
+func (x *fastReflection_QueryProposalsRequest) Interface() protoreflect.ProtoMessage {
+	return (*QueryProposalsRequest)(x)
 }
 
 // Range iterates over every populated field in an undefined order,
@@ -2891,16 +2722,28 @@ func (x *fastReflection_QueryVoteRequest) Interface() protoreflect.ProtoMessage
 // Range returns immediately if f returns false.
 // While iterating, mutating operations may only be performed
 // on the current field descriptor.
-func (x *fastReflection_QueryVoteRequest) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
-	if x.ProposalId != uint64(0) {
-		value := protoreflect.ValueOfUint64(x.ProposalId)
-		if !f(fd_QueryVoteRequest_proposal_id, value) {
+func (x *fastReflection_QueryProposalsRequest) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.ProposalStatus != 0 {
+		value := protoreflect.ValueOfEnum((protoreflect.EnumNumber)(x.ProposalStatus))
+		if !f(fd_QueryProposalsRequest_proposal_status, value) {
 			return
 		}
 	}
 	if x.Voter != "" {
 		value := protoreflect.ValueOfString(x.Voter)
-		if !f(fd_QueryVoteRequest_voter, value) {
+		if !f(fd_QueryProposalsRequest_voter, value) {
+			return
+		}
+	}
+	if x.Depositor != "" {
+		value := protoreflect.ValueOfString(x.Depositor)
+		if !f(fd_QueryProposalsRequest_depositor, value) {
+			return
+		}
+	}
+	if x.Pagination != nil {
+		value := protoreflect.ValueOfMessage(x.Pagination.ProtoReflect())
+		if !f(fd_QueryProposalsRequest_pagination, value) {
 			return
 		}
 	}
@@ -2917,17 +2760,21 @@ func (x *fastReflection_QueryVoteRequest) Range(f func(protoreflect.FieldDescrip
 // In other cases (aside from the nullable cases above),
 // a proto3 scalar field is populated if it contains a non-zero value, and
 // a repeated field is populated if it is non-empty.
-func (x *fastReflection_QueryVoteRequest) Has(fd protoreflect.FieldDescriptor) bool {
+func (x *fastReflection_QueryProposalsRequest) Has(fd protoreflect.FieldDescriptor) bool {
 	switch fd.FullName() {
-	case "cosmos.gov.v1.QueryVoteRequest.proposal_id":
-		return x.ProposalId != uint64(0)
-	case "cosmos.gov.v1.QueryVoteRequest.voter":
+	case "cosmos.gov.v1.QueryProposalsRequest.proposal_status":
+		return x.ProposalStatus != 0
+	case "cosmos.gov.v1.QueryProposalsRequest.voter":
 		return x.Voter != ""
+	case "cosmos.gov.v1.QueryProposalsRequest.depositor":
+		return x.Depositor != ""
+	case "cosmos.gov.v1.QueryProposalsRequest.pagination":
+		return x.Pagination != nil
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.gov.v1.QueryVoteRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.gov.v1.QueryProposalsRequest"))
 		}
-		panic(fmt.Errorf("message cosmos.gov.v1.QueryVoteRequest does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.gov.v1.QueryProposalsRequest does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -2937,17 +2784,21 @@ func (x *fastReflection_QueryVoteRequest) Has(fd protoreflect.FieldDescriptor) b
 // associated with the given field number.
 //
 // Clear is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryVoteRequest) Clear(fd protoreflect.FieldDescriptor) {
+func (x *fastReflection_QueryProposalsRequest) Clear(fd protoreflect.FieldDescriptor) {
 	switch fd.FullName() {
-	case "cosmos.gov.v1.QueryVoteRequest.proposal_id":
-		x.ProposalId = uint64(0)
-	case "cosmos.gov.v1.QueryVoteRequest.voter":
+	case "cosmos.gov.v1.QueryProposalsRequest.proposal_status":
+		x.ProposalStatus = 0
+	case "cosmos.gov.v1.QueryProposalsRequest.voter":
 		x.Voter = ""
+	case "cosmos.gov.v1.QueryProposalsRequest.depositor":
+		x.Depositor = ""
+	case "cosmos.gov.v1.QueryProposalsRequest.pagination":
+		x.Pagination = nil
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.gov.v1.QueryVoteRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.gov.v1.QueryProposalsRequest"))
 		}
-		panic(fmt.Errorf("message cosmos.gov.v1.QueryVoteRequest does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.gov.v1.QueryProposalsRequest does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -2957,19 +2808,25 @@ func (x *fastReflection_QueryVoteRequest) Clear(fd protoreflect.FieldDescriptor)
 // the default value of a bytes scalar is guaranteed to be a copy.
 // For unpopulated composite types, it returns an empty, read-only view
 // of the value; to obtain a mutable reference, use Mutable.
-func (x *fastReflection_QueryVoteRequest) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_QueryProposalsRequest) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
 	switch descriptor.FullName() {
-	case "cosmos.gov.v1.QueryVoteRequest.proposal_id":
-		value := x.ProposalId
-		return protoreflect.ValueOfUint64(value)
-	case "cosmos.gov.v1.QueryVoteRequest.voter":
+	case "cosmos.gov.v1.QueryProposalsRequest.proposal_status":
+		value := x.ProposalStatus
+		return protoreflect.ValueOfEnum((protoreflect.EnumNumber)(value))
+	case "cosmos.gov.v1.QueryProposalsRequest.voter":
 		value := x.Voter
 		return protoreflect.ValueOfString(value)
+	case "cosmos.gov.v1.QueryProposalsRequest.depositor":
+		value := x.Depositor
+		return protoreflect.ValueOfString(value)
+	case "cosmos.gov.v1.QueryProposalsRequest.pagination":
+		value := x.Pagination
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
 	default:
 		if descriptor.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.gov.v1.QueryVoteRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.gov.v1.QueryProposalsRequest"))
 		}
-		panic(fmt.Errorf("message cosmos.gov.v1.QueryVoteRequest does not contain field %s", descriptor.FullName()))
+		panic(fmt.Errorf("message cosmos.gov.v1.QueryProposalsRequest does not contain field %s", descriptor.FullName()))
 	}
 }
 
@@ -2983,17 +2840,21 @@ func (x *fastReflection_QueryVoteRequest) Get(descriptor protoreflect.FieldDescr
 // empty, read-only value, then it panics.
 //
 // Set is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryVoteRequest) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+func (x *fastReflection_QueryProposalsRequest) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
 	switch fd.FullName() {
-	case "cosmos.gov.v1.QueryVoteRequest.proposal_id":
-		x.ProposalId = value.Uint()
-	case "cosmos.gov.v1.QueryVoteRequest.voter":
+	case "cosmos.gov.v1.QueryProposalsRequest.proposal_status":
+		x.ProposalStatus = (ProposalStatus)(value.Enum())
+	case "cosmos.gov.v1.QueryProposalsRequest.voter":
 		x.Voter = value.Interface().(string)
+	case "cosmos.gov.v1.QueryProposalsRequest.depositor":
+		x.Depositor = value.Interface().(string)
+	case "cosmos.gov.v1.QueryProposalsRequest.pagination":
+		x.Pagination = value.Message().Interface().(*v1beta1.PageRequest)
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.gov.v1.QueryVoteRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.gov.v1.QueryProposalsRequest"))
 		}
-		panic(fmt.Errorf("message cosmos.gov.v1.QueryVoteRequest does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.gov.v1.QueryProposalsRequest does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -3007,44 +2868,56 @@ func (x *fastReflection_QueryVoteRequest) Set(fd protoreflect.FieldDescriptor, v
 // It panics if the field does not contain a composite type.
 //
 // Mutable is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryVoteRequest) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_QueryProposalsRequest) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "cosmos.gov.v1.QueryVoteRequest.proposal_id":
-		panic(fmt.Errorf("field proposal_id of message cosmos.gov.v1.QueryVoteRequest is not mutable"))
-	case "cosmos.gov.v1.QueryVoteRequest.voter":
-		panic(fmt.Errorf("field voter of message cosmos.gov.v1.QueryVoteRequest is not mutable"))
+	case "cosmos.gov.v1.QueryProposalsRequest.pagination":
+		if x.Pagination == nil {
+			x.Pagination = new(v1beta1.PageRequest)
+		}
+		return protoreflect.ValueOfMessage(x.Pagination.ProtoReflect())
+	case "cosmos.gov.v1.QueryProposalsRequest.proposal_status":
+		panic(fmt.Errorf("field proposal_status of message cosmos.gov.v1.QueryProposalsRequest is not mutable"))
+	case "cosmos.gov.v1.QueryProposalsRequest.voter":
+		panic(fmt.Errorf("field voter of message cosmos.gov.v1.QueryProposalsRequest is not mutable"))
+	case "cosmos.gov.v1.QueryProposalsRequest.depositor":
+		panic(fmt.Errorf("field depositor of message cosmos.gov.v1.QueryProposalsRequest is not mutable"))
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.gov.v1.QueryVoteRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.gov.v1.QueryProposalsRequest"))
 		}
-		panic(fmt.Errorf("message cosmos.gov.v1.QueryVoteRequest does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.gov.v1.QueryProposalsRequest does not contain field %s", fd.FullName()))
 	}
 }
 
 // NewField returns a new value that is assignable to the field
 // for the given descriptor. For scalars, this returns the default value.
 // For lists, maps, and messages, this returns a new, empty, mutable value.
-func (x *fastReflection_QueryVoteRequest) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_QueryProposalsRequest) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "cosmos.gov.v1.QueryVoteRequest.proposal_id":
-		return protoreflect.ValueOfUint64(uint64(0))
-	case "cosmos.gov.v1.QueryVoteRequest.voter":
+	case "cosmos.gov.v1.QueryProposalsRequest.proposal_status":
+		return protoreflect.ValueOfEnum(0)
+	case "cosmos.gov.v1.QueryProposalsRequest.voter":
+		return protoreflect.ValueOfString("")
+	case "cosmos.gov.v1.QueryProposalsRequest.depositor":
 		return protoreflect.ValueOfString("")
+	case "cosmos.gov.v1.QueryProposalsRequest.pagination":
+		m := new(v1beta1.PageRequest)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.gov.v1.QueryVoteRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.gov.v1.QueryProposalsRequest"))
 		}
-		panic(fmt.Errorf("message cosmos.gov.v1.QueryVoteRequest does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.gov.v1.QueryProposalsRequest does not contain field %s", fd.FullName()))
 	}
 }
 
 // WhichOneof reports which field within the oneof is populated,
 // returning nil if none are populated.
 // It panics if the oneof descriptor does not belong to this message.
-func (x *fastReflection_QueryVoteRequest) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+func (x *fastReflection_QueryProposalsRequest) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
 	switch d.FullName() {
 	default:
-		panic(fmt.Errorf("%s is not a oneof field in cosmos.gov.v1.QueryVoteRequest", d.FullName()))
+		panic(fmt.Errorf("%s is not a oneof field in cosmos.gov.v1.QueryProposalsRequest", d.FullName()))
 	}
 	panic("unreachable")
 }
@@ -3052,7 +2925,7 @@ func (x *fastReflection_QueryVoteRequest) WhichOneof(d protoreflect.OneofDescrip
 // GetUnknown retrieves the entire list of unknown fields.
 // The caller may only mutate the contents of the RawFields
 // if the mutated bytes are stored back into the message with SetUnknown.
-func (x *fastReflection_QueryVoteRequest) GetUnknown() protoreflect.RawFields {
+func (x *fastReflection_QueryProposalsRequest) GetUnknown() protoreflect.RawFields {
 	return x.unknownFields
 }
 
@@ -3063,7 +2936,7 @@ func (x *fastReflection_QueryVoteRequest) GetUnknown() protoreflect.RawFields {
 // An empty RawFields may be passed to clear the fields.
 //
 // SetUnknown is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryVoteRequest) SetUnknown(fields protoreflect.RawFields) {
+func (x *fastReflection_QueryProposalsRequest) SetUnknown(fields protoreflect.RawFields) {
 	x.unknownFields = fields
 }
 
@@ -3075,7 +2948,7 @@ func (x *fastReflection_QueryVoteRequest) SetUnknown(fields protoreflect.RawFiel
 // message type, but the details are implementation dependent.
 // Validity is not part of the protobuf data model, and may not
 // be preserved in marshaling or other operations.
-func (x *fastReflection_QueryVoteRequest) IsValid() bool {
+func (x *fastReflection_QueryProposalsRequest) IsValid() bool {
 	return x != nil
 }
 
@@ -3085,9 +2958,9 @@ func (x *fastReflection_QueryVoteRequest) IsValid() bool {
 // The returned methods type is identical to
 // "google.golang.org/protobuf/runtime/protoiface".Methods.
 // Consult the protoiface package documentation for details.
-func (x *fastReflection_QueryVoteRequest) ProtoMethods() *protoiface.Methods {
+func (x *fastReflection_QueryProposalsRequest) ProtoMethods() *protoiface.Methods {
 	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
-		x := input.Message.Interface().(*QueryVoteRequest)
+		x := input.Message.Interface().(*QueryProposalsRequest)
 		if x == nil {
 			return protoiface.SizeOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -3099,13 +2972,21 @@ func (x *fastReflection_QueryVoteRequest) ProtoMethods() *protoiface.Methods {
 		var n int
 		var l int
 		_ = l
-		if x.ProposalId != 0 {
-			n += 1 + runtime.Sov(uint64(x.ProposalId))
+		if x.ProposalStatus != 0 {
+			n += 1 + runtime.Sov(uint64(x.ProposalStatus))
 		}
 		l = len(x.Voter)
 		if l > 0 {
 			n += 1 + l + runtime.Sov(uint64(l))
 		}
+		l = len(x.Depositor)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.Pagination != nil {
+			l = options.Size(x.Pagination)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
 		}
@@ -3116,7 +2997,7 @@ func (x *fastReflection_QueryVoteRequest) ProtoMethods() *protoiface.Methods {
 	}
 
 	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
-		x := input.Message.Interface().(*QueryVoteRequest)
+		x := input.Message.Interface().(*QueryProposalsRequest)
 		if x == nil {
 			return protoiface.MarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -3135,6 +3016,27 @@ func (x *fastReflection_QueryVoteRequest) ProtoMethods() *protoiface.Methods {
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
+		if x.Pagination != nil {
+			encoded, err := options.Marshal(x.Pagination)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x22
+		}
+		if len(x.Depositor) > 0 {
+			i -= len(x.Depositor)
+			copy(dAtA[i:], x.Depositor)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Depositor)))
+			i--
+			dAtA[i] = 0x1a
+		}
 		if len(x.Voter) > 0 {
 			i -= len(x.Voter)
 			copy(dAtA[i:], x.Voter)
@@ -3142,8 +3044,8 @@ func (x *fastReflection_QueryVoteRequest) ProtoMethods() *protoiface.Methods {
 			i--
 			dAtA[i] = 0x12
 		}
-		if x.ProposalId != 0 {
-			i = runtime.EncodeVarint(dAtA, i, uint64(x.ProposalId))
+		if x.ProposalStatus != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.ProposalStatus))
 			i--
 			dAtA[i] = 0x8
 		}
@@ -3158,7 +3060,7 @@ func (x *fastReflection_QueryVoteRequest) ProtoMethods() *protoiface.Methods {
 		}, nil
 	}
 	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
-		x := input.Message.Interface().(*QueryVoteRequest)
+		x := input.Message.Interface().(*QueryProposalsRequest)
 		if x == nil {
 			return protoiface.UnmarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -3190,17 +3092,17 @@ func (x *fastReflection_QueryVoteRequest) ProtoMethods() *protoiface.Methods {
 			fieldNum := int32(wire >> 3)
 			wireType := int(wire & 0x7)
 			if wireType == 4 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryVoteRequest: wiretype end group for non-group")
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryProposalsRequest: wiretype end group for non-group")
 			}
 			if fieldNum <= 0 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryVoteRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryProposalsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 			}
 			switch fieldNum {
 			case 1:
 				if wireType != 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ProposalId", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ProposalStatus", wireType)
 				}
-				x.ProposalId = 0
+				x.ProposalStatus = 0
 				for shift := uint(0); ; shift += 7 {
 					if shift >= 64 {
 						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
@@ -3210,7 +3112,7 @@ func (x *fastReflection_QueryVoteRequest) ProtoMethods() *protoiface.Methods {
 					}
 					b := dAtA[iNdEx]
 					iNdEx++
-					x.ProposalId |= uint64(b&0x7F) << shift
+					x.ProposalStatus |= ProposalStatus(b&0x7F) << shift
 					if b < 0x80 {
 						break
 					}
@@ -3247,20 +3149,88 @@ func (x *fastReflection_QueryVoteRequest) ProtoMethods() *protoiface.Methods {
 				}
 				x.Voter = string(dAtA[iNdEx:postIndex])
 				iNdEx = postIndex
-			default:
-				iNdEx = preIndex
-				skippy, err := runtime.Skip(dAtA[iNdEx:])
-				if err != nil {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+			case 3:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Depositor", wireType)
 				}
-				if (skippy < 0) || (iNdEx+skippy) < 0 {
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 				}
-				if (iNdEx + skippy) > l {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 				}
-				if !options.DiscardUnknown {
-					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Depositor = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 4:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.Pagination == nil {
+					x.Pagination = &v1beta1.PageRequest{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Pagination); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
 				}
 				iNdEx += skippy
 			}
@@ -3282,26 +3252,79 @@ func (x *fastReflection_QueryVoteRequest) ProtoMethods() *protoiface.Methods {
 	}
 }
 
+var _ protoreflect.List = (*_QueryProposalsResponse_1_list)(nil)
+
+type _QueryProposalsResponse_1_list struct {
+	list *[]*Proposal
+}
+
+func (x *_QueryProposalsResponse_1_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_QueryProposalsResponse_1_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_QueryProposalsResponse_1_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*Proposal)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_QueryProposalsResponse_1_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*Proposal)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_QueryProposalsResponse_1_list) AppendMutable() protoreflect.Value {
+	v := new(Proposal)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_QueryProposalsResponse_1_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_QueryProposalsResponse_1_list) NewElement() protoreflect.Value {
+	v := new(Proposal)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_QueryProposalsResponse_1_list) IsValid() bool {
+	return x.list != nil
+}
+
 var (
-	md_QueryVoteResponse      protoreflect.MessageDescriptor
-	fd_QueryVoteResponse_vote protoreflect.FieldDescriptor
+	md_QueryProposalsResponse            protoreflect.MessageDescriptor
+	fd_QueryProposalsResponse_proposals  protoreflect.FieldDescriptor
+	fd_QueryProposalsResponse_pagination protoreflect.FieldDescriptor
 )
 
 func init() {
 	file_cosmos_gov_v1_query_proto_init()
-	md_QueryVoteResponse = File_cosmos_gov_v1_query_proto.Messages().ByName("QueryVoteResponse")
-	fd_QueryVoteResponse_vote = md_QueryVoteResponse.Fields().ByName("vote")
+	md_QueryProposalsResponse = File_cosmos_gov_v1_query_proto.Messages().ByName("QueryProposalsResponse")
+	fd_QueryProposalsResponse_proposals = md_QueryProposalsResponse.Fields().ByName("proposals")
+	fd_QueryProposalsResponse_pagination = md_QueryProposalsResponse.Fields().ByName("pagination")
 }
 
-var _ protoreflect.Message = (*fastReflection_QueryVoteResponse)(nil)
+var _ protoreflect.Message = (*fastReflection_QueryProposalsResponse)(nil)
 
-type fastReflection_QueryVoteResponse QueryVoteResponse
+type fastReflection_QueryProposalsResponse QueryProposalsResponse
 
-func (x *QueryVoteResponse) ProtoReflect() protoreflect.Message {
-	return (*fastReflection_QueryVoteResponse)(x)
+func (x *QueryProposalsResponse) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_QueryProposalsResponse)(x)
 }
 
-func (x *QueryVoteResponse) slowProtoReflect() protoreflect.Message {
+func (x *QueryProposalsResponse) slowProtoReflect() protoreflect.Message {
 	mi := &file_cosmos_gov_v1_query_proto_msgTypes[7]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3313,43 +3336,43 @@ func (x *QueryVoteResponse) slowProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-var _fastReflection_QueryVoteResponse_messageType fastReflection_QueryVoteResponse_messageType
-var _ protoreflect.MessageType = fastReflection_QueryVoteResponse_messageType{}
+var _fastReflection_QueryProposalsResponse_messageType fastReflection_QueryProposalsResponse_messageType
+var _ protoreflect.MessageType = fastReflection_QueryProposalsResponse_messageType{}
 
-type fastReflection_QueryVoteResponse_messageType struct{}
+type fastReflection_QueryProposalsResponse_messageType struct{}
 
-func (x fastReflection_QueryVoteResponse_messageType) Zero() protoreflect.Message {
-	return (*fastReflection_QueryVoteResponse)(nil)
+func (x fastReflection_QueryProposalsResponse_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_QueryProposalsResponse)(nil)
 }
-func (x fastReflection_QueryVoteResponse_messageType) New() protoreflect.Message {
-	return new(fastReflection_QueryVoteResponse)
+func (x fastReflection_QueryProposalsResponse_messageType) New() protoreflect.Message {
+	return new(fastReflection_QueryProposalsResponse)
 }
-func (x fastReflection_QueryVoteResponse_messageType) Descriptor() protoreflect.MessageDescriptor {
-	return md_QueryVoteResponse
+func (x fastReflection_QueryProposalsResponse_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryProposalsResponse
 }
 
 // Descriptor returns message descriptor, which contains only the protobuf
 // type information for the message.
-func (x *fastReflection_QueryVoteResponse) Descriptor() protoreflect.MessageDescriptor {
-	return md_QueryVoteResponse
+func (x *fastReflection_QueryProposalsResponse) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryProposalsResponse
 }
 
 // Type returns the message type, which encapsulates both Go and protobuf
 // type information. If the Go type information is not needed,
 // it is recommended that the message descriptor be used instead.
-func (x *fastReflection_QueryVoteResponse) Type() protoreflect.MessageType {
-	return _fastReflection_QueryVoteResponse_messageType
+func (x *fastReflection_QueryProposalsResponse) Type() protoreflect.MessageType {
+	return _fastReflection_QueryProposalsResponse_messageType
 }
 
 // New returns a newly allocated and mutable empty message.
-func (x *fastReflection_QueryVoteResponse) New() protoreflect.Message {
-	return new(fastReflection_QueryVoteResponse)
+func (x *fastReflection_QueryProposalsResponse) New() protoreflect.Message {
+	return new(fastReflection_QueryProposalsResponse)
 }
 
 // Interface unwraps the message reflection interface and
 // returns the underlying ProtoMessage interface.
-func (x *fastReflection_QueryVoteResponse) Interface() protoreflect.ProtoMessage {
-	return (*QueryVoteResponse)(x)
+func (x *fastReflection_QueryProposalsResponse) Interface() protoreflect.ProtoMessage {
+	return (*QueryProposalsResponse)(x)
 }
 
 // Range iterates over every populated field in an undefined order,
@@ -3357,10 +3380,16 @@ func (x *fastReflection_QueryVoteResponse) Interface() protoreflect.ProtoMessage
 // Range returns immediately if f returns false.
 // While iterating, mutating operations may only be performed
 // on the current field descriptor.
-func (x *fastReflection_QueryVoteResponse) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
-	if x.Vote != nil {
-		value := protoreflect.ValueOfMessage(x.Vote.ProtoReflect())
-		if !f(fd_QueryVoteResponse_vote, value) {
+func (x *fastReflection_QueryProposalsResponse) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if len(x.Proposals) != 0 {
+		value := protoreflect.ValueOfList(&_QueryProposalsResponse_1_list{list: &x.Proposals})
+		if !f(fd_QueryProposalsResponse_proposals, value) {
+			return
+		}
+	}
+	if x.Pagination != nil {
+		value := protoreflect.ValueOfMessage(x.Pagination.ProtoReflect())
+		if !f(fd_QueryProposalsResponse_pagination, value) {
 			return
 		}
 	}
@@ -3377,15 +3406,17 @@ func (x *fastReflection_QueryVoteResponse) Range(f func(protoreflect.FieldDescri
 // In other cases (aside from the nullable cases above),
 // a proto3 scalar field is populated if it contains a non-zero value, and
 // a repeated field is populated if it is non-empty.
-func (x *fastReflection_QueryVoteResponse) Has(fd protoreflect.FieldDescriptor) bool {
+func (x *fastReflection_QueryProposalsResponse) Has(fd protoreflect.FieldDescriptor) bool {
 	switch fd.FullName() {
-	case "cosmos.gov.v1.QueryVoteResponse.vote":
-		return x.Vote != nil
+	case "cosmos.gov.v1.QueryProposalsResponse.proposals":
+		return len(x.Proposals) != 0
+	case "cosmos.gov.v1.QueryProposalsResponse.pagination":
+		return x.Pagination != nil
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.gov.v1.QueryVoteResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.gov.v1.QueryProposalsResponse"))
 		}
-		panic(fmt.Errorf("message cosmos.gov.v1.QueryVoteResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.gov.v1.QueryProposalsResponse does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -3395,15 +3426,17 @@ func (x *fastReflection_QueryVoteResponse) Has(fd protoreflect.FieldDescriptor)
 // associated with the given field number.
 //
 // Clear is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryVoteResponse) Clear(fd protoreflect.FieldDescriptor) {
+func (x *fastReflection_QueryProposalsResponse) Clear(fd protoreflect.FieldDescriptor) {
 	switch fd.FullName() {
-	case "cosmos.gov.v1.QueryVoteResponse.vote":
-		x.Vote = nil
+	case "cosmos.gov.v1.QueryProposalsResponse.proposals":
+		x.Proposals = nil
+	case "cosmos.gov.v1.QueryProposalsResponse.pagination":
+		x.Pagination = nil
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.gov.v1.QueryVoteResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.gov.v1.QueryProposalsResponse"))
 		}
-		panic(fmt.Errorf("message cosmos.gov.v1.QueryVoteResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.gov.v1.QueryProposalsResponse does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -3413,16 +3446,22 @@ func (x *fastReflection_QueryVoteResponse) Clear(fd protoreflect.FieldDescriptor
 // the default value of a bytes scalar is guaranteed to be a copy.
 // For unpopulated composite types, it returns an empty, read-only view
 // of the value; to obtain a mutable reference, use Mutable.
-func (x *fastReflection_QueryVoteResponse) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_QueryProposalsResponse) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
 	switch descriptor.FullName() {
-	case "cosmos.gov.v1.QueryVoteResponse.vote":
-		value := x.Vote
+	case "cosmos.gov.v1.QueryProposalsResponse.proposals":
+		if len(x.Proposals) == 0 {
+			return protoreflect.ValueOfList(&_QueryProposalsResponse_1_list{})
+		}
+		listValue := &_QueryProposalsResponse_1_list{list: &x.Proposals}
+		return protoreflect.ValueOfList(listValue)
+	case "cosmos.gov.v1.QueryProposalsResponse.pagination":
+		value := x.Pagination
 		return protoreflect.ValueOfMessage(value.ProtoReflect())
 	default:
 		if descriptor.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.gov.v1.QueryVoteResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.gov.v1.QueryProposalsResponse"))
 		}
-		panic(fmt.Errorf("message cosmos.gov.v1.QueryVoteResponse does not contain field %s", descriptor.FullName()))
+		panic(fmt.Errorf("message cosmos.gov.v1.QueryProposalsResponse does not contain field %s", descriptor.FullName()))
 	}
 }
 
@@ -3436,15 +3475,19 @@ func (x *fastReflection_QueryVoteResponse) Get(descriptor protoreflect.FieldDesc
 // empty, read-only value, then it panics.
 //
 // Set is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryVoteResponse) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+func (x *fastReflection_QueryProposalsResponse) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
 	switch fd.FullName() {
-	case "cosmos.gov.v1.QueryVoteResponse.vote":
-		x.Vote = value.Message().Interface().(*Vote)
+	case "cosmos.gov.v1.QueryProposalsResponse.proposals":
+		lv := value.List()
+		clv := lv.(*_QueryProposalsResponse_1_list)
+		x.Proposals = *clv.list
+	case "cosmos.gov.v1.QueryProposalsResponse.pagination":
+		x.Pagination = value.Message().Interface().(*v1beta1.PageResponse)
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.gov.v1.QueryVoteResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.gov.v1.QueryProposalsResponse"))
 		}
-		panic(fmt.Errorf("message cosmos.gov.v1.QueryVoteResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.gov.v1.QueryProposalsResponse does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -3458,44 +3501,53 @@ func (x *fastReflection_QueryVoteResponse) Set(fd protoreflect.FieldDescriptor,
 // It panics if the field does not contain a composite type.
 //
 // Mutable is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryVoteResponse) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_QueryProposalsResponse) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "cosmos.gov.v1.QueryVoteResponse.vote":
-		if x.Vote == nil {
-			x.Vote = new(Vote)
+	case "cosmos.gov.v1.QueryProposalsResponse.proposals":
+		if x.Proposals == nil {
+			x.Proposals = []*Proposal{}
 		}
-		return protoreflect.ValueOfMessage(x.Vote.ProtoReflect())
+		value := &_QueryProposalsResponse_1_list{list: &x.Proposals}
+		return protoreflect.ValueOfList(value)
+	case "cosmos.gov.v1.QueryProposalsResponse.pagination":
+		if x.Pagination == nil {
+			x.Pagination = new(v1beta1.PageResponse)
+		}
+		return protoreflect.ValueOfMessage(x.Pagination.ProtoReflect())
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.gov.v1.QueryVoteResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.gov.v1.QueryProposalsResponse"))
 		}
-		panic(fmt.Errorf("message cosmos.gov.v1.QueryVoteResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.gov.v1.QueryProposalsResponse does not contain field %s", fd.FullName()))
 	}
 }
 
 // NewField returns a new value that is assignable to the field
 // for the given descriptor. For scalars, this returns the default value.
 // For lists, maps, and messages, this returns a new, empty, mutable value.
-func (x *fastReflection_QueryVoteResponse) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_QueryProposalsResponse) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "cosmos.gov.v1.QueryVoteResponse.vote":
-		m := new(Vote)
+	case "cosmos.gov.v1.QueryProposalsResponse.proposals":
+		list := []*Proposal{}
+		return protoreflect.ValueOfList(&_QueryProposalsResponse_1_list{list: &list})
+	case "cosmos.gov.v1.QueryProposalsResponse.pagination":
+		m := new(v1beta1.PageResponse)
 		return protoreflect.ValueOfMessage(m.ProtoReflect())
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.gov.v1.QueryVoteResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.gov.v1.QueryProposalsResponse"))
 		}
-		panic(fmt.Errorf("message cosmos.gov.v1.QueryVoteResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.gov.v1.QueryProposalsResponse does not contain field %s", fd.FullName()))
 	}
 }
 
 // WhichOneof reports which field within the oneof is populated,
 // returning nil if none are populated.
 // It panics if the oneof descriptor does not belong to this message.
-func (x *fastReflection_QueryVoteResponse) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+func (x *fastReflection_QueryProposalsResponse) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
 	switch d.FullName() {
 	default:
-		panic(fmt.Errorf("%s is not a oneof field in cosmos.gov.v1.QueryVoteResponse", d.FullName()))
+		panic(fmt.Errorf("%s is not a oneof field in cosmos.gov.v1.QueryProposalsResponse", d.FullName()))
 	}
 	panic("unreachable")
 }
@@ -3503,7 +3555,7 @@ func (x *fastReflection_QueryVoteResponse) WhichOneof(d protoreflect.OneofDescri
 // GetUnknown retrieves the entire list of unknown fields.
 // The caller may only mutate the contents of the RawFields
 // if the mutated bytes are stored back into the message with SetUnknown.
-func (x *fastReflection_QueryVoteResponse) GetUnknown() protoreflect.RawFields {
+func (x *fastReflection_QueryProposalsResponse) GetUnknown() protoreflect.RawFields {
 	return x.unknownFields
 }
 
@@ -3514,7 +3566,7 @@ func (x *fastReflection_QueryVoteResponse) GetUnknown() protoreflect.RawFields {
 // An empty RawFields may be passed to clear the fields.
 //
 // SetUnknown is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryVoteResponse) SetUnknown(fields protoreflect.RawFields) {
+func (x *fastReflection_QueryProposalsResponse) SetUnknown(fields protoreflect.RawFields) {
 	x.unknownFields = fields
 }
 
@@ -3526,7 +3578,7 @@ func (x *fastReflection_QueryVoteResponse) SetUnknown(fields protoreflect.RawFie
 // message type, but the details are implementation dependent.
 // Validity is not part of the protobuf data model, and may not
 // be preserved in marshaling or other operations.
-func (x *fastReflection_QueryVoteResponse) IsValid() bool {
+func (x *fastReflection_QueryProposalsResponse) IsValid() bool {
 	return x != nil
 }
 
@@ -3536,9 +3588,9 @@ func (x *fastReflection_QueryVoteResponse) IsValid() bool {
 // The returned methods type is identical to
 // "google.golang.org/protobuf/runtime/protoiface".Methods.
 // Consult the protoiface package documentation for details.
-func (x *fastReflection_QueryVoteResponse) ProtoMethods() *protoiface.Methods {
+func (x *fastReflection_QueryProposalsResponse) ProtoMethods() *protoiface.Methods {
 	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
-		x := input.Message.Interface().(*QueryVoteResponse)
+		x := input.Message.Interface().(*QueryProposalsResponse)
 		if x == nil {
 			return protoiface.SizeOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -3550,8 +3602,14 @@ func (x *fastReflection_QueryVoteResponse) ProtoMethods() *protoiface.Methods {
 		var n int
 		var l int
 		_ = l
-		if x.Vote != nil {
-			l = options.Size(x.Vote)
+		if len(x.Proposals) > 0 {
+			for _, e := range x.Proposals {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if x.Pagination != nil {
+			l = options.Size(x.Pagination)
 			n += 1 + l + runtime.Sov(uint64(l))
 		}
 		if x.unknownFields != nil {
@@ -3564,7 +3622,7 @@ func (x *fastReflection_QueryVoteResponse) ProtoMethods() *protoiface.Methods {
 	}
 
 	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
-		x := input.Message.Interface().(*QueryVoteResponse)
+		x := input.Message.Interface().(*QueryProposalsResponse)
 		if x == nil {
 			return protoiface.MarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -3583,8 +3641,8 @@ func (x *fastReflection_QueryVoteResponse) ProtoMethods() *protoiface.Methods {
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
-		if x.Vote != nil {
-			encoded, err := options.Marshal(x.Vote)
+		if x.Pagination != nil {
+			encoded, err := options.Marshal(x.Pagination)
 			if err != nil {
 				return protoiface.MarshalOutput{
 					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -3595,7 +3653,23 @@ func (x *fastReflection_QueryVoteResponse) ProtoMethods() *protoiface.Methods {
 			copy(dAtA[i:], encoded)
 			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
 			i--
-			dAtA[i] = 0xa
+			dAtA[i] = 0x12
+		}
+		if len(x.Proposals) > 0 {
+			for iNdEx := len(x.Proposals) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.Proposals[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0xa
+			}
 		}
 		if input.Buf != nil {
 			input.Buf = append(input.Buf, dAtA...)
@@ -3608,7 +3682,7 @@ func (x *fastReflection_QueryVoteResponse) ProtoMethods() *protoiface.Methods {
 		}, nil
 	}
 	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
-		x := input.Message.Interface().(*QueryVoteResponse)
+		x := input.Message.Interface().(*QueryProposalsResponse)
 		if x == nil {
 			return protoiface.UnmarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -3640,15 +3714,15 @@ func (x *fastReflection_QueryVoteResponse) ProtoMethods() *protoiface.Methods {
 			fieldNum := int32(wire >> 3)
 			wireType := int(wire & 0x7)
 			if wireType == 4 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryVoteResponse: wiretype end group for non-group")
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryProposalsResponse: wiretype end group for non-group")
 			}
 			if fieldNum <= 0 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryVoteResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryProposalsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 			}
 			switch fieldNum {
 			case 1:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Vote", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Proposals", wireType)
 				}
 				var msglen int
 				for shift := uint(0); ; shift += 7 {
@@ -3675,10 +3749,44 @@ func (x *fastReflection_QueryVoteResponse) ProtoMethods() *protoiface.Methods {
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				if x.Vote == nil {
-					x.Vote = &Vote{}
+				x.Proposals = append(x.Proposals, &Proposal{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Proposals[len(x.Proposals)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
 				}
-				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Vote); err != nil {
+				iNdEx = postIndex
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.Pagination == nil {
+					x.Pagination = &v1beta1.PageResponse{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Pagination); err != nil {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
 				}
 				iNdEx = postIndex
@@ -3718,27 +3826,27 @@ func (x *fastReflection_QueryVoteResponse) ProtoMethods() *protoiface.Methods {
 }
 
 var (
-	md_QueryVotesRequest             protoreflect.MessageDescriptor
-	fd_QueryVotesRequest_proposal_id protoreflect.FieldDescriptor
-	fd_QueryVotesRequest_pagination  protoreflect.FieldDescriptor
+	md_QueryVoteRequest             protoreflect.MessageDescriptor
+	fd_QueryVoteRequest_proposal_id protoreflect.FieldDescriptor
+	fd_QueryVoteRequest_voter       protoreflect.FieldDescriptor
 )
 
 func init() {
 	file_cosmos_gov_v1_query_proto_init()
-	md_QueryVotesRequest = File_cosmos_gov_v1_query_proto.Messages().ByName("QueryVotesRequest")
-	fd_QueryVotesRequest_proposal_id = md_QueryVotesRequest.Fields().ByName("proposal_id")
-	fd_QueryVotesRequest_pagination = md_QueryVotesRequest.Fields().ByName("pagination")
+	md_QueryVoteRequest = File_cosmos_gov_v1_query_proto.Messages().ByName("QueryVoteRequest")
+	fd_QueryVoteRequest_proposal_id = md_QueryVoteRequest.Fields().ByName("proposal_id")
+	fd_QueryVoteRequest_voter = md_QueryVoteRequest.Fields().ByName("voter")
 }
 
-var _ protoreflect.Message = (*fastReflection_QueryVotesRequest)(nil)
+var _ protoreflect.Message = (*fastReflection_QueryVoteRequest)(nil)
 
-type fastReflection_QueryVotesRequest QueryVotesRequest
+type fastReflection_QueryVoteRequest QueryVoteRequest
 
-func (x *QueryVotesRequest) ProtoReflect() protoreflect.Message {
-	return (*fastReflection_QueryVotesRequest)(x)
+func (x *QueryVoteRequest) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_QueryVoteRequest)(x)
 }
 
-func (x *QueryVotesRequest) slowProtoReflect() protoreflect.Message {
+func (x *QueryVoteRequest) slowProtoReflect() protoreflect.Message {
 	mi := &file_cosmos_gov_v1_query_proto_msgTypes[8]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3750,43 +3858,43 @@ func (x *QueryVotesRequest) slowProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-var _fastReflection_QueryVotesRequest_messageType fastReflection_QueryVotesRequest_messageType
-var _ protoreflect.MessageType = fastReflection_QueryVotesRequest_messageType{}
+var _fastReflection_QueryVoteRequest_messageType fastReflection_QueryVoteRequest_messageType
+var _ protoreflect.MessageType = fastReflection_QueryVoteRequest_messageType{}
 
-type fastReflection_QueryVotesRequest_messageType struct{}
+type fastReflection_QueryVoteRequest_messageType struct{}
 
-func (x fastReflection_QueryVotesRequest_messageType) Zero() protoreflect.Message {
-	return (*fastReflection_QueryVotesRequest)(nil)
+func (x fastReflection_QueryVoteRequest_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_QueryVoteRequest)(nil)
 }
-func (x fastReflection_QueryVotesRequest_messageType) New() protoreflect.Message {
-	return new(fastReflection_QueryVotesRequest)
+func (x fastReflection_QueryVoteRequest_messageType) New() protoreflect.Message {
+	return new(fastReflection_QueryVoteRequest)
 }
-func (x fastReflection_QueryVotesRequest_messageType) Descriptor() protoreflect.MessageDescriptor {
-	return md_QueryVotesRequest
+func (x fastReflection_QueryVoteRequest_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryVoteRequest
 }
 
 // Descriptor returns message descriptor, which contains only the protobuf
 // type information for the message.
-func (x *fastReflection_QueryVotesRequest) Descriptor() protoreflect.MessageDescriptor {
-	return md_QueryVotesRequest
+func (x *fastReflection_QueryVoteRequest) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryVoteRequest
 }
 
 // Type returns the message type, which encapsulates both Go and protobuf
 // type information. If the Go type information is not needed,
 // it is recommended that the message descriptor be used instead.
-func (x *fastReflection_QueryVotesRequest) Type() protoreflect.MessageType {
-	return _fastReflection_QueryVotesRequest_messageType
+func (x *fastReflection_QueryVoteRequest) Type() protoreflect.MessageType {
+	return _fastReflection_QueryVoteRequest_messageType
 }
 
 // New returns a newly allocated and mutable empty message.
-func (x *fastReflection_QueryVotesRequest) New() protoreflect.Message {
-	return new(fastReflection_QueryVotesRequest)
+func (x *fastReflection_QueryVoteRequest) New() protoreflect.Message {
+	return new(fastReflection_QueryVoteRequest)
 }
 
 // Interface unwraps the message reflection interface and
 // returns the underlying ProtoMessage interface.
-func (x *fastReflection_QueryVotesRequest) Interface() protoreflect.ProtoMessage {
-	return (*QueryVotesRequest)(x)
+func (x *fastReflection_QueryVoteRequest) Interface() protoreflect.ProtoMessage {
+	return (*QueryVoteRequest)(x)
 }
 
 // Range iterates over every populated field in an undefined order,
@@ -3794,16 +3902,16 @@ func (x *fastReflection_QueryVotesRequest) Interface() protoreflect.ProtoMessage
 // Range returns immediately if f returns false.
 // While iterating, mutating operations may only be performed
 // on the current field descriptor.
-func (x *fastReflection_QueryVotesRequest) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+func (x *fastReflection_QueryVoteRequest) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
 	if x.ProposalId != uint64(0) {
 		value := protoreflect.ValueOfUint64(x.ProposalId)
-		if !f(fd_QueryVotesRequest_proposal_id, value) {
+		if !f(fd_QueryVoteRequest_proposal_id, value) {
 			return
 		}
 	}
-	if x.Pagination != nil {
-		value := protoreflect.ValueOfMessage(x.Pagination.ProtoReflect())
-		if !f(fd_QueryVotesRequest_pagination, value) {
+	if x.Voter != "" {
+		value := protoreflect.ValueOfString(x.Voter)
+		if !f(fd_QueryVoteRequest_voter, value) {
 			return
 		}
 	}
@@ -3820,17 +3928,17 @@ func (x *fastReflection_QueryVotesRequest) Range(f func(protoreflect.FieldDescri
 // In other cases (aside from the nullable cases above),
 // a proto3 scalar field is populated if it contains a non-zero value, and
 // a repeated field is populated if it is non-empty.
-func (x *fastReflection_QueryVotesRequest) Has(fd protoreflect.FieldDescriptor) bool {
+func (x *fastReflection_QueryVoteRequest) Has(fd protoreflect.FieldDescriptor) bool {
 	switch fd.FullName() {
-	case "cosmos.gov.v1.QueryVotesRequest.proposal_id":
+	case "cosmos.gov.v1.QueryVoteRequest.proposal_id":
 		return x.ProposalId != uint64(0)
-	case "cosmos.gov.v1.QueryVotesRequest.pagination":
-		return x.Pagination != nil
+	case "cosmos.gov.v1.QueryVoteRequest.voter":
+		return x.Voter != ""
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.gov.v1.QueryVotesRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.gov.v1.QueryVoteRequest"))
 		}
-		panic(fmt.Errorf("message cosmos.gov.v1.QueryVotesRequest does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.gov.v1.QueryVoteRequest does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -3840,17 +3948,17 @@ func (x *fastReflection_QueryVotesRequest) Has(fd protoreflect.FieldDescriptor)
 // associated with the given field number.
 //
 // Clear is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryVotesRequest) Clear(fd protoreflect.FieldDescriptor) {
+func (x *fastReflection_QueryVoteRequest) Clear(fd protoreflect.FieldDescriptor) {
 	switch fd.FullName() {
-	case "cosmos.gov.v1.QueryVotesRequest.proposal_id":
+	case "cosmos.gov.v1.QueryVoteRequest.proposal_id":
 		x.ProposalId = uint64(0)
-	case "cosmos.gov.v1.QueryVotesRequest.pagination":
-		x.Pagination = nil
+	case "cosmos.gov.v1.QueryVoteRequest.voter":
+		x.Voter = ""
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.gov.v1.QueryVotesRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.gov.v1.QueryVoteRequest"))
 		}
-		panic(fmt.Errorf("message cosmos.gov.v1.QueryVotesRequest does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.gov.v1.QueryVoteRequest does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -3860,19 +3968,19 @@ func (x *fastReflection_QueryVotesRequest) Clear(fd protoreflect.FieldDescriptor
 // the default value of a bytes scalar is guaranteed to be a copy.
 // For unpopulated composite types, it returns an empty, read-only view
 // of the value; to obtain a mutable reference, use Mutable.
-func (x *fastReflection_QueryVotesRequest) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_QueryVoteRequest) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
 	switch descriptor.FullName() {
-	case "cosmos.gov.v1.QueryVotesRequest.proposal_id":
+	case "cosmos.gov.v1.QueryVoteRequest.proposal_id":
 		value := x.ProposalId
 		return protoreflect.ValueOfUint64(value)
-	case "cosmos.gov.v1.QueryVotesRequest.pagination":
-		value := x.Pagination
-		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "cosmos.gov.v1.QueryVoteRequest.voter":
+		value := x.Voter
+		return protoreflect.ValueOfString(value)
 	default:
 		if descriptor.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.gov.v1.QueryVotesRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.gov.v1.QueryVoteRequest"))
 		}
-		panic(fmt.Errorf("message cosmos.gov.v1.QueryVotesRequest does not contain field %s", descriptor.FullName()))
+		panic(fmt.Errorf("message cosmos.gov.v1.QueryVoteRequest does not contain field %s", descriptor.FullName()))
 	}
 }
 
@@ -3886,17 +3994,17 @@ func (x *fastReflection_QueryVotesRequest) Get(descriptor protoreflect.FieldDesc
 // empty, read-only value, then it panics.
 //
 // Set is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryVotesRequest) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+func (x *fastReflection_QueryVoteRequest) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
 	switch fd.FullName() {
-	case "cosmos.gov.v1.QueryVotesRequest.proposal_id":
+	case "cosmos.gov.v1.QueryVoteRequest.proposal_id":
 		x.ProposalId = value.Uint()
-	case "cosmos.gov.v1.QueryVotesRequest.pagination":
-		x.Pagination = value.Message().Interface().(*v1beta1.PageRequest)
+	case "cosmos.gov.v1.QueryVoteRequest.voter":
+		x.Voter = value.Interface().(string)
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.gov.v1.QueryVotesRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.gov.v1.QueryVoteRequest"))
 		}
-		panic(fmt.Errorf("message cosmos.gov.v1.QueryVotesRequest does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.gov.v1.QueryVoteRequest does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -3910,48 +4018,44 @@ func (x *fastReflection_QueryVotesRequest) Set(fd protoreflect.FieldDescriptor,
 // It panics if the field does not contain a composite type.
 //
 // Mutable is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryVotesRequest) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_QueryVoteRequest) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "cosmos.gov.v1.QueryVotesRequest.pagination":
-		if x.Pagination == nil {
-			x.Pagination = new(v1beta1.PageRequest)
-		}
-		return protoreflect.ValueOfMessage(x.Pagination.ProtoReflect())
-	case "cosmos.gov.v1.QueryVotesRequest.proposal_id":
-		panic(fmt.Errorf("field proposal_id of message cosmos.gov.v1.QueryVotesRequest is not mutable"))
+	case "cosmos.gov.v1.QueryVoteRequest.proposal_id":
+		panic(fmt.Errorf("field proposal_id of message cosmos.gov.v1.QueryVoteRequest is not mutable"))
+	case "cosmos.gov.v1.QueryVoteRequest.voter":
+		panic(fmt.Errorf("field voter of message cosmos.gov.v1.QueryVoteRequest is not mutable"))
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.gov.v1.QueryVotesRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.gov.v1.QueryVoteRequest"))
 		}
-		panic(fmt.Errorf("message cosmos.gov.v1.QueryVotesRequest does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.gov.v1.QueryVoteRequest does not contain field %s", fd.FullName()))
 	}
 }
 
 // NewField returns a new value that is assignable to the field
 // for the given descriptor. For scalars, this returns the default value.
 // For lists, maps, and messages, this returns a new, empty, mutable value.
-func (x *fastReflection_QueryVotesRequest) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_QueryVoteRequest) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "cosmos.gov.v1.QueryVotesRequest.proposal_id":
+	case "cosmos.gov.v1.QueryVoteRequest.proposal_id":
 		return protoreflect.ValueOfUint64(uint64(0))
-	case "cosmos.gov.v1.QueryVotesRequest.pagination":
-		m := new(v1beta1.PageRequest)
-		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case "cosmos.gov.v1.QueryVoteRequest.voter":
+		return protoreflect.ValueOfString("")
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.gov.v1.QueryVotesRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.gov.v1.QueryVoteRequest"))
 		}
-		panic(fmt.Errorf("message cosmos.gov.v1.QueryVotesRequest does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.gov.v1.QueryVoteRequest does not contain field %s", fd.FullName()))
 	}
 }
 
 // WhichOneof reports which field within the oneof is populated,
 // returning nil if none are populated.
 // It panics if the oneof descriptor does not belong to this message.
-func (x *fastReflection_QueryVotesRequest) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+func (x *fastReflection_QueryVoteRequest) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
 	switch d.FullName() {
 	default:
-		panic(fmt.Errorf("%s is not a oneof field in cosmos.gov.v1.QueryVotesRequest", d.FullName()))
+		panic(fmt.Errorf("%s is not a oneof field in cosmos.gov.v1.QueryVoteRequest", d.FullName()))
 	}
 	panic("unreachable")
 }
@@ -3959,7 +4063,7 @@ func (x *fastReflection_QueryVotesRequest) WhichOneof(d protoreflect.OneofDescri
 // GetUnknown retrieves the entire list of unknown fields.
 // The caller may only mutate the contents of the RawFields
 // if the mutated bytes are stored back into the message with SetUnknown.
-func (x *fastReflection_QueryVotesRequest) GetUnknown() protoreflect.RawFields {
+func (x *fastReflection_QueryVoteRequest) GetUnknown() protoreflect.RawFields {
 	return x.unknownFields
 }
 
@@ -3970,7 +4074,7 @@ func (x *fastReflection_QueryVotesRequest) GetUnknown() protoreflect.RawFields {
 // An empty RawFields may be passed to clear the fields.
 //
 // SetUnknown is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryVotesRequest) SetUnknown(fields protoreflect.RawFields) {
+func (x *fastReflection_QueryVoteRequest) SetUnknown(fields protoreflect.RawFields) {
 	x.unknownFields = fields
 }
 
@@ -3982,7 +4086,7 @@ func (x *fastReflection_QueryVotesRequest) SetUnknown(fields protoreflect.RawFie
 // message type, but the details are implementation dependent.
 // Validity is not part of the protobuf data model, and may not
 // be preserved in marshaling or other operations.
-func (x *fastReflection_QueryVotesRequest) IsValid() bool {
+func (x *fastReflection_QueryVoteRequest) IsValid() bool {
 	return x != nil
 }
 
@@ -3992,9 +4096,9 @@ func (x *fastReflection_QueryVotesRequest) IsValid() bool {
 // The returned methods type is identical to
 // "google.golang.org/protobuf/runtime/protoiface".Methods.
 // Consult the protoiface package documentation for details.
-func (x *fastReflection_QueryVotesRequest) ProtoMethods() *protoiface.Methods {
+func (x *fastReflection_QueryVoteRequest) ProtoMethods() *protoiface.Methods {
 	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
-		x := input.Message.Interface().(*QueryVotesRequest)
+		x := input.Message.Interface().(*QueryVoteRequest)
 		if x == nil {
 			return protoiface.SizeOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -4009,8 +4113,8 @@ func (x *fastReflection_QueryVotesRequest) ProtoMethods() *protoiface.Methods {
 		if x.ProposalId != 0 {
 			n += 1 + runtime.Sov(uint64(x.ProposalId))
 		}
-		if x.Pagination != nil {
-			l = options.Size(x.Pagination)
+		l = len(x.Voter)
+		if l > 0 {
 			n += 1 + l + runtime.Sov(uint64(l))
 		}
 		if x.unknownFields != nil {
@@ -4023,7 +4127,7 @@ func (x *fastReflection_QueryVotesRequest) ProtoMethods() *protoiface.Methods {
 	}
 
 	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
-		x := input.Message.Interface().(*QueryVotesRequest)
+		x := input.Message.Interface().(*QueryVoteRequest)
 		if x == nil {
 			return protoiface.MarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -4042,17 +4146,10 @@ func (x *fastReflection_QueryVotesRequest) ProtoMethods() *protoiface.Methods {
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
-		if x.Pagination != nil {
-			encoded, err := options.Marshal(x.Pagination)
-			if err != nil {
-				return protoiface.MarshalOutput{
-					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
-					Buf:               input.Buf,
-				}, err
-			}
-			i -= len(encoded)
-			copy(dAtA[i:], encoded)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+		if len(x.Voter) > 0 {
+			i -= len(x.Voter)
+			copy(dAtA[i:], x.Voter)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Voter)))
 			i--
 			dAtA[i] = 0x12
 		}
@@ -4072,7 +4169,7 @@ func (x *fastReflection_QueryVotesRequest) ProtoMethods() *protoiface.Methods {
 		}, nil
 	}
 	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
-		x := input.Message.Interface().(*QueryVotesRequest)
+		x := input.Message.Interface().(*QueryVoteRequest)
 		if x == nil {
 			return protoiface.UnmarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -4104,10 +4201,10 @@ func (x *fastReflection_QueryVotesRequest) ProtoMethods() *protoiface.Methods {
 			fieldNum := int32(wire >> 3)
 			wireType := int(wire & 0x7)
 			if wireType == 4 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryVotesRequest: wiretype end group for non-group")
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryVoteRequest: wiretype end group for non-group")
 			}
 			if fieldNum <= 0 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryVotesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryVoteRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 			}
 			switch fieldNum {
 			case 1:
@@ -4131,9 +4228,9 @@ func (x *fastReflection_QueryVotesRequest) ProtoMethods() *protoiface.Methods {
 				}
 			case 2:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Voter", wireType)
 				}
-				var msglen int
+				var stringLen uint64
 				for shift := uint(0); ; shift += 7 {
 					if shift >= 64 {
 						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
@@ -4143,27 +4240,23 @@ func (x *fastReflection_QueryVotesRequest) ProtoMethods() *protoiface.Methods {
 					}
 					b := dAtA[iNdEx]
 					iNdEx++
-					msglen |= int(b&0x7F) << shift
+					stringLen |= uint64(b&0x7F) << shift
 					if b < 0x80 {
 						break
 					}
 				}
-				if msglen < 0 {
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 				}
-				postIndex := iNdEx + msglen
+				postIndex := iNdEx + intStringLen
 				if postIndex < 0 {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 				}
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				if x.Pagination == nil {
-					x.Pagination = &v1beta1.PageRequest{}
-				}
-				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Pagination); err != nil {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
-				}
+				x.Voter = string(dAtA[iNdEx:postIndex])
 				iNdEx = postIndex
 			default:
 				iNdEx = preIndex
@@ -4200,79 +4293,26 @@ func (x *fastReflection_QueryVotesRequest) ProtoMethods() *protoiface.Methods {
 	}
 }
 
-var _ protoreflect.List = (*_QueryVotesResponse_1_list)(nil)
-
-type _QueryVotesResponse_1_list struct {
-	list *[]*Vote
-}
-
-func (x *_QueryVotesResponse_1_list) Len() int {
-	if x.list == nil {
-		return 0
-	}
-	return len(*x.list)
-}
-
-func (x *_QueryVotesResponse_1_list) Get(i int) protoreflect.Value {
-	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
-}
-
-func (x *_QueryVotesResponse_1_list) Set(i int, value protoreflect.Value) {
-	valueUnwrapped := value.Message()
-	concreteValue := valueUnwrapped.Interface().(*Vote)
-	(*x.list)[i] = concreteValue
-}
-
-func (x *_QueryVotesResponse_1_list) Append(value protoreflect.Value) {
-	valueUnwrapped := value.Message()
-	concreteValue := valueUnwrapped.Interface().(*Vote)
-	*x.list = append(*x.list, concreteValue)
-}
-
-func (x *_QueryVotesResponse_1_list) AppendMutable() protoreflect.Value {
-	v := new(Vote)
-	*x.list = append(*x.list, v)
-	return protoreflect.ValueOfMessage(v.ProtoReflect())
-}
-
-func (x *_QueryVotesResponse_1_list) Truncate(n int) {
-	for i := n; i < len(*x.list); i++ {
-		(*x.list)[i] = nil
-	}
-	*x.list = (*x.list)[:n]
-}
-
-func (x *_QueryVotesResponse_1_list) NewElement() protoreflect.Value {
-	v := new(Vote)
-	return protoreflect.ValueOfMessage(v.ProtoReflect())
-}
-
-func (x *_QueryVotesResponse_1_list) IsValid() bool {
-	return x.list != nil
-}
-
 var (
-	md_QueryVotesResponse            protoreflect.MessageDescriptor
-	fd_QueryVotesResponse_votes      protoreflect.FieldDescriptor
-	fd_QueryVotesResponse_pagination protoreflect.FieldDescriptor
+	md_QueryVoteResponse      protoreflect.MessageDescriptor
+	fd_QueryVoteResponse_vote protoreflect.FieldDescriptor
 )
 
 func init() {
 	file_cosmos_gov_v1_query_proto_init()
-	md_QueryVotesResponse = File_cosmos_gov_v1_query_proto.Messages().ByName("QueryVotesResponse")
-	fd_QueryVotesResponse_votes = md_QueryVotesResponse.Fields().ByName("votes")
-	fd_QueryVotesResponse_pagination = md_QueryVotesResponse.Fields().ByName("pagination")
+	md_QueryVoteResponse = File_cosmos_gov_v1_query_proto.Messages().ByName("QueryVoteResponse")
+	fd_QueryVoteResponse_vote = md_QueryVoteResponse.Fields().ByName("vote")
 }
 
-var _ protoreflect.Message = (*fastReflection_QueryVotesResponse)(nil)
+var _ protoreflect.Message = (*fastReflection_QueryVoteResponse)(nil)
 
-type fastReflection_QueryVotesResponse QueryVotesResponse
+type fastReflection_QueryVoteResponse QueryVoteResponse
 
-func (x *QueryVotesResponse) ProtoReflect() protoreflect.Message {
-	return (*fastReflection_QueryVotesResponse)(x)
+func (x *QueryVoteResponse) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_QueryVoteResponse)(x)
 }
 
-func (x *QueryVotesResponse) slowProtoReflect() protoreflect.Message {
+func (x *QueryVoteResponse) slowProtoReflect() protoreflect.Message {
 	mi := &file_cosmos_gov_v1_query_proto_msgTypes[9]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -4284,43 +4324,43 @@ func (x *QueryVotesResponse) slowProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-var _fastReflection_QueryVotesResponse_messageType fastReflection_QueryVotesResponse_messageType
-var _ protoreflect.MessageType = fastReflection_QueryVotesResponse_messageType{}
+var _fastReflection_QueryVoteResponse_messageType fastReflection_QueryVoteResponse_messageType
+var _ protoreflect.MessageType = fastReflection_QueryVoteResponse_messageType{}
 
-type fastReflection_QueryVotesResponse_messageType struct{}
+type fastReflection_QueryVoteResponse_messageType struct{}
 
-func (x fastReflection_QueryVotesResponse_messageType) Zero() protoreflect.Message {
-	return (*fastReflection_QueryVotesResponse)(nil)
+func (x fastReflection_QueryVoteResponse_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_QueryVoteResponse)(nil)
 }
-func (x fastReflection_QueryVotesResponse_messageType) New() protoreflect.Message {
-	return new(fastReflection_QueryVotesResponse)
+func (x fastReflection_QueryVoteResponse_messageType) New() protoreflect.Message {
+	return new(fastReflection_QueryVoteResponse)
 }
-func (x fastReflection_QueryVotesResponse_messageType) Descriptor() protoreflect.MessageDescriptor {
-	return md_QueryVotesResponse
+func (x fastReflection_QueryVoteResponse_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryVoteResponse
 }
 
 // Descriptor returns message descriptor, which contains only the protobuf
 // type information for the message.
-func (x *fastReflection_QueryVotesResponse) Descriptor() protoreflect.MessageDescriptor {
-	return md_QueryVotesResponse
+func (x *fastReflection_QueryVoteResponse) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryVoteResponse
 }
 
 // Type returns the message type, which encapsulates both Go and protobuf
 // type information. If the Go type information is not needed,
 // it is recommended that the message descriptor be used instead.
-func (x *fastReflection_QueryVotesResponse) Type() protoreflect.MessageType {
-	return _fastReflection_QueryVotesResponse_messageType
+func (x *fastReflection_QueryVoteResponse) Type() protoreflect.MessageType {
+	return _fastReflection_QueryVoteResponse_messageType
 }
 
 // New returns a newly allocated and mutable empty message.
-func (x *fastReflection_QueryVotesResponse) New() protoreflect.Message {
-	return new(fastReflection_QueryVotesResponse)
+func (x *fastReflection_QueryVoteResponse) New() protoreflect.Message {
+	return new(fastReflection_QueryVoteResponse)
 }
 
 // Interface unwraps the message reflection interface and
 // returns the underlying ProtoMessage interface.
-func (x *fastReflection_QueryVotesResponse) Interface() protoreflect.ProtoMessage {
-	return (*QueryVotesResponse)(x)
+func (x *fastReflection_QueryVoteResponse) Interface() protoreflect.ProtoMessage {
+	return (*QueryVoteResponse)(x)
 }
 
 // Range iterates over every populated field in an undefined order,
@@ -4328,16 +4368,10 @@ func (x *fastReflection_QueryVotesResponse) Interface() protoreflect.ProtoMessag
 // Range returns immediately if f returns false.
 // While iterating, mutating operations may only be performed
 // on the current field descriptor.
-func (x *fastReflection_QueryVotesResponse) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
-	if len(x.Votes) != 0 {
-		value := protoreflect.ValueOfList(&_QueryVotesResponse_1_list{list: &x.Votes})
-		if !f(fd_QueryVotesResponse_votes, value) {
-			return
-		}
-	}
-	if x.Pagination != nil {
-		value := protoreflect.ValueOfMessage(x.Pagination.ProtoReflect())
-		if !f(fd_QueryVotesResponse_pagination, value) {
+func (x *fastReflection_QueryVoteResponse) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Vote != nil {
+		value := protoreflect.ValueOfMessage(x.Vote.ProtoReflect())
+		if !f(fd_QueryVoteResponse_vote, value) {
 			return
 		}
 	}
@@ -4354,17 +4388,15 @@ func (x *fastReflection_QueryVotesResponse) Range(f func(protoreflect.FieldDescr
 // In other cases (aside from the nullable cases above),
 // a proto3 scalar field is populated if it contains a non-zero value, and
 // a repeated field is populated if it is non-empty.
-func (x *fastReflection_QueryVotesResponse) Has(fd protoreflect.FieldDescriptor) bool {
+func (x *fastReflection_QueryVoteResponse) Has(fd protoreflect.FieldDescriptor) bool {
 	switch fd.FullName() {
-	case "cosmos.gov.v1.QueryVotesResponse.votes":
-		return len(x.Votes) != 0
-	case "cosmos.gov.v1.QueryVotesResponse.pagination":
-		return x.Pagination != nil
+	case "cosmos.gov.v1.QueryVoteResponse.vote":
+		return x.Vote != nil
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.gov.v1.QueryVotesResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.gov.v1.QueryVoteResponse"))
 		}
-		panic(fmt.Errorf("message cosmos.gov.v1.QueryVotesResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.gov.v1.QueryVoteResponse does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -4374,17 +4406,15 @@ func (x *fastReflection_QueryVotesResponse) Has(fd protoreflect.FieldDescriptor)
 // associated with the given field number.
 //
 // Clear is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryVotesResponse) Clear(fd protoreflect.FieldDescriptor) {
+func (x *fastReflection_QueryVoteResponse) Clear(fd protoreflect.FieldDescriptor) {
 	switch fd.FullName() {
-	case "cosmos.gov.v1.QueryVotesResponse.votes":
-		x.Votes = nil
-	case "cosmos.gov.v1.QueryVotesResponse.pagination":
-		x.Pagination = nil
+	case "cosmos.gov.v1.QueryVoteResponse.vote":
+		x.Vote = nil
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.gov.v1.QueryVotesResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.gov.v1.QueryVoteResponse"))
 		}
-		panic(fmt.Errorf("message cosmos.gov.v1.QueryVotesResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.gov.v1.QueryVoteResponse does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -4394,22 +4424,16 @@ func (x *fastReflection_QueryVotesResponse) Clear(fd protoreflect.FieldDescripto
 // the default value of a bytes scalar is guaranteed to be a copy.
 // For unpopulated composite types, it returns an empty, read-only view
 // of the value; to obtain a mutable reference, use Mutable.
-func (x *fastReflection_QueryVotesResponse) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_QueryVoteResponse) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
 	switch descriptor.FullName() {
-	case "cosmos.gov.v1.QueryVotesResponse.votes":
-		if len(x.Votes) == 0 {
-			return protoreflect.ValueOfList(&_QueryVotesResponse_1_list{})
-		}
-		listValue := &_QueryVotesResponse_1_list{list: &x.Votes}
-		return protoreflect.ValueOfList(listValue)
-	case "cosmos.gov.v1.QueryVotesResponse.pagination":
-		value := x.Pagination
+	case "cosmos.gov.v1.QueryVoteResponse.vote":
+		value := x.Vote
 		return protoreflect.ValueOfMessage(value.ProtoReflect())
 	default:
 		if descriptor.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.gov.v1.QueryVotesResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.gov.v1.QueryVoteResponse"))
 		}
-		panic(fmt.Errorf("message cosmos.gov.v1.QueryVotesResponse does not contain field %s", descriptor.FullName()))
+		panic(fmt.Errorf("message cosmos.gov.v1.QueryVoteResponse does not contain field %s", descriptor.FullName()))
 	}
 }
 
@@ -4423,19 +4447,15 @@ func (x *fastReflection_QueryVotesResponse) Get(descriptor protoreflect.FieldDes
 // empty, read-only value, then it panics.
 //
 // Set is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryVotesResponse) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+func (x *fastReflection_QueryVoteResponse) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
 	switch fd.FullName() {
-	case "cosmos.gov.v1.QueryVotesResponse.votes":
-		lv := value.List()
-		clv := lv.(*_QueryVotesResponse_1_list)
-		x.Votes = *clv.list
-	case "cosmos.gov.v1.QueryVotesResponse.pagination":
-		x.Pagination = value.Message().Interface().(*v1beta1.PageResponse)
+	case "cosmos.gov.v1.QueryVoteResponse.vote":
+		x.Vote = value.Message().Interface().(*Vote)
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.gov.v1.QueryVotesResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.gov.v1.QueryVoteResponse"))
 		}
-		panic(fmt.Errorf("message cosmos.gov.v1.QueryVotesResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.gov.v1.QueryVoteResponse does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -4449,53 +4469,44 @@ func (x *fastReflection_QueryVotesResponse) Set(fd protoreflect.FieldDescriptor,
 // It panics if the field does not contain a composite type.
 //
 // Mutable is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryVotesResponse) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_QueryVoteResponse) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "cosmos.gov.v1.QueryVotesResponse.votes":
-		if x.Votes == nil {
-			x.Votes = []*Vote{}
-		}
-		value := &_QueryVotesResponse_1_list{list: &x.Votes}
-		return protoreflect.ValueOfList(value)
-	case "cosmos.gov.v1.QueryVotesResponse.pagination":
-		if x.Pagination == nil {
-			x.Pagination = new(v1beta1.PageResponse)
+	case "cosmos.gov.v1.QueryVoteResponse.vote":
+		if x.Vote == nil {
+			x.Vote = new(Vote)
 		}
-		return protoreflect.ValueOfMessage(x.Pagination.ProtoReflect())
+		return protoreflect.ValueOfMessage(x.Vote.ProtoReflect())
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.gov.v1.QueryVotesResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.gov.v1.QueryVoteResponse"))
 		}
-		panic(fmt.Errorf("message cosmos.gov.v1.QueryVotesResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.gov.v1.QueryVoteResponse does not contain field %s", fd.FullName()))
 	}
 }
 
 // NewField returns a new value that is assignable to the field
 // for the given descriptor. For scalars, this returns the default value.
 // For lists, maps, and messages, this returns a new, empty, mutable value.
-func (x *fastReflection_QueryVotesResponse) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_QueryVoteResponse) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "cosmos.gov.v1.QueryVotesResponse.votes":
-		list := []*Vote{}
-		return protoreflect.ValueOfList(&_QueryVotesResponse_1_list{list: &list})
-	case "cosmos.gov.v1.QueryVotesResponse.pagination":
-		m := new(v1beta1.PageResponse)
+	case "cosmos.gov.v1.QueryVoteResponse.vote":
+		m := new(Vote)
 		return protoreflect.ValueOfMessage(m.ProtoReflect())
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.gov.v1.QueryVotesResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.gov.v1.QueryVoteResponse"))
 		}
-		panic(fmt.Errorf("message cosmos.gov.v1.QueryVotesResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.gov.v1.QueryVoteResponse does not contain field %s", fd.FullName()))
 	}
 }
 
 // WhichOneof reports which field within the oneof is populated,
 // returning nil if none are populated.
 // It panics if the oneof descriptor does not belong to this message.
-func (x *fastReflection_QueryVotesResponse) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+func (x *fastReflection_QueryVoteResponse) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
 	switch d.FullName() {
 	default:
-		panic(fmt.Errorf("%s is not a oneof field in cosmos.gov.v1.QueryVotesResponse", d.FullName()))
+		panic(fmt.Errorf("%s is not a oneof field in cosmos.gov.v1.QueryVoteResponse", d.FullName()))
 	}
 	panic("unreachable")
 }
@@ -4503,7 +4514,7 @@ func (x *fastReflection_QueryVotesResponse) WhichOneof(d protoreflect.OneofDescr
 // GetUnknown retrieves the entire list of unknown fields.
 // The caller may only mutate the contents of the RawFields
 // if the mutated bytes are stored back into the message with SetUnknown.
-func (x *fastReflection_QueryVotesResponse) GetUnknown() protoreflect.RawFields {
+func (x *fastReflection_QueryVoteResponse) GetUnknown() protoreflect.RawFields {
 	return x.unknownFields
 }
 
@@ -4514,7 +4525,7 @@ func (x *fastReflection_QueryVotesResponse) GetUnknown() protoreflect.RawFields
 // An empty RawFields may be passed to clear the fields.
 //
 // SetUnknown is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryVotesResponse) SetUnknown(fields protoreflect.RawFields) {
+func (x *fastReflection_QueryVoteResponse) SetUnknown(fields protoreflect.RawFields) {
 	x.unknownFields = fields
 }
 
@@ -4526,7 +4537,7 @@ func (x *fastReflection_QueryVotesResponse) SetUnknown(fields protoreflect.RawFi
 // message type, but the details are implementation dependent.
 // Validity is not part of the protobuf data model, and may not
 // be preserved in marshaling or other operations.
-func (x *fastReflection_QueryVotesResponse) IsValid() bool {
+func (x *fastReflection_QueryVoteResponse) IsValid() bool {
 	return x != nil
 }
 
@@ -4536,9 +4547,9 @@ func (x *fastReflection_QueryVotesResponse) IsValid() bool {
 // The returned methods type is identical to
 // "google.golang.org/protobuf/runtime/protoiface".Methods.
 // Consult the protoiface package documentation for details.
-func (x *fastReflection_QueryVotesResponse) ProtoMethods() *protoiface.Methods {
+func (x *fastReflection_QueryVoteResponse) ProtoMethods() *protoiface.Methods {
 	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
-		x := input.Message.Interface().(*QueryVotesResponse)
+		x := input.Message.Interface().(*QueryVoteResponse)
 		if x == nil {
 			return protoiface.SizeOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -4550,14 +4561,8 @@ func (x *fastReflection_QueryVotesResponse) ProtoMethods() *protoiface.Methods {
 		var n int
 		var l int
 		_ = l
-		if len(x.Votes) > 0 {
-			for _, e := range x.Votes {
-				l = options.Size(e)
-				n += 1 + l + runtime.Sov(uint64(l))
-			}
-		}
-		if x.Pagination != nil {
-			l = options.Size(x.Pagination)
+		if x.Vote != nil {
+			l = options.Size(x.Vote)
 			n += 1 + l + runtime.Sov(uint64(l))
 		}
 		if x.unknownFields != nil {
@@ -4570,7 +4575,7 @@ func (x *fastReflection_QueryVotesResponse) ProtoMethods() *protoiface.Methods {
 	}
 
 	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
-		x := input.Message.Interface().(*QueryVotesResponse)
+		x := input.Message.Interface().(*QueryVoteResponse)
 		if x == nil {
 			return protoiface.MarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -4589,8 +4594,8 @@ func (x *fastReflection_QueryVotesResponse) ProtoMethods() *protoiface.Methods {
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
-		if x.Pagination != nil {
-			encoded, err := options.Marshal(x.Pagination)
+		if x.Vote != nil {
+			encoded, err := options.Marshal(x.Vote)
 			if err != nil {
 				return protoiface.MarshalOutput{
 					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -4601,23 +4606,7 @@ func (x *fastReflection_QueryVotesResponse) ProtoMethods() *protoiface.Methods {
 			copy(dAtA[i:], encoded)
 			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
 			i--
-			dAtA[i] = 0x12
-		}
-		if len(x.Votes) > 0 {
-			for iNdEx := len(x.Votes) - 1; iNdEx >= 0; iNdEx-- {
-				encoded, err := options.Marshal(x.Votes[iNdEx])
-				if err != nil {
-					return protoiface.MarshalOutput{
-						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
-						Buf:               input.Buf,
-					}, err
-				}
-				i -= len(encoded)
-				copy(dAtA[i:], encoded)
-				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
-				i--
-				dAtA[i] = 0xa
-			}
+			dAtA[i] = 0xa
 		}
 		if input.Buf != nil {
 			input.Buf = append(input.Buf, dAtA...)
@@ -4630,7 +4619,7 @@ func (x *fastReflection_QueryVotesResponse) ProtoMethods() *protoiface.Methods {
 		}, nil
 	}
 	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
-		x := input.Message.Interface().(*QueryVotesResponse)
+		x := input.Message.Interface().(*QueryVoteResponse)
 		if x == nil {
 			return protoiface.UnmarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -4662,49 +4651,15 @@ func (x *fastReflection_QueryVotesResponse) ProtoMethods() *protoiface.Methods {
 			fieldNum := int32(wire >> 3)
 			wireType := int(wire & 0x7)
 			if wireType == 4 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryVotesResponse: wiretype end group for non-group")
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryVoteResponse: wiretype end group for non-group")
 			}
 			if fieldNum <= 0 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryVotesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryVoteResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 			}
 			switch fieldNum {
 			case 1:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Votes", wireType)
-				}
-				var msglen int
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
-					}
-					if iNdEx >= l {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					msglen |= int(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				if msglen < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				postIndex := iNdEx + msglen
-				if postIndex < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				if postIndex > l {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-				}
-				x.Votes = append(x.Votes, &Vote{})
-				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Votes[len(x.Votes)-1]); err != nil {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
-				}
-				iNdEx = postIndex
-			case 2:
-				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Vote", wireType)
 				}
 				var msglen int
 				for shift := uint(0); ; shift += 7 {
@@ -4731,10 +4686,10 @@ func (x *fastReflection_QueryVotesResponse) ProtoMethods() *protoiface.Methods {
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				if x.Pagination == nil {
-					x.Pagination = &v1beta1.PageResponse{}
+				if x.Vote == nil {
+					x.Vote = &Vote{}
 				}
-				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Pagination); err != nil {
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Vote); err != nil {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
 				}
 				iNdEx = postIndex
@@ -4774,25 +4729,27 @@ func (x *fastReflection_QueryVotesResponse) ProtoMethods() *protoiface.Methods {
 }
 
 var (
-	md_QueryParamsRequest             protoreflect.MessageDescriptor
-	fd_QueryParamsRequest_params_type protoreflect.FieldDescriptor
+	md_QueryVoteCommitmentRequest             protoreflect.MessageDescriptor
+	fd_QueryVoteCommitmentRequest_proposal_id protoreflect.FieldDescriptor
+	fd_QueryVoteCommitmentRequest_voter       protoreflect.FieldDescriptor
 )
 
 func init() {
 	file_cosmos_gov_v1_query_proto_init()
-	md_QueryParamsRequest = File_cosmos_gov_v1_query_proto.Messages().ByName("QueryParamsRequest")
-	fd_QueryParamsRequest_params_type = md_QueryParamsRequest.Fields().ByName("params_type")
+	md_QueryVoteCommitmentRequest = File_cosmos_gov_v1_query_proto.Messages().ByName("QueryVoteCommitmentRequest")
+	fd_QueryVoteCommitmentRequest_proposal_id = md_QueryVoteCommitmentRequest.Fields().ByName("proposal_id")
+	fd_QueryVoteCommitmentRequest_voter = md_QueryVoteCommitmentRequest.Fields().ByName("voter")
 }
 
-var _ protoreflect.Message = (*fastReflection_QueryParamsRequest)(nil)
+var _ protoreflect.Message = (*fastReflection_QueryVoteCommitmentRequest)(nil)
 
-type fastReflection_QueryParamsRequest QueryParamsRequest
+type fastReflection_QueryVoteCommitmentRequest QueryVoteCommitmentRequest
 
-func (x *QueryParamsRequest) ProtoReflect() protoreflect.Message {
-	return (*fastReflection_QueryParamsRequest)(x)
+func (x *QueryVoteCommitmentRequest) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_QueryVoteCommitmentRequest)(x)
 }
 
-func (x *QueryParamsRequest) slowProtoReflect() protoreflect.Message {
+func (x *QueryVoteCommitmentRequest) slowProtoReflect() protoreflect.Message {
 	mi := &file_cosmos_gov_v1_query_proto_msgTypes[10]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -4804,43 +4761,43 @@ func (x *QueryParamsRequest) slowProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-var _fastReflection_QueryParamsRequest_messageType fastReflection_QueryParamsRequest_messageType
-var _ protoreflect.MessageType = fastReflection_QueryParamsRequest_messageType{}
+var _fastReflection_QueryVoteCommitmentRequest_messageType fastReflection_QueryVoteCommitmentRequest_messageType
+var _ protoreflect.MessageType = fastReflection_QueryVoteCommitmentRequest_messageType{}
 
-type fastReflection_QueryParamsRequest_messageType struct{}
+type fastReflection_QueryVoteCommitmentRequest_messageType struct{}
 
-func (x fastReflection_QueryParamsRequest_messageType) Zero() protoreflect.Message {
-	return (*fastReflection_QueryParamsRequest)(nil)
+func (x fastReflection_QueryVoteCommitmentRequest_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_QueryVoteCommitmentRequest)(nil)
 }
-func (x fastReflection_QueryParamsRequest_messageType) New() protoreflect.Message {
-	return new(fastReflection_QueryParamsRequest)
+func (x fastReflection_QueryVoteCommitmentRequest_messageType) New() protoreflect.Message {
+	return new(fastReflection_QueryVoteCommitmentRequest)
 }
-func (x fastReflection_QueryParamsRequest_messageType) Descriptor() protoreflect.MessageDescriptor {
-	return md_QueryParamsRequest
+func (x fastReflection_QueryVoteCommitmentRequest_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryVoteCommitmentRequest
 }
 
 // Descriptor returns message descriptor, which contains only the protobuf
 // type information for the message.
-func (x *fastReflection_QueryParamsRequest) Descriptor() protoreflect.MessageDescriptor {
-	return md_QueryParamsRequest
+func (x *fastReflection_QueryVoteCommitmentRequest) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryVoteCommitmentRequest
 }
 
 // Type returns the message type, which encapsulates both Go and protobuf
 // type information. If the Go type information is not needed,
 // it is recommended that the message descriptor be used instead.
-func (x *fastReflection_QueryParamsRequest) Type() protoreflect.MessageType {
-	return _fastReflection_QueryParamsRequest_messageType
+func (x *fastReflection_QueryVoteCommitmentRequest) Type() protoreflect.MessageType {
+	return _fastReflection_QueryVoteCommitmentRequest_messageType
 }
 
 // New returns a newly allocated and mutable empty message.
-func (x *fastReflection_QueryParamsRequest) New() protoreflect.Message {
-	return new(fastReflection_QueryParamsRequest)
+func (x *fastReflection_QueryVoteCommitmentRequest) New() protoreflect.Message {
+	return new(fastReflection_QueryVoteCommitmentRequest)
 }
 
 // Interface unwraps the message reflection interface and
 // returns the underlying ProtoMessage interface.
-func (x *fastReflection_QueryParamsRequest) Interface() protoreflect.ProtoMessage {
-	return (*QueryParamsRequest)(x)
+func (x *fastReflection_QueryVoteCommitmentRequest) Interface() protoreflect.ProtoMessage {
+	return (*QueryVoteCommitmentRequest)(x)
 }
 
 // Range iterates over every populated field in an undefined order,
@@ -4848,10 +4805,16 @@ func (x *fastReflection_QueryParamsRequest) Interface() protoreflect.ProtoMessag
 // Range returns immediately if f returns false.
 // While iterating, mutating operations may only be performed
 // on the current field descriptor.
-func (x *fastReflection_QueryParamsRequest) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
-	if x.ParamsType != "" {
-		value := protoreflect.ValueOfString(x.ParamsType)
-		if !f(fd_QueryParamsRequest_params_type, value) {
+func (x *fastReflection_QueryVoteCommitmentRequest) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.ProposalId != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.ProposalId)
+		if !f(fd_QueryVoteCommitmentRequest_proposal_id, value) {
+			return
+		}
+	}
+	if x.Voter != "" {
+		value := protoreflect.ValueOfString(x.Voter)
+		if !f(fd_QueryVoteCommitmentRequest_voter, value) {
 			return
 		}
 	}
@@ -4868,15 +4831,17 @@ func (x *fastReflection_QueryParamsRequest) Range(f func(protoreflect.FieldDescr
 // In other cases (aside from the nullable cases above),
 // a proto3 scalar field is populated if it contains a non-zero value, and
 // a repeated field is populated if it is non-empty.
-func (x *fastReflection_QueryParamsRequest) Has(fd protoreflect.FieldDescriptor) bool {
+func (x *fastReflection_QueryVoteCommitmentRequest) Has(fd protoreflect.FieldDescriptor) bool {
 	switch fd.FullName() {
-	case "cosmos.gov.v1.QueryParamsRequest.params_type":
-		return x.ParamsType != ""
+	case "cosmos.gov.v1.QueryVoteCommitmentRequest.proposal_id":
+		return x.ProposalId != uint64(0)
+	case "cosmos.gov.v1.QueryVoteCommitmentRequest.voter":
+		return x.Voter != ""
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.gov.v1.QueryParamsRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.gov.v1.QueryVoteCommitmentRequest"))
 		}
-		panic(fmt.Errorf("message cosmos.gov.v1.QueryParamsRequest does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.gov.v1.QueryVoteCommitmentRequest does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -4886,15 +4851,17 @@ func (x *fastReflection_QueryParamsRequest) Has(fd protoreflect.FieldDescriptor)
 // associated with the given field number.
 //
 // Clear is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryParamsRequest) Clear(fd protoreflect.FieldDescriptor) {
+func (x *fastReflection_QueryVoteCommitmentRequest) Clear(fd protoreflect.FieldDescriptor) {
 	switch fd.FullName() {
-	case "cosmos.gov.v1.QueryParamsRequest.params_type":
-		x.ParamsType = ""
+	case "cosmos.gov.v1.QueryVoteCommitmentRequest.proposal_id":
+		x.ProposalId = uint64(0)
+	case "cosmos.gov.v1.QueryVoteCommitmentRequest.voter":
+		x.Voter = ""
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.gov.v1.QueryParamsRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.gov.v1.QueryVoteCommitmentRequest"))
 		}
-		panic(fmt.Errorf("message cosmos.gov.v1.QueryParamsRequest does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.gov.v1.QueryVoteCommitmentRequest does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -4904,16 +4871,19 @@ func (x *fastReflection_QueryParamsRequest) Clear(fd protoreflect.FieldDescripto
 // the default value of a bytes scalar is guaranteed to be a copy.
 // For unpopulated composite types, it returns an empty, read-only view
 // of the value; to obtain a mutable reference, use Mutable.
-func (x *fastReflection_QueryParamsRequest) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_QueryVoteCommitmentRequest) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
 	switch descriptor.FullName() {
-	case "cosmos.gov.v1.QueryParamsRequest.params_type":
-		value := x.ParamsType
+	case "cosmos.gov.v1.QueryVoteCommitmentRequest.proposal_id":
+		value := x.ProposalId
+		return protoreflect.ValueOfUint64(value)
+	case "cosmos.gov.v1.QueryVoteCommitmentRequest.voter":
+		value := x.Voter
 		return protoreflect.ValueOfString(value)
 	default:
 		if descriptor.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.gov.v1.QueryParamsRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.gov.v1.QueryVoteCommitmentRequest"))
 		}
-		panic(fmt.Errorf("message cosmos.gov.v1.QueryParamsRequest does not contain field %s", descriptor.FullName()))
+		panic(fmt.Errorf("message cosmos.gov.v1.QueryVoteCommitmentRequest does not contain field %s", descriptor.FullName()))
 	}
 }
 
@@ -4927,15 +4897,17 @@ func (x *fastReflection_QueryParamsRequest) Get(descriptor protoreflect.FieldDes
 // empty, read-only value, then it panics.
 //
 // Set is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryParamsRequest) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+func (x *fastReflection_QueryVoteCommitmentRequest) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
 	switch fd.FullName() {
-	case "cosmos.gov.v1.QueryParamsRequest.params_type":
-		x.ParamsType = value.Interface().(string)
+	case "cosmos.gov.v1.QueryVoteCommitmentRequest.proposal_id":
+		x.ProposalId = value.Uint()
+	case "cosmos.gov.v1.QueryVoteCommitmentRequest.voter":
+		x.Voter = value.Interface().(string)
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.gov.v1.QueryParamsRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.gov.v1.QueryVoteCommitmentRequest"))
 		}
-		panic(fmt.Errorf("message cosmos.gov.v1.QueryParamsRequest does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.gov.v1.QueryVoteCommitmentRequest does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -4949,40 +4921,44 @@ func (x *fastReflection_QueryParamsRequest) Set(fd protoreflect.FieldDescriptor,
 // It panics if the field does not contain a composite type.
 //
 // Mutable is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryParamsRequest) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_QueryVoteCommitmentRequest) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "cosmos.gov.v1.QueryParamsRequest.params_type":
-		panic(fmt.Errorf("field params_type of message cosmos.gov.v1.QueryParamsRequest is not mutable"))
+	case "cosmos.gov.v1.QueryVoteCommitmentRequest.proposal_id":
+		panic(fmt.Errorf("field proposal_id of message cosmos.gov.v1.QueryVoteCommitmentRequest is not mutable"))
+	case "cosmos.gov.v1.QueryVoteCommitmentRequest.voter":
+		panic(fmt.Errorf("field voter of message cosmos.gov.v1.QueryVoteCommitmentRequest is not mutable"))
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.gov.v1.QueryParamsRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.gov.v1.QueryVoteCommitmentRequest"))
 		}
-		panic(fmt.Errorf("message cosmos.gov.v1.QueryParamsRequest does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.gov.v1.QueryVoteCommitmentRequest does not contain field %s", fd.FullName()))
 	}
 }
 
 // NewField returns a new value that is assignable to the field
 // for the given descriptor. For scalars, this returns the default value.
 // For lists, maps, and messages, this returns a new, empty, mutable value.
-func (x *fastReflection_QueryParamsRequest) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_QueryVoteCommitmentRequest) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "cosmos.gov.v1.QueryParamsRequest.params_type":
+	case "cosmos.gov.v1.QueryVoteCommitmentRequest.proposal_id":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "cosmos.gov.v1.QueryVoteCommitmentRequest.voter":
 		return protoreflect.ValueOfString("")
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.gov.v1.QueryParamsRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.gov.v1.QueryVoteCommitmentRequest"))
 		}
-		panic(fmt.Errorf("message cosmos.gov.v1.QueryParamsRequest does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.gov.v1.QueryVoteCommitmentRequest does not contain field %s", fd.FullName()))
 	}
 }
 
 // WhichOneof reports which field within the oneof is populated,
 // returning nil if none are populated.
 // It panics if the oneof descriptor does not belong to this message.
-func (x *fastReflection_QueryParamsRequest) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+func (x *fastReflection_QueryVoteCommitmentRequest) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
 	switch d.FullName() {
 	default:
-		panic(fmt.Errorf("%s is not a oneof field in cosmos.gov.v1.QueryParamsRequest", d.FullName()))
+		panic(fmt.Errorf("%s is not a oneof field in cosmos.gov.v1.QueryVoteCommitmentRequest", d.FullName()))
 	}
 	panic("unreachable")
 }
@@ -4990,7 +4966,7 @@ func (x *fastReflection_QueryParamsRequest) WhichOneof(d protoreflect.OneofDescr
 // GetUnknown retrieves the entire list of unknown fields.
 // The caller may only mutate the contents of the RawFields
 // if the mutated bytes are stored back into the message with SetUnknown.
-func (x *fastReflection_QueryParamsRequest) GetUnknown() protoreflect.RawFields {
+func (x *fastReflection_QueryVoteCommitmentRequest) GetUnknown() protoreflect.RawFields {
 	return x.unknownFields
 }
 
@@ -5001,7 +4977,7 @@ func (x *fastReflection_QueryParamsRequest) GetUnknown() protoreflect.RawFields
 // An empty RawFields may be passed to clear the fields.
 //
 // SetUnknown is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryParamsRequest) SetUnknown(fields protoreflect.RawFields) {
+func (x *fastReflection_QueryVoteCommitmentRequest) SetUnknown(fields protoreflect.RawFields) {
 	x.unknownFields = fields
 }
 
@@ -5013,7 +4989,7 @@ func (x *fastReflection_QueryParamsRequest) SetUnknown(fields protoreflect.RawFi
 // message type, but the details are implementation dependent.
 // Validity is not part of the protobuf data model, and may not
 // be preserved in marshaling or other operations.
-func (x *fastReflection_QueryParamsRequest) IsValid() bool {
+func (x *fastReflection_QueryVoteCommitmentRequest) IsValid() bool {
 	return x != nil
 }
 
@@ -5023,9 +4999,9 @@ func (x *fastReflection_QueryParamsRequest) IsValid() bool {
 // The returned methods type is identical to
 // "google.golang.org/protobuf/runtime/protoiface".Methods.
 // Consult the protoiface package documentation for details.
-func (x *fastReflection_QueryParamsRequest) ProtoMethods() *protoiface.Methods {
+func (x *fastReflection_QueryVoteCommitmentRequest) ProtoMethods() *protoiface.Methods {
 	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
-		x := input.Message.Interface().(*QueryParamsRequest)
+		x := input.Message.Interface().(*QueryVoteCommitmentRequest)
 		if x == nil {
 			return protoiface.SizeOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -5037,7 +5013,10 @@ func (x *fastReflection_QueryParamsRequest) ProtoMethods() *protoiface.Methods {
 		var n int
 		var l int
 		_ = l
-		l = len(x.ParamsType)
+		if x.ProposalId != 0 {
+			n += 1 + runtime.Sov(uint64(x.ProposalId))
+		}
+		l = len(x.Voter)
 		if l > 0 {
 			n += 1 + l + runtime.Sov(uint64(l))
 		}
@@ -5051,7 +5030,7 @@ func (x *fastReflection_QueryParamsRequest) ProtoMethods() *protoiface.Methods {
 	}
 
 	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
-		x := input.Message.Interface().(*QueryParamsRequest)
+		x := input.Message.Interface().(*QueryVoteCommitmentRequest)
 		if x == nil {
 			return protoiface.MarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -5070,12 +5049,17 @@ func (x *fastReflection_QueryParamsRequest) ProtoMethods() *protoiface.Methods {
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
-		if len(x.ParamsType) > 0 {
-			i -= len(x.ParamsType)
-			copy(dAtA[i:], x.ParamsType)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.ParamsType)))
+		if len(x.Voter) > 0 {
+			i -= len(x.Voter)
+			copy(dAtA[i:], x.Voter)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Voter)))
 			i--
-			dAtA[i] = 0xa
+			dAtA[i] = 0x12
+		}
+		if x.ProposalId != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.ProposalId))
+			i--
+			dAtA[i] = 0x8
 		}
 		if input.Buf != nil {
 			input.Buf = append(input.Buf, dAtA...)
@@ -5088,7 +5072,7 @@ func (x *fastReflection_QueryParamsRequest) ProtoMethods() *protoiface.Methods {
 		}, nil
 	}
 	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
-		x := input.Message.Interface().(*QueryParamsRequest)
+		x := input.Message.Interface().(*QueryVoteCommitmentRequest)
 		if x == nil {
 			return protoiface.UnmarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -5120,15 +5104,34 @@ func (x *fastReflection_QueryParamsRequest) ProtoMethods() *protoiface.Methods {
 			fieldNum := int32(wire >> 3)
 			wireType := int(wire & 0x7)
 			if wireType == 4 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryParamsRequest: wiretype end group for non-group")
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryVoteCommitmentRequest: wiretype end group for non-group")
 			}
 			if fieldNum <= 0 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryVoteCommitmentRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 			}
 			switch fieldNum {
 			case 1:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ProposalId", wireType)
+				}
+				x.ProposalId = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.ProposalId |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 2:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ParamsType", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Voter", wireType)
 				}
 				var stringLen uint64
 				for shift := uint(0); ; shift += 7 {
@@ -5156,7 +5159,7 @@ func (x *fastReflection_QueryParamsRequest) ProtoMethods() *protoiface.Methods {
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				x.ParamsType = string(dAtA[iNdEx:postIndex])
+				x.Voter = string(dAtA[iNdEx:postIndex])
 				iNdEx = postIndex
 			default:
 				iNdEx = preIndex
@@ -5194,31 +5197,25 @@ func (x *fastReflection_QueryParamsRequest) ProtoMethods() *protoiface.Methods {
 }
 
 var (
-	md_QueryParamsResponse                protoreflect.MessageDescriptor
-	fd_QueryParamsResponse_voting_params  protoreflect.FieldDescriptor
-	fd_QueryParamsResponse_deposit_params protoreflect.FieldDescriptor
-	fd_QueryParamsResponse_tally_params   protoreflect.FieldDescriptor
-	fd_QueryParamsResponse_params         protoreflect.FieldDescriptor
+	md_QueryVoteCommitmentResponse            protoreflect.MessageDescriptor
+	fd_QueryVoteCommitmentResponse_commitment protoreflect.FieldDescriptor
 )
 
 func init() {
 	file_cosmos_gov_v1_query_proto_init()
-	md_QueryParamsResponse = File_cosmos_gov_v1_query_proto.Messages().ByName("QueryParamsResponse")
-	fd_QueryParamsResponse_voting_params = md_QueryParamsResponse.Fields().ByName("voting_params")
-	fd_QueryParamsResponse_deposit_params = md_QueryParamsResponse.Fields().ByName("deposit_params")
-	fd_QueryParamsResponse_tally_params = md_QueryParamsResponse.Fields().ByName("tally_params")
-	fd_QueryParamsResponse_params = md_QueryParamsResponse.Fields().ByName("params")
+	md_QueryVoteCommitmentResponse = File_cosmos_gov_v1_query_proto.Messages().ByName("QueryVoteCommitmentResponse")
+	fd_QueryVoteCommitmentResponse_commitment = md_QueryVoteCommitmentResponse.Fields().ByName("commitment")
 }
 
-var _ protoreflect.Message = (*fastReflection_QueryParamsResponse)(nil)
+var _ protoreflect.Message = (*fastReflection_QueryVoteCommitmentResponse)(nil)
 
-type fastReflection_QueryParamsResponse QueryParamsResponse
+type fastReflection_QueryVoteCommitmentResponse QueryVoteCommitmentResponse
 
-func (x *QueryParamsResponse) ProtoReflect() protoreflect.Message {
-	return (*fastReflection_QueryParamsResponse)(x)
+func (x *QueryVoteCommitmentResponse) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_QueryVoteCommitmentResponse)(x)
 }
 
-func (x *QueryParamsResponse) slowProtoReflect() protoreflect.Message {
+func (x *QueryVoteCommitmentResponse) slowProtoReflect() protoreflect.Message {
 	mi := &file_cosmos_gov_v1_query_proto_msgTypes[11]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -5230,43 +5227,43 @@ func (x *QueryParamsResponse) slowProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-var _fastReflection_QueryParamsResponse_messageType fastReflection_QueryParamsResponse_messageType
-var _ protoreflect.MessageType = fastReflection_QueryParamsResponse_messageType{}
+var _fastReflection_QueryVoteCommitmentResponse_messageType fastReflection_QueryVoteCommitmentResponse_messageType
+var _ protoreflect.MessageType = fastReflection_QueryVoteCommitmentResponse_messageType{}
 
-type fastReflection_QueryParamsResponse_messageType struct{}
+type fastReflection_QueryVoteCommitmentResponse_messageType struct{}
 
-func (x fastReflection_QueryParamsResponse_messageType) Zero() protoreflect.Message {
-	return (*fastReflection_QueryParamsResponse)(nil)
+func (x fastReflection_QueryVoteCommitmentResponse_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_QueryVoteCommitmentResponse)(nil)
 }
-func (x fastReflection_QueryParamsResponse_messageType) New() protoreflect.Message {
-	return new(fastReflection_QueryParamsResponse)
+func (x fastReflection_QueryVoteCommitmentResponse_messageType) New() protoreflect.Message {
+	return new(fastReflection_QueryVoteCommitmentResponse)
 }
-func (x fastReflection_QueryParamsResponse_messageType) Descriptor() protoreflect.MessageDescriptor {
-	return md_QueryParamsResponse
+func (x fastReflection_QueryVoteCommitmentResponse_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryVoteCommitmentResponse
 }
 
 // Descriptor returns message descriptor, which contains only the protobuf
 // type information for the message.
-func (x *fastReflection_QueryParamsResponse) Descriptor() protoreflect.MessageDescriptor {
-	return md_QueryParamsResponse
+func (x *fastReflection_QueryVoteCommitmentResponse) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryVoteCommitmentResponse
 }
 
 // Type returns the message type, which encapsulates both Go and protobuf
 // type information. If the Go type information is not needed,
 // it is recommended that the message descriptor be used instead.
-func (x *fastReflection_QueryParamsResponse) Type() protoreflect.MessageType {
-	return _fastReflection_QueryParamsResponse_messageType
+func (x *fastReflection_QueryVoteCommitmentResponse) Type() protoreflect.MessageType {
+	return _fastReflection_QueryVoteCommitmentResponse_messageType
 }
 
 // New returns a newly allocated and mutable empty message.
-func (x *fastReflection_QueryParamsResponse) New() protoreflect.Message {
-	return new(fastReflection_QueryParamsResponse)
+func (x *fastReflection_QueryVoteCommitmentResponse) New() protoreflect.Message {
+	return new(fastReflection_QueryVoteCommitmentResponse)
 }
 
 // Interface unwraps the message reflection interface and
 // returns the underlying ProtoMessage interface.
-func (x *fastReflection_QueryParamsResponse) Interface() protoreflect.ProtoMessage {
-	return (*QueryParamsResponse)(x)
+func (x *fastReflection_QueryVoteCommitmentResponse) Interface() protoreflect.ProtoMessage {
+	return (*QueryVoteCommitmentResponse)(x)
 }
 
 // Range iterates over every populated field in an undefined order,
@@ -5274,28 +5271,10 @@ func (x *fastReflection_QueryParamsResponse) Interface() protoreflect.ProtoMessa
 // Range returns immediately if f returns false.
 // While iterating, mutating operations may only be performed
 // on the current field descriptor.
-func (x *fastReflection_QueryParamsResponse) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
-	if x.VotingParams != nil {
-		value := protoreflect.ValueOfMessage(x.VotingParams.ProtoReflect())
-		if !f(fd_QueryParamsResponse_voting_params, value) {
-			return
-		}
-	}
-	if x.DepositParams != nil {
-		value := protoreflect.ValueOfMessage(x.DepositParams.ProtoReflect())
-		if !f(fd_QueryParamsResponse_deposit_params, value) {
-			return
-		}
-	}
-	if x.TallyParams != nil {
-		value := protoreflect.ValueOfMessage(x.TallyParams.ProtoReflect())
-		if !f(fd_QueryParamsResponse_tally_params, value) {
-			return
-		}
-	}
-	if x.Params != nil {
-		value := protoreflect.ValueOfMessage(x.Params.ProtoReflect())
-		if !f(fd_QueryParamsResponse_params, value) {
+func (x *fastReflection_QueryVoteCommitmentResponse) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Commitment != nil {
+		value := protoreflect.ValueOfMessage(x.Commitment.ProtoReflect())
+		if !f(fd_QueryVoteCommitmentResponse_commitment, value) {
 			return
 		}
 	}
@@ -5312,21 +5291,15 @@ func (x *fastReflection_QueryParamsResponse) Range(f func(protoreflect.FieldDesc
 // In other cases (aside from the nullable cases above),
 // a proto3 scalar field is populated if it contains a non-zero value, and
 // a repeated field is populated if it is non-empty.
-func (x *fastReflection_QueryParamsResponse) Has(fd protoreflect.FieldDescriptor) bool {
+func (x *fastReflection_QueryVoteCommitmentResponse) Has(fd protoreflect.FieldDescriptor) bool {
 	switch fd.FullName() {
-	case "cosmos.gov.v1.QueryParamsResponse.voting_params":
-		return x.VotingParams != nil
-	case "cosmos.gov.v1.QueryParamsResponse.deposit_params":
-		return x.DepositParams != nil
-	case "cosmos.gov.v1.QueryParamsResponse.tally_params":
-		return x.TallyParams != nil
-	case "cosmos.gov.v1.QueryParamsResponse.params":
-		return x.Params != nil
+	case "cosmos.gov.v1.QueryVoteCommitmentResponse.commitment":
+		return x.Commitment != nil
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.gov.v1.QueryParamsResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.gov.v1.QueryVoteCommitmentResponse"))
 		}
-		panic(fmt.Errorf("message cosmos.gov.v1.QueryParamsResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.gov.v1.QueryVoteCommitmentResponse does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -5336,21 +5309,15 @@ func (x *fastReflection_QueryParamsResponse) Has(fd protoreflect.FieldDescriptor
 // associated with the given field number.
 //
 // Clear is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryParamsResponse) Clear(fd protoreflect.FieldDescriptor) {
+func (x *fastReflection_QueryVoteCommitmentResponse) Clear(fd protoreflect.FieldDescriptor) {
 	switch fd.FullName() {
-	case "cosmos.gov.v1.QueryParamsResponse.voting_params":
-		x.VotingParams = nil
-	case "cosmos.gov.v1.QueryParamsResponse.deposit_params":
-		x.DepositParams = nil
-	case "cosmos.gov.v1.QueryParamsResponse.tally_params":
-		x.TallyParams = nil
-	case "cosmos.gov.v1.QueryParamsResponse.params":
-		x.Params = nil
+	case "cosmos.gov.v1.QueryVoteCommitmentResponse.commitment":
+		x.Commitment = nil
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.gov.v1.QueryParamsResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.gov.v1.QueryVoteCommitmentResponse"))
 		}
-		panic(fmt.Errorf("message cosmos.gov.v1.QueryParamsResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.gov.v1.QueryVoteCommitmentResponse does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -5360,25 +5327,16 @@ func (x *fastReflection_QueryParamsResponse) Clear(fd protoreflect.FieldDescript
 // the default value of a bytes scalar is guaranteed to be a copy.
 // For unpopulated composite types, it returns an empty, read-only view
 // of the value; to obtain a mutable reference, use Mutable.
-func (x *fastReflection_QueryParamsResponse) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_QueryVoteCommitmentResponse) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
 	switch descriptor.FullName() {
-	case "cosmos.gov.v1.QueryParamsResponse.voting_params":
-		value := x.VotingParams
-		return protoreflect.ValueOfMessage(value.ProtoReflect())
-	case "cosmos.gov.v1.QueryParamsResponse.deposit_params":
-		value := x.DepositParams
-		return protoreflect.ValueOfMessage(value.ProtoReflect())
-	case "cosmos.gov.v1.QueryParamsResponse.tally_params":
-		value := x.TallyParams
-		return protoreflect.ValueOfMessage(value.ProtoReflect())
-	case "cosmos.gov.v1.QueryParamsResponse.params":
-		value := x.Params
+	case "cosmos.gov.v1.QueryVoteCommitmentResponse.commitment":
+		value := x.Commitment
 		return protoreflect.ValueOfMessage(value.ProtoReflect())
 	default:
 		if descriptor.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.gov.v1.QueryParamsResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.gov.v1.QueryVoteCommitmentResponse"))
 		}
-		panic(fmt.Errorf("message cosmos.gov.v1.QueryParamsResponse does not contain field %s", descriptor.FullName()))
+		panic(fmt.Errorf("message cosmos.gov.v1.QueryVoteCommitmentResponse does not contain field %s", descriptor.FullName()))
 	}
 }
 
@@ -5392,21 +5350,15 @@ func (x *fastReflection_QueryParamsResponse) Get(descriptor protoreflect.FieldDe
 // empty, read-only value, then it panics.
 //
 // Set is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryParamsResponse) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+func (x *fastReflection_QueryVoteCommitmentResponse) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
 	switch fd.FullName() {
-	case "cosmos.gov.v1.QueryParamsResponse.voting_params":
-		x.VotingParams = value.Message().Interface().(*VotingParams)
-	case "cosmos.gov.v1.QueryParamsResponse.deposit_params":
-		x.DepositParams = value.Message().Interface().(*DepositParams)
-	case "cosmos.gov.v1.QueryParamsResponse.tally_params":
-		x.TallyParams = value.Message().Interface().(*TallyParams)
-	case "cosmos.gov.v1.QueryParamsResponse.params":
-		x.Params = value.Message().Interface().(*Params)
+	case "cosmos.gov.v1.QueryVoteCommitmentResponse.commitment":
+		x.Commitment = value.Message().Interface().(*VoteCommitment)
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.gov.v1.QueryParamsResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.gov.v1.QueryVoteCommitmentResponse"))
 		}
-		panic(fmt.Errorf("message cosmos.gov.v1.QueryParamsResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.gov.v1.QueryVoteCommitmentResponse does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -5420,68 +5372,44 @@ func (x *fastReflection_QueryParamsResponse) Set(fd protoreflect.FieldDescriptor
 // It panics if the field does not contain a composite type.
 //
 // Mutable is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryParamsResponse) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_QueryVoteCommitmentResponse) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "cosmos.gov.v1.QueryParamsResponse.voting_params":
-		if x.VotingParams == nil {
-			x.VotingParams = new(VotingParams)
-		}
-		return protoreflect.ValueOfMessage(x.VotingParams.ProtoReflect())
-	case "cosmos.gov.v1.QueryParamsResponse.deposit_params":
-		if x.DepositParams == nil {
-			x.DepositParams = new(DepositParams)
-		}
-		return protoreflect.ValueOfMessage(x.DepositParams.ProtoReflect())
-	case "cosmos.gov.v1.QueryParamsResponse.tally_params":
-		if x.TallyParams == nil {
-			x.TallyParams = new(TallyParams)
-		}
-		return protoreflect.ValueOfMessage(x.TallyParams.ProtoReflect())
-	case "cosmos.gov.v1.QueryParamsResponse.params":
-		if x.Params == nil {
-			x.Params = new(Params)
+	case "cosmos.gov.v1.QueryVoteCommitmentResponse.commitment":
+		if x.Commitment == nil {
+			x.Commitment = new(VoteCommitment)
 		}
-		return protoreflect.ValueOfMessage(x.Params.ProtoReflect())
+		return protoreflect.ValueOfMessage(x.Commitment.ProtoReflect())
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.gov.v1.QueryParamsResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.gov.v1.QueryVoteCommitmentResponse"))
 		}
-		panic(fmt.Errorf("message cosmos.gov.v1.QueryParamsResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.gov.v1.QueryVoteCommitmentResponse does not contain field %s", fd.FullName()))
 	}
 }
 
 // NewField returns a new value that is assignable to the field
 // for the given descriptor. For scalars, this returns the default value.
 // For lists, maps, and messages, this returns a new, empty, mutable value.
-func (x *fastReflection_QueryParamsResponse) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_QueryVoteCommitmentResponse) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "cosmos.gov.v1.QueryParamsResponse.voting_params":
-		m := new(VotingParams)
-		return protoreflect.ValueOfMessage(m.ProtoReflect())
-	case "cosmos.gov.v1.QueryParamsResponse.deposit_params":
-		m := new(DepositParams)
-		return protoreflect.ValueOfMessage(m.ProtoReflect())
-	case "cosmos.gov.v1.QueryParamsResponse.tally_params":
-		m := new(TallyParams)
-		return protoreflect.ValueOfMessage(m.ProtoReflect())
-	case "cosmos.gov.v1.QueryParamsResponse.params":
-		m := new(Params)
+	case "cosmos.gov.v1.QueryVoteCommitmentResponse.commitment":
+		m := new(VoteCommitment)
 		return protoreflect.ValueOfMessage(m.ProtoReflect())
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.gov.v1.QueryParamsResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.gov.v1.QueryVoteCommitmentResponse"))
 		}
-		panic(fmt.Errorf("message cosmos.gov.v1.QueryParamsResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.gov.v1.QueryVoteCommitmentResponse does not contain field %s", fd.FullName()))
 	}
 }
 
 // WhichOneof reports which field within the oneof is populated,
 // returning nil if none are populated.
 // It panics if the oneof descriptor does not belong to this message.
-func (x *fastReflection_QueryParamsResponse) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+func (x *fastReflection_QueryVoteCommitmentResponse) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
 	switch d.FullName() {
 	default:
-		panic(fmt.Errorf("%s is not a oneof field in cosmos.gov.v1.QueryParamsResponse", d.FullName()))
+		panic(fmt.Errorf("%s is not a oneof field in cosmos.gov.v1.QueryVoteCommitmentResponse", d.FullName()))
 	}
 	panic("unreachable")
 }
@@ -5489,7 +5417,7 @@ func (x *fastReflection_QueryParamsResponse) WhichOneof(d protoreflect.OneofDesc
 // GetUnknown retrieves the entire list of unknown fields.
 // The caller may only mutate the contents of the RawFields
 // if the mutated bytes are stored back into the message with SetUnknown.
-func (x *fastReflection_QueryParamsResponse) GetUnknown() protoreflect.RawFields {
+func (x *fastReflection_QueryVoteCommitmentResponse) GetUnknown() protoreflect.RawFields {
 	return x.unknownFields
 }
 
@@ -5500,7 +5428,7 @@ func (x *fastReflection_QueryParamsResponse) GetUnknown() protoreflect.RawFields
 // An empty RawFields may be passed to clear the fields.
 //
 // SetUnknown is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryParamsResponse) SetUnknown(fields protoreflect.RawFields) {
+func (x *fastReflection_QueryVoteCommitmentResponse) SetUnknown(fields protoreflect.RawFields) {
 	x.unknownFields = fields
 }
 
@@ -5512,7 +5440,7 @@ func (x *fastReflection_QueryParamsResponse) SetUnknown(fields protoreflect.RawF
 // message type, but the details are implementation dependent.
 // Validity is not part of the protobuf data model, and may not
 // be preserved in marshaling or other operations.
-func (x *fastReflection_QueryParamsResponse) IsValid() bool {
+func (x *fastReflection_QueryVoteCommitmentResponse) IsValid() bool {
 	return x != nil
 }
 
@@ -5522,9 +5450,9 @@ func (x *fastReflection_QueryParamsResponse) IsValid() bool {
 // The returned methods type is identical to
 // "google.golang.org/protobuf/runtime/protoiface".Methods.
 // Consult the protoiface package documentation for details.
-func (x *fastReflection_QueryParamsResponse) ProtoMethods() *protoiface.Methods {
+func (x *fastReflection_QueryVoteCommitmentResponse) ProtoMethods() *protoiface.Methods {
 	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
-		x := input.Message.Interface().(*QueryParamsResponse)
+		x := input.Message.Interface().(*QueryVoteCommitmentResponse)
 		if x == nil {
 			return protoiface.SizeOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -5536,20 +5464,8 @@ func (x *fastReflection_QueryParamsResponse) ProtoMethods() *protoiface.Methods
 		var n int
 		var l int
 		_ = l
-		if x.VotingParams != nil {
-			l = options.Size(x.VotingParams)
-			n += 1 + l + runtime.Sov(uint64(l))
-		}
-		if x.DepositParams != nil {
-			l = options.Size(x.DepositParams)
-			n += 1 + l + runtime.Sov(uint64(l))
-		}
-		if x.TallyParams != nil {
-			l = options.Size(x.TallyParams)
-			n += 1 + l + runtime.Sov(uint64(l))
-		}
-		if x.Params != nil {
-			l = options.Size(x.Params)
+		if x.Commitment != nil {
+			l = options.Size(x.Commitment)
 			n += 1 + l + runtime.Sov(uint64(l))
 		}
 		if x.unknownFields != nil {
@@ -5562,7 +5478,7 @@ func (x *fastReflection_QueryParamsResponse) ProtoMethods() *protoiface.Methods
 	}
 
 	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
-		x := input.Message.Interface().(*QueryParamsResponse)
+		x := input.Message.Interface().(*QueryVoteCommitmentResponse)
 		if x == nil {
 			return protoiface.MarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -5581,50 +5497,8 @@ func (x *fastReflection_QueryParamsResponse) ProtoMethods() *protoiface.Methods
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
-		if x.Params != nil {
-			encoded, err := options.Marshal(x.Params)
-			if err != nil {
-				return protoiface.MarshalOutput{
-					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
-					Buf:               input.Buf,
-				}, err
-			}
-			i -= len(encoded)
-			copy(dAtA[i:], encoded)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
-			i--
-			dAtA[i] = 0x22
-		}
-		if x.TallyParams != nil {
-			encoded, err := options.Marshal(x.TallyParams)
-			if err != nil {
-				return protoiface.MarshalOutput{
-					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
-					Buf:               input.Buf,
-				}, err
-			}
-			i -= len(encoded)
-			copy(dAtA[i:], encoded)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
-			i--
-			dAtA[i] = 0x1a
-		}
-		if x.DepositParams != nil {
-			encoded, err := options.Marshal(x.DepositParams)
-			if err != nil {
-				return protoiface.MarshalOutput{
-					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
-					Buf:               input.Buf,
-				}, err
-			}
-			i -= len(encoded)
-			copy(dAtA[i:], encoded)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
-			i--
-			dAtA[i] = 0x12
-		}
-		if x.VotingParams != nil {
-			encoded, err := options.Marshal(x.VotingParams)
+		if x.Commitment != nil {
+			encoded, err := options.Marshal(x.Commitment)
 			if err != nil {
 				return protoiface.MarshalOutput{
 					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -5648,7 +5522,7 @@ func (x *fastReflection_QueryParamsResponse) ProtoMethods() *protoiface.Methods
 		}, nil
 	}
 	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
-		x := input.Message.Interface().(*QueryParamsResponse)
+		x := input.Message.Interface().(*QueryVoteCommitmentResponse)
 		if x == nil {
 			return protoiface.UnmarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -5680,15 +5554,15 @@ func (x *fastReflection_QueryParamsResponse) ProtoMethods() *protoiface.Methods
 			fieldNum := int32(wire >> 3)
 			wireType := int(wire & 0x7)
 			if wireType == 4 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryParamsResponse: wiretype end group for non-group")
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryVoteCommitmentResponse: wiretype end group for non-group")
 			}
 			if fieldNum <= 0 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryVoteCommitmentResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 			}
 			switch fieldNum {
 			case 1:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field VotingParams", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Commitment", wireType)
 				}
 				var msglen int
 				for shift := uint(0); ; shift += 7 {
@@ -5715,135 +5589,27 @@ func (x *fastReflection_QueryParamsResponse) ProtoMethods() *protoiface.Methods
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				if x.VotingParams == nil {
-					x.VotingParams = &VotingParams{}
+				if x.Commitment == nil {
+					x.Commitment = &VoteCommitment{}
 				}
-				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.VotingParams); err != nil {
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Commitment); err != nil {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
 				}
 				iNdEx = postIndex
-			case 2:
-				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field DepositParams", wireType)
-				}
-				var msglen int
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
-					}
-					if iNdEx >= l {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					msglen |= int(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				if msglen < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				postIndex := iNdEx + msglen
-				if postIndex < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				if postIndex > l {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-				}
-				if x.DepositParams == nil {
-					x.DepositParams = &DepositParams{}
-				}
-				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.DepositParams); err != nil {
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
 				}
-				iNdEx = postIndex
-			case 3:
-				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field TallyParams", wireType)
-				}
-				var msglen int
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
-					}
-					if iNdEx >= l {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					msglen |= int(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				if msglen < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				postIndex := iNdEx + msglen
-				if postIndex < 0 {
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 				}
-				if postIndex > l {
+				if (iNdEx + skippy) > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				if x.TallyParams == nil {
-					x.TallyParams = &TallyParams{}
-				}
-				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.TallyParams); err != nil {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
-				}
-				iNdEx = postIndex
-			case 4:
-				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
-				}
-				var msglen int
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
-					}
-					if iNdEx >= l {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					msglen |= int(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				if msglen < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				postIndex := iNdEx + msglen
-				if postIndex < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				if postIndex > l {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-				}
-				if x.Params == nil {
-					x.Params = &Params{}
-				}
-				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Params); err != nil {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
-				}
-				iNdEx = postIndex
-			default:
-				iNdEx = preIndex
-				skippy, err := runtime.Skip(dAtA[iNdEx:])
-				if err != nil {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
-				}
-				if (skippy < 0) || (iNdEx+skippy) < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				if (iNdEx + skippy) > l {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-				}
-				if !options.DiscardUnknown {
-					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
 				}
 				iNdEx += skippy
 			}
@@ -5866,27 +5632,25 @@ func (x *fastReflection_QueryParamsResponse) ProtoMethods() *protoiface.Methods
 }
 
 var (
-	md_QueryDepositRequest             protoreflect.MessageDescriptor
-	fd_QueryDepositRequest_proposal_id protoreflect.FieldDescriptor
-	fd_QueryDepositRequest_depositor   protoreflect.FieldDescriptor
+	md_QueryExecutionReceiptRequest             protoreflect.MessageDescriptor
+	fd_QueryExecutionReceiptRequest_proposal_id protoreflect.FieldDescriptor
 )
 
 func init() {
 	file_cosmos_gov_v1_query_proto_init()
-	md_QueryDepositRequest = File_cosmos_gov_v1_query_proto.Messages().ByName("QueryDepositRequest")
-	fd_QueryDepositRequest_proposal_id = md_QueryDepositRequest.Fields().ByName("proposal_id")
-	fd_QueryDepositRequest_depositor = md_QueryDepositRequest.Fields().ByName("depositor")
+	md_QueryExecutionReceiptRequest = File_cosmos_gov_v1_query_proto.Messages().ByName("QueryExecutionReceiptRequest")
+	fd_QueryExecutionReceiptRequest_proposal_id = md_QueryExecutionReceiptRequest.Fields().ByName("proposal_id")
 }
 
-var _ protoreflect.Message = (*fastReflection_QueryDepositRequest)(nil)
+var _ protoreflect.Message = (*fastReflection_QueryExecutionReceiptRequest)(nil)
 
-type fastReflection_QueryDepositRequest QueryDepositRequest
+type fastReflection_QueryExecutionReceiptRequest QueryExecutionReceiptRequest
 
-func (x *QueryDepositRequest) ProtoReflect() protoreflect.Message {
-	return (*fastReflection_QueryDepositRequest)(x)
+func (x *QueryExecutionReceiptRequest) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_QueryExecutionReceiptRequest)(x)
 }
 
-func (x *QueryDepositRequest) slowProtoReflect() protoreflect.Message {
+func (x *QueryExecutionReceiptRequest) slowProtoReflect() protoreflect.Message {
 	mi := &file_cosmos_gov_v1_query_proto_msgTypes[12]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -5898,43 +5662,43 @@ func (x *QueryDepositRequest) slowProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-var _fastReflection_QueryDepositRequest_messageType fastReflection_QueryDepositRequest_messageType
-var _ protoreflect.MessageType = fastReflection_QueryDepositRequest_messageType{}
+var _fastReflection_QueryExecutionReceiptRequest_messageType fastReflection_QueryExecutionReceiptRequest_messageType
+var _ protoreflect.MessageType = fastReflection_QueryExecutionReceiptRequest_messageType{}
 
-type fastReflection_QueryDepositRequest_messageType struct{}
+type fastReflection_QueryExecutionReceiptRequest_messageType struct{}
 
-func (x fastReflection_QueryDepositRequest_messageType) Zero() protoreflect.Message {
-	return (*fastReflection_QueryDepositRequest)(nil)
+func (x fastReflection_QueryExecutionReceiptRequest_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_QueryExecutionReceiptRequest)(nil)
 }
-func (x fastReflection_QueryDepositRequest_messageType) New() protoreflect.Message {
-	return new(fastReflection_QueryDepositRequest)
+func (x fastReflection_QueryExecutionReceiptRequest_messageType) New() protoreflect.Message {
+	return new(fastReflection_QueryExecutionReceiptRequest)
 }
-func (x fastReflection_QueryDepositRequest_messageType) Descriptor() protoreflect.MessageDescriptor {
-	return md_QueryDepositRequest
+func (x fastReflection_QueryExecutionReceiptRequest_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryExecutionReceiptRequest
 }
 
 // Descriptor returns message descriptor, which contains only the protobuf
 // type information for the message.
-func (x *fastReflection_QueryDepositRequest) Descriptor() protoreflect.MessageDescriptor {
-	return md_QueryDepositRequest
+func (x *fastReflection_QueryExecutionReceiptRequest) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryExecutionReceiptRequest
 }
 
 // Type returns the message type, which encapsulates both Go and protobuf
 // type information. If the Go type information is not needed,
 // it is recommended that the message descriptor be used instead.
-func (x *fastReflection_QueryDepositRequest) Type() protoreflect.MessageType {
-	return _fastReflection_QueryDepositRequest_messageType
+func (x *fastReflection_QueryExecutionReceiptRequest) Type() protoreflect.MessageType {
+	return _fastReflection_QueryExecutionReceiptRequest_messageType
 }
 
 // New returns a newly allocated and mutable empty message.
-func (x *fastReflection_QueryDepositRequest) New() protoreflect.Message {
-	return new(fastReflection_QueryDepositRequest)
+func (x *fastReflection_QueryExecutionReceiptRequest) New() protoreflect.Message {
+	return new(fastReflection_QueryExecutionReceiptRequest)
 }
 
 // Interface unwraps the message reflection interface and
 // returns the underlying ProtoMessage interface.
-func (x *fastReflection_QueryDepositRequest) Interface() protoreflect.ProtoMessage {
-	return (*QueryDepositRequest)(x)
+func (x *fastReflection_QueryExecutionReceiptRequest) Interface() protoreflect.ProtoMessage {
+	return (*QueryExecutionReceiptRequest)(x)
 }
 
 // Range iterates over every populated field in an undefined order,
@@ -5942,16 +5706,10 @@ func (x *fastReflection_QueryDepositRequest) Interface() protoreflect.ProtoMessa
 // Range returns immediately if f returns false.
 // While iterating, mutating operations may only be performed
 // on the current field descriptor.
-func (x *fastReflection_QueryDepositRequest) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+func (x *fastReflection_QueryExecutionReceiptRequest) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
 	if x.ProposalId != uint64(0) {
 		value := protoreflect.ValueOfUint64(x.ProposalId)
-		if !f(fd_QueryDepositRequest_proposal_id, value) {
-			return
-		}
-	}
-	if x.Depositor != "" {
-		value := protoreflect.ValueOfString(x.Depositor)
-		if !f(fd_QueryDepositRequest_depositor, value) {
+		if !f(fd_QueryExecutionReceiptRequest_proposal_id, value) {
 			return
 		}
 	}
@@ -5968,17 +5726,15 @@ func (x *fastReflection_QueryDepositRequest) Range(f func(protoreflect.FieldDesc
 // In other cases (aside from the nullable cases above),
 // a proto3 scalar field is populated if it contains a non-zero value, and
 // a repeated field is populated if it is non-empty.
-func (x *fastReflection_QueryDepositRequest) Has(fd protoreflect.FieldDescriptor) bool {
+func (x *fastReflection_QueryExecutionReceiptRequest) Has(fd protoreflect.FieldDescriptor) bool {
 	switch fd.FullName() {
-	case "cosmos.gov.v1.QueryDepositRequest.proposal_id":
+	case "cosmos.gov.v1.QueryExecutionReceiptRequest.proposal_id":
 		return x.ProposalId != uint64(0)
-	case "cosmos.gov.v1.QueryDepositRequest.depositor":
-		return x.Depositor != ""
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.gov.v1.QueryDepositRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.gov.v1.QueryExecutionReceiptRequest"))
 		}
-		panic(fmt.Errorf("message cosmos.gov.v1.QueryDepositRequest does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.gov.v1.QueryExecutionReceiptRequest does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -5988,17 +5744,15 @@ func (x *fastReflection_QueryDepositRequest) Has(fd protoreflect.FieldDescriptor
 // associated with the given field number.
 //
 // Clear is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryDepositRequest) Clear(fd protoreflect.FieldDescriptor) {
+func (x *fastReflection_QueryExecutionReceiptRequest) Clear(fd protoreflect.FieldDescriptor) {
 	switch fd.FullName() {
-	case "cosmos.gov.v1.QueryDepositRequest.proposal_id":
+	case "cosmos.gov.v1.QueryExecutionReceiptRequest.proposal_id":
 		x.ProposalId = uint64(0)
-	case "cosmos.gov.v1.QueryDepositRequest.depositor":
-		x.Depositor = ""
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.gov.v1.QueryDepositRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.gov.v1.QueryExecutionReceiptRequest"))
 		}
-		panic(fmt.Errorf("message cosmos.gov.v1.QueryDepositRequest does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.gov.v1.QueryExecutionReceiptRequest does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -6008,19 +5762,16 @@ func (x *fastReflection_QueryDepositRequest) Clear(fd protoreflect.FieldDescript
 // the default value of a bytes scalar is guaranteed to be a copy.
 // For unpopulated composite types, it returns an empty, read-only view
 // of the value; to obtain a mutable reference, use Mutable.
-func (x *fastReflection_QueryDepositRequest) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_QueryExecutionReceiptRequest) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
 	switch descriptor.FullName() {
-	case "cosmos.gov.v1.QueryDepositRequest.proposal_id":
+	case "cosmos.gov.v1.QueryExecutionReceiptRequest.proposal_id":
 		value := x.ProposalId
 		return protoreflect.ValueOfUint64(value)
-	case "cosmos.gov.v1.QueryDepositRequest.depositor":
-		value := x.Depositor
-		return protoreflect.ValueOfString(value)
 	default:
 		if descriptor.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.gov.v1.QueryDepositRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.gov.v1.QueryExecutionReceiptRequest"))
 		}
-		panic(fmt.Errorf("message cosmos.gov.v1.QueryDepositRequest does not contain field %s", descriptor.FullName()))
+		panic(fmt.Errorf("message cosmos.gov.v1.QueryExecutionReceiptRequest does not contain field %s", descriptor.FullName()))
 	}
 }
 
@@ -6034,17 +5785,15 @@ func (x *fastReflection_QueryDepositRequest) Get(descriptor protoreflect.FieldDe
 // empty, read-only value, then it panics.
 //
 // Set is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryDepositRequest) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+func (x *fastReflection_QueryExecutionReceiptRequest) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
 	switch fd.FullName() {
-	case "cosmos.gov.v1.QueryDepositRequest.proposal_id":
+	case "cosmos.gov.v1.QueryExecutionReceiptRequest.proposal_id":
 		x.ProposalId = value.Uint()
-	case "cosmos.gov.v1.QueryDepositRequest.depositor":
-		x.Depositor = value.Interface().(string)
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.gov.v1.QueryDepositRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.gov.v1.QueryExecutionReceiptRequest"))
 		}
-		panic(fmt.Errorf("message cosmos.gov.v1.QueryDepositRequest does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.gov.v1.QueryExecutionReceiptRequest does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -6058,44 +5807,40 @@ func (x *fastReflection_QueryDepositRequest) Set(fd protoreflect.FieldDescriptor
 // It panics if the field does not contain a composite type.
 //
 // Mutable is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryDepositRequest) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_QueryExecutionReceiptRequest) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "cosmos.gov.v1.QueryDepositRequest.proposal_id":
-		panic(fmt.Errorf("field proposal_id of message cosmos.gov.v1.QueryDepositRequest is not mutable"))
-	case "cosmos.gov.v1.QueryDepositRequest.depositor":
-		panic(fmt.Errorf("field depositor of message cosmos.gov.v1.QueryDepositRequest is not mutable"))
+	case "cosmos.gov.v1.QueryExecutionReceiptRequest.proposal_id":
+		panic(fmt.Errorf("field proposal_id of message cosmos.gov.v1.QueryExecutionReceiptRequest is not mutable"))
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.gov.v1.QueryDepositRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.gov.v1.QueryExecutionReceiptRequest"))
 		}
-		panic(fmt.Errorf("message cosmos.gov.v1.QueryDepositRequest does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.gov.v1.QueryExecutionReceiptRequest does not contain field %s", fd.FullName()))
 	}
 }
 
 // NewField returns a new value that is assignable to the field
 // for the given descriptor. For scalars, this returns the default value.
 // For lists, maps, and messages, this returns a new, empty, mutable value.
-func (x *fastReflection_QueryDepositRequest) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_QueryExecutionReceiptRequest) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "cosmos.gov.v1.QueryDepositRequest.proposal_id":
+	case "cosmos.gov.v1.QueryExecutionReceiptRequest.proposal_id":
 		return protoreflect.ValueOfUint64(uint64(0))
-	case "cosmos.gov.v1.QueryDepositRequest.depositor":
-		return protoreflect.ValueOfString("")
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.gov.v1.QueryDepositRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.gov.v1.QueryExecutionReceiptRequest"))
 		}
-		panic(fmt.Errorf("message cosmos.gov.v1.QueryDepositRequest does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.gov.v1.QueryExecutionReceiptRequest does not contain field %s", fd.FullName()))
 	}
 }
 
 // WhichOneof reports which field within the oneof is populated,
 // returning nil if none are populated.
 // It panics if the oneof descriptor does not belong to this message.
-func (x *fastReflection_QueryDepositRequest) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+func (x *fastReflection_QueryExecutionReceiptRequest) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
 	switch d.FullName() {
 	default:
-		panic(fmt.Errorf("%s is not a oneof field in cosmos.gov.v1.QueryDepositRequest", d.FullName()))
+		panic(fmt.Errorf("%s is not a oneof field in cosmos.gov.v1.QueryExecutionReceiptRequest", d.FullName()))
 	}
 	panic("unreachable")
 }
@@ -6103,7 +5848,7 @@ func (x *fastReflection_QueryDepositRequest) WhichOneof(d protoreflect.OneofDesc
 // GetUnknown retrieves the entire list of unknown fields.
 // The caller may only mutate the contents of the RawFields
 // if the mutated bytes are stored back into the message with SetUnknown.
-func (x *fastReflection_QueryDepositRequest) GetUnknown() protoreflect.RawFields {
+func (x *fastReflection_QueryExecutionReceiptRequest) GetUnknown() protoreflect.RawFields {
 	return x.unknownFields
 }
 
@@ -6114,7 +5859,7 @@ func (x *fastReflection_QueryDepositRequest) GetUnknown() protoreflect.RawFields
 // An empty RawFields may be passed to clear the fields.
 //
 // SetUnknown is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryDepositRequest) SetUnknown(fields protoreflect.RawFields) {
+func (x *fastReflection_QueryExecutionReceiptRequest) SetUnknown(fields protoreflect.RawFields) {
 	x.unknownFields = fields
 }
 
@@ -6126,7 +5871,7 @@ func (x *fastReflection_QueryDepositRequest) SetUnknown(fields protoreflect.RawF
 // message type, but the details are implementation dependent.
 // Validity is not part of the protobuf data model, and may not
 // be preserved in marshaling or other operations.
-func (x *fastReflection_QueryDepositRequest) IsValid() bool {
+func (x *fastReflection_QueryExecutionReceiptRequest) IsValid() bool {
 	return x != nil
 }
 
@@ -6136,9 +5881,9 @@ func (x *fastReflection_QueryDepositRequest) IsValid() bool {
 // The returned methods type is identical to
 // "google.golang.org/protobuf/runtime/protoiface".Methods.
 // Consult the protoiface package documentation for details.
-func (x *fastReflection_QueryDepositRequest) ProtoMethods() *protoiface.Methods {
+func (x *fastReflection_QueryExecutionReceiptRequest) ProtoMethods() *protoiface.Methods {
 	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
-		x := input.Message.Interface().(*QueryDepositRequest)
+		x := input.Message.Interface().(*QueryExecutionReceiptRequest)
 		if x == nil {
 			return protoiface.SizeOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -6153,10 +5898,6 @@ func (x *fastReflection_QueryDepositRequest) ProtoMethods() *protoiface.Methods
 		if x.ProposalId != 0 {
 			n += 1 + runtime.Sov(uint64(x.ProposalId))
 		}
-		l = len(x.Depositor)
-		if l > 0 {
-			n += 1 + l + runtime.Sov(uint64(l))
-		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
 		}
@@ -6167,7 +5908,7 @@ func (x *fastReflection_QueryDepositRequest) ProtoMethods() *protoiface.Methods
 	}
 
 	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
-		x := input.Message.Interface().(*QueryDepositRequest)
+		x := input.Message.Interface().(*QueryExecutionReceiptRequest)
 		if x == nil {
 			return protoiface.MarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -6186,13 +5927,6 @@ func (x *fastReflection_QueryDepositRequest) ProtoMethods() *protoiface.Methods
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
-		if len(x.Depositor) > 0 {
-			i -= len(x.Depositor)
-			copy(dAtA[i:], x.Depositor)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Depositor)))
-			i--
-			dAtA[i] = 0x12
-		}
 		if x.ProposalId != 0 {
 			i = runtime.EncodeVarint(dAtA, i, uint64(x.ProposalId))
 			i--
@@ -6209,7 +5943,7 @@ func (x *fastReflection_QueryDepositRequest) ProtoMethods() *protoiface.Methods
 		}, nil
 	}
 	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
-		x := input.Message.Interface().(*QueryDepositRequest)
+		x := input.Message.Interface().(*QueryExecutionReceiptRequest)
 		if x == nil {
 			return protoiface.UnmarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -6241,10 +5975,10 @@ func (x *fastReflection_QueryDepositRequest) ProtoMethods() *protoiface.Methods
 			fieldNum := int32(wire >> 3)
 			wireType := int(wire & 0x7)
 			if wireType == 4 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryDepositRequest: wiretype end group for non-group")
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryExecutionReceiptRequest: wiretype end group for non-group")
 			}
 			if fieldNum <= 0 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryDepositRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryExecutionReceiptRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 			}
 			switch fieldNum {
 			case 1:
@@ -6266,38 +6000,6 @@ func (x *fastReflection_QueryDepositRequest) ProtoMethods() *protoiface.Methods
 						break
 					}
 				}
-			case 2:
-				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Depositor", wireType)
-				}
-				var stringLen uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
-					}
-					if iNdEx >= l {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					stringLen |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				intStringLen := int(stringLen)
-				if intStringLen < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				postIndex := iNdEx + intStringLen
-				if postIndex < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				if postIndex > l {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-				}
-				x.Depositor = string(dAtA[iNdEx:postIndex])
-				iNdEx = postIndex
 			default:
 				iNdEx = preIndex
 				skippy, err := runtime.Skip(dAtA[iNdEx:])
@@ -6334,25 +6036,25 @@ func (x *fastReflection_QueryDepositRequest) ProtoMethods() *protoiface.Methods
 }
 
 var (
-	md_QueryDepositResponse         protoreflect.MessageDescriptor
-	fd_QueryDepositResponse_deposit protoreflect.FieldDescriptor
+	md_QueryExecutionReceiptResponse         protoreflect.MessageDescriptor
+	fd_QueryExecutionReceiptResponse_receipt protoreflect.FieldDescriptor
 )
 
 func init() {
 	file_cosmos_gov_v1_query_proto_init()
-	md_QueryDepositResponse = File_cosmos_gov_v1_query_proto.Messages().ByName("QueryDepositResponse")
-	fd_QueryDepositResponse_deposit = md_QueryDepositResponse.Fields().ByName("deposit")
+	md_QueryExecutionReceiptResponse = File_cosmos_gov_v1_query_proto.Messages().ByName("QueryExecutionReceiptResponse")
+	fd_QueryExecutionReceiptResponse_receipt = md_QueryExecutionReceiptResponse.Fields().ByName("receipt")
 }
 
-var _ protoreflect.Message = (*fastReflection_QueryDepositResponse)(nil)
+var _ protoreflect.Message = (*fastReflection_QueryExecutionReceiptResponse)(nil)
 
-type fastReflection_QueryDepositResponse QueryDepositResponse
+type fastReflection_QueryExecutionReceiptResponse QueryExecutionReceiptResponse
 
-func (x *QueryDepositResponse) ProtoReflect() protoreflect.Message {
-	return (*fastReflection_QueryDepositResponse)(x)
+func (x *QueryExecutionReceiptResponse) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_QueryExecutionReceiptResponse)(x)
 }
 
-func (x *QueryDepositResponse) slowProtoReflect() protoreflect.Message {
+func (x *QueryExecutionReceiptResponse) slowProtoReflect() protoreflect.Message {
 	mi := &file_cosmos_gov_v1_query_proto_msgTypes[13]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -6364,43 +6066,43 @@ func (x *QueryDepositResponse) slowProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-var _fastReflection_QueryDepositResponse_messageType fastReflection_QueryDepositResponse_messageType
-var _ protoreflect.MessageType = fastReflection_QueryDepositResponse_messageType{}
+var _fastReflection_QueryExecutionReceiptResponse_messageType fastReflection_QueryExecutionReceiptResponse_messageType
+var _ protoreflect.MessageType = fastReflection_QueryExecutionReceiptResponse_messageType{}
 
-type fastReflection_QueryDepositResponse_messageType struct{}
+type fastReflection_QueryExecutionReceiptResponse_messageType struct{}
 
-func (x fastReflection_QueryDepositResponse_messageType) Zero() protoreflect.Message {
-	return (*fastReflection_QueryDepositResponse)(nil)
+func (x fastReflection_QueryExecutionReceiptResponse_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_QueryExecutionReceiptResponse)(nil)
 }
-func (x fastReflection_QueryDepositResponse_messageType) New() protoreflect.Message {
-	return new(fastReflection_QueryDepositResponse)
+func (x fastReflection_QueryExecutionReceiptResponse_messageType) New() protoreflect.Message {
+	return new(fastReflection_QueryExecutionReceiptResponse)
 }
-func (x fastReflection_QueryDepositResponse_messageType) Descriptor() protoreflect.MessageDescriptor {
-	return md_QueryDepositResponse
+func (x fastReflection_QueryExecutionReceiptResponse_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryExecutionReceiptResponse
 }
 
 // Descriptor returns message descriptor, which contains only the protobuf
 // type information for the message.
-func (x *fastReflection_QueryDepositResponse) Descriptor() protoreflect.MessageDescriptor {
-	return md_QueryDepositResponse
+func (x *fastReflection_QueryExecutionReceiptResponse) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryExecutionReceiptResponse
 }
 
 // Type returns the message type, which encapsulates both Go and protobuf
 // type information. If the Go type information is not needed,
 // it is recommended that the message descriptor be used instead.
-func (x *fastReflection_QueryDepositResponse) Type() protoreflect.MessageType {
-	return _fastReflection_QueryDepositResponse_messageType
+func (x *fastReflection_QueryExecutionReceiptResponse) Type() protoreflect.MessageType {
+	return _fastReflection_QueryExecutionReceiptResponse_messageType
 }
 
 // New returns a newly allocated and mutable empty message.
-func (x *fastReflection_QueryDepositResponse) New() protoreflect.Message {
-	return new(fastReflection_QueryDepositResponse)
+func (x *fastReflection_QueryExecutionReceiptResponse) New() protoreflect.Message {
+	return new(fastReflection_QueryExecutionReceiptResponse)
 }
 
 // Interface unwraps the message reflection interface and
 // returns the underlying ProtoMessage interface.
-func (x *fastReflection_QueryDepositResponse) Interface() protoreflect.ProtoMessage {
-	return (*QueryDepositResponse)(x)
+func (x *fastReflection_QueryExecutionReceiptResponse) Interface() protoreflect.ProtoMessage {
+	return (*QueryExecutionReceiptResponse)(x)
 }
 
 // Range iterates over every populated field in an undefined order,
@@ -6408,10 +6110,10 @@ func (x *fastReflection_QueryDepositResponse) Interface() protoreflect.ProtoMess
 // Range returns immediately if f returns false.
 // While iterating, mutating operations may only be performed
 // on the current field descriptor.
-func (x *fastReflection_QueryDepositResponse) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
-	if x.Deposit != nil {
-		value := protoreflect.ValueOfMessage(x.Deposit.ProtoReflect())
-		if !f(fd_QueryDepositResponse_deposit, value) {
+func (x *fastReflection_QueryExecutionReceiptResponse) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Receipt != nil {
+		value := protoreflect.ValueOfMessage(x.Receipt.ProtoReflect())
+		if !f(fd_QueryExecutionReceiptResponse_receipt, value) {
 			return
 		}
 	}
@@ -6428,15 +6130,15 @@ func (x *fastReflection_QueryDepositResponse) Range(f func(protoreflect.FieldDes
 // In other cases (aside from the nullable cases above),
 // a proto3 scalar field is populated if it contains a non-zero value, and
 // a repeated field is populated if it is non-empty.
-func (x *fastReflection_QueryDepositResponse) Has(fd protoreflect.FieldDescriptor) bool {
+func (x *fastReflection_QueryExecutionReceiptResponse) Has(fd protoreflect.FieldDescriptor) bool {
 	switch fd.FullName() {
-	case "cosmos.gov.v1.QueryDepositResponse.deposit":
-		return x.Deposit != nil
+	case "cosmos.gov.v1.QueryExecutionReceiptResponse.receipt":
+		return x.Receipt != nil
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.gov.v1.QueryDepositResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.gov.v1.QueryExecutionReceiptResponse"))
 		}
-		panic(fmt.Errorf("message cosmos.gov.v1.QueryDepositResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.gov.v1.QueryExecutionReceiptResponse does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -6446,15 +6148,15 @@ func (x *fastReflection_QueryDepositResponse) Has(fd protoreflect.FieldDescripto
 // associated with the given field number.
 //
 // Clear is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryDepositResponse) Clear(fd protoreflect.FieldDescriptor) {
+func (x *fastReflection_QueryExecutionReceiptResponse) Clear(fd protoreflect.FieldDescriptor) {
 	switch fd.FullName() {
-	case "cosmos.gov.v1.QueryDepositResponse.deposit":
-		x.Deposit = nil
+	case "cosmos.gov.v1.QueryExecutionReceiptResponse.receipt":
+		x.Receipt = nil
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.gov.v1.QueryDepositResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.gov.v1.QueryExecutionReceiptResponse"))
 		}
-		panic(fmt.Errorf("message cosmos.gov.v1.QueryDepositResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.gov.v1.QueryExecutionReceiptResponse does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -6464,16 +6166,16 @@ func (x *fastReflection_QueryDepositResponse) Clear(fd protoreflect.FieldDescrip
 // the default value of a bytes scalar is guaranteed to be a copy.
 // For unpopulated composite types, it returns an empty, read-only view
 // of the value; to obtain a mutable reference, use Mutable.
-func (x *fastReflection_QueryDepositResponse) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_QueryExecutionReceiptResponse) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
 	switch descriptor.FullName() {
-	case "cosmos.gov.v1.QueryDepositResponse.deposit":
-		value := x.Deposit
+	case "cosmos.gov.v1.QueryExecutionReceiptResponse.receipt":
+		value := x.Receipt
 		return protoreflect.ValueOfMessage(value.ProtoReflect())
 	default:
 		if descriptor.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.gov.v1.QueryDepositResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.gov.v1.QueryExecutionReceiptResponse"))
 		}
-		panic(fmt.Errorf("message cosmos.gov.v1.QueryDepositResponse does not contain field %s", descriptor.FullName()))
+		panic(fmt.Errorf("message cosmos.gov.v1.QueryExecutionReceiptResponse does not contain field %s", descriptor.FullName()))
 	}
 }
 
@@ -6487,15 +6189,15 @@ func (x *fastReflection_QueryDepositResponse) Get(descriptor protoreflect.FieldD
 // empty, read-only value, then it panics.
 //
 // Set is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryDepositResponse) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+func (x *fastReflection_QueryExecutionReceiptResponse) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
 	switch fd.FullName() {
-	case "cosmos.gov.v1.QueryDepositResponse.deposit":
-		x.Deposit = value.Message().Interface().(*Deposit)
+	case "cosmos.gov.v1.QueryExecutionReceiptResponse.receipt":
+		x.Receipt = value.Message().Interface().(*ProposalExecutionReceipt)
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.gov.v1.QueryDepositResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.gov.v1.QueryExecutionReceiptResponse"))
 		}
-		panic(fmt.Errorf("message cosmos.gov.v1.QueryDepositResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.gov.v1.QueryExecutionReceiptResponse does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -6509,44 +6211,44 @@ func (x *fastReflection_QueryDepositResponse) Set(fd protoreflect.FieldDescripto
 // It panics if the field does not contain a composite type.
 //
 // Mutable is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryDepositResponse) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_QueryExecutionReceiptResponse) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "cosmos.gov.v1.QueryDepositResponse.deposit":
-		if x.Deposit == nil {
-			x.Deposit = new(Deposit)
+	case "cosmos.gov.v1.QueryExecutionReceiptResponse.receipt":
+		if x.Receipt == nil {
+			x.Receipt = new(ProposalExecutionReceipt)
 		}
-		return protoreflect.ValueOfMessage(x.Deposit.ProtoReflect())
+		return protoreflect.ValueOfMessage(x.Receipt.ProtoReflect())
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.gov.v1.QueryDepositResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.gov.v1.QueryExecutionReceiptResponse"))
 		}
-		panic(fmt.Errorf("message cosmos.gov.v1.QueryDepositResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.gov.v1.QueryExecutionReceiptResponse does not contain field %s", fd.FullName()))
 	}
 }
 
 // NewField returns a new value that is assignable to the field
 // for the given descriptor. For scalars, this returns the default value.
 // For lists, maps, and messages, this returns a new, empty, mutable value.
-func (x *fastReflection_QueryDepositResponse) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_QueryExecutionReceiptResponse) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "cosmos.gov.v1.QueryDepositResponse.deposit":
-		m := new(Deposit)
+	case "cosmos.gov.v1.QueryExecutionReceiptResponse.receipt":
+		m := new(ProposalExecutionReceipt)
 		return protoreflect.ValueOfMessage(m.ProtoReflect())
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.gov.v1.QueryDepositResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.gov.v1.QueryExecutionReceiptResponse"))
 		}
-		panic(fmt.Errorf("message cosmos.gov.v1.QueryDepositResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.gov.v1.QueryExecutionReceiptResponse does not contain field %s", fd.FullName()))
 	}
 }
 
 // WhichOneof reports which field within the oneof is populated,
 // returning nil if none are populated.
 // It panics if the oneof descriptor does not belong to this message.
-func (x *fastReflection_QueryDepositResponse) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+func (x *fastReflection_QueryExecutionReceiptResponse) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
 	switch d.FullName() {
 	default:
-		panic(fmt.Errorf("%s is not a oneof field in cosmos.gov.v1.QueryDepositResponse", d.FullName()))
+		panic(fmt.Errorf("%s is not a oneof field in cosmos.gov.v1.QueryExecutionReceiptResponse", d.FullName()))
 	}
 	panic("unreachable")
 }
@@ -6554,7 +6256,7 @@ func (x *fastReflection_QueryDepositResponse) WhichOneof(d protoreflect.OneofDes
 // GetUnknown retrieves the entire list of unknown fields.
 // The caller may only mutate the contents of the RawFields
 // if the mutated bytes are stored back into the message with SetUnknown.
-func (x *fastReflection_QueryDepositResponse) GetUnknown() protoreflect.RawFields {
+func (x *fastReflection_QueryExecutionReceiptResponse) GetUnknown() protoreflect.RawFields {
 	return x.unknownFields
 }
 
@@ -6565,7 +6267,7 @@ func (x *fastReflection_QueryDepositResponse) GetUnknown() protoreflect.RawField
 // An empty RawFields may be passed to clear the fields.
 //
 // SetUnknown is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryDepositResponse) SetUnknown(fields protoreflect.RawFields) {
+func (x *fastReflection_QueryExecutionReceiptResponse) SetUnknown(fields protoreflect.RawFields) {
 	x.unknownFields = fields
 }
 
@@ -6577,7 +6279,7 @@ func (x *fastReflection_QueryDepositResponse) SetUnknown(fields protoreflect.Raw
 // message type, but the details are implementation dependent.
 // Validity is not part of the protobuf data model, and may not
 // be preserved in marshaling or other operations.
-func (x *fastReflection_QueryDepositResponse) IsValid() bool {
+func (x *fastReflection_QueryExecutionReceiptResponse) IsValid() bool {
 	return x != nil
 }
 
@@ -6587,9 +6289,9 @@ func (x *fastReflection_QueryDepositResponse) IsValid() bool {
 // The returned methods type is identical to
 // "google.golang.org/protobuf/runtime/protoiface".Methods.
 // Consult the protoiface package documentation for details.
-func (x *fastReflection_QueryDepositResponse) ProtoMethods() *protoiface.Methods {
+func (x *fastReflection_QueryExecutionReceiptResponse) ProtoMethods() *protoiface.Methods {
 	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
-		x := input.Message.Interface().(*QueryDepositResponse)
+		x := input.Message.Interface().(*QueryExecutionReceiptResponse)
 		if x == nil {
 			return protoiface.SizeOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -6601,8 +6303,8 @@ func (x *fastReflection_QueryDepositResponse) ProtoMethods() *protoiface.Methods
 		var n int
 		var l int
 		_ = l
-		if x.Deposit != nil {
-			l = options.Size(x.Deposit)
+		if x.Receipt != nil {
+			l = options.Size(x.Receipt)
 			n += 1 + l + runtime.Sov(uint64(l))
 		}
 		if x.unknownFields != nil {
@@ -6615,7 +6317,7 @@ func (x *fastReflection_QueryDepositResponse) ProtoMethods() *protoiface.Methods
 	}
 
 	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
-		x := input.Message.Interface().(*QueryDepositResponse)
+		x := input.Message.Interface().(*QueryExecutionReceiptResponse)
 		if x == nil {
 			return protoiface.MarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -6634,8 +6336,8 @@ func (x *fastReflection_QueryDepositResponse) ProtoMethods() *protoiface.Methods
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
-		if x.Deposit != nil {
-			encoded, err := options.Marshal(x.Deposit)
+		if x.Receipt != nil {
+			encoded, err := options.Marshal(x.Receipt)
 			if err != nil {
 				return protoiface.MarshalOutput{
 					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -6659,7 +6361,7 @@ func (x *fastReflection_QueryDepositResponse) ProtoMethods() *protoiface.Methods
 		}, nil
 	}
 	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
-		x := input.Message.Interface().(*QueryDepositResponse)
+		x := input.Message.Interface().(*QueryExecutionReceiptResponse)
 		if x == nil {
 			return protoiface.UnmarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -6691,15 +6393,15 @@ func (x *fastReflection_QueryDepositResponse) ProtoMethods() *protoiface.Methods
 			fieldNum := int32(wire >> 3)
 			wireType := int(wire & 0x7)
 			if wireType == 4 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryDepositResponse: wiretype end group for non-group")
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryExecutionReceiptResponse: wiretype end group for non-group")
 			}
 			if fieldNum <= 0 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryDepositResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryExecutionReceiptResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 			}
 			switch fieldNum {
 			case 1:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Deposit", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Receipt", wireType)
 				}
 				var msglen int
 				for shift := uint(0); ; shift += 7 {
@@ -6726,10 +6428,10 @@ func (x *fastReflection_QueryDepositResponse) ProtoMethods() *protoiface.Methods
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				if x.Deposit == nil {
-					x.Deposit = &Deposit{}
+				if x.Receipt == nil {
+					x.Receipt = &ProposalExecutionReceipt{}
 				}
-				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Deposit); err != nil {
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Receipt); err != nil {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
 				}
 				iNdEx = postIndex
@@ -6769,27 +6471,27 @@ func (x *fastReflection_QueryDepositResponse) ProtoMethods() *protoiface.Methods
 }
 
 var (
-	md_QueryDepositsRequest             protoreflect.MessageDescriptor
-	fd_QueryDepositsRequest_proposal_id protoreflect.FieldDescriptor
-	fd_QueryDepositsRequest_pagination  protoreflect.FieldDescriptor
+	md_QueryVotesRequest             protoreflect.MessageDescriptor
+	fd_QueryVotesRequest_proposal_id protoreflect.FieldDescriptor
+	fd_QueryVotesRequest_pagination  protoreflect.FieldDescriptor
 )
 
 func init() {
 	file_cosmos_gov_v1_query_proto_init()
-	md_QueryDepositsRequest = File_cosmos_gov_v1_query_proto.Messages().ByName("QueryDepositsRequest")
-	fd_QueryDepositsRequest_proposal_id = md_QueryDepositsRequest.Fields().ByName("proposal_id")
-	fd_QueryDepositsRequest_pagination = md_QueryDepositsRequest.Fields().ByName("pagination")
+	md_QueryVotesRequest = File_cosmos_gov_v1_query_proto.Messages().ByName("QueryVotesRequest")
+	fd_QueryVotesRequest_proposal_id = md_QueryVotesRequest.Fields().ByName("proposal_id")
+	fd_QueryVotesRequest_pagination = md_QueryVotesRequest.Fields().ByName("pagination")
 }
 
-var _ protoreflect.Message = (*fastReflection_QueryDepositsRequest)(nil)
+var _ protoreflect.Message = (*fastReflection_QueryVotesRequest)(nil)
 
-type fastReflection_QueryDepositsRequest QueryDepositsRequest
+type fastReflection_QueryVotesRequest QueryVotesRequest
 
-func (x *QueryDepositsRequest) ProtoReflect() protoreflect.Message {
-	return (*fastReflection_QueryDepositsRequest)(x)
+func (x *QueryVotesRequest) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_QueryVotesRequest)(x)
 }
 
-func (x *QueryDepositsRequest) slowProtoReflect() protoreflect.Message {
+func (x *QueryVotesRequest) slowProtoReflect() protoreflect.Message {
 	mi := &file_cosmos_gov_v1_query_proto_msgTypes[14]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -6801,43 +6503,43 @@ func (x *QueryDepositsRequest) slowProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-var _fastReflection_QueryDepositsRequest_messageType fastReflection_QueryDepositsRequest_messageType
-var _ protoreflect.MessageType = fastReflection_QueryDepositsRequest_messageType{}
+var _fastReflection_QueryVotesRequest_messageType fastReflection_QueryVotesRequest_messageType
+var _ protoreflect.MessageType = fastReflection_QueryVotesRequest_messageType{}
 
-type fastReflection_QueryDepositsRequest_messageType struct{}
+type fastReflection_QueryVotesRequest_messageType struct{}
 
-func (x fastReflection_QueryDepositsRequest_messageType) Zero() protoreflect.Message {
-	return (*fastReflection_QueryDepositsRequest)(nil)
+func (x fastReflection_QueryVotesRequest_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_QueryVotesRequest)(nil)
 }
-func (x fastReflection_QueryDepositsRequest_messageType) New() protoreflect.Message {
-	return new(fastReflection_QueryDepositsRequest)
+func (x fastReflection_QueryVotesRequest_messageType) New() protoreflect.Message {
+	return new(fastReflection_QueryVotesRequest)
 }
-func (x fastReflection_QueryDepositsRequest_messageType) Descriptor() protoreflect.MessageDescriptor {
-	return md_QueryDepositsRequest
+func (x fastReflection_QueryVotesRequest_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryVotesRequest
 }
 
 // Descriptor returns message descriptor, which contains only the protobuf
 // type information for the message.
-func (x *fastReflection_QueryDepositsRequest) Descriptor() protoreflect.MessageDescriptor {
-	return md_QueryDepositsRequest
+func (x *fastReflection_QueryVotesRequest) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryVotesRequest
 }
 
 // Type returns the message type, which encapsulates both Go and protobuf
 // type information. If the Go type information is not needed,
 // it is recommended that the message descriptor be used instead.
-func (x *fastReflection_QueryDepositsRequest) Type() protoreflect.MessageType {
-	return _fastReflection_QueryDepositsRequest_messageType
+func (x *fastReflection_QueryVotesRequest) Type() protoreflect.MessageType {
+	return _fastReflection_QueryVotesRequest_messageType
 }
 
 // New returns a newly allocated and mutable empty message.
-func (x *fastReflection_QueryDepositsRequest) New() protoreflect.Message {
-	return new(fastReflection_QueryDepositsRequest)
+func (x *fastReflection_QueryVotesRequest) New() protoreflect.Message {
+	return new(fastReflection_QueryVotesRequest)
 }
 
 // Interface unwraps the message reflection interface and
 // returns the underlying ProtoMessage interface.
-func (x *fastReflection_QueryDepositsRequest) Interface() protoreflect.ProtoMessage {
-	return (*QueryDepositsRequest)(x)
+func (x *fastReflection_QueryVotesRequest) Interface() protoreflect.ProtoMessage {
+	return (*QueryVotesRequest)(x)
 }
 
 // Range iterates over every populated field in an undefined order,
@@ -6845,16 +6547,16 @@ func (x *fastReflection_QueryDepositsRequest) Interface() protoreflect.ProtoMess
 // Range returns immediately if f returns false.
 // While iterating, mutating operations may only be performed
 // on the current field descriptor.
-func (x *fastReflection_QueryDepositsRequest) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+func (x *fastReflection_QueryVotesRequest) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
 	if x.ProposalId != uint64(0) {
 		value := protoreflect.ValueOfUint64(x.ProposalId)
-		if !f(fd_QueryDepositsRequest_proposal_id, value) {
+		if !f(fd_QueryVotesRequest_proposal_id, value) {
 			return
 		}
 	}
 	if x.Pagination != nil {
 		value := protoreflect.ValueOfMessage(x.Pagination.ProtoReflect())
-		if !f(fd_QueryDepositsRequest_pagination, value) {
+		if !f(fd_QueryVotesRequest_pagination, value) {
 			return
 		}
 	}
@@ -6871,17 +6573,17 @@ func (x *fastReflection_QueryDepositsRequest) Range(f func(protoreflect.FieldDes
 // In other cases (aside from the nullable cases above),
 // a proto3 scalar field is populated if it contains a non-zero value, and
 // a repeated field is populated if it is non-empty.
-func (x *fastReflection_QueryDepositsRequest) Has(fd protoreflect.FieldDescriptor) bool {
+func (x *fastReflection_QueryVotesRequest) Has(fd protoreflect.FieldDescriptor) bool {
 	switch fd.FullName() {
-	case "cosmos.gov.v1.QueryDepositsRequest.proposal_id":
+	case "cosmos.gov.v1.QueryVotesRequest.proposal_id":
 		return x.ProposalId != uint64(0)
-	case "cosmos.gov.v1.QueryDepositsRequest.pagination":
+	case "cosmos.gov.v1.QueryVotesRequest.pagination":
 		return x.Pagination != nil
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.gov.v1.QueryDepositsRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.gov.v1.QueryVotesRequest"))
 		}
-		panic(fmt.Errorf("message cosmos.gov.v1.QueryDepositsRequest does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.gov.v1.QueryVotesRequest does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -6891,17 +6593,17 @@ func (x *fastReflection_QueryDepositsRequest) Has(fd protoreflect.FieldDescripto
 // associated with the given field number.
 //
 // Clear is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryDepositsRequest) Clear(fd protoreflect.FieldDescriptor) {
+func (x *fastReflection_QueryVotesRequest) Clear(fd protoreflect.FieldDescriptor) {
 	switch fd.FullName() {
-	case "cosmos.gov.v1.QueryDepositsRequest.proposal_id":
+	case "cosmos.gov.v1.QueryVotesRequest.proposal_id":
 		x.ProposalId = uint64(0)
-	case "cosmos.gov.v1.QueryDepositsRequest.pagination":
+	case "cosmos.gov.v1.QueryVotesRequest.pagination":
 		x.Pagination = nil
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.gov.v1.QueryDepositsRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.gov.v1.QueryVotesRequest"))
 		}
-		panic(fmt.Errorf("message cosmos.gov.v1.QueryDepositsRequest does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.gov.v1.QueryVotesRequest does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -6911,19 +6613,19 @@ func (x *fastReflection_QueryDepositsRequest) Clear(fd protoreflect.FieldDescrip
 // the default value of a bytes scalar is guaranteed to be a copy.
 // For unpopulated composite types, it returns an empty, read-only view
 // of the value; to obtain a mutable reference, use Mutable.
-func (x *fastReflection_QueryDepositsRequest) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_QueryVotesRequest) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
 	switch descriptor.FullName() {
-	case "cosmos.gov.v1.QueryDepositsRequest.proposal_id":
+	case "cosmos.gov.v1.QueryVotesRequest.proposal_id":
 		value := x.ProposalId
 		return protoreflect.ValueOfUint64(value)
-	case "cosmos.gov.v1.QueryDepositsRequest.pagination":
+	case "cosmos.gov.v1.QueryVotesRequest.pagination":
 		value := x.Pagination
 		return protoreflect.ValueOfMessage(value.ProtoReflect())
 	default:
 		if descriptor.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.gov.v1.QueryDepositsRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.gov.v1.QueryVotesRequest"))
 		}
-		panic(fmt.Errorf("message cosmos.gov.v1.QueryDepositsRequest does not contain field %s", descriptor.FullName()))
+		panic(fmt.Errorf("message cosmos.gov.v1.QueryVotesRequest does not contain field %s", descriptor.FullName()))
 	}
 }
 
@@ -6937,17 +6639,17 @@ func (x *fastReflection_QueryDepositsRequest) Get(descriptor protoreflect.FieldD
 // empty, read-only value, then it panics.
 //
 // Set is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryDepositsRequest) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+func (x *fastReflection_QueryVotesRequest) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
 	switch fd.FullName() {
-	case "cosmos.gov.v1.QueryDepositsRequest.proposal_id":
+	case "cosmos.gov.v1.QueryVotesRequest.proposal_id":
 		x.ProposalId = value.Uint()
-	case "cosmos.gov.v1.QueryDepositsRequest.pagination":
+	case "cosmos.gov.v1.QueryVotesRequest.pagination":
 		x.Pagination = value.Message().Interface().(*v1beta1.PageRequest)
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.gov.v1.QueryDepositsRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.gov.v1.QueryVotesRequest"))
 		}
-		panic(fmt.Errorf("message cosmos.gov.v1.QueryDepositsRequest does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.gov.v1.QueryVotesRequest does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -6961,48 +6663,48 @@ func (x *fastReflection_QueryDepositsRequest) Set(fd protoreflect.FieldDescripto
 // It panics if the field does not contain a composite type.
 //
 // Mutable is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryDepositsRequest) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_QueryVotesRequest) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "cosmos.gov.v1.QueryDepositsRequest.pagination":
+	case "cosmos.gov.v1.QueryVotesRequest.pagination":
 		if x.Pagination == nil {
 			x.Pagination = new(v1beta1.PageRequest)
 		}
 		return protoreflect.ValueOfMessage(x.Pagination.ProtoReflect())
-	case "cosmos.gov.v1.QueryDepositsRequest.proposal_id":
-		panic(fmt.Errorf("field proposal_id of message cosmos.gov.v1.QueryDepositsRequest is not mutable"))
+	case "cosmos.gov.v1.QueryVotesRequest.proposal_id":
+		panic(fmt.Errorf("field proposal_id of message cosmos.gov.v1.QueryVotesRequest is not mutable"))
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.gov.v1.QueryDepositsRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.gov.v1.QueryVotesRequest"))
 		}
-		panic(fmt.Errorf("message cosmos.gov.v1.QueryDepositsRequest does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.gov.v1.QueryVotesRequest does not contain field %s", fd.FullName()))
 	}
 }
 
 // NewField returns a new value that is assignable to the field
 // for the given descriptor. For scalars, this returns the default value.
 // For lists, maps, and messages, this returns a new, empty, mutable value.
-func (x *fastReflection_QueryDepositsRequest) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_QueryVotesRequest) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "cosmos.gov.v1.QueryDepositsRequest.proposal_id":
+	case "cosmos.gov.v1.QueryVotesRequest.proposal_id":
 		return protoreflect.ValueOfUint64(uint64(0))
-	case "cosmos.gov.v1.QueryDepositsRequest.pagination":
+	case "cosmos.gov.v1.QueryVotesRequest.pagination":
 		m := new(v1beta1.PageRequest)
 		return protoreflect.ValueOfMessage(m.ProtoReflect())
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.gov.v1.QueryDepositsRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.gov.v1.QueryVotesRequest"))
 		}
-		panic(fmt.Errorf("message cosmos.gov.v1.QueryDepositsRequest does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.gov.v1.QueryVotesRequest does not contain field %s", fd.FullName()))
 	}
 }
 
 // WhichOneof reports which field within the oneof is populated,
 // returning nil if none are populated.
 // It panics if the oneof descriptor does not belong to this message.
-func (x *fastReflection_QueryDepositsRequest) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+func (x *fastReflection_QueryVotesRequest) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
 	switch d.FullName() {
 	default:
-		panic(fmt.Errorf("%s is not a oneof field in cosmos.gov.v1.QueryDepositsRequest", d.FullName()))
+		panic(fmt.Errorf("%s is not a oneof field in cosmos.gov.v1.QueryVotesRequest", d.FullName()))
 	}
 	panic("unreachable")
 }
@@ -7010,7 +6712,7 @@ func (x *fastReflection_QueryDepositsRequest) WhichOneof(d protoreflect.OneofDes
 // GetUnknown retrieves the entire list of unknown fields.
 // The caller may only mutate the contents of the RawFields
 // if the mutated bytes are stored back into the message with SetUnknown.
-func (x *fastReflection_QueryDepositsRequest) GetUnknown() protoreflect.RawFields {
+func (x *fastReflection_QueryVotesRequest) GetUnknown() protoreflect.RawFields {
 	return x.unknownFields
 }
 
@@ -7021,7 +6723,7 @@ func (x *fastReflection_QueryDepositsRequest) GetUnknown() protoreflect.RawField
 // An empty RawFields may be passed to clear the fields.
 //
 // SetUnknown is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryDepositsRequest) SetUnknown(fields protoreflect.RawFields) {
+func (x *fastReflection_QueryVotesRequest) SetUnknown(fields protoreflect.RawFields) {
 	x.unknownFields = fields
 }
 
@@ -7033,7 +6735,7 @@ func (x *fastReflection_QueryDepositsRequest) SetUnknown(fields protoreflect.Raw
 // message type, but the details are implementation dependent.
 // Validity is not part of the protobuf data model, and may not
 // be preserved in marshaling or other operations.
-func (x *fastReflection_QueryDepositsRequest) IsValid() bool {
+func (x *fastReflection_QueryVotesRequest) IsValid() bool {
 	return x != nil
 }
 
@@ -7043,9 +6745,9 @@ func (x *fastReflection_QueryDepositsRequest) IsValid() bool {
 // The returned methods type is identical to
 // "google.golang.org/protobuf/runtime/protoiface".Methods.
 // Consult the protoiface package documentation for details.
-func (x *fastReflection_QueryDepositsRequest) ProtoMethods() *protoiface.Methods {
+func (x *fastReflection_QueryVotesRequest) ProtoMethods() *protoiface.Methods {
 	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
-		x := input.Message.Interface().(*QueryDepositsRequest)
+		x := input.Message.Interface().(*QueryVotesRequest)
 		if x == nil {
 			return protoiface.SizeOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -7074,7 +6776,7 @@ func (x *fastReflection_QueryDepositsRequest) ProtoMethods() *protoiface.Methods
 	}
 
 	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
-		x := input.Message.Interface().(*QueryDepositsRequest)
+		x := input.Message.Interface().(*QueryVotesRequest)
 		if x == nil {
 			return protoiface.MarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -7123,7 +6825,7 @@ func (x *fastReflection_QueryDepositsRequest) ProtoMethods() *protoiface.Methods
 		}, nil
 	}
 	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
-		x := input.Message.Interface().(*QueryDepositsRequest)
+		x := input.Message.Interface().(*QueryVotesRequest)
 		if x == nil {
 			return protoiface.UnmarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -7155,10 +6857,10 @@ func (x *fastReflection_QueryDepositsRequest) ProtoMethods() *protoiface.Methods
 			fieldNum := int32(wire >> 3)
 			wireType := int(wire & 0x7)
 			if wireType == 4 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryDepositsRequest: wiretype end group for non-group")
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryVotesRequest: wiretype end group for non-group")
 			}
 			if fieldNum <= 0 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryDepositsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryVotesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 			}
 			switch fieldNum {
 			case 1:
@@ -7251,79 +6953,79 @@ func (x *fastReflection_QueryDepositsRequest) ProtoMethods() *protoiface.Methods
 	}
 }
 
-var _ protoreflect.List = (*_QueryDepositsResponse_1_list)(nil)
+var _ protoreflect.List = (*_QueryVotesResponse_1_list)(nil)
 
-type _QueryDepositsResponse_1_list struct {
-	list *[]*Deposit
+type _QueryVotesResponse_1_list struct {
+	list *[]*Vote
 }
 
-func (x *_QueryDepositsResponse_1_list) Len() int {
+func (x *_QueryVotesResponse_1_list) Len() int {
 	if x.list == nil {
 		return 0
 	}
 	return len(*x.list)
 }
 
-func (x *_QueryDepositsResponse_1_list) Get(i int) protoreflect.Value {
+func (x *_QueryVotesResponse_1_list) Get(i int) protoreflect.Value {
 	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
 }
 
-func (x *_QueryDepositsResponse_1_list) Set(i int, value protoreflect.Value) {
+func (x *_QueryVotesResponse_1_list) Set(i int, value protoreflect.Value) {
 	valueUnwrapped := value.Message()
-	concreteValue := valueUnwrapped.Interface().(*Deposit)
+	concreteValue := valueUnwrapped.Interface().(*Vote)
 	(*x.list)[i] = concreteValue
 }
 
-func (x *_QueryDepositsResponse_1_list) Append(value protoreflect.Value) {
+func (x *_QueryVotesResponse_1_list) Append(value protoreflect.Value) {
 	valueUnwrapped := value.Message()
-	concreteValue := valueUnwrapped.Interface().(*Deposit)
+	concreteValue := valueUnwrapped.Interface().(*Vote)
 	*x.list = append(*x.list, concreteValue)
 }
 
-func (x *_QueryDepositsResponse_1_list) AppendMutable() protoreflect.Value {
-	v := new(Deposit)
+func (x *_QueryVotesResponse_1_list) AppendMutable() protoreflect.Value {
+	v := new(Vote)
 	*x.list = append(*x.list, v)
 	return protoreflect.ValueOfMessage(v.ProtoReflect())
 }
 
-func (x *_QueryDepositsResponse_1_list) Truncate(n int) {
+func (x *_QueryVotesResponse_1_list) Truncate(n int) {
 	for i := n; i < len(*x.list); i++ {
 		(*x.list)[i] = nil
 	}
 	*x.list = (*x.list)[:n]
 }
 
-func (x *_QueryDepositsResponse_1_list) NewElement() protoreflect.Value {
-	v := new(Deposit)
+func (x *_QueryVotesResponse_1_list) NewElement() protoreflect.Value {
+	v := new(Vote)
 	return protoreflect.ValueOfMessage(v.ProtoReflect())
 }
 
-func (x *_QueryDepositsResponse_1_list) IsValid() bool {
+func (x *_QueryVotesResponse_1_list) IsValid() bool {
 	return x.list != nil
 }
 
 var (
-	md_QueryDepositsResponse            protoreflect.MessageDescriptor
-	fd_QueryDepositsResponse_deposits   protoreflect.FieldDescriptor
-	fd_QueryDepositsResponse_pagination protoreflect.FieldDescriptor
+	md_QueryVotesResponse            protoreflect.MessageDescriptor
+	fd_QueryVotesResponse_votes      protoreflect.FieldDescriptor
+	fd_QueryVotesResponse_pagination protoreflect.FieldDescriptor
 )
 
 func init() {
 	file_cosmos_gov_v1_query_proto_init()
-	md_QueryDepositsResponse = File_cosmos_gov_v1_query_proto.Messages().ByName("QueryDepositsResponse")
-	fd_QueryDepositsResponse_deposits = md_QueryDepositsResponse.Fields().ByName("deposits")
-	fd_QueryDepositsResponse_pagination = md_QueryDepositsResponse.Fields().ByName("pagination")
+	md_QueryVotesResponse = File_cosmos_gov_v1_query_proto.Messages().ByName("QueryVotesResponse")
+	fd_QueryVotesResponse_votes = md_QueryVotesResponse.Fields().ByName("votes")
+	fd_QueryVotesResponse_pagination = md_QueryVotesResponse.Fields().ByName("pagination")
 }
 
-var _ protoreflect.Message = (*fastReflection_QueryDepositsResponse)(nil)
+var _ protoreflect.Message = (*fastReflection_QueryVotesResponse)(nil)
 
-type fastReflection_QueryDepositsResponse QueryDepositsResponse
+type fastReflection_QueryVotesResponse QueryVotesResponse
 
-func (x *QueryDepositsResponse) ProtoReflect() protoreflect.Message {
-	return (*fastReflection_QueryDepositsResponse)(x)
+func (x *QueryVotesResponse) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_QueryVotesResponse)(x)
 }
 
-func (x *QueryDepositsResponse) slowProtoReflect() protoreflect.Message {
+func (x *QueryVotesResponse) slowProtoReflect() protoreflect.Message {
 	mi := &file_cosmos_gov_v1_query_proto_msgTypes[15]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -7335,43 +7037,43 @@ func (x *QueryDepositsResponse) slowProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-var _fastReflection_QueryDepositsResponse_messageType fastReflection_QueryDepositsResponse_messageType
-var _ protoreflect.MessageType = fastReflection_QueryDepositsResponse_messageType{}
+var _fastReflection_QueryVotesResponse_messageType fastReflection_QueryVotesResponse_messageType
+var _ protoreflect.MessageType = fastReflection_QueryVotesResponse_messageType{}
 
-type fastReflection_QueryDepositsResponse_messageType struct{}
+type fastReflection_QueryVotesResponse_messageType struct{}
 
-func (x fastReflection_QueryDepositsResponse_messageType) Zero() protoreflect.Message {
-	return (*fastReflection_QueryDepositsResponse)(nil)
+func (x fastReflection_QueryVotesResponse_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_QueryVotesResponse)(nil)
 }
-func (x fastReflection_QueryDepositsResponse_messageType) New() protoreflect.Message {
-	return new(fastReflection_QueryDepositsResponse)
+func (x fastReflection_QueryVotesResponse_messageType) New() protoreflect.Message {
+	return new(fastReflection_QueryVotesResponse)
 }
-func (x fastReflection_QueryDepositsResponse_messageType) Descriptor() protoreflect.MessageDescriptor {
-	return md_QueryDepositsResponse
+func (x fastReflection_QueryVotesResponse_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryVotesResponse
 }
 
 // Descriptor returns message descriptor, which contains only the protobuf
 // type information for the message.
-func (x *fastReflection_QueryDepositsResponse) Descriptor() protoreflect.MessageDescriptor {
-	return md_QueryDepositsResponse
+func (x *fastReflection_QueryVotesResponse) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryVotesResponse
 }
 
 // Type returns the message type, which encapsulates both Go and protobuf
 // type information. If the Go type information is not needed,
 // it is recommended that the message descriptor be used instead.
-func (x *fastReflection_QueryDepositsResponse) Type() protoreflect.MessageType {
-	return _fastReflection_QueryDepositsResponse_messageType
+func (x *fastReflection_QueryVotesResponse) Type() protoreflect.MessageType {
+	return _fastReflection_QueryVotesResponse_messageType
 }
 
 // New returns a newly allocated and mutable empty message.
-func (x *fastReflection_QueryDepositsResponse) New() protoreflect.Message {
-	return new(fastReflection_QueryDepositsResponse)
+func (x *fastReflection_QueryVotesResponse) New() protoreflect.Message {
+	return new(fastReflection_QueryVotesResponse)
 }
 
 // Interface unwraps the message reflection interface and
 // returns the underlying ProtoMessage interface.
-func (x *fastReflection_QueryDepositsResponse) Interface() protoreflect.ProtoMessage {
-	return (*QueryDepositsResponse)(x)
+func (x *fastReflection_QueryVotesResponse) Interface() protoreflect.ProtoMessage {
+	return (*QueryVotesResponse)(x)
 }
 
 // Range iterates over every populated field in an undefined order,
@@ -7379,16 +7081,16 @@ func (x *fastReflection_QueryDepositsResponse) Interface() protoreflect.ProtoMes
 // Range returns immediately if f returns false.
 // While iterating, mutating operations may only be performed
 // on the current field descriptor.
-func (x *fastReflection_QueryDepositsResponse) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
-	if len(x.Deposits) != 0 {
-		value := protoreflect.ValueOfList(&_QueryDepositsResponse_1_list{list: &x.Deposits})
-		if !f(fd_QueryDepositsResponse_deposits, value) {
+func (x *fastReflection_QueryVotesResponse) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if len(x.Votes) != 0 {
+		value := protoreflect.ValueOfList(&_QueryVotesResponse_1_list{list: &x.Votes})
+		if !f(fd_QueryVotesResponse_votes, value) {
 			return
 		}
 	}
 	if x.Pagination != nil {
 		value := protoreflect.ValueOfMessage(x.Pagination.ProtoReflect())
-		if !f(fd_QueryDepositsResponse_pagination, value) {
+		if !f(fd_QueryVotesResponse_pagination, value) {
 			return
 		}
 	}
@@ -7405,17 +7107,17 @@ func (x *fastReflection_QueryDepositsResponse) Range(f func(protoreflect.FieldDe
 // In other cases (aside from the nullable cases above),
 // a proto3 scalar field is populated if it contains a non-zero value, and
 // a repeated field is populated if it is non-empty.
-func (x *fastReflection_QueryDepositsResponse) Has(fd protoreflect.FieldDescriptor) bool {
+func (x *fastReflection_QueryVotesResponse) Has(fd protoreflect.FieldDescriptor) bool {
 	switch fd.FullName() {
-	case "cosmos.gov.v1.QueryDepositsResponse.deposits":
-		return len(x.Deposits) != 0
-	case "cosmos.gov.v1.QueryDepositsResponse.pagination":
+	case "cosmos.gov.v1.QueryVotesResponse.votes":
+		return len(x.Votes) != 0
+	case "cosmos.gov.v1.QueryVotesResponse.pagination":
 		return x.Pagination != nil
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.gov.v1.QueryDepositsResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.gov.v1.QueryVotesResponse"))
 		}
-		panic(fmt.Errorf("message cosmos.gov.v1.QueryDepositsResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.gov.v1.QueryVotesResponse does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -7425,17 +7127,17 @@ func (x *fastReflection_QueryDepositsResponse) Has(fd protoreflect.FieldDescript
 // associated with the given field number.
 //
 // Clear is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryDepositsResponse) Clear(fd protoreflect.FieldDescriptor) {
+func (x *fastReflection_QueryVotesResponse) Clear(fd protoreflect.FieldDescriptor) {
 	switch fd.FullName() {
-	case "cosmos.gov.v1.QueryDepositsResponse.deposits":
-		x.Deposits = nil
-	case "cosmos.gov.v1.QueryDepositsResponse.pagination":
+	case "cosmos.gov.v1.QueryVotesResponse.votes":
+		x.Votes = nil
+	case "cosmos.gov.v1.QueryVotesResponse.pagination":
 		x.Pagination = nil
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.gov.v1.QueryDepositsResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.gov.v1.QueryVotesResponse"))
 		}
-		panic(fmt.Errorf("message cosmos.gov.v1.QueryDepositsResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.gov.v1.QueryVotesResponse does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -7445,22 +7147,22 @@ func (x *fastReflection_QueryDepositsResponse) Clear(fd protoreflect.FieldDescri
 // the default value of a bytes scalar is guaranteed to be a copy.
 // For unpopulated composite types, it returns an empty, read-only view
 // of the value; to obtain a mutable reference, use Mutable.
-func (x *fastReflection_QueryDepositsResponse) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_QueryVotesResponse) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
 	switch descriptor.FullName() {
-	case "cosmos.gov.v1.QueryDepositsResponse.deposits":
-		if len(x.Deposits) == 0 {
-			return protoreflect.ValueOfList(&_QueryDepositsResponse_1_list{})
+	case "cosmos.gov.v1.QueryVotesResponse.votes":
+		if len(x.Votes) == 0 {
+			return protoreflect.ValueOfList(&_QueryVotesResponse_1_list{})
 		}
-		listValue := &_QueryDepositsResponse_1_list{list: &x.Deposits}
+		listValue := &_QueryVotesResponse_1_list{list: &x.Votes}
 		return protoreflect.ValueOfList(listValue)
-	case "cosmos.gov.v1.QueryDepositsResponse.pagination":
+	case "cosmos.gov.v1.QueryVotesResponse.pagination":
 		value := x.Pagination
 		return protoreflect.ValueOfMessage(value.ProtoReflect())
 	default:
 		if descriptor.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.gov.v1.QueryDepositsResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.gov.v1.QueryVotesResponse"))
 		}
-		panic(fmt.Errorf("message cosmos.gov.v1.QueryDepositsResponse does not contain field %s", descriptor.FullName()))
+		panic(fmt.Errorf("message cosmos.gov.v1.QueryVotesResponse does not contain field %s", descriptor.FullName()))
 	}
 }
 
@@ -7474,19 +7176,19 @@ func (x *fastReflection_QueryDepositsResponse) Get(descriptor protoreflect.Field
 // empty, read-only value, then it panics.
 //
 // Set is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryDepositsResponse) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+func (x *fastReflection_QueryVotesResponse) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
 	switch fd.FullName() {
-	case "cosmos.gov.v1.QueryDepositsResponse.deposits":
+	case "cosmos.gov.v1.QueryVotesResponse.votes":
 		lv := value.List()
-		clv := lv.(*_QueryDepositsResponse_1_list)
-		x.Deposits = *clv.list
-	case "cosmos.gov.v1.QueryDepositsResponse.pagination":
+		clv := lv.(*_QueryVotesResponse_1_list)
+		x.Votes = *clv.list
+	case "cosmos.gov.v1.QueryVotesResponse.pagination":
 		x.Pagination = value.Message().Interface().(*v1beta1.PageResponse)
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.gov.v1.QueryDepositsResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.gov.v1.QueryVotesResponse"))
 		}
-		panic(fmt.Errorf("message cosmos.gov.v1.QueryDepositsResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.gov.v1.QueryVotesResponse does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -7500,53 +7202,53 @@ func (x *fastReflection_QueryDepositsResponse) Set(fd protoreflect.FieldDescript
 // It panics if the field does not contain a composite type.
 //
 // Mutable is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryDepositsResponse) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_QueryVotesResponse) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "cosmos.gov.v1.QueryDepositsResponse.deposits":
-		if x.Deposits == nil {
-			x.Deposits = []*Deposit{}
+	case "cosmos.gov.v1.QueryVotesResponse.votes":
+		if x.Votes == nil {
+			x.Votes = []*Vote{}
 		}
-		value := &_QueryDepositsResponse_1_list{list: &x.Deposits}
+		value := &_QueryVotesResponse_1_list{list: &x.Votes}
 		return protoreflect.ValueOfList(value)
-	case "cosmos.gov.v1.QueryDepositsResponse.pagination":
+	case "cosmos.gov.v1.QueryVotesResponse.pagination":
 		if x.Pagination == nil {
 			x.Pagination = new(v1beta1.PageResponse)
 		}
 		return protoreflect.ValueOfMessage(x.Pagination.ProtoReflect())
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.gov.v1.QueryDepositsResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.gov.v1.QueryVotesResponse"))
 		}
-		panic(fmt.Errorf("message cosmos.gov.v1.QueryDepositsResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.gov.v1.QueryVotesResponse does not contain field %s", fd.FullName()))
 	}
 }
 
 // NewField returns a new value that is assignable to the field
 // for the given descriptor. For scalars, this returns the default value.
 // For lists, maps, and messages, this returns a new, empty, mutable value.
-func (x *fastReflection_QueryDepositsResponse) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_QueryVotesResponse) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "cosmos.gov.v1.QueryDepositsResponse.deposits":
-		list := []*Deposit{}
-		return protoreflect.ValueOfList(&_QueryDepositsResponse_1_list{list: &list})
-	case "cosmos.gov.v1.QueryDepositsResponse.pagination":
+	case "cosmos.gov.v1.QueryVotesResponse.votes":
+		list := []*Vote{}
+		return protoreflect.ValueOfList(&_QueryVotesResponse_1_list{list: &list})
+	case "cosmos.gov.v1.QueryVotesResponse.pagination":
 		m := new(v1beta1.PageResponse)
 		return protoreflect.ValueOfMessage(m.ProtoReflect())
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.gov.v1.QueryDepositsResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.gov.v1.QueryVotesResponse"))
 		}
-		panic(fmt.Errorf("message cosmos.gov.v1.QueryDepositsResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.gov.v1.QueryVotesResponse does not contain field %s", fd.FullName()))
 	}
 }
 
 // WhichOneof reports which field within the oneof is populated,
 // returning nil if none are populated.
 // It panics if the oneof descriptor does not belong to this message.
-func (x *fastReflection_QueryDepositsResponse) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+func (x *fastReflection_QueryVotesResponse) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
 	switch d.FullName() {
 	default:
-		panic(fmt.Errorf("%s is not a oneof field in cosmos.gov.v1.QueryDepositsResponse", d.FullName()))
+		panic(fmt.Errorf("%s is not a oneof field in cosmos.gov.v1.QueryVotesResponse", d.FullName()))
 	}
 	panic("unreachable")
 }
@@ -7554,7 +7256,7 @@ func (x *fastReflection_QueryDepositsResponse) WhichOneof(d protoreflect.OneofDe
 // GetUnknown retrieves the entire list of unknown fields.
 // The caller may only mutate the contents of the RawFields
 // if the mutated bytes are stored back into the message with SetUnknown.
-func (x *fastReflection_QueryDepositsResponse) GetUnknown() protoreflect.RawFields {
+func (x *fastReflection_QueryVotesResponse) GetUnknown() protoreflect.RawFields {
 	return x.unknownFields
 }
 
@@ -7565,7 +7267,7 @@ func (x *fastReflection_QueryDepositsResponse) GetUnknown() protoreflect.RawFiel
 // An empty RawFields may be passed to clear the fields.
 //
 // SetUnknown is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryDepositsResponse) SetUnknown(fields protoreflect.RawFields) {
+func (x *fastReflection_QueryVotesResponse) SetUnknown(fields protoreflect.RawFields) {
 	x.unknownFields = fields
 }
 
@@ -7577,7 +7279,7 @@ func (x *fastReflection_QueryDepositsResponse) SetUnknown(fields protoreflect.Ra
 // message type, but the details are implementation dependent.
 // Validity is not part of the protobuf data model, and may not
 // be preserved in marshaling or other operations.
-func (x *fastReflection_QueryDepositsResponse) IsValid() bool {
+func (x *fastReflection_QueryVotesResponse) IsValid() bool {
 	return x != nil
 }
 
@@ -7587,9 +7289,9 @@ func (x *fastReflection_QueryDepositsResponse) IsValid() bool {
 // The returned methods type is identical to
 // "google.golang.org/protobuf/runtime/protoiface".Methods.
 // Consult the protoiface package documentation for details.
-func (x *fastReflection_QueryDepositsResponse) ProtoMethods() *protoiface.Methods {
+func (x *fastReflection_QueryVotesResponse) ProtoMethods() *protoiface.Methods {
 	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
-		x := input.Message.Interface().(*QueryDepositsResponse)
+		x := input.Message.Interface().(*QueryVotesResponse)
 		if x == nil {
 			return protoiface.SizeOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -7601,8 +7303,8 @@ func (x *fastReflection_QueryDepositsResponse) ProtoMethods() *protoiface.Method
 		var n int
 		var l int
 		_ = l
-		if len(x.Deposits) > 0 {
-			for _, e := range x.Deposits {
+		if len(x.Votes) > 0 {
+			for _, e := range x.Votes {
 				l = options.Size(e)
 				n += 1 + l + runtime.Sov(uint64(l))
 			}
@@ -7621,7 +7323,7 @@ func (x *fastReflection_QueryDepositsResponse) ProtoMethods() *protoiface.Method
 	}
 
 	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
-		x := input.Message.Interface().(*QueryDepositsResponse)
+		x := input.Message.Interface().(*QueryVotesResponse)
 		if x == nil {
 			return protoiface.MarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -7654,9 +7356,9 @@ func (x *fastReflection_QueryDepositsResponse) ProtoMethods() *protoiface.Method
 			i--
 			dAtA[i] = 0x12
 		}
-		if len(x.Deposits) > 0 {
-			for iNdEx := len(x.Deposits) - 1; iNdEx >= 0; iNdEx-- {
-				encoded, err := options.Marshal(x.Deposits[iNdEx])
+		if len(x.Votes) > 0 {
+			for iNdEx := len(x.Votes) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.Votes[iNdEx])
 				if err != nil {
 					return protoiface.MarshalOutput{
 						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -7681,7 +7383,7 @@ func (x *fastReflection_QueryDepositsResponse) ProtoMethods() *protoiface.Method
 		}, nil
 	}
 	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
-		x := input.Message.Interface().(*QueryDepositsResponse)
+		x := input.Message.Interface().(*QueryVotesResponse)
 		if x == nil {
 			return protoiface.UnmarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -7713,15 +7415,15 @@ func (x *fastReflection_QueryDepositsResponse) ProtoMethods() *protoiface.Method
 			fieldNum := int32(wire >> 3)
 			wireType := int(wire & 0x7)
 			if wireType == 4 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryDepositsResponse: wiretype end group for non-group")
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryVotesResponse: wiretype end group for non-group")
 			}
 			if fieldNum <= 0 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryDepositsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryVotesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 			}
 			switch fieldNum {
 			case 1:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Deposits", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Votes", wireType)
 				}
 				var msglen int
 				for shift := uint(0); ; shift += 7 {
@@ -7748,8 +7450,8 @@ func (x *fastReflection_QueryDepositsResponse) ProtoMethods() *protoiface.Method
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				x.Deposits = append(x.Deposits, &Deposit{})
-				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Deposits[len(x.Deposits)-1]); err != nil {
+				x.Votes = append(x.Votes, &Vote{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Votes[len(x.Votes)-1]); err != nil {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
 				}
 				iNdEx = postIndex
@@ -7825,25 +7527,25 @@ func (x *fastReflection_QueryDepositsResponse) ProtoMethods() *protoiface.Method
 }
 
 var (
-	md_QueryTallyResultRequest             protoreflect.MessageDescriptor
-	fd_QueryTallyResultRequest_proposal_id protoreflect.FieldDescriptor
+	md_QueryParamsRequest             protoreflect.MessageDescriptor
+	fd_QueryParamsRequest_params_type protoreflect.FieldDescriptor
 )
 
 func init() {
 	file_cosmos_gov_v1_query_proto_init()
-	md_QueryTallyResultRequest = File_cosmos_gov_v1_query_proto.Messages().ByName("QueryTallyResultRequest")
-	fd_QueryTallyResultRequest_proposal_id = md_QueryTallyResultRequest.Fields().ByName("proposal_id")
+	md_QueryParamsRequest = File_cosmos_gov_v1_query_proto.Messages().ByName("QueryParamsRequest")
+	fd_QueryParamsRequest_params_type = md_QueryParamsRequest.Fields().ByName("params_type")
 }
 
-var _ protoreflect.Message = (*fastReflection_QueryTallyResultRequest)(nil)
+var _ protoreflect.Message = (*fastReflection_QueryParamsRequest)(nil)
 
-type fastReflection_QueryTallyResultRequest QueryTallyResultRequest
+type fastReflection_QueryParamsRequest QueryParamsRequest
 
-func (x *QueryTallyResultRequest) ProtoReflect() protoreflect.Message {
-	return (*fastReflection_QueryTallyResultRequest)(x)
+func (x *QueryParamsRequest) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_QueryParamsRequest)(x)
 }
 
-func (x *QueryTallyResultRequest) slowProtoReflect() protoreflect.Message {
+func (x *QueryParamsRequest) slowProtoReflect() protoreflect.Message {
 	mi := &file_cosmos_gov_v1_query_proto_msgTypes[16]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -7855,43 +7557,43 @@ func (x *QueryTallyResultRequest) slowProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-var _fastReflection_QueryTallyResultRequest_messageType fastReflection_QueryTallyResultRequest_messageType
-var _ protoreflect.MessageType = fastReflection_QueryTallyResultRequest_messageType{}
+var _fastReflection_QueryParamsRequest_messageType fastReflection_QueryParamsRequest_messageType
+var _ protoreflect.MessageType = fastReflection_QueryParamsRequest_messageType{}
 
-type fastReflection_QueryTallyResultRequest_messageType struct{}
+type fastReflection_QueryParamsRequest_messageType struct{}
 
-func (x fastReflection_QueryTallyResultRequest_messageType) Zero() protoreflect.Message {
-	return (*fastReflection_QueryTallyResultRequest)(nil)
+func (x fastReflection_QueryParamsRequest_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_QueryParamsRequest)(nil)
 }
-func (x fastReflection_QueryTallyResultRequest_messageType) New() protoreflect.Message {
-	return new(fastReflection_QueryTallyResultRequest)
+func (x fastReflection_QueryParamsRequest_messageType) New() protoreflect.Message {
+	return new(fastReflection_QueryParamsRequest)
 }
-func (x fastReflection_QueryTallyResultRequest_messageType) Descriptor() protoreflect.MessageDescriptor {
-	return md_QueryTallyResultRequest
+func (x fastReflection_QueryParamsRequest_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryParamsRequest
 }
 
 // Descriptor returns message descriptor, which contains only the protobuf
 // type information for the message.
-func (x *fastReflection_QueryTallyResultRequest) Descriptor() protoreflect.MessageDescriptor {
-	return md_QueryTallyResultRequest
+func (x *fastReflection_QueryParamsRequest) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryParamsRequest
 }
 
 // Type returns the message type, which encapsulates both Go and protobuf
 // type information. If the Go type information is not needed,
 // it is recommended that the message descriptor be used instead.
-func (x *fastReflection_QueryTallyResultRequest) Type() protoreflect.MessageType {
-	return _fastReflection_QueryTallyResultRequest_messageType
+func (x *fastReflection_QueryParamsRequest) Type() protoreflect.MessageType {
+	return _fastReflection_QueryParamsRequest_messageType
 }
 
 // New returns a newly allocated and mutable empty message.
-func (x *fastReflection_QueryTallyResultRequest) New() protoreflect.Message {
-	return new(fastReflection_QueryTallyResultRequest)
+func (x *fastReflection_QueryParamsRequest) New() protoreflect.Message {
+	return new(fastReflection_QueryParamsRequest)
 }
 
 // Interface unwraps the message reflection interface and
 // returns the underlying ProtoMessage interface.
-func (x *fastReflection_QueryTallyResultRequest) Interface() protoreflect.ProtoMessage {
-	return (*QueryTallyResultRequest)(x)
+func (x *fastReflection_QueryParamsRequest) Interface() protoreflect.ProtoMessage {
+	return (*QueryParamsRequest)(x)
 }
 
 // Range iterates over every populated field in an undefined order,
@@ -7899,10 +7601,10 @@ func (x *fastReflection_QueryTallyResultRequest) Interface() protoreflect.ProtoM
 // Range returns immediately if f returns false.
 // While iterating, mutating operations may only be performed
 // on the current field descriptor.
-func (x *fastReflection_QueryTallyResultRequest) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
-	if x.ProposalId != uint64(0) {
-		value := protoreflect.ValueOfUint64(x.ProposalId)
-		if !f(fd_QueryTallyResultRequest_proposal_id, value) {
+func (x *fastReflection_QueryParamsRequest) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.ParamsType != "" {
+		value := protoreflect.ValueOfString(x.ParamsType)
+		if !f(fd_QueryParamsRequest_params_type, value) {
 			return
 		}
 	}
@@ -7919,15 +7621,15 @@ func (x *fastReflection_QueryTallyResultRequest) Range(f func(protoreflect.Field
 // In other cases (aside from the nullable cases above),
 // a proto3 scalar field is populated if it contains a non-zero value, and
 // a repeated field is populated if it is non-empty.
-func (x *fastReflection_QueryTallyResultRequest) Has(fd protoreflect.FieldDescriptor) bool {
+func (x *fastReflection_QueryParamsRequest) Has(fd protoreflect.FieldDescriptor) bool {
 	switch fd.FullName() {
-	case "cosmos.gov.v1.QueryTallyResultRequest.proposal_id":
-		return x.ProposalId != uint64(0)
+	case "cosmos.gov.v1.QueryParamsRequest.params_type":
+		return x.ParamsType != ""
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.gov.v1.QueryTallyResultRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.gov.v1.QueryParamsRequest"))
 		}
-		panic(fmt.Errorf("message cosmos.gov.v1.QueryTallyResultRequest does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.gov.v1.QueryParamsRequest does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -7937,15 +7639,15 @@ func (x *fastReflection_QueryTallyResultRequest) Has(fd protoreflect.FieldDescri
 // associated with the given field number.
 //
 // Clear is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryTallyResultRequest) Clear(fd protoreflect.FieldDescriptor) {
+func (x *fastReflection_QueryParamsRequest) Clear(fd protoreflect.FieldDescriptor) {
 	switch fd.FullName() {
-	case "cosmos.gov.v1.QueryTallyResultRequest.proposal_id":
-		x.ProposalId = uint64(0)
+	case "cosmos.gov.v1.QueryParamsRequest.params_type":
+		x.ParamsType = ""
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.gov.v1.QueryTallyResultRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.gov.v1.QueryParamsRequest"))
 		}
-		panic(fmt.Errorf("message cosmos.gov.v1.QueryTallyResultRequest does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.gov.v1.QueryParamsRequest does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -7955,16 +7657,16 @@ func (x *fastReflection_QueryTallyResultRequest) Clear(fd protoreflect.FieldDesc
 // the default value of a bytes scalar is guaranteed to be a copy.
 // For unpopulated composite types, it returns an empty, read-only view
 // of the value; to obtain a mutable reference, use Mutable.
-func (x *fastReflection_QueryTallyResultRequest) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_QueryParamsRequest) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
 	switch descriptor.FullName() {
-	case "cosmos.gov.v1.QueryTallyResultRequest.proposal_id":
-		value := x.ProposalId
-		return protoreflect.ValueOfUint64(value)
+	case "cosmos.gov.v1.QueryParamsRequest.params_type":
+		value := x.ParamsType
+		return protoreflect.ValueOfString(value)
 	default:
 		if descriptor.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.gov.v1.QueryTallyResultRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.gov.v1.QueryParamsRequest"))
 		}
-		panic(fmt.Errorf("message cosmos.gov.v1.QueryTallyResultRequest does not contain field %s", descriptor.FullName()))
+		panic(fmt.Errorf("message cosmos.gov.v1.QueryParamsRequest does not contain field %s", descriptor.FullName()))
 	}
 }
 
@@ -7978,15 +7680,15 @@ func (x *fastReflection_QueryTallyResultRequest) Get(descriptor protoreflect.Fie
 // empty, read-only value, then it panics.
 //
 // Set is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryTallyResultRequest) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+func (x *fastReflection_QueryParamsRequest) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
 	switch fd.FullName() {
-	case "cosmos.gov.v1.QueryTallyResultRequest.proposal_id":
-		x.ProposalId = value.Uint()
+	case "cosmos.gov.v1.QueryParamsRequest.params_type":
+		x.ParamsType = value.Interface().(string)
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.gov.v1.QueryTallyResultRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.gov.v1.QueryParamsRequest"))
 		}
-		panic(fmt.Errorf("message cosmos.gov.v1.QueryTallyResultRequest does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.gov.v1.QueryParamsRequest does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -8000,40 +7702,40 @@ func (x *fastReflection_QueryTallyResultRequest) Set(fd protoreflect.FieldDescri
 // It panics if the field does not contain a composite type.
 //
 // Mutable is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryTallyResultRequest) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_QueryParamsRequest) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "cosmos.gov.v1.QueryTallyResultRequest.proposal_id":
-		panic(fmt.Errorf("field proposal_id of message cosmos.gov.v1.QueryTallyResultRequest is not mutable"))
+	case "cosmos.gov.v1.QueryParamsRequest.params_type":
+		panic(fmt.Errorf("field params_type of message cosmos.gov.v1.QueryParamsRequest is not mutable"))
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.gov.v1.QueryTallyResultRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.gov.v1.QueryParamsRequest"))
 		}
-		panic(fmt.Errorf("message cosmos.gov.v1.QueryTallyResultRequest does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.gov.v1.QueryParamsRequest does not contain field %s", fd.FullName()))
 	}
 }
 
 // NewField returns a new value that is assignable to the field
 // for the given descriptor. For scalars, this returns the default value.
 // For lists, maps, and messages, this returns a new, empty, mutable value.
-func (x *fastReflection_QueryTallyResultRequest) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_QueryParamsRequest) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "cosmos.gov.v1.QueryTallyResultRequest.proposal_id":
-		return protoreflect.ValueOfUint64(uint64(0))
+	case "cosmos.gov.v1.QueryParamsRequest.params_type":
+		return protoreflect.ValueOfString("")
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.gov.v1.QueryTallyResultRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.gov.v1.QueryParamsRequest"))
 		}
-		panic(fmt.Errorf("message cosmos.gov.v1.QueryTallyResultRequest does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.gov.v1.QueryParamsRequest does not contain field %s", fd.FullName()))
 	}
 }
 
 // WhichOneof reports which field within the oneof is populated,
 // returning nil if none are populated.
 // It panics if the oneof descriptor does not belong to this message.
-func (x *fastReflection_QueryTallyResultRequest) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+func (x *fastReflection_QueryParamsRequest) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
 	switch d.FullName() {
 	default:
-		panic(fmt.Errorf("%s is not a oneof field in cosmos.gov.v1.QueryTallyResultRequest", d.FullName()))
+		panic(fmt.Errorf("%s is not a oneof field in cosmos.gov.v1.QueryParamsRequest", d.FullName()))
 	}
 	panic("unreachable")
 }
@@ -8041,7 +7743,7 @@ func (x *fastReflection_QueryTallyResultRequest) WhichOneof(d protoreflect.Oneof
 // GetUnknown retrieves the entire list of unknown fields.
 // The caller may only mutate the contents of the RawFields
 // if the mutated bytes are stored back into the message with SetUnknown.
-func (x *fastReflection_QueryTallyResultRequest) GetUnknown() protoreflect.RawFields {
+func (x *fastReflection_QueryParamsRequest) GetUnknown() protoreflect.RawFields {
 	return x.unknownFields
 }
 
@@ -8052,7 +7754,7 @@ func (x *fastReflection_QueryTallyResultRequest) GetUnknown() protoreflect.RawFi
 // An empty RawFields may be passed to clear the fields.
 //
 // SetUnknown is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryTallyResultRequest) SetUnknown(fields protoreflect.RawFields) {
+func (x *fastReflection_QueryParamsRequest) SetUnknown(fields protoreflect.RawFields) {
 	x.unknownFields = fields
 }
 
@@ -8064,7 +7766,7 @@ func (x *fastReflection_QueryTallyResultRequest) SetUnknown(fields protoreflect.
 // message type, but the details are implementation dependent.
 // Validity is not part of the protobuf data model, and may not
 // be preserved in marshaling or other operations.
-func (x *fastReflection_QueryTallyResultRequest) IsValid() bool {
+func (x *fastReflection_QueryParamsRequest) IsValid() bool {
 	return x != nil
 }
 
@@ -8074,9 +7776,9 @@ func (x *fastReflection_QueryTallyResultRequest) IsValid() bool {
 // The returned methods type is identical to
 // "google.golang.org/protobuf/runtime/protoiface".Methods.
 // Consult the protoiface package documentation for details.
-func (x *fastReflection_QueryTallyResultRequest) ProtoMethods() *protoiface.Methods {
+func (x *fastReflection_QueryParamsRequest) ProtoMethods() *protoiface.Methods {
 	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
-		x := input.Message.Interface().(*QueryTallyResultRequest)
+		x := input.Message.Interface().(*QueryParamsRequest)
 		if x == nil {
 			return protoiface.SizeOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -8088,8 +7790,9 @@ func (x *fastReflection_QueryTallyResultRequest) ProtoMethods() *protoiface.Meth
 		var n int
 		var l int
 		_ = l
-		if x.ProposalId != 0 {
-			n += 1 + runtime.Sov(uint64(x.ProposalId))
+		l = len(x.ParamsType)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
 		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
@@ -8101,7 +7804,7 @@ func (x *fastReflection_QueryTallyResultRequest) ProtoMethods() *protoiface.Meth
 	}
 
 	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
-		x := input.Message.Interface().(*QueryTallyResultRequest)
+		x := input.Message.Interface().(*QueryParamsRequest)
 		if x == nil {
 			return protoiface.MarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -8120,10 +7823,12 @@ func (x *fastReflection_QueryTallyResultRequest) ProtoMethods() *protoiface.Meth
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
-		if x.ProposalId != 0 {
-			i = runtime.EncodeVarint(dAtA, i, uint64(x.ProposalId))
+		if len(x.ParamsType) > 0 {
+			i -= len(x.ParamsType)
+			copy(dAtA[i:], x.ParamsType)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.ParamsType)))
 			i--
-			dAtA[i] = 0x8
+			dAtA[i] = 0xa
 		}
 		if input.Buf != nil {
 			input.Buf = append(input.Buf, dAtA...)
@@ -8136,7 +7841,7 @@ func (x *fastReflection_QueryTallyResultRequest) ProtoMethods() *protoiface.Meth
 		}, nil
 	}
 	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
-		x := input.Message.Interface().(*QueryTallyResultRequest)
+		x := input.Message.Interface().(*QueryParamsRequest)
 		if x == nil {
 			return protoiface.UnmarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -8168,17 +7873,17 @@ func (x *fastReflection_QueryTallyResultRequest) ProtoMethods() *protoiface.Meth
 			fieldNum := int32(wire >> 3)
 			wireType := int(wire & 0x7)
 			if wireType == 4 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryTallyResultRequest: wiretype end group for non-group")
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryParamsRequest: wiretype end group for non-group")
 			}
 			if fieldNum <= 0 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryTallyResultRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 			}
 			switch fieldNum {
 			case 1:
-				if wireType != 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ProposalId", wireType)
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ParamsType", wireType)
 				}
-				x.ProposalId = 0
+				var stringLen uint64
 				for shift := uint(0); ; shift += 7 {
 					if shift >= 64 {
 						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
@@ -8188,11 +7893,24 @@ func (x *fastReflection_QueryTallyResultRequest) ProtoMethods() *protoiface.Meth
 					}
 					b := dAtA[iNdEx]
 					iNdEx++
-					x.ProposalId |= uint64(b&0x7F) << shift
+					stringLen |= uint64(b&0x7F) << shift
 					if b < 0x80 {
 						break
 					}
 				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.ParamsType = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
 			default:
 				iNdEx = preIndex
 				skippy, err := runtime.Skip(dAtA[iNdEx:])
@@ -8229,25 +7947,31 @@ func (x *fastReflection_QueryTallyResultRequest) ProtoMethods() *protoiface.Meth
 }
 
 var (
-	md_QueryTallyResultResponse       protoreflect.MessageDescriptor
-	fd_QueryTallyResultResponse_tally protoreflect.FieldDescriptor
+	md_QueryParamsResponse                protoreflect.MessageDescriptor
+	fd_QueryParamsResponse_voting_params  protoreflect.FieldDescriptor
+	fd_QueryParamsResponse_deposit_params protoreflect.FieldDescriptor
+	fd_QueryParamsResponse_tally_params   protoreflect.FieldDescriptor
+	fd_QueryParamsResponse_params         protoreflect.FieldDescriptor
 )
 
 func init() {
 	file_cosmos_gov_v1_query_proto_init()
-	md_QueryTallyResultResponse = File_cosmos_gov_v1_query_proto.Messages().ByName("QueryTallyResultResponse")
-	fd_QueryTallyResultResponse_tally = md_QueryTallyResultResponse.Fields().ByName("tally")
+	md_QueryParamsResponse = File_cosmos_gov_v1_query_proto.Messages().ByName("QueryParamsResponse")
+	fd_QueryParamsResponse_voting_params = md_QueryParamsResponse.Fields().ByName("voting_params")
+	fd_QueryParamsResponse_deposit_params = md_QueryParamsResponse.Fields().ByName("deposit_params")
+	fd_QueryParamsResponse_tally_params = md_QueryParamsResponse.Fields().ByName("tally_params")
+	fd_QueryParamsResponse_params = md_QueryParamsResponse.Fields().ByName("params")
 }
 
-var _ protoreflect.Message = (*fastReflection_QueryTallyResultResponse)(nil)
+var _ protoreflect.Message = (*fastReflection_QueryParamsResponse)(nil)
 
-type fastReflection_QueryTallyResultResponse QueryTallyResultResponse
+type fastReflection_QueryParamsResponse QueryParamsResponse
 
-func (x *QueryTallyResultResponse) ProtoReflect() protoreflect.Message {
-	return (*fastReflection_QueryTallyResultResponse)(x)
+func (x *QueryParamsResponse) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_QueryParamsResponse)(x)
 }
 
-func (x *QueryTallyResultResponse) slowProtoReflect() protoreflect.Message {
+func (x *QueryParamsResponse) slowProtoReflect() protoreflect.Message {
 	mi := &file_cosmos_gov_v1_query_proto_msgTypes[17]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -8259,43 +7983,43 @@ func (x *QueryTallyResultResponse) slowProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-var _fastReflection_QueryTallyResultResponse_messageType fastReflection_QueryTallyResultResponse_messageType
-var _ protoreflect.MessageType = fastReflection_QueryTallyResultResponse_messageType{}
+var _fastReflection_QueryParamsResponse_messageType fastReflection_QueryParamsResponse_messageType
+var _ protoreflect.MessageType = fastReflection_QueryParamsResponse_messageType{}
 
-type fastReflection_QueryTallyResultResponse_messageType struct{}
+type fastReflection_QueryParamsResponse_messageType struct{}
 
-func (x fastReflection_QueryTallyResultResponse_messageType) Zero() protoreflect.Message {
-	return (*fastReflection_QueryTallyResultResponse)(nil)
+func (x fastReflection_QueryParamsResponse_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_QueryParamsResponse)(nil)
 }
-func (x fastReflection_QueryTallyResultResponse_messageType) New() protoreflect.Message {
-	return new(fastReflection_QueryTallyResultResponse)
+func (x fastReflection_QueryParamsResponse_messageType) New() protoreflect.Message {
+	return new(fastReflection_QueryParamsResponse)
 }
-func (x fastReflection_QueryTallyResultResponse_messageType) Descriptor() protoreflect.MessageDescriptor {
-	return md_QueryTallyResultResponse
+func (x fastReflection_QueryParamsResponse_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryParamsResponse
 }
 
 // Descriptor returns message descriptor, which contains only the protobuf
 // type information for the message.
-func (x *fastReflection_QueryTallyResultResponse) Descriptor() protoreflect.MessageDescriptor {
-	return md_QueryTallyResultResponse
+func (x *fastReflection_QueryParamsResponse) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryParamsResponse
 }
 
 // Type returns the message type, which encapsulates both Go and protobuf
 // type information. If the Go type information is not needed,
 // it is recommended that the message descriptor be used instead.
-func (x *fastReflection_QueryTallyResultResponse) Type() protoreflect.MessageType {
-	return _fastReflection_QueryTallyResultResponse_messageType
+func (x *fastReflection_QueryParamsResponse) Type() protoreflect.MessageType {
+	return _fastReflection_QueryParamsResponse_messageType
 }
 
 // New returns a newly allocated and mutable empty message.
-func (x *fastReflection_QueryTallyResultResponse) New() protoreflect.Message {
-	return new(fastReflection_QueryTallyResultResponse)
+func (x *fastReflection_QueryParamsResponse) New() protoreflect.Message {
+	return new(fastReflection_QueryParamsResponse)
 }
 
 // Interface unwraps the message reflection interface and
 // returns the underlying ProtoMessage interface.
-func (x *fastReflection_QueryTallyResultResponse) Interface() protoreflect.ProtoMessage {
-	return (*QueryTallyResultResponse)(x)
+func (x *fastReflection_QueryParamsResponse) Interface() protoreflect.ProtoMessage {
+	return (*QueryParamsResponse)(x)
 }
 
 // Range iterates over every populated field in an undefined order,
@@ -8303,10 +8027,28 @@ func (x *fastReflection_QueryTallyResultResponse) Interface() protoreflect.Proto
 // Range returns immediately if f returns false.
 // While iterating, mutating operations may only be performed
 // on the current field descriptor.
-func (x *fastReflection_QueryTallyResultResponse) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
-	if x.Tally != nil {
-		value := protoreflect.ValueOfMessage(x.Tally.ProtoReflect())
-		if !f(fd_QueryTallyResultResponse_tally, value) {
+func (x *fastReflection_QueryParamsResponse) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.VotingParams != nil {
+		value := protoreflect.ValueOfMessage(x.VotingParams.ProtoReflect())
+		if !f(fd_QueryParamsResponse_voting_params, value) {
+			return
+		}
+	}
+	if x.DepositParams != nil {
+		value := protoreflect.ValueOfMessage(x.DepositParams.ProtoReflect())
+		if !f(fd_QueryParamsResponse_deposit_params, value) {
+			return
+		}
+	}
+	if x.TallyParams != nil {
+		value := protoreflect.ValueOfMessage(x.TallyParams.ProtoReflect())
+		if !f(fd_QueryParamsResponse_tally_params, value) {
+			return
+		}
+	}
+	if x.Params != nil {
+		value := protoreflect.ValueOfMessage(x.Params.ProtoReflect())
+		if !f(fd_QueryParamsResponse_params, value) {
 			return
 		}
 	}
@@ -8323,15 +8065,21 @@ func (x *fastReflection_QueryTallyResultResponse) Range(f func(protoreflect.Fiel
 // In other cases (aside from the nullable cases above),
 // a proto3 scalar field is populated if it contains a non-zero value, and
 // a repeated field is populated if it is non-empty.
-func (x *fastReflection_QueryTallyResultResponse) Has(fd protoreflect.FieldDescriptor) bool {
+func (x *fastReflection_QueryParamsResponse) Has(fd protoreflect.FieldDescriptor) bool {
 	switch fd.FullName() {
-	case "cosmos.gov.v1.QueryTallyResultResponse.tally":
-		return x.Tally != nil
+	case "cosmos.gov.v1.QueryParamsResponse.voting_params":
+		return x.VotingParams != nil
+	case "cosmos.gov.v1.QueryParamsResponse.deposit_params":
+		return x.DepositParams != nil
+	case "cosmos.gov.v1.QueryParamsResponse.tally_params":
+		return x.TallyParams != nil
+	case "cosmos.gov.v1.QueryParamsResponse.params":
+		return x.Params != nil
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.gov.v1.QueryTallyResultResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.gov.v1.QueryParamsResponse"))
 		}
-		panic(fmt.Errorf("message cosmos.gov.v1.QueryTallyResultResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.gov.v1.QueryParamsResponse does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -8341,15 +8089,21 @@ func (x *fastReflection_QueryTallyResultResponse) Has(fd protoreflect.FieldDescr
 // associated with the given field number.
 //
 // Clear is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryTallyResultResponse) Clear(fd protoreflect.FieldDescriptor) {
+func (x *fastReflection_QueryParamsResponse) Clear(fd protoreflect.FieldDescriptor) {
 	switch fd.FullName() {
-	case "cosmos.gov.v1.QueryTallyResultResponse.tally":
-		x.Tally = nil
+	case "cosmos.gov.v1.QueryParamsResponse.voting_params":
+		x.VotingParams = nil
+	case "cosmos.gov.v1.QueryParamsResponse.deposit_params":
+		x.DepositParams = nil
+	case "cosmos.gov.v1.QueryParamsResponse.tally_params":
+		x.TallyParams = nil
+	case "cosmos.gov.v1.QueryParamsResponse.params":
+		x.Params = nil
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.gov.v1.QueryTallyResultResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.gov.v1.QueryParamsResponse"))
 		}
-		panic(fmt.Errorf("message cosmos.gov.v1.QueryTallyResultResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.gov.v1.QueryParamsResponse does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -8359,16 +8113,25 @@ func (x *fastReflection_QueryTallyResultResponse) Clear(fd protoreflect.FieldDes
 // the default value of a bytes scalar is guaranteed to be a copy.
 // For unpopulated composite types, it returns an empty, read-only view
 // of the value; to obtain a mutable reference, use Mutable.
-func (x *fastReflection_QueryTallyResultResponse) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_QueryParamsResponse) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
 	switch descriptor.FullName() {
-	case "cosmos.gov.v1.QueryTallyResultResponse.tally":
-		value := x.Tally
+	case "cosmos.gov.v1.QueryParamsResponse.voting_params":
+		value := x.VotingParams
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "cosmos.gov.v1.QueryParamsResponse.deposit_params":
+		value := x.DepositParams
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "cosmos.gov.v1.QueryParamsResponse.tally_params":
+		value := x.TallyParams
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "cosmos.gov.v1.QueryParamsResponse.params":
+		value := x.Params
 		return protoreflect.ValueOfMessage(value.ProtoReflect())
 	default:
 		if descriptor.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.gov.v1.QueryTallyResultResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.gov.v1.QueryParamsResponse"))
 		}
-		panic(fmt.Errorf("message cosmos.gov.v1.QueryTallyResultResponse does not contain field %s", descriptor.FullName()))
+		panic(fmt.Errorf("message cosmos.gov.v1.QueryParamsResponse does not contain field %s", descriptor.FullName()))
 	}
 }
 
@@ -8382,15 +8145,21 @@ func (x *fastReflection_QueryTallyResultResponse) Get(descriptor protoreflect.Fi
 // empty, read-only value, then it panics.
 //
 // Set is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryTallyResultResponse) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+func (x *fastReflection_QueryParamsResponse) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
 	switch fd.FullName() {
-	case "cosmos.gov.v1.QueryTallyResultResponse.tally":
-		x.Tally = value.Message().Interface().(*TallyResult)
+	case "cosmos.gov.v1.QueryParamsResponse.voting_params":
+		x.VotingParams = value.Message().Interface().(*VotingParams)
+	case "cosmos.gov.v1.QueryParamsResponse.deposit_params":
+		x.DepositParams = value.Message().Interface().(*DepositParams)
+	case "cosmos.gov.v1.QueryParamsResponse.tally_params":
+		x.TallyParams = value.Message().Interface().(*TallyParams)
+	case "cosmos.gov.v1.QueryParamsResponse.params":
+		x.Params = value.Message().Interface().(*Params)
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.gov.v1.QueryTallyResultResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.gov.v1.QueryParamsResponse"))
 		}
-		panic(fmt.Errorf("message cosmos.gov.v1.QueryTallyResultResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.gov.v1.QueryParamsResponse does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -8404,44 +8173,68 @@ func (x *fastReflection_QueryTallyResultResponse) Set(fd protoreflect.FieldDescr
 // It panics if the field does not contain a composite type.
 //
 // Mutable is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryTallyResultResponse) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_QueryParamsResponse) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "cosmos.gov.v1.QueryTallyResultResponse.tally":
-		if x.Tally == nil {
-			x.Tally = new(TallyResult)
+	case "cosmos.gov.v1.QueryParamsResponse.voting_params":
+		if x.VotingParams == nil {
+			x.VotingParams = new(VotingParams)
 		}
-		return protoreflect.ValueOfMessage(x.Tally.ProtoReflect())
+		return protoreflect.ValueOfMessage(x.VotingParams.ProtoReflect())
+	case "cosmos.gov.v1.QueryParamsResponse.deposit_params":
+		if x.DepositParams == nil {
+			x.DepositParams = new(DepositParams)
+		}
+		return protoreflect.ValueOfMessage(x.DepositParams.ProtoReflect())
+	case "cosmos.gov.v1.QueryParamsResponse.tally_params":
+		if x.TallyParams == nil {
+			x.TallyParams = new(TallyParams)
+		}
+		return protoreflect.ValueOfMessage(x.TallyParams.ProtoReflect())
+	case "cosmos.gov.v1.QueryParamsResponse.params":
+		if x.Params == nil {
+			x.Params = new(Params)
+		}
+		return protoreflect.ValueOfMessage(x.Params.ProtoReflect())
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.gov.v1.QueryTallyResultResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.gov.v1.QueryParamsResponse"))
 		}
-		panic(fmt.Errorf("message cosmos.gov.v1.QueryTallyResultResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.gov.v1.QueryParamsResponse does not contain field %s", fd.FullName()))
 	}
 }
 
 // NewField returns a new value that is assignable to the field
 // for the given descriptor. For scalars, this returns the default value.
 // For lists, maps, and messages, this returns a new, empty, mutable value.
-func (x *fastReflection_QueryTallyResultResponse) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_QueryParamsResponse) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "cosmos.gov.v1.QueryTallyResultResponse.tally":
-		m := new(TallyResult)
+	case "cosmos.gov.v1.QueryParamsResponse.voting_params":
+		m := new(VotingParams)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case "cosmos.gov.v1.QueryParamsResponse.deposit_params":
+		m := new(DepositParams)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case "cosmos.gov.v1.QueryParamsResponse.tally_params":
+		m := new(TallyParams)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case "cosmos.gov.v1.QueryParamsResponse.params":
+		m := new(Params)
 		return protoreflect.ValueOfMessage(m.ProtoReflect())
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.gov.v1.QueryTallyResultResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.gov.v1.QueryParamsResponse"))
 		}
-		panic(fmt.Errorf("message cosmos.gov.v1.QueryTallyResultResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.gov.v1.QueryParamsResponse does not contain field %s", fd.FullName()))
 	}
 }
 
 // WhichOneof reports which field within the oneof is populated,
 // returning nil if none are populated.
 // It panics if the oneof descriptor does not belong to this message.
-func (x *fastReflection_QueryTallyResultResponse) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+func (x *fastReflection_QueryParamsResponse) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
 	switch d.FullName() {
 	default:
-		panic(fmt.Errorf("%s is not a oneof field in cosmos.gov.v1.QueryTallyResultResponse", d.FullName()))
+		panic(fmt.Errorf("%s is not a oneof field in cosmos.gov.v1.QueryParamsResponse", d.FullName()))
 	}
 	panic("unreachable")
 }
@@ -8449,7 +8242,7 @@ func (x *fastReflection_QueryTallyResultResponse) WhichOneof(d protoreflect.Oneo
 // GetUnknown retrieves the entire list of unknown fields.
 // The caller may only mutate the contents of the RawFields
 // if the mutated bytes are stored back into the message with SetUnknown.
-func (x *fastReflection_QueryTallyResultResponse) GetUnknown() protoreflect.RawFields {
+func (x *fastReflection_QueryParamsResponse) GetUnknown() protoreflect.RawFields {
 	return x.unknownFields
 }
 
@@ -8460,7 +8253,7 @@ func (x *fastReflection_QueryTallyResultResponse) GetUnknown() protoreflect.RawF
 // An empty RawFields may be passed to clear the fields.
 //
 // SetUnknown is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryTallyResultResponse) SetUnknown(fields protoreflect.RawFields) {
+func (x *fastReflection_QueryParamsResponse) SetUnknown(fields protoreflect.RawFields) {
 	x.unknownFields = fields
 }
 
@@ -8472,7 +8265,7 @@ func (x *fastReflection_QueryTallyResultResponse) SetUnknown(fields protoreflect
 // message type, but the details are implementation dependent.
 // Validity is not part of the protobuf data model, and may not
 // be preserved in marshaling or other operations.
-func (x *fastReflection_QueryTallyResultResponse) IsValid() bool {
+func (x *fastReflection_QueryParamsResponse) IsValid() bool {
 	return x != nil
 }
 
@@ -8482,9 +8275,9 @@ func (x *fastReflection_QueryTallyResultResponse) IsValid() bool {
 // The returned methods type is identical to
 // "google.golang.org/protobuf/runtime/protoiface".Methods.
 // Consult the protoiface package documentation for details.
-func (x *fastReflection_QueryTallyResultResponse) ProtoMethods() *protoiface.Methods {
+func (x *fastReflection_QueryParamsResponse) ProtoMethods() *protoiface.Methods {
 	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
-		x := input.Message.Interface().(*QueryTallyResultResponse)
+		x := input.Message.Interface().(*QueryParamsResponse)
 		if x == nil {
 			return protoiface.SizeOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -8496,21 +8289,33 @@ func (x *fastReflection_QueryTallyResultResponse) ProtoMethods() *protoiface.Met
 		var n int
 		var l int
 		_ = l
-		if x.Tally != nil {
-			l = options.Size(x.Tally)
+		if x.VotingParams != nil {
+			l = options.Size(x.VotingParams)
 			n += 1 + l + runtime.Sov(uint64(l))
 		}
-		if x.unknownFields != nil {
-			n += len(x.unknownFields)
-		}
-		return protoiface.SizeOutput{
-			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
-			Size:              n,
+		if x.DepositParams != nil {
+			l = options.Size(x.DepositParams)
+			n += 1 + l + runtime.Sov(uint64(l))
 		}
-	}
+		if x.TallyParams != nil {
+			l = options.Size(x.TallyParams)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.Params != nil {
+			l = options.Size(x.Params)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
 
 	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
-		x := input.Message.Interface().(*QueryTallyResultResponse)
+		x := input.Message.Interface().(*QueryParamsResponse)
 		if x == nil {
 			return protoiface.MarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -8529,8 +8334,50 @@ func (x *fastReflection_QueryTallyResultResponse) ProtoMethods() *protoiface.Met
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
-		if x.Tally != nil {
-			encoded, err := options.Marshal(x.Tally)
+		if x.Params != nil {
+			encoded, err := options.Marshal(x.Params)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x22
+		}
+		if x.TallyParams != nil {
+			encoded, err := options.Marshal(x.TallyParams)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x1a
+		}
+		if x.DepositParams != nil {
+			encoded, err := options.Marshal(x.DepositParams)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x12
+		}
+		if x.VotingParams != nil {
+			encoded, err := options.Marshal(x.VotingParams)
 			if err != nil {
 				return protoiface.MarshalOutput{
 					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -8554,7 +8401,7 @@ func (x *fastReflection_QueryTallyResultResponse) ProtoMethods() *protoiface.Met
 		}, nil
 	}
 	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
-		x := input.Message.Interface().(*QueryTallyResultResponse)
+		x := input.Message.Interface().(*QueryParamsResponse)
 		if x == nil {
 			return protoiface.UnmarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -8586,15 +8433,15 @@ func (x *fastReflection_QueryTallyResultResponse) ProtoMethods() *protoiface.Met
 			fieldNum := int32(wire >> 3)
 			wireType := int(wire & 0x7)
 			if wireType == 4 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryTallyResultResponse: wiretype end group for non-group")
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryParamsResponse: wiretype end group for non-group")
 			}
 			if fieldNum <= 0 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryTallyResultResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 			}
 			switch fieldNum {
 			case 1:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Tally", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field VotingParams", wireType)
 				}
 				var msglen int
 				for shift := uint(0); ; shift += 7 {
@@ -8621,10 +8468,118 @@ func (x *fastReflection_QueryTallyResultResponse) ProtoMethods() *protoiface.Met
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				if x.Tally == nil {
-					x.Tally = &TallyResult{}
+				if x.VotingParams == nil {
+					x.VotingParams = &VotingParams{}
 				}
-				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Tally); err != nil {
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.VotingParams); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field DepositParams", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.DepositParams == nil {
+					x.DepositParams = &DepositParams{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.DepositParams); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 3:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field TallyParams", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.TallyParams == nil {
+					x.TallyParams = &TallyParams{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.TallyParams); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 4:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.Params == nil {
+					x.Params = &Params{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Params); err != nil {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
 				}
 				iNdEx = postIndex
@@ -8663,805 +8618,9115 @@ func (x *fastReflection_QueryTallyResultResponse) ProtoMethods() *protoiface.Met
 	}
 }
 
-// Since: cosmos-sdk 0.46
+var (
+	md_QueryDepositRequest             protoreflect.MessageDescriptor
+	fd_QueryDepositRequest_proposal_id protoreflect.FieldDescriptor
+	fd_QueryDepositRequest_depositor   protoreflect.FieldDescriptor
+)
 
-// Code generated by protoc-gen-go. DO NOT EDIT.
-// versions:
-// 	protoc-gen-go v1.27.0
-// 	protoc        (unknown)
-// source: cosmos/gov/v1/query.proto
+func init() {
+	file_cosmos_gov_v1_query_proto_init()
+	md_QueryDepositRequest = File_cosmos_gov_v1_query_proto.Messages().ByName("QueryDepositRequest")
+	fd_QueryDepositRequest_proposal_id = md_QueryDepositRequest.Fields().ByName("proposal_id")
+	fd_QueryDepositRequest_depositor = md_QueryDepositRequest.Fields().ByName("depositor")
+}
 
-const (
-	// Verify that this generated code is sufficiently up-to-date.
-	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
-	// Verify that runtime/protoimpl is sufficiently up-to-date.
-	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
-)
+var _ protoreflect.Message = (*fastReflection_QueryDepositRequest)(nil)
+
+type fastReflection_QueryDepositRequest QueryDepositRequest
+
+func (x *QueryDepositRequest) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_QueryDepositRequest)(x)
+}
+
+func (x *QueryDepositRequest) slowProtoReflect() protoreflect.Message {
+	mi := &file_cosmos_gov_v1_query_proto_msgTypes[18]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_QueryDepositRequest_messageType fastReflection_QueryDepositRequest_messageType
+var _ protoreflect.MessageType = fastReflection_QueryDepositRequest_messageType{}
+
+type fastReflection_QueryDepositRequest_messageType struct{}
+
+func (x fastReflection_QueryDepositRequest_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_QueryDepositRequest)(nil)
+}
+func (x fastReflection_QueryDepositRequest_messageType) New() protoreflect.Message {
+	return new(fastReflection_QueryDepositRequest)
+}
+func (x fastReflection_QueryDepositRequest_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryDepositRequest
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_QueryDepositRequest) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryDepositRequest
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_QueryDepositRequest) Type() protoreflect.MessageType {
+	return _fastReflection_QueryDepositRequest_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_QueryDepositRequest) New() protoreflect.Message {
+	return new(fastReflection_QueryDepositRequest)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_QueryDepositRequest) Interface() protoreflect.ProtoMessage {
+	return (*QueryDepositRequest)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_QueryDepositRequest) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.ProposalId != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.ProposalId)
+		if !f(fd_QueryDepositRequest_proposal_id, value) {
+			return
+		}
+	}
+	if x.Depositor != "" {
+		value := protoreflect.ValueOfString(x.Depositor)
+		if !f(fd_QueryDepositRequest_depositor, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_QueryDepositRequest) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "cosmos.gov.v1.QueryDepositRequest.proposal_id":
+		return x.ProposalId != uint64(0)
+	case "cosmos.gov.v1.QueryDepositRequest.depositor":
+		return x.Depositor != ""
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.gov.v1.QueryDepositRequest"))
+		}
+		panic(fmt.Errorf("message cosmos.gov.v1.QueryDepositRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryDepositRequest) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "cosmos.gov.v1.QueryDepositRequest.proposal_id":
+		x.ProposalId = uint64(0)
+	case "cosmos.gov.v1.QueryDepositRequest.depositor":
+		x.Depositor = ""
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.gov.v1.QueryDepositRequest"))
+		}
+		panic(fmt.Errorf("message cosmos.gov.v1.QueryDepositRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_QueryDepositRequest) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "cosmos.gov.v1.QueryDepositRequest.proposal_id":
+		value := x.ProposalId
+		return protoreflect.ValueOfUint64(value)
+	case "cosmos.gov.v1.QueryDepositRequest.depositor":
+		value := x.Depositor
+		return protoreflect.ValueOfString(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.gov.v1.QueryDepositRequest"))
+		}
+		panic(fmt.Errorf("message cosmos.gov.v1.QueryDepositRequest does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryDepositRequest) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "cosmos.gov.v1.QueryDepositRequest.proposal_id":
+		x.ProposalId = value.Uint()
+	case "cosmos.gov.v1.QueryDepositRequest.depositor":
+		x.Depositor = value.Interface().(string)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.gov.v1.QueryDepositRequest"))
+		}
+		panic(fmt.Errorf("message cosmos.gov.v1.QueryDepositRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryDepositRequest) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "cosmos.gov.v1.QueryDepositRequest.proposal_id":
+		panic(fmt.Errorf("field proposal_id of message cosmos.gov.v1.QueryDepositRequest is not mutable"))
+	case "cosmos.gov.v1.QueryDepositRequest.depositor":
+		panic(fmt.Errorf("field depositor of message cosmos.gov.v1.QueryDepositRequest is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.gov.v1.QueryDepositRequest"))
+		}
+		panic(fmt.Errorf("message cosmos.gov.v1.QueryDepositRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_QueryDepositRequest) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "cosmos.gov.v1.QueryDepositRequest.proposal_id":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "cosmos.gov.v1.QueryDepositRequest.depositor":
+		return protoreflect.ValueOfString("")
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.gov.v1.QueryDepositRequest"))
+		}
+		panic(fmt.Errorf("message cosmos.gov.v1.QueryDepositRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_QueryDepositRequest) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in cosmos.gov.v1.QueryDepositRequest", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_QueryDepositRequest) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryDepositRequest) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_QueryDepositRequest) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_QueryDepositRequest) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*QueryDepositRequest)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if x.ProposalId != 0 {
+			n += 1 + runtime.Sov(uint64(x.ProposalId))
+		}
+		l = len(x.Depositor)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*QueryDepositRequest)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if len(x.Depositor) > 0 {
+			i -= len(x.Depositor)
+			copy(dAtA[i:], x.Depositor)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Depositor)))
+			i--
+			dAtA[i] = 0x12
+		}
+		if x.ProposalId != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.ProposalId))
+			i--
+			dAtA[i] = 0x8
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*QueryDepositRequest)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryDepositRequest: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryDepositRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ProposalId", wireType)
+				}
+				x.ProposalId = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.ProposalId |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Depositor", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Depositor = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_QueryDepositResponse         protoreflect.MessageDescriptor
+	fd_QueryDepositResponse_deposit protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_cosmos_gov_v1_query_proto_init()
+	md_QueryDepositResponse = File_cosmos_gov_v1_query_proto.Messages().ByName("QueryDepositResponse")
+	fd_QueryDepositResponse_deposit = md_QueryDepositResponse.Fields().ByName("deposit")
+}
+
+var _ protoreflect.Message = (*fastReflection_QueryDepositResponse)(nil)
+
+type fastReflection_QueryDepositResponse QueryDepositResponse
+
+func (x *QueryDepositResponse) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_QueryDepositResponse)(x)
+}
+
+func (x *QueryDepositResponse) slowProtoReflect() protoreflect.Message {
+	mi := &file_cosmos_gov_v1_query_proto_msgTypes[19]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_QueryDepositResponse_messageType fastReflection_QueryDepositResponse_messageType
+var _ protoreflect.MessageType = fastReflection_QueryDepositResponse_messageType{}
+
+type fastReflection_QueryDepositResponse_messageType struct{}
+
+func (x fastReflection_QueryDepositResponse_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_QueryDepositResponse)(nil)
+}
+func (x fastReflection_QueryDepositResponse_messageType) New() protoreflect.Message {
+	return new(fastReflection_QueryDepositResponse)
+}
+func (x fastReflection_QueryDepositResponse_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryDepositResponse
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_QueryDepositResponse) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryDepositResponse
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_QueryDepositResponse) Type() protoreflect.MessageType {
+	return _fastReflection_QueryDepositResponse_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_QueryDepositResponse) New() protoreflect.Message {
+	return new(fastReflection_QueryDepositResponse)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_QueryDepositResponse) Interface() protoreflect.ProtoMessage {
+	return (*QueryDepositResponse)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_QueryDepositResponse) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Deposit != nil {
+		value := protoreflect.ValueOfMessage(x.Deposit.ProtoReflect())
+		if !f(fd_QueryDepositResponse_deposit, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_QueryDepositResponse) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "cosmos.gov.v1.QueryDepositResponse.deposit":
+		return x.Deposit != nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.gov.v1.QueryDepositResponse"))
+		}
+		panic(fmt.Errorf("message cosmos.gov.v1.QueryDepositResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryDepositResponse) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "cosmos.gov.v1.QueryDepositResponse.deposit":
+		x.Deposit = nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.gov.v1.QueryDepositResponse"))
+		}
+		panic(fmt.Errorf("message cosmos.gov.v1.QueryDepositResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_QueryDepositResponse) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "cosmos.gov.v1.QueryDepositResponse.deposit":
+		value := x.Deposit
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.gov.v1.QueryDepositResponse"))
+		}
+		panic(fmt.Errorf("message cosmos.gov.v1.QueryDepositResponse does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryDepositResponse) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "cosmos.gov.v1.QueryDepositResponse.deposit":
+		x.Deposit = value.Message().Interface().(*Deposit)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.gov.v1.QueryDepositResponse"))
+		}
+		panic(fmt.Errorf("message cosmos.gov.v1.QueryDepositResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryDepositResponse) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "cosmos.gov.v1.QueryDepositResponse.deposit":
+		if x.Deposit == nil {
+			x.Deposit = new(Deposit)
+		}
+		return protoreflect.ValueOfMessage(x.Deposit.ProtoReflect())
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.gov.v1.QueryDepositResponse"))
+		}
+		panic(fmt.Errorf("message cosmos.gov.v1.QueryDepositResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_QueryDepositResponse) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "cosmos.gov.v1.QueryDepositResponse.deposit":
+		m := new(Deposit)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.gov.v1.QueryDepositResponse"))
+		}
+		panic(fmt.Errorf("message cosmos.gov.v1.QueryDepositResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_QueryDepositResponse) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in cosmos.gov.v1.QueryDepositResponse", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_QueryDepositResponse) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryDepositResponse) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_QueryDepositResponse) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_QueryDepositResponse) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*QueryDepositResponse)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if x.Deposit != nil {
+			l = options.Size(x.Deposit)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*QueryDepositResponse)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if x.Deposit != nil {
+			encoded, err := options.Marshal(x.Deposit)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*QueryDepositResponse)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryDepositResponse: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryDepositResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Deposit", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.Deposit == nil {
+					x.Deposit = &Deposit{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Deposit); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_QueryDepositsRequest             protoreflect.MessageDescriptor
+	fd_QueryDepositsRequest_proposal_id protoreflect.FieldDescriptor
+	fd_QueryDepositsRequest_pagination  protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_cosmos_gov_v1_query_proto_init()
+	md_QueryDepositsRequest = File_cosmos_gov_v1_query_proto.Messages().ByName("QueryDepositsRequest")
+	fd_QueryDepositsRequest_proposal_id = md_QueryDepositsRequest.Fields().ByName("proposal_id")
+	fd_QueryDepositsRequest_pagination = md_QueryDepositsRequest.Fields().ByName("pagination")
+}
+
+var _ protoreflect.Message = (*fastReflection_QueryDepositsRequest)(nil)
+
+type fastReflection_QueryDepositsRequest QueryDepositsRequest
+
+func (x *QueryDepositsRequest) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_QueryDepositsRequest)(x)
+}
+
+func (x *QueryDepositsRequest) slowProtoReflect() protoreflect.Message {
+	mi := &file_cosmos_gov_v1_query_proto_msgTypes[20]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_QueryDepositsRequest_messageType fastReflection_QueryDepositsRequest_messageType
+var _ protoreflect.MessageType = fastReflection_QueryDepositsRequest_messageType{}
+
+type fastReflection_QueryDepositsRequest_messageType struct{}
+
+func (x fastReflection_QueryDepositsRequest_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_QueryDepositsRequest)(nil)
+}
+func (x fastReflection_QueryDepositsRequest_messageType) New() protoreflect.Message {
+	return new(fastReflection_QueryDepositsRequest)
+}
+func (x fastReflection_QueryDepositsRequest_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryDepositsRequest
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_QueryDepositsRequest) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryDepositsRequest
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_QueryDepositsRequest) Type() protoreflect.MessageType {
+	return _fastReflection_QueryDepositsRequest_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_QueryDepositsRequest) New() protoreflect.Message {
+	return new(fastReflection_QueryDepositsRequest)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_QueryDepositsRequest) Interface() protoreflect.ProtoMessage {
+	return (*QueryDepositsRequest)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_QueryDepositsRequest) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.ProposalId != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.ProposalId)
+		if !f(fd_QueryDepositsRequest_proposal_id, value) {
+			return
+		}
+	}
+	if x.Pagination != nil {
+		value := protoreflect.ValueOfMessage(x.Pagination.ProtoReflect())
+		if !f(fd_QueryDepositsRequest_pagination, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_QueryDepositsRequest) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "cosmos.gov.v1.QueryDepositsRequest.proposal_id":
+		return x.ProposalId != uint64(0)
+	case "cosmos.gov.v1.QueryDepositsRequest.pagination":
+		return x.Pagination != nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.gov.v1.QueryDepositsRequest"))
+		}
+		panic(fmt.Errorf("message cosmos.gov.v1.QueryDepositsRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryDepositsRequest) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "cosmos.gov.v1.QueryDepositsRequest.proposal_id":
+		x.ProposalId = uint64(0)
+	case "cosmos.gov.v1.QueryDepositsRequest.pagination":
+		x.Pagination = nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.gov.v1.QueryDepositsRequest"))
+		}
+		panic(fmt.Errorf("message cosmos.gov.v1.QueryDepositsRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_QueryDepositsRequest) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "cosmos.gov.v1.QueryDepositsRequest.proposal_id":
+		value := x.ProposalId
+		return protoreflect.ValueOfUint64(value)
+	case "cosmos.gov.v1.QueryDepositsRequest.pagination":
+		value := x.Pagination
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.gov.v1.QueryDepositsRequest"))
+		}
+		panic(fmt.Errorf("message cosmos.gov.v1.QueryDepositsRequest does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryDepositsRequest) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "cosmos.gov.v1.QueryDepositsRequest.proposal_id":
+		x.ProposalId = value.Uint()
+	case "cosmos.gov.v1.QueryDepositsRequest.pagination":
+		x.Pagination = value.Message().Interface().(*v1beta1.PageRequest)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.gov.v1.QueryDepositsRequest"))
+		}
+		panic(fmt.Errorf("message cosmos.gov.v1.QueryDepositsRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryDepositsRequest) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "cosmos.gov.v1.QueryDepositsRequest.pagination":
+		if x.Pagination == nil {
+			x.Pagination = new(v1beta1.PageRequest)
+		}
+		return protoreflect.ValueOfMessage(x.Pagination.ProtoReflect())
+	case "cosmos.gov.v1.QueryDepositsRequest.proposal_id":
+		panic(fmt.Errorf("field proposal_id of message cosmos.gov.v1.QueryDepositsRequest is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.gov.v1.QueryDepositsRequest"))
+		}
+		panic(fmt.Errorf("message cosmos.gov.v1.QueryDepositsRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_QueryDepositsRequest) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "cosmos.gov.v1.QueryDepositsRequest.proposal_id":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "cosmos.gov.v1.QueryDepositsRequest.pagination":
+		m := new(v1beta1.PageRequest)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.gov.v1.QueryDepositsRequest"))
+		}
+		panic(fmt.Errorf("message cosmos.gov.v1.QueryDepositsRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_QueryDepositsRequest) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in cosmos.gov.v1.QueryDepositsRequest", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_QueryDepositsRequest) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryDepositsRequest) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_QueryDepositsRequest) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_QueryDepositsRequest) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*QueryDepositsRequest)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if x.ProposalId != 0 {
+			n += 1 + runtime.Sov(uint64(x.ProposalId))
+		}
+		if x.Pagination != nil {
+			l = options.Size(x.Pagination)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*QueryDepositsRequest)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if x.Pagination != nil {
+			encoded, err := options.Marshal(x.Pagination)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x12
+		}
+		if x.ProposalId != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.ProposalId))
+			i--
+			dAtA[i] = 0x8
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*QueryDepositsRequest)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryDepositsRequest: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryDepositsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ProposalId", wireType)
+				}
+				x.ProposalId = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.ProposalId |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.Pagination == nil {
+					x.Pagination = &v1beta1.PageRequest{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Pagination); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var _ protoreflect.List = (*_QueryDepositsResponse_1_list)(nil)
+
+type _QueryDepositsResponse_1_list struct {
+	list *[]*Deposit
+}
+
+func (x *_QueryDepositsResponse_1_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_QueryDepositsResponse_1_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_QueryDepositsResponse_1_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*Deposit)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_QueryDepositsResponse_1_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*Deposit)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_QueryDepositsResponse_1_list) AppendMutable() protoreflect.Value {
+	v := new(Deposit)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_QueryDepositsResponse_1_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_QueryDepositsResponse_1_list) NewElement() protoreflect.Value {
+	v := new(Deposit)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_QueryDepositsResponse_1_list) IsValid() bool {
+	return x.list != nil
+}
+
+var (
+	md_QueryDepositsResponse            protoreflect.MessageDescriptor
+	fd_QueryDepositsResponse_deposits   protoreflect.FieldDescriptor
+	fd_QueryDepositsResponse_pagination protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_cosmos_gov_v1_query_proto_init()
+	md_QueryDepositsResponse = File_cosmos_gov_v1_query_proto.Messages().ByName("QueryDepositsResponse")
+	fd_QueryDepositsResponse_deposits = md_QueryDepositsResponse.Fields().ByName("deposits")
+	fd_QueryDepositsResponse_pagination = md_QueryDepositsResponse.Fields().ByName("pagination")
+}
+
+var _ protoreflect.Message = (*fastReflection_QueryDepositsResponse)(nil)
+
+type fastReflection_QueryDepositsResponse QueryDepositsResponse
+
+func (x *QueryDepositsResponse) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_QueryDepositsResponse)(x)
+}
+
+func (x *QueryDepositsResponse) slowProtoReflect() protoreflect.Message {
+	mi := &file_cosmos_gov_v1_query_proto_msgTypes[21]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_QueryDepositsResponse_messageType fastReflection_QueryDepositsResponse_messageType
+var _ protoreflect.MessageType = fastReflection_QueryDepositsResponse_messageType{}
+
+type fastReflection_QueryDepositsResponse_messageType struct{}
+
+func (x fastReflection_QueryDepositsResponse_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_QueryDepositsResponse)(nil)
+}
+func (x fastReflection_QueryDepositsResponse_messageType) New() protoreflect.Message {
+	return new(fastReflection_QueryDepositsResponse)
+}
+func (x fastReflection_QueryDepositsResponse_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryDepositsResponse
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_QueryDepositsResponse) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryDepositsResponse
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_QueryDepositsResponse) Type() protoreflect.MessageType {
+	return _fastReflection_QueryDepositsResponse_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_QueryDepositsResponse) New() protoreflect.Message {
+	return new(fastReflection_QueryDepositsResponse)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_QueryDepositsResponse) Interface() protoreflect.ProtoMessage {
+	return (*QueryDepositsResponse)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_QueryDepositsResponse) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if len(x.Deposits) != 0 {
+		value := protoreflect.ValueOfList(&_QueryDepositsResponse_1_list{list: &x.Deposits})
+		if !f(fd_QueryDepositsResponse_deposits, value) {
+			return
+		}
+	}
+	if x.Pagination != nil {
+		value := protoreflect.ValueOfMessage(x.Pagination.ProtoReflect())
+		if !f(fd_QueryDepositsResponse_pagination, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_QueryDepositsResponse) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "cosmos.gov.v1.QueryDepositsResponse.deposits":
+		return len(x.Deposits) != 0
+	case "cosmos.gov.v1.QueryDepositsResponse.pagination":
+		return x.Pagination != nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.gov.v1.QueryDepositsResponse"))
+		}
+		panic(fmt.Errorf("message cosmos.gov.v1.QueryDepositsResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryDepositsResponse) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "cosmos.gov.v1.QueryDepositsResponse.deposits":
+		x.Deposits = nil
+	case "cosmos.gov.v1.QueryDepositsResponse.pagination":
+		x.Pagination = nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.gov.v1.QueryDepositsResponse"))
+		}
+		panic(fmt.Errorf("message cosmos.gov.v1.QueryDepositsResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_QueryDepositsResponse) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "cosmos.gov.v1.QueryDepositsResponse.deposits":
+		if len(x.Deposits) == 0 {
+			return protoreflect.ValueOfList(&_QueryDepositsResponse_1_list{})
+		}
+		listValue := &_QueryDepositsResponse_1_list{list: &x.Deposits}
+		return protoreflect.ValueOfList(listValue)
+	case "cosmos.gov.v1.QueryDepositsResponse.pagination":
+		value := x.Pagination
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.gov.v1.QueryDepositsResponse"))
+		}
+		panic(fmt.Errorf("message cosmos.gov.v1.QueryDepositsResponse does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryDepositsResponse) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "cosmos.gov.v1.QueryDepositsResponse.deposits":
+		lv := value.List()
+		clv := lv.(*_QueryDepositsResponse_1_list)
+		x.Deposits = *clv.list
+	case "cosmos.gov.v1.QueryDepositsResponse.pagination":
+		x.Pagination = value.Message().Interface().(*v1beta1.PageResponse)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.gov.v1.QueryDepositsResponse"))
+		}
+		panic(fmt.Errorf("message cosmos.gov.v1.QueryDepositsResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryDepositsResponse) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "cosmos.gov.v1.QueryDepositsResponse.deposits":
+		if x.Deposits == nil {
+			x.Deposits = []*Deposit{}
+		}
+		value := &_QueryDepositsResponse_1_list{list: &x.Deposits}
+		return protoreflect.ValueOfList(value)
+	case "cosmos.gov.v1.QueryDepositsResponse.pagination":
+		if x.Pagination == nil {
+			x.Pagination = new(v1beta1.PageResponse)
+		}
+		return protoreflect.ValueOfMessage(x.Pagination.ProtoReflect())
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.gov.v1.QueryDepositsResponse"))
+		}
+		panic(fmt.Errorf("message cosmos.gov.v1.QueryDepositsResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_QueryDepositsResponse) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "cosmos.gov.v1.QueryDepositsResponse.deposits":
+		list := []*Deposit{}
+		return protoreflect.ValueOfList(&_QueryDepositsResponse_1_list{list: &list})
+	case "cosmos.gov.v1.QueryDepositsResponse.pagination":
+		m := new(v1beta1.PageResponse)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.gov.v1.QueryDepositsResponse"))
+		}
+		panic(fmt.Errorf("message cosmos.gov.v1.QueryDepositsResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_QueryDepositsResponse) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in cosmos.gov.v1.QueryDepositsResponse", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_QueryDepositsResponse) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryDepositsResponse) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_QueryDepositsResponse) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_QueryDepositsResponse) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*QueryDepositsResponse)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if len(x.Deposits) > 0 {
+			for _, e := range x.Deposits {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if x.Pagination != nil {
+			l = options.Size(x.Pagination)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*QueryDepositsResponse)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if x.Pagination != nil {
+			encoded, err := options.Marshal(x.Pagination)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x12
+		}
+		if len(x.Deposits) > 0 {
+			for iNdEx := len(x.Deposits) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.Deposits[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0xa
+			}
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*QueryDepositsResponse)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryDepositsResponse: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryDepositsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Deposits", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Deposits = append(x.Deposits, &Deposit{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Deposits[len(x.Deposits)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.Pagination == nil {
+					x.Pagination = &v1beta1.PageResponse{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Pagination); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_QueryTallyResultRequest             protoreflect.MessageDescriptor
+	fd_QueryTallyResultRequest_proposal_id protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_cosmos_gov_v1_query_proto_init()
+	md_QueryTallyResultRequest = File_cosmos_gov_v1_query_proto.Messages().ByName("QueryTallyResultRequest")
+	fd_QueryTallyResultRequest_proposal_id = md_QueryTallyResultRequest.Fields().ByName("proposal_id")
+}
+
+var _ protoreflect.Message = (*fastReflection_QueryTallyResultRequest)(nil)
+
+type fastReflection_QueryTallyResultRequest QueryTallyResultRequest
+
+func (x *QueryTallyResultRequest) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_QueryTallyResultRequest)(x)
+}
+
+func (x *QueryTallyResultRequest) slowProtoReflect() protoreflect.Message {
+	mi := &file_cosmos_gov_v1_query_proto_msgTypes[22]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_QueryTallyResultRequest_messageType fastReflection_QueryTallyResultRequest_messageType
+var _ protoreflect.MessageType = fastReflection_QueryTallyResultRequest_messageType{}
+
+type fastReflection_QueryTallyResultRequest_messageType struct{}
+
+func (x fastReflection_QueryTallyResultRequest_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_QueryTallyResultRequest)(nil)
+}
+func (x fastReflection_QueryTallyResultRequest_messageType) New() protoreflect.Message {
+	return new(fastReflection_QueryTallyResultRequest)
+}
+func (x fastReflection_QueryTallyResultRequest_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryTallyResultRequest
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_QueryTallyResultRequest) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryTallyResultRequest
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_QueryTallyResultRequest) Type() protoreflect.MessageType {
+	return _fastReflection_QueryTallyResultRequest_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_QueryTallyResultRequest) New() protoreflect.Message {
+	return new(fastReflection_QueryTallyResultRequest)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_QueryTallyResultRequest) Interface() protoreflect.ProtoMessage {
+	return (*QueryTallyResultRequest)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_QueryTallyResultRequest) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.ProposalId != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.ProposalId)
+		if !f(fd_QueryTallyResultRequest_proposal_id, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_QueryTallyResultRequest) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "cosmos.gov.v1.QueryTallyResultRequest.proposal_id":
+		return x.ProposalId != uint64(0)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.gov.v1.QueryTallyResultRequest"))
+		}
+		panic(fmt.Errorf("message cosmos.gov.v1.QueryTallyResultRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryTallyResultRequest) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "cosmos.gov.v1.QueryTallyResultRequest.proposal_id":
+		x.ProposalId = uint64(0)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.gov.v1.QueryTallyResultRequest"))
+		}
+		panic(fmt.Errorf("message cosmos.gov.v1.QueryTallyResultRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_QueryTallyResultRequest) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "cosmos.gov.v1.QueryTallyResultRequest.proposal_id":
+		value := x.ProposalId
+		return protoreflect.ValueOfUint64(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.gov.v1.QueryTallyResultRequest"))
+		}
+		panic(fmt.Errorf("message cosmos.gov.v1.QueryTallyResultRequest does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryTallyResultRequest) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "cosmos.gov.v1.QueryTallyResultRequest.proposal_id":
+		x.ProposalId = value.Uint()
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.gov.v1.QueryTallyResultRequest"))
+		}
+		panic(fmt.Errorf("message cosmos.gov.v1.QueryTallyResultRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryTallyResultRequest) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "cosmos.gov.v1.QueryTallyResultRequest.proposal_id":
+		panic(fmt.Errorf("field proposal_id of message cosmos.gov.v1.QueryTallyResultRequest is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.gov.v1.QueryTallyResultRequest"))
+		}
+		panic(fmt.Errorf("message cosmos.gov.v1.QueryTallyResultRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_QueryTallyResultRequest) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "cosmos.gov.v1.QueryTallyResultRequest.proposal_id":
+		return protoreflect.ValueOfUint64(uint64(0))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.gov.v1.QueryTallyResultRequest"))
+		}
+		panic(fmt.Errorf("message cosmos.gov.v1.QueryTallyResultRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_QueryTallyResultRequest) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in cosmos.gov.v1.QueryTallyResultRequest", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_QueryTallyResultRequest) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryTallyResultRequest) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_QueryTallyResultRequest) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_QueryTallyResultRequest) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*QueryTallyResultRequest)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if x.ProposalId != 0 {
+			n += 1 + runtime.Sov(uint64(x.ProposalId))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*QueryTallyResultRequest)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if x.ProposalId != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.ProposalId))
+			i--
+			dAtA[i] = 0x8
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*QueryTallyResultRequest)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryTallyResultRequest: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryTallyResultRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ProposalId", wireType)
+				}
+				x.ProposalId = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.ProposalId |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_QueryTallyResultResponse       protoreflect.MessageDescriptor
+	fd_QueryTallyResultResponse_tally protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_cosmos_gov_v1_query_proto_init()
+	md_QueryTallyResultResponse = File_cosmos_gov_v1_query_proto.Messages().ByName("QueryTallyResultResponse")
+	fd_QueryTallyResultResponse_tally = md_QueryTallyResultResponse.Fields().ByName("tally")
+}
+
+var _ protoreflect.Message = (*fastReflection_QueryTallyResultResponse)(nil)
+
+type fastReflection_QueryTallyResultResponse QueryTallyResultResponse
+
+func (x *QueryTallyResultResponse) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_QueryTallyResultResponse)(x)
+}
+
+func (x *QueryTallyResultResponse) slowProtoReflect() protoreflect.Message {
+	mi := &file_cosmos_gov_v1_query_proto_msgTypes[23]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_QueryTallyResultResponse_messageType fastReflection_QueryTallyResultResponse_messageType
+var _ protoreflect.MessageType = fastReflection_QueryTallyResultResponse_messageType{}
+
+type fastReflection_QueryTallyResultResponse_messageType struct{}
+
+func (x fastReflection_QueryTallyResultResponse_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_QueryTallyResultResponse)(nil)
+}
+func (x fastReflection_QueryTallyResultResponse_messageType) New() protoreflect.Message {
+	return new(fastReflection_QueryTallyResultResponse)
+}
+func (x fastReflection_QueryTallyResultResponse_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryTallyResultResponse
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_QueryTallyResultResponse) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryTallyResultResponse
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_QueryTallyResultResponse) Type() protoreflect.MessageType {
+	return _fastReflection_QueryTallyResultResponse_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_QueryTallyResultResponse) New() protoreflect.Message {
+	return new(fastReflection_QueryTallyResultResponse)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_QueryTallyResultResponse) Interface() protoreflect.ProtoMessage {
+	return (*QueryTallyResultResponse)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_QueryTallyResultResponse) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Tally != nil {
+		value := protoreflect.ValueOfMessage(x.Tally.ProtoReflect())
+		if !f(fd_QueryTallyResultResponse_tally, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_QueryTallyResultResponse) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "cosmos.gov.v1.QueryTallyResultResponse.tally":
+		return x.Tally != nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.gov.v1.QueryTallyResultResponse"))
+		}
+		panic(fmt.Errorf("message cosmos.gov.v1.QueryTallyResultResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryTallyResultResponse) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "cosmos.gov.v1.QueryTallyResultResponse.tally":
+		x.Tally = nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.gov.v1.QueryTallyResultResponse"))
+		}
+		panic(fmt.Errorf("message cosmos.gov.v1.QueryTallyResultResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_QueryTallyResultResponse) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "cosmos.gov.v1.QueryTallyResultResponse.tally":
+		value := x.Tally
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.gov.v1.QueryTallyResultResponse"))
+		}
+		panic(fmt.Errorf("message cosmos.gov.v1.QueryTallyResultResponse does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryTallyResultResponse) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "cosmos.gov.v1.QueryTallyResultResponse.tally":
+		x.Tally = value.Message().Interface().(*TallyResult)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.gov.v1.QueryTallyResultResponse"))
+		}
+		panic(fmt.Errorf("message cosmos.gov.v1.QueryTallyResultResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryTallyResultResponse) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "cosmos.gov.v1.QueryTallyResultResponse.tally":
+		if x.Tally == nil {
+			x.Tally = new(TallyResult)
+		}
+		return protoreflect.ValueOfMessage(x.Tally.ProtoReflect())
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.gov.v1.QueryTallyResultResponse"))
+		}
+		panic(fmt.Errorf("message cosmos.gov.v1.QueryTallyResultResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_QueryTallyResultResponse) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "cosmos.gov.v1.QueryTallyResultResponse.tally":
+		m := new(TallyResult)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.gov.v1.QueryTallyResultResponse"))
+		}
+		panic(fmt.Errorf("message cosmos.gov.v1.QueryTallyResultResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_QueryTallyResultResponse) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in cosmos.gov.v1.QueryTallyResultResponse", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_QueryTallyResultResponse) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryTallyResultResponse) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_QueryTallyResultResponse) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_QueryTallyResultResponse) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*QueryTallyResultResponse)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if x.Tally != nil {
+			l = options.Size(x.Tally)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*QueryTallyResultResponse)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if x.Tally != nil {
+			encoded, err := options.Marshal(x.Tally)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*QueryTallyResultResponse)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryTallyResultResponse: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryTallyResultResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Tally", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.Tally == nil {
+					x.Tally = &TallyResult{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Tally); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_QueryValidatorVoteBreakdownsRequest             protoreflect.MessageDescriptor
+	fd_QueryValidatorVoteBreakdownsRequest_proposal_id protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_cosmos_gov_v1_query_proto_init()
+	md_QueryValidatorVoteBreakdownsRequest = File_cosmos_gov_v1_query_proto.Messages().ByName("QueryValidatorVoteBreakdownsRequest")
+	fd_QueryValidatorVoteBreakdownsRequest_proposal_id = md_QueryValidatorVoteBreakdownsRequest.Fields().ByName("proposal_id")
+}
+
+var _ protoreflect.Message = (*fastReflection_QueryValidatorVoteBreakdownsRequest)(nil)
+
+type fastReflection_QueryValidatorVoteBreakdownsRequest QueryValidatorVoteBreakdownsRequest
+
+func (x *QueryValidatorVoteBreakdownsRequest) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_QueryValidatorVoteBreakdownsRequest)(x)
+}
+
+func (x *QueryValidatorVoteBreakdownsRequest) slowProtoReflect() protoreflect.Message {
+	mi := &file_cosmos_gov_v1_query_proto_msgTypes[24]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_QueryValidatorVoteBreakdownsRequest_messageType fastReflection_QueryValidatorVoteBreakdownsRequest_messageType
+var _ protoreflect.MessageType = fastReflection_QueryValidatorVoteBreakdownsRequest_messageType{}
+
+type fastReflection_QueryValidatorVoteBreakdownsRequest_messageType struct{}
+
+func (x fastReflection_QueryValidatorVoteBreakdownsRequest_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_QueryValidatorVoteBreakdownsRequest)(nil)
+}
+func (x fastReflection_QueryValidatorVoteBreakdownsRequest_messageType) New() protoreflect.Message {
+	return new(fastReflection_QueryValidatorVoteBreakdownsRequest)
+}
+func (x fastReflection_QueryValidatorVoteBreakdownsRequest_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryValidatorVoteBreakdownsRequest
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_QueryValidatorVoteBreakdownsRequest) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryValidatorVoteBreakdownsRequest
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_QueryValidatorVoteBreakdownsRequest) Type() protoreflect.MessageType {
+	return _fastReflection_QueryValidatorVoteBreakdownsRequest_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_QueryValidatorVoteBreakdownsRequest) New() protoreflect.Message {
+	return new(fastReflection_QueryValidatorVoteBreakdownsRequest)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_QueryValidatorVoteBreakdownsRequest) Interface() protoreflect.ProtoMessage {
+	return (*QueryValidatorVoteBreakdownsRequest)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_QueryValidatorVoteBreakdownsRequest) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.ProposalId != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.ProposalId)
+		if !f(fd_QueryValidatorVoteBreakdownsRequest_proposal_id, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_QueryValidatorVoteBreakdownsRequest) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "cosmos.gov.v1.QueryValidatorVoteBreakdownsRequest.proposal_id":
+		return x.ProposalId != uint64(0)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.gov.v1.QueryValidatorVoteBreakdownsRequest"))
+		}
+		panic(fmt.Errorf("message cosmos.gov.v1.QueryValidatorVoteBreakdownsRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryValidatorVoteBreakdownsRequest) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "cosmos.gov.v1.QueryValidatorVoteBreakdownsRequest.proposal_id":
+		x.ProposalId = uint64(0)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.gov.v1.QueryValidatorVoteBreakdownsRequest"))
+		}
+		panic(fmt.Errorf("message cosmos.gov.v1.QueryValidatorVoteBreakdownsRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_QueryValidatorVoteBreakdownsRequest) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "cosmos.gov.v1.QueryValidatorVoteBreakdownsRequest.proposal_id":
+		value := x.ProposalId
+		return protoreflect.ValueOfUint64(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.gov.v1.QueryValidatorVoteBreakdownsRequest"))
+		}
+		panic(fmt.Errorf("message cosmos.gov.v1.QueryValidatorVoteBreakdownsRequest does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryValidatorVoteBreakdownsRequest) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "cosmos.gov.v1.QueryValidatorVoteBreakdownsRequest.proposal_id":
+		x.ProposalId = value.Uint()
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.gov.v1.QueryValidatorVoteBreakdownsRequest"))
+		}
+		panic(fmt.Errorf("message cosmos.gov.v1.QueryValidatorVoteBreakdownsRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryValidatorVoteBreakdownsRequest) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "cosmos.gov.v1.QueryValidatorVoteBreakdownsRequest.proposal_id":
+		panic(fmt.Errorf("field proposal_id of message cosmos.gov.v1.QueryValidatorVoteBreakdownsRequest is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.gov.v1.QueryValidatorVoteBreakdownsRequest"))
+		}
+		panic(fmt.Errorf("message cosmos.gov.v1.QueryValidatorVoteBreakdownsRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_QueryValidatorVoteBreakdownsRequest) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "cosmos.gov.v1.QueryValidatorVoteBreakdownsRequest.proposal_id":
+		return protoreflect.ValueOfUint64(uint64(0))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.gov.v1.QueryValidatorVoteBreakdownsRequest"))
+		}
+		panic(fmt.Errorf("message cosmos.gov.v1.QueryValidatorVoteBreakdownsRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_QueryValidatorVoteBreakdownsRequest) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in cosmos.gov.v1.QueryValidatorVoteBreakdownsRequest", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_QueryValidatorVoteBreakdownsRequest) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryValidatorVoteBreakdownsRequest) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_QueryValidatorVoteBreakdownsRequest) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_QueryValidatorVoteBreakdownsRequest) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*QueryValidatorVoteBreakdownsRequest)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if x.ProposalId != 0 {
+			n += 1 + runtime.Sov(uint64(x.ProposalId))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*QueryValidatorVoteBreakdownsRequest)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if x.ProposalId != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.ProposalId))
+			i--
+			dAtA[i] = 0x8
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*QueryValidatorVoteBreakdownsRequest)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryValidatorVoteBreakdownsRequest: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryValidatorVoteBreakdownsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ProposalId", wireType)
+				}
+				x.ProposalId = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.ProposalId |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var _ protoreflect.List = (*_QueryValidatorVoteBreakdownsResponse_1_list)(nil)
+
+type _QueryValidatorVoteBreakdownsResponse_1_list struct {
+	list *[]*ValidatorVoteBreakdown
+}
+
+func (x *_QueryValidatorVoteBreakdownsResponse_1_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_QueryValidatorVoteBreakdownsResponse_1_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_QueryValidatorVoteBreakdownsResponse_1_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*ValidatorVoteBreakdown)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_QueryValidatorVoteBreakdownsResponse_1_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*ValidatorVoteBreakdown)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_QueryValidatorVoteBreakdownsResponse_1_list) AppendMutable() protoreflect.Value {
+	v := new(ValidatorVoteBreakdown)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_QueryValidatorVoteBreakdownsResponse_1_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_QueryValidatorVoteBreakdownsResponse_1_list) NewElement() protoreflect.Value {
+	v := new(ValidatorVoteBreakdown)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_QueryValidatorVoteBreakdownsResponse_1_list) IsValid() bool {
+	return x.list != nil
+}
+
+var (
+	md_QueryValidatorVoteBreakdownsResponse            protoreflect.MessageDescriptor
+	fd_QueryValidatorVoteBreakdownsResponse_breakdowns protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_cosmos_gov_v1_query_proto_init()
+	md_QueryValidatorVoteBreakdownsResponse = File_cosmos_gov_v1_query_proto.Messages().ByName("QueryValidatorVoteBreakdownsResponse")
+	fd_QueryValidatorVoteBreakdownsResponse_breakdowns = md_QueryValidatorVoteBreakdownsResponse.Fields().ByName("breakdowns")
+}
+
+var _ protoreflect.Message = (*fastReflection_QueryValidatorVoteBreakdownsResponse)(nil)
+
+type fastReflection_QueryValidatorVoteBreakdownsResponse QueryValidatorVoteBreakdownsResponse
+
+func (x *QueryValidatorVoteBreakdownsResponse) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_QueryValidatorVoteBreakdownsResponse)(x)
+}
+
+func (x *QueryValidatorVoteBreakdownsResponse) slowProtoReflect() protoreflect.Message {
+	mi := &file_cosmos_gov_v1_query_proto_msgTypes[25]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_QueryValidatorVoteBreakdownsResponse_messageType fastReflection_QueryValidatorVoteBreakdownsResponse_messageType
+var _ protoreflect.MessageType = fastReflection_QueryValidatorVoteBreakdownsResponse_messageType{}
+
+type fastReflection_QueryValidatorVoteBreakdownsResponse_messageType struct{}
+
+func (x fastReflection_QueryValidatorVoteBreakdownsResponse_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_QueryValidatorVoteBreakdownsResponse)(nil)
+}
+func (x fastReflection_QueryValidatorVoteBreakdownsResponse_messageType) New() protoreflect.Message {
+	return new(fastReflection_QueryValidatorVoteBreakdownsResponse)
+}
+func (x fastReflection_QueryValidatorVoteBreakdownsResponse_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryValidatorVoteBreakdownsResponse
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_QueryValidatorVoteBreakdownsResponse) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryValidatorVoteBreakdownsResponse
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_QueryValidatorVoteBreakdownsResponse) Type() protoreflect.MessageType {
+	return _fastReflection_QueryValidatorVoteBreakdownsResponse_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_QueryValidatorVoteBreakdownsResponse) New() protoreflect.Message {
+	return new(fastReflection_QueryValidatorVoteBreakdownsResponse)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_QueryValidatorVoteBreakdownsResponse) Interface() protoreflect.ProtoMessage {
+	return (*QueryValidatorVoteBreakdownsResponse)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_QueryValidatorVoteBreakdownsResponse) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if len(x.Breakdowns) != 0 {
+		value := protoreflect.ValueOfList(&_QueryValidatorVoteBreakdownsResponse_1_list{list: &x.Breakdowns})
+		if !f(fd_QueryValidatorVoteBreakdownsResponse_breakdowns, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_QueryValidatorVoteBreakdownsResponse) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "cosmos.gov.v1.QueryValidatorVoteBreakdownsResponse.breakdowns":
+		return len(x.Breakdowns) != 0
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.gov.v1.QueryValidatorVoteBreakdownsResponse"))
+		}
+		panic(fmt.Errorf("message cosmos.gov.v1.QueryValidatorVoteBreakdownsResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryValidatorVoteBreakdownsResponse) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "cosmos.gov.v1.QueryValidatorVoteBreakdownsResponse.breakdowns":
+		x.Breakdowns = nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.gov.v1.QueryValidatorVoteBreakdownsResponse"))
+		}
+		panic(fmt.Errorf("message cosmos.gov.v1.QueryValidatorVoteBreakdownsResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_QueryValidatorVoteBreakdownsResponse) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "cosmos.gov.v1.QueryValidatorVoteBreakdownsResponse.breakdowns":
+		if len(x.Breakdowns) == 0 {
+			return protoreflect.ValueOfList(&_QueryValidatorVoteBreakdownsResponse_1_list{})
+		}
+		listValue := &_QueryValidatorVoteBreakdownsResponse_1_list{list: &x.Breakdowns}
+		return protoreflect.ValueOfList(listValue)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.gov.v1.QueryValidatorVoteBreakdownsResponse"))
+		}
+		panic(fmt.Errorf("message cosmos.gov.v1.QueryValidatorVoteBreakdownsResponse does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryValidatorVoteBreakdownsResponse) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "cosmos.gov.v1.QueryValidatorVoteBreakdownsResponse.breakdowns":
+		lv := value.List()
+		clv := lv.(*_QueryValidatorVoteBreakdownsResponse_1_list)
+		x.Breakdowns = *clv.list
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.gov.v1.QueryValidatorVoteBreakdownsResponse"))
+		}
+		panic(fmt.Errorf("message cosmos.gov.v1.QueryValidatorVoteBreakdownsResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryValidatorVoteBreakdownsResponse) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "cosmos.gov.v1.QueryValidatorVoteBreakdownsResponse.breakdowns":
+		if x.Breakdowns == nil {
+			x.Breakdowns = []*ValidatorVoteBreakdown{}
+		}
+		value := &_QueryValidatorVoteBreakdownsResponse_1_list{list: &x.Breakdowns}
+		return protoreflect.ValueOfList(value)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.gov.v1.QueryValidatorVoteBreakdownsResponse"))
+		}
+		panic(fmt.Errorf("message cosmos.gov.v1.QueryValidatorVoteBreakdownsResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_QueryValidatorVoteBreakdownsResponse) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "cosmos.gov.v1.QueryValidatorVoteBreakdownsResponse.breakdowns":
+		list := []*ValidatorVoteBreakdown{}
+		return protoreflect.ValueOfList(&_QueryValidatorVoteBreakdownsResponse_1_list{list: &list})
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.gov.v1.QueryValidatorVoteBreakdownsResponse"))
+		}
+		panic(fmt.Errorf("message cosmos.gov.v1.QueryValidatorVoteBreakdownsResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_QueryValidatorVoteBreakdownsResponse) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in cosmos.gov.v1.QueryValidatorVoteBreakdownsResponse", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_QueryValidatorVoteBreakdownsResponse) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryValidatorVoteBreakdownsResponse) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_QueryValidatorVoteBreakdownsResponse) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_QueryValidatorVoteBreakdownsResponse) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*QueryValidatorVoteBreakdownsResponse)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if len(x.Breakdowns) > 0 {
+			for _, e := range x.Breakdowns {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*QueryValidatorVoteBreakdownsResponse)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if len(x.Breakdowns) > 0 {
+			for iNdEx := len(x.Breakdowns) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.Breakdowns[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0xa
+			}
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*QueryValidatorVoteBreakdownsResponse)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryValidatorVoteBreakdownsResponse: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryValidatorVoteBreakdownsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Breakdowns", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Breakdowns = append(x.Breakdowns, &ValidatorVoteBreakdown{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Breakdowns[len(x.Breakdowns)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_QueryParticipationExemptValidatorsRequest protoreflect.MessageDescriptor
+)
+
+func init() {
+	file_cosmos_gov_v1_query_proto_init()
+	md_QueryParticipationExemptValidatorsRequest = File_cosmos_gov_v1_query_proto.Messages().ByName("QueryParticipationExemptValidatorsRequest")
+}
+
+var _ protoreflect.Message = (*fastReflection_QueryParticipationExemptValidatorsRequest)(nil)
+
+type fastReflection_QueryParticipationExemptValidatorsRequest QueryParticipationExemptValidatorsRequest
+
+func (x *QueryParticipationExemptValidatorsRequest) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_QueryParticipationExemptValidatorsRequest)(x)
+}
+
+func (x *QueryParticipationExemptValidatorsRequest) slowProtoReflect() protoreflect.Message {
+	mi := &file_cosmos_gov_v1_query_proto_msgTypes[26]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_QueryParticipationExemptValidatorsRequest_messageType fastReflection_QueryParticipationExemptValidatorsRequest_messageType
+var _ protoreflect.MessageType = fastReflection_QueryParticipationExemptValidatorsRequest_messageType{}
+
+type fastReflection_QueryParticipationExemptValidatorsRequest_messageType struct{}
+
+func (x fastReflection_QueryParticipationExemptValidatorsRequest_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_QueryParticipationExemptValidatorsRequest)(nil)
+}
+func (x fastReflection_QueryParticipationExemptValidatorsRequest_messageType) New() protoreflect.Message {
+	return new(fastReflection_QueryParticipationExemptValidatorsRequest)
+}
+func (x fastReflection_QueryParticipationExemptValidatorsRequest_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryParticipationExemptValidatorsRequest
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_QueryParticipationExemptValidatorsRequest) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryParticipationExemptValidatorsRequest
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_QueryParticipationExemptValidatorsRequest) Type() protoreflect.MessageType {
+	return _fastReflection_QueryParticipationExemptValidatorsRequest_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_QueryParticipationExemptValidatorsRequest) New() protoreflect.Message {
+	return new(fastReflection_QueryParticipationExemptValidatorsRequest)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_QueryParticipationExemptValidatorsRequest) Interface() protoreflect.ProtoMessage {
+	return (*QueryParticipationExemptValidatorsRequest)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_QueryParticipationExemptValidatorsRequest) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_QueryParticipationExemptValidatorsRequest) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.gov.v1.QueryParticipationExemptValidatorsRequest"))
+		}
+		panic(fmt.Errorf("message cosmos.gov.v1.QueryParticipationExemptValidatorsRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryParticipationExemptValidatorsRequest) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.gov.v1.QueryParticipationExemptValidatorsRequest"))
+		}
+		panic(fmt.Errorf("message cosmos.gov.v1.QueryParticipationExemptValidatorsRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_QueryParticipationExemptValidatorsRequest) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.gov.v1.QueryParticipationExemptValidatorsRequest"))
+		}
+		panic(fmt.Errorf("message cosmos.gov.v1.QueryParticipationExemptValidatorsRequest does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryParticipationExemptValidatorsRequest) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.gov.v1.QueryParticipationExemptValidatorsRequest"))
+		}
+		panic(fmt.Errorf("message cosmos.gov.v1.QueryParticipationExemptValidatorsRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryParticipationExemptValidatorsRequest) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.gov.v1.QueryParticipationExemptValidatorsRequest"))
+		}
+		panic(fmt.Errorf("message cosmos.gov.v1.QueryParticipationExemptValidatorsRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_QueryParticipationExemptValidatorsRequest) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.gov.v1.QueryParticipationExemptValidatorsRequest"))
+		}
+		panic(fmt.Errorf("message cosmos.gov.v1.QueryParticipationExemptValidatorsRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_QueryParticipationExemptValidatorsRequest) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in cosmos.gov.v1.QueryParticipationExemptValidatorsRequest", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_QueryParticipationExemptValidatorsRequest) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryParticipationExemptValidatorsRequest) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_QueryParticipationExemptValidatorsRequest) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_QueryParticipationExemptValidatorsRequest) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*QueryParticipationExemptValidatorsRequest)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*QueryParticipationExemptValidatorsRequest)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*QueryParticipationExemptValidatorsRequest)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryParticipationExemptValidatorsRequest: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryParticipationExemptValidatorsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var _ protoreflect.List = (*_QueryParticipationExemptValidatorsResponse_2_list)(nil)
+
+type _QueryParticipationExemptValidatorsResponse_2_list struct {
+	list *[]string
+}
+
+func (x *_QueryParticipationExemptValidatorsResponse_2_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_QueryParticipationExemptValidatorsResponse_2_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfString((*x.list)[i])
+}
+
+func (x *_QueryParticipationExemptValidatorsResponse_2_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.String()
+	concreteValue := valueUnwrapped
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_QueryParticipationExemptValidatorsResponse_2_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.String()
+	concreteValue := valueUnwrapped
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_QueryParticipationExemptValidatorsResponse_2_list) AppendMutable() protoreflect.Value {
+	panic(fmt.Errorf("AppendMutable can not be called on message QueryParticipationExemptValidatorsResponse at list field ValidatorAddresses as it is not of Message kind"))
+}
+
+func (x *_QueryParticipationExemptValidatorsResponse_2_list) Truncate(n int) {
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_QueryParticipationExemptValidatorsResponse_2_list) NewElement() protoreflect.Value {
+	v := ""
+	return protoreflect.ValueOfString(v)
+}
+
+func (x *_QueryParticipationExemptValidatorsResponse_2_list) IsValid() bool {
+	return x.list != nil
+}
+
+var (
+	md_QueryParticipationExemptValidatorsResponse                     protoreflect.MessageDescriptor
+	fd_QueryParticipationExemptValidatorsResponse_threshold           protoreflect.FieldDescriptor
+	fd_QueryParticipationExemptValidatorsResponse_validator_addresses protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_cosmos_gov_v1_query_proto_init()
+	md_QueryParticipationExemptValidatorsResponse = File_cosmos_gov_v1_query_proto.Messages().ByName("QueryParticipationExemptValidatorsResponse")
+	fd_QueryParticipationExemptValidatorsResponse_threshold = md_QueryParticipationExemptValidatorsResponse.Fields().ByName("threshold")
+	fd_QueryParticipationExemptValidatorsResponse_validator_addresses = md_QueryParticipationExemptValidatorsResponse.Fields().ByName("validator_addresses")
+}
+
+var _ protoreflect.Message = (*fastReflection_QueryParticipationExemptValidatorsResponse)(nil)
+
+type fastReflection_QueryParticipationExemptValidatorsResponse QueryParticipationExemptValidatorsResponse
+
+func (x *QueryParticipationExemptValidatorsResponse) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_QueryParticipationExemptValidatorsResponse)(x)
+}
+
+func (x *QueryParticipationExemptValidatorsResponse) slowProtoReflect() protoreflect.Message {
+	mi := &file_cosmos_gov_v1_query_proto_msgTypes[27]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_QueryParticipationExemptValidatorsResponse_messageType fastReflection_QueryParticipationExemptValidatorsResponse_messageType
+var _ protoreflect.MessageType = fastReflection_QueryParticipationExemptValidatorsResponse_messageType{}
+
+type fastReflection_QueryParticipationExemptValidatorsResponse_messageType struct{}
+
+func (x fastReflection_QueryParticipationExemptValidatorsResponse_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_QueryParticipationExemptValidatorsResponse)(nil)
+}
+func (x fastReflection_QueryParticipationExemptValidatorsResponse_messageType) New() protoreflect.Message {
+	return new(fastReflection_QueryParticipationExemptValidatorsResponse)
+}
+func (x fastReflection_QueryParticipationExemptValidatorsResponse_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryParticipationExemptValidatorsResponse
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_QueryParticipationExemptValidatorsResponse) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryParticipationExemptValidatorsResponse
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_QueryParticipationExemptValidatorsResponse) Type() protoreflect.MessageType {
+	return _fastReflection_QueryParticipationExemptValidatorsResponse_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_QueryParticipationExemptValidatorsResponse) New() protoreflect.Message {
+	return new(fastReflection_QueryParticipationExemptValidatorsResponse)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_QueryParticipationExemptValidatorsResponse) Interface() protoreflect.ProtoMessage {
+	return (*QueryParticipationExemptValidatorsResponse)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_QueryParticipationExemptValidatorsResponse) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Threshold != "" {
+		value := protoreflect.ValueOfString(x.Threshold)
+		if !f(fd_QueryParticipationExemptValidatorsResponse_threshold, value) {
+			return
+		}
+	}
+	if len(x.ValidatorAddresses) != 0 {
+		value := protoreflect.ValueOfList(&_QueryParticipationExemptValidatorsResponse_2_list{list: &x.ValidatorAddresses})
+		if !f(fd_QueryParticipationExemptValidatorsResponse_validator_addresses, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_QueryParticipationExemptValidatorsResponse) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "cosmos.gov.v1.QueryParticipationExemptValidatorsResponse.threshold":
+		return x.Threshold != ""
+	case "cosmos.gov.v1.QueryParticipationExemptValidatorsResponse.validator_addresses":
+		return len(x.ValidatorAddresses) != 0
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.gov.v1.QueryParticipationExemptValidatorsResponse"))
+		}
+		panic(fmt.Errorf("message cosmos.gov.v1.QueryParticipationExemptValidatorsResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryParticipationExemptValidatorsResponse) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "cosmos.gov.v1.QueryParticipationExemptValidatorsResponse.threshold":
+		x.Threshold = ""
+	case "cosmos.gov.v1.QueryParticipationExemptValidatorsResponse.validator_addresses":
+		x.ValidatorAddresses = nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.gov.v1.QueryParticipationExemptValidatorsResponse"))
+		}
+		panic(fmt.Errorf("message cosmos.gov.v1.QueryParticipationExemptValidatorsResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_QueryParticipationExemptValidatorsResponse) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "cosmos.gov.v1.QueryParticipationExemptValidatorsResponse.threshold":
+		value := x.Threshold
+		return protoreflect.ValueOfString(value)
+	case "cosmos.gov.v1.QueryParticipationExemptValidatorsResponse.validator_addresses":
+		if len(x.ValidatorAddresses) == 0 {
+			return protoreflect.ValueOfList(&_QueryParticipationExemptValidatorsResponse_2_list{})
+		}
+		listValue := &_QueryParticipationExemptValidatorsResponse_2_list{list: &x.ValidatorAddresses}
+		return protoreflect.ValueOfList(listValue)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.gov.v1.QueryParticipationExemptValidatorsResponse"))
+		}
+		panic(fmt.Errorf("message cosmos.gov.v1.QueryParticipationExemptValidatorsResponse does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryParticipationExemptValidatorsResponse) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "cosmos.gov.v1.QueryParticipationExemptValidatorsResponse.threshold":
+		x.Threshold = value.Interface().(string)
+	case "cosmos.gov.v1.QueryParticipationExemptValidatorsResponse.validator_addresses":
+		lv := value.List()
+		clv := lv.(*_QueryParticipationExemptValidatorsResponse_2_list)
+		x.ValidatorAddresses = *clv.list
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.gov.v1.QueryParticipationExemptValidatorsResponse"))
+		}
+		panic(fmt.Errorf("message cosmos.gov.v1.QueryParticipationExemptValidatorsResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryParticipationExemptValidatorsResponse) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "cosmos.gov.v1.QueryParticipationExemptValidatorsResponse.validator_addresses":
+		if x.ValidatorAddresses == nil {
+			x.ValidatorAddresses = []string{}
+		}
+		value := &_QueryParticipationExemptValidatorsResponse_2_list{list: &x.ValidatorAddresses}
+		return protoreflect.ValueOfList(value)
+	case "cosmos.gov.v1.QueryParticipationExemptValidatorsResponse.threshold":
+		panic(fmt.Errorf("field threshold of message cosmos.gov.v1.QueryParticipationExemptValidatorsResponse is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.gov.v1.QueryParticipationExemptValidatorsResponse"))
+		}
+		panic(fmt.Errorf("message cosmos.gov.v1.QueryParticipationExemptValidatorsResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_QueryParticipationExemptValidatorsResponse) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "cosmos.gov.v1.QueryParticipationExemptValidatorsResponse.threshold":
+		return protoreflect.ValueOfString("")
+	case "cosmos.gov.v1.QueryParticipationExemptValidatorsResponse.validator_addresses":
+		list := []string{}
+		return protoreflect.ValueOfList(&_QueryParticipationExemptValidatorsResponse_2_list{list: &list})
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.gov.v1.QueryParticipationExemptValidatorsResponse"))
+		}
+		panic(fmt.Errorf("message cosmos.gov.v1.QueryParticipationExemptValidatorsResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_QueryParticipationExemptValidatorsResponse) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in cosmos.gov.v1.QueryParticipationExemptValidatorsResponse", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_QueryParticipationExemptValidatorsResponse) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryParticipationExemptValidatorsResponse) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_QueryParticipationExemptValidatorsResponse) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_QueryParticipationExemptValidatorsResponse) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*QueryParticipationExemptValidatorsResponse)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		l = len(x.Threshold)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if len(x.ValidatorAddresses) > 0 {
+			for _, s := range x.ValidatorAddresses {
+				l = len(s)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*QueryParticipationExemptValidatorsResponse)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if len(x.ValidatorAddresses) > 0 {
+			for iNdEx := len(x.ValidatorAddresses) - 1; iNdEx >= 0; iNdEx-- {
+				i -= len(x.ValidatorAddresses[iNdEx])
+				copy(dAtA[i:], x.ValidatorAddresses[iNdEx])
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(x.ValidatorAddresses[iNdEx])))
+				i--
+				dAtA[i] = 0x12
+			}
+		}
+		if len(x.Threshold) > 0 {
+			i -= len(x.Threshold)
+			copy(dAtA[i:], x.Threshold)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Threshold)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*QueryParticipationExemptValidatorsResponse)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryParticipationExemptValidatorsResponse: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryParticipationExemptValidatorsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Threshold", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Threshold = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddresses", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.ValidatorAddresses = append(x.ValidatorAddresses, string(dAtA[iNdEx:postIndex]))
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var _ protoreflect.List = (*_QuerySimulateProposalRequest_1_list)(nil)
+
+type _QuerySimulateProposalRequest_1_list struct {
+	list *[]*anypb.Any
+}
+
+func (x *_QuerySimulateProposalRequest_1_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_QuerySimulateProposalRequest_1_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_QuerySimulateProposalRequest_1_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*anypb.Any)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_QuerySimulateProposalRequest_1_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*anypb.Any)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_QuerySimulateProposalRequest_1_list) AppendMutable() protoreflect.Value {
+	v := new(anypb.Any)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_QuerySimulateProposalRequest_1_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_QuerySimulateProposalRequest_1_list) NewElement() protoreflect.Value {
+	v := new(anypb.Any)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_QuerySimulateProposalRequest_1_list) IsValid() bool {
+	return x.list != nil
+}
+
+var (
+	md_QuerySimulateProposalRequest                     protoreflect.MessageDescriptor
+	fd_QuerySimulateProposalRequest_messages            protoreflect.FieldDescriptor
+	fd_QuerySimulateProposalRequest_execution_authority protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_cosmos_gov_v1_query_proto_init()
+	md_QuerySimulateProposalRequest = File_cosmos_gov_v1_query_proto.Messages().ByName("QuerySimulateProposalRequest")
+	fd_QuerySimulateProposalRequest_messages = md_QuerySimulateProposalRequest.Fields().ByName("messages")
+	fd_QuerySimulateProposalRequest_execution_authority = md_QuerySimulateProposalRequest.Fields().ByName("execution_authority")
+}
+
+var _ protoreflect.Message = (*fastReflection_QuerySimulateProposalRequest)(nil)
+
+type fastReflection_QuerySimulateProposalRequest QuerySimulateProposalRequest
+
+func (x *QuerySimulateProposalRequest) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_QuerySimulateProposalRequest)(x)
+}
+
+func (x *QuerySimulateProposalRequest) slowProtoReflect() protoreflect.Message {
+	mi := &file_cosmos_gov_v1_query_proto_msgTypes[28]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_QuerySimulateProposalRequest_messageType fastReflection_QuerySimulateProposalRequest_messageType
+var _ protoreflect.MessageType = fastReflection_QuerySimulateProposalRequest_messageType{}
+
+type fastReflection_QuerySimulateProposalRequest_messageType struct{}
+
+func (x fastReflection_QuerySimulateProposalRequest_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_QuerySimulateProposalRequest)(nil)
+}
+func (x fastReflection_QuerySimulateProposalRequest_messageType) New() protoreflect.Message {
+	return new(fastReflection_QuerySimulateProposalRequest)
+}
+func (x fastReflection_QuerySimulateProposalRequest_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_QuerySimulateProposalRequest
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_QuerySimulateProposalRequest) Descriptor() protoreflect.MessageDescriptor {
+	return md_QuerySimulateProposalRequest
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_QuerySimulateProposalRequest) Type() protoreflect.MessageType {
+	return _fastReflection_QuerySimulateProposalRequest_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_QuerySimulateProposalRequest) New() protoreflect.Message {
+	return new(fastReflection_QuerySimulateProposalRequest)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_QuerySimulateProposalRequest) Interface() protoreflect.ProtoMessage {
+	return (*QuerySimulateProposalRequest)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_QuerySimulateProposalRequest) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if len(x.Messages) != 0 {
+		value := protoreflect.ValueOfList(&_QuerySimulateProposalRequest_1_list{list: &x.Messages})
+		if !f(fd_QuerySimulateProposalRequest_messages, value) {
+			return
+		}
+	}
+	if x.ExecutionAuthority != "" {
+		value := protoreflect.ValueOfString(x.ExecutionAuthority)
+		if !f(fd_QuerySimulateProposalRequest_execution_authority, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_QuerySimulateProposalRequest) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "cosmos.gov.v1.QuerySimulateProposalRequest.messages":
+		return len(x.Messages) != 0
+	case "cosmos.gov.v1.QuerySimulateProposalRequest.execution_authority":
+		return x.ExecutionAuthority != ""
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.gov.v1.QuerySimulateProposalRequest"))
+		}
+		panic(fmt.Errorf("message cosmos.gov.v1.QuerySimulateProposalRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QuerySimulateProposalRequest) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "cosmos.gov.v1.QuerySimulateProposalRequest.messages":
+		x.Messages = nil
+	case "cosmos.gov.v1.QuerySimulateProposalRequest.execution_authority":
+		x.ExecutionAuthority = ""
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.gov.v1.QuerySimulateProposalRequest"))
+		}
+		panic(fmt.Errorf("message cosmos.gov.v1.QuerySimulateProposalRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_QuerySimulateProposalRequest) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "cosmos.gov.v1.QuerySimulateProposalRequest.messages":
+		if len(x.Messages) == 0 {
+			return protoreflect.ValueOfList(&_QuerySimulateProposalRequest_1_list{})
+		}
+		listValue := &_QuerySimulateProposalRequest_1_list{list: &x.Messages}
+		return protoreflect.ValueOfList(listValue)
+	case "cosmos.gov.v1.QuerySimulateProposalRequest.execution_authority":
+		value := x.ExecutionAuthority
+		return protoreflect.ValueOfString(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.gov.v1.QuerySimulateProposalRequest"))
+		}
+		panic(fmt.Errorf("message cosmos.gov.v1.QuerySimulateProposalRequest does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QuerySimulateProposalRequest) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "cosmos.gov.v1.QuerySimulateProposalRequest.messages":
+		lv := value.List()
+		clv := lv.(*_QuerySimulateProposalRequest_1_list)
+		x.Messages = *clv.list
+	case "cosmos.gov.v1.QuerySimulateProposalRequest.execution_authority":
+		x.ExecutionAuthority = value.Interface().(string)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.gov.v1.QuerySimulateProposalRequest"))
+		}
+		panic(fmt.Errorf("message cosmos.gov.v1.QuerySimulateProposalRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QuerySimulateProposalRequest) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "cosmos.gov.v1.QuerySimulateProposalRequest.messages":
+		if x.Messages == nil {
+			x.Messages = []*anypb.Any{}
+		}
+		value := &_QuerySimulateProposalRequest_1_list{list: &x.Messages}
+		return protoreflect.ValueOfList(value)
+	case "cosmos.gov.v1.QuerySimulateProposalRequest.execution_authority":
+		panic(fmt.Errorf("field execution_authority of message cosmos.gov.v1.QuerySimulateProposalRequest is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.gov.v1.QuerySimulateProposalRequest"))
+		}
+		panic(fmt.Errorf("message cosmos.gov.v1.QuerySimulateProposalRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_QuerySimulateProposalRequest) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "cosmos.gov.v1.QuerySimulateProposalRequest.messages":
+		list := []*anypb.Any{}
+		return protoreflect.ValueOfList(&_QuerySimulateProposalRequest_1_list{list: &list})
+	case "cosmos.gov.v1.QuerySimulateProposalRequest.execution_authority":
+		return protoreflect.ValueOfString("")
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.gov.v1.QuerySimulateProposalRequest"))
+		}
+		panic(fmt.Errorf("message cosmos.gov.v1.QuerySimulateProposalRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_QuerySimulateProposalRequest) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in cosmos.gov.v1.QuerySimulateProposalRequest", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_QuerySimulateProposalRequest) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QuerySimulateProposalRequest) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_QuerySimulateProposalRequest) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_QuerySimulateProposalRequest) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*QuerySimulateProposalRequest)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if len(x.Messages) > 0 {
+			for _, e := range x.Messages {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		l = len(x.ExecutionAuthority)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*QuerySimulateProposalRequest)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if len(x.ExecutionAuthority) > 0 {
+			i -= len(x.ExecutionAuthority)
+			copy(dAtA[i:], x.ExecutionAuthority)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.ExecutionAuthority)))
+			i--
+			dAtA[i] = 0x12
+		}
+		if len(x.Messages) > 0 {
+			for iNdEx := len(x.Messages) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.Messages[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0xa
+			}
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*QuerySimulateProposalRequest)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QuerySimulateProposalRequest: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QuerySimulateProposalRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Messages", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Messages = append(x.Messages, &anypb.Any{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Messages[len(x.Messages)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ExecutionAuthority", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.ExecutionAuthority = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var _ protoreflect.List = (*_QuerySimulateProposalResponse_2_list)(nil)
+
+type _QuerySimulateProposalResponse_2_list struct {
+	list *[]*abci.Event
+}
+
+func (x *_QuerySimulateProposalResponse_2_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_QuerySimulateProposalResponse_2_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_QuerySimulateProposalResponse_2_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*abci.Event)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_QuerySimulateProposalResponse_2_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*abci.Event)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_QuerySimulateProposalResponse_2_list) AppendMutable() protoreflect.Value {
+	v := new(abci.Event)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_QuerySimulateProposalResponse_2_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_QuerySimulateProposalResponse_2_list) NewElement() protoreflect.Value {
+	v := new(abci.Event)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_QuerySimulateProposalResponse_2_list) IsValid() bool {
+	return x.list != nil
+}
+
+var (
+	md_QuerySimulateProposalResponse          protoreflect.MessageDescriptor
+	fd_QuerySimulateProposalResponse_gas_used protoreflect.FieldDescriptor
+	fd_QuerySimulateProposalResponse_events   protoreflect.FieldDescriptor
+	fd_QuerySimulateProposalResponse_failure  protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_cosmos_gov_v1_query_proto_init()
+	md_QuerySimulateProposalResponse = File_cosmos_gov_v1_query_proto.Messages().ByName("QuerySimulateProposalResponse")
+	fd_QuerySimulateProposalResponse_gas_used = md_QuerySimulateProposalResponse.Fields().ByName("gas_used")
+	fd_QuerySimulateProposalResponse_events = md_QuerySimulateProposalResponse.Fields().ByName("events")
+	fd_QuerySimulateProposalResponse_failure = md_QuerySimulateProposalResponse.Fields().ByName("failure")
+}
+
+var _ protoreflect.Message = (*fastReflection_QuerySimulateProposalResponse)(nil)
+
+type fastReflection_QuerySimulateProposalResponse QuerySimulateProposalResponse
+
+func (x *QuerySimulateProposalResponse) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_QuerySimulateProposalResponse)(x)
+}
+
+func (x *QuerySimulateProposalResponse) slowProtoReflect() protoreflect.Message {
+	mi := &file_cosmos_gov_v1_query_proto_msgTypes[29]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_QuerySimulateProposalResponse_messageType fastReflection_QuerySimulateProposalResponse_messageType
+var _ protoreflect.MessageType = fastReflection_QuerySimulateProposalResponse_messageType{}
+
+type fastReflection_QuerySimulateProposalResponse_messageType struct{}
+
+func (x fastReflection_QuerySimulateProposalResponse_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_QuerySimulateProposalResponse)(nil)
+}
+func (x fastReflection_QuerySimulateProposalResponse_messageType) New() protoreflect.Message {
+	return new(fastReflection_QuerySimulateProposalResponse)
+}
+func (x fastReflection_QuerySimulateProposalResponse_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_QuerySimulateProposalResponse
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_QuerySimulateProposalResponse) Descriptor() protoreflect.MessageDescriptor {
+	return md_QuerySimulateProposalResponse
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_QuerySimulateProposalResponse) Type() protoreflect.MessageType {
+	return _fastReflection_QuerySimulateProposalResponse_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_QuerySimulateProposalResponse) New() protoreflect.Message {
+	return new(fastReflection_QuerySimulateProposalResponse)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_QuerySimulateProposalResponse) Interface() protoreflect.ProtoMessage {
+	return (*QuerySimulateProposalResponse)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_QuerySimulateProposalResponse) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.GasUsed != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.GasUsed)
+		if !f(fd_QuerySimulateProposalResponse_gas_used, value) {
+			return
+		}
+	}
+	if len(x.Events) != 0 {
+		value := protoreflect.ValueOfList(&_QuerySimulateProposalResponse_2_list{list: &x.Events})
+		if !f(fd_QuerySimulateProposalResponse_events, value) {
+			return
+		}
+	}
+	if x.Failure != nil {
+		value := protoreflect.ValueOfMessage(x.Failure.ProtoReflect())
+		if !f(fd_QuerySimulateProposalResponse_failure, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_QuerySimulateProposalResponse) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "cosmos.gov.v1.QuerySimulateProposalResponse.gas_used":
+		return x.GasUsed != uint64(0)
+	case "cosmos.gov.v1.QuerySimulateProposalResponse.events":
+		return len(x.Events) != 0
+	case "cosmos.gov.v1.QuerySimulateProposalResponse.failure":
+		return x.Failure != nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.gov.v1.QuerySimulateProposalResponse"))
+		}
+		panic(fmt.Errorf("message cosmos.gov.v1.QuerySimulateProposalResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QuerySimulateProposalResponse) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "cosmos.gov.v1.QuerySimulateProposalResponse.gas_used":
+		x.GasUsed = uint64(0)
+	case "cosmos.gov.v1.QuerySimulateProposalResponse.events":
+		x.Events = nil
+	case "cosmos.gov.v1.QuerySimulateProposalResponse.failure":
+		x.Failure = nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.gov.v1.QuerySimulateProposalResponse"))
+		}
+		panic(fmt.Errorf("message cosmos.gov.v1.QuerySimulateProposalResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_QuerySimulateProposalResponse) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "cosmos.gov.v1.QuerySimulateProposalResponse.gas_used":
+		value := x.GasUsed
+		return protoreflect.ValueOfUint64(value)
+	case "cosmos.gov.v1.QuerySimulateProposalResponse.events":
+		if len(x.Events) == 0 {
+			return protoreflect.ValueOfList(&_QuerySimulateProposalResponse_2_list{})
+		}
+		listValue := &_QuerySimulateProposalResponse_2_list{list: &x.Events}
+		return protoreflect.ValueOfList(listValue)
+	case "cosmos.gov.v1.QuerySimulateProposalResponse.failure":
+		value := x.Failure
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.gov.v1.QuerySimulateProposalResponse"))
+		}
+		panic(fmt.Errorf("message cosmos.gov.v1.QuerySimulateProposalResponse does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QuerySimulateProposalResponse) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "cosmos.gov.v1.QuerySimulateProposalResponse.gas_used":
+		x.GasUsed = value.Uint()
+	case "cosmos.gov.v1.QuerySimulateProposalResponse.events":
+		lv := value.List()
+		clv := lv.(*_QuerySimulateProposalResponse_2_list)
+		x.Events = *clv.list
+	case "cosmos.gov.v1.QuerySimulateProposalResponse.failure":
+		x.Failure = value.Message().Interface().(*ProposalExecutionFailure)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.gov.v1.QuerySimulateProposalResponse"))
+		}
+		panic(fmt.Errorf("message cosmos.gov.v1.QuerySimulateProposalResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QuerySimulateProposalResponse) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "cosmos.gov.v1.QuerySimulateProposalResponse.events":
+		if x.Events == nil {
+			x.Events = []*abci.Event{}
+		}
+		value := &_QuerySimulateProposalResponse_2_list{list: &x.Events}
+		return protoreflect.ValueOfList(value)
+	case "cosmos.gov.v1.QuerySimulateProposalResponse.failure":
+		if x.Failure == nil {
+			x.Failure = new(ProposalExecutionFailure)
+		}
+		return protoreflect.ValueOfMessage(x.Failure.ProtoReflect())
+	case "cosmos.gov.v1.QuerySimulateProposalResponse.gas_used":
+		panic(fmt.Errorf("field gas_used of message cosmos.gov.v1.QuerySimulateProposalResponse is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.gov.v1.QuerySimulateProposalResponse"))
+		}
+		panic(fmt.Errorf("message cosmos.gov.v1.QuerySimulateProposalResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_QuerySimulateProposalResponse) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "cosmos.gov.v1.QuerySimulateProposalResponse.gas_used":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "cosmos.gov.v1.QuerySimulateProposalResponse.events":
+		list := []*abci.Event{}
+		return protoreflect.ValueOfList(&_QuerySimulateProposalResponse_2_list{list: &list})
+	case "cosmos.gov.v1.QuerySimulateProposalResponse.failure":
+		m := new(ProposalExecutionFailure)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.gov.v1.QuerySimulateProposalResponse"))
+		}
+		panic(fmt.Errorf("message cosmos.gov.v1.QuerySimulateProposalResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_QuerySimulateProposalResponse) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in cosmos.gov.v1.QuerySimulateProposalResponse", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_QuerySimulateProposalResponse) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QuerySimulateProposalResponse) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_QuerySimulateProposalResponse) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_QuerySimulateProposalResponse) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*QuerySimulateProposalResponse)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if x.GasUsed != 0 {
+			n += 1 + runtime.Sov(uint64(x.GasUsed))
+		}
+		if len(x.Events) > 0 {
+			for _, e := range x.Events {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if x.Failure != nil {
+			l = options.Size(x.Failure)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*QuerySimulateProposalResponse)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if x.Failure != nil {
+			encoded, err := options.Marshal(x.Failure)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x1a
+		}
+		if len(x.Events) > 0 {
+			for iNdEx := len(x.Events) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.Events[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x12
+			}
+		}
+		if x.GasUsed != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.GasUsed))
+			i--
+			dAtA[i] = 0x8
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*QuerySimulateProposalResponse)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QuerySimulateProposalResponse: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QuerySimulateProposalResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field GasUsed", wireType)
+				}
+				x.GasUsed = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.GasUsed |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Events", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Events = append(x.Events, &abci.Event{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Events[len(x.Events)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 3:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Failure", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.Failure == nil {
+					x.Failure = &ProposalExecutionFailure{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Failure); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_QueryMetadataSchemaRequest           protoreflect.MessageDescriptor
+	fd_QueryMetadataSchemaRequest_schema_id protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_cosmos_gov_v1_query_proto_init()
+	md_QueryMetadataSchemaRequest = File_cosmos_gov_v1_query_proto.Messages().ByName("QueryMetadataSchemaRequest")
+	fd_QueryMetadataSchemaRequest_schema_id = md_QueryMetadataSchemaRequest.Fields().ByName("schema_id")
+}
+
+var _ protoreflect.Message = (*fastReflection_QueryMetadataSchemaRequest)(nil)
+
+type fastReflection_QueryMetadataSchemaRequest QueryMetadataSchemaRequest
+
+func (x *QueryMetadataSchemaRequest) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_QueryMetadataSchemaRequest)(x)
+}
+
+func (x *QueryMetadataSchemaRequest) slowProtoReflect() protoreflect.Message {
+	mi := &file_cosmos_gov_v1_query_proto_msgTypes[30]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_QueryMetadataSchemaRequest_messageType fastReflection_QueryMetadataSchemaRequest_messageType
+var _ protoreflect.MessageType = fastReflection_QueryMetadataSchemaRequest_messageType{}
+
+type fastReflection_QueryMetadataSchemaRequest_messageType struct{}
+
+func (x fastReflection_QueryMetadataSchemaRequest_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_QueryMetadataSchemaRequest)(nil)
+}
+func (x fastReflection_QueryMetadataSchemaRequest_messageType) New() protoreflect.Message {
+	return new(fastReflection_QueryMetadataSchemaRequest)
+}
+func (x fastReflection_QueryMetadataSchemaRequest_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryMetadataSchemaRequest
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_QueryMetadataSchemaRequest) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryMetadataSchemaRequest
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_QueryMetadataSchemaRequest) Type() protoreflect.MessageType {
+	return _fastReflection_QueryMetadataSchemaRequest_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_QueryMetadataSchemaRequest) New() protoreflect.Message {
+	return new(fastReflection_QueryMetadataSchemaRequest)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_QueryMetadataSchemaRequest) Interface() protoreflect.ProtoMessage {
+	return (*QueryMetadataSchemaRequest)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_QueryMetadataSchemaRequest) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.SchemaId != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.SchemaId)
+		if !f(fd_QueryMetadataSchemaRequest_schema_id, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_QueryMetadataSchemaRequest) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "cosmos.gov.v1.QueryMetadataSchemaRequest.schema_id":
+		return x.SchemaId != uint64(0)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.gov.v1.QueryMetadataSchemaRequest"))
+		}
+		panic(fmt.Errorf("message cosmos.gov.v1.QueryMetadataSchemaRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryMetadataSchemaRequest) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "cosmos.gov.v1.QueryMetadataSchemaRequest.schema_id":
+		x.SchemaId = uint64(0)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.gov.v1.QueryMetadataSchemaRequest"))
+		}
+		panic(fmt.Errorf("message cosmos.gov.v1.QueryMetadataSchemaRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_QueryMetadataSchemaRequest) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "cosmos.gov.v1.QueryMetadataSchemaRequest.schema_id":
+		value := x.SchemaId
+		return protoreflect.ValueOfUint64(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.gov.v1.QueryMetadataSchemaRequest"))
+		}
+		panic(fmt.Errorf("message cosmos.gov.v1.QueryMetadataSchemaRequest does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryMetadataSchemaRequest) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "cosmos.gov.v1.QueryMetadataSchemaRequest.schema_id":
+		x.SchemaId = value.Uint()
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.gov.v1.QueryMetadataSchemaRequest"))
+		}
+		panic(fmt.Errorf("message cosmos.gov.v1.QueryMetadataSchemaRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryMetadataSchemaRequest) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "cosmos.gov.v1.QueryMetadataSchemaRequest.schema_id":
+		panic(fmt.Errorf("field schema_id of message cosmos.gov.v1.QueryMetadataSchemaRequest is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.gov.v1.QueryMetadataSchemaRequest"))
+		}
+		panic(fmt.Errorf("message cosmos.gov.v1.QueryMetadataSchemaRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_QueryMetadataSchemaRequest) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "cosmos.gov.v1.QueryMetadataSchemaRequest.schema_id":
+		return protoreflect.ValueOfUint64(uint64(0))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.gov.v1.QueryMetadataSchemaRequest"))
+		}
+		panic(fmt.Errorf("message cosmos.gov.v1.QueryMetadataSchemaRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_QueryMetadataSchemaRequest) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in cosmos.gov.v1.QueryMetadataSchemaRequest", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_QueryMetadataSchemaRequest) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryMetadataSchemaRequest) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_QueryMetadataSchemaRequest) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_QueryMetadataSchemaRequest) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*QueryMetadataSchemaRequest)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if x.SchemaId != 0 {
+			n += 1 + runtime.Sov(uint64(x.SchemaId))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*QueryMetadataSchemaRequest)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if x.SchemaId != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.SchemaId))
+			i--
+			dAtA[i] = 0x8
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*QueryMetadataSchemaRequest)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryMetadataSchemaRequest: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryMetadataSchemaRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field SchemaId", wireType)
+				}
+				x.SchemaId = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.SchemaId |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_QueryMetadataSchemaResponse        protoreflect.MessageDescriptor
+	fd_QueryMetadataSchemaResponse_schema protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_cosmos_gov_v1_query_proto_init()
+	md_QueryMetadataSchemaResponse = File_cosmos_gov_v1_query_proto.Messages().ByName("QueryMetadataSchemaResponse")
+	fd_QueryMetadataSchemaResponse_schema = md_QueryMetadataSchemaResponse.Fields().ByName("schema")
+}
+
+var _ protoreflect.Message = (*fastReflection_QueryMetadataSchemaResponse)(nil)
+
+type fastReflection_QueryMetadataSchemaResponse QueryMetadataSchemaResponse
+
+func (x *QueryMetadataSchemaResponse) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_QueryMetadataSchemaResponse)(x)
+}
+
+func (x *QueryMetadataSchemaResponse) slowProtoReflect() protoreflect.Message {
+	mi := &file_cosmos_gov_v1_query_proto_msgTypes[31]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_QueryMetadataSchemaResponse_messageType fastReflection_QueryMetadataSchemaResponse_messageType
+var _ protoreflect.MessageType = fastReflection_QueryMetadataSchemaResponse_messageType{}
+
+type fastReflection_QueryMetadataSchemaResponse_messageType struct{}
+
+func (x fastReflection_QueryMetadataSchemaResponse_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_QueryMetadataSchemaResponse)(nil)
+}
+func (x fastReflection_QueryMetadataSchemaResponse_messageType) New() protoreflect.Message {
+	return new(fastReflection_QueryMetadataSchemaResponse)
+}
+func (x fastReflection_QueryMetadataSchemaResponse_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryMetadataSchemaResponse
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_QueryMetadataSchemaResponse) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryMetadataSchemaResponse
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_QueryMetadataSchemaResponse) Type() protoreflect.MessageType {
+	return _fastReflection_QueryMetadataSchemaResponse_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_QueryMetadataSchemaResponse) New() protoreflect.Message {
+	return new(fastReflection_QueryMetadataSchemaResponse)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_QueryMetadataSchemaResponse) Interface() protoreflect.ProtoMessage {
+	return (*QueryMetadataSchemaResponse)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_QueryMetadataSchemaResponse) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Schema != nil {
+		value := protoreflect.ValueOfMessage(x.Schema.ProtoReflect())
+		if !f(fd_QueryMetadataSchemaResponse_schema, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_QueryMetadataSchemaResponse) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "cosmos.gov.v1.QueryMetadataSchemaResponse.schema":
+		return x.Schema != nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.gov.v1.QueryMetadataSchemaResponse"))
+		}
+		panic(fmt.Errorf("message cosmos.gov.v1.QueryMetadataSchemaResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryMetadataSchemaResponse) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "cosmos.gov.v1.QueryMetadataSchemaResponse.schema":
+		x.Schema = nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.gov.v1.QueryMetadataSchemaResponse"))
+		}
+		panic(fmt.Errorf("message cosmos.gov.v1.QueryMetadataSchemaResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_QueryMetadataSchemaResponse) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "cosmos.gov.v1.QueryMetadataSchemaResponse.schema":
+		value := x.Schema
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.gov.v1.QueryMetadataSchemaResponse"))
+		}
+		panic(fmt.Errorf("message cosmos.gov.v1.QueryMetadataSchemaResponse does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryMetadataSchemaResponse) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "cosmos.gov.v1.QueryMetadataSchemaResponse.schema":
+		x.Schema = value.Message().Interface().(*MetadataSchema)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.gov.v1.QueryMetadataSchemaResponse"))
+		}
+		panic(fmt.Errorf("message cosmos.gov.v1.QueryMetadataSchemaResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryMetadataSchemaResponse) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "cosmos.gov.v1.QueryMetadataSchemaResponse.schema":
+		if x.Schema == nil {
+			x.Schema = new(MetadataSchema)
+		}
+		return protoreflect.ValueOfMessage(x.Schema.ProtoReflect())
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.gov.v1.QueryMetadataSchemaResponse"))
+		}
+		panic(fmt.Errorf("message cosmos.gov.v1.QueryMetadataSchemaResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_QueryMetadataSchemaResponse) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "cosmos.gov.v1.QueryMetadataSchemaResponse.schema":
+		m := new(MetadataSchema)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.gov.v1.QueryMetadataSchemaResponse"))
+		}
+		panic(fmt.Errorf("message cosmos.gov.v1.QueryMetadataSchemaResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_QueryMetadataSchemaResponse) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in cosmos.gov.v1.QueryMetadataSchemaResponse", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_QueryMetadataSchemaResponse) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryMetadataSchemaResponse) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_QueryMetadataSchemaResponse) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_QueryMetadataSchemaResponse) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*QueryMetadataSchemaResponse)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if x.Schema != nil {
+			l = options.Size(x.Schema)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*QueryMetadataSchemaResponse)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if x.Schema != nil {
+			encoded, err := options.Marshal(x.Schema)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*QueryMetadataSchemaResponse)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryMetadataSchemaResponse: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryMetadataSchemaResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Schema", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.Schema == nil {
+					x.Schema = &MetadataSchema{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Schema); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_QueryMetadataSchemasRequest            protoreflect.MessageDescriptor
+	fd_QueryMetadataSchemasRequest_pagination protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_cosmos_gov_v1_query_proto_init()
+	md_QueryMetadataSchemasRequest = File_cosmos_gov_v1_query_proto.Messages().ByName("QueryMetadataSchemasRequest")
+	fd_QueryMetadataSchemasRequest_pagination = md_QueryMetadataSchemasRequest.Fields().ByName("pagination")
+}
+
+var _ protoreflect.Message = (*fastReflection_QueryMetadataSchemasRequest)(nil)
+
+type fastReflection_QueryMetadataSchemasRequest QueryMetadataSchemasRequest
+
+func (x *QueryMetadataSchemasRequest) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_QueryMetadataSchemasRequest)(x)
+}
+
+func (x *QueryMetadataSchemasRequest) slowProtoReflect() protoreflect.Message {
+	mi := &file_cosmos_gov_v1_query_proto_msgTypes[32]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_QueryMetadataSchemasRequest_messageType fastReflection_QueryMetadataSchemasRequest_messageType
+var _ protoreflect.MessageType = fastReflection_QueryMetadataSchemasRequest_messageType{}
+
+type fastReflection_QueryMetadataSchemasRequest_messageType struct{}
+
+func (x fastReflection_QueryMetadataSchemasRequest_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_QueryMetadataSchemasRequest)(nil)
+}
+func (x fastReflection_QueryMetadataSchemasRequest_messageType) New() protoreflect.Message {
+	return new(fastReflection_QueryMetadataSchemasRequest)
+}
+func (x fastReflection_QueryMetadataSchemasRequest_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryMetadataSchemasRequest
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_QueryMetadataSchemasRequest) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryMetadataSchemasRequest
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_QueryMetadataSchemasRequest) Type() protoreflect.MessageType {
+	return _fastReflection_QueryMetadataSchemasRequest_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_QueryMetadataSchemasRequest) New() protoreflect.Message {
+	return new(fastReflection_QueryMetadataSchemasRequest)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_QueryMetadataSchemasRequest) Interface() protoreflect.ProtoMessage {
+	return (*QueryMetadataSchemasRequest)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_QueryMetadataSchemasRequest) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Pagination != nil {
+		value := protoreflect.ValueOfMessage(x.Pagination.ProtoReflect())
+		if !f(fd_QueryMetadataSchemasRequest_pagination, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_QueryMetadataSchemasRequest) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "cosmos.gov.v1.QueryMetadataSchemasRequest.pagination":
+		return x.Pagination != nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.gov.v1.QueryMetadataSchemasRequest"))
+		}
+		panic(fmt.Errorf("message cosmos.gov.v1.QueryMetadataSchemasRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryMetadataSchemasRequest) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "cosmos.gov.v1.QueryMetadataSchemasRequest.pagination":
+		x.Pagination = nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.gov.v1.QueryMetadataSchemasRequest"))
+		}
+		panic(fmt.Errorf("message cosmos.gov.v1.QueryMetadataSchemasRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_QueryMetadataSchemasRequest) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "cosmos.gov.v1.QueryMetadataSchemasRequest.pagination":
+		value := x.Pagination
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.gov.v1.QueryMetadataSchemasRequest"))
+		}
+		panic(fmt.Errorf("message cosmos.gov.v1.QueryMetadataSchemasRequest does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryMetadataSchemasRequest) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "cosmos.gov.v1.QueryMetadataSchemasRequest.pagination":
+		x.Pagination = value.Message().Interface().(*v1beta1.PageRequest)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.gov.v1.QueryMetadataSchemasRequest"))
+		}
+		panic(fmt.Errorf("message cosmos.gov.v1.QueryMetadataSchemasRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryMetadataSchemasRequest) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "cosmos.gov.v1.QueryMetadataSchemasRequest.pagination":
+		if x.Pagination == nil {
+			x.Pagination = new(v1beta1.PageRequest)
+		}
+		return protoreflect.ValueOfMessage(x.Pagination.ProtoReflect())
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.gov.v1.QueryMetadataSchemasRequest"))
+		}
+		panic(fmt.Errorf("message cosmos.gov.v1.QueryMetadataSchemasRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_QueryMetadataSchemasRequest) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "cosmos.gov.v1.QueryMetadataSchemasRequest.pagination":
+		m := new(v1beta1.PageRequest)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.gov.v1.QueryMetadataSchemasRequest"))
+		}
+		panic(fmt.Errorf("message cosmos.gov.v1.QueryMetadataSchemasRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_QueryMetadataSchemasRequest) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in cosmos.gov.v1.QueryMetadataSchemasRequest", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_QueryMetadataSchemasRequest) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryMetadataSchemasRequest) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_QueryMetadataSchemasRequest) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_QueryMetadataSchemasRequest) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*QueryMetadataSchemasRequest)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if x.Pagination != nil {
+			l = options.Size(x.Pagination)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*QueryMetadataSchemasRequest)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if x.Pagination != nil {
+			encoded, err := options.Marshal(x.Pagination)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*QueryMetadataSchemasRequest)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryMetadataSchemasRequest: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryMetadataSchemasRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.Pagination == nil {
+					x.Pagination = &v1beta1.PageRequest{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Pagination); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var _ protoreflect.List = (*_QueryMetadataSchemasResponse_1_list)(nil)
+
+type _QueryMetadataSchemasResponse_1_list struct {
+	list *[]*MetadataSchema
+}
+
+func (x *_QueryMetadataSchemasResponse_1_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_QueryMetadataSchemasResponse_1_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_QueryMetadataSchemasResponse_1_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*MetadataSchema)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_QueryMetadataSchemasResponse_1_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*MetadataSchema)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_QueryMetadataSchemasResponse_1_list) AppendMutable() protoreflect.Value {
+	v := new(MetadataSchema)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_QueryMetadataSchemasResponse_1_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_QueryMetadataSchemasResponse_1_list) NewElement() protoreflect.Value {
+	v := new(MetadataSchema)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_QueryMetadataSchemasResponse_1_list) IsValid() bool {
+	return x.list != nil
+}
+
+var (
+	md_QueryMetadataSchemasResponse            protoreflect.MessageDescriptor
+	fd_QueryMetadataSchemasResponse_schemas    protoreflect.FieldDescriptor
+	fd_QueryMetadataSchemasResponse_pagination protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_cosmos_gov_v1_query_proto_init()
+	md_QueryMetadataSchemasResponse = File_cosmos_gov_v1_query_proto.Messages().ByName("QueryMetadataSchemasResponse")
+	fd_QueryMetadataSchemasResponse_schemas = md_QueryMetadataSchemasResponse.Fields().ByName("schemas")
+	fd_QueryMetadataSchemasResponse_pagination = md_QueryMetadataSchemasResponse.Fields().ByName("pagination")
+}
+
+var _ protoreflect.Message = (*fastReflection_QueryMetadataSchemasResponse)(nil)
+
+type fastReflection_QueryMetadataSchemasResponse QueryMetadataSchemasResponse
+
+func (x *QueryMetadataSchemasResponse) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_QueryMetadataSchemasResponse)(x)
+}
+
+func (x *QueryMetadataSchemasResponse) slowProtoReflect() protoreflect.Message {
+	mi := &file_cosmos_gov_v1_query_proto_msgTypes[33]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_QueryMetadataSchemasResponse_messageType fastReflection_QueryMetadataSchemasResponse_messageType
+var _ protoreflect.MessageType = fastReflection_QueryMetadataSchemasResponse_messageType{}
+
+type fastReflection_QueryMetadataSchemasResponse_messageType struct{}
+
+func (x fastReflection_QueryMetadataSchemasResponse_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_QueryMetadataSchemasResponse)(nil)
+}
+func (x fastReflection_QueryMetadataSchemasResponse_messageType) New() protoreflect.Message {
+	return new(fastReflection_QueryMetadataSchemasResponse)
+}
+func (x fastReflection_QueryMetadataSchemasResponse_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryMetadataSchemasResponse
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_QueryMetadataSchemasResponse) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryMetadataSchemasResponse
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_QueryMetadataSchemasResponse) Type() protoreflect.MessageType {
+	return _fastReflection_QueryMetadataSchemasResponse_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_QueryMetadataSchemasResponse) New() protoreflect.Message {
+	return new(fastReflection_QueryMetadataSchemasResponse)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_QueryMetadataSchemasResponse) Interface() protoreflect.ProtoMessage {
+	return (*QueryMetadataSchemasResponse)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_QueryMetadataSchemasResponse) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if len(x.Schemas) != 0 {
+		value := protoreflect.ValueOfList(&_QueryMetadataSchemasResponse_1_list{list: &x.Schemas})
+		if !f(fd_QueryMetadataSchemasResponse_schemas, value) {
+			return
+		}
+	}
+	if x.Pagination != nil {
+		value := protoreflect.ValueOfMessage(x.Pagination.ProtoReflect())
+		if !f(fd_QueryMetadataSchemasResponse_pagination, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_QueryMetadataSchemasResponse) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "cosmos.gov.v1.QueryMetadataSchemasResponse.schemas":
+		return len(x.Schemas) != 0
+	case "cosmos.gov.v1.QueryMetadataSchemasResponse.pagination":
+		return x.Pagination != nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.gov.v1.QueryMetadataSchemasResponse"))
+		}
+		panic(fmt.Errorf("message cosmos.gov.v1.QueryMetadataSchemasResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryMetadataSchemasResponse) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "cosmos.gov.v1.QueryMetadataSchemasResponse.schemas":
+		x.Schemas = nil
+	case "cosmos.gov.v1.QueryMetadataSchemasResponse.pagination":
+		x.Pagination = nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.gov.v1.QueryMetadataSchemasResponse"))
+		}
+		panic(fmt.Errorf("message cosmos.gov.v1.QueryMetadataSchemasResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_QueryMetadataSchemasResponse) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "cosmos.gov.v1.QueryMetadataSchemasResponse.schemas":
+		if len(x.Schemas) == 0 {
+			return protoreflect.ValueOfList(&_QueryMetadataSchemasResponse_1_list{})
+		}
+		listValue := &_QueryMetadataSchemasResponse_1_list{list: &x.Schemas}
+		return protoreflect.ValueOfList(listValue)
+	case "cosmos.gov.v1.QueryMetadataSchemasResponse.pagination":
+		value := x.Pagination
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.gov.v1.QueryMetadataSchemasResponse"))
+		}
+		panic(fmt.Errorf("message cosmos.gov.v1.QueryMetadataSchemasResponse does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryMetadataSchemasResponse) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "cosmos.gov.v1.QueryMetadataSchemasResponse.schemas":
+		lv := value.List()
+		clv := lv.(*_QueryMetadataSchemasResponse_1_list)
+		x.Schemas = *clv.list
+	case "cosmos.gov.v1.QueryMetadataSchemasResponse.pagination":
+		x.Pagination = value.Message().Interface().(*v1beta1.PageResponse)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.gov.v1.QueryMetadataSchemasResponse"))
+		}
+		panic(fmt.Errorf("message cosmos.gov.v1.QueryMetadataSchemasResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryMetadataSchemasResponse) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "cosmos.gov.v1.QueryMetadataSchemasResponse.schemas":
+		if x.Schemas == nil {
+			x.Schemas = []*MetadataSchema{}
+		}
+		value := &_QueryMetadataSchemasResponse_1_list{list: &x.Schemas}
+		return protoreflect.ValueOfList(value)
+	case "cosmos.gov.v1.QueryMetadataSchemasResponse.pagination":
+		if x.Pagination == nil {
+			x.Pagination = new(v1beta1.PageResponse)
+		}
+		return protoreflect.ValueOfMessage(x.Pagination.ProtoReflect())
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.gov.v1.QueryMetadataSchemasResponse"))
+		}
+		panic(fmt.Errorf("message cosmos.gov.v1.QueryMetadataSchemasResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_QueryMetadataSchemasResponse) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "cosmos.gov.v1.QueryMetadataSchemasResponse.schemas":
+		list := []*MetadataSchema{}
+		return protoreflect.ValueOfList(&_QueryMetadataSchemasResponse_1_list{list: &list})
+	case "cosmos.gov.v1.QueryMetadataSchemasResponse.pagination":
+		m := new(v1beta1.PageResponse)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.gov.v1.QueryMetadataSchemasResponse"))
+		}
+		panic(fmt.Errorf("message cosmos.gov.v1.QueryMetadataSchemasResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_QueryMetadataSchemasResponse) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in cosmos.gov.v1.QueryMetadataSchemasResponse", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_QueryMetadataSchemasResponse) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryMetadataSchemasResponse) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_QueryMetadataSchemasResponse) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_QueryMetadataSchemasResponse) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*QueryMetadataSchemasResponse)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if len(x.Schemas) > 0 {
+			for _, e := range x.Schemas {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if x.Pagination != nil {
+			l = options.Size(x.Pagination)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*QueryMetadataSchemasResponse)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if x.Pagination != nil {
+			encoded, err := options.Marshal(x.Pagination)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x12
+		}
+		if len(x.Schemas) > 0 {
+			for iNdEx := len(x.Schemas) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.Schemas[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0xa
+			}
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*QueryMetadataSchemasResponse)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryMetadataSchemasResponse: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryMetadataSchemasResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Schemas", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Schemas = append(x.Schemas, &MetadataSchema{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Schemas[len(x.Schemas)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.Pagination == nil {
+					x.Pagination = &v1beta1.PageResponse{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Pagination); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+// Since: cosmos-sdk 0.46
+
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.27.0
+// 	protoc        (unknown)
+// source: cosmos/gov/v1/query.proto
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// QueryConstitutionRequest is the request type for the Query/Constitution RPC method
+type QueryConstitutionRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *QueryConstitutionRequest) Reset() {
+	*x = QueryConstitutionRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cosmos_gov_v1_query_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *QueryConstitutionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QueryConstitutionRequest) ProtoMessage() {}
+
+// Deprecated: Use QueryConstitutionRequest.ProtoReflect.Descriptor instead.
+func (*QueryConstitutionRequest) Descriptor() ([]byte, []int) {
+	return file_cosmos_gov_v1_query_proto_rawDescGZIP(), []int{0}
+}
+
+// QueryConstitutionResponse is the response type for the Query/Constitution RPC method
+type QueryConstitutionResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Constitution string `protobuf:"bytes,1,opt,name=constitution,proto3" json:"constitution,omitempty"`
+}
+
+func (x *QueryConstitutionResponse) Reset() {
+	*x = QueryConstitutionResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cosmos_gov_v1_query_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *QueryConstitutionResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QueryConstitutionResponse) ProtoMessage() {}
+
+// Deprecated: Use QueryConstitutionResponse.ProtoReflect.Descriptor instead.
+func (*QueryConstitutionResponse) Descriptor() ([]byte, []int) {
+	return file_cosmos_gov_v1_query_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *QueryConstitutionResponse) GetConstitution() string {
+	if x != nil {
+		return x.Constitution
+	}
+	return ""
+}
+
+// QueryConstitutionAmendmentsRequest is the request type for the
+// Query/ConstitutionAmendments RPC method.
+type QueryConstitutionAmendmentsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// pagination defines an optional pagination for the request.
+	Pagination *v1beta1.PageRequest `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (x *QueryConstitutionAmendmentsRequest) Reset() {
+	*x = QueryConstitutionAmendmentsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cosmos_gov_v1_query_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *QueryConstitutionAmendmentsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QueryConstitutionAmendmentsRequest) ProtoMessage() {}
+
+// Deprecated: Use QueryConstitutionAmendmentsRequest.ProtoReflect.Descriptor instead.
+func (*QueryConstitutionAmendmentsRequest) Descriptor() ([]byte, []int) {
+	return file_cosmos_gov_v1_query_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *QueryConstitutionAmendmentsRequest) GetPagination() *v1beta1.PageRequest {
+	if x != nil {
+		return x.Pagination
+	}
+	return nil
+}
+
+// QueryConstitutionAmendmentsResponse is the response type for the
+// Query/ConstitutionAmendments RPC method.
+type QueryConstitutionAmendmentsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// amendments defines the constitution amendments.
+	Amendments []*ConstitutionAmendment `protobuf:"bytes,1,rep,name=amendments,proto3" json:"amendments,omitempty"`
+	// pagination defines the pagination in the response.
+	Pagination *v1beta1.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (x *QueryConstitutionAmendmentsResponse) Reset() {
+	*x = QueryConstitutionAmendmentsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cosmos_gov_v1_query_proto_msgTypes[3]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *QueryConstitutionAmendmentsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QueryConstitutionAmendmentsResponse) ProtoMessage() {}
+
+// Deprecated: Use QueryConstitutionAmendmentsResponse.ProtoReflect.Descriptor instead.
+func (*QueryConstitutionAmendmentsResponse) Descriptor() ([]byte, []int) {
+	return file_cosmos_gov_v1_query_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *QueryConstitutionAmendmentsResponse) GetAmendments() []*ConstitutionAmendment {
+	if x != nil {
+		return x.Amendments
+	}
+	return nil
+}
+
+func (x *QueryConstitutionAmendmentsResponse) GetPagination() *v1beta1.PageResponse {
+	if x != nil {
+		return x.Pagination
+	}
+	return nil
+}
+
+// QueryProposalRequest is the request type for the Query/Proposal RPC method.
+type QueryProposalRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// proposal_id defines the unique id of the proposal.
+	ProposalId uint64 `protobuf:"varint,1,opt,name=proposal_id,json=proposalId,proto3" json:"proposal_id,omitempty"`
+}
+
+func (x *QueryProposalRequest) Reset() {
+	*x = QueryProposalRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cosmos_gov_v1_query_proto_msgTypes[4]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *QueryProposalRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QueryProposalRequest) ProtoMessage() {}
+
+// Deprecated: Use QueryProposalRequest.ProtoReflect.Descriptor instead.
+func (*QueryProposalRequest) Descriptor() ([]byte, []int) {
+	return file_cosmos_gov_v1_query_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *QueryProposalRequest) GetProposalId() uint64 {
+	if x != nil {
+		return x.ProposalId
+	}
+	return 0
+}
+
+// QueryProposalResponse is the response type for the Query/Proposal RPC method.
+type QueryProposalResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// proposal is the requested governance proposal.
+	Proposal *Proposal `protobuf:"bytes,1,opt,name=proposal,proto3" json:"proposal,omitempty"`
+}
+
+func (x *QueryProposalResponse) Reset() {
+	*x = QueryProposalResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cosmos_gov_v1_query_proto_msgTypes[5]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *QueryProposalResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QueryProposalResponse) ProtoMessage() {}
+
+// Deprecated: Use QueryProposalResponse.ProtoReflect.Descriptor instead.
+func (*QueryProposalResponse) Descriptor() ([]byte, []int) {
+	return file_cosmos_gov_v1_query_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *QueryProposalResponse) GetProposal() *Proposal {
+	if x != nil {
+		return x.Proposal
+	}
+	return nil
+}
+
+// QueryProposalsRequest is the request type for the Query/Proposals RPC method.
+type QueryProposalsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// proposal_status defines the status of the proposals.
+	ProposalStatus ProposalStatus `protobuf:"varint,1,opt,name=proposal_status,json=proposalStatus,proto3,enum=cosmos.gov.v1.ProposalStatus" json:"proposal_status,omitempty"`
+	// voter defines the voter address for the proposals.
+	Voter string `protobuf:"bytes,2,opt,name=voter,proto3" json:"voter,omitempty"`
+	// depositor defines the deposit addresses from the proposals.
+	Depositor string `protobuf:"bytes,3,opt,name=depositor,proto3" json:"depositor,omitempty"`
+	// pagination defines an optional pagination for the request.
+	Pagination *v1beta1.PageRequest `protobuf:"bytes,4,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (x *QueryProposalsRequest) Reset() {
+	*x = QueryProposalsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cosmos_gov_v1_query_proto_msgTypes[6]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *QueryProposalsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QueryProposalsRequest) ProtoMessage() {}
+
+// Deprecated: Use QueryProposalsRequest.ProtoReflect.Descriptor instead.
+func (*QueryProposalsRequest) Descriptor() ([]byte, []int) {
+	return file_cosmos_gov_v1_query_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *QueryProposalsRequest) GetProposalStatus() ProposalStatus {
+	if x != nil {
+		return x.ProposalStatus
+	}
+	return ProposalStatus_PROPOSAL_STATUS_UNSPECIFIED
+}
+
+func (x *QueryProposalsRequest) GetVoter() string {
+	if x != nil {
+		return x.Voter
+	}
+	return ""
+}
+
+func (x *QueryProposalsRequest) GetDepositor() string {
+	if x != nil {
+		return x.Depositor
+	}
+	return ""
+}
+
+func (x *QueryProposalsRequest) GetPagination() *v1beta1.PageRequest {
+	if x != nil {
+		return x.Pagination
+	}
+	return nil
+}
+
+// QueryProposalsResponse is the response type for the Query/Proposals RPC
+// method.
+type QueryProposalsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// proposals defines all the requested governance proposals.
+	Proposals []*Proposal `protobuf:"bytes,1,rep,name=proposals,proto3" json:"proposals,omitempty"`
+	// pagination defines the pagination in the response.
+	Pagination *v1beta1.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (x *QueryProposalsResponse) Reset() {
+	*x = QueryProposalsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cosmos_gov_v1_query_proto_msgTypes[7]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *QueryProposalsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QueryProposalsResponse) ProtoMessage() {}
+
+// Deprecated: Use QueryProposalsResponse.ProtoReflect.Descriptor instead.
+func (*QueryProposalsResponse) Descriptor() ([]byte, []int) {
+	return file_cosmos_gov_v1_query_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *QueryProposalsResponse) GetProposals() []*Proposal {
+	if x != nil {
+		return x.Proposals
+	}
+	return nil
+}
+
+func (x *QueryProposalsResponse) GetPagination() *v1beta1.PageResponse {
+	if x != nil {
+		return x.Pagination
+	}
+	return nil
+}
+
+// QueryVoteRequest is the request type for the Query/Vote RPC method.
+type QueryVoteRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// proposal_id defines the unique id of the proposal.
+	ProposalId uint64 `protobuf:"varint,1,opt,name=proposal_id,json=proposalId,proto3" json:"proposal_id,omitempty"`
+	// voter defines the voter address for the proposals.
+	Voter string `protobuf:"bytes,2,opt,name=voter,proto3" json:"voter,omitempty"`
+}
+
+func (x *QueryVoteRequest) Reset() {
+	*x = QueryVoteRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cosmos_gov_v1_query_proto_msgTypes[8]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *QueryVoteRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QueryVoteRequest) ProtoMessage() {}
+
+// Deprecated: Use QueryVoteRequest.ProtoReflect.Descriptor instead.
+func (*QueryVoteRequest) Descriptor() ([]byte, []int) {
+	return file_cosmos_gov_v1_query_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *QueryVoteRequest) GetProposalId() uint64 {
+	if x != nil {
+		return x.ProposalId
+	}
+	return 0
+}
+
+func (x *QueryVoteRequest) GetVoter() string {
+	if x != nil {
+		return x.Voter
+	}
+	return ""
+}
+
+// QueryVoteResponse is the response type for the Query/Vote RPC method.
+type QueryVoteResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// vote defines the queried vote.
+	Vote *Vote `protobuf:"bytes,1,opt,name=vote,proto3" json:"vote,omitempty"`
+}
+
+func (x *QueryVoteResponse) Reset() {
+	*x = QueryVoteResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cosmos_gov_v1_query_proto_msgTypes[9]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *QueryVoteResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QueryVoteResponse) ProtoMessage() {}
+
+// Deprecated: Use QueryVoteResponse.ProtoReflect.Descriptor instead.
+func (*QueryVoteResponse) Descriptor() ([]byte, []int) {
+	return file_cosmos_gov_v1_query_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *QueryVoteResponse) GetVote() *Vote {
+	if x != nil {
+		return x.Vote
+	}
+	return nil
+}
+
+// QueryVoteCommitmentRequest is the request type for the Query/VoteCommitment
+// RPC method.
+//
+// Since: cosmos-sdk 0.48
+type QueryVoteCommitmentRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// proposal_id defines the unique id of the proposal.
+	ProposalId uint64 `protobuf:"varint,1,opt,name=proposal_id,json=proposalId,proto3" json:"proposal_id,omitempty"`
+	// voter defines the voter address for the proposal.
+	Voter string `protobuf:"bytes,2,opt,name=voter,proto3" json:"voter,omitempty"`
+}
+
+func (x *QueryVoteCommitmentRequest) Reset() {
+	*x = QueryVoteCommitmentRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cosmos_gov_v1_query_proto_msgTypes[10]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *QueryVoteCommitmentRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QueryVoteCommitmentRequest) ProtoMessage() {}
+
+// Deprecated: Use QueryVoteCommitmentRequest.ProtoReflect.Descriptor instead.
+func (*QueryVoteCommitmentRequest) Descriptor() ([]byte, []int) {
+	return file_cosmos_gov_v1_query_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *QueryVoteCommitmentRequest) GetProposalId() uint64 {
+	if x != nil {
+		return x.ProposalId
+	}
+	return 0
+}
+
+func (x *QueryVoteCommitmentRequest) GetVoter() string {
+	if x != nil {
+		return x.Voter
+	}
+	return ""
+}
+
+// QueryVoteCommitmentResponse is the response type for the
+// Query/VoteCommitment RPC method.
+//
+// Since: cosmos-sdk 0.48
+type QueryVoteCommitmentResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// commitment defines the queried vote commitment.
+	Commitment *VoteCommitment `protobuf:"bytes,1,opt,name=commitment,proto3" json:"commitment,omitempty"`
+}
+
+func (x *QueryVoteCommitmentResponse) Reset() {
+	*x = QueryVoteCommitmentResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cosmos_gov_v1_query_proto_msgTypes[11]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *QueryVoteCommitmentResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QueryVoteCommitmentResponse) ProtoMessage() {}
+
+// Deprecated: Use QueryVoteCommitmentResponse.ProtoReflect.Descriptor instead.
+func (*QueryVoteCommitmentResponse) Descriptor() ([]byte, []int) {
+	return file_cosmos_gov_v1_query_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *QueryVoteCommitmentResponse) GetCommitment() *VoteCommitment {
+	if x != nil {
+		return x.Commitment
+	}
+	return nil
+}
+
+// QueryExecutionReceiptRequest is the request type for the
+// Query/ExecutionReceipt RPC method.
+//
+// Since: cosmos-sdk 0.48
+type QueryExecutionReceiptRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// proposal_id defines the unique id of the proposal.
+	ProposalId uint64 `protobuf:"varint,1,opt,name=proposal_id,json=proposalId,proto3" json:"proposal_id,omitempty"`
+}
+
+func (x *QueryExecutionReceiptRequest) Reset() {
+	*x = QueryExecutionReceiptRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cosmos_gov_v1_query_proto_msgTypes[12]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *QueryExecutionReceiptRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QueryExecutionReceiptRequest) ProtoMessage() {}
+
+// Deprecated: Use QueryExecutionReceiptRequest.ProtoReflect.Descriptor instead.
+func (*QueryExecutionReceiptRequest) Descriptor() ([]byte, []int) {
+	return file_cosmos_gov_v1_query_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *QueryExecutionReceiptRequest) GetProposalId() uint64 {
+	if x != nil {
+		return x.ProposalId
+	}
+	return 0
+}
+
+// QueryExecutionReceiptResponse is the response type for the
+// Query/ExecutionReceipt RPC method.
+//
+// Since: cosmos-sdk 0.48
+type QueryExecutionReceiptResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// receipt defines the execution receipt of the proposal.
+	Receipt *ProposalExecutionReceipt `protobuf:"bytes,1,opt,name=receipt,proto3" json:"receipt,omitempty"`
+}
+
+func (x *QueryExecutionReceiptResponse) Reset() {
+	*x = QueryExecutionReceiptResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cosmos_gov_v1_query_proto_msgTypes[13]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *QueryExecutionReceiptResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QueryExecutionReceiptResponse) ProtoMessage() {}
+
+// Deprecated: Use QueryExecutionReceiptResponse.ProtoReflect.Descriptor instead.
+func (*QueryExecutionReceiptResponse) Descriptor() ([]byte, []int) {
+	return file_cosmos_gov_v1_query_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *QueryExecutionReceiptResponse) GetReceipt() *ProposalExecutionReceipt {
+	if x != nil {
+		return x.Receipt
+	}
+	return nil
+}
+
+// QueryVotesRequest is the request type for the Query/Votes RPC method.
+type QueryVotesRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// proposal_id defines the unique id of the proposal.
+	ProposalId uint64 `protobuf:"varint,1,opt,name=proposal_id,json=proposalId,proto3" json:"proposal_id,omitempty"`
+	// pagination defines an optional pagination for the request.
+	Pagination *v1beta1.PageRequest `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (x *QueryVotesRequest) Reset() {
+	*x = QueryVotesRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cosmos_gov_v1_query_proto_msgTypes[14]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *QueryVotesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QueryVotesRequest) ProtoMessage() {}
+
+// Deprecated: Use QueryVotesRequest.ProtoReflect.Descriptor instead.
+func (*QueryVotesRequest) Descriptor() ([]byte, []int) {
+	return file_cosmos_gov_v1_query_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *QueryVotesRequest) GetProposalId() uint64 {
+	if x != nil {
+		return x.ProposalId
+	}
+	return 0
+}
+
+func (x *QueryVotesRequest) GetPagination() *v1beta1.PageRequest {
+	if x != nil {
+		return x.Pagination
+	}
+	return nil
+}
+
+// QueryVotesResponse is the response type for the Query/Votes RPC method.
+type QueryVotesResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// votes defines the queried votes.
+	Votes []*Vote `protobuf:"bytes,1,rep,name=votes,proto3" json:"votes,omitempty"`
+	// pagination defines the pagination in the response.
+	Pagination *v1beta1.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (x *QueryVotesResponse) Reset() {
+	*x = QueryVotesResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cosmos_gov_v1_query_proto_msgTypes[15]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *QueryVotesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QueryVotesResponse) ProtoMessage() {}
+
+// Deprecated: Use QueryVotesResponse.ProtoReflect.Descriptor instead.
+func (*QueryVotesResponse) Descriptor() ([]byte, []int) {
+	return file_cosmos_gov_v1_query_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *QueryVotesResponse) GetVotes() []*Vote {
+	if x != nil {
+		return x.Votes
+	}
+	return nil
+}
 
-// QueryConstitutionRequest is the request type for the Query/Constitution RPC method
-type QueryConstitutionRequest struct {
+func (x *QueryVotesResponse) GetPagination() *v1beta1.PageResponse {
+	if x != nil {
+		return x.Pagination
+	}
+	return nil
+}
+
+// QueryParamsRequest is the request type for the Query/Params RPC method.
+type QueryParamsRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
+
+	// params_type defines which parameters to query for, can be one of "voting",
+	// "tallying" or "deposit".
+	ParamsType string `protobuf:"bytes,1,opt,name=params_type,json=paramsType,proto3" json:"params_type,omitempty"`
 }
 
-func (x *QueryConstitutionRequest) Reset() {
-	*x = QueryConstitutionRequest{}
+func (x *QueryParamsRequest) Reset() {
+	*x = QueryParamsRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_cosmos_gov_v1_query_proto_msgTypes[0]
+		mi := &file_cosmos_gov_v1_query_proto_msgTypes[16]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *QueryConstitutionRequest) String() string {
+func (x *QueryParamsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*QueryConstitutionRequest) ProtoMessage() {}
+func (*QueryParamsRequest) ProtoMessage() {}
 
-// Deprecated: Use QueryConstitutionRequest.ProtoReflect.Descriptor instead.
-func (*QueryConstitutionRequest) Descriptor() ([]byte, []int) {
-	return file_cosmos_gov_v1_query_proto_rawDescGZIP(), []int{0}
+// Deprecated: Use QueryParamsRequest.ProtoReflect.Descriptor instead.
+func (*QueryParamsRequest) Descriptor() ([]byte, []int) {
+	return file_cosmos_gov_v1_query_proto_rawDescGZIP(), []int{16}
 }
 
-// QueryConstitutionResponse is the response type for the Query/Constitution RPC method
-type QueryConstitutionResponse struct {
+func (x *QueryParamsRequest) GetParamsType() string {
+	if x != nil {
+		return x.ParamsType
+	}
+	return ""
+}
+
+// QueryParamsResponse is the response type for the Query/Params RPC method.
+type QueryParamsResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Constitution string `protobuf:"bytes,1,opt,name=constitution,proto3" json:"constitution,omitempty"`
+	// Deprecated: Prefer to use `params` instead.
+	// voting_params defines the parameters related to voting.
+	//
+	// Deprecated: Do not use.
+	VotingParams *VotingParams `protobuf:"bytes,1,opt,name=voting_params,json=votingParams,proto3" json:"voting_params,omitempty"`
+	// Deprecated: Prefer to use `params` instead.
+	// deposit_params defines the parameters related to deposit.
+	//
+	// Deprecated: Do not use.
+	DepositParams *DepositParams `protobuf:"bytes,2,opt,name=deposit_params,json=depositParams,proto3" json:"deposit_params,omitempty"`
+	// Deprecated: Prefer to use `params` instead.
+	// tally_params defines the parameters related to tally.
+	//
+	// Deprecated: Do not use.
+	TallyParams *TallyParams `protobuf:"bytes,3,opt,name=tally_params,json=tallyParams,proto3" json:"tally_params,omitempty"`
+	// params defines all the paramaters of x/gov module.
+	//
+	// Since: cosmos-sdk 0.47
+	Params *Params `protobuf:"bytes,4,opt,name=params,proto3" json:"params,omitempty"`
 }
 
-func (x *QueryConstitutionResponse) Reset() {
-	*x = QueryConstitutionResponse{}
+func (x *QueryParamsResponse) Reset() {
+	*x = QueryParamsResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_cosmos_gov_v1_query_proto_msgTypes[1]
+		mi := &file_cosmos_gov_v1_query_proto_msgTypes[17]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *QueryConstitutionResponse) String() string {
+func (x *QueryParamsResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*QueryConstitutionResponse) ProtoMessage() {}
+func (*QueryParamsResponse) ProtoMessage() {}
 
-// Deprecated: Use QueryConstitutionResponse.ProtoReflect.Descriptor instead.
-func (*QueryConstitutionResponse) Descriptor() ([]byte, []int) {
-	return file_cosmos_gov_v1_query_proto_rawDescGZIP(), []int{1}
+// Deprecated: Use QueryParamsResponse.ProtoReflect.Descriptor instead.
+func (*QueryParamsResponse) Descriptor() ([]byte, []int) {
+	return file_cosmos_gov_v1_query_proto_rawDescGZIP(), []int{17}
 }
 
-func (x *QueryConstitutionResponse) GetConstitution() string {
+// Deprecated: Do not use.
+func (x *QueryParamsResponse) GetVotingParams() *VotingParams {
 	if x != nil {
-		return x.Constitution
+		return x.VotingParams
 	}
-	return ""
+	return nil
 }
 
-// QueryProposalRequest is the request type for the Query/Proposal RPC method.
-type QueryProposalRequest struct {
+// Deprecated: Do not use.
+func (x *QueryParamsResponse) GetDepositParams() *DepositParams {
+	if x != nil {
+		return x.DepositParams
+	}
+	return nil
+}
+
+// Deprecated: Do not use.
+func (x *QueryParamsResponse) GetTallyParams() *TallyParams {
+	if x != nil {
+		return x.TallyParams
+	}
+	return nil
+}
+
+func (x *QueryParamsResponse) GetParams() *Params {
+	if x != nil {
+		return x.Params
+	}
+	return nil
+}
+
+// QueryDepositRequest is the request type for the Query/Deposit RPC method.
+type QueryDepositRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
 	// proposal_id defines the unique id of the proposal.
 	ProposalId uint64 `protobuf:"varint,1,opt,name=proposal_id,json=proposalId,proto3" json:"proposal_id,omitempty"`
+	// depositor defines the deposit addresses from the proposals.
+	Depositor string `protobuf:"bytes,2,opt,name=depositor,proto3" json:"depositor,omitempty"`
 }
 
-func (x *QueryProposalRequest) Reset() {
-	*x = QueryProposalRequest{}
+func (x *QueryDepositRequest) Reset() {
+	*x = QueryDepositRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_cosmos_gov_v1_query_proto_msgTypes[2]
+		mi := &file_cosmos_gov_v1_query_proto_msgTypes[18]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *QueryProposalRequest) String() string {
+func (x *QueryDepositRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*QueryProposalRequest) ProtoMessage() {}
+func (*QueryDepositRequest) ProtoMessage() {}
 
-// Deprecated: Use QueryProposalRequest.ProtoReflect.Descriptor instead.
-func (*QueryProposalRequest) Descriptor() ([]byte, []int) {
-	return file_cosmos_gov_v1_query_proto_rawDescGZIP(), []int{2}
+// Deprecated: Use QueryDepositRequest.ProtoReflect.Descriptor instead.
+func (*QueryDepositRequest) Descriptor() ([]byte, []int) {
+	return file_cosmos_gov_v1_query_proto_rawDescGZIP(), []int{18}
 }
 
-func (x *QueryProposalRequest) GetProposalId() uint64 {
+func (x *QueryDepositRequest) GetProposalId() uint64 {
 	if x != nil {
 		return x.ProposalId
 	}
 	return 0
 }
 
-// QueryProposalResponse is the response type for the Query/Proposal RPC method.
-type QueryProposalResponse struct {
+func (x *QueryDepositRequest) GetDepositor() string {
+	if x != nil {
+		return x.Depositor
+	}
+	return ""
+}
+
+// QueryDepositResponse is the response type for the Query/Deposit RPC method.
+type QueryDepositResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// proposal is the requested governance proposal.
-	Proposal *Proposal `protobuf:"bytes,1,opt,name=proposal,proto3" json:"proposal,omitempty"`
+	// deposit defines the requested deposit.
+	Deposit *Deposit `protobuf:"bytes,1,opt,name=deposit,proto3" json:"deposit,omitempty"`
 }
 
-func (x *QueryProposalResponse) Reset() {
-	*x = QueryProposalResponse{}
+func (x *QueryDepositResponse) Reset() {
+	*x = QueryDepositResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_cosmos_gov_v1_query_proto_msgTypes[3]
+		mi := &file_cosmos_gov_v1_query_proto_msgTypes[19]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *QueryProposalResponse) String() string {
+func (x *QueryDepositResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*QueryProposalResponse) ProtoMessage() {}
+func (*QueryDepositResponse) ProtoMessage() {}
 
-// Deprecated: Use QueryProposalResponse.ProtoReflect.Descriptor instead.
-func (*QueryProposalResponse) Descriptor() ([]byte, []int) {
-	return file_cosmos_gov_v1_query_proto_rawDescGZIP(), []int{3}
+// Deprecated: Use QueryDepositResponse.ProtoReflect.Descriptor instead.
+func (*QueryDepositResponse) Descriptor() ([]byte, []int) {
+	return file_cosmos_gov_v1_query_proto_rawDescGZIP(), []int{19}
 }
 
-func (x *QueryProposalResponse) GetProposal() *Proposal {
+func (x *QueryDepositResponse) GetDeposit() *Deposit {
 	if x != nil {
-		return x.Proposal
+		return x.Deposit
 	}
 	return nil
 }
 
-// QueryProposalsRequest is the request type for the Query/Proposals RPC method.
-type QueryProposalsRequest struct {
+// QueryDepositsRequest is the request type for the Query/Deposits RPC method.
+type QueryDepositsRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// proposal_status defines the status of the proposals.
-	ProposalStatus ProposalStatus `protobuf:"varint,1,opt,name=proposal_status,json=proposalStatus,proto3,enum=cosmos.gov.v1.ProposalStatus" json:"proposal_status,omitempty"`
-	// voter defines the voter address for the proposals.
-	Voter string `protobuf:"bytes,2,opt,name=voter,proto3" json:"voter,omitempty"`
-	// depositor defines the deposit addresses from the proposals.
-	Depositor string `protobuf:"bytes,3,opt,name=depositor,proto3" json:"depositor,omitempty"`
+	// proposal_id defines the unique id of the proposal.
+	ProposalId uint64 `protobuf:"varint,1,opt,name=proposal_id,json=proposalId,proto3" json:"proposal_id,omitempty"`
 	// pagination defines an optional pagination for the request.
-	Pagination *v1beta1.PageRequest `protobuf:"bytes,4,opt,name=pagination,proto3" json:"pagination,omitempty"`
+	Pagination *v1beta1.PageRequest `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (x *QueryProposalsRequest) Reset() {
-	*x = QueryProposalsRequest{}
+func (x *QueryDepositsRequest) Reset() {
+	*x = QueryDepositsRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_cosmos_gov_v1_query_proto_msgTypes[4]
+		mi := &file_cosmos_gov_v1_query_proto_msgTypes[20]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *QueryProposalsRequest) String() string {
+func (x *QueryDepositsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*QueryProposalsRequest) ProtoMessage() {}
-
-// Deprecated: Use QueryProposalsRequest.ProtoReflect.Descriptor instead.
-func (*QueryProposalsRequest) Descriptor() ([]byte, []int) {
-	return file_cosmos_gov_v1_query_proto_rawDescGZIP(), []int{4}
-}
-
-func (x *QueryProposalsRequest) GetProposalStatus() ProposalStatus {
-	if x != nil {
-		return x.ProposalStatus
-	}
-	return ProposalStatus_PROPOSAL_STATUS_UNSPECIFIED
-}
+func (*QueryDepositsRequest) ProtoMessage() {}
 
-func (x *QueryProposalsRequest) GetVoter() string {
-	if x != nil {
-		return x.Voter
-	}
-	return ""
+// Deprecated: Use QueryDepositsRequest.ProtoReflect.Descriptor instead.
+func (*QueryDepositsRequest) Descriptor() ([]byte, []int) {
+	return file_cosmos_gov_v1_query_proto_rawDescGZIP(), []int{20}
 }
 
-func (x *QueryProposalsRequest) GetDepositor() string {
+func (x *QueryDepositsRequest) GetProposalId() uint64 {
 	if x != nil {
-		return x.Depositor
+		return x.ProposalId
 	}
-	return ""
+	return 0
 }
 
-func (x *QueryProposalsRequest) GetPagination() *v1beta1.PageRequest {
+func (x *QueryDepositsRequest) GetPagination() *v1beta1.PageRequest {
 	if x != nil {
 		return x.Pagination
 	}
 	return nil
 }
 
-// QueryProposalsResponse is the response type for the Query/Proposals RPC
-// method.
-type QueryProposalsResponse struct {
+// QueryDepositsResponse is the response type for the Query/Deposits RPC method.
+type QueryDepositsResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// proposals defines all the requested governance proposals.
-	Proposals []*Proposal `protobuf:"bytes,1,rep,name=proposals,proto3" json:"proposals,omitempty"`
+	// deposits defines the requested deposits.
+	Deposits []*Deposit `protobuf:"bytes,1,rep,name=deposits,proto3" json:"deposits,omitempty"`
 	// pagination defines the pagination in the response.
 	Pagination *v1beta1.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (x *QueryProposalsResponse) Reset() {
-	*x = QueryProposalsResponse{}
+func (x *QueryDepositsResponse) Reset() {
+	*x = QueryDepositsResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_cosmos_gov_v1_query_proto_msgTypes[5]
+		mi := &file_cosmos_gov_v1_query_proto_msgTypes[21]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *QueryProposalsResponse) String() string {
+func (x *QueryDepositsResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*QueryProposalsResponse) ProtoMessage() {}
+func (*QueryDepositsResponse) ProtoMessage() {}
 
-// Deprecated: Use QueryProposalsResponse.ProtoReflect.Descriptor instead.
-func (*QueryProposalsResponse) Descriptor() ([]byte, []int) {
-	return file_cosmos_gov_v1_query_proto_rawDescGZIP(), []int{5}
+// Deprecated: Use QueryDepositsResponse.ProtoReflect.Descriptor instead.
+func (*QueryDepositsResponse) Descriptor() ([]byte, []int) {
+	return file_cosmos_gov_v1_query_proto_rawDescGZIP(), []int{21}
 }
 
-func (x *QueryProposalsResponse) GetProposals() []*Proposal {
+func (x *QueryDepositsResponse) GetDeposits() []*Deposit {
 	if x != nil {
-		return x.Proposals
+		return x.Deposits
 	}
 	return nil
 }
 
-func (x *QueryProposalsResponse) GetPagination() *v1beta1.PageResponse {
+func (x *QueryDepositsResponse) GetPagination() *v1beta1.PageResponse {
 	if x != nil {
 		return x.Pagination
 	}
 	return nil
 }
 
-// QueryVoteRequest is the request type for the Query/Vote RPC method.
-type QueryVoteRequest struct {
+// QueryTallyResultRequest is the request type for the Query/Tally RPC method.
+type QueryTallyResultRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
 	// proposal_id defines the unique id of the proposal.
 	ProposalId uint64 `protobuf:"varint,1,opt,name=proposal_id,json=proposalId,proto3" json:"proposal_id,omitempty"`
-	// voter defines the voter address for the proposals.
-	Voter string `protobuf:"bytes,2,opt,name=voter,proto3" json:"voter,omitempty"`
 }
 
-func (x *QueryVoteRequest) Reset() {
-	*x = QueryVoteRequest{}
+func (x *QueryTallyResultRequest) Reset() {
+	*x = QueryTallyResultRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_cosmos_gov_v1_query_proto_msgTypes[6]
+		mi := &file_cosmos_gov_v1_query_proto_msgTypes[22]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *QueryVoteRequest) String() string {
+func (x *QueryTallyResultRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*QueryVoteRequest) ProtoMessage() {}
+func (*QueryTallyResultRequest) ProtoMessage() {}
 
-// Deprecated: Use QueryVoteRequest.ProtoReflect.Descriptor instead.
-func (*QueryVoteRequest) Descriptor() ([]byte, []int) {
-	return file_cosmos_gov_v1_query_proto_rawDescGZIP(), []int{6}
+// Deprecated: Use QueryTallyResultRequest.ProtoReflect.Descriptor instead.
+func (*QueryTallyResultRequest) Descriptor() ([]byte, []int) {
+	return file_cosmos_gov_v1_query_proto_rawDescGZIP(), []int{22}
 }
 
-func (x *QueryVoteRequest) GetProposalId() uint64 {
+func (x *QueryTallyResultRequest) GetProposalId() uint64 {
 	if x != nil {
 		return x.ProposalId
 	}
 	return 0
 }
 
-func (x *QueryVoteRequest) GetVoter() string {
-	if x != nil {
-		return x.Voter
-	}
-	return ""
-}
-
-// QueryVoteResponse is the response type for the Query/Vote RPC method.
-type QueryVoteResponse struct {
+// QueryTallyResultResponse is the response type for the Query/Tally RPC method.
+type QueryTallyResultResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// vote defines the queried vote.
-	Vote *Vote `protobuf:"bytes,1,opt,name=vote,proto3" json:"vote,omitempty"`
+	// tally defines the requested tally.
+	Tally *TallyResult `protobuf:"bytes,1,opt,name=tally,proto3" json:"tally,omitempty"`
 }
 
-func (x *QueryVoteResponse) Reset() {
-	*x = QueryVoteResponse{}
+func (x *QueryTallyResultResponse) Reset() {
+	*x = QueryTallyResultResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_cosmos_gov_v1_query_proto_msgTypes[7]
+		mi := &file_cosmos_gov_v1_query_proto_msgTypes[23]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *QueryVoteResponse) String() string {
+func (x *QueryTallyResultResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*QueryVoteResponse) ProtoMessage() {}
+func (*QueryTallyResultResponse) ProtoMessage() {}
 
-// Deprecated: Use QueryVoteResponse.ProtoReflect.Descriptor instead.
-func (*QueryVoteResponse) Descriptor() ([]byte, []int) {
-	return file_cosmos_gov_v1_query_proto_rawDescGZIP(), []int{7}
+// Deprecated: Use QueryTallyResultResponse.ProtoReflect.Descriptor instead.
+func (*QueryTallyResultResponse) Descriptor() ([]byte, []int) {
+	return file_cosmos_gov_v1_query_proto_rawDescGZIP(), []int{23}
 }
 
-func (x *QueryVoteResponse) GetVote() *Vote {
+func (x *QueryTallyResultResponse) GetTally() *TallyResult {
 	if x != nil {
-		return x.Vote
+		return x.Tally
 	}
 	return nil
 }
 
-// QueryVotesRequest is the request type for the Query/Votes RPC method.
-type QueryVotesRequest struct {
+// QueryValidatorVoteBreakdownsRequest is the request type for the
+// Query/ValidatorVoteBreakdowns RPC method.
+type QueryValidatorVoteBreakdownsRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
 	// proposal_id defines the unique id of the proposal.
 	ProposalId uint64 `protobuf:"varint,1,opt,name=proposal_id,json=proposalId,proto3" json:"proposal_id,omitempty"`
-	// pagination defines an optional pagination for the request.
-	Pagination *v1beta1.PageRequest `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (x *QueryVotesRequest) Reset() {
-	*x = QueryVotesRequest{}
+func (x *QueryValidatorVoteBreakdownsRequest) Reset() {
+	*x = QueryValidatorVoteBreakdownsRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_cosmos_gov_v1_query_proto_msgTypes[8]
+		mi := &file_cosmos_gov_v1_query_proto_msgTypes[24]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *QueryVotesRequest) String() string {
+func (x *QueryValidatorVoteBreakdownsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*QueryVotesRequest) ProtoMessage() {}
+func (*QueryValidatorVoteBreakdownsRequest) ProtoMessage() {}
 
-// Deprecated: Use QueryVotesRequest.ProtoReflect.Descriptor instead.
-func (*QueryVotesRequest) Descriptor() ([]byte, []int) {
-	return file_cosmos_gov_v1_query_proto_rawDescGZIP(), []int{8}
+// Deprecated: Use QueryValidatorVoteBreakdownsRequest.ProtoReflect.Descriptor instead.
+func (*QueryValidatorVoteBreakdownsRequest) Descriptor() ([]byte, []int) {
+	return file_cosmos_gov_v1_query_proto_rawDescGZIP(), []int{24}
 }
 
-func (x *QueryVotesRequest) GetProposalId() uint64 {
+func (x *QueryValidatorVoteBreakdownsRequest) GetProposalId() uint64 {
 	if x != nil {
 		return x.ProposalId
 	}
 	return 0
 }
 
-func (x *QueryVotesRequest) GetPagination() *v1beta1.PageRequest {
-	if x != nil {
-		return x.Pagination
-	}
-	return nil
-}
-
-// QueryVotesResponse is the response type for the Query/Votes RPC method.
-type QueryVotesResponse struct {
+// QueryValidatorVoteBreakdownsResponse is the response type for the
+// Query/ValidatorVoteBreakdowns RPC method.
+type QueryValidatorVoteBreakdownsResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// votes defines the queried votes.
-	Votes []*Vote `protobuf:"bytes,1,rep,name=votes,proto3" json:"votes,omitempty"`
-	// pagination defines the pagination in the response.
-	Pagination *v1beta1.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+	// breakdowns defines the per-validator voting power breakdowns, ordered by
+	// validator address.
+	Breakdowns []*ValidatorVoteBreakdown `protobuf:"bytes,1,rep,name=breakdowns,proto3" json:"breakdowns,omitempty"`
 }
 
-func (x *QueryVotesResponse) Reset() {
-	*x = QueryVotesResponse{}
+func (x *QueryValidatorVoteBreakdownsResponse) Reset() {
+	*x = QueryValidatorVoteBreakdownsResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_cosmos_gov_v1_query_proto_msgTypes[9]
+		mi := &file_cosmos_gov_v1_query_proto_msgTypes[25]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *QueryVotesResponse) String() string {
+func (x *QueryValidatorVoteBreakdownsResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*QueryVotesResponse) ProtoMessage() {}
-
-// Deprecated: Use QueryVotesResponse.ProtoReflect.Descriptor instead.
-func (*QueryVotesResponse) Descriptor() ([]byte, []int) {
-	return file_cosmos_gov_v1_query_proto_rawDescGZIP(), []int{9}
-}
+func (*QueryValidatorVoteBreakdownsResponse) ProtoMessage() {}
 
-func (x *QueryVotesResponse) GetVotes() []*Vote {
-	if x != nil {
-		return x.Votes
-	}
-	return nil
+// Deprecated: Use QueryValidatorVoteBreakdownsResponse.ProtoReflect.Descriptor instead.
+func (*QueryValidatorVoteBreakdownsResponse) Descriptor() ([]byte, []int) {
+	return file_cosmos_gov_v1_query_proto_rawDescGZIP(), []int{25}
 }
 
-func (x *QueryVotesResponse) GetPagination() *v1beta1.PageResponse {
+func (x *QueryValidatorVoteBreakdownsResponse) GetBreakdowns() []*ValidatorVoteBreakdown {
 	if x != nil {
-		return x.Pagination
+		return x.Breakdowns
 	}
 	return nil
 }
 
-// QueryParamsRequest is the request type for the Query/Params RPC method.
-type QueryParamsRequest struct {
+// QueryParticipationExemptValidatorsRequest is the request type for the
+// Query/ParticipationExemptValidators RPC method.
+//
+// Since: cosmos-sdk 0.48
+type QueryParticipationExemptValidatorsRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
-
-	// params_type defines which parameters to query for, can be one of "voting",
-	// "tallying" or "deposit".
-	ParamsType string `protobuf:"bytes,1,opt,name=params_type,json=paramsType,proto3" json:"params_type,omitempty"`
 }
 
-func (x *QueryParamsRequest) Reset() {
-	*x = QueryParamsRequest{}
+func (x *QueryParticipationExemptValidatorsRequest) Reset() {
+	*x = QueryParticipationExemptValidatorsRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_cosmos_gov_v1_query_proto_msgTypes[10]
+		mi := &file_cosmos_gov_v1_query_proto_msgTypes[26]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *QueryParamsRequest) String() string {
+func (x *QueryParticipationExemptValidatorsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*QueryParamsRequest) ProtoMessage() {}
-
-// Deprecated: Use QueryParamsRequest.ProtoReflect.Descriptor instead.
-func (*QueryParamsRequest) Descriptor() ([]byte, []int) {
-	return file_cosmos_gov_v1_query_proto_rawDescGZIP(), []int{10}
-}
+func (*QueryParticipationExemptValidatorsRequest) ProtoMessage() {}
 
-func (x *QueryParamsRequest) GetParamsType() string {
-	if x != nil {
-		return x.ParamsType
-	}
-	return ""
+// Deprecated: Use QueryParticipationExemptValidatorsRequest.ProtoReflect.Descriptor instead.
+func (*QueryParticipationExemptValidatorsRequest) Descriptor() ([]byte, []int) {
+	return file_cosmos_gov_v1_query_proto_rawDescGZIP(), []int{26}
 }
 
-// QueryParamsResponse is the response type for the Query/Params RPC method.
-type QueryParamsResponse struct {
+// QueryParticipationExemptValidatorsResponse is the response type for the
+// Query/ParticipationExemptValidators RPC method.
+//
+// Since: cosmos-sdk 0.48
+type QueryParticipationExemptValidatorsResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// Deprecated: Prefer to use `params` instead.
-	// voting_params defines the parameters related to voting.
-	//
-	// Deprecated: Do not use.
-	VotingParams *VotingParams `protobuf:"bytes,1,opt,name=voting_params,json=votingParams,proto3" json:"voting_params,omitempty"`
-	// Deprecated: Prefer to use `params` instead.
-	// deposit_params defines the parameters related to deposit.
-	//
-	// Deprecated: Do not use.
-	DepositParams *DepositParams `protobuf:"bytes,2,opt,name=deposit_params,json=depositParams,proto3" json:"deposit_params,omitempty"`
-	// Deprecated: Prefer to use `params` instead.
-	// tally_params defines the parameters related to tally.
-	//
-	// Deprecated: Do not use.
-	TallyParams *TallyParams `protobuf:"bytes,3,opt,name=tally_params,json=tallyParams,proto3" json:"tally_params,omitempty"`
-	// params defines all the paramaters of x/gov module.
-	//
-	// Since: cosmos-sdk 0.47
-	Params *Params `protobuf:"bytes,4,opt,name=params,proto3" json:"params,omitempty"`
+	// threshold is the participation exemption threshold.
+	Threshold string `protobuf:"bytes,1,opt,name=threshold,proto3" json:"threshold,omitempty"`
+	// validator_addresses defines the operator addresses of the exempt
+	// validators, ordered by voting power.
+	ValidatorAddresses []string `protobuf:"bytes,2,rep,name=validator_addresses,json=validatorAddresses,proto3" json:"validator_addresses,omitempty"`
 }
 
-func (x *QueryParamsResponse) Reset() {
-	*x = QueryParamsResponse{}
+func (x *QueryParticipationExemptValidatorsResponse) Reset() {
+	*x = QueryParticipationExemptValidatorsResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_cosmos_gov_v1_query_proto_msgTypes[11]
+		mi := &file_cosmos_gov_v1_query_proto_msgTypes[27]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *QueryParamsResponse) String() string {
+func (x *QueryParticipationExemptValidatorsResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*QueryParamsResponse) ProtoMessage() {}
-
-// Deprecated: Use QueryParamsResponse.ProtoReflect.Descriptor instead.
-func (*QueryParamsResponse) Descriptor() ([]byte, []int) {
-	return file_cosmos_gov_v1_query_proto_rawDescGZIP(), []int{11}
-}
-
-// Deprecated: Do not use.
-func (x *QueryParamsResponse) GetVotingParams() *VotingParams {
-	if x != nil {
-		return x.VotingParams
-	}
-	return nil
-}
+func (*QueryParticipationExemptValidatorsResponse) ProtoMessage() {}
 
-// Deprecated: Do not use.
-func (x *QueryParamsResponse) GetDepositParams() *DepositParams {
-	if x != nil {
-		return x.DepositParams
-	}
-	return nil
+// Deprecated: Use QueryParticipationExemptValidatorsResponse.ProtoReflect.Descriptor instead.
+func (*QueryParticipationExemptValidatorsResponse) Descriptor() ([]byte, []int) {
+	return file_cosmos_gov_v1_query_proto_rawDescGZIP(), []int{27}
 }
 
-// Deprecated: Do not use.
-func (x *QueryParamsResponse) GetTallyParams() *TallyParams {
+func (x *QueryParticipationExemptValidatorsResponse) GetThreshold() string {
 	if x != nil {
-		return x.TallyParams
+		return x.Threshold
 	}
-	return nil
+	return ""
 }
 
-func (x *QueryParamsResponse) GetParams() *Params {
+func (x *QueryParticipationExemptValidatorsResponse) GetValidatorAddresses() []string {
 	if x != nil {
-		return x.Params
+		return x.ValidatorAddresses
 	}
 	return nil
 }
 
-// QueryDepositRequest is the request type for the Query/Deposit RPC method.
-type QueryDepositRequest struct {
+// QuerySimulateProposalRequest is the request type for the
+// Query/SimulateProposal RPC method.
+//
+// Since: cosmos-sdk 0.48
+type QuerySimulateProposalRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// proposal_id defines the unique id of the proposal.
-	ProposalId uint64 `protobuf:"varint,1,opt,name=proposal_id,json=proposalId,proto3" json:"proposal_id,omitempty"`
-	// depositor defines the deposit addresses from the proposals.
-	Depositor string `protobuf:"bytes,2,opt,name=depositor,proto3" json:"depositor,omitempty"`
+	// messages are the arbitrary messages of the candidate proposal.
+	Messages []*anypb.Any `protobuf:"bytes,1,rep,name=messages,proto3" json:"messages,omitempty"`
+	// execution_authority is the address the messages are executed on behalf of.
+	// An empty execution authority defaults to the gov module account.
+	ExecutionAuthority string `protobuf:"bytes,2,opt,name=execution_authority,json=executionAuthority,proto3" json:"execution_authority,omitempty"`
 }
 
-func (x *QueryDepositRequest) Reset() {
-	*x = QueryDepositRequest{}
+func (x *QuerySimulateProposalRequest) Reset() {
+	*x = QuerySimulateProposalRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_cosmos_gov_v1_query_proto_msgTypes[12]
+		mi := &file_cosmos_gov_v1_query_proto_msgTypes[28]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *QueryDepositRequest) String() string {
+func (x *QuerySimulateProposalRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*QueryDepositRequest) ProtoMessage() {}
+func (*QuerySimulateProposalRequest) ProtoMessage() {}
 
-// Deprecated: Use QueryDepositRequest.ProtoReflect.Descriptor instead.
-func (*QueryDepositRequest) Descriptor() ([]byte, []int) {
-	return file_cosmos_gov_v1_query_proto_rawDescGZIP(), []int{12}
+// Deprecated: Use QuerySimulateProposalRequest.ProtoReflect.Descriptor instead.
+func (*QuerySimulateProposalRequest) Descriptor() ([]byte, []int) {
+	return file_cosmos_gov_v1_query_proto_rawDescGZIP(), []int{28}
 }
 
-func (x *QueryDepositRequest) GetProposalId() uint64 {
+func (x *QuerySimulateProposalRequest) GetMessages() []*anypb.Any {
 	if x != nil {
-		return x.ProposalId
+		return x.Messages
 	}
-	return 0
+	return nil
 }
 
-func (x *QueryDepositRequest) GetDepositor() string {
+func (x *QuerySimulateProposalRequest) GetExecutionAuthority() string {
 	if x != nil {
-		return x.Depositor
+		return x.ExecutionAuthority
 	}
 	return ""
 }
 
-// QueryDepositResponse is the response type for the Query/Deposit RPC method.
-type QueryDepositResponse struct {
+// QuerySimulateProposalResponse is the response type for the
+// Query/SimulateProposal RPC method.
+//
+// Since: cosmos-sdk 0.48
+type QuerySimulateProposalResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// deposit defines the requested deposit.
-	Deposit *Deposit `protobuf:"bytes,1,opt,name=deposit,proto3" json:"deposit,omitempty"`
+	// gas_used is the amount of gas consumed by the execution of the messages.
+	GasUsed uint64 `protobuf:"varint,1,opt,name=gas_used,json=gasUsed,proto3" json:"gas_used,omitempty"`
+	// events are the events emitted by the execution of the messages.
+	Events []*abci.Event `protobuf:"bytes,2,rep,name=events,proto3" json:"events,omitempty"`
+	// failure describes why the execution of the messages failed, if it did.
+	Failure *ProposalExecutionFailure `protobuf:"bytes,3,opt,name=failure,proto3" json:"failure,omitempty"`
 }
 
-func (x *QueryDepositResponse) Reset() {
-	*x = QueryDepositResponse{}
+func (x *QuerySimulateProposalResponse) Reset() {
+	*x = QuerySimulateProposalResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_cosmos_gov_v1_query_proto_msgTypes[13]
+		mi := &file_cosmos_gov_v1_query_proto_msgTypes[29]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *QueryDepositResponse) String() string {
+func (x *QuerySimulateProposalResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*QueryDepositResponse) ProtoMessage() {}
+func (*QuerySimulateProposalResponse) ProtoMessage() {}
 
-// Deprecated: Use QueryDepositResponse.ProtoReflect.Descriptor instead.
-func (*QueryDepositResponse) Descriptor() ([]byte, []int) {
-	return file_cosmos_gov_v1_query_proto_rawDescGZIP(), []int{13}
+// Deprecated: Use QuerySimulateProposalResponse.ProtoReflect.Descriptor instead.
+func (*QuerySimulateProposalResponse) Descriptor() ([]byte, []int) {
+	return file_cosmos_gov_v1_query_proto_rawDescGZIP(), []int{29}
 }
 
-func (x *QueryDepositResponse) GetDeposit() *Deposit {
+func (x *QuerySimulateProposalResponse) GetGasUsed() uint64 {
 	if x != nil {
-		return x.Deposit
+		return x.GasUsed
+	}
+	return 0
+}
+
+func (x *QuerySimulateProposalResponse) GetEvents() []*abci.Event {
+	if x != nil {
+		return x.Events
 	}
 	return nil
 }
 
-// QueryDepositsRequest is the request type for the Query/Deposits RPC method.
-type QueryDepositsRequest struct {
+func (x *QuerySimulateProposalResponse) GetFailure() *ProposalExecutionFailure {
+	if x != nil {
+		return x.Failure
+	}
+	return nil
+}
+
+// QueryMetadataSchemaRequest is the request type for the Query/MetadataSchema
+// RPC method.
+//
+// Since: cosmos-sdk 0.48
+type QueryMetadataSchemaRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// proposal_id defines the unique id of the proposal.
-	ProposalId uint64 `protobuf:"varint,1,opt,name=proposal_id,json=proposalId,proto3" json:"proposal_id,omitempty"`
-	// pagination defines an optional pagination for the request.
-	Pagination *v1beta1.PageRequest `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+	// schema_id defines the unique id of the schema.
+	SchemaId uint64 `protobuf:"varint,1,opt,name=schema_id,json=schemaId,proto3" json:"schema_id,omitempty"`
 }
 
-func (x *QueryDepositsRequest) Reset() {
-	*x = QueryDepositsRequest{}
+func (x *QueryMetadataSchemaRequest) Reset() {
+	*x = QueryMetadataSchemaRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_cosmos_gov_v1_query_proto_msgTypes[14]
+		mi := &file_cosmos_gov_v1_query_proto_msgTypes[30]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *QueryDepositsRequest) String() string {
+func (x *QueryMetadataSchemaRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*QueryDepositsRequest) ProtoMessage() {}
+func (*QueryMetadataSchemaRequest) ProtoMessage() {}
 
-// Deprecated: Use QueryDepositsRequest.ProtoReflect.Descriptor instead.
-func (*QueryDepositsRequest) Descriptor() ([]byte, []int) {
-	return file_cosmos_gov_v1_query_proto_rawDescGZIP(), []int{14}
+// Deprecated: Use QueryMetadataSchemaRequest.ProtoReflect.Descriptor instead.
+func (*QueryMetadataSchemaRequest) Descriptor() ([]byte, []int) {
+	return file_cosmos_gov_v1_query_proto_rawDescGZIP(), []int{30}
 }
 
-func (x *QueryDepositsRequest) GetProposalId() uint64 {
+func (x *QueryMetadataSchemaRequest) GetSchemaId() uint64 {
 	if x != nil {
-		return x.ProposalId
+		return x.SchemaId
 	}
 	return 0
 }
 
-func (x *QueryDepositsRequest) GetPagination() *v1beta1.PageRequest {
-	if x != nil {
-		return x.Pagination
-	}
-	return nil
-}
-
-// QueryDepositsResponse is the response type for the Query/Deposits RPC method.
-type QueryDepositsResponse struct {
+// QueryMetadataSchemaResponse is the response type for the
+// Query/MetadataSchema RPC method.
+//
+// Since: cosmos-sdk 0.48
+type QueryMetadataSchemaResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// deposits defines the requested deposits.
-	Deposits []*Deposit `protobuf:"bytes,1,rep,name=deposits,proto3" json:"deposits,omitempty"`
-	// pagination defines the pagination in the response.
-	Pagination *v1beta1.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+	// schema is the registered metadata schema.
+	Schema *MetadataSchema `protobuf:"bytes,1,opt,name=schema,proto3" json:"schema,omitempty"`
 }
 
-func (x *QueryDepositsResponse) Reset() {
-	*x = QueryDepositsResponse{}
+func (x *QueryMetadataSchemaResponse) Reset() {
+	*x = QueryMetadataSchemaResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_cosmos_gov_v1_query_proto_msgTypes[15]
+		mi := &file_cosmos_gov_v1_query_proto_msgTypes[31]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *QueryDepositsResponse) String() string {
+func (x *QueryMetadataSchemaResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*QueryDepositsResponse) ProtoMessage() {}
-
-// Deprecated: Use QueryDepositsResponse.ProtoReflect.Descriptor instead.
-func (*QueryDepositsResponse) Descriptor() ([]byte, []int) {
-	return file_cosmos_gov_v1_query_proto_rawDescGZIP(), []int{15}
-}
+func (*QueryMetadataSchemaResponse) ProtoMessage() {}
 
-func (x *QueryDepositsResponse) GetDeposits() []*Deposit {
-	if x != nil {
-		return x.Deposits
-	}
-	return nil
+// Deprecated: Use QueryMetadataSchemaResponse.ProtoReflect.Descriptor instead.
+func (*QueryMetadataSchemaResponse) Descriptor() ([]byte, []int) {
+	return file_cosmos_gov_v1_query_proto_rawDescGZIP(), []int{31}
 }
 
-func (x *QueryDepositsResponse) GetPagination() *v1beta1.PageResponse {
+func (x *QueryMetadataSchemaResponse) GetSchema() *MetadataSchema {
 	if x != nil {
-		return x.Pagination
+		return x.Schema
 	}
 	return nil
 }
 
-// QueryTallyResultRequest is the request type for the Query/Tally RPC method.
-type QueryTallyResultRequest struct {
+// QueryMetadataSchemasRequest is the request type for the
+// Query/MetadataSchemas RPC method.
+//
+// Since: cosmos-sdk 0.48
+type QueryMetadataSchemasRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// proposal_id defines the unique id of the proposal.
-	ProposalId uint64 `protobuf:"varint,1,opt,name=proposal_id,json=proposalId,proto3" json:"proposal_id,omitempty"`
+	// pagination defines an optional pagination for the request.
+	Pagination *v1beta1.PageRequest `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (x *QueryTallyResultRequest) Reset() {
-	*x = QueryTallyResultRequest{}
+func (x *QueryMetadataSchemasRequest) Reset() {
+	*x = QueryMetadataSchemasRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_cosmos_gov_v1_query_proto_msgTypes[16]
+		mi := &file_cosmos_gov_v1_query_proto_msgTypes[32]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *QueryTallyResultRequest) String() string {
+func (x *QueryMetadataSchemasRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*QueryTallyResultRequest) ProtoMessage() {}
+func (*QueryMetadataSchemasRequest) ProtoMessage() {}
 
-// Deprecated: Use QueryTallyResultRequest.ProtoReflect.Descriptor instead.
-func (*QueryTallyResultRequest) Descriptor() ([]byte, []int) {
-	return file_cosmos_gov_v1_query_proto_rawDescGZIP(), []int{16}
+// Deprecated: Use QueryMetadataSchemasRequest.ProtoReflect.Descriptor instead.
+func (*QueryMetadataSchemasRequest) Descriptor() ([]byte, []int) {
+	return file_cosmos_gov_v1_query_proto_rawDescGZIP(), []int{32}
 }
 
-func (x *QueryTallyResultRequest) GetProposalId() uint64 {
+func (x *QueryMetadataSchemasRequest) GetPagination() *v1beta1.PageRequest {
 	if x != nil {
-		return x.ProposalId
+		return x.Pagination
 	}
-	return 0
+	return nil
 }
 
-// QueryTallyResultResponse is the response type for the Query/Tally RPC method.
-type QueryTallyResultResponse struct {
+// QueryMetadataSchemasResponse is the response type for the
+// Query/MetadataSchemas RPC method.
+//
+// Since: cosmos-sdk 0.48
+type QueryMetadataSchemasResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// tally defines the requested tally.
-	Tally *TallyResult `protobuf:"bytes,1,opt,name=tally,proto3" json:"tally,omitempty"`
+	// schemas defines the registered metadata schemas.
+	Schemas []*MetadataSchema `protobuf:"bytes,1,rep,name=schemas,proto3" json:"schemas,omitempty"`
+	// pagination defines the pagination in the response.
+	Pagination *v1beta1.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (x *QueryTallyResultResponse) Reset() {
-	*x = QueryTallyResultResponse{}
+func (x *QueryMetadataSchemasResponse) Reset() {
+	*x = QueryMetadataSchemasResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_cosmos_gov_v1_query_proto_msgTypes[17]
+		mi := &file_cosmos_gov_v1_query_proto_msgTypes[33]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *QueryTallyResultResponse) String() string {
+func (x *QueryMetadataSchemasResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*QueryTallyResultResponse) ProtoMessage() {}
+func (*QueryMetadataSchemasResponse) ProtoMessage() {}
 
-// Deprecated: Use QueryTallyResultResponse.ProtoReflect.Descriptor instead.
-func (*QueryTallyResultResponse) Descriptor() ([]byte, []int) {
-	return file_cosmos_gov_v1_query_proto_rawDescGZIP(), []int{17}
+// Deprecated: Use QueryMetadataSchemasResponse.ProtoReflect.Descriptor instead.
+func (*QueryMetadataSchemasResponse) Descriptor() ([]byte, []int) {
+	return file_cosmos_gov_v1_query_proto_rawDescGZIP(), []int{33}
 }
 
-func (x *QueryTallyResultResponse) GetTally() *TallyResult {
+func (x *QueryMetadataSchemasResponse) GetSchemas() []*MetadataSchema {
 	if x != nil {
-		return x.Tally
+		return x.Schemas
+	}
+	return nil
+}
+
+func (x *QueryMetadataSchemasResponse) GetPagination() *v1beta1.PageResponse {
+	if x != nil {
+		return x.Pagination
 	}
 	return nil
 }
@@ -9474,227 +17739,434 @@ var file_cosmos_gov_v1_query_proto_rawDesc = []byte{
 	0x6d, 0x6f, 0x73, 0x2e, 0x67, 0x6f, 0x76, 0x2e, 0x76, 0x31, 0x1a, 0x2a, 0x63, 0x6f, 0x73, 0x6d,
 	0x6f, 0x73, 0x2f, 0x62, 0x61, 0x73, 0x65, 0x2f, 0x71, 0x75, 0x65, 0x72, 0x79, 0x2f, 0x76, 0x31,
 	0x62, 0x65, 0x74, 0x61, 0x31, 0x2f, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e,
-	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1c, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x61,
-	0x70, 0x69, 0x2f, 0x61, 0x6e, 0x6e, 0x6f, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x70,
-	0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x17, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2f, 0x67, 0x6f, 0x76,
-	0x2f, 0x76, 0x31, 0x2f, 0x67, 0x6f, 0x76, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x19, 0x63,
-	0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x5f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x63, 0x6f, 0x73, 0x6d,
-	0x6f, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x1a, 0x0a, 0x18, 0x51, 0x75, 0x65, 0x72,
-	0x79, 0x43, 0x6f, 0x6e, 0x73, 0x74, 0x69, 0x74, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x22, 0x3f, 0x0a, 0x19, 0x51, 0x75, 0x65, 0x72, 0x79, 0x43, 0x6f, 0x6e,
-	0x73, 0x74, 0x69, 0x74, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
-	0x65, 0x12, 0x22, 0x0a, 0x0c, 0x63, 0x6f, 0x6e, 0x73, 0x74, 0x69, 0x74, 0x75, 0x74, 0x69, 0x6f,
-	0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x63, 0x6f, 0x6e, 0x73, 0x74, 0x69, 0x74,
-	0x75, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x37, 0x0a, 0x14, 0x51, 0x75, 0x65, 0x72, 0x79, 0x50, 0x72,
-	0x6f, 0x70, 0x6f, 0x73, 0x61, 0x6c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1f, 0x0a,
-	0x0b, 0x70, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x61, 0x6c, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x04, 0x52, 0x0a, 0x70, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x61, 0x6c, 0x49, 0x64, 0x22, 0x4c,
-	0x0a, 0x15, 0x51, 0x75, 0x65, 0x72, 0x79, 0x50, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x61, 0x6c, 0x52,
-	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x33, 0x0a, 0x08, 0x70, 0x72, 0x6f, 0x70, 0x6f,
-	0x73, 0x61, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x63, 0x6f, 0x73, 0x6d,
-	0x6f, 0x73, 0x2e, 0x67, 0x6f, 0x76, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x72, 0x6f, 0x70, 0x6f, 0x73,
-	0x61, 0x6c, 0x52, 0x08, 0x70, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x61, 0x6c, 0x22, 0x8f, 0x02, 0x0a,
-	0x15, 0x51, 0x75, 0x65, 0x72, 0x79, 0x50, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x61, 0x6c, 0x73, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x46, 0x0a, 0x0f, 0x70, 0x72, 0x6f, 0x70, 0x6f, 0x73,
-	0x61, 0x6c, 0x5f, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32,
-	0x1d, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x67, 0x6f, 0x76, 0x2e, 0x76, 0x31, 0x2e,
-	0x50, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x61, 0x6c, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x0e,
-	0x70, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x61, 0x6c, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x2e,
-	0x0a, 0x05, 0x76, 0x6f, 0x74, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x18, 0xd2,
-	0xb4, 0x2d, 0x14, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73,
-	0x73, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x52, 0x05, 0x76, 0x6f, 0x74, 0x65, 0x72, 0x12, 0x36,
-	0x0a, 0x09, 0x64, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x6f, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28,
-	0x09, 0x42, 0x18, 0xd2, 0xb4, 0x2d, 0x14, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x41, 0x64,
-	0x64, 0x72, 0x65, 0x73, 0x73, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x52, 0x09, 0x64, 0x65, 0x70,
-	0x6f, 0x73, 0x69, 0x74, 0x6f, 0x72, 0x12, 0x46, 0x0a, 0x0a, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x61,
-	0x74, 0x69, 0x6f, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x26, 0x2e, 0x63, 0x6f, 0x73,
-	0x6d, 0x6f, 0x73, 0x2e, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x71, 0x75, 0x65, 0x72, 0x79, 0x2e, 0x76,
-	0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x50, 0x61, 0x67, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x52, 0x0a, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x98,
-	0x01, 0x0a, 0x16, 0x51, 0x75, 0x65, 0x72, 0x79, 0x50, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x61, 0x6c,
-	0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x35, 0x0a, 0x09, 0x70, 0x72, 0x6f,
-	0x70, 0x6f, 0x73, 0x61, 0x6c, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x63,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x14, 0x67, 0x6f, 0x67, 0x6f, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x2f, 0x67, 0x6f, 0x67, 0x6f, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1c, 0x67, 0x6f,
+	0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x61, 0x6e, 0x6e, 0x6f, 0x74, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x19, 0x67, 0x6f, 0x6f, 0x67,
+	0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x61, 0x6e, 0x79, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x17, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2f, 0x67, 0x6f,
+	0x76, 0x2f, 0x76, 0x31, 0x2f, 0x67, 0x6f, 0x76, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x19,
+	0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x5f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x63, 0x6f, 0x73,
+	0x6d, 0x6f, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1b, 0x74, 0x65, 0x6e, 0x64, 0x65,
+	0x72, 0x6d, 0x69, 0x6e, 0x74, 0x2f, 0x61, 0x62, 0x63, 0x69, 0x2f, 0x74, 0x79, 0x70, 0x65, 0x73,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x1a, 0x0a, 0x18, 0x51, 0x75, 0x65, 0x72, 0x79, 0x43,
+	0x6f, 0x6e, 0x73, 0x74, 0x69, 0x74, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x22, 0x3f, 0x0a, 0x19, 0x51, 0x75, 0x65, 0x72, 0x79, 0x43, 0x6f, 0x6e, 0x73, 0x74,
+	0x69, 0x74, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x22, 0x0a, 0x0c, 0x63, 0x6f, 0x6e, 0x73, 0x74, 0x69, 0x74, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x63, 0x6f, 0x6e, 0x73, 0x74, 0x69, 0x74, 0x75, 0x74,
+	0x69, 0x6f, 0x6e, 0x22, 0x6c, 0x0a, 0x22, 0x51, 0x75, 0x65, 0x72, 0x79, 0x43, 0x6f, 0x6e, 0x73,
+	0x74, 0x69, 0x74, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x41, 0x6d, 0x65, 0x6e, 0x64, 0x6d, 0x65, 0x6e,
+	0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x46, 0x0a, 0x0a, 0x70, 0x61, 0x67,
+	0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x26, 0x2e,
+	0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x71, 0x75, 0x65, 0x72,
+	0x79, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x50, 0x61, 0x67, 0x65, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x52, 0x0a, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x22, 0xb4, 0x01, 0x0a, 0x23, 0x51, 0x75, 0x65, 0x72, 0x79, 0x43, 0x6f, 0x6e, 0x73, 0x74,
+	0x69, 0x74, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x41, 0x6d, 0x65, 0x6e, 0x64, 0x6d, 0x65, 0x6e, 0x74,
+	0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x44, 0x0a, 0x0a, 0x61, 0x6d, 0x65,
+	0x6e, 0x64, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x24, 0x2e,
+	0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x67, 0x6f, 0x76, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x6f,
+	0x6e, 0x73, 0x74, 0x69, 0x74, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x41, 0x6d, 0x65, 0x6e, 0x64, 0x6d,
+	0x65, 0x6e, 0x74, 0x52, 0x0a, 0x61, 0x6d, 0x65, 0x6e, 0x64, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x12,
+	0x47, 0x0a, 0x0a, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x27, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x62, 0x61, 0x73,
+	0x65, 0x2e, 0x71, 0x75, 0x65, 0x72, 0x79, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e,
+	0x50, 0x61, 0x67, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x52, 0x0a, 0x70, 0x61,
+	0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x37, 0x0a, 0x14, 0x51, 0x75, 0x65, 0x72,
+	0x79, 0x50, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x61, 0x6c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x12, 0x1f, 0x0a, 0x0b, 0x70, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x61, 0x6c, 0x5f, 0x69, 0x64, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0a, 0x70, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x61, 0x6c, 0x49,
+	0x64, 0x22, 0x4c, 0x0a, 0x15, 0x51, 0x75, 0x65, 0x72, 0x79, 0x50, 0x72, 0x6f, 0x70, 0x6f, 0x73,
+	0x61, 0x6c, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x33, 0x0a, 0x08, 0x70, 0x72,
+	0x6f, 0x70, 0x6f, 0x73, 0x61, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x63,
 	0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x67, 0x6f, 0x76, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x72, 0x6f,
-	0x70, 0x6f, 0x73, 0x61, 0x6c, 0x52, 0x09, 0x70, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x61, 0x6c, 0x73,
-	0x12, 0x47, 0x0a, 0x0a, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02,
-	0x20, 0x01, 0x28, 0x0b, 0x32, 0x27, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x62, 0x61,
-	0x73, 0x65, 0x2e, 0x71, 0x75, 0x65, 0x72, 0x79, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31,
-	0x2e, 0x50, 0x61, 0x67, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x52, 0x0a, 0x70,
-	0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x63, 0x0a, 0x10, 0x51, 0x75, 0x65,
-	0x72, 0x79, 0x56, 0x6f, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1f, 0x0a,
+	0x70, 0x6f, 0x73, 0x61, 0x6c, 0x52, 0x08, 0x70, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x61, 0x6c, 0x22,
+	0x8f, 0x02, 0x0a, 0x15, 0x51, 0x75, 0x65, 0x72, 0x79, 0x50, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x61,
+	0x6c, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x46, 0x0a, 0x0f, 0x70, 0x72, 0x6f,
+	0x70, 0x6f, 0x73, 0x61, 0x6c, 0x5f, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x0e, 0x32, 0x1d, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x67, 0x6f, 0x76, 0x2e,
+	0x76, 0x31, 0x2e, 0x50, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x61, 0x6c, 0x53, 0x74, 0x61, 0x74, 0x75,
+	0x73, 0x52, 0x0e, 0x70, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x61, 0x6c, 0x53, 0x74, 0x61, 0x74, 0x75,
+	0x73, 0x12, 0x2e, 0x0a, 0x05, 0x76, 0x6f, 0x74, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x42, 0x18, 0xd2, 0xb4, 0x2d, 0x14, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x41, 0x64, 0x64,
+	0x72, 0x65, 0x73, 0x73, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x52, 0x05, 0x76, 0x6f, 0x74, 0x65,
+	0x72, 0x12, 0x36, 0x0a, 0x09, 0x64, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x6f, 0x72, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x09, 0x42, 0x18, 0xd2, 0xb4, 0x2d, 0x14, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73,
+	0x2e, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x52, 0x09,
+	0x64, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x6f, 0x72, 0x12, 0x46, 0x0a, 0x0a, 0x70, 0x61, 0x67,
+	0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x26, 0x2e,
+	0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x71, 0x75, 0x65, 0x72,
+	0x79, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x50, 0x61, 0x67, 0x65, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x52, 0x0a, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x22, 0x98, 0x01, 0x0a, 0x16, 0x51, 0x75, 0x65, 0x72, 0x79, 0x50, 0x72, 0x6f, 0x70, 0x6f,
+	0x73, 0x61, 0x6c, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x35, 0x0a, 0x09,
+	0x70, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x61, 0x6c, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x17, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x67, 0x6f, 0x76, 0x2e, 0x76, 0x31, 0x2e,
+	0x50, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x61, 0x6c, 0x52, 0x09, 0x70, 0x72, 0x6f, 0x70, 0x6f, 0x73,
+	0x61, 0x6c, 0x73, 0x12, 0x47, 0x0a, 0x0a, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x27, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73,
+	0x2e, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x71, 0x75, 0x65, 0x72, 0x79, 0x2e, 0x76, 0x31, 0x62, 0x65,
+	0x74, 0x61, 0x31, 0x2e, 0x50, 0x61, 0x67, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x52, 0x0a, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x63, 0x0a, 0x10,
+	0x51, 0x75, 0x65, 0x72, 0x79, 0x56, 0x6f, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x12, 0x1f, 0x0a, 0x0b, 0x70, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x61, 0x6c, 0x5f, 0x69, 0x64, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0a, 0x70, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x61, 0x6c, 0x49,
+	0x64, 0x12, 0x2e, 0x0a, 0x05, 0x76, 0x6f, 0x74, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x42, 0x18, 0xd2, 0xb4, 0x2d, 0x14, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x41, 0x64, 0x64,
+	0x72, 0x65, 0x73, 0x73, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x52, 0x05, 0x76, 0x6f, 0x74, 0x65,
+	0x72, 0x22, 0x3c, 0x0a, 0x11, 0x51, 0x75, 0x65, 0x72, 0x79, 0x56, 0x6f, 0x74, 0x65, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x27, 0x0a, 0x04, 0x76, 0x6f, 0x74, 0x65, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x67, 0x6f,
+	0x76, 0x2e, 0x76, 0x31, 0x2e, 0x56, 0x6f, 0x74, 0x65, 0x52, 0x04, 0x76, 0x6f, 0x74, 0x65, 0x22,
+	0x6d, 0x0a, 0x1a, 0x51, 0x75, 0x65, 0x72, 0x79, 0x56, 0x6f, 0x74, 0x65, 0x43, 0x6f, 0x6d, 0x6d,
+	0x69, 0x74, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1f, 0x0a,
 	0x0b, 0x70, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x61, 0x6c, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01,
 	0x28, 0x04, 0x52, 0x0a, 0x70, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x61, 0x6c, 0x49, 0x64, 0x12, 0x2e,
 	0x0a, 0x05, 0x76, 0x6f, 0x74, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x18, 0xd2,
 	0xb4, 0x2d, 0x14, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73,
-	0x73, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x52, 0x05, 0x76, 0x6f, 0x74, 0x65, 0x72, 0x22, 0x3c,
-	0x0a, 0x11, 0x51, 0x75, 0x65, 0x72, 0x79, 0x56, 0x6f, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f,
-	0x6e, 0x73, 0x65, 0x12, 0x27, 0x0a, 0x04, 0x76, 0x6f, 0x74, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x0b, 0x32, 0x13, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x67, 0x6f, 0x76, 0x2e, 0x76,
-	0x31, 0x2e, 0x56, 0x6f, 0x74, 0x65, 0x52, 0x04, 0x76, 0x6f, 0x74, 0x65, 0x22, 0x7c, 0x0a, 0x11,
-	0x51, 0x75, 0x65, 0x72, 0x79, 0x56, 0x6f, 0x74, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x73, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x52, 0x05, 0x76, 0x6f, 0x74, 0x65, 0x72, 0x22, 0x5c,
+	0x0a, 0x1b, 0x51, 0x75, 0x65, 0x72, 0x79, 0x56, 0x6f, 0x74, 0x65, 0x43, 0x6f, 0x6d, 0x6d, 0x69,
+	0x74, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3d, 0x0a,
+	0x0a, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x6d, 0x65, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x1d, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x67, 0x6f, 0x76, 0x2e, 0x76,
+	0x31, 0x2e, 0x56, 0x6f, 0x74, 0x65, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x6d, 0x65, 0x6e, 0x74,
+	0x52, 0x0a, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x6d, 0x65, 0x6e, 0x74, 0x22, 0x3f, 0x0a, 0x1c,
+	0x51, 0x75, 0x65, 0x72, 0x79, 0x45, 0x78, 0x65, 0x63, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65,
+	0x63, 0x65, 0x69, 0x70, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1f, 0x0a, 0x0b,
+	0x70, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x61, 0x6c, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x04, 0x52, 0x0a, 0x70, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x61, 0x6c, 0x49, 0x64, 0x22, 0x62, 0x0a,
+	0x1d, 0x51, 0x75, 0x65, 0x72, 0x79, 0x45, 0x78, 0x65, 0x63, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x52,
+	0x65, 0x63, 0x65, 0x69, 0x70, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x41,
+	0x0a, 0x07, 0x72, 0x65, 0x63, 0x65, 0x69, 0x70, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x27, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x67, 0x6f, 0x76, 0x2e, 0x76, 0x31, 0x2e,
+	0x50, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x61, 0x6c, 0x45, 0x78, 0x65, 0x63, 0x75, 0x74, 0x69, 0x6f,
+	0x6e, 0x52, 0x65, 0x63, 0x65, 0x69, 0x70, 0x74, 0x52, 0x07, 0x72, 0x65, 0x63, 0x65, 0x69, 0x70,
+	0x74, 0x22, 0x7c, 0x0a, 0x11, 0x51, 0x75, 0x65, 0x72, 0x79, 0x56, 0x6f, 0x74, 0x65, 0x73, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1f, 0x0a, 0x0b, 0x70, 0x72, 0x6f, 0x70, 0x6f, 0x73,
+	0x61, 0x6c, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0a, 0x70, 0x72, 0x6f,
+	0x70, 0x6f, 0x73, 0x61, 0x6c, 0x49, 0x64, 0x12, 0x46, 0x0a, 0x0a, 0x70, 0x61, 0x67, 0x69, 0x6e,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x26, 0x2e, 0x63, 0x6f,
+	0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x71, 0x75, 0x65, 0x72, 0x79, 0x2e,
+	0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x50, 0x61, 0x67, 0x65, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x52, 0x0a, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x22,
+	0x88, 0x01, 0x0a, 0x12, 0x51, 0x75, 0x65, 0x72, 0x79, 0x56, 0x6f, 0x74, 0x65, 0x73, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x29, 0x0a, 0x05, 0x76, 0x6f, 0x74, 0x65, 0x73, 0x18,
+	0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x67,
+	0x6f, 0x76, 0x2e, 0x76, 0x31, 0x2e, 0x56, 0x6f, 0x74, 0x65, 0x52, 0x05, 0x76, 0x6f, 0x74, 0x65,
+	0x73, 0x12, 0x47, 0x0a, 0x0a, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x27, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x62,
+	0x61, 0x73, 0x65, 0x2e, 0x71, 0x75, 0x65, 0x72, 0x79, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61,
+	0x31, 0x2e, 0x50, 0x61, 0x67, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x52, 0x0a,
+	0x70, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x35, 0x0a, 0x12, 0x51, 0x75,
+	0x65, 0x72, 0x79, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x12, 0x1f, 0x0a, 0x0b, 0x70, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x70, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x54, 0x79, 0x70,
+	0x65, 0x22, 0x96, 0x02, 0x0a, 0x13, 0x51, 0x75, 0x65, 0x72, 0x79, 0x50, 0x61, 0x72, 0x61, 0x6d,
+	0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x44, 0x0a, 0x0d, 0x76, 0x6f, 0x74,
+	0x69, 0x6e, 0x67, 0x5f, 0x70, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x1b, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x67, 0x6f, 0x76, 0x2e, 0x76, 0x31,
+	0x2e, 0x56, 0x6f, 0x74, 0x69, 0x6e, 0x67, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x42, 0x02, 0x18,
+	0x01, 0x52, 0x0c, 0x76, 0x6f, 0x74, 0x69, 0x6e, 0x67, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x12,
+	0x47, 0x0a, 0x0e, 0x64, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x5f, 0x70, 0x61, 0x72, 0x61, 0x6d,
+	0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73,
+	0x2e, 0x67, 0x6f, 0x76, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x50,
+	0x61, 0x72, 0x61, 0x6d, 0x73, 0x42, 0x02, 0x18, 0x01, 0x52, 0x0d, 0x64, 0x65, 0x70, 0x6f, 0x73,
+	0x69, 0x74, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x12, 0x41, 0x0a, 0x0c, 0x74, 0x61, 0x6c, 0x6c,
+	0x79, 0x5f, 0x70, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a,
+	0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x67, 0x6f, 0x76, 0x2e, 0x76, 0x31, 0x2e, 0x54,
+	0x61, 0x6c, 0x6c, 0x79, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x42, 0x02, 0x18, 0x01, 0x52, 0x0b,
+	0x74, 0x61, 0x6c, 0x6c, 0x79, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x12, 0x2d, 0x0a, 0x06, 0x70,
+	0x61, 0x72, 0x61, 0x6d, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x63, 0x6f,
+	0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x67, 0x6f, 0x76, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x61, 0x72, 0x61,
+	0x6d, 0x73, 0x52, 0x06, 0x70, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x22, 0x6e, 0x0a, 0x13, 0x51, 0x75,
+	0x65, 0x72, 0x79, 0x44, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
 	0x74, 0x12, 0x1f, 0x0a, 0x0b, 0x70, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x61, 0x6c, 0x5f, 0x69, 0x64,
 	0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0a, 0x70, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x61, 0x6c,
-	0x49, 0x64, 0x12, 0x46, 0x0a, 0x0a, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e,
-	0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x26, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e,
-	0x62, 0x61, 0x73, 0x65, 0x2e, 0x71, 0x75, 0x65, 0x72, 0x79, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74,
-	0x61, 0x31, 0x2e, 0x50, 0x61, 0x67, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x52, 0x0a,
-	0x70, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x88, 0x01, 0x0a, 0x12, 0x51,
-	0x75, 0x65, 0x72, 0x79, 0x56, 0x6f, 0x74, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
-	0x65, 0x12, 0x29, 0x0a, 0x05, 0x76, 0x6f, 0x74, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b,
-	0x32, 0x13, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x67, 0x6f, 0x76, 0x2e, 0x76, 0x31,
-	0x2e, 0x56, 0x6f, 0x74, 0x65, 0x52, 0x05, 0x76, 0x6f, 0x74, 0x65, 0x73, 0x12, 0x47, 0x0a, 0x0a,
-	0x70, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b,
-	0x32, 0x27, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x71,
-	0x75, 0x65, 0x72, 0x79, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x50, 0x61, 0x67,
-	0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x52, 0x0a, 0x70, 0x61, 0x67, 0x69, 0x6e,
-	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x35, 0x0a, 0x12, 0x51, 0x75, 0x65, 0x72, 0x79, 0x50, 0x61,
-	0x72, 0x61, 0x6d, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1f, 0x0a, 0x0b, 0x70,
-	0x61, 0x72, 0x61, 0x6d, 0x73, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x0a, 0x70, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x54, 0x79, 0x70, 0x65, 0x22, 0x96, 0x02, 0x0a,
-	0x13, 0x51, 0x75, 0x65, 0x72, 0x79, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x52, 0x65, 0x73, 0x70,
-	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x44, 0x0a, 0x0d, 0x76, 0x6f, 0x74, 0x69, 0x6e, 0x67, 0x5f, 0x70,
-	0x61, 0x72, 0x61, 0x6d, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x63, 0x6f,
-	0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x67, 0x6f, 0x76, 0x2e, 0x76, 0x31, 0x2e, 0x56, 0x6f, 0x74, 0x69,
-	0x6e, 0x67, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x42, 0x02, 0x18, 0x01, 0x52, 0x0c, 0x76, 0x6f,
-	0x74, 0x69, 0x6e, 0x67, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x12, 0x47, 0x0a, 0x0e, 0x64, 0x65,
-	0x70, 0x6f, 0x73, 0x69, 0x74, 0x5f, 0x70, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x18, 0x02, 0x20, 0x01,
-	0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x67, 0x6f, 0x76, 0x2e,
-	0x76, 0x31, 0x2e, 0x44, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73,
-	0x42, 0x02, 0x18, 0x01, 0x52, 0x0d, 0x64, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x50, 0x61, 0x72,
-	0x61, 0x6d, 0x73, 0x12, 0x41, 0x0a, 0x0c, 0x74, 0x61, 0x6c, 0x6c, 0x79, 0x5f, 0x70, 0x61, 0x72,
-	0x61, 0x6d, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x63, 0x6f, 0x73, 0x6d,
-	0x6f, 0x73, 0x2e, 0x67, 0x6f, 0x76, 0x2e, 0x76, 0x31, 0x2e, 0x54, 0x61, 0x6c, 0x6c, 0x79, 0x50,
-	0x61, 0x72, 0x61, 0x6d, 0x73, 0x42, 0x02, 0x18, 0x01, 0x52, 0x0b, 0x74, 0x61, 0x6c, 0x6c, 0x79,
-	0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x12, 0x2d, 0x0a, 0x06, 0x70, 0x61, 0x72, 0x61, 0x6d, 0x73,
-	0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e,
-	0x67, 0x6f, 0x76, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x52, 0x06, 0x70,
-	0x61, 0x72, 0x61, 0x6d, 0x73, 0x22, 0x6e, 0x0a, 0x13, 0x51, 0x75, 0x65, 0x72, 0x79, 0x44, 0x65,
-	0x70, 0x6f, 0x73, 0x69, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1f, 0x0a, 0x0b,
+	0x49, 0x64, 0x12, 0x36, 0x0a, 0x09, 0x64, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x6f, 0x72, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x18, 0xd2, 0xb4, 0x2d, 0x14, 0x63, 0x6f, 0x73, 0x6d, 0x6f,
+	0x73, 0x2e, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x52,
+	0x09, 0x64, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x6f, 0x72, 0x22, 0x48, 0x0a, 0x14, 0x51, 0x75,
+	0x65, 0x72, 0x79, 0x44, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x30, 0x0a, 0x07, 0x64, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x67, 0x6f, 0x76,
+	0x2e, 0x76, 0x31, 0x2e, 0x44, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x52, 0x07, 0x64, 0x65, 0x70,
+	0x6f, 0x73, 0x69, 0x74, 0x22, 0x7f, 0x0a, 0x14, 0x51, 0x75, 0x65, 0x72, 0x79, 0x44, 0x65, 0x70,
+	0x6f, 0x73, 0x69, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1f, 0x0a, 0x0b,
 	0x70, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x61, 0x6c, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x04, 0x52, 0x0a, 0x70, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x61, 0x6c, 0x49, 0x64, 0x12, 0x36, 0x0a,
-	0x09, 0x64, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x6f, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
-	0x42, 0x18, 0xd2, 0xb4, 0x2d, 0x14, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x41, 0x64, 0x64,
-	0x72, 0x65, 0x73, 0x73, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x52, 0x09, 0x64, 0x65, 0x70, 0x6f,
-	0x73, 0x69, 0x74, 0x6f, 0x72, 0x22, 0x48, 0x0a, 0x14, 0x51, 0x75, 0x65, 0x72, 0x79, 0x44, 0x65,
-	0x70, 0x6f, 0x73, 0x69, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x30, 0x0a,
-	0x07, 0x64, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x16,
-	0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x67, 0x6f, 0x76, 0x2e, 0x76, 0x31, 0x2e, 0x44,
-	0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x52, 0x07, 0x64, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x22,
-	0x7f, 0x0a, 0x14, 0x51, 0x75, 0x65, 0x72, 0x79, 0x44, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x73,
+	0x04, 0x52, 0x0a, 0x70, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x61, 0x6c, 0x49, 0x64, 0x12, 0x46, 0x0a,
+	0x0a, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x26, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x62, 0x61, 0x73, 0x65, 0x2e,
+	0x71, 0x75, 0x65, 0x72, 0x79, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x50, 0x61,
+	0x67, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x52, 0x0a, 0x70, 0x61, 0x67, 0x69, 0x6e,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x94, 0x01, 0x0a, 0x15, 0x51, 0x75, 0x65, 0x72, 0x79, 0x44,
+	0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x32, 0x0a, 0x08, 0x64, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x16, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x67, 0x6f, 0x76, 0x2e, 0x76,
+	0x31, 0x2e, 0x44, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x52, 0x08, 0x64, 0x65, 0x70, 0x6f, 0x73,
+	0x69, 0x74, 0x73, 0x12, 0x47, 0x0a, 0x0a, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x27, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73,
+	0x2e, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x71, 0x75, 0x65, 0x72, 0x79, 0x2e, 0x76, 0x31, 0x62, 0x65,
+	0x74, 0x61, 0x31, 0x2e, 0x50, 0x61, 0x67, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x52, 0x0a, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x3a, 0x0a, 0x17,
+	0x51, 0x75, 0x65, 0x72, 0x79, 0x54, 0x61, 0x6c, 0x6c, 0x79, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74,
 	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1f, 0x0a, 0x0b, 0x70, 0x72, 0x6f, 0x70, 0x6f,
 	0x73, 0x61, 0x6c, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0a, 0x70, 0x72,
-	0x6f, 0x70, 0x6f, 0x73, 0x61, 0x6c, 0x49, 0x64, 0x12, 0x46, 0x0a, 0x0a, 0x70, 0x61, 0x67, 0x69,
-	0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x26, 0x2e, 0x63,
-	0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x71, 0x75, 0x65, 0x72, 0x79,
-	0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x50, 0x61, 0x67, 0x65, 0x52, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x52, 0x0a, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e,
-	0x22, 0x94, 0x01, 0x0a, 0x15, 0x51, 0x75, 0x65, 0x72, 0x79, 0x44, 0x65, 0x70, 0x6f, 0x73, 0x69,
-	0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x32, 0x0a, 0x08, 0x64, 0x65,
-	0x70, 0x6f, 0x73, 0x69, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x63,
-	0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x67, 0x6f, 0x76, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x65, 0x70,
-	0x6f, 0x73, 0x69, 0x74, 0x52, 0x08, 0x64, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x73, 0x12, 0x47,
-	0x0a, 0x0a, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01,
-	0x28, 0x0b, 0x32, 0x27, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x62, 0x61, 0x73, 0x65,
-	0x2e, 0x71, 0x75, 0x65, 0x72, 0x79, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x50,
-	0x61, 0x67, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x52, 0x0a, 0x70, 0x61, 0x67,
-	0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x3a, 0x0a, 0x17, 0x51, 0x75, 0x65, 0x72, 0x79,
-	0x54, 0x61, 0x6c, 0x6c, 0x79, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x12, 0x1f, 0x0a, 0x0b, 0x70, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x61, 0x6c, 0x5f, 0x69,
-	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0a, 0x70, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x61,
-	0x6c, 0x49, 0x64, 0x22, 0x4c, 0x0a, 0x18, 0x51, 0x75, 0x65, 0x72, 0x79, 0x54, 0x61, 0x6c, 0x6c,
-	0x79, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
-	0x30, 0x0a, 0x05, 0x74, 0x61, 0x6c, 0x6c, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a,
-	0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x67, 0x6f, 0x76, 0x2e, 0x76, 0x31, 0x2e, 0x54,
-	0x61, 0x6c, 0x6c, 0x79, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x52, 0x05, 0x74, 0x61, 0x6c, 0x6c,
-	0x79, 0x32, 0xe3, 0x09, 0x0a, 0x05, 0x51, 0x75, 0x65, 0x72, 0x79, 0x12, 0x86, 0x01, 0x0a, 0x0c,
-	0x43, 0x6f, 0x6e, 0x73, 0x74, 0x69, 0x74, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x27, 0x2e, 0x63,
-	0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x67, 0x6f, 0x76, 0x2e, 0x76, 0x31, 0x2e, 0x51, 0x75, 0x65,
-	0x72, 0x79, 0x43, 0x6f, 0x6e, 0x73, 0x74, 0x69, 0x74, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x28, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x67,
+	0x6f, 0x70, 0x6f, 0x73, 0x61, 0x6c, 0x49, 0x64, 0x22, 0x4c, 0x0a, 0x18, 0x51, 0x75, 0x65, 0x72,
+	0x79, 0x54, 0x61, 0x6c, 0x6c, 0x79, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x30, 0x0a, 0x05, 0x74, 0x61, 0x6c, 0x6c, 0x79, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x67, 0x6f, 0x76,
+	0x2e, 0x76, 0x31, 0x2e, 0x54, 0x61, 0x6c, 0x6c, 0x79, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x52,
+	0x05, 0x74, 0x61, 0x6c, 0x6c, 0x79, 0x22, 0x46, 0x0a, 0x23, 0x51, 0x75, 0x65, 0x72, 0x79, 0x56,
+	0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x56, 0x6f, 0x74, 0x65, 0x42, 0x72, 0x65, 0x61,
+	0x6b, 0x64, 0x6f, 0x77, 0x6e, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1f, 0x0a,
+	0x0b, 0x70, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x61, 0x6c, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x04, 0x52, 0x0a, 0x70, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x61, 0x6c, 0x49, 0x64, 0x22, 0x6d,
+	0x0a, 0x24, 0x51, 0x75, 0x65, 0x72, 0x79, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72,
+	0x56, 0x6f, 0x74, 0x65, 0x42, 0x72, 0x65, 0x61, 0x6b, 0x64, 0x6f, 0x77, 0x6e, 0x73, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x45, 0x0a, 0x0a, 0x62, 0x72, 0x65, 0x61, 0x6b, 0x64,
+	0x6f, 0x77, 0x6e, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x25, 0x2e, 0x63, 0x6f, 0x73,
+	0x6d, 0x6f, 0x73, 0x2e, 0x67, 0x6f, 0x76, 0x2e, 0x76, 0x31, 0x2e, 0x56, 0x61, 0x6c, 0x69, 0x64,
+	0x61, 0x74, 0x6f, 0x72, 0x56, 0x6f, 0x74, 0x65, 0x42, 0x72, 0x65, 0x61, 0x6b, 0x64, 0x6f, 0x77,
+	0x6e, 0x52, 0x0a, 0x62, 0x72, 0x65, 0x61, 0x6b, 0x64, 0x6f, 0x77, 0x6e, 0x73, 0x22, 0x2b, 0x0a,
+	0x29, 0x51, 0x75, 0x65, 0x72, 0x79, 0x50, 0x61, 0x72, 0x74, 0x69, 0x63, 0x69, 0x70, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x45, 0x78, 0x65, 0x6d, 0x70, 0x74, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74,
+	0x6f, 0x72, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0xae, 0x01, 0x0a, 0x2a, 0x51,
+	0x75, 0x65, 0x72, 0x79, 0x50, 0x61, 0x72, 0x74, 0x69, 0x63, 0x69, 0x70, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x45, 0x78, 0x65, 0x6d, 0x70, 0x74, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72,
+	0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2c, 0x0a, 0x09, 0x74, 0x68, 0x72,
+	0x65, 0x73, 0x68, 0x6f, 0x6c, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x0e, 0xd2, 0xb4,
+	0x2d, 0x0a, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x44, 0x65, 0x63, 0x52, 0x09, 0x74, 0x68,
+	0x72, 0x65, 0x73, 0x68, 0x6f, 0x6c, 0x64, 0x12, 0x52, 0x0a, 0x13, 0x76, 0x61, 0x6c, 0x69, 0x64,
+	0x61, 0x74, 0x6f, 0x72, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x65, 0x73, 0x18, 0x02,
+	0x20, 0x03, 0x28, 0x09, 0x42, 0x21, 0xd2, 0xb4, 0x2d, 0x1d, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73,
+	0x2e, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73,
+	0x73, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x52, 0x12, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74,
+	0x6f, 0x72, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x65, 0x73, 0x22, 0x9b, 0x01, 0x0a, 0x1c,
+	0x51, 0x75, 0x65, 0x72, 0x79, 0x53, 0x69, 0x6d, 0x75, 0x6c, 0x61, 0x74, 0x65, 0x50, 0x72, 0x6f,
+	0x70, 0x6f, 0x73, 0x61, 0x6c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x30, 0x0a, 0x08,
+	0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x14,
+	0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66,
+	0x2e, 0x41, 0x6e, 0x79, 0x52, 0x08, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x12, 0x49,
+	0x0a, 0x13, 0x65, 0x78, 0x65, 0x63, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x61, 0x75, 0x74, 0x68,
+	0x6f, 0x72, 0x69, 0x74, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x18, 0xd2, 0xb4, 0x2d,
+	0x14, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x53,
+	0x74, 0x72, 0x69, 0x6e, 0x67, 0x52, 0x12, 0x65, 0x78, 0x65, 0x63, 0x75, 0x74, 0x69, 0x6f, 0x6e,
+	0x41, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x69, 0x74, 0x79, 0x22, 0xb3, 0x01, 0x0a, 0x1d, 0x51, 0x75,
+	0x65, 0x72, 0x79, 0x53, 0x69, 0x6d, 0x75, 0x6c, 0x61, 0x74, 0x65, 0x50, 0x72, 0x6f, 0x70, 0x6f,
+	0x73, 0x61, 0x6c, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x19, 0x0a, 0x08, 0x67,
+	0x61, 0x73, 0x5f, 0x75, 0x73, 0x65, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x07, 0x67,
+	0x61, 0x73, 0x55, 0x73, 0x65, 0x64, 0x12, 0x34, 0x0a, 0x06, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x73,
+	0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x74, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x6d,
+	0x69, 0x6e, 0x74, 0x2e, 0x61, 0x62, 0x63, 0x69, 0x2e, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x42, 0x04,
+	0xc8, 0xde, 0x1f, 0x00, 0x52, 0x06, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x12, 0x41, 0x0a, 0x07,
+	0x66, 0x61, 0x69, 0x6c, 0x75, 0x72, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x27, 0x2e,
+	0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x67, 0x6f, 0x76, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x72,
+	0x6f, 0x70, 0x6f, 0x73, 0x61, 0x6c, 0x45, 0x78, 0x65, 0x63, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x46,
+	0x61, 0x69, 0x6c, 0x75, 0x72, 0x65, 0x52, 0x07, 0x66, 0x61, 0x69, 0x6c, 0x75, 0x72, 0x65, 0x22,
+	0x39, 0x0a, 0x1a, 0x51, 0x75, 0x65, 0x72, 0x79, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61,
+	0x53, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1b, 0x0a,
+	0x09, 0x73, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04,
+	0x52, 0x08, 0x73, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x49, 0x64, 0x22, 0x54, 0x0a, 0x1b, 0x51, 0x75,
+	0x65, 0x72, 0x79, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x53, 0x63, 0x68, 0x65, 0x6d,
+	0x61, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x35, 0x0a, 0x06, 0x73, 0x63, 0x68,
+	0x65, 0x6d, 0x61, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x63, 0x6f, 0x73, 0x6d,
+	0x6f, 0x73, 0x2e, 0x67, 0x6f, 0x76, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61,
+	0x74, 0x61, 0x53, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x52, 0x06, 0x73, 0x63, 0x68, 0x65, 0x6d, 0x61,
+	0x22, 0x65, 0x0a, 0x1b, 0x51, 0x75, 0x65, 0x72, 0x79, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74,
+	0x61, 0x53, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x46, 0x0a, 0x0a, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x26, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x62, 0x61, 0x73,
+	0x65, 0x2e, 0x71, 0x75, 0x65, 0x72, 0x79, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e,
+	0x50, 0x61, 0x67, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x52, 0x0a, 0x70, 0x61, 0x67,
+	0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0xa0, 0x01, 0x0a, 0x1c, 0x51, 0x75, 0x65, 0x72,
+	0x79, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x53, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x73,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x37, 0x0a, 0x07, 0x73, 0x63, 0x68, 0x65,
+	0x6d, 0x61, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x63, 0x6f, 0x73, 0x6d,
+	0x6f, 0x73, 0x2e, 0x67, 0x6f, 0x76, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61,
+	0x74, 0x61, 0x53, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x52, 0x07, 0x73, 0x63, 0x68, 0x65, 0x6d, 0x61,
+	0x73, 0x12, 0x47, 0x0a, 0x0a, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x27, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x62,
+	0x61, 0x73, 0x65, 0x2e, 0x71, 0x75, 0x65, 0x72, 0x79, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61,
+	0x31, 0x2e, 0x50, 0x61, 0x67, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x52, 0x0a,
+	0x70, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x32, 0xe6, 0x14, 0x0a, 0x05, 0x51,
+	0x75, 0x65, 0x72, 0x79, 0x12, 0x86, 0x01, 0x0a, 0x0c, 0x43, 0x6f, 0x6e, 0x73, 0x74, 0x69, 0x74,
+	0x75, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x27, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x67,
 	0x6f, 0x76, 0x2e, 0x76, 0x31, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x43, 0x6f, 0x6e, 0x73, 0x74,
-	0x69, 0x74, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22,
-	0x23, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1d, 0x12, 0x1b, 0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73,
+	0x69, 0x74, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x28,
+	0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x67, 0x6f, 0x76, 0x2e, 0x76, 0x31, 0x2e, 0x51,
+	0x75, 0x65, 0x72, 0x79, 0x43, 0x6f, 0x6e, 0x73, 0x74, 0x69, 0x74, 0x75, 0x74, 0x69, 0x6f, 0x6e,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x23, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1d,
+	0x12, 0x1b, 0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2f, 0x67, 0x6f, 0x76, 0x2f, 0x76, 0x31,
+	0x2f, 0x63, 0x6f, 0x6e, 0x73, 0x74, 0x69, 0x74, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0xaf, 0x01,
+	0x0a, 0x16, 0x43, 0x6f, 0x6e, 0x73, 0x74, 0x69, 0x74, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x41, 0x6d,
+	0x65, 0x6e, 0x64, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x12, 0x31, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f,
+	0x73, 0x2e, 0x67, 0x6f, 0x76, 0x2e, 0x76, 0x31, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x43, 0x6f,
+	0x6e, 0x73, 0x74, 0x69, 0x74, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x41, 0x6d, 0x65, 0x6e, 0x64, 0x6d,
+	0x65, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x32, 0x2e, 0x63, 0x6f,
+	0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x67, 0x6f, 0x76, 0x2e, 0x76, 0x31, 0x2e, 0x51, 0x75, 0x65, 0x72,
+	0x79, 0x43, 0x6f, 0x6e, 0x73, 0x74, 0x69, 0x74, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x41, 0x6d, 0x65,
+	0x6e, 0x64, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22,
+	0x2e, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x28, 0x12, 0x26, 0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73,
 	0x2f, 0x67, 0x6f, 0x76, 0x2f, 0x76, 0x31, 0x2f, 0x63, 0x6f, 0x6e, 0x73, 0x74, 0x69, 0x74, 0x75,
-	0x74, 0x69, 0x6f, 0x6e, 0x12, 0x85, 0x01, 0x0a, 0x08, 0x50, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x61,
-	0x6c, 0x12, 0x23, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x67, 0x6f, 0x76, 0x2e, 0x76,
+	0x74, 0x69, 0x6f, 0x6e, 0x2f, 0x61, 0x6d, 0x65, 0x6e, 0x64, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x12,
+	0x85, 0x01, 0x0a, 0x08, 0x50, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x61, 0x6c, 0x12, 0x23, 0x2e, 0x63,
+	0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x67, 0x6f, 0x76, 0x2e, 0x76, 0x31, 0x2e, 0x51, 0x75, 0x65,
+	0x72, 0x79, 0x50, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x61, 0x6c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x24, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x67, 0x6f, 0x76, 0x2e, 0x76,
 	0x31, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x50, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x61, 0x6c, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x24, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e,
-	0x67, 0x6f, 0x76, 0x2e, 0x76, 0x31, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x50, 0x72, 0x6f, 0x70,
-	0x6f, 0x73, 0x61, 0x6c, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x2e, 0x82, 0xd3,
-	0xe4, 0x93, 0x02, 0x28, 0x12, 0x26, 0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2f, 0x67, 0x6f,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x2e, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x28, 0x12,
+	0x26, 0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2f, 0x67, 0x6f, 0x76, 0x2f, 0x76, 0x31, 0x2f,
+	0x70, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x61, 0x6c, 0x73, 0x2f, 0x7b, 0x70, 0x72, 0x6f, 0x70, 0x6f,
+	0x73, 0x61, 0x6c, 0x5f, 0x69, 0x64, 0x7d, 0x12, 0x7a, 0x0a, 0x09, 0x50, 0x72, 0x6f, 0x70, 0x6f,
+	0x73, 0x61, 0x6c, 0x73, 0x12, 0x24, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x67, 0x6f,
+	0x76, 0x2e, 0x76, 0x31, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x50, 0x72, 0x6f, 0x70, 0x6f, 0x73,
+	0x61, 0x6c, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x25, 0x2e, 0x63, 0x6f, 0x73,
+	0x6d, 0x6f, 0x73, 0x2e, 0x67, 0x6f, 0x76, 0x2e, 0x76, 0x31, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79,
+	0x50, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x61, 0x6c, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x22, 0x20, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1a, 0x12, 0x18, 0x2f, 0x63, 0x6f, 0x73, 0x6d,
+	0x6f, 0x73, 0x2f, 0x67, 0x6f, 0x76, 0x2f, 0x76, 0x31, 0x2f, 0x70, 0x72, 0x6f, 0x70, 0x6f, 0x73,
+	0x61, 0x6c, 0x73, 0x12, 0x87, 0x01, 0x0a, 0x04, 0x56, 0x6f, 0x74, 0x65, 0x12, 0x1f, 0x2e, 0x63,
+	0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x67, 0x6f, 0x76, 0x2e, 0x76, 0x31, 0x2e, 0x51, 0x75, 0x65,
+	0x72, 0x79, 0x56, 0x6f, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x20, 0x2e,
+	0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x67, 0x6f, 0x76, 0x2e, 0x76, 0x31, 0x2e, 0x51, 0x75,
+	0x65, 0x72, 0x79, 0x56, 0x6f, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22,
+	0x3c, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x36, 0x12, 0x34, 0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73,
+	0x2f, 0x67, 0x6f, 0x76, 0x2f, 0x76, 0x31, 0x2f, 0x70, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x61, 0x6c,
+	0x73, 0x2f, 0x7b, 0x70, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x61, 0x6c, 0x5f, 0x69, 0x64, 0x7d, 0x2f,
+	0x76, 0x6f, 0x74, 0x65, 0x73, 0x2f, 0x7b, 0x76, 0x6f, 0x74, 0x65, 0x72, 0x7d, 0x12, 0xab, 0x01,
+	0x0a, 0x0e, 0x56, 0x6f, 0x74, 0x65, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x6d, 0x65, 0x6e, 0x74,
+	0x12, 0x29, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x67, 0x6f, 0x76, 0x2e, 0x76, 0x31,
+	0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x56, 0x6f, 0x74, 0x65, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74,
+	0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2a, 0x2e, 0x63, 0x6f,
+	0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x67, 0x6f, 0x76, 0x2e, 0x76, 0x31, 0x2e, 0x51, 0x75, 0x65, 0x72,
+	0x79, 0x56, 0x6f, 0x74, 0x65, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x6d, 0x65, 0x6e, 0x74, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x42, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x3c, 0x12,
+	0x3a, 0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2f, 0x67, 0x6f, 0x76, 0x2f, 0x76, 0x31, 0x2f,
+	0x70, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x61, 0x6c, 0x73, 0x2f, 0x7b, 0x70, 0x72, 0x6f, 0x70, 0x6f,
+	0x73, 0x61, 0x6c, 0x5f, 0x69, 0x64, 0x7d, 0x2f, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x6d, 0x65,
+	0x6e, 0x74, 0x73, 0x2f, 0x7b, 0x76, 0x6f, 0x74, 0x65, 0x72, 0x7d, 0x12, 0xaf, 0x01, 0x0a, 0x10,
+	0x45, 0x78, 0x65, 0x63, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x63, 0x65, 0x69, 0x70, 0x74,
+	0x12, 0x2b, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x67, 0x6f, 0x76, 0x2e, 0x76, 0x31,
+	0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x45, 0x78, 0x65, 0x63, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x52,
+	0x65, 0x63, 0x65, 0x69, 0x70, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2c, 0x2e,
+	0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x67, 0x6f, 0x76, 0x2e, 0x76, 0x31, 0x2e, 0x51, 0x75,
+	0x65, 0x72, 0x79, 0x45, 0x78, 0x65, 0x63, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x63, 0x65,
+	0x69, 0x70, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x40, 0x82, 0xd3, 0xe4,
+	0x93, 0x02, 0x3a, 0x12, 0x38, 0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2f, 0x67, 0x6f, 0x76,
+	0x2f, 0x76, 0x31, 0x2f, 0x70, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x61, 0x6c, 0x73, 0x2f, 0x7b, 0x70,
+	0x72, 0x6f, 0x70, 0x6f, 0x73, 0x61, 0x6c, 0x5f, 0x69, 0x64, 0x7d, 0x2f, 0x65, 0x78, 0x65, 0x63,
+	0x75, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x72, 0x65, 0x63, 0x65, 0x69, 0x70, 0x74, 0x12, 0x82, 0x01,
+	0x0a, 0x05, 0x56, 0x6f, 0x74, 0x65, 0x73, 0x12, 0x20, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73,
+	0x2e, 0x67, 0x6f, 0x76, 0x2e, 0x76, 0x31, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x56, 0x6f, 0x74,
+	0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x21, 0x2e, 0x63, 0x6f, 0x73, 0x6d,
+	0x6f, 0x73, 0x2e, 0x67, 0x6f, 0x76, 0x2e, 0x76, 0x31, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x56,
+	0x6f, 0x74, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x34, 0x82, 0xd3,
+	0xe4, 0x93, 0x02, 0x2e, 0x12, 0x2c, 0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2f, 0x67, 0x6f,
 	0x76, 0x2f, 0x76, 0x31, 0x2f, 0x70, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x61, 0x6c, 0x73, 0x2f, 0x7b,
-	0x70, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x61, 0x6c, 0x5f, 0x69, 0x64, 0x7d, 0x12, 0x7a, 0x0a, 0x09,
-	0x50, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x61, 0x6c, 0x73, 0x12, 0x24, 0x2e, 0x63, 0x6f, 0x73, 0x6d,
-	0x6f, 0x73, 0x2e, 0x67, 0x6f, 0x76, 0x2e, 0x76, 0x31, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x50,
-	0x72, 0x6f, 0x70, 0x6f, 0x73, 0x61, 0x6c, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
-	0x25, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x67, 0x6f, 0x76, 0x2e, 0x76, 0x31, 0x2e,
-	0x51, 0x75, 0x65, 0x72, 0x79, 0x50, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x61, 0x6c, 0x73, 0x52, 0x65,
-	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x20, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1a, 0x12, 0x18,
-	0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2f, 0x67, 0x6f, 0x76, 0x2f, 0x76, 0x31, 0x2f, 0x70,
-	0x72, 0x6f, 0x70, 0x6f, 0x73, 0x61, 0x6c, 0x73, 0x12, 0x87, 0x01, 0x0a, 0x04, 0x56, 0x6f, 0x74,
-	0x65, 0x12, 0x1f, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x67, 0x6f, 0x76, 0x2e, 0x76,
-	0x31, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x56, 0x6f, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x1a, 0x20, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x67, 0x6f, 0x76, 0x2e,
-	0x76, 0x31, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x56, 0x6f, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70,
-	0x6f, 0x6e, 0x73, 0x65, 0x22, 0x3c, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x36, 0x12, 0x34, 0x2f, 0x63,
-	0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2f, 0x67, 0x6f, 0x76, 0x2f, 0x76, 0x31, 0x2f, 0x70, 0x72, 0x6f,
-	0x70, 0x6f, 0x73, 0x61, 0x6c, 0x73, 0x2f, 0x7b, 0x70, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x61, 0x6c,
-	0x5f, 0x69, 0x64, 0x7d, 0x2f, 0x76, 0x6f, 0x74, 0x65, 0x73, 0x2f, 0x7b, 0x76, 0x6f, 0x74, 0x65,
-	0x72, 0x7d, 0x12, 0x82, 0x01, 0x0a, 0x05, 0x56, 0x6f, 0x74, 0x65, 0x73, 0x12, 0x20, 0x2e, 0x63,
+	0x70, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x61, 0x6c, 0x5f, 0x69, 0x64, 0x7d, 0x2f, 0x76, 0x6f, 0x74,
+	0x65, 0x73, 0x12, 0x7c, 0x0a, 0x06, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x12, 0x21, 0x2e, 0x63,
 	0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x67, 0x6f, 0x76, 0x2e, 0x76, 0x31, 0x2e, 0x51, 0x75, 0x65,
-	0x72, 0x79, 0x56, 0x6f, 0x74, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x21,
-	0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x67, 0x6f, 0x76, 0x2e, 0x76, 0x31, 0x2e, 0x51,
-	0x75, 0x65, 0x72, 0x79, 0x56, 0x6f, 0x74, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
-	0x65, 0x22, 0x34, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x2e, 0x12, 0x2c, 0x2f, 0x63, 0x6f, 0x73, 0x6d,
-	0x6f, 0x73, 0x2f, 0x67, 0x6f, 0x76, 0x2f, 0x76, 0x31, 0x2f, 0x70, 0x72, 0x6f, 0x70, 0x6f, 0x73,
-	0x61, 0x6c, 0x73, 0x2f, 0x7b, 0x70, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x61, 0x6c, 0x5f, 0x69, 0x64,
-	0x7d, 0x2f, 0x76, 0x6f, 0x74, 0x65, 0x73, 0x12, 0x7c, 0x0a, 0x06, 0x50, 0x61, 0x72, 0x61, 0x6d,
-	0x73, 0x12, 0x21, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x67, 0x6f, 0x76, 0x2e, 0x76,
-	0x31, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x52, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x1a, 0x22, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x67, 0x6f,
-	0x76, 0x2e, 0x76, 0x31, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73,
-	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x2b, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x25,
-	0x12, 0x23, 0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2f, 0x67, 0x6f, 0x76, 0x2f, 0x76, 0x31,
-	0x2f, 0x70, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x2f, 0x7b, 0x70, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x5f,
-	0x74, 0x79, 0x70, 0x65, 0x7d, 0x12, 0x97, 0x01, 0x0a, 0x07, 0x44, 0x65, 0x70, 0x6f, 0x73, 0x69,
-	0x74, 0x12, 0x22, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x67, 0x6f, 0x76, 0x2e, 0x76,
-	0x31, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x44, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x52, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x23, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x67,
-	0x6f, 0x76, 0x2e, 0x76, 0x31, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x44, 0x65, 0x70, 0x6f, 0x73,
-	0x69, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x43, 0x82, 0xd3, 0xe4, 0x93,
-	0x02, 0x3d, 0x12, 0x3b, 0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2f, 0x67, 0x6f, 0x76, 0x2f,
-	0x76, 0x31, 0x2f, 0x70, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x61, 0x6c, 0x73, 0x2f, 0x7b, 0x70, 0x72,
-	0x6f, 0x70, 0x6f, 0x73, 0x61, 0x6c, 0x5f, 0x69, 0x64, 0x7d, 0x2f, 0x64, 0x65, 0x70, 0x6f, 0x73,
-	0x69, 0x74, 0x73, 0x2f, 0x7b, 0x64, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x6f, 0x72, 0x7d, 0x12,
-	0x8e, 0x01, 0x0a, 0x08, 0x44, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x73, 0x12, 0x23, 0x2e, 0x63,
+	0x72, 0x79, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x22, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x67, 0x6f, 0x76, 0x2e, 0x76, 0x31, 0x2e,
+	0x51, 0x75, 0x65, 0x72, 0x79, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x22, 0x2b, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x25, 0x12, 0x23, 0x2f, 0x63, 0x6f,
+	0x73, 0x6d, 0x6f, 0x73, 0x2f, 0x67, 0x6f, 0x76, 0x2f, 0x76, 0x31, 0x2f, 0x70, 0x61, 0x72, 0x61,
+	0x6d, 0x73, 0x2f, 0x7b, 0x70, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x7d,
+	0x12, 0x97, 0x01, 0x0a, 0x07, 0x44, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x12, 0x22, 0x2e, 0x63,
 	0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x67, 0x6f, 0x76, 0x2e, 0x76, 0x31, 0x2e, 0x51, 0x75, 0x65,
-	0x72, 0x79, 0x44, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x1a, 0x24, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x67, 0x6f, 0x76, 0x2e, 0x76,
-	0x31, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x44, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x73, 0x52,
-	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x37, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x31, 0x12,
-	0x2f, 0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2f, 0x67, 0x6f, 0x76, 0x2f, 0x76, 0x31, 0x2f,
+	0x72, 0x79, 0x44, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x23, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x67, 0x6f, 0x76, 0x2e, 0x76, 0x31,
+	0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x44, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x43, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x3d, 0x12, 0x3b, 0x2f,
+	0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2f, 0x67, 0x6f, 0x76, 0x2f, 0x76, 0x31, 0x2f, 0x70, 0x72,
+	0x6f, 0x70, 0x6f, 0x73, 0x61, 0x6c, 0x73, 0x2f, 0x7b, 0x70, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x61,
+	0x6c, 0x5f, 0x69, 0x64, 0x7d, 0x2f, 0x64, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x73, 0x2f, 0x7b,
+	0x64, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x6f, 0x72, 0x7d, 0x12, 0x8e, 0x01, 0x0a, 0x08, 0x44,
+	0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x73, 0x12, 0x23, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73,
+	0x2e, 0x67, 0x6f, 0x76, 0x2e, 0x76, 0x31, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x44, 0x65, 0x70,
+	0x6f, 0x73, 0x69, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x24, 0x2e, 0x63,
+	0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x67, 0x6f, 0x76, 0x2e, 0x76, 0x31, 0x2e, 0x51, 0x75, 0x65,
+	0x72, 0x79, 0x44, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x22, 0x37, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x31, 0x12, 0x2f, 0x2f, 0x63, 0x6f, 0x73,
+	0x6d, 0x6f, 0x73, 0x2f, 0x67, 0x6f, 0x76, 0x2f, 0x76, 0x31, 0x2f, 0x70, 0x72, 0x6f, 0x70, 0x6f,
+	0x73, 0x61, 0x6c, 0x73, 0x2f, 0x7b, 0x70, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x61, 0x6c, 0x5f, 0x69,
+	0x64, 0x7d, 0x2f, 0x64, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x73, 0x12, 0x94, 0x01, 0x0a, 0x0b,
+	0x54, 0x61, 0x6c, 0x6c, 0x79, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x12, 0x26, 0x2e, 0x63, 0x6f,
+	0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x67, 0x6f, 0x76, 0x2e, 0x76, 0x31, 0x2e, 0x51, 0x75, 0x65, 0x72,
+	0x79, 0x54, 0x61, 0x6c, 0x6c, 0x79, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x27, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x67, 0x6f, 0x76,
+	0x2e, 0x76, 0x31, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x54, 0x61, 0x6c, 0x6c, 0x79, 0x52, 0x65,
+	0x73, 0x75, 0x6c, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x34, 0x82, 0xd3,
+	0xe4, 0x93, 0x02, 0x2e, 0x12, 0x2c, 0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2f, 0x67, 0x6f,
+	0x76, 0x2f, 0x76, 0x31, 0x2f, 0x70, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x61, 0x6c, 0x73, 0x2f, 0x7b,
+	0x70, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x61, 0x6c, 0x5f, 0x69, 0x64, 0x7d, 0x2f, 0x74, 0x61, 0x6c,
+	0x6c, 0x79, 0x12, 0xcc, 0x01, 0x0a, 0x17, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72,
+	0x56, 0x6f, 0x74, 0x65, 0x42, 0x72, 0x65, 0x61, 0x6b, 0x64, 0x6f, 0x77, 0x6e, 0x73, 0x12, 0x32,
+	0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x67, 0x6f, 0x76, 0x2e, 0x76, 0x31, 0x2e, 0x51,
+	0x75, 0x65, 0x72, 0x79, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x56, 0x6f, 0x74,
+	0x65, 0x42, 0x72, 0x65, 0x61, 0x6b, 0x64, 0x6f, 0x77, 0x6e, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x33, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x67, 0x6f, 0x76, 0x2e,
+	0x76, 0x31, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f,
+	0x72, 0x56, 0x6f, 0x74, 0x65, 0x42, 0x72, 0x65, 0x61, 0x6b, 0x64, 0x6f, 0x77, 0x6e, 0x73, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x48, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x42, 0x12,
+	0x40, 0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2f, 0x67, 0x6f, 0x76, 0x2f, 0x76, 0x31, 0x2f,
 	0x70, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x61, 0x6c, 0x73, 0x2f, 0x7b, 0x70, 0x72, 0x6f, 0x70, 0x6f,
-	0x73, 0x61, 0x6c, 0x5f, 0x69, 0x64, 0x7d, 0x2f, 0x64, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x73,
-	0x12, 0x94, 0x01, 0x0a, 0x0b, 0x54, 0x61, 0x6c, 0x6c, 0x79, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74,
-	0x12, 0x26, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x67, 0x6f, 0x76, 0x2e, 0x76, 0x31,
-	0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x54, 0x61, 0x6c, 0x6c, 0x79, 0x52, 0x65, 0x73, 0x75, 0x6c,
-	0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x27, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f,
-	0x73, 0x2e, 0x67, 0x6f, 0x76, 0x2e, 0x76, 0x31, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x54, 0x61,
-	0x6c, 0x6c, 0x79, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
-	0x65, 0x22, 0x34, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x2e, 0x12, 0x2c, 0x2f, 0x63, 0x6f, 0x73, 0x6d,
-	0x6f, 0x73, 0x2f, 0x67, 0x6f, 0x76, 0x2f, 0x76, 0x31, 0x2f, 0x70, 0x72, 0x6f, 0x70, 0x6f, 0x73,
-	0x61, 0x6c, 0x73, 0x2f, 0x7b, 0x70, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x61, 0x6c, 0x5f, 0x69, 0x64,
-	0x7d, 0x2f, 0x74, 0x61, 0x6c, 0x6c, 0x79, 0x42, 0x9b, 0x01, 0x0a, 0x11, 0x63, 0x6f, 0x6d, 0x2e,
-	0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x67, 0x6f, 0x76, 0x2e, 0x76, 0x31, 0x42, 0x0a, 0x51,
-	0x75, 0x65, 0x72, 0x79, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50, 0x01, 0x5a, 0x24, 0x63, 0x6f, 0x73,
-	0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x63, 0x6f,
-	0x73, 0x6d, 0x6f, 0x73, 0x2f, 0x67, 0x6f, 0x76, 0x2f, 0x76, 0x31, 0x3b, 0x67, 0x6f, 0x76, 0x76,
-	0x31, 0xa2, 0x02, 0x03, 0x43, 0x47, 0x58, 0xaa, 0x02, 0x0d, 0x43, 0x6f, 0x73, 0x6d, 0x6f, 0x73,
-	0x2e, 0x47, 0x6f, 0x76, 0x2e, 0x56, 0x31, 0xca, 0x02, 0x0d, 0x43, 0x6f, 0x73, 0x6d, 0x6f, 0x73,
-	0x5c, 0x47, 0x6f, 0x76, 0x5c, 0x56, 0x31, 0xe2, 0x02, 0x19, 0x43, 0x6f, 0x73, 0x6d, 0x6f, 0x73,
-	0x5c, 0x47, 0x6f, 0x76, 0x5c, 0x56, 0x31, 0x5c, 0x47, 0x50, 0x42, 0x4d, 0x65, 0x74, 0x61, 0x64,
-	0x61, 0x74, 0x61, 0xea, 0x02, 0x0f, 0x43, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x3a, 0x3a, 0x47, 0x6f,
-	0x76, 0x3a, 0x3a, 0x56, 0x31, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x73, 0x61, 0x6c, 0x5f, 0x69, 0x64, 0x7d, 0x2f, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f,
+	0x72, 0x5f, 0x76, 0x6f, 0x74, 0x65, 0x5f, 0x62, 0x72, 0x65, 0x61, 0x6b, 0x64, 0x6f, 0x77, 0x6e,
+	0x73, 0x12, 0xcc, 0x01, 0x0a, 0x1d, 0x50, 0x61, 0x72, 0x74, 0x69, 0x63, 0x69, 0x70, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x45, 0x78, 0x65, 0x6d, 0x70, 0x74, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74,
+	0x6f, 0x72, 0x73, 0x12, 0x38, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x67, 0x6f, 0x76,
+	0x2e, 0x76, 0x31, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x50, 0x61, 0x72, 0x74, 0x69, 0x63, 0x69,
+	0x70, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x45, 0x78, 0x65, 0x6d, 0x70, 0x74, 0x56, 0x61, 0x6c, 0x69,
+	0x64, 0x61, 0x74, 0x6f, 0x72, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x39, 0x2e,
+	0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x67, 0x6f, 0x76, 0x2e, 0x76, 0x31, 0x2e, 0x51, 0x75,
+	0x65, 0x72, 0x79, 0x50, 0x61, 0x72, 0x74, 0x69, 0x63, 0x69, 0x70, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x45, 0x78, 0x65, 0x6d, 0x70, 0x74, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x73,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x36, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x30,
+	0x12, 0x2e, 0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2f, 0x67, 0x6f, 0x76, 0x2f, 0x76, 0x31,
+	0x2f, 0x70, 0x61, 0x72, 0x74, 0x69, 0x63, 0x69, 0x70, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x65,
+	0x78, 0x65, 0x6d, 0x70, 0x74, 0x5f, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x73,
+	0x12, 0x9b, 0x01, 0x0a, 0x10, 0x53, 0x69, 0x6d, 0x75, 0x6c, 0x61, 0x74, 0x65, 0x50, 0x72, 0x6f,
+	0x70, 0x6f, 0x73, 0x61, 0x6c, 0x12, 0x2b, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x67,
+	0x6f, 0x76, 0x2e, 0x76, 0x31, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x53, 0x69, 0x6d, 0x75, 0x6c,
+	0x61, 0x74, 0x65, 0x50, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x61, 0x6c, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x2c, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x67, 0x6f, 0x76, 0x2e,
+	0x76, 0x31, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x53, 0x69, 0x6d, 0x75, 0x6c, 0x61, 0x74, 0x65,
+	0x50, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x61, 0x6c, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x22, 0x2c, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x26, 0x22, 0x21, 0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f,
+	0x73, 0x2f, 0x67, 0x6f, 0x76, 0x2f, 0x76, 0x31, 0x2f, 0x70, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x61,
+	0x6c, 0x73, 0x2f, 0x73, 0x69, 0x6d, 0x75, 0x6c, 0x61, 0x74, 0x65, 0x3a, 0x01, 0x2a, 0x12, 0x9c,
+	0x01, 0x0a, 0x0e, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x53, 0x63, 0x68, 0x65, 0x6d,
+	0x61, 0x12, 0x29, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x67, 0x6f, 0x76, 0x2e, 0x76,
+	0x31, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x53,
+	0x63, 0x68, 0x65, 0x6d, 0x61, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2a, 0x2e, 0x63,
+	0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x67, 0x6f, 0x76, 0x2e, 0x76, 0x31, 0x2e, 0x51, 0x75, 0x65,
+	0x72, 0x79, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x53, 0x63, 0x68, 0x65, 0x6d, 0x61,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x33, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x2d,
+	0x12, 0x2b, 0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2f, 0x67, 0x6f, 0x76, 0x2f, 0x76, 0x31,
+	0x2f, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x5f, 0x73, 0x63, 0x68, 0x65, 0x6d, 0x61,
+	0x73, 0x2f, 0x7b, 0x73, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x5f, 0x69, 0x64, 0x7d, 0x12, 0x93, 0x01,
+	0x0a, 0x0f, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x53, 0x63, 0x68, 0x65, 0x6d, 0x61,
+	0x73, 0x12, 0x2a, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x67, 0x6f, 0x76, 0x2e, 0x76,
+	0x31, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x53,
+	0x63, 0x68, 0x65, 0x6d, 0x61, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2b, 0x2e,
+	0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x67, 0x6f, 0x76, 0x2e, 0x76, 0x31, 0x2e, 0x51, 0x75,
+	0x65, 0x72, 0x79, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x53, 0x63, 0x68, 0x65, 0x6d,
+	0x61, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x27, 0x82, 0xd3, 0xe4, 0x93,
+	0x02, 0x21, 0x12, 0x1f, 0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2f, 0x67, 0x6f, 0x76, 0x2f,
+	0x76, 0x31, 0x2f, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x5f, 0x73, 0x63, 0x68, 0x65,
+	0x6d, 0x61, 0x73, 0x42, 0x9b, 0x01, 0x0a, 0x11, 0x63, 0x6f, 0x6d, 0x2e, 0x63, 0x6f, 0x73, 0x6d,
+	0x6f, 0x73, 0x2e, 0x67, 0x6f, 0x76, 0x2e, 0x76, 0x31, 0x42, 0x0a, 0x51, 0x75, 0x65, 0x72, 0x79,
+	0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50, 0x01, 0x5a, 0x24, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73,
+	0x64, 0x6b, 0x2e, 0x69, 0x6f, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73,
+	0x2f, 0x67, 0x6f, 0x76, 0x2f, 0x76, 0x31, 0x3b, 0x67, 0x6f, 0x76, 0x76, 0x31, 0xa2, 0x02, 0x03,
+	0x43, 0x47, 0x58, 0xaa, 0x02, 0x0d, 0x43, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x47, 0x6f, 0x76,
+	0x2e, 0x56, 0x31, 0xca, 0x02, 0x0d, 0x43, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x5c, 0x47, 0x6f, 0x76,
+	0x5c, 0x56, 0x31, 0xe2, 0x02, 0x19, 0x43, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x5c, 0x47, 0x6f, 0x76,
+	0x5c, 0x56, 0x31, 0x5c, 0x47, 0x50, 0x42, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0xea,
+	0x02, 0x0f, 0x43, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x3a, 0x3a, 0x47, 0x6f, 0x76, 0x3a, 0x3a, 0x56,
+	0x31, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -9709,80 +18181,133 @@ func file_cosmos_gov_v1_query_proto_rawDescGZIP() []byte {
 	return file_cosmos_gov_v1_query_proto_rawDescData
 }
 
-var file_cosmos_gov_v1_query_proto_msgTypes = make([]protoimpl.MessageInfo, 18)
+var file_cosmos_gov_v1_query_proto_msgTypes = make([]protoimpl.MessageInfo, 34)
 var file_cosmos_gov_v1_query_proto_goTypes = []interface{}{
-	(*QueryConstitutionRequest)(nil),  // 0: cosmos.gov.v1.QueryConstitutionRequest
-	(*QueryConstitutionResponse)(nil), // 1: cosmos.gov.v1.QueryConstitutionResponse
-	(*QueryProposalRequest)(nil),      // 2: cosmos.gov.v1.QueryProposalRequest
-	(*QueryProposalResponse)(nil),     // 3: cosmos.gov.v1.QueryProposalResponse
-	(*QueryProposalsRequest)(nil),     // 4: cosmos.gov.v1.QueryProposalsRequest
-	(*QueryProposalsResponse)(nil),    // 5: cosmos.gov.v1.QueryProposalsResponse
-	(*QueryVoteRequest)(nil),          // 6: cosmos.gov.v1.QueryVoteRequest
-	(*QueryVoteResponse)(nil),         // 7: cosmos.gov.v1.QueryVoteResponse
-	(*QueryVotesRequest)(nil),         // 8: cosmos.gov.v1.QueryVotesRequest
-	(*QueryVotesResponse)(nil),        // 9: cosmos.gov.v1.QueryVotesResponse
-	(*QueryParamsRequest)(nil),        // 10: cosmos.gov.v1.QueryParamsRequest
-	(*QueryParamsResponse)(nil),       // 11: cosmos.gov.v1.QueryParamsResponse
-	(*QueryDepositRequest)(nil),       // 12: cosmos.gov.v1.QueryDepositRequest
-	(*QueryDepositResponse)(nil),      // 13: cosmos.gov.v1.QueryDepositResponse
-	(*QueryDepositsRequest)(nil),      // 14: cosmos.gov.v1.QueryDepositsRequest
-	(*QueryDepositsResponse)(nil),     // 15: cosmos.gov.v1.QueryDepositsResponse
-	(*QueryTallyResultRequest)(nil),   // 16: cosmos.gov.v1.QueryTallyResultRequest
-	(*QueryTallyResultResponse)(nil),  // 17: cosmos.gov.v1.QueryTallyResultResponse
-	(*Proposal)(nil),                  // 18: cosmos.gov.v1.Proposal
-	(ProposalStatus)(0),               // 19: cosmos.gov.v1.ProposalStatus
-	(*v1beta1.PageRequest)(nil),       // 20: cosmos.base.query.v1beta1.PageRequest
-	(*v1beta1.PageResponse)(nil),      // 21: cosmos.base.query.v1beta1.PageResponse
-	(*Vote)(nil),                      // 22: cosmos.gov.v1.Vote
-	(*VotingParams)(nil),              // 23: cosmos.gov.v1.VotingParams
-	(*DepositParams)(nil),             // 24: cosmos.gov.v1.DepositParams
-	(*TallyParams)(nil),               // 25: cosmos.gov.v1.TallyParams
-	(*Params)(nil),                    // 26: cosmos.gov.v1.Params
-	(*Deposit)(nil),                   // 27: cosmos.gov.v1.Deposit
-	(*TallyResult)(nil),               // 28: cosmos.gov.v1.TallyResult
+	(*QueryConstitutionRequest)(nil),                   // 0: cosmos.gov.v1.QueryConstitutionRequest
+	(*QueryConstitutionResponse)(nil),                  // 1: cosmos.gov.v1.QueryConstitutionResponse
+	(*QueryConstitutionAmendmentsRequest)(nil),         // 2: cosmos.gov.v1.QueryConstitutionAmendmentsRequest
+	(*QueryConstitutionAmendmentsResponse)(nil),        // 3: cosmos.gov.v1.QueryConstitutionAmendmentsResponse
+	(*QueryProposalRequest)(nil),                       // 4: cosmos.gov.v1.QueryProposalRequest
+	(*QueryProposalResponse)(nil),                      // 5: cosmos.gov.v1.QueryProposalResponse
+	(*QueryProposalsRequest)(nil),                      // 6: cosmos.gov.v1.QueryProposalsRequest
+	(*QueryProposalsResponse)(nil),                     // 7: cosmos.gov.v1.QueryProposalsResponse
+	(*QueryVoteRequest)(nil),                           // 8: cosmos.gov.v1.QueryVoteRequest
+	(*QueryVoteResponse)(nil),                          // 9: cosmos.gov.v1.QueryVoteResponse
+	(*QueryVoteCommitmentRequest)(nil),                 // 10: cosmos.gov.v1.QueryVoteCommitmentRequest
+	(*QueryVoteCommitmentResponse)(nil),                // 11: cosmos.gov.v1.QueryVoteCommitmentResponse
+	(*QueryExecutionReceiptRequest)(nil),               // 12: cosmos.gov.v1.QueryExecutionReceiptRequest
+	(*QueryExecutionReceiptResponse)(nil),              // 13: cosmos.gov.v1.QueryExecutionReceiptResponse
+	(*QueryVotesRequest)(nil),                          // 14: cosmos.gov.v1.QueryVotesRequest
+	(*QueryVotesResponse)(nil),                         // 15: cosmos.gov.v1.QueryVotesResponse
+	(*QueryParamsRequest)(nil),                         // 16: cosmos.gov.v1.QueryParamsRequest
+	(*QueryParamsResponse)(nil),                        // 17: cosmos.gov.v1.QueryParamsResponse
+	(*QueryDepositRequest)(nil),                        // 18: cosmos.gov.v1.QueryDepositRequest
+	(*QueryDepositResponse)(nil),                       // 19: cosmos.gov.v1.QueryDepositResponse
+	(*QueryDepositsRequest)(nil),                       // 20: cosmos.gov.v1.QueryDepositsRequest
+	(*QueryDepositsResponse)(nil),                      // 21: cosmos.gov.v1.QueryDepositsResponse
+	(*QueryTallyResultRequest)(nil),                    // 22: cosmos.gov.v1.QueryTallyResultRequest
+	(*QueryTallyResultResponse)(nil),                   // 23: cosmos.gov.v1.QueryTallyResultResponse
+	(*QueryValidatorVoteBreakdownsRequest)(nil),        // 24: cosmos.gov.v1.QueryValidatorVoteBreakdownsRequest
+	(*QueryValidatorVoteBreakdownsResponse)(nil),       // 25: cosmos.gov.v1.QueryValidatorVoteBreakdownsResponse
+	(*QueryParticipationExemptValidatorsRequest)(nil),  // 26: cosmos.gov.v1.QueryParticipationExemptValidatorsRequest
+	(*QueryParticipationExemptValidatorsResponse)(nil), // 27: cosmos.gov.v1.QueryParticipationExemptValidatorsResponse
+	(*QuerySimulateProposalRequest)(nil),               // 28: cosmos.gov.v1.QuerySimulateProposalRequest
+	(*QuerySimulateProposalResponse)(nil),              // 29: cosmos.gov.v1.QuerySimulateProposalResponse
+	(*QueryMetadataSchemaRequest)(nil),                 // 30: cosmos.gov.v1.QueryMetadataSchemaRequest
+	(*QueryMetadataSchemaResponse)(nil),                // 31: cosmos.gov.v1.QueryMetadataSchemaResponse
+	(*QueryMetadataSchemasRequest)(nil),                // 32: cosmos.gov.v1.QueryMetadataSchemasRequest
+	(*QueryMetadataSchemasResponse)(nil),               // 33: cosmos.gov.v1.QueryMetadataSchemasResponse
+	(*v1beta1.PageRequest)(nil),                        // 34: cosmos.base.query.v1beta1.PageRequest
+	(*ConstitutionAmendment)(nil),                      // 35: cosmos.gov.v1.ConstitutionAmendment
+	(*v1beta1.PageResponse)(nil),                       // 36: cosmos.base.query.v1beta1.PageResponse
+	(*Proposal)(nil),                                   // 37: cosmos.gov.v1.Proposal
+	(ProposalStatus)(0),                                // 38: cosmos.gov.v1.ProposalStatus
+	(*Vote)(nil),                                       // 39: cosmos.gov.v1.Vote
+	(*VoteCommitment)(nil),                             // 40: cosmos.gov.v1.VoteCommitment
+	(*ProposalExecutionReceipt)(nil),                   // 41: cosmos.gov.v1.ProposalExecutionReceipt
+	(*VotingParams)(nil),                               // 42: cosmos.gov.v1.VotingParams
+	(*DepositParams)(nil),                              // 43: cosmos.gov.v1.DepositParams
+	(*TallyParams)(nil),                                // 44: cosmos.gov.v1.TallyParams
+	(*Params)(nil),                                     // 45: cosmos.gov.v1.Params
+	(*Deposit)(nil),                                    // 46: cosmos.gov.v1.Deposit
+	(*TallyResult)(nil),                                // 47: cosmos.gov.v1.TallyResult
+	(*ValidatorVoteBreakdown)(nil),                     // 48: cosmos.gov.v1.ValidatorVoteBreakdown
+	(*anypb.Any)(nil),                                  // 49: google.protobuf.Any
+	(*abci.Event)(nil),                                 // 50: tendermint.abci.Event
+	(*ProposalExecutionFailure)(nil),                   // 51: cosmos.gov.v1.ProposalExecutionFailure
+	(*MetadataSchema)(nil),                             // 52: cosmos.gov.v1.MetadataSchema
 }
 var file_cosmos_gov_v1_query_proto_depIdxs = []int32{
-	18, // 0: cosmos.gov.v1.QueryProposalResponse.proposal:type_name -> cosmos.gov.v1.Proposal
-	19, // 1: cosmos.gov.v1.QueryProposalsRequest.proposal_status:type_name -> cosmos.gov.v1.ProposalStatus
-	20, // 2: cosmos.gov.v1.QueryProposalsRequest.pagination:type_name -> cosmos.base.query.v1beta1.PageRequest
-	18, // 3: cosmos.gov.v1.QueryProposalsResponse.proposals:type_name -> cosmos.gov.v1.Proposal
-	21, // 4: cosmos.gov.v1.QueryProposalsResponse.pagination:type_name -> cosmos.base.query.v1beta1.PageResponse
-	22, // 5: cosmos.gov.v1.QueryVoteResponse.vote:type_name -> cosmos.gov.v1.Vote
-	20, // 6: cosmos.gov.v1.QueryVotesRequest.pagination:type_name -> cosmos.base.query.v1beta1.PageRequest
-	22, // 7: cosmos.gov.v1.QueryVotesResponse.votes:type_name -> cosmos.gov.v1.Vote
-	21, // 8: cosmos.gov.v1.QueryVotesResponse.pagination:type_name -> cosmos.base.query.v1beta1.PageResponse
-	23, // 9: cosmos.gov.v1.QueryParamsResponse.voting_params:type_name -> cosmos.gov.v1.VotingParams
-	24, // 10: cosmos.gov.v1.QueryParamsResponse.deposit_params:type_name -> cosmos.gov.v1.DepositParams
-	25, // 11: cosmos.gov.v1.QueryParamsResponse.tally_params:type_name -> cosmos.gov.v1.TallyParams
-	26, // 12: cosmos.gov.v1.QueryParamsResponse.params:type_name -> cosmos.gov.v1.Params
-	27, // 13: cosmos.gov.v1.QueryDepositResponse.deposit:type_name -> cosmos.gov.v1.Deposit
-	20, // 14: cosmos.gov.v1.QueryDepositsRequest.pagination:type_name -> cosmos.base.query.v1beta1.PageRequest
-	27, // 15: cosmos.gov.v1.QueryDepositsResponse.deposits:type_name -> cosmos.gov.v1.Deposit
-	21, // 16: cosmos.gov.v1.QueryDepositsResponse.pagination:type_name -> cosmos.base.query.v1beta1.PageResponse
-	28, // 17: cosmos.gov.v1.QueryTallyResultResponse.tally:type_name -> cosmos.gov.v1.TallyResult
-	0,  // 18: cosmos.gov.v1.Query.Constitution:input_type -> cosmos.gov.v1.QueryConstitutionRequest
-	2,  // 19: cosmos.gov.v1.Query.Proposal:input_type -> cosmos.gov.v1.QueryProposalRequest
-	4,  // 20: cosmos.gov.v1.Query.Proposals:input_type -> cosmos.gov.v1.QueryProposalsRequest
-	6,  // 21: cosmos.gov.v1.Query.Vote:input_type -> cosmos.gov.v1.QueryVoteRequest
-	8,  // 22: cosmos.gov.v1.Query.Votes:input_type -> cosmos.gov.v1.QueryVotesRequest
-	10, // 23: cosmos.gov.v1.Query.Params:input_type -> cosmos.gov.v1.QueryParamsRequest
-	12, // 24: cosmos.gov.v1.Query.Deposit:input_type -> cosmos.gov.v1.QueryDepositRequest
-	14, // 25: cosmos.gov.v1.Query.Deposits:input_type -> cosmos.gov.v1.QueryDepositsRequest
-	16, // 26: cosmos.gov.v1.Query.TallyResult:input_type -> cosmos.gov.v1.QueryTallyResultRequest
-	1,  // 27: cosmos.gov.v1.Query.Constitution:output_type -> cosmos.gov.v1.QueryConstitutionResponse
-	3,  // 28: cosmos.gov.v1.Query.Proposal:output_type -> cosmos.gov.v1.QueryProposalResponse
-	5,  // 29: cosmos.gov.v1.Query.Proposals:output_type -> cosmos.gov.v1.QueryProposalsResponse
-	7,  // 30: cosmos.gov.v1.Query.Vote:output_type -> cosmos.gov.v1.QueryVoteResponse
-	9,  // 31: cosmos.gov.v1.Query.Votes:output_type -> cosmos.gov.v1.QueryVotesResponse
-	11, // 32: cosmos.gov.v1.Query.Params:output_type -> cosmos.gov.v1.QueryParamsResponse
-	13, // 33: cosmos.gov.v1.Query.Deposit:output_type -> cosmos.gov.v1.QueryDepositResponse
-	15, // 34: cosmos.gov.v1.Query.Deposits:output_type -> cosmos.gov.v1.QueryDepositsResponse
-	17, // 35: cosmos.gov.v1.Query.TallyResult:output_type -> cosmos.gov.v1.QueryTallyResultResponse
-	27, // [27:36] is the sub-list for method output_type
-	18, // [18:27] is the sub-list for method input_type
-	18, // [18:18] is the sub-list for extension type_name
-	18, // [18:18] is the sub-list for extension extendee
-	0,  // [0:18] is the sub-list for field type_name
+	34, // 0: cosmos.gov.v1.QueryConstitutionAmendmentsRequest.pagination:type_name -> cosmos.base.query.v1beta1.PageRequest
+	35, // 1: cosmos.gov.v1.QueryConstitutionAmendmentsResponse.amendments:type_name -> cosmos.gov.v1.ConstitutionAmendment
+	36, // 2: cosmos.gov.v1.QueryConstitutionAmendmentsResponse.pagination:type_name -> cosmos.base.query.v1beta1.PageResponse
+	37, // 3: cosmos.gov.v1.QueryProposalResponse.proposal:type_name -> cosmos.gov.v1.Proposal
+	38, // 4: cosmos.gov.v1.QueryProposalsRequest.proposal_status:type_name -> cosmos.gov.v1.ProposalStatus
+	34, // 5: cosmos.gov.v1.QueryProposalsRequest.pagination:type_name -> cosmos.base.query.v1beta1.PageRequest
+	37, // 6: cosmos.gov.v1.QueryProposalsResponse.proposals:type_name -> cosmos.gov.v1.Proposal
+	36, // 7: cosmos.gov.v1.QueryProposalsResponse.pagination:type_name -> cosmos.base.query.v1beta1.PageResponse
+	39, // 8: cosmos.gov.v1.QueryVoteResponse.vote:type_name -> cosmos.gov.v1.Vote
+	40, // 9: cosmos.gov.v1.QueryVoteCommitmentResponse.commitment:type_name -> cosmos.gov.v1.VoteCommitment
+	41, // 10: cosmos.gov.v1.QueryExecutionReceiptResponse.receipt:type_name -> cosmos.gov.v1.ProposalExecutionReceipt
+	34, // 11: cosmos.gov.v1.QueryVotesRequest.pagination:type_name -> cosmos.base.query.v1beta1.PageRequest
+	39, // 12: cosmos.gov.v1.QueryVotesResponse.votes:type_name -> cosmos.gov.v1.Vote
+	36, // 13: cosmos.gov.v1.QueryVotesResponse.pagination:type_name -> cosmos.base.query.v1beta1.PageResponse
+	42, // 14: cosmos.gov.v1.QueryParamsResponse.voting_params:type_name -> cosmos.gov.v1.VotingParams
+	43, // 15: cosmos.gov.v1.QueryParamsResponse.deposit_params:type_name -> cosmos.gov.v1.DepositParams
+	44, // 16: cosmos.gov.v1.QueryParamsResponse.tally_params:type_name -> cosmos.gov.v1.TallyParams
+	45, // 17: cosmos.gov.v1.QueryParamsResponse.params:type_name -> cosmos.gov.v1.Params
+	46, // 18: cosmos.gov.v1.QueryDepositResponse.deposit:type_name -> cosmos.gov.v1.Deposit
+	34, // 19: cosmos.gov.v1.QueryDepositsRequest.pagination:type_name -> cosmos.base.query.v1beta1.PageRequest
+	46, // 20: cosmos.gov.v1.QueryDepositsResponse.deposits:type_name -> cosmos.gov.v1.Deposit
+	36, // 21: cosmos.gov.v1.QueryDepositsResponse.pagination:type_name -> cosmos.base.query.v1beta1.PageResponse
+	47, // 22: cosmos.gov.v1.QueryTallyResultResponse.tally:type_name -> cosmos.gov.v1.TallyResult
+	48, // 23: cosmos.gov.v1.QueryValidatorVoteBreakdownsResponse.breakdowns:type_name -> cosmos.gov.v1.ValidatorVoteBreakdown
+	49, // 24: cosmos.gov.v1.QuerySimulateProposalRequest.messages:type_name -> google.protobuf.Any
+	50, // 25: cosmos.gov.v1.QuerySimulateProposalResponse.events:type_name -> tendermint.abci.Event
+	51, // 26: cosmos.gov.v1.QuerySimulateProposalResponse.failure:type_name -> cosmos.gov.v1.ProposalExecutionFailure
+	52, // 27: cosmos.gov.v1.QueryMetadataSchemaResponse.schema:type_name -> cosmos.gov.v1.MetadataSchema
+	34, // 28: cosmos.gov.v1.QueryMetadataSchemasRequest.pagination:type_name -> cosmos.base.query.v1beta1.PageRequest
+	52, // 29: cosmos.gov.v1.QueryMetadataSchemasResponse.schemas:type_name -> cosmos.gov.v1.MetadataSchema
+	36, // 30: cosmos.gov.v1.QueryMetadataSchemasResponse.pagination:type_name -> cosmos.base.query.v1beta1.PageResponse
+	0,  // 31: cosmos.gov.v1.Query.Constitution:input_type -> cosmos.gov.v1.QueryConstitutionRequest
+	2,  // 32: cosmos.gov.v1.Query.ConstitutionAmendments:input_type -> cosmos.gov.v1.QueryConstitutionAmendmentsRequest
+	4,  // 33: cosmos.gov.v1.Query.Proposal:input_type -> cosmos.gov.v1.QueryProposalRequest
+	6,  // 34: cosmos.gov.v1.Query.Proposals:input_type -> cosmos.gov.v1.QueryProposalsRequest
+	8,  // 35: cosmos.gov.v1.Query.Vote:input_type -> cosmos.gov.v1.QueryVoteRequest
+	10, // 36: cosmos.gov.v1.Query.VoteCommitment:input_type -> cosmos.gov.v1.QueryVoteCommitmentRequest
+	12, // 37: cosmos.gov.v1.Query.ExecutionReceipt:input_type -> cosmos.gov.v1.QueryExecutionReceiptRequest
+	14, // 38: cosmos.gov.v1.Query.Votes:input_type -> cosmos.gov.v1.QueryVotesRequest
+	16, // 39: cosmos.gov.v1.Query.Params:input_type -> cosmos.gov.v1.QueryParamsRequest
+	18, // 40: cosmos.gov.v1.Query.Deposit:input_type -> cosmos.gov.v1.QueryDepositRequest
+	20, // 41: cosmos.gov.v1.Query.Deposits:input_type -> cosmos.gov.v1.QueryDepositsRequest
+	22, // 42: cosmos.gov.v1.Query.TallyResult:input_type -> cosmos.gov.v1.QueryTallyResultRequest
+	24, // 43: cosmos.gov.v1.Query.ValidatorVoteBreakdowns:input_type -> cosmos.gov.v1.QueryValidatorVoteBreakdownsRequest
+	26, // 44: cosmos.gov.v1.Query.ParticipationExemptValidators:input_type -> cosmos.gov.v1.QueryParticipationExemptValidatorsRequest
+	28, // 45: cosmos.gov.v1.Query.SimulateProposal:input_type -> cosmos.gov.v1.QuerySimulateProposalRequest
+	30, // 46: cosmos.gov.v1.Query.MetadataSchema:input_type -> cosmos.gov.v1.QueryMetadataSchemaRequest
+	32, // 47: cosmos.gov.v1.Query.MetadataSchemas:input_type -> cosmos.gov.v1.QueryMetadataSchemasRequest
+	1,  // 48: cosmos.gov.v1.Query.Constitution:output_type -> cosmos.gov.v1.QueryConstitutionResponse
+	3,  // 49: cosmos.gov.v1.Query.ConstitutionAmendments:output_type -> cosmos.gov.v1.QueryConstitutionAmendmentsResponse
+	5,  // 50: cosmos.gov.v1.Query.Proposal:output_type -> cosmos.gov.v1.QueryProposalResponse
+	7,  // 51: cosmos.gov.v1.Query.Proposals:output_type -> cosmos.gov.v1.QueryProposalsResponse
+	9,  // 52: cosmos.gov.v1.Query.Vote:output_type -> cosmos.gov.v1.QueryVoteResponse
+	11, // 53: cosmos.gov.v1.Query.VoteCommitment:output_type -> cosmos.gov.v1.QueryVoteCommitmentResponse
+	13, // 54: cosmos.gov.v1.Query.ExecutionReceipt:output_type -> cosmos.gov.v1.QueryExecutionReceiptResponse
+	15, // 55: cosmos.gov.v1.Query.Votes:output_type -> cosmos.gov.v1.QueryVotesResponse
+	17, // 56: cosmos.gov.v1.Query.Params:output_type -> cosmos.gov.v1.QueryParamsResponse
+	19, // 57: cosmos.gov.v1.Query.Deposit:output_type -> cosmos.gov.v1.QueryDepositResponse
+	21, // 58: cosmos.gov.v1.Query.Deposits:output_type -> cosmos.gov.v1.QueryDepositsResponse
+	23, // 59: cosmos.gov.v1.Query.TallyResult:output_type -> cosmos.gov.v1.QueryTallyResultResponse
+	25, // 60: cosmos.gov.v1.Query.ValidatorVoteBreakdowns:output_type -> cosmos.gov.v1.QueryValidatorVoteBreakdownsResponse
+	27, // 61: cosmos.gov.v1.Query.ParticipationExemptValidators:output_type -> cosmos.gov.v1.QueryParticipationExemptValidatorsResponse
+	29, // 62: cosmos.gov.v1.Query.SimulateProposal:output_type -> cosmos.gov.v1.QuerySimulateProposalResponse
+	31, // 63: cosmos.gov.v1.Query.MetadataSchema:output_type -> cosmos.gov.v1.QueryMetadataSchemaResponse
+	33, // 64: cosmos.gov.v1.Query.MetadataSchemas:output_type -> cosmos.gov.v1.QueryMetadataSchemasResponse
+	48, // [48:65] is the sub-list for method output_type
+	31, // [31:48] is the sub-list for method input_type
+	31, // [31:31] is the sub-list for extension type_name
+	31, // [31:31] is the sub-list for extension extendee
+	0,  // [0:31] is the sub-list for field type_name
 }
 
 func init() { file_cosmos_gov_v1_query_proto_init() }
@@ -9817,7 +18342,7 @@ func file_cosmos_gov_v1_query_proto_init() {
 			}
 		}
 		file_cosmos_gov_v1_query_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*QueryProposalRequest); i {
+			switch v := v.(*QueryConstitutionAmendmentsRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -9829,7 +18354,7 @@ func file_cosmos_gov_v1_query_proto_init() {
 			}
 		}
 		file_cosmos_gov_v1_query_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*QueryProposalResponse); i {
+			switch v := v.(*QueryConstitutionAmendmentsResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -9841,7 +18366,7 @@ func file_cosmos_gov_v1_query_proto_init() {
 			}
 		}
 		file_cosmos_gov_v1_query_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*QueryProposalsRequest); i {
+			switch v := v.(*QueryProposalRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -9853,7 +18378,7 @@ func file_cosmos_gov_v1_query_proto_init() {
 			}
 		}
 		file_cosmos_gov_v1_query_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*QueryProposalsResponse); i {
+			switch v := v.(*QueryProposalResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -9865,7 +18390,7 @@ func file_cosmos_gov_v1_query_proto_init() {
 			}
 		}
 		file_cosmos_gov_v1_query_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*QueryVoteRequest); i {
+			switch v := v.(*QueryProposalsRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -9877,7 +18402,7 @@ func file_cosmos_gov_v1_query_proto_init() {
 			}
 		}
 		file_cosmos_gov_v1_query_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*QueryVoteResponse); i {
+			switch v := v.(*QueryProposalsResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -9889,7 +18414,7 @@ func file_cosmos_gov_v1_query_proto_init() {
 			}
 		}
 		file_cosmos_gov_v1_query_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*QueryVotesRequest); i {
+			switch v := v.(*QueryVoteRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -9901,7 +18426,7 @@ func file_cosmos_gov_v1_query_proto_init() {
 			}
 		}
 		file_cosmos_gov_v1_query_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*QueryVotesResponse); i {
+			switch v := v.(*QueryVoteResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -9913,7 +18438,7 @@ func file_cosmos_gov_v1_query_proto_init() {
 			}
 		}
 		file_cosmos_gov_v1_query_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*QueryParamsRequest); i {
+			switch v := v.(*QueryVoteCommitmentRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -9925,7 +18450,7 @@ func file_cosmos_gov_v1_query_proto_init() {
 			}
 		}
 		file_cosmos_gov_v1_query_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*QueryParamsResponse); i {
+			switch v := v.(*QueryVoteCommitmentResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -9937,7 +18462,7 @@ func file_cosmos_gov_v1_query_proto_init() {
 			}
 		}
 		file_cosmos_gov_v1_query_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*QueryDepositRequest); i {
+			switch v := v.(*QueryExecutionReceiptRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -9949,7 +18474,7 @@ func file_cosmos_gov_v1_query_proto_init() {
 			}
 		}
 		file_cosmos_gov_v1_query_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*QueryDepositResponse); i {
+			switch v := v.(*QueryExecutionReceiptResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -9961,7 +18486,7 @@ func file_cosmos_gov_v1_query_proto_init() {
 			}
 		}
 		file_cosmos_gov_v1_query_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*QueryDepositsRequest); i {
+			switch v := v.(*QueryVotesRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -9973,7 +18498,7 @@ func file_cosmos_gov_v1_query_proto_init() {
 			}
 		}
 		file_cosmos_gov_v1_query_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*QueryDepositsResponse); i {
+			switch v := v.(*QueryVotesResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -9985,7 +18510,7 @@ func file_cosmos_gov_v1_query_proto_init() {
 			}
 		}
 		file_cosmos_gov_v1_query_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*QueryTallyResultRequest); i {
+			switch v := v.(*QueryParamsRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -9997,6 +18522,78 @@ func file_cosmos_gov_v1_query_proto_init() {
 			}
 		}
 		file_cosmos_gov_v1_query_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*QueryParamsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_cosmos_gov_v1_query_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*QueryDepositRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_cosmos_gov_v1_query_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*QueryDepositResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_cosmos_gov_v1_query_proto_msgTypes[20].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*QueryDepositsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_cosmos_gov_v1_query_proto_msgTypes[21].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*QueryDepositsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_cosmos_gov_v1_query_proto_msgTypes[22].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*QueryTallyResultRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_cosmos_gov_v1_query_proto_msgTypes[23].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*QueryTallyResultResponse); i {
 			case 0:
 				return &v.state
@@ -10008,6 +18605,126 @@ func file_cosmos_gov_v1_query_proto_init() {
 				return nil
 			}
 		}
+		file_cosmos_gov_v1_query_proto_msgTypes[24].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*QueryValidatorVoteBreakdownsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_cosmos_gov_v1_query_proto_msgTypes[25].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*QueryValidatorVoteBreakdownsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_cosmos_gov_v1_query_proto_msgTypes[26].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*QueryParticipationExemptValidatorsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_cosmos_gov_v1_query_proto_msgTypes[27].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*QueryParticipationExemptValidatorsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_cosmos_gov_v1_query_proto_msgTypes[28].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*QuerySimulateProposalRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_cosmos_gov_v1_query_proto_msgTypes[29].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*QuerySimulateProposalResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_cosmos_gov_v1_query_proto_msgTypes[30].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*QueryMetadataSchemaRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_cosmos_gov_v1_query_proto_msgTypes[31].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*QueryMetadataSchemaResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_cosmos_gov_v1_query_proto_msgTypes[32].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*QueryMetadataSchemasRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_cosmos_gov_v1_query_proto_msgTypes[33].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*QueryMetadataSchemasResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
 	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
@@ -10015,7 +18732,7 @@ func file_cosmos_gov_v1_query_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_cosmos_gov_v1_query_proto_rawDesc,
 			NumEnums:      0,
-			NumMessages:   18,
+			NumMessages:   34,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
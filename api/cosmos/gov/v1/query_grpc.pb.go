@@ -21,15 +21,23 @@ import (
 const _ = grpc.SupportPackageIsVersion7
 
 const (
-	Query_Constitution_FullMethodName = "/cosmos.gov.v1.Query/Constitution"
-	Query_Proposal_FullMethodName     = "/cosmos.gov.v1.Query/Proposal"
-	Query_Proposals_FullMethodName    = "/cosmos.gov.v1.Query/Proposals"
-	Query_Vote_FullMethodName         = "/cosmos.gov.v1.Query/Vote"
-	Query_Votes_FullMethodName        = "/cosmos.gov.v1.Query/Votes"
-	Query_Params_FullMethodName       = "/cosmos.gov.v1.Query/Params"
-	Query_Deposit_FullMethodName      = "/cosmos.gov.v1.Query/Deposit"
-	Query_Deposits_FullMethodName     = "/cosmos.gov.v1.Query/Deposits"
-	Query_TallyResult_FullMethodName  = "/cosmos.gov.v1.Query/TallyResult"
+	Query_Constitution_FullMethodName                  = "/cosmos.gov.v1.Query/Constitution"
+	Query_ConstitutionAmendments_FullMethodName        = "/cosmos.gov.v1.Query/ConstitutionAmendments"
+	Query_Proposal_FullMethodName                      = "/cosmos.gov.v1.Query/Proposal"
+	Query_Proposals_FullMethodName                     = "/cosmos.gov.v1.Query/Proposals"
+	Query_Vote_FullMethodName                          = "/cosmos.gov.v1.Query/Vote"
+	Query_VoteCommitment_FullMethodName                = "/cosmos.gov.v1.Query/VoteCommitment"
+	Query_ExecutionReceipt_FullMethodName              = "/cosmos.gov.v1.Query/ExecutionReceipt"
+	Query_Votes_FullMethodName                         = "/cosmos.gov.v1.Query/Votes"
+	Query_Params_FullMethodName                        = "/cosmos.gov.v1.Query/Params"
+	Query_Deposit_FullMethodName                       = "/cosmos.gov.v1.Query/Deposit"
+	Query_Deposits_FullMethodName                      = "/cosmos.gov.v1.Query/Deposits"
+	Query_TallyResult_FullMethodName                   = "/cosmos.gov.v1.Query/TallyResult"
+	Query_ValidatorVoteBreakdowns_FullMethodName       = "/cosmos.gov.v1.Query/ValidatorVoteBreakdowns"
+	Query_ParticipationExemptValidators_FullMethodName = "/cosmos.gov.v1.Query/ParticipationExemptValidators"
+	Query_SimulateProposal_FullMethodName              = "/cosmos.gov.v1.Query/SimulateProposal"
+	Query_MetadataSchema_FullMethodName                = "/cosmos.gov.v1.Query/MetadataSchema"
+	Query_MetadataSchemas_FullMethodName               = "/cosmos.gov.v1.Query/MetadataSchemas"
 )
 
 // QueryClient is the client API for Query service.
@@ -38,12 +46,26 @@ const (
 type QueryClient interface {
 	// Constitution queries the chain's constitution.
 	Constitution(ctx context.Context, in *QueryConstitutionRequest, opts ...grpc.CallOption) (*QueryConstitutionResponse, error)
+	// ConstitutionAmendments queries the amendments made to the chain's
+	// constitution, in the order they were made.
+	//
+	// Since: cosmos-sdk 0.48
+	ConstitutionAmendments(ctx context.Context, in *QueryConstitutionAmendmentsRequest, opts ...grpc.CallOption) (*QueryConstitutionAmendmentsResponse, error)
 	// Proposal queries proposal details based on ProposalID.
 	Proposal(ctx context.Context, in *QueryProposalRequest, opts ...grpc.CallOption) (*QueryProposalResponse, error)
 	// Proposals queries all proposals based on given status.
 	Proposals(ctx context.Context, in *QueryProposalsRequest, opts ...grpc.CallOption) (*QueryProposalsResponse, error)
 	// Vote queries voted information based on proposalID, voterAddr.
 	Vote(ctx context.Context, in *QueryVoteRequest, opts ...grpc.CallOption) (*QueryVoteResponse, error)
+	// VoteCommitment queries the unrevealed vote commitment of a voter on a
+	// commit-reveal proposal.
+	//
+	// Since: cosmos-sdk 0.48
+	VoteCommitment(ctx context.Context, in *QueryVoteCommitmentRequest, opts ...grpc.CallOption) (*QueryVoteCommitmentResponse, error)
+	// ExecutionReceipt queries the execution receipt of a passed proposal.
+	//
+	// Since: cosmos-sdk 0.48
+	ExecutionReceipt(ctx context.Context, in *QueryExecutionReceiptRequest, opts ...grpc.CallOption) (*QueryExecutionReceiptResponse, error)
 	// Votes queries votes of a given proposal.
 	Votes(ctx context.Context, in *QueryVotesRequest, opts ...grpc.CallOption) (*QueryVotesResponse, error)
 	// Params queries all parameters of the gov module.
@@ -54,6 +76,31 @@ type QueryClient interface {
 	Deposits(ctx context.Context, in *QueryDepositsRequest, opts ...grpc.CallOption) (*QueryDepositsResponse, error)
 	// TallyResult queries the tally of a proposal vote.
 	TallyResult(ctx context.Context, in *QueryTallyResultRequest, opts ...grpc.CallOption) (*QueryTallyResultResponse, error)
+	// ValidatorVoteBreakdowns queries, for a proposal in voting period, how much
+	// of each bonded validator's voting power is inherited by its delegations
+	// versus overridden by delegators voting themselves.
+	ValidatorVoteBreakdowns(ctx context.Context, in *QueryValidatorVoteBreakdownsRequest, opts ...grpc.CallOption) (*QueryValidatorVoteBreakdownsResponse, error)
+	// ParticipationExemptValidators queries the bonded validators whose voting
+	// power is currently below the participation exemption threshold.
+	//
+	// Since: cosmos-sdk 0.48
+	ParticipationExemptValidators(ctx context.Context, in *QueryParticipationExemptValidatorsRequest, opts ...grpc.CallOption) (*QueryParticipationExemptValidatorsResponse, error)
+	// SimulateProposal executes the messages of a candidate proposal on behalf of
+	// its execution authority against a branch of the current state, without
+	// committing it, and returns the gas used, the emitted events and the
+	// execution failure, if any.
+	//
+	// Since: cosmos-sdk 0.48
+	SimulateProposal(ctx context.Context, in *QuerySimulateProposalRequest, opts ...grpc.CallOption) (*QuerySimulateProposalResponse, error)
+	// MetadataSchema queries a registered metadata schema by its id.
+	//
+	// Since: cosmos-sdk 0.48
+	MetadataSchema(ctx context.Context, in *QueryMetadataSchemaRequest, opts ...grpc.CallOption) (*QueryMetadataSchemaResponse, error)
+	// MetadataSchemas queries the registered metadata schemas, in the order they
+	// were registered.
+	//
+	// Since: cosmos-sdk 0.48
+	MetadataSchemas(ctx context.Context, in *QueryMetadataSchemasRequest, opts ...grpc.CallOption) (*QueryMetadataSchemasResponse, error)
 }
 
 type queryClient struct {
@@ -73,6 +120,15 @@ func (c *queryClient) Constitution(ctx context.Context, in *QueryConstitutionReq
 	return out, nil
 }
 
+func (c *queryClient) ConstitutionAmendments(ctx context.Context, in *QueryConstitutionAmendmentsRequest, opts ...grpc.CallOption) (*QueryConstitutionAmendmentsResponse, error) {
+	out := new(QueryConstitutionAmendmentsResponse)
+	err := c.cc.Invoke(ctx, Query_ConstitutionAmendments_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *queryClient) Proposal(ctx context.Context, in *QueryProposalRequest, opts ...grpc.CallOption) (*QueryProposalResponse, error) {
 	out := new(QueryProposalResponse)
 	err := c.cc.Invoke(ctx, Query_Proposal_FullMethodName, in, out, opts...)
@@ -100,6 +156,24 @@ func (c *queryClient) Vote(ctx context.Context, in *QueryVoteRequest, opts ...gr
 	return out, nil
 }
 
+func (c *queryClient) VoteCommitment(ctx context.Context, in *QueryVoteCommitmentRequest, opts ...grpc.CallOption) (*QueryVoteCommitmentResponse, error) {
+	out := new(QueryVoteCommitmentResponse)
+	err := c.cc.Invoke(ctx, Query_VoteCommitment_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) ExecutionReceipt(ctx context.Context, in *QueryExecutionReceiptRequest, opts ...grpc.CallOption) (*QueryExecutionReceiptResponse, error) {
+	out := new(QueryExecutionReceiptResponse)
+	err := c.cc.Invoke(ctx, Query_ExecutionReceipt_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *queryClient) Votes(ctx context.Context, in *QueryVotesRequest, opts ...grpc.CallOption) (*QueryVotesResponse, error) {
 	out := new(QueryVotesResponse)
 	err := c.cc.Invoke(ctx, Query_Votes_FullMethodName, in, out, opts...)
@@ -145,18 +219,77 @@ func (c *queryClient) TallyResult(ctx context.Context, in *QueryTallyResultReque
 	return out, nil
 }
 
+func (c *queryClient) ValidatorVoteBreakdowns(ctx context.Context, in *QueryValidatorVoteBreakdownsRequest, opts ...grpc.CallOption) (*QueryValidatorVoteBreakdownsResponse, error) {
+	out := new(QueryValidatorVoteBreakdownsResponse)
+	err := c.cc.Invoke(ctx, Query_ValidatorVoteBreakdowns_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) ParticipationExemptValidators(ctx context.Context, in *QueryParticipationExemptValidatorsRequest, opts ...grpc.CallOption) (*QueryParticipationExemptValidatorsResponse, error) {
+	out := new(QueryParticipationExemptValidatorsResponse)
+	err := c.cc.Invoke(ctx, Query_ParticipationExemptValidators_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) SimulateProposal(ctx context.Context, in *QuerySimulateProposalRequest, opts ...grpc.CallOption) (*QuerySimulateProposalResponse, error) {
+	out := new(QuerySimulateProposalResponse)
+	err := c.cc.Invoke(ctx, Query_SimulateProposal_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) MetadataSchema(ctx context.Context, in *QueryMetadataSchemaRequest, opts ...grpc.CallOption) (*QueryMetadataSchemaResponse, error) {
+	out := new(QueryMetadataSchemaResponse)
+	err := c.cc.Invoke(ctx, Query_MetadataSchema_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) MetadataSchemas(ctx context.Context, in *QueryMetadataSchemasRequest, opts ...grpc.CallOption) (*QueryMetadataSchemasResponse, error) {
+	out := new(QueryMetadataSchemasResponse)
+	err := c.cc.Invoke(ctx, Query_MetadataSchemas_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 // All implementations must embed UnimplementedQueryServer
 // for forward compatibility
 type QueryServer interface {
 	// Constitution queries the chain's constitution.
 	Constitution(context.Context, *QueryConstitutionRequest) (*QueryConstitutionResponse, error)
+	// ConstitutionAmendments queries the amendments made to the chain's
+	// constitution, in the order they were made.
+	//
+	// Since: cosmos-sdk 0.48
+	ConstitutionAmendments(context.Context, *QueryConstitutionAmendmentsRequest) (*QueryConstitutionAmendmentsResponse, error)
 	// Proposal queries proposal details based on ProposalID.
 	Proposal(context.Context, *QueryProposalRequest) (*QueryProposalResponse, error)
 	// Proposals queries all proposals based on given status.
 	Proposals(context.Context, *QueryProposalsRequest) (*QueryProposalsResponse, error)
 	// Vote queries voted information based on proposalID, voterAddr.
 	Vote(context.Context, *QueryVoteRequest) (*QueryVoteResponse, error)
+	// VoteCommitment queries the unrevealed vote commitment of a voter on a
+	// commit-reveal proposal.
+	//
+	// Since: cosmos-sdk 0.48
+	VoteCommitment(context.Context, *QueryVoteCommitmentRequest) (*QueryVoteCommitmentResponse, error)
+	// ExecutionReceipt queries the execution receipt of a passed proposal.
+	//
+	// Since: cosmos-sdk 0.48
+	ExecutionReceipt(context.Context, *QueryExecutionReceiptRequest) (*QueryExecutionReceiptResponse, error)
 	// Votes queries votes of a given proposal.
 	Votes(context.Context, *QueryVotesRequest) (*QueryVotesResponse, error)
 	// Params queries all parameters of the gov module.
@@ -167,6 +300,31 @@ type QueryServer interface {
 	Deposits(context.Context, *QueryDepositsRequest) (*QueryDepositsResponse, error)
 	// TallyResult queries the tally of a proposal vote.
 	TallyResult(context.Context, *QueryTallyResultRequest) (*QueryTallyResultResponse, error)
+	// ValidatorVoteBreakdowns queries, for a proposal in voting period, how much
+	// of each bonded validator's voting power is inherited by its delegations
+	// versus overridden by delegators voting themselves.
+	ValidatorVoteBreakdowns(context.Context, *QueryValidatorVoteBreakdownsRequest) (*QueryValidatorVoteBreakdownsResponse, error)
+	// ParticipationExemptValidators queries the bonded validators whose voting
+	// power is currently below the participation exemption threshold.
+	//
+	// Since: cosmos-sdk 0.48
+	ParticipationExemptValidators(context.Context, *QueryParticipationExemptValidatorsRequest) (*QueryParticipationExemptValidatorsResponse, error)
+	// SimulateProposal executes the messages of a candidate proposal on behalf of
+	// its execution authority against a branch of the current state, without
+	// committing it, and returns the gas used, the emitted events and the
+	// execution failure, if any.
+	//
+	// Since: cosmos-sdk 0.48
+	SimulateProposal(context.Context, *QuerySimulateProposalRequest) (*QuerySimulateProposalResponse, error)
+	// MetadataSchema queries a registered metadata schema by its id.
+	//
+	// Since: cosmos-sdk 0.48
+	MetadataSchema(context.Context, *QueryMetadataSchemaRequest) (*QueryMetadataSchemaResponse, error)
+	// MetadataSchemas queries the registered metadata schemas, in the order they
+	// were registered.
+	//
+	// Since: cosmos-sdk 0.48
+	MetadataSchemas(context.Context, *QueryMetadataSchemasRequest) (*QueryMetadataSchemasResponse, error)
 	mustEmbedUnimplementedQueryServer()
 }
 
@@ -177,6 +335,9 @@ type UnimplementedQueryServer struct {
 func (UnimplementedQueryServer) Constitution(context.Context, *QueryConstitutionRequest) (*QueryConstitutionResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Constitution not implemented")
 }
+func (UnimplementedQueryServer) ConstitutionAmendments(context.Context, *QueryConstitutionAmendmentsRequest) (*QueryConstitutionAmendmentsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ConstitutionAmendments not implemented")
+}
 func (UnimplementedQueryServer) Proposal(context.Context, *QueryProposalRequest) (*QueryProposalResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Proposal not implemented")
 }
@@ -186,6 +347,12 @@ func (UnimplementedQueryServer) Proposals(context.Context, *QueryProposalsReques
 func (UnimplementedQueryServer) Vote(context.Context, *QueryVoteRequest) (*QueryVoteResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Vote not implemented")
 }
+func (UnimplementedQueryServer) VoteCommitment(context.Context, *QueryVoteCommitmentRequest) (*QueryVoteCommitmentResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method VoteCommitment not implemented")
+}
+func (UnimplementedQueryServer) ExecutionReceipt(context.Context, *QueryExecutionReceiptRequest) (*QueryExecutionReceiptResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ExecutionReceipt not implemented")
+}
 func (UnimplementedQueryServer) Votes(context.Context, *QueryVotesRequest) (*QueryVotesResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Votes not implemented")
 }
@@ -201,6 +368,21 @@ func (UnimplementedQueryServer) Deposits(context.Context, *QueryDepositsRequest)
 func (UnimplementedQueryServer) TallyResult(context.Context, *QueryTallyResultRequest) (*QueryTallyResultResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method TallyResult not implemented")
 }
+func (UnimplementedQueryServer) ValidatorVoteBreakdowns(context.Context, *QueryValidatorVoteBreakdownsRequest) (*QueryValidatorVoteBreakdownsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ValidatorVoteBreakdowns not implemented")
+}
+func (UnimplementedQueryServer) ParticipationExemptValidators(context.Context, *QueryParticipationExemptValidatorsRequest) (*QueryParticipationExemptValidatorsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ParticipationExemptValidators not implemented")
+}
+func (UnimplementedQueryServer) SimulateProposal(context.Context, *QuerySimulateProposalRequest) (*QuerySimulateProposalResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SimulateProposal not implemented")
+}
+func (UnimplementedQueryServer) MetadataSchema(context.Context, *QueryMetadataSchemaRequest) (*QueryMetadataSchemaResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MetadataSchema not implemented")
+}
+func (UnimplementedQueryServer) MetadataSchemas(context.Context, *QueryMetadataSchemasRequest) (*QueryMetadataSchemasResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MetadataSchemas not implemented")
+}
 func (UnimplementedQueryServer) mustEmbedUnimplementedQueryServer() {}
 
 // UnsafeQueryServer may be embedded to opt out of forward compatibility for this service.
@@ -232,6 +414,24 @@ func _Query_Constitution_Handler(srv interface{}, ctx context.Context, dec func(
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_ConstitutionAmendments_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryConstitutionAmendmentsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ConstitutionAmendments(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_ConstitutionAmendments_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ConstitutionAmendments(ctx, req.(*QueryConstitutionAmendmentsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Query_Proposal_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(QueryProposalRequest)
 	if err := dec(in); err != nil {
@@ -286,6 +486,42 @@ func _Query_Vote_Handler(srv interface{}, ctx context.Context, dec func(interfac
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_VoteCommitment_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryVoteCommitmentRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).VoteCommitment(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_VoteCommitment_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).VoteCommitment(ctx, req.(*QueryVoteCommitmentRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ExecutionReceipt_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryExecutionReceiptRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ExecutionReceipt(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_ExecutionReceipt_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ExecutionReceipt(ctx, req.(*QueryExecutionReceiptRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Query_Votes_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(QueryVotesRequest)
 	if err := dec(in); err != nil {
@@ -376,6 +612,96 @@ func _Query_TallyResult_Handler(srv interface{}, ctx context.Context, dec func(i
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_ValidatorVoteBreakdowns_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryValidatorVoteBreakdownsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ValidatorVoteBreakdowns(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_ValidatorVoteBreakdowns_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ValidatorVoteBreakdowns(ctx, req.(*QueryValidatorVoteBreakdownsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ParticipationExemptValidators_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryParticipationExemptValidatorsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ParticipationExemptValidators(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_ParticipationExemptValidators_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ParticipationExemptValidators(ctx, req.(*QueryParticipationExemptValidatorsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_SimulateProposal_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QuerySimulateProposalRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).SimulateProposal(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_SimulateProposal_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).SimulateProposal(ctx, req.(*QuerySimulateProposalRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_MetadataSchema_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryMetadataSchemaRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).MetadataSchema(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_MetadataSchema_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).MetadataSchema(ctx, req.(*QueryMetadataSchemaRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_MetadataSchemas_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryMetadataSchemasRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).MetadataSchemas(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_MetadataSchemas_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).MetadataSchemas(ctx, req.(*QueryMetadataSchemasRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // Query_ServiceDesc is the grpc.ServiceDesc for Query service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -387,6 +713,10 @@ var Query_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "Constitution",
 			Handler:    _Query_Constitution_Handler,
 		},
+		{
+			MethodName: "ConstitutionAmendments",
+			Handler:    _Query_ConstitutionAmendments_Handler,
+		},
 		{
 			MethodName: "Proposal",
 			Handler:    _Query_Proposal_Handler,
@@ -399,6 +729,14 @@ var Query_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "Vote",
 			Handler:    _Query_Vote_Handler,
 		},
+		{
+			MethodName: "VoteCommitment",
+			Handler:    _Query_VoteCommitment_Handler,
+		},
+		{
+			MethodName: "ExecutionReceipt",
+			Handler:    _Query_ExecutionReceipt_Handler,
+		},
 		{
 			MethodName: "Votes",
 			Handler:    _Query_Votes_Handler,
@@ -419,6 +757,26 @@ var Query_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "TallyResult",
 			Handler:    _Query_TallyResult_Handler,
 		},
+		{
+			MethodName: "ValidatorVoteBreakdowns",
+			Handler:    _Query_ValidatorVoteBreakdowns_Handler,
+		},
+		{
+			MethodName: "ParticipationExemptValidators",
+			Handler:    _Query_ParticipationExemptValidators_Handler,
+		},
+		{
+			MethodName: "SimulateProposal",
+			Handler:    _Query_SimulateProposal_Handler,
+		},
+		{
+			MethodName: "MetadataSchema",
+			Handler:    _Query_MetadataSchema_Handler,
+		},
+		{
+			MethodName: "MetadataSchemas",
+			Handler:    _Query_MetadataSchemas_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "cosmos/gov/v1/query.proto",
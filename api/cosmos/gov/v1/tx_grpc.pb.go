@@ -21,13 +21,22 @@ import (
 const _ = grpc.SupportPackageIsVersion7
 
 const (
-	Msg_SubmitProposal_FullMethodName    = "/cosmos.gov.v1.Msg/SubmitProposal"
-	Msg_ExecLegacyContent_FullMethodName = "/cosmos.gov.v1.Msg/ExecLegacyContent"
-	Msg_Vote_FullMethodName              = "/cosmos.gov.v1.Msg/Vote"
-	Msg_VoteWeighted_FullMethodName      = "/cosmos.gov.v1.Msg/VoteWeighted"
-	Msg_Deposit_FullMethodName           = "/cosmos.gov.v1.Msg/Deposit"
-	Msg_UpdateParams_FullMethodName      = "/cosmos.gov.v1.Msg/UpdateParams"
-	Msg_CancelProposal_FullMethodName    = "/cosmos.gov.v1.Msg/CancelProposal"
+	Msg_SubmitProposal_FullMethodName         = "/cosmos.gov.v1.Msg/SubmitProposal"
+	Msg_ExecLegacyContent_FullMethodName      = "/cosmos.gov.v1.Msg/ExecLegacyContent"
+	Msg_Vote_FullMethodName                   = "/cosmos.gov.v1.Msg/Vote"
+	Msg_VoteWeighted_FullMethodName           = "/cosmos.gov.v1.Msg/VoteWeighted"
+	Msg_Deposit_FullMethodName                = "/cosmos.gov.v1.Msg/Deposit"
+	Msg_UpdateParams_FullMethodName           = "/cosmos.gov.v1.Msg/UpdateParams"
+	Msg_CancelProposal_FullMethodName         = "/cosmos.gov.v1.Msg/CancelProposal"
+	Msg_BatchUpdateParams_FullMethodName      = "/cosmos.gov.v1.Msg/BatchUpdateParams"
+	Msg_UpdateProposalMetadata_FullMethodName = "/cosmos.gov.v1.Msg/UpdateProposalMetadata"
+	Msg_WithdrawDeposit_FullMethodName        = "/cosmos.gov.v1.Msg/WithdrawDeposit"
+	Msg_AmendConstitution_FullMethodName      = "/cosmos.gov.v1.Msg/AmendConstitution"
+	Msg_RetractVote_FullMethodName            = "/cosmos.gov.v1.Msg/RetractVote"
+	Msg_AmendProposal_FullMethodName          = "/cosmos.gov.v1.Msg/AmendProposal"
+	Msg_CommitVote_FullMethodName             = "/cosmos.gov.v1.Msg/CommitVote"
+	Msg_RevealVote_FullMethodName             = "/cosmos.gov.v1.Msg/RevealVote"
+	Msg_RegisterMetadataSchema_FullMethodName = "/cosmos.gov.v1.Msg/RegisterMetadataSchema"
 )
 
 // MsgClient is the client API for Msg service.
@@ -54,6 +63,52 @@ type MsgClient interface {
 	//
 	// Since: cosmos-sdk 0.48
 	CancelProposal(ctx context.Context, in *MsgCancelProposal, opts ...grpc.CallOption) (*MsgCancelProposalResponse, error)
+	// BatchUpdateParams defines a governance operation for atomically updating
+	// the parameters of several modules. The authority is defined in the keeper.
+	//
+	// Since: cosmos-sdk 0.48
+	BatchUpdateParams(ctx context.Context, in *MsgBatchUpdateParams, opts ...grpc.CallOption) (*MsgBatchUpdateParamsResponse, error)
+	// UpdateProposalMetadata defines a method for the proposer to amend the
+	// metadata of a proposal in its discussion period.
+	//
+	// Since: cosmos-sdk 0.48
+	UpdateProposalMetadata(ctx context.Context, in *MsgUpdateProposalMetadata, opts ...grpc.CallOption) (*MsgUpdateProposalMetadataResponse, error)
+	// WithdrawDeposit defines a method for a depositor to withdraw its deposit
+	// from a proposal in its discussion period.
+	//
+	// Since: cosmos-sdk 0.48
+	WithdrawDeposit(ctx context.Context, in *MsgWithdrawDeposit, opts ...grpc.CallOption) (*MsgWithdrawDepositResponse, error)
+	// AmendConstitution defines a governance operation for amending the chain's
+	// constitution. It can only be executed by a constitution amendment proposal.
+	//
+	// Since: cosmos-sdk 0.48
+	AmendConstitution(ctx context.Context, in *MsgAmendConstitution, opts ...grpc.CallOption) (*MsgAmendConstitutionResponse, error)
+	// RetractVote defines a method for a voter to retract its vote on a proposal
+	// in its voting period.
+	//
+	// Since: cosmos-sdk 0.48
+	RetractVote(ctx context.Context, in *MsgRetractVote, opts ...grpc.CallOption) (*MsgRetractVoteResponse, error)
+	// AmendProposal defines a method for the proposer to amend the messages and
+	// metadata of a proposal in its discussion period.
+	//
+	// Since: cosmos-sdk 0.48
+	AmendProposal(ctx context.Context, in *MsgAmendProposal, opts ...grpc.CallOption) (*MsgAmendProposalResponse, error)
+	// CommitVote defines a method for a voter to commit to its vote on a
+	// commit-reveal proposal in its commit period.
+	//
+	// Since: cosmos-sdk 0.48
+	CommitVote(ctx context.Context, in *MsgCommitVote, opts ...grpc.CallOption) (*MsgCommitVoteResponse, error)
+	// RevealVote defines a method for a voter to reveal the vote it committed to
+	// on a commit-reveal proposal in its reveal period.
+	//
+	// Since: cosmos-sdk 0.48
+	RevealVote(ctx context.Context, in *MsgRevealVote, opts ...grpc.CallOption) (*MsgRevealVoteResponse, error)
+	// RegisterMetadataSchema defines a governance operation for registering a
+	// metadata schema, which the metadata of the proposals, votes and deposits
+	// can reference.
+	//
+	// Since: cosmos-sdk 0.48
+	RegisterMetadataSchema(ctx context.Context, in *MsgRegisterMetadataSchema, opts ...grpc.CallOption) (*MsgRegisterMetadataSchemaResponse, error)
 }
 
 type msgClient struct {
@@ -127,6 +182,87 @@ func (c *msgClient) CancelProposal(ctx context.Context, in *MsgCancelProposal, o
 	return out, nil
 }
 
+func (c *msgClient) BatchUpdateParams(ctx context.Context, in *MsgBatchUpdateParams, opts ...grpc.CallOption) (*MsgBatchUpdateParamsResponse, error) {
+	out := new(MsgBatchUpdateParamsResponse)
+	err := c.cc.Invoke(ctx, Msg_BatchUpdateParams_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) UpdateProposalMetadata(ctx context.Context, in *MsgUpdateProposalMetadata, opts ...grpc.CallOption) (*MsgUpdateProposalMetadataResponse, error) {
+	out := new(MsgUpdateProposalMetadataResponse)
+	err := c.cc.Invoke(ctx, Msg_UpdateProposalMetadata_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) WithdrawDeposit(ctx context.Context, in *MsgWithdrawDeposit, opts ...grpc.CallOption) (*MsgWithdrawDepositResponse, error) {
+	out := new(MsgWithdrawDepositResponse)
+	err := c.cc.Invoke(ctx, Msg_WithdrawDeposit_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) AmendConstitution(ctx context.Context, in *MsgAmendConstitution, opts ...grpc.CallOption) (*MsgAmendConstitutionResponse, error) {
+	out := new(MsgAmendConstitutionResponse)
+	err := c.cc.Invoke(ctx, Msg_AmendConstitution_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) RetractVote(ctx context.Context, in *MsgRetractVote, opts ...grpc.CallOption) (*MsgRetractVoteResponse, error) {
+	out := new(MsgRetractVoteResponse)
+	err := c.cc.Invoke(ctx, Msg_RetractVote_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) AmendProposal(ctx context.Context, in *MsgAmendProposal, opts ...grpc.CallOption) (*MsgAmendProposalResponse, error) {
+	out := new(MsgAmendProposalResponse)
+	err := c.cc.Invoke(ctx, Msg_AmendProposal_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) CommitVote(ctx context.Context, in *MsgCommitVote, opts ...grpc.CallOption) (*MsgCommitVoteResponse, error) {
+	out := new(MsgCommitVoteResponse)
+	err := c.cc.Invoke(ctx, Msg_CommitVote_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) RevealVote(ctx context.Context, in *MsgRevealVote, opts ...grpc.CallOption) (*MsgRevealVoteResponse, error) {
+	out := new(MsgRevealVoteResponse)
+	err := c.cc.Invoke(ctx, Msg_RevealVote_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) RegisterMetadataSchema(ctx context.Context, in *MsgRegisterMetadataSchema, opts ...grpc.CallOption) (*MsgRegisterMetadataSchemaResponse, error) {
+	out := new(MsgRegisterMetadataSchemaResponse)
+	err := c.cc.Invoke(ctx, Msg_RegisterMetadataSchema_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MsgServer is the server API for Msg service.
 // All implementations must embed UnimplementedMsgServer
 // for forward compatibility
@@ -151,6 +287,52 @@ type MsgServer interface {
 	//
 	// Since: cosmos-sdk 0.48
 	CancelProposal(context.Context, *MsgCancelProposal) (*MsgCancelProposalResponse, error)
+	// BatchUpdateParams defines a governance operation for atomically updating
+	// the parameters of several modules. The authority is defined in the keeper.
+	//
+	// Since: cosmos-sdk 0.48
+	BatchUpdateParams(context.Context, *MsgBatchUpdateParams) (*MsgBatchUpdateParamsResponse, error)
+	// UpdateProposalMetadata defines a method for the proposer to amend the
+	// metadata of a proposal in its discussion period.
+	//
+	// Since: cosmos-sdk 0.48
+	UpdateProposalMetadata(context.Context, *MsgUpdateProposalMetadata) (*MsgUpdateProposalMetadataResponse, error)
+	// WithdrawDeposit defines a method for a depositor to withdraw its deposit
+	// from a proposal in its discussion period.
+	//
+	// Since: cosmos-sdk 0.48
+	WithdrawDeposit(context.Context, *MsgWithdrawDeposit) (*MsgWithdrawDepositResponse, error)
+	// AmendConstitution defines a governance operation for amending the chain's
+	// constitution. It can only be executed by a constitution amendment proposal.
+	//
+	// Since: cosmos-sdk 0.48
+	AmendConstitution(context.Context, *MsgAmendConstitution) (*MsgAmendConstitutionResponse, error)
+	// RetractVote defines a method for a voter to retract its vote on a proposal
+	// in its voting period.
+	//
+	// Since: cosmos-sdk 0.48
+	RetractVote(context.Context, *MsgRetractVote) (*MsgRetractVoteResponse, error)
+	// AmendProposal defines a method for the proposer to amend the messages and
+	// metadata of a proposal in its discussion period.
+	//
+	// Since: cosmos-sdk 0.48
+	AmendProposal(context.Context, *MsgAmendProposal) (*MsgAmendProposalResponse, error)
+	// CommitVote defines a method for a voter to commit to its vote on a
+	// commit-reveal proposal in its commit period.
+	//
+	// Since: cosmos-sdk 0.48
+	CommitVote(context.Context, *MsgCommitVote) (*MsgCommitVoteResponse, error)
+	// RevealVote defines a method for a voter to reveal the vote it committed to
+	// on a commit-reveal proposal in its reveal period.
+	//
+	// Since: cosmos-sdk 0.48
+	RevealVote(context.Context, *MsgRevealVote) (*MsgRevealVoteResponse, error)
+	// RegisterMetadataSchema defines a governance operation for registering a
+	// metadata schema, which the metadata of the proposals, votes and deposits
+	// can reference.
+	//
+	// Since: cosmos-sdk 0.48
+	RegisterMetadataSchema(context.Context, *MsgRegisterMetadataSchema) (*MsgRegisterMetadataSchemaResponse, error)
 	mustEmbedUnimplementedMsgServer()
 }
 
@@ -179,6 +361,33 @@ func (UnimplementedMsgServer) UpdateParams(context.Context, *MsgUpdateParams) (*
 func (UnimplementedMsgServer) CancelProposal(context.Context, *MsgCancelProposal) (*MsgCancelProposalResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method CancelProposal not implemented")
 }
+func (UnimplementedMsgServer) BatchUpdateParams(context.Context, *MsgBatchUpdateParams) (*MsgBatchUpdateParamsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BatchUpdateParams not implemented")
+}
+func (UnimplementedMsgServer) UpdateProposalMetadata(context.Context, *MsgUpdateProposalMetadata) (*MsgUpdateProposalMetadataResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateProposalMetadata not implemented")
+}
+func (UnimplementedMsgServer) WithdrawDeposit(context.Context, *MsgWithdrawDeposit) (*MsgWithdrawDepositResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method WithdrawDeposit not implemented")
+}
+func (UnimplementedMsgServer) AmendConstitution(context.Context, *MsgAmendConstitution) (*MsgAmendConstitutionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AmendConstitution not implemented")
+}
+func (UnimplementedMsgServer) RetractVote(context.Context, *MsgRetractVote) (*MsgRetractVoteResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RetractVote not implemented")
+}
+func (UnimplementedMsgServer) AmendProposal(context.Context, *MsgAmendProposal) (*MsgAmendProposalResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AmendProposal not implemented")
+}
+func (UnimplementedMsgServer) CommitVote(context.Context, *MsgCommitVote) (*MsgCommitVoteResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CommitVote not implemented")
+}
+func (UnimplementedMsgServer) RevealVote(context.Context, *MsgRevealVote) (*MsgRevealVoteResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RevealVote not implemented")
+}
+func (UnimplementedMsgServer) RegisterMetadataSchema(context.Context, *MsgRegisterMetadataSchema) (*MsgRegisterMetadataSchemaResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RegisterMetadataSchema not implemented")
+}
 func (UnimplementedMsgServer) mustEmbedUnimplementedMsgServer() {}
 
 // UnsafeMsgServer may be embedded to opt out of forward compatibility for this service.
@@ -318,6 +527,168 @@ func _Msg_CancelProposal_Handler(srv interface{}, ctx context.Context, dec func(
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_BatchUpdateParams_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgBatchUpdateParams)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).BatchUpdateParams(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_BatchUpdateParams_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).BatchUpdateParams(ctx, req.(*MsgBatchUpdateParams))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_UpdateProposalMetadata_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUpdateProposalMetadata)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).UpdateProposalMetadata(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_UpdateProposalMetadata_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UpdateProposalMetadata(ctx, req.(*MsgUpdateProposalMetadata))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_WithdrawDeposit_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgWithdrawDeposit)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).WithdrawDeposit(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_WithdrawDeposit_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).WithdrawDeposit(ctx, req.(*MsgWithdrawDeposit))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_AmendConstitution_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgAmendConstitution)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).AmendConstitution(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_AmendConstitution_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).AmendConstitution(ctx, req.(*MsgAmendConstitution))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_RetractVote_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgRetractVote)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).RetractVote(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_RetractVote_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).RetractVote(ctx, req.(*MsgRetractVote))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_AmendProposal_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgAmendProposal)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).AmendProposal(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_AmendProposal_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).AmendProposal(ctx, req.(*MsgAmendProposal))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_CommitVote_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgCommitVote)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).CommitVote(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_CommitVote_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).CommitVote(ctx, req.(*MsgCommitVote))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_RevealVote_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgRevealVote)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).RevealVote(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_RevealVote_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).RevealVote(ctx, req.(*MsgRevealVote))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_RegisterMetadataSchema_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgRegisterMetadataSchema)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).RegisterMetadataSchema(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_RegisterMetadataSchema_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).RegisterMetadataSchema(ctx, req.(*MsgRegisterMetadataSchema))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // Msg_ServiceDesc is the grpc.ServiceDesc for Msg service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -353,6 +724,42 @@ var Msg_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "CancelProposal",
 			Handler:    _Msg_CancelProposal_Handler,
 		},
+		{
+			MethodName: "BatchUpdateParams",
+			Handler:    _Msg_BatchUpdateParams_Handler,
+		},
+		{
+			MethodName: "UpdateProposalMetadata",
+			Handler:    _Msg_UpdateProposalMetadata_Handler,
+		},
+		{
+			MethodName: "WithdrawDeposit",
+			Handler:    _Msg_WithdrawDeposit_Handler,
+		},
+		{
+			MethodName: "AmendConstitution",
+			Handler:    _Msg_AmendConstitution_Handler,
+		},
+		{
+			MethodName: "RetractVote",
+			Handler:    _Msg_RetractVote_Handler,
+		},
+		{
+			MethodName: "AmendProposal",
+			Handler:    _Msg_AmendProposal_Handler,
+		},
+		{
+			MethodName: "CommitVote",
+			Handler:    _Msg_CommitVote_Handler,
+		},
+		{
+			MethodName: "RevealVote",
+			Handler:    _Msg_RevealVote_Handler,
+		},
+		{
+			MethodName: "RegisterMetadataSchema",
+			Handler:    _Msg_RegisterMetadataSchema_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "cosmos/gov/v1/tx.proto",
@@ -0,0 +1,191 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.3.0
+// - protoc             (unknown)
+// source: cosmos/payments/v1/query.proto
+
+package paymentsv1
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.32.0 or later.
+const _ = grpc.SupportPackageIsVersion7
+
+const (
+	Query_Stream_FullMethodName         = "/cosmos.payments.v1.Query/Stream"
+	Query_StreamsByPayer_FullMethodName = "/cosmos.payments.v1.Query/StreamsByPayer"
+	Query_StreamsByPayee_FullMethodName = "/cosmos.payments.v1.Query/StreamsByPayee"
+)
+
+// QueryClient is the client API for Query service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type QueryClient interface {
+	// Stream queries a payment stream, along with the amount its payee can
+	// withdraw.
+	Stream(ctx context.Context, in *QueryStreamRequest, opts ...grpc.CallOption) (*QueryStreamResponse, error)
+	// StreamsByPayer queries the payment streams funded by an account.
+	StreamsByPayer(ctx context.Context, in *QueryStreamsByPayerRequest, opts ...grpc.CallOption) (*QueryStreamsByPayerResponse, error)
+	// StreamsByPayee queries the payment streams received by an account.
+	StreamsByPayee(ctx context.Context, in *QueryStreamsByPayeeRequest, opts ...grpc.CallOption) (*QueryStreamsByPayeeResponse, error)
+}
+
+type queryClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewQueryClient(cc grpc.ClientConnInterface) QueryClient {
+	return &queryClient{cc}
+}
+
+func (c *queryClient) Stream(ctx context.Context, in *QueryStreamRequest, opts ...grpc.CallOption) (*QueryStreamResponse, error) {
+	out := new(QueryStreamResponse)
+	err := c.cc.Invoke(ctx, Query_Stream_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) StreamsByPayer(ctx context.Context, in *QueryStreamsByPayerRequest, opts ...grpc.CallOption) (*QueryStreamsByPayerResponse, error) {
+	out := new(QueryStreamsByPayerResponse)
+	err := c.cc.Invoke(ctx, Query_StreamsByPayer_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) StreamsByPayee(ctx context.Context, in *QueryStreamsByPayeeRequest, opts ...grpc.CallOption) (*QueryStreamsByPayeeResponse, error) {
+	out := new(QueryStreamsByPayeeResponse)
+	err := c.cc.Invoke(ctx, Query_StreamsByPayee_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// QueryServer is the server API for Query service.
+// All implementations must embed UnimplementedQueryServer
+// for forward compatibility
+type QueryServer interface {
+	// Stream queries a payment stream, along with the amount its payee can
+	// withdraw.
+	Stream(context.Context, *QueryStreamRequest) (*QueryStreamResponse, error)
+	// StreamsByPayer queries the payment streams funded by an account.
+	StreamsByPayer(context.Context, *QueryStreamsByPayerRequest) (*QueryStreamsByPayerResponse, error)
+	// StreamsByPayee queries the payment streams received by an account.
+	StreamsByPayee(context.Context, *QueryStreamsByPayeeRequest) (*QueryStreamsByPayeeResponse, error)
+	mustEmbedUnimplementedQueryServer()
+}
+
+// UnimplementedQueryServer must be embedded to have forward compatible implementations.
+type UnimplementedQueryServer struct {
+}
+
+func (UnimplementedQueryServer) Stream(context.Context, *QueryStreamRequest) (*QueryStreamResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Stream not implemented")
+}
+func (UnimplementedQueryServer) StreamsByPayer(context.Context, *QueryStreamsByPayerRequest) (*QueryStreamsByPayerResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method StreamsByPayer not implemented")
+}
+func (UnimplementedQueryServer) StreamsByPayee(context.Context, *QueryStreamsByPayeeRequest) (*QueryStreamsByPayeeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method StreamsByPayee not implemented")
+}
+func (UnimplementedQueryServer) mustEmbedUnimplementedQueryServer() {}
+
+// UnsafeQueryServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to QueryServer will
+// result in compilation errors.
+type UnsafeQueryServer interface {
+	mustEmbedUnimplementedQueryServer()
+}
+
+func RegisterQueryServer(s grpc.ServiceRegistrar, srv QueryServer) {
+	s.RegisterService(&Query_ServiceDesc, srv)
+}
+
+func _Query_Stream_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryStreamRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Stream(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_Stream_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Stream(ctx, req.(*QueryStreamRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_StreamsByPayer_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryStreamsByPayerRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).StreamsByPayer(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_StreamsByPayer_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).StreamsByPayer(ctx, req.(*QueryStreamsByPayerRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_StreamsByPayee_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryStreamsByPayeeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).StreamsByPayee(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_StreamsByPayee_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).StreamsByPayee(ctx, req.(*QueryStreamsByPayeeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// Query_ServiceDesc is the grpc.ServiceDesc for Query service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var Query_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "cosmos.payments.v1.Query",
+	HandlerType: (*QueryServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Stream",
+			Handler:    _Query_Stream_Handler,
+		},
+		{
+			MethodName: "StreamsByPayer",
+			Handler:    _Query_StreamsByPayer_Handler,
+		},
+		{
+			MethodName: "StreamsByPayee",
+			Handler:    _Query_StreamsByPayee_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "cosmos/payments/v1/query.proto",
+}
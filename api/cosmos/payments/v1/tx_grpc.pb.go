@@ -0,0 +1,240 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.3.0
+// - protoc             (unknown)
+// source: cosmos/payments/v1/tx.proto
+
+package paymentsv1
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.32.0 or later.
+const _ = grpc.SupportPackageIsVersion7
+
+const (
+	Msg_CreateStream_FullMethodName              = "/cosmos.payments.v1.Msg/CreateStream"
+	Msg_CreateCommunityPoolStream_FullMethodName = "/cosmos.payments.v1.Msg/CreateCommunityPoolStream"
+	Msg_WithdrawStream_FullMethodName            = "/cosmos.payments.v1.Msg/WithdrawStream"
+	Msg_CancelStream_FullMethodName              = "/cosmos.payments.v1.Msg/CancelStream"
+)
+
+// MsgClient is the client API for Msg service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type MsgClient interface {
+	// CreateStream defines an operation for a payer to open a payment stream to
+	// a payee, escrowing its deposit.
+	CreateStream(ctx context.Context, in *MsgCreateStream, opts ...grpc.CallOption) (*MsgCreateStreamResponse, error)
+	// CreateCommunityPoolStream defines a governance operation for opening a
+	// payment stream funded by the community pool, e.g. to pay a grant. The
+	// authority is defined in the keeper.
+	CreateCommunityPoolStream(ctx context.Context, in *MsgCreateCommunityPoolStream, opts ...grpc.CallOption) (*MsgCreateCommunityPoolStreamResponse, error)
+	// WithdrawStream defines an operation for the payee of a stream to withdraw
+	// its accrued funds.
+	WithdrawStream(ctx context.Context, in *MsgWithdrawStream, opts ...grpc.CallOption) (*MsgWithdrawStreamResponse, error)
+	// CancelStream defines an operation for the payer of a cancelable stream to
+	// cancel it. The accrued funds are paid to the payee, and the unaccrued
+	// funds return to the payer.
+	CancelStream(ctx context.Context, in *MsgCancelStream, opts ...grpc.CallOption) (*MsgCancelStreamResponse, error)
+}
+
+type msgClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewMsgClient(cc grpc.ClientConnInterface) MsgClient {
+	return &msgClient{cc}
+}
+
+func (c *msgClient) CreateStream(ctx context.Context, in *MsgCreateStream, opts ...grpc.CallOption) (*MsgCreateStreamResponse, error) {
+	out := new(MsgCreateStreamResponse)
+	err := c.cc.Invoke(ctx, Msg_CreateStream_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) CreateCommunityPoolStream(ctx context.Context, in *MsgCreateCommunityPoolStream, opts ...grpc.CallOption) (*MsgCreateCommunityPoolStreamResponse, error) {
+	out := new(MsgCreateCommunityPoolStreamResponse)
+	err := c.cc.Invoke(ctx, Msg_CreateCommunityPoolStream_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) WithdrawStream(ctx context.Context, in *MsgWithdrawStream, opts ...grpc.CallOption) (*MsgWithdrawStreamResponse, error) {
+	out := new(MsgWithdrawStreamResponse)
+	err := c.cc.Invoke(ctx, Msg_WithdrawStream_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) CancelStream(ctx context.Context, in *MsgCancelStream, opts ...grpc.CallOption) (*MsgCancelStreamResponse, error) {
+	out := new(MsgCancelStreamResponse)
+	err := c.cc.Invoke(ctx, Msg_CancelStream_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// MsgServer is the server API for Msg service.
+// All implementations must embed UnimplementedMsgServer
+// for forward compatibility
+type MsgServer interface {
+	// CreateStream defines an operation for a payer to open a payment stream to
+	// a payee, escrowing its deposit.
+	CreateStream(context.Context, *MsgCreateStream) (*MsgCreateStreamResponse, error)
+	// CreateCommunityPoolStream defines a governance operation for opening a
+	// payment stream funded by the community pool, e.g. to pay a grant. The
+	// authority is defined in the keeper.
+	CreateCommunityPoolStream(context.Context, *MsgCreateCommunityPoolStream) (*MsgCreateCommunityPoolStreamResponse, error)
+	// WithdrawStream defines an operation for the payee of a stream to withdraw
+	// its accrued funds.
+	WithdrawStream(context.Context, *MsgWithdrawStream) (*MsgWithdrawStreamResponse, error)
+	// CancelStream defines an operation for the payer of a cancelable stream to
+	// cancel it. The accrued funds are paid to the payee, and the unaccrued
+	// funds return to the payer.
+	CancelStream(context.Context, *MsgCancelStream) (*MsgCancelStreamResponse, error)
+	mustEmbedUnimplementedMsgServer()
+}
+
+// UnimplementedMsgServer must be embedded to have forward compatible implementations.
+type UnimplementedMsgServer struct {
+}
+
+func (UnimplementedMsgServer) CreateStream(context.Context, *MsgCreateStream) (*MsgCreateStreamResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateStream not implemented")
+}
+func (UnimplementedMsgServer) CreateCommunityPoolStream(context.Context, *MsgCreateCommunityPoolStream) (*MsgCreateCommunityPoolStreamResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateCommunityPoolStream not implemented")
+}
+func (UnimplementedMsgServer) WithdrawStream(context.Context, *MsgWithdrawStream) (*MsgWithdrawStreamResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method WithdrawStream not implemented")
+}
+func (UnimplementedMsgServer) CancelStream(context.Context, *MsgCancelStream) (*MsgCancelStreamResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CancelStream not implemented")
+}
+func (UnimplementedMsgServer) mustEmbedUnimplementedMsgServer() {}
+
+// UnsafeMsgServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to MsgServer will
+// result in compilation errors.
+type UnsafeMsgServer interface {
+	mustEmbedUnimplementedMsgServer()
+}
+
+func RegisterMsgServer(s grpc.ServiceRegistrar, srv MsgServer) {
+	s.RegisterService(&Msg_ServiceDesc, srv)
+}
+
+func _Msg_CreateStream_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgCreateStream)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).CreateStream(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_CreateStream_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).CreateStream(ctx, req.(*MsgCreateStream))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_CreateCommunityPoolStream_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgCreateCommunityPoolStream)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).CreateCommunityPoolStream(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_CreateCommunityPoolStream_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).CreateCommunityPoolStream(ctx, req.(*MsgCreateCommunityPoolStream))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_WithdrawStream_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgWithdrawStream)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).WithdrawStream(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_WithdrawStream_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).WithdrawStream(ctx, req.(*MsgWithdrawStream))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_CancelStream_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgCancelStream)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).CancelStream(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_CancelStream_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).CancelStream(ctx, req.(*MsgCancelStream))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// Msg_ServiceDesc is the grpc.ServiceDesc for Msg service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var Msg_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "cosmos.payments.v1.Msg",
+	HandlerType: (*MsgServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "CreateStream",
+			Handler:    _Msg_CreateStream_Handler,
+		},
+		{
+			MethodName: "CreateCommunityPoolStream",
+			Handler:    _Msg_CreateCommunityPoolStream_Handler,
+		},
+		{
+			MethodName: "WithdrawStream",
+			Handler:    _Msg_WithdrawStream_Handler,
+		},
+		{
+			MethodName: "CancelStream",
+			Handler:    _Msg_CancelStream_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "cosmos/payments/v1/tx.proto",
+}
@@ -0,0 +1,113 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.3.0
+// - protoc             (unknown)
+// source: cosmos/reflection/v1/params.proto
+
+package reflectionv1
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.32.0 or later.
+const _ = grpc.SupportPackageIsVersion7
+
+const (
+	ParamsService_ModuleParams_FullMethodName = "/cosmos.reflection.v1.ParamsService/ModuleParams"
+)
+
+// ParamsServiceClient is the client API for ParamsService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type ParamsServiceClient interface {
+	// ModuleParams queries the params of the modules, along with the message
+	// updating them and the authority allowed to update them.
+	ModuleParams(ctx context.Context, in *ModuleParamsRequest, opts ...grpc.CallOption) (*ModuleParamsResponse, error)
+}
+
+type paramsServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewParamsServiceClient(cc grpc.ClientConnInterface) ParamsServiceClient {
+	return &paramsServiceClient{cc}
+}
+
+func (c *paramsServiceClient) ModuleParams(ctx context.Context, in *ModuleParamsRequest, opts ...grpc.CallOption) (*ModuleParamsResponse, error) {
+	out := new(ModuleParamsResponse)
+	err := c.cc.Invoke(ctx, ParamsService_ModuleParams_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// ParamsServiceServer is the server API for ParamsService service.
+// All implementations must embed UnimplementedParamsServiceServer
+// for forward compatibility
+type ParamsServiceServer interface {
+	// ModuleParams queries the params of the modules, along with the message
+	// updating them and the authority allowed to update them.
+	ModuleParams(context.Context, *ModuleParamsRequest) (*ModuleParamsResponse, error)
+	mustEmbedUnimplementedParamsServiceServer()
+}
+
+// UnimplementedParamsServiceServer must be embedded to have forward compatible implementations.
+type UnimplementedParamsServiceServer struct {
+}
+
+func (UnimplementedParamsServiceServer) ModuleParams(context.Context, *ModuleParamsRequest) (*ModuleParamsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ModuleParams not implemented")
+}
+func (UnimplementedParamsServiceServer) mustEmbedUnimplementedParamsServiceServer() {}
+
+// UnsafeParamsServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to ParamsServiceServer will
+// result in compilation errors.
+type UnsafeParamsServiceServer interface {
+	mustEmbedUnimplementedParamsServiceServer()
+}
+
+func RegisterParamsServiceServer(s grpc.ServiceRegistrar, srv ParamsServiceServer) {
+	s.RegisterService(&ParamsService_ServiceDesc, srv)
+}
+
+func _ParamsService_ModuleParams_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ModuleParamsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ParamsServiceServer).ModuleParams(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ParamsService_ModuleParams_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ParamsServiceServer).ModuleParams(ctx, req.(*ModuleParamsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// ParamsService_ServiceDesc is the grpc.ServiceDesc for ParamsService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var ParamsService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "cosmos.reflection.v1.ParamsService",
+	HandlerType: (*ParamsServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "ModuleParams",
+			Handler:    _ParamsService_ModuleParams_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "cosmos/reflection/v1/params.proto",
+}
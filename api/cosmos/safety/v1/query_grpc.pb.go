@@ -0,0 +1,189 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.3.0
+// - protoc             (unknown)
+// source: cosmos/safety/v1/query.proto
+
+package safetyv1
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.32.0 or later.
+const _ = grpc.SupportPackageIsVersion7
+
+const (
+	Query_Params_FullMethodName        = "/cosmos.safety.v1.Query/Params"
+	Query_ScheduledHalt_FullMethodName = "/cosmos.safety.v1.Query/ScheduledHalt"
+	Query_PastHalts_FullMethodName     = "/cosmos.safety.v1.Query/PastHalts"
+)
+
+// QueryClient is the client API for Query service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type QueryClient interface {
+	// Params queries the parameters of the safety module.
+	Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error)
+	// ScheduledHalt queries the halt scheduled at a future height, if any.
+	ScheduledHalt(ctx context.Context, in *QueryScheduledHaltRequest, opts ...grpc.CallOption) (*QueryScheduledHaltResponse, error)
+	// PastHalts queries the halts the chain resumed from.
+	PastHalts(ctx context.Context, in *QueryPastHaltsRequest, opts ...grpc.CallOption) (*QueryPastHaltsResponse, error)
+}
+
+type queryClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewQueryClient(cc grpc.ClientConnInterface) QueryClient {
+	return &queryClient{cc}
+}
+
+func (c *queryClient) Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error) {
+	out := new(QueryParamsResponse)
+	err := c.cc.Invoke(ctx, Query_Params_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) ScheduledHalt(ctx context.Context, in *QueryScheduledHaltRequest, opts ...grpc.CallOption) (*QueryScheduledHaltResponse, error) {
+	out := new(QueryScheduledHaltResponse)
+	err := c.cc.Invoke(ctx, Query_ScheduledHalt_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) PastHalts(ctx context.Context, in *QueryPastHaltsRequest, opts ...grpc.CallOption) (*QueryPastHaltsResponse, error) {
+	out := new(QueryPastHaltsResponse)
+	err := c.cc.Invoke(ctx, Query_PastHalts_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// QueryServer is the server API for Query service.
+// All implementations must embed UnimplementedQueryServer
+// for forward compatibility
+type QueryServer interface {
+	// Params queries the parameters of the safety module.
+	Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error)
+	// ScheduledHalt queries the halt scheduled at a future height, if any.
+	ScheduledHalt(context.Context, *QueryScheduledHaltRequest) (*QueryScheduledHaltResponse, error)
+	// PastHalts queries the halts the chain resumed from.
+	PastHalts(context.Context, *QueryPastHaltsRequest) (*QueryPastHaltsResponse, error)
+	mustEmbedUnimplementedQueryServer()
+}
+
+// UnimplementedQueryServer must be embedded to have forward compatible implementations.
+type UnimplementedQueryServer struct {
+}
+
+func (UnimplementedQueryServer) Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Params not implemented")
+}
+func (UnimplementedQueryServer) ScheduledHalt(context.Context, *QueryScheduledHaltRequest) (*QueryScheduledHaltResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ScheduledHalt not implemented")
+}
+func (UnimplementedQueryServer) PastHalts(context.Context, *QueryPastHaltsRequest) (*QueryPastHaltsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PastHalts not implemented")
+}
+func (UnimplementedQueryServer) mustEmbedUnimplementedQueryServer() {}
+
+// UnsafeQueryServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to QueryServer will
+// result in compilation errors.
+type UnsafeQueryServer interface {
+	mustEmbedUnimplementedQueryServer()
+}
+
+func RegisterQueryServer(s grpc.ServiceRegistrar, srv QueryServer) {
+	s.RegisterService(&Query_ServiceDesc, srv)
+}
+
+func _Query_Params_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryParamsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Params(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_Params_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Params(ctx, req.(*QueryParamsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ScheduledHalt_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryScheduledHaltRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ScheduledHalt(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_ScheduledHalt_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ScheduledHalt(ctx, req.(*QueryScheduledHaltRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_PastHalts_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryPastHaltsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).PastHalts(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_PastHalts_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).PastHalts(ctx, req.(*QueryPastHaltsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// Query_ServiceDesc is the grpc.ServiceDesc for Query service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var Query_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "cosmos.safety.v1.Query",
+	HandlerType: (*QueryServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Params",
+			Handler:    _Query_Params_Handler,
+		},
+		{
+			MethodName: "ScheduledHalt",
+			Handler:    _Query_ScheduledHalt_Handler,
+		},
+		{
+			MethodName: "PastHalts",
+			Handler:    _Query_PastHalts_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "cosmos/safety/v1/query.proto",
+}
@@ -0,0 +1,203 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.3.0
+// - protoc             (unknown)
+// source: cosmos/safety/v1/tx.proto
+
+package safetyv1
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.32.0 or later.
+const _ = grpc.SupportPackageIsVersion7
+
+const (
+	Msg_ScheduleHalt_FullMethodName = "/cosmos.safety.v1.Msg/ScheduleHalt"
+	Msg_CancelHalt_FullMethodName   = "/cosmos.safety.v1.Msg/CancelHalt"
+	Msg_UpdateParams_FullMethodName = "/cosmos.safety.v1.Msg/UpdateParams"
+)
+
+// MsgClient is the client API for Msg service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type MsgClient interface {
+	// ScheduleHalt defines an operation for halting block production at a given
+	// height with a recorded reason. It can be executed by the module authority
+	// or by the emergency council. Scheduling a halt replaces the halt already
+	// scheduled, unless the emergency council tries to replace a halt scheduled
+	// by the module authority.
+	ScheduleHalt(ctx context.Context, in *MsgScheduleHalt, opts ...grpc.CallOption) (*MsgScheduleHaltResponse, error)
+	// CancelHalt defines an operation for cancelling the scheduled halt before
+	// its height is reached. The emergency council can only cancel the halts it
+	// scheduled.
+	CancelHalt(ctx context.Context, in *MsgCancelHalt, opts ...grpc.CallOption) (*MsgCancelHaltResponse, error)
+	// UpdateParams defines a governance operation for updating the x/safety
+	// module parameters. The authority is defined in the keeper.
+	UpdateParams(ctx context.Context, in *MsgUpdateParams, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error)
+}
+
+type msgClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewMsgClient(cc grpc.ClientConnInterface) MsgClient {
+	return &msgClient{cc}
+}
+
+func (c *msgClient) ScheduleHalt(ctx context.Context, in *MsgScheduleHalt, opts ...grpc.CallOption) (*MsgScheduleHaltResponse, error) {
+	out := new(MsgScheduleHaltResponse)
+	err := c.cc.Invoke(ctx, Msg_ScheduleHalt_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) CancelHalt(ctx context.Context, in *MsgCancelHalt, opts ...grpc.CallOption) (*MsgCancelHaltResponse, error) {
+	out := new(MsgCancelHaltResponse)
+	err := c.cc.Invoke(ctx, Msg_CancelHalt_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) UpdateParams(ctx context.Context, in *MsgUpdateParams, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error) {
+	out := new(MsgUpdateParamsResponse)
+	err := c.cc.Invoke(ctx, Msg_UpdateParams_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// MsgServer is the server API for Msg service.
+// All implementations must embed UnimplementedMsgServer
+// for forward compatibility
+type MsgServer interface {
+	// ScheduleHalt defines an operation for halting block production at a given
+	// height with a recorded reason. It can be executed by the module authority
+	// or by the emergency council. Scheduling a halt replaces the halt already
+	// scheduled, unless the emergency council tries to replace a halt scheduled
+	// by the module authority.
+	ScheduleHalt(context.Context, *MsgScheduleHalt) (*MsgScheduleHaltResponse, error)
+	// CancelHalt defines an operation for cancelling the scheduled halt before
+	// its height is reached. The emergency council can only cancel the halts it
+	// scheduled.
+	CancelHalt(context.Context, *MsgCancelHalt) (*MsgCancelHaltResponse, error)
+	// UpdateParams defines a governance operation for updating the x/safety
+	// module parameters. The authority is defined in the keeper.
+	UpdateParams(context.Context, *MsgUpdateParams) (*MsgUpdateParamsResponse, error)
+	mustEmbedUnimplementedMsgServer()
+}
+
+// UnimplementedMsgServer must be embedded to have forward compatible implementations.
+type UnimplementedMsgServer struct {
+}
+
+func (UnimplementedMsgServer) ScheduleHalt(context.Context, *MsgScheduleHalt) (*MsgScheduleHaltResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ScheduleHalt not implemented")
+}
+func (UnimplementedMsgServer) CancelHalt(context.Context, *MsgCancelHalt) (*MsgCancelHaltResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CancelHalt not implemented")
+}
+func (UnimplementedMsgServer) UpdateParams(context.Context, *MsgUpdateParams) (*MsgUpdateParamsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateParams not implemented")
+}
+func (UnimplementedMsgServer) mustEmbedUnimplementedMsgServer() {}
+
+// UnsafeMsgServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to MsgServer will
+// result in compilation errors.
+type UnsafeMsgServer interface {
+	mustEmbedUnimplementedMsgServer()
+}
+
+func RegisterMsgServer(s grpc.ServiceRegistrar, srv MsgServer) {
+	s.RegisterService(&Msg_ServiceDesc, srv)
+}
+
+func _Msg_ScheduleHalt_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgScheduleHalt)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).ScheduleHalt(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_ScheduleHalt_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).ScheduleHalt(ctx, req.(*MsgScheduleHalt))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_CancelHalt_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgCancelHalt)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).CancelHalt(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_CancelHalt_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).CancelHalt(ctx, req.(*MsgCancelHalt))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_UpdateParams_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUpdateParams)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).UpdateParams(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_UpdateParams_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UpdateParams(ctx, req.(*MsgUpdateParams))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// Msg_ServiceDesc is the grpc.ServiceDesc for Msg service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var Msg_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "cosmos.safety.v1.Msg",
+	HandlerType: (*MsgServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "ScheduleHalt",
+			Handler:    _Msg_ScheduleHalt_Handler,
+		},
+		{
+			MethodName: "CancelHalt",
+			Handler:    _Msg_CancelHalt_Handler,
+		},
+		{
+			MethodName: "UpdateParams",
+			Handler:    _Msg_UpdateParams_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "cosmos/safety/v1/tx.proto",
+}
@@ -19,9 +19,11 @@ import (
 const _ = grpc.SupportPackageIsVersion7
 
 const (
-	Query_Params_FullMethodName       = "/cosmos.slashing.v1beta1.Query/Params"
-	Query_SigningInfo_FullMethodName  = "/cosmos.slashing.v1beta1.Query/SigningInfo"
-	Query_SigningInfos_FullMethodName = "/cosmos.slashing.v1beta1.Query/SigningInfos"
+	Query_Params_FullMethodName                = "/cosmos.slashing.v1beta1.Query/Params"
+	Query_SigningInfo_FullMethodName           = "/cosmos.slashing.v1beta1.Query/SigningInfo"
+	Query_SigningInfos_FullMethodName          = "/cosmos.slashing.v1beta1.Query/SigningInfos"
+	Query_ValidatorPerformance_FullMethodName  = "/cosmos.slashing.v1beta1.Query/ValidatorPerformance"
+	Query_ValidatorsPerformance_FullMethodName = "/cosmos.slashing.v1beta1.Query/ValidatorsPerformance"
 )
 
 // QueryClient is the client API for Query service.
@@ -34,6 +36,16 @@ type QueryClient interface {
 	SigningInfo(ctx context.Context, in *QuerySigningInfoRequest, opts ...grpc.CallOption) (*QuerySigningInfoResponse, error)
 	// SigningInfos queries signing info of all validators
 	SigningInfos(ctx context.Context, in *QuerySigningInfosRequest, opts ...grpc.CallOption) (*QuerySigningInfosResponse, error)
+	// ValidatorPerformance queries the performance counters of a validator
+	// aggregated over a height range.
+	//
+	// Since: cosmos-sdk 0.48
+	ValidatorPerformance(ctx context.Context, in *QueryValidatorPerformanceRequest, opts ...grpc.CallOption) (*QueryValidatorPerformanceResponse, error)
+	// ValidatorsPerformance queries the performance counters of all validators
+	// aggregated over a height range.
+	//
+	// Since: cosmos-sdk 0.48
+	ValidatorsPerformance(ctx context.Context, in *QueryValidatorsPerformanceRequest, opts ...grpc.CallOption) (*QueryValidatorsPerformanceResponse, error)
 }
 
 type queryClient struct {
@@ -71,6 +83,24 @@ func (c *queryClient) SigningInfos(ctx context.Context, in *QuerySigningInfosReq
 	return out, nil
 }
 
+func (c *queryClient) ValidatorPerformance(ctx context.Context, in *QueryValidatorPerformanceRequest, opts ...grpc.CallOption) (*QueryValidatorPerformanceResponse, error) {
+	out := new(QueryValidatorPerformanceResponse)
+	err := c.cc.Invoke(ctx, Query_ValidatorPerformance_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) ValidatorsPerformance(ctx context.Context, in *QueryValidatorsPerformanceRequest, opts ...grpc.CallOption) (*QueryValidatorsPerformanceResponse, error) {
+	out := new(QueryValidatorsPerformanceResponse)
+	err := c.cc.Invoke(ctx, Query_ValidatorsPerformance_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 // All implementations must embed UnimplementedQueryServer
 // for forward compatibility
@@ -81,6 +111,16 @@ type QueryServer interface {
 	SigningInfo(context.Context, *QuerySigningInfoRequest) (*QuerySigningInfoResponse, error)
 	// SigningInfos queries signing info of all validators
 	SigningInfos(context.Context, *QuerySigningInfosRequest) (*QuerySigningInfosResponse, error)
+	// ValidatorPerformance queries the performance counters of a validator
+	// aggregated over a height range.
+	//
+	// Since: cosmos-sdk 0.48
+	ValidatorPerformance(context.Context, *QueryValidatorPerformanceRequest) (*QueryValidatorPerformanceResponse, error)
+	// ValidatorsPerformance queries the performance counters of all validators
+	// aggregated over a height range.
+	//
+	// Since: cosmos-sdk 0.48
+	ValidatorsPerformance(context.Context, *QueryValidatorsPerformanceRequest) (*QueryValidatorsPerformanceResponse, error)
 	mustEmbedUnimplementedQueryServer()
 }
 
@@ -97,6 +137,12 @@ func (UnimplementedQueryServer) SigningInfo(context.Context, *QuerySigningInfoRe
 func (UnimplementedQueryServer) SigningInfos(context.Context, *QuerySigningInfosRequest) (*QuerySigningInfosResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method SigningInfos not implemented")
 }
+func (UnimplementedQueryServer) ValidatorPerformance(context.Context, *QueryValidatorPerformanceRequest) (*QueryValidatorPerformanceResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ValidatorPerformance not implemented")
+}
+func (UnimplementedQueryServer) ValidatorsPerformance(context.Context, *QueryValidatorsPerformanceRequest) (*QueryValidatorsPerformanceResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ValidatorsPerformance not implemented")
+}
 func (UnimplementedQueryServer) mustEmbedUnimplementedQueryServer() {}
 
 // UnsafeQueryServer may be embedded to opt out of forward compatibility for this service.
@@ -164,6 +210,42 @@ func _Query_SigningInfos_Handler(srv interface{}, ctx context.Context, dec func(
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_ValidatorPerformance_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryValidatorPerformanceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ValidatorPerformance(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_ValidatorPerformance_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ValidatorPerformance(ctx, req.(*QueryValidatorPerformanceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ValidatorsPerformance_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryValidatorsPerformanceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ValidatorsPerformance(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_ValidatorsPerformance_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ValidatorsPerformance(ctx, req.(*QueryValidatorsPerformanceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // Query_ServiceDesc is the grpc.ServiceDesc for Query service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -183,6 +265,14 @@ var Query_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "SigningInfos",
 			Handler:    _Query_SigningInfos_Handler,
 		},
+		{
+			MethodName: "ValidatorPerformance",
+			Handler:    _Query_ValidatorPerformance_Handler,
+		},
+		{
+			MethodName: "ValidatorsPerformance",
+			Handler:    _Query_ValidatorsPerformance_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "cosmos/slashing/v1beta1/query.proto",
@@ -18,13 +18,14 @@ import (
 )
 
 var (
-	md_ValidatorSigningInfo                       protoreflect.MessageDescriptor
-	fd_ValidatorSigningInfo_address               protoreflect.FieldDescriptor
-	fd_ValidatorSigningInfo_start_height          protoreflect.FieldDescriptor
-	fd_ValidatorSigningInfo_index_offset          protoreflect.FieldDescriptor
-	fd_ValidatorSigningInfo_jailed_until          protoreflect.FieldDescriptor
-	fd_ValidatorSigningInfo_tombstoned            protoreflect.FieldDescriptor
-	fd_ValidatorSigningInfo_missed_blocks_counter protoreflect.FieldDescriptor
+	md_ValidatorSigningInfo                           protoreflect.MessageDescriptor
+	fd_ValidatorSigningInfo_address                   protoreflect.FieldDescriptor
+	fd_ValidatorSigningInfo_start_height              protoreflect.FieldDescriptor
+	fd_ValidatorSigningInfo_index_offset              protoreflect.FieldDescriptor
+	fd_ValidatorSigningInfo_jailed_until              protoreflect.FieldDescriptor
+	fd_ValidatorSigningInfo_tombstoned                protoreflect.FieldDescriptor
+	fd_ValidatorSigningInfo_missed_blocks_counter     protoreflect.FieldDescriptor
+	fd_ValidatorSigningInfo_consecutive_missed_blocks protoreflect.FieldDescriptor
 )
 
 func init() {
@@ -36,6 +37,7 @@ func init() {
 	fd_ValidatorSigningInfo_jailed_until = md_ValidatorSigningInfo.Fields().ByName("jailed_until")
 	fd_ValidatorSigningInfo_tombstoned = md_ValidatorSigningInfo.Fields().ByName("tombstoned")
 	fd_ValidatorSigningInfo_missed_blocks_counter = md_ValidatorSigningInfo.Fields().ByName("missed_blocks_counter")
+	fd_ValidatorSigningInfo_consecutive_missed_blocks = md_ValidatorSigningInfo.Fields().ByName("consecutive_missed_blocks")
 }
 
 var _ protoreflect.Message = (*fastReflection_ValidatorSigningInfo)(nil)
@@ -139,6 +141,12 @@ func (x *fastReflection_ValidatorSigningInfo) Range(f func(protoreflect.FieldDes
 			return
 		}
 	}
+	if x.ConsecutiveMissedBlocks != int64(0) {
+		value := protoreflect.ValueOfInt64(x.ConsecutiveMissedBlocks)
+		if !f(fd_ValidatorSigningInfo_consecutive_missed_blocks, value) {
+			return
+		}
+	}
 }
 
 // Has reports whether a field is populated.
@@ -166,6 +174,8 @@ func (x *fastReflection_ValidatorSigningInfo) Has(fd protoreflect.FieldDescripto
 		return x.Tombstoned != false
 	case "cosmos.slashing.v1beta1.ValidatorSigningInfo.missed_blocks_counter":
 		return x.MissedBlocksCounter != int64(0)
+	case "cosmos.slashing.v1beta1.ValidatorSigningInfo.consecutive_missed_blocks":
+		return x.ConsecutiveMissedBlocks != int64(0)
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.slashing.v1beta1.ValidatorSigningInfo"))
@@ -194,6 +204,8 @@ func (x *fastReflection_ValidatorSigningInfo) Clear(fd protoreflect.FieldDescrip
 		x.Tombstoned = false
 	case "cosmos.slashing.v1beta1.ValidatorSigningInfo.missed_blocks_counter":
 		x.MissedBlocksCounter = int64(0)
+	case "cosmos.slashing.v1beta1.ValidatorSigningInfo.consecutive_missed_blocks":
+		x.ConsecutiveMissedBlocks = int64(0)
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.slashing.v1beta1.ValidatorSigningInfo"))
@@ -228,6 +240,9 @@ func (x *fastReflection_ValidatorSigningInfo) Get(descriptor protoreflect.FieldD
 	case "cosmos.slashing.v1beta1.ValidatorSigningInfo.missed_blocks_counter":
 		value := x.MissedBlocksCounter
 		return protoreflect.ValueOfInt64(value)
+	case "cosmos.slashing.v1beta1.ValidatorSigningInfo.consecutive_missed_blocks":
+		value := x.ConsecutiveMissedBlocks
+		return protoreflect.ValueOfInt64(value)
 	default:
 		if descriptor.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.slashing.v1beta1.ValidatorSigningInfo"))
@@ -260,6 +275,8 @@ func (x *fastReflection_ValidatorSigningInfo) Set(fd protoreflect.FieldDescripto
 		x.Tombstoned = value.Bool()
 	case "cosmos.slashing.v1beta1.ValidatorSigningInfo.missed_blocks_counter":
 		x.MissedBlocksCounter = value.Int()
+	case "cosmos.slashing.v1beta1.ValidatorSigningInfo.consecutive_missed_blocks":
+		x.ConsecutiveMissedBlocks = value.Int()
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.slashing.v1beta1.ValidatorSigningInfo"))
@@ -295,6 +312,8 @@ func (x *fastReflection_ValidatorSigningInfo) Mutable(fd protoreflect.FieldDescr
 		panic(fmt.Errorf("field tombstoned of message cosmos.slashing.v1beta1.ValidatorSigningInfo is not mutable"))
 	case "cosmos.slashing.v1beta1.ValidatorSigningInfo.missed_blocks_counter":
 		panic(fmt.Errorf("field missed_blocks_counter of message cosmos.slashing.v1beta1.ValidatorSigningInfo is not mutable"))
+	case "cosmos.slashing.v1beta1.ValidatorSigningInfo.consecutive_missed_blocks":
+		panic(fmt.Errorf("field consecutive_missed_blocks of message cosmos.slashing.v1beta1.ValidatorSigningInfo is not mutable"))
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.slashing.v1beta1.ValidatorSigningInfo"))
@@ -321,6 +340,8 @@ func (x *fastReflection_ValidatorSigningInfo) NewField(fd protoreflect.FieldDesc
 		return protoreflect.ValueOfBool(false)
 	case "cosmos.slashing.v1beta1.ValidatorSigningInfo.missed_blocks_counter":
 		return protoreflect.ValueOfInt64(int64(0))
+	case "cosmos.slashing.v1beta1.ValidatorSigningInfo.consecutive_missed_blocks":
+		return protoreflect.ValueOfInt64(int64(0))
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.slashing.v1beta1.ValidatorSigningInfo"))
@@ -410,6 +431,9 @@ func (x *fastReflection_ValidatorSigningInfo) ProtoMethods() *protoiface.Methods
 		if x.MissedBlocksCounter != 0 {
 			n += 1 + runtime.Sov(uint64(x.MissedBlocksCounter))
 		}
+		if x.ConsecutiveMissedBlocks != 0 {
+			n += 1 + runtime.Sov(uint64(x.ConsecutiveMissedBlocks))
+		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
 		}
@@ -439,6 +463,11 @@ func (x *fastReflection_ValidatorSigningInfo) ProtoMethods() *protoiface.Methods
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
+		if x.ConsecutiveMissedBlocks != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.ConsecutiveMissedBlocks))
+			i--
+			dAtA[i] = 0x38
+		}
 		if x.MissedBlocksCounter != 0 {
 			i = runtime.EncodeVarint(dAtA, i, uint64(x.MissedBlocksCounter))
 			i--
@@ -679,6 +708,25 @@ func (x *fastReflection_ValidatorSigningInfo) ProtoMethods() *protoiface.Methods
 						break
 					}
 				}
+			case 7:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ConsecutiveMissedBlocks", wireType)
+				}
+				x.ConsecutiveMissedBlocks = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.ConsecutiveMissedBlocks |= int64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
 			default:
 				iNdEx = preIndex
 				skippy, err := runtime.Skip(dAtA[iNdEx:])
@@ -715,12 +763,14 @@ func (x *fastReflection_ValidatorSigningInfo) ProtoMethods() *protoiface.Methods
 }
 
 var (
-	md_Params                            protoreflect.MessageDescriptor
-	fd_Params_signed_blocks_window       protoreflect.FieldDescriptor
-	fd_Params_min_signed_per_window      protoreflect.FieldDescriptor
-	fd_Params_downtime_jail_duration     protoreflect.FieldDescriptor
-	fd_Params_slash_fraction_double_sign protoreflect.FieldDescriptor
-	fd_Params_slash_fraction_downtime    protoreflect.FieldDescriptor
+	md_Params                               protoreflect.MessageDescriptor
+	fd_Params_signed_blocks_window          protoreflect.FieldDescriptor
+	fd_Params_min_signed_per_window         protoreflect.FieldDescriptor
+	fd_Params_downtime_jail_duration        protoreflect.FieldDescriptor
+	fd_Params_slash_fraction_double_sign    protoreflect.FieldDescriptor
+	fd_Params_slash_fraction_downtime       protoreflect.FieldDescriptor
+	fd_Params_performance_epoch_length      protoreflect.FieldDescriptor
+	fd_Params_standby_key_activation_blocks protoreflect.FieldDescriptor
 )
 
 func init() {
@@ -731,6 +781,8 @@ func init() {
 	fd_Params_downtime_jail_duration = md_Params.Fields().ByName("downtime_jail_duration")
 	fd_Params_slash_fraction_double_sign = md_Params.Fields().ByName("slash_fraction_double_sign")
 	fd_Params_slash_fraction_downtime = md_Params.Fields().ByName("slash_fraction_downtime")
+	fd_Params_performance_epoch_length = md_Params.Fields().ByName("performance_epoch_length")
+	fd_Params_standby_key_activation_blocks = md_Params.Fields().ByName("standby_key_activation_blocks")
 }
 
 var _ protoreflect.Message = (*fastReflection_Params)(nil)
@@ -828,6 +880,18 @@ func (x *fastReflection_Params) Range(f func(protoreflect.FieldDescriptor, proto
 			return
 		}
 	}
+	if x.PerformanceEpochLength != int64(0) {
+		value := protoreflect.ValueOfInt64(x.PerformanceEpochLength)
+		if !f(fd_Params_performance_epoch_length, value) {
+			return
+		}
+	}
+	if x.StandbyKeyActivationBlocks != int64(0) {
+		value := protoreflect.ValueOfInt64(x.StandbyKeyActivationBlocks)
+		if !f(fd_Params_standby_key_activation_blocks, value) {
+			return
+		}
+	}
 }
 
 // Has reports whether a field is populated.
@@ -853,6 +917,10 @@ func (x *fastReflection_Params) Has(fd protoreflect.FieldDescriptor) bool {
 		return len(x.SlashFractionDoubleSign) != 0
 	case "cosmos.slashing.v1beta1.Params.slash_fraction_downtime":
 		return len(x.SlashFractionDowntime) != 0
+	case "cosmos.slashing.v1beta1.Params.performance_epoch_length":
+		return x.PerformanceEpochLength != int64(0)
+	case "cosmos.slashing.v1beta1.Params.standby_key_activation_blocks":
+		return x.StandbyKeyActivationBlocks != int64(0)
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.slashing.v1beta1.Params"))
@@ -879,6 +947,10 @@ func (x *fastReflection_Params) Clear(fd protoreflect.FieldDescriptor) {
 		x.SlashFractionDoubleSign = nil
 	case "cosmos.slashing.v1beta1.Params.slash_fraction_downtime":
 		x.SlashFractionDowntime = nil
+	case "cosmos.slashing.v1beta1.Params.performance_epoch_length":
+		x.PerformanceEpochLength = int64(0)
+	case "cosmos.slashing.v1beta1.Params.standby_key_activation_blocks":
+		x.StandbyKeyActivationBlocks = int64(0)
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.slashing.v1beta1.Params"))
@@ -910,6 +982,12 @@ func (x *fastReflection_Params) Get(descriptor protoreflect.FieldDescriptor) pro
 	case "cosmos.slashing.v1beta1.Params.slash_fraction_downtime":
 		value := x.SlashFractionDowntime
 		return protoreflect.ValueOfBytes(value)
+	case "cosmos.slashing.v1beta1.Params.performance_epoch_length":
+		value := x.PerformanceEpochLength
+		return protoreflect.ValueOfInt64(value)
+	case "cosmos.slashing.v1beta1.Params.standby_key_activation_blocks":
+		value := x.StandbyKeyActivationBlocks
+		return protoreflect.ValueOfInt64(value)
 	default:
 		if descriptor.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.slashing.v1beta1.Params"))
@@ -940,6 +1018,10 @@ func (x *fastReflection_Params) Set(fd protoreflect.FieldDescriptor, value proto
 		x.SlashFractionDoubleSign = value.Bytes()
 	case "cosmos.slashing.v1beta1.Params.slash_fraction_downtime":
 		x.SlashFractionDowntime = value.Bytes()
+	case "cosmos.slashing.v1beta1.Params.performance_epoch_length":
+		x.PerformanceEpochLength = value.Int()
+	case "cosmos.slashing.v1beta1.Params.standby_key_activation_blocks":
+		x.StandbyKeyActivationBlocks = value.Int()
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.slashing.v1beta1.Params"))
@@ -973,6 +1055,10 @@ func (x *fastReflection_Params) Mutable(fd protoreflect.FieldDescriptor) protore
 		panic(fmt.Errorf("field slash_fraction_double_sign of message cosmos.slashing.v1beta1.Params is not mutable"))
 	case "cosmos.slashing.v1beta1.Params.slash_fraction_downtime":
 		panic(fmt.Errorf("field slash_fraction_downtime of message cosmos.slashing.v1beta1.Params is not mutable"))
+	case "cosmos.slashing.v1beta1.Params.performance_epoch_length":
+		panic(fmt.Errorf("field performance_epoch_length of message cosmos.slashing.v1beta1.Params is not mutable"))
+	case "cosmos.slashing.v1beta1.Params.standby_key_activation_blocks":
+		panic(fmt.Errorf("field standby_key_activation_blocks of message cosmos.slashing.v1beta1.Params is not mutable"))
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.slashing.v1beta1.Params"))
@@ -997,6 +1083,10 @@ func (x *fastReflection_Params) NewField(fd protoreflect.FieldDescriptor) protor
 		return protoreflect.ValueOfBytes(nil)
 	case "cosmos.slashing.v1beta1.Params.slash_fraction_downtime":
 		return protoreflect.ValueOfBytes(nil)
+	case "cosmos.slashing.v1beta1.Params.performance_epoch_length":
+		return protoreflect.ValueOfInt64(int64(0))
+	case "cosmos.slashing.v1beta1.Params.standby_key_activation_blocks":
+		return protoreflect.ValueOfInt64(int64(0))
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.slashing.v1beta1.Params"))
@@ -1085,6 +1175,12 @@ func (x *fastReflection_Params) ProtoMethods() *protoiface.Methods {
 		if l > 0 {
 			n += 1 + l + runtime.Sov(uint64(l))
 		}
+		if x.PerformanceEpochLength != 0 {
+			n += 1 + runtime.Sov(uint64(x.PerformanceEpochLength))
+		}
+		if x.StandbyKeyActivationBlocks != 0 {
+			n += 1 + runtime.Sov(uint64(x.StandbyKeyActivationBlocks))
+		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
 		}
@@ -1114,6 +1210,16 @@ func (x *fastReflection_Params) ProtoMethods() *protoiface.Methods {
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
+		if x.StandbyKeyActivationBlocks != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.StandbyKeyActivationBlocks))
+			i--
+			dAtA[i] = 0x38
+		}
+		if x.PerformanceEpochLength != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.PerformanceEpochLength))
+			i--
+			dAtA[i] = 0x30
+		}
 		if len(x.SlashFractionDowntime) > 0 {
 			i -= len(x.SlashFractionDowntime)
 			copy(dAtA[i:], x.SlashFractionDowntime)
@@ -1360,6 +1466,44 @@ func (x *fastReflection_Params) ProtoMethods() *protoiface.Methods {
 					x.SlashFractionDowntime = []byte{}
 				}
 				iNdEx = postIndex
+			case 6:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field PerformanceEpochLength", wireType)
+				}
+				x.PerformanceEpochLength = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.PerformanceEpochLength |= int64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 7:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field StandbyKeyActivationBlocks", wireType)
+				}
+				x.StandbyKeyActivationBlocks = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.StandbyKeyActivationBlocks |= int64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
 			default:
 				iNdEx = preIndex
 				skippy, err := runtime.Skip(dAtA[iNdEx:])
@@ -1395,176 +1539,889 @@ func (x *fastReflection_Params) ProtoMethods() *protoiface.Methods {
 	}
 }
 
-// Code generated by protoc-gen-go. DO NOT EDIT.
-// versions:
-// 	protoc-gen-go v1.27.0
-// 	protoc        (unknown)
-// source: cosmos/slashing/v1beta1/slashing.proto
-
-const (
-	// Verify that this generated code is sufficiently up-to-date.
-	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
-	// Verify that runtime/protoimpl is sufficiently up-to-date.
-	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+var (
+	md_ValidatorPerformance                 protoreflect.MessageDescriptor
+	fd_ValidatorPerformance_signed_blocks   protoreflect.FieldDescriptor
+	fd_ValidatorPerformance_missed_blocks   protoreflect.FieldDescriptor
+	fd_ValidatorPerformance_proposed_blocks protoreflect.FieldDescriptor
+	fd_ValidatorPerformance_gov_votes       protoreflect.FieldDescriptor
+	fd_ValidatorPerformance_slash_events    protoreflect.FieldDescriptor
 )
 
-// ValidatorSigningInfo defines a validator's signing info for monitoring their
-// liveness activity.
-type ValidatorSigningInfo struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
-
-	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
-	// Height at which validator was first a candidate OR was un-jailed
-	StartHeight int64 `protobuf:"varint,2,opt,name=start_height,json=startHeight,proto3" json:"start_height,omitempty"`
-	// Index which is incremented every time a validator is bonded in a block and
-	// _may_ have signed a pre-commit or not. This in conjunction with the
-	// signed_blocks_window param determines the index in the missed block bitmap.
-	IndexOffset int64 `protobuf:"varint,3,opt,name=index_offset,json=indexOffset,proto3" json:"index_offset,omitempty"`
-	// Timestamp until which the validator is jailed due to liveness downtime.
-	JailedUntil *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=jailed_until,json=jailedUntil,proto3" json:"jailed_until,omitempty"`
-	// Whether or not a validator has been tombstoned (killed out of validator
-	// set). It is set once the validator commits an equivocation or for any other
-	// configured misbehavior.
-	Tombstoned bool `protobuf:"varint,5,opt,name=tombstoned,proto3" json:"tombstoned,omitempty"`
-	// A counter of missed (unsigned) blocks. It is used to avoid unnecessary
-	// reads in the missed block bitmap.
-	MissedBlocksCounter int64 `protobuf:"varint,6,opt,name=missed_blocks_counter,json=missedBlocksCounter,proto3" json:"missed_blocks_counter,omitempty"`
-}
-
-func (x *ValidatorSigningInfo) Reset() {
-	*x = ValidatorSigningInfo{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_cosmos_slashing_v1beta1_slashing_proto_msgTypes[0]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
-	}
+func init() {
+	file_cosmos_slashing_v1beta1_slashing_proto_init()
+	md_ValidatorPerformance = File_cosmos_slashing_v1beta1_slashing_proto.Messages().ByName("ValidatorPerformance")
+	fd_ValidatorPerformance_signed_blocks = md_ValidatorPerformance.Fields().ByName("signed_blocks")
+	fd_ValidatorPerformance_missed_blocks = md_ValidatorPerformance.Fields().ByName("missed_blocks")
+	fd_ValidatorPerformance_proposed_blocks = md_ValidatorPerformance.Fields().ByName("proposed_blocks")
+	fd_ValidatorPerformance_gov_votes = md_ValidatorPerformance.Fields().ByName("gov_votes")
+	fd_ValidatorPerformance_slash_events = md_ValidatorPerformance.Fields().ByName("slash_events")
 }
 
-func (x *ValidatorSigningInfo) String() string {
-	return protoimpl.X.MessageStringOf(x)
-}
+var _ protoreflect.Message = (*fastReflection_ValidatorPerformance)(nil)
 
-func (*ValidatorSigningInfo) ProtoMessage() {}
+type fastReflection_ValidatorPerformance ValidatorPerformance
 
-// Deprecated: Use ValidatorSigningInfo.ProtoReflect.Descriptor instead.
-func (*ValidatorSigningInfo) Descriptor() ([]byte, []int) {
-	return file_cosmos_slashing_v1beta1_slashing_proto_rawDescGZIP(), []int{0}
+func (x *ValidatorPerformance) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_ValidatorPerformance)(x)
 }
 
-func (x *ValidatorSigningInfo) GetAddress() string {
-	if x != nil {
-		return x.Address
+func (x *ValidatorPerformance) slowProtoReflect() protoreflect.Message {
+	mi := &file_cosmos_slashing_v1beta1_slashing_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return ""
+	return mi.MessageOf(x)
 }
 
-func (x *ValidatorSigningInfo) GetStartHeight() int64 {
-	if x != nil {
-		return x.StartHeight
-	}
-	return 0
-}
+var _fastReflection_ValidatorPerformance_messageType fastReflection_ValidatorPerformance_messageType
+var _ protoreflect.MessageType = fastReflection_ValidatorPerformance_messageType{}
 
-func (x *ValidatorSigningInfo) GetIndexOffset() int64 {
-	if x != nil {
-		return x.IndexOffset
-	}
-	return 0
-}
+type fastReflection_ValidatorPerformance_messageType struct{}
 
-func (x *ValidatorSigningInfo) GetJailedUntil() *timestamppb.Timestamp {
-	if x != nil {
-		return x.JailedUntil
-	}
-	return nil
+func (x fastReflection_ValidatorPerformance_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_ValidatorPerformance)(nil)
 }
-
-func (x *ValidatorSigningInfo) GetTombstoned() bool {
-	if x != nil {
-		return x.Tombstoned
-	}
-	return false
+func (x fastReflection_ValidatorPerformance_messageType) New() protoreflect.Message {
+	return new(fastReflection_ValidatorPerformance)
 }
-
-func (x *ValidatorSigningInfo) GetMissedBlocksCounter() int64 {
-	if x != nil {
-		return x.MissedBlocksCounter
-	}
-	return 0
+func (x fastReflection_ValidatorPerformance_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_ValidatorPerformance
 }
 
-// Params represents the parameters used for by the slashing module.
-type Params struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
-
-	SignedBlocksWindow      int64                `protobuf:"varint,1,opt,name=signed_blocks_window,json=signedBlocksWindow,proto3" json:"signed_blocks_window,omitempty"`
-	MinSignedPerWindow      []byte               `protobuf:"bytes,2,opt,name=min_signed_per_window,json=minSignedPerWindow,proto3" json:"min_signed_per_window,omitempty"`
-	DowntimeJailDuration    *durationpb.Duration `protobuf:"bytes,3,opt,name=downtime_jail_duration,json=downtimeJailDuration,proto3" json:"downtime_jail_duration,omitempty"`
-	SlashFractionDoubleSign []byte               `protobuf:"bytes,4,opt,name=slash_fraction_double_sign,json=slashFractionDoubleSign,proto3" json:"slash_fraction_double_sign,omitempty"`
-	SlashFractionDowntime   []byte               `protobuf:"bytes,5,opt,name=slash_fraction_downtime,json=slashFractionDowntime,proto3" json:"slash_fraction_downtime,omitempty"`
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_ValidatorPerformance) Descriptor() protoreflect.MessageDescriptor {
+	return md_ValidatorPerformance
 }
 
-func (x *Params) Reset() {
-	*x = Params{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_cosmos_slashing_v1beta1_slashing_proto_msgTypes[1]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
-	}
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_ValidatorPerformance) Type() protoreflect.MessageType {
+	return _fastReflection_ValidatorPerformance_messageType
 }
 
-func (x *Params) String() string {
-	return protoimpl.X.MessageStringOf(x)
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_ValidatorPerformance) New() protoreflect.Message {
+	return new(fastReflection_ValidatorPerformance)
 }
 
-func (*Params) ProtoMessage() {}
-
-// Deprecated: Use Params.ProtoReflect.Descriptor instead.
-func (*Params) Descriptor() ([]byte, []int) {
-	return file_cosmos_slashing_v1beta1_slashing_proto_rawDescGZIP(), []int{1}
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_ValidatorPerformance) Interface() protoreflect.ProtoMessage {
+	return (*ValidatorPerformance)(x)
 }
 
-func (x *Params) GetSignedBlocksWindow() int64 {
-	if x != nil {
-		return x.SignedBlocksWindow
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_ValidatorPerformance) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.SignedBlocks != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.SignedBlocks)
+		if !f(fd_ValidatorPerformance_signed_blocks, value) {
+			return
+		}
 	}
-	return 0
-}
-
-func (x *Params) GetMinSignedPerWindow() []byte {
-	if x != nil {
-		return x.MinSignedPerWindow
+	if x.MissedBlocks != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.MissedBlocks)
+		if !f(fd_ValidatorPerformance_missed_blocks, value) {
+			return
+		}
 	}
-	return nil
-}
-
-func (x *Params) GetDowntimeJailDuration() *durationpb.Duration {
-	if x != nil {
-		return x.DowntimeJailDuration
+	if x.ProposedBlocks != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.ProposedBlocks)
+		if !f(fd_ValidatorPerformance_proposed_blocks, value) {
+			return
+		}
 	}
-	return nil
-}
-
-func (x *Params) GetSlashFractionDoubleSign() []byte {
-	if x != nil {
-		return x.SlashFractionDoubleSign
+	if x.GovVotes != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.GovVotes)
+		if !f(fd_ValidatorPerformance_gov_votes, value) {
+			return
+		}
+	}
+	if x.SlashEvents != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.SlashEvents)
+		if !f(fd_ValidatorPerformance_slash_events, value) {
+			return
+		}
 	}
-	return nil
 }
 
-func (x *Params) GetSlashFractionDowntime() []byte {
-	if x != nil {
-		return x.SlashFractionDowntime
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_ValidatorPerformance) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "cosmos.slashing.v1beta1.ValidatorPerformance.signed_blocks":
+		return x.SignedBlocks != uint64(0)
+	case "cosmos.slashing.v1beta1.ValidatorPerformance.missed_blocks":
+		return x.MissedBlocks != uint64(0)
+	case "cosmos.slashing.v1beta1.ValidatorPerformance.proposed_blocks":
+		return x.ProposedBlocks != uint64(0)
+	case "cosmos.slashing.v1beta1.ValidatorPerformance.gov_votes":
+		return x.GovVotes != uint64(0)
+	case "cosmos.slashing.v1beta1.ValidatorPerformance.slash_events":
+		return x.SlashEvents != uint64(0)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.slashing.v1beta1.ValidatorPerformance"))
+		}
+		panic(fmt.Errorf("message cosmos.slashing.v1beta1.ValidatorPerformance does not contain field %s", fd.FullName()))
 	}
-	return nil
 }
 
-var File_cosmos_slashing_v1beta1_slashing_proto protoreflect.FileDescriptor
-
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_ValidatorPerformance) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "cosmos.slashing.v1beta1.ValidatorPerformance.signed_blocks":
+		x.SignedBlocks = uint64(0)
+	case "cosmos.slashing.v1beta1.ValidatorPerformance.missed_blocks":
+		x.MissedBlocks = uint64(0)
+	case "cosmos.slashing.v1beta1.ValidatorPerformance.proposed_blocks":
+		x.ProposedBlocks = uint64(0)
+	case "cosmos.slashing.v1beta1.ValidatorPerformance.gov_votes":
+		x.GovVotes = uint64(0)
+	case "cosmos.slashing.v1beta1.ValidatorPerformance.slash_events":
+		x.SlashEvents = uint64(0)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.slashing.v1beta1.ValidatorPerformance"))
+		}
+		panic(fmt.Errorf("message cosmos.slashing.v1beta1.ValidatorPerformance does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_ValidatorPerformance) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "cosmos.slashing.v1beta1.ValidatorPerformance.signed_blocks":
+		value := x.SignedBlocks
+		return protoreflect.ValueOfUint64(value)
+	case "cosmos.slashing.v1beta1.ValidatorPerformance.missed_blocks":
+		value := x.MissedBlocks
+		return protoreflect.ValueOfUint64(value)
+	case "cosmos.slashing.v1beta1.ValidatorPerformance.proposed_blocks":
+		value := x.ProposedBlocks
+		return protoreflect.ValueOfUint64(value)
+	case "cosmos.slashing.v1beta1.ValidatorPerformance.gov_votes":
+		value := x.GovVotes
+		return protoreflect.ValueOfUint64(value)
+	case "cosmos.slashing.v1beta1.ValidatorPerformance.slash_events":
+		value := x.SlashEvents
+		return protoreflect.ValueOfUint64(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.slashing.v1beta1.ValidatorPerformance"))
+		}
+		panic(fmt.Errorf("message cosmos.slashing.v1beta1.ValidatorPerformance does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_ValidatorPerformance) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "cosmos.slashing.v1beta1.ValidatorPerformance.signed_blocks":
+		x.SignedBlocks = value.Uint()
+	case "cosmos.slashing.v1beta1.ValidatorPerformance.missed_blocks":
+		x.MissedBlocks = value.Uint()
+	case "cosmos.slashing.v1beta1.ValidatorPerformance.proposed_blocks":
+		x.ProposedBlocks = value.Uint()
+	case "cosmos.slashing.v1beta1.ValidatorPerformance.gov_votes":
+		x.GovVotes = value.Uint()
+	case "cosmos.slashing.v1beta1.ValidatorPerformance.slash_events":
+		x.SlashEvents = value.Uint()
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.slashing.v1beta1.ValidatorPerformance"))
+		}
+		panic(fmt.Errorf("message cosmos.slashing.v1beta1.ValidatorPerformance does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_ValidatorPerformance) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "cosmos.slashing.v1beta1.ValidatorPerformance.signed_blocks":
+		panic(fmt.Errorf("field signed_blocks of message cosmos.slashing.v1beta1.ValidatorPerformance is not mutable"))
+	case "cosmos.slashing.v1beta1.ValidatorPerformance.missed_blocks":
+		panic(fmt.Errorf("field missed_blocks of message cosmos.slashing.v1beta1.ValidatorPerformance is not mutable"))
+	case "cosmos.slashing.v1beta1.ValidatorPerformance.proposed_blocks":
+		panic(fmt.Errorf("field proposed_blocks of message cosmos.slashing.v1beta1.ValidatorPerformance is not mutable"))
+	case "cosmos.slashing.v1beta1.ValidatorPerformance.gov_votes":
+		panic(fmt.Errorf("field gov_votes of message cosmos.slashing.v1beta1.ValidatorPerformance is not mutable"))
+	case "cosmos.slashing.v1beta1.ValidatorPerformance.slash_events":
+		panic(fmt.Errorf("field slash_events of message cosmos.slashing.v1beta1.ValidatorPerformance is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.slashing.v1beta1.ValidatorPerformance"))
+		}
+		panic(fmt.Errorf("message cosmos.slashing.v1beta1.ValidatorPerformance does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_ValidatorPerformance) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "cosmos.slashing.v1beta1.ValidatorPerformance.signed_blocks":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "cosmos.slashing.v1beta1.ValidatorPerformance.missed_blocks":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "cosmos.slashing.v1beta1.ValidatorPerformance.proposed_blocks":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "cosmos.slashing.v1beta1.ValidatorPerformance.gov_votes":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "cosmos.slashing.v1beta1.ValidatorPerformance.slash_events":
+		return protoreflect.ValueOfUint64(uint64(0))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.slashing.v1beta1.ValidatorPerformance"))
+		}
+		panic(fmt.Errorf("message cosmos.slashing.v1beta1.ValidatorPerformance does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_ValidatorPerformance) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in cosmos.slashing.v1beta1.ValidatorPerformance", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_ValidatorPerformance) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_ValidatorPerformance) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_ValidatorPerformance) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_ValidatorPerformance) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*ValidatorPerformance)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if x.SignedBlocks != 0 {
+			n += 1 + runtime.Sov(uint64(x.SignedBlocks))
+		}
+		if x.MissedBlocks != 0 {
+			n += 1 + runtime.Sov(uint64(x.MissedBlocks))
+		}
+		if x.ProposedBlocks != 0 {
+			n += 1 + runtime.Sov(uint64(x.ProposedBlocks))
+		}
+		if x.GovVotes != 0 {
+			n += 1 + runtime.Sov(uint64(x.GovVotes))
+		}
+		if x.SlashEvents != 0 {
+			n += 1 + runtime.Sov(uint64(x.SlashEvents))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*ValidatorPerformance)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if x.SlashEvents != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.SlashEvents))
+			i--
+			dAtA[i] = 0x28
+		}
+		if x.GovVotes != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.GovVotes))
+			i--
+			dAtA[i] = 0x20
+		}
+		if x.ProposedBlocks != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.ProposedBlocks))
+			i--
+			dAtA[i] = 0x18
+		}
+		if x.MissedBlocks != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.MissedBlocks))
+			i--
+			dAtA[i] = 0x10
+		}
+		if x.SignedBlocks != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.SignedBlocks))
+			i--
+			dAtA[i] = 0x8
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*ValidatorPerformance)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: ValidatorPerformance: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: ValidatorPerformance: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field SignedBlocks", wireType)
+				}
+				x.SignedBlocks = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.SignedBlocks |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 2:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field MissedBlocks", wireType)
+				}
+				x.MissedBlocks = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.MissedBlocks |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 3:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ProposedBlocks", wireType)
+				}
+				x.ProposedBlocks = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.ProposedBlocks |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 4:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field GovVotes", wireType)
+				}
+				x.GovVotes = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.GovVotes |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 5:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field SlashEvents", wireType)
+				}
+				x.SlashEvents = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.SlashEvents |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.27.0
+// 	protoc        (unknown)
+// source: cosmos/slashing/v1beta1/slashing.proto
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// ValidatorSigningInfo defines a validator's signing info for monitoring their
+// liveness activity.
+type ValidatorSigningInfo struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	// Height at which validator was first a candidate OR was un-jailed
+	StartHeight int64 `protobuf:"varint,2,opt,name=start_height,json=startHeight,proto3" json:"start_height,omitempty"`
+	// Index which is incremented every time a validator is bonded in a block and
+	// _may_ have signed a pre-commit or not. This in conjunction with the
+	// signed_blocks_window param determines the index in the missed block bitmap.
+	IndexOffset int64 `protobuf:"varint,3,opt,name=index_offset,json=indexOffset,proto3" json:"index_offset,omitempty"`
+	// Timestamp until which the validator is jailed due to liveness downtime.
+	JailedUntil *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=jailed_until,json=jailedUntil,proto3" json:"jailed_until,omitempty"`
+	// Whether or not a validator has been tombstoned (killed out of validator
+	// set). It is set once the validator commits an equivocation or for any other
+	// configured misbehavior.
+	Tombstoned bool `protobuf:"varint,5,opt,name=tombstoned,proto3" json:"tombstoned,omitempty"`
+	// A counter of missed (unsigned) blocks. It is used to avoid unnecessary
+	// reads in the missed block bitmap.
+	MissedBlocksCounter int64 `protobuf:"varint,6,opt,name=missed_blocks_counter,json=missedBlocksCounter,proto3" json:"missed_blocks_counter,omitempty"`
+	// consecutive_missed_blocks is the number of blocks missed in a row up to
+	// the last block.
+	//
+	// Since: cosmos-sdk 0.48
+	ConsecutiveMissedBlocks int64 `protobuf:"varint,7,opt,name=consecutive_missed_blocks,json=consecutiveMissedBlocks,proto3" json:"consecutive_missed_blocks,omitempty"`
+}
+
+func (x *ValidatorSigningInfo) Reset() {
+	*x = ValidatorSigningInfo{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cosmos_slashing_v1beta1_slashing_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ValidatorSigningInfo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ValidatorSigningInfo) ProtoMessage() {}
+
+// Deprecated: Use ValidatorSigningInfo.ProtoReflect.Descriptor instead.
+func (*ValidatorSigningInfo) Descriptor() ([]byte, []int) {
+	return file_cosmos_slashing_v1beta1_slashing_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *ValidatorSigningInfo) GetAddress() string {
+	if x != nil {
+		return x.Address
+	}
+	return ""
+}
+
+func (x *ValidatorSigningInfo) GetStartHeight() int64 {
+	if x != nil {
+		return x.StartHeight
+	}
+	return 0
+}
+
+func (x *ValidatorSigningInfo) GetIndexOffset() int64 {
+	if x != nil {
+		return x.IndexOffset
+	}
+	return 0
+}
+
+func (x *ValidatorSigningInfo) GetJailedUntil() *timestamppb.Timestamp {
+	if x != nil {
+		return x.JailedUntil
+	}
+	return nil
+}
+
+func (x *ValidatorSigningInfo) GetTombstoned() bool {
+	if x != nil {
+		return x.Tombstoned
+	}
+	return false
+}
+
+func (x *ValidatorSigningInfo) GetMissedBlocksCounter() int64 {
+	if x != nil {
+		return x.MissedBlocksCounter
+	}
+	return 0
+}
+
+func (x *ValidatorSigningInfo) GetConsecutiveMissedBlocks() int64 {
+	if x != nil {
+		return x.ConsecutiveMissedBlocks
+	}
+	return 0
+}
+
+// Params represents the parameters used for by the slashing module.
+type Params struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	SignedBlocksWindow      int64                `protobuf:"varint,1,opt,name=signed_blocks_window,json=signedBlocksWindow,proto3" json:"signed_blocks_window,omitempty"`
+	MinSignedPerWindow      []byte               `protobuf:"bytes,2,opt,name=min_signed_per_window,json=minSignedPerWindow,proto3" json:"min_signed_per_window,omitempty"`
+	DowntimeJailDuration    *durationpb.Duration `protobuf:"bytes,3,opt,name=downtime_jail_duration,json=downtimeJailDuration,proto3" json:"downtime_jail_duration,omitempty"`
+	SlashFractionDoubleSign []byte               `protobuf:"bytes,4,opt,name=slash_fraction_double_sign,json=slashFractionDoubleSign,proto3" json:"slash_fraction_double_sign,omitempty"`
+	SlashFractionDowntime   []byte               `protobuf:"bytes,5,opt,name=slash_fraction_downtime,json=slashFractionDowntime,proto3" json:"slash_fraction_downtime,omitempty"`
+	// performance_epoch_length is the number of blocks of the epochs for which
+	// the performance counters of the validators are aggregated. A zero value
+	// disables the performance tracking.
+	//
+	// Since: cosmos-sdk 0.48
+	PerformanceEpochLength int64 `protobuf:"varint,6,opt,name=performance_epoch_length,json=performanceEpochLength,proto3" json:"performance_epoch_length,omitempty"`
+	// standby_key_activation_blocks is the number of consecutive blocks a
+	// validator must miss for its standby consensus key, if any, to replace its
+	// consensus key. It should be lower than the number of blocks missed in a
+	// window leading to jailing. A zero value disables the standby keys.
+	//
+	// Since: cosmos-sdk 0.48
+	StandbyKeyActivationBlocks int64 `protobuf:"varint,7,opt,name=standby_key_activation_blocks,json=standbyKeyActivationBlocks,proto3" json:"standby_key_activation_blocks,omitempty"`
+}
+
+func (x *Params) Reset() {
+	*x = Params{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cosmos_slashing_v1beta1_slashing_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Params) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Params) ProtoMessage() {}
+
+// Deprecated: Use Params.ProtoReflect.Descriptor instead.
+func (*Params) Descriptor() ([]byte, []int) {
+	return file_cosmos_slashing_v1beta1_slashing_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *Params) GetSignedBlocksWindow() int64 {
+	if x != nil {
+		return x.SignedBlocksWindow
+	}
+	return 0
+}
+
+func (x *Params) GetMinSignedPerWindow() []byte {
+	if x != nil {
+		return x.MinSignedPerWindow
+	}
+	return nil
+}
+
+func (x *Params) GetDowntimeJailDuration() *durationpb.Duration {
+	if x != nil {
+		return x.DowntimeJailDuration
+	}
+	return nil
+}
+
+func (x *Params) GetSlashFractionDoubleSign() []byte {
+	if x != nil {
+		return x.SlashFractionDoubleSign
+	}
+	return nil
+}
+
+func (x *Params) GetSlashFractionDowntime() []byte {
+	if x != nil {
+		return x.SlashFractionDowntime
+	}
+	return nil
+}
+
+func (x *Params) GetPerformanceEpochLength() int64 {
+	if x != nil {
+		return x.PerformanceEpochLength
+	}
+	return 0
+}
+
+func (x *Params) GetStandbyKeyActivationBlocks() int64 {
+	if x != nil {
+		return x.StandbyKeyActivationBlocks
+	}
+	return 0
+}
+
+// ValidatorPerformance defines the performance counters of a validator,
+// aggregated over an epoch or over a height range.
+//
+// Since: cosmos-sdk 0.48
+type ValidatorPerformance struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// signed_blocks is the number of blocks signed by the validator.
+	SignedBlocks uint64 `protobuf:"varint,1,opt,name=signed_blocks,json=signedBlocks,proto3" json:"signed_blocks,omitempty"`
+	// missed_blocks is the number of blocks the validator should have signed but
+	// did not.
+	MissedBlocks uint64 `protobuf:"varint,2,opt,name=missed_blocks,json=missedBlocks,proto3" json:"missed_blocks,omitempty"`
+	// proposed_blocks is the number of blocks proposed by the validator.
+	ProposedBlocks uint64 `protobuf:"varint,3,opt,name=proposed_blocks,json=proposedBlocks,proto3" json:"proposed_blocks,omitempty"`
+	// gov_votes is the number of governance votes cast by the operator account
+	// of the validator.
+	GovVotes uint64 `protobuf:"varint,4,opt,name=gov_votes,json=govVotes,proto3" json:"gov_votes,omitempty"`
+	// slash_events is the number of times the validator was slashed.
+	SlashEvents uint64 `protobuf:"varint,5,opt,name=slash_events,json=slashEvents,proto3" json:"slash_events,omitempty"`
+}
+
+func (x *ValidatorPerformance) Reset() {
+	*x = ValidatorPerformance{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cosmos_slashing_v1beta1_slashing_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ValidatorPerformance) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ValidatorPerformance) ProtoMessage() {}
+
+// Deprecated: Use ValidatorPerformance.ProtoReflect.Descriptor instead.
+func (*ValidatorPerformance) Descriptor() ([]byte, []int) {
+	return file_cosmos_slashing_v1beta1_slashing_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *ValidatorPerformance) GetSignedBlocks() uint64 {
+	if x != nil {
+		return x.SignedBlocks
+	}
+	return 0
+}
+
+func (x *ValidatorPerformance) GetMissedBlocks() uint64 {
+	if x != nil {
+		return x.MissedBlocks
+	}
+	return 0
+}
+
+func (x *ValidatorPerformance) GetProposedBlocks() uint64 {
+	if x != nil {
+		return x.ProposedBlocks
+	}
+	return 0
+}
+
+func (x *ValidatorPerformance) GetGovVotes() uint64 {
+	if x != nil {
+		return x.GovVotes
+	}
+	return 0
+}
+
+func (x *ValidatorPerformance) GetSlashEvents() uint64 {
+	if x != nil {
+		return x.SlashEvents
+	}
+	return 0
+}
+
+var File_cosmos_slashing_v1beta1_slashing_proto protoreflect.FileDescriptor
+
 var file_cosmos_slashing_v1beta1_slashing_proto_rawDesc = []byte{
 	0x0a, 0x26, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2f, 0x73, 0x6c, 0x61, 0x73, 0x68, 0x69, 0x6e,
 	0x67, 0x2f, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2f, 0x73, 0x6c, 0x61, 0x73, 0x68, 0x69,
@@ -1578,7 +2435,7 @@ var file_cosmos_slashing_v1beta1_slashing_proto_rawDesc = []byte{
 	0x6d, 0x70, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x19, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73,
 	0x5f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x70, 0x72,
 	0x6f, 0x74, 0x6f, 0x1a, 0x11, 0x61, 0x6d, 0x69, 0x6e, 0x6f, 0x2f, 0x61, 0x6d, 0x69, 0x6e, 0x6f,
-	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0xc1, 0x02, 0x0a, 0x14, 0x56, 0x61, 0x6c, 0x69, 0x64,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0xfd, 0x02, 0x0a, 0x14, 0x56, 0x61, 0x6c, 0x69, 0x64,
 	0x61, 0x74, 0x6f, 0x72, 0x53, 0x69, 0x67, 0x6e, 0x69, 0x6e, 0x67, 0x49, 0x6e, 0x66, 0x6f, 0x12,
 	0x3b, 0x0a, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
 	0x42, 0x21, 0xd2, 0xb4, 0x2d, 0x1d, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x43, 0x6f, 0x6e,
@@ -1598,59 +2455,83 @@ var file_cosmos_slashing_v1beta1_slashing_proto_rawDesc = []byte{
 	0x12, 0x32, 0x0a, 0x15, 0x6d, 0x69, 0x73, 0x73, 0x65, 0x64, 0x5f, 0x62, 0x6c, 0x6f, 0x63, 0x6b,
 	0x73, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x65, 0x72, 0x18, 0x06, 0x20, 0x01, 0x28, 0x03, 0x52,
 	0x13, 0x6d, 0x69, 0x73, 0x73, 0x65, 0x64, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x73, 0x43, 0x6f, 0x75,
-	0x6e, 0x74, 0x65, 0x72, 0x3a, 0x04, 0xe8, 0xa0, 0x1f, 0x01, 0x22, 0xc5, 0x04, 0x0a, 0x06, 0x50,
-	0x61, 0x72, 0x61, 0x6d, 0x73, 0x12, 0x30, 0x0a, 0x14, 0x73, 0x69, 0x67, 0x6e, 0x65, 0x64, 0x5f,
-	0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x73, 0x5f, 0x77, 0x69, 0x6e, 0x64, 0x6f, 0x77, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x03, 0x52, 0x12, 0x73, 0x69, 0x67, 0x6e, 0x65, 0x64, 0x42, 0x6c, 0x6f, 0x63, 0x6b,
-	0x73, 0x57, 0x69, 0x6e, 0x64, 0x6f, 0x77, 0x12, 0x7b, 0x0a, 0x15, 0x6d, 0x69, 0x6e, 0x5f, 0x73,
-	0x69, 0x67, 0x6e, 0x65, 0x64, 0x5f, 0x70, 0x65, 0x72, 0x5f, 0x77, 0x69, 0x6e, 0x64, 0x6f, 0x77,
-	0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x42, 0x48, 0xc8, 0xde, 0x1f, 0x00, 0xda, 0xde, 0x1f, 0x26,
-	0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f,
-	0x73, 0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2d, 0x73, 0x64, 0x6b, 0x2f, 0x74, 0x79, 0x70,
-	0x65, 0x73, 0x2e, 0x44, 0x65, 0x63, 0x9a, 0xe7, 0xb0, 0x2a, 0x10, 0x63, 0x6f, 0x73, 0x6d, 0x6f,
-	0x73, 0x5f, 0x64, 0x65, 0x63, 0x5f, 0x62, 0x79, 0x74, 0x65, 0x73, 0xa8, 0xe7, 0xb0, 0x2a, 0x01,
-	0x52, 0x12, 0x6d, 0x69, 0x6e, 0x53, 0x69, 0x67, 0x6e, 0x65, 0x64, 0x50, 0x65, 0x72, 0x57, 0x69,
-	0x6e, 0x64, 0x6f, 0x77, 0x12, 0x5e, 0x0a, 0x16, 0x64, 0x6f, 0x77, 0x6e, 0x74, 0x69, 0x6d, 0x65,
-	0x5f, 0x6a, 0x61, 0x69, 0x6c, 0x5f, 0x64, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x03,
-	0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72,
-	0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x42,
-	0x0d, 0xc8, 0xde, 0x1f, 0x00, 0x98, 0xdf, 0x1f, 0x01, 0xa8, 0xe7, 0xb0, 0x2a, 0x01, 0x52, 0x14,
-	0x64, 0x6f, 0x77, 0x6e, 0x74, 0x69, 0x6d, 0x65, 0x4a, 0x61, 0x69, 0x6c, 0x44, 0x75, 0x72, 0x61,
-	0x74, 0x69, 0x6f, 0x6e, 0x12, 0x85, 0x01, 0x0a, 0x1a, 0x73, 0x6c, 0x61, 0x73, 0x68, 0x5f, 0x66,
-	0x72, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x64, 0x6f, 0x75, 0x62, 0x6c, 0x65, 0x5f, 0x73,
-	0x69, 0x67, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0c, 0x42, 0x48, 0xc8, 0xde, 0x1f, 0x00, 0xda,
-	0xde, 0x1f, 0x26, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x63, 0x6f,
-	0x73, 0x6d, 0x6f, 0x73, 0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2d, 0x73, 0x64, 0x6b, 0x2f,
-	0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x44, 0x65, 0x63, 0x9a, 0xe7, 0xb0, 0x2a, 0x10, 0x63, 0x6f,
-	0x73, 0x6d, 0x6f, 0x73, 0x5f, 0x64, 0x65, 0x63, 0x5f, 0x62, 0x79, 0x74, 0x65, 0x73, 0xa8, 0xe7,
-	0xb0, 0x2a, 0x01, 0x52, 0x17, 0x73, 0x6c, 0x61, 0x73, 0x68, 0x46, 0x72, 0x61, 0x63, 0x74, 0x69,
-	0x6f, 0x6e, 0x44, 0x6f, 0x75, 0x62, 0x6c, 0x65, 0x53, 0x69, 0x67, 0x6e, 0x12, 0x80, 0x01, 0x0a,
-	0x17, 0x73, 0x6c, 0x61, 0x73, 0x68, 0x5f, 0x66, 0x72, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x5f,
-	0x64, 0x6f, 0x77, 0x6e, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0c, 0x42, 0x48,
-	0xc8, 0xde, 0x1f, 0x00, 0xda, 0xde, 0x1f, 0x26, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63,
-	0x6f, 0x6d, 0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73,
-	0x2d, 0x73, 0x64, 0x6b, 0x2f, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x44, 0x65, 0x63, 0x9a, 0xe7,
-	0xb0, 0x2a, 0x10, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x5f, 0x64, 0x65, 0x63, 0x5f, 0x62, 0x79,
-	0x74, 0x65, 0x73, 0xa8, 0xe7, 0xb0, 0x2a, 0x01, 0x52, 0x15, 0x73, 0x6c, 0x61, 0x73, 0x68, 0x46,
-	0x72, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x44, 0x6f, 0x77, 0x6e, 0x74, 0x69, 0x6d, 0x65, 0x3a,
-	0x21, 0x8a, 0xe7, 0xb0, 0x2a, 0x1c, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2d, 0x73, 0x64, 0x6b,
-	0x2f, 0x78, 0x2f, 0x73, 0x6c, 0x61, 0x73, 0x68, 0x69, 0x6e, 0x67, 0x2f, 0x50, 0x61, 0x72, 0x61,
-	0x6d, 0x73, 0x42, 0xe8, 0x01, 0x0a, 0x1b, 0x63, 0x6f, 0x6d, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f,
-	0x73, 0x2e, 0x73, 0x6c, 0x61, 0x73, 0x68, 0x69, 0x6e, 0x67, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74,
-	0x61, 0x31, 0x42, 0x0d, 0x53, 0x6c, 0x61, 0x73, 0x68, 0x69, 0x6e, 0x67, 0x50, 0x72, 0x6f, 0x74,
-	0x6f, 0x50, 0x01, 0x5a, 0x38, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69,
-	0x6f, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2f, 0x73, 0x6c, 0x61,
-	0x73, 0x68, 0x69, 0x6e, 0x67, 0x2f, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x3b, 0x73, 0x6c,
-	0x61, 0x73, 0x68, 0x69, 0x6e, 0x67, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0xa2, 0x02, 0x03,
-	0x43, 0x53, 0x58, 0xaa, 0x02, 0x17, 0x43, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x53, 0x6c, 0x61,
-	0x73, 0x68, 0x69, 0x6e, 0x67, 0x2e, 0x56, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0xca, 0x02, 0x17,
-	0x43, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x5c, 0x53, 0x6c, 0x61, 0x73, 0x68, 0x69, 0x6e, 0x67, 0x5c,
-	0x56, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0xe2, 0x02, 0x23, 0x43, 0x6f, 0x73, 0x6d, 0x6f, 0x73,
-	0x5c, 0x53, 0x6c, 0x61, 0x73, 0x68, 0x69, 0x6e, 0x67, 0x5c, 0x56, 0x31, 0x62, 0x65, 0x74, 0x61,
-	0x31, 0x5c, 0x47, 0x50, 0x42, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0xea, 0x02, 0x19,
-	0x43, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x3a, 0x3a, 0x53, 0x6c, 0x61, 0x73, 0x68, 0x69, 0x6e, 0x67,
-	0x3a, 0x3a, 0x56, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0xa8, 0xe2, 0x1e, 0x01, 0x62, 0x06, 0x70,
-	0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x6e, 0x74, 0x65, 0x72, 0x12, 0x3a, 0x0a, 0x19, 0x63, 0x6f, 0x6e, 0x73, 0x65, 0x63, 0x75, 0x74,
+	0x69, 0x76, 0x65, 0x5f, 0x6d, 0x69, 0x73, 0x73, 0x65, 0x64, 0x5f, 0x62, 0x6c, 0x6f, 0x63, 0x6b,
+	0x73, 0x18, 0x07, 0x20, 0x01, 0x28, 0x03, 0x52, 0x17, 0x63, 0x6f, 0x6e, 0x73, 0x65, 0x63, 0x75,
+	0x74, 0x69, 0x76, 0x65, 0x4d, 0x69, 0x73, 0x73, 0x65, 0x64, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x73,
+	0x3a, 0x04, 0xe8, 0xa0, 0x1f, 0x01, 0x22, 0xc2, 0x05, 0x0a, 0x06, 0x50, 0x61, 0x72, 0x61, 0x6d,
+	0x73, 0x12, 0x30, 0x0a, 0x14, 0x73, 0x69, 0x67, 0x6e, 0x65, 0x64, 0x5f, 0x62, 0x6c, 0x6f, 0x63,
+	0x6b, 0x73, 0x5f, 0x77, 0x69, 0x6e, 0x64, 0x6f, 0x77, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x12, 0x73, 0x69, 0x67, 0x6e, 0x65, 0x64, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x73, 0x57, 0x69, 0x6e,
+	0x64, 0x6f, 0x77, 0x12, 0x7b, 0x0a, 0x15, 0x6d, 0x69, 0x6e, 0x5f, 0x73, 0x69, 0x67, 0x6e, 0x65,
+	0x64, 0x5f, 0x70, 0x65, 0x72, 0x5f, 0x77, 0x69, 0x6e, 0x64, 0x6f, 0x77, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x0c, 0x42, 0x48, 0xc8, 0xde, 0x1f, 0x00, 0xda, 0xde, 0x1f, 0x26, 0x67, 0x69, 0x74, 0x68,
+	0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2f, 0x63, 0x6f,
+	0x73, 0x6d, 0x6f, 0x73, 0x2d, 0x73, 0x64, 0x6b, 0x2f, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x44,
+	0x65, 0x63, 0x9a, 0xe7, 0xb0, 0x2a, 0x10, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x5f, 0x64, 0x65,
+	0x63, 0x5f, 0x62, 0x79, 0x74, 0x65, 0x73, 0xa8, 0xe7, 0xb0, 0x2a, 0x01, 0x52, 0x12, 0x6d, 0x69,
+	0x6e, 0x53, 0x69, 0x67, 0x6e, 0x65, 0x64, 0x50, 0x65, 0x72, 0x57, 0x69, 0x6e, 0x64, 0x6f, 0x77,
+	0x12, 0x5e, 0x0a, 0x16, 0x64, 0x6f, 0x77, 0x6e, 0x74, 0x69, 0x6d, 0x65, 0x5f, 0x6a, 0x61, 0x69,
+	0x6c, 0x5f, 0x64, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x19, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
+	0x75, 0x66, 0x2e, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x42, 0x0d, 0xc8, 0xde, 0x1f,
+	0x00, 0x98, 0xdf, 0x1f, 0x01, 0xa8, 0xe7, 0xb0, 0x2a, 0x01, 0x52, 0x14, 0x64, 0x6f, 0x77, 0x6e,
+	0x74, 0x69, 0x6d, 0x65, 0x4a, 0x61, 0x69, 0x6c, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x12, 0x85, 0x01, 0x0a, 0x1a, 0x73, 0x6c, 0x61, 0x73, 0x68, 0x5f, 0x66, 0x72, 0x61, 0x63, 0x74,
+	0x69, 0x6f, 0x6e, 0x5f, 0x64, 0x6f, 0x75, 0x62, 0x6c, 0x65, 0x5f, 0x73, 0x69, 0x67, 0x6e, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x0c, 0x42, 0x48, 0xc8, 0xde, 0x1f, 0x00, 0xda, 0xde, 0x1f, 0x26, 0x67,
+	0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73,
+	0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2d, 0x73, 0x64, 0x6b, 0x2f, 0x74, 0x79, 0x70, 0x65,
+	0x73, 0x2e, 0x44, 0x65, 0x63, 0x9a, 0xe7, 0xb0, 0x2a, 0x10, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73,
+	0x5f, 0x64, 0x65, 0x63, 0x5f, 0x62, 0x79, 0x74, 0x65, 0x73, 0xa8, 0xe7, 0xb0, 0x2a, 0x01, 0x52,
+	0x17, 0x73, 0x6c, 0x61, 0x73, 0x68, 0x46, 0x72, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x44, 0x6f,
+	0x75, 0x62, 0x6c, 0x65, 0x53, 0x69, 0x67, 0x6e, 0x12, 0x80, 0x01, 0x0a, 0x17, 0x73, 0x6c, 0x61,
+	0x73, 0x68, 0x5f, 0x66, 0x72, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x64, 0x6f, 0x77, 0x6e,
+	0x74, 0x69, 0x6d, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0c, 0x42, 0x48, 0xc8, 0xde, 0x1f, 0x00,
+	0xda, 0xde, 0x1f, 0x26, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x63,
+	0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2d, 0x73, 0x64, 0x6b,
+	0x2f, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x44, 0x65, 0x63, 0x9a, 0xe7, 0xb0, 0x2a, 0x10, 0x63,
+	0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x5f, 0x64, 0x65, 0x63, 0x5f, 0x62, 0x79, 0x74, 0x65, 0x73, 0xa8,
+	0xe7, 0xb0, 0x2a, 0x01, 0x52, 0x15, 0x73, 0x6c, 0x61, 0x73, 0x68, 0x46, 0x72, 0x61, 0x63, 0x74,
+	0x69, 0x6f, 0x6e, 0x44, 0x6f, 0x77, 0x6e, 0x74, 0x69, 0x6d, 0x65, 0x12, 0x38, 0x0a, 0x18, 0x70,
+	0x65, 0x72, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x6e, 0x63, 0x65, 0x5f, 0x65, 0x70, 0x6f, 0x63, 0x68,
+	0x5f, 0x6c, 0x65, 0x6e, 0x67, 0x74, 0x68, 0x18, 0x06, 0x20, 0x01, 0x28, 0x03, 0x52, 0x16, 0x70,
+	0x65, 0x72, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x6e, 0x63, 0x65, 0x45, 0x70, 0x6f, 0x63, 0x68, 0x4c,
+	0x65, 0x6e, 0x67, 0x74, 0x68, 0x12, 0x41, 0x0a, 0x1d, 0x73, 0x74, 0x61, 0x6e, 0x64, 0x62, 0x79,
+	0x5f, 0x6b, 0x65, 0x79, 0x5f, 0x61, 0x63, 0x74, 0x69, 0x76, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f,
+	0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x73, 0x18, 0x07, 0x20, 0x01, 0x28, 0x03, 0x52, 0x1a, 0x73, 0x74,
+	0x61, 0x6e, 0x64, 0x62, 0x79, 0x4b, 0x65, 0x79, 0x41, 0x63, 0x74, 0x69, 0x76, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x73, 0x3a, 0x21, 0x8a, 0xe7, 0xb0, 0x2a, 0x1c, 0x63,
+	0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2d, 0x73, 0x64, 0x6b, 0x2f, 0x78, 0x2f, 0x73, 0x6c, 0x61, 0x73,
+	0x68, 0x69, 0x6e, 0x67, 0x2f, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x22, 0xc9, 0x01, 0x0a, 0x14,
+	0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x50, 0x65, 0x72, 0x66, 0x6f, 0x72, 0x6d,
+	0x61, 0x6e, 0x63, 0x65, 0x12, 0x23, 0x0a, 0x0d, 0x73, 0x69, 0x67, 0x6e, 0x65, 0x64, 0x5f, 0x62,
+	0x6c, 0x6f, 0x63, 0x6b, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0c, 0x73, 0x69, 0x67,
+	0x6e, 0x65, 0x64, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x73, 0x12, 0x23, 0x0a, 0x0d, 0x6d, 0x69, 0x73,
+	0x73, 0x65, 0x64, 0x5f, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04,
+	0x52, 0x0c, 0x6d, 0x69, 0x73, 0x73, 0x65, 0x64, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x73, 0x12, 0x27,
+	0x0a, 0x0f, 0x70, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x65, 0x64, 0x5f, 0x62, 0x6c, 0x6f, 0x63, 0x6b,
+	0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0e, 0x70, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x65,
+	0x64, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x73, 0x12, 0x1b, 0x0a, 0x09, 0x67, 0x6f, 0x76, 0x5f, 0x76,
+	0x6f, 0x74, 0x65, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x04, 0x52, 0x08, 0x67, 0x6f, 0x76, 0x56,
+	0x6f, 0x74, 0x65, 0x73, 0x12, 0x21, 0x0a, 0x0c, 0x73, 0x6c, 0x61, 0x73, 0x68, 0x5f, 0x65, 0x76,
+	0x65, 0x6e, 0x74, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0b, 0x73, 0x6c, 0x61, 0x73,
+	0x68, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x42, 0xe8, 0x01, 0x0a, 0x1b, 0x63, 0x6f, 0x6d, 0x2e,
+	0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x73, 0x6c, 0x61, 0x73, 0x68, 0x69, 0x6e, 0x67, 0x2e,
+	0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x42, 0x0d, 0x53, 0x6c, 0x61, 0x73, 0x68, 0x69, 0x6e,
+	0x67, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50, 0x01, 0x5a, 0x38, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73,
+	0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f,
+	0x73, 0x2f, 0x73, 0x6c, 0x61, 0x73, 0x68, 0x69, 0x6e, 0x67, 0x2f, 0x76, 0x31, 0x62, 0x65, 0x74,
+	0x61, 0x31, 0x3b, 0x73, 0x6c, 0x61, 0x73, 0x68, 0x69, 0x6e, 0x67, 0x76, 0x31, 0x62, 0x65, 0x74,
+	0x61, 0x31, 0xa2, 0x02, 0x03, 0x43, 0x53, 0x58, 0xaa, 0x02, 0x17, 0x43, 0x6f, 0x73, 0x6d, 0x6f,
+	0x73, 0x2e, 0x53, 0x6c, 0x61, 0x73, 0x68, 0x69, 0x6e, 0x67, 0x2e, 0x56, 0x31, 0x62, 0x65, 0x74,
+	0x61, 0x31, 0xca, 0x02, 0x17, 0x43, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x5c, 0x53, 0x6c, 0x61, 0x73,
+	0x68, 0x69, 0x6e, 0x67, 0x5c, 0x56, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0xe2, 0x02, 0x23, 0x43,
+	0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x5c, 0x53, 0x6c, 0x61, 0x73, 0x68, 0x69, 0x6e, 0x67, 0x5c, 0x56,
+	0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x5c, 0x47, 0x50, 0x42, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61,
+	0x74, 0x61, 0xea, 0x02, 0x19, 0x43, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x3a, 0x3a, 0x53, 0x6c, 0x61,
+	0x73, 0x68, 0x69, 0x6e, 0x67, 0x3a, 0x3a, 0x56, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0xa8, 0xe2,
+	0x1e, 0x01, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -1665,16 +2546,17 @@ func file_cosmos_slashing_v1beta1_slashing_proto_rawDescGZIP() []byte {
 	return file_cosmos_slashing_v1beta1_slashing_proto_rawDescData
 }
 
-var file_cosmos_slashing_v1beta1_slashing_proto_msgTypes = make([]protoimpl.MessageInfo, 2)
+var file_cosmos_slashing_v1beta1_slashing_proto_msgTypes = make([]protoimpl.MessageInfo, 3)
 var file_cosmos_slashing_v1beta1_slashing_proto_goTypes = []interface{}{
 	(*ValidatorSigningInfo)(nil),  // 0: cosmos.slashing.v1beta1.ValidatorSigningInfo
 	(*Params)(nil),                // 1: cosmos.slashing.v1beta1.Params
-	(*timestamppb.Timestamp)(nil), // 2: google.protobuf.Timestamp
-	(*durationpb.Duration)(nil),   // 3: google.protobuf.Duration
+	(*ValidatorPerformance)(nil),  // 2: cosmos.slashing.v1beta1.ValidatorPerformance
+	(*timestamppb.Timestamp)(nil), // 3: google.protobuf.Timestamp
+	(*durationpb.Duration)(nil),   // 4: google.protobuf.Duration
 }
 var file_cosmos_slashing_v1beta1_slashing_proto_depIdxs = []int32{
-	2, // 0: cosmos.slashing.v1beta1.ValidatorSigningInfo.jailed_until:type_name -> google.protobuf.Timestamp
-	3, // 1: cosmos.slashing.v1beta1.Params.downtime_jail_duration:type_name -> google.protobuf.Duration
+	3, // 0: cosmos.slashing.v1beta1.ValidatorSigningInfo.jailed_until:type_name -> google.protobuf.Timestamp
+	4, // 1: cosmos.slashing.v1beta1.Params.downtime_jail_duration:type_name -> google.protobuf.Duration
 	2, // [2:2] is the sub-list for method output_type
 	2, // [2:2] is the sub-list for method input_type
 	2, // [2:2] is the sub-list for extension type_name
@@ -1712,6 +2594,18 @@ func file_cosmos_slashing_v1beta1_slashing_proto_init() {
 				return nil
 			}
 		}
+		file_cosmos_slashing_v1beta1_slashing_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ValidatorPerformance); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
 	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
@@ -1719,7 +2613,7 @@ func file_cosmos_slashing_v1beta1_slashing_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_cosmos_slashing_v1beta1_slashing_proto_rawDesc,
 			NumEnums:      0,
-			NumMessages:   2,
+			NumMessages:   3,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
@@ -270,16 +270,435 @@ func (x *_GenesisState_7_list) IsValid() bool {
 	return x.list != nil
 }
 
+var _ protoreflect.List = (*_GenesisState_9_list)(nil)
+
+type _GenesisState_9_list struct {
+	list *[]*TokenizeShareRecord
+}
+
+func (x *_GenesisState_9_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_GenesisState_9_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_GenesisState_9_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*TokenizeShareRecord)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_GenesisState_9_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*TokenizeShareRecord)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_GenesisState_9_list) AppendMutable() protoreflect.Value {
+	v := new(TokenizeShareRecord)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_GenesisState_9_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_GenesisState_9_list) NewElement() protoreflect.Value {
+	v := new(TokenizeShareRecord)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_GenesisState_9_list) IsValid() bool {
+	return x.list != nil
+}
+
+var _ protoreflect.List = (*_GenesisState_12_list)(nil)
+
+type _GenesisState_12_list struct {
+	list *[]*PendingCommissionChange
+}
+
+func (x *_GenesisState_12_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_GenesisState_12_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_GenesisState_12_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*PendingCommissionChange)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_GenesisState_12_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*PendingCommissionChange)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_GenesisState_12_list) AppendMutable() protoreflect.Value {
+	v := new(PendingCommissionChange)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_GenesisState_12_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_GenesisState_12_list) NewElement() protoreflect.Value {
+	v := new(PendingCommissionChange)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_GenesisState_12_list) IsValid() bool {
+	return x.list != nil
+}
+
+var _ protoreflect.List = (*_GenesisState_13_list)(nil)
+
+type _GenesisState_13_list struct {
+	list *[]*QueuedEpochMsg
+}
+
+func (x *_GenesisState_13_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_GenesisState_13_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_GenesisState_13_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*QueuedEpochMsg)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_GenesisState_13_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*QueuedEpochMsg)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_GenesisState_13_list) AppendMutable() protoreflect.Value {
+	v := new(QueuedEpochMsg)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_GenesisState_13_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_GenesisState_13_list) NewElement() protoreflect.Value {
+	v := new(QueuedEpochMsg)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_GenesisState_13_list) IsValid() bool {
+	return x.list != nil
+}
+
+var _ protoreflect.List = (*_GenesisState_14_list)(nil)
+
+type _GenesisState_14_list struct {
+	list *[]*DelegationLock
+}
+
+func (x *_GenesisState_14_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_GenesisState_14_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_GenesisState_14_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*DelegationLock)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_GenesisState_14_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*DelegationLock)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_GenesisState_14_list) AppendMutable() protoreflect.Value {
+	v := new(DelegationLock)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_GenesisState_14_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_GenesisState_14_list) NewElement() protoreflect.Value {
+	v := new(DelegationLock)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_GenesisState_14_list) IsValid() bool {
+	return x.list != nil
+}
+
+var _ protoreflect.List = (*_GenesisState_15_list)(nil)
+
+type _GenesisState_15_list struct {
+	list *[]*ValidatorRiskTier
+}
+
+func (x *_GenesisState_15_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_GenesisState_15_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_GenesisState_15_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*ValidatorRiskTier)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_GenesisState_15_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*ValidatorRiskTier)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_GenesisState_15_list) AppendMutable() protoreflect.Value {
+	v := new(ValidatorRiskTier)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_GenesisState_15_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_GenesisState_15_list) NewElement() protoreflect.Value {
+	v := new(ValidatorRiskTier)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_GenesisState_15_list) IsValid() bool {
+	return x.list != nil
+}
+
+var _ protoreflect.List = (*_GenesisState_16_list)(nil)
+
+type _GenesisState_16_list struct {
+	list *[]*ValidatorJailInfo
+}
+
+func (x *_GenesisState_16_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_GenesisState_16_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_GenesisState_16_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*ValidatorJailInfo)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_GenesisState_16_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*ValidatorJailInfo)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_GenesisState_16_list) AppendMutable() protoreflect.Value {
+	v := new(ValidatorJailInfo)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_GenesisState_16_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_GenesisState_16_list) NewElement() protoreflect.Value {
+	v := new(ValidatorJailInfo)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_GenesisState_16_list) IsValid() bool {
+	return x.list != nil
+}
+
+var _ protoreflect.List = (*_GenesisState_18_list)(nil)
+
+type _GenesisState_18_list struct {
+	list *[]*ProfileAttestation
+}
+
+func (x *_GenesisState_18_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_GenesisState_18_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_GenesisState_18_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*ProfileAttestation)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_GenesisState_18_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*ProfileAttestation)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_GenesisState_18_list) AppendMutable() protoreflect.Value {
+	v := new(ProfileAttestation)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_GenesisState_18_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_GenesisState_18_list) NewElement() protoreflect.Value {
+	v := new(ProfileAttestation)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_GenesisState_18_list) IsValid() bool {
+	return x.list != nil
+}
+
+var _ protoreflect.List = (*_GenesisState_19_list)(nil)
+
+type _GenesisState_19_list struct {
+	list *[]*StandbyConsPubKey
+}
+
+func (x *_GenesisState_19_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_GenesisState_19_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_GenesisState_19_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*StandbyConsPubKey)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_GenesisState_19_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*StandbyConsPubKey)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_GenesisState_19_list) AppendMutable() protoreflect.Value {
+	v := new(StandbyConsPubKey)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_GenesisState_19_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_GenesisState_19_list) NewElement() protoreflect.Value {
+	v := new(StandbyConsPubKey)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_GenesisState_19_list) IsValid() bool {
+	return x.list != nil
+}
+
 var (
-	md_GenesisState                       protoreflect.MessageDescriptor
-	fd_GenesisState_params                protoreflect.FieldDescriptor
-	fd_GenesisState_last_total_power      protoreflect.FieldDescriptor
-	fd_GenesisState_last_validator_powers protoreflect.FieldDescriptor
-	fd_GenesisState_validators            protoreflect.FieldDescriptor
-	fd_GenesisState_delegations           protoreflect.FieldDescriptor
-	fd_GenesisState_unbonding_delegations protoreflect.FieldDescriptor
-	fd_GenesisState_redelegations         protoreflect.FieldDescriptor
-	fd_GenesisState_exported              protoreflect.FieldDescriptor
+	md_GenesisState                               protoreflect.MessageDescriptor
+	fd_GenesisState_params                        protoreflect.FieldDescriptor
+	fd_GenesisState_last_total_power              protoreflect.FieldDescriptor
+	fd_GenesisState_last_validator_powers         protoreflect.FieldDescriptor
+	fd_GenesisState_validators                    protoreflect.FieldDescriptor
+	fd_GenesisState_delegations                   protoreflect.FieldDescriptor
+	fd_GenesisState_unbonding_delegations         protoreflect.FieldDescriptor
+	fd_GenesisState_redelegations                 protoreflect.FieldDescriptor
+	fd_GenesisState_exported                      protoreflect.FieldDescriptor
+	fd_GenesisState_tokenize_share_records        protoreflect.FieldDescriptor
+	fd_GenesisState_last_tokenize_share_record_id protoreflect.FieldDescriptor
+	fd_GenesisState_total_liquid_staked_tokens    protoreflect.FieldDescriptor
+	fd_GenesisState_pending_commission_changes    protoreflect.FieldDescriptor
+	fd_GenesisState_queued_epoch_msgs             protoreflect.FieldDescriptor
+	fd_GenesisState_delegation_locks              protoreflect.FieldDescriptor
+	fd_GenesisState_validator_risk_tiers          protoreflect.FieldDescriptor
+	fd_GenesisState_validator_jail_infos          protoreflect.FieldDescriptor
+	fd_GenesisState_staking_paused                protoreflect.FieldDescriptor
+	fd_GenesisState_profile_attestations          protoreflect.FieldDescriptor
+	fd_GenesisState_standby_cons_pubkeys          protoreflect.FieldDescriptor
 )
 
 func init() {
@@ -293,6 +712,17 @@ func init() {
 	fd_GenesisState_unbonding_delegations = md_GenesisState.Fields().ByName("unbonding_delegations")
 	fd_GenesisState_redelegations = md_GenesisState.Fields().ByName("redelegations")
 	fd_GenesisState_exported = md_GenesisState.Fields().ByName("exported")
+	fd_GenesisState_tokenize_share_records = md_GenesisState.Fields().ByName("tokenize_share_records")
+	fd_GenesisState_last_tokenize_share_record_id = md_GenesisState.Fields().ByName("last_tokenize_share_record_id")
+	fd_GenesisState_total_liquid_staked_tokens = md_GenesisState.Fields().ByName("total_liquid_staked_tokens")
+	fd_GenesisState_pending_commission_changes = md_GenesisState.Fields().ByName("pending_commission_changes")
+	fd_GenesisState_queued_epoch_msgs = md_GenesisState.Fields().ByName("queued_epoch_msgs")
+	fd_GenesisState_delegation_locks = md_GenesisState.Fields().ByName("delegation_locks")
+	fd_GenesisState_validator_risk_tiers = md_GenesisState.Fields().ByName("validator_risk_tiers")
+	fd_GenesisState_validator_jail_infos = md_GenesisState.Fields().ByName("validator_jail_infos")
+	fd_GenesisState_staking_paused = md_GenesisState.Fields().ByName("staking_paused")
+	fd_GenesisState_profile_attestations = md_GenesisState.Fields().ByName("profile_attestations")
+	fd_GenesisState_standby_cons_pubkeys = md_GenesisState.Fields().ByName("standby_cons_pubkeys")
 }
 
 var _ protoreflect.Message = (*fastReflection_GenesisState)(nil)
@@ -408,6 +838,72 @@ func (x *fastReflection_GenesisState) Range(f func(protoreflect.FieldDescriptor,
 			return
 		}
 	}
+	if len(x.TokenizeShareRecords) != 0 {
+		value := protoreflect.ValueOfList(&_GenesisState_9_list{list: &x.TokenizeShareRecords})
+		if !f(fd_GenesisState_tokenize_share_records, value) {
+			return
+		}
+	}
+	if x.LastTokenizeShareRecordId != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.LastTokenizeShareRecordId)
+		if !f(fd_GenesisState_last_tokenize_share_record_id, value) {
+			return
+		}
+	}
+	if len(x.TotalLiquidStakedTokens) != 0 {
+		value := protoreflect.ValueOfBytes(x.TotalLiquidStakedTokens)
+		if !f(fd_GenesisState_total_liquid_staked_tokens, value) {
+			return
+		}
+	}
+	if len(x.PendingCommissionChanges) != 0 {
+		value := protoreflect.ValueOfList(&_GenesisState_12_list{list: &x.PendingCommissionChanges})
+		if !f(fd_GenesisState_pending_commission_changes, value) {
+			return
+		}
+	}
+	if len(x.QueuedEpochMsgs) != 0 {
+		value := protoreflect.ValueOfList(&_GenesisState_13_list{list: &x.QueuedEpochMsgs})
+		if !f(fd_GenesisState_queued_epoch_msgs, value) {
+			return
+		}
+	}
+	if len(x.DelegationLocks) != 0 {
+		value := protoreflect.ValueOfList(&_GenesisState_14_list{list: &x.DelegationLocks})
+		if !f(fd_GenesisState_delegation_locks, value) {
+			return
+		}
+	}
+	if len(x.ValidatorRiskTiers) != 0 {
+		value := protoreflect.ValueOfList(&_GenesisState_15_list{list: &x.ValidatorRiskTiers})
+		if !f(fd_GenesisState_validator_risk_tiers, value) {
+			return
+		}
+	}
+	if len(x.ValidatorJailInfos) != 0 {
+		value := protoreflect.ValueOfList(&_GenesisState_16_list{list: &x.ValidatorJailInfos})
+		if !f(fd_GenesisState_validator_jail_infos, value) {
+			return
+		}
+	}
+	if x.StakingPaused != false {
+		value := protoreflect.ValueOfBool(x.StakingPaused)
+		if !f(fd_GenesisState_staking_paused, value) {
+			return
+		}
+	}
+	if len(x.ProfileAttestations) != 0 {
+		value := protoreflect.ValueOfList(&_GenesisState_18_list{list: &x.ProfileAttestations})
+		if !f(fd_GenesisState_profile_attestations, value) {
+			return
+		}
+	}
+	if len(x.StandbyConsPubkeys) != 0 {
+		value := protoreflect.ValueOfList(&_GenesisState_19_list{list: &x.StandbyConsPubkeys})
+		if !f(fd_GenesisState_standby_cons_pubkeys, value) {
+			return
+		}
+	}
 }
 
 // Has reports whether a field is populated.
@@ -439,6 +935,28 @@ func (x *fastReflection_GenesisState) Has(fd protoreflect.FieldDescriptor) bool
 		return len(x.Redelegations) != 0
 	case "cosmos.staking.v1beta1.GenesisState.exported":
 		return x.Exported != false
+	case "cosmos.staking.v1beta1.GenesisState.tokenize_share_records":
+		return len(x.TokenizeShareRecords) != 0
+	case "cosmos.staking.v1beta1.GenesisState.last_tokenize_share_record_id":
+		return x.LastTokenizeShareRecordId != uint64(0)
+	case "cosmos.staking.v1beta1.GenesisState.total_liquid_staked_tokens":
+		return len(x.TotalLiquidStakedTokens) != 0
+	case "cosmos.staking.v1beta1.GenesisState.pending_commission_changes":
+		return len(x.PendingCommissionChanges) != 0
+	case "cosmos.staking.v1beta1.GenesisState.queued_epoch_msgs":
+		return len(x.QueuedEpochMsgs) != 0
+	case "cosmos.staking.v1beta1.GenesisState.delegation_locks":
+		return len(x.DelegationLocks) != 0
+	case "cosmos.staking.v1beta1.GenesisState.validator_risk_tiers":
+		return len(x.ValidatorRiskTiers) != 0
+	case "cosmos.staking.v1beta1.GenesisState.validator_jail_infos":
+		return len(x.ValidatorJailInfos) != 0
+	case "cosmos.staking.v1beta1.GenesisState.staking_paused":
+		return x.StakingPaused != false
+	case "cosmos.staking.v1beta1.GenesisState.profile_attestations":
+		return len(x.ProfileAttestations) != 0
+	case "cosmos.staking.v1beta1.GenesisState.standby_cons_pubkeys":
+		return len(x.StandbyConsPubkeys) != 0
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.staking.v1beta1.GenesisState"))
@@ -471,6 +989,28 @@ func (x *fastReflection_GenesisState) Clear(fd protoreflect.FieldDescriptor) {
 		x.Redelegations = nil
 	case "cosmos.staking.v1beta1.GenesisState.exported":
 		x.Exported = false
+	case "cosmos.staking.v1beta1.GenesisState.tokenize_share_records":
+		x.TokenizeShareRecords = nil
+	case "cosmos.staking.v1beta1.GenesisState.last_tokenize_share_record_id":
+		x.LastTokenizeShareRecordId = uint64(0)
+	case "cosmos.staking.v1beta1.GenesisState.total_liquid_staked_tokens":
+		x.TotalLiquidStakedTokens = nil
+	case "cosmos.staking.v1beta1.GenesisState.pending_commission_changes":
+		x.PendingCommissionChanges = nil
+	case "cosmos.staking.v1beta1.GenesisState.queued_epoch_msgs":
+		x.QueuedEpochMsgs = nil
+	case "cosmos.staking.v1beta1.GenesisState.delegation_locks":
+		x.DelegationLocks = nil
+	case "cosmos.staking.v1beta1.GenesisState.validator_risk_tiers":
+		x.ValidatorRiskTiers = nil
+	case "cosmos.staking.v1beta1.GenesisState.validator_jail_infos":
+		x.ValidatorJailInfos = nil
+	case "cosmos.staking.v1beta1.GenesisState.staking_paused":
+		x.StakingPaused = false
+	case "cosmos.staking.v1beta1.GenesisState.profile_attestations":
+		x.ProfileAttestations = nil
+	case "cosmos.staking.v1beta1.GenesisState.standby_cons_pubkeys":
+		x.StandbyConsPubkeys = nil
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.staking.v1beta1.GenesisState"))
@@ -526,6 +1066,63 @@ func (x *fastReflection_GenesisState) Get(descriptor protoreflect.FieldDescripto
 	case "cosmos.staking.v1beta1.GenesisState.exported":
 		value := x.Exported
 		return protoreflect.ValueOfBool(value)
+	case "cosmos.staking.v1beta1.GenesisState.tokenize_share_records":
+		if len(x.TokenizeShareRecords) == 0 {
+			return protoreflect.ValueOfList(&_GenesisState_9_list{})
+		}
+		listValue := &_GenesisState_9_list{list: &x.TokenizeShareRecords}
+		return protoreflect.ValueOfList(listValue)
+	case "cosmos.staking.v1beta1.GenesisState.last_tokenize_share_record_id":
+		value := x.LastTokenizeShareRecordId
+		return protoreflect.ValueOfUint64(value)
+	case "cosmos.staking.v1beta1.GenesisState.total_liquid_staked_tokens":
+		value := x.TotalLiquidStakedTokens
+		return protoreflect.ValueOfBytes(value)
+	case "cosmos.staking.v1beta1.GenesisState.pending_commission_changes":
+		if len(x.PendingCommissionChanges) == 0 {
+			return protoreflect.ValueOfList(&_GenesisState_12_list{})
+		}
+		listValue := &_GenesisState_12_list{list: &x.PendingCommissionChanges}
+		return protoreflect.ValueOfList(listValue)
+	case "cosmos.staking.v1beta1.GenesisState.queued_epoch_msgs":
+		if len(x.QueuedEpochMsgs) == 0 {
+			return protoreflect.ValueOfList(&_GenesisState_13_list{})
+		}
+		listValue := &_GenesisState_13_list{list: &x.QueuedEpochMsgs}
+		return protoreflect.ValueOfList(listValue)
+	case "cosmos.staking.v1beta1.GenesisState.delegation_locks":
+		if len(x.DelegationLocks) == 0 {
+			return protoreflect.ValueOfList(&_GenesisState_14_list{})
+		}
+		listValue := &_GenesisState_14_list{list: &x.DelegationLocks}
+		return protoreflect.ValueOfList(listValue)
+	case "cosmos.staking.v1beta1.GenesisState.validator_risk_tiers":
+		if len(x.ValidatorRiskTiers) == 0 {
+			return protoreflect.ValueOfList(&_GenesisState_15_list{})
+		}
+		listValue := &_GenesisState_15_list{list: &x.ValidatorRiskTiers}
+		return protoreflect.ValueOfList(listValue)
+	case "cosmos.staking.v1beta1.GenesisState.validator_jail_infos":
+		if len(x.ValidatorJailInfos) == 0 {
+			return protoreflect.ValueOfList(&_GenesisState_16_list{})
+		}
+		listValue := &_GenesisState_16_list{list: &x.ValidatorJailInfos}
+		return protoreflect.ValueOfList(listValue)
+	case "cosmos.staking.v1beta1.GenesisState.staking_paused":
+		value := x.StakingPaused
+		return protoreflect.ValueOfBool(value)
+	case "cosmos.staking.v1beta1.GenesisState.profile_attestations":
+		if len(x.ProfileAttestations) == 0 {
+			return protoreflect.ValueOfList(&_GenesisState_18_list{})
+		}
+		listValue := &_GenesisState_18_list{list: &x.ProfileAttestations}
+		return protoreflect.ValueOfList(listValue)
+	case "cosmos.staking.v1beta1.GenesisState.standby_cons_pubkeys":
+		if len(x.StandbyConsPubkeys) == 0 {
+			return protoreflect.ValueOfList(&_GenesisState_19_list{})
+		}
+		listValue := &_GenesisState_19_list{list: &x.StandbyConsPubkeys}
+		return protoreflect.ValueOfList(listValue)
 	default:
 		if descriptor.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.staking.v1beta1.GenesisState"))
@@ -572,6 +1169,44 @@ func (x *fastReflection_GenesisState) Set(fd protoreflect.FieldDescriptor, value
 		x.Redelegations = *clv.list
 	case "cosmos.staking.v1beta1.GenesisState.exported":
 		x.Exported = value.Bool()
+	case "cosmos.staking.v1beta1.GenesisState.tokenize_share_records":
+		lv := value.List()
+		clv := lv.(*_GenesisState_9_list)
+		x.TokenizeShareRecords = *clv.list
+	case "cosmos.staking.v1beta1.GenesisState.last_tokenize_share_record_id":
+		x.LastTokenizeShareRecordId = value.Uint()
+	case "cosmos.staking.v1beta1.GenesisState.total_liquid_staked_tokens":
+		x.TotalLiquidStakedTokens = value.Bytes()
+	case "cosmos.staking.v1beta1.GenesisState.pending_commission_changes":
+		lv := value.List()
+		clv := lv.(*_GenesisState_12_list)
+		x.PendingCommissionChanges = *clv.list
+	case "cosmos.staking.v1beta1.GenesisState.queued_epoch_msgs":
+		lv := value.List()
+		clv := lv.(*_GenesisState_13_list)
+		x.QueuedEpochMsgs = *clv.list
+	case "cosmos.staking.v1beta1.GenesisState.delegation_locks":
+		lv := value.List()
+		clv := lv.(*_GenesisState_14_list)
+		x.DelegationLocks = *clv.list
+	case "cosmos.staking.v1beta1.GenesisState.validator_risk_tiers":
+		lv := value.List()
+		clv := lv.(*_GenesisState_15_list)
+		x.ValidatorRiskTiers = *clv.list
+	case "cosmos.staking.v1beta1.GenesisState.validator_jail_infos":
+		lv := value.List()
+		clv := lv.(*_GenesisState_16_list)
+		x.ValidatorJailInfos = *clv.list
+	case "cosmos.staking.v1beta1.GenesisState.staking_paused":
+		x.StakingPaused = value.Bool()
+	case "cosmos.staking.v1beta1.GenesisState.profile_attestations":
+		lv := value.List()
+		clv := lv.(*_GenesisState_18_list)
+		x.ProfileAttestations = *clv.list
+	case "cosmos.staking.v1beta1.GenesisState.standby_cons_pubkeys":
+		lv := value.List()
+		clv := lv.(*_GenesisState_19_list)
+		x.StandbyConsPubkeys = *clv.list
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.staking.v1beta1.GenesisState"))
@@ -627,10 +1262,64 @@ func (x *fastReflection_GenesisState) Mutable(fd protoreflect.FieldDescriptor) p
 		}
 		value := &_GenesisState_7_list{list: &x.Redelegations}
 		return protoreflect.ValueOfList(value)
+	case "cosmos.staking.v1beta1.GenesisState.tokenize_share_records":
+		if x.TokenizeShareRecords == nil {
+			x.TokenizeShareRecords = []*TokenizeShareRecord{}
+		}
+		value := &_GenesisState_9_list{list: &x.TokenizeShareRecords}
+		return protoreflect.ValueOfList(value)
+	case "cosmos.staking.v1beta1.GenesisState.pending_commission_changes":
+		if x.PendingCommissionChanges == nil {
+			x.PendingCommissionChanges = []*PendingCommissionChange{}
+		}
+		value := &_GenesisState_12_list{list: &x.PendingCommissionChanges}
+		return protoreflect.ValueOfList(value)
+	case "cosmos.staking.v1beta1.GenesisState.queued_epoch_msgs":
+		if x.QueuedEpochMsgs == nil {
+			x.QueuedEpochMsgs = []*QueuedEpochMsg{}
+		}
+		value := &_GenesisState_13_list{list: &x.QueuedEpochMsgs}
+		return protoreflect.ValueOfList(value)
+	case "cosmos.staking.v1beta1.GenesisState.delegation_locks":
+		if x.DelegationLocks == nil {
+			x.DelegationLocks = []*DelegationLock{}
+		}
+		value := &_GenesisState_14_list{list: &x.DelegationLocks}
+		return protoreflect.ValueOfList(value)
+	case "cosmos.staking.v1beta1.GenesisState.validator_risk_tiers":
+		if x.ValidatorRiskTiers == nil {
+			x.ValidatorRiskTiers = []*ValidatorRiskTier{}
+		}
+		value := &_GenesisState_15_list{list: &x.ValidatorRiskTiers}
+		return protoreflect.ValueOfList(value)
+	case "cosmos.staking.v1beta1.GenesisState.validator_jail_infos":
+		if x.ValidatorJailInfos == nil {
+			x.ValidatorJailInfos = []*ValidatorJailInfo{}
+		}
+		value := &_GenesisState_16_list{list: &x.ValidatorJailInfos}
+		return protoreflect.ValueOfList(value)
+	case "cosmos.staking.v1beta1.GenesisState.profile_attestations":
+		if x.ProfileAttestations == nil {
+			x.ProfileAttestations = []*ProfileAttestation{}
+		}
+		value := &_GenesisState_18_list{list: &x.ProfileAttestations}
+		return protoreflect.ValueOfList(value)
+	case "cosmos.staking.v1beta1.GenesisState.standby_cons_pubkeys":
+		if x.StandbyConsPubkeys == nil {
+			x.StandbyConsPubkeys = []*StandbyConsPubKey{}
+		}
+		value := &_GenesisState_19_list{list: &x.StandbyConsPubkeys}
+		return protoreflect.ValueOfList(value)
 	case "cosmos.staking.v1beta1.GenesisState.last_total_power":
 		panic(fmt.Errorf("field last_total_power of message cosmos.staking.v1beta1.GenesisState is not mutable"))
 	case "cosmos.staking.v1beta1.GenesisState.exported":
 		panic(fmt.Errorf("field exported of message cosmos.staking.v1beta1.GenesisState is not mutable"))
+	case "cosmos.staking.v1beta1.GenesisState.last_tokenize_share_record_id":
+		panic(fmt.Errorf("field last_tokenize_share_record_id of message cosmos.staking.v1beta1.GenesisState is not mutable"))
+	case "cosmos.staking.v1beta1.GenesisState.total_liquid_staked_tokens":
+		panic(fmt.Errorf("field total_liquid_staked_tokens of message cosmos.staking.v1beta1.GenesisState is not mutable"))
+	case "cosmos.staking.v1beta1.GenesisState.staking_paused":
+		panic(fmt.Errorf("field staking_paused of message cosmos.staking.v1beta1.GenesisState is not mutable"))
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.staking.v1beta1.GenesisState"))
@@ -666,6 +1355,36 @@ func (x *fastReflection_GenesisState) NewField(fd protoreflect.FieldDescriptor)
 		return protoreflect.ValueOfList(&_GenesisState_7_list{list: &list})
 	case "cosmos.staking.v1beta1.GenesisState.exported":
 		return protoreflect.ValueOfBool(false)
+	case "cosmos.staking.v1beta1.GenesisState.tokenize_share_records":
+		list := []*TokenizeShareRecord{}
+		return protoreflect.ValueOfList(&_GenesisState_9_list{list: &list})
+	case "cosmos.staking.v1beta1.GenesisState.last_tokenize_share_record_id":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "cosmos.staking.v1beta1.GenesisState.total_liquid_staked_tokens":
+		return protoreflect.ValueOfBytes(nil)
+	case "cosmos.staking.v1beta1.GenesisState.pending_commission_changes":
+		list := []*PendingCommissionChange{}
+		return protoreflect.ValueOfList(&_GenesisState_12_list{list: &list})
+	case "cosmos.staking.v1beta1.GenesisState.queued_epoch_msgs":
+		list := []*QueuedEpochMsg{}
+		return protoreflect.ValueOfList(&_GenesisState_13_list{list: &list})
+	case "cosmos.staking.v1beta1.GenesisState.delegation_locks":
+		list := []*DelegationLock{}
+		return protoreflect.ValueOfList(&_GenesisState_14_list{list: &list})
+	case "cosmos.staking.v1beta1.GenesisState.validator_risk_tiers":
+		list := []*ValidatorRiskTier{}
+		return protoreflect.ValueOfList(&_GenesisState_15_list{list: &list})
+	case "cosmos.staking.v1beta1.GenesisState.validator_jail_infos":
+		list := []*ValidatorJailInfo{}
+		return protoreflect.ValueOfList(&_GenesisState_16_list{list: &list})
+	case "cosmos.staking.v1beta1.GenesisState.staking_paused":
+		return protoreflect.ValueOfBool(false)
+	case "cosmos.staking.v1beta1.GenesisState.profile_attestations":
+		list := []*ProfileAttestation{}
+		return protoreflect.ValueOfList(&_GenesisState_18_list{list: &list})
+	case "cosmos.staking.v1beta1.GenesisState.standby_cons_pubkeys":
+		list := []*StandbyConsPubKey{}
+		return protoreflect.ValueOfList(&_GenesisState_19_list{list: &list})
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.staking.v1beta1.GenesisState"))
@@ -767,43 +1486,259 @@ func (x *fastReflection_GenesisState) ProtoMethods() *protoiface.Methods {
 				n += 1 + l + runtime.Sov(uint64(l))
 			}
 		}
-		if len(x.Redelegations) > 0 {
-			for _, e := range x.Redelegations {
-				l = options.Size(e)
-				n += 1 + l + runtime.Sov(uint64(l))
+		if len(x.Redelegations) > 0 {
+			for _, e := range x.Redelegations {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if x.Exported {
+			n += 2
+		}
+		if len(x.TokenizeShareRecords) > 0 {
+			for _, e := range x.TokenizeShareRecords {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if x.LastTokenizeShareRecordId != 0 {
+			n += 1 + runtime.Sov(uint64(x.LastTokenizeShareRecordId))
+		}
+		l = len(x.TotalLiquidStakedTokens)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if len(x.PendingCommissionChanges) > 0 {
+			for _, e := range x.PendingCommissionChanges {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if len(x.QueuedEpochMsgs) > 0 {
+			for _, e := range x.QueuedEpochMsgs {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if len(x.DelegationLocks) > 0 {
+			for _, e := range x.DelegationLocks {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if len(x.ValidatorRiskTiers) > 0 {
+			for _, e := range x.ValidatorRiskTiers {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if len(x.ValidatorJailInfos) > 0 {
+			for _, e := range x.ValidatorJailInfos {
+				l = options.Size(e)
+				n += 2 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if x.StakingPaused {
+			n += 3
+		}
+		if len(x.ProfileAttestations) > 0 {
+			for _, e := range x.ProfileAttestations {
+				l = options.Size(e)
+				n += 2 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if len(x.StandbyConsPubkeys) > 0 {
+			for _, e := range x.StandbyConsPubkeys {
+				l = options.Size(e)
+				n += 2 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*GenesisState)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if len(x.StandbyConsPubkeys) > 0 {
+			for iNdEx := len(x.StandbyConsPubkeys) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.StandbyConsPubkeys[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x1
+				i--
+				dAtA[i] = 0x9a
+			}
+		}
+		if len(x.ProfileAttestations) > 0 {
+			for iNdEx := len(x.ProfileAttestations) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.ProfileAttestations[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x1
+				i--
+				dAtA[i] = 0x92
+			}
+		}
+		if x.StakingPaused {
+			i--
+			if x.StakingPaused {
+				dAtA[i] = 1
+			} else {
+				dAtA[i] = 0
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0x88
+		}
+		if len(x.ValidatorJailInfos) > 0 {
+			for iNdEx := len(x.ValidatorJailInfos) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.ValidatorJailInfos[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x1
+				i--
+				dAtA[i] = 0x82
+			}
+		}
+		if len(x.ValidatorRiskTiers) > 0 {
+			for iNdEx := len(x.ValidatorRiskTiers) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.ValidatorRiskTiers[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x7a
+			}
+		}
+		if len(x.DelegationLocks) > 0 {
+			for iNdEx := len(x.DelegationLocks) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.DelegationLocks[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x72
+			}
+		}
+		if len(x.QueuedEpochMsgs) > 0 {
+			for iNdEx := len(x.QueuedEpochMsgs) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.QueuedEpochMsgs[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x6a
+			}
+		}
+		if len(x.PendingCommissionChanges) > 0 {
+			for iNdEx := len(x.PendingCommissionChanges) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.PendingCommissionChanges[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x62
 			}
 		}
-		if x.Exported {
-			n += 2
-		}
-		if x.unknownFields != nil {
-			n += len(x.unknownFields)
-		}
-		return protoiface.SizeOutput{
-			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
-			Size:              n,
+		if len(x.TotalLiquidStakedTokens) > 0 {
+			i -= len(x.TotalLiquidStakedTokens)
+			copy(dAtA[i:], x.TotalLiquidStakedTokens)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.TotalLiquidStakedTokens)))
+			i--
+			dAtA[i] = 0x5a
 		}
-	}
-
-	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
-		x := input.Message.Interface().(*GenesisState)
-		if x == nil {
-			return protoiface.MarshalOutput{
-				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
-				Buf:               input.Buf,
-			}, nil
+		if x.LastTokenizeShareRecordId != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.LastTokenizeShareRecordId))
+			i--
+			dAtA[i] = 0x50
 		}
-		options := runtime.MarshalInputToOptions(input)
-		_ = options
-		size := options.Size(x)
-		dAtA := make([]byte, size)
-		i := len(dAtA)
-		_ = i
-		var l int
-		_ = l
-		if x.unknownFields != nil {
-			i -= len(x.unknownFields)
-			copy(dAtA[i:], x.unknownFields)
+		if len(x.TokenizeShareRecords) > 0 {
+			for iNdEx := len(x.TokenizeShareRecords) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.TokenizeShareRecords[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x4a
+			}
 		}
 		if x.Exported {
 			i--
@@ -946,28 +1881,234 @@ func (x *fastReflection_GenesisState) ProtoMethods() *protoiface.Methods {
 				if shift >= 64 {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
 				}
-				if iNdEx >= l {
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: GenesisState: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: GenesisState: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.Params == nil {
+					x.Params = &Params{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Params); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field LastTotalPower", wireType)
+				}
+				var byteLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					byteLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if byteLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + byteLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.LastTotalPower = append(x.LastTotalPower[:0], dAtA[iNdEx:postIndex]...)
+				if x.LastTotalPower == nil {
+					x.LastTotalPower = []byte{}
+				}
+				iNdEx = postIndex
+			case 3:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field LastValidatorPowers", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.LastValidatorPowers = append(x.LastValidatorPowers, &LastValidatorPower{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.LastValidatorPowers[len(x.LastValidatorPowers)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 4:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Validators", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Validators = append(x.Validators, &Validator{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Validators[len(x.Validators)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 5:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Delegations", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Delegations = append(x.Delegations, &Delegation{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Delegations[len(x.Delegations)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 6:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field UnbondingDelegations", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				wire |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
+				x.UnbondingDelegations = append(x.UnbondingDelegations, &UnbondingDelegation{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.UnbondingDelegations[len(x.UnbondingDelegations)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
 				}
-			}
-			fieldNum := int32(wire >> 3)
-			wireType := int(wire & 0x7)
-			if wireType == 4 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: GenesisState: wiretype end group for non-group")
-			}
-			if fieldNum <= 0 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: GenesisState: illegal tag %d (wire type %d)", fieldNum, wire)
-			}
-			switch fieldNum {
-			case 1:
+				iNdEx = postIndex
+			case 7:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Redelegations", wireType)
 				}
 				var msglen int
 				for shift := uint(0); ; shift += 7 {
@@ -994,16 +2135,87 @@ func (x *fastReflection_GenesisState) ProtoMethods() *protoiface.Methods {
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				if x.Params == nil {
-					x.Params = &Params{}
+				x.Redelegations = append(x.Redelegations, &Redelegation{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Redelegations[len(x.Redelegations)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
 				}
-				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Params); err != nil {
+				iNdEx = postIndex
+			case 8:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Exported", wireType)
+				}
+				var v int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				x.Exported = bool(v != 0)
+			case 9:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field TokenizeShareRecords", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.TokenizeShareRecords = append(x.TokenizeShareRecords, &TokenizeShareRecord{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.TokenizeShareRecords[len(x.TokenizeShareRecords)-1]); err != nil {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
 				}
 				iNdEx = postIndex
-			case 2:
+			case 10:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field LastTokenizeShareRecordId", wireType)
+				}
+				x.LastTokenizeShareRecordId = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.LastTokenizeShareRecordId |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 11:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field LastTotalPower", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field TotalLiquidStakedTokens", wireType)
 				}
 				var byteLen int
 				for shift := uint(0); ; shift += 7 {
@@ -1030,14 +2242,14 @@ func (x *fastReflection_GenesisState) ProtoMethods() *protoiface.Methods {
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				x.LastTotalPower = append(x.LastTotalPower[:0], dAtA[iNdEx:postIndex]...)
-				if x.LastTotalPower == nil {
-					x.LastTotalPower = []byte{}
+				x.TotalLiquidStakedTokens = append(x.TotalLiquidStakedTokens[:0], dAtA[iNdEx:postIndex]...)
+				if x.TotalLiquidStakedTokens == nil {
+					x.TotalLiquidStakedTokens = []byte{}
 				}
 				iNdEx = postIndex
-			case 3:
+			case 12:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field LastValidatorPowers", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field PendingCommissionChanges", wireType)
 				}
 				var msglen int
 				for shift := uint(0); ; shift += 7 {
@@ -1064,14 +2276,14 @@ func (x *fastReflection_GenesisState) ProtoMethods() *protoiface.Methods {
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				x.LastValidatorPowers = append(x.LastValidatorPowers, &LastValidatorPower{})
-				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.LastValidatorPowers[len(x.LastValidatorPowers)-1]); err != nil {
+				x.PendingCommissionChanges = append(x.PendingCommissionChanges, &PendingCommissionChange{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.PendingCommissionChanges[len(x.PendingCommissionChanges)-1]); err != nil {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
 				}
 				iNdEx = postIndex
-			case 4:
+			case 13:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Validators", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field QueuedEpochMsgs", wireType)
 				}
 				var msglen int
 				for shift := uint(0); ; shift += 7 {
@@ -1098,14 +2310,14 @@ func (x *fastReflection_GenesisState) ProtoMethods() *protoiface.Methods {
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				x.Validators = append(x.Validators, &Validator{})
-				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Validators[len(x.Validators)-1]); err != nil {
+				x.QueuedEpochMsgs = append(x.QueuedEpochMsgs, &QueuedEpochMsg{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.QueuedEpochMsgs[len(x.QueuedEpochMsgs)-1]); err != nil {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
 				}
 				iNdEx = postIndex
-			case 5:
+			case 14:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Delegations", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field DelegationLocks", wireType)
 				}
 				var msglen int
 				for shift := uint(0); ; shift += 7 {
@@ -1132,14 +2344,14 @@ func (x *fastReflection_GenesisState) ProtoMethods() *protoiface.Methods {
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				x.Delegations = append(x.Delegations, &Delegation{})
-				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Delegations[len(x.Delegations)-1]); err != nil {
+				x.DelegationLocks = append(x.DelegationLocks, &DelegationLock{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.DelegationLocks[len(x.DelegationLocks)-1]); err != nil {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
 				}
 				iNdEx = postIndex
-			case 6:
+			case 15:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field UnbondingDelegations", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ValidatorRiskTiers", wireType)
 				}
 				var msglen int
 				for shift := uint(0); ; shift += 7 {
@@ -1166,14 +2378,14 @@ func (x *fastReflection_GenesisState) ProtoMethods() *protoiface.Methods {
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				x.UnbondingDelegations = append(x.UnbondingDelegations, &UnbondingDelegation{})
-				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.UnbondingDelegations[len(x.UnbondingDelegations)-1]); err != nil {
+				x.ValidatorRiskTiers = append(x.ValidatorRiskTiers, &ValidatorRiskTier{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.ValidatorRiskTiers[len(x.ValidatorRiskTiers)-1]); err != nil {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
 				}
 				iNdEx = postIndex
-			case 7:
+			case 16:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Redelegations", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ValidatorJailInfos", wireType)
 				}
 				var msglen int
 				for shift := uint(0); ; shift += 7 {
@@ -1200,14 +2412,14 @@ func (x *fastReflection_GenesisState) ProtoMethods() *protoiface.Methods {
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				x.Redelegations = append(x.Redelegations, &Redelegation{})
-				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Redelegations[len(x.Redelegations)-1]); err != nil {
+				x.ValidatorJailInfos = append(x.ValidatorJailInfos, &ValidatorJailInfo{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.ValidatorJailInfos[len(x.ValidatorJailInfos)-1]); err != nil {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
 				}
 				iNdEx = postIndex
-			case 8:
+			case 17:
 				if wireType != 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Exported", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field StakingPaused", wireType)
 				}
 				var v int
 				for shift := uint(0); ; shift += 7 {
@@ -1224,7 +2436,75 @@ func (x *fastReflection_GenesisState) ProtoMethods() *protoiface.Methods {
 						break
 					}
 				}
-				x.Exported = bool(v != 0)
+				x.StakingPaused = bool(v != 0)
+			case 18:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ProfileAttestations", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.ProfileAttestations = append(x.ProfileAttestations, &ProfileAttestation{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.ProfileAttestations[len(x.ProfileAttestations)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 19:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field StandbyConsPubkeys", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.StandbyConsPubkeys = append(x.StandbyConsPubkeys, &StandbyConsPubKey{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.StandbyConsPubkeys[len(x.StandbyConsPubkeys)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
 			default:
 				iNdEx = preIndex
 				skippy, err := runtime.Skip(dAtA[iNdEx:])
@@ -1764,6 +3044,58 @@ type GenesisState struct {
 	// redelegations defines the redelegations active at genesis.
 	Redelegations []*Redelegation `protobuf:"bytes,7,rep,name=redelegations,proto3" json:"redelegations,omitempty"`
 	Exported      bool            `protobuf:"varint,8,opt,name=exported,proto3" json:"exported,omitempty"`
+	// tokenize_share_records defines the tokenize share records at genesis.
+	//
+	// Since: cosmos-sdk 0.48
+	TokenizeShareRecords []*TokenizeShareRecord `protobuf:"bytes,9,rep,name=tokenize_share_records,json=tokenizeShareRecords,proto3" json:"tokenize_share_records,omitempty"`
+	// last_tokenize_share_record_id is the id of the last created tokenize share
+	// record.
+	//
+	// Since: cosmos-sdk 0.48
+	LastTokenizeShareRecordId uint64 `protobuf:"varint,10,opt,name=last_tokenize_share_record_id,json=lastTokenizeShareRecordId,proto3" json:"last_tokenize_share_record_id,omitempty"`
+	// total_liquid_staked_tokens is the total amount of liquid staked tokens.
+	//
+	// Since: cosmos-sdk 0.48
+	TotalLiquidStakedTokens []byte `protobuf:"bytes,11,opt,name=total_liquid_staked_tokens,json=totalLiquidStakedTokens,proto3" json:"total_liquid_staked_tokens,omitempty"`
+	// pending_commission_changes defines the commission rate changes announced
+	// by validators which have not taken effect yet.
+	//
+	// Since: cosmos-sdk 0.48
+	PendingCommissionChanges []*PendingCommissionChange `protobuf:"bytes,12,rep,name=pending_commission_changes,json=pendingCommissionChanges,proto3" json:"pending_commission_changes,omitempty"`
+	// queued_epoch_msgs defines the staking messages queued until the end of the
+	// current epoch.
+	//
+	// Since: cosmos-sdk 0.48
+	QueuedEpochMsgs []*QueuedEpochMsg `protobuf:"bytes,13,rep,name=queued_epoch_msgs,json=queuedEpochMsgs,proto3" json:"queued_epoch_msgs,omitempty"`
+	// delegation_locks defines the delegations locked in exchange for a reward
+	// multiplier.
+	//
+	// Since: cosmos-sdk 0.48
+	DelegationLocks []*DelegationLock `protobuf:"bytes,14,rep,name=delegation_locks,json=delegationLocks,proto3" json:"delegation_locks,omitempty"`
+	// validator_risk_tiers defines the unbonding tiers the validators are
+	// assigned to.
+	//
+	// Since: cosmos-sdk 0.48
+	ValidatorRiskTiers []*ValidatorRiskTier `protobuf:"bytes,15,rep,name=validator_risk_tiers,json=validatorRiskTiers,proto3" json:"validator_risk_tiers,omitempty"`
+	// validator_jail_infos defines why and when the jailed validators were
+	// jailed.
+	//
+	// Since: cosmos-sdk 0.48
+	ValidatorJailInfos []*ValidatorJailInfo `protobuf:"bytes,16,rep,name=validator_jail_infos,json=validatorJailInfos,proto3" json:"validator_jail_infos,omitempty"`
+	// staking_paused defines whether the delegations, undelegations and
+	// redelegations are paused.
+	//
+	// Since: cosmos-sdk 0.48
+	StakingPaused bool `protobuf:"varint,17,opt,name=staking_paused,json=stakingPaused,proto3" json:"staking_paused,omitempty"`
+	// profile_attestations defines the attestations of the fields of the
+	// validator profiles.
+	//
+	// Since: cosmos-sdk 0.48
+	ProfileAttestations []*ProfileAttestation `protobuf:"bytes,18,rep,name=profile_attestations,json=profileAttestations,proto3" json:"profile_attestations,omitempty"`
+	// standby_cons_pubkeys defines the standby consensus keys of the validators.
+	//
+	// Since: cosmos-sdk 0.48
+	StandbyConsPubkeys []*StandbyConsPubKey `protobuf:"bytes,19,rep,name=standby_cons_pubkeys,json=standbyConsPubkeys,proto3" json:"standby_cons_pubkeys,omitempty"`
 }
 
 func (x *GenesisState) Reset() {
@@ -1842,6 +3174,83 @@ func (x *GenesisState) GetExported() bool {
 	return false
 }
 
+func (x *GenesisState) GetTokenizeShareRecords() []*TokenizeShareRecord {
+	if x != nil {
+		return x.TokenizeShareRecords
+	}
+	return nil
+}
+
+func (x *GenesisState) GetLastTokenizeShareRecordId() uint64 {
+	if x != nil {
+		return x.LastTokenizeShareRecordId
+	}
+	return 0
+}
+
+func (x *GenesisState) GetTotalLiquidStakedTokens() []byte {
+	if x != nil {
+		return x.TotalLiquidStakedTokens
+	}
+	return nil
+}
+
+func (x *GenesisState) GetPendingCommissionChanges() []*PendingCommissionChange {
+	if x != nil {
+		return x.PendingCommissionChanges
+	}
+	return nil
+}
+
+func (x *GenesisState) GetQueuedEpochMsgs() []*QueuedEpochMsg {
+	if x != nil {
+		return x.QueuedEpochMsgs
+	}
+	return nil
+}
+
+func (x *GenesisState) GetDelegationLocks() []*DelegationLock {
+	if x != nil {
+		return x.DelegationLocks
+	}
+	return nil
+}
+
+func (x *GenesisState) GetValidatorRiskTiers() []*ValidatorRiskTier {
+	if x != nil {
+		return x.ValidatorRiskTiers
+	}
+	return nil
+}
+
+func (x *GenesisState) GetValidatorJailInfos() []*ValidatorJailInfo {
+	if x != nil {
+		return x.ValidatorJailInfos
+	}
+	return nil
+}
+
+func (x *GenesisState) GetStakingPaused() bool {
+	if x != nil {
+		return x.StakingPaused
+	}
+	return false
+}
+
+func (x *GenesisState) GetProfileAttestations() []*ProfileAttestation {
+	if x != nil {
+		return x.ProfileAttestations
+	}
+	return nil
+}
+
+func (x *GenesisState) GetStandbyConsPubkeys() []*StandbyConsPubKey {
+	if x != nil {
+		return x.StandbyConsPubkeys
+	}
+	return nil
+}
+
 // LastValidatorPower required for validator set update logic.
 type LastValidatorPower struct {
 	state         protoimpl.MessageState
@@ -1901,7 +3310,7 @@ var file_cosmos_staking_v1beta1_genesis_proto_rawDesc = []byte{
 	0x6b, 0x69, 0x6e, 0x67, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x19, 0x63, 0x6f, 0x73, 0x6d,
 	0x6f, 0x73, 0x5f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e,
 	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x11, 0x61, 0x6d, 0x69, 0x6e, 0x6f, 0x2f, 0x61, 0x6d, 0x69,
-	0x6e, 0x6f, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x9a, 0x05, 0x0a, 0x0c, 0x47, 0x65, 0x6e,
+	0x6e, 0x6f, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0xbc, 0x0d, 0x0a, 0x0c, 0x47, 0x65, 0x6e,
 	0x65, 0x73, 0x69, 0x73, 0x53, 0x74, 0x61, 0x74, 0x65, 0x12, 0x41, 0x0a, 0x06, 0x70, 0x61, 0x72,
 	0x61, 0x6d, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x63, 0x6f, 0x73, 0x6d,
 	0x6f, 0x73, 0x2e, 0x73, 0x74, 0x61, 0x6b, 0x69, 0x6e, 0x67, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74,
@@ -1943,28 +3352,94 @@ var file_cosmos_staking_v1beta1_genesis_proto_rawDesc = []byte{
 	0x09, 0xc8, 0xde, 0x1f, 0x00, 0xa8, 0xe7, 0xb0, 0x2a, 0x01, 0x52, 0x0d, 0x72, 0x65, 0x64, 0x65,
 	0x6c, 0x65, 0x67, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x1a, 0x0a, 0x08, 0x65, 0x78, 0x70,
 	0x6f, 0x72, 0x74, 0x65, 0x64, 0x18, 0x08, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x65, 0x78, 0x70,
-	0x6f, 0x72, 0x74, 0x65, 0x64, 0x22, 0x68, 0x0a, 0x12, 0x4c, 0x61, 0x73, 0x74, 0x56, 0x61, 0x6c,
-	0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x50, 0x6f, 0x77, 0x65, 0x72, 0x12, 0x32, 0x0a, 0x07, 0x61,
-	0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x18, 0xd2, 0xb4,
-	0x2d, 0x14, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73,
-	0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x52, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12,
-	0x14, 0x0a, 0x05, 0x70, 0x6f, 0x77, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05,
-	0x70, 0x6f, 0x77, 0x65, 0x72, 0x3a, 0x08, 0x88, 0xa0, 0x1f, 0x00, 0xe8, 0xa0, 0x1f, 0x00, 0x42,
-	0xdc, 0x01, 0x0a, 0x1a, 0x63, 0x6f, 0x6d, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x73,
-	0x74, 0x61, 0x6b, 0x69, 0x6e, 0x67, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x42, 0x0c,
-	0x47, 0x65, 0x6e, 0x65, 0x73, 0x69, 0x73, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50, 0x01, 0x5a, 0x36,
-	0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f, 0x2f, 0x61, 0x70, 0x69,
-	0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2f, 0x73, 0x74, 0x61, 0x6b, 0x69, 0x6e, 0x67, 0x2f,
-	0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x3b, 0x73, 0x74, 0x61, 0x6b, 0x69, 0x6e, 0x67, 0x76,
-	0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0xa2, 0x02, 0x03, 0x43, 0x53, 0x58, 0xaa, 0x02, 0x16, 0x43,
-	0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x53, 0x74, 0x61, 0x6b, 0x69, 0x6e, 0x67, 0x2e, 0x56, 0x31,
-	0x62, 0x65, 0x74, 0x61, 0x31, 0xca, 0x02, 0x16, 0x43, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x5c, 0x53,
-	0x74, 0x61, 0x6b, 0x69, 0x6e, 0x67, 0x5c, 0x56, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0xe2, 0x02,
-	0x22, 0x43, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x5c, 0x53, 0x74, 0x61, 0x6b, 0x69, 0x6e, 0x67, 0x5c,
-	0x56, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x5c, 0x47, 0x50, 0x42, 0x4d, 0x65, 0x74, 0x61, 0x64,
-	0x61, 0x74, 0x61, 0xea, 0x02, 0x18, 0x43, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x3a, 0x3a, 0x53, 0x74,
-	0x61, 0x6b, 0x69, 0x6e, 0x67, 0x3a, 0x3a, 0x56, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x62, 0x06,
-	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x6f, 0x72, 0x74, 0x65, 0x64, 0x12, 0x6c, 0x0a, 0x16, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x69, 0x7a,
+	0x65, 0x5f, 0x73, 0x68, 0x61, 0x72, 0x65, 0x5f, 0x72, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x73, 0x18,
+	0x09, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2b, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x73,
+	0x74, 0x61, 0x6b, 0x69, 0x6e, 0x67, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x54,
+	0x6f, 0x6b, 0x65, 0x6e, 0x69, 0x7a, 0x65, 0x53, 0x68, 0x61, 0x72, 0x65, 0x52, 0x65, 0x63, 0x6f,
+	0x72, 0x64, 0x42, 0x09, 0xc8, 0xde, 0x1f, 0x00, 0xa8, 0xe7, 0xb0, 0x2a, 0x01, 0x52, 0x14, 0x74,
+	0x6f, 0x6b, 0x65, 0x6e, 0x69, 0x7a, 0x65, 0x53, 0x68, 0x61, 0x72, 0x65, 0x52, 0x65, 0x63, 0x6f,
+	0x72, 0x64, 0x73, 0x12, 0x40, 0x0a, 0x1d, 0x6c, 0x61, 0x73, 0x74, 0x5f, 0x74, 0x6f, 0x6b, 0x65,
+	0x6e, 0x69, 0x7a, 0x65, 0x5f, 0x73, 0x68, 0x61, 0x72, 0x65, 0x5f, 0x72, 0x65, 0x63, 0x6f, 0x72,
+	0x64, 0x5f, 0x69, 0x64, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x04, 0x52, 0x19, 0x6c, 0x61, 0x73, 0x74,
+	0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x69, 0x7a, 0x65, 0x53, 0x68, 0x61, 0x72, 0x65, 0x52, 0x65, 0x63,
+	0x6f, 0x72, 0x64, 0x49, 0x64, 0x12, 0x70, 0x0a, 0x1a, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x5f, 0x6c,
+	0x69, 0x71, 0x75, 0x69, 0x64, 0x5f, 0x73, 0x74, 0x61, 0x6b, 0x65, 0x64, 0x5f, 0x74, 0x6f, 0x6b,
+	0x65, 0x6e, 0x73, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x0c, 0x42, 0x33, 0xc8, 0xde, 0x1f, 0x00, 0xda,
+	0xde, 0x1f, 0x26, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x63, 0x6f,
+	0x73, 0x6d, 0x6f, 0x73, 0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2d, 0x73, 0x64, 0x6b, 0x2f,
+	0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x49, 0x6e, 0x74, 0xa8, 0xe7, 0xb0, 0x2a, 0x01, 0x52, 0x17,
+	0x74, 0x6f, 0x74, 0x61, 0x6c, 0x4c, 0x69, 0x71, 0x75, 0x69, 0x64, 0x53, 0x74, 0x61, 0x6b, 0x65,
+	0x64, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x73, 0x12, 0x78, 0x0a, 0x1a, 0x70, 0x65, 0x6e, 0x64, 0x69,
+	0x6e, 0x67, 0x5f, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x5f, 0x63, 0x68,
+	0x61, 0x6e, 0x67, 0x65, 0x73, 0x18, 0x0c, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2f, 0x2e, 0x63, 0x6f,
+	0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x73, 0x74, 0x61, 0x6b, 0x69, 0x6e, 0x67, 0x2e, 0x76, 0x31, 0x62,
+	0x65, 0x74, 0x61, 0x31, 0x2e, 0x50, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x43, 0x6f, 0x6d, 0x6d,
+	0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x42, 0x09, 0xc8, 0xde,
+	0x1f, 0x00, 0xa8, 0xe7, 0xb0, 0x2a, 0x01, 0x52, 0x18, 0x70, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67,
+	0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65,
+	0x73, 0x12, 0x5d, 0x0a, 0x11, 0x71, 0x75, 0x65, 0x75, 0x65, 0x64, 0x5f, 0x65, 0x70, 0x6f, 0x63,
+	0x68, 0x5f, 0x6d, 0x73, 0x67, 0x73, 0x18, 0x0d, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x26, 0x2e, 0x63,
+	0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x73, 0x74, 0x61, 0x6b, 0x69, 0x6e, 0x67, 0x2e, 0x76, 0x31,
+	0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x51, 0x75, 0x65, 0x75, 0x65, 0x64, 0x45, 0x70, 0x6f, 0x63,
+	0x68, 0x4d, 0x73, 0x67, 0x42, 0x09, 0xc8, 0xde, 0x1f, 0x00, 0xa8, 0xe7, 0xb0, 0x2a, 0x01, 0x52,
+	0x0f, 0x71, 0x75, 0x65, 0x75, 0x65, 0x64, 0x45, 0x70, 0x6f, 0x63, 0x68, 0x4d, 0x73, 0x67, 0x73,
+	0x12, 0x5c, 0x0a, 0x10, 0x64, 0x65, 0x6c, 0x65, 0x67, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x6c,
+	0x6f, 0x63, 0x6b, 0x73, 0x18, 0x0e, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x26, 0x2e, 0x63, 0x6f, 0x73,
+	0x6d, 0x6f, 0x73, 0x2e, 0x73, 0x74, 0x61, 0x6b, 0x69, 0x6e, 0x67, 0x2e, 0x76, 0x31, 0x62, 0x65,
+	0x74, 0x61, 0x31, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x67, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4c, 0x6f,
+	0x63, 0x6b, 0x42, 0x09, 0xc8, 0xde, 0x1f, 0x00, 0xa8, 0xe7, 0xb0, 0x2a, 0x01, 0x52, 0x0f, 0x64,
+	0x65, 0x6c, 0x65, 0x67, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4c, 0x6f, 0x63, 0x6b, 0x73, 0x12, 0x66,
+	0x0a, 0x14, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x5f, 0x72, 0x69, 0x73, 0x6b,
+	0x5f, 0x74, 0x69, 0x65, 0x72, 0x73, 0x18, 0x0f, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x29, 0x2e, 0x63,
+	0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x73, 0x74, 0x61, 0x6b, 0x69, 0x6e, 0x67, 0x2e, 0x76, 0x31,
+	0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x52,
+	0x69, 0x73, 0x6b, 0x54, 0x69, 0x65, 0x72, 0x42, 0x09, 0xc8, 0xde, 0x1f, 0x00, 0xa8, 0xe7, 0xb0,
+	0x2a, 0x01, 0x52, 0x12, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x52, 0x69, 0x73,
+	0x6b, 0x54, 0x69, 0x65, 0x72, 0x73, 0x12, 0x66, 0x0a, 0x14, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61,
+	0x74, 0x6f, 0x72, 0x5f, 0x6a, 0x61, 0x69, 0x6c, 0x5f, 0x69, 0x6e, 0x66, 0x6f, 0x73, 0x18, 0x10,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x29, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x73, 0x74,
+	0x61, 0x6b, 0x69, 0x6e, 0x67, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x56, 0x61,
+	0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x4a, 0x61, 0x69, 0x6c, 0x49, 0x6e, 0x66, 0x6f, 0x42,
+	0x09, 0xc8, 0xde, 0x1f, 0x00, 0xa8, 0xe7, 0xb0, 0x2a, 0x01, 0x52, 0x12, 0x76, 0x61, 0x6c, 0x69,
+	0x64, 0x61, 0x74, 0x6f, 0x72, 0x4a, 0x61, 0x69, 0x6c, 0x49, 0x6e, 0x66, 0x6f, 0x73, 0x12, 0x25,
+	0x0a, 0x0e, 0x73, 0x74, 0x61, 0x6b, 0x69, 0x6e, 0x67, 0x5f, 0x70, 0x61, 0x75, 0x73, 0x65, 0x64,
+	0x18, 0x11, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0d, 0x73, 0x74, 0x61, 0x6b, 0x69, 0x6e, 0x67, 0x50,
+	0x61, 0x75, 0x73, 0x65, 0x64, 0x12, 0x68, 0x0a, 0x14, 0x70, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65,
+	0x5f, 0x61, 0x74, 0x74, 0x65, 0x73, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x12, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x2a, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x73, 0x74, 0x61,
+	0x6b, 0x69, 0x6e, 0x67, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x50, 0x72, 0x6f,
+	0x66, 0x69, 0x6c, 0x65, 0x41, 0x74, 0x74, 0x65, 0x73, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x42,
+	0x09, 0xc8, 0xde, 0x1f, 0x00, 0xa8, 0xe7, 0xb0, 0x2a, 0x01, 0x52, 0x13, 0x70, 0x72, 0x6f, 0x66,
+	0x69, 0x6c, 0x65, 0x41, 0x74, 0x74, 0x65, 0x73, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12,
+	0x66, 0x0a, 0x14, 0x73, 0x74, 0x61, 0x6e, 0x64, 0x62, 0x79, 0x5f, 0x63, 0x6f, 0x6e, 0x73, 0x5f,
+	0x70, 0x75, 0x62, 0x6b, 0x65, 0x79, 0x73, 0x18, 0x13, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x29, 0x2e,
+	0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x73, 0x74, 0x61, 0x6b, 0x69, 0x6e, 0x67, 0x2e, 0x76,
+	0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x53, 0x74, 0x61, 0x6e, 0x64, 0x62, 0x79, 0x43, 0x6f,
+	0x6e, 0x73, 0x50, 0x75, 0x62, 0x4b, 0x65, 0x79, 0x42, 0x09, 0xc8, 0xde, 0x1f, 0x00, 0xa8, 0xe7,
+	0xb0, 0x2a, 0x01, 0x52, 0x12, 0x73, 0x74, 0x61, 0x6e, 0x64, 0x62, 0x79, 0x43, 0x6f, 0x6e, 0x73,
+	0x50, 0x75, 0x62, 0x6b, 0x65, 0x79, 0x73, 0x22, 0x68, 0x0a, 0x12, 0x4c, 0x61, 0x73, 0x74, 0x56,
+	0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x50, 0x6f, 0x77, 0x65, 0x72, 0x12, 0x32, 0x0a,
+	0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x18,
+	0xd2, 0xb4, 0x2d, 0x14, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x41, 0x64, 0x64, 0x72, 0x65,
+	0x73, 0x73, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x52, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73,
+	0x73, 0x12, 0x14, 0x0a, 0x05, 0x70, 0x6f, 0x77, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03,
+	0x52, 0x05, 0x70, 0x6f, 0x77, 0x65, 0x72, 0x3a, 0x08, 0x88, 0xa0, 0x1f, 0x00, 0xe8, 0xa0, 0x1f,
+	0x00, 0x42, 0xdc, 0x01, 0x0a, 0x1a, 0x63, 0x6f, 0x6d, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73,
+	0x2e, 0x73, 0x74, 0x61, 0x6b, 0x69, 0x6e, 0x67, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31,
+	0x42, 0x0c, 0x47, 0x65, 0x6e, 0x65, 0x73, 0x69, 0x73, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50, 0x01,
+	0x5a, 0x36, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f, 0x2f, 0x61,
+	0x70, 0x69, 0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2f, 0x73, 0x74, 0x61, 0x6b, 0x69, 0x6e,
+	0x67, 0x2f, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x3b, 0x73, 0x74, 0x61, 0x6b, 0x69, 0x6e,
+	0x67, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0xa2, 0x02, 0x03, 0x43, 0x53, 0x58, 0xaa, 0x02,
+	0x16, 0x43, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x53, 0x74, 0x61, 0x6b, 0x69, 0x6e, 0x67, 0x2e,
+	0x56, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0xca, 0x02, 0x16, 0x43, 0x6f, 0x73, 0x6d, 0x6f, 0x73,
+	0x5c, 0x53, 0x74, 0x61, 0x6b, 0x69, 0x6e, 0x67, 0x5c, 0x56, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31,
+	0xe2, 0x02, 0x22, 0x43, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x5c, 0x53, 0x74, 0x61, 0x6b, 0x69, 0x6e,
+	0x67, 0x5c, 0x56, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x5c, 0x47, 0x50, 0x42, 0x4d, 0x65, 0x74,
+	0x61, 0x64, 0x61, 0x74, 0x61, 0xea, 0x02, 0x18, 0x43, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x3a, 0x3a,
+	0x53, 0x74, 0x61, 0x6b, 0x69, 0x6e, 0x67, 0x3a, 0x3a, 0x56, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31,
+	0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -1981,26 +3456,42 @@ func file_cosmos_staking_v1beta1_genesis_proto_rawDescGZIP() []byte {
 
 var file_cosmos_staking_v1beta1_genesis_proto_msgTypes = make([]protoimpl.MessageInfo, 2)
 var file_cosmos_staking_v1beta1_genesis_proto_goTypes = []interface{}{
-	(*GenesisState)(nil),        // 0: cosmos.staking.v1beta1.GenesisState
-	(*LastValidatorPower)(nil),  // 1: cosmos.staking.v1beta1.LastValidatorPower
-	(*Params)(nil),              // 2: cosmos.staking.v1beta1.Params
-	(*Validator)(nil),           // 3: cosmos.staking.v1beta1.Validator
-	(*Delegation)(nil),          // 4: cosmos.staking.v1beta1.Delegation
-	(*UnbondingDelegation)(nil), // 5: cosmos.staking.v1beta1.UnbondingDelegation
-	(*Redelegation)(nil),        // 6: cosmos.staking.v1beta1.Redelegation
+	(*GenesisState)(nil),            // 0: cosmos.staking.v1beta1.GenesisState
+	(*LastValidatorPower)(nil),      // 1: cosmos.staking.v1beta1.LastValidatorPower
+	(*Params)(nil),                  // 2: cosmos.staking.v1beta1.Params
+	(*Validator)(nil),               // 3: cosmos.staking.v1beta1.Validator
+	(*Delegation)(nil),              // 4: cosmos.staking.v1beta1.Delegation
+	(*UnbondingDelegation)(nil),     // 5: cosmos.staking.v1beta1.UnbondingDelegation
+	(*Redelegation)(nil),            // 6: cosmos.staking.v1beta1.Redelegation
+	(*TokenizeShareRecord)(nil),     // 7: cosmos.staking.v1beta1.TokenizeShareRecord
+	(*PendingCommissionChange)(nil), // 8: cosmos.staking.v1beta1.PendingCommissionChange
+	(*QueuedEpochMsg)(nil),          // 9: cosmos.staking.v1beta1.QueuedEpochMsg
+	(*DelegationLock)(nil),          // 10: cosmos.staking.v1beta1.DelegationLock
+	(*ValidatorRiskTier)(nil),       // 11: cosmos.staking.v1beta1.ValidatorRiskTier
+	(*ValidatorJailInfo)(nil),       // 12: cosmos.staking.v1beta1.ValidatorJailInfo
+	(*ProfileAttestation)(nil),      // 13: cosmos.staking.v1beta1.ProfileAttestation
+	(*StandbyConsPubKey)(nil),       // 14: cosmos.staking.v1beta1.StandbyConsPubKey
 }
 var file_cosmos_staking_v1beta1_genesis_proto_depIdxs = []int32{
-	2, // 0: cosmos.staking.v1beta1.GenesisState.params:type_name -> cosmos.staking.v1beta1.Params
-	1, // 1: cosmos.staking.v1beta1.GenesisState.last_validator_powers:type_name -> cosmos.staking.v1beta1.LastValidatorPower
-	3, // 2: cosmos.staking.v1beta1.GenesisState.validators:type_name -> cosmos.staking.v1beta1.Validator
-	4, // 3: cosmos.staking.v1beta1.GenesisState.delegations:type_name -> cosmos.staking.v1beta1.Delegation
-	5, // 4: cosmos.staking.v1beta1.GenesisState.unbonding_delegations:type_name -> cosmos.staking.v1beta1.UnbondingDelegation
-	6, // 5: cosmos.staking.v1beta1.GenesisState.redelegations:type_name -> cosmos.staking.v1beta1.Redelegation
-	6, // [6:6] is the sub-list for method output_type
-	6, // [6:6] is the sub-list for method input_type
-	6, // [6:6] is the sub-list for extension type_name
-	6, // [6:6] is the sub-list for extension extendee
-	0, // [0:6] is the sub-list for field type_name
+	2,  // 0: cosmos.staking.v1beta1.GenesisState.params:type_name -> cosmos.staking.v1beta1.Params
+	1,  // 1: cosmos.staking.v1beta1.GenesisState.last_validator_powers:type_name -> cosmos.staking.v1beta1.LastValidatorPower
+	3,  // 2: cosmos.staking.v1beta1.GenesisState.validators:type_name -> cosmos.staking.v1beta1.Validator
+	4,  // 3: cosmos.staking.v1beta1.GenesisState.delegations:type_name -> cosmos.staking.v1beta1.Delegation
+	5,  // 4: cosmos.staking.v1beta1.GenesisState.unbonding_delegations:type_name -> cosmos.staking.v1beta1.UnbondingDelegation
+	6,  // 5: cosmos.staking.v1beta1.GenesisState.redelegations:type_name -> cosmos.staking.v1beta1.Redelegation
+	7,  // 6: cosmos.staking.v1beta1.GenesisState.tokenize_share_records:type_name -> cosmos.staking.v1beta1.TokenizeShareRecord
+	8,  // 7: cosmos.staking.v1beta1.GenesisState.pending_commission_changes:type_name -> cosmos.staking.v1beta1.PendingCommissionChange
+	9,  // 8: cosmos.staking.v1beta1.GenesisState.queued_epoch_msgs:type_name -> cosmos.staking.v1beta1.QueuedEpochMsg
+	10, // 9: cosmos.staking.v1beta1.GenesisState.delegation_locks:type_name -> cosmos.staking.v1beta1.DelegationLock
+	11, // 10: cosmos.staking.v1beta1.GenesisState.validator_risk_tiers:type_name -> cosmos.staking.v1beta1.ValidatorRiskTier
+	12, // 11: cosmos.staking.v1beta1.GenesisState.validator_jail_infos:type_name -> cosmos.staking.v1beta1.ValidatorJailInfo
+	13, // 12: cosmos.staking.v1beta1.GenesisState.profile_attestations:type_name -> cosmos.staking.v1beta1.ProfileAttestation
+	14, // 13: cosmos.staking.v1beta1.GenesisState.standby_cons_pubkeys:type_name -> cosmos.staking.v1beta1.StandbyConsPubKey
+	14, // [14:14] is the sub-list for method output_type
+	14, // [14:14] is the sub-list for method input_type
+	14, // [14:14] is the sub-list for extension type_name
+	14, // [14:14] is the sub-list for extension extendee
+	0,  // [0:14] is the sub-list for field type_name
 }
 
 func init() { file_cosmos_staking_v1beta1_genesis_proto_init() }
@@ -19,20 +19,33 @@ import (
 const _ = grpc.SupportPackageIsVersion7
 
 const (
-	Query_Validators_FullMethodName                    = "/cosmos.staking.v1beta1.Query/Validators"
-	Query_Validator_FullMethodName                     = "/cosmos.staking.v1beta1.Query/Validator"
-	Query_ValidatorDelegations_FullMethodName          = "/cosmos.staking.v1beta1.Query/ValidatorDelegations"
-	Query_ValidatorUnbondingDelegations_FullMethodName = "/cosmos.staking.v1beta1.Query/ValidatorUnbondingDelegations"
-	Query_Delegation_FullMethodName                    = "/cosmos.staking.v1beta1.Query/Delegation"
-	Query_UnbondingDelegation_FullMethodName           = "/cosmos.staking.v1beta1.Query/UnbondingDelegation"
-	Query_DelegatorDelegations_FullMethodName          = "/cosmos.staking.v1beta1.Query/DelegatorDelegations"
-	Query_DelegatorUnbondingDelegations_FullMethodName = "/cosmos.staking.v1beta1.Query/DelegatorUnbondingDelegations"
-	Query_Redelegations_FullMethodName                 = "/cosmos.staking.v1beta1.Query/Redelegations"
-	Query_DelegatorValidators_FullMethodName           = "/cosmos.staking.v1beta1.Query/DelegatorValidators"
-	Query_DelegatorValidator_FullMethodName            = "/cosmos.staking.v1beta1.Query/DelegatorValidator"
-	Query_HistoricalInfo_FullMethodName                = "/cosmos.staking.v1beta1.Query/HistoricalInfo"
-	Query_Pool_FullMethodName                          = "/cosmos.staking.v1beta1.Query/Pool"
-	Query_Params_FullMethodName                        = "/cosmos.staking.v1beta1.Query/Params"
+	Query_Validators_FullMethodName                      = "/cosmos.staking.v1beta1.Query/Validators"
+	Query_Validator_FullMethodName                       = "/cosmos.staking.v1beta1.Query/Validator"
+	Query_ValidatorDelegations_FullMethodName            = "/cosmos.staking.v1beta1.Query/ValidatorDelegations"
+	Query_ValidatorUnbondingDelegations_FullMethodName   = "/cosmos.staking.v1beta1.Query/ValidatorUnbondingDelegations"
+	Query_Delegation_FullMethodName                      = "/cosmos.staking.v1beta1.Query/Delegation"
+	Query_UnbondingDelegation_FullMethodName             = "/cosmos.staking.v1beta1.Query/UnbondingDelegation"
+	Query_DelegatorDelegations_FullMethodName            = "/cosmos.staking.v1beta1.Query/DelegatorDelegations"
+	Query_DelegatorUnbondingDelegations_FullMethodName   = "/cosmos.staking.v1beta1.Query/DelegatorUnbondingDelegations"
+	Query_Redelegations_FullMethodName                   = "/cosmos.staking.v1beta1.Query/Redelegations"
+	Query_DelegatorValidators_FullMethodName             = "/cosmos.staking.v1beta1.Query/DelegatorValidators"
+	Query_DelegatorValidator_FullMethodName              = "/cosmos.staking.v1beta1.Query/DelegatorValidator"
+	Query_HistoricalInfo_FullMethodName                  = "/cosmos.staking.v1beta1.Query/HistoricalInfo"
+	Query_Pool_FullMethodName                            = "/cosmos.staking.v1beta1.Query/Pool"
+	Query_Params_FullMethodName                          = "/cosmos.staking.v1beta1.Query/Params"
+	Query_TokenizeShareRecord_FullMethodName             = "/cosmos.staking.v1beta1.Query/TokenizeShareRecord"
+	Query_TokenizeShareRecordsOwned_FullMethodName       = "/cosmos.staking.v1beta1.Query/TokenizeShareRecordsOwned"
+	Query_TotalLiquidStaked_FullMethodName               = "/cosmos.staking.v1beta1.Query/TotalLiquidStaked"
+	Query_PendingCommissionChange_FullMethodName         = "/cosmos.staking.v1beta1.Query/PendingCommissionChange"
+	Query_ValidatorsNearMinSelfDelegation_FullMethodName = "/cosmos.staking.v1beta1.Query/ValidatorsNearMinSelfDelegation"
+	Query_EpochQueue_FullMethodName                      = "/cosmos.staking.v1beta1.Query/EpochQueue"
+	Query_DelegationLock_FullMethodName                  = "/cosmos.staking.v1beta1.Query/DelegationLock"
+	Query_DelegatorDelegationLocks_FullMethodName        = "/cosmos.staking.v1beta1.Query/DelegatorDelegationLocks"
+	Query_ValidatorProfile_FullMethodName                = "/cosmos.staking.v1beta1.Query/ValidatorProfile"
+	Query_ValidatorRiskTier_FullMethodName               = "/cosmos.staking.v1beta1.Query/ValidatorRiskTier"
+	Query_ValidatorSetChanges_FullMethodName             = "/cosmos.staking.v1beta1.Query/ValidatorSetChanges"
+	Query_StakingPaused_FullMethodName                   = "/cosmos.staking.v1beta1.Query/StakingPaused"
+	Query_StandbyConsPubKey_FullMethodName               = "/cosmos.staking.v1beta1.Query/StandbyConsPubKey"
 )
 
 // QueryClient is the client API for Query service.
@@ -92,6 +105,76 @@ type QueryClient interface {
 	Pool(ctx context.Context, in *QueryPoolRequest, opts ...grpc.CallOption) (*QueryPoolResponse, error)
 	// Parameters queries the staking parameters.
 	Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error)
+	// TokenizeShareRecord queries a tokenize share record by its id.
+	//
+	// Since: cosmos-sdk 0.48
+	TokenizeShareRecord(ctx context.Context, in *QueryTokenizeShareRecordRequest, opts ...grpc.CallOption) (*QueryTokenizeShareRecordResponse, error)
+	// TokenizeShareRecordsOwned queries the tokenize share records owned by an
+	// address.
+	//
+	// Since: cosmos-sdk 0.48
+	TokenizeShareRecordsOwned(ctx context.Context, in *QueryTokenizeShareRecordsOwnedRequest, opts ...grpc.CallOption) (*QueryTokenizeShareRecordsOwnedResponse, error)
+	// TotalLiquidStaked queries the total amount of liquid staked tokens.
+	//
+	// Since: cosmos-sdk 0.48
+	TotalLiquidStaked(ctx context.Context, in *QueryTotalLiquidStakedRequest, opts ...grpc.CallOption) (*QueryTotalLiquidStakedResponse, error)
+	// PendingCommissionChange queries the commission rate change announced by a
+	// validator which has not taken effect yet.
+	//
+	// Since: cosmos-sdk 0.48
+	PendingCommissionChange(ctx context.Context, in *QueryPendingCommissionChangeRequest, opts ...grpc.CallOption) (*QueryPendingCommissionChangeResponse, error)
+	// ValidatorsNearMinSelfDelegation queries the validators whose self
+	// delegation is within the given margin above their effective minimum self
+	// delegation, or below it.
+	//
+	// When called from another module, this query might consume a high amount of
+	// gas if the pagination field is incorrectly set.
+	//
+	// Since: cosmos-sdk 0.48
+	ValidatorsNearMinSelfDelegation(ctx context.Context, in *QueryValidatorsNearMinSelfDelegationRequest, opts ...grpc.CallOption) (*QueryValidatorsNearMinSelfDelegationResponse, error)
+	// EpochQueue queries the staking messages queued until the end of the current
+	// epoch, in queue order.
+	//
+	// Since: cosmos-sdk 0.48
+	EpochQueue(ctx context.Context, in *QueryEpochQueueRequest, opts ...grpc.CallOption) (*QueryEpochQueueResponse, error)
+	// DelegationLock queries the lock of a delegation, if any.
+	//
+	// Since: cosmos-sdk 0.48
+	DelegationLock(ctx context.Context, in *QueryDelegationLockRequest, opts ...grpc.CallOption) (*QueryDelegationLockResponse, error)
+	// DelegatorDelegationLocks queries the locks of all the delegations of a
+	// delegator.
+	//
+	// Since: cosmos-sdk 0.48
+	DelegatorDelegationLocks(ctx context.Context, in *QueryDelegatorDelegationLocksRequest, opts ...grpc.CallOption) (*QueryDelegatorDelegationLocksResponse, error)
+	// ValidatorProfile queries the description and profile metadata of a
+	// validator.
+	//
+	// Since: cosmos-sdk 0.48
+	ValidatorProfile(ctx context.Context, in *QueryValidatorProfileRequest, opts ...grpc.CallOption) (*QueryValidatorProfileResponse, error)
+	// ValidatorRiskTier queries the unbonding tier of a validator, and the
+	// unbonding time applied to it.
+	//
+	// Since: cosmos-sdk 0.48
+	ValidatorRiskTier(ctx context.Context, in *QueryValidatorRiskTierRequest, opts ...grpc.CallOption) (*QueryValidatorRiskTierResponse, error)
+	// ValidatorSetChanges queries the validator set changes, i.e. the joins,
+	// exits and power changes, over a range of heights, as recorded by the
+	// historical info entries.
+	//
+	// The height range cannot span more than 1000 heights. When called from
+	// another module, this query might consume a high amount of gas if the
+	// height range is large.
+	//
+	// Since: cosmos-sdk 0.48
+	ValidatorSetChanges(ctx context.Context, in *QueryValidatorSetChangesRequest, opts ...grpc.CallOption) (*QueryValidatorSetChangesResponse, error)
+	// StakingPaused queries whether the delegations, undelegations and
+	// redelegations are paused.
+	//
+	// Since: cosmos-sdk 0.48
+	StakingPaused(ctx context.Context, in *QueryStakingPausedRequest, opts ...grpc.CallOption) (*QueryStakingPausedResponse, error)
+	// StandbyConsPubKey queries the standby consensus key of a validator.
+	//
+	// Since: cosmos-sdk 0.48
+	StandbyConsPubKey(ctx context.Context, in *QueryStandbyConsPubKeyRequest, opts ...grpc.CallOption) (*QueryStandbyConsPubKeyResponse, error)
 }
 
 type queryClient struct {
@@ -228,6 +311,123 @@ func (c *queryClient) Params(ctx context.Context, in *QueryParamsRequest, opts .
 	return out, nil
 }
 
+func (c *queryClient) TokenizeShareRecord(ctx context.Context, in *QueryTokenizeShareRecordRequest, opts ...grpc.CallOption) (*QueryTokenizeShareRecordResponse, error) {
+	out := new(QueryTokenizeShareRecordResponse)
+	err := c.cc.Invoke(ctx, Query_TokenizeShareRecord_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) TokenizeShareRecordsOwned(ctx context.Context, in *QueryTokenizeShareRecordsOwnedRequest, opts ...grpc.CallOption) (*QueryTokenizeShareRecordsOwnedResponse, error) {
+	out := new(QueryTokenizeShareRecordsOwnedResponse)
+	err := c.cc.Invoke(ctx, Query_TokenizeShareRecordsOwned_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) TotalLiquidStaked(ctx context.Context, in *QueryTotalLiquidStakedRequest, opts ...grpc.CallOption) (*QueryTotalLiquidStakedResponse, error) {
+	out := new(QueryTotalLiquidStakedResponse)
+	err := c.cc.Invoke(ctx, Query_TotalLiquidStaked_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) PendingCommissionChange(ctx context.Context, in *QueryPendingCommissionChangeRequest, opts ...grpc.CallOption) (*QueryPendingCommissionChangeResponse, error) {
+	out := new(QueryPendingCommissionChangeResponse)
+	err := c.cc.Invoke(ctx, Query_PendingCommissionChange_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) ValidatorsNearMinSelfDelegation(ctx context.Context, in *QueryValidatorsNearMinSelfDelegationRequest, opts ...grpc.CallOption) (*QueryValidatorsNearMinSelfDelegationResponse, error) {
+	out := new(QueryValidatorsNearMinSelfDelegationResponse)
+	err := c.cc.Invoke(ctx, Query_ValidatorsNearMinSelfDelegation_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) EpochQueue(ctx context.Context, in *QueryEpochQueueRequest, opts ...grpc.CallOption) (*QueryEpochQueueResponse, error) {
+	out := new(QueryEpochQueueResponse)
+	err := c.cc.Invoke(ctx, Query_EpochQueue_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) DelegationLock(ctx context.Context, in *QueryDelegationLockRequest, opts ...grpc.CallOption) (*QueryDelegationLockResponse, error) {
+	out := new(QueryDelegationLockResponse)
+	err := c.cc.Invoke(ctx, Query_DelegationLock_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) DelegatorDelegationLocks(ctx context.Context, in *QueryDelegatorDelegationLocksRequest, opts ...grpc.CallOption) (*QueryDelegatorDelegationLocksResponse, error) {
+	out := new(QueryDelegatorDelegationLocksResponse)
+	err := c.cc.Invoke(ctx, Query_DelegatorDelegationLocks_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) ValidatorProfile(ctx context.Context, in *QueryValidatorProfileRequest, opts ...grpc.CallOption) (*QueryValidatorProfileResponse, error) {
+	out := new(QueryValidatorProfileResponse)
+	err := c.cc.Invoke(ctx, Query_ValidatorProfile_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) ValidatorRiskTier(ctx context.Context, in *QueryValidatorRiskTierRequest, opts ...grpc.CallOption) (*QueryValidatorRiskTierResponse, error) {
+	out := new(QueryValidatorRiskTierResponse)
+	err := c.cc.Invoke(ctx, Query_ValidatorRiskTier_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) ValidatorSetChanges(ctx context.Context, in *QueryValidatorSetChangesRequest, opts ...grpc.CallOption) (*QueryValidatorSetChangesResponse, error) {
+	out := new(QueryValidatorSetChangesResponse)
+	err := c.cc.Invoke(ctx, Query_ValidatorSetChanges_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) StakingPaused(ctx context.Context, in *QueryStakingPausedRequest, opts ...grpc.CallOption) (*QueryStakingPausedResponse, error) {
+	out := new(QueryStakingPausedResponse)
+	err := c.cc.Invoke(ctx, Query_StakingPaused_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) StandbyConsPubKey(ctx context.Context, in *QueryStandbyConsPubKeyRequest, opts ...grpc.CallOption) (*QueryStandbyConsPubKeyResponse, error) {
+	out := new(QueryStandbyConsPubKeyResponse)
+	err := c.cc.Invoke(ctx, Query_StandbyConsPubKey_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 // All implementations must embed UnimplementedQueryServer
 // for forward compatibility
@@ -285,6 +485,76 @@ type QueryServer interface {
 	Pool(context.Context, *QueryPoolRequest) (*QueryPoolResponse, error)
 	// Parameters queries the staking parameters.
 	Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error)
+	// TokenizeShareRecord queries a tokenize share record by its id.
+	//
+	// Since: cosmos-sdk 0.48
+	TokenizeShareRecord(context.Context, *QueryTokenizeShareRecordRequest) (*QueryTokenizeShareRecordResponse, error)
+	// TokenizeShareRecordsOwned queries the tokenize share records owned by an
+	// address.
+	//
+	// Since: cosmos-sdk 0.48
+	TokenizeShareRecordsOwned(context.Context, *QueryTokenizeShareRecordsOwnedRequest) (*QueryTokenizeShareRecordsOwnedResponse, error)
+	// TotalLiquidStaked queries the total amount of liquid staked tokens.
+	//
+	// Since: cosmos-sdk 0.48
+	TotalLiquidStaked(context.Context, *QueryTotalLiquidStakedRequest) (*QueryTotalLiquidStakedResponse, error)
+	// PendingCommissionChange queries the commission rate change announced by a
+	// validator which has not taken effect yet.
+	//
+	// Since: cosmos-sdk 0.48
+	PendingCommissionChange(context.Context, *QueryPendingCommissionChangeRequest) (*QueryPendingCommissionChangeResponse, error)
+	// ValidatorsNearMinSelfDelegation queries the validators whose self
+	// delegation is within the given margin above their effective minimum self
+	// delegation, or below it.
+	//
+	// When called from another module, this query might consume a high amount of
+	// gas if the pagination field is incorrectly set.
+	//
+	// Since: cosmos-sdk 0.48
+	ValidatorsNearMinSelfDelegation(context.Context, *QueryValidatorsNearMinSelfDelegationRequest) (*QueryValidatorsNearMinSelfDelegationResponse, error)
+	// EpochQueue queries the staking messages queued until the end of the current
+	// epoch, in queue order.
+	//
+	// Since: cosmos-sdk 0.48
+	EpochQueue(context.Context, *QueryEpochQueueRequest) (*QueryEpochQueueResponse, error)
+	// DelegationLock queries the lock of a delegation, if any.
+	//
+	// Since: cosmos-sdk 0.48
+	DelegationLock(context.Context, *QueryDelegationLockRequest) (*QueryDelegationLockResponse, error)
+	// DelegatorDelegationLocks queries the locks of all the delegations of a
+	// delegator.
+	//
+	// Since: cosmos-sdk 0.48
+	DelegatorDelegationLocks(context.Context, *QueryDelegatorDelegationLocksRequest) (*QueryDelegatorDelegationLocksResponse, error)
+	// ValidatorProfile queries the description and profile metadata of a
+	// validator.
+	//
+	// Since: cosmos-sdk 0.48
+	ValidatorProfile(context.Context, *QueryValidatorProfileRequest) (*QueryValidatorProfileResponse, error)
+	// ValidatorRiskTier queries the unbonding tier of a validator, and the
+	// unbonding time applied to it.
+	//
+	// Since: cosmos-sdk 0.48
+	ValidatorRiskTier(context.Context, *QueryValidatorRiskTierRequest) (*QueryValidatorRiskTierResponse, error)
+	// ValidatorSetChanges queries the validator set changes, i.e. the joins,
+	// exits and power changes, over a range of heights, as recorded by the
+	// historical info entries.
+	//
+	// The height range cannot span more than 1000 heights. When called from
+	// another module, this query might consume a high amount of gas if the
+	// height range is large.
+	//
+	// Since: cosmos-sdk 0.48
+	ValidatorSetChanges(context.Context, *QueryValidatorSetChangesRequest) (*QueryValidatorSetChangesResponse, error)
+	// StakingPaused queries whether the delegations, undelegations and
+	// redelegations are paused.
+	//
+	// Since: cosmos-sdk 0.48
+	StakingPaused(context.Context, *QueryStakingPausedRequest) (*QueryStakingPausedResponse, error)
+	// StandbyConsPubKey queries the standby consensus key of a validator.
+	//
+	// Since: cosmos-sdk 0.48
+	StandbyConsPubKey(context.Context, *QueryStandbyConsPubKeyRequest) (*QueryStandbyConsPubKeyResponse, error)
 	mustEmbedUnimplementedQueryServer()
 }
 
@@ -334,6 +604,45 @@ func (UnimplementedQueryServer) Pool(context.Context, *QueryPoolRequest) (*Query
 func (UnimplementedQueryServer) Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Params not implemented")
 }
+func (UnimplementedQueryServer) TokenizeShareRecord(context.Context, *QueryTokenizeShareRecordRequest) (*QueryTokenizeShareRecordResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TokenizeShareRecord not implemented")
+}
+func (UnimplementedQueryServer) TokenizeShareRecordsOwned(context.Context, *QueryTokenizeShareRecordsOwnedRequest) (*QueryTokenizeShareRecordsOwnedResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TokenizeShareRecordsOwned not implemented")
+}
+func (UnimplementedQueryServer) TotalLiquidStaked(context.Context, *QueryTotalLiquidStakedRequest) (*QueryTotalLiquidStakedResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TotalLiquidStaked not implemented")
+}
+func (UnimplementedQueryServer) PendingCommissionChange(context.Context, *QueryPendingCommissionChangeRequest) (*QueryPendingCommissionChangeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PendingCommissionChange not implemented")
+}
+func (UnimplementedQueryServer) ValidatorsNearMinSelfDelegation(context.Context, *QueryValidatorsNearMinSelfDelegationRequest) (*QueryValidatorsNearMinSelfDelegationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ValidatorsNearMinSelfDelegation not implemented")
+}
+func (UnimplementedQueryServer) EpochQueue(context.Context, *QueryEpochQueueRequest) (*QueryEpochQueueResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method EpochQueue not implemented")
+}
+func (UnimplementedQueryServer) DelegationLock(context.Context, *QueryDelegationLockRequest) (*QueryDelegationLockResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DelegationLock not implemented")
+}
+func (UnimplementedQueryServer) DelegatorDelegationLocks(context.Context, *QueryDelegatorDelegationLocksRequest) (*QueryDelegatorDelegationLocksResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DelegatorDelegationLocks not implemented")
+}
+func (UnimplementedQueryServer) ValidatorProfile(context.Context, *QueryValidatorProfileRequest) (*QueryValidatorProfileResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ValidatorProfile not implemented")
+}
+func (UnimplementedQueryServer) ValidatorRiskTier(context.Context, *QueryValidatorRiskTierRequest) (*QueryValidatorRiskTierResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ValidatorRiskTier not implemented")
+}
+func (UnimplementedQueryServer) ValidatorSetChanges(context.Context, *QueryValidatorSetChangesRequest) (*QueryValidatorSetChangesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ValidatorSetChanges not implemented")
+}
+func (UnimplementedQueryServer) StakingPaused(context.Context, *QueryStakingPausedRequest) (*QueryStakingPausedResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method StakingPaused not implemented")
+}
+func (UnimplementedQueryServer) StandbyConsPubKey(context.Context, *QueryStandbyConsPubKeyRequest) (*QueryStandbyConsPubKeyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method StandbyConsPubKey not implemented")
+}
 func (UnimplementedQueryServer) mustEmbedUnimplementedQueryServer() {}
 
 // UnsafeQueryServer may be embedded to opt out of forward compatibility for this service.
@@ -599,6 +908,240 @@ func _Query_Params_Handler(srv interface{}, ctx context.Context, dec func(interf
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_TokenizeShareRecord_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryTokenizeShareRecordRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).TokenizeShareRecord(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_TokenizeShareRecord_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).TokenizeShareRecord(ctx, req.(*QueryTokenizeShareRecordRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_TokenizeShareRecordsOwned_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryTokenizeShareRecordsOwnedRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).TokenizeShareRecordsOwned(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_TokenizeShareRecordsOwned_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).TokenizeShareRecordsOwned(ctx, req.(*QueryTokenizeShareRecordsOwnedRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_TotalLiquidStaked_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryTotalLiquidStakedRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).TotalLiquidStaked(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_TotalLiquidStaked_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).TotalLiquidStaked(ctx, req.(*QueryTotalLiquidStakedRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_PendingCommissionChange_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryPendingCommissionChangeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).PendingCommissionChange(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_PendingCommissionChange_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).PendingCommissionChange(ctx, req.(*QueryPendingCommissionChangeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ValidatorsNearMinSelfDelegation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryValidatorsNearMinSelfDelegationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ValidatorsNearMinSelfDelegation(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_ValidatorsNearMinSelfDelegation_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ValidatorsNearMinSelfDelegation(ctx, req.(*QueryValidatorsNearMinSelfDelegationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_EpochQueue_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryEpochQueueRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).EpochQueue(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_EpochQueue_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).EpochQueue(ctx, req.(*QueryEpochQueueRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_DelegationLock_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryDelegationLockRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).DelegationLock(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_DelegationLock_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).DelegationLock(ctx, req.(*QueryDelegationLockRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_DelegatorDelegationLocks_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryDelegatorDelegationLocksRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).DelegatorDelegationLocks(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_DelegatorDelegationLocks_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).DelegatorDelegationLocks(ctx, req.(*QueryDelegatorDelegationLocksRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ValidatorProfile_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryValidatorProfileRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ValidatorProfile(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_ValidatorProfile_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ValidatorProfile(ctx, req.(*QueryValidatorProfileRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ValidatorRiskTier_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryValidatorRiskTierRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ValidatorRiskTier(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_ValidatorRiskTier_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ValidatorRiskTier(ctx, req.(*QueryValidatorRiskTierRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ValidatorSetChanges_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryValidatorSetChangesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ValidatorSetChanges(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_ValidatorSetChanges_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ValidatorSetChanges(ctx, req.(*QueryValidatorSetChangesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_StakingPaused_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryStakingPausedRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).StakingPaused(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_StakingPaused_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).StakingPaused(ctx, req.(*QueryStakingPausedRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_StandbyConsPubKey_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryStandbyConsPubKeyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).StandbyConsPubKey(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_StandbyConsPubKey_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).StandbyConsPubKey(ctx, req.(*QueryStandbyConsPubKeyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // Query_ServiceDesc is the grpc.ServiceDesc for Query service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -662,6 +1205,58 @@ var Query_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "Params",
 			Handler:    _Query_Params_Handler,
 		},
+		{
+			MethodName: "TokenizeShareRecord",
+			Handler:    _Query_TokenizeShareRecord_Handler,
+		},
+		{
+			MethodName: "TokenizeShareRecordsOwned",
+			Handler:    _Query_TokenizeShareRecordsOwned_Handler,
+		},
+		{
+			MethodName: "TotalLiquidStaked",
+			Handler:    _Query_TotalLiquidStaked_Handler,
+		},
+		{
+			MethodName: "PendingCommissionChange",
+			Handler:    _Query_PendingCommissionChange_Handler,
+		},
+		{
+			MethodName: "ValidatorsNearMinSelfDelegation",
+			Handler:    _Query_ValidatorsNearMinSelfDelegation_Handler,
+		},
+		{
+			MethodName: "EpochQueue",
+			Handler:    _Query_EpochQueue_Handler,
+		},
+		{
+			MethodName: "DelegationLock",
+			Handler:    _Query_DelegationLock_Handler,
+		},
+		{
+			MethodName: "DelegatorDelegationLocks",
+			Handler:    _Query_DelegatorDelegationLocks_Handler,
+		},
+		{
+			MethodName: "ValidatorProfile",
+			Handler:    _Query_ValidatorProfile_Handler,
+		},
+		{
+			MethodName: "ValidatorRiskTier",
+			Handler:    _Query_ValidatorRiskTier_Handler,
+		},
+		{
+			MethodName: "ValidatorSetChanges",
+			Handler:    _Query_ValidatorSetChanges_Handler,
+		},
+		{
+			MethodName: "StakingPaused",
+			Handler:    _Query_StakingPaused_Handler,
+		},
+		{
+			MethodName: "StandbyConsPubKey",
+			Handler:    _Query_StandbyConsPubKey_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "cosmos/staking/v1beta1/query.proto",
@@ -19,13 +19,25 @@ import (
 const _ = grpc.SupportPackageIsVersion7
 
 const (
-	Msg_CreateValidator_FullMethodName           = "/cosmos.staking.v1beta1.Msg/CreateValidator"
-	Msg_EditValidator_FullMethodName             = "/cosmos.staking.v1beta1.Msg/EditValidator"
-	Msg_Delegate_FullMethodName                  = "/cosmos.staking.v1beta1.Msg/Delegate"
-	Msg_BeginRedelegate_FullMethodName           = "/cosmos.staking.v1beta1.Msg/BeginRedelegate"
-	Msg_Undelegate_FullMethodName                = "/cosmos.staking.v1beta1.Msg/Undelegate"
-	Msg_CancelUnbondingDelegation_FullMethodName = "/cosmos.staking.v1beta1.Msg/CancelUnbondingDelegation"
-	Msg_UpdateParams_FullMethodName              = "/cosmos.staking.v1beta1.Msg/UpdateParams"
+	Msg_CreateValidator_FullMethodName             = "/cosmos.staking.v1beta1.Msg/CreateValidator"
+	Msg_EditValidator_FullMethodName               = "/cosmos.staking.v1beta1.Msg/EditValidator"
+	Msg_Delegate_FullMethodName                    = "/cosmos.staking.v1beta1.Msg/Delegate"
+	Msg_BeginRedelegate_FullMethodName             = "/cosmos.staking.v1beta1.Msg/BeginRedelegate"
+	Msg_Undelegate_FullMethodName                  = "/cosmos.staking.v1beta1.Msg/Undelegate"
+	Msg_CancelUnbondingDelegation_FullMethodName   = "/cosmos.staking.v1beta1.Msg/CancelUnbondingDelegation"
+	Msg_UpdateParams_FullMethodName                = "/cosmos.staking.v1beta1.Msg/UpdateParams"
+	Msg_TokenizeShares_FullMethodName              = "/cosmos.staking.v1beta1.Msg/TokenizeShares"
+	Msg_RedeemTokensForShares_FullMethodName       = "/cosmos.staking.v1beta1.Msg/RedeemTokensForShares"
+	Msg_TransferTokenizeShareRecord_FullMethodName = "/cosmos.staking.v1beta1.Msg/TransferTokenizeShareRecord"
+	Msg_ValidatorBond_FullMethodName               = "/cosmos.staking.v1beta1.Msg/ValidatorBond"
+	Msg_CancelRedelegation_FullMethodName          = "/cosmos.staking.v1beta1.Msg/CancelRedelegation"
+	Msg_LockDelegation_FullMethodName              = "/cosmos.staking.v1beta1.Msg/LockDelegation"
+	Msg_RedelegateMulti_FullMethodName             = "/cosmos.staking.v1beta1.Msg/RedelegateMulti"
+	Msg_InstantUndelegate_FullMethodName           = "/cosmos.staking.v1beta1.Msg/InstantUndelegate"
+	Msg_SetValidatorRiskTier_FullMethodName        = "/cosmos.staking.v1beta1.Msg/SetValidatorRiskTier"
+	Msg_SetStakingPaused_FullMethodName            = "/cosmos.staking.v1beta1.Msg/SetStakingPaused"
+	Msg_AttestValidatorProfile_FullMethodName      = "/cosmos.staking.v1beta1.Msg/AttestValidatorProfile"
+	Msg_SetStandbyConsPubKey_FullMethodName        = "/cosmos.staking.v1beta1.Msg/SetStandbyConsPubKey"
 )
 
 // MsgClient is the client API for Msg service.
@@ -54,6 +66,69 @@ type MsgClient interface {
 	// parameters.
 	// Since: cosmos-sdk 0.47
 	UpdateParams(ctx context.Context, in *MsgUpdateParams, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error)
+	// TokenizeShares defines a method for tokenizing the shares of a delegation
+	// into transferable share tokens.
+	//
+	// Since: cosmos-sdk 0.48
+	TokenizeShares(ctx context.Context, in *MsgTokenizeShares, opts ...grpc.CallOption) (*MsgTokenizeSharesResponse, error)
+	// RedeemTokensForShares defines a method for redeeming share tokens for the
+	// delegation shares they represent.
+	//
+	// Since: cosmos-sdk 0.48
+	RedeemTokensForShares(ctx context.Context, in *MsgRedeemTokensForShares, opts ...grpc.CallOption) (*MsgRedeemTokensForSharesResponse, error)
+	// TransferTokenizeShareRecord defines a method for transferring the ownership
+	// of a tokenize share record, and thus its rewards.
+	//
+	// Since: cosmos-sdk 0.48
+	TransferTokenizeShareRecord(ctx context.Context, in *MsgTransferTokenizeShareRecord, opts ...grpc.CallOption) (*MsgTransferTokenizeShareRecordResponse, error)
+	// ValidatorBond defines a method for flagging a delegation as validator bond.
+	//
+	// Since: cosmos-sdk 0.48
+	ValidatorBond(ctx context.Context, in *MsgValidatorBond, opts ...grpc.CallOption) (*MsgValidatorBondResponse, error)
+	// CancelRedelegation defines a method for canceling an in-progress
+	// redelegation entry and delegating the shares back to the source validator.
+	//
+	// Since: cosmos-sdk 0.48
+	CancelRedelegation(ctx context.Context, in *MsgCancelRedelegation, opts ...grpc.CallOption) (*MsgCancelRedelegationResponse, error)
+	// LockDelegation defines a method for locking a delegation for one of the
+	// lockup terms in exchange for a reward multiplier.
+	//
+	// Since: cosmos-sdk 0.48
+	LockDelegation(ctx context.Context, in *MsgLockDelegation, opts ...grpc.CallOption) (*MsgLockDelegationResponse, error)
+	// RedelegateMulti defines a method for atomically redelegating coins from a
+	// source validator to several destination validators.
+	//
+	// Since: cosmos-sdk 0.48
+	RedelegateMulti(ctx context.Context, in *MsgRedelegateMulti, opts ...grpc.CallOption) (*MsgRedelegateMultiResponse, error)
+	// InstantUndelegate defines a method for undelegating coins without waiting
+	// for the unbonding period, for a fee.
+	//
+	// Since: cosmos-sdk 0.48
+	InstantUndelegate(ctx context.Context, in *MsgInstantUndelegate, opts ...grpc.CallOption) (*MsgInstantUndelegateResponse, error)
+	// SetValidatorRiskTier defines a governance operation for assigning a
+	// validator to an unbonding tier.
+	//
+	// Since: cosmos-sdk 0.48
+	SetValidatorRiskTier(ctx context.Context, in *MsgSetValidatorRiskTier, opts ...grpc.CallOption) (*MsgSetValidatorRiskTierResponse, error)
+	// SetStakingPaused defines a governance operation for pausing or resuming
+	// the delegations, undelegations and redelegations, e.g. during an
+	// emergency. The unbonding delegations and redelegations keep maturing
+	// while staking is paused.
+	//
+	// Since: cosmos-sdk 0.48
+	SetStakingPaused(ctx context.Context, in *MsgSetStakingPaused, opts ...grpc.CallOption) (*MsgSetStakingPausedResponse, error)
+	// AttestValidatorProfile defines a method for attesting a field of the
+	// profile of a validator, accepted by the profile verifier registered for
+	// the field.
+	//
+	// Since: cosmos-sdk 0.48
+	AttestValidatorProfile(ctx context.Context, in *MsgAttestValidatorProfile, opts ...grpc.CallOption) (*MsgAttestValidatorProfileResponse, error)
+	// SetStandbyConsPubKey defines a method for a validator to register, replace
+	// or remove its standby consensus key, which replaces its consensus key once
+	// the latter has missed too many consecutive blocks.
+	//
+	// Since: cosmos-sdk 0.48
+	SetStandbyConsPubKey(ctx context.Context, in *MsgSetStandbyConsPubKey, opts ...grpc.CallOption) (*MsgSetStandbyConsPubKeyResponse, error)
 }
 
 type msgClient struct {
@@ -127,6 +202,114 @@ func (c *msgClient) UpdateParams(ctx context.Context, in *MsgUpdateParams, opts
 	return out, nil
 }
 
+func (c *msgClient) TokenizeShares(ctx context.Context, in *MsgTokenizeShares, opts ...grpc.CallOption) (*MsgTokenizeSharesResponse, error) {
+	out := new(MsgTokenizeSharesResponse)
+	err := c.cc.Invoke(ctx, Msg_TokenizeShares_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) RedeemTokensForShares(ctx context.Context, in *MsgRedeemTokensForShares, opts ...grpc.CallOption) (*MsgRedeemTokensForSharesResponse, error) {
+	out := new(MsgRedeemTokensForSharesResponse)
+	err := c.cc.Invoke(ctx, Msg_RedeemTokensForShares_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) TransferTokenizeShareRecord(ctx context.Context, in *MsgTransferTokenizeShareRecord, opts ...grpc.CallOption) (*MsgTransferTokenizeShareRecordResponse, error) {
+	out := new(MsgTransferTokenizeShareRecordResponse)
+	err := c.cc.Invoke(ctx, Msg_TransferTokenizeShareRecord_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) ValidatorBond(ctx context.Context, in *MsgValidatorBond, opts ...grpc.CallOption) (*MsgValidatorBondResponse, error) {
+	out := new(MsgValidatorBondResponse)
+	err := c.cc.Invoke(ctx, Msg_ValidatorBond_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) CancelRedelegation(ctx context.Context, in *MsgCancelRedelegation, opts ...grpc.CallOption) (*MsgCancelRedelegationResponse, error) {
+	out := new(MsgCancelRedelegationResponse)
+	err := c.cc.Invoke(ctx, Msg_CancelRedelegation_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) LockDelegation(ctx context.Context, in *MsgLockDelegation, opts ...grpc.CallOption) (*MsgLockDelegationResponse, error) {
+	out := new(MsgLockDelegationResponse)
+	err := c.cc.Invoke(ctx, Msg_LockDelegation_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) RedelegateMulti(ctx context.Context, in *MsgRedelegateMulti, opts ...grpc.CallOption) (*MsgRedelegateMultiResponse, error) {
+	out := new(MsgRedelegateMultiResponse)
+	err := c.cc.Invoke(ctx, Msg_RedelegateMulti_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) InstantUndelegate(ctx context.Context, in *MsgInstantUndelegate, opts ...grpc.CallOption) (*MsgInstantUndelegateResponse, error) {
+	out := new(MsgInstantUndelegateResponse)
+	err := c.cc.Invoke(ctx, Msg_InstantUndelegate_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) SetValidatorRiskTier(ctx context.Context, in *MsgSetValidatorRiskTier, opts ...grpc.CallOption) (*MsgSetValidatorRiskTierResponse, error) {
+	out := new(MsgSetValidatorRiskTierResponse)
+	err := c.cc.Invoke(ctx, Msg_SetValidatorRiskTier_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) SetStakingPaused(ctx context.Context, in *MsgSetStakingPaused, opts ...grpc.CallOption) (*MsgSetStakingPausedResponse, error) {
+	out := new(MsgSetStakingPausedResponse)
+	err := c.cc.Invoke(ctx, Msg_SetStakingPaused_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) AttestValidatorProfile(ctx context.Context, in *MsgAttestValidatorProfile, opts ...grpc.CallOption) (*MsgAttestValidatorProfileResponse, error) {
+	out := new(MsgAttestValidatorProfileResponse)
+	err := c.cc.Invoke(ctx, Msg_AttestValidatorProfile_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) SetStandbyConsPubKey(ctx context.Context, in *MsgSetStandbyConsPubKey, opts ...grpc.CallOption) (*MsgSetStandbyConsPubKeyResponse, error) {
+	out := new(MsgSetStandbyConsPubKeyResponse)
+	err := c.cc.Invoke(ctx, Msg_SetStandbyConsPubKey_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MsgServer is the server API for Msg service.
 // All implementations must embed UnimplementedMsgServer
 // for forward compatibility
@@ -153,6 +336,69 @@ type MsgServer interface {
 	// parameters.
 	// Since: cosmos-sdk 0.47
 	UpdateParams(context.Context, *MsgUpdateParams) (*MsgUpdateParamsResponse, error)
+	// TokenizeShares defines a method for tokenizing the shares of a delegation
+	// into transferable share tokens.
+	//
+	// Since: cosmos-sdk 0.48
+	TokenizeShares(context.Context, *MsgTokenizeShares) (*MsgTokenizeSharesResponse, error)
+	// RedeemTokensForShares defines a method for redeeming share tokens for the
+	// delegation shares they represent.
+	//
+	// Since: cosmos-sdk 0.48
+	RedeemTokensForShares(context.Context, *MsgRedeemTokensForShares) (*MsgRedeemTokensForSharesResponse, error)
+	// TransferTokenizeShareRecord defines a method for transferring the ownership
+	// of a tokenize share record, and thus its rewards.
+	//
+	// Since: cosmos-sdk 0.48
+	TransferTokenizeShareRecord(context.Context, *MsgTransferTokenizeShareRecord) (*MsgTransferTokenizeShareRecordResponse, error)
+	// ValidatorBond defines a method for flagging a delegation as validator bond.
+	//
+	// Since: cosmos-sdk 0.48
+	ValidatorBond(context.Context, *MsgValidatorBond) (*MsgValidatorBondResponse, error)
+	// CancelRedelegation defines a method for canceling an in-progress
+	// redelegation entry and delegating the shares back to the source validator.
+	//
+	// Since: cosmos-sdk 0.48
+	CancelRedelegation(context.Context, *MsgCancelRedelegation) (*MsgCancelRedelegationResponse, error)
+	// LockDelegation defines a method for locking a delegation for one of the
+	// lockup terms in exchange for a reward multiplier.
+	//
+	// Since: cosmos-sdk 0.48
+	LockDelegation(context.Context, *MsgLockDelegation) (*MsgLockDelegationResponse, error)
+	// RedelegateMulti defines a method for atomically redelegating coins from a
+	// source validator to several destination validators.
+	//
+	// Since: cosmos-sdk 0.48
+	RedelegateMulti(context.Context, *MsgRedelegateMulti) (*MsgRedelegateMultiResponse, error)
+	// InstantUndelegate defines a method for undelegating coins without waiting
+	// for the unbonding period, for a fee.
+	//
+	// Since: cosmos-sdk 0.48
+	InstantUndelegate(context.Context, *MsgInstantUndelegate) (*MsgInstantUndelegateResponse, error)
+	// SetValidatorRiskTier defines a governance operation for assigning a
+	// validator to an unbonding tier.
+	//
+	// Since: cosmos-sdk 0.48
+	SetValidatorRiskTier(context.Context, *MsgSetValidatorRiskTier) (*MsgSetValidatorRiskTierResponse, error)
+	// SetStakingPaused defines a governance operation for pausing or resuming
+	// the delegations, undelegations and redelegations, e.g. during an
+	// emergency. The unbonding delegations and redelegations keep maturing
+	// while staking is paused.
+	//
+	// Since: cosmos-sdk 0.48
+	SetStakingPaused(context.Context, *MsgSetStakingPaused) (*MsgSetStakingPausedResponse, error)
+	// AttestValidatorProfile defines a method for attesting a field of the
+	// profile of a validator, accepted by the profile verifier registered for
+	// the field.
+	//
+	// Since: cosmos-sdk 0.48
+	AttestValidatorProfile(context.Context, *MsgAttestValidatorProfile) (*MsgAttestValidatorProfileResponse, error)
+	// SetStandbyConsPubKey defines a method for a validator to register, replace
+	// or remove its standby consensus key, which replaces its consensus key once
+	// the latter has missed too many consecutive blocks.
+	//
+	// Since: cosmos-sdk 0.48
+	SetStandbyConsPubKey(context.Context, *MsgSetStandbyConsPubKey) (*MsgSetStandbyConsPubKeyResponse, error)
 	mustEmbedUnimplementedMsgServer()
 }
 
@@ -181,6 +427,42 @@ func (UnimplementedMsgServer) CancelUnbondingDelegation(context.Context, *MsgCan
 func (UnimplementedMsgServer) UpdateParams(context.Context, *MsgUpdateParams) (*MsgUpdateParamsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method UpdateParams not implemented")
 }
+func (UnimplementedMsgServer) TokenizeShares(context.Context, *MsgTokenizeShares) (*MsgTokenizeSharesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TokenizeShares not implemented")
+}
+func (UnimplementedMsgServer) RedeemTokensForShares(context.Context, *MsgRedeemTokensForShares) (*MsgRedeemTokensForSharesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RedeemTokensForShares not implemented")
+}
+func (UnimplementedMsgServer) TransferTokenizeShareRecord(context.Context, *MsgTransferTokenizeShareRecord) (*MsgTransferTokenizeShareRecordResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TransferTokenizeShareRecord not implemented")
+}
+func (UnimplementedMsgServer) ValidatorBond(context.Context, *MsgValidatorBond) (*MsgValidatorBondResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ValidatorBond not implemented")
+}
+func (UnimplementedMsgServer) CancelRedelegation(context.Context, *MsgCancelRedelegation) (*MsgCancelRedelegationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CancelRedelegation not implemented")
+}
+func (UnimplementedMsgServer) LockDelegation(context.Context, *MsgLockDelegation) (*MsgLockDelegationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method LockDelegation not implemented")
+}
+func (UnimplementedMsgServer) RedelegateMulti(context.Context, *MsgRedelegateMulti) (*MsgRedelegateMultiResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RedelegateMulti not implemented")
+}
+func (UnimplementedMsgServer) InstantUndelegate(context.Context, *MsgInstantUndelegate) (*MsgInstantUndelegateResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method InstantUndelegate not implemented")
+}
+func (UnimplementedMsgServer) SetValidatorRiskTier(context.Context, *MsgSetValidatorRiskTier) (*MsgSetValidatorRiskTierResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetValidatorRiskTier not implemented")
+}
+func (UnimplementedMsgServer) SetStakingPaused(context.Context, *MsgSetStakingPaused) (*MsgSetStakingPausedResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetStakingPaused not implemented")
+}
+func (UnimplementedMsgServer) AttestValidatorProfile(context.Context, *MsgAttestValidatorProfile) (*MsgAttestValidatorProfileResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AttestValidatorProfile not implemented")
+}
+func (UnimplementedMsgServer) SetStandbyConsPubKey(context.Context, *MsgSetStandbyConsPubKey) (*MsgSetStandbyConsPubKeyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetStandbyConsPubKey not implemented")
+}
 func (UnimplementedMsgServer) mustEmbedUnimplementedMsgServer() {}
 
 // UnsafeMsgServer may be embedded to opt out of forward compatibility for this service.
@@ -320,6 +602,222 @@ func _Msg_UpdateParams_Handler(srv interface{}, ctx context.Context, dec func(in
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_TokenizeShares_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgTokenizeShares)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).TokenizeShares(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_TokenizeShares_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).TokenizeShares(ctx, req.(*MsgTokenizeShares))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_RedeemTokensForShares_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgRedeemTokensForShares)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).RedeemTokensForShares(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_RedeemTokensForShares_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).RedeemTokensForShares(ctx, req.(*MsgRedeemTokensForShares))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_TransferTokenizeShareRecord_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgTransferTokenizeShareRecord)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).TransferTokenizeShareRecord(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_TransferTokenizeShareRecord_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).TransferTokenizeShareRecord(ctx, req.(*MsgTransferTokenizeShareRecord))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_ValidatorBond_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgValidatorBond)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).ValidatorBond(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_ValidatorBond_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).ValidatorBond(ctx, req.(*MsgValidatorBond))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_CancelRedelegation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgCancelRedelegation)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).CancelRedelegation(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_CancelRedelegation_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).CancelRedelegation(ctx, req.(*MsgCancelRedelegation))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_LockDelegation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgLockDelegation)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).LockDelegation(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_LockDelegation_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).LockDelegation(ctx, req.(*MsgLockDelegation))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_RedelegateMulti_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgRedelegateMulti)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).RedelegateMulti(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_RedelegateMulti_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).RedelegateMulti(ctx, req.(*MsgRedelegateMulti))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_InstantUndelegate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgInstantUndelegate)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).InstantUndelegate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_InstantUndelegate_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).InstantUndelegate(ctx, req.(*MsgInstantUndelegate))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_SetValidatorRiskTier_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSetValidatorRiskTier)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SetValidatorRiskTier(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_SetValidatorRiskTier_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SetValidatorRiskTier(ctx, req.(*MsgSetValidatorRiskTier))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_SetStakingPaused_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSetStakingPaused)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SetStakingPaused(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_SetStakingPaused_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SetStakingPaused(ctx, req.(*MsgSetStakingPaused))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_AttestValidatorProfile_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgAttestValidatorProfile)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).AttestValidatorProfile(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_AttestValidatorProfile_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).AttestValidatorProfile(ctx, req.(*MsgAttestValidatorProfile))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_SetStandbyConsPubKey_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSetStandbyConsPubKey)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SetStandbyConsPubKey(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_SetStandbyConsPubKey_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SetStandbyConsPubKey(ctx, req.(*MsgSetStandbyConsPubKey))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // Msg_ServiceDesc is the grpc.ServiceDesc for Msg service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -355,6 +853,54 @@ var Msg_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "UpdateParams",
 			Handler:    _Msg_UpdateParams_Handler,
 		},
+		{
+			MethodName: "TokenizeShares",
+			Handler:    _Msg_TokenizeShares_Handler,
+		},
+		{
+			MethodName: "RedeemTokensForShares",
+			Handler:    _Msg_RedeemTokensForShares_Handler,
+		},
+		{
+			MethodName: "TransferTokenizeShareRecord",
+			Handler:    _Msg_TransferTokenizeShareRecord_Handler,
+		},
+		{
+			MethodName: "ValidatorBond",
+			Handler:    _Msg_ValidatorBond_Handler,
+		},
+		{
+			MethodName: "CancelRedelegation",
+			Handler:    _Msg_CancelRedelegation_Handler,
+		},
+		{
+			MethodName: "LockDelegation",
+			Handler:    _Msg_LockDelegation_Handler,
+		},
+		{
+			MethodName: "RedelegateMulti",
+			Handler:    _Msg_RedelegateMulti_Handler,
+		},
+		{
+			MethodName: "InstantUndelegate",
+			Handler:    _Msg_InstantUndelegate_Handler,
+		},
+		{
+			MethodName: "SetValidatorRiskTier",
+			Handler:    _Msg_SetValidatorRiskTier_Handler,
+		},
+		{
+			MethodName: "SetStakingPaused",
+			Handler:    _Msg_SetStakingPaused_Handler,
+		},
+		{
+			MethodName: "AttestValidatorProfile",
+			Handler:    _Msg_AttestValidatorProfile_Handler,
+		},
+		{
+			MethodName: "SetStandbyConsPubKey",
+			Handler:    _Msg_SetStandbyConsPubKey_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "cosmos/staking/v1beta1/tx.proto",
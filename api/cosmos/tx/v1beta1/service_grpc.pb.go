@@ -28,6 +28,7 @@ const (
 	Service_TxEncode_FullMethodName        = "/cosmos.tx.v1beta1.Service/TxEncode"
 	Service_TxEncodeAmino_FullMethodName   = "/cosmos.tx.v1beta1.Service/TxEncodeAmino"
 	Service_TxDecodeAmino_FullMethodName   = "/cosmos.tx.v1beta1.Service/TxDecodeAmino"
+	Service_SearchTxs_FullMethodName       = "/cosmos.tx.v1beta1.Service/SearchTxs"
 )
 
 // ServiceClient is the client API for Service service.
@@ -62,6 +63,10 @@ type ServiceClient interface {
 	//
 	// Since: cosmos-sdk 0.47
 	TxDecodeAmino(ctx context.Context, in *TxDecodeAminoRequest, opts ...grpc.CallOption) (*TxDecodeAminoResponse, error)
+	// SearchTxs searches the txs stored in the node-local tx index by message
+	// type URL, signer and height range. It is only available on nodes with
+	// the tx index enabled.
+	SearchTxs(ctx context.Context, in *SearchTxsRequest, opts ...grpc.CallOption) (*SearchTxsResponse, error)
 }
 
 type serviceClient struct {
@@ -153,6 +158,15 @@ func (c *serviceClient) TxDecodeAmino(ctx context.Context, in *TxDecodeAminoRequ
 	return out, nil
 }
 
+func (c *serviceClient) SearchTxs(ctx context.Context, in *SearchTxsRequest, opts ...grpc.CallOption) (*SearchTxsResponse, error) {
+	out := new(SearchTxsResponse)
+	err := c.cc.Invoke(ctx, Service_SearchTxs_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // ServiceServer is the server API for Service service.
 // All implementations must embed UnimplementedServiceServer
 // for forward compatibility
@@ -185,6 +199,10 @@ type ServiceServer interface {
 	//
 	// Since: cosmos-sdk 0.47
 	TxDecodeAmino(context.Context, *TxDecodeAminoRequest) (*TxDecodeAminoResponse, error)
+	// SearchTxs searches the txs stored in the node-local tx index by message
+	// type URL, signer and height range. It is only available on nodes with
+	// the tx index enabled.
+	SearchTxs(context.Context, *SearchTxsRequest) (*SearchTxsResponse, error)
 	mustEmbedUnimplementedServiceServer()
 }
 
@@ -219,6 +237,9 @@ func (UnimplementedServiceServer) TxEncodeAmino(context.Context, *TxEncodeAminoR
 func (UnimplementedServiceServer) TxDecodeAmino(context.Context, *TxDecodeAminoRequest) (*TxDecodeAminoResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method TxDecodeAmino not implemented")
 }
+func (UnimplementedServiceServer) SearchTxs(context.Context, *SearchTxsRequest) (*SearchTxsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SearchTxs not implemented")
+}
 func (UnimplementedServiceServer) mustEmbedUnimplementedServiceServer() {}
 
 // UnsafeServiceServer may be embedded to opt out of forward compatibility for this service.
@@ -394,6 +415,24 @@ func _Service_TxDecodeAmino_Handler(srv interface{}, ctx context.Context, dec fu
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Service_SearchTxs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SearchTxsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ServiceServer).SearchTxs(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Service_SearchTxs_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ServiceServer).SearchTxs(ctx, req.(*SearchTxsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // Service_ServiceDesc is the grpc.ServiceDesc for Service service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -437,6 +476,10 @@ var Service_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "TxDecodeAmino",
 			Handler:    _Service_TxDecodeAmino_Handler,
 		},
+		{
+			MethodName: "SearchTxs",
+			Handler:    _Service_SearchTxs_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "cosmos/tx/v1beta1/service.proto",
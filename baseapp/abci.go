@@ -18,6 +18,7 @@ import (
 
 	errorsmod "cosmossdk.io/errors"
 	"cosmossdk.io/store/rootmulti"
+	"cosmossdk.io/store/snapshots"
 	snapshottypes "cosmossdk.io/store/snapshots/types"
 	storetypes "cosmossdk.io/store/types"
 
@@ -161,6 +162,8 @@ func (app *BaseApp) FilterPeerByID(info string) abci.ResponseQuery {
 
 // BeginBlock implements the ABCI application interface.
 func (app *BaseApp) BeginBlock(req abci.RequestBeginBlock) (res abci.ResponseBeginBlock) {
+	app.stopCacheWarming()
+
 	if req.Header.ChainID != app.chainID {
 		panic(fmt.Sprintf("invalid chain-id on BeginBlock; expected: %s, got: %s", app.chainID, req.Header.ChainID))
 	}
@@ -189,6 +192,7 @@ func (app *BaseApp) BeginBlock(req abci.RequestBeginBlock) (res abci.ResponseBeg
 	}
 
 	gasMeter := app.getBlockGasMeter(app.deliverState.ctx)
+	app.blockEventAttributes = 0
 
 	app.deliverState.ctx = app.deliverState.ctx.
 		WithBlockGasMeter(gasMeter).
@@ -210,7 +214,7 @@ func (app *BaseApp) BeginBlock(req abci.RequestBeginBlock) (res abci.ResponseBeg
 		if err != nil {
 			panic(err)
 		}
-		res.Events = sdk.MarkEventsToIndex(res.Events, app.indexEvents)
+		res.Events = sdk.MarkEventsToIndex(app.limitEvents(res.Events, false, true), app.indexEvents)
 	}
 
 	// call the streaming service hook with the BeginBlock messages
@@ -237,7 +241,7 @@ func (app *BaseApp) EndBlock(req abci.RequestEndBlock) (res abci.ResponseEndBloc
 		if err != nil {
 			panic(err)
 		}
-		res.Events = sdk.MarkEventsToIndex(res.Events, app.indexEvents)
+		res.Events = sdk.MarkEventsToIndex(app.limitEvents(res.Events, false, true), app.indexEvents)
 	}
 
 	cp := app.GetConsensusParams(app.deliverState.ctx)
@@ -273,6 +277,8 @@ func (app *BaseApp) PrepareProposal(req abci.RequestPrepareProposal) (resp abci.
 		panic("PrepareProposal method not set")
 	}
 
+	app.stopCacheWarming()
+
 	// always reset state given that PrepareProposal can timeout and be called again
 	emptyHeader := cmtproto.Header{ChainID: app.chainID}
 	app.setState(runTxPrepareProposal, emptyHeader)
@@ -331,12 +337,19 @@ func (app *BaseApp) ProcessProposal(req abci.RequestProcessProposal) (resp abci.
 		panic("app.ProcessProposal is not set")
 	}
 
+	app.stopCacheWarming()
+
 	// CometBFT must never call ProcessProposal with a height of 0.
 	// Ref: https://github.com/cometbft/cometbft/blob/059798a4f5b0c9f52aa8655fa619054a0154088c/spec/core/state.md?plain=1#L37-L38
 	if req.Height < 1 {
 		panic("ProcessProposal called with invalid height")
 	}
 
+	if err := app.validateBlockTime(req.Time); err != nil {
+		app.logger.Error("rejecting proposal", "height", req.Height, "err", err)
+		return abci.ResponseProcessProposal{Status: abci.ResponseProcessProposal_REJECT}
+	}
+
 	// always reset state given that ProcessProposal can timeout and be called again
 	emptyHeader := cmtproto.Header{ChainID: app.chainID}
 	app.setState(runTxProcessProposal, emptyHeader)
@@ -392,7 +405,11 @@ func (app *BaseApp) CheckTx(req abci.RequestCheckTx) abci.ResponseCheckTx {
 
 	gInfo, result, anteEvents, priority, err := app.runTx(mode, req.Tx)
 	if err != nil {
-		return sdkerrors.ResponseCheckTxWithEvents(err, gInfo.GasWanted, gInfo.GasUsed, anteEvents, app.trace)
+		return sdkerrors.ResponseCheckTxWithEvents(err, gInfo.GasWanted, gInfo.GasUsed, app.limitEvents(anteEvents, true, false), app.trace)
+	}
+
+	if mode == runTxModeCheck {
+		app.enqueueWarmingTx(req.Tx)
 	}
 
 	return abci.ResponseCheckTx{
@@ -400,7 +417,7 @@ func (app *BaseApp) CheckTx(req abci.RequestCheckTx) abci.ResponseCheckTx {
 		GasUsed:   int64(gInfo.GasUsed),   // TODO: Should type accept unsigned ints?
 		Log:       result.Log,
 		Data:      result.Data,
-		Events:    sdk.MarkEventsToIndex(result.Events, app.indexEvents),
+		Events:    sdk.MarkEventsToIndex(app.limitEvents(result.Events, true, false), app.indexEvents),
 		Priority:  priority,
 	}
 }
@@ -436,7 +453,7 @@ func (app *BaseApp) DeliverTx(req abci.RequestDeliverTx) abci.ResponseDeliverTx
 	gInfo, result, anteEvents, _, err := app.runTx(runTxModeDeliver, req.Tx)
 	if err != nil {
 		resultStr = "failed"
-		return sdkerrors.ResponseDeliverTxWithEvents(err, gInfo.GasWanted, gInfo.GasUsed, sdk.MarkEventsToIndex(anteEvents, app.indexEvents), app.trace)
+		return sdkerrors.ResponseDeliverTxWithEvents(err, gInfo.GasWanted, gInfo.GasUsed, sdk.MarkEventsToIndex(app.limitEvents(anteEvents, true, true), app.indexEvents), app.trace)
 	}
 
 	return abci.ResponseDeliverTx{
@@ -444,7 +461,7 @@ func (app *BaseApp) DeliverTx(req abci.RequestDeliverTx) abci.ResponseDeliverTx
 		GasUsed:   int64(gInfo.GasUsed),   // TODO: Should type accept unsigned ints?
 		Log:       result.Log,
 		Data:      result.Data,
-		Events:    sdk.MarkEventsToIndex(result.Events, app.indexEvents),
+		Events:    sdk.MarkEventsToIndex(app.limitEvents(result.Events, true, true), app.indexEvents),
 	}
 }
 
@@ -473,6 +490,13 @@ func (app *BaseApp) Commit() abci.ResponseCommit {
 	// MultiStore (app.cms) so when Commit() is called it persists those values.
 	app.deliverState.ms.Write()
 	commitID := app.cms.Commit()
+	app.lastBlockTime = header.Time
+
+	if info, ok := app.deliverState.ctx.CometInfo().(cometInfo); ok {
+		app.lastCommitInfoMtx.Lock()
+		app.lastCommitInfo = info.LastCommit
+		app.lastCommitInfoMtx.Unlock()
+	}
 
 	res := abci.ResponseCommit{
 		Data:         commitID.Hash,
@@ -507,6 +531,8 @@ func (app *BaseApp) Commit() abci.ResponseCommit {
 		app.prepareCheckStater(app.checkState.ctx)
 	}
 
+	app.resumeCacheWarming(header)
+
 	var halt bool
 
 	switch {
@@ -786,8 +812,14 @@ func (app *BaseApp) CreateQueryContext(height int64, prove bool) (sdk.Context, e
 		qms = app.cms.(storetypes.MultiStore)
 	}
 
+	// The latest version is only updated once a state sync snapshot is fully
+	// restored, meanwhile the queries are served from the stores restored so far.
 	lastBlockHeight := qms.LatestVersion()
 	if lastBlockHeight == 0 {
+		if progress, ok := app.snapshotRestoreProgress(); ok {
+			return app.createRestoringQueryContext(height, prove, progress)
+		}
+
 		return sdk.Context{}, errorsmod.Wrapf(sdkerrors.ErrInvalidHeight, "%s is not ready; please wait for first block", app.Name())
 	}
 
@@ -824,6 +856,7 @@ func (app *BaseApp) CreateQueryContext(height int64, prove bool) (sdk.Context, e
 	// branch the commit-multistore for safety
 	ctx := sdk.NewContext(cacheMS, app.checkState.ctx.BlockHeader(), true, app.logger).
 		WithMinGasPrices(app.minGasPrices).
+		WithMinGasPricesAllOf(app.minGasPricesAllOf).
 		WithBlockHeight(height)
 
 	if height != lastBlockHeight {
@@ -839,6 +872,53 @@ func (app *BaseApp) CreateQueryContext(height int64, prove bool) (sdk.Context, e
 	return ctx, nil
 }
 
+// createRestoringQueryContext creates a new sdk.Context for a query served
+// while a state sync snapshot is being restored. Only the stores which are
+// fully restored can be queried, at the height of the snapshot; querying any
+// other store fails with ErrStoreNotRestored.
+func (app *BaseApp) createRestoringQueryContext(height int64, prove bool, progress snapshots.RestoreProgress) (sdk.Context, error) {
+	rms, ok := app.cms.(*rootmulti.Store)
+	if !ok || app.qms != nil {
+		return sdk.Context{}, errorsmod.Wrapf(
+			sdkerrors.ErrInvalidHeight,
+			"%s is restoring a state sync snapshot at height %d (%d/%d chunks applied); please wait for the restore to complete",
+			app.Name(), progress.Height, progress.ChunksApplied, progress.Chunks,
+		)
+	}
+
+	cacheMS, restoreHeight, ok := rms.CacheMultiStoreRestored()
+	if !ok {
+		return sdk.Context{}, errorsmod.Wrapf(
+			sdkerrors.ErrInvalidHeight,
+			"%s is restoring a state sync snapshot at height %d (%d/%d chunks applied) and no store is restored yet; please wait for the restore to progress",
+			app.Name(), progress.Height, progress.ChunksApplied, progress.Chunks,
+		)
+	}
+
+	if height != 0 && height != restoreHeight {
+		return sdk.Context{},
+			errorsmod.Wrapf(
+				sdkerrors.ErrInvalidHeight,
+				"%s is restoring a state sync snapshot; only height %d can be queried", app.Name(), restoreHeight,
+			)
+	}
+
+	if prove {
+		return sdk.Context{},
+			errorsmod.Wrap(
+				sdkerrors.ErrInvalidRequest,
+				"cannot query with proof while restoring a state sync snapshot",
+			)
+	}
+
+	ctx := sdk.NewContext(cacheMS, app.checkState.ctx.BlockHeader(), true, app.logger).
+		WithMinGasPrices(app.minGasPrices).
+		WithMinGasPricesAllOf(app.minGasPricesAllOf).
+		WithBlockHeight(restoreHeight)
+
+	return ctx, nil
+}
+
 // GetBlockRetentionHeight returns the height for which all blocks below this height
 // are pruned from CometBFT. Given a commitment height and a non-zero local
 // minRetainBlocks configuration, the retentionHeight is the smallest height that
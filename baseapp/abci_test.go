@@ -2,14 +2,19 @@ package baseapp_test
 
 import (
 	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
 	"errors"
 	"fmt"
+	"strconv"
 	"strings"
 	"testing"
+	"time"
 
 	dbm "github.com/cosmos/cosmos-db"
 
 	abci "github.com/cometbft/cometbft/abci/types"
+	"github.com/cometbft/cometbft/crypto/tmhash"
 	cmtproto "github.com/cometbft/cometbft/proto/tendermint/types"
 	"github.com/cosmos/gogoproto/jsonpb"
 	"github.com/stretchr/testify/require"
@@ -173,6 +178,43 @@ func TestABCI_BeginBlock_WithInitialHeight(t *testing.T) {
 	require.Equal(t, int64(3), app.LastBlockHeight())
 }
 
+func TestABCI_ProcessProposal_BlockTimeMonotonicity(t *testing.T) {
+	name := t.Name()
+	db := dbm.NewMemDB()
+	app := baseapp.NewBaseApp(name, log.NewTestLogger(t), db, nil)
+	require.NoError(t, app.LoadLatestVersion())
+
+	app.InitChain(abci.RequestInitChain{})
+
+	clock := testutil.NewClock(time.Date(2023, 1, 1, 0, 0, 0, 0, time.UTC))
+	header := clock.NextHeader(cmtproto.Header{}, 0)
+	app.BeginBlock(abci.RequestBeginBlock{Header: header})
+	app.Commit()
+
+	// a proposal whose time is before the time of the last block is rejected
+	res := app.ProcessProposal(abci.RequestProcessProposal{Height: 2, Time: clock.Now().Add(-time.Second)})
+	require.Equal(t, abci.ResponseProcessProposal_REJECT, res.Status)
+
+	// the time of a block may be equal to the time of the previous block
+	res = app.ProcessProposal(abci.RequestProcessProposal{Height: 2, Time: clock.Now()})
+	require.Equal(t, abci.ResponseProcessProposal_ACCEPT, res.Status)
+
+	header = clock.NextHeader(header, time.Second)
+	app.BeginBlock(abci.RequestBeginBlock{Header: header})
+	app.Commit()
+
+	// the time of the last block is loaded when the app is restarted
+	app = baseapp.NewBaseApp(name, log.NewTestLogger(t), db, nil)
+	require.NoError(t, app.LoadLatestVersion())
+	require.Equal(t, int64(2), app.LastBlockHeight())
+
+	res = app.ProcessProposal(abci.RequestProcessProposal{Height: 3, Time: clock.Now().Add(-time.Second)})
+	require.Equal(t, abci.ResponseProcessProposal_REJECT, res.Status)
+
+	res = app.ProcessProposal(abci.RequestProcessProposal{Height: 3, Time: clock.Now()})
+	require.Equal(t, abci.ResponseProcessProposal_ACCEPT, res.Status)
+}
+
 func TestABCI_GRPCQuery(t *testing.T) {
 	grpcQueryOpt := func(bapp *baseapp.BaseApp) {
 		testdata.RegisterQueryServer(
@@ -567,6 +609,86 @@ func TestABCI_ApplySnapshotChunk(t *testing.T) {
 	require.Equal(t, srcSuite.baseApp.LastCommitID(), targetSuite.baseApp.LastCommitID())
 }
 
+func TestABCI_ApplySnapshotChunk_Query(t *testing.T) {
+	srcCfg := SnapshotsConfig{
+		blocks:             4,
+		blockTxs:           10,
+		snapshotInterval:   2,
+		snapshotKeepRecent: 2,
+		pruningOpts:        pruningtypes.NewPruningOptions(pruningtypes.PruningNothing),
+	}
+	srcSuite := NewBaseAppSuiteWithSnapshots(t, srcCfg)
+
+	targetCfg := SnapshotsConfig{
+		blocks:             0,
+		blockTxs:           0,
+		snapshotInterval:   2,
+		snapshotKeepRecent: 2,
+		pruningOpts:        pruningtypes.NewPruningOptions(pruningtypes.PruningNothing),
+	}
+	targetSuite := NewBaseAppSuiteWithSnapshots(t, targetCfg)
+
+	respList := srcSuite.baseApp.ListSnapshots(abci.RequestListSnapshots{})
+	require.NotEmpty(t, respList.Snapshots)
+	snapshot := respList.Snapshots[0]
+	require.GreaterOrEqual(t, snapshot.Chunks, uint32(3), "Not enough snapshot chunks")
+
+	respOffer := targetSuite.baseApp.OfferSnapshot(abci.RequestOfferSnapshot{Snapshot: snapshot})
+	require.Equal(t, abci.ResponseOfferSnapshot{Result: abci.ResponseOfferSnapshot_ACCEPT}, respOffer)
+
+	// no store is restored before the first chunk is applied
+	_, err := targetSuite.baseApp.CreateQueryContext(0, false)
+	require.ErrorContains(t, err, fmt.Sprintf("(0/%d chunks applied) and no store is restored yet", snapshot.Chunks))
+
+	applyChunk := func(index uint32) {
+		respChunk := srcSuite.baseApp.LoadSnapshotChunk(abci.RequestLoadSnapshotChunk{
+			Height: snapshot.Height,
+			Format: snapshot.Format,
+			Chunk:  index,
+		})
+		require.NotNil(t, respChunk.Chunk)
+
+		respApply := targetSuite.baseApp.ApplySnapshotChunk(abci.RequestApplySnapshotChunk{
+			Index: index,
+			Chunk: respChunk.Chunk,
+		})
+		require.Equal(t, abci.ResponseApplySnapshotChunk{
+			Result: abci.ResponseApplySnapshotChunk_ACCEPT,
+		}, respApply)
+	}
+
+	// the first store is restored from the first chunk, while the data of the
+	// second store spans all the chunks
+	applyChunk(0)
+	var ctx sdk.Context
+	require.Eventually(t, func() bool {
+		ctx, err = targetSuite.baseApp.CreateQueryContext(0, false)
+		return err == nil
+	}, 5*time.Second, 10*time.Millisecond)
+	require.EqualValues(t, snapshot.Height, ctx.BlockHeight())
+	require.Nil(t, ctx.KVStore(capKey1).Get([]byte("0")))
+	require.PanicsWithError(t, "store key2 is not restored yet: store not restored", func() {
+		ctx.KVStore(capKey2).Get([]byte("0"))
+	})
+
+	_, err = targetSuite.baseApp.CreateQueryContext(int64(snapshot.Height), false)
+	require.NoError(t, err)
+	_, err = targetSuite.baseApp.CreateQueryContext(int64(snapshot.Height)-1, false)
+	require.ErrorIs(t, err, sdkerrors.ErrInvalidHeight)
+	_, err = targetSuite.baseApp.CreateQueryContext(0, true)
+	require.ErrorIs(t, err, sdkerrors.ErrInvalidRequest)
+
+	for index := uint32(1); index < snapshot.Chunks; index++ {
+		applyChunk(index)
+	}
+
+	// the whole state is queried once the restore completes
+	ctx, err = targetSuite.baseApp.CreateQueryContext(0, false)
+	require.NoError(t, err)
+	require.EqualValues(t, snapshot.Height, ctx.BlockHeight())
+	require.NotNil(t, ctx.KVStore(capKey2).Get([]byte("0")))
+}
+
 func TestABCI_EndBlock(t *testing.T) {
 	db := dbm.NewMemDB()
 	name := t.Name()
@@ -598,6 +720,151 @@ func TestABCI_EndBlock(t *testing.T) {
 	require.Equal(t, cp.Block.MaxGas, res.ConsensusParamUpdates.Block.MaxGas)
 }
 
+func TestABCI_EventLimits(t *testing.T) {
+	db := dbm.NewMemDB()
+	name := t.Name()
+
+	limits := baseapp.EventLimits{MaxAttributeSize: 4, MaxBlockAttributes: 6}
+	app := baseapp.NewBaseApp(name, log.NewTestLogger(t), db, nil, baseapp.SetEventLimits(limits))
+	app.SetParamStore(&paramStore{db: dbm.NewMemDB()})
+	app.InitChain(abci.RequestInitChain{})
+
+	newEvent := func(typ string, values ...string) abci.Event {
+		event := abci.Event{Type: typ}
+		for i, value := range values {
+			event.Attributes = append(event.Attributes, abci.EventAttribute{Key: fmt.Sprintf("key%d", i), Value: value})
+		}
+		return event
+	}
+	events := []abci.Event{
+		newEvent("first", "short", "hé"),
+		newEvent("second", "ok", "hééé"),
+	}
+	app.SetBeginBlocker(func(ctx sdk.Context, req abci.RequestBeginBlock) (abci.ResponseBeginBlock, error) {
+		return abci.ResponseBeginBlock{Events: events}, nil
+	})
+	app.SetEndBlocker(func(ctx sdk.Context, req abci.RequestEndBlock) (abci.ResponseEndBlock, error) {
+		return abci.ResponseEndBlock{Events: events}, nil
+	})
+	app.Seal()
+
+	requireTruncated := func(event abci.Event, dropped, droppedAttributes, truncated int) {
+		require.Equal(t, baseapp.EventTypeEventsTruncated, event.Type)
+		require.Equal(t, strconv.Itoa(dropped), event.Attributes[0].Value)
+		require.Equal(t, strconv.Itoa(droppedAttributes), event.Attributes[1].Value)
+		require.Equal(t, strconv.Itoa(truncated), event.Attributes[2].Value)
+	}
+
+	// the oversized values are truncated without splitting a character
+	beginRes := app.BeginBlock(abci.RequestBeginBlock{Header: cmtproto.Header{Height: 1}})
+	require.Len(t, beginRes.Events, 3)
+	require.Equal(t, "shor", beginRes.Events[0].Attributes[0].Value)
+	require.Equal(t, "hé", beginRes.Events[0].Attributes[1].Value)
+	require.Equal(t, "hé", beginRes.Events[1].Attributes[1].Value)
+	requireTruncated(beginRes.Events[2], 0, 0, 2)
+	// the events of the context aren't modified
+	require.Equal(t, "short", events[0].Attributes[0].Value)
+
+	// the events exceeding the block limit are dropped
+	endRes := app.EndBlock(abci.RequestEndBlock{})
+	require.Len(t, endRes.Events, 2)
+	require.Equal(t, "first", endRes.Events[0].Type)
+	requireTruncated(endRes.Events[1], 1, 2, 1)
+
+	// the block limit is reset at the beginning of each block
+	app.Commit()
+	beginRes = app.BeginBlock(abci.RequestBeginBlock{Header: cmtproto.Header{Height: 2}})
+	require.Len(t, beginRes.Events, 3)
+}
+
+func TestABCI_CacheWarming(t *testing.T) {
+	warmKey := []byte("warm")
+	warming := make(chan sdk.Context)
+	release := make(chan struct{})
+	anteOpt := func(bapp *baseapp.BaseApp) {
+		bapp.SetAnteHandler(func(ctx sdk.Context, tx sdk.Tx, simulate bool) (sdk.Context, error) {
+			if ctx.ExecMode() == sdk.ExecModeSimulate {
+				ctx.KVStore(capKey1).Set(warmKey, []byte{1})
+				warming <- ctx
+				<-release
+			}
+			return ctx, nil
+		})
+	}
+	suite := NewBaseAppSuite(t, anteOpt, baseapp.SetCacheWarming(baseapp.CacheWarmingConfig{Workers: 1, QueueSize: 1}))
+	baseapptestutil.RegisterCounterServer(suite.baseApp.MsgServiceRouter(), NoopCounterServerImpl{})
+
+	suite.baseApp.InitChain(abci.RequestInitChain{
+		ConsensusParams: &cmtproto.ConsensusParams{},
+	})
+
+	txBytes, err := suite.txConfig.TxEncoder()(newTxCounter(t, suite.txConfig, 0, 0))
+	require.NoError(t, err)
+
+	requireNotWarmed := func(msg string) {
+		select {
+		case <-warming:
+			t.Fatal(msg)
+		case <-time.After(100 * time.Millisecond):
+		}
+	}
+	requireWarmed := func(height int64) {
+		select {
+		case ctx := <-warming:
+			require.Equal(t, height, ctx.BlockHeight())
+			require.Equal(t, tmhash.Sum(txBytes), ctx.TxHash())
+			require.Equal(t, storetypes.NewInfiniteGasMeter().Limit(), ctx.GasMeter().Limit())
+		case <-time.After(5 * time.Second):
+			t.Fatal("tx not pre-executed")
+		}
+	}
+
+	// the txs are only pre-executed once a block is committed
+	res := suite.baseApp.CheckTx(abci.RequestCheckTx{Tx: txBytes})
+	require.True(t, res.IsOK())
+	requireNotWarmed("tx pre-executed before a block is committed")
+
+	suite.baseApp.BeginBlock(abci.RequestBeginBlock{Header: cmtproto.Header{Height: 1}})
+	suite.baseApp.EndBlock(abci.RequestEndBlock{})
+	suite.baseApp.Commit()
+	requireWarmed(1)
+
+	// the next block waits for the running pre-execution to complete
+	begun := make(chan struct{})
+	go func() {
+		suite.baseApp.BeginBlock(abci.RequestBeginBlock{Header: cmtproto.Header{Height: 2}})
+		close(begun)
+	}()
+	select {
+	case <-begun:
+		t.Fatal("block started while a tx is pre-executed")
+	case <-time.After(100 * time.Millisecond):
+	}
+	close(release)
+	<-begun
+
+	// the speculative writes are discarded
+	require.False(t, getDeliverStateCtx(suite.baseApp).KVStore(capKey1).Has(warmKey))
+	require.False(t, getCheckStateCtx(suite.baseApp).KVStore(capKey1).Has(warmKey))
+
+	// the txs accepted during a block are pre-executed once it is committed
+	res = suite.baseApp.CheckTx(abci.RequestCheckTx{Tx: txBytes})
+	require.True(t, res.IsOK())
+	requireNotWarmed("tx pre-executed during a block")
+
+	suite.baseApp.EndBlock(abci.RequestEndBlock{})
+	suite.baseApp.Commit()
+	requireWarmed(2)
+
+	// rechecked txs aren't pre-executed again
+	res = suite.baseApp.CheckTx(abci.RequestCheckTx{Tx: txBytes, Type: abci.CheckTxType_Recheck})
+	require.True(t, res.IsOK())
+	requireNotWarmed("rechecked tx pre-executed")
+
+	suite.baseApp.BeginBlock(abci.RequestBeginBlock{Header: cmtproto.Header{Height: 3}})
+	require.False(t, getDeliverStateCtx(suite.baseApp).KVStore(capKey1).Has(warmKey))
+}
+
 func TestBaseApp_PrepareCheckState(t *testing.T) {
 	db := dbm.NewMemDB()
 	name := t.Name()
@@ -699,6 +966,160 @@ func TestABCI_CheckTx(t *testing.T) {
 	require.Nil(t, storedBytes)
 }
 
+func TestABCI_CheckTx_TxWireDecoder(t *testing.T) {
+	anteKey := []byte("ante-key")
+	deliverKey := []byte("deliver-key")
+	anteOpt := func(bapp *baseapp.BaseApp) { bapp.SetAnteHandler(anteHandlerTxTest(t, capKey1, anteKey)) }
+
+	// the alternative encoding is the canonical encoding with its bytes reversed
+	reverse := func(bz []byte) []byte {
+		res := make([]byte, len(bz))
+		for i, b := range bz {
+			res[len(bz)-1-i] = b
+		}
+		return res
+	}
+
+	var txDecoder sdk.TxDecoder
+	wireOpt := func(bapp *baseapp.BaseApp) {
+		bapp.SetTxWireDecoder(0x01, func(txBytes []byte) (sdk.Tx, error) {
+			return txDecoder(reverse(txBytes))
+		})
+	}
+
+	newSuite := func(opts ...func(*baseapp.BaseApp)) *BaseAppSuite {
+		suite := NewBaseAppSuite(t, append([]func(*baseapp.BaseApp){anteOpt, wireOpt}, opts...)...)
+		txDecoder = suite.txConfig.TxDecoder()
+		baseapptestutil.RegisterCounterServer(suite.baseApp.MsgServiceRouter(), CounterServerImpl{t, capKey1, deliverKey})
+		suite.baseApp.InitChain(abci.RequestInitChain{
+			ConsensusParams: &cmtproto.ConsensusParams{},
+		})
+		return suite
+	}
+
+	// without an application-side mempool, the alternative encoding would be
+	// proposed as is, so the app fails to load
+	app := baseapp.NewBaseApp(t.Name(), log.NewNopLogger(), dbm.NewMemDB(), nil, wireOpt)
+	app.MountStores(capKey1)
+	require.ErrorContains(t, app.LoadLatestVersion(), "application-side mempool")
+
+	// with an application-side mempool, the alternative encoding is accepted by
+	// CheckTx and proposed in the canonical encoding
+	suite := newSuite(baseapp.SetMempool(mempool.NewSenderNonceMempool()))
+
+	tx := newTxCounter(t, suite.txConfig, 0, 0)
+	txBytes, err := suite.txConfig.TxEncoder()(tx)
+	require.NoError(t, err)
+	wireBytes := append([]byte{0x01}, reverse(txBytes)...)
+
+	r := suite.baseApp.CheckTx(abci.RequestCheckTx{Tx: wireBytes})
+	require.True(t, r.IsOK(), fmt.Sprintf("%v", r))
+
+	// unknown prefixes are decoded canonically and fail
+	r = suite.baseApp.CheckTx(abci.RequestCheckTx{Tx: append([]byte{0x02}, reverse(txBytes)...)})
+	require.False(t, r.IsOK())
+
+	resPrepareProposal := suite.baseApp.PrepareProposal(abci.RequestPrepareProposal{MaxTxBytes: 1000, Height: 1})
+	require.Equal(t, [][]byte{txBytes}, resPrepareProposal.Txs)
+
+	// the alternative encoding is rejected during block execution
+	suite.baseApp.BeginBlock(abci.RequestBeginBlock{Header: cmtproto.Header{Height: 1}})
+
+	res := suite.baseApp.DeliverTx(abci.RequestDeliverTx{Tx: wireBytes})
+	require.False(t, res.IsOK())
+
+	res = suite.baseApp.DeliverTx(abci.RequestDeliverTx{Tx: txBytes})
+	require.True(t, res.IsOK(), fmt.Sprintf("%v", res))
+
+	// decoders cannot be registered once sealed, twice or for the canonical prefix
+	require.Panics(t, func() { suite.baseApp.SetTxWireDecoder(0x02, txDecoder) })
+
+	newApp := func(opts ...func(*baseapp.BaseApp)) {
+		baseapp.NewBaseApp(t.Name(), log.NewNopLogger(), dbm.NewMemDB(), txDecoder, opts...)
+	}
+	require.Panics(t, func() { newApp(wireOpt, wireOpt) })
+	require.Panics(t, func() { newApp(func(bapp *baseapp.BaseApp) { bapp.SetTxWireDecoder(0x0a, txDecoder) }) })
+	require.NotPanics(t, func() { newApp(wireOpt) })
+}
+
+func TestABCI_ReCheckTx_TxWireDecoder(t *testing.T) {
+	anteKey := []byte("ante-key")
+	deliverKey := []byte("deliver-key")
+
+	// the ante handler checks the counter of the txs like a sequence, and
+	// rejects all the txs once invalidated
+	invalidated := false
+	anteOpt := func(bapp *baseapp.BaseApp) {
+		bapp.SetAnteHandler(func(ctx sdk.Context, tx sdk.Tx, simulate bool) (sdk.Context, error) {
+			store := ctx.KVStore(capKey1)
+			counter, _ := parseTxMemo(t, tx)
+			if invalidated || getIntFromStore(t, store, anteKey) != counter {
+				return ctx, sdkerrors.ErrWrongSequence
+			}
+
+			setIntOnStore(store, anteKey, counter+1)
+			return ctx, nil
+		})
+	}
+
+	// the alternative encoding is the canonical encoding prefixed by 0x01
+	var txDecoder sdk.TxDecoder
+	wireOpt := func(bapp *baseapp.BaseApp) {
+		bapp.SetTxWireDecoder(0x01, func(txBytes []byte) (sdk.Tx, error) {
+			return txDecoder(txBytes)
+		})
+	}
+
+	mp := mempool.NewSenderNonceMempool()
+	suite := NewBaseAppSuite(t, anteOpt, wireOpt, baseapp.SetMempool(mp))
+	txDecoder = suite.txConfig.TxDecoder()
+	baseapptestutil.RegisterCounterServer(suite.baseApp.MsgServiceRouter(), CounterServerImpl{t, capKey1, deliverKey})
+	suite.baseApp.InitChain(abci.RequestInitChain{
+		ConsensusParams: &cmtproto.ConsensusParams{},
+	})
+
+	txBytes := make([][]byte, 2)
+	wireBytes := make([][]byte, 2)
+	for i := range txBytes {
+		var err error
+		txBytes[i], err = suite.txConfig.TxEncoder()(newTxCounter(t, suite.txConfig, int64(i), int64(i)))
+		require.NoError(t, err)
+		wireBytes[i] = append([]byte{0x01}, txBytes[i]...)
+
+		r := suite.baseApp.CheckTx(abci.RequestCheckTx{Tx: wireBytes[i]})
+		require.True(t, r.IsOK(), fmt.Sprintf("%v", r))
+	}
+	require.Equal(t, 2, mp.CountTx())
+
+	// the first tx is included in a block in the canonical encoding, which
+	// removes it from the application-side mempool
+	suite.baseApp.BeginBlock(abci.RequestBeginBlock{Header: cmtproto.Header{Height: 1}})
+	res := suite.baseApp.DeliverTx(abci.RequestDeliverTx{Tx: txBytes[0]})
+	require.True(t, res.IsOK(), fmt.Sprintf("%v", res))
+	suite.baseApp.EndBlock(abci.RequestEndBlock{})
+	suite.baseApp.Commit()
+	require.Equal(t, 1, mp.CountTx())
+
+	// CometBFT doesn't recognize the included tx, received in the alternative
+	// encoding, so it rechecks it: it fails, and CometBFT evicts it
+	r := suite.baseApp.CheckTx(abci.RequestCheckTx{Tx: wireBytes[0], Type: abci.CheckTxType_Recheck})
+	require.False(t, r.IsOK())
+
+	r = suite.baseApp.CheckTx(abci.RequestCheckTx{Tx: wireBytes[1], Type: abci.CheckTxType_Recheck})
+	require.True(t, r.IsOK(), fmt.Sprintf("%v", r))
+	require.Equal(t, 1, mp.CountTx())
+
+	// a tx failing its recheck is evicted from the application-side mempool
+	// too, so that it is no longer proposed
+	invalidated = true
+	r = suite.baseApp.CheckTx(abci.RequestCheckTx{Tx: wireBytes[1], Type: abci.CheckTxType_Recheck})
+	require.False(t, r.IsOK())
+	require.Zero(t, mp.CountTx())
+
+	resPrepareProposal := suite.baseApp.PrepareProposal(abci.RequestPrepareProposal{MaxTxBytes: 1000, Height: 2})
+	require.Empty(t, resPrepareProposal.Txs)
+}
+
 func TestABCI_DeliverTx(t *testing.T) {
 	anteKey := []byte("ante-key")
 	anteOpt := func(bapp *baseapp.BaseApp) { bapp.SetAnteHandler(anteHandlerTxTest(t, capKey1, anteKey)) }
@@ -739,6 +1160,67 @@ func TestABCI_DeliverTx(t *testing.T) {
 	}
 }
 
+func TestABCI_DeliverTx_MsgHandlerPanic(t *testing.T) {
+	anteKey := []byte("ante-key")
+	anteOpt := func(bapp *baseapp.BaseApp) { bapp.SetAnteHandler(anteHandlerTxTest(t, capKey1, anteKey)) }
+	suite := NewBaseAppSuite(t, anteOpt)
+
+	suite.baseApp.InitChain(abci.RequestInitChain{
+		ConsensusParams: &cmtproto.ConsensusParams{},
+	})
+
+	panicMsg := "handler panic"
+	baseapptestutil.RegisterCounterServer(suite.baseApp.MsgServiceRouter(), PanicCounterServerImpl{panicMsg})
+
+	deliverKey2 := []byte("deliver-key2")
+	baseapptestutil.RegisterCounter2Server(suite.baseApp.MsgServiceRouter(), Counter2ServerImpl{t, capKey1, deliverKey2})
+
+	suite.baseApp.BeginBlock(abci.RequestBeginBlock{Header: cmtproto.Header{Height: 1}})
+
+	// the second message panics, the state changes of the first one are reverted
+	tx := newTxCounter(t, suite.txConfig, 0, 0)
+	builder := suite.txConfig.NewTxBuilder()
+	require.NoError(t, builder.SetMsgs(&baseapptestutil.MsgCounter2{Counter: 0}, tx.GetMsgs()[0]))
+	builder.SetMemo(tx.GetMemo())
+	setTxSignature(t, builder, 0)
+
+	txBytes, err := suite.txConfig.TxEncoder()(builder.GetTx())
+	require.NoError(t, err)
+
+	res := suite.baseApp.DeliverTx(abci.RequestDeliverTx{Tx: txBytes})
+	require.False(t, res.IsOK(), fmt.Sprintf("%v", res))
+	require.Equal(t, sdkerrors.ErrPanic.ABCICode(), res.Code)
+	require.Equal(t, sdkerrors.ErrPanic.Codespace(), res.Codespace)
+	require.Contains(t, res.Log, panicMsg)
+
+	panicHash := sha256.Sum256([]byte(panicMsg))
+	event := res.Events[len(res.Events)-1]
+	require.Equal(t, sdk.EventTypeMsgPanic, event.Type)
+	require.Equal(t, []abci.EventAttribute{
+		{Key: sdk.AttributeKeyModule, Value: ""},
+		{Key: sdk.AttributeKeyMsgIndex, Value: "1"},
+		{Key: sdk.AttributeKeyPanicHash, Value: hex.EncodeToString(panicHash[:])},
+	}, event.Attributes)
+
+	store := getDeliverStateCtx(suite.baseApp).KVStore(capKey1)
+	require.Equal(t, int64(1), getIntFromStore(t, store, anteKey))
+	require.Equal(t, int64(0), getIntFromStore(t, store, deliverKey2))
+
+	// the following txs are still processed
+	tx = newTxCounter(t, suite.txConfig, 1)
+	builder = suite.txConfig.NewTxBuilder()
+	require.NoError(t, builder.SetMsgs(&baseapptestutil.MsgCounter2{Counter: 0}))
+	builder.SetMemo(tx.GetMemo())
+	setTxSignature(t, builder, 1)
+
+	txBytes, err = suite.txConfig.TxEncoder()(builder.GetTx())
+	require.NoError(t, err)
+
+	res = suite.baseApp.DeliverTx(abci.RequestDeliverTx{Tx: txBytes})
+	require.True(t, res.IsOK(), fmt.Sprintf("%v", res))
+	require.Equal(t, int64(1), getIntFromStore(t, store, deliverKey2))
+}
+
 func TestABCI_DeliverTx_MultiMsg(t *testing.T) {
 	anteKey := []byte("ante-key")
 	anteOpt := func(bapp *baseapp.BaseApp) { bapp.SetAnteHandler(anteHandlerTxTest(t, capKey1, anteKey)) }
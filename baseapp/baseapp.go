@@ -4,11 +4,14 @@ import (
 	"fmt"
 	"sort"
 	"strconv"
+	"sync"
+	"time"
 
 	errorsmod "cosmossdk.io/errors"
 	"cosmossdk.io/log"
 	"cosmossdk.io/store"
 	storemetrics "cosmossdk.io/store/metrics"
+	"cosmossdk.io/store/rootmulti"
 	"cosmossdk.io/store/snapshots"
 	storetypes "cosmossdk.io/store/types"
 	"github.com/cockroachdb/errors"
@@ -45,6 +48,29 @@ const (
 	runTxProcessProposal                  // Process a TM block proposal
 )
 
+// execMode returns the exec mode set on the contexts of the mode.
+func (m runTxMode) execMode() sdk.ExecMode {
+	switch m {
+	case runTxModeCheck:
+		return sdk.ExecModeCheck
+	case runTxModeReCheck:
+		return sdk.ExecModeReCheck
+	case runTxModeSimulate:
+		return sdk.ExecModeSimulate
+	case runTxPrepareProposal:
+		return sdk.ExecModePrepareProposal
+	case runTxProcessProposal:
+		return sdk.ExecModeProcessProposal
+	default:
+		return sdk.ExecModeDeliver
+	}
+}
+
+// canonicalTxPrefix is the first byte of every canonically encoded transaction,
+// i.e. the tag of the first field of a protobuf TxRaw. It cannot be used as the
+// prefix of an alternative transaction wire encoding.
+const canonicalTxPrefix byte = 0x0a
+
 var _ abci.Application = (*BaseApp)(nil)
 
 // BaseApp reflects the ABCI application implementation.
@@ -62,6 +88,10 @@ type BaseApp struct {
 	txDecoder         sdk.TxDecoder // unmarshal []byte into sdk.Tx
 	txEncoder         sdk.TxEncoder // marshal sdk.Tx into []byte
 
+	// txWireDecoders holds the decoders of the alternative transaction wire
+	// encodings, indexed by their prefix byte.
+	txWireDecoders map[byte]sdk.TxDecoder
+
 	mempool            mempool.Mempool            // application side mempool
 	anteHandler        sdk.AnteHandler            // ante handler for fee and auth
 	postHandler        sdk.PostHandler            // post handler, optional, e.g. for tips
@@ -99,9 +129,28 @@ type BaseApp struct {
 	// transaction. This is mainly used for DoS and spam prevention.
 	minGasPrices sdk.DecCoins
 
+	// minGasPricesAllOf requires the fee of a transaction to cover all the
+	// minimum gas prices instead of any of them, e.g. on dual-token chains.
+	minGasPricesAllOf bool
+
 	// initialHeight is the initial height at which we start the baseapp
 	initialHeight int64
 
+	// lastBlockTime is the time of the last committed block, which the time of
+	// a proposed block must not be before. It is loaded from the commit info of
+	// the latest version when the baseapp is started, and is zero if it is not
+	// known, e.g. before the first block.
+	lastBlockTime time.Time
+
+	// lastCommitInfo is the last commit info of the latest committed block,
+	// kept at Commit for WhatIf. The mutex guards it as WhatIf runs on the
+	// query goroutines, concurrently with Commit.
+	lastCommitInfoMtx sync.RWMutex
+	lastCommitInfo    abci.CommitInfo
+
+	// whatIfGuard is called by WhatIf before each simulated block.
+	whatIfGuard WhatIfGuard
+
 	// flag for sealing options and parameters to a BaseApp
 	sealed bool
 
@@ -140,6 +189,16 @@ type BaseApp struct {
 	// which informs CometBFT what to index. If empty, all events will be indexed.
 	indexEvents map[string]struct{}
 
+	// eventLimits defines the limits on the events returned to CometBFT, and
+	// blockEventAttributes the number of attributes of the events returned in
+	// the current block.
+	eventLimits          EventLimits
+	blockEventAttributes int
+
+	// cacheWarmer pre-executes the txs accepted by CheckTx to warm the store
+	// caches, if enabled.
+	cacheWarmer *cacheWarmer
+
 	// streamingManager for managing instances and configuration of ABCIListener services
 	streamingManager storetypes.StreamingManager
 
@@ -320,6 +379,16 @@ func (app *BaseApp) SnapshotManager() *snapshots.Manager {
 	return app.snapshotManager
 }
 
+// snapshotRestoreProgress returns the progress of the state sync snapshot
+// restoration in progress, if any.
+func (app *BaseApp) snapshotRestoreProgress() (snapshots.RestoreProgress, bool) {
+	if app.snapshotManager == nil {
+		return snapshots.RestoreProgress{}, false
+	}
+
+	return app.snapshotManager.RestoreProgress()
+}
+
 // LoadVersion loads the BaseApp application version. It will panic if called
 // more than once on a running baseapp.
 func (app *BaseApp) LoadVersion(version int64) error {
@@ -361,13 +430,44 @@ func (app *BaseApp) Init() error {
 		return errors.New("commit multi-store must not be nil")
 	}
 
+	// the transactions of a no-op mempool are proposed as the raw bytes
+	// received by CometBFT, which block execution rejects in an alternative
+	// wire encoding
+	if _, isNoOp := app.mempool.(mempool.NoOpMempool); isNoOp && len(app.txWireDecoders) > 0 {
+		return errors.New("tx wire decoders require an application-side mempool")
+	}
+
+	app.loadLastBlockTime()
+	app.startCacheWarming()
+
 	return app.cms.GetPruning().Validate()
 }
 
+// loadLastBlockTime sets the time of the last committed block from the commit
+// info of the latest version, so that it survives restarts.
+func (app *BaseApp) loadLastBlockTime() {
+	rms, ok := app.cms.(*rootmulti.Store)
+	if !ok || app.LastBlockHeight() == 0 {
+		return
+	}
+
+	cInfo, err := rms.GetCommitInfo(app.LastBlockHeight())
+	if err != nil {
+		app.logger.Error("failed to load the time of the last committed block", "height", app.LastBlockHeight(), "err", err)
+		return
+	}
+
+	app.lastBlockTime = cInfo.Timestamp
+}
+
 func (app *BaseApp) setMinGasPrices(gasPrices sdk.DecCoins) {
 	app.minGasPrices = gasPrices
 }
 
+func (app *BaseApp) setMinGasPricesAllOf(allOf bool) {
+	app.minGasPricesAllOf = allOf
+}
+
 func (app *BaseApp) setHaltHeight(haltHeight uint64) {
 	app.haltHeight = haltHeight
 }
@@ -388,6 +488,10 @@ func (app *BaseApp) setTrace(trace bool) {
 	app.trace = trace
 }
 
+func (app *BaseApp) setEventLimits(limits EventLimits) {
+	app.eventLimits = limits
+}
+
 func (app *BaseApp) setIndexEvents(ie []string) {
 	app.indexEvents = make(map[string]struct{})
 
@@ -409,13 +513,13 @@ func (app *BaseApp) setState(mode runTxMode, header cmtproto.Header) {
 	ms := app.cms.CacheMultiStore()
 	baseState := &state{
 		ms:  ms,
-		ctx: sdk.NewContext(ms, header, false, app.logger).WithStreamingManager(app.streamingManager),
+		ctx: sdk.NewContext(ms, header, false, app.logger).WithStreamingManager(app.streamingManager).WithExecMode(mode.execMode()),
 	}
 
 	switch mode {
 	case runTxModeCheck:
 		// Minimum gas prices are also set. It is set on InitChain and reset on Commit.
-		baseState.ctx = baseState.ctx.WithIsCheckTx(true).WithMinGasPrices(app.minGasPrices)
+		baseState.ctx = baseState.ctx.WithIsCheckTx(true).WithMinGasPrices(app.minGasPrices).WithMinGasPricesAllOf(app.minGasPricesAllOf)
 		app.checkState = baseState
 	case runTxModeDeliver:
 		// It is set on InitChain and BeginBlock and set to nil on Commit.
@@ -516,6 +620,12 @@ func (app *BaseApp) validateHeight(req abci.RequestBeginBlock) error {
 	return nil
 }
 
+// validateBlockTime returns an error if the time of a block is before the time
+// of the last committed block.
+func (app *BaseApp) validateBlockTime(blockTime time.Time) error {
+	return sdk.ValidateBlockTime(app.lastBlockTime, blockTime)
+}
+
 // validateBasicTxMsgs executes basic validator calls for messages.
 func validateBasicTxMsgs(msgs []sdk.Msg) error {
 	if len(msgs) == 0 {
@@ -570,9 +680,14 @@ func (app *BaseApp) getContextForTx(mode runTxMode, txBytes []byte) sdk.Context
 		panic(fmt.Sprintf("state is nil for mode %v", mode))
 	}
 	ctx := modeState.ctx.
-		WithTxBytes(txBytes)
+		WithTxBytes(txBytes).
+		WithExecMode(mode.execMode())
 		// WithVoteInfos(app.voteInfos) // TODO: identify if this is needed
 
+	if len(txBytes) > 0 {
+		ctx = ctx.WithTxHash(tmhash.Sum(txBytes))
+	}
+
 	ctx = ctx.WithConsensusParams(app.GetConsensusParams(ctx))
 
 	if mode == runTxModeReCheck {
@@ -605,6 +720,20 @@ func (app *BaseApp) cacheTxContext(ctx sdk.Context, txBytes []byte) (sdk.Context
 	return ctx.WithMultiStore(msCache), msCache
 }
 
+// decodeTx decodes the given transaction bytes. Outside of block execution,
+// transactions starting with the prefix of a registered alternative wire
+// encoding are decoded by the corresponding decoder; otherwise the canonical
+// TxDecoder is used.
+func (app *BaseApp) decodeTx(mode runTxMode, txBytes []byte) (sdk.Tx, error) {
+	if len(txBytes) > 0 && (mode == runTxModeCheck || mode == runTxModeReCheck || mode == runTxModeSimulate) {
+		if decoder, ok := app.txWireDecoders[txBytes[0]]; ok {
+			return decoder(txBytes[1:])
+		}
+	}
+
+	return app.txDecoder(txBytes)
+}
+
 // runTx processes a transaction within a given execution mode, encoded transaction
 // bytes, and the decoded transaction itself. All state transitions occur through
 // a cached Context depending on the mode provided. State only gets persisted
@@ -659,7 +788,7 @@ func (app *BaseApp) runTx(mode runTxMode, txBytes []byte) (gInfo sdk.GasInfo, re
 		defer consumeBlockGas()
 	}
 
-	tx, err := app.txDecoder(txBytes)
+	tx, err := app.decodeTx(mode, txBytes)
 	if err != nil {
 		return sdk.GasInfo{}, nil, nil, 0, err
 	}
@@ -702,6 +831,14 @@ func (app *BaseApp) runTx(mode runTxMode, txBytes []byte) (gInfo sdk.GasInfo, re
 		gasWanted = ctx.GasMeter().Limit()
 
 		if err != nil {
+			if mode == runTxModeReCheck {
+				// the tx is evicted from the application-side mempool as CometBFT
+				// evicts it from its own, so that it is no longer proposed
+				if removeErr := app.mempool.Remove(tx); removeErr != nil && !errors.Is(removeErr, mempool.ErrTxNotFound) {
+					return gInfo, nil, nil, 0, fmt.Errorf("%w; failed to remove tx from mempool: %v", err, removeErr)
+				}
+			}
+
 			return gInfo, nil, nil, 0, err
 		}
 
@@ -732,6 +869,14 @@ func (app *BaseApp) runTx(mode runTxMode, txBytes []byte) (gInfo sdk.GasInfo, re
 	// and we're in DeliverTx. Note, runMsgs will never return a reference to a
 	// Result if any single message fails or does not have a registered Handler.
 	result, err = app.runMsgs(runMsgCtx, msgs, mode)
+
+	// keep the diagnostic event of a message handler panic in the result of
+	// the failed tx
+	var panicErr *msgPanicError
+	if errors.As(err, &panicErr) {
+		anteEvents = append(anteEvents, abci.Event(panicErr.event))
+	}
+
 	if err == nil {
 		// Run optional postHandlers.
 		//
@@ -787,7 +932,7 @@ func (app *BaseApp) runMsgs(ctx sdk.Context, msgs []sdk.Msg, mode runTxMode) (*s
 		}
 
 		// ADR 031 request type routing
-		msgResult, err := handler(ctx, msg)
+		msgResult, err := app.runMsgHandler(ctx, handler, msg, i, mode)
 		if err != nil {
 			return nil, errorsmod.Wrapf(err, "failed to execute message; message index: %d", i)
 		}
@@ -801,7 +946,7 @@ func (app *BaseApp) runMsgs(ctx sdk.Context, msgs []sdk.Msg, mode runTxMode) (*s
 		// separate each result.
 		for j, event := range msgEvents {
 			// append message index to all events
-			msgEvents[j] = event.AppendAttributes(sdk.NewAttribute("msg_index", strconv.Itoa(i)))
+			msgEvents[j] = event.AppendAttributes(sdk.NewAttribute(sdk.AttributeKeyMsgIndex, strconv.Itoa(i)))
 		}
 
 		events = events.AppendEvents(msgEvents)
@@ -15,6 +15,7 @@ import (
 	snapshottypes "cosmossdk.io/store/snapshots/types"
 	storetypes "cosmossdk.io/store/types"
 	abci "github.com/cometbft/cometbft/abci/types"
+	"github.com/cometbft/cometbft/crypto/tmhash"
 	cmtproto "github.com/cometbft/cometbft/proto/tendermint/types"
 	dbm "github.com/cosmos/cosmos-db"
 	"github.com/stretchr/testify/require"
@@ -467,6 +468,41 @@ func TestCustomRunTxPanicHandler(t *testing.T) {
 	}
 }
 
+func TestAnteHandlerExecMode(t *testing.T) {
+	var (
+		execModes []sdk.ExecMode
+		txHashes  [][]byte
+	)
+	anteOpt := func(bapp *baseapp.BaseApp) {
+		bapp.SetAnteHandler(func(ctx sdk.Context, tx sdk.Tx, simulate bool) (sdk.Context, error) {
+			require.Equal(t, simulate, ctx.IsSimulate())
+			execModes = append(execModes, ctx.ExecMode())
+			txHashes = append(txHashes, ctx.TxHash())
+			return ctx, nil
+		})
+	}
+	suite := NewBaseAppSuite(t, anteOpt)
+
+	suite.baseApp.InitChain(abci.RequestInitChain{
+		ConsensusParams: &cmtproto.ConsensusParams{},
+	})
+
+	txBytes, err := suite.txConfig.TxEncoder()(newTxCounter(t, suite.txConfig, 0, 0))
+	require.NoError(t, err)
+
+	suite.baseApp.CheckTx(abci.RequestCheckTx{Tx: txBytes})
+	suite.baseApp.CheckTx(abci.RequestCheckTx{Tx: txBytes, Type: abci.CheckTxType_Recheck})
+	_, _, _ = suite.baseApp.Simulate(txBytes)
+
+	suite.baseApp.BeginBlock(abci.RequestBeginBlock{Header: cmtproto.Header{Height: 1}})
+	suite.baseApp.DeliverTx(abci.RequestDeliverTx{Tx: txBytes})
+
+	require.Equal(t, []sdk.ExecMode{sdk.ExecModeCheck, sdk.ExecModeReCheck, sdk.ExecModeSimulate, sdk.ExecModeDeliver}, execModes)
+	for _, hash := range txHashes {
+		require.Equal(t, tmhash.Sum(txBytes), hash)
+	}
+}
+
 func TestBaseAppAnteHandler(t *testing.T) {
 	anteKey := []byte("ante-key")
 	anteOpt := func(bapp *baseapp.BaseApp) {
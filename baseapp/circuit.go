@@ -0,0 +1,10 @@
+package baseapp
+
+import "context"
+
+// CircuitBreaker tells whether the messages of a given type can be executed.
+// It is checked by the MsgServiceRouter before executing each message, nested
+// messages included.
+type CircuitBreaker interface {
+	IsAllowed(ctx context.Context, typeURL string) (bool, error)
+}
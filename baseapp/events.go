@@ -0,0 +1,159 @@
+package baseapp
+
+import (
+	"strconv"
+	"unicode/utf8"
+
+	abci "github.com/cometbft/cometbft/abci/types"
+)
+
+const (
+	// EventTypeEventsTruncated is the type of the event appended to the events
+	// of a tx, or of BeginBlock or EndBlock, truncated by the event limits.
+	EventTypeEventsTruncated = "events_truncated"
+
+	// AttributeKeyDroppedEvents is the number of events dropped.
+	AttributeKeyDroppedEvents = "dropped_events"
+	// AttributeKeyDroppedAttributes is the number of attributes of the dropped
+	// events.
+	AttributeKeyDroppedAttributes = "dropped_attributes"
+	// AttributeKeyTruncatedAttributes is the number of attribute values
+	// truncated to the maximum attribute size.
+	AttributeKeyTruncatedAttributes = "truncated_attributes"
+)
+
+// EventLimits defines the limits on the events returned to CometBFT, which
+// protect the nodes from the bloat of oversized or countless events, e.g. a
+// failed proposal logging a huge error. A zero limit is disabled.
+//
+// The events aren't part of the results hashed in the blocks, so the limits
+// are a node-local configuration. The truncation is deterministic: the events
+// are kept in order until the limit on their number of attributes is reached,
+// all the following events being dropped, and an EventTypeEventsTruncated
+// event is appended to the truncated events.
+type EventLimits struct {
+	// MaxAttributeSize is the maximum size in bytes of the value of an
+	// attribute. Longer values are truncated.
+	MaxAttributeSize int
+	// MaxTxAttributes is the maximum number of attributes of the events of a
+	// tx.
+	MaxTxAttributes int
+	// MaxBlockAttributes is the maximum number of attributes of the events of
+	// a block, i.e. of BeginBlock, of the txs and of EndBlock.
+	MaxBlockAttributes int
+}
+
+// limitEvents applies the event limits to the events of a tx or, if isTx is
+// false, of BeginBlock or EndBlock. The block limit only applies to the events
+// of the block being executed, i.e. not in CheckTx.
+func (app *BaseApp) limitEvents(events []abci.Event, isTx, inBlock bool) []abci.Event {
+	limits := app.eventLimits
+
+	maxAttributes := -1
+	if isTx && limits.MaxTxAttributes > 0 {
+		maxAttributes = limits.MaxTxAttributes
+	}
+	if inBlock && limits.MaxBlockAttributes > 0 {
+		remaining := limits.MaxBlockAttributes - app.blockEventAttributes
+		if remaining < 0 {
+			remaining = 0
+		}
+		if maxAttributes < 0 || remaining < maxAttributes {
+			maxAttributes = remaining
+		}
+	}
+
+	events, attributes := truncateEvents(events, maxAttributes, limits.MaxAttributeSize)
+	if inBlock {
+		app.blockEventAttributes += attributes
+	}
+
+	return events
+}
+
+// truncateEvents keeps the events in order until their number of attributes
+// exceeds maxAttributes, if not negative, and truncates the attribute values
+// longer than maxAttributeSize, if positive. It returns the kept events, along
+// with a truncation event if any event was dropped or truncated, and the
+// number of kept attributes.
+func truncateEvents(events []abci.Event, maxAttributes, maxAttributeSize int) ([]abci.Event, int) {
+	var (
+		attributes, droppedEvents, droppedAttributes, truncatedAttributes int
+		kept                                                              []abci.Event
+	)
+	for i, event := range events {
+		if droppedEvents > 0 || (maxAttributes >= 0 && attributes+len(event.Attributes) > maxAttributes) {
+			if kept == nil {
+				kept = events[:i:i]
+			}
+			droppedEvents++
+			droppedAttributes += len(event.Attributes)
+			continue
+		}
+		attributes += len(event.Attributes)
+
+		truncated := countOversizedAttributes(event.Attributes, maxAttributeSize)
+		if truncated == 0 {
+			if kept != nil {
+				kept = append(kept, event)
+			}
+			continue
+		}
+
+		// the kept events are copied on append, as their capacity is their
+		// length, so that the given events aren't modified
+		if kept == nil {
+			kept = events[:i:i]
+		}
+		kept = append(kept, truncateEvent(event, maxAttributeSize))
+		truncatedAttributes += truncated
+	}
+
+	if kept == nil {
+		return events, attributes
+	}
+
+	return append(kept, abci.Event{
+		Type: EventTypeEventsTruncated,
+		Attributes: []abci.EventAttribute{
+			{Key: AttributeKeyDroppedEvents, Value: strconv.Itoa(droppedEvents)},
+			{Key: AttributeKeyDroppedAttributes, Value: strconv.Itoa(droppedAttributes)},
+			{Key: AttributeKeyTruncatedAttributes, Value: strconv.Itoa(truncatedAttributes)},
+		},
+	}), attributes
+}
+
+// countOversizedAttributes returns the number of attribute values longer than
+// maxAttributeSize, if positive.
+func countOversizedAttributes(attrs []abci.EventAttribute, maxAttributeSize int) int {
+	if maxAttributeSize <= 0 {
+		return 0
+	}
+
+	n := 0
+	for _, attr := range attrs {
+		if len(attr.Value) > maxAttributeSize {
+			n++
+		}
+	}
+
+	return n
+}
+
+// truncateEvent returns a copy of the event with its attribute values
+// truncated to maxAttributeSize bytes, without splitting a UTF-8 character.
+func truncateEvent(event abci.Event, maxAttributeSize int) abci.Event {
+	attrs := make([]abci.EventAttribute, len(event.Attributes))
+	for i, attr := range event.Attributes {
+		if len(attr.Value) > maxAttributeSize {
+			end := maxAttributeSize
+			for end > 0 && !utf8.RuneStart(attr.Value[end]) {
+				end--
+			}
+			attr.Value = attr.Value[:end]
+		}
+		attrs[i] = attr
+	}
+
+	return abci.Event{Type: event.Type, Attributes: attrs}
+}
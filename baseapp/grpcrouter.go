@@ -5,6 +5,8 @@ import (
 
 	abci "github.com/cometbft/cometbft/abci/types"
 	gogogrpc "github.com/cosmos/gogoproto/grpc"
+	"golang.org/x/exp/maps"
+	"golang.org/x/exp/slices"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/encoding"
 
@@ -50,6 +52,14 @@ func (qrt *GRPCQueryRouter) Route(path string) GRPCQueryHandler {
 	return handler
 }
 
+// Routes returns the sorted fully-qualified method names of the registered
+// query handlers.
+func (qrt *GRPCQueryRouter) Routes() []string {
+	routes := maps.Keys(qrt.routes)
+	slices.Sort(routes)
+	return routes
+}
+
 // RegisterService implements the gRPC Server.RegisterService method. sd is a gRPC
 // service description, handler is an object which implements that gRPC service/
 //
@@ -2,6 +2,7 @@ package baseapp
 
 import (
 	"context"
+	"fmt"
 	"strconv"
 
 	errorsmod "cosmossdk.io/errors"
@@ -46,6 +47,18 @@ func (app *BaseApp) RegisterGRPCServer(server gogogrpc.Server) {
 			}
 		}
 
+		// Report the progress of a state sync snapshot restoration, if any. The
+		// queries are served from the stores restored so far meanwhile.
+		if progress, ok := app.snapshotRestoreProgress(); ok {
+			md := metadata.Pairs(
+				grpctypes.GRPCStateSyncRestoreHeightHeader, strconv.FormatUint(progress.Height, 10),
+				grpctypes.GRPCStateSyncRestoreChunksHeader, fmt.Sprintf("%d/%d", progress.ChunksApplied, progress.Chunks),
+			)
+			if err := grpc.SetHeader(grpcCtx, md); err != nil {
+				app.logger.Error("failed to set gRPC header", "err", err)
+			}
+		}
+
 		// Create the sdk.Context. Passing false as 2nd arg, as we can't
 		// actually support proofs with gRPC right now.
 		sdkCtx, err := app.CreateQueryContext(height, false)
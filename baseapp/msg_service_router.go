@@ -6,6 +6,8 @@ import (
 
 	gogogrpc "github.com/cosmos/gogoproto/grpc"
 	"github.com/cosmos/gogoproto/proto"
+	"golang.org/x/exp/maps"
+	"golang.org/x/exp/slices"
 	"google.golang.org/grpc"
 
 	errorsmod "cosmossdk.io/errors"
@@ -26,6 +28,7 @@ type MessageRouter interface {
 type MsgServiceRouter struct {
 	interfaceRegistry codectypes.InterfaceRegistry
 	routes            map[string]MsgServiceHandler
+	circuitBreaker    CircuitBreaker
 }
 
 var _ gogogrpc.Server = &MsgServiceRouter{}
@@ -51,6 +54,14 @@ func (msr *MsgServiceRouter) HandlerByTypeURL(typeURL string) MsgServiceHandler
 	return msr.routes[typeURL]
 }
 
+// MsgTypeURLs returns the sorted type URLs of the messages which have a
+// registered handler.
+func (msr *MsgServiceRouter) MsgTypeURLs() []string {
+	typeURLs := maps.Keys(msr.routes)
+	slices.Sort(typeURLs)
+	return typeURLs
+}
+
 // RegisterService implements the gRPC Server.RegisterService method. sd is a gRPC
 // service description, handler is an object which implements that gRPC service.
 //
@@ -122,6 +133,18 @@ func (msr *MsgServiceRouter) RegisterService(sd *grpc.ServiceDesc, handler inter
 				return handler(goCtx, msg)
 			}
 
+			if msr.circuitBreaker != nil {
+				msgURL := sdk.MsgTypeURL(msg)
+				isAllowed, err := msr.circuitBreaker.IsAllowed(ctx, msgURL)
+				if err != nil {
+					return nil, err
+				}
+
+				if !isAllowed {
+					return nil, errorsmod.Wrapf(sdkerrors.ErrUnauthorized, "circuit breaker disables execution of this message: %s", msgURL)
+				}
+			}
+
 			if m, ok := msg.(sdk.HasValidateBasic); ok {
 				if err := m.ValidateBasic(); err != nil {
 					return nil, err
@@ -145,6 +168,11 @@ func (msr *MsgServiceRouter) RegisterService(sd *grpc.ServiceDesc, handler inter
 	}
 }
 
+// SetCircuit sets the circuit breaker checked before executing each message.
+func (msr *MsgServiceRouter) SetCircuit(cb CircuitBreaker) {
+	msr.circuitBreaker = cb
+}
+
 // SetInterfaceRegistry sets the interface registry for the router.
 func (msr *MsgServiceRouter) SetInterfaceRegistry(interfaceRegistry codectypes.InterfaceRegistry) {
 	msr.interfaceRegistry = interfaceRegistry
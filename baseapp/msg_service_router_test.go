@@ -12,11 +12,14 @@ import (
 	"cosmossdk.io/depinject"
 	"cosmossdk.io/log"
 
+	"github.com/cosmos/cosmos-sdk/baseapp"
 	"github.com/cosmos/cosmos-sdk/client/tx"
 	"github.com/cosmos/cosmos-sdk/codec"
 	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
 	"github.com/cosmos/cosmos-sdk/runtime"
 	"github.com/cosmos/cosmos-sdk/testutil/testdata"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	"github.com/cosmos/cosmos-sdk/types/tx/signing"
 	authsigning "github.com/cosmos/cosmos-sdk/x/auth/signing"
 	authtx "github.com/cosmos/cosmos-sdk/x/auth/tx"
@@ -160,3 +163,53 @@ func TestMsgService(t *testing.T) {
 	res := app.DeliverTx(abci.RequestDeliverTx{Tx: txBytes})
 	require.Equal(t, abci.CodeTypeOK, res.Code, "res=%+v", res)
 }
+
+type mockCircuitBreaker struct {
+	tripped map[string]bool
+}
+
+var _ baseapp.CircuitBreaker = mockCircuitBreaker{}
+
+func (cb mockCircuitBreaker) IsAllowed(_ context.Context, typeURL string) (bool, error) {
+	return !cb.tripped[typeURL], nil
+}
+
+func TestMsgServiceCircuitBreaker(t *testing.T) {
+	var (
+		appBuilder *runtime.AppBuilder
+		registry   codectypes.InterfaceRegistry
+	)
+	err := depinject.Inject(
+		depinject.Configs(
+			makeMinimalConfig(),
+			depinject.Supply(log.NewTestLogger(t)),
+		), &appBuilder, &registry)
+	require.NoError(t, err)
+	app := appBuilder.Build(dbm.NewMemDB(), nil)
+
+	testdata.RegisterInterfaces(registry)
+	testdata.RegisterMsgServer(
+		app.MsgServiceRouter(),
+		testdata.MsgServerImpl{},
+	)
+
+	msg := &testdata.MsgCreateDog{Dog: &testdata.Dog{Name: "Spot"}}
+	ctx := sdk.NewContext(nil, cmtproto.Header{}, false, log.NewNopLogger())
+
+	// the messages are executed while their circuit is not tripped
+	app.SetCircuitBreaker(mockCircuitBreaker{})
+	handler := app.MsgServiceRouter().Handler(msg)
+	_, err = handler(ctx, msg)
+	require.NoError(t, err)
+
+	// the router checks the circuit breaker on every message it routes, so
+	// that the messages nested in other messages are rejected too
+	app.SetCircuitBreaker(mockCircuitBreaker{tripped: map[string]bool{sdk.MsgTypeURL(msg): true}})
+	_, err = handler(ctx, msg)
+	require.ErrorIs(t, err, sdkerrors.ErrUnauthorized)
+
+	// the circuit breaker is optional
+	app.MsgServiceRouter().SetCircuit(nil)
+	_, err = handler(ctx, msg)
+	require.NoError(t, err)
+}
@@ -34,6 +34,12 @@ func SetMinGasPrices(gasPricesStr string) func(*BaseApp) {
 	return func(bapp *BaseApp) { bapp.setMinGasPrices(gasPrices) }
 }
 
+// SetMinGasPricesAllOf returns an option requiring the fee of a transaction to
+// cover all the minimum gas prices, instead of any of them.
+func SetMinGasPricesAllOf(allOf bool) func(*BaseApp) {
+	return func(bapp *BaseApp) { bapp.setMinGasPricesAllOf(allOf) }
+}
+
 // SetHaltHeight returns a BaseApp option function that sets the halt block height.
 func SetHaltHeight(blockHeight uint64) func(*BaseApp) {
 	return func(bapp *BaseApp) { bapp.setHaltHeight(blockHeight) }
@@ -61,6 +67,18 @@ func SetIndexEvents(ie []string) func(*BaseApp) {
 	return func(app *BaseApp) { app.setIndexEvents(ie) }
 }
 
+// SetEventLimits provides a BaseApp option function that sets the limits on the
+// events returned to CometBFT.
+func SetEventLimits(limits EventLimits) func(*BaseApp) {
+	return func(app *BaseApp) { app.setEventLimits(limits) }
+}
+
+// SetCacheWarming provides a BaseApp option function that enables the
+// pre-execution of the txs accepted by CheckTx to warm the store caches.
+func SetCacheWarming(cfg CacheWarmingConfig) func(*BaseApp) {
+	return func(app *BaseApp) { app.setCacheWarming(cfg) }
+}
+
 // SetIAVLCacheSize provides a BaseApp option function that sets the size of IAVL cache.
 func SetIAVLCacheSize(size int) func(*BaseApp) {
 	return func(bapp *BaseApp) { bapp.cms.SetIAVLCacheSize(size) }
@@ -263,6 +281,38 @@ func (app *BaseApp) SetTxDecoder(txDecoder sdk.TxDecoder) {
 	app.txDecoder = txDecoder
 }
 
+// SetTxWireDecoder registers a decoder for transactions submitted in an
+// alternative wire encoding (e.g. CBOR or SSZ). Such transactions are identified
+// by their first byte, which must equal prefix and is stripped before the
+// remaining bytes are passed to the decoder.
+//
+// Alternative encodings are only accepted by CheckTx and transaction simulation,
+// and are rejected during block execution. Blocks must therefore only contain
+// canonically encoded transactions: the app fails to load unless an
+// application-side mempool is set, whose transactions the default
+// PrepareProposal handler re-encodes with the TxEncoder. Applications setting a
+// custom PrepareProposal handler must re-encode the transactions they propose
+// in the same way.
+func (app *BaseApp) SetTxWireDecoder(prefix byte, decoder sdk.TxDecoder) {
+	if app.sealed {
+		panic("SetTxWireDecoder() on sealed BaseApp")
+	}
+
+	if prefix == canonicalTxPrefix {
+		panic(fmt.Errorf("tx wire prefix 0x%02x is reserved for the canonical encoding", prefix))
+	}
+
+	if app.txWireDecoders == nil {
+		app.txWireDecoders = make(map[byte]sdk.TxDecoder)
+	}
+
+	if _, ok := app.txWireDecoders[prefix]; ok {
+		panic(fmt.Errorf("tx wire decoder already registered for prefix 0x%02x", prefix))
+	}
+
+	app.txWireDecoders[prefix] = decoder
+}
+
 // SetTxEncoder sets the TxEncoder if it wasn't provided in the BaseApp constructor.
 func (app *BaseApp) SetTxEncoder(txEncoder sdk.TxEncoder) {
 	app.txEncoder = txEncoder
@@ -313,3 +363,18 @@ func (app *BaseApp) SetStoreMetrics(gatherer metrics.StoreMetrics) {
 func (app *BaseApp) SetStreamingManager(manager storetypes.StreamingManager) {
 	app.streamingManager = manager
 }
+
+// SetWhatIfGuard sets the guard WhatIf calls before each simulated block.
+func (app *BaseApp) SetWhatIfGuard(guard WhatIfGuard) {
+	app.whatIfGuard = guard
+}
+
+// SetCircuitBreaker sets the circuit breaker of the BaseApp, which is checked
+// before the execution of every message, nested messages included.
+func (app *BaseApp) SetCircuitBreaker(cb CircuitBreaker) {
+	if app.msgServiceRouter == nil {
+		panic("cannot set circuit breaker with no msg service router set")
+	}
+
+	app.msgServiceRouter.SetCircuit(cb)
+}
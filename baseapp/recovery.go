@@ -1,12 +1,17 @@
 package baseapp
 
 import (
+	"crypto/sha256"
+	"encoding/hex"
 	"fmt"
 	"runtime/debug"
+	"strconv"
 
 	errorsmod "cosmossdk.io/errors"
 	storetypes "cosmossdk.io/store/types"
+	"github.com/armon/go-metrics"
 
+	"github.com/cosmos/cosmos-sdk/telemetry"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 )
@@ -78,3 +83,60 @@ func newDefaultRecoveryMiddleware() recoveryMiddleware {
 
 	return newRecoveryMiddleware(handler, nil)
 }
+
+// msgPanicError is the error of a message whose handler panicked. It carries
+// the diagnostic event added to the result of the failed tx.
+type msgPanicError struct {
+	err   error
+	event sdk.Event
+}
+
+func (e *msgPanicError) Error() string { return e.err.Error() }
+
+// Cause returns the error of the recovery middlewares, so that the ABCI code
+// of the failed tx is the one of that error.
+func (e *msgPanicError) Cause() error { return e.err }
+
+func (e *msgPanicError) Unwrap() error { return e.err }
+
+// runMsgHandler executes the handler of the message at the given index of a
+// tx. A panic in the handler is processed by the runTx recovery middlewares
+// and returned as a msgPanicError, so that the tx fails with a diagnostic
+// event naming the module, the message index and the hash of the panic
+// message. Out of gas panics are propagated to runTx, which reports the gas
+// used.
+func (app *BaseApp) runMsgHandler(ctx sdk.Context, handler MsgServiceHandler, msg sdk.Msg, msgIndex int, mode runTxMode) (result *sdk.Result, err error) {
+	defer func() {
+		r := recover()
+		if r == nil {
+			return
+		}
+
+		if _, ok := r.(storetypes.ErrorOutOfGas); ok {
+			panic(r)
+		}
+
+		module := sdk.GetModuleNameFromTypeURL(sdk.MsgTypeURL(msg))
+		panicHash := sha256.Sum256([]byte(fmt.Sprintf("%v", r)))
+
+		if mode == runTxModeDeliver {
+			app.logger.Error("recovered from panic in message handler", "module", module, "msg_index", msgIndex, "panic", r)
+			telemetry.IncrCounterWithLabels(
+				[]string{"tx", "msg", "panic"}, 1,
+				[]metrics.Label{telemetry.NewLabel("module", module)},
+			)
+		}
+
+		result, err = nil, &msgPanicError{
+			err: processRecovery(r, app.runTxRecoveryMiddleware),
+			event: sdk.NewEvent(
+				sdk.EventTypeMsgPanic,
+				sdk.NewAttribute(sdk.AttributeKeyModule, module),
+				sdk.NewAttribute(sdk.AttributeKeyMsgIndex, strconv.Itoa(msgIndex)),
+				sdk.NewAttribute(sdk.AttributeKeyPanicHash, hex.EncodeToString(panicHash[:])),
+			),
+		}
+	}()
+
+	return handler(ctx, msg)
+}
@@ -78,6 +78,15 @@ func (app *BaseApp) registerABCIListenerPlugin(
 	)
 }
 
+// RegisterABCIListener appends an ABCIListener to the listeners of the BaseApp
+// streaming manager and exposes the state changes of the given store keys to
+// them. It must be called after RegisterStreamingServices, which replaces the
+// streaming manager.
+func (app *BaseApp) RegisterABCIListener(listener storetypes.ABCIListener, keys ...storetypes.StoreKey) {
+	app.cms.AddListeners(keys)
+	app.streamingManager.ABCIListeners = append(app.streamingManager.ABCIListeners, listener)
+}
+
 func exposeAll(list []string) bool {
 	for _, ele := range list {
 		if ele == "*" {
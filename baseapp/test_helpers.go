@@ -42,7 +42,8 @@ func (app *BaseApp) SimDeliver(txEncoder sdk.TxEncoder, tx sdk.Tx) (sdk.GasInfo,
 func (app *BaseApp) NewContext(isCheckTx bool, header cmtproto.Header) sdk.Context {
 	if isCheckTx {
 		return sdk.NewContext(app.checkState.ms, header, true, app.logger).
-			WithMinGasPrices(app.minGasPrices)
+			WithMinGasPrices(app.minGasPrices).
+			WithMinGasPricesAllOf(app.minGasPricesAllOf)
 	}
 
 	return sdk.NewContext(app.deliverState.ms, header, false, app.logger)
@@ -132,6 +132,17 @@ func (m NoopCounterServerImpl) IncrementCounter(
 	return &baseapptestutil.MsgCreateCounterResponse{}, nil
 }
 
+type PanicCounterServerImpl struct {
+	msg string
+}
+
+func (m PanicCounterServerImpl) IncrementCounter(
+	_ context.Context,
+	_ *baseapptestutil.MsgCounter,
+) (*baseapptestutil.MsgCreateCounterResponse, error) {
+	panic(m.msg)
+}
+
 type CounterServerImpl struct {
 	t          *testing.T
 	capKey     storetypes.StoreKey
@@ -0,0 +1,175 @@
+package baseapp
+
+import (
+	"fmt"
+	"sync"
+
+	"github.com/cometbft/cometbft/crypto/tmhash"
+	cmtproto "github.com/cometbft/cometbft/proto/tendermint/types"
+
+	storetypes "cosmossdk.io/store/types"
+
+	"github.com/cosmos/cosmos-sdk/telemetry"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// CacheWarmingConfig defines the configuration of the cache warming, which
+// pre-executes the txs accepted by CheckTx in the background so that the
+// state they read is loaded in the store caches, e.g. the IAVL node cache and
+// the cache of the database, before the txs are executed in a block. It
+// reduces the block execution latency of the nodes whose state reads are
+// I/O-bound.
+//
+// The txs are only pre-executed between the Commit of a block and the start
+// of the next one, against a branch of the committed state which is never
+// written, so the speculative writes are discarded and the cache warming
+// doesn't affect the execution of the blocks.
+type CacheWarmingConfig struct {
+	// Workers is the number of txs pre-executed concurrently. Zero disables
+	// the cache warming.
+	Workers int
+	// QueueSize is the maximum number of txs waiting to be pre-executed. The
+	// txs accepted by CheckTx while the queue is full aren't pre-executed.
+	QueueSize int
+}
+
+// cacheWarmer pre-executes the txs of its queue with a pool of workers.
+type cacheWarmer struct {
+	cfg   CacheWarmingConfig
+	queue chan []byte
+
+	// mtx guards the committed state: the workers hold its read lock while
+	// they pre-execute a tx, and its write lock is held to start a block, so
+	// that the block never runs concurrently with a pre-execution.
+	mtx sync.RWMutex
+	// resumed is signaled when the warming is resumed, i.e. on Commit.
+	resumed *sync.Cond
+	// open is whether the txs can be pre-executed, i.e. whether the last
+	// block is committed and the next one isn't started.
+	open bool
+	// header is the header of the last committed block.
+	header cmtproto.Header
+}
+
+func (app *BaseApp) setCacheWarming(cfg CacheWarmingConfig) {
+	if cfg.Workers <= 0 {
+		app.cacheWarmer = nil
+		return
+	}
+
+	w := &cacheWarmer{cfg: cfg}
+	w.resumed = sync.NewCond(w.mtx.RLocker())
+	app.cacheWarmer = w
+}
+
+// startCacheWarming starts the workers of the cache warming, if enabled.
+func (app *BaseApp) startCacheWarming() {
+	w := app.cacheWarmer
+	if w == nil || w.queue != nil {
+		return
+	}
+
+	w.queue = make(chan []byte, w.cfg.QueueSize)
+	for i := 0; i < w.cfg.Workers; i++ {
+		go func() {
+			for txBytes := range w.queue {
+				w.mtx.RLock()
+				for !w.open {
+					w.resumed.Wait()
+				}
+				if err := app.warmTx(txBytes, w.header); err != nil {
+					app.logger.Debug("failed to pre-execute tx", "height", w.header.Height, "err", err)
+				}
+				w.mtx.RUnlock()
+			}
+		}()
+	}
+}
+
+// stopCacheWarming waits for the running pre-executions to complete and stops
+// the cache warming until the next Commit. It is called before a block reads
+// or writes the committed state.
+func (app *BaseApp) stopCacheWarming() {
+	w := app.cacheWarmer
+	if w == nil {
+		return
+	}
+
+	w.mtx.Lock()
+	w.open = false
+	w.mtx.Unlock()
+}
+
+// resumeCacheWarming resumes the cache warming once a block is committed,
+// pre-executing the txs against the state it committed.
+func (app *BaseApp) resumeCacheWarming(header cmtproto.Header) {
+	w := app.cacheWarmer
+	if w == nil {
+		return
+	}
+
+	w.mtx.Lock()
+	w.open = true
+	w.header = header
+	w.resumed.Broadcast()
+	w.mtx.Unlock()
+}
+
+// enqueueWarmingTx queues a tx accepted by CheckTx to be pre-executed, unless
+// the queue is full. It never blocks CheckTx.
+func (app *BaseApp) enqueueWarmingTx(txBytes []byte) {
+	w := app.cacheWarmer
+	if w == nil || w.queue == nil {
+		return
+	}
+
+	select {
+	case w.queue <- txBytes:
+	default:
+		telemetry.IncrCounter(1, "cache_warming", "dropped")
+	}
+}
+
+// warmTx pre-executes a tx, i.e. runs its ante handler and its messages in
+// simulation mode and with an infinite gas meter, against a branch of the
+// committed state which is discarded. It must be called while the cache
+// warming is open.
+func (app *BaseApp) warmTx(txBytes []byte, header cmtproto.Header) (err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			err = fmt.Errorf("panic while pre-executing tx: %v", r)
+		}
+	}()
+
+	ms := app.cms.CacheMultiStore()
+	ctx := sdk.NewContext(ms, header, false, app.logger).
+		WithTxBytes(txBytes).
+		WithTxHash(tmhash.Sum(txBytes)).
+		WithExecMode(sdk.ExecModeSimulate).
+		WithGasMeter(storetypes.NewInfiniteGasMeter()).
+		WithBlockGasMeter(storetypes.NewInfiniteGasMeter())
+	ctx = ctx.WithConsensusParams(app.GetConsensusParams(ctx))
+
+	tx, err := app.decodeTx(runTxModeSimulate, txBytes)
+	if err != nil {
+		return err
+	}
+
+	if app.anteHandler != nil {
+		newCtx, err := app.anteHandler(ctx, tx, true)
+		if err != nil {
+			return err
+		}
+		if !newCtx.IsZero() {
+			ctx = newCtx.WithMultiStore(ms)
+		}
+	}
+
+	if _, err := app.runMsgs(ctx.WithEventManager(sdk.NewEventManager()), tx.GetMsgs(), runTxModeSimulate); err != nil {
+		return err
+	}
+
+	telemetry.IncrCounter(1, "cache_warming", "warmed")
+
+	return nil
+}
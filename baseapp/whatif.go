@@ -0,0 +1,102 @@
+package baseapp
+
+import (
+	"fmt"
+	"time"
+
+	abci "github.com/cometbft/cometbft/abci/types"
+
+	errorsmod "cosmossdk.io/errors"
+	storetypes "cosmossdk.io/store/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// WhatIfGuard is called by WhatIf with the context of each simulated block
+// before running it. The simulation stops with the returned error, e.g. for a
+// block whose execution would act on the node outside of the state branch,
+// such as an upgrade writing the upgrade info to disk.
+type WhatIfGuard func(ctx sdk.Context) error
+
+// WhatIf executes msgs against an in-memory branch of the state of ctx, e.g. a
+// query context, then fast-forwards the branch by the given number of blocks
+// spaced by blockTime, running the BeginBlocker and EndBlocker of the app for
+// each of them. The branch is never written back to ctx. It returns the context
+// of the branch after the last simulated block, to measure its state, and the
+// gas consumed by the messages.
+//
+// The messages are executed without authentication nor ante handler, e.g. a
+// MsgUpdateParams on behalf of the governance module account. The simulated
+// blocks contain no transaction, are proposed by the proposer of the latest
+// block, and are signed by the validators which signed the latest block. The
+// guard set with SetWhatIfGuard is called before each simulated block.
+func (app *BaseApp) WhatIf(ctx sdk.Context, msgs []sdk.Msg, blocks uint64, blockTime time.Duration) (_ sdk.Context, gasUsed uint64, err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			err = fmt.Errorf("panic while simulating: %v", r)
+		}
+	}()
+
+	ctx, _ = ctx.CacheContext()
+	ctx = ctx.
+		WithIsCheckTx(false).
+		WithExecMode(sdk.ExecModeSimulate).
+		WithGasMeter(storetypes.NewInfiniteGasMeter()).
+		WithBlockGasMeter(storetypes.NewInfiniteGasMeter()).
+		WithConsensusParams(app.GetConsensusParams(ctx))
+
+	for i, msg := range msgs {
+		if m, ok := msg.(sdk.HasValidateBasic); ok {
+			if err := m.ValidateBasic(); err != nil {
+				return ctx, 0, errorsmod.Wrapf(err, "message %d", i)
+			}
+		}
+
+		handler := app.msgServiceRouter.Handler(msg)
+		if handler == nil {
+			return ctx, 0, errorsmod.Wrapf(sdkerrors.ErrUnknownRequest, "no message handler found for %s", sdk.MsgTypeURL(msg))
+		}
+
+		if _, err := handler(ctx, msg); err != nil {
+			return ctx, 0, errorsmod.Wrapf(err, "message %d", i)
+		}
+	}
+	gasUsed = ctx.GasMeter().GasConsumed()
+
+	app.lastCommitInfoMtx.RLock()
+	lastCommit := app.lastCommitInfo
+	app.lastCommitInfoMtx.RUnlock()
+
+	header := ctx.BlockHeader()
+	for i := uint64(0); i < blocks; i++ {
+		header.Height++
+		header.Time = header.Time.Add(blockTime)
+
+		ctx = ctx.
+			WithBlockHeader(header).
+			WithEventManager(sdk.NewEventManager()).
+			WithVoteInfos(lastCommit.Votes).
+			WithCometInfo(cometInfo{ValidatorsHash: header.ValidatorsHash, ProposerAddress: header.ProposerAddress, LastCommit: lastCommit})
+
+		if app.whatIfGuard != nil {
+			if err := app.whatIfGuard(ctx); err != nil {
+				return ctx, gasUsed, errorsmod.Wrapf(err, "block %d", header.Height)
+			}
+		}
+
+		if app.beginBlocker != nil {
+			if _, err := app.beginBlocker(ctx, abci.RequestBeginBlock{Header: header, LastCommitInfo: lastCommit}); err != nil {
+				return ctx, gasUsed, errorsmod.Wrapf(err, "begin block %d", header.Height)
+			}
+		}
+
+		if app.endBlocker != nil {
+			if _, err := app.endBlocker(ctx, abci.RequestEndBlock{Height: header.Height}); err != nil {
+				return ctx, gasUsed, errorsmod.Wrapf(err, "end block %d", header.Height)
+			}
+		}
+	}
+
+	return ctx, gasUsed, nil
+}
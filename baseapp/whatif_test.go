@@ -0,0 +1,103 @@
+package baseapp_test
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	abci "github.com/cometbft/cometbft/abci/types"
+	cmtproto "github.com/cometbft/cometbft/proto/tendermint/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	baseapptestutil "github.com/cosmos/cosmos-sdk/baseapp/testutil"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+func TestWhatIf(t *testing.T) {
+	heightKey := []byte("height")
+
+	// the end blocker records the height of the last block
+	setEndBlocker := func(bapp *baseapp.BaseApp) {
+		bapp.SetEndBlocker(func(ctx sdk.Context, _ abci.RequestEndBlock) (abci.ResponseEndBlock, error) {
+			ctx.KVStore(capKey1).Set(heightKey, sdk.Uint64ToBigEndian(uint64(ctx.BlockHeight())))
+			return abci.ResponseEndBlock{}, nil
+		})
+	}
+
+	suite := NewBaseAppSuite(t, setEndBlocker)
+	baseapptestutil.RegisterKeyValueServer(suite.baseApp.MsgServiceRouter(), MsgKeyValueImpl{})
+
+	suite.baseApp.InitChain(abci.RequestInitChain{
+		ConsensusParams: &cmtproto.ConsensusParams{},
+	})
+
+	header := cmtproto.Header{Height: 1, Time: time.Unix(1_000_000, 0).UTC()}
+	suite.baseApp.BeginBlock(abci.RequestBeginBlock{Header: header})
+	suite.baseApp.EndBlock(abci.RequestEndBlock{Height: header.Height})
+	suite.baseApp.Commit()
+
+	ctx, err := suite.baseApp.CreateQueryContext(0, false)
+	require.NoError(t, err)
+
+	msg := &baseapptestutil.MsgKeyValue{Key: []byte("key"), Value: []byte("value")}
+	forkCtx, gasUsed, err := suite.baseApp.WhatIf(ctx, []sdk.Msg{msg}, 3, 5*time.Second)
+	require.NoError(t, err)
+	require.NotZero(t, gasUsed)
+	require.Equal(t, int64(4), forkCtx.BlockHeight())
+	require.Equal(t, header.Time.Add(15*time.Second), forkCtx.BlockTime())
+	require.Equal(t, msg.Value, forkCtx.KVStore(capKey2).Get(msg.Key))
+	require.Equal(t, sdk.Uint64ToBigEndian(4), forkCtx.KVStore(capKey1).Get(heightKey))
+
+	// the fork is not written back
+	require.Nil(t, ctx.KVStore(capKey2).Get(msg.Key))
+	require.Equal(t, sdk.Uint64ToBigEndian(1), ctx.KVStore(capKey1).Get(heightKey))
+
+	// the blocks can be simulated without messages
+	forkCtx, gasUsed, err = suite.baseApp.WhatIf(ctx, nil, 2, time.Second)
+	require.NoError(t, err)
+	require.Zero(t, gasUsed)
+	require.Nil(t, forkCtx.KVStore(capKey2).Get(msg.Key))
+	require.Equal(t, sdk.Uint64ToBigEndian(3), forkCtx.KVStore(capKey1).Get(heightKey))
+
+	_, _, err = suite.baseApp.WhatIf(ctx, []sdk.Msg{&baseapptestutil.MsgKeyValue{}}, 1, time.Second)
+	require.ErrorIs(t, err, sdkerrors.ErrInvalidRequest)
+}
+
+func TestWhatIfGuard(t *testing.T) {
+	errUpgradeDue := errors.New("upgrade due")
+	lastCommit := abci.CommitInfo{Round: 1, Votes: []abci.VoteInfo{{Validator: abci.Validator{Address: []byte("val"), Power: 10}, SignedLastBlock: true}}}
+
+	// the guard refuses to simulate the block at height 3
+	setGuard := func(bapp *baseapp.BaseApp) {
+		bapp.SetWhatIfGuard(func(ctx sdk.Context) error {
+			require.True(t, ctx.IsSimulate())
+			require.Equal(t, lastCommit.Votes, ctx.VoteInfos())
+			if ctx.BlockHeight() == 3 {
+				return errUpgradeDue
+			}
+			return nil
+		})
+	}
+
+	suite := NewBaseAppSuite(t, setGuard)
+	suite.baseApp.InitChain(abci.RequestInitChain{
+		ConsensusParams: &cmtproto.ConsensusParams{},
+	})
+
+	header := cmtproto.Header{Height: 1, Time: time.Unix(1_000_000, 0).UTC()}
+	suite.baseApp.BeginBlock(abci.RequestBeginBlock{Header: header, LastCommitInfo: lastCommit})
+	suite.baseApp.EndBlock(abci.RequestEndBlock{Height: header.Height})
+	suite.baseApp.Commit()
+
+	ctx, err := suite.baseApp.CreateQueryContext(0, false)
+	require.NoError(t, err)
+
+	forkCtx, _, err := suite.baseApp.WhatIf(ctx, nil, 1, time.Second)
+	require.NoError(t, err)
+	require.Equal(t, int64(2), forkCtx.BlockHeight())
+
+	_, _, err = suite.baseApp.WhatIf(ctx, nil, 3, time.Second)
+	require.ErrorIs(t, err, errUpgradeDue)
+}
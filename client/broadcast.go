@@ -19,8 +19,17 @@ import (
 // BroadcastTx broadcasts a transactions either synchronously or asynchronously
 // based on the context parameters. The result of the broadcast is parsed into
 // an intermediate structure which is logged if the context has a logger
-// defined.
+// defined. If the context has a Broadcaster, the transaction is broadcast
+// through it.
 func (ctx Context) BroadcastTx(txBytes []byte) (res *sdk.TxResponse, err error) {
+	if ctx.Broadcaster != nil {
+		goCtx := ctx.CmdContext
+		if goCtx == nil {
+			goCtx = context.Background()
+		}
+		return ctx.Broadcaster.Broadcast(goCtx, txBytes, ctx.BroadcastMode)
+	}
+
 	switch ctx.BroadcastMode {
 	case flags.BroadcastSync:
 		res, err = ctx.BroadcastTxSync(txBytes)
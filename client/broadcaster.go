@@ -0,0 +1,300 @@
+package client
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+
+	coretypes "github.com/cometbft/cometbft/rpc/core/types"
+	cmttypes "github.com/cometbft/cometbft/types"
+
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+const (
+	// DefaultBroadcastTimeout is the default timeout of a broadcast to a
+	// single node, after which the broadcast fails over to the next node.
+	DefaultBroadcastTimeout = 10 * time.Second
+
+	// broadcastResultsCacheSize is the number of broadcast results kept to
+	// deduplicate the broadcasts of the same tx.
+	broadcastResultsCacheSize = 1024
+)
+
+// BroadcastEndpoint is a node the txs can be broadcast to.
+type BroadcastEndpoint struct {
+	// URI is the URI of the CometBFT RPC interface of the node.
+	URI string
+	// Client is the RPC client of the node.
+	Client CometRPC
+}
+
+// EndpointHealth is the health of a broadcast endpoint, as last observed by
+// a health check or a broadcast.
+type EndpointHealth struct {
+	URI     string
+	Healthy bool
+	// Err is the last error returned by the node, if any.
+	Err error
+}
+
+type broadcastEndpoint struct {
+	BroadcastEndpoint
+
+	healthy bool
+	err     error
+}
+
+// broadcastCall is a broadcast of a tx, in flight until done is closed.
+type broadcastCall struct {
+	done chan struct{}
+	res  *sdk.TxResponse
+	err  error
+}
+
+// Broadcaster broadcasts txs to redundant nodes, e.g. the sentry nodes of a
+// validator or redundant RPC nodes. The broadcasts are load-balanced across
+// the healthy nodes, and fail over to the next node when a node returns an
+// error or doesn't respond within the timeout. The broadcasts of the same tx
+// are deduplicated by tx hash, the result of the first broadcast being
+// returned to all of them.
+//
+// A Broadcaster is safe for concurrent use.
+type Broadcaster struct {
+	timeout time.Duration
+
+	mtx       sync.Mutex
+	endpoints []*broadcastEndpoint
+	next      int
+	calls     map[string]*broadcastCall
+	results   []string
+}
+
+// NewBroadcaster returns a Broadcaster of the given endpoints, all assumed to
+// be healthy until a health check or a broadcast tells otherwise. A zero
+// timeout defaults to DefaultBroadcastTimeout.
+func NewBroadcaster(endpoints []BroadcastEndpoint, timeout time.Duration) (*Broadcaster, error) {
+	if len(endpoints) == 0 {
+		return nil, errors.New("no broadcast endpoints")
+	}
+
+	if timeout <= 0 {
+		timeout = DefaultBroadcastTimeout
+	}
+
+	b := &Broadcaster{
+		timeout: timeout,
+		calls:   make(map[string]*broadcastCall),
+	}
+	for _, e := range endpoints {
+		if e.Client == nil {
+			return nil, fmt.Errorf("no client for broadcast endpoint %s", e.URI)
+		}
+		b.endpoints = append(b.endpoints, &broadcastEndpoint{BroadcastEndpoint: e, healthy: true})
+	}
+
+	return b, nil
+}
+
+// NewBroadcasterFromNodes returns a Broadcaster of the CometBFT RPC interfaces
+// of the given nodes.
+func NewBroadcasterFromNodes(nodeURIs []string, timeout time.Duration) (*Broadcaster, error) {
+	endpoints := make([]BroadcastEndpoint, len(nodeURIs))
+	for i, uri := range nodeURIs {
+		c, err := NewClientFromNode(uri)
+		if err != nil {
+			return nil, fmt.Errorf("failed to create client of node %s: %w", uri, err)
+		}
+		endpoints[i] = BroadcastEndpoint{URI: uri, Client: c}
+	}
+
+	return NewBroadcaster(endpoints, timeout)
+}
+
+// CheckHealth checks the status of all the nodes concurrently. A node is
+// healthy if it responds within the timeout and isn't catching up.
+func (b *Broadcaster) CheckHealth(ctx context.Context) []EndpointHealth {
+	b.mtx.Lock()
+	endpoints := append([]*broadcastEndpoint(nil), b.endpoints...)
+	b.mtx.Unlock()
+
+	errs := make([]error, len(endpoints))
+	var wg sync.WaitGroup
+	for i, e := range endpoints {
+		wg.Add(1)
+		go func(i int, e *broadcastEndpoint) {
+			defer wg.Done()
+
+			ctx, cancel := context.WithTimeout(ctx, b.timeout)
+			defer cancel()
+
+			status, err := e.Client.Status(ctx)
+			switch {
+			case err != nil:
+				errs[i] = err
+			case status.SyncInfo.CatchingUp:
+				errs[i] = errors.New("node is catching up")
+			}
+		}(i, e)
+	}
+	wg.Wait()
+
+	for i, e := range endpoints {
+		b.setHealth(e, errs[i])
+	}
+
+	return b.Health()
+}
+
+// Health returns the health of the endpoints, as last observed.
+func (b *Broadcaster) Health() []EndpointHealth {
+	b.mtx.Lock()
+	defer b.mtx.Unlock()
+
+	health := make([]EndpointHealth, len(b.endpoints))
+	for i, e := range b.endpoints {
+		health[i] = EndpointHealth{URI: e.URI, Healthy: e.healthy, Err: e.err}
+	}
+
+	return health
+}
+
+// Client returns the client of the first healthy endpoint, or of the first
+// endpoint if none is healthy, e.g. to query the accounts of the signers.
+func (b *Broadcaster) Client() CometRPC {
+	b.mtx.Lock()
+	defer b.mtx.Unlock()
+
+	for _, e := range b.endpoints {
+		if e.healthy {
+			return e.Client
+		}
+	}
+
+	return b.endpoints[0].Client
+}
+
+// Broadcast broadcasts the tx bytes in the given mode, either sync or async.
+// The nodes are tried in turn, starting with the healthy ones, until one of
+// them accepts the broadcast; a tx rejected by the CheckTx of a node isn't
+// broadcast to the other nodes. If the tx was already broadcast, the result
+// of the previous broadcast is returned.
+func (b *Broadcaster) Broadcast(ctx context.Context, txBytes []byte, mode string) (*sdk.TxResponse, error) {
+	if mode != flags.BroadcastSync && mode != flags.BroadcastAsync {
+		return nil, fmt.Errorf("unsupported return type %s; supported types: sync, async", mode)
+	}
+
+	hash := fmt.Sprintf("%X", cmttypes.Tx(txBytes).Hash())
+
+	b.mtx.Lock()
+	if call, ok := b.calls[hash]; ok {
+		b.mtx.Unlock()
+		select {
+		case <-call.done:
+			return call.res, call.err
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		}
+	}
+	call := &broadcastCall{done: make(chan struct{})}
+	b.calls[hash] = call
+	b.mtx.Unlock()
+
+	call.res, call.err = b.broadcast(ctx, txBytes, mode)
+	close(call.done)
+
+	b.mtx.Lock()
+	if call.err != nil {
+		// failed broadcasts are not deduplicated, so that they can be retried
+		delete(b.calls, hash)
+	} else {
+		b.results = append(b.results, hash)
+		if len(b.results) > broadcastResultsCacheSize {
+			delete(b.calls, b.results[0])
+			b.results = b.results[1:]
+		}
+	}
+	b.mtx.Unlock()
+
+	return call.res, call.err
+}
+
+func (b *Broadcaster) broadcast(ctx context.Context, txBytes []byte, mode string) (*sdk.TxResponse, error) {
+	var errs []error
+	for _, e := range b.order() {
+		res, err := b.broadcastTo(ctx, e, txBytes, mode)
+		if err == nil {
+			b.setHealth(e, nil)
+			return res, nil
+		}
+
+		b.setHealth(e, err)
+		errs = append(errs, fmt.Errorf("%s: %w", e.URI, err))
+		if ctx.Err() != nil {
+			break
+		}
+	}
+
+	return nil, fmt.Errorf("failed to broadcast tx to any node: %w", errors.Join(errs...))
+}
+
+func (b *Broadcaster) broadcastTo(ctx context.Context, e *broadcastEndpoint, txBytes []byte, mode string) (*sdk.TxResponse, error) {
+	ctx, cancel := context.WithTimeout(ctx, b.timeout)
+	defer cancel()
+
+	var (
+		res *coretypes.ResultBroadcastTx
+		err error
+	)
+	if mode == flags.BroadcastSync {
+		res, err = e.Client.BroadcastTxSync(ctx, txBytes)
+	} else {
+		res, err = e.Client.BroadcastTxAsync(ctx, txBytes)
+	}
+
+	// a tx already in the mempool of the node, e.g. broadcast by a timed out
+	// attempt, or rejected by its mempool is not failed over
+	if errRes := CheckCometError(err, txBytes); errRes != nil {
+		return errRes, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return sdk.NewResponseFormatBroadcastTx(res), nil
+}
+
+// order returns the endpoints in the order they are tried: the healthy ones,
+// in round robin order, then the unhealthy ones.
+func (b *Broadcaster) order() []*broadcastEndpoint {
+	b.mtx.Lock()
+	defer b.mtx.Unlock()
+
+	n := len(b.endpoints)
+	start := b.next
+	b.next = (b.next + 1) % n
+
+	healthy := make([]*broadcastEndpoint, 0, n)
+	var unhealthy []*broadcastEndpoint
+	for i := 0; i < n; i++ {
+		e := b.endpoints[(start+i)%n]
+		if e.healthy {
+			healthy = append(healthy, e)
+		} else {
+			unhealthy = append(unhealthy, e)
+		}
+	}
+
+	return append(healthy, unhealthy...)
+}
+
+func (b *Broadcaster) setHealth(e *broadcastEndpoint, err error) {
+	b.mtx.Lock()
+	defer b.mtx.Unlock()
+
+	e.healthy = err == nil
+	e.err = err
+}
@@ -0,0 +1,176 @@
+package client
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/cometbft/cometbft/mempool"
+	"github.com/cometbft/cometbft/rpc/client/mock"
+	coretypes "github.com/cometbft/cometbft/rpc/core/types"
+	cmttypes "github.com/cometbft/cometbft/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+type broadcastNode struct {
+	mock.Client
+
+	mtx        sync.Mutex
+	broadcasts int
+	hang       bool
+	catchingUp bool
+	err        error
+}
+
+func (n *broadcastNode) Status(ctx context.Context) (*coretypes.ResultStatus, error) {
+	if n.hang {
+		<-ctx.Done()
+		return nil, ctx.Err()
+	}
+
+	return &coretypes.ResultStatus{SyncInfo: coretypes.SyncInfo{CatchingUp: n.catchingUp}}, nil
+}
+
+func (n *broadcastNode) BroadcastTxSync(ctx context.Context, tx cmttypes.Tx) (*coretypes.ResultBroadcastTx, error) {
+	n.mtx.Lock()
+	n.broadcasts++
+	n.mtx.Unlock()
+
+	if n.hang {
+		<-ctx.Done()
+		return nil, ctx.Err()
+	}
+	if n.err != nil {
+		return nil, n.err
+	}
+
+	return &coretypes.ResultBroadcastTx{Hash: tx.Hash()}, nil
+}
+
+func (n *broadcastNode) count() int {
+	n.mtx.Lock()
+	defer n.mtx.Unlock()
+
+	return n.broadcasts
+}
+
+func newTestBroadcaster(t *testing.T, nodes ...*broadcastNode) *Broadcaster {
+	t.Helper()
+
+	endpoints := make([]BroadcastEndpoint, len(nodes))
+	for i, n := range nodes {
+		endpoints[i] = BroadcastEndpoint{URI: string(rune('a' + i)), Client: n}
+	}
+
+	b, err := NewBroadcaster(endpoints, 50*time.Millisecond)
+	require.NoError(t, err)
+
+	return b
+}
+
+func TestBroadcasterLoadBalancing(t *testing.T) {
+	a, b := &broadcastNode{}, &broadcastNode{}
+	broadcaster := newTestBroadcaster(t, a, b)
+
+	for i := 0; i < 4; i++ {
+		res, err := broadcaster.Broadcast(context.Background(), []byte{byte(i)}, flags.BroadcastSync)
+		require.NoError(t, err)
+		require.Equal(t, uint32(0), res.Code)
+	}
+
+	require.Equal(t, 2, a.count())
+	require.Equal(t, 2, b.count())
+}
+
+func TestBroadcasterFailover(t *testing.T) {
+	hanging := &broadcastNode{hang: true}
+	failing := &broadcastNode{err: errors.New("connection refused")}
+	healthy := &broadcastNode{}
+	broadcaster := newTestBroadcaster(t, hanging, failing, healthy)
+
+	res, err := broadcaster.Broadcast(context.Background(), []byte{0x1}, flags.BroadcastSync)
+	require.NoError(t, err)
+	require.Equal(t, uint32(0), res.Code)
+	require.Equal(t, 1, hanging.count())
+	require.Equal(t, 1, failing.count())
+	require.Equal(t, 1, healthy.count())
+
+	// the failed nodes are tried last
+	health := broadcaster.Health()
+	require.False(t, health[0].Healthy)
+	require.ErrorIs(t, health[0].Err, context.DeadlineExceeded)
+	require.False(t, health[1].Healthy)
+	require.True(t, health[2].Healthy)
+
+	_, err = broadcaster.Broadcast(context.Background(), []byte{0x2}, flags.BroadcastSync)
+	require.NoError(t, err)
+	require.Equal(t, 1, hanging.count())
+	require.Equal(t, 1, failing.count())
+	require.Equal(t, 2, healthy.count())
+
+	// a tx rejected by the mempool of a node is not failed over
+	healthy.err = mempool.ErrTxInCache
+	res, err = broadcaster.Broadcast(context.Background(), []byte{0x3}, flags.BroadcastSync)
+	require.NoError(t, err)
+	require.Equal(t, sdkerrors.ErrTxInMempoolCache.ABCICode(), res.Code)
+	require.Equal(t, 1, failing.count())
+
+	// all the nodes failing
+	healthy.err = errors.New("connection refused")
+	_, err = broadcaster.Broadcast(context.Background(), []byte{0x4}, flags.BroadcastSync)
+	require.ErrorContains(t, err, "failed to broadcast tx to any node")
+}
+
+func TestBroadcasterDeduplication(t *testing.T) {
+	a := &broadcastNode{}
+	broadcaster := newTestBroadcaster(t, a)
+
+	txBytes := []byte{0x1}
+	var wg sync.WaitGroup
+	for i := 0; i < 5; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			res, err := broadcaster.Broadcast(context.Background(), txBytes, flags.BroadcastSync)
+			require.NoError(t, err)
+			require.Equal(t, uint32(0), res.Code)
+		}()
+	}
+	wg.Wait()
+	require.Equal(t, 1, a.count())
+
+	// failed broadcasts can be retried
+	a.err = errors.New("connection refused")
+	_, err := broadcaster.Broadcast(context.Background(), []byte{0x2}, flags.BroadcastSync)
+	require.Error(t, err)
+	a.err = nil
+	_, err = broadcaster.Broadcast(context.Background(), []byte{0x2}, flags.BroadcastSync)
+	require.NoError(t, err)
+	require.Equal(t, 3, a.count())
+}
+
+func TestBroadcasterCheckHealth(t *testing.T) {
+	hanging := &broadcastNode{hang: true}
+	catchingUp := &broadcastNode{catchingUp: true}
+	healthy := &broadcastNode{}
+	broadcaster := newTestBroadcaster(t, hanging, catchingUp, healthy)
+
+	health := broadcaster.CheckHealth(context.Background())
+	require.False(t, health[0].Healthy)
+	require.False(t, health[1].Healthy)
+	require.ErrorContains(t, health[1].Err, "catching up")
+	require.True(t, health[2].Healthy)
+	require.Equal(t, healthy, broadcaster.Client())
+
+	// the broadcasts go to the healthy node
+	ctx := Context{Broadcaster: broadcaster, BroadcastMode: flags.BroadcastSync}
+	_, err := ctx.BroadcastTx([]byte{0x1})
+	require.NoError(t, err)
+	require.Equal(t, 1, healthy.count())
+	require.Equal(t, 0, hanging.count())
+}
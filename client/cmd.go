@@ -1,6 +1,7 @@
 package client
 
 import (
+	"context"
 	"crypto/tls"
 	"fmt"
 	"strings"
@@ -235,6 +236,27 @@ func readTxCommandFlags(clientCtx Context, flagSet *pflag.FlagSet) (Context, err
 		clientCtx = clientCtx.WithBroadcastMode(bMode)
 	}
 
+	if clientCtx.Broadcaster == nil && flagSet.Changed(flags.FlagNodes) && !clientCtx.Offline && !clientCtx.GenerateOnly {
+		nodes, _ := flagSet.GetStringSlice(flags.FlagNodes)
+		timeout, _ := flagSet.GetDuration(flags.FlagBroadcastTimeout)
+
+		broadcaster, err := NewBroadcasterFromNodes(nodes, timeout)
+		if err != nil {
+			return clientCtx, err
+		}
+
+		goCtx := clientCtx.CmdContext
+		if goCtx == nil {
+			goCtx = context.Background()
+		}
+		broadcaster.CheckHealth(goCtx)
+
+		clientCtx = clientCtx.WithBroadcaster(broadcaster)
+		if !flagSet.Changed(flags.FlagNode) {
+			clientCtx = clientCtx.WithClient(broadcaster.Client())
+		}
+	}
+
 	if !clientCtx.SkipConfirm || flagSet.Changed(flags.FlagSkipConfirmation) {
 		skipConfirm, _ := flagSet.GetBool(flags.FlagSkipConfirmation)
 		clientCtx = clientCtx.WithSkipConfirmation(skipConfirm)
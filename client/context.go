@@ -56,6 +56,7 @@ type Context struct {
 	Viper             *viper.Viper
 	LedgerHasProtobuf bool
 	PreprocessTxHook  PreprocessTxFn
+	Broadcaster       *Broadcaster
 
 	// IsAux is true when the signer is an auxiliary signer (e.g. the tipper).
 	IsAux bool
@@ -132,6 +133,13 @@ func (ctx Context) WithNodeURI(nodeURI string) Context {
 	return ctx
 }
 
+// WithBroadcaster returns a copy of the context with an updated Broadcaster,
+// broadcasting the txs to redundant nodes instead of the node of the client.
+func (ctx Context) WithBroadcaster(broadcaster *Broadcaster) Context {
+	ctx.Broadcaster = broadcaster
+	return ctx
+}
+
 // WithHeight returns a copy of the context with an updated height.
 func (ctx Context) WithHeight(height int64) Context {
 	ctx.Height = height
@@ -3,6 +3,7 @@ package flags
 import (
 	"fmt"
 	"strconv"
+	"time"
 
 	"github.com/spf13/cobra"
 	"github.com/spf13/pflag"
@@ -62,6 +63,8 @@ const (
 	FlagGas              = "gas"
 	FlagGasPrices        = "gas-prices"
 	FlagBroadcastMode    = "broadcast-mode"
+	FlagNodes            = "nodes"
+	FlagBroadcastTimeout = "broadcast-timeout"
 	FlagDryRun           = "dry-run"
 	FlagGenerateOnly     = "generate-only"
 	FlagOffline          = "offline"
@@ -128,6 +131,8 @@ func AddTxFlagsToCmd(cmd *cobra.Command) {
 	f.Bool(FlagUseLedger, false, "Use a connected Ledger device")
 	f.Float64(FlagGasAdjustment, DefaultGasAdjustment, "adjustment factor to be multiplied against the estimate returned by the tx simulation; if the gas limit is set manually this flag is ignored ")
 	f.StringP(FlagBroadcastMode, "b", BroadcastSync, "Transaction broadcasting mode (sync|async)")
+	f.StringSlice(FlagNodes, nil, "Comma-separated <host>:<port> of the CometBFT rpc interfaces of redundant nodes to broadcast to, with load-balancing and failover")
+	f.Duration(FlagBroadcastTimeout, 10*time.Second, "Timeout of a broadcast to a single node of --nodes, after which the broadcast fails over to the next node")
 	f.Bool(FlagDryRun, false, "ignore the --gas flag and perform a simulation of a transaction, but don't broadcast it (when enabled, the local Keybase is not accessible)")
 	f.Bool(FlagGenerateOnly, false, "Build an unsigned transaction and write it to STDOUT (when enabled, the local Keybase only accessed when providing a key name)")
 	f.Bool(FlagOffline, false, "Offline mode (does not allow any online functionality)")
@@ -0,0 +1,1203 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: cosmos/base/whatif/v1beta1/query.proto
+
+package whatif
+
+import (
+	context "context"
+	fmt "fmt"
+	_ "github.com/cosmos/cosmos-proto"
+	types "github.com/cosmos/cosmos-sdk/codec/types"
+	_ "github.com/cosmos/gogoproto/gogoproto"
+	grpc1 "github.com/cosmos/gogoproto/grpc"
+	proto "github.com/cosmos/gogoproto/proto"
+	github_com_cosmos_gogoproto_types "github.com/cosmos/gogoproto/types"
+	_ "google.golang.org/genproto/googleapis/api/annotations"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+	_ "google.golang.org/protobuf/types/known/durationpb"
+	_ "google.golang.org/protobuf/types/known/timestamppb"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+	time "time"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+var _ = time.Kitchen
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// WhatIfRequest defines the request structure for the WhatIf gRPC query.
+type WhatIfRequest struct {
+	// messages are the messages to execute, without authentication, e.g. a
+	// MsgUpdateParams signed by the governance module account.
+	Messages []*types.Any `protobuf:"bytes,1,rep,name=messages,proto3" json:"messages,omitempty"`
+	// blocks is the number of blocks to simulate after the messages execution.
+	Blocks uint64 `protobuf:"varint,2,opt,name=blocks,proto3" json:"blocks,omitempty"`
+	// block_time is the duration between two simulated blocks, which defaults to
+	// 5 seconds.
+	BlockTime time.Duration `protobuf:"bytes,3,opt,name=block_time,json=blockTime,proto3,stdduration" json:"block_time"`
+}
+
+func (m *WhatIfRequest) Reset()         { *m = WhatIfRequest{} }
+func (m *WhatIfRequest) String() string { return proto.CompactTextString(m) }
+func (*WhatIfRequest) ProtoMessage()    {}
+func (*WhatIfRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f51e5fd3428d0033, []int{0}
+}
+func (m *WhatIfRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *WhatIfRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_WhatIfRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *WhatIfRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_WhatIfRequest.Merge(m, src)
+}
+func (m *WhatIfRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *WhatIfRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_WhatIfRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_WhatIfRequest proto.InternalMessageInfo
+
+func (m *WhatIfRequest) GetMessages() []*types.Any {
+	if m != nil {
+		return m.Messages
+	}
+	return nil
+}
+
+func (m *WhatIfRequest) GetBlocks() uint64 {
+	if m != nil {
+		return m.Blocks
+	}
+	return 0
+}
+
+func (m *WhatIfRequest) GetBlockTime() time.Duration {
+	if m != nil {
+		return m.BlockTime
+	}
+	return 0
+}
+
+// WhatIfResponse defines the response structure for the WhatIf gRPC query.
+type WhatIfResponse struct {
+	// initial_metrics are the metrics of the current state.
+	InitialMetrics []Metric `protobuf:"bytes,1,rep,name=initial_metrics,json=initialMetrics,proto3" json:"initial_metrics"`
+	// baseline_metrics are the metrics after the simulated blocks without the
+	// messages, for comparison.
+	BaselineMetrics []Metric `protobuf:"bytes,2,rep,name=baseline_metrics,json=baselineMetrics,proto3" json:"baseline_metrics"`
+	// final_metrics are the metrics after the messages and the simulated blocks.
+	FinalMetrics []Metric `protobuf:"bytes,3,rep,name=final_metrics,json=finalMetrics,proto3" json:"final_metrics"`
+	// gas_used is the gas consumed by the messages execution.
+	GasUsed uint64 `protobuf:"varint,4,opt,name=gas_used,json=gasUsed,proto3" json:"gas_used,omitempty"`
+	// final_height is the height of the last simulated block.
+	FinalHeight int64 `protobuf:"varint,5,opt,name=final_height,json=finalHeight,proto3" json:"final_height,omitempty"`
+	// final_time is the time of the last simulated block.
+	FinalTime time.Time `protobuf:"bytes,6,opt,name=final_time,json=finalTime,proto3,stdtime" json:"final_time"`
+}
+
+func (m *WhatIfResponse) Reset()         { *m = WhatIfResponse{} }
+func (m *WhatIfResponse) String() string { return proto.CompactTextString(m) }
+func (*WhatIfResponse) ProtoMessage()    {}
+func (*WhatIfResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f51e5fd3428d0033, []int{1}
+}
+func (m *WhatIfResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *WhatIfResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_WhatIfResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *WhatIfResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_WhatIfResponse.Merge(m, src)
+}
+func (m *WhatIfResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *WhatIfResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_WhatIfResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_WhatIfResponse proto.InternalMessageInfo
+
+func (m *WhatIfResponse) GetInitialMetrics() []Metric {
+	if m != nil {
+		return m.InitialMetrics
+	}
+	return nil
+}
+
+func (m *WhatIfResponse) GetBaselineMetrics() []Metric {
+	if m != nil {
+		return m.BaselineMetrics
+	}
+	return nil
+}
+
+func (m *WhatIfResponse) GetFinalMetrics() []Metric {
+	if m != nil {
+		return m.FinalMetrics
+	}
+	return nil
+}
+
+func (m *WhatIfResponse) GetGasUsed() uint64 {
+	if m != nil {
+		return m.GasUsed
+	}
+	return 0
+}
+
+func (m *WhatIfResponse) GetFinalHeight() int64 {
+	if m != nil {
+		return m.FinalHeight
+	}
+	return 0
+}
+
+func (m *WhatIfResponse) GetFinalTime() time.Time {
+	if m != nil {
+		return m.FinalTime
+	}
+	return time.Time{}
+}
+
+// Metric is a named value measured on the state of the chain, e.g. the
+// inflation or the bonded ratio.
+type Metric struct {
+	Name  string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Value string `protobuf:"bytes,2,opt,name=value,proto3" json:"value,omitempty"`
+}
+
+func (m *Metric) Reset()         { *m = Metric{} }
+func (m *Metric) String() string { return proto.CompactTextString(m) }
+func (*Metric) ProtoMessage()    {}
+func (*Metric) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f51e5fd3428d0033, []int{2}
+}
+func (m *Metric) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Metric) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Metric.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Metric) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Metric.Merge(m, src)
+}
+func (m *Metric) XXX_Size() int {
+	return m.Size()
+}
+func (m *Metric) XXX_DiscardUnknown() {
+	xxx_messageInfo_Metric.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Metric proto.InternalMessageInfo
+
+func (m *Metric) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *Metric) GetValue() string {
+	if m != nil {
+		return m.Value
+	}
+	return ""
+}
+
+func init() {
+	proto.RegisterType((*WhatIfRequest)(nil), "cosmos.base.whatif.v1beta1.WhatIfRequest")
+	proto.RegisterType((*WhatIfResponse)(nil), "cosmos.base.whatif.v1beta1.WhatIfResponse")
+	proto.RegisterType((*Metric)(nil), "cosmos.base.whatif.v1beta1.Metric")
+}
+
+func init() {
+	proto.RegisterFile("cosmos/base/whatif/v1beta1/query.proto", fileDescriptor_f51e5fd3428d0033)
+}
+
+var fileDescriptor_f51e5fd3428d0033 = []byte{
+	// 578 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x53, 0x3f, 0x6f, 0xd3, 0x40,
+	0x14, 0xcf, 0x35, 0x69, 0xda, 0x5e, 0x69, 0x8b, 0x4e, 0x15, 0x38, 0x06, 0x39, 0x21, 0x48, 0xc8,
+	0x54, 0xea, 0x9d, 0x1a, 0x36, 0x36, 0x02, 0x03, 0x1d, 0x32, 0xe0, 0x16, 0x21, 0xb1, 0x58, 0x67,
+	0xe7, 0xe2, 0x9c, 0x6a, 0xfb, 0x52, 0xdf, 0x39, 0xa8, 0x2b, 0x23, 0x0b, 0x95, 0x58, 0x90, 0xf8,
+	0x1a, 0x4c, 0x7c, 0x82, 0x8a, 0xa9, 0x12, 0x0b, 0x13, 0xa0, 0x84, 0x0f, 0x82, 0x7c, 0x67, 0x57,
+	0xa5, 0x15, 0x15, 0x9d, 0xfc, 0xde, 0xbd, 0xdf, 0xfb, 0xbd, 0x3f, 0xbf, 0x67, 0xf8, 0x20, 0x14,
+	0x32, 0x11, 0x92, 0x04, 0x54, 0x32, 0xf2, 0x66, 0x4c, 0x15, 0x1f, 0x91, 0xe9, 0x4e, 0xc0, 0x14,
+	0xdd, 0x21, 0x87, 0x39, 0xcb, 0x8e, 0xf0, 0x24, 0x13, 0x4a, 0x20, 0xdb, 0xe0, 0x70, 0x81, 0xc3,
+	0x06, 0x87, 0x4b, 0x9c, 0x7d, 0x37, 0x12, 0x22, 0x8a, 0x19, 0xa1, 0x13, 0x4e, 0x68, 0x9a, 0x0a,
+	0x45, 0x15, 0x17, 0xa9, 0x34, 0x99, 0x76, 0xab, 0x8c, 0x6a, 0x2f, 0xc8, 0x47, 0x84, 0xa6, 0x25,
+	0xa9, 0xed, 0x5c, 0x0c, 0x0d, 0xf3, 0x4c, 0xe7, 0x96, 0xf1, 0xf6, 0xc5, 0xb8, 0xe2, 0x09, 0x93,
+	0x8a, 0x26, 0x93, 0x12, 0xb0, 0x19, 0x89, 0x48, 0x68, 0x93, 0x14, 0x56, 0x55, 0xd1, 0xf4, 0xea,
+	0x9b, 0x40, 0xd9, 0xb8, 0x76, 0xba, 0x5f, 0x00, 0x5c, 0x7b, 0x35, 0xa6, 0x6a, 0x77, 0xe4, 0xb1,
+	0xc3, 0x9c, 0x49, 0x85, 0x06, 0x70, 0x39, 0x61, 0x52, 0xd2, 0x88, 0x49, 0x0b, 0x74, 0xea, 0xee,
+	0x6a, 0x6f, 0x13, 0x9b, 0xb2, 0xb8, 0x2a, 0x8b, 0x9f, 0xa4, 0x47, 0xfd, 0x3b, 0x5f, 0x3f, 0x6f,
+	0xdf, 0x3e, 0xbf, 0x84, 0x72, 0x7a, 0x3c, 0x90, 0x91, 0x77, 0x46, 0x81, 0x6e, 0xc1, 0x66, 0x10,
+	0x8b, 0xf0, 0x40, 0x5a, 0x0b, 0x1d, 0xe0, 0x36, 0xbc, 0xd2, 0x43, 0x7d, 0x08, 0xb5, 0xe5, 0x17,
+	0x23, 0x58, 0xf5, 0x0e, 0x70, 0x57, 0x7b, 0xad, 0x4b, 0x85, 0x9e, 0x95, 0xf3, 0xf7, 0x97, 0x4f,
+	0x7e, 0xb4, 0x6b, 0x1f, 0x7f, 0xb6, 0x81, 0xb7, 0xa2, 0xd3, 0xf6, 0x79, 0xc2, 0xba, 0xef, 0xea,
+	0x70, 0xbd, 0x6a, 0x5e, 0x4e, 0x44, 0x2a, 0x19, 0x7a, 0x01, 0x37, 0x78, 0xca, 0x15, 0xa7, 0xb1,
+	0x9f, 0x30, 0x95, 0xf1, 0xb0, 0x1a, 0xa2, 0x8b, 0xff, 0x2d, 0x18, 0x1e, 0x68, 0x68, 0xbf, 0x51,
+	0x14, 0xf1, 0xd6, 0x4b, 0x02, 0xf3, 0x28, 0xd1, 0x1e, 0xbc, 0x59, 0xe4, 0xc4, 0x3c, 0x65, 0x67,
+	0x9c, 0x0b, 0xd7, 0xe4, 0xdc, 0xa8, 0x18, 0x2a, 0xd2, 0x01, 0x5c, 0x1b, 0xf1, 0xf4, 0x5c, 0x97,
+	0xf5, 0x6b, 0x32, 0xde, 0xd0, 0xe9, 0x15, 0x5d, 0x0b, 0x2e, 0x47, 0x54, 0xfa, 0xb9, 0x64, 0x43,
+	0xab, 0xa1, 0xf7, 0xbc, 0x14, 0x51, 0xf9, 0x52, 0xb2, 0x21, 0xba, 0x07, 0x0d, 0xd4, 0x1f, 0x33,
+	0x1e, 0x8d, 0x95, 0xb5, 0xd8, 0x01, 0x6e, 0xdd, 0x5b, 0xd5, 0x6f, 0xcf, 0xf5, 0x13, 0x7a, 0x0a,
+	0xa1, 0x81, 0x68, 0x2d, 0x9a, 0x5a, 0x0b, 0xfb, 0x92, 0x16, 0xfb, 0xd5, 0xad, 0x19, 0x31, 0x8e,
+	0xb5, 0x18, 0x3a, 0x4f, 0x8b, 0xd1, 0x83, 0x4d, 0xd3, 0x0d, 0x42, 0xb0, 0x91, 0xd2, 0x84, 0x59,
+	0xa0, 0x03, 0xdc, 0x15, 0x4f, 0xdb, 0x68, 0x13, 0x2e, 0x4e, 0x69, 0x9c, 0x33, 0x7d, 0x05, 0x2b,
+	0x9e, 0x71, 0x7a, 0x9f, 0x00, 0x5c, 0xda, 0x63, 0xd9, 0x94, 0x87, 0x0c, 0xbd, 0x07, 0xb0, 0x69,
+	0xc4, 0x44, 0x0f, 0xaf, 0xda, 0xc2, 0x5f, 0xd7, 0x6a, 0x6f, 0xfd, 0x0f, 0xd4, 0xdc, 0x46, 0x17,
+	0xbf, 0xfd, 0xf6, 0xfb, 0xc3, 0x82, 0xfb, 0x18, 0x6c, 0x75, 0xef, 0x93, 0x2b, 0x7e, 0xf3, 0xc2,
+	0xf5, 0xf9, 0xa8, 0xbf, 0x7b, 0x32, 0x73, 0xc0, 0xe9, 0xcc, 0x01, 0xbf, 0x66, 0x0e, 0x38, 0x9e,
+	0x3b, 0xb5, 0xd3, 0xb9, 0x53, 0xfb, 0x3e, 0x77, 0x6a, 0xaf, 0x49, 0xc4, 0xd5, 0x38, 0x0f, 0x70,
+	0x28, 0x92, 0x8a, 0xc8, 0x7c, 0xb6, 0xe5, 0xf0, 0x80, 0x84, 0x31, 0x67, 0xa9, 0x22, 0x51, 0x36,
+	0x09, 0x4b, 0xea, 0xa0, 0xa9, 0xb7, 0xf8, 0xe8, 0xcf, 0x00, 0x48, 0xdd, 0x81, 0x22, 0x5e, 0x04,
+	0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// ServiceClient is the client API for Service service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type ServiceClient interface {
+	// WhatIf executes messages against an in-memory fork of the current state,
+	// fast-forwards the fork by simulated blocks, and returns the metrics of the
+	// chain before and after.
+	WhatIf(ctx context.Context, in *WhatIfRequest, opts ...grpc.CallOption) (*WhatIfResponse, error)
+}
+
+type serviceClient struct {
+	cc grpc1.ClientConn
+}
+
+func NewServiceClient(cc grpc1.ClientConn) ServiceClient {
+	return &serviceClient{cc}
+}
+
+func (c *serviceClient) WhatIf(ctx context.Context, in *WhatIfRequest, opts ...grpc.CallOption) (*WhatIfResponse, error) {
+	out := new(WhatIfResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.base.whatif.v1beta1.Service/WhatIf", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// ServiceServer is the server API for Service service.
+type ServiceServer interface {
+	// WhatIf executes messages against an in-memory fork of the current state,
+	// fast-forwards the fork by simulated blocks, and returns the metrics of the
+	// chain before and after.
+	WhatIf(context.Context, *WhatIfRequest) (*WhatIfResponse, error)
+}
+
+// UnimplementedServiceServer can be embedded to have forward compatible implementations.
+type UnimplementedServiceServer struct {
+}
+
+func (*UnimplementedServiceServer) WhatIf(ctx context.Context, req *WhatIfRequest) (*WhatIfResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method WhatIf not implemented")
+}
+
+func RegisterServiceServer(s grpc1.Server, srv ServiceServer) {
+	s.RegisterService(&_Service_serviceDesc, srv)
+}
+
+func _Service_WhatIf_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(WhatIfRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ServiceServer).WhatIf(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.base.whatif.v1beta1.Service/WhatIf",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ServiceServer).WhatIf(ctx, req.(*WhatIfRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _Service_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "cosmos.base.whatif.v1beta1.Service",
+	HandlerType: (*ServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "WhatIf",
+			Handler:    _Service_WhatIf_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "cosmos/base/whatif/v1beta1/query.proto",
+}
+
+func (m *WhatIfRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *WhatIfRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *WhatIfRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	n1, err1 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.BlockTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.BlockTime):])
+	if err1 != nil {
+		return 0, err1
+	}
+	i -= n1
+	i = encodeVarintQuery(dAtA, i, uint64(n1))
+	i--
+	dAtA[i] = 0x1a
+	if m.Blocks != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Blocks))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Messages) > 0 {
+		for iNdEx := len(m.Messages) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Messages[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *WhatIfResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *WhatIfResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *WhatIfResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	n2, err2 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.FinalTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.FinalTime):])
+	if err2 != nil {
+		return 0, err2
+	}
+	i -= n2
+	i = encodeVarintQuery(dAtA, i, uint64(n2))
+	i--
+	dAtA[i] = 0x32
+	if m.FinalHeight != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.FinalHeight))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.GasUsed != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.GasUsed))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.FinalMetrics) > 0 {
+		for iNdEx := len(m.FinalMetrics) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.FinalMetrics[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.BaselineMetrics) > 0 {
+		for iNdEx := len(m.BaselineMetrics) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.BaselineMetrics[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.InitialMetrics) > 0 {
+		for iNdEx := len(m.InitialMetrics) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.InitialMetrics[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *Metric) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Metric) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Metric) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Value) > 0 {
+		i -= len(m.Value)
+		copy(dAtA[i:], m.Value)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Value)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
+	offset -= sovQuery(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *WhatIfRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Messages) > 0 {
+		for _, e := range m.Messages {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Blocks != 0 {
+		n += 1 + sovQuery(uint64(m.Blocks))
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.BlockTime)
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *WhatIfResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.InitialMetrics) > 0 {
+		for _, e := range m.InitialMetrics {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if len(m.BaselineMetrics) > 0 {
+		for _, e := range m.BaselineMetrics {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if len(m.FinalMetrics) > 0 {
+		for _, e := range m.FinalMetrics {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.GasUsed != 0 {
+		n += 1 + sovQuery(uint64(m.GasUsed))
+	}
+	if m.FinalHeight != 0 {
+		n += 1 + sovQuery(uint64(m.FinalHeight))
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.FinalTime)
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *Metric) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Value)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func sovQuery(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozQuery(x uint64) (n int) {
+	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *WhatIfRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: WhatIfRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: WhatIfRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Messages", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Messages = append(m.Messages, &types.Any{})
+			if err := m.Messages[len(m.Messages)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Blocks", wireType)
+			}
+			m.Blocks = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Blocks |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdDurationUnmarshal(&m.BlockTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *WhatIfResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: WhatIfResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: WhatIfResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InitialMetrics", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.InitialMetrics = append(m.InitialMetrics, Metric{})
+			if err := m.InitialMetrics[len(m.InitialMetrics)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BaselineMetrics", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BaselineMetrics = append(m.BaselineMetrics, Metric{})
+			if err := m.BaselineMetrics[len(m.BaselineMetrics)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FinalMetrics", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FinalMetrics = append(m.FinalMetrics, Metric{})
+			if err := m.FinalMetrics[len(m.FinalMetrics)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GasUsed", wireType)
+			}
+			m.GasUsed = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.GasUsed |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FinalHeight", wireType)
+			}
+			m.FinalHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.FinalHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FinalTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.FinalTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Metric) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Metric: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Metric: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Value = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipQuery(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthQuery
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupQuery
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthQuery
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthQuery        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowQuery          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupQuery = fmt.Errorf("proto: unexpected end of group")
+)
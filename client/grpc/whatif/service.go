@@ -0,0 +1,138 @@
+package whatif
+
+import (
+	context "context"
+	"time"
+
+	gogogrpc "github.com/cosmos/gogoproto/grpc"
+	"github.com/grpc-ecosystem/grpc-gateway/runtime"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	"github.com/cosmos/cosmos-sdk/server/config"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdktx "github.com/cosmos/cosmos-sdk/types/tx"
+)
+
+// DefaultBlockTime is the duration between two simulated blocks when a request
+// does not specify it.
+const DefaultBlockTime = 5 * time.Second
+
+// Runner executes msgs against an in-memory fork of the state of ctx and
+// fast-forwards the fork by the given number of blocks, as BaseApp.WhatIf
+// does. It returns the context of the fork after the last simulated block and
+// the gas consumed by the messages.
+type Runner func(ctx sdk.Context, msgs []sdk.Msg, blocks uint64, blockTime time.Duration) (sdk.Context, uint64, error)
+
+// MetricsFn measures the metrics reported by the service on the state of ctx,
+// e.g. the inflation, the bonded ratio or the balances of the module accounts.
+type MetricsFn func(ctx sdk.Context) ([]Metric, error)
+
+// RegisterWhatIfService registers the what-if gRPC service on the provided
+// gRPC router, if it is enabled by the configuration.
+func RegisterWhatIfService(server gogogrpc.Server, cfg config.WhatIfConfig, runner Runner, metrics MetricsFn) {
+	if !cfg.Enable {
+		return
+	}
+
+	RegisterServiceServer(server, NewQueryServer(cfg.MaxBlocks, runner, metrics))
+}
+
+// RegisterGRPCGatewayRoutes mounts the what-if gRPC service's GRPC-gateway
+// routes on the given mux object.
+func RegisterGRPCGatewayRoutes(clientConn gogogrpc.ClientConn, mux *runtime.ServeMux) {
+	_ = RegisterServiceHandlerClient(context.Background(), mux, NewServiceClient(clientConn))
+}
+
+var _ ServiceServer = queryServer{}
+
+type queryServer struct {
+	maxBlocks uint64
+	runner    Runner
+	metrics   MetricsFn
+}
+
+// NewQueryServer returns a new what-if ServiceServer simulating at most
+// maxBlocks blocks per request.
+func NewQueryServer(maxBlocks uint64, runner Runner, metrics MetricsFn) ServiceServer {
+	return queryServer{
+		maxBlocks: maxBlocks,
+		runner:    runner,
+		metrics:   metrics,
+	}
+}
+
+// WhatIf implements the ServiceServer.WhatIf method. The messages are compared
+// with a baseline fork, fast-forwarded by the same number of blocks without
+// the messages, so that the metrics changing over time, e.g. the supply, can be
+// told apart from the effects of the messages.
+func (s queryServer) WhatIf(ctx context.Context, req *WhatIfRequest) (*WhatIfResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	if req.Blocks > s.maxBlocks {
+		return nil, status.Errorf(codes.InvalidArgument, "cannot simulate more than %d blocks", s.maxBlocks)
+	}
+
+	blockTime := req.BlockTime
+	switch {
+	case blockTime < 0:
+		return nil, status.Error(codes.InvalidArgument, "block time cannot be negative")
+	case blockTime == 0:
+		blockTime = DefaultBlockTime
+	}
+
+	msgs, err := req.GetMsgs()
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	initial, err := s.metrics(sdkCtx)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	baselineCtx, _, err := s.runner(sdkCtx, nil, req.Blocks, blockTime)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	baseline, err := s.metrics(baselineCtx)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	finalCtx, gasUsed, err := s.runner(sdkCtx, msgs, req.Blocks, blockTime)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	final, err := s.metrics(finalCtx)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &WhatIfResponse{
+		InitialMetrics:  initial,
+		BaselineMetrics: baseline,
+		FinalMetrics:    final,
+		GasUsed:         gasUsed,
+		FinalHeight:     finalCtx.BlockHeight(),
+		FinalTime:       finalCtx.BlockTime(),
+	}, nil
+}
+
+var _ codectypes.UnpackInterfacesMessage = &WhatIfRequest{}
+
+// GetMsgs unpacks the messages of the request.
+func (m *WhatIfRequest) GetMsgs() ([]sdk.Msg, error) {
+	return sdktx.GetMsgs(m.Messages, "sdk.Msg")
+}
+
+// UnpackInterfaces implements UnpackInterfacesMessage.UnpackInterfaces
+func (m WhatIfRequest) UnpackInterfaces(unpacker codectypes.AnyUnpacker) error {
+	return sdktx.UnpackInterfaces(unpacker, m.Messages)
+}
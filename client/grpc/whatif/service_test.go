@@ -0,0 +1,43 @@
+package whatif
+
+import (
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func TestServiceServer_WhatIf(t *testing.T) {
+	var blockTimes []time.Duration
+	runner := func(ctx sdk.Context, msgs []sdk.Msg, blocks uint64, blockTime time.Duration) (sdk.Context, uint64, error) {
+		blockTimes = append(blockTimes, blockTime)
+		ctx = ctx.WithBlockHeight(ctx.BlockHeight() + int64(blocks)).
+			WithBlockTime(ctx.BlockTime().Add(time.Duration(blocks) * blockTime))
+		return ctx, uint64(len(msgs)) * 10, nil
+	}
+	metrics := func(ctx sdk.Context) ([]Metric, error) {
+		return []Metric{{Name: "height", Value: strconv.FormatInt(ctx.BlockHeight(), 10)}}, nil
+	}
+
+	svr := NewQueryServer(10, runner, metrics)
+	ctx := sdk.Context{}.WithBlockHeight(5).WithBlockTime(time.Unix(0, 0).UTC())
+
+	_, err := svr.WhatIf(ctx, &WhatIfRequest{Blocks: 11})
+	require.ErrorContains(t, err, "cannot simulate more than 10 blocks")
+
+	_, err = svr.WhatIf(ctx, &WhatIfRequest{Blocks: 1, BlockTime: -time.Second})
+	require.ErrorContains(t, err, "block time cannot be negative")
+
+	resp, err := svr.WhatIf(ctx, &WhatIfRequest{Blocks: 10})
+	require.NoError(t, err)
+	require.Equal(t, []time.Duration{DefaultBlockTime, DefaultBlockTime}, blockTimes)
+	require.Equal(t, int64(15), resp.FinalHeight)
+	require.Equal(t, time.Unix(50, 0).UTC(), resp.FinalTime)
+	require.Zero(t, resp.GasUsed)
+	require.Equal(t, "5", resp.InitialMetrics[0].Value)
+	require.Equal(t, "15", resp.BaselineMetrics[0].Value)
+	require.Equal(t, "15", resp.FinalMetrics[0].Value)
+}
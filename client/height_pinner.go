@@ -0,0 +1,160 @@
+package client
+
+import (
+	gocontext "context"
+	"strconv"
+	"strings"
+	"sync"
+
+	gogogrpc "github.com/cosmos/gogoproto/grpc"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+
+	grpctypes "github.com/cosmos/cosmos-sdk/types/grpc"
+)
+
+// prunedHeightErrMsgs are the error messages returned by a node when the state
+// at the queried height is no longer available.
+var prunedHeightErrMsgs = []string{
+	"failed to load state at height",
+	"version does not exist",
+}
+
+// IsPrunedHeightError returns true if the error was returned by a node because
+// the state at the queried height has been pruned.
+func IsPrunedHeightError(err error) bool {
+	if err == nil {
+		return false
+	}
+
+	for _, msg := range prunedHeightErrMsgs {
+		if strings.Contains(err.Error(), msg) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// HeightPinner pins the block height of a logical query session. The height
+// returned by the node for the first query is attached to all subsequent
+// queries, so that they all observe the same state. If the pinned height gets
+// pruned by the node, the query is retried at the latest height, which becomes
+// the new pinned height.
+//
+// A HeightPinner is safe for concurrent use.
+type HeightPinner struct {
+	mtx    sync.Mutex
+	height int64
+}
+
+// NewHeightPinner returns a HeightPinner with no pinned height.
+func NewHeightPinner() *HeightPinner {
+	return &HeightPinner{}
+}
+
+// Height returns the pinned height, or 0 if no height is pinned yet.
+func (p *HeightPinner) Height() int64 {
+	p.mtx.Lock()
+	defer p.mtx.Unlock()
+
+	return p.height
+}
+
+// Reset unpins the height, starting a new session on the next query.
+func (p *HeightPinner) Reset() {
+	p.mtx.Lock()
+	defer p.mtx.Unlock()
+
+	p.height = 0
+}
+
+// UnaryClientInterceptor returns a gRPC interceptor pinning the height of the
+// queries made through a grpc.ClientConn.
+func (p *HeightPinner) UnaryClientInterceptor() grpc.UnaryClientInterceptor {
+	return func(ctx gocontext.Context, method string, req, reply interface{}, cc *grpc.ClientConn, invoker grpc.UnaryInvoker, opts ...grpc.CallOption) error {
+		return p.invoke(ctx, func(ctx gocontext.Context, opts ...grpc.CallOption) error {
+			return invoker(ctx, method, req, reply, cc, opts...)
+		}, opts...)
+	}
+}
+
+// ClientConn wraps a gRPC client connection, e.g. a client.Context, so that
+// the height of the queries made through it is pinned.
+func (p *HeightPinner) ClientConn(conn gogogrpc.ClientConn) gogogrpc.ClientConn {
+	return pinnedClientConn{ClientConn: conn, pinner: p}
+}
+
+type pinnedClientConn struct {
+	gogogrpc.ClientConn
+	pinner *HeightPinner
+}
+
+// Invoke implements the grpc ClientConn.Invoke method
+func (c pinnedClientConn) Invoke(ctx gocontext.Context, method string, req, reply interface{}, opts ...grpc.CallOption) error {
+	return c.pinner.invoke(ctx, func(ctx gocontext.Context, opts ...grpc.CallOption) error {
+		return c.ClientConn.Invoke(ctx, method, req, reply, opts...)
+	}, opts...)
+}
+
+func (p *HeightPinner) invoke(
+	ctx gocontext.Context,
+	invoke func(gocontext.Context, ...grpc.CallOption) error,
+	opts ...grpc.CallOption,
+) error {
+	// a height explicitly set by the caller takes precedence over the pinned one
+	md, _ := metadata.FromOutgoingContext(ctx)
+	if len(md.Get(grpctypes.GRPCBlockHeightHeader)) > 0 {
+		return invoke(ctx, opts...)
+	}
+
+	height := p.Height()
+	err := p.invokeAtHeight(ctx, height, invoke, opts...)
+	if height != 0 && IsPrunedHeightError(err) {
+		// the pinned height is gone, re-pin at the latest height
+		p.Reset()
+		err = p.invokeAtHeight(ctx, 0, invoke, opts...)
+	}
+
+	return err
+}
+
+func (p *HeightPinner) invokeAtHeight(
+	ctx gocontext.Context,
+	height int64,
+	invoke func(gocontext.Context, ...grpc.CallOption) error,
+	opts ...grpc.CallOption,
+) error {
+	if height != 0 {
+		ctx = metadata.AppendToOutgoingContext(ctx, grpctypes.GRPCBlockHeightHeader, strconv.FormatInt(height, 10))
+	}
+
+	var header metadata.MD
+	if err := invoke(ctx, append(opts[:len(opts):len(opts)], grpc.Header(&header))...); err != nil {
+		return err
+	}
+
+	if height != 0 {
+		return nil
+	}
+
+	// pin the height the node answered at, unless another query of the
+	// session already did
+	heights := header.Get(grpctypes.GRPCBlockHeightHeader)
+	if len(heights) == 0 {
+		return nil
+	}
+
+	respHeight, err := strconv.ParseInt(heights[0], 10, 64)
+	if err != nil || respHeight <= 0 {
+		return nil
+	}
+
+	p.mtx.Lock()
+	defer p.mtx.Unlock()
+	if p.height == 0 {
+		p.height = respHeight
+	}
+
+	return nil
+}
@@ -0,0 +1,87 @@
+package client_test
+
+import (
+	"context"
+	"errors"
+	"strconv"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	grpctypes "github.com/cosmos/cosmos-sdk/types/grpc"
+)
+
+// heightConn is a client connection answering at the requested height, or at
+// the latest height when none is requested.
+type heightConn struct {
+	latest    int64
+	pruned    int64
+	requested []int64
+}
+
+func (c *heightConn) Invoke(ctx context.Context, _ string, _, _ interface{}, opts ...grpc.CallOption) error {
+	height := c.latest
+	md, _ := metadata.FromOutgoingContext(ctx)
+	if heights := md.Get(grpctypes.GRPCBlockHeightHeader); len(heights) > 0 {
+		height, _ = strconv.ParseInt(heights[0], 10, 64)
+	}
+	c.requested = append(c.requested, height)
+
+	if height <= c.pruned {
+		return errors.New("failed to load state at height " + strconv.FormatInt(height, 10))
+	}
+
+	for _, opt := range opts {
+		if header, ok := opt.(grpc.HeaderCallOption); ok {
+			*header.HeaderAddr = metadata.Pairs(grpctypes.GRPCBlockHeightHeader, strconv.FormatInt(height, 10))
+		}
+	}
+
+	return nil
+}
+
+func (c *heightConn) NewStream(context.Context, *grpc.StreamDesc, string, ...grpc.CallOption) (grpc.ClientStream, error) {
+	return nil, errors.New("not supported")
+}
+
+func TestHeightPinner(t *testing.T) {
+	conn := &heightConn{latest: 10}
+	pinner := client.NewHeightPinner()
+	pinnedConn := pinner.ClientConn(conn)
+	ctx := context.Background()
+
+	// the first query pins the height
+	require.NoError(t, pinnedConn.Invoke(ctx, "/test", nil, nil))
+	require.Equal(t, int64(10), pinner.Height())
+
+	// the following queries are made at the pinned height
+	conn.latest = 12
+	require.NoError(t, pinnedConn.Invoke(ctx, "/test", nil, nil))
+	require.Equal(t, []int64{10, 10}, conn.requested)
+
+	// an explicit height is left untouched
+	require.NoError(t, pinnedConn.Invoke(metadata.AppendToOutgoingContext(ctx, grpctypes.GRPCBlockHeightHeader, "11"), "/test", nil, nil))
+	require.Equal(t, []int64{10, 10, 11}, conn.requested)
+	require.Equal(t, int64(10), pinner.Height())
+
+	// the height is re-pinned once pruned
+	conn.pruned = 10
+	require.NoError(t, pinnedConn.Invoke(ctx, "/test", nil, nil))
+	require.Equal(t, []int64{10, 10, 11, 10, 12}, conn.requested)
+	require.Equal(t, int64(12), pinner.Height())
+
+	// a new session pins the latest height
+	conn.latest = 13
+	pinner.Reset()
+	require.NoError(t, pinnedConn.Invoke(ctx, "/test", nil, nil))
+	require.Equal(t, int64(13), pinner.Height())
+}
+
+func TestIsPrunedHeightError(t *testing.T) {
+	require.False(t, client.IsPrunedHeightError(nil))
+	require.False(t, client.IsPrunedHeightError(errors.New("cannot query with height in the future")))
+	require.True(t, client.IsPrunedHeightError(errors.New("rpc error: code = InvalidArgument desc = failed to load state at height 10; version does not exist (latest height: 12)")))
+}
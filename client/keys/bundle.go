@@ -0,0 +1,95 @@
+package keys
+
+import (
+	"bufio"
+	"fmt"
+	"os"
+
+	"github.com/spf13/cobra"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/input"
+)
+
+const flagAll = "all"
+
+// ExportKeyBundleCommand exports several keys from the key store into a single
+// encrypted bundle.
+func ExportKeyBundleCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "export-bundle [name...]",
+		Short: "Export keys along with their metadata into an encrypted bundle",
+		Long: `Export keys from the local keyring, along with their names, algorithms and HD
+paths when recorded, into a single ASCII-armored bundle encrypted with a passphrase.
+The bundle can be imported into a keyring of another backend with import-bundle.
+
+The --all flag exports all the keys of the keyring.`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			buf := bufio.NewReader(clientCtx.Input)
+
+			all, _ := cmd.Flags().GetBool(flagAll)
+			if all == (len(args) > 0) {
+				return fmt.Errorf("either key names or the --%s flag must be provided", flagAll)
+			}
+
+			encryptPassword, err := input.GetPassword("Enter passphrase to encrypt the exported bundle:", buf)
+			if err != nil {
+				return err
+			}
+
+			armored, err := clientCtx.Keyring.ExportKeyBundle(args, encryptPassword)
+			if err != nil {
+				return err
+			}
+
+			cmd.Println(armored)
+
+			return nil
+		},
+	}
+
+	cmd.Flags().Bool(flagAll, false, "Export all the keys of the keyring")
+
+	return cmd
+}
+
+// ImportKeyBundleCommand imports the keys of an encrypted bundle into the key
+// store.
+func ImportKeyBundleCommand() *cobra.Command {
+	return &cobra.Command{
+		Use:   "import-bundle <bundle-file>",
+		Short: "Import the keys of an encrypted bundle into the local keybase",
+		Long: `Import the keys of a bundle created with export-bundle into the local keybase,
+preserving their names and metadata. No key is imported if any of them has the name
+or the address of an existing key.`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			buf := bufio.NewReader(clientCtx.Input)
+
+			bz, err := os.ReadFile(args[0])
+			if err != nil {
+				return err
+			}
+
+			passphrase, err := input.GetPassword("Enter passphrase to decrypt the bundle:", buf)
+			if err != nil {
+				return err
+			}
+
+			records, err := clientCtx.Keyring.ImportKeyBundle(string(bz), passphrase)
+			if err != nil {
+				return err
+			}
+
+			return printKeyringRecords(cmd.OutOrStdout(), records, clientCtx.OutputFormat)
+		},
+	}
+}
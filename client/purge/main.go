@@ -0,0 +1,100 @@
+package purge
+
+import (
+	"errors"
+	"fmt"
+	"path/filepath"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+
+	dbm "github.com/cosmos/cosmos-db"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/server"
+	"github.com/cosmos/cosmos-sdk/types/retention"
+	authtx "github.com/cosmos/cosmos-sdk/x/auth/tx"
+)
+
+const (
+	FlagAppDBBackend = "app-db-backend"
+	FlagMode         = "mode"
+	FlagMaxHeight    = "max-height"
+	FlagList         = "list"
+)
+
+// Cmd purges the purgeable data registered by the modules, e.g. the memos of
+// the txs, from the node-local tx index.
+func Cmd(registry *retention.Registry) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "purge-data [category...]",
+		Short: "Purge the purgeable data of the given categories from the node-local indexes",
+		Long: `Purge the purgeable data of the given categories, e.g. the memos of the txs or the
+metadata of the governance proposals, from the node-local tx index. The txs are kept
+and remain searchable, with their purged data replaced by its sha256 hash, or by an
+empty string with '--mode delete'. The consensus state and the blocks stored by
+CometBFT, including its own tx index, are never modified.
+
+The node must be stopped. The registered categories are listed with '--list'.
+`,
+		Example: "purge-data auth/memo gov/vote-metadata --home './' --max-height 100000",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			vp := viper.New()
+			if err := vp.BindPFlags(cmd.Flags()); err != nil {
+				return err
+			}
+
+			if vp.GetBool(FlagList) {
+				for _, c := range registry.Categories() {
+					fmt.Fprintf(cmd.OutOrStdout(), "%s: %s\n", c.Name, c.Description)
+				}
+				return nil
+			}
+
+			if len(args) == 0 {
+				return errors.New("no category to purge, the registered categories are listed with --list")
+			}
+
+			categories, err := registry.Resolve(args...)
+			if err != nil {
+				return err
+			}
+
+			mode, err := retention.ParseMode(vp.GetString(FlagMode))
+			if err != nil {
+				return err
+			}
+
+			clientCtx := client.GetClientContextFromCmd(cmd)
+			if clientCtx.InterfaceRegistry == nil {
+				return errors.New("the client context has no interface registry")
+			}
+
+			dataDir := filepath.Join(vp.GetString(flags.FlagHome), "data")
+			db, err := dbm.NewDB(authtx.TxIndexDBName, server.GetAppDBBackend(vp), dataDir)
+			if err != nil {
+				return err
+			}
+			defer db.Close()
+
+			// the tx decoder is only used to index the delivered txs
+			indexer := authtx.NewTxIndexer(db, nil)
+			purged, err := indexer.Purge(clientCtx.InterfaceRegistry, categories, mode, vp.GetInt64(FlagMaxHeight))
+			if err != nil {
+				return fmt.Errorf("failed to purge the tx index after %d txs: %w", purged, err)
+			}
+
+			fmt.Fprintf(cmd.OutOrStdout(), "purged %d txs of the tx index\n", purged)
+			return nil
+		},
+	}
+
+	cmd.Flags().String(flags.FlagHome, "", "The node home directory")
+	cmd.Flags().String(FlagAppDBBackend, "", "The type of database of the node-local indexes")
+	cmd.Flags().String(FlagMode, retention.ModeHash.String(), "How the data is purged (hash|delete)")
+	cmd.Flags().Int64(FlagMaxHeight, 0, "The height up to which the txs are purged, all the txs if 0")
+	cmd.Flags().Bool(FlagList, false, "List the registered categories of purgeable data")
+
+	return cmd
+}
@@ -0,0 +1,130 @@
+package keyring
+
+import (
+	errorsmod "cosmossdk.io/errors"
+
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	"github.com/cosmos/cosmos-sdk/crypto"
+)
+
+// ExportKeyBundle exports the keys with the given names, or all the keys if no
+// name is given, along with their metadata, in a single ASCII armored bundle
+// encrypted with the passphrase.
+func (ks keystore) ExportKeyBundle(uids []string, encryptPassphrase string) (armor string, err error) {
+	var records []*Record
+	if len(uids) == 0 {
+		if records, err = ks.List(); err != nil {
+			return "", err
+		}
+	}
+
+	for _, uid := range uids {
+		k, err := ks.Key(uid)
+		if err != nil {
+			return "", err
+		}
+
+		records = append(records, k)
+	}
+
+	bundle := RecordBundle{Entries: make([]*RecordBundle_Entry, 0, len(records))}
+	for _, k := range records {
+		entry, err := newRecordBundleEntry(k)
+		if err != nil {
+			return "", err
+		}
+
+		bundle.Entries = append(bundle.Entries, entry)
+	}
+
+	bz, err := ks.cdc.Marshal(&bundle)
+	if err != nil {
+		return "", err
+	}
+
+	return crypto.EncryptArmorKeyBundle(bz, encryptPassphrase), nil
+}
+
+// ImportKeyBundle imports the keys of a bundle exported by ExportKeyBundle and
+// returns their records. No key is imported if any of them has the name or the
+// address of an existing key, or uses an unsupported signing algorithm.
+func (ks keystore) ImportKeyBundle(armor, passphrase string) ([]*Record, error) {
+	bz, err := crypto.UnarmorDecryptKeyBundle(armor, passphrase)
+	if err != nil {
+		return nil, errorsmod.Wrap(err, "failed to decrypt key bundle")
+	}
+
+	var bundle RecordBundle
+	if err := ks.cdc.Unmarshal(bz, &bundle); err != nil {
+		return nil, err
+	}
+
+	names := make(map[string]bool, len(bundle.Entries))
+	addrs := make(map[string]bool, len(bundle.Entries))
+	records := make([]*Record, 0, len(bundle.Entries))
+	for _, entry := range bundle.Entries {
+		k := entry.Record
+		if k == nil {
+			return nil, errorsmod.Wrap(ErrInvalidKeyBundle, "empty bundle entry")
+		}
+
+		addr, err := k.GetAddress()
+		if err != nil {
+			return nil, err
+		}
+
+		if _, err := ks.Key(k.Name); err == nil || names[k.Name] {
+			return nil, errorsmod.Wrap(ErrOverwriteKey, k.Name)
+		}
+		if _, err := ks.KeyByAddress(addr); err == nil || addrs[addr.String()] {
+			return nil, errorsmod.Wrap(ErrDuplicatedAddress, addr.String())
+		}
+
+		if k.GetLocal() != nil {
+			if _, err := NewSigningAlgoFromString(entry.Algo, ks.options.SupportedAlgos); err != nil {
+				return nil, err
+			}
+		}
+
+		names[k.Name], addrs[addr.String()] = true, true
+		records = append(records, k)
+	}
+
+	for _, k := range records {
+		if err := ks.writeRecord(k); err != nil {
+			return nil, err
+		}
+	}
+
+	return records, nil
+}
+
+// newRecordBundleEntry returns the bundle entry of a record.
+func newRecordBundleEntry(k *Record) (*RecordBundle_Entry, error) {
+	pk, err := k.GetPubKey()
+	if err != nil {
+		return nil, err
+	}
+
+	entry := &RecordBundle_Entry{Record: k, Algo: pk.Type()}
+	if l := k.GetLedger(); l != nil && l.Path != nil {
+		entry.HdPath = l.Path.String()
+	}
+
+	return entry, nil
+}
+
+// UnpackInterfaces implements UnpackInterfacesMessage.UnpackInterfaces
+func (b *RecordBundle) UnpackInterfaces(unpacker codectypes.AnyUnpacker) error {
+	for _, entry := range b.Entries {
+		if entry.Record == nil {
+			continue
+		}
+
+		if err := entry.Record.UnpackInterfaces(unpacker); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
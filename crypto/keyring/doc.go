@@ -37,4 +37,10 @@
 //		be unlocked and it should be used only for testing purposes.
 //	memory	Same instance as returned by NewInMemory. This backend uses a transient storage. Keys
 //		are discarded when the process terminates or the type instance is garbage collected.
+//
+// # Key bundles
+//
+// ExportKeyBundle exports several keys, along with their names, algorithms and HD paths when
+// recorded, into a single passphrase-encrypted bundle, which ImportKeyBundle imports into a
+// keyring of any backend. Bundles enable migrating keys between backends, e.g. from os to file.
 package keyring
@@ -35,4 +35,6 @@ var (
 	ErrLegacyToRecord = errors.New("unable to convert LegacyInfo to Record")
 	// ErrUnknownLegacyType is raised when a LegacyInfo type is unknown.
 	ErrUnknownLegacyType = errors.New("unknown LegacyInfo type")
+	// ErrInvalidKeyBundle is raised when a key bundle cannot be imported.
+	ErrInvalidKeyBundle = errors.New("invalid key bundle")
 )
@@ -120,6 +120,10 @@ type Importer interface {
 
 	// ImportPubKey imports ASCII armored public keys.
 	ImportPubKey(uid, armor string) error
+
+	// ImportKeyBundle imports the keys of an ASCII armored passphrase-encrypted
+	// bundle, and returns their records.
+	ImportKeyBundle(armor, passphrase string) ([]*Record, error)
 }
 
 // Migrator is implemented by key stores and enables migration of keys from amino to proto
@@ -137,6 +141,11 @@ type Exporter interface {
 	// It returns an error if the key does not exist or a wrong encryption passphrase is supplied.
 	ExportPrivKeyArmor(uid, encryptPassphrase string) (armor string, err error)
 	ExportPrivKeyArmorByAddress(address sdk.Address, encryptPassphrase string) (armor string, err error)
+
+	// ExportKeyBundle returns the keys with the given names, or all the keys if
+	// no name is given, along with their metadata, in a single ASCII armored
+	// passphrase-encrypted bundle.
+	ExportKeyBundle(uids []string, encryptPassphrase string) (armor string, err error)
 }
 
 // Option overrides keyring configuration options.
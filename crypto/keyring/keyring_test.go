@@ -2017,3 +2017,59 @@ func assertKeysExist(t *testing.T, kr Keyring, names ...string) {
 }
 
 func accAddr(k *Record) (sdk.AccAddress, error) { return k.GetAddress() }
+
+func TestExportImportKeyBundle(t *testing.T) {
+	cdc := getCodec()
+
+	src, err := New("TestExportBundle", BackendTest, t.TempDir(), nil, cdc)
+	require.NoError(t, err)
+	local, _, err := src.NewMnemonic("local", English, sdk.FullFundraiserPath, DefaultBIP39Passphrase, hd.Secp256k1)
+	require.NoError(t, err)
+	offline, err := src.SaveOfflineKey("offline", ed25519.GenPrivKey().PubKey())
+	require.NoError(t, err)
+	ledgerPath := hd.NewFundraiserParams(1, sdk.CoinType, 2)
+	_, err = src.(keystore).writeLedgerKey("ledger", secp256k1.GenPrivKey().PubKey(), ledgerPath)
+	require.NoError(t, err)
+
+	armor, err := src.ExportKeyBundle(nil, "apassphrase")
+	require.NoError(t, err)
+
+	dst := NewInMemory(cdc)
+	_, err = dst.ImportKeyBundle(armor, "wrongpassphrase")
+	require.ErrorIs(t, err, sdkerrors.ErrWrongPassword)
+
+	records, err := dst.ImportKeyBundle(armor, "apassphrase")
+	require.NoError(t, err)
+	require.Len(t, records, 3)
+
+	for _, expected := range []*Record{local, offline} {
+		imported, err := dst.Key(expected.Name)
+		require.NoError(t, err)
+		require.Equal(t, expected.GetType(), imported.GetType())
+		expectedAddr, err := expected.GetAddress()
+		require.NoError(t, err)
+		importedAddr, err := imported.GetAddress()
+		require.NoError(t, err)
+		require.Equal(t, expectedAddr, importedAddr)
+	}
+
+	expectedPriv, err := src.(keystore).ExportPrivateKeyObject("local")
+	require.NoError(t, err)
+	importedPriv, err := dst.(keystore).ExportPrivateKeyObject("local")
+	require.NoError(t, err)
+	require.True(t, expectedPriv.Equals(importedPriv))
+
+	imported, err := dst.Key("ledger")
+	require.NoError(t, err)
+	require.Equal(t, ledgerPath, imported.GetLedger().GetPath())
+
+	// a bundle conflicting with existing keys is not imported
+	armor, err = src.ExportKeyBundle([]string{"local"}, "apassphrase")
+	require.NoError(t, err)
+	require.NoError(t, dst.Delete("offline"))
+	_, err = dst.ImportKeyBundle(armor, "apassphrase")
+	require.ErrorIs(t, err, ErrOverwriteKey)
+
+	_, err = src.ExportKeyBundle([]string{"missing"}, "apassphrase")
+	require.Error(t, err)
+}
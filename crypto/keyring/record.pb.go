@@ -294,12 +294,100 @@ func (m *Record_Offline) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_Record_Offline proto.InternalMessageInfo
 
+// RecordBundle is a set of keyring records exported together, along with
+// their metadata, to be imported into another keyring.
+//
+// Since: cosmos-sdk 0.48
+type RecordBundle struct {
+	// entries are the bundled records.
+	Entries []*RecordBundle_Entry `protobuf:"bytes,1,rep,name=entries,proto3" json:"entries,omitempty"`
+}
+
+func (m *RecordBundle) Reset()         { *m = RecordBundle{} }
+func (m *RecordBundle) String() string { return proto.CompactTextString(m) }
+func (*RecordBundle) ProtoMessage()    {}
+func (*RecordBundle) Descriptor() ([]byte, []int) {
+	return fileDescriptor_36d640103edea005, []int{1}
+}
+func (m *RecordBundle) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RecordBundle) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RecordBundle.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *RecordBundle) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RecordBundle.Merge(m, src)
+}
+func (m *RecordBundle) XXX_Size() int {
+	return m.Size()
+}
+func (m *RecordBundle) XXX_DiscardUnknown() {
+	xxx_messageInfo_RecordBundle.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RecordBundle proto.InternalMessageInfo
+
+// Entry is a bundled record.
+type RecordBundle_Entry struct {
+	// record is the keyring record, including the private key of local keys.
+	Record *Record `protobuf:"bytes,1,opt,name=record,proto3" json:"record,omitempty"`
+	// algo is the name of the signing algorithm of the key.
+	Algo string `protobuf:"bytes,2,opt,name=algo,proto3" json:"algo,omitempty"`
+	// hd_path is the HD derivation path of the key, when recorded in the
+	// keyring.
+	HdPath string `protobuf:"bytes,3,opt,name=hd_path,json=hdPath,proto3" json:"hd_path,omitempty"`
+}
+
+func (m *RecordBundle_Entry) Reset()         { *m = RecordBundle_Entry{} }
+func (m *RecordBundle_Entry) String() string { return proto.CompactTextString(m) }
+func (*RecordBundle_Entry) ProtoMessage()    {}
+func (*RecordBundle_Entry) Descriptor() ([]byte, []int) {
+	return fileDescriptor_36d640103edea005, []int{1, 0}
+}
+func (m *RecordBundle_Entry) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RecordBundle_Entry) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RecordBundle_Entry.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *RecordBundle_Entry) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RecordBundle_Entry.Merge(m, src)
+}
+func (m *RecordBundle_Entry) XXX_Size() int {
+	return m.Size()
+}
+func (m *RecordBundle_Entry) XXX_DiscardUnknown() {
+	xxx_messageInfo_RecordBundle_Entry.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RecordBundle_Entry proto.InternalMessageInfo
+
 func init() {
 	proto.RegisterType((*Record)(nil), "cosmos.crypto.keyring.v1.Record")
 	proto.RegisterType((*Record_Local)(nil), "cosmos.crypto.keyring.v1.Record.Local")
 	proto.RegisterType((*Record_Ledger)(nil), "cosmos.crypto.keyring.v1.Record.Ledger")
 	proto.RegisterType((*Record_Multi)(nil), "cosmos.crypto.keyring.v1.Record.Multi")
 	proto.RegisterType((*Record_Offline)(nil), "cosmos.crypto.keyring.v1.Record.Offline")
+	proto.RegisterType((*RecordBundle)(nil), "cosmos.crypto.keyring.v1.RecordBundle")
+	proto.RegisterType((*RecordBundle_Entry)(nil), "cosmos.crypto.keyring.v1.RecordBundle.Entry")
 }
 
 func init() {
@@ -307,33 +395,38 @@ func init() {
 }
 
 var fileDescriptor_36d640103edea005 = []byte{
-	// 411 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x92, 0xbd, 0xae, 0xd3, 0x30,
-	0x1c, 0xc5, 0x1d, 0x6e, 0x3e, 0xb8, 0x66, 0xb3, 0xee, 0x10, 0x22, 0x64, 0x55, 0x48, 0x40, 0x25,
-	0x54, 0x5b, 0x85, 0x0e, 0x4c, 0x95, 0x5a, 0x31, 0x14, 0x95, 0x8a, 0x2a, 0x23, 0x0b, 0xca, 0x87,
-	0x9b, 0x44, 0x4d, 0xe2, 0xc8, 0x49, 0x2a, 0xe5, 0x2d, 0x18, 0x79, 0xa4, 0x8e, 0x1d, 0x19, 0xa1,
-	0xd9, 0x78, 0x0a, 0x64, 0x3b, 0x1d, 0xa8, 0x04, 0x65, 0x8a, 0x23, 0xff, 0xce, 0xff, 0x9c, 0x63,
-	0xfd, 0xe1, 0x8b, 0x88, 0xd7, 0x05, 0xaf, 0x69, 0x24, 0xba, 0xaa, 0xe1, 0x74, 0xcf, 0x3a, 0x91,
-	0x95, 0x09, 0x3d, 0x4c, 0xa9, 0x60, 0x11, 0x17, 0x31, 0xa9, 0x04, 0x6f, 0x38, 0x72, 0x35, 0x46,
-	0x34, 0x46, 0x06, 0x8c, 0x1c, 0xa6, 0xde, 0x43, 0xc2, 0x13, 0xae, 0x20, 0x2a, 0x4f, 0x9a, 0xf7,
-	0x9e, 0x26, 0x9c, 0x27, 0x39, 0xa3, 0xea, 0x2f, 0x6c, 0x77, 0x34, 0x28, 0xbb, 0xe1, 0xea, 0xd9,
-	0x9f, 0x8e, 0x69, 0x2c, 0xcd, 0xd2, 0xc1, 0xe8, 0xf9, 0xaf, 0x3b, 0x68, 0xfb, 0xca, 0x19, 0x21,
-	0x68, 0x96, 0x41, 0xc1, 0x5c, 0x63, 0x64, 0x8c, 0xef, 0x7d, 0x75, 0x46, 0x13, 0xe8, 0x54, 0x6d,
-	0xf8, 0x65, 0xcf, 0x3a, 0xf7, 0xd1, 0xc8, 0x18, 0x3f, 0x79, 0xf3, 0x40, 0xb4, 0x13, 0xb9, 0x38,
-	0x91, 0x45, 0xd9, 0xf9, 0x76, 0xd5, 0x86, 0x6b, 0xd6, 0xa1, 0x39, 0xb4, 0x72, 0x1e, 0x05, 0xb9,
-	0x7b, 0xa7, 0xe0, 0x97, 0xe4, 0x6f, 0x35, 0x88, 0xf6, 0x24, 0x1f, 0x25, 0xbd, 0x02, 0xbe, 0x96,
-	0xa1, 0x05, 0xb4, 0x73, 0x16, 0x27, 0x4c, 0xb8, 0xa6, 0x1a, 0xf0, 0xea, 0xf6, 0x00, 0x85, 0xaf,
-	0x80, 0x3f, 0x08, 0x65, 0x84, 0xa2, 0xcd, 0x9b, 0xcc, 0xb5, 0xfe, 0x33, 0xc2, 0x46, 0xd2, 0x32,
-	0x82, 0x92, 0xa1, 0xf7, 0xd0, 0xe1, 0xbb, 0x5d, 0x9e, 0x95, 0xcc, 0xb5, 0xd5, 0x84, 0xf1, 0xcd,
-	0x09, 0x9f, 0x34, 0xbf, 0x02, 0xfe, 0x45, 0xea, 0xbd, 0x83, 0x96, 0xaa, 0x86, 0x28, 0x7c, 0x5c,
-	0x89, 0xec, 0xa0, 0x5e, 0xd0, 0xf8, 0xc7, 0x0b, 0x3a, 0x92, 0x5a, 0xb3, 0xce, 0x9b, 0x43, 0x5b,
-	0x77, 0x42, 0x33, 0x68, 0x56, 0x41, 0x93, 0x0e, 0xb2, 0xd1, 0x55, 0x8c, 0x34, 0x96, 0x09, 0x96,
-	0x1f, 0xb6, 0xb3, 0xd9, 0x36, 0x10, 0x41, 0x51, 0xfb, 0x8a, 0xf6, 0x1c, 0x68, 0xa9, 0x46, 0xde,
-	0x3d, 0x74, 0x86, 0x60, 0x4b, 0x1b, 0x9a, 0x59, 0xc3, 0x8a, 0xe5, 0xe6, 0xf8, 0x13, 0x83, 0xe3,
-	0x19, 0x1b, 0xa7, 0x33, 0x36, 0x7e, 0x9c, 0xb1, 0xf1, 0xb5, 0xc7, 0xe0, 0x5b, 0x8f, 0xc1, 0xa9,
-	0xc7, 0xe0, 0x7b, 0x8f, 0xc1, 0xe7, 0xd7, 0x49, 0xd6, 0xa4, 0x6d, 0x48, 0x22, 0x5e, 0xd0, 0xcb,
-	0xde, 0xa8, 0xcf, 0xa4, 0x8e, 0xf7, 0x57, 0x4b, 0x1b, 0xda, 0xaa, 0xc1, 0xdb, 0xdf, 0x01, 0x00,
-	0x00, 0xff, 0xff, 0x47, 0x24, 0x2f, 0xa9, 0xd4, 0x02, 0x00, 0x00,
+	// 493 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x93, 0x41, 0x8b, 0xd3, 0x40,
+	0x14, 0xc7, 0x13, 0xdb, 0x4e, 0xec, 0x5b, 0x4f, 0xc3, 0x82, 0x31, 0x48, 0x28, 0x0b, 0x6a, 0x41,
+	0x77, 0xc2, 0xae, 0x3d, 0xec, 0x69, 0x61, 0x8b, 0x4a, 0x65, 0x5d, 0x2c, 0x73, 0xf4, 0xb2, 0xa4,
+	0xc9, 0x34, 0x09, 0x4d, 0x32, 0x61, 0x92, 0x14, 0xf2, 0x2d, 0x3c, 0xfa, 0x89, 0x64, 0x8f, 0x7b,
+	0xf4, 0xa8, 0xed, 0xcd, 0x4f, 0x21, 0x79, 0x93, 0x0a, 0xae, 0xac, 0xf5, 0x94, 0xc9, 0xe4, 0xf7,
+	0x7f, 0xff, 0xff, 0x9b, 0xbc, 0x81, 0x67, 0x81, 0x2c, 0x33, 0x59, 0x7a, 0x81, 0x6a, 0x8a, 0x4a,
+	0x7a, 0x2b, 0xd1, 0xa8, 0x24, 0x8f, 0xbc, 0xf5, 0x89, 0xa7, 0x44, 0x20, 0x55, 0xc8, 0x0a, 0x25,
+	0x2b, 0x49, 0x6d, 0x8d, 0x31, 0x8d, 0xb1, 0x0e, 0x63, 0xeb, 0x13, 0xe7, 0x30, 0x92, 0x91, 0x44,
+	0xc8, 0x6b, 0x57, 0x9a, 0x77, 0x9e, 0x44, 0x52, 0x46, 0xa9, 0xf0, 0xf0, 0x6d, 0x51, 0x2f, 0x3d,
+	0x3f, 0x6f, 0xba, 0x4f, 0x4f, 0xff, 0x74, 0x8c, 0xc3, 0xd6, 0x2c, 0xee, 0x8c, 0x8e, 0x7e, 0xf6,
+	0x80, 0x70, 0x74, 0xa6, 0x14, 0xfa, 0xb9, 0x9f, 0x09, 0xdb, 0x1c, 0x99, 0xe3, 0x21, 0xc7, 0x35,
+	0x3d, 0x06, 0xab, 0xa8, 0x17, 0xd7, 0x2b, 0xd1, 0xd8, 0x0f, 0x46, 0xe6, 0xf8, 0xe0, 0xf4, 0x90,
+	0x69, 0x27, 0xb6, 0x73, 0x62, 0x17, 0x79, 0xc3, 0x49, 0x51, 0x2f, 0x2e, 0x45, 0x43, 0xcf, 0x61,
+	0x90, 0xca, 0xc0, 0x4f, 0xed, 0x1e, 0xc2, 0xcf, 0xd9, 0x7d, 0x6d, 0x30, 0xed, 0xc9, 0x3e, 0xb4,
+	0xf4, 0xcc, 0xe0, 0x5a, 0x46, 0x2f, 0x80, 0xa4, 0x22, 0x8c, 0x84, 0xb2, 0xfb, 0x58, 0xe0, 0xc5,
+	0xfe, 0x02, 0x88, 0xcf, 0x0c, 0xde, 0x09, 0xdb, 0x08, 0x59, 0x9d, 0x56, 0x89, 0x3d, 0xf8, 0xcf,
+	0x08, 0x57, 0x2d, 0xdd, 0x46, 0x40, 0x19, 0x7d, 0x03, 0x96, 0x5c, 0x2e, 0xd3, 0x24, 0x17, 0x36,
+	0xc1, 0x0a, 0xe3, 0xbd, 0x15, 0x3e, 0x6a, 0x7e, 0x66, 0xf0, 0x9d, 0xd4, 0x39, 0x83, 0x01, 0xb6,
+	0x46, 0x3d, 0x78, 0x58, 0xa8, 0x64, 0x8d, 0x27, 0x68, 0xfe, 0xe3, 0x04, 0xad, 0x96, 0xba, 0x14,
+	0x8d, 0x73, 0x0e, 0x44, 0xf7, 0x44, 0x27, 0xd0, 0x2f, 0xfc, 0x2a, 0xee, 0x64, 0xa3, 0x3b, 0x31,
+	0xe2, 0xb0, 0x4d, 0x30, 0x7d, 0x3f, 0x9f, 0x4c, 0xe6, 0xbe, 0xf2, 0xb3, 0x92, 0x23, 0xed, 0x58,
+	0x30, 0xc0, 0x8e, 0x9c, 0x21, 0x58, 0x5d, 0xb0, 0x29, 0x81, 0x7e, 0x52, 0x89, 0xec, 0xe8, 0xab,
+	0x09, 0x8f, 0x74, 0xe6, 0x69, 0x9d, 0x87, 0xa9, 0xa0, 0xef, 0xc0, 0x12, 0x79, 0xa5, 0x12, 0x51,
+	0xda, 0xe6, 0xa8, 0x37, 0x3e, 0x38, 0x7d, 0xb5, 0xaf, 0x59, 0x2d, 0x64, 0x6f, 0xf3, 0x4a, 0x35,
+	0x7c, 0x27, 0x76, 0x72, 0x18, 0xe0, 0x0e, 0x3d, 0x03, 0xa2, 0xe7, 0xf8, 0x9e, 0xd4, 0x7f, 0xd5,
+	0xe3, 0x44, 0xfd, 0x9e, 0x3e, 0x3f, 0x8d, 0x24, 0x8e, 0xd9, 0x90, 0xe3, 0x9a, 0x3e, 0x06, 0x2b,
+	0x0e, 0xaf, 0xf1, 0x10, 0x7a, 0xb8, 0x4d, 0xe2, 0x70, 0xee, 0x57, 0xf1, 0xf4, 0xea, 0xe6, 0x87,
+	0x6b, 0xdc, 0x6c, 0x5c, 0xf3, 0x76, 0xe3, 0x9a, 0xdf, 0x37, 0xae, 0xf9, 0x79, 0xeb, 0x1a, 0x5f,
+	0xb6, 0xae, 0x71, 0xbb, 0x75, 0x8d, 0x6f, 0x5b, 0xd7, 0xf8, 0xf4, 0x32, 0x4a, 0xaa, 0xb8, 0x5e,
+	0xb0, 0x40, 0x66, 0xde, 0xee, 0x02, 0xe0, 0xe3, 0xb8, 0x0c, 0x57, 0x77, 0x6e, 0xdf, 0x82, 0xe0,
+	0xaf, 0x78, 0xfd, 0x6b, 0x00, 0x52, 0x4f, 0x52, 0xc6, 0x9d, 0x03, 0x00, 0x00,
 }
 
 func (m *Record) Marshal() (dAtA []byte, err error) {
@@ -587,6 +680,92 @@ func (m *Record_Offline) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *RecordBundle) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RecordBundle) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RecordBundle) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Entries) > 0 {
+		for iNdEx := len(m.Entries) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Entries[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintRecord(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *RecordBundle_Entry) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RecordBundle_Entry) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RecordBundle_Entry) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.HdPath) > 0 {
+		i -= len(m.HdPath)
+		copy(dAtA[i:], m.HdPath)
+		i = encodeVarintRecord(dAtA, i, uint64(len(m.HdPath)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Algo) > 0 {
+		i -= len(m.Algo)
+		copy(dAtA[i:], m.Algo)
+		i = encodeVarintRecord(dAtA, i, uint64(len(m.Algo)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Record != nil {
+		{
+			size, err := m.Record.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintRecord(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintRecord(dAtA []byte, offset int, v uint64) int {
 	offset -= sovRecord(v)
 	base := offset
@@ -710,6 +889,42 @@ func (m *Record_Offline) Size() (n int) {
 	return n
 }
 
+func (m *RecordBundle) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Entries) > 0 {
+		for _, e := range m.Entries {
+			l = e.Size()
+			n += 1 + l + sovRecord(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *RecordBundle_Entry) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Record != nil {
+		l = m.Record.Size()
+		n += 1 + l + sovRecord(uint64(l))
+	}
+	l = len(m.Algo)
+	if l > 0 {
+		n += 1 + l + sovRecord(uint64(l))
+	}
+	l = len(m.HdPath)
+	if l > 0 {
+		n += 1 + l + sovRecord(uint64(l))
+	}
+	return n
+}
+
 func sovRecord(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -1246,6 +1461,240 @@ func (m *Record_Offline) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *RecordBundle) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRecord
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RecordBundle: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RecordBundle: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Entries", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRecord
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRecord
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRecord
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Entries = append(m.Entries, &RecordBundle_Entry{})
+			if err := m.Entries[len(m.Entries)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRecord(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthRecord
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RecordBundle_Entry) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRecord
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Entry: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Entry: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Record", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRecord
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRecord
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRecord
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Record == nil {
+				m.Record = &Record{}
+			}
+			if err := m.Record.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Algo", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRecord
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRecord
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRecord
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Algo = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HdPath", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRecord
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRecord
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRecord
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.HdPath = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRecord(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthRecord
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipRecord(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
@@ -42,6 +42,8 @@ type App struct {
 	configurator      module.Configurator
 	config            *runtimev1alpha1.Module
 	storeKeys         []storetypes.StoreKey
+	storeKeyHolders   []StoreKeyHolder
+	storeAudit        *storeAudit
 	interfaceRegistry codectypes.InterfaceRegistry
 	cdc               codec.Codec
 	amino             *codec.LegacyAmino
@@ -117,6 +119,15 @@ func (a *App) Load(loadLatest bool) error {
 		a.ModuleManager.SetOrderMigrations(a.config.OrderMigrations...)
 	}
 
+	for _, limit := range a.config.BlockerGasLimits {
+		a.ModuleManager.SetBlockerGasLimit(limit.ModuleName, limit.Limit)
+	}
+
+	a.storeAudit.loadStorePrefixes(a.ModuleManager.Modules)
+	if objCapAssertions {
+		a.Logger().Info("object-capability report of the store keys\n" + a.ObjCapReport().String())
+	}
+
 	if loadLatest {
 		if err := a.LoadLatestVersion(); err != nil {
 			return err
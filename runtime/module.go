@@ -128,6 +128,7 @@ func ProvideApp() (
 		amino:             amino,
 		basicManager:      module.BasicManager{},
 		msgServiceRouter:  msgServiceRouter,
+		storeAudit:        &storeAudit{prefixes: map[string][][]byte{}},
 	}
 	appBuilder := &AppBuilder{app}
 
@@ -185,6 +186,12 @@ func storeKeyOverride(config *runtimev1alpha1.Module, moduleName string) *runtim
 }
 
 func ProvideKVStoreKey(config *runtimev1alpha1.Module, key depinject.ModuleKey, app *AppBuilder) *storetypes.KVStoreKey {
+	storeKey := newKVStoreKey(config, key, app)
+	registerStoreKeyHolder(app, key.Name(), storeKey.Name(), StoreAccessKVStoreKey)
+	return storeKey
+}
+
+func newKVStoreKey(config *runtimev1alpha1.Module, key depinject.ModuleKey, app *AppBuilder) *storetypes.KVStoreKey {
 	override := storeKeyOverride(config, key.Name())
 
 	var storeKeyName string
@@ -202,12 +209,14 @@ func ProvideKVStoreKey(config *runtimev1alpha1.Module, key depinject.ModuleKey,
 func ProvideTransientStoreKey(key depinject.ModuleKey, app *AppBuilder) *storetypes.TransientStoreKey {
 	storeKey := storetypes.NewTransientStoreKey(fmt.Sprintf("transient:%s", key.Name()))
 	registerStoreKey(app, storeKey)
+	registerStoreKeyHolder(app, key.Name(), storeKey.Name(), StoreAccessTransientStore)
 	return storeKey
 }
 
 func ProvideMemoryStoreKey(key depinject.ModuleKey, app *AppBuilder) *storetypes.MemoryStoreKey {
 	storeKey := storetypes.NewMemoryStoreKey(fmt.Sprintf("memory:%s", key.Name()))
 	registerStoreKey(app, storeKey)
+	registerStoreKeyHolder(app, key.Name(), storeKey.Name(), StoreAccessMemoryStore)
 	return storeKey
 }
 
@@ -216,8 +225,9 @@ func ProvideGenesisTxHandler(appBuilder *AppBuilder) genesis.TxHandler {
 }
 
 func ProvideKVStoreService(config *runtimev1alpha1.Module, key depinject.ModuleKey, app *AppBuilder) store.KVStoreService {
-	storeKey := ProvideKVStoreKey(config, key, app)
-	return kvStoreService{key: storeKey}
+	storeKey := newKVStoreKey(config, key, app)
+	registerStoreKeyHolder(app, key.Name(), storeKey.Name(), StoreAccessKVStoreService)
+	return kvStoreService{key: storeKey, module: key.Name(), audit: app.app.storeAudit}
 }
 
 func ProvideMemoryStoreService(key depinject.ModuleKey, app *AppBuilder) store.MemoryStoreService {
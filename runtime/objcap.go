@@ -0,0 +1,178 @@
+package runtime
+
+import (
+	"bytes"
+	"fmt"
+	"strings"
+
+	"cosmossdk.io/core/store"
+	"golang.org/x/exp/maps"
+	"golang.org/x/exp/slices"
+
+	"github.com/cosmos/cosmos-sdk/types/module"
+)
+
+// Store accesses recorded in the object-capability report, i.e. how a module
+// was given a store key.
+const (
+	StoreAccessKVStoreKey     = "kv_store_key"
+	StoreAccessKVStoreService = "kv_store_service"
+	StoreAccessTransientStore = "transient_store"
+	StoreAccessMemoryStore    = "memory_store"
+)
+
+// StoreKeyHolder records a store key provided to a module.
+type StoreKeyHolder struct {
+	Module   string
+	StoreKey string
+	Access   string
+}
+
+// ObjCapReport is the object-capability report of the store keys of an app,
+// mapping which modules hold which store keys.
+type ObjCapReport struct {
+	// Holders are the store keys provided to the modules, in provision order.
+	Holders []StoreKeyHolder
+
+	// SharedStoreKeys maps the store keys held by several modules to their
+	// holders.
+	SharedStoreKeys map[string][]string
+
+	// StorePrefixes maps the modules declaring the prefixes of the keys they
+	// write in their KV store to these prefixes. The writes of these modules
+	// through their KV store service are asserted to be within them in the
+	// builds with the objcap_assert build tag.
+	StorePrefixes map[string][][]byte
+
+	// UnauditedModules are the modules whose KV store writes cannot be
+	// asserted, because they hold a raw KV store key or do not declare their
+	// store prefixes.
+	UnauditedModules []string
+}
+
+// String implements the Stringer interface.
+func (r ObjCapReport) String() string {
+	var sb strings.Builder
+	sb.WriteString("store key holders:\n")
+	for _, holder := range r.Holders {
+		fmt.Fprintf(&sb, "  %-20s %-24s %s\n", holder.Module, holder.StoreKey, holder.Access)
+	}
+
+	for _, storeKey := range sortedKeys(r.SharedStoreKeys) {
+		fmt.Fprintf(&sb, "shared store key %s: %s\n", storeKey, strings.Join(r.SharedStoreKeys[storeKey], ", "))
+	}
+
+	for _, moduleName := range sortedKeys(r.StorePrefixes) {
+		prefixes := make([]string, 0, len(r.StorePrefixes[moduleName]))
+		for _, prefix := range r.StorePrefixes[moduleName] {
+			prefixes = append(prefixes, fmt.Sprintf("%X", prefix))
+		}
+		fmt.Fprintf(&sb, "store prefixes of %s: %s\n", moduleName, strings.Join(prefixes, ", "))
+	}
+
+	if len(r.UnauditedModules) > 0 {
+		fmt.Fprintf(&sb, "unaudited modules: %s\n", strings.Join(r.UnauditedModules, ", "))
+	}
+
+	return sb.String()
+}
+
+// ObjCapReport returns the object-capability report of the store keys of the
+// app. It is complete once the app is loaded.
+func (a *App) ObjCapReport() ObjCapReport {
+	report := ObjCapReport{
+		Holders:         append([]StoreKeyHolder{}, a.storeKeyHolders...),
+		SharedStoreKeys: map[string][]string{},
+		StorePrefixes:   map[string][][]byte{},
+	}
+
+	holders := map[string][]string{}
+	unaudited := map[string]bool{}
+	for _, holder := range a.storeKeyHolders {
+		if !slices.Contains(holders[holder.StoreKey], holder.Module) {
+			holders[holder.StoreKey] = append(holders[holder.StoreKey], holder.Module)
+		}
+
+		switch holder.Access {
+		case StoreAccessKVStoreKey:
+			unaudited[holder.Module] = true
+		case StoreAccessKVStoreService:
+			if _, ok := a.storeAudit.prefixes[holder.Module]; !ok {
+				unaudited[holder.Module] = true
+			}
+		}
+	}
+
+	for storeKey, modules := range holders {
+		if len(modules) > 1 {
+			report.SharedStoreKeys[storeKey] = modules
+		}
+	}
+
+	for moduleName, prefixes := range a.storeAudit.prefixes {
+		report.StorePrefixes[moduleName] = prefixes
+	}
+
+	report.UnauditedModules = sortedKeys(unaudited)
+	return report
+}
+
+// registerStoreKeyHolder records a store key provided to a module.
+func registerStoreKeyHolder(app *AppBuilder, moduleName, storeKey, access string) {
+	app.app.storeKeyHolders = append(app.app.storeKeyHolders, StoreKeyHolder{
+		Module:   moduleName,
+		StoreKey: storeKey,
+		Access:   access,
+	})
+}
+
+// storeAudit holds the store prefixes declared by the modules, which are
+// asserted by their KV store services.
+type storeAudit struct {
+	prefixes map[string][][]byte
+}
+
+// loadStorePrefixes records the store prefixes declared by the modules.
+func (s *storeAudit) loadStorePrefixes(modules map[string]interface{}) {
+	for moduleName, mod := range modules {
+		if mod, ok := mod.(module.HasStorePrefixes); ok {
+			s.prefixes[moduleName] = mod.StorePrefixes()
+		}
+	}
+}
+
+// auditedKVStore is a KV store panicking on the writes of a module outside of
+// its declared store prefixes.
+type auditedKVStore struct {
+	store.KVStore
+	module   string
+	prefixes [][]byte
+}
+
+// Set implements the store.KVStore interface.
+func (s auditedKVStore) Set(key, value []byte) error {
+	s.assertPrefix(key)
+	return s.KVStore.Set(key, value)
+}
+
+// Delete implements the store.KVStore interface.
+func (s auditedKVStore) Delete(key []byte) error {
+	s.assertPrefix(key)
+	return s.KVStore.Delete(key)
+}
+
+func (s auditedKVStore) assertPrefix(key []byte) {
+	for _, prefix := range s.prefixes {
+		if bytes.HasPrefix(key, prefix) {
+			return
+		}
+	}
+
+	panic(fmt.Errorf("module %s writes key %X outside of its declared store prefixes", s.module, key))
+}
+
+func sortedKeys[V any](m map[string]V) []string {
+	keys := maps.Keys(m)
+	slices.Sort(keys)
+	return keys
+}
@@ -0,0 +1,10 @@
+//go:build objcap_assert
+// +build objcap_assert
+
+package runtime
+
+// objCapAssertions enables the assertions of the KV store writes of the
+// modules against their declared store prefixes, and the logging of the
+// object-capability report of the app when loaded. They are meant for the
+// development builds, with the objcap_assert build tag.
+const objCapAssertions = true
@@ -0,0 +1,42 @@
+//go:build objcap_assert
+// +build objcap_assert
+
+package runtime
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	storetypes "cosmossdk.io/store/types"
+
+	"github.com/cosmos/cosmos-sdk/testutil"
+)
+
+func TestKVStoreServiceAssertions(t *testing.T) {
+	key := storetypes.NewKVStoreKey("bank")
+	ctx := testutil.DefaultContext(key, storetypes.NewTransientStoreKey("transient_test"))
+	audit := &storeAudit{prefixes: map[string][][]byte{"bank": {{0x01}}}}
+
+	// the writes of a module declaring its store prefixes are asserted
+	store := kvStoreService{key: key, module: "bank", audit: audit}.OpenKVStore(ctx)
+	require.IsType(t, auditedKVStore{}, store)
+	require.NotPanics(t, func() {
+		require.NoError(t, store.Set([]byte{0x01}, []byte("value")))
+	})
+	require.Panics(t, func() {
+		_ = store.Set([]byte{0x02}, []byte("value"))
+	})
+
+	// the modules which don't declare their store prefixes are skipped
+	store = kvStoreService{key: key, module: "gov", audit: audit}.OpenKVStore(ctx)
+	require.NotPanics(t, func() {
+		require.NoError(t, store.Set([]byte{0x02}, []byte("value")))
+	})
+
+	// as are the store services not provided by the runtime
+	store = NewKVStoreService(key).OpenKVStore(ctx)
+	require.NotPanics(t, func() {
+		require.NoError(t, store.Set([]byte{0x02}, []byte("value")))
+	})
+}
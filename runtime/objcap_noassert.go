@@ -0,0 +1,8 @@
+//go:build !objcap_assert
+// +build !objcap_assert
+
+package runtime
+
+// objCapAssertions is disabled without the objcap_assert build tag, see
+// objcap_assert.go.
+const objCapAssertions = false
@@ -0,0 +1,27 @@
+//go:build !objcap_assert
+// +build !objcap_assert
+
+package runtime
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	storetypes "cosmossdk.io/store/types"
+
+	"github.com/cosmos/cosmos-sdk/testutil"
+)
+
+func TestKVStoreServiceNoAssertions(t *testing.T) {
+	key := storetypes.NewKVStoreKey("bank")
+	ctx := testutil.DefaultContext(key, storetypes.NewTransientStoreKey("transient_test"))
+	audit := &storeAudit{prefixes: map[string][][]byte{"bank": {{0x01}}}}
+
+	// without the objcap_assert build tag, the writes are not asserted
+	store := kvStoreService{key: key, module: "bank", audit: audit}.OpenKVStore(ctx)
+	require.IsType(t, coreKVStore{}, store)
+	require.NotPanics(t, func() {
+		require.NoError(t, store.Set([]byte{0x02}, []byte("value")))
+	})
+}
@@ -0,0 +1,82 @@
+package runtime
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	storetypes "cosmossdk.io/store/types"
+
+	"github.com/cosmos/cosmos-sdk/testutil"
+)
+
+type storePrefixesModule struct {
+	prefixes [][]byte
+}
+
+func (m storePrefixesModule) StorePrefixes() [][]byte {
+	return m.prefixes
+}
+
+func TestObjCapReport(t *testing.T) {
+	audit := &storeAudit{prefixes: map[string][][]byte{}}
+	audit.loadStorePrefixes(map[string]interface{}{
+		"bank": storePrefixesModule{prefixes: [][]byte{{0x01}, {0x02}}},
+		"gov":  struct{}{},
+	})
+	require.Equal(t, map[string][][]byte{"bank": {{0x01}, {0x02}}}, audit.prefixes)
+
+	app := &App{
+		storeKeyHolders: []StoreKeyHolder{
+			{Module: "bank", StoreKey: "bank", Access: StoreAccessKVStoreService},
+			{Module: "gov", StoreKey: "gov", Access: StoreAccessKVStoreService},
+			{Module: "staking", StoreKey: "staking", Access: StoreAccessKVStoreKey},
+			{Module: "staking", StoreKey: "transient:staking", Access: StoreAccessTransientStore},
+			{Module: "distribution", StoreKey: "staking", Access: StoreAccessKVStoreKey},
+		},
+		storeAudit: audit,
+	}
+
+	report := app.ObjCapReport()
+	require.Equal(t, app.storeKeyHolders, report.Holders)
+	require.Equal(t, map[string][]string{"staking": {"staking", "distribution"}}, report.SharedStoreKeys)
+	require.Equal(t, map[string][][]byte{"bank": {{0x01}, {0x02}}}, report.StorePrefixes)
+
+	// the modules holding a raw store key, or which don't declare their store
+	// prefixes, are not audited
+	require.Equal(t, []string{"distribution", "gov", "staking"}, report.UnauditedModules)
+
+	str := report.String()
+	require.Contains(t, str, "shared store key staking: staking, distribution\n")
+	require.Contains(t, str, "store prefixes of bank: 01, 02\n")
+	require.Contains(t, str, "unaudited modules: distribution, gov, staking\n")
+}
+
+func TestAuditedKVStore(t *testing.T) {
+	key := storetypes.NewKVStoreKey("bank")
+	ctx := testutil.DefaultContext(key, storetypes.NewTransientStoreKey("transient_test"))
+
+	store := auditedKVStore{
+		KVStore:  newKVStore(ctx.KVStore(key)),
+		module:   "bank",
+		prefixes: [][]byte{{0x01}, {0x02, 0x03}},
+	}
+
+	require.NotPanics(t, func() {
+		require.NoError(t, store.Set([]byte{0x01, 0xff}, []byte("value")))
+		require.NoError(t, store.Set([]byte{0x02, 0x03}, []byte("value")))
+		require.NoError(t, store.Delete([]byte{0x01, 0xff}))
+	})
+
+	require.PanicsWithError(t, "module bank writes key 0204 outside of its declared store prefixes", func() {
+		_ = store.Set([]byte{0x02, 0x04}, []byte("value"))
+	})
+	require.Panics(t, func() {
+		_ = store.Delete([]byte{0x03})
+	})
+
+	// the reads are not audited
+	value, err := store.Get([]byte{0x02, 0x03})
+	require.NoError(t, err)
+	require.Equal(t, []byte("value"), value)
+}
@@ -23,6 +23,7 @@ func (a *App) registerRuntimeServices(cfg module.Configurator) error {
 		return err
 	}
 	reflectionv1.RegisterReflectionServiceServer(cfg.QueryServer(), reflectionSvc)
+	reflectionv1.RegisterParamsServiceServer(cfg.QueryServer(), services.NewParamsService(a.ModuleManager.Modules))
 
 	return nil
 }
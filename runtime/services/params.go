@@ -0,0 +1,77 @@
+package services
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	reflectionv1 "cosmossdk.io/api/cosmos/reflection/v1"
+	"github.com/cosmos/gogoproto/proto"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/anypb"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/module"
+)
+
+// ParamsService implements the cosmos.reflection.v1.ParamsService service.
+type ParamsService struct {
+	reflectionv1.UnimplementedParamsServiceServer
+
+	modules []string
+	params  map[string]module.UpdatableParams
+}
+
+// NewParamsService returns a ParamsService for the provided modules
+// implementing module.HasUpdatableParams.
+func NewParamsService(appModules map[string]interface{}) *ParamsService {
+	s := &ParamsService{params: map[string]module.UpdatableParams{}}
+	for name, mod := range appModules {
+		if mod, ok := mod.(module.HasUpdatableParams); ok {
+			s.modules = append(s.modules, name)
+			s.params[name] = mod.UpdatableParams()
+		}
+	}
+	sort.Strings(s.modules)
+
+	return s
+}
+
+// ModuleParams implements the cosmos.reflection.v1.ParamsService/ModuleParams method.
+func (s ParamsService) ModuleParams(ctx context.Context, req *reflectionv1.ModuleParamsRequest) (*reflectionv1.ModuleParamsResponse, error) {
+	modules := s.modules
+	if req.Module != "" {
+		if _, ok := s.params[req.Module]; !ok {
+			return nil, status.Errorf(codes.NotFound, "module %s has no updatable params", req.Module)
+		}
+		modules = []string{req.Module}
+	}
+
+	res := &reflectionv1.ModuleParamsResponse{}
+	for _, name := range modules {
+		p := s.params[name]
+		params, err := p.GetParams(ctx)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to get the params of module %s: %v", name, err)
+		}
+
+		bz, err := proto.Marshal(params)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to marshal the params of module %s: %v", name, err)
+		}
+
+		paramsType := proto.MessageName(params)
+		res.Params = append(res.Params, &reflectionv1.ModuleParams{
+			Module:           name,
+			ParamsType:       paramsType,
+			UpdateMsgTypeUrl: sdk.MsgTypeURL(p.UpdateMsg),
+			Authority:        p.Authority,
+			Params:           &anypb.Any{TypeUrl: fmt.Sprintf("/%s", paramsType), Value: bz},
+		})
+	}
+
+	return res, nil
+}
+
+var _ reflectionv1.ParamsServiceServer = &ParamsService{}
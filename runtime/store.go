@@ -19,10 +19,25 @@ func NewKVStoreService(storeKey *storetypes.KVStoreKey) store.KVStoreService {
 
 type kvStoreService struct {
 	key *storetypes.KVStoreKey
+
+	// module and audit are set for the store services provided to the modules
+	// by the runtime, to assert their writes, see objcap.go.
+	module string
+	audit  *storeAudit
 }
 
 func (k kvStoreService) OpenKVStore(ctx context.Context) store.KVStore {
-	return newKVStore(sdk.UnwrapSDKContext(ctx).KVStore(k.key))
+	kvStore := newKVStore(sdk.UnwrapSDKContext(ctx).KVStore(k.key))
+	if !objCapAssertions || k.audit == nil {
+		return kvStore
+	}
+
+	prefixes, ok := k.audit.prefixes[k.module]
+	if !ok {
+		return kvStore
+	}
+
+	return auditedKVStore{KVStore: kvStore, module: k.module, prefixes: prefixes}
 }
 
 type memStoreService struct {
@@ -33,6 +48,10 @@ func (m memStoreService) OpenMemoryStore(ctx context.Context) store.KVStore {
 	return newKVStore(sdk.UnwrapSDKContext(ctx).KVStore(m.key))
 }
 
+func NewTransientStoreService(storeKey *storetypes.TransientStoreKey) store.TransientStoreService {
+	return &transientStoreService{key: storeKey}
+}
+
 type transientStoreService struct {
 	key *storetypes.TransientStoreKey
 }
@@ -0,0 +1,53 @@
+package runtime
+
+import (
+	"golang.org/x/exp/slices"
+
+	servertypes "github.com/cosmos/cosmos-sdk/server/types"
+	"github.com/cosmos/cosmos-sdk/types/module"
+)
+
+var _ servertypes.HasAppWiring = (*App)(nil)
+
+// AppWiring returns the wiring of the app. It is complete once the app is
+// loaded.
+func (a *App) AppWiring() servertypes.AppWiring {
+	wiring := servertypes.AppWiring{
+		AppName:             a.Name(),
+		BeginBlockers:       a.ModuleManager.OrderBeginBlockers,
+		EndBlockers:         a.ModuleManager.OrderEndBlockers,
+		InitGenesis:         a.ModuleManager.OrderInitGenesis,
+		ExportGenesis:       a.ModuleManager.OrderExportGenesis,
+		Precommiters:        a.ModuleManager.OrderPrecommiters,
+		PrepareCheckStaters: a.ModuleManager.OrderPrepareCheckStaters,
+		MsgServices:         a.MsgServiceRouter().MsgTypeURLs(),
+		QueryServices:       a.GRPCQueryRouter().Routes(),
+	}
+
+	for _, name := range sortedKeys(a.ModuleManager.Modules) {
+		var version uint64
+		if mod, ok := a.ModuleManager.Modules[name].(module.HasConsensusVersion); ok {
+			version = mod.ConsensusVersion()
+		}
+
+		wiring.Modules = append(wiring.Modules, servertypes.ModuleWiring{Name: name, ConsensusVersion: version})
+	}
+
+	for _, holder := range a.storeKeyHolders {
+		wiring.StoreKeys = append(wiring.StoreKeys, servertypes.StoreKeyWiring{
+			Module:   holder.Module,
+			StoreKey: holder.StoreKey,
+			Access:   holder.Access,
+		})
+	}
+
+	// the store keys are provided in the dependency resolution order
+	slices.SortFunc(wiring.StoreKeys, func(x, y servertypes.StoreKeyWiring) bool {
+		if x.Module != y.Module {
+			return x.Module < y.Module
+		}
+		return x.StoreKey < y.StoreKey
+	})
+
+	return wiring
+}
@@ -14,6 +14,9 @@ $mockgen_cmd -source=x/feegrant/expected_keepers.go -package testutil -destinati
 $mockgen_cmd -source=x/mint/types/expected_keepers.go -package testutil -destination x/mint/testutil/expected_keepers_mocks.go
 $mockgen_cmd -source=x/params/proposal_handler_test.go -package testutil -destination x/params/testutil/staking_keeper_mock.go
 $mockgen_cmd -source=x/crisis/types/expected_keepers.go -package testutil -destination x/crisis/testutil/expected_keepers_mocks.go
+$mockgen_cmd -source=x/council/types/expected_keepers.go -package testutil -destination x/council/testutil/expected_keepers_mocks.go
+$mockgen_cmd -source=x/tokenfactory/types/expected_keepers.go -package testutil -destination x/tokenfactory/testutil/expected_keepers_mocks.go
+$mockgen_cmd -source=x/payments/types/expected_keepers.go -package testutil -destination x/payments/testutil/expected_keepers_mocks.go
 $mockgen_cmd -source=x/auth/tx/config/expected_keepers.go -package testutil -destination x/auth/tx/testutil/expected_keepers_mocks.go
 $mockgen_cmd -source=x/auth/types/expected_keepers.go -package testutil -destination x/auth/testutil/expected_keepers_mocks.go
 $mockgen_cmd -source=x/auth/ante/expected_keepers.go -package testutil -destination x/auth/ante/testutil/expected_keepers_mocks.go
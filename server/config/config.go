@@ -39,6 +39,11 @@ type BaseConfig struct {
 	// specified in this config (e.g. 0.25token1;0.0001token2).
 	MinGasPrices string `mapstructure:"minimum-gas-prices"`
 
+	// MinGasPricesAllOf requires a transaction's fees to meet the minimum of all
+	// the denominations specified in MinGasPrices instead of any of them, e.g.
+	// on dual-token chains.
+	MinGasPricesAllOf bool `mapstructure:"minimum-gas-prices-all-of"`
+
 	Pruning           string `mapstructure:"pruning"`
 	PruningKeepRecent string `mapstructure:"pruning-keep-recent"`
 	PruningInterval   string `mapstructure:"pruning-interval"`
@@ -139,6 +144,26 @@ type GRPCConfig struct {
 	// MaxSendMsgSize defines the max message size in bytes the server can send.
 	// The default value is math.MaxInt32.
 	MaxSendMsgSize int `mapstructure:"max-send-msg-size"`
+
+	// RateLimits defines the token bucket rate limits of the gRPC queries, per
+	// service or method.
+	RateLimits []GRPCRateLimit `mapstructure:"rate-limits"`
+}
+
+// GRPCRateLimit defines a token bucket rate limit of the gRPC server.
+type GRPCRateLimit struct {
+	// Method is the full name of the rate limited gRPC method, e.g.
+	// "/cosmos.staking.v1beta1.Query/DelegatorDelegations", or of the rate
+	// limited gRPC service, e.g. "/cosmos.staking.v1beta1.Query", in which case
+	// the limit applies to each of its methods not rate limited on their own.
+	Method string `mapstructure:"method"`
+
+	// Rate is the number of requests per second replenished in the bucket.
+	Rate float64 `mapstructure:"rate"`
+
+	// Burst is the size of the bucket, i.e. the maximum number of requests
+	// served at once.
+	Burst int `mapstructure:"burst"`
 }
 
 // GRPCWebConfig defines configuration for the gRPC-web server.
@@ -156,6 +181,16 @@ type StateSyncConfig struct {
 	// SnapshotKeepRecent sets the number of recent state sync snapshots to keep.
 	// 0 keeps all snapshots.
 	SnapshotKeepRecent uint32 `mapstructure:"snapshot-keep-recent"`
+
+	// SnapshotMaxInterval enables the adaptive snapshot interval when greater
+	// than SnapshotInterval. The snapshots are then taken every multiple of
+	// SnapshotInterval up to SnapshotMaxInterval, depending on the rate at
+	// which the state changes and on the free disk space.
+	SnapshotMaxInterval uint64 `mapstructure:"snapshot-max-interval"`
+
+	// SnapshotMinFreeDiskRatio is the ratio of free disk space below which the
+	// adaptive snapshot interval is set to SnapshotMaxInterval.
+	SnapshotMinFreeDiskRatio float64 `mapstructure:"snapshot-min-free-disk-ratio"`
 }
 
 // MempoolConfig defines the configurations for the SDK built-in app-side mempool
@@ -168,6 +203,45 @@ type MempoolConfig struct {
 	MaxTxs int
 }
 
+// EventsConfig defines the limits on the events returned to CometBFT. A zero
+// limit is disabled.
+type EventsConfig struct {
+	// MaxAttributeSize defines the maximum size in bytes of the value of an
+	// event attribute. Longer values are truncated.
+	MaxAttributeSize int `mapstructure:"max-attribute-size"`
+
+	// MaxTxAttributes defines the maximum number of event attributes per tx.
+	MaxTxAttributes int `mapstructure:"max-tx-attributes"`
+
+	// MaxBlockAttributes defines the maximum number of event attributes per
+	// block.
+	MaxBlockAttributes int `mapstructure:"max-block-attributes"`
+}
+
+// CacheWarmingConfig defines the configuration of the cache warming, which
+// pre-executes the txs accepted by CheckTx to warm the store caches before the
+// txs are executed in a block.
+type CacheWarmingConfig struct {
+	// Workers defines the number of txs pre-executed concurrently. 0 disables
+	// the cache warming.
+	Workers int `mapstructure:"workers"`
+
+	// QueueSize defines the maximum number of txs waiting to be pre-executed.
+	QueueSize int `mapstructure:"queue-size"`
+}
+
+// WhatIfConfig defines the configuration of the what-if service, which
+// simulates the effects of messages on a fork of the current state.
+type WhatIfConfig struct {
+	// Enable defines if the what-if service should be registered. As each
+	// request executes blocks in memory, it should only be enabled on nodes
+	// dedicated to development or analysis.
+	Enable bool `mapstructure:"enable"`
+
+	// MaxBlocks defines the maximum number of blocks simulated by a request.
+	MaxBlocks uint64 `mapstructure:"max-blocks"`
+}
+
 // State Streaming configuration
 type (
 	// StreamingConfig defines application configuration for external streaming services
@@ -194,6 +268,10 @@ type Config struct {
 	StateSync StateSyncConfig  `mapstructure:"state-sync"`
 	Streaming StreamingConfig  `mapstructure:"streaming"`
 	Mempool   MempoolConfig    `mapstructure:"mempool"`
+	WhatIf    WhatIfConfig     `mapstructure:"what-if"`
+	Events    EventsConfig     `mapstructure:"events"`
+
+	CacheWarming CacheWarmingConfig `mapstructure:"cache-warming"`
 }
 
 // SetMinGasPrices sets the validator's minimum gas prices.
@@ -261,8 +339,10 @@ func DefaultConfig() *Config {
 			Enable: true,
 		},
 		StateSync: StateSyncConfig{
-			SnapshotInterval:   0,
-			SnapshotKeepRecent: 2,
+			SnapshotInterval:         0,
+			SnapshotKeepRecent:       2,
+			SnapshotMaxInterval:      0,
+			SnapshotMinFreeDiskRatio: 0.1,
 		},
 		Streaming: StreamingConfig{
 			ABCI: ABCIListenerConfig{
@@ -273,6 +353,19 @@ func DefaultConfig() *Config {
 		Mempool: MempoolConfig{
 			MaxTxs: 5_000,
 		},
+		WhatIf: WhatIfConfig{
+			Enable:    false,
+			MaxBlocks: 1_000,
+		},
+		Events: EventsConfig{
+			MaxAttributeSize:   0,
+			MaxTxAttributes:    0,
+			MaxBlockAttributes: 0,
+		},
+		CacheWarming: CacheWarmingConfig{
+			Workers:   0,
+			QueueSize: 1_000,
+		},
 	}
 }
 
@@ -295,6 +388,37 @@ func (c Config) ValidateBasic() error {
 			"cannot enable state sync snapshots with '%s' pruning setting", pruningtypes.PruningOptionEverything,
 		)
 	}
+	if c.StateSync.SnapshotMaxInterval > 0 && c.StateSync.SnapshotMaxInterval < c.StateSync.SnapshotInterval {
+		return sdkerrors.ErrAppConfig.Wrapf(
+			"state sync snapshot max interval %d cannot be lower than the snapshot interval %d",
+			c.StateSync.SnapshotMaxInterval, c.StateSync.SnapshotInterval,
+		)
+	}
+	if c.StateSync.SnapshotMinFreeDiskRatio < 0 || c.StateSync.SnapshotMinFreeDiskRatio > 1 {
+		return sdkerrors.ErrAppConfig.Wrapf(
+			"state sync snapshot min free disk ratio must be between 0 and 1: %v", c.StateSync.SnapshotMinFreeDiskRatio,
+		)
+	}
+	if c.Events.MaxAttributeSize < 0 || c.Events.MaxTxAttributes < 0 || c.Events.MaxBlockAttributes < 0 {
+		return sdkerrors.ErrAppConfig.Wrap("event limits cannot be negative")
+	}
+	if c.CacheWarming.Workers < 0 || c.CacheWarming.QueueSize < 0 {
+		return sdkerrors.ErrAppConfig.Wrap("cache warming workers and queue size cannot be negative")
+	}
+
+	methods := make(map[string]bool, len(c.GRPC.RateLimits))
+	for _, limit := range c.GRPC.RateLimits {
+		if !strings.HasPrefix(limit.Method, "/") {
+			return sdkerrors.ErrAppConfig.Wrapf("invalid gRPC rate limit method %q: must start with '/'", limit.Method)
+		}
+		if methods[limit.Method] {
+			return sdkerrors.ErrAppConfig.Wrapf("duplicate gRPC rate limit for %q", limit.Method)
+		}
+		if limit.Rate <= 0 || limit.Burst <= 0 {
+			return sdkerrors.ErrAppConfig.Wrapf("invalid gRPC rate limit for %q: rate and burst must be positive", limit.Method)
+		}
+		methods[limit.Method] = true
+	}
 
 	return nil
 }
@@ -208,3 +208,57 @@ func TestSetConfigTemplate(t *testing.T) {
 	actual := setBuffer.String()
 	require.Equal(t, expected, actual, "resulting config strings")
 }
+
+func TestGRPCRateLimitsConfig(t *testing.T) {
+	cfg := DefaultConfig()
+	cfg.GRPC.RateLimits = []GRPCRateLimit{
+		{Method: "/cosmos.staking.v1beta1.Query/DelegatorDelegations", Rate: 10, Burst: 20},
+		{Method: "/cosmos.bank.v1beta1.Query", Rate: 0.5, Burst: 1},
+	}
+
+	testDir := t.TempDir()
+	cfgFile := filepath.Join(testDir, "app.toml")
+	WriteConfigFile(cfgFile, cfg)
+
+	vpr := viper.New()
+	vpr.SetConfigFile(cfgFile)
+	err := vpr.ReadInConfig()
+	require.NoError(t, err, "reading config file into viper")
+
+	actual, err := GetConfig(vpr)
+	require.NoError(t, err)
+	require.Equal(t, cfg.GRPC.RateLimits, actual.GRPC.RateLimits)
+}
+
+func TestValidateGRPCRateLimits(t *testing.T) {
+	testCases := []struct {
+		name   string
+		limits []GRPCRateLimit
+		expErr bool
+	}{
+		{"no limits", nil, false},
+		{"valid limits", []GRPCRateLimit{{Method: "/cosmos.bank.v1beta1.Query", Rate: 1, Burst: 1}}, false},
+		{"invalid method", []GRPCRateLimit{{Method: "cosmos.bank.v1beta1.Query", Rate: 1, Burst: 1}}, true},
+		{"zero rate", []GRPCRateLimit{{Method: "/cosmos.bank.v1beta1.Query", Burst: 1}}, true},
+		{"zero burst", []GRPCRateLimit{{Method: "/cosmos.bank.v1beta1.Query", Rate: 1}}, true},
+		{"duplicate method", []GRPCRateLimit{
+			{Method: "/cosmos.bank.v1beta1.Query", Rate: 1, Burst: 1},
+			{Method: "/cosmos.bank.v1beta1.Query", Rate: 2, Burst: 2},
+		}, true},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			cfg := DefaultConfig()
+			cfg.MinGasPrices = "0stake"
+			cfg.GRPC.RateLimits = tc.limits
+
+			err := cfg.ValidateBasic()
+			if tc.expErr {
+				require.Error(t, err)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
@@ -21,6 +21,10 @@ const DefaultConfigTemplate = `# This is a TOML config file.
 # specified in this config (e.g. 0.25token1;0.0001token2).
 minimum-gas-prices = "{{ .BaseConfig.MinGasPrices }}"
 
+# If true, a transaction's fees must meet the minimum of all the denominations
+# specified in minimum-gas-prices instead of any of them.
+minimum-gas-prices-all-of = {{ .BaseConfig.MinGasPricesAllOf }}
+
 # default: the last 362880 states are kept, pruning at 10 block intervals
 # nothing: all historic states will be saved, nothing will be deleted (i.e. archiving node)
 # everything: 2 latest states will be kept; pruning at 10 block intervals.
@@ -172,6 +176,26 @@ max-recv-msg-size = "{{ .GRPC.MaxRecvMsgSize }}"
 # The default value is math.MaxInt32.
 max-send-msg-size = "{{ .GRPC.MaxSendMsgSize }}"
 
+# RateLimits defines token bucket rate limits of the gRPC queries. A limit applies
+# to a single method, or to each method of a service not limited on its own.
+# Requests exceeding a limit fail with the ResourceExhausted gRPC code.
+# The limits are shared by all the clients of the node, and by the gRPC server
+# and the ABCI Query method, through which the CometBFT RPC and the REST
+# gateway (when the gRPC server is disabled) route the queries.
+#
+# Example:
+# [[grpc.rate-limits]]
+# method = "/cosmos.staking.v1beta1.Query/DelegatorDelegations"
+# rate = 10.0 # requests per second
+# burst = 20
+{{- range .GRPC.RateLimits }}
+
+[[grpc.rate-limits]]
+method = "{{ .Method }}"
+rate = {{ .Rate }}
+burst = {{ .Burst }}
+{{- end }}
+
 ###############################################################################
 ###                        gRPC Web Configuration                           ###
 ###############################################################################
@@ -198,6 +222,16 @@ snapshot-interval = {{ .StateSync.SnapshotInterval }}
 # snapshot-keep-recent specifies the number of recent snapshots to keep and serve (0 to keep all).
 snapshot-keep-recent = {{ .StateSync.SnapshotKeepRecent }}
 
+# snapshot-max-interval enables the adaptive snapshot interval when greater than snapshot-interval
+# (0 to disable). Snapshots are then taken every multiple of snapshot-interval up to
+# snapshot-max-interval: the interval is shortened when the state changes quickly, lengthened when
+# it changes slowly, and set to snapshot-max-interval under disk pressure.
+snapshot-max-interval = {{ .StateSync.SnapshotMaxInterval }}
+
+# snapshot-min-free-disk-ratio is the ratio of free disk space of the snapshot directory below which
+# the adaptive snapshot interval is set to snapshot-max-interval.
+snapshot-min-free-disk-ratio = {{ .StateSync.SnapshotMinFreeDiskRatio }}
+
 ###############################################################################
 ###                              State Streaming                            ###
 ###############################################################################
@@ -236,6 +270,64 @@ stop-node-on-err = {{ .Streaming.ABCI.StopNodeOnErr }}
 # Note, this configuration only applies to SDK built-in app-side mempool
 # implementations.
 max-txs = "{{ .Mempool.MaxTxs }}"
+
+###############################################################################
+###                         What-If Configuration                           ###
+###############################################################################
+
+[what-if]
+
+# Enable defines if the what-if gRPC service should be enabled. It executes messages,
+# e.g. a parameter change, against an in-memory fork of the current state and simulates
+# blocks to report their effects. Only enable it on nodes dedicated to development or
+# analysis, as each request executes the Begin and EndBlockers of the simulated blocks.
+enable = {{ .WhatIf.Enable }}
+
+# MaxBlocks defines the maximum number of blocks simulated by a request.
+max-blocks = {{ .WhatIf.MaxBlocks }}
+
+###############################################################################
+###                         Events Configuration                            ###
+###############################################################################
+
+[events]
+
+# The limits on the events returned to CometBFT, protecting the node from the bloat
+# of oversized or countless events, e.g. a failed proposal logging a huge error.
+# The events aren't part of consensus, so the limits only apply to the events
+# indexed and returned by this node. The truncation is deterministic, and an
+# "events_truncated" event is appended to the truncated events. 0 disables a limit.
+
+# MaxAttributeSize defines the maximum size in bytes of the value of an event attribute.
+# Longer values are truncated.
+max-attribute-size = {{ .Events.MaxAttributeSize }}
+
+# MaxTxAttributes defines the maximum number of event attributes per tx. The events
+# exceeding the limit are dropped.
+max-tx-attributes = {{ .Events.MaxTxAttributes }}
+
+# MaxBlockAttributes defines the maximum number of event attributes per block, including
+# the events of BeginBlock and EndBlock. The events exceeding the limit are dropped.
+max-block-attributes = {{ .Events.MaxBlockAttributes }}
+
+###############################################################################
+###                      Cache Warming Configuration                        ###
+###############################################################################
+
+[cache-warming]
+
+# The cache warming pre-executes the txs accepted by CheckTx in the background, between
+# the commit of a block and the start of the next one, against a branch of the last
+# committed state which is discarded, so that the state they read is loaded in the store
+# caches before they are executed in a block. It reduces the block execution latency of
+# the nodes whose state reads are I/O-bound.
+
+# Workers defines the number of txs pre-executed concurrently. 0 disables the cache warming.
+workers = {{ .CacheWarming.Workers }}
+
+# QueueSize defines the maximum number of txs waiting to be pre-executed. The txs accepted
+# while the queue is full aren't pre-executed.
+queue-size = {{ .CacheWarming.QueueSize }}
 `
 
 var configTemplate *template.Template
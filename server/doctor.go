@@ -0,0 +1,405 @@
+package server
+
+import (
+	"bytes"
+	"encoding/binary"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"sort"
+
+	cmtcfg "github.com/cometbft/cometbft/config"
+	"github.com/cometbft/cometbft/node"
+	sm "github.com/cometbft/cometbft/state"
+	cmtstore "github.com/cometbft/cometbft/store"
+	dbm "github.com/cosmos/cosmos-db"
+	"github.com/spf13/cast"
+	"github.com/spf13/cobra"
+
+	"cosmossdk.io/log"
+	"cosmossdk.io/store/iavl"
+	"cosmossdk.io/store/metrics"
+	pruningtypes "cosmossdk.io/store/pruning/types"
+	"cosmossdk.io/store/rootmulti"
+	"cosmossdk.io/store/snapshots"
+	storetypes "cosmossdk.io/store/types"
+
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/version"
+)
+
+const flagSkipOrphanScan = "skip-orphan-scan"
+
+const (
+	doctorError   = "ERROR"
+	doctorWarning = "WARN"
+)
+
+// doctorFinding is an issue found by the chain data doctor, along with the
+// guidance to repair it.
+type doctorFinding struct {
+	severity string
+	check    string
+	message  string
+	advice   string
+}
+
+// doctor runs the offline checks of the data directory of a node and collects
+// their findings.
+type doctor struct {
+	out      io.Writer
+	findings []doctorFinding
+}
+
+// appStoreState is the state of the application store, as inspected by the
+// doctor.
+type appStoreState struct {
+	latestVersion int64
+	commitInfo    *storetypes.CommitInfo
+}
+
+// DoctorCmd returns the command running offline integrity checks on the data
+// directory of the node, and printing repair or resync guidance for each issue
+// found. The node must be stopped.
+func DoctorCmd(defaultNodeHome string) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "doctor",
+		Short: "Check the integrity of the node data directory and suggest repairs",
+		Long: `Check the integrity of the node data directory, offline, and print actionable
+repair or resync guidance for each issue found:
+
+- the version of each application store matches the latest commit info, and its
+  versions have no gaps;
+- the retained versions are consistent with the pruning options of app.toml;
+- the IAVL trees have no orphaned nodes left over by an interrupted pruning;
+- the application state matches the CometBFT block store and state, including
+  the app hash of the last block;
+- the state sync snapshots have all their chunks, and are not ahead of the
+  application state.
+
+The node must be stopped, as the databases are opened. The command fails if an
+error is found, warnings don't fail it.`,
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			serverCtx := GetServerContextFromCmd(cmd)
+			cfg := serverCtx.Config
+			backend := GetAppDBBackend(serverCtx.Viper)
+
+			pruningOpts, err := GetPruningOptionsFromFlags(serverCtx.Viper)
+			if err != nil {
+				return err
+			}
+			snapshotInterval := cast.ToUint64(serverCtx.Viper.Get(FlagStateSyncSnapshotInterval))
+			skipOrphanScan, _ := cmd.Flags().GetBool(flagSkipOrphanScan)
+
+			d := &doctor{out: cmd.OutOrStdout()}
+
+			dataDir := filepath.Join(cfg.RootDir, "data")
+			if _, err := os.Stat(filepath.Join(dataDir, "application.db")); err != nil {
+				return fmt.Errorf("no application database found in %s: %w", dataDir, err)
+			}
+
+			db, err := dbm.NewDB("application", backend, dataDir)
+			if err != nil {
+				return fmt.Errorf("failed to open the application database, is the node running? %w", err)
+			}
+			defer db.Close()
+
+			app := d.checkAppStore(db, pruningOpts, snapshotInterval, !skipOrphanScan)
+			if err := d.checkCometBFT(cfg, app); err != nil {
+				return err
+			}
+			if err := d.checkSnapshots(filepath.Join(dataDir, "snapshots"), backend, app); err != nil {
+				return err
+			}
+
+			return d.printFindings()
+		},
+	}
+
+	cmd.Flags().String(flags.FlagHome, defaultNodeHome, "The application home directory")
+	cmd.Flags().Bool(flagSkipOrphanScan, false, "Skip the scan of the orphaned IAVL nodes, which reads all the orphan records")
+
+	return cmd
+}
+
+// report records a finding.
+func (d *doctor) report(severity, check, advice, format string, args ...any) {
+	d.findings = append(d.findings, doctorFinding{
+		severity: severity,
+		check:    check,
+		message:  fmt.Sprintf(format, args...),
+		advice:   advice,
+	})
+}
+
+// printFindings prints the findings, and returns an error if any of them is an
+// error.
+func (d *doctor) printFindings() error {
+	if len(d.findings) == 0 {
+		fmt.Fprintln(d.out, "no issue found")
+		return nil
+	}
+
+	var errs int
+	for _, f := range d.findings {
+		if f.severity == doctorError {
+			errs++
+		}
+		fmt.Fprintf(d.out, "[%s] %s: %s\n", f.severity, f.check, f.message)
+		fmt.Fprintf(d.out, "  -> %s\n", f.advice)
+	}
+
+	if errs > 0 {
+		return fmt.Errorf("found %d error(s) and %d warning(s)", errs, len(d.findings)-errs)
+	}
+
+	return nil
+}
+
+// checkAppStore checks the stores of the latest commit info of the application
+// database: their versions, the consistency of the retained versions with the
+// pruning options, and optionally their orphaned IAVL nodes.
+func (d *doctor) checkAppStore(db dbm.DB, pruningOpts pruningtypes.PruningOptions, snapshotInterval uint64, scanOrphans bool) appStoreState {
+	latest := rootmulti.GetLatestVersion(db)
+	fmt.Fprintf(d.out, "application: latest version %d\n", latest)
+	if latest == 0 {
+		return appStoreState{}
+	}
+
+	commitInfo, err := rootmulti.NewStore(db, log.NewNopLogger(), metrics.NewNoOpMetrics()).GetCommitInfo(latest)
+	if err != nil {
+		d.report(doctorError, "commit-info", fmt.Sprintf("restore the data directory from a backup or a snapshot, or resync the node, e.g. with `%s comet unsafe-reset-all` and state sync", version.AppName),
+			"the commit info of the latest version %d cannot be read: %s", latest, err)
+		return appStoreState{latestVersion: latest}
+	}
+
+	for _, info := range commitInfo.StoreInfos {
+		versions, err := loadStoreVersions(db, info.Name, latest)
+		if err != nil {
+			d.report(doctorError, "store-version", fmt.Sprintf("roll the node back with `%s rollback` if the previous version loads, otherwise restore a backup or resync the node", version.AppName),
+				"store %s cannot be loaded at version %d: %s", info.Name, latest, err)
+			continue
+		}
+
+		d.checkStoreVersions(info.Name, versions, latest, pruningOpts, snapshotInterval)
+		if scanOrphans {
+			if err := d.checkStoreOrphans(db, info.Name, versions); err != nil {
+				d.report(doctorWarning, "orphans", "run the scan again, or skip it with --"+flagSkipOrphanScan,
+					"the orphans of store %s cannot be scanned: %s", info.Name, err)
+			}
+		}
+	}
+
+	return appStoreState{latestVersion: latest, commitInfo: commitInfo}
+}
+
+// loadStoreVersions loads an IAVL store of the application database at the
+// given version, and returns all its available versions in ascending order.
+func loadStoreVersions(db dbm.DB, name string, ver int64) ([]int64, error) {
+	rs := rootmulti.NewStore(db, log.NewNopLogger(), metrics.NewNoOpMetrics())
+	// the fast nodes are not upgraded, so that no store is written
+	rs.SetIAVLDisableFastNode(true)
+
+	key := storetypes.NewKVStoreKey(name)
+	rs.MountStoreWithDB(key, storetypes.StoreTypeIAVL, nil)
+	if err := rs.LoadVersion(ver); err != nil {
+		return nil, err
+	}
+
+	store, ok := rs.GetCommitKVStore(key).(*iavl.Store)
+	if !ok {
+		return nil, fmt.Errorf("store %s is not an IAVL store", name)
+	}
+
+	var versions []int64
+	for _, v := range store.GetAllVersions() {
+		versions = append(versions, int64(v))
+	}
+	sort.Slice(versions, func(i, j int) bool { return versions[i] < versions[j] })
+
+	return versions, nil
+}
+
+// checkStoreVersions checks that the versions of a store end at the latest
+// version, have no gaps unless pruned, and are consistent with the pruning
+// options.
+func (d *doctor) checkStoreVersions(name string, versions []int64, latest int64, pruningOpts pruningtypes.PruningOptions, snapshotInterval uint64) {
+	if len(versions) == 0 {
+		d.report(doctorError, "store-version", "restore a backup or resync the node", "store %s has no version", name)
+		return
+	}
+
+	if last := versions[len(versions)-1]; last > latest {
+		d.report(doctorWarning, "store-version", fmt.Sprintf("the versions are overwritten when the node restarts; if it fails to, roll it back with `%s rollback`", version.AppName),
+			"store %s has versions up to %d, ahead of the latest commit info at %d, e.g. after a crash during a commit", name, last, latest)
+	}
+
+	var gaps int64
+	for i := 1; i < len(versions); i++ {
+		gaps += versions[i] - versions[i-1] - 1
+	}
+
+	if pruningOpts.GetPruningStrategy() == pruningtypes.PruningNothing {
+		if gaps > 0 {
+			d.report(doctorError, "version-gaps", "the state of the missing heights cannot be queried; restore a backup, or resync the node from genesis, if it must serve the full history",
+				"store %s misses %d versions between %d and %d, although pruning is disabled", name, gaps, versions[0], latest)
+		}
+		return
+	}
+
+	// the versions older than the kept recent ones are pruned every interval,
+	// except the heights of the state sync snapshots
+	pruneBelow := latest - int64(pruningOpts.KeepRecent) - int64(pruningOpts.Interval)
+	var unpruned int
+	for _, v := range versions {
+		if v >= pruneBelow {
+			break
+		}
+		if snapshotInterval == 0 || uint64(v)%snapshotInterval != 0 {
+			unpruned++
+		}
+	}
+
+	if unpruned > 0 {
+		d.report(doctorWarning, "pruning", fmt.Sprintf("prune them with `%s prune`, with the pruning options of app.toml, while the node is stopped", version.AppName),
+			"store %s retains %d versions older than the %d recent versions kept by the pruning options", name, unpruned, pruningOpts.KeepRecent)
+	}
+}
+
+// checkStoreOrphans counts the orphaned nodes of the IAVL tree of a store
+// which are not referenced by any of its available versions, i.e. the nodes
+// left over by an interrupted pruning.
+func (d *doctor) checkStoreOrphans(db dbm.DB, name string, versions []int64) error {
+	// the orphans are stored by IAVL under o<last-version><first-version><hash>,
+	// in the prefix of the store in the root multistore
+	prefix := []byte("s/k:" + name + "/o")
+	it, err := db.Iterator(prefix, storetypes.PrefixEndBytes(prefix))
+	if err != nil {
+		return err
+	}
+	defer it.Close()
+
+	var stale int
+	for ; it.Valid(); it.Next() {
+		key := bytes.TrimPrefix(it.Key(), prefix)
+		if len(key) < 16 {
+			continue
+		}
+
+		last := int64(binary.BigEndian.Uint64(key[:8]))
+		first := int64(binary.BigEndian.Uint64(key[8:16]))
+		i := sort.Search(len(versions), func(i int) bool { return versions[i] >= first })
+		if i == len(versions) || versions[i] > last {
+			stale++
+		}
+	}
+	if err := it.Error(); err != nil {
+		return err
+	}
+
+	if stale > 0 {
+		d.report(doctorWarning, "orphans", "the nodes only waste disk space; reclaim it by restoring a snapshot into a fresh data directory, or by state syncing the node",
+			"store %s has %d orphaned nodes not referenced by any available version", name, stale)
+	}
+
+	return nil
+}
+
+// checkCometBFT checks that the application state matches the heights of the
+// CometBFT block store and state, and the app hash of the last block.
+func (d *doctor) checkCometBFT(cfg *cmtcfg.Config, app appStoreState) error {
+	for _, id := range []string{"blockstore", "state"} {
+		if _, err := os.Stat(filepath.Join(cfg.DBDir(), id+".db")); err != nil {
+			d.report(doctorWarning, "cometbft", "ignore this warning for a node which was never started", "no CometBFT %s database found in %s", id, cfg.DBDir())
+			return nil
+		}
+	}
+
+	blockStoreDB, err := node.DefaultDBProvider(&node.DBContext{ID: "blockstore", Config: cfg})
+	if err != nil {
+		return err
+	}
+	defer blockStoreDB.Close()
+	blockStore := cmtstore.NewBlockStore(blockStoreDB)
+
+	stateDB, err := node.DefaultDBProvider(&node.DBContext{ID: "state", Config: cfg})
+	if err != nil {
+		return err
+	}
+	defer stateDB.Close()
+	state, err := sm.NewStore(stateDB, sm.StoreOptions{DiscardABCIResponses: cfg.Storage.DiscardABCIResponses}).Load()
+	if err != nil {
+		return err
+	}
+
+	base, height := blockStore.Base(), blockStore.Height()
+	fmt.Fprintf(d.out, "cometbft: blocks %d-%d, state at height %d\n", base, height, state.LastBlockHeight)
+
+	switch {
+	case app.latestVersion > height:
+		d.report(doctorError, "cometbft", "restore a backup or a snapshot of both the application and CometBFT data, or resync the node",
+			"the application is at version %d, ahead of the block store at height %d", app.latestVersion, height)
+	case app.latestVersion < height-1 && app.latestVersion+1 < base:
+		d.report(doctorError, "cometbft", fmt.Sprintf("the missing blocks cannot be replayed; resync the node with `%s comet unsafe-reset-all` and state sync, or restore a snapshot", version.AppName),
+			"the application is at version %d, but the block store starts at height %d", app.latestVersion, base)
+	case app.latestVersion < height-1:
+		d.report(doctorWarning, "cometbft", "the blocks are replayed when the node restarts",
+			"the application is at version %d, behind the block store at height %d", app.latestVersion, height)
+	}
+
+	if app.commitInfo != nil && state.LastBlockHeight == app.latestVersion && !bytes.Equal(state.AppHash, app.commitInfo.Hash()) {
+		d.report(doctorError, "app-hash", fmt.Sprintf("roll the node back with `%s rollback` and restart it with the correct binary, or resync the node", version.AppName),
+			"the app hash %X of the application at version %d differs from the app hash %X of the CometBFT state", app.commitInfo.Hash(), app.latestVersion, state.AppHash)
+	}
+
+	return nil
+}
+
+// checkSnapshots checks that the state sync snapshots have all their chunks,
+// and are not ahead of the application state.
+func (d *doctor) checkSnapshots(dir string, backend dbm.BackendType, app appStoreState) error {
+	if _, err := os.Stat(filepath.Join(dir, "metadata.db")); err != nil {
+		return nil
+	}
+
+	snapshotDB, err := dbm.NewDB("metadata", backend, dir)
+	if err != nil {
+		return err
+	}
+	defer snapshotDB.Close()
+
+	snapshotStore, err := snapshots.NewStore(snapshotDB, dir)
+	if err != nil {
+		return err
+	}
+
+	list, err := snapshotStore.List()
+	if err != nil {
+		return err
+	}
+	fmt.Fprintf(d.out, "snapshots: %d\n", len(list))
+
+	for _, s := range list {
+		if int64(s.Height) > app.latestVersion {
+			d.report(doctorError, "snapshots", "remove the snapshots directory while the node is stopped, the snapshots are taken again at the next snapshot heights",
+				"snapshot %d (format %d) is ahead of the application at version %d", s.Height, s.Format, app.latestVersion)
+		}
+
+		for i := uint32(0); i < s.Chunks; i++ {
+			chunk, err := snapshotStore.LoadChunk(s.Height, s.Format, i)
+			if err != nil {
+				return err
+			}
+			if chunk == nil {
+				d.report(doctorError, "snapshots", "remove the snapshots directory while the node is stopped, as peers would fail to state sync from the snapshot",
+					"snapshot %d (format %d) misses chunk %d of %d", s.Height, s.Format, i, s.Chunks)
+				break
+			}
+			chunk.Close()
+		}
+	}
+
+	return nil
+}
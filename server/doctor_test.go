@@ -0,0 +1,88 @@
+package server
+
+import (
+	"bytes"
+	"encoding/binary"
+	"testing"
+
+	dbm "github.com/cosmos/cosmos-db"
+	"github.com/stretchr/testify/require"
+
+	"cosmossdk.io/log"
+	"cosmossdk.io/store/iavl"
+	"cosmossdk.io/store/metrics"
+	pruningtypes "cosmossdk.io/store/pruning/types"
+	"cosmossdk.io/store/rootmulti"
+	storetypes "cosmossdk.io/store/types"
+)
+
+func TestDoctorCheckAppStore(t *testing.T) {
+	db := dbm.NewMemDB()
+	rs := rootmulti.NewStore(db, log.NewNopLogger(), metrics.NewNoOpMetrics())
+	rs.SetPruning(pruningtypes.NewPruningOptions(pruningtypes.PruningNothing))
+	keyA, keyB := storetypes.NewKVStoreKey("a"), storetypes.NewKVStoreKey("b")
+	rs.MountStoreWithDB(keyA, storetypes.StoreTypeIAVL, nil)
+	rs.MountStoreWithDB(keyB, storetypes.StoreTypeIAVL, nil)
+	require.NoError(t, rs.LoadLatestVersion())
+
+	for i := byte(0); i < 5; i++ {
+		rs.GetKVStore(keyA).Set([]byte{i}, []byte{i})
+		rs.GetKVStore(keyB).Set([]byte{0}, []byte{i})
+		rs.Commit()
+	}
+
+	nothing := pruningtypes.NewPruningOptions(pruningtypes.PruningNothing)
+	d := &doctor{out: &bytes.Buffer{}}
+	app := d.checkAppStore(db, nothing, 0, true)
+	require.Equal(t, int64(5), app.latestVersion)
+	require.Empty(t, d.findings)
+	require.NoError(t, d.printFindings())
+
+	// a missing version is an error when pruning is disabled
+	require.NoError(t, rs.GetCommitKVStore(keyA).(*iavl.Store).DeleteVersions(2))
+	d = &doctor{out: &bytes.Buffer{}}
+	d.checkAppStore(db, nothing, 0, false)
+	require.Len(t, d.findings, 1)
+	require.Equal(t, "version-gaps", d.findings[0].check)
+	require.Error(t, d.printFindings())
+
+	// an orphan record whose versions are all deleted is left over by an
+	// interrupted pruning
+	orphanKey := []byte("s/k:a/o")
+	orphanKey = binary.BigEndian.AppendUint64(orphanKey, 2)
+	orphanKey = binary.BigEndian.AppendUint64(orphanKey, 2)
+	orphanKey = append(orphanKey, make([]byte, 32)...)
+	require.NoError(t, db.Set(orphanKey, []byte{}))
+
+	d = &doctor{out: &bytes.Buffer{}}
+	d.checkAppStore(db, pruningtypes.NewPruningOptions(pruningtypes.PruningDefault), 0, true)
+	require.Len(t, d.findings, 1)
+	require.Equal(t, "orphans", d.findings[0].check)
+	require.Equal(t, doctorWarning, d.findings[0].severity)
+	require.NoError(t, d.printFindings())
+}
+
+func TestDoctorCheckStoreVersions(t *testing.T) {
+	versions := make([]int64, 0, 30)
+	for v := int64(1); v <= 30; v++ {
+		versions = append(versions, v)
+	}
+
+	// the versions older than the kept recent ones and the pruning interval
+	// should be pruned, except the snapshot heights
+	d := &doctor{out: &bytes.Buffer{}}
+	d.checkStoreVersions("a", versions, 30, pruningtypes.NewCustomPruningOptions(2, 10), 5)
+	require.Len(t, d.findings, 1)
+	require.Equal(t, "pruning", d.findings[0].check)
+	require.Contains(t, d.findings[0].message, "retains 14 versions")
+
+	d = &doctor{out: &bytes.Buffer{}}
+	d.checkStoreVersions("a", versions[20:], 30, pruningtypes.NewCustomPruningOptions(2, 10), 0)
+	require.Empty(t, d.findings)
+
+	// the versions ahead of the commit info are overwritten on restart
+	d = &doctor{out: &bytes.Buffer{}}
+	d.checkStoreVersions("a", versions, 29, pruningtypes.NewPruningOptions(pruningtypes.PruningNothing), 0)
+	require.Len(t, d.findings, 1)
+	require.Equal(t, "store-version", d.findings[0].check)
+}
@@ -0,0 +1,183 @@
+package grpc
+
+import (
+	"context"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/armon/go-metrics"
+	abci "github.com/cometbft/cometbft/abci/types"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/cosmos/cosmos-sdk/server/config"
+	"github.com/cosmos/cosmos-sdk/telemetry"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// tokenBucket is a token bucket rate limiter, safe for concurrent use.
+type tokenBucket struct {
+	mtx    sync.Mutex
+	rate   float64 // tokens replenished per second
+	burst  float64 // maximum number of tokens
+	tokens float64
+	last   time.Time
+}
+
+func newTokenBucket(rate float64, burst int, now time.Time) *tokenBucket {
+	return &tokenBucket{
+		rate:   rate,
+		burst:  float64(burst),
+		tokens: float64(burst),
+		last:   now,
+	}
+}
+
+// allow replenishes the bucket up to now and takes a token from it, returning
+// false if the bucket is empty.
+func (b *tokenBucket) allow(now time.Time) bool {
+	b.mtx.Lock()
+	defer b.mtx.Unlock()
+
+	if elapsed := now.Sub(b.last); elapsed > 0 {
+		b.tokens += elapsed.Seconds() * b.rate
+		if b.tokens > b.burst {
+			b.tokens = b.burst
+		}
+		b.last = now
+	}
+
+	if b.tokens < 1 {
+		return false
+	}
+
+	b.tokens--
+	return true
+}
+
+// RateLimiter holds the token buckets of the rate limited gRPC methods and
+// services. The same RateLimiter limits the queries served by the gRPC server
+// and through the ABCI Query method, e.g. by the REST gateway when the gRPC
+// server is disabled, so that a method is limited whichever way it is queried.
+type RateLimiter struct {
+	methods  map[string]*tokenBucket
+	services map[string]config.GRPCRateLimit
+
+	// serviceMethods holds the buckets of the methods limited through their
+	// service, created on their first request.
+	mtx            sync.Mutex
+	serviceMethods map[string]*tokenBucket
+
+	now func() time.Time
+}
+
+// NewRateLimiter returns a RateLimiter enforcing the given rate limits. The
+// limits are shared by all the clients of the node.
+func NewRateLimiter(limits []config.GRPCRateLimit) *RateLimiter {
+	return newRateLimiter(limits, time.Now)
+}
+
+func newRateLimiter(limits []config.GRPCRateLimit, now func() time.Time) *RateLimiter {
+	rl := &RateLimiter{
+		methods:        make(map[string]*tokenBucket),
+		services:       make(map[string]config.GRPCRateLimit),
+		serviceMethods: make(map[string]*tokenBucket),
+		now:            now,
+	}
+
+	for _, limit := range limits {
+		// a method name has the form /package.Service/Method
+		if strings.Count(limit.Method, "/") > 1 {
+			rl.methods[limit.Method] = newTokenBucket(limit.Rate, limit.Burst, now())
+		} else {
+			rl.services[limit.Method] = limit
+		}
+	}
+
+	return rl
+}
+
+// bucket returns the token bucket limiting the given method, or nil if the
+// method is not rate limited.
+func (rl *RateLimiter) bucket(fullMethod string) *tokenBucket {
+	if bucket, ok := rl.methods[fullMethod]; ok {
+		return bucket
+	}
+
+	idx := strings.LastIndex(fullMethod, "/")
+	if idx <= 0 {
+		return nil
+	}
+
+	limit, ok := rl.services[fullMethod[:idx]]
+	if !ok {
+		return nil
+	}
+
+	rl.mtx.Lock()
+	defer rl.mtx.Unlock()
+
+	bucket, ok := rl.serviceMethods[fullMethod]
+	if !ok {
+		bucket = newTokenBucket(limit.Rate, limit.Burst, rl.now())
+		rl.serviceMethods[fullMethod] = bucket
+	}
+
+	return bucket
+}
+
+// allow takes a token from the bucket of the method, returning false if the
+// request exceeds the rate limit of the method.
+func (rl *RateLimiter) allow(fullMethod string) bool {
+	if bucket := rl.bucket(fullMethod); bucket == nil || bucket.allow(rl.now()) {
+		return true
+	}
+
+	telemetry.IncrCounterWithLabels(
+		[]string{"grpc", "rate_limited"},
+		1,
+		[]metrics.Label{telemetry.NewLabel("method", fullMethod)},
+	)
+
+	return false
+}
+
+// UnaryInterceptor rejects the requests exceeding the rate limit of their
+// method with the ResourceExhausted code.
+func (rl *RateLimiter) UnaryInterceptor(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+	if !rl.allow(info.FullMethod) {
+		return nil, status.Errorf(codes.ResourceExhausted, "rate limit exceeded for %s", info.FullMethod)
+	}
+
+	return handler(ctx, req)
+}
+
+// WrapABCIApplication returns the application with its ABCI Query method rate
+// limited. The gRPC queries are routed through ABCI by their full method name,
+// the requests exceeding its rate limit fail with ErrRateLimited.
+func (rl *RateLimiter) WrapABCIApplication(app abci.Application) abci.Application {
+	return rateLimitedApplication{Application: app, rateLimiter: rl}
+}
+
+// rateLimitedApplication rate limits the ABCI queries of an application.
+type rateLimitedApplication struct {
+	abci.Application
+	rateLimiter *RateLimiter
+}
+
+// Query implements the ABCI Query method.
+func (app rateLimitedApplication) Query(req abci.RequestQuery) abci.ResponseQuery {
+	if !app.rateLimiter.allow(req.Path) {
+		return sdkerrors.QueryResult(sdkerrors.ErrRateLimited.Wrapf("rate limit exceeded for %s", req.Path), false)
+	}
+
+	return app.Application.Query(req)
+}
+
+// NewRateLimitInterceptor returns a unary server interceptor enforcing the given
+// rate limits. The limits are shared by all the clients of the server.
+func NewRateLimitInterceptor(limits []config.GRPCRateLimit) grpc.UnaryServerInterceptor {
+	return NewRateLimiter(limits).UnaryInterceptor
+}
@@ -0,0 +1,103 @@
+package grpc
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	abci "github.com/cometbft/cometbft/abci/types"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/cosmos/cosmos-sdk/server/config"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+func TestRateLimitInterceptor(t *testing.T) {
+	now := time.Unix(0, 0)
+	rl := newRateLimiter([]config.GRPCRateLimit{
+		{Method: "/cosmos.staking.v1beta1.Query/DelegatorDelegations", Rate: 1, Burst: 2},
+		{Method: "/cosmos.bank.v1beta1.Query", Rate: 1, Burst: 1},
+	}, func() time.Time { return now })
+
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return "ok", nil
+	}
+	call := func(method string) error {
+		_, err := rl.UnaryInterceptor(context.Background(), nil, &grpc.UnaryServerInfo{FullMethod: method}, handler)
+		return err
+	}
+	requireLimited := func(err error) {
+		require.Error(t, err)
+		require.Equal(t, codes.ResourceExhausted, status.Code(err))
+	}
+
+	// the burst is served at once, then the requests are limited
+	delegations := "/cosmos.staking.v1beta1.Query/DelegatorDelegations"
+	require.NoError(t, call(delegations))
+	require.NoError(t, call(delegations))
+	requireLimited(call(delegations))
+
+	// the methods of a limited service have a bucket each
+	require.NoError(t, call("/cosmos.bank.v1beta1.Query/Balance"))
+	requireLimited(call("/cosmos.bank.v1beta1.Query/Balance"))
+	require.NoError(t, call("/cosmos.bank.v1beta1.Query/AllBalances"))
+
+	// the other methods are not limited
+	for i := 0; i < 10; i++ {
+		require.NoError(t, call("/cosmos.staking.v1beta1.Query/Validators"))
+	}
+
+	// the buckets are replenished over time, up to their burst
+	now = now.Add(10 * time.Second)
+	require.NoError(t, call(delegations))
+	require.NoError(t, call(delegations))
+	requireLimited(call(delegations))
+
+	now = now.Add(time.Second)
+	require.NoError(t, call(delegations))
+	requireLimited(call(delegations))
+}
+
+type queryCounterApp struct {
+	abci.BaseApplication
+	queries int
+}
+
+func (app *queryCounterApp) Query(abci.RequestQuery) abci.ResponseQuery {
+	app.queries++
+	return abci.ResponseQuery{}
+}
+
+func TestRateLimitABCIQuery(t *testing.T) {
+	now := time.Unix(0, 0)
+	rl := newRateLimiter([]config.GRPCRateLimit{
+		{Method: "/cosmos.bank.v1beta1.Query/Balance", Rate: 1, Burst: 1},
+	}, func() time.Time { return now })
+
+	counter := &queryCounterApp{}
+	app := rl.WrapABCIApplication(counter)
+	balance := "/cosmos.bank.v1beta1.Query/Balance"
+
+	res := app.Query(abci.RequestQuery{Path: balance})
+	require.True(t, res.IsOK())
+
+	res = app.Query(abci.RequestQuery{Path: balance})
+	require.Equal(t, sdkerrors.ErrRateLimited.ABCICode(), res.Code)
+	require.Equal(t, sdkerrors.ErrRateLimited.Codespace(), res.Codespace)
+
+	// the buckets are shared with the gRPC server
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return "ok", nil
+	}
+	_, err := rl.UnaryInterceptor(context.Background(), nil, &grpc.UnaryServerInfo{FullMethod: balance}, handler)
+	require.Equal(t, codes.ResourceExhausted, status.Code(err))
+
+	// the other queries are not limited
+	for i := 0; i < 10; i++ {
+		require.True(t, app.Query(abci.RequestQuery{Path: "/store/bank/key"}).IsOK())
+	}
+	require.Equal(t, 11, counter.queries)
+}
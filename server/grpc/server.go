@@ -22,6 +22,19 @@ import (
 // NewGRPCServer returns a correctly configured and initialized gRPC server.
 // Note, the caller is responsible for starting the server. See StartGRPCServer.
 func NewGRPCServer(clientCtx client.Context, app types.Application, cfg config.GRPCConfig) (*grpc.Server, error) {
+	var rateLimiter *RateLimiter
+	if len(cfg.RateLimits) > 0 {
+		rateLimiter = NewRateLimiter(cfg.RateLimits)
+	}
+
+	return NewGRPCServerWithRateLimiter(clientCtx, app, cfg, rateLimiter)
+}
+
+// NewGRPCServerWithRateLimiter returns a gRPC server like NewGRPCServer, its
+// queries being limited by the given rate limiter, if not nil, instead of the
+// rate limits of cfg. It allows to share the rate limiter with the ABCI Query
+// method of the application, see RateLimiter.WrapABCIApplication.
+func NewGRPCServerWithRateLimiter(clientCtx client.Context, app types.Application, cfg config.GRPCConfig, rateLimiter *RateLimiter) (*grpc.Server, error) {
 	maxSendMsgSize := cfg.MaxSendMsgSize
 	if maxSendMsgSize == 0 {
 		maxSendMsgSize = config.DefaultGRPCMaxSendMsgSize
@@ -32,11 +45,16 @@ func NewGRPCServer(clientCtx client.Context, app types.Application, cfg config.G
 		maxRecvMsgSize = config.DefaultGRPCMaxRecvMsgSize
 	}
 
-	grpcSrv := grpc.NewServer(
+	opts := []grpc.ServerOption{
 		grpc.ForceServerCodec(codec.NewProtoCodec(clientCtx.InterfaceRegistry).GRPCCodec()),
 		grpc.MaxSendMsgSize(maxSendMsgSize),
 		grpc.MaxRecvMsgSize(maxRecvMsgSize),
-	)
+	}
+	if rateLimiter != nil {
+		opts = append(opts, grpc.UnaryInterceptor(rateLimiter.UnaryInterceptor))
+	}
+
+	grpcSrv := grpc.NewServer(opts...)
 
 	app.RegisterGRPCServer(grpcSrv)
 
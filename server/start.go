@@ -10,6 +10,7 @@ import (
 	pruningtypes "cosmossdk.io/store/pruning/types"
 	"github.com/armon/go-metrics"
 	"github.com/cometbft/cometbft/abci/server"
+	abci "github.com/cometbft/cometbft/abci/types"
 	cmtcmd "github.com/cometbft/cometbft/cmd/cometbft/commands"
 	"github.com/cometbft/cometbft/node"
 	"github.com/cometbft/cometbft/p2p"
@@ -45,6 +46,7 @@ const (
 	flagTraceStore         = "trace-store"
 	flagCPUProfile         = "cpu-profile"
 	FlagMinGasPrices       = "minimum-gas-prices"
+	FlagMinGasPricesAllOf  = "minimum-gas-prices-all-of"
 	FlagHaltHeight         = "halt-height"
 	FlagHaltTime           = "halt-time"
 	FlagInterBlockCache    = "inter-block-cache"
@@ -52,6 +54,8 @@ const (
 	FlagTrace              = "trace"
 	FlagInvCheckPeriod     = "inv-check-period"
 
+	FlagUnsafeSkipHaltHeights = "unsafe-skip-halt-heights"
+
 	FlagPruning             = "pruning"
 	FlagPruningKeepRecent   = "pruning-keep-recent"
 	FlagPruningInterval     = "pruning-interval"
@@ -65,6 +69,9 @@ const (
 	FlagStateSyncSnapshotInterval   = "state-sync.snapshot-interval"
 	FlagStateSyncSnapshotKeepRecent = "state-sync.snapshot-keep-recent"
 
+	FlagStateSyncSnapshotMaxInterval      = "state-sync.snapshot-max-interval"
+	FlagStateSyncSnapshotMinFreeDiskRatio = "state-sync.snapshot-min-free-disk-ratio"
+
 	// api-related flags
 	FlagAPIEnable             = "api.enable"
 	FlagAPISwagger            = "api.swagger"
@@ -83,6 +90,15 @@ const (
 
 	// mempool flags
 	FlagMempoolMaxTxs = "mempool.max-txs"
+
+	// events flags
+	FlagEventsMaxAttributeSize   = "events.max-attribute-size"
+	FlagEventsMaxTxAttributes    = "events.max-tx-attributes"
+	FlagEventsMaxBlockAttributes = "events.max-block-attributes"
+
+	// cache warming flags
+	FlagCacheWarmingWorkers   = "cache-warming.workers"
+	FlagCacheWarmingQueueSize = "cache-warming.queue-size"
 )
 
 // StartCmd runs the service passed in, either stand-alone or in-process with
@@ -158,7 +174,9 @@ is performed. Note, when enabled, gRPC will also be automatically enabled.
 	cmd.Flags().String(flagTransport, "socket", "Transport protocol: socket, grpc")
 	cmd.Flags().String(flagTraceStore, "", "Enable KVStore tracing to an output file")
 	cmd.Flags().String(FlagMinGasPrices, "", "Minimum gas prices to accept for transactions; Any fee in a tx must meet this minimum (e.g. 0.01photino;0.0001stake)")
+	cmd.Flags().Bool(FlagMinGasPricesAllOf, false, "Require the fees of a tx to meet the minimum of all the minimum gas prices denominations instead of any of them")
 	cmd.Flags().IntSlice(FlagUnsafeSkipUpgrades, []int{}, "Skip a set of upgrade heights to continue the old binary")
+	cmd.Flags().IntSlice(FlagUnsafeSkipHaltHeights, []int{}, "Skip a set of chain halt heights scheduled on-chain to resume block production")
 	cmd.Flags().Uint64(FlagHaltHeight, 0, "Block height at which to gracefully halt the chain and shutdown the node")
 	cmd.Flags().Uint64(FlagHaltTime, 0, "Minimum block time (in Unix seconds) at which to gracefully halt the chain and shutdown the node")
 	cmd.Flags().Bool(FlagInterBlockCache, true, "Enable inter-block caching")
@@ -183,8 +201,15 @@ is performed. Note, when enabled, gRPC will also be automatically enabled.
 	cmd.Flags().Bool(flagGRPCWebEnable, true, "Define if the gRPC-Web server should be enabled. (Note: gRPC must also be enabled)")
 	cmd.Flags().Uint64(FlagStateSyncSnapshotInterval, 0, "State sync snapshot interval")
 	cmd.Flags().Uint32(FlagStateSyncSnapshotKeepRecent, 2, "State sync snapshot to keep")
+	cmd.Flags().Uint64(FlagStateSyncSnapshotMaxInterval, 0, "State sync snapshot max interval, enabling the adaptive snapshot interval when greater than the snapshot interval")
+	cmd.Flags().Float64(FlagStateSyncSnapshotMinFreeDiskRatio, 0.1, "Ratio of free disk space below which the adaptive snapshot interval is set to its maximum")
 	cmd.Flags().Bool(FlagDisableIAVLFastNode, false, "Disable fast node for IAVL tree")
 	cmd.Flags().Int(FlagMempoolMaxTxs, mempool.DefaultMaxTx, "Sets MaxTx value for the app-side mempool")
+	cmd.Flags().Int(FlagEventsMaxAttributeSize, 0, "Maximum size in bytes of the value of an event attribute, longer values being truncated (0 disables the limit)")
+	cmd.Flags().Int(FlagEventsMaxTxAttributes, 0, "Maximum number of event attributes per tx (0 disables the limit)")
+	cmd.Flags().Int(FlagEventsMaxBlockAttributes, 0, "Maximum number of event attributes per block (0 disables the limit)")
+	cmd.Flags().Int(FlagCacheWarmingWorkers, 0, "Number of txs accepted by CheckTx pre-executed concurrently to warm the store caches (0 disables the cache warming)")
+	cmd.Flags().Int(FlagCacheWarmingQueueSize, 1000, "Maximum number of txs waiting to be pre-executed to warm the store caches")
 
 	// support old flags name for backwards compatibility
 	cmd.Flags().SetNormalizeFunc(func(f *pflag.FlagSet, name string) pflag.NormalizedName {
@@ -229,7 +254,7 @@ func startStandAlone(svrCtx *Context, appCreator types.AppCreator) error {
 
 	emitServerInfoMetrics()
 
-	svr, err := server.NewServer(addr, transport, app)
+	svr, err := server.NewServer(addr, transport, wrapABCIApplication(newQueryRateLimiter(config.GRPC), app))
 	if err != nil {
 		return fmt.Errorf("error creating listener: %v", err)
 	}
@@ -258,6 +283,26 @@ func startStandAlone(svrCtx *Context, appCreator types.AppCreator) error {
 	return g.Wait()
 }
 
+// newQueryRateLimiter returns the rate limiter of the queries configured in
+// cfg, or nil if no rate limit is configured.
+func newQueryRateLimiter(cfg serverconfig.GRPCConfig) *servergrpc.RateLimiter {
+	if len(cfg.RateLimits) == 0 {
+		return nil
+	}
+
+	return servergrpc.NewRateLimiter(cfg.RateLimits)
+}
+
+// wrapABCIApplication rate limits the ABCI queries of the application with the
+// given rate limiter, if not nil.
+func wrapABCIApplication(rateLimiter *servergrpc.RateLimiter, app types.Application) abci.Application {
+	if rateLimiter == nil {
+		return app
+	}
+
+	return rateLimiter.WrapABCIApplication(app)
+}
+
 func startInProcess(svrCtx *Context, clientCtx client.Context, appCreator types.AppCreator) error {
 	cfg := svrCtx.Config
 	home := cfg.RootDir
@@ -296,6 +341,10 @@ func startInProcess(svrCtx *Context, clientCtx client.Context, appCreator types.
 
 	app := appCreator(svrCtx.Logger, db, traceWriter, svrCtx.Viper)
 
+	// the queries are rate limited the same by the gRPC server and through the
+	// ABCI Query method
+	rateLimiter := newQueryRateLimiter(config.GRPC)
+
 	nodeKey, err := p2p.LoadOrGenNodeKey(cfg.NodeKeyFile())
 	if err != nil {
 		return err
@@ -325,7 +374,7 @@ func startInProcess(svrCtx *Context, clientCtx client.Context, appCreator types.
 			cfg,
 			pvm.LoadOrGenFilePV(cfg.PrivValidatorKeyFile(), cfg.PrivValidatorStateFile()),
 			nodeKey,
-			proxy.NewLocalClientCreator(app),
+			proxy.NewLocalClientCreator(wrapABCIApplication(rateLimiter, app)),
 			genDocProvider,
 			node.DefaultDBProvider,
 			node.DefaultMetricsProvider(cfg.Instrumentation),
@@ -406,7 +455,7 @@ func startInProcess(svrCtx *Context, clientCtx client.Context, appCreator types.
 		clientCtx = clientCtx.WithGRPCClient(grpcClient)
 		svrCtx.Logger.Debug("gRPC client assigned to client context", "target", grpcAddress)
 
-		grpcSrv, err = servergrpc.NewGRPCServer(clientCtx, app, config.GRPC)
+		grpcSrv, err = servergrpc.NewGRPCServerWithRateLimiter(clientCtx, app, config.GRPC, rateLimiter)
 		if err != nil {
 			return err
 		}
@@ -0,0 +1,203 @@
+package types
+
+import (
+	"fmt"
+	"strings"
+
+	"golang.org/x/exp/slices"
+)
+
+type (
+	// AppWiring describes the wiring of an application: its modules, the store
+	// keys provided to them, the order of their ABCI calls and the services
+	// they register. It is dumped as JSON so that the wiring of two binary
+	// versions can be compared.
+	AppWiring struct {
+		AppName             string           `json:"app_name"`
+		Modules             []ModuleWiring   `json:"modules"`
+		StoreKeys           []StoreKeyWiring `json:"store_keys"`
+		BeginBlockers       []string         `json:"begin_blockers"`
+		EndBlockers         []string         `json:"end_blockers"`
+		InitGenesis         []string         `json:"init_genesis"`
+		ExportGenesis       []string         `json:"export_genesis"`
+		Precommiters        []string         `json:"precommiters"`
+		PrepareCheckStaters []string         `json:"prepare_check_staters"`
+		MsgServices         []string         `json:"msg_services"`
+		QueryServices       []string         `json:"query_services"`
+	}
+
+	// ModuleWiring describes a module of an application.
+	ModuleWiring struct {
+		Name             string `json:"name"`
+		ConsensusVersion uint64 `json:"consensus_version,omitempty"`
+	}
+
+	// StoreKeyWiring describes a store key provided to a module, i.e. to its
+	// keeper.
+	StoreKeyWiring struct {
+		Module   string `json:"module"`
+		StoreKey string `json:"store_key"`
+		Access   string `json:"access"`
+	}
+
+	// HasAppWiring is implemented by the applications able to describe their
+	// wiring.
+	HasAppWiring interface {
+		AppWiring() AppWiring
+	}
+
+	// AppWiringDiff lists the changes between the wiring of two applications.
+	// The order changes are reported as the full old and new orders.
+	AppWiringDiff struct {
+		AddedModules         []string         `json:"added_modules,omitempty"`
+		RemovedModules       []string         `json:"removed_modules,omitempty"`
+		ConsensusVersions    []VersionChange  `json:"consensus_versions,omitempty"`
+		AddedStoreKeys       []StoreKeyWiring `json:"added_store_keys,omitempty"`
+		RemovedStoreKeys     []StoreKeyWiring `json:"removed_store_keys,omitempty"`
+		OrderChanges         []OrderChange    `json:"order_changes,omitempty"`
+		AddedMsgServices     []string         `json:"added_msg_services,omitempty"`
+		RemovedMsgServices   []string         `json:"removed_msg_services,omitempty"`
+		AddedQueryServices   []string         `json:"added_query_services,omitempty"`
+		RemovedQueryServices []string         `json:"removed_query_services,omitempty"`
+		AppNameChanged       bool             `json:"app_name_changed,omitempty"`
+	}
+
+	// VersionChange is a change of the consensus version of a module.
+	VersionChange struct {
+		Module string `json:"module"`
+		Old    uint64 `json:"old"`
+		New    uint64 `json:"new"`
+	}
+
+	// OrderChange is a change of one of the orders of the ABCI calls of the
+	// modules.
+	OrderChange struct {
+		Name string   `json:"name"`
+		Old  []string `json:"old"`
+		New  []string `json:"new"`
+	}
+)
+
+// DiffAppWiring returns the changes from the old to the new wiring.
+func DiffAppWiring(oldWiring, newWiring AppWiring) AppWiringDiff {
+	diff := AppWiringDiff{
+		AppNameChanged: oldWiring.AppName != newWiring.AppName,
+	}
+
+	oldVersions := make(map[string]uint64, len(oldWiring.Modules))
+	for _, m := range oldWiring.Modules {
+		oldVersions[m.Name] = m.ConsensusVersion
+	}
+
+	newVersions := make(map[string]uint64, len(newWiring.Modules))
+	for _, m := range newWiring.Modules {
+		newVersions[m.Name] = m.ConsensusVersion
+
+		oldVersion, found := oldVersions[m.Name]
+		switch {
+		case !found:
+			diff.AddedModules = append(diff.AddedModules, m.Name)
+		case oldVersion != m.ConsensusVersion:
+			diff.ConsensusVersions = append(diff.ConsensusVersions, VersionChange{Module: m.Name, Old: oldVersion, New: m.ConsensusVersion})
+		}
+	}
+
+	for _, m := range oldWiring.Modules {
+		if _, found := newVersions[m.Name]; !found {
+			diff.RemovedModules = append(diff.RemovedModules, m.Name)
+		}
+	}
+
+	diff.AddedStoreKeys, diff.RemovedStoreKeys = diffLists(oldWiring.StoreKeys, newWiring.StoreKeys)
+	diff.AddedMsgServices, diff.RemovedMsgServices = diffLists(oldWiring.MsgServices, newWiring.MsgServices)
+	diff.AddedQueryServices, diff.RemovedQueryServices = diffLists(oldWiring.QueryServices, newWiring.QueryServices)
+
+	for _, order := range []struct {
+		name     string
+		old, new []string
+	}{
+		{"begin_blockers", oldWiring.BeginBlockers, newWiring.BeginBlockers},
+		{"end_blockers", oldWiring.EndBlockers, newWiring.EndBlockers},
+		{"init_genesis", oldWiring.InitGenesis, newWiring.InitGenesis},
+		{"export_genesis", oldWiring.ExportGenesis, newWiring.ExportGenesis},
+		{"precommiters", oldWiring.Precommiters, newWiring.Precommiters},
+		{"prepare_check_staters", oldWiring.PrepareCheckStaters, newWiring.PrepareCheckStaters},
+	} {
+		if !slices.Equal(order.old, order.new) {
+			diff.OrderChanges = append(diff.OrderChanges, OrderChange{Name: order.name, Old: order.old, New: order.new})
+		}
+	}
+
+	return diff
+}
+
+// IsEmpty returns true if the wirings compared are the same.
+func (d AppWiringDiff) IsEmpty() bool {
+	return !d.AppNameChanged &&
+		len(d.AddedModules) == 0 && len(d.RemovedModules) == 0 && len(d.ConsensusVersions) == 0 &&
+		len(d.AddedStoreKeys) == 0 && len(d.RemovedStoreKeys) == 0 && len(d.OrderChanges) == 0 &&
+		len(d.AddedMsgServices) == 0 && len(d.RemovedMsgServices) == 0 &&
+		len(d.AddedQueryServices) == 0 && len(d.RemovedQueryServices) == 0
+}
+
+// String implements the Stringer interface, listing the changes one per line.
+func (d AppWiringDiff) String() string {
+	var lines []string
+	add := func(format string, args ...interface{}) {
+		lines = append(lines, fmt.Sprintf(format, args...))
+	}
+
+	if d.AppNameChanged {
+		add("~ app name")
+	}
+	for _, m := range d.AddedModules {
+		add("+ module %s", m)
+	}
+	for _, m := range d.RemovedModules {
+		add("- module %s", m)
+	}
+	for _, v := range d.ConsensusVersions {
+		add("~ module %s consensus version %d -> %d", v.Module, v.Old, v.New)
+	}
+	for _, k := range d.AddedStoreKeys {
+		add("+ store key %s of %s (%s)", k.StoreKey, k.Module, k.Access)
+	}
+	for _, k := range d.RemovedStoreKeys {
+		add("- store key %s of %s (%s)", k.StoreKey, k.Module, k.Access)
+	}
+	for _, o := range d.OrderChanges {
+		add("~ %s %v -> %v", o.Name, o.Old, o.New)
+	}
+	for _, s := range d.AddedMsgServices {
+		add("+ msg %s", s)
+	}
+	for _, s := range d.RemovedMsgServices {
+		add("- msg %s", s)
+	}
+	for _, s := range d.AddedQueryServices {
+		add("+ query %s", s)
+	}
+	for _, s := range d.RemovedQueryServices {
+		add("- query %s", s)
+	}
+
+	return strings.Join(lines, "\n")
+}
+
+// diffLists returns the elements of the new list missing from the old one, and
+// the elements of the old list missing from the new one.
+func diffLists[T comparable](oldList, newList []T) (added, removed []T) {
+	for _, e := range newList {
+		if !slices.Contains(oldList, e) {
+			added = append(added, e)
+		}
+	}
+
+	for _, e := range oldList {
+		if !slices.Contains(newList, e) {
+			removed = append(removed, e)
+		}
+	}
+
+	return added, removed
+}
@@ -332,6 +332,7 @@ func AddCommands(rootCmd *cobra.Command, defaultNodeHome string, appCreator type
 		ExportCmd(appExport, defaultNodeHome),
 		version.NewVersionCommand(),
 		NewRollbackCmd(appCreator, defaultNodeHome),
+		AppWiringCmd(appCreator, defaultNodeHome),
 	)
 }
 
@@ -483,9 +484,11 @@ func DefaultBaseappOptions(appOpts types.AppOptions) []func(*baseapp.BaseApp) {
 		panic(err)
 	}
 
-	snapshotOptions := snapshottypes.NewSnapshotOptions(
+	snapshotOptions := snapshottypes.NewAdaptiveSnapshotOptions(
 		cast.ToUint64(appOpts.Get(FlagStateSyncSnapshotInterval)),
+		cast.ToUint64(appOpts.Get(FlagStateSyncSnapshotMaxInterval)),
 		cast.ToUint32(appOpts.Get(FlagStateSyncSnapshotKeepRecent)),
+		cast.ToFloat64(appOpts.Get(FlagStateSyncSnapshotMinFreeDiskRatio)),
 	)
 
 	defaultMempool := baseapp.SetMempool(mempool.NoOpMempool{})
@@ -500,12 +503,22 @@ func DefaultBaseappOptions(appOpts types.AppOptions) []func(*baseapp.BaseApp) {
 	return []func(*baseapp.BaseApp){
 		baseapp.SetPruning(pruningOpts),
 		baseapp.SetMinGasPrices(cast.ToString(appOpts.Get(FlagMinGasPrices))),
+		baseapp.SetMinGasPricesAllOf(cast.ToBool(appOpts.Get(FlagMinGasPricesAllOf))),
 		baseapp.SetHaltHeight(cast.ToUint64(appOpts.Get(FlagHaltHeight))),
 		baseapp.SetHaltTime(cast.ToUint64(appOpts.Get(FlagHaltTime))),
 		baseapp.SetMinRetainBlocks(cast.ToUint64(appOpts.Get(FlagMinRetainBlocks))),
 		baseapp.SetInterBlockCache(cache),
 		baseapp.SetTrace(cast.ToBool(appOpts.Get(FlagTrace))),
 		baseapp.SetIndexEvents(cast.ToStringSlice(appOpts.Get(FlagIndexEvents))),
+		baseapp.SetEventLimits(baseapp.EventLimits{
+			MaxAttributeSize:   cast.ToInt(appOpts.Get(FlagEventsMaxAttributeSize)),
+			MaxTxAttributes:    cast.ToInt(appOpts.Get(FlagEventsMaxTxAttributes)),
+			MaxBlockAttributes: cast.ToInt(appOpts.Get(FlagEventsMaxBlockAttributes)),
+		}),
+		baseapp.SetCacheWarming(baseapp.CacheWarmingConfig{
+			Workers:   cast.ToInt(appOpts.Get(FlagCacheWarmingWorkers)),
+			QueueSize: cast.ToInt(appOpts.Get(FlagCacheWarmingQueueSize)),
+		}),
 		baseapp.SetSnapshot(snapshotStore, snapshotOptions),
 		baseapp.SetIAVLCacheSize(cast.ToInt(appOpts.Get(FlagIAVLCacheSize))),
 		baseapp.SetIAVLDisableFastNode(cast.ToBool(appOpts.Get(FlagDisableIAVLFastNode))),
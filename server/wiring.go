@@ -0,0 +1,129 @@
+package server
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+
+	dbm "github.com/cosmos/cosmos-db"
+	"github.com/spf13/cobra"
+
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/server/types"
+)
+
+// AppWiringCmd returns the commands dumping the wiring of the app as JSON, and
+// comparing two dumps, e.g. of the binaries before and after a chain upgrade.
+func AppWiringCmd(appCreator types.AppCreator, defaultNodeHome string) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "app-wiring",
+		Short: "Dump and compare the wiring of the app",
+	}
+
+	cmd.AddCommand(
+		appWiringDumpCmd(appCreator, defaultNodeHome),
+		appWiringDiffCmd(),
+	)
+
+	return cmd
+}
+
+func appWiringDumpCmd(appCreator types.AppCreator, defaultNodeHome string) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "dump",
+		Short: "Dump the wiring of the app as JSON",
+		Long: `Dump the wiring of the app as JSON: its modules and their consensus versions,
+the store keys provided to their keepers, the order of their begin and end blockers,
+genesis, precommit and prepare check state functions, and the registered msg and query
+services. The app is created on an in-memory database, the node state is not read.`,
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			serverCtx := GetServerContextFromCmd(cmd)
+
+			app := appCreator(serverCtx.Logger, dbm.NewMemDB(), nil, serverCtx.Viper)
+			wiringApp, ok := app.(types.HasAppWiring)
+			if !ok {
+				return fmt.Errorf("the app does not describe its wiring")
+			}
+
+			bz, err := json.MarshalIndent(wiringApp.AppWiring(), "", "  ")
+			if err != nil {
+				return err
+			}
+
+			outputDocument, _ := cmd.Flags().GetString(flags.FlagOutputDocument)
+			if outputDocument == "" {
+				fmt.Fprintln(cmd.OutOrStdout(), string(bz))
+				return nil
+			}
+
+			return os.WriteFile(outputDocument, bz, 0o600)
+		},
+	}
+
+	cmd.Flags().String(flags.FlagHome, defaultNodeHome, "The application home directory")
+	cmd.Flags().String(flags.FlagOutputDocument, "", "Write the wiring to the given file instead of STDOUT")
+
+	return cmd
+}
+
+func appWiringDiffCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "diff [old-wiring-json] [new-wiring-json]",
+		Short: "Compare two dumps of the wiring of the app",
+		Long: `Compare two dumps of the wiring of the app, listing the added (+), removed (-)
+and changed (~) modules, consensus versions, store keys, orders and services.`,
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			oldWiring, err := readAppWiring(args[0])
+			if err != nil {
+				return err
+			}
+
+			newWiring, err := readAppWiring(args[1])
+			if err != nil {
+				return err
+			}
+
+			diff := types.DiffAppWiring(oldWiring, newWiring)
+
+			output, _ := cmd.Flags().GetString(flags.FlagOutput)
+			if output == flags.OutputFormatJSON {
+				bz, err := json.MarshalIndent(diff, "", "  ")
+				if err != nil {
+					return err
+				}
+
+				fmt.Fprintln(cmd.OutOrStdout(), string(bz))
+				return nil
+			}
+
+			if diff.IsEmpty() {
+				fmt.Fprintln(cmd.OutOrStdout(), "no wiring changes")
+				return nil
+			}
+
+			fmt.Fprintln(cmd.OutOrStdout(), diff.String())
+			return nil
+		},
+	}
+
+	cmd.Flags().StringP(flags.FlagOutput, "o", flags.OutputFormatText, "Output format (text|json)")
+
+	return cmd
+}
+
+func readAppWiring(path string) (types.AppWiring, error) {
+	var wiring types.AppWiring
+
+	bz, err := os.ReadFile(path)
+	if err != nil {
+		return wiring, err
+	}
+
+	if err := json.Unmarshal(bz, &wiring); err != nil {
+		return wiring, fmt.Errorf("failed to parse the app wiring %s: %w", path, err)
+	}
+
+	return wiring, nil
+}
@@ -0,0 +1,81 @@
+package server_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/cosmos-sdk/server"
+	servertypes "github.com/cosmos/cosmos-sdk/server/types"
+)
+
+func TestDiffAppWiring(t *testing.T) {
+	oldWiring := servertypes.AppWiring{
+		AppName: "simapp",
+		Modules: []servertypes.ModuleWiring{{Name: "bank", ConsensusVersion: 4}, {Name: "crisis", ConsensusVersion: 2}, {Name: "staking", ConsensusVersion: 4}},
+		StoreKeys: []servertypes.StoreKeyWiring{
+			{Module: "bank", StoreKey: "bank", Access: "kv"},
+			{Module: "crisis", StoreKey: "crisis", Access: "kv"},
+			{Module: "staking", StoreKey: "staking", Access: "kv"},
+		},
+		BeginBlockers: []string{"staking", "bank"},
+		EndBlockers:   []string{"crisis", "staking"},
+		MsgServices:   []string{"/cosmos.bank.v1beta1.MsgSend", "/cosmos.crisis.v1beta1.MsgVerifyInvariant"},
+		QueryServices: []string{"/cosmos.bank.v1beta1.Query/Balance"},
+	}
+
+	require.True(t, servertypes.DiffAppWiring(oldWiring, oldWiring).IsEmpty())
+
+	newWiring := oldWiring
+	newWiring.Modules = []servertypes.ModuleWiring{{Name: "bank", ConsensusVersion: 4}, {Name: "circuit", ConsensusVersion: 1}, {Name: "staking", ConsensusVersion: 5}}
+	newWiring.StoreKeys = []servertypes.StoreKeyWiring{
+		{Module: "bank", StoreKey: "bank", Access: "kv"},
+		{Module: "circuit", StoreKey: "circuit", Access: "kv"},
+		{Module: "staking", StoreKey: "staking", Access: "kv"},
+	}
+	newWiring.EndBlockers = []string{"staking"}
+	newWiring.MsgServices = []string{"/cosmos.bank.v1beta1.MsgSend", "/cosmos.circuit.v1.MsgTripCircuitBreaker"}
+
+	diff := servertypes.DiffAppWiring(oldWiring, newWiring)
+	require.False(t, diff.IsEmpty())
+	require.False(t, diff.AppNameChanged)
+	require.Equal(t, []string{"circuit"}, diff.AddedModules)
+	require.Equal(t, []string{"crisis"}, diff.RemovedModules)
+	require.Equal(t, []servertypes.VersionChange{{Module: "staking", Old: 4, New: 5}}, diff.ConsensusVersions)
+	require.Equal(t, []servertypes.StoreKeyWiring{{Module: "circuit", StoreKey: "circuit", Access: "kv"}}, diff.AddedStoreKeys)
+	require.Equal(t, []servertypes.StoreKeyWiring{{Module: "crisis", StoreKey: "crisis", Access: "kv"}}, diff.RemovedStoreKeys)
+	require.Equal(t, []servertypes.OrderChange{{Name: "end_blockers", Old: []string{"crisis", "staking"}, New: []string{"staking"}}}, diff.OrderChanges)
+	require.Equal(t, []string{"/cosmos.circuit.v1.MsgTripCircuitBreaker"}, diff.AddedMsgServices)
+	require.Equal(t, []string{"/cosmos.crisis.v1beta1.MsgVerifyInvariant"}, diff.RemovedMsgServices)
+	require.Empty(t, diff.AddedQueryServices)
+	require.Empty(t, diff.RemovedQueryServices)
+
+	// the command compares two dumps
+	dir := t.TempDir()
+	writeWiring := func(name string, wiring servertypes.AppWiring) string {
+		bz, err := json.Marshal(wiring)
+		require.NoError(t, err)
+		path := filepath.Join(dir, name)
+		require.NoError(t, os.WriteFile(path, bz, 0o600))
+		return path
+	}
+	oldPath, newPath := writeWiring("old.json", oldWiring), writeWiring("new.json", newWiring)
+
+	cmd := server.AppWiringCmd(nil, dir)
+	out := new(bytes.Buffer)
+	cmd.SetOut(out)
+	cmd.SetArgs([]string{"diff", oldPath, newPath})
+	require.NoError(t, cmd.Execute())
+	require.Equal(t, diff.String()+"\n", out.String())
+	require.Contains(t, out.String(), "+ module circuit")
+	require.Contains(t, out.String(), "~ module staking consensus version 4 -> 5")
+
+	out.Reset()
+	cmd.SetArgs([]string{"diff", oldPath, oldPath})
+	require.NoError(t, cmd.Execute())
+	require.Equal(t, "no wiring changes\n", out.String())
+}
@@ -41,6 +41,7 @@ import (
 	"github.com/cosmos/cosmos-sdk/client/flags"
 	"github.com/cosmos/cosmos-sdk/client/grpc/cmtservice"
 	nodeservice "github.com/cosmos/cosmos-sdk/client/grpc/node"
+	"github.com/cosmos/cosmos-sdk/client/grpc/whatif"
 	"github.com/cosmos/cosmos-sdk/codec"
 	"github.com/cosmos/cosmos-sdk/codec/types"
 	"github.com/cosmos/cosmos-sdk/runtime"
@@ -73,12 +74,18 @@ import (
 	consensus "github.com/cosmos/cosmos-sdk/x/consensus"
 	consensusparamkeeper "github.com/cosmos/cosmos-sdk/x/consensus/keeper"
 	consensusparamtypes "github.com/cosmos/cosmos-sdk/x/consensus/types"
+	"github.com/cosmos/cosmos-sdk/x/council"
+	councilkeeper "github.com/cosmos/cosmos-sdk/x/council/keeper"
+	counciltypes "github.com/cosmos/cosmos-sdk/x/council/types"
 	"github.com/cosmos/cosmos-sdk/x/crisis"
 	crisiskeeper "github.com/cosmos/cosmos-sdk/x/crisis/keeper"
 	crisistypes "github.com/cosmos/cosmos-sdk/x/crisis/types"
 	distr "github.com/cosmos/cosmos-sdk/x/distribution"
 	distrkeeper "github.com/cosmos/cosmos-sdk/x/distribution/keeper"
 	distrtypes "github.com/cosmos/cosmos-sdk/x/distribution/types"
+	"github.com/cosmos/cosmos-sdk/x/featureflag"
+	featureflagkeeper "github.com/cosmos/cosmos-sdk/x/featureflag/keeper"
+	featureflagtypes "github.com/cosmos/cosmos-sdk/x/featureflag/types"
 	"github.com/cosmos/cosmos-sdk/x/genutil"
 	genutiltypes "github.com/cosmos/cosmos-sdk/x/genutil/types"
 	"github.com/cosmos/cosmos-sdk/x/gov"
@@ -97,12 +104,21 @@ import (
 	paramskeeper "github.com/cosmos/cosmos-sdk/x/params/keeper"
 	paramstypes "github.com/cosmos/cosmos-sdk/x/params/types"
 	paramproposal "github.com/cosmos/cosmos-sdk/x/params/types/proposal"
+	"github.com/cosmos/cosmos-sdk/x/payments"
+	paymentskeeper "github.com/cosmos/cosmos-sdk/x/payments/keeper"
+	paymentstypes "github.com/cosmos/cosmos-sdk/x/payments/types"
+	"github.com/cosmos/cosmos-sdk/x/safety"
+	safetykeeper "github.com/cosmos/cosmos-sdk/x/safety/keeper"
+	safetytypes "github.com/cosmos/cosmos-sdk/x/safety/types"
 	"github.com/cosmos/cosmos-sdk/x/slashing"
 	slashingkeeper "github.com/cosmos/cosmos-sdk/x/slashing/keeper"
 	slashingtypes "github.com/cosmos/cosmos-sdk/x/slashing/types"
 	"github.com/cosmos/cosmos-sdk/x/staking"
 	stakingkeeper "github.com/cosmos/cosmos-sdk/x/staking/keeper"
 	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+	"github.com/cosmos/cosmos-sdk/x/tokenfactory"
+	tokenfactorykeeper "github.com/cosmos/cosmos-sdk/x/tokenfactory/keeper"
+	tokenfactorytypes "github.com/cosmos/cosmos-sdk/x/tokenfactory/types"
 )
 
 const appName = "SimApp"
@@ -118,8 +134,12 @@ var (
 		minttypes.ModuleName:           {authtypes.Minter},
 		stakingtypes.BondedPoolName:    {authtypes.Burner, authtypes.Staking},
 		stakingtypes.NotBondedPoolName: {authtypes.Burner, authtypes.Staking},
+		stakingtypes.ModuleName:        {authtypes.Minter, authtypes.Burner},
 		govtypes.ModuleName:            {authtypes.Burner},
 		nft.ModuleName:                 nil,
+		tokenfactorytypes.ModuleName:   {authtypes.Minter, authtypes.Burner},
+		paymentstypes.ModuleName:       nil,
+		banktypes.ModuleName:           {authtypes.Burner},
 	}
 )
 
@@ -137,6 +157,7 @@ type SimApp struct {
 	appCodec          codec.Codec
 	txConfig          client.TxConfig
 	interfaceRegistry types.InterfaceRegistry
+	txIndexer         *authtx.TxIndexer
 
 	// keys to access the substores
 	keys  map[string]*storetypes.KVStoreKey
@@ -159,6 +180,11 @@ type SimApp struct {
 	GroupKeeper           groupkeeper.Keeper
 	NFTKeeper             nftkeeper.Keeper
 	ConsensusParamsKeeper consensusparamkeeper.Keeper
+	FeatureFlagKeeper     featureflagkeeper.Keeper
+	SafetyKeeper          safetykeeper.Keeper
+	CouncilKeeper         councilkeeper.Keeper
+	TokenFactoryKeeper    *tokenfactorykeeper.Keeper
+	PaymentsKeeper        *paymentskeeper.Keeper
 
 	// the module manager
 	ModuleManager      *module.Manager
@@ -235,7 +261,9 @@ func NewSimApp(
 		authtypes.StoreKey, banktypes.StoreKey, stakingtypes.StoreKey, crisistypes.StoreKey,
 		minttypes.StoreKey, distrtypes.StoreKey, slashingtypes.StoreKey,
 		govtypes.StoreKey, paramstypes.StoreKey, consensusparamtypes.StoreKey, upgradetypes.StoreKey, feegrant.StoreKey,
-		evidencetypes.StoreKey, authzkeeper.StoreKey, nftkeeper.StoreKey, group.StoreKey,
+		evidencetypes.StoreKey, authzkeeper.StoreKey, nftkeeper.StoreKey, group.StoreKey, featureflagtypes.StoreKey,
+		safetytypes.StoreKey, counciltypes.StoreKey, tokenfactorytypes.StoreKey,
+		paymentstypes.StoreKey,
 	)
 
 	// register streaming services
@@ -243,7 +271,7 @@ func NewSimApp(
 		panic(err)
 	}
 
-	tkeys := storetypes.NewTransientStoreKeys(paramstypes.TStoreKey)
+	tkeys := storetypes.NewTransientStoreKeys(paramstypes.TStoreKey, govtypes.TStoreKey, crisistypes.TStoreKey)
 	app := &SimApp{
 		BaseApp:           bApp,
 		legacyAmino:       legacyAmino,
@@ -274,25 +302,21 @@ func NewSimApp(
 	app.StakingKeeper = stakingkeeper.NewKeeper(
 		appCodec, keys[stakingtypes.StoreKey], app.AccountKeeper, app.BankKeeper, authtypes.NewModuleAddress(govtypes.ModuleName).String(),
 	)
-	app.MintKeeper = mintkeeper.NewKeeper(appCodec, keys[minttypes.StoreKey], app.StakingKeeper, app.AccountKeeper, app.BankKeeper, authtypes.FeeCollectorName, authtypes.NewModuleAddress(govtypes.ModuleName).String())
-
 	app.DistrKeeper = distrkeeper.NewKeeper(appCodec, runtime.NewKVStoreService(keys[distrtypes.StoreKey]), app.AccountKeeper, app.BankKeeper, app.StakingKeeper, authtypes.FeeCollectorName, authtypes.NewModuleAddress(govtypes.ModuleName).String())
 
+	app.MintKeeper = mintkeeper.NewKeeper(appCodec, keys[minttypes.StoreKey], app.StakingKeeper, app.AccountKeeper, app.BankKeeper, app.DistrKeeper, authtypes.FeeCollectorName, authtypes.NewModuleAddress(govtypes.ModuleName).String())
+
 	app.SlashingKeeper = slashingkeeper.NewKeeper(
 		appCodec, legacyAmino, keys[slashingtypes.StoreKey], app.StakingKeeper, authtypes.NewModuleAddress(govtypes.ModuleName).String(),
 	)
 
 	invCheckPeriod := cast.ToUint(appOpts.Get(server.FlagInvCheckPeriod))
-	app.CrisisKeeper = crisiskeeper.NewKeeper(appCodec, keys[crisistypes.StoreKey], invCheckPeriod,
+	app.CrisisKeeper = crisiskeeper.NewKeeper(appCodec, keys[crisistypes.StoreKey], tkeys[crisistypes.TStoreKey], invCheckPeriod,
 		app.BankKeeper, authtypes.FeeCollectorName, authtypes.NewModuleAddress(govtypes.ModuleName).String(), app.AccountKeeper.GetAddressCodec())
 
-	app.FeeGrantKeeper = feegrantkeeper.NewKeeper(appCodec, runtime.NewKVStoreService(keys[feegrant.StoreKey]), app.AccountKeeper)
+	app.FeatureFlagKeeper = featureflagkeeper.NewKeeper(appCodec, runtime.NewKVStoreService(keys[featureflagtypes.StoreKey]), authtypes.NewModuleAddress(govtypes.ModuleName).String(), runtime.EventService{})
 
-	// register the staking hooks
-	// NOTE: stakingKeeper above is passed by reference, so that it will contain these hooks
-	app.StakingKeeper.SetHooks(
-		stakingtypes.NewMultiStakingHooks(app.DistrKeeper.Hooks(), app.SlashingKeeper.Hooks()),
-	)
+	app.FeeGrantKeeper = feegrantkeeper.NewKeeper(appCodec, runtime.NewKVStoreService(keys[feegrant.StoreKey]), app.AccountKeeper, authtypes.NewModuleAddress(govtypes.ModuleName).String())
 
 	app.AuthzKeeper = authzkeeper.NewKeeper(runtime.NewKVStoreService(keys[authzkeeper.StoreKey]), appCodec, app.MsgServiceRouter(), app.AccountKeeper)
 
@@ -311,6 +335,15 @@ func NewSimApp(
 	homePath := cast.ToString(appOpts.Get(flags.FlagHome))
 	// set the governance module account as the authority for conducting upgrades
 	app.UpgradeKeeper = upgradekeeper.NewKeeper(skipUpgradeHeights, keys[upgradetypes.StoreKey], appCodec, homePath, app.BaseApp, authtypes.NewModuleAddress(govtypes.ModuleName).String())
+	// the what-if simulations must not cross an upgrade
+	app.SetWhatIfGuard(app.UpgradeKeeper.WhatIfGuard)
+
+	// get skipHaltHeights from the app options
+	skipHaltHeights := map[int64]bool{}
+	for _, h := range cast.ToIntSlice(appOpts.Get(server.FlagUnsafeSkipHaltHeights)) {
+		skipHaltHeights[int64(h)] = true
+	}
+	app.SafetyKeeper = safetykeeper.NewKeeper(appCodec, runtime.NewKVStoreService(keys[safetytypes.StoreKey]), authtypes.NewModuleAddress(govtypes.ModuleName).String(), skipHaltHeights, runtime.EventService{})
 
 	// Register the proposal types
 	// Deprecated: Avoid adding new handlers, instead use the new proposal flow
@@ -326,19 +359,48 @@ func NewSimApp(
 		govConfig.MaxMetadataLen = 10000
 	*/
 	govKeeper := govkeeper.NewKeeper(
-		appCodec, runtime.NewKVStoreService(keys[govtypes.StoreKey]), app.AccountKeeper, app.BankKeeper,
+		appCodec, runtime.NewKVStoreService(keys[govtypes.StoreKey]), runtime.NewTransientStoreService(tkeys[govtypes.TStoreKey]), app.AccountKeeper, app.BankKeeper,
 		app.StakingKeeper, app.DistrKeeper, app.MsgServiceRouter(), govConfig, authtypes.NewModuleAddress(govtypes.ModuleName).String(),
 	)
 
 	// Set legacy router for backwards compatibility with gov v1beta1
 	govKeeper.SetLegacyRouter(govRouter)
 
+	// Register the params validators used by atomic batch params update proposals
+	govKeeper.SetParamsValidators(
+		auth.NewParamsValidatorRoute(),
+		bank.NewParamsValidatorRoute(),
+		consensus.NewParamsValidatorRoute(),
+		distr.NewParamsValidatorRoute(),
+		gov.NewParamsValidatorRoute(),
+		mint.NewParamsValidatorRoute(),
+		slashing.NewParamsValidatorRoute(),
+		staking.NewParamsValidatorRoute(),
+	)
+
 	app.GovKeeper = *govKeeper.SetHooks(
 		govtypes.NewMultiGovHooks(
-		// register the governance hooks
+			// register the governance hooks
+			app.SlashingKeeper.GovHooks(),
 		),
 	)
 
+	// register the staking hooks
+	// NOTE: stakingKeeper above is passed by reference, so that it will contain these hooks
+	app.StakingKeeper.SetHooks(
+		stakingtypes.NewMultiStakingHooks(app.DistrKeeper.Hooks(), app.SlashingKeeper.Hooks(), app.GovKeeper.StakingHooks(), stakingkeeper.NewInvariantHooks(app.CrisisKeeper)),
+	)
+	app.StakingKeeper.SetCommunityPoolKeeper(app.DistrKeeper)
+	app.BankKeeper.SetCommunityPoolKeeper(app.DistrKeeper)
+
+	app.CouncilKeeper = councilkeeper.NewKeeper(appCodec, runtime.NewKVStoreService(keys[counciltypes.StoreKey]), app.GovKeeper, authtypes.NewModuleAddress(govtypes.ModuleName).String(), runtime.EventService{})
+	app.BaseApp.SetCircuitBreaker(app.CouncilKeeper)
+
+	app.TokenFactoryKeeper = tokenfactorykeeper.NewKeeper(appCodec, runtime.NewKVStoreService(keys[tokenfactorytypes.StoreKey]), app.AccountKeeper, app.BankKeeper, app.DistrKeeper, authtypes.NewModuleAddress(govtypes.ModuleName).String(), runtime.EventService{})
+	app.BankKeeper.AppendSendRestriction(app.TokenFactoryKeeper.SendRestrictionFn)
+
+	app.PaymentsKeeper = paymentskeeper.NewKeeper(appCodec, runtime.NewKVStoreService(keys[paymentstypes.StoreKey]), app.BankKeeper, app.DistrKeeper, authtypes.NewModuleAddress(govtypes.ModuleName).String(), runtime.EventService{})
+
 	app.NFTKeeper = nftkeeper.NewKeeper(runtime.NewKVStoreService(keys[nftkeeper.StoreKey]), appCodec, app.AccountKeeper, app.BankKeeper)
 
 	// create evidence keeper with router
@@ -378,6 +440,11 @@ func NewSimApp(
 		groupmodule.NewAppModule(appCodec, app.GroupKeeper, app.AccountKeeper, app.BankKeeper, app.interfaceRegistry),
 		nftmodule.NewAppModule(appCodec, app.NFTKeeper, app.AccountKeeper, app.BankKeeper, app.interfaceRegistry),
 		consensus.NewAppModule(appCodec, app.ConsensusParamsKeeper),
+		featureflag.NewAppModule(appCodec, app.FeatureFlagKeeper),
+		safety.NewAppModule(appCodec, app.SafetyKeeper),
+		council.NewAppModule(appCodec, app.CouncilKeeper),
+		tokenfactory.NewAppModule(appCodec, app.TokenFactoryKeeper),
+		payments.NewAppModule(appCodec, app.PaymentsKeeper),
 	)
 
 	// BasicModuleManager defines the module BasicManager is in charge of setting up basic,
@@ -403,6 +470,8 @@ func NewSimApp(
 	// NOTE: staking module is required if HistoricalEntries param > 0
 	app.ModuleManager.SetOrderBeginBlockers(
 		upgradetypes.ModuleName,
+		safetytypes.ModuleName,
+		counciltypes.ModuleName,
 		minttypes.ModuleName,
 		distrtypes.ModuleName,
 		slashingtypes.ModuleName,
@@ -414,10 +483,12 @@ func NewSimApp(
 	app.ModuleManager.SetOrderEndBlockers(
 		crisistypes.ModuleName,
 		govtypes.ModuleName,
+		distrtypes.ModuleName,
 		stakingtypes.ModuleName,
 		genutiltypes.ModuleName,
 		feegrant.ModuleName,
 		group.ModuleName,
+		banktypes.ModuleName,
 	)
 
 	// NOTE: The genutils module must occur after staking so that pools are
@@ -428,7 +499,9 @@ func NewSimApp(
 		distrtypes.ModuleName, stakingtypes.ModuleName, slashingtypes.ModuleName, govtypes.ModuleName,
 		minttypes.ModuleName, crisistypes.ModuleName, genutiltypes.ModuleName, evidencetypes.ModuleName, authz.ModuleName,
 		feegrant.ModuleName, nft.ModuleName, group.ModuleName, paramstypes.ModuleName, upgradetypes.ModuleName,
-		vestingtypes.ModuleName, consensusparamtypes.ModuleName,
+		vestingtypes.ModuleName, consensusparamtypes.ModuleName, featureflagtypes.ModuleName,
+		safetytypes.ModuleName, counciltypes.ModuleName, tokenfactorytypes.ModuleName,
+		paymentstypes.ModuleName,
 	}
 	app.ModuleManager.SetOrderInitGenesis(genesisModuleOrder...)
 	app.ModuleManager.SetOrderExportGenesis(genesisModuleOrder...)
@@ -454,6 +527,7 @@ func NewSimApp(
 		panic(err)
 	}
 	reflectionv1.RegisterReflectionServiceServer(app.GRPCQueryRouter(), reflectionSvc)
+	reflectionv1.RegisterParamsServiceServer(app.GRPCQueryRouter(), runtimeservices.NewParamsService(app.ModuleManager.Modules))
 
 	// add test gRPC service for testing gRPC queries in isolation
 	testdata_pulsar.RegisterQueryServer(app.GRPCQueryRouter(), testdata_pulsar.QueryImpl{})
@@ -516,6 +590,9 @@ func NewSimApp(
 		if err := app.LoadLatestVersion(); err != nil {
 			panic(fmt.Errorf("error loading last version: %w", err))
 		}
+
+		app.registerSupplyChecker(appOpts)
+		app.registerTxIndexer(appOpts)
 	}
 
 	return app
@@ -673,6 +750,9 @@ func (app *SimApp) RegisterAPIRoutes(apiSvr *api.Server, apiConfig config.APICon
 	// Register node gRPC service for grpc-gateway.
 	nodeservice.RegisterGRPCGatewayRoutes(clientCtx, apiSvr.GRPCGatewayRouter)
 
+	// Register what-if gRPC service for grpc-gateway.
+	whatif.RegisterGRPCGatewayRoutes(clientCtx, apiSvr.GRPCGatewayRouter)
+
 	// Register grpc-gateway routes for all modules.
 	app.BasicModuleManager.RegisterGRPCGatewayRoutes(clientCtx, apiSvr.GRPCGatewayRouter)
 
@@ -684,7 +764,7 @@ func (app *SimApp) RegisterAPIRoutes(apiSvr *api.Server, apiConfig config.APICon
 
 // RegisterTxService implements the Application.RegisterTxService method.
 func (app *SimApp) RegisterTxService(clientCtx client.Context) {
-	authtx.RegisterTxService(app.BaseApp.GRPCQueryRouter(), clientCtx, app.BaseApp.Simulate, app.interfaceRegistry)
+	authtx.RegisterTxService(app.BaseApp.GRPCQueryRouter(), clientCtx, app.BaseApp.Simulate, app.interfaceRegistry, authtx.WithTxIndexer(app.txIndexer))
 }
 
 // RegisterTendermintService implements the Application.RegisterTendermintService method.
@@ -699,6 +779,7 @@ func (app *SimApp) RegisterTendermintService(clientCtx client.Context) {
 
 func (app *SimApp) RegisterNodeService(clientCtx client.Context, cfg config.Config) {
 	nodeservice.RegisterNodeService(clientCtx, app.GRPCQueryRouter(), cfg)
+	whatif.RegisterWhatIfService(app.GRPCQueryRouter(), cfg.WhatIf, app.WhatIf, app.whatIfMetrics)
 }
 
 // GetMaccPerms returns a copy of the module account permissions
@@ -19,6 +19,7 @@ import (
 
 	"github.com/cosmos/cosmos-sdk/baseapp"
 	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/grpc/whatif"
 	"github.com/cosmos/cosmos-sdk/codec"
 	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
 	"github.com/cosmos/cosmos-sdk/runtime"
@@ -31,19 +32,25 @@ import (
 	"github.com/cosmos/cosmos-sdk/x/auth"
 	authkeeper "github.com/cosmos/cosmos-sdk/x/auth/keeper"
 	authsims "github.com/cosmos/cosmos-sdk/x/auth/simulation"
+	authtx "github.com/cosmos/cosmos-sdk/x/auth/tx"
 	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 	authzkeeper "github.com/cosmos/cosmos-sdk/x/authz/keeper"
 	bankkeeper "github.com/cosmos/cosmos-sdk/x/bank/keeper"
 	consensuskeeper "github.com/cosmos/cosmos-sdk/x/consensus/keeper"
+	councilkeeper "github.com/cosmos/cosmos-sdk/x/council/keeper"
 	crisiskeeper "github.com/cosmos/cosmos-sdk/x/crisis/keeper"
 	distrkeeper "github.com/cosmos/cosmos-sdk/x/distribution/keeper"
+	featureflagkeeper "github.com/cosmos/cosmos-sdk/x/featureflag/keeper"
 	govkeeper "github.com/cosmos/cosmos-sdk/x/gov/keeper"
 	groupkeeper "github.com/cosmos/cosmos-sdk/x/group/keeper"
 	mintkeeper "github.com/cosmos/cosmos-sdk/x/mint/keeper"
 	paramskeeper "github.com/cosmos/cosmos-sdk/x/params/keeper"
 	paramstypes "github.com/cosmos/cosmos-sdk/x/params/types"
+	paymentskeeper "github.com/cosmos/cosmos-sdk/x/payments/keeper"
+	safetykeeper "github.com/cosmos/cosmos-sdk/x/safety/keeper"
 	slashingkeeper "github.com/cosmos/cosmos-sdk/x/slashing/keeper"
 	stakingkeeper "github.com/cosmos/cosmos-sdk/x/staking/keeper"
+	tokenfactorykeeper "github.com/cosmos/cosmos-sdk/x/tokenfactory/keeper"
 )
 
 // DefaultNodeHome default home directories for the application daemon
@@ -63,6 +70,7 @@ type SimApp struct {
 	appCodec          codec.Codec
 	txConfig          client.TxConfig
 	interfaceRegistry codectypes.InterfaceRegistry
+	txIndexer         *authtx.TxIndexer
 
 	// keepers
 	AccountKeeper         authkeeper.AccountKeeper
@@ -81,6 +89,11 @@ type SimApp struct {
 	GroupKeeper           groupkeeper.Keeper
 	NFTKeeper             nftkeeper.Keeper
 	ConsensusParamsKeeper consensuskeeper.Keeper
+	FeatureFlagKeeper     featureflagkeeper.Keeper
+	SafetyKeeper          safetykeeper.Keeper
+	CouncilKeeper         councilkeeper.Keeper
+	TokenFactoryKeeper    *tokenfactorykeeper.Keeper
+	PaymentsKeeper        *paymentskeeper.Keeper
 
 	// simulation manager
 	sm *module.SimulationManager
@@ -165,6 +178,11 @@ func NewSimApp(
 		&app.GroupKeeper,
 		&app.NFTKeeper,
 		&app.ConsensusParamsKeeper,
+		&app.FeatureFlagKeeper,
+		&app.SafetyKeeper,
+		&app.CouncilKeeper,
+		&app.TokenFactoryKeeper,
+		&app.PaymentsKeeper,
 	); err != nil {
 		panic(err)
 	}
@@ -234,10 +252,18 @@ func NewSimApp(
 	// 	return app.App.InitChainer(ctx, req)
 	// })
 
+	// the what-if simulations must not cross an upgrade
+	app.SetWhatIfGuard(app.UpgradeKeeper.WhatIfGuard)
+
 	if err := app.Load(loadLatest); err != nil {
 		panic(err)
 	}
 
+	if loadLatest {
+		app.registerSupplyChecker(appOpts)
+		app.registerTxIndexer(appOpts)
+	}
+
 	return app
 }
 
@@ -310,12 +336,24 @@ func (app *SimApp) SimulationManager() *module.SimulationManager {
 // API server.
 func (app *SimApp) RegisterAPIRoutes(apiSvr *api.Server, apiConfig config.APIConfig) {
 	app.App.RegisterAPIRoutes(apiSvr, apiConfig)
+	whatif.RegisterGRPCGatewayRoutes(apiSvr.ClientCtx, apiSvr.GRPCGatewayRouter)
 	// register swagger API in app.go so that other applications can override easily
 	if err := server.RegisterSwaggerAPI(apiSvr.ClientCtx, apiSvr.Router, apiConfig.Swagger); err != nil {
 		panic(err)
 	}
 }
 
+// RegisterTxService implements the Application.RegisterTxService method.
+func (app *SimApp) RegisterTxService(clientCtx client.Context) {
+	authtx.RegisterTxService(app.GRPCQueryRouter(), clientCtx, app.Simulate, app.interfaceRegistry, authtx.WithTxIndexer(app.txIndexer))
+}
+
+// RegisterNodeService implements the Application.RegisterNodeService method.
+func (app *SimApp) RegisterNodeService(clientCtx client.Context, cfg config.Config) {
+	app.App.RegisterNodeService(clientCtx, cfg)
+	whatif.RegisterWhatIfService(app.GRPCQueryRouter(), cfg.WhatIf, app.WhatIf, app.whatIfMetrics)
+}
+
 // GetMaccPerms returns a copy of the module account permissions
 //
 // NOTE: This is solely to be used for testing purposes.
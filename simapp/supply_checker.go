@@ -0,0 +1,25 @@
+package simapp
+
+import (
+	cmtproto "github.com/cometbft/cometbft/proto/tendermint/types"
+	"github.com/spf13/cast"
+
+	servertypes "github.com/cosmos/cosmos-sdk/server/types"
+	"github.com/cosmos/cosmos-sdk/x/bank"
+	bankkeeper "github.com/cosmos/cosmos-sdk/x/bank/keeper"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+)
+
+// registerSupplyChecker starts the off-consensus x/bank total supply checker
+// when it is enabled in the app options. It must be called once the latest
+// version has been loaded, as the checker is seeded from the committed state.
+func (app *SimApp) registerSupplyChecker(appOpts servertypes.AppOptions) {
+	if !cast.ToBool(appOpts.Get(bank.FlagSupplyChecker)) {
+		return
+	}
+
+	checker := bankkeeper.NewSupplyChecker(app.Logger(), nil)
+	checker.Seed(app.NewContext(true, cmtproto.Header{Height: app.LastBlockHeight()}), app.BankKeeper)
+	app.RegisterABCIListener(checker, app.GetKey(banktypes.StoreKey))
+	checker.Start()
+}
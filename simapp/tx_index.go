@@ -0,0 +1,33 @@
+package simapp
+
+import (
+	"fmt"
+	"path/filepath"
+
+	dbm "github.com/cosmos/cosmos-db"
+	"github.com/spf13/cast"
+
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/server"
+	servertypes "github.com/cosmos/cosmos-sdk/server/types"
+	"github.com/cosmos/cosmos-sdk/x/auth"
+	authtx "github.com/cosmos/cosmos-sdk/x/auth/tx"
+)
+
+// registerTxIndexer starts indexing the delivered txs in a node-local
+// database when the tx index is enabled in the app options. The index is
+// searched by the SearchTxs method of the tx service.
+func (app *SimApp) registerTxIndexer(appOpts servertypes.AppOptions) {
+	if !cast.ToBool(appOpts.Get(auth.FlagTxIndex)) {
+		return
+	}
+
+	dataDir := filepath.Join(cast.ToString(appOpts.Get(flags.FlagHome)), "data")
+	db, err := dbm.NewDB(authtx.TxIndexDBName, server.GetAppDBBackend(appOpts), dataDir)
+	if err != nil {
+		panic(fmt.Errorf("failed to open the tx index database: %w", err))
+	}
+
+	app.txIndexer = authtx.NewTxIndexer(db, app.txConfig.TxDecoder())
+	app.RegisterABCIListener(app.txIndexer)
+}
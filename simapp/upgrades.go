@@ -6,6 +6,11 @@ import (
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/module"
+	counciltypes "github.com/cosmos/cosmos-sdk/x/council/types"
+	featureflagtypes "github.com/cosmos/cosmos-sdk/x/featureflag/types"
+	paymentstypes "github.com/cosmos/cosmos-sdk/x/payments/types"
+	safetytypes "github.com/cosmos/cosmos-sdk/x/safety/types"
+	tokenfactorytypes "github.com/cosmos/cosmos-sdk/x/tokenfactory/types"
 )
 
 // UpgradeName defines the on-chain upgrade name for the sample SimApp upgrade
@@ -30,7 +35,15 @@ func (app SimApp) RegisterUpgradeHandlers() {
 	}
 
 	if upgradeInfo.Name == UpgradeName && !app.UpgradeKeeper.IsSkipHeight(upgradeInfo.Height) {
-		storeUpgrades := storetypes.StoreUpgrades{}
+		storeUpgrades := storetypes.StoreUpgrades{
+			Added: []string{
+				featureflagtypes.StoreKey,
+				safetytypes.StoreKey,
+				counciltypes.StoreKey,
+				tokenfactorytypes.StoreKey,
+				paymentstypes.StoreKey,
+			},
+		}
 
 		// configure store loader that checks if version == upgradeHeight and applies store upgrades
 		app.SetStoreLoader(upgradetypes.UpgradeStoreLoader(upgradeInfo.Height, &storeUpgrades))
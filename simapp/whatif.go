@@ -0,0 +1,41 @@
+package simapp
+
+import (
+	"github.com/cosmos/cosmos-sdk/client/grpc/whatif"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	distrtypes "github.com/cosmos/cosmos-sdk/x/distribution/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+)
+
+// whatIfPools are the module accounts whose balances are reported by the
+// what-if service.
+var whatIfPools = []string{
+	stakingtypes.BondedPoolName,
+	stakingtypes.NotBondedPoolName,
+	distrtypes.ModuleName,
+	authtypes.FeeCollectorName,
+}
+
+// whatIfMetrics measures the metrics reported by the what-if service: the
+// inflation, the bonded ratio, the community pool and the balances of the
+// pools.
+func (app *SimApp) whatIfMetrics(ctx sdk.Context) ([]whatif.Metric, error) {
+	communityPool, err := app.DistrKeeper.GetFeePoolCommunityCoins(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	metrics := []whatif.Metric{
+		{Name: "inflation", Value: app.MintKeeper.GetMinter(ctx).Inflation.String()},
+		{Name: "bonded_ratio", Value: app.StakingKeeper.BondedRatio(ctx).String()},
+		{Name: "community_pool", Value: communityPool.String()},
+	}
+
+	for _, pool := range whatIfPools {
+		balances := app.BankKeeper.GetAllBalances(ctx, app.AccountKeeper.GetModuleAddress(pool))
+		metrics = append(metrics, whatif.Metric{Name: pool + "_balance", Value: balances.String()})
+	}
+
+	return metrics, nil
+}
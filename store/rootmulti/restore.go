@@ -0,0 +1,122 @@
+package rootmulti
+
+import (
+	"io"
+
+	errorsmod "cosmossdk.io/errors"
+	"cosmossdk.io/store/cachemulti"
+	"cosmossdk.io/store/iavl"
+	"cosmossdk.io/store/listenkv"
+	"cosmossdk.io/store/types"
+)
+
+// startRestore starts tracking the stores restored from a snapshot at the
+// given height.
+func (rs *Store) startRestore(height int64) {
+	rs.restoreMtx.Lock()
+	defer rs.restoreMtx.Unlock()
+
+	rs.restoreHeight = height
+	rs.restoredStores = make(map[string]bool)
+}
+
+// markStoreRestored records that all the nodes of the named store have been
+// imported and committed.
+func (rs *Store) markStoreRestored(name string) {
+	rs.restoreMtx.Lock()
+	defer rs.restoreMtx.Unlock()
+
+	if rs.restoredStores != nil {
+		rs.restoredStores[name] = true
+	}
+}
+
+// endRestore stops tracking the restored stores. It waits for the queries
+// branching the restored stores to be done with the root multi-store.
+func (rs *Store) endRestore() {
+	rs.restoreMtx.Lock()
+	defer rs.restoreMtx.Unlock()
+
+	rs.restoreHeight = 0
+	rs.restoredStores = nil
+}
+
+// CacheMultiStoreRestored branches the stores fully restored so far by the
+// snapshot restoration in progress, at the height of the snapshot, so that
+// they can be queried before the restoration completes. Accessing a store
+// which is not restored yet panics with ErrStoreNotRestored.
+//
+// It returns false if no restoration is in progress, or if no store has been
+// fully restored yet.
+func (rs *Store) CacheMultiStoreRestored() (types.CacheMultiStore, int64, bool) {
+	rs.restoreMtx.RLock()
+	defer rs.restoreMtx.RUnlock()
+
+	if len(rs.restoredStores) == 0 {
+		return nil, 0, false
+	}
+
+	cachedStores := make(map[types.StoreKey]types.CacheWrapper)
+	for key, store := range rs.stores {
+		var cacheStore types.KVStore
+		switch store.GetStoreType() {
+		case types.StoreTypeIAVL:
+			if !rs.restoredStores[key.Name()] {
+				cacheStore = unrestoredStore{name: key.Name()}
+				break
+			}
+
+			immutable, err := rs.GetCommitKVStore(key).(*iavl.Store).GetImmutable(rs.restoreHeight)
+			if err != nil {
+				rs.logger.Error("failed to load restored store", "store", key.Name(), "height", rs.restoreHeight, "err", err)
+				cacheStore = unrestoredStore{name: key.Name()}
+				break
+			}
+			cacheStore = immutable
+
+		default:
+			cacheStore = store
+		}
+
+		if rs.ListeningEnabled(key) {
+			cacheStore = listenkv.NewStore(cacheStore, key, rs.listeners[key])
+		}
+
+		cachedStores[key] = cacheStore
+	}
+
+	return cachemulti.NewStore(rs.db, cachedStores, rs.keysByName, rs.traceWriter, rs.getTracingContext()), rs.restoreHeight, true
+}
+
+var _ types.KVStore = unrestoredStore{}
+
+// unrestoredStore stands for a store which is not restored yet by the snapshot
+// restoration in progress. All its accesses panic with ErrStoreNotRestored.
+type unrestoredStore struct {
+	name string
+}
+
+func (s unrestoredStore) panic() {
+	panic(errorsmod.Wrapf(types.ErrStoreNotRestored, "store %s is not restored yet", s.name))
+}
+
+func (s unrestoredStore) GetStoreType() types.StoreType { return types.StoreTypeIAVL }
+
+func (s unrestoredStore) CacheWrap() types.CacheWrap { s.panic(); return nil }
+
+func (s unrestoredStore) CacheWrapWithTrace(io.Writer, types.TraceContext) types.CacheWrap {
+	s.panic()
+	return nil
+}
+
+func (s unrestoredStore) Get([]byte) []byte { s.panic(); return nil }
+
+func (s unrestoredStore) Has([]byte) bool { s.panic(); return false }
+
+func (s unrestoredStore) Set([]byte, []byte) { s.panic() }
+
+func (s unrestoredStore) Delete([]byte) { s.panic() }
+
+func (s unrestoredStore) Iterator(_, _ []byte) types.Iterator { s.panic(); return nil }
+
+func (s unrestoredStore) ReverseIterator(_, _ []byte) types.Iterator { s.panic(); return nil }
@@ -11,6 +11,8 @@ import (
 	"testing"
 
 	"cosmossdk.io/log"
+	protoio "github.com/cosmos/gogoproto/io"
+	"github.com/cosmos/gogoproto/proto"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 
@@ -225,6 +227,80 @@ func TestMultistoreSnapshotRestore(t *testing.T) {
 	}
 }
 
+// restoreHookReader calls hook before returning the first node of the store
+// named after.
+type restoreHookReader struct {
+	protoio.Reader
+	after string
+	hook  func()
+
+	store string
+}
+
+func (r *restoreHookReader) ReadMsg(msg proto.Message) error {
+	if err := r.Reader.ReadMsg(msg); err != nil {
+		return err
+	}
+	switch item := msg.(*snapshottypes.SnapshotItem).Item.(type) {
+	case *snapshottypes.SnapshotItem_Store:
+		r.store = item.Store.Name
+	case *snapshottypes.SnapshotItem_IAVL:
+		if r.store == r.after && r.hook != nil {
+			r.hook()
+			r.hook = nil
+		}
+	}
+	return nil
+}
+
+func TestMultistoreSnapshotRestore_QueryRestored(t *testing.T) {
+	source := newMultiStoreWithMixedMountsAndBasicData(dbm.NewMemDB())
+	target := newMultiStoreWithMixedMounts(dbm.NewMemDB())
+	version := uint64(source.LastCommitID().Version)
+
+	_, _, ok := target.CacheMultiStoreRestored()
+	require.False(t, ok)
+
+	chunks := make(chan io.ReadCloser, 100)
+	go func() {
+		streamWriter := snapshots.NewStreamWriter(chunks)
+		require.NotNil(t, streamWriter)
+		defer streamWriter.Close()
+		err := source.Snapshot(version, streamWriter)
+		require.NoError(t, err)
+	}()
+
+	streamReader, err := snapshots.NewStreamReader(chunks)
+	require.NoError(t, err)
+
+	queried := false
+	reader := &restoreHookReader{Reader: streamReader, after: "iavl2", hook: func() {
+		queried = true
+
+		cms, height, ok := target.CacheMultiStoreRestored()
+		require.True(t, ok)
+		require.EqualValues(t, version, height)
+
+		// iavl1 is fully restored while iavl2 is being imported
+		sourceStore1 := source.GetStoreByName("iavl1").(types.KVStore)
+		store1 := cms.GetKVStore(target.StoreKeysByName()["iavl1"])
+		require.Equal(t, sourceStore1.Get([]byte("a")), store1.Get([]byte("a")))
+		require.Equal(t, sourceStore1.Get([]byte("b")), store1.Get([]byte("b")))
+
+		store2 := cms.GetKVStore(target.StoreKeysByName()["iavl2"])
+		require.PanicsWithError(t, "store iavl2 is not restored yet: store not restored", func() {
+			store2.Get([]byte("X"))
+		})
+	}}
+
+	_, err = target.Restore(version, snapshottypes.CurrentFormat, reader)
+	require.NoError(t, err)
+	require.True(t, queried)
+
+	_, _, ok = target.CacheMultiStoreRestored()
+	require.False(t, ok)
+}
+
 func benchmarkMultistoreSnapshot(b *testing.B, stores uint8, storeKeys uint64) {
 	b.Skip("Noisy with slow setup time, please see https://github.com/cosmos/cosmos-sdk/issues/8855.")
 
@@ -75,6 +75,12 @@ type Store struct {
 	listeners           map[types.StoreKey]*types.MemoryListener
 	metrics             metrics.StoreMetrics
 	commitHeader        cmtproto.Header
+
+	// restoreMtx guards the progress of the snapshot restoration in progress,
+	// which is read by queries concurrently with the restoration.
+	restoreMtx     sync.RWMutex
+	restoreHeight  int64
+	restoredStores map[string]bool
 }
 
 var (
@@ -921,7 +927,11 @@ func (rs *Store) Restore(
 	// a SnapshotStoreItem, telling us which store to import into. The following items will contain
 	// SnapshotNodeItem (i.e. ExportNode) until we reach the next SnapshotStoreItem or EOF.
 	var importer *iavltree.Importer
+	var importerStore string
 	var snapshotItem snapshottypes.SnapshotItem
+
+	rs.startRestore(int64(height))
+	defer rs.endRestore()
 loop:
 	for {
 		snapshotItem = snapshottypes.SnapshotItem{}
@@ -940,6 +950,7 @@ loop:
 					return snapshottypes.SnapshotItem{}, errorsmod.Wrap(err, "IAVL commit failed")
 				}
 				importer.Close()
+				rs.markStoreRestored(importerStore)
 			}
 			store, ok := rs.GetStoreByName(item.Store.Name).(*iavl.Store)
 			if !ok || store == nil {
@@ -949,6 +960,7 @@ loop:
 			if err != nil {
 				return snapshottypes.SnapshotItem{}, errorsmod.Wrap(err, "import failed")
 			}
+			importerStore = item.Store.Name
 			defer importer.Close()
 			// Importer height must reflect the node height (which usually matches the block height, but not always)
 			rs.logger.Debug("restoring snapshot", "store", item.Store.Name)
@@ -994,6 +1006,10 @@ loop:
 		importer.Close()
 	}
 
+	// the stores are reloaded below, so the partially restored state must not
+	// be queried anymore
+	rs.endRestore()
+
 	rs.flushMetadata(rs.db, int64(height), rs.buildCommitInfo(int64(height)))
 	return snapshotItem, rs.LoadLatestVersion()
 }
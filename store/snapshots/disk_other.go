@@ -0,0 +1,9 @@
+//go:build !linux && !darwin && !freebsd
+
+package snapshots
+
+// freeDiskRatio is not supported on this platform, so that disk pressure is
+// ignored by the adaptive snapshot interval.
+func freeDiskRatio(string) (float64, error) {
+	return 0, errDiskUsageUnsupported
+}
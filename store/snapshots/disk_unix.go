@@ -0,0 +1,20 @@
+//go:build linux || darwin || freebsd
+
+package snapshots
+
+import "syscall"
+
+// freeDiskRatio returns the ratio of free disk space of the file system
+// containing the given directory.
+func freeDiskRatio(dir string) (float64, error) {
+	var stat syscall.Statfs_t
+	if err := syscall.Statfs(dir, &stat); err != nil {
+		return 0, err
+	}
+
+	if stat.Blocks == 0 {
+		return 0, errDiskUsageUnsupported
+	}
+
+	return float64(stat.Bavail) / float64(stat.Blocks), nil
+}
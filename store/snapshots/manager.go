@@ -9,8 +9,11 @@ import (
 	"math"
 	"sort"
 	"sync"
+	"sync/atomic"
+	"time"
 
 	"cosmossdk.io/log"
+	"github.com/armon/go-metrics"
 
 	errorsmod "cosmossdk.io/errors"
 	"cosmossdk.io/store/snapshots/types"
@@ -45,6 +48,28 @@ type Manager struct {
 	chRestoreDone      <-chan restoreDone
 	restoreChunkHashes [][]byte
 	restoreChunkIndex  uint32
+
+	// restoreProgress is the progress of the restore operation in progress, if
+	// any. It is kept apart from the mutex-guarded fields, which stay locked
+	// while the final chunk is being restored, so that it can always be read.
+	restoreProgress atomic.Pointer[RestoreProgress]
+
+	// interval is the current snapshot interval, which adapts within the
+	// bounds of the options when the adaptive snapshot interval is enabled.
+	interval atomic.Uint64
+	// lastSnapshotSize is the size in bytes of the last snapshot taken, from
+	// which the rate at which the state changes is estimated.
+	lastSnapshotSize atomic.Uint64
+}
+
+// RestoreProgress describes the progress of a snapshot restoration.
+type RestoreProgress struct {
+	// Height is the height of the snapshot being restored.
+	Height uint64
+	// ChunksApplied is the number of chunks fed to the restoration so far.
+	ChunksApplied uint32
+	// Chunks is the total number of chunks of the snapshot.
+	Chunks uint32
 }
 
 // operation represents a Manager operation. Only one operation can be in progress at a time.
@@ -69,18 +94,25 @@ const (
 
 var ErrOptsZeroSnapshotInterval = errors.New("snaphot-interval must not be 0")
 
+// errDiskUsageUnsupported is returned when the free disk space cannot be
+// determined.
+var errDiskUsageUnsupported = errors.New("disk usage is not supported")
+
 // NewManager creates a new manager.
 func NewManager(store *Store, opts types.SnapshotOptions, multistore types.Snapshotter, extensions map[string]types.ExtensionSnapshotter, logger log.Logger) *Manager {
 	if extensions == nil {
 		extensions = map[string]types.ExtensionSnapshotter{}
 	}
-	return &Manager{
+	m := &Manager{
 		store:      store,
 		opts:       opts,
 		multistore: multistore,
 		extensions: extensions,
 		logger:     logger,
 	}
+	m.interval.Store(opts.Interval)
+
+	return m
 }
 
 // RegisterExtensions register extension snapshotters to manager
@@ -137,11 +169,13 @@ func (m *Manager) endLocked() {
 	m.chRestoreDone = nil
 	m.restoreChunkHashes = nil
 	m.restoreChunkIndex = 0
+	m.restoreProgress.Store(nil)
 }
 
-// GetInterval returns snapshot interval represented in heights.
+// GetInterval returns snapshot interval represented in heights. When the
+// adaptive snapshot interval is enabled, it is the current interval.
 func (m *Manager) GetInterval() uint64 {
-	return m.opts.Interval
+	return m.interval.Load()
 }
 
 // GetKeepRecent returns snapshot keep-recent represented in heights.
@@ -154,7 +188,7 @@ func (m *Manager) GetKeepRecent() uint32 {
 // available for state sync nodes to catch up (oldest because a node may be
 // restoring an old snapshot while a new snapshot was taken).
 func (m *Manager) GetSnapshotBlockRetentionHeights() int64 {
-	return int64(m.opts.Interval * uint64(m.opts.KeepRecent))
+	return int64(m.opts.LongestInterval() * uint64(m.opts.KeepRecent))
 }
 
 // Create creates a snapshot and returns its metadata.
@@ -307,6 +341,7 @@ func (m *Manager) Restore(snapshot types.Snapshot) error {
 	m.chRestoreDone = chDone
 	m.restoreChunkHashes = snapshot.Metadata.ChunkHashes
 	m.restoreChunkIndex = 0
+	m.restoreProgress.Store(&RestoreProgress{Height: snapshot.Height, Chunks: snapshot.Chunks})
 	return nil
 }
 
@@ -401,6 +436,13 @@ func (m *Manager) RestoreChunk(chunk []byte) (bool, error) {
 	// Pass the chunk to the restore, and wait for completion if it was the final one.
 	m.chRestore <- io.NopCloser(bytes.NewReader(chunk))
 	m.restoreChunkIndex++
+	if progress := m.restoreProgress.Load(); progress != nil {
+		m.restoreProgress.Store(&RestoreProgress{
+			Height:        progress.Height,
+			ChunksApplied: m.restoreChunkIndex,
+			Chunks:        progress.Chunks,
+		})
+	}
 
 	if int(m.restoreChunkIndex) >= len(m.restoreChunkHashes) {
 		close(m.chRestore)
@@ -418,6 +460,18 @@ func (m *Manager) RestoreChunk(chunk []byte) (bool, error) {
 	return false, nil
 }
 
+// RestoreProgress returns the progress of the snapshot restoration in progress.
+// It returns false if no restoration is in progress. It can be concurrent with
+// other operations.
+func (m *Manager) RestoreProgress() (RestoreProgress, bool) {
+	progress := m.restoreProgress.Load()
+	if progress == nil {
+		return RestoreProgress{}, false
+	}
+
+	return *progress, true
+}
+
 // sortedExtensionNames sort extension names for deterministic iteration.
 func (m *Manager) sortedExtensionNames() []string {
 	names := make([]string, 0, len(m.extensions))
@@ -447,6 +501,12 @@ func (m *Manager) SnapshotIfApplicable(height int64) {
 	}
 	if !m.shouldTakeSnapshot(height) {
 		m.logger.Debug("snapshot is skipped", "height", height)
+
+		// the heights of the base interval are kept from pruning for the
+		// snapshots, so they are released when skipped by the adaptive interval
+		if m.opts.IsAdaptive() && height > 0 && uint64(height)%m.opts.Interval == 0 {
+			m.multistore.PruneSnapshotHeight(height)
+		}
 		return
 	}
 	m.snapshot(height)
@@ -454,7 +514,8 @@ func (m *Manager) SnapshotIfApplicable(height int64) {
 
 // shouldTakeSnapshot returns true is snapshot should be taken at height.
 func (m *Manager) shouldTakeSnapshot(height int64) bool {
-	return m.opts.Interval > 0 && uint64(height)%m.opts.Interval == 0
+	interval := m.interval.Load()
+	return interval > 0 && uint64(height)%interval == 0
 }
 
 func (m *Manager) snapshot(height int64) {
@@ -465,6 +526,7 @@ func (m *Manager) snapshot(height int64) {
 		return
 	}
 
+	start := time.Now()
 	snapshot, err := m.Create(uint64(height))
 	if err != nil {
 		m.logger.Error("failed to create state snapshot", "height", height, "err", err)
@@ -472,6 +534,7 @@ func (m *Manager) snapshot(height int64) {
 	}
 
 	m.logger.Info("completed state snapshot", "height", height, "format", snapshot.Format)
+	m.recordSnapshot(snapshot, start)
 
 	if m.opts.KeepRecent > 0 {
 		m.logger.Debug("pruning state snapshots")
@@ -485,3 +548,36 @@ func (m *Manager) snapshot(height int64) {
 		m.logger.Debug("pruned state snapshots", "pruned", pruned)
 	}
 }
+
+// recordSnapshot emits the metrics of a completed snapshot and adapts the
+// snapshot interval, if enabled, to its size and to the free disk space.
+func (m *Manager) recordSnapshot(snapshot *types.Snapshot, start time.Time) {
+	metrics.MeasureSince([]string{"store", "snapshot", "duration"}, start)
+	metrics.SetGauge([]string{"store", "snapshot", "chunks"}, float32(snapshot.Chunks))
+
+	size, err := m.store.Size(snapshot.Height, snapshot.Format)
+	if err != nil {
+		m.logger.Error("failed to get state snapshot size", "height", snapshot.Height, "err", err)
+		return
+	}
+	metrics.SetGauge([]string{"store", "snapshot", "size"}, float32(size))
+
+	if !m.opts.IsAdaptive() {
+		return
+	}
+
+	freeRatio, err := freeDiskRatio(m.store.dir)
+	if err != nil {
+		m.logger.Debug("failed to get free disk space of the snapshot directory", "err", err)
+		freeRatio = -1
+	}
+
+	current := m.interval.Load()
+	next := m.opts.AdaptInterval(current, m.lastSnapshotSize.Swap(size), size, freeRatio)
+	m.interval.Store(next)
+	metrics.SetGauge([]string{"store", "snapshot", "interval"}, float32(next))
+
+	if next != current {
+		m.logger.Info("adapted state snapshot interval", "interval", next, "previous", current, "size", size, "free_disk_ratio", freeRatio)
+	}
+}
@@ -4,6 +4,7 @@ import (
 	"errors"
 	"testing"
 
+	db "github.com/cosmos/cosmos-db"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 
@@ -185,6 +186,10 @@ func TestManager_Restore(t *testing.T) {
 	})
 	require.NoError(t, err)
 
+	progress, restoring := manager.RestoreProgress()
+	require.True(t, restoring)
+	require.Equal(t, snapshots.RestoreProgress{Height: 3, ChunksApplied: 0, Chunks: 1}, progress)
+
 	// While the restore is in progress, any other operations fail
 	_, err = manager.Create(4)
 	require.Error(t, err)
@@ -213,6 +218,9 @@ func TestManager_Restore(t *testing.T) {
 	assert.Equal(t, expectItems, target.items)
 	assert.Equal(t, 10, len(extSnapshotter.state))
 
+	_, restoring = manager.RestoreProgress()
+	require.False(t, restoring)
+
 	// Starting a new restore should fail now, because the target already has contents.
 	err = manager.Restore(types.Snapshot{
 		Height:   3,
@@ -236,3 +244,70 @@ func TestManager_Restore(t *testing.T) {
 	})
 	require.NoError(t, err)
 }
+
+func TestSnapshotOptions_AdaptInterval(t *testing.T) {
+	adaptive := types.NewAdaptiveSnapshotOptions(100, 800, 2, 0.1)
+	require.True(t, adaptive.IsAdaptive())
+	require.Equal(t, uint64(800), adaptive.LongestInterval())
+	require.False(t, opts.IsAdaptive())
+	require.Equal(t, opts.Interval, opts.LongestInterval())
+
+	testCases := []struct {
+		name          string
+		current       uint64
+		prevSize      uint64
+		size          uint64
+		freeDiskRatio float64
+		expected      uint64
+	}{
+		{"first snapshot", 200, 0, 1000, 0.5, 200},
+		{"high change rate", 200, 1000, 1300, 0.5, 100},
+		{"high change rate at the lower bound", 100, 1000, 1300, 0.5, 100},
+		{"moderate change rate", 200, 1000, 1050, 0.5, 200},
+		{"low change rate", 200, 1000, 1001, 0.5, 400},
+		{"low change rate at the upper bound", 800, 1000, 1001, 0.5, 800},
+		{"disk pressure", 100, 1000, 1300, 0.05, 800},
+		{"unknown free disk space", 200, 1000, 1001, -1, 400},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			require.Equal(t, tc.expected, adaptive.AdaptInterval(tc.current, tc.prevSize, tc.size, tc.freeDiskRatio))
+		})
+	}
+
+	// the interval is not adapted when the adaptive interval is disabled
+	require.Equal(t, opts.Interval, opts.AdaptInterval(opts.Interval, 1000, 1001, 0.5))
+}
+
+func TestManager_AdaptiveInterval(t *testing.T) {
+	store, err := snapshots.NewStore(db.NewMemDB(), t.TempDir())
+	require.NoError(t, err)
+	snapshotter := &mockSnapshotter{
+		items:         [][]byte{{1, 2, 3}},
+		prunedHeights: make(map[int64]struct{}),
+	}
+	adaptive := types.NewAdaptiveSnapshotOptions(10, 40, 0, 0)
+	manager := snapshots.NewManager(store, adaptive, snapshotter, nil, log.NewNopLogger())
+	require.Equal(t, uint64(10), manager.GetInterval())
+	require.Equal(t, int64(0), manager.GetSnapshotBlockRetentionHeights())
+
+	// the state does not change, so the interval is doubled after each
+	// snapshot but the first one
+	manager.SnapshotIfApplicable(10)
+	require.Equal(t, uint64(10), manager.GetInterval())
+	manager.SnapshotIfApplicable(20)
+	require.Equal(t, uint64(20), manager.GetInterval())
+
+	// the heights of the base interval skipped are released for pruning
+	manager.SnapshotIfApplicable(30)
+	_, didPruneHeight := snapshotter.prunedHeights[30]
+	require.True(t, didPruneHeight)
+
+	manager.SnapshotIfApplicable(40)
+	require.Equal(t, uint64(40), manager.GetInterval())
+
+	snapshotList, err := manager.List()
+	require.NoError(t, err)
+	require.Len(t, snapshotList, 3)
+}
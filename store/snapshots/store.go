@@ -285,6 +285,28 @@ func (s *Store) Save(
 	return snapshot, s.saveSnapshot(snapshot)
 }
 
+// Size returns the total size in bytes of the chunks of a snapshot.
+func (s *Store) Size(height uint64, format uint32) (uint64, error) {
+	snapshot, err := s.Get(height, format)
+	if err != nil {
+		return 0, err
+	}
+	if snapshot == nil {
+		return 0, errors.Wrapf(storetypes.ErrLogic, "snapshot not found at height %v format %v", height, format)
+	}
+
+	size := uint64(0)
+	for i := uint32(0); i < snapshot.Chunks; i++ {
+		info, err := os.Stat(s.pathChunk(height, format, i))
+		if err != nil {
+			return 0, errors.Wrapf(err, "failed to stat snapshot chunk %d", i)
+		}
+		size += uint64(info.Size())
+	}
+
+	return size, nil
+}
+
 // saveChunk saves the given chunkBody with the given index to its appropriate path on disk.
 // The hash of the chunk is appended to the snapshot's metadata,
 // and the overall snapshot hash is updated with the chunk content too.
@@ -1,5 +1,17 @@
 package types
 
+import "math"
+
+const (
+	// HighSnapshotChangeRatio is the relative change of the snapshot size per
+	// base interval above which an adaptive snapshot interval is halved.
+	HighSnapshotChangeRatio = 0.1
+
+	// LowSnapshotChangeRatio is the relative change of the snapshot size per
+	// base interval below which an adaptive snapshot interval is doubled.
+	LowSnapshotChangeRatio = 0.01
+)
+
 // SnapshotOptions defines the snapshot strategy used when determining which
 // heights are snapshotted for state sync.
 type SnapshotOptions struct {
@@ -8,6 +20,17 @@ type SnapshotOptions struct {
 
 	// KeepRecent defines how many snapshots to keep in heights.
 	KeepRecent uint32
+
+	// MaxInterval enables the adaptive snapshot interval when greater than
+	// Interval. The snapshots are then taken every multiple of Interval up to
+	// MaxInterval, depending on the rate at which the state changes and on
+	// the free disk space.
+	MaxInterval uint64
+
+	// MinFreeDiskRatio is the ratio of free disk space of the snapshot
+	// directory below which an adaptive snapshot interval is set to
+	// MaxInterval.
+	MinFreeDiskRatio float64
 }
 
 func NewSnapshotOptions(interval uint64, keepRecent uint32) SnapshotOptions {
@@ -16,3 +39,73 @@ func NewSnapshotOptions(interval uint64, keepRecent uint32) SnapshotOptions {
 		KeepRecent: keepRecent,
 	}
 }
+
+// NewAdaptiveSnapshotOptions returns the options of a snapshot interval
+// adapting between interval and maxInterval.
+func NewAdaptiveSnapshotOptions(interval, maxInterval uint64, keepRecent uint32, minFreeDiskRatio float64) SnapshotOptions {
+	return SnapshotOptions{
+		Interval:         interval,
+		KeepRecent:       keepRecent,
+		MaxInterval:      maxInterval,
+		MinFreeDiskRatio: minFreeDiskRatio,
+	}
+}
+
+// IsAdaptive returns true if the snapshot interval adapts between Interval
+// and MaxInterval.
+func (o SnapshotOptions) IsAdaptive() bool {
+	return o.Interval > 0 && o.MaxInterval > o.Interval
+}
+
+// LongestInterval returns the longest interval between two snapshots.
+func (o SnapshotOptions) LongestInterval() uint64 {
+	if o.IsAdaptive() {
+		return o.MaxInterval
+	}
+
+	return o.Interval
+}
+
+// AdaptInterval returns the snapshot interval following a snapshot taken with
+// the current interval, given the sizes in bytes of the previous snapshot,
+// zero if none, and of the new one, and the ratio of free disk space of the
+// snapshot directory, negative if unknown.
+//
+// The interval is set to MaxInterval under disk pressure. Otherwise, the rate
+// at which the state changes is estimated from the relative change of the
+// snapshot size per base Interval: the interval is halved when the rate is
+// above HighSnapshotChangeRatio, and doubled when it is below
+// LowSnapshotChangeRatio. The result is always a multiple of Interval within
+// the bounds of the options.
+func (o SnapshotOptions) AdaptInterval(current, prevSize, size uint64, freeDiskRatio float64) uint64 {
+	if !o.IsAdaptive() {
+		return o.Interval
+	}
+
+	next := current
+	switch {
+	case freeDiskRatio >= 0 && freeDiskRatio < o.MinFreeDiskRatio:
+		next = o.MaxInterval
+
+	case prevSize > 0 && current > 0:
+		change := math.Abs(float64(size)-float64(prevSize)) / float64(prevSize)
+		rate := change * float64(o.Interval) / float64(current)
+
+		switch {
+		case rate > HighSnapshotChangeRatio:
+			next = current / 2
+		case rate < LowSnapshotChangeRatio:
+			next = current * 2
+		}
+	}
+
+	if next > o.MaxInterval {
+		next = o.MaxInterval
+	}
+	next -= next % o.Interval
+	if next < o.Interval {
+		next = o.Interval
+	}
+
+	return next
+}
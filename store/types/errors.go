@@ -26,6 +26,9 @@ var (
 	// ErrInvalidRequest defines an ABCI typed error where the request contains
 	// invalid data.
 	ErrInvalidRequest = errors.Register(StoreCodespace, 7, "invalid request")
+	// ErrStoreNotRestored is returned when accessing a store which is not
+	// restored yet by the state sync snapshot restoration in progress.
+	ErrStoreNotRestored = errors.Register(StoreCodespace, 8, "store not restored")
 )
 
 // ABCI QueryResult
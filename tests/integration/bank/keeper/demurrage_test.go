@@ -0,0 +1,83 @@
+package keeper_test
+
+import (
+	"testing"
+
+	cmtproto "github.com/cometbft/cometbft/proto/tendermint/types"
+	"gotest.tools/v3/assert"
+
+	"cosmossdk.io/math"
+	"cosmossdk.io/simapp"
+
+	simtestutil "github.com/cosmos/cosmos-sdk/testutil/sims"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	"github.com/cosmos/cosmos-sdk/x/bank/keeper"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	govkeeper "github.com/cosmos/cosmos-sdk/x/gov/keeper"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+	stakingkeeper "github.com/cosmos/cosmos-sdk/x/staking/keeper"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+)
+
+func TestDemurrageModuleAccounts(t *testing.T) {
+	app := simapp.Setup(t, false)
+	ctx := app.BaseApp.NewContext(false, cmtproto.Header{Height: 1})
+
+	bondDenom := app.StakingKeeper.BondDenom(ctx)
+	addrs := simtestutil.AddTestAddrsIncremental(app.BankKeeper, app.StakingKeeper, ctx, 2, math.NewInt(30000000))
+
+	// a tenth of the balances of the bond denom decay at the end of each epoch
+	// of 10 blocks
+	_, err := app.BankKeeper.SetDemurrageSchedule(ctx, bondDenom, math.LegacyNewDecWithPrec(1, 1), 10)
+	assert.NilError(t, err)
+
+	validators := app.StakingKeeper.GetAllValidators(ctx)
+	assert.Assert(t, len(validators) > 0)
+	_, err = app.StakingKeeper.Delegate(ctx, addrs[0], math.NewInt(10000000), stakingtypes.Unbonded, validators[0], true)
+	assert.NilError(t, err)
+
+	govAddr := authtypes.NewModuleAddress(govtypes.ModuleName)
+	msgs := []sdk.Msg{banktypes.NewMsgSend(govAddr, addrs[1], sdk.NewCoins(sdk.NewCoin(bondDenom, math.NewInt(1000))))}
+	proposal, err := app.GovKeeper.SubmitProposal(ctx, msgs, "", "title", "summary", addrs[1], false)
+	assert.NilError(t, err)
+	_, err = app.GovKeeper.AddDeposit(ctx, proposal.Id, addrs[1], sdk.NewCoins(sdk.NewCoin(bondDenom, math.NewInt(5000000))))
+	assert.NilError(t, err)
+
+	bondedPool := app.StakingKeeper.GetBondedPool(ctx).GetAddress()
+	bondedBalance := app.BankKeeper.GetBalance(ctx, bondedPool, bondDenom)
+	govBalance := app.BankKeeper.GetBalance(ctx, govAddr, bondDenom)
+
+	invariants := []sdk.Invariant{
+		keeper.TotalSupply(app.BankKeeper),
+		stakingkeeper.ModuleAccountInvariants(app.StakingKeeper),
+		govkeeper.ModuleAccountInvariant(app.GovKeeper, app.BankKeeper),
+	}
+	checkInvariants := func() {
+		for _, invariant := range invariants {
+			msg, broken := invariant(ctx)
+			assert.Assert(t, !broken, msg)
+		}
+	}
+
+	for height := int64(10); height <= 50; height += 10 {
+		assert.NilError(t, app.BankKeeper.AdvanceDemurrageEpochs(ctx.WithBlockHeight(height)))
+		checkInvariants()
+
+		// the decay of the delegator is settled, and burnt, while the coins
+		// escrowed by the staking and gov module accounts don't decay
+		assert.NilError(t, app.BankKeeper.SendCoins(ctx, addrs[0], addrs[1], sdk.NewCoins(sdk.NewCoin(bondDenom, math.NewInt(1)))))
+		checkInvariants()
+
+		assert.Assert(t, bondedBalance.IsEqual(app.BankKeeper.GetBalance(ctx, bondedPool, bondDenom)))
+		assert.Assert(t, govBalance.IsEqual(app.BankKeeper.GetBalance(ctx, govAddr, bondDenom)))
+	}
+
+	// the delegator's remaining 20000000 decayed by a tenth over each of the 5
+	// epochs, less the coin sent after each of them
+	expected := math.NewInt(20000000)
+	for i := 0; i < 5; i++ {
+		expected = math.LegacyNewDecFromInt(expected).Mul(math.LegacyNewDecWithPrec(9, 1)).TruncateInt().SubRaw(1)
+	}
+	assert.Equal(t, expected.String(), app.BankKeeper.GetBalance(ctx, addrs[0], bondDenom).Amount.String())
+}
@@ -1,12 +1,16 @@
 package keeper_test
 
 import (
+	"context"
 	"testing"
 
+	"cosmossdk.io/math"
 	"gotest.tools/v3/assert"
 
 	simtestutil "github.com/cosmos/cosmos-sdk/testutil/sims"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/gov/keeper"
+	"github.com/cosmos/cosmos-sdk/x/gov/types"
 	v1 "github.com/cosmos/cosmos-sdk/x/gov/types/v1"
 	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
 )
@@ -501,3 +505,600 @@ func TestTallyValidatorMultipleDelegations(t *testing.T) {
 
 	assert.Assert(t, tallyResults.Equals(expectedTallyResult))
 }
+
+func TestTallyValidatorVoteBreakdown(t *testing.T) {
+	t.Parallel()
+	f := initFixture(t)
+
+	app, ctx := f.app, f.ctx
+
+	addrs, valAddrs := createValidators(t, ctx, app, []int64{5, 6, 7})
+
+	delTokens := app.StakingKeeper.TokensFromConsensusPower(ctx, 30)
+	val1, found := app.StakingKeeper.GetValidator(ctx, valAddrs[0])
+	assert.Assert(t, found)
+
+	_, err := app.StakingKeeper.Delegate(ctx, addrs[4], delTokens, stakingtypes.Unbonded, val1, true)
+	assert.NilError(t, err)
+
+	app.StakingKeeper.EndBlocker(ctx)
+
+	tp := TestProposal
+	proposal, err := app.GovKeeper.SubmitProposal(ctx, tp, "", "test", "description", addrs[0], false)
+	assert.NilError(t, err)
+	proposalID := proposal.Id
+	proposal.Status = v1.StatusVotingPeriod
+	app.GovKeeper.SetProposal(ctx, proposal)
+
+	assert.NilError(t, app.GovKeeper.AddVote(ctx, proposalID, addrs[1], v1.NewNonSplitVoteOption(v1.OptionYes), ""))
+	assert.NilError(t, app.GovKeeper.AddVote(ctx, proposalID, addrs[4], v1.NewNonSplitVoteOption(v1.OptionNo), ""))
+
+	res, err := f.queryClient.ValidatorVoteBreakdowns(ctx, &v1.QueryValidatorVoteBreakdownsRequest{ProposalId: proposalID})
+	assert.NilError(t, err)
+	assert.Equal(t, len(res.Breakdowns), 3)
+
+	for _, breakdown := range res.Breakdowns {
+		switch breakdown.ValidatorAddress {
+		case valAddrs[0].String():
+			// val1 did not vote, its delegator overrode with its own vote
+			assert.Equal(t, len(breakdown.Options), 0)
+			assert.Equal(t, breakdown.InheritedPower, app.StakingKeeper.TokensFromConsensusPower(ctx, 5).String())
+			assert.Equal(t, breakdown.OverriddenPower, delTokens.String())
+		case valAddrs[1].String():
+			assert.DeepEqual(t, breakdown.Options, v1.NewNonSplitVoteOption(v1.OptionYes))
+			assert.Equal(t, breakdown.InheritedPower, app.StakingKeeper.TokensFromConsensusPower(ctx, 6).String())
+			assert.Equal(t, breakdown.OverriddenPower, "0")
+		case valAddrs[2].String():
+			assert.Equal(t, len(breakdown.Options), 0)
+			assert.Equal(t, breakdown.InheritedPower, app.StakingKeeper.TokensFromConsensusPower(ctx, 7).String())
+			assert.Equal(t, breakdown.OverriddenPower, "0")
+		default:
+			t.Fatalf("unexpected validator %s", breakdown.ValidatorAddress)
+		}
+	}
+
+	// the query must not delete the votes
+	_, err = app.GovKeeper.GetVote(ctx, proposalID, addrs[4])
+	assert.NilError(t, err)
+
+	// the final tally records the breakdown and emits events for the
+	// validators taking part in it
+	ctx = ctx.WithEventManager(sdk.NewEventManager())
+	proposal, ok := app.GovKeeper.GetProposal(ctx, proposalID)
+	assert.Assert(t, ok)
+	_, _, _, err = app.GovKeeper.Tally(ctx, proposal)
+	assert.NilError(t, err)
+
+	breakdowns, err := app.GovKeeper.GetValidatorVoteBreakdowns(ctx, proposalID)
+	assert.NilError(t, err)
+	assert.Equal(t, len(breakdowns), 3)
+
+	var events int
+	for _, event := range ctx.EventManager().Events() {
+		if event.Type == types.EventTypeValidatorVoteBreakdown {
+			events++
+		}
+	}
+	assert.Equal(t, events, 2)
+}
+
+func TestTallyParticipationExemption(t *testing.T) {
+	t.Parallel()
+	f := initFixture(t)
+
+	app, ctx := f.app, f.ctx
+
+	addrs, valAddrs := createValidators(t, ctx, app, []int64{5, 6, 7})
+
+	// exempt the validators with less voting power than the second one
+	totalBonded := app.StakingKeeper.TotalBondedTokens(ctx)
+	threshold := math.LegacyNewDecFromInt(app.StakingKeeper.TokensFromConsensusPower(ctx, 6)).Quo(math.LegacyNewDecFromInt(totalBonded))
+	params, err := app.GovKeeper.GetParams(ctx)
+	assert.NilError(t, err)
+	params.ParticipationExemptionThreshold = threshold.String()
+	assert.NilError(t, app.GovKeeper.SetParams(ctx, params))
+
+	res, err := f.queryClient.ParticipationExemptValidators(ctx, &v1.QueryParticipationExemptValidatorsRequest{})
+	assert.NilError(t, err)
+	assert.Equal(t, res.Threshold, threshold.String())
+	assert.DeepEqual(t, res.ValidatorAddresses, []string{valAddrs[0].String()})
+
+	tp := TestProposal
+	proposal, err := app.GovKeeper.SubmitProposal(ctx, tp, "", "test", "description", addrs[0], false)
+	assert.NilError(t, err)
+	proposalID := proposal.Id
+	proposal.Status = v1.StatusVotingPeriod
+	app.GovKeeper.SetProposal(ctx, proposal)
+
+	assert.NilError(t, app.GovKeeper.AddVote(ctx, proposalID, addrs[0], v1.NewNonSplitVoteOption(v1.OptionYes), ""))
+	assert.NilError(t, app.GovKeeper.AddVote(ctx, proposalID, addrs[1], v1.NewNonSplitVoteOption(v1.OptionYes), ""))
+
+	ctx = ctx.WithEventManager(sdk.NewEventManager())
+	proposal, ok := app.GovKeeper.GetProposal(ctx, proposalID)
+	assert.Assert(t, ok)
+	_, _, tallyResults, err := app.GovKeeper.Tally(ctx, proposal)
+	assert.NilError(t, err)
+
+	// the vote of the exempt validator still counts
+	assert.Equal(t, tallyResults.YesCount, app.StakingKeeper.TokensFromConsensusPower(ctx, 11).String())
+
+	breakdowns, err := app.GovKeeper.GetValidatorVoteBreakdowns(ctx, proposalID)
+	assert.NilError(t, err)
+	for _, breakdown := range breakdowns {
+		assert.Equal(t, breakdown.ParticipationExempt, breakdown.ValidatorAddress == valAddrs[0].String())
+	}
+
+	// no event tracks the participation of the exempt validator
+	var events int
+	for _, event := range ctx.EventManager().Events() {
+		if event.Type == types.EventTypeValidatorVoteBreakdown {
+			events++
+		}
+	}
+	assert.Equal(t, events, 1)
+}
+
+func TestTallyAbstainSemantics(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name        string
+		powers      []int64
+		votes       []v1.VoteOption
+		semantics   v1.AbstainSemantics
+		expPasses   bool
+		expBurnVeto bool
+	}{
+		// 6 abstain, 6 no, 7 yes: Yes has a majority of the non-abstaining votes only
+		{"unspecified: yes majority of non-abstaining", []int64{6, 6, 7}, []v1.VoteOption{v1.OptionAbstain, v1.OptionNo, v1.OptionYes}, v1.AbstainSemanticsUnspecified, true, false},
+		{"quorum only: yes majority of non-abstaining", []int64{6, 6, 7}, []v1.VoteOption{v1.OptionAbstain, v1.OptionNo, v1.OptionYes}, v1.AbstainSemanticsQuorumOnly, true, false},
+		{"threshold: yes majority of non-abstaining", []int64{6, 6, 7}, []v1.VoteOption{v1.OptionAbstain, v1.OptionNo, v1.OptionYes}, v1.AbstainSemanticsThreshold, false, false},
+		{"ignored: yes majority of non-abstaining", []int64{6, 6, 7}, []v1.VoteOption{v1.OptionAbstain, v1.OptionNo, v1.OptionYes}, v1.AbstainSemanticsIgnored, true, false},
+		// 6 abstain, 2 yes out of 20: the quorum is only reached with the Abstain votes
+		{"unspecified: quorum reached with abstain", []int64{6, 2, 12}, []v1.VoteOption{v1.OptionAbstain, v1.OptionYes, v1.OptionEmpty}, v1.AbstainSemanticsUnspecified, true, false},
+		{"quorum only: quorum reached with abstain", []int64{6, 2, 12}, []v1.VoteOption{v1.OptionAbstain, v1.OptionYes, v1.OptionEmpty}, v1.AbstainSemanticsQuorumOnly, true, false},
+		{"threshold: quorum reached with abstain", []int64{6, 2, 12}, []v1.VoteOption{v1.OptionAbstain, v1.OptionYes, v1.OptionEmpty}, v1.AbstainSemanticsThreshold, false, false},
+		{"ignored: quorum reached with abstain", []int64{6, 2, 12}, []v1.VoteOption{v1.OptionAbstain, v1.OptionYes, v1.OptionEmpty}, v1.AbstainSemanticsIgnored, false, false},
+		// 6 abstain, 3 veto, 5 yes: the veto threshold is only exceeded without the Abstain votes
+		{"unspecified: veto diluted by abstain", []int64{6, 3, 5}, []v1.VoteOption{v1.OptionAbstain, v1.OptionNoWithVeto, v1.OptionYes}, v1.AbstainSemanticsUnspecified, true, false},
+		{"quorum only: veto diluted by abstain", []int64{6, 3, 5}, []v1.VoteOption{v1.OptionAbstain, v1.OptionNoWithVeto, v1.OptionYes}, v1.AbstainSemanticsQuorumOnly, true, false},
+		{"threshold: veto diluted by abstain", []int64{6, 3, 5}, []v1.VoteOption{v1.OptionAbstain, v1.OptionNoWithVeto, v1.OptionYes}, v1.AbstainSemanticsThreshold, false, false},
+		{"ignored: veto diluted by abstain", []int64{6, 3, 5}, []v1.VoteOption{v1.OptionAbstain, v1.OptionNoWithVeto, v1.OptionYes}, v1.AbstainSemanticsIgnored, false, true},
+		// everyone abstains
+		{"unspecified: all abstain", []int64{5, 5, 5}, []v1.VoteOption{v1.OptionAbstain, v1.OptionAbstain, v1.OptionAbstain}, v1.AbstainSemanticsUnspecified, false, false},
+		{"quorum only: all abstain", []int64{5, 5, 5}, []v1.VoteOption{v1.OptionAbstain, v1.OptionAbstain, v1.OptionAbstain}, v1.AbstainSemanticsQuorumOnly, false, false},
+		{"threshold: all abstain", []int64{5, 5, 5}, []v1.VoteOption{v1.OptionAbstain, v1.OptionAbstain, v1.OptionAbstain}, v1.AbstainSemanticsThreshold, false, false},
+		{"ignored: all abstain", []int64{5, 5, 5}, []v1.VoteOption{v1.OptionAbstain, v1.OptionAbstain, v1.OptionAbstain}, v1.AbstainSemanticsIgnored, false, false},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			f := initFixture(t)
+
+			app, ctx := f.app, f.ctx
+
+			params, err := app.GovKeeper.GetParams(ctx)
+			assert.NilError(t, err)
+			params.AbstainSemantics = tc.semantics
+			assert.NilError(t, app.GovKeeper.SetParams(ctx, params))
+
+			valAccAddrs, _ := createValidators(t, ctx, app, tc.powers)
+
+			tp := TestProposal
+			proposal, err := app.GovKeeper.SubmitProposal(ctx, tp, "", "test", "description", valAccAddrs[0], false)
+			assert.NilError(t, err)
+			proposalID := proposal.Id
+			proposal.Status = v1.StatusVotingPeriod
+			app.GovKeeper.SetProposal(ctx, proposal)
+
+			for i, option := range tc.votes {
+				if option == v1.OptionEmpty {
+					continue
+				}
+				assert.NilError(t, app.GovKeeper.AddVote(ctx, proposalID, valAccAddrs[i], v1.NewNonSplitVoteOption(option), ""))
+			}
+
+			proposal, ok := app.GovKeeper.GetProposal(ctx, proposalID)
+			assert.Assert(t, ok)
+			passes, burnDeposits, _, err := app.GovKeeper.Tally(ctx, proposal)
+			assert.NilError(t, err)
+
+			assert.Equal(t, tc.expPasses, passes)
+			assert.Equal(t, tc.expBurnVeto, burnDeposits)
+		})
+	}
+}
+
+func TestTallyVetoQuorum(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name        string
+		vetoQuorum  string
+		kindQuorums []v1.ProposalKindQuorum
+		expPasses   bool
+		expBurnVeto bool
+	}{
+		// 3 veto, 4 yes out of 20: the participation is 35%
+		{"veto counts without veto quorum", "0", nil, false, true},
+		{"veto counts above veto quorum", "0.3", nil, false, true},
+		{"veto counts as no below veto quorum", "0.5", nil, true, false},
+		{
+			"veto quorum of the proposal kind", "0",
+			[]v1.ProposalKindQuorum{{Kind: string(v1.ProposalKindStandard), VetoQuorum: "0.5"}},
+			true, false,
+		},
+		{
+			"veto quorum of another proposal kind", "0",
+			[]v1.ProposalKindQuorum{{Kind: string(v1.ProposalKindExpedited), VetoQuorum: "0.5"}},
+			false, true,
+		},
+		{
+			"quorum of the proposal kind", "0",
+			[]v1.ProposalKindQuorum{{Kind: string(v1.ProposalKindStandard), Quorum: "0.5"}},
+			false, false,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			f := initFixture(t)
+
+			app, ctx := f.app, f.ctx
+
+			params, err := app.GovKeeper.GetParams(ctx)
+			assert.NilError(t, err)
+			params.VetoQuorum = tc.vetoQuorum
+			params.ProposalKindQuorums = tc.kindQuorums
+			assert.NilError(t, app.GovKeeper.SetParams(ctx, params))
+
+			valAccAddrs, _ := createValidators(t, ctx, app, []int64{3, 4, 13})
+
+			tp := TestProposal
+			proposal, err := app.GovKeeper.SubmitProposal(ctx, tp, "", "test", "description", valAccAddrs[0], false)
+			assert.NilError(t, err)
+			proposalID := proposal.Id
+			proposal.Status = v1.StatusVotingPeriod
+			app.GovKeeper.SetProposal(ctx, proposal)
+
+			assert.NilError(t, app.GovKeeper.AddVote(ctx, proposalID, valAccAddrs[0], v1.NewNonSplitVoteOption(v1.OptionNoWithVeto), ""))
+			assert.NilError(t, app.GovKeeper.AddVote(ctx, proposalID, valAccAddrs[1], v1.NewNonSplitVoteOption(v1.OptionYes), ""))
+
+			proposal, ok := app.GovKeeper.GetProposal(ctx, proposalID)
+			assert.Assert(t, ok)
+			passes, burnDeposits, _, err := app.GovKeeper.Tally(ctx, proposal)
+			assert.NilError(t, err)
+
+			assert.Equal(t, tc.expPasses, passes)
+			assert.Equal(t, tc.expBurnVeto, burnDeposits)
+		})
+	}
+}
+
+func TestIsTallyDecided(t *testing.T) {
+	t.Parallel()
+	f := initFixture(t)
+
+	app, ctx := f.app, f.ctx
+
+	valAccAddrs, _ := createValidators(t, ctx, app, []int64{10, 1, 1})
+
+	tp := TestProposal
+	proposal, err := app.GovKeeper.SubmitProposal(ctx, tp, "", "test", "description", valAccAddrs[0], false)
+	assert.NilError(t, err)
+	proposalID := proposal.Id
+	proposal.Status = v1.StatusVotingPeriod
+	app.GovKeeper.SetProposal(ctx, proposal)
+
+	// the quorum is not reached yet
+	decided, err := app.GovKeeper.IsTallyDecided(ctx, proposal)
+	assert.NilError(t, err)
+	assert.Assert(t, decided == false)
+
+	// all the voting power votes Yes, and the proposal would pass
+	for _, addr := range valAccAddrs {
+		assert.NilError(t, app.GovKeeper.AddVote(ctx, proposalID, addr, v1.NewNonSplitVoteOption(v1.OptionYes), ""))
+	}
+
+	cacheCtx, _ := ctx.CacheContext()
+	passes, _, _, err := app.GovKeeper.Tally(cacheCtx, proposal)
+	assert.NilError(t, err)
+	assert.Assert(t, passes)
+
+	// but the proposal is not decided, as the cast votes can still change
+	decided, err = app.GovKeeper.IsTallyDecided(ctx, proposal)
+	assert.NilError(t, err)
+	assert.Assert(t, decided == false)
+
+	// a revote flips the result
+	assert.NilError(t, app.GovKeeper.AddVote(ctx, proposalID, valAccAddrs[0], v1.NewNonSplitVoteOption(v1.OptionNo), ""))
+
+	cacheCtx, _ = ctx.CacheContext()
+	passes, _, _, err = app.GovKeeper.Tally(cacheCtx, proposal)
+	assert.NilError(t, err)
+	assert.Assert(t, passes == false)
+
+	decided, err = app.GovKeeper.IsTallyDecided(ctx, proposal)
+	assert.NilError(t, err)
+	assert.Assert(t, decided == false)
+
+	// the proposal is decided once it cannot pass whatever the votes
+	params, err := app.GovKeeper.GetParams(ctx)
+	assert.NilError(t, err)
+	params.Threshold = math.LegacyOneDec().String()
+	assert.NilError(t, app.GovKeeper.SetParams(ctx, params))
+
+	decided, err = app.GovKeeper.IsTallyDecided(ctx, proposal)
+	assert.NilError(t, err)
+	assert.Assert(t, decided)
+
+	// the votes are kept for the final tally
+	passes, burnDeposits, _, err := app.GovKeeper.Tally(ctx, proposal)
+	assert.NilError(t, err)
+	assert.Assert(t, passes == false)
+	assert.Assert(t, burnDeposits == false)
+}
+
+func TestTallyDelegationChangedAfterVote(t *testing.T) {
+	t.Parallel()
+	f := initFixture(t)
+
+	app, ctx := f.app, f.ctx
+
+	addrs, valAddrs := createValidators(t, ctx, app, []int64{5, 6, 7})
+	app.StakingKeeper.SetHooks(stakingtypes.NewMultiStakingHooks(app.GovKeeper.StakingHooks()))
+
+	tp := TestProposal
+	proposal, err := app.GovKeeper.SubmitProposal(ctx, tp, "", "test", "description", addrs[0], false)
+	assert.NilError(t, err)
+	proposalID := proposal.Id
+	proposal.Status = v1.StatusVotingPeriod
+	app.GovKeeper.SetProposal(ctx, proposal)
+
+	assert.NilError(t, app.GovKeeper.AddVote(ctx, proposalID, addrs[1], v1.NewNonSplitVoteOption(v1.OptionNo), ""))
+	assert.NilError(t, app.GovKeeper.AddVote(ctx, proposalID, addrs[2], v1.NewNonSplitVoteOption(v1.OptionYes), ""))
+	// addrs[4] has no voting power yet
+	assert.NilError(t, app.GovKeeper.AddVote(ctx, proposalID, addrs[4], v1.NewNonSplitVoteOption(v1.OptionNo), ""))
+
+	// the delegation made after the vote counts towards the tally
+	delTokens := app.StakingKeeper.TokensFromConsensusPower(ctx, 30)
+	val1, found := app.StakingKeeper.GetValidator(ctx, valAddrs[0])
+	assert.Assert(t, found)
+
+	_, err = app.StakingKeeper.Delegate(ctx, addrs[4], delTokens, stakingtypes.Unbonded, val1, true)
+	assert.NilError(t, err)
+
+	app.StakingKeeper.EndBlocker(ctx)
+
+	cacheCtx, _ := ctx.CacheContext()
+	passes, _, tallyResults, err := app.GovKeeper.Tally(cacheCtx, proposal)
+	assert.NilError(t, err)
+	assert.Assert(t, passes == false)
+	assert.Equal(t, tallyResults.YesCount, app.StakingKeeper.TokensFromConsensusPower(ctx, 7).String())
+	assert.Equal(t, tallyResults.NoCount, app.StakingKeeper.TokensFromConsensusPower(ctx, 36).String())
+
+	// as does the undelegation
+	_, _, err = app.StakingKeeper.Undelegate(ctx, addrs[4], valAddrs[0], sdk.NewDecFromInt(delTokens))
+	assert.NilError(t, err)
+
+	app.StakingKeeper.EndBlocker(ctx)
+
+	passes, _, tallyResults, err = app.GovKeeper.Tally(ctx, proposal)
+	assert.NilError(t, err)
+	assert.Assert(t, passes)
+	assert.Equal(t, tallyResults.YesCount, app.StakingKeeper.TokensFromConsensusPower(ctx, 7).String())
+	assert.Equal(t, tallyResults.NoCount, app.StakingKeeper.TokensFromConsensusPower(ctx, 6).String())
+}
+
+func TestTallyVoteChanged(t *testing.T) {
+	t.Parallel()
+	f := initFixture(t)
+
+	app, ctx := f.app, f.ctx
+
+	addrs, valAddrs := createValidators(t, ctx, app, []int64{5, 6, 7})
+
+	delTokens := app.StakingKeeper.TokensFromConsensusPower(ctx, 30)
+	val1, found := app.StakingKeeper.GetValidator(ctx, valAddrs[0])
+	assert.Assert(t, found)
+
+	_, err := app.StakingKeeper.Delegate(ctx, addrs[4], delTokens, stakingtypes.Unbonded, val1, true)
+	assert.NilError(t, err)
+
+	app.StakingKeeper.EndBlocker(ctx)
+
+	tp := TestProposal
+	proposal, err := app.GovKeeper.SubmitProposal(ctx, tp, "", "test", "description", addrs[0], false)
+	assert.NilError(t, err)
+	proposalID := proposal.Id
+	proposal.Status = v1.StatusVotingPeriod
+	app.GovKeeper.SetProposal(ctx, proposal)
+
+	assert.NilError(t, app.GovKeeper.AddVote(ctx, proposalID, addrs[1], v1.NewNonSplitVoteOption(v1.OptionNo), ""))
+	assert.NilError(t, app.GovKeeper.AddVote(ctx, proposalID, addrs[2], v1.NewNonSplitVoteOption(v1.OptionYes), ""))
+	assert.NilError(t, app.GovKeeper.AddVote(ctx, proposalID, addrs[4], v1.NewNonSplitVoteOption(v1.OptionNo), ""))
+
+	// only the last vote of addrs[4] counts towards the tally
+	assert.NilError(t, app.GovKeeper.AddVote(ctx, proposalID, addrs[4], v1.NewNonSplitVoteOption(v1.OptionYes), ""))
+
+	passes, _, tallyResults, err := app.GovKeeper.Tally(ctx, proposal)
+	assert.NilError(t, err)
+	assert.Assert(t, passes)
+	assert.Equal(t, tallyResults.YesCount, app.StakingKeeper.TokensFromConsensusPower(ctx, 37).String())
+	assert.Equal(t, tallyResults.NoCount, app.StakingKeeper.TokensFromConsensusPower(ctx, 6).String())
+}
+
+func TestTallyVoteRetracted(t *testing.T) {
+	t.Parallel()
+	f := initFixture(t)
+
+	app, ctx := f.app, f.ctx
+
+	addrs, valAddrs := createValidators(t, ctx, app, []int64{5, 6, 7})
+
+	delTokens := app.StakingKeeper.TokensFromConsensusPower(ctx, 30)
+	val3, found := app.StakingKeeper.GetValidator(ctx, valAddrs[2])
+	assert.Assert(t, found)
+
+	_, err := app.StakingKeeper.Delegate(ctx, addrs[4], delTokens, stakingtypes.Unbonded, val3, true)
+	assert.NilError(t, err)
+
+	app.StakingKeeper.EndBlocker(ctx)
+
+	tp := TestProposal
+	proposal, err := app.GovKeeper.SubmitProposal(ctx, tp, "", "test", "description", addrs[0], false)
+	assert.NilError(t, err)
+	proposalID := proposal.Id
+
+	// votes can only be retracted in the voting period
+	assert.ErrorIs(t, app.GovKeeper.RetractVote(ctx, proposalID, addrs[4]), types.ErrInactiveProposal)
+
+	proposal.Status = v1.StatusVotingPeriod
+	app.GovKeeper.SetProposal(ctx, proposal)
+
+	assert.ErrorIs(t, app.GovKeeper.RetractVote(ctx, proposalID, addrs[4]), types.ErrVoteNotFound)
+
+	assert.NilError(t, app.GovKeeper.AddVote(ctx, proposalID, addrs[0], v1.NewNonSplitVoteOption(v1.OptionNo), ""))
+	assert.NilError(t, app.GovKeeper.AddVote(ctx, proposalID, addrs[1], v1.NewNonSplitVoteOption(v1.OptionNo), ""))
+	assert.NilError(t, app.GovKeeper.AddVote(ctx, proposalID, addrs[2], v1.NewNonSplitVoteOption(v1.OptionYes), ""))
+	assert.NilError(t, app.GovKeeper.AddVote(ctx, proposalID, addrs[4], v1.NewNonSplitVoteOption(v1.OptionNo), ""))
+
+	// addrs[4] inherits again the vote of its validator
+	assert.NilError(t, app.GovKeeper.RetractVote(ctx, proposalID, addrs[4]))
+	_, err = app.GovKeeper.GetVote(ctx, proposalID, addrs[4])
+	assert.ErrorIs(t, err, types.ErrVoteNotFound)
+
+	passes, _, tallyResults, err := app.GovKeeper.Tally(ctx, proposal)
+	assert.NilError(t, err)
+	assert.Assert(t, passes)
+	assert.Equal(t, tallyResults.YesCount, app.StakingKeeper.TokensFromConsensusPower(ctx, 37).String())
+	assert.Equal(t, tallyResults.NoCount, app.StakingKeeper.TokensFromConsensusPower(ctx, 11).String())
+}
+
+func TestTallyHandlers(t *testing.T) {
+	t.Parallel()
+	f := initFixture(t)
+
+	app, ctx := f.app, f.ctx
+
+	valAccAddrs, _ := createValidators(t, ctx, app, []int64{10, 1, 1})
+
+	// a simple majority of the voting power which voted, without quorum
+	var gotVotes v1.TallyVotes
+	app.GovKeeper.SetTallyHandlers(v1.TallyHandlerRoute{
+		Kind: v1.ProposalKindStandard,
+		Handler: func(_ context.Context, _ v1.Proposal, _ v1.Params, votes v1.TallyVotes) (bool, bool, error) {
+			gotVotes = votes
+			return votes.Results[v1.OptionYes].GT(votes.Results[v1.OptionNo]), false, nil
+		},
+	})
+
+	tp := TestProposal
+	proposal, err := app.GovKeeper.SubmitProposal(ctx, tp, "", "test", "description", valAccAddrs[0], false)
+	assert.NilError(t, err)
+	proposal.Status = v1.StatusVotingPeriod
+	app.GovKeeper.SetProposal(ctx, proposal)
+	assert.Equal(t, v1.ProposalKindStandard, proposal.Kind())
+
+	// the quorum is not reached, which would make the default tally fail
+	assert.NilError(t, app.GovKeeper.AddVote(ctx, proposal.Id, valAccAddrs[1], v1.NewNonSplitVoteOption(v1.OptionYes), ""))
+
+	// proposals with a tally handler are never decided early
+	decided, err := app.GovKeeper.IsTallyDecided(ctx, proposal)
+	assert.NilError(t, err)
+	assert.Assert(t, decided == false)
+
+	passes, burnDeposits, tallyResults, err := app.GovKeeper.Tally(ctx, proposal)
+	assert.NilError(t, err)
+	assert.Assert(t, passes)
+	assert.Assert(t, burnDeposits == false)
+	assert.Assert(t, tallyResults.Equals(v1.NewTallyResultFromMap(gotVotes.Results)))
+	assert.Assert(t, gotVotes.TotalVotingPower.Equal(gotVotes.Results[v1.OptionYes]))
+	assert.Assert(t, gotVotes.TotalBonded.Equal(app.StakingKeeper.TotalBondedTokens(ctx)))
+
+	// expedited proposals still use the default tally
+	proposal, err = app.GovKeeper.SubmitProposal(ctx, tp, "", "test", "description", valAccAddrs[0], true)
+	assert.NilError(t, err)
+	proposal.Status = v1.StatusVotingPeriod
+	app.GovKeeper.SetProposal(ctx, proposal)
+	assert.Equal(t, v1.ProposalKindExpedited, proposal.Kind())
+
+	assert.NilError(t, app.GovKeeper.AddVote(ctx, proposal.Id, valAccAddrs[1], v1.NewNonSplitVoteOption(v1.OptionYes), ""))
+
+	passes, _, _, err = app.GovKeeper.Tally(ctx, proposal)
+	assert.NilError(t, err)
+	assert.Assert(t, passes == false)
+
+	// registering a second handler for the same kind panics
+	assert.Assert(t, func() (panicked bool) {
+		defer func() { panicked = recover() != nil }()
+		app.GovKeeper.SetTallyHandlers(v1.TallyHandlerRoute{Kind: v1.ProposalKindStandard, Handler: keeper.DefaultTally})
+		return false
+	}())
+}
+
+func TestVotedSharesInvariant(t *testing.T) {
+	t.Parallel()
+	f := initFixture(t)
+
+	app, ctx := f.app, f.ctx
+
+	addrs, valAddrs := createValidators(t, ctx, app, []int64{5, 6, 7})
+
+	tp := TestProposal
+	proposal, err := app.GovKeeper.SubmitProposal(ctx, tp, "", "test", "description", addrs[0], false)
+	assert.NilError(t, err)
+	proposalID := proposal.Id
+	proposal.Status = v1.StatusVotingPeriod
+	app.GovKeeper.SetProposal(ctx, proposal)
+
+	assert.NilError(t, app.GovKeeper.AddVote(ctx, proposalID, addrs[1], v1.NewNonSplitVoteOption(v1.OptionNo), ""))
+	assert.NilError(t, app.GovKeeper.AddVote(ctx, proposalID, addrs[4], v1.NewNonSplitVoteOption(v1.OptionYes), ""))
+
+	invariant := keeper.VotedSharesInvariant(app.GovKeeper)
+	_, broken := invariant(ctx)
+	assert.Assert(t, !broken)
+
+	// without the gov staking hooks, the delegation made after the vote is
+	// missing from the voted shares
+	delTokens := app.StakingKeeper.TokensFromConsensusPower(ctx, 30)
+	val1, found := app.StakingKeeper.GetValidator(ctx, valAddrs[0])
+	assert.Assert(t, found)
+
+	_, err = app.StakingKeeper.Delegate(ctx, addrs[4], delTokens, stakingtypes.Unbonded, val1, true)
+	assert.NilError(t, err)
+
+	_, broken = invariant(ctx)
+	assert.Assert(t, broken)
+
+	// with the hooks, the voted shares are kept up to date
+	assert.NilError(t, app.GovKeeper.RebuildVotedShares(ctx, proposalID))
+	app.StakingKeeper.SetHooks(stakingtypes.NewMultiStakingHooks(app.GovKeeper.StakingHooks()))
+
+	val1, found = app.StakingKeeper.GetValidator(ctx, valAddrs[0])
+	assert.Assert(t, found)
+
+	_, err = app.StakingKeeper.Delegate(ctx, addrs[4], delTokens, stakingtypes.Unbonded, val1, true)
+	assert.NilError(t, err)
+
+	// the delegations of the delegators who did not vote don't change the
+	// voted shares
+	_, err = app.StakingKeeper.Delegate(ctx, addrs[3], delTokens, stakingtypes.Unbonded, val1, true)
+	assert.NilError(t, err)
+
+	_, broken = invariant(ctx)
+	assert.Assert(t, !broken)
+
+	// deleting the votes removes them from the per voter index
+	assert.NilError(t, app.GovKeeper.RetractVote(ctx, proposalID, addrs[4]))
+
+	_, err = app.StakingKeeper.Delegate(ctx, addrs[4], delTokens, stakingtypes.Unbonded, val1, true)
+	assert.NilError(t, err)
+
+	_, broken = invariant(ctx)
+	assert.Assert(t, !broken)
+}
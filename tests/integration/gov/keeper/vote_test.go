@@ -0,0 +1,64 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"gotest.tools/v3/assert"
+
+	sdkmath "cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	v1 "github.com/cosmos/cosmos-sdk/x/gov/types/v1"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+)
+
+func TestVoteTypedEvent(t *testing.T) {
+	t.Parallel()
+	f := initFixture(t)
+
+	app, ctx := f.app, f.ctx
+
+	addrs, valAddrs := createValidators(t, ctx, app, []int64{5, 6, 7})
+
+	delTokens := app.StakingKeeper.TokensFromConsensusPower(ctx, 30)
+	val1, found := app.StakingKeeper.GetValidator(ctx, valAddrs[0])
+	assert.Assert(t, found)
+
+	_, err := app.StakingKeeper.Delegate(ctx, addrs[4], delTokens, stakingtypes.Unbonded, val1, true)
+	assert.NilError(t, err)
+
+	app.StakingKeeper.EndBlocker(ctx)
+
+	tp := TestProposal
+	proposal, err := app.GovKeeper.SubmitProposal(ctx, tp, "", "test", "description", addrs[0], false)
+	assert.NilError(t, err)
+	proposalID := proposal.Id
+	proposal.Status = v1.StatusVotingPeriod
+	app.GovKeeper.SetProposal(ctx, proposal)
+
+	options := v1.WeightedVoteOptions{
+		v1.NewWeightedVoteOption(v1.OptionYes, sdkmath.LegacyNewDecWithPrec(60, 2)),
+		v1.NewWeightedVoteOption(v1.OptionNo, sdkmath.LegacyNewDecWithPrec(40, 2)),
+	}
+
+	ctx = ctx.WithEventManager(sdk.NewEventManager())
+	assert.NilError(t, app.GovKeeper.AddVote(ctx, proposalID, addrs[4], options, "metadata"))
+
+	var voteEvent *v1.EventVote
+	for _, event := range ctx.EventManager().ABCIEvents() {
+		if event.Type != "cosmos.gov.v1.EventVote" {
+			continue
+		}
+
+		msg, err := sdk.ParseTypedEvent(event)
+		assert.NilError(t, err)
+		voteEvent = msg.(*v1.EventVote)
+	}
+
+	assert.Assert(t, voteEvent != nil)
+	assert.Equal(t, proposalID, voteEvent.ProposalId)
+	assert.Equal(t, addrs[4].String(), voteEvent.Voter)
+	assert.DeepEqual(t, []*v1.WeightedVoteOption(options), voteEvent.Options)
+	assert.Equal(t, delTokens.String(), voteEvent.BondedStake)
+	assert.Equal(t, "metadata", voteEvent.Metadata)
+}
@@ -21,6 +21,9 @@ import (
 	"github.com/cosmos/cosmos-sdk/testutil/configurator"
 	simtestutil "github.com/cosmos/cosmos-sdk/testutil/sims"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
 
 	_ "github.com/cosmos/cosmos-sdk/x/auth"
 	_ "github.com/cosmos/cosmos-sdk/x/auth/tx/config"
@@ -35,6 +38,7 @@ type fixture struct {
 	appQueryClient    appv1alpha1.QueryClient
 	autocliInfoClient autocliv1.QueryClient
 	reflectionClient  reflectionv1.ReflectionServiceClient
+	paramsClient      reflectionv1.ParamsServiceClient
 }
 
 func initFixture(t assert.TestingT) *fixture {
@@ -66,6 +70,7 @@ func initFixture(t assert.TestingT) *fixture {
 	f.appQueryClient = appv1alpha1.NewQueryClient(queryHelper)
 	f.autocliInfoClient = autocliv1.NewQueryClient(queryHelper)
 	f.reflectionClient = reflectionv1.NewReflectionServiceClient(queryHelper)
+	f.paramsClient = reflectionv1.NewParamsServiceClient(queryHelper)
 
 	return f
 }
@@ -150,3 +155,35 @@ func TestQueryAutoCLIAppOptions(t *testing.T) {
 	// make sure tx module has no autocli options because it has no services
 	assert.Assert(t, res.ModuleOptions["tx"] == nil)
 }
+
+func TestParamsService(t *testing.T) {
+	t.Parallel()
+	f := initFixture(t)
+
+	res, err := f.paramsClient.ModuleParams(f.ctx, &reflectionv1.ModuleParamsRequest{})
+	assert.NilError(t, err)
+
+	modules := make([]string, len(res.Params))
+	for i, p := range res.Params {
+		modules[i] = p.Module
+	}
+	assert.DeepEqual(t, []string{"auth", "bank", "consensus", "staking"}, modules)
+
+	res, err = f.paramsClient.ModuleParams(f.ctx, &reflectionv1.ModuleParamsRequest{Module: "bank"})
+	assert.NilError(t, err)
+	assert.Equal(t, 1, len(res.Params))
+
+	bankParams := res.Params[0]
+	assert.Equal(t, "cosmos.bank.v1beta1.Params", bankParams.ParamsType)
+	assert.Equal(t, sdk.MsgTypeURL(&banktypes.MsgUpdateParams{}), bankParams.UpdateMsgTypeUrl)
+	assert.Equal(t, authtypes.NewModuleAddress(govtypes.ModuleName).String(), bankParams.Authority)
+	assert.Equal(t, "/cosmos.bank.v1beta1.Params", bankParams.Params.TypeUrl)
+
+	var params banktypes.Params
+	assert.NilError(t, proto.Unmarshal(bankParams.Params.Value, &params))
+	assert.DeepEqual(t, banktypes.DefaultParams(), params)
+
+	// the params module has no updatable params
+	_, err = f.paramsClient.ModuleParams(f.ctx, &reflectionv1.ModuleParamsRequest{Module: "params"})
+	assert.ErrorContains(t, err, "module params has no updatable params")
+}
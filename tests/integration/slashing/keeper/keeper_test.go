@@ -7,11 +7,13 @@ import (
 	"cosmossdk.io/log"
 	storetypes "cosmossdk.io/store/types"
 
+	abci "github.com/cometbft/cometbft/abci/types"
 	cmtproto "github.com/cometbft/cometbft/proto/tendermint/types"
 	"github.com/stretchr/testify/require"
 	"gotest.tools/v3/assert"
 
 	"github.com/cosmos/cosmos-sdk/codec"
+	cryptocodec "github.com/cosmos/cosmos-sdk/crypto/codec"
 	"github.com/cosmos/cosmos-sdk/runtime"
 	"github.com/cosmos/cosmos-sdk/testutil/integration"
 	simtestutil "github.com/cosmos/cosmos-sdk/testutil/sims"
@@ -423,3 +425,97 @@ func TestValidatorDippingInAndOut(t *testing.T) {
 	f.stakingKeeper.EndBlocker(f.ctx)
 	tstaking.CheckValidator(valAddr, stakingtypes.Unbonding, true)
 }
+
+// Test a validator with a standby consensus key missing blocks
+// Ensure that the standby consensus key replaces the consensus key once
+// enough consecutive blocks are missed, and that the replaced key is retired
+func TestStandbyKeyActivation(t *testing.T) {
+	t.Parallel()
+	f := initFixture(t)
+
+	pks := simtestutil.CreateTestPubKeys(2)
+	addr, val, standby := f.valAddrs[0], pks[0], pks[1]
+	consAddr, standbyAddr := sdk.ConsAddress(val.Address()), sdk.ConsAddress(standby.Address())
+	power := int64(100)
+	tstaking := stakingtestutil.NewHelper(t, f.ctx, f.stakingKeeper)
+
+	f.slashingKeeper.AddPubkey(f.ctx, val)
+
+	info := slashingtypes.NewValidatorSigningInfo(consAddr, f.ctx.BlockHeight(), int64(0), time.Unix(0, 0), false, int64(0))
+	f.slashingKeeper.SetValidatorSigningInfo(f.ctx, consAddr, info)
+
+	tstaking.CreateValidatorWithValPower(addr, val, power, true)
+	_, err := f.stakingKeeper.EndBlocker(f.ctx)
+	assert.NilError(t, err)
+
+	// the standby consensus key is reserved to the validator
+	assert.NilError(t, f.stakingKeeper.SetStandbyConsPubKey(f.ctx, addr, standby))
+	assert.ErrorIs(t, f.stakingKeeper.SetStandbyConsPubKey(f.ctx, f.valAddrs[1], standby), stakingtypes.ErrValidatorPubKeyExists)
+	assert.ErrorIs(t, f.stakingKeeper.SetStandbyConsPubKey(f.ctx, f.valAddrs[1], val), stakingtypes.ErrValidatorPubKeyExists)
+
+	// the consecutive missed blocks are reset by a signed block
+	activationBlocks := f.slashingKeeper.StandbyKeyActivationBlocks(f.ctx)
+	height := int64(1)
+	for ; height < activationBlocks; height++ {
+		f.ctx = f.ctx.WithBlockHeight(height)
+		f.slashingKeeper.HandleValidatorSignature(f.ctx, val.Address(), power, false)
+	}
+	f.ctx = f.ctx.WithBlockHeight(height)
+	f.slashingKeeper.HandleValidatorSignature(f.ctx, val.Address(), power, true)
+	height++
+
+	info, found := f.slashingKeeper.GetValidatorSigningInfo(f.ctx, consAddr)
+	assert.Assert(t, found)
+	assert.Equal(t, int64(0), info.ConsecutiveMissedBlocks)
+	assert.Equal(t, activationBlocks-1, info.MissedBlocksCounter)
+
+	// the standby consensus key is activated by the consecutive missed blocks
+	for i := int64(0); i < activationBlocks; i++ {
+		f.ctx = f.ctx.WithBlockHeight(height)
+		f.slashingKeeper.HandleValidatorSignature(f.ctx, val.Address(), power, false)
+		height++
+	}
+	activationHeight := height - 1
+
+	info, found = f.slashingKeeper.GetValidatorSigningInfo(f.ctx, consAddr)
+	assert.Assert(t, found)
+	assert.Equal(t, activationBlocks, info.ConsecutiveMissedBlocks)
+	assert.Assert(t, f.slashingKeeper.IsReplacedConsAddr(f.ctx, consAddr))
+
+	standbyInfo, found := f.slashingKeeper.GetValidatorSigningInfo(f.ctx, standbyAddr)
+	assert.Assert(t, found)
+	assert.Equal(t, activationHeight, standbyInfo.StartHeight)
+
+	// the consensus key is replaced at the end of the block
+	assert.ErrorIs(t, f.stakingKeeper.SetStandbyConsPubKey(f.ctx, addr, nil), stakingtypes.ErrStandbyConsPubKeyActivated)
+
+	updates, err := f.stakingKeeper.EndBlocker(f.ctx)
+	assert.NilError(t, err)
+	oldPk, err := cryptocodec.ToCmtProtoPublicKey(val)
+	assert.NilError(t, err)
+	newPk, err := cryptocodec.ToCmtProtoPublicKey(standby)
+	assert.NilError(t, err)
+	assert.DeepEqual(t, []abci.ValidatorUpdate{{PubKey: oldPk, Power: 0}, {PubKey: newPk, Power: power}}, updates)
+
+	validator, found := f.stakingKeeper.GetValidator(f.ctx, addr)
+	assert.Assert(t, found)
+	assert.Equal(t, stakingtypes.Bonded, validator.GetStatus())
+	valConsAddr, err := validator.GetConsAddr()
+	assert.NilError(t, err)
+	assert.DeepEqual(t, standbyAddr, valConsAddr)
+	_, found = f.stakingKeeper.GetStandbyConsPubKey(f.ctx, addr)
+	assert.Assert(t, !found)
+
+	// the last votes of the replaced key are ignored
+	f.ctx = f.ctx.WithBlockHeight(height)
+	f.slashingKeeper.HandleValidatorSignature(f.ctx, val.Address(), power, false)
+	replacedInfo, found := f.slashingKeeper.GetValidatorSigningInfo(f.ctx, consAddr)
+	assert.Assert(t, found)
+	assert.DeepEqual(t, info, replacedInfo)
+
+	// a double sign of the replaced key tombstones the validator
+	_, found = f.stakingKeeper.GetValidatorByConsAddr(f.ctx, consAddr)
+	assert.Assert(t, found)
+	f.slashingKeeper.Tombstone(f.ctx, consAddr)
+	assert.Assert(t, f.slashingKeeper.IsTombstoned(f.ctx, standbyAddr))
+}
@@ -104,7 +104,7 @@ func initFixture(t testing.TB) *fixture {
 
 	maccPerms := map[string][]string{
 		minttypes.ModuleName:    {authtypes.Minter},
-		types.ModuleName:        {authtypes.Minter},
+		types.ModuleName:        {authtypes.Minter, authtypes.Burner},
 		types.BondedPoolName:    {authtypes.Burner, authtypes.Staking},
 		types.NotBondedPoolName: {authtypes.Burner, authtypes.Staking},
 	}
@@ -0,0 +1,253 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"cosmossdk.io/math"
+	"gotest.tools/v3/assert"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	"github.com/cosmos/cosmos-sdk/x/staking/keeper"
+	"github.com/cosmos/cosmos-sdk/x/staking/types"
+)
+
+func TestTokenizeAndRedeemShares(t *testing.T) {
+	t.Parallel()
+	f := initFixture(t)
+
+	ctx := f.sdkCtx
+	msgServer := keeper.NewMsgServerImpl(f.stakingKeeper)
+	bondDenom := f.stakingKeeper.BondDenom(ctx)
+
+	addrs, valAddrs, _ := createValidators(t, f, []int64{10, 20, 30})
+	delegator, recipient, owner, newOwner := addrs[0], addrs[1], addrs[2], addrs[3]
+
+	tokenizeAmount := f.stakingKeeper.TokensFromConsensusPower(ctx, 4)
+	delegationBefore, found := f.stakingKeeper.GetDelegation(ctx, delegator, valAddrs[0])
+	assert.Assert(t, found)
+
+	res, err := msgServer.TokenizeShares(ctx, &types.MsgTokenizeShares{
+		DelegatorAddress:    delegator.String(),
+		ValidatorAddress:    valAddrs[0].String(),
+		Amount:              sdk.NewCoin(bondDenom, tokenizeAmount),
+		TokenizedShareOwner: owner.String(),
+	})
+	assert.NilError(t, err)
+
+	record, found := f.stakingKeeper.GetTokenizeShareRecord(ctx, 1)
+	assert.Assert(t, found)
+	assert.Equal(t, owner.String(), record.Owner)
+	assert.Equal(t, record.GetShareTokenDenom(), res.Amount.Denom)
+	assert.DeepEqual(t, res.Amount, f.bankKeeper.GetBalance(ctx, delegator, record.GetShareTokenDenom()))
+
+	// the tokenized shares are delegated by the record account
+	delegationAfter, found := f.stakingKeeper.GetDelegation(ctx, delegator, valAddrs[0])
+	assert.Assert(t, found)
+	assert.DeepEqual(t, delegationBefore.Shares.Sub(math.LegacyNewDecFromInt(tokenizeAmount)), delegationAfter.Shares)
+	assert.DeepEqual(t, tokenizeAmount, f.stakingKeeper.GetTokenizeShareRecordDelegatedTokens(ctx, record))
+
+	validator, found := f.stakingKeeper.GetValidator(ctx, valAddrs[0])
+	assert.Assert(t, found)
+	assert.DeepEqual(t, math.LegacyNewDecFromInt(tokenizeAmount), validator.LiquidShares)
+	assert.DeepEqual(t, tokenizeAmount, f.stakingKeeper.GetTotalLiquidStakedTokens(ctx))
+
+	// only the record owner can transfer the record
+	_, err = msgServer.TransferTokenizeShareRecord(ctx, &types.MsgTransferTokenizeShareRecord{
+		TokenizeShareRecordId: record.Id,
+		Sender:                delegator.String(),
+		NewOwner:              newOwner.String(),
+	})
+	assert.ErrorIs(t, err, types.ErrNotTokenizeShareRecordOwner)
+
+	_, err = msgServer.TransferTokenizeShareRecord(ctx, &types.MsgTransferTokenizeShareRecord{
+		TokenizeShareRecordId: record.Id,
+		Sender:                owner.String(),
+		NewOwner:              newOwner.String(),
+	})
+	assert.NilError(t, err)
+	assert.Equal(t, 1, len(f.stakingKeeper.GetTokenizeShareRecordsByOwner(ctx, newOwner)))
+	assert.Equal(t, 0, len(f.stakingKeeper.GetTokenizeShareRecordsByOwner(ctx, owner)))
+
+	// the share tokens can be redeemed by any holder
+	halfShares := sdk.NewCoin(res.Amount.Denom, res.Amount.Amount.QuoRaw(2))
+	assert.NilError(t, f.bankKeeper.SendCoins(ctx, delegator, recipient, sdk.NewCoins(halfShares)))
+
+	_, err = msgServer.RedeemTokensForShares(ctx, &types.MsgRedeemTokensForShares{
+		DelegatorAddress: recipient.String(),
+		Amount:           res.Amount,
+	})
+	assert.ErrorIs(t, err, types.ErrNotEnoughBalance)
+
+	redeemRes, err := msgServer.RedeemTokensForShares(ctx, &types.MsgRedeemTokensForShares{
+		DelegatorAddress: recipient.String(),
+		Amount:           halfShares,
+	})
+	assert.NilError(t, err)
+	assert.DeepEqual(t, sdk.NewCoin(bondDenom, halfShares.Amount), redeemRes.Amount)
+
+	recipientDelegation, found := f.stakingKeeper.GetDelegation(ctx, recipient, valAddrs[0])
+	assert.Assert(t, found)
+	assert.DeepEqual(t, math.LegacyNewDecFromInt(halfShares.Amount), recipientDelegation.Shares)
+	assert.DeepEqual(t, tokenizeAmount.Sub(halfShares.Amount), f.stakingKeeper.GetTotalLiquidStakedTokens(ctx))
+
+	// redeeming the last share tokens removes the record
+	_, err = msgServer.RedeemTokensForShares(ctx, &types.MsgRedeemTokensForShares{
+		DelegatorAddress: delegator.String(),
+		Amount:           f.bankKeeper.GetBalance(ctx, delegator, res.Amount.Denom),
+	})
+	assert.NilError(t, err)
+
+	_, found = f.stakingKeeper.GetTokenizeShareRecord(ctx, record.Id)
+	assert.Assert(t, !found)
+	assert.Assert(t, f.stakingKeeper.GetTotalLiquidStakedTokens(ctx).IsZero())
+	assert.Assert(t, f.bankKeeper.GetSupply(ctx, res.Amount.Denom).IsZero())
+
+	validator, found = f.stakingKeeper.GetValidator(ctx, valAddrs[0])
+	assert.Assert(t, found)
+	assert.Assert(t, validator.LiquidShares.IsZero())
+
+	delegationAfter, found = f.stakingKeeper.GetDelegation(ctx, delegator, valAddrs[0])
+	assert.Assert(t, found)
+	assert.DeepEqual(t, delegationBefore.Shares.Sub(math.LegacyNewDecFromInt(halfShares.Amount)), delegationAfter.Shares)
+}
+
+func TestTokenizeSharesLiquidStakingCaps(t *testing.T) {
+	t.Parallel()
+	f := initFixture(t)
+
+	ctx := f.sdkCtx
+	msgServer := keeper.NewMsgServerImpl(f.stakingKeeper)
+	bondDenom := f.stakingKeeper.BondDenom(ctx)
+
+	addrs, valAddrs, _ := createValidators(t, f, []int64{10, 20, 30})
+	tokenizeAmount := f.stakingKeeper.TokensFromConsensusPower(ctx, 5)
+
+	tokenizeShares := func(delegator sdk.AccAddress, valAddr sdk.ValAddress) error {
+		_, err := msgServer.TokenizeShares(ctx, &types.MsgTokenizeShares{
+			DelegatorAddress:    delegator.String(),
+			ValidatorAddress:    valAddr.String(),
+			Amount:              sdk.NewCoin(bondDenom, tokenizeAmount),
+			TokenizedShareOwner: delegator.String(),
+		})
+		return err
+	}
+
+	// 5 out of 60 bonded tokens exceeds a global cap of 5%
+	params := f.stakingKeeper.GetParams(ctx)
+	params.GlobalLiquidStakingCap = math.LegacyNewDecWithPrec(5, 2)
+	assert.NilError(t, f.stakingKeeper.SetParams(ctx, params))
+	assert.ErrorIs(t, tokenizeShares(addrs[1], valAddrs[1]), types.ErrGlobalLiquidStakingCapExceeded)
+
+	// 5 out of 50 validator shares exceeds a validator cap of 5%
+	params.GlobalLiquidStakingCap = types.DefaultGlobalLiquidStakingCap
+	params.ValidatorLiquidStakingCap = math.LegacyNewDecWithPrec(5, 2)
+	assert.NilError(t, f.stakingKeeper.SetParams(ctx, params))
+	assert.ErrorIs(t, tokenizeShares(addrs[1], valAddrs[1]), types.ErrValidatorLiquidStakingCapExceeded)
+
+	// without a validator bond, no shares can be tokenized
+	params.ValidatorLiquidStakingCap = types.DefaultValidatorLiquidStakingCap
+	params.ValidatorBondFactor = math.LegacyNewDec(1)
+	assert.NilError(t, f.stakingKeeper.SetParams(ctx, params))
+	assert.ErrorIs(t, tokenizeShares(addrs[1], valAddrs[1]), types.ErrInsufficientValidatorBondShares)
+
+	_, err := msgServer.ValidatorBond(ctx, &types.MsgValidatorBond{
+		DelegatorAddress: addrs[0].String(),
+		ValidatorAddress: valAddrs[1].String(),
+	})
+	assert.NilError(t, err)
+
+	validator, found := f.stakingKeeper.GetValidator(ctx, valAddrs[1])
+	assert.Assert(t, found)
+	assert.DeepEqual(t, math.LegacyNewDecFromInt(f.stakingKeeper.TokensFromConsensusPower(ctx, 30)), validator.ValidatorBondShares)
+
+	// a validator bond delegation cannot be tokenized
+	assert.ErrorIs(t, tokenizeShares(addrs[0], valAddrs[1]), types.ErrValidatorBondNotAllowedForTokenizeShare)
+	assert.NilError(t, tokenizeShares(addrs[1], valAddrs[1]))
+
+	// the validator bond can only be unbonded down to the liquid shares
+	_, _, err = f.stakingKeeper.Undelegate(ctx, addrs[0], valAddrs[1], math.LegacyNewDecFromInt(f.stakingKeeper.TokensFromConsensusPower(ctx, 26)))
+	assert.ErrorIs(t, err, types.ErrInsufficientValidatorBondShares)
+
+	_, _, err = f.stakingKeeper.Undelegate(ctx, addrs[0], valAddrs[1], math.LegacyNewDecFromInt(f.stakingKeeper.TokensFromConsensusPower(ctx, 25)))
+	assert.NilError(t, err)
+
+	validator, found = f.stakingKeeper.GetValidator(ctx, valAddrs[1])
+	assert.Assert(t, found)
+	assert.DeepEqual(t, math.LegacyNewDecFromInt(tokenizeAmount), validator.ValidatorBondShares)
+}
+
+func TestTokenizeSharesModuleAccountCollision(t *testing.T) {
+	t.Parallel()
+	f := initFixture(t)
+
+	ctx := f.sdkCtx
+	msgServer := keeper.NewMsgServerImpl(f.stakingKeeper)
+	bondDenom := f.stakingKeeper.BondDenom(ctx)
+
+	addrs, valAddrs, _ := createValidators(t, f, []int64{10, 20, 30})
+	delegator, owner := addrs[0], addrs[1]
+
+	// an account already exists at the module account address of the first
+	// record, e.g. created by sending coins to it
+	takenAddress := authtypes.NewModuleAddress(types.GetTokenizeShareModuleAccountName(1))
+	f.accountKeeper.SetAccount(ctx, f.accountKeeper.NewAccountWithAddress(ctx, takenAddress))
+
+	_, err := msgServer.TokenizeShares(ctx, &types.MsgTokenizeShares{
+		DelegatorAddress:    delegator.String(),
+		ValidatorAddress:    valAddrs[0].String(),
+		Amount:              sdk.NewCoin(bondDenom, f.stakingKeeper.TokensFromConsensusPower(ctx, 4)),
+		TokenizedShareOwner: owner.String(),
+	})
+	assert.NilError(t, err)
+
+	// the existing account is left untouched and the record ID is skipped
+	_, isModuleAccount := f.accountKeeper.GetAccount(ctx, takenAddress).(sdk.ModuleAccountI)
+	assert.Assert(t, !isModuleAccount)
+
+	_, found := f.stakingKeeper.GetTokenizeShareRecord(ctx, 1)
+	assert.Assert(t, !found)
+	record, found := f.stakingKeeper.GetTokenizeShareRecord(ctx, 2)
+	assert.Assert(t, found)
+	assert.Equal(t, owner.String(), record.Owner)
+	assert.Equal(t, uint64(2), f.stakingKeeper.GetLastTokenizeShareRecordID(ctx))
+}
+
+func TestRedeemTokensForSharesLiquidAccountingMismatch(t *testing.T) {
+	t.Parallel()
+	f := initFixture(t)
+
+	ctx := f.sdkCtx
+	msgServer := keeper.NewMsgServerImpl(f.stakingKeeper)
+	bondDenom := f.stakingKeeper.BondDenom(ctx)
+
+	addrs, valAddrs, _ := createValidators(t, f, []int64{10, 20, 30})
+	delegator, owner := addrs[0], addrs[1]
+
+	res, err := msgServer.TokenizeShares(ctx, &types.MsgTokenizeShares{
+		DelegatorAddress:    delegator.String(),
+		ValidatorAddress:    valAddrs[0].String(),
+		Amount:              sdk.NewCoin(bondDenom, f.stakingKeeper.TokensFromConsensusPower(ctx, 4)),
+		TokenizedShareOwner: owner.String(),
+	})
+	assert.NilError(t, err)
+
+	// the redemption fails rather than silently hiding an inconsistent liquid
+	// staking accounting
+	f.stakingKeeper.SetTotalLiquidStakedTokens(ctx, math.ZeroInt())
+	_, err = msgServer.RedeemTokensForShares(ctx, &types.MsgRedeemTokensForShares{
+		DelegatorAddress: delegator.String(),
+		Amount:           res.Amount,
+	})
+	assert.ErrorIs(t, err, types.ErrNotEnoughBalance)
+
+	validator, found := f.stakingKeeper.GetValidator(ctx, valAddrs[0])
+	assert.Assert(t, found)
+	validator.LiquidShares = math.LegacyZeroDec()
+	f.stakingKeeper.SetValidator(ctx, validator)
+	_, err = msgServer.RedeemTokensForShares(ctx, &types.MsgRedeemTokensForShares{
+		DelegatorAddress: delegator.String(),
+		Amount:           res.Amount,
+	})
+	assert.ErrorIs(t, err, types.ErrNotEnoughDelegationShares)
+}
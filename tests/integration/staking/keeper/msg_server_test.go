@@ -4,6 +4,7 @@ import (
 	"testing"
 	"time"
 
+	"cosmossdk.io/math"
 	"gotest.tools/v3/assert"
 
 	simtestutil "github.com/cosmos/cosmos-sdk/testutil/sims"
@@ -168,3 +169,137 @@ func TestCancelUnbondingDelegation(t *testing.T) {
 		})
 	}
 }
+
+func TestCancelRedelegation(t *testing.T) {
+	t.Parallel()
+	f := initFixture(t)
+
+	addrs, valAddrs, _ := createValidators(t, f, []int64{10, 20, 30})
+	delegator, srcValAddr, dstValAddr := addrs[0], valAddrs[0], valAddrs[1]
+
+	ctx := f.sdkCtx.WithBlockHeight(10)
+	msgServer := keeper.NewMsgServerImpl(f.stakingKeeper)
+	bondDenom := f.stakingKeeper.BondDenom(ctx)
+
+	srcDelegationBefore, found := f.stakingKeeper.GetDelegation(ctx, delegator, srcValAddr)
+	assert.Assert(t, found)
+	dstDelegationBefore, found := f.stakingKeeper.GetDelegation(ctx, delegator, dstValAddr)
+	assert.Assert(t, found)
+
+	redelegationAmount := sdk.NewCoin(bondDenom, f.stakingKeeper.TokensFromConsensusPower(ctx, 4))
+	_, err := msgServer.BeginRedelegate(ctx, &types.MsgBeginRedelegate{
+		DelegatorAddress:    delegator.String(),
+		ValidatorSrcAddress: srcValAddr.String(),
+		ValidatorDstAddress: dstValAddr.String(),
+		Amount:              redelegationAmount,
+	})
+	assert.NilError(t, err)
+
+	red, found := f.stakingKeeper.GetRedelegation(ctx, delegator, srcValAddr, dstValAddr)
+	assert.Assert(t, found)
+	assert.Equal(t, 1, len(red.Entries))
+	entry := red.Entries[0]
+	assert.Equal(t, 1, len(f.stakingKeeper.GetRedelegationQueueTimeSlice(ctx, entry.CompletionTime)))
+
+	halfAmount := sdk.NewCoin(bondDenom, redelegationAmount.Amount.QuoRaw(2))
+
+	testCases := []struct {
+		name      string
+		req       types.MsgCancelRedelegation
+		expErrMsg string
+	}{
+		{
+			name: "entry not found at height",
+			req: types.MsgCancelRedelegation{
+				DelegatorAddress:    delegator.String(),
+				ValidatorSrcAddress: srcValAddr.String(),
+				ValidatorDstAddress: dstValAddr.String(),
+				Amount:              halfAmount,
+				CreationHeight:      11,
+			},
+			expErrMsg: "redelegation entry is not found at block height",
+		},
+		{
+			name: "invalid height",
+			req: types.MsgCancelRedelegation{
+				DelegatorAddress:    delegator.String(),
+				ValidatorSrcAddress: srcValAddr.String(),
+				ValidatorDstAddress: dstValAddr.String(),
+				Amount:              halfAmount,
+				CreationHeight:      0,
+			},
+			expErrMsg: "invalid height",
+		},
+		{
+			name: "invalid coin",
+			req: types.MsgCancelRedelegation{
+				DelegatorAddress:    delegator.String(),
+				ValidatorSrcAddress: srcValAddr.String(),
+				ValidatorDstAddress: dstValAddr.String(),
+				Amount:              sdk.NewCoin("dump_coin", halfAmount.Amount),
+				CreationHeight:      10,
+			},
+			expErrMsg: "invalid coin denomination",
+		},
+		{
+			name: "redelegation not found",
+			req: types.MsgCancelRedelegation{
+				DelegatorAddress:    delegator.String(),
+				ValidatorSrcAddress: dstValAddr.String(),
+				ValidatorDstAddress: srcValAddr.String(),
+				Amount:              halfAmount,
+				CreationHeight:      10,
+			},
+			expErrMsg: "no redelegation found",
+		},
+		{
+			name: "invalid amount",
+			req: types.MsgCancelRedelegation{
+				DelegatorAddress:    delegator.String(),
+				ValidatorSrcAddress: srcValAddr.String(),
+				ValidatorDstAddress: dstValAddr.String(),
+				Amount:              redelegationAmount.Add(sdk.NewInt64Coin(bondDenom, 1)),
+				CreationHeight:      10,
+			},
+			expErrMsg: "amount is greater than the redelegation entry balance",
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			_, err := msgServer.CancelRedelegation(ctx, &testCase.req)
+			assert.ErrorContains(t, err, testCase.expErrMsg)
+		})
+	}
+
+	// canceling part of the entry keeps the entry and its queue entry
+	_, err = msgServer.CancelRedelegation(ctx, types.NewMsgCancelRedelegation(delegator, srcValAddr, dstValAddr, 10, halfAmount))
+	assert.NilError(t, err)
+
+	red, found = f.stakingKeeper.GetRedelegation(ctx, delegator, srcValAddr, dstValAddr)
+	assert.Assert(t, found)
+	assert.Equal(t, 1, len(red.Entries))
+	assert.DeepEqual(t, redelegationAmount.Amount.Sub(halfAmount.Amount), red.Entries[0].InitialBalance)
+	assert.Equal(t, 1, len(f.stakingKeeper.GetRedelegationQueueTimeSlice(ctx, entry.CompletionTime)))
+
+	srcDelegation, found := f.stakingKeeper.GetDelegation(ctx, delegator, srcValAddr)
+	assert.Assert(t, found)
+	assert.DeepEqual(t, srcDelegationBefore.Shares.Sub(math.LegacyNewDecFromInt(redelegationAmount.Amount.Sub(halfAmount.Amount))), srcDelegation.Shares)
+
+	// canceling the rest of the entry removes the redelegation and its queue entry
+	_, err = msgServer.CancelRedelegation(ctx, types.NewMsgCancelRedelegation(delegator, srcValAddr, dstValAddr, 10, sdk.NewCoin(bondDenom, red.Entries[0].InitialBalance)))
+	assert.NilError(t, err)
+
+	_, found = f.stakingKeeper.GetRedelegation(ctx, delegator, srcValAddr, dstValAddr)
+	assert.Assert(t, !found)
+	_, found = f.stakingKeeper.GetRedelegationByUnbondingID(ctx, entry.UnbondingId)
+	assert.Assert(t, !found)
+	assert.Equal(t, 0, len(f.stakingKeeper.GetRedelegationQueueTimeSlice(ctx, entry.CompletionTime)))
+
+	srcDelegation, found = f.stakingKeeper.GetDelegation(ctx, delegator, srcValAddr)
+	assert.Assert(t, found)
+	assert.DeepEqual(t, srcDelegationBefore.Shares, srcDelegation.Shares)
+	dstDelegation, found := f.stakingKeeper.GetDelegation(ctx, delegator, dstValAddr)
+	assert.Assert(t, found)
+	assert.DeepEqual(t, dstDelegationBefore.Shares, dstDelegation.Shares)
+}
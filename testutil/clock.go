@@ -0,0 +1,60 @@
+package testutil
+
+import (
+	"fmt"
+	"sync"
+	"time"
+
+	cmtproto "github.com/cometbft/cometbft/proto/tendermint/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// Clock is a manually advanced clock providing the block times of tests, so
+// that they are deterministic and never go backwards, unlike the wall clock.
+// It is safe for concurrent use.
+type Clock struct {
+	mu  sync.Mutex
+	now time.Time
+}
+
+// NewClock returns a Clock starting at the given time.
+func NewClock(start time.Time) *Clock {
+	return &Clock{now: start.UTC()}
+}
+
+// Now returns the current time of the clock.
+func (c *Clock) Now() time.Time {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	return c.now
+}
+
+// Advance moves the clock forward by d and returns the new time of the clock.
+// It panics if d is negative.
+func (c *Clock) Advance(d time.Duration) time.Time {
+	if d < 0 {
+		panic(fmt.Sprintf("cannot move the clock backwards by %s", -d))
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.now = c.now.Add(d)
+	return c.now
+}
+
+// NextHeader advances the clock by blockTime and returns the header of the
+// block following header, at the new time of the clock.
+func (c *Clock) NextHeader(header cmtproto.Header, blockTime time.Duration) cmtproto.Header {
+	header.Height++
+	header.Time = c.Advance(blockTime)
+	return header
+}
+
+// NextBlock advances the clock by blockTime and returns ctx with the header of
+// the following block, at the new time of the clock.
+func (c *Clock) NextBlock(ctx sdk.Context, blockTime time.Duration) sdk.Context {
+	return ctx.WithBlockHeader(c.NextHeader(ctx.BlockHeader(), blockTime))
+}
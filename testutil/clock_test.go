@@ -0,0 +1,35 @@
+package testutil_test
+
+import (
+	"testing"
+	"time"
+
+	cmtproto "github.com/cometbft/cometbft/proto/tendermint/types"
+	"github.com/stretchr/testify/require"
+
+	storetypes "cosmossdk.io/store/types"
+
+	"github.com/cosmos/cosmos-sdk/testutil"
+)
+
+func TestClock(t *testing.T) {
+	start := time.Date(2023, 1, 1, 0, 0, 0, 0, time.UTC)
+	clock := testutil.NewClock(start)
+	require.Equal(t, start, clock.Now())
+
+	require.Equal(t, start.Add(time.Minute), clock.Advance(time.Minute))
+	require.Equal(t, start.Add(time.Minute), clock.Now())
+
+	require.Panics(t, func() { clock.Advance(-time.Second) })
+	require.Equal(t, start.Add(time.Minute), clock.Now())
+
+	header := clock.NextHeader(cmtproto.Header{Height: 1, Time: start}, 5*time.Second)
+	require.Equal(t, int64(2), header.Height)
+	require.Equal(t, start.Add(time.Minute+5*time.Second), header.Time)
+
+	key := storetypes.NewKVStoreKey("test")
+	ctx := testutil.DefaultContext(key, storetypes.NewTransientStoreKey("transient_test")).WithBlockHeader(header)
+	ctx = clock.NextBlock(ctx, 5*time.Second)
+	require.Equal(t, int64(3), ctx.BlockHeight())
+	require.Equal(t, start.Add(time.Minute+10*time.Second), ctx.BlockTime())
+}
@@ -48,10 +48,10 @@ var beginBlockOrder = []string{
 var endBlockersOrder = []string{
 	"crisis",
 	"gov",
+	"distribution",
 	"staking",
 	"auth",
 	"bank",
-	"distribution",
 	"slashing",
 	"mint",
 	"genutil",
@@ -115,6 +115,7 @@ func AuthModule() ModuleOption {
 					{Account: "mint", Permissions: []string{"minter"}},
 					{Account: "bonded_tokens_pool", Permissions: []string{"burner", "staking"}},
 					{Account: "not_bonded_tokens_pool", Permissions: []string{"burner", "staking"}},
+					{Account: "staking", Permissions: []string{"minter", "burner"}},
 					{Account: "gov", Permissions: []string{"burner"}},
 					{Account: "nft"},
 				},
@@ -394,6 +394,98 @@ func (coins Coins) SafeSub(coinsB ...Coin) (Coins, bool) {
 	return diff, diff.IsAnyNegative()
 }
 
+// AddValid adds two valid sets of coins, i.e. sorted with unique denoms and
+// positive amounts, and returns a valid set of coins. Unlike Add, it neither
+// sorts nor validates its operands and merges them in a single pass, allocating
+// at most the resulting slice, which makes it suitable for hot paths operating
+// on coins known to be valid, e.g. read from the store. The validity of the
+// operands is only asserted in builds with the coins_assert build tag.
+//
+// NOTE: The result may share its underlying array with one of the operands.
+func (coins Coins) AddValid(coinsB Coins) Coins {
+	assertValidCoins(coins)
+	assertValidCoins(coinsB)
+
+	switch {
+	case len(coinsB) == 0:
+		return coins
+	case len(coins) == 0:
+		return coinsB
+	}
+
+	sum := make(Coins, 0, len(coins)+len(coinsB))
+	indexA, indexB := 0, 0
+	for indexA < len(coins) && indexB < len(coinsB) {
+		coinA, coinB := coins[indexA], coinsB[indexB]
+		switch strings.Compare(coinA.Denom, coinB.Denom) {
+		case -1: // denom missing from coinsB
+			sum = append(sum, coinA)
+			indexA++
+		case 0: // same denom in both
+			sum = append(sum, Coin{Denom: coinA.Denom, Amount: coinA.Amount.Add(coinB.Amount)})
+			indexA++
+			indexB++
+		case 1: // denom missing from coins
+			sum = append(sum, coinB)
+			indexB++
+		}
+	}
+
+	sum = append(sum, coins[indexA:]...)
+	return append(sum, coinsB[indexB:]...)
+}
+
+// SubValid subtracts a valid set of coins from another, see AddValid, and
+// panics if any resulting amount is negative.
+//
+// NOTE: The result may share its underlying array with the receiver.
+func (coins Coins) SubValid(coinsB Coins) Coins {
+	diff, hasNeg := coins.SafeSubValid(coinsB)
+	if hasNeg {
+		panic("negative coin amount")
+	}
+
+	return diff
+}
+
+// SafeSubValid performs the same arithmetic as SubValid but returns true,
+// and nil coins, instead of panicking if any resulting amount is negative.
+//
+// NOTE: The result may share its underlying array with the receiver.
+func (coins Coins) SafeSubValid(coinsB Coins) (Coins, bool) {
+	assertValidCoins(coins)
+	assertValidCoins(coinsB)
+
+	if len(coinsB) == 0 {
+		return coins, false
+	}
+
+	diff := make(Coins, 0, len(coins))
+	indexA := 0
+	for _, coinB := range coinsB {
+		for indexA < len(coins) && coins[indexA].Denom < coinB.Denom {
+			diff = append(diff, coins[indexA])
+			indexA++
+		}
+
+		if indexA == len(coins) || coins[indexA].Denom != coinB.Denom {
+			// denom missing from coins
+			return nil, true
+		}
+
+		amount := coins[indexA].Amount.Sub(coinB.Amount)
+		switch amount.Sign() {
+		case -1:
+			return nil, true
+		case 1:
+			diff = append(diff, Coin{Denom: coinB.Denom, Amount: amount})
+		}
+		indexA++
+	}
+
+	return append(diff, coins[indexA:]...), false
+}
+
 // MulInt performs the scalar multiplication of coins with a `multiplier`
 // All coins are multiplied by x
 // e.g.
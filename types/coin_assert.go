@@ -0,0 +1,22 @@
+//go:build coins_assert
+// +build coins_assert
+
+package types
+
+import "fmt"
+
+// assertValidCoins panics if coins are not valid. Building with the
+// coins_assert build tag enables the assertions of the operations skipping
+// the validation of their operands, e.g. Coins.AddValid.
+func assertValidCoins(coins Coins) {
+	if err := coins.Validate(); err != nil {
+		panic(fmt.Errorf("invalid coin set %s: %w", coins, err))
+	}
+}
+
+// assertValidDecCoins panics if coins are not valid, see assertValidCoins.
+func assertValidDecCoins(coins DecCoins) {
+	if err := coins.Validate(); err != nil {
+		panic(fmt.Errorf("invalid dec coin set %s: %w", coins, err))
+	}
+}
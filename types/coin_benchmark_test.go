@@ -138,3 +138,72 @@ func BenchmarkSumOfCoinAdds(b *testing.B) {
 		}
 	}
 }
+
+func BenchmarkCoinsAddValid(b *testing.B) {
+	benchmarkingFunc := func(numCoinsA, numCoinsB int, addFn func(coinsA, coinsB Coins) Coins) func(b *testing.B) {
+		return func(b *testing.B) {
+			b.ReportAllocs()
+			coinsA := Coins(make([]Coin, numCoinsA))
+			coinsB := Coins(make([]Coin, numCoinsB))
+
+			for i := 0; i < numCoinsA; i++ {
+				coinsA[i] = NewCoin(coinName(i), NewInt(int64(i+1)))
+			}
+			for i := 0; i < numCoinsB; i++ {
+				coinsB[i] = NewCoin(coinName(2*i), NewInt(int64(i+1)))
+			}
+
+			b.ResetTimer()
+
+			for i := 0; i < b.N; i++ {
+				addFn(coinsA, coinsB)
+			}
+		}
+	}
+
+	addFns := map[string]func(coinsA, coinsB Coins) Coins{
+		"Add":      func(coinsA, coinsB Coins) Coins { return coinsA.Add(coinsB...) },
+		"AddValid": Coins.AddValid,
+	}
+
+	benchmarkSizes := [][]int{{1, 1}, {5, 5}, {5, 20}, {1, 1000}, {1000, 2}}
+	for _, name := range []string{"Add", "AddValid"} {
+		for _, sizes := range benchmarkSizes {
+			b.Run(fmt.Sprintf("%s sizes: A_%d, B_%d", name, sizes[0], sizes[1]), benchmarkingFunc(sizes[0], sizes[1], addFns[name]))
+		}
+	}
+}
+
+func BenchmarkCoinsSubValid(b *testing.B) {
+	benchmarkingFunc := func(numCoins int, subFn func(coinsA, coinsB Coins) Coins) func(b *testing.B) {
+		return func(b *testing.B) {
+			b.ReportAllocs()
+			coinsA := Coins(make([]Coin, numCoins))
+			coinsB := Coins(make([]Coin, 0, numCoins/2+1))
+
+			for i := 0; i < numCoins; i++ {
+				coinsA[i] = NewCoin(coinName(i), NewInt(int64(i+2)))
+				if i%2 == 0 {
+					coinsB = append(coinsB, NewCoin(coinName(i), NewInt(1)))
+				}
+			}
+
+			b.ResetTimer()
+
+			for i := 0; i < b.N; i++ {
+				subFn(coinsA, coinsB)
+			}
+		}
+	}
+
+	subFns := map[string]func(coinsA, coinsB Coins) Coins{
+		"Sub":      func(coinsA, coinsB Coins) Coins { return coinsA.Sub(coinsB...) },
+		"SubValid": Coins.SubValid,
+	}
+
+	for _, name := range []string{"Sub", "SubValid"} {
+		for _, numCoins := range []int{1, 5, 20, 1000} {
+			b.Run(fmt.Sprintf("%s size: %d", name, numCoins), benchmarkingFunc(numCoins, subFns[name]))
+		}
+	}
+}
@@ -0,0 +1,12 @@
+//go:build !coins_assert
+// +build !coins_assert
+
+package types
+
+// assertValidCoins is a no-op without the coins_assert build tag, see
+// coin_assert.go.
+func assertValidCoins(Coins) {}
+
+// assertValidDecCoins is a no-op without the coins_assert build tag, see
+// coin_assert.go.
+func assertValidDecCoins(DecCoins) {}
@@ -644,6 +644,65 @@ func (s *coinTestSuite) TestSubCoins() {
 	}
 }
 
+func (s *coinTestSuite) TestAddValidCoins() {
+	cases := []struct {
+		name     string
+		inputOne sdk.Coins
+		inputTwo sdk.Coins
+		expected sdk.Coins
+	}{
+		{"adding two empty lists", s.emptyCoins, s.emptyCoins, s.emptyCoins},
+		{"empty list + set", s.emptyCoins, sdk.Coins{s.ca1, s.cm1}, sdk.Coins{s.ca1, s.cm1}},
+		{"set + empty list", sdk.Coins{s.ca1, s.cm1}, s.emptyCoins, sdk.Coins{s.ca1, s.cm1}},
+		{"{1atom,1muon}+{1atom,1muon}", sdk.Coins{s.ca1, s.cm1}, sdk.Coins{s.ca1, s.cm1}, sdk.Coins{s.ca2, s.cm2}},
+		{"{1atom}+{1atom,2muon}", sdk.Coins{s.ca1}, sdk.Coins{s.ca1, s.cm2}, sdk.Coins{s.ca2, s.cm2}},
+		{"{2muon}+{1atom}", sdk.Coins{s.cm2}, sdk.Coins{s.ca1}, sdk.Coins{s.ca1, s.cm2}},
+	}
+
+	for _, tc := range cases {
+		s.T().Run(tc.name, func(t *testing.T) {
+			res := tc.inputOne.AddValid(tc.inputTwo)
+			require.True(t, res.IsValid(), fmt.Sprintf("%s + %s = %s", tc.inputOne, tc.inputTwo, res))
+			require.Equal(t, tc.expected, res)
+			require.Equal(t, tc.inputOne.Add(tc.inputTwo...), res)
+		})
+	}
+}
+
+func (s *coinTestSuite) TestSubValidCoins() {
+	testCases := []struct {
+		inputOne sdk.Coins
+		inputTwo sdk.Coins
+		expected sdk.Coins
+		hasNeg   bool
+	}{
+		{s.emptyCoins, s.emptyCoins, s.emptyCoins, false},
+		{sdk.Coins{s.cm1}, s.emptyCoins, sdk.Coins{s.cm1}, false},
+		{sdk.Coins{s.ca2}, sdk.Coins{s.ca1}, sdk.Coins{s.ca1}, false},
+		{sdk.Coins{s.ca1, s.cm1}, sdk.Coins{s.ca1}, sdk.Coins{s.cm1}, false},
+		{sdk.Coins{s.ca1, s.cm2}, sdk.Coins{s.cm1}, sdk.Coins{s.ca1, s.cm1}, false},
+		{sdk.Coins{s.ca2, s.cm2}, sdk.Coins{s.ca1, s.cm2}, sdk.Coins{s.ca1}, false},
+		{sdk.Coins{s.ca1, s.cm1}, sdk.Coins{s.ca2}, nil, true},
+		{sdk.Coins{s.ca2}, sdk.Coins{s.ca1, s.cm1}, nil, true},
+		{sdk.Coins{s.cm2}, sdk.Coins{s.ca1}, nil, true},
+	}
+
+	assert := s.Assert()
+	for i, tc := range testCases {
+		tc := tc
+		res, hasNeg := tc.inputOne.SafeSubValid(tc.inputTwo)
+		assert.Equal(tc.hasNeg, hasNeg, "tc #%d", i)
+		assert.Equal(tc.expected, res, "tc #%d", i)
+
+		if tc.hasNeg {
+			assert.Panics(func() { tc.inputOne.SubValid(tc.inputTwo) })
+		} else {
+			assert.True(res.IsValid())
+			assert.Equal(tc.inputOne.Sub(tc.inputTwo...), tc.inputOne.SubValid(tc.inputTwo), "tc #%d", i)
+		}
+	}
+}
+
 func (s *coinTestSuite) TestSafeSubCoin() {
 	cases := []struct {
 		inputOne  sdk.Coin
@@ -2,6 +2,7 @@ package types
 
 import (
 	"context"
+	"fmt"
 	"time"
 
 	"cosmossdk.io/log"
@@ -16,6 +17,37 @@ import (
 	"cosmossdk.io/core/header"
 )
 
+// ExecMode defines the execution mode in which a Context is used, so that the
+// modules can branch on it instead of on untyped context values.
+type ExecMode uint8
+
+const (
+	ExecModeCheck           ExecMode = iota // Check a transaction
+	ExecModeReCheck                         // Recheck a (pending) transaction after a commit
+	ExecModeSimulate                        // Simulate a transaction
+	ExecModePrepareProposal                 // Prepare a block proposal
+	ExecModeProcessProposal                 // Process a block proposal
+	ExecModeDeliver                         // Deliver a transaction, or execute a block
+)
+
+var execModeNames = map[ExecMode]string{
+	ExecModeCheck:           "check",
+	ExecModeReCheck:         "recheck",
+	ExecModeSimulate:        "simulate",
+	ExecModePrepareProposal: "prepare_proposal",
+	ExecModeProcessProposal: "process_proposal",
+	ExecModeDeliver:         "deliver",
+}
+
+// String implements the Stringer interface.
+func (m ExecMode) String() string {
+	if name, ok := execModeNames[m]; ok {
+		return name
+	}
+
+	return fmt.Sprintf("ExecMode(%d)", uint8(m))
+}
+
 /*
 Context is an immutable object contains all information needed to
 process a request.
@@ -34,13 +66,17 @@ type Context struct {
 	// Deprecated: Use HeaderService for chainID and CometService for the rest
 	chainID              string
 	txBytes              []byte
+	txHash               []byte
 	logger               log.Logger
 	voteInfo             []abci.VoteInfo
 	gasMeter             storetypes.GasMeter
 	blockGasMeter        storetypes.GasMeter
+	blockerGasLimit      uint64 // gas limit of a Begin or EndBlocker, zero if unlimited
 	checkTx              bool
 	recheckTx            bool // if recheckTx == true, then checkTx must also be true
+	execMode             ExecMode
 	minGasPrice          DecCoins
+	minGasPricesAllOf    bool // if true, the fee must cover all the minGasPrice denoms instead of any of them
 	consParams           cmtproto.ConsensusParams
 	eventManager         EventManagerI
 	priority             int64 // The tx priority, only relevant in CheckTx
@@ -65,9 +101,13 @@ func (c Context) Logger() log.Logger                            { return c.logge
 func (c Context) VoteInfos() []abci.VoteInfo                    { return c.voteInfo }
 func (c Context) GasMeter() storetypes.GasMeter                 { return c.gasMeter }
 func (c Context) BlockGasMeter() storetypes.GasMeter            { return c.blockGasMeter }
+func (c Context) BlockerGasLimit() uint64                       { return c.blockerGasLimit }
 func (c Context) IsCheckTx() bool                               { return c.checkTx }
 func (c Context) IsReCheckTx() bool                             { return c.recheckTx }
+func (c Context) ExecMode() ExecMode                            { return c.execMode }
+func (c Context) IsSimulate() bool                              { return c.execMode == ExecModeSimulate }
 func (c Context) MinGasPrices() DecCoins                        { return c.minGasPrice }
+func (c Context) MinGasPricesAllOf() bool                       { return c.minGasPricesAllOf }
 func (c Context) EventManager() EventManagerI                   { return c.eventManager }
 func (c Context) Priority() int64                               { return c.priority }
 func (c Context) KVGasConfig() storetypes.GasConfig             { return c.kvGasConfig }
@@ -89,6 +129,18 @@ func (c Context) HeaderHash() []byte {
 	return hash
 }
 
+// TxHash returns a copy of the hash of the transaction being executed, or nil
+// outside of the execution of a transaction.
+func (c Context) TxHash() []byte {
+	if c.txHash == nil {
+		return nil
+	}
+
+	hash := make([]byte, len(c.txHash))
+	copy(hash, c.txHash)
+	return hash
+}
+
 func (c Context) ConsensusParams() cmtproto.ConsensusParams {
 	return c.consParams
 }
@@ -109,12 +161,19 @@ func (c Context) Err() error {
 func NewContext(ms storetypes.MultiStore, header cmtproto.Header, isCheckTx bool, logger log.Logger) Context {
 	// https://github.com/gogo/protobuf/issues/519
 	header.Time = header.Time.UTC()
+
+	execMode := ExecModeDeliver
+	if isCheckTx {
+		execMode = ExecModeCheck
+	}
+
 	return Context{
 		baseCtx:              context.Background(),
 		ms:                   ms,
 		header:               header,
 		chainID:              header.ChainID,
 		checkTx:              isCheckTx,
+		execMode:             execMode,
 		logger:               logger,
 		gasMeter:             storetypes.NewInfiniteGasMeter(),
 		minGasPrice:          DecCoins{},
@@ -188,6 +247,16 @@ func (c Context) WithTxBytes(txBytes []byte) Context {
 	return c
 }
 
+// WithTxHash returns a Context with an updated hash of the transaction being
+// executed.
+func (c Context) WithTxHash(hash []byte) Context {
+	temp := make([]byte, len(hash))
+	copy(temp, hash)
+
+	c.txHash = temp
+	return c
+}
+
 // WithLogger returns a Context with an updated logger.
 func (c Context) WithLogger(logger log.Logger) Context {
 	c.logger = logger
@@ -212,6 +281,15 @@ func (c Context) WithBlockGasMeter(meter storetypes.GasMeter) Context {
 	return c
 }
 
+// WithBlockerGasLimit returns a Context with an updated gas limit for the
+// execution of a Begin or EndBlocker, zero meaning unlimited. The limit is
+// checked against the gas consumed by the GasMeter of the Context, see
+// IsBlockerGasExhausted.
+func (c Context) WithBlockerGasLimit(limit uint64) Context {
+	c.blockerGasLimit = limit
+	return c
+}
+
 // WithKVGasConfig returns a Context with an updated gas configuration for
 // the KVStore
 func (c Context) WithKVGasConfig(gasConfig storetypes.GasConfig) Context {
@@ -226,28 +304,53 @@ func (c Context) WithTransientKVGasConfig(gasConfig storetypes.GasConfig) Contex
 	return c
 }
 
-// WithIsCheckTx enables or disables CheckTx value for verifying transactions and returns an updated Context
+// WithIsCheckTx enables or disables CheckTx value for verifying transactions and returns an updated Context.
+// The exec mode is updated accordingly, from or to ExecModeDeliver.
 func (c Context) WithIsCheckTx(isCheckTx bool) Context {
 	c.checkTx = isCheckTx
+	switch {
+	case isCheckTx && c.execMode == ExecModeDeliver:
+		c.execMode = ExecModeCheck
+	case !isCheckTx && (c.execMode == ExecModeCheck || c.execMode == ExecModeReCheck):
+		c.execMode = ExecModeDeliver
+	}
 	return c
 }
 
 // WithIsRecheckTx called with true will also set true on checkTx in order to
 // enforce the invariant that if recheckTx = true then checkTx = true as well.
+// The exec mode is updated accordingly, from or to ExecModeReCheck.
 func (c Context) WithIsReCheckTx(isRecheckTx bool) Context {
 	if isRecheckTx {
 		c.checkTx = true
+		c.execMode = ExecModeReCheck
+	} else if c.execMode == ExecModeReCheck {
+		c.execMode = ExecModeCheck
 	}
 	c.recheckTx = isRecheckTx
 	return c
 }
 
+// WithExecMode returns a Context with an updated exec mode. The CheckTx and
+// ReCheckTx flags are left untouched, as e.g. a simulation runs in CheckTx.
+func (c Context) WithExecMode(m ExecMode) Context {
+	c.execMode = m
+	return c
+}
+
 // WithMinGasPrices returns a Context with an updated minimum gas price value
 func (c Context) WithMinGasPrices(gasPrices DecCoins) Context {
 	c.minGasPrice = gasPrices
 	return c
 }
 
+// WithMinGasPricesAllOf returns a Context requiring the fee to cover all the
+// minimum gas prices, instead of any of them, if allOf is true
+func (c Context) WithMinGasPricesAllOf(allOf bool) Context {
+	c.minGasPricesAllOf = allOf
+	return c
+}
+
 // WithConsensusParams returns a Context with an updated consensus params
 func (c Context) WithConsensusParams(params cmtproto.ConsensusParams) Context {
 	c.consParams = params
@@ -284,6 +387,15 @@ func (c Context) WithHeaderInfo(headerInfo header.Info) Context {
 	return c
 }
 
+// IsBlockerGasExhausted returns true if a blocker gas limit is set and the gas
+// consumed by the GasMeter of the Context reached it. Begin and EndBlockers
+// processing queues should then stop and defer the remaining work to the next
+// blocks. As store reads and iterations consume gas deterministically, all the
+// nodes defer the same work.
+func (c Context) IsBlockerGasExhausted() bool {
+	return c.blockerGasLimit > 0 && c.gasMeter.GasConsumed() >= c.blockerGasLimit
+}
+
 // TODO: remove???
 func (c Context) IsZero() bool {
 	return c.ms == nil
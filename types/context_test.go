@@ -143,6 +143,39 @@ func (s *contextTestSuite) TestContextWithCustom() {
 	s.Require().NotEqual(ctx.Context(), ctx.WithContext(newContext).Context())
 }
 
+func (s *contextTestSuite) TestContextExecMode() {
+	ctx := types.NewContext(nil, cmtproto.Header{}, true, nil)
+	s.Require().Equal(types.ExecModeCheck, ctx.ExecMode())
+	s.Require().False(ctx.IsSimulate())
+
+	ctx = types.NewContext(nil, cmtproto.Header{}, false, nil)
+	s.Require().Equal(types.ExecModeDeliver, ctx.ExecMode())
+
+	// the CheckTx flags keep the exec mode consistent
+	ctx = ctx.WithIsCheckTx(true)
+	s.Require().Equal(types.ExecModeCheck, ctx.ExecMode())
+	ctx = ctx.WithIsReCheckTx(true)
+	s.Require().Equal(types.ExecModeReCheck, ctx.ExecMode())
+	ctx = ctx.WithIsReCheckTx(false)
+	s.Require().Equal(types.ExecModeCheck, ctx.ExecMode())
+	ctx = ctx.WithIsCheckTx(false)
+	s.Require().Equal(types.ExecModeDeliver, ctx.ExecMode())
+
+	// a simulation runs in CheckTx
+	ctx = ctx.WithIsCheckTx(true).WithExecMode(types.ExecModeSimulate)
+	s.Require().True(ctx.IsSimulate())
+	s.Require().True(ctx.IsCheckTx())
+	s.Require().Equal("simulate", ctx.ExecMode().String())
+	s.Require().Equal("ExecMode(42)", types.ExecMode(42).String())
+
+	// the tx hash is copied
+	s.Require().Nil(ctx.TxHash())
+	hash := []byte("txhash")
+	ctx = ctx.WithTxHash(hash)
+	hash[0] = 'x'
+	s.Require().Equal([]byte("txhash"), ctx.TxHash())
+}
+
 // Testing saving/loading of header fields to/from the context
 func (s *contextTestSuite) TestContextHeader() {
 	var ctx types.Context
@@ -318,6 +318,108 @@ func (coins DecCoins) SafeSub(coinsB DecCoins) (DecCoins, bool) {
 	return diff, diff.IsAnyNegative()
 }
 
+// AddValid adds two valid sets of DecCoins, i.e. sorted with unique denoms and
+// positive amounts, and returns a valid set of DecCoins, see Coins.AddValid.
+// Unlike Add, it doesn't check the amounts of its operands, whose validity is
+// only asserted in builds with the coins_assert build tag. Like Add, it
+// returns nil if both operands are empty.
+//
+// NOTE: The result may share its underlying array with one of the operands.
+func (coins DecCoins) AddValid(coinsB DecCoins) DecCoins {
+	assertValidDecCoins(coins)
+	assertValidDecCoins(coinsB)
+
+	switch {
+	case len(coinsB) == 0:
+		if len(coins) == 0 {
+			return nil
+		}
+		return coins
+	case len(coins) == 0:
+		return coinsB
+	}
+
+	sum := make(DecCoins, 0, len(coins)+len(coinsB))
+	indexA, indexB := 0, 0
+	for indexA < len(coins) && indexB < len(coinsB) {
+		coinA, coinB := coins[indexA], coinsB[indexB]
+		switch strings.Compare(coinA.Denom, coinB.Denom) {
+		case -1: // denom missing from coinsB
+			sum = append(sum, coinA)
+			indexA++
+		case 0: // same denom in both
+			sum = append(sum, DecCoin{Denom: coinA.Denom, Amount: coinA.Amount.Add(coinB.Amount)})
+			indexA++
+			indexB++
+		case 1: // denom missing from coins
+			sum = append(sum, coinB)
+			indexB++
+		}
+	}
+
+	sum = append(sum, coins[indexA:]...)
+	return append(sum, coinsB[indexB:]...)
+}
+
+// SubValid subtracts a valid set of DecCoins from another, see AddValid, and
+// panics if any resulting amount is negative.
+//
+// NOTE: The result may share its underlying array with the receiver.
+func (coins DecCoins) SubValid(coinsB DecCoins) DecCoins {
+	diff, hasNeg := coins.SafeSubValid(coinsB)
+	if hasNeg {
+		panic("negative coin amount")
+	}
+
+	return diff
+}
+
+// SafeSubValid performs the same arithmetic as SubValid but returns true,
+// and nil coins, instead of panicking if any resulting amount is negative.
+// Like SafeSub, it returns nil if the difference is empty.
+//
+// NOTE: The result may share its underlying array with the receiver.
+func (coins DecCoins) SafeSubValid(coinsB DecCoins) (DecCoins, bool) {
+	assertValidDecCoins(coins)
+	assertValidDecCoins(coinsB)
+
+	if len(coinsB) == 0 {
+		if len(coins) == 0 {
+			return nil, false
+		}
+		return coins, false
+	}
+
+	diff := make(DecCoins, 0, len(coins))
+	indexA := 0
+	for _, coinB := range coinsB {
+		for indexA < len(coins) && coins[indexA].Denom < coinB.Denom {
+			diff = append(diff, coins[indexA])
+			indexA++
+		}
+
+		if indexA == len(coins) || coins[indexA].Denom != coinB.Denom {
+			// denom missing from coins
+			return nil, true
+		}
+
+		amount := coins[indexA].Amount.Sub(coinB.Amount)
+		switch {
+		case amount.IsNegative():
+			return nil, true
+		case amount.IsPositive():
+			diff = append(diff, DecCoin{Denom: coinB.Denom, Amount: amount})
+		}
+		indexA++
+	}
+
+	if diff = append(diff, coins[indexA:]...); len(diff) == 0 {
+		return nil, false
+	}
+
+	return diff, false
+}
+
 // Intersect will return a new set of coins which contains the minimum DecCoin
 // for common denoms found in both `coins` and `coinsB`. For denoms not common
 // to both `coins` and `coinsB` the minimum is considered to be 0, thus they
@@ -399,6 +501,61 @@ func (coins DecCoins) MulDecTruncate(d Dec) DecCoins {
 	return res
 }
 
+// SafeMulDec performs the same arithmetic as MulDec but returns an error
+// instead of panicking if any product overflows.
+//
+// CONTRACT: No zero coins will be returned.
+func (coins DecCoins) SafeMulDec(d Dec) (DecCoins, error) {
+	return coins.safeMulDec(d, FixedDec.CheckedMul)
+}
+
+// SafeMulDecTruncate performs the same arithmetic as MulDecTruncate but
+// returns an error instead of panicking if any product overflows.
+//
+// CONTRACT: No zero coins will be returned.
+func (coins DecCoins) SafeMulDecTruncate(d Dec) (DecCoins, error) {
+	if d.IsZero() {
+		return DecCoins{}, nil
+	}
+
+	return coins.safeMulDec(d, FixedDec.CheckedMulTruncate)
+}
+
+// safeMulDec multiplies all the coins by d using the given checked
+// multiplication at the precision of Dec.
+func (coins DecCoins) safeMulDec(d Dec, mul func(a, b FixedDec) (FixedDec, error)) (DecCoins, error) {
+	factor, err := NewFixedDecFromLegacyDec(d, math.LegacyPrecision)
+	if err != nil {
+		return nil, err
+	}
+
+	var res DecCoins
+	for _, coin := range coins {
+		amount, err := NewFixedDecFromLegacyDec(coin.Amount, math.LegacyPrecision)
+		if err != nil {
+			return nil, err
+		}
+
+		product, err := mul(amount, factor)
+		if err != nil {
+			return nil, errors.Wrapf(err, "%s * %s", coin, d)
+		}
+
+		if product.IsZero() {
+			continue
+		}
+
+		productDec, err := product.ToLegacyDec()
+		if err != nil {
+			return nil, err
+		}
+
+		res = res.Add(DecCoin{Denom: coin.Denom, Amount: productDec})
+	}
+
+	return res, nil
+}
+
 // QuoDec divides all the decimal coins by a decimal. It panics if d is zero.
 //
 // CONTRACT: No zero coins will be returned.
@@ -291,6 +291,60 @@ func (s *decCoinTestSuite) TestSubDecCoins() {
 	}
 }
 
+func (s *decCoinTestSuite) TestAddValidDecCoins() {
+	one := math.LegacyNewDec(1)
+	two := math.LegacyNewDec(2)
+
+	cases := []struct {
+		inputOne sdk.DecCoins
+		inputTwo sdk.DecCoins
+		expected sdk.DecCoins
+	}{
+		{sdk.DecCoins{}, nil, sdk.DecCoins(nil)},
+		{sdk.DecCoins{{testDenom1, one}}, nil, sdk.DecCoins{{testDenom1, one}}},
+		{nil, sdk.DecCoins{{testDenom2, one}}, sdk.DecCoins{{testDenom2, one}}},
+		{sdk.DecCoins{{testDenom1, one}, {testDenom2, one}}, sdk.DecCoins{{testDenom1, one}, {testDenom2, one}}, sdk.DecCoins{{testDenom1, two}, {testDenom2, two}}},
+		{sdk.DecCoins{{testDenom2, two}}, sdk.DecCoins{{testDenom1, one}}, sdk.DecCoins{{testDenom1, one}, {testDenom2, two}}},
+	}
+
+	for tcIndex, tc := range cases {
+		res := tc.inputOne.AddValid(tc.inputTwo)
+		s.Require().Equal(tc.expected, res, "sum of coins is incorrect, tc #%d", tcIndex)
+		s.Require().Equal(tc.inputOne.Add(tc.inputTwo...), res, "tc #%d", tcIndex)
+	}
+}
+
+func (s *decCoinTestSuite) TestSubValidDecCoins() {
+	one := math.LegacyNewDec(1)
+	two := math.LegacyNewDec(2)
+
+	cases := []struct {
+		inputOne sdk.DecCoins
+		inputTwo sdk.DecCoins
+		expected sdk.DecCoins
+		hasNeg   bool
+	}{
+		{sdk.DecCoins{}, nil, sdk.DecCoins(nil), false},
+		{sdk.DecCoins{{testDenom1, one}}, nil, sdk.DecCoins{{testDenom1, one}}, false},
+		{sdk.DecCoins{{testDenom1, two}, {testDenom2, one}}, sdk.DecCoins{{testDenom1, one}}, sdk.DecCoins{{testDenom1, one}, {testDenom2, one}}, false},
+		{sdk.DecCoins{{testDenom1, one}, {testDenom2, two}}, sdk.DecCoins{{testDenom1, one}, {testDenom2, two}}, sdk.DecCoins(nil), false},
+		{sdk.DecCoins{{testDenom1, one}}, sdk.DecCoins{{testDenom1, two}}, nil, true},
+		{sdk.DecCoins{{testDenom2, one}}, sdk.DecCoins{{testDenom1, one}}, nil, true},
+	}
+
+	for tcIndex, tc := range cases {
+		res, hasNeg := tc.inputOne.SafeSubValid(tc.inputTwo)
+		s.Require().Equal(tc.hasNeg, hasNeg, "tc #%d", tcIndex)
+		s.Require().Equal(tc.expected, res, "tc #%d", tcIndex)
+
+		if tc.hasNeg {
+			s.Require().Panics(func() { tc.inputOne.SubValid(tc.inputTwo) }, "tc #%d", tcIndex)
+		} else {
+			s.Require().Equal(tc.inputOne.Sub(tc.inputTwo), tc.inputOne.SubValid(tc.inputTwo), "tc #%d", tcIndex)
+		}
+	}
+}
+
 func (s *decCoinTestSuite) TestSortDecCoins() {
 	good := sdk.DecCoins{
 		sdk.NewInt64DecCoin("gas", 1),
@@ -947,6 +1001,53 @@ func (s *decCoinTestSuite) TestDecCoins_MulDecTruncate() {
 	}
 }
 
+func (s *decCoinTestSuite) TestDecCoins_SafeMulDec() {
+	maxDec, err := sdk.MaxFixedDec(math.LegacyPrecision).ToLegacyDec()
+	s.Require().NoError(err)
+
+	testCases := []struct {
+		name       string
+		coins      sdk.DecCoins
+		multiplier sdk.Dec
+		expErr     bool
+	}{
+		{"No Coins", sdk.DecCoins{}, math.LegacyNewDec(1), false},
+		{"Multiple coins - zero multiplier", sdk.DecCoins{
+			sdk.DecCoin{testDenom1, sdk.NewDecWithPrec(10, 3)},
+		}, math.LegacyNewDec(0), false},
+		{"Multiple coins - fractional multiplier", sdk.DecCoins{
+			sdk.DecCoin{testDenom1, sdk.NewDecWithPrec(15, 1)},
+			sdk.DecCoin{testDenom2, sdk.NewDecWithPrec(3333, 4)},
+		}, sdk.NewDecWithPrec(123456789, 17), false},
+		{"Multiple coins - negative multiplier", sdk.DecCoins{
+			sdk.DecCoin{testDenom1, sdk.NewDecWithPrec(15, 1)},
+			sdk.DecCoin{testDenom2, sdk.NewDecWithPrec(333, 4)},
+		}, sdk.NewDecWithPrec(-25, 1), false},
+		{"Overflow", sdk.DecCoins{
+			sdk.DecCoin{testDenom1, maxDec},
+		}, math.LegacyNewDec(2), true},
+	}
+
+	for i, tc := range testCases {
+		tc := tc
+		s.T().Run(tc.name, func(t *testing.T) {
+			res, err := tc.coins.SafeMulDec(tc.multiplier)
+			resTruncate, errTruncate := tc.coins.SafeMulDecTruncate(tc.multiplier)
+			if tc.expErr {
+				s.Require().Error(err, "Test case #%d: %s", i, tc.name)
+				s.Require().Error(errTruncate, "Test case #%d: %s", i, tc.name)
+				s.Require().Panics(func() { tc.coins.MulDec(tc.multiplier) })
+				return
+			}
+
+			s.Require().NoError(err, "Test case #%d: %s", i, tc.name)
+			s.Require().NoError(errTruncate, "Test case #%d: %s", i, tc.name)
+			s.Require().Equal(tc.coins.MulDec(tc.multiplier).String(), res.String(), "Test case #%d: %s", i, tc.name)
+			s.Require().Equal(tc.coins.MulDecTruncate(tc.multiplier).String(), resTruncate.String(), "Test case #%d: %s", i, tc.name)
+		})
+	}
+}
+
 func (s *decCoinTestSuite) TestDecCoins_QuoDec() {
 	testCases := []struct {
 		name           string
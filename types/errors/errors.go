@@ -1,6 +1,8 @@
 package errors
 
 import (
+	"google.golang.org/grpc/codes"
+
 	errorsmod "cosmossdk.io/errors"
 )
 
@@ -140,6 +142,10 @@ var (
 	// supplied.
 	ErrInvalidGasLimit = errorsmod.Register(RootCodespace, 41, "invalid gas limit")
 
+	// ErrRateLimited defines an error when a query exceeds the rate limit
+	// configured for it.
+	ErrRateLimited = errorsmod.RegisterWithGRPCCode(RootCodespace, 42, codes.ResourceExhausted, "rate limit exceeded")
+
 	// ErrPanic should only be set when we recovering from a panic
 	ErrPanic = errorsmod.ErrPanic
 )
@@ -267,6 +267,13 @@ const (
 	AttributeKeyModule = "module"
 	AttributeKeySender = "sender"
 	AttributeKeyAmount = "amount"
+
+	// EventTypeMsgPanic is added to the result of a tx which failed because
+	// the handler of one of its messages panicked.
+	EventTypeMsgPanic = "msg_panic"
+
+	AttributeKeyMsgIndex  = "msg_index"
+	AttributeKeyPanicHash = "panic_hash"
 )
 
 type (
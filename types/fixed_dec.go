@@ -0,0 +1,414 @@
+package types
+
+import (
+	"errors"
+	"fmt"
+	"math/big"
+	"strings"
+
+	sdkmath "cosmossdk.io/math"
+)
+
+// MaxFixedDecPrecision is the maximum precision, i.e. number of decimal
+// places, of a FixedDec.
+const MaxFixedDecPrecision = sdkmath.LegacyPrecision
+
+// FixedDec errors
+var (
+	ErrFixedDecOverflow         = errors.New("fixed-point decimal overflow")
+	ErrFixedDecDivisionByZero   = errors.New("fixed-point decimal division by zero")
+	ErrFixedDecInvalidPrecision = errors.New("invalid fixed-point decimal precision")
+	ErrFixedDecLossOfPrecision  = errors.New("fixed-point decimal loss of precision")
+)
+
+// fixedDecMaxBitLen is the maximum bit length of the scaled integer of a
+// FixedDec, which is the same bound as the one of LegacyDec.
+const fixedDecMaxBitLen = sdkmath.MaxBitLen + sdkmath.LegacyDecimalPrecisionBits - 1
+
+var (
+	fixedDecZero = big.NewInt(0)
+	fixedDecOne  = big.NewInt(1)
+
+	// maxFixedDecScaled is the largest absolute value of the scaled integer of
+	// a FixedDec.
+	maxFixedDecScaled = new(big.Int).Sub(new(big.Int).Lsh(fixedDecOne, fixedDecMaxBitLen), fixedDecOne)
+
+	// fixedDecMultipliers caches 10^prec for every supported precision.
+	fixedDecMultipliers = func() []*big.Int {
+		multipliers := make([]*big.Int, MaxFixedDecPrecision+1)
+		for prec := range multipliers {
+			multipliers[prec] = new(big.Int).Exp(big.NewInt(10), big.NewInt(int64(prec)), nil)
+		}
+		return multipliers
+	}()
+)
+
+// FixedDec is an immutable fixed-point decimal number with an explicit
+// precision of at most MaxFixedDecPrecision decimal places, represented as an
+// integer scaled by 10^precision.
+//
+// Unlike LegacyDec, its arithmetic never panics: the Checked operations return
+// an error on overflow or division by zero, and the Saturating operations clamp
+// their results to the range of representable values. The operand of an
+// operation is converted to the precision of the receiver, truncating its
+// decimal places beyond it, and so is the result. At the precision of
+// LegacyPrecision, the results are the same as the ones of LegacyDec.
+//
+// The zero value is zero with a precision of zero.
+type FixedDec struct {
+	i    *big.Int
+	prec uint32
+}
+
+// fixedDecMultiplier returns 10^prec, which must not be mutated.
+func fixedDecMultiplier(prec uint32) *big.Int {
+	return fixedDecMultipliers[prec]
+}
+
+func validateFixedDecPrecision(prec uint32) error {
+	if prec > MaxFixedDecPrecision {
+		return fmt.Errorf("%w: maximum %d, provided %d", ErrFixedDecInvalidPrecision, MaxFixedDecPrecision, prec)
+	}
+
+	return nil
+}
+
+// newFixedDec returns the FixedDec of scaled integer i, which must not be
+// shared, or an error if it is out of range.
+func newFixedDec(i *big.Int, prec uint32) (FixedDec, error) {
+	if i.CmpAbs(maxFixedDecScaled) > 0 {
+		return FixedDec{}, ErrFixedDecOverflow
+	}
+
+	return FixedDec{i: i, prec: prec}, nil
+}
+
+// saturateFixedDec returns the FixedDec of scaled integer i, which must not be
+// shared, clamped to the range of representable values.
+func saturateFixedDec(i *big.Int, prec uint32) FixedDec {
+	if i.CmpAbs(maxFixedDecScaled) > 0 {
+		neg := i.Sign() < 0
+		i.Set(maxFixedDecScaled)
+		if neg {
+			i.Neg(i)
+		}
+	}
+
+	return FixedDec{i: i, prec: prec}
+}
+
+// ZeroFixedDec returns zero with the given precision.
+// CONTRACT: prec <= MaxFixedDecPrecision
+func ZeroFixedDec(prec uint32) FixedDec {
+	if err := validateFixedDecPrecision(prec); err != nil {
+		panic(err)
+	}
+
+	return FixedDec{i: new(big.Int), prec: prec}
+}
+
+// MaxFixedDec returns the largest FixedDec with the given precision.
+// CONTRACT: prec <= MaxFixedDecPrecision
+func MaxFixedDec(prec uint32) FixedDec {
+	if err := validateFixedDecPrecision(prec); err != nil {
+		panic(err)
+	}
+
+	return FixedDec{i: new(big.Int).Set(maxFixedDecScaled), prec: prec}
+}
+
+// NewFixedDec returns the whole number value as a FixedDec with the given
+// precision.
+func NewFixedDec(value int64, prec uint32) (FixedDec, error) {
+	return NewFixedDecFromBigInt(big.NewInt(value), prec)
+}
+
+// NewFixedDecFromInt returns the whole number i as a FixedDec with the given
+// precision.
+func NewFixedDecFromInt(i sdkmath.Int, prec uint32) (FixedDec, error) {
+	return NewFixedDecFromBigInt(i.BigInt(), prec)
+}
+
+// NewFixedDecFromBigInt returns the whole number i as a FixedDec with the
+// given precision.
+func NewFixedDecFromBigInt(i *big.Int, prec uint32) (FixedDec, error) {
+	if err := validateFixedDecPrecision(prec); err != nil {
+		return FixedDec{}, err
+	}
+
+	return newFixedDec(new(big.Int).Mul(i, fixedDecMultiplier(prec)), prec)
+}
+
+// NewFixedDecFromLegacyDec returns d as a FixedDec with the given precision,
+// truncating the decimal places of d beyond it.
+func NewFixedDecFromLegacyDec(d sdkmath.LegacyDec, prec uint32) (FixedDec, error) {
+	if err := validateFixedDecPrecision(prec); err != nil {
+		return FixedDec{}, err
+	}
+
+	if d.IsNil() {
+		return ZeroFixedDec(prec), nil
+	}
+
+	i := d.BigInt()
+	return newFixedDec(i.Quo(i, fixedDecMultiplier(MaxFixedDecPrecision-prec)), prec)
+}
+
+// ParseFixedDec parses a decimal string, as accepted by LegacyNewDecFromStr,
+// into a FixedDec with the given precision. It returns an error if the string
+// has more decimal places than prec.
+func ParseFixedDec(str string, prec uint32) (FixedDec, error) {
+	if err := validateFixedDecPrecision(prec); err != nil {
+		return FixedDec{}, err
+	}
+
+	d, err := sdkmath.LegacyNewDecFromStr(str)
+	if err != nil {
+		return FixedDec{}, err
+	}
+
+	quo, rem := new(big.Int).QuoRem(d.BigInt(), fixedDecMultiplier(MaxFixedDecPrecision-prec), new(big.Int))
+	if rem.Sign() != 0 {
+		return FixedDec{}, fmt.Errorf("%w: %s has more than %d decimal places", ErrFixedDecLossOfPrecision, str, prec)
+	}
+
+	return newFixedDec(quo, prec)
+}
+
+// scaled returns the scaled integer of d, treating the zero value as zero.
+func (d FixedDec) scaled() *big.Int {
+	if d.i == nil {
+		return fixedDecZero
+	}
+
+	return d.i
+}
+
+// rescaled returns the scaled integer of d at the given precision, truncating
+// the decimal places of d beyond it. The result may be out of range.
+func (d FixedDec) rescaled(prec uint32) *big.Int {
+	switch {
+	case prec > d.prec:
+		return new(big.Int).Mul(d.scaled(), fixedDecMultiplier(prec-d.prec))
+	case prec < d.prec:
+		return new(big.Int).Quo(d.scaled(), fixedDecMultiplier(d.prec-prec))
+	default:
+		return new(big.Int).Set(d.scaled())
+	}
+}
+
+// Precision returns the number of decimal places of d.
+func (d FixedDec) Precision() uint32 { return d.prec }
+
+func (d FixedDec) IsZero() bool     { return d.scaled().Sign() == 0 }
+func (d FixedDec) IsNegative() bool { return d.scaled().Sign() == -1 }
+func (d FixedDec) IsPositive() bool { return d.scaled().Sign() == 1 }
+
+// Cmp compares the values of d and d2, whatever their precisions, and returns
+// -1 if d < d2, 0 if d == d2 and +1 if d > d2.
+func (d FixedDec) Cmp(d2 FixedDec) int {
+	prec := d.prec
+	if d2.prec > prec {
+		prec = d2.prec
+	}
+
+	return d.rescaled(prec).Cmp(d2.rescaled(prec))
+}
+
+func (d FixedDec) Equal(d2 FixedDec) bool { return d.Cmp(d2) == 0 }
+func (d FixedDec) GT(d2 FixedDec) bool    { return d.Cmp(d2) > 0 }
+func (d FixedDec) GTE(d2 FixedDec) bool   { return d.Cmp(d2) >= 0 }
+func (d FixedDec) LT(d2 FixedDec) bool    { return d.Cmp(d2) < 0 }
+func (d FixedDec) LTE(d2 FixedDec) bool   { return d.Cmp(d2) <= 0 }
+
+// Neg returns the opposite of d.
+func (d FixedDec) Neg() FixedDec {
+	return FixedDec{i: new(big.Int).Neg(d.scaled()), prec: d.prec}
+}
+
+// Abs returns the absolute value of d.
+func (d FixedDec) Abs() FixedDec {
+	return FixedDec{i: new(big.Int).Abs(d.scaled()), prec: d.prec}
+}
+
+// WithPrecision returns d with the given precision, truncating the decimal
+// places of d beyond it.
+func (d FixedDec) WithPrecision(prec uint32) (FixedDec, error) {
+	if err := validateFixedDecPrecision(prec); err != nil {
+		return FixedDec{}, err
+	}
+
+	return newFixedDec(d.rescaled(prec), prec)
+}
+
+// ToLegacyDec returns d as a LegacyDec.
+func (d FixedDec) ToLegacyDec() (sdkmath.LegacyDec, error) {
+	i := d.rescaled(sdkmath.LegacyPrecision)
+	if i.BitLen() > fixedDecMaxBitLen {
+		return sdkmath.LegacyDec{}, ErrFixedDecOverflow
+	}
+
+	return sdkmath.LegacyNewDecFromBigIntWithPrec(i, sdkmath.LegacyPrecision), nil
+}
+
+// TruncateInt returns the integer part of d.
+func (d FixedDec) TruncateInt() (sdkmath.Int, error) {
+	i := d.rescaled(0)
+	if i.BitLen() > sdkmath.MaxBitLen {
+		return sdkmath.Int{}, ErrFixedDecOverflow
+	}
+
+	return sdkmath.NewIntFromBigInt(i), nil
+}
+
+// String returns d with all of its decimal places, e.g. "-1.50" for -1.5 with
+// a precision of 2.
+func (d FixedDec) String() string {
+	digits := new(big.Int).Abs(d.scaled()).String()
+	if len(digits) <= int(d.prec) {
+		digits = strings.Repeat("0", int(d.prec)-len(digits)+1) + digits
+	}
+
+	str := digits
+	if d.prec > 0 {
+		point := len(digits) - int(d.prec)
+		str = digits[:point] + "." + digits[point:]
+	}
+
+	if d.IsNegative() {
+		return "-" + str
+	}
+
+	return str
+}
+
+func (d FixedDec) add(d2 FixedDec) *big.Int {
+	i := d2.rescaled(d.prec)
+	return i.Add(d.scaled(), i)
+}
+
+func (d FixedDec) sub(d2 FixedDec) *big.Int {
+	i := d2.rescaled(d.prec)
+	return i.Sub(d.scaled(), i)
+}
+
+func (d FixedDec) mul(d2 FixedDec) *big.Int {
+	i := d2.rescaled(d.prec)
+	i.Mul(d.scaled(), i)
+	return fixedDecChopAndRound(i, fixedDecMultiplier(d.prec))
+}
+
+func (d FixedDec) mulTruncate(d2 FixedDec) *big.Int {
+	i := d2.rescaled(d.prec)
+	i.Mul(d.scaled(), i)
+	return i.Quo(i, fixedDecMultiplier(d.prec))
+}
+
+// quo returns the quotient of d by d2, multiplied by 10^prec once more than
+// the scaled integer of the result, or nil if d2 is zero at the precision of d.
+func (d FixedDec) quo(d2 FixedDec) *big.Int {
+	divisor := d2.rescaled(d.prec)
+	if divisor.Sign() == 0 {
+		return nil
+	}
+
+	multiplier := fixedDecMultiplier(d.prec)
+	i := new(big.Int).Mul(d.scaled(), multiplier)
+	i.Mul(i, multiplier)
+	return i.Quo(i, divisor)
+}
+
+// CheckedAdd returns d + d2, or an error on overflow.
+func (d FixedDec) CheckedAdd(d2 FixedDec) (FixedDec, error) {
+	return newFixedDec(d.add(d2), d.prec)
+}
+
+// CheckedSub returns d - d2, or an error on overflow.
+func (d FixedDec) CheckedSub(d2 FixedDec) (FixedDec, error) {
+	return newFixedDec(d.sub(d2), d.prec)
+}
+
+// CheckedMul returns d * d2 using bankers rounding, or an error on overflow.
+func (d FixedDec) CheckedMul(d2 FixedDec) (FixedDec, error) {
+	return newFixedDec(d.mul(d2), d.prec)
+}
+
+// CheckedMulTruncate returns d * d2 truncated toward zero, or an error on
+// overflow.
+func (d FixedDec) CheckedMulTruncate(d2 FixedDec) (FixedDec, error) {
+	return newFixedDec(d.mulTruncate(d2), d.prec)
+}
+
+// CheckedQuo returns d / d2 using bankers rounding, or an error on overflow or
+// division by zero.
+func (d FixedDec) CheckedQuo(d2 FixedDec) (FixedDec, error) {
+	i := d.quo(d2)
+	if i == nil {
+		return FixedDec{}, ErrFixedDecDivisionByZero
+	}
+
+	return newFixedDec(fixedDecChopAndRound(i, fixedDecMultiplier(d.prec)), d.prec)
+}
+
+// CheckedQuoTruncate returns d / d2 truncated toward zero, or an error on
+// overflow or division by zero.
+func (d FixedDec) CheckedQuoTruncate(d2 FixedDec) (FixedDec, error) {
+	i := d.quo(d2)
+	if i == nil {
+		return FixedDec{}, ErrFixedDecDivisionByZero
+	}
+
+	return newFixedDec(i.Quo(i, fixedDecMultiplier(d.prec)), d.prec)
+}
+
+// SaturatingAdd returns d + d2, clamped to the range of representable values.
+func (d FixedDec) SaturatingAdd(d2 FixedDec) FixedDec {
+	return saturateFixedDec(d.add(d2), d.prec)
+}
+
+// SaturatingSub returns d - d2, clamped to the range of representable values.
+func (d FixedDec) SaturatingSub(d2 FixedDec) FixedDec {
+	return saturateFixedDec(d.sub(d2), d.prec)
+}
+
+// SaturatingMul returns d * d2 using bankers rounding, clamped to the range of
+// representable values.
+func (d FixedDec) SaturatingMul(d2 FixedDec) FixedDec {
+	return saturateFixedDec(d.mul(d2), d.prec)
+}
+
+// SaturatingMulTruncate returns d * d2 truncated toward zero, clamped to the
+// range of representable values.
+func (d FixedDec) SaturatingMulTruncate(d2 FixedDec) FixedDec {
+	return saturateFixedDec(d.mulTruncate(d2), d.prec)
+}
+
+// fixedDecChopAndRound divides d by divisor and performs bankers rounding on the
+// remainder. It mutates and returns d.
+func fixedDecChopAndRound(d, divisor *big.Int) *big.Int {
+	neg := d.Sign() == -1
+	if neg {
+		d.Neg(d)
+	}
+
+	rem := new(big.Int)
+	d.QuoRem(d, divisor, rem)
+
+	if rem.Sign() != 0 {
+		switch rem.Lsh(rem, 1).Cmp(divisor) {
+		case 1:
+			d.Add(d, fixedDecOne)
+		case 0:
+			// bankers rounding: always round to an even number
+			if d.Bit(0) == 1 {
+				d.Add(d, fixedDecOne)
+			}
+		}
+	}
+
+	if neg {
+		d.Neg(d)
+	}
+
+	return d
+}
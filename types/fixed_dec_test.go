@@ -0,0 +1,198 @@
+package types_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func mustParseFixedDec(t *testing.T, str string, prec uint32) sdk.FixedDec {
+	t.Helper()
+	d, err := sdk.ParseFixedDec(str, prec)
+	require.NoError(t, err)
+	return d
+}
+
+func TestParseFixedDec(t *testing.T) {
+	tests := []struct {
+		str    string
+		prec   uint32
+		expStr string
+		expErr error
+	}{
+		{"0", 0, "0", nil},
+		{"1.5", 2, "1.50", nil},
+		{"-1.5", 2, "-1.50", nil},
+		{"0.001", 3, "0.001", nil},
+		{"-0.001", 6, "-0.001000", nil},
+		{"123456789", 18, "123456789.000000000000000000", nil},
+		{"1.55", 1, "", sdk.ErrFixedDecLossOfPrecision},
+		{"1", 19, "", sdk.ErrFixedDecInvalidPrecision},
+	}
+
+	for _, tc := range tests {
+		d, err := sdk.ParseFixedDec(tc.str, tc.prec)
+		if tc.expErr != nil {
+			require.ErrorIs(t, err, tc.expErr, tc.str)
+			continue
+		}
+
+		require.NoError(t, err, tc.str)
+		require.Equal(t, tc.expStr, d.String(), tc.str)
+		require.Equal(t, tc.prec, d.Precision())
+	}
+
+	_, err := sdk.ParseFixedDec("invalid", 2)
+	require.Error(t, err)
+}
+
+func TestFixedDecConversions(t *testing.T) {
+	d, err := sdk.NewFixedDec(-7, 3)
+	require.NoError(t, err)
+	require.Equal(t, "-7.000", d.String())
+
+	d, err = sdk.NewFixedDecFromInt(math.NewInt(42), 0)
+	require.NoError(t, err)
+	require.Equal(t, "42", d.String())
+
+	d, err = sdk.NewFixedDecFromLegacyDec(math.LegacyMustNewDecFromStr("-1.23456"), 3)
+	require.NoError(t, err)
+	require.Equal(t, "-1.234", d.String())
+
+	legacy, err := d.ToLegacyDec()
+	require.NoError(t, err)
+	require.Equal(t, math.LegacyMustNewDecFromStr("-1.234"), legacy)
+
+	i, err := mustParseFixedDec(t, "-9.99", 2).TruncateInt()
+	require.NoError(t, err)
+	require.Equal(t, math.NewInt(-9), i)
+
+	d, err = mustParseFixedDec(t, "2.789", 3).WithPrecision(1)
+	require.NoError(t, err)
+	require.Equal(t, "2.7", d.String())
+
+	d, err = d.WithPrecision(4)
+	require.NoError(t, err)
+	require.Equal(t, "2.7000", d.String())
+
+	_, err = sdk.MaxFixedDec(0).WithPrecision(1)
+	require.ErrorIs(t, err, sdk.ErrFixedDecOverflow)
+
+	require.Equal(t, "0.00", sdk.ZeroFixedDec(2).String())
+	require.Equal(t, "0", sdk.FixedDec{}.String())
+	require.Panics(t, func() { sdk.ZeroFixedDec(sdk.MaxFixedDecPrecision + 1) })
+}
+
+func TestFixedDecComparisons(t *testing.T) {
+	a := mustParseFixedDec(t, "1.5", 1)
+	b := mustParseFixedDec(t, "1.50", 2)
+	c := mustParseFixedDec(t, "-1.51", 2)
+
+	require.True(t, a.Equal(b))
+	require.True(t, a.GT(c))
+	require.True(t, c.LT(a))
+	require.True(t, a.GTE(b))
+	require.True(t, a.LTE(b))
+	require.True(t, c.IsNegative())
+	require.True(t, a.IsPositive())
+	require.True(t, sdk.FixedDec{}.IsZero())
+	require.True(t, c.Neg().Equal(c.Abs()))
+}
+
+func TestFixedDecCheckedArithmetic(t *testing.T) {
+	tests := []struct {
+		name string
+		op   func(a, b sdk.FixedDec) (sdk.FixedDec, error)
+		a, b string
+		exp  string
+	}{
+		{"add", sdk.FixedDec.CheckedAdd, "1.25", "2.5", "3.75"},
+		{"sub", sdk.FixedDec.CheckedSub, "1.25", "2.5", "-1.25"},
+		{"mul", sdk.FixedDec.CheckedMul, "1.25", "0.5", "0.62"},
+		{"mul rounds up", sdk.FixedDec.CheckedMul, "1.35", "0.5", "0.68"},
+		{"mul truncate", sdk.FixedDec.CheckedMulTruncate, "1.35", "0.5", "0.67"},
+		{"mul truncate negative", sdk.FixedDec.CheckedMulTruncate, "-1.35", "0.5", "-0.67"},
+		{"quo", sdk.FixedDec.CheckedQuo, "2", "3", "0.67"},
+		{"quo truncate", sdk.FixedDec.CheckedQuoTruncate, "2", "3", "0.66"},
+		{"quo negative", sdk.FixedDec.CheckedQuo, "-2", "3", "-0.67"},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			res, err := tc.op(mustParseFixedDec(t, tc.a, 2), mustParseFixedDec(t, tc.b, 2))
+			require.NoError(t, err)
+			require.Equal(t, tc.exp, res.String())
+		})
+	}
+
+	// the operand is truncated to the precision of the receiver
+	res, err := mustParseFixedDec(t, "1", 1).CheckedAdd(mustParseFixedDec(t, "0.19", 2))
+	require.NoError(t, err)
+	require.Equal(t, "1.1", res.String())
+
+	maxDec := sdk.MaxFixedDec(2)
+	_, err = maxDec.CheckedAdd(mustParseFixedDec(t, "0.01", 2))
+	require.ErrorIs(t, err, sdk.ErrFixedDecOverflow)
+	_, err = maxDec.Neg().CheckedSub(mustParseFixedDec(t, "0.01", 2))
+	require.ErrorIs(t, err, sdk.ErrFixedDecOverflow)
+	_, err = maxDec.CheckedMul(mustParseFixedDec(t, "2", 2))
+	require.ErrorIs(t, err, sdk.ErrFixedDecOverflow)
+	_, err = maxDec.CheckedQuo(mustParseFixedDec(t, "0.5", 2))
+	require.ErrorIs(t, err, sdk.ErrFixedDecOverflow)
+	_, err = maxDec.CheckedQuo(mustParseFixedDec(t, "0.001", 3))
+	require.ErrorIs(t, err, sdk.ErrFixedDecDivisionByZero)
+	_, err = maxDec.CheckedQuoTruncate(sdk.ZeroFixedDec(2))
+	require.ErrorIs(t, err, sdk.ErrFixedDecDivisionByZero)
+}
+
+func TestFixedDecSaturatingArithmetic(t *testing.T) {
+	maxDec := sdk.MaxFixedDec(4)
+	minDec := maxDec.Neg()
+	one := mustParseFixedDec(t, "1", 4)
+
+	require.True(t, maxDec.SaturatingAdd(one).Equal(maxDec))
+	require.True(t, minDec.SaturatingSub(one).Equal(minDec))
+	require.True(t, maxDec.SaturatingMul(maxDec).Equal(maxDec))
+	require.True(t, maxDec.SaturatingMulTruncate(minDec).Equal(minDec))
+
+	require.Equal(t, "2.0000", one.SaturatingAdd(one).String())
+	require.Equal(t, "0.0000", one.SaturatingSub(one).String())
+	require.Equal(t, "1.0000", one.SaturatingMul(one).String())
+}
+
+func TestFixedDecMatchesLegacyDec(t *testing.T) {
+	pairs := [][2]string{
+		{"1.123456789012345678", "3.333333333333333333"},
+		{"-1000000.000000000000000001", "0.000000000000000007"},
+		{"0.5", "0.000000000000000001"},
+		{"987654321.123456789", "-123456789.987654321"},
+	}
+
+	for _, pair := range pairs {
+		a, b := math.LegacyMustNewDecFromStr(pair[0]), math.LegacyMustNewDecFromStr(pair[1])
+		fa := mustParseFixedDec(t, pair[0], sdk.MaxFixedDecPrecision)
+		fb := mustParseFixedDec(t, pair[1], sdk.MaxFixedDecPrecision)
+
+		for _, c := range []struct {
+			exp math.LegacyDec
+			op  func(a, b sdk.FixedDec) (sdk.FixedDec, error)
+		}{
+			{a.Add(b), sdk.FixedDec.CheckedAdd},
+			{a.Sub(b), sdk.FixedDec.CheckedSub},
+			{a.Mul(b), sdk.FixedDec.CheckedMul},
+			{a.MulTruncate(b), sdk.FixedDec.CheckedMulTruncate},
+			{a.Quo(b), sdk.FixedDec.CheckedQuo},
+			{a.QuoTruncate(b), sdk.FixedDec.CheckedQuoTruncate},
+		} {
+			res, err := c.op(fa, fb)
+			require.NoError(t, err)
+			legacy, err := res.ToLegacyDec()
+			require.NoError(t, err)
+			require.True(t, c.exp.Equal(legacy), "%s: expected %s, got %s", pair, c.exp, legacy)
+		}
+	}
+}
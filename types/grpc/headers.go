@@ -3,4 +3,15 @@ package grpc
 const (
 	// GRPCBlockHeightHeader is the gRPC header for block height.
 	GRPCBlockHeightHeader = "x-cosmos-block-height"
+
+	// GRPCStateSyncRestoreHeightHeader is the gRPC response header for the
+	// height of the state sync snapshot being restored. It is only set while a
+	// snapshot restoration is in progress.
+	GRPCStateSyncRestoreHeightHeader = "x-cosmos-state-sync-restore-height"
+
+	// GRPCStateSyncRestoreChunksHeader is the gRPC response header for the
+	// progress of the state sync snapshot restoration, as the number of chunks
+	// applied over the total number of chunks, e.g. "3/10". It is only set while
+	// a snapshot restoration is in progress.
+	GRPCStateSyncRestoreChunksHeader = "x-cosmos-state-sync-restore-chunks"
 )
@@ -1,6 +1,9 @@
 package types
 
-import "fmt"
+import (
+	"context"
+	"fmt"
+)
 
 // An Invariant is a function which tests a particular invariant.
 // The invariant returns a descriptive message about what happened
@@ -11,11 +14,26 @@ type Invariant func(ctx Context) (string, bool)
 // Invariants defines a group of invariants
 type Invariants []Invariant
 
+// A TargetedInvariant is a function which tests an invariant for a single
+// subject, such as a validator or an account, rather than for the whole state.
+// It is cheap enough to be checked at the end of every block in which the state
+// of the subject changed. Invariants which do not apply to a subject are given
+// an empty subject.
+type TargetedInvariant func(ctx Context, subject []byte) (string, bool)
+
 // expected interface for registering invariants
 type InvariantRegistry interface {
 	RegisterRoute(moduleName, route string, invar Invariant)
 }
 
+// TargetedInvariantRegistry is the expected interface for registering targeted
+// invariants, and for scheduling their checks from the hooks called on the
+// relevant state changes.
+type TargetedInvariantRegistry interface {
+	RegisterTargetedRoute(moduleName, route string, invar TargetedInvariant)
+	ScheduleInvariantCheck(ctx context.Context, moduleName, route string, subject []byte)
+}
+
 // FormatInvariant returns a standardized invariant message.
 func FormatInvariant(module, name, msg string) string {
 	return fmt.Sprintf("%s: %s invariant\n%s\n", module, name, msg)
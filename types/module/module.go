@@ -38,6 +38,7 @@ import (
 	"cosmossdk.io/core/appmodule"
 	"cosmossdk.io/core/genesis"
 	abci "github.com/cometbft/cometbft/abci/types"
+	"github.com/cosmos/gogoproto/proto"
 	"github.com/grpc-ecosystem/grpc-gateway/runtime"
 	"github.com/spf13/cobra"
 	"golang.org/x/exp/maps"
@@ -219,6 +220,34 @@ type HasConsensusVersion interface {
 	ConsensusVersion() uint64
 }
 
+// HasStorePrefixes is the interface for declaring the prefixes of the keys a
+// module writes in its KV store. The writes outside of them are reported by
+// the store access audit of runtime. The modules not implementing it are
+// skipped by the audit, and listed as unaudited in the runtime ObjCapReport.
+type HasStorePrefixes interface {
+	// StorePrefixes returns the prefixes of the keys written by the module in
+	// its KV store.
+	StorePrefixes() [][]byte
+}
+
+// HasUpdatableParams is the interface for declaring the params of a module
+// which can be updated by an authority, e.g. x/gov, with a message. The params
+// of the modules are queried with the cosmos.reflection.v1.ParamsService.
+type HasUpdatableParams interface {
+	// UpdatableParams returns the params of the module and how to update them.
+	UpdatableParams() UpdatableParams
+}
+
+// UpdatableParams describes the params of a module and how to update them.
+type UpdatableParams struct {
+	// UpdateMsg is the message updating the params, e.g. &types.MsgUpdateParams{}.
+	UpdateMsg sdk.Msg
+	// Authority is the address of the authority allowed to update the params.
+	Authority string
+	// GetParams returns the current params.
+	GetParams func(ctx context.Context) (proto.Message, error)
+}
+
 // BeginBlockAppModule is an extension interface that contains information about the AppModule and BeginBlock.
 type BeginBlockAppModule interface {
 	AppModule
@@ -284,6 +313,10 @@ type Manager struct {
 	OrderPrepareCheckStaters []string
 	OrderPrecommiters        []string
 	OrderMigrations          []string
+
+	// BlockerGasLimits defines the gas limits of the Begin and EndBlockers of
+	// the modules, see SetBlockerGasLimit.
+	BlockerGasLimits map[string]uint64
 }
 
 // NewManager creates a new Manager object.
@@ -409,6 +442,40 @@ func (m *Manager) SetOrderMigrations(moduleNames ...string) {
 	m.OrderMigrations = moduleNames
 }
 
+// SetBlockerGasLimit sets the gas limit of the Begin and EndBlockers of a
+// module, zero removing it. Each blocker of the module then runs with a fresh
+// gas meter, and the queues processed by the blocker defer their remaining work
+// to the next blocks once the gas consumed reaches the limit, see
+// sdk.Context.IsBlockerGasExhausted. The gas limits only bound the work which
+// can be deferred, the blockers do not fail when exceeding them.
+func (m *Manager) SetBlockerGasLimit(moduleName string, limit uint64) {
+	if _, ok := m.Modules[moduleName]; !ok {
+		panic(fmt.Sprintf("module %s does not exist", moduleName))
+	}
+
+	if m.BlockerGasLimits == nil {
+		m.BlockerGasLimits = make(map[string]uint64)
+	}
+
+	if limit == 0 {
+		delete(m.BlockerGasLimits, moduleName)
+		return
+	}
+
+	m.BlockerGasLimits[moduleName] = limit
+}
+
+// blockerContext returns the context in which the Begin or EndBlocker of a
+// module is run, metering its gas when a blocker gas limit is set.
+func (m *Manager) blockerContext(ctx sdk.Context, moduleName string) sdk.Context {
+	limit, ok := m.BlockerGasLimits[moduleName]
+	if !ok {
+		return ctx
+	}
+
+	return ctx.WithGasMeter(storetypes.NewInfiniteGasMeter()).WithBlockerGasLimit(limit)
+}
+
 // RegisterInvariants registers all module invariants
 func (m *Manager) RegisterInvariants(ir sdk.InvariantRegistry) {
 	for _, module := range m.Modules {
@@ -706,6 +773,7 @@ func (m *Manager) BeginBlock(ctx sdk.Context, req abci.RequestBeginBlock) (abci.
 	ctx = ctx.WithEventManager(sdk.NewEventManager())
 
 	for _, moduleName := range m.OrderBeginBlockers {
+		ctx := m.blockerContext(ctx, moduleName)
 		if module, ok := m.Modules[moduleName].(BeginBlockAppModule); ok {
 			module.BeginBlock(ctx, req)
 		} else if module, ok := m.Modules[moduleName].(appmodule.HasBeginBlocker); ok {
@@ -729,6 +797,7 @@ func (m *Manager) EndBlock(ctx sdk.Context, req abci.RequestEndBlock) (abci.Resp
 	validatorUpdates := []abci.ValidatorUpdate{}
 
 	for _, moduleName := range m.OrderEndBlockers {
+		ctx := m.blockerContext(ctx, moduleName)
 		if module, ok := m.Modules[moduleName].(EndBlockAppModule); ok {
 			moduleValUpdates := module.EndBlock(ctx, req)
 
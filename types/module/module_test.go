@@ -9,6 +9,7 @@ import (
 
 	"cosmossdk.io/core/appmodule"
 	"cosmossdk.io/log"
+	storetypes "cosmossdk.io/store/types"
 	abci "github.com/cometbft/cometbft/abci/types"
 	cmtproto "github.com/cometbft/cometbft/proto/tendermint/types"
 	"github.com/golang/mock/gomock"
@@ -365,6 +366,46 @@ func TestManager_EndBlock(t *testing.T) {
 	require.Error(t, err)
 }
 
+func TestManager_BlockerGasLimits(t *testing.T) {
+	mockCtrl := gomock.NewController(t)
+	t.Cleanup(mockCtrl.Finish)
+
+	mockAppModule1 := mock.NewMockEndBlockAppModule(mockCtrl)
+	mockAppModule2 := mock.NewMockEndBlockAppModule(mockCtrl)
+	mockAppModule1.EXPECT().Name().Times(2).Return("module1")
+	mockAppModule2.EXPECT().Name().Times(2).Return("module2")
+	mm := module.NewManager(mockAppModule1, mockAppModule2)
+
+	require.Panics(t, func() { mm.SetBlockerGasLimit("module3", 100) })
+	mm.SetBlockerGasLimit("module1", 100)
+
+	req := abci.RequestEndBlock{Height: 10}
+	ctx := sdk.Context{}.WithGasMeter(storetypes.NewInfiniteGasMeter())
+	ctx.GasMeter().ConsumeGas(1000, "previous module")
+
+	// the limited module runs with a fresh gas meter
+	mockAppModule1.EXPECT().EndBlock(gomock.Any(), gomock.Eq(req)).Times(1).DoAndReturn(
+		func(ctx sdk.Context, _ abci.RequestEndBlock) []abci.ValidatorUpdate {
+			require.Equal(t, uint64(100), ctx.BlockerGasLimit())
+			require.False(t, ctx.IsBlockerGasExhausted())
+			ctx.GasMeter().ConsumeGas(100, "iteration")
+			require.True(t, ctx.IsBlockerGasExhausted())
+			return nil
+		})
+	mockAppModule2.EXPECT().EndBlock(gomock.Any(), gomock.Eq(req)).Times(1).DoAndReturn(
+		func(ctx sdk.Context, _ abci.RequestEndBlock) []abci.ValidatorUpdate {
+			require.Zero(t, ctx.BlockerGasLimit())
+			require.False(t, ctx.IsBlockerGasExhausted())
+			return nil
+		})
+	_, err := mm.EndBlock(ctx, req)
+	require.NoError(t, err)
+
+	// a zero limit removes it
+	mm.SetBlockerGasLimit("module1", 0)
+	require.Empty(t, mm.BlockerGasLimits)
+}
+
 // Core API exclusive tests
 func TestCoreAPIManager(t *testing.T) {
 	mockCtrl := gomock.NewController(t)
@@ -0,0 +1,255 @@
+package module
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"sort"
+
+	abci "github.com/cometbft/cometbft/abci/types"
+
+	"cosmossdk.io/collections"
+	collcodec "cosmossdk.io/collections/codec"
+	storetypes "cosmossdk.io/store/types"
+)
+
+// StreamableCollections declares the collections of a module whose changes are
+// streamed as typed change records.
+type StreamableCollections struct {
+	// StoreKey is the name of the store key the collections are stored under.
+	StoreKey string
+	// Collections are the streamed collections of the module store.
+	Collections []StreamedCollection
+}
+
+// StreamedCollection declares a streamed collection by its name, its prefix
+// and the codecs its entries are decoded with.
+type StreamedCollection struct {
+	name   string
+	prefix []byte
+	// decodeKey decodes the key of an entry, stripped of the prefix.
+	decodeKey func(key []byte) (any, error)
+	// decodeValue decodes the value of an entry.
+	decodeValue func(value []byte) (any, error)
+}
+
+// NewStreamedCollection returns the StreamedCollection of the map, key set or
+// indexed map named name, stored under prefix, whose keys and values are
+// decoded with keyCodec and valueCodec.
+func NewStreamedCollection[K, V any](prefix collections.Prefix, name string, keyCodec collcodec.KeyCodec[K], valueCodec collcodec.ValueCodec[V]) StreamedCollection {
+	return StreamedCollection{
+		name:   name,
+		prefix: prefix.Bytes(),
+		decodeKey: func(key []byte) (any, error) {
+			read, k, err := keyCodec.Decode(key)
+			if err != nil {
+				return nil, fmt.Errorf("%w: key decode: %s", collections.ErrEncoding, err)
+			}
+			if read != len(key) {
+				return nil, fmt.Errorf("%w: key decoder didn't fully consume the key: %T %x %d", collections.ErrEncoding, keyCodec, key, read)
+			}
+			return k, nil
+		},
+		decodeValue: decodeStreamedValue(valueCodec),
+	}
+}
+
+// NewStreamedItem returns the StreamedCollection of the item named name, stored
+// under prefix, whose value is decoded with valueCodec. The changes of an item
+// have no key.
+func NewStreamedItem[V any](prefix collections.Prefix, name string, valueCodec collcodec.ValueCodec[V]) StreamedCollection {
+	return StreamedCollection{
+		name:   name,
+		prefix: prefix.Bytes(),
+		decodeKey: func(key []byte) (any, error) {
+			if len(key) != 0 {
+				return nil, fmt.Errorf("%w: unexpected item key %x", collections.ErrEncoding, key)
+			}
+			return nil, nil
+		},
+		decodeValue: decodeStreamedValue(valueCodec),
+	}
+}
+
+func decodeStreamedValue[V any](valueCodec collcodec.ValueCodec[V]) func([]byte) (any, error) {
+	return func(value []byte) (any, error) {
+		v, err := valueCodec.Decode(value)
+		if err != nil {
+			return nil, fmt.Errorf("%w: value decode: %s", collections.ErrEncoding, err)
+		}
+		return v, nil
+	}
+}
+
+// HasStreamableCollections is the extension interface for modules streaming
+// the changes of their collections to the ABCI listeners.
+type HasStreamableCollections interface {
+	// StreamableCollections returns the collections of the module whose
+	// changes are streamed.
+	StreamableCollections() StreamableCollections
+}
+
+// CollectionChange is a typed change of a streamable collection of a module.
+type CollectionChange struct {
+	// Module is the name of the module owning the collection.
+	Module string
+	// Collection is the name of the collection the entry belongs to.
+	Collection string
+	// Key is the decoded key of the entry. It is nil for an item.
+	Key any
+	// Value is the decoded value of the entry. It is nil when the entry was
+	// deleted.
+	Value any
+	// Delete reports whether the entry was removed from the collection.
+	Delete bool
+}
+
+// CollectionChangeListener is the interface for consumers, such as indexers, of
+// the typed changes of the streamable collections.
+type CollectionChangeListener interface {
+	// ListenCollectionChanges is called after each commit with the changes of
+	// the block, in the order they were committed. The errors are logged and
+	// never affect the consensus state machine.
+	ListenCollectionChanges(ctx context.Context, changes []CollectionChange) error
+}
+
+var _ storetypes.ABCIListener = (*CollectionsStreamer)(nil)
+
+// CollectionsStreamer is an ABCIListener decoding the state changes streamed
+// after each commit into the typed changes of the collections declared by the
+// modules implementing HasStreamableCollections. The store keys returned by
+// StoreKeys must be exposed to the streaming listeners for the streamer to
+// receive the state changes.
+type CollectionsStreamer struct {
+	listener CollectionChangeListener
+	streams  map[string]collectionsStream // by store key name
+}
+
+type collectionsStream struct {
+	module      string
+	collections []StreamedCollection
+}
+
+// NewCollectionsStreamer returns a CollectionsStreamer passing the changes of
+// the streamable collections of the modules to listener.
+func (m *Manager) NewCollectionsStreamer(listener CollectionChangeListener) (*CollectionsStreamer, error) {
+	streamer := &CollectionsStreamer{
+		listener: listener,
+		streams:  make(map[string]collectionsStream),
+	}
+
+	moduleNames := m.ModuleNames()
+	sort.Strings(moduleNames)
+	for _, moduleName := range moduleNames {
+		mod, ok := m.Modules[moduleName].(HasStreamableCollections)
+		if !ok {
+			continue
+		}
+
+		streamable := mod.StreamableCollections()
+		if other, ok := streamer.streams[streamable.StoreKey]; ok {
+			return nil, fmt.Errorf("store key %s is streamed by both modules %s and %s", streamable.StoreKey, other.module, moduleName)
+		}
+
+		streamer.streams[streamable.StoreKey] = collectionsStream{
+			module:      moduleName,
+			collections: streamable.Collections,
+		}
+	}
+
+	return streamer, nil
+}
+
+// StoreKeys returns the sorted names of the store keys holding the streamable
+// collections.
+func (s *CollectionsStreamer) StoreKeys() []string {
+	storeKeys := make([]string, 0, len(s.streams))
+	for storeKey := range s.streams {
+		storeKeys = append(storeKeys, storeKey)
+	}
+	sort.Strings(storeKeys)
+
+	return storeKeys
+}
+
+// ListenBeginBlock implements the storetypes.ABCIListener interface.
+func (s *CollectionsStreamer) ListenBeginBlock(context.Context, abci.RequestBeginBlock, abci.ResponseBeginBlock) error {
+	return nil
+}
+
+// ListenEndBlock implements the storetypes.ABCIListener interface.
+func (s *CollectionsStreamer) ListenEndBlock(context.Context, abci.RequestEndBlock, abci.ResponseEndBlock) error {
+	return nil
+}
+
+// ListenDeliverTx implements the storetypes.ABCIListener interface.
+func (s *CollectionsStreamer) ListenDeliverTx(context.Context, abci.RequestDeliverTx, abci.ResponseDeliverTx) error {
+	return nil
+}
+
+// ListenCommit implements the storetypes.ABCIListener interface. It decodes the
+// committed state changes of the streamable collections and passes them to the
+// listener.
+func (s *CollectionsStreamer) ListenCommit(ctx context.Context, _ abci.ResponseCommit, changeSet []*storetypes.StoreKVPair) error {
+	changes, err := s.DecodeChanges(changeSet)
+	if err != nil {
+		return err
+	}
+
+	return s.listener.ListenCollectionChanges(ctx, changes)
+}
+
+// DecodeChanges decodes the state changes of the streamable collections from
+// changeSet, ignoring the changes of any other state.
+func (s *CollectionsStreamer) DecodeChanges(changeSet []*storetypes.StoreKVPair) ([]CollectionChange, error) {
+	var changes []CollectionChange
+	for _, pair := range changeSet {
+		stream, ok := s.streams[pair.StoreKey]
+		if !ok {
+			continue
+		}
+
+		change, found, err := stream.decodeChange(pair)
+		if err != nil {
+			return nil, fmt.Errorf("failed to decode %s state change %X: %w", stream.module, pair.Key, err)
+		}
+		if !found {
+			continue
+		}
+
+		changes = append(changes, change)
+	}
+
+	return changes, nil
+}
+
+// decodeChange decodes pair into the change of the streamed collection owning
+// its key. The returned boolean is false if no streamed collection owns the key.
+func (s collectionsStream) decodeChange(pair *storetypes.StoreKVPair) (CollectionChange, bool, error) {
+	// the prefixes of the collections of a store never overlap, so at most one
+	// collection owns the key
+	for _, coll := range s.collections {
+		if !bytes.HasPrefix(pair.Key, coll.prefix) {
+			continue
+		}
+
+		change := CollectionChange{Module: s.module, Collection: coll.name, Delete: pair.Delete}
+		key, err := coll.decodeKey(pair.Key[len(coll.prefix):])
+		if err != nil {
+			return change, true, err
+		}
+		change.Key = key
+
+		if !pair.Delete {
+			value, err := coll.decodeValue(pair.Value)
+			if err != nil {
+				return change, true, err
+			}
+			change.Value = value
+		}
+
+		return change, true, nil
+	}
+
+	return CollectionChange{}, false, nil
+}
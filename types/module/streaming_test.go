@@ -0,0 +1,81 @@
+package module_test
+
+import (
+	"context"
+	"testing"
+
+	"cosmossdk.io/collections"
+	"cosmossdk.io/core/appmodule"
+	storetypes "cosmossdk.io/store/types"
+	abci "github.com/cometbft/cometbft/abci/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/cosmos-sdk/types/module"
+)
+
+type streamableAppModule struct {
+	streamable module.StreamableCollections
+}
+
+func (streamableAppModule) IsOnePerModuleType() {}
+func (streamableAppModule) IsAppModule()        {}
+
+func (m streamableAppModule) StreamableCollections() module.StreamableCollections {
+	return m.streamable
+}
+
+type collectionChangeListenerFn func(context.Context, []module.CollectionChange) error
+
+func (f collectionChangeListenerFn) ListenCollectionChanges(ctx context.Context, changes []module.CollectionChange) error {
+	return f(ctx, changes)
+}
+
+func TestCollectionsStreamer(t *testing.T) {
+	mm := module.NewManagerFromMap(map[string]appmodule.AppModule{
+		"module1": streamableAppModule{module.StreamableCollections{
+			StoreKey: "store1",
+			Collections: []module.StreamedCollection{
+				module.NewStreamedCollection(collections.NewPrefix(0), "streamed", collections.StringKey, collections.Uint64Value),
+				module.NewStreamedItem(collections.NewPrefix(2), "item", collections.Uint64Value),
+			},
+		}},
+		"module2": MockCoreAppModule{},
+	})
+
+	var received []module.CollectionChange
+	streamer, err := mm.NewCollectionsStreamer(collectionChangeListenerFn(func(_ context.Context, changes []module.CollectionChange) error {
+		received = changes
+		return nil
+	}))
+	require.NoError(t, err)
+	require.Equal(t, []string{"store1"}, streamer.StoreKeys())
+
+	value, err := collections.Uint64Value.Encode(10)
+	require.NoError(t, err)
+
+	changeSet := []*storetypes.StoreKVPair{
+		{StoreKey: "store1", Key: append([]byte{0}, "a"...), Value: value},
+		{StoreKey: "store1", Key: append([]byte{1}, "b"...), Value: value},
+		{StoreKey: "store2", Key: append([]byte{0}, "c"...), Value: value},
+		{StoreKey: "store1", Key: append([]byte{0}, "d"...), Delete: true},
+		{StoreKey: "store1", Key: []byte{2}, Value: value},
+	}
+	require.NoError(t, streamer.ListenCommit(context.Background(), abci.ResponseCommit{}, changeSet))
+	require.Equal(t, []module.CollectionChange{
+		{Module: "module1", Collection: "streamed", Key: "a", Value: uint64(10)},
+		{Module: "module1", Collection: "streamed", Key: "d", Delete: true},
+		{Module: "module1", Collection: "item", Value: uint64(10)},
+	}, received)
+
+	// undecodable changes fail
+	_, err = streamer.DecodeChanges([]*storetypes.StoreKVPair{{StoreKey: "store1", Key: append([]byte{0}, "a"...), Value: []byte{1}}})
+	require.ErrorIs(t, err, collections.ErrEncoding)
+
+	// two modules cannot stream the same store
+	mm = module.NewManagerFromMap(map[string]appmodule.AppModule{
+		"module1": streamableAppModule{module.StreamableCollections{StoreKey: "store1"}},
+		"module2": streamableAppModule{module.StreamableCollections{StoreKey: "store1"}},
+	})
+	_, err = mm.NewCollectionsStreamer(nil)
+	require.ErrorContains(t, err, "store key store1 is streamed by both modules module1 and module2")
+}
@@ -0,0 +1,240 @@
+// Package retention defines the categories of purgeable data held by the txs,
+// e.g. their memos or the metadata of their messages, which node operators can
+// purge from the node-local indexes, e.g. to comply with a data retention
+// policy. The consensus state and the blocks are never modified.
+package retention
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"sort"
+
+	"github.com/cosmos/gogoproto/proto"
+
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/module"
+	"github.com/cosmos/cosmos-sdk/types/tx"
+)
+
+// HashPrefix prefixes the hashes replacing the purged data in ModeHash.
+const HashPrefix = "sha256:"
+
+// Mode defines how the purgeable data is purged.
+type Mode int
+
+const (
+	// ModeHash replaces the purged data with its hash, so that it can still be
+	// matched against a copy of the original data.
+	ModeHash Mode = iota
+	// ModeDelete replaces the purged data with an empty string.
+	ModeDelete
+)
+
+// ParseMode returns the mode of the given name, either "hash" or "delete".
+func ParseMode(s string) (Mode, error) {
+	switch s {
+	case "hash":
+		return ModeHash, nil
+	case "delete":
+		return ModeDelete, nil
+	default:
+		return 0, fmt.Errorf("invalid purge mode %q, expected hash or delete", s)
+	}
+}
+
+// String implements the fmt.Stringer interface.
+func (m Mode) String() string {
+	switch m {
+	case ModeHash:
+		return "hash"
+	case ModeDelete:
+		return "delete"
+	default:
+		return fmt.Sprintf("Mode(%d)", int(m))
+	}
+}
+
+// Redact returns the value replacing the purged value. Empty and already
+// hashed values are kept as they are, so that purging is idempotent.
+func (m Mode) Redact(value string) string {
+	if value == "" {
+		return value
+	}
+
+	switch m {
+	case ModeDelete:
+		return ""
+	default:
+		if len(value) == len(HashPrefix)+2*sha256.Size && value[:len(HashPrefix)] == HashPrefix {
+			return value
+		}
+		hash := sha256.Sum256([]byte(value))
+		return HashPrefix + hex.EncodeToString(hash[:])
+	}
+}
+
+// RedactFields replaces the values of the fields with their redacted values,
+// and returns whether any value changed.
+func RedactFields(redact func(string) string, fields ...*string) bool {
+	changed := false
+	for _, field := range fields {
+		if redacted := redact(*field); redacted != *field {
+			*field = redacted
+			changed = true
+		}
+	}
+	return changed
+}
+
+// PurgeFn purges the data of a category held by a message, replacing each
+// purgeable string with the result of redact, and returns whether the message
+// was changed. It is called with the body of each tx, and with each of its
+// messages.
+type PurgeFn func(msg proto.Message, redact func(string) string) (changed bool)
+
+// Category defines a category of purgeable data, e.g. the memos of the txs or
+// the metadata of the governance proposals.
+type Category struct {
+	// Name is the unique name of the category, prefixed by the name of the
+	// module registering it, e.g. "gov/proposal-metadata".
+	Name string
+	// Description is a short human readable description of the data.
+	Description string
+	// Purge purges the data of the category.
+	Purge PurgeFn
+}
+
+// HasPurgeableData is implemented by the module basics whose txs hold
+// purgeable data.
+type HasPurgeableData interface {
+	PurgeableData() []Category
+}
+
+// Registry holds the registered categories of purgeable data.
+type Registry struct {
+	categories map[string]Category
+}
+
+// NewRegistry returns an empty registry.
+func NewRegistry() *Registry {
+	return &Registry{categories: make(map[string]Category)}
+}
+
+// NewRegistryFromModules returns a registry holding the categories of the
+// modules implementing HasPurgeableData. It panics if two categories have the
+// same name.
+func NewRegistryFromModules(modules module.BasicManager) *Registry {
+	names := make([]string, 0, len(modules))
+	for name := range modules {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	r := NewRegistry()
+	for _, name := range names {
+		if m, ok := modules[name].(HasPurgeableData); ok {
+			if err := r.Register(m.PurgeableData()...); err != nil {
+				panic(err)
+			}
+		}
+	}
+
+	return r
+}
+
+// Register registers categories of purgeable data.
+func (r *Registry) Register(categories ...Category) error {
+	for _, c := range categories {
+		if c.Name == "" || c.Purge == nil {
+			return fmt.Errorf("purgeable data category %q must have a name and a purge function", c.Name)
+		}
+		if _, ok := r.categories[c.Name]; ok {
+			return fmt.Errorf("purgeable data category %q is already registered", c.Name)
+		}
+		r.categories[c.Name] = c
+	}
+
+	return nil
+}
+
+// Get returns the category of the given name.
+func (r *Registry) Get(name string) (Category, bool) {
+	c, ok := r.categories[name]
+	return c, ok
+}
+
+// Categories returns the registered categories, in name order.
+func (r *Registry) Categories() []Category {
+	categories := make([]Category, 0, len(r.categories))
+	for _, c := range r.categories {
+		categories = append(categories, c)
+	}
+	sort.Slice(categories, func(i, j int) bool {
+		return categories[i].Name < categories[j].Name
+	})
+
+	return categories
+}
+
+// Resolve returns the categories of the given names, or all the registered
+// categories if no name is given.
+func (r *Registry) Resolve(names ...string) ([]Category, error) {
+	if len(names) == 0 {
+		return r.Categories(), nil
+	}
+
+	categories := make([]Category, len(names))
+	for i, name := range names {
+		c, ok := r.Get(name)
+		if !ok {
+			return nil, fmt.Errorf("unknown purgeable data category %q", name)
+		}
+		categories[i] = c
+	}
+
+	return categories, nil
+}
+
+// PurgeTx purges the data of the categories from the body of a tx and from its
+// messages, the messages nested in other messages excepted. It returns
+// whether the tx was changed.
+func PurgeTx(t *tx.Tx, unpacker codectypes.AnyUnpacker, categories []Category, mode Mode) (bool, error) {
+	if t.Body == nil {
+		return false, nil
+	}
+
+	changed := false
+	for _, c := range categories {
+		if c.Purge(t.Body, mode.Redact) {
+			changed = true
+		}
+	}
+
+	for i, anyMsg := range t.Body.Messages {
+		var msg sdk.Msg
+		if err := unpacker.UnpackAny(anyMsg, &msg); err != nil {
+			return false, err
+		}
+
+		msgChanged := false
+		for _, c := range categories {
+			if c.Purge(msg, mode.Redact) {
+				msgChanged = true
+			}
+		}
+		if !msgChanged {
+			continue
+		}
+
+		repacked, err := codectypes.NewAnyWithValue(msg)
+		if err != nil {
+			return false, err
+		}
+		t.Body.Messages[i] = repacked
+		changed = true
+	}
+
+	return changed, nil
+}
@@ -0,0 +1,100 @@
+package retention_test
+
+import (
+	"testing"
+
+	"github.com/cosmos/gogoproto/proto"
+	"github.com/stretchr/testify/require"
+
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	"github.com/cosmos/cosmos-sdk/testutil/testdata"
+	"github.com/cosmos/cosmos-sdk/types/retention"
+	"github.com/cosmos/cosmos-sdk/types/tx"
+)
+
+var (
+	memoCategory = retention.Category{
+		Name: "test/memo",
+		Purge: func(msg proto.Message, redact func(string) string) bool {
+			body, ok := msg.(*tx.TxBody)
+			return ok && retention.RedactFields(redact, &body.Memo)
+		},
+	}
+	dogCategory = retention.Category{
+		Name: "test/dog",
+		Purge: func(msg proto.Message, redact func(string) string) bool {
+			dog, ok := msg.(*testdata.MsgCreateDog)
+			return ok && retention.RedactFields(redact, &dog.Dog.Name)
+		},
+	}
+)
+
+func TestModeRedact(t *testing.T) {
+	hashed := retention.ModeHash.Redact("my memo")
+	require.Len(t, hashed, len(retention.HashPrefix)+64)
+	require.Equal(t, hashed, retention.ModeHash.Redact(hashed))
+	require.NotEqual(t, hashed, retention.ModeHash.Redact("other memo"))
+	require.Empty(t, retention.ModeHash.Redact(""))
+
+	require.Empty(t, retention.ModeDelete.Redact("my memo"))
+
+	mode, err := retention.ParseMode("delete")
+	require.NoError(t, err)
+	require.Equal(t, retention.ModeDelete, mode)
+	_, err = retention.ParseMode("erase")
+	require.Error(t, err)
+}
+
+func TestRegistry(t *testing.T) {
+	registry := retention.NewRegistry()
+	require.NoError(t, registry.Register(memoCategory, dogCategory))
+	require.Error(t, registry.Register(memoCategory))
+	require.Error(t, registry.Register(retention.Category{Name: "test/nothing"}))
+
+	categories := registry.Categories()
+	require.Len(t, categories, 2)
+	require.Equal(t, "test/dog", categories[0].Name)
+
+	categories, err := registry.Resolve("test/memo")
+	require.NoError(t, err)
+	require.Len(t, categories, 1)
+	_, err = registry.Resolve("test/unknown")
+	require.Error(t, err)
+}
+
+func TestPurgeTx(t *testing.T) {
+	interfaceRegistry := codectypes.NewInterfaceRegistry()
+	testdata.RegisterInterfaces(interfaceRegistry)
+
+	dog, err := codectypes.NewAnyWithValue(&testdata.MsgCreateDog{Dog: &testdata.Dog{Name: "Spot"}})
+	require.NoError(t, err)
+	other, err := codectypes.NewAnyWithValue(testdata.NewTestMsg())
+	require.NoError(t, err)
+
+	newTx := func() *tx.Tx {
+		return &tx.Tx{Body: &tx.TxBody{Memo: "my memo", Messages: []*codectypes.Any{dog, other}}}
+	}
+
+	// only the data of the given categories is purged
+	purged := newTx()
+	changed, err := retention.PurgeTx(purged, interfaceRegistry, []retention.Category{dogCategory}, retention.ModeDelete)
+	require.NoError(t, err)
+	require.True(t, changed)
+	require.Equal(t, "my memo", purged.Body.Memo)
+	require.Equal(t, other, purged.Body.Messages[1])
+
+	var msg testdata.MsgCreateDog
+	require.NoError(t, msg.Unmarshal(purged.Body.Messages[0].Value))
+	require.Empty(t, msg.Dog.Name)
+
+	// purging is idempotent
+	purged = newTx()
+	changed, err = retention.PurgeTx(purged, interfaceRegistry, []retention.Category{memoCategory}, retention.ModeHash)
+	require.NoError(t, err)
+	require.True(t, changed)
+	require.Equal(t, retention.ModeHash.Redact("my memo"), purged.Body.Memo)
+
+	changed, err = retention.PurgeTx(purged, interfaceRegistry, []retention.Category{memoCategory}, retention.ModeHash)
+	require.NoError(t, err)
+	require.False(t, changed)
+}
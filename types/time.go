@@ -0,0 +1,45 @@
+package types
+
+import (
+	"context"
+	"fmt"
+	"time"
+)
+
+// ValidateBlockTime returns an error if the time of a block is before the time
+// of the previous block. A zero previous block time, e.g. for the first block
+// of the chain, is not checked.
+func ValidateBlockTime(prevBlockTime, blockTime time.Time) error {
+	if prevBlockTime.IsZero() {
+		return nil
+	}
+
+	if blockTime.Before(prevBlockTime) {
+		return fmt.Errorf("invalid block time: %s is before the previous block time %s", FormatTimeString(blockTime), FormatTimeString(prevBlockTime))
+	}
+
+	return nil
+}
+
+// BlockTimeSince returns the duration elapsed between t and the time of the
+// current block, or zero if t is after the time of the current block.
+func BlockTimeSince(ctx context.Context, t time.Time) time.Duration {
+	elapsed := UnwrapSDKContext(ctx).BlockTime().Sub(t)
+	if elapsed < 0 {
+		return 0
+	}
+
+	return elapsed
+}
+
+// BlockTimeUntil returns the duration remaining between the time of the
+// current block and t, or zero if t is not after the time of the current
+// block.
+func BlockTimeUntil(ctx context.Context, t time.Time) time.Duration {
+	remaining := t.Sub(UnwrapSDKContext(ctx).BlockTime())
+	if remaining < 0 {
+		return 0
+	}
+
+	return remaining
+}
@@ -0,0 +1,35 @@
+package types_test
+
+import (
+	"testing"
+	"time"
+
+	cmtproto "github.com/cometbft/cometbft/proto/tendermint/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/cosmos-sdk/types"
+)
+
+func TestValidateBlockTime(t *testing.T) {
+	prev := time.Date(2023, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	require.NoError(t, types.ValidateBlockTime(time.Time{}, prev))
+	require.NoError(t, types.ValidateBlockTime(time.Time{}, time.Time{}))
+	require.NoError(t, types.ValidateBlockTime(prev, prev))
+	require.NoError(t, types.ValidateBlockTime(prev, prev.Add(time.Nanosecond)))
+	require.EqualError(t, types.ValidateBlockTime(prev, prev.Add(-time.Second)),
+		"invalid block time: 2022-12-31T23:59:59.000000000 is before the previous block time 2023-01-01T00:00:00.000000000")
+}
+
+func TestBlockTimeSinceUntil(t *testing.T) {
+	blockTime := time.Date(2023, 1, 1, 0, 0, 0, 0, time.UTC)
+	ctx := types.Context{}.WithBlockHeader(cmtproto.Header{Time: blockTime})
+
+	require.Equal(t, time.Hour, types.BlockTimeSince(ctx, blockTime.Add(-time.Hour)))
+	require.Equal(t, time.Duration(0), types.BlockTimeSince(ctx, blockTime))
+	require.Equal(t, time.Duration(0), types.BlockTimeSince(ctx, blockTime.Add(time.Hour)))
+
+	require.Equal(t, time.Hour, types.BlockTimeUntil(ctx, blockTime.Add(time.Hour)))
+	require.Equal(t, time.Duration(0), types.BlockTimeUntil(ctx, blockTime))
+	require.Equal(t, time.Duration(0), types.BlockTimeUntil(ctx, blockTime.Add(-time.Hour)))
+}
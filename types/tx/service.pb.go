@@ -1121,6 +1121,146 @@ func (m *TxDecodeAminoResponse) GetAminoJson() string {
 	return ""
 }
 
+// SearchTxsRequest is the request type for the Service.SearchTxs
+// RPC method.
+type SearchTxsRequest struct {
+	// message_type_url, if set, restricts the results to the txs containing a
+	// message of this type, e.g. "/cosmos.bank.v1beta1.MsgSend".
+	MessageTypeUrl string `protobuf:"bytes,1,opt,name=message_type_url,json=messageTypeUrl,proto3" json:"message_type_url,omitempty"`
+	// signer, if set, restricts the results to the txs signed by this address.
+	Signer string `protobuf:"bytes,2,opt,name=signer,proto3" json:"signer,omitempty"`
+	// min_height, if set, is the lowest height of the returned txs.
+	MinHeight int64 `protobuf:"varint,3,opt,name=min_height,json=minHeight,proto3" json:"min_height,omitempty"`
+	// max_height, if set, is the highest height of the returned txs.
+	MaxHeight int64 `protobuf:"varint,4,opt,name=max_height,json=maxHeight,proto3" json:"max_height,omitempty"`
+	// pagination defines a pagination for the request.
+	Pagination *query.PageRequest `protobuf:"bytes,5,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *SearchTxsRequest) Reset()         { *m = SearchTxsRequest{} }
+func (m *SearchTxsRequest) String() string { return proto.CompactTextString(m) }
+func (*SearchTxsRequest) ProtoMessage()    {}
+func (*SearchTxsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e0b00a618705eca7, []int{18}
+}
+func (m *SearchTxsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SearchTxsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SearchTxsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *SearchTxsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SearchTxsRequest.Merge(m, src)
+}
+func (m *SearchTxsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *SearchTxsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_SearchTxsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SearchTxsRequest proto.InternalMessageInfo
+
+func (m *SearchTxsRequest) GetMessageTypeUrl() string {
+	if m != nil {
+		return m.MessageTypeUrl
+	}
+	return ""
+}
+
+func (m *SearchTxsRequest) GetSigner() string {
+	if m != nil {
+		return m.Signer
+	}
+	return ""
+}
+
+func (m *SearchTxsRequest) GetMinHeight() int64 {
+	if m != nil {
+		return m.MinHeight
+	}
+	return 0
+}
+
+func (m *SearchTxsRequest) GetMaxHeight() int64 {
+	if m != nil {
+		return m.MaxHeight
+	}
+	return 0
+}
+
+func (m *SearchTxsRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+// SearchTxsResponse is the response type for the Service.SearchTxs
+// RPC method.
+type SearchTxsResponse struct {
+	// tx_responses is the list of matching TxResponses, ordered by height.
+	TxResponses []*types.TxResponse `protobuf:"bytes,1,rep,name=tx_responses,json=txResponses,proto3" json:"tx_responses,omitempty"`
+	// pagination defines a pagination for the response.
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *SearchTxsResponse) Reset()         { *m = SearchTxsResponse{} }
+func (m *SearchTxsResponse) String() string { return proto.CompactTextString(m) }
+func (*SearchTxsResponse) ProtoMessage()    {}
+func (*SearchTxsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e0b00a618705eca7, []int{19}
+}
+func (m *SearchTxsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SearchTxsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SearchTxsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *SearchTxsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SearchTxsResponse.Merge(m, src)
+}
+func (m *SearchTxsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *SearchTxsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_SearchTxsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SearchTxsResponse proto.InternalMessageInfo
+
+func (m *SearchTxsResponse) GetTxResponses() []*types.TxResponse {
+	if m != nil {
+		return m.TxResponses
+	}
+	return nil
+}
+
+func (m *SearchTxsResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterEnum("cosmos.tx.v1beta1.OrderBy", OrderBy_name, OrderBy_value)
 	proto.RegisterEnum("cosmos.tx.v1beta1.BroadcastMode", BroadcastMode_name, BroadcastMode_value)
@@ -1142,90 +1282,100 @@ func init() {
 	proto.RegisterType((*TxEncodeAminoResponse)(nil), "cosmos.tx.v1beta1.TxEncodeAminoResponse")
 	proto.RegisterType((*TxDecodeAminoRequest)(nil), "cosmos.tx.v1beta1.TxDecodeAminoRequest")
 	proto.RegisterType((*TxDecodeAminoResponse)(nil), "cosmos.tx.v1beta1.TxDecodeAminoResponse")
+	proto.RegisterType((*SearchTxsRequest)(nil), "cosmos.tx.v1beta1.SearchTxsRequest")
+	proto.RegisterType((*SearchTxsResponse)(nil), "cosmos.tx.v1beta1.SearchTxsResponse")
 }
 
 func init() { proto.RegisterFile("cosmos/tx/v1beta1/service.proto", fileDescriptor_e0b00a618705eca7) }
 
 var fileDescriptor_e0b00a618705eca7 = []byte{
-	// 1237 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x57, 0x4d, 0x4f, 0x1b, 0x47,
-	0x18, 0x66, 0x6d, 0x83, 0xcd, 0x6b, 0x08, 0xce, 0x40, 0xc0, 0x38, 0xc4, 0x98, 0x0d, 0x1f, 0x8e,
-	0x55, 0xbc, 0x0a, 0x4d, 0xaa, 0x24, 0xaa, 0x54, 0xe1, 0x8f, 0x50, 0x92, 0x26, 0x44, 0x6b, 0xaa,
-	0x28, 0x55, 0x25, 0x6b, 0x6d, 0x4f, 0xec, 0x6d, 0xec, 0x5d, 0xe3, 0x19, 0xd0, 0x5a, 0x14, 0xb5,
-	0xea, 0xb1, 0x87, 0xaa, 0x52, 0x0f, 0xfd, 0x0f, 0xfd, 0x25, 0x3d, 0x46, 0xea, 0xa5, 0xc7, 0x0a,
-	0x7a, 0xea, 0xa9, 0x52, 0xff, 0x40, 0xb5, 0x33, 0xb3, 0xb6, 0xd7, 0xde, 0xc5, 0x90, 0x0b, 0xcc,
-	0xc7, 0xf3, 0xbe, 0xcf, 0x33, 0xef, 0xcc, 0x3c, 0x3b, 0x86, 0xd5, 0xaa, 0x49, 0x5a, 0x26, 0x51,
-	0xa8, 0xa5, 0x9c, 0xdc, 0xaf, 0x60, 0xaa, 0xdd, 0x57, 0x08, 0xee, 0x9c, 0xe8, 0x55, 0x9c, 0x6d,
-	0x77, 0x4c, 0x6a, 0xa2, 0x9b, 0x1c, 0x90, 0xa5, 0x56, 0x56, 0x00, 0x12, 0x2b, 0x75, 0xd3, 0xac,
-	0x37, 0xb1, 0xa2, 0xb5, 0x75, 0x45, 0x33, 0x0c, 0x93, 0x6a, 0x54, 0x37, 0x0d, 0xc2, 0x03, 0x12,
-	0x77, 0x45, 0xc6, 0x8a, 0x46, 0xb0, 0xa2, 0x55, 0xaa, 0x7a, 0x2f, 0xb1, 0xdd, 0x11, 0xa0, 0xc4,
-	0x28, 0x2d, 0xb5, 0xc4, 0x5c, 0x66, 0x30, 0xc1, 0xd1, 0x31, 0xee, 0x74, 0x7b, 0x98, 0xb6, 0x56,
-	0xd7, 0x0d, 0xc6, 0x26, 0xb0, 0x2b, 0x14, 0x1b, 0x35, 0xdc, 0x69, 0xe9, 0x06, 0x55, 0x68, 0xb7,
-	0x8d, 0x89, 0x52, 0x69, 0x9a, 0xd5, 0x77, 0xbe, 0xb3, 0xec, 0x2f, 0x9f, 0x95, 0xff, 0x93, 0x00,
-	0xed, 0x61, 0x7a, 0x68, 0x91, 0xe2, 0x09, 0x36, 0xa8, 0x8a, 0x8f, 0x8e, 0x31, 0xa1, 0x28, 0x01,
-	0x53, 0xd8, 0xee, 0x93, 0xb8, 0x94, 0x0a, 0xa6, 0xa7, 0x73, 0x81, 0xb8, 0xa4, 0x8a, 0x11, 0xf4,
-	0x0c, 0xa0, 0x2f, 0x21, 0x1e, 0x48, 0x49, 0xe9, 0xe8, 0xce, 0x66, 0x56, 0x54, 0xc8, 0xd6, 0x9b,
-	0x65, 0x7a, 0x9d, 0x4a, 0x65, 0x5f, 0x69, 0x75, 0x2c, 0xf2, 0xb2, 0x3c, 0x03, 0xd1, 0xe8, 0x21,
-	0x44, 0xcc, 0x4e, 0x0d, 0x77, 0xca, 0x95, 0x6e, 0x3c, 0x98, 0x92, 0xd2, 0x37, 0x76, 0x12, 0xd9,
-	0x91, 0x5a, 0x67, 0x0f, 0x6c, 0x48, 0xae, 0xab, 0x86, 0x4d, 0xde, 0x40, 0x08, 0x42, 0x6d, 0xad,
-	0x8e, 0xe3, 0xa1, 0x94, 0x94, 0x0e, 0xa9, 0xac, 0x8d, 0x16, 0x60, 0xb2, 0xa9, 0xb7, 0x74, 0x1a,
-	0x9f, 0x64, 0x83, 0xbc, 0x63, 0x8f, 0x32, 0x35, 0xf1, 0xa9, 0x94, 0x94, 0x9e, 0x56, 0x79, 0x47,
-	0xfe, 0x47, 0x82, 0x79, 0xd7, 0xaa, 0x49, 0xdb, 0x34, 0x08, 0x46, 0x5b, 0x10, 0xa4, 0x16, 0x5f,
-	0x73, 0x74, 0xe7, 0x96, 0x87, 0x92, 0x43, 0x4b, 0xb5, 0x11, 0x68, 0x0f, 0x66, 0xa8, 0x55, 0xee,
-	0x88, 0x38, 0x12, 0x0f, 0xb0, 0x88, 0x75, 0x57, 0x15, 0xd8, 0x4e, 0x0f, 0x04, 0x0a, 0xb0, 0x1a,
-	0xa5, 0xbd, 0x36, 0x41, 0xcf, 0x5d, 0xc5, 0x0c, 0xb2, 0x62, 0x6e, 0x8d, 0x2d, 0x26, 0x8f, 0x1e,
-	0xa9, 0xe6, 0x02, 0x4c, 0x52, 0x93, 0x6a, 0x4d, 0x51, 0x17, 0xde, 0x91, 0x31, 0xa0, 0x5c, 0xc7,
-	0xd4, 0x6a, 0x55, 0x8d, 0x50, 0x5b, 0x06, 0xdf, 0xe1, 0x65, 0x88, 0x50, 0xab, 0x5c, 0xe9, 0x52,
-	0x6c, 0xaf, 0x57, 0x4a, 0xcf, 0xa8, 0x61, 0x6a, 0xe5, 0xec, 0x2e, 0x7a, 0x00, 0xa1, 0x96, 0x59,
-	0xc3, 0x6c, 0x6b, 0x6f, 0xec, 0xa4, 0x3c, 0xca, 0xd0, 0xcb, 0xf7, 0xc2, 0xac, 0x61, 0x95, 0xa1,
-	0xe5, 0xaf, 0x61, 0xde, 0x45, 0x23, 0x4a, 0x5a, 0x84, 0xe8, 0x40, 0xa5, 0x18, 0xd5, 0x55, 0x0b,
-	0x05, 0xfd, 0x42, 0xc9, 0xaf, 0x61, 0xae, 0xa4, 0xb7, 0x8e, 0x9b, 0x1a, 0x75, 0xce, 0x12, 0xba,
-	0x07, 0x01, 0x6a, 0x89, 0x84, 0xde, 0x7b, 0xc5, 0x0a, 0x14, 0xa0, 0x96, 0x6b, 0xb1, 0x01, 0xd7,
-	0x62, 0xe5, 0x1f, 0x25, 0x88, 0xf5, 0x33, 0x0b, 0xd1, 0x9f, 0x42, 0xa4, 0xae, 0x91, 0xb2, 0x6e,
-	0xbc, 0x35, 0x05, 0xc1, 0x9a, 0xbf, 0xe2, 0x3d, 0x8d, 0xec, 0x1b, 0x6f, 0x4d, 0x35, 0x5c, 0xe7,
-	0x0d, 0xf4, 0x08, 0xa6, 0x3a, 0x98, 0x1c, 0x37, 0xa9, 0xb8, 0x1c, 0x29, 0xff, 0x58, 0x95, 0xe1,
-	0x54, 0x81, 0x97, 0x65, 0x98, 0x61, 0xc7, 0xd2, 0x59, 0x22, 0x82, 0x50, 0x43, 0x23, 0x0d, 0xa6,
-	0x61, 0x5a, 0x65, 0x6d, 0xf9, 0x0c, 0x66, 0x05, 0x46, 0x88, 0xdd, 0x18, 0x5b, 0x07, 0x56, 0x83,
-	0xa1, 0x8d, 0x08, 0x7c, 0xe0, 0x46, 0x58, 0xb0, 0xb8, 0x87, 0x69, 0xce, 0x36, 0x98, 0xd7, 0x3a,
-	0x6d, 0x1c, 0x5a, 0xc4, 0x11, 0xbb, 0x08, 0x53, 0x0d, 0xac, 0xd7, 0x1b, 0x94, 0x69, 0x09, 0xaa,
-	0xa2, 0x87, 0x9e, 0x7e, 0xb8, 0x5f, 0x0c, 0x9e, 0x6e, 0xf9, 0x5f, 0x09, 0x96, 0x46, 0xa8, 0xaf,
-	0x7b, 0x71, 0x1f, 0x40, 0x84, 0x99, 0x63, 0x59, 0xaf, 0x09, 0x29, 0xcb, 0xd9, 0xbe, 0x41, 0x66,
-	0xb9, 0x35, 0x32, 0x8a, 0xfd, 0x82, 0x1a, 0x66, 0xd0, 0xfd, 0x1a, 0xda, 0x86, 0x49, 0xd6, 0x14,
-	0x17, 0x74, 0xc9, 0x27, 0x44, 0xe5, 0x28, 0xb4, 0xe7, 0x5a, 0x71, 0xe8, 0x5a, 0x97, 0xda, 0xb5,
-	0xe4, 0x8f, 0x60, 0xee, 0xd0, 0x2a, 0xe0, 0xaa, 0x7d, 0xcb, 0xc6, 0xde, 0x5b, 0xf9, 0x31, 0xc4,
-	0xfa, 0xe8, 0x6b, 0x1d, 0x0e, 0xf9, 0x91, 0x4d, 0x54, 0x34, 0x06, 0x89, 0xae, 0x18, 0xb9, 0x6d,
-	0x93, 0x3a, 0x91, 0x82, 0xf4, 0x12, 0x8d, 0x0f, 0x61, 0xc1, 0x81, 0xef, 0xb6, 0x74, 0xc3, 0x74,
-	0xd8, 0xee, 0x00, 0x68, 0x76, 0xbf, 0xfc, 0x0d, 0x31, 0x0d, 0x71, 0xde, 0xa7, 0xd9, 0xc8, 0x33,
-	0x62, 0x1a, 0xf2, 0x13, 0xb8, 0x35, 0x14, 0x26, 0xa8, 0xd6, 0x60, 0x86, 0xc7, 0x55, 0x74, 0x43,
-	0xeb, 0x74, 0x05, 0x5d, 0x94, 0x8d, 0xe5, 0xd8, 0x90, 0xfc, 0xd8, 0xa6, 0xe4, 0x65, 0x71, 0x51,
-	0x5e, 0x21, 0xf4, 0x13, 0x9b, 0xd6, 0x15, 0x2a, 0x68, 0x2f, 0x97, 0x9b, 0xf9, 0x1c, 0xc2, 0xe2,
-	0x9b, 0x85, 0xe2, 0xb0, 0x70, 0xa0, 0x16, 0x8a, 0x6a, 0x39, 0xf7, 0xa6, 0xfc, 0xe5, 0xcb, 0xd2,
-	0xab, 0x62, 0x7e, 0xff, 0xe9, 0x7e, 0xb1, 0x10, 0x9b, 0x40, 0x31, 0x98, 0xe9, 0xcd, 0xec, 0x96,
-	0xf2, 0x31, 0x09, 0xdd, 0x84, 0xd9, 0xde, 0x48, 0xa1, 0x58, 0xca, 0xc7, 0x02, 0x99, 0xef, 0x25,
-	0x98, 0x75, 0xb9, 0x2d, 0x4a, 0x42, 0x22, 0xa7, 0x1e, 0xec, 0x16, 0xf2, 0xbb, 0xa5, 0xc3, 0xf2,
-	0x8b, 0x83, 0x42, 0x71, 0x28, 0xed, 0x0a, 0x2c, 0x0c, 0xcd, 0xe7, 0xbe, 0x38, 0xc8, 0x3f, 0x8f,
-	0x49, 0x89, 0x40, 0x44, 0x42, 0x4b, 0x30, 0x3f, 0x34, 0x5b, 0x7a, 0xf3, 0x32, 0x1f, 0x0b, 0xd8,
-	0x3a, 0x87, 0x26, 0x76, 0xd9, 0x4c, 0x70, 0xe7, 0xb7, 0x69, 0x08, 0x97, 0xf8, 0x73, 0x08, 0x9d,
-	0x42, 0xc4, 0x31, 0x4b, 0x24, 0x7b, 0x1c, 0x8a, 0x21, 0x8f, 0x4e, 0xdc, 0xbd, 0x14, 0x23, 0x2c,
-	0x65, 0xf3, 0x87, 0x3f, 0xfe, 0xfe, 0x25, 0x90, 0x7a, 0x22, 0x65, 0xe4, 0xdb, 0x8a, 0xc7, 0x53,
-	0xcc, 0x21, 0x3c, 0x82, 0x49, 0xe6, 0x7c, 0x68, 0xd5, 0x23, 0xeb, 0xa0, 0x6f, 0x26, 0x52, 0xfe,
-	0x00, 0xc1, 0xb9, 0xc1, 0x38, 0x57, 0xd1, 0x1d, 0xc5, 0xeb, 0x11, 0x46, 0x94, 0x53, 0xdb, 0x6b,
-	0xcf, 0xd0, 0x77, 0x10, 0x1d, 0xf8, 0xa8, 0xa1, 0x8d, 0xcb, 0xbe, 0x85, 0x7d, 0xfa, 0xcd, 0x71,
-	0x30, 0x21, 0x62, 0x8d, 0x89, 0xb8, 0x6d, 0x2f, 0x7c, 0xd1, 0x5b, 0x07, 0xfa, 0x16, 0xa2, 0x03,
-	0x0f, 0x15, 0x4f, 0x01, 0xa3, 0xcf, 0x37, 0x4f, 0x01, 0x1e, 0xef, 0x1d, 0x39, 0xc9, 0x04, 0xc4,
-	0x91, 0x1f, 0xfb, 0xaf, 0x12, 0xcc, 0x0d, 0x59, 0x2e, 0xba, 0xe7, 0x9d, 0xdb, 0xe3, 0x8b, 0x90,
-	0xc8, 0x5c, 0x05, 0x2a, 0xa4, 0x6c, 0x33, 0x29, 0x5b, 0x68, 0xc3, 0x67, 0x43, 0x98, 0xb3, 0x2a,
-	0xa7, 0xfc, 0x9b, 0x72, 0x86, 0xba, 0x10, 0x71, 0x6e, 0xa6, 0xe7, 0x41, 0x1c, 0xb2, 0x4d, 0xcf,
-	0x83, 0x38, 0x6c, 0x96, 0xf2, 0x3a, 0xd3, 0x90, 0xb4, 0xf7, 0x63, 0xd9, 0x43, 0x46, 0x8d, 0xd3,
-	0x31, 0x6a, 0xee, 0x45, 0x3e, 0xd4, 0x2e, 0x23, 0xf5, 0xa1, 0x76, 0x5b, 0xe6, 0x38, 0x6a, 0xcc,
-	0xe9, 0x7e, 0x92, 0x60, 0xd6, 0xe5, 0x83, 0x68, 0xeb, 0x92, 0xe4, 0x83, 0x6e, 0x97, 0x48, 0x8f,
-	0x07, 0x0a, 0x29, 0x19, 0x26, 0x65, 0xdd, 0x96, 0xb2, 0xea, 0x2b, 0x45, 0x61, 0x66, 0x27, 0x04,
-	0x0d, 0x38, 0xa4, 0x8f, 0xa0, 0x51, 0xfb, 0xf5, 0x11, 0xe4, 0x61, 0xb6, 0xe3, 0x04, 0xf1, 0x6d,
-	0xe1, 0x82, 0x72, 0x9f, 0xfd, 0x7e, 0x9e, 0x94, 0xde, 0x9f, 0x27, 0xa5, 0xbf, 0xce, 0x93, 0xd2,
-	0xcf, 0x17, 0xc9, 0x89, 0xf7, 0x17, 0xc9, 0x89, 0x3f, 0x2f, 0x92, 0x13, 0x5f, 0x6d, 0xd4, 0x75,
-	0xda, 0x38, 0xae, 0x64, 0xab, 0x66, 0xcb, 0x49, 0xc2, 0xff, 0x6d, 0x93, 0xda, 0x3b, 0xe7, 0x97,
-	0x91, 0x55, 0x99, 0x62, 0xbf, 0x8b, 0x3e, 0xfe, 0x3f, 0x00, 0x00, 0xff, 0xff, 0x42, 0xa8, 0xd7,
-	0x97, 0x14, 0x0e, 0x00, 0x00,
+	// 1367 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x57, 0xcf, 0x6f, 0x13, 0xc7,
+	0x17, 0xcf, 0xda, 0x4e, 0x62, 0x3f, 0x27, 0x60, 0x86, 0x00, 0x66, 0x01, 0xc7, 0x2c, 0xf9, 0x61,
+	0xa2, 0x6f, 0xbc, 0x22, 0x5f, 0xa8, 0x00, 0x55, 0xaa, 0xe2, 0xd8, 0x84, 0x40, 0x21, 0x68, 0x6d,
+	0x84, 0xa8, 0x2a, 0xad, 0xd6, 0xde, 0xc1, 0xd9, 0x62, 0xef, 0x9a, 0x9d, 0x09, 0x5a, 0x8b, 0xd2,
+	0x56, 0x3d, 0xf6, 0x50, 0x55, 0xea, 0xa1, 0x7f, 0x41, 0x2f, 0xfd, 0x4b, 0x7a, 0x44, 0xea, 0xa5,
+	0xea, 0xa9, 0x82, 0x9e, 0x7a, 0xaa, 0xd4, 0x7f, 0xa0, 0xda, 0xd9, 0x59, 0x7b, 0x77, 0xb3, 0xfe,
+	0x91, 0x5c, 0x92, 0x9d, 0x99, 0xcf, 0x7b, 0x9f, 0xcf, 0xbc, 0x99, 0xf7, 0xde, 0x18, 0x96, 0x5b,
+	0x16, 0xe9, 0x5a, 0x44, 0xa6, 0x8e, 0xfc, 0xfa, 0x46, 0x13, 0x53, 0xed, 0x86, 0x4c, 0xb0, 0xfd,
+	0xda, 0x68, 0xe1, 0x72, 0xcf, 0xb6, 0xa8, 0x85, 0xce, 0x78, 0x80, 0x32, 0x75, 0xca, 0x1c, 0x20,
+	0x5e, 0x6e, 0x5b, 0x56, 0xbb, 0x83, 0x65, 0xad, 0x67, 0xc8, 0x9a, 0x69, 0x5a, 0x54, 0xa3, 0x86,
+	0x65, 0x12, 0xcf, 0x40, 0xbc, 0xc6, 0x3d, 0x36, 0x35, 0x82, 0x65, 0xad, 0xd9, 0x32, 0x06, 0x8e,
+	0xdd, 0x01, 0x07, 0x89, 0x47, 0x69, 0xa9, 0xc3, 0xd7, 0x36, 0x82, 0x0e, 0x5e, 0x1d, 0x62, 0xbb,
+	0x3f, 0xc0, 0xf4, 0xb4, 0xb6, 0x61, 0x32, 0x36, 0x8e, 0xbd, 0x4c, 0xb1, 0xa9, 0x63, 0xbb, 0x6b,
+	0x98, 0x54, 0xa6, 0xfd, 0x1e, 0x26, 0x72, 0xb3, 0x63, 0xb5, 0x5e, 0x8e, 0x5c, 0x65, 0x7f, 0xbd,
+	0x55, 0xe9, 0x5f, 0x01, 0xd0, 0x2e, 0xa6, 0x0d, 0x87, 0xd4, 0x5e, 0x63, 0x93, 0x2a, 0xf8, 0xd5,
+	0x21, 0x26, 0x14, 0x89, 0x30, 0x87, 0xdd, 0x31, 0xc9, 0x0b, 0xc5, 0x64, 0x29, 0x53, 0x49, 0xe4,
+	0x05, 0x85, 0xcf, 0xa0, 0x07, 0x00, 0x43, 0x09, 0xf9, 0x44, 0x51, 0x28, 0x65, 0xb7, 0xd6, 0xca,
+	0x3c, 0x42, 0xae, 0xde, 0x32, 0xd3, 0xeb, 0x47, 0xaa, 0xfc, 0x44, 0x6b, 0x63, 0xee, 0x97, 0xf9,
+	0x09, 0x58, 0xa3, 0x5b, 0x90, 0xb6, 0x6c, 0x1d, 0xdb, 0x6a, 0xb3, 0x9f, 0x4f, 0x16, 0x85, 0xd2,
+	0xa9, 0x2d, 0xb1, 0x7c, 0x24, 0xd6, 0xe5, 0x7d, 0x17, 0x52, 0xe9, 0x2b, 0xf3, 0x96, 0xf7, 0x81,
+	0x10, 0xa4, 0x7a, 0x5a, 0x1b, 0xe7, 0x53, 0x45, 0xa1, 0x94, 0x52, 0xd8, 0x37, 0x5a, 0x82, 0xd9,
+	0x8e, 0xd1, 0x35, 0x68, 0x7e, 0x96, 0x4d, 0x7a, 0x03, 0x77, 0x96, 0xa9, 0xc9, 0xcf, 0x15, 0x85,
+	0x52, 0x46, 0xf1, 0x06, 0xd2, 0xdf, 0x02, 0x9c, 0x0d, 0xed, 0x9a, 0xf4, 0x2c, 0x93, 0x60, 0xb4,
+	0x0e, 0x49, 0xea, 0x78, 0x7b, 0xce, 0x6e, 0x9d, 0x8b, 0x51, 0xd2, 0x70, 0x14, 0x17, 0x81, 0x76,
+	0x61, 0x81, 0x3a, 0xaa, 0xcd, 0xed, 0x48, 0x3e, 0xc1, 0x2c, 0x56, 0x42, 0x51, 0x60, 0x27, 0x1d,
+	0x30, 0xe4, 0x60, 0x25, 0x4b, 0x07, 0xdf, 0x04, 0x3d, 0x0c, 0x05, 0x33, 0xc9, 0x82, 0xb9, 0x3e,
+	0x31, 0x98, 0x9e, 0xf5, 0x91, 0x68, 0x2e, 0xc1, 0x2c, 0xb5, 0xa8, 0xd6, 0xe1, 0x71, 0xf1, 0x06,
+	0x12, 0x06, 0x54, 0xb1, 0x2d, 0x4d, 0x6f, 0x69, 0x84, 0x36, 0x1c, 0x7e, 0x12, 0xe8, 0x22, 0xa4,
+	0xa9, 0xa3, 0x36, 0xfb, 0x14, 0xbb, 0xfb, 0x15, 0x4a, 0x0b, 0xca, 0x3c, 0x75, 0x2a, 0xee, 0x10,
+	0xdd, 0x84, 0x54, 0xd7, 0xd2, 0x31, 0x3b, 0xda, 0x53, 0x5b, 0xc5, 0x98, 0x30, 0x0c, 0xfc, 0x3d,
+	0xb2, 0x74, 0xac, 0x30, 0xb4, 0xf4, 0x39, 0x9c, 0x0d, 0xd1, 0xf0, 0x90, 0xd6, 0x20, 0x1b, 0x88,
+	0x14, 0xa3, 0x9a, 0x36, 0x50, 0x30, 0x0c, 0x94, 0xf4, 0x0c, 0x4e, 0xd7, 0x8d, 0xee, 0x61, 0x47,
+	0xa3, 0xfe, 0x5d, 0x42, 0xd7, 0x21, 0x41, 0x1d, 0xee, 0x30, 0xfe, 0xac, 0x58, 0x80, 0x12, 0xd4,
+	0x09, 0x6d, 0x36, 0x11, 0xda, 0xac, 0xf4, 0x9d, 0x00, 0xb9, 0xa1, 0x67, 0x2e, 0xfa, 0x63, 0x48,
+	0xb7, 0x35, 0xa2, 0x1a, 0xe6, 0x0b, 0x8b, 0x13, 0x5c, 0x1d, 0xad, 0x78, 0x57, 0x23, 0x7b, 0xe6,
+	0x0b, 0x4b, 0x99, 0x6f, 0x7b, 0x1f, 0xe8, 0x36, 0xcc, 0xd9, 0x98, 0x1c, 0x76, 0x28, 0x4f, 0x8e,
+	0xe2, 0x68, 0x5b, 0x85, 0xe1, 0x14, 0x8e, 0x97, 0x24, 0x58, 0x60, 0xd7, 0xd2, 0xdf, 0x22, 0x82,
+	0xd4, 0x81, 0x46, 0x0e, 0x98, 0x86, 0x8c, 0xc2, 0xbe, 0xa5, 0xb7, 0xb0, 0xc8, 0x31, 0x5c, 0xec,
+	0xea, 0xc4, 0x38, 0xb0, 0x18, 0x44, 0x0e, 0x22, 0x71, 0xc2, 0x83, 0x70, 0xe0, 0xfc, 0x2e, 0xa6,
+	0x15, 0xb7, 0xc0, 0x3c, 0x33, 0xe8, 0x41, 0xc3, 0x21, 0xbe, 0xd8, 0xf3, 0x30, 0x77, 0x80, 0x8d,
+	0xf6, 0x01, 0x65, 0x5a, 0x92, 0x0a, 0x1f, 0xa1, 0x7b, 0x27, 0xaf, 0x17, 0xc1, 0xdb, 0x2d, 0xfd,
+	0x23, 0xc0, 0x85, 0x23, 0xd4, 0xc7, 0x4d, 0xdc, 0x9b, 0x90, 0x66, 0xc5, 0x51, 0x35, 0x74, 0x2e,
+	0xe5, 0x62, 0x79, 0x58, 0x20, 0xcb, 0x5e, 0x69, 0x64, 0x14, 0x7b, 0x55, 0x65, 0x9e, 0x41, 0xf7,
+	0x74, 0xb4, 0x09, 0xb3, 0xec, 0x93, 0x27, 0xe8, 0x85, 0x11, 0x26, 0x8a, 0x87, 0x42, 0xbb, 0xa1,
+	0x1d, 0xa7, 0x8e, 0x95, 0xd4, 0xa1, 0x2d, 0xff, 0x0f, 0x4e, 0x37, 0x9c, 0x2a, 0x6e, 0x59, 0xba,
+	0x1f, 0x91, 0x31, 0x79, 0x2b, 0xdd, 0x81, 0xdc, 0x10, 0x7d, 0xac, 0xcb, 0x21, 0xdd, 0x76, 0x89,
+	0x6a, 0x66, 0x90, 0x68, 0x4a, 0xcb, 0x4d, 0xc8, 0x0d, 0x2d, 0x39, 0xe9, 0x18, 0x8d, 0xb7, 0x60,
+	0xc9, 0x87, 0x6f, 0x77, 0x0d, 0xd3, 0xf2, 0xd9, 0xae, 0x00, 0x68, 0xee, 0x58, 0xfd, 0x82, 0x58,
+	0x26, 0xbf, 0xef, 0x19, 0x36, 0xf3, 0x80, 0x58, 0xa6, 0x74, 0x17, 0xce, 0x45, 0xcc, 0x38, 0xd5,
+	0x55, 0x58, 0xf0, 0xec, 0x9a, 0x86, 0xa9, 0xd9, 0x7d, 0x4e, 0x97, 0x65, 0x73, 0x15, 0x36, 0x25,
+	0xdd, 0x81, 0x25, 0x3f, 0x2c, 0x21, 0xca, 0x29, 0x4c, 0x3f, 0x82, 0x73, 0x11, 0x53, 0x4e, 0x3b,
+	0x41, 0xee, 0x1f, 0x6e, 0x51, 0xc1, 0x9a, 0xdd, 0x0a, 0xe6, 0x47, 0x09, 0x72, 0x5d, 0x4c, 0x88,
+	0xd6, 0xc6, 0xaa, 0x7b, 0x67, 0xd4, 0x43, 0xbb, 0xc3, 0x2d, 0x4f, 0xf1, 0xf9, 0x46, 0xbf, 0x87,
+	0x9f, 0xda, 0x1d, 0x37, 0x93, 0x88, 0xd1, 0x36, 0xb1, 0xcd, 0xae, 0x68, 0x46, 0xe1, 0x23, 0x97,
+	0xb5, 0x6b, 0x98, 0x2a, 0xcf, 0xb2, 0x24, 0xcb, 0xb2, 0x4c, 0xd7, 0x30, 0xef, 0x7b, 0x89, 0xe6,
+	0x2e, 0x6b, 0x8e, 0xbf, 0x9c, 0xe2, 0xcb, 0x9a, 0x73, 0x3f, 0x2e, 0x0f, 0x67, 0x4f, 0x9c, 0x87,
+	0x3f, 0x0b, 0x70, 0x26, 0xb0, 0x39, 0x1e, 0x91, 0x68, 0x47, 0x14, 0x4e, 0xda, 0x11, 0x77, 0x63,
+	0xca, 0xc5, 0x49, 0x92, 0x67, 0xe3, 0x3e, 0xcc, 0xf3, 0x87, 0x03, 0xca, 0xc3, 0xd2, 0xbe, 0x52,
+	0xad, 0x29, 0x6a, 0xe5, 0xb9, 0xfa, 0xf4, 0x71, 0xfd, 0x49, 0x6d, 0x67, 0xef, 0xde, 0x5e, 0xad,
+	0x9a, 0x9b, 0x41, 0x39, 0x58, 0x18, 0xac, 0x6c, 0xd7, 0x77, 0x72, 0x02, 0x3a, 0x03, 0x8b, 0x83,
+	0x99, 0x6a, 0xad, 0xbe, 0x93, 0x4b, 0x6c, 0x7c, 0x23, 0xc0, 0x62, 0xa8, 0xe5, 0xa1, 0x02, 0x88,
+	0x15, 0x65, 0x7f, 0xbb, 0xba, 0xb3, 0x5d, 0x6f, 0xa8, 0x8f, 0xf6, 0xab, 0xb5, 0x88, 0xdb, 0xcb,
+	0xb0, 0x14, 0x59, 0xaf, 0x7c, 0xba, 0xbf, 0xf3, 0x30, 0x27, 0x88, 0x89, 0xb4, 0x80, 0x2e, 0xc0,
+	0xd9, 0xc8, 0x6a, 0xfd, 0xf9, 0xe3, 0x9d, 0x5c, 0xc2, 0xd5, 0x19, 0x59, 0xd8, 0x66, 0x2b, 0xc9,
+	0xad, 0x5f, 0x00, 0xe6, 0xeb, 0xde, 0x9b, 0x14, 0xbd, 0x81, 0xb4, 0xdf, 0xb1, 0x90, 0x14, 0x93,
+	0x99, 0x91, 0x46, 0x29, 0x5e, 0x1b, 0x8b, 0xe1, 0x75, 0x7d, 0xed, 0xdb, 0xdf, 0xfe, 0xfa, 0x31,
+	0x51, 0x94, 0x2e, 0xc9, 0x31, 0x8f, 0x61, 0x0e, 0xbe, 0x2b, 0x6c, 0xa0, 0x57, 0x30, 0xcb, 0xda,
+	0x0f, 0x5a, 0x8e, 0xf1, 0x1a, 0x6c, 0x5e, 0x62, 0x71, 0x34, 0x80, 0x73, 0xae, 0x32, 0xce, 0x65,
+	0x74, 0x45, 0x8e, 0x7b, 0x09, 0x13, 0xf9, 0x8d, 0xdb, 0xf0, 0xde, 0xa2, 0xaf, 0x21, 0x1b, 0x78,
+	0x59, 0xa0, 0xd5, 0x71, 0x0f, 0x92, 0x21, 0xfd, 0xda, 0x24, 0x18, 0x17, 0x71, 0x95, 0x89, 0xb8,
+	0x24, 0x9d, 0x8f, 0x17, 0xe1, 0xee, 0xf9, 0x4b, 0xc8, 0x06, 0x5e, 0x8b, 0xb1, 0x02, 0x8e, 0xbe,
+	0xa1, 0xc5, 0xb5, 0x49, 0x30, 0x2e, 0xa0, 0xc0, 0x04, 0xe4, 0xd1, 0x08, 0x01, 0xe8, 0x27, 0x01,
+	0x4e, 0x47, 0xfa, 0x1e, 0xba, 0x1e, 0xef, 0x3b, 0xa6, 0x2d, 0x8b, 0x1b, 0xd3, 0x40, 0xb9, 0x94,
+	0x4d, 0x26, 0x65, 0x1d, 0xad, 0x8e, 0x38, 0x10, 0xd6, 0xde, 0xe4, 0x37, 0x5e, 0x85, 0x79, 0x8b,
+	0xfa, 0x90, 0xf6, 0xcb, 0x63, 0xec, 0x45, 0x8c, 0xf4, 0x2e, 0xf1, 0xda, 0x58, 0x0c, 0xd7, 0xb0,
+	0xc2, 0x34, 0x14, 0xa4, 0x8b, 0x31, 0x1a, 0x74, 0x06, 0x75, 0x8f, 0x84, 0x51, 0xd7, 0xcc, 0x31,
+	0xd4, 0x35, 0x73, 0x32, 0x75, 0xcd, 0x9c, 0x9a, 0x1a, 0x9b, 0x3e, 0xf5, 0xf7, 0x02, 0x2c, 0x86,
+	0x9a, 0x11, 0x5a, 0x1f, 0xe3, 0x3c, 0xd8, 0x72, 0xc4, 0xd2, 0x64, 0x20, 0x97, 0xb2, 0xc1, 0xa4,
+	0xac, 0x48, 0xcb, 0x23, 0xa5, 0xc8, 0xac, 0xdd, 0x0c, 0x05, 0x55, 0xf1, 0x24, 0x41, 0x55, 0x3c,
+	0xa5, 0xa0, 0x2a, 0x3e, 0x9e, 0x20, 0x1d, 0x87, 0x05, 0x7d, 0x05, 0x99, 0x41, 0x83, 0x40, 0xb1,
+	0xd5, 0x27, 0xd2, 0x1b, 0xc5, 0x95, 0xf1, 0xa0, 0x70, 0x8d, 0x42, 0x85, 0x18, 0x0d, 0x86, 0xa9,
+	0x63, 0x07, 0xeb, 0x2a, 0x75, 0x48, 0xe5, 0x93, 0x5f, 0xdf, 0x17, 0x84, 0x77, 0xef, 0x0b, 0xc2,
+	0x9f, 0xef, 0x0b, 0xc2, 0x0f, 0x1f, 0x0a, 0x33, 0xef, 0x3e, 0x14, 0x66, 0x7e, 0xff, 0x50, 0x98,
+	0xf9, 0x6c, 0xb5, 0x6d, 0xd0, 0x83, 0xc3, 0x66, 0xb9, 0x65, 0x75, 0x7d, 0x1f, 0xde, 0xbf, 0x4d,
+	0xa2, 0xbf, 0xf4, 0x7f, 0x1e, 0x3b, 0xcd, 0x39, 0xf6, 0xe3, 0xf8, 0xff, 0xff, 0x0d, 0x00, 0xb1,
+	0xff, 0x46, 0xab, 0x19, 0x10, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -1268,6 +1418,10 @@ type ServiceClient interface {
 	//
 	// Since: cosmos-sdk 0.47
 	TxDecodeAmino(ctx context.Context, in *TxDecodeAminoRequest, opts ...grpc.CallOption) (*TxDecodeAminoResponse, error)
+	// SearchTxs searches the txs stored in the node-local tx index by message
+	// type URL, signer and height range. It is only available on nodes with
+	// the tx index enabled.
+	SearchTxs(ctx context.Context, in *SearchTxsRequest, opts ...grpc.CallOption) (*SearchTxsResponse, error)
 }
 
 type serviceClient struct {
@@ -1359,6 +1513,15 @@ func (c *serviceClient) TxDecodeAmino(ctx context.Context, in *TxDecodeAminoRequ
 	return out, nil
 }
 
+func (c *serviceClient) SearchTxs(ctx context.Context, in *SearchTxsRequest, opts ...grpc.CallOption) (*SearchTxsResponse, error) {
+	out := new(SearchTxsResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.tx.v1beta1.Service/SearchTxs", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // ServiceServer is the server API for Service service.
 type ServiceServer interface {
 	// Simulate simulates executing a transaction for estimating gas usage.
@@ -1389,6 +1552,10 @@ type ServiceServer interface {
 	//
 	// Since: cosmos-sdk 0.47
 	TxDecodeAmino(context.Context, *TxDecodeAminoRequest) (*TxDecodeAminoResponse, error)
+	// SearchTxs searches the txs stored in the node-local tx index by message
+	// type URL, signer and height range. It is only available on nodes with
+	// the tx index enabled.
+	SearchTxs(context.Context, *SearchTxsRequest) (*SearchTxsResponse, error)
 }
 
 // UnimplementedServiceServer can be embedded to have forward compatible implementations.
@@ -1422,6 +1589,9 @@ func (*UnimplementedServiceServer) TxEncodeAmino(ctx context.Context, req *TxEnc
 func (*UnimplementedServiceServer) TxDecodeAmino(ctx context.Context, req *TxDecodeAminoRequest) (*TxDecodeAminoResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method TxDecodeAmino not implemented")
 }
+func (*UnimplementedServiceServer) SearchTxs(ctx context.Context, req *SearchTxsRequest) (*SearchTxsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SearchTxs not implemented")
+}
 
 func RegisterServiceServer(s grpc1.Server, srv ServiceServer) {
 	s.RegisterService(&_Service_serviceDesc, srv)
@@ -1589,6 +1759,24 @@ func _Service_TxDecodeAmino_Handler(srv interface{}, ctx context.Context, dec fu
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Service_SearchTxs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SearchTxsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ServiceServer).SearchTxs(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.tx.v1beta1.Service/SearchTxs",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ServiceServer).SearchTxs(ctx, req.(*SearchTxsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Service_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "cosmos.tx.v1beta1.Service",
 	HandlerType: (*ServiceServer)(nil),
@@ -1629,6 +1817,10 @@ var _Service_serviceDesc = grpc.ServiceDesc{
 			MethodName: "TxDecodeAmino",
 			Handler:    _Service_TxDecodeAmino_Handler,
 		},
+		{
+			MethodName: "SearchTxs",
+			Handler:    _Service_SearchTxs_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "cosmos/tx/v1beta1/service.proto",
@@ -2367,6 +2559,114 @@ func (m *TxDecodeAminoResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *SearchTxsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SearchTxsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SearchTxsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintService(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.MaxHeight != 0 {
+		i = encodeVarintService(dAtA, i, uint64(m.MaxHeight))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.MinHeight != 0 {
+		i = encodeVarintService(dAtA, i, uint64(m.MinHeight))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Signer) > 0 {
+		i -= len(m.Signer)
+		copy(dAtA[i:], m.Signer)
+		i = encodeVarintService(dAtA, i, uint64(len(m.Signer)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.MessageTypeUrl) > 0 {
+		i -= len(m.MessageTypeUrl)
+		copy(dAtA[i:], m.MessageTypeUrl)
+		i = encodeVarintService(dAtA, i, uint64(len(m.MessageTypeUrl)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *SearchTxsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SearchTxsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SearchTxsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintService(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.TxResponses) > 0 {
+		for iNdEx := len(m.TxResponses) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.TxResponses[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintService(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintService(dAtA []byte, offset int, v uint64) int {
 	offset -= sovService(v)
 	base := offset
@@ -2678,6 +2978,52 @@ func (m *TxDecodeAminoResponse) Size() (n int) {
 	return n
 }
 
+func (m *SearchTxsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.MessageTypeUrl)
+	if l > 0 {
+		n += 1 + l + sovService(uint64(l))
+	}
+	l = len(m.Signer)
+	if l > 0 {
+		n += 1 + l + sovService(uint64(l))
+	}
+	if m.MinHeight != 0 {
+		n += 1 + sovService(uint64(m.MinHeight))
+	}
+	if m.MaxHeight != 0 {
+		n += 1 + sovService(uint64(m.MaxHeight))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovService(uint64(l))
+	}
+	return n
+}
+
+func (m *SearchTxsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.TxResponses) > 0 {
+		for _, e := range m.TxResponses {
+			l = e.Size()
+			n += 1 + l + sovService(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovService(uint64(l))
+	}
+	return n
+}
+
 func sovService(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -4668,6 +5014,314 @@ func (m *TxDecodeAminoResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *SearchTxsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowService
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SearchTxsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SearchTxsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MessageTypeUrl", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthService
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthService
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MessageTypeUrl = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Signer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthService
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthService
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Signer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinHeight", wireType)
+			}
+			m.MinHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MinHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxHeight", wireType)
+			}
+			m.MaxHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthService
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthService
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipService(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthService
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SearchTxsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowService
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SearchTxsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SearchTxsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TxResponses", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthService
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthService
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TxResponses = append(m.TxResponses, &types.TxResponse{})
+			if err := m.TxResponses[len(m.TxResponses)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthService
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthService
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipService(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthService
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipService(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
@@ -27,7 +27,7 @@ func NewValidateBasicDecorator() ValidateBasicDecorator {
 
 func (vbd ValidateBasicDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate bool, next sdk.AnteHandler) (sdk.Context, error) {
 	// no need to validate basic on recheck tx, call next antehandler
-	if ctx.IsReCheckTx() {
+	if ctx.ExecMode() == sdk.ExecModeReCheck {
 		return next(ctx, tx, simulate)
 	}
 
@@ -59,6 +59,15 @@ func (dfd DeductFeeDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate bo
 		if err != nil {
 			return ctx, err
 		}
+
+		// the minimum gas prices of the params apply to every tx but the
+		// genesis ones, whatever the validator
+		if ctx.BlockHeight() > 0 {
+			params := dfd.accountKeeper.GetParams(ctx)
+			if err := checkFeeMeetsMinGasPrices(fee, feeTx.GetGas(), params.MinGasPrices, params.MinGasPricesAllOf); err != nil {
+				return ctx, errorsmod.Wrap(err, "params minimum gas prices")
+			}
+		}
 	}
 	if err := dfd.checkDeductFee(ctx, tx, fee); err != nil {
 		return ctx, err
@@ -109,6 +109,69 @@ func TestEnsureMempoolFees(t *testing.T) {
 	require.Equal(t, int64(10), newCtx.Priority())
 }
 
+func TestMinGasPricesAllOf(t *testing.T) {
+	s := SetupTestSuite(t, true)
+	s.txBuilder = s.clientCtx.TxConfig.NewTxBuilder()
+
+	mfd := ante.NewDeductFeeDecorator(s.accountKeeper, s.bankKeeper, s.feeGrantKeeper, nil)
+	antehandler := sdk.ChainAnteDecorators(mfd)
+
+	accs := s.CreateTestAccounts(1)
+
+	// 150atom and 30stake for 15 gas, i.e. gas prices of 10atom and 2stake
+	feeAmount := sdk.NewCoins(sdk.NewInt64Coin("atom", 150), sdk.NewInt64Coin("stake", 30))
+	require.NoError(t, s.txBuilder.SetMsgs(testdata.NewTestMsg(accs[0].acc.GetAddress())))
+	s.txBuilder.SetFeeAmount(feeAmount)
+	s.txBuilder.SetGasLimit(15)
+
+	s.bankKeeper.EXPECT().SendCoinsFromAccountToModule(gomock.Any(), accs[0].acc.GetAddress(), authtypes.FeeCollectorName, feeAmount).Return(nil).AnyTimes()
+
+	privs, accNums, accSeqs := []cryptotypes.PrivKey{accs[0].priv}, []uint64{0}, []uint64{0}
+	tx, err := s.CreateTestTx(s.ctx, privs, accNums, accSeqs, s.ctx.ChainID(), signing.SignMode_SIGN_MODE_DIRECT)
+	require.NoError(t, err)
+
+	gasPrices := func(atom, stake int64) sdk.DecCoins {
+		return sdk.NewDecCoins(sdk.NewInt64DecCoin("atom", atom), sdk.NewInt64DecCoin("stake", stake))
+	}
+
+	// the validator minimum gas prices are met in any of the denoms by default
+	s.ctx = s.ctx.WithIsCheckTx(true).WithMinGasPrices(gasPrices(20, 2))
+	_, err = antehandler(s.ctx, tx, false)
+	require.NoError(t, err)
+
+	s.ctx = s.ctx.WithMinGasPricesAllOf(true)
+	_, err = antehandler(s.ctx, tx, false)
+	require.ErrorIs(t, err, sdkerrors.ErrInsufficientFee)
+
+	s.ctx = s.ctx.WithMinGasPrices(gasPrices(10, 2))
+	_, err = antehandler(s.ctx, tx, false)
+	require.NoError(t, err)
+
+	// the params minimum gas prices apply whatever the exec mode
+	s.ctx = s.ctx.WithIsCheckTx(false).WithMinGasPrices(sdk.DecCoins{}).WithMinGasPricesAllOf(false)
+
+	params := authtypes.DefaultParams()
+	params.MinGasPrices = gasPrices(20, 3)
+	require.NoError(t, s.accountKeeper.SetParams(s.ctx, params))
+	_, err = antehandler(s.ctx, tx, false)
+	require.ErrorIs(t, err, sdkerrors.ErrInsufficientFee)
+
+	params.MinGasPrices = gasPrices(20, 2)
+	require.NoError(t, s.accountKeeper.SetParams(s.ctx, params))
+	_, err = antehandler(s.ctx, tx, false)
+	require.NoError(t, err)
+
+	params.MinGasPricesAllOf = true
+	require.NoError(t, s.accountKeeper.SetParams(s.ctx, params))
+	_, err = antehandler(s.ctx, tx, false)
+	require.ErrorIs(t, err, sdkerrors.ErrInsufficientFee)
+
+	params.MinGasPrices = gasPrices(10, 2)
+	require.NoError(t, s.accountKeeper.SetParams(s.ctx, params))
+	_, err = antehandler(s.ctx, tx, false)
+	require.NoError(t, err)
+}
+
 func TestDeductFees(t *testing.T) {
 	s := SetupTestSuite(t, false)
 	s.txBuilder = s.clientCtx.TxConfig.NewTxBuilder()
@@ -23,23 +23,12 @@ func checkTxFeeWithValidatorMinGasPrices(ctx sdk.Context, tx sdk.Tx) (sdk.Coins,
 
 	// Ensure that the provided fees meet a minimum threshold for the validator,
 	// if this is a CheckTx. This is only for local mempool purposes, and thus
-	// is only ran on check tx.
-	if ctx.IsCheckTx() {
-		minGasPrices := ctx.MinGasPrices()
-		if !minGasPrices.IsZero() {
-			requiredFees := make(sdk.Coins, len(minGasPrices))
-
-			// Determine the required fees by multiplying each required minimum gas
-			// price by the gas limit, where fee = ceil(minGasPrice * gasLimit).
-			glDec := sdkmath.LegacyNewDec(int64(gas))
-			for i, gp := range minGasPrices {
-				fee := gp.Amount.Mul(glDec)
-				requiredFees[i] = sdk.NewCoin(gp.Denom, fee.Ceil().RoundInt())
-			}
-
-			if !feeCoins.IsAnyGTE(requiredFees) {
-				return nil, 0, errorsmod.Wrapf(sdkerrors.ErrInsufficientFee, "insufficient fees; got: %s required: %s", feeCoins, requiredFees)
-			}
+	// is only ran on check tx, as well as on recheck and simulation which run
+	// against the check state.
+	switch ctx.ExecMode() {
+	case sdk.ExecModeCheck, sdk.ExecModeReCheck, sdk.ExecModeSimulate:
+		if err := checkFeeMeetsMinGasPrices(feeCoins, gas, ctx.MinGasPrices(), ctx.MinGasPricesAllOf()); err != nil {
+			return nil, 0, err
 		}
 	}
 
@@ -47,6 +36,39 @@ func checkTxFeeWithValidatorMinGasPrices(ctx sdk.Context, tx sdk.Tx) (sdk.Coins,
 	return feeCoins, priority, nil
 }
 
+// checkFeeMeetsMinGasPrices checks that the fee meets the minimum gas prices for
+// the gas limit, in any of their denoms or, if allOf is true, in all of them.
+func checkFeeMeetsMinGasPrices(feeCoins sdk.Coins, gas uint64, minGasPrices sdk.DecCoins, allOf bool) error {
+	if minGasPrices.IsZero() {
+		return nil
+	}
+
+	requiredFees := make(sdk.Coins, len(minGasPrices))
+
+	// Determine the required fees by multiplying each required minimum gas
+	// price by the gas limit, where fee = ceil(minGasPrice * gasLimit).
+	glDec := sdkmath.LegacyNewDec(int64(gas))
+	for i, gp := range minGasPrices {
+		fee := gp.Amount.Mul(glDec)
+		requiredFees[i] = sdk.NewCoin(gp.Denom, fee.Ceil().RoundInt())
+	}
+
+	if allOf {
+		// the zero prices are free, so only the positive ones must be met
+		if !feeCoins.IsAllGTE(sdk.NewCoins(requiredFees...)) {
+			return errorsmod.Wrapf(sdkerrors.ErrInsufficientFee, "insufficient fees; got: %s required all of: %s", feeCoins, requiredFees)
+		}
+
+		return nil
+	}
+
+	if !feeCoins.IsAnyGTE(requiredFees) {
+		return errorsmod.Wrapf(sdkerrors.ErrInsufficientFee, "insufficient fees; got: %s required: %s", feeCoins, requiredFees)
+	}
+
+	return nil
+}
+
 // getTxPriority returns a naive tx priority based on the amount of the smallest denomination of the gas price
 // provided in a transaction.
 // NOTE: This implementation should be used with a great consideration as it opens potential attack vectors
@@ -0,0 +1,30 @@
+package keeper
+
+import (
+	"context"
+
+	"cosmossdk.io/core/address"
+
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/auth/types"
+)
+
+var _ ViewKeeper = AccountKeeper{}
+
+// ViewKeeper defines a module interface that facilitates read only access to
+// the accounts. Unlike GetModuleAccount, none of its methods creates an
+// account.
+type ViewKeeper interface {
+	GetParams(ctx context.Context) (params types.Params)
+	GetAddressCodec() address.Codec
+
+	HasAccount(ctx context.Context, addr sdk.AccAddress) bool
+	GetAccount(ctx context.Context, addr sdk.AccAddress) sdk.AccountI
+	GetPubKey(ctx context.Context, addr sdk.AccAddress) (cryptotypes.PubKey, error)
+	GetSequence(ctx context.Context, addr sdk.AccAddress) (uint64, error)
+	IterateAccounts(ctx context.Context, cb func(account sdk.AccountI) (stop bool))
+
+	GetModuleAddress(moduleName string) sdk.AccAddress
+	GetModulePermissions() map[string]types.PermissionsForAddress
+}
@@ -6,10 +6,12 @@ import (
 	"fmt"
 
 	abci "github.com/cometbft/cometbft/abci/types"
+	"github.com/cosmos/gogoproto/proto"
 	gwruntime "github.com/grpc-ecosystem/grpc-gateway/runtime"
 	"github.com/spf13/cobra"
 
 	"cosmossdk.io/depinject"
+	"cosmossdk.io/errors"
 	authcodec "github.com/cosmos/cosmos-sdk/x/auth/codec"
 
 	"cosmossdk.io/core/address"
@@ -23,6 +25,7 @@ import (
 	"github.com/cosmos/cosmos-sdk/codec"
 	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	"github.com/cosmos/cosmos-sdk/types/module"
 	simtypes "github.com/cosmos/cosmos-sdk/types/simulation"
 	"github.com/cosmos/cosmos-sdk/x/auth/client/cli"
@@ -42,6 +45,11 @@ var (
 	_ module.AppModuleSimulation = AppModule{}
 )
 
+// Module init related flags
+const (
+	FlagTxIndex = "x-auth-tx-index"
+)
+
 // AppModuleBasic defines the basic application module used by the auth module.
 type AppModuleBasic struct {
 	ac address.Codec
@@ -114,6 +122,11 @@ func (am AppModule) IsOnePerModuleType() {}
 // IsAppModule implements the appmodule.AppModule interface.
 func (am AppModule) IsAppModule() {}
 
+// AddModuleInitFlags implements servertypes.ModuleInitFlags interface.
+func AddModuleInitFlags(startCmd *cobra.Command) {
+	startCmd.Flags().Bool(FlagTxIndex, false, "Index the delivered txs by message type and signer in a node-local database, searchable through the tx service")
+}
+
 // NewAppModule creates a new AppModule object
 func NewAppModule(cdc codec.Codec, accountKeeper keeper.AccountKeeper, randGenAccountsFn types.RandomGenesisAccountsFn, ss exported.Subspace) AppModule {
 	return AppModule{
@@ -172,6 +185,18 @@ func (am AppModule) ExportGenesis(ctx sdk.Context, cdc codec.JSONCodec) json.Raw
 // ConsensusVersion implements AppModule/ConsensusVersion.
 func (AppModule) ConsensusVersion() uint64 { return ConsensusVersion }
 
+// UpdatableParams implements the module.HasUpdatableParams interface.
+func (am AppModule) UpdatableParams() module.UpdatableParams {
+	return module.UpdatableParams{
+		UpdateMsg: &types.MsgUpdateParams{},
+		Authority: am.accountKeeper.GetAuthority(),
+		GetParams: func(ctx context.Context) (proto.Message, error) {
+			params := am.accountKeeper.GetParams(ctx)
+			return &params, nil
+		},
+	}
+}
+
 // AppModuleSimulation functions
 
 // GenerateGenesisState creates a randomized GenState of the auth module
@@ -228,8 +253,10 @@ type ModuleInputs struct {
 type ModuleOutputs struct {
 	depinject.Out
 
-	AccountKeeper keeper.AccountKeeper
-	Module        appmodule.AppModule
+	AccountKeeper        keeper.AccountKeeper
+	ViewKeeper           keeper.ViewKeeper
+	Module               appmodule.AppModule
+	ParamsValidatorRoute govtypes.ParamsValidatorRoute
 }
 
 func ProvideModule(in ModuleInputs) ModuleOutputs {
@@ -255,5 +282,21 @@ func ProvideModule(in ModuleInputs) ModuleOutputs {
 	k := keeper.NewAccountKeeper(in.Cdc, in.StoreService, in.AccountI, maccPerms, in.Config.Bech32Prefix, authority.String())
 	m := NewAppModule(in.Cdc, k, in.RandomGenesisAccountsFn, in.LegacySubspace)
 
-	return ModuleOutputs{AccountKeeper: k, Module: m}
+	return ModuleOutputs{AccountKeeper: k, ViewKeeper: k, Module: m, ParamsValidatorRoute: NewParamsValidatorRoute()}
+}
+
+// NewParamsValidatorRoute returns the params validator route of the x/auth
+// MsgUpdateParams message, used by the x/gov MsgBatchUpdateParams message.
+func NewParamsValidatorRoute() govtypes.ParamsValidatorRoute {
+	return govtypes.ParamsValidatorRoute{
+		MsgTypeURL: sdk.MsgTypeURL(&types.MsgUpdateParams{}),
+		Validator: func(_ context.Context, msg sdk.Msg) error {
+			m, ok := msg.(*types.MsgUpdateParams)
+			if !ok {
+				return errors.Wrapf(sdkerrors.ErrInvalidType, "expected %T, got %T", &types.MsgUpdateParams{}, msg)
+			}
+
+			return m.Params.Validate()
+		},
+	}
 }
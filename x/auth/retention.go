@@ -0,0 +1,25 @@
+package auth
+
+import (
+	"github.com/cosmos/gogoproto/proto"
+
+	"github.com/cosmos/cosmos-sdk/types/retention"
+	"github.com/cosmos/cosmos-sdk/types/tx"
+)
+
+var _ retention.HasPurgeableData = AppModuleBasic{}
+
+// PurgeableData implements the retention.HasPurgeableData interface. The
+// memos of the txs are purgeable.
+func (AppModuleBasic) PurgeableData() []retention.Category {
+	return []retention.Category{
+		{
+			Name:        "auth/memo",
+			Description: "the memos of the txs",
+			Purge: func(msg proto.Message, redact func(string) string) bool {
+				body, ok := msg.(*tx.TxBody)
+				return ok && retention.RedactFields(redact, &body.Memo)
+			},
+		},
+	}
+}
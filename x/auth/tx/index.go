@@ -0,0 +1,337 @@
+package tx
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/binary"
+	"fmt"
+	"sync"
+	"time"
+
+	abci "github.com/cometbft/cometbft/abci/types"
+	coretypes "github.com/cometbft/cometbft/rpc/core/types"
+	dbm "github.com/cosmos/cosmos-db"
+
+	"cosmossdk.io/store/dbadapter"
+	"cosmossdk.io/store/prefix"
+	storetypes "cosmossdk.io/store/types"
+
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/address"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"github.com/cosmos/cosmos-sdk/types/retention"
+	txtypes "github.com/cosmos/cosmos-sdk/types/tx"
+)
+
+var (
+	// txResultPrefix stores the TxResponse of the indexed txs, keyed by height
+	// and index in the block.
+	txResultPrefix = []byte{0x01}
+	// msgTypeIndexPrefix indexes the txs by the type URLs of their messages.
+	msgTypeIndexPrefix = []byte{0x02}
+	// signerIndexPrefix indexes the txs by their signers.
+	signerIndexPrefix = []byte{0x03}
+)
+
+// TxIndexDBName is the name of the node-local database of the tx index, in
+// the data directory of the node.
+const TxIndexDBName = "txindex"
+
+// purgeBatchSize is the number of purged txs written at once.
+const purgeBatchSize = 1000
+
+// txPositionLen is the length of the key suffix locating a tx, made of its
+// height and its index in the block.
+const txPositionLen = 8 + 4
+
+var _ storetypes.ABCIListener = (*TxIndexer)(nil)
+
+// TxIndexer is an ABCIListener storing the results of the delivered txs in a
+// node-local database, indexed by message type URL and signer. It lets the tx
+// service search txs without relying on the CometBFT tx_search event matching.
+//
+// The index is not part of the consensus state: it only covers the blocks the
+// node executed since it was enabled.
+type TxIndexer struct {
+	db        dbm.DB
+	txDecoder sdk.TxDecoder
+
+	mtx     sync.Mutex
+	batch   dbm.Batch
+	txIndex uint32
+}
+
+// NewTxIndexer returns a TxIndexer storing the tx results in the given
+// database.
+func NewTxIndexer(db dbm.DB, txDecoder sdk.TxDecoder) *TxIndexer {
+	return &TxIndexer{
+		db:        db,
+		txDecoder: txDecoder,
+	}
+}
+
+// ListenBeginBlock implements the ABCIListener interface.
+func (idx *TxIndexer) ListenBeginBlock(context.Context, abci.RequestBeginBlock, abci.ResponseBeginBlock) error {
+	idx.mtx.Lock()
+	defer idx.mtx.Unlock()
+
+	idx.resetBatch()
+	return nil
+}
+
+// ListenEndBlock implements the ABCIListener interface.
+func (idx *TxIndexer) ListenEndBlock(context.Context, abci.RequestEndBlock, abci.ResponseEndBlock) error {
+	return nil
+}
+
+// ListenDeliverTx implements the ABCIListener interface. It indexes the
+// delivered tx, the index is written once the block is committed.
+func (idx *TxIndexer) ListenDeliverTx(goCtx context.Context, req abci.RequestDeliverTx, res abci.ResponseDeliverTx) error {
+	idx.mtx.Lock()
+	defer idx.mtx.Unlock()
+
+	if idx.batch == nil {
+		idx.resetBatch()
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	height, txIndex := ctx.BlockHeight(), idx.txIndex
+	idx.txIndex++
+
+	tx, err := idx.txDecoder(req.Tx)
+	if err != nil {
+		return err
+	}
+
+	p, ok := tx.(intoAny)
+	if !ok {
+		return fmt.Errorf("expecting a type implementing intoAny, got: %T", tx)
+	}
+
+	hash := sha256.Sum256(req.Tx)
+	txResponse := sdk.NewResponseResultTx(&coretypes.ResultTx{
+		Hash:     hash[:],
+		Height:   height,
+		Index:    txIndex,
+		TxResult: res,
+		Tx:       req.Tx,
+	}, p.AsAny(), ctx.BlockTime().Format(time.RFC3339))
+
+	bz, err := txResponse.Marshal()
+	if err != nil {
+		return err
+	}
+
+	position := txPosition(height, txIndex)
+	if err := idx.batch.Set(append(txResultPrefix, position...), bz); err != nil {
+		return err
+	}
+
+	typeURLs := make(map[string]struct{})
+	for _, msg := range tx.GetMsgs() {
+		typeURLs[sdk.MsgTypeURL(msg)] = struct{}{}
+	}
+	for typeURL := range typeURLs {
+		if err := idx.batch.Set(msgTypeIndexKey(typeURL, position), []byte{}); err != nil {
+			return err
+		}
+	}
+
+	if sigTx, ok := tx.(interface{ GetSigners() []sdk.AccAddress }); ok {
+		for _, signer := range sigTx.GetSigners() {
+			if err := idx.batch.Set(signerIndexKey(signer, position), []byte{}); err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+// ListenCommit implements the ABCIListener interface. It writes the index of
+// the txs of the committed block.
+func (idx *TxIndexer) ListenCommit(context.Context, abci.ResponseCommit, []*storetypes.StoreKVPair) error {
+	idx.mtx.Lock()
+	defer idx.mtx.Unlock()
+
+	if idx.batch == nil {
+		return nil
+	}
+
+	defer idx.resetBatch()
+	return idx.batch.Write()
+}
+
+func (idx *TxIndexer) resetBatch() {
+	if idx.batch != nil {
+		idx.batch.Close()
+	}
+
+	idx.batch = idx.db.NewBatch()
+	idx.txIndex = 0
+}
+
+// SearchTxs returns the indexed txs containing a message of the given type
+// URL, signed by the given signer and included between the given heights.
+// Empty filters are ignored.
+func (idx *TxIndexer) SearchTxs(
+	msgTypeURL string,
+	signer sdk.AccAddress,
+	minHeight, maxHeight int64,
+	pageReq *query.PageRequest,
+) ([]*sdk.TxResponse, *query.PageResponse, error) {
+	store := dbadapter.Store{DB: idx.db}
+
+	// iterate over the most selective index
+	var iterStore prefix.Store
+	switch {
+	case msgTypeURL != "":
+		iterStore = prefix.NewStore(store, msgTypeIndexKey(msgTypeURL, nil))
+	case len(signer) > 0:
+		iterStore = prefix.NewStore(store, signerIndexKey(signer, nil))
+	default:
+		iterStore = prefix.NewStore(store, txResultPrefix)
+	}
+
+	var txResponses []*sdk.TxResponse
+	pageRes, err := query.FilteredPaginate(iterStore, pageReq, func(position, _ []byte, accumulate bool) (bool, error) {
+		if len(position) != txPositionLen {
+			return false, nil
+		}
+
+		height := int64(binary.BigEndian.Uint64(position))
+		if (minHeight > 0 && height < minHeight) || (maxHeight > 0 && height > maxHeight) {
+			return false, nil
+		}
+
+		if msgTypeURL != "" && len(signer) > 0 {
+			signed, err := idx.db.Has(signerIndexKey(signer, position))
+			if err != nil || !signed {
+				return false, err
+			}
+		}
+
+		if !accumulate {
+			return true, nil
+		}
+
+		bz, err := idx.db.Get(append(txResultPrefix, position...))
+		if err != nil {
+			return false, err
+		}
+
+		var txResponse sdk.TxResponse
+		if err := txResponse.Unmarshal(bz); err != nil {
+			return false, err
+		}
+
+		txResponses = append(txResponses, &txResponse)
+		return true, nil
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return txResponses, pageRes, nil
+}
+
+// Purge purges the data of the given categories from the indexed txs included
+// up to maxHeight, or from all the indexed txs if maxHeight is 0, e.g. to
+// comply with a data retention policy. The txs are kept, with their purged
+// data replaced according to the mode, and remain searchable. It returns the
+// number of txs changed.
+func (idx *TxIndexer) Purge(unpacker codectypes.AnyUnpacker, categories []retention.Category, mode retention.Mode, maxHeight int64) (int, error) {
+	idx.mtx.Lock()
+	defer idx.mtx.Unlock()
+
+	end := storetypes.PrefixEndBytes(txResultPrefix)
+	if maxHeight > 0 {
+		end = append(append([]byte{}, txResultPrefix...), txPosition(maxHeight+1, 0)...)
+	}
+
+	it, err := idx.db.Iterator(txResultPrefix, end)
+	if err != nil {
+		return 0, err
+	}
+	defer it.Close()
+
+	batch := idx.db.NewBatch()
+	defer func() { batch.Close() }()
+
+	purged, pending := 0, 0
+	for ; it.Valid(); it.Next() {
+		var txResponse sdk.TxResponse
+		if err := txResponse.Unmarshal(it.Value()); err != nil {
+			return purged, err
+		}
+		if txResponse.Tx == nil {
+			continue
+		}
+
+		var t txtypes.Tx
+		if err := t.Unmarshal(txResponse.Tx.Value); err != nil {
+			return purged, err
+		}
+
+		changed, err := retention.PurgeTx(&t, unpacker, categories, mode)
+		if err != nil {
+			return purged, err
+		}
+		if !changed {
+			continue
+		}
+
+		if txResponse.Tx, err = codectypes.NewAnyWithValue(&t); err != nil {
+			return purged, err
+		}
+
+		bz, err := txResponse.Marshal()
+		if err != nil {
+			return purged, err
+		}
+		if err := batch.Set(append([]byte{}, it.Key()...), bz); err != nil {
+			return purged, err
+		}
+
+		purged++
+		pending++
+		if pending == purgeBatchSize {
+			if err := batch.Write(); err != nil {
+				return purged, err
+			}
+			batch.Close()
+			batch = idx.db.NewBatch()
+			pending = 0
+		}
+	}
+	if err := it.Error(); err != nil {
+		return purged, err
+	}
+
+	return purged, batch.Write()
+}
+
+// txPosition returns the key suffix locating a tx in the index.
+func txPosition(height int64, txIndex uint32) []byte {
+	bz := make([]byte, txPositionLen)
+	binary.BigEndian.PutUint64(bz, uint64(height))
+	binary.BigEndian.PutUint32(bz[8:], txIndex)
+	return bz
+}
+
+// msgTypeIndexKey returns the key indexing a tx by one of its message type
+// URLs: 0x02 | typeURLLen (1 byte) | typeURL | height | txIndex
+func msgTypeIndexKey(typeURL string, position []byte) []byte {
+	key := append([]byte{}, msgTypeIndexPrefix...)
+	key = append(key, address.MustLengthPrefix([]byte(typeURL))...)
+	return append(key, position...)
+}
+
+// signerIndexKey returns the key indexing a tx by one of its signers:
+// 0x03 | signerLen (1 byte) | signer | height | txIndex
+func signerIndexKey(signer sdk.AccAddress, position []byte) []byte {
+	key := append([]byte{}, signerIndexPrefix...)
+	key = append(key, address.MustLengthPrefix(signer)...)
+	return append(key, position...)
+}
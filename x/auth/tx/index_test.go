@@ -0,0 +1,150 @@
+package tx
+
+import (
+	"testing"
+
+	abci "github.com/cometbft/cometbft/abci/types"
+	dbm "github.com/cosmos/cosmos-db"
+	"github.com/cosmos/gogoproto/proto"
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	"github.com/cosmos/cosmos-sdk/std"
+	"github.com/cosmos/cosmos-sdk/testutil/testdata"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"github.com/cosmos/cosmos-sdk/types/retention"
+	txtypes "github.com/cosmos/cosmos-sdk/types/tx"
+)
+
+func TestTxIndexer(t *testing.T) {
+	interfaceRegistry := codectypes.NewInterfaceRegistry()
+	std.RegisterInterfaces(interfaceRegistry)
+	testdata.RegisterInterfaces(interfaceRegistry)
+	txConfig := NewTxConfig(codec.NewProtoCodec(interfaceRegistry), DefaultSignModes)
+
+	_, _, addr1 := testdata.KeyTestPubAddr()
+	_, _, addr2 := testdata.KeyTestPubAddr()
+
+	encodeTx := func(msgs ...sdk.Msg) []byte {
+		builder := txConfig.NewTxBuilder()
+		require.NoError(t, builder.SetMsgs(msgs...))
+		bz, err := txConfig.TxEncoder()(builder.GetTx())
+		require.NoError(t, err)
+		return bz
+	}
+
+	indexer := NewTxIndexer(dbm.NewMemDB(), txConfig.TxDecoder())
+	deliverBlock := func(height int64, txs ...[]byte) {
+		ctx := sdk.Context{}.WithBlockHeight(height)
+		require.NoError(t, indexer.ListenBeginBlock(ctx, abci.RequestBeginBlock{}, abci.ResponseBeginBlock{}))
+		for _, tx := range txs {
+			require.NoError(t, indexer.ListenDeliverTx(ctx, abci.RequestDeliverTx{Tx: tx}, abci.ResponseDeliverTx{}))
+		}
+		require.NoError(t, indexer.ListenEndBlock(ctx, abci.RequestEndBlock{}, abci.ResponseEndBlock{}))
+		require.NoError(t, indexer.ListenCommit(ctx, abci.ResponseCommit{}, nil))
+	}
+
+	testMsgTypeURL := sdk.MsgTypeURL(&testdata.TestMsg{})
+	dogMsgTypeURL := sdk.MsgTypeURL(&testdata.MsgCreateDog{})
+
+	deliverBlock(1, encodeTx(testdata.NewTestMsg(addr1)), encodeTx(&testdata.MsgCreateDog{Dog: &testdata.Dog{Name: "Spot"}}))
+	deliverBlock(2, encodeTx(testdata.NewTestMsg(addr2)))
+	deliverBlock(3, encodeTx(testdata.NewTestMsg(addr1, addr2), &testdata.MsgCreateDog{Dog: &testdata.Dog{Name: "Rex"}}))
+
+	heights := func(txResponses []*sdk.TxResponse) []int64 {
+		res := make([]int64, len(txResponses))
+		for i, txResponse := range txResponses {
+			res[i] = txResponse.Height
+		}
+		return res
+	}
+
+	testCases := []struct {
+		name      string
+		typeURL   string
+		signer    sdk.AccAddress
+		minHeight int64
+		maxHeight int64
+		expHeight []int64
+	}{
+		{"all txs", "", nil, 0, 0, []int64{1, 1, 2, 3}},
+		{"by message type", dogMsgTypeURL, nil, 0, 0, []int64{1, 3}},
+		{"by signer", "", addr1, 0, 0, []int64{1, 3}},
+		{"by message type and signer", testMsgTypeURL, addr2, 0, 0, []int64{2, 3}},
+		{"by height range", testMsgTypeURL, nil, 2, 2, []int64{2}},
+		{"from min height", "", addr2, 3, 0, []int64{3}},
+		{"no match", dogMsgTypeURL, addr2, 0, 2, []int64{}},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			txResponses, _, err := indexer.SearchTxs(tc.typeURL, tc.signer, tc.minHeight, tc.maxHeight, nil)
+			require.NoError(t, err)
+			require.Equal(t, tc.expHeight, heights(txResponses))
+		})
+	}
+
+	// the results are paginated
+	txResponses, pageRes, err := indexer.SearchTxs("", nil, 0, 0, &query.PageRequest{Limit: 1, Offset: 1, CountTotal: true})
+	require.NoError(t, err)
+	require.Len(t, txResponses, 1)
+	require.Equal(t, int64(1), txResponses[0].Height)
+	require.Equal(t, uint64(4), pageRes.Total)
+}
+
+func TestTxIndexerPurge(t *testing.T) {
+	interfaceRegistry := codectypes.NewInterfaceRegistry()
+	std.RegisterInterfaces(interfaceRegistry)
+	testdata.RegisterInterfaces(interfaceRegistry)
+	txConfig := NewTxConfig(codec.NewProtoCodec(interfaceRegistry), DefaultSignModes)
+
+	encodeTx := func(memo string) []byte {
+		builder := txConfig.NewTxBuilder()
+		require.NoError(t, builder.SetMsgs(&testdata.MsgCreateDog{Dog: &testdata.Dog{Name: "Spot"}}))
+		builder.SetMemo(memo)
+		bz, err := txConfig.TxEncoder()(builder.GetTx())
+		require.NoError(t, err)
+		return bz
+	}
+
+	indexer := NewTxIndexer(dbm.NewMemDB(), txConfig.TxDecoder())
+	for height := int64(1); height <= 3; height++ {
+		ctx := sdk.Context{}.WithBlockHeight(height)
+		require.NoError(t, indexer.ListenBeginBlock(ctx, abci.RequestBeginBlock{}, abci.ResponseBeginBlock{}))
+		require.NoError(t, indexer.ListenDeliverTx(ctx, abci.RequestDeliverTx{Tx: encodeTx("my memo")}, abci.ResponseDeliverTx{}))
+		require.NoError(t, indexer.ListenCommit(ctx, abci.ResponseCommit{}, nil))
+	}
+
+	memoCategory := retention.Category{
+		Name: "test/memo",
+		Purge: func(msg proto.Message, redact func(string) string) bool {
+			body, ok := msg.(*txtypes.TxBody)
+			return ok && retention.RedactFields(redact, &body.Memo)
+		},
+	}
+
+	// the txs up to the max height are purged, and remain searchable
+	purged, err := indexer.Purge(interfaceRegistry, []retention.Category{memoCategory}, retention.ModeHash, 2)
+	require.NoError(t, err)
+	require.Equal(t, 2, purged)
+
+	txResponses, _, err := indexer.SearchTxs(sdk.MsgTypeURL(&testdata.MsgCreateDog{}), nil, 0, 0, nil)
+	require.NoError(t, err)
+	require.Len(t, txResponses, 3)
+
+	memos := make([]string, len(txResponses))
+	for i, txResponse := range txResponses {
+		var tx txtypes.Tx
+		require.NoError(t, tx.Unmarshal(txResponse.Tx.Value))
+		memos[i] = tx.Body.Memo
+	}
+	hashed := retention.ModeHash.Redact("my memo")
+	require.Equal(t, []string{hashed, hashed, "my memo"}, memos)
+
+	// purging again only changes the txs not purged yet
+	purged, err = indexer.Purge(interfaceRegistry, []retention.Category{memoCategory}, retention.ModeHash, 0)
+	require.NoError(t, err)
+	require.Equal(t, 1, purged)
+}
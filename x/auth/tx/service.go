@@ -29,15 +29,32 @@ type txServer struct {
 	clientCtx         client.Context
 	simulate          baseAppSimulateFn
 	interfaceRegistry codectypes.InterfaceRegistry
+	indexer           *TxIndexer
+}
+
+// TxServerOption configures the Tx service server.
+type TxServerOption func(*txServer)
+
+// WithTxIndexer sets the node-local tx index searched by the SearchTxs RPC
+// method. A nil indexer leaves the method disabled.
+func WithTxIndexer(indexer *TxIndexer) TxServerOption {
+	return func(s *txServer) {
+		s.indexer = indexer
+	}
 }
 
 // NewTxServer creates a new Tx service server.
-func NewTxServer(clientCtx client.Context, simulate baseAppSimulateFn, interfaceRegistry codectypes.InterfaceRegistry) txtypes.ServiceServer {
-	return txServer{
+func NewTxServer(clientCtx client.Context, simulate baseAppSimulateFn, interfaceRegistry codectypes.InterfaceRegistry, opts ...TxServerOption) txtypes.ServiceServer {
+	s := txServer{
 		clientCtx:         clientCtx,
 		simulate:          simulate,
 		interfaceRegistry: interfaceRegistry,
 	}
+	for _, opt := range opts {
+		opt(&s)
+	}
+
+	return s
 }
 
 var _ txtypes.ServiceServer = txServer{}
@@ -304,16 +321,61 @@ func (s txServer) TxDecodeAmino(ctx context.Context, req *txtypes.TxDecodeAminoR
 	}, nil
 }
 
+// SearchTxs implements the ServiceServer.SearchTxs RPC method.
+func (s txServer) SearchTxs(ctx context.Context, req *txtypes.SearchTxsRequest) (*txtypes.SearchTxsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "request cannot be nil")
+	}
+
+	if s.indexer == nil {
+		return nil, status.Error(codes.Unavailable, "the tx index is not enabled on this node")
+	}
+
+	if req.MinHeight < 0 || req.MaxHeight < 0 {
+		return nil, status.Error(codes.InvalidArgument, "heights cannot be negative")
+	}
+
+	if req.MaxHeight > 0 && req.MinHeight > req.MaxHeight {
+		return nil, status.Errorf(codes.InvalidArgument, "min height %d is greater than max height %d", req.MinHeight, req.MaxHeight)
+	}
+
+	var signer sdk.AccAddress
+	if req.Signer != "" {
+		var err error
+		signer, err = sdk.AccAddressFromBech32(req.Signer)
+		if err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid signer address: %s", err)
+		}
+	}
+
+	txResponses, pageRes, err := s.indexer.SearchTxs(req.MessageTypeUrl, signer, req.MinHeight, req.MaxHeight, req.Pagination)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	for _, txResponse := range txResponses {
+		if err := txResponse.UnpackInterfaces(s.interfaceRegistry); err != nil {
+			return nil, status.Error(codes.Internal, err.Error())
+		}
+	}
+
+	return &txtypes.SearchTxsResponse{
+		TxResponses: txResponses,
+		Pagination:  pageRes,
+	}, nil
+}
+
 // RegisterTxService registers the tx service on the gRPC router.
 func RegisterTxService(
 	qrt gogogrpc.Server,
 	clientCtx client.Context,
 	simulateFn baseAppSimulateFn,
 	interfaceRegistry codectypes.InterfaceRegistry,
+	opts ...TxServerOption,
 ) {
 	txtypes.RegisterServiceServer(
 		qrt,
-		NewTxServer(clientCtx, simulateFn, interfaceRegistry),
+		NewTxServer(clientCtx, simulateFn, interfaceRegistry, opts...),
 	)
 }
 
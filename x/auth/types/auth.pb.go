@@ -7,6 +7,8 @@ import (
 	fmt "fmt"
 	_ "github.com/cosmos/cosmos-proto"
 	types "github.com/cosmos/cosmos-sdk/codec/types"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	types1 "github.com/cosmos/cosmos-sdk/types"
 	_ "github.com/cosmos/cosmos-sdk/types/tx/amino"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	proto "github.com/cosmos/gogoproto/proto"
@@ -174,6 +176,18 @@ type Params struct {
 	TxSizeCostPerByte      uint64 `protobuf:"varint,3,opt,name=tx_size_cost_per_byte,json=txSizeCostPerByte,proto3" json:"tx_size_cost_per_byte,omitempty"`
 	SigVerifyCostED25519   uint64 `protobuf:"varint,4,opt,name=sig_verify_cost_ed25519,json=sigVerifyCostEd25519,proto3" json:"sig_verify_cost_ed25519,omitempty"`
 	SigVerifyCostSecp256k1 uint64 `protobuf:"varint,5,opt,name=sig_verify_cost_secp256k1,json=sigVerifyCostSecp256k1,proto3" json:"sig_verify_cost_secp256k1,omitempty"`
+	// min_gas_prices defines the minimum gas prices the fee of every transaction
+	// must meet, enforced in consensus on top of the minimum gas prices of each
+	// validator. An empty list disables the requirement.
+	//
+	// Since: cosmos-sdk 0.48
+	MinGasPrices github_com_cosmos_cosmos_sdk_types.DecCoins `protobuf:"bytes,6,rep,name=min_gas_prices,json=minGasPrices,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.DecCoins" json:"min_gas_prices"`
+	// min_gas_prices_all_of requires the fee to meet the minimum of all the
+	// denoms of min_gas_prices, e.g. on dual-token chains, instead of any of
+	// them.
+	//
+	// Since: cosmos-sdk 0.48
+	MinGasPricesAllOf bool `protobuf:"varint,7,opt,name=min_gas_prices_all_of,json=minGasPricesAllOf,proto3" json:"min_gas_prices_all_of,omitempty"`
 }
 
 func (m *Params) Reset()         { *m = Params{} }
@@ -244,6 +258,20 @@ func (m *Params) GetSigVerifyCostSecp256k1() uint64 {
 	return 0
 }
 
+func (m *Params) GetMinGasPrices() github_com_cosmos_cosmos_sdk_types.DecCoins {
+	if m != nil {
+		return m.MinGasPrices
+	}
+	return nil
+}
+
+func (m *Params) GetMinGasPricesAllOf() bool {
+	if m != nil {
+		return m.MinGasPricesAllOf
+	}
+	return false
+}
+
 func init() {
 	proto.RegisterType((*BaseAccount)(nil), "cosmos.auth.v1beta1.BaseAccount")
 	proto.RegisterType((*ModuleAccount)(nil), "cosmos.auth.v1beta1.ModuleAccount")
@@ -254,53 +282,60 @@ func init() {
 func init() { proto.RegisterFile("cosmos/auth/v1beta1/auth.proto", fileDescriptor_7e1f7e915d020d2d) }
 
 var fileDescriptor_7e1f7e915d020d2d = []byte{
-	// 733 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x6c, 0x54, 0xc1, 0x4e, 0xeb, 0x46,
-	0x14, 0x8d, 0x93, 0x14, 0xca, 0x04, 0x68, 0x31, 0x29, 0x35, 0x51, 0x15, 0x9b, 0x48, 0x2d, 0x29,
-	0x2a, 0x76, 0x93, 0x8a, 0x4a, 0xcd, 0x8e, 0xa4, 0x55, 0x85, 0x28, 0x14, 0x39, 0x2a, 0x0b, 0x36,
-	0xd6, 0xd8, 0x19, 0xcc, 0x88, 0x8c, 0xc7, 0xf5, 0x8c, 0x51, 0xcc, 0xba, 0x0b, 0xd4, 0x55, 0xd5,
-	0x2f, 0xa0, 0xfd, 0x02, 0x16, 0x7c, 0x44, 0xd5, 0x15, 0xea, 0xa6, 0x6f, 0x15, 0x3d, 0x85, 0x05,
-	0xe8, 0xe9, 0x7d, 0xc4, 0x93, 0x67, 0x1c, 0x48, 0x78, 0xd9, 0x44, 0x9e, 0x73, 0xce, 0xbd, 0xf7,
-	0xdc, 0xe3, 0x89, 0x41, 0xd5, 0xa3, 0x8c, 0x50, 0x66, 0xc1, 0x98, 0x9f, 0x59, 0x17, 0x0d, 0x17,
-	0x71, 0xd8, 0x10, 0x07, 0x33, 0x8c, 0x28, 0xa7, 0xea, 0xaa, 0xe4, 0x4d, 0x01, 0x65, 0x7c, 0x65,
-	0x05, 0x12, 0x1c, 0x50, 0x4b, 0xfc, 0x4a, 0x5d, 0x65, 0x5d, 0xea, 0x1c, 0x71, 0xb2, 0xb2, 0x22,
-	0x49, 0x95, 0x7d, 0xea, 0x53, 0x89, 0xa7, 0x4f, 0xe3, 0x02, 0x9f, 0x52, 0xbf, 0x8f, 0x2c, 0x71,
-	0x72, 0xe3, 0x53, 0x0b, 0x06, 0x89, 0xa4, 0x6a, 0x7f, 0xe5, 0x41, 0xa9, 0x0d, 0x19, 0xda, 0xf5,
-	0x3c, 0x1a, 0x07, 0x5c, 0x6d, 0x82, 0x79, 0xd8, 0xeb, 0x45, 0x88, 0x31, 0x4d, 0x31, 0x94, 0xfa,
-	0x42, 0x5b, 0xfb, 0xef, 0x76, 0xbb, 0x9c, 0xcd, 0xd8, 0x95, 0x4c, 0x97, 0x47, 0x38, 0xf0, 0xed,
-	0xb1, 0x50, 0x3d, 0x06, 0xf3, 0x61, 0xec, 0x3a, 0xe7, 0x28, 0xd1, 0xf2, 0x86, 0x52, 0x2f, 0x35,
-	0xcb, 0xa6, 0x1c, 0x68, 0x8e, 0x07, 0x9a, 0xbb, 0x41, 0xd2, 0xde, 0x7c, 0x33, 0xd4, 0xcb, 0x61,
-	0xec, 0xf6, 0xb1, 0x97, 0x6a, 0xbf, 0xa2, 0x04, 0x73, 0x44, 0x42, 0x9e, 0xfc, 0xfd, 0x70, 0xb3,
-	0x05, 0x9e, 0x09, 0x7b, 0x2e, 0x8c, 0xdd, 0x7d, 0x94, 0xa8, 0x9f, 0x83, 0x65, 0x28, 0x6d, 0x39,
-	0x41, 0x4c, 0x5c, 0x14, 0x69, 0x05, 0x43, 0xa9, 0x17, 0xed, 0xa5, 0x0c, 0x3d, 0x14, 0xa0, 0x5a,
-	0x01, 0x1f, 0x32, 0xf4, 0x6b, 0x8c, 0x02, 0x0f, 0x69, 0x45, 0x21, 0x78, 0x3a, 0xb7, 0x3a, 0x57,
-	0xd7, 0x7a, 0xee, 0xf1, 0x5a, 0xcf, 0xfd, 0x7b, 0xbb, 0xfd, 0xd9, 0x8c, 0x78, 0xcd, 0x6c, 0xef,
-	0xbd, 0xdf, 0x1f, 0x6e, 0xb6, 0xd6, 0xa4, 0x60, 0x9b, 0xf5, 0xce, 0xad, 0x89, 0x4c, 0x6a, 0x6f,
-	0x15, 0xb0, 0x74, 0x40, 0x7b, 0x71, 0xff, 0x29, 0xa5, 0x3d, 0xb0, 0xe8, 0x42, 0x86, 0x9c, 0xcc,
-	0x88, 0x88, 0xaa, 0xd4, 0x34, 0xcc, 0x59, 0x13, 0x26, 0x3a, 0xb5, 0x8b, 0x77, 0x43, 0x5d, 0xb1,
-	0x4b, 0xee, 0x44, 0xe0, 0x2a, 0x28, 0x06, 0x90, 0x20, 0x91, 0xdc, 0x82, 0x2d, 0x9e, 0x55, 0x03,
-	0x94, 0x42, 0x14, 0x11, 0xcc, 0x18, 0xa6, 0x01, 0xd3, 0x0a, 0x46, 0xa1, 0xbe, 0x60, 0x4f, 0x42,
-	0xad, 0x93, 0x2b, 0xb9, 0x53, 0x6d, 0xd6, 0xc4, 0x29, 0xaf, 0x62, 0x33, 0x6d, 0x62, 0xb3, 0x29,
-	0xf6, 0xcf, 0x87, 0x9b, 0xad, 0x65, 0x22, 0x90, 0xf1, 0x32, 0xb5, 0xdf, 0x14, 0xf0, 0xb1, 0x14,
-	0x75, 0x22, 0xd4, 0x43, 0x01, 0xc7, 0xb0, 0xaf, 0xea, 0xa0, 0x94, 0xc9, 0x84, 0x5b, 0x71, 0x37,
-	0x6c, 0x20, 0xa1, 0xc3, 0xd4, 0xf3, 0x26, 0xf8, 0xa8, 0x87, 0x22, 0x7c, 0x01, 0x39, 0xa6, 0x41,
-	0xfa, 0x1a, 0x99, 0x96, 0x37, 0x0a, 0xf5, 0x45, 0x7b, 0xf9, 0x19, 0xde, 0x47, 0x09, 0x6b, 0x7d,
-	0x91, 0x1a, 0xda, 0x98, 0x30, 0xf4, 0x63, 0x44, 0xe3, 0x30, 0xf3, 0xf3, 0x3c, 0xb1, 0xf6, 0x7f,
-	0x1e, 0xcc, 0x1d, 0xc1, 0x08, 0x12, 0xa6, 0x9a, 0x60, 0x95, 0xc0, 0x81, 0x43, 0x10, 0xa1, 0x8e,
-	0x77, 0x06, 0x23, 0xe8, 0x71, 0x14, 0xc9, 0x0b, 0x5a, 0xb4, 0x57, 0x08, 0x1c, 0x1c, 0x20, 0x42,
-	0x3b, 0x4f, 0x84, 0x6a, 0x80, 0x45, 0x3e, 0x70, 0x18, 0xf6, 0x9d, 0x3e, 0x26, 0x98, 0x8b, 0x6c,
-	0x8b, 0x36, 0xe0, 0x83, 0x2e, 0xf6, 0x7f, 0x4a, 0x11, 0xf5, 0x6b, 0xf0, 0x89, 0x50, 0x5c, 0x22,
-	0xc7, 0xa3, 0x8c, 0x3b, 0x21, 0x8a, 0x1c, 0x37, 0xe1, 0x28, 0xbb, 0x61, 0x2b, 0xa9, 0xf4, 0x12,
-	0x75, 0x28, 0xe3, 0x47, 0x28, 0x6a, 0x27, 0x1c, 0xa9, 0x3f, 0x83, 0x4f, 0xd3, 0x86, 0x17, 0x28,
-	0xc2, 0xa7, 0x89, 0x2c, 0x42, 0xbd, 0xe6, 0xce, 0x4e, 0xe3, 0x3b, 0x79, 0xe9, 0xda, 0xda, 0x68,
-	0xa8, 0x97, 0xbb, 0xd8, 0x3f, 0x16, 0x8a, 0xb4, 0xf4, 0x87, 0xef, 0x05, 0x6f, 0x97, 0xd9, 0x14,
-	0x2a, 0xab, 0xd4, 0x5f, 0xc0, 0xfa, 0xcb, 0x86, 0x0c, 0x79, 0x61, 0x73, 0xe7, 0xdb, 0xf3, 0x86,
-	0xf6, 0x81, 0x68, 0x59, 0x19, 0x0d, 0xf5, 0xb5, 0xa9, 0x96, 0xdd, 0xb1, 0xc2, 0x5e, 0x63, 0x33,
-	0xf1, 0xd6, 0xc6, 0xe3, 0xb5, 0xae, 0xbc, 0x7c, 0xe7, 0x03, 0xf9, 0xcd, 0x91, 0x71, 0xb6, 0x3b,
-	0xff, 0x8c, 0xaa, 0xca, 0xdd, 0xa8, 0xaa, 0xbc, 0x1e, 0x55, 0x95, 0x3f, 0xee, 0xab, 0xb9, 0xbb,
-	0xfb, 0x6a, 0xee, 0xd5, 0x7d, 0x35, 0x77, 0xf2, 0xa5, 0x8f, 0xf9, 0x59, 0xec, 0x9a, 0x1e, 0x25,
-	0xd9, 0x77, 0xc5, 0x7a, 0xbf, 0x0b, 0x4f, 0x42, 0xc4, 0xdc, 0x39, 0xf1, 0xdf, 0xfe, 0xe6, 0x5d,
-	0x00, 0x00, 0x00, 0xff, 0xff, 0xc4, 0x84, 0x5b, 0x40, 0xd5, 0x04, 0x00, 0x00,
+	// 834 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x7c, 0x54, 0x41, 0x6f, 0xdc, 0x44,
+	0x14, 0x5e, 0x67, 0x97, 0xa4, 0x99, 0x4d, 0x03, 0x71, 0x97, 0xe0, 0x46, 0xd5, 0xda, 0x5d, 0x09,
+	0xba, 0x04, 0xe2, 0x25, 0x8b, 0x82, 0x20, 0xb7, 0xec, 0x16, 0x55, 0x55, 0x69, 0x1b, 0x39, 0xa2,
+	0x87, 0x5e, 0xac, 0xb1, 0xf7, 0xc5, 0x19, 0xc5, 0xe3, 0x31, 0x9e, 0x71, 0xb4, 0xae, 0x38, 0x22,
+	0x51, 0x71, 0x42, 0xfc, 0x82, 0xc0, 0x09, 0x71, 0xca, 0xa1, 0x3f, 0xa2, 0xe2, 0x14, 0x71, 0xe2,
+	0xb4, 0xa0, 0xcd, 0x21, 0x15, 0xe2, 0x47, 0x20, 0xcf, 0x78, 0x37, 0xde, 0x68, 0xc5, 0xc5, 0xf2,
+	0x7c, 0xdf, 0xf7, 0xde, 0xfb, 0xde, 0x9b, 0xa7, 0x41, 0x4d, 0x9f, 0x71, 0xca, 0x78, 0x07, 0xa7,
+	0xe2, 0xa8, 0x73, 0xb2, 0xed, 0x81, 0xc0, 0xdb, 0xf2, 0x60, 0xc7, 0x09, 0x13, 0x4c, 0xbf, 0xa5,
+	0x78, 0x5b, 0x42, 0x05, 0xbf, 0xb1, 0x86, 0x29, 0x89, 0x58, 0x47, 0x7e, 0x95, 0x6e, 0xe3, 0xb6,
+	0xd2, 0xb9, 0xf2, 0xd4, 0x29, 0x82, 0x14, 0xd5, 0x08, 0x58, 0xc0, 0x14, 0x9e, 0xff, 0x4d, 0x02,
+	0x02, 0xc6, 0x82, 0x10, 0x3a, 0xf2, 0xe4, 0xa5, 0x87, 0x1d, 0x1c, 0x65, 0x05, 0x35, 0xf1, 0xe4,
+	0x61, 0x0e, 0x53, 0x4f, 0x3e, 0x23, 0x91, 0xe2, 0x5b, 0x3f, 0x2f, 0xa0, 0x7a, 0x0f, 0x73, 0xd8,
+	0xf3, 0x7d, 0x96, 0x46, 0x42, 0xef, 0xa2, 0x25, 0x3c, 0x18, 0x24, 0xc0, 0xb9, 0xa1, 0x59, 0x5a,
+	0x7b, 0xb9, 0x67, 0xfc, 0xf1, 0x6a, 0xab, 0x51, 0x78, 0xd8, 0x53, 0xcc, 0x81, 0x48, 0x48, 0x14,
+	0x38, 0x13, 0xa1, 0xfe, 0x0c, 0x2d, 0xc5, 0xa9, 0xe7, 0x1e, 0x43, 0x66, 0x2c, 0x58, 0x5a, 0xbb,
+	0xde, 0x6d, 0xd8, 0xca, 0x90, 0x3d, 0x31, 0x64, 0xef, 0x45, 0x59, 0xef, 0xde, 0x3f, 0x23, 0xb3,
+	0x11, 0xa7, 0x5e, 0x48, 0xfc, 0x5c, 0xfb, 0x31, 0xa3, 0x44, 0x00, 0x8d, 0x45, 0xf6, 0xcb, 0xe5,
+	0xd9, 0x26, 0xba, 0x22, 0x9c, 0xc5, 0x38, 0xf5, 0x1e, 0x41, 0xa6, 0xbf, 0x8f, 0x56, 0xb1, 0xb2,
+	0xe5, 0x46, 0x29, 0xf5, 0x20, 0x31, 0xaa, 0x96, 0xd6, 0xae, 0x39, 0x37, 0x0b, 0xf4, 0x89, 0x04,
+	0xf5, 0x0d, 0x74, 0x83, 0xc3, 0x37, 0x29, 0x44, 0x3e, 0x18, 0x35, 0x29, 0x98, 0x9e, 0x77, 0xfb,
+	0x2f, 0x4f, 0xcd, 0xca, 0x9b, 0x53, 0xb3, 0xf2, 0xfb, 0xab, 0xad, 0x3b, 0x73, 0xc6, 0x6f, 0x17,
+	0x7d, 0x3f, 0xfc, 0xe1, 0xf2, 0x6c, 0x73, 0x5d, 0x09, 0xb6, 0xf8, 0xe0, 0xb8, 0x53, 0x9a, 0x49,
+	0xeb, 0x5f, 0x0d, 0xdd, 0x7c, 0xcc, 0x06, 0x69, 0x38, 0x9d, 0xd2, 0x43, 0xb4, 0x92, 0x0f, 0xd4,
+	0x2d, 0x8c, 0xc8, 0x51, 0xd5, 0xbb, 0x96, 0x3d, 0xaf, 0x42, 0x29, 0x53, 0xaf, 0x76, 0x3e, 0x32,
+	0x35, 0xa7, 0xee, 0x95, 0x06, 0xae, 0xa3, 0x5a, 0x84, 0x29, 0xc8, 0xc9, 0x2d, 0x3b, 0xf2, 0x5f,
+	0xb7, 0x50, 0x3d, 0x86, 0x84, 0x12, 0xce, 0x09, 0x8b, 0xb8, 0x51, 0xb5, 0xaa, 0xed, 0x65, 0xa7,
+	0x0c, 0xed, 0x3e, 0x7f, 0xa9, 0x7a, 0x6a, 0xcd, 0xab, 0x38, 0xe3, 0x55, 0x76, 0x66, 0x94, 0x3a,
+	0x9b, 0x61, 0x7f, 0xba, 0x3c, 0xdb, 0x5c, 0xa5, 0x12, 0x99, 0x34, 0xd3, 0xfa, 0x4e, 0x43, 0xef,
+	0x28, 0x51, 0x3f, 0x81, 0x01, 0x44, 0x82, 0xe0, 0x50, 0x37, 0x51, 0xbd, 0x90, 0x49, 0xb7, 0x72,
+	0x37, 0x1c, 0xa4, 0xa0, 0x27, 0xb9, 0xe7, 0x7b, 0xe8, 0xed, 0x01, 0x24, 0xe4, 0x04, 0x0b, 0xc2,
+	0xa2, 0xfc, 0x1a, 0xb9, 0xb1, 0x60, 0x55, 0xdb, 0x2b, 0xce, 0xea, 0x15, 0xfc, 0x08, 0x32, 0xbe,
+	0xfb, 0x41, 0x6e, 0xe8, 0x6e, 0xc9, 0xd0, 0x83, 0x84, 0xa5, 0x71, 0xe1, 0xe7, 0xaa, 0x62, 0xeb,
+	0xfb, 0x1a, 0x5a, 0xdc, 0xc7, 0x09, 0xa6, 0x5c, 0xb7, 0xd1, 0x2d, 0x8a, 0x87, 0x2e, 0x05, 0xca,
+	0x5c, 0xff, 0x08, 0x27, 0xd8, 0x17, 0x90, 0xa8, 0x05, 0xad, 0x39, 0x6b, 0x14, 0x0f, 0x1f, 0x03,
+	0x65, 0xfd, 0x29, 0xa1, 0x5b, 0x68, 0x45, 0x0c, 0x5d, 0x4e, 0x02, 0x37, 0x24, 0x94, 0x08, 0x39,
+	0xdb, 0x9a, 0x83, 0xc4, 0xf0, 0x80, 0x04, 0x5f, 0xe5, 0x88, 0xfe, 0x09, 0x7a, 0x57, 0x2a, 0x5e,
+	0x80, 0xeb, 0x33, 0x2e, 0xdc, 0x18, 0x12, 0xd7, 0xcb, 0x04, 0x14, 0x1b, 0xb6, 0x96, 0x4b, 0x5f,
+	0x40, 0x9f, 0x71, 0xb1, 0x0f, 0x49, 0x2f, 0x13, 0xa0, 0x3f, 0x45, 0xef, 0xe5, 0x09, 0x4f, 0x20,
+	0x21, 0x87, 0x99, 0x0a, 0x82, 0x41, 0x77, 0x67, 0x67, 0xfb, 0x0b, 0xb5, 0x74, 0x3d, 0x63, 0x3c,
+	0x32, 0x1b, 0x07, 0x24, 0x78, 0x26, 0x15, 0x79, 0xe8, 0x97, 0xf7, 0x25, 0xef, 0x34, 0xf8, 0x0c,
+	0xaa, 0xa2, 0xf4, 0xaf, 0xd1, 0xed, 0xeb, 0x09, 0x39, 0xf8, 0x71, 0x77, 0xe7, 0xb3, 0xe3, 0x6d,
+	0xe3, 0x2d, 0x99, 0x72, 0x63, 0x3c, 0x32, 0xd7, 0x67, 0x52, 0x1e, 0x4c, 0x14, 0xce, 0x3a, 0x9f,
+	0x8b, 0xeb, 0xdf, 0xa2, 0x55, 0x4a, 0x22, 0x37, 0xc0, 0xf9, 0xfb, 0x41, 0x7c, 0xe0, 0xc6, 0xa2,
+	0x55, 0x6d, 0xd7, 0xbb, 0x77, 0x26, 0xcb, 0x99, 0x2f, 0xdf, 0x74, 0x55, 0xee, 0x83, 0xdf, 0x67,
+	0x24, 0xea, 0x7d, 0xfe, 0x7a, 0x64, 0x56, 0x7e, 0xfb, 0xcb, 0xfc, 0x28, 0x20, 0xe2, 0x28, 0xf5,
+	0x6c, 0x9f, 0xd1, 0xe2, 0xe1, 0xe9, 0x94, 0x6e, 0x4a, 0x64, 0x31, 0xf0, 0x49, 0x0c, 0xff, 0xf5,
+	0xf2, 0x6c, 0x53, 0x73, 0x56, 0x28, 0x89, 0x1e, 0x60, 0xbe, 0x2f, 0x6b, 0xe5, 0x73, 0x9d, 0xad,
+	0xee, 0xe2, 0x30, 0x74, 0xd9, 0xa1, 0xb1, 0x64, 0x69, 0xed, 0x1b, 0xce, 0x5a, 0x59, 0xbc, 0x17,
+	0x86, 0x4f, 0x0f, 0x77, 0xef, 0xbe, 0x39, 0x35, 0xb5, 0xeb, 0x3b, 0x3a, 0x54, 0x6f, 0xa8, 0xba,
+	0xfe, 0x5e, 0xff, 0xf5, 0xb8, 0xa9, 0x9d, 0x8f, 0x9b, 0xda, 0xdf, 0xe3, 0xa6, 0xf6, 0xe3, 0x45,
+	0xb3, 0x72, 0x7e, 0xd1, 0xac, 0xfc, 0x79, 0xd1, 0xac, 0x3c, 0xff, 0xf0, 0x7f, 0xed, 0x16, 0x59,
+	0xa4, 0x6b, 0x6f, 0x51, 0xbe, 0x45, 0x9f, 0xfe, 0x37, 0x00, 0x72, 0x57, 0xc0, 0xc9, 0xa5, 0x05,
+	0x00, 0x00,
 }
 
 func (this *Params) Equal(that interface{}) bool {
@@ -337,6 +372,17 @@ func (this *Params) Equal(that interface{}) bool {
 	if this.SigVerifyCostSecp256k1 != that1.SigVerifyCostSecp256k1 {
 		return false
 	}
+	if len(this.MinGasPrices) != len(that1.MinGasPrices) {
+		return false
+	}
+	for i := range this.MinGasPrices {
+		if !this.MinGasPrices[i].Equal(&that1.MinGasPrices[i]) {
+			return false
+		}
+	}
+	if this.MinGasPricesAllOf != that1.MinGasPricesAllOf {
+		return false
+	}
 	return true
 }
 func (m *BaseAccount) Marshal() (dAtA []byte, err error) {
@@ -501,6 +547,30 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.MinGasPricesAllOf {
+		i--
+		if m.MinGasPricesAllOf {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x38
+	}
+	if len(m.MinGasPrices) > 0 {
+		for iNdEx := len(m.MinGasPrices) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.MinGasPrices[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintAuth(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x32
+		}
+	}
 	if m.SigVerifyCostSecp256k1 != 0 {
 		i = encodeVarintAuth(dAtA, i, uint64(m.SigVerifyCostSecp256k1))
 		i--
@@ -626,6 +696,15 @@ func (m *Params) Size() (n int) {
 	if m.SigVerifyCostSecp256k1 != 0 {
 		n += 1 + sovAuth(uint64(m.SigVerifyCostSecp256k1))
 	}
+	if len(m.MinGasPrices) > 0 {
+		for _, e := range m.MinGasPrices {
+			l = e.Size()
+			n += 1 + l + sovAuth(uint64(l))
+		}
+	}
+	if m.MinGasPricesAllOf {
+		n += 2
+	}
 	return n
 }
 
@@ -1179,6 +1258,60 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinGasPrices", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuth
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAuth
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuth
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MinGasPrices = append(m.MinGasPrices, types1.DecCoin{})
+			if err := m.MinGasPrices[len(m.MinGasPrices)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinGasPricesAllOf", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuth
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.MinGasPricesAllOf = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipAuth(dAtA[iNdEx:])
@@ -129,6 +129,9 @@ func (p Params) Validate() error {
 	if err := validateTxSizeCostPerByte(p.TxSizeCostPerByte); err != nil {
 		return err
 	}
+	if err := p.MinGasPrices.Validate(); err != nil {
+		return fmt.Errorf("invalid min gas prices: %w", err)
+	}
 
 	return nil
 }
@@ -38,3 +38,14 @@ type VestingAccount interface {
 	GetDelegatedFree() sdk.Coins
 	GetDelegatedVesting() sdk.Coins
 }
+
+// GrantableVestingAccount defines a vesting account whose original vesting can
+// be increased, e.g. by the staking rewards of its holder.
+type GrantableVestingAccount interface {
+	VestingAccount
+
+	// AddOriginalVesting adds coins to the original vesting amount of the
+	// account, the coins vesting along its vesting schedule. The coins must be
+	// added to the balance of the account by the caller.
+	AddOriginalVesting(amount sdk.Coins)
+}
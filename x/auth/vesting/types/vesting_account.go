@@ -17,6 +17,11 @@ var (
 	_ vestexported.VestingAccount = (*ContinuousVestingAccount)(nil)
 	_ vestexported.VestingAccount = (*PeriodicVestingAccount)(nil)
 	_ vestexported.VestingAccount = (*DelayedVestingAccount)(nil)
+
+	_ vestexported.GrantableVestingAccount = (*ContinuousVestingAccount)(nil)
+	_ vestexported.GrantableVestingAccount = (*PeriodicVestingAccount)(nil)
+	_ vestexported.GrantableVestingAccount = (*DelayedVestingAccount)(nil)
+	_ vestexported.GrantableVestingAccount = (*PermanentLockedAccount)(nil)
 )
 
 // Base Vesting Account
@@ -120,6 +125,14 @@ func (bva *BaseVestingAccount) TrackUndelegation(amount sdk.Coins) {
 	}
 }
 
+// AddOriginalVesting adds coins to the original vesting amount of the account,
+// the coins vesting along its vesting schedule. As the vested coins of a
+// continuous vesting account are proportional to the elapsed time, the share
+// of the added coins matching the elapsed time is vested immediately.
+func (bva *BaseVestingAccount) AddOriginalVesting(amount sdk.Coins) {
+	bva.OriginalVesting = bva.OriginalVesting.Add(amount...)
+}
+
 // GetOriginalVesting returns a vesting account's original vesting amount
 func (bva BaseVestingAccount) GetOriginalVesting() sdk.Coins {
 	return bva.OriginalVesting
@@ -333,6 +346,20 @@ func (pva PeriodicVestingAccount) GetStartTime() int64 {
 	return pva.StartTime
 }
 
+// AddOriginalVesting adds coins to the original vesting amount of the account,
+// the coins vesting with its last vesting period.
+func (pva *PeriodicVestingAccount) AddOriginalVesting(amount sdk.Coins) {
+	pva.BaseVestingAccount.AddOriginalVesting(amount)
+
+	if len(pva.VestingPeriods) == 0 {
+		pva.VestingPeriods = Periods{{Length: pva.EndTime - pva.StartTime, Amount: amount}}
+		return
+	}
+
+	last := &pva.VestingPeriods[len(pva.VestingPeriods)-1]
+	last.Amount = last.Amount.Add(amount...)
+}
+
 // GetVestingPeriods returns vesting periods associated with periodic vesting account.
 func (pva PeriodicVestingAccount) GetVestingPeriods() Periods {
 	return pva.VestingPeriods
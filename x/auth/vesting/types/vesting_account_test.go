@@ -704,6 +704,41 @@ func TestTrackUndelegationPermLockedVestingAcc(t *testing.T) {
 	require.Equal(t, sdk.Coins{sdk.NewInt64Coin(stakeDenom, 25)}, plva.DelegatedVesting)
 }
 
+func TestAddOriginalVestingDelVestingAcc(t *testing.T) {
+	now := tmtime.Now()
+	endTime := now.Add(24 * time.Hour)
+
+	bacc, origCoins := initBaseAccount()
+	dva := types.NewDelayedVestingAccount(bacc, origCoins, endTime.Unix())
+
+	// require the added coins to be locked until schedule maturation
+	added := sdk.Coins{sdk.NewInt64Coin(stakeDenom, 10)}
+	dva.AddOriginalVesting(added)
+	require.Equal(t, origCoins.Add(added...), dva.GetOriginalVesting())
+	require.Equal(t, origCoins.Add(added...), dva.LockedCoins(now.Add(12*time.Hour)))
+	require.Equal(t, emptyCoins, dva.GetVestingCoins(endTime))
+	require.NoError(t, dva.Validate())
+}
+
+func TestAddOriginalVestingPeriodicVestingAcc(t *testing.T) {
+	now := tmtime.Now()
+	periods := types.Periods{
+		types.Period{Length: int64(12 * 60 * 60), Amount: sdk.Coins{sdk.NewInt64Coin(feeDenom, 500), sdk.NewInt64Coin(stakeDenom, 50)}},
+		types.Period{Length: int64(12 * 60 * 60), Amount: sdk.Coins{sdk.NewInt64Coin(feeDenom, 500), sdk.NewInt64Coin(stakeDenom, 50)}},
+	}
+
+	bacc, origCoins := initBaseAccount()
+	pva := types.NewPeriodicVestingAccount(bacc, origCoins, now.Unix(), periods)
+
+	// require the added coins to vest with the last period
+	added := sdk.Coins{sdk.NewInt64Coin(stakeDenom, 10)}
+	pva.AddOriginalVesting(added)
+	require.Equal(t, origCoins.Add(added...), pva.GetOriginalVesting())
+	require.Equal(t, sdk.Coins{sdk.NewInt64Coin(feeDenom, 500), sdk.NewInt64Coin(stakeDenom, 60)}, pva.GetVestingCoins(now.Add(12*time.Hour)))
+	require.Equal(t, emptyCoins, pva.GetVestingCoins(now.Add(24*time.Hour)))
+	require.NoError(t, pva.Validate())
+}
+
 func TestGenesisAccountValidate(t *testing.T) {
 	pubkey := secp256k1.GenPrivKey().PubKey()
 	addr := sdk.AccAddress(pubkey.Address())
@@ -3,6 +3,7 @@ package cli
 import (
 	"fmt"
 	"strings"
+	"time"
 
 	"cosmossdk.io/core/address"
 	"github.com/spf13/cobra"
@@ -14,6 +15,11 @@ import (
 	bank "github.com/cosmos/cosmos-sdk/x/bank/types"
 )
 
+// Flag names and values
+const (
+	FlagGranter = "granter"
+)
+
 // GetQueryCmd returns the cli query commands for this module
 func GetQueryCmd(ac address.Codec) *cobra.Command {
 	authorizationQueryCmd := &cobra.Command{
@@ -29,6 +35,7 @@ func GetQueryCmd(ac address.Codec) *cobra.Command {
 		GetCmdQueryGrants(ac),
 		GetQueryGranterGrants(ac),
 		GetQueryGranteeGrants(ac),
+		GetQueryExpiringGrants(ac),
 	)
 
 	return authorizationQueryCmd
@@ -192,3 +199,61 @@ $ %s q %s grants-by-grantee cosmos1skj..
 	flags.AddPaginationFlagsToCmd(cmd, "grantee-grants")
 	return cmd
 }
+
+// GetQueryExpiringGrants returns cmd to query for the grants expiring within a window.
+func GetQueryExpiringGrants(ac address.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "expiring-grants [window]",
+		Args:  cobra.ExactArgs(1),
+		Short: "query authorization grants expiring within a window",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query authorization grants expiring within a window after the current
+block time, ordered by expiration. If --%s is set, it will select grants only
+granted by that address.
+Examples:
+$ %s q %s expiring-grants 72h
+$ %s q %s expiring-grants 168h --%s=cosmos1skj..
+`,
+				FlagGranter, version.AppName, authz.ModuleName, version.AppName, authz.ModuleName, FlagGranter),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			window, err := time.ParseDuration(args[0])
+			if err != nil {
+				return err
+			}
+
+			granter, err := cmd.Flags().GetString(FlagGranter)
+			if err != nil {
+				return err
+			}
+
+			if granter != "" {
+				if _, err := ac.StringToBytes(granter); err != nil {
+					return err
+				}
+			}
+
+			queryClient := authz.NewQueryClient(clientCtx)
+			res, err := queryClient.ExpiringGrants(
+				cmd.Context(),
+				&authz.QueryExpiringGrantsRequest{
+					Granter: granter,
+					Window:  window,
+				},
+			)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+	flags.AddQueryFlagsToCmd(cmd)
+	cmd.Flags().String(FlagGranter, "", "Only query the grants granted by this address")
+	return cmd
+}
@@ -240,3 +240,69 @@ func (s *CLITestSuite) TestQueryGranterGrants() {
 		})
 	}
 }
+
+func (s *CLITestSuite) TestQueryExpiringGrants() {
+	val := testutil.CreateKeyringAccounts(s.T(), s.kr, 1)
+
+	require := s.Require()
+
+	testCases := []struct {
+		name        string
+		args        []string
+		expectErr   bool
+		expectedErr string
+	}{
+		{
+			"invalid window",
+			[]string{
+				"two-days",
+				fmt.Sprintf("--%s=json", flags.FlagOutput),
+			},
+			true,
+			"invalid duration",
+		},
+		{
+			"invalid granter address",
+			[]string{
+				"48h",
+				fmt.Sprintf("--%s=invalid-address", cli.FlagGranter),
+				fmt.Sprintf("--%s=json", flags.FlagOutput),
+			},
+			true,
+			"decoding bech32 failed",
+		},
+		{
+			"valid case",
+			[]string{
+				"48h",
+				fmt.Sprintf("--%s=json", flags.FlagOutput),
+			},
+			false,
+			"",
+		},
+		{
+			"valid case with granter",
+			[]string{
+				"48h",
+				fmt.Sprintf("--%s=%s", cli.FlagGranter, val[0].Address.String()),
+				fmt.Sprintf("--%s=json", flags.FlagOutput),
+			},
+			false,
+			"",
+		},
+	}
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			cmd := cli.GetQueryExpiringGrants(addresscodec.NewBech32Codec("cosmos"))
+			out, err := clitestutil.ExecTestCLICmd(s.clientCtx, cmd, tc.args)
+			if tc.expectErr {
+				require.Error(err)
+				require.Contains(err.Error(), tc.expectedErr)
+			} else {
+				require.NoError(err)
+				var grants authz.QueryExpiringGrantsResponse
+				require.NoError(s.clientCtx.Codec.UnmarshalJSON(out.Bytes(), &grants))
+			}
+		})
+	}
+}
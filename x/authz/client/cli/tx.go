@@ -28,6 +28,7 @@ const (
 	FlagAllowedValidators = "allowed-validators"
 	FlagDenyValidators    = "deny-validators"
 	FlagAllowList         = "allow-list"
+	FlagMaxGasPerTx       = "max-gas-per-tx"
 	delegate              = "delegate"
 	redelegate            = "redelegate"
 	unbond                = "unbond"
@@ -48,6 +49,8 @@ func GetTxCmd(ac address.Codec) *cobra.Command {
 		NewCmdGrantAuthorization(ac),
 		NewCmdRevokeAuthorization(ac),
 		NewCmdExecAuthorization(),
+		NewCmdGrantAuthorizationBatch(ac),
+		NewCmdRevokeAuthorizationBatch(ac),
 	)
 
 	return AuthorizationTxCmd
@@ -261,6 +264,106 @@ Example:
 	return cmd
 }
 
+// NewCmdGrantAuthorizationBatch returns a CLI command handler for creating MsgGrant transactions from a CSV file.
+func NewCmdGrantAuthorizationBatch(ac address.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "grant-batch [csv-file] --from [granter]",
+		Short: "Grant authorizations to many addresses from a CSV file",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Grant authorizations to many addresses from a CSV file, in a single
+transaction. Each line of the file has the form:
+
+  grantee,authorization_type,spend_limit_or_msg_type[,expiration]
+
+where authorization_type is either "send", followed by a spend limit, or
+"generic", followed by a msg type URL, and expiration is an optional Unix
+timestamp. A header line starting with "grantee" and lines starting with "#"
+are ignored.
+
+If --%s is set, the grants are split into as many transactions as needed for
+the estimated gas of each not to exceed it.
+
+Example:
+ $ cat grants.csv
+ grantee,authorization_type,spend_limit_or_msg_type,expiration
+ cosmos1skjw..,send,1000stake,1735689600
+ cosmos1skl..,generic,/cosmos.gov.v1.MsgVote,
+ $ %s tx %s grant-batch grants.csv --from=cosmos1skj..
+			`, FlagMaxGasPerTx, version.AppName, authz.ModuleName),
+		),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			msgs, err := parseGrantsCSV(args[0], clientCtx.GetFromAddress(), ac)
+			if err != nil {
+				return err
+			}
+
+			maxGas, err := cmd.Flags().GetUint64(FlagMaxGasPerTx)
+			if err != nil {
+				return err
+			}
+
+			return generateOrBroadcastBatchTxs(clientCtx, cmd.Flags(), maxGas, msgs)
+		},
+	}
+	flags.AddTxFlagsToCmd(cmd)
+	cmd.Flags().Uint64(FlagMaxGasPerTx, 0, "Maximum estimated gas of each transaction, the grants are sent in a single transaction if zero")
+	return cmd
+}
+
+// NewCmdRevokeAuthorizationBatch returns a CLI command handler for creating MsgRevoke transactions from a CSV file.
+func NewCmdRevokeAuthorizationBatch(ac address.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "revoke-batch [csv-file] --from [granter]",
+		Short: "Revoke authorizations of many addresses from a CSV file",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Revoke authorizations of many addresses from a CSV file, in a single
+transaction. Each line of the file has the form:
+
+  grantee,msg_type_url
+
+A header line starting with "grantee" and lines starting with "#" are ignored.
+
+If --%s is set, the revocations are split into as many transactions as needed
+for the estimated gas of each not to exceed it.
+
+Example:
+ $ cat revokes.csv
+ cosmos1skjw..,%s
+ cosmos1skl..,/cosmos.gov.v1.MsgVote
+ $ %s tx %s revoke-batch revokes.csv --from=cosmos1skj..
+			`, FlagMaxGasPerTx, bank.SendAuthorization{}.MsgTypeURL(), version.AppName, authz.ModuleName),
+		),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			msgs, err := parseRevokesCSV(args[0], clientCtx.GetFromAddress(), ac)
+			if err != nil {
+				return err
+			}
+
+			maxGas, err := cmd.Flags().GetUint64(FlagMaxGasPerTx)
+			if err != nil {
+				return err
+			}
+
+			return generateOrBroadcastBatchTxs(clientCtx, cmd.Flags(), maxGas, msgs)
+		},
+	}
+	flags.AddTxFlagsToCmd(cmd)
+	cmd.Flags().Uint64(FlagMaxGasPerTx, 0, "Maximum estimated gas of each transaction, the revocations are sent in a single transaction if zero")
+	return cmd
+}
+
 // NewCmdExecAuthorization returns a CLI command handler for creating a MsgExec transaction.
 func NewCmdExecAuthorization() *cobra.Command {
 	cmd := &cobra.Command{
@@ -577,6 +577,163 @@ func (s *CLITestSuite) TestCmdRevokeAuthorizations() {
 	}
 }
 
+func (s *CLITestSuite) TestCLITxGrantAuthorizationBatch() {
+	val := testutil.CreateKeyringAccounts(s.T(), s.kr, 1)
+
+	twoHours := time.Now().Add(time.Minute * time.Duration(120)).Unix()
+	validCSV := testutil.WriteToNewTempFile(s.T(), fmt.Sprintf(`grantee,authorization_type,spend_limit_or_msg_type,expiration
+%s,send,100stake,%d
+%s,generic,%s,
+# a comment
+%s,generic,%s
+`, s.grantee[0], twoHours, s.grantee[1], typeMsgVote, s.grantee[2], typeMsgSubmitProposal))
+	invalidTypeCSV := testutil.WriteToNewTempFile(s.T(), fmt.Sprintf("%s,delegate,100stake\n", s.grantee[0]))
+	invalidSpendLimitCSV := testutil.WriteToNewTempFile(s.T(), fmt.Sprintf("%s,send,0stake\n", s.grantee[0]))
+	invalidGranteeCSV := testutil.WriteToNewTempFile(s.T(), fmt.Sprintf("invalid grantee,generic,%s\n", typeMsgVote))
+	selfGrantCSV := testutil.WriteToNewTempFile(s.T(), fmt.Sprintf("%s,generic,%s\n", val[0].Address, typeMsgVote))
+	missingFieldsCSV := testutil.WriteToNewTempFile(s.T(), fmt.Sprintf("%s,generic\n", s.grantee[0]))
+	emptyCSV := testutil.WriteToNewTempFile(s.T(), "grantee,authorization_type,spend_limit_or_msg_type,expiration\n")
+
+	testCases := []struct {
+		name      string
+		args      []string
+		expectErr bool
+		expErrMsg string
+	}{
+		{
+			"missing file",
+			[]string{"missing.csv"},
+			true,
+			"no such file",
+		},
+		{
+			"empty file",
+			[]string{emptyCSV.Name()},
+			true,
+			"no records found",
+		},
+		{
+			"invalid authorization type",
+			[]string{invalidTypeCSV.Name()},
+			true,
+			"invalid authorization type",
+		},
+		{
+			"invalid spend limit",
+			[]string{invalidSpendLimitCSV.Name()},
+			true,
+			"spend-limit should be greater than zero",
+		},
+		{
+			"invalid grantee address",
+			[]string{invalidGranteeCSV.Name()},
+			true,
+			"record 1",
+		},
+		{
+			"grantee same as granter",
+			[]string{selfGrantCSV.Name()},
+			true,
+			"grantee and granter should be different",
+		},
+		{
+			"missing fields",
+			[]string{missingFieldsCSV.Name()},
+			true,
+			"expected between 3 and 4 fields",
+		},
+		{
+			"valid tx",
+			[]string{validCSV.Name()},
+			false,
+			"",
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		s.Run(tc.name, func() {
+			cmd := cli.NewCmdGrantAuthorizationBatch(addresscodec.NewBech32Codec("cosmos"))
+			args := append(tc.args,
+				fmt.Sprintf("--%s=%s", flags.FlagFrom, val[0].Address.String()),
+				fmt.Sprintf("--%s=%s", flags.FlagBroadcastMode, flags.BroadcastSync),
+				fmt.Sprintf("--%s=true", flags.FlagSkipConfirmation),
+				fmt.Sprintf("--%s=%s", flags.FlagFees, sdk.NewCoins(sdk.NewCoin("stake", sdkmath.NewInt(10))).String()),
+			)
+
+			out, err := clitestutil.ExecTestCLICmd(s.clientCtx, cmd, args)
+			if tc.expectErr {
+				s.Require().Error(err)
+				s.Require().Contains(err.Error(), tc.expErrMsg)
+			} else {
+				s.Require().NoError(err)
+				var txResp sdk.TxResponse
+				s.Require().NoError(s.clientCtx.Codec.UnmarshalJSON(out.Bytes(), &txResp), out.String())
+			}
+		})
+	}
+}
+
+func (s *CLITestSuite) TestCmdRevokeAuthorizationBatch() {
+	val := testutil.CreateKeyringAccounts(s.T(), s.kr, 1)
+
+	validCSV := testutil.WriteToNewTempFile(s.T(), fmt.Sprintf(`grantee,msg_type_url
+%s,%s
+%s,%s
+`, s.grantee[0], typeMsgSend, s.grantee[1], typeMsgVote))
+	invalidGranteeCSV := testutil.WriteToNewTempFile(s.T(), fmt.Sprintf("invalid grantee,%s\n", typeMsgVote))
+	missingFieldsCSV := testutil.WriteToNewTempFile(s.T(), fmt.Sprintf("%s\n", s.grantee[0]))
+
+	testCases := []struct {
+		name      string
+		args      []string
+		expectErr bool
+		expErrMsg string
+	}{
+		{
+			"invalid grantee address",
+			[]string{invalidGranteeCSV.Name()},
+			true,
+			"record 1",
+		},
+		{
+			"missing fields",
+			[]string{missingFieldsCSV.Name()},
+			true,
+			"expected between 2 and 2 fields",
+		},
+		{
+			"valid tx",
+			[]string{validCSV.Name()},
+			false,
+			"",
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		s.Run(tc.name, func() {
+			cmd := cli.NewCmdRevokeAuthorizationBatch(addresscodec.NewBech32Codec("cosmos"))
+			args := append(tc.args,
+				fmt.Sprintf("--%s=%s", flags.FlagFrom, val[0].Address.String()),
+				fmt.Sprintf("--%s=%s", flags.FlagBroadcastMode, flags.BroadcastSync),
+				fmt.Sprintf("--%s=true", flags.FlagSkipConfirmation),
+				fmt.Sprintf("--%s=%s", flags.FlagFees, sdk.NewCoins(sdk.NewCoin("stake", sdkmath.NewInt(10))).String()),
+			)
+
+			out, err := clitestutil.ExecTestCLICmd(s.clientCtx, cmd, args)
+			if tc.expectErr {
+				s.Require().Error(err)
+				s.Require().Contains(err.Error(), tc.expErrMsg)
+			} else {
+				s.Require().NoError(err)
+				var txResp sdk.TxResponse
+				s.Require().NoError(s.clientCtx.Codec.UnmarshalJSON(out.Bytes(), &txResp), out.String())
+			}
+		})
+	}
+}
+
 func (s *CLITestSuite) TestExecAuthorizationWithExpiration() {
 	val := testutil.CreateKeyringAccounts(s.T(), s.kr, 1)
 
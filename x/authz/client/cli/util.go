@@ -0,0 +1,222 @@
+package cli
+
+import (
+	"encoding/csv"
+	"fmt"
+	"io"
+	"os"
+	"strconv"
+	"strings"
+	"time"
+
+	"cosmossdk.io/core/address"
+	"github.com/spf13/pflag"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/authz"
+	bank "github.com/cosmos/cosmos-sdk/x/bank/types"
+)
+
+// readCSVRecords reads the records of the CSV file at path, skipping its
+// header row if its first field is equal to header. Each record must have
+// between minFields and maxFields fields.
+func readCSVRecords(path, header string, minFields, maxFields int) ([][]string, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	r := csv.NewReader(f)
+	r.FieldsPerRecord = -1
+	r.TrimLeadingSpace = true
+	r.Comment = '#'
+
+	var records [][]string
+	for {
+		record, err := r.Read()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, err
+		}
+
+		if len(records) == 0 && strings.EqualFold(record[0], header) {
+			continue
+		}
+
+		if len(record) < minFields || len(record) > maxFields {
+			line, _ := r.FieldPos(0)
+			return nil, fmt.Errorf("line %d: expected between %d and %d fields, got %d", line, minFields, maxFields, len(record))
+		}
+
+		records = append(records, record)
+	}
+
+	if len(records) == 0 {
+		return nil, fmt.Errorf("no records found in %s", path)
+	}
+
+	return records, nil
+}
+
+// parseGrantsCSV parses the CSV file at path into MsgGrant messages from
+// granter. Each record has the form:
+//
+//	grantee,authorization_type,spend_limit_or_msg_type[,expiration]
+//
+// where authorization_type is "send", with a spend limit, or "generic", with a
+// msg type URL, and expiration is an optional Unix timestamp.
+func parseGrantsCSV(path string, granter sdk.AccAddress, ac address.Codec) ([]sdk.Msg, error) {
+	records, err := readCSVRecords(path, "grantee", 3, 4)
+	if err != nil {
+		return nil, err
+	}
+
+	msgs := make([]sdk.Msg, len(records))
+	for i, record := range records {
+		grantee, err := ac.StringToBytes(record[0])
+		if err != nil {
+			return nil, fmt.Errorf("record %d: %w", i+1, err)
+		}
+
+		if granter.Equals(sdk.AccAddress(grantee)) {
+			return nil, fmt.Errorf("record %d: grantee and granter should be different", i+1)
+		}
+
+		var authorization authz.Authorization
+		switch record[1] {
+		case "send":
+			spendLimit, err := sdk.ParseCoinsNormalized(record[2])
+			if err != nil {
+				return nil, fmt.Errorf("record %d: %w", i+1, err)
+			}
+
+			if !spendLimit.IsAllPositive() {
+				return nil, fmt.Errorf("record %d: spend-limit should be greater than zero", i+1)
+			}
+
+			authorization = bank.NewSendAuthorization(spendLimit, nil)
+		case "generic":
+			authorization = authz.NewGenericAuthorization(record[2])
+		default:
+			return nil, fmt.Errorf("record %d: invalid authorization type, %s", i+1, record[1])
+		}
+
+		var expire *time.Time
+		if len(record) > 3 && record[3] != "" && record[3] != "0" {
+			exp, err := strconv.ParseInt(record[3], 10, 64)
+			if err != nil {
+				return nil, fmt.Errorf("record %d: invalid expiration: %w", i+1, err)
+			}
+
+			e := time.Unix(exp, 0)
+			expire = &e
+		}
+
+		msg, err := authz.NewMsgGrant(granter, grantee, authorization, expire)
+		if err != nil {
+			return nil, fmt.Errorf("record %d: %w", i+1, err)
+		}
+
+		msgs[i] = msg
+	}
+
+	return msgs, nil
+}
+
+// parseRevokesCSV parses the CSV file at path into MsgRevoke messages from
+// granter. Each record has the form:
+//
+//	grantee,msg_type_url
+func parseRevokesCSV(path string, granter sdk.AccAddress, ac address.Codec) ([]sdk.Msg, error) {
+	records, err := readCSVRecords(path, "grantee", 2, 2)
+	if err != nil {
+		return nil, err
+	}
+
+	msgs := make([]sdk.Msg, len(records))
+	for i, record := range records {
+		grantee, err := ac.StringToBytes(record[0])
+		if err != nil {
+			return nil, fmt.Errorf("record %d: %w", i+1, err)
+		}
+
+		msg := authz.NewMsgRevoke(granter, grantee, record[1])
+		msgs[i] = &msg
+	}
+
+	return msgs, nil
+}
+
+// chunkMsgsByGas splits msgs into consecutive chunks whose estimated gas does
+// not exceed maxGas, halving the chunks exceeding it.
+func chunkMsgsByGas(clientCtx client.Context, txf tx.Factory, msgs []sdk.Msg, maxGas uint64) ([][]sdk.Msg, error) {
+	_, gas, err := tx.CalculateGas(clientCtx, txf, msgs...)
+	if err != nil {
+		return nil, err
+	}
+
+	if gas <= maxGas {
+		return [][]sdk.Msg{msgs}, nil
+	}
+
+	if len(msgs) == 1 {
+		return nil, fmt.Errorf("message %s requires %d gas, more than the maximum of %d per transaction", sdk.MsgTypeURL(msgs[0]), gas, maxGas)
+	}
+
+	half := len(msgs) / 2
+	left, err := chunkMsgsByGas(clientCtx, txf, msgs[:half], maxGas)
+	if err != nil {
+		return nil, err
+	}
+
+	right, err := chunkMsgsByGas(clientCtx, txf, msgs[half:], maxGas)
+	if err != nil {
+		return nil, err
+	}
+
+	return append(left, right...), nil
+}
+
+// generateOrBroadcastBatchTxs generates or broadcasts msgs in a single
+// transaction, or, if maxGas is set, in as many transactions as needed for the
+// estimated gas of each not to exceed it. The transactions are given
+// consecutive sequences so that they can all be broadcast before the first one
+// is committed.
+func generateOrBroadcastBatchTxs(clientCtx client.Context, flagSet *pflag.FlagSet, maxGas uint64, msgs []sdk.Msg) error {
+	if maxGas == 0 {
+		return tx.GenerateOrBroadcastTxCLI(clientCtx, flagSet, msgs...)
+	}
+
+	if clientCtx.Offline {
+		return fmt.Errorf("cannot estimate gas in offline mode, unset --%s", FlagMaxGasPerTx)
+	}
+
+	txf, err := tx.NewFactoryCLI(clientCtx, flagSet)
+	if err != nil {
+		return err
+	}
+
+	txf, err = txf.Prepare(clientCtx)
+	if err != nil {
+		return err
+	}
+
+	chunks, err := chunkMsgsByGas(clientCtx, txf, msgs, maxGas)
+	if err != nil {
+		return err
+	}
+
+	sequence := txf.Sequence()
+	for i, chunk := range chunks {
+		if err := tx.GenerateOrBroadcastTxWithFactory(clientCtx, txf.WithSequence(sequence+uint64(i)), chunk...); err != nil {
+			return fmt.Errorf("transaction %d of %d: %w", i+1, len(chunks), err)
+		}
+	}
+
+	return nil
+}
@@ -9,9 +9,11 @@ import (
 
 	"cosmossdk.io/errors"
 	"cosmossdk.io/store/prefix"
+	storetypes "cosmossdk.io/store/types"
 
 	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
 	"github.com/cosmos/cosmos-sdk/runtime"
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/query"
 	"github.com/cosmos/cosmos-sdk/x/authz"
 )
@@ -181,3 +183,76 @@ func (k Keeper) GranteeGrants(ctx context.Context, req *authz.QueryGranteeGrants
 		Pagination: pageRes,
 	}, nil
 }
+
+// ExpiringGrants implements the Query/ExpiringGrants gRPC method.
+// It returns the grants expiring within the requested window after the current block time, walking the grant queue.
+func (k Keeper) ExpiringGrants(ctx context.Context, req *authz.QueryExpiringGrantsRequest) (*authz.QueryExpiringGrantsResponse, error) {
+	if req == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "empty request")
+	}
+
+	if req.Window <= 0 {
+		return nil, status.Errorf(codes.InvalidArgument, "window must be positive")
+	}
+
+	var granter sdk.AccAddress
+	if req.Granter != "" {
+		var err error
+		granter, err = k.authKeeper.StringToBytes(req.Granter)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	end := sdk.UnwrapSDKContext(ctx).BlockTime().Add(req.Window)
+
+	store := k.storeService.OpenKVStore(ctx)
+	iterator, err := store.Iterator(GrantQueuePrefix, storetypes.PrefixEndBytes(GrantQueueTimePrefix(end)))
+	if err != nil {
+		return nil, err
+	}
+	defer iterator.Close()
+
+	var grants []*authz.GrantAuthorization
+	for ; iterator.Valid(); iterator.Next() {
+		_, queueGranter, grantee, err := parseGrantQueueKey(iterator.Key())
+		if err != nil {
+			return nil, err
+		}
+
+		if granter != nil && !granter.Equals(queueGranter) {
+			continue
+		}
+
+		var queueItem authz.GrantQueueItem
+		if err := k.cdc.Unmarshal(iterator.Value(), &queueItem); err != nil {
+			return nil, err
+		}
+
+		for _, typeURL := range queueItem.MsgTypeUrls {
+			grant, found := k.getGrant(ctx, grantStoreKey(grantee, queueGranter, typeURL))
+			if !found {
+				continue
+			}
+
+			authorization, err := grant.GetAuthorization()
+			if err != nil {
+				return nil, err
+			}
+
+			authorizationAny, err := codectypes.NewAnyWithValue(authorization)
+			if err != nil {
+				return nil, status.Errorf(codes.Internal, err.Error())
+			}
+
+			grants = append(grants, &authz.GrantAuthorization{
+				Granter:       queueGranter.String(),
+				Grantee:       grantee.String(),
+				Authorization: authorizationAny,
+				Expiration:    grant.Expiration,
+			})
+		}
+	}
+
+	return &authz.QueryExpiringGrantsResponse{Grants: grants}, nil
+}
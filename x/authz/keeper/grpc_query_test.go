@@ -304,6 +304,84 @@ func (suite *TestSuite) TestGRPCQueryGranteeGrants() {
 	}
 }
 
+func (suite *TestSuite) TestGRPCQueryExpiringGrants() {
+	require := suite.Require()
+	queryClient, addrs := suite.queryClient, suite.addrs
+
+	// grants expiring in an hour
+	suite.createSendAuthorization(addrs[0], addrs[1])
+	suite.createSendAuthorization(addrs[0], addrs[2])
+
+	// grant expiring in two days
+	exp := suite.ctx.BlockHeader().Time.Add(48 * time.Hour)
+	err := suite.authzKeeper.SaveGrant(suite.ctx, addrs[3], addrs[1], authz.NewGenericAuthorization(bankSendAuthMsgType), &exp)
+	require.NoError(err)
+
+	// grant without expiration
+	err = suite.authzKeeper.SaveGrant(suite.ctx, addrs[4], addrs[1], authz.NewGenericAuthorization(bankSendAuthMsgType), nil)
+	require.NoError(err)
+
+	testCases := []struct {
+		msg         string
+		req         *authz.QueryExpiringGrantsRequest
+		expError    string
+		expGrantees []sdk.AccAddress
+	}{
+		{
+			"fail zero window",
+			&authz.QueryExpiringGrantsRequest{},
+			"window must be positive",
+			nil,
+		},
+		{
+			"fail invalid granter addr",
+			&authz.QueryExpiringGrantsRequest{Granter: "invalid", Window: time.Hour},
+			"invalid bech32 string",
+			nil,
+		},
+		{
+			"no grants expiring within window",
+			&authz.QueryExpiringGrantsRequest{Window: time.Minute},
+			"",
+			nil,
+		},
+		{
+			"grants expiring within window",
+			&authz.QueryExpiringGrantsRequest{Window: 2 * time.Hour},
+			"",
+			[]sdk.AccAddress{addrs[0], addrs[0]},
+		},
+		{
+			"grants of granter expiring within window",
+			&authz.QueryExpiringGrantsRequest{Granter: addrs[1].String(), Window: 72 * time.Hour},
+			"",
+			[]sdk.AccAddress{addrs[0], addrs[3]},
+		},
+		{
+			"grants ordered by expiration",
+			&authz.QueryExpiringGrantsRequest{Window: 72 * time.Hour},
+			"",
+			[]sdk.AccAddress{addrs[0], addrs[0], addrs[3]},
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(fmt.Sprintf("Case %s", tc.msg), func() {
+			result, err := queryClient.ExpiringGrants(gocontext.Background(), tc.req)
+			if tc.expError != "" {
+				require.ErrorContains(err, tc.expError)
+				return
+			}
+
+			require.NoError(err)
+			require.Len(result.Grants, len(tc.expGrantees))
+			for i, grantee := range tc.expGrantees {
+				require.Equal(grantee.String(), result.Grants[i].Grantee)
+			}
+		})
+	}
+}
+
 func (suite *TestSuite) createSendAuthorization(grantee, granter sdk.AccAddress) authz.Authorization {
 	exp := suite.ctx.BlockHeader().Time.Add(time.Hour)
 	newCoins := sdk.NewCoins(sdk.NewInt64Coin("steak", 100))
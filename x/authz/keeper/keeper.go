@@ -399,6 +399,8 @@ func (k Keeper) removeFromGrantQueue(ctx context.Context, grantKey []byte, grant
 }
 
 // DequeueAndDeleteExpiredGrants deletes expired grants from the state and grant queue.
+// It stops once the blocker gas limit of the module, if any, is reached, the
+// remaining expired grants being deleted in the following blocks.
 func (k Keeper) DequeueAndDeleteExpiredGrants(ctx context.Context) error {
 	store := k.storeService.OpenKVStore(ctx)
 	sdkCtx := sdk.UnwrapSDKContext(ctx)
@@ -409,7 +411,12 @@ func (k Keeper) DequeueAndDeleteExpiredGrants(ctx context.Context) error {
 	}
 	defer iterator.Close()
 
-	for ; iterator.Valid(); iterator.Next() {
+	for first := true; iterator.Valid(); iterator.Next() {
+		if !first && sdkCtx.IsBlockerGasExhausted() {
+			break
+		}
+		first = false
+
 		var queueItem authz.GrantQueueItem
 		if err := k.cdc.Unmarshal(iterator.Value(), &queueItem); err != nil {
 			return err
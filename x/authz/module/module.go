@@ -125,6 +125,7 @@ func NewAppModule(cdc codec.Codec, keeper keeper.Keeper, ak authz.AccountKeeper,
 var (
 	_ appmodule.AppModule       = AppModule{}
 	_ appmodule.HasBeginBlocker = AppModule{}
+	_ module.HasStorePrefixes   = AppModule{}
 )
 
 // IsOnePerModuleType implements the depinject.OnePerModuleType interface.
@@ -133,6 +134,11 @@ func (am AppModule) IsOnePerModuleType() {}
 // IsAppModule implements the appmodule.AppModule interface.
 func (am AppModule) IsAppModule() {}
 
+// StorePrefixes implements the module.HasStorePrefixes interface.
+func (AppModule) StorePrefixes() [][]byte {
+	return [][]byte{keeper.GrantKey, keeper.GrantQueuePrefix}
+}
+
 // Name returns the authz module's name.
 func (AppModule) Name() string {
 	return authz.ModuleName
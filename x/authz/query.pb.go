@@ -8,21 +8,26 @@ import (
 	fmt "fmt"
 	_ "github.com/cosmos/cosmos-proto"
 	query "github.com/cosmos/cosmos-sdk/types/query"
+	_ "github.com/cosmos/gogoproto/gogoproto"
 	grpc1 "github.com/cosmos/gogoproto/grpc"
 	proto "github.com/cosmos/gogoproto/proto"
+	github_com_cosmos_gogoproto_types "github.com/cosmos/gogoproto/types"
 	_ "google.golang.org/genproto/googleapis/api/annotations"
 	grpc "google.golang.org/grpc"
 	codes "google.golang.org/grpc/codes"
 	status "google.golang.org/grpc/status"
+	_ "google.golang.org/protobuf/types/known/durationpb"
 	io "io"
 	math "math"
 	math_bits "math/bits"
+	time "time"
 )
 
 // Reference imports to suppress errors if they are not otherwise used.
 var _ = proto.Marshal
 var _ = fmt.Errorf
 var _ = math.Inf
+var _ = time.Kitchen
 
 // This is a compile-time assertion to ensure that this generated file
 // is compatible with the proto package it is being compiled against.
@@ -374,6 +379,112 @@ func (m *QueryGranteeGrantsResponse) GetPagination() *query.PageResponse {
 	return nil
 }
 
+// QueryExpiringGrantsRequest is the request type for the Query/ExpiringGrants RPC method.
+//
+// Since: cosmos-sdk 0.48
+type QueryExpiringGrantsRequest struct {
+	// Optional, granter, when set, will query only grants granted by the granter.
+	Granter string `protobuf:"bytes,1,opt,name=granter,proto3" json:"granter,omitempty"`
+	// window is the duration after the current block time within which the
+	// returned grants expire.
+	Window time.Duration `protobuf:"bytes,2,opt,name=window,proto3,stdduration" json:"window"`
+}
+
+func (m *QueryExpiringGrantsRequest) Reset()         { *m = QueryExpiringGrantsRequest{} }
+func (m *QueryExpiringGrantsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryExpiringGrantsRequest) ProtoMessage()    {}
+func (*QueryExpiringGrantsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_376d714ffdeb1545, []int{6}
+}
+func (m *QueryExpiringGrantsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryExpiringGrantsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryExpiringGrantsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryExpiringGrantsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryExpiringGrantsRequest.Merge(m, src)
+}
+func (m *QueryExpiringGrantsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryExpiringGrantsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryExpiringGrantsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryExpiringGrantsRequest proto.InternalMessageInfo
+
+func (m *QueryExpiringGrantsRequest) GetGranter() string {
+	if m != nil {
+		return m.Granter
+	}
+	return ""
+}
+
+func (m *QueryExpiringGrantsRequest) GetWindow() time.Duration {
+	if m != nil {
+		return m.Window
+	}
+	return 0
+}
+
+// QueryExpiringGrantsResponse is the response type for the Query/ExpiringGrants RPC method.
+//
+// Since: cosmos-sdk 0.48
+type QueryExpiringGrantsResponse struct {
+	// grants is a list of grants expiring within the window, ordered by expiration.
+	Grants []*GrantAuthorization `protobuf:"bytes,1,rep,name=grants,proto3" json:"grants,omitempty"`
+}
+
+func (m *QueryExpiringGrantsResponse) Reset()         { *m = QueryExpiringGrantsResponse{} }
+func (m *QueryExpiringGrantsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryExpiringGrantsResponse) ProtoMessage()    {}
+func (*QueryExpiringGrantsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_376d714ffdeb1545, []int{7}
+}
+func (m *QueryExpiringGrantsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryExpiringGrantsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryExpiringGrantsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryExpiringGrantsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryExpiringGrantsResponse.Merge(m, src)
+}
+func (m *QueryExpiringGrantsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryExpiringGrantsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryExpiringGrantsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryExpiringGrantsResponse proto.InternalMessageInfo
+
+func (m *QueryExpiringGrantsResponse) GetGrants() []*GrantAuthorization {
+	if m != nil {
+		return m.Grants
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*QueryGrantsRequest)(nil), "cosmos.authz.v1beta1.QueryGrantsRequest")
 	proto.RegisterType((*QueryGrantsResponse)(nil), "cosmos.authz.v1beta1.QueryGrantsResponse")
@@ -381,46 +492,54 @@ func init() {
 	proto.RegisterType((*QueryGranterGrantsResponse)(nil), "cosmos.authz.v1beta1.QueryGranterGrantsResponse")
 	proto.RegisterType((*QueryGranteeGrantsRequest)(nil), "cosmos.authz.v1beta1.QueryGranteeGrantsRequest")
 	proto.RegisterType((*QueryGranteeGrantsResponse)(nil), "cosmos.authz.v1beta1.QueryGranteeGrantsResponse")
+	proto.RegisterType((*QueryExpiringGrantsRequest)(nil), "cosmos.authz.v1beta1.QueryExpiringGrantsRequest")
+	proto.RegisterType((*QueryExpiringGrantsResponse)(nil), "cosmos.authz.v1beta1.QueryExpiringGrantsResponse")
 }
 
 func init() { proto.RegisterFile("cosmos/authz/v1beta1/query.proto", fileDescriptor_376d714ffdeb1545) }
 
 var fileDescriptor_376d714ffdeb1545 = []byte{
-	// 529 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x95, 0x41, 0x6f, 0xd3, 0x3e,
-	0x18, 0xc6, 0xeb, 0xf6, 0xff, 0x2f, 0xc2, 0x83, 0x8b, 0xe1, 0x90, 0x85, 0x29, 0x8a, 0xaa, 0x09,
-	0x0a, 0xd2, 0xec, 0xad, 0x93, 0x38, 0x22, 0xb6, 0xc3, 0x76, 0x85, 0x00, 0x17, 0x2e, 0x55, 0xba,
-	0xbe, 0x72, 0x23, 0xda, 0x38, 0xb3, 0x1d, 0x44, 0x87, 0x76, 0x81, 0x2f, 0x80, 0xb4, 0x03, 0x1f,
-	0x01, 0x89, 0x33, 0x1f, 0x82, 0xe3, 0x04, 0x17, 0x8e, 0xa8, 0x45, 0xf0, 0x35, 0x50, 0x6d, 0x97,
-	0xae, 0x23, 0xdb, 0x02, 0xd3, 0x24, 0x4e, 0xad, 0xa3, 0xe7, 0x7d, 0xde, 0xdf, 0xfb, 0x38, 0x76,
-	0x70, 0xb8, 0x23, 0xd4, 0x40, 0x28, 0x16, 0xe7, 0xba, 0xb7, 0xc7, 0x9e, 0xaf, 0x75, 0x40, 0xc7,
-	0x6b, 0x6c, 0x37, 0x07, 0x39, 0xa4, 0x99, 0x14, 0x5a, 0x90, 0xeb, 0x56, 0x41, 0x8d, 0x82, 0x3a,
-	0x85, 0xbf, 0xc4, 0x85, 0xe0, 0x7d, 0x60, 0x71, 0x96, 0xb0, 0x38, 0x4d, 0x85, 0x8e, 0x75, 0x22,
-	0x52, 0x65, 0x6b, 0xfc, 0x3b, 0xce, 0xb5, 0x13, 0x2b, 0xb0, 0x66, 0xbf, 0xac, 0xb3, 0x98, 0x27,
-	0xa9, 0x11, 0x3b, 0x6d, 0x31, 0x81, 0xed, 0x66, 0x15, 0x8b, 0x56, 0xd1, 0x36, 0x2b, 0xe6, 0x70,
-	0xcc, 0xa2, 0xf1, 0x1d, 0x61, 0xf2, 0x70, 0xe2, 0xbf, 0x2d, 0xe3, 0x54, 0xab, 0x08, 0x76, 0x73,
-	0x50, 0x9a, 0xb4, 0xf0, 0x25, 0x3e, 0x79, 0x00, 0xd2, 0x43, 0x21, 0x6a, 0x5e, 0xde, 0xf4, 0x3e,
-	0x7d, 0x58, 0x99, 0x0e, 0xb2, 0xd1, 0xed, 0x4a, 0x50, 0xea, 0x91, 0x96, 0x49, 0xca, 0xa3, 0xa9,
-	0x70, 0x56, 0x03, 0x5e, 0xb5, 0x5c, 0x0d, 0x90, 0x10, 0x5f, 0x19, 0x28, 0xde, 0xd6, 0xc3, 0x0c,
-	0xda, 0xb9, 0xec, 0x7b, 0xb5, 0x49, 0x61, 0x84, 0x07, 0x8a, 0x3f, 0x1e, 0x66, 0xf0, 0x44, 0xf6,
-	0xc9, 0x16, 0xc6, 0xb3, 0x89, 0xbd, 0xff, 0x42, 0xd4, 0x5c, 0x68, 0xdd, 0xa4, 0xce, 0x75, 0x12,
-	0x0f, 0xb5, 0x59, 0xbb, 0xb9, 0xe9, 0x83, 0x98, 0x83, 0x9b, 0x22, 0x3a, 0x52, 0xd9, 0x38, 0x40,
-	0xf8, 0xda, 0xdc, 0xa0, 0x2a, 0x13, 0xa9, 0x02, 0xb2, 0x8e, 0xeb, 0x06, 0x46, 0x79, 0x28, 0xac,
-	0x35, 0x17, 0x5a, 0x37, 0x68, 0xd1, 0x76, 0x51, 0x53, 0x15, 0x39, 0x29, 0xd9, 0x9e, 0x83, 0xaa,
-	0x1a, 0xa8, 0x5b, 0x67, 0x42, 0xd9, 0x8e, 0x73, 0x54, 0x6f, 0x11, 0x5e, 0x9c, 0x51, 0x81, 0x3c,
-	0xff, 0x2e, 0x6c, 0x15, 0xa0, 0xfd, 0x4d, 0x5e, 0xef, 0x10, 0xf6, 0x8b, 0xc8, 0x5c, 0x6c, 0xf7,
-	0x8f, 0xc5, 0xd6, 0x3c, 0x25, 0xb6, 0x8d, 0x5c, 0xf7, 0x84, 0x4c, 0xf6, 0x8c, 0xf1, 0x85, 0x67,
-	0x08, 0x27, 0x64, 0x08, 0x65, 0x33, 0x84, 0x8b, 0xca, 0x10, 0xfe, 0xd9, 0x0c, 0x5b, 0x3f, 0x6a,
-	0xf8, 0x7f, 0x43, 0x4a, 0x5e, 0x23, 0x5c, 0xb7, 0x9c, 0xe4, 0x04, 0x9e, 0xdf, 0xaf, 0x0b, 0xff,
-	0x76, 0x09, 0xa5, 0xed, 0xda, 0x58, 0x7e, 0xf5, 0xf9, 0xdb, 0x41, 0x35, 0x20, 0x4b, 0xac, 0xf0,
-	0xda, 0x72, 0x83, 0xbd, 0x47, 0xf8, 0xea, 0xdc, 0x8b, 0x47, 0xd8, 0x59, 0x2d, 0x8e, 0x1d, 0x1e,
-	0x7f, 0xb5, 0x7c, 0x81, 0x43, 0xbb, 0x6b, 0xd0, 0x56, 0x09, 0x3d, 0x0d, 0x8d, 0xb9, 0x83, 0xc6,
-	0x5e, 0xba, 0x3f, 0xfb, 0x47, 0x60, 0xa1, 0x34, 0x2c, 0xfc, 0x29, 0x2c, 0x9c, 0x03, 0x16, 0xa6,
-	0xb0, 0xb0, 0xbf, 0x79, 0xef, 0xe3, 0x28, 0x40, 0x87, 0xa3, 0x00, 0x7d, 0x1d, 0x05, 0xe8, 0xcd,
-	0x38, 0xa8, 0x1c, 0x8e, 0x83, 0xca, 0x97, 0x71, 0x50, 0x79, 0xba, 0xcc, 0x13, 0xdd, 0xcb, 0x3b,
-	0x74, 0x47, 0x0c, 0xa6, 0x9e, 0xf6, 0x67, 0x45, 0x75, 0x9f, 0xb1, 0x17, 0xb6, 0x41, 0xa7, 0x6e,
-	0xbe, 0x1b, 0xeb, 0x3f, 0x03, 0x00, 0x00, 0xff, 0xff, 0x3e, 0x8b, 0x47, 0x69, 0xf8, 0x06, 0x00,
-	0x00,
+	// 632 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x94, 0xc1, 0x4f, 0x13, 0x4f,
+	0x14, 0xc7, 0x3b, 0xf0, 0xa3, 0x3f, 0x1d, 0xd4, 0xc3, 0xc8, 0x61, 0x59, 0xc8, 0xd2, 0x34, 0x28,
+	0xd5, 0x84, 0x19, 0x28, 0x89, 0x17, 0x13, 0x23, 0x44, 0xe1, 0xaa, 0xab, 0x5e, 0xbc, 0x34, 0x5b,
+	0xfa, 0x5c, 0x36, 0xb6, 0x3b, 0xcb, 0xce, 0xae, 0x50, 0x0c, 0x17, 0x3d, 0x6b, 0x4c, 0x38, 0xe8,
+	0x1f, 0x60, 0x62, 0xe2, 0xd9, 0x3f, 0x82, 0x23, 0xd1, 0x8b, 0x27, 0x35, 0xad, 0xf1, 0xef, 0x30,
+	0x3b, 0x33, 0x6b, 0x5b, 0x5c, 0x4a, 0xb1, 0x92, 0x78, 0xea, 0x4e, 0xe7, 0xfb, 0xde, 0x7c, 0xde,
+	0xf7, 0xcd, 0x3c, 0x5c, 0x58, 0xe7, 0xa2, 0xc1, 0x05, 0x73, 0xe2, 0x68, 0x63, 0x87, 0x3d, 0x59,
+	0xac, 0x42, 0xe4, 0x2c, 0xb2, 0xcd, 0x18, 0xc2, 0x26, 0x0d, 0x42, 0x1e, 0x71, 0x32, 0xa1, 0x14,
+	0x54, 0x2a, 0xa8, 0x56, 0x98, 0x13, 0x2e, 0x77, 0xb9, 0x14, 0xb0, 0xe4, 0x4b, 0x69, 0xcd, 0x69,
+	0x97, 0x73, 0xb7, 0x0e, 0xcc, 0x09, 0x3c, 0xe6, 0xf8, 0x3e, 0x8f, 0x9c, 0xc8, 0xe3, 0xbe, 0xd0,
+	0xbb, 0x96, 0xde, 0x95, 0xab, 0x6a, 0xfc, 0x88, 0xd5, 0xe2, 0x50, 0x0a, 0xf4, 0xfe, 0x55, 0xcd,
+	0x52, 0x75, 0x04, 0x28, 0x84, 0x5f, 0x40, 0x81, 0xe3, 0x7a, 0x7e, 0xb7, 0x36, 0x9b, 0x5b, 0xae,
+	0xb4, 0x62, 0x52, 0x29, 0x2a, 0x0a, 0x52, 0x2d, 0xd4, 0x56, 0xf1, 0x07, 0xc2, 0xe4, 0x6e, 0x92,
+	0x7f, 0x2d, 0x74, 0xfc, 0x48, 0xd8, 0xb0, 0x19, 0x83, 0x88, 0x48, 0x19, 0xff, 0xef, 0x26, 0x7f,
+	0x40, 0x68, 0xa0, 0x02, 0x2a, 0x9d, 0x5d, 0x31, 0x3e, 0x7e, 0x98, 0x4f, 0xcb, 0x5f, 0xae, 0xd5,
+	0x42, 0x10, 0xe2, 0x5e, 0x14, 0x7a, 0xbe, 0x6b, 0xa7, 0xc2, 0x4e, 0x0c, 0x18, 0x23, 0x83, 0xc5,
+	0x00, 0x29, 0xe0, 0x73, 0x0d, 0xe1, 0x56, 0xa2, 0x66, 0x00, 0x95, 0x38, 0xac, 0x1b, 0xa3, 0x49,
+	0xa0, 0x8d, 0x1b, 0xc2, 0xbd, 0xdf, 0x0c, 0xe0, 0x41, 0x58, 0x27, 0xab, 0x18, 0x77, 0x2a, 0x36,
+	0xfe, 0x2b, 0xa0, 0xd2, 0x78, 0xf9, 0x32, 0xd5, 0x59, 0x13, 0x7b, 0xa8, 0xea, 0x90, 0xae, 0x9b,
+	0xde, 0x71, 0x5c, 0xd0, 0x55, 0xd8, 0x5d, 0x91, 0xc5, 0x3d, 0x84, 0x2f, 0xf6, 0x14, 0x2a, 0x02,
+	0xee, 0x0b, 0x20, 0x4b, 0x38, 0x2f, 0x61, 0x84, 0x81, 0x0a, 0xa3, 0xa5, 0xf1, 0xf2, 0x14, 0xcd,
+	0x6a, 0x32, 0x95, 0x51, 0xb6, 0x96, 0x92, 0xb5, 0x1e, 0xa8, 0x11, 0x09, 0x35, 0x77, 0x2c, 0x94,
+	0x3a, 0xb1, 0x87, 0xea, 0x35, 0xc2, 0x93, 0x1d, 0x2a, 0x08, 0x87, 0xef, 0xc2, 0x6a, 0x06, 0xda,
+	0x9f, 0xf8, 0xf5, 0x0e, 0x61, 0x33, 0x8b, 0x4c, 0xdb, 0x76, 0xf3, 0x90, 0x6d, 0xa5, 0x3e, 0xb6,
+	0x2d, 0xc7, 0xd1, 0x06, 0x0f, 0xbd, 0x1d, 0x99, 0xf8, 0xd4, 0x3d, 0x84, 0x23, 0x3c, 0x84, 0x41,
+	0x3d, 0x84, 0xd3, 0xf2, 0x10, 0xfe, 0x5d, 0x0f, 0x5f, 0xa4, 0xa4, 0xb7, 0xb7, 0x03, 0x2f, 0x31,
+	0x63, 0xf8, 0x8b, 0x78, 0x1d, 0xe7, 0xb7, 0x3c, 0xbf, 0xc6, 0xb7, 0x34, 0xd7, 0x24, 0x55, 0x33,
+	0x8f, 0xa6, 0x33, 0x8f, 0xde, 0xd2, 0x33, 0x6f, 0xe5, 0xcc, 0xfe, 0x97, 0x99, 0xdc, 0x9b, 0xaf,
+	0x33, 0xc8, 0xd6, 0x21, 0xc5, 0x0a, 0x9e, 0xca, 0xc4, 0xf9, 0x5b, 0xce, 0x95, 0x5f, 0x8e, 0xe1,
+	0x31, 0x79, 0x02, 0x79, 0x8e, 0x70, 0x5e, 0xa5, 0x27, 0x47, 0xa4, 0xf9, 0x7d, 0x3e, 0x9a, 0x57,
+	0x06, 0x50, 0x2a, 0xd6, 0xe2, 0xec, 0xb3, 0x4f, 0xdf, 0xf7, 0x46, 0x2c, 0x32, 0xcd, 0x32, 0xe7,
+	0xb4, 0xee, 0xe4, 0x7b, 0x84, 0xcf, 0xf7, 0xbc, 0x34, 0xc2, 0x8e, 0x3b, 0xe2, 0xd0, 0xb4, 0x30,
+	0x17, 0x06, 0x0f, 0xd0, 0x68, 0xd7, 0x24, 0xda, 0x02, 0xa1, 0xfd, 0xd0, 0x98, 0x6e, 0x28, 0x7b,
+	0xaa, 0x3f, 0x76, 0xbb, 0x60, 0x61, 0x60, 0x58, 0x38, 0x29, 0x2c, 0x0c, 0x01, 0x0b, 0x29, 0x2c,
+	0xec, 0x92, 0xb7, 0x08, 0x5f, 0xe8, 0xbd, 0x46, 0xa4, 0xdf, 0xe1, 0x99, 0x0f, 0xc0, 0x5c, 0x3c,
+	0x41, 0x84, 0xe6, 0x9d, 0x97, 0xbc, 0x73, 0xe4, 0x52, 0x5f, 0x5e, 0xd0, 0xc1, 0x2b, 0x37, 0xf6,
+	0x5b, 0x16, 0x3a, 0x68, 0x59, 0xe8, 0x5b, 0xcb, 0x42, 0xaf, 0xda, 0x56, 0xee, 0xa0, 0x6d, 0xe5,
+	0x3e, 0xb7, 0xad, 0xdc, 0xc3, 0x59, 0xd7, 0x8b, 0x36, 0xe2, 0x2a, 0x5d, 0xe7, 0x8d, 0x34, 0x95,
+	0xfa, 0x99, 0x17, 0xb5, 0xc7, 0x6c, 0x5b, 0xe5, 0xad, 0xe6, 0xe5, 0xb3, 0x5a, 0xfa, 0x39, 0x00,
+	0x6e, 0x7d, 0x56, 0x34, 0xc6, 0x08, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -445,6 +564,11 @@ type QueryClient interface {
 	//
 	// Since: cosmos-sdk 0.46
 	GranteeGrants(ctx context.Context, in *QueryGranteeGrantsRequest, opts ...grpc.CallOption) (*QueryGranteeGrantsResponse, error)
+	// ExpiringGrants returns a list of `GrantAuthorization` expiring within a
+	// window after the current block time, ordered by expiration.
+	//
+	// Since: cosmos-sdk 0.48
+	ExpiringGrants(ctx context.Context, in *QueryExpiringGrantsRequest, opts ...grpc.CallOption) (*QueryExpiringGrantsResponse, error)
 }
 
 type queryClient struct {
@@ -482,6 +606,15 @@ func (c *queryClient) GranteeGrants(ctx context.Context, in *QueryGranteeGrantsR
 	return out, nil
 }
 
+func (c *queryClient) ExpiringGrants(ctx context.Context, in *QueryExpiringGrantsRequest, opts ...grpc.CallOption) (*QueryExpiringGrantsResponse, error) {
+	out := new(QueryExpiringGrantsResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.authz.v1beta1.Query/ExpiringGrants", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 type QueryServer interface {
 	// Returns list of `Authorization`, granted to the grantee by the granter.
@@ -494,6 +627,11 @@ type QueryServer interface {
 	//
 	// Since: cosmos-sdk 0.46
 	GranteeGrants(context.Context, *QueryGranteeGrantsRequest) (*QueryGranteeGrantsResponse, error)
+	// ExpiringGrants returns a list of `GrantAuthorization` expiring within a
+	// window after the current block time, ordered by expiration.
+	//
+	// Since: cosmos-sdk 0.48
+	ExpiringGrants(context.Context, *QueryExpiringGrantsRequest) (*QueryExpiringGrantsResponse, error)
 }
 
 // UnimplementedQueryServer can be embedded to have forward compatible implementations.
@@ -509,6 +647,9 @@ func (*UnimplementedQueryServer) GranterGrants(ctx context.Context, req *QueryGr
 func (*UnimplementedQueryServer) GranteeGrants(ctx context.Context, req *QueryGranteeGrantsRequest) (*QueryGranteeGrantsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GranteeGrants not implemented")
 }
+func (*UnimplementedQueryServer) ExpiringGrants(ctx context.Context, req *QueryExpiringGrantsRequest) (*QueryExpiringGrantsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ExpiringGrants not implemented")
+}
 
 func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
 	s.RegisterService(&_Query_serviceDesc, srv)
@@ -568,6 +709,24 @@ func _Query_GranteeGrants_Handler(srv interface{}, ctx context.Context, dec func
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_ExpiringGrants_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryExpiringGrantsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ExpiringGrants(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.authz.v1beta1.Query/ExpiringGrants",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ExpiringGrants(ctx, req.(*QueryExpiringGrantsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Query_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "cosmos.authz.v1beta1.Query",
 	HandlerType: (*QueryServer)(nil),
@@ -584,6 +743,10 @@ var _Query_serviceDesc = grpc.ServiceDesc{
 			MethodName: "GranteeGrants",
 			Handler:    _Query_GranteeGrants_Handler,
 		},
+		{
+			MethodName: "ExpiringGrants",
+			Handler:    _Query_ExpiringGrants_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "cosmos/authz/v1beta1/query.proto",
@@ -876,6 +1039,81 @@ func (m *QueryGranteeGrantsResponse) MarshalToSizedBuffer(dAtA []byte) (int, err
 	return len(dAtA) - i, nil
 }
 
+func (m *QueryExpiringGrantsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryExpiringGrantsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryExpiringGrantsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	n7, err7 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.Window, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.Window):])
+	if err7 != nil {
+		return 0, err7
+	}
+	i -= n7
+	i = encodeVarintQuery(dAtA, i, uint64(n7))
+	i--
+	dAtA[i] = 0x12
+	if len(m.Granter) > 0 {
+		i -= len(m.Granter)
+		copy(dAtA[i:], m.Granter)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Granter)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryExpiringGrantsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryExpiringGrantsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryExpiringGrantsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Grants) > 0 {
+		for iNdEx := len(m.Grants) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Grants[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
 	offset -= sovQuery(v)
 	base := offset
@@ -1003,6 +1241,36 @@ func (m *QueryGranteeGrantsResponse) Size() (n int) {
 	return n
 }
 
+func (m *QueryExpiringGrantsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Granter)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.Window)
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryExpiringGrantsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Grants) > 0 {
+		for _, e := range m.Grants {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
 func sovQuery(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -1787,6 +2055,205 @@ func (m *QueryGranteeGrantsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *QueryExpiringGrantsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryExpiringGrantsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryExpiringGrantsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Granter", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Granter = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Window", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdDurationUnmarshal(&m.Window, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryExpiringGrantsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryExpiringGrantsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryExpiringGrantsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Grants", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Grants = append(m.Grants, &GrantAuthorization{})
+			if err := m.Grants[len(m.Grants)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipQuery(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
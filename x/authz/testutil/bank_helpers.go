@@ -23,3 +23,47 @@ func (k MockBankKeeper) UpdateParams(goCtx context.Context, req *bank.MsgUpdateP
 func (k MockBankKeeper) SetSendEnabled(goCtx context.Context, req *bank.MsgSetSendEnabled) (*bank.MsgSetSendEnabledResponse, error) {
 	return nil, nil
 }
+
+func (k MockBankKeeper) UpdateDenomMetadata(goCtx context.Context, req *bank.MsgUpdateDenomMetadata) (*bank.MsgUpdateDenomMetadataResponse, error) {
+	return nil, nil
+}
+
+func (k MockBankKeeper) CreateBalanceSnapshot(goCtx context.Context, req *bank.MsgCreateBalanceSnapshot) (*bank.MsgCreateBalanceSnapshotResponse, error) {
+	return nil, nil
+}
+
+func (k MockBankKeeper) DeleteBalanceSnapshot(goCtx context.Context, req *bank.MsgDeleteBalanceSnapshot) (*bank.MsgDeleteBalanceSnapshotResponse, error) {
+	return nil, nil
+}
+
+func (k MockBankKeeper) BatchSend(goCtx context.Context, req *bank.MsgBatchSend) (*bank.MsgBatchSendResponse, error) {
+	return nil, nil
+}
+
+func (k MockBankKeeper) SetMintBurnPermissions(goCtx context.Context, req *bank.MsgSetMintBurnPermissions) (*bank.MsgSetMintBurnPermissionsResponse, error) {
+	return nil, nil
+}
+
+func (k MockBankKeeper) SetDustSweepOptIn(goCtx context.Context, req *bank.MsgSetDustSweepOptIn) (*bank.MsgSetDustSweepOptInResponse, error) {
+	return nil, nil
+}
+
+func (k MockBankKeeper) SendWithTimelock(goCtx context.Context, req *bank.MsgSendWithTimelock) (*bank.MsgSendWithTimelockResponse, error) {
+	return nil, nil
+}
+
+func (k MockBankKeeper) ClaimTimelockedTransfer(goCtx context.Context, req *bank.MsgClaimTimelockedTransfer) (*bank.MsgClaimTimelockedTransferResponse, error) {
+	return nil, nil
+}
+
+func (k MockBankKeeper) ReclaimTimelockedTransfer(goCtx context.Context, req *bank.MsgReclaimTimelockedTransfer) (*bank.MsgReclaimTimelockedTransferResponse, error) {
+	return nil, nil
+}
+
+func (k MockBankKeeper) SetSpendingLimit(goCtx context.Context, req *bank.MsgSetSpendingLimit) (*bank.MsgSetSpendingLimitResponse, error) {
+	return nil, nil
+}
+
+func (k MockBankKeeper) SetDemurrageSchedule(goCtx context.Context, req *bank.MsgSetDemurrageSchedule) (*bank.MsgSetDemurrageScheduleResponse, error) {
+	return nil, nil
+}
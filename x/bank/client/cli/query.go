@@ -2,7 +2,9 @@ package cli
 
 import (
 	"fmt"
+	"strconv"
 	"strings"
+	"time"
 
 	"github.com/spf13/cobra"
 
@@ -16,6 +18,8 @@ import (
 const (
 	FlagDenom        = "denom"
 	FlagResolveDenom = "resolve-denom"
+	FlagUnit         = "unit"
+	FlagSent         = "sent"
 )
 
 // GetQueryCmd returns the parent command for all x/bank CLi query commands. The
@@ -36,6 +40,17 @@ func GetQueryCmd() *cobra.Command {
 		GetCmdQueryTotalSupply(),
 		GetCmdDenomsMetadata(),
 		GetCmdQuerySendEnabled(),
+		GetCmdQueryMintBurnPermissions(),
+		GetCmdQueryModuleEscrows(),
+		GetCmdQueryTimelockedTransfer(),
+		GetCmdQueryTimelockedTransfers(),
+		GetCmdQuerySpendingLimit(),
+		GetCmdQueryDemurrageSchedules(),
+		GetHoldsCmd(),
+		GetSpendableBalanceProjectionCmd(),
+		GetCmdDisplayAmount(),
+		GetCmdQueryBalanceSnapshots(),
+		GetCmdQueryBalanceSnapshotProof(),
 	)
 
 	return cmd
@@ -177,6 +192,240 @@ func GetSpendableBalancesCmd() *cobra.Command {
 	return cmd
 }
 
+// GetHoldsCmd defines the cobra command to query the holds on the balance of
+// an account.
+func GetHoldsCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "holds [address]",
+		Short:   "Query for the holds on the balance of an account, and its held and spendable balances",
+		Example: fmt.Sprintf("$ %s query %s holds [address]", version.AppName, types.ModuleName),
+		Args:    cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			addr, err := sdk.AccAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.Holds(cmd.Context(), &types.QueryHoldsRequest{Address: addr.String()})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// GetSpendableBalanceProjectionCmd defines the cobra command to query the
+// projected spendable balance of an account at a future time.
+func GetSpendableBalanceProjectionCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "spendable-balance-projection [address] [time]",
+		Short: "Query for the projected spendable balance of an account at a future time, accounting for its vesting schedule and holds",
+		Long: `Query for the projected spendable balance of an account at a future time, in RFC3339 format,
+accounting for its vesting schedule and for the expiration of the holds on its balance,
+assuming its balance is unchanged.`,
+		Example: fmt.Sprintf("$ %s query %s spendable-balance-projection [address] 2024-01-01T00:00:00Z", version.AppName, types.ModuleName),
+		Args:    cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			addr, err := sdk.AccAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+
+			t, err := time.Parse(time.RFC3339, args[1])
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.SpendableBalanceProjection(cmd.Context(), &types.QuerySpendableBalanceProjectionRequest{Address: addr.String(), Time: t})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// GetCmdDisplayAmount defines the cobra command to convert an amount of the
+// base unit of a denomination to its display unit.
+func GetCmdDisplayAmount() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "display-amount [coin]",
+		Short: "Convert an amount of the base unit of a denomination to its display unit",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Convert an amount of the base unit of a denomination to its display unit, or to
+another unit, using the exponents of the client metadata of the denomination.
+
+Example:
+  $ %s query %s display-amount 1500000uatom
+  $ %s query %s display-amount 1500000uatom --unit=matom
+`,
+				version.AppName, types.ModuleName, version.AppName, types.ModuleName,
+			),
+		),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			coin, err := sdk.ParseCoinNormalized(args[0])
+			if err != nil {
+				return err
+			}
+
+			unit, err := cmd.Flags().GetString(FlagUnit)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+			res, err := queryClient.DisplayAmount(cmd.Context(), &types.QueryDisplayAmountRequest{
+				Denom:  coin.Denom,
+				Amount: coin.Amount.String(),
+				Unit:   unit,
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	cmd.Flags().String(FlagUnit, "", "The unit to convert the amount to, instead of the display unit")
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// GetCmdQueryBalanceSnapshots defines the cobra command to query a balance
+// snapshot, or all the balance snapshots.
+func GetCmdQueryBalanceSnapshots() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "balance-snapshots [snapshot-id]",
+		Short: "Query for a balance snapshot, or all the balance snapshots",
+		Example: strings.TrimSpace(
+			fmt.Sprintf(`Getting one specific balance snapshot:
+  $ %[1]s query %[2]s balance-snapshots 1
+
+Getting all balance snapshots:
+  $ %[1]s query %[2]s balance-snapshots
+`,
+				version.AppName, types.ModuleName,
+			),
+		),
+		Args: cobra.MaximumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			if len(args) == 0 {
+				pageReq, err := client.ReadPageRequest(cmd.Flags())
+				if err != nil {
+					return err
+				}
+
+				res, err := queryClient.BalanceSnapshots(cmd.Context(), &types.QueryBalanceSnapshotsRequest{Pagination: pageReq})
+				if err != nil {
+					return err
+				}
+
+				return clientCtx.PrintProto(res)
+			}
+
+			id, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return fmt.Errorf("invalid snapshot id %q: %w", args[0], err)
+			}
+
+			res, err := queryClient.BalanceSnapshot(cmd.Context(), &types.QueryBalanceSnapshotRequest{SnapshotId: id})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "balance snapshots")
+
+	return cmd
+}
+
+// GetCmdQueryBalanceSnapshotProof defines the cobra command to query the
+// balance of an account recorded in a balance snapshot, along with its merkle
+// proof.
+func GetCmdQueryBalanceSnapshotProof() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "balance-snapshot-proof [snapshot-id] [address]",
+		Short:   "Query for the balance of an account recorded in a balance snapshot, along with its merkle proof",
+		Example: fmt.Sprintf("$ %s query %s balance-snapshot-proof 1 [address]", version.AppName, types.ModuleName),
+		Args:    cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			id, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return fmt.Errorf("invalid snapshot id %q: %w", args[0], err)
+			}
+
+			addr, err := sdk.AccAddressFromBech32(args[1])
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+			res, err := queryClient.BalanceSnapshotProof(cmd.Context(), &types.QueryBalanceSnapshotProofRequest{
+				SnapshotId: id,
+				Address:    addr.String(),
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
 // GetCmdDenomsMetadata defines the cobra command to query client denomination metadata.
 func GetCmdDenomsMetadata() *cobra.Command {
 	cmd := &cobra.Command{
@@ -347,3 +596,275 @@ Getting all entries:
 
 	return cmd
 }
+
+func GetCmdQueryMintBurnPermissions() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "mint-burn-permissions [denom1 ...]",
+		Short: "Query for the mint/burn permissions of denoms",
+		Long: strings.TrimSpace(`Query for the modules allowed to mint and burn denoms. Denoms without
+mint/burn permissions can be minted and burned by any module with the minter
+and burner permissions, and are not returned.
+
+To look up one or more specific denoms, supply them as arguments to this command.
+To look up all denoms, do not provide any arguments.
+`,
+		),
+		Example: strings.TrimSpace(
+			fmt.Sprintf(`Getting one specific entry:
+  $ %[1]s query %[2]s mint-burn-permissions foocoin
+
+Getting all entries:
+  $ %[1]s query %[2]s mint-burn-permissions
+`,
+				version.AppName, types.ModuleName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			reqPag, err := client.ReadPageRequest(client.MustFlagSetWithPageKeyDecoded(cmd.Flags()))
+			if err != nil {
+				return err
+			}
+
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+			req := &types.QueryMintBurnPermissionsRequest{
+				Denoms:     args,
+				Pagination: reqPag,
+			}
+
+			res, err := queryClient.MintBurnPermissions(cmd.Context(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "mint/burn permissions")
+
+	return cmd
+}
+
+// GetCmdQueryModuleEscrows defines the cobra command to query the breakdown of
+// the balance of a module account by purpose.
+func GetCmdQueryModuleEscrows() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "module-escrows [module-name]",
+		Short: "Query for the escrows of a module account, by purpose",
+		Long: strings.TrimSpace(`Query for the breakdown of the balance of a module account by purpose, e.g. the
+governance deposits by proposal, along with the amounts of its balance which
+aren't accounted for by its escrows.
+`,
+		),
+		Example: fmt.Sprintf("$ %s query %s module-escrows gov", version.AppName, types.ModuleName),
+		Args:    cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			reqPag, err := client.ReadPageRequest(client.MustFlagSetWithPageKeyDecoded(cmd.Flags()))
+			if err != nil {
+				return err
+			}
+
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+			res, err := queryClient.ModuleEscrows(cmd.Context(), &types.QueryModuleEscrowsRequest{
+				ModuleName: args[0],
+				Pagination: reqPag,
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "module escrows")
+
+	return cmd
+}
+
+// GetCmdQueryTimelockedTransfer defines the cobra command to query a
+// time-locked transfer by its identifier.
+func GetCmdQueryTimelockedTransfer() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "timelocked-transfer [transfer-id]",
+		Short:   "Query for a time-locked transfer by its identifier",
+		Example: fmt.Sprintf("$ %s query %s timelocked-transfer 1", version.AppName, types.ModuleName),
+		Args:    cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			id, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return fmt.Errorf("invalid transfer id: %w", err)
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+			res, err := queryClient.TimelockedTransfer(cmd.Context(), &types.QueryTimelockedTransferRequest{TransferId: id})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// GetCmdQueryTimelockedTransfers defines the cobra command to query the
+// time-locked transfers claimable by, or sent by, an account.
+func GetCmdQueryTimelockedTransfers() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "timelocked-transfers [address]",
+		Short: "Query for the time-locked transfers claimable by an account, or sent by it with --sent",
+		Example: fmt.Sprintf(`$ %[1]s query %[2]s timelocked-transfers [address]
+$ %[1]s query %[2]s timelocked-transfers [address] --sent`, version.AppName, types.ModuleName),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			sent, err := cmd.Flags().GetBool(FlagSent)
+			if err != nil {
+				return err
+			}
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+			if sent {
+				res, err := queryClient.TimelockedTransfersBySender(cmd.Context(), &types.QueryTimelockedTransfersBySenderRequest{
+					Sender:     args[0],
+					Pagination: pageReq,
+				})
+				if err != nil {
+					return err
+				}
+
+				return clientCtx.PrintProto(res)
+			}
+
+			res, err := queryClient.TimelockedTransfersByRecipient(cmd.Context(), &types.QueryTimelockedTransfersByRecipientRequest{
+				Recipient:  args[0],
+				Pagination: pageReq,
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	cmd.Flags().Bool(FlagSent, false, "Query the time-locked transfers sent by the account instead")
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "timelocked transfers")
+
+	return cmd
+}
+
+// GetCmdQuerySpendingLimit defines the cobra command to query the spending
+// limit of an account.
+func GetCmdQuerySpendingLimit() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "spending-limit [address]",
+		Short:   "Query for the spending limit of an account, and the funds it can still send within the current window",
+		Example: fmt.Sprintf("$ %s query %s spending-limit [address]", version.AppName, types.ModuleName),
+		Args:    cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+			res, err := queryClient.SpendingLimit(cmd.Context(), &types.QuerySpendingLimitRequest{Address: args[0]})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// GetCmdQueryDemurrageSchedules defines the cobra command to query the
+// demurrage schedules of denoms.
+func GetCmdQueryDemurrageSchedules() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "demurrage-schedules [denom1 ...]",
+		Short: "Query for the demurrage schedules of denoms",
+		Long: strings.TrimSpace(`Query for the decay, or expiry, schedules of the balances of denoms. Denoms
+without demurrage schedule don't decay, and are not returned.
+
+To look up one or more specific denoms, supply them as arguments to this command.
+To look up all denoms, do not provide any arguments.
+`,
+		),
+		Example: strings.TrimSpace(
+			fmt.Sprintf(`Getting one specific entry:
+  $ %[1]s query %[2]s demurrage-schedules points
+
+Getting all entries:
+  $ %[1]s query %[2]s demurrage-schedules
+`,
+				version.AppName, types.ModuleName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			reqPag, err := client.ReadPageRequest(client.MustFlagSetWithPageKeyDecoded(cmd.Flags()))
+			if err != nil {
+				return err
+			}
+
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+			req := &types.QueryDemurrageSchedulesRequest{
+				Denoms:     args,
+				Pagination: reqPag,
+			}
+
+			res, err := queryClient.DemurrageSchedules(cmd.Context(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "demurrage schedules")
+
+	return cmd
+}
@@ -1,7 +1,12 @@
 package cli
 
 import (
+	"encoding/csv"
 	"fmt"
+	"io"
+	"os"
+	"strconv"
+	"time"
 
 	sdkmath "cosmossdk.io/math"
 	"github.com/spf13/cobra"
@@ -10,6 +15,7 @@ import (
 	"github.com/cosmos/cosmos-sdk/client/flags"
 	"github.com/cosmos/cosmos-sdk/client/tx"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/version"
 	"github.com/cosmos/cosmos-sdk/x/bank/types"
 )
 
@@ -28,6 +34,11 @@ func NewTxCmd() *cobra.Command {
 	txCmd.AddCommand(
 		NewSendTxCmd(),
 		NewMultiSendTxCmd(),
+		NewBatchSendTxCmd(),
+		NewSendWithTimelockTxCmd(),
+		NewClaimTimelockedTransferTxCmd(),
+		NewReclaimTimelockedTransferTxCmd(),
+		NewSetSpendingLimitTxCmd(),
 	)
 
 	return txCmd
@@ -147,3 +158,244 @@ When using '--dry-run' a key name cannot be used, only a bech32 address.
 
 	return cmd
 }
+
+// NewBatchSendTxCmd returns a CLI command handler for creating a MsgBatchSend transaction.
+func NewBatchSendTxCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "batch-send [from_key_or_address] [outputs_file]",
+		Short: "Send funds from one account to many accounts, with a memo per output.",
+		Long: `Send funds from one account to many accounts, with an optional memo per output
+emitted in its transfer event.
+The outputs are read from a CSV file with one output per line, formatted as
+address,amount[,memo], e.g. cosmos1...,100stake,withdrawal-42.
+Note, the '--from' flag is ignored as it is implied from [from_key_or_address].
+When using '--dry-run' a key name cannot be used, only a bech32 address.
+`,
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cmd.Flags().Set(flags.FlagFrom, args[0])
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			f, err := os.Open(args[1])
+			if err != nil {
+				return err
+			}
+			defer f.Close()
+
+			outputs, err := parseBatchOutputs(f)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgBatchSend(clientCtx.GetFromAddress(), outputs)
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// parseBatchOutputs parses the outputs of a batch send from CSV records
+// formatted as address,amount[,memo].
+func parseBatchOutputs(r io.Reader) ([]types.BatchOutput, error) {
+	reader := csv.NewReader(r)
+	reader.FieldsPerRecord = -1
+	reader.TrimLeadingSpace = true
+
+	records, err := reader.ReadAll()
+	if err != nil {
+		return nil, err
+	}
+
+	outputs := make([]types.BatchOutput, 0, len(records))
+	for i, record := range records {
+		if len(record) != 2 && len(record) != 3 {
+			return nil, fmt.Errorf("line %d: expected address,amount[,memo], got %d fields", i+1, len(record))
+		}
+
+		toAddr, err := sdk.AccAddressFromBech32(record[0])
+		if err != nil {
+			return nil, fmt.Errorf("line %d: %w", i+1, err)
+		}
+
+		coins, err := sdk.ParseCoinsNormalized(record[1])
+		if err != nil {
+			return nil, fmt.Errorf("line %d: %w", i+1, err)
+		}
+
+		var memo string
+		if len(record) == 3 {
+			memo = record[2]
+		}
+
+		outputs = append(outputs, types.NewBatchOutput(toAddr, coins, memo))
+	}
+
+	if len(outputs) == 0 {
+		return nil, fmt.Errorf("no outputs in batch")
+	}
+
+	return outputs, nil
+}
+
+// NewSendWithTimelockTxCmd returns a CLI command handler for creating a
+// MsgSendWithTimelock transaction.
+func NewSendWithTimelockTxCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "send-with-timelock [from_key_or_address] [to_address] [amount] [unlock_time] [expiry_time]",
+		Short: "Send funds claimable by the recipient once unlocked, and reclaimable by the sender once expired.",
+		Long: `Send funds claimable by the recipient from the unlock time until the expiry
+time, from which the sender can reclaim them if unclaimed. The funds are held in
+the account of the sender until they are claimed or reclaimed. The times are in
+RFC 3339 format, e.g. 2024-01-02T15:04:05Z.
+Note, the '--from' flag is ignored as it is implied from [from_key_or_address].
+`,
+		Args: cobra.ExactArgs(5),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cmd.Flags().Set(flags.FlagFrom, args[0])
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			toAddr, err := sdk.AccAddressFromBech32(args[1])
+			if err != nil {
+				return err
+			}
+
+			coins, err := sdk.ParseCoinsNormalized(args[2])
+			if err != nil {
+				return err
+			}
+
+			unlockTime, err := time.Parse(time.RFC3339, args[3])
+			if err != nil {
+				return fmt.Errorf("invalid unlock time: %w", err)
+			}
+
+			expiryTime, err := time.Parse(time.RFC3339, args[4])
+			if err != nil {
+				return fmt.Errorf("invalid expiry time: %w", err)
+			}
+
+			if err := types.ValidateTimelock(coins, unlockTime, expiryTime); err != nil {
+				return err
+			}
+
+			msg := types.NewMsgSendWithTimelock(clientCtx.GetFromAddress(), toAddr, coins, unlockTime, expiryTime)
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// NewClaimTimelockedTransferTxCmd returns a CLI command handler for creating a
+// MsgClaimTimelockedTransfer transaction.
+func NewClaimTimelockedTransferTxCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "claim-timelocked-transfer [transfer_id]",
+		Short: "Claim the funds of an unlocked time-locked transfer as its recipient.",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			id, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return fmt.Errorf("invalid transfer id: %w", err)
+			}
+
+			msg := types.NewMsgClaimTimelockedTransfer(clientCtx.GetFromAddress(), id)
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// NewReclaimTimelockedTransferTxCmd returns a CLI command handler for creating
+// a MsgReclaimTimelockedTransfer transaction.
+func NewReclaimTimelockedTransferTxCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "reclaim-timelocked-transfer [transfer_id]",
+		Short: "Reclaim the funds of an expired time-locked transfer as its sender.",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			id, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return fmt.Errorf("invalid transfer id: %w", err)
+			}
+
+			msg := types.NewMsgReclaimTimelockedTransfer(clientCtx.GetFromAddress(), id)
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// NewSetSpendingLimitTxCmd returns a CLI command handler for creating a
+// MsgSetSpendingLimit transaction.
+func NewSetSpendingLimitTxCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "set-spending-limit [limits] [window]",
+		Short: "Limit the funds the account can send within any window, or remove its limit with empty limits.",
+		Long: `Limit the funds the account can send within any window, e.g. 1000stake per 24h.
+A change loosening the existing limit, including its removal, only takes effect after a window of the existing limit.
+`,
+		Example: fmt.Sprintf(`$ %[1]s tx %[2]s set-spending-limit 1000stake,500atom 24h --from mykey
+$ %[1]s tx %[2]s set-spending-limit "" 0s --from mykey`, version.AppName, types.ModuleName),
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			limits, err := sdk.ParseCoinsNormalized(args[0])
+			if err != nil {
+				return err
+			}
+
+			window, err := time.ParseDuration(args[1])
+			if err != nil {
+				return fmt.Errorf("invalid window: %w", err)
+			}
+
+			if err := types.ValidateSpendingLimit(limits, window); err != nil {
+				return err
+			}
+
+			msg := types.NewMsgSetSpendingLimit(clientCtx.GetFromAddress(), limits, window)
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
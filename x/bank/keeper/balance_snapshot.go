@@ -0,0 +1,235 @@
+package keeper
+
+import (
+	"bytes"
+	"context"
+	"encoding/hex"
+	"errors"
+	"sort"
+	"strconv"
+
+	"cosmossdk.io/collections"
+	errorsmod "cosmossdk.io/errors"
+	"cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/bank/types"
+)
+
+// GetBalanceSnapshot returns the balance snapshot of the given identifier.
+func (k BaseViewKeeper) GetBalanceSnapshot(ctx context.Context, id uint64) (types.BalanceSnapshot, bool) {
+	snapshot, err := k.Snapshots.Get(ctx, id)
+	if err != nil {
+		if !errors.Is(err, collections.ErrNotFound) {
+			panic(err)
+		}
+		return types.BalanceSnapshot{}, false
+	}
+
+	return snapshot, true
+}
+
+// IterateSnapshotBalances iterates over the balances recorded in a balance
+// snapshot, in address order, and calls the provided callback. If true is
+// returned from the callback, iteration is halted.
+func (k BaseViewKeeper) IterateSnapshotBalances(ctx context.Context, id uint64, cb func(addr sdk.AccAddress, amount math.Int) (stop bool)) {
+	err := k.SnapshotBalances.Walk(ctx, collections.NewPrefixedPairRange[uint64, sdk.AccAddress](id), func(key collections.Pair[uint64, sdk.AccAddress], amount math.Int) (stop bool) {
+		return cb(key.K2(), amount)
+	})
+	if err != nil {
+		panic(err)
+	}
+}
+
+// GetBalanceSnapshotProof returns the balance of an account recorded in a
+// taken balance snapshot, along with its merkle proof of inclusion in the root
+// of the snapshot. It returns false if the account had no balance.
+func (k BaseViewKeeper) GetBalanceSnapshotProof(ctx context.Context, id uint64, addr sdk.AccAddress) (math.Int, types.BalanceSnapshotProof, bool) {
+	index := -1
+	var (
+		amount math.Int
+		leaves [][]byte
+	)
+	k.IterateSnapshotBalances(ctx, id, func(holder sdk.AccAddress, balance math.Int) bool {
+		if holder.Equals(addr) {
+			index = len(leaves)
+			amount = balance
+		}
+		leaves = append(leaves, types.SnapshotLeaf(holder, balance))
+		return false
+	})
+
+	if index < 0 {
+		return math.Int{}, types.BalanceSnapshotProof{}, false
+	}
+
+	return amount, types.NewBalanceSnapshotProof(leaves, index), true
+}
+
+// CreateBalanceSnapshot schedules a snapshot of the balances of all the holders
+// of the denom at the end of the given height, which defaults to the current
+// height when zero. It returns the identifier of the snapshot.
+func (k BaseKeeper) CreateBalanceSnapshot(ctx context.Context, denom string, height int64) (uint64, error) {
+	if err := sdk.ValidateDenom(denom); err != nil {
+		return 0, errorsmod.Wrap(types.ErrInvalidSnapshot, err.Error())
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	if height == 0 {
+		height = sdkCtx.BlockHeight()
+	}
+	if height < sdkCtx.BlockHeight() {
+		return 0, errorsmod.Wrapf(types.ErrInvalidSnapshot, "height %d is in the past", height)
+	}
+
+	id, err := k.SnapshotSequence.Next(ctx)
+	if err != nil {
+		return 0, err
+	}
+
+	if err := k.Snapshots.Set(ctx, id, types.NewBalanceSnapshot(id, denom, height)); err != nil {
+		return 0, err
+	}
+	if err := k.PendingSnapshots.Set(ctx, collections.Join(height, id)); err != nil {
+		return 0, err
+	}
+
+	sdkCtx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeCreateBalanceSnapshot,
+			sdk.NewAttribute(types.AttributeKeySnapshotID, strconv.FormatUint(id, 10)),
+			sdk.NewAttribute(types.AttributeKeyDenom, denom),
+			sdk.NewAttribute(types.AttributeKeyHeight, strconv.FormatInt(height, 10)),
+		),
+	)
+
+	return id, nil
+}
+
+// DeleteBalanceSnapshot deletes a balance snapshot, along with its recorded
+// balances. A snapshot not taken yet is cancelled.
+func (k BaseKeeper) DeleteBalanceSnapshot(ctx context.Context, id uint64) error {
+	snapshot, found := k.GetBalanceSnapshot(ctx, id)
+	if !found {
+		return errorsmod.Wrapf(types.ErrSnapshotNotFound, "balance snapshot %d", id)
+	}
+
+	if !snapshot.Taken {
+		if err := k.PendingSnapshots.Remove(ctx, collections.Join(snapshot.Height, id)); err != nil {
+			return err
+		}
+	}
+
+	var holders []sdk.AccAddress
+	k.IterateSnapshotBalances(ctx, id, func(addr sdk.AccAddress, _ math.Int) bool {
+		holders = append(holders, addr)
+		return false
+	})
+	for _, addr := range holders {
+		if err := k.SnapshotBalances.Remove(ctx, collections.Join(id, addr)); err != nil {
+			return err
+		}
+	}
+
+	if err := k.Snapshots.Remove(ctx, id); err != nil {
+		return err
+	}
+
+	sdk.UnwrapSDKContext(ctx).EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeDeleteBalanceSnapshot,
+			sdk.NewAttribute(types.AttributeKeySnapshotID, strconv.FormatUint(id, 10)),
+		),
+	)
+
+	return nil
+}
+
+// TakeBalanceSnapshots records the balances of the snapshots due at the
+// current height. It is called at the end of each block.
+func (k BaseKeeper) TakeBalanceSnapshots(ctx context.Context) error {
+	height := sdk.UnwrapSDKContext(ctx).BlockHeight()
+
+	var due []collections.Pair[int64, uint64]
+	err := k.PendingSnapshots.Walk(ctx, nil, func(key collections.Pair[int64, uint64]) (stop bool) {
+		if key.K1() > height {
+			return true
+		}
+		due = append(due, key)
+		return false
+	})
+	if err != nil {
+		return err
+	}
+
+	for _, key := range due {
+		if err := k.takeBalanceSnapshot(ctx, key.K2()); err != nil {
+			return err
+		}
+		if err := k.PendingSnapshots.Remove(ctx, key); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// takeBalanceSnapshot records the current balances of the holders of the denom
+// of a snapshot, and sets the merkle root of the recorded balances.
+func (k BaseKeeper) takeBalanceSnapshot(ctx context.Context, id uint64) error {
+	snapshot, err := k.Snapshots.Get(ctx, id)
+	if err != nil {
+		return err
+	}
+
+	var holders []sdk.AccAddress
+	err = k.Balances.Indexes.Denom.Walk(ctx, collections.NewPrefixedPairRange[string, sdk.AccAddress](snapshot.Denom), func(_ string, addr sdk.AccAddress) (stop bool) {
+		holders = append(holders, addr)
+		return false
+	})
+	if err != nil {
+		return err
+	}
+
+	// the leaves are in the order of the recorded balances in the store, i.e.
+	// in the byte order of the addresses
+	sort.Slice(holders, func(i, j int) bool {
+		return bytes.Compare(holders[i], holders[j]) < 0
+	})
+
+	total := math.ZeroInt()
+	leaves := make([][]byte, 0, len(holders))
+	for _, addr := range holders {
+		amount := k.GetBalance(ctx, addr, snapshot.Denom).Amount
+		if !amount.IsPositive() {
+			continue
+		}
+
+		if err := k.SnapshotBalances.Set(ctx, collections.Join(id, addr), amount); err != nil {
+			return err
+		}
+		total = total.Add(amount)
+		leaves = append(leaves, types.SnapshotLeaf(addr, amount))
+	}
+
+	snapshot.Taken = true
+	snapshot.Root = types.SnapshotRoot(leaves)
+	snapshot.Count = uint64(len(leaves))
+	snapshot.Total = total
+	if err := k.Snapshots.Set(ctx, id, snapshot); err != nil {
+		return err
+	}
+
+	sdk.UnwrapSDKContext(ctx).EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeTakeBalanceSnapshot,
+			sdk.NewAttribute(types.AttributeKeySnapshotID, strconv.FormatUint(id, 10)),
+			sdk.NewAttribute(types.AttributeKeyDenom, snapshot.Denom),
+			sdk.NewAttribute(types.AttributeKeyRoot, hex.EncodeToString(snapshot.Root)),
+			sdk.NewAttribute(types.AttributeKeyCount, strconv.FormatUint(snapshot.Count, 10)),
+			sdk.NewAttribute(sdk.AttributeKeyAmount, total.String()),
+		),
+	)
+
+	return nil
+}
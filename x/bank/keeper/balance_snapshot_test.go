@@ -0,0 +1,101 @@
+package keeper_test
+
+import (
+	"cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	banktestutil "github.com/cosmos/cosmos-sdk/x/bank/testutil"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+)
+
+func (suite *KeeperTestSuite) TestBalanceSnapshots() {
+	ctx := sdk.UnwrapSDKContext(suite.ctx)
+	require := suite.Require()
+	authority := govAcc.GetAddress().String()
+
+	for i, amt := range []int64{100, 50, 25} {
+		suite.mockFundAccount(accAddrs[i])
+		require.NoError(banktestutil.FundAccount(ctx, suite.bankKeeper, accAddrs[i], sdk.NewCoins(newFooCoin(amt), newBarCoin(amt))))
+	}
+
+	ctx = ctx.WithBlockHeight(10)
+
+	_, err := suite.msgServer.CreateBalanceSnapshot(ctx, banktypes.NewMsgCreateBalanceSnapshot("invalid", fooDenom, 0))
+	require.ErrorContains(err, "invalid authority")
+	_, err = suite.msgServer.CreateBalanceSnapshot(ctx, banktypes.NewMsgCreateBalanceSnapshot(authority, fooDenom, 9))
+	require.ErrorIs(err, banktypes.ErrInvalidSnapshot)
+
+	res, err := suite.msgServer.CreateBalanceSnapshot(ctx, banktypes.NewMsgCreateBalanceSnapshot(authority, fooDenom, 11))
+	require.NoError(err)
+	id := res.SnapshotId
+
+	// the balances are not recorded until the end of the height
+	require.NoError(suite.bankKeeper.TakeBalanceSnapshots(ctx))
+	snapshot, found := suite.bankKeeper.GetBalanceSnapshot(ctx, id)
+	require.True(found)
+	require.False(snapshot.Taken)
+	_, err = suite.queryClient.BalanceSnapshotProof(ctx, &banktypes.QueryBalanceSnapshotProofRequest{SnapshotId: id, Address: accAddrs[0].String()})
+	require.ErrorContains(err, "is not taken")
+
+	// the balances are recorded at the end of the height, and later transfers
+	// don't change the snapshot
+	ctx = ctx.WithBlockHeight(11)
+	require.NoError(suite.bankKeeper.TakeBalanceSnapshots(ctx))
+	suite.mockSendCoins(ctx, authtypes.NewBaseAccountWithAddress(accAddrs[0]), accAddrs[3])
+	require.NoError(suite.bankKeeper.SendCoins(ctx, accAddrs[0], accAddrs[3], sdk.NewCoins(newFooCoin(10))))
+
+	snapshotRes, err := suite.queryClient.BalanceSnapshot(ctx, &banktypes.QueryBalanceSnapshotRequest{SnapshotId: id})
+	require.NoError(err)
+	snapshot = snapshotRes.Snapshot
+	require.True(snapshot.Taken)
+	require.Equal(uint64(3), snapshot.Count)
+	require.Equal(math.NewInt(175), snapshot.Total)
+
+	for i, amt := range []int64{100, 50, 25} {
+		proofRes, err := suite.queryClient.BalanceSnapshotProof(ctx, &banktypes.QueryBalanceSnapshotProofRequest{SnapshotId: id, Address: accAddrs[i].String()})
+		require.NoError(err)
+		require.Equal(math.NewInt(amt), proofRes.Amount)
+		require.Equal(snapshot.Root, proofRes.Root)
+		require.NoError(banktypes.VerifyBalanceSnapshotProof(snapshot.Root, accAddrs[i], proofRes.Amount, proofRes.Proof))
+		require.Error(banktypes.VerifyBalanceSnapshotProof(snapshot.Root, accAddrs[i], proofRes.Amount.AddRaw(1), proofRes.Proof))
+	}
+	_, err = suite.queryClient.BalanceSnapshotProof(ctx, &banktypes.QueryBalanceSnapshotProofRequest{SnapshotId: id, Address: accAddrs[3].String()})
+	require.ErrorContains(err, "not found")
+
+	// the snapshots are exported and imported along with their balances
+	pending, err := suite.msgServer.CreateBalanceSnapshot(ctx, banktypes.NewMsgCreateBalanceSnapshot(authority, barDenom, 20))
+	require.NoError(err)
+
+	genesis := suite.bankKeeper.ExportGenesis(ctx)
+	require.Len(genesis.BalanceSnapshots, 2)
+	require.NoError(genesis.Validate())
+
+	allRes, err := suite.queryClient.BalanceSnapshots(ctx, &banktypes.QueryBalanceSnapshotsRequest{})
+	require.NoError(err)
+	require.Len(allRes.Snapshots, 2)
+
+	// the snapshots are deleted along with their balances
+	_, err = suite.msgServer.DeleteBalanceSnapshot(ctx, banktypes.NewMsgDeleteBalanceSnapshot(authority, pending.SnapshotId+1))
+	require.ErrorIs(err, banktypes.ErrSnapshotNotFound)
+	_, err = suite.msgServer.DeleteBalanceSnapshot(ctx, banktypes.NewMsgDeleteBalanceSnapshot(authority, id))
+	require.NoError(err)
+	_, err = suite.msgServer.DeleteBalanceSnapshot(ctx, banktypes.NewMsgDeleteBalanceSnapshot(authority, pending.SnapshotId))
+	require.NoError(err)
+
+	_, found = suite.bankKeeper.GetBalanceSnapshot(ctx, id)
+	require.False(found)
+	_, _, found = suite.bankKeeper.GetBalanceSnapshotProof(ctx, id, accAddrs[0])
+	require.False(found)
+	require.NoError(suite.bankKeeper.TakeBalanceSnapshots(ctx.WithBlockHeight(20)))
+	_, found = suite.bankKeeper.GetBalanceSnapshot(ctx, pending.SnapshotId)
+	require.False(found)
+
+	suite.bankKeeper.InitGenesis(ctx, genesis)
+	snapshot, found = suite.bankKeeper.GetBalanceSnapshot(ctx, id)
+	require.True(found)
+	require.Equal(snapshotRes.Snapshot, snapshot)
+	amount, _, found := suite.bankKeeper.GetBalanceSnapshotProof(ctx, id, accAddrs[1])
+	require.True(found)
+	require.Equal(math.NewInt(50), amount)
+}
@@ -0,0 +1,240 @@
+package keeper
+
+import (
+	"context"
+	"errors"
+	"strconv"
+
+	"cosmossdk.io/collections"
+	"cosmossdk.io/math"
+	storetypes "cosmossdk.io/store/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/bank/types"
+)
+
+// GetDemurrageSchedule returns the demurrage schedule of a denom, if any.
+func (k BaseViewKeeper) GetDemurrageSchedule(ctx context.Context, denom string) (types.DemurrageSchedule, bool) {
+	s, err := k.DemurrageSchedules.Get(ctx, denom)
+	if err != nil {
+		if !errors.Is(err, collections.ErrNotFound) {
+			panic(err)
+		}
+		return types.DemurrageSchedule{}, false
+	}
+
+	return s, true
+}
+
+// hasDemurrageSchedule returns whether a denom has a demurrage schedule. It is
+// checked on every balance read and change, so that it doesn't consume gas:
+// the balances of the denominations without schedule cost the same gas as
+// before the demurrage.
+func (k BaseViewKeeper) hasDemurrageSchedule(ctx context.Context, denom string) bool {
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	has, err := k.DemurrageSchedules.Has(sdkCtx.WithGasMeter(storetypes.NewInfiniteGasMeter()), denom)
+	if err != nil {
+		panic(err)
+	}
+
+	return has
+}
+
+// demurrageExempt returns whether the balances of an account are exempt from
+// the demurrage, i.e. whether it is a module account or a blocked address, so
+// that the coins escrowed by the modules, e.g. the bonded tokens or the
+// deposits, keep matching their records.
+func (k BaseViewKeeper) demurrageExempt(ctx context.Context, addr sdk.AccAddress) bool {
+	if k.blockedAddrs[addr.String()] {
+		return true
+	}
+
+	_, ok := k.ak.GetAccount(ctx, addr).(sdk.ModuleAccountI)
+	return ok
+}
+
+// IterateDemurrageSchedules iterates over the demurrage schedules, in denom
+// order.
+func (k BaseViewKeeper) IterateDemurrageSchedules(ctx context.Context, cb func(s types.DemurrageSchedule) (stop bool)) {
+	err := k.DemurrageSchedules.Walk(ctx, nil, func(_ string, s types.DemurrageSchedule) bool {
+		return cb(s)
+	})
+	if err != nil {
+		panic(err)
+	}
+}
+
+// IterateDemurrageAccountEpochs iterates over the epochs the decay of the
+// balances of the demurrage denominations was last settled at, in address then
+// denom order.
+func (k BaseViewKeeper) IterateDemurrageAccountEpochs(ctx context.Context, cb func(addr sdk.AccAddress, denom string, epoch uint64) (stop bool)) {
+	err := k.DemurrageAccountEpochs.Walk(ctx, nil, func(key collections.Pair[sdk.AccAddress, string], epoch uint64) bool {
+		return cb(key.K1(), key.K2(), epoch)
+	})
+	if err != nil {
+		panic(err)
+	}
+}
+
+// demurrageAccountEpoch returns the epoch the decay of the balance of an
+// account was last settled at. The balances never settled decay from the
+// creation of the schedule, i.e. from epoch zero.
+func (k BaseViewKeeper) demurrageAccountEpoch(ctx context.Context, addr sdk.AccAddress, denom string) uint64 {
+	epoch, err := k.DemurrageAccountEpochs.Get(ctx, collections.Join(addr, denom))
+	if err != nil {
+		if !errors.Is(err, collections.ErrNotFound) {
+			panic(err)
+		}
+		return 0
+	}
+
+	return epoch
+}
+
+// decayedBalance returns the stored balance of an account net of its pending
+// decay, if its denomination has a demurrage schedule and the account is not
+// exempt from it.
+func (k BaseViewKeeper) decayedBalance(ctx context.Context, addr sdk.AccAddress, balance sdk.Coin) sdk.Coin {
+	if !balance.IsPositive() || !k.hasDemurrageSchedule(ctx, balance.Denom) {
+		return balance
+	}
+
+	s, found := k.GetDemurrageSchedule(ctx, balance.Denom)
+	if !found || k.demurrageExempt(ctx, addr) {
+		return balance
+	}
+
+	return sdk.NewCoin(balance.Denom, s.Decay(balance.Amount, k.demurrageAccountEpoch(ctx, addr, balance.Denom)))
+}
+
+// settleDemurrage settles the pending decay of the balance of an account,
+// burning the decayed coins, and returns the settled balance. The balances of
+// the denominations without demurrage schedule, and of the accounts exempt
+// from it, are returned unchanged. It is called before any change of a
+// balance, so that the decay of the previous epochs only applies to the coins
+// held during these epochs.
+func (k BaseSendKeeper) settleDemurrage(ctx context.Context, addr sdk.AccAddress, denom string) (sdk.Coin, error) {
+	balance := k.storedBalance(ctx, addr, denom)
+	if !k.hasDemurrageSchedule(ctx, denom) {
+		return balance, nil
+	}
+
+	s, found := k.GetDemurrageSchedule(ctx, denom)
+	if !found || k.demurrageExempt(ctx, addr) {
+		return balance, nil
+	}
+
+	from := k.demurrageAccountEpoch(ctx, addr, denom)
+	if from == s.Epoch {
+		return balance, nil
+	}
+
+	settled := sdk.NewCoin(denom, s.Decay(balance.Amount, from))
+	if burnt := balance.Sub(settled); burnt.IsPositive() {
+		if err := k.burnDecayedCoins(ctx, addr, settled, burnt, s.Epoch); err != nil {
+			return sdk.Coin{}, err
+		}
+	}
+
+	// the epoch is set once the balance is, as emptying a balance removes its
+	// epoch
+	if err := k.DemurrageAccountEpochs.Set(ctx, collections.Join(addr, denom), s.Epoch); err != nil {
+		return sdk.Coin{}, err
+	}
+
+	return settled, nil
+}
+
+// burnDecayedCoins burns the decayed coins of a balance, setting the balance
+// to the settled one.
+func (k BaseSendKeeper) burnDecayedCoins(ctx context.Context, addr sdk.AccAddress, settled, burnt sdk.Coin, epoch uint64) error {
+	if err := k.setBalance(ctx, addr, settled); err != nil {
+		return err
+	}
+
+	supply, err := k.Supply.Get(ctx, burnt.Denom)
+	if err != nil {
+		return err
+	}
+	// Bank invariants and IBC requires to remove zero coins.
+	if supply = supply.Sub(burnt.Amount); supply.IsZero() {
+		err = k.Supply.Remove(ctx, burnt.Denom)
+	} else {
+		err = k.Supply.Set(ctx, burnt.Denom, supply)
+	}
+	if err != nil {
+		return err
+	}
+
+	sdk.UnwrapSDKContext(ctx).EventManager().EmitEvents(sdk.Events{
+		types.NewCoinBurnEvent(addr, sdk.NewCoins(burnt)),
+		sdk.NewEvent(
+			types.EventTypeDemurrage,
+			sdk.NewAttribute(types.AttributeKeyAccount, addr.String()),
+			sdk.NewAttribute(sdk.AttributeKeyAmount, burnt.String()),
+			sdk.NewAttribute(types.AttributeKeyEpoch, strconv.FormatUint(epoch, 10)),
+		),
+	})
+
+	return nil
+}
+
+// SetDemurrageSchedule creates the demurrage schedule of a denom, or changes
+// the rate and the epoch length of its existing schedule. A rate change
+// applies from the current epoch of the schedule.
+func (k BaseKeeper) SetDemurrageSchedule(ctx context.Context, denom string, rate math.LegacyDec, epochLength int64) (types.DemurrageSchedule, error) {
+	s, found := k.GetDemurrageSchedule(ctx, denom)
+	if found {
+		s.SetRate(rate)
+		s.EpochLength = epochLength
+	} else {
+		s = types.NewDemurrageSchedule(denom, rate, epochLength)
+	}
+
+	if err := s.Validate(); err != nil {
+		return types.DemurrageSchedule{}, types.ErrInvalidDemurrage.Wrap(err.Error())
+	}
+
+	if err := k.BaseViewKeeper.DemurrageSchedules.Set(ctx, denom, s); err != nil {
+		return types.DemurrageSchedule{}, err
+	}
+
+	return s, nil
+}
+
+// AdvanceDemurrageEpochs ends the current epoch of the demurrage schedules
+// whose epoch ends at the current height. Only the schedules are updated: the
+// decay of the balances is settled lazily. It is called at the end of each
+// block.
+func (k BaseKeeper) AdvanceDemurrageEpochs(ctx context.Context) error {
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	height := sdkCtx.BlockHeight()
+
+	var due []types.DemurrageSchedule
+	k.IterateDemurrageSchedules(ctx, func(s types.DemurrageSchedule) bool {
+		if height%s.EpochLength == 0 {
+			due = append(due, s)
+		}
+		return false
+	})
+
+	// the schedules are advanced once collected, as they cannot be modified
+	// while they are iterated
+	for _, s := range due {
+		s.Epoch++
+		if err := k.BaseViewKeeper.DemurrageSchedules.Set(ctx, s.Denom, s); err != nil {
+			return err
+		}
+
+		sdkCtx.EventManager().EmitEvent(
+			sdk.NewEvent(
+				types.EventTypeDemurrageEpoch,
+				sdk.NewAttribute(types.AttributeKeyDenom, s.Denom),
+				sdk.NewAttribute(types.AttributeKeyEpoch, strconv.FormatUint(s.Epoch, 10)),
+				sdk.NewAttribute(types.AttributeKeyRate, s.Rate().String()),
+			),
+		)
+	}
+
+	return nil
+}
@@ -0,0 +1,115 @@
+package keeper_test
+
+import (
+	"context"
+
+	"github.com/golang/mock/gomock"
+
+	"cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	banktestutil "github.com/cosmos/cosmos-sdk/x/bank/testutil"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+)
+
+func (suite *KeeperTestSuite) TestDemurrage() {
+	ctx := sdk.UnwrapSDKContext(suite.ctx).WithBlockHeight(1)
+	require := suite.Require()
+
+	suite.authKeeper.EXPECT().GetAccount(gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, addr sdk.AccAddress) sdk.AccountI {
+		if addr.Equals(holderAcc.GetAddress()) {
+			return holderAcc
+		}
+		return authtypes.NewBaseAccountWithAddress(addr)
+	}).AnyTimes()
+	suite.authKeeper.EXPECT().HasAccount(gomock.Any(), gomock.Any()).Return(true).AnyTimes()
+
+	suite.mockFundAccount(accAddrs[0])
+	require.NoError(banktestutil.FundAccount(ctx, suite.bankKeeper, accAddrs[0], sdk.NewCoins(newFooCoin(100), newBarCoin(100))))
+
+	authority := govAcc.GetAddress().String()
+	setSchedule := func(rate string, epochLength int64) error {
+		_, err := suite.msgServer.SetDemurrageSchedule(ctx, banktypes.NewMsgSetDemurrageSchedule(authority, fooDenom, math.LegacyMustNewDecFromStr(rate), epochLength))
+		return err
+	}
+	balance := func(addr sdk.AccAddress) math.Int {
+		return suite.bankKeeper.GetBalance(ctx, addr, fooDenom).Amount
+	}
+
+	_, err := suite.msgServer.SetDemurrageSchedule(ctx, banktypes.NewMsgSetDemurrageSchedule("invalid", fooDenom, math.LegacyNewDecWithPrec(5, 1), 10))
+	require.ErrorContains(err, "invalid authority")
+	require.ErrorIs(setSchedule("1.5", 10), banktypes.ErrInvalidDemurrage)
+	require.ErrorIs(setSchedule("0.5", 0), banktypes.ErrInvalidDemurrage)
+
+	// half of the balances decay at the end of each epoch of 10 blocks
+	require.NoError(setSchedule("0.5", 10))
+	require.NoError(suite.bankKeeper.AdvanceDemurrageEpochs(ctx.WithBlockHeight(9)))
+	require.Equal(math.NewInt(100), balance(accAddrs[0]))
+
+	require.NoError(suite.bankKeeper.AdvanceDemurrageEpochs(ctx.WithBlockHeight(10)))
+	require.Equal(math.NewInt(50), balance(accAddrs[0]))
+	require.Equal(sdk.NewCoins(newFooCoin(50), newBarCoin(100)), suite.bankKeeper.GetAllBalances(ctx, accAddrs[0]))
+
+	// the decay is only settled, and burnt, once the balance changes
+	supply := suite.bankKeeper.GetSupply(ctx, fooDenom)
+	require.Equal(math.NewInt(100), supply.Amount)
+
+	ctx = ctx.WithEventManager(sdk.NewEventManager())
+	require.NoError(suite.bankKeeper.SendCoins(ctx, accAddrs[0], accAddrs[1], sdk.NewCoins(newFooCoin(10))))
+	require.Equal(math.NewInt(40), balance(accAddrs[0]))
+	require.Equal(math.NewInt(10), balance(accAddrs[1]))
+	require.Equal(math.NewInt(50), suite.bankKeeper.GetSupply(ctx, fooDenom).Amount)
+
+	var settled bool
+	for _, event := range ctx.EventManager().Events() {
+		if event.Type == banktypes.EventTypeDemurrage {
+			settled = true
+			require.Equal(accAddrs[0].String(), string(event.Attributes[0].Value))
+			require.Equal(newFooCoin(50).String(), string(event.Attributes[1].Value))
+		}
+	}
+	require.True(settled)
+
+	// a rate change applies from the current epoch, and a rate of 1 expires
+	// the balances
+	require.NoError(setSchedule("1", 10))
+	require.NoError(suite.bankKeeper.AdvanceDemurrageEpochs(ctx.WithBlockHeight(20)))
+	require.True(balance(accAddrs[0]).IsZero())
+	require.True(balance(accAddrs[1]).IsZero())
+	require.Equal(sdk.NewCoins(newBarCoin(100)), suite.bankKeeper.GetAllBalances(ctx, accAddrs[0]))
+
+	res, err := suite.queryClient.DemurrageSchedules(ctx, &banktypes.QueryDemurrageSchedulesRequest{Denoms: []string{fooDenom, barDenom}})
+	require.NoError(err)
+	require.Len(res.Schedules, 1)
+	require.Equal(uint64(2), res.Schedules[0].Epoch)
+	require.Len(res.Schedules[0].Segments, 2)
+
+	// the coins received after an epoch don't decay during that epoch
+	suite.mockFundAccount(accAddrs[2])
+	require.NoError(banktestutil.FundAccount(ctx, suite.bankKeeper, accAddrs[2], sdk.NewCoins(newFooCoin(8))))
+	require.Equal(math.NewInt(8), balance(accAddrs[2]))
+
+	genState := suite.bankKeeper.ExportGenesis(ctx)
+	require.NoError(genState.Validate())
+	require.Len(genState.DemurrageSchedules, 1)
+	require.Len(genState.DemurrageAccountEpochs, 3)
+
+	// an emptied balance has no decay to settle anymore
+	require.NoError(suite.bankKeeper.SendCoins(ctx, accAddrs[2], accAddrs[0], sdk.NewCoins(newFooCoin(8))))
+	require.Equal(math.NewInt(8), balance(accAddrs[0]))
+	genState = suite.bankKeeper.ExportGenesis(ctx)
+	require.Len(genState.DemurrageAccountEpochs, 2)
+
+	// the module accounts and the blocked addresses are exempt from the
+	// demurrage
+	require.NoError(suite.bankKeeper.SendCoins(ctx, accAddrs[0], holderAcc.GetAddress(), sdk.NewCoins(newFooCoin(4))))
+	require.NoError(suite.bankKeeper.SendCoins(ctx, accAddrs[0], accAddrs[4], sdk.NewCoins(newFooCoin(4))))
+	require.NoError(suite.bankKeeper.AdvanceDemurrageEpochs(ctx.WithBlockHeight(30)))
+	require.Equal(math.NewInt(4), balance(holderAcc.GetAddress()))
+	require.Equal(math.NewInt(4), balance(accAddrs[4]))
+
+	require.NoError(suite.bankKeeper.SendCoins(ctx, holderAcc.GetAddress(), accAddrs[1], sdk.NewCoins(newFooCoin(1))))
+	require.Equal(math.NewInt(3), balance(holderAcc.GetAddress()))
+	require.Equal(math.NewInt(8), suite.bankKeeper.GetSupply(ctx, fooDenom).Amount)
+}
@@ -0,0 +1,212 @@
+package keeper
+
+import (
+	"context"
+	"errors"
+
+	"cosmossdk.io/collections"
+	"cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	"github.com/cosmos/cosmos-sdk/x/bank/types"
+)
+
+// communityPoolKeeper holds the keeper funding the community pool with the
+// swept dust, so that it can be set on a keeper passed by value.
+type communityPoolKeeper struct {
+	keeper types.CommunityPoolKeeper
+}
+
+// SetCommunityPoolKeeper sets the keeper funding the community pool with the
+// swept dust balances. It panics if the keeper is already set.
+func (k BaseKeeper) SetCommunityPoolKeeper(cpk types.CommunityPoolKeeper) {
+	if k.communityPool.keeper != nil {
+		panic("cannot set community pool keeper twice")
+	}
+
+	k.communityPool.keeper = cpk
+}
+
+// IsDustSweepOptedIn returns whether the account opted in the sweeping of its
+// dust balances.
+func (k BaseViewKeeper) IsDustSweepOptedIn(ctx context.Context, addr sdk.AccAddress) bool {
+	optedIn, err := k.DustSweepOptIns.Has(ctx, addr)
+	if err != nil {
+		panic(err)
+	}
+
+	return optedIn
+}
+
+// GetAllDustSweepOptIns returns the addresses of the accounts which opted in
+// the sweeping of their dust balances.
+func (k BaseViewKeeper) GetAllDustSweepOptIns(ctx context.Context) []sdk.AccAddress {
+	var addrs []sdk.AccAddress
+	err := k.DustSweepOptIns.Walk(ctx, nil, func(addr sdk.AccAddress) (stop bool) {
+		addrs = append(addrs, addr)
+		return false
+	})
+	if err != nil {
+		panic(err)
+	}
+
+	return addrs
+}
+
+// SetDustSweepOptIn opts the account in, or out of, the sweeping of its dust
+// balances when the sweeping isn't chain-wide.
+func (k BaseKeeper) SetDustSweepOptIn(ctx context.Context, addr sdk.AccAddress, optIn bool) error {
+	if optIn {
+		return k.DustSweepOptIns.Set(ctx, addr)
+	}
+
+	return k.DustSweepOptIns.Remove(ctx, addr)
+}
+
+// SweepDust sweeps the dust balances at the end of each dust sweep epoch. The
+// balances of all the accounts are swept if the sweeping is chain-wide, and
+// the balances of the accounts which opted in otherwise. At most
+// MaxAccountsPerEpoch accounts are visited per sweep, the next sweep resuming
+// after the last visited account. It is called at the end of each block.
+func (k BaseKeeper) SweepDust(ctx context.Context) error {
+	params := k.GetParams(ctx).DustSweep
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	if !params.IsEnabled() || sdkCtx.BlockHeight()%params.EpochLength != 0 {
+		return nil
+	}
+
+	if !params.Burn && k.communityPool.keeper == nil {
+		k.logger.Error("cannot sweep the dust balances without a community pool keeper")
+		return nil
+	}
+	if params.Burn {
+		if macc := k.ak.GetModuleAccount(ctx, types.ModuleName); macc == nil || !macc.HasPermission(authtypes.Burner) {
+			k.logger.Error("cannot burn the dust balances without a bank module account with burner permissions")
+			return nil
+		}
+	}
+
+	start, err := k.DustSweepCursor.Get(ctx)
+	if err != nil && !errors.Is(err, collections.ErrNotFound) {
+		return err
+	}
+
+	addrs, next, err := k.dustSweepAccounts(ctx, params, start)
+	if err != nil {
+		return err
+	}
+
+	// the accounts are visited once collected, as the balances cannot be
+	// modified while they are iterated
+	for _, addr := range addrs {
+		k.sweepAccountDust(sdkCtx, params, addr)
+	}
+
+	if next == nil {
+		return k.DustSweepCursor.Remove(ctx)
+	}
+
+	return k.DustSweepCursor.Set(ctx, next)
+}
+
+// dustSweepAccounts returns the accounts to visit, starting at the given
+// address, and the address the next sweep resumes at, if any.
+func (k BaseKeeper) dustSweepAccounts(ctx context.Context, params types.DustSweepParams, start sdk.AccAddress) (addrs []sdk.AccAddress, next sdk.AccAddress, err error) {
+	visit := func(addr sdk.AccAddress) (stop bool) {
+		if params.MaxAccountsPerEpoch > 0 && uint64(len(addrs)) == params.MaxAccountsPerEpoch {
+			next = addr
+			return true
+		}
+		addrs = append(addrs, addr)
+		return false
+	}
+
+	if !params.ChainWide {
+		var ranger collections.Ranger[sdk.AccAddress]
+		if start != nil {
+			ranger = new(collections.Range[sdk.AccAddress]).StartInclusive(start)
+		}
+		err = k.DustSweepOptIns.Walk(ctx, ranger, visit)
+		return addrs, next, err
+	}
+
+	var ranger collections.Ranger[collections.Pair[sdk.AccAddress, string]]
+	if start != nil {
+		ranger = new(collections.Range[collections.Pair[sdk.AccAddress, string]]).StartInclusive(collections.PairPrefix[sdk.AccAddress, string](start))
+	}
+	var last sdk.AccAddress
+	err = k.Balances.Walk(ctx, ranger, func(key collections.Pair[sdk.AccAddress, string], _ math.Int) (stop bool) {
+		if last.Equals(key.K1()) {
+			return false
+		}
+		last = key.K1()
+		return visit(last)
+	})
+
+	return addrs, next, err
+}
+
+// sweepAccountDust sweeps the dust balances of an account, unless it is exempt
+// or a module account. A balance is only swept if it is fully spendable, and
+// the balances of an account are left unchanged if they cannot be swept.
+func (k BaseKeeper) sweepAccountDust(ctx sdk.Context, params types.DustSweepParams, addr sdk.AccAddress) {
+	if params.IsExempt(addr) || k.BlockedAddr(addr) {
+		return
+	}
+	if _, ok := k.ak.GetAccount(ctx, addr).(sdk.ModuleAccountI); ok {
+		return
+	}
+
+	var balances sdk.Coins
+	for _, threshold := range params.Thresholds {
+		balance := k.GetBalance(ctx, addr, threshold.Denom)
+		if balance.IsZero() || !k.SpendableCoin(ctx, addr, threshold.Denom).IsEqual(balance) {
+			continue
+		}
+		balances = append(balances, balance)
+	}
+
+	dust := params.Dust(balances)
+	if dust.Empty() {
+		return
+	}
+
+	destination := types.DustSweepDestinationCommunityPool
+	if params.Burn {
+		destination = types.DustSweepDestinationBurn
+	}
+
+	cacheCtx, write := ctx.CacheContext()
+	var err error
+	if params.Burn {
+		err = k.burnDust(cacheCtx, addr, dust)
+	} else {
+		err = k.communityPool.keeper.FundCommunityPool(cacheCtx, dust, addr)
+	}
+	if err != nil {
+		k.logger.Error("failed to sweep dust balances", "account", addr.String(), "amount", dust.String(), "err", err)
+		return
+	}
+	write()
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeDustSweep,
+			sdk.NewAttribute(types.AttributeKeyAccount, addr.String()),
+			sdk.NewAttribute(sdk.AttributeKeyAmount, dust.String()),
+			sdk.NewAttribute(types.AttributeKeyDestination, destination),
+		),
+	)
+}
+
+// burnDust burns the dust balances of an account, sending them to the bank
+// module account to burn them, so that the burn permissions of the denoms are
+// checked.
+func (k BaseKeeper) burnDust(ctx context.Context, addr sdk.AccAddress, dust sdk.Coins) error {
+	if err := k.SendCoinsFromAccountToModule(ctx, addr, types.ModuleName, dust); err != nil {
+		return err
+	}
+
+	return k.BurnCoins(ctx, types.ModuleName, dust)
+}
@@ -0,0 +1,120 @@
+package keeper_test
+
+import (
+	"context"
+
+	"github.com/golang/mock/gomock"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	banktestutil "github.com/cosmos/cosmos-sdk/x/bank/testutil"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+)
+
+func (suite *KeeperTestSuite) TestSweepDust() {
+	ctx := sdk.UnwrapSDKContext(suite.ctx)
+	require := suite.Require()
+
+	balances := []sdk.Coins{
+		sdk.NewCoins(newFooCoin(5), newBarCoin(100)),
+		sdk.NewCoins(newFooCoin(5)),
+		sdk.NewCoins(newFooCoin(5)),
+		sdk.NewCoins(newFooCoin(50)),
+	}
+	for i, balance := range balances {
+		suite.mockFundAccount(accAddrs[i])
+		require.NoError(banktestutil.FundAccount(ctx, suite.bankKeeper, accAddrs[i], balance))
+	}
+	suite.authKeeper.EXPECT().GetAccount(gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, addr sdk.AccAddress) sdk.AccountI {
+		return authtypes.NewBaseAccountWithAddress(addr)
+	}).AnyTimes()
+
+	params := banktypes.DefaultParams()
+	params.DustSweep = banktypes.DustSweepParams{
+		Thresholds:      sdk.NewCoins(newFooCoin(10), newBarCoin(10)),
+		EpochLength:     10,
+		Burn:            true,
+		ExemptAddresses: []string{accAddrs[2].String()},
+	}
+	require.NoError(suite.bankKeeper.SetParams(ctx, params))
+
+	for _, addr := range []sdk.AccAddress{accAddrs[0], accAddrs[2]} {
+		_, err := suite.msgServer.SetDustSweepOptIn(ctx, banktypes.NewMsgSetDustSweepOptIn(addr, true))
+		require.NoError(err)
+	}
+	require.True(suite.bankKeeper.IsDustSweepOptedIn(ctx, accAddrs[0]))
+	require.False(suite.bankKeeper.IsDustSweepOptedIn(ctx, accAddrs[1]))
+
+	// the dust balances are only swept at the end of an epoch
+	require.NoError(suite.bankKeeper.SweepDust(ctx.WithBlockHeight(9)))
+	require.Equal(balances[0], suite.bankKeeper.GetAllBalances(ctx, accAddrs[0]))
+
+	// the dust balances aren't burnt without a bank module account with
+	// burner permissions
+	bankAcc := authtypes.NewEmptyModuleAccount(banktypes.ModuleName, authtypes.Burner)
+	suite.authKeeper.EXPECT().GetModuleAccount(gomock.Any(), banktypes.ModuleName).Return(authtypes.NewEmptyModuleAccount(banktypes.ModuleName))
+	suite.authKeeper.EXPECT().GetModuleAccount(gomock.Any(), banktypes.ModuleName).Return(bankAcc).AnyTimes()
+	suite.authKeeper.EXPECT().HasAccount(gomock.Any(), bankAcc.GetAddress()).Return(true).AnyTimes()
+	require.NoError(suite.bankKeeper.SweepDust(ctx.WithBlockHeight(10)))
+	require.Equal(balances[0], suite.bankKeeper.GetAllBalances(ctx, accAddrs[0]))
+
+	// nor if the bank module isn't allowed to burn their denomination
+	suite.bankKeeper.SetMintBurnPermissions(ctx, banktypes.NewMintBurnPermissions(fooDenom, nil, []string{authtypes.Burner}))
+	require.NoError(suite.bankKeeper.SweepDust(ctx.WithBlockHeight(10)))
+	require.Equal(balances[0], suite.bankKeeper.GetAllBalances(ctx, accAddrs[0]))
+	suite.bankKeeper.DeleteMintBurnPermissions(ctx, fooDenom)
+
+	// only the accounts which opted in, and aren't exempt, are swept
+	supply := suite.bankKeeper.GetSupply(ctx, fooDenom)
+	ctx = ctx.WithBlockHeight(10).WithEventManager(sdk.NewEventManager())
+	require.NoError(suite.bankKeeper.SweepDust(ctx))
+	require.Equal(sdk.NewCoins(newBarCoin(100)), suite.bankKeeper.GetAllBalances(ctx, accAddrs[0]))
+	require.Equal(balances[1], suite.bankKeeper.GetAllBalances(ctx, accAddrs[1]))
+	require.Equal(balances[2], suite.bankKeeper.GetAllBalances(ctx, accAddrs[2]))
+	require.Equal(supply.SubAmount(newFooCoin(5).Amount), suite.bankKeeper.GetSupply(ctx, fooDenom))
+
+	var swept bool
+	for _, event := range ctx.EventManager().Events() {
+		if event.Type == banktypes.EventTypeDustSweep {
+			swept = true
+			require.Equal(accAddrs[0].String(), string(event.Attributes[0].Value))
+			require.Equal(banktypes.DustSweepDestinationBurn, string(event.Attributes[2].Value))
+		}
+	}
+	require.True(swept)
+
+	// an account opting out is no longer swept
+	_, err := suite.msgServer.SetDustSweepOptIn(ctx, banktypes.NewMsgSetDustSweepOptIn(accAddrs[2], false))
+	require.NoError(err)
+	require.Equal([]sdk.AccAddress{accAddrs[0]}, suite.bankKeeper.GetAllDustSweepOptIns(ctx))
+
+	// the chain-wide sweeps to the community pool visit at most
+	// MaxAccountsPerEpoch accounts, resuming after the last visited account
+	params.DustSweep = banktypes.DustSweepParams{
+		Thresholds:          sdk.NewCoins(newFooCoin(10)),
+		EpochLength:         10,
+		ChainWide:           true,
+		MaxAccountsPerEpoch: 1,
+	}
+	require.NoError(suite.bankKeeper.SetParams(ctx, params))
+
+	// the sweeps are skipped without a community pool keeper
+	require.NoError(suite.bankKeeper.SweepDust(ctx.WithBlockHeight(20)))
+	_, err = suite.bankKeeper.DustSweepCursor.Get(ctx)
+	require.Error(err)
+
+	communityPool := banktestutil.NewMockCommunityPoolKeeper(gomock.NewController(suite.T()))
+	suite.bankKeeper.SetCommunityPoolKeeper(communityPool)
+	require.Panics(func() { suite.bankKeeper.SetCommunityPoolKeeper(communityPool) })
+
+	require.NoError(suite.bankKeeper.SweepDust(ctx.WithBlockHeight(20)))
+	cursor, err := suite.bankKeeper.DustSweepCursor.Get(ctx)
+	require.NoError(err)
+	require.Equal([]byte(accAddrs[1]), cursor)
+
+	communityPool.EXPECT().FundCommunityPool(gomock.Any(), sdk.NewCoins(newFooCoin(5)), accAddrs[1]).Return(nil)
+	require.NoError(suite.bankKeeper.SweepDust(ctx.WithBlockHeight(30)))
+	cursor, err = suite.bankKeeper.DustSweepCursor.Get(ctx)
+	require.NoError(err)
+	require.Equal([]byte(accAddrs[2]), cursor)
+}
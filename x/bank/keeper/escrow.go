@@ -0,0 +1,102 @@
+package keeper
+
+import (
+	"context"
+	"errors"
+
+	"cosmossdk.io/collections"
+	errorsmod "cosmossdk.io/errors"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/bank/types"
+)
+
+// GetModuleEscrow returns the amount of coins escrowed by the account of a
+// module for a purpose.
+func (k BaseViewKeeper) GetModuleEscrow(ctx context.Context, moduleName, purpose string) sdk.Coins {
+	escrow, err := k.ModuleEscrows.Get(ctx, collections.Join(moduleName, purpose))
+	if err != nil {
+		if !errors.Is(err, collections.ErrNotFound) {
+			panic(err)
+		}
+		return sdk.NewCoins()
+	}
+
+	return escrow.Amount
+}
+
+// GetModuleEscrows returns the escrows of the account of a module, in purpose
+// order.
+func (k BaseViewKeeper) GetModuleEscrows(ctx context.Context, moduleName string) []types.ModuleEscrow {
+	var escrows []types.ModuleEscrow
+	err := k.ModuleEscrows.Walk(ctx, collections.NewPrefixedPairRange[string, string](moduleName), func(_ collections.Pair[string, string], escrow types.ModuleEscrow) (stop bool) {
+		escrows = append(escrows, escrow)
+		return false
+	})
+	if err != nil {
+		panic(err)
+	}
+
+	return escrows
+}
+
+// EscrowedCoins returns the total amount of coins escrowed by the account of a
+// module, over all its escrows.
+func (k BaseViewKeeper) EscrowedCoins(ctx context.Context, moduleName string) sdk.Coins {
+	escrowed := sdk.NewCoins()
+	for _, escrow := range k.GetModuleEscrows(ctx, moduleName) {
+		escrowed = escrowed.Add(escrow.Amount...)
+	}
+
+	return escrowed
+}
+
+// AddModuleEscrow adds coins to the amount escrowed by the account of a module
+// for a purpose. It is called by the modules when coins are deposited to their
+// account for the purpose, and only keeps track of the escrowed amounts: no
+// coins are moved.
+func (k BaseKeeper) AddModuleEscrow(ctx context.Context, moduleName, purpose string, amt sdk.Coins) error {
+	if !amt.IsValid() {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidCoins, amt.String())
+	}
+
+	return k.SetModuleEscrow(ctx, moduleName, purpose, k.GetModuleEscrow(ctx, moduleName, purpose).Add(amt...))
+}
+
+// SubModuleEscrow subtracts coins from the amount escrowed by the account of a
+// module for a purpose. It is called by the modules when coins are withdrawn
+// from their account for the purpose, and returns an error if more coins are
+// withdrawn than escrowed.
+func (k BaseKeeper) SubModuleEscrow(ctx context.Context, moduleName, purpose string, amt sdk.Coins) error {
+	if !amt.IsValid() {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidCoins, amt.String())
+	}
+
+	escrowed := k.GetModuleEscrow(ctx, moduleName, purpose)
+	remaining, hasNeg := escrowed.SafeSub(amt...)
+	if hasNeg {
+		return errorsmod.Wrapf(types.ErrInsufficientEscrow, "%s is smaller than %s escrowed by %s for %s", escrowed, amt, moduleName, purpose)
+	}
+
+	return k.SetModuleEscrow(ctx, moduleName, purpose, remaining)
+}
+
+// SetModuleEscrow sets the amount of coins escrowed by the account of a module
+// for a purpose, removing the escrow if the amount is zero. It is used by the
+// modules tracking an amount they recompute, e.g. the tokens of a validator.
+func (k BaseKeeper) SetModuleEscrow(ctx context.Context, moduleName, purpose string, amt sdk.Coins) error {
+	if k.ak.GetModuleAddress(moduleName) == nil {
+		return errorsmod.Wrapf(sdkerrors.ErrUnknownAddress, "module account %s does not exist", moduleName)
+	}
+	if !amt.IsValid() {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidCoins, amt.String())
+	}
+
+	key := collections.Join(moduleName, purpose)
+	if amt.IsZero() {
+		return k.BaseViewKeeper.ModuleEscrows.Remove(ctx, key)
+	}
+
+	return k.BaseViewKeeper.ModuleEscrows.Set(ctx, key, types.ModuleEscrow{Purpose: purpose, Amount: amt})
+}
@@ -0,0 +1,53 @@
+package keeper_test
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	banktestutil "github.com/cosmos/cosmos-sdk/x/bank/testutil"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+)
+
+func (suite *KeeperTestSuite) TestModuleEscrows() {
+	ctx := sdk.UnwrapSDKContext(suite.ctx)
+	require := suite.Require()
+
+	suite.authKeeper.EXPECT().GetModuleAddress(holder).Return(holderAcc.GetAddress()).AnyTimes()
+	suite.authKeeper.EXPECT().GetModuleAddress(authtypes.Burner).Return(nil).AnyTimes()
+
+	suite.mockFundAccount(holderAcc.GetAddress())
+	require.NoError(banktestutil.FundAccount(ctx, suite.bankKeeper, holderAcc.GetAddress(), sdk.NewCoins(newFooCoin(100), newBarCoin(10))))
+
+	// the escrows are tracked by purpose
+	require.NoError(suite.bankKeeper.AddModuleEscrow(ctx, holder, "proposal/1", sdk.NewCoins(newFooCoin(30))))
+	require.NoError(suite.bankKeeper.AddModuleEscrow(ctx, holder, "proposal/1", sdk.NewCoins(newFooCoin(20))))
+	require.NoError(suite.bankKeeper.AddModuleEscrow(ctx, holder, "proposal/2", sdk.NewCoins(newFooCoin(40), newBarCoin(20))))
+	require.Equal(sdk.NewCoins(newFooCoin(50)), suite.bankKeeper.GetModuleEscrow(ctx, holder, "proposal/1"))
+	require.Equal(sdk.NewCoins(newFooCoin(90), newBarCoin(20)), suite.bankKeeper.EscrowedCoins(ctx, holder))
+
+	// an escrow cannot be withdrawn beyond its amount, and is removed once
+	// fully withdrawn
+	err := suite.bankKeeper.SubModuleEscrow(ctx, holder, "proposal/1", sdk.NewCoins(newFooCoin(60)))
+	require.ErrorIs(err, banktypes.ErrInsufficientEscrow)
+	require.NoError(suite.bankKeeper.SubModuleEscrow(ctx, holder, "proposal/1", sdk.NewCoins(newFooCoin(50))))
+	require.True(suite.bankKeeper.GetModuleEscrow(ctx, holder, "proposal/1").IsZero())
+	require.Len(suite.bankKeeper.GetModuleEscrows(ctx, holder), 1)
+
+	require.NoError(suite.bankKeeper.SetModuleEscrow(ctx, holder, "validator/1", sdk.NewCoins(newFooCoin(10))))
+	require.Error(suite.bankKeeper.SetModuleEscrow(ctx, authtypes.Burner, "validator/1", sdk.NewCoins(newFooCoin(10))))
+
+	// the query reconciles the balance of the module account with its escrows
+	queryClient := suite.mockQueryClient(ctx)
+	res, err := queryClient.ModuleEscrows(ctx, &banktypes.QueryModuleEscrowsRequest{ModuleName: holder})
+	require.NoError(err)
+	require.Equal([]banktypes.ModuleEscrow{
+		{Purpose: "proposal/2", Amount: sdk.NewCoins(newFooCoin(40), newBarCoin(20))},
+		{Purpose: "validator/1", Amount: sdk.NewCoins(newFooCoin(10))},
+	}, res.Escrows)
+	require.Equal(sdk.NewCoins(newFooCoin(100), newBarCoin(10)), res.Balance)
+	require.Equal(sdk.NewCoins(newFooCoin(50), newBarCoin(20)), res.Tracked)
+	require.Equal(sdk.NewCoins(newFooCoin(50)), res.Untracked)
+	require.Equal(sdk.NewCoins(newBarCoin(10)), res.Deficit)
+
+	_, err = queryClient.ModuleEscrows(ctx, &banktypes.QueryModuleEscrowsRequest{ModuleName: authtypes.Burner})
+	require.Error(err)
+}
@@ -5,6 +5,7 @@ import (
 	"fmt"
 
 	"cosmossdk.io/collections"
+	"cosmossdk.io/math"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/query"
@@ -49,6 +50,101 @@ func (k BaseKeeper) InitGenesis(ctx context.Context, genState *types.GenesisStat
 	for _, meta := range genState.DenomMetadata {
 		k.SetDenomMetaData(ctx, meta)
 	}
+
+	for _, hold := range genState.Holds {
+		addr := sdk.MustAccAddressFromBech32(hold.Address)
+		if err := k.BalanceHolds.Set(ctx, collections.Join(addr, hold.Holder), hold); err != nil {
+			panic(err)
+		}
+	}
+
+	for _, gs := range genState.BalanceSnapshots {
+		k.initBalanceSnapshot(ctx, gs)
+	}
+
+	for _, p := range genState.MintBurnPermissions {
+		k.SetMintBurnPermissions(ctx, p)
+	}
+
+	for _, addr := range genState.DustSweepOptIns {
+		if err := k.SetDustSweepOptIn(ctx, sdk.MustAccAddressFromBech32(addr), true); err != nil {
+			panic(err)
+		}
+	}
+
+	// the coins of the time-locked transfers are held by the holds of the
+	// genesis state
+	for _, t := range genState.TimelockedTransfers {
+		k.initTimelockedTransfer(ctx, t)
+	}
+
+	for _, l := range genState.SpendingLimits {
+		if err := k.SpendingLimits.Set(ctx, sdk.MustAccAddressFromBech32(l.Address), l); err != nil {
+			panic(err)
+		}
+	}
+
+	for _, s := range genState.DemurrageSchedules {
+		if err := k.BaseViewKeeper.DemurrageSchedules.Set(ctx, s.Denom, s); err != nil {
+			panic(err)
+		}
+	}
+
+	for _, e := range genState.DemurrageAccountEpochs {
+		if err := k.DemurrageAccountEpochs.Set(ctx, collections.Join(sdk.MustAccAddressFromBech32(e.Address), e.Denom), e.Epoch); err != nil {
+			panic(err)
+		}
+	}
+}
+
+// initTimelockedTransfer initializes a time-locked transfer from the genesis
+// state.
+func (k BaseKeeper) initTimelockedTransfer(ctx context.Context, t types.TimelockedTransfer) {
+	if err := k.setTimelockedTransfer(ctx, t); err != nil {
+		panic(err)
+	}
+
+	seq, err := k.TimelockedTransferSequence.Peek(ctx)
+	if err != nil {
+		panic(err)
+	}
+	if t.Id >= seq {
+		if err := k.TimelockedTransferSequence.Set(ctx, t.Id+1); err != nil {
+			panic(err)
+		}
+	}
+}
+
+// initBalanceSnapshot initializes a balance snapshot, along with its recorded
+// balances, from the genesis state.
+func (k BaseKeeper) initBalanceSnapshot(ctx context.Context, gs types.GenesisBalanceSnapshot) {
+	snapshot := gs.Snapshot
+	if err := k.Snapshots.Set(ctx, snapshot.Id, snapshot); err != nil {
+		panic(err)
+	}
+
+	if !snapshot.Taken {
+		if err := k.PendingSnapshots.Set(ctx, collections.Join(snapshot.Height, snapshot.Id)); err != nil {
+			panic(err)
+		}
+	}
+
+	for _, balance := range gs.Balances {
+		addr := sdk.MustAccAddressFromBech32(balance.Address)
+		if err := k.SnapshotBalances.Set(ctx, collections.Join(snapshot.Id, addr), balance.Amount); err != nil {
+			panic(err)
+		}
+	}
+
+	seq, err := k.SnapshotSequence.Peek(ctx)
+	if err != nil {
+		panic(err)
+	}
+	if snapshot.Id >= seq {
+		if err := k.SnapshotSequence.Set(ctx, snapshot.Id+1); err != nil {
+			panic(err)
+		}
+	}
 }
 
 // ExportGenesis returns the bank module's genesis state.
@@ -65,5 +161,50 @@ func (k BaseKeeper) ExportGenesis(ctx context.Context) *types.GenesisState {
 		k.GetAllDenomMetaData(ctx),
 		k.GetAllSendEnabledEntries(ctx),
 	)
+
+	k.IterateAllHolds(ctx, func(hold types.Hold) bool {
+		rv.Holds = append(rv.Holds, hold)
+		return false
+	})
+
+	err = k.Snapshots.Walk(ctx, nil, func(id uint64, snapshot types.BalanceSnapshot) (stop bool) {
+		gs := types.GenesisBalanceSnapshot{Snapshot: snapshot}
+		k.IterateSnapshotBalances(ctx, id, func(addr sdk.AccAddress, amount math.Int) bool {
+			gs.Balances = append(gs.Balances, types.SnapshotBalance{Address: addr.String(), Amount: amount})
+			return false
+		})
+		rv.BalanceSnapshots = append(rv.BalanceSnapshots, gs)
+		return false
+	})
+	if err != nil {
+		panic(err)
+	}
+
+	rv.MintBurnPermissions = k.GetAllMintBurnPermissions(ctx)
+
+	for _, addr := range k.GetAllDustSweepOptIns(ctx) {
+		rv.DustSweepOptIns = append(rv.DustSweepOptIns, addr.String())
+	}
+
+	k.IterateTimelockedTransfers(ctx, func(t types.TimelockedTransfer) bool {
+		rv.TimelockedTransfers = append(rv.TimelockedTransfers, t)
+		return false
+	})
+
+	k.IterateSpendingLimits(ctx, func(l types.SpendingLimit) bool {
+		rv.SpendingLimits = append(rv.SpendingLimits, l)
+		return false
+	})
+
+	k.IterateDemurrageSchedules(ctx, func(s types.DemurrageSchedule) bool {
+		rv.DemurrageSchedules = append(rv.DemurrageSchedules, s)
+		return false
+	})
+
+	k.IterateDemurrageAccountEpochs(ctx, func(addr sdk.AccAddress, denom string, epoch uint64) bool {
+		rv.DemurrageAccountEpochs = append(rv.DemurrageAccountEpochs, types.DemurrageAccountEpoch{Address: addr.String(), Denom: denom, Epoch: epoch})
+		return false
+	})
+
 	return rv
 }
@@ -63,13 +63,16 @@ func (k BaseKeeper) AllBalances(ctx context.Context, req *types.QueryAllBalances
 	balances := sdk.NewCoins()
 
 	_, pageRes, err := query.CollectionFilteredPaginate(ctx, k.Balances, req.Pagination, func(key collections.Pair[sdk.AccAddress, string], value math.Int) (include bool, err error) {
-		denom := key.K2()
+		balance := k.decayedBalance(ctx, addr, sdk.NewCoin(key.K2(), value))
+		if balance.IsZero() {
+			return false, nil
+		}
 		if req.ResolveDenom {
-			if metadata, ok := k.GetDenomMetaData(sdkCtx, denom); ok {
-				denom = metadata.Display
+			if metadata, ok := k.GetDenomMetaData(sdkCtx, balance.Denom); ok {
+				balance.Denom = metadata.Display
 			}
 		}
-		balances = append(balances, sdk.NewCoin(denom, value))
+		balances = append(balances, balance)
 		return false, nil // we don't include results because we're appending them here.
 	}, query.WithCollectionPaginationPairPrefix[sdk.AccAddress, string](addr))
 	if err != nil {
@@ -246,7 +249,7 @@ func (k BaseKeeper) DenomOwners(
 			}
 			denomOwners = append(denomOwners, &types.DenomOwner{
 				Address: key.K2().String(),
-				Balance: sdk.NewCoin(req.Denom, amt),
+				Balance: k.decayedBalance(goCtx, key.K2(), sdk.NewCoin(req.Denom, amt)),
 			})
 			return false, nil
 		},
@@ -287,3 +290,357 @@ func (k BaseKeeper) SendEnabled(goCtx context.Context, req *types.QuerySendEnabl
 
 	return resp, nil
 }
+
+// MintBurnPermissions implements the Query/MintBurnPermissions gRPC method
+func (k BaseKeeper) MintBurnPermissions(ctx context.Context, req *types.QueryMintBurnPermissionsRequest) (*types.QueryMintBurnPermissionsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	resp := &types.QueryMintBurnPermissionsResponse{}
+	if len(req.Denoms) > 0 {
+		for _, denom := range req.Denoms {
+			if p, ok := k.GetMintBurnPermissions(ctx, denom); ok {
+				resp.Permissions = append(resp.Permissions, p)
+			}
+		}
+	} else {
+		results, pageResp, err := query.CollectionPaginate[string, types.MintBurnPermissions](ctx, k.BaseViewKeeper.MintBurnPermissions, req.Pagination)
+		if err != nil {
+			return nil, status.Error(codes.Internal, err.Error())
+		}
+		for _, r := range results {
+			resp.Permissions = append(resp.Permissions, r.Value)
+		}
+		resp.Pagination = pageResp
+	}
+
+	return resp, nil
+}
+
+// ModuleEscrows implements the Query/ModuleEscrows gRPC method
+func (k BaseKeeper) ModuleEscrows(ctx context.Context, req *types.QueryModuleEscrowsRequest) (*types.QueryModuleEscrowsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	if req.ModuleName == "" {
+		return nil, status.Error(codes.InvalidArgument, "empty module name")
+	}
+
+	moduleAddr := k.ak.GetModuleAddress(req.ModuleName)
+	if moduleAddr == nil {
+		return nil, status.Errorf(codes.NotFound, "module account %s does not exist", req.ModuleName)
+	}
+
+	results, pageRes, err := query.CollectionFilteredPaginate(ctx, k.BaseViewKeeper.ModuleEscrows, req.Pagination, nil,
+		query.WithCollectionPaginationPairPrefix[string, string](req.ModuleName),
+	)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "paginate: %v", err)
+	}
+
+	escrows := make([]types.ModuleEscrow, 0, len(results))
+	for _, r := range results {
+		escrows = append(escrows, r.Value)
+	}
+
+	// the totals are computed over all the escrows, whatever the page
+	balance := k.GetAllBalances(ctx, moduleAddr)
+	tracked := k.EscrowedCoins(ctx, req.ModuleName)
+
+	return &types.QueryModuleEscrowsResponse{
+		Escrows:    escrows,
+		Balance:    balance,
+		Tracked:    tracked,
+		Untracked:  excessCoins(balance, tracked),
+		Deficit:    excessCoins(tracked, balance),
+		Pagination: pageRes,
+	}, nil
+}
+
+// TimelockedTransfer implements the Query/TimelockedTransfer gRPC method
+func (k BaseKeeper) TimelockedTransfer(ctx context.Context, req *types.QueryTimelockedTransferRequest) (*types.QueryTimelockedTransferResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	t, found := k.GetTimelockedTransfer(ctx, req.TransferId)
+	if !found {
+		return nil, status.Errorf(codes.NotFound, "time-locked transfer %d not found", req.TransferId)
+	}
+
+	return &types.QueryTimelockedTransferResponse{Transfer: t}, nil
+}
+
+// SpendingLimit implements the Query/SpendingLimit gRPC method
+func (k BaseKeeper) SpendingLimit(ctx context.Context, req *types.QuerySpendingLimitRequest) (*types.QuerySpendingLimitResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	addr, err := sdk.AccAddressFromBech32(req.Address)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid address: %s", err.Error())
+	}
+
+	l, found := k.currentSpendingLimit(ctx, addr)
+	if !found {
+		return nil, status.Errorf(codes.NotFound, "spending limit of %s not found", req.Address)
+	}
+
+	return &types.QuerySpendingLimitResponse{SpendingLimit: l, Remaining: l.Remaining()}, nil
+}
+
+// DemurrageSchedules implements the Query/DemurrageSchedules gRPC method
+func (k BaseKeeper) DemurrageSchedules(ctx context.Context, req *types.QueryDemurrageSchedulesRequest) (*types.QueryDemurrageSchedulesResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	resp := &types.QueryDemurrageSchedulesResponse{}
+	if len(req.Denoms) > 0 {
+		for _, denom := range req.Denoms {
+			if s, ok := k.GetDemurrageSchedule(ctx, denom); ok {
+				resp.Schedules = append(resp.Schedules, s)
+			}
+		}
+	} else {
+		results, pageResp, err := query.CollectionPaginate[string, types.DemurrageSchedule](ctx, k.BaseViewKeeper.DemurrageSchedules, req.Pagination)
+		if err != nil {
+			return nil, status.Error(codes.Internal, err.Error())
+		}
+		for _, r := range results {
+			resp.Schedules = append(resp.Schedules, r.Value)
+		}
+		resp.Pagination = pageResp
+	}
+
+	return resp, nil
+}
+
+// TimelockedTransfersBySender implements the Query/TimelockedTransfersBySender gRPC method
+func (k BaseKeeper) TimelockedTransfersBySender(ctx context.Context, req *types.QueryTimelockedTransfersBySenderRequest) (*types.QueryTimelockedTransfersBySenderResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	sender, err := sdk.AccAddressFromBech32(req.Sender)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid address: %s", err.Error())
+	}
+
+	transfers, pageRes, err := k.paginateTimelockedTransfers(ctx, k.SenderTimelockedTransfers, sender, req.Pagination)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryTimelockedTransfersBySenderResponse{Transfers: transfers, Pagination: pageRes}, nil
+}
+
+// TimelockedTransfersByRecipient implements the Query/TimelockedTransfersByRecipient gRPC method
+func (k BaseKeeper) TimelockedTransfersByRecipient(ctx context.Context, req *types.QueryTimelockedTransfersByRecipientRequest) (*types.QueryTimelockedTransfersByRecipientResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	recipient, err := sdk.AccAddressFromBech32(req.Recipient)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid address: %s", err.Error())
+	}
+
+	transfers, pageRes, err := k.paginateTimelockedTransfers(ctx, k.RecipientTimelockedTransfers, recipient, req.Pagination)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryTimelockedTransfersByRecipientResponse{Transfers: transfers, Pagination: pageRes}, nil
+}
+
+// paginateTimelockedTransfers returns a page of the time-locked transfers of
+// an account, as indexed by the given index.
+func (k BaseKeeper) paginateTimelockedTransfers(
+	ctx context.Context, index collections.KeySet[collections.Pair[sdk.AccAddress, uint64]], addr sdk.AccAddress, pageReq *query.PageRequest,
+) ([]types.TimelockedTransfer, *query.PageResponse, error) {
+	results, pageRes, err := query.CollectionFilteredPaginate[collections.Pair[sdk.AccAddress, uint64], collections.NoValue](
+		ctx, index, pageReq, nil, query.WithCollectionPaginationPairPrefix[sdk.AccAddress, uint64](addr),
+	)
+	if err != nil {
+		return nil, nil, status.Errorf(codes.InvalidArgument, "paginate: %v", err)
+	}
+
+	transfers := make([]types.TimelockedTransfer, 0, len(results))
+	for _, r := range results {
+		t, err := k.TimelockedTransfers.Get(ctx, r.Key.K2())
+		if err != nil {
+			return nil, nil, status.Error(codes.Internal, err.Error())
+		}
+		transfers = append(transfers, t)
+	}
+
+	return transfers, pageRes, nil
+}
+
+// excessCoins returns the amounts of coins in excess of other, by denom.
+func excessCoins(coins, other sdk.Coins) sdk.Coins {
+	excess := sdk.NewCoins()
+	for _, coin := range coins {
+		if diff := coin.Amount.Sub(other.AmountOf(coin.Denom)); diff.IsPositive() {
+			excess = excess.Add(sdk.NewCoin(coin.Denom, diff))
+		}
+	}
+
+	return excess
+}
+
+// Holds implements the Query/Holds gRPC method
+func (k BaseKeeper) Holds(ctx context.Context, req *types.QueryHoldsRequest) (*types.QueryHoldsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	addr, err := sdk.AccAddressFromBech32(req.Address)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid address: %s", err.Error())
+	}
+
+	holds := k.GetHolds(ctx, addr)
+	held := sdk.NewCoins()
+	for _, hold := range holds {
+		held = held.Add(hold.Amount...)
+	}
+
+	return &types.QueryHoldsResponse{
+		Holds:     holds,
+		Held:      held,
+		Spendable: k.SpendableCoins(ctx, addr),
+	}, nil
+}
+
+// DisplayAmount implements the Query/DisplayAmount gRPC method
+func (k BaseKeeper) DisplayAmount(ctx context.Context, req *types.QueryDisplayAmountRequest) (*types.QueryDisplayAmountResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	if err := sdk.ValidateDenom(req.Denom); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	amount, ok := math.NewIntFromString(req.Amount)
+	if !ok {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid amount %q", req.Amount)
+	}
+
+	metadata, found := k.GetDenomMetaData(ctx, req.Denom)
+	if !found {
+		return nil, status.Errorf(codes.NotFound, "client metadata for denom %s", req.Denom)
+	}
+
+	unit := req.Unit
+	if unit == "" {
+		unit = metadata.Display
+	}
+
+	converted, exponent, err := metadata.ConvertAmount(amount, unit)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	return &types.QueryDisplayAmountResponse{
+		Amount:   converted,
+		Unit:     unit,
+		Exponent: exponent,
+	}, nil
+}
+
+// BalanceSnapshot implements the Query/BalanceSnapshot gRPC method
+func (k BaseKeeper) BalanceSnapshot(ctx context.Context, req *types.QueryBalanceSnapshotRequest) (*types.QueryBalanceSnapshotResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	snapshot, found := k.GetBalanceSnapshot(ctx, req.SnapshotId)
+	if !found {
+		return nil, status.Errorf(codes.NotFound, "balance snapshot %d", req.SnapshotId)
+	}
+
+	return &types.QueryBalanceSnapshotResponse{Snapshot: snapshot}, nil
+}
+
+// BalanceSnapshots implements the Query/BalanceSnapshots gRPC method
+func (k BaseKeeper) BalanceSnapshots(ctx context.Context, req *types.QueryBalanceSnapshotsRequest) (*types.QueryBalanceSnapshotsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	results, pageRes, err := query.CollectionPaginate[uint64, types.BalanceSnapshot](ctx, k.Snapshots, req.Pagination)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	snapshots := make([]types.BalanceSnapshot, len(results))
+	for i, res := range results {
+		snapshots[i] = res.Value
+	}
+
+	return &types.QueryBalanceSnapshotsResponse{Snapshots: snapshots, Pagination: pageRes}, nil
+}
+
+// BalanceSnapshotProof implements the Query/BalanceSnapshotProof gRPC method
+func (k BaseKeeper) BalanceSnapshotProof(ctx context.Context, req *types.QueryBalanceSnapshotProofRequest) (*types.QueryBalanceSnapshotProofResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	addr, err := sdk.AccAddressFromBech32(req.Address)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid address: %s", err.Error())
+	}
+
+	snapshot, found := k.GetBalanceSnapshot(ctx, req.SnapshotId)
+	if !found {
+		return nil, status.Errorf(codes.NotFound, "balance snapshot %d", req.SnapshotId)
+	}
+	if !snapshot.Taken {
+		return nil, status.Errorf(codes.FailedPrecondition, "balance snapshot %d is not taken until height %d", req.SnapshotId, snapshot.Height)
+	}
+
+	amount, proof, found := k.GetBalanceSnapshotProof(ctx, req.SnapshotId, addr)
+	if !found {
+		return nil, status.Errorf(codes.NotFound, "balance of %s in balance snapshot %d", req.Address, req.SnapshotId)
+	}
+
+	return &types.QueryBalanceSnapshotProofResponse{
+		Amount: amount,
+		Root:   snapshot.Root,
+		Proof:  proof,
+	}, nil
+}
+
+// SpendableBalanceProjection implements the Query/SpendableBalanceProjection gRPC method
+func (k BaseKeeper) SpendableBalanceProjection(ctx context.Context, req *types.QuerySpendableBalanceProjectionRequest) (*types.QuerySpendableBalanceProjectionResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	addr, err := sdk.AccAddressFromBech32(req.Address)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid address: %s", err.Error())
+	}
+
+	blockTime := sdk.UnwrapSDKContext(ctx).BlockTime()
+	if req.Time.Before(blockTime) {
+		return nil, status.Errorf(codes.InvalidArgument, "projection time %s is before the block time %s", req.Time, blockTime)
+	}
+
+	projected, locked, held := k.ProjectedSpendableCoins(ctx, addr, req.Time)
+
+	return &types.QuerySpendableBalanceProjectionResponse{
+		Balance:            k.GetAllBalances(ctx, addr),
+		Spendable:          k.SpendableCoins(ctx, addr),
+		Locked:             locked,
+		Held:               held,
+		ProjectedSpendable: projected,
+	}, nil
+}
@@ -5,6 +5,8 @@ import (
 	"fmt"
 	"time"
 
+	"github.com/golang/mock/gomock"
+
 	"github.com/cosmos/cosmos-sdk/testutil/testdata"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/query"
@@ -225,6 +227,46 @@ func (suite *KeeperTestSuite) TestSpendableBalanceByDenom() {
 	suite.EqualValues(30, res.Balance.Amount.Int64())
 }
 
+func (suite *KeeperTestSuite) TestSpendableBalanceProjection() {
+	_, _, addr := testdata.KeyTestPubAddr()
+
+	now := time.Now().UTC()
+	ctx := sdk.UnwrapSDKContext(suite.ctx).WithBlockTime(now)
+	queryClient := suite.mockQueryClient(ctx)
+
+	vacc := vestingtypes.NewContinuousVestingAccount(
+		authtypes.NewBaseAccountWithAddress(addr),
+		sdk.NewCoins(newFooCoin(100)),
+		now.Unix(),
+		now.Add(time.Hour).Unix(),
+	)
+	suite.authKeeper.EXPECT().GetAccount(gomock.Any(), addr).Return(vacc).AnyTimes()
+
+	suite.mockFundAccount(addr)
+	suite.Require().NoError(testutil.FundAccount(suite.ctx, suite.bankKeeper, addr, sdk.NewCoins(newFooCoin(100), newBarCoin(50))))
+	expiration := now.Add(30 * time.Minute)
+	suite.Require().NoError(suite.bankKeeper.HoldCoins(ctx, addr, "escrow", sdk.NewCoins(newBarCoin(20)), &expiration))
+
+	_, err := queryClient.SpendableBalanceProjection(ctx, &types.QuerySpendableBalanceProjectionRequest{Address: addr.String(), Time: now.Add(-time.Minute)})
+	suite.Require().ErrorContains(err, "is before the block time")
+
+	// a quarter of the vesting coins are vested, and the hold is active
+	res, err := queryClient.SpendableBalanceProjection(ctx, &types.QuerySpendableBalanceProjectionRequest{Address: addr.String(), Time: now.Add(15 * time.Minute)})
+	suite.Require().NoError(err)
+	suite.Require().Equal(sdk.NewCoins(newFooCoin(100), newBarCoin(50)), res.Balance)
+	suite.Require().Equal(sdk.NewCoins(newBarCoin(30)), res.Spendable)
+	suite.Require().Equal(sdk.NewCoins(newFooCoin(75)), res.Locked)
+	suite.Require().Equal(sdk.NewCoins(newBarCoin(20)), res.Held)
+	suite.Require().Equal(sdk.NewCoins(newFooCoin(25), newBarCoin(30)), res.ProjectedSpendable)
+
+	// all the coins are vested, and the hold is expired
+	res, err = queryClient.SpendableBalanceProjection(ctx, &types.QuerySpendableBalanceProjectionRequest{Address: addr.String(), Time: now.Add(2 * time.Hour)})
+	suite.Require().NoError(err)
+	suite.Require().True(res.Locked.IsZero())
+	suite.Require().True(res.Held.IsZero())
+	suite.Require().Equal(sdk.NewCoins(newFooCoin(100), newBarCoin(50)), res.ProjectedSpendable)
+}
+
 func (suite *KeeperTestSuite) TestQueryTotalSupply() {
 	ctx, queryClient := suite.ctx, suite.queryClient
 	res, err := queryClient.TotalSupply(gocontext.Background(), &types.QueryTotalSupplyRequest{})
@@ -674,3 +716,36 @@ func (suite *KeeperTestSuite) TestQuerySendEnabled() {
 		})
 	}
 }
+
+func (suite *KeeperTestSuite) TestQueryDisplayAmount() {
+	ctx, queryClient := suite.ctx, suite.queryClient
+
+	suite.bankKeeper.SetDenomMetaData(ctx, types.Metadata{
+		Name:   "Cosmos Hub Atom",
+		Symbol: "ATOM",
+		DenomUnits: []*types.DenomUnit{
+			{Denom: "uatom", Exponent: 0},
+			{Denom: "matom", Exponent: 3, Aliases: []string{"milliatom"}},
+			{Denom: "atom", Exponent: 6},
+		},
+		Base:    "uatom",
+		Display: "atom",
+	})
+
+	_, err := queryClient.DisplayAmount(gocontext.Background(), &types.QueryDisplayAmountRequest{Denom: "uosmo", Amount: "1"})
+	suite.Require().ErrorContains(err, "client metadata for denom uosmo")
+
+	_, err = queryClient.DisplayAmount(gocontext.Background(), &types.QueryDisplayAmountRequest{Denom: "uatom", Amount: "1.5"})
+	suite.Require().ErrorContains(err, "invalid amount")
+
+	_, err = queryClient.DisplayAmount(gocontext.Background(), &types.QueryDisplayAmountRequest{Denom: "uatom", Amount: "1", Unit: "katom"})
+	suite.Require().ErrorContains(err, "unit katom is not a unit of denom uatom")
+
+	res, err := queryClient.DisplayAmount(gocontext.Background(), &types.QueryDisplayAmountRequest{Denom: "uatom", Amount: "1500000"})
+	suite.Require().NoError(err)
+	suite.Require().Equal(&types.QueryDisplayAmountResponse{Amount: "1.5", Unit: "atom", Exponent: 6}, res)
+
+	res, err = queryClient.DisplayAmount(gocontext.Background(), &types.QueryDisplayAmountRequest{Denom: "uatom", Amount: "1500", Unit: "milliatom"})
+	suite.Require().NoError(err)
+	suite.Require().Equal(&types.QueryDisplayAmountResponse{Amount: "1.5", Unit: "milliatom", Exponent: 3}, res)
+}
@@ -0,0 +1,185 @@
+package keeper
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"cosmossdk.io/collections"
+	errorsmod "cosmossdk.io/errors"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/bank/types"
+)
+
+// GetHold returns the hold of the given holder on the balance of an account.
+// An expired hold is not returned, as it is released.
+func (k BaseViewKeeper) GetHold(ctx context.Context, addr sdk.AccAddress, holder string) (types.Hold, bool) {
+	hold, err := k.BalanceHolds.Get(ctx, collections.Join(addr, holder))
+	if err != nil {
+		if !errors.Is(err, collections.ErrNotFound) {
+			panic(err)
+		}
+		return types.Hold{}, false
+	}
+
+	if !hold.IsActive(sdk.UnwrapSDKContext(ctx).BlockTime()) {
+		return types.Hold{}, false
+	}
+
+	return hold, true
+}
+
+// GetHolds returns the holds on the balance of an account, in holder order.
+// The expired holds are not returned.
+func (k BaseViewKeeper) GetHolds(ctx context.Context, addr sdk.AccAddress) []types.Hold {
+	return k.getHolds(ctx, addr, sdk.UnwrapSDKContext(ctx).BlockTime())
+}
+
+// getHolds returns the holds on the balance of an account active at the given
+// time, in holder order.
+func (k BaseViewKeeper) getHolds(ctx context.Context, addr sdk.AccAddress, t time.Time) []types.Hold {
+	var holds []types.Hold
+	err := k.BalanceHolds.Walk(ctx, collections.NewPrefixedPairRange[sdk.AccAddress, string](addr), func(_ collections.Pair[sdk.AccAddress, string], hold types.Hold) (stop bool) {
+		if hold.IsActive(t) {
+			holds = append(holds, hold)
+		}
+		return false
+	})
+	if err != nil {
+		panic(err)
+	}
+
+	return holds
+}
+
+// HeldCoins returns the coins of an account held by any holder. The held coins
+// stay in the account but cannot be spent nor delegated.
+func (k BaseViewKeeper) HeldCoins(ctx context.Context, addr sdk.AccAddress) sdk.Coins {
+	held := sdk.NewCoins()
+	for _, hold := range k.GetHolds(ctx, addr) {
+		held = held.Add(hold.Amount...)
+	}
+
+	return held
+}
+
+// IterateAllHolds iterates over the holds on the balances of all the accounts,
+// the expired holds excepted, and calls the provided callback. If true is
+// returned from the callback, iteration is halted.
+func (k BaseViewKeeper) IterateAllHolds(ctx context.Context, cb func(hold types.Hold) (stop bool)) {
+	blockTime := sdk.UnwrapSDKContext(ctx).BlockTime()
+
+	err := k.BalanceHolds.Walk(ctx, nil, func(_ collections.Pair[sdk.AccAddress, string], hold types.Hold) (stop bool) {
+		if !hold.IsActive(blockTime) {
+			return false
+		}
+		return cb(hold)
+	})
+	if err != nil {
+		panic(err)
+	}
+}
+
+// HoldCoins places a hold of the given holder, e.g. a module name, on amt coins
+// of the balance of an account. The held coins stay in the account, but cannot
+// be spent nor delegated until they are released, either with ReleaseHold or
+// SendHeldCoins, or automatically at expiration unless it is nil. The coins
+// must be spendable. If the holder already holds coins of the account, the
+// coins are added to its hold and the expiration of the hold is replaced.
+func (k BaseSendKeeper) HoldCoins(ctx context.Context, addr sdk.AccAddress, holder string, amt sdk.Coins, expiration *time.Time) error {
+	if holder == "" {
+		return errorsmod.Wrap(types.ErrInvalidHold, "empty holder")
+	}
+
+	if !amt.IsValid() || amt.IsZero() {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidCoins, amt.String())
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	if expiration != nil && !expiration.After(sdkCtx.BlockTime()) {
+		return errorsmod.Wrapf(types.ErrInvalidHold, "expiration %s is not after the block time", expiration)
+	}
+
+	for _, coin := range amt {
+		if spendable := k.SpendableCoin(ctx, addr, coin.Denom); spendable.IsLT(coin) {
+			return errorsmod.Wrapf(sdkerrors.ErrInsufficientFunds, "spendable balance %s is smaller than %s", spendable, coin)
+		}
+	}
+
+	hold, found := k.GetHold(ctx, addr, holder)
+	if !found {
+		hold = types.NewHold(addr, holder, sdk.NewCoins(), nil)
+	}
+	hold.Amount = hold.Amount.Add(amt...)
+	hold.Expiration = expiration
+
+	if err := k.BalanceHolds.Set(ctx, collections.Join(addr, holder), hold); err != nil {
+		return err
+	}
+
+	attrs := []sdk.Attribute{
+		sdk.NewAttribute(types.AttributeKeyAccount, addr.String()),
+		sdk.NewAttribute(types.AttributeKeyHolder, holder),
+		sdk.NewAttribute(sdk.AttributeKeyAmount, amt.String()),
+	}
+	if expiration != nil {
+		attrs = append(attrs, sdk.NewAttribute(types.AttributeKeyExpiration, expiration.String()))
+	}
+	sdkCtx.EventManager().EmitEvent(sdk.NewEvent(types.EventTypeHold, attrs...))
+
+	return nil
+}
+
+// ReleaseHold releases amt coins of the hold of the given holder on the balance
+// of an account, making them spendable again. The hold is removed once all its
+// coins are released.
+func (k BaseSendKeeper) ReleaseHold(ctx context.Context, addr sdk.AccAddress, holder string, amt sdk.Coins) error {
+	if !amt.IsValid() || amt.IsZero() {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidCoins, amt.String())
+	}
+
+	hold, found := k.GetHold(ctx, addr, holder)
+	if !found {
+		return errorsmod.Wrapf(types.ErrHoldNotFound, "holder %s, account %s", holder, addr)
+	}
+
+	remaining, hasNeg := hold.Amount.SafeSub(amt...)
+	if hasNeg {
+		return errorsmod.Wrapf(types.ErrInsufficientHold, "held %s is smaller than %s", hold.Amount, amt)
+	}
+
+	var err error
+	if remaining.IsZero() {
+		err = k.BalanceHolds.Remove(ctx, collections.Join(addr, holder))
+	} else {
+		hold.Amount = remaining
+		err = k.BalanceHolds.Set(ctx, collections.Join(addr, holder), hold)
+	}
+	if err != nil {
+		return err
+	}
+
+	sdk.UnwrapSDKContext(ctx).EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeReleaseHold,
+			sdk.NewAttribute(types.AttributeKeyAccount, addr.String()),
+			sdk.NewAttribute(types.AttributeKeyHolder, holder),
+			sdk.NewAttribute(sdk.AttributeKeyAmount, amt.String()),
+		),
+	)
+
+	return nil
+}
+
+// SendHeldCoins releases amt coins of the hold of the given holder on the
+// balance of an account and sends them to the recipient, e.g. to settle an
+// escrow.
+func (k BaseSendKeeper) SendHeldCoins(ctx context.Context, fromAddr sdk.AccAddress, holder string, toAddr sdk.AccAddress, amt sdk.Coins) error {
+	if err := k.ReleaseHold(ctx, fromAddr, holder, amt); err != nil {
+		return err
+	}
+
+	return k.SendCoins(ctx, fromAddr, toAddr, amt)
+}
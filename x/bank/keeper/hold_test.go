@@ -0,0 +1,71 @@
+package keeper_test
+
+import (
+	"time"
+
+	"github.com/golang/mock/gomock"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	banktestutil "github.com/cosmos/cosmos-sdk/x/bank/testutil"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+)
+
+func (suite *KeeperTestSuite) TestHolds() {
+	ctx := sdk.UnwrapSDKContext(suite.ctx)
+	require := suite.Require()
+
+	acc0 := authtypes.NewBaseAccountWithAddress(accAddrs[0])
+	suite.authKeeper.EXPECT().GetAccount(gomock.Any(), accAddrs[0]).Return(acc0).AnyTimes()
+	suite.authKeeper.EXPECT().HasAccount(gomock.Any(), accAddrs[1]).Return(true).AnyTimes()
+
+	suite.mockFundAccount(accAddrs[0])
+	require.NoError(banktestutil.FundAccount(ctx, suite.bankKeeper, accAddrs[0], sdk.NewCoins(newFooCoin(100))))
+
+	foo := func(amt int64) sdk.Coins { return sdk.NewCoins(newFooCoin(amt)) }
+
+	require.ErrorIs(suite.bankKeeper.HoldCoins(ctx, accAddrs[0], "", foo(10), nil), banktypes.ErrInvalidHold)
+	past := ctx.BlockTime().Add(-time.Hour)
+	require.ErrorIs(suite.bankKeeper.HoldCoins(ctx, accAddrs[0], "escrow", foo(10), &past), banktypes.ErrInvalidHold)
+
+	// the held coins cannot be spent nor held again
+	require.NoError(suite.bankKeeper.HoldCoins(ctx, accAddrs[0], "escrow", foo(60), nil))
+	require.ErrorIs(suite.bankKeeper.HoldCoins(ctx, accAddrs[0], "other", foo(50), nil), sdkerrors.ErrInsufficientFunds)
+	require.Equal(foo(60), suite.bankKeeper.HeldCoins(ctx, accAddrs[0]))
+	require.Equal(foo(40), suite.bankKeeper.SpendableCoins(ctx, accAddrs[0]))
+	require.Equal(newFooCoin(40), suite.bankKeeper.SpendableCoin(ctx, accAddrs[0], fooDenom))
+
+	require.ErrorIs(suite.bankKeeper.SendCoins(ctx, accAddrs[0], accAddrs[1], foo(50)), sdkerrors.ErrInsufficientFunds)
+	require.NoError(suite.bankKeeper.SendCoins(ctx, accAddrs[0], accAddrs[1], foo(40)))
+
+	res, err := suite.queryClient.Holds(ctx, &banktypes.QueryHoldsRequest{Address: accAddrs[0].String()})
+	require.NoError(err)
+	require.Equal([]banktypes.Hold{banktypes.NewHold(accAddrs[0], "escrow", foo(60), nil)}, res.Holds)
+	require.Equal(foo(60), res.Held)
+	require.True(res.Spendable.IsZero())
+
+	// the held coins are released or sent by the holder
+	require.ErrorIs(suite.bankKeeper.ReleaseHold(ctx, accAddrs[0], "other", foo(10)), banktypes.ErrHoldNotFound)
+	require.ErrorIs(suite.bankKeeper.ReleaseHold(ctx, accAddrs[0], "escrow", foo(70)), banktypes.ErrInsufficientHold)
+
+	require.NoError(suite.bankKeeper.SendHeldCoins(ctx, accAddrs[0], "escrow", accAddrs[1], foo(20)))
+	require.Equal(foo(60), suite.bankKeeper.GetAllBalances(ctx, accAddrs[1]))
+	require.Equal(foo(40), suite.bankKeeper.HeldCoins(ctx, accAddrs[0]))
+
+	require.NoError(suite.bankKeeper.ReleaseHold(ctx, accAddrs[0], "escrow", foo(40)))
+	_, found := suite.bankKeeper.GetHold(ctx, accAddrs[0], "escrow")
+	require.False(found)
+	require.Equal(foo(40), suite.bankKeeper.SpendableCoins(ctx, accAddrs[0]))
+
+	// the holds are released automatically at expiration
+	expiration := ctx.BlockTime().Add(time.Hour)
+	require.NoError(suite.bankKeeper.HoldCoins(ctx, accAddrs[0], "escrow", foo(40), &expiration))
+	require.True(suite.bankKeeper.SpendableCoins(ctx, accAddrs[0]).IsZero())
+
+	ctx = ctx.WithBlockTime(expiration)
+	_, found = suite.bankKeeper.GetHold(ctx, accAddrs[0], "escrow")
+	require.False(found)
+	require.Equal(foo(40), suite.bankKeeper.SpendableCoins(ctx, accAddrs[0]))
+	require.NoError(suite.bankKeeper.HoldCoins(ctx, accAddrs[0], "other", foo(40), nil))
+}
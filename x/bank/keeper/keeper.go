@@ -3,6 +3,7 @@ package keeper
 import (
 	"context"
 	"fmt"
+	"time"
 
 	"cosmossdk.io/core/store"
 	"cosmossdk.io/log"
@@ -50,6 +51,41 @@ type Keeper interface {
 	DelegateCoins(ctx context.Context, delegatorAddr, moduleAccAddr sdk.AccAddress, amt sdk.Coins) error
 	UndelegateCoins(ctx context.Context, moduleAccAddr, delegatorAddr sdk.AccAddress, amt sdk.Coins) error
 
+	GetBalanceSnapshot(ctx context.Context, id uint64) (types.BalanceSnapshot, bool)
+	IterateSnapshotBalances(ctx context.Context, id uint64, cb func(addr sdk.AccAddress, amount math.Int) (stop bool))
+	GetBalanceSnapshotProof(ctx context.Context, id uint64, addr sdk.AccAddress) (math.Int, types.BalanceSnapshotProof, bool)
+	CreateBalanceSnapshot(ctx context.Context, denom string, height int64) (uint64, error)
+	DeleteBalanceSnapshot(ctx context.Context, id uint64) error
+	TakeBalanceSnapshots(ctx context.Context) error
+
+	GetMintBurnPermissions(ctx context.Context, denom string) (types.MintBurnPermissions, bool)
+	SetMintBurnPermissions(ctx context.Context, p types.MintBurnPermissions)
+	DeleteMintBurnPermissions(ctx context.Context, denoms ...string)
+	IterateMintBurnPermissions(ctx context.Context, cb func(p types.MintBurnPermissions) (stop bool))
+	GetAllMintBurnPermissions(ctx context.Context) []types.MintBurnPermissions
+
+	SetCommunityPoolKeeper(cpk types.CommunityPoolKeeper)
+	IsDustSweepOptedIn(ctx context.Context, addr sdk.AccAddress) bool
+	GetAllDustSweepOptIns(ctx context.Context) []sdk.AccAddress
+	SetDustSweepOptIn(ctx context.Context, addr sdk.AccAddress, optIn bool) error
+	SweepDust(ctx context.Context) error
+
+	SetDemurrageSchedule(ctx context.Context, denom string, rate math.LegacyDec, epochLength int64) (types.DemurrageSchedule, error)
+	AdvanceDemurrageEpochs(ctx context.Context) error
+
+	GetModuleEscrow(ctx context.Context, moduleName, purpose string) sdk.Coins
+	GetModuleEscrows(ctx context.Context, moduleName string) []types.ModuleEscrow
+	EscrowedCoins(ctx context.Context, moduleName string) sdk.Coins
+	AddModuleEscrow(ctx context.Context, moduleName, purpose string, amt sdk.Coins) error
+	SubModuleEscrow(ctx context.Context, moduleName, purpose string, amt sdk.Coins) error
+	SetModuleEscrow(ctx context.Context, moduleName, purpose string, amt sdk.Coins) error
+
+	GetTimelockedTransfer(ctx context.Context, id uint64) (types.TimelockedTransfer, bool)
+	IterateTimelockedTransfers(ctx context.Context, cb func(t types.TimelockedTransfer) (stop bool))
+	SendWithTimelock(ctx context.Context, fromAddr, toAddr sdk.AccAddress, amt sdk.Coins, unlockTime, expiryTime time.Time) (uint64, error)
+	ClaimTimelockedTransfer(ctx context.Context, recipient sdk.AccAddress, id uint64) error
+	ReclaimTimelockedTransfer(ctx context.Context, sender sdk.AccAddress, id uint64) error
+
 	types.QueryServer
 }
 
@@ -61,6 +97,7 @@ type BaseKeeper struct {
 	cdc                    codec.BinaryCodec
 	storeService           store.KVStoreService
 	mintCoinsRestrictionFn MintingRestrictionFn
+	communityPool          *communityPoolKeeper
 	logger                 log.Logger
 }
 
@@ -107,6 +144,7 @@ func NewBaseKeeper(
 		cdc:                    cdc,
 		storeService:           storeService,
 		mintCoinsRestrictionFn: func(ctx context.Context, coins sdk.Coins) error { return nil },
+		communityPool:          &communityPoolKeeper{},
 		logger:                 logger,
 	}
 }
@@ -148,18 +186,28 @@ func (k BaseKeeper) DelegateCoins(ctx context.Context, delegatorAddr, moduleAccA
 	}
 
 	balances := sdk.NewCoins()
+	held := k.HeldCoins(ctx, delegatorAddr)
 
 	for _, coin := range amt {
-		balance := k.GetBalance(ctx, delegatorAddr, coin.GetDenom())
+		balance, err := k.settleDemurrage(ctx, delegatorAddr, coin.GetDenom())
+		if err != nil {
+			return err
+		}
 		if balance.IsLT(coin) {
 			return errorsmod.Wrapf(
 				sdkerrors.ErrInsufficientFunds, "failed to delegate; %s is smaller than %s", balance, amt,
 			)
 		}
 
+		// held coins cannot be delegated, even if they are vesting
+		if balance.Amount.Sub(held.AmountOf(coin.Denom)).LT(coin.Amount) {
+			return errorsmod.Wrapf(
+				sdkerrors.ErrInsufficientFunds, "failed to delegate; %s of %s are held", held.AmountOf(coin.Denom), balance,
+			)
+		}
+
 		balances = balances.Add(balance)
-		err := k.setBalance(ctx, delegatorAddr, balance.Sub(coin))
-		if err != nil {
+		if err := k.setBalance(ctx, delegatorAddr, balance.Sub(coin)); err != nil {
 			return err
 		}
 	}
@@ -352,7 +400,9 @@ func (k BaseKeeper) UndelegateCoinsFromModuleToAccount(
 }
 
 // MintCoins creates new coins from thin air and adds it to the module account.
-// It will panic if the module account does not exist or is unauthorized.
+// It will panic if the module account does not exist or is unauthorized, and
+// returns an error if the mint/burn permissions of a denom don't allow the
+// module to mint it.
 func (k BaseKeeper) MintCoins(ctx context.Context, moduleName string, amounts sdk.Coins) error {
 	sdkCtx := sdk.UnwrapSDKContext(ctx)
 
@@ -370,6 +420,11 @@ func (k BaseKeeper) MintCoins(ctx context.Context, moduleName string, amounts sd
 		panic(errorsmod.Wrapf(sdkerrors.ErrUnauthorized, "module account %s does not have permissions to mint tokens", moduleName))
 	}
 
+	if err := k.checkMintPermissions(ctx, moduleName, acc.GetAddress(), amounts); err != nil {
+		k.logger.Error(fmt.Sprintf("Module %q attempted to mint coins %s it doesn't have permission for, error %v", moduleName, amounts, err))
+		return err
+	}
+
 	err = k.addCoins(ctx, acc.GetAddress(), amounts)
 	if err != nil {
 		return err
@@ -392,7 +447,9 @@ func (k BaseKeeper) MintCoins(ctx context.Context, moduleName string, amounts sd
 }
 
 // BurnCoins burns coins deletes coins from the balance of the module account.
-// It will panic if the module account does not exist or is unauthorized.
+// It will panic if the module account does not exist or is unauthorized, and
+// returns an error if the mint/burn permissions of a denom don't allow the
+// module to burn it.
 func (k BaseKeeper) BurnCoins(ctx context.Context, moduleName string, amounts sdk.Coins) error {
 	acc := k.ak.GetModuleAccount(ctx, moduleName)
 	if acc == nil {
@@ -403,6 +460,10 @@ func (k BaseKeeper) BurnCoins(ctx context.Context, moduleName string, amounts sd
 		panic(errorsmod.Wrapf(sdkerrors.ErrUnauthorized, "module account %s does not have permissions to burn tokens", moduleName))
 	}
 
+	if err := k.checkBurnPermissions(ctx, moduleName, acc.GetAddress(), amounts); err != nil {
+		return err
+	}
+
 	err := k.subUnlockedCoins(ctx, acc.GetAddress(), amounts)
 	if err != nil {
 		return err
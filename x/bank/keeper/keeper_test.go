@@ -472,6 +472,83 @@ func (suite *KeeperTestSuite) TestSupply_BurnCoins() {
 	require.Equal(supplyAfterInflation.Sub(initCoins...), supplyAfterBurn)
 }
 
+func (suite *KeeperTestSuite) TestSupply_MintBurnPermissions() {
+	ctx := suite.ctx
+	require := suite.Require()
+	authKeeper, keeper := suite.authKeeper, suite.bankKeeper
+
+	// the multi permissions module is listed by name as minter and by address
+	// as burner
+	permissions := banktypes.NewMintBurnPermissions(sdk.DefaultBondDenom, []string{multiPerm}, []string{multiPermAcc.GetAddress().String()})
+	keeper.SetMintBurnPermissions(ctx, permissions)
+	stored, found := keeper.GetMintBurnPermissions(ctx, sdk.DefaultBondDenom)
+	require.True(found)
+	require.Equal(permissions, stored)
+	require.Equal([]banktypes.MintBurnPermissions{permissions}, keeper.GetAllMintBurnPermissions(ctx))
+
+	suite.mockMintCoins(minterAcc)
+	require.ErrorIs(keeper.MintCoins(ctx, authtypes.Minter, initCoins), banktypes.ErrMintBurnNotPermitted)
+
+	suite.mockMintCoins(multiPermAcc)
+	require.NoError(keeper.MintCoins(ctx, multiPerm, initCoins))
+
+	authKeeper.EXPECT().GetModuleAccount(ctx, burnerAcc.Name).Return(burnerAcc)
+	require.ErrorIs(keeper.BurnCoins(ctx, authtypes.Burner, initCoins), banktypes.ErrMintBurnNotPermitted)
+
+	suite.mockBurnCoins(multiPermAcc)
+	require.NoError(keeper.BurnCoins(ctx, multiPerm, initCoins))
+
+	// denoms without permissions are not restricted
+	fooCoins := sdk.NewCoins(sdk.NewInt64Coin(fooDenom, 10))
+	suite.mockMintCoins(minterAcc)
+	require.NoError(keeper.MintCoins(ctx, authtypes.Minter, fooCoins))
+
+	keeper.DeleteMintBurnPermissions(ctx, sdk.DefaultBondDenom)
+	_, found = keeper.GetMintBurnPermissions(ctx, sdk.DefaultBondDenom)
+	require.False(found)
+
+	suite.mockMintCoins(minterAcc)
+	require.NoError(keeper.MintCoins(ctx, authtypes.Minter, initCoins))
+}
+
+type mockBankHooks struct {
+	changes []string
+	err     error
+}
+
+func (h *mockBankHooks) AfterBalanceChange(_ context.Context, addr sdk.AccAddress, denom string, oldBalance, newBalance math.Int) error {
+	h.changes = append(h.changes, fmt.Sprintf("%s %s: %s -> %s", addr, denom, oldBalance, newBalance))
+	return h.err
+}
+
+func (suite *KeeperTestSuite) TestBalanceChangeHooks() {
+	ctx := suite.ctx
+	require := suite.Require()
+	keeper := suite.bankKeeper
+
+	hooks := &mockBankHooks{}
+	keeper.SetHooks(hooks)
+	require.Panics(func() { keeper.SetHooks(hooks) })
+
+	coins := sdk.NewCoins(sdk.NewInt64Coin(fooDenom, 100))
+	suite.mockMintCoins(minterAcc)
+	require.NoError(keeper.MintCoins(ctx, authtypes.Minter, coins))
+
+	suite.mockSendCoinsFromModuleToAccount(minterAcc, accAddrs[0])
+	require.NoError(keeper.SendCoinsFromModuleToAccount(ctx, authtypes.Minter, accAddrs[0], coins))
+
+	require.Equal([]string{
+		fmt.Sprintf("%s foo: 0 -> 100", minterAcc.GetAddress()),
+		fmt.Sprintf("%s foo: 100 -> 0", minterAcc.GetAddress()),
+		fmt.Sprintf("%s foo: 0 -> 100", accAddrs[0]),
+	}, hooks.changes)
+
+	// an error of the hooks aborts the operation
+	hooks.err = fmt.Errorf("hook error")
+	suite.mockMintCoins(minterAcc)
+	require.ErrorContains(keeper.MintCoins(ctx, authtypes.Minter, coins), "hook error")
+}
+
 func (suite *KeeperTestSuite) TestSendCoinsNewAccount() {
 	ctx := suite.ctx
 	require := suite.Require()
@@ -0,0 +1,89 @@
+package keeper
+
+import (
+	"context"
+	"errors"
+
+	"cosmossdk.io/collections"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/bank/types"
+)
+
+// GetMintBurnPermissions returns the mint/burn permissions of a denom, if any.
+func (k BaseKeeper) GetMintBurnPermissions(ctx context.Context, denom string) (types.MintBurnPermissions, bool) {
+	p, err := k.BaseViewKeeper.MintBurnPermissions.Get(ctx, denom)
+	if err != nil {
+		if !errors.Is(err, collections.ErrNotFound) {
+			panic(err)
+		}
+		return types.MintBurnPermissions{}, false
+	}
+
+	return p, true
+}
+
+// SetMintBurnPermissions sets the mint/burn permissions of a denom, replacing
+// its current permissions, if any.
+func (k BaseKeeper) SetMintBurnPermissions(ctx context.Context, p types.MintBurnPermissions) {
+	if err := k.BaseViewKeeper.MintBurnPermissions.Set(ctx, p.Denom, p); err != nil {
+		panic(err)
+	}
+}
+
+// DeleteMintBurnPermissions deletes the mint/burn permissions of one or more
+// denoms, which can then be minted and burned by any module with the minter
+// and burner permissions. Denoms without mint/burn permissions are ignored.
+func (k BaseKeeper) DeleteMintBurnPermissions(ctx context.Context, denoms ...string) {
+	for _, denom := range denoms {
+		if err := k.BaseViewKeeper.MintBurnPermissions.Remove(ctx, denom); err != nil {
+			panic(err)
+		}
+	}
+}
+
+// IterateMintBurnPermissions iterates over the mint/burn permissions, in denom
+// order.
+func (k BaseKeeper) IterateMintBurnPermissions(ctx context.Context, cb func(p types.MintBurnPermissions) (stop bool)) {
+	err := k.BaseViewKeeper.MintBurnPermissions.Walk(ctx, nil, func(_ string, p types.MintBurnPermissions) bool {
+		return cb(p)
+	})
+	if err != nil {
+		panic(err)
+	}
+}
+
+// GetAllMintBurnPermissions returns all the mint/burn permissions.
+func (k BaseKeeper) GetAllMintBurnPermissions(ctx context.Context) []types.MintBurnPermissions {
+	var rv []types.MintBurnPermissions
+	k.IterateMintBurnPermissions(ctx, func(p types.MintBurnPermissions) bool {
+		rv = append(rv, p)
+		return false
+	})
+
+	return rv
+}
+
+// checkMintPermissions returns an error if any of the coins has mint/burn
+// permissions not allowing the module to mint it.
+func (k BaseKeeper) checkMintPermissions(ctx context.Context, moduleName string, moduleAddr sdk.AccAddress, amounts sdk.Coins) error {
+	for _, coin := range amounts {
+		if p, ok := k.GetMintBurnPermissions(ctx, coin.Denom); ok && !p.CanMint(moduleName, moduleAddr) {
+			return types.ErrMintBurnNotPermitted.Wrapf("module %s is not allowed to mint %s", moduleName, coin.Denom)
+		}
+	}
+
+	return nil
+}
+
+// checkBurnPermissions returns an error if any of the coins has mint/burn
+// permissions not allowing the module to burn it.
+func (k BaseKeeper) checkBurnPermissions(ctx context.Context, moduleName string, moduleAddr sdk.AccAddress, amounts sdk.Coins) error {
+	for _, coin := range amounts {
+		if p, ok := k.GetMintBurnPermissions(ctx, coin.Denom); ok && !p.CanBurn(moduleName, moduleAddr) {
+			return types.ErrMintBurnNotPermitted.Wrapf("module %s is not allowed to burn %s", moduleName, coin.Denom)
+		}
+	}
+
+	return nil
+}
@@ -2,6 +2,7 @@ package keeper
 
 import (
 	"context"
+	"strconv"
 
 	"github.com/armon/go-metrics"
 
@@ -116,6 +117,38 @@ func (k msgServer) MultiSend(goCtx context.Context, msg *types.MsgMultiSend) (*t
 	return &types.MsgMultiSendResponse{}, nil
 }
 
+func (k msgServer) BatchSend(goCtx context.Context, msg *types.MsgBatchSend) (*types.MsgBatchSendResponse, error) {
+	from, err := sdk.AccAddressFromBech32(msg.FromAddress)
+	if err != nil {
+		return nil, sdkerrors.ErrInvalidAddress.Wrapf("invalid from address: %s", err)
+	}
+
+	if len(msg.Outputs) == 0 {
+		return nil, types.ErrNoOutputs
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	for _, out := range msg.Outputs {
+		if err := out.ValidateBasic(); err != nil {
+			return nil, err
+		}
+
+		if err := k.IsSendEnabledCoins(ctx, out.Coins...); err != nil {
+			return nil, err
+		}
+
+		if k.BlockedAddr(sdk.MustAccAddressFromBech32(out.Address)) {
+			return nil, errorsmod.Wrapf(sdkerrors.ErrUnauthorized, "%s is not allowed to receive funds", out.Address)
+		}
+	}
+
+	if err := k.BatchSendCoins(ctx, from, msg.Outputs); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgBatchSendResponse{}, nil
+}
+
 func (k msgServer) UpdateParams(goCtx context.Context, req *types.MsgUpdateParams) (*types.MsgUpdateParamsResponse, error) {
 	if k.GetAuthority() != req.Authority {
 		return nil, errorsmod.Wrapf(govtypes.ErrInvalidSigner, "invalid authority; expected %s, got %s", k.GetAuthority(), req.Authority)
@@ -167,3 +200,208 @@ func (k msgServer) SetSendEnabled(goCtx context.Context, msg *types.MsgSetSendEn
 
 	return &types.MsgSetSendEnabledResponse{}, nil
 }
+
+func (k msgServer) UpdateDenomMetadata(goCtx context.Context, msg *types.MsgUpdateDenomMetadata) (*types.MsgUpdateDenomMetadataResponse, error) {
+	if k.GetAuthority() != msg.Authority {
+		return nil, errorsmod.Wrapf(govtypes.ErrInvalidSigner, "invalid authority; expected %s, got %s", k.GetAuthority(), msg.Authority)
+	}
+
+	if err := msg.Metadata.Validate(); err != nil {
+		return nil, sdkerrors.ErrInvalidRequest.Wrapf("invalid metadata for denom %q: %s", msg.Metadata.Base, err)
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	k.SetDenomMetaData(ctx, msg.Metadata)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeUpdateDenomMetadata,
+			sdk.NewAttribute(types.AttributeKeyDenom, msg.Metadata.Base),
+		),
+	)
+
+	return &types.MsgUpdateDenomMetadataResponse{}, nil
+}
+
+func (k msgServer) CreateBalanceSnapshot(goCtx context.Context, msg *types.MsgCreateBalanceSnapshot) (*types.MsgCreateBalanceSnapshotResponse, error) {
+	if k.GetAuthority() != msg.Authority {
+		return nil, errorsmod.Wrapf(govtypes.ErrInvalidSigner, "invalid authority; expected %s, got %s", k.GetAuthority(), msg.Authority)
+	}
+
+	id, err := k.Keeper.CreateBalanceSnapshot(goCtx, msg.Denom, msg.Height)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.MsgCreateBalanceSnapshotResponse{SnapshotId: id}, nil
+}
+
+func (k msgServer) DeleteBalanceSnapshot(goCtx context.Context, msg *types.MsgDeleteBalanceSnapshot) (*types.MsgDeleteBalanceSnapshotResponse, error) {
+	if k.GetAuthority() != msg.Authority {
+		return nil, errorsmod.Wrapf(govtypes.ErrInvalidSigner, "invalid authority; expected %s, got %s", k.GetAuthority(), msg.Authority)
+	}
+
+	if err := k.Keeper.DeleteBalanceSnapshot(goCtx, msg.SnapshotId); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgDeleteBalanceSnapshotResponse{}, nil
+}
+
+func (k msgServer) SetMintBurnPermissions(goCtx context.Context, msg *types.MsgSetMintBurnPermissions) (*types.MsgSetMintBurnPermissionsResponse, error) {
+	if k.GetAuthority() != msg.Authority {
+		return nil, errorsmod.Wrapf(govtypes.ErrInvalidSigner, "invalid authority; expected %s, got %s", k.GetAuthority(), msg.Authority)
+	}
+
+	seen := map[string]bool{}
+	for _, p := range msg.Permissions {
+		if seen[p.Denom] {
+			return nil, sdkerrors.ErrInvalidRequest.Wrapf("duplicate denom entries found for %q", p.Denom)
+		}
+		seen[p.Denom] = true
+
+		if err := p.Validate(); err != nil {
+			return nil, sdkerrors.ErrInvalidRequest.Wrapf("invalid mint/burn permissions for denom %q: %s", p.Denom, err)
+		}
+	}
+
+	for _, denom := range msg.UnrestrictedDenoms {
+		if err := sdk.ValidateDenom(denom); err != nil {
+			return nil, sdkerrors.ErrInvalidRequest.Wrapf("invalid unrestricted denom %q: %s", denom, err)
+		}
+		if seen[denom] {
+			return nil, sdkerrors.ErrInvalidRequest.Wrapf("denom %q both restricted and unrestricted", denom)
+		}
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	for _, p := range msg.Permissions {
+		k.Keeper.SetMintBurnPermissions(ctx, p)
+	}
+	k.Keeper.DeleteMintBurnPermissions(ctx, msg.UnrestrictedDenoms...)
+
+	return &types.MsgSetMintBurnPermissionsResponse{}, nil
+}
+
+func (k msgServer) SetDustSweepOptIn(goCtx context.Context, msg *types.MsgSetDustSweepOptIn) (*types.MsgSetDustSweepOptInResponse, error) {
+	addr, err := sdk.AccAddressFromBech32(msg.Address)
+	if err != nil {
+		return nil, sdkerrors.ErrInvalidAddress.Wrapf("invalid address: %s", err)
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	if err := k.Keeper.SetDustSweepOptIn(ctx, addr, msg.OptIn); err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeSetDustSweepOptIn,
+			sdk.NewAttribute(types.AttributeKeyAccount, msg.Address),
+			sdk.NewAttribute(types.AttributeKeyOptIn, strconv.FormatBool(msg.OptIn)),
+		),
+	)
+
+	return &types.MsgSetDustSweepOptInResponse{}, nil
+}
+
+// SendWithTimelock implements the Msg/SendWithTimelock method.
+func (k msgServer) SendWithTimelock(goCtx context.Context, msg *types.MsgSendWithTimelock) (*types.MsgSendWithTimelockResponse, error) {
+	from, err := sdk.AccAddressFromBech32(msg.FromAddress)
+	if err != nil {
+		return nil, sdkerrors.ErrInvalidAddress.Wrapf("invalid from address: %s", err)
+	}
+
+	to, err := sdk.AccAddressFromBech32(msg.ToAddress)
+	if err != nil {
+		return nil, sdkerrors.ErrInvalidAddress.Wrapf("invalid to address: %s", err)
+	}
+
+	if !msg.Amount.IsValid() || !msg.Amount.IsAllPositive() {
+		return nil, errorsmod.Wrap(sdkerrors.ErrInvalidCoins, msg.Amount.String())
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	if err := k.IsSendEnabledCoins(ctx, msg.Amount...); err != nil {
+		return nil, err
+	}
+
+	if k.BlockedAddr(to) {
+		return nil, errorsmod.Wrapf(sdkerrors.ErrUnauthorized, "%s is not allowed to receive funds", msg.ToAddress)
+	}
+
+	id, err := k.Keeper.SendWithTimelock(ctx, from, to, msg.Amount, msg.UnlockTime, msg.ExpiryTime)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.MsgSendWithTimelockResponse{TransferId: id}, nil
+}
+
+// ClaimTimelockedTransfer implements the Msg/ClaimTimelockedTransfer method.
+func (k msgServer) ClaimTimelockedTransfer(goCtx context.Context, msg *types.MsgClaimTimelockedTransfer) (*types.MsgClaimTimelockedTransferResponse, error) {
+	recipient, err := sdk.AccAddressFromBech32(msg.Recipient)
+	if err != nil {
+		return nil, sdkerrors.ErrInvalidAddress.Wrapf("invalid recipient address: %s", err)
+	}
+
+	if err := k.Keeper.ClaimTimelockedTransfer(goCtx, recipient, msg.TransferId); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgClaimTimelockedTransferResponse{}, nil
+}
+
+// ReclaimTimelockedTransfer implements the Msg/ReclaimTimelockedTransfer method.
+func (k msgServer) ReclaimTimelockedTransfer(goCtx context.Context, msg *types.MsgReclaimTimelockedTransfer) (*types.MsgReclaimTimelockedTransferResponse, error) {
+	sender, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return nil, sdkerrors.ErrInvalidAddress.Wrapf("invalid sender address: %s", err)
+	}
+
+	if err := k.Keeper.ReclaimTimelockedTransfer(goCtx, sender, msg.TransferId); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgReclaimTimelockedTransferResponse{}, nil
+}
+
+// SetDemurrageSchedule implements the Msg/SetDemurrageSchedule method.
+func (k msgServer) SetDemurrageSchedule(goCtx context.Context, msg *types.MsgSetDemurrageSchedule) (*types.MsgSetDemurrageScheduleResponse, error) {
+	if k.GetAuthority() != msg.Authority {
+		return nil, errorsmod.Wrapf(govtypes.ErrInvalidSigner, "invalid authority; expected %s, got %s", k.GetAuthority(), msg.Authority)
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	s, err := k.Keeper.SetDemurrageSchedule(ctx, msg.Denom, msg.Rate, msg.EpochLength)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeSetDemurrageSchedule,
+			sdk.NewAttribute(types.AttributeKeyDenom, s.Denom),
+			sdk.NewAttribute(types.AttributeKeyRate, s.Rate().String()),
+			sdk.NewAttribute(types.AttributeKeyEpochLength, strconv.FormatInt(s.EpochLength, 10)),
+			sdk.NewAttribute(types.AttributeKeyEpoch, strconv.FormatUint(s.Epoch, 10)),
+		),
+	)
+
+	return &types.MsgSetDemurrageScheduleResponse{}, nil
+}
+
+// SetSpendingLimit implements the Msg/SetSpendingLimit method.
+func (k msgServer) SetSpendingLimit(goCtx context.Context, msg *types.MsgSetSpendingLimit) (*types.MsgSetSpendingLimitResponse, error) {
+	addr, err := sdk.AccAddressFromBech32(msg.Address)
+	if err != nil {
+		return nil, sdkerrors.ErrInvalidAddress.Wrapf("invalid address: %s", err)
+	}
+
+	effectiveTime, err := k.Keeper.SetSpendingLimit(goCtx, addr, msg.Limits, msg.Window)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.MsgSetSpendingLimitResponse{EffectiveTime: effectiveTime}, nil
+}
@@ -1,6 +1,11 @@
 package keeper_test
 
 import (
+	"strings"
+
+	abci "github.com/cometbft/cometbft/abci/types"
+	"github.com/cosmos/gogoproto/proto"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
@@ -255,6 +260,110 @@ func (suite *KeeperTestSuite) TestMsgMultiSend() {
 	}
 }
 
+func (suite *KeeperTestSuite) TestMsgBatchSend() {
+	origDenom := "sendableCoin"
+	origCoins := sdk.NewCoins(sdk.NewInt64Coin(origDenom, 100))
+	sendCoins := sdk.NewCoins(sdk.NewInt64Coin(origDenom, 30))
+	suite.bankKeeper.SetSendEnabled(suite.ctx, origDenom, true)
+
+	testCases := []struct {
+		name      string
+		input     *banktypes.MsgBatchSend
+		expErr    bool
+		expErrMsg string
+	}{
+		{
+			name:      "invalid from address",
+			input:     &banktypes.MsgBatchSend{FromAddress: "invalid"},
+			expErr:    true,
+			expErrMsg: "invalid from address",
+		},
+		{
+			name:      "no outputs to send transaction",
+			input:     banktypes.NewMsgBatchSend(minterAcc.GetAddress(), nil),
+			expErr:    true,
+			expErrMsg: "no outputs to send transaction",
+		},
+		{
+			name: "memo too large",
+			input: banktypes.NewMsgBatchSend(minterAcc.GetAddress(), []banktypes.BatchOutput{
+				banktypes.NewBatchOutput(accAddrs[0], sendCoins, strings.Repeat("m", banktypes.MaxBatchOutputMemoLength+1)),
+			}),
+			expErr:    true,
+			expErrMsg: "memo too large",
+		},
+		{
+			name: "invalid send to blocked address",
+			input: banktypes.NewMsgBatchSend(minterAcc.GetAddress(), []banktypes.BatchOutput{
+				banktypes.NewBatchOutput(accAddrs[0], sendCoins, ""),
+				banktypes.NewBatchOutput(accAddrs[4], sendCoins, ""),
+			}),
+			expErr:    true,
+			expErrMsg: "is not allowed to receive funds",
+		},
+		{
+			name: "insufficient funds",
+			input: banktypes.NewMsgBatchSend(minterAcc.GetAddress(), []banktypes.BatchOutput{
+				banktypes.NewBatchOutput(accAddrs[0], sdk.NewCoins(sdk.NewInt64Coin(origDenom, 1000)), ""),
+				banktypes.NewBatchOutput(accAddrs[1], sdk.NewCoins(sdk.NewInt64Coin(origDenom, 1000)), ""),
+			}),
+			expErr:    true,
+			expErrMsg: "insufficient funds",
+		},
+		{
+			name: "valid batch send",
+			input: banktypes.NewMsgBatchSend(minterAcc.GetAddress(), []banktypes.BatchOutput{
+				banktypes.NewBatchOutput(accAddrs[0], sendCoins, "withdrawal-1"),
+				banktypes.NewBatchOutput(accAddrs[1], sendCoins, ""),
+			}),
+			expErr: false,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		suite.Run(tc.name, func() {
+			suite.mockMintCoins(minterAcc)
+			suite.Require().NoError(suite.bankKeeper.MintCoins(suite.ctx, minterAcc.Name, origCoins))
+
+			ctx := sdk.UnwrapSDKContext(suite.ctx).WithEventManager(sdk.NewEventManager())
+			if tc.expErrMsg == "insufficient funds" {
+				suite.authKeeper.EXPECT().GetAccount(ctx, minterAcc.GetAddress()).Return(minterAcc)
+			} else if !tc.expErr {
+				suite.authKeeper.EXPECT().GetAccount(ctx, minterAcc.GetAddress()).Return(minterAcc)
+				suite.authKeeper.EXPECT().HasAccount(ctx, accAddrs[0]).Return(true)
+				suite.authKeeper.EXPECT().HasAccount(ctx, accAddrs[1]).Return(true)
+			}
+
+			_, err := suite.msgServer.BatchSend(ctx, tc.input)
+			if tc.expErr {
+				suite.Require().Error(err)
+				suite.Require().Contains(err.Error(), tc.expErrMsg)
+				return
+			}
+			suite.Require().NoError(err)
+
+			var transfers []*banktypes.EventBatchTransfer
+			for _, event := range ctx.EventManager().Events() {
+				if event.Type != proto.MessageName(&banktypes.EventBatchTransfer{}) {
+					continue
+				}
+				msg, err := sdk.ParseTypedEvent(abci.Event(event))
+				suite.Require().NoError(err)
+				transfers = append(transfers, msg.(*banktypes.EventBatchTransfer))
+			}
+			suite.Require().Len(transfers, 2)
+			for i, out := range tc.input.Outputs {
+				suite.Require().Equal(minterAcc.GetAddress().String(), transfers[i].Sender)
+				suite.Require().Equal(out.Address, transfers[i].Recipient)
+				suite.Require().Equal(out.Coins, transfers[i].Amount)
+				suite.Require().Equal(out.Memo, transfers[i].Memo)
+				suite.Require().Equal(uint32(i), transfers[i].Index)
+			}
+		})
+	}
+}
+
 func (suite *KeeperTestSuite) TestMsgSetSendEnabled() {
 	testCases := []struct {
 		name     string
@@ -360,3 +469,130 @@ func (suite *KeeperTestSuite) TestMsgSetSendEnabled() {
 		})
 	}
 }
+
+func (suite *KeeperTestSuite) TestMsgUpdateDenomMetadata() {
+	metadata := banktypes.Metadata{
+		Name:   "Cosmos Hub Atom",
+		Symbol: "ATOM",
+		DenomUnits: []*banktypes.DenomUnit{
+			{Denom: "uatom", Exponent: 0},
+			{Denom: "atom", Exponent: 6},
+		},
+		Base:    "uatom",
+		Display: "atom",
+	}
+
+	// the wrong exponent is fixed by the update
+	wrongMetadata := metadata
+	wrongMetadata.DenomUnits = []*banktypes.DenomUnit{
+		{Denom: "uatom", Exponent: 0},
+		{Denom: "atom", Exponent: 8},
+	}
+	suite.bankKeeper.SetDenomMetaData(suite.ctx, wrongMetadata)
+
+	invalidMetadata := metadata
+	invalidMetadata.Display = "matom"
+
+	testCases := []struct {
+		name     string
+		req      *banktypes.MsgUpdateDenomMetadata
+		isExpErr bool
+		errMsg   string
+	}{
+		{
+			name:     "invalid authority",
+			req:      banktypes.NewMsgUpdateDenomMetadata("invalid", metadata),
+			isExpErr: true,
+			errMsg:   "invalid authority",
+		},
+		{
+			name:     "invalid metadata",
+			req:      banktypes.NewMsgUpdateDenomMetadata(govAcc.GetAddress().String(), invalidMetadata),
+			isExpErr: true,
+			errMsg:   `invalid metadata for denom "uatom"`,
+		},
+		{
+			name: "all good",
+			req:  banktypes.NewMsgUpdateDenomMetadata(govAcc.GetAddress().String(), metadata),
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			_, err := suite.msgServer.UpdateDenomMetadata(suite.ctx, tc.req)
+
+			stored, found := suite.bankKeeper.GetDenomMetaData(suite.ctx, "uatom")
+			suite.Require().True(found)
+			if tc.isExpErr {
+				suite.Require().Error(err)
+				suite.Require().Contains(err.Error(), tc.errMsg)
+				suite.Require().Equal(wrongMetadata, stored)
+			} else {
+				suite.Require().NoError(err)
+				suite.Require().Equal(metadata, stored)
+			}
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestMsgSetMintBurnPermissions() {
+	authority := govAcc.GetAddress().String()
+	permissions := banktypes.NewMintBurnPermissions("foocoin", []string{"mint"}, []string{"mint", "distribution"})
+	suite.bankKeeper.SetMintBurnPermissions(suite.ctx, banktypes.NewMintBurnPermissions("barcoin", nil, nil))
+
+	testCases := []struct {
+		name     string
+		req      *banktypes.MsgSetMintBurnPermissions
+		isExpErr bool
+		errMsg   string
+	}{
+		{
+			name:     "invalid authority",
+			req:      banktypes.NewMsgSetMintBurnPermissions("invalid", []banktypes.MintBurnPermissions{permissions}, nil),
+			isExpErr: true,
+			errMsg:   "invalid authority",
+		},
+		{
+			name:     "duplicate denoms",
+			req:      banktypes.NewMsgSetMintBurnPermissions(authority, []banktypes.MintBurnPermissions{permissions, permissions}, nil),
+			isExpErr: true,
+			errMsg:   `duplicate denom entries found for "foocoin"`,
+		},
+		{
+			name: "duplicate minters",
+			req: banktypes.NewMsgSetMintBurnPermissions(authority, []banktypes.MintBurnPermissions{
+				banktypes.NewMintBurnPermissions("foocoin", []string{"mint", "mint"}, nil),
+			}, nil),
+			isExpErr: true,
+			errMsg:   `invalid mint/burn permissions for denom "foocoin"`,
+		},
+		{
+			name:     "restricted and unrestricted denom",
+			req:      banktypes.NewMsgSetMintBurnPermissions(authority, []banktypes.MintBurnPermissions{permissions}, []string{"foocoin"}),
+			isExpErr: true,
+			errMsg:   `denom "foocoin" both restricted and unrestricted`,
+		},
+		{
+			name: "all good",
+			req:  banktypes.NewMsgSetMintBurnPermissions(authority, []banktypes.MintBurnPermissions{permissions}, []string{"barcoin"}),
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			_, err := suite.msgServer.SetMintBurnPermissions(suite.ctx, tc.req)
+			if tc.isExpErr {
+				suite.Require().Error(err)
+				suite.Require().Contains(err.Error(), tc.errMsg)
+				return
+			}
+
+			suite.Require().NoError(err)
+			stored, found := suite.bankKeeper.GetMintBurnPermissions(suite.ctx, "foocoin")
+			suite.Require().True(found)
+			suite.Require().Equal(permissions, stored)
+			_, found = suite.bankKeeper.GetMintBurnPermissions(suite.ctx, "barcoin")
+			suite.Require().False(found)
+		})
+	}
+}
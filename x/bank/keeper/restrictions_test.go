@@ -0,0 +1,73 @@
+package keeper_test
+
+import (
+	"context"
+
+	"github.com/golang/mock/gomock"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	banktestutil "github.com/cosmos/cosmos-sdk/x/bank/testutil"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+)
+
+func (suite *KeeperTestSuite) TestSendRestrictions() {
+	ctx := suite.ctx
+	require := suite.Require()
+
+	acc0 := authtypes.NewBaseAccountWithAddress(accAddrs[0])
+	suite.authKeeper.EXPECT().GetAccount(gomock.Any(), accAddrs[0]).Return(acc0).AnyTimes()
+	suite.authKeeper.EXPECT().HasAccount(gomock.Any(), gomock.Any()).Return(true).AnyTimes()
+
+	suite.mockFundAccount(accAddrs[0])
+	require.NoError(banktestutil.FundAccount(ctx, suite.bankKeeper, accAddrs[0], sdk.NewCoins(newFooCoin(100), newBarCoin(50))))
+
+	redirect := func(from, to sdk.AccAddress) banktypes.SendRestrictionFn {
+		return func(_ context.Context, _, toAddr sdk.AccAddress, _ sdk.Coins) (sdk.AccAddress, error) {
+			if toAddr.Equals(from) {
+				return to, nil
+			}
+			return toAddr, nil
+		}
+	}
+	denyBar := func(_ context.Context, _, toAddr sdk.AccAddress, amt sdk.Coins) (sdk.AccAddress, error) {
+		if !amt.AmountOf(barDenom).IsZero() {
+			return nil, sdkerrors.ErrUnauthorized.Wrap("bar cannot be sent")
+		}
+		return toAddr, nil
+	}
+
+	suite.bankKeeper.AppendSendRestriction(denyBar)
+	suite.bankKeeper.AppendSendRestriction(redirect(accAddrs[1], accAddrs[2]))
+	defer suite.bankKeeper.ClearSendRestriction()
+
+	// the transfers are denied or redirected
+	require.ErrorIs(suite.bankKeeper.SendCoins(ctx, accAddrs[0], accAddrs[1], sdk.NewCoins(newBarCoin(10))), sdkerrors.ErrUnauthorized)
+	require.NoError(suite.bankKeeper.SendCoins(ctx, accAddrs[0], accAddrs[1], sdk.NewCoins(newFooCoin(10))))
+	require.True(suite.bankKeeper.GetAllBalances(ctx, accAddrs[1]).IsZero())
+	require.Equal(sdk.NewCoins(newFooCoin(10)), suite.bankKeeper.GetAllBalances(ctx, accAddrs[2]))
+
+	input := banktypes.Input{Address: accAddrs[0].String(), Coins: sdk.NewCoins(newFooCoin(20))}
+	outputs := []banktypes.Output{
+		{Address: accAddrs[1].String(), Coins: sdk.NewCoins(newFooCoin(10))},
+		{Address: accAddrs[3].String(), Coins: sdk.NewCoins(newFooCoin(10))},
+	}
+	require.NoError(suite.bankKeeper.InputOutputCoins(ctx, input, outputs))
+	require.True(suite.bankKeeper.GetAllBalances(ctx, accAddrs[1]).IsZero())
+	require.Equal(sdk.NewCoins(newFooCoin(20)), suite.bankKeeper.GetAllBalances(ctx, accAddrs[2]))
+	require.Equal(sdk.NewCoins(newFooCoin(10)), suite.bankKeeper.GetAllBalances(ctx, accAddrs[3]))
+
+	// a prepended restriction is applied first
+	suite.bankKeeper.PrependSendRestriction(redirect(accAddrs[3], accAddrs[1]))
+	require.NoError(suite.bankKeeper.SendCoins(ctx, accAddrs[0], accAddrs[3], sdk.NewCoins(newFooCoin(10))))
+	require.Equal(sdk.NewCoins(newFooCoin(30)), suite.bankKeeper.GetAllBalances(ctx, accAddrs[2]))
+
+	// the restrictions also apply to the module to account transfers
+	suite.authKeeper.EXPECT().GetModuleAddress(mintAcc.Name).Return(mintAcc.GetAddress())
+	require.ErrorIs(suite.bankKeeper.SendCoinsFromModuleToAccount(ctx, mintAcc.Name, accAddrs[1], sdk.NewCoins(newBarCoin(10))), sdkerrors.ErrUnauthorized)
+
+	suite.bankKeeper.ClearSendRestriction()
+	require.NoError(suite.bankKeeper.SendCoins(ctx, accAddrs[0], accAddrs[1], sdk.NewCoins(newBarCoin(10))))
+	require.Equal(sdk.NewCoins(newBarCoin(10)), suite.bankKeeper.GetAllBalances(ctx, accAddrs[1]))
+}
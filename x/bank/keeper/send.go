@@ -3,6 +3,7 @@ package keeper
 import (
 	"context"
 	"fmt"
+	"time"
 
 	"cosmossdk.io/collections"
 	"cosmossdk.io/core/store"
@@ -22,7 +23,14 @@ import (
 type SendKeeper interface {
 	ViewKeeper
 
+	AppendSendRestriction(restriction types.SendRestrictionFn)
+	PrependSendRestriction(restriction types.SendRestrictionFn)
+	ClearSendRestriction()
+
+	SetHooks(hooks types.BankHooks)
+
 	InputOutputCoins(ctx context.Context, inputs types.Input, outputs []types.Output) error
+	BatchSendCoins(ctx context.Context, fromAddr sdk.AccAddress, outputs []types.BatchOutput) error
 	SendCoins(ctx context.Context, fromAddr, toAddr sdk.AccAddress, amt sdk.Coins) error
 
 	GetParams(ctx context.Context) types.Params
@@ -36,6 +44,12 @@ type SendKeeper interface {
 	IterateSendEnabledEntries(ctx context.Context, cb func(denom string, sendEnabled bool) (stop bool))
 	GetAllSendEnabledEntries(ctx context.Context) []types.SendEnabled
 
+	HoldCoins(ctx context.Context, addr sdk.AccAddress, holder string, amt sdk.Coins, expiration *time.Time) error
+	ReleaseHold(ctx context.Context, addr sdk.AccAddress, holder string, amt sdk.Coins) error
+	SendHeldCoins(ctx context.Context, fromAddr sdk.AccAddress, holder string, toAddr sdk.AccAddress, amt sdk.Coins) error
+
+	SetSpendingLimit(ctx context.Context, addr sdk.AccAddress, limits sdk.Coins, window time.Duration) (time.Time, error)
+
 	IsSendEnabledCoin(ctx context.Context, coin sdk.Coin) bool
 	IsSendEnabledCoins(ctx context.Context, coins ...sdk.Coin) error
 
@@ -60,6 +74,14 @@ type BaseSendKeeper struct {
 	// list of addresses that are restricted from receiving transactions
 	blockedAddrs map[string]bool
 
+	// the restriction applied to the transfers, shared by the copies of the
+	// keeper so that it can be registered after the keeper is passed around
+	sendRestriction *sendRestriction
+
+	// the hooks called on the balance changes, shared by the copies of the
+	// keeper like the send restriction
+	hooks *bankHooks
+
 	// the address capable of executing a MsgUpdateParams message. Typically, this
 	// should be the x/gov module account.
 	authority string
@@ -77,15 +99,52 @@ func NewBaseSendKeeper(
 		panic(fmt.Errorf("invalid bank authority address: %w", err))
 	}
 
-	return BaseSendKeeper{
-		BaseViewKeeper: NewBaseViewKeeper(cdc, storeService, ak, logger),
+	viewKeeper := NewBaseViewKeeper(cdc, storeService, ak, logger)
+	viewKeeper.blockedAddrs = blockedAddrs
+
+	k := BaseSendKeeper{
+		BaseViewKeeper: viewKeeper,
 		cdc:            cdc,
 		ak:             ak,
 		storeService:   storeService,
 		blockedAddrs:   blockedAddrs,
 		authority:      authority,
 		logger:         logger,
+
+		sendRestriction: newSendRestriction(),
+		hooks:           &bankHooks{},
 	}
+	k.sendRestriction.spendingLimit = k.spendingLimitRestriction
+
+	return k
+}
+
+// AppendSendRestriction adds a restriction applied to the transfers of coins
+// after the already registered ones.
+func (k BaseSendKeeper) AppendSendRestriction(restriction types.SendRestrictionFn) {
+	k.sendRestriction.append(restriction)
+}
+
+// PrependSendRestriction adds a restriction applied to the transfers of coins
+// before the already registered ones.
+func (k BaseSendKeeper) PrependSendRestriction(restriction types.SendRestrictionFn) {
+	k.sendRestriction.prepend(restriction)
+}
+
+// ClearSendRestriction removes all the restrictions applied to the transfers
+// of coins. The spending limits of the accounts are still enforced.
+func (k BaseSendKeeper) ClearSendRestriction() {
+	k.sendRestriction.clear()
+}
+
+// SetHooks sets the hooks called on the balance changes. It panics if the
+// hooks are already set.
+func (k BaseSendKeeper) SetHooks(hooks types.BankHooks) {
+	if k.hooks.hooks != nil {
+		panic("cannot set bank hooks twice")
+	}
+
+	k.hooks.hooks = hooks
 }
 
 // GetAuthority returns the x/bank module's authority.
@@ -149,6 +208,11 @@ func (k BaseSendKeeper) InputOutputCoins(ctx context.Context, input types.Input,
 			return err
 		}
 
+		outAddress, err = k.sendRestriction.apply(ctx, inAddress, outAddress, out.Coins)
+		if err != nil {
+			return err
+		}
+
 		if err := k.addCoins(ctx, outAddress, out.Coins); err != nil {
 			return err
 		}
@@ -156,7 +220,7 @@ func (k BaseSendKeeper) InputOutputCoins(ctx context.Context, input types.Input,
 		sdkCtx.EventManager().EmitEvent(
 			sdk.NewEvent(
 				types.EventTypeTransfer,
-				sdk.NewAttribute(types.AttributeKeyRecipient, out.Address),
+				sdk.NewAttribute(types.AttributeKeyRecipient, outAddress.String()),
 				sdk.NewAttribute(sdk.AttributeKeyAmount, out.Coins.String()),
 			),
 		)
@@ -175,10 +239,81 @@ func (k BaseSendKeeper) InputOutputCoins(ctx context.Context, input types.Input,
 	return nil
 }
 
-// SendCoins transfers amt coins from a sending account to a receiving account.
-// An error is returned upon failure.
+// BatchSendCoins sends coins from an account to many accounts. The total of the
+// outputs is deducted from the sender at once, and an EventBatchTransfer is
+// emitted for each output along with its memo. It returns an error if any
+// output is invalid or if any single transfer of tokens fails.
+func (k BaseSendKeeper) BatchSendCoins(ctx context.Context, fromAddr sdk.AccAddress, outputs []types.BatchOutput) error {
+	if len(outputs) == 0 {
+		return types.ErrNoOutputs
+	}
+
+	var total sdk.Coins
+	for _, out := range outputs {
+		if err := out.ValidateBasic(); err != nil {
+			return err
+		}
+		total = total.Add(out.Coins...)
+	}
+
+	if err := k.subUnlockedCoins(ctx, fromAddr, total); err != nil {
+		return err
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	sdkCtx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(types.AttributeKeySender, fromAddr.String()),
+		),
+	)
+
+	for i, out := range outputs {
+		outAddress, err := sdk.AccAddressFromBech32(out.Address)
+		if err != nil {
+			return err
+		}
+
+		outAddress, err = k.sendRestriction.apply(ctx, fromAddr, outAddress, out.Coins)
+		if err != nil {
+			return err
+		}
+
+		if err := k.addCoins(ctx, outAddress, out.Coins); err != nil {
+			return err
+		}
+
+		if err := sdkCtx.EventManager().EmitTypedEvent(&types.EventBatchTransfer{
+			Sender:    fromAddr.String(),
+			Recipient: outAddress.String(),
+			Amount:    out.Coins,
+			Memo:      out.Memo,
+			Index:     uint32(i),
+		}); err != nil {
+			return err
+		}
+
+		// Create account if recipient does not exist.
+		accExists := k.ak.HasAccount(ctx, outAddress)
+		if !accExists {
+			defer telemetry.IncrCounter(1, "new", "account")
+			k.ak.SetAccount(ctx, k.ak.NewAccountWithAddress(ctx, outAddress))
+		}
+	}
+
+	return nil
+}
+
+// SendCoins transfers amt coins from a sending account to a receiving account,
+// or to the account the send restrictions redirect them to. An error is
+// returned upon failure.
 func (k BaseSendKeeper) SendCoins(ctx context.Context, fromAddr, toAddr sdk.AccAddress, amt sdk.Coins) error {
-	err := k.subUnlockedCoins(ctx, fromAddr, amt)
+	toAddr, err := k.sendRestriction.apply(ctx, fromAddr, toAddr, amt)
+	if err != nil {
+		return err
+	}
+
+	err = k.subUnlockedCoins(ctx, fromAddr, amt)
 	if err != nil {
 		return err
 	}
@@ -225,19 +360,22 @@ func (k BaseSendKeeper) subUnlockedCoins(ctx context.Context, addr sdk.AccAddres
 		return errorsmod.Wrap(sdkerrors.ErrInvalidCoins, amt.String())
 	}
 
-	lockedCoins := k.LockedCoins(ctx, addr)
+	lockedCoins := k.unspendableCoins(ctx, addr)
 
 	for _, coin := range amt {
-		balance := k.GetBalance(ctx, addr, coin.Denom)
-		locked := sdk.NewCoin(coin.Denom, lockedCoins.AmountOf(coin.Denom))
+		balance, err := k.settleDemurrage(ctx, addr, coin.Denom)
+		if err != nil {
+			return err
+		}
+		locked := sdk.NewCoin(coin.Denom, lockedCoins.AmountOfNoDenomValidation(coin.Denom))
 
-		spendable, hasNeg := sdk.Coins{balance}.SafeSub(locked)
-		if hasNeg {
+		spendable, err := balance.SafeSub(locked)
+		if err != nil {
 			return errorsmod.Wrapf(sdkerrors.ErrInsufficientFunds,
 				"locked amount exceeds account balance funds: %s > %s", locked, balance)
 		}
 
-		if _, hasNeg := spendable.SafeSub(coin); hasNeg {
+		if spendable.IsLT(coin) {
 			return errorsmod.Wrapf(
 				sdkerrors.ErrInsufficientFunds,
 				"spendable balance %s is smaller than %s",
@@ -268,11 +406,13 @@ func (k BaseSendKeeper) addCoins(ctx context.Context, addr sdk.AccAddress, amt s
 	}
 
 	for _, coin := range amt {
-		balance := k.GetBalance(ctx, addr, coin.Denom)
+		balance, err := k.settleDemurrage(ctx, addr, coin.Denom)
+		if err != nil {
+			return err
+		}
 		newBalance := balance.Add(coin)
 
-		err := k.setBalance(ctx, addr, newBalance)
-		if err != nil {
+		if err := k.setBalance(ctx, addr, newBalance); err != nil {
 			return err
 		}
 	}
@@ -286,21 +426,42 @@ func (k BaseSendKeeper) addCoins(ctx context.Context, addr sdk.AccAddress, amt s
 	return nil
 }
 
-// setBalance sets the coin balance for an account by address.
+// setBalance sets the coin balance for an account by address, and calls the
+// hooks, if any, with the previous and new balances. The pending decay of a
+// demurrage denomination must be settled beforehand.
 func (k BaseSendKeeper) setBalance(ctx context.Context, addr sdk.AccAddress, balance sdk.Coin) error {
 	if !balance.IsValid() {
 		return errorsmod.Wrap(sdkerrors.ErrInvalidCoins, balance.String())
 	}
 
+	hooks := k.hooks.get()
+	var oldBalance sdk.Coin
+	if hooks != nil {
+		oldBalance = k.storedBalance(ctx, addr, balance.Denom)
+	}
+
 	// x/bank invariants prohibit persistence of zero balances
 	if balance.IsZero() {
 		err := k.Balances.Remove(ctx, collections.Join(addr, balance.Denom))
 		if err != nil {
 			return err
 		}
+
+		// an empty balance has no decay to settle anymore
+		if k.hasDemurrageSchedule(ctx, balance.Denom) {
+			if err := k.DemurrageAccountEpochs.Remove(ctx, collections.Join(addr, balance.Denom)); err != nil {
+				return err
+			}
+		}
+	} else if err := k.Balances.Set(ctx, collections.Join(addr, balance.Denom), balance.Amount); err != nil {
+		return err
+	}
+
+	if hooks == nil || oldBalance.Amount.Equal(balance.Amount) {
 		return nil
 	}
-	return k.Balances.Set(ctx, collections.Join(addr, balance.Denom), balance.Amount)
+
+	return hooks.AfterBalanceChange(ctx, addr, balance.Denom, oldBalance.Amount, balance.Amount)
 }
 
 // IsSendEnabledCoins checks the coins provided and returns an ErrSendDisabled
@@ -425,3 +586,57 @@ func (k BaseSendKeeper) getSendEnabledOrDefault(ctx context.Context, denom strin
 
 	return defaultVal
 }
+
+// bankHooks holds the hooks called on the balance changes. It is shared by
+// pointer so that the copies of the keeper see the hooks set after them.
+type bankHooks struct {
+	hooks types.BankHooks
+}
+
+func (h *bankHooks) get() types.BankHooks {
+	if h == nil {
+		return nil
+	}
+
+	return h.hooks
+}
+
+// sendRestriction is the restriction applied to the transfers of coins.
+type sendRestriction struct {
+	fn types.SendRestrictionFn
+
+	// spendingLimit enforces the spending limits of the accounts before the
+	// registered restrictions, and isn't cleared with them
+	spendingLimit types.SendRestrictionFn
+}
+
+func newSendRestriction() *sendRestriction {
+	return &sendRestriction{}
+}
+
+func (r *sendRestriction) append(restriction types.SendRestrictionFn) {
+	r.fn = r.fn.Then(restriction)
+}
+
+func (r *sendRestriction) prepend(restriction types.SendRestrictionFn) {
+	r.fn = restriction.Then(r.fn)
+}
+
+func (r *sendRestriction) clear() {
+	r.fn = nil
+}
+
+// apply applies the restriction, if any, to a transfer and returns the address
+// the coins must be sent to.
+func (r *sendRestriction) apply(ctx context.Context, fromAddr, toAddr sdk.AccAddress, amt sdk.Coins) (sdk.AccAddress, error) {
+	if r == nil {
+		return toAddr, nil
+	}
+
+	fn := r.spendingLimit.Then(r.fn)
+	if fn == nil {
+		return toAddr, nil
+	}
+
+	return fn(ctx, fromAddr, toAddr, amt)
+}
@@ -0,0 +1,135 @@
+package keeper
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"cosmossdk.io/collections"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/bank/types"
+)
+
+// GetSpendingLimit returns the spending limit of an account, as stored, i.e.
+// without applying its pending change nor pruning its spending records.
+func (k BaseViewKeeper) GetSpendingLimit(ctx context.Context, addr sdk.AccAddress) (types.SpendingLimit, bool) {
+	l, err := k.SpendingLimits.Get(ctx, addr)
+	if err != nil {
+		if !errors.Is(err, collections.ErrNotFound) {
+			panic(err)
+		}
+		return types.SpendingLimit{}, false
+	}
+
+	return l, true
+}
+
+// IterateSpendingLimits iterates over the spending limits, in address order,
+// and calls the provided callback. If true is returned from the callback,
+// iteration is halted.
+func (k BaseViewKeeper) IterateSpendingLimits(ctx context.Context, cb func(l types.SpendingLimit) (stop bool)) {
+	err := k.SpendingLimits.Walk(ctx, nil, func(_ sdk.AccAddress, l types.SpendingLimit) (stop bool) {
+		return cb(l)
+	})
+	if err != nil {
+		panic(err)
+	}
+}
+
+// currentSpendingLimit returns the spending limit of an account at the block
+// time, i.e. with its pending change applied if it took effect, and without
+// the coins sent before its window.
+func (k BaseViewKeeper) currentSpendingLimit(ctx context.Context, addr sdk.AccAddress) (types.SpendingLimit, bool) {
+	l, found := k.GetSpendingLimit(ctx, addr)
+	if !found {
+		return l, false
+	}
+
+	blockTime := sdk.UnwrapSDKContext(ctx).BlockTime()
+	if !l.ApplyPending(blockTime) {
+		return types.SpendingLimit{}, false
+	}
+	l.Prune(blockTime)
+
+	return l, true
+}
+
+// SetSpendingLimit attaches, changes or removes the spending limit of an
+// account, limiting the coins of each denomination of limits it can send
+// within any window. The spending limit is removed if limits is empty.
+//
+// A new spending limit, or a change tightening the existing one, takes effect
+// immediately. A change loosening the existing spending limit, including its
+// removal, only takes effect after a window of the existing spending limit, so
+// that a compromised key cannot lift the limit at once. It returns the time
+// from which the change takes effect.
+func (k BaseSendKeeper) SetSpendingLimit(ctx context.Context, addr sdk.AccAddress, limits sdk.Coins, window time.Duration) (time.Time, error) {
+	if err := types.ValidateSpendingLimit(limits, window); err != nil {
+		return time.Time{}, err
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	blockTime := sdkCtx.BlockTime()
+	effectiveTime := blockTime
+
+	l, found := k.currentSpendingLimit(ctx, addr)
+	switch {
+	case !found && limits.Empty():
+		return time.Time{}, types.ErrSpendingLimitNotFound.Wrapf("no spending limit to remove for %s", addr)
+	case !found:
+		l = types.NewSpendingLimit(addr, limits, window)
+	case l.IsLoosenedBy(limits, window):
+		effectiveTime = blockTime.Add(l.Window)
+		l.Pending = &types.PendingSpendingLimit{
+			Limits:        limits,
+			Window:        window,
+			EffectiveTime: effectiveTime,
+		}
+	default:
+		// a tightening change replaces the pending change, if any, and keeps
+		// the coins sent within the window
+		l.Limits, l.Window, l.Pending = limits, window, nil
+		l.Prune(blockTime)
+	}
+
+	if err := k.SpendingLimits.Set(ctx, addr, l); err != nil {
+		return time.Time{}, err
+	}
+
+	sdkCtx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeSetSpendingLimit,
+			sdk.NewAttribute(types.AttributeKeyAccount, addr.String()),
+			sdk.NewAttribute(types.AttributeKeyLimits, limits.String()),
+			sdk.NewAttribute(types.AttributeKeyWindow, window.String()),
+			sdk.NewAttribute(types.AttributeKeyEffectiveTime, effectiveTime.String()),
+		),
+	)
+
+	return effectiveTime, nil
+}
+
+// spendingLimitRestriction is the send restriction enforcing the spending
+// limit of the sender, if any. The coins it sends are recorded within the
+// window of its spending limit, and the transfer is denied if they exceed the
+// limit of a denomination.
+func (k BaseSendKeeper) spendingLimitRestriction(ctx context.Context, fromAddr, toAddr sdk.AccAddress, amt sdk.Coins) (sdk.AccAddress, error) {
+	l, found := k.GetSpendingLimit(ctx, fromAddr)
+	if !found {
+		return toAddr, nil
+	}
+
+	blockTime := sdk.UnwrapSDKContext(ctx).BlockTime()
+	if !l.ApplyPending(blockTime) {
+		// the pending removal of the spending limit took effect
+		return toAddr, k.SpendingLimits.Remove(ctx, fromAddr)
+	}
+	l.Prune(blockTime)
+
+	if err := l.Spend(blockTime, amt); err != nil {
+		return nil, err
+	}
+
+	return toAddr, k.SpendingLimits.Set(ctx, fromAddr, l)
+}
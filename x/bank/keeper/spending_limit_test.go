@@ -0,0 +1,84 @@
+package keeper_test
+
+import (
+	"time"
+
+	"github.com/golang/mock/gomock"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	banktestutil "github.com/cosmos/cosmos-sdk/x/bank/testutil"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+)
+
+func (suite *KeeperTestSuite) TestSpendingLimits() {
+	ctx := sdk.UnwrapSDKContext(suite.ctx)
+	require := suite.Require()
+
+	acc0 := authtypes.NewBaseAccountWithAddress(accAddrs[0])
+	suite.authKeeper.EXPECT().GetAccount(gomock.Any(), accAddrs[0]).Return(acc0).AnyTimes()
+	suite.authKeeper.EXPECT().HasAccount(gomock.Any(), gomock.Any()).Return(true).AnyTimes()
+
+	suite.mockFundAccount(accAddrs[0])
+	require.NoError(banktestutil.FundAccount(ctx, suite.bankKeeper, accAddrs[0], sdk.NewCoins(newFooCoin(100), newBarCoin(50))))
+
+	foo := func(amt int64) sdk.Coins { return sdk.NewCoins(newFooCoin(amt)) }
+	setLimit := func(ctx sdk.Context, limits sdk.Coins, window time.Duration) (time.Time, error) {
+		res, err := suite.msgServer.SetSpendingLimit(ctx, banktypes.NewMsgSetSpendingLimit(accAddrs[0], limits, window))
+		if err != nil {
+			return time.Time{}, err
+		}
+		return res.EffectiveTime, nil
+	}
+
+	_, err := setLimit(ctx, nil, 0)
+	require.ErrorIs(err, banktypes.ErrSpendingLimitNotFound)
+	_, err = setLimit(ctx, foo(30), 0)
+	require.ErrorIs(err, banktypes.ErrInvalidSpendingLimit)
+
+	// a new spending limit takes effect immediately
+	effectiveTime, err := setLimit(ctx, foo(30), time.Hour)
+	require.NoError(err)
+	require.Equal(ctx.BlockTime(), effectiveTime)
+
+	// only the limited denominations are limited
+	require.NoError(suite.bankKeeper.SendCoins(ctx, accAddrs[0], accAddrs[1], foo(20)))
+	require.ErrorIs(suite.bankKeeper.SendCoins(ctx, accAddrs[0], accAddrs[1], foo(15)), banktypes.ErrSpendingLimitExceeded)
+	require.NoError(suite.bankKeeper.SendCoins(ctx, accAddrs[0], accAddrs[1], sdk.NewCoins(newBarCoin(40))))
+
+	res, err := suite.queryClient.SpendingLimit(ctx, &banktypes.QuerySpendingLimitRequest{Address: accAddrs[0].String()})
+	require.NoError(err)
+	require.Equal(foo(10), res.Remaining)
+	require.Equal(foo(20), res.SpendingLimit.SpentAmount())
+
+	// a loosening change only takes effect after a window
+	effectiveTime, err = setLimit(ctx, foo(50), time.Hour)
+	require.NoError(err)
+	require.Equal(ctx.BlockTime().Add(time.Hour), effectiveTime)
+	require.ErrorIs(suite.bankKeeper.SendCoins(ctx, accAddrs[0], accAddrs[1], foo(15)), banktypes.ErrSpendingLimitExceeded)
+
+	// a tightening change takes effect immediately, replacing the pending change
+	_, err = setLimit(ctx, foo(20), 2*time.Hour)
+	require.NoError(err)
+	l, found := suite.bankKeeper.GetSpendingLimit(ctx, accAddrs[0])
+	require.True(found)
+	require.Nil(l.Pending)
+	require.ErrorIs(suite.bankKeeper.SendCoins(ctx, accAddrs[0], accAddrs[1], foo(1)), banktypes.ErrSpendingLimitExceeded)
+
+	// the coins sent before the window are no longer counted
+	ctx = ctx.WithBlockTime(ctx.BlockTime().Add(2 * time.Hour))
+	require.NoError(suite.bankKeeper.SendCoins(ctx, accAddrs[0], accAddrs[1], foo(20)))
+	l, _ = suite.bankKeeper.GetSpendingLimit(ctx, accAddrs[0])
+	require.Equal([]banktypes.SpendingRecord{{Time: ctx.BlockTime(), Amount: foo(20)}}, l.Spent)
+
+	// the removal of the spending limit is a loosening change
+	effectiveTime, err = setLimit(ctx, nil, 0)
+	require.NoError(err)
+	require.ErrorIs(suite.bankKeeper.SendCoins(ctx, accAddrs[0], accAddrs[1], foo(10)), banktypes.ErrSpendingLimitExceeded)
+
+	ctx = ctx.WithBlockTime(effectiveTime)
+	require.NoError(suite.bankKeeper.SendCoins(ctx, accAddrs[0], accAddrs[1], foo(40)))
+	_, found = suite.bankKeeper.GetSpendingLimit(ctx, accAddrs[0])
+	require.False(found)
+	require.Equal(sdk.NewCoins(newFooCoin(80), newBarCoin(40)), suite.bankKeeper.GetAllBalances(ctx, accAddrs[1]))
+}
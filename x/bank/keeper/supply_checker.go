@@ -0,0 +1,313 @@
+package keeper
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"sync/atomic"
+
+	abci "github.com/cometbft/cometbft/abci/types"
+
+	"cosmossdk.io/collections"
+	"cosmossdk.io/log"
+	"cosmossdk.io/math"
+	storetypes "cosmossdk.io/store/types"
+
+	"github.com/cosmos/cosmos-sdk/telemetry"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/bank/types"
+)
+
+// supplyCheckerBufferSize is the number of committed blocks the supply checker
+// can lag behind before it gives up verifying the following blocks.
+const supplyCheckerBufferSize = 128
+
+var (
+	_ storetypes.ABCIListener = (*SupplyChecker)(nil)
+
+	balancesKeyCodec = collections.PairKeyCodec(sdk.AccAddressKey, collections.StringKey)
+	balanceValue     = types.NewBalanceCompatValueCodec()
+)
+
+// SupplyDivergence describes a denom whose total supply does not match the sum
+// of the balances of all accounts after the block at Height was committed.
+type SupplyDivergence struct {
+	Height   int64
+	Denom    string
+	Supply   math.Int
+	Balances math.Int
+}
+
+// String implements the fmt.Stringer interface.
+func (d SupplyDivergence) String() string {
+	return fmt.Sprintf("height %d: %s supply %s does not match sum of balances %s", d.Height, d.Denom, d.Supply, d.Balances)
+}
+
+// SupplyChecker is an off-consensus ABCIListener which incrementally verifies
+// the total supply invariant. It keeps an in-memory copy of the supply and of
+// every balance, applies the x/bank state changes streamed after each commit,
+// and reports a SupplyDivergence for every denom touched by the block whose
+// supply differs from the sum of its balances.
+//
+// Unlike the x/crisis TotalSupply invariant, the verification runs in a
+// background goroutine and never affects block execution. If the checker lags
+// too far behind the chain, it stops verifying rather than delaying Commit: the
+// block which cannot be buffered is dropped, and since the in-memory state can
+// no longer be updated incrementally, so are all the following ones. The x/bank
+// store key must be exposed to the streaming listeners for the checker to
+// receive the state changes.
+type SupplyChecker struct {
+	logger log.Logger
+	alert  func(SupplyDivergence)
+
+	commits  chan supplyCheckerCommit
+	done     chan struct{}
+	desynced atomic.Bool
+
+	// The following fields are only accessed by the checker goroutine once it
+	// has been started.
+	balances map[balanceKey]math.Int
+	totals   map[string]math.Int
+	supply   map[string]math.Int
+}
+
+type balanceKey struct {
+	addr  string
+	denom string
+}
+
+type supplyCheckerCommit struct {
+	height  int64
+	changes []*storetypes.StoreKVPair
+}
+
+// NewSupplyChecker returns a new SupplyChecker. Every divergence is logged as an
+// error and, if alert is not nil, passed to alert from the checker goroutine.
+func NewSupplyChecker(logger log.Logger, alert func(SupplyDivergence)) *SupplyChecker {
+	return &SupplyChecker{
+		logger:   logger.With("module", "x/"+types.ModuleName, "service", "supply-checker"),
+		alert:    alert,
+		commits:  make(chan supplyCheckerCommit, supplyCheckerBufferSize),
+		done:     make(chan struct{}),
+		balances: make(map[balanceKey]math.Int),
+		totals:   make(map[string]math.Int),
+		supply:   make(map[string]math.Int),
+	}
+}
+
+// Seed loads the total supply and all account balances from the state of the
+// given context and verifies them. It must be called with the latest committed
+// state, before Start and before any block is streamed to the checker.
+func (c *SupplyChecker) Seed(ctx context.Context, k Keeper) {
+	k.IterateTotalSupply(ctx, func(coin sdk.Coin) bool {
+		c.supply[coin.Denom] = coin.Amount
+		return false
+	})
+
+	k.IterateAllBalances(ctx, func(addr sdk.AccAddress, coin sdk.Coin) bool {
+		c.setBalance(balanceKey{addr: string(addr), denom: coin.Denom}, coin.Amount)
+		return false
+	})
+
+	height := sdk.UnwrapSDKContext(ctx).BlockHeight()
+	for _, denom := range c.denoms() {
+		c.verify(height, denom)
+	}
+}
+
+// Start starts the background goroutine verifying the streamed blocks.
+func (c *SupplyChecker) Start() {
+	go func() {
+		defer close(c.done)
+
+		for commit := range c.commits {
+			c.process(commit)
+		}
+	}()
+}
+
+// Stop stops the checker once all the blocks already streamed are verified.
+func (c *SupplyChecker) Stop() {
+	close(c.commits)
+	<-c.done
+}
+
+// ListenBeginBlock implements the ABCIListener interface.
+func (c *SupplyChecker) ListenBeginBlock(context.Context, abci.RequestBeginBlock, abci.ResponseBeginBlock) error {
+	return nil
+}
+
+// ListenEndBlock implements the ABCIListener interface.
+func (c *SupplyChecker) ListenEndBlock(context.Context, abci.RequestEndBlock, abci.ResponseEndBlock) error {
+	return nil
+}
+
+// ListenDeliverTx implements the ABCIListener interface.
+func (c *SupplyChecker) ListenDeliverTx(context.Context, abci.RequestDeliverTx, abci.ResponseDeliverTx) error {
+	return nil
+}
+
+// ListenCommit implements the ABCIListener interface. It hands the x/bank supply
+// and balance changes of the committed block over to the checker goroutine.
+func (c *SupplyChecker) ListenCommit(ctx context.Context, _ abci.ResponseCommit, changeSet []*storetypes.StoreKVPair) error {
+	var changes []*storetypes.StoreKVPair
+	for _, pair := range changeSet {
+		if pair.StoreKey != types.StoreKey || len(pair.Key) == 0 {
+			continue
+		}
+
+		if pair.Key[0] == types.SupplyKey[0] || pair.Key[0] == types.BalancesPrefix[0] {
+			changes = append(changes, pair)
+		}
+	}
+
+	if c.desynced.Load() {
+		return nil
+	}
+
+	height := sdk.UnwrapSDKContext(ctx).BlockHeight()
+	select {
+	case c.commits <- supplyCheckerCommit{height: height, changes: changes}:
+	default:
+		c.desynced.Store(true)
+		c.logger.Error("supply checker is too far behind, stopping the verification", "height", height, "buffered_blocks", supplyCheckerBufferSize)
+		telemetry.IncrCounter(1, types.ModuleName, "supply_checker", "desynced")
+	}
+
+	return nil
+}
+
+// Desynced returns true if the checker dropped a block because it was too far
+// behind the chain, in which case it no longer verifies the following blocks.
+func (c *SupplyChecker) Desynced() bool {
+	return c.desynced.Load()
+}
+
+// process applies the changes of a committed block and verifies the invariant
+// of every denom they touch.
+func (c *SupplyChecker) process(commit supplyCheckerCommit) {
+	touched := make(map[string]struct{})
+	for _, pair := range commit.changes {
+		denom, err := c.apply(pair)
+		if err != nil {
+			c.logger.Error("failed to decode x/bank state change", "height", commit.height, "key", fmt.Sprintf("%X", pair.Key), "err", err)
+			continue
+		}
+
+		touched[denom] = struct{}{}
+	}
+
+	denoms := make([]string, 0, len(touched))
+	for denom := range touched {
+		denoms = append(denoms, denom)
+	}
+	sort.Strings(denoms)
+
+	for _, denom := range denoms {
+		c.verify(commit.height, denom)
+	}
+}
+
+// apply updates the in-memory state with a single x/bank store change and
+// returns the denom it affects.
+func (c *SupplyChecker) apply(pair *storetypes.StoreKVPair) (string, error) {
+	switch pair.Key[0] {
+	case types.SupplyKey[0]:
+		_, denom, err := collections.StringKey.Decode(pair.Key[len(types.SupplyKey):])
+		if err != nil {
+			return "", err
+		}
+
+		amount := math.ZeroInt()
+		if !pair.Delete {
+			amount, err = sdk.IntValue.Decode(pair.Value)
+			if err != nil {
+				return "", err
+			}
+		}
+
+		c.supply[denom] = amount
+		return denom, nil
+
+	default:
+		_, key, err := balancesKeyCodec.Decode(pair.Key[len(types.BalancesPrefix):])
+		if err != nil {
+			return "", err
+		}
+
+		amount := math.ZeroInt()
+		if !pair.Delete {
+			amount, err = balanceValue.Decode(pair.Value)
+			if err != nil {
+				return "", err
+			}
+		}
+
+		c.setBalance(balanceKey{addr: string(key.K1()), denom: key.K2()}, amount)
+		return key.K2(), nil
+	}
+}
+
+// setBalance records the balance of an account and updates the sum of the
+// balances of its denom accordingly.
+func (c *SupplyChecker) setBalance(key balanceKey, amount math.Int) {
+	total, ok := c.totals[key.denom]
+	if !ok {
+		total = math.ZeroInt()
+	}
+
+	if previous, ok := c.balances[key]; ok {
+		total = total.Sub(previous)
+	}
+
+	if amount.IsZero() {
+		delete(c.balances, key)
+	} else {
+		c.balances[key] = amount
+	}
+
+	c.totals[key.denom] = total.Add(amount)
+}
+
+// verify checks the supply of denom against the sum of its balances and
+// reports a divergence.
+func (c *SupplyChecker) verify(height int64, denom string) {
+	supply, ok := c.supply[denom]
+	if !ok {
+		supply = math.ZeroInt()
+	}
+
+	total, ok := c.totals[denom]
+	if !ok {
+		total = math.ZeroInt()
+	}
+
+	if supply.Equal(total) {
+		return
+	}
+
+	divergence := SupplyDivergence{Height: height, Denom: denom, Supply: supply, Balances: total}
+	c.logger.Error("total supply invariant broken", "height", height, "denom", denom, "supply", supply, "balances", total)
+	if c.alert != nil {
+		c.alert(divergence)
+	}
+}
+
+// denoms returns the sorted list of denoms with either a supply or a balance.
+func (c *SupplyChecker) denoms() []string {
+	seen := make(map[string]struct{}, len(c.supply))
+	for denom := range c.supply {
+		seen[denom] = struct{}{}
+	}
+	for denom := range c.totals {
+		seen[denom] = struct{}{}
+	}
+
+	denoms := make([]string, 0, len(seen))
+	for denom := range seen {
+		denoms = append(denoms, denom)
+	}
+	sort.Strings(denoms)
+
+	return denoms
+}
@@ -0,0 +1,120 @@
+package keeper_test
+
+import (
+	"testing"
+
+	abci "github.com/cometbft/cometbft/abci/types"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+
+	"cosmossdk.io/collections"
+	"cosmossdk.io/log"
+	"cosmossdk.io/math"
+	storetypes "cosmossdk.io/store/types"
+
+	"github.com/cosmos/cosmos-sdk/runtime"
+	"github.com/cosmos/cosmos-sdk/testutil"
+	moduletestutil "github.com/cosmos/cosmos-sdk/types/module/testutil"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	"github.com/cosmos/cosmos-sdk/x/bank/keeper"
+	banktestutil "github.com/cosmos/cosmos-sdk/x/bank/testutil"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+)
+
+func TestSupplyChecker(t *testing.T) {
+	key := storetypes.NewKVStoreKey(banktypes.StoreKey)
+	testCtx := testutil.DefaultContextWithDB(t, key, storetypes.NewTransientStoreKey("transient_test"))
+	ctx := testCtx.Ctx
+	encCfg := moduletestutil.MakeTestEncodingConfig()
+
+	k := keeper.NewBaseKeeper(
+		encCfg.Codec,
+		runtime.NewKVStoreService(key),
+		banktestutil.NewMockAccountKeeper(gomock.NewController(t)),
+		map[string]bool{},
+		authtypes.NewModuleAddress(govtypes.ModuleName).String(),
+		log.NewNopLogger(),
+	)
+
+	setSupply := func(amount int64) {
+		require.NoError(t, k.Supply.Set(ctx, fooDenom, math.NewInt(amount)))
+	}
+	setBalance := func(i int, amount int64) {
+		require.NoError(t, k.Balances.Set(ctx, collections.Join(accAddrs[i], fooDenom), math.NewInt(amount)))
+	}
+
+	setSupply(100)
+	setBalance(0, 100)
+
+	var divergences []keeper.SupplyDivergence
+	checker := keeper.NewSupplyChecker(log.NewNopLogger(), func(d keeper.SupplyDivergence) {
+		divergences = append(divergences, d)
+	})
+	checker.Seed(ctx, k)
+	require.Empty(t, divergences)
+
+	testCtx.CMS.AddListeners([]storetypes.StoreKey{key})
+	checker.Start()
+
+	commit := func(height int64) {
+		err := checker.ListenCommit(ctx.WithBlockHeight(height), abci.ResponseCommit{}, testCtx.CMS.PopStateCache())
+		require.NoError(t, err)
+	}
+
+	// transfer between accounts
+	setBalance(0, 60)
+	setBalance(1, 40)
+	commit(1)
+
+	// mint to an account
+	setSupply(150)
+	setBalance(1, 90)
+	commit(2)
+
+	// balance created out of thin air
+	setBalance(2, 10)
+	commit(3)
+
+	// balance removed again
+	require.NoError(t, k.Balances.Remove(ctx, collections.Join(accAddrs[2], fooDenom)))
+	commit(4)
+
+	checker.Stop()
+
+	require.Len(t, divergences, 1)
+	require.Equal(t, int64(3), divergences[0].Height)
+	require.Equal(t, fooDenom, divergences[0].Denom)
+	require.Equal(t, math.NewInt(150).String(), divergences[0].Supply.String())
+	require.Equal(t, math.NewInt(160).String(), divergences[0].Balances.String())
+}
+
+func TestSupplyChecker_Desynced(t *testing.T) {
+	key := storetypes.NewKVStoreKey(banktypes.StoreKey)
+	testCtx := testutil.DefaultContextWithDB(t, key, storetypes.NewTransientStoreKey("transient_test"))
+	ctx := testCtx.Ctx
+
+	var divergences []keeper.SupplyDivergence
+	checker := keeper.NewSupplyChecker(log.NewNopLogger(), func(d keeper.SupplyDivergence) {
+		divergences = append(divergences, d)
+	})
+
+	// the checker is not started, so the committed blocks pile up in its buffer
+	// until it drops one rather than blocking the commit
+	height := int64(1)
+	for ; !checker.Desynced(); height++ {
+		err := checker.ListenCommit(ctx.WithBlockHeight(height), abci.ResponseCommit{}, nil)
+		require.NoError(t, err)
+	}
+	require.Equal(t, int64(130), height)
+
+	// the following blocks are dropped as well
+	supplyKey := append(append([]byte{}, banktypes.SupplyKey...), fooDenom...)
+	changes := []*storetypes.StoreKVPair{{StoreKey: banktypes.StoreKey, Key: supplyKey, Value: []byte("100")}}
+	err := checker.ListenCommit(ctx.WithBlockHeight(height), abci.ResponseCommit{}, changes)
+	require.NoError(t, err)
+
+	checker.Start()
+	checker.Stop()
+	require.Empty(t, divergences)
+}
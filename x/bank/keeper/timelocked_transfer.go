@@ -0,0 +1,189 @@
+package keeper
+
+import (
+	"context"
+	"errors"
+	"strconv"
+	"time"
+
+	"cosmossdk.io/collections"
+	errorsmod "cosmossdk.io/errors"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/bank/types"
+)
+
+// GetTimelockedTransfer returns a time-locked transfer by its identifier.
+func (k BaseViewKeeper) GetTimelockedTransfer(ctx context.Context, id uint64) (types.TimelockedTransfer, bool) {
+	t, err := k.TimelockedTransfers.Get(ctx, id)
+	if err != nil {
+		if !errors.Is(err, collections.ErrNotFound) {
+			panic(err)
+		}
+		return types.TimelockedTransfer{}, false
+	}
+
+	return t, true
+}
+
+// IterateTimelockedTransfers iterates over the time-locked transfers, in
+// identifier order, and calls the provided callback. If true is returned from
+// the callback, iteration is halted.
+func (k BaseViewKeeper) IterateTimelockedTransfers(ctx context.Context, cb func(t types.TimelockedTransfer) (stop bool)) {
+	err := k.TimelockedTransfers.Walk(ctx, nil, func(_ uint64, t types.TimelockedTransfer) (stop bool) {
+		return cb(t)
+	})
+	if err != nil {
+		panic(err)
+	}
+}
+
+// SendWithTimelock sends amt coins from an account to a recipient which can
+// claim them from the unlock time until the expiry time, from which the sender
+// can reclaim them. The coins are held in the account of the sender until they
+// are claimed or reclaimed. It returns the identifier of the transfer.
+func (k BaseKeeper) SendWithTimelock(ctx context.Context, fromAddr, toAddr sdk.AccAddress, amt sdk.Coins, unlockTime, expiryTime time.Time) (uint64, error) {
+	if err := types.ValidateTimelock(amt, unlockTime, expiryTime); err != nil {
+		return 0, err
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	if !expiryTime.After(sdkCtx.BlockTime()) {
+		return 0, errorsmod.Wrapf(types.ErrInvalidTimelock, "expiry time %s is not after the block time", expiryTime)
+	}
+
+	id, err := k.TimelockedTransferSequence.Next(ctx)
+	if err != nil {
+		return 0, err
+	}
+
+	if err := k.HoldCoins(ctx, fromAddr, types.TimelockedTransferHolder(id), amt, nil); err != nil {
+		return 0, err
+	}
+
+	t := types.NewTimelockedTransfer(id, fromAddr, toAddr, amt, unlockTime, expiryTime)
+	if err := k.setTimelockedTransfer(ctx, t); err != nil {
+		return 0, err
+	}
+
+	sdkCtx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeSendWithTimelock,
+			sdk.NewAttribute(types.AttributeKeyTransferID, strconv.FormatUint(id, 10)),
+			sdk.NewAttribute(types.AttributeKeySender, t.Sender),
+			sdk.NewAttribute(types.AttributeKeyRecipient, t.Recipient),
+			sdk.NewAttribute(sdk.AttributeKeyAmount, amt.String()),
+			sdk.NewAttribute(types.AttributeKeyUnlockTime, unlockTime.String()),
+			sdk.NewAttribute(types.AttributeKeyExpiryTime, expiryTime.String()),
+		),
+	)
+
+	return id, nil
+}
+
+// ClaimTimelockedTransfer sends the coins of a time-locked transfer to its
+// recipient. The transfer can only be claimed from its unlock time until its
+// expiry time.
+func (k BaseKeeper) ClaimTimelockedTransfer(ctx context.Context, recipient sdk.AccAddress, id uint64) error {
+	t, found := k.GetTimelockedTransfer(ctx, id)
+	if !found {
+		return errorsmod.Wrapf(types.ErrTimelockNotFound, "%d", id)
+	}
+
+	if t.Recipient != recipient.String() {
+		return errorsmod.Wrapf(sdkerrors.ErrUnauthorized, "%s is not the recipient of time-locked transfer %d", recipient, id)
+	}
+
+	blockTime := sdk.UnwrapSDKContext(ctx).BlockTime()
+	switch {
+	case t.IsExpired(blockTime):
+		return errorsmod.Wrapf(types.ErrTimelockExpired, "time-locked transfer %d expired at %s", id, t.ExpiryTime)
+	case !t.IsUnlocked(blockTime):
+		return errorsmod.Wrapf(types.ErrTimelockLocked, "time-locked transfer %d unlocks at %s", id, t.UnlockTime)
+	}
+
+	sender := sdk.MustAccAddressFromBech32(t.Sender)
+	if err := k.SendHeldCoins(ctx, sender, types.TimelockedTransferHolder(id), recipient, t.Amount); err != nil {
+		return err
+	}
+
+	if err := k.removeTimelockedTransfer(ctx, t); err != nil {
+		return err
+	}
+
+	sdk.UnwrapSDKContext(ctx).EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeClaimTimelockedTransfer,
+			sdk.NewAttribute(types.AttributeKeyTransferID, strconv.FormatUint(id, 10)),
+			sdk.NewAttribute(types.AttributeKeyRecipient, t.Recipient),
+			sdk.NewAttribute(sdk.AttributeKeyAmount, t.Amount.String()),
+		),
+	)
+
+	return nil
+}
+
+// ReclaimTimelockedTransfer releases the coins of an unclaimed time-locked
+// transfer held in the account of its sender. The transfer can only be
+// reclaimed from its expiry time.
+func (k BaseKeeper) ReclaimTimelockedTransfer(ctx context.Context, sender sdk.AccAddress, id uint64) error {
+	t, found := k.GetTimelockedTransfer(ctx, id)
+	if !found {
+		return errorsmod.Wrapf(types.ErrTimelockNotFound, "%d", id)
+	}
+
+	if t.Sender != sender.String() {
+		return errorsmod.Wrapf(sdkerrors.ErrUnauthorized, "%s is not the sender of time-locked transfer %d", sender, id)
+	}
+
+	if !t.IsExpired(sdk.UnwrapSDKContext(ctx).BlockTime()) {
+		return errorsmod.Wrapf(types.ErrTimelockNotExpired, "time-locked transfer %d expires at %s", id, t.ExpiryTime)
+	}
+
+	if err := k.ReleaseHold(ctx, sender, types.TimelockedTransferHolder(id), t.Amount); err != nil {
+		return err
+	}
+
+	if err := k.removeTimelockedTransfer(ctx, t); err != nil {
+		return err
+	}
+
+	sdk.UnwrapSDKContext(ctx).EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeReclaimTimelockedTransfer,
+			sdk.NewAttribute(types.AttributeKeyTransferID, strconv.FormatUint(id, 10)),
+			sdk.NewAttribute(types.AttributeKeySender, t.Sender),
+			sdk.NewAttribute(sdk.AttributeKeyAmount, t.Amount.String()),
+		),
+	)
+
+	return nil
+}
+
+// setTimelockedTransfer stores a time-locked transfer along with its indexes.
+func (k BaseKeeper) setTimelockedTransfer(ctx context.Context, t types.TimelockedTransfer) error {
+	if err := k.TimelockedTransfers.Set(ctx, t.Id, t); err != nil {
+		return err
+	}
+
+	if err := k.SenderTimelockedTransfers.Set(ctx, collections.Join(sdk.MustAccAddressFromBech32(t.Sender), t.Id)); err != nil {
+		return err
+	}
+
+	return k.RecipientTimelockedTransfers.Set(ctx, collections.Join(sdk.MustAccAddressFromBech32(t.Recipient), t.Id))
+}
+
+// removeTimelockedTransfer removes a time-locked transfer along with its
+// indexes.
+func (k BaseKeeper) removeTimelockedTransfer(ctx context.Context, t types.TimelockedTransfer) error {
+	if err := k.TimelockedTransfers.Remove(ctx, t.Id); err != nil {
+		return err
+	}
+
+	if err := k.SenderTimelockedTransfers.Remove(ctx, collections.Join(sdk.MustAccAddressFromBech32(t.Sender), t.Id)); err != nil {
+		return err
+	}
+
+	return k.RecipientTimelockedTransfers.Remove(ctx, collections.Join(sdk.MustAccAddressFromBech32(t.Recipient), t.Id))
+}
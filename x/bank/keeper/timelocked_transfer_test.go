@@ -0,0 +1,83 @@
+package keeper_test
+
+import (
+	"time"
+
+	"github.com/golang/mock/gomock"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	banktestutil "github.com/cosmos/cosmos-sdk/x/bank/testutil"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+)
+
+func (suite *KeeperTestSuite) TestTimelockedTransfers() {
+	ctx := sdk.UnwrapSDKContext(suite.ctx)
+	require := suite.Require()
+
+	acc0 := authtypes.NewBaseAccountWithAddress(accAddrs[0])
+	suite.authKeeper.EXPECT().GetAccount(gomock.Any(), accAddrs[0]).Return(acc0).AnyTimes()
+	suite.authKeeper.EXPECT().HasAccount(gomock.Any(), accAddrs[1]).Return(true).AnyTimes()
+
+	suite.mockFundAccount(accAddrs[0])
+	require.NoError(banktestutil.FundAccount(ctx, suite.bankKeeper, accAddrs[0], sdk.NewCoins(newFooCoin(100))))
+
+	foo := func(amt int64) sdk.Coins { return sdk.NewCoins(newFooCoin(amt)) }
+	unlockTime := ctx.BlockTime().Add(time.Hour)
+	expiryTime := unlockTime.Add(time.Hour)
+
+	_, err := suite.msgServer.SendWithTimelock(ctx, banktypes.NewMsgSendWithTimelock(accAddrs[0], accAddrs[1], foo(10), expiryTime, unlockTime))
+	require.ErrorIs(err, banktypes.ErrInvalidTimelock)
+	_, err = suite.msgServer.SendWithTimelock(ctx, banktypes.NewMsgSendWithTimelock(accAddrs[0], accAddrs[1], foo(200), unlockTime, expiryTime))
+	require.ErrorIs(err, sdkerrors.ErrInsufficientFunds)
+
+	// the coins are held in the account of the sender
+	res, err := suite.msgServer.SendWithTimelock(ctx, banktypes.NewMsgSendWithTimelock(accAddrs[0], accAddrs[1], foo(60), unlockTime, expiryTime))
+	require.NoError(err)
+	require.Equal(uint64(1), res.TransferId)
+	require.Equal(foo(60), suite.bankKeeper.HeldCoins(ctx, accAddrs[0]))
+	require.Equal(foo(40), suite.bankKeeper.SpendableCoins(ctx, accAddrs[0]))
+
+	transfers, err := suite.queryClient.TimelockedTransfersByRecipient(ctx, &banktypes.QueryTimelockedTransfersByRecipientRequest{Recipient: accAddrs[1].String()})
+	require.NoError(err)
+	require.Equal([]banktypes.TimelockedTransfer{
+		banktypes.NewTimelockedTransfer(1, accAddrs[0], accAddrs[1], foo(60), unlockTime, expiryTime),
+	}, transfers.Transfers)
+
+	// the transfer is only claimable by its recipient once unlocked
+	_, err = suite.msgServer.ClaimTimelockedTransfer(ctx, banktypes.NewMsgClaimTimelockedTransfer(accAddrs[1], 1))
+	require.ErrorIs(err, banktypes.ErrTimelockLocked)
+	_, err = suite.msgServer.ClaimTimelockedTransfer(ctx.WithBlockTime(unlockTime), banktypes.NewMsgClaimTimelockedTransfer(accAddrs[0], 1))
+	require.ErrorIs(err, sdkerrors.ErrUnauthorized)
+	_, err = suite.msgServer.ReclaimTimelockedTransfer(ctx.WithBlockTime(unlockTime), banktypes.NewMsgReclaimTimelockedTransfer(accAddrs[0], 1))
+	require.ErrorIs(err, banktypes.ErrTimelockNotExpired)
+
+	_, err = suite.msgServer.ClaimTimelockedTransfer(ctx.WithBlockTime(unlockTime), banktypes.NewMsgClaimTimelockedTransfer(accAddrs[1], 1))
+	require.NoError(err)
+	require.Equal(foo(60), suite.bankKeeper.GetAllBalances(ctx, accAddrs[1]))
+	require.True(suite.bankKeeper.HeldCoins(ctx, accAddrs[0]).IsZero())
+	_, found := suite.bankKeeper.GetTimelockedTransfer(ctx, 1)
+	require.False(found)
+
+	// an unclaimed transfer is reclaimable by its sender once expired
+	res, err = suite.msgServer.SendWithTimelock(ctx, banktypes.NewMsgSendWithTimelock(accAddrs[0], accAddrs[1], foo(40), unlockTime, expiryTime))
+	require.NoError(err)
+	require.Equal(uint64(2), res.TransferId)
+
+	ctx = ctx.WithBlockTime(expiryTime)
+	_, err = suite.msgServer.ClaimTimelockedTransfer(ctx, banktypes.NewMsgClaimTimelockedTransfer(accAddrs[1], 2))
+	require.ErrorIs(err, banktypes.ErrTimelockExpired)
+	_, err = suite.msgServer.ReclaimTimelockedTransfer(ctx, banktypes.NewMsgReclaimTimelockedTransfer(accAddrs[1], 2))
+	require.ErrorIs(err, sdkerrors.ErrUnauthorized)
+
+	_, err = suite.msgServer.ReclaimTimelockedTransfer(ctx, banktypes.NewMsgReclaimTimelockedTransfer(accAddrs[0], 2))
+	require.NoError(err)
+	require.Equal(foo(40), suite.bankKeeper.SpendableCoins(ctx, accAddrs[0]))
+	_, err = suite.msgServer.ReclaimTimelockedTransfer(ctx, banktypes.NewMsgReclaimTimelockedTransfer(accAddrs[0], 2))
+	require.ErrorIs(err, banktypes.ErrTimelockNotFound)
+
+	sent, err := suite.queryClient.TimelockedTransfersBySender(ctx, &banktypes.QueryTimelockedTransfersBySenderRequest{Sender: accAddrs[0].String()})
+	require.NoError(err)
+	require.Empty(sent.Transfers)
+}
@@ -3,6 +3,7 @@ package keeper
 import (
 	"context"
 	"fmt"
+	"time"
 
 	"cosmossdk.io/collections/indexes"
 	"cosmossdk.io/core/store"
@@ -36,6 +37,18 @@ type ViewKeeper interface {
 	LockedCoins(ctx context.Context, addr sdk.AccAddress) sdk.Coins
 	SpendableCoins(ctx context.Context, addr sdk.AccAddress) sdk.Coins
 	SpendableCoin(ctx context.Context, addr sdk.AccAddress, denom string) sdk.Coin
+	ProjectedSpendableCoins(ctx context.Context, addr sdk.AccAddress, t time.Time) (spendable, locked, held sdk.Coins)
+
+	GetHold(ctx context.Context, addr sdk.AccAddress, holder string) (types.Hold, bool)
+	GetHolds(ctx context.Context, addr sdk.AccAddress) []types.Hold
+	HeldCoins(ctx context.Context, addr sdk.AccAddress) sdk.Coins
+	IterateAllHolds(ctx context.Context, cb func(hold types.Hold) (stop bool))
+
+	GetSpendingLimit(ctx context.Context, addr sdk.AccAddress) (types.SpendingLimit, bool)
+	IterateSpendingLimits(ctx context.Context, cb func(l types.SpendingLimit) (stop bool))
+
+	GetDemurrageSchedule(ctx context.Context, denom string) (types.DemurrageSchedule, bool)
+	IterateDemurrageSchedules(ctx context.Context, cb func(s types.DemurrageSchedule) (stop bool))
 
 	IterateAccountBalances(ctx context.Context, addr sdk.AccAddress, cb func(coin sdk.Coin) (stop bool))
 	IterateAllBalances(ctx context.Context, cb func(address sdk.AccAddress, coin sdk.Coin) (stop bool))
@@ -65,12 +78,39 @@ type BaseViewKeeper struct {
 	ak           types.AccountKeeper
 	logger       log.Logger
 
+	// the addresses restricted from receiving funds, which are exempt from the
+	// demurrage. They are only set for the view keeper of a send keeper.
+	blockedAddrs map[string]bool
+
 	Schema        collections.Schema
 	Supply        collections.Map[string, math.Int]
 	DenomMetadata collections.Map[string, types.Metadata]
 	SendEnabled   collections.Map[string, bool]
 	Balances      *collections.IndexedMap[collections.Pair[sdk.AccAddress, string], math.Int, BalancesIndexes]
 	Params        collections.Item[types.Params]
+	BalanceHolds  collections.Map[collections.Pair[sdk.AccAddress, string], types.Hold]
+
+	Snapshots        collections.Map[uint64, types.BalanceSnapshot]
+	SnapshotBalances collections.Map[collections.Pair[uint64, sdk.AccAddress], math.Int]
+	PendingSnapshots collections.KeySet[collections.Pair[int64, uint64]]
+	SnapshotSequence collections.Sequence
+
+	MintBurnPermissions collections.Map[string, types.MintBurnPermissions]
+
+	DustSweepOptIns collections.KeySet[sdk.AccAddress]
+	DustSweepCursor collections.Item[[]byte]
+
+	ModuleEscrows collections.Map[collections.Pair[string, string], types.ModuleEscrow]
+
+	TimelockedTransfers          collections.Map[uint64, types.TimelockedTransfer]
+	SenderTimelockedTransfers    collections.KeySet[collections.Pair[sdk.AccAddress, uint64]]
+	RecipientTimelockedTransfers collections.KeySet[collections.Pair[sdk.AccAddress, uint64]]
+	TimelockedTransferSequence   collections.Sequence
+
+	SpendingLimits collections.Map[sdk.AccAddress, types.SpendingLimit]
+
+	DemurrageSchedules     collections.Map[string, types.DemurrageSchedule]
+	DemurrageAccountEpochs collections.Map[collections.Pair[sdk.AccAddress, string], uint64]
 }
 
 // NewBaseViewKeeper returns a new BaseViewKeeper.
@@ -86,6 +126,29 @@ func NewBaseViewKeeper(cdc codec.BinaryCodec, storeService store.KVStoreService,
 		SendEnabled:   collections.NewMap(sb, types.SendEnabledPrefix, "send_enabled", collections.StringKey, codec.BoolValue), // NOTE: we use a bool value which uses protobuf to retain state backwards compat
 		Balances:      collections.NewIndexedMap(sb, types.BalancesPrefix, "balances", collections.PairKeyCodec(sdk.AccAddressKey, collections.StringKey), types.NewBalanceCompatValueCodec(), newBalancesIndexes(sb)),
 		Params:        collections.NewItem(sb, types.ParamsKey, "params", codec.CollValue[types.Params](cdc)),
+		BalanceHolds:  collections.NewMap(sb, types.HoldsPrefix, "holds", collections.PairKeyCodec(sdk.AccAddressKey, collections.StringKey), codec.CollValue[types.Hold](cdc)),
+
+		Snapshots:        collections.NewMap(sb, types.BalanceSnapshotsPrefix, "balance_snapshots", collections.Uint64Key, codec.CollValue[types.BalanceSnapshot](cdc)),
+		SnapshotBalances: collections.NewMap(sb, types.SnapshotBalancesPrefix, "snapshot_balances", collections.PairKeyCodec(collections.Uint64Key, sdk.AccAddressKey), sdk.IntValue),
+		PendingSnapshots: collections.NewKeySet(sb, types.PendingBalanceSnapshotsPrefix, "pending_balance_snapshots", collections.PairKeyCodec(collections.Int64Key, collections.Uint64Key)),
+		SnapshotSequence: collections.NewSequence(sb, types.BalanceSnapshotSequenceKey, "balance_snapshot_sequence"),
+
+		MintBurnPermissions: collections.NewMap(sb, types.MintBurnPermissionsPrefix, "mint_burn_permissions", collections.StringKey, codec.CollValue[types.MintBurnPermissions](cdc)),
+
+		DustSweepOptIns: collections.NewKeySet(sb, types.DustSweepOptInsPrefix, "dust_sweep_opt_ins", sdk.AccAddressKey),
+		DustSweepCursor: collections.NewItem(sb, types.DustSweepCursorKey, "dust_sweep_cursor", collections.BytesValue),
+
+		ModuleEscrows: collections.NewMap(sb, types.ModuleEscrowsPrefix, "module_escrows", collections.PairKeyCodec(collections.StringKey, collections.StringKey), codec.CollValue[types.ModuleEscrow](cdc)),
+
+		TimelockedTransfers:          collections.NewMap(sb, types.TimelockedTransfersPrefix, "timelocked_transfers", collections.Uint64Key, codec.CollValue[types.TimelockedTransfer](cdc)),
+		SenderTimelockedTransfers:    collections.NewKeySet(sb, types.TimelockedTransfersBySenderPrefix, "timelocked_transfers_by_sender", collections.PairKeyCodec(sdk.AccAddressKey, collections.Uint64Key)),
+		RecipientTimelockedTransfers: collections.NewKeySet(sb, types.TimelockedTransfersByRecipientPrefix, "timelocked_transfers_by_recipient", collections.PairKeyCodec(sdk.AccAddressKey, collections.Uint64Key)),
+		TimelockedTransferSequence:   collections.NewSequence(sb, types.TimelockedTransferSequenceKey, "timelocked_transfer_sequence"),
+
+		SpendingLimits: collections.NewMap(sb, types.SpendingLimitsPrefix, "spending_limits", sdk.AccAddressKey, codec.CollValue[types.SpendingLimit](cdc)),
+
+		DemurrageSchedules:     collections.NewMap(sb, types.DemurrageSchedulesPrefix, "demurrage_schedules", collections.StringKey, codec.CollValue[types.DemurrageSchedule](cdc)),
+		DemurrageAccountEpochs: collections.NewMap(sb, types.DemurrageAccountEpochsPrefix, "demurrage_account_epochs", collections.PairKeyCodec(sdk.AccAddressKey, collections.StringKey), collections.Uint64Value),
 	}
 
 	schema, err := sb.Build()
@@ -107,14 +170,20 @@ func (k BaseViewKeeper) Logger() log.Logger {
 }
 
 // GetAllBalances returns all the account balances for the given account address.
+// The balances of the demurrage denominations are net of their pending decay.
 func (k BaseViewKeeper) GetAllBalances(ctx context.Context, addr sdk.AccAddress) sdk.Coins {
+	// balances are iterated in denom order, so that they can be appended
+	// without being sorted nor coalesced
 	balances := sdk.NewCoins()
 	k.IterateAccountBalances(ctx, addr, func(balance sdk.Coin) bool {
-		balances = balances.Add(balance)
+		balance = k.decayedBalance(ctx, addr, balance)
+		if !balance.IsZero() {
+			balances = append(balances, balance)
+		}
 		return false
 	})
 
-	return balances.Sort()
+	return balances
 }
 
 // GetAccountsBalances returns all the accounts balances from the store.
@@ -144,8 +213,15 @@ func (k BaseViewKeeper) GetAccountsBalances(ctx context.Context) []types.Balance
 }
 
 // GetBalance returns the balance of a specific denomination for a given account
-// by address.
+// by address. The balance of a demurrage denomination is net of its pending
+// decay.
 func (k BaseViewKeeper) GetBalance(ctx context.Context, addr sdk.AccAddress, denom string) sdk.Coin {
+	return k.decayedBalance(ctx, addr, k.storedBalance(ctx, addr, denom))
+}
+
+// storedBalance returns the stored balance of a specific denomination for a
+// given account, without settling its pending decay, if any.
+func (k BaseViewKeeper) storedBalance(ctx context.Context, addr sdk.AccAddress, denom string) sdk.Coin {
 	amt, err := k.Balances.Get(ctx, collections.Join(addr, denom))
 	if err != nil {
 		return sdk.NewCoin(denom, sdk.ZeroInt())
@@ -155,7 +231,8 @@ func (k BaseViewKeeper) GetBalance(ctx context.Context, addr sdk.AccAddress, den
 
 // IterateAccountBalances iterates over the balances of a single account and
 // provides the token balance to a callback. If true is returned from the
-// callback, iteration is halted.
+// callback, iteration is halted. The balances are the stored ones, which
+// include the pending decay of the demurrage denominations.
 func (k BaseViewKeeper) IterateAccountBalances(ctx context.Context, addr sdk.AccAddress, cb func(sdk.Coin) bool) {
 	err := k.Balances.Walk(ctx, collections.NewPrefixedPairRange[sdk.AccAddress, string](addr), func(key collections.Pair[sdk.AccAddress, string], value math.Int) bool {
 		return cb(sdk.NewCoin(key.K2(), value))
@@ -167,7 +244,9 @@ func (k BaseViewKeeper) IterateAccountBalances(ctx context.Context, addr sdk.Acc
 
 // IterateAllBalances iterates over all the balances of all accounts and
 // denominations that are provided to a callback. If true is returned from the
-// callback, iteration is halted.
+// callback, iteration is halted. The balances are the stored ones, which
+// include the pending decay of the demurrage denominations, so that they sum up
+// to the total supply.
 func (k BaseViewKeeper) IterateAllBalances(ctx context.Context, cb func(sdk.AccAddress, sdk.Coin) bool) {
 	err := k.Balances.Walk(ctx, nil, func(key collections.Pair[sdk.AccAddress, string], value math.Int) bool {
 		return cb(key.K1(), sdk.NewCoin(key.K2(), value))
@@ -207,17 +286,52 @@ func (k BaseViewKeeper) SpendableCoins(ctx context.Context, addr sdk.AccAddress)
 // is returned.
 func (k BaseViewKeeper) SpendableCoin(ctx context.Context, addr sdk.AccAddress, denom string) sdk.Coin {
 	balance := k.GetBalance(ctx, addr, denom)
-	locked := k.LockedCoins(ctx, addr)
-	return balance.SubAmount(locked.AmountOf(denom))
+	unspendable := k.unspendableCoins(ctx, addr).AmountOf(denom)
+	if balance.Amount.LT(unspendable) {
+		return sdk.NewCoin(denom, math.ZeroInt())
+	}
+
+	return balance.SubAmount(unspendable)
+}
+
+// ProjectedSpendableCoins returns the coins of an account spendable at the
+// given time, not before the block time, assuming its balance is unchanged,
+// along with the coins still locked by its vesting schedule and held by the
+// holds not expired at that time.
+func (k BaseViewKeeper) ProjectedSpendableCoins(ctx context.Context, addr sdk.AccAddress, t time.Time) (spendable, locked, held sdk.Coins) {
+	locked = sdk.NewCoins()
+	if acc := k.ak.GetAccount(ctx, addr); acc != nil {
+		if vacc, ok := acc.(types.VestingAccount); ok {
+			locked = vacc.LockedCoins(t)
+		}
+	}
+
+	held = sdk.NewCoins()
+	for _, hold := range k.getHolds(ctx, addr, t) {
+		held = held.Add(hold.Amount...)
+	}
+
+	spendable, hasNeg := k.GetAllBalances(ctx, addr).SafeSubValid(locked.Add(held...))
+	if hasNeg {
+		spendable = sdk.NewCoins()
+	}
+
+	return spendable, locked, held
+}
+
+// unspendableCoins returns the coins of an account which are either locked or
+// held.
+func (k BaseViewKeeper) unspendableCoins(ctx context.Context, addr sdk.AccAddress) sdk.Coins {
+	return k.LockedCoins(ctx, addr).Add(k.HeldCoins(ctx, addr)...)
 }
 
 // spendableCoins returns the coins the given address can spend alongside the total amount of coins it holds.
 // It exists for gas efficiency, in order to avoid to have to get balance multiple times.
 func (k BaseViewKeeper) spendableCoins(ctx context.Context, addr sdk.AccAddress) (spendable, total sdk.Coins) {
 	total = k.GetAllBalances(ctx, addr)
-	locked := k.LockedCoins(ctx, addr)
+	locked := k.unspendableCoins(ctx, addr)
 
-	spendable, hasNeg := total.SafeSub(locked...)
+	spendable, hasNeg := total.SafeSubValid(locked)
 	if hasNeg {
 		spendable = sdk.NewCoins()
 		return
@@ -74,6 +74,7 @@ func TestMigrateJSON(t *testing.T) {
 		}
 	],
 	"denom_metadata": [],
+	"holds": [],
 	"params": {
 		"default_send_enabled": false,
 		"send_enabled": []
@@ -4,13 +4,16 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"sort"
 	"time"
 
 	modulev1 "cosmossdk.io/api/cosmos/bank/module/v1"
 	"cosmossdk.io/core/appmodule"
 	"cosmossdk.io/depinject"
+	"cosmossdk.io/errors"
 	"cosmossdk.io/log"
 	abci "github.com/cometbft/cometbft/abci/types"
+	"github.com/cosmos/gogoproto/proto"
 	gwruntime "github.com/grpc-ecosystem/grpc-gateway/runtime"
 	"github.com/spf13/cobra"
 
@@ -21,6 +24,7 @@ import (
 	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
 	"github.com/cosmos/cosmos-sdk/telemetry"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	"github.com/cosmos/cosmos-sdk/types/module"
 	simtypes "github.com/cosmos/cosmos-sdk/types/simulation"
 	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
@@ -37,9 +41,15 @@ import (
 const ConsensusVersion = 4
 
 var (
-	_ module.AppModule           = AppModule{}
-	_ module.AppModuleBasic      = AppModuleBasic{}
-	_ module.AppModuleSimulation = AppModule{}
+	_ module.AppModule                = AppModule{}
+	_ module.AppModuleBasic           = AppModuleBasic{}
+	_ module.AppModuleSimulation      = AppModule{}
+	_ module.HasStreamableCollections = AppModule{}
+)
+
+// Module init related flags
+const (
+	FlagSupplyChecker = "x-bank-supply-checker"
 )
 
 // AppModuleBasic defines the basic application module used by the bank module.
@@ -107,7 +117,10 @@ type AppModule struct {
 	legacySubspace exported.Subspace
 }
 
-var _ appmodule.AppModule = AppModule{}
+var (
+	_ appmodule.AppModule     = AppModule{}
+	_ appmodule.HasEndBlocker = AppModule{}
+)
 
 // IsOnePerModuleType implements the depinject.OnePerModuleType interface.
 func (am AppModule) IsOnePerModuleType() {}
@@ -115,6 +128,11 @@ func (am AppModule) IsOnePerModuleType() {}
 // IsAppModule implements the appmodule.AppModule interface.
 func (am AppModule) IsAppModule() {}
 
+// AddModuleInitFlags implements servertypes.ModuleInitFlags interface.
+func AddModuleInitFlags(startCmd *cobra.Command) {
+	startCmd.Flags().Bool(FlagSupplyChecker, false, "Continuously verify the x/bank total supply invariant off-consensus from the streamed state changes")
+}
+
 // RegisterServices registers module services.
 func (am AppModule) RegisterServices(cfg module.Configurator) {
 	types.RegisterMsgServer(cfg.MsgServer(), keeper.NewMsgServerImpl(am.keeper))
@@ -174,9 +192,49 @@ func (am AppModule) ExportGenesis(ctx sdk.Context, cdc codec.JSONCodec) json.Raw
 	return cdc.MustMarshalJSON(gs)
 }
 
+// EndBlock takes the balance snapshots due at the current height, sweeps the
+// dust balances at the end of each dust sweep epoch and ends the epochs of the
+// demurrage schedules.
+func (am AppModule) EndBlock(ctx context.Context) error {
+	if err := am.keeper.TakeBalanceSnapshots(ctx); err != nil {
+		return err
+	}
+
+	if err := am.keeper.SweepDust(ctx); err != nil {
+		return err
+	}
+
+	return am.keeper.AdvanceDemurrageEpochs(ctx)
+}
+
 // ConsensusVersion implements AppModule/ConsensusVersion.
 func (AppModule) ConsensusVersion() uint64 { return ConsensusVersion }
 
+// UpdatableParams implements the module.HasUpdatableParams interface.
+func (am AppModule) UpdatableParams() module.UpdatableParams {
+	return module.UpdatableParams{
+		UpdateMsg: &types.MsgUpdateParams{},
+		Authority: am.keeper.GetAuthority(),
+		GetParams: func(ctx context.Context) (proto.Message, error) {
+			params := am.keeper.GetParams(ctx)
+			return &params, nil
+		},
+	}
+}
+
+// StreamableCollections implements module.HasStreamableCollections. The
+// balances and the supply of x/bank are streamed.
+func (am AppModule) StreamableCollections() module.StreamableCollections {
+	k := am.keeper.(keeper.BaseKeeper)
+	return module.StreamableCollections{
+		StoreKey: types.StoreKey,
+		Collections: []module.StreamedCollection{
+			module.NewStreamedCollection(types.BalancesPrefix, "balances", k.Balances.KeyCodec(), k.Balances.ValueCodec()),
+			module.NewStreamedCollection(types.SupplyKey, "supply", k.Supply.KeyCodec(), k.Supply.ValueCodec()),
+		},
+	}
+}
+
 // AppModuleSimulation functions
 
 // GenerateGenesisState creates a randomized GenState of the bank module.
@@ -204,6 +262,9 @@ func (am AppModule) WeightedOperations(simState module.SimulationState) []simtyp
 func init() {
 	appmodule.Register(&modulev1.Module{},
 		appmodule.Provide(ProvideModule),
+		appmodule.Invoke(InvokeSetSendRestrictions),
+		appmodule.Invoke(InvokeSetBankHooks),
+		appmodule.Invoke(InvokeSetCommunityPoolKeeper),
 	)
 }
 
@@ -224,8 +285,10 @@ type ModuleInputs struct {
 type ModuleOutputs struct {
 	depinject.Out
 
-	BankKeeper keeper.BaseKeeper
-	Module     appmodule.AppModule
+	BankKeeper           keeper.BaseKeeper
+	ViewKeeper           keeper.ViewKeeper
+	Module               appmodule.AppModule
+	ParamsValidatorRoute govtypes.ParamsValidatorRoute
 }
 
 func ProvideModule(in ModuleInputs) ModuleOutputs {
@@ -260,5 +323,71 @@ func ProvideModule(in ModuleInputs) ModuleOutputs {
 	)
 	m := NewAppModule(in.Cdc, bankKeeper, in.AccountKeeper, in.LegacySubspace)
 
-	return ModuleOutputs{BankKeeper: bankKeeper, Module: m}
+	return ModuleOutputs{BankKeeper: bankKeeper, ViewKeeper: bankKeeper, Module: m, ParamsValidatorRoute: NewParamsValidatorRoute()}
+}
+
+// InvokeSetSendRestrictions registers the send restrictions provided by the
+// modules, applied in the lexical order of the module names.
+func InvokeSetSendRestrictions(bankKeeper keeper.BaseKeeper, restrictions map[string]types.SendRestrictionFn) {
+	if restrictions == nil {
+		return
+	}
+
+	modNames := make([]string, 0, len(restrictions))
+	for modName := range restrictions {
+		modNames = append(modNames, modName)
+	}
+	sort.Strings(modNames)
+
+	for _, modName := range modNames {
+		bankKeeper.AppendSendRestriction(restrictions[modName])
+	}
+}
+
+// InvokeSetBankHooks sets the bank hooks provided by the modules, called in
+// the lexical order of the module names.
+func InvokeSetBankHooks(bankKeeper keeper.BaseKeeper, bankHooks map[string]types.BankHooksWrapper) {
+	if len(bankHooks) == 0 {
+		return
+	}
+
+	modNames := make([]string, 0, len(bankHooks))
+	for modName := range bankHooks {
+		modNames = append(modNames, modName)
+	}
+	sort.Strings(modNames)
+
+	var multiHooks types.MultiBankHooks
+	for _, modName := range modNames {
+		multiHooks = append(multiHooks, bankHooks[modName])
+	}
+
+	bankKeeper.SetHooks(multiHooks)
+}
+
+// NewParamsValidatorRoute returns the params validator route of the x/bank
+// MsgUpdateParams message, used by the x/gov MsgBatchUpdateParams message.
+func NewParamsValidatorRoute() govtypes.ParamsValidatorRoute {
+	return govtypes.ParamsValidatorRoute{
+		MsgTypeURL: sdk.MsgTypeURL(&types.MsgUpdateParams{}),
+		Validator: func(_ context.Context, msg sdk.Msg) error {
+			m, ok := msg.(*types.MsgUpdateParams)
+			if !ok {
+				return errors.Wrapf(sdkerrors.ErrInvalidType, "expected %T, got %T", &types.MsgUpdateParams{}, msg)
+			}
+
+			return m.Params.Validate()
+		},
+	}
+}
+
+// InvokeSetCommunityPoolKeeper sets the keeper funding the community pool with
+// the swept dust balances, i.e. the x/distribution keeper when the app has one.
+func InvokeSetCommunityPoolKeeper(bankKeeper keeper.BaseKeeper, communityPoolKeeper types.CommunityPoolKeeper) {
+	// all arguments to invokers are optional
+	if communityPoolKeeper == nil {
+		return
+	}
+
+	bankKeeper.SetCommunityPoolKeeper(communityPoolKeeper)
 }
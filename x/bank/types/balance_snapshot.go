@@ -0,0 +1,146 @@
+package types
+
+import (
+	"crypto/sha256"
+	"errors"
+	"fmt"
+
+	"github.com/cometbft/cometbft/crypto/merkle"
+
+	"cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/address"
+)
+
+// innerPrefix is the prefix of the inner nodes of the CometBFT simple merkle
+// tree, which isn't exported by CometBFT.
+var innerPrefix = []byte{1}
+
+// NewBalanceSnapshot returns a new balance snapshot of the denom, not taken
+// yet, recording the balances at the end of the given height.
+func NewBalanceSnapshot(id uint64, denom string, height int64) BalanceSnapshot {
+	return BalanceSnapshot{
+		Id:     id,
+		Denom:  denom,
+		Height: height,
+		Total:  math.ZeroInt(),
+	}
+}
+
+// Validate checks for denom, height and totals correctness.
+func (s BalanceSnapshot) Validate() error {
+	if err := sdk.ValidateDenom(s.Denom); err != nil {
+		return err
+	}
+
+	if s.Height <= 0 {
+		return fmt.Errorf("balance snapshot %d has a non-positive height %d", s.Id, s.Height)
+	}
+
+	if s.Total.IsNil() || s.Total.IsNegative() {
+		return fmt.Errorf("balance snapshot %d has an invalid total", s.Id)
+	}
+
+	if !s.Taken && (len(s.Root) > 0 || s.Count > 0 || !s.Total.IsZero()) {
+		return fmt.Errorf("balance snapshot %d is not taken but has balances", s.Id)
+	}
+
+	return nil
+}
+
+// Validate checks for address and amount correctness.
+func (b SnapshotBalance) Validate() error {
+	if _, err := sdk.AccAddressFromBech32(b.Address); err != nil {
+		return err
+	}
+
+	if b.Amount.IsNil() || !b.Amount.IsPositive() {
+		return fmt.Errorf("snapshot balance of %s is not positive", b.Address)
+	}
+
+	return nil
+}
+
+// SnapshotLeaf returns the leaf of the merkle tree of a balance snapshot for
+// the balance of an account, i.e. the length-prefixed address of the account
+// followed by the decimal string of its balance.
+func SnapshotLeaf(addr sdk.AccAddress, amount math.Int) []byte {
+	return append(address.MustLengthPrefix(addr), []byte(amount.String())...)
+}
+
+// SnapshotRoot returns the merkle root of the leaves of a balance snapshot.
+func SnapshotRoot(leaves [][]byte) []byte {
+	return merkle.HashFromByteSlices(leaves)
+}
+
+// NewBalanceSnapshotProof returns the merkle proof of inclusion of the leaf
+// at the given index in the root of the leaves, without computing the proofs
+// of the other leaves.
+func NewBalanceSnapshotProof(leaves [][]byte, index int) BalanceSnapshotProof {
+	if index < 0 || index >= len(leaves) {
+		panic(fmt.Sprintf("leaf index %d out of range [0, %d)", index, len(leaves)))
+	}
+
+	_, aunts := snapshotAunts(leaves, index)
+	return BalanceSnapshotProof{
+		Total:    int64(len(leaves)),
+		Index:    int64(index),
+		LeafHash: merkle.HashFromByteSlices(leaves[index : index+1]),
+		Aunts:    aunts,
+	}
+}
+
+// snapshotAunts returns the root of the leaves, along with the hashes of the
+// sibling subtrees of the leaf at the given index from the leaf to the root.
+func snapshotAunts(leaves [][]byte, index int) (root []byte, aunts [][]byte) {
+	if len(leaves) == 1 {
+		return merkle.HashFromByteSlices(leaves), nil
+	}
+
+	k := splitPoint(len(leaves))
+	if index < k {
+		left, aunts := snapshotAunts(leaves[:k], index)
+		right := merkle.HashFromByteSlices(leaves[k:])
+		return innerHash(left, right), append(aunts, right)
+	}
+
+	left := merkle.HashFromByteSlices(leaves[:k])
+	right, aunts := snapshotAunts(leaves[k:], index-k)
+	return innerHash(left, right), append(aunts, left)
+}
+
+// splitPoint returns the largest power of 2 less than length, as the split
+// point of the CometBFT simple merkle tree.
+func splitPoint(length int) int {
+	k := 1
+	for k*2 < length {
+		k *= 2
+	}
+	return k
+}
+
+func innerHash(left, right []byte) []byte {
+	h := sha256.New()
+	h.Write(innerPrefix)
+	h.Write(left)
+	h.Write(right)
+	return h.Sum(nil)
+}
+
+// VerifyBalanceSnapshotProof verifies that the balance of an account is
+// included in the root of a balance snapshot, e.g. for an airdrop claimed on
+// another chain.
+func VerifyBalanceSnapshotProof(root []byte, addr sdk.AccAddress, amount math.Int, proof BalanceSnapshotProof) error {
+	if len(root) == 0 {
+		return errors.New("empty balance snapshot root")
+	}
+
+	p := merkle.Proof{
+		Total:    proof.Total,
+		Index:    proof.Index,
+		LeafHash: proof.LeafHash,
+		Aunts:    proof.Aunts,
+	}
+	return p.Verify(root, SnapshotLeaf(addr, amount))
+}
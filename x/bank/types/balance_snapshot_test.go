@@ -0,0 +1,56 @@
+package types_test
+
+import (
+	"testing"
+
+	"github.com/cometbft/cometbft/crypto/merkle"
+	"github.com/stretchr/testify/require"
+
+	"cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/bank/types"
+)
+
+func TestBalanceSnapshotProof(t *testing.T) {
+	for _, n := range []int{1, 2, 3, 4, 5, 7, 8, 13} {
+		addrs := make([]sdk.AccAddress, n)
+		leaves := make([][]byte, n)
+		for i := range addrs {
+			addrs[i] = sdk.AccAddress([]byte{byte(i + 1)})
+			leaves[i] = types.SnapshotLeaf(addrs[i], math.NewInt(int64(i+1)))
+		}
+
+		// the proofs match the CometBFT simple merkle tree proofs
+		root, expected := merkle.ProofsFromByteSlices(leaves)
+		require.Equal(t, root, types.SnapshotRoot(leaves))
+
+		for i := range leaves {
+			proof := types.NewBalanceSnapshotProof(leaves, i)
+			require.Equal(t, expected[i].Total, proof.Total)
+			require.Equal(t, expected[i].Index, proof.Index)
+			require.Equal(t, expected[i].LeafHash, proof.LeafHash)
+			require.Equal(t, expected[i].Aunts, proof.Aunts)
+
+			require.NoError(t, types.VerifyBalanceSnapshotProof(root, addrs[i], math.NewInt(int64(i+1)), proof))
+			require.Error(t, types.VerifyBalanceSnapshotProof(root, addrs[i], math.NewInt(int64(i+2)), proof))
+		}
+	}
+}
+
+func TestBalanceSnapshotValidate(t *testing.T) {
+	snapshot := types.NewBalanceSnapshot(1, "foo", 10)
+	require.NoError(t, snapshot.Validate())
+
+	invalid := snapshot
+	invalid.Height = 0
+	require.Error(t, invalid.Validate())
+
+	invalid = snapshot
+	invalid.Denom = "1foo"
+	require.Error(t, invalid.Validate())
+
+	invalid = snapshot
+	invalid.Count = 1
+	require.Error(t, invalid.Validate())
+}
@@ -4,6 +4,7 @@
 package types
 
 import (
+	cosmossdk_io_math "cosmossdk.io/math"
 	fmt "fmt"
 	_ "github.com/cosmos/cosmos-proto"
 	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
@@ -12,15 +13,20 @@ import (
 	_ "github.com/cosmos/cosmos-sdk/types/tx/amino"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	proto "github.com/cosmos/gogoproto/proto"
+	github_com_cosmos_gogoproto_types "github.com/cosmos/gogoproto/types"
+	_ "google.golang.org/protobuf/types/known/durationpb"
+	_ "google.golang.org/protobuf/types/known/timestamppb"
 	io "io"
 	math "math"
 	math_bits "math/bits"
+	time "time"
 )
 
 // Reference imports to suppress errors if they are not otherwise used.
 var _ = proto.Marshal
 var _ = fmt.Errorf
 var _ = math.Inf
+var _ = time.Kitchen
 
 // This is a compile-time assertion to ensure that this generated file
 // is compatible with the proto package it is being compiled against.
@@ -37,6 +43,10 @@ type Params struct {
 	// As of cosmos-sdk 0.47, this only exists for backwards compatibility of genesis files.
 	SendEnabled        []*SendEnabled `protobuf:"bytes,1,rep,name=send_enabled,json=sendEnabled,proto3" json:"send_enabled,omitempty"` // Deprecated: Do not use.
 	DefaultSendEnabled bool           `protobuf:"varint,2,opt,name=default_send_enabled,json=defaultSendEnabled,proto3" json:"default_send_enabled,omitempty"`
+	// dust_sweep defines the sweeping of the dust balances.
+	//
+	// Since: cosmos-sdk 0.48
+	DustSweep DustSweepParams `protobuf:"bytes,3,opt,name=dust_sweep,json=dustSweep,proto3" json:"dust_sweep"`
 }
 
 func (m *Params) Reset()         { *m = Params{} }
@@ -87,6 +97,116 @@ func (m *Params) GetDefaultSendEnabled() bool {
 	return false
 }
 
+func (m *Params) GetDustSweep() DustSweepParams {
+	if m != nil {
+		return m.DustSweep
+	}
+	return DustSweepParams{}
+}
+
+// DustSweepParams defines the sweeping of the dust balances, i.e. the balances
+// below the dust threshold of their denomination, which are transferred to the
+// community pool or burned at the end of each sweep epoch to curb the state
+// growth.
+//
+// Since: cosmos-sdk 0.48
+type DustSweepParams struct {
+	// thresholds are the dust thresholds of the swept denominations. A positive
+	// balance of a denomination below its threshold is dust.
+	Thresholds github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,1,rep,name=thresholds,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"thresholds"`
+	// epoch_length is the number of blocks between two sweeps. Zero disables the
+	// sweeping.
+	EpochLength int64 `protobuf:"varint,2,opt,name=epoch_length,json=epochLength,proto3" json:"epoch_length,omitempty"`
+	// chain_wide defines whether the balances of all the accounts are swept, or
+	// only the balances of the accounts which opted in.
+	ChainWide bool `protobuf:"varint,3,opt,name=chain_wide,json=chainWide,proto3" json:"chain_wide,omitempty"`
+	// burn defines whether the dust is burned instead of being transferred to
+	// the community pool.
+	Burn bool `protobuf:"varint,4,opt,name=burn,proto3" json:"burn,omitempty"`
+	// exempt_addresses are the addresses whose balances are never swept. The
+	// module accounts are always exempt.
+	ExemptAddresses []string `protobuf:"bytes,5,rep,name=exempt_addresses,json=exemptAddresses,proto3" json:"exempt_addresses,omitempty"`
+	// max_accounts_per_epoch is the maximum number of accounts visited by a
+	// sweep, the next sweep resuming after the last visited account. Zero
+	// disables the limit.
+	MaxAccountsPerEpoch uint64 `protobuf:"varint,6,opt,name=max_accounts_per_epoch,json=maxAccountsPerEpoch,proto3" json:"max_accounts_per_epoch,omitempty"`
+}
+
+func (m *DustSweepParams) Reset()         { *m = DustSweepParams{} }
+func (m *DustSweepParams) String() string { return proto.CompactTextString(m) }
+func (*DustSweepParams) ProtoMessage()    {}
+func (*DustSweepParams) Descriptor() ([]byte, []int) {
+	return fileDescriptor_dd052eee12edf988, []int{1}
+}
+func (m *DustSweepParams) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DustSweepParams) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DustSweepParams.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *DustSweepParams) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DustSweepParams.Merge(m, src)
+}
+func (m *DustSweepParams) XXX_Size() int {
+	return m.Size()
+}
+func (m *DustSweepParams) XXX_DiscardUnknown() {
+	xxx_messageInfo_DustSweepParams.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DustSweepParams proto.InternalMessageInfo
+
+func (m *DustSweepParams) GetThresholds() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Thresholds
+	}
+	return nil
+}
+
+func (m *DustSweepParams) GetEpochLength() int64 {
+	if m != nil {
+		return m.EpochLength
+	}
+	return 0
+}
+
+func (m *DustSweepParams) GetChainWide() bool {
+	if m != nil {
+		return m.ChainWide
+	}
+	return false
+}
+
+func (m *DustSweepParams) GetBurn() bool {
+	if m != nil {
+		return m.Burn
+	}
+	return false
+}
+
+func (m *DustSweepParams) GetExemptAddresses() []string {
+	if m != nil {
+		return m.ExemptAddresses
+	}
+	return nil
+}
+
+func (m *DustSweepParams) GetMaxAccountsPerEpoch() uint64 {
+	if m != nil {
+		return m.MaxAccountsPerEpoch
+	}
+	return 0
+}
+
 // SendEnabled maps coin denom to a send_enabled status (whether a denom is
 // sendable).
 type SendEnabled struct {
@@ -98,7 +218,7 @@ func (m *SendEnabled) Reset()         { *m = SendEnabled{} }
 func (m *SendEnabled) String() string { return proto.CompactTextString(m) }
 func (*SendEnabled) ProtoMessage()    {}
 func (*SendEnabled) Descriptor() ([]byte, []int) {
-	return fileDescriptor_dd052eee12edf988, []int{1}
+	return fileDescriptor_dd052eee12edf988, []int{2}
 }
 func (m *SendEnabled) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -151,7 +271,7 @@ func (m *Input) Reset()         { *m = Input{} }
 func (m *Input) String() string { return proto.CompactTextString(m) }
 func (*Input) ProtoMessage()    {}
 func (*Input) Descriptor() ([]byte, []int) {
-	return fileDescriptor_dd052eee12edf988, []int{2}
+	return fileDescriptor_dd052eee12edf988, []int{3}
 }
 func (m *Input) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -190,7 +310,7 @@ func (m *Output) Reset()         { *m = Output{} }
 func (m *Output) String() string { return proto.CompactTextString(m) }
 func (*Output) ProtoMessage()    {}
 func (*Output) Descriptor() ([]byte, []int) {
-	return fileDescriptor_dd052eee12edf988, []int{3}
+	return fileDescriptor_dd052eee12edf988, []int{4}
 }
 func (m *Output) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -219,6 +339,50 @@ func (m *Output) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_Output proto.InternalMessageInfo
 
+// BatchOutput models an output of a batch send, along with its memo.
+//
+// Since: cosmos-sdk 0.48
+type BatchOutput struct {
+	Address string                                   `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	Coins   github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,2,rep,name=coins,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"coins"`
+	// memo is an optional reference of the output, e.g. the identifier of a
+	// withdrawal, emitted in its transfer event.
+	Memo string `protobuf:"bytes,3,opt,name=memo,proto3" json:"memo,omitempty"`
+}
+
+func (m *BatchOutput) Reset()         { *m = BatchOutput{} }
+func (m *BatchOutput) String() string { return proto.CompactTextString(m) }
+func (*BatchOutput) ProtoMessage()    {}
+func (*BatchOutput) Descriptor() ([]byte, []int) {
+	return fileDescriptor_dd052eee12edf988, []int{5}
+}
+func (m *BatchOutput) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *BatchOutput) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_BatchOutput.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *BatchOutput) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BatchOutput.Merge(m, src)
+}
+func (m *BatchOutput) XXX_Size() int {
+	return m.Size()
+}
+func (m *BatchOutput) XXX_DiscardUnknown() {
+	xxx_messageInfo_BatchOutput.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BatchOutput proto.InternalMessageInfo
+
 // Supply represents a struct that passively keeps track of the total supply
 // amounts in the network.
 // This message is deprecated now that supply is indexed by denom.
@@ -232,7 +396,7 @@ func (m *Supply) Reset()         { *m = Supply{} }
 func (m *Supply) String() string { return proto.CompactTextString(m) }
 func (*Supply) ProtoMessage()    {}
 func (*Supply) Descriptor() ([]byte, []int) {
-	return fileDescriptor_dd052eee12edf988, []int{4}
+	return fileDescriptor_dd052eee12edf988, []int{6}
 }
 func (m *Supply) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -280,7 +444,7 @@ func (m *DenomUnit) Reset()         { *m = DenomUnit{} }
 func (m *DenomUnit) String() string { return proto.CompactTextString(m) }
 func (*DenomUnit) ProtoMessage()    {}
 func (*DenomUnit) Descriptor() ([]byte, []int) {
-	return fileDescriptor_dd052eee12edf988, []int{5}
+	return fileDescriptor_dd052eee12edf988, []int{7}
 }
 func (m *DenomUnit) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -365,7 +529,7 @@ func (m *Metadata) Reset()         { *m = Metadata{} }
 func (m *Metadata) String() string { return proto.CompactTextString(m) }
 func (*Metadata) ProtoMessage()    {}
 func (*Metadata) Descriptor() ([]byte, []int) {
-	return fileDescriptor_dd052eee12edf988, []int{6}
+	return fileDescriptor_dd052eee12edf988, []int{8}
 }
 func (m *Metadata) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -450,633 +614,4729 @@ func (m *Metadata) GetURIHash() string {
 	return ""
 }
 
-func init() {
-	proto.RegisterType((*Params)(nil), "cosmos.bank.v1beta1.Params")
-	proto.RegisterType((*SendEnabled)(nil), "cosmos.bank.v1beta1.SendEnabled")
-	proto.RegisterType((*Input)(nil), "cosmos.bank.v1beta1.Input")
-	proto.RegisterType((*Output)(nil), "cosmos.bank.v1beta1.Output")
-	proto.RegisterType((*Supply)(nil), "cosmos.bank.v1beta1.Supply")
-	proto.RegisterType((*DenomUnit)(nil), "cosmos.bank.v1beta1.DenomUnit")
-	proto.RegisterType((*Metadata)(nil), "cosmos.bank.v1beta1.Metadata")
+// Hold defines a portion of the balance of an account held by a holder, e.g. a
+// module escrowing it. The held coins stay in the account but cannot be spent
+// until they are released.
+//
+// Since: cosmos-sdk 0.48
+type Hold struct {
+	// address is the address of the account whose balance is held.
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	// holder is the name of the holder of the hold, e.g. a module name.
+	Holder string `protobuf:"bytes,2,opt,name=holder,proto3" json:"holder,omitempty"`
+	// amount is the amount of coins held.
+	Amount github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,3,rep,name=amount,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"amount"`
+	// expiration is the time at which the hold is released automatically, if
+	// any.
+	Expiration *time.Time `protobuf:"bytes,4,opt,name=expiration,proto3,stdtime" json:"expiration,omitempty"`
 }
 
-func init() { proto.RegisterFile("cosmos/bank/v1beta1/bank.proto", fileDescriptor_dd052eee12edf988) }
-
-var fileDescriptor_dd052eee12edf988 = []byte{
-	// 677 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xd4, 0x54, 0xbd, 0x6f, 0xd3, 0x40,
-	0x14, 0xcf, 0x25, 0xcd, 0x47, 0x2f, 0x65, 0xe0, 0x88, 0xe0, 0x5a, 0x84, 0x13, 0x65, 0x40, 0x69,
-	0xa4, 0x26, 0xb4, 0x6c, 0x59, 0x10, 0x29, 0x5f, 0x19, 0x10, 0xc8, 0x55, 0x85, 0xc4, 0x12, 0x5d,
-	0xe2, 0x23, 0x39, 0xd5, 0xbe, 0xb3, 0x7c, 0xe7, 0xd2, 0xac, 0x4c, 0xa8, 0x13, 0x33, 0x53, 0xc5,
-	0x84, 0x10, 0x43, 0x86, 0xee, 0xac, 0x55, 0xa7, 0x8a, 0x89, 0xa9, 0xa0, 0x74, 0x48, 0xff, 0x0c,
-	0xe4, 0x3b, 0x3b, 0x4d, 0xa5, 0xc2, 0x88, 0xc4, 0x92, 0xbc, 0xf7, 0x7e, 0x3f, 0xbf, 0xf7, 0x7b,
-	0x1f, 0x36, 0xb4, 0xfa, 0x42, 0x7a, 0x42, 0x36, 0x7b, 0x84, 0xef, 0x34, 0x77, 0xd7, 0x7b, 0x54,
-	0x91, 0x75, 0xed, 0x34, 0xfc, 0x40, 0x28, 0x81, 0x6e, 0x18, 0xbc, 0xa1, 0x43, 0x31, 0xbe, 0x52,
-	0x1a, 0x88, 0x81, 0xd0, 0x78, 0x33, 0xb2, 0x0c, 0x75, 0x65, 0xd9, 0x50, 0xbb, 0x06, 0x88, 0x9f,
-	0x33, 0xd0, 0x45, 0x15, 0x49, 0x67, 0x55, 0xfa, 0x82, 0xf1, 0x18, 0xbf, 0x15, 0xe3, 0x9e, 0x1c,
-	0x34, 0x77, 0xd7, 0xa3, 0xbf, 0x18, 0xb8, 0x4e, 0x3c, 0xc6, 0x45, 0x53, 0xff, 0x9a, 0x50, 0xf5,
-	0x13, 0x80, 0xb9, 0x97, 0x24, 0x20, 0x9e, 0x44, 0x4f, 0xe1, 0x92, 0xa4, 0xdc, 0xe9, 0x52, 0x4e,
-	0x7a, 0x2e, 0x75, 0x30, 0xa8, 0x64, 0x6a, 0xc5, 0x8d, 0x4a, 0xe3, 0x0a, 0xcd, 0x8d, 0x2d, 0xca,
-	0x9d, 0xc7, 0x86, 0xd7, 0x4e, 0x63, 0x60, 0x17, 0xe5, 0x45, 0x00, 0xdd, 0x83, 0x25, 0x87, 0xbe,
-	0x21, 0xa1, 0xab, 0xba, 0x97, 0x12, 0xa6, 0x2b, 0xa0, 0x56, 0xb0, 0x51, 0x8c, 0xcd, 0xa5, 0x68,
-	0xdd, 0xd9, 0x9f, 0x8e, 0xeb, 0xd8, 0x14, 0x5a, 0x93, 0xce, 0x4e, 0x73, 0xcf, 0x8c, 0xd0, 0x28,
-	0xab, 0x6e, 0xc2, 0xe2, 0x1c, 0x1b, 0x95, 0x60, 0xd6, 0xa1, 0x5c, 0x78, 0x18, 0x54, 0x40, 0x6d,
-	0xd1, 0x36, 0x0e, 0xc2, 0x30, 0x7f, 0xb9, 0x50, 0xe2, 0xb6, 0x16, 0xce, 0x0f, 0xca, 0xa0, 0x7a,
-	0x0c, 0x60, 0xb6, 0xc3, 0xfd, 0x50, 0xa1, 0x0d, 0x98, 0x27, 0x8e, 0x13, 0x50, 0x29, 0x4d, 0x86,
-	0x36, 0xfe, 0x7e, 0xb8, 0x56, 0x8a, 0xdb, 0x7c, 0x68, 0x90, 0x2d, 0x15, 0x30, 0x3e, 0xb0, 0x13,
-	0x22, 0x7a, 0x0b, 0xb3, 0xd1, 0x84, 0x25, 0x4e, 0xeb, 0xa9, 0x2c, 0x5f, 0x4c, 0x45, 0xd2, 0xd9,
-	0x54, 0x36, 0x05, 0xe3, 0xed, 0x27, 0x47, 0xa7, 0xe5, 0xd4, 0x97, 0x9f, 0xe5, 0xda, 0x80, 0xa9,
-	0x61, 0xd8, 0x6b, 0xf4, 0x85, 0x17, 0xaf, 0xaf, 0x39, 0xd7, 0xa0, 0x1a, 0xf9, 0x54, 0xea, 0x07,
-	0xe4, 0xc7, 0xe9, 0xb8, 0xbe, 0xe4, 0xd2, 0x01, 0xe9, 0x8f, 0xba, 0xba, 0xc6, 0xe7, 0xe9, 0xb8,
-	0x0e, 0x6c, 0x53, 0xaf, 0x55, 0x7a, 0x7f, 0x50, 0x4e, 0x9d, 0x1f, 0x94, 0x53, 0xef, 0xa6, 0xe3,
-	0x7a, 0x22, 0xa7, 0xfa, 0x0d, 0xc0, 0xdc, 0x8b, 0x50, 0xfd, 0x77, 0xdd, 0x14, 0x92, 0x6e, 0xaa,
-	0x5f, 0x01, 0xcc, 0x6d, 0x85, 0xbe, 0xef, 0x8e, 0x22, 0x35, 0x4a, 0x28, 0xe2, 0xc6, 0x17, 0xf7,
-	0x2f, 0xd4, 0xe8, 0x7a, 0xad, 0xd5, 0x58, 0x0d, 0x38, 0x3e, 0x5c, 0xbb, 0x7d, 0xe5, 0x99, 0x6b,
-	0x81, 0x1d, 0x0c, 0xaa, 0xaf, 0xe0, 0xe2, 0xa3, 0xe8, 0xcc, 0xb6, 0x39, 0x53, 0x7f, 0x38, 0xc0,
-	0x15, 0x58, 0xa0, 0x7b, 0xbe, 0xe0, 0x94, 0x2b, 0x7d, 0x81, 0xd7, 0xec, 0x99, 0x1f, 0x1d, 0x27,
-	0x71, 0x19, 0x91, 0x54, 0xe2, 0x4c, 0x25, 0x53, 0x5b, 0xb4, 0x13, 0xb7, 0xba, 0x9f, 0x86, 0x85,
-	0xe7, 0x54, 0x11, 0x87, 0x28, 0x82, 0x2a, 0xb0, 0xe8, 0x50, 0xd9, 0x0f, 0x98, 0xaf, 0x98, 0xe0,
-	0x71, 0xfa, 0xf9, 0x10, 0x7a, 0x10, 0x31, 0xb8, 0xf0, 0xba, 0x21, 0x67, 0x2a, 0xd9, 0x9f, 0x75,
-	0xe5, 0x3b, 0x3a, 0xd3, 0x6b, 0x43, 0x27, 0x31, 0x25, 0x42, 0x70, 0x21, 0x9a, 0x2b, 0xce, 0xe8,
-	0xdc, 0xda, 0x8e, 0xd4, 0x39, 0x4c, 0xfa, 0x2e, 0x19, 0xe1, 0x05, 0x1d, 0x4e, 0xdc, 0x88, 0xcd,
-	0x89, 0x47, 0x71, 0xd6, 0xb0, 0x23, 0x1b, 0xdd, 0x84, 0x39, 0x39, 0xf2, 0x7a, 0xc2, 0xc5, 0x39,
-	0x1d, 0x8d, 0x3d, 0xb4, 0x0c, 0x33, 0x61, 0xc0, 0x70, 0x5e, 0x1f, 0x61, 0x7e, 0x72, 0x5a, 0xce,
-	0x6c, 0xdb, 0x1d, 0x3b, 0x8a, 0xa1, 0xbb, 0xb0, 0x10, 0x06, 0xac, 0x3b, 0x24, 0x72, 0x88, 0x0b,
-	0x1a, 0x2f, 0x4e, 0x4e, 0xcb, 0xf9, 0x6d, 0xbb, 0xf3, 0x8c, 0xc8, 0xa1, 0x9d, 0x0f, 0x03, 0x16,
-	0x19, 0xed, 0xcd, 0xa3, 0x89, 0x05, 0x4e, 0x26, 0x16, 0xf8, 0x35, 0xb1, 0xc0, 0x87, 0x33, 0x2b,
-	0x75, 0x72, 0x66, 0xa5, 0x7e, 0x9c, 0x59, 0xa9, 0xd7, 0xab, 0x7f, 0xdd, 0x78, 0xfc, 0xb9, 0xd0,
-	0x8b, 0xef, 0xe5, 0xf4, 0x97, 0xed, 0xfe, 0xef, 0x00, 0x00, 0x00, 0xff, 0xff, 0x9f, 0x47, 0xd8,
-	0x49, 0x8d, 0x05, 0x00, 0x00,
+func (m *Hold) Reset()         { *m = Hold{} }
+func (m *Hold) String() string { return proto.CompactTextString(m) }
+func (*Hold) ProtoMessage()    {}
+func (*Hold) Descriptor() ([]byte, []int) {
+	return fileDescriptor_dd052eee12edf988, []int{9}
 }
-
-func (this *SendEnabled) Equal(that interface{}) bool {
-	if that == nil {
-		return this == nil
-	}
-
-	that1, ok := that.(*SendEnabled)
-	if !ok {
-		that2, ok := that.(SendEnabled)
-		if ok {
-			that1 = &that2
-		} else {
-			return false
+func (m *Hold) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Hold) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Hold.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if that1 == nil {
-		return this == nil
-	} else if this == nil {
-		return false
-	}
-	if this.Denom != that1.Denom {
-		return false
-	}
-	if this.Enabled != that1.Enabled {
-		return false
-	}
-	return true
 }
-func (this *Supply) Equal(that interface{}) bool {
-	if that == nil {
-		return this == nil
-	}
+func (m *Hold) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Hold.Merge(m, src)
+}
+func (m *Hold) XXX_Size() int {
+	return m.Size()
+}
+func (m *Hold) XXX_DiscardUnknown() {
+	xxx_messageInfo_Hold.DiscardUnknown(m)
+}
 
-	that1, ok := that.(*Supply)
-	if !ok {
-		that2, ok := that.(Supply)
-		if ok {
-			that1 = &that2
-		} else {
-			return false
-		}
-	}
-	if that1 == nil {
-		return this == nil
-	} else if this == nil {
-		return false
+var xxx_messageInfo_Hold proto.InternalMessageInfo
+
+func (m *Hold) GetAddress() string {
+	if m != nil {
+		return m.Address
 	}
-	if len(this.Total) != len(that1.Total) {
-		return false
+	return ""
+}
+
+func (m *Hold) GetHolder() string {
+	if m != nil {
+		return m.Holder
 	}
-	for i := range this.Total {
-		if !this.Total[i].Equal(&that1.Total[i]) {
-			return false
-		}
+	return ""
+}
+
+func (m *Hold) GetAmount() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Amount
 	}
-	return true
+	return nil
 }
-func (m *Params) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+
+func (m *Hold) GetExpiration() *time.Time {
+	if m != nil {
+		return m.Expiration
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *Params) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// BalanceSnapshot defines a merkleized snapshot of the balances of all the
+// holders of a denomination at a given height, e.g. for an airdrop.
+//
+// Since: cosmos-sdk 0.48
+type BalanceSnapshot struct {
+	// id is the unique identifier of the snapshot.
+	Id uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	// denom is the denomination of the balances of the snapshot.
+	Denom string `protobuf:"bytes,2,opt,name=denom,proto3" json:"denom,omitempty"`
+	// height is the height at the end of which the balances are recorded.
+	Height int64 `protobuf:"varint,3,opt,name=height,proto3" json:"height,omitempty"`
+	// taken is whether the balances were recorded, i.e. whether the height was
+	// reached.
+	Taken bool `protobuf:"varint,4,opt,name=taken,proto3" json:"taken,omitempty"`
+	// root is the merkle root of the balances of the snapshot, set once taken.
+	Root []byte `protobuf:"bytes,5,opt,name=root,proto3" json:"root,omitempty"`
+	// count is the number of holders of the denomination in the snapshot.
+	Count uint64 `protobuf:"varint,6,opt,name=count,proto3" json:"count,omitempty"`
+	// total is the sum of the balances of the snapshot.
+	Total cosmossdk_io_math.Int `protobuf:"bytes,7,opt,name=total,proto3,customtype=cosmossdk.io/math.Int" json:"total"`
 }
 
-func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.DefaultSendEnabled {
-		i--
-		if m.DefaultSendEnabled {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
+func (m *BalanceSnapshot) Reset()         { *m = BalanceSnapshot{} }
+func (m *BalanceSnapshot) String() string { return proto.CompactTextString(m) }
+func (*BalanceSnapshot) ProtoMessage()    {}
+func (*BalanceSnapshot) Descriptor() ([]byte, []int) {
+	return fileDescriptor_dd052eee12edf988, []int{10}
+}
+func (m *BalanceSnapshot) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *BalanceSnapshot) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_BalanceSnapshot.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0x10
+		return b[:n], nil
 	}
-	if len(m.SendEnabled) > 0 {
-		for iNdEx := len(m.SendEnabled) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.SendEnabled[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintBank(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
-		}
+}
+func (m *BalanceSnapshot) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BalanceSnapshot.Merge(m, src)
+}
+func (m *BalanceSnapshot) XXX_Size() int {
+	return m.Size()
+}
+func (m *BalanceSnapshot) XXX_DiscardUnknown() {
+	xxx_messageInfo_BalanceSnapshot.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BalanceSnapshot proto.InternalMessageInfo
+
+func (m *BalanceSnapshot) GetId() uint64 {
+	if m != nil {
+		return m.Id
 	}
-	return len(dAtA) - i, nil
+	return 0
 }
 
-func (m *SendEnabled) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *BalanceSnapshot) GetDenom() string {
+	if m != nil {
+		return m.Denom
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *SendEnabled) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *BalanceSnapshot) GetHeight() int64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
 }
 
-func (m *SendEnabled) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Enabled {
-		i--
-		if m.Enabled {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
-		i--
-		dAtA[i] = 0x10
+func (m *BalanceSnapshot) GetTaken() bool {
+	if m != nil {
+		return m.Taken
 	}
-	if len(m.Denom) > 0 {
-		i -= len(m.Denom)
-		copy(dAtA[i:], m.Denom)
-		i = encodeVarintBank(dAtA, i, uint64(len(m.Denom)))
-		i--
-		dAtA[i] = 0xa
+	return false
+}
+
+func (m *BalanceSnapshot) GetRoot() []byte {
+	if m != nil {
+		return m.Root
 	}
-	return len(dAtA) - i, nil
+	return nil
 }
 
-func (m *Input) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *BalanceSnapshot) GetCount() uint64 {
+	if m != nil {
+		return m.Count
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *Input) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// SnapshotBalance defines the balance of an account recorded in a balance
+// snapshot.
+//
+// Since: cosmos-sdk 0.48
+type SnapshotBalance struct {
+	// address is the address of the account.
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	// amount is the balance of the account in the denomination of the snapshot.
+	Amount cosmossdk_io_math.Int `protobuf:"bytes,2,opt,name=amount,proto3,customtype=cosmossdk.io/math.Int" json:"amount"`
 }
 
-func (m *Input) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Coins) > 0 {
-		for iNdEx := len(m.Coins) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Coins[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintBank(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x12
+func (m *SnapshotBalance) Reset()         { *m = SnapshotBalance{} }
+func (m *SnapshotBalance) String() string { return proto.CompactTextString(m) }
+func (*SnapshotBalance) ProtoMessage()    {}
+func (*SnapshotBalance) Descriptor() ([]byte, []int) {
+	return fileDescriptor_dd052eee12edf988, []int{11}
+}
+func (m *SnapshotBalance) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SnapshotBalance) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SnapshotBalance.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if len(m.Address) > 0 {
-		i -= len(m.Address)
-		copy(dAtA[i:], m.Address)
-		i = encodeVarintBank(dAtA, i, uint64(len(m.Address)))
-		i--
-		dAtA[i] = 0xa
-	}
-	return len(dAtA) - i, nil
+}
+func (m *SnapshotBalance) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SnapshotBalance.Merge(m, src)
+}
+func (m *SnapshotBalance) XXX_Size() int {
+	return m.Size()
+}
+func (m *SnapshotBalance) XXX_DiscardUnknown() {
+	xxx_messageInfo_SnapshotBalance.DiscardUnknown(m)
 }
 
-func (m *Output) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_SnapshotBalance proto.InternalMessageInfo
+
+func (m *SnapshotBalance) GetAddress() string {
+	if m != nil {
+		return m.Address
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *Output) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// BalanceSnapshotProof defines the merkle proof of inclusion of a balance in
+// the root of a balance snapshot. The leaves of the merkle tree are the
+// length-prefixed addresses of the holders followed by the decimal string of
+// their balances, in address order, and the tree is hashed as the CometBFT
+// simple merkle tree.
+//
+// Since: cosmos-sdk 0.48
+type BalanceSnapshotProof struct {
+	// total is the number of leaves of the merkle tree.
+	Total int64 `protobuf:"varint,1,opt,name=total,proto3" json:"total,omitempty"`
+	// index is the index of the leaf of the balance.
+	Index int64 `protobuf:"varint,2,opt,name=index,proto3" json:"index,omitempty"`
+	// leaf_hash is the hash of the leaf of the balance.
+	LeafHash []byte `protobuf:"bytes,3,opt,name=leaf_hash,json=leafHash,proto3" json:"leaf_hash,omitempty"`
+	// aunts are the hashes of the sibling subtrees from the leaf to the root.
+	Aunts [][]byte `protobuf:"bytes,4,rep,name=aunts,proto3" json:"aunts,omitempty"`
 }
 
-func (m *Output) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Coins) > 0 {
-		for iNdEx := len(m.Coins) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Coins[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintBank(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x12
+func (m *BalanceSnapshotProof) Reset()         { *m = BalanceSnapshotProof{} }
+func (m *BalanceSnapshotProof) String() string { return proto.CompactTextString(m) }
+func (*BalanceSnapshotProof) ProtoMessage()    {}
+func (*BalanceSnapshotProof) Descriptor() ([]byte, []int) {
+	return fileDescriptor_dd052eee12edf988, []int{12}
+}
+func (m *BalanceSnapshotProof) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *BalanceSnapshotProof) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_BalanceSnapshotProof.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if len(m.Address) > 0 {
-		i -= len(m.Address)
-		copy(dAtA[i:], m.Address)
-		i = encodeVarintBank(dAtA, i, uint64(len(m.Address)))
-		i--
-		dAtA[i] = 0xa
-	}
-	return len(dAtA) - i, nil
+}
+func (m *BalanceSnapshotProof) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BalanceSnapshotProof.Merge(m, src)
+}
+func (m *BalanceSnapshotProof) XXX_Size() int {
+	return m.Size()
+}
+func (m *BalanceSnapshotProof) XXX_DiscardUnknown() {
+	xxx_messageInfo_BalanceSnapshotProof.DiscardUnknown(m)
 }
 
-func (m *Supply) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_BalanceSnapshotProof proto.InternalMessageInfo
+
+func (m *BalanceSnapshotProof) GetTotal() int64 {
+	if m != nil {
+		return m.Total
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *Supply) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *BalanceSnapshotProof) GetIndex() int64 {
+	if m != nil {
+		return m.Index
+	}
+	return 0
 }
 
-func (m *Supply) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Total) > 0 {
-		for iNdEx := len(m.Total) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Total[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintBank(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
-		}
+func (m *BalanceSnapshotProof) GetLeafHash() []byte {
+	if m != nil {
+		return m.LeafHash
 	}
-	return len(dAtA) - i, nil
+	return nil
 }
 
-func (m *DenomUnit) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *BalanceSnapshotProof) GetAunts() [][]byte {
+	if m != nil {
+		return m.Aunts
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *DenomUnit) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// MintBurnPermissions defines the module accounts or addresses allowed to mint
+// and to burn a denomination. A denomination without mint/burn permissions can
+// be minted and burned by any module account with the minter and burner
+// permissions. Each entry is either the name of a module or the address of its
+// module account.
+//
+// Since: cosmos-sdk 0.48
+type MintBurnPermissions struct {
+	// denom is the denomination the permissions apply to.
+	Denom string `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty"`
+	// minters are the modules allowed to mint the denomination.
+	Minters []string `protobuf:"bytes,2,rep,name=minters,proto3" json:"minters,omitempty"`
+	// burners are the modules allowed to burn the denomination.
+	Burners []string `protobuf:"bytes,3,rep,name=burners,proto3" json:"burners,omitempty"`
 }
 
-func (m *DenomUnit) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Aliases) > 0 {
-		for iNdEx := len(m.Aliases) - 1; iNdEx >= 0; iNdEx-- {
-			i -= len(m.Aliases[iNdEx])
-			copy(dAtA[i:], m.Aliases[iNdEx])
-			i = encodeVarintBank(dAtA, i, uint64(len(m.Aliases[iNdEx])))
-			i--
-			dAtA[i] = 0x1a
+func (m *MintBurnPermissions) Reset()         { *m = MintBurnPermissions{} }
+func (m *MintBurnPermissions) String() string { return proto.CompactTextString(m) }
+func (*MintBurnPermissions) ProtoMessage()    {}
+func (*MintBurnPermissions) Descriptor() ([]byte, []int) {
+	return fileDescriptor_dd052eee12edf988, []int{13}
+}
+func (m *MintBurnPermissions) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MintBurnPermissions) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MintBurnPermissions.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if m.Exponent != 0 {
-		i = encodeVarintBank(dAtA, i, uint64(m.Exponent))
-		i--
-		dAtA[i] = 0x10
+}
+func (m *MintBurnPermissions) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MintBurnPermissions.Merge(m, src)
+}
+func (m *MintBurnPermissions) XXX_Size() int {
+	return m.Size()
+}
+func (m *MintBurnPermissions) XXX_DiscardUnknown() {
+	xxx_messageInfo_MintBurnPermissions.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MintBurnPermissions proto.InternalMessageInfo
+
+func (m *MintBurnPermissions) GetDenom() string {
+	if m != nil {
+		return m.Denom
 	}
-	if len(m.Denom) > 0 {
-		i -= len(m.Denom)
-		copy(dAtA[i:], m.Denom)
-		i = encodeVarintBank(dAtA, i, uint64(len(m.Denom)))
-		i--
-		dAtA[i] = 0xa
+	return ""
+}
+
+func (m *MintBurnPermissions) GetMinters() []string {
+	if m != nil {
+		return m.Minters
 	}
-	return len(dAtA) - i, nil
+	return nil
 }
 
-func (m *Metadata) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MintBurnPermissions) GetBurners() []string {
+	if m != nil {
+		return m.Burners
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *Metadata) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// ModuleEscrow defines the amount of coins escrowed by a module account for a
+// purpose, e.g. the deposits of a governance proposal. The escrows are tracked
+// by the modules at deposit and withdrawal time, so that the balance of their
+// module accounts can be reconciled with the escrowed amounts.
+//
+// Since: cosmos-sdk 0.48
+type ModuleEscrow struct {
+	// purpose is the purpose of the escrow, e.g. "proposal/1".
+	Purpose string `protobuf:"bytes,1,opt,name=purpose,proto3" json:"purpose,omitempty"`
+	// amount is the amount of coins escrowed for the purpose.
+	Amount github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,2,rep,name=amount,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"amount"`
 }
 
-func (m *Metadata) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.URIHash) > 0 {
-		i -= len(m.URIHash)
-		copy(dAtA[i:], m.URIHash)
-		i = encodeVarintBank(dAtA, i, uint64(len(m.URIHash)))
-		i--
-		dAtA[i] = 0x42
-	}
-	if len(m.URI) > 0 {
-		i -= len(m.URI)
-		copy(dAtA[i:], m.URI)
-		i = encodeVarintBank(dAtA, i, uint64(len(m.URI)))
-		i--
-		dAtA[i] = 0x3a
-	}
-	if len(m.Symbol) > 0 {
-		i -= len(m.Symbol)
-		copy(dAtA[i:], m.Symbol)
-		i = encodeVarintBank(dAtA, i, uint64(len(m.Symbol)))
-		i--
-		dAtA[i] = 0x32
-	}
-	if len(m.Name) > 0 {
-		i -= len(m.Name)
-		copy(dAtA[i:], m.Name)
-		i = encodeVarintBank(dAtA, i, uint64(len(m.Name)))
-		i--
-		dAtA[i] = 0x2a
-	}
-	if len(m.Display) > 0 {
-		i -= len(m.Display)
-		copy(dAtA[i:], m.Display)
-		i = encodeVarintBank(dAtA, i, uint64(len(m.Display)))
-		i--
-		dAtA[i] = 0x22
-	}
-	if len(m.Base) > 0 {
-		i -= len(m.Base)
-		copy(dAtA[i:], m.Base)
-		i = encodeVarintBank(dAtA, i, uint64(len(m.Base)))
-		i--
-		dAtA[i] = 0x1a
-	}
-	if len(m.DenomUnits) > 0 {
-		for iNdEx := len(m.DenomUnits) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.DenomUnits[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintBank(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x12
+func (m *ModuleEscrow) Reset()         { *m = ModuleEscrow{} }
+func (m *ModuleEscrow) String() string { return proto.CompactTextString(m) }
+func (*ModuleEscrow) ProtoMessage()    {}
+func (*ModuleEscrow) Descriptor() ([]byte, []int) {
+	return fileDescriptor_dd052eee12edf988, []int{14}
+}
+func (m *ModuleEscrow) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ModuleEscrow) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ModuleEscrow.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if len(m.Description) > 0 {
-		i -= len(m.Description)
-		copy(dAtA[i:], m.Description)
-		i = encodeVarintBank(dAtA, i, uint64(len(m.Description)))
-		i--
-		dAtA[i] = 0xa
-	}
-	return len(dAtA) - i, nil
+}
+func (m *ModuleEscrow) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ModuleEscrow.Merge(m, src)
+}
+func (m *ModuleEscrow) XXX_Size() int {
+	return m.Size()
+}
+func (m *ModuleEscrow) XXX_DiscardUnknown() {
+	xxx_messageInfo_ModuleEscrow.DiscardUnknown(m)
 }
 
-func encodeVarintBank(dAtA []byte, offset int, v uint64) int {
-	offset -= sovBank(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+var xxx_messageInfo_ModuleEscrow proto.InternalMessageInfo
+
+func (m *ModuleEscrow) GetPurpose() string {
+	if m != nil {
+		return m.Purpose
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return ""
 }
-func (m *Params) Size() (n int) {
-	if m == nil {
-		return 0
+
+func (m *ModuleEscrow) GetAmount() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Amount
 	}
-	var l int
-	_ = l
-	if len(m.SendEnabled) > 0 {
-		for _, e := range m.SendEnabled {
-			l = e.Size()
-			n += 1 + l + sovBank(uint64(l))
+	return nil
+}
+
+// TimelockedTransfer defines a transfer of coins which its recipient can claim
+// once its unlock time is reached, and its sender can reclaim once its expiry
+// time is reached if it is still unclaimed. The coins stay held in the account
+// of the sender until the transfer is claimed or reclaimed.
+//
+// Since: cosmos-sdk 0.48
+type TimelockedTransfer struct {
+	// id is the unique identifier of the transfer.
+	Id uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	// sender is the address of the account sending the coins.
+	Sender string `protobuf:"bytes,2,opt,name=sender,proto3" json:"sender,omitempty"`
+	// recipient is the address of the account allowed to claim the coins.
+	Recipient string `protobuf:"bytes,3,opt,name=recipient,proto3" json:"recipient,omitempty"`
+	// amount is the amount of coins transferred.
+	Amount github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,4,rep,name=amount,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"amount"`
+	// unlock_time is the time from which the recipient can claim the coins.
+	UnlockTime time.Time `protobuf:"bytes,5,opt,name=unlock_time,json=unlockTime,proto3,stdtime" json:"unlock_time"`
+	// expiry_time is the time from which the recipient can no longer claim the
+	// coins, and the sender can reclaim them.
+	ExpiryTime time.Time `protobuf:"bytes,6,opt,name=expiry_time,json=expiryTime,proto3,stdtime" json:"expiry_time"`
+}
+
+func (m *TimelockedTransfer) Reset()         { *m = TimelockedTransfer{} }
+func (m *TimelockedTransfer) String() string { return proto.CompactTextString(m) }
+func (*TimelockedTransfer) ProtoMessage()    {}
+func (*TimelockedTransfer) Descriptor() ([]byte, []int) {
+	return fileDescriptor_dd052eee12edf988, []int{15}
+}
+func (m *TimelockedTransfer) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TimelockedTransfer) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TimelockedTransfer.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if m.DefaultSendEnabled {
-		n += 2
+}
+func (m *TimelockedTransfer) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TimelockedTransfer.Merge(m, src)
+}
+func (m *TimelockedTransfer) XXX_Size() int {
+	return m.Size()
+}
+func (m *TimelockedTransfer) XXX_DiscardUnknown() {
+	xxx_messageInfo_TimelockedTransfer.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TimelockedTransfer proto.InternalMessageInfo
+
+func (m *TimelockedTransfer) GetId() uint64 {
+	if m != nil {
+		return m.Id
 	}
-	return n
+	return 0
 }
 
-func (m *SendEnabled) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *TimelockedTransfer) GetSender() string {
+	if m != nil {
+		return m.Sender
 	}
-	var l int
-	_ = l
-	l = len(m.Denom)
-	if l > 0 {
-		n += 1 + l + sovBank(uint64(l))
+	return ""
+}
+
+func (m *TimelockedTransfer) GetRecipient() string {
+	if m != nil {
+		return m.Recipient
 	}
-	if m.Enabled {
-		n += 2
+	return ""
+}
+
+func (m *TimelockedTransfer) GetAmount() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Amount
 	}
-	return n
+	return nil
 }
 
-func (m *Input) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *TimelockedTransfer) GetUnlockTime() time.Time {
+	if m != nil {
+		return m.UnlockTime
 	}
-	var l int
-	_ = l
-	l = len(m.Address)
-	if l > 0 {
-		n += 1 + l + sovBank(uint64(l))
+	return time.Time{}
+}
+
+func (m *TimelockedTransfer) GetExpiryTime() time.Time {
+	if m != nil {
+		return m.ExpiryTime
 	}
-	if len(m.Coins) > 0 {
-		for _, e := range m.Coins {
-			l = e.Size()
-			n += 1 + l + sovBank(uint64(l))
+	return time.Time{}
+}
+
+// SpendingLimit defines an opt-in policy limiting the coins an account can
+// send, per denomination, within any rolling window, e.g. to cap the losses of
+// a compromised hot wallet.
+//
+// Since: cosmos-sdk 0.48
+type SpendingLimit struct {
+	// address is the address of the account whose transfers are limited.
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	// limits are the maximum amounts of the denominations the account can send
+	// within a window. The other denominations are not limited.
+	Limits github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,2,rep,name=limits,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"limits"`
+	// window is the duration of the rolling window.
+	Window time.Duration `protobuf:"bytes,3,opt,name=window,proto3,stdduration" json:"window"`
+	// spent are the coins sent by the account within the last window, in the
+	// order of their transfer times.
+	Spent []SpendingRecord `protobuf:"bytes,4,rep,name=spent,proto3" json:"spent"`
+	// pending is the change of the policy loosening it, if any, which takes
+	// effect once its effective time is reached.
+	Pending *PendingSpendingLimit `protobuf:"bytes,5,opt,name=pending,proto3" json:"pending,omitempty"`
+}
+
+func (m *SpendingLimit) Reset()         { *m = SpendingLimit{} }
+func (m *SpendingLimit) String() string { return proto.CompactTextString(m) }
+func (*SpendingLimit) ProtoMessage()    {}
+func (*SpendingLimit) Descriptor() ([]byte, []int) {
+	return fileDescriptor_dd052eee12edf988, []int{16}
+}
+func (m *SpendingLimit) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SpendingLimit) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SpendingLimit.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	return n
+}
+func (m *SpendingLimit) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SpendingLimit.Merge(m, src)
+}
+func (m *SpendingLimit) XXX_Size() int {
+	return m.Size()
+}
+func (m *SpendingLimit) XXX_DiscardUnknown() {
+	xxx_messageInfo_SpendingLimit.DiscardUnknown(m)
 }
 
-func (m *Output) Size() (n int) {
-	if m == nil {
-		return 0
+var xxx_messageInfo_SpendingLimit proto.InternalMessageInfo
+
+func (m *SpendingLimit) GetAddress() string {
+	if m != nil {
+		return m.Address
 	}
-	var l int
-	_ = l
-	l = len(m.Address)
-	if l > 0 {
-		n += 1 + l + sovBank(uint64(l))
+	return ""
+}
+
+func (m *SpendingLimit) GetLimits() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Limits
 	}
-	if len(m.Coins) > 0 {
-		for _, e := range m.Coins {
-			l = e.Size()
-			n += 1 + l + sovBank(uint64(l))
-		}
+	return nil
+}
+
+func (m *SpendingLimit) GetWindow() time.Duration {
+	if m != nil {
+		return m.Window
 	}
-	return n
+	return 0
 }
 
-func (m *Supply) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *SpendingLimit) GetSpent() []SpendingRecord {
+	if m != nil {
+		return m.Spent
 	}
-	var l int
-	_ = l
-	if len(m.Total) > 0 {
-		for _, e := range m.Total {
-			l = e.Size()
-			n += 1 + l + sovBank(uint64(l))
-		}
+	return nil
+}
+
+func (m *SpendingLimit) GetPending() *PendingSpendingLimit {
+	if m != nil {
+		return m.Pending
 	}
-	return n
+	return nil
 }
 
-func (m *DenomUnit) Size() (n int) {
-	if m == nil {
-		return 0
+// SpendingRecord defines the coins sent by an account limited by a spending
+// limit at a given time.
+//
+// Since: cosmos-sdk 0.48
+type SpendingRecord struct {
+	// time is the time of the transfers.
+	Time time.Time `protobuf:"bytes,1,opt,name=time,proto3,stdtime" json:"time"`
+	// amount is the amount of coins sent at that time.
+	Amount github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,2,rep,name=amount,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"amount"`
+}
+
+func (m *SpendingRecord) Reset()         { *m = SpendingRecord{} }
+func (m *SpendingRecord) String() string { return proto.CompactTextString(m) }
+func (*SpendingRecord) ProtoMessage()    {}
+func (*SpendingRecord) Descriptor() ([]byte, []int) {
+	return fileDescriptor_dd052eee12edf988, []int{17}
+}
+func (m *SpendingRecord) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SpendingRecord) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SpendingRecord.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	var l int
-	_ = l
-	l = len(m.Denom)
-	if l > 0 {
-		n += 1 + l + sovBank(uint64(l))
+}
+func (m *SpendingRecord) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SpendingRecord.Merge(m, src)
+}
+func (m *SpendingRecord) XXX_Size() int {
+	return m.Size()
+}
+func (m *SpendingRecord) XXX_DiscardUnknown() {
+	xxx_messageInfo_SpendingRecord.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SpendingRecord proto.InternalMessageInfo
+
+func (m *SpendingRecord) GetTime() time.Time {
+	if m != nil {
+		return m.Time
 	}
-	if m.Exponent != 0 {
-		n += 1 + sovBank(uint64(m.Exponent))
+	return time.Time{}
+}
+
+func (m *SpendingRecord) GetAmount() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Amount
 	}
-	if len(m.Aliases) > 0 {
-		for _, s := range m.Aliases {
-			l = len(s)
-			n += 1 + l + sovBank(uint64(l))
+	return nil
+}
+
+// PendingSpendingLimit defines a change of a spending limit loosening it,
+// which only takes effect after a window of the current spending limit, so
+// that a compromised key cannot lift the limit at once.
+//
+// Since: cosmos-sdk 0.48
+type PendingSpendingLimit struct {
+	// limits are the new limits of the spending limit. The spending limit is
+	// removed if empty.
+	Limits github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,1,rep,name=limits,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"limits"`
+	// window is the new duration of the rolling window.
+	Window time.Duration `protobuf:"bytes,2,opt,name=window,proto3,stdduration" json:"window"`
+	// effective_time is the time from which the change takes effect.
+	EffectiveTime time.Time `protobuf:"bytes,3,opt,name=effective_time,json=effectiveTime,proto3,stdtime" json:"effective_time"`
+}
+
+func (m *PendingSpendingLimit) Reset()         { *m = PendingSpendingLimit{} }
+func (m *PendingSpendingLimit) String() string { return proto.CompactTextString(m) }
+func (*PendingSpendingLimit) ProtoMessage()    {}
+func (*PendingSpendingLimit) Descriptor() ([]byte, []int) {
+	return fileDescriptor_dd052eee12edf988, []int{18}
+}
+func (m *PendingSpendingLimit) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PendingSpendingLimit) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PendingSpendingLimit.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *PendingSpendingLimit) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PendingSpendingLimit.Merge(m, src)
+}
+func (m *PendingSpendingLimit) XXX_Size() int {
+	return m.Size()
+}
+func (m *PendingSpendingLimit) XXX_DiscardUnknown() {
+	xxx_messageInfo_PendingSpendingLimit.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PendingSpendingLimit proto.InternalMessageInfo
+
+func (m *PendingSpendingLimit) GetLimits() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Limits
+	}
+	return nil
+}
+
+func (m *PendingSpendingLimit) GetWindow() time.Duration {
+	if m != nil {
+		return m.Window
+	}
+	return 0
+}
+
+func (m *PendingSpendingLimit) GetEffectiveTime() time.Time {
+	if m != nil {
+		return m.EffectiveTime
+	}
+	return time.Time{}
+}
+
+// DemurrageSchedule defines the decay, or the expiry, of the balances of a
+// denomination, e.g. loyalty points, which are reduced at the end of each
+// epoch. The balances are not iterated: the decay of a balance is settled
+// lazily, from the epoch of its last settlement, when it is next changed.
+//
+// Since: cosmos-sdk 0.48
+type DemurrageSchedule struct {
+	// denom is the denomination whose balances decay.
+	Denom string `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty"`
+	// epoch_length is the number of blocks of an epoch.
+	EpochLength int64 `protobuf:"varint,2,opt,name=epoch_length,json=epochLength,proto3" json:"epoch_length,omitempty"`
+	// epoch is the number of epochs elapsed since the schedule was created.
+	Epoch uint64 `protobuf:"varint,3,opt,name=epoch,proto3" json:"epoch,omitempty"`
+	// segments are the rates of the schedule, in the order of their start
+	// epochs, the last one being the current rate.
+	Segments []DemurrageSegment `protobuf:"bytes,4,rep,name=segments,proto3" json:"segments"`
+}
+
+func (m *DemurrageSchedule) Reset()         { *m = DemurrageSchedule{} }
+func (m *DemurrageSchedule) String() string { return proto.CompactTextString(m) }
+func (*DemurrageSchedule) ProtoMessage()    {}
+func (*DemurrageSchedule) Descriptor() ([]byte, []int) {
+	return fileDescriptor_dd052eee12edf988, []int{19}
+}
+func (m *DemurrageSchedule) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DemurrageSchedule) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DemurrageSchedule.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *DemurrageSchedule) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DemurrageSchedule.Merge(m, src)
+}
+func (m *DemurrageSchedule) XXX_Size() int {
+	return m.Size()
+}
+func (m *DemurrageSchedule) XXX_DiscardUnknown() {
+	xxx_messageInfo_DemurrageSchedule.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DemurrageSchedule proto.InternalMessageInfo
+
+func (m *DemurrageSchedule) GetDenom() string {
+	if m != nil {
+		return m.Denom
+	}
+	return ""
+}
+
+func (m *DemurrageSchedule) GetEpochLength() int64 {
+	if m != nil {
+		return m.EpochLength
+	}
+	return 0
+}
+
+func (m *DemurrageSchedule) GetEpoch() uint64 {
+	if m != nil {
+		return m.Epoch
+	}
+	return 0
+}
+
+func (m *DemurrageSchedule) GetSegments() []DemurrageSegment {
+	if m != nil {
+		return m.Segments
+	}
+	return nil
+}
+
+// DemurrageSegment defines the rate of a demurrage schedule from an epoch,
+// until the start epoch of the next segment, if any.
+//
+// Since: cosmos-sdk 0.48
+type DemurrageSegment struct {
+	// start_epoch is the first epoch the rate applies to.
+	StartEpoch uint64 `protobuf:"varint,1,opt,name=start_epoch,json=startEpoch,proto3" json:"start_epoch,omitempty"`
+	// rate is the fraction of the balances burnt at the end of each epoch,
+	// between 0 and 1. A rate of 1 expires the balances at the end of each
+	// epoch, and a rate of 0 pauses the schedule.
+	Rate cosmossdk_io_math.LegacyDec `protobuf:"bytes,2,opt,name=rate,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"rate"`
+}
+
+func (m *DemurrageSegment) Reset()         { *m = DemurrageSegment{} }
+func (m *DemurrageSegment) String() string { return proto.CompactTextString(m) }
+func (*DemurrageSegment) ProtoMessage()    {}
+func (*DemurrageSegment) Descriptor() ([]byte, []int) {
+	return fileDescriptor_dd052eee12edf988, []int{20}
+}
+func (m *DemurrageSegment) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DemurrageSegment) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DemurrageSegment.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *DemurrageSegment) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DemurrageSegment.Merge(m, src)
+}
+func (m *DemurrageSegment) XXX_Size() int {
+	return m.Size()
+}
+func (m *DemurrageSegment) XXX_DiscardUnknown() {
+	xxx_messageInfo_DemurrageSegment.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DemurrageSegment proto.InternalMessageInfo
+
+func (m *DemurrageSegment) GetStartEpoch() uint64 {
+	if m != nil {
+		return m.StartEpoch
+	}
+	return 0
+}
+
+func init() {
+	proto.RegisterType((*Params)(nil), "cosmos.bank.v1beta1.Params")
+	proto.RegisterType((*DustSweepParams)(nil), "cosmos.bank.v1beta1.DustSweepParams")
+	proto.RegisterType((*SendEnabled)(nil), "cosmos.bank.v1beta1.SendEnabled")
+	proto.RegisterType((*Input)(nil), "cosmos.bank.v1beta1.Input")
+	proto.RegisterType((*Output)(nil), "cosmos.bank.v1beta1.Output")
+	proto.RegisterType((*BatchOutput)(nil), "cosmos.bank.v1beta1.BatchOutput")
+	proto.RegisterType((*Supply)(nil), "cosmos.bank.v1beta1.Supply")
+	proto.RegisterType((*DenomUnit)(nil), "cosmos.bank.v1beta1.DenomUnit")
+	proto.RegisterType((*Metadata)(nil), "cosmos.bank.v1beta1.Metadata")
+	proto.RegisterType((*Hold)(nil), "cosmos.bank.v1beta1.Hold")
+	proto.RegisterType((*BalanceSnapshot)(nil), "cosmos.bank.v1beta1.BalanceSnapshot")
+	proto.RegisterType((*SnapshotBalance)(nil), "cosmos.bank.v1beta1.SnapshotBalance")
+	proto.RegisterType((*BalanceSnapshotProof)(nil), "cosmos.bank.v1beta1.BalanceSnapshotProof")
+	proto.RegisterType((*MintBurnPermissions)(nil), "cosmos.bank.v1beta1.MintBurnPermissions")
+	proto.RegisterType((*ModuleEscrow)(nil), "cosmos.bank.v1beta1.ModuleEscrow")
+	proto.RegisterType((*TimelockedTransfer)(nil), "cosmos.bank.v1beta1.TimelockedTransfer")
+	proto.RegisterType((*SpendingLimit)(nil), "cosmos.bank.v1beta1.SpendingLimit")
+	proto.RegisterType((*SpendingRecord)(nil), "cosmos.bank.v1beta1.SpendingRecord")
+	proto.RegisterType((*PendingSpendingLimit)(nil), "cosmos.bank.v1beta1.PendingSpendingLimit")
+	proto.RegisterType((*DemurrageSchedule)(nil), "cosmos.bank.v1beta1.DemurrageSchedule")
+	proto.RegisterType((*DemurrageSegment)(nil), "cosmos.bank.v1beta1.DemurrageSegment")
+}
+
+func init() { proto.RegisterFile("cosmos/bank/v1beta1/bank.proto", fileDescriptor_dd052eee12edf988) }
+
+var fileDescriptor_dd052eee12edf988 = []byte{
+	// 1583 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xd4, 0x58, 0xcb, 0x6f, 0x1b, 0xc7,
+	0x19, 0xd7, 0xf2, 0x25, 0xf2, 0xa3, 0x6c, 0x27, 0x63, 0xd6, 0x5d, 0xdb, 0x08, 0xc9, 0x6e, 0x1f,
+	0xa0, 0x0d, 0x98, 0xb4, 0x15, 0x20, 0x07, 0x03, 0x45, 0x63, 0x4a, 0x4e, 0xad, 0xc0, 0x6e, 0x85,
+	0x55, 0x8c, 0x00, 0xbd, 0x10, 0xc3, 0xdd, 0x11, 0x39, 0xd0, 0xee, 0xcc, 0x62, 0x67, 0xd6, 0x12,
+	0x4f, 0x45, 0x7b, 0x2a, 0xd2, 0x43, 0x72, 0x2c, 0x7a, 0x72, 0x51, 0x14, 0x28, 0x8a, 0x1e, 0x74,
+	0xf0, 0xbd, 0xd7, 0x20, 0xa7, 0x20, 0x97, 0x06, 0x3d, 0x28, 0x85, 0x8c, 0x42, 0x39, 0xf7, 0x2f,
+	0x28, 0xe6, 0xb1, 0xd4, 0x4a, 0x96, 0xd4, 0x58, 0x07, 0x05, 0xb9, 0x48, 0xfb, 0xcd, 0xf7, 0x9c,
+	0xdf, 0xf7, 0x98, 0x19, 0x42, 0x3b, 0xe0, 0x22, 0xe6, 0x62, 0x30, 0xc6, 0x6c, 0x6b, 0xf0, 0xec,
+	0xde, 0x98, 0x48, 0x7c, 0x4f, 0x13, 0xfd, 0x24, 0xe5, 0x92, 0xa3, 0xab, 0x86, 0xdf, 0xd7, 0x4b,
+	0x96, 0x7f, 0xa3, 0x35, 0xe1, 0x13, 0xae, 0xf9, 0x03, 0xf5, 0x65, 0x44, 0x6f, 0x5c, 0x37, 0xa2,
+	0x23, 0xc3, 0xb0, 0x7a, 0x86, 0x75, 0xe8, 0x45, 0x90, 0xb9, 0x97, 0x80, 0x53, 0x66, 0xf9, 0xdf,
+	0xb7, 0xfc, 0x58, 0x4c, 0x06, 0xcf, 0xee, 0xa9, 0x7f, 0x96, 0xf1, 0x26, 0x8e, 0x29, 0xe3, 0x03,
+	0xfd, 0xd7, 0x2e, 0x75, 0x26, 0x9c, 0x4f, 0x22, 0x32, 0xd0, 0xd4, 0x38, 0xdb, 0x1c, 0x48, 0x1a,
+	0x13, 0x21, 0x71, 0x9c, 0xe4, 0xce, 0x8e, 0x0b, 0x84, 0x59, 0x8a, 0x25, 0xe5, 0xd6, 0x99, 0xf7,
+	0x5f, 0x07, 0x6a, 0xeb, 0x38, 0xc5, 0xb1, 0x40, 0x3f, 0x87, 0x25, 0x41, 0x58, 0x38, 0x22, 0x0c,
+	0x8f, 0x23, 0x12, 0xba, 0x4e, 0xb7, 0xdc, 0x6b, 0x2e, 0x77, 0xfb, 0x27, 0x6c, 0xba, 0xbf, 0x41,
+	0x58, 0xf8, 0xd0, 0xc8, 0x0d, 0x4b, 0xae, 0xe3, 0x37, 0xc5, 0xe1, 0x02, 0xba, 0x0b, 0xad, 0x90,
+	0x6c, 0xe2, 0x2c, 0x92, 0xa3, 0x23, 0x06, 0x4b, 0x5d, 0xa7, 0x57, 0xf7, 0x91, 0xe5, 0x15, 0x4c,
+	0xa0, 0x5f, 0x00, 0x84, 0x99, 0x90, 0x23, 0xb1, 0x4d, 0x48, 0xe2, 0x96, 0xbb, 0x4e, 0xaf, 0xb9,
+	0xfc, 0xa3, 0x13, 0x1d, 0xaf, 0x66, 0x42, 0x6e, 0x28, 0x29, 0x13, 0xf4, 0xb0, 0xf1, 0xe9, 0x5e,
+	0x67, 0xe1, 0xaf, 0x07, 0xbb, 0xb7, 0x1d, 0xbf, 0x11, 0xe6, 0xbc, 0xfb, 0x6f, 0x7d, 0x74, 0xb0,
+	0x7b, 0xdb, 0x35, 0xfa, 0x77, 0x44, 0xb8, 0x35, 0xd8, 0x31, 0x39, 0x35, 0x4a, 0xde, 0x7f, 0x4a,
+	0x70, 0xe5, 0x98, 0x21, 0xf4, 0x1b, 0x07, 0x40, 0x4e, 0x53, 0x22, 0xa6, 0x3c, 0x0a, 0x85, 0xdd,
+	0xfc, 0xf5, 0xc3, 0x18, 0x04, 0x99, 0xc7, 0xb0, 0xc2, 0x29, 0x1b, 0xbe, 0xa7, 0x1c, 0xff, 0xed,
+	0xab, 0x4e, 0x6f, 0x42, 0xe5, 0x34, 0x1b, 0xf7, 0x03, 0x1e, 0xdb, 0x34, 0x0f, 0x0a, 0x7e, 0xe5,
+	0x2c, 0x21, 0x42, 0x2b, 0x88, 0x3f, 0x1e, 0xec, 0xde, 0x5e, 0x8a, 0xc8, 0x04, 0x07, 0xb3, 0x91,
+	0xca, 0xb6, 0x30, 0x51, 0x17, 0x9c, 0xa2, 0x1f, 0xc0, 0x12, 0x49, 0x78, 0x30, 0x1d, 0x45, 0x84,
+	0x4d, 0xe4, 0x54, 0x03, 0x56, 0xf6, 0x9b, 0x7a, 0xed, 0xb1, 0x5e, 0x42, 0x6f, 0x01, 0x04, 0x53,
+	0x4c, 0xd9, 0x68, 0x9b, 0x86, 0x44, 0x23, 0x55, 0xf7, 0x1b, 0x7a, 0xe5, 0x43, 0x1a, 0x12, 0x84,
+	0xa0, 0x32, 0xce, 0x52, 0xe6, 0x56, 0x34, 0x43, 0x7f, 0xa3, 0x15, 0x78, 0x83, 0xec, 0x90, 0x38,
+	0x91, 0x23, 0x1c, 0x86, 0x29, 0x11, 0x82, 0x08, 0xb7, 0xda, 0x2d, 0xf7, 0x1a, 0x43, 0xf7, 0x8b,
+	0x17, 0x77, 0x5a, 0x76, 0x87, 0x0f, 0x0c, 0x6f, 0x43, 0xa6, 0x94, 0x4d, 0xfc, 0x2b, 0x46, 0xe3,
+	0x41, 0xae, 0x80, 0xde, 0x86, 0x6b, 0x31, 0xde, 0x19, 0xe1, 0x20, 0xe0, 0x19, 0x93, 0x62, 0x94,
+	0x90, 0x74, 0xa4, 0xe3, 0x72, 0x6b, 0x5d, 0xa7, 0x57, 0xf1, 0xaf, 0xc6, 0x78, 0xe7, 0x81, 0x65,
+	0xae, 0x93, 0xf4, 0xa1, 0x62, 0x79, 0x2b, 0xd0, 0x2c, 0x66, 0xb9, 0x05, 0xd5, 0x90, 0x30, 0x1e,
+	0xbb, 0x4e, 0xd7, 0xe9, 0x35, 0x7c, 0x43, 0x20, 0x17, 0x16, 0x8f, 0x16, 0x48, 0x4e, 0xde, 0xaf,
+	0x7c, 0xfd, 0xbc, 0xe3, 0x78, 0x9f, 0x39, 0x50, 0x5d, 0x63, 0x49, 0x26, 0xd1, 0x32, 0x2c, 0xda,
+	0x1d, 0x18, 0x0b, 0x67, 0xc4, 0x9f, 0x0b, 0xa2, 0x6d, 0xa8, 0x6a, 0xb0, 0xdd, 0xd2, 0x45, 0x25,
+	0xd4, 0xf8, 0xbb, 0xdf, 0xfa, 0xdd, 0xf3, 0xce, 0xc2, 0xd7, 0xcf, 0x3b, 0x0b, 0xbf, 0x3d, 0xd8,
+	0xbd, 0x9d, 0x87, 0xe3, 0xfd, 0xc3, 0x81, 0xda, 0x2f, 0x33, 0xf9, 0x9d, 0xdb, 0x4d, 0x3d, 0xdf,
+	0x8d, 0xf7, 0xa5, 0x03, 0xcd, 0x21, 0x96, 0xc1, 0xf4, 0x3b, 0xb8, 0x0d, 0xd5, 0x1e, 0x31, 0x89,
+	0xb9, 0xee, 0x9b, 0x86, 0xaf, 0xbf, 0x0b, 0x5b, 0xfb, 0xbb, 0x03, 0xb5, 0x8d, 0x2c, 0x49, 0xa2,
+	0x99, 0x8a, 0x50, 0x72, 0x89, 0xa3, 0x8b, 0x9b, 0x03, 0xc6, 0xdf, 0xfd, 0x5b, 0x36, 0x1a, 0xe7,
+	0xb3, 0x17, 0x77, 0x6e, 0x9e, 0x38, 0x79, 0x75, 0x80, 0x6b, 0xae, 0xe3, 0x7d, 0x08, 0x8d, 0x55,
+	0xd5, 0x41, 0x4f, 0x19, 0x95, 0xa7, 0xf4, 0xd6, 0x0d, 0xa8, 0x93, 0x9d, 0x84, 0x33, 0xc2, 0xa4,
+	0x6e, 0xae, 0x4b, 0xfe, 0x9c, 0x56, 0x7d, 0x87, 0x23, 0x8a, 0xd5, 0x34, 0x28, 0xab, 0x69, 0xe0,
+	0xe7, 0xa4, 0xf7, 0x51, 0x09, 0xea, 0x4f, 0x88, 0xc4, 0x21, 0x96, 0x18, 0x75, 0xa1, 0x19, 0x12,
+	0x11, 0xa4, 0x34, 0x51, 0xa7, 0x86, 0x35, 0x5f, 0x5c, 0x42, 0x3f, 0x53, 0x12, 0x8c, 0xc7, 0xa3,
+	0x8c, 0x51, 0x99, 0xe7, 0xb4, 0x7d, 0xf2, 0xf4, 0xce, 0xe3, 0xf5, 0x21, 0xcc, 0x3f, 0x75, 0x56,
+	0x14, 0xae, 0x79, 0x56, 0xd4, 0xb7, 0x8a, 0x2e, 0xa4, 0x22, 0x89, 0xf0, 0x4c, 0xcf, 0xb2, 0x86,
+	0x9f, 0x93, 0x4a, 0x9a, 0xe1, 0x98, 0xb8, 0x55, 0x23, 0xad, 0xbe, 0xd1, 0x35, 0xa8, 0x89, 0x59,
+	0x3c, 0xe6, 0x91, 0x9e, 0x46, 0x0d, 0xdf, 0x52, 0xe8, 0x3a, 0x94, 0xb3, 0x94, 0xba, 0x8b, 0xba,
+	0x30, 0x17, 0xf7, 0xf7, 0x3a, 0xe5, 0xa7, 0xfe, 0x9a, 0xaf, 0xd6, 0xd0, 0x4f, 0xa0, 0x9e, 0xa5,
+	0x74, 0x34, 0xc5, 0x62, 0xea, 0xd6, 0x35, 0xbf, 0xb9, 0xbf, 0xd7, 0x59, 0x7c, 0xea, 0xaf, 0x3d,
+	0xc2, 0x62, 0xea, 0x2f, 0x66, 0x29, 0x55, 0x1f, 0xde, 0xc7, 0x25, 0xa8, 0x3c, 0xe2, 0x51, 0x78,
+	0xae, 0x42, 0xbf, 0x06, 0x35, 0x35, 0xd9, 0x49, 0xaa, 0xd1, 0x6f, 0xf8, 0x96, 0x42, 0x33, 0xa8,
+	0xe1, 0x58, 0xcd, 0x4a, 0xb7, 0x7c, 0x51, 0xf5, 0x65, 0x1d, 0xa2, 0x77, 0x01, 0xc8, 0x4e, 0x42,
+	0xcd, 0x25, 0x40, 0x63, 0xdb, 0x5c, 0xbe, 0xd1, 0x37, 0xb7, 0x84, 0x7e, 0x7e, 0x4b, 0xe8, 0x7f,
+	0x90, 0x5f, 0x23, 0x86, 0x95, 0x4f, 0xbe, 0xea, 0x38, 0x7e, 0x41, 0xc7, 0xfb, 0xa7, 0x03, 0x57,
+	0x86, 0x38, 0xc2, 0x2c, 0x20, 0x1b, 0x0c, 0x27, 0x62, 0xca, 0x25, 0xba, 0x0c, 0x25, 0x1a, 0x6a,
+	0x5c, 0x2a, 0x7e, 0x89, 0x16, 0x46, 0x7d, 0xa9, 0x58, 0x8e, 0x0a, 0x0e, 0x42, 0x27, 0x53, 0xa9,
+	0x53, 0x5d, 0xf6, 0x2d, 0xa5, 0xa4, 0x25, 0xde, 0x22, 0xf9, 0xb1, 0x65, 0x08, 0x95, 0xe8, 0x94,
+	0x73, 0xa9, 0x13, 0xbd, 0xe4, 0xeb, 0x6f, 0x25, 0xa9, 0xcf, 0x18, 0x7b, 0xea, 0x18, 0x02, 0xbd,
+	0x97, 0x77, 0xab, 0x49, 0xf4, 0x5d, 0x05, 0xd9, 0xbf, 0xf6, 0x3a, 0xdf, 0x33, 0x00, 0x89, 0x70,
+	0xab, 0x4f, 0xf9, 0x20, 0xc6, 0x72, 0xda, 0x5f, 0x63, 0xf2, 0x8b, 0x17, 0x77, 0xc0, 0xa2, 0xbd,
+	0xc6, 0x64, 0xb1, 0xf9, 0xbc, 0x8f, 0x1d, 0xb8, 0x92, 0x6f, 0xc9, 0xee, 0xf0, 0x5c, 0x69, 0x7f,
+	0x34, 0x4f, 0x6f, 0xe9, 0x9c, 0x01, 0x59, 0x7d, 0x2f, 0x83, 0xd6, 0x31, 0xa8, 0xd7, 0x53, 0xce,
+	0x37, 0x35, 0x62, 0x76, 0x3e, 0x29, 0x20, 0x0d, 0xa1, 0x56, 0x29, 0x0b, 0xc9, 0x8e, 0xbd, 0x38,
+	0x18, 0x02, 0xdd, 0x84, 0x46, 0x44, 0xf0, 0xa6, 0x29, 0xf5, 0xb2, 0x06, 0xb3, 0xae, 0x16, 0x54,
+	0x79, 0x2b, 0x15, 0xac, 0xce, 0x6c, 0xb7, 0xd2, 0x2d, 0xf7, 0x96, 0x7c, 0x43, 0x78, 0x23, 0xb8,
+	0xfa, 0x84, 0x32, 0x39, 0xcc, 0x52, 0xb6, 0x4e, 0xd2, 0x98, 0x0a, 0x41, 0x39, 0x13, 0xa7, 0x1f,
+	0xe0, 0x31, 0x65, 0x92, 0xa4, 0xa6, 0xf7, 0x1b, 0x7e, 0x4e, 0x2a, 0x8e, 0xba, 0x81, 0x28, 0x8e,
+	0x1d, 0x31, 0x96, 0xf4, 0xfe, 0xec, 0xc0, 0xd2, 0x13, 0x1e, 0x66, 0x11, 0x79, 0x28, 0x82, 0x94,
+	0x6f, 0x2b, 0xd1, 0x24, 0x4b, 0x13, 0x2e, 0x88, 0x35, 0x9e, 0x93, 0x85, 0x5e, 0x29, 0x5d, 0x70,
+	0xaf, 0x78, 0x7f, 0x2a, 0x03, 0x52, 0x9d, 0x10, 0xf1, 0x60, 0x8b, 0x84, 0x1f, 0xa4, 0x98, 0x89,
+	0x4d, 0x92, 0xbe, 0x52, 0xec, 0x77, 0xa1, 0xa6, 0xee, 0xb9, 0x79, 0x97, 0x9f, 0x51, 0x21, 0x56,
+	0x0e, 0xbd, 0x03, 0x8d, 0x94, 0x04, 0x34, 0xa1, 0x84, 0x99, 0x5e, 0x38, 0x4b, 0xe9, 0x50, 0xb4,
+	0x80, 0x45, 0xe5, 0xa2, 0xe7, 0xc6, 0xfb, 0xd0, 0xcc, 0x98, 0x02, 0x62, 0xa4, 0x9e, 0x18, 0x6e,
+	0xf5, 0xff, 0x0e, 0x8e, 0x4b, 0x2a, 0x00, 0x35, 0x3c, 0x8c, 0x1d, 0x30, 0xda, 0x8a, 0xaf, 0x6c,
+	0xe9, 0x79, 0x32, 0x33, 0xb6, 0x6a, 0xaf, 0x6d, 0xcb, 0x68, 0x2b, 0xbe, 0xf7, 0xfb, 0x32, 0x5c,
+	0xda, 0x48, 0x08, 0x0b, 0x29, 0x9b, 0x3c, 0xa6, 0x31, 0x3d, 0xdf, 0x8d, 0x64, 0x06, 0xb5, 0x48,
+	0x29, 0x5f, 0xe0, 0x95, 0xc4, 0x3a, 0x44, 0xef, 0x42, 0x6d, 0x9b, 0xb2, 0x90, 0x6f, 0xdb, 0x77,
+	0xcf, 0xf5, 0x57, 0x70, 0x58, 0xb5, 0x4f, 0x36, 0x03, 0xc3, 0x1f, 0xe6, 0x30, 0x58, 0x3d, 0xb4,
+	0x0a, 0x55, 0x91, 0x90, 0x79, 0x51, 0xfc, 0xf0, 0xe4, 0x17, 0x9b, 0xc5, 0xc8, 0x27, 0x01, 0x4f,
+	0xc3, 0xe2, 0xbb, 0xc9, 0x28, 0xa3, 0x15, 0x58, 0xb4, 0x22, 0x36, 0xb9, 0xb7, 0x4e, 0xb4, 0xb3,
+	0x6e, 0x64, 0x8e, 0x40, 0xee, 0xe7, 0x9a, 0xea, 0xb2, 0x7e, 0xf9, 0xa8, 0x27, 0xf4, 0x53, 0xa8,
+	0xe8, 0x2c, 0x3b, 0xaf, 0x9b, 0x65, 0xad, 0xf6, 0x6d, 0xb6, 0xff, 0x5f, 0x4a, 0xd0, 0x3a, 0x69,
+	0xbb, 0x85, 0x6a, 0x71, 0xbe, 0xbd, 0x6a, 0x29, 0x9d, 0xb3, 0x5a, 0xd6, 0xe1, 0x32, 0xd9, 0xdc,
+	0x24, 0x81, 0xa4, 0xcf, 0x88, 0xe9, 0xbf, 0xf2, 0xeb, 0x66, 0xe6, 0xd2, 0xdc, 0x80, 0x6e, 0xc1,
+	0x5d, 0x07, 0xde, 0x5c, 0x25, 0x71, 0x96, 0xa6, 0x78, 0x42, 0x36, 0x82, 0x29, 0x51, 0x73, 0xfd,
+	0x94, 0xc3, 0xe2, 0x1b, 0x3c, 0x71, 0x5b, 0x50, 0xd5, 0xa4, 0x8e, 0xab, 0xe2, 0x1b, 0x02, 0x3d,
+	0x86, 0xba, 0x20, 0x93, 0x98, 0xe4, 0x67, 0x55, 0x73, 0xf9, 0xc7, 0xa7, 0x5c, 0x31, 0xf3, 0x40,
+	0x8c, 0x74, 0xb1, 0xd2, 0xe7, 0x16, 0xbc, 0x5f, 0xc3, 0x1b, 0xc7, 0x05, 0x51, 0x07, 0x9a, 0x42,
+	0xe2, 0x54, 0xda, 0x77, 0xad, 0x99, 0xef, 0xa0, 0x97, 0xf4, 0x73, 0x16, 0xbd, 0x0f, 0x95, 0x14,
+	0x4b, 0x62, 0xa7, 0xfc, 0x3b, 0xf6, 0x50, 0xbf, 0xf9, 0xea, 0xa1, 0xfe, 0x58, 0xa7, 0x6f, 0x95,
+	0x04, 0x85, 0xa3, 0x7d, 0x95, 0x04, 0xb6, 0xac, 0x95, 0x8d, 0xe1, 0xca, 0xa7, 0xfb, 0x6d, 0xe7,
+	0xf3, 0xfd, 0xb6, 0xf3, 0xef, 0xfd, 0xb6, 0xf3, 0xc9, 0xcb, 0xf6, 0xc2, 0xe7, 0x2f, 0xdb, 0x0b,
+	0x5f, 0xbe, 0x6c, 0x2f, 0xfc, 0xea, 0xd6, 0x99, 0x95, 0x62, 0x7f, 0xc8, 0xd0, 0x05, 0x33, 0xae,
+	0xe9, 0x54, 0xbd, 0xfd, 0xbf, 0x01, 0x00, 0x9f, 0xdf, 0xe3, 0xf3, 0xb8, 0x12, 0x00, 0x00,
+}
+
+func (this *SendEnabled) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*SendEnabled)
+	if !ok {
+		that2, ok := that.(SendEnabled)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Denom != that1.Denom {
+		return false
+	}
+	if this.Enabled != that1.Enabled {
+		return false
+	}
+	return true
+}
+func (this *Supply) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*Supply)
+	if !ok {
+		that2, ok := that.(Supply)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if len(this.Total) != len(that1.Total) {
+		return false
+	}
+	for i := range this.Total {
+		if !this.Total[i].Equal(&that1.Total[i]) {
+			return false
+		}
+	}
+	return true
+}
+func (m *Params) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Params) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.DustSweep.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintBank(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if m.DefaultSendEnabled {
+		i--
+		if m.DefaultSendEnabled {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.SendEnabled) > 0 {
+		for iNdEx := len(m.SendEnabled) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.SendEnabled[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintBank(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *DustSweepParams) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DustSweepParams) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DustSweepParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.MaxAccountsPerEpoch != 0 {
+		i = encodeVarintBank(dAtA, i, uint64(m.MaxAccountsPerEpoch))
+		i--
+		dAtA[i] = 0x30
+	}
+	if len(m.ExemptAddresses) > 0 {
+		for iNdEx := len(m.ExemptAddresses) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.ExemptAddresses[iNdEx])
+			copy(dAtA[i:], m.ExemptAddresses[iNdEx])
+			i = encodeVarintBank(dAtA, i, uint64(len(m.ExemptAddresses[iNdEx])))
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if m.Burn {
+		i--
+		if m.Burn {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.ChainWide {
+		i--
+		if m.ChainWide {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.EpochLength != 0 {
+		i = encodeVarintBank(dAtA, i, uint64(m.EpochLength))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Thresholds) > 0 {
+		for iNdEx := len(m.Thresholds) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Thresholds[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintBank(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *SendEnabled) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SendEnabled) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SendEnabled) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Enabled {
+		i--
+		if m.Enabled {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintBank(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *Input) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Input) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Input) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Coins) > 0 {
+		for iNdEx := len(m.Coins) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Coins[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintBank(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintBank(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *Output) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Output) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Output) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Coins) > 0 {
+		for iNdEx := len(m.Coins) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Coins[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintBank(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintBank(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *BatchOutput) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *BatchOutput) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *BatchOutput) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Memo) > 0 {
+		i -= len(m.Memo)
+		copy(dAtA[i:], m.Memo)
+		i = encodeVarintBank(dAtA, i, uint64(len(m.Memo)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Coins) > 0 {
+		for iNdEx := len(m.Coins) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Coins[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintBank(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintBank(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *Supply) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Supply) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Supply) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Total) > 0 {
+		for iNdEx := len(m.Total) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Total[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintBank(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *DenomUnit) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DenomUnit) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DenomUnit) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Aliases) > 0 {
+		for iNdEx := len(m.Aliases) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Aliases[iNdEx])
+			copy(dAtA[i:], m.Aliases[iNdEx])
+			i = encodeVarintBank(dAtA, i, uint64(len(m.Aliases[iNdEx])))
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if m.Exponent != 0 {
+		i = encodeVarintBank(dAtA, i, uint64(m.Exponent))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintBank(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *Metadata) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Metadata) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Metadata) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.URIHash) > 0 {
+		i -= len(m.URIHash)
+		copy(dAtA[i:], m.URIHash)
+		i = encodeVarintBank(dAtA, i, uint64(len(m.URIHash)))
+		i--
+		dAtA[i] = 0x42
+	}
+	if len(m.URI) > 0 {
+		i -= len(m.URI)
+		copy(dAtA[i:], m.URI)
+		i = encodeVarintBank(dAtA, i, uint64(len(m.URI)))
+		i--
+		dAtA[i] = 0x3a
+	}
+	if len(m.Symbol) > 0 {
+		i -= len(m.Symbol)
+		copy(dAtA[i:], m.Symbol)
+		i = encodeVarintBank(dAtA, i, uint64(len(m.Symbol)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintBank(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.Display) > 0 {
+		i -= len(m.Display)
+		copy(dAtA[i:], m.Display)
+		i = encodeVarintBank(dAtA, i, uint64(len(m.Display)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Base) > 0 {
+		i -= len(m.Base)
+		copy(dAtA[i:], m.Base)
+		i = encodeVarintBank(dAtA, i, uint64(len(m.Base)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.DenomUnits) > 0 {
+		for iNdEx := len(m.DenomUnits) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.DenomUnits[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintBank(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Description) > 0 {
+		i -= len(m.Description)
+		copy(dAtA[i:], m.Description)
+		i = encodeVarintBank(dAtA, i, uint64(len(m.Description)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *Hold) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Hold) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Hold) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Expiration != nil {
+		n2, err2 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(*m.Expiration, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.Expiration):])
+		if err2 != nil {
+			return 0, err2
+		}
+		i -= n2
+		i = encodeVarintBank(dAtA, i, uint64(n2))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Amount) > 0 {
+		for iNdEx := len(m.Amount) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Amount[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintBank(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.Holder) > 0 {
+		i -= len(m.Holder)
+		copy(dAtA[i:], m.Holder)
+		i = encodeVarintBank(dAtA, i, uint64(len(m.Holder)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintBank(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *BalanceSnapshot) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *BalanceSnapshot) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *BalanceSnapshot) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.Total.Size()
+		i -= size
+		if _, err := m.Total.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintBank(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x3a
+	if m.Count != 0 {
+		i = encodeVarintBank(dAtA, i, uint64(m.Count))
+		i--
+		dAtA[i] = 0x30
+	}
+	if len(m.Root) > 0 {
+		i -= len(m.Root)
+		copy(dAtA[i:], m.Root)
+		i = encodeVarintBank(dAtA, i, uint64(len(m.Root)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.Taken {
+		i--
+		if m.Taken {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.Height != 0 {
+		i = encodeVarintBank(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintBank(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Id != 0 {
+		i = encodeVarintBank(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *SnapshotBalance) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SnapshotBalance) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SnapshotBalance) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.Amount.Size()
+		i -= size
+		if _, err := m.Amount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintBank(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintBank(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *BalanceSnapshotProof) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *BalanceSnapshotProof) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *BalanceSnapshotProof) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Aunts) > 0 {
+		for iNdEx := len(m.Aunts) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Aunts[iNdEx])
+			copy(dAtA[i:], m.Aunts[iNdEx])
+			i = encodeVarintBank(dAtA, i, uint64(len(m.Aunts[iNdEx])))
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.LeafHash) > 0 {
+		i -= len(m.LeafHash)
+		copy(dAtA[i:], m.LeafHash)
+		i = encodeVarintBank(dAtA, i, uint64(len(m.LeafHash)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.Index != 0 {
+		i = encodeVarintBank(dAtA, i, uint64(m.Index))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Total != 0 {
+		i = encodeVarintBank(dAtA, i, uint64(m.Total))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MintBurnPermissions) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MintBurnPermissions) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MintBurnPermissions) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Burners) > 0 {
+		for iNdEx := len(m.Burners) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Burners[iNdEx])
+			copy(dAtA[i:], m.Burners[iNdEx])
+			i = encodeVarintBank(dAtA, i, uint64(len(m.Burners[iNdEx])))
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.Minters) > 0 {
+		for iNdEx := len(m.Minters) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Minters[iNdEx])
+			copy(dAtA[i:], m.Minters[iNdEx])
+			i = encodeVarintBank(dAtA, i, uint64(len(m.Minters[iNdEx])))
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintBank(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ModuleEscrow) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ModuleEscrow) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ModuleEscrow) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Amount) > 0 {
+		for iNdEx := len(m.Amount) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Amount[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintBank(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Purpose) > 0 {
+		i -= len(m.Purpose)
+		copy(dAtA[i:], m.Purpose)
+		i = encodeVarintBank(dAtA, i, uint64(len(m.Purpose)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *TimelockedTransfer) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TimelockedTransfer) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TimelockedTransfer) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	n3, err3 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.ExpiryTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.ExpiryTime):])
+	if err3 != nil {
+		return 0, err3
+	}
+	i -= n3
+	i = encodeVarintBank(dAtA, i, uint64(n3))
+	i--
+	dAtA[i] = 0x32
+	n4, err4 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.UnlockTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.UnlockTime):])
+	if err4 != nil {
+		return 0, err4
+	}
+	i -= n4
+	i = encodeVarintBank(dAtA, i, uint64(n4))
+	i--
+	dAtA[i] = 0x2a
+	if len(m.Amount) > 0 {
+		for iNdEx := len(m.Amount) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Amount[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintBank(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.Recipient) > 0 {
+		i -= len(m.Recipient)
+		copy(dAtA[i:], m.Recipient)
+		i = encodeVarintBank(dAtA, i, uint64(len(m.Recipient)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintBank(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Id != 0 {
+		i = encodeVarintBank(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *SpendingLimit) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SpendingLimit) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SpendingLimit) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pending != nil {
+		{
+			size, err := m.Pending.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintBank(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.Spent) > 0 {
+		for iNdEx := len(m.Spent) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Spent[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintBank(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	n6, err6 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.Window, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.Window):])
+	if err6 != nil {
+		return 0, err6
+	}
+	i -= n6
+	i = encodeVarintBank(dAtA, i, uint64(n6))
+	i--
+	dAtA[i] = 0x1a
+	if len(m.Limits) > 0 {
+		for iNdEx := len(m.Limits) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Limits[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintBank(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintBank(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *SpendingRecord) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SpendingRecord) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SpendingRecord) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Amount) > 0 {
+		for iNdEx := len(m.Amount) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Amount[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintBank(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	n7, err7 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.Time, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.Time):])
+	if err7 != nil {
+		return 0, err7
+	}
+	i -= n7
+	i = encodeVarintBank(dAtA, i, uint64(n7))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *PendingSpendingLimit) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PendingSpendingLimit) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PendingSpendingLimit) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	n8, err8 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.EffectiveTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.EffectiveTime):])
+	if err8 != nil {
+		return 0, err8
+	}
+	i -= n8
+	i = encodeVarintBank(dAtA, i, uint64(n8))
+	i--
+	dAtA[i] = 0x1a
+	n9, err9 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.Window, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.Window):])
+	if err9 != nil {
+		return 0, err9
+	}
+	i -= n9
+	i = encodeVarintBank(dAtA, i, uint64(n9))
+	i--
+	dAtA[i] = 0x12
+	if len(m.Limits) > 0 {
+		for iNdEx := len(m.Limits) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Limits[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintBank(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *DemurrageSchedule) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DemurrageSchedule) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DemurrageSchedule) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Segments) > 0 {
+		for iNdEx := len(m.Segments) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Segments[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintBank(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if m.Epoch != 0 {
+		i = encodeVarintBank(dAtA, i, uint64(m.Epoch))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.EpochLength != 0 {
+		i = encodeVarintBank(dAtA, i, uint64(m.EpochLength))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintBank(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *DemurrageSegment) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DemurrageSegment) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DemurrageSegment) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.Rate.Size()
+		i -= size
+		if _, err := m.Rate.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintBank(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if m.StartEpoch != 0 {
+		i = encodeVarintBank(dAtA, i, uint64(m.StartEpoch))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintBank(dAtA []byte, offset int, v uint64) int {
+	offset -= sovBank(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *Params) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.SendEnabled) > 0 {
+		for _, e := range m.SendEnabled {
+			l = e.Size()
+			n += 1 + l + sovBank(uint64(l))
+		}
+	}
+	if m.DefaultSendEnabled {
+		n += 2
+	}
+	l = m.DustSweep.Size()
+	n += 1 + l + sovBank(uint64(l))
+	return n
+}
+
+func (m *DustSweepParams) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Thresholds) > 0 {
+		for _, e := range m.Thresholds {
+			l = e.Size()
+			n += 1 + l + sovBank(uint64(l))
+		}
+	}
+	if m.EpochLength != 0 {
+		n += 1 + sovBank(uint64(m.EpochLength))
+	}
+	if m.ChainWide {
+		n += 2
+	}
+	if m.Burn {
+		n += 2
+	}
+	if len(m.ExemptAddresses) > 0 {
+		for _, s := range m.ExemptAddresses {
+			l = len(s)
+			n += 1 + l + sovBank(uint64(l))
+		}
+	}
+	if m.MaxAccountsPerEpoch != 0 {
+		n += 1 + sovBank(uint64(m.MaxAccountsPerEpoch))
+	}
+	return n
+}
+
+func (m *SendEnabled) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovBank(uint64(l))
+	}
+	if m.Enabled {
+		n += 2
+	}
+	return n
+}
+
+func (m *Input) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovBank(uint64(l))
+	}
+	if len(m.Coins) > 0 {
+		for _, e := range m.Coins {
+			l = e.Size()
+			n += 1 + l + sovBank(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *Output) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovBank(uint64(l))
+	}
+	if len(m.Coins) > 0 {
+		for _, e := range m.Coins {
+			l = e.Size()
+			n += 1 + l + sovBank(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *BatchOutput) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovBank(uint64(l))
+	}
+	if len(m.Coins) > 0 {
+		for _, e := range m.Coins {
+			l = e.Size()
+			n += 1 + l + sovBank(uint64(l))
+		}
+	}
+	l = len(m.Memo)
+	if l > 0 {
+		n += 1 + l + sovBank(uint64(l))
+	}
+	return n
+}
+
+func (m *Supply) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Total) > 0 {
+		for _, e := range m.Total {
+			l = e.Size()
+			n += 1 + l + sovBank(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *DenomUnit) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovBank(uint64(l))
+	}
+	if m.Exponent != 0 {
+		n += 1 + sovBank(uint64(m.Exponent))
+	}
+	if len(m.Aliases) > 0 {
+		for _, s := range m.Aliases {
+			l = len(s)
+			n += 1 + l + sovBank(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *Metadata) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Description)
+	if l > 0 {
+		n += 1 + l + sovBank(uint64(l))
+	}
+	if len(m.DenomUnits) > 0 {
+		for _, e := range m.DenomUnits {
+			l = e.Size()
+			n += 1 + l + sovBank(uint64(l))
+		}
+	}
+	l = len(m.Base)
+	if l > 0 {
+		n += 1 + l + sovBank(uint64(l))
+	}
+	l = len(m.Display)
+	if l > 0 {
+		n += 1 + l + sovBank(uint64(l))
+	}
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovBank(uint64(l))
+	}
+	l = len(m.Symbol)
+	if l > 0 {
+		n += 1 + l + sovBank(uint64(l))
+	}
+	l = len(m.URI)
+	if l > 0 {
+		n += 1 + l + sovBank(uint64(l))
+	}
+	l = len(m.URIHash)
+	if l > 0 {
+		n += 1 + l + sovBank(uint64(l))
+	}
+	return n
+}
+
+func (m *Hold) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovBank(uint64(l))
+	}
+	l = len(m.Holder)
+	if l > 0 {
+		n += 1 + l + sovBank(uint64(l))
+	}
+	if len(m.Amount) > 0 {
+		for _, e := range m.Amount {
+			l = e.Size()
+			n += 1 + l + sovBank(uint64(l))
+		}
+	}
+	if m.Expiration != nil {
+		l = github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.Expiration)
+		n += 1 + l + sovBank(uint64(l))
+	}
+	return n
+}
+
+func (m *BalanceSnapshot) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovBank(uint64(m.Id))
+	}
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovBank(uint64(l))
+	}
+	if m.Height != 0 {
+		n += 1 + sovBank(uint64(m.Height))
+	}
+	if m.Taken {
+		n += 2
+	}
+	l = len(m.Root)
+	if l > 0 {
+		n += 1 + l + sovBank(uint64(l))
+	}
+	if m.Count != 0 {
+		n += 1 + sovBank(uint64(m.Count))
+	}
+	l = m.Total.Size()
+	n += 1 + l + sovBank(uint64(l))
+	return n
+}
+
+func (m *SnapshotBalance) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovBank(uint64(l))
+	}
+	l = m.Amount.Size()
+	n += 1 + l + sovBank(uint64(l))
+	return n
+}
+
+func (m *BalanceSnapshotProof) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Total != 0 {
+		n += 1 + sovBank(uint64(m.Total))
+	}
+	if m.Index != 0 {
+		n += 1 + sovBank(uint64(m.Index))
+	}
+	l = len(m.LeafHash)
+	if l > 0 {
+		n += 1 + l + sovBank(uint64(l))
+	}
+	if len(m.Aunts) > 0 {
+		for _, b := range m.Aunts {
+			l = len(b)
+			n += 1 + l + sovBank(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MintBurnPermissions) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovBank(uint64(l))
+	}
+	if len(m.Minters) > 0 {
+		for _, s := range m.Minters {
+			l = len(s)
+			n += 1 + l + sovBank(uint64(l))
+		}
+	}
+	if len(m.Burners) > 0 {
+		for _, s := range m.Burners {
+			l = len(s)
+			n += 1 + l + sovBank(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *ModuleEscrow) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Purpose)
+	if l > 0 {
+		n += 1 + l + sovBank(uint64(l))
+	}
+	if len(m.Amount) > 0 {
+		for _, e := range m.Amount {
+			l = e.Size()
+			n += 1 + l + sovBank(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *TimelockedTransfer) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovBank(uint64(m.Id))
+	}
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovBank(uint64(l))
+	}
+	l = len(m.Recipient)
+	if l > 0 {
+		n += 1 + l + sovBank(uint64(l))
+	}
+	if len(m.Amount) > 0 {
+		for _, e := range m.Amount {
+			l = e.Size()
+			n += 1 + l + sovBank(uint64(l))
+		}
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.UnlockTime)
+	n += 1 + l + sovBank(uint64(l))
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.ExpiryTime)
+	n += 1 + l + sovBank(uint64(l))
+	return n
+}
+
+func (m *SpendingLimit) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovBank(uint64(l))
+	}
+	if len(m.Limits) > 0 {
+		for _, e := range m.Limits {
+			l = e.Size()
+			n += 1 + l + sovBank(uint64(l))
+		}
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.Window)
+	n += 1 + l + sovBank(uint64(l))
+	if len(m.Spent) > 0 {
+		for _, e := range m.Spent {
+			l = e.Size()
+			n += 1 + l + sovBank(uint64(l))
+		}
+	}
+	if m.Pending != nil {
+		l = m.Pending.Size()
+		n += 1 + l + sovBank(uint64(l))
+	}
+	return n
+}
+
+func (m *SpendingRecord) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.Time)
+	n += 1 + l + sovBank(uint64(l))
+	if len(m.Amount) > 0 {
+		for _, e := range m.Amount {
+			l = e.Size()
+			n += 1 + l + sovBank(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *PendingSpendingLimit) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Limits) > 0 {
+		for _, e := range m.Limits {
+			l = e.Size()
+			n += 1 + l + sovBank(uint64(l))
+		}
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.Window)
+	n += 1 + l + sovBank(uint64(l))
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.EffectiveTime)
+	n += 1 + l + sovBank(uint64(l))
+	return n
+}
+
+func (m *DemurrageSchedule) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovBank(uint64(l))
+	}
+	if m.EpochLength != 0 {
+		n += 1 + sovBank(uint64(m.EpochLength))
+	}
+	if m.Epoch != 0 {
+		n += 1 + sovBank(uint64(m.Epoch))
+	}
+	if len(m.Segments) > 0 {
+		for _, e := range m.Segments {
+			l = e.Size()
+			n += 1 + l + sovBank(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *DemurrageSegment) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.StartEpoch != 0 {
+		n += 1 + sovBank(uint64(m.StartEpoch))
+	}
+	l = m.Rate.Size()
+	n += 1 + l + sovBank(uint64(l))
+	return n
+}
+
+func sovBank(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozBank(x uint64) (n int) {
+	return sovBank(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *Params) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowBank
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Params: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Params: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SendEnabled", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBank
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthBank
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthBank
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SendEnabled = append(m.SendEnabled, &SendEnabled{})
+			if err := m.SendEnabled[len(m.SendEnabled)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DefaultSendEnabled", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBank
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.DefaultSendEnabled = bool(v != 0)
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DustSweep", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBank
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthBank
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthBank
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.DustSweep.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipBank(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthBank
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DustSweepParams) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowBank
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DustSweepParams: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DustSweepParams: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Thresholds", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBank
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthBank
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthBank
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Thresholds = append(m.Thresholds, types.Coin{})
+			if err := m.Thresholds[len(m.Thresholds)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EpochLength", wireType)
+			}
+			m.EpochLength = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBank
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EpochLength |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainWide", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBank
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.ChainWide = bool(v != 0)
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Burn", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBank
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Burn = bool(v != 0)
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExemptAddresses", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBank
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBank
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBank
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ExemptAddresses = append(m.ExemptAddresses, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxAccountsPerEpoch", wireType)
+			}
+			m.MaxAccountsPerEpoch = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBank
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxAccountsPerEpoch |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipBank(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthBank
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SendEnabled) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowBank
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SendEnabled: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SendEnabled: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBank
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBank
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBank
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Enabled", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBank
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Enabled = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipBank(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthBank
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Input) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowBank
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Input: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Input: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBank
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBank
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBank
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Coins", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBank
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthBank
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthBank
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Coins = append(m.Coins, types.Coin{})
+			if err := m.Coins[len(m.Coins)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipBank(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthBank
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Output) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowBank
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Output: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Output: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBank
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBank
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBank
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Coins", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBank
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthBank
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthBank
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Coins = append(m.Coins, types.Coin{})
+			if err := m.Coins[len(m.Coins)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipBank(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthBank
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *BatchOutput) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowBank
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: BatchOutput: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: BatchOutput: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBank
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBank
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBank
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Coins", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBank
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthBank
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthBank
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Coins = append(m.Coins, types.Coin{})
+			if err := m.Coins[len(m.Coins)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Memo", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBank
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBank
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBank
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Memo = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipBank(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthBank
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Supply) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowBank
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Supply: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Supply: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Total", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBank
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthBank
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthBank
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Total = append(m.Total, types.Coin{})
+			if err := m.Total[len(m.Total)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipBank(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthBank
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DenomUnit) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowBank
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DenomUnit: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DenomUnit: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBank
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBank
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBank
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Exponent", wireType)
+			}
+			m.Exponent = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBank
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Exponent |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Aliases", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBank
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBank
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBank
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Aliases = append(m.Aliases, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipBank(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthBank
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Metadata) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowBank
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Metadata: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Metadata: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBank
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBank
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBank
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Description = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DenomUnits", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBank
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthBank
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthBank
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DenomUnits = append(m.DenomUnits, &DenomUnit{})
+			if err := m.DenomUnits[len(m.DenomUnits)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Base", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBank
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBank
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBank
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Base = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Display", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBank
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBank
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBank
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Display = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBank
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBank
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBank
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Symbol", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBank
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBank
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBank
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Symbol = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field URI", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBank
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBank
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBank
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.URI = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field URIHash", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBank
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBank
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBank
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.URIHash = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipBank(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthBank
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Hold) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowBank
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Hold: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Hold: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBank
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBank
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBank
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Holder", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBank
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBank
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBank
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Holder = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBank
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthBank
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthBank
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Amount = append(m.Amount, types.Coin{})
+			if err := m.Amount[len(m.Amount)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Expiration", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBank
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthBank
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthBank
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Expiration == nil {
+				m.Expiration = new(time.Time)
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(m.Expiration, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipBank(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthBank
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *BalanceSnapshot) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowBank
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: BalanceSnapshot: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: BalanceSnapshot: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBank
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBank
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBank
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBank
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBank
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Taken", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBank
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Taken = bool(v != 0)
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Root", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBank
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthBank
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBank
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Root = append(m.Root[:0], dAtA[iNdEx:postIndex]...)
+			if m.Root == nil {
+				m.Root = []byte{}
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Count", wireType)
+			}
+			m.Count = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBank
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Count |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Total", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBank
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBank
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBank
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Total.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipBank(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthBank
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SnapshotBalance) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowBank
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SnapshotBalance: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SnapshotBalance: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBank
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBank
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBank
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBank
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBank
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBank
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipBank(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthBank
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
 		}
 	}
-	return n
-}
 
-func (m *Metadata) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Description)
-	if l > 0 {
-		n += 1 + l + sovBank(uint64(l))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	if len(m.DenomUnits) > 0 {
-		for _, e := range m.DenomUnits {
-			l = e.Size()
-			n += 1 + l + sovBank(uint64(l))
+	return nil
+}
+func (m *BalanceSnapshotProof) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowBank
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: BalanceSnapshotProof: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: BalanceSnapshotProof: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Total", wireType)
+			}
+			m.Total = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBank
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Total |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Index", wireType)
+			}
+			m.Index = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBank
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Index |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LeafHash", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBank
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthBank
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBank
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.LeafHash = append(m.LeafHash[:0], dAtA[iNdEx:postIndex]...)
+			if m.LeafHash == nil {
+				m.LeafHash = []byte{}
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Aunts", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBank
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthBank
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBank
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Aunts = append(m.Aunts, make([]byte, postIndex-iNdEx))
+			copy(m.Aunts[len(m.Aunts)-1], dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipBank(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthBank
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
 		}
 	}
-	l = len(m.Base)
-	if l > 0 {
-		n += 1 + l + sovBank(uint64(l))
-	}
-	l = len(m.Display)
-	if l > 0 {
-		n += 1 + l + sovBank(uint64(l))
-	}
-	l = len(m.Name)
-	if l > 0 {
-		n += 1 + l + sovBank(uint64(l))
-	}
-	l = len(m.Symbol)
-	if l > 0 {
-		n += 1 + l + sovBank(uint64(l))
-	}
-	l = len(m.URI)
-	if l > 0 {
-		n += 1 + l + sovBank(uint64(l))
-	}
-	l = len(m.URIHash)
-	if l > 0 {
-		n += 1 + l + sovBank(uint64(l))
-	}
-	return n
-}
 
-func sovBank(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozBank(x uint64) (n int) {
-	return sovBank(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
 }
-func (m *Params) Unmarshal(dAtA []byte) error {
+func (m *MintBurnPermissions) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1099,17 +5359,17 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Params: wiretype end group for non-group")
+			return fmt.Errorf("proto: MintBurnPermissions: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Params: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MintBurnPermissions: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SendEnabled", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowBank
@@ -1119,31 +5379,29 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthBank
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthBank
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.SendEnabled = append(m.SendEnabled, &SendEnabled{})
-			if err := m.SendEnabled[len(m.SendEnabled)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DefaultSendEnabled", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Minters", wireType)
 			}
-			var v int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowBank
@@ -1153,12 +5411,56 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBank
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBank
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Minters = append(m.Minters, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Burners", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBank
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.DefaultSendEnabled = bool(v != 0)
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBank
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBank
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Burners = append(m.Burners, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipBank(dAtA[iNdEx:])
@@ -1180,7 +5482,7 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *SendEnabled) Unmarshal(dAtA []byte) error {
+func (m *ModuleEscrow) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1203,15 +5505,15 @@ func (m *SendEnabled) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: SendEnabled: wiretype end group for non-group")
+			return fmt.Errorf("proto: ModuleEscrow: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: SendEnabled: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ModuleEscrow: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Purpose", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1239,13 +5541,13 @@ func (m *SendEnabled) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Denom = string(dAtA[iNdEx:postIndex])
+			m.Purpose = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Enabled", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
 			}
-			var v int
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowBank
@@ -1255,12 +5557,26 @@ func (m *SendEnabled) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.Enabled = bool(v != 0)
+			if msglen < 0 {
+				return ErrInvalidLengthBank
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthBank
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Amount = append(m.Amount, types.Coin{})
+			if err := m.Amount[len(m.Amount)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipBank(dAtA[iNdEx:])
@@ -1282,7 +5598,7 @@ func (m *SendEnabled) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Input) Unmarshal(dAtA []byte) error {
+func (m *TimelockedTransfer) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1305,15 +5621,34 @@ func (m *Input) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Input: wiretype end group for non-group")
+			return fmt.Errorf("proto: TimelockedTransfer: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Input: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: TimelockedTransfer: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBank
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1341,11 +5676,43 @@ func (m *Input) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Address = string(dAtA[iNdEx:postIndex])
+			m.Sender = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Coins", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Recipient", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBank
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBank
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBank
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Recipient = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1372,8 +5739,74 @@ func (m *Input) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Coins = append(m.Coins, types.Coin{})
-			if err := m.Coins[len(m.Coins)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Amount = append(m.Amount, types.Coin{})
+			if err := m.Amount[len(m.Amount)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UnlockTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBank
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthBank
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthBank
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.UnlockTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExpiryTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBank
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthBank
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthBank
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.ExpiryTime, dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -1398,7 +5831,7 @@ func (m *Input) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Output) Unmarshal(dAtA []byte) error {
+func (m *SpendingLimit) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1421,10 +5854,10 @@ func (m *Output) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Output: wiretype end group for non-group")
+			return fmt.Errorf("proto: SpendingLimit: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Output: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: SpendingLimit: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -1461,7 +5894,7 @@ func (m *Output) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Coins", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Limits", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1488,64 +5921,81 @@ func (m *Output) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Coins = append(m.Coins, types.Coin{})
-			if err := m.Coins[len(m.Coins)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Limits = append(m.Limits, types.Coin{})
+			if err := m.Limits[len(m.Limits)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipBank(dAtA[iNdEx:])
-			if err != nil {
-				return err
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Window", wireType)
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBank
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
 				return ErrInvalidLengthBank
 			}
-			if (iNdEx + skippy) > l {
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthBank
+			}
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *Supply) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowBank
+			if err := github_com_cosmos_gogoproto_types.StdDurationUnmarshal(&m.Window, dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			if iNdEx >= l {
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Spent", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBank
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthBank
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthBank
+			}
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			m.Spent = append(m.Spent, SpendingRecord{})
+			if err := m.Spent[len(m.Spent)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: Supply: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Supply: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			iNdEx = postIndex
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Total", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Pending", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1572,8 +6022,10 @@ func (m *Supply) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Total = append(m.Total, types.Coin{})
-			if err := m.Total[len(m.Total)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.Pending == nil {
+				m.Pending = &PendingSpendingLimit{}
+			}
+			if err := m.Pending.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -1598,7 +6050,7 @@ func (m *Supply) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *DenomUnit) Unmarshal(dAtA []byte) error {
+func (m *SpendingRecord) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1621,17 +6073,17 @@ func (m *DenomUnit) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: DenomUnit: wiretype end group for non-group")
+			return fmt.Errorf("proto: SpendingRecord: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: DenomUnit: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: SpendingRecord: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Time", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowBank
@@ -1641,48 +6093,30 @@ func (m *DenomUnit) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthBank
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthBank
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Denom = string(dAtA[iNdEx:postIndex])
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.Time, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Exponent", wireType)
-			}
-			m.Exponent = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowBank
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Exponent |= uint32(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Aliases", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowBank
@@ -1692,23 +6126,25 @@ func (m *DenomUnit) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthBank
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthBank
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Aliases = append(m.Aliases, string(dAtA[iNdEx:postIndex]))
+			m.Amount = append(m.Amount, types.Coin{})
+			if err := m.Amount[len(m.Amount)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -1731,7 +6167,7 @@ func (m *DenomUnit) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Metadata) Unmarshal(dAtA []byte) error {
+func (m *PendingSpendingLimit) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1754,17 +6190,17 @@ func (m *Metadata) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Metadata: wiretype end group for non-group")
+			return fmt.Errorf("proto: PendingSpendingLimit: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Metadata: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: PendingSpendingLimit: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Limits", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowBank
@@ -1774,27 +6210,29 @@ func (m *Metadata) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthBank
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthBank
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Description = string(dAtA[iNdEx:postIndex])
+			m.Limits = append(m.Limits, types.Coin{})
+			if err := m.Limits[len(m.Limits)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DenomUnits", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Window", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1821,16 +6259,15 @@ func (m *Metadata) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.DenomUnits = append(m.DenomUnits, &DenomUnit{})
-			if err := m.DenomUnits[len(m.DenomUnits)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := github_com_cosmos_gogoproto_types.StdDurationUnmarshal(&m.Window, dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Base", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field EffectiveTime", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowBank
@@ -1840,27 +6277,78 @@ func (m *Metadata) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthBank
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthBank
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Base = string(dAtA[iNdEx:postIndex])
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.EffectiveTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 4:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipBank(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthBank
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DemurrageSchedule) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowBank
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DemurrageSchedule: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DemurrageSchedule: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Display", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1888,13 +6376,13 @@ func (m *Metadata) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Display = string(dAtA[iNdEx:postIndex])
+			m.Denom = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 5:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EpochLength", wireType)
 			}
-			var stringLen uint64
+			m.EpochLength = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowBank
@@ -1904,29 +6392,35 @@ func (m *Metadata) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.EpochLength |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthBank
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthBank
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Epoch", wireType)
 			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+			m.Epoch = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBank
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Epoch |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			m.Name = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 6:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Symbol", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Segments", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowBank
@@ -1936,29 +6430,81 @@ func (m *Metadata) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthBank
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthBank
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Symbol = string(dAtA[iNdEx:postIndex])
+			m.Segments = append(m.Segments, DemurrageSegment{})
+			if err := m.Segments[len(m.Segments)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 7:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field URI", wireType)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipBank(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			var stringLen uint64
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthBank
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DemurrageSegment) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowBank
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DemurrageSegment: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DemurrageSegment: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartEpoch", wireType)
+			}
+			m.StartEpoch = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowBank
@@ -1968,27 +6514,14 @@ func (m *Metadata) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.StartEpoch |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthBank
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthBank
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.URI = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 8:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field URIHash", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Rate", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2016,7 +6549,9 @@ func (m *Metadata) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.URIHash = string(dAtA[iNdEx:postIndex])
+			if err := m.Rate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -18,8 +18,19 @@ import (
 func RegisterLegacyAminoCodec(cdc *codec.LegacyAmino) {
 	legacy.RegisterAminoMsg(cdc, &MsgSend{}, "cosmos-sdk/MsgSend")
 	legacy.RegisterAminoMsg(cdc, &MsgMultiSend{}, "cosmos-sdk/MsgMultiSend")
+	legacy.RegisterAminoMsg(cdc, &MsgBatchSend{}, "cosmos-sdk/MsgBatchSend")
 	legacy.RegisterAminoMsg(cdc, &MsgUpdateParams{}, "cosmos-sdk/x/bank/MsgUpdateParams")
 	legacy.RegisterAminoMsg(cdc, &MsgSetSendEnabled{}, "cosmos-sdk/MsgSetSendEnabled")
+	legacy.RegisterAminoMsg(cdc, &MsgUpdateDenomMetadata{}, "cosmos-sdk/MsgUpdateDenomMetadata")
+	legacy.RegisterAminoMsg(cdc, &MsgCreateBalanceSnapshot{}, "cosmos-sdk/MsgCreateBalanceSnapshot")
+	legacy.RegisterAminoMsg(cdc, &MsgDeleteBalanceSnapshot{}, "cosmos-sdk/MsgDeleteBalanceSnapshot")
+	legacy.RegisterAminoMsg(cdc, &MsgSetMintBurnPermissions{}, "cosmos-sdk/MsgSetMintBurnPermissions")
+	legacy.RegisterAminoMsg(cdc, &MsgSetDustSweepOptIn{}, "cosmos-sdk/MsgSetDustSweepOptIn")
+	legacy.RegisterAminoMsg(cdc, &MsgSendWithTimelock{}, "cosmos-sdk/MsgSendWithTimelock")
+	legacy.RegisterAminoMsg(cdc, &MsgClaimTimelockedTransfer{}, "cosmos-sdk/MsgClaimTimelockedTransfer")
+	legacy.RegisterAminoMsg(cdc, &MsgReclaimTimelockedTransfer{}, "cosmos-sdk/MsgReclaimTimelockedTransfer")
+	legacy.RegisterAminoMsg(cdc, &MsgSetSpendingLimit{}, "cosmos-sdk/MsgSetSpendingLimit")
+	legacy.RegisterAminoMsg(cdc, &MsgSetDemurrageSchedule{}, "cosmos-sdk/MsgSetDemurrageSchedule")
 
 	cdc.RegisterConcrete(&SendAuthorization{}, "cosmos-sdk/SendAuthorization", nil)
 	cdc.RegisterConcrete(&Params{}, "cosmos-sdk/x/bank/Params", nil)
@@ -0,0 +1,126 @@
+package types
+
+import (
+	"errors"
+	"fmt"
+
+	"cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// NewDemurrageSchedule returns a new demurrage schedule of a denomination,
+// starting at epoch zero.
+func NewDemurrageSchedule(denom string, rate math.LegacyDec, epochLength int64) DemurrageSchedule {
+	return DemurrageSchedule{
+		Denom:       denom,
+		EpochLength: epochLength,
+		Segments:    []DemurrageSegment{{StartEpoch: 0, Rate: rate}},
+	}
+}
+
+// Rate returns the current rate of the schedule.
+func (s DemurrageSchedule) Rate() math.LegacyDec {
+	if len(s.Segments) == 0 {
+		return math.LegacyZeroDec()
+	}
+
+	return s.Segments[len(s.Segments)-1].Rate
+}
+
+// SetRate sets the rate of the schedule from its current epoch, keeping the
+// rates of the previous epochs so that their decay is still settled at these
+// rates.
+func (s *DemurrageSchedule) SetRate(rate math.LegacyDec) {
+	if len(s.Segments) > 0 {
+		last := &s.Segments[len(s.Segments)-1]
+		if last.Rate.Equal(rate) {
+			return
+		}
+		// the current epoch isn't over, so that its rate can be replaced
+		if last.StartEpoch == s.Epoch {
+			last.Rate = rate
+			return
+		}
+	}
+
+	s.Segments = append(s.Segments, DemurrageSegment{StartEpoch: s.Epoch, Rate: rate})
+}
+
+// DecayFactor returns the fraction of a balance last settled at the given
+// epoch which remains at the current epoch of the schedule, i.e. the product
+// of one minus the rate of each epoch since.
+func (s DemurrageSchedule) DecayFactor(from uint64) math.LegacyDec {
+	factor := math.LegacyOneDec()
+	for i, segment := range s.Segments {
+		start, end := segment.StartEpoch, s.Epoch
+		if i+1 < len(s.Segments) {
+			end = s.Segments[i+1].StartEpoch
+		}
+		if start < from {
+			start = from
+		}
+		if end <= start || segment.Rate.IsZero() {
+			continue
+		}
+
+		factor = factor.Mul(math.LegacyOneDec().Sub(segment.Rate).Power(end - start))
+		if factor.IsZero() {
+			break
+		}
+	}
+
+	return factor
+}
+
+// Decay returns the amount remaining at the current epoch of the schedule of a
+// balance last settled at the given epoch, rounded down.
+func (s DemurrageSchedule) Decay(amount math.Int, from uint64) math.Int {
+	if from >= s.Epoch || !amount.IsPositive() {
+		return amount
+	}
+
+	return math.LegacyNewDecFromInt(amount).Mul(s.DecayFactor(from)).TruncateInt()
+}
+
+// Validate checks for denom correctness, that the epoch length is positive and
+// that the segments start at epoch zero, in order, with valid rates.
+func (s DemurrageSchedule) Validate() error {
+	if err := sdk.ValidateDenom(s.Denom); err != nil {
+		return err
+	}
+
+	if s.EpochLength <= 0 {
+		return fmt.Errorf("epoch length of the demurrage schedule of %s must be positive: %d", s.Denom, s.EpochLength)
+	}
+
+	if len(s.Segments) == 0 || s.Segments[0].StartEpoch != 0 {
+		return fmt.Errorf("segments of the demurrage schedule of %s must start at epoch zero", s.Denom)
+	}
+
+	for i, segment := range s.Segments {
+		if i > 0 && segment.StartEpoch <= s.Segments[i-1].StartEpoch {
+			return fmt.Errorf("segments of the demurrage schedule of %s must be in increasing start epoch order", s.Denom)
+		}
+		if segment.StartEpoch > s.Epoch {
+			return fmt.Errorf("segment of the demurrage schedule of %s starts after its current epoch: %d > %d", s.Denom, segment.StartEpoch, s.Epoch)
+		}
+		if err := ValidateDemurrageRate(segment.Rate); err != nil {
+			return fmt.Errorf("invalid rate of the demurrage schedule of %s: %w", s.Denom, err)
+		}
+	}
+
+	return nil
+}
+
+// ValidateDemurrageRate checks that a demurrage rate is between 0 and 1.
+func ValidateDemurrageRate(rate math.LegacyDec) error {
+	if rate.IsNil() {
+		return errors.New("rate cannot be nil")
+	}
+	if rate.IsNegative() || rate.GT(math.LegacyOneDec()) {
+		return fmt.Errorf("rate must be between 0 and 1: %s", rate)
+	}
+
+	return nil
+}
@@ -12,4 +12,20 @@ var (
 	ErrInvalidKey            = errors.Register(ModuleName, 7, "invalid key")
 	ErrDuplicateEntry        = errors.Register(ModuleName, 8, "duplicate entry")
 	ErrMultipleSenders       = errors.Register(ModuleName, 9, "multiple senders not allowed")
+	ErrInvalidHold           = errors.Register(ModuleName, 10, "invalid hold")
+	ErrHoldNotFound          = errors.Register(ModuleName, 11, "hold not found")
+	ErrInsufficientHold      = errors.Register(ModuleName, 12, "insufficient held coins")
+	ErrInvalidSnapshot       = errors.Register(ModuleName, 13, "invalid balance snapshot")
+	ErrSnapshotNotFound      = errors.Register(ModuleName, 14, "balance snapshot not found")
+	ErrMintBurnNotPermitted  = errors.Register(ModuleName, 15, "mint/burn not permitted for denom")
+	ErrInsufficientEscrow    = errors.Register(ModuleName, 16, "insufficient escrowed coins")
+	ErrInvalidTimelock       = errors.Register(ModuleName, 17, "invalid time-locked transfer")
+	ErrTimelockNotFound      = errors.Register(ModuleName, 18, "time-locked transfer not found")
+	ErrTimelockLocked        = errors.Register(ModuleName, 19, "time-locked transfer is locked")
+	ErrTimelockExpired       = errors.Register(ModuleName, 20, "time-locked transfer is expired")
+	ErrTimelockNotExpired    = errors.Register(ModuleName, 21, "time-locked transfer is not expired")
+	ErrInvalidSpendingLimit  = errors.Register(ModuleName, 22, "invalid spending limit")
+	ErrSpendingLimitNotFound = errors.Register(ModuleName, 23, "spending limit not found")
+	ErrSpendingLimitExceeded = errors.Register(ModuleName, 24, "spending limit exceeded")
+	ErrInvalidDemurrage      = errors.Register(ModuleName, 25, "invalid demurrage schedule")
 )
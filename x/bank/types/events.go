@@ -21,6 +21,66 @@ const (
 	AttributeKeyReceiver = "receiver"
 	AttributeKeyMinter   = "minter"
 	AttributeKeyBurner   = "burner"
+
+	// hold events name and attributes
+	EventTypeHold        = "hold"
+	EventTypeReleaseHold = "release_hold"
+
+	AttributeKeyAccount    = "account"
+	AttributeKeyHolder     = "holder"
+	AttributeKeyExpiration = "expiration"
+
+	// denom metadata events name and attributes
+	EventTypeUpdateDenomMetadata = "update_denom_metadata"
+
+	AttributeKeyDenom = "denom"
+
+	// balance snapshot events name and attributes
+	EventTypeCreateBalanceSnapshot = "create_balance_snapshot"
+	EventTypeTakeBalanceSnapshot   = "take_balance_snapshot"
+	EventTypeDeleteBalanceSnapshot = "delete_balance_snapshot"
+
+	AttributeKeySnapshotID = "snapshot_id"
+	AttributeKeyHeight     = "height"
+	AttributeKeyRoot       = "root"
+	AttributeKeyCount      = "count"
+
+	// dust sweep events name and attributes
+	EventTypeDustSweep         = "dust_sweep"
+	EventTypeSetDustSweepOptIn = "set_dust_sweep_opt_in"
+
+	AttributeKeyDestination = "destination"
+	AttributeKeyOptIn       = "opt_in"
+
+	// DustSweepDestinationCommunityPool and DustSweepDestinationBurn are the
+	// destinations of the swept dust.
+	DustSweepDestinationCommunityPool = "community_pool"
+	DustSweepDestinationBurn          = "burn"
+
+	// time-locked transfer events name and attributes
+	EventTypeSendWithTimelock          = "send_with_timelock"
+	EventTypeClaimTimelockedTransfer   = "claim_timelocked_transfer"
+	EventTypeReclaimTimelockedTransfer = "reclaim_timelocked_transfer"
+
+	AttributeKeyTransferID = "transfer_id"
+	AttributeKeyUnlockTime = "unlock_time"
+	AttributeKeyExpiryTime = "expiry_time"
+
+	// spending limit events name and attributes
+	EventTypeSetSpendingLimit = "set_spending_limit"
+
+	AttributeKeyLimits        = "limits"
+	AttributeKeyWindow        = "window"
+	AttributeKeyEffectiveTime = "effective_time"
+
+	// demurrage events name and attributes
+	EventTypeSetDemurrageSchedule = "set_demurrage_schedule"
+	EventTypeDemurrageEpoch       = "demurrage_epoch"
+	EventTypeDemurrage            = "demurrage"
+
+	AttributeKeyRate        = "rate"
+	AttributeKeyEpochLength = "epoch_length"
+	AttributeKeyEpoch       = "epoch"
 )
 
 // NewCoinSpentEvent constructs a new coin spent sdk.Event
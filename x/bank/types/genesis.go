@@ -1,10 +1,13 @@
 package types
 
 import (
+	"bytes"
 	"encoding/json"
 	"errors"
 	"fmt"
 
+	"cosmossdk.io/math"
+
 	"github.com/cosmos/cosmos-sdk/codec"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
@@ -62,6 +65,119 @@ func (gs GenesisState) Validate() error {
 		seenMetadatas[metadata.Base] = true
 	}
 
+	balances := make(map[string]sdk.Coins, len(gs.Balances))
+	for _, balance := range gs.Balances {
+		balances[balance.Address] = balance.Coins
+	}
+
+	seenHolds := make(map[string]bool)
+	held := make(map[string]sdk.Coins)
+	for _, hold := range gs.Holds {
+		if err := hold.Validate(); err != nil {
+			return err
+		}
+
+		key := hold.Address + "/" + hold.Holder
+		if seenHolds[key] {
+			return fmt.Errorf("duplicate hold of %s on %s", hold.Holder, hold.Address)
+		}
+		seenHolds[key] = true
+
+		held[hold.Address] = held[hold.Address].Add(hold.Amount...)
+		if !held[hold.Address].IsAllLTE(balances[hold.Address]) {
+			return fmt.Errorf("held coins %s exceed the balance of %s", held[hold.Address], hold.Address)
+		}
+	}
+
+	if err := validateBalanceSnapshots(gs.BalanceSnapshots); err != nil {
+		return err
+	}
+
+	seenMintBurnPermissions := make(map[string]bool)
+	for _, p := range gs.MintBurnPermissions {
+		if seenMintBurnPermissions[p.Denom] {
+			return fmt.Errorf("duplicate mint/burn permissions for denom %s", p.Denom)
+		}
+		if err := p.Validate(); err != nil {
+			return err
+		}
+		seenMintBurnPermissions[p.Denom] = true
+	}
+
+	seenDustSweepOptIns := make(map[string]bool)
+	for _, addr := range gs.DustSweepOptIns {
+		if _, err := sdk.AccAddressFromBech32(addr); err != nil {
+			return fmt.Errorf("invalid dust sweep opt-in address %s: %w", addr, err)
+		}
+		if seenDustSweepOptIns[addr] {
+			return fmt.Errorf("duplicate dust sweep opt-in of %s", addr)
+		}
+		seenDustSweepOptIns[addr] = true
+	}
+
+	holds := make(map[string]Hold, len(gs.Holds))
+	for _, hold := range gs.Holds {
+		holds[hold.Address+"/"+hold.Holder] = hold
+	}
+
+	seenTimelockedTransfers := make(map[uint64]bool)
+	for _, t := range gs.TimelockedTransfers {
+		if seenTimelockedTransfers[t.Id] {
+			return fmt.Errorf("duplicate time-locked transfer %d", t.Id)
+		}
+		if err := t.Validate(); err != nil {
+			return err
+		}
+		seenTimelockedTransfers[t.Id] = true
+
+		// the coins of the transfer must be held in the account of its sender
+		hold := t.Hold()
+		if held, ok := holds[hold.Address+"/"+hold.Holder]; !ok || !held.Amount.Equal(t.Amount) || held.Expiration != nil {
+			return fmt.Errorf("time-locked transfer %d has no matching hold on %s", t.Id, t.Sender)
+		}
+	}
+
+	seenSpendingLimits := make(map[string]bool)
+	for _, l := range gs.SpendingLimits {
+		if seenSpendingLimits[l.Address] {
+			return fmt.Errorf("duplicate spending limit of %s", l.Address)
+		}
+		if err := l.Validate(); err != nil {
+			return err
+		}
+		seenSpendingLimits[l.Address] = true
+	}
+
+	demurrageSchedules := make(map[string]DemurrageSchedule)
+	for _, s := range gs.DemurrageSchedules {
+		if _, ok := demurrageSchedules[s.Denom]; ok {
+			return fmt.Errorf("duplicate demurrage schedule for denom %s", s.Denom)
+		}
+		if err := s.Validate(); err != nil {
+			return err
+		}
+		demurrageSchedules[s.Denom] = s
+	}
+
+	seenDemurrageAccountEpochs := make(map[string]bool)
+	for _, e := range gs.DemurrageAccountEpochs {
+		if _, err := sdk.AccAddressFromBech32(e.Address); err != nil {
+			return fmt.Errorf("invalid demurrage account epoch address %s: %w", e.Address, err)
+		}
+		s, ok := demurrageSchedules[e.Denom]
+		if !ok {
+			return fmt.Errorf("demurrage account epoch of %s for denom %s without demurrage schedule", e.Address, e.Denom)
+		}
+		if e.Epoch > s.Epoch {
+			return fmt.Errorf("demurrage account epoch of %s for denom %s after the schedule epoch: %d > %d", e.Address, e.Denom, e.Epoch, s.Epoch)
+		}
+		key := e.Address + "/" + e.Denom
+		if seenDemurrageAccountEpochs[key] {
+			return fmt.Errorf("duplicate demurrage account epoch of %s for denom %s", e.Address, e.Denom)
+		}
+		seenDemurrageAccountEpochs[key] = true
+	}
+
 	if !gs.Supply.Empty() {
 		// NOTE: this errors if supply for any given coin is zero
 		err := gs.Supply.Validate()
@@ -77,6 +193,58 @@ func (gs GenesisState) Validate() error {
 	return nil
 }
 
+// validateBalanceSnapshots checks that the balance snapshots are unique and
+// that the recorded balances of each snapshot match its root and totals.
+func validateBalanceSnapshots(snapshots []GenesisBalanceSnapshot) error {
+	seenSnapshots := make(map[uint64]bool)
+	for _, gs := range snapshots {
+		snapshot := gs.Snapshot
+		if seenSnapshots[snapshot.Id] {
+			return fmt.Errorf("duplicate balance snapshot %d", snapshot.Id)
+		}
+		seenSnapshots[snapshot.Id] = true
+
+		if err := snapshot.Validate(); err != nil {
+			return err
+		}
+
+		if !snapshot.Taken {
+			if len(gs.Balances) > 0 {
+				return fmt.Errorf("balance snapshot %d is not taken but has balances", snapshot.Id)
+			}
+			continue
+		}
+
+		var prev sdk.AccAddress
+		total := math.ZeroInt()
+		leaves := make([][]byte, 0, len(gs.Balances))
+		for _, balance := range gs.Balances {
+			if err := balance.Validate(); err != nil {
+				return err
+			}
+
+			addr := sdk.MustAccAddressFromBech32(balance.Address)
+			if prev != nil && bytes.Compare(prev, addr) >= 0 {
+				return fmt.Errorf("balances of balance snapshot %d are not sorted by address", snapshot.Id)
+			}
+			prev = addr
+
+			total = total.Add(balance.Amount)
+			leaves = append(leaves, SnapshotLeaf(addr, balance.Amount))
+		}
+
+		if snapshot.Count != uint64(len(gs.Balances)) || !snapshot.Total.Equal(total) {
+			return fmt.Errorf("balances of balance snapshot %d don't match its count and total", snapshot.Id)
+		}
+
+		if !bytes.Equal(snapshot.Root, SnapshotRoot(leaves)) {
+			return fmt.Errorf("balances of balance snapshot %d don't match its root", snapshot.Id)
+		}
+	}
+
+	return nil
+}
+
 // NewGenesisState creates a new genesis state.
 func NewGenesisState(params Params, balances []Balance, supply sdk.Coins, denomMetaData []Metadata, sendEnabled []SendEnabled) *GenesisState {
 	rv := &GenesisState{
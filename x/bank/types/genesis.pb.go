@@ -42,6 +42,43 @@ type GenesisState struct {
 	//
 	// Since: cosmos-sdk 0.47
 	SendEnabled []SendEnabled `protobuf:"bytes,5,rep,name=send_enabled,json=sendEnabled,proto3" json:"send_enabled"`
+	// holds defines the portions of the balances held by holders.
+	//
+	// Since: cosmos-sdk 0.48
+	Holds []Hold `protobuf:"bytes,6,rep,name=holds,proto3" json:"holds"`
+	// balance_snapshots defines the balance snapshots, along with their recorded
+	// balances.
+	//
+	// Since: cosmos-sdk 0.48
+	BalanceSnapshots []GenesisBalanceSnapshot `protobuf:"bytes,7,rep,name=balance_snapshots,json=balanceSnapshots,proto3" json:"balance_snapshots"`
+	// mint_burn_permissions defines the modules allowed to mint and burn the
+	// denominations.
+	//
+	// Since: cosmos-sdk 0.48
+	MintBurnPermissions []MintBurnPermissions `protobuf:"bytes,8,rep,name=mint_burn_permissions,json=mintBurnPermissions,proto3" json:"mint_burn_permissions"`
+	// dust_sweep_opt_ins defines the addresses of the accounts which opted in
+	// the sweeping of their dust balances.
+	//
+	// Since: cosmos-sdk 0.48
+	DustSweepOptIns []string `protobuf:"bytes,9,rep,name=dust_sweep_opt_ins,json=dustSweepOptIns,proto3" json:"dust_sweep_opt_ins,omitempty"`
+	// timelocked_transfers defines the time-locked transfers not claimed nor
+	// reclaimed yet.
+	//
+	// Since: cosmos-sdk 0.48
+	TimelockedTransfers []TimelockedTransfer `protobuf:"bytes,10,rep,name=timelocked_transfers,json=timelockedTransfers,proto3" json:"timelocked_transfers"`
+	// spending_limits defines the spending limits of the accounts.
+	//
+	// Since: cosmos-sdk 0.48
+	SpendingLimits []SpendingLimit `protobuf:"bytes,11,rep,name=spending_limits,json=spendingLimits,proto3" json:"spending_limits"`
+	// demurrage_schedules defines the demurrage schedules of the denominations.
+	//
+	// Since: cosmos-sdk 0.48
+	DemurrageSchedules []DemurrageSchedule `protobuf:"bytes,12,rep,name=demurrage_schedules,json=demurrageSchedules,proto3" json:"demurrage_schedules"`
+	// demurrage_account_epochs defines the epochs the decay of the balances of
+	// the demurrage denominations was last settled at.
+	//
+	// Since: cosmos-sdk 0.48
+	DemurrageAccountEpochs []DemurrageAccountEpoch `protobuf:"bytes,13,rep,name=demurrage_account_epochs,json=demurrageAccountEpochs,proto3" json:"demurrage_account_epochs"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -112,6 +149,188 @@ func (m *GenesisState) GetSendEnabled() []SendEnabled {
 	return nil
 }
 
+func (m *GenesisState) GetHolds() []Hold {
+	if m != nil {
+		return m.Holds
+	}
+	return nil
+}
+
+func (m *GenesisState) GetBalanceSnapshots() []GenesisBalanceSnapshot {
+	if m != nil {
+		return m.BalanceSnapshots
+	}
+	return nil
+}
+
+func (m *GenesisState) GetMintBurnPermissions() []MintBurnPermissions {
+	if m != nil {
+		return m.MintBurnPermissions
+	}
+	return nil
+}
+
+func (m *GenesisState) GetDustSweepOptIns() []string {
+	if m != nil {
+		return m.DustSweepOptIns
+	}
+	return nil
+}
+
+func (m *GenesisState) GetTimelockedTransfers() []TimelockedTransfer {
+	if m != nil {
+		return m.TimelockedTransfers
+	}
+	return nil
+}
+
+func (m *GenesisState) GetSpendingLimits() []SpendingLimit {
+	if m != nil {
+		return m.SpendingLimits
+	}
+	return nil
+}
+
+func (m *GenesisState) GetDemurrageSchedules() []DemurrageSchedule {
+	if m != nil {
+		return m.DemurrageSchedules
+	}
+	return nil
+}
+
+func (m *GenesisState) GetDemurrageAccountEpochs() []DemurrageAccountEpoch {
+	if m != nil {
+		return m.DemurrageAccountEpochs
+	}
+	return nil
+}
+
+// GenesisBalanceSnapshot defines a balance snapshot, along with its recorded
+// balances, in the bank module's genesis state.
+//
+// Since: cosmos-sdk 0.48
+type GenesisBalanceSnapshot struct {
+	// snapshot is the balance snapshot.
+	Snapshot BalanceSnapshot `protobuf:"bytes,1,opt,name=snapshot,proto3" json:"snapshot"`
+	// balances are the balances recorded in the snapshot, in address order.
+	Balances []SnapshotBalance `protobuf:"bytes,2,rep,name=balances,proto3" json:"balances"`
+}
+
+func (m *GenesisBalanceSnapshot) Reset()         { *m = GenesisBalanceSnapshot{} }
+func (m *GenesisBalanceSnapshot) String() string { return proto.CompactTextString(m) }
+func (*GenesisBalanceSnapshot) ProtoMessage()    {}
+func (*GenesisBalanceSnapshot) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8f007de11b420c6e, []int{1}
+}
+func (m *GenesisBalanceSnapshot) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *GenesisBalanceSnapshot) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_GenesisBalanceSnapshot.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *GenesisBalanceSnapshot) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GenesisBalanceSnapshot.Merge(m, src)
+}
+func (m *GenesisBalanceSnapshot) XXX_Size() int {
+	return m.Size()
+}
+func (m *GenesisBalanceSnapshot) XXX_DiscardUnknown() {
+	xxx_messageInfo_GenesisBalanceSnapshot.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GenesisBalanceSnapshot proto.InternalMessageInfo
+
+func (m *GenesisBalanceSnapshot) GetSnapshot() BalanceSnapshot {
+	if m != nil {
+		return m.Snapshot
+	}
+	return BalanceSnapshot{}
+}
+
+func (m *GenesisBalanceSnapshot) GetBalances() []SnapshotBalance {
+	if m != nil {
+		return m.Balances
+	}
+	return nil
+}
+
+// DemurrageAccountEpoch defines the epoch of the demurrage schedule of a
+// denomination the decay of the balance of an account was last settled at, in
+// the bank module's genesis state.
+//
+// Since: cosmos-sdk 0.48
+type DemurrageAccountEpoch struct {
+	// address is the address of the account.
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	// denom is the denomination of the demurrage schedule.
+	Denom string `protobuf:"bytes,2,opt,name=denom,proto3" json:"denom,omitempty"`
+	// epoch is the epoch the decay of the balance was last settled at.
+	Epoch uint64 `protobuf:"varint,3,opt,name=epoch,proto3" json:"epoch,omitempty"`
+}
+
+func (m *DemurrageAccountEpoch) Reset()         { *m = DemurrageAccountEpoch{} }
+func (m *DemurrageAccountEpoch) String() string { return proto.CompactTextString(m) }
+func (*DemurrageAccountEpoch) ProtoMessage()    {}
+func (*DemurrageAccountEpoch) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8f007de11b420c6e, []int{2}
+}
+func (m *DemurrageAccountEpoch) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DemurrageAccountEpoch) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DemurrageAccountEpoch.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *DemurrageAccountEpoch) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DemurrageAccountEpoch.Merge(m, src)
+}
+func (m *DemurrageAccountEpoch) XXX_Size() int {
+	return m.Size()
+}
+func (m *DemurrageAccountEpoch) XXX_DiscardUnknown() {
+	xxx_messageInfo_DemurrageAccountEpoch.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DemurrageAccountEpoch proto.InternalMessageInfo
+
+func (m *DemurrageAccountEpoch) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+func (m *DemurrageAccountEpoch) GetDenom() string {
+	if m != nil {
+		return m.Denom
+	}
+	return ""
+}
+
+func (m *DemurrageAccountEpoch) GetEpoch() uint64 {
+	if m != nil {
+		return m.Epoch
+	}
+	return 0
+}
+
 // Balance defines an account address and balance pair used in the bank module's
 // genesis state.
 type Balance struct {
@@ -125,7 +344,7 @@ func (m *Balance) Reset()         { *m = Balance{} }
 func (m *Balance) String() string { return proto.CompactTextString(m) }
 func (*Balance) ProtoMessage()    {}
 func (*Balance) Descriptor() ([]byte, []int) {
-	return fileDescriptor_8f007de11b420c6e, []int{1}
+	return fileDescriptor_8f007de11b420c6e, []int{3}
 }
 func (m *Balance) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -156,42 +375,65 @@ var xxx_messageInfo_Balance proto.InternalMessageInfo
 
 func init() {
 	proto.RegisterType((*GenesisState)(nil), "cosmos.bank.v1beta1.GenesisState")
+	proto.RegisterType((*GenesisBalanceSnapshot)(nil), "cosmos.bank.v1beta1.GenesisBalanceSnapshot")
+	proto.RegisterType((*DemurrageAccountEpoch)(nil), "cosmos.bank.v1beta1.DemurrageAccountEpoch")
 	proto.RegisterType((*Balance)(nil), "cosmos.bank.v1beta1.Balance")
 }
 
 func init() { proto.RegisterFile("cosmos/bank/v1beta1/genesis.proto", fileDescriptor_8f007de11b420c6e) }
 
 var fileDescriptor_8f007de11b420c6e = []byte{
-	// 461 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x92, 0xbf, 0x6f, 0xd3, 0x40,
-	0x14, 0xc7, 0x6d, 0x42, 0xd3, 0xf6, 0x12, 0x90, 0x38, 0x3a, 0xb8, 0x05, 0x9c, 0xd0, 0x29, 0x54,
-	0xaa, 0xad, 0x86, 0x8d, 0x01, 0x09, 0x47, 0xc0, 0xc4, 0x0f, 0x35, 0x1b, 0x4b, 0x74, 0xf6, 0x3d,
-	0x19, 0xab, 0xf1, 0x9d, 0x95, 0x77, 0x05, 0xf2, 0x1f, 0x30, 0x32, 0x33, 0x75, 0x44, 0x2c, 0x74,
-	0xe0, 0x0f, 0x60, 0xec, 0x58, 0x31, 0x31, 0x01, 0x4a, 0x86, 0xf2, 0x67, 0x20, 0xdf, 0x5d, 0x5d,
-	0x4b, 0x58, 0x8c, 0x5d, 0x12, 0xeb, 0xbe, 0xdf, 0xf7, 0xf9, 0xbe, 0xf7, 0xee, 0xc8, 0xdd, 0x44,
-	0x62, 0x2e, 0x31, 0x8c, 0x99, 0x38, 0x08, 0xdf, 0xec, 0xc5, 0xa0, 0xd8, 0x5e, 0x98, 0x82, 0x00,
-	0xcc, 0x30, 0x28, 0x66, 0x52, 0x49, 0x7a, 0xd3, 0x58, 0x82, 0xd2, 0x12, 0x58, 0xcb, 0xd6, 0x46,
-	0x2a, 0x53, 0xa9, 0xf5, 0xb0, 0xfc, 0x32, 0xd6, 0x2d, 0xbf, 0xa2, 0x21, 0x54, 0xb4, 0x44, 0x66,
-	0xe2, 0x1f, 0xbd, 0x96, 0xa6, 0xb9, 0x46, 0xdf, 0x34, 0xfa, 0xc4, 0x80, 0x6d, 0xae, 0x91, 0x6e,
-	0xb0, 0x3c, 0x13, 0x32, 0xd4, 0xbf, 0xe6, 0x68, 0xfb, 0x4b, 0x8b, 0x74, 0x9f, 0x9a, 0x56, 0xc7,
-	0x8a, 0x29, 0xa0, 0x0f, 0x49, 0xbb, 0x60, 0x33, 0x96, 0xa3, 0xe7, 0xf6, 0xdd, 0x41, 0x67, 0x78,
-	0x2b, 0x68, 0x68, 0x3d, 0x78, 0xa9, 0x2d, 0xd1, 0xfa, 0xc9, 0xcf, 0x9e, 0xf3, 0xe9, 0xec, 0x78,
-	0xc7, 0xdd, 0xb7, 0x55, 0x74, 0x44, 0xd6, 0x62, 0x36, 0x65, 0x22, 0x01, 0xf4, 0xae, 0xf4, 0x5b,
-	0x83, 0xce, 0xf0, 0x76, 0x23, 0x21, 0x32, 0xa6, 0x3a, 0xa2, 0x2a, 0xa4, 0x73, 0xd2, 0xc6, 0xc3,
-	0xa2, 0x98, 0xce, 0xbd, 0x96, 0x46, 0x6c, 0x5e, 0x20, 0x10, 0x2a, 0xc4, 0x48, 0x66, 0x22, 0x7a,
-	0x52, 0xd6, 0x7f, 0xfe, 0xd5, 0x1b, 0xa4, 0x99, 0x7a, 0x7d, 0x18, 0x07, 0x89, 0xcc, 0xed, 0xd0,
-	0xf6, 0x6f, 0x17, 0xf9, 0x41, 0xa8, 0xe6, 0x05, 0xa0, 0x2e, 0xc0, 0x8f, 0x67, 0xc7, 0x3b, 0xdd,
-	0x29, 0xa4, 0x2c, 0x99, 0x4f, 0xca, 0xb5, 0xa2, 0xed, 0xdf, 0x04, 0xd2, 0x17, 0xe4, 0x3a, 0x07,
-	0x21, 0xf3, 0x49, 0x0e, 0x8a, 0x71, 0xa6, 0x98, 0x77, 0x55, 0xb7, 0x70, 0xa7, 0x71, 0x8a, 0x67,
-	0xd6, 0x54, 0x1f, 0xe3, 0x9a, 0xae, 0x3f, 0x57, 0xe8, 0x73, 0xd2, 0x45, 0x10, 0x7c, 0x02, 0x82,
-	0xc5, 0x53, 0xe0, 0xde, 0x8a, 0xc6, 0xf5, 0x1b, 0x71, 0x63, 0x10, 0xfc, 0xb1, 0xf1, 0xd5, 0x89,
-	0x1d, 0xbc, 0x38, 0xdf, 0xfe, 0xe6, 0x92, 0x55, 0xbb, 0x3c, 0x3a, 0x24, 0xab, 0x8c, 0xf3, 0x19,
-	0xa0, 0xb9, 0xad, 0xf5, 0xc8, 0xfb, 0xfe, 0x75, 0x77, 0xc3, 0x92, 0x1f, 0x19, 0x65, 0xac, 0x66,
-	0x99, 0x48, 0xf7, 0xcf, 0x8d, 0xf4, 0x2d, 0x59, 0xd1, 0x63, 0xdb, 0xdb, 0xb9, 0x84, 0xd5, 0x9a,
-	0xbc, 0x07, 0x6b, 0xef, 0x8f, 0x7a, 0xce, 0x9f, 0xa3, 0x9e, 0x13, 0x8d, 0x4e, 0x16, 0xbe, 0x7b,
-	0xba, 0xf0, 0xdd, 0xdf, 0x0b, 0xdf, 0xfd, 0xb0, 0xf4, 0x9d, 0xd3, 0xa5, 0xef, 0xfc, 0x58, 0xfa,
-	0xce, 0xab, 0x7b, 0xff, 0x8d, 0x7a, 0x67, 0x1e, 0xbd, 0x4e, 0x8c, 0xdb, 0xfa, 0x01, 0xdf, 0xff,
-	0x1b, 0x00, 0x00, 0xff, 0xff, 0x78, 0x65, 0xc7, 0x6b, 0x7e, 0x03, 0x00, 0x00,
+	// 795 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x95, 0x4f, 0x6f, 0x1b, 0x45,
+	0x18, 0xc6, 0xbd, 0x71, 0xed, 0xd8, 0x63, 0xb7, 0xa5, 0x13, 0xb7, 0x9a, 0x16, 0xb0, 0x8d, 0x85,
+	0xc0, 0x04, 0xd5, 0x56, 0xc3, 0xad, 0x07, 0xa4, 0x3a, 0x84, 0x3f, 0xe2, 0x4f, 0x2b, 0xbb, 0xe2,
+	0xc0, 0x65, 0x35, 0xbb, 0x33, 0xac, 0x57, 0xd9, 0x9d, 0x59, 0xed, 0x3b, 0x4b, 0xf0, 0x37, 0xe0,
+	0xc8, 0x81, 0x13, 0xa7, 0x1e, 0x11, 0xa7, 0x0a, 0xf1, 0x01, 0x38, 0xe6, 0x18, 0x71, 0xe2, 0x04,
+	0x28, 0x39, 0x84, 0x8f, 0x81, 0x76, 0x66, 0x6c, 0x6f, 0x9c, 0x95, 0x0f, 0x48, 0x5c, 0x92, 0xec,
+	0xbe, 0xcf, 0xf3, 0x7b, 0xde, 0x99, 0xbc, 0x7a, 0x17, 0xbd, 0xe1, 0x4b, 0x88, 0x25, 0x8c, 0x3d,
+	0x2a, 0x8e, 0xc7, 0xdf, 0x3c, 0xf2, 0xb8, 0xa2, 0x8f, 0xc6, 0x01, 0x17, 0x1c, 0x42, 0x18, 0x25,
+	0xa9, 0x54, 0x12, 0xef, 0x19, 0xc9, 0x28, 0x97, 0x8c, 0xac, 0xe4, 0x41, 0x27, 0x90, 0x81, 0xd4,
+	0xf5, 0x71, 0xfe, 0x97, 0x91, 0x3e, 0xe8, 0xae, 0x68, 0xc0, 0x57, 0x34, 0x5f, 0x86, 0xe2, 0x5a,
+	0xbd, 0x90, 0xa6, 0xb9, 0xa6, 0x7e, 0xdf, 0xd4, 0x5d, 0x03, 0xb6, 0xb9, 0xa6, 0x74, 0x87, 0xc6,
+	0xa1, 0x90, 0x63, 0xfd, 0xd3, 0xbc, 0x1a, 0xfc, 0xd0, 0x44, 0xed, 0x8f, 0x4c, 0xab, 0x33, 0x45,
+	0x15, 0xc7, 0xef, 0xa3, 0x7a, 0x42, 0x53, 0x1a, 0x03, 0x71, 0xfa, 0xce, 0xb0, 0x75, 0xf0, 0xea,
+	0xa8, 0xa4, 0xf5, 0xd1, 0x33, 0x2d, 0x99, 0x34, 0x4f, 0xff, 0xec, 0x55, 0x7e, 0xba, 0x7c, 0xb9,
+	0xef, 0x4c, 0xad, 0x0b, 0x1f, 0xa2, 0x86, 0x47, 0x23, 0x2a, 0x7c, 0x0e, 0x64, 0xa7, 0x5f, 0x1d,
+	0xb6, 0x0e, 0x5e, 0x2b, 0x25, 0x4c, 0x8c, 0xa8, 0x88, 0x58, 0x19, 0xf1, 0x02, 0xd5, 0x21, 0x4b,
+	0x92, 0x68, 0x41, 0xaa, 0x1a, 0x71, 0x7f, 0x8d, 0x00, 0xbe, 0x42, 0x1c, 0xca, 0x50, 0x4c, 0x3e,
+	0xcc, 0xfd, 0x3f, 0xff, 0xd5, 0x1b, 0x06, 0xa1, 0x9a, 0x67, 0xde, 0xc8, 0x97, 0xb1, 0x3d, 0xb4,
+	0xfd, 0xf5, 0x10, 0xd8, 0xf1, 0x58, 0x2d, 0x12, 0x0e, 0xda, 0x00, 0x3f, 0x5e, 0xbe, 0xdc, 0x6f,
+	0x47, 0x3c, 0xa0, 0xfe, 0xc2, 0xcd, 0xaf, 0x15, 0x6c, 0xff, 0x26, 0x10, 0x3f, 0x45, 0xb7, 0x18,
+	0x17, 0x32, 0x76, 0x63, 0xae, 0x28, 0xa3, 0x8a, 0x92, 0x1b, 0xba, 0x85, 0xd7, 0x4b, 0x4f, 0xf1,
+	0xb9, 0x15, 0x15, 0x8f, 0x71, 0x53, 0xfb, 0x97, 0x15, 0xfc, 0x05, 0x6a, 0x03, 0x17, 0xcc, 0xe5,
+	0x82, 0x7a, 0x11, 0x67, 0xa4, 0xa6, 0x71, 0xfd, 0x52, 0xdc, 0x8c, 0x0b, 0x76, 0x64, 0x74, 0x45,
+	0x62, 0x0b, 0xd6, 0xef, 0xf1, 0x63, 0x54, 0x9b, 0xcb, 0x88, 0x01, 0xa9, 0x6f, 0x5e, 0x4d, 0x01,
+	0xf4, 0xb1, 0x8c, 0xae, 0x10, 0x8c, 0x05, 0xfb, 0xe8, 0x8e, 0xbd, 0x63, 0x17, 0x04, 0x4d, 0x60,
+	0x2e, 0x15, 0x90, 0x5d, 0xcd, 0x79, 0xb7, 0x94, 0x63, 0x47, 0xc3, 0xfe, 0xb3, 0x66, 0xd6, 0x53,
+	0x24, 0xbf, 0xe2, 0x5d, 0xad, 0x01, 0x0e, 0xd0, 0xdd, 0x38, 0x14, 0xca, 0xf5, 0xb2, 0x54, 0xb8,
+	0x09, 0x4f, 0xe3, 0x10, 0x20, 0x94, 0x02, 0x48, 0x43, 0x07, 0x0d, 0xcb, 0x2f, 0x32, 0x14, 0x6a,
+	0x92, 0xa5, 0xe2, 0xd9, 0x5a, 0x5f, 0x4c, 0xd9, 0x8b, 0xaf, 0xd7, 0xf1, 0x11, 0xc2, 0x2c, 0x03,
+	0xe5, 0xc2, 0x09, 0xe7, 0x89, 0x2b, 0x13, 0xe5, 0x86, 0x02, 0x48, 0xb3, 0x5f, 0x1d, 0x36, 0x27,
+	0xe4, 0xf7, 0x5f, 0x1f, 0x76, 0x6c, 0xd0, 0x13, 0xc6, 0x52, 0x0e, 0x30, 0x53, 0x69, 0x28, 0x82,
+	0xe9, 0xed, 0xdc, 0x33, 0xcb, 0x2d, 0x4f, 0x13, 0xf5, 0x89, 0x00, 0xcc, 0x51, 0x47, 0x85, 0x31,
+	0x8f, 0xa4, 0x7f, 0xcc, 0x99, 0xab, 0x52, 0x2a, 0xe0, 0x6b, 0x9e, 0x02, 0x41, 0xba, 0xdd, 0xb7,
+	0x4b, 0xdb, 0x7d, 0xbe, 0x32, 0x3c, 0xb7, 0xfa, 0x2b, 0xdd, 0xaa, 0x6b, 0x65, 0xc0, 0x5f, 0xa2,
+	0xdb, 0x90, 0x70, 0xc1, 0x42, 0x11, 0xb8, 0x51, 0x18, 0x87, 0x0a, 0x48, 0x4b, 0x27, 0x0c, 0xca,
+	0x47, 0xc1, 0x6a, 0x3f, 0xcb, 0xa5, 0x45, 0xf8, 0x2d, 0x28, 0x56, 0x00, 0x7b, 0x68, 0x8f, 0xf1,
+	0x38, 0x4b, 0x53, 0x1a, 0x70, 0x17, 0xfc, 0x39, 0x67, 0x59, 0xc4, 0x81, 0xb4, 0x35, 0xfb, 0xad,
+	0x52, 0xf6, 0x07, 0x4b, 0xfd, 0xcc, 0xca, 0x8b, 0x7c, 0xcc, 0x36, 0xab, 0x80, 0x25, 0x22, 0xeb,
+	0x0c, 0xea, 0xfb, 0x32, 0x13, 0xca, 0xe5, 0x89, 0xf4, 0xe7, 0x40, 0x6e, 0xea, 0xa0, 0xfd, 0xed,
+	0x41, 0x4f, 0x8c, 0xe7, 0x28, 0xb7, 0x14, 0xc3, 0xee, 0xb1, 0x32, 0x05, 0x0c, 0x7e, 0x71, 0xd0,
+	0xbd, 0xf2, 0xd9, 0xc3, 0x9f, 0xa2, 0xc6, 0x72, 0x76, 0xed, 0x8a, 0x7a, 0x73, 0xdb, 0x82, 0x29,
+	0x9b, 0xd9, 0x06, 0x14, 0x60, 0x1b, 0xdb, 0xaa, 0x1c, 0xb6, 0xa2, 0x6c, 0xd9, 0x5a, 0x83, 0x13,
+	0x74, 0xb7, 0xf4, 0xc0, 0xf8, 0x00, 0xed, 0x52, 0x33, 0x83, 0xba, 0xe3, 0x6d, 0xd3, 0xb9, 0x14,
+	0xe2, 0x0e, 0xaa, 0xe9, 0x3d, 0x42, 0x76, 0x72, 0xc7, 0xd4, 0x3c, 0xe4, 0x6f, 0xf5, 0xb5, 0x93,
+	0x6a, 0xdf, 0x19, 0xde, 0x98, 0x9a, 0x87, 0xc1, 0x6f, 0x0e, 0xda, 0xb5, 0x9d, 0xfd, 0xa7, 0xac,
+	0x13, 0x54, 0xd3, 0x9b, 0x90, 0xec, 0x6c, 0xae, 0x94, 0xff, 0x6b, 0xdb, 0x9a, 0xbc, 0xc7, 0x8d,
+	0xef, 0x5e, 0xf4, 0x2a, 0xff, 0xbc, 0xe8, 0x55, 0x26, 0x87, 0xa7, 0xe7, 0x5d, 0xe7, 0xec, 0xbc,
+	0xeb, 0xfc, 0x7d, 0xde, 0x75, 0xbe, 0xbf, 0xe8, 0x56, 0xce, 0x2e, 0xba, 0x95, 0x3f, 0x2e, 0xba,
+	0x95, 0xaf, 0xde, 0xd9, 0x1a, 0xf5, 0xad, 0xf9, 0x0e, 0xea, 0x44, 0xaf, 0xae, 0xbf, 0x69, 0xef,
+	0xfd, 0x3b, 0x00, 0xd0, 0xfc, 0xac, 0x2b, 0x91, 0x07, 0x00, 0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -214,6 +456,113 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.DemurrageAccountEpochs) > 0 {
+		for iNdEx := len(m.DemurrageAccountEpochs) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.DemurrageAccountEpochs[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x6a
+		}
+	}
+	if len(m.DemurrageSchedules) > 0 {
+		for iNdEx := len(m.DemurrageSchedules) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.DemurrageSchedules[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x62
+		}
+	}
+	if len(m.SpendingLimits) > 0 {
+		for iNdEx := len(m.SpendingLimits) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.SpendingLimits[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x5a
+		}
+	}
+	if len(m.TimelockedTransfers) > 0 {
+		for iNdEx := len(m.TimelockedTransfers) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.TimelockedTransfers[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x52
+		}
+	}
+	if len(m.DustSweepOptIns) > 0 {
+		for iNdEx := len(m.DustSweepOptIns) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.DustSweepOptIns[iNdEx])
+			copy(dAtA[i:], m.DustSweepOptIns[iNdEx])
+			i = encodeVarintGenesis(dAtA, i, uint64(len(m.DustSweepOptIns[iNdEx])))
+			i--
+			dAtA[i] = 0x4a
+		}
+	}
+	if len(m.MintBurnPermissions) > 0 {
+		for iNdEx := len(m.MintBurnPermissions) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.MintBurnPermissions[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x42
+		}
+	}
+	if len(m.BalanceSnapshots) > 0 {
+		for iNdEx := len(m.BalanceSnapshots) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.BalanceSnapshots[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x3a
+		}
+	}
+	if len(m.Holds) > 0 {
+		for iNdEx := len(m.Holds) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Holds[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x32
+		}
+	}
 	if len(m.SendEnabled) > 0 {
 		for iNdEx := len(m.SendEnabled) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -283,7 +632,7 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func (m *Balance) Marshal() (dAtA []byte, err error) {
+func (m *GenesisBalanceSnapshot) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -293,20 +642,20 @@ func (m *Balance) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *Balance) MarshalTo(dAtA []byte) (int, error) {
+func (m *GenesisBalanceSnapshot) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *Balance) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *GenesisBalanceSnapshot) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.Coins) > 0 {
-		for iNdEx := len(m.Coins) - 1; iNdEx >= 0; iNdEx-- {
+	if len(m.Balances) > 0 {
+		for iNdEx := len(m.Balances) - 1; iNdEx >= 0; iNdEx-- {
 			{
-				size, err := m.Coins[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				size, err := m.Balances[iNdEx].MarshalToSizedBuffer(dAtA[:i])
 				if err != nil {
 					return 0, err
 				}
@@ -317,6 +666,51 @@ func (m *Balance) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 			dAtA[i] = 0x12
 		}
 	}
+	{
+		size, err := m.Snapshot.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *DemurrageAccountEpoch) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DemurrageAccountEpoch) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DemurrageAccountEpoch) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Epoch != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.Epoch))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0x12
+	}
 	if len(m.Address) > 0 {
 		i -= len(m.Address)
 		copy(dAtA[i:], m.Address)
@@ -327,27 +721,71 @@ func (m *Balance) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintGenesis(dAtA []byte, offset int, v uint64) int {
-	offset -= sovGenesis(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *Balance) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *GenesisState) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = m.Params.Size()
-	n += 1 + l + sovGenesis(uint64(l))
-	if len(m.Balances) > 0 {
-		for _, e := range m.Balances {
+
+func (m *Balance) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Balance) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Coins) > 0 {
+		for iNdEx := len(m.Coins) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Coins[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintGenesis(dAtA []byte, offset int, v uint64) int {
+	offset -= sovGenesis(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *GenesisState) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Params.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	if len(m.Balances) > 0 {
+		for _, e := range m.Balances {
 			l = e.Size()
 			n += 1 + l + sovGenesis(uint64(l))
 		}
@@ -370,6 +808,91 @@ func (m *GenesisState) Size() (n int) {
 			n += 1 + l + sovGenesis(uint64(l))
 		}
 	}
+	if len(m.Holds) > 0 {
+		for _, e := range m.Holds {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.BalanceSnapshots) > 0 {
+		for _, e := range m.BalanceSnapshots {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.MintBurnPermissions) > 0 {
+		for _, e := range m.MintBurnPermissions {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.DustSweepOptIns) > 0 {
+		for _, s := range m.DustSweepOptIns {
+			l = len(s)
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.TimelockedTransfers) > 0 {
+		for _, e := range m.TimelockedTransfers {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.SpendingLimits) > 0 {
+		for _, e := range m.SpendingLimits {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.DemurrageSchedules) > 0 {
+		for _, e := range m.DemurrageSchedules {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.DemurrageAccountEpochs) > 0 {
+		for _, e := range m.DemurrageAccountEpochs {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *GenesisBalanceSnapshot) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Snapshot.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	if len(m.Balances) > 0 {
+		for _, e := range m.Balances {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *DemurrageAccountEpoch) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	if m.Epoch != 0 {
+		n += 1 + sovGenesis(uint64(m.Epoch))
+	}
 	return n
 }
 
@@ -596,6 +1119,526 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Holds", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Holds = append(m.Holds, Hold{})
+			if err := m.Holds[len(m.Holds)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BalanceSnapshots", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BalanceSnapshots = append(m.BalanceSnapshots, GenesisBalanceSnapshot{})
+			if err := m.BalanceSnapshots[len(m.BalanceSnapshots)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MintBurnPermissions", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MintBurnPermissions = append(m.MintBurnPermissions, MintBurnPermissions{})
+			if err := m.MintBurnPermissions[len(m.MintBurnPermissions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DustSweepOptIns", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DustSweepOptIns = append(m.DustSweepOptIns, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TimelockedTransfers", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TimelockedTransfers = append(m.TimelockedTransfers, TimelockedTransfer{})
+			if err := m.TimelockedTransfers[len(m.TimelockedTransfers)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SpendingLimits", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SpendingLimits = append(m.SpendingLimits, SpendingLimit{})
+			if err := m.SpendingLimits[len(m.SpendingLimits)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DemurrageSchedules", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DemurrageSchedules = append(m.DemurrageSchedules, DemurrageSchedule{})
+			if err := m.DemurrageSchedules[len(m.DemurrageSchedules)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 13:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DemurrageAccountEpochs", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DemurrageAccountEpochs = append(m.DemurrageAccountEpochs, DemurrageAccountEpoch{})
+			if err := m.DemurrageAccountEpochs[len(m.DemurrageAccountEpochs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GenesisBalanceSnapshot) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GenesisBalanceSnapshot: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GenesisBalanceSnapshot: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Snapshot", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Snapshot.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Balances", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Balances = append(m.Balances, SnapshotBalance{})
+			if err := m.Balances[len(m.Balances)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DemurrageAccountEpoch) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DemurrageAccountEpoch: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DemurrageAccountEpoch: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Epoch", wireType)
+			}
+			m.Epoch = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Epoch |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
@@ -142,6 +142,25 @@ func TestGenesisStateValidate(t *testing.T) {
 			},
 			true,
 		},
+		{
+			"dup mint/burn permissions",
+			GenesisState{
+				MintBurnPermissions: []MintBurnPermissions{
+					NewMintBurnPermissions("uatom", []string{"mint"}, nil),
+					NewMintBurnPermissions("uatom", nil, []string{"mint"}),
+				},
+			},
+			true,
+		},
+		{
+			"invalid mint/burn permissions",
+			GenesisState{
+				MintBurnPermissions: []MintBurnPermissions{
+					NewMintBurnPermissions("uatom", []string{""}, nil),
+				},
+			},
+			true,
+		},
 	}
 
 	for _, tc := range testCases {
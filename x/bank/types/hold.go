@@ -0,0 +1,45 @@
+package types
+
+import (
+	"fmt"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// NewHold returns a new hold of the holder on amount coins of the balance of
+// the account, released automatically at expiration unless it is nil.
+func NewHold(addr sdk.AccAddress, holder string, amount sdk.Coins, expiration *time.Time) Hold {
+	return Hold{
+		Address:    addr.String(),
+		Holder:     holder,
+		Amount:     amount,
+		Expiration: expiration,
+	}
+}
+
+// IsActive returns true if the hold is not expired at the given time.
+func (h Hold) IsActive(blockTime time.Time) bool {
+	return h.Expiration == nil || blockTime.Before(*h.Expiration)
+}
+
+// Validate checks for address, holder and amount correctness.
+func (h Hold) Validate() error {
+	if _, err := sdk.AccAddressFromBech32(h.Address); err != nil {
+		return err
+	}
+
+	if h.Holder == "" {
+		return fmt.Errorf("hold on %s has no holder", h.Address)
+	}
+
+	if err := h.Amount.Validate(); err != nil {
+		return err
+	}
+
+	if h.Amount.IsZero() {
+		return fmt.Errorf("hold of %s on %s has no amount", h.Holder, h.Address)
+	}
+
+	return nil
+}
@@ -0,0 +1,49 @@
+package types
+
+import (
+	"context"
+
+	"cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// BankHooks defines the hooks called by the bank keeper, e.g. to maintain
+// state derived from the balances in-process, such as leaderboards, tiers or
+// tax accounting, instead of re-deriving it off-chain from the events.
+type BankHooks interface {
+	// AfterBalanceChange is called after the balance of an account in a denom
+	// changed from oldBalance to newBalance, a zero balance meaning that the
+	// account holds no coins of the denom. It is called within the operation
+	// changing the balance, e.g. between the debit and the credit of a
+	// transfer, and an error aborts the operation.
+	AfterBalanceChange(ctx context.Context, addr sdk.AccAddress, denom string, oldBalance, newBalance math.Int) error
+}
+
+// BankHooksWrapper is a wrapper for modules to inject BankHooks using depinject.
+type BankHooksWrapper struct{ BankHooks }
+
+// IsOnePerModuleType implements the depinject.OnePerModuleType interface.
+func (BankHooksWrapper) IsOnePerModuleType() {}
+
+var _ BankHooks = MultiBankHooks{}
+
+// MultiBankHooks combines multiple bank hooks, all hook functions are run in
+// array sequence.
+type MultiBankHooks []BankHooks
+
+// NewMultiBankHooks returns the combination of the given bank hooks.
+func NewMultiBankHooks(hooks ...BankHooks) MultiBankHooks {
+	return hooks
+}
+
+// AfterBalanceChange implements the BankHooks interface.
+func (h MultiBankHooks) AfterBalanceChange(ctx context.Context, addr sdk.AccAddress, denom string, oldBalance, newBalance math.Int) error {
+	for i := range h {
+		if err := h[i].AfterBalanceChange(ctx, addr, denom, oldBalance, newBalance); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
@@ -81,3 +81,36 @@ func NewOutput(addr sdk.AccAddress, coins sdk.Coins) Output {
 		Coins:   coins,
 	}
 }
+
+// MaxBatchOutputMemoLength is the maximum length of the memo of a batch output.
+const MaxBatchOutputMemoLength = 256
+
+// ValidateBasic - validate batch send output
+func (out BatchOutput) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(out.Address); err != nil {
+		return sdkerrors.ErrInvalidAddress.Wrapf("invalid output address: %s", err)
+	}
+
+	if !out.Coins.IsValid() {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidCoins, out.Coins.String())
+	}
+
+	if !out.Coins.IsAllPositive() {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidCoins, out.Coins.String())
+	}
+
+	if len(out.Memo) > MaxBatchOutputMemoLength {
+		return sdkerrors.ErrMemoTooLarge.Wrapf("memo of output to %s is %d characters, maximum is %d", out.Address, len(out.Memo), MaxBatchOutputMemoLength)
+	}
+
+	return nil
+}
+
+// NewBatchOutput - create a batch send output, used with MsgBatchSend
+func NewBatchOutput(addr sdk.AccAddress, coins sdk.Coins, memo string) BatchOutput {
+	return BatchOutput{
+		Address: addr.String(),
+		Coins:   coins,
+		Memo:    memo,
+	}
+}
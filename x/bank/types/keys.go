@@ -31,6 +31,60 @@ var (
 
 	// ParamsKey is the prefix for x/bank parameters
 	ParamsKey = collections.NewPrefix(5)
+
+	// HoldsPrefix is the prefix for the holds on the account balances.
+	HoldsPrefix = collections.NewPrefix(6)
+
+	// BalanceSnapshotsPrefix is the prefix for the balance snapshots.
+	BalanceSnapshotsPrefix = collections.NewPrefix(7)
+	// SnapshotBalancesPrefix is the prefix for the balances recorded in the
+	// balance snapshots.
+	SnapshotBalancesPrefix = collections.NewPrefix(8)
+	// PendingBalanceSnapshotsPrefix is the prefix for the balance snapshots
+	// not taken yet, indexed by height.
+	PendingBalanceSnapshotsPrefix = collections.NewPrefix(9)
+	// BalanceSnapshotSequenceKey is the key of the sequence of the balance
+	// snapshot identifiers.
+	BalanceSnapshotSequenceKey = collections.NewPrefix(10)
+
+	// MintBurnPermissionsPrefix is the prefix for the mint/burn permissions of
+	// the denoms.
+	MintBurnPermissionsPrefix = collections.NewPrefix(11)
+
+	// DustSweepOptInsPrefix is the prefix for the accounts which opted in the
+	// sweeping of their dust balances.
+	DustSweepOptInsPrefix = collections.NewPrefix(12)
+	// DustSweepCursorKey is the key of the address of the account the next
+	// dust sweep resumes at.
+	DustSweepCursorKey = collections.NewPrefix(13)
+
+	// ModuleEscrowsPrefix is the prefix for the escrows of the module
+	// accounts, by module name and purpose.
+	ModuleEscrowsPrefix = collections.NewPrefix(14)
+
+	// TimelockedTransfersPrefix is the prefix for the time-locked transfers.
+	TimelockedTransfersPrefix = collections.NewPrefix(15)
+	// TimelockedTransfersBySenderPrefix is the prefix for the index of the
+	// time-locked transfers by sender.
+	TimelockedTransfersBySenderPrefix = collections.NewPrefix(16)
+	// TimelockedTransfersByRecipientPrefix is the prefix for the index of the
+	// time-locked transfers by recipient.
+	TimelockedTransfersByRecipientPrefix = collections.NewPrefix(17)
+	// TimelockedTransferSequenceKey is the key of the sequence of the
+	// time-locked transfer identifiers.
+	TimelockedTransferSequenceKey = collections.NewPrefix(18)
+
+	// SpendingLimitsPrefix is the prefix for the spending limits of the
+	// accounts.
+	SpendingLimitsPrefix = collections.NewPrefix(19)
+
+	// DemurrageSchedulesPrefix is the prefix for the demurrage schedules of
+	// the denominations.
+	DemurrageSchedulesPrefix = collections.NewPrefix(20)
+
+	// DemurrageAccountEpochsPrefix is the prefix for the epochs the decay of
+	// the balances of the demurrage denominations was last settled at.
+	DemurrageAccountEpochsPrefix = collections.NewPrefix(21)
 )
 
 // NewBalanceCompatValueCodec is a codec for encoding Balances in a backwards compatible way
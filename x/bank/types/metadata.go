@@ -5,6 +5,8 @@ import (
 	"fmt"
 	"strings"
 
+	"cosmossdk.io/math"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
@@ -98,3 +100,54 @@ func (du DenomUnit) Validate() error {
 
 	return nil
 }
+
+// UnitExponent returns the exponent of a unit of the denomination, designated
+// by its denom or one of its aliases, and whether the unit exists.
+func (m Metadata) UnitExponent(unit string) (uint32, bool) {
+	for _, denomUnit := range m.DenomUnits {
+		if denomUnit.Denom == unit {
+			return denomUnit.Exponent, true
+		}
+
+		for _, alias := range denomUnit.Aliases {
+			if alias == unit {
+				return denomUnit.Exponent, true
+			}
+		}
+	}
+
+	return 0, false
+}
+
+// ConvertAmount converts a non-negative amount of the base unit of the
+// denomination to a unit of the denomination, and returns the exact decimal
+// amount of the unit along with its exponent, e.g. "1.5" and 6 for an amount
+// of 1500000 converted to a unit of exponent 6.
+func (m Metadata) ConvertAmount(amount math.Int, unit string) (string, uint32, error) {
+	if amount.IsNil() || amount.IsNegative() {
+		return "", 0, errors.New("amount must be non-negative")
+	}
+
+	exponent, ok := m.UnitExponent(unit)
+	if !ok {
+		return "", 0, fmt.Errorf("unit %s is not a unit of denom %s", unit, m.Base)
+	}
+
+	digits := amount.String()
+	if exponent == 0 {
+		return digits, exponent, nil
+	}
+
+	// left pad the digits so that there is at least one integer digit
+	if n := int(exponent) + 1 - len(digits); n > 0 {
+		digits = strings.Repeat("0", n) + digits
+	}
+
+	point := len(digits) - int(exponent)
+	fraction := strings.TrimRight(digits[point:], "0")
+	if fraction == "" {
+		return digits[:point], exponent, nil
+	}
+
+	return digits[:point] + "." + fraction, exponent, nil
+}
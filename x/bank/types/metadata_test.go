@@ -5,6 +5,8 @@ import (
 
 	"github.com/stretchr/testify/require"
 
+	"cosmossdk.io/math"
+
 	"github.com/cosmos/cosmos-sdk/codec"
 	"github.com/cosmos/cosmos-sdk/x/bank/types"
 )
@@ -275,3 +277,51 @@ func TestMarshalJSONMetaData(t *testing.T) {
 		})
 	}
 }
+
+func TestMetadataConvertAmount(t *testing.T) {
+	metadata := types.Metadata{
+		Name:   "Cosmos Hub Atom",
+		Symbol: "ATOM",
+		DenomUnits: []*types.DenomUnit{
+			{"uatom", uint32(0), []string{"microatom"}},
+			{"matom", uint32(3), []string{"milliatom"}},
+			{"atom", uint32(6), nil},
+		},
+		Base:    "uatom",
+		Display: "atom",
+	}
+
+	testCases := []struct {
+		name        string
+		amount      math.Int
+		unit        string
+		expAmount   string
+		expExponent uint32
+		expErr      bool
+	}{
+		{"display unit", math.NewInt(1_500_000), "atom", "1.5", 6, false},
+		{"integer amount", math.NewInt(2_000_000), "atom", "2", 6, false},
+		{"amount smaller than the unit", math.NewInt(25), "atom", "0.000025", 6, false},
+		{"zero amount", math.ZeroInt(), "atom", "0", 6, false},
+		{"alias", math.NewInt(1_500_000), "milliatom", "1500", 3, false},
+		{"base unit", math.NewInt(1_500_000), "uatom", "1500000", 0, false},
+		{"large amount", math.NewIntFromUint64(18_446_744_073_709_551_615), "atom", "18446744073709.551615", 6, false},
+		{"unknown unit", math.NewInt(1), "katom", "", 0, true},
+		{"negative amount", math.NewInt(-1), "atom", "", 0, true},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			amount, exponent, err := metadata.ConvertAmount(tc.amount, tc.unit)
+			if tc.expErr {
+				require.Error(t, err)
+				return
+			}
+
+			require.NoError(t, err)
+			require.Equal(t, tc.expAmount, amount)
+			require.Equal(t, tc.expExponent, exponent)
+		})
+	}
+}
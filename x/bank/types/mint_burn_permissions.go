@@ -0,0 +1,73 @@
+package types
+
+import (
+	"fmt"
+	"strings"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// NewMintBurnPermissions returns the mint/burn permissions of a denomination.
+func NewMintBurnPermissions(denom string, minters, burners []string) MintBurnPermissions {
+	return MintBurnPermissions{
+		Denom:   denom,
+		Minters: minters,
+		Burners: burners,
+	}
+}
+
+// Validate checks for denom correctness and that the minters and burners are
+// non-empty and unique.
+func (p MintBurnPermissions) Validate() error {
+	if err := sdk.ValidateDenom(p.Denom); err != nil {
+		return err
+	}
+
+	if err := validateMintBurnPermissionEntries(p.Minters); err != nil {
+		return fmt.Errorf("invalid minters of %s: %w", p.Denom, err)
+	}
+
+	if err := validateMintBurnPermissionEntries(p.Burners); err != nil {
+		return fmt.Errorf("invalid burners of %s: %w", p.Denom, err)
+	}
+
+	return nil
+}
+
+// CanMint returns whether the module of the given name and module account
+// address is allowed to mint the denomination.
+func (p MintBurnPermissions) CanMint(moduleName string, moduleAddr sdk.AccAddress) bool {
+	return hasMintBurnPermissionEntry(p.Minters, moduleName, moduleAddr)
+}
+
+// CanBurn returns whether the module of the given name and module account
+// address is allowed to burn the denomination.
+func (p MintBurnPermissions) CanBurn(moduleName string, moduleAddr sdk.AccAddress) bool {
+	return hasMintBurnPermissionEntry(p.Burners, moduleName, moduleAddr)
+}
+
+func validateMintBurnPermissionEntries(entries []string) error {
+	seen := make(map[string]bool, len(entries))
+	for _, entry := range entries {
+		if strings.TrimSpace(entry) != entry || entry == "" {
+			return fmt.Errorf("invalid module name or address %q", entry)
+		}
+		if seen[entry] {
+			return fmt.Errorf("duplicate module name or address %q", entry)
+		}
+		seen[entry] = true
+	}
+
+	return nil
+}
+
+func hasMintBurnPermissionEntry(entries []string, moduleName string, moduleAddr sdk.AccAddress) bool {
+	addr := moduleAddr.String()
+	for _, entry := range entries {
+		if entry == moduleName || entry == addr {
+			return true
+		}
+	}
+
+	return false
+}
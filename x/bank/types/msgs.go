@@ -1,6 +1,10 @@
 package types
 
 import (
+	"time"
+
+	"cosmossdk.io/math"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/x/auth/migrations/legacytx"
 )
@@ -8,10 +12,12 @@ import (
 var (
 	_ sdk.Msg = &MsgSend{}
 	_ sdk.Msg = &MsgMultiSend{}
+	_ sdk.Msg = &MsgBatchSend{}
 	_ sdk.Msg = &MsgUpdateParams{}
 
 	_ legacytx.LegacyMsg = &MsgSend{}
 	_ legacytx.LegacyMsg = &MsgMultiSend{}
+	_ legacytx.LegacyMsg = &MsgBatchSend{}
 	_ legacytx.LegacyMsg = &MsgUpdateParams{}
 )
 
@@ -52,6 +58,23 @@ func (msg MsgMultiSend) GetSigners() []sdk.AccAddress {
 	return []sdk.AccAddress{addrs}
 }
 
+// NewMsgBatchSend - construct a msg to send coins from one account to many
+// accounts.
+func NewMsgBatchSend(fromAddr sdk.AccAddress, outputs []BatchOutput) *MsgBatchSend {
+	return &MsgBatchSend{FromAddress: fromAddr.String(), Outputs: outputs}
+}
+
+// GetSignBytes Implements Msg.
+func (msg MsgBatchSend) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&msg))
+}
+
+// GetSigners Implements Msg.
+func (msg MsgBatchSend) GetSigners() []sdk.AccAddress {
+	fromAddress, _ := sdk.AccAddressFromBech32(msg.FromAddress)
+	return []sdk.AccAddress{fromAddress}
+}
+
 // GetSigners returns the signer addresses that are expected to sign the result
 // of GetSignBytes.
 func (msg MsgUpdateParams) GetSigners() []sdk.AccAddress {
@@ -85,3 +108,212 @@ func (msg MsgSetSendEnabled) GetSigners() []sdk.AccAddress {
 	addr, _ := sdk.AccAddressFromBech32(msg.Authority)
 	return []sdk.AccAddress{addr}
 }
+
+// NewMsgUpdateDenomMetadata creates a message to set the client metadata of a
+// denomination.
+func NewMsgUpdateDenomMetadata(authority string, metadata Metadata) *MsgUpdateDenomMetadata {
+	return &MsgUpdateDenomMetadata{
+		Authority: authority,
+		Metadata:  metadata,
+	}
+}
+
+// GetSignBytes implements the LegacyMsg interface.
+func (msg MsgUpdateDenomMetadata) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&msg))
+}
+
+// GetSigners returns the expected signers for MsgUpdateDenomMetadata.
+func (msg MsgUpdateDenomMetadata) GetSigners() []sdk.AccAddress {
+	addr, _ := sdk.AccAddressFromBech32(msg.Authority)
+	return []sdk.AccAddress{addr}
+}
+
+// NewMsgCreateBalanceSnapshot creates a message to record a snapshot of the
+// balances of a denomination at the end of the given height.
+func NewMsgCreateBalanceSnapshot(authority, denom string, height int64) *MsgCreateBalanceSnapshot {
+	return &MsgCreateBalanceSnapshot{
+		Authority: authority,
+		Denom:     denom,
+		Height:    height,
+	}
+}
+
+// GetSignBytes implements the LegacyMsg interface.
+func (msg MsgCreateBalanceSnapshot) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&msg))
+}
+
+// GetSigners returns the expected signers for MsgCreateBalanceSnapshot.
+func (msg MsgCreateBalanceSnapshot) GetSigners() []sdk.AccAddress {
+	addr, _ := sdk.AccAddressFromBech32(msg.Authority)
+	return []sdk.AccAddress{addr}
+}
+
+// NewMsgDeleteBalanceSnapshot creates a message to delete a balance snapshot.
+func NewMsgDeleteBalanceSnapshot(authority string, snapshotID uint64) *MsgDeleteBalanceSnapshot {
+	return &MsgDeleteBalanceSnapshot{
+		Authority:  authority,
+		SnapshotId: snapshotID,
+	}
+}
+
+// GetSignBytes implements the LegacyMsg interface.
+func (msg MsgDeleteBalanceSnapshot) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&msg))
+}
+
+// GetSigners returns the expected signers for MsgDeleteBalanceSnapshot.
+func (msg MsgDeleteBalanceSnapshot) GetSigners() []sdk.AccAddress {
+	addr, _ := sdk.AccAddressFromBech32(msg.Authority)
+	return []sdk.AccAddress{addr}
+}
+
+// NewMsgSetMintBurnPermissions creates a message to set the mint/burn
+// permissions of denominations.
+func NewMsgSetMintBurnPermissions(authority string, permissions []MintBurnPermissions, unrestrictedDenoms []string) *MsgSetMintBurnPermissions {
+	return &MsgSetMintBurnPermissions{
+		Authority:          authority,
+		Permissions:        permissions,
+		UnrestrictedDenoms: unrestrictedDenoms,
+	}
+}
+
+// GetSignBytes implements the LegacyMsg interface.
+func (msg MsgSetMintBurnPermissions) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&msg))
+}
+
+// GetSigners returns the expected signers for MsgSetMintBurnPermissions.
+func (msg MsgSetMintBurnPermissions) GetSigners() []sdk.AccAddress {
+	addr, _ := sdk.AccAddressFromBech32(msg.Authority)
+	return []sdk.AccAddress{addr}
+}
+
+// NewMsgSetDustSweepOptIn creates a message for an account to opt in, or out
+// of, the sweeping of its dust balances.
+func NewMsgSetDustSweepOptIn(addr sdk.AccAddress, optIn bool) *MsgSetDustSweepOptIn {
+	return &MsgSetDustSweepOptIn{
+		Address: addr.String(),
+		OptIn:   optIn,
+	}
+}
+
+// GetSignBytes implements the LegacyMsg interface.
+func (msg MsgSetDustSweepOptIn) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&msg))
+}
+
+// GetSigners returns the expected signers for MsgSetDustSweepOptIn.
+func (msg MsgSetDustSweepOptIn) GetSigners() []sdk.AccAddress {
+	addr, _ := sdk.AccAddressFromBech32(msg.Address)
+	return []sdk.AccAddress{addr}
+}
+
+// NewMsgSendWithTimelock creates a message to send coins claimable by their
+// recipient from the unlock time, and reclaimable by their sender from the
+// expiry time.
+func NewMsgSendWithTimelock(fromAddr, toAddr sdk.AccAddress, amount sdk.Coins, unlockTime, expiryTime time.Time) *MsgSendWithTimelock {
+	return &MsgSendWithTimelock{
+		FromAddress: fromAddr.String(),
+		ToAddress:   toAddr.String(),
+		Amount:      amount,
+		UnlockTime:  unlockTime,
+		ExpiryTime:  expiryTime,
+	}
+}
+
+// GetSignBytes implements the LegacyMsg interface.
+func (msg MsgSendWithTimelock) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&msg))
+}
+
+// GetSigners returns the expected signers for MsgSendWithTimelock.
+func (msg MsgSendWithTimelock) GetSigners() []sdk.AccAddress {
+	fromAddress, _ := sdk.AccAddressFromBech32(msg.FromAddress)
+	return []sdk.AccAddress{fromAddress}
+}
+
+// NewMsgClaimTimelockedTransfer creates a message for the recipient of a
+// time-locked transfer to claim its coins.
+func NewMsgClaimTimelockedTransfer(recipient sdk.AccAddress, transferID uint64) *MsgClaimTimelockedTransfer {
+	return &MsgClaimTimelockedTransfer{
+		Recipient:  recipient.String(),
+		TransferId: transferID,
+	}
+}
+
+// GetSignBytes implements the LegacyMsg interface.
+func (msg MsgClaimTimelockedTransfer) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&msg))
+}
+
+// GetSigners returns the expected signers for MsgClaimTimelockedTransfer.
+func (msg MsgClaimTimelockedTransfer) GetSigners() []sdk.AccAddress {
+	recipient, _ := sdk.AccAddressFromBech32(msg.Recipient)
+	return []sdk.AccAddress{recipient}
+}
+
+// NewMsgReclaimTimelockedTransfer creates a message for the sender of an
+// expired time-locked transfer to reclaim its coins.
+func NewMsgReclaimTimelockedTransfer(sender sdk.AccAddress, transferID uint64) *MsgReclaimTimelockedTransfer {
+	return &MsgReclaimTimelockedTransfer{
+		Sender:     sender.String(),
+		TransferId: transferID,
+	}
+}
+
+// GetSignBytes implements the LegacyMsg interface.
+func (msg MsgReclaimTimelockedTransfer) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&msg))
+}
+
+// GetSigners returns the expected signers for MsgReclaimTimelockedTransfer.
+func (msg MsgReclaimTimelockedTransfer) GetSigners() []sdk.AccAddress {
+	sender, _ := sdk.AccAddressFromBech32(msg.Sender)
+	return []sdk.AccAddress{sender}
+}
+
+// NewMsgSetSpendingLimit creates a message to attach, change or remove the
+// spending limit of an account. The spending limit is removed if limits is
+// empty.
+func NewMsgSetSpendingLimit(addr sdk.AccAddress, limits sdk.Coins, window time.Duration) *MsgSetSpendingLimit {
+	return &MsgSetSpendingLimit{
+		Address: addr.String(),
+		Limits:  limits,
+		Window:  window,
+	}
+}
+
+// GetSignBytes implements the LegacyMsg interface.
+func (msg MsgSetSpendingLimit) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&msg))
+}
+
+// GetSigners returns the expected signers for MsgSetSpendingLimit.
+func (msg MsgSetSpendingLimit) GetSigners() []sdk.AccAddress {
+	addr, _ := sdk.AccAddressFromBech32(msg.Address)
+	return []sdk.AccAddress{addr}
+}
+
+// NewMsgSetDemurrageSchedule creates a message to set the demurrage schedule
+// of a denomination.
+func NewMsgSetDemurrageSchedule(authority, denom string, rate math.LegacyDec, epochLength int64) *MsgSetDemurrageSchedule {
+	return &MsgSetDemurrageSchedule{
+		Authority:   authority,
+		Denom:       denom,
+		Rate:        rate,
+		EpochLength: epochLength,
+	}
+}
+
+// GetSignBytes implements the LegacyMsg interface.
+func (msg MsgSetDemurrageSchedule) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&msg))
+}
+
+// GetSigners returns the expected signers for MsgSetDemurrageSchedule.
+func (msg MsgSetDemurrageSchedule) GetSigners() []sdk.AccAddress {
+	addr, _ := sdk.AccAddressFromBech32(msg.Authority)
+	return []sdk.AccAddress{addr}
+}
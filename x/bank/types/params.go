@@ -15,6 +15,7 @@ func NewParams(defaultSendEnabled bool) Params {
 	return Params{
 		SendEnabled:        nil,
 		DefaultSendEnabled: defaultSendEnabled,
+		DustSweep:          DefaultDustSweepParams(),
 	}
 }
 
@@ -23,6 +24,7 @@ func DefaultParams() Params {
 	return Params{
 		SendEnabled:        nil,
 		DefaultSendEnabled: DefaultDefaultSendEnabled,
+		DustSweep:          DefaultDustSweepParams(),
 	}
 }
 
@@ -31,7 +33,73 @@ func (p Params) Validate() error {
 	if len(p.SendEnabled) > 0 {
 		return errors.New("use of send_enabled in params is no longer supported")
 	}
-	return validateIsBool(p.DefaultSendEnabled)
+	if err := validateIsBool(p.DefaultSendEnabled); err != nil {
+		return err
+	}
+	return p.DustSweep.Validate()
+}
+
+// DefaultDustSweepParams returns the default dust sweep parameters, which
+// disable the sweeping.
+func DefaultDustSweepParams() DustSweepParams {
+	return DustSweepParams{
+		Thresholds:  nil,
+		EpochLength: 0,
+	}
+}
+
+// Validate checks the dust sweep parameters.
+func (p DustSweepParams) Validate() error {
+	if err := p.Thresholds.Validate(); err != nil {
+		return fmt.Errorf("invalid dust thresholds: %w", err)
+	}
+	if p.EpochLength < 0 {
+		return fmt.Errorf("dust sweep epoch length cannot be negative: %d", p.EpochLength)
+	}
+	if p.ChainWide && p.MaxAccountsPerEpoch == 0 {
+		return errors.New("chain-wide dust sweep must bound the accounts visited per epoch")
+	}
+
+	seen := make(map[string]bool, len(p.ExemptAddresses))
+	for _, addr := range p.ExemptAddresses {
+		if _, err := sdk.AccAddressFromBech32(addr); err != nil {
+			return fmt.Errorf("invalid dust sweep exempt address %s: %w", addr, err)
+		}
+		if seen[addr] {
+			return fmt.Errorf("duplicate dust sweep exempt address %s", addr)
+		}
+		seen[addr] = true
+	}
+
+	return nil
+}
+
+// IsEnabled returns whether the dust balances are swept.
+func (p DustSweepParams) IsEnabled() bool {
+	return p.EpochLength > 0 && !p.Thresholds.Empty()
+}
+
+// IsExempt returns whether the balances of the given address are exempt from
+// the sweeping.
+func (p DustSweepParams) IsExempt(addr sdk.AccAddress) bool {
+	for _, exempt := range p.ExemptAddresses {
+		if exempt == addr.String() {
+			return true
+		}
+	}
+	return false
+}
+
+// Dust returns the dust balances among the given balances, i.e. the positive
+// balances below the threshold of their denomination.
+func (p DustSweepParams) Dust(balances sdk.Coins) sdk.Coins {
+	var dust sdk.Coins
+	for _, threshold := range p.Thresholds {
+		if balance := balances.AmountOf(threshold.Denom); balance.IsPositive() && balance.LT(threshold.Amount) {
+			dust = append(dust, sdk.NewCoin(threshold.Denom, balance))
+		}
+	}
+	return dust
 }
 
 // Validate gets any errors with this SendEnabled entry.
@@ -38,23 +38,23 @@ func Test_ParamsString(t *testing.T) {
 	}{
 		{
 			name:     "default true empty send enabled",
-			params:   Params{[]*SendEnabled{}, true},
-			expected: "default_send_enabled:true ",
+			params:   Params{[]*SendEnabled{}, true, DustSweepParams{}},
+			expected: "default_send_enabled:true dust_sweep:<> ",
 		},
 		{
 			name:     "default false empty send enabled",
-			params:   Params{[]*SendEnabled{}, false},
-			expected: "",
+			params:   Params{[]*SendEnabled{}, false, DustSweepParams{}},
+			expected: "dust_sweep:<> ",
 		},
 		{
 			name:     "default true one true send enabled",
-			params:   Params{[]*SendEnabled{{"foocoin", true}}, true},
-			expected: "send_enabled:<denom:\"foocoin\" enabled:true > default_send_enabled:true ",
+			params:   Params{[]*SendEnabled{{"foocoin", true}}, true, DustSweepParams{}},
+			expected: "send_enabled:<denom:\"foocoin\" enabled:true > default_send_enabled:true dust_sweep:<> ",
 		},
 		{
 			name:     "default true one false send enabled",
-			params:   Params{[]*SendEnabled{{"barcoin", false}}, true},
-			expected: "send_enabled:<denom:\"barcoin\" > default_send_enabled:true ",
+			params:   Params{[]*SendEnabled{{"barcoin", false}}, true, DustSweepParams{}},
+			expected: "send_enabled:<denom:\"barcoin\" > default_send_enabled:true dust_sweep:<> ",
 		},
 	}
 	for _, tc := range tests {
@@ -69,5 +69,7 @@ func Test_validateParams(t *testing.T) {
 	assert.NoError(t, DefaultParams().Validate(), "default")
 	assert.NoError(t, NewParams(true).Validate(), "true")
 	assert.NoError(t, NewParams(false).Validate(), "false")
-	assert.Error(t, Params{[]*SendEnabled{{"foocoing", false}}, true}.Validate(), "with SendEnabled entry")
+	assert.Error(t, Params{[]*SendEnabled{{"foocoing", false}}, true, DustSweepParams{}}.Validate(), "with SendEnabled entry")
+	assert.Error(t, Params{DefaultSendEnabled: true, DustSweep: DustSweepParams{EpochLength: 10, ChainWide: true}}.Validate(), "unbounded chain-wide dust sweep")
+	assert.NoError(t, Params{DefaultSendEnabled: true, DustSweep: DustSweepParams{EpochLength: 10, ChainWide: true, MaxAccountsPerEpoch: 100}}.Validate(), "bounded chain-wide dust sweep")
 }
@@ -0,0 +1,53 @@
+package types
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// SendRestrictionFn defines a restriction applied to the transfers of coins,
+// e.g. by a compliance module. It is called before the coins are added to the
+// recipient account and returns the address the coins must be sent to, which
+// is usually the provided one but may be another one, e.g. an escrow account.
+// An error denies the transfer.
+type SendRestrictionFn func(ctx context.Context, fromAddr, toAddr sdk.AccAddress, amt sdk.Coins) (newToAddr sdk.AccAddress, err error)
+
+// IsOnePerModuleType implements the depinject.OnePerModuleType interface.
+func (SendRestrictionFn) IsOnePerModuleType() {}
+
+// NoOpSendRestrictionFn is a SendRestrictionFn allowing all the transfers.
+func NoOpSendRestrictionFn(_ context.Context, _, toAddr sdk.AccAddress, _ sdk.Coins) (sdk.AccAddress, error) {
+	return toAddr, nil
+}
+
+// Then returns a SendRestrictionFn applying r and then the second restriction
+// to the address returned by r. A nil restriction is ignored.
+func (r SendRestrictionFn) Then(second SendRestrictionFn) SendRestrictionFn {
+	if r == nil {
+		return second
+	}
+	if second == nil {
+		return r
+	}
+
+	return func(ctx context.Context, fromAddr, toAddr sdk.AccAddress, amt sdk.Coins) (sdk.AccAddress, error) {
+		newToAddr, err := r(ctx, fromAddr, toAddr, amt)
+		if err != nil {
+			return newToAddr, err
+		}
+
+		return second(ctx, fromAddr, newToAddr, amt)
+	}
+}
+
+// ComposeSendRestrictions returns a SendRestrictionFn applying the given
+// restrictions in order, or nil if there are none.
+func ComposeSendRestrictions(restrictions ...SendRestrictionFn) SendRestrictionFn {
+	var composed SendRestrictionFn
+	for _, r := range restrictions {
+		composed = composed.Then(r)
+	}
+
+	return composed
+}
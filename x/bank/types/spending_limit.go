@@ -0,0 +1,162 @@
+package types
+
+import (
+	"fmt"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// NewSpendingLimit returns a new spending limit of an account.
+func NewSpendingLimit(addr sdk.AccAddress, limits sdk.Coins, window time.Duration) SpendingLimit {
+	return SpendingLimit{
+		Address: addr.String(),
+		Limits:  limits,
+		Window:  window,
+	}
+}
+
+// IsLoosenedBy returns true if changing the spending limit to the given limits
+// and window allows the account to send more coins within a window, i.e. if
+// the window is shortened, or if the limit of a denomination is raised or
+// removed. Removing the spending limit, with empty limits, loosens it.
+func (l SpendingLimit) IsLoosenedBy(limits sdk.Coins, window time.Duration) bool {
+	if limits.Empty() || window < l.Window {
+		return true
+	}
+
+	for _, limit := range l.Limits {
+		if amount := limits.AmountOf(limit.Denom); amount.IsZero() || amount.GT(limit.Amount) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// ApplyPending applies the pending change of the spending limit, if it takes
+// effect at the given time. It returns false if the change removes the
+// spending limit.
+func (l *SpendingLimit) ApplyPending(blockTime time.Time) bool {
+	if l.Pending == nil || blockTime.Before(l.Pending.EffectiveTime) {
+		return true
+	}
+
+	if l.Pending.Limits.Empty() {
+		return false
+	}
+
+	l.Limits, l.Window, l.Pending = l.Pending.Limits, l.Pending.Window, nil
+	return true
+}
+
+// Prune removes the coins sent before the window ending at the given time.
+func (l *SpendingLimit) Prune(blockTime time.Time) {
+	start := blockTime.Add(-l.Window)
+	i := 0
+	for i < len(l.Spent) && !l.Spent[i].Time.After(start) {
+		i++
+	}
+	l.Spent = l.Spent[i:]
+}
+
+// SpentAmount returns the coins sent within the window.
+func (l SpendingLimit) SpentAmount() sdk.Coins {
+	var spent sdk.Coins
+	for _, r := range l.Spent {
+		spent = spent.Add(r.Amount...)
+	}
+
+	return spent
+}
+
+// Remaining returns the coins of the limited denominations which can still be
+// sent within the window. The denominations whose limit is reached are
+// omitted.
+func (l SpendingLimit) Remaining() sdk.Coins {
+	spent := l.SpentAmount()
+	remaining := sdk.NewCoins()
+	for _, limit := range l.Limits {
+		if amount := limit.Amount.Sub(spent.AmountOf(limit.Denom)); amount.IsPositive() {
+			remaining = remaining.Add(sdk.NewCoin(limit.Denom, amount))
+		}
+	}
+
+	return remaining
+}
+
+// Spend records the coins sent at the given time, returning an error if they
+// exceed the remaining coins of a limited denomination. The coins of the other
+// denominations are not recorded.
+func (l *SpendingLimit) Spend(blockTime time.Time, amt sdk.Coins) error {
+	var limited sdk.Coins
+	for _, coin := range amt {
+		if ok, _ := l.Limits.Find(coin.Denom); ok && coin.IsPositive() {
+			limited = limited.Add(coin)
+		}
+	}
+	if limited.Empty() {
+		return nil
+	}
+
+	remaining := l.Remaining()
+	for _, coin := range limited {
+		if remaining.AmountOf(coin.Denom).LT(coin.Amount) {
+			return ErrSpendingLimitExceeded.Wrapf(
+				"%s exceeds the remaining %s%s of %s", coin, remaining.AmountOf(coin.Denom), coin.Denom, l.Address,
+			)
+		}
+	}
+
+	if n := len(l.Spent); n > 0 && l.Spent[n-1].Time.Equal(blockTime) {
+		l.Spent[n-1].Amount = l.Spent[n-1].Amount.Add(limited...)
+		return nil
+	}
+
+	l.Spent = append(l.Spent, SpendingRecord{Time: blockTime, Amount: limited})
+	return nil
+}
+
+// Validate checks for address, limits, window and records correctness.
+func (l SpendingLimit) Validate() error {
+	if _, err := sdk.AccAddressFromBech32(l.Address); err != nil {
+		return fmt.Errorf("invalid address of spending limit: %w", err)
+	}
+
+	if l.Limits.Empty() {
+		return ErrInvalidSpendingLimit.Wrapf("empty limits of %s", l.Address)
+	}
+
+	if err := ValidateSpendingLimit(l.Limits, l.Window); err != nil {
+		return err
+	}
+
+	for i, r := range l.Spent {
+		if !r.Amount.IsValid() || r.Amount.IsZero() {
+			return ErrInvalidSpendingLimit.Wrapf("invalid spent amount %s of %s", r.Amount, l.Address)
+		}
+		if i > 0 && !r.Time.After(l.Spent[i-1].Time) {
+			return ErrInvalidSpendingLimit.Wrapf("spending records of %s are not sorted by time", l.Address)
+		}
+	}
+
+	if l.Pending != nil {
+		return ValidateSpendingLimit(l.Pending.Limits, l.Pending.Window)
+	}
+
+	return nil
+}
+
+// ValidateSpendingLimit checks that the limits of a spending limit are valid,
+// and that its window is positive unless the limits are empty.
+func ValidateSpendingLimit(limits sdk.Coins, window time.Duration) error {
+	if !limits.IsValid() {
+		return ErrInvalidSpendingLimit.Wrapf("invalid limits %s", limits)
+	}
+
+	if !limits.Empty() && window <= 0 {
+		return ErrInvalidSpendingLimit.Wrapf("window %s is not positive", window)
+	}
+
+	return nil
+}
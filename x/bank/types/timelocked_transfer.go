@@ -0,0 +1,75 @@
+package types
+
+import (
+	"fmt"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// NewTimelockedTransfer returns a new time-locked transfer of amount coins from
+// the sender to the recipient.
+func NewTimelockedTransfer(id uint64, sender, recipient sdk.AccAddress, amount sdk.Coins, unlockTime, expiryTime time.Time) TimelockedTransfer {
+	return TimelockedTransfer{
+		Id:         id,
+		Sender:     sender.String(),
+		Recipient:  recipient.String(),
+		Amount:     amount,
+		UnlockTime: unlockTime,
+		ExpiryTime: expiryTime,
+	}
+}
+
+// TimelockedTransferHolder returns the holder of the hold placed on the
+// balance of the sender of a time-locked transfer.
+func TimelockedTransferHolder(id uint64) string {
+	return fmt.Sprintf("timelock/%d", id)
+}
+
+// IsUnlocked returns true if the transfer can be claimed by its recipient at
+// the given time, i.e. from its unlock time until its expiry time.
+func (t TimelockedTransfer) IsUnlocked(blockTime time.Time) bool {
+	return !blockTime.Before(t.UnlockTime) && !t.IsExpired(blockTime)
+}
+
+// IsExpired returns true if the transfer can be reclaimed by its sender at the
+// given time, i.e. from its expiry time.
+func (t TimelockedTransfer) IsExpired(blockTime time.Time) bool {
+	return !blockTime.Before(t.ExpiryTime)
+}
+
+// Hold returns the hold placed on the balance of the sender of the transfer.
+func (t TimelockedTransfer) Hold() Hold {
+	return Hold{
+		Address: t.Sender,
+		Holder:  TimelockedTransferHolder(t.Id),
+		Amount:  t.Amount,
+	}
+}
+
+// Validate checks for addresses, amount and times correctness.
+func (t TimelockedTransfer) Validate() error {
+	if _, err := sdk.AccAddressFromBech32(t.Sender); err != nil {
+		return fmt.Errorf("invalid sender of time-locked transfer %d: %w", t.Id, err)
+	}
+
+	if _, err := sdk.AccAddressFromBech32(t.Recipient); err != nil {
+		return fmt.Errorf("invalid recipient of time-locked transfer %d: %w", t.Id, err)
+	}
+
+	return ValidateTimelock(t.Amount, t.UnlockTime, t.ExpiryTime)
+}
+
+// ValidateTimelock checks that the amount of a time-locked transfer is valid
+// and not zero, and that its expiry time is after its unlock time.
+func ValidateTimelock(amount sdk.Coins, unlockTime, expiryTime time.Time) error {
+	if !amount.IsValid() || amount.IsZero() {
+		return ErrInvalidTimelock.Wrapf("invalid amount %s", amount)
+	}
+
+	if !expiryTime.After(unlockTime) {
+		return ErrInvalidTimelock.Wrapf("expiry time %s is not after unlock time %s", expiryTime, unlockTime)
+	}
+
+	return nil
+}
@@ -5,6 +5,7 @@ package types
 
 import (
 	context "context"
+	cosmossdk_io_math "cosmossdk.io/math"
 	fmt "fmt"
 	_ "github.com/cosmos/cosmos-proto"
 	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
@@ -14,18 +15,23 @@ import (
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	grpc1 "github.com/cosmos/gogoproto/grpc"
 	proto "github.com/cosmos/gogoproto/proto"
+	github_com_cosmos_gogoproto_types "github.com/cosmos/gogoproto/types"
 	grpc "google.golang.org/grpc"
 	codes "google.golang.org/grpc/codes"
 	status "google.golang.org/grpc/status"
+	_ "google.golang.org/protobuf/types/known/durationpb"
+	_ "google.golang.org/protobuf/types/known/timestamppb"
 	io "io"
 	math "math"
 	math_bits "math/bits"
+	time "time"
 )
 
 // Reference imports to suppress errors if they are not otherwise used.
 var _ = proto.Marshal
 var _ = fmt.Errorf
 var _ = math.Inf
+var _ = time.Kitchen
 
 // This is a compile-time assertion to ensure that this generated file
 // is compatible with the proto package it is being compiled against.
@@ -412,704 +418,5499 @@ func (m *MsgSetSendEnabledResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgSetSendEnabledResponse proto.InternalMessageInfo
 
-func init() {
-	proto.RegisterType((*MsgSend)(nil), "cosmos.bank.v1beta1.MsgSend")
-	proto.RegisterType((*MsgSendResponse)(nil), "cosmos.bank.v1beta1.MsgSendResponse")
-	proto.RegisterType((*MsgMultiSend)(nil), "cosmos.bank.v1beta1.MsgMultiSend")
-	proto.RegisterType((*MsgMultiSendResponse)(nil), "cosmos.bank.v1beta1.MsgMultiSendResponse")
-	proto.RegisterType((*MsgUpdateParams)(nil), "cosmos.bank.v1beta1.MsgUpdateParams")
-	proto.RegisterType((*MsgUpdateParamsResponse)(nil), "cosmos.bank.v1beta1.MsgUpdateParamsResponse")
-	proto.RegisterType((*MsgSetSendEnabled)(nil), "cosmos.bank.v1beta1.MsgSetSendEnabled")
-	proto.RegisterType((*MsgSetSendEnabledResponse)(nil), "cosmos.bank.v1beta1.MsgSetSendEnabledResponse")
+// MsgUpdateDenomMetadata is the Msg/UpdateDenomMetadata request type.
+//
+// Since: cosmos-sdk 0.48
+type MsgUpdateDenomMetadata struct {
+	// authority is the address that controls the module (defaults to x/gov unless overwritten).
+	Authority string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
+	// metadata is the client metadata of the denomination, which replaces its
+	// current metadata, if any.
+	Metadata Metadata `protobuf:"bytes,2,opt,name=metadata,proto3" json:"metadata"`
 }
 
-func init() { proto.RegisterFile("cosmos/bank/v1beta1/tx.proto", fileDescriptor_1d8cb1613481f5b7) }
-
-var fileDescriptor_1d8cb1613481f5b7 = []byte{
-	// 700 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x54, 0xcf, 0x4f, 0xd3, 0x50,
-	0x1c, 0x5f, 0x99, 0x8e, 0xec, 0x31, 0x25, 0x54, 0x22, 0xac, 0x90, 0x0e, 0x16, 0x43, 0x00, 0xa5,
-	0x15, 0x34, 0x9a, 0xcc, 0x68, 0x74, 0x28, 0x89, 0x26, 0x8b, 0x66, 0xc4, 0x83, 0x5e, 0x96, 0xd7,
-	0xf5, 0x51, 0x1a, 0xd6, 0xbe, 0xa6, 0xef, 0x95, 0xb0, 0x9b, 0x7a, 0x32, 0x9e, 0x3c, 0x7b, 0xe2,
-	0x68, 0x8c, 0x07, 0x0e, 0x1e, 0x4d, 0xbc, 0x72, 0x24, 0x9e, 0x3c, 0xa9, 0x81, 0x03, 0xfa, 0x5f,
-	0x98, 0xf7, 0xa3, 0xa5, 0x8c, 0x8d, 0x11, 0x2f, 0x6b, 0xf7, 0x3e, 0x3f, 0xbe, 0xef, 0xf3, 0xed,
-	0xf7, 0x3d, 0x30, 0xd9, 0xc4, 0xc4, 0xc3, 0xc4, 0xb4, 0xa0, 0xbf, 0x61, 0x6e, 0x2e, 0x5a, 0x88,
-	0xc2, 0x45, 0x93, 0x6e, 0x19, 0x41, 0x88, 0x29, 0x56, 0x2f, 0x09, 0xd4, 0x60, 0xa8, 0x21, 0x51,
-	0x6d, 0xd4, 0xc1, 0x0e, 0xe6, 0xb8, 0xc9, 0xde, 0x04, 0x55, 0xd3, 0x13, 0x23, 0x82, 0x12, 0xa3,
-	0x26, 0x76, 0xfd, 0x13, 0x78, 0xaa, 0x10, 0xf7, 0x15, 0x78, 0x51, 0xe0, 0x0d, 0x61, 0x2c, 0xeb,
-	0x0a, 0x68, 0x4c, 0x4a, 0x3d, 0xe2, 0x98, 0x9b, 0x8b, 0xec, 0x21, 0x81, 0x11, 0xe8, 0xb9, 0x3e,
-	0x36, 0xf9, 0xaf, 0x58, 0x2a, 0x7f, 0x1e, 0x00, 0x83, 0x35, 0xe2, 0xac, 0x22, 0xdf, 0x56, 0xef,
-	0x80, 0xc2, 0x5a, 0x88, 0xbd, 0x06, 0xb4, 0xed, 0x10, 0x11, 0x32, 0xae, 0x4c, 0x29, 0xb3, 0xf9,
-	0xea, 0xf8, 0xf7, 0x2f, 0x0b, 0xa3, 0xd2, 0xff, 0x81, 0x40, 0x56, 0x69, 0xe8, 0xfa, 0x4e, 0x7d,
-	0x88, 0xb1, 0xe5, 0x92, 0x7a, 0x1b, 0x00, 0x8a, 0x13, 0xe9, 0x40, 0x1f, 0x69, 0x9e, 0xe2, 0x58,
-	0xd8, 0x06, 0x39, 0xe8, 0xe1, 0xc8, 0xa7, 0xe3, 0xd9, 0xa9, 0xec, 0xec, 0xd0, 0x52, 0xd1, 0x48,
-	0x9a, 0x48, 0x50, 0xdc, 0x44, 0x63, 0x19, 0xbb, 0x7e, 0x75, 0x65, 0xf7, 0x67, 0x29, 0xf3, 0xe9,
-	0x57, 0x69, 0xd6, 0x71, 0xe9, 0x7a, 0x64, 0x19, 0x4d, 0xec, 0xc9, 0xe4, 0xf2, 0xb1, 0x40, 0xec,
-	0x0d, 0x93, 0xb6, 0x03, 0x44, 0xb8, 0x80, 0x7c, 0x38, 0xdc, 0x99, 0x2f, 0xb4, 0x90, 0x03, 0x9b,
-	0xed, 0x06, 0xeb, 0x2d, 0xf9, 0x78, 0xb8, 0x33, 0xaf, 0xd4, 0x65, 0xc1, 0xca, 0xf5, 0xb7, 0xdb,
-	0xa5, 0xcc, 0x9f, 0xed, 0x52, 0xe6, 0x0d, 0xe3, 0xa5, 0xb3, 0xbf, 0x3b, 0xdc, 0x99, 0x57, 0x53,
-	0x9e, 0xb2, 0x45, 0xe5, 0x11, 0x30, 0x2c, 0x5f, 0xeb, 0x88, 0x04, 0xd8, 0x27, 0xa8, 0xfc, 0x55,
-	0x01, 0x85, 0x1a, 0x71, 0x6a, 0x51, 0x8b, 0xba, 0xbc, 0x8d, 0x77, 0x41, 0xce, 0xf5, 0x83, 0x88,
-	0xb2, 0x06, 0xb2, 0x40, 0x9a, 0xd1, 0x65, 0x2a, 0x8c, 0xc7, 0x8c, 0x52, 0xcd, 0xb3, 0x44, 0x72,
-	0x53, 0x42, 0xa4, 0xde, 0x07, 0x83, 0x38, 0xa2, 0x5c, 0x3f, 0xc0, 0xf5, 0x13, 0x5d, 0xf5, 0x4f,
-	0x39, 0x27, 0x6d, 0x10, 0xcb, 0x2a, 0x57, 0xe3, 0x48, 0xd2, 0x92, 0x85, 0x19, 0x3b, 0x1e, 0x26,
-	0xd9, 0x6d, 0xf9, 0x32, 0x18, 0x4d, 0xff, 0x4f, 0x62, 0x7d, 0x53, 0x78, 0xd4, 0xe7, 0x81, 0x0d,
-	0x29, 0x7a, 0x06, 0x43, 0xe8, 0x11, 0xf5, 0x16, 0xc8, 0xc3, 0x88, 0xae, 0xe3, 0xd0, 0xa5, 0xed,
-	0xbe, 0xd3, 0x71, 0x44, 0x55, 0xef, 0x81, 0x5c, 0xc0, 0x1d, 0xf8, 0x5c, 0xf4, 0x4a, 0x24, 0x8a,
-	0x1c, 0x6b, 0x89, 0x50, 0x55, 0x6e, 0xb2, 0x30, 0x47, 0x7e, 0x2c, 0xcf, 0x74, 0x2a, 0xcf, 0x96,
-	0x38, 0x24, 0x1d, 0xbb, 0x2d, 0x17, 0xc1, 0x58, 0xc7, 0x52, 0x12, 0xee, 0xaf, 0x02, 0x46, 0xf8,
-	0x77, 0xa4, 0x2c, 0xf3, 0x23, 0x1f, 0x5a, 0x2d, 0x64, 0xff, 0x77, 0xbc, 0x65, 0x50, 0x20, 0xc8,
-	0xb7, 0x1b, 0x48, 0xf8, 0xc8, 0xcf, 0x36, 0xd5, 0x35, 0x64, 0xaa, 0x5e, 0x7d, 0x88, 0xa4, 0x8a,
-	0xcf, 0x80, 0xe1, 0x88, 0xa0, 0x86, 0x8d, 0xd6, 0x60, 0xd4, 0xa2, 0x8d, 0x35, 0x1c, 0xf2, 0xf3,
-	0x90, 0xaf, 0x5f, 0x88, 0x08, 0x7a, 0x28, 0x56, 0x57, 0x70, 0x58, 0x31, 0x4f, 0xf6, 0x62, 0xb2,
-	0x73, 0x50, 0xd3, 0xa9, 0xca, 0x13, 0xa0, 0x78, 0x62, 0x31, 0x6e, 0xc4, 0xd2, 0xeb, 0x2c, 0xc8,
-	0xd6, 0x88, 0xa3, 0x3e, 0x01, 0xe7, 0xf8, 0xec, 0x4e, 0x76, 0xdd, 0xb4, 0x1c, 0x79, 0xed, 0xca,
-	0x69, 0x68, 0xec, 0xa9, 0xbe, 0x00, 0xf9, 0xa3, 0xc3, 0x30, 0xdd, 0x4b, 0x92, 0x50, 0xb4, 0xb9,
-	0xbe, 0x94, 0xc4, 0xda, 0x02, 0x85, 0x63, 0x03, 0xd9, 0x73, 0x43, 0x69, 0x96, 0x76, 0xed, 0x2c,
-	0xac, 0xa4, 0xc6, 0x3a, 0xb8, 0xd8, 0x31, 0x17, 0x33, 0xbd, 0x63, 0xa7, 0x79, 0x9a, 0x71, 0x36,
-	0x5e, 0x5c, 0x49, 0x3b, 0xff, 0x8a, 0x4d, 0x79, 0x75, 0x79, 0x77, 0x5f, 0x57, 0xf6, 0xf6, 0x75,
-	0xe5, 0xf7, 0xbe, 0xae, 0xbc, 0x3f, 0xd0, 0x33, 0x7b, 0x07, 0x7a, 0xe6, 0xc7, 0x81, 0x9e, 0x79,
-	0x39, 0x77, 0xea, 0x3d, 0x27, 0xc7, 0x9e, 0x5f, 0x77, 0x56, 0x8e, 0x5f, 0xe7, 0x37, 0xfe, 0x05,
-	0x00, 0x00, 0xff, 0xff, 0x5b, 0x5b, 0x43, 0xa9, 0xa0, 0x06, 0x00, 0x00,
+func (m *MsgUpdateDenomMetadata) Reset()         { *m = MsgUpdateDenomMetadata{} }
+func (m *MsgUpdateDenomMetadata) String() string { return proto.CompactTextString(m) }
+func (*MsgUpdateDenomMetadata) ProtoMessage()    {}
+func (*MsgUpdateDenomMetadata) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1d8cb1613481f5b7, []int{8}
+}
+func (m *MsgUpdateDenomMetadata) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUpdateDenomMetadata) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUpdateDenomMetadata.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgUpdateDenomMetadata) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUpdateDenomMetadata.Merge(m, src)
+}
+func (m *MsgUpdateDenomMetadata) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUpdateDenomMetadata) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUpdateDenomMetadata.DiscardUnknown(m)
 }
 
-// Reference imports to suppress errors if they are not otherwise used.
-var _ context.Context
-var _ grpc.ClientConn
+var xxx_messageInfo_MsgUpdateDenomMetadata proto.InternalMessageInfo
 
-// This is a compile-time assertion to ensure that this generated file
-// is compatible with the grpc package it is being compiled against.
-const _ = grpc.SupportPackageIsVersion4
+func (m *MsgUpdateDenomMetadata) GetAuthority() string {
+	if m != nil {
+		return m.Authority
+	}
+	return ""
+}
 
-// MsgClient is the client API for Msg service.
+func (m *MsgUpdateDenomMetadata) GetMetadata() Metadata {
+	if m != nil {
+		return m.Metadata
+	}
+	return Metadata{}
+}
+
+// MsgUpdateDenomMetadataResponse defines the Msg/UpdateDenomMetadata response type.
 //
-// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
-type MsgClient interface {
-	// Send defines a method for sending coins from one account to another account.
-	Send(ctx context.Context, in *MsgSend, opts ...grpc.CallOption) (*MsgSendResponse, error)
-	// MultiSend defines a method for sending coins from some accounts to other accounts.
-	MultiSend(ctx context.Context, in *MsgMultiSend, opts ...grpc.CallOption) (*MsgMultiSendResponse, error)
-	// UpdateParams defines a governance operation for updating the x/bank module parameters.
-	// The authority is defined in the keeper.
-	//
-	// Since: cosmos-sdk 0.47
-	UpdateParams(ctx context.Context, in *MsgUpdateParams, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error)
-	// SetSendEnabled is a governance operation for setting the SendEnabled flag
-	// on any number of Denoms. Only the entries to add or update should be
-	// included. Entries that already exist in the store, but that aren't
-	// included in this message, will be left unchanged.
-	//
-	// Since: cosmos-sdk 0.47
-	SetSendEnabled(ctx context.Context, in *MsgSetSendEnabled, opts ...grpc.CallOption) (*MsgSetSendEnabledResponse, error)
+// Since: cosmos-sdk 0.48
+type MsgUpdateDenomMetadataResponse struct {
 }
 
-type msgClient struct {
-	cc grpc1.ClientConn
+func (m *MsgUpdateDenomMetadataResponse) Reset()         { *m = MsgUpdateDenomMetadataResponse{} }
+func (m *MsgUpdateDenomMetadataResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgUpdateDenomMetadataResponse) ProtoMessage()    {}
+func (*MsgUpdateDenomMetadataResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1d8cb1613481f5b7, []int{9}
+}
+func (m *MsgUpdateDenomMetadataResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUpdateDenomMetadataResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUpdateDenomMetadataResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgUpdateDenomMetadataResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUpdateDenomMetadataResponse.Merge(m, src)
+}
+func (m *MsgUpdateDenomMetadataResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUpdateDenomMetadataResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUpdateDenomMetadataResponse.DiscardUnknown(m)
 }
 
-func NewMsgClient(cc grpc1.ClientConn) MsgClient {
-	return &msgClient{cc}
+var xxx_messageInfo_MsgUpdateDenomMetadataResponse proto.InternalMessageInfo
+
+// MsgCreateBalanceSnapshot is the Msg/CreateBalanceSnapshot request type.
+//
+// Since: cosmos-sdk 0.48
+type MsgCreateBalanceSnapshot struct {
+	// authority is the address that controls the module (defaults to x/gov unless overwritten).
+	Authority string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
+	// denom is the denomination of the balances to record.
+	Denom string `protobuf:"bytes,2,opt,name=denom,proto3" json:"denom,omitempty"`
+	// height is the height at the end of which the balances are recorded. It
+	// defaults to the current height, and cannot be in the past.
+	Height int64 `protobuf:"varint,3,opt,name=height,proto3" json:"height,omitempty"`
 }
 
-func (c *msgClient) Send(ctx context.Context, in *MsgSend, opts ...grpc.CallOption) (*MsgSendResponse, error) {
-	out := new(MsgSendResponse)
-	err := c.cc.Invoke(ctx, "/cosmos.bank.v1beta1.Msg/Send", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *MsgCreateBalanceSnapshot) Reset()         { *m = MsgCreateBalanceSnapshot{} }
+func (m *MsgCreateBalanceSnapshot) String() string { return proto.CompactTextString(m) }
+func (*MsgCreateBalanceSnapshot) ProtoMessage()    {}
+func (*MsgCreateBalanceSnapshot) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1d8cb1613481f5b7, []int{10}
+}
+func (m *MsgCreateBalanceSnapshot) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgCreateBalanceSnapshot) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgCreateBalanceSnapshot.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *MsgCreateBalanceSnapshot) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgCreateBalanceSnapshot.Merge(m, src)
+}
+func (m *MsgCreateBalanceSnapshot) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgCreateBalanceSnapshot) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgCreateBalanceSnapshot.DiscardUnknown(m)
 }
 
-func (c *msgClient) MultiSend(ctx context.Context, in *MsgMultiSend, opts ...grpc.CallOption) (*MsgMultiSendResponse, error) {
-	out := new(MsgMultiSendResponse)
-	err := c.cc.Invoke(ctx, "/cosmos.bank.v1beta1.Msg/MultiSend", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_MsgCreateBalanceSnapshot proto.InternalMessageInfo
+
+func (m *MsgCreateBalanceSnapshot) GetAuthority() string {
+	if m != nil {
+		return m.Authority
 	}
-	return out, nil
+	return ""
 }
 
-func (c *msgClient) UpdateParams(ctx context.Context, in *MsgUpdateParams, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error) {
-	out := new(MsgUpdateParamsResponse)
-	err := c.cc.Invoke(ctx, "/cosmos.bank.v1beta1.Msg/UpdateParams", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *MsgCreateBalanceSnapshot) GetDenom() string {
+	if m != nil {
+		return m.Denom
 	}
-	return out, nil
+	return ""
 }
 
-func (c *msgClient) SetSendEnabled(ctx context.Context, in *MsgSetSendEnabled, opts ...grpc.CallOption) (*MsgSetSendEnabledResponse, error) {
-	out := new(MsgSetSendEnabledResponse)
-	err := c.cc.Invoke(ctx, "/cosmos.bank.v1beta1.Msg/SetSendEnabled", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *MsgCreateBalanceSnapshot) GetHeight() int64 {
+	if m != nil {
+		return m.Height
 	}
-	return out, nil
+	return 0
 }
 
-// MsgServer is the server API for Msg service.
-type MsgServer interface {
-	// Send defines a method for sending coins from one account to another account.
-	Send(context.Context, *MsgSend) (*MsgSendResponse, error)
-	// MultiSend defines a method for sending coins from some accounts to other accounts.
-	MultiSend(context.Context, *MsgMultiSend) (*MsgMultiSendResponse, error)
-	// UpdateParams defines a governance operation for updating the x/bank module parameters.
-	// The authority is defined in the keeper.
-	//
-	// Since: cosmos-sdk 0.47
-	UpdateParams(context.Context, *MsgUpdateParams) (*MsgUpdateParamsResponse, error)
-	// SetSendEnabled is a governance operation for setting the SendEnabled flag
-	// on any number of Denoms. Only the entries to add or update should be
-	// included. Entries that already exist in the store, but that aren't
-	// included in this message, will be left unchanged.
-	//
-	// Since: cosmos-sdk 0.47
-	SetSendEnabled(context.Context, *MsgSetSendEnabled) (*MsgSetSendEnabledResponse, error)
+// MsgCreateBalanceSnapshotResponse defines the Msg/CreateBalanceSnapshot response type.
+//
+// Since: cosmos-sdk 0.48
+type MsgCreateBalanceSnapshotResponse struct {
+	// snapshot_id is the identifier of the balance snapshot.
+	SnapshotId uint64 `protobuf:"varint,1,opt,name=snapshot_id,json=snapshotId,proto3" json:"snapshot_id,omitempty"`
 }
 
-// UnimplementedMsgServer can be embedded to have forward compatible implementations.
-type UnimplementedMsgServer struct {
+func (m *MsgCreateBalanceSnapshotResponse) Reset()         { *m = MsgCreateBalanceSnapshotResponse{} }
+func (m *MsgCreateBalanceSnapshotResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgCreateBalanceSnapshotResponse) ProtoMessage()    {}
+func (*MsgCreateBalanceSnapshotResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1d8cb1613481f5b7, []int{11}
 }
-
-func (*UnimplementedMsgServer) Send(ctx context.Context, req *MsgSend) (*MsgSendResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Send not implemented")
+func (m *MsgCreateBalanceSnapshotResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-func (*UnimplementedMsgServer) MultiSend(ctx context.Context, req *MsgMultiSend) (*MsgMultiSendResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method MultiSend not implemented")
+func (m *MsgCreateBalanceSnapshotResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgCreateBalanceSnapshotResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
 }
-func (*UnimplementedMsgServer) UpdateParams(ctx context.Context, req *MsgUpdateParams) (*MsgUpdateParamsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method UpdateParams not implemented")
+func (m *MsgCreateBalanceSnapshotResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgCreateBalanceSnapshotResponse.Merge(m, src)
 }
-func (*UnimplementedMsgServer) SetSendEnabled(ctx context.Context, req *MsgSetSendEnabled) (*MsgSetSendEnabledResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method SetSendEnabled not implemented")
+func (m *MsgCreateBalanceSnapshotResponse) XXX_Size() int {
+	return m.Size()
 }
-
-func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
-	s.RegisterService(&_Msg_serviceDesc, srv)
+func (m *MsgCreateBalanceSnapshotResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgCreateBalanceSnapshotResponse.DiscardUnknown(m)
 }
 
-func _Msg_Send_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgSend)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).Send(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/cosmos.bank.v1beta1.Msg/Send",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).Send(ctx, req.(*MsgSend))
+var xxx_messageInfo_MsgCreateBalanceSnapshotResponse proto.InternalMessageInfo
+
+func (m *MsgCreateBalanceSnapshotResponse) GetSnapshotId() uint64 {
+	if m != nil {
+		return m.SnapshotId
 	}
-	return interceptor(ctx, in, info, handler)
+	return 0
 }
 
-func _Msg_MultiSend_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgMultiSend)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).MultiSend(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/cosmos.bank.v1beta1.Msg/MultiSend",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).MultiSend(ctx, req.(*MsgMultiSend))
-	}
-	return interceptor(ctx, in, info, handler)
+// MsgDeleteBalanceSnapshot is the Msg/DeleteBalanceSnapshot request type.
+//
+// Since: cosmos-sdk 0.48
+type MsgDeleteBalanceSnapshot struct {
+	// authority is the address that controls the module (defaults to x/gov unless overwritten).
+	Authority string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
+	// snapshot_id is the identifier of the balance snapshot to delete.
+	SnapshotId uint64 `protobuf:"varint,2,opt,name=snapshot_id,json=snapshotId,proto3" json:"snapshot_id,omitempty"`
 }
 
-func _Msg_UpdateParams_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgUpdateParams)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).UpdateParams(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/cosmos.bank.v1beta1.Msg/UpdateParams",
+func (m *MsgDeleteBalanceSnapshot) Reset()         { *m = MsgDeleteBalanceSnapshot{} }
+func (m *MsgDeleteBalanceSnapshot) String() string { return proto.CompactTextString(m) }
+func (*MsgDeleteBalanceSnapshot) ProtoMessage()    {}
+func (*MsgDeleteBalanceSnapshot) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1d8cb1613481f5b7, []int{12}
+}
+func (m *MsgDeleteBalanceSnapshot) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgDeleteBalanceSnapshot) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgDeleteBalanceSnapshot.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).UpdateParams(ctx, req.(*MsgUpdateParams))
+}
+func (m *MsgDeleteBalanceSnapshot) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgDeleteBalanceSnapshot.Merge(m, src)
+}
+func (m *MsgDeleteBalanceSnapshot) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgDeleteBalanceSnapshot) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgDeleteBalanceSnapshot.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgDeleteBalanceSnapshot proto.InternalMessageInfo
+
+func (m *MsgDeleteBalanceSnapshot) GetAuthority() string {
+	if m != nil {
+		return m.Authority
 	}
-	return interceptor(ctx, in, info, handler)
+	return ""
 }
 
-func _Msg_SetSendEnabled_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgSetSendEnabled)
-	if err := dec(in); err != nil {
-		return nil, err
+func (m *MsgDeleteBalanceSnapshot) GetSnapshotId() uint64 {
+	if m != nil {
+		return m.SnapshotId
 	}
-	if interceptor == nil {
-		return srv.(MsgServer).SetSendEnabled(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/cosmos.bank.v1beta1.Msg/SetSendEnabled",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).SetSendEnabled(ctx, req.(*MsgSetSendEnabled))
-	}
-	return interceptor(ctx, in, info, handler)
+	return 0
 }
 
-var _Msg_serviceDesc = grpc.ServiceDesc{
-	ServiceName: "cosmos.bank.v1beta1.Msg",
-	HandlerType: (*MsgServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "Send",
-			Handler:    _Msg_Send_Handler,
-		},
-		{
-			MethodName: "MultiSend",
-			Handler:    _Msg_MultiSend_Handler,
-		},
-		{
-			MethodName: "UpdateParams",
-			Handler:    _Msg_UpdateParams_Handler,
-		},
-		{
-			MethodName: "SetSendEnabled",
-			Handler:    _Msg_SetSendEnabled_Handler,
-		},
-	},
-	Streams:  []grpc.StreamDesc{},
-	Metadata: "cosmos/bank/v1beta1/tx.proto",
+// MsgDeleteBalanceSnapshotResponse defines the Msg/DeleteBalanceSnapshot response type.
+//
+// Since: cosmos-sdk 0.48
+type MsgDeleteBalanceSnapshotResponse struct {
 }
 
-func (m *MsgSend) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MsgDeleteBalanceSnapshotResponse) Reset()         { *m = MsgDeleteBalanceSnapshotResponse{} }
+func (m *MsgDeleteBalanceSnapshotResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgDeleteBalanceSnapshotResponse) ProtoMessage()    {}
+func (*MsgDeleteBalanceSnapshotResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1d8cb1613481f5b7, []int{13}
+}
+func (m *MsgDeleteBalanceSnapshotResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgDeleteBalanceSnapshotResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgDeleteBalanceSnapshotResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
+}
+func (m *MsgDeleteBalanceSnapshotResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgDeleteBalanceSnapshotResponse.Merge(m, src)
+}
+func (m *MsgDeleteBalanceSnapshotResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgDeleteBalanceSnapshotResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgDeleteBalanceSnapshotResponse.DiscardUnknown(m)
 }
 
-func (m *MsgSend) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+var xxx_messageInfo_MsgDeleteBalanceSnapshotResponse proto.InternalMessageInfo
+
+// MsgBatchSend represents a message to send coins from one account to many
+// accounts, e.g. for the payouts of an exchange, with an optional memo per
+// output.
+//
+// Since: cosmos-sdk 0.48
+type MsgBatchSend struct {
+	FromAddress string        `protobuf:"bytes,1,opt,name=from_address,json=fromAddress,proto3" json:"from_address,omitempty"`
+	Outputs     []BatchOutput `protobuf:"bytes,2,rep,name=outputs,proto3" json:"outputs"`
 }
 
-func (m *MsgSend) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Amount) > 0 {
-		for iNdEx := len(m.Amount) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Amount[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintTx(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x1a
+func (m *MsgBatchSend) Reset()         { *m = MsgBatchSend{} }
+func (m *MsgBatchSend) String() string { return proto.CompactTextString(m) }
+func (*MsgBatchSend) ProtoMessage()    {}
+func (*MsgBatchSend) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1d8cb1613481f5b7, []int{14}
+}
+func (m *MsgBatchSend) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgBatchSend) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgBatchSend.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if len(m.ToAddress) > 0 {
-		i -= len(m.ToAddress)
-		copy(dAtA[i:], m.ToAddress)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.ToAddress)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.FromAddress) > 0 {
-		i -= len(m.FromAddress)
-		copy(dAtA[i:], m.FromAddress)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.FromAddress)))
-		i--
-		dAtA[i] = 0xa
-	}
-	return len(dAtA) - i, nil
 }
-
-func (m *MsgSendResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *MsgBatchSend) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgBatchSend.Merge(m, src)
 }
-
-func (m *MsgSendResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *MsgBatchSend) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgBatchSend) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgBatchSend.DiscardUnknown(m)
 }
 
-func (m *MsgSendResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+var xxx_messageInfo_MsgBatchSend proto.InternalMessageInfo
+
+// MsgBatchSendResponse defines the Msg/BatchSend response type.
+//
+// Since: cosmos-sdk 0.48
+type MsgBatchSendResponse struct {
 }
 
-func (m *MsgMultiSend) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MsgBatchSendResponse) Reset()         { *m = MsgBatchSendResponse{} }
+func (m *MsgBatchSendResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgBatchSendResponse) ProtoMessage()    {}
+func (*MsgBatchSendResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1d8cb1613481f5b7, []int{15}
+}
+func (m *MsgBatchSendResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgBatchSendResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgBatchSendResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
+}
+func (m *MsgBatchSendResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgBatchSendResponse.Merge(m, src)
+}
+func (m *MsgBatchSendResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgBatchSendResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgBatchSendResponse.DiscardUnknown(m)
 }
 
-func (m *MsgMultiSend) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+var xxx_messageInfo_MsgBatchSendResponse proto.InternalMessageInfo
+
+// MsgSetMintBurnPermissions is the Msg/SetMintBurnPermissions request type.
+//
+// Only the denominations to add/update/delete need to be included. The
+// mint/burn permissions of the denominations that are not included in this
+// message are left unchanged.
+//
+// Since: cosmos-sdk 0.48
+type MsgSetMintBurnPermissions struct {
+	// authority is the address that controls the module (defaults to x/gov unless overwritten).
+	Authority string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
+	// permissions is the list of mint/burn permissions to add or update.
+	Permissions []MintBurnPermissions `protobuf:"bytes,2,rep,name=permissions,proto3" json:"permissions"`
+	// unrestricted_denoms is a list of denoms whose mint/burn permissions are
+	// deleted, so that they can be minted and burned by any module with the
+	// minter and burner permissions again.
+	UnrestrictedDenoms []string `protobuf:"bytes,3,rep,name=unrestricted_denoms,json=unrestrictedDenoms,proto3" json:"unrestricted_denoms,omitempty"`
 }
 
-func (m *MsgMultiSend) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Outputs) > 0 {
-		for iNdEx := len(m.Outputs) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Outputs[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintTx(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x12
+func (m *MsgSetMintBurnPermissions) Reset()         { *m = MsgSetMintBurnPermissions{} }
+func (m *MsgSetMintBurnPermissions) String() string { return proto.CompactTextString(m) }
+func (*MsgSetMintBurnPermissions) ProtoMessage()    {}
+func (*MsgSetMintBurnPermissions) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1d8cb1613481f5b7, []int{16}
+}
+func (m *MsgSetMintBurnPermissions) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSetMintBurnPermissions) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetMintBurnPermissions.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if len(m.Inputs) > 0 {
-		for iNdEx := len(m.Inputs) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Inputs[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintTx(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
-		}
+}
+func (m *MsgSetMintBurnPermissions) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetMintBurnPermissions.Merge(m, src)
+}
+func (m *MsgSetMintBurnPermissions) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSetMintBurnPermissions) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetMintBurnPermissions.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgSetMintBurnPermissions proto.InternalMessageInfo
+
+func (m *MsgSetMintBurnPermissions) GetAuthority() string {
+	if m != nil {
+		return m.Authority
 	}
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func (m *MsgMultiSendResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MsgSetMintBurnPermissions) GetPermissions() []MintBurnPermissions {
+	if m != nil {
+		return m.Permissions
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *MsgMultiSendResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *MsgSetMintBurnPermissions) GetUnrestrictedDenoms() []string {
+	if m != nil {
+		return m.UnrestrictedDenoms
+	}
+	return nil
 }
 
-func (m *MsgMultiSendResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+// MsgSetMintBurnPermissionsResponse defines the Msg/SetMintBurnPermissions response type.
+//
+// Since: cosmos-sdk 0.48
+type MsgSetMintBurnPermissionsResponse struct {
 }
 
-func (m *MsgUpdateParams) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MsgSetMintBurnPermissionsResponse) Reset()         { *m = MsgSetMintBurnPermissionsResponse{} }
+func (m *MsgSetMintBurnPermissionsResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgSetMintBurnPermissionsResponse) ProtoMessage()    {}
+func (*MsgSetMintBurnPermissionsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1d8cb1613481f5b7, []int{17}
+}
+func (m *MsgSetMintBurnPermissionsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSetMintBurnPermissionsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetMintBurnPermissionsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
 }
-
-func (m *MsgUpdateParams) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *MsgSetMintBurnPermissionsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetMintBurnPermissionsResponse.Merge(m, src)
+}
+func (m *MsgSetMintBurnPermissionsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSetMintBurnPermissionsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetMintBurnPermissionsResponse.DiscardUnknown(m)
 }
 
-func (m *MsgUpdateParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+var xxx_messageInfo_MsgSetMintBurnPermissionsResponse proto.InternalMessageInfo
+
+// MsgSetDustSweepOptIn is the Msg/SetDustSweepOptIn request type.
+//
+// Since: cosmos-sdk 0.48
+type MsgSetDustSweepOptIn struct {
+	// address is the address of the account opting in, or out.
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	// opt_in defines whether the dust balances of the account are swept when
+	// the sweeping isn't chain-wide.
+	OptIn bool `protobuf:"varint,2,opt,name=opt_in,json=optIn,proto3" json:"opt_in,omitempty"`
+}
+
+func (m *MsgSetDustSweepOptIn) Reset()         { *m = MsgSetDustSweepOptIn{} }
+func (m *MsgSetDustSweepOptIn) String() string { return proto.CompactTextString(m) }
+func (*MsgSetDustSweepOptIn) ProtoMessage()    {}
+func (*MsgSetDustSweepOptIn) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1d8cb1613481f5b7, []int{18}
+}
+func (m *MsgSetDustSweepOptIn) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSetDustSweepOptIn) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetDustSweepOptIn.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
-			return 0, err
+			return nil, err
 		}
-		i -= size
-		i = encodeVarintTx(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x12
-	if len(m.Authority) > 0 {
-		i -= len(m.Authority)
-		copy(dAtA[i:], m.Authority)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
-		i--
-		dAtA[i] = 0xa
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *MsgSetDustSweepOptIn) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetDustSweepOptIn.Merge(m, src)
+}
+func (m *MsgSetDustSweepOptIn) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSetDustSweepOptIn) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetDustSweepOptIn.DiscardUnknown(m)
 }
 
-func (m *MsgUpdateParamsResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_MsgSetDustSweepOptIn proto.InternalMessageInfo
+
+func (m *MsgSetDustSweepOptIn) GetAddress() string {
+	if m != nil {
+		return m.Address
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *MsgUpdateParamsResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *MsgSetDustSweepOptIn) GetOptIn() bool {
+	if m != nil {
+		return m.OptIn
+	}
+	return false
 }
 
-func (m *MsgUpdateParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+// MsgSetDustSweepOptInResponse defines the Msg/SetDustSweepOptIn response type.
+//
+// Since: cosmos-sdk 0.48
+type MsgSetDustSweepOptInResponse struct {
 }
 
-func (m *MsgSetSendEnabled) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MsgSetDustSweepOptInResponse) Reset()         { *m = MsgSetDustSweepOptInResponse{} }
+func (m *MsgSetDustSweepOptInResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgSetDustSweepOptInResponse) ProtoMessage()    {}
+func (*MsgSetDustSweepOptInResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1d8cb1613481f5b7, []int{19}
+}
+func (m *MsgSetDustSweepOptInResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSetDustSweepOptInResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetDustSweepOptInResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
+}
+func (m *MsgSetDustSweepOptInResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetDustSweepOptInResponse.Merge(m, src)
+}
+func (m *MsgSetDustSweepOptInResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSetDustSweepOptInResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetDustSweepOptInResponse.DiscardUnknown(m)
 }
 
-func (m *MsgSetSendEnabled) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+var xxx_messageInfo_MsgSetDustSweepOptInResponse proto.InternalMessageInfo
+
+// MsgSendWithTimelock represents a message to send coins claimable by their
+// recipient once the unlock time is reached. The coins are held in the account
+// of the sender until they are claimed, or reclaimed by the sender once the
+// expiry time is reached.
+//
+// Since: cosmos-sdk 0.48
+type MsgSendWithTimelock struct {
+	// from_address is the address of the account sending the coins.
+	FromAddress string `protobuf:"bytes,1,opt,name=from_address,json=fromAddress,proto3" json:"from_address,omitempty"`
+	// to_address is the address of the account allowed to claim the coins.
+	ToAddress string `protobuf:"bytes,2,opt,name=to_address,json=toAddress,proto3" json:"to_address,omitempty"`
+	// amount is the amount of coins to send.
+	Amount github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,3,rep,name=amount,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"amount"`
+	// unlock_time is the time from which the recipient can claim the coins.
+	UnlockTime time.Time `protobuf:"bytes,4,opt,name=unlock_time,json=unlockTime,proto3,stdtime" json:"unlock_time"`
+	// expiry_time is the time from which the sender can reclaim the coins if
+	// unclaimed. It must be after the unlock time.
+	ExpiryTime time.Time `protobuf:"bytes,5,opt,name=expiry_time,json=expiryTime,proto3,stdtime" json:"expiry_time"`
 }
 
-func (m *MsgSetSendEnabled) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.UseDefaultFor) > 0 {
-		for iNdEx := len(m.UseDefaultFor) - 1; iNdEx >= 0; iNdEx-- {
-			i -= len(m.UseDefaultFor[iNdEx])
-			copy(dAtA[i:], m.UseDefaultFor[iNdEx])
-			i = encodeVarintTx(dAtA, i, uint64(len(m.UseDefaultFor[iNdEx])))
-			i--
-			dAtA[i] = 0x1a
-		}
-	}
-	if len(m.SendEnabled) > 0 {
-		for iNdEx := len(m.SendEnabled) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.SendEnabled[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintTx(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x12
+func (m *MsgSendWithTimelock) Reset()         { *m = MsgSendWithTimelock{} }
+func (m *MsgSendWithTimelock) String() string { return proto.CompactTextString(m) }
+func (*MsgSendWithTimelock) ProtoMessage()    {}
+func (*MsgSendWithTimelock) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1d8cb1613481f5b7, []int{20}
+}
+func (m *MsgSendWithTimelock) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSendWithTimelock) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSendWithTimelock.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if len(m.Authority) > 0 {
-		i -= len(m.Authority)
-		copy(dAtA[i:], m.Authority)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
-		i--
-		dAtA[i] = 0xa
-	}
-	return len(dAtA) - i, nil
+}
+func (m *MsgSendWithTimelock) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSendWithTimelock.Merge(m, src)
+}
+func (m *MsgSendWithTimelock) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSendWithTimelock) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSendWithTimelock.DiscardUnknown(m)
 }
 
-func (m *MsgSetSendEnabledResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_MsgSendWithTimelock proto.InternalMessageInfo
+
+func (m *MsgSendWithTimelock) GetFromAddress() string {
+	if m != nil {
+		return m.FromAddress
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *MsgSetSendEnabledResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *MsgSendWithTimelock) GetToAddress() string {
+	if m != nil {
+		return m.ToAddress
+	}
+	return ""
 }
 
-func (m *MsgSetSendEnabledResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func (m *MsgSendWithTimelock) GetAmount() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Amount
+	}
+	return nil
 }
 
-func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
-	offset -= sovTx(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *MsgSendWithTimelock) GetUnlockTime() time.Time {
+	if m != nil {
+		return m.UnlockTime
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return time.Time{}
 }
-func (m *MsgSend) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.FromAddress)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = len(m.ToAddress)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	if len(m.Amount) > 0 {
-		for _, e := range m.Amount {
-			l = e.Size()
-			n += 1 + l + sovTx(uint64(l))
-		}
+
+func (m *MsgSendWithTimelock) GetExpiryTime() time.Time {
+	if m != nil {
+		return m.ExpiryTime
 	}
-	return n
+	return time.Time{}
 }
 
-func (m *MsgSendResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	return n
+// MsgSendWithTimelockResponse defines the Msg/SendWithTimelock response type.
+//
+// Since: cosmos-sdk 0.48
+type MsgSendWithTimelockResponse struct {
+	// transfer_id is the identifier of the time-locked transfer.
+	TransferId uint64 `protobuf:"varint,1,opt,name=transfer_id,json=transferId,proto3" json:"transfer_id,omitempty"`
 }
 
-func (m *MsgMultiSend) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if len(m.Inputs) > 0 {
-		for _, e := range m.Inputs {
-			l = e.Size()
-			n += 1 + l + sovTx(uint64(l))
-		}
-	}
-	if len(m.Outputs) > 0 {
-		for _, e := range m.Outputs {
-			l = e.Size()
-			n += 1 + l + sovTx(uint64(l))
+func (m *MsgSendWithTimelockResponse) Reset()         { *m = MsgSendWithTimelockResponse{} }
+func (m *MsgSendWithTimelockResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgSendWithTimelockResponse) ProtoMessage()    {}
+func (*MsgSendWithTimelockResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1d8cb1613481f5b7, []int{21}
+}
+func (m *MsgSendWithTimelockResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSendWithTimelockResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSendWithTimelockResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	return n
+}
+func (m *MsgSendWithTimelockResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSendWithTimelockResponse.Merge(m, src)
+}
+func (m *MsgSendWithTimelockResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSendWithTimelockResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSendWithTimelockResponse.DiscardUnknown(m)
 }
 
-func (m *MsgMultiSendResponse) Size() (n int) {
-	if m == nil {
-		return 0
+var xxx_messageInfo_MsgSendWithTimelockResponse proto.InternalMessageInfo
+
+func (m *MsgSendWithTimelockResponse) GetTransferId() uint64 {
+	if m != nil {
+		return m.TransferId
 	}
-	var l int
-	_ = l
-	return n
+	return 0
 }
 
-func (m *MsgUpdateParams) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Authority)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = m.Params.Size()
-	n += 1 + l + sovTx(uint64(l))
-	return n
+// MsgClaimTimelockedTransfer is the Msg/ClaimTimelockedTransfer request type.
+//
+// Since: cosmos-sdk 0.48
+type MsgClaimTimelockedTransfer struct {
+	// recipient is the address of the recipient of the transfer.
+	Recipient string `protobuf:"bytes,1,opt,name=recipient,proto3" json:"recipient,omitempty"`
+	// transfer_id is the identifier of the time-locked transfer.
+	TransferId uint64 `protobuf:"varint,2,opt,name=transfer_id,json=transferId,proto3" json:"transfer_id,omitempty"`
 }
 
-func (m *MsgUpdateParamsResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	return n
+func (m *MsgClaimTimelockedTransfer) Reset()         { *m = MsgClaimTimelockedTransfer{} }
+func (m *MsgClaimTimelockedTransfer) String() string { return proto.CompactTextString(m) }
+func (*MsgClaimTimelockedTransfer) ProtoMessage()    {}
+func (*MsgClaimTimelockedTransfer) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1d8cb1613481f5b7, []int{22}
+}
+func (m *MsgClaimTimelockedTransfer) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgClaimTimelockedTransfer) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgClaimTimelockedTransfer.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgClaimTimelockedTransfer) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgClaimTimelockedTransfer.Merge(m, src)
+}
+func (m *MsgClaimTimelockedTransfer) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgClaimTimelockedTransfer) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgClaimTimelockedTransfer.DiscardUnknown(m)
 }
 
-func (m *MsgSetSendEnabled) Size() (n int) {
-	if m == nil {
-		return 0
+var xxx_messageInfo_MsgClaimTimelockedTransfer proto.InternalMessageInfo
+
+func (m *MsgClaimTimelockedTransfer) GetRecipient() string {
+	if m != nil {
+		return m.Recipient
 	}
-	var l int
-	_ = l
-	l = len(m.Authority)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	return ""
+}
+
+func (m *MsgClaimTimelockedTransfer) GetTransferId() uint64 {
+	if m != nil {
+		return m.TransferId
 	}
-	if len(m.SendEnabled) > 0 {
-		for _, e := range m.SendEnabled {
-			l = e.Size()
-			n += 1 + l + sovTx(uint64(l))
+	return 0
+}
+
+// MsgClaimTimelockedTransferResponse defines the Msg/ClaimTimelockedTransfer
+// response type.
+//
+// Since: cosmos-sdk 0.48
+type MsgClaimTimelockedTransferResponse struct {
+}
+
+func (m *MsgClaimTimelockedTransferResponse) Reset()         { *m = MsgClaimTimelockedTransferResponse{} }
+func (m *MsgClaimTimelockedTransferResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgClaimTimelockedTransferResponse) ProtoMessage()    {}
+func (*MsgClaimTimelockedTransferResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1d8cb1613481f5b7, []int{23}
+}
+func (m *MsgClaimTimelockedTransferResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgClaimTimelockedTransferResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgClaimTimelockedTransferResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if len(m.UseDefaultFor) > 0 {
-		for _, s := range m.UseDefaultFor {
-			l = len(s)
-			n += 1 + l + sovTx(uint64(l))
+}
+func (m *MsgClaimTimelockedTransferResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgClaimTimelockedTransferResponse.Merge(m, src)
+}
+func (m *MsgClaimTimelockedTransferResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgClaimTimelockedTransferResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgClaimTimelockedTransferResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgClaimTimelockedTransferResponse proto.InternalMessageInfo
+
+// MsgReclaimTimelockedTransfer is the Msg/ReclaimTimelockedTransfer request
+// type.
+//
+// Since: cosmos-sdk 0.48
+type MsgReclaimTimelockedTransfer struct {
+	// sender is the address of the sender of the transfer.
+	Sender string `protobuf:"bytes,1,opt,name=sender,proto3" json:"sender,omitempty"`
+	// transfer_id is the identifier of the time-locked transfer.
+	TransferId uint64 `protobuf:"varint,2,opt,name=transfer_id,json=transferId,proto3" json:"transfer_id,omitempty"`
+}
+
+func (m *MsgReclaimTimelockedTransfer) Reset()         { *m = MsgReclaimTimelockedTransfer{} }
+func (m *MsgReclaimTimelockedTransfer) String() string { return proto.CompactTextString(m) }
+func (*MsgReclaimTimelockedTransfer) ProtoMessage()    {}
+func (*MsgReclaimTimelockedTransfer) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1d8cb1613481f5b7, []int{24}
+}
+func (m *MsgReclaimTimelockedTransfer) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgReclaimTimelockedTransfer) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgReclaimTimelockedTransfer.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgReclaimTimelockedTransfer) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgReclaimTimelockedTransfer.Merge(m, src)
+}
+func (m *MsgReclaimTimelockedTransfer) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgReclaimTimelockedTransfer) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgReclaimTimelockedTransfer.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgReclaimTimelockedTransfer proto.InternalMessageInfo
+
+func (m *MsgReclaimTimelockedTransfer) GetSender() string {
+	if m != nil {
+		return m.Sender
+	}
+	return ""
+}
+
+func (m *MsgReclaimTimelockedTransfer) GetTransferId() uint64 {
+	if m != nil {
+		return m.TransferId
+	}
+	return 0
+}
+
+// MsgReclaimTimelockedTransferResponse defines the
+// Msg/ReclaimTimelockedTransfer response type.
+//
+// Since: cosmos-sdk 0.48
+type MsgReclaimTimelockedTransferResponse struct {
+}
+
+func (m *MsgReclaimTimelockedTransferResponse) Reset()         { *m = MsgReclaimTimelockedTransferResponse{} }
+func (m *MsgReclaimTimelockedTransferResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgReclaimTimelockedTransferResponse) ProtoMessage()    {}
+func (*MsgReclaimTimelockedTransferResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1d8cb1613481f5b7, []int{25}
+}
+func (m *MsgReclaimTimelockedTransferResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgReclaimTimelockedTransferResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgReclaimTimelockedTransferResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgReclaimTimelockedTransferResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgReclaimTimelockedTransferResponse.Merge(m, src)
+}
+func (m *MsgReclaimTimelockedTransferResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgReclaimTimelockedTransferResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgReclaimTimelockedTransferResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgReclaimTimelockedTransferResponse proto.InternalMessageInfo
+
+// MsgSetSpendingLimit is the Msg/SetSpendingLimit request type. A change
+// loosening an existing spending limit, including its removal, only takes
+// effect after a window of the existing spending limit.
+//
+// Since: cosmos-sdk 0.48
+type MsgSetSpendingLimit struct {
+	// address is the address of the account whose transfers are limited.
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	// limits are the maximum amounts of the denominations the account can send
+	// within a window. The spending limit is removed if empty.
+	Limits github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,2,rep,name=limits,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"limits"`
+	// window is the duration of the rolling window.
+	Window time.Duration `protobuf:"bytes,3,opt,name=window,proto3,stdduration" json:"window"`
+}
+
+func (m *MsgSetSpendingLimit) Reset()         { *m = MsgSetSpendingLimit{} }
+func (m *MsgSetSpendingLimit) String() string { return proto.CompactTextString(m) }
+func (*MsgSetSpendingLimit) ProtoMessage()    {}
+func (*MsgSetSpendingLimit) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1d8cb1613481f5b7, []int{26}
+}
+func (m *MsgSetSpendingLimit) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSetSpendingLimit) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetSpendingLimit.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgSetSpendingLimit) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetSpendingLimit.Merge(m, src)
+}
+func (m *MsgSetSpendingLimit) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSetSpendingLimit) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetSpendingLimit.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgSetSpendingLimit proto.InternalMessageInfo
+
+func (m *MsgSetSpendingLimit) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+func (m *MsgSetSpendingLimit) GetLimits() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Limits
+	}
+	return nil
+}
+
+func (m *MsgSetSpendingLimit) GetWindow() time.Duration {
+	if m != nil {
+		return m.Window
+	}
+	return 0
+}
+
+// MsgSetSpendingLimitResponse defines the Msg/SetSpendingLimit response type.
+//
+// Since: cosmos-sdk 0.48
+type MsgSetSpendingLimitResponse struct {
+	// effective_time is the time from which the change takes effect.
+	EffectiveTime time.Time `protobuf:"bytes,1,opt,name=effective_time,json=effectiveTime,proto3,stdtime" json:"effective_time"`
+}
+
+func (m *MsgSetSpendingLimitResponse) Reset()         { *m = MsgSetSpendingLimitResponse{} }
+func (m *MsgSetSpendingLimitResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgSetSpendingLimitResponse) ProtoMessage()    {}
+func (*MsgSetSpendingLimitResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1d8cb1613481f5b7, []int{27}
+}
+func (m *MsgSetSpendingLimitResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSetSpendingLimitResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetSpendingLimitResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgSetSpendingLimitResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetSpendingLimitResponse.Merge(m, src)
+}
+func (m *MsgSetSpendingLimitResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSetSpendingLimitResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetSpendingLimitResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgSetSpendingLimitResponse proto.InternalMessageInfo
+
+func (m *MsgSetSpendingLimitResponse) GetEffectiveTime() time.Time {
+	if m != nil {
+		return m.EffectiveTime
+	}
+	return time.Time{}
+}
+
+// MsgSetDemurrageSchedule is the Msg/SetDemurrageSchedule request type. It
+// creates the demurrage schedule of a denomination, or changes the rate and
+// the epoch length of its existing schedule. A rate change applies from the
+// current epoch, the decay of the previous epochs being settled at the previous
+// rates.
+//
+// Since: cosmos-sdk 0.48
+type MsgSetDemurrageSchedule struct {
+	// authority is the address that controls the module (defaults to x/gov unless overwritten).
+	Authority string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
+	// denom is the denomination whose balances decay.
+	Denom string `protobuf:"bytes,2,opt,name=denom,proto3" json:"denom,omitempty"`
+	// rate is the fraction of the balances burnt at the end of each epoch,
+	// between 0 and 1. A rate of 1 expires the balances at the end of each
+	// epoch, and a rate of 0 pauses the schedule.
+	Rate cosmossdk_io_math.LegacyDec `protobuf:"bytes,3,opt,name=rate,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"rate"`
+	// epoch_length is the number of blocks of an epoch.
+	EpochLength int64 `protobuf:"varint,4,opt,name=epoch_length,json=epochLength,proto3" json:"epoch_length,omitempty"`
+}
+
+func (m *MsgSetDemurrageSchedule) Reset()         { *m = MsgSetDemurrageSchedule{} }
+func (m *MsgSetDemurrageSchedule) String() string { return proto.CompactTextString(m) }
+func (*MsgSetDemurrageSchedule) ProtoMessage()    {}
+func (*MsgSetDemurrageSchedule) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1d8cb1613481f5b7, []int{28}
+}
+func (m *MsgSetDemurrageSchedule) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSetDemurrageSchedule) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetDemurrageSchedule.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgSetDemurrageSchedule) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetDemurrageSchedule.Merge(m, src)
+}
+func (m *MsgSetDemurrageSchedule) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSetDemurrageSchedule) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetDemurrageSchedule.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgSetDemurrageSchedule proto.InternalMessageInfo
+
+func (m *MsgSetDemurrageSchedule) GetAuthority() string {
+	if m != nil {
+		return m.Authority
+	}
+	return ""
+}
+
+func (m *MsgSetDemurrageSchedule) GetDenom() string {
+	if m != nil {
+		return m.Denom
+	}
+	return ""
+}
+
+func (m *MsgSetDemurrageSchedule) GetEpochLength() int64 {
+	if m != nil {
+		return m.EpochLength
+	}
+	return 0
+}
+
+// MsgSetDemurrageScheduleResponse defines the Msg/SetDemurrageSchedule response type.
+//
+// Since: cosmos-sdk 0.48
+type MsgSetDemurrageScheduleResponse struct {
+}
+
+func (m *MsgSetDemurrageScheduleResponse) Reset()         { *m = MsgSetDemurrageScheduleResponse{} }
+func (m *MsgSetDemurrageScheduleResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgSetDemurrageScheduleResponse) ProtoMessage()    {}
+func (*MsgSetDemurrageScheduleResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1d8cb1613481f5b7, []int{29}
+}
+func (m *MsgSetDemurrageScheduleResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSetDemurrageScheduleResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetDemurrageScheduleResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgSetDemurrageScheduleResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetDemurrageScheduleResponse.Merge(m, src)
+}
+func (m *MsgSetDemurrageScheduleResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSetDemurrageScheduleResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetDemurrageScheduleResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgSetDemurrageScheduleResponse proto.InternalMessageInfo
+
+func init() {
+	proto.RegisterType((*MsgSend)(nil), "cosmos.bank.v1beta1.MsgSend")
+	proto.RegisterType((*MsgSendResponse)(nil), "cosmos.bank.v1beta1.MsgSendResponse")
+	proto.RegisterType((*MsgMultiSend)(nil), "cosmos.bank.v1beta1.MsgMultiSend")
+	proto.RegisterType((*MsgMultiSendResponse)(nil), "cosmos.bank.v1beta1.MsgMultiSendResponse")
+	proto.RegisterType((*MsgUpdateParams)(nil), "cosmos.bank.v1beta1.MsgUpdateParams")
+	proto.RegisterType((*MsgUpdateParamsResponse)(nil), "cosmos.bank.v1beta1.MsgUpdateParamsResponse")
+	proto.RegisterType((*MsgSetSendEnabled)(nil), "cosmos.bank.v1beta1.MsgSetSendEnabled")
+	proto.RegisterType((*MsgSetSendEnabledResponse)(nil), "cosmos.bank.v1beta1.MsgSetSendEnabledResponse")
+	proto.RegisterType((*MsgUpdateDenomMetadata)(nil), "cosmos.bank.v1beta1.MsgUpdateDenomMetadata")
+	proto.RegisterType((*MsgUpdateDenomMetadataResponse)(nil), "cosmos.bank.v1beta1.MsgUpdateDenomMetadataResponse")
+	proto.RegisterType((*MsgCreateBalanceSnapshot)(nil), "cosmos.bank.v1beta1.MsgCreateBalanceSnapshot")
+	proto.RegisterType((*MsgCreateBalanceSnapshotResponse)(nil), "cosmos.bank.v1beta1.MsgCreateBalanceSnapshotResponse")
+	proto.RegisterType((*MsgDeleteBalanceSnapshot)(nil), "cosmos.bank.v1beta1.MsgDeleteBalanceSnapshot")
+	proto.RegisterType((*MsgDeleteBalanceSnapshotResponse)(nil), "cosmos.bank.v1beta1.MsgDeleteBalanceSnapshotResponse")
+	proto.RegisterType((*MsgBatchSend)(nil), "cosmos.bank.v1beta1.MsgBatchSend")
+	proto.RegisterType((*MsgBatchSendResponse)(nil), "cosmos.bank.v1beta1.MsgBatchSendResponse")
+	proto.RegisterType((*MsgSetMintBurnPermissions)(nil), "cosmos.bank.v1beta1.MsgSetMintBurnPermissions")
+	proto.RegisterType((*MsgSetMintBurnPermissionsResponse)(nil), "cosmos.bank.v1beta1.MsgSetMintBurnPermissionsResponse")
+	proto.RegisterType((*MsgSetDustSweepOptIn)(nil), "cosmos.bank.v1beta1.MsgSetDustSweepOptIn")
+	proto.RegisterType((*MsgSetDustSweepOptInResponse)(nil), "cosmos.bank.v1beta1.MsgSetDustSweepOptInResponse")
+	proto.RegisterType((*MsgSendWithTimelock)(nil), "cosmos.bank.v1beta1.MsgSendWithTimelock")
+	proto.RegisterType((*MsgSendWithTimelockResponse)(nil), "cosmos.bank.v1beta1.MsgSendWithTimelockResponse")
+	proto.RegisterType((*MsgClaimTimelockedTransfer)(nil), "cosmos.bank.v1beta1.MsgClaimTimelockedTransfer")
+	proto.RegisterType((*MsgClaimTimelockedTransferResponse)(nil), "cosmos.bank.v1beta1.MsgClaimTimelockedTransferResponse")
+	proto.RegisterType((*MsgReclaimTimelockedTransfer)(nil), "cosmos.bank.v1beta1.MsgReclaimTimelockedTransfer")
+	proto.RegisterType((*MsgReclaimTimelockedTransferResponse)(nil), "cosmos.bank.v1beta1.MsgReclaimTimelockedTransferResponse")
+	proto.RegisterType((*MsgSetSpendingLimit)(nil), "cosmos.bank.v1beta1.MsgSetSpendingLimit")
+	proto.RegisterType((*MsgSetSpendingLimitResponse)(nil), "cosmos.bank.v1beta1.MsgSetSpendingLimitResponse")
+	proto.RegisterType((*MsgSetDemurrageSchedule)(nil), "cosmos.bank.v1beta1.MsgSetDemurrageSchedule")
+	proto.RegisterType((*MsgSetDemurrageScheduleResponse)(nil), "cosmos.bank.v1beta1.MsgSetDemurrageScheduleResponse")
+}
+
+func init() { proto.RegisterFile("cosmos/bank/v1beta1/tx.proto", fileDescriptor_1d8cb1613481f5b7) }
+
+var fileDescriptor_1d8cb1613481f5b7 = []byte{
+	// 1670 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe4, 0x58, 0xcd, 0x6f, 0x14, 0x47,
+	0x16, 0x77, 0x8f, 0xf1, 0x18, 0x97, 0x6d, 0x58, 0xb7, 0x8d, 0x3f, 0xda, 0xde, 0x19, 0xbb, 0xf1,
+	0xb2, 0x36, 0xe0, 0x19, 0x1b, 0xb0, 0xd9, 0x9d, 0xd5, 0x22, 0x32, 0x1e, 0x90, 0x8c, 0xb0, 0x40,
+	0x63, 0x50, 0x94, 0x5c, 0x46, 0xed, 0xe9, 0x72, 0x4f, 0xcb, 0xd3, 0x5d, 0x9d, 0xae, 0x6a, 0x8c,
+	0x23, 0x45, 0x42, 0xc9, 0x25, 0x89, 0x14, 0x09, 0x29, 0x39, 0x44, 0x39, 0x71, 0x8c, 0x48, 0xa4,
+	0x70, 0x40, 0x22, 0x87, 0x48, 0xb9, 0x12, 0x29, 0x07, 0x84, 0x72, 0x88, 0x72, 0x80, 0x08, 0x0e,
+	0x24, 0xff, 0x45, 0x54, 0xd5, 0xd5, 0xe5, 0x9e, 0x9e, 0xee, 0x19, 0x8f, 0x83, 0x72, 0xc9, 0x05,
+	0x4f, 0xd7, 0xfb, 0xbd, 0x8f, 0x5f, 0xbd, 0x7a, 0xaf, 0x5e, 0x01, 0xa6, 0xaa, 0x08, 0x5b, 0x08,
+	0xe7, 0x37, 0x35, 0x7b, 0x3b, 0x7f, 0x6b, 0x69, 0x13, 0x12, 0x6d, 0x29, 0x4f, 0x6e, 0xe7, 0x1c,
+	0x17, 0x11, 0x24, 0x0f, 0xfb, 0xd2, 0x1c, 0x95, 0xe6, 0xb8, 0x54, 0x19, 0x31, 0x90, 0x81, 0x98,
+	0x3c, 0x4f, 0x7f, 0xf9, 0x50, 0x25, 0x23, 0x0c, 0x61, 0x28, 0x0c, 0x55, 0x91, 0x69, 0x37, 0xc9,
+	0x43, 0x8e, 0x98, 0x5d, 0x5f, 0x3e, 0xe1, 0xcb, 0x2b, 0xbe, 0x61, 0xee, 0xd7, 0x17, 0x8d, 0x71,
+	0x55, 0x0b, 0x1b, 0xf9, 0x5b, 0x4b, 0xf4, 0x0f, 0x17, 0x0c, 0x69, 0x96, 0x69, 0xa3, 0x3c, 0xfb,
+	0x97, 0x2f, 0x65, 0x0d, 0x84, 0x8c, 0x3a, 0xcc, 0xb3, 0xaf, 0x4d, 0x6f, 0x2b, 0x4f, 0x4c, 0x0b,
+	0x62, 0xa2, 0x59, 0x4e, 0x10, 0x47, 0x14, 0xa0, 0x7b, 0xae, 0x46, 0x4c, 0xc4, 0xe3, 0x54, 0xbf,
+	0x4e, 0x81, 0xde, 0x75, 0x6c, 0x6c, 0x40, 0x5b, 0x97, 0xff, 0x07, 0x06, 0xb6, 0x5c, 0x64, 0x55,
+	0x34, 0x5d, 0x77, 0x21, 0xc6, 0xe3, 0xd2, 0xb4, 0x34, 0xd7, 0x57, 0x1c, 0x7f, 0xfa, 0x70, 0x61,
+	0x84, 0x07, 0xf8, 0x86, 0x2f, 0xd9, 0x20, 0xae, 0x69, 0x1b, 0xe5, 0x7e, 0x8a, 0xe6, 0x4b, 0xf2,
+	0x79, 0x00, 0x08, 0x12, 0xaa, 0xa9, 0x36, 0xaa, 0x7d, 0x04, 0x05, 0x8a, 0xbb, 0x20, 0xad, 0x59,
+	0xc8, 0xb3, 0xc9, 0x78, 0xf7, 0x74, 0xf7, 0x5c, 0xff, 0x99, 0x89, 0x9c, 0xc8, 0x02, 0x86, 0x41,
+	0x16, 0x72, 0xab, 0xc8, 0xb4, 0x8b, 0x97, 0x1f, 0x3f, 0xcb, 0x76, 0xdd, 0x7f, 0x9e, 0x9d, 0x33,
+	0x4c, 0x52, 0xf3, 0x36, 0x73, 0x55, 0x64, 0xf1, 0xad, 0xe3, 0x7f, 0x16, 0xb0, 0xbe, 0x9d, 0x27,
+	0xbb, 0x0e, 0xc4, 0x4c, 0x01, 0x7f, 0xf1, 0xea, 0xc1, 0xc9, 0x81, 0x3a, 0x34, 0xb4, 0xea, 0x6e,
+	0x85, 0x26, 0x07, 0x7f, 0xf9, 0xea, 0xc1, 0x49, 0xa9, 0xcc, 0x1d, 0x16, 0x16, 0x3f, 0xbc, 0x97,
+	0xed, 0xfa, 0xed, 0x5e, 0xb6, 0xeb, 0x7d, 0x8a, 0x0b, 0x73, 0xff, 0xf8, 0xd5, 0x83, 0x93, 0x72,
+	0xc8, 0x26, 0xdf, 0x22, 0x75, 0x08, 0x1c, 0xe5, 0x3f, 0xcb, 0x10, 0x3b, 0xc8, 0xc6, 0x50, 0xfd,
+	0x4e, 0x02, 0x03, 0xeb, 0xd8, 0x58, 0xf7, 0xea, 0xc4, 0x64, 0xdb, 0xf8, 0x7f, 0x90, 0x36, 0x6d,
+	0xc7, 0x23, 0x74, 0x03, 0x29, 0x21, 0x25, 0x17, 0x73, 0xac, 0x72, 0x6b, 0x14, 0x52, 0xec, 0xa3,
+	0x8c, 0x78, 0x50, 0xbe, 0x92, 0x7c, 0x11, 0xf4, 0x22, 0x8f, 0x30, 0xfd, 0x14, 0xd3, 0x9f, 0x8c,
+	0xd5, 0xbf, 0xe6, 0x91, 0x88, 0x81, 0x40, 0xad, 0x70, 0x2a, 0xa0, 0xc4, 0x4d, 0x52, 0x32, 0x63,
+	0x8d, 0x64, 0x44, 0xb4, 0xea, 0x28, 0x18, 0x09, 0x7f, 0x0b, 0x5a, 0xdf, 0x4b, 0x8c, 0xea, 0x4d,
+	0x47, 0xd7, 0x08, 0xbc, 0xae, 0xb9, 0x9a, 0x85, 0xe5, 0x15, 0xd0, 0xa7, 0x79, 0xa4, 0x86, 0x5c,
+	0x93, 0xec, 0xb6, 0x3d, 0x1d, 0x7b, 0x50, 0xf9, 0x02, 0x48, 0x3b, 0xcc, 0x02, 0x3b, 0x17, 0x49,
+	0x8c, 0x7c, 0x27, 0x0d, 0x5b, 0xe2, 0x6b, 0x15, 0xce, 0x51, 0x32, 0x7b, 0xf6, 0x28, 0x9f, 0x99,
+	0x10, 0x9f, 0xdb, 0x7e, 0x95, 0x45, 0xa2, 0x55, 0x27, 0xc0, 0x58, 0x64, 0x49, 0x90, 0xfb, 0x5d,
+	0x02, 0x43, 0x2c, 0x8f, 0x84, 0x72, 0xbe, 0x64, 0x6b, 0x9b, 0x75, 0xa8, 0x1f, 0x98, 0xde, 0x2a,
+	0x18, 0xc0, 0xd0, 0xd6, 0x2b, 0xd0, 0xb7, 0xc3, 0xd3, 0x36, 0x1d, 0x4b, 0x32, 0xe4, 0xaf, 0xdc,
+	0x8f, 0x43, 0xce, 0x4f, 0x80, 0xa3, 0x1e, 0x86, 0x15, 0x1d, 0x6e, 0x69, 0x5e, 0x9d, 0x54, 0xb6,
+	0x90, 0xcb, 0xea, 0xa1, 0xaf, 0x3c, 0xe8, 0x61, 0x58, 0xf2, 0x57, 0x2f, 0x23, 0xb7, 0x90, 0x6f,
+	0xde, 0x8b, 0xa9, 0xe8, 0x41, 0x0d, 0xb3, 0x52, 0x27, 0xc1, 0x44, 0xd3, 0xa2, 0xd8, 0x88, 0x1f,
+	0x25, 0x30, 0x2a, 0x36, 0xa9, 0x04, 0x6d, 0x64, 0xad, 0x43, 0xa2, 0xe9, 0x1a, 0xd1, 0x0e, 0xbc,
+	0x1b, 0x25, 0x70, 0xd8, 0xe2, 0x36, 0x78, 0xba, 0xff, 0x19, 0xbb, 0x13, 0x81, 0xa3, 0x70, 0xc2,
+	0x85, 0x66, 0xbb, 0x94, 0xc7, 0xc7, 0xac, 0x4e, 0x83, 0x4c, 0xbc, 0x44, 0x10, 0xfe, 0x56, 0x02,
+	0xe3, 0xeb, 0xd8, 0x58, 0x75, 0xa1, 0x46, 0x60, 0x51, 0xab, 0x6b, 0x76, 0x15, 0x6e, 0xd8, 0x9a,
+	0x83, 0x6b, 0x88, 0x1c, 0x98, 0xf2, 0x08, 0xe8, 0xd1, 0xa9, 0x37, 0xbf, 0xed, 0x95, 0xfd, 0x0f,
+	0x79, 0x14, 0xa4, 0x6b, 0xd0, 0x34, 0x6a, 0xb4, 0xb1, 0x49, 0x73, 0xdd, 0x65, 0xfe, 0x55, 0x58,
+	0x69, 0xa6, 0x76, 0xbc, 0x91, 0x5a, 0x6c, 0x74, 0xea, 0x2a, 0x98, 0x4e, 0x92, 0x05, 0xf4, 0xe4,
+	0x2c, 0xe8, 0xc7, 0x7c, 0xad, 0x62, 0xea, 0x8c, 0xc3, 0xa1, 0x32, 0x08, 0x96, 0xd6, 0x74, 0xf5,
+	0x2b, 0x9f, 0x7f, 0x09, 0xd6, 0xe1, 0xeb, 0xe3, 0x1f, 0xf1, 0x9a, 0x8a, 0x7a, 0xdd, 0x07, 0xe5,
+	0xd8, 0x80, 0x54, 0x15, 0x4c, 0x27, 0xc9, 0x44, 0x46, 0x7f, 0xf0, 0xfb, 0x6f, 0x51, 0x23, 0xd5,
+	0xda, 0x9f, 0xbf, 0xc6, 0x2e, 0x45, 0xbb, 0x6f, 0x7c, 0x19, 0x33, 0x6f, 0x2d, 0x5a, 0xf0, 0x72,
+	0xcb, 0x9b, 0x25, 0xd2, 0x8c, 0x45, 0xe8, 0xbc, 0x19, 0x8b, 0x6f, 0xc1, 0xf1, 0xb3, 0x54, 0x50,
+	0xc4, 0xeb, 0xa6, 0x4d, 0x8a, 0x9e, 0x6b, 0x5f, 0x87, 0xae, 0x65, 0x62, 0x6c, 0x22, 0xfb, 0xe0,
+	0x6d, 0xf9, 0x26, 0xe8, 0x77, 0xf6, 0xcc, 0x70, 0xbe, 0x73, 0xf1, 0xc5, 0xda, 0xec, 0x36, 0xcc,
+	0x3b, 0x6c, 0x47, 0xce, 0x83, 0x61, 0xcf, 0x76, 0x21, 0x26, 0xae, 0x59, 0x25, 0x50, 0xaf, 0xb0,
+	0x6a, 0xc0, 0xbc, 0x9b, 0xc9, 0x61, 0x11, 0x2b, 0x51, 0x5c, 0x38, 0xdf, 0x7c, 0x3a, 0x66, 0x9b,
+	0x5a, 0x5a, 0x4c, 0x04, 0xea, 0x71, 0x30, 0x93, 0x28, 0x14, 0x7b, 0xf7, 0xa9, 0xc4, 0x36, 0x75,
+	0x03, 0x92, 0x92, 0x87, 0xc9, 0xc6, 0x0e, 0x84, 0xce, 0x35, 0x87, 0xac, 0xd9, 0xf2, 0x19, 0xd0,
+	0xbb, 0xdf, 0x23, 0x12, 0x00, 0xe5, 0x63, 0x20, 0x8d, 0x1c, 0x52, 0x31, 0x6d, 0x76, 0xc8, 0x0f,
+	0x97, 0x7b, 0x10, 0x35, 0x55, 0x58, 0xa4, 0x0c, 0x7a, 0x43, 0x19, 0xce, 0x36, 0xc5, 0xdf, 0xe8,
+	0x5c, 0xcd, 0x80, 0xa9, 0xb8, 0x75, 0x11, 0xf5, 0x4f, 0xdd, 0x60, 0x98, 0x4f, 0x1a, 0x6f, 0x9a,
+	0xa4, 0x76, 0xc3, 0xb4, 0x60, 0x1d, 0x55, 0xb7, 0xff, 0x76, 0x33, 0x9a, 0x7c, 0x05, 0xf4, 0x7b,
+	0x36, 0xa5, 0x5e, 0xa1, 0xa3, 0xed, 0xf8, 0x21, 0x76, 0xa3, 0x28, 0x39, 0x7f, 0xac, 0xcd, 0x05,
+	0x63, 0x6d, 0xee, 0x46, 0x30, 0xf7, 0x16, 0x07, 0x69, 0x00, 0x77, 0x9f, 0x67, 0x25, 0xdf, 0x0e,
+	0xf0, 0xb5, 0xa9, 0x9c, 0xda, 0x82, 0xb7, 0x1d, 0xd3, 0xdd, 0xf5, 0x6d, 0xf5, 0x74, 0x6c, 0xcb,
+	0xd7, 0xa6, 0x72, 0xff, 0x82, 0x6a, 0xaa, 0xec, 0x4c, 0xf3, 0xcc, 0x18, 0x4e, 0x9f, 0x7a, 0x01,
+	0x4c, 0xc6, 0x2c, 0x87, 0xdb, 0x37, 0x71, 0x35, 0x1b, 0x6f, 0x41, 0x37, 0xd4, 0xbe, 0x83, 0xa5,
+	0x35, 0x5d, 0xfd, 0x46, 0x02, 0x0a, 0xbd, 0x04, 0xea, 0x9a, 0x69, 0x05, 0xda, 0x50, 0xbf, 0xc1,
+	0x01, 0xb4, 0x13, 0xb8, 0xb0, 0x6a, 0x3a, 0x26, 0xb4, 0x49, 0xfb, 0x4e, 0x20, 0xa0, 0x51, 0xbf,
+	0xa9, 0xa8, 0xdf, 0xc2, 0x7f, 0x58, 0x89, 0x0a, 0x05, 0x4a, 0xf5, 0x5f, 0x91, 0x3b, 0x2b, 0x3e,
+	0x24, 0x75, 0x16, 0xa8, 0xc9, 0x52, 0x71, 0xdc, 0xef, 0x4b, 0xac, 0x1e, 0xca, 0xb0, 0x9a, 0xc0,
+	0x6c, 0x11, 0xa4, 0xe9, 0xb4, 0x04, 0xdd, 0xb6, 0xb4, 0x38, 0xae, 0x3d, 0x27, 0xd6, 0x76, 0x38,
+	0x9a, 0x12, 0xfa, 0x77, 0x23, 0xa1, 0xc4, 0x58, 0xd4, 0x13, 0x60, 0xb6, 0x95, 0x5c, 0x90, 0x7a,
+	0x94, 0xe2, 0x35, 0x4c, 0x36, 0x1c, 0x68, 0xeb, 0xa6, 0x6d, 0x5c, 0x35, 0x2d, 0x93, 0x1c, 0xa8,
+	0xf1, 0xec, 0x82, 0x74, 0x9d, 0x2a, 0x07, 0x6d, 0xfa, 0xaf, 0xa8, 0x40, 0xdf, 0xa1, 0x7c, 0x11,
+	0xa4, 0x77, 0x4c, 0x5b, 0x47, 0x3b, 0x6c, 0x8e, 0xa1, 0xae, 0xa3, 0x05, 0x53, 0xe2, 0x6f, 0x4a,
+	0xbf, 0x5e, 0x3e, 0x17, 0xf5, 0xc2, 0xf5, 0xfc, 0x99, 0xb5, 0xb7, 0x55, 0x99, 0x34, 0xee, 0x90,
+	0x8a, 0xc0, 0x64, 0xcc, 0xb2, 0x28, 0x93, 0xeb, 0xe0, 0x08, 0xdc, 0xda, 0x82, 0x55, 0x62, 0xde,
+	0x82, 0x7e, 0x29, 0x4b, 0x9d, 0x96, 0xf2, 0xa0, 0x30, 0x40, 0x21, 0xea, 0x27, 0x29, 0x30, 0xc6,
+	0xfb, 0x31, 0xb4, 0x3c, 0xd7, 0xd5, 0x0c, 0xb8, 0x51, 0xad, 0x41, 0xdd, 0xab, 0xc3, 0xd7, 0x3c,
+	0x15, 0x5e, 0x01, 0x87, 0x5c, 0x8d, 0x40, 0xb6, 0x97, 0x7d, 0xc5, 0x15, 0x1a, 0xd5, 0x2f, 0xcf,
+	0xb2, 0x93, 0xbe, 0x31, 0xac, 0x6f, 0xe7, 0x4c, 0x94, 0xb7, 0x34, 0x52, 0xcb, 0x5d, 0x65, 0x09,
+	0x29, 0xc1, 0xea, 0xd3, 0x87, 0x0b, 0x80, 0xfb, 0x2a, 0xc1, 0xaa, 0x1f, 0x3e, 0xb3, 0x21, 0xcf,
+	0x80, 0x01, 0xe8, 0xa0, 0x6a, 0xad, 0x52, 0x87, 0xb6, 0x41, 0x6a, 0xac, 0x39, 0x76, 0x97, 0xfb,
+	0xd9, 0xda, 0x55, 0xb6, 0x54, 0x58, 0x6e, 0xbe, 0x5b, 0xd5, 0xe6, 0xbb, 0x29, 0xca, 0x59, 0x9d,
+	0x01, 0xd9, 0x04, 0x51, 0x90, 0x84, 0x33, 0x8f, 0x06, 0x41, 0xf7, 0x3a, 0x36, 0x28, 0x21, 0x36,
+	0x76, 0x4d, 0xc5, 0x0f, 0x0e, 0x7e, 0xb7, 0x53, 0x66, 0x5b, 0x49, 0x45, 0x62, 0xdf, 0x02, 0x7d,
+	0x7b, 0xef, 0xe8, 0x99, 0x24, 0x15, 0x01, 0x51, 0xe6, 0xdb, 0x42, 0x84, 0xe9, 0x4d, 0x30, 0xd0,
+	0xf0, 0x96, 0x4d, 0x0c, 0x28, 0x8c, 0x52, 0x4e, 0xef, 0x07, 0x25, 0x7c, 0xd4, 0xc0, 0x91, 0xc8,
+	0x93, 0xf2, 0x44, 0x32, 0xed, 0x30, 0x4e, 0xc9, 0xed, 0x0f, 0x27, 0x3c, 0xed, 0x80, 0xe1, 0xb8,
+	0x37, 0xdb, 0xa9, 0xd6, 0xe1, 0x36, 0x80, 0x95, 0xb3, 0x1d, 0x80, 0x85, 0xe3, 0xf7, 0xc0, 0xb1,
+	0xf8, 0xb7, 0xd3, 0x42, 0x92, 0xb5, 0x58, 0xb8, 0xb2, 0xdc, 0x11, 0x3c, 0xec, 0x3e, 0xfe, 0xe9,
+	0x92, 0xe8, 0x3e, 0x16, 0xae, 0x2c, 0x77, 0x04, 0x0f, 0x9f, 0xcf, 0xbd, 0x77, 0x46, 0xe2, 0xf9,
+	0x14, 0x10, 0x65, 0xbe, 0x2d, 0x44, 0x98, 0xbe, 0x23, 0x81, 0xd1, 0x84, 0xf9, 0xbe, 0xd5, 0xe1,
+	0x88, 0xc1, 0x2b, 0x2b, 0x9d, 0xe1, 0x45, 0x08, 0xef, 0x80, 0xa1, 0xe6, 0x29, 0x79, 0xbe, 0x85,
+	0xb1, 0x46, 0xa8, 0xb2, 0xb4, 0x6f, 0xa8, 0x70, 0x69, 0x83, 0x7f, 0x34, 0x8d, 0xb8, 0x73, 0xad,
+	0x5a, 0x45, 0x18, 0xa9, 0x2c, 0xee, 0x17, 0x29, 0xfc, 0x7d, 0x20, 0x81, 0xb1, 0xa4, 0xe1, 0x29,
+	0x9f, 0x78, 0x24, 0xe3, 0x15, 0x94, 0xf3, 0x1d, 0x2a, 0x88, 0x28, 0x3e, 0x92, 0xc0, 0x44, 0xf2,
+	0xa8, 0x93, 0xb8, 0x8d, 0x89, 0x2a, 0xca, 0x7f, 0x3b, 0x56, 0x69, 0xcc, 0x40, 0x64, 0x40, 0x99,
+	0x6b, 0xd5, 0x8d, 0xc2, 0x48, 0x65, 0x71, 0xbf, 0x48, 0xe1, 0xef, 0x5d, 0x30, 0x12, 0x7b, 0xcb,
+	0x9e, 0x6e, 0x75, 0x78, 0xa2, 0x68, 0xe5, 0x5c, 0x27, 0xe8, 0xc0, 0xb7, 0xd2, 0x73, 0x87, 0x5e,
+	0x9e, 0xc5, 0xd5, 0xc7, 0x2f, 0x32, 0xd2, 0x93, 0x17, 0x19, 0xe9, 0xd7, 0x17, 0x19, 0xe9, 0xee,
+	0xcb, 0x4c, 0xd7, 0x93, 0x97, 0x99, 0xae, 0x9f, 0x5f, 0x66, 0xba, 0xde, 0x9e, 0x6f, 0x39, 0x32,
+	0xf1, 0xff, 0x67, 0x64, 0x93, 0xd3, 0x66, 0x9a, 0xcd, 0x18, 0x67, 0xff, 0x18, 0x00, 0xc9, 0x2a,
+	0x48, 0x3d, 0x52, 0x18, 0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// MsgClient is the client API for Msg service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type MsgClient interface {
+	// Send defines a method for sending coins from one account to another account.
+	Send(ctx context.Context, in *MsgSend, opts ...grpc.CallOption) (*MsgSendResponse, error)
+	// MultiSend defines a method for sending coins from some accounts to other accounts.
+	MultiSend(ctx context.Context, in *MsgMultiSend, opts ...grpc.CallOption) (*MsgMultiSendResponse, error)
+	// UpdateParams defines a governance operation for updating the x/bank module parameters.
+	// The authority is defined in the keeper.
+	//
+	// Since: cosmos-sdk 0.47
+	UpdateParams(ctx context.Context, in *MsgUpdateParams, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error)
+	// SetSendEnabled is a governance operation for setting the SendEnabled flag
+	// on any number of Denoms. Only the entries to add or update should be
+	// included. Entries that already exist in the store, but that aren't
+	// included in this message, will be left unchanged.
+	//
+	// Since: cosmos-sdk 0.47
+	SetSendEnabled(ctx context.Context, in *MsgSetSendEnabled, opts ...grpc.CallOption) (*MsgSetSendEnabledResponse, error)
+	// UpdateDenomMetadata is a governance operation for setting the client
+	// metadata of a denomination, e.g. to fix a wrong display exponent without a
+	// software upgrade.
+	//
+	// Since: cosmos-sdk 0.48
+	UpdateDenomMetadata(ctx context.Context, in *MsgUpdateDenomMetadata, opts ...grpc.CallOption) (*MsgUpdateDenomMetadataResponse, error)
+	// CreateBalanceSnapshot is a governance operation for recording a merkleized
+	// snapshot of the balances of all the holders of a denomination at a given
+	// height, e.g. for an airdrop.
+	//
+	// Since: cosmos-sdk 0.48
+	CreateBalanceSnapshot(ctx context.Context, in *MsgCreateBalanceSnapshot, opts ...grpc.CallOption) (*MsgCreateBalanceSnapshotResponse, error)
+	// DeleteBalanceSnapshot is a governance operation for deleting a balance
+	// snapshot and its recorded balances once they are no longer needed.
+	//
+	// Since: cosmos-sdk 0.48
+	DeleteBalanceSnapshot(ctx context.Context, in *MsgDeleteBalanceSnapshot, opts ...grpc.CallOption) (*MsgDeleteBalanceSnapshotResponse, error)
+	// BatchSend defines a method for sending coins from one account to many
+	// accounts, with an optional memo per output emitted in its transfer event.
+	//
+	// Since: cosmos-sdk 0.48
+	BatchSend(ctx context.Context, in *MsgBatchSend, opts ...grpc.CallOption) (*MsgBatchSendResponse, error)
+	// SetMintBurnPermissions is a governance operation for setting the modules
+	// allowed to mint and burn denominations.
+	//
+	// Since: cosmos-sdk 0.48
+	SetMintBurnPermissions(ctx context.Context, in *MsgSetMintBurnPermissions, opts ...grpc.CallOption) (*MsgSetMintBurnPermissionsResponse, error)
+	// SetDustSweepOptIn defines a method for an account to opt in, or out of,
+	// the sweeping of its dust balances.
+	//
+	// Since: cosmos-sdk 0.48
+	SetDustSweepOptIn(ctx context.Context, in *MsgSetDustSweepOptIn, opts ...grpc.CallOption) (*MsgSetDustSweepOptInResponse, error)
+	// SendWithTimelock defines a method for sending coins claimable by their
+	// recipient once an unlock time is reached, and reclaimable by their sender
+	// once an expiry time is reached if still unclaimed.
+	//
+	// Since: cosmos-sdk 0.48
+	SendWithTimelock(ctx context.Context, in *MsgSendWithTimelock, opts ...grpc.CallOption) (*MsgSendWithTimelockResponse, error)
+	// ClaimTimelockedTransfer defines a method for the recipient of a
+	// time-locked transfer to claim its coins.
+	//
+	// Since: cosmos-sdk 0.48
+	ClaimTimelockedTransfer(ctx context.Context, in *MsgClaimTimelockedTransfer, opts ...grpc.CallOption) (*MsgClaimTimelockedTransferResponse, error)
+	// ReclaimTimelockedTransfer defines a method for the sender of an expired
+	// time-locked transfer to reclaim its coins.
+	//
+	// Since: cosmos-sdk 0.48
+	ReclaimTimelockedTransfer(ctx context.Context, in *MsgReclaimTimelockedTransfer, opts ...grpc.CallOption) (*MsgReclaimTimelockedTransferResponse, error)
+	// SetSpendingLimit defines a method for an account to attach, change or
+	// remove a spending limit limiting its outgoing transfers.
+	//
+	// Since: cosmos-sdk 0.48
+	SetSpendingLimit(ctx context.Context, in *MsgSetSpendingLimit, opts ...grpc.CallOption) (*MsgSetSpendingLimitResponse, error)
+	// SetDemurrageSchedule is a governance operation for setting the decay, or
+	// the expiry, schedule of the balances of a denomination.
+	//
+	// Since: cosmos-sdk 0.48
+	SetDemurrageSchedule(ctx context.Context, in *MsgSetDemurrageSchedule, opts ...grpc.CallOption) (*MsgSetDemurrageScheduleResponse, error)
+}
+
+type msgClient struct {
+	cc grpc1.ClientConn
+}
+
+func NewMsgClient(cc grpc1.ClientConn) MsgClient {
+	return &msgClient{cc}
+}
+
+func (c *msgClient) Send(ctx context.Context, in *MsgSend, opts ...grpc.CallOption) (*MsgSendResponse, error) {
+	out := new(MsgSendResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.bank.v1beta1.Msg/Send", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) MultiSend(ctx context.Context, in *MsgMultiSend, opts ...grpc.CallOption) (*MsgMultiSendResponse, error) {
+	out := new(MsgMultiSendResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.bank.v1beta1.Msg/MultiSend", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) UpdateParams(ctx context.Context, in *MsgUpdateParams, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error) {
+	out := new(MsgUpdateParamsResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.bank.v1beta1.Msg/UpdateParams", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) SetSendEnabled(ctx context.Context, in *MsgSetSendEnabled, opts ...grpc.CallOption) (*MsgSetSendEnabledResponse, error) {
+	out := new(MsgSetSendEnabledResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.bank.v1beta1.Msg/SetSendEnabled", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) UpdateDenomMetadata(ctx context.Context, in *MsgUpdateDenomMetadata, opts ...grpc.CallOption) (*MsgUpdateDenomMetadataResponse, error) {
+	out := new(MsgUpdateDenomMetadataResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.bank.v1beta1.Msg/UpdateDenomMetadata", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) CreateBalanceSnapshot(ctx context.Context, in *MsgCreateBalanceSnapshot, opts ...grpc.CallOption) (*MsgCreateBalanceSnapshotResponse, error) {
+	out := new(MsgCreateBalanceSnapshotResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.bank.v1beta1.Msg/CreateBalanceSnapshot", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) DeleteBalanceSnapshot(ctx context.Context, in *MsgDeleteBalanceSnapshot, opts ...grpc.CallOption) (*MsgDeleteBalanceSnapshotResponse, error) {
+	out := new(MsgDeleteBalanceSnapshotResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.bank.v1beta1.Msg/DeleteBalanceSnapshot", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) BatchSend(ctx context.Context, in *MsgBatchSend, opts ...grpc.CallOption) (*MsgBatchSendResponse, error) {
+	out := new(MsgBatchSendResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.bank.v1beta1.Msg/BatchSend", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) SetMintBurnPermissions(ctx context.Context, in *MsgSetMintBurnPermissions, opts ...grpc.CallOption) (*MsgSetMintBurnPermissionsResponse, error) {
+	out := new(MsgSetMintBurnPermissionsResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.bank.v1beta1.Msg/SetMintBurnPermissions", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) SetDustSweepOptIn(ctx context.Context, in *MsgSetDustSweepOptIn, opts ...grpc.CallOption) (*MsgSetDustSweepOptInResponse, error) {
+	out := new(MsgSetDustSweepOptInResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.bank.v1beta1.Msg/SetDustSweepOptIn", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) SendWithTimelock(ctx context.Context, in *MsgSendWithTimelock, opts ...grpc.CallOption) (*MsgSendWithTimelockResponse, error) {
+	out := new(MsgSendWithTimelockResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.bank.v1beta1.Msg/SendWithTimelock", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) ClaimTimelockedTransfer(ctx context.Context, in *MsgClaimTimelockedTransfer, opts ...grpc.CallOption) (*MsgClaimTimelockedTransferResponse, error) {
+	out := new(MsgClaimTimelockedTransferResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.bank.v1beta1.Msg/ClaimTimelockedTransfer", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) ReclaimTimelockedTransfer(ctx context.Context, in *MsgReclaimTimelockedTransfer, opts ...grpc.CallOption) (*MsgReclaimTimelockedTransferResponse, error) {
+	out := new(MsgReclaimTimelockedTransferResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.bank.v1beta1.Msg/ReclaimTimelockedTransfer", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) SetSpendingLimit(ctx context.Context, in *MsgSetSpendingLimit, opts ...grpc.CallOption) (*MsgSetSpendingLimitResponse, error) {
+	out := new(MsgSetSpendingLimitResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.bank.v1beta1.Msg/SetSpendingLimit", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) SetDemurrageSchedule(ctx context.Context, in *MsgSetDemurrageSchedule, opts ...grpc.CallOption) (*MsgSetDemurrageScheduleResponse, error) {
+	out := new(MsgSetDemurrageScheduleResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.bank.v1beta1.Msg/SetDemurrageSchedule", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// MsgServer is the server API for Msg service.
+type MsgServer interface {
+	// Send defines a method for sending coins from one account to another account.
+	Send(context.Context, *MsgSend) (*MsgSendResponse, error)
+	// MultiSend defines a method for sending coins from some accounts to other accounts.
+	MultiSend(context.Context, *MsgMultiSend) (*MsgMultiSendResponse, error)
+	// UpdateParams defines a governance operation for updating the x/bank module parameters.
+	// The authority is defined in the keeper.
+	//
+	// Since: cosmos-sdk 0.47
+	UpdateParams(context.Context, *MsgUpdateParams) (*MsgUpdateParamsResponse, error)
+	// SetSendEnabled is a governance operation for setting the SendEnabled flag
+	// on any number of Denoms. Only the entries to add or update should be
+	// included. Entries that already exist in the store, but that aren't
+	// included in this message, will be left unchanged.
+	//
+	// Since: cosmos-sdk 0.47
+	SetSendEnabled(context.Context, *MsgSetSendEnabled) (*MsgSetSendEnabledResponse, error)
+	// UpdateDenomMetadata is a governance operation for setting the client
+	// metadata of a denomination, e.g. to fix a wrong display exponent without a
+	// software upgrade.
+	//
+	// Since: cosmos-sdk 0.48
+	UpdateDenomMetadata(context.Context, *MsgUpdateDenomMetadata) (*MsgUpdateDenomMetadataResponse, error)
+	// CreateBalanceSnapshot is a governance operation for recording a merkleized
+	// snapshot of the balances of all the holders of a denomination at a given
+	// height, e.g. for an airdrop.
+	//
+	// Since: cosmos-sdk 0.48
+	CreateBalanceSnapshot(context.Context, *MsgCreateBalanceSnapshot) (*MsgCreateBalanceSnapshotResponse, error)
+	// DeleteBalanceSnapshot is a governance operation for deleting a balance
+	// snapshot and its recorded balances once they are no longer needed.
+	//
+	// Since: cosmos-sdk 0.48
+	DeleteBalanceSnapshot(context.Context, *MsgDeleteBalanceSnapshot) (*MsgDeleteBalanceSnapshotResponse, error)
+	// BatchSend defines a method for sending coins from one account to many
+	// accounts, with an optional memo per output emitted in its transfer event.
+	//
+	// Since: cosmos-sdk 0.48
+	BatchSend(context.Context, *MsgBatchSend) (*MsgBatchSendResponse, error)
+	// SetMintBurnPermissions is a governance operation for setting the modules
+	// allowed to mint and burn denominations.
+	//
+	// Since: cosmos-sdk 0.48
+	SetMintBurnPermissions(context.Context, *MsgSetMintBurnPermissions) (*MsgSetMintBurnPermissionsResponse, error)
+	// SetDustSweepOptIn defines a method for an account to opt in, or out of,
+	// the sweeping of its dust balances.
+	//
+	// Since: cosmos-sdk 0.48
+	SetDustSweepOptIn(context.Context, *MsgSetDustSweepOptIn) (*MsgSetDustSweepOptInResponse, error)
+	// SendWithTimelock defines a method for sending coins claimable by their
+	// recipient once an unlock time is reached, and reclaimable by their sender
+	// once an expiry time is reached if still unclaimed.
+	//
+	// Since: cosmos-sdk 0.48
+	SendWithTimelock(context.Context, *MsgSendWithTimelock) (*MsgSendWithTimelockResponse, error)
+	// ClaimTimelockedTransfer defines a method for the recipient of a
+	// time-locked transfer to claim its coins.
+	//
+	// Since: cosmos-sdk 0.48
+	ClaimTimelockedTransfer(context.Context, *MsgClaimTimelockedTransfer) (*MsgClaimTimelockedTransferResponse, error)
+	// ReclaimTimelockedTransfer defines a method for the sender of an expired
+	// time-locked transfer to reclaim its coins.
+	//
+	// Since: cosmos-sdk 0.48
+	ReclaimTimelockedTransfer(context.Context, *MsgReclaimTimelockedTransfer) (*MsgReclaimTimelockedTransferResponse, error)
+	// SetSpendingLimit defines a method for an account to attach, change or
+	// remove a spending limit limiting its outgoing transfers.
+	//
+	// Since: cosmos-sdk 0.48
+	SetSpendingLimit(context.Context, *MsgSetSpendingLimit) (*MsgSetSpendingLimitResponse, error)
+	// SetDemurrageSchedule is a governance operation for setting the decay, or
+	// the expiry, schedule of the balances of a denomination.
+	//
+	// Since: cosmos-sdk 0.48
+	SetDemurrageSchedule(context.Context, *MsgSetDemurrageSchedule) (*MsgSetDemurrageScheduleResponse, error)
+}
+
+// UnimplementedMsgServer can be embedded to have forward compatible implementations.
+type UnimplementedMsgServer struct {
+}
+
+func (*UnimplementedMsgServer) Send(ctx context.Context, req *MsgSend) (*MsgSendResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Send not implemented")
+}
+func (*UnimplementedMsgServer) MultiSend(ctx context.Context, req *MsgMultiSend) (*MsgMultiSendResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MultiSend not implemented")
+}
+func (*UnimplementedMsgServer) UpdateParams(ctx context.Context, req *MsgUpdateParams) (*MsgUpdateParamsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateParams not implemented")
+}
+func (*UnimplementedMsgServer) SetSendEnabled(ctx context.Context, req *MsgSetSendEnabled) (*MsgSetSendEnabledResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetSendEnabled not implemented")
+}
+func (*UnimplementedMsgServer) UpdateDenomMetadata(ctx context.Context, req *MsgUpdateDenomMetadata) (*MsgUpdateDenomMetadataResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateDenomMetadata not implemented")
+}
+func (*UnimplementedMsgServer) CreateBalanceSnapshot(ctx context.Context, req *MsgCreateBalanceSnapshot) (*MsgCreateBalanceSnapshotResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateBalanceSnapshot not implemented")
+}
+func (*UnimplementedMsgServer) DeleteBalanceSnapshot(ctx context.Context, req *MsgDeleteBalanceSnapshot) (*MsgDeleteBalanceSnapshotResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteBalanceSnapshot not implemented")
+}
+func (*UnimplementedMsgServer) BatchSend(ctx context.Context, req *MsgBatchSend) (*MsgBatchSendResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BatchSend not implemented")
+}
+func (*UnimplementedMsgServer) SetMintBurnPermissions(ctx context.Context, req *MsgSetMintBurnPermissions) (*MsgSetMintBurnPermissionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetMintBurnPermissions not implemented")
+}
+func (*UnimplementedMsgServer) SetDustSweepOptIn(ctx context.Context, req *MsgSetDustSweepOptIn) (*MsgSetDustSweepOptInResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetDustSweepOptIn not implemented")
+}
+func (*UnimplementedMsgServer) SendWithTimelock(ctx context.Context, req *MsgSendWithTimelock) (*MsgSendWithTimelockResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SendWithTimelock not implemented")
+}
+func (*UnimplementedMsgServer) ClaimTimelockedTransfer(ctx context.Context, req *MsgClaimTimelockedTransfer) (*MsgClaimTimelockedTransferResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ClaimTimelockedTransfer not implemented")
+}
+func (*UnimplementedMsgServer) ReclaimTimelockedTransfer(ctx context.Context, req *MsgReclaimTimelockedTransfer) (*MsgReclaimTimelockedTransferResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReclaimTimelockedTransfer not implemented")
+}
+func (*UnimplementedMsgServer) SetSpendingLimit(ctx context.Context, req *MsgSetSpendingLimit) (*MsgSetSpendingLimitResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetSpendingLimit not implemented")
+}
+func (*UnimplementedMsgServer) SetDemurrageSchedule(ctx context.Context, req *MsgSetDemurrageSchedule) (*MsgSetDemurrageScheduleResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetDemurrageSchedule not implemented")
+}
+
+func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
+	s.RegisterService(&_Msg_serviceDesc, srv)
+}
+
+func _Msg_Send_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSend)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).Send(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.bank.v1beta1.Msg/Send",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).Send(ctx, req.(*MsgSend))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_MultiSend_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgMultiSend)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).MultiSend(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.bank.v1beta1.Msg/MultiSend",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).MultiSend(ctx, req.(*MsgMultiSend))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_UpdateParams_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUpdateParams)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).UpdateParams(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.bank.v1beta1.Msg/UpdateParams",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UpdateParams(ctx, req.(*MsgUpdateParams))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_SetSendEnabled_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSetSendEnabled)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SetSendEnabled(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.bank.v1beta1.Msg/SetSendEnabled",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SetSendEnabled(ctx, req.(*MsgSetSendEnabled))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_UpdateDenomMetadata_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUpdateDenomMetadata)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).UpdateDenomMetadata(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.bank.v1beta1.Msg/UpdateDenomMetadata",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UpdateDenomMetadata(ctx, req.(*MsgUpdateDenomMetadata))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_CreateBalanceSnapshot_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgCreateBalanceSnapshot)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).CreateBalanceSnapshot(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.bank.v1beta1.Msg/CreateBalanceSnapshot",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).CreateBalanceSnapshot(ctx, req.(*MsgCreateBalanceSnapshot))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_DeleteBalanceSnapshot_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgDeleteBalanceSnapshot)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).DeleteBalanceSnapshot(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.bank.v1beta1.Msg/DeleteBalanceSnapshot",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).DeleteBalanceSnapshot(ctx, req.(*MsgDeleteBalanceSnapshot))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_BatchSend_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgBatchSend)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).BatchSend(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.bank.v1beta1.Msg/BatchSend",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).BatchSend(ctx, req.(*MsgBatchSend))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_SetMintBurnPermissions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSetMintBurnPermissions)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SetMintBurnPermissions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.bank.v1beta1.Msg/SetMintBurnPermissions",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SetMintBurnPermissions(ctx, req.(*MsgSetMintBurnPermissions))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_SetDustSweepOptIn_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSetDustSweepOptIn)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SetDustSweepOptIn(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.bank.v1beta1.Msg/SetDustSweepOptIn",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SetDustSweepOptIn(ctx, req.(*MsgSetDustSweepOptIn))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_SendWithTimelock_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSendWithTimelock)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SendWithTimelock(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.bank.v1beta1.Msg/SendWithTimelock",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SendWithTimelock(ctx, req.(*MsgSendWithTimelock))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_ClaimTimelockedTransfer_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgClaimTimelockedTransfer)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).ClaimTimelockedTransfer(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.bank.v1beta1.Msg/ClaimTimelockedTransfer",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).ClaimTimelockedTransfer(ctx, req.(*MsgClaimTimelockedTransfer))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_ReclaimTimelockedTransfer_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgReclaimTimelockedTransfer)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).ReclaimTimelockedTransfer(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.bank.v1beta1.Msg/ReclaimTimelockedTransfer",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).ReclaimTimelockedTransfer(ctx, req.(*MsgReclaimTimelockedTransfer))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_SetSpendingLimit_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSetSpendingLimit)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SetSpendingLimit(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.bank.v1beta1.Msg/SetSpendingLimit",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SetSpendingLimit(ctx, req.(*MsgSetSpendingLimit))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_SetDemurrageSchedule_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSetDemurrageSchedule)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SetDemurrageSchedule(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.bank.v1beta1.Msg/SetDemurrageSchedule",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SetDemurrageSchedule(ctx, req.(*MsgSetDemurrageSchedule))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _Msg_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "cosmos.bank.v1beta1.Msg",
+	HandlerType: (*MsgServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Send",
+			Handler:    _Msg_Send_Handler,
+		},
+		{
+			MethodName: "MultiSend",
+			Handler:    _Msg_MultiSend_Handler,
+		},
+		{
+			MethodName: "UpdateParams",
+			Handler:    _Msg_UpdateParams_Handler,
+		},
+		{
+			MethodName: "SetSendEnabled",
+			Handler:    _Msg_SetSendEnabled_Handler,
+		},
+		{
+			MethodName: "UpdateDenomMetadata",
+			Handler:    _Msg_UpdateDenomMetadata_Handler,
+		},
+		{
+			MethodName: "CreateBalanceSnapshot",
+			Handler:    _Msg_CreateBalanceSnapshot_Handler,
+		},
+		{
+			MethodName: "DeleteBalanceSnapshot",
+			Handler:    _Msg_DeleteBalanceSnapshot_Handler,
+		},
+		{
+			MethodName: "BatchSend",
+			Handler:    _Msg_BatchSend_Handler,
+		},
+		{
+			MethodName: "SetMintBurnPermissions",
+			Handler:    _Msg_SetMintBurnPermissions_Handler,
+		},
+		{
+			MethodName: "SetDustSweepOptIn",
+			Handler:    _Msg_SetDustSweepOptIn_Handler,
+		},
+		{
+			MethodName: "SendWithTimelock",
+			Handler:    _Msg_SendWithTimelock_Handler,
+		},
+		{
+			MethodName: "ClaimTimelockedTransfer",
+			Handler:    _Msg_ClaimTimelockedTransfer_Handler,
+		},
+		{
+			MethodName: "ReclaimTimelockedTransfer",
+			Handler:    _Msg_ReclaimTimelockedTransfer_Handler,
+		},
+		{
+			MethodName: "SetSpendingLimit",
+			Handler:    _Msg_SetSpendingLimit_Handler,
+		},
+		{
+			MethodName: "SetDemurrageSchedule",
+			Handler:    _Msg_SetDemurrageSchedule_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "cosmos/bank/v1beta1/tx.proto",
+}
+
+func (m *MsgSend) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSend) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSend) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Amount) > 0 {
+		for iNdEx := len(m.Amount) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Amount[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.ToAddress) > 0 {
+		i -= len(m.ToAddress)
+		copy(dAtA[i:], m.ToAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ToAddress)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.FromAddress) > 0 {
+		i -= len(m.FromAddress)
+		copy(dAtA[i:], m.FromAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.FromAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSendResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSendResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSendResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgMultiSend) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgMultiSend) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgMultiSend) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Outputs) > 0 {
+		for iNdEx := len(m.Outputs) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Outputs[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Inputs) > 0 {
+		for iNdEx := len(m.Inputs) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Inputs[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgMultiSendResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgMultiSendResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgMultiSendResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpdateParams) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateParams) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpdateParamsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateParamsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSetSendEnabled) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetSendEnabled) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetSendEnabled) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.UseDefaultFor) > 0 {
+		for iNdEx := len(m.UseDefaultFor) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.UseDefaultFor[iNdEx])
+			copy(dAtA[i:], m.UseDefaultFor[iNdEx])
+			i = encodeVarintTx(dAtA, i, uint64(len(m.UseDefaultFor[iNdEx])))
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.SendEnabled) > 0 {
+		for iNdEx := len(m.SendEnabled) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.SendEnabled[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSetSendEnabledResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetSendEnabledResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetSendEnabledResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpdateDenomMetadata) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateDenomMetadata) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateDenomMetadata) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Metadata.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpdateDenomMetadataResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateDenomMetadataResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateDenomMetadataResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgCreateBalanceSnapshot) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCreateBalanceSnapshot) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCreateBalanceSnapshot) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Height != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgCreateBalanceSnapshotResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCreateBalanceSnapshotResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCreateBalanceSnapshotResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.SnapshotId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.SnapshotId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgDeleteBalanceSnapshot) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgDeleteBalanceSnapshot) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgDeleteBalanceSnapshot) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.SnapshotId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.SnapshotId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgDeleteBalanceSnapshotResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgDeleteBalanceSnapshotResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgDeleteBalanceSnapshotResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgBatchSend) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgBatchSend) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgBatchSend) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Outputs) > 0 {
+		for iNdEx := len(m.Outputs) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Outputs[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.FromAddress) > 0 {
+		i -= len(m.FromAddress)
+		copy(dAtA[i:], m.FromAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.FromAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgBatchSendResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgBatchSendResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgBatchSendResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSetMintBurnPermissions) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetMintBurnPermissions) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetMintBurnPermissions) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.UnrestrictedDenoms) > 0 {
+		for iNdEx := len(m.UnrestrictedDenoms) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.UnrestrictedDenoms[iNdEx])
+			copy(dAtA[i:], m.UnrestrictedDenoms[iNdEx])
+			i = encodeVarintTx(dAtA, i, uint64(len(m.UnrestrictedDenoms[iNdEx])))
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.Permissions) > 0 {
+		for iNdEx := len(m.Permissions) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Permissions[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSetMintBurnPermissionsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetMintBurnPermissionsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetMintBurnPermissionsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSetDustSweepOptIn) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetDustSweepOptIn) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetDustSweepOptIn) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.OptIn {
+		i--
+		if m.OptIn {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSetDustSweepOptInResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetDustSweepOptInResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetDustSweepOptInResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSendWithTimelock) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSendWithTimelock) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSendWithTimelock) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	n3, err3 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.ExpiryTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.ExpiryTime):])
+	if err3 != nil {
+		return 0, err3
+	}
+	i -= n3
+	i = encodeVarintTx(dAtA, i, uint64(n3))
+	i--
+	dAtA[i] = 0x2a
+	n4, err4 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.UnlockTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.UnlockTime):])
+	if err4 != nil {
+		return 0, err4
+	}
+	i -= n4
+	i = encodeVarintTx(dAtA, i, uint64(n4))
+	i--
+	dAtA[i] = 0x22
+	if len(m.Amount) > 0 {
+		for iNdEx := len(m.Amount) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Amount[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.ToAddress) > 0 {
+		i -= len(m.ToAddress)
+		copy(dAtA[i:], m.ToAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ToAddress)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.FromAddress) > 0 {
+		i -= len(m.FromAddress)
+		copy(dAtA[i:], m.FromAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.FromAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSendWithTimelockResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSendWithTimelockResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSendWithTimelockResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.TransferId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.TransferId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgClaimTimelockedTransfer) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgClaimTimelockedTransfer) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgClaimTimelockedTransfer) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.TransferId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.TransferId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Recipient) > 0 {
+		i -= len(m.Recipient)
+		copy(dAtA[i:], m.Recipient)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Recipient)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgClaimTimelockedTransferResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgClaimTimelockedTransferResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgClaimTimelockedTransferResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgReclaimTimelockedTransfer) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgReclaimTimelockedTransfer) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgReclaimTimelockedTransfer) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.TransferId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.TransferId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgReclaimTimelockedTransferResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgReclaimTimelockedTransferResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgReclaimTimelockedTransferResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSetSpendingLimit) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetSpendingLimit) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetSpendingLimit) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	n5, err5 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.Window, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.Window):])
+	if err5 != nil {
+		return 0, err5
+	}
+	i -= n5
+	i = encodeVarintTx(dAtA, i, uint64(n5))
+	i--
+	dAtA[i] = 0x1a
+	if len(m.Limits) > 0 {
+		for iNdEx := len(m.Limits) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Limits[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSetSpendingLimitResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetSpendingLimitResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetSpendingLimitResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	n6, err6 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.EffectiveTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.EffectiveTime):])
+	if err6 != nil {
+		return 0, err6
+	}
+	i -= n6
+	i = encodeVarintTx(dAtA, i, uint64(n6))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSetDemurrageSchedule) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetDemurrageSchedule) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetDemurrageSchedule) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.EpochLength != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.EpochLength))
+		i--
+		dAtA[i] = 0x20
+	}
+	{
+		size := m.Rate.Size()
+		i -= size
+		if _, err := m.Rate.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSetDemurrageScheduleResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetDemurrageScheduleResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetDemurrageScheduleResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
+	offset -= sovTx(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *MsgSend) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.FromAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.ToAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.Amount) > 0 {
+		for _, e := range m.Amount {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgSendResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgMultiSend) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Inputs) > 0 {
+		for _, e := range m.Inputs {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	if len(m.Outputs) > 0 {
+		for _, e := range m.Outputs {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgMultiSendResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgUpdateParams) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.Params.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgUpdateParamsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgSetSendEnabled) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.SendEnabled) > 0 {
+		for _, e := range m.SendEnabled {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	if len(m.UseDefaultFor) > 0 {
+		for _, s := range m.UseDefaultFor {
+			l = len(s)
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgSetSendEnabledResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgUpdateDenomMetadata) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.Metadata.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgUpdateDenomMetadataResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgCreateBalanceSnapshot) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Height != 0 {
+		n += 1 + sovTx(uint64(m.Height))
+	}
+	return n
+}
+
+func (m *MsgCreateBalanceSnapshotResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.SnapshotId != 0 {
+		n += 1 + sovTx(uint64(m.SnapshotId))
+	}
+	return n
+}
+
+func (m *MsgDeleteBalanceSnapshot) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.SnapshotId != 0 {
+		n += 1 + sovTx(uint64(m.SnapshotId))
+	}
+	return n
+}
+
+func (m *MsgDeleteBalanceSnapshotResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgBatchSend) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.FromAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.Outputs) > 0 {
+		for _, e := range m.Outputs {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgBatchSendResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgSetMintBurnPermissions) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.Permissions) > 0 {
+		for _, e := range m.Permissions {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	if len(m.UnrestrictedDenoms) > 0 {
+		for _, s := range m.UnrestrictedDenoms {
+			l = len(s)
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgSetMintBurnPermissionsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgSetDustSweepOptIn) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.OptIn {
+		n += 2
+	}
+	return n
+}
+
+func (m *MsgSetDustSweepOptInResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgSendWithTimelock) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.FromAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.ToAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.Amount) > 0 {
+		for _, e := range m.Amount {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.UnlockTime)
+	n += 1 + l + sovTx(uint64(l))
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.ExpiryTime)
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgSendWithTimelockResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.TransferId != 0 {
+		n += 1 + sovTx(uint64(m.TransferId))
+	}
+	return n
+}
+
+func (m *MsgClaimTimelockedTransfer) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Recipient)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.TransferId != 0 {
+		n += 1 + sovTx(uint64(m.TransferId))
+	}
+	return n
+}
+
+func (m *MsgClaimTimelockedTransferResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgReclaimTimelockedTransfer) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.TransferId != 0 {
+		n += 1 + sovTx(uint64(m.TransferId))
+	}
+	return n
+}
+
+func (m *MsgReclaimTimelockedTransferResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgSetSpendingLimit) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.Limits) > 0 {
+		for _, e := range m.Limits {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.Window)
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgSetSpendingLimitResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.EffectiveTime)
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgSetDemurrageSchedule) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.Rate.Size()
+	n += 1 + l + sovTx(uint64(l))
+	if m.EpochLength != 0 {
+		n += 1 + sovTx(uint64(m.EpochLength))
+	}
+	return n
+}
+
+func (m *MsgSetDemurrageScheduleResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func sovTx(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozTx(x uint64) (n int) {
+	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *MsgSend) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSend: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSend: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FromAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FromAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ToAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ToAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Amount = append(m.Amount, types.Coin{})
+			if err := m.Amount[len(m.Amount)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSendResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSendResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSendResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgMultiSend) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgMultiSend: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgMultiSend: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Inputs", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Inputs = append(m.Inputs, Input{})
+			if err := m.Inputs[len(m.Inputs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Outputs", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Outputs = append(m.Outputs, Output{})
+			if err := m.Outputs[len(m.Outputs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgMultiSendResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgMultiSendResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgMultiSendResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdateParams: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdateParams: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Authority = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgUpdateParamsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdateParamsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdateParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSetSendEnabled) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSetSendEnabled: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSetSendEnabled: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Authority = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SendEnabled", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SendEnabled = append(m.SendEnabled, &SendEnabled{})
+			if err := m.SendEnabled[len(m.SendEnabled)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UseDefaultFor", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.UseDefaultFor = append(m.UseDefaultFor, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSetSendEnabledResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSetSendEnabledResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSetSendEnabledResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgUpdateDenomMetadata) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdateDenomMetadata: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdateDenomMetadata: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Authority = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Metadata", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Metadata.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgUpdateDenomMetadataResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdateDenomMetadataResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdateDenomMetadataResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgCreateBalanceSnapshot) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgCreateBalanceSnapshot: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgCreateBalanceSnapshot: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Authority = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgCreateBalanceSnapshotResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgCreateBalanceSnapshotResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgCreateBalanceSnapshotResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SnapshotId", wireType)
+			}
+			m.SnapshotId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SnapshotId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgDeleteBalanceSnapshot) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgDeleteBalanceSnapshot: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgDeleteBalanceSnapshot: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Authority = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SnapshotId", wireType)
+			}
+			m.SnapshotId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SnapshotId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgDeleteBalanceSnapshotResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgDeleteBalanceSnapshotResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgDeleteBalanceSnapshotResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgBatchSend) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgBatchSend: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgBatchSend: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FromAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FromAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Outputs", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Outputs = append(m.Outputs, BatchOutput{})
+			if err := m.Outputs[len(m.Outputs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgBatchSendResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgBatchSendResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgBatchSendResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSetMintBurnPermissions) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSetMintBurnPermissions: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSetMintBurnPermissions: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Authority = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Permissions", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Permissions = append(m.Permissions, MintBurnPermissions{})
+			if err := m.Permissions[len(m.Permissions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UnrestrictedDenoms", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.UnrestrictedDenoms = append(m.UnrestrictedDenoms, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSetMintBurnPermissionsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSetMintBurnPermissionsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSetMintBurnPermissionsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSetDustSweepOptIn) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSetDustSweepOptIn: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSetDustSweepOptIn: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OptIn", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.OptIn = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSetDustSweepOptInResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSetDustSweepOptInResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSetDustSweepOptInResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSendWithTimelock) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSendWithTimelock: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSendWithTimelock: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FromAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FromAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ToAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ToAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Amount = append(m.Amount, types.Coin{})
+			if err := m.Amount[len(m.Amount)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UnlockTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.UnlockTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExpiryTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.ExpiryTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
 		}
 	}
-	return n
-}
 
-func (m *MsgSetSendEnabledResponse) Size() (n int) {
-	if m == nil {
-		return 0
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	var l int
-	_ = l
-	return n
-}
-
-func sovTx(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozTx(x uint64) (n int) {
-	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	return nil
 }
-func (m *MsgSend) Unmarshal(dAtA []byte) error {
+func (m *MsgSendWithTimelockResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1132,17 +5933,17 @@ func (m *MsgSend) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgSend: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgSendWithTimelockResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgSend: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgSendWithTimelockResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field FromAddress", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TransferId", wireType)
 			}
-			var stringLen uint64
+			m.TransferId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -1152,27 +5953,64 @@ func (m *MsgSend) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.TransferId |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTx
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthTx
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.FromAddress = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 2:
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgClaimTimelockedTransfer) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgClaimTimelockedTransfer: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgClaimTimelockedTransfer: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ToAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Recipient", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1200,13 +6038,13 @@ func (m *MsgSend) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ToAddress = string(dAtA[iNdEx:postIndex])
+			m.Recipient = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TransferId", wireType)
 			}
-			var msglen int
+			m.TransferId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -1216,26 +6054,11 @@ func (m *MsgSend) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.TransferId |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Amount = append(m.Amount, types.Coin{})
-			if err := m.Amount[len(m.Amount)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -1257,7 +6080,7 @@ func (m *MsgSend) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgSendResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgClaimTimelockedTransferResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1280,10 +6103,10 @@ func (m *MsgSendResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgSendResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgClaimTimelockedTransferResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgSendResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgClaimTimelockedTransferResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -1307,7 +6130,7 @@ func (m *MsgSendResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgMultiSend) Unmarshal(dAtA []byte) error {
+func (m *MsgReclaimTimelockedTransfer) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1330,17 +6153,17 @@ func (m *MsgMultiSend) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgMultiSend: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgReclaimTimelockedTransfer: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgMultiSend: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgReclaimTimelockedTransfer: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Inputs", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -1350,31 +6173,29 @@ func (m *MsgMultiSend) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Inputs = append(m.Inputs, Input{})
-			if err := m.Inputs[len(m.Inputs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Sender = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Outputs", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TransferId", wireType)
 			}
-			var msglen int
+			m.TransferId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -1384,26 +6205,11 @@ func (m *MsgMultiSend) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.TransferId |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Outputs = append(m.Outputs, Output{})
-			if err := m.Outputs[len(m.Outputs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -1425,7 +6231,7 @@ func (m *MsgMultiSend) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgMultiSendResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgReclaimTimelockedTransferResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1448,10 +6254,10 @@ func (m *MsgMultiSendResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgMultiSendResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgReclaimTimelockedTransferResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgMultiSendResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgReclaimTimelockedTransferResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -1475,7 +6281,7 @@ func (m *MsgMultiSendResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
+func (m *MsgSetSpendingLimit) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1498,15 +6304,15 @@ func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgUpdateParams: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgSetSpendingLimit: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgUpdateParams: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgSetSpendingLimit: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1534,11 +6340,11 @@ func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Authority = string(dAtA[iNdEx:postIndex])
+			m.Address = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Limits", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1565,7 +6371,41 @@ func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Limits = append(m.Limits, types.Coin{})
+			if err := m.Limits[len(m.Limits)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Window", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdDurationUnmarshal(&m.Window, dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -1590,7 +6430,7 @@ func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgUpdateParamsResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgSetSpendingLimitResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1613,12 +6453,45 @@ func (m *MsgUpdateParamsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgUpdateParamsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgSetSpendingLimitResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgUpdateParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgSetSpendingLimitResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EffectiveTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.EffectiveTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -1640,7 +6513,7 @@ func (m *MsgUpdateParamsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgSetSendEnabled) Unmarshal(dAtA []byte) error {
+func (m *MsgSetDemurrageSchedule) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1663,10 +6536,10 @@ func (m *MsgSetSendEnabled) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgSetSendEnabled: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgSetDemurrageSchedule: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgSetSendEnabled: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgSetDemurrageSchedule: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -1703,9 +6576,9 @@ func (m *MsgSetSendEnabled) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SendEnabled", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -1715,29 +6588,27 @@ func (m *MsgSetSendEnabled) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.SendEnabled = append(m.SendEnabled, &SendEnabled{})
-			if err := m.SendEnabled[len(m.SendEnabled)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field UseDefaultFor", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Rate", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1765,8 +6636,29 @@ func (m *MsgSetSendEnabled) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.UseDefaultFor = append(m.UseDefaultFor, string(dAtA[iNdEx:postIndex]))
+			if err := m.Rate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EpochLength", wireType)
+			}
+			m.EpochLength = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EpochLength |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -1788,7 +6680,7 @@ func (m *MsgSetSendEnabled) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgSetSendEnabledResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgSetDemurrageScheduleResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1811,10 +6703,10 @@ func (m *MsgSetSendEnabledResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgSetSendEnabledResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgSetDemurrageScheduleResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgSetSendEnabledResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgSetDemurrageScheduleResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -8,7 +8,10 @@ import (
 	"cosmossdk.io/core/appmodule"
 	"cosmossdk.io/core/event"
 	"cosmossdk.io/depinject"
+	"cosmossdk.io/errors"
 	abci "github.com/cometbft/cometbft/abci/types"
+	cmttypes "github.com/cometbft/cometbft/types"
+	"github.com/cosmos/gogoproto/proto"
 	gwruntime "github.com/grpc-ecosystem/grpc-gateway/runtime"
 	"github.com/spf13/cobra"
 	"google.golang.org/grpc"
@@ -21,6 +24,7 @@ import (
 	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
 	"github.com/cosmos/cosmos-sdk/runtime"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	"github.com/cosmos/cosmos-sdk/types/module"
 	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 	"github.com/cosmos/cosmos-sdk/x/consensus/keeper"
@@ -134,6 +138,18 @@ func (am AppModule) ExportGenesis(sdk.Context, codec.JSONCodec) json.RawMessage
 // ConsensusVersion implements AppModule/ConsensusVersion.
 func (AppModule) ConsensusVersion() uint64 { return ConsensusVersion }
 
+// UpdatableParams implements the module.HasUpdatableParams interface.
+func (am AppModule) UpdatableParams() module.UpdatableParams {
+	return module.UpdatableParams{
+		UpdateMsg: &types.MsgUpdateParams{},
+		Authority: am.keeper.GetAuthority(),
+		GetParams: func(ctx context.Context) (proto.Message, error) {
+			params, err := am.keeper.ParamsStore.Get(ctx)
+			return &params, err
+		},
+	}
+}
+
 func init() {
 	appmodule.Register(
 		&modulev1.Module{},
@@ -153,9 +169,10 @@ type ModuleInputs struct {
 type ModuleOutputs struct {
 	depinject.Out
 
-	Keeper        keeper.Keeper
-	Module        appmodule.AppModule
-	BaseAppOption runtime.BaseAppOption
+	Keeper               keeper.Keeper
+	Module               appmodule.AppModule
+	BaseAppOption        runtime.BaseAppOption
+	ParamsValidatorRoute govtypes.ParamsValidatorRoute
 }
 
 func ProvideModule(in ModuleInputs) ModuleOutputs {
@@ -172,8 +189,25 @@ func ProvideModule(in ModuleInputs) ModuleOutputs {
 	}
 
 	return ModuleOutputs{
-		Keeper:        k,
-		Module:        m,
-		BaseAppOption: baseappOpt,
+		Keeper:               k,
+		Module:               m,
+		BaseAppOption:        baseappOpt,
+		ParamsValidatorRoute: NewParamsValidatorRoute(),
+	}
+}
+
+// NewParamsValidatorRoute returns the params validator route of the x/consensus
+// MsgUpdateParams message, used by the x/gov MsgBatchUpdateParams message.
+func NewParamsValidatorRoute() govtypes.ParamsValidatorRoute {
+	return govtypes.ParamsValidatorRoute{
+		MsgTypeURL: sdk.MsgTypeURL(&types.MsgUpdateParams{}),
+		Validator: func(_ context.Context, msg sdk.Msg) error {
+			m, ok := msg.(*types.MsgUpdateParams)
+			if !ok {
+				return errors.Wrapf(sdkerrors.ErrInvalidType, "expected %T, got %T", &types.MsgUpdateParams{}, msg)
+			}
+
+			return cmttypes.ConsensusParamsFromProto(m.ToProtoConsensusParams()).ValidateBasic()
+		},
 	}
 }
@@ -0,0 +1,44 @@
+package council
+
+import (
+	"fmt"
+
+	autocliv1 "cosmossdk.io/api/cosmos/autocli/v1"
+	councilv1 "cosmossdk.io/api/cosmos/council/v1"
+
+	"github.com/cosmos/cosmos-sdk/version"
+	"github.com/cosmos/cosmos-sdk/x/council/types"
+)
+
+// AutoCLIOptions implements the autocli.HasAutoCLIConfig interface.
+func (am AppModule) AutoCLIOptions() *autocliv1.ModuleOptions {
+	return &autocliv1.ModuleOptions{
+		Query: &autocliv1.ServiceCommandDescriptor{
+			Service: councilv1.Query_ServiceDesc.ServiceName,
+			RpcCommandOptions: []*autocliv1.RpcCommandOptions{
+				{
+					RpcMethod: "Params",
+					Use:       "params",
+					Short:     "Query the parameters of the council module, including the council members.",
+					Example:   fmt.Sprintf(`%s query %s params`, version.AppName, types.ModuleName),
+				},
+				{
+					RpcMethod:      "Action",
+					Use:            "action [action-id]",
+					Short:          "Query an action taken by the council.",
+					Example:        fmt.Sprintf(`%s query %s action 1`, version.AppName, types.ModuleName),
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "action_id"}},
+				},
+				{
+					RpcMethod: "Actions",
+					Use:       "actions",
+					Short:     "Query the actions taken by the council, optionally filtered by status.",
+					Example:   fmt.Sprintf(`%s query %s actions --status ACTION_STATUS_ACTIVE`, version.AppName, types.ModuleName),
+				},
+			},
+		},
+		Tx: &autocliv1.ServiceCommandDescriptor{
+			Service: councilv1.Msg_ServiceDesc.ServiceName,
+		},
+	}
+}
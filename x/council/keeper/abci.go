@@ -0,0 +1,43 @@
+package keeper
+
+import (
+	"context"
+	"strconv"
+
+	"cosmossdk.io/core/event"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/council/types"
+)
+
+// BeginBlocker expires the actions which reached their expiry, so that the
+// tripped circuits and paused modules are restored before the transactions of
+// the block are executed. The proposals expedited by the council stay
+// expedited.
+func (k Keeper) BeginBlocker(ctx context.Context) error {
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	actions, err := k.expiredActions(ctx, sdkCtx.BlockTime())
+	if err != nil {
+		return err
+	}
+
+	for _, action := range actions {
+		if err := k.deactivateAction(ctx, action, types.StatusExpired); err != nil {
+			return err
+		}
+
+		sdkCtx.Logger().Info("council action expired", "id", action.Id, "type", action.Type, "target", action.Target)
+
+		if err := k.event.EventManager(ctx).EmitKV(
+			ctx,
+			types.EventTypeExpireAction,
+			event.Attribute{Key: types.AttributeKeyActionID, Value: strconv.FormatUint(action.Id, 10)},
+			event.Attribute{Key: types.AttributeKeyActionType, Value: action.Type.String()},
+			event.Attribute{Key: types.AttributeKeyTarget, Value: action.Target},
+		); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,51 @@
+package keeper
+
+import (
+	"context"
+
+	"github.com/cosmos/cosmos-sdk/x/council/types"
+)
+
+// InitGenesis initializes the council module's state from a given genesis
+// state.
+func (k Keeper) InitGenesis(ctx context.Context, gs *types.GenesisState) error {
+	if err := k.Params.Set(ctx, gs.Params); err != nil {
+		return err
+	}
+
+	if err := k.LastActionID.Set(ctx, gs.LastActionId); err != nil {
+		return err
+	}
+
+	for _, action := range gs.Actions {
+		if err := k.SetAction(ctx, action); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// ExportGenesis returns the council module's exported genesis.
+func (k Keeper) ExportGenesis(ctx context.Context) (*types.GenesisState, error) {
+	params, err := k.Params.Get(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	lastActionID, err := k.LastActionID.Peek(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	gs := &types.GenesisState{Params: params, LastActionId: lastActionID}
+	err = k.Actions.Walk(ctx, nil, func(_ uint64, action types.Action) bool {
+		gs.Actions = append(gs.Actions, action)
+		return false
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return gs, nil
+}
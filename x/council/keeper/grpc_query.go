@@ -0,0 +1,80 @@
+package keeper
+
+import (
+	"context"
+	"errors"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"cosmossdk.io/collections"
+
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"github.com/cosmos/cosmos-sdk/x/council/types"
+)
+
+var _ types.QueryServer = Querier{}
+
+// Querier is used as Keeper will have duplicate methods if used directly, and gRPC names take precedence over keeper.
+type Querier struct {
+	Keeper
+}
+
+// NewQuerier returns an implementation of the council QueryServer interface
+// for the provided Keeper.
+func NewQuerier(keeper Keeper) Querier {
+	return Querier{Keeper: keeper}
+}
+
+// Params queries the parameters of the module.
+func (k Querier) Params(ctx context.Context, req *types.QueryParamsRequest) (*types.QueryParamsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	params, err := k.Keeper.Params.Get(ctx)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryParamsResponse{Params: params}, nil
+}
+
+// Action queries an action taken by the council.
+func (k Querier) Action(ctx context.Context, req *types.QueryActionRequest) (*types.QueryActionResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	action, err := k.Keeper.Actions.Get(ctx, req.ActionId)
+	if err != nil {
+		if errors.Is(err, collections.ErrNotFound) {
+			return nil, status.Errorf(codes.NotFound, "action %d not found", req.ActionId)
+		}
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryActionResponse{Action: action}, nil
+}
+
+// Actions queries the actions taken by the council, optionally filtered by
+// status.
+func (k Querier) Actions(ctx context.Context, req *types.QueryActionsRequest) (*types.QueryActionsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	results, pageRes, err := query.CollectionFilteredPaginate[uint64, types.Action](ctx, k.Keeper.Actions, req.Pagination, func(_ uint64, action types.Action) (bool, error) {
+		return req.Status == types.StatusUnspecified || action.Status == req.Status, nil
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	actions := make([]types.Action, 0, len(results))
+	for _, r := range results {
+		actions = append(actions, r.Value)
+	}
+
+	return &types.QueryActionsResponse{Actions: actions, Pagination: pageRes}, nil
+}
@@ -0,0 +1,167 @@
+package keeper
+
+import (
+	"context"
+	"time"
+
+	"cosmossdk.io/collections"
+	"cosmossdk.io/core/event"
+	storetypes "cosmossdk.io/core/store"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/x/council/types"
+)
+
+// Keeper of the council store
+type Keeper struct {
+	storeService storetypes.KVStoreService
+	event        event.Service
+	govKeeper    types.GovKeeper
+
+	// the address capable of executing a MsgUpdateParams or MsgRevokeAction
+	// message. Typically, this should be the x/gov module account.
+	authority string
+
+	Schema          collections.Schema
+	Params          collections.Item[types.Params]
+	LastActionID    collections.Sequence
+	Actions         collections.Map[uint64, types.Action]
+	ActiveActions   collections.KeySet[collections.Pair[int64, uint64]]
+	TrippedCircuits collections.Map[string, uint64]
+	PausedModules   collections.Map[string, uint64]
+}
+
+// NewKeeper returns a new council Keeper.
+func NewKeeper(cdc codec.BinaryCodec, storeService storetypes.KVStoreService, govKeeper types.GovKeeper, authority string, em event.Service) Keeper {
+	sb := collections.NewSchemaBuilder(storeService)
+	k := Keeper{
+		storeService:    storeService,
+		event:           em,
+		govKeeper:       govKeeper,
+		authority:       authority,
+		Params:          collections.NewItem(sb, types.ParamsKey, "params", codec.CollValue[types.Params](cdc)),
+		LastActionID:    collections.NewSequence(sb, types.LastActionIDKey, "last_action_id"),
+		Actions:         collections.NewMap(sb, types.ActionsPrefix, "actions", collections.Uint64Key, codec.CollValue[types.Action](cdc)),
+		ActiveActions:   collections.NewKeySet(sb, types.ActiveActionsPrefix, "active_actions", collections.PairKeyCodec(collections.Int64Key, collections.Uint64Key)),
+		TrippedCircuits: collections.NewMap(sb, types.TrippedCircuitsPrefix, "tripped_circuits", collections.StringKey, collections.Uint64Value),
+		PausedModules:   collections.NewMap(sb, types.PausedModulesPrefix, "paused_modules", collections.StringKey, collections.Uint64Value),
+	}
+
+	schema, err := sb.Build()
+	if err != nil {
+		panic(err)
+	}
+	k.Schema = schema
+
+	return k
+}
+
+// GetAuthority returns the x/council module's authority.
+func (k Keeper) GetAuthority() string {
+	return k.authority
+}
+
+// IsAllowed returns false if the council tripped the circuit of the message
+// type, or paused the module of the message. It implements the
+// baseapp.CircuitBreaker interface.
+func (k Keeper) IsAllowed(ctx context.Context, msgTypeURL string) (bool, error) {
+	tripped, err := k.TrippedCircuits.Has(ctx, msgTypeURL)
+	if err != nil || tripped {
+		return false, err
+	}
+
+	paused, err := k.IsModulePaused(ctx, types.MsgModuleName(msgTypeURL))
+	return !paused, err
+}
+
+// IsModulePaused returns true if the council paused the module. The messages
+// of a paused module are rejected by IsAllowed, and the modules processing IBC
+// packets are expected to check it before processing them.
+func (k Keeper) IsModulePaused(ctx context.Context, moduleName string) (bool, error) {
+	return k.PausedModules.Has(ctx, moduleName)
+}
+
+// activeActionKey returns the key under which an active action is indexed by
+// expiry time.
+func activeActionKey(action types.Action) collections.Pair[int64, uint64] {
+	return collections.Join(action.ExpiresAt.UnixNano(), action.Id)
+}
+
+// effectIndex returns the index of the actions of the given type which are in
+// effect until they are deactivated, if any.
+func (k Keeper) effectIndex(actionType types.ActionType) (collections.Map[string, uint64], bool) {
+	switch actionType {
+	case types.ActionTypeTripCircuit:
+		return k.TrippedCircuits, true
+	case types.ActionTypePauseModule:
+		return k.PausedModules, true
+	default:
+		return collections.Map[string, uint64]{}, false
+	}
+}
+
+// SetAction stores an action, together with its indexes if it is active.
+func (k Keeper) SetAction(ctx context.Context, action types.Action) error {
+	if err := k.Actions.Set(ctx, action.Id, action); err != nil {
+		return err
+	}
+
+	if action.Status != types.StatusActive {
+		return nil
+	}
+
+	if err := k.ActiveActions.Set(ctx, activeActionKey(action)); err != nil {
+		return err
+	}
+
+	if index, ok := k.effectIndex(action.Type); ok {
+		return index.Set(ctx, action.Target, action.Id)
+	}
+
+	return nil
+}
+
+// deactivateAction sets the status of an active action, and removes it from
+// the indexes of the active actions.
+func (k Keeper) deactivateAction(ctx context.Context, action types.Action, status types.ActionStatus) error {
+	if err := k.ActiveActions.Remove(ctx, activeActionKey(action)); err != nil {
+		return err
+	}
+
+	if index, ok := k.effectIndex(action.Type); ok {
+		if err := index.Remove(ctx, action.Target); err != nil {
+			return err
+		}
+	}
+
+	action.Status = status
+	return k.Actions.Set(ctx, action.Id, action)
+}
+
+// expiredActions returns the active actions which expire at or before the
+// given time.
+func (k Keeper) expiredActions(ctx context.Context, blockTime time.Time) ([]types.Action, error) {
+	var (
+		actions []types.Action
+		getErr  error
+	)
+	err := k.ActiveActions.Walk(ctx, nil, func(key collections.Pair[int64, uint64]) bool {
+		if key.K1() > blockTime.UnixNano() {
+			return true
+		}
+
+		var action types.Action
+		action, getErr = k.Actions.Get(ctx, key.K2())
+		if getErr != nil {
+			return true
+		}
+
+		actions = append(actions, action)
+		return false
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return actions, getErr
+}
@@ -0,0 +1,366 @@
+package keeper_test
+
+import (
+	"testing"
+	"time"
+
+	cmtproto "github.com/cometbft/cometbft/proto/tendermint/types"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/suite"
+
+	storetypes "cosmossdk.io/store/types"
+
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	"github.com/cosmos/cosmos-sdk/runtime"
+	"github.com/cosmos/cosmos-sdk/testutil"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	moduletestutil "github.com/cosmos/cosmos-sdk/types/module/testutil"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	"github.com/cosmos/cosmos-sdk/x/council/keeper"
+	counciltestutil "github.com/cosmos/cosmos-sdk/x/council/testutil"
+	"github.com/cosmos/cosmos-sdk/x/council/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+	v1 "github.com/cosmos/cosmos-sdk/x/gov/types/v1"
+)
+
+const sendMsgTypeURL = "/cosmos.bank.v1beta1.MsgSend"
+
+type KeeperTestSuite struct {
+	suite.Suite
+
+	ctx         sdk.Context
+	keeper      keeper.Keeper
+	govKeeper   *counciltestutil.MockGovKeeper
+	msgServer   types.MsgServer
+	queryClient types.QueryClient
+	authority   string
+	member      string
+	blockTime   time.Time
+}
+
+func (s *KeeperTestSuite) SetupTest() {
+	key := storetypes.NewKVStoreKey(types.StoreKey)
+	testCtx := testutil.DefaultContextWithDB(s.T(), key, storetypes.NewTransientStoreKey("transient_test"))
+	encCfg := moduletestutil.MakeTestEncodingConfig()
+
+	ctrl := gomock.NewController(s.T())
+	s.govKeeper = counciltestutil.NewMockGovKeeper(ctrl)
+
+	s.authority = authtypes.NewModuleAddress(govtypes.ModuleName).String()
+	s.member = sdk.AccAddress("member").String()
+	s.blockTime = time.Date(2023, 1, 1, 0, 0, 0, 0, time.UTC)
+	s.ctx = testCtx.Ctx.WithBlockHeader(cmtproto.Header{Height: 10, Time: s.blockTime})
+	s.keeper = keeper.NewKeeper(encCfg.Codec, runtime.NewKVStoreService(key), s.govKeeper, s.authority, runtime.EventService{})
+	s.msgServer = keeper.NewMsgServerImpl(s.keeper)
+	s.Require().NoError(s.keeper.Params.Set(s.ctx, types.Params{
+		Members:         []string{s.member},
+		ActionDuration:  time.Hour,
+		PausableModules: []string{"transfer"},
+	}))
+
+	types.RegisterInterfaces(encCfg.InterfaceRegistry)
+	queryHelper := baseapp.NewQueryServerTestHelper(s.ctx, encCfg.InterfaceRegistry)
+	types.RegisterQueryServer(queryHelper, keeper.NewQuerier(s.keeper))
+	s.queryClient = types.NewQueryClient(queryHelper)
+}
+
+func TestKeeperTestSuite(t *testing.T) {
+	suite.Run(t, new(KeeperTestSuite))
+}
+
+func (s *KeeperTestSuite) TestTripCircuit() {
+	testCases := []struct {
+		name   string
+		msg    *types.MsgTripCircuit
+		expErr error
+	}{
+		{
+			name:   "not a member",
+			msg:    &types.MsgTripCircuit{Member: s.authority, MsgTypeUrl: sendMsgTypeURL, Reason: "exploit"},
+			expErr: types.ErrNotCouncilMember,
+		},
+		{
+			name:   "invalid type URL",
+			msg:    &types.MsgTripCircuit{Member: s.member, MsgTypeUrl: "cosmos.bank.v1beta1.MsgSend", Reason: "exploit"},
+			expErr: types.ErrActionNotAllowed,
+		},
+		{
+			name:   "gov message",
+			msg:    &types.MsgTripCircuit{Member: s.member, MsgTypeUrl: "/cosmos.gov.v1.MsgVote", Reason: "exploit"},
+			expErr: types.ErrActionNotAllowed,
+		},
+		{
+			name:   "council message",
+			msg:    &types.MsgTripCircuit{Member: s.member, MsgTypeUrl: "/cosmos.council.v1.MsgTripCircuit", Reason: "exploit"},
+			expErr: types.ErrActionNotAllowed,
+		},
+		{
+			name:   "empty reason",
+			msg:    &types.MsgTripCircuit{Member: s.member, MsgTypeUrl: sendMsgTypeURL, Reason: ""},
+			expErr: types.ErrInvalidActionReason,
+		},
+		{
+			name: "success",
+			msg:  &types.MsgTripCircuit{Member: s.member, MsgTypeUrl: sendMsgTypeURL, Reason: "exploit"},
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			s.SetupTest()
+
+			res, err := s.msgServer.TripCircuit(s.ctx, tc.msg)
+			if tc.expErr != nil {
+				s.Require().ErrorIs(err, tc.expErr)
+				return
+			}
+
+			s.Require().NoError(err)
+			s.Require().Equal(uint64(1), res.ActionId)
+
+			allowed, err := s.keeper.IsAllowed(s.ctx, sendMsgTypeURL)
+			s.Require().NoError(err)
+			s.Require().False(allowed)
+
+			action, err := s.keeper.Actions.Get(s.ctx, res.ActionId)
+			s.Require().NoError(err)
+			s.Require().Equal(types.Action{
+				Id:        1,
+				Type:      types.ActionTypeTripCircuit,
+				Target:    sendMsgTypeURL,
+				Member:    s.member,
+				Reason:    "exploit",
+				CreatedAt: s.blockTime,
+				ExpiresAt: s.blockTime.Add(time.Hour),
+				Status:    types.StatusActive,
+			}, action)
+
+			_, err = s.msgServer.TripCircuit(s.ctx, tc.msg)
+			s.Require().ErrorIs(err, types.ErrActionAlreadyActive)
+		})
+	}
+}
+
+func (s *KeeperTestSuite) TestPauseModule() {
+	_, err := s.msgServer.PauseModule(s.ctx, &types.MsgPauseModule{Member: s.member, ModuleName: "bank", Reason: "exploit"})
+	s.Require().ErrorIs(err, types.ErrActionNotAllowed)
+
+	_, err = s.msgServer.PauseModule(s.ctx, &types.MsgPauseModule{Member: s.member, ModuleName: "transfer", Reason: "exploit"})
+	s.Require().NoError(err)
+
+	paused, err := s.keeper.IsModulePaused(s.ctx, "transfer")
+	s.Require().NoError(err)
+	s.Require().True(paused)
+
+	_, err = s.msgServer.PauseModule(s.ctx, &types.MsgPauseModule{Member: s.member, ModuleName: "transfer", Reason: "exploit"})
+	s.Require().ErrorIs(err, types.ErrActionAlreadyActive)
+
+	// the messages of the paused module are rejected
+	allowed, err := s.keeper.IsAllowed(s.ctx, "/ibc.applications.transfer.v1.MsgTransfer")
+	s.Require().NoError(err)
+	s.Require().False(allowed)
+
+	allowed, err = s.keeper.IsAllowed(s.ctx, sendMsgTypeURL)
+	s.Require().NoError(err)
+	s.Require().True(allowed)
+}
+
+func (s *KeeperTestSuite) TestMsgModuleName() {
+	for typeURL, moduleName := range map[string]string{
+		sendMsgTypeURL:                              "bank",
+		"/cosmos.gov.v1.MsgVote":                    "gov",
+		"/ibc.applications.transfer.v1.MsgTransfer": "transfer",
+		"/ibc.core.channel.v1.MsgRecvPacket":        "channel",
+		"/cosmos.council.v1.MsgTripCircuit":         "council",
+		"/cosmos.authz.v1beta1.MsgExec":             "authz",
+		"/testpb.TestMsg":                           "testpb",
+		"invalid":                                   "",
+	} {
+		s.Require().Equal(moduleName, types.MsgModuleName(typeURL), typeURL)
+	}
+}
+
+func (s *KeeperTestSuite) TestExpediteProposal() {
+	votingStart := s.blockTime.Add(-time.Hour)
+	votingEnd := votingStart.Add(48 * time.Hour)
+	proposal := v1.Proposal{Id: 1, Status: v1.StatusVotingPeriod, VotingStartTime: &votingStart, VotingEndTime: &votingEnd}
+
+	// the proposal is expedited by the gov keeper
+	expedited := proposal
+	expedited.Expedited = true
+	s.govKeeper.EXPECT().GetProposal(s.ctx, uint64(1)).Return(proposal, nil)
+	s.govKeeper.EXPECT().SetProposalExpedited(s.ctx, uint64(1), true).Return(nil)
+
+	res, err := s.msgServer.ExpediteProposal(s.ctx, &types.MsgExpediteProposal{Member: s.member, ProposalId: 1, Reason: "security patch"})
+	s.Require().NoError(err)
+
+	s.govKeeper.EXPECT().GetProposal(s.ctx, uint64(1)).Return(expedited, nil)
+	_, err = s.msgServer.ExpediteProposal(s.ctx, &types.MsgExpediteProposal{Member: s.member, ProposalId: 1, Reason: "security patch"})
+	s.Require().ErrorIs(err, types.ErrActionAlreadyActive)
+
+	// revoking the action restores the regular proposal
+	s.govKeeper.EXPECT().GetProposal(s.ctx, uint64(1)).Return(expedited, nil)
+	s.govKeeper.EXPECT().SetProposalExpedited(s.ctx, uint64(1), false).Return(nil)
+
+	_, err = s.msgServer.RevokeAction(s.ctx, &types.MsgRevokeAction{Authority: s.authority, ActionId: res.ActionId})
+	s.Require().NoError(err)
+
+	// unless its voting period ended, as it is then only waiting for its tally
+	s.govKeeper.EXPECT().GetProposal(s.ctx, uint64(1)).Return(proposal, nil)
+	s.govKeeper.EXPECT().SetProposalExpedited(s.ctx, uint64(1), true).Return(nil)
+
+	res, err = s.msgServer.ExpediteProposal(s.ctx, &types.MsgExpediteProposal{Member: s.member, ProposalId: 1, Reason: "security patch"})
+	s.Require().NoError(err)
+
+	s.govKeeper.EXPECT().GetProposal(s.ctx, uint64(1)).Return(expedited, nil)
+	s.govKeeper.EXPECT().SetProposalExpedited(s.ctx, uint64(1), false).Return(govtypes.ErrVotingPeriodEnded)
+
+	_, err = s.msgServer.RevokeAction(s.ctx, &types.MsgRevokeAction{Authority: s.authority, ActionId: res.ActionId})
+	s.Require().NoError(err)
+
+	// a proposal which is already tallied cannot be expedited
+	proposal.Status = v1.StatusPassed
+	s.govKeeper.EXPECT().GetProposal(s.ctx, uint64(1)).Return(proposal, nil)
+	_, err = s.msgServer.ExpediteProposal(s.ctx, &types.MsgExpediteProposal{Member: s.member, ProposalId: 1, Reason: "security patch"})
+	s.Require().ErrorIs(err, types.ErrActionNotAllowed)
+}
+
+func (s *KeeperTestSuite) TestRevokeAction() {
+	res, err := s.msgServer.TripCircuit(s.ctx, &types.MsgTripCircuit{Member: s.member, MsgTypeUrl: sendMsgTypeURL, Reason: "exploit"})
+	s.Require().NoError(err)
+
+	_, err = s.msgServer.RevokeAction(s.ctx, &types.MsgRevokeAction{Authority: s.member, ActionId: res.ActionId})
+	s.Require().ErrorIs(err, govtypes.ErrInvalidSigner)
+
+	_, err = s.msgServer.RevokeAction(s.ctx, &types.MsgRevokeAction{Authority: s.authority, ActionId: 2})
+	s.Require().ErrorIs(err, types.ErrActionNotFound)
+
+	_, err = s.msgServer.RevokeAction(s.ctx, &types.MsgRevokeAction{Authority: s.authority, ActionId: res.ActionId})
+	s.Require().NoError(err)
+
+	allowed, err := s.keeper.IsAllowed(s.ctx, sendMsgTypeURL)
+	s.Require().NoError(err)
+	s.Require().True(allowed)
+
+	action, err := s.keeper.Actions.Get(s.ctx, res.ActionId)
+	s.Require().NoError(err)
+	s.Require().Equal(types.StatusRevoked, action.Status)
+
+	_, err = s.msgServer.RevokeAction(s.ctx, &types.MsgRevokeAction{Authority: s.authority, ActionId: res.ActionId})
+	s.Require().ErrorIs(err, types.ErrActionNotActive)
+}
+
+func (s *KeeperTestSuite) TestBeginBlocker() {
+	trip, err := s.msgServer.TripCircuit(s.ctx, &types.MsgTripCircuit{Member: s.member, MsgTypeUrl: sendMsgTypeURL, Reason: "exploit"})
+	s.Require().NoError(err)
+	pause, err := s.msgServer.PauseModule(s.ctx.WithBlockTime(s.blockTime.Add(time.Minute)), &types.MsgPauseModule{Member: s.member, ModuleName: "transfer", Reason: "exploit"})
+	s.Require().NoError(err)
+
+	// nothing expires before the action duration
+	s.Require().NoError(s.keeper.BeginBlocker(s.ctx.WithBlockTime(s.blockTime.Add(time.Hour - time.Second))))
+	allowed, err := s.keeper.IsAllowed(s.ctx, sendMsgTypeURL)
+	s.Require().NoError(err)
+	s.Require().False(allowed)
+
+	// the circuit is restored once its action expires, while the module stays
+	// paused until its own action expires
+	s.Require().NoError(s.keeper.BeginBlocker(s.ctx.WithBlockTime(s.blockTime.Add(time.Hour))))
+	allowed, err = s.keeper.IsAllowed(s.ctx, sendMsgTypeURL)
+	s.Require().NoError(err)
+	s.Require().True(allowed)
+	paused, err := s.keeper.IsModulePaused(s.ctx, "transfer")
+	s.Require().NoError(err)
+	s.Require().True(paused)
+
+	action, err := s.keeper.Actions.Get(s.ctx, trip.ActionId)
+	s.Require().NoError(err)
+	s.Require().Equal(types.StatusExpired, action.Status)
+
+	s.Require().NoError(s.keeper.BeginBlocker(s.ctx.WithBlockTime(s.blockTime.Add(2 * time.Hour))))
+	paused, err = s.keeper.IsModulePaused(s.ctx, "transfer")
+	s.Require().NoError(err)
+	s.Require().False(paused)
+
+	action, err = s.keeper.Actions.Get(s.ctx, pause.ActionId)
+	s.Require().NoError(err)
+	s.Require().Equal(types.StatusExpired, action.Status)
+}
+
+func (s *KeeperTestSuite) TestUpdateParams() {
+	params := types.DefaultParams()
+	_, err := s.msgServer.UpdateParams(s.ctx, &types.MsgUpdateParams{Authority: s.member, Params: params})
+	s.Require().ErrorIs(err, govtypes.ErrInvalidSigner)
+
+	params.ActionDuration = 0
+	_, err = s.msgServer.UpdateParams(s.ctx, &types.MsgUpdateParams{Authority: s.authority, Params: params})
+	s.Require().Error(err)
+
+	// governance cannot be paused, so that it can always override the council
+	params = types.DefaultParams()
+	params.PausableModules = []string{"gov"}
+	_, err = s.msgServer.UpdateParams(s.ctx, &types.MsgUpdateParams{Authority: s.authority, Params: params})
+	s.Require().Error(err)
+
+	// the member is removed from the council
+	_, err = s.msgServer.UpdateParams(s.ctx, &types.MsgUpdateParams{Authority: s.authority, Params: types.DefaultParams()})
+	s.Require().NoError(err)
+	_, err = s.msgServer.TripCircuit(s.ctx, &types.MsgTripCircuit{Member: s.member, MsgTypeUrl: sendMsgTypeURL, Reason: "exploit"})
+	s.Require().ErrorIs(err, types.ErrNotCouncilMember)
+}
+
+func (s *KeeperTestSuite) TestGRPCQueries() {
+	_, err := s.queryClient.Action(s.ctx, &types.QueryActionRequest{ActionId: 1})
+	s.Require().Error(err)
+
+	trip, err := s.msgServer.TripCircuit(s.ctx, &types.MsgTripCircuit{Member: s.member, MsgTypeUrl: sendMsgTypeURL, Reason: "exploit"})
+	s.Require().NoError(err)
+	_, err = s.msgServer.PauseModule(s.ctx, &types.MsgPauseModule{Member: s.member, ModuleName: "transfer", Reason: "exploit"})
+	s.Require().NoError(err)
+	_, err = s.msgServer.RevokeAction(s.ctx, &types.MsgRevokeAction{Authority: s.authority, ActionId: trip.ActionId})
+	s.Require().NoError(err)
+
+	res, err := s.queryClient.Action(s.ctx, &types.QueryActionRequest{ActionId: trip.ActionId})
+	s.Require().NoError(err)
+	s.Require().Equal(types.StatusRevoked, res.Action.Status)
+
+	all, err := s.queryClient.Actions(s.ctx, &types.QueryActionsRequest{})
+	s.Require().NoError(err)
+	s.Require().Len(all.Actions, 2)
+
+	active, err := s.queryClient.Actions(s.ctx, &types.QueryActionsRequest{Status: types.StatusActive})
+	s.Require().NoError(err)
+	s.Require().Len(active.Actions, 1)
+	s.Require().Equal(types.ActionTypePauseModule, active.Actions[0].Type)
+
+	params, err := s.queryClient.Params(s.ctx, &types.QueryParamsRequest{})
+	s.Require().NoError(err)
+	s.Require().Equal([]string{s.member}, params.Params.Members)
+}
+
+func (s *KeeperTestSuite) TestGenesis() {
+	gs := &types.GenesisState{
+		Params: types.Params{Members: []string{s.member}, ActionDuration: time.Hour},
+		Actions: []types.Action{
+			{Id: 1, Type: types.ActionTypeTripCircuit, Target: sendMsgTypeURL, Member: s.member, Reason: "exploit", CreatedAt: s.blockTime, ExpiresAt: s.blockTime.Add(time.Hour), Status: types.StatusExpired},
+			{Id: 2, Type: types.ActionTypeTripCircuit, Target: sendMsgTypeURL, Member: s.member, Reason: "exploit", CreatedAt: s.blockTime, ExpiresAt: s.blockTime.Add(time.Hour), Status: types.StatusActive},
+		},
+		LastActionId: 2,
+	}
+	s.Require().NoError(gs.Validate())
+	s.Require().NoError(s.keeper.InitGenesis(s.ctx, gs))
+
+	allowed, err := s.keeper.IsAllowed(s.ctx, sendMsgTypeURL)
+	s.Require().NoError(err)
+	s.Require().False(allowed)
+
+	exported, err := s.keeper.ExportGenesis(s.ctx)
+	s.Require().NoError(err)
+	s.Require().Equal(gs, exported)
+
+	gs.Actions = append(gs.Actions, types.Action{Id: 3, Type: types.ActionTypeTripCircuit, Target: sendMsgTypeURL, Member: s.member, Reason: "exploit", CreatedAt: s.blockTime, ExpiresAt: s.blockTime.Add(time.Hour), Status: types.StatusActive})
+	gs.LastActionId = 3
+	s.Require().ErrorIs(gs.Validate(), types.ErrActionAlreadyActive)
+
+	gs.Actions[2].Id = 2
+	s.Require().ErrorIs(gs.Validate(), types.ErrDuplicateGenesisAction)
+}
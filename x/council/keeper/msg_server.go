@@ -0,0 +1,243 @@
+package keeper
+
+import (
+	"context"
+	"strconv"
+
+	"cosmossdk.io/collections"
+	"cosmossdk.io/core/event"
+	errorsmod "cosmossdk.io/errors"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/council/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+	v1 "github.com/cosmos/cosmos-sdk/x/gov/types/v1"
+)
+
+type msgServer struct {
+	Keeper
+}
+
+var _ types.MsgServer = msgServer{}
+
+// NewMsgServerImpl returns an implementation of the council MsgServer
+// interface for the provided Keeper.
+func NewMsgServerImpl(keeper Keeper) types.MsgServer {
+	return &msgServer{Keeper: keeper}
+}
+
+// checkMember checks that the address is a council member.
+func (k msgServer) checkMember(ctx context.Context, member string) (types.Params, error) {
+	params, err := k.Params.Get(ctx)
+	if err != nil {
+		return types.Params{}, err
+	}
+
+	if !params.IsMember(member) {
+		return types.Params{}, errorsmod.Wrapf(types.ErrNotCouncilMember, "%s", member)
+	}
+
+	return params, nil
+}
+
+// recordAction records an action taken by a council member, which stays in
+// effect for the action duration.
+func (k msgServer) recordAction(ctx context.Context, params types.Params, actionType types.ActionType, target, member, reason string) (uint64, error) {
+	if err := types.ValidateActionReason(reason); err != nil {
+		return 0, err
+	}
+
+	lastID, err := k.LastActionID.Peek(ctx)
+	if err != nil {
+		return 0, err
+	}
+	id := lastID + 1
+	if err := k.LastActionID.Set(ctx, id); err != nil {
+		return 0, err
+	}
+
+	now := sdk.UnwrapSDKContext(ctx).BlockTime()
+	action := types.Action{
+		Id:        id,
+		Type:      actionType,
+		Target:    target,
+		Member:    member,
+		Reason:    reason,
+		CreatedAt: now,
+		ExpiresAt: now.Add(params.ActionDuration),
+		Status:    types.StatusActive,
+	}
+	if err := k.SetAction(ctx, action); err != nil {
+		return 0, err
+	}
+
+	if err := k.event.EventManager(ctx).EmitKV(
+		ctx,
+		types.EventTypeTakeAction,
+		event.Attribute{Key: types.AttributeKeyActionID, Value: strconv.FormatUint(id, 10)},
+		event.Attribute{Key: types.AttributeKeyActionType, Value: actionType.String()},
+		event.Attribute{Key: types.AttributeKeyTarget, Value: target},
+		event.Attribute{Key: types.AttributeKeyMember, Value: member},
+		event.Attribute{Key: types.AttributeKeyReason, Value: reason},
+		event.Attribute{Key: types.AttributeKeyExpiresAt, Value: action.ExpiresAt.String()},
+	); err != nil {
+		return 0, err
+	}
+
+	return id, nil
+}
+
+// TripCircuit implements the Msg/TripCircuit method.
+func (k msgServer) TripCircuit(ctx context.Context, msg *types.MsgTripCircuit) (*types.MsgTripCircuitResponse, error) {
+	params, err := k.checkMember(ctx, msg.Member)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := types.ValidateTrippableMsgTypeURL(msg.MsgTypeUrl); err != nil {
+		return nil, err
+	}
+
+	tripped, err := k.TrippedCircuits.Has(ctx, msg.MsgTypeUrl)
+	if err != nil {
+		return nil, err
+	}
+	if tripped {
+		return nil, errorsmod.Wrapf(types.ErrActionAlreadyActive, "circuit of %s is already tripped", msg.MsgTypeUrl)
+	}
+
+	id, err := k.recordAction(ctx, params, types.ActionTypeTripCircuit, msg.MsgTypeUrl, msg.Member, msg.Reason)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.MsgTripCircuitResponse{ActionId: id}, nil
+}
+
+// PauseModule implements the Msg/PauseModule method.
+func (k msgServer) PauseModule(ctx context.Context, msg *types.MsgPauseModule) (*types.MsgPauseModuleResponse, error) {
+	params, err := k.checkMember(ctx, msg.Member)
+	if err != nil {
+		return nil, err
+	}
+
+	if !params.IsPausable(msg.ModuleName) {
+		return nil, errorsmod.Wrapf(types.ErrActionNotAllowed, "module %s is not pausable", msg.ModuleName)
+	}
+
+	paused, err := k.PausedModules.Has(ctx, msg.ModuleName)
+	if err != nil {
+		return nil, err
+	}
+	if paused {
+		return nil, errorsmod.Wrapf(types.ErrActionAlreadyActive, "module %s is already paused", msg.ModuleName)
+	}
+
+	id, err := k.recordAction(ctx, params, types.ActionTypePauseModule, msg.ModuleName, msg.Member, msg.Reason)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.MsgPauseModuleResponse{ActionId: id}, nil
+}
+
+// ExpediteProposal implements the Msg/ExpediteProposal method.
+func (k msgServer) ExpediteProposal(ctx context.Context, msg *types.MsgExpediteProposal) (*types.MsgExpediteProposalResponse, error) {
+	params, err := k.checkMember(ctx, msg.Member)
+	if err != nil {
+		return nil, err
+	}
+
+	proposal, err := k.govKeeper.GetProposal(ctx, msg.ProposalId)
+	if err != nil {
+		return nil, err
+	}
+
+	if proposal.Status != v1.StatusDiscussion && proposal.Status != v1.StatusVotingPeriod {
+		return nil, errorsmod.Wrapf(types.ErrActionNotAllowed, "proposal %d is not in its discussion or voting period", msg.ProposalId)
+	}
+
+	if proposal.Expedited {
+		return nil, errorsmod.Wrapf(types.ErrActionAlreadyActive, "proposal %d is already expedited", msg.ProposalId)
+	}
+
+	if err := k.govKeeper.SetProposalExpedited(ctx, msg.ProposalId, true); err != nil {
+		return nil, err
+	}
+
+	id, err := k.recordAction(ctx, params, types.ActionTypeExpediteProposal, strconv.FormatUint(msg.ProposalId, 10), msg.Member, msg.Reason)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.MsgExpediteProposalResponse{ActionId: id}, nil
+}
+
+// RevokeAction implements the Msg/RevokeAction method.
+func (k msgServer) RevokeAction(ctx context.Context, msg *types.MsgRevokeAction) (*types.MsgRevokeActionResponse, error) {
+	if k.authority != msg.Authority {
+		return nil, errorsmod.Wrapf(govtypes.ErrInvalidSigner, "invalid authority; expected %s, got %s", k.authority, msg.Authority)
+	}
+
+	action, err := k.Actions.Get(ctx, msg.ActionId)
+	if err != nil {
+		if errorsmod.IsOf(err, collections.ErrNotFound) {
+			return nil, errorsmod.Wrapf(types.ErrActionNotFound, "action %d", msg.ActionId)
+		}
+		return nil, err
+	}
+
+	if action.Status != types.StatusActive {
+		return nil, errorsmod.Wrapf(types.ErrActionNotActive, "action %d is %s", action.Id, action.Status)
+	}
+
+	if err := k.deactivateAction(ctx, action, types.StatusRevoked); err != nil {
+		return nil, err
+	}
+
+	// a proposal which is still pending is restored to its regular kind,
+	// unless its voting period ended and it is only waiting for its tally
+	if action.Type == types.ActionTypeExpediteProposal {
+		proposalID, err := strconv.ParseUint(action.Target, 10, 64)
+		if err != nil {
+			return nil, err
+		}
+
+		proposal, err := k.govKeeper.GetProposal(ctx, proposalID)
+		if err == nil && proposal.Expedited &&
+			(proposal.Status == v1.StatusDiscussion || proposal.Status == v1.StatusVotingPeriod) {
+			if err := k.govKeeper.SetProposalExpedited(ctx, proposalID, false); err != nil && !errorsmod.IsOf(err, govtypes.ErrVotingPeriodEnded) {
+				return nil, err
+			}
+		}
+	}
+
+	if err := k.event.EventManager(ctx).EmitKV(
+		ctx,
+		types.EventTypeRevokeAction,
+		event.Attribute{Key: types.AttributeKeyActionID, Value: strconv.FormatUint(action.Id, 10)},
+		event.Attribute{Key: types.AttributeKeyActionType, Value: action.Type.String()},
+		event.Attribute{Key: types.AttributeKeyTarget, Value: action.Target},
+	); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgRevokeActionResponse{}, nil
+}
+
+// UpdateParams implements the Msg/UpdateParams method.
+func (k msgServer) UpdateParams(ctx context.Context, msg *types.MsgUpdateParams) (*types.MsgUpdateParamsResponse, error) {
+	if k.authority != msg.Authority {
+		return nil, errorsmod.Wrapf(govtypes.ErrInvalidSigner, "invalid authority; expected %s, got %s", k.authority, msg.Authority)
+	}
+
+	if err := msg.Params.Validate(); err != nil {
+		return nil, err
+	}
+
+	if err := k.Params.Set(ctx, msg.Params); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgUpdateParamsResponse{}, nil
+}
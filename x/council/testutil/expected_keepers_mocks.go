@@ -0,0 +1,65 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: x/council/types/expected_keepers.go
+
+// Package testutil is a generated GoMock package.
+package testutil
+
+import (
+	context "context"
+	reflect "reflect"
+
+	v1 "github.com/cosmos/cosmos-sdk/x/gov/types/v1"
+	gomock "github.com/golang/mock/gomock"
+)
+
+// MockGovKeeper is a mock of GovKeeper interface.
+type MockGovKeeper struct {
+	ctrl     *gomock.Controller
+	recorder *MockGovKeeperMockRecorder
+}
+
+// MockGovKeeperMockRecorder is the mock recorder for MockGovKeeper.
+type MockGovKeeperMockRecorder struct {
+	mock *MockGovKeeper
+}
+
+// NewMockGovKeeper creates a new mock instance.
+func NewMockGovKeeper(ctrl *gomock.Controller) *MockGovKeeper {
+	mock := &MockGovKeeper{ctrl: ctrl}
+	mock.recorder = &MockGovKeeperMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockGovKeeper) EXPECT() *MockGovKeeperMockRecorder {
+	return m.recorder
+}
+
+// GetProposal mocks base method.
+func (m *MockGovKeeper) GetProposal(ctx context.Context, proposalID uint64) (v1.Proposal, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetProposal", ctx, proposalID)
+	ret0, _ := ret[0].(v1.Proposal)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetProposal indicates an expected call of GetProposal.
+func (mr *MockGovKeeperMockRecorder) GetProposal(ctx, proposalID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetProposal", reflect.TypeOf((*MockGovKeeper)(nil).GetProposal), ctx, proposalID)
+}
+
+// SetProposalExpedited mocks base method.
+func (m *MockGovKeeper) SetProposalExpedited(ctx context.Context, proposalID uint64, expedited bool) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetProposalExpedited", ctx, proposalID, expedited)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SetProposalExpedited indicates an expected call of SetProposalExpedited.
+func (mr *MockGovKeeperMockRecorder) SetProposalExpedited(ctx, proposalID, expedited interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetProposalExpedited", reflect.TypeOf((*MockGovKeeper)(nil).SetProposalExpedited), ctx, proposalID, expedited)
+}
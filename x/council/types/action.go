@@ -0,0 +1,118 @@
+package types
+
+import (
+	"strings"
+
+	errorsmod "cosmossdk.io/errors"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// MaxActionReasonLength is the maximum length of the reason of an action.
+const MaxActionReasonLength = 512
+
+// untrippableMsgPrefixes are the prefixes of the type URLs of the messages
+// whose circuit cannot be tripped, so that governance can always override the
+// council.
+var untrippableMsgPrefixes = []string{"/cosmos.council.", "/cosmos.gov."}
+
+// unpausableModules are the modules which cannot be paused, for the same
+// reason.
+var unpausableModules = []string{ModuleName, "gov"}
+
+// ValidateActionReason checks that the reason of an action is non-empty and
+// not too long.
+func ValidateActionReason(reason string) error {
+	if len(reason) == 0 {
+		return errorsmod.Wrap(ErrInvalidActionReason, "reason cannot be empty")
+	}
+
+	if len(reason) > MaxActionReasonLength {
+		return errorsmod.Wrapf(ErrInvalidActionReason, "reason is longer than %d characters", MaxActionReasonLength)
+	}
+
+	return nil
+}
+
+// ValidateTrippableMsgTypeURL checks that the circuit of the message type can
+// be tripped by the council.
+func ValidateTrippableMsgTypeURL(msgTypeURL string) error {
+	if !strings.HasPrefix(msgTypeURL, "/") || len(msgTypeURL) == 1 {
+		return errorsmod.Wrapf(ErrActionNotAllowed, "invalid message type URL %q", msgTypeURL)
+	}
+
+	for _, prefix := range untrippableMsgPrefixes {
+		if strings.HasPrefix(msgTypeURL, prefix) {
+			return errorsmod.Wrapf(ErrActionNotAllowed, "cannot trip the circuit of %s", msgTypeURL)
+		}
+	}
+
+	return nil
+}
+
+// MsgModuleName returns the name of the module of a message from its type URL,
+// i.e. the segment of its protobuf package preceding the version, e.g. "bank"
+// for "/cosmos.bank.v1beta1.MsgSend" and "transfer" for
+// "/ibc.applications.transfer.v1.MsgTransfer". Without a version, it is the
+// last segment of the package.
+func MsgModuleName(msgTypeURL string) string {
+	segments := strings.Split(strings.TrimPrefix(msgTypeURL, "/"), ".")
+	if len(segments) < 2 {
+		return ""
+	}
+
+	// the last segment is the message name
+	segments = segments[:len(segments)-1]
+	for i := len(segments) - 1; i > 0; i-- {
+		if isVersionSegment(segments[i]) {
+			return segments[i-1]
+		}
+	}
+
+	return segments[len(segments)-1]
+}
+
+// isVersionSegment returns true if the package segment is a version, e.g. v1,
+// v1beta1 or v2alpha1.
+func isVersionSegment(segment string) bool {
+	if len(segment) < 2 || segment[0] != 'v' || segment[1] < '0' || segment[1] > '9' {
+		return false
+	}
+
+	for _, r := range segment[2:] {
+		if (r < '0' || r > '9') && (r < 'a' || r > 'z') {
+			return false
+		}
+	}
+
+	return true
+}
+
+// Validate performs a stateless validation of the action.
+func (a Action) Validate() error {
+	if a.Type == ActionTypeUnspecified {
+		return errorsmod.Wrapf(ErrActionNotAllowed, "action %d has no type", a.Id)
+	}
+
+	if a.Target == "" {
+		return errorsmod.Wrapf(ErrActionNotAllowed, "action %d has no target", a.Id)
+	}
+
+	if _, err := sdk.AccAddressFromBech32(a.Member); err != nil {
+		return errorsmod.Wrapf(err, "invalid action member %s", a.Member)
+	}
+
+	if err := ValidateActionReason(a.Reason); err != nil {
+		return err
+	}
+
+	if !a.ExpiresAt.After(a.CreatedAt) {
+		return errorsmod.Wrapf(ErrActionNotAllowed, "action %d expires before it is created", a.Id)
+	}
+
+	if a.Status == StatusUnspecified {
+		return errorsmod.Wrapf(ErrActionNotAllowed, "action %d has no status", a.Id)
+	}
+
+	return nil
+}
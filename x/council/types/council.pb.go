@@ -0,0 +1,1043 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: cosmos/council/v1/council.proto
+
+package types
+
+import (
+	fmt "fmt"
+	_ "github.com/cosmos/cosmos-proto"
+	_ "github.com/cosmos/cosmos-sdk/types/tx/amino"
+	_ "github.com/cosmos/gogoproto/gogoproto"
+	proto "github.com/cosmos/gogoproto/proto"
+	github_com_cosmos_gogoproto_types "github.com/cosmos/gogoproto/types"
+	_ "google.golang.org/protobuf/types/known/durationpb"
+	_ "google.golang.org/protobuf/types/known/timestamppb"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+	time "time"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+var _ = time.Kitchen
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// ActionType enumerates the actions the council can take.
+type ActionType int32
+
+const (
+	// ACTION_TYPE_UNSPECIFIED defines a no-op action type.
+	ActionTypeUnspecified ActionType = 0
+	// ACTION_TYPE_TRIP_CIRCUIT defines an action rejecting the transactions
+	// containing a given message type.
+	ActionTypeTripCircuit ActionType = 1
+	// ACTION_TYPE_PAUSE_MODULE defines an action pausing one of the pausable
+	// modules, typically an IBC-facing module.
+	ActionTypePauseModule ActionType = 2
+	// ACTION_TYPE_EXPEDITE_PROPOSAL defines an action expediting a governance
+	// proposal which is not tallied yet.
+	ActionTypeExpediteProposal ActionType = 3
+)
+
+var ActionType_name = map[int32]string{
+	0: "ACTION_TYPE_UNSPECIFIED",
+	1: "ACTION_TYPE_TRIP_CIRCUIT",
+	2: "ACTION_TYPE_PAUSE_MODULE",
+	3: "ACTION_TYPE_EXPEDITE_PROPOSAL",
+}
+
+var ActionType_value = map[string]int32{
+	"ACTION_TYPE_UNSPECIFIED":       0,
+	"ACTION_TYPE_TRIP_CIRCUIT":      1,
+	"ACTION_TYPE_PAUSE_MODULE":      2,
+	"ACTION_TYPE_EXPEDITE_PROPOSAL": 3,
+}
+
+func (x ActionType) String() string {
+	return proto.EnumName(ActionType_name, int32(x))
+}
+
+func (ActionType) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_bcc05be69a767d72, []int{0}
+}
+
+// ActionStatus enumerates the statuses of a council action.
+type ActionStatus int32
+
+const (
+	// ACTION_STATUS_UNSPECIFIED defines a no-op action status.
+	StatusUnspecified ActionStatus = 0
+	// ACTION_STATUS_ACTIVE defines an action which is in effect.
+	StatusActive ActionStatus = 1
+	// ACTION_STATUS_EXPIRED defines an action which reached its expiry.
+	StatusExpired ActionStatus = 2
+	// ACTION_STATUS_REVOKED defines an action revoked by governance before its
+	// expiry.
+	StatusRevoked ActionStatus = 3
+)
+
+var ActionStatus_name = map[int32]string{
+	0: "ACTION_STATUS_UNSPECIFIED",
+	1: "ACTION_STATUS_ACTIVE",
+	2: "ACTION_STATUS_EXPIRED",
+	3: "ACTION_STATUS_REVOKED",
+}
+
+var ActionStatus_value = map[string]int32{
+	"ACTION_STATUS_UNSPECIFIED": 0,
+	"ACTION_STATUS_ACTIVE":      1,
+	"ACTION_STATUS_EXPIRED":     2,
+	"ACTION_STATUS_REVOKED":     3,
+}
+
+func (x ActionStatus) String() string {
+	return proto.EnumName(ActionStatus_name, int32(x))
+}
+
+func (ActionStatus) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_bcc05be69a767d72, []int{1}
+}
+
+// Action defines an action taken by a council member, recorded on-chain.
+type Action struct {
+	// id is the unique id of the action.
+	Id uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	// type is the type of the action.
+	Type ActionType `protobuf:"varint,2,opt,name=type,proto3,enum=cosmos.council.v1.ActionType" json:"type,omitempty"`
+	// target is the target of the action: the message type URL of a tripped
+	// circuit, the name of a paused module or the id of an expedited proposal.
+	Target string `protobuf:"bytes,3,opt,name=target,proto3" json:"target,omitempty"`
+	// member is the address of the council member who took the action.
+	Member string `protobuf:"bytes,4,opt,name=member,proto3" json:"member,omitempty"`
+	// reason is the reason of the action.
+	Reason string `protobuf:"bytes,5,opt,name=reason,proto3" json:"reason,omitempty"`
+	// created_at is the time at which the action was taken.
+	CreatedAt time.Time `protobuf:"bytes,6,opt,name=created_at,json=createdAt,proto3,stdtime" json:"created_at"`
+	// expires_at is the time at which the action stops being in effect, unless
+	// it is revoked by governance before.
+	ExpiresAt time.Time `protobuf:"bytes,7,opt,name=expires_at,json=expiresAt,proto3,stdtime" json:"expires_at"`
+	// status is the status of the action.
+	Status ActionStatus `protobuf:"varint,8,opt,name=status,proto3,enum=cosmos.council.v1.ActionStatus" json:"status,omitempty"`
+}
+
+func (m *Action) Reset()         { *m = Action{} }
+func (m *Action) String() string { return proto.CompactTextString(m) }
+func (*Action) ProtoMessage()    {}
+func (*Action) Descriptor() ([]byte, []int) {
+	return fileDescriptor_bcc05be69a767d72, []int{0}
+}
+func (m *Action) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Action) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Action.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Action) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Action.Merge(m, src)
+}
+func (m *Action) XXX_Size() int {
+	return m.Size()
+}
+func (m *Action) XXX_DiscardUnknown() {
+	xxx_messageInfo_Action.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Action proto.InternalMessageInfo
+
+func (m *Action) GetId() uint64 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
+}
+
+func (m *Action) GetType() ActionType {
+	if m != nil {
+		return m.Type
+	}
+	return ActionTypeUnspecified
+}
+
+func (m *Action) GetTarget() string {
+	if m != nil {
+		return m.Target
+	}
+	return ""
+}
+
+func (m *Action) GetMember() string {
+	if m != nil {
+		return m.Member
+	}
+	return ""
+}
+
+func (m *Action) GetReason() string {
+	if m != nil {
+		return m.Reason
+	}
+	return ""
+}
+
+func (m *Action) GetCreatedAt() time.Time {
+	if m != nil {
+		return m.CreatedAt
+	}
+	return time.Time{}
+}
+
+func (m *Action) GetExpiresAt() time.Time {
+	if m != nil {
+		return m.ExpiresAt
+	}
+	return time.Time{}
+}
+
+func (m *Action) GetStatus() ActionStatus {
+	if m != nil {
+		return m.Status
+	}
+	return StatusUnspecified
+}
+
+// Params defines the parameters of the council module.
+type Params struct {
+	// members are the addresses of the council members. Each member can take
+	// actions on its own.
+	Members []string `protobuf:"bytes,1,rep,name=members,proto3" json:"members,omitempty"`
+	// action_duration is the duration during which an action stays in effect.
+	ActionDuration time.Duration `protobuf:"bytes,2,opt,name=action_duration,json=actionDuration,proto3,stdduration" json:"action_duration"`
+	// pausable_modules are the names of the modules the council can pause,
+	// typically the IBC-facing modules.
+	PausableModules []string `protobuf:"bytes,3,rep,name=pausable_modules,json=pausableModules,proto3" json:"pausable_modules,omitempty"`
+}
+
+func (m *Params) Reset()         { *m = Params{} }
+func (m *Params) String() string { return proto.CompactTextString(m) }
+func (*Params) ProtoMessage()    {}
+func (*Params) Descriptor() ([]byte, []int) {
+	return fileDescriptor_bcc05be69a767d72, []int{1}
+}
+func (m *Params) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Params) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Params.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Params) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Params.Merge(m, src)
+}
+func (m *Params) XXX_Size() int {
+	return m.Size()
+}
+func (m *Params) XXX_DiscardUnknown() {
+	xxx_messageInfo_Params.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Params proto.InternalMessageInfo
+
+func (m *Params) GetMembers() []string {
+	if m != nil {
+		return m.Members
+	}
+	return nil
+}
+
+func (m *Params) GetActionDuration() time.Duration {
+	if m != nil {
+		return m.ActionDuration
+	}
+	return 0
+}
+
+func (m *Params) GetPausableModules() []string {
+	if m != nil {
+		return m.PausableModules
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterEnum("cosmos.council.v1.ActionType", ActionType_name, ActionType_value)
+	proto.RegisterEnum("cosmos.council.v1.ActionStatus", ActionStatus_name, ActionStatus_value)
+	proto.RegisterType((*Action)(nil), "cosmos.council.v1.Action")
+	proto.RegisterType((*Params)(nil), "cosmos.council.v1.Params")
+}
+
+func init() { proto.RegisterFile("cosmos/council/v1/council.proto", fileDescriptor_bcc05be69a767d72) }
+
+var fileDescriptor_bcc05be69a767d72 = []byte{
+	// 746 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x94, 0x41, 0x6f, 0xe3, 0x44,
+	0x14, 0xc7, 0x33, 0x49, 0xc8, 0xb2, 0xb3, 0xbb, 0x5d, 0xc7, 0x6a, 0xc1, 0x31, 0x5a, 0xc7, 0xda,
+	0x53, 0x88, 0xb6, 0x36, 0x0d, 0x88, 0x4a, 0xdc, 0xdc, 0xc4, 0x15, 0x16, 0x6d, 0x63, 0x1c, 0xa7,
+	0x2a, 0x5c, 0x2c, 0xc7, 0x9e, 0x06, 0xab, 0x71, 0xc6, 0xf2, 0x8c, 0xa3, 0xf6, 0x1b, 0xa0, 0x9c,
+	0x7a, 0xe4, 0x92, 0x13, 0x17, 0x8e, 0x3d, 0xf0, 0x21, 0x7a, 0xac, 0x7a, 0xe2, 0x80, 0x00, 0xb5,
+	0x87, 0x7e, 0x07, 0x2e, 0x20, 0x7b, 0xec, 0x36, 0x4d, 0x01, 0x89, 0x4b, 0xe4, 0x79, 0xef, 0xf7,
+	0xff, 0xc7, 0xff, 0x37, 0x4f, 0x86, 0x4d, 0x0f, 0x93, 0x10, 0x13, 0xd5, 0xc3, 0xc9, 0xd4, 0x0b,
+	0x26, 0xea, 0x6c, 0xab, 0x78, 0x54, 0xa2, 0x18, 0x53, 0xcc, 0xd7, 0x19, 0xa0, 0x14, 0xd5, 0xd9,
+	0x96, 0xb8, 0x3e, 0xc6, 0x63, 0x9c, 0x75, 0xd5, 0xf4, 0x89, 0x81, 0x62, 0xdd, 0x0d, 0x83, 0x29,
+	0x56, 0xb3, 0xdf, 0xbc, 0xd4, 0x60, 0x5a, 0x87, 0xb1, 0xb9, 0x11, 0x6b, 0x49, 0x63, 0x8c, 0xc7,
+	0x13, 0xa4, 0x66, 0xa7, 0x51, 0x72, 0xac, 0xfa, 0x49, 0xec, 0xd2, 0x00, 0x4f, 0xf3, 0x7e, 0x73,
+	0xb5, 0x4f, 0x83, 0x10, 0x11, 0xea, 0x86, 0x11, 0x03, 0xde, 0xfe, 0x59, 0x86, 0x35, 0xcd, 0x4b,
+	0x15, 0xfc, 0x1a, 0x2c, 0x07, 0xbe, 0x00, 0x64, 0xd0, 0xaa, 0x5a, 0xe5, 0xc0, 0xe7, 0xb7, 0x60,
+	0x95, 0x9e, 0x45, 0x48, 0x28, 0xcb, 0xa0, 0xb5, 0xd6, 0x79, 0xa3, 0x3c, 0x49, 0xa0, 0x30, 0xa1,
+	0x7d, 0x16, 0x21, 0x2b, 0x43, 0xf9, 0x0f, 0x60, 0x8d, 0xba, 0xf1, 0x18, 0x51, 0xa1, 0x22, 0x83,
+	0xd6, 0x73, 0x2b, 0x3f, 0xf1, 0x9f, 0xc0, 0x5a, 0x88, 0xc2, 0x11, 0x8a, 0x85, 0x6a, 0x5a, 0xdf,
+	0x11, 0xae, 0x7f, 0xde, 0x5c, 0xcf, 0xfd, 0x34, 0xdf, 0x8f, 0x11, 0x21, 0x03, 0x1a, 0x07, 0xd3,
+	0xb1, 0x95, 0x73, 0xa9, 0x53, 0x8c, 0x5c, 0x82, 0xa7, 0xc2, 0x7b, 0xcc, 0x89, 0x9d, 0xf8, 0x2f,
+	0x21, 0xf4, 0x62, 0xe4, 0x52, 0xe4, 0x3b, 0x2e, 0x15, 0x6a, 0x32, 0x68, 0xbd, 0xe8, 0x88, 0x0a,
+	0x4b, 0xa9, 0x14, 0x29, 0x15, 0xbb, 0x48, 0xb9, 0xf3, 0xea, 0xf2, 0xb7, 0x66, 0xe9, 0xfc, 0xf7,
+	0x26, 0xf8, 0xe9, 0xee, 0xa2, 0x0d, 0xac, 0xe7, 0xb9, 0x58, 0xa3, 0xa9, 0x13, 0x3a, 0x8d, 0x82,
+	0x18, 0x91, 0xd4, 0xe9, 0xd9, 0xff, 0x76, 0xca, 0xc5, 0x1a, 0xe5, 0xb7, 0x61, 0x8d, 0x50, 0x97,
+	0x26, 0x44, 0x78, 0x3f, 0x1b, 0x55, 0xf3, 0x5f, 0x47, 0x35, 0xc8, 0x30, 0x2b, 0xc7, 0xdf, 0xfe,
+	0x0a, 0x60, 0xcd, 0x74, 0x63, 0x37, 0x24, 0x7c, 0x07, 0x3e, 0x63, 0xc9, 0x89, 0x00, 0xe4, 0xca,
+	0x7f, 0x8e, 0xa8, 0x00, 0xf9, 0xaf, 0xe1, 0x6b, 0x37, 0xb3, 0x75, 0x8a, 0x5b, 0xcf, 0xee, 0xea,
+	0x45, 0xa7, 0xf1, 0x24, 0x46, 0x2f, 0x07, 0x58, 0x8a, 0x1f, 0xee, 0x53, 0xac, 0x31, 0x83, 0xa2,
+	0xcd, 0x7f, 0x0c, 0xb9, 0xc8, 0x4d, 0x88, 0x3b, 0x9a, 0x20, 0x27, 0xc4, 0x7e, 0x32, 0x41, 0x44,
+	0xa8, 0xa4, 0xef, 0x63, 0xbd, 0x2e, 0xea, 0xfb, 0xac, 0xfc, 0x85, 0x3c, 0xbf, 0xbb, 0x68, 0x7f,
+	0xc4, 0xde, 0x70, 0x93, 0xf8, 0x27, 0xea, 0xe9, 0xfd, 0xf6, 0xb3, 0x4c, 0xed, 0xbf, 0x00, 0x84,
+	0x0f, 0x2b, 0xc2, 0x7f, 0x0e, 0x3f, 0xd4, 0xba, 0xb6, 0xd1, 0x3f, 0x70, 0xec, 0x6f, 0x4c, 0xdd,
+	0x19, 0x1e, 0x0c, 0x4c, 0xbd, 0x6b, 0xec, 0x1a, 0x7a, 0x8f, 0x2b, 0x89, 0x8d, 0xf9, 0x42, 0xde,
+	0x78, 0x80, 0x87, 0x53, 0x12, 0x21, 0x2f, 0x38, 0x0e, 0x90, 0xcf, 0x6f, 0x43, 0x61, 0x59, 0x67,
+	0x5b, 0x86, 0xe9, 0x74, 0x0d, 0xab, 0x3b, 0x34, 0x6c, 0x0e, 0xac, 0x0a, 0xed, 0x38, 0x88, 0xba,
+	0x41, 0xec, 0x25, 0x01, 0x5d, 0x15, 0x9a, 0xda, 0x70, 0xa0, 0x3b, 0xfb, 0xfd, 0xde, 0x70, 0x4f,
+	0xe7, 0xca, 0xab, 0x42, 0xd3, 0x4d, 0x48, 0x9e, 0x8d, 0xd7, 0xe0, 0x9b, 0x65, 0xa1, 0x7e, 0x64,
+	0xea, 0x3d, 0xc3, 0xd6, 0x1d, 0xd3, 0xea, 0x9b, 0xfd, 0x81, 0xb6, 0xc7, 0x55, 0x44, 0x69, 0xbe,
+	0x90, 0xc5, 0x07, 0xb5, 0x7e, 0x1a, 0x21, 0x3f, 0xa0, 0xc8, 0x8c, 0x71, 0x84, 0x89, 0x3b, 0x11,
+	0xab, 0xdf, 0xff, 0x28, 0x95, 0xda, 0xd7, 0x00, 0xbe, 0x5c, 0xbe, 0x79, 0xfe, 0x33, 0xd8, 0xc8,
+	0x9d, 0x07, 0xb6, 0x66, 0x0f, 0x07, 0x2b, 0x53, 0xd8, 0x98, 0x2f, 0xe4, 0x3a, 0x43, 0x97, 0x27,
+	0xd0, 0x86, 0xeb, 0x8f, 0x55, 0xe9, 0xe9, 0x50, 0xe7, 0x80, 0xc8, 0xcd, 0x17, 0xf2, 0x4b, 0x26,
+	0x48, 0xff, 0x67, 0x86, 0xf8, 0x77, 0x70, 0xe3, 0x31, 0xab, 0x1f, 0x99, 0x86, 0xa5, 0xf7, 0xb8,
+	0xb2, 0x58, 0x9f, 0x2f, 0xe4, 0x57, 0x0c, 0xd6, 0xb3, 0xe5, 0xf5, 0x9f, 0xd2, 0x96, 0x7e, 0xd8,
+	0xff, 0x4a, 0xef, 0x71, 0x95, 0x65, 0xda, 0x42, 0x33, 0x7c, 0x82, 0x7c, 0x16, 0x6a, 0x67, 0xf7,
+	0xf2, 0x46, 0x02, 0x57, 0x37, 0x12, 0xf8, 0xe3, 0x46, 0x02, 0xe7, 0xb7, 0x52, 0xe9, 0xea, 0x56,
+	0x2a, 0xfd, 0x72, 0x2b, 0x95, 0xbe, 0x7d, 0x37, 0x0e, 0xe8, 0x77, 0xc9, 0x48, 0xf1, 0x70, 0xa8,
+	0xde, 0x7f, 0x10, 0xff, 0x61, 0x3f, 0xd2, 0x6f, 0x05, 0x19, 0xd5, 0xb2, 0xed, 0xfc, 0xf4, 0xef,
+	0x01, 0x00, 0xc3, 0x2b, 0xaa, 0x2c, 0x3c, 0x05, 0x00, 0x00,
+}
+
+func (m *Action) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Action) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Action) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Status != 0 {
+		i = encodeVarintCouncil(dAtA, i, uint64(m.Status))
+		i--
+		dAtA[i] = 0x40
+	}
+	n1, err1 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.ExpiresAt, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.ExpiresAt):])
+	if err1 != nil {
+		return 0, err1
+	}
+	i -= n1
+	i = encodeVarintCouncil(dAtA, i, uint64(n1))
+	i--
+	dAtA[i] = 0x3a
+	n2, err2 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.CreatedAt, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.CreatedAt):])
+	if err2 != nil {
+		return 0, err2
+	}
+	i -= n2
+	i = encodeVarintCouncil(dAtA, i, uint64(n2))
+	i--
+	dAtA[i] = 0x32
+	if len(m.Reason) > 0 {
+		i -= len(m.Reason)
+		copy(dAtA[i:], m.Reason)
+		i = encodeVarintCouncil(dAtA, i, uint64(len(m.Reason)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.Member) > 0 {
+		i -= len(m.Member)
+		copy(dAtA[i:], m.Member)
+		i = encodeVarintCouncil(dAtA, i, uint64(len(m.Member)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Target) > 0 {
+		i -= len(m.Target)
+		copy(dAtA[i:], m.Target)
+		i = encodeVarintCouncil(dAtA, i, uint64(len(m.Target)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.Type != 0 {
+		i = encodeVarintCouncil(dAtA, i, uint64(m.Type))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Id != 0 {
+		i = encodeVarintCouncil(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *Params) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Params) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.PausableModules) > 0 {
+		for iNdEx := len(m.PausableModules) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.PausableModules[iNdEx])
+			copy(dAtA[i:], m.PausableModules[iNdEx])
+			i = encodeVarintCouncil(dAtA, i, uint64(len(m.PausableModules[iNdEx])))
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	n3, err3 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.ActionDuration, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.ActionDuration):])
+	if err3 != nil {
+		return 0, err3
+	}
+	i -= n3
+	i = encodeVarintCouncil(dAtA, i, uint64(n3))
+	i--
+	dAtA[i] = 0x12
+	if len(m.Members) > 0 {
+		for iNdEx := len(m.Members) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Members[iNdEx])
+			copy(dAtA[i:], m.Members[iNdEx])
+			i = encodeVarintCouncil(dAtA, i, uint64(len(m.Members[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintCouncil(dAtA []byte, offset int, v uint64) int {
+	offset -= sovCouncil(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *Action) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovCouncil(uint64(m.Id))
+	}
+	if m.Type != 0 {
+		n += 1 + sovCouncil(uint64(m.Type))
+	}
+	l = len(m.Target)
+	if l > 0 {
+		n += 1 + l + sovCouncil(uint64(l))
+	}
+	l = len(m.Member)
+	if l > 0 {
+		n += 1 + l + sovCouncil(uint64(l))
+	}
+	l = len(m.Reason)
+	if l > 0 {
+		n += 1 + l + sovCouncil(uint64(l))
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.CreatedAt)
+	n += 1 + l + sovCouncil(uint64(l))
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.ExpiresAt)
+	n += 1 + l + sovCouncil(uint64(l))
+	if m.Status != 0 {
+		n += 1 + sovCouncil(uint64(m.Status))
+	}
+	return n
+}
+
+func (m *Params) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Members) > 0 {
+		for _, s := range m.Members {
+			l = len(s)
+			n += 1 + l + sovCouncil(uint64(l))
+		}
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.ActionDuration)
+	n += 1 + l + sovCouncil(uint64(l))
+	if len(m.PausableModules) > 0 {
+		for _, s := range m.PausableModules {
+			l = len(s)
+			n += 1 + l + sovCouncil(uint64(l))
+		}
+	}
+	return n
+}
+
+func sovCouncil(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozCouncil(x uint64) (n int) {
+	return sovCouncil(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *Action) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowCouncil
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Action: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Action: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCouncil
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
+			}
+			m.Type = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCouncil
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Type |= ActionType(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Target", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCouncil
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCouncil
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCouncil
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Target = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Member", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCouncil
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCouncil
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCouncil
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Member = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCouncil
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCouncil
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCouncil
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Reason = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CreatedAt", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCouncil
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCouncil
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCouncil
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.CreatedAt, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExpiresAt", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCouncil
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCouncil
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCouncil
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.ExpiresAt, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			}
+			m.Status = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCouncil
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Status |= ActionStatus(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipCouncil(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthCouncil
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Params) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowCouncil
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Params: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Params: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Members", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCouncil
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCouncil
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCouncil
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Members = append(m.Members, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ActionDuration", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCouncil
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCouncil
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCouncil
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdDurationUnmarshal(&m.ActionDuration, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PausableModules", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCouncil
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCouncil
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCouncil
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PausableModules = append(m.PausableModules, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipCouncil(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthCouncil
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipCouncil(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowCouncil
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowCouncil
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowCouncil
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthCouncil
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupCouncil
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthCouncil
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthCouncil        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowCouncil          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupCouncil = fmt.Errorf("proto: unexpected end of group")
+)
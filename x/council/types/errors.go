@@ -0,0 +1,14 @@
+package types
+
+import "cosmossdk.io/errors"
+
+// x/council module sentinel errors
+var (
+	ErrNotCouncilMember       = errors.Register(ModuleName, 2, "not a council member")
+	ErrInvalidActionReason    = errors.Register(ModuleName, 3, "invalid action reason")
+	ErrActionNotAllowed       = errors.Register(ModuleName, 4, "action not allowed")
+	ErrActionAlreadyActive    = errors.Register(ModuleName, 5, "action already active")
+	ErrActionNotFound         = errors.Register(ModuleName, 6, "action not found")
+	ErrActionNotActive        = errors.Register(ModuleName, 7, "action not active")
+	ErrDuplicateGenesisAction = errors.Register(ModuleName, 8, "duplicate action in genesis")
+)
@@ -0,0 +1,15 @@
+package types
+
+// council module event types
+const (
+	EventTypeTakeAction   = "take_council_action"
+	EventTypeRevokeAction = "revoke_council_action"
+	EventTypeExpireAction = "expire_council_action"
+
+	AttributeKeyActionID   = "action_id"
+	AttributeKeyActionType = "action_type"
+	AttributeKeyTarget     = "target"
+	AttributeKeyMember     = "member"
+	AttributeKeyReason     = "reason"
+	AttributeKeyExpiresAt  = "expires_at"
+)
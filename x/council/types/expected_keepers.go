@@ -0,0 +1,13 @@
+package types
+
+import (
+	"context"
+
+	v1 "github.com/cosmos/cosmos-sdk/x/gov/types/v1"
+)
+
+// GovKeeper defines the expected gov keeper, used to expedite proposals.
+type GovKeeper interface {
+	GetProposal(ctx context.Context, proposalID uint64) (v1.Proposal, error)
+	SetProposalExpedited(ctx context.Context, proposalID uint64, expedited bool) error
+}
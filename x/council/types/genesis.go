@@ -0,0 +1,56 @@
+package types
+
+import (
+	"fmt"
+
+	errorsmod "cosmossdk.io/errors"
+)
+
+// DefaultGenesisState returns the default genesis state, which has no
+// actions.
+func DefaultGenesisState() *GenesisState {
+	return &GenesisState{
+		Params: DefaultParams(),
+	}
+}
+
+// Validate performs basic genesis state validation.
+func (gs GenesisState) Validate() error {
+	if err := gs.Params.Validate(); err != nil {
+		return err
+	}
+
+	type activeTarget struct {
+		actionType ActionType
+		target     string
+	}
+
+	ids := make(map[uint64]struct{}, len(gs.Actions))
+	active := make(map[activeTarget]struct{})
+	for _, a := range gs.Actions {
+		if err := a.Validate(); err != nil {
+			return err
+		}
+
+		if _, ok := ids[a.Id]; ok {
+			return errorsmod.Wrapf(ErrDuplicateGenesisAction, "id %d", a.Id)
+		}
+		ids[a.Id] = struct{}{}
+
+		if a.Id == 0 || a.Id > gs.LastActionId {
+			return fmt.Errorf("action id %d is not between 1 and the last action id %d", a.Id, gs.LastActionId)
+		}
+
+		if a.Status != StatusActive {
+			continue
+		}
+
+		t := activeTarget{actionType: a.Type, target: a.Target}
+		if _, ok := active[t]; ok {
+			return errorsmod.Wrapf(ErrActionAlreadyActive, "%s on %s", a.Type, a.Target)
+		}
+		active[t] = struct{}{}
+	}
+
+	return nil
+}
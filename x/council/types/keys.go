@@ -0,0 +1,36 @@
+package types
+
+import "cosmossdk.io/collections"
+
+const (
+	// ModuleName defines the name of the x/council module.
+	ModuleName = "council"
+
+	// StoreKey defines the module's store key.
+	StoreKey = ModuleName
+)
+
+var (
+	// ParamsKey is the key under which the module parameters are stored.
+	ParamsKey = collections.NewPrefix(0)
+
+	// LastActionIDKey is the key under which the id of the last action is
+	// stored.
+	LastActionIDKey = collections.NewPrefix(1)
+
+	// ActionsPrefix is the prefix under which the actions taken by the council
+	// are stored, indexed by id.
+	ActionsPrefix = collections.NewPrefix(2)
+
+	// ActiveActionsPrefix is the prefix under which the ids of the active
+	// actions are indexed by expiry time.
+	ActiveActionsPrefix = collections.NewPrefix(3)
+
+	// TrippedCircuitsPrefix is the prefix under which the ids of the actions
+	// tripping a circuit are indexed by message type URL.
+	TrippedCircuitsPrefix = collections.NewPrefix(4)
+
+	// PausedModulesPrefix is the prefix under which the ids of the actions
+	// pausing a module are indexed by module name.
+	PausedModulesPrefix = collections.NewPrefix(5)
+)
@@ -0,0 +1,84 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/auth/migrations/legacytx"
+)
+
+var (
+	_ sdk.Msg            = &MsgTripCircuit{}
+	_ legacytx.LegacyMsg = &MsgTripCircuit{}
+	_ sdk.Msg            = &MsgPauseModule{}
+	_ legacytx.LegacyMsg = &MsgPauseModule{}
+	_ sdk.Msg            = &MsgExpediteProposal{}
+	_ legacytx.LegacyMsg = &MsgExpediteProposal{}
+	_ sdk.Msg            = &MsgRevokeAction{}
+	_ legacytx.LegacyMsg = &MsgRevokeAction{}
+	_ sdk.Msg            = &MsgUpdateParams{}
+	_ legacytx.LegacyMsg = &MsgUpdateParams{}
+)
+
+// GetSigners returns the signer addresses that are expected to sign the result
+// of GetSignBytes.
+func (msg MsgTripCircuit) GetSigners() []sdk.AccAddress {
+	member, _ := sdk.AccAddressFromBech32(msg.Member)
+	return []sdk.AccAddress{member}
+}
+
+// GetSignBytes returns the raw bytes for a MsgTripCircuit message that
+// the expected signer needs to sign.
+func (msg MsgTripCircuit) GetSignBytes() []byte {
+	return sdk.MustSortJSON(amino.MustMarshalJSON(&msg))
+}
+
+// GetSigners returns the signer addresses that are expected to sign the result
+// of GetSignBytes.
+func (msg MsgPauseModule) GetSigners() []sdk.AccAddress {
+	member, _ := sdk.AccAddressFromBech32(msg.Member)
+	return []sdk.AccAddress{member}
+}
+
+// GetSignBytes returns the raw bytes for a MsgPauseModule message that
+// the expected signer needs to sign.
+func (msg MsgPauseModule) GetSignBytes() []byte {
+	return sdk.MustSortJSON(amino.MustMarshalJSON(&msg))
+}
+
+// GetSigners returns the signer addresses that are expected to sign the result
+// of GetSignBytes.
+func (msg MsgExpediteProposal) GetSigners() []sdk.AccAddress {
+	member, _ := sdk.AccAddressFromBech32(msg.Member)
+	return []sdk.AccAddress{member}
+}
+
+// GetSignBytes returns the raw bytes for a MsgExpediteProposal message that
+// the expected signer needs to sign.
+func (msg MsgExpediteProposal) GetSignBytes() []byte {
+	return sdk.MustSortJSON(amino.MustMarshalJSON(&msg))
+}
+
+// GetSigners returns the signer addresses that are expected to sign the result
+// of GetSignBytes.
+func (msg MsgRevokeAction) GetSigners() []sdk.AccAddress {
+	authority, _ := sdk.AccAddressFromBech32(msg.Authority)
+	return []sdk.AccAddress{authority}
+}
+
+// GetSignBytes returns the raw bytes for a MsgRevokeAction message that
+// the expected signer needs to sign.
+func (msg MsgRevokeAction) GetSignBytes() []byte {
+	return sdk.MustSortJSON(amino.MustMarshalJSON(&msg))
+}
+
+// GetSigners returns the signer addresses that are expected to sign the result
+// of GetSignBytes.
+func (msg MsgUpdateParams) GetSigners() []sdk.AccAddress {
+	authority, _ := sdk.AccAddressFromBech32(msg.Authority)
+	return []sdk.AccAddress{authority}
+}
+
+// GetSignBytes returns the raw bytes for a MsgUpdateParams message that
+// the expected signer needs to sign.
+func (msg MsgUpdateParams) GetSignBytes() []byte {
+	return sdk.MustSortJSON(amino.MustMarshalJSON(&msg))
+}
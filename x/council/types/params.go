@@ -0,0 +1,83 @@
+package types
+
+import (
+	"fmt"
+	"time"
+
+	errorsmod "cosmossdk.io/errors"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// DefaultActionDuration is the default duration during which an action stays
+// in effect.
+const DefaultActionDuration = 72 * time.Hour
+
+// DefaultParams returns the default parameters of the module, which have no
+// council members.
+func DefaultParams() Params {
+	return Params{
+		ActionDuration: DefaultActionDuration,
+	}
+}
+
+// IsMember returns true if the address is a council member.
+func (p Params) IsMember(addr string) bool {
+	for _, m := range p.Members {
+		if m == addr {
+			return true
+		}
+	}
+
+	return false
+}
+
+// IsPausable returns true if the council can pause the module.
+func (p Params) IsPausable(moduleName string) bool {
+	for _, m := range p.PausableModules {
+		if m == moduleName {
+			return true
+		}
+	}
+
+	return false
+}
+
+// Validate performs basic validation of the parameters.
+func (p Params) Validate() error {
+	members := make(map[string]struct{}, len(p.Members))
+	for _, m := range p.Members {
+		if _, err := sdk.AccAddressFromBech32(m); err != nil {
+			return errorsmod.Wrapf(err, "invalid council member address %s", m)
+		}
+
+		if _, ok := members[m]; ok {
+			return fmt.Errorf("duplicate council member %s", m)
+		}
+		members[m] = struct{}{}
+	}
+
+	if p.ActionDuration <= 0 {
+		return fmt.Errorf("action duration must be positive, got %s", p.ActionDuration)
+	}
+
+	modules := make(map[string]struct{}, len(p.PausableModules))
+	for _, m := range p.PausableModules {
+		if m == "" {
+			return fmt.Errorf("pausable module name cannot be empty")
+		}
+
+		for _, unpausable := range unpausableModules {
+			if m == unpausable {
+				return fmt.Errorf("module %s cannot be paused", m)
+			}
+		}
+
+		if _, ok := modules[m]; ok {
+			return fmt.Errorf("duplicate pausable module %s", m)
+		}
+		modules[m] = struct{}{}
+	}
+
+	return nil
+}
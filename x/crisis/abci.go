@@ -9,12 +9,19 @@ import (
 	"github.com/cosmos/cosmos-sdk/x/crisis/types"
 )
 
-// check all registered invariants
+// check the targeted invariants scheduled in the block, and all registered
+// invariants every invariant check period
 func EndBlocker(ctx sdk.Context, k keeper.Keeper) {
 	defer telemetry.ModuleMeasureSince(types.ModuleName, time.Now(), telemetry.MetricKeyEndBlocker)
 
-	if k.InvCheckPeriod() == 0 || ctx.BlockHeight()%int64(k.InvCheckPeriod()) != 0 {
-		// skip running the invariant check
+	if k.InvCheckPeriod() == 0 {
+		// skip running the invariant checks
+		return
+	}
+	k.AssertTargetedInvariants(ctx)
+
+	if ctx.BlockHeight()%int64(k.InvCheckPeriod()) != 0 {
+		// skip running the full invariant check
 		return
 	}
 	k.AssertInvariants(ctx)
@@ -0,0 +1,106 @@
+package cli
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/version"
+	"github.com/cosmos/cosmos-sdk/x/crisis/types"
+)
+
+// GetQueryCmd returns the cli query commands for the crisis module.
+func GetQueryCmd() *cobra.Command {
+	queryCmd := &cobra.Command{
+		Use:                        types.ModuleName,
+		Short:                      "Querying commands for the crisis module",
+		DisableFlagParsing:         true,
+		SuggestionsMinimumDistance: 2,
+		RunE:                       client.ValidateCmd,
+	}
+
+	queryCmd.AddCommand(
+		GetCmdQueryInvariants(),
+		GetCmdCheckInvariants(),
+	)
+
+	return queryCmd
+}
+
+// GetCmdQueryInvariants implements the query invariants command.
+func GetCmdQueryInvariants() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "invariants",
+		Args:  cobra.NoArgs,
+		Short: "Query the registered invariant routes",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.Invariants(cmd.Context(), &types.QueryInvariantsRequest{})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// GetCmdCheckInvariants implements the check invariants command.
+func GetCmdCheckInvariants() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "check-invariants [module-name] [invariant-route]",
+		Args:  cobra.RangeArgs(0, 2),
+		Short: "Run a full scan of the registered invariants against the latest state",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Run a full scan of the registered invariants against the latest state
+of the queried node, without halting the chain when one is broken. The scan can
+be restricted to the invariants of a module, or to a single invariant route.
+
+Example:
+$ %s query crisis check-invariants
+$ %s query crisis check-invariants staking
+$ %s query crisis check-invariants staking module-accounts
+`,
+				version.AppName, version.AppName, version.AppName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			req := &types.QueryCheckInvariantsRequest{}
+			if len(args) > 0 {
+				req.ModuleName = args[0]
+			}
+			if len(args) > 1 {
+				req.Route = args[1]
+			}
+
+			res, err := queryClient.CheckInvariants(cmd.Context(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
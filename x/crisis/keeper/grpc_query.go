@@ -0,0 +1,58 @@
+package keeper
+
+import (
+	"context"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/crisis/types"
+)
+
+var _ types.QueryServer = Querier{}
+
+type Querier struct {
+	*Keeper
+}
+
+func NewQuerier(keeper *Keeper) Querier {
+	return Querier{Keeper: keeper}
+}
+
+// Invariants implements the Query/Invariants gRPC method
+func (k Querier) Invariants(_ context.Context, _ *types.QueryInvariantsRequest) (*types.QueryInvariantsResponse, error) {
+	routes := make([]types.InvariantRoute, 0, len(k.routes)+len(k.targetedRoutes))
+	for _, ir := range k.routes {
+		routes = append(routes, types.InvariantRoute{ModuleName: ir.ModuleName, Route: ir.Route})
+	}
+
+	for _, ir := range k.targetedRoutes {
+		routes = append(routes, types.InvariantRoute{ModuleName: ir.ModuleName, Route: ir.Route, Targeted: true})
+	}
+
+	return &types.QueryInvariantsResponse{Routes: routes}, nil
+}
+
+// CheckInvariants implements the Query/CheckInvariants gRPC method
+func (k Querier) CheckInvariants(ctx context.Context, req *types.QueryCheckInvariantsRequest) (*types.QueryCheckInvariantsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	if req.ModuleName == "" && req.Route != "" {
+		return nil, status.Error(codes.InvalidArgument, "route requires a module name")
+	}
+
+	results := k.RunInvariants(sdk.UnwrapSDKContext(ctx), req.ModuleName, req.Route)
+	if len(results) == 0 && req.ModuleName != "" {
+		return nil, status.Errorf(codes.NotFound, "%s: %s/%s", types.ErrUnknownInvariant, req.ModuleName, req.Route)
+	}
+
+	broken := false
+	for _, res := range results {
+		broken = broken || res.Broken
+	}
+
+	return &types.QueryCheckInvariantsResponse{Results: results, Broken: broken}, nil
+}
@@ -1,7 +1,9 @@
 package keeper
 
 import (
+	"context"
 	"fmt"
+	"math"
 	"time"
 
 	"cosmossdk.io/core/address"
@@ -17,8 +19,10 @@ import (
 // Keeper - crisis keeper
 type Keeper struct {
 	routes         []types.InvarRoute
+	targetedRoutes []types.TargetedInvarRoute
 	invCheckPeriod uint
 	storeKey       storetypes.StoreKey
+	tStoreKey      storetypes.StoreKey
 	cdc            codec.BinaryCodec
 
 	// the address capable of executing a MsgUpdateParams message. Typically, this
@@ -34,13 +38,15 @@ type Keeper struct {
 
 // NewKeeper creates a new Keeper object
 func NewKeeper(
-	cdc codec.BinaryCodec, storeKey storetypes.StoreKey, invCheckPeriod uint,
+	cdc codec.BinaryCodec, storeKey, tStoreKey storetypes.StoreKey, invCheckPeriod uint,
 	supplyKeeper types.SupplyKeeper, feeCollectorName, authority string, ac address.Codec,
 ) *Keeper {
 	return &Keeper{
 		storeKey:         storeKey,
+		tStoreKey:        tStoreKey,
 		cdc:              cdc,
 		routes:           make([]types.InvarRoute, 0),
+		targetedRoutes:   make([]types.TargetedInvarRoute, 0),
 		invCheckPeriod:   invCheckPeriod,
 		supplyKeeper:     supplyKeeper,
 		feeCollectorName: feeCollectorName,
@@ -79,6 +85,96 @@ func (k *Keeper) Invariants() []sdk.Invariant {
 	return invars
 }
 
+// RegisterTargetedRoute registers the route of a targeted invariant.
+func (k *Keeper) RegisterTargetedRoute(moduleName, route string, invar sdk.TargetedInvariant) {
+	invarRoute := types.NewTargetedInvarRoute(moduleName, route, invar)
+	if len(invarRoute.FullRoute()) > math.MaxUint8 {
+		panic(fmt.Errorf("targeted invariant route %s is too long", invarRoute.FullRoute()))
+	}
+
+	k.targetedRoutes = append(k.targetedRoutes, invarRoute)
+}
+
+// TargetedRoutes returns the keeper's targeted invariant routes.
+func (k *Keeper) TargetedRoutes() []types.TargetedInvarRoute {
+	return k.targetedRoutes
+}
+
+// ScheduleInvariantCheck schedules the check of a targeted invariant for a
+// subject at the end of the block. The checks are only scheduled when the
+// invariants are asserted by the node, and do not consume gas, so that they do
+// not affect the execution of the transactions.
+func (k *Keeper) ScheduleInvariantCheck(ctx context.Context, moduleName, route string, subject []byte) {
+	if k.invCheckPeriod == 0 {
+		return
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx).WithGasMeter(storetypes.NewInfiniteGasMeter())
+	store := sdkCtx.TransientStore(k.tStoreKey)
+	fullRoute := types.NewTargetedInvarRoute(moduleName, route, nil).FullRoute()
+	store.Set(types.PendingInvariantCheckKey(fullRoute, subject), []byte{})
+}
+
+// AssertTargetedInvariants checks the targeted invariants for the subjects
+// scheduled in the current block. If any invariant fails, the method panics.
+func (k *Keeper) AssertTargetedInvariants(ctx sdk.Context) {
+	store := ctx.WithGasMeter(storetypes.NewInfiniteGasMeter()).TransientStore(k.tStoreKey)
+	iterator := storetypes.KVStorePrefixIterator(store, types.PendingInvariantCheckPrefix)
+	defer iterator.Close()
+
+	routes := make(map[string]types.TargetedInvarRoute, len(k.targetedRoutes))
+	for _, ir := range k.targetedRoutes {
+		routes[ir.FullRoute()] = ir
+	}
+
+	checks := 0
+	for ; iterator.Valid(); iterator.Next() {
+		fullRoute, subject, err := types.ParsePendingInvariantCheckKey(iterator.Key())
+		if err != nil {
+			panic(err)
+		}
+
+		ir, ok := routes[fullRoute]
+		if !ok {
+			// the checks of the invariants which are not registered are skipped
+			continue
+		}
+
+		invCtx, _ := ctx.CacheContext()
+		if res, stop := ir.Invar(invCtx, subject); stop {
+			panic(fmt.Errorf("targeted invariant %s broken for subject %X: %s", fullRoute, subject, res))
+		}
+		checks++
+	}
+
+	if checks > 0 {
+		k.Logger(ctx).Debug("asserted targeted invariants", "checks", checks, "height", ctx.BlockHeight())
+	}
+}
+
+// RunInvariants runs the registered invariants, restricted to the ones of a
+// module or to a single route if set, and returns their results without
+// panicking when one is broken.
+func (k *Keeper) RunInvariants(ctx sdk.Context, moduleName, route string) []types.InvariantResult {
+	results := make([]types.InvariantResult, 0)
+	for _, ir := range k.routes {
+		if (moduleName != "" && ir.ModuleName != moduleName) || (route != "" && ir.Route != route) {
+			continue
+		}
+
+		invCtx, _ := ctx.CacheContext()
+		res, stop := ir.Invar(invCtx)
+		results = append(results, types.InvariantResult{
+			ModuleName: ir.ModuleName,
+			Route:      ir.Route,
+			Broken:     stop,
+			Message:    res,
+		})
+	}
+
+	return results
+}
+
 // AssertInvariants asserts all registered invariants. If any invariant fails,
 // the method panics.
 func (k *Keeper) AssertInvariants(ctx sdk.Context) {
@@ -23,9 +23,10 @@ func TestLogger(t *testing.T) {
 	supplyKeeper := crisistestutil.NewMockSupplyKeeper(ctrl)
 
 	key := storetypes.NewKVStoreKey(types.StoreKey)
-	testCtx := testutil.DefaultContextWithDB(t, key, storetypes.NewTransientStoreKey("transient_test"))
+	tkey := storetypes.NewTransientStoreKey(types.TStoreKey)
+	testCtx := testutil.DefaultContextWithDB(t, key, tkey)
 	encCfg := moduletestutil.MakeTestEncodingConfig(crisis.AppModuleBasic{})
-	keeper := keeper.NewKeeper(encCfg.Codec, key, 5, supplyKeeper, "", "", addresscodec.NewBech32Codec("cosmos"))
+	keeper := keeper.NewKeeper(encCfg.Codec, key, tkey, 5, supplyKeeper, "", "", addresscodec.NewBech32Codec("cosmos"))
 
 	require.Equal(t,
 		testCtx.Ctx.Logger().With("module", "x/"+types.ModuleName),
@@ -37,8 +38,9 @@ func TestInvariants(t *testing.T) {
 	supplyKeeper := crisistestutil.NewMockSupplyKeeper(ctrl)
 
 	key := storetypes.NewKVStoreKey(types.StoreKey)
+	tkey := storetypes.NewTransientStoreKey(types.TStoreKey)
 	encCfg := moduletestutil.MakeTestEncodingConfig(crisis.AppModuleBasic{})
-	keeper := keeper.NewKeeper(encCfg.Codec, key, 5, supplyKeeper, "", "", addresscodec.NewBech32Codec("cosmos"))
+	keeper := keeper.NewKeeper(encCfg.Codec, key, tkey, 5, supplyKeeper, "", "", addresscodec.NewBech32Codec("cosmos"))
 	require.Equal(t, keeper.InvCheckPeriod(), uint(5))
 
 	orgInvRoutes := keeper.Routes()
@@ -52,9 +54,10 @@ func TestAssertInvariants(t *testing.T) {
 	supplyKeeper := crisistestutil.NewMockSupplyKeeper(ctrl)
 
 	key := storetypes.NewKVStoreKey(types.StoreKey)
-	testCtx := testutil.DefaultContextWithDB(t, key, storetypes.NewTransientStoreKey("transient_test"))
+	tkey := storetypes.NewTransientStoreKey(types.TStoreKey)
+	testCtx := testutil.DefaultContextWithDB(t, key, tkey)
 	encCfg := moduletestutil.MakeTestEncodingConfig(crisis.AppModuleBasic{})
-	keeper := keeper.NewKeeper(encCfg.Codec, key, 5, supplyKeeper, "", "", addresscodec.NewBech32Codec("cosmos"))
+	keeper := keeper.NewKeeper(encCfg.Codec, key, tkey, 5, supplyKeeper, "", "", addresscodec.NewBech32Codec("cosmos"))
 
 	keeper.RegisterRoute("testModule", "testRoute1", func(sdk.Context) (string, bool) { return "", false })
 	require.NotPanics(t, func() { keeper.AssertInvariants(testCtx.Ctx) })
@@ -62,3 +65,55 @@ func TestAssertInvariants(t *testing.T) {
 	keeper.RegisterRoute("testModule", "testRoute2", func(sdk.Context) (string, bool) { return "", true })
 	require.Panics(t, func() { keeper.AssertInvariants(testCtx.Ctx) })
 }
+
+func TestAssertTargetedInvariants(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	supplyKeeper := crisistestutil.NewMockSupplyKeeper(ctrl)
+
+	key := storetypes.NewKVStoreKey(types.StoreKey)
+	tkey := storetypes.NewTransientStoreKey(types.TStoreKey)
+	testCtx := testutil.DefaultContextWithDB(t, key, tkey)
+	encCfg := moduletestutil.MakeTestEncodingConfig(crisis.AppModuleBasic{})
+	keeper := keeper.NewKeeper(encCfg.Codec, key, tkey, 5, supplyKeeper, "", "", addresscodec.NewBech32Codec("cosmos"))
+
+	var checked [][]byte
+	keeper.RegisterTargetedRoute("testModule", "testRoute", func(_ sdk.Context, subject []byte) (string, bool) {
+		checked = append(checked, subject)
+		return "", string(subject) == "broken"
+	})
+	require.Len(t, keeper.TargetedRoutes(), 1)
+
+	// the checks are only run for the scheduled subjects of registered routes
+	keeper.ScheduleInvariantCheck(testCtx.Ctx, "testModule", "testRoute", []byte("subject1"))
+	keeper.ScheduleInvariantCheck(testCtx.Ctx, "testModule", "testRoute", []byte("subject1"))
+	keeper.ScheduleInvariantCheck(testCtx.Ctx, "testModule", "unknownRoute", []byte("subject2"))
+	require.NotPanics(t, func() { keeper.AssertTargetedInvariants(testCtx.Ctx) })
+	require.Equal(t, [][]byte{[]byte("subject1")}, checked)
+
+	keeper.ScheduleInvariantCheck(testCtx.Ctx, "testModule", "testRoute", []byte("broken"))
+	require.Panics(t, func() { keeper.AssertTargetedInvariants(testCtx.Ctx) })
+}
+
+func TestRunInvariants(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	supplyKeeper := crisistestutil.NewMockSupplyKeeper(ctrl)
+
+	key := storetypes.NewKVStoreKey(types.StoreKey)
+	tkey := storetypes.NewTransientStoreKey(types.TStoreKey)
+	testCtx := testutil.DefaultContextWithDB(t, key, tkey)
+	encCfg := moduletestutil.MakeTestEncodingConfig(crisis.AppModuleBasic{})
+	keeper := keeper.NewKeeper(encCfg.Codec, key, tkey, 5, supplyKeeper, "", "", addresscodec.NewBech32Codec("cosmos"))
+
+	keeper.RegisterRoute("testModule", "testRoute1", func(sdk.Context) (string, bool) { return "ok", false })
+	keeper.RegisterRoute("testModule", "testRoute2", func(sdk.Context) (string, bool) { return "broken", true })
+	keeper.RegisterRoute("otherModule", "testRoute1", func(sdk.Context) (string, bool) { return "ok", false })
+
+	require.Len(t, keeper.RunInvariants(testCtx.Ctx, "", ""), 3)
+	require.Equal(t, []types.InvariantResult{
+		{ModuleName: "testModule", Route: "testRoute1", Message: "ok"},
+		{ModuleName: "testModule", Route: "testRoute2", Broken: true, Message: "broken"},
+	}, keeper.RunInvariants(testCtx.Ctx, "testModule", ""))
+	require.Equal(t, []types.InvariantResult{
+		{ModuleName: "otherModule", Route: "testRoute1", Message: "ok"},
+	}, keeper.RunInvariants(testCtx.Ctx, "otherModule", "testRoute1"))
+}
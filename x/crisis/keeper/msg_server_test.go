@@ -34,9 +34,10 @@ func (s *KeeperTestSuite) SetupTest() {
 	supplyKeeper := crisistestutil.NewMockSupplyKeeper(ctrl)
 
 	key := storetypes.NewKVStoreKey(types.StoreKey)
-	testCtx := testutil.DefaultContextWithDB(s.T(), key, storetypes.NewTransientStoreKey("transient_test"))
+	tkey := storetypes.NewTransientStoreKey(types.TStoreKey)
+	testCtx := testutil.DefaultContextWithDB(s.T(), key, tkey)
 	encCfg := moduletestutil.MakeTestEncodingConfig(crisis.AppModuleBasic{})
-	keeper := keeper.NewKeeper(encCfg.Codec, key, 5, supplyKeeper, "", sdk.AccAddress([]byte("addr1_______________")).String(), addresscodec.NewBech32Codec("cosmos"))
+	keeper := keeper.NewKeeper(encCfg.Codec, key, tkey, 5, supplyKeeper, "", sdk.AccAddress([]byte("addr1_______________")).String(), addresscodec.NewBech32Codec("cosmos"))
 
 	s.ctx = testCtx.Ctx
 	s.keeper = keeper
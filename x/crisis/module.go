@@ -7,6 +7,7 @@ import (
 	"time"
 
 	abci "github.com/cometbft/cometbft/abci/types"
+	"github.com/cosmos/gogoproto/proto"
 	gwruntime "github.com/grpc-ecosystem/grpc-gateway/runtime"
 	"github.com/spf13/cast"
 	"github.com/spf13/cobra"
@@ -74,15 +75,21 @@ func (AppModuleBasic) ValidateGenesis(cdc codec.JSONCodec, config client.TxEncod
 }
 
 // RegisterGRPCGatewayRoutes registers the gRPC Gateway routes for the crisis module.
-func (AppModuleBasic) RegisterGRPCGatewayRoutes(_ client.Context, _ *gwruntime.ServeMux) {}
+func (AppModuleBasic) RegisterGRPCGatewayRoutes(clientCtx client.Context, mux *gwruntime.ServeMux) {
+	if err := types.RegisterQueryHandlerClient(context.Background(), mux, types.NewQueryClient(clientCtx)); err != nil {
+		panic(err)
+	}
+}
 
 // GetTxCmd returns the root tx command for the crisis module.
 func (b AppModuleBasic) GetTxCmd() *cobra.Command {
 	return cli.NewTxCmd()
 }
 
-// GetQueryCmd returns no root query command for the crisis module.
-func (AppModuleBasic) GetQueryCmd() *cobra.Command { return nil }
+// GetQueryCmd returns the root query command for the crisis module.
+func (AppModuleBasic) GetQueryCmd() *cobra.Command {
+	return cli.GetQueryCmd()
+}
 
 // RegisterInterfaces registers interfaces and implementations of the crisis
 // module.
@@ -143,6 +150,7 @@ func (AppModule) Name() string {
 // RegisterServices registers module services.
 func (am AppModule) RegisterServices(cfg module.Configurator) {
 	types.RegisterMsgServer(cfg.MsgServer(), am.keeper)
+	types.RegisterQueryServer(cfg.QueryServer(), keeper.NewQuerier(am.keeper))
 
 	m := keeper.NewMigrator(am.keeper, am.legacySubspace)
 	if err := cfg.RegisterMigration(types.ModuleName, 1, m.Migrate1to2); err != nil {
@@ -175,6 +183,18 @@ func (am AppModule) ExportGenesis(ctx sdk.Context, cdc codec.JSONCodec) json.Raw
 // ConsensusVersion implements AppModule/ConsensusVersion.
 func (AppModule) ConsensusVersion() uint64 { return ConsensusVersion }
 
+// UpdatableParams implements the module.HasUpdatableParams interface.
+func (am AppModule) UpdatableParams() module.UpdatableParams {
+	return module.UpdatableParams{
+		UpdateMsg: &types.MsgUpdateParams{},
+		Authority: am.keeper.GetAuthority(),
+		GetParams: func(ctx context.Context) (proto.Message, error) {
+			constantFee := am.keeper.GetConstantFee(sdk.UnwrapSDKContext(ctx))
+			return &constantFee, nil
+		},
+	}
+}
+
 // EndBlock returns the end blocker for the crisis module. It returns no validator
 // updates.
 func (am AppModule) EndBlock(ctx context.Context) error {
@@ -197,6 +217,7 @@ type ModuleInputs struct {
 
 	Config  *modulev1.Module
 	Key     *store.KVStoreKey
+	TKey    *store.TransientStoreKey
 	Cdc     codec.Codec
 	AppOpts servertypes.AppOptions `optional:"true"`
 
@@ -234,6 +255,7 @@ func ProvideModule(in ModuleInputs) ModuleOutputs {
 	k := keeper.NewKeeper(
 		in.Cdc,
 		in.Key,
+		in.TKey,
 		invalidCheckPeriod,
 		in.BankKeeper,
 		feeCollectorName,
@@ -1,10 +1,46 @@
 package types
 
+import "fmt"
+
 const (
 	// module name
 	ModuleName = "crisis"
 
 	StoreKey = ModuleName
+
+	// TStoreKey is the transient store key string for crisis
+	TStoreKey = "transient_" + ModuleName
 )
 
-var ConstantFeeKey = []byte{0x01}
+var (
+	ConstantFeeKey = []byte{0x01}
+
+	// PendingInvariantCheckPrefix is the prefix of the targeted invariant
+	// checks scheduled in the current block, in the transient store
+	PendingInvariantCheckPrefix = []byte{0x01}
+)
+
+// PendingInvariantCheckKey returns the transient store key of a scheduled check
+// of a targeted invariant for a subject.
+//
+// Key format:
+// 0x01 | full route length (1 byte) | full route | subject
+func PendingInvariantCheckKey(fullRoute string, subject []byte) []byte {
+	key := make([]byte, 0, len(PendingInvariantCheckPrefix)+1+len(fullRoute)+len(subject))
+	key = append(key, PendingInvariantCheckPrefix...)
+	key = append(key, byte(len(fullRoute)))
+	key = append(key, fullRoute...)
+	return append(key, subject...)
+}
+
+// ParsePendingInvariantCheckKey returns the full route of the targeted
+// invariant and the subject of a scheduled check from its key.
+func ParsePendingInvariantCheckKey(key []byte) (fullRoute string, subject []byte, err error) {
+	key = key[len(PendingInvariantCheckPrefix):]
+	if len(key) == 0 || len(key) < 1+int(key[0]) {
+		return "", nil, fmt.Errorf("invalid pending invariant check key length %d", len(key))
+	}
+
+	routeLen := int(key[0])
+	return string(key[1 : 1+routeLen]), key[1+routeLen:], nil
+}
@@ -0,0 +1,1638 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: cosmos/crisis/v1beta1/query.proto
+
+package types
+
+import (
+	context "context"
+	fmt "fmt"
+	_ "github.com/cosmos/cosmos-sdk/types/tx/amino"
+	_ "github.com/cosmos/gogoproto/gogoproto"
+	grpc1 "github.com/cosmos/gogoproto/grpc"
+	proto "github.com/cosmos/gogoproto/proto"
+	_ "google.golang.org/genproto/googleapis/api/annotations"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// InvariantRoute defines a registered invariant route.
+//
+// Since: cosmos-sdk 0.48
+type InvariantRoute struct {
+	// module_name is the name of the module which registered the invariant.
+	ModuleName string `protobuf:"bytes,1,opt,name=module_name,json=moduleName,proto3" json:"module_name,omitempty"`
+	// route is the route of the invariant in its module.
+	Route string `protobuf:"bytes,2,opt,name=route,proto3" json:"route,omitempty"`
+	// targeted is true for the invariants checked for the subjects whose state
+	// changed, at the end of each block, rather than by full scans.
+	Targeted bool `protobuf:"varint,3,opt,name=targeted,proto3" json:"targeted,omitempty"`
+}
+
+func (m *InvariantRoute) Reset()         { *m = InvariantRoute{} }
+func (m *InvariantRoute) String() string { return proto.CompactTextString(m) }
+func (*InvariantRoute) ProtoMessage()    {}
+func (*InvariantRoute) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3ca16352ca9a50b9, []int{0}
+}
+func (m *InvariantRoute) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *InvariantRoute) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_InvariantRoute.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *InvariantRoute) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_InvariantRoute.Merge(m, src)
+}
+func (m *InvariantRoute) XXX_Size() int {
+	return m.Size()
+}
+func (m *InvariantRoute) XXX_DiscardUnknown() {
+	xxx_messageInfo_InvariantRoute.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_InvariantRoute proto.InternalMessageInfo
+
+func (m *InvariantRoute) GetModuleName() string {
+	if m != nil {
+		return m.ModuleName
+	}
+	return ""
+}
+
+func (m *InvariantRoute) GetRoute() string {
+	if m != nil {
+		return m.Route
+	}
+	return ""
+}
+
+func (m *InvariantRoute) GetTargeted() bool {
+	if m != nil {
+		return m.Targeted
+	}
+	return false
+}
+
+// InvariantResult defines the result of an invariant check.
+//
+// Since: cosmos-sdk 0.48
+type InvariantResult struct {
+	// module_name is the name of the module which registered the invariant.
+	ModuleName string `protobuf:"bytes,1,opt,name=module_name,json=moduleName,proto3" json:"module_name,omitempty"`
+	// route is the route of the invariant in its module.
+	Route string `protobuf:"bytes,2,opt,name=route,proto3" json:"route,omitempty"`
+	// broken is true if the invariant is broken.
+	Broken bool `protobuf:"varint,3,opt,name=broken,proto3" json:"broken,omitempty"`
+	// message is the descriptive message returned by the invariant.
+	Message string `protobuf:"bytes,4,opt,name=message,proto3" json:"message,omitempty"`
+}
+
+func (m *InvariantResult) Reset()         { *m = InvariantResult{} }
+func (m *InvariantResult) String() string { return proto.CompactTextString(m) }
+func (*InvariantResult) ProtoMessage()    {}
+func (*InvariantResult) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3ca16352ca9a50b9, []int{1}
+}
+func (m *InvariantResult) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *InvariantResult) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_InvariantResult.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *InvariantResult) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_InvariantResult.Merge(m, src)
+}
+func (m *InvariantResult) XXX_Size() int {
+	return m.Size()
+}
+func (m *InvariantResult) XXX_DiscardUnknown() {
+	xxx_messageInfo_InvariantResult.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_InvariantResult proto.InternalMessageInfo
+
+func (m *InvariantResult) GetModuleName() string {
+	if m != nil {
+		return m.ModuleName
+	}
+	return ""
+}
+
+func (m *InvariantResult) GetRoute() string {
+	if m != nil {
+		return m.Route
+	}
+	return ""
+}
+
+func (m *InvariantResult) GetBroken() bool {
+	if m != nil {
+		return m.Broken
+	}
+	return false
+}
+
+func (m *InvariantResult) GetMessage() string {
+	if m != nil {
+		return m.Message
+	}
+	return ""
+}
+
+// QueryInvariantsRequest is the request type for the Query/Invariants RPC method.
+type QueryInvariantsRequest struct {
+}
+
+func (m *QueryInvariantsRequest) Reset()         { *m = QueryInvariantsRequest{} }
+func (m *QueryInvariantsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryInvariantsRequest) ProtoMessage()    {}
+func (*QueryInvariantsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3ca16352ca9a50b9, []int{2}
+}
+func (m *QueryInvariantsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryInvariantsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryInvariantsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryInvariantsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryInvariantsRequest.Merge(m, src)
+}
+func (m *QueryInvariantsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryInvariantsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryInvariantsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryInvariantsRequest proto.InternalMessageInfo
+
+// QueryInvariantsResponse is the response type for the Query/Invariants RPC method.
+type QueryInvariantsResponse struct {
+	// routes are the registered invariant routes.
+	Routes []InvariantRoute `protobuf:"bytes,1,rep,name=routes,proto3" json:"routes"`
+}
+
+func (m *QueryInvariantsResponse) Reset()         { *m = QueryInvariantsResponse{} }
+func (m *QueryInvariantsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryInvariantsResponse) ProtoMessage()    {}
+func (*QueryInvariantsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3ca16352ca9a50b9, []int{3}
+}
+func (m *QueryInvariantsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryInvariantsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryInvariantsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryInvariantsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryInvariantsResponse.Merge(m, src)
+}
+func (m *QueryInvariantsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryInvariantsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryInvariantsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryInvariantsResponse proto.InternalMessageInfo
+
+func (m *QueryInvariantsResponse) GetRoutes() []InvariantRoute {
+	if m != nil {
+		return m.Routes
+	}
+	return nil
+}
+
+// QueryCheckInvariantsRequest is the request type for the Query/CheckInvariants RPC method.
+type QueryCheckInvariantsRequest struct {
+	// module_name restricts the check to the invariants of a module, if set.
+	ModuleName string `protobuf:"bytes,1,opt,name=module_name,json=moduleName,proto3" json:"module_name,omitempty"`
+	// route restricts the check to a single invariant of the module, if set.
+	Route string `protobuf:"bytes,2,opt,name=route,proto3" json:"route,omitempty"`
+}
+
+func (m *QueryCheckInvariantsRequest) Reset()         { *m = QueryCheckInvariantsRequest{} }
+func (m *QueryCheckInvariantsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryCheckInvariantsRequest) ProtoMessage()    {}
+func (*QueryCheckInvariantsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3ca16352ca9a50b9, []int{4}
+}
+func (m *QueryCheckInvariantsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryCheckInvariantsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryCheckInvariantsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryCheckInvariantsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryCheckInvariantsRequest.Merge(m, src)
+}
+func (m *QueryCheckInvariantsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryCheckInvariantsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryCheckInvariantsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryCheckInvariantsRequest proto.InternalMessageInfo
+
+func (m *QueryCheckInvariantsRequest) GetModuleName() string {
+	if m != nil {
+		return m.ModuleName
+	}
+	return ""
+}
+
+func (m *QueryCheckInvariantsRequest) GetRoute() string {
+	if m != nil {
+		return m.Route
+	}
+	return ""
+}
+
+// QueryCheckInvariantsResponse is the response type for the Query/CheckInvariants RPC method.
+type QueryCheckInvariantsResponse struct {
+	// results are the results of the checked invariants.
+	Results []InvariantResult `protobuf:"bytes,1,rep,name=results,proto3" json:"results"`
+	// broken is true if any of the checked invariants is broken.
+	Broken bool `protobuf:"varint,2,opt,name=broken,proto3" json:"broken,omitempty"`
+}
+
+func (m *QueryCheckInvariantsResponse) Reset()         { *m = QueryCheckInvariantsResponse{} }
+func (m *QueryCheckInvariantsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryCheckInvariantsResponse) ProtoMessage()    {}
+func (*QueryCheckInvariantsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3ca16352ca9a50b9, []int{5}
+}
+func (m *QueryCheckInvariantsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryCheckInvariantsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryCheckInvariantsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryCheckInvariantsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryCheckInvariantsResponse.Merge(m, src)
+}
+func (m *QueryCheckInvariantsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryCheckInvariantsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryCheckInvariantsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryCheckInvariantsResponse proto.InternalMessageInfo
+
+func (m *QueryCheckInvariantsResponse) GetResults() []InvariantResult {
+	if m != nil {
+		return m.Results
+	}
+	return nil
+}
+
+func (m *QueryCheckInvariantsResponse) GetBroken() bool {
+	if m != nil {
+		return m.Broken
+	}
+	return false
+}
+
+func init() {
+	proto.RegisterType((*InvariantRoute)(nil), "cosmos.crisis.v1beta1.InvariantRoute")
+	proto.RegisterType((*InvariantResult)(nil), "cosmos.crisis.v1beta1.InvariantResult")
+	proto.RegisterType((*QueryInvariantsRequest)(nil), "cosmos.crisis.v1beta1.QueryInvariantsRequest")
+	proto.RegisterType((*QueryInvariantsResponse)(nil), "cosmos.crisis.v1beta1.QueryInvariantsResponse")
+	proto.RegisterType((*QueryCheckInvariantsRequest)(nil), "cosmos.crisis.v1beta1.QueryCheckInvariantsRequest")
+	proto.RegisterType((*QueryCheckInvariantsResponse)(nil), "cosmos.crisis.v1beta1.QueryCheckInvariantsResponse")
+}
+
+func init() { proto.RegisterFile("cosmos/crisis/v1beta1/query.proto", fileDescriptor_3ca16352ca9a50b9) }
+
+var fileDescriptor_3ca16352ca9a50b9 = []byte{
+	// 484 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x53, 0x4f, 0x6b, 0x13, 0x41,
+	0x14, 0xcf, 0xa4, 0x36, 0x6d, 0x5f, 0xc1, 0xe2, 0x50, 0xeb, 0xb2, 0x96, 0x6d, 0xba, 0xa2, 0xa6,
+	0x4a, 0x77, 0x68, 0xfa, 0x0d, 0x2a, 0x82, 0x22, 0x08, 0x2e, 0x9e, 0xbc, 0xc8, 0x64, 0xf3, 0xd8,
+	0x2e, 0xc9, 0xce, 0xa4, 0x33, 0xb3, 0xc5, 0x7a, 0xd4, 0x2f, 0x20, 0x08, 0x7e, 0x06, 0xc1, 0x8b,
+	0x1f, 0xa3, 0x27, 0x29, 0x78, 0xf1, 0x24, 0x92, 0x08, 0x7e, 0x0d, 0xc9, 0xec, 0x6e, 0x12, 0xed,
+	0x6a, 0x35, 0x97, 0x64, 0xdf, 0xbc, 0xdf, 0xbc, 0xdf, 0x9f, 0xc7, 0xc0, 0x76, 0x24, 0x75, 0x2a,
+	0x35, 0x8b, 0x54, 0xa2, 0x13, 0xcd, 0x8e, 0xf7, 0x3a, 0x68, 0xf8, 0x1e, 0x3b, 0xca, 0x50, 0x9d,
+	0x04, 0x03, 0x25, 0x8d, 0xa4, 0x57, 0x73, 0x48, 0x90, 0x43, 0x82, 0x02, 0xe2, 0xae, 0xc7, 0x32,
+	0x96, 0x16, 0xc1, 0xc6, 0x5f, 0x39, 0xd8, 0xdd, 0x8c, 0xa5, 0x8c, 0xfb, 0xc8, 0xf8, 0x20, 0x61,
+	0x5c, 0x08, 0x69, 0xb8, 0x49, 0xa4, 0xd0, 0x45, 0xf7, 0x0a, 0x4f, 0x13, 0x21, 0x99, 0xfd, 0xcd,
+	0x8f, 0xfc, 0x08, 0x2e, 0x3f, 0x14, 0xc7, 0x5c, 0x25, 0x5c, 0x98, 0x50, 0x66, 0x06, 0xe9, 0x16,
+	0xac, 0xa6, 0xb2, 0x9b, 0xf5, 0xf1, 0xb9, 0xe0, 0x29, 0x3a, 0xa4, 0x49, 0x5a, 0x2b, 0x21, 0xe4,
+	0x47, 0x8f, 0x79, 0x8a, 0x74, 0x1d, 0x16, 0xd5, 0x18, 0xe9, 0xd4, 0x6d, 0x2b, 0x2f, 0xa8, 0x0b,
+	0xcb, 0x86, 0xab, 0x18, 0x0d, 0x76, 0x9d, 0x85, 0x26, 0x69, 0x2d, 0x87, 0x93, 0xda, 0x7f, 0x09,
+	0x6b, 0x53, 0x12, 0xd4, 0x59, 0xdf, 0xcc, 0xcb, 0xb2, 0x01, 0x8d, 0x8e, 0x92, 0x3d, 0x14, 0x05,
+	0x47, 0x51, 0x51, 0x07, 0x96, 0x52, 0xd4, 0x9a, 0xc7, 0xe8, 0x5c, 0xb2, 0xf8, 0xb2, 0xf4, 0x1d,
+	0xd8, 0x78, 0x32, 0x4e, 0x73, 0x22, 0x40, 0x87, 0x78, 0x94, 0xa1, 0x36, 0x7e, 0x04, 0xd7, 0xce,
+	0x75, 0xf4, 0x40, 0x0a, 0x8d, 0xf4, 0x01, 0x34, 0x2c, 0x9f, 0x76, 0x48, 0x73, 0xa1, 0xb5, 0xda,
+	0xbe, 0x19, 0x54, 0x2e, 0x21, 0xf8, 0x35, 0xba, 0x83, 0x95, 0xd3, 0xaf, 0x5b, 0xb5, 0xf7, 0x3f,
+	0x3e, 0xde, 0x21, 0x61, 0x71, 0xdf, 0x7f, 0x0a, 0xd7, 0x2d, 0xc9, 0xbd, 0x43, 0x8c, 0x7a, 0xe7,
+	0x34, 0xcc, 0x19, 0x83, 0xff, 0x9a, 0xc0, 0x66, 0xf5, 0xd8, 0xc2, 0xc0, 0x23, 0x58, 0x52, 0x36,
+	0xe8, 0xd2, 0xc1, 0xad, 0x0b, 0x1d, 0x58, 0xf8, 0xac, 0x85, 0x72, 0xc2, 0x4c, 0xe8, 0xf5, 0xd9,
+	0xd0, 0xdb, 0x9f, 0xea, 0xb0, 0x68, 0x55, 0xd0, 0x77, 0x04, 0x60, 0xaa, 0x82, 0xee, 0xfe, 0x81,
+	0xac, 0x7a, 0x11, 0x6e, 0xf0, 0xaf, 0xf0, 0xdc, 0x9c, 0xbf, 0xf3, 0xea, 0xf3, 0xf7, 0xb7, 0xf5,
+	0x1b, 0x74, 0x9b, 0x55, 0xbf, 0x9e, 0x64, 0xaa, 0xe4, 0x03, 0x81, 0xb5, 0xdf, 0x32, 0xa2, 0xed,
+	0xbf, 0xd1, 0x55, 0xef, 0xc9, 0xdd, 0xff, 0xaf, 0x3b, 0x85, 0x4e, 0x66, 0x75, 0xee, 0xd0, 0xdb,
+	0x17, 0xea, 0x64, 0xd1, 0x78, 0xc4, 0xc1, 0xfd, 0xd3, 0xa1, 0x47, 0xce, 0x86, 0x1e, 0xf9, 0x36,
+	0xf4, 0xc8, 0x9b, 0x91, 0x57, 0x3b, 0x1b, 0x79, 0xb5, 0x2f, 0x23, 0xaf, 0xf6, 0xec, 0x6e, 0x9c,
+	0x98, 0xc3, 0xac, 0x13, 0x44, 0x32, 0x9d, 0x0c, 0xb3, 0x7f, 0xbb, 0xba, 0xdb, 0x63, 0x2f, 0xca,
+	0xc9, 0xe6, 0x64, 0x80, 0xba, 0xd3, 0xb0, 0x4f, 0x7b, 0xff, 0xe7, 0x00, 0x44, 0x78, 0x22, 0xa2,
+	0x5d, 0x04, 0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// QueryClient is the client API for Query service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type QueryClient interface {
+	// Invariants returns the registered invariant routes.
+	Invariants(ctx context.Context, in *QueryInvariantsRequest, opts ...grpc.CallOption) (*QueryInvariantsResponse, error)
+	// CheckInvariants runs a full scan of the registered invariants, or of the
+	// ones of a module, against the latest state and returns their results.
+	// Broken invariants are reported without halting the chain.
+	CheckInvariants(ctx context.Context, in *QueryCheckInvariantsRequest, opts ...grpc.CallOption) (*QueryCheckInvariantsResponse, error)
+}
+
+type queryClient struct {
+	cc grpc1.ClientConn
+}
+
+func NewQueryClient(cc grpc1.ClientConn) QueryClient {
+	return &queryClient{cc}
+}
+
+func (c *queryClient) Invariants(ctx context.Context, in *QueryInvariantsRequest, opts ...grpc.CallOption) (*QueryInvariantsResponse, error) {
+	out := new(QueryInvariantsResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.crisis.v1beta1.Query/Invariants", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) CheckInvariants(ctx context.Context, in *QueryCheckInvariantsRequest, opts ...grpc.CallOption) (*QueryCheckInvariantsResponse, error) {
+	out := new(QueryCheckInvariantsResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.crisis.v1beta1.Query/CheckInvariants", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// QueryServer is the server API for Query service.
+type QueryServer interface {
+	// Invariants returns the registered invariant routes.
+	Invariants(context.Context, *QueryInvariantsRequest) (*QueryInvariantsResponse, error)
+	// CheckInvariants runs a full scan of the registered invariants, or of the
+	// ones of a module, against the latest state and returns their results.
+	// Broken invariants are reported without halting the chain.
+	CheckInvariants(context.Context, *QueryCheckInvariantsRequest) (*QueryCheckInvariantsResponse, error)
+}
+
+// UnimplementedQueryServer can be embedded to have forward compatible implementations.
+type UnimplementedQueryServer struct {
+}
+
+func (*UnimplementedQueryServer) Invariants(ctx context.Context, req *QueryInvariantsRequest) (*QueryInvariantsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Invariants not implemented")
+}
+func (*UnimplementedQueryServer) CheckInvariants(ctx context.Context, req *QueryCheckInvariantsRequest) (*QueryCheckInvariantsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CheckInvariants not implemented")
+}
+
+func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
+	s.RegisterService(&_Query_serviceDesc, srv)
+}
+
+func _Query_Invariants_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryInvariantsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Invariants(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.crisis.v1beta1.Query/Invariants",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Invariants(ctx, req.(*QueryInvariantsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_CheckInvariants_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryCheckInvariantsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).CheckInvariants(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.crisis.v1beta1.Query/CheckInvariants",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).CheckInvariants(ctx, req.(*QueryCheckInvariantsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _Query_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "cosmos.crisis.v1beta1.Query",
+	HandlerType: (*QueryServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Invariants",
+			Handler:    _Query_Invariants_Handler,
+		},
+		{
+			MethodName: "CheckInvariants",
+			Handler:    _Query_CheckInvariants_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "cosmos/crisis/v1beta1/query.proto",
+}
+
+func (m *InvariantRoute) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *InvariantRoute) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *InvariantRoute) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Targeted {
+		i--
+		if m.Targeted {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Route) > 0 {
+		i -= len(m.Route)
+		copy(dAtA[i:], m.Route)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Route)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ModuleName) > 0 {
+		i -= len(m.ModuleName)
+		copy(dAtA[i:], m.ModuleName)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ModuleName)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *InvariantResult) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *InvariantResult) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *InvariantResult) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Message) > 0 {
+		i -= len(m.Message)
+		copy(dAtA[i:], m.Message)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Message)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.Broken {
+		i--
+		if m.Broken {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Route) > 0 {
+		i -= len(m.Route)
+		copy(dAtA[i:], m.Route)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Route)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ModuleName) > 0 {
+		i -= len(m.ModuleName)
+		copy(dAtA[i:], m.ModuleName)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ModuleName)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryInvariantsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryInvariantsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryInvariantsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryInvariantsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryInvariantsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryInvariantsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Routes) > 0 {
+		for iNdEx := len(m.Routes) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Routes[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryCheckInvariantsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryCheckInvariantsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryCheckInvariantsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Route) > 0 {
+		i -= len(m.Route)
+		copy(dAtA[i:], m.Route)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Route)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ModuleName) > 0 {
+		i -= len(m.ModuleName)
+		copy(dAtA[i:], m.ModuleName)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ModuleName)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryCheckInvariantsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryCheckInvariantsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryCheckInvariantsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Broken {
+		i--
+		if m.Broken {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Results) > 0 {
+		for iNdEx := len(m.Results) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Results[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
+	offset -= sovQuery(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *InvariantRoute) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ModuleName)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Route)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Targeted {
+		n += 2
+	}
+	return n
+}
+
+func (m *InvariantResult) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ModuleName)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Route)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Broken {
+		n += 2
+	}
+	l = len(m.Message)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryInvariantsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryInvariantsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Routes) > 0 {
+		for _, e := range m.Routes {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryCheckInvariantsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ModuleName)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Route)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryCheckInvariantsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Results) > 0 {
+		for _, e := range m.Results {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Broken {
+		n += 2
+	}
+	return n
+}
+
+func sovQuery(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozQuery(x uint64) (n int) {
+	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *InvariantRoute) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: InvariantRoute: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: InvariantRoute: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ModuleName", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ModuleName = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Route", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Route = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Targeted", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Targeted = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *InvariantResult) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: InvariantResult: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: InvariantResult: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ModuleName", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ModuleName = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Route", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Route = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Broken", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Broken = bool(v != 0)
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Message", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Message = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryInvariantsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryInvariantsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryInvariantsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryInvariantsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryInvariantsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryInvariantsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Routes", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Routes = append(m.Routes, InvariantRoute{})
+			if err := m.Routes[len(m.Routes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryCheckInvariantsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryCheckInvariantsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryCheckInvariantsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ModuleName", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ModuleName = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Route", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Route = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryCheckInvariantsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryCheckInvariantsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryCheckInvariantsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Results", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Results = append(m.Results, InvariantResult{})
+			if err := m.Results[len(m.Results)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Broken", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Broken = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipQuery(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthQuery
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupQuery
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthQuery
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthQuery        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowQuery          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupQuery = fmt.Errorf("proto: unexpected end of group")
+)
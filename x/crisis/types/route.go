@@ -24,3 +24,24 @@ func NewInvarRoute(moduleName, route string, invar sdk.Invariant) InvarRoute {
 func (i InvarRoute) FullRoute() string {
 	return i.ModuleName + "/" + i.Route
 }
+
+// TargetedInvarRoute is the route of a targeted invariant
+type TargetedInvarRoute struct {
+	ModuleName string
+	Route      string
+	Invar      sdk.TargetedInvariant
+}
+
+// NewTargetedInvarRoute - create a TargetedInvarRoute object
+func NewTargetedInvarRoute(moduleName, route string, invar sdk.TargetedInvariant) TargetedInvarRoute {
+	return TargetedInvarRoute{
+		ModuleName: moduleName,
+		Route:      route,
+		Invar:      invar,
+	}
+}
+
+// get the full invariance route
+func (i TargetedInvarRoute) FullRoute() string {
+	return i.ModuleName + "/" + i.Route
+}
@@ -31,3 +31,11 @@ func BeginBlocker(ctx sdk.Context, k keeper.Keeper) error {
 	k.SetPreviousProposerConsAddr(ctx, consAddr)
 	return nil
 }
+
+// EndBlocker restakes the rewards of the delegations opted in to
+// auto-compounding.
+func EndBlocker(ctx sdk.Context, k keeper.Keeper) error {
+	defer telemetry.ModuleMeasureSince(types.ModuleName, time.Now(), telemetry.MetricKeyEndBlocker)
+
+	return k.ProcessAutoCompounds(ctx)
+}
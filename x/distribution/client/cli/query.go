@@ -33,6 +33,7 @@ func GetQueryCmd(ac address.Codec) *cobra.Command {
 		GetCmdQueryValidatorSlashes(),
 		GetCmdQueryDelegatorRewards(ac),
 		GetCmdQueryCommunityPool(),
+		GetCmdQueryRewardsDestination(),
 	)
 
 	return distQueryCmd
@@ -365,3 +366,43 @@ $ %s query distribution community-pool
 	flags.AddQueryFlagsToCmd(cmd)
 	return cmd
 }
+
+// GetCmdQueryRewardsDestination returns the command for fetching where the
+// rewards of a delegator are credited.
+func GetCmdQueryRewardsDestination() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "rewards-destination [delegator-addr]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Query where the staking rewards of a delegator are credited",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query whether the staking rewards of a delegator are credited to its spendable
+balance or to its vesting amount.
+
+Example:
+$ %s query distribution rewards-destination cosmos1gghjut3ccd8ay0zduzj64hwre2fxs9ld75ru9p
+`,
+				version.AppName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.DelegatorRewardsDestination(
+				cmd.Context(),
+				&types.QueryDelegatorRewardsDestinationRequest{DelegatorAddress: args[0]},
+			)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
@@ -2,6 +2,7 @@ package cli
 
 import (
 	"fmt"
+	"strconv"
 	"strings"
 
 	"cosmossdk.io/core/address"
@@ -20,6 +21,7 @@ import (
 var (
 	FlagCommission       = "commission"
 	FlagMaxMessagesPerTx = "max-msgs"
+	FlagSourceValidator  = "source-validator"
 )
 
 const (
@@ -42,6 +44,9 @@ func NewTxCmd(ac address.Codec) *cobra.Command {
 		NewSetWithdrawAddrCmd(ac),
 		NewFundCommunityPoolCmd(),
 		NewDepositValidatorRewardsPoolCmd(),
+		NewSetAutoCompoundCmd(),
+		NewWithdrawAndDelegateCmd(),
+		NewSetRewardsDestinationCmd(),
 	)
 
 	return distTxCmd
@@ -295,3 +300,128 @@ func NewDepositValidatorRewardsPoolCmd() *cobra.Command {
 
 	return cmd
 }
+
+// NewSetAutoCompoundCmd returns a CLI command handler for creating a
+// MsgSetAutoCompound transaction.
+func NewSetAutoCompoundCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "set-auto-compound [val_addr] [enabled]",
+		Args:  cobra.ExactArgs(2),
+		Short: "Opt in or out of the automatic restaking of the rewards of a delegation",
+		Example: fmt.Sprintf(
+			"%s tx distribution set-auto-compound cosmosvaloper1x20lytyf6zkcrv5edpkfkn8sz578qg5sqfyqnp true --from mykey",
+			version.AppName,
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			valAddr, err := sdk.ValAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+
+			enabled, err := strconv.ParseBool(args[1])
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgSetAutoCompound(clientCtx.GetFromAddress(), valAddr, enabled)
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// NewWithdrawAndDelegateCmd returns a CLI command handler for creating a
+// MsgWithdrawAndDelegate transaction.
+func NewWithdrawAndDelegateCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "withdraw-and-delegate [val_addr]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Withdraw rewards and delegate them to a validator in a single atomic message",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Withdraw the rewards from all the validators the delegator delegates to, or
+from the validator given with --%s, and delegate the withdrawn staking tokens to a validator.
+The rewards must be withdrawn to the delegator account.
+
+Example:
+$ %s tx distribution withdraw-and-delegate cosmosvaloper1gghjut3ccd8ay0zduzj64hwre2fxs9ldmqhffj --from mykey
+$ %s tx distribution withdraw-and-delegate cosmosvaloper1gghjut3ccd8ay0zduzj64hwre2fxs9ldmqhffj --%s cosmosvaloper1x20lytyf6zkcrv5edpkfkn8sz578qg5sqfyqnp --from mykey
+`,
+				FlagSourceValidator, version.AppName, version.AppName, FlagSourceValidator,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			valAddr, err := sdk.ValAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+
+			var srcValAddr sdk.ValAddress
+			if src, _ := cmd.Flags().GetString(FlagSourceValidator); src != "" {
+				srcValAddr, err = sdk.ValAddressFromBech32(src)
+				if err != nil {
+					return err
+				}
+			}
+
+			msg := types.NewMsgWithdrawAndDelegate(clientCtx.GetFromAddress(), srcValAddr, valAddr)
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	cmd.Flags().String(FlagSourceValidator, "", "Withdraw the rewards from this validator only")
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// NewSetRewardsDestinationCmd returns a CLI command handler for creating a
+// MsgSetRewardsDestination transaction.
+func NewSetRewardsDestinationCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "set-rewards-destination [spendable|vesting]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Credit the staking rewards of a vesting account to its spendable balance or to its vesting amount",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Set where the staking rewards of a vesting account are credited when withdrawn
+to the account, either its spendable balance (default) or its vesting amount, the
+rewards then vesting along the vesting schedule of the account.
+
+Example:
+$ %s tx distribution set-rewards-destination vesting --from mykey
+`,
+				version.AppName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			destination, ok := types.RewardsDestination_value["REWARDS_DESTINATION_"+strings.ToUpper(args[0])]
+			if !ok {
+				return fmt.Errorf("invalid rewards destination %s, expected spendable or vesting", args[0])
+			}
+
+			msg := types.NewMsgSetRewardsDestination(clientCtx.GetFromAddress(), types.RewardsDestination(destination))
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
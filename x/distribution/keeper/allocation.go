@@ -36,7 +36,7 @@ func (k Keeper) AllocateTokens(ctx context.Context, totalPreviousPower int64, bo
 	}
 
 	if totalPreviousPower == 0 {
-		feePool.CommunityPool = feePool.CommunityPool.Add(feesCollected...)
+		feePool.CommunityPool = feePool.CommunityPool.AddValid(feesCollected)
 		return k.SetFeePool(ctx, feePool)
 	}
 
@@ -48,7 +48,10 @@ func (k Keeper) AllocateTokens(ctx context.Context, totalPreviousPower int64, bo
 	}
 
 	voteMultiplier := math.LegacyOneDec().Sub(communityTax)
-	feeMultiplier := feesCollected.MulDecTruncate(voteMultiplier)
+	feeMultiplier, err := feesCollected.SafeMulDecTruncate(voteMultiplier)
+	if err != nil {
+		return err
+	}
 
 	// allocate tokens proportionally to voting power
 	//
@@ -62,18 +65,21 @@ func (k Keeper) AllocateTokens(ctx context.Context, totalPreviousPower int64, bo
 		//
 		// Ref: https://github.com/cosmos/cosmos-sdk/issues/2525#issuecomment-430838701
 		powerFraction := math.LegacyNewDec(vote.Validator.Power).QuoTruncate(math.LegacyNewDec(totalPreviousPower))
-		reward := feeMultiplier.MulDecTruncate(powerFraction)
+		reward, err := feeMultiplier.SafeMulDecTruncate(powerFraction)
+		if err != nil {
+			return err
+		}
 
-		err := k.AllocateTokensToValidator(ctx, validator, reward)
+		err = k.AllocateTokensToValidator(ctx, validator, reward)
 		if err != nil {
 			return err
 		}
 
-		remaining = remaining.Sub(reward)
+		remaining = remaining.SubValid(reward)
 	}
 
 	// allocate community funding
-	feePool.CommunityPool = feePool.CommunityPool.Add(remaining...)
+	feePool.CommunityPool = feePool.CommunityPool.AddValid(remaining)
 	return k.SetFeePool(ctx, feePool)
 }
 
@@ -81,8 +87,11 @@ func (k Keeper) AllocateTokens(ctx context.Context, totalPreviousPower int64, bo
 // splitting according to commission.
 func (k Keeper) AllocateTokensToValidator(ctx context.Context, val stakingtypes.ValidatorI, tokens sdk.DecCoins) error {
 	// split tokens between validator and delegators according to commission
-	commission := tokens.MulDec(val.GetCommission())
-	shared := tokens.Sub(commission)
+	commission, err := tokens.SafeMulDec(val.GetCommission())
+	if err != nil {
+		return err
+	}
+	shared := tokens.SubValid(commission)
 
 	// update current commission
 	sdkCtx := sdk.UnwrapSDKContext(ctx)
@@ -98,7 +107,7 @@ func (k Keeper) AllocateTokensToValidator(ctx context.Context, val stakingtypes.
 		return err
 	}
 
-	currentCommission.Commission = currentCommission.Commission.Add(commission...)
+	currentCommission.Commission = currentCommission.Commission.AddValid(commission)
 	err = k.SetValidatorAccumulatedCommission(ctx, val.GetOperator(), currentCommission)
 	if err != nil {
 		return err
@@ -110,7 +119,7 @@ func (k Keeper) AllocateTokensToValidator(ctx context.Context, val stakingtypes.
 		return err
 	}
 
-	currentRewards.Rewards = currentRewards.Rewards.Add(shared...)
+	currentRewards.Rewards = currentRewards.Rewards.AddValid(shared)
 	err = k.SetValidatorCurrentRewards(ctx, val.GetOperator(), currentRewards)
 	if err != nil {
 		return err
@@ -130,6 +139,6 @@ func (k Keeper) AllocateTokensToValidator(ctx context.Context, val stakingtypes.
 		return err
 	}
 
-	outstanding.Rewards = outstanding.Rewards.Add(tokens...)
+	outstanding.Rewards = outstanding.Rewards.AddValid(tokens)
 	return k.SetValidatorOutstandingRewards(ctx, val.GetOperator(), outstanding)
 }
@@ -0,0 +1,151 @@
+package keeper
+
+import (
+	"context"
+
+	storetypes "cosmossdk.io/store/types"
+
+	"github.com/cosmos/cosmos-sdk/runtime"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/distribution/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+)
+
+// SetAutoCompound opts a delegation in or out of auto-compounding.
+func (k Keeper) SetAutoCompound(ctx context.Context, delAddr sdk.AccAddress, valAddr sdk.ValAddress, enabled bool) error {
+	store := k.storeService.OpenKVStore(ctx)
+	if !enabled {
+		return store.Delete(types.GetAutoCompoundKey(delAddr, valAddr))
+	}
+
+	return store.Set(types.GetAutoCompoundKey(delAddr, valAddr), []byte{})
+}
+
+// HasAutoCompound returns whether a delegation is opted in to auto-compounding.
+func (k Keeper) HasAutoCompound(ctx context.Context, delAddr sdk.AccAddress, valAddr sdk.ValAddress) (bool, error) {
+	store := k.storeService.OpenKVStore(ctx)
+	return store.Has(types.GetAutoCompoundKey(delAddr, valAddr))
+}
+
+// IterateAutoCompounds iterates over the delegations opted in to
+// auto-compounding.
+func (k Keeper) IterateAutoCompounds(ctx context.Context, handler func(del sdk.AccAddress, val sdk.ValAddress) (stop bool)) {
+	store := k.storeService.OpenKVStore(ctx)
+	iter := storetypes.KVStorePrefixIterator(runtime.KVStoreAdapter(store), types.AutoCompoundPrefix)
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		del, val := types.GetAutoCompoundAddresses(iter.Key())
+		if handler(del, val) {
+			break
+		}
+	}
+}
+
+// ProcessAutoCompounds restakes the rewards of the delegations opted in to
+// auto-compounding. A pass starts every AutoCompoundInterval blocks and
+// processes at most AutoCompoundBatchSize delegations per block, resuming
+// from where the previous block stopped until all delegations are processed.
+func (k Keeper) ProcessAutoCompounds(ctx sdk.Context) error {
+	params, err := k.GetParams(ctx)
+	if err != nil {
+		return err
+	}
+
+	if params.AutoCompoundInterval == 0 {
+		return nil
+	}
+
+	store := k.storeService.OpenKVStore(ctx)
+	start, err := store.Get(types.AutoCompoundCursorKey)
+	if err != nil {
+		return err
+	}
+
+	// no pass is ongoing, start a new one at the epoch boundary
+	if start == nil {
+		if uint64(ctx.BlockHeight())%params.AutoCompoundInterval != 0 {
+			return nil
+		}
+
+		start = types.AutoCompoundPrefix
+	}
+
+	// collect the batch first, as compounding writes to the store
+	var (
+		batch [][]byte
+		next  []byte
+	)
+	iter := runtime.KVStoreAdapter(store).Iterator(start, storetypes.PrefixEndBytes(types.AutoCompoundPrefix))
+	for ; iter.Valid(); iter.Next() {
+		if params.AutoCompoundBatchSize > 0 && len(batch) == int(params.AutoCompoundBatchSize) {
+			next = iter.Key()
+			break
+		}
+
+		batch = append(batch, iter.Key())
+	}
+	iter.Close()
+
+	// the rest of the batch is deferred to the next block once the blocker gas
+	// limit of the module is reached
+	for i, key := range batch {
+		if i > 0 && ctx.IsBlockerGasExhausted() {
+			next = key
+			break
+		}
+
+		delAddr, valAddr := types.GetAutoCompoundAddresses(key)
+		k.autoCompound(ctx, delAddr, valAddr)
+	}
+
+	if next == nil {
+		return store.Delete(types.AutoCompoundCursorKey)
+	}
+
+	return store.Set(types.AutoCompoundCursorKey, next)
+}
+
+// autoCompound withdraws the rewards of a delegation and delegates the bond
+// denom part of them to the same validator. The rewards are only restaked when
+// they are withdrawn to the delegator account. Failures are logged and leave
+// the state untouched.
+func (k Keeper) autoCompound(ctx sdk.Context, delAddr sdk.AccAddress, valAddr sdk.ValAddress) {
+	withdrawAddr, err := k.GetDelegatorWithdrawAddr(ctx, delAddr)
+	if err != nil || !withdrawAddr.Equals(delAddr) {
+		return
+	}
+
+	validator, found := k.stakingKeeper.GetValidator(ctx, valAddr)
+	if !found {
+		return
+	}
+
+	cacheCtx, write := ctx.CacheContext()
+	rewards, err := k.WithdrawDelegationRewards(cacheCtx, delAddr, valAddr)
+	if err != nil {
+		k.Logger(ctx).Error("failed to withdraw rewards to compound", "delegator", delAddr.String(), "validator", valAddr.String(), "err", err)
+		return
+	}
+
+	bondDenom := k.stakingKeeper.BondDenom(ctx)
+	amount := rewards.AmountOf(bondDenom)
+	if !amount.IsPositive() {
+		return
+	}
+
+	if _, err := k.stakingKeeper.Delegate(cacheCtx, delAddr, amount, stakingtypes.Unbonded, validator, true); err != nil {
+		k.Logger(ctx).Error("failed to compound rewards", "delegator", delAddr.String(), "validator", valAddr.String(), "err", err)
+		return
+	}
+
+	write()
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeAutoCompound,
+			sdk.NewAttribute(sdk.AttributeKeyAmount, sdk.NewCoin(bondDenom, amount).String()),
+			sdk.NewAttribute(types.AttributeKeyValidator, valAddr.String()),
+			sdk.NewAttribute(types.AttributeKeyDelegator, delAddr.String()),
+		),
+	)
+}
@@ -0,0 +1,88 @@
+package keeper_test
+
+import (
+	"testing"
+	"time"
+
+	cmtproto "github.com/cometbft/cometbft/proto/tendermint/types"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+
+	storetypes "cosmossdk.io/store/types"
+
+	"github.com/cosmos/cosmos-sdk/runtime"
+	"github.com/cosmos/cosmos-sdk/testutil"
+	simtestutil "github.com/cosmos/cosmos-sdk/testutil/sims"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	moduletestutil "github.com/cosmos/cosmos-sdk/types/module/testutil"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	"github.com/cosmos/cosmos-sdk/x/distribution"
+	"github.com/cosmos/cosmos-sdk/x/distribution/keeper"
+	distrtestutil "github.com/cosmos/cosmos-sdk/x/distribution/testutil"
+	"github.com/cosmos/cosmos-sdk/x/distribution/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+)
+
+func TestProcessAutoCompounds(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	key := storetypes.NewKVStoreKey(types.StoreKey)
+	storeService := runtime.NewKVStoreService(key)
+	testCtx := testutil.DefaultContextWithDB(t, key, storetypes.NewTransientStoreKey("transient_test"))
+	encCfg := moduletestutil.MakeTestEncodingConfig(distribution.AppModuleBasic{})
+	ctx := testCtx.Ctx.WithBlockHeader(cmtproto.Header{Time: time.Now()})
+	addrs := simtestutil.CreateIncrementalAccounts(3)
+	valAddr := sdk.ValAddress(addrs[0])
+
+	bankKeeper := distrtestutil.NewMockBankKeeper(ctrl)
+	stakingKeeper := distrtestutil.NewMockStakingKeeper(ctrl)
+	accountKeeper := distrtestutil.NewMockAccountKeeper(ctrl)
+
+	accountKeeper.EXPECT().GetModuleAddress("distribution").Return(distrAcc.GetAddress())
+
+	distrKeeper := keeper.NewKeeper(
+		encCfg.Codec,
+		storeService,
+		accountKeeper,
+		bankKeeper,
+		stakingKeeper,
+		"fee_collector",
+		authtypes.NewModuleAddress("gov").String(),
+	)
+
+	params := types.DefaultParams()
+	params.AutoCompoundInterval = 10
+	params.AutoCompoundBatchSize = 2
+	require.NoError(t, distrKeeper.SetParams(ctx, params))
+
+	for _, addr := range addrs {
+		require.NoError(t, distrKeeper.SetAutoCompound(ctx, addr, valAddr, true))
+	}
+
+	has, err := distrKeeper.HasAutoCompound(ctx, addrs[1], valAddr)
+	require.NoError(t, err)
+	require.True(t, has)
+
+	// the validator is not found, so every delegation is skipped
+	stakingKeeper.EXPECT().GetValidator(gomock.Any(), valAddr).Return(stakingtypes.Validator{}, false).Times(3)
+
+	// no pass starts outside of an epoch boundary
+	require.NoError(t, distrKeeper.ProcessAutoCompounds(ctx.WithBlockHeight(5)))
+
+	// a pass starts at the epoch boundary and processes the first batch
+	require.NoError(t, distrKeeper.ProcessAutoCompounds(ctx.WithBlockHeight(10)))
+	cursor, err := storeService.OpenKVStore(ctx).Get(types.AutoCompoundCursorKey)
+	require.NoError(t, err)
+	require.NotNil(t, cursor)
+
+	// the pass resumes in the next block and ends
+	require.NoError(t, distrKeeper.ProcessAutoCompounds(ctx.WithBlockHeight(11)))
+	cursor, err = storeService.OpenKVStore(ctx).Get(types.AutoCompoundCursorKey)
+	require.NoError(t, err)
+	require.Nil(t, cursor)
+
+	// opting out removes the delegation
+	require.NoError(t, distrKeeper.SetAutoCompound(ctx, addrs[1], valAddr, false))
+	has, err = distrKeeper.HasAutoCompound(ctx, addrs[1], valAddr)
+	require.NoError(t, err)
+	require.False(t, has)
+}
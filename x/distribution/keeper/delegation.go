@@ -64,10 +64,35 @@ func (k Keeper) calculateDelegationRewardsBetween(ctx context.Context, val staki
 		panic("negative rewards should not be possible")
 	}
 	// note: necessary to truncate so we don't allow withdrawing more rewards than owed
-	rewards := difference.MulDecTruncate(stake)
+	rewards, err := difference.SafeMulDecTruncate(stake)
+	if err != nil {
+		return sdk.DecCoins{}, err
+	}
+
 	return rewards, nil
 }
 
+// mulTruncate returns a * b truncated, or an error instead of panicking if the
+// product overflows.
+func mulTruncate(a, b math.LegacyDec) (math.LegacyDec, error) {
+	fa, err := sdk.NewFixedDecFromLegacyDec(a, math.LegacyPrecision)
+	if err != nil {
+		return math.LegacyDec{}, err
+	}
+
+	fb, err := sdk.NewFixedDecFromLegacyDec(b, math.LegacyPrecision)
+	if err != nil {
+		return math.LegacyDec{}, err
+	}
+
+	product, err := fa.CheckedMulTruncate(fb)
+	if err != nil {
+		return math.LegacyDec{}, err
+	}
+
+	return product.ToLegacyDec()
+}
+
 // calculate the total rewards accrued by a delegation
 func (k Keeper) CalculateDelegationRewards(ctx context.Context, val stakingtypes.ValidatorI, del stakingtypes.DelegationI, endingPeriod uint64) (rewards sdk.DecCoins, err error) {
 	// fetch starting info for delegation
@@ -97,24 +122,32 @@ func (k Keeper) CalculateDelegationRewards(ctx context.Context, val stakingtypes
 	// for them for the stake sanity check below.
 	endingHeight := uint64(sdkCtx.BlockHeight())
 	if endingHeight > startingHeight {
+		var iterErr error
 		k.IterateValidatorSlashEventsBetween(ctx, del.GetValidatorAddr(), startingHeight, endingHeight,
 			func(height uint64, event types.ValidatorSlashEvent) (stop bool) {
 				endingPeriod := event.ValidatorPeriod
 				if endingPeriod > startingPeriod {
-					delRewards, err := k.calculateDelegationRewardsBetween(ctx, val, startingPeriod, endingPeriod, stake)
-					if err != nil {
-						panic(err)
+					var delRewards sdk.DecCoins
+					delRewards, iterErr = k.calculateDelegationRewardsBetween(ctx, val, startingPeriod, endingPeriod, stake)
+					if iterErr != nil {
+						return true
 					}
 					rewards = rewards.Add(delRewards...)
 
 					// Note: It is necessary to truncate so we don't allow withdrawing
 					// more rewards than owed.
-					stake = stake.MulTruncate(math.LegacyOneDec().Sub(event.Fraction))
+					stake, iterErr = mulTruncate(stake, math.LegacyOneDec().Sub(event.Fraction))
+					if iterErr != nil {
+						return true
+					}
 					startingPeriod = endingPeriod
 				}
 				return false
 			},
 		)
+		if iterErr != nil {
+			return sdk.DecCoins{}, iterErr
+		}
 	}
 
 	// A total stake sanity check; Recalculated final stake should be less than or
@@ -209,32 +242,32 @@ func (k Keeper) withdrawDelegationRewards(ctx context.Context, val stakingtypes.
 	// truncate reward dec coins, return remainder to community pool
 	finalRewards, remainder := rewards.TruncateDecimal()
 
+	feePool, err := k.GetFeePool(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	// a locked delegation earns a bonus on top of its rewards, which is paid
+	// out of the community pool as long as it holds enough funds
+	bonus := k.delegationLockBonus(ctx, del, rewards, feePool.CommunityPool)
+	feePool.CommunityPool = feePool.CommunityPool.SubValid(sdk.NewDecCoinsFromCoins(bonus...))
+	finalRewards = finalRewards.AddValid(bonus)
+
 	// add coins to user account
 	if !finalRewards.IsZero() {
-		withdrawAddr, err := k.GetDelegatorWithdrawAddr(ctx, del.GetDelegatorAddr())
-		if err != nil {
-			return nil, err
-		}
-
-		err = k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, withdrawAddr, finalRewards)
-		if err != nil {
+		if err := k.sendDelegationRewards(ctx, del.GetDelegatorAddr(), finalRewards); err != nil {
 			return nil, err
 		}
 	}
 
 	// update the outstanding rewards and the community pool only if the
 	// transaction was successful
-	err = k.SetValidatorOutstandingRewards(ctx, del.GetValidatorAddr(), types.ValidatorOutstandingRewards{Rewards: outstanding.Sub(rewards)})
+	err = k.SetValidatorOutstandingRewards(ctx, del.GetValidatorAddr(), types.ValidatorOutstandingRewards{Rewards: outstanding.SubValid(rewards)})
 	if err != nil {
 		return nil, err
 	}
 
-	feePool, err := k.GetFeePool(ctx)
-	if err != nil {
-		return nil, err
-	}
-
-	feePool.CommunityPool = feePool.CommunityPool.Add(remainder...)
+	feePool.CommunityPool = feePool.CommunityPool.AddValid(remainder)
 	err = k.SetFeePool(ctx, feePool)
 	if err != nil {
 		return nil, err
@@ -281,3 +314,16 @@ func (k Keeper) withdrawDelegationRewards(ctx context.Context, val stakingtypes.
 
 	return finalRewards, nil
 }
+
+// delegationLockBonus returns the part of the rewards of a delegation earned on
+// top of the base rewards because of the reward multiplier of its lock,
+// truncated and capped by the community pool.
+func (k Keeper) delegationLockBonus(ctx context.Context, del stakingtypes.DelegationI, rewards, communityPool sdk.DecCoins) sdk.Coins {
+	multiplier := k.stakingKeeper.DelegationRewardMultiplier(sdk.UnwrapSDKContext(ctx), del.GetDelegatorAddr(), del.GetValidatorAddr())
+	if multiplier.LTE(math.LegacyOneDec()) {
+		return nil
+	}
+
+	bonus, _ := rewards.MulDecTruncate(multiplier.Sub(math.LegacyOneDec())).Intersect(communityPool).TruncateDecimal()
+	return bonus
+}
@@ -460,6 +460,7 @@ func TestWithdrawDelegationRewardsBasic(t *testing.T) {
 	del := stakingtypes.NewDelegation(addr, valAddr, val.DelegatorShares)
 	stakingKeeper.EXPECT().Validator(gomock.Any(), valAddr).Return(val).Times(5)
 	stakingKeeper.EXPECT().Delegation(gomock.Any(), addr, valAddr).Return(del).Times(3)
+	stakingKeeper.EXPECT().DelegationRewardMultiplier(gomock.Any(), gomock.Any(), valAddr).Return(math.LegacyOneDec()).AnyTimes()
 
 	// run the necessary hooks manually (given that we are not running an actual staking module)
 	err = distrtestutil.CallCreateValidatorHooks(ctx, distrKeeper, addr, valAddr)
@@ -493,6 +494,75 @@ func TestWithdrawDelegationRewardsBasic(t *testing.T) {
 	require.Nil(t, err)
 }
 
+func TestWithdrawLockedDelegationRewards(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	key := storetypes.NewKVStoreKey(disttypes.StoreKey)
+	storeService := runtime.NewKVStoreService(key)
+	testCtx := testutil.DefaultContextWithDB(t, key, storetypes.NewTransientStoreKey("transient_test"))
+	encCfg := moduletestutil.MakeTestEncodingConfig(distribution.AppModuleBasic{})
+	ctx := testCtx.Ctx.WithBlockHeader(cmtproto.Header{Height: 1})
+
+	bankKeeper := distrtestutil.NewMockBankKeeper(ctrl)
+	stakingKeeper := distrtestutil.NewMockStakingKeeper(ctrl)
+	accountKeeper := distrtestutil.NewMockAccountKeeper(ctrl)
+
+	accountKeeper.EXPECT().GetModuleAddress("distribution").Return(distrAcc.GetAddress())
+
+	distrKeeper := keeper.NewKeeper(
+		encCfg.Codec,
+		storeService,
+		accountKeeper,
+		bankKeeper,
+		stakingKeeper,
+		"fee_collector",
+		authtypes.NewModuleAddress("gov").String(),
+	)
+
+	// fund the community pool with less than the lockup bonus
+	initial := sdk.TokensFromConsensusPower(10, sdk.DefaultPowerReduction)
+	feePool := disttypes.InitialFeePool()
+	feePool.CommunityPool = sdk.DecCoins{sdk.NewDecCoin(sdk.DefaultBondDenom, initial.QuoRaw(8))}
+	distrKeeper.SetFeePool(ctx, feePool)
+	distrKeeper.SetParams(ctx, disttypes.DefaultParams())
+
+	// create validator with 50% commission
+	valAddr := sdk.ValAddress(valConsAddr0)
+	addr := sdk.AccAddress(valAddr)
+	val, err := distrtestutil.CreateValidator(valConsPk0, math.NewInt(100))
+	require.NoError(t, err)
+
+	val.Commission = stakingtypes.NewCommission(math.LegacyNewDecWithPrec(5, 1), math.LegacyNewDecWithPrec(5, 1), math.LegacyNewDec(0))
+
+	// delegation mock, locked with a 1.5 reward multiplier
+	del := stakingtypes.NewDelegation(addr, valAddr, val.DelegatorShares)
+	stakingKeeper.EXPECT().Validator(gomock.Any(), valAddr).Return(val).Times(5)
+	stakingKeeper.EXPECT().Delegation(gomock.Any(), addr, valAddr).Return(del).Times(3)
+	stakingKeeper.EXPECT().DelegationRewardMultiplier(gomock.Any(), addr, valAddr).Return(math.LegacyNewDecWithPrec(15, 1))
+
+	// run the necessary hooks manually (given that we are not running an actual staking module)
+	err = distrtestutil.CallCreateValidatorHooks(ctx, distrKeeper, addr, valAddr)
+	require.NoError(t, err)
+
+	// next block
+	ctx = ctx.WithBlockHeight(ctx.BlockHeight() + 1)
+
+	// allocate some rewards
+	tokens := sdk.DecCoins{sdk.NewDecCoin(sdk.DefaultBondDenom, initial)}
+	distrKeeper.AllocateTokensToValidator(ctx, val, tokens)
+
+	// the bonus of half the rewards is capped by the community pool
+	expRewards := sdk.Coins{sdk.NewCoin(sdk.DefaultBondDenom, initial.QuoRaw(2).Add(initial.QuoRaw(8)))}
+	bankKeeper.EXPECT().SendCoinsFromModuleToAccount(ctx, disttypes.ModuleName, addr, expRewards)
+	rewards, err := distrKeeper.WithdrawDelegationRewards(ctx, addr, valAddr)
+	require.NoError(t, err)
+	require.Equal(t, expRewards, rewards)
+
+	// the community pool paid the bonus
+	feePool, err = distrKeeper.GetFeePool(ctx)
+	require.NoError(t, err)
+	require.True(t, feePool.CommunityPool.IsZero())
+}
+
 func TestCalculateRewardsAfterManySlashesInSameBlock(t *testing.T) {
 	ctrl := gomock.NewController(t)
 	key := storetypes.NewKVStoreKey(disttypes.StoreKey)
@@ -776,6 +846,7 @@ func TestCalculateRewardsMultiDelegatorMultWithdraw(t *testing.T) {
 	del := stakingtypes.NewDelegation(addr, valAddr, val.DelegatorShares)
 	stakingKeeper.EXPECT().Validator(gomock.Any(), valAddr).Return(val).Times(3)
 	stakingKeeper.EXPECT().Delegation(gomock.Any(), addr, valAddr).Return(del).Times(5)
+	stakingKeeper.EXPECT().DelegationRewardMultiplier(gomock.Any(), gomock.Any(), valAddr).Return(math.LegacyOneDec()).AnyTimes()
 
 	// run the necessary hooks manually (given that we are not running an actual staking module)
 	err = distrtestutil.CallCreateValidatorHooks(ctx, distrKeeper, addr, valAddr)
@@ -972,6 +1043,7 @@ func Test100PercentCommissionReward(t *testing.T) {
 	del := stakingtypes.NewDelegation(addr, valAddr, val.DelegatorShares)
 	stakingKeeper.EXPECT().Validator(gomock.Any(), valAddr).Return(val).Times(3)
 	stakingKeeper.EXPECT().Delegation(gomock.Any(), addr, valAddr).Return(del).Times(3)
+	stakingKeeper.EXPECT().DelegationRewardMultiplier(gomock.Any(), gomock.Any(), valAddr).Return(math.LegacyOneDec()).AnyTimes()
 
 	// run the necessary hooks manually (given that we are not running an actual staking module)
 	err = distrtestutil.CallCreateValidatorHooks(ctx, distrKeeper, addr, valAddr)
@@ -89,6 +89,30 @@ func (k Keeper) InitGenesis(ctx sdk.Context, data types.GenesisState) {
 		k.SetValidatorSlashEvent(ctx, valAddr, evt.Height, evt.Period, evt.ValidatorSlashEvent)
 	}
 
+	for _, entry := range data.AutoCompoundEntries {
+		delegatorAddress, err := k.authKeeper.StringToBytes(entry.DelegatorAddress)
+		if err != nil {
+			panic(err)
+		}
+		valAddr, err := sdk.ValAddressFromBech32(entry.ValidatorAddress)
+		if err != nil {
+			panic(err)
+		}
+		if err := k.SetAutoCompound(ctx, delegatorAddress, valAddr, true); err != nil {
+			panic(err)
+		}
+	}
+
+	for _, del := range data.VestingRewardsDelegators {
+		delegatorAddress, err := k.authKeeper.StringToBytes(del)
+		if err != nil {
+			panic(err)
+		}
+		if err := k.SetRewardsDestination(ctx, delegatorAddress, types.RewardsDestinationVesting); err != nil {
+			panic(err)
+		}
+	}
+
 	moduleHoldings = moduleHoldings.Add(data.FeePool.CommunityPool...)
 	moduleHoldingsInt, _ := moduleHoldings.TruncateDecimal()
 
@@ -204,5 +228,20 @@ func (k Keeper) ExportGenesis(ctx sdk.Context) *types.GenesisState {
 		},
 	)
 
-	return types.NewGenesisState(params, feePool, dwi, pp, outstanding, acc, his, cur, dels, slashes)
+	autoCompounds := make([]types.AutoCompoundEntry, 0)
+	k.IterateAutoCompounds(ctx, func(del sdk.AccAddress, val sdk.ValAddress) (stop bool) {
+		autoCompounds = append(autoCompounds, types.AutoCompoundEntry{
+			DelegatorAddress: del.String(),
+			ValidatorAddress: val.String(),
+		})
+		return false
+	})
+
+	vestingRewards := make([]string, 0)
+	k.IterateVestingRewardsDelegators(ctx, func(del sdk.AccAddress) (stop bool) {
+		vestingRewards = append(vestingRewards, del.String())
+		return false
+	})
+
+	return types.NewGenesisState(params, feePool, dwi, pp, outstanding, acc, his, cur, dels, slashes, autoCompounds, vestingRewards)
 }
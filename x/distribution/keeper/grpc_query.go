@@ -333,6 +333,29 @@ func (k Querier) DelegatorWithdrawAddress(c context.Context, req *types.QueryDel
 	return &types.QueryDelegatorWithdrawAddressResponse{WithdrawAddress: withdrawAddr.String()}, nil
 }
 
+// DelegatorRewardsDestination queries where the rewards of a delegator are
+// credited
+func (k Querier) DelegatorRewardsDestination(c context.Context, req *types.QueryDelegatorRewardsDestinationRequest) (*types.QueryDelegatorRewardsDestinationResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	if req.DelegatorAddress == "" {
+		return nil, status.Error(codes.InvalidArgument, "empty delegator address")
+	}
+	delAdr, err := k.authKeeper.StringToBytes(req.DelegatorAddress)
+	if err != nil {
+		return nil, err
+	}
+
+	destination, err := k.GetRewardsDestination(c, delAdr)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryDelegatorRewardsDestinationResponse{Destination: destination}, nil
+}
+
 // CommunityPool queries the community pool coins
 func (k Querier) CommunityPool(c context.Context, req *types.QueryCommunityPoolRequest) (*types.QueryCommunityPoolResponse, error) {
 	pool, err := k.GetFeePoolCommunityCoins(c)
@@ -158,10 +158,19 @@ func (h Hooks) AfterValidatorBeginUnbonding(_ sdk.Context, _ sdk.ConsAddress, _
 	return nil
 }
 
-func (h Hooks) BeforeDelegationRemoved(_ sdk.Context, _ sdk.AccAddress, _ sdk.ValAddress) error {
+// BeforeDelegationRemoved opts the removed delegation out of auto-compounding.
+func (h Hooks) BeforeDelegationRemoved(ctx sdk.Context, delAddr sdk.AccAddress, valAddr sdk.ValAddress) error {
+	return h.k.SetAutoCompound(ctx, delAddr, valAddr, false)
+}
+
+func (h Hooks) AfterValidatorSlashed(_ sdk.Context, _ sdk.ValAddress, _ sdkmath.LegacyDec, _ []stakingtypes.SlashedDelegation) error {
 	return nil
 }
 
 func (h Hooks) AfterUnbondingInitiated(_ sdk.Context, _ uint64) error {
 	return nil
 }
+
+func (h Hooks) AfterUnbondingCanceled(_ sdk.Context, _ uint64) error {
+	return nil
+}
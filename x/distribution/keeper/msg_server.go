@@ -2,6 +2,7 @@ package keeper
 
 import (
 	"context"
+	"strconv"
 
 	"cosmossdk.io/errors"
 	"github.com/armon/go-metrics"
@@ -9,6 +10,7 @@ import (
 	"github.com/cosmos/cosmos-sdk/telemetry"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	vestexported "github.com/cosmos/cosmos-sdk/x/auth/vesting/exported"
 	"github.com/cosmos/cosmos-sdk/x/distribution/types"
 	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
 )
@@ -205,6 +207,75 @@ func (k msgServer) DepositValidatorRewardsPool(ctx context.Context, msg *types.M
 	return &types.MsgDepositValidatorRewardsPoolResponse{}, nil
 }
 
+func (k msgServer) SetAutoCompound(ctx context.Context, msg *types.MsgSetAutoCompound) (*types.MsgSetAutoCompoundResponse, error) {
+	delegatorAddress, err := k.authKeeper.StringToBytes(msg.DelegatorAddress)
+	if err != nil {
+		return nil, sdkerrors.ErrInvalidAddress.Wrapf("invalid delegator address: %s", err)
+	}
+
+	valAddr, err := sdk.ValAddressFromBech32(msg.ValidatorAddress)
+	if err != nil {
+		return nil, sdkerrors.ErrInvalidAddress.Wrapf("invalid validator address: %s", err)
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	if msg.Enabled && k.stakingKeeper.Delegation(sdkCtx, delegatorAddress, valAddr) == nil {
+		return nil, errors.Wrapf(types.ErrNoDelegationExists, "delegator %s, validator %s", msg.DelegatorAddress, msg.ValidatorAddress)
+	}
+
+	if err := k.Keeper.SetAutoCompound(ctx, delegatorAddress, valAddr, msg.Enabled); err != nil {
+		return nil, err
+	}
+
+	sdkCtx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeSetAutoCompound,
+			sdk.NewAttribute(types.AttributeKeyDelegator, msg.DelegatorAddress),
+			sdk.NewAttribute(types.AttributeKeyValidator, msg.ValidatorAddress),
+			sdk.NewAttribute(types.AttributeKeyEnabled, strconv.FormatBool(msg.Enabled)),
+		),
+	)
+
+	return &types.MsgSetAutoCompoundResponse{}, nil
+}
+
+func (k msgServer) WithdrawAndDelegate(ctx context.Context, msg *types.MsgWithdrawAndDelegate) (*types.MsgWithdrawAndDelegateResponse, error) {
+	delegatorAddress, err := k.authKeeper.StringToBytes(msg.DelegatorAddress)
+	if err != nil {
+		return nil, sdkerrors.ErrInvalidAddress.Wrapf("invalid delegator address: %s", err)
+	}
+
+	var srcValAddr sdk.ValAddress
+	if msg.SourceValidatorAddress != "" {
+		srcValAddr, err = sdk.ValAddressFromBech32(msg.SourceValidatorAddress)
+		if err != nil {
+			return nil, sdkerrors.ErrInvalidAddress.Wrapf("invalid source validator address: %s", err)
+		}
+	}
+
+	valAddr, err := sdk.ValAddressFromBech32(msg.ValidatorAddress)
+	if err != nil {
+		return nil, sdkerrors.ErrInvalidAddress.Wrapf("invalid validator address: %s", err)
+	}
+
+	withdrawn, delegated, err := k.Keeper.WithdrawAndDelegate(ctx, delegatorAddress, srcValAddr, valAddr)
+	if err != nil {
+		return nil, err
+	}
+
+	defer func() {
+		if delegated.Amount.IsInt64() {
+			telemetry.SetGaugeWithLabels(
+				[]string{"tx", "msg", "withdraw_and_delegate"},
+				float32(delegated.Amount.Int64()),
+				[]metrics.Label{telemetry.NewLabel("denom", delegated.Denom)},
+			)
+		}
+	}()
+
+	return &types.MsgWithdrawAndDelegateResponse{Withdrawn: withdrawn, Delegated: delegated}, nil
+}
+
 func (k *Keeper) validateAuthority(authority string) error {
 	if _, err := k.authKeeper.StringToBytes(authority); err != nil {
 		return sdkerrors.ErrInvalidAddress.Wrapf("invalid authority address: %s", err)
@@ -228,3 +299,34 @@ func validateAmount(amount sdk.Coins) error {
 
 	return nil
 }
+
+func (k msgServer) SetRewardsDestination(ctx context.Context, msg *types.MsgSetRewardsDestination) (*types.MsgSetRewardsDestinationResponse, error) {
+	delegatorAddress, err := k.authKeeper.StringToBytes(msg.DelegatorAddress)
+	if err != nil {
+		return nil, sdkerrors.ErrInvalidAddress.Wrapf("invalid delegator address: %s", err)
+	}
+
+	if _, ok := types.RewardsDestination_name[int32(msg.Destination)]; !ok {
+		return nil, errors.Wrapf(types.ErrInvalidRewardsDestination, "%d", msg.Destination)
+	}
+
+	if msg.Destination == types.RewardsDestinationVesting {
+		if _, ok := k.authKeeper.GetAccount(ctx, delegatorAddress).(vestexported.GrantableVestingAccount); !ok {
+			return nil, errors.Wrapf(types.ErrNotVestingAccount, "%s", msg.DelegatorAddress)
+		}
+	}
+
+	if err := k.Keeper.SetRewardsDestination(ctx, delegatorAddress, msg.Destination); err != nil {
+		return nil, err
+	}
+
+	sdk.UnwrapSDKContext(ctx).EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeSetRewardsDestination,
+			sdk.NewAttribute(types.AttributeKeyDelegator, msg.DelegatorAddress),
+			sdk.NewAttribute(types.AttributeKeyDestination, msg.Destination.String()),
+		),
+	)
+
+	return &types.MsgSetRewardsDestinationResponse{}, nil
+}
@@ -0,0 +1,94 @@
+package keeper
+
+import (
+	"context"
+
+	storetypes "cosmossdk.io/store/types"
+
+	"github.com/cosmos/cosmos-sdk/runtime"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	vestexported "github.com/cosmos/cosmos-sdk/x/auth/vesting/exported"
+	"github.com/cosmos/cosmos-sdk/x/distribution/types"
+)
+
+// SetRewardsDestination sets where the rewards of a delegator are credited when
+// withdrawn to its account.
+func (k Keeper) SetRewardsDestination(ctx context.Context, delAddr sdk.AccAddress, destination types.RewardsDestination) error {
+	store := k.storeService.OpenKVStore(ctx)
+	if destination != types.RewardsDestinationVesting {
+		return store.Delete(types.GetVestingRewardsKey(delAddr))
+	}
+
+	return store.Set(types.GetVestingRewardsKey(delAddr), []byte{})
+}
+
+// GetRewardsDestination returns where the rewards of a delegator are credited
+// when withdrawn to its account.
+func (k Keeper) GetRewardsDestination(ctx context.Context, delAddr sdk.AccAddress) (types.RewardsDestination, error) {
+	store := k.storeService.OpenKVStore(ctx)
+	vesting, err := store.Has(types.GetVestingRewardsKey(delAddr))
+	if err != nil || !vesting {
+		return types.RewardsDestinationSpendable, err
+	}
+
+	return types.RewardsDestinationVesting, nil
+}
+
+// IterateVestingRewardsDelegators iterates over the delegators whose rewards
+// are added to their vesting amount.
+func (k Keeper) IterateVestingRewardsDelegators(ctx context.Context, handler func(del sdk.AccAddress) (stop bool)) {
+	store := k.storeService.OpenKVStore(ctx)
+	iter := storetypes.KVStorePrefixIterator(runtime.KVStoreAdapter(store), types.VestingRewardsPrefix)
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		if handler(types.GetVestingRewardsAddress(iter.Key())) {
+			break
+		}
+	}
+}
+
+// sendDelegationRewards sends the rewards of a delegator to its withdraw
+// address. The rewards withdrawn to the account of a delegator whose rewards
+// destination is vesting are added to the original vesting of its vesting
+// account, and vest along its vesting schedule, until the end of the schedule.
+func (k Keeper) sendDelegationRewards(ctx context.Context, delAddr sdk.AccAddress, rewards sdk.Coins) error {
+	withdrawAddr, err := k.GetDelegatorWithdrawAddr(ctx, delAddr)
+	if err != nil {
+		return err
+	}
+
+	err = k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, withdrawAddr, rewards)
+	if err != nil {
+		return err
+	}
+
+	if !withdrawAddr.Equals(delAddr) {
+		return nil
+	}
+
+	destination, err := k.GetRewardsDestination(ctx, delAddr)
+	if err != nil || destination != types.RewardsDestinationVesting {
+		return err
+	}
+
+	// the account is read once the rewards are sent, so that its balance
+	// covers its increased original vesting
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	acc, ok := k.authKeeper.GetAccount(ctx, delAddr).(vestexported.GrantableVestingAccount)
+	if !ok || sdkCtx.BlockTime().Unix() >= acc.GetEndTime() {
+		return nil
+	}
+
+	acc.AddOriginalVesting(rewards)
+	k.authKeeper.SetAccount(ctx, acc)
+
+	sdkCtx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeVestRewards,
+			sdk.NewAttribute(types.AttributeKeyDelegator, delAddr.String()),
+			sdk.NewAttribute(sdk.AttributeKeyAmount, rewards.String()),
+		),
+	)
+
+	return nil
+}
@@ -0,0 +1,126 @@
+package keeper_test
+
+import (
+	"testing"
+	"time"
+
+	cmtproto "github.com/cometbft/cometbft/proto/tendermint/types"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+
+	"cosmossdk.io/math"
+	storetypes "cosmossdk.io/store/types"
+
+	"github.com/cosmos/cosmos-sdk/runtime"
+	"github.com/cosmos/cosmos-sdk/testutil"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	moduletestutil "github.com/cosmos/cosmos-sdk/types/module/testutil"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	vestingtypes "github.com/cosmos/cosmos-sdk/x/auth/vesting/types"
+	"github.com/cosmos/cosmos-sdk/x/distribution"
+	"github.com/cosmos/cosmos-sdk/x/distribution/keeper"
+	distrtestutil "github.com/cosmos/cosmos-sdk/x/distribution/testutil"
+	disttypes "github.com/cosmos/cosmos-sdk/x/distribution/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+)
+
+func TestVestingRewardsDestination(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	key := storetypes.NewKVStoreKey(disttypes.StoreKey)
+	storeService := runtime.NewKVStoreService(key)
+	testCtx := testutil.DefaultContextWithDB(t, key, storetypes.NewTransientStoreKey("transient_test"))
+	encCfg := moduletestutil.MakeTestEncodingConfig(distribution.AppModuleBasic{})
+	now := time.Now()
+	ctx := testCtx.Ctx.WithBlockHeader(cmtproto.Header{Height: 1, Time: now})
+
+	bankKeeper := distrtestutil.NewMockBankKeeper(ctrl)
+	stakingKeeper := distrtestutil.NewMockStakingKeeper(ctrl)
+	accountKeeper := distrtestutil.NewMockAccountKeeper(ctrl)
+
+	accountKeeper.EXPECT().GetModuleAddress("distribution").Return(distrAcc.GetAddress())
+
+	distrKeeper := keeper.NewKeeper(
+		encCfg.Codec,
+		storeService,
+		accountKeeper,
+		bankKeeper,
+		stakingKeeper,
+		"fee_collector",
+		authtypes.NewModuleAddress("gov").String(),
+	)
+
+	// reset fee pool
+	distrKeeper.SetFeePool(ctx, disttypes.InitialFeePool())
+	distrKeeper.SetParams(ctx, disttypes.DefaultParams())
+
+	// create validator with 50% commission
+	valAddr := sdk.ValAddress(valConsAddr0)
+	addr := sdk.AccAddress(valAddr)
+	val, err := distrtestutil.CreateValidator(valConsPk0, math.NewInt(100))
+	require.NoError(t, err)
+
+	val.Commission = stakingtypes.NewCommission(math.LegacyNewDecWithPrec(5, 1), math.LegacyNewDecWithPrec(5, 1), math.LegacyNewDec(0))
+
+	// delegation mock
+	del := stakingtypes.NewDelegation(addr, valAddr, val.DelegatorShares)
+	stakingKeeper.EXPECT().Validator(gomock.Any(), valAddr).Return(val).AnyTimes()
+	stakingKeeper.EXPECT().Delegation(gomock.Any(), addr, valAddr).Return(del).AnyTimes()
+	stakingKeeper.EXPECT().DelegationRewardMultiplier(gomock.Any(), gomock.Any(), valAddr).Return(math.LegacyOneDec()).AnyTimes()
+
+	// run the necessary hooks manually (given that we are not running an actual staking module)
+	err = distrtestutil.CallCreateValidatorHooks(ctx, distrKeeper, addr, valAddr)
+	require.NoError(t, err)
+
+	// the rewards destination of a delegator is spendable by default
+	destination, err := distrKeeper.GetRewardsDestination(ctx, addr)
+	require.NoError(t, err)
+	require.Equal(t, disttypes.RewardsDestinationSpendable, destination)
+
+	require.NoError(t, distrKeeper.SetRewardsDestination(ctx, addr, disttypes.RewardsDestinationVesting))
+	destination, err = distrKeeper.GetRewardsDestination(ctx, addr)
+	require.NoError(t, err)
+	require.Equal(t, disttypes.RewardsDestinationVesting, destination)
+
+	// the rewards withdrawn to the vesting account are added to its original
+	// vesting
+	origVesting := sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 1000))
+	acc := vestingtypes.NewDelayedVestingAccount(authtypes.NewBaseAccountWithAddress(addr), origVesting, now.Add(time.Hour).Unix())
+	accountKeeper.EXPECT().GetAccount(gomock.Any(), addr).Return(acc)
+	accountKeeper.EXPECT().SetAccount(gomock.Any(), acc)
+
+	ctx = ctx.WithBlockHeight(ctx.BlockHeight() + 1)
+	initial := sdk.TokensFromConsensusPower(10, sdk.DefaultPowerReduction)
+	distrKeeper.AllocateTokensToValidator(ctx, val, sdk.DecCoins{sdk.NewDecCoin(sdk.DefaultBondDenom, initial)})
+
+	expRewards := sdk.Coins{sdk.NewCoin(sdk.DefaultBondDenom, initial.QuoRaw(2))}
+	bankKeeper.EXPECT().SendCoinsFromModuleToAccount(ctx, disttypes.ModuleName, addr, expRewards)
+
+	rewards, err := distrKeeper.WithdrawDelegationRewards(ctx, addr, valAddr)
+	require.NoError(t, err)
+	require.Equal(t, expRewards, rewards)
+	require.Equal(t, origVesting.Add(expRewards...), acc.GetOriginalVesting())
+
+	// the rewards withdrawn to another account are spendable
+	withdrawAddr := sdk.AccAddress(valConsAddr1)
+	require.NoError(t, distrKeeper.SetDelegatorWithdrawAddr(ctx, addr, withdrawAddr))
+
+	ctx = ctx.WithBlockHeight(ctx.BlockHeight() + 1)
+	distrKeeper.AllocateTokensToValidator(ctx, val, sdk.DecCoins{sdk.NewDecCoin(sdk.DefaultBondDenom, initial)})
+	bankKeeper.EXPECT().SendCoinsFromModuleToAccount(ctx, disttypes.ModuleName, withdrawAddr, expRewards)
+
+	_, err = distrKeeper.WithdrawDelegationRewards(ctx, addr, valAddr)
+	require.NoError(t, err)
+	require.Equal(t, origVesting.Add(expRewards...), acc.GetOriginalVesting())
+
+	var delegators []sdk.AccAddress
+	distrKeeper.IterateVestingRewardsDelegators(ctx, func(del sdk.AccAddress) (stop bool) {
+		delegators = append(delegators, del)
+		return false
+	})
+	require.Equal(t, []sdk.AccAddress{addr}, delegators)
+
+	require.NoError(t, distrKeeper.SetRewardsDestination(ctx, addr, disttypes.RewardsDestinationSpendable))
+	destination, err = distrKeeper.GetRewardsDestination(ctx, addr)
+	require.NoError(t, err)
+	require.Equal(t, disttypes.RewardsDestinationSpendable, destination)
+}
@@ -0,0 +1,42 @@
+package keeper
+
+import (
+	"context"
+
+	"cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/distribution/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+)
+
+var _ ViewKeeper = Keeper{}
+
+// ViewKeeper defines a module interface that facilitates read only access to
+// the distribution state: the params, the fee pool and the rewards and
+// commissions of the validators and delegators.
+type ViewKeeper interface {
+	GetParams(ctx context.Context) (params types.Params, err error)
+	GetCommunityTax(ctx context.Context) (math.LegacyDec, error)
+	GetWithdrawAddrEnabled(ctx context.Context) (enabled bool, err error)
+
+	GetFeePool(ctx context.Context) (feePool types.FeePool, err error)
+	GetFeePoolCommunityCoins(ctx context.Context) (sdk.DecCoins, error)
+	GetTotalRewards(ctx context.Context) (totalRewards sdk.DecCoins)
+
+	GetDelegatorWithdrawAddr(ctx context.Context, delAddr sdk.AccAddress) (sdk.AccAddress, error)
+	GetDelegatorStartingInfo(ctx context.Context, val sdk.ValAddress, del sdk.AccAddress) (period types.DelegatorStartingInfo, err error)
+	HasAutoCompound(ctx context.Context, delAddr sdk.AccAddress, valAddr sdk.ValAddress) (bool, error)
+	CalculateDelegationRewards(ctx context.Context, val stakingtypes.ValidatorI, del stakingtypes.DelegationI, endingPeriod uint64) (rewards sdk.DecCoins, err error)
+
+	GetValidatorCurrentRewards(ctx context.Context, val sdk.ValAddress) (rewards types.ValidatorCurrentRewards, err error)
+	GetValidatorHistoricalRewards(ctx context.Context, val sdk.ValAddress, period uint64) (rewards types.ValidatorHistoricalRewards, err error)
+	GetValidatorOutstandingRewards(ctx context.Context, val sdk.ValAddress) (rewards types.ValidatorOutstandingRewards, err error)
+	GetValidatorOutstandingRewardsCoins(ctx context.Context, val sdk.ValAddress) (sdk.DecCoins, error)
+	GetValidatorAccumulatedCommission(ctx context.Context, val sdk.ValAddress) (commission types.ValidatorAccumulatedCommission, err error)
+	GetValidatorSlashEvent(ctx context.Context, val sdk.ValAddress, height, period uint64) (event types.ValidatorSlashEvent, found bool, err error)
+
+	IterateValidatorOutstandingRewards(ctx context.Context, handler func(val sdk.ValAddress, rewards types.ValidatorOutstandingRewards) (stop bool))
+	IterateValidatorAccumulatedCommissions(ctx context.Context, handler func(val sdk.ValAddress, commission types.ValidatorAccumulatedCommission) (stop bool))
+	IterateDelegatorWithdrawAddrs(ctx context.Context, handler func(del, addr sdk.AccAddress) (stop bool))
+}
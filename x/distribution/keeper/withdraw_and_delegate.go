@@ -0,0 +1,79 @@
+package keeper
+
+import (
+	"context"
+
+	"cosmossdk.io/errors"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/distribution/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+)
+
+// WithdrawAndDelegate withdraws the rewards of a delegator from the source
+// validator, or from all the validators it delegates to if srcValAddr is nil,
+// and delegates the staking tokens withdrawn to the given validator. The
+// rewards of other denoms are kept in the delegator account. It returns the
+// withdrawn rewards and the delegated amount.
+func (k Keeper) WithdrawAndDelegate(
+	ctx context.Context, delAddr sdk.AccAddress, srcValAddr, valAddr sdk.ValAddress,
+) (withdrawn sdk.Coins, delegated sdk.Coin, err error) {
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	bondDenom := k.stakingKeeper.BondDenom(sdkCtx)
+
+	withdrawAddr, err := k.GetDelegatorWithdrawAddr(ctx, delAddr)
+	if err != nil {
+		return nil, sdk.Coin{}, err
+	}
+
+	if !withdrawAddr.Equals(delAddr) {
+		return nil, sdk.Coin{}, errors.Wrapf(types.ErrRewardsNotWithdrawnToDelegator, "withdraw address %s", withdrawAddr)
+	}
+
+	if _, found := k.stakingKeeper.GetValidator(sdkCtx, valAddr); !found {
+		return nil, sdk.Coin{}, errors.Wrap(types.ErrNoValidatorExists, valAddr.String())
+	}
+
+	srcValAddrs := []sdk.ValAddress{srcValAddr}
+	if srcValAddr == nil {
+		srcValAddrs = nil
+		k.stakingKeeper.IterateDelegations(sdkCtx, delAddr, func(_ int64, del stakingtypes.DelegationI) (stop bool) {
+			srcValAddrs = append(srcValAddrs, del.GetValidatorAddr())
+			return false
+		})
+	}
+
+	withdrawn = sdk.NewCoins()
+	for _, srcValAddr := range srcValAddrs {
+		rewards, err := k.WithdrawDelegationRewards(ctx, delAddr, srcValAddr)
+		if err != nil {
+			return nil, sdk.Coin{}, err
+		}
+
+		withdrawn = withdrawn.Add(rewards...)
+	}
+
+	delegated = sdk.NewCoin(bondDenom, withdrawn.AmountOf(bondDenom))
+	if !delegated.IsPositive() {
+		return nil, sdk.Coin{}, errors.Wrapf(types.ErrNoRewardsToDelegate, "withdrawn %s", withdrawn)
+	}
+
+	// the validator is read after the withdrawals, as its state may have been
+	// updated by the hooks
+	validator, _ := k.stakingKeeper.GetValidator(sdkCtx, valAddr)
+	if _, err := k.stakingKeeper.Delegate(sdkCtx, delAddr, delegated.Amount, stakingtypes.Unbonded, validator, true); err != nil {
+		return nil, sdk.Coin{}, err
+	}
+
+	sdkCtx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeWithdrawAndDelegate,
+			sdk.NewAttribute(sdk.AttributeKeyAmount, delegated.String()),
+			sdk.NewAttribute(types.AttributeKeyWithdrawn, withdrawn.String()),
+			sdk.NewAttribute(types.AttributeKeyValidator, valAddr.String()),
+			sdk.NewAttribute(types.AttributeKeyDelegator, delAddr.String()),
+		),
+	)
+
+	return withdrawn, delegated, nil
+}
@@ -0,0 +1,105 @@
+package keeper_test
+
+import (
+	"testing"
+
+	cmtproto "github.com/cometbft/cometbft/proto/tendermint/types"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+
+	"cosmossdk.io/math"
+	storetypes "cosmossdk.io/store/types"
+
+	"github.com/cosmos/cosmos-sdk/runtime"
+	"github.com/cosmos/cosmos-sdk/testutil"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	moduletestutil "github.com/cosmos/cosmos-sdk/types/module/testutil"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	"github.com/cosmos/cosmos-sdk/x/distribution"
+	"github.com/cosmos/cosmos-sdk/x/distribution/keeper"
+	distrtestutil "github.com/cosmos/cosmos-sdk/x/distribution/testutil"
+	disttypes "github.com/cosmos/cosmos-sdk/x/distribution/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+)
+
+func TestWithdrawAndDelegate(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	key := storetypes.NewKVStoreKey(disttypes.StoreKey)
+	storeService := runtime.NewKVStoreService(key)
+	testCtx := testutil.DefaultContextWithDB(t, key, storetypes.NewTransientStoreKey("transient_test"))
+	encCfg := moduletestutil.MakeTestEncodingConfig(distribution.AppModuleBasic{})
+	ctx := testCtx.Ctx.WithBlockHeader(cmtproto.Header{Height: 1})
+
+	bankKeeper := distrtestutil.NewMockBankKeeper(ctrl)
+	stakingKeeper := distrtestutil.NewMockStakingKeeper(ctrl)
+	accountKeeper := distrtestutil.NewMockAccountKeeper(ctrl)
+
+	accountKeeper.EXPECT().GetModuleAddress("distribution").Return(distrAcc.GetAddress())
+
+	distrKeeper := keeper.NewKeeper(
+		encCfg.Codec,
+		storeService,
+		accountKeeper,
+		bankKeeper,
+		stakingKeeper,
+		"fee_collector",
+		authtypes.NewModuleAddress("gov").String(),
+	)
+
+	// reset fee pool
+	distrKeeper.SetFeePool(ctx, disttypes.InitialFeePool())
+	distrKeeper.SetParams(ctx, disttypes.DefaultParams())
+
+	// create validator with 50% commission
+	valAddr := sdk.ValAddress(valConsAddr0)
+	addr := sdk.AccAddress(valAddr)
+	val, err := distrtestutil.CreateValidator(valConsPk0, math.NewInt(100))
+	require.NoError(t, err)
+
+	val.Commission = stakingtypes.NewCommission(math.LegacyNewDecWithPrec(5, 1), math.LegacyNewDecWithPrec(5, 1), math.LegacyNewDec(0))
+
+	// delegation mock
+	del := stakingtypes.NewDelegation(addr, valAddr, val.DelegatorShares)
+	stakingKeeper.EXPECT().Validator(gomock.Any(), valAddr).Return(val).AnyTimes()
+	stakingKeeper.EXPECT().GetValidator(gomock.Any(), valAddr).Return(val, true).AnyTimes()
+	stakingKeeper.EXPECT().Delegation(gomock.Any(), addr, valAddr).Return(del).AnyTimes()
+	stakingKeeper.EXPECT().DelegationRewardMultiplier(gomock.Any(), gomock.Any(), valAddr).Return(math.LegacyOneDec()).AnyTimes()
+	stakingKeeper.EXPECT().BondDenom(gomock.Any()).Return(sdk.DefaultBondDenom).AnyTimes()
+	stakingKeeper.EXPECT().IterateDelegations(gomock.Any(), addr, gomock.Any()).DoAndReturn(
+		func(_ sdk.Context, _ sdk.AccAddress, fn func(int64, stakingtypes.DelegationI) bool) {
+			fn(0, del)
+		},
+	).AnyTimes()
+
+	// run the necessary hooks manually (given that we are not running an actual staking module)
+	err = distrtestutil.CallCreateValidatorHooks(ctx, distrKeeper, addr, valAddr)
+	require.NoError(t, err)
+
+	// next block
+	ctx = ctx.WithBlockHeight(ctx.BlockHeight() + 1)
+
+	// allocate some rewards
+	initial := sdk.TokensFromConsensusPower(10, sdk.DefaultPowerReduction)
+	tokens := sdk.DecCoins{sdk.NewDecCoin(sdk.DefaultBondDenom, initial)}
+	distrKeeper.AllocateTokensToValidator(ctx, val, tokens)
+
+	// the rewards must be withdrawn to the delegator account
+	require.NoError(t, distrKeeper.SetDelegatorWithdrawAddr(ctx, addr, sdk.AccAddress(valConsAddr1)))
+	_, _, err = distrKeeper.WithdrawAndDelegate(ctx, addr, valAddr, valAddr)
+	require.ErrorIs(t, err, disttypes.ErrRewardsNotWithdrawnToDelegator)
+	require.NoError(t, distrKeeper.SetDelegatorWithdrawAddr(ctx, addr, addr))
+
+	// the rewards are withdrawn and delegated
+	expRewards := sdk.Coins{sdk.NewCoin(sdk.DefaultBondDenom, initial.QuoRaw(2))}
+	bankKeeper.EXPECT().SendCoinsFromModuleToAccount(ctx, disttypes.ModuleName, addr, expRewards)
+	stakingKeeper.EXPECT().Delegate(gomock.Any(), addr, initial.QuoRaw(2), stakingtypes.Unbonded, val, true).Return(math.LegacyOneDec(), nil)
+
+	withdrawn, delegated, err := distrKeeper.WithdrawAndDelegate(ctx, addr, valAddr, valAddr)
+	require.NoError(t, err)
+	require.Equal(t, expRewards, withdrawn)
+	require.Equal(t, sdk.NewCoin(sdk.DefaultBondDenom, initial.QuoRaw(2)), delegated)
+
+	// nothing is left to delegate from all the validators
+	_, _, err = distrKeeper.WithdrawAndDelegate(ctx, addr, nil, valAddr)
+	require.ErrorIs(t, err, disttypes.ErrNoRewardsToDelegate)
+}
@@ -41,6 +41,7 @@ func TestMigrateJSON(t *testing.T) {
 	require.NoError(t, err)
 
 	expected := `{
+	"auto_compound_entries": [],
 	"delegator_starting_infos": [],
 	"delegator_withdraw_infos": [],
 	"fee_pool": {
@@ -48,6 +49,8 @@ func TestMigrateJSON(t *testing.T) {
 	},
 	"outstanding_rewards": [],
 	"params": {
+		"auto_compound_batch_size": 100,
+		"auto_compound_interval": "100",
 		"base_proposer_reward": "0.000000000000000000",
 		"bonus_proposer_reward": "0.000000000000000000",
 		"community_tax": "0.020000000000000000",
@@ -57,7 +60,8 @@ func TestMigrateJSON(t *testing.T) {
 	"validator_accumulated_commissions": [],
 	"validator_current_rewards": [],
 	"validator_historical_rewards": [],
-	"validator_slash_events": []
+	"validator_slash_events": [],
+	"vesting_rewards_delegators": []
 }`
 
 	require.Equal(t, expected, string(indentedBz))
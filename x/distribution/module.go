@@ -6,6 +6,7 @@ import (
 	"fmt"
 
 	abci "github.com/cometbft/cometbft/abci/types"
+	"github.com/cosmos/gogoproto/proto"
 	gwruntime "github.com/grpc-ecosystem/grpc-gateway/runtime"
 	"github.com/spf13/cobra"
 
@@ -13,6 +14,7 @@ import (
 	"cosmossdk.io/core/address"
 	"cosmossdk.io/core/appmodule"
 	"cosmossdk.io/depinject"
+	"cosmossdk.io/errors"
 
 	"cosmossdk.io/core/store"
 
@@ -20,6 +22,7 @@ import (
 	"github.com/cosmos/cosmos-sdk/codec"
 	cdctypes "github.com/cosmos/cosmos-sdk/codec/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	"github.com/cosmos/cosmos-sdk/types/module"
 	simtypes "github.com/cosmos/cosmos-sdk/types/simulation"
 	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
@@ -125,6 +128,7 @@ func NewAppModule(
 var (
 	_ appmodule.AppModule       = AppModule{}
 	_ appmodule.HasBeginBlocker = AppModule{}
+	_ appmodule.HasEndBlocker   = AppModule{}
 )
 
 // IsOnePerModuleType implements the depinject.OnePerModuleType interface.
@@ -177,12 +181,30 @@ func (am AppModule) ExportGenesis(ctx sdk.Context, cdc codec.JSONCodec) json.Raw
 // ConsensusVersion implements AppModule/ConsensusVersion.
 func (AppModule) ConsensusVersion() uint64 { return ConsensusVersion }
 
+// UpdatableParams implements the module.HasUpdatableParams interface.
+func (am AppModule) UpdatableParams() module.UpdatableParams {
+	return module.UpdatableParams{
+		UpdateMsg: &types.MsgUpdateParams{},
+		Authority: am.keeper.GetAuthority(),
+		GetParams: func(ctx context.Context) (proto.Message, error) {
+			params, err := am.keeper.GetParams(ctx)
+			return &params, err
+		},
+	}
+}
+
 // BeginBlock returns the begin blocker for the distribution module.
 func (am AppModule) BeginBlock(ctx context.Context) error {
 	c := sdk.UnwrapSDKContext(ctx)
 	return BeginBlocker(c, am.keeper)
 }
 
+// EndBlock returns the end blocker for the distribution module.
+func (am AppModule) EndBlock(ctx context.Context) error {
+	c := sdk.UnwrapSDKContext(ctx)
+	return EndBlocker(c, am.keeper)
+}
+
 // AppModuleSimulation functions
 
 // GenerateGenesisState creates a randomized GenState of the distribution module.
@@ -236,9 +258,11 @@ type ModuleInputs struct {
 type ModuleOutputs struct {
 	depinject.Out
 
-	DistrKeeper keeper.Keeper
-	Module      appmodule.AppModule
-	Hooks       staking.StakingHooksWrapper
+	DistrKeeper          keeper.Keeper
+	ViewKeeper           keeper.ViewKeeper
+	Module               appmodule.AppModule
+	Hooks                staking.StakingHooksWrapper
+	ParamsValidatorRoute govtypes.ParamsValidatorRoute
 }
 
 func ProvideModule(in ModuleInputs) ModuleOutputs {
@@ -266,8 +290,31 @@ func ProvideModule(in ModuleInputs) ModuleOutputs {
 	m := NewAppModule(in.Cdc, k, in.AccountKeeper, in.BankKeeper, in.StakingKeeper, in.LegacySubspace)
 
 	return ModuleOutputs{
-		DistrKeeper: k,
-		Module:      m,
-		Hooks:       staking.StakingHooksWrapper{StakingHooks: k.Hooks()},
+		DistrKeeper:          k,
+		ViewKeeper:           k,
+		Module:               m,
+		Hooks:                staking.StakingHooksWrapper{StakingHooks: k.Hooks()},
+		ParamsValidatorRoute: NewParamsValidatorRoute(),
+	}
+}
+
+// NewParamsValidatorRoute returns the params validator route of the x/distribution
+// MsgUpdateParams message, used by the x/gov MsgBatchUpdateParams message.
+func NewParamsValidatorRoute() govtypes.ParamsValidatorRoute {
+	return govtypes.ParamsValidatorRoute{
+		MsgTypeURL: sdk.MsgTypeURL(&types.MsgUpdateParams{}),
+		Validator: func(_ context.Context, msg sdk.Msg) error {
+			m, ok := msg.(*types.MsgUpdateParams)
+			if !ok {
+				return errors.Wrapf(sdkerrors.ErrInvalidType, "expected %T, got %T", &types.MsgUpdateParams{}, msg)
+			}
+
+			if (!m.Params.BaseProposerReward.IsNil() && !m.Params.BaseProposerReward.IsZero()) || //nolint:staticcheck // deprecated but kept for backwards compatibility
+				(!m.Params.BonusProposerReward.IsNil() && !m.Params.BonusProposerReward.IsZero()) { //nolint:staticcheck // deprecated but kept for backwards compatibility
+				return errors.Wrapf(sdkerrors.ErrInvalidRequest, "cannot update base or bonus proposer reward because these are deprecated fields")
+			}
+
+			return m.Params.ValidateBasic()
+		},
 	}
 }
@@ -7,7 +7,9 @@ package testutil
 import (
 	context "context"
 	reflect "reflect"
+	time "time"
 
+	math "cosmossdk.io/math"
 	types "github.com/cosmos/cosmos-sdk/types"
 	types0 "github.com/cosmos/cosmos-sdk/x/staking/types"
 	gomock "github.com/golang/mock/gomock"
@@ -93,6 +95,18 @@ func (mr *MockAccountKeeperMockRecorder) GetModuleAddress(name interface{}) *gom
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetModuleAddress", reflect.TypeOf((*MockAccountKeeper)(nil).GetModuleAddress), name)
 }
 
+// SetAccount mocks base method.
+func (m *MockAccountKeeper) SetAccount(ctx context.Context, acc types.AccountI) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "SetAccount", ctx, acc)
+}
+
+// SetAccount indicates an expected call of SetAccount.
+func (mr *MockAccountKeeperMockRecorder) SetAccount(ctx, acc interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetAccount", reflect.TypeOf((*MockAccountKeeper)(nil).SetAccount), ctx, acc)
+}
+
 // SetModuleAccount mocks base method.
 func (m *MockAccountKeeper) SetModuleAccount(arg0 context.Context, arg1 types.ModuleAccountI) {
 	m.ctrl.T.Helper()
@@ -250,18 +264,75 @@ func (m *MockStakingKeeper) EXPECT() *MockStakingKeeperMockRecorder {
 	return m.recorder
 }
 
+// BondDenom mocks base method.
+func (m *MockStakingKeeper) BondDenom(ctx types.Context) string {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "BondDenom", ctx)
+	ret0, _ := ret[0].(string)
+	return ret0
+}
+
+// BondDenom indicates an expected call of BondDenom.
+func (mr *MockStakingKeeperMockRecorder) BondDenom(ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "BondDenom", reflect.TypeOf((*MockStakingKeeper)(nil).BondDenom), ctx)
+}
+
+// BondedRatio mocks base method.
+func (m *MockStakingKeeper) BondedRatio(ctx types.Context) math.LegacyDec {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "BondedRatio", ctx)
+	ret0, _ := ret[0].(math.LegacyDec)
+	return ret0
+}
+
+// BondedRatio indicates an expected call of BondedRatio.
+func (mr *MockStakingKeeperMockRecorder) BondedRatio(ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "BondedRatio", reflect.TypeOf((*MockStakingKeeper)(nil).BondedRatio), ctx)
+}
+
+// Delegate mocks base method.
+func (m *MockStakingKeeper) Delegate(ctx types.Context, delAddr types.AccAddress, bondAmt math.Int, tokenSrc types0.BondStatus, validator types0.Validator, subtractAccount bool) (math.LegacyDec, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Delegate", ctx, delAddr, bondAmt, tokenSrc, validator, subtractAccount)
+	ret0, _ := ret[0].(math.LegacyDec)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Delegate indicates an expected call of Delegate.
+func (mr *MockStakingKeeperMockRecorder) Delegate(ctx, delAddr, bondAmt, tokenSrc, validator, subtractAccount interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delegate", reflect.TypeOf((*MockStakingKeeper)(nil).Delegate), ctx, delAddr, bondAmt, tokenSrc, validator, subtractAccount)
+}
+
 // Delegation mocks base method.
-func (m *MockStakingKeeper) Delegation(arg0 types.Context, arg1 types.AccAddress, arg2 types.ValAddress) types0.DelegationI {
+func (m *MockStakingKeeper) Delegation(ctx types.Context, delAddr types.AccAddress, valAddr types.ValAddress) types0.DelegationI {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "Delegation", arg0, arg1, arg2)
+	ret := m.ctrl.Call(m, "Delegation", ctx, delAddr, valAddr)
 	ret0, _ := ret[0].(types0.DelegationI)
 	return ret0
 }
 
 // Delegation indicates an expected call of Delegation.
-func (mr *MockStakingKeeperMockRecorder) Delegation(arg0, arg1, arg2 interface{}) *gomock.Call {
+func (mr *MockStakingKeeperMockRecorder) Delegation(ctx, delAddr, valAddr interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delegation", reflect.TypeOf((*MockStakingKeeper)(nil).Delegation), arg0, arg1, arg2)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delegation", reflect.TypeOf((*MockStakingKeeper)(nil).Delegation), ctx, delAddr, valAddr)
+}
+
+// DelegationRewardMultiplier mocks base method.
+func (m *MockStakingKeeper) DelegationRewardMultiplier(ctx types.Context, delAddr types.AccAddress, valAddr types.ValAddress) math.LegacyDec {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DelegationRewardMultiplier", ctx, delAddr, valAddr)
+	ret0, _ := ret[0].(math.LegacyDec)
+	return ret0
+}
+
+// DelegationRewardMultiplier indicates an expected call of DelegationRewardMultiplier.
+func (mr *MockStakingKeeperMockRecorder) DelegationRewardMultiplier(ctx, delAddr, valAddr interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DelegationRewardMultiplier", reflect.TypeOf((*MockStakingKeeper)(nil).DelegationRewardMultiplier), ctx, delAddr, valAddr)
 }
 
 // GetAllDelegatorDelegations mocks base method.
@@ -306,56 +377,392 @@ func (mr *MockStakingKeeperMockRecorder) GetAllValidators(ctx interface{}) *gomo
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAllValidators", reflect.TypeOf((*MockStakingKeeper)(nil).GetAllValidators), ctx)
 }
 
+// GetBondedValidatorsByPower mocks base method.
+func (m *MockStakingKeeper) GetBondedValidatorsByPower(ctx types.Context) []types0.Validator {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetBondedValidatorsByPower", ctx)
+	ret0, _ := ret[0].([]types0.Validator)
+	return ret0
+}
+
+// GetBondedValidatorsByPower indicates an expected call of GetBondedValidatorsByPower.
+func (mr *MockStakingKeeperMockRecorder) GetBondedValidatorsByPower(ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetBondedValidatorsByPower", reflect.TypeOf((*MockStakingKeeper)(nil).GetBondedValidatorsByPower), ctx)
+}
+
+// GetDelegation mocks base method.
+func (m *MockStakingKeeper) GetDelegation(ctx types.Context, delAddr types.AccAddress, valAddr types.ValAddress) (types0.Delegation, bool) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetDelegation", ctx, delAddr, valAddr)
+	ret0, _ := ret[0].(types0.Delegation)
+	ret1, _ := ret[1].(bool)
+	return ret0, ret1
+}
+
+// GetDelegation indicates an expected call of GetDelegation.
+func (mr *MockStakingKeeperMockRecorder) GetDelegation(ctx, delAddr, valAddr interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetDelegation", reflect.TypeOf((*MockStakingKeeper)(nil).GetDelegation), ctx, delAddr, valAddr)
+}
+
+// GetDelegatorBonded mocks base method.
+func (m *MockStakingKeeper) GetDelegatorBonded(ctx types.Context, delegator types.AccAddress) math.Int {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetDelegatorBonded", ctx, delegator)
+	ret0, _ := ret[0].(math.Int)
+	return ret0
+}
+
+// GetDelegatorBonded indicates an expected call of GetDelegatorBonded.
+func (mr *MockStakingKeeperMockRecorder) GetDelegatorBonded(ctx, delegator interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetDelegatorBonded", reflect.TypeOf((*MockStakingKeeper)(nil).GetDelegatorBonded), ctx, delegator)
+}
+
+// GetDelegatorDelegations mocks base method.
+func (m *MockStakingKeeper) GetDelegatorDelegations(ctx types.Context, delegator types.AccAddress, maxRetrieve uint16) []types0.Delegation {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetDelegatorDelegations", ctx, delegator, maxRetrieve)
+	ret0, _ := ret[0].([]types0.Delegation)
+	return ret0
+}
+
+// GetDelegatorDelegations indicates an expected call of GetDelegatorDelegations.
+func (mr *MockStakingKeeperMockRecorder) GetDelegatorDelegations(ctx, delegator, maxRetrieve interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetDelegatorDelegations", reflect.TypeOf((*MockStakingKeeper)(nil).GetDelegatorDelegations), ctx, delegator, maxRetrieve)
+}
+
+// GetDelegatorUnbonding mocks base method.
+func (m *MockStakingKeeper) GetDelegatorUnbonding(ctx types.Context, delegator types.AccAddress) math.Int {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetDelegatorUnbonding", ctx, delegator)
+	ret0, _ := ret[0].(math.Int)
+	return ret0
+}
+
+// GetDelegatorUnbonding indicates an expected call of GetDelegatorUnbonding.
+func (mr *MockStakingKeeperMockRecorder) GetDelegatorUnbonding(ctx, delegator interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetDelegatorUnbonding", reflect.TypeOf((*MockStakingKeeper)(nil).GetDelegatorUnbonding), ctx, delegator)
+}
+
+// GetHistoricalInfo mocks base method.
+func (m *MockStakingKeeper) GetHistoricalInfo(ctx types.Context, height int64) (types0.HistoricalInfo, bool) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetHistoricalInfo", ctx, height)
+	ret0, _ := ret[0].(types0.HistoricalInfo)
+	ret1, _ := ret[1].(bool)
+	return ret0, ret1
+}
+
+// GetHistoricalInfo indicates an expected call of GetHistoricalInfo.
+func (mr *MockStakingKeeperMockRecorder) GetHistoricalInfo(ctx, height interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetHistoricalInfo", reflect.TypeOf((*MockStakingKeeper)(nil).GetHistoricalInfo), ctx, height)
+}
+
+// GetLastTotalPower mocks base method.
+func (m *MockStakingKeeper) GetLastTotalPower(ctx types.Context) math.Int {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetLastTotalPower", ctx)
+	ret0, _ := ret[0].(math.Int)
+	return ret0
+}
+
+// GetLastTotalPower indicates an expected call of GetLastTotalPower.
+func (mr *MockStakingKeeperMockRecorder) GetLastTotalPower(ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetLastTotalPower", reflect.TypeOf((*MockStakingKeeper)(nil).GetLastTotalPower), ctx)
+}
+
+// GetLastValidatorPower mocks base method.
+func (m *MockStakingKeeper) GetLastValidatorPower(ctx types.Context, operator types.ValAddress) int64 {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetLastValidatorPower", ctx, operator)
+	ret0, _ := ret[0].(int64)
+	return ret0
+}
+
+// GetLastValidatorPower indicates an expected call of GetLastValidatorPower.
+func (mr *MockStakingKeeperMockRecorder) GetLastValidatorPower(ctx, operator interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetLastValidatorPower", reflect.TypeOf((*MockStakingKeeper)(nil).GetLastValidatorPower), ctx, operator)
+}
+
+// GetLastValidators mocks base method.
+func (m *MockStakingKeeper) GetLastValidators(ctx types.Context) []types0.Validator {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetLastValidators", ctx)
+	ret0, _ := ret[0].([]types0.Validator)
+	return ret0
+}
+
+// GetLastValidators indicates an expected call of GetLastValidators.
+func (mr *MockStakingKeeperMockRecorder) GetLastValidators(ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetLastValidators", reflect.TypeOf((*MockStakingKeeper)(nil).GetLastValidators), ctx)
+}
+
+// GetParams mocks base method.
+func (m *MockStakingKeeper) GetParams(ctx types.Context) types0.Params {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetParams", ctx)
+	ret0, _ := ret[0].(types0.Params)
+	return ret0
+}
+
+// GetParams indicates an expected call of GetParams.
+func (mr *MockStakingKeeperMockRecorder) GetParams(ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetParams", reflect.TypeOf((*MockStakingKeeper)(nil).GetParams), ctx)
+}
+
+// GetRedelegation mocks base method.
+func (m *MockStakingKeeper) GetRedelegation(ctx types.Context, delAddr types.AccAddress, valSrcAddr, valDstAddr types.ValAddress) (types0.Redelegation, bool) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetRedelegation", ctx, delAddr, valSrcAddr, valDstAddr)
+	ret0, _ := ret[0].(types0.Redelegation)
+	ret1, _ := ret[1].(bool)
+	return ret0, ret1
+}
+
+// GetRedelegation indicates an expected call of GetRedelegation.
+func (mr *MockStakingKeeperMockRecorder) GetRedelegation(ctx, delAddr, valSrcAddr, valDstAddr interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetRedelegation", reflect.TypeOf((*MockStakingKeeper)(nil).GetRedelegation), ctx, delAddr, valSrcAddr, valDstAddr)
+}
+
+// GetRedelegations mocks base method.
+func (m *MockStakingKeeper) GetRedelegations(ctx types.Context, delegator types.AccAddress, maxRetrieve uint16) []types0.Redelegation {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetRedelegations", ctx, delegator, maxRetrieve)
+	ret0, _ := ret[0].([]types0.Redelegation)
+	return ret0
+}
+
+// GetRedelegations indicates an expected call of GetRedelegations.
+func (mr *MockStakingKeeperMockRecorder) GetRedelegations(ctx, delegator, maxRetrieve interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetRedelegations", reflect.TypeOf((*MockStakingKeeper)(nil).GetRedelegations), ctx, delegator, maxRetrieve)
+}
+
+// GetUnbondingDelegation mocks base method.
+func (m *MockStakingKeeper) GetUnbondingDelegation(ctx types.Context, delAddr types.AccAddress, valAddr types.ValAddress) (types0.UnbondingDelegation, bool) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetUnbondingDelegation", ctx, delAddr, valAddr)
+	ret0, _ := ret[0].(types0.UnbondingDelegation)
+	ret1, _ := ret[1].(bool)
+	return ret0, ret1
+}
+
+// GetUnbondingDelegation indicates an expected call of GetUnbondingDelegation.
+func (mr *MockStakingKeeperMockRecorder) GetUnbondingDelegation(ctx, delAddr, valAddr interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUnbondingDelegation", reflect.TypeOf((*MockStakingKeeper)(nil).GetUnbondingDelegation), ctx, delAddr, valAddr)
+}
+
+// GetUnbondingDelegations mocks base method.
+func (m *MockStakingKeeper) GetUnbondingDelegations(ctx types.Context, delegator types.AccAddress, maxRetrieve uint16) []types0.UnbondingDelegation {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetUnbondingDelegations", ctx, delegator, maxRetrieve)
+	ret0, _ := ret[0].([]types0.UnbondingDelegation)
+	return ret0
+}
+
+// GetUnbondingDelegations indicates an expected call of GetUnbondingDelegations.
+func (mr *MockStakingKeeperMockRecorder) GetUnbondingDelegations(ctx, delegator, maxRetrieve interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUnbondingDelegations", reflect.TypeOf((*MockStakingKeeper)(nil).GetUnbondingDelegations), ctx, delegator, maxRetrieve)
+}
+
+// GetValidator mocks base method.
+func (m *MockStakingKeeper) GetValidator(ctx types.Context, addr types.ValAddress) (types0.Validator, bool) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetValidator", ctx, addr)
+	ret0, _ := ret[0].(types0.Validator)
+	ret1, _ := ret[1].(bool)
+	return ret0, ret1
+}
+
+// GetValidator indicates an expected call of GetValidator.
+func (mr *MockStakingKeeperMockRecorder) GetValidator(ctx, addr interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetValidator", reflect.TypeOf((*MockStakingKeeper)(nil).GetValidator), ctx, addr)
+}
+
+// GetValidatorByConsAddr mocks base method.
+func (m *MockStakingKeeper) GetValidatorByConsAddr(ctx types.Context, consAddr types.ConsAddress) (types0.Validator, bool) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetValidatorByConsAddr", ctx, consAddr)
+	ret0, _ := ret[0].(types0.Validator)
+	ret1, _ := ret[1].(bool)
+	return ret0, ret1
+}
+
+// GetValidatorByConsAddr indicates an expected call of GetValidatorByConsAddr.
+func (mr *MockStakingKeeperMockRecorder) GetValidatorByConsAddr(ctx, consAddr interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetValidatorByConsAddr", reflect.TypeOf((*MockStakingKeeper)(nil).GetValidatorByConsAddr), ctx, consAddr)
+}
+
+// GetValidatorDelegations mocks base method.
+func (m *MockStakingKeeper) GetValidatorDelegations(ctx types.Context, valAddr types.ValAddress) []types0.Delegation {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetValidatorDelegations", ctx, valAddr)
+	ret0, _ := ret[0].([]types0.Delegation)
+	return ret0
+}
+
+// GetValidatorDelegations indicates an expected call of GetValidatorDelegations.
+func (mr *MockStakingKeeperMockRecorder) GetValidatorDelegations(ctx, valAddr interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetValidatorDelegations", reflect.TypeOf((*MockStakingKeeper)(nil).GetValidatorDelegations), ctx, valAddr)
+}
+
+// IterateBondedValidatorsByPower mocks base method.
+func (m *MockStakingKeeper) IterateBondedValidatorsByPower(ctx types.Context, fn func(int64, types0.ValidatorI) bool) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "IterateBondedValidatorsByPower", ctx, fn)
+}
+
+// IterateBondedValidatorsByPower indicates an expected call of IterateBondedValidatorsByPower.
+func (mr *MockStakingKeeperMockRecorder) IterateBondedValidatorsByPower(ctx, fn interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IterateBondedValidatorsByPower", reflect.TypeOf((*MockStakingKeeper)(nil).IterateBondedValidatorsByPower), ctx, fn)
+}
+
 // IterateDelegations mocks base method.
-func (m *MockStakingKeeper) IterateDelegations(ctx types.Context, delegator types.AccAddress, fn func(int64, types0.DelegationI) bool) {
+func (m *MockStakingKeeper) IterateDelegations(ctx types.Context, delAddr types.AccAddress, fn func(int64, types0.DelegationI) bool) {
 	m.ctrl.T.Helper()
-	m.ctrl.Call(m, "IterateDelegations", ctx, delegator, fn)
+	m.ctrl.Call(m, "IterateDelegations", ctx, delAddr, fn)
 }
 
 // IterateDelegations indicates an expected call of IterateDelegations.
-func (mr *MockStakingKeeperMockRecorder) IterateDelegations(ctx, delegator, fn interface{}) *gomock.Call {
+func (mr *MockStakingKeeperMockRecorder) IterateDelegations(ctx, delAddr, fn interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IterateDelegations", reflect.TypeOf((*MockStakingKeeper)(nil).IterateDelegations), ctx, delAddr, fn)
+}
+
+// IterateDelegatorDelegations mocks base method.
+func (m *MockStakingKeeper) IterateDelegatorDelegations(ctx types.Context, delegator types.AccAddress, cb func(types0.Delegation) bool) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "IterateDelegatorDelegations", ctx, delegator, cb)
+}
+
+// IterateDelegatorDelegations indicates an expected call of IterateDelegatorDelegations.
+func (mr *MockStakingKeeperMockRecorder) IterateDelegatorDelegations(ctx, delegator, cb interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IterateDelegations", reflect.TypeOf((*MockStakingKeeper)(nil).IterateDelegations), ctx, delegator, fn)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IterateDelegatorDelegations", reflect.TypeOf((*MockStakingKeeper)(nil).IterateDelegatorDelegations), ctx, delegator, cb)
+}
+
+// IterateLastValidators mocks base method.
+func (m *MockStakingKeeper) IterateLastValidators(ctx types.Context, fn func(int64, types0.ValidatorI) bool) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "IterateLastValidators", ctx, fn)
+}
+
+// IterateLastValidators indicates an expected call of IterateLastValidators.
+func (mr *MockStakingKeeperMockRecorder) IterateLastValidators(ctx, fn interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IterateLastValidators", reflect.TypeOf((*MockStakingKeeper)(nil).IterateLastValidators), ctx, fn)
 }
 
 // IterateValidators mocks base method.
-func (m *MockStakingKeeper) IterateValidators(arg0 types.Context, arg1 func(int64, types0.ValidatorI) bool) {
+func (m *MockStakingKeeper) IterateValidators(ctx types.Context, fn func(int64, types0.ValidatorI) bool) {
 	m.ctrl.T.Helper()
-	m.ctrl.Call(m, "IterateValidators", arg0, arg1)
+	m.ctrl.Call(m, "IterateValidators", ctx, fn)
 }
 
 // IterateValidators indicates an expected call of IterateValidators.
-func (mr *MockStakingKeeperMockRecorder) IterateValidators(arg0, arg1 interface{}) *gomock.Call {
+func (mr *MockStakingKeeperMockRecorder) IterateValidators(ctx, fn interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IterateValidators", reflect.TypeOf((*MockStakingKeeper)(nil).IterateValidators), arg0, arg1)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IterateValidators", reflect.TypeOf((*MockStakingKeeper)(nil).IterateValidators), ctx, fn)
+}
+
+// PowerReduction mocks base method.
+func (m *MockStakingKeeper) PowerReduction(ctx types.Context) math.Int {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PowerReduction", ctx)
+	ret0, _ := ret[0].(math.Int)
+	return ret0
+}
+
+// PowerReduction indicates an expected call of PowerReduction.
+func (mr *MockStakingKeeperMockRecorder) PowerReduction(ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PowerReduction", reflect.TypeOf((*MockStakingKeeper)(nil).PowerReduction), ctx)
+}
+
+// TotalBondedTokens mocks base method.
+func (m *MockStakingKeeper) TotalBondedTokens(ctx types.Context) math.Int {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "TotalBondedTokens", ctx)
+	ret0, _ := ret[0].(math.Int)
+	return ret0
+}
+
+// TotalBondedTokens indicates an expected call of TotalBondedTokens.
+func (mr *MockStakingKeeperMockRecorder) TotalBondedTokens(ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "TotalBondedTokens", reflect.TypeOf((*MockStakingKeeper)(nil).TotalBondedTokens), ctx)
+}
+
+// UnbondingTime mocks base method.
+func (m *MockStakingKeeper) UnbondingTime(ctx types.Context) time.Duration {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UnbondingTime", ctx)
+	ret0, _ := ret[0].(time.Duration)
+	return ret0
+}
+
+// UnbondingTime indicates an expected call of UnbondingTime.
+func (mr *MockStakingKeeperMockRecorder) UnbondingTime(ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UnbondingTime", reflect.TypeOf((*MockStakingKeeper)(nil).UnbondingTime), ctx)
 }
 
 // Validator mocks base method.
-func (m *MockStakingKeeper) Validator(arg0 types.Context, arg1 types.ValAddress) types0.ValidatorI {
+func (m *MockStakingKeeper) Validator(ctx types.Context, addr types.ValAddress) types0.ValidatorI {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "Validator", arg0, arg1)
+	ret := m.ctrl.Call(m, "Validator", ctx, addr)
 	ret0, _ := ret[0].(types0.ValidatorI)
 	return ret0
 }
 
 // Validator indicates an expected call of Validator.
-func (mr *MockStakingKeeperMockRecorder) Validator(arg0, arg1 interface{}) *gomock.Call {
+func (mr *MockStakingKeeperMockRecorder) Validator(ctx, addr interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Validator", reflect.TypeOf((*MockStakingKeeper)(nil).Validator), arg0, arg1)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Validator", reflect.TypeOf((*MockStakingKeeper)(nil).Validator), ctx, addr)
 }
 
 // ValidatorByConsAddr mocks base method.
-func (m *MockStakingKeeper) ValidatorByConsAddr(arg0 types.Context, arg1 types.ConsAddress) types0.ValidatorI {
+func (m *MockStakingKeeper) ValidatorByConsAddr(ctx types.Context, consAddr types.ConsAddress) types0.ValidatorI {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "ValidatorByConsAddr", arg0, arg1)
+	ret := m.ctrl.Call(m, "ValidatorByConsAddr", ctx, consAddr)
 	ret0, _ := ret[0].(types0.ValidatorI)
 	return ret0
 }
 
 // ValidatorByConsAddr indicates an expected call of ValidatorByConsAddr.
-func (mr *MockStakingKeeperMockRecorder) ValidatorByConsAddr(arg0, arg1 interface{}) *gomock.Call {
+func (mr *MockStakingKeeperMockRecorder) ValidatorByConsAddr(ctx, consAddr interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ValidatorByConsAddr", reflect.TypeOf((*MockStakingKeeper)(nil).ValidatorByConsAddr), ctx, consAddr)
+}
+
+// ValidatorUnbondingTime mocks base method.
+func (m *MockStakingKeeper) ValidatorUnbondingTime(ctx types.Context, valAddr types.ValAddress) time.Duration {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ValidatorUnbondingTime", ctx, valAddr)
+	ret0, _ := ret[0].(time.Duration)
+	return ret0
+}
+
+// ValidatorUnbondingTime indicates an expected call of ValidatorUnbondingTime.
+func (mr *MockStakingKeeperMockRecorder) ValidatorUnbondingTime(ctx, valAddr interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ValidatorByConsAddr", reflect.TypeOf((*MockStakingKeeper)(nil).ValidatorByConsAddr), arg0, arg1)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ValidatorUnbondingTime", reflect.TypeOf((*MockStakingKeeper)(nil).ValidatorUnbondingTime), ctx, valAddr)
 }
 
 // MockStakingHooks is a mock of StakingHooks interface.
@@ -24,6 +24,9 @@ func RegisterLegacyAminoCodec(cdc *codec.LegacyAmino) {
 	legacy.RegisterAminoMsg(cdc, &MsgUpdateParams{}, "cosmos-sdk/distribution/MsgUpdateParams")
 	legacy.RegisterAminoMsg(cdc, &MsgCommunityPoolSpend{}, "cosmos-sdk/distr/MsgCommunityPoolSpend")
 	legacy.RegisterAminoMsg(cdc, &MsgDepositValidatorRewardsPool{}, "cosmos-sdk/distr/MsgDepositValRewards")
+	legacy.RegisterAminoMsg(cdc, &MsgSetAutoCompound{}, "cosmos-sdk/distr/MsgSetAutoCompound")
+	legacy.RegisterAminoMsg(cdc, &MsgWithdrawAndDelegate{}, "cosmos-sdk/distr/MsgWithdrawAndDelegate")
+	legacy.RegisterAminoMsg(cdc, &MsgSetRewardsDestination{}, "cosmos-sdk/distr/MsgSetRewardsDestination")
 
 	cdc.RegisterConcrete(Params{}, "cosmos-sdk/x/distribution/Params", nil)
 }
@@ -38,6 +41,9 @@ func RegisterInterfaces(registry types.InterfaceRegistry) {
 		&MsgUpdateParams{},
 		&MsgCommunityPoolSpend{},
 		&MsgDepositValidatorRewardsPool{},
+		&MsgSetAutoCompound{},
+		&MsgWithdrawAndDelegate{},
+		&MsgSetRewardsDestination{},
 	)
 
 	registry.RegisterImplementations(
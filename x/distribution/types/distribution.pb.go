@@ -27,6 +27,40 @@ var _ = math.Inf
 // proto package needs to be updated.
 const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
+// RewardsDestination defines where the staking rewards of a delegator are
+// credited when withdrawn to its account.
+//
+// Since: cosmos-sdk 0.48
+type RewardsDestination int32
+
+const (
+	// REWARDS_DESTINATION_SPENDABLE defines the rewards being added to the
+	// spendable balance of the delegator.
+	RewardsDestinationSpendable RewardsDestination = 0
+	// REWARDS_DESTINATION_VESTING defines the rewards being added to the
+	// original vesting of the delegator vesting account, vesting along its
+	// vesting schedule.
+	RewardsDestinationVesting RewardsDestination = 1
+)
+
+var RewardsDestination_name = map[int32]string{
+	0: "REWARDS_DESTINATION_SPENDABLE",
+	1: "REWARDS_DESTINATION_VESTING",
+}
+
+var RewardsDestination_value = map[string]int32{
+	"REWARDS_DESTINATION_SPENDABLE": 0,
+	"REWARDS_DESTINATION_VESTING":   1,
+}
+
+func (x RewardsDestination) String() string {
+	return proto.EnumName(RewardsDestination_name, int32(x))
+}
+
+func (RewardsDestination) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_cd78a31ea281a992, []int{0}
+}
+
 // Params defines the set of params for the distribution module.
 type Params struct {
 	CommunityTax github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,1,opt,name=community_tax,json=communityTax,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"community_tax"`
@@ -37,6 +71,13 @@ type Params struct {
 	// in the x/distribution module's reward mechanism.
 	BonusProposerReward github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,3,opt,name=bonus_proposer_reward,json=bonusProposerReward,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"bonus_proposer_reward"` // Deprecated: Do not use.
 	WithdrawAddrEnabled bool                                   `protobuf:"varint,4,opt,name=withdraw_addr_enabled,json=withdrawAddrEnabled,proto3" json:"withdraw_addr_enabled,omitempty"`
+	// auto_compound_interval is the number of blocks between two passes
+	// restaking the rewards of the delegations opted in to auto-compounding.
+	// Zero disables auto-compounding.
+	AutoCompoundInterval uint64 `protobuf:"varint,5,opt,name=auto_compound_interval,json=autoCompoundInterval,proto3" json:"auto_compound_interval,omitempty"`
+	// auto_compound_batch_size is the maximum number of delegations processed
+	// per block during an auto-compounding pass. Zero means no limit.
+	AutoCompoundBatchSize uint32 `protobuf:"varint,6,opt,name=auto_compound_batch_size,json=autoCompoundBatchSize,proto3" json:"auto_compound_batch_size,omitempty"`
 }
 
 func (m *Params) Reset()         { *m = Params{} }
@@ -79,6 +120,20 @@ func (m *Params) GetWithdrawAddrEnabled() bool {
 	return false
 }
 
+func (m *Params) GetAutoCompoundInterval() uint64 {
+	if m != nil {
+		return m.AutoCompoundInterval
+	}
+	return 0
+}
+
+func (m *Params) GetAutoCompoundBatchSize() uint32 {
+	if m != nil {
+		return m.AutoCompoundBatchSize
+	}
+	return 0
+}
+
 // ValidatorHistoricalRewards represents historical rewards for a validator.
 // Height is implicit within the store key.
 // Cumulative reward ratio is the sum from the zeroeth period
@@ -623,6 +678,7 @@ func (m *CommunityPoolSpendProposalWithDeposit) XXX_DiscardUnknown() {
 var xxx_messageInfo_CommunityPoolSpendProposalWithDeposit proto.InternalMessageInfo
 
 func init() {
+	proto.RegisterEnum("cosmos.distribution.v1beta1.RewardsDestination", RewardsDestination_name, RewardsDestination_value)
 	proto.RegisterType((*Params)(nil), "cosmos.distribution.v1beta1.Params")
 	proto.RegisterType((*ValidatorHistoricalRewards)(nil), "cosmos.distribution.v1beta1.ValidatorHistoricalRewards")
 	proto.RegisterType((*ValidatorCurrentRewards)(nil), "cosmos.distribution.v1beta1.ValidatorCurrentRewards")
@@ -642,70 +698,80 @@ func init() {
 }
 
 var fileDescriptor_cd78a31ea281a992 = []byte{
-	// 1006 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xc4, 0x56, 0xcf, 0x6f, 0x1b, 0x45,
-	0x14, 0xf6, 0x34, 0x89, 0xd3, 0x4c, 0xdb, 0x84, 0x4e, 0x7e, 0xd4, 0x71, 0x8b, 0x1d, 0x56, 0x2a,
-	0x84, 0x40, 0x6c, 0x52, 0x2e, 0x28, 0xe2, 0x12, 0xdb, 0xad, 0xe0, 0x42, 0xa3, 0x0d, 0xa2, 0x88,
-	0xcb, 0x6a, 0xbc, 0x3b, 0xb1, 0x47, 0xdd, 0x9d, 0x59, 0x66, 0x66, 0x9d, 0xe4, 0xc0, 0x3d, 0x70,
-	0x00, 0x6e, 0xa0, 0x9e, 0x2a, 0xb8, 0x54, 0x9c, 0x72, 0xc8, 0x8d, 0x7f, 0xa0, 0xe2, 0x54, 0x15,
-	0x09, 0x21, 0x0e, 0x29, 0x24, 0x87, 0x20, 0xfe, 0x0a, 0x34, 0x3b, 0xe3, 0x5d, 0x27, 0x84, 0x52,
-	0xa9, 0xb5, 0xb8, 0x24, 0x9e, 0xef, 0xcd, 0xbe, 0xef, 0x7d, 0xef, 0xbd, 0x79, 0x33, 0xb0, 0xe6,
-	0x73, 0x19, 0x71, 0x59, 0x0f, 0xa8, 0x54, 0x82, 0xb6, 0x13, 0x45, 0x39, 0xab, 0xf7, 0x56, 0xda,
-	0x44, 0xe1, 0x95, 0x13, 0x60, 0x2d, 0x16, 0x5c, 0x71, 0x74, 0xd5, 0xec, 0xaf, 0x9d, 0x30, 0xd9,
-	0xfd, 0xe5, 0x99, 0x0e, 0xef, 0xf0, 0x74, 0x5f, 0x5d, 0xff, 0x32, 0x9f, 0x94, 0x2b, 0x96, 0xa2,
-	0x8d, 0x25, 0xc9, 0x5c, 0xfb, 0x9c, 0x5a, 0x97, 0xe5, 0x79, 0x63, 0xf7, 0xcc, 0x87, 0xd6, 0xbf,
-	0x31, 0x5d, 0xc6, 0x11, 0x65, 0xbc, 0x9e, 0xfe, 0x35, 0x90, 0xf3, 0xe3, 0x08, 0x2c, 0xae, 0x63,
-	0x81, 0x23, 0x89, 0x36, 0xe1, 0x25, 0x9f, 0x47, 0x51, 0xc2, 0xa8, 0xda, 0xf1, 0x14, 0xde, 0x2e,
-	0x81, 0x05, 0xb0, 0x38, 0xd1, 0x58, 0x7b, 0x78, 0x50, 0x2d, 0xfc, 0x76, 0x50, 0x7d, 0xb5, 0x43,
-	0x55, 0x37, 0x69, 0xd7, 0x7c, 0x1e, 0x59, 0xaf, 0xf6, 0xdf, 0xb2, 0x0c, 0xee, 0xd6, 0xd5, 0x4e,
-	0x4c, 0x64, 0xad, 0x45, 0xfc, 0xc7, 0xfb, 0xcb, 0xd0, 0x92, 0xb6, 0x88, 0xff, 0xe0, 0x78, 0x6f,
-	0x09, 0xb8, 0x17, 0x33, 0xbf, 0x1f, 0xe2, 0x6d, 0x94, 0xc0, 0x19, 0x1d, 0xbb, 0x0e, 0x30, 0xe6,
-	0x92, 0x08, 0x4f, 0x90, 0x2d, 0x2c, 0x82, 0xd2, 0xb9, 0x94, 0xae, 0xf9, 0xdc, 0x74, 0x25, 0xe0,
-	0x22, 0x4d, 0xb0, 0x6e, 0xfd, 0xbb, 0xa9, 0x7b, 0xb4, 0x05, 0x67, 0xdb, 0x9c, 0x25, 0xf2, 0x1f,
-	0xbc, 0x23, 0x2f, 0x8e, 0x77, 0x3a, 0x65, 0x38, 0x45, 0x7c, 0x03, 0xce, 0x6e, 0x51, 0xd5, 0x0d,
-	0x04, 0xde, 0xf2, 0x70, 0x10, 0x08, 0x8f, 0x30, 0xdc, 0x0e, 0x49, 0x50, 0x1a, 0x5d, 0x00, 0x8b,
-	0xe7, 0xdd, 0xe9, 0xbe, 0x71, 0x2d, 0x08, 0xc4, 0x4d, 0x63, 0x5a, 0xbd, 0xfe, 0xc5, 0xf1, 0xde,
-	0xd2, 0xc2, 0x00, 0xf1, 0xf6, 0xc9, 0x96, 0x32, 0x25, 0x73, 0x7e, 0x01, 0xb0, 0xfc, 0x11, 0x0e,
-	0x69, 0x80, 0x15, 0x17, 0xef, 0x51, 0xa9, 0xb8, 0xa0, 0x3e, 0x0e, 0x0d, 0xb1, 0x44, 0x5f, 0x02,
-	0x78, 0xc5, 0x4f, 0xa2, 0x24, 0xc4, 0x8a, 0xf6, 0x88, 0xd5, 0xeb, 0x09, 0xac, 0x28, 0x2f, 0x81,
-	0x85, 0x91, 0xc5, 0x0b, 0x37, 0xae, 0xd9, 0x86, 0xad, 0xe9, 0x84, 0xf5, 0x1b, 0x4f, 0x2b, 0x6a,
-	0x72, 0xca, 0x1a, 0xef, 0xe8, 0x9c, 0xfc, 0xf0, 0xa4, 0xfa, 0xc6, 0xb3, 0xe5, 0x44, 0x7f, 0x23,
-	0x4d, 0xc5, 0x67, 0x73, 0x5a, 0x13, 0x8c, 0xab, 0x49, 0xd1, 0x6b, 0x70, 0x4a, 0x90, 0x4d, 0x22,
-	0x08, 0xf3, 0x89, 0xe7, 0xf3, 0x84, 0xa9, 0xb4, 0xea, 0x97, 0xdc, 0xc9, 0x0c, 0x6e, 0x6a, 0xd4,
-	0xf9, 0x1e, 0xc0, 0x2b, 0x99, 0xb0, 0x66, 0x22, 0x04, 0x61, 0xaa, 0xaf, 0x2a, 0x86, 0xe3, 0x46,
-	0x89, 0x1c, 0xb2, 0x88, 0x3e, 0x0d, 0x9a, 0x83, 0xc5, 0x98, 0x08, 0xca, 0x4d, 0x8f, 0x8e, 0xba,
-	0x76, 0xe5, 0x7c, 0x0b, 0x60, 0x25, 0x8b, 0x72, 0xcd, 0xb7, 0x9a, 0x49, 0xd0, 0xe4, 0x51, 0x44,
-	0xa5, 0xa4, 0x9c, 0xa1, 0x1e, 0x84, 0x7e, 0xb6, 0x1a, 0x72, 0xbc, 0x03, 0x4c, 0xce, 0x57, 0x00,
-	0x5e, 0xcd, 0x42, 0xbb, 0x9d, 0x28, 0xa9, 0x30, 0x0b, 0x28, 0xeb, 0xfc, 0x6f, 0x49, 0x74, 0xee,
-	0x01, 0x38, 0x9d, 0x45, 0xb4, 0x11, 0x62, 0xd9, 0xbd, 0xd9, 0x23, 0x4c, 0xa1, 0xd7, 0xe1, 0x4b,
-	0xbd, 0x3e, 0xec, 0xd9, 0x34, 0x83, 0x34, 0xcd, 0x53, 0x19, 0xbe, 0x9e, 0xc2, 0xe8, 0x63, 0x78,
-	0x7e, 0x53, 0x60, 0x5f, 0x9f, 0x00, 0x3b, 0x2d, 0xde, 0x7d, 0x9e, 0x53, 0xeb, 0x66, 0xde, 0x9c,
-	0xcf, 0x01, 0x9c, 0x39, 0x23, 0x38, 0x89, 0x3e, 0x85, 0x73, 0x79, 0x74, 0x52, 0x1b, 0x3c, 0x92,
-	0x5a, 0x6c, 0xda, 0xde, 0xaa, 0x3d, 0x65, 0x82, 0xd7, 0xce, 0x70, 0xd9, 0x98, 0xd0, 0x21, 0x9b,
-	0xdc, 0xcc, 0xf4, 0xce, 0xa0, 0x74, 0x76, 0x01, 0x1c, 0xbf, 0x45, 0xc8, 0x3a, 0xe7, 0x21, 0xfa,
-	0x0c, 0x4e, 0xe6, 0x33, 0x39, 0xe6, 0x3c, 0x1c, 0x72, 0xb5, 0xf2, 0x1b, 0x40, 0xd3, 0x3b, 0xdf,
-	0x9c, 0x83, 0xe5, 0xe6, 0x20, 0xb2, 0x11, 0x13, 0x16, 0x98, 0xf9, 0x86, 0x43, 0x34, 0x03, 0xc7,
-	0x14, 0x55, 0x21, 0x31, 0x37, 0x85, 0x6b, 0x16, 0x68, 0x01, 0x5e, 0x08, 0x88, 0xf4, 0x05, 0x8d,
-	0xf3, 0x42, 0xb9, 0x83, 0x10, 0xba, 0x06, 0x27, 0x04, 0xf1, 0x69, 0x4c, 0x09, 0x53, 0x66, 0xfc,
-	0xba, 0x39, 0x80, 0x76, 0x60, 0x11, 0x47, 0xe9, 0x6c, 0x18, 0x4d, 0xb5, 0xce, 0x9f, 0xa9, 0x35,
-	0x15, 0x7a, 0xcb, 0x0a, 0x5d, 0x7c, 0x06, 0xa1, 0xa9, 0xca, 0x7b, 0xc7, 0x7b, 0x4b, 0x17, 0x43,
-	0xd2, 0xc1, 0xfe, 0x8e, 0xe7, 0xe7, 0xb2, 0x2d, 0xe1, 0xea, 0xe2, 0xee, 0xfd, 0x6a, 0xe1, 0xcf,
-	0xfb, 0xd5, 0xc2, 0x4f, 0xfb, 0xcb, 0x65, 0xcb, 0xda, 0xe1, 0xbd, 0x01, 0x52, 0xa6, 0x74, 0xcc,
-	0xc0, 0x79, 0x02, 0xe0, 0x6c, 0x8b, 0x68, 0x4f, 0xba, 0x7a, 0x0a, 0x0b, 0x45, 0x59, 0xe7, 0x7d,
-	0xb6, 0x99, 0xce, 0xb8, 0x58, 0x90, 0x1e, 0xe5, 0xfa, 0xaa, 0x19, 0x6c, 0xe7, 0xc9, 0x3e, 0x6c,
-	0xbb, 0xf9, 0x0e, 0x1c, 0x93, 0x0a, 0xdf, 0x25, 0xb6, 0x95, 0x5f, 0xc0, 0x3d, 0x6b, 0xfc, 0xa1,
-	0x16, 0x2c, 0x76, 0x09, 0xed, 0x74, 0x4d, 0x6e, 0x47, 0x1b, 0x6f, 0xfe, 0x75, 0x50, 0x9d, 0xf2,
-	0x05, 0xd1, 0x23, 0x98, 0x79, 0xc6, 0xf4, 0xdd, 0xf1, 0xde, 0xd2, 0x69, 0xcc, 0xe6, 0xc2, 0x2c,
-	0x9c, 0x3f, 0x00, 0x9c, 0xb7, 0x0a, 0x29, 0x67, 0x99, 0x56, 0x7b, 0xa9, 0x7d, 0x00, 0x2f, 0xe7,
-	0xe7, 0x42, 0xdf, 0x6a, 0x44, 0x4a, 0xfb, 0x60, 0x78, 0xe5, 0xf1, 0xfe, 0xf2, 0xcb, 0x36, 0xb4,
-	0x7c, 0x3a, 0x9a, 0x2d, 0x1b, 0x4a, 0xe8, 0x21, 0x94, 0x9f, 0x78, 0x8b, 0x23, 0x06, 0x8b, 0xd9,
-	0x33, 0x60, 0x98, 0x0d, 0x6e, 0x59, 0x56, 0x47, 0x75, 0xa5, 0x9d, 0x9f, 0x01, 0xbc, 0xfe, 0xef,
-	0xfd, 0x7d, 0x87, 0xaa, 0x6e, 0x8b, 0xc4, 0x5c, 0x52, 0x35, 0xa4, 0x56, 0x9f, 0x1b, 0x68, 0x75,
-	0x6d, 0xb2, 0x2b, 0x54, 0x82, 0xe3, 0x81, 0x21, 0x2e, 0x8d, 0xa5, 0x86, 0xfe, 0x72, 0xd5, 0xd9,
-	0xfd, 0xcf, 0xee, 0x6c, 0xdc, 0x7e, 0x70, 0x58, 0x01, 0x0f, 0x0f, 0x2b, 0xe0, 0xd1, 0x61, 0x05,
-	0xfc, 0x7e, 0x58, 0x01, 0x5f, 0x1f, 0x55, 0x0a, 0x8f, 0x8e, 0x2a, 0x85, 0x5f, 0x8f, 0x2a, 0x85,
-	0x4f, 0x56, 0x9e, 0x9a, 0xb3, 0x53, 0xef, 0x8c, 0x34, 0x85, 0xed, 0x62, 0xfa, 0x56, 0x7c, 0xfb,
-	0xef, 0x00, 0x00, 0x00, 0xff, 0xff, 0x2f, 0xcc, 0x9a, 0x24, 0xde, 0x0a, 0x00, 0x00,
+	// 1158 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xc4, 0x56, 0xcf, 0x6f, 0x13, 0xc7,
+	0x17, 0xf7, 0x80, 0x31, 0x64, 0xf8, 0x15, 0x86, 0x24, 0x38, 0x0e, 0xd8, 0xfe, 0xae, 0xc4, 0xb7,
+	0x6e, 0xda, 0x38, 0x85, 0x56, 0x6a, 0x15, 0x55, 0x95, 0xfc, 0x8b, 0x36, 0x52, 0x15, 0xa2, 0x35,
+	0x82, 0xaa, 0x97, 0xd5, 0x78, 0x77, 0x62, 0x8f, 0xb0, 0x67, 0xb6, 0x33, 0xb3, 0x0e, 0x41, 0xea,
+	0x3d, 0xe5, 0xd0, 0xf6, 0xd6, 0x0a, 0x89, 0x0a, 0x95, 0x0b, 0xe2, 0x94, 0x03, 0x7f, 0x04, 0xea,
+	0x09, 0x51, 0xa9, 0xaa, 0x7a, 0x80, 0x36, 0x1c, 0x52, 0xf5, 0xaf, 0xa8, 0x66, 0x67, 0xbc, 0x76,
+	0x7e, 0x94, 0x22, 0x41, 0xd4, 0x8b, 0xbd, 0xf3, 0xde, 0xcc, 0xfb, 0xbc, 0xcf, 0x7b, 0x6f, 0xde,
+	0x1b, 0x58, 0xf6, 0xb9, 0xec, 0x71, 0x39, 0x1f, 0x50, 0xa9, 0x04, 0x6d, 0x45, 0x8a, 0x72, 0x36,
+	0xdf, 0xbf, 0xd0, 0x22, 0x0a, 0x5f, 0xd8, 0x26, 0x2c, 0x87, 0x82, 0x2b, 0x8e, 0x66, 0xcc, 0xfe,
+	0xf2, 0x36, 0x95, 0xdd, 0x9f, 0x9b, 0x68, 0xf3, 0x36, 0x8f, 0xf7, 0xcd, 0xeb, 0x2f, 0x73, 0x24,
+	0x97, 0xb7, 0x10, 0x2d, 0x2c, 0x49, 0x62, 0xda, 0xe7, 0xd4, 0x9a, 0xcc, 0x4d, 0x1b, 0xbd, 0x67,
+	0x0e, 0x5a, 0xfb, 0x46, 0x75, 0x0a, 0xf7, 0x28, 0xe3, 0xf3, 0xf1, 0xaf, 0x11, 0x39, 0x0f, 0xd2,
+	0x30, 0xb3, 0x8c, 0x05, 0xee, 0x49, 0xb4, 0x02, 0x8f, 0xfb, 0xbc, 0xd7, 0x8b, 0x18, 0x55, 0x6b,
+	0x9e, 0xc2, 0x37, 0xb2, 0xa0, 0x08, 0x4a, 0x63, 0xd5, 0xca, 0xa3, 0xa7, 0x85, 0xd4, 0x6f, 0x4f,
+	0x0b, 0xff, 0x6f, 0x53, 0xd5, 0x89, 0x5a, 0x65, 0x9f, 0xf7, 0xac, 0x55, 0xfb, 0x37, 0x27, 0x83,
+	0xeb, 0xf3, 0x6a, 0x2d, 0x24, 0xb2, 0x5c, 0x27, 0xfe, 0x93, 0x87, 0x73, 0xd0, 0x82, 0xd6, 0x89,
+	0x7f, 0x7f, 0x6b, 0x63, 0x16, 0xb8, 0xc7, 0x12, 0xbb, 0x57, 0xf0, 0x0d, 0x14, 0xc1, 0x09, 0xed,
+	0xbb, 0x76, 0x30, 0xe4, 0x92, 0x08, 0x4f, 0x90, 0x55, 0x2c, 0x82, 0xec, 0x81, 0x18, 0xae, 0xf6,
+	0xca, 0x70, 0x59, 0xe0, 0x22, 0x0d, 0xb0, 0x6c, 0xed, 0xbb, 0xb1, 0x79, 0xb4, 0x0a, 0x27, 0x5b,
+	0x9c, 0x45, 0x72, 0x17, 0xee, 0xc1, 0xd7, 0x87, 0x7b, 0x3a, 0x46, 0xd8, 0x01, 0x7c, 0x11, 0x4e,
+	0xae, 0x52, 0xd5, 0x09, 0x04, 0x5e, 0xf5, 0x70, 0x10, 0x08, 0x8f, 0x30, 0xdc, 0xea, 0x92, 0x20,
+	0x9b, 0x2e, 0x82, 0xd2, 0x11, 0xf7, 0xf4, 0x40, 0x59, 0x09, 0x02, 0xd1, 0x30, 0x2a, 0xf4, 0x1e,
+	0x9c, 0xc2, 0x91, 0xe2, 0x9e, 0xcf, 0x7b, 0x21, 0x8f, 0x58, 0xe0, 0x51, 0xa6, 0x88, 0xe8, 0xe3,
+	0x6e, 0xf6, 0x50, 0x11, 0x94, 0xd2, 0xee, 0x84, 0xd6, 0xd6, 0xac, 0x72, 0xd1, 0xea, 0xd0, 0xfb,
+	0x30, 0xbb, 0xfd, 0x54, 0x0b, 0x2b, 0xbf, 0xe3, 0x49, 0x7a, 0x93, 0x64, 0x33, 0x45, 0x50, 0x3a,
+	0xee, 0x4e, 0x8e, 0x9e, 0xab, 0x6a, 0x6d, 0x93, 0xde, 0x24, 0x0b, 0xe7, 0x6f, 0x6d, 0x6d, 0xcc,
+	0x16, 0x47, 0x78, 0xde, 0xd8, 0x5e, 0xc1, 0xa6, 0x42, 0x9c, 0x5f, 0x00, 0xcc, 0x5d, 0xc5, 0x5d,
+	0x1a, 0x60, 0xc5, 0xc5, 0x27, 0x54, 0x2a, 0x2e, 0xa8, 0x8f, 0xbb, 0x86, 0xa7, 0x44, 0x5f, 0x03,
+	0x78, 0xc6, 0x8f, 0x7a, 0x51, 0x17, 0x2b, 0xda, 0x27, 0x36, 0xbc, 0x9e, 0xc0, 0x8a, 0xf2, 0x2c,
+	0x28, 0x1e, 0x2c, 0x1d, 0xbd, 0x78, 0xd6, 0xde, 0x8f, 0xb2, 0xce, 0xcf, 0xa0, 0xce, 0x75, 0x00,
+	0x6b, 0x9c, 0xb2, 0xea, 0x07, 0x3a, 0x05, 0x0f, 0x9e, 0x15, 0xde, 0x7a, 0xb9, 0x14, 0xe8, 0x33,
+	0xd2, 0x14, 0xd8, 0xe4, 0x10, 0xd6, 0x38, 0xe3, 0x6a, 0x50, 0xf4, 0x06, 0x3c, 0x29, 0xc8, 0x0a,
+	0x11, 0x84, 0xf9, 0xc4, 0xf3, 0x79, 0xc4, 0x54, 0x5c, 0x64, 0xc7, 0xdd, 0x13, 0x89, 0xb8, 0xa6,
+	0xa5, 0xce, 0x3d, 0x00, 0xcf, 0x24, 0xc4, 0x6a, 0x91, 0x10, 0x84, 0xa9, 0x01, 0xab, 0x10, 0x1e,
+	0x36, 0x4c, 0xe4, 0x3e, 0x93, 0x18, 0xc0, 0xa0, 0x29, 0x98, 0x09, 0x89, 0xa0, 0xdc, 0x5c, 0x89,
+	0xb4, 0x6b, 0x57, 0xce, 0xf7, 0x00, 0xe6, 0x13, 0x2f, 0x2b, 0xbe, 0xe5, 0x4c, 0x82, 0x1a, 0xef,
+	0xf5, 0xa8, 0x94, 0x94, 0x33, 0xd4, 0x87, 0xd0, 0x4f, 0x56, 0xfb, 0xec, 0xef, 0x08, 0x92, 0xf3,
+	0x0d, 0x80, 0x33, 0x89, 0x6b, 0x97, 0x23, 0x25, 0x15, 0x66, 0x01, 0x65, 0xed, 0xff, 0x2c, 0x88,
+	0xce, 0x6d, 0x00, 0x4f, 0x27, 0x1e, 0x35, 0xbb, 0x58, 0x76, 0x1a, 0x7d, 0xc2, 0x14, 0x7a, 0x13,
+	0x8e, 0xf7, 0x07, 0x62, 0xcf, 0x86, 0x19, 0xc4, 0x61, 0x3e, 0x99, 0xc8, 0x97, 0x63, 0x31, 0xfa,
+	0x0c, 0x1e, 0x59, 0x11, 0xd8, 0xd7, 0x37, 0xc0, 0x36, 0xa7, 0x0f, 0x5f, 0xa5, 0x49, 0xb8, 0x89,
+	0x35, 0xe7, 0x2b, 0x00, 0x27, 0xf6, 0x70, 0x4e, 0xa2, 0x2f, 0xe0, 0xd4, 0xd0, 0x3b, 0xa9, 0x15,
+	0x1e, 0x89, 0x35, 0x36, 0x6c, 0xef, 0x94, 0x5f, 0x30, 0x30, 0xca, 0x7b, 0x98, 0xac, 0x8e, 0x69,
+	0x97, 0x4d, 0x6c, 0x26, 0xfa, 0x7b, 0x40, 0x3a, 0xeb, 0x00, 0x1e, 0xbe, 0x44, 0xc8, 0x32, 0xe7,
+	0x5d, 0xf4, 0x25, 0x3c, 0x31, 0x1c, 0x01, 0x21, 0xe7, 0xdd, 0x7d, 0xce, 0xd6, 0x70, 0xe0, 0x68,
+	0x78, 0xe7, 0xbb, 0x03, 0x30, 0x57, 0x1b, 0x95, 0x34, 0x43, 0xc2, 0x02, 0xd3, 0x4e, 0x71, 0x17,
+	0x4d, 0xc0, 0x43, 0x8a, 0xaa, 0x2e, 0x31, 0x83, 0xc9, 0x35, 0x0b, 0x54, 0x84, 0x47, 0x03, 0x22,
+	0x7d, 0x41, 0xc3, 0x61, 0xa2, 0xdc, 0x51, 0x11, 0x3a, 0x0b, 0xc7, 0x04, 0xf1, 0x69, 0x48, 0x09,
+	0x53, 0xa6, 0xdb, 0xbb, 0x43, 0x01, 0x5a, 0x83, 0x19, 0xdc, 0x8b, 0x7b, 0x43, 0x3a, 0xe6, 0x3a,
+	0xbd, 0x27, 0xd7, 0x98, 0xe8, 0x25, 0x4b, 0xb4, 0xf4, 0x12, 0x44, 0x63, 0x96, 0xb7, 0xb7, 0x36,
+	0x66, 0x8f, 0x75, 0x49, 0x1b, 0xfb, 0x6b, 0x9e, 0x3f, 0xa4, 0x6d, 0x01, 0x17, 0x4a, 0xeb, 0x77,
+	0x0b, 0xa9, 0x3f, 0xef, 0x16, 0x52, 0x3f, 0x3d, 0x9c, 0xcb, 0x59, 0xd4, 0x36, 0xef, 0x8f, 0x80,
+	0x32, 0xa5, 0x7d, 0x06, 0xce, 0x33, 0x00, 0x27, 0xeb, 0x44, 0x5b, 0xd2, 0xd9, 0x53, 0x58, 0x28,
+	0xca, 0xda, 0x8b, 0x6c, 0x25, 0xee, 0x71, 0xa1, 0x20, 0x7d, 0xca, 0xf5, 0x64, 0x1b, 0x2d, 0xe7,
+	0x13, 0x03, 0xb1, 0xad, 0xe6, 0x6b, 0xf0, 0x90, 0x54, 0xf8, 0x3a, 0xc9, 0x1e, 0x78, 0x5d, 0x63,
+	0xdd, 0xd8, 0x43, 0x75, 0x98, 0xe9, 0x10, 0xda, 0xee, 0x98, 0xd8, 0xa6, 0xab, 0x6f, 0xff, 0xf5,
+	0xb4, 0x70, 0xd2, 0x17, 0x44, 0xb7, 0x60, 0xe6, 0x19, 0xd5, 0x8f, 0x5b, 0x1b, 0xb3, 0x3b, 0x65,
+	0x36, 0x16, 0x66, 0xe1, 0xfc, 0x01, 0xe0, 0xb4, 0x65, 0x48, 0x39, 0x4b, 0xb8, 0xda, 0x19, 0xba,
+	0x04, 0x4f, 0x0d, 0xef, 0x85, 0x1e, 0xa2, 0x44, 0x4a, 0xfb, 0x3e, 0xf9, 0xdf, 0x93, 0x87, 0x73,
+	0xe7, 0xac, 0x6b, 0xc3, 0xee, 0x68, 0xb6, 0x34, 0x95, 0xd0, 0x4d, 0x68, 0xbc, 0xbf, 0x43, 0x8e,
+	0x18, 0xcc, 0x24, 0xaf, 0x8e, 0xfd, 0x2c, 0x70, 0x8b, 0xb2, 0x90, 0xd6, 0x99, 0x76, 0x7e, 0x06,
+	0xf0, 0xfc, 0x3f, 0xd7, 0xf7, 0x35, 0xaa, 0x3a, 0x75, 0x12, 0x72, 0x49, 0xd5, 0x3e, 0x95, 0xfa,
+	0xd4, 0x48, 0xa9, 0x6b, 0x95, 0x5d, 0xa1, 0x2c, 0x3c, 0x1c, 0x18, 0xe0, 0xf8, 0x79, 0x31, 0xe6,
+	0x0e, 0x96, 0x0b, 0xce, 0xfa, 0xbf, 0x56, 0xe7, 0xec, 0x0f, 0x00, 0x22, 0xdb, 0xe7, 0xeb, 0x44,
+	0x2a, 0xca, 0xe2, 0x0c, 0xa2, 0x2a, 0x3c, 0xe7, 0x36, 0xae, 0x55, 0xdc, 0x7a, 0xd3, 0xab, 0x37,
+	0x9a, 0x57, 0x16, 0x97, 0x2a, 0x57, 0x16, 0x2f, 0x2f, 0x79, 0xcd, 0xe5, 0xc6, 0x52, 0xbd, 0x52,
+	0xfd, 0xb4, 0x31, 0x9e, 0xca, 0x15, 0x6e, 0xdd, 0x29, 0xce, 0xec, 0x3e, 0x1a, 0x47, 0x45, 0xbf,
+	0x83, 0xd0, 0x47, 0x70, 0x66, 0x2f, 0x1b, 0x57, 0xe3, 0xef, 0x8f, 0xc7, 0x41, 0xee, 0xdc, 0xad,
+	0x3b, 0xc5, 0xe9, 0xdd, 0x16, 0xae, 0xc6, 0x9f, 0xed, 0x5c, 0x7a, 0xfd, 0x5e, 0x3e, 0x55, 0xbd,
+	0x7c, 0x7f, 0x33, 0x0f, 0x1e, 0x6d, 0xe6, 0xc1, 0xe3, 0xcd, 0x3c, 0xf8, 0x7d, 0x33, 0x0f, 0xbe,
+	0x7d, 0x9e, 0x4f, 0x3d, 0x7e, 0x9e, 0x4f, 0xfd, 0xfa, 0x3c, 0x9f, 0xfa, 0xfc, 0xc2, 0x0b, 0x93,
+	0xba, 0xe3, 0x21, 0x14, 0xe7, 0xb8, 0x95, 0x89, 0xdf, 0xce, 0xef, 0xfe, 0x3d, 0x00, 0x2d, 0xd6,
+	0xfe, 0x73, 0xee, 0x0b, 0x00, 0x00,
 }
 
 func (this *Params) Equal(that interface{}) bool {
@@ -739,6 +805,12 @@ func (this *Params) Equal(that interface{}) bool {
 	if this.WithdrawAddrEnabled != that1.WithdrawAddrEnabled {
 		return false
 	}
+	if this.AutoCompoundInterval != that1.AutoCompoundInterval {
+		return false
+	}
+	if this.AutoCompoundBatchSize != that1.AutoCompoundBatchSize {
+		return false
+	}
 	return true
 }
 func (this *ValidatorHistoricalRewards) Equal(that interface{}) bool {
@@ -1066,6 +1138,16 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.AutoCompoundBatchSize != 0 {
+		i = encodeVarintDistribution(dAtA, i, uint64(m.AutoCompoundBatchSize))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.AutoCompoundInterval != 0 {
+		i = encodeVarintDistribution(dAtA, i, uint64(m.AutoCompoundInterval))
+		i--
+		dAtA[i] = 0x28
+	}
 	if m.WithdrawAddrEnabled {
 		i--
 		if m.WithdrawAddrEnabled {
@@ -1608,6 +1690,12 @@ func (m *Params) Size() (n int) {
 	if m.WithdrawAddrEnabled {
 		n += 2
 	}
+	if m.AutoCompoundInterval != 0 {
+		n += 1 + sovDistribution(uint64(m.AutoCompoundInterval))
+	}
+	if m.AutoCompoundBatchSize != 0 {
+		n += 1 + sovDistribution(uint64(m.AutoCompoundBatchSize))
+	}
 	return n
 }
 
@@ -1970,6 +2058,44 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				}
 			}
 			m.WithdrawAddrEnabled = bool(v != 0)
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AutoCompoundInterval", wireType)
+			}
+			m.AutoCompoundInterval = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDistribution
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.AutoCompoundInterval |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AutoCompoundBatchSize", wireType)
+			}
+			m.AutoCompoundBatchSize = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDistribution
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.AutoCompoundBatchSize |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipDistribution(dAtA[iNdEx:])
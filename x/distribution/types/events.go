@@ -2,14 +2,22 @@ package types
 
 // distribution module event types
 const (
-	EventTypeSetWithdrawAddress = "set_withdraw_address"
-	EventTypeRewards            = "rewards"
-	EventTypeCommission         = "commission"
-	EventTypeWithdrawRewards    = "withdraw_rewards"
-	EventTypeWithdrawCommission = "withdraw_commission"
-	EventTypeProposerReward     = "proposer_reward"
+	EventTypeSetWithdrawAddress    = "set_withdraw_address"
+	EventTypeRewards               = "rewards"
+	EventTypeCommission            = "commission"
+	EventTypeWithdrawRewards       = "withdraw_rewards"
+	EventTypeWithdrawCommission    = "withdraw_commission"
+	EventTypeProposerReward        = "proposer_reward"
+	EventTypeSetAutoCompound       = "set_auto_compound"
+	EventTypeAutoCompound          = "auto_compound"
+	EventTypeWithdrawAndDelegate   = "withdraw_and_delegate"
+	EventTypeSetRewardsDestination = "set_rewards_destination"
+	EventTypeVestRewards           = "vest_rewards"
 
 	AttributeKeyWithdrawAddress = "withdraw_address"
 	AttributeKeyValidator       = "validator"
 	AttributeKeyDelegator       = "delegator"
+	AttributeKeyEnabled         = "enabled"
+	AttributeKeyWithdrawn       = "withdrawn"
+	AttributeKeyDestination     = "destination"
 )
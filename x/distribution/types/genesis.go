@@ -8,6 +8,7 @@ func NewGenesisState(
 	params Params, fp FeePool, dwis []DelegatorWithdrawInfo, pp sdk.ConsAddress, r []ValidatorOutstandingRewardsRecord,
 	acc []ValidatorAccumulatedCommissionRecord, historical []ValidatorHistoricalRewardsRecord,
 	cur []ValidatorCurrentRewardsRecord, dels []DelegatorStartingInfoRecord, slashes []ValidatorSlashEventRecord,
+	autoCompounds []AutoCompoundEntry, vestingRewardsDelegators []string,
 ) *GenesisState {
 	return &GenesisState{
 		Params:                          params,
@@ -20,6 +21,8 @@ func NewGenesisState(
 		ValidatorCurrentRewards:         cur,
 		DelegatorStartingInfos:          dels,
 		ValidatorSlashEvents:            slashes,
+		AutoCompoundEntries:             autoCompounds,
+		VestingRewardsDelegators:        vestingRewardsDelegators,
 	}
 }
 
@@ -36,6 +39,8 @@ func DefaultGenesisState() *GenesisState {
 		ValidatorCurrentRewards:         []ValidatorCurrentRewardsRecord{},
 		DelegatorStartingInfos:          []DelegatorStartingInfoRecord{},
 		ValidatorSlashEvents:            []ValidatorSlashEventRecord{},
+		AutoCompoundEntries:             []AutoCompoundEntry{},
+		VestingRewardsDelegators:        []string{},
 	}
 }
 
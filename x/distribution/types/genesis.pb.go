@@ -326,6 +326,48 @@ func (m *ValidatorSlashEventRecord) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_ValidatorSlashEventRecord proto.InternalMessageInfo
 
+// AutoCompoundEntry is a delegation opted in to the automatic restaking of its
+// rewards, used for import/export via genesis json.
+type AutoCompoundEntry struct {
+	// delegator_address is the address of the delegator.
+	DelegatorAddress string `protobuf:"bytes,1,opt,name=delegator_address,json=delegatorAddress,proto3" json:"delegator_address,omitempty"`
+	// validator_address is the address of the validator.
+	ValidatorAddress string `protobuf:"bytes,2,opt,name=validator_address,json=validatorAddress,proto3" json:"validator_address,omitempty"`
+}
+
+func (m *AutoCompoundEntry) Reset()         { *m = AutoCompoundEntry{} }
+func (m *AutoCompoundEntry) String() string { return proto.CompactTextString(m) }
+func (*AutoCompoundEntry) ProtoMessage()    {}
+func (*AutoCompoundEntry) Descriptor() ([]byte, []int) {
+	return fileDescriptor_76eed0f9489db580, []int{7}
+}
+func (m *AutoCompoundEntry) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *AutoCompoundEntry) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_AutoCompoundEntry.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *AutoCompoundEntry) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AutoCompoundEntry.Merge(m, src)
+}
+func (m *AutoCompoundEntry) XXX_Size() int {
+	return m.Size()
+}
+func (m *AutoCompoundEntry) XXX_DiscardUnknown() {
+	xxx_messageInfo_AutoCompoundEntry.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AutoCompoundEntry proto.InternalMessageInfo
+
 // GenesisState defines the distribution module's genesis state.
 type GenesisState struct {
 	// params defines all the parameters of the module.
@@ -348,13 +390,21 @@ type GenesisState struct {
 	DelegatorStartingInfos []DelegatorStartingInfoRecord `protobuf:"bytes,9,rep,name=delegator_starting_infos,json=delegatorStartingInfos,proto3" json:"delegator_starting_infos"`
 	// fee_pool defines the validator slash events at genesis.
 	ValidatorSlashEvents []ValidatorSlashEventRecord `protobuf:"bytes,10,rep,name=validator_slash_events,json=validatorSlashEvents,proto3" json:"validator_slash_events"`
+	// auto_compound_entries defines the delegations opted in to auto-compounding
+	// at genesis.
+	AutoCompoundEntries []AutoCompoundEntry `protobuf:"bytes,11,rep,name=auto_compound_entries,json=autoCompoundEntries,proto3" json:"auto_compound_entries"`
+	// vesting_rewards_delegators defines the delegators whose rewards are added
+	// to their vesting amount at genesis.
+	//
+	// Since: cosmos-sdk 0.48
+	VestingRewardsDelegators []string `protobuf:"bytes,12,rep,name=vesting_rewards_delegators,json=vestingRewardsDelegators,proto3" json:"vesting_rewards_delegators,omitempty"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
 func (m *GenesisState) String() string { return proto.CompactTextString(m) }
 func (*GenesisState) ProtoMessage()    {}
 func (*GenesisState) Descriptor() ([]byte, []int) {
-	return fileDescriptor_76eed0f9489db580, []int{7}
+	return fileDescriptor_76eed0f9489db580, []int{8}
 }
 func (m *GenesisState) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -391,6 +441,7 @@ func init() {
 	proto.RegisterType((*ValidatorCurrentRewardsRecord)(nil), "cosmos.distribution.v1beta1.ValidatorCurrentRewardsRecord")
 	proto.RegisterType((*DelegatorStartingInfoRecord)(nil), "cosmos.distribution.v1beta1.DelegatorStartingInfoRecord")
 	proto.RegisterType((*ValidatorSlashEventRecord)(nil), "cosmos.distribution.v1beta1.ValidatorSlashEventRecord")
+	proto.RegisterType((*AutoCompoundEntry)(nil), "cosmos.distribution.v1beta1.AutoCompoundEntry")
 	proto.RegisterType((*GenesisState)(nil), "cosmos.distribution.v1beta1.GenesisState")
 }
 
@@ -399,66 +450,71 @@ func init() {
 }
 
 var fileDescriptor_76eed0f9489db580 = []byte{
-	// 938 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x56, 0xcf, 0x6f, 0x1b, 0x45,
-	0x14, 0xf6, 0x3a, 0x25, 0x4d, 0x26, 0x45, 0x34, 0xd3, 0x34, 0x6c, 0xd2, 0x76, 0x9d, 0x94, 0x1e,
-	0x0a, 0x28, 0x6b, 0x12, 0x10, 0x54, 0x45, 0x20, 0x35, 0x6e, 0xcb, 0x8f, 0x03, 0x8d, 0x6c, 0x09,
-	0x04, 0x42, 0xb2, 0xc6, 0xbb, 0xe3, 0xf5, 0x08, 0x7b, 0xc7, 0x9a, 0x19, 0xaf, 0x01, 0x89, 0x03,
-	0xa7, 0x22, 0xc4, 0x81, 0x13, 0xe2, 0x58, 0x71, 0xaa, 0x90, 0x90, 0x38, 0xf4, 0x8f, 0xe8, 0xb1,
-	0xaa, 0x38, 0x70, 0x02, 0xe4, 0x1c, 0x40, 0x1c, 0xf9, 0x0b, 0xd0, 0xce, 0xcc, 0xee, 0x8e, 0xb3,
-	0xdb, 0xad, 0x1b, 0xf0, 0xa5, 0x8d, 0x67, 0xde, 0x7b, 0xdf, 0xf7, 0xbd, 0xf7, 0xf6, 0xbd, 0x01,
-	0xcf, 0x7b, 0x94, 0x0f, 0x28, 0xaf, 0xfb, 0x84, 0x0b, 0x46, 0x3a, 0x23, 0x41, 0x68, 0x58, 0x8f,
-	0x76, 0x3b, 0x58, 0xa0, 0xdd, 0x7a, 0x80, 0x43, 0xcc, 0x09, 0x77, 0x87, 0x8c, 0x0a, 0x0a, 0xcf,
-	0x29, 0x53, 0xd7, 0x34, 0x75, 0xb5, 0xe9, 0xe6, 0x5a, 0x40, 0x03, 0x2a, 0xed, 0xea, 0xf1, 0x5f,
-	0xca, 0x65, 0xd3, 0xd1, 0xd1, 0x3b, 0x88, 0xe3, 0x34, 0xaa, 0x47, 0x49, 0xa8, 0xef, 0xdd, 0x32,
-	0xf4, 0x29, 0x1c, 0x65, 0xbf, 0xa1, 0xec, 0xdb, 0x0a, 0x48, 0xf3, 0x51, 0x57, 0xab, 0x68, 0x40,
-	0x42, 0x5a, 0x97, 0xff, 0xaa, 0xa3, 0x8b, 0x3f, 0x59, 0xe0, 0xec, 0x75, 0xdc, 0xc7, 0x01, 0x12,
-	0x94, 0x7d, 0x40, 0x44, 0xcf, 0x67, 0x68, 0xfc, 0x4e, 0xd8, 0xa5, 0xf0, 0x06, 0x58, 0xf5, 0x93,
-	0x8b, 0x36, 0xf2, 0x7d, 0x86, 0x39, 0xb7, 0xad, 0x2d, 0xeb, 0xf2, 0xf2, 0xbe, 0xfd, 0xf0, 0xde,
-	0xce, 0x9a, 0x8e, 0x7c, 0x4d, 0xdd, 0xb4, 0x04, 0x23, 0x61, 0xd0, 0x3c, 0x9d, 0xba, 0xe8, 0x73,
-	0xd8, 0x00, 0xa7, 0xc7, 0x3a, 0x6c, 0x1a, 0xa5, 0xfa, 0x98, 0x28, 0xcf, 0x24, 0x1e, 0xfa, 0xf8,
-	0xea, 0xd2, 0x57, 0x77, 0x6a, 0x95, 0xbf, 0xee, 0xd4, 0x2a, 0x17, 0x6f, 0x57, 0xc1, 0xf6, 0xfb,
-	0xa8, 0x4f, 0xfc, 0x18, 0xe3, 0xd6, 0x48, 0x70, 0x81, 0x42, 0x3f, 0xf6, 0xc1, 0x63, 0xc4, 0x7c,
-	0xde, 0xc4, 0x1e, 0x65, 0x3e, 0x7c, 0x0f, 0xac, 0x46, 0x89, 0xd1, 0x11, 0xee, 0xdb, 0x0f, 0xef,
-	0xed, 0x5c, 0xd0, 0xa8, 0x69, 0xa0, 0x23, 0x22, 0xa2, 0x23, 0xe7, 0xf0, 0xb6, 0x05, 0xce, 0xd0,
-	0x0c, 0xac, 0xcd, 0x14, 0x9a, 0x5d, 0xdd, 0x5a, 0xb8, 0xbc, 0xb2, 0x77, 0x5e, 0x97, 0xc8, 0x8d,
-	0x4b, 0x98, 0x54, 0xdb, 0xbd, 0x8e, 0xbd, 0x06, 0x25, 0xe1, 0xfe, 0x95, 0xfb, 0xbf, 0xd5, 0x2a,
-	0x3f, 0xfe, 0x5e, 0x7b, 0x31, 0x20, 0xa2, 0x37, 0xea, 0xb8, 0x1e, 0x1d, 0xe8, 0xaa, 0xe8, 0xff,
-	0x76, 0xb8, 0xff, 0x49, 0x5d, 0x7c, 0x36, 0xc4, 0x3c, 0xf1, 0xe1, 0x77, 0xff, 0xfc, 0xf9, 0x05,
-	0xab, 0x09, 0x69, 0x4e, 0x9f, 0x91, 0x89, 0xbf, 0x2d, 0x70, 0x29, 0x13, 0xe0, 0x79, 0xa3, 0xc1,
-	0xa8, 0x8f, 0x04, 0xf6, 0x1b, 0x74, 0x30, 0x20, 0x9c, 0x13, 0x1a, 0xce, 0x29, 0x19, 0x3d, 0xb0,
-	0x82, 0x32, 0x38, 0x59, 0xcc, 0x95, 0xbd, 0xd7, 0xdd, 0x92, 0xce, 0x77, 0xcb, 0x79, 0xee, 0x2f,
-	0xc7, 0x29, 0x52, 0x9a, 0xcd, 0xd0, 0x86, 0xd8, 0x7f, 0x2c, 0xb0, 0x95, 0x06, 0x79, 0x9b, 0x70,
-	0x41, 0x19, 0xf1, 0x50, 0x7f, 0xbe, 0x55, 0x5f, 0x07, 0x8b, 0x43, 0xcc, 0x08, 0x55, 0x1a, 0x4f,
-	0x34, 0xf5, 0x2f, 0xf8, 0x31, 0x38, 0x99, 0x34, 0xc0, 0x82, 0x14, 0xff, 0xda, 0x6c, 0xe2, 0x73,
-	0xbc, 0x4d, 0xe1, 0x49, 0x48, 0x43, 0xf4, 0x2f, 0x16, 0xb8, 0x90, 0x3a, 0x37, 0x46, 0x8c, 0xe1,
-	0x50, 0xcc, 0x57, 0xf1, 0x87, 0x99, 0x32, 0x55, 0xd6, 0x57, 0x66, 0x53, 0x36, 0x4d, 0xee, 0x31,
-	0xb2, 0x7e, 0xa8, 0x82, 0x73, 0xe9, 0xc8, 0x69, 0x09, 0xc4, 0x04, 0x09, 0x83, 0x78, 0xe4, 0x68,
-	0x51, 0xff, 0xd3, 0xe0, 0x29, 0xcc, 0x4d, 0xf5, 0xf8, 0xb9, 0xe9, 0x80, 0xa7, 0xb9, 0x26, 0xdb,
-	0x26, 0x61, 0x97, 0xea, 0xda, 0xef, 0x95, 0x66, 0xa8, 0x50, 0xa7, 0x99, 0x9f, 0x53, 0xdc, 0xb8,
-	0x30, 0x92, 0xf4, 0x5d, 0x15, 0x6c, 0xa4, 0xd4, 0x5a, 0x7d, 0xc4, 0x7b, 0x37, 0x22, 0x99, 0xe1,
-	0x79, 0x75, 0x7a, 0x0f, 0x93, 0xa0, 0x27, 0x92, 0x4e, 0x57, 0xbf, 0x8c, 0x2f, 0x60, 0x61, 0xea,
-	0x0b, 0xa0, 0xe0, 0x6c, 0x86, 0xcf, 0x63, 0x76, 0x6d, 0x1c, 0xd3, 0xb3, 0x4f, 0xc8, 0x9c, 0xbc,
-	0x34, 0x5b, 0xd7, 0x64, 0xb2, 0xcc, 0x8c, 0x9c, 0x89, 0xf2, 0xf7, 0x46, 0x62, 0xbe, 0x59, 0x06,
-	0xa7, 0xde, 0x52, 0x3b, 0xb7, 0x25, 0x90, 0xc0, 0xf0, 0x26, 0x58, 0x1c, 0x22, 0x86, 0x06, 0x2a,
-	0x01, 0x2b, 0x7b, 0xcf, 0x95, 0x82, 0x1f, 0x48, 0x53, 0x13, 0x4f, 0x7b, 0xc3, 0x77, 0xc1, 0x52,
-	0x17, 0xe3, 0xf6, 0x90, 0xd2, 0xbe, 0x6e, 0xfe, 0x4b, 0xa5, 0x91, 0x6e, 0x62, 0x7c, 0x40, 0x69,
-	0x7f, 0xaa, 0xd9, 0xbb, 0xea, 0x0c, 0x8e, 0x81, 0x9d, 0xb5, 0x70, 0xba, 0xfe, 0xe2, 0xae, 0x89,
-	0x47, 0xc6, 0xc2, 0xec, 0x6d, 0x63, 0x6e, 0x64, 0x13, 0x69, 0xdd, 0x2f, 0xb2, 0xe0, 0xf1, 0xb7,
-	0x33, 0x64, 0x38, 0x22, 0x74, 0x24, 0x1f, 0x00, 0x43, 0xca, 0x31, 0x93, 0x45, 0x29, 0xfd, 0x76,
-	0x12, 0x97, 0x03, 0xed, 0x01, 0x3f, 0x2f, 0x5e, 0x77, 0x4f, 0x49, 0xea, 0x6f, 0xce, 0x56, 0xdd,
-	0x47, 0x2d, 0x67, 0x53, 0x46, 0xc1, 0x86, 0x83, 0xdf, 0x5b, 0x60, 0xdb, 0x68, 0xee, 0x6c, 0x1d,
-	0xb4, 0xbd, 0x74, 0x63, 0x70, 0x7b, 0x51, 0x52, 0xb9, 0xf6, 0x1f, 0xb6, 0x4e, 0x9e, 0x4d, 0x2d,
-	0x2a, 0x75, 0xe0, 0xf0, 0x6b, 0x0b, 0x9c, 0xcf, 0xa8, 0xf5, 0xd2, 0x71, 0x9e, 0x26, 0xe8, 0xa4,
-	0x64, 0xf5, 0xc6, 0x31, 0xd7, 0x41, 0x9e, 0xd1, 0x66, 0xf4, 0x48, 0x63, 0xf8, 0xa5, 0x05, 0x36,
-	0x32, 0x32, 0x9e, 0x9a, 0xc0, 0x29, 0x93, 0x25, 0xc9, 0xe4, 0xea, 0x71, 0xc6, 0x77, 0x9e, 0xc6,
-	0xb3, 0x51, 0xb1, 0x25, 0xfc, 0xc2, 0xec, 0xf3, 0xa9, 0xe9, 0xc8, 0xed, 0x65, 0xc9, 0xe0, 0xca,
-	0x93, 0x8f, 0xc7, 0x3c, 0x7e, 0xd6, 0xed, 0xa6, 0x1d, 0x87, 0x63, 0xb0, 0x5e, 0x38, 0x86, 0xb8,
-	0x0d, 0x24, 0xf8, 0xab, 0x4f, 0x3a, 0x87, 0xf2, 0xd0, 0x6b, 0x05, 0xd3, 0xc8, 0x58, 0x66, 0xfb,
-	0xb7, 0xee, 0x4e, 0x1c, 0xeb, 0xfe, 0xc4, 0xb1, 0x1e, 0x4c, 0x1c, 0xeb, 0x8f, 0x89, 0x63, 0x7d,
-	0x7b, 0xe8, 0x54, 0x1e, 0x1c, 0x3a, 0x95, 0x5f, 0x0f, 0x9d, 0xca, 0x47, 0xbb, 0xa5, 0x6f, 0xbe,
-	0x4f, 0xa7, 0xdf, 0xf4, 0xf2, 0x09, 0xd8, 0x59, 0x94, 0xef, 0xf2, 0x97, 0xff, 0x0d, 0x00, 0x00,
-	0xff, 0xff, 0xbe, 0x75, 0xf7, 0x68, 0x75, 0x0c, 0x00, 0x00,
+	// 1014 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x57, 0xcf, 0x6f, 0x1b, 0x45,
+	0x14, 0xf6, 0xda, 0x21, 0x4d, 0xc6, 0x41, 0x34, 0xd3, 0x24, 0x6c, 0xd2, 0xd6, 0x4e, 0x4a, 0x0f,
+	0x05, 0x94, 0x35, 0x09, 0x08, 0xaa, 0x22, 0x90, 0x12, 0x37, 0xe5, 0xc7, 0x81, 0x46, 0x89, 0x54,
+	0x04, 0x42, 0x5a, 0x8d, 0x77, 0x27, 0xf6, 0x08, 0xef, 0x8e, 0x35, 0x33, 0xbb, 0xa6, 0x48, 0x1c,
+	0x38, 0x15, 0x71, 0xe2, 0x84, 0x38, 0x56, 0x9c, 0x2a, 0x24, 0x24, 0x0e, 0xf9, 0x23, 0x7a, 0xac,
+	0x0a, 0x07, 0x4e, 0x80, 0x92, 0x03, 0x88, 0x23, 0x7f, 0x01, 0xda, 0x9d, 0xd9, 0xdd, 0x71, 0x76,
+	0xbb, 0x71, 0x02, 0x91, 0x7a, 0x49, 0xec, 0x99, 0xf7, 0xe3, 0xfb, 0xbe, 0xf7, 0xe6, 0xcd, 0x18,
+	0xbc, 0xe8, 0x50, 0xee, 0x51, 0xde, 0x72, 0x09, 0x17, 0x8c, 0x74, 0x02, 0x41, 0xa8, 0xdf, 0x0a,
+	0xd7, 0x3a, 0x58, 0xa0, 0xb5, 0x56, 0x17, 0xfb, 0x98, 0x13, 0x6e, 0x0d, 0x18, 0x15, 0x14, 0x5e,
+	0x94, 0xa6, 0x96, 0x6e, 0x6a, 0x29, 0xd3, 0xa5, 0xb9, 0x2e, 0xed, 0xd2, 0xd8, 0xae, 0x15, 0x7d,
+	0x92, 0x2e, 0x4b, 0x0d, 0x15, 0xbd, 0x83, 0x38, 0x4e, 0xa3, 0x3a, 0x94, 0xf8, 0x6a, 0xdf, 0x2a,
+	0xcb, 0x3e, 0x92, 0x47, 0xda, 0x2f, 0x4a, 0x7b, 0x5b, 0x26, 0x52, 0x78, 0xe4, 0xd6, 0x2c, 0xf2,
+	0x88, 0x4f, 0x5b, 0xf1, 0x5f, 0xb9, 0x74, 0xe5, 0x47, 0x03, 0xcc, 0xdf, 0xc4, 0x7d, 0xdc, 0x45,
+	0x82, 0xb2, 0x0f, 0x89, 0xe8, 0xb9, 0x0c, 0x0d, 0xdf, 0xf3, 0xf7, 0x28, 0xdc, 0x02, 0xb3, 0x6e,
+	0xb2, 0x61, 0x23, 0xd7, 0x65, 0x98, 0x73, 0xd3, 0x58, 0x36, 0xae, 0x4d, 0x6f, 0x9a, 0x8f, 0xf7,
+	0x57, 0xe7, 0x54, 0xe4, 0x0d, 0xb9, 0xb3, 0x2b, 0x18, 0xf1, 0xbb, 0x3b, 0xe7, 0x53, 0x17, 0xb5,
+	0x0e, 0xdb, 0xe0, 0xfc, 0x50, 0x85, 0x4d, 0xa3, 0x54, 0x8f, 0x89, 0xf2, 0x5c, 0xe2, 0xa1, 0x96,
+	0x6f, 0x4c, 0x7d, 0x75, 0xbf, 0x59, 0xf9, 0xeb, 0x7e, 0xb3, 0x72, 0xe5, 0x5e, 0x15, 0xac, 0xdc,
+	0x41, 0x7d, 0xe2, 0x46, 0x39, 0x6e, 0x07, 0x82, 0x0b, 0xe4, 0xbb, 0x91, 0x0f, 0x1e, 0x22, 0xe6,
+	0xf2, 0x1d, 0xec, 0x50, 0xe6, 0xc2, 0x0f, 0xc0, 0x6c, 0x98, 0x18, 0x1d, 0xc1, 0xbe, 0xf2, 0x78,
+	0x7f, 0xf5, 0xb2, 0xca, 0x9a, 0x06, 0x3a, 0x42, 0x22, 0x3c, 0xb2, 0x0e, 0xef, 0x19, 0xe0, 0x02,
+	0xcd, 0x92, 0xd9, 0x4c, 0x66, 0x33, 0xab, 0xcb, 0xb5, 0x6b, 0xf5, 0xf5, 0x4b, 0xaa, 0x44, 0x56,
+	0x54, 0xc2, 0xa4, 0xda, 0xd6, 0x4d, 0xec, 0xb4, 0x29, 0xf1, 0x37, 0xaf, 0x3f, 0xfc, 0xad, 0x59,
+	0xf9, 0xe1, 0xf7, 0xe6, 0xcb, 0x5d, 0x22, 0x7a, 0x41, 0xc7, 0x72, 0xa8, 0xa7, 0xaa, 0xa2, 0xfe,
+	0xad, 0x72, 0xf7, 0xd3, 0x96, 0xb8, 0x3b, 0xc0, 0x3c, 0xf1, 0xe1, 0x0f, 0xfe, 0xfc, 0xe9, 0x25,
+	0x63, 0x07, 0xd2, 0x1c, 0x3f, 0x4d, 0x89, 0xbf, 0x0d, 0x70, 0x35, 0x23, 0xe0, 0x38, 0x81, 0x17,
+	0xf4, 0x91, 0xc0, 0x6e, 0x9b, 0x7a, 0x1e, 0xe1, 0x9c, 0x50, 0xff, 0x8c, 0xc4, 0xe8, 0x81, 0x3a,
+	0xca, 0xd2, 0xc5, 0xc5, 0xac, 0xaf, 0xbf, 0x69, 0x95, 0x74, 0xbe, 0x55, 0x8e, 0x73, 0x73, 0x3a,
+	0x92, 0x48, 0x72, 0xd6, 0x43, 0x6b, 0x64, 0xff, 0x31, 0xc0, 0x72, 0x1a, 0xe4, 0x5d, 0xc2, 0x05,
+	0x65, 0xc4, 0x41, 0xfd, 0xb3, 0xad, 0xfa, 0x02, 0x98, 0x1c, 0x60, 0x46, 0xa8, 0xe4, 0x38, 0xb1,
+	0xa3, 0xbe, 0xc1, 0x4f, 0xc0, 0xb9, 0xa4, 0x01, 0x6a, 0x31, 0xf9, 0x37, 0xc6, 0x23, 0x9f, 0xc3,
+	0xad, 0x13, 0x4f, 0x42, 0x6a, 0xa4, 0x7f, 0x31, 0xc0, 0xe5, 0xd4, 0xb9, 0x1d, 0x30, 0x86, 0x7d,
+	0x71, 0xb6, 0x8c, 0x3f, 0xca, 0x98, 0xc9, 0xb2, 0xbe, 0x36, 0x1e, 0xb3, 0x51, 0x70, 0xc7, 0xd0,
+	0xfa, 0xbe, 0x0a, 0x2e, 0xa6, 0x23, 0x67, 0x57, 0x20, 0x26, 0x88, 0xdf, 0x8d, 0x46, 0x8e, 0x22,
+	0xf5, 0x3f, 0x0d, 0x9e, 0x42, 0x6d, 0xaa, 0xa7, 0xd7, 0xa6, 0x03, 0x9e, 0xe5, 0x0a, 0xac, 0x4d,
+	0xfc, 0x3d, 0xaa, 0x6a, 0xbf, 0x5e, 0xaa, 0x50, 0x21, 0x4f, 0x5d, 0x9f, 0x19, 0xae, 0x6d, 0x68,
+	0x22, 0x7d, 0x5b, 0x05, 0x8b, 0x29, 0xb4, 0xdd, 0x3e, 0xe2, 0xbd, 0xad, 0x30, 0x56, 0xf8, 0xac,
+	0x3a, 0xbd, 0x87, 0x49, 0xb7, 0x27, 0x92, 0x4e, 0x97, 0xdf, 0xb4, 0x13, 0x50, 0x1b, 0x39, 0x01,
+	0x14, 0xcc, 0x67, 0xf9, 0x79, 0x84, 0xce, 0xc6, 0x11, 0x3c, 0x73, 0x22, 0xd6, 0xe4, 0x95, 0xf1,
+	0xba, 0x26, 0xa3, 0xa5, 0x2b, 0x72, 0x21, 0xcc, 0xef, 0x6b, 0xc2, 0xec, 0x1b, 0x60, 0x76, 0x23,
+	0x10, 0xb4, 0x4d, 0xbd, 0x01, 0x0d, 0x7c, 0x77, 0xcb, 0x17, 0xec, 0xee, 0x53, 0xda, 0x33, 0x1a,
+	0xec, 0x9f, 0x01, 0x98, 0x79, 0x47, 0x3e, 0x15, 0x76, 0x05, 0x12, 0x18, 0xde, 0x02, 0x93, 0x03,
+	0xc4, 0x90, 0x27, 0x61, 0xd6, 0xd7, 0x5f, 0x28, 0xd5, 0x6c, 0x3b, 0x36, 0xd5, 0x65, 0x52, 0xde,
+	0xf0, 0x7d, 0x30, 0xb5, 0x87, 0xb1, 0x3d, 0xa0, 0xb4, 0xaf, 0xce, 0xec, 0xd5, 0xd2, 0x48, 0xb7,
+	0x30, 0xde, 0xa6, 0xb4, 0x3f, 0x72, 0x46, 0xf7, 0xe4, 0x1a, 0x1c, 0x02, 0x33, 0x53, 0x31, 0xbd,
+	0xb5, 0xa3, 0x66, 0x8f, 0x26, 0x5d, 0x6d, 0xfc, 0x6e, 0xd7, 0x1f, 0x12, 0x7a, 0xa6, 0x05, 0xb7,
+	0xc8, 0x82, 0x47, 0xe5, 0x1b, 0x30, 0x1c, 0x12, 0x1a, 0xc4, 0xef, 0x96, 0x01, 0xe5, 0x98, 0x99,
+	0x13, 0xc7, 0x95, 0x2f, 0x71, 0xd9, 0x56, 0x1e, 0xf0, 0xf3, 0xe2, 0x5b, 0xfa, 0x99, 0x18, 0xfa,
+	0xdb, 0xe3, 0x35, 0xe5, 0x93, 0xde, 0x14, 0x3a, 0x8d, 0x82, 0x8b, 0x19, 0x7e, 0x67, 0x80, 0x15,
+	0xad, 0x77, 0xb2, 0x5b, 0xcc, 0x76, 0xd2, 0x8b, 0x8e, 0x9b, 0x93, 0x31, 0x94, 0x8d, 0xff, 0x70,
+	0x59, 0xe6, 0xd1, 0x34, 0xc3, 0x52, 0x07, 0x0e, 0xbf, 0x36, 0xc0, 0xa5, 0x0c, 0x5a, 0x2f, 0xbd,
+	0x85, 0x52, 0x81, 0xce, 0xc5, 0xa8, 0xde, 0x3a, 0xe5, 0x2d, 0x96, 0x47, 0xb4, 0x14, 0x3e, 0xd1,
+	0x18, 0x7e, 0x69, 0x80, 0xc5, 0x0c, 0x8c, 0x23, 0x2f, 0x8e, 0x14, 0xc9, 0x54, 0x8c, 0xe4, 0xc6,
+	0x69, 0x6e, 0x9d, 0x3c, 0x8c, 0xe7, 0xc3, 0x62, 0x4b, 0xf8, 0x85, 0xde, 0xe7, 0x23, 0x43, 0x9d,
+	0x9b, 0xd3, 0x31, 0x82, 0xeb, 0x27, 0x9f, 0xea, 0xf9, 0xfc, 0x0b, 0x6e, 0x91, 0x1d, 0x87, 0x43,
+	0xb0, 0x50, 0x38, 0x3d, 0xb9, 0x09, 0xe2, 0xe4, 0xaf, 0x9f, 0x74, 0x7c, 0xe6, 0x53, 0xcf, 0x15,
+	0x0c, 0x51, 0x0e, 0x3d, 0x30, 0x8f, 0x02, 0x41, 0x6d, 0x47, 0xcd, 0x4e, 0x1b, 0xfb, 0x82, 0x11,
+	0xcc, 0xcd, 0x7a, 0x9c, 0xd7, 0x2a, 0xcd, 0x9b, 0x1b, 0xba, 0x23, 0x43, 0x1b, 0x1d, 0xd9, 0x25,
+	0x98, 0xc3, 0x3b, 0x60, 0x29, 0xc4, 0x5c, 0x68, 0x47, 0xd1, 0x4e, 0x15, 0xe1, 0xe6, 0xcc, 0x72,
+	0xad, 0xf4, 0x78, 0x9b, 0xca, 0x57, 0x15, 0x2d, 0xd5, 0x5c, 0x9b, 0xaa, 0x9b, 0xb7, 0x1f, 0x1c,
+	0x34, 0x8c, 0x87, 0x07, 0x0d, 0xe3, 0xd1, 0x41, 0xc3, 0xf8, 0xe3, 0xa0, 0x61, 0x7c, 0x73, 0xd8,
+	0xa8, 0x3c, 0x3a, 0x6c, 0x54, 0x7e, 0x3d, 0x6c, 0x54, 0x3e, 0x5e, 0x2b, 0x7d, 0x71, 0x7f, 0x36,
+	0xfa, 0x8b, 0x2a, 0x7e, 0x80, 0x77, 0x26, 0xe3, 0x5f, 0x45, 0xaf, 0xfe, 0x3b, 0x00, 0x1c, 0x25,
+	0x99, 0xba, 0xf3, 0x0d, 0x00, 0x00,
 }
 
 func (m *DelegatorWithdrawInfo) Marshal() (dAtA []byte, err error) {
@@ -764,6 +820,43 @@ func (m *ValidatorSlashEventRecord) MarshalToSizedBuffer(dAtA []byte) (int, erro
 	return len(dAtA) - i, nil
 }
 
+func (m *AutoCompoundEntry) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AutoCompoundEntry) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AutoCompoundEntry) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ValidatorAddress) > 0 {
+		i -= len(m.ValidatorAddress)
+		copy(dAtA[i:], m.ValidatorAddress)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.ValidatorAddress)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.DelegatorAddress) > 0 {
+		i -= len(m.DelegatorAddress)
+		copy(dAtA[i:], m.DelegatorAddress)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.DelegatorAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -784,6 +877,29 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.VestingRewardsDelegators) > 0 {
+		for iNdEx := len(m.VestingRewardsDelegators) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.VestingRewardsDelegators[iNdEx])
+			copy(dAtA[i:], m.VestingRewardsDelegators[iNdEx])
+			i = encodeVarintGenesis(dAtA, i, uint64(len(m.VestingRewardsDelegators[iNdEx])))
+			i--
+			dAtA[i] = 0x62
+		}
+	}
+	if len(m.AutoCompoundEntries) > 0 {
+		for iNdEx := len(m.AutoCompoundEntries) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.AutoCompoundEntries[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x5a
+		}
+	}
 	if len(m.ValidatorSlashEvents) > 0 {
 		for iNdEx := len(m.ValidatorSlashEvents) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -1047,6 +1163,23 @@ func (m *ValidatorSlashEventRecord) Size() (n int) {
 	return n
 }
 
+func (m *AutoCompoundEntry) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.DelegatorAddress)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	l = len(m.ValidatorAddress)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	return n
+}
+
 func (m *GenesisState) Size() (n int) {
 	if m == nil {
 		return 0
@@ -1103,6 +1236,18 @@ func (m *GenesisState) Size() (n int) {
 			n += 1 + l + sovGenesis(uint64(l))
 		}
 	}
+	if len(m.AutoCompoundEntries) > 0 {
+		for _, e := range m.AutoCompoundEntries {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.VestingRewardsDelegators) > 0 {
+		for _, s := range m.VestingRewardsDelegators {
+			l = len(s)
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -2006,6 +2151,120 @@ func (m *ValidatorSlashEventRecord) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *AutoCompoundEntry) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AutoCompoundEntry: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AutoCompoundEntry: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DelegatorAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DelegatorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ValidatorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func (m *GenesisState) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
@@ -2371,6 +2630,72 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AutoCompoundEntries", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AutoCompoundEntries = append(m.AutoCompoundEntries, AutoCompoundEntry{})
+			if err := m.AutoCompoundEntries[len(m.AutoCompoundEntries)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VestingRewardsDelegators", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.VestingRewardsDelegators = append(m.VestingRewardsDelegators, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
@@ -41,6 +41,12 @@ const (
 // - 0x08<valAddrLen (1 Byte)><valAddr_Bytes><height>: ValidatorSlashEvent
 //
 // - 0x09: Params
+//
+// - 0x0A<accAddrLen (1 Byte)><accAddr_Bytes><valAddrLen (1 Byte)><valAddr_Bytes>: AutoCompound
+//
+// - 0x0B: AutoCompoundCursor
+//
+// - 0x0C<accAddrLen (1 Byte)><accAddr_Bytes>: VestingRewards
 var (
 	FeePoolKey                        = []byte{0x00} // key for global distribution state
 	ProposerKey                       = []byte{0x01} // key for the proposer operator address
@@ -54,6 +60,11 @@ var (
 	ValidatorSlashEventPrefix            = []byte{0x08} // key for validator slash fraction
 
 	ParamsKey = []byte{0x09} // key for distribution module params
+
+	AutoCompoundPrefix    = []byte{0x0A} // key for the delegations opted in to auto-compounding
+	AutoCompoundCursorKey = []byte{0x0B} // key for the next delegation of an ongoing auto-compounding pass
+
+	VestingRewardsPrefix = []byte{0x0C} // key for the delegators whose rewards are added to their vesting amount
 )
 
 // GetValidatorOutstandingRewardsAddress creates an address from a validator's outstanding rewards key.
@@ -153,6 +164,35 @@ func GetValidatorSlashEventAddressHeight(key []byte) (valAddr sdk.ValAddress, he
 	return
 }
 
+// GetAutoCompoundAddresses creates the addresses from an auto-compound key.
+func GetAutoCompoundAddresses(key []byte) (delAddr sdk.AccAddress, valAddr sdk.ValAddress) {
+	// key is in the format:
+	// 0x0A<accAddrLen (1 Byte)><accAddr_Bytes><valAddrLen (1 Byte)><valAddr_Bytes>
+	kv.AssertKeyAtLeastLength(key, 2)
+	delAddrLen := int(key[1])
+	kv.AssertKeyAtLeastLength(key, 3+delAddrLen)
+	delAddr = sdk.AccAddress(key[2 : 2+delAddrLen])
+	valAddrLen := int(key[2+delAddrLen])
+	kv.AssertKeyAtLeastLength(key, 4+delAddrLen)
+	valAddr = sdk.ValAddress(key[3+delAddrLen:])
+	kv.AssertKeyLength(valAddr.Bytes(), valAddrLen)
+
+	return
+}
+
+// GetVestingRewardsAddress creates an address from a vesting rewards key.
+func GetVestingRewardsAddress(key []byte) (delAddr sdk.AccAddress) {
+	// key is in the format:
+	// 0x0C<accAddrLen (1 Byte)><accAddr_Bytes>
+
+	// Remove prefix and address length.
+	kv.AssertKeyAtLeastLength(key, 3)
+	addr := key[2:]
+	kv.AssertKeyLength(addr, int(key[1]))
+
+	return sdk.AccAddress(addr)
+}
+
 // GetValidatorOutstandingRewardsKey creates the outstanding rewards key for a validator.
 func GetValidatorOutstandingRewardsKey(valAddr sdk.ValAddress) []byte {
 	return append(ValidatorOutstandingRewardsPrefix, address.MustLengthPrefix(valAddr.Bytes())...)
@@ -214,3 +254,15 @@ func GetValidatorSlashEventKey(v sdk.ValAddress, height, period uint64) []byte {
 
 	return append(prefix, periodBz...)
 }
+
+// GetAutoCompoundKey creates the key for a delegation opted in to
+// auto-compounding.
+func GetAutoCompoundKey(d sdk.AccAddress, v sdk.ValAddress) []byte {
+	return append(append(AutoCompoundPrefix, address.MustLengthPrefix(d.Bytes())...), address.MustLengthPrefix(v.Bytes())...)
+}
+
+// GetVestingRewardsKey creates the key for a delegator whose rewards are added
+// to its vesting amount.
+func GetVestingRewardsKey(d sdk.AccAddress) []byte {
+	return append(VestingRewardsPrefix, address.MustLengthPrefix(d.Bytes())...)
+}
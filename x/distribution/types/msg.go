@@ -13,6 +13,9 @@ var (
 	_ sdk.Msg = (*MsgUpdateParams)(nil)
 	_ sdk.Msg = (*MsgCommunityPoolSpend)(nil)
 	_ sdk.Msg = (*MsgDepositValidatorRewardsPool)(nil)
+	_ sdk.Msg = (*MsgSetAutoCompound)(nil)
+	_ sdk.Msg = (*MsgWithdrawAndDelegate)(nil)
+	_ sdk.Msg = (*MsgSetRewardsDestination)(nil)
 
 	_ legacytx.LegacyMsg = (*MsgSetWithdrawAddress)(nil)
 	_ legacytx.LegacyMsg = (*MsgWithdrawDelegatorReward)(nil)
@@ -20,6 +23,9 @@ var (
 	_ legacytx.LegacyMsg = (*MsgUpdateParams)(nil)
 	_ legacytx.LegacyMsg = (*MsgCommunityPoolSpend)(nil)
 	_ legacytx.LegacyMsg = (*MsgDepositValidatorRewardsPool)(nil)
+	_ legacytx.LegacyMsg = (*MsgSetAutoCompound)(nil)
+	_ legacytx.LegacyMsg = (*MsgWithdrawAndDelegate)(nil)
+	_ legacytx.LegacyMsg = (*MsgSetRewardsDestination)(nil)
 )
 
 func NewMsgSetWithdrawAddress(delAddr, withdrawAddr sdk.AccAddress) *MsgSetWithdrawAddress {
@@ -152,3 +158,79 @@ func (msg MsgDepositValidatorRewardsPool) GetSignBytes() []byte {
 	bz := ModuleCdc.MustMarshalJSON(&msg)
 	return sdk.MustSortJSON(bz)
 }
+
+// NewMsgSetAutoCompound returns a new MsgSetAutoCompound opting a delegation
+// in or out of auto-compounding.
+func NewMsgSetAutoCompound(delAddr sdk.AccAddress, valAddr sdk.ValAddress, enabled bool) *MsgSetAutoCompound {
+	return &MsgSetAutoCompound{
+		DelegatorAddress: delAddr.String(),
+		ValidatorAddress: valAddr.String(),
+		Enabled:          enabled,
+	}
+}
+
+// GetSigners returns the signer addresses that are expected to sign the result
+// of GetSignBytes, which is the delegator.
+func (msg MsgSetAutoCompound) GetSigners() []sdk.AccAddress {
+	delegator, _ := sdk.AccAddressFromBech32(msg.DelegatorAddress)
+	return []sdk.AccAddress{delegator}
+}
+
+// GetSignBytes returns the raw bytes for a MsgSetAutoCompound message that
+// the expected signer needs to sign.
+func (msg MsgSetAutoCompound) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(&msg)
+	return sdk.MustSortJSON(bz)
+}
+
+// NewMsgWithdrawAndDelegate returns a new MsgWithdrawAndDelegate withdrawing
+// the rewards of a delegator from the source validator, or from all the
+// validators if srcValAddr is nil, and delegating them to valAddr.
+func NewMsgWithdrawAndDelegate(delAddr sdk.AccAddress, srcValAddr, valAddr sdk.ValAddress) *MsgWithdrawAndDelegate {
+	msg := &MsgWithdrawAndDelegate{
+		DelegatorAddress: delAddr.String(),
+		ValidatorAddress: valAddr.String(),
+	}
+	if srcValAddr != nil {
+		msg.SourceValidatorAddress = srcValAddr.String()
+	}
+
+	return msg
+}
+
+// GetSigners returns the signer addresses that are expected to sign the result
+// of GetSignBytes, which is the delegator.
+func (msg MsgWithdrawAndDelegate) GetSigners() []sdk.AccAddress {
+	delegator, _ := sdk.AccAddressFromBech32(msg.DelegatorAddress)
+	return []sdk.AccAddress{delegator}
+}
+
+// GetSignBytes returns the raw bytes for a MsgWithdrawAndDelegate message that
+// the expected signer needs to sign.
+func (msg MsgWithdrawAndDelegate) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(&msg)
+	return sdk.MustSortJSON(bz)
+}
+
+// NewMsgSetRewardsDestination returns a new MsgSetRewardsDestination setting
+// where the rewards of a delegator are credited.
+func NewMsgSetRewardsDestination(delAddr sdk.AccAddress, destination RewardsDestination) *MsgSetRewardsDestination {
+	return &MsgSetRewardsDestination{
+		DelegatorAddress: delAddr.String(),
+		Destination:      destination,
+	}
+}
+
+// GetSigners returns the signer addresses that are expected to sign the result
+// of GetSignBytes, which is the delegator.
+func (msg MsgSetRewardsDestination) GetSigners() []sdk.AccAddress {
+	delegator, _ := sdk.AccAddressFromBech32(msg.DelegatorAddress)
+	return []sdk.AccAddress{delegator}
+}
+
+// GetSignBytes returns the raw bytes for a MsgSetRewardsDestination message
+// that the expected signer needs to sign.
+func (msg MsgSetRewardsDestination) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(&msg)
+	return sdk.MustSortJSON(bz)
+}
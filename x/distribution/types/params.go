@@ -6,13 +6,21 @@ import (
 	"cosmossdk.io/math"
 )
 
+// Default parameter values
+const (
+	DefaultAutoCompoundInterval  uint64 = 100
+	DefaultAutoCompoundBatchSize uint32 = 100
+)
+
 // DefaultParams returns default distribution parameters
 func DefaultParams() Params {
 	return Params{
-		CommunityTax:        math.LegacyNewDecWithPrec(2, 2), // 2%
-		BaseProposerReward:  math.LegacyZeroDec(),            // deprecated
-		BonusProposerReward: math.LegacyZeroDec(),            // deprecated
-		WithdrawAddrEnabled: true,
+		CommunityTax:          math.LegacyNewDecWithPrec(2, 2), // 2%
+		BaseProposerReward:    math.LegacyZeroDec(),            // deprecated
+		BonusProposerReward:   math.LegacyZeroDec(),            // deprecated
+		WithdrawAddrEnabled:   true,
+		AutoCompoundInterval:  DefaultAutoCompoundInterval,
+		AutoCompoundBatchSize: DefaultAutoCompoundBatchSize,
 	}
 }
 
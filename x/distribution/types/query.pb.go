@@ -867,6 +867,101 @@ func (m *QueryDelegatorWithdrawAddressResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_QueryDelegatorWithdrawAddressResponse proto.InternalMessageInfo
 
+// QueryDelegatorRewardsDestinationRequest is the request type for the
+// Query/DelegatorRewardsDestination RPC method.
+//
+// Since: cosmos-sdk 0.48
+type QueryDelegatorRewardsDestinationRequest struct {
+	// delegator_address defines the delegator address to query for.
+	DelegatorAddress string `protobuf:"bytes,1,opt,name=delegator_address,json=delegatorAddress,proto3" json:"delegator_address,omitempty"`
+}
+
+func (m *QueryDelegatorRewardsDestinationRequest) Reset() {
+	*m = QueryDelegatorRewardsDestinationRequest{}
+}
+func (m *QueryDelegatorRewardsDestinationRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryDelegatorRewardsDestinationRequest) ProtoMessage()    {}
+func (*QueryDelegatorRewardsDestinationRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5efd02cbc06efdc9, []int{18}
+}
+func (m *QueryDelegatorRewardsDestinationRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryDelegatorRewardsDestinationRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryDelegatorRewardsDestinationRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryDelegatorRewardsDestinationRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryDelegatorRewardsDestinationRequest.Merge(m, src)
+}
+func (m *QueryDelegatorRewardsDestinationRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryDelegatorRewardsDestinationRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryDelegatorRewardsDestinationRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryDelegatorRewardsDestinationRequest proto.InternalMessageInfo
+
+// QueryDelegatorRewardsDestinationResponse is the response type for the
+// Query/DelegatorRewardsDestination RPC method.
+//
+// Since: cosmos-sdk 0.48
+type QueryDelegatorRewardsDestinationResponse struct {
+	// destination defines where the rewards of the delegator are credited.
+	Destination RewardsDestination `protobuf:"varint,1,opt,name=destination,proto3,enum=cosmos.distribution.v1beta1.RewardsDestination" json:"destination,omitempty"`
+}
+
+func (m *QueryDelegatorRewardsDestinationResponse) Reset() {
+	*m = QueryDelegatorRewardsDestinationResponse{}
+}
+func (m *QueryDelegatorRewardsDestinationResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryDelegatorRewardsDestinationResponse) ProtoMessage()    {}
+func (*QueryDelegatorRewardsDestinationResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5efd02cbc06efdc9, []int{19}
+}
+func (m *QueryDelegatorRewardsDestinationResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryDelegatorRewardsDestinationResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryDelegatorRewardsDestinationResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryDelegatorRewardsDestinationResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryDelegatorRewardsDestinationResponse.Merge(m, src)
+}
+func (m *QueryDelegatorRewardsDestinationResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryDelegatorRewardsDestinationResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryDelegatorRewardsDestinationResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryDelegatorRewardsDestinationResponse proto.InternalMessageInfo
+
+func (m *QueryDelegatorRewardsDestinationResponse) GetDestination() RewardsDestination {
+	if m != nil {
+		return m.Destination
+	}
+	return RewardsDestinationSpendable
+}
+
 // QueryCommunityPoolRequest is the request type for the Query/CommunityPool RPC
 // method.
 type QueryCommunityPoolRequest struct {
@@ -876,7 +971,7 @@ func (m *QueryCommunityPoolRequest) Reset()         { *m = QueryCommunityPoolReq
 func (m *QueryCommunityPoolRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryCommunityPoolRequest) ProtoMessage()    {}
 func (*QueryCommunityPoolRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_5efd02cbc06efdc9, []int{18}
+	return fileDescriptor_5efd02cbc06efdc9, []int{20}
 }
 func (m *QueryCommunityPoolRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -916,7 +1011,7 @@ func (m *QueryCommunityPoolResponse) Reset()         { *m = QueryCommunityPoolRe
 func (m *QueryCommunityPoolResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryCommunityPoolResponse) ProtoMessage()    {}
 func (*QueryCommunityPoolResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_5efd02cbc06efdc9, []int{19}
+	return fileDescriptor_5efd02cbc06efdc9, []int{21}
 }
 func (m *QueryCommunityPoolResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -971,6 +1066,8 @@ func init() {
 	proto.RegisterType((*QueryDelegatorValidatorsResponse)(nil), "cosmos.distribution.v1beta1.QueryDelegatorValidatorsResponse")
 	proto.RegisterType((*QueryDelegatorWithdrawAddressRequest)(nil), "cosmos.distribution.v1beta1.QueryDelegatorWithdrawAddressRequest")
 	proto.RegisterType((*QueryDelegatorWithdrawAddressResponse)(nil), "cosmos.distribution.v1beta1.QueryDelegatorWithdrawAddressResponse")
+	proto.RegisterType((*QueryDelegatorRewardsDestinationRequest)(nil), "cosmos.distribution.v1beta1.QueryDelegatorRewardsDestinationRequest")
+	proto.RegisterType((*QueryDelegatorRewardsDestinationResponse)(nil), "cosmos.distribution.v1beta1.QueryDelegatorRewardsDestinationResponse")
 	proto.RegisterType((*QueryCommunityPoolRequest)(nil), "cosmos.distribution.v1beta1.QueryCommunityPoolRequest")
 	proto.RegisterType((*QueryCommunityPoolResponse)(nil), "cosmos.distribution.v1beta1.QueryCommunityPoolResponse")
 }
@@ -980,87 +1077,90 @@ func init() {
 }
 
 var fileDescriptor_5efd02cbc06efdc9 = []byte{
-	// 1269 bytes of a gzipped FileDescriptorProto
+	// 1325 bytes of a gzipped FileDescriptorProto
 	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x58, 0x4f, 0x6c, 0x1b, 0xc5,
-	0x17, 0xce, 0xb8, 0x69, 0xfa, 0xcb, 0xeb, 0xaf, 0x24, 0x9e, 0x46, 0xc8, 0xd9, 0xa4, 0x4e, 0x70,
-	0x68, 0x13, 0x35, 0x8a, 0xb7, 0x49, 0xa4, 0x52, 0x12, 0x2a, 0x88, 0x9d, 0x84, 0xa2, 0x46, 0x69,
-	0xeb, 0x16, 0x22, 0x40, 0x95, 0xb5, 0xf6, 0x6e, 0x36, 0x0b, 0xf6, 0x8e, 0xb3, 0xb3, 0x8e, 0x89,
-	0xaa, 0x5e, 0xca, 0xa5, 0xf4, 0x84, 0xe0, 0xc2, 0x91, 0x23, 0xe2, 0xc4, 0xa1, 0x9c, 0xe1, 0xc0,
-	0xa1, 0xc7, 0xaa, 0x48, 0x88, 0x13, 0xa0, 0x04, 0x89, 0x72, 0x00, 0x71, 0xe3, 0x8a, 0x76, 0x66,
-	0xd6, 0xde, 0xb5, 0xd7, 0xeb, 0x7f, 0xf2, 0x25, 0xb1, 0x66, 0xe7, 0x7d, 0xdf, 0xfb, 0xde, 0x7b,
-	0x33, 0xfb, 0xd9, 0x30, 0x9b, 0x27, 0xb4, 0x48, 0xa8, 0xac, 0x1a, 0xd4, 0xb6, 0x8c, 0x5c, 0xd9,
-	0x36, 0x88, 0x29, 0x1f, 0x2c, 0xe6, 0x34, 0x5b, 0x59, 0x94, 0xf7, 0xcb, 0x9a, 0x75, 0x98, 0x2c,
-	0x59, 0xc4, 0x26, 0x78, 0x82, 0x6f, 0x4c, 0x7a, 0x37, 0x26, 0xc5, 0x46, 0xe9, 0xa2, 0x40, 0xc9,
-	0x29, 0x54, 0xe3, 0x51, 0x55, 0x8c, 0x92, 0xa2, 0x1b, 0xa6, 0xc2, 0x76, 0x33, 0x20, 0x69, 0x4c,
-	0x27, 0x3a, 0x61, 0x1f, 0x65, 0xe7, 0x93, 0x58, 0x9d, 0xd4, 0x09, 0xd1, 0x0b, 0x9a, 0xac, 0x94,
-	0x0c, 0x59, 0x31, 0x4d, 0x62, 0xb3, 0x10, 0x2a, 0x9e, 0xc6, 0xbd, 0xf8, 0x2e, 0x72, 0x9e, 0x18,
-	0x2e, 0x66, 0x32, 0x4c, 0x85, 0x2f, 0x63, 0xbe, 0x7f, 0x9c, 0xef, 0xcf, 0xf2, 0x34, 0x84, 0x32,
-	0xfe, 0x28, 0xaa, 0x14, 0x0d, 0x93, 0xc8, 0xec, 0x2f, 0x5f, 0x4a, 0x8c, 0x01, 0xbe, 0xe5, 0x68,
-	0xba, 0xa9, 0x58, 0x4a, 0x91, 0x66, 0xb4, 0xfd, 0xb2, 0x46, 0xed, 0xc4, 0x5d, 0x38, 0xeb, 0x5b,
-	0xa5, 0x25, 0x62, 0x52, 0x0d, 0x6f, 0xc2, 0x50, 0x89, 0xad, 0xc4, 0xd0, 0x34, 0x9a, 0x3b, 0xbd,
-	0x34, 0x93, 0x0c, 0x29, 0x5c, 0x92, 0x07, 0xa7, 0x86, 0x9f, 0xfc, 0x32, 0x35, 0xf0, 0xd5, 0x1f,
-	0xdf, 0x5c, 0x44, 0x19, 0x11, 0x9d, 0xa8, 0xc0, 0x79, 0x06, 0xff, 0x8e, 0x52, 0x30, 0x54, 0xc5,
-	0x26, 0xd6, 0xba, 0x27, 0xfe, 0x2d, 0x73, 0x97, 0x88, 0x3c, 0xf0, 0x36, 0x44, 0x0f, 0xdc, 0x3d,
-	0x59, 0x45, 0x55, 0x2d, 0x8d, 0x72, 0xee, 0xe1, 0xd4, 0x4b, 0xcf, 0x1e, 0x2f, 0x9c, 0x13, 0xf4,
-	0x55, 0x9c, 0x35, 0xbe, 0xe5, 0xb6, 0x6d, 0x19, 0xa6, 0x9e, 0x19, 0x3d, 0xa8, 0x5b, 0x4f, 0xfc,
-	0x1d, 0x81, 0x0b, 0xad, 0x98, 0x85, 0xd6, 0x2d, 0x18, 0x25, 0x25, 0xcd, 0xea, 0x8e, 0x79, 0xc4,
-	0x0d, 0x15, 0xcb, 0xf8, 0x01, 0x82, 0x28, 0xd5, 0x0a, 0xbb, 0xd9, 0x1c, 0x31, 0xd5, 0xac, 0xa5,
-	0x55, 0x14, 0x4b, 0xa5, 0xb1, 0xc8, 0xf4, 0x89, 0xb9, 0xd3, 0x4b, 0x93, 0x6e, 0x15, 0x9d, 0x09,
-	0xa8, 0x56, 0x6f, 0x5d, 0xcb, 0xa7, 0x89, 0x61, 0xa6, 0xae, 0x38, 0xe5, 0xfb, 0xfa, 0xd7, 0xa9,
-	0x79, 0xdd, 0xb0, 0xf7, 0xca, 0xb9, 0x64, 0x9e, 0x14, 0x45, 0x53, 0xc5, 0xbf, 0x05, 0xaa, 0x7e,
-	0x28, 0xdb, 0x87, 0x25, 0x8d, 0xba, 0x31, 0x94, 0x57, 0x7b, 0xc4, 0x21, 0x4c, 0x11, 0x53, 0xcd,
-	0x70, 0x3a, 0xbc, 0x0f, 0x90, 0x27, 0xc5, 0xa2, 0x41, 0xa9, 0x41, 0xcc, 0xd8, 0x89, 0x36, 0xc8,
-	0x97, 0xbb, 0x20, 0xcf, 0x78, 0x48, 0x12, 0x87, 0x30, 0xeb, 0xaf, 0xf7, 0x8d, 0xb2, 0x4d, 0x6d,
-	0xc5, 0x54, 0x9d, 0x2a, 0xf1, 0xb4, 0xfa, 0xd5, 0xeb, 0x4f, 0x10, 0xcc, 0xb5, 0xe6, 0x16, 0xdd,
-	0xbe, 0x0b, 0xa7, 0xdc, 0xa6, 0xf0, 0xd1, 0xbe, 0x12, 0x3a, 0xda, 0x21, 0x90, 0xde, 0x79, 0x77,
-	0x31, 0x13, 0xfb, 0x30, 0xe5, 0x4f, 0x25, 0x5d, 0x2d, 0x51, 0xbf, 0xe4, 0x3f, 0x42, 0x30, 0xdd,
-	0x9c, 0x53, 0xc8, 0xde, 0xf5, 0x4d, 0x04, 0x57, 0xbe, 0xda, 0x9e, 0xf2, 0xb5, 0x7c, 0xbe, 0x5c,
-	0x2c, 0x17, 0x14, 0x5b, 0x53, 0x6b, 0xc0, 0x5e, 0xf1, 0xde, 0x31, 0x78, 0x14, 0x81, 0x49, 0x7f,
-	0x32, 0xb7, 0x0b, 0x0a, 0xdd, 0xd3, 0xfa, 0xd5, 0x7c, 0x3c, 0x0b, 0x23, 0xd4, 0x56, 0x2c, 0xdb,
-	0x30, 0xf5, 0xec, 0x9e, 0x66, 0xe8, 0x7b, 0x76, 0x2c, 0x32, 0x8d, 0xe6, 0x06, 0x33, 0x2f, 0xb8,
-	0xcb, 0xd7, 0xd8, 0x2a, 0x9e, 0x81, 0x33, 0x1a, 0x6b, 0x9f, 0xbb, 0xed, 0x04, 0xdb, 0xf6, 0x7f,
-	0xbe, 0x28, 0x36, 0x6d, 0x02, 0xd4, 0xae, 0xfa, 0xd8, 0x20, 0x2b, 0xd3, 0x05, 0xdf, 0xc1, 0xe1,
-	0x6f, 0x93, 0xda, 0xcd, 0xa7, 0x6b, 0x42, 0x59, 0xc6, 0x13, 0xb9, 0x32, 0xf8, 0xf0, 0xcb, 0xa9,
-	0x81, 0xc4, 0x77, 0x08, 0xce, 0x35, 0x29, 0x86, 0x68, 0xcb, 0xdb, 0x70, 0x8a, 0xf2, 0xa5, 0x18,
-	0x62, 0xa7, 0xf4, 0x52, 0x7b, 0x3d, 0x61, 0x38, 0x1b, 0x07, 0x9a, 0x69, 0xfb, 0xa6, 0x50, 0x60,
-	0xe1, 0x37, 0x7d, 0x32, 0x22, 0x4c, 0xc6, 0x6c, 0x4b, 0x19, 0x3c, 0x27, 0xaf, 0x8e, 0xc4, 0xf7,
-	0xae, 0x82, 0x75, 0xad, 0xa0, 0xe9, 0x6c, 0xad, 0xee, 0x30, 0x6f, 0x40, 0x54, 0xe5, 0xcf, 0x1a,
-	0xfa, 0x19, 0x7b, 0xf6, 0x78, 0x61, 0x4c, 0x90, 0xd6, 0xb5, 0xb1, 0x1a, 0xe2, 0xb6, 0x31, 0x70,
-	0x2c, 0x22, 0x5d, 0x8f, 0xc5, 0xca, 0xff, 0x9c, 0x06, 0x3c, 0x77, 0x9a, 0xf0, 0x19, 0x82, 0x78,
-	0x33, 0x09, 0xa2, 0x0b, 0x25, 0xef, 0x9d, 0xd0, 0xcf, 0x8b, 0xba, 0x7a, 0x4d, 0x94, 0x21, 0x51,
-	0x97, 0xd3, 0x1d, 0x62, 0x2b, 0x85, 0xbe, 0xd4, 0xd6, 0x53, 0x8b, 0x7f, 0x10, 0xcc, 0x84, 0xf2,
-	0x8a, 0x82, 0xbc, 0x5f, 0x5f, 0x90, 0xcb, 0xa1, 0x63, 0x59, 0x43, 0x5b, 0x77, 0xb9, 0x39, 0x62,
-	0xd0, 0x15, 0x89, 0x0b, 0x70, 0xd2, 0x76, 0x48, 0xfb, 0xfc, 0x52, 0xe4, 0x24, 0x09, 0x4b, 0x5c,
-	0xc8, 0xd5, 0xcc, 0xaa, 0x23, 0xd4, 0xbf, 0x32, 0x6f, 0x89, 0x0b, 0x39, 0x90, 0x53, 0x94, 0x38,
-	0x0e, 0x50, 0x1d, 0x5a, 0x5e, 0xe5, 0xe1, 0x8c, 0x67, 0xc5, 0x83, 0x56, 0x81, 0x97, 0xfd, 0x68,
-	0x3b, 0x86, 0xbd, 0xa7, 0x5a, 0x4a, 0x45, 0x10, 0xf7, 0x4d, 0xc6, 0x81, 0x30, 0x6f, 0xcd, 0x89,
-	0x85, 0x96, 0x34, 0x8c, 0x56, 0xc4, 0xa3, 0xb6, 0x89, 0x47, 0x2a, 0x7e, 0x30, 0x0f, 0xef, 0x04,
-	0x8c, 0x33, 0x5e, 0xe7, 0x6d, 0x53, 0x36, 0x0d, 0xfb, 0xf0, 0x26, 0x21, 0x05, 0xd7, 0xb0, 0x3e,
-	0x44, 0x20, 0x05, 0x3d, 0x15, 0xa9, 0x7c, 0x00, 0x83, 0x25, 0x42, 0x0a, 0x7d, 0x3e, 0xc7, 0x8c,
-	0x63, 0xe9, 0x87, 0x28, 0x9c, 0x64, 0xa9, 0xe0, 0x2f, 0x10, 0x0c, 0x71, 0x13, 0x8c, 0xe5, 0xd0,
-	0x93, 0xd2, 0xe8, 0xc0, 0xa5, 0x4b, 0xed, 0x07, 0x70, 0x8d, 0x89, 0xf9, 0x07, 0x3f, 0xfe, 0xfe,
-	0x79, 0xe4, 0x3c, 0x9e, 0x91, 0xc3, 0xbe, 0x30, 0x70, 0x07, 0x8e, 0xff, 0x44, 0x30, 0xde, 0xd4,
-	0x03, 0xe3, 0x54, 0x6b, 0xf2, 0x56, 0xd6, 0x5d, 0x4a, 0xf7, 0x84, 0x21, 0x34, 0xa5, 0x99, 0xa6,
-	0xab, 0x78, 0x35, 0x54, 0x53, 0xed, 0x7c, 0xc8, 0xf7, 0x1a, 0x5e, 0x17, 0xf7, 0xf1, 0xc7, 0x11,
-	0x98, 0x08, 0x31, 0x6c, 0x78, 0xbd, 0x83, 0x4c, 0x9b, 0xda, 0x57, 0x69, 0xa3, 0x47, 0x14, 0xa1,
-	0x78, 0x87, 0x29, 0xbe, 0x85, 0x6f, 0xf4, 0xa0, 0x58, 0x26, 0x35, 0x7c, 0xf7, 0xbb, 0x06, 0x3e,
-	0x42, 0x70, 0x36, 0xc0, 0x0a, 0xe2, 0xd7, 0x3a, 0xc8, 0xbb, 0xc1, 0xb5, 0x4a, 0x57, 0xbb, 0x8c,
-	0x16, 0x6a, 0xb7, 0x99, 0xda, 0x6b, 0x78, 0xb3, 0x17, 0xb5, 0x35, 0x9f, 0x89, 0x7f, 0x42, 0x30,
-	0x5a, 0xef, 0xaa, 0xf0, 0xab, 0x1d, 0xe4, 0xe8, 0xb7, 0xa5, 0xd2, 0x4a, 0x37, 0xa1, 0x42, 0xdb,
-	0x75, 0xa6, 0x6d, 0x03, 0xa7, 0x7b, 0xd1, 0xe6, 0x5a, 0xb7, 0xbf, 0x10, 0x44, 0x1b, 0x9c, 0x0a,
-	0x6e, 0x23, 0xbd, 0x66, 0x0e, 0x4d, 0x5a, 0xed, 0x2a, 0x56, 0x68, 0xcb, 0x32, 0x6d, 0xef, 0xe2,
-	0x9d, 0x50, 0x6d, 0xd5, 0x97, 0x08, 0x95, 0xef, 0x35, 0xbc, 0x83, 0xee, 0xcb, 0x62, 0x32, 0x03,
-	0xcf, 0xec, 0x73, 0x04, 0x2f, 0x06, 0xbb, 0x11, 0xfc, 0x7a, 0x27, 0x89, 0x07, 0xf8, 0x27, 0xe9,
-	0x8d, 0xee, 0x01, 0x3a, 0x6a, 0x6d, 0x7b, 0xf2, 0xd9, 0xc1, 0x0c, 0xb0, 0x04, 0xed, 0x1c, 0xcc,
-	0xe6, 0xee, 0xa5, 0x9d, 0x83, 0x19, 0xe2, 0x43, 0xda, 0x3c, 0x98, 0x2d, 0x14, 0xd6, 0x66, 0x1b,
-	0xff, 0x8b, 0x20, 0xd6, 0xcc, 0x30, 0xe0, 0xb5, 0x0e, 0x72, 0x0d, 0x76, 0x39, 0x52, 0xaa, 0x17,
-	0x08, 0xa1, 0xf9, 0x0e, 0xd3, 0xbc, 0x8d, 0xb7, 0x7a, 0xd1, 0x5c, 0xef, 0x78, 0xf0, 0xb7, 0x08,
-	0xce, 0xf8, 0x4c, 0x09, 0xbe, 0xdc, 0x3a, 0xd7, 0x20, 0x8f, 0x23, 0xbd, 0xd2, 0x71, 0x9c, 0x10,
-	0xb6, 0xcc, 0x84, 0x2d, 0xe0, 0xf9, 0x50, 0x61, 0x79, 0x37, 0x36, 0xeb, 0xd8, 0x98, 0xd4, 0xf5,
-	0x27, 0x47, 0x71, 0xf4, 0xf4, 0x28, 0x8e, 0x7e, 0x3b, 0x8a, 0xa3, 0x4f, 0x8f, 0xe3, 0x03, 0x4f,
-	0x8f, 0xe3, 0x03, 0x3f, 0x1f, 0xc7, 0x07, 0xde, 0x5b, 0x0c, 0x35, 0x46, 0x1f, 0xf9, 0xd1, 0x99,
-	0x4f, 0xca, 0x0d, 0xb1, 0x1f, 0x1b, 0x97, 0xff, 0x0b, 0x00, 0x00, 0xff, 0xff, 0x0e, 0x2b, 0x1c,
-	0xf2, 0x92, 0x15, 0x00, 0x00,
+	0x17, 0xce, 0x38, 0x69, 0xfa, 0xcb, 0xcb, 0xaf, 0x4d, 0x32, 0x89, 0x90, 0xb3, 0x49, 0x9d, 0xb0,
+	0x21, 0x4d, 0xd4, 0x28, 0xde, 0x26, 0x91, 0x4a, 0x49, 0x88, 0x20, 0xb6, 0x13, 0x8a, 0x1a, 0xa5,
+	0x8d, 0x5b, 0x88, 0x00, 0x55, 0xd6, 0xda, 0xbb, 0x71, 0x16, 0xec, 0x1d, 0x67, 0x67, 0x1d, 0x13,
+	0xaa, 0x5c, 0xca, 0xa5, 0xf4, 0x84, 0xe0, 0xc2, 0x91, 0x23, 0xe2, 0xc4, 0xa1, 0x9c, 0xe1, 0x58,
+	0x71, 0xaa, 0x8a, 0x84, 0x38, 0x01, 0x4a, 0x90, 0x28, 0x07, 0x10, 0x37, 0xae, 0xc8, 0x33, 0xb3,
+	0xf6, 0xae, 0xff, 0xac, 0xff, 0xc9, 0x97, 0xd6, 0x9a, 0x9d, 0xf7, 0x7d, 0xef, 0x7b, 0xef, 0xed,
+	0xcc, 0xb7, 0x81, 0xb9, 0x14, 0xa1, 0x59, 0x42, 0x15, 0xcd, 0xa0, 0xb6, 0x65, 0x24, 0xf3, 0xb6,
+	0x41, 0x4c, 0xe5, 0x68, 0x29, 0xa9, 0xdb, 0xea, 0x92, 0x72, 0x98, 0xd7, 0xad, 0xe3, 0x70, 0xce,
+	0x22, 0x36, 0xc1, 0x13, 0x7c, 0x63, 0xd8, 0xbd, 0x31, 0x2c, 0x36, 0x4a, 0x57, 0x04, 0x4a, 0x52,
+	0xa5, 0x3a, 0x8f, 0x2a, 0x61, 0xe4, 0xd4, 0xb4, 0x61, 0xaa, 0x6c, 0x37, 0x03, 0x92, 0xc6, 0xd2,
+	0x24, 0x4d, 0xd8, 0x4f, 0xa5, 0xf8, 0x4b, 0xac, 0x4e, 0xa6, 0x09, 0x49, 0x67, 0x74, 0x45, 0xcd,
+	0x19, 0x8a, 0x6a, 0x9a, 0xc4, 0x66, 0x21, 0x54, 0x3c, 0x0d, 0xb9, 0xf1, 0x1d, 0xe4, 0x14, 0x31,
+	0x1c, 0xcc, 0xb0, 0x9f, 0x0a, 0x4f, 0xc6, 0x7c, 0xff, 0x38, 0xdf, 0x9f, 0xe0, 0x69, 0x08, 0x65,
+	0xfc, 0xd1, 0x88, 0x9a, 0x35, 0x4c, 0xa2, 0xb0, 0x7f, 0xf9, 0x92, 0x3c, 0x06, 0x78, 0xb7, 0xa8,
+	0xe9, 0xb6, 0x6a, 0xa9, 0x59, 0x1a, 0xd7, 0x0f, 0xf3, 0x3a, 0xb5, 0xe5, 0x7b, 0x30, 0xea, 0x59,
+	0xa5, 0x39, 0x62, 0x52, 0x1d, 0x6f, 0x41, 0x7f, 0x8e, 0xad, 0x04, 0xd1, 0x34, 0x9a, 0x1f, 0x5c,
+	0x9e, 0x09, 0xfb, 0x14, 0x2e, 0xcc, 0x83, 0x23, 0x03, 0x4f, 0x7e, 0x99, 0xea, 0xf9, 0xea, 0x8f,
+	0x6f, 0xae, 0xa0, 0xb8, 0x88, 0x96, 0x0b, 0x30, 0xcb, 0xe0, 0xdf, 0x56, 0x33, 0x86, 0xa6, 0xda,
+	0xc4, 0x8a, 0xb9, 0xe2, 0xdf, 0x34, 0xf7, 0x89, 0xc8, 0x03, 0xef, 0xc0, 0xc8, 0x91, 0xb3, 0x27,
+	0xa1, 0x6a, 0x9a, 0xa5, 0x53, 0xce, 0x3d, 0x10, 0x79, 0xf1, 0xd9, 0xe3, 0xc5, 0x4b, 0x82, 0xbe,
+	0x84, 0xb3, 0xc1, 0xb7, 0xdc, 0xb1, 0x2d, 0xc3, 0x4c, 0xc7, 0x87, 0x8f, 0x2a, 0xd6, 0xe5, 0xbf,
+	0x03, 0x70, 0xb9, 0x11, 0xb3, 0xd0, 0xba, 0x0d, 0xc3, 0x24, 0xa7, 0x5b, 0xed, 0x31, 0x0f, 0x39,
+	0xa1, 0x62, 0x19, 0x3f, 0x40, 0x30, 0x42, 0xf5, 0xcc, 0x7e, 0x22, 0x49, 0x4c, 0x2d, 0x61, 0xe9,
+	0x05, 0xd5, 0xd2, 0x68, 0x30, 0x30, 0xdd, 0x3b, 0x3f, 0xb8, 0x3c, 0xe9, 0x54, 0xb1, 0x38, 0x01,
+	0xa5, 0xea, 0xc5, 0xf4, 0x54, 0x94, 0x18, 0x66, 0xe4, 0x7a, 0xb1, 0x7c, 0x5f, 0xff, 0x3a, 0xb5,
+	0x90, 0x36, 0xec, 0x83, 0x7c, 0x32, 0x9c, 0x22, 0x59, 0xd1, 0x54, 0xf1, 0xdf, 0x22, 0xd5, 0x3e,
+	0x50, 0xec, 0xe3, 0x9c, 0x4e, 0x9d, 0x18, 0xca, 0xab, 0x3d, 0x54, 0x24, 0x8c, 0x10, 0x53, 0x8b,
+	0x73, 0x3a, 0x7c, 0x08, 0x90, 0x22, 0xd9, 0xac, 0x41, 0xa9, 0x41, 0xcc, 0x60, 0x6f, 0x13, 0xe4,
+	0x2b, 0x6d, 0x90, 0xc7, 0x5d, 0x24, 0xf2, 0x31, 0xcc, 0x79, 0xeb, 0x7d, 0x2b, 0x6f, 0x53, 0x5b,
+	0x35, 0xb5, 0x62, 0x95, 0x78, 0x5a, 0xdd, 0xea, 0xf5, 0x27, 0x08, 0xe6, 0x1b, 0x73, 0x8b, 0x6e,
+	0xdf, 0x83, 0xf3, 0x4e, 0x53, 0xf8, 0x68, 0x5f, 0xf7, 0x1d, 0x6d, 0x1f, 0x48, 0xf7, 0xbc, 0x3b,
+	0x98, 0xf2, 0x21, 0x4c, 0x79, 0x53, 0x89, 0x96, 0x4a, 0xd4, 0x2d, 0xf9, 0x8f, 0x10, 0x4c, 0xd7,
+	0xe7, 0x14, 0xb2, 0xf7, 0x3d, 0x13, 0xc1, 0x95, 0xaf, 0x35, 0xa7, 0x7c, 0x23, 0x95, 0xca, 0x67,
+	0xf3, 0x19, 0xd5, 0xd6, 0xb5, 0x32, 0xb0, 0x5b, 0xbc, 0x7b, 0x0c, 0x1e, 0x05, 0x60, 0xd2, 0x9b,
+	0xcc, 0x9d, 0x8c, 0x4a, 0x0f, 0xf4, 0x6e, 0x35, 0x1f, 0xcf, 0xc1, 0x10, 0xb5, 0x55, 0xcb, 0x36,
+	0xcc, 0x74, 0xe2, 0x40, 0x37, 0xd2, 0x07, 0x76, 0x30, 0x30, 0x8d, 0xe6, 0xfb, 0xe2, 0x17, 0x9d,
+	0xe5, 0x1b, 0x6c, 0x15, 0xcf, 0xc0, 0x05, 0xdd, 0xd4, 0x5c, 0xdb, 0x7a, 0xd9, 0xb6, 0xff, 0xf3,
+	0x45, 0xb1, 0x69, 0x0b, 0xa0, 0x7c, 0xd4, 0x07, 0xfb, 0x58, 0x99, 0x2e, 0x7b, 0x5e, 0x1c, 0x7e,
+	0x9b, 0x94, 0x4f, 0xbe, 0xb4, 0x2e, 0x94, 0xc5, 0x5d, 0x91, 0xab, 0x7d, 0x0f, 0xbf, 0x9c, 0xea,
+	0x91, 0xbf, 0x43, 0x70, 0xa9, 0x4e, 0x31, 0x44, 0x5b, 0xde, 0x82, 0xf3, 0x94, 0x2f, 0x05, 0x11,
+	0x7b, 0x4b, 0xaf, 0x36, 0xd7, 0x13, 0x86, 0xb3, 0x79, 0xa4, 0x9b, 0xb6, 0x67, 0x0a, 0x05, 0x16,
+	0x7e, 0xc3, 0x23, 0x23, 0xc0, 0x64, 0xcc, 0x35, 0x94, 0xc1, 0x73, 0x72, 0xeb, 0x90, 0xbf, 0x77,
+	0x14, 0xc4, 0xf4, 0x8c, 0x9e, 0x66, 0x6b, 0x15, 0x2f, 0xf3, 0x26, 0x8c, 0x68, 0xfc, 0x59, 0x55,
+	0x3f, 0x83, 0xcf, 0x1e, 0x2f, 0x8e, 0x09, 0xd2, 0x8a, 0x36, 0x96, 0x42, 0x9c, 0x36, 0xd6, 0x1c,
+	0x8b, 0x40, 0xdb, 0x63, 0xb1, 0xfa, 0xbf, 0x62, 0x03, 0x9e, 0x17, 0x9b, 0xf0, 0x19, 0x82, 0x50,
+	0x3d, 0x09, 0xa2, 0x0b, 0x39, 0xf7, 0x99, 0xd0, 0xcd, 0x83, 0xba, 0x74, 0x4c, 0xe4, 0x41, 0xae,
+	0xc8, 0xe9, 0x2e, 0xb1, 0xd5, 0x4c, 0x57, 0x6a, 0xeb, 0xaa, 0xc5, 0x3f, 0x08, 0x66, 0x7c, 0x79,
+	0x45, 0x41, 0xde, 0xab, 0x2c, 0xc8, 0x35, 0xdf, 0xb1, 0x2c, 0xa3, 0xc5, 0x1c, 0x6e, 0x8e, 0x58,
+	0xeb, 0x88, 0xc4, 0x19, 0x38, 0x67, 0x17, 0x49, 0xbb, 0x7c, 0x29, 0x72, 0x12, 0xd9, 0x12, 0x07,
+	0x72, 0x29, 0xb3, 0xd2, 0x08, 0x75, 0xaf, 0xcc, 0xdb, 0x30, 0x5d, 0x9f, 0x53, 0x94, 0x38, 0x04,
+	0x50, 0x1a, 0x5a, 0x5e, 0xe5, 0x81, 0xb8, 0x6b, 0xc5, 0x85, 0x56, 0x80, 0x97, 0xbc, 0x68, 0x7b,
+	0x86, 0x7d, 0xa0, 0x59, 0x6a, 0x41, 0x10, 0x77, 0x4d, 0xc6, 0x11, 0xcc, 0x36, 0x20, 0x16, 0x5a,
+	0xa2, 0x30, 0x5c, 0x10, 0x8f, 0x9a, 0x26, 0x1e, 0x2a, 0x78, 0xc1, 0x5c, 0xbc, 0x1f, 0xc1, 0x9c,
+	0x97, 0x57, 0x8c, 0x67, 0x4c, 0xa7, 0xb6, 0x38, 0x98, 0xba, 0xa6, 0xf9, 0x04, 0xe6, 0x1b, 0x73,
+	0x0b, 0xd9, 0xbb, 0x30, 0xa8, 0x95, 0x97, 0x19, 0xed, 0xc5, 0x65, 0xc5, 0xf7, 0x4d, 0xa9, 0x81,
+	0xe6, 0xc6, 0x90, 0x27, 0x60, 0x9c, 0xd1, 0x17, 0x2f, 0xda, 0xbc, 0x69, 0xd8, 0xc7, 0xb7, 0x09,
+	0xc9, 0x38, 0x5e, 0xfd, 0x21, 0x02, 0xa9, 0xd6, 0x53, 0x91, 0xce, 0xfb, 0xd0, 0x97, 0x23, 0x24,
+	0xd3, 0xe5, 0x23, 0x8c, 0x71, 0x2c, 0xff, 0x30, 0x0a, 0xe7, 0x58, 0x2a, 0xf8, 0x0b, 0x04, 0xfd,
+	0xdc, 0xff, 0x63, 0x7f, 0xe9, 0xd5, 0x1f, 0x1f, 0xd2, 0xd5, 0xe6, 0x03, 0xb8, 0x46, 0x79, 0xe1,
+	0xc1, 0x8f, 0xbf, 0x7f, 0x1e, 0x98, 0xc5, 0x33, 0x8a, 0xdf, 0xb7, 0x12, 0xff, 0xf8, 0xc0, 0x7f,
+	0x22, 0x18, 0xaf, 0x6b, 0xff, 0x71, 0xa4, 0x31, 0x79, 0xa3, 0xaf, 0x16, 0x29, 0xda, 0x11, 0x86,
+	0xd0, 0x14, 0x65, 0x9a, 0xd6, 0xf1, 0x9a, 0xaf, 0xa6, 0xf2, 0xd1, 0xa0, 0xdc, 0xaf, 0xba, 0x29,
+	0x4f, 0xf0, 0xc7, 0x01, 0x98, 0xf0, 0xf1, 0xaa, 0x38, 0xd6, 0x42, 0xa6, 0x75, 0x9d, 0xbb, 0xb4,
+	0xd9, 0x21, 0x8a, 0x50, 0xbc, 0xc7, 0x14, 0xef, 0xe2, 0x5b, 0x1d, 0x28, 0x56, 0x48, 0x19, 0xdf,
+	0xf9, 0xcc, 0xc2, 0xa7, 0x08, 0x46, 0x6b, 0xb8, 0x60, 0xfc, 0x6a, 0x0b, 0x79, 0x57, 0x19, 0x76,
+	0x69, 0xbd, 0xcd, 0x68, 0xa1, 0x76, 0x87, 0xa9, 0xbd, 0x81, 0xb7, 0x3a, 0x51, 0x5b, 0xb6, 0xd8,
+	0xf8, 0x27, 0x04, 0xc3, 0x95, 0x86, 0x12, 0xbf, 0xd2, 0x42, 0x8e, 0x5e, 0x47, 0x2e, 0xad, 0xb6,
+	0x13, 0x2a, 0xb4, 0xdd, 0x64, 0xda, 0x36, 0x71, 0xb4, 0x13, 0x6d, 0x8e, 0x6b, 0xfd, 0x0b, 0xc1,
+	0x48, 0x95, 0x49, 0xc3, 0x4d, 0xa4, 0x57, 0xcf, 0x9c, 0x4a, 0x6b, 0x6d, 0xc5, 0x0a, 0x6d, 0x09,
+	0xa6, 0xed, 0x1d, 0xbc, 0xe7, 0xab, 0xad, 0x74, 0x97, 0x50, 0xe5, 0x7e, 0xd5, 0x55, 0x74, 0xa2,
+	0x88, 0xc9, 0xac, 0xf9, 0xce, 0x3e, 0x47, 0xf0, 0x42, 0x6d, 0x23, 0x86, 0x5f, 0x6b, 0x25, 0xf1,
+	0x1a, 0xd6, 0x51, 0x7a, 0xbd, 0x7d, 0x80, 0x96, 0x5a, 0xdb, 0x9c, 0x7c, 0xf6, 0x62, 0xd6, 0x70,
+	0x43, 0xcd, 0xbc, 0x98, 0xf5, 0x8d, 0x9b, 0xb4, 0xde, 0x66, 0x74, 0x4b, 0x2f, 0x66, 0x03, 0x85,
+	0xe5, 0xd9, 0xc6, 0xff, 0x22, 0x08, 0xd6, 0xf3, 0x4a, 0x78, 0xa3, 0x85, 0x5c, 0x6b, 0x1b, 0x3c,
+	0x29, 0xd2, 0x09, 0x84, 0xd0, 0x7c, 0x97, 0x69, 0xde, 0xc1, 0xdb, 0x9d, 0x68, 0xae, 0x34, 0x7b,
+	0xec, 0xf6, 0xf1, 0x71, 0x4c, 0xcd, 0xdc, 0x3e, 0x8d, 0xcd, 0x9e, 0xb4, 0xd9, 0x21, 0x4a, 0x4b,
+	0xb7, 0x4f, 0x73, 0x83, 0x9d, 0x70, 0x99, 0x37, 0xfc, 0x2d, 0x82, 0x0b, 0x1e, 0x6b, 0x86, 0xaf,
+	0x35, 0xce, 0xb8, 0x96, 0xd3, 0x93, 0x5e, 0x6e, 0x39, 0x4e, 0x68, 0x5b, 0x61, 0xda, 0x16, 0xf1,
+	0x82, 0xaf, 0xb6, 0x94, 0x13, 0x9b, 0x28, 0x9a, 0xb9, 0xc8, 0xcd, 0x27, 0xa7, 0x21, 0xf4, 0xf4,
+	0x34, 0x84, 0x7e, 0x3b, 0x0d, 0xa1, 0x4f, 0xcf, 0x42, 0x3d, 0x4f, 0xcf, 0x42, 0x3d, 0x3f, 0x9f,
+	0x85, 0x7a, 0xde, 0x5d, 0xf2, 0xb5, 0x87, 0x1f, 0x7a, 0xd1, 0x99, 0x5b, 0x4c, 0xf6, 0xb3, 0xbf,
+	0x36, 0xaf, 0xfc, 0x37, 0x00, 0x37, 0x68, 0xec, 0x4d, 0x93, 0x17, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -1094,6 +1194,11 @@ type QueryClient interface {
 	DelegatorValidators(ctx context.Context, in *QueryDelegatorValidatorsRequest, opts ...grpc.CallOption) (*QueryDelegatorValidatorsResponse, error)
 	// DelegatorWithdrawAddress queries withdraw address of a delegator.
 	DelegatorWithdrawAddress(ctx context.Context, in *QueryDelegatorWithdrawAddressRequest, opts ...grpc.CallOption) (*QueryDelegatorWithdrawAddressResponse, error)
+	// DelegatorRewardsDestination queries where the rewards of a delegator are
+	// credited.
+	//
+	// Since: cosmos-sdk 0.48
+	DelegatorRewardsDestination(ctx context.Context, in *QueryDelegatorRewardsDestinationRequest, opts ...grpc.CallOption) (*QueryDelegatorRewardsDestinationResponse, error)
 	// CommunityPool queries the community pool coins.
 	CommunityPool(ctx context.Context, in *QueryCommunityPoolRequest, opts ...grpc.CallOption) (*QueryCommunityPoolResponse, error)
 }
@@ -1187,6 +1292,15 @@ func (c *queryClient) DelegatorWithdrawAddress(ctx context.Context, in *QueryDel
 	return out, nil
 }
 
+func (c *queryClient) DelegatorRewardsDestination(ctx context.Context, in *QueryDelegatorRewardsDestinationRequest, opts ...grpc.CallOption) (*QueryDelegatorRewardsDestinationResponse, error) {
+	out := new(QueryDelegatorRewardsDestinationResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.distribution.v1beta1.Query/DelegatorRewardsDestination", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *queryClient) CommunityPool(ctx context.Context, in *QueryCommunityPoolRequest, opts ...grpc.CallOption) (*QueryCommunityPoolResponse, error) {
 	out := new(QueryCommunityPoolResponse)
 	err := c.cc.Invoke(ctx, "/cosmos.distribution.v1beta1.Query/CommunityPool", in, out, opts...)
@@ -1217,6 +1331,11 @@ type QueryServer interface {
 	DelegatorValidators(context.Context, *QueryDelegatorValidatorsRequest) (*QueryDelegatorValidatorsResponse, error)
 	// DelegatorWithdrawAddress queries withdraw address of a delegator.
 	DelegatorWithdrawAddress(context.Context, *QueryDelegatorWithdrawAddressRequest) (*QueryDelegatorWithdrawAddressResponse, error)
+	// DelegatorRewardsDestination queries where the rewards of a delegator are
+	// credited.
+	//
+	// Since: cosmos-sdk 0.48
+	DelegatorRewardsDestination(context.Context, *QueryDelegatorRewardsDestinationRequest) (*QueryDelegatorRewardsDestinationResponse, error)
 	// CommunityPool queries the community pool coins.
 	CommunityPool(context.Context, *QueryCommunityPoolRequest) (*QueryCommunityPoolResponse, error)
 }
@@ -1252,6 +1371,9 @@ func (*UnimplementedQueryServer) DelegatorValidators(ctx context.Context, req *Q
 func (*UnimplementedQueryServer) DelegatorWithdrawAddress(ctx context.Context, req *QueryDelegatorWithdrawAddressRequest) (*QueryDelegatorWithdrawAddressResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method DelegatorWithdrawAddress not implemented")
 }
+func (*UnimplementedQueryServer) DelegatorRewardsDestination(ctx context.Context, req *QueryDelegatorRewardsDestinationRequest) (*QueryDelegatorRewardsDestinationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DelegatorRewardsDestination not implemented")
+}
 func (*UnimplementedQueryServer) CommunityPool(ctx context.Context, req *QueryCommunityPoolRequest) (*QueryCommunityPoolResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method CommunityPool not implemented")
 }
@@ -1422,6 +1544,24 @@ func _Query_DelegatorWithdrawAddress_Handler(srv interface{}, ctx context.Contex
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_DelegatorRewardsDestination_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryDelegatorRewardsDestinationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).DelegatorRewardsDestination(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.distribution.v1beta1.Query/DelegatorRewardsDestination",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).DelegatorRewardsDestination(ctx, req.(*QueryDelegatorRewardsDestinationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Query_CommunityPool_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(QueryCommunityPoolRequest)
 	if err := dec(in); err != nil {
@@ -1480,6 +1620,10 @@ var _Query_serviceDesc = grpc.ServiceDesc{
 			MethodName: "DelegatorWithdrawAddress",
 			Handler:    _Query_DelegatorWithdrawAddress_Handler,
 		},
+		{
+			MethodName: "DelegatorRewardsDestination",
+			Handler:    _Query_DelegatorRewardsDestination_Handler,
+		},
 		{
 			MethodName: "CommunityPool",
 			Handler:    _Query_CommunityPool_Handler,
@@ -2137,6 +2281,64 @@ func (m *QueryDelegatorWithdrawAddressResponse) MarshalToSizedBuffer(dAtA []byte
 	return len(dAtA) - i, nil
 }
 
+func (m *QueryDelegatorRewardsDestinationRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryDelegatorRewardsDestinationRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryDelegatorRewardsDestinationRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.DelegatorAddress) > 0 {
+		i -= len(m.DelegatorAddress)
+		copy(dAtA[i:], m.DelegatorAddress)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.DelegatorAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryDelegatorRewardsDestinationResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryDelegatorRewardsDestinationResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryDelegatorRewardsDestinationResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Destination != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Destination))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *QueryCommunityPoolRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -2476,6 +2678,31 @@ func (m *QueryDelegatorWithdrawAddressResponse) Size() (n int) {
 	return n
 }
 
+func (m *QueryDelegatorRewardsDestinationRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.DelegatorAddress)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryDelegatorRewardsDestinationResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Destination != 0 {
+		n += 1 + sovQuery(uint64(m.Destination))
+	}
+	return n
+}
+
 func (m *QueryCommunityPoolRequest) Size() (n int) {
 	if m == nil {
 		return 0
@@ -4203,6 +4430,157 @@ func (m *QueryDelegatorWithdrawAddressResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *QueryDelegatorRewardsDestinationRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryDelegatorRewardsDestinationRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryDelegatorRewardsDestinationRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DelegatorAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DelegatorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryDelegatorRewardsDestinationResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryDelegatorRewardsDestinationResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryDelegatorRewardsDestinationResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Destination", wireType)
+			}
+			m.Destination = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Destination |= RewardsDestination(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func (m *QueryCommunityPoolRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
@@ -653,6 +653,276 @@ func (m *MsgDepositValidatorRewardsPoolResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgDepositValidatorRewardsPoolResponse proto.InternalMessageInfo
 
+// MsgSetAutoCompound defines a message for a delegator to opt in or out of the
+// automatic restaking of the rewards accrued from a validator.
+type MsgSetAutoCompound struct {
+	DelegatorAddress string `protobuf:"bytes,1,opt,name=delegator_address,json=delegatorAddress,proto3" json:"delegator_address,omitempty"`
+	ValidatorAddress string `protobuf:"bytes,2,opt,name=validator_address,json=validatorAddress,proto3" json:"validator_address,omitempty"`
+	// enabled defines whether the rewards of the delegation are restaked.
+	Enabled bool `protobuf:"varint,3,opt,name=enabled,proto3" json:"enabled,omitempty"`
+}
+
+func (m *MsgSetAutoCompound) Reset()         { *m = MsgSetAutoCompound{} }
+func (m *MsgSetAutoCompound) String() string { return proto.CompactTextString(m) }
+func (*MsgSetAutoCompound) ProtoMessage()    {}
+func (*MsgSetAutoCompound) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ed4f433d965e58ca, []int{14}
+}
+func (m *MsgSetAutoCompound) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSetAutoCompound) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetAutoCompound.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgSetAutoCompound) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetAutoCompound.Merge(m, src)
+}
+func (m *MsgSetAutoCompound) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSetAutoCompound) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetAutoCompound.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgSetAutoCompound proto.InternalMessageInfo
+
+// MsgSetAutoCompoundResponse defines the Msg/SetAutoCompound response type.
+type MsgSetAutoCompoundResponse struct {
+}
+
+func (m *MsgSetAutoCompoundResponse) Reset()         { *m = MsgSetAutoCompoundResponse{} }
+func (m *MsgSetAutoCompoundResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgSetAutoCompoundResponse) ProtoMessage()    {}
+func (*MsgSetAutoCompoundResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ed4f433d965e58ca, []int{15}
+}
+func (m *MsgSetAutoCompoundResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSetAutoCompoundResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetAutoCompoundResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgSetAutoCompoundResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetAutoCompoundResponse.Merge(m, src)
+}
+func (m *MsgSetAutoCompoundResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSetAutoCompoundResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetAutoCompoundResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgSetAutoCompoundResponse proto.InternalMessageInfo
+
+// MsgWithdrawAndDelegate withdraws the rewards of a delegator from a single
+// validator, or from all the validators it delegates to, and delegates the
+// withdrawn staking tokens to a validator. The rewards must be withdrawn to the
+// delegator account.
+//
+// Since: cosmos-sdk 0.48
+type MsgWithdrawAndDelegate struct {
+	DelegatorAddress string `protobuf:"bytes,1,opt,name=delegator_address,json=delegatorAddress,proto3" json:"delegator_address,omitempty"`
+	// source_validator_address is the validator to withdraw the rewards from.
+	// If empty, the rewards are withdrawn from all the validators the delegator
+	// delegates to.
+	SourceValidatorAddress string `protobuf:"bytes,2,opt,name=source_validator_address,json=sourceValidatorAddress,proto3" json:"source_validator_address,omitempty"`
+	// validator_address is the validator to delegate the withdrawn rewards to.
+	ValidatorAddress string `protobuf:"bytes,3,opt,name=validator_address,json=validatorAddress,proto3" json:"validator_address,omitempty"`
+}
+
+func (m *MsgWithdrawAndDelegate) Reset()         { *m = MsgWithdrawAndDelegate{} }
+func (m *MsgWithdrawAndDelegate) String() string { return proto.CompactTextString(m) }
+func (*MsgWithdrawAndDelegate) ProtoMessage()    {}
+func (*MsgWithdrawAndDelegate) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ed4f433d965e58ca, []int{16}
+}
+func (m *MsgWithdrawAndDelegate) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgWithdrawAndDelegate) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgWithdrawAndDelegate.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgWithdrawAndDelegate) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgWithdrawAndDelegate.Merge(m, src)
+}
+func (m *MsgWithdrawAndDelegate) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgWithdrawAndDelegate) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgWithdrawAndDelegate.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgWithdrawAndDelegate proto.InternalMessageInfo
+
+// MsgWithdrawAndDelegateResponse defines the Msg/WithdrawAndDelegate response
+// type.
+//
+// Since: cosmos-sdk 0.48
+type MsgWithdrawAndDelegateResponse struct {
+	// withdrawn is the amount of rewards withdrawn.
+	Withdrawn github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,1,rep,name=withdrawn,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"withdrawn"`
+	// delegated is the amount of staking tokens delegated, the withdrawn rewards
+	// of other denoms being kept in the delegator account.
+	Delegated types.Coin `protobuf:"bytes,2,opt,name=delegated,proto3" json:"delegated"`
+}
+
+func (m *MsgWithdrawAndDelegateResponse) Reset()         { *m = MsgWithdrawAndDelegateResponse{} }
+func (m *MsgWithdrawAndDelegateResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgWithdrawAndDelegateResponse) ProtoMessage()    {}
+func (*MsgWithdrawAndDelegateResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ed4f433d965e58ca, []int{17}
+}
+func (m *MsgWithdrawAndDelegateResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgWithdrawAndDelegateResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgWithdrawAndDelegateResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgWithdrawAndDelegateResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgWithdrawAndDelegateResponse.Merge(m, src)
+}
+func (m *MsgWithdrawAndDelegateResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgWithdrawAndDelegateResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgWithdrawAndDelegateResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgWithdrawAndDelegateResponse proto.InternalMessageInfo
+
+func (m *MsgWithdrawAndDelegateResponse) GetWithdrawn() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Withdrawn
+	}
+	return nil
+}
+
+func (m *MsgWithdrawAndDelegateResponse) GetDelegated() types.Coin {
+	if m != nil {
+		return m.Delegated
+	}
+	return types.Coin{}
+}
+
+// MsgSetRewardsDestination defines a message for a delegator to set where its
+// staking rewards are credited when withdrawn to its account.
+//
+// Since: cosmos-sdk 0.48
+type MsgSetRewardsDestination struct {
+	DelegatorAddress string `protobuf:"bytes,1,opt,name=delegator_address,json=delegatorAddress,proto3" json:"delegator_address,omitempty"`
+	// destination defines where the rewards of the delegator are credited.
+	Destination RewardsDestination `protobuf:"varint,2,opt,name=destination,proto3,enum=cosmos.distribution.v1beta1.RewardsDestination" json:"destination,omitempty"`
+}
+
+func (m *MsgSetRewardsDestination) Reset()         { *m = MsgSetRewardsDestination{} }
+func (m *MsgSetRewardsDestination) String() string { return proto.CompactTextString(m) }
+func (*MsgSetRewardsDestination) ProtoMessage()    {}
+func (*MsgSetRewardsDestination) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ed4f433d965e58ca, []int{18}
+}
+func (m *MsgSetRewardsDestination) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSetRewardsDestination) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetRewardsDestination.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgSetRewardsDestination) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetRewardsDestination.Merge(m, src)
+}
+func (m *MsgSetRewardsDestination) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSetRewardsDestination) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetRewardsDestination.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgSetRewardsDestination proto.InternalMessageInfo
+
+// MsgSetRewardsDestinationResponse defines the Msg/SetRewardsDestination
+// response type.
+//
+// Since: cosmos-sdk 0.48
+type MsgSetRewardsDestinationResponse struct {
+}
+
+func (m *MsgSetRewardsDestinationResponse) Reset()         { *m = MsgSetRewardsDestinationResponse{} }
+func (m *MsgSetRewardsDestinationResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgSetRewardsDestinationResponse) ProtoMessage()    {}
+func (*MsgSetRewardsDestinationResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ed4f433d965e58ca, []int{19}
+}
+func (m *MsgSetRewardsDestinationResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSetRewardsDestinationResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetRewardsDestinationResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgSetRewardsDestinationResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetRewardsDestinationResponse.Merge(m, src)
+}
+func (m *MsgSetRewardsDestinationResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSetRewardsDestinationResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetRewardsDestinationResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgSetRewardsDestinationResponse proto.InternalMessageInfo
+
 func init() {
 	proto.RegisterType((*MsgSetWithdrawAddress)(nil), "cosmos.distribution.v1beta1.MsgSetWithdrawAddress")
 	proto.RegisterType((*MsgSetWithdrawAddressResponse)(nil), "cosmos.distribution.v1beta1.MsgSetWithdrawAddressResponse")
@@ -668,6 +938,12 @@ func init() {
 	proto.RegisterType((*MsgCommunityPoolSpendResponse)(nil), "cosmos.distribution.v1beta1.MsgCommunityPoolSpendResponse")
 	proto.RegisterType((*MsgDepositValidatorRewardsPool)(nil), "cosmos.distribution.v1beta1.MsgDepositValidatorRewardsPool")
 	proto.RegisterType((*MsgDepositValidatorRewardsPoolResponse)(nil), "cosmos.distribution.v1beta1.MsgDepositValidatorRewardsPoolResponse")
+	proto.RegisterType((*MsgSetAutoCompound)(nil), "cosmos.distribution.v1beta1.MsgSetAutoCompound")
+	proto.RegisterType((*MsgSetAutoCompoundResponse)(nil), "cosmos.distribution.v1beta1.MsgSetAutoCompoundResponse")
+	proto.RegisterType((*MsgWithdrawAndDelegate)(nil), "cosmos.distribution.v1beta1.MsgWithdrawAndDelegate")
+	proto.RegisterType((*MsgWithdrawAndDelegateResponse)(nil), "cosmos.distribution.v1beta1.MsgWithdrawAndDelegateResponse")
+	proto.RegisterType((*MsgSetRewardsDestination)(nil), "cosmos.distribution.v1beta1.MsgSetRewardsDestination")
+	proto.RegisterType((*MsgSetRewardsDestinationResponse)(nil), "cosmos.distribution.v1beta1.MsgSetRewardsDestinationResponse")
 }
 
 func init() {
@@ -675,65 +951,81 @@ func init() {
 }
 
 var fileDescriptor_ed4f433d965e58ca = []byte{
-	// 925 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x57, 0x41, 0x6f, 0xe3, 0x44,
-	0x14, 0xce, 0xa4, 0xa2, 0x28, 0xb3, 0x2b, 0xed, 0x26, 0x2a, 0x6a, 0xeb, 0xdd, 0x75, 0x16, 0x17,
-	0x4a, 0x14, 0x51, 0x5b, 0x09, 0x08, 0x54, 0x73, 0x00, 0x92, 0x12, 0x89, 0x43, 0xa0, 0x4a, 0x05,
-	0x48, 0x5c, 0x2a, 0x27, 0x36, 0xee, 0x88, 0xda, 0x63, 0x79, 0x26, 0x49, 0x73, 0x03, 0x04, 0x12,
-	0xe2, 0x84, 0xc4, 0x8d, 0x4b, 0x2b, 0xf5, 0x52, 0x71, 0xca, 0xa1, 0x07, 0x7e, 0x42, 0x2f, 0x48,
-	0x55, 0x4f, 0x9c, 0x00, 0xa5, 0x42, 0x41, 0x82, 0xdf, 0x80, 0x90, 0xed, 0x89, 0x63, 0xc7, 0x8e,
-	0xdd, 0x96, 0x8a, 0x72, 0x69, 0xab, 0x99, 0xf7, 0xbe, 0xf9, 0xde, 0x37, 0xdf, 0xbc, 0xe7, 0xc2,
-	0x17, 0x3a, 0x98, 0x18, 0x98, 0x48, 0x2a, 0x22, 0xd4, 0x46, 0xed, 0x2e, 0x45, 0xd8, 0x94, 0x7a,
-	0x95, 0xb6, 0x46, 0x95, 0x8a, 0x44, 0x0f, 0x44, 0xcb, 0xc6, 0x14, 0x17, 0x1e, 0x79, 0x51, 0x62,
-	0x30, 0x4a, 0x64, 0x51, 0xdc, 0x92, 0x8e, 0x75, 0xec, 0xc6, 0x49, 0xce, 0x5f, 0x5e, 0x0a, 0xc7,
-	0x33, 0xe0, 0xb6, 0x42, 0x34, 0x1f, 0xb0, 0x83, 0x91, 0xc9, 0xf6, 0x57, 0xbd, 0xfd, 0x5d, 0x2f,
-	0x91, 0xe1, 0x7b, 0x5b, 0xcb, 0x2c, 0xd5, 0x20, 0xba, 0xd4, 0xab, 0x38, 0xbf, 0xd8, 0x46, 0x5e,
-	0x31, 0x90, 0x89, 0x25, 0xf7, 0x27, 0x5b, 0x12, 0x93, 0xf8, 0x87, 0xe8, 0xba, 0xf1, 0xc2, 0x9f,
-	0x00, 0x3e, 0xd7, 0x24, 0xfa, 0x8e, 0x46, 0x3f, 0x42, 0x74, 0x4f, 0xb5, 0x95, 0xfe, 0xdb, 0xaa,
-	0x6a, 0x6b, 0x84, 0x14, 0xde, 0x81, 0x79, 0x55, 0xdb, 0xd7, 0x74, 0x85, 0x62, 0x7b, 0x57, 0xf1,
-	0x16, 0x57, 0xc0, 0x53, 0x50, 0xca, 0xd5, 0x56, 0x2e, 0x4e, 0x37, 0x96, 0x18, 0x45, 0x16, 0xbe,
-	0x43, 0x6d, 0x64, 0xea, 0xad, 0x87, 0x7e, 0xca, 0x04, 0xa6, 0x0e, 0x1f, 0xf6, 0x19, 0xb2, 0x8f,
-	0x92, 0x4d, 0x41, 0x79, 0xd0, 0x0f, 0x73, 0x91, 0x1b, 0x5f, 0x1f, 0x15, 0x33, 0x7f, 0x1c, 0x15,
-	0x33, 0x5f, 0x8c, 0x87, 0xe5, 0x28, 0xad, 0x6f, 0xc6, 0xc3, 0xf2, 0x9a, 0x87, 0xb4, 0x41, 0xd4,
-	0x4f, 0xa5, 0x26, 0xd1, 0x9b, 0x58, 0x45, 0x9f, 0x0c, 0x66, 0x6a, 0x12, 0x8a, 0xf0, 0x49, 0x6c,
-	0xb1, 0x2d, 0x8d, 0x58, 0xd8, 0x24, 0x9a, 0xf0, 0x37, 0x80, 0x5c, 0x93, 0xe8, 0x93, 0xed, 0xad,
-	0xc9, 0x49, 0x2d, 0xad, 0xaf, 0xd8, 0xea, 0x6d, 0x69, 0xf2, 0x1e, 0xcc, 0xf7, 0x94, 0x7d, 0xa4,
-	0x86, 0x60, 0x3c, 0x51, 0x9e, 0xbf, 0x38, 0xdd, 0x78, 0xc2, 0x60, 0x3e, 0x9c, 0xc4, 0xcc, 0xe0,
-	0xf5, 0x66, 0xd6, 0xe5, 0x77, 0xd3, 0xe5, 0x59, 0x0f, 0xcb, 0x33, 0x53, 0x20, 0xc2, 0xa6, 0x57,
-	0xa1, 0x70, 0x08, 0xa0, 0x30, 0x5f, 0x80, 0x89, 0x4e, 0x85, 0x01, 0x5c, 0x54, 0x0c, 0xdc, 0x35,
-	0xe9, 0x0a, 0x78, 0xba, 0x50, 0xba, 0x57, 0x5d, 0x65, 0xbe, 0x13, 0x1d, 0x7b, 0x4f, 0x5e, 0x82,
-	0x58, 0xc7, 0xc8, 0xac, 0x35, 0xce, 0x7e, 0x29, 0x66, 0x7e, 0xf8, 0xb5, 0x58, 0xd2, 0x11, 0xdd,
-	0xeb, 0xb6, 0xc5, 0x0e, 0x36, 0x98, 0xbd, 0xa5, 0x00, 0x27, 0x3a, 0xb0, 0x34, 0xe2, 0x26, 0x90,
-	0xef, 0xc7, 0xc3, 0xf2, 0x7d, 0xe7, 0xd8, 0xce, 0x60, 0xd7, 0x79, 0x20, 0xe4, 0x64, 0x3c, 0x2c,
-	0x83, 0x16, 0x3b, 0x50, 0xf8, 0x11, 0x40, 0x3e, 0xc0, 0xd0, 0x17, 0xa9, 0x8e, 0x0d, 0x03, 0x11,
-	0x82, 0xb0, 0x19, 0xaf, 0x2f, 0xb8, 0xb9, 0xbe, 0x61, 0xfb, 0x45, 0xa0, 0x63, 0xec, 0x17, 0x60,
-	0x37, 0xe5, 0x25, 0x1c, 0x03, 0xb8, 0x9e, 0x4c, 0xfd, 0xff, 0x20, 0xf0, 0x57, 0x59, 0xb8, 0xd4,
-	0x24, 0x7a, 0xa3, 0x6b, 0xaa, 0x0e, 0xb1, 0xae, 0x89, 0xe8, 0x60, 0x1b, 0xe3, 0xfd, 0x3b, 0xe4,
-	0x54, 0x78, 0x0d, 0xe6, 0x54, 0xcd, 0xc2, 0x04, 0x51, 0x6c, 0xa7, 0xb6, 0x8f, 0x69, 0xa8, 0x2c,
-	0x07, 0x6f, 0x6e, 0xba, 0xee, 0xdc, 0x58, 0x31, 0x7c, 0x63, 0x91, 0x72, 0x05, 0x1e, 0x3e, 0x8e,
-	0x5b, 0xf7, 0x7b, 0xc5, 0x4f, 0x00, 0x3e, 0x68, 0x12, 0xfd, 0x03, 0x4b, 0x55, 0xa8, 0xb6, 0xad,
-	0xd8, 0x8a, 0x41, 0x1c, 0x9e, 0x4a, 0x97, 0xee, 0x61, 0x1b, 0xd1, 0x41, 0x6a, 0x63, 0x98, 0x86,
-	0x16, 0x1a, 0x70, 0xd1, 0x72, 0x11, 0xdc, 0xe2, 0xee, 0x55, 0xd7, 0xc4, 0x84, 0x09, 0x23, 0x7a,
-	0x87, 0xd5, 0x72, 0x8e, 0xc8, 0x4c, 0x27, 0x2f, 0x5b, 0x96, 0xdd, 0x3a, 0x7d, 0x5c, 0xa7, 0xce,
-	0x97, 0x02, 0x75, 0x86, 0xa6, 0xc2, 0x0c, 0x77, 0x61, 0x15, 0x2e, 0xcf, 0x2c, 0xf9, 0xa5, 0x1e,
-	0x67, 0xdd, 0x29, 0x11, 0xd2, 0x61, 0xc7, 0xd2, 0x4c, 0xf5, 0xc6, 0x05, 0x3f, 0x86, 0x39, 0x5b,
-	0xeb, 0x20, 0x0b, 0x69, 0x26, 0xf5, 0x2e, 0xb4, 0x35, 0x5d, 0x08, 0x38, 0x6d, 0xe1, 0x3f, 0x76,
-	0x9a, 0xbc, 0x19, 0x55, 0x70, 0x7d, 0x56, 0x41, 0x29, 0x56, 0x0b, 0x36, 0x5d, 0xa2, 0x1b, 0xbe,
-	0x8c, 0xbf, 0x67, 0xdd, 0xd6, 0xb5, 0xe5, 0xd9, 0xd0, 0x7f, 0xfe, 0x5e, 0x6f, 0x25, 0xee, 0x1b,
-	0x0b, 0x19, 0x1d, 0x5c, 0xd9, 0xe8, 0xb7, 0x3d, 0x52, 0xee, 0xf2, 0x06, 0xde, 0x9a, 0xff, 0x66,
-	0x5f, 0x8c, 0xbb, 0x89, 0xa9, 0x9c, 0x4c, 0x48, 0xa1, 0xe4, 0xb6, 0xd9, 0x04, 0x99, 0x27, 0x37,
-	0x52, 0xfd, 0xeb, 0x59, 0xb8, 0xd0, 0x24, 0x7a, 0xe1, 0x4b, 0x00, 0x0b, 0x31, 0xdf, 0x40, 0xd5,
-	0xc4, 0x67, 0x18, 0xfb, 0x29, 0xc1, 0xc9, 0xd7, 0xcf, 0xf1, 0xbb, 0xfe, 0x77, 0x00, 0x2e, 0xcf,
-	0xfb, 0xf6, 0x78, 0x3d, 0x0d, 0x77, 0x4e, 0x22, 0xf7, 0xe6, 0x0d, 0x13, 0x7d, 0x56, 0x87, 0x00,
-	0x3e, 0x4a, 0x1a, 0xb7, 0x6f, 0x5c, 0xf5, 0x80, 0x98, 0x64, 0xae, 0xfe, 0x2f, 0x92, 0x7d, 0x86,
-	0x9f, 0x03, 0x98, 0x8f, 0xce, 0xab, 0x4a, 0x1a, 0x74, 0x24, 0x85, 0xdb, 0xbc, 0x76, 0x8a, 0xcf,
-	0xc1, 0x86, 0xf7, 0x43, 0xa3, 0xe0, 0xe5, 0x34, 0xa8, 0x60, 0x34, 0xf7, 0xea, 0x75, 0xa2, 0xfd,
-	0x33, 0x1d, 0xdb, 0xc6, 0x34, 0xe5, 0x54, 0xdb, 0x46, 0x73, 0xd2, 0x6d, 0x3b, 0xbf, 0xaf, 0xb9,
-	0x06, 0x49, 0x6a, 0x6a, 0xa9, 0x06, 0x49, 0x48, 0x4e, 0x37, 0xc8, 0x15, 0xde, 0x39, 0xf7, 0xcc,
-	0x67, 0x4e, 0x8b, 0xa9, 0xbd, 0x7f, 0x32, 0xe2, 0xc1, 0xd9, 0x88, 0x07, 0xe7, 0x23, 0x1e, 0xfc,
-	0x36, 0xe2, 0xc1, 0xb7, 0x97, 0x7c, 0xe6, 0xfc, 0x92, 0xcf, 0xfc, 0x7c, 0xc9, 0x67, 0x3e, 0xae,
-	0x24, 0x36, 0xb0, 0x83, 0xf0, 0xf4, 0x74, 0xfb, 0x59, 0x7b, 0xd1, 0xfd, 0x2f, 0xea, 0x95, 0x7f,
-	0x02, 0x00, 0x00, 0xff, 0xff, 0x97, 0xa4, 0x34, 0x89, 0x37, 0x0e, 0x00, 0x00,
+	// 1170 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x58, 0xcf, 0x6f, 0x1b, 0x45,
+	0x14, 0xf6, 0x38, 0x22, 0x90, 0x49, 0x45, 0x1a, 0x93, 0x36, 0xce, 0x36, 0x5d, 0x87, 0x2d, 0xa4,
+	0x21, 0x22, 0x5e, 0x25, 0x05, 0xaa, 0x6e, 0x85, 0x20, 0x71, 0x88, 0x04, 0xc2, 0x50, 0x1c, 0x01,
+	0x12, 0x1c, 0xa2, 0xb5, 0x77, 0xd8, 0x8c, 0x88, 0x77, 0xac, 0x9d, 0x71, 0x52, 0x8b, 0x03, 0x50,
+	0x40, 0x20, 0xa4, 0x4a, 0x48, 0xdc, 0xb8, 0xb4, 0x52, 0x2f, 0x15, 0xa7, 0x1c, 0x7a, 0xe0, 0x4f,
+	0xe8, 0x05, 0xa9, 0xea, 0xa9, 0x27, 0x40, 0x89, 0xaa, 0x20, 0xf1, 0x3f, 0xa0, 0x6a, 0x77, 0x67,
+	0xc7, 0xfb, 0xcb, 0xbb, 0xb1, 0x13, 0xb5, 0xbd, 0xb4, 0xc9, 0xcc, 0x7c, 0xdf, 0x7c, 0xef, 0x9b,
+	0x37, 0xf3, 0xde, 0x06, 0xbe, 0xd4, 0x20, 0xb4, 0x49, 0xa8, 0x6a, 0x60, 0xca, 0x6c, 0x5c, 0x6f,
+	0x33, 0x4c, 0x2c, 0x75, 0x7b, 0xb1, 0x8e, 0x98, 0xbe, 0xa8, 0xb2, 0xab, 0xe5, 0x96, 0x4d, 0x18,
+	0x29, 0x9c, 0xf1, 0x56, 0x95, 0x83, 0xab, 0xca, 0x7c, 0x95, 0x34, 0x61, 0x12, 0x93, 0xb8, 0xeb,
+	0x54, 0xe7, 0x27, 0x0f, 0x22, 0xc9, 0x9c, 0xb8, 0xae, 0x53, 0x24, 0x08, 0x1b, 0x04, 0x5b, 0x7c,
+	0x7e, 0xca, 0x9b, 0xdf, 0xf0, 0x80, 0x9c, 0xdf, 0x9b, 0x9a, 0xe4, 0xd0, 0x26, 0x35, 0xd5, 0xed,
+	0x45, 0xe7, 0x3f, 0x3e, 0x31, 0xae, 0x37, 0xb1, 0x45, 0x54, 0xf7, 0x5f, 0x3e, 0x54, 0x4e, 0xd3,
+	0x1f, 0x92, 0xeb, 0xae, 0x57, 0xfe, 0x03, 0xf0, 0x54, 0x95, 0x9a, 0xeb, 0x88, 0x7d, 0x8a, 0xd9,
+	0xa6, 0x61, 0xeb, 0x3b, 0xcb, 0x86, 0x61, 0x23, 0x4a, 0x0b, 0xef, 0xc0, 0x71, 0x03, 0x6d, 0x21,
+	0x53, 0x67, 0xc4, 0xde, 0xd0, 0xbd, 0xc1, 0x22, 0x98, 0x01, 0x73, 0x23, 0x2b, 0xc5, 0xfb, 0x77,
+	0x16, 0x26, 0xb8, 0x44, 0xbe, 0x7c, 0x9d, 0xd9, 0xd8, 0x32, 0x6b, 0x27, 0x05, 0xc4, 0xa7, 0xa9,
+	0xc0, 0x93, 0x3b, 0x9c, 0x59, 0xb0, 0xe4, 0x33, 0x58, 0xc6, 0x76, 0xc2, 0x5a, 0xb4, 0xb5, 0x9f,
+	0x6e, 0x96, 0x72, 0xff, 0xde, 0x2c, 0xe5, 0xae, 0x1d, 0xec, 0xce, 0xc7, 0x65, 0xfd, 0x7c, 0xb0,
+	0x3b, 0x7f, 0xce, 0x63, 0x5a, 0xa0, 0xc6, 0x97, 0x6a, 0x95, 0x9a, 0x55, 0x62, 0xe0, 0x2f, 0x3a,
+	0x91, 0x98, 0x94, 0x12, 0x3c, 0x9b, 0x18, 0x6c, 0x0d, 0xd1, 0x16, 0xb1, 0x28, 0x52, 0xfe, 0x07,
+	0x50, 0xaa, 0x52, 0xd3, 0x9f, 0x5e, 0xf5, 0x77, 0xaa, 0xa1, 0x1d, 0xdd, 0x36, 0x8e, 0xcb, 0x93,
+	0x0f, 0xe0, 0xf8, 0xb6, 0xbe, 0x85, 0x8d, 0x10, 0x8d, 0x67, 0xca, 0x8b, 0xf7, 0xef, 0x2c, 0x9c,
+	0xe5, 0x34, 0x9f, 0xf8, 0x6b, 0x22, 0x7c, 0xdb, 0x91, 0x71, 0xed, 0xdd, 0x6c, 0x7b, 0x66, 0xc3,
+	0xf6, 0x44, 0x02, 0xc4, 0xc4, 0xf2, 0x22, 0x54, 0x6e, 0x00, 0xa8, 0xf4, 0x36, 0xc0, 0xf7, 0xa9,
+	0xd0, 0x81, 0xc3, 0x7a, 0x93, 0xb4, 0x2d, 0x56, 0x04, 0x33, 0x43, 0x73, 0xa3, 0x4b, 0x53, 0x3c,
+	0xef, 0xca, 0x4e, 0x7a, 0xfb, 0x37, 0xa1, 0x5c, 0x21, 0xd8, 0x5a, 0x59, 0xbb, 0xfb, 0x57, 0x29,
+	0xf7, 0xfb, 0xdf, 0xa5, 0x39, 0x13, 0xb3, 0xcd, 0x76, 0xbd, 0xdc, 0x20, 0x4d, 0x9e, 0xde, 0x6a,
+	0x40, 0x13, 0xeb, 0xb4, 0x10, 0x75, 0x01, 0xf4, 0xb7, 0x83, 0xdd, 0xf9, 0x13, 0xce, 0xb6, 0x8d,
+	0xce, 0x86, 0x73, 0x41, 0xe8, 0xed, 0x83, 0xdd, 0x79, 0x50, 0xe3, 0x1b, 0x2a, 0x7f, 0x00, 0x28,
+	0x07, 0x14, 0x0a, 0x93, 0x2a, 0xa4, 0xd9, 0xc4, 0x94, 0x62, 0x62, 0x25, 0xfb, 0x0b, 0x06, 0xf7,
+	0x37, 0x9c, 0x7e, 0x31, 0xea, 0x84, 0xf4, 0x0b, 0xa8, 0xeb, 0xea, 0x52, 0x6e, 0x01, 0x38, 0x9b,
+	0x2e, 0xfd, 0x69, 0x30, 0xf8, 0x87, 0x3c, 0x9c, 0xa8, 0x52, 0x73, 0xad, 0x6d, 0x19, 0x8e, 0xb0,
+	0xb6, 0x85, 0x59, 0xe7, 0x0a, 0x21, 0x5b, 0x4f, 0x50, 0x53, 0xe1, 0x0d, 0x38, 0x62, 0xa0, 0x16,
+	0xa1, 0x98, 0x11, 0x3b, 0xf3, 0xf9, 0xe8, 0x2e, 0xd5, 0xb4, 0xe0, 0xc9, 0x75, 0xc7, 0x9d, 0x13,
+	0x2b, 0x85, 0x4f, 0x2c, 0x16, 0xae, 0x22, 0xc3, 0xe9, 0xa4, 0x71, 0xf1, 0x56, 0xfc, 0x09, 0xe0,
+	0x58, 0x95, 0x9a, 0x1f, 0xb7, 0x0c, 0x9d, 0xa1, 0x2b, 0xba, 0xad, 0x37, 0xa9, 0xa3, 0x53, 0x6f,
+	0xb3, 0x4d, 0x62, 0x63, 0xd6, 0xc9, 0x7c, 0x18, 0xba, 0x4b, 0x0b, 0x6b, 0x70, 0xb8, 0xe5, 0x32,
+	0xb8, 0xc1, 0x8d, 0x2e, 0x9d, 0x2b, 0xa7, 0x54, 0x98, 0xb2, 0xb7, 0xd9, 0xca, 0x88, 0x63, 0x32,
+	0xf7, 0xc9, 0x43, 0x6b, 0x9a, 0x1b, 0xa7, 0xe0, 0x75, 0xe2, 0x3c, 0x1f, 0x88, 0x33, 0x54, 0x15,
+	0x22, 0xda, 0x95, 0x29, 0x38, 0x19, 0x19, 0x12, 0xa1, 0xde, 0xca, 0xbb, 0x55, 0x22, 0xe4, 0xc3,
+	0x7a, 0x0b, 0x59, 0xc6, 0xc0, 0x01, 0x4f, 0xc3, 0x11, 0x1b, 0x35, 0x70, 0x0b, 0x23, 0x8b, 0x79,
+	0x07, 0x5a, 0xeb, 0x0e, 0x04, 0x32, 0x6d, 0xe8, 0x31, 0x67, 0x9a, 0x76, 0x29, 0xee, 0xe0, 0x6c,
+	0xd4, 0x41, 0x35, 0xd1, 0x0b, 0x5e, 0x5d, 0xe2, 0x13, 0xc2, 0xc6, 0x87, 0x79, 0xf7, 0xe9, 0x5a,
+	0xf5, 0xd2, 0x50, 0x5c, 0x7f, 0xef, 0x6d, 0xa5, 0xee, 0x1d, 0x0b, 0x25, 0x3a, 0x38, 0x74, 0xa2,
+	0x1f, 0x77, 0x49, 0x79, 0x92, 0x27, 0xf0, 0x76, 0xef, 0x3b, 0xfb, 0x72, 0xd2, 0x49, 0x74, 0xed,
+	0xe4, 0x46, 0x2a, 0x73, 0x70, 0x36, 0x34, 0x1e, 0xb3, 0x59, 0x9c, 0xc8, 0xf5, 0x3c, 0x2c, 0x78,
+	0x1d, 0xc1, 0x72, 0x9b, 0x91, 0x0a, 0x69, 0xb6, 0x48, 0xdb, 0x7a, 0x5a, 0xeb, 0x7c, 0xa1, 0x08,
+	0x9f, 0x45, 0x96, 0x5e, 0xdf, 0x42, 0x46, 0x71, 0x68, 0x06, 0xcc, 0x3d, 0x57, 0xf3, 0x7f, 0xed,
+	0xb7, 0x41, 0x12, 0xde, 0x45, 0x02, 0x57, 0xa6, 0xa1, 0x14, 0x1f, 0x15, 0x6e, 0x3d, 0xc8, 0xc3,
+	0xd3, 0x81, 0xfa, 0xb5, 0x6c, 0x19, 0xbc, 0x3f, 0x40, 0xc7, 0xe5, 0xd8, 0xe7, 0xb0, 0x48, 0x49,
+	0xdb, 0x6e, 0xa0, 0x8d, 0x23, 0x18, 0x77, 0xda, 0xa3, 0x88, 0xce, 0x26, 0x1f, 0xc7, 0xd0, 0xe0,
+	0x6d, 0xc1, 0x7b, 0xd9, 0xa6, 0x9f, 0x4f, 0x32, 0x3d, 0xc1, 0x3f, 0xe5, 0x61, 0xb8, 0xab, 0x09,
+	0x4c, 0x89, 0x96, 0xe0, 0x6b, 0x38, 0xe2, 0xf7, 0xc5, 0xd6, 0xe3, 0xab, 0xc0, 0xdd, 0x3d, 0x0b,
+	0x2b, 0xce, 0xdb, 0xe4, 0x89, 0x32, 0x78, 0x9d, 0x4a, 0x11, 0x10, 0xa8, 0x4e, 0x5d, 0x98, 0x72,
+	0x2d, 0x0f, 0x8b, 0x5e, 0x86, 0xf1, 0xeb, 0xb8, 0x8a, 0x28, 0xc3, 0x96, 0xdb, 0x82, 0x1e, 0x57,
+	0x12, 0x7d, 0x04, 0x47, 0x8d, 0x2e, 0xab, 0xab, 0xf4, 0xf9, 0x25, 0x35, 0xb5, 0xa2, 0xc6, 0xc5,
+	0xd4, 0x82, 0x1c, 0xda, 0xfb, 0xd9, 0x47, 0xfd, 0x4a, 0x8f, 0xfb, 0x15, 0xa7, 0x56, 0x14, 0x38,
+	0xd3, 0x6b, 0xce, 0x3f, 0xed, 0xa5, 0xef, 0x46, 0xe1, 0x50, 0x95, 0x9a, 0x85, 0xef, 0x01, 0x2c,
+	0x24, 0x7c, 0x9d, 0x2d, 0xa5, 0x86, 0x93, 0xf8, 0x91, 0x23, 0x69, 0xfd, 0x63, 0x44, 0xf2, 0xfd,
+	0x0a, 0xe0, 0x64, 0xaf, 0xaf, 0xa2, 0x8b, 0x59, 0xbc, 0x3d, 0x80, 0xd2, 0x5b, 0x03, 0x02, 0x85,
+	0xaa, 0x1b, 0x00, 0x9e, 0x49, 0xfb, 0x10, 0xb8, 0x7c, 0xd8, 0x0d, 0x12, 0xc0, 0x52, 0xe5, 0x08,
+	0x60, 0xa1, 0xf0, 0x5b, 0x00, 0xc7, 0xe3, 0x9d, 0xf4, 0x62, 0x16, 0x75, 0x0c, 0x22, 0x5d, 0xea,
+	0x1b, 0x22, 0x34, 0xd8, 0xf0, 0x44, 0xa8, 0x49, 0x7d, 0x35, 0x8b, 0x2a, 0xb8, 0x5a, 0x7a, 0xad,
+	0x9f, 0xd5, 0x62, 0x4f, 0x27, 0x6d, 0x13, 0xda, 0xc5, 0xcc, 0xb4, 0x8d, 0x63, 0x24, 0xad, 0x7f,
+	0x4c, 0x28, 0x41, 0xd2, 0xda, 0xad, 0xcc, 0x04, 0x49, 0x01, 0x4b, 0x95, 0x23, 0x80, 0x85, 0xc2,
+	0xaf, 0xe0, 0x58, 0xb4, 0xfb, 0x50, 0x0f, 0x71, 0x4f, 0x83, 0x00, 0xe9, 0x62, 0x9f, 0x00, 0xb1,
+	0xf9, 0x8f, 0x00, 0xbe, 0x90, 0x54, 0xcd, 0x2f, 0x1c, 0x36, 0xf5, 0x03, 0x20, 0xe9, 0xf2, 0x00,
+	0x20, 0xa1, 0xe4, 0x3a, 0x80, 0xa7, 0x92, 0x8b, 0xc2, 0xeb, 0x87, 0x08, 0x2e, 0x0e, 0x93, 0xde,
+	0x1c, 0x08, 0xe6, 0xeb, 0x91, 0x9e, 0xf9, 0xc6, 0xa9, 0x5c, 0x2b, 0x1f, 0xde, 0xde, 0x93, 0xc1,
+	0xdd, 0x3d, 0x19, 0xdc, 0xdb, 0x93, 0xc1, 0x3f, 0x7b, 0x32, 0xf8, 0x65, 0x5f, 0xce, 0xdd, 0xdb,
+	0x97, 0x73, 0x0f, 0xf6, 0xe5, 0xdc, 0x67, 0x8b, 0xa9, 0xb5, 0xf5, 0x6a, 0xf8, 0x73, 0xcb, 0x2d,
+	0xb5, 0xf5, 0x61, 0xf7, 0xcf, 0x6e, 0x17, 0x1e, 0x0d, 0x00, 0x09, 0x71, 0x58, 0x6f, 0x68, 0x14,
+	0x00, 0x00,
 }
 
 func (this *MsgSetWithdrawAddressResponse) Equal(that interface{}) bool {
@@ -961,6 +1253,80 @@ func (this *MsgDepositValidatorRewardsPoolResponse) Equal(that interface{}) bool
 	}
 	return true
 }
+func (this *MsgSetAutoCompoundResponse) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*MsgSetAutoCompoundResponse)
+	if !ok {
+		that2, ok := that.(MsgSetAutoCompoundResponse)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	return true
+}
+func (this *MsgWithdrawAndDelegateResponse) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*MsgWithdrawAndDelegateResponse)
+	if !ok {
+		that2, ok := that.(MsgWithdrawAndDelegateResponse)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if len(this.Withdrawn) != len(that1.Withdrawn) {
+		return false
+	}
+	for i := range this.Withdrawn {
+		if !this.Withdrawn[i].Equal(&that1.Withdrawn[i]) {
+			return false
+		}
+	}
+	if !this.Delegated.Equal(&that1.Delegated) {
+		return false
+	}
+	return true
+}
+func (this *MsgSetRewardsDestinationResponse) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*MsgSetRewardsDestinationResponse)
+	if !ok {
+		that2, ok := that.(MsgSetRewardsDestinationResponse)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	return true
+}
 
 // Reference imports to suppress errors if they are not otherwise used.
 var _ context.Context
@@ -1003,6 +1369,21 @@ type MsgClient interface {
 	//
 	// Since: cosmos-sdk 0.48
 	DepositValidatorRewardsPool(ctx context.Context, in *MsgDepositValidatorRewardsPool, opts ...grpc.CallOption) (*MsgDepositValidatorRewardsPoolResponse, error)
+	// SetAutoCompound defines a method for a delegator to opt in or out of the
+	// automatic restaking of the rewards of a delegation.
+	SetAutoCompound(ctx context.Context, in *MsgSetAutoCompound, opts ...grpc.CallOption) (*MsgSetAutoCompoundResponse, error)
+	// WithdrawAndDelegate defines a method to withdraw the rewards of a
+	// delegator from one or all validators and delegate them in a single atomic
+	// message.
+	//
+	// Since: cosmos-sdk 0.48
+	WithdrawAndDelegate(ctx context.Context, in *MsgWithdrawAndDelegate, opts ...grpc.CallOption) (*MsgWithdrawAndDelegateResponse, error)
+	// SetRewardsDestination defines a method for a vesting account holder to
+	// credit its staking rewards to its spendable balance or to its vesting
+	// amount.
+	//
+	// Since: cosmos-sdk 0.48
+	SetRewardsDestination(ctx context.Context, in *MsgSetRewardsDestination, opts ...grpc.CallOption) (*MsgSetRewardsDestinationResponse, error)
 }
 
 type msgClient struct {
@@ -1076,6 +1457,33 @@ func (c *msgClient) DepositValidatorRewardsPool(ctx context.Context, in *MsgDepo
 	return out, nil
 }
 
+func (c *msgClient) SetAutoCompound(ctx context.Context, in *MsgSetAutoCompound, opts ...grpc.CallOption) (*MsgSetAutoCompoundResponse, error) {
+	out := new(MsgSetAutoCompoundResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.distribution.v1beta1.Msg/SetAutoCompound", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) WithdrawAndDelegate(ctx context.Context, in *MsgWithdrawAndDelegate, opts ...grpc.CallOption) (*MsgWithdrawAndDelegateResponse, error) {
+	out := new(MsgWithdrawAndDelegateResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.distribution.v1beta1.Msg/WithdrawAndDelegate", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) SetRewardsDestination(ctx context.Context, in *MsgSetRewardsDestination, opts ...grpc.CallOption) (*MsgSetRewardsDestinationResponse, error) {
+	out := new(MsgSetRewardsDestinationResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.distribution.v1beta1.Msg/SetRewardsDestination", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MsgServer is the server API for Msg service.
 type MsgServer interface {
 	// SetWithdrawAddress defines a method to change the withdraw address
@@ -1107,6 +1515,21 @@ type MsgServer interface {
 	//
 	// Since: cosmos-sdk 0.48
 	DepositValidatorRewardsPool(context.Context, *MsgDepositValidatorRewardsPool) (*MsgDepositValidatorRewardsPoolResponse, error)
+	// SetAutoCompound defines a method for a delegator to opt in or out of the
+	// automatic restaking of the rewards of a delegation.
+	SetAutoCompound(context.Context, *MsgSetAutoCompound) (*MsgSetAutoCompoundResponse, error)
+	// WithdrawAndDelegate defines a method to withdraw the rewards of a
+	// delegator from one or all validators and delegate them in a single atomic
+	// message.
+	//
+	// Since: cosmos-sdk 0.48
+	WithdrawAndDelegate(context.Context, *MsgWithdrawAndDelegate) (*MsgWithdrawAndDelegateResponse, error)
+	// SetRewardsDestination defines a method for a vesting account holder to
+	// credit its staking rewards to its spendable balance or to its vesting
+	// amount.
+	//
+	// Since: cosmos-sdk 0.48
+	SetRewardsDestination(context.Context, *MsgSetRewardsDestination) (*MsgSetRewardsDestinationResponse, error)
 }
 
 // UnimplementedMsgServer can be embedded to have forward compatible implementations.
@@ -1134,6 +1557,15 @@ func (*UnimplementedMsgServer) CommunityPoolSpend(ctx context.Context, req *MsgC
 func (*UnimplementedMsgServer) DepositValidatorRewardsPool(ctx context.Context, req *MsgDepositValidatorRewardsPool) (*MsgDepositValidatorRewardsPoolResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method DepositValidatorRewardsPool not implemented")
 }
+func (*UnimplementedMsgServer) SetAutoCompound(ctx context.Context, req *MsgSetAutoCompound) (*MsgSetAutoCompoundResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetAutoCompound not implemented")
+}
+func (*UnimplementedMsgServer) WithdrawAndDelegate(ctx context.Context, req *MsgWithdrawAndDelegate) (*MsgWithdrawAndDelegateResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method WithdrawAndDelegate not implemented")
+}
+func (*UnimplementedMsgServer) SetRewardsDestination(ctx context.Context, req *MsgSetRewardsDestination) (*MsgSetRewardsDestinationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetRewardsDestination not implemented")
+}
 
 func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
 	s.RegisterService(&_Msg_serviceDesc, srv)
@@ -1265,23 +1697,77 @@ func _Msg_DepositValidatorRewardsPool_Handler(srv interface{}, ctx context.Conte
 	return interceptor(ctx, in, info, handler)
 }
 
-var _Msg_serviceDesc = grpc.ServiceDesc{
-	ServiceName: "cosmos.distribution.v1beta1.Msg",
-	HandlerType: (*MsgServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "SetWithdrawAddress",
-			Handler:    _Msg_SetWithdrawAddress_Handler,
-		},
-		{
-			MethodName: "WithdrawDelegatorReward",
-			Handler:    _Msg_WithdrawDelegatorReward_Handler,
-		},
-		{
-			MethodName: "WithdrawValidatorCommission",
-			Handler:    _Msg_WithdrawValidatorCommission_Handler,
-		},
-		{
+func _Msg_SetAutoCompound_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSetAutoCompound)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SetAutoCompound(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.distribution.v1beta1.Msg/SetAutoCompound",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SetAutoCompound(ctx, req.(*MsgSetAutoCompound))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_WithdrawAndDelegate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgWithdrawAndDelegate)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).WithdrawAndDelegate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.distribution.v1beta1.Msg/WithdrawAndDelegate",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).WithdrawAndDelegate(ctx, req.(*MsgWithdrawAndDelegate))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_SetRewardsDestination_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSetRewardsDestination)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SetRewardsDestination(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.distribution.v1beta1.Msg/SetRewardsDestination",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SetRewardsDestination(ctx, req.(*MsgSetRewardsDestination))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _Msg_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "cosmos.distribution.v1beta1.Msg",
+	HandlerType: (*MsgServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "SetWithdrawAddress",
+			Handler:    _Msg_SetWithdrawAddress_Handler,
+		},
+		{
+			MethodName: "WithdrawDelegatorReward",
+			Handler:    _Msg_WithdrawDelegatorReward_Handler,
+		},
+		{
+			MethodName: "WithdrawValidatorCommission",
+			Handler:    _Msg_WithdrawValidatorCommission_Handler,
+		},
+		{
 			MethodName: "FundCommunityPool",
 			Handler:    _Msg_FundCommunityPool_Handler,
 		},
@@ -1297,6 +1783,18 @@ var _Msg_serviceDesc = grpc.ServiceDesc{
 			MethodName: "DepositValidatorRewardsPool",
 			Handler:    _Msg_DepositValidatorRewardsPool_Handler,
 		},
+		{
+			MethodName: "SetAutoCompound",
+			Handler:    _Msg_SetAutoCompound_Handler,
+		},
+		{
+			MethodName: "WithdrawAndDelegate",
+			Handler:    _Msg_WithdrawAndDelegate_Handler,
+		},
+		{
+			MethodName: "SetRewardsDestination",
+			Handler:    _Msg_SetRewardsDestination_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "cosmos/distribution/v1beta1/tx.proto",
@@ -1781,6 +2279,225 @@ func (m *MsgDepositValidatorRewardsPoolResponse) MarshalToSizedBuffer(dAtA []byt
 	return len(dAtA) - i, nil
 }
 
+func (m *MsgSetAutoCompound) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetAutoCompound) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetAutoCompound) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Enabled {
+		i--
+		if m.Enabled {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.ValidatorAddress) > 0 {
+		i -= len(m.ValidatorAddress)
+		copy(dAtA[i:], m.ValidatorAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ValidatorAddress)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.DelegatorAddress) > 0 {
+		i -= len(m.DelegatorAddress)
+		copy(dAtA[i:], m.DelegatorAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.DelegatorAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSetAutoCompoundResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetAutoCompoundResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetAutoCompoundResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgWithdrawAndDelegate) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgWithdrawAndDelegate) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgWithdrawAndDelegate) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ValidatorAddress) > 0 {
+		i -= len(m.ValidatorAddress)
+		copy(dAtA[i:], m.ValidatorAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ValidatorAddress)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.SourceValidatorAddress) > 0 {
+		i -= len(m.SourceValidatorAddress)
+		copy(dAtA[i:], m.SourceValidatorAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.SourceValidatorAddress)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.DelegatorAddress) > 0 {
+		i -= len(m.DelegatorAddress)
+		copy(dAtA[i:], m.DelegatorAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.DelegatorAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgWithdrawAndDelegateResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgWithdrawAndDelegateResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgWithdrawAndDelegateResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Delegated.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Withdrawn) > 0 {
+		for iNdEx := len(m.Withdrawn) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Withdrawn[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSetRewardsDestination) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetRewardsDestination) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetRewardsDestination) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Destination != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Destination))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.DelegatorAddress) > 0 {
+		i -= len(m.DelegatorAddress)
+		copy(dAtA[i:], m.DelegatorAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.DelegatorAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSetRewardsDestinationResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetRewardsDestinationResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetRewardsDestinationResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
 	offset -= sovTx(v)
 	base := offset
@@ -1994,16 +2711,108 @@ func (m *MsgDepositValidatorRewardsPoolResponse) Size() (n int) {
 	return n
 }
 
-func sovTx(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozTx(x uint64) (n int) {
-	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
-}
-func (m *MsgSetWithdrawAddress) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
+func (m *MsgSetAutoCompound) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.DelegatorAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.ValidatorAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Enabled {
+		n += 2
+	}
+	return n
+}
+
+func (m *MsgSetAutoCompoundResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgWithdrawAndDelegate) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.DelegatorAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.SourceValidatorAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.ValidatorAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgWithdrawAndDelegateResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Withdrawn) > 0 {
+		for _, e := range m.Withdrawn {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	l = m.Delegated.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgSetRewardsDestination) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.DelegatorAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Destination != 0 {
+		n += 1 + sovTx(uint64(m.Destination))
+	}
+	return n
+}
+
+func (m *MsgSetRewardsDestinationResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func sovTx(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozTx(x uint64) (n int) {
+	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *MsgSetWithdrawAddress) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
 		preIndex := iNdEx
 		var wire uint64
 		for shift := uint(0); ; shift += 7 {
@@ -2551,17 +3360,546 @@ func (m *MsgFundCommunityPool) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgFundCommunityPool: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgFundCommunityPool: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgFundCommunityPool: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Amount = append(m.Amount, types.Coin{})
+			if err := m.Amount[len(m.Amount)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Depositor", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Depositor = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgFundCommunityPoolResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgFundCommunityPoolResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgFundCommunityPoolResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdateParams: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdateParams: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Authority = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgUpdateParamsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdateParamsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdateParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgCommunityPoolSpend) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgCommunityPoolSpend: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgCommunityPoolSpend: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Authority = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Recipient", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Recipient = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Amount = append(m.Amount, types.Coin{})
+			if err := m.Amount[len(m.Amount)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgCommunityPoolSpendResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgCommunityPoolSpendResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgCommunityPoolSpendResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgDepositValidatorRewardsPool) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgDepositValidatorRewardsPool: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgFundCommunityPool: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgDepositValidatorRewardsPool: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Depositor", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -2571,29 +3909,27 @@ func (m *MsgFundCommunityPool) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Amount = append(m.Amount, types.Coin{})
-			if err := m.Amount[len(m.Amount)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Depositor = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Depositor", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddress", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2621,7 +3957,41 @@ func (m *MsgFundCommunityPool) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Depositor = string(dAtA[iNdEx:postIndex])
+			m.ValidatorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Amount = append(m.Amount, types.Coin{})
+			if err := m.Amount[len(m.Amount)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -2644,7 +4014,7 @@ func (m *MsgFundCommunityPool) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgFundCommunityPoolResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgDepositValidatorRewardsPoolResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2667,10 +4037,10 @@ func (m *MsgFundCommunityPoolResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgFundCommunityPoolResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgDepositValidatorRewardsPoolResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgFundCommunityPoolResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgDepositValidatorRewardsPoolResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -2694,7 +4064,7 @@ func (m *MsgFundCommunityPoolResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
+func (m *MsgSetAutoCompound) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2717,15 +4087,15 @@ func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgUpdateParams: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgSetAutoCompound: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgUpdateParams: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgSetAutoCompound: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DelegatorAddress", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2753,13 +4123,13 @@ func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Authority = string(dAtA[iNdEx:postIndex])
+			m.DelegatorAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddress", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -2769,25 +4139,44 @@ func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.ValidatorAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Enabled", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Enabled = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -2809,7 +4198,7 @@ func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgUpdateParamsResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgSetAutoCompoundResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2832,10 +4221,10 @@ func (m *MsgUpdateParamsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgUpdateParamsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgSetAutoCompoundResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgUpdateParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgSetAutoCompoundResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -2859,7 +4248,7 @@ func (m *MsgUpdateParamsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgCommunityPoolSpend) Unmarshal(dAtA []byte) error {
+func (m *MsgWithdrawAndDelegate) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2882,15 +4271,15 @@ func (m *MsgCommunityPoolSpend) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgCommunityPoolSpend: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgWithdrawAndDelegate: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgCommunityPoolSpend: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgWithdrawAndDelegate: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DelegatorAddress", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2918,11 +4307,11 @@ func (m *MsgCommunityPoolSpend) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Authority = string(dAtA[iNdEx:postIndex])
+			m.DelegatorAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Recipient", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SourceValidatorAddress", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2950,13 +4339,13 @@ func (m *MsgCommunityPoolSpend) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Recipient = string(dAtA[iNdEx:postIndex])
+			m.SourceValidatorAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddress", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -2966,25 +4355,23 @@ func (m *MsgCommunityPoolSpend) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Amount = append(m.Amount, types.Coin{})
-			if err := m.Amount[len(m.Amount)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.ValidatorAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -3007,7 +4394,7 @@ func (m *MsgCommunityPoolSpend) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgCommunityPoolSpendResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgWithdrawAndDelegateResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3030,12 +4417,79 @@ func (m *MsgCommunityPoolSpendResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgCommunityPoolSpendResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgWithdrawAndDelegateResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgCommunityPoolSpendResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgWithdrawAndDelegateResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Withdrawn", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Withdrawn = append(m.Withdrawn, types.Coin{})
+			if err := m.Withdrawn[len(m.Withdrawn)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Delegated", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Delegated.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -3057,7 +4511,7 @@ func (m *MsgCommunityPoolSpendResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgDepositValidatorRewardsPool) Unmarshal(dAtA []byte) error {
+func (m *MsgSetRewardsDestination) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3080,15 +4534,15 @@ func (m *MsgDepositValidatorRewardsPool) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgDepositValidatorRewardsPool: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgSetRewardsDestination: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgDepositValidatorRewardsPool: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgSetRewardsDestination: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Depositor", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DelegatorAddress", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3116,45 +4570,13 @@ func (m *MsgDepositValidatorRewardsPool) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Depositor = string(dAtA[iNdEx:postIndex])
+			m.DelegatorAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddress", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.ValidatorAddress = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Destination", wireType)
 			}
-			var msglen int
+			m.Destination = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -3164,26 +4586,11 @@ func (m *MsgDepositValidatorRewardsPool) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.Destination |= RewardsDestination(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Amount = append(m.Amount, types.Coin{})
-			if err := m.Amount[len(m.Amount)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -3205,7 +4612,7 @@ func (m *MsgDepositValidatorRewardsPool) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgDepositValidatorRewardsPoolResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgSetRewardsDestinationResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3228,10 +4635,10 @@ func (m *MsgDepositValidatorRewardsPoolResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgDepositValidatorRewardsPoolResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgSetRewardsDestinationResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgDepositValidatorRewardsPoolResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgSetRewardsDestinationResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -1,7 +1,9 @@
 package keeper
 
 import (
+	"encoding/hex"
 	"fmt"
+	"strings"
 
 	"cosmossdk.io/x/evidence/types"
 
@@ -119,7 +121,7 @@ func (k Keeper) handleEquivocationEvidence(ctx sdk.Context, evidence *types.Equi
 	// Jail the validator if not already jailed. This will begin unbonding the
 	// validator if not already unbonding (tombstoned).
 	if !validator.IsJailed() {
-		k.slashingKeeper.Jail(ctx, consAddr)
+		k.slashingKeeper.JailWithReason(ctx, consAddr, stakingtypes.JailReason_JAIL_REASON_DOUBLE_SIGN, strings.ToUpper(hex.EncodeToString(evidence.Hash())))
 	}
 
 	k.slashingKeeper.JailUntil(ctx, consAddr, types.DoubleSignJailEndTime)
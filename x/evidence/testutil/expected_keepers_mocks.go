@@ -158,6 +158,18 @@ func (mr *MockSlashingKeeperMockRecorder) JailUntil(arg0, arg1, arg2 interface{}
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "JailUntil", reflect.TypeOf((*MockSlashingKeeper)(nil).JailUntil), arg0, arg1, arg2)
 }
 
+// JailWithReason mocks base method.
+func (m *MockSlashingKeeper) JailWithReason(arg0 types0.Context, arg1 types0.ConsAddress, arg2 types1.JailReason, arg3 string) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "JailWithReason", arg0, arg1, arg2, arg3)
+}
+
+// JailWithReason indicates an expected call of JailWithReason.
+func (mr *MockSlashingKeeperMockRecorder) JailWithReason(arg0, arg1, arg2, arg3 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "JailWithReason", reflect.TypeOf((*MockSlashingKeeper)(nil).JailWithReason), arg0, arg1, arg2, arg3)
+}
+
 // Slash mocks base method.
 func (m *MockSlashingKeeper) Slash(arg0 types0.Context, arg1 types0.ConsAddress, arg2 math.LegacyDec, arg3, arg4 int64) {
 	m.ctrl.T.Helper()
@@ -31,6 +31,7 @@ type (
 		SlashWithInfractionReason(sdk.Context, sdk.ConsAddress, sdkmath.LegacyDec, int64, int64, stakingtypes.Infraction)
 		SlashFractionDoubleSign(sdk.Context) sdkmath.LegacyDec
 		Jail(sdk.Context, sdk.ConsAddress)
+		JailWithReason(sdk.Context, sdk.ConsAddress, stakingtypes.JailReason, string)
 		JailUntil(sdk.Context, sdk.ConsAddress, time.Time)
 	}
 
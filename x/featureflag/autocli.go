@@ -0,0 +1,40 @@
+package featureflag
+
+import (
+	"fmt"
+
+	autocliv1 "cosmossdk.io/api/cosmos/autocli/v1"
+	featureflagv1 "cosmossdk.io/api/cosmos/featureflag/v1"
+
+	"github.com/cosmos/cosmos-sdk/version"
+	"github.com/cosmos/cosmos-sdk/x/featureflag/types"
+)
+
+// AutoCLIOptions implements the autocli.HasAutoCLIConfig interface.
+func (am AppModule) AutoCLIOptions() *autocliv1.ModuleOptions {
+	return &autocliv1.ModuleOptions{
+		Query: &autocliv1.ServiceCommandDescriptor{
+			Service: featureflagv1.Query_ServiceDesc.ServiceName,
+			RpcCommandOptions: []*autocliv1.RpcCommandOptions{
+				{
+					RpcMethod: "Feature",
+					Use:       "feature [name]",
+					Short:     "Query a feature and whether it is active at the current height.",
+					Example:   fmt.Sprintf(`%s query %s feature <name>`, version.AppName, types.ModuleName),
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "name"},
+					},
+				},
+				{
+					RpcMethod: "Features",
+					Use:       "features",
+					Short:     "Query all the scheduled features.",
+					Example:   fmt.Sprintf(`%s query %s features`, version.AppName, types.ModuleName),
+				},
+			},
+		},
+		Tx: &autocliv1.ServiceCommandDescriptor{
+			Service: featureflagv1.Msg_ServiceDesc.ServiceName,
+		},
+	}
+}
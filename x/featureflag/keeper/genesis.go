@@ -0,0 +1,32 @@
+package keeper
+
+import (
+	"context"
+
+	"github.com/cosmos/cosmos-sdk/x/featureflag/types"
+)
+
+// InitGenesis initializes the featureflag module's state from a given genesis state.
+func (k Keeper) InitGenesis(ctx context.Context, gs *types.GenesisState) error {
+	for _, f := range gs.Features {
+		if err := k.Features.Set(ctx, f.Name, f); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// ExportGenesis returns the featureflag module's exported genesis.
+func (k Keeper) ExportGenesis(ctx context.Context) (*types.GenesisState, error) {
+	gs := types.DefaultGenesisState()
+	err := k.Features.Walk(ctx, nil, func(_ string, f types.Feature) bool {
+		gs.Features = append(gs.Features, f)
+		return false
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return gs, nil
+}
@@ -0,0 +1,65 @@
+package keeper
+
+import (
+	"context"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"github.com/cosmos/cosmos-sdk/x/featureflag/types"
+)
+
+var _ types.QueryServer = Querier{}
+
+// Querier is used as Keeper will have duplicate methods if used directly, and gRPC names take precedence over keeper.
+type Querier struct {
+	Keeper
+}
+
+// NewQuerier returns an implementation of the featureflag QueryServer interface
+// for the provided Keeper.
+func NewQuerier(keeper Keeper) Querier {
+	return Querier{Keeper: keeper}
+}
+
+// Feature queries a feature by name.
+func (k Querier) Feature(ctx context.Context, req *types.QueryFeatureRequest) (*types.QueryFeatureResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	if err := types.ValidateFeatureName(req.Name); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	feature, found := k.GetFeature(ctx, req.Name)
+	if !found {
+		return nil, status.Errorf(codes.NotFound, "feature %s not found", req.Name)
+	}
+
+	return &types.QueryFeatureResponse{
+		Feature: feature,
+		Active:  feature.IsActiveAt(sdk.UnwrapSDKContext(ctx).BlockHeight()),
+	}, nil
+}
+
+// Features queries all the scheduled features.
+func (k Querier) Features(ctx context.Context, req *types.QueryFeaturesRequest) (*types.QueryFeaturesResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	results, pageRes, err := query.CollectionPaginate[string, types.Feature](ctx, k.Keeper.Features, req.Pagination)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	features := make([]types.Feature, 0, len(results))
+	for _, r := range results {
+		features = append(features, r.Value)
+	}
+
+	return &types.QueryFeaturesResponse{Features: features, Pagination: pageRes}, nil
+}
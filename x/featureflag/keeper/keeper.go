@@ -0,0 +1,77 @@
+package keeper
+
+import (
+	"context"
+	"errors"
+
+	"cosmossdk.io/collections"
+	"cosmossdk.io/core/event"
+	storetypes "cosmossdk.io/core/store"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/featureflag/types"
+)
+
+// Keeper of the featureflag store
+type Keeper struct {
+	storeService storetypes.KVStoreService
+	event        event.Service
+
+	// the address capable of executing a MsgScheduleFeature or MsgCancelFeature message.
+	// Typically, this should be the x/gov module account.
+	authority string
+
+	Schema   collections.Schema
+	Features collections.Map[string, types.Feature]
+}
+
+// NewKeeper returns a new featureflag Keeper.
+func NewKeeper(cdc codec.BinaryCodec, storeService storetypes.KVStoreService, authority string, em event.Service) Keeper {
+	sb := collections.NewSchemaBuilder(storeService)
+	k := Keeper{
+		storeService: storeService,
+		event:        em,
+		authority:    authority,
+		Features:     collections.NewMap(sb, types.FeaturesPrefix, "features", collections.StringKey, codec.CollValue[types.Feature](cdc)),
+	}
+
+	schema, err := sb.Build()
+	if err != nil {
+		panic(err)
+	}
+	k.Schema = schema
+
+	return k
+}
+
+// GetAuthority returns the x/featureflag module's authority.
+func (k Keeper) GetAuthority() string {
+	return k.authority
+}
+
+// GetFeature returns the feature with the given name, if it has been scheduled.
+func (k Keeper) GetFeature(ctx context.Context, name string) (types.Feature, bool) {
+	feature, err := k.Features.Get(ctx, name)
+	if err != nil {
+		if !errors.Is(err, collections.ErrNotFound) {
+			panic(err)
+		}
+		return types.Feature{}, false
+	}
+
+	return feature, true
+}
+
+// IsActive returns true if the named feature has been scheduled and the
+// current block height has reached its activation height. Unknown features
+// are never active, which lets keepers gate new behavior on features that
+// governance has not scheduled yet.
+func (k Keeper) IsActive(ctx context.Context, name string) bool {
+	feature, found := k.GetFeature(ctx, name)
+	if !found {
+		return false
+	}
+
+	return feature.IsActiveAt(sdk.UnwrapSDKContext(ctx).BlockHeight())
+}
@@ -0,0 +1,170 @@
+package keeper_test
+
+import (
+	"testing"
+
+	cmtproto "github.com/cometbft/cometbft/proto/tendermint/types"
+	"github.com/stretchr/testify/suite"
+
+	storetypes "cosmossdk.io/store/types"
+
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	"github.com/cosmos/cosmos-sdk/runtime"
+	"github.com/cosmos/cosmos-sdk/testutil"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	moduletestutil "github.com/cosmos/cosmos-sdk/types/module/testutil"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	"github.com/cosmos/cosmos-sdk/x/featureflag/keeper"
+	"github.com/cosmos/cosmos-sdk/x/featureflag/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+)
+
+type KeeperTestSuite struct {
+	suite.Suite
+
+	ctx         sdk.Context
+	keeper      keeper.Keeper
+	msgServer   types.MsgServer
+	queryClient types.QueryClient
+	authority   string
+}
+
+func (s *KeeperTestSuite) SetupTest() {
+	key := storetypes.NewKVStoreKey(types.StoreKey)
+	testCtx := testutil.DefaultContextWithDB(s.T(), key, storetypes.NewTransientStoreKey("transient_test"))
+	encCfg := moduletestutil.MakeTestEncodingConfig()
+
+	s.authority = authtypes.NewModuleAddress(govtypes.ModuleName).String()
+	s.ctx = testCtx.Ctx.WithBlockHeader(cmtproto.Header{Height: 10})
+	s.keeper = keeper.NewKeeper(encCfg.Codec, runtime.NewKVStoreService(key), s.authority, runtime.EventService{})
+	s.msgServer = keeper.NewMsgServerImpl(s.keeper)
+
+	types.RegisterInterfaces(encCfg.InterfaceRegistry)
+	queryHelper := baseapp.NewQueryServerTestHelper(s.ctx, encCfg.InterfaceRegistry)
+	types.RegisterQueryServer(queryHelper, keeper.NewQuerier(s.keeper))
+	s.queryClient = types.NewQueryClient(queryHelper)
+}
+
+func TestKeeperTestSuite(t *testing.T) {
+	suite.Run(t, new(KeeperTestSuite))
+}
+
+func (s *KeeperTestSuite) TestScheduleFeature() {
+	testCases := []struct {
+		name   string
+		msg    *types.MsgScheduleFeature
+		expErr error
+	}{
+		{
+			name:   "invalid authority",
+			msg:    &types.MsgScheduleFeature{Authority: "invalid", Name: "foo", ActivationHeight: 20},
+			expErr: govtypes.ErrInvalidSigner,
+		},
+		{
+			name:   "empty name",
+			msg:    &types.MsgScheduleFeature{Authority: s.authority, Name: "", ActivationHeight: 20},
+			expErr: types.ErrInvalidFeatureName,
+		},
+		{
+			name:   "name with spaces",
+			msg:    &types.MsgScheduleFeature{Authority: s.authority, Name: "foo bar", ActivationHeight: 20},
+			expErr: types.ErrInvalidFeatureName,
+		},
+		{
+			name:   "activation height in the past",
+			msg:    &types.MsgScheduleFeature{Authority: s.authority, Name: "foo", ActivationHeight: 10},
+			expErr: types.ErrInvalidActivationHeight,
+		},
+		{
+			name: "success",
+			msg:  &types.MsgScheduleFeature{Authority: s.authority, Name: "foo", ActivationHeight: 20},
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			_, err := s.msgServer.ScheduleFeature(s.ctx, tc.msg)
+			if tc.expErr != nil {
+				s.Require().ErrorIs(err, tc.expErr)
+				return
+			}
+
+			s.Require().NoError(err)
+			feature, found := s.keeper.GetFeature(s.ctx, tc.msg.Name)
+			s.Require().True(found)
+			s.Require().Equal(tc.msg.ActivationHeight, feature.ActivationHeight)
+		})
+	}
+}
+
+func (s *KeeperTestSuite) TestIsActive() {
+	s.Require().False(s.keeper.IsActive(s.ctx, "foo"))
+
+	_, err := s.msgServer.ScheduleFeature(s.ctx, &types.MsgScheduleFeature{Authority: s.authority, Name: "foo", ActivationHeight: 20})
+	s.Require().NoError(err)
+
+	s.Require().False(s.keeper.IsActive(s.ctx.WithBlockHeight(19), "foo"))
+	s.Require().True(s.keeper.IsActive(s.ctx.WithBlockHeight(20), "foo"))
+	s.Require().True(s.keeper.IsActive(s.ctx.WithBlockHeight(21), "foo"))
+
+	// an active feature can neither be rescheduled nor cancelled
+	activeCtx := s.ctx.WithBlockHeight(20)
+	_, err = s.msgServer.ScheduleFeature(activeCtx, &types.MsgScheduleFeature{Authority: s.authority, Name: "foo", ActivationHeight: 30})
+	s.Require().ErrorIs(err, types.ErrFeatureAlreadyActive)
+	_, err = s.msgServer.CancelFeature(activeCtx, &types.MsgCancelFeature{Authority: s.authority, Name: "foo"})
+	s.Require().ErrorIs(err, types.ErrFeatureAlreadyActive)
+}
+
+func (s *KeeperTestSuite) TestCancelFeature() {
+	_, err := s.msgServer.CancelFeature(s.ctx, &types.MsgCancelFeature{Authority: s.authority, Name: "foo"})
+	s.Require().ErrorIs(err, types.ErrFeatureNotFound)
+
+	_, err = s.msgServer.ScheduleFeature(s.ctx, &types.MsgScheduleFeature{Authority: s.authority, Name: "foo", ActivationHeight: 20})
+	s.Require().NoError(err)
+
+	_, err = s.msgServer.CancelFeature(s.ctx, &types.MsgCancelFeature{Authority: "invalid", Name: "foo"})
+	s.Require().ErrorIs(err, govtypes.ErrInvalidSigner)
+
+	_, err = s.msgServer.CancelFeature(s.ctx, &types.MsgCancelFeature{Authority: s.authority, Name: "foo"})
+	s.Require().NoError(err)
+	s.Require().False(s.keeper.IsActive(s.ctx.WithBlockHeight(20), "foo"))
+}
+
+func (s *KeeperTestSuite) TestGRPCQueries() {
+	_, err := s.queryClient.Feature(s.ctx, &types.QueryFeatureRequest{Name: "foo"})
+	s.Require().Error(err)
+
+	_, err = s.msgServer.ScheduleFeature(s.ctx, &types.MsgScheduleFeature{Authority: s.authority, Name: "foo", ActivationHeight: 20})
+	s.Require().NoError(err)
+	_, err = s.msgServer.ScheduleFeature(s.ctx, &types.MsgScheduleFeature{Authority: s.authority, Name: "bar", ActivationHeight: 30})
+	s.Require().NoError(err)
+
+	res, err := s.queryClient.Feature(s.ctx, &types.QueryFeatureRequest{Name: "foo"})
+	s.Require().NoError(err)
+	s.Require().Equal(types.Feature{Name: "foo", ActivationHeight: 20}, res.Feature)
+	s.Require().False(res.Active)
+
+	all, err := s.queryClient.Features(s.ctx, &types.QueryFeaturesRequest{})
+	s.Require().NoError(err)
+	s.Require().Len(all.Features, 2)
+}
+
+func (s *KeeperTestSuite) TestGenesis() {
+	gs := &types.GenesisState{
+		Features: []types.Feature{
+			{Name: "bar", ActivationHeight: 5},
+			{Name: "foo", ActivationHeight: 20},
+		},
+	}
+	s.Require().NoError(gs.Validate())
+	s.Require().NoError(s.keeper.InitGenesis(s.ctx, gs))
+	s.Require().True(s.keeper.IsActive(s.ctx, "bar"))
+	s.Require().False(s.keeper.IsActive(s.ctx, "foo"))
+
+	exported, err := s.keeper.ExportGenesis(s.ctx)
+	s.Require().NoError(err)
+	s.Require().Equal(gs, exported)
+
+	gs.Features = append(gs.Features, types.Feature{Name: "foo", ActivationHeight: 30})
+	s.Require().ErrorIs(gs.Validate(), types.ErrDuplicateGenesisFeature)
+}
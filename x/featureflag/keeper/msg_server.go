@@ -0,0 +1,97 @@
+package keeper
+
+import (
+	"context"
+	"strconv"
+
+	"cosmossdk.io/core/event"
+	errorsmod "cosmossdk.io/errors"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/featureflag/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+)
+
+type msgServer struct {
+	Keeper
+}
+
+var _ types.MsgServer = msgServer{}
+
+// NewMsgServerImpl returns an implementation of the featureflag MsgServer interface
+// for the provided Keeper.
+func NewMsgServerImpl(keeper Keeper) types.MsgServer {
+	return &msgServer{Keeper: keeper}
+}
+
+// ScheduleFeature implements the Msg/ScheduleFeature method.
+func (k msgServer) ScheduleFeature(ctx context.Context, msg *types.MsgScheduleFeature) (*types.MsgScheduleFeatureResponse, error) {
+	if k.authority != msg.Authority {
+		return nil, errorsmod.Wrapf(govtypes.ErrInvalidSigner, "invalid authority; expected %s, got %s", k.authority, msg.Authority)
+	}
+
+	feature := types.Feature{Name: msg.Name, ActivationHeight: msg.ActivationHeight}
+	if err := feature.Validate(); err != nil {
+		return nil, err
+	}
+
+	height := sdk.UnwrapSDKContext(ctx).BlockHeight()
+	if msg.ActivationHeight <= height {
+		return nil, errorsmod.Wrapf(types.ErrInvalidActivationHeight, "activation height must be greater than current height %d", height)
+	}
+
+	// an active feature cannot be rescheduled, as modules may already have
+	// committed state relying on it.
+	if existing, found := k.GetFeature(ctx, msg.Name); found && existing.IsActiveAt(height) {
+		return nil, errorsmod.Wrap(types.ErrFeatureAlreadyActive, msg.Name)
+	}
+
+	if err := k.Features.Set(ctx, msg.Name, feature); err != nil {
+		return nil, err
+	}
+
+	if err := k.event.EventManager(ctx).EmitKV(
+		ctx,
+		types.EventTypeScheduleFeature,
+		event.Attribute{Key: types.AttributeKeyFeatureName, Value: msg.Name},
+		event.Attribute{Key: types.AttributeKeyActivationHeight, Value: strconv.FormatInt(msg.ActivationHeight, 10)},
+	); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgScheduleFeatureResponse{}, nil
+}
+
+// CancelFeature implements the Msg/CancelFeature method.
+func (k msgServer) CancelFeature(ctx context.Context, msg *types.MsgCancelFeature) (*types.MsgCancelFeatureResponse, error) {
+	if k.authority != msg.Authority {
+		return nil, errorsmod.Wrapf(govtypes.ErrInvalidSigner, "invalid authority; expected %s, got %s", k.authority, msg.Authority)
+	}
+
+	if err := types.ValidateFeatureName(msg.Name); err != nil {
+		return nil, err
+	}
+
+	existing, found := k.GetFeature(ctx, msg.Name)
+	if !found {
+		return nil, errorsmod.Wrap(types.ErrFeatureNotFound, msg.Name)
+	}
+
+	if existing.IsActiveAt(sdk.UnwrapSDKContext(ctx).BlockHeight()) {
+		return nil, errorsmod.Wrap(types.ErrFeatureAlreadyActive, msg.Name)
+	}
+
+	if err := k.Features.Remove(ctx, msg.Name); err != nil {
+		return nil, err
+	}
+
+	if err := k.event.EventManager(ctx).EmitKV(
+		ctx,
+		types.EventTypeCancelFeature,
+		event.Attribute{Key: types.AttributeKeyFeatureName, Value: msg.Name},
+	); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgCancelFeatureResponse{}, nil
+}
@@ -0,0 +1,12 @@
+package types
+
+import "cosmossdk.io/errors"
+
+// x/featureflag module sentinel errors
+var (
+	ErrInvalidFeatureName      = errors.Register(ModuleName, 2, "invalid feature name")
+	ErrInvalidActivationHeight = errors.Register(ModuleName, 3, "invalid activation height")
+	ErrFeatureNotFound         = errors.Register(ModuleName, 4, "feature not found")
+	ErrFeatureAlreadyActive    = errors.Register(ModuleName, 5, "feature already active")
+	ErrDuplicateGenesisFeature = errors.Register(ModuleName, 6, "duplicate feature in genesis")
+)
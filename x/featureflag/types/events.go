@@ -0,0 +1,10 @@
+package types
+
+// featureflag module event types
+const (
+	EventTypeScheduleFeature = "schedule_feature"
+	EventTypeCancelFeature   = "cancel_feature"
+
+	AttributeKeyFeatureName      = "name"
+	AttributeKeyActivationHeight = "activation_height"
+)
@@ -0,0 +1,48 @@
+package types
+
+import (
+	"unicode"
+
+	errorsmod "cosmossdk.io/errors"
+)
+
+// MaxFeatureNameLength is the maximum length of a feature name.
+const MaxFeatureNameLength = 64
+
+// ValidateFeatureName checks that a feature name is non-empty, not too long
+// and only made of printable, non-space characters.
+func ValidateFeatureName(name string) error {
+	if len(name) == 0 {
+		return errorsmod.Wrap(ErrInvalidFeatureName, "name cannot be empty")
+	}
+
+	if len(name) > MaxFeatureNameLength {
+		return errorsmod.Wrapf(ErrInvalidFeatureName, "name is longer than %d characters", MaxFeatureNameLength)
+	}
+
+	for _, r := range name {
+		if !unicode.IsPrint(r) || unicode.IsSpace(r) {
+			return errorsmod.Wrapf(ErrInvalidFeatureName, "name %q contains invalid characters", name)
+		}
+	}
+
+	return nil
+}
+
+// Validate performs a stateless validation of the feature.
+func (f Feature) Validate() error {
+	if err := ValidateFeatureName(f.Name); err != nil {
+		return err
+	}
+
+	if f.ActivationHeight <= 0 {
+		return errorsmod.Wrapf(ErrInvalidActivationHeight, "feature %s: activation height must be positive", f.Name)
+	}
+
+	return nil
+}
+
+// IsActiveAt returns true if the feature is active at the given block height.
+func (f Feature) IsActiveAt(height int64) bool {
+	return height >= f.ActivationHeight
+}
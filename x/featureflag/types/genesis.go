@@ -0,0 +1,25 @@
+package types
+
+import errorsmod "cosmossdk.io/errors"
+
+// DefaultGenesisState returns the default genesis state, which has no features.
+func DefaultGenesisState() *GenesisState {
+	return &GenesisState{}
+}
+
+// Validate performs basic genesis state validation.
+func (gs GenesisState) Validate() error {
+	seen := make(map[string]struct{}, len(gs.Features))
+	for _, f := range gs.Features {
+		if err := f.Validate(); err != nil {
+			return err
+		}
+
+		if _, ok := seen[f.Name]; ok {
+			return errorsmod.Wrap(ErrDuplicateGenesisFeature, f.Name)
+		}
+		seen[f.Name] = struct{}{}
+	}
+
+	return nil
+}
@@ -0,0 +1,14 @@
+package types
+
+import "cosmossdk.io/collections"
+
+const (
+	// ModuleName defines the name of the x/featureflag module.
+	ModuleName = "featureflag"
+
+	// StoreKey defines the module's store key.
+	StoreKey = ModuleName
+)
+
+// FeaturesPrefix is the prefix under which features are stored, indexed by name.
+var FeaturesPrefix = collections.NewPrefix(0)
@@ -0,0 +1,1107 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: cosmos/featureflag/v1/query.proto
+
+package types
+
+import (
+	context "context"
+	fmt "fmt"
+	query "github.com/cosmos/cosmos-sdk/types/query"
+	_ "github.com/cosmos/cosmos-sdk/types/tx/amino"
+	_ "github.com/cosmos/gogoproto/gogoproto"
+	grpc1 "github.com/cosmos/gogoproto/grpc"
+	proto "github.com/cosmos/gogoproto/proto"
+	_ "google.golang.org/genproto/googleapis/api/annotations"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// QueryFeatureRequest is the request type for the Query/Feature RPC method.
+type QueryFeatureRequest struct {
+	// name is the name of the feature to query.
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+}
+
+func (m *QueryFeatureRequest) Reset()         { *m = QueryFeatureRequest{} }
+func (m *QueryFeatureRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryFeatureRequest) ProtoMessage()    {}
+func (*QueryFeatureRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d22a197b71d492bb, []int{0}
+}
+func (m *QueryFeatureRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryFeatureRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryFeatureRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryFeatureRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryFeatureRequest.Merge(m, src)
+}
+func (m *QueryFeatureRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryFeatureRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryFeatureRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryFeatureRequest proto.InternalMessageInfo
+
+func (m *QueryFeatureRequest) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+// QueryFeatureResponse is the response type for the Query/Feature RPC method.
+type QueryFeatureResponse struct {
+	// feature is the requested feature.
+	Feature Feature `protobuf:"bytes,1,opt,name=feature,proto3" json:"feature"`
+	// active is true if the feature is active at the current block height.
+	Active bool `protobuf:"varint,2,opt,name=active,proto3" json:"active,omitempty"`
+}
+
+func (m *QueryFeatureResponse) Reset()         { *m = QueryFeatureResponse{} }
+func (m *QueryFeatureResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryFeatureResponse) ProtoMessage()    {}
+func (*QueryFeatureResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d22a197b71d492bb, []int{1}
+}
+func (m *QueryFeatureResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryFeatureResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryFeatureResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryFeatureResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryFeatureResponse.Merge(m, src)
+}
+func (m *QueryFeatureResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryFeatureResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryFeatureResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryFeatureResponse proto.InternalMessageInfo
+
+func (m *QueryFeatureResponse) GetFeature() Feature {
+	if m != nil {
+		return m.Feature
+	}
+	return Feature{}
+}
+
+func (m *QueryFeatureResponse) GetActive() bool {
+	if m != nil {
+		return m.Active
+	}
+	return false
+}
+
+// QueryFeaturesRequest is the request type for the Query/Features RPC method.
+type QueryFeaturesRequest struct {
+	// pagination defines an optional pagination for the request.
+	Pagination *query.PageRequest `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryFeaturesRequest) Reset()         { *m = QueryFeaturesRequest{} }
+func (m *QueryFeaturesRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryFeaturesRequest) ProtoMessage()    {}
+func (*QueryFeaturesRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d22a197b71d492bb, []int{2}
+}
+func (m *QueryFeaturesRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryFeaturesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryFeaturesRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryFeaturesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryFeaturesRequest.Merge(m, src)
+}
+func (m *QueryFeaturesRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryFeaturesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryFeaturesRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryFeaturesRequest proto.InternalMessageInfo
+
+func (m *QueryFeaturesRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+// QueryFeaturesResponse is the response type for the Query/Features RPC method.
+type QueryFeaturesResponse struct {
+	// features are the scheduled features.
+	Features []Feature `protobuf:"bytes,1,rep,name=features,proto3" json:"features"`
+	// pagination defines the pagination in the response.
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryFeaturesResponse) Reset()         { *m = QueryFeaturesResponse{} }
+func (m *QueryFeaturesResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryFeaturesResponse) ProtoMessage()    {}
+func (*QueryFeaturesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d22a197b71d492bb, []int{3}
+}
+func (m *QueryFeaturesResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryFeaturesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryFeaturesResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryFeaturesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryFeaturesResponse.Merge(m, src)
+}
+func (m *QueryFeaturesResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryFeaturesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryFeaturesResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryFeaturesResponse proto.InternalMessageInfo
+
+func (m *QueryFeaturesResponse) GetFeatures() []Feature {
+	if m != nil {
+		return m.Features
+	}
+	return nil
+}
+
+func (m *QueryFeaturesResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterType((*QueryFeatureRequest)(nil), "cosmos.featureflag.v1.QueryFeatureRequest")
+	proto.RegisterType((*QueryFeatureResponse)(nil), "cosmos.featureflag.v1.QueryFeatureResponse")
+	proto.RegisterType((*QueryFeaturesRequest)(nil), "cosmos.featureflag.v1.QueryFeaturesRequest")
+	proto.RegisterType((*QueryFeaturesResponse)(nil), "cosmos.featureflag.v1.QueryFeaturesResponse")
+}
+
+func init() { proto.RegisterFile("cosmos/featureflag/v1/query.proto", fileDescriptor_d22a197b71d492bb) }
+
+var fileDescriptor_d22a197b71d492bb = []byte{
+	// 456 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x93, 0xc1, 0x6e, 0xd3, 0x30,
+	0x18, 0xc7, 0xe3, 0x00, 0x5b, 0x67, 0x4e, 0x98, 0x0d, 0x55, 0x11, 0xca, 0xba, 0x1c, 0xd6, 0x52,
+	0xc0, 0x26, 0xe3, 0x0d, 0x86, 0x18, 0x12, 0x27, 0xc8, 0x91, 0x03, 0x92, 0x53, 0x3c, 0x13, 0xb1,
+	0xc4, 0x69, 0xed, 0x44, 0x54, 0x88, 0x0b, 0x0f, 0x80, 0x10, 0x3c, 0x04, 0x5c, 0x90, 0x78, 0x8c,
+	0x1e, 0x2b, 0x71, 0xe1, 0x84, 0x50, 0x8b, 0xc4, 0x6b, 0xa0, 0xd8, 0x0e, 0x24, 0xa8, 0xd0, 0x72,
+	0x49, 0x9c, 0xf8, 0xff, 0x7d, 0xff, 0xdf, 0xf7, 0x7d, 0x36, 0x3c, 0x18, 0x09, 0x99, 0x0a, 0x49,
+	0x4e, 0x19, 0x55, 0xc5, 0x84, 0x9d, 0x9e, 0x51, 0x4e, 0xca, 0x90, 0x8c, 0x0b, 0x36, 0x99, 0xe2,
+	0x7c, 0x22, 0x94, 0x40, 0x7b, 0x46, 0x82, 0x1b, 0x12, 0x5c, 0x86, 0xde, 0x2e, 0x17, 0x5c, 0x68,
+	0x05, 0xa9, 0x56, 0x46, 0xec, 0x5d, 0xa2, 0x69, 0x92, 0x09, 0xa2, 0x9f, 0xf6, 0xd7, 0x55, 0x2e,
+	0x04, 0x3f, 0x63, 0x84, 0xe6, 0x09, 0xa1, 0x59, 0x26, 0x14, 0x55, 0x89, 0xc8, 0xa4, 0xdd, 0x1d,
+	0x5a, 0x80, 0x98, 0x4a, 0x66, 0x6c, 0x49, 0x19, 0xc6, 0x4c, 0xd1, 0x90, 0xe4, 0x94, 0x27, 0x99,
+	0x16, 0x5b, 0x6d, 0x7f, 0x35, 0x6c, 0x13, 0x4c, 0x0b, 0x83, 0x6b, 0xf0, 0xf2, 0xc3, 0x2a, 0xd5,
+	0x89, 0xd9, 0x89, 0xd8, 0xb8, 0x60, 0x52, 0x21, 0x04, 0xcf, 0x67, 0x34, 0x65, 0x5d, 0xd0, 0x03,
+	0x83, 0x9d, 0x48, 0xaf, 0x03, 0x09, 0x77, 0xdb, 0x52, 0x99, 0x8b, 0x4c, 0x32, 0x74, 0x07, 0x6e,
+	0xdb, 0xbc, 0x5a, 0x7e, 0xf1, 0xc8, 0xc7, 0x2b, 0xfb, 0x80, 0x6d, 0xe0, 0xf1, 0xce, 0xec, 0xeb,
+	0xbe, 0xf3, 0xe1, 0xc7, 0xa7, 0x21, 0x88, 0xea, 0x48, 0x74, 0x05, 0x6e, 0xd1, 0x91, 0x4a, 0x4a,
+	0xd6, 0x75, 0x7b, 0x60, 0xd0, 0x89, 0xec, 0x57, 0xf0, 0xb8, 0x6d, 0x2a, 0x6b, 0xc0, 0x13, 0x08,
+	0x7f, 0x17, 0x6d, 0x7d, 0x0f, 0x6b, 0xdf, 0xaa, 0x43, 0xd8, 0x0c, 0xc6, 0x76, 0x08, 0x3f, 0xa0,
+	0xbc, 0x2e, 0x2e, 0x6a, 0x44, 0x06, 0xef, 0x01, 0xdc, 0xfb, 0xc3, 0xc0, 0x96, 0x75, 0x17, 0x76,
+	0x2c, 0x9c, 0xec, 0x82, 0xde, 0xb9, 0xff, 0xab, 0xeb, 0x57, 0x28, 0xba, 0xd7, 0x02, 0x75, 0x35,
+	0x68, 0x7f, 0x2d, 0xa8, 0x61, 0x68, 0x92, 0x1e, 0x7d, 0x74, 0xe1, 0x05, 0x4d, 0x8a, 0xde, 0x02,
+	0xb8, 0x6d, 0x3d, 0xd1, 0xf0, 0x2f, 0x4c, 0x2b, 0x86, 0xea, 0x5d, 0xdf, 0x48, 0x6b, 0xac, 0x03,
+	0xfc, 0xea, 0xf3, 0xf7, 0x77, 0xee, 0x00, 0x1d, 0x92, 0x7f, 0x1e, 0x25, 0x49, 0x5e, 0x54, 0x87,
+	0xe3, 0x25, 0x7a, 0x0d, 0x60, 0xa7, 0xee, 0x21, 0xda, 0xc4, 0xa9, 0x1e, 0xa5, 0x77, 0x63, 0x33,
+	0xb1, 0xe5, 0xea, 0x6b, 0xae, 0x03, 0xb4, 0xbf, 0x86, 0xeb, 0xf8, 0xfe, 0x6c, 0xe1, 0x83, 0xf9,
+	0xc2, 0x07, 0xdf, 0x16, 0x3e, 0x78, 0xb3, 0xf4, 0x9d, 0xf9, 0xd2, 0x77, 0xbe, 0x2c, 0x7d, 0xe7,
+	0xd1, 0x2d, 0x9e, 0xa8, 0xa7, 0x45, 0x8c, 0x47, 0x22, 0xad, 0x93, 0x98, 0xd7, 0x4d, 0xf9, 0xe4,
+	0x19, 0x79, 0xde, 0xca, 0xa8, 0xa6, 0x39, 0x93, 0xf1, 0x96, 0xbe, 0x2c, 0xb7, 0x7f, 0x0e, 0x00,
+	0xf8, 0x77, 0xaa, 0xf5, 0x04, 0x04, 0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// QueryClient is the client API for Query service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type QueryClient interface {
+	// Feature queries a feature by its name, along with whether it is active at
+	// the current block height.
+	Feature(ctx context.Context, in *QueryFeatureRequest, opts ...grpc.CallOption) (*QueryFeatureResponse, error)
+	// Features queries all the scheduled features.
+	Features(ctx context.Context, in *QueryFeaturesRequest, opts ...grpc.CallOption) (*QueryFeaturesResponse, error)
+}
+
+type queryClient struct {
+	cc grpc1.ClientConn
+}
+
+func NewQueryClient(cc grpc1.ClientConn) QueryClient {
+	return &queryClient{cc}
+}
+
+func (c *queryClient) Feature(ctx context.Context, in *QueryFeatureRequest, opts ...grpc.CallOption) (*QueryFeatureResponse, error) {
+	out := new(QueryFeatureResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.featureflag.v1.Query/Feature", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) Features(ctx context.Context, in *QueryFeaturesRequest, opts ...grpc.CallOption) (*QueryFeaturesResponse, error) {
+	out := new(QueryFeaturesResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.featureflag.v1.Query/Features", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// QueryServer is the server API for Query service.
+type QueryServer interface {
+	// Feature queries a feature by its name, along with whether it is active at
+	// the current block height.
+	Feature(context.Context, *QueryFeatureRequest) (*QueryFeatureResponse, error)
+	// Features queries all the scheduled features.
+	Features(context.Context, *QueryFeaturesRequest) (*QueryFeaturesResponse, error)
+}
+
+// UnimplementedQueryServer can be embedded to have forward compatible implementations.
+type UnimplementedQueryServer struct {
+}
+
+func (*UnimplementedQueryServer) Feature(ctx context.Context, req *QueryFeatureRequest) (*QueryFeatureResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Feature not implemented")
+}
+func (*UnimplementedQueryServer) Features(ctx context.Context, req *QueryFeaturesRequest) (*QueryFeaturesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Features not implemented")
+}
+
+func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
+	s.RegisterService(&_Query_serviceDesc, srv)
+}
+
+func _Query_Feature_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryFeatureRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Feature(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.featureflag.v1.Query/Feature",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Feature(ctx, req.(*QueryFeatureRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_Features_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryFeaturesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Features(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.featureflag.v1.Query/Features",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Features(ctx, req.(*QueryFeaturesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _Query_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "cosmos.featureflag.v1.Query",
+	HandlerType: (*QueryServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Feature",
+			Handler:    _Query_Feature_Handler,
+		},
+		{
+			MethodName: "Features",
+			Handler:    _Query_Features_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "cosmos/featureflag/v1/query.proto",
+}
+
+func (m *QueryFeatureRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryFeatureRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryFeatureRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryFeatureResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryFeatureResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryFeatureResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Active {
+		i--
+		if m.Active {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x10
+	}
+	{
+		size, err := m.Feature.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryFeaturesRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryFeaturesRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryFeaturesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryFeaturesResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryFeaturesResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryFeaturesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Features) > 0 {
+		for iNdEx := len(m.Features) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Features[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
+	offset -= sovQuery(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *QueryFeatureRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryFeatureResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Feature.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	if m.Active {
+		n += 2
+	}
+	return n
+}
+
+func (m *QueryFeaturesRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryFeaturesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Features) > 0 {
+		for _, e := range m.Features {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func sovQuery(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozQuery(x uint64) (n int) {
+	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *QueryFeatureRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryFeatureRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryFeatureRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryFeatureResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryFeatureResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryFeatureResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Feature", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Feature.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Active", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Active = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryFeaturesRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryFeaturesRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryFeaturesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryFeaturesResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryFeaturesResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryFeaturesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Features", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Features = append(m.Features, Feature{})
+			if err := m.Features[len(m.Features)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipQuery(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthQuery
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupQuery
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthQuery
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthQuery        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowQuery          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupQuery = fmt.Errorf("proto: unexpected end of group")
+)
@@ -0,0 +1,1021 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: cosmos/featureflag/v1/tx.proto
+
+package types
+
+import (
+	context "context"
+	fmt "fmt"
+	_ "github.com/cosmos/cosmos-proto"
+	_ "github.com/cosmos/cosmos-sdk/types/msgservice"
+	_ "github.com/cosmos/cosmos-sdk/types/tx/amino"
+	grpc1 "github.com/cosmos/gogoproto/grpc"
+	proto "github.com/cosmos/gogoproto/proto"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// MsgScheduleFeature is the Msg/ScheduleFeature request type.
+type MsgScheduleFeature struct {
+	// authority is the address that controls the module (defaults to x/gov unless overwritten).
+	Authority string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
+	// name is the name of the feature to schedule.
+	Name string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	// activation_height is the first block height at which the feature is active.
+	// It must be greater than the current block height.
+	ActivationHeight int64 `protobuf:"varint,3,opt,name=activation_height,json=activationHeight,proto3" json:"activation_height,omitempty"`
+}
+
+func (m *MsgScheduleFeature) Reset()         { *m = MsgScheduleFeature{} }
+func (m *MsgScheduleFeature) String() string { return proto.CompactTextString(m) }
+func (*MsgScheduleFeature) ProtoMessage()    {}
+func (*MsgScheduleFeature) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4917a2f76112059a, []int{0}
+}
+func (m *MsgScheduleFeature) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgScheduleFeature) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgScheduleFeature.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgScheduleFeature) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgScheduleFeature.Merge(m, src)
+}
+func (m *MsgScheduleFeature) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgScheduleFeature) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgScheduleFeature.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgScheduleFeature proto.InternalMessageInfo
+
+func (m *MsgScheduleFeature) GetAuthority() string {
+	if m != nil {
+		return m.Authority
+	}
+	return ""
+}
+
+func (m *MsgScheduleFeature) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *MsgScheduleFeature) GetActivationHeight() int64 {
+	if m != nil {
+		return m.ActivationHeight
+	}
+	return 0
+}
+
+// MsgScheduleFeatureResponse defines the response structure for executing a
+// MsgScheduleFeature message.
+type MsgScheduleFeatureResponse struct {
+}
+
+func (m *MsgScheduleFeatureResponse) Reset()         { *m = MsgScheduleFeatureResponse{} }
+func (m *MsgScheduleFeatureResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgScheduleFeatureResponse) ProtoMessage()    {}
+func (*MsgScheduleFeatureResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4917a2f76112059a, []int{1}
+}
+func (m *MsgScheduleFeatureResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgScheduleFeatureResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgScheduleFeatureResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgScheduleFeatureResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgScheduleFeatureResponse.Merge(m, src)
+}
+func (m *MsgScheduleFeatureResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgScheduleFeatureResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgScheduleFeatureResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgScheduleFeatureResponse proto.InternalMessageInfo
+
+// MsgCancelFeature is the Msg/CancelFeature request type.
+type MsgCancelFeature struct {
+	// authority is the address that controls the module (defaults to x/gov unless overwritten).
+	Authority string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
+	// name is the name of the feature to cancel.
+	Name string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+}
+
+func (m *MsgCancelFeature) Reset()         { *m = MsgCancelFeature{} }
+func (m *MsgCancelFeature) String() string { return proto.CompactTextString(m) }
+func (*MsgCancelFeature) ProtoMessage()    {}
+func (*MsgCancelFeature) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4917a2f76112059a, []int{2}
+}
+func (m *MsgCancelFeature) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgCancelFeature) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgCancelFeature.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgCancelFeature) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgCancelFeature.Merge(m, src)
+}
+func (m *MsgCancelFeature) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgCancelFeature) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgCancelFeature.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgCancelFeature proto.InternalMessageInfo
+
+func (m *MsgCancelFeature) GetAuthority() string {
+	if m != nil {
+		return m.Authority
+	}
+	return ""
+}
+
+func (m *MsgCancelFeature) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+// MsgCancelFeatureResponse defines the response structure for executing a
+// MsgCancelFeature message.
+type MsgCancelFeatureResponse struct {
+}
+
+func (m *MsgCancelFeatureResponse) Reset()         { *m = MsgCancelFeatureResponse{} }
+func (m *MsgCancelFeatureResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgCancelFeatureResponse) ProtoMessage()    {}
+func (*MsgCancelFeatureResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4917a2f76112059a, []int{3}
+}
+func (m *MsgCancelFeatureResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgCancelFeatureResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgCancelFeatureResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgCancelFeatureResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgCancelFeatureResponse.Merge(m, src)
+}
+func (m *MsgCancelFeatureResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgCancelFeatureResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgCancelFeatureResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgCancelFeatureResponse proto.InternalMessageInfo
+
+func init() {
+	proto.RegisterType((*MsgScheduleFeature)(nil), "cosmos.featureflag.v1.MsgScheduleFeature")
+	proto.RegisterType((*MsgScheduleFeatureResponse)(nil), "cosmos.featureflag.v1.MsgScheduleFeatureResponse")
+	proto.RegisterType((*MsgCancelFeature)(nil), "cosmos.featureflag.v1.MsgCancelFeature")
+	proto.RegisterType((*MsgCancelFeatureResponse)(nil), "cosmos.featureflag.v1.MsgCancelFeatureResponse")
+}
+
+func init() { proto.RegisterFile("cosmos/featureflag/v1/tx.proto", fileDescriptor_4917a2f76112059a) }
+
+var fileDescriptor_4917a2f76112059a = []byte{
+	// 402 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x53, 0x31, 0x6f, 0xda, 0x40,
+	0x18, 0xe5, 0x4a, 0x5b, 0x89, 0x93, 0xaa, 0xc2, 0xa9, 0x55, 0x5d, 0xb7, 0xb5, 0x90, 0x97, 0x52,
+	0x2a, 0x7c, 0xd0, 0x4a, 0x1d, 0xd8, 0x4a, 0xa5, 0xaa, 0xaa, 0xc4, 0x62, 0xb6, 0x2e, 0xe8, 0xb0,
+	0x8f, 0xf3, 0xa9, 0xd8, 0x87, 0x7c, 0x67, 0x04, 0x5b, 0x94, 0x31, 0x43, 0x94, 0x9f, 0xc2, 0x90,
+	0x3d, 0x6b, 0x46, 0x94, 0x29, 0x63, 0x04, 0x03, 0xf9, 0x19, 0x51, 0x6c, 0x23, 0x30, 0x4e, 0x24,
+	0x96, 0x2c, 0x96, 0xfd, 0xbd, 0x77, 0xef, 0xbd, 0xef, 0xf3, 0x77, 0xd0, 0x70, 0x84, 0xf4, 0x85,
+	0xc4, 0x43, 0x4a, 0x54, 0x14, 0xd2, 0xe1, 0x88, 0x30, 0x3c, 0x69, 0x61, 0x35, 0xb5, 0xc6, 0xa1,
+	0x50, 0x02, 0xbd, 0x4d, 0x70, 0x6b, 0x07, 0xb7, 0x26, 0x2d, 0xbd, 0x42, 0x7c, 0x1e, 0x08, 0x1c,
+	0x3f, 0x13, 0xa6, 0xfe, 0x3e, 0x61, 0xf6, 0xe3, 0x2f, 0x9c, 0x1e, 0x4b, 0xa0, 0x77, 0xa9, 0x89,
+	0x2f, 0x63, 0x71, 0x5f, 0xb2, 0x04, 0x30, 0x2f, 0x00, 0x44, 0x5d, 0xc9, 0x7a, 0x8e, 0x47, 0xdd,
+	0x68, 0x44, 0x7f, 0x27, 0x26, 0xe8, 0x07, 0x2c, 0x91, 0x48, 0x79, 0x22, 0xe4, 0x6a, 0xa6, 0x81,
+	0x2a, 0xa8, 0x95, 0x3a, 0xda, 0xd5, 0x79, 0xe3, 0x4d, 0x2a, 0xfa, 0xd3, 0x75, 0x43, 0x2a, 0x65,
+	0x4f, 0x85, 0x3c, 0x60, 0xf6, 0x96, 0x8a, 0x10, 0x7c, 0x1e, 0x10, 0x9f, 0x6a, 0xcf, 0xee, 0x8f,
+	0xd8, 0xf1, 0x3b, 0xfa, 0x0a, 0x2b, 0xc4, 0x51, 0x7c, 0x42, 0x14, 0x17, 0x41, 0xdf, 0xa3, 0x9c,
+	0x79, 0x4a, 0x2b, 0x56, 0x41, 0xad, 0x68, 0x97, 0xb7, 0xc0, 0x9f, 0xb8, 0xde, 0x6e, 0x1e, 0xaf,
+	0xe7, 0xf5, 0xad, 0xe0, 0xc9, 0x7a, 0x5e, 0xff, 0x94, 0x98, 0x36, 0xa4, 0xfb, 0x1f, 0xe7, 0xa3,
+	0x9a, 0x1f, 0xa1, 0x9e, 0xaf, 0xda, 0x54, 0x8e, 0x45, 0x20, 0xa9, 0x79, 0x0a, 0x60, 0xb9, 0x2b,
+	0xd9, 0x2f, 0x12, 0x38, 0x74, 0xf4, 0x04, 0xdd, 0xb5, 0xad, 0x7c, 0xe0, 0x0f, 0xd9, 0xc0, 0x19,
+	0x6f, 0x53, 0x87, 0xda, 0x7e, 0x6d, 0x13, 0xf6, 0xdb, 0x2d, 0x80, 0xc5, 0xae, 0x64, 0x48, 0xc0,
+	0xd7, 0xfb, 0x3f, 0xe4, 0x8b, 0xf5, 0xe0, 0x1a, 0x58, 0xf9, 0xd6, 0xf5, 0xd6, 0xc1, 0xd4, 0x8d,
+	0x31, 0xe2, 0xf0, 0x55, 0x76, 0x42, 0x9f, 0x1f, 0xd7, 0xc8, 0x10, 0x75, 0x7c, 0x20, 0x71, 0x63,
+	0xa5, 0xbf, 0x38, 0x5a, 0xcf, 0xeb, 0xa0, 0xf3, 0xf7, 0x72, 0x69, 0x80, 0xc5, 0xd2, 0x00, 0x37,
+	0x4b, 0x03, 0x9c, 0xad, 0x8c, 0xc2, 0x62, 0x65, 0x14, 0xae, 0x57, 0x46, 0xe1, 0x5f, 0x93, 0x71,
+	0xe5, 0x45, 0x03, 0xcb, 0x11, 0x7e, 0xba, 0xc3, 0x78, 0x67, 0x9e, 0xd3, 0xcc, 0x3d, 0x51, 0xb3,
+	0x31, 0x95, 0x83, 0x97, 0xf1, 0x2a, 0x7f, 0xbf, 0x1b, 0x00, 0x51, 0x12, 0x5c, 0x9f, 0x4a, 0x03,
+	0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// MsgClient is the client API for Msg service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type MsgClient interface {
+	// ScheduleFeature defines a governance operation for setting the activation
+	// height of a named feature. Scheduling an existing feature overwrites its
+	// activation height, as long as the feature is not active yet.
+	ScheduleFeature(ctx context.Context, in *MsgScheduleFeature, opts ...grpc.CallOption) (*MsgScheduleFeatureResponse, error)
+	// CancelFeature defines a governance operation for removing a feature that
+	// has been scheduled but is not active yet.
+	CancelFeature(ctx context.Context, in *MsgCancelFeature, opts ...grpc.CallOption) (*MsgCancelFeatureResponse, error)
+}
+
+type msgClient struct {
+	cc grpc1.ClientConn
+}
+
+func NewMsgClient(cc grpc1.ClientConn) MsgClient {
+	return &msgClient{cc}
+}
+
+func (c *msgClient) ScheduleFeature(ctx context.Context, in *MsgScheduleFeature, opts ...grpc.CallOption) (*MsgScheduleFeatureResponse, error) {
+	out := new(MsgScheduleFeatureResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.featureflag.v1.Msg/ScheduleFeature", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) CancelFeature(ctx context.Context, in *MsgCancelFeature, opts ...grpc.CallOption) (*MsgCancelFeatureResponse, error) {
+	out := new(MsgCancelFeatureResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.featureflag.v1.Msg/CancelFeature", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// MsgServer is the server API for Msg service.
+type MsgServer interface {
+	// ScheduleFeature defines a governance operation for setting the activation
+	// height of a named feature. Scheduling an existing feature overwrites its
+	// activation height, as long as the feature is not active yet.
+	ScheduleFeature(context.Context, *MsgScheduleFeature) (*MsgScheduleFeatureResponse, error)
+	// CancelFeature defines a governance operation for removing a feature that
+	// has been scheduled but is not active yet.
+	CancelFeature(context.Context, *MsgCancelFeature) (*MsgCancelFeatureResponse, error)
+}
+
+// UnimplementedMsgServer can be embedded to have forward compatible implementations.
+type UnimplementedMsgServer struct {
+}
+
+func (*UnimplementedMsgServer) ScheduleFeature(ctx context.Context, req *MsgScheduleFeature) (*MsgScheduleFeatureResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ScheduleFeature not implemented")
+}
+func (*UnimplementedMsgServer) CancelFeature(ctx context.Context, req *MsgCancelFeature) (*MsgCancelFeatureResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CancelFeature not implemented")
+}
+
+func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
+	s.RegisterService(&_Msg_serviceDesc, srv)
+}
+
+func _Msg_ScheduleFeature_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgScheduleFeature)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).ScheduleFeature(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.featureflag.v1.Msg/ScheduleFeature",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).ScheduleFeature(ctx, req.(*MsgScheduleFeature))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_CancelFeature_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgCancelFeature)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).CancelFeature(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.featureflag.v1.Msg/CancelFeature",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).CancelFeature(ctx, req.(*MsgCancelFeature))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _Msg_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "cosmos.featureflag.v1.Msg",
+	HandlerType: (*MsgServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "ScheduleFeature",
+			Handler:    _Msg_ScheduleFeature_Handler,
+		},
+		{
+			MethodName: "CancelFeature",
+			Handler:    _Msg_CancelFeature_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "cosmos/featureflag/v1/tx.proto",
+}
+
+func (m *MsgScheduleFeature) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgScheduleFeature) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgScheduleFeature) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ActivationHeight != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.ActivationHeight))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgScheduleFeatureResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgScheduleFeatureResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgScheduleFeatureResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgCancelFeature) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCancelFeature) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCancelFeature) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgCancelFeatureResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCancelFeatureResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCancelFeatureResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
+	offset -= sovTx(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *MsgScheduleFeature) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.ActivationHeight != 0 {
+		n += 1 + sovTx(uint64(m.ActivationHeight))
+	}
+	return n
+}
+
+func (m *MsgScheduleFeatureResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgCancelFeature) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgCancelFeatureResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func sovTx(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozTx(x uint64) (n int) {
+	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *MsgScheduleFeature) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgScheduleFeature: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgScheduleFeature: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Authority = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ActivationHeight", wireType)
+			}
+			m.ActivationHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ActivationHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgScheduleFeatureResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgScheduleFeatureResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgScheduleFeatureResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgCancelFeature) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgCancelFeature: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgCancelFeature: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Authority = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgCancelFeatureResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgCancelFeatureResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgCancelFeatureResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipTx(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthTx
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupTx
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthTx
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthTx        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowTx          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupTx = fmt.Errorf("proto: unexpected end of group")
+)
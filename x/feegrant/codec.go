@@ -17,6 +17,8 @@ import (
 func RegisterLegacyAminoCodec(cdc *codec.LegacyAmino) {
 	legacy.RegisterAminoMsg(cdc, &MsgGrantAllowance{}, "cosmos-sdk/MsgGrantAllowance")
 	legacy.RegisterAminoMsg(cdc, &MsgRevokeAllowance{}, "cosmos-sdk/MsgRevokeAllowance")
+	legacy.RegisterAminoMsg(cdc, &MsgGrantAllowanceFromModule{}, "cosmos-sdk/MsgGrantAllowanceFromModule")
+	legacy.RegisterAminoMsg(cdc, &MsgRevokeAllowanceFromModule{}, "cosmos-sdk/MsgRevokeAllowanceFromModule")
 
 	cdc.RegisterInterface((*FeeAllowanceI)(nil), nil)
 	cdc.RegisterConcrete(&BasicAllowance{}, "cosmos-sdk/BasicAllowance", nil)
@@ -29,6 +31,8 @@ func RegisterInterfaces(registry types.InterfaceRegistry) {
 	registry.RegisterImplementations((*sdk.Msg)(nil),
 		&MsgGrantAllowance{},
 		&MsgRevokeAllowance{},
+		&MsgGrantAllowanceFromModule{},
+		&MsgRevokeAllowanceFromModule{},
 	)
 
 	registry.RegisterInterface(
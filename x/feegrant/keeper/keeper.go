@@ -25,19 +25,29 @@ type Keeper struct {
 	cdc          codec.BinaryCodec
 	storeService store.KVStoreService
 	authKeeper   feegrant.AccountKeeper
+
+	// the address capable of granting allowances funded by module accounts,
+	// usually the gov module account
+	authority string
 }
 
 var _ ante.FeegrantKeeper = &Keeper{}
 
 // NewKeeper creates a fee grant Keeper
-func NewKeeper(cdc codec.BinaryCodec, storeService store.KVStoreService, ak feegrant.AccountKeeper) Keeper {
+func NewKeeper(cdc codec.BinaryCodec, storeService store.KVStoreService, ak feegrant.AccountKeeper, authority string) Keeper {
 	return Keeper{
 		cdc:          cdc,
 		storeService: storeService,
 		authKeeper:   ak,
+		authority:    authority,
 	}
 }
 
+// GetAuthority returns the x/feegrant module's authority.
+func (k Keeper) GetAuthority() string {
+	return k.authority
+}
+
 // Logger returns a module-specific logger.
 func (k Keeper) Logger(ctx sdk.Context) log.Logger {
 	return ctx.Logger().With("module", fmt.Sprintf("x/%s", feegrant.ModuleName))
@@ -167,6 +177,47 @@ func (k Keeper) revokeAllowance(ctx context.Context, granter, grantee sdk.AccAdd
 	return nil
 }
 
+// GrantAllowanceFromModule creates a new grant funded by the account of the
+// module moduleName, creating the module account if it does not exist yet.
+func (k Keeper) GrantAllowanceFromModule(ctx context.Context, moduleName string, grantee sdk.AccAddress, feeAllowance feegrant.FeeAllowanceI) error {
+	granter, err := k.moduleAccountAddress(ctx, moduleName)
+	if err != nil {
+		return err
+	}
+
+	if granter.Equals(grantee) {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidAddress, "cannot self-grant fee authorization")
+	}
+
+	return k.GrantAllowance(ctx, granter, grantee, feeAllowance)
+}
+
+// RevokeAllowanceFromModule removes an existing grant funded by the account
+// of the module moduleName.
+func (k Keeper) RevokeAllowanceFromModule(ctx context.Context, moduleName string, grantee sdk.AccAddress) error {
+	granter, err := k.moduleAccountAddress(ctx, moduleName)
+	if err != nil {
+		return err
+	}
+
+	return k.revokeAllowance(ctx, granter, grantee)
+}
+
+// moduleAccountAddress returns the address of the account of the module
+// moduleName, which must have been registered with the account keeper.
+func (k Keeper) moduleAccountAddress(ctx context.Context, moduleName string) (sdk.AccAddress, error) {
+	if moduleName == "" {
+		return nil, errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "module name cannot be empty")
+	}
+
+	macc := k.authKeeper.GetModuleAccount(ctx, moduleName)
+	if macc == nil {
+		return nil, errorsmod.Wrapf(sdkerrors.ErrUnknownAddress, "module account %s does not exist", moduleName)
+	}
+
+	return macc.GetAddress(), nil
+}
+
 // GetAllowance returns the allowance between the granter and grantee.
 // If there is none, it returns nil, nil.
 // Returns an error on parsing issues
@@ -311,9 +362,19 @@ func (k Keeper) addToFeeAllowanceQueue(ctx context.Context, grantKey []byte, exp
 	return store.Set(feegrant.FeeAllowancePrefixQueue(exp, grantKey), []byte{})
 }
 
+// blockerGasContext is implemented by the contexts of the SDK versions with
+// blocker gas limits. As x/feegrant also builds against the SDK versions
+// predating them, the limit is only checked if the context supports it.
+type blockerGasContext interface {
+	IsBlockerGasExhausted() bool
+}
+
 // RemoveExpiredAllowances iterates grantsByExpiryQueue and deletes the expired grants.
+// It stops once the blocker gas limit of the module, if any, is reached, the
+// remaining expired grants being deleted in the following blocks.
 func (k Keeper) RemoveExpiredAllowances(ctx context.Context) error {
-	exp := sdk.UnwrapSDKContext(ctx).BlockTime()
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	exp := sdkCtx.BlockTime()
 	store := k.storeService.OpenKVStore(ctx)
 	iterator, err := store.Iterator(feegrant.FeeAllowanceQueueKeyPrefix, storetypes.InclusiveEndBytes(feegrant.AllowanceByExpTimeKey(&exp)))
 	if err != nil {
@@ -321,7 +382,13 @@ func (k Keeper) RemoveExpiredAllowances(ctx context.Context) error {
 	}
 	defer iterator.Close()
 
-	for ; iterator.Valid(); iterator.Next() {
+	blockerCtx, hasBlockerGasLimit := any(sdkCtx).(blockerGasContext)
+	for first := true; iterator.Valid(); iterator.Next() {
+		if !first && hasBlockerGasLimit && blockerCtx.IsBlockerGasExhausted() {
+			break
+		}
+		first = false
+
 		err = store.Delete(iterator.Key())
 		if err != nil {
 			return err
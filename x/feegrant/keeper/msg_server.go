@@ -7,6 +7,7 @@ import (
 	errorsmod "cosmossdk.io/errors"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
 
 	"cosmossdk.io/x/feegrant"
 )
@@ -89,3 +90,56 @@ func (k msgServer) RevokeAllowance(goCtx context.Context, msg *feegrant.MsgRevok
 
 	return &feegrant.MsgRevokeAllowanceResponse{}, nil
 }
+
+// GrantAllowanceFromModule grants an allowance from the funds of a module
+// account to be used by the grantee.
+func (k msgServer) GrantAllowanceFromModule(goCtx context.Context, msg *feegrant.MsgGrantAllowanceFromModule) (*feegrant.MsgGrantAllowanceFromModuleResponse, error) {
+	if k.authority != msg.Authority {
+		return nil, errorsmod.Wrapf(govtypes.ErrInvalidSigner, "invalid authority; expected %s, got %s", k.authority, msg.Authority)
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	grantee, err := k.authKeeper.StringToBytes(msg.Grantee)
+	if err != nil {
+		return nil, err
+	}
+
+	allowance, err := msg.GetFeeAllowanceI()
+	if err != nil {
+		return nil, err
+	}
+
+	if err := allowance.ValidateBasic(); err != nil {
+		return nil, err
+	}
+
+	err = k.Keeper.GrantAllowanceFromModule(ctx, msg.ModuleName, grantee, allowance)
+	if err != nil {
+		return nil, err
+	}
+
+	return &feegrant.MsgGrantAllowanceFromModuleResponse{}, nil
+}
+
+// RevokeAllowanceFromModule revokes a fee allowance between a module account
+// and a grantee.
+func (k msgServer) RevokeAllowanceFromModule(goCtx context.Context, msg *feegrant.MsgRevokeAllowanceFromModule) (*feegrant.MsgRevokeAllowanceFromModuleResponse, error) {
+	if k.authority != msg.Authority {
+		return nil, errorsmod.Wrapf(govtypes.ErrInvalidSigner, "invalid authority; expected %s, got %s", k.authority, msg.Authority)
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	grantee, err := k.authKeeper.StringToBytes(msg.Grantee)
+	if err != nil {
+		return nil, err
+	}
+
+	err = k.Keeper.RevokeAllowanceFromModule(ctx, msg.ModuleName, grantee)
+	if err != nil {
+		return nil, err
+	}
+
+	return &feegrant.MsgRevokeAllowanceFromModuleResponse{}, nil
+}
@@ -298,3 +298,119 @@ func (suite *KeeperTestSuite) TestRevokeAllowance() {
 		})
 	}
 }
+
+func (suite *KeeperTestSuite) TestGrantAllowanceFromModule() {
+	ctx := suite.ctx.WithBlockTime(time.Now())
+	oneYear := ctx.BlockTime().AddDate(1, 0, 0)
+
+	authority := suite.feegrantKeeper.GetAuthority()
+	treasury := authtypes.NewEmptyModuleAccount("treasury")
+	suite.accountKeeper.EXPECT().GetModuleAccount(gomock.Any(), "treasury").Return(treasury).AnyTimes()
+	suite.accountKeeper.EXPECT().GetModuleAccount(gomock.Any(), "unknown").Return(nil).AnyTimes()
+
+	testCases := []struct {
+		name      string
+		req       func() *feegrant.MsgGrantAllowanceFromModule
+		expectErr bool
+		errMsg    string
+	}{
+		{
+			"error: invalid authority",
+			func() *feegrant.MsgGrantAllowanceFromModule {
+				msg, err := feegrant.NewMsgGrantAllowanceFromModule(&feegrant.BasicAllowance{}, suite.addrs[0].String(), "treasury", suite.addrs[1])
+				suite.Require().NoError(err)
+				return msg
+			},
+			true,
+			"invalid authority",
+		},
+		{
+			"error: unknown module",
+			func() *feegrant.MsgGrantAllowanceFromModule {
+				msg, err := feegrant.NewMsgGrantAllowanceFromModule(&feegrant.BasicAllowance{}, authority, "unknown", suite.addrs[1])
+				suite.Require().NoError(err)
+				return msg
+			},
+			true,
+			"module account unknown does not exist",
+		},
+		{
+			"error: empty module name",
+			func() *feegrant.MsgGrantAllowanceFromModule {
+				msg, err := feegrant.NewMsgGrantAllowanceFromModule(&feegrant.BasicAllowance{}, authority, "", suite.addrs[1])
+				suite.Require().NoError(err)
+				return msg
+			},
+			true,
+			"module name cannot be empty",
+		},
+		{
+			"valid: basic fee allowance",
+			func() *feegrant.MsgGrantAllowanceFromModule {
+				msg, err := feegrant.NewMsgGrantAllowanceFromModule(&feegrant.BasicAllowance{
+					SpendLimit: suite.atom,
+					Expiration: &oneYear,
+				}, authority, "treasury", suite.addrs[1])
+				suite.Require().NoError(err)
+				return msg
+			},
+			false,
+			"",
+		},
+		{
+			"error: fee allowance exists",
+			func() *feegrant.MsgGrantAllowanceFromModule {
+				msg, err := feegrant.NewMsgGrantAllowanceFromModule(&feegrant.BasicAllowance{
+					SpendLimit: suite.atom,
+				}, authority, "treasury", suite.addrs[1])
+				suite.Require().NoError(err)
+				return msg
+			},
+			true,
+			"fee allowance already exists",
+		},
+	}
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			_, err := suite.msgSrvr.GrantAllowanceFromModule(ctx, tc.req())
+			if tc.expectErr {
+				suite.Require().Error(err)
+				suite.Require().Contains(err.Error(), tc.errMsg)
+				return
+			}
+
+			suite.Require().NoError(err)
+			allowance, err := suite.feegrantKeeper.GetAllowance(ctx, treasury.GetAddress(), suite.addrs[1])
+			suite.Require().NoError(err)
+			suite.Require().NotNil(allowance)
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestRevokeAllowanceFromModule() {
+	oneYear := suite.ctx.BlockTime().AddDate(1, 0, 0)
+
+	authority := suite.feegrantKeeper.GetAuthority()
+	treasury := authtypes.NewEmptyModuleAccount("treasury")
+	suite.accountKeeper.EXPECT().GetModuleAccount(gomock.Any(), "treasury").Return(treasury).AnyTimes()
+
+	err := suite.feegrantKeeper.GrantAllowanceFromModule(suite.ctx, "treasury", suite.addrs[2], &feegrant.BasicAllowance{
+		SpendLimit: suite.atom,
+		Expiration: &oneYear,
+	})
+	suite.Require().NoError(err)
+
+	msg := feegrant.NewMsgRevokeAllowanceFromModule(suite.addrs[0].String(), "treasury", suite.addrs[2])
+	_, err = suite.msgSrvr.RevokeAllowanceFromModule(suite.ctx, &msg)
+	suite.Require().ErrorContains(err, "invalid authority")
+
+	msg = feegrant.NewMsgRevokeAllowanceFromModule(authority, "treasury", suite.addrs[2])
+	_, err = suite.msgSrvr.RevokeAllowanceFromModule(suite.ctx, &msg)
+	suite.Require().NoError(err)
+
+	_, err = suite.feegrantKeeper.GetAllowance(suite.ctx, treasury.GetAddress(), suite.addrs[2])
+	suite.Require().ErrorContains(err, "fee-grant not found")
+
+	_, err = suite.msgSrvr.RevokeAllowanceFromModule(suite.ctx, &msg)
+	suite.Require().ErrorContains(err, "fee-grant not found")
+}
@@ -12,11 +12,11 @@ import (
 )
 
 var (
-	_, _ sdk.Msg = &MsgGrantAllowance{}, &MsgRevokeAllowance{}
+	_, _, _, _ sdk.Msg = &MsgGrantAllowance{}, &MsgRevokeAllowance{}, &MsgGrantAllowanceFromModule{}, &MsgRevokeAllowanceFromModule{}
 	// For amino support.
-	_, _ legacytx.LegacyMsg = &MsgGrantAllowance{}, &MsgRevokeAllowance{}
+	_, _, _, _ legacytx.LegacyMsg = &MsgGrantAllowance{}, &MsgRevokeAllowance{}, &MsgGrantAllowanceFromModule{}, &MsgRevokeAllowanceFromModule{}
 
-	_ types.UnpackInterfacesMessage = &MsgGrantAllowance{}
+	_, _ types.UnpackInterfacesMessage = &MsgGrantAllowance{}, &MsgGrantAllowanceFromModule{}
 )
 
 // NewMsgGrantAllowance creates a new MsgGrantAllowance.
@@ -83,3 +83,66 @@ func (msg MsgRevokeAllowance) GetSigners() []sdk.AccAddress {
 func (msg MsgRevokeAllowance) GetSignBytes() []byte {
 	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&msg))
 }
+
+// NewMsgGrantAllowanceFromModule creates a new MsgGrantAllowanceFromModule.
+func NewMsgGrantAllowanceFromModule(feeAllowance FeeAllowanceI, authority, moduleName string, grantee sdk.AccAddress) (*MsgGrantAllowanceFromModule, error) {
+	msg, ok := feeAllowance.(proto.Message)
+	if !ok {
+		return nil, errorsmod.Wrapf(sdkerrors.ErrPackAny, "cannot proto marshal %T", msg)
+	}
+	any, err := types.NewAnyWithValue(msg)
+	if err != nil {
+		return nil, err
+	}
+
+	return &MsgGrantAllowanceFromModule{
+		Authority:  authority,
+		ModuleName: moduleName,
+		Grantee:    grantee.String(),
+		Allowance:  any,
+	}, nil
+}
+
+// GetSigners gets the authority address of the message
+func (msg MsgGrantAllowanceFromModule) GetSigners() []sdk.AccAddress {
+	authority, _ := sdk.AccAddressFromBech32(msg.Authority)
+	return []sdk.AccAddress{authority}
+}
+
+// GetSignBytes implements the LegacyMsg.GetSignBytes method.
+func (msg MsgGrantAllowanceFromModule) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&msg))
+}
+
+// GetFeeAllowanceI returns unpacked FeeAllowance
+func (msg MsgGrantAllowanceFromModule) GetFeeAllowanceI() (FeeAllowanceI, error) {
+	allowance, ok := msg.Allowance.GetCachedValue().(FeeAllowanceI)
+	if !ok {
+		return nil, errorsmod.Wrap(ErrNoAllowance, "failed to get allowance")
+	}
+
+	return allowance, nil
+}
+
+// UnpackInterfaces implements UnpackInterfacesMessage.UnpackInterfaces
+func (msg MsgGrantAllowanceFromModule) UnpackInterfaces(unpacker types.AnyUnpacker) error {
+	var allowance FeeAllowanceI
+	return unpacker.UnpackAny(msg.Allowance, &allowance)
+}
+
+// NewMsgRevokeAllowanceFromModule returns a message to revoke a fee allowance
+// funded by a module account for a given grantee
+func NewMsgRevokeAllowanceFromModule(authority, moduleName string, grantee sdk.AccAddress) MsgRevokeAllowanceFromModule {
+	return MsgRevokeAllowanceFromModule{Authority: authority, ModuleName: moduleName, Grantee: grantee.String()}
+}
+
+// GetSigners gets the authority address of the message
+func (msg MsgRevokeAllowanceFromModule) GetSigners() []sdk.AccAddress {
+	authority, _ := sdk.AccAddressFromBech32(msg.Authority)
+	return []sdk.AccAddress{authority}
+}
+
+// GetSignBytes implements the LegacyMsg.GetSignBytes method.
+func (msg MsgRevokeAllowanceFromModule) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&msg))
+}
@@ -225,43 +225,277 @@ func (m *MsgRevokeAllowanceResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgRevokeAllowanceResponse proto.InternalMessageInfo
 
+// MsgGrantAllowanceFromModule adds permission for Grantee to spend up to
+// Allowance of fees from the account of the module ModuleName.
+//
+// Since: cosmos-sdk 0.50
+type MsgGrantAllowanceFromModule struct {
+	// authority is the address that controls the module (defaults to x/gov unless overwritten).
+	Authority string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
+	// module_name is the name of the module whose account funds the allowance.
+	ModuleName string `protobuf:"bytes,2,opt,name=module_name,json=moduleName,proto3" json:"module_name,omitempty"`
+	// grantee is the address of the user being granted an allowance of the module's funds.
+	Grantee string `protobuf:"bytes,3,opt,name=grantee,proto3" json:"grantee,omitempty"`
+	// allowance can be any of basic, periodic, allowed fee allowance.
+	Allowance *types.Any `protobuf:"bytes,4,opt,name=allowance,proto3" json:"allowance,omitempty"`
+}
+
+func (m *MsgGrantAllowanceFromModule) Reset()         { *m = MsgGrantAllowanceFromModule{} }
+func (m *MsgGrantAllowanceFromModule) String() string { return proto.CompactTextString(m) }
+func (*MsgGrantAllowanceFromModule) ProtoMessage()    {}
+func (*MsgGrantAllowanceFromModule) Descriptor() ([]byte, []int) {
+	return fileDescriptor_dd44ad7946dad783, []int{4}
+}
+func (m *MsgGrantAllowanceFromModule) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgGrantAllowanceFromModule) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgGrantAllowanceFromModule.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgGrantAllowanceFromModule) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgGrantAllowanceFromModule.Merge(m, src)
+}
+func (m *MsgGrantAllowanceFromModule) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgGrantAllowanceFromModule) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgGrantAllowanceFromModule.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgGrantAllowanceFromModule proto.InternalMessageInfo
+
+func (m *MsgGrantAllowanceFromModule) GetAuthority() string {
+	if m != nil {
+		return m.Authority
+	}
+	return ""
+}
+
+func (m *MsgGrantAllowanceFromModule) GetModuleName() string {
+	if m != nil {
+		return m.ModuleName
+	}
+	return ""
+}
+
+func (m *MsgGrantAllowanceFromModule) GetGrantee() string {
+	if m != nil {
+		return m.Grantee
+	}
+	return ""
+}
+
+func (m *MsgGrantAllowanceFromModule) GetAllowance() *types.Any {
+	if m != nil {
+		return m.Allowance
+	}
+	return nil
+}
+
+// MsgGrantAllowanceFromModuleResponse defines the Msg/GrantAllowanceFromModule response type.
+//
+// Since: cosmos-sdk 0.50
+type MsgGrantAllowanceFromModuleResponse struct {
+}
+
+func (m *MsgGrantAllowanceFromModuleResponse) Reset()         { *m = MsgGrantAllowanceFromModuleResponse{} }
+func (m *MsgGrantAllowanceFromModuleResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgGrantAllowanceFromModuleResponse) ProtoMessage()    {}
+func (*MsgGrantAllowanceFromModuleResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_dd44ad7946dad783, []int{5}
+}
+func (m *MsgGrantAllowanceFromModuleResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgGrantAllowanceFromModuleResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgGrantAllowanceFromModuleResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgGrantAllowanceFromModuleResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgGrantAllowanceFromModuleResponse.Merge(m, src)
+}
+func (m *MsgGrantAllowanceFromModuleResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgGrantAllowanceFromModuleResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgGrantAllowanceFromModuleResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgGrantAllowanceFromModuleResponse proto.InternalMessageInfo
+
+// MsgRevokeAllowanceFromModule removes any existing Allowance from the account
+// of the module ModuleName to Grantee.
+//
+// Since: cosmos-sdk 0.50
+type MsgRevokeAllowanceFromModule struct {
+	// authority is the address that controls the module (defaults to x/gov unless overwritten).
+	Authority string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
+	// module_name is the name of the module whose account funds the allowance.
+	ModuleName string `protobuf:"bytes,2,opt,name=module_name,json=moduleName,proto3" json:"module_name,omitempty"`
+	// grantee is the address of the user being granted an allowance of the module's funds.
+	Grantee string `protobuf:"bytes,3,opt,name=grantee,proto3" json:"grantee,omitempty"`
+}
+
+func (m *MsgRevokeAllowanceFromModule) Reset()         { *m = MsgRevokeAllowanceFromModule{} }
+func (m *MsgRevokeAllowanceFromModule) String() string { return proto.CompactTextString(m) }
+func (*MsgRevokeAllowanceFromModule) ProtoMessage()    {}
+func (*MsgRevokeAllowanceFromModule) Descriptor() ([]byte, []int) {
+	return fileDescriptor_dd44ad7946dad783, []int{6}
+}
+func (m *MsgRevokeAllowanceFromModule) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRevokeAllowanceFromModule) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRevokeAllowanceFromModule.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgRevokeAllowanceFromModule) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRevokeAllowanceFromModule.Merge(m, src)
+}
+func (m *MsgRevokeAllowanceFromModule) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRevokeAllowanceFromModule) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRevokeAllowanceFromModule.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgRevokeAllowanceFromModule proto.InternalMessageInfo
+
+func (m *MsgRevokeAllowanceFromModule) GetAuthority() string {
+	if m != nil {
+		return m.Authority
+	}
+	return ""
+}
+
+func (m *MsgRevokeAllowanceFromModule) GetModuleName() string {
+	if m != nil {
+		return m.ModuleName
+	}
+	return ""
+}
+
+func (m *MsgRevokeAllowanceFromModule) GetGrantee() string {
+	if m != nil {
+		return m.Grantee
+	}
+	return ""
+}
+
+// MsgRevokeAllowanceFromModuleResponse defines the Msg/RevokeAllowanceFromModule response type.
+//
+// Since: cosmos-sdk 0.50
+type MsgRevokeAllowanceFromModuleResponse struct {
+}
+
+func (m *MsgRevokeAllowanceFromModuleResponse) Reset()         { *m = MsgRevokeAllowanceFromModuleResponse{} }
+func (m *MsgRevokeAllowanceFromModuleResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgRevokeAllowanceFromModuleResponse) ProtoMessage()    {}
+func (*MsgRevokeAllowanceFromModuleResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_dd44ad7946dad783, []int{7}
+}
+func (m *MsgRevokeAllowanceFromModuleResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRevokeAllowanceFromModuleResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRevokeAllowanceFromModuleResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgRevokeAllowanceFromModuleResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRevokeAllowanceFromModuleResponse.Merge(m, src)
+}
+func (m *MsgRevokeAllowanceFromModuleResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRevokeAllowanceFromModuleResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRevokeAllowanceFromModuleResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgRevokeAllowanceFromModuleResponse proto.InternalMessageInfo
+
 func init() {
 	proto.RegisterType((*MsgGrantAllowance)(nil), "cosmos.feegrant.v1beta1.MsgGrantAllowance")
 	proto.RegisterType((*MsgGrantAllowanceResponse)(nil), "cosmos.feegrant.v1beta1.MsgGrantAllowanceResponse")
 	proto.RegisterType((*MsgRevokeAllowance)(nil), "cosmos.feegrant.v1beta1.MsgRevokeAllowance")
 	proto.RegisterType((*MsgRevokeAllowanceResponse)(nil), "cosmos.feegrant.v1beta1.MsgRevokeAllowanceResponse")
+	proto.RegisterType((*MsgGrantAllowanceFromModule)(nil), "cosmos.feegrant.v1beta1.MsgGrantAllowanceFromModule")
+	proto.RegisterType((*MsgGrantAllowanceFromModuleResponse)(nil), "cosmos.feegrant.v1beta1.MsgGrantAllowanceFromModuleResponse")
+	proto.RegisterType((*MsgRevokeAllowanceFromModule)(nil), "cosmos.feegrant.v1beta1.MsgRevokeAllowanceFromModule")
+	proto.RegisterType((*MsgRevokeAllowanceFromModuleResponse)(nil), "cosmos.feegrant.v1beta1.MsgRevokeAllowanceFromModuleResponse")
 }
 
 func init() { proto.RegisterFile("cosmos/feegrant/v1beta1/tx.proto", fileDescriptor_dd44ad7946dad783) }
 
 var fileDescriptor_dd44ad7946dad783 = []byte{
-	// 409 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x52, 0x48, 0xce, 0x2f, 0xce,
-	0xcd, 0x2f, 0xd6, 0x4f, 0x4b, 0x4d, 0x4d, 0x2f, 0x4a, 0xcc, 0x2b, 0xd1, 0x2f, 0x33, 0x4c, 0x4a,
-	0x2d, 0x49, 0x34, 0xd4, 0x2f, 0xa9, 0xd0, 0x2b, 0x28, 0xca, 0x2f, 0xc9, 0x17, 0x12, 0x87, 0xa8,
-	0xd0, 0x83, 0xa9, 0xd0, 0x83, 0xaa, 0x90, 0x92, 0x4c, 0xcf, 0xcf, 0x4f, 0xcf, 0x49, 0xd5, 0x07,
-	0x2b, 0x4b, 0x2a, 0x4d, 0xd3, 0x4f, 0xcc, 0xab, 0x84, 0xe8, 0x91, 0x92, 0x84, 0xe8, 0x89, 0x07,
-	0xf3, 0xf4, 0xa1, 0x06, 0x40, 0xa4, 0xa0, 0xc6, 0xe9, 0xe7, 0x16, 0xa7, 0xeb, 0x97, 0x19, 0x82,
-	0x28, 0xa8, 0x84, 0x60, 0x62, 0x6e, 0x66, 0x5e, 0xbe, 0x3e, 0x98, 0x84, 0x08, 0x29, 0x75, 0x32,
-	0x71, 0x09, 0xfa, 0x16, 0xa7, 0xbb, 0x83, 0xac, 0x75, 0xcc, 0xc9, 0xc9, 0x2f, 0x4f, 0xcc, 0x4b,
-	0x4e, 0x15, 0x32, 0xe2, 0x62, 0x07, 0x3b, 0x24, 0xb5, 0x48, 0x82, 0x51, 0x81, 0x51, 0x83, 0xd3,
-	0x49, 0xe2, 0xd2, 0x16, 0x5d, 0x11, 0xa8, 0x25, 0x8e, 0x29, 0x29, 0x45, 0xa9, 0xc5, 0xc5, 0xc1,
-	0x25, 0x45, 0x99, 0x79, 0xe9, 0x41, 0x30, 0x85, 0x08, 0x3d, 0xa9, 0x12, 0x4c, 0xc4, 0xe9, 0x49,
-	0x15, 0x8a, 0xe5, 0xe2, 0x4c, 0x84, 0x59, 0x2a, 0xc1, 0xac, 0xc0, 0xa8, 0xc1, 0x6d, 0x24, 0xa2,
-	0x07, 0xf1, 0xb3, 0x1e, 0xcc, 0xcf, 0x7a, 0x8e, 0x79, 0x95, 0x4e, 0x9a, 0xa7, 0xb6, 0xe8, 0xaa,
-	0xe2, 0x08, 0x25, 0x3d, 0xb7, 0xd4, 0x54, 0xb8, 0xd3, 0x3d, 0x83, 0x10, 0x26, 0x5a, 0xe9, 0x36,
-	0x3d, 0xdf, 0xa0, 0x05, 0x73, 0x60, 0xd7, 0xf3, 0x0d, 0x5a, 0x32, 0x10, 0x23, 0x74, 0x8b, 0x53,
-	0xb2, 0xf5, 0x31, 0x7c, 0xad, 0x24, 0xcd, 0x25, 0x89, 0x21, 0x18, 0x94, 0x5a, 0x5c, 0x90, 0x9f,
-	0x57, 0x9c, 0xaa, 0xb4, 0x86, 0x91, 0x4b, 0xc8, 0xb7, 0x38, 0x3d, 0x28, 0xb5, 0x2c, 0x3f, 0x3b,
-	0x95, 0xee, 0x21, 0x65, 0xa5, 0x87, 0xee, 0x15, 0x59, 0x54, 0xaf, 0xa0, 0xb9, 0x4b, 0x49, 0x86,
-	0x4b, 0x0a, 0x53, 0x14, 0xe6, 0x19, 0xa3, 0xcf, 0x8c, 0x5c, 0xcc, 0xbe, 0xc5, 0xe9, 0x42, 0x05,
-	0x5c, 0x7c, 0x68, 0x31, 0xaf, 0xa5, 0x87, 0x2b, 0x94, 0x31, 0x82, 0x46, 0xca, 0x88, 0x78, 0xb5,
-	0x30, 0x9b, 0x85, 0x8a, 0xb9, 0xf8, 0xd1, 0x83, 0x50, 0x1b, 0x9f, 0x31, 0x68, 0x8a, 0xa5, 0x8c,
-	0x49, 0x50, 0x0c, 0xb3, 0x54, 0x8a, 0xb5, 0xe1, 0xf9, 0x06, 0x2d, 0x46, 0x27, 0xc3, 0x13, 0x8f,
-	0xe4, 0x18, 0x2f, 0x3c, 0x92, 0x63, 0x7c, 0xf0, 0x48, 0x8e, 0x71, 0xc2, 0x63, 0x39, 0x86, 0x0b,
-	0x8f, 0xe5, 0x18, 0x6e, 0x3c, 0x96, 0x63, 0x88, 0x82, 0xe6, 0x98, 0xe2, 0x94, 0x6c, 0xbd, 0xcc,
-	0x7c, 0xfd, 0x0a, 0x78, 0x56, 0x4d, 0x62, 0x03, 0xa7, 0x42, 0x63, 0x40, 0x00, 0x00, 0x00, 0xff,
-	0xff, 0x3f, 0x13, 0x6c, 0x2e, 0xc4, 0x03, 0x00, 0x00,
+	// 560 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x55, 0xbf, 0x6f, 0xd3, 0x40,
+	0x14, 0xee, 0x25, 0x05, 0x94, 0x57, 0x09, 0x54, 0xab, 0x12, 0x8e, 0x1b, 0x4c, 0x64, 0x68, 0x29,
+	0x41, 0xb9, 0x53, 0x52, 0x40, 0x22, 0x82, 0x21, 0x19, 0x8a, 0x18, 0xc2, 0x60, 0x36, 0x24, 0x54,
+	0x39, 0xf5, 0xd5, 0x44, 0x89, 0x7d, 0x91, 0xcf, 0x09, 0xcd, 0x86, 0xd8, 0x60, 0x40, 0xb0, 0xf1,
+	0x47, 0x30, 0x64, 0xe8, 0x9f, 0xc0, 0x80, 0x98, 0x2a, 0x26, 0x46, 0x94, 0x0c, 0xf9, 0x37, 0x50,
+	0xfc, 0x33, 0xd8, 0x8a, 0x49, 0x23, 0x84, 0x58, 0x12, 0xf9, 0xbd, 0xef, 0xbd, 0xf7, 0x7d, 0x9f,
+	0x9f, 0xef, 0xa0, 0x78, 0xc4, 0xb8, 0xc9, 0x38, 0x39, 0xa6, 0xd4, 0xb0, 0x35, 0xcb, 0x21, 0x83,
+	0x4a, 0x8b, 0x3a, 0x5a, 0x85, 0x38, 0x27, 0xb8, 0x67, 0x33, 0x87, 0x09, 0x57, 0x3d, 0x04, 0x0e,
+	0x10, 0xd8, 0x47, 0x48, 0x79, 0x83, 0x31, 0xa3, 0x4b, 0x89, 0x0b, 0x6b, 0xf5, 0x8f, 0x89, 0x66,
+	0x0d, 0xbd, 0x1a, 0x29, 0xef, 0xd5, 0x1c, 0xba, 0x4f, 0xc4, 0x6f, 0xe0, 0xa5, 0xfc, 0x76, 0xc4,
+	0xe4, 0x06, 0x19, 0x54, 0x66, 0x7f, 0x7e, 0x62, 0x53, 0x33, 0xdb, 0x16, 0x23, 0xee, 0xaf, 0x17,
+	0x52, 0xde, 0x66, 0x60, 0xb3, 0xc9, 0x8d, 0xc7, 0xb3, 0xb1, 0xf5, 0x6e, 0x97, 0xbd, 0xd2, 0xac,
+	0x23, 0x2a, 0x54, 0xe1, 0x92, 0x4b, 0x84, 0xda, 0x22, 0x2a, 0xa2, 0xbd, 0x5c, 0x43, 0xfc, 0x7e,
+	0x5a, 0xde, 0xf2, 0x87, 0xd4, 0x75, 0xdd, 0xa6, 0x9c, 0x3f, 0x73, 0xec, 0xb6, 0x65, 0xa8, 0x01,
+	0x30, 0xaa, 0xa1, 0x62, 0x66, 0xb9, 0x1a, 0x2a, 0xbc, 0x80, 0x9c, 0x16, 0x0c, 0x15, 0xb3, 0x45,
+	0xb4, 0xb7, 0x51, 0xdd, 0xc2, 0x9e, 0x66, 0x1c, 0x68, 0xc6, 0x75, 0x6b, 0xd8, 0xb8, 0xfd, 0xed,
+	0xb4, 0xbc, 0xb3, 0xc0, 0x25, 0x7c, 0x40, 0x69, 0x48, 0xfd, 0x89, 0x1a, 0x75, 0xac, 0x95, 0xdf,
+	0x4c, 0x47, 0xa5, 0x80, 0xe0, 0xbb, 0xe9, 0xa8, 0x54, 0xf0, 0x5a, 0x94, 0xb9, 0xde, 0x21, 0x09,
+	0xd5, 0xca, 0x36, 0xe4, 0x13, 0x41, 0x95, 0xf2, 0x1e, 0xb3, 0x38, 0x55, 0x3e, 0x23, 0x10, 0x9a,
+	0xdc, 0x50, 0xe9, 0x80, 0x75, 0xe8, 0x3f, 0x77, 0xaa, 0x86, 0xe3, 0x52, 0xae, 0xfd, 0x2e, 0x25,
+	0xc6, 0x4b, 0x29, 0x80, 0x94, 0x8c, 0x86, 0x62, 0xbe, 0x64, 0x60, 0x3b, 0x21, 0xf5, 0xc0, 0x66,
+	0x66, 0x93, 0xe9, 0xfd, 0x2e, 0x15, 0xee, 0x43, 0x4e, 0xeb, 0x3b, 0x2f, 0x99, 0xdd, 0x76, 0x86,
+	0x7f, 0xd4, 0x15, 0x41, 0x85, 0xeb, 0xb0, 0x61, 0xba, 0x1d, 0x0e, 0x2d, 0xcd, 0xf4, 0xd5, 0xa9,
+	0xe0, 0x85, 0x9e, 0x6a, 0x26, 0x9d, 0x97, 0x9e, 0x5d, 0x69, 0x49, 0xd6, 0xff, 0xfa, 0x92, 0x3c,
+	0x98, 0x39, 0x1b, 0x69, 0x98, 0x79, 0xbb, 0x9b, 0xb6, 0x26, 0x91, 0x4d, 0xca, 0x0e, 0xdc, 0x48,
+	0x49, 0x87, 0x6e, 0x4f, 0x11, 0x14, 0x92, 0x2f, 0xe3, 0x3f, 0xb5, 0xbb, 0x56, 0x4b, 0xfa, 0x71,
+	0x2b, 0x75, 0xd7, 0xe6, 0x0c, 0xd9, 0x85, 0x9b, 0x69, 0xf9, 0xc0, 0x91, 0xea, 0xa7, 0x75, 0xc8,
+	0x36, 0xb9, 0x21, 0xf4, 0xe0, 0x72, 0xec, 0xe4, 0x29, 0xe1, 0x45, 0x2f, 0x30, 0xe1, 0xb4, 0x54,
+	0x5d, 0x1e, 0x1b, 0x4c, 0x16, 0x38, 0x5c, 0x89, 0x7f, 0xc2, 0x77, 0xd2, 0xda, 0xc4, 0xc0, 0xd2,
+	0xfe, 0x39, 0xc0, 0xe1, 0xd0, 0xf7, 0x08, 0xc4, 0x85, 0xdf, 0xda, 0xdd, 0xe5, 0x55, 0x44, 0x55,
+	0xd2, 0xc3, 0x55, 0xaa, 0x42, 0x42, 0x1f, 0x11, 0xe4, 0x17, 0xaf, 0xe3, 0xbd, 0x73, 0x68, 0x9c,
+	0xa3, 0xf4, 0x68, 0xa5, 0xb2, 0x80, 0x93, 0x74, 0xe1, 0xf5, 0x74, 0x54, 0x42, 0x8d, 0xca, 0xd7,
+	0xb1, 0x8c, 0xce, 0xc6, 0x32, 0xfa, 0x39, 0x96, 0xd1, 0x87, 0x89, 0xbc, 0x76, 0x36, 0x91, 0xd7,
+	0x7e, 0x4c, 0xe4, 0xb5, 0xe7, 0xfe, 0xb5, 0xc6, 0xf5, 0x0e, 0x6e, 0x33, 0x72, 0x12, 0xde, 0xa7,
+	0xad, 0x8b, 0xee, 0x29, 0xb0, 0xff, 0x6b, 0x00, 0x16, 0x00, 0x04, 0xf5, 0x69, 0x07, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -282,6 +516,18 @@ type MsgClient interface {
 	// RevokeAllowance revokes any fee allowance of granter's account that
 	// has been granted to the grantee.
 	RevokeAllowance(ctx context.Context, in *MsgRevokeAllowance, opts ...grpc.CallOption) (*MsgRevokeAllowanceResponse, error)
+	// GrantAllowanceFromModule grants fee allowance to the grantee on the
+	// account of a module, so that the module funds the fees of the grantee.
+	// It must be executed by the feegrant module authority.
+	//
+	// Since: cosmos-sdk 0.50
+	GrantAllowanceFromModule(ctx context.Context, in *MsgGrantAllowanceFromModule, opts ...grpc.CallOption) (*MsgGrantAllowanceFromModuleResponse, error)
+	// RevokeAllowanceFromModule revokes any fee allowance of a module account
+	// that has been granted to the grantee. It must be executed by the feegrant
+	// module authority.
+	//
+	// Since: cosmos-sdk 0.50
+	RevokeAllowanceFromModule(ctx context.Context, in *MsgRevokeAllowanceFromModule, opts ...grpc.CallOption) (*MsgRevokeAllowanceFromModuleResponse, error)
 }
 
 type msgClient struct {
@@ -310,6 +556,24 @@ func (c *msgClient) RevokeAllowance(ctx context.Context, in *MsgRevokeAllowance,
 	return out, nil
 }
 
+func (c *msgClient) GrantAllowanceFromModule(ctx context.Context, in *MsgGrantAllowanceFromModule, opts ...grpc.CallOption) (*MsgGrantAllowanceFromModuleResponse, error) {
+	out := new(MsgGrantAllowanceFromModuleResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.feegrant.v1beta1.Msg/GrantAllowanceFromModule", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) RevokeAllowanceFromModule(ctx context.Context, in *MsgRevokeAllowanceFromModule, opts ...grpc.CallOption) (*MsgRevokeAllowanceFromModuleResponse, error) {
+	out := new(MsgRevokeAllowanceFromModuleResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.feegrant.v1beta1.Msg/RevokeAllowanceFromModule", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MsgServer is the server API for Msg service.
 type MsgServer interface {
 	// GrantAllowance grants fee allowance to the grantee on the granter's
@@ -318,6 +582,18 @@ type MsgServer interface {
 	// RevokeAllowance revokes any fee allowance of granter's account that
 	// has been granted to the grantee.
 	RevokeAllowance(context.Context, *MsgRevokeAllowance) (*MsgRevokeAllowanceResponse, error)
+	// GrantAllowanceFromModule grants fee allowance to the grantee on the
+	// account of a module, so that the module funds the fees of the grantee.
+	// It must be executed by the feegrant module authority.
+	//
+	// Since: cosmos-sdk 0.50
+	GrantAllowanceFromModule(context.Context, *MsgGrantAllowanceFromModule) (*MsgGrantAllowanceFromModuleResponse, error)
+	// RevokeAllowanceFromModule revokes any fee allowance of a module account
+	// that has been granted to the grantee. It must be executed by the feegrant
+	// module authority.
+	//
+	// Since: cosmos-sdk 0.50
+	RevokeAllowanceFromModule(context.Context, *MsgRevokeAllowanceFromModule) (*MsgRevokeAllowanceFromModuleResponse, error)
 }
 
 // UnimplementedMsgServer can be embedded to have forward compatible implementations.
@@ -330,6 +606,12 @@ func (*UnimplementedMsgServer) GrantAllowance(ctx context.Context, req *MsgGrant
 func (*UnimplementedMsgServer) RevokeAllowance(ctx context.Context, req *MsgRevokeAllowance) (*MsgRevokeAllowanceResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method RevokeAllowance not implemented")
 }
+func (*UnimplementedMsgServer) GrantAllowanceFromModule(ctx context.Context, req *MsgGrantAllowanceFromModule) (*MsgGrantAllowanceFromModuleResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GrantAllowanceFromModule not implemented")
+}
+func (*UnimplementedMsgServer) RevokeAllowanceFromModule(ctx context.Context, req *MsgRevokeAllowanceFromModule) (*MsgRevokeAllowanceFromModuleResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RevokeAllowanceFromModule not implemented")
+}
 
 func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
 	s.RegisterService(&_Msg_serviceDesc, srv)
@@ -371,6 +653,42 @@ func _Msg_RevokeAllowance_Handler(srv interface{}, ctx context.Context, dec func
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_GrantAllowanceFromModule_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgGrantAllowanceFromModule)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).GrantAllowanceFromModule(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.feegrant.v1beta1.Msg/GrantAllowanceFromModule",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).GrantAllowanceFromModule(ctx, req.(*MsgGrantAllowanceFromModule))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_RevokeAllowanceFromModule_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgRevokeAllowanceFromModule)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).RevokeAllowanceFromModule(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.feegrant.v1beta1.Msg/RevokeAllowanceFromModule",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).RevokeAllowanceFromModule(ctx, req.(*MsgRevokeAllowanceFromModule))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Msg_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "cosmos.feegrant.v1beta1.Msg",
 	HandlerType: (*MsgServer)(nil),
@@ -383,6 +701,14 @@ var _Msg_serviceDesc = grpc.ServiceDesc{
 			MethodName: "RevokeAllowance",
 			Handler:    _Msg_RevokeAllowance_Handler,
 		},
+		{
+			MethodName: "GrantAllowanceFromModule",
+			Handler:    _Msg_GrantAllowanceFromModule_Handler,
+		},
+		{
+			MethodName: "RevokeAllowanceFromModule",
+			Handler:    _Msg_RevokeAllowanceFromModule_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "cosmos/feegrant/v1beta1/tx.proto",
@@ -520,50 +846,196 @@ func (m *MsgRevokeAllowanceResponse) MarshalToSizedBuffer(dAtA []byte) (int, err
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
-	offset -= sovTx(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *MsgGrantAllowanceFromModule) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *MsgGrantAllowance) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+
+func (m *MsgGrantAllowanceFromModule) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgGrantAllowanceFromModule) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Granter)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if m.Allowance != nil {
+		{
+			size, err := m.Allowance.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTx(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
 	}
-	l = len(m.Grantee)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if len(m.Grantee) > 0 {
+		i -= len(m.Grantee)
+		copy(dAtA[i:], m.Grantee)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Grantee)))
+		i--
+		dAtA[i] = 0x1a
 	}
-	if m.Allowance != nil {
-		l = m.Allowance.Size()
-		n += 1 + l + sovTx(uint64(l))
+	if len(m.ModuleName) > 0 {
+		i -= len(m.ModuleName)
+		copy(dAtA[i:], m.ModuleName)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ModuleName)))
+		i--
+		dAtA[i] = 0x12
 	}
-	return n
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgGrantAllowanceResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgGrantAllowanceFromModuleResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgGrantAllowanceFromModuleResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgGrantAllowanceFromModuleResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgRevokeAllowance) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgRevokeAllowanceFromModule) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRevokeAllowanceFromModule) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRevokeAllowanceFromModule) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Grantee) > 0 {
+		i -= len(m.Grantee)
+		copy(dAtA[i:], m.Grantee)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Grantee)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.ModuleName) > 0 {
+		i -= len(m.ModuleName)
+		copy(dAtA[i:], m.ModuleName)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ModuleName)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRevokeAllowanceFromModuleResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRevokeAllowanceFromModuleResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRevokeAllowanceFromModuleResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
+	offset -= sovTx(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *MsgGrantAllowance) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Granter)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Grantee)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Allowance != nil {
+		l = m.Allowance.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgGrantAllowanceResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgRevokeAllowance) Size() (n int) {
+	if m == nil {
+		return 0
 	}
 	var l int
 	_ = l
@@ -571,29 +1043,457 @@ func (m *MsgRevokeAllowance) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovTx(uint64(l))
 	}
-	l = len(m.Grantee)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	l = len(m.Grantee)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgRevokeAllowanceResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgGrantAllowanceFromModule) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.ModuleName)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Grantee)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Allowance != nil {
+		l = m.Allowance.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgGrantAllowanceFromModuleResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgRevokeAllowanceFromModule) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.ModuleName)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Grantee)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgRevokeAllowanceFromModuleResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func sovTx(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozTx(x uint64) (n int) {
+	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *MsgGrantAllowance) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgGrantAllowance: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgGrantAllowance: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Granter", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Granter = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Grantee", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Grantee = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Allowance", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Allowance == nil {
+				m.Allowance = &types.Any{}
+			}
+			if err := m.Allowance.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgGrantAllowanceResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgGrantAllowanceResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgGrantAllowanceResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	return n
+	return nil
 }
+func (m *MsgRevokeAllowance) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgRevokeAllowance: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgRevokeAllowance: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Granter", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Granter = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Grantee", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Grantee = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
 
-func (m *MsgRevokeAllowanceResponse) Size() (n int) {
-	if m == nil {
-		return 0
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	var l int
-	_ = l
-	return n
+	return nil
 }
+func (m *MsgRevokeAllowanceResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgRevokeAllowanceResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgRevokeAllowanceResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
 
-func sovTx(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozTx(x uint64) (n int) {
-	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
 }
-func (m *MsgGrantAllowance) Unmarshal(dAtA []byte) error {
+func (m *MsgGrantAllowanceFromModule) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -616,15 +1516,15 @@ func (m *MsgGrantAllowance) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgGrantAllowance: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgGrantAllowanceFromModule: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgGrantAllowance: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgGrantAllowanceFromModule: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Granter", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -652,9 +1552,41 @@ func (m *MsgGrantAllowance) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Granter = string(dAtA[iNdEx:postIndex])
+			m.Authority = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ModuleName", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ModuleName = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Grantee", wireType)
 			}
@@ -686,7 +1618,7 @@ func (m *MsgGrantAllowance) Unmarshal(dAtA []byte) error {
 			}
 			m.Grantee = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 3:
+		case 4:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Allowance", wireType)
 			}
@@ -743,7 +1675,7 @@ func (m *MsgGrantAllowance) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgGrantAllowanceResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgGrantAllowanceFromModuleResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -766,10 +1698,10 @@ func (m *MsgGrantAllowanceResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgGrantAllowanceResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgGrantAllowanceFromModuleResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgGrantAllowanceResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgGrantAllowanceFromModuleResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -793,7 +1725,7 @@ func (m *MsgGrantAllowanceResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgRevokeAllowance) Unmarshal(dAtA []byte) error {
+func (m *MsgRevokeAllowanceFromModule) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -816,15 +1748,15 @@ func (m *MsgRevokeAllowance) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgRevokeAllowance: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgRevokeAllowanceFromModule: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgRevokeAllowance: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgRevokeAllowanceFromModule: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Granter", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -852,9 +1784,41 @@ func (m *MsgRevokeAllowance) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Granter = string(dAtA[iNdEx:postIndex])
+			m.Authority = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ModuleName", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ModuleName = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Grantee", wireType)
 			}
@@ -907,7 +1871,7 @@ func (m *MsgRevokeAllowance) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgRevokeAllowanceResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgRevokeAllowanceFromModuleResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -930,10 +1894,10 @@ func (m *MsgRevokeAllowanceResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgRevokeAllowanceResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgRevokeAllowanceFromModuleResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgRevokeAllowanceResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgRevokeAllowanceFromModuleResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
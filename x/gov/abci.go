@@ -1,9 +1,13 @@
 package gov
 
 import (
+	"errors"
 	"fmt"
 	"time"
 
+	errorsmod "cosmossdk.io/errors"
+
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
 	"github.com/cosmos/cosmos-sdk/telemetry"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/x/gov/keeper"
@@ -18,7 +22,18 @@ func EndBlocker(ctx sdk.Context, keeper *keeper.Keeper) error {
 	logger := ctx.Logger().With("module", "x/"+types.ModuleName)
 	// delete dead proposals from store and returns theirs deposits.
 	// A proposal is dead when it's inactive and didn't get enough deposit on time to get into voting phase.
+	//
+	// The queues are processed until the blocker gas limit of the module, if
+	// any, is reached, the remaining proposals being processed in the following
+	// blocks. At least one proposal of each queue is processed per block.
+	var deleted int
 	err := keeper.IterateInactiveProposalsQueue(ctx, ctx.BlockHeader().Time, func(proposal v1.Proposal) error {
+		if deleted > 0 && ctx.IsBlockerGasExhausted() {
+			logger.Info("blocker gas limit reached; deferring remaining inactive proposals", "proposal", proposal.Id)
+			return errorsmod.ErrStopIterating
+		}
+
+		deleted++
 		err := keeper.DeleteProposal(ctx, proposal.Id)
 		if err != nil {
 			return err
@@ -64,142 +79,312 @@ func EndBlocker(ctx sdk.Context, keeper *keeper.Keeper) error {
 		return err
 	}
 
-	// fetch active proposals whose voting periods have ended (are passed the block time)
-	return keeper.IterateActiveProposalsQueue(ctx, ctx.BlockHeader().Time, func(proposal v1.Proposal) error {
-		var tagValue, logMsg string
+	// start the voting period of proposals whose discussion periods have ended
+	var started int
+	err = keeper.IterateDiscussionProposalsQueue(ctx, ctx.BlockHeader().Time, func(proposal v1.Proposal) error {
+		if started > 0 && ctx.IsBlockerGasExhausted() {
+			logger.Info("blocker gas limit reached; deferring remaining discussion proposals", "proposal", proposal.Id)
+			return errorsmod.ErrStopIterating
+		}
+		started++
+
+		// proposals whose messages are no longer valid are failed instead
+		activated, err := keeper.ActivateVotingPeriodIfValid(ctx, proposal)
+		if err != nil || !activated {
+			return err
+		}
+
+		ctx.EventManager().EmitEvent(
+			sdk.NewEvent(
+				types.EventTypeActiveProposal,
+				sdk.NewAttribute(types.AttributeKeyProposalID, fmt.Sprintf("%d", proposal.Id)),
+				sdk.NewAttribute(types.AttributeKeyVotingPeriodStart, fmt.Sprintf("%d", proposal.Id)),
+			),
+		)
+
+		logger.Info(
+			"proposal discussion period ended; voting period started",
+			"proposal", proposal.Id,
+			"expedited", proposal.Expedited,
+			"title", proposal.Title,
+		)
+
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+
+	params, err := keeper.GetParams(ctx)
+	if err != nil {
+		return err
+	}
+
+	// limit the number of proposals tallied in this block, and the gas spent
+	// tallying them, the proposals left in the active queue are tallied in the
+	// following blocks
+	var tallied uint64
+	limitReached := func() bool {
+		return (params.MaxProposalsProcessedPerEndBlock > 0 && tallied >= params.MaxProposalsProcessedPerEndBlock) ||
+			(tallied > 0 && ctx.IsBlockerGasExhausted())
+	}
+
+	// fetch active proposals whose voting periods have ended (are passed the block time).
+	// They are all collected before any of them is tallied, as tallying modifies the
+	// active queue (expedited proposals which fail are inserted back with their regular
+	// voting end time), so that the proposals tallied in this block, and the ones carried
+	// over to the following blocks, only depend on the queue at the start of the block.
+	var endedProposals []v1.Proposal
+	err = keeper.IterateActiveProposalsQueue(ctx, ctx.BlockHeader().Time, func(proposal v1.Proposal) error {
+		if limitReached() {
+			logger.Info("maximum number of proposals processed per block reached; deferring remaining proposals", "proposal", proposal.Id)
+			return errorsmod.ErrStopIterating
+		}
+
+		tallied++
+		endedProposals = append(endedProposals, proposal)
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+
+	for i, proposal := range endedProposals {
+		// proposals are only left in the active queue when the blocker gas
+		// limit is reached while tallying
+		if i > 0 && ctx.IsBlockerGasExhausted() {
+			logger.Info("blocker gas limit reached; deferring remaining proposals", "proposal", proposal.Id)
+			break
+		}
+
+		if err := tallyProposal(ctx, keeper, proposal); err != nil {
+			return err
+		}
+	}
+
+	if !params.EarlyResolution || limitReached() {
+		return nil
+	}
+
+	// finalize the proposals whose outcome can no longer change before the end
+	// of their voting period
+	proposals, err := keeper.NextEarlyResolutionCandidates(ctx)
+	if err != nil {
+		return err
+	}
+
+	for _, proposal := range proposals {
+		if limitReached() {
+			break
+		}
+
+		// the votes of commit-reveal proposals are only known once revealed
+		if proposal.CommitReveal {
+			continue
+		}
 
-		passes, burnDeposits, tallyResults, err := keeper.Tally(ctx, proposal)
+		decided, err := keeper.IsTallyDecided(ctx, proposal)
 		if err != nil {
 			return err
 		}
 
-		// If an expedited proposal fails, we do not want to update
-		// the deposit at this point since the proposal is converted to regular.
-		// As a result, the deposits are either deleted or refunded in all cases
-		// EXCEPT when an expedited proposal fails.
-		if !(proposal.Expedited && !passes) {
-			if burnDeposits {
-				err = keeper.DeleteAndBurnDeposits(ctx, proposal.Id)
-			} else {
-				err = keeper.RefundAndDeleteDeposits(ctx, proposal.Id)
-			}
+		if !decided {
+			continue
+		}
 
-			if err != nil {
-				return err
-			}
+		logger.Info("proposal outcome decided before the end of its voting period", "proposal", proposal.Id)
+
+		tallied++
+		if err := tallyProposal(ctx, keeper, proposal); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// tallyProposal tallies a proposal in its voting period, executes its messages
+// if it passed, and finalizes it.
+func tallyProposal(ctx sdk.Context, keeper *keeper.Keeper, proposal v1.Proposal) error {
+	var (
+		tagValue, logMsg string
+		msgResponses     []*codectypes.Any
+	)
+
+	logger := ctx.Logger().With("module", "x/"+types.ModuleName)
+
+	// the commitments which were not revealed are counted as abstain votes
+	if proposal.CommitReveal {
+		if err := keeper.AbstainUnrevealedVotes(ctx, proposal.Id); err != nil {
+			return err
+		}
+	}
+
+	passes, burnDeposits, tallyResults, err := keeper.Tally(ctx, proposal)
+	if err != nil {
+		return err
+	}
+
+	// If an expedited proposal fails, we do not want to update
+	// the deposit at this point since the proposal is converted to regular.
+	// As a result, the deposits are either deleted or refunded in all cases
+	// EXCEPT when an expedited proposal fails.
+	if !(proposal.Expedited && !passes) {
+		if burnDeposits {
+			err = keeper.DeleteAndBurnDeposits(ctx, proposal.Id)
+		} else {
+			err = keeper.RefundAndDeleteDeposits(ctx, proposal.Id)
 		}
 
-		err = keeper.RemoveFromActiveProposalQueue(ctx, proposal.Id, *proposal.VotingEndTime)
 		if err != nil {
 			return err
 		}
+	}
 
-		switch {
-		case passes:
-			var (
-				idx    int
-				events sdk.Events
-				msg    sdk.Msg
-			)
-
-			// attempt to execute all messages within the passed proposal
-			// Messages may mutate state thus we use a cached context. If one of
-			// the handlers fails, no state mutation is written and the error
-			// message is logged.
-			cacheCtx, writeCache := ctx.CacheContext()
-			messages, err := proposal.GetMsgs()
-			if err != nil {
-				proposal.Status = v1.StatusFailed
-				tagValue = types.AttributeValueProposalFailed
-				logMsg = fmt.Sprintf("passed proposal (%v) failed to execute; msgs: %s", proposal, err)
+	err = keeper.RemoveFromActiveProposalQueue(ctx, proposal.Id, *proposal.VotingEndTime)
+	if err != nil {
+		return err
+	}
 
-				break
-			}
+	switch {
+	case passes:
+		var (
+			idx    int
+			events sdk.Events
+			msg    sdk.Msg
+		)
 
-			// execute all messages
-			for idx, msg = range messages {
-				handler := keeper.Router().Handler(msg)
+		// attempt to execute all messages within the passed proposal
+		// Messages may mutate state thus we use a cached context. If one of
+		// the handlers fails, no state mutation is written and the error
+		// message is logged.
+		cacheCtx, writeCache := ctx.CacheContext()
+		cacheCtx = types.WithExecutingProposal(cacheCtx, proposal.Id)
+
+		// the execution authority of the proposal may have been removed
+		// from the allowed execution authorities during voting
+		if err := keeper.ValidateExecutionAuthority(ctx, proposal); err != nil {
+			proposal.Status = v1.StatusFailed
+			proposal.ExecutionFailure = &v1.ProposalExecutionFailure{Reason: err.Error()}
+			tagValue = types.AttributeValueProposalFailed
+			logMsg = fmt.Sprintf("passed proposal (%v) failed to execute; %s", proposal.Id, err)
+
+			break
+		}
 
-				var res *sdk.Result
-				res, err = handler(cacheCtx, msg)
-				if err != nil {
-					break
-				}
+		messages, err := proposal.GetMsgs()
+		if err != nil {
+			proposal.Status = v1.StatusFailed
+			proposal.ExecutionFailure = &v1.ProposalExecutionFailure{Reason: err.Error()}
+			tagValue = types.AttributeValueProposalFailed
+			logMsg = fmt.Sprintf("passed proposal (%v) failed to execute; msgs: %s", proposal, err)
 
-				events = append(events, res.GetEvents()...)
-			}
+			break
+		}
 
-			// `err == nil` when all handlers passed.
-			// Or else, `idx` and `err` are populated with the msg index and error.
-			if err == nil {
-				proposal.Status = v1.StatusPassed
-				tagValue = types.AttributeValueProposalPassed
-				logMsg = "passed"
-
-				// write state to the underlying multi-store
-				writeCache()
-
-				// propagate the msg events to the current context
-				ctx.EventManager().EmitEvents(events)
-			} else {
-				proposal.Status = v1.StatusFailed
-				tagValue = types.AttributeValueProposalFailed
-				logMsg = fmt.Sprintf("passed, but msg %d (%s) failed on execution: %s", idx, sdk.MsgTypeURL(msg), err)
-			}
-		case proposal.Expedited:
-			// When expedited proposal fails, it is converted
-			// to a regular proposal. As a result, the voting period is extended, and,
-			// once the regular voting period expires again, the tally is repeated
-			// according to the regular proposal rules.
-			proposal.Expedited = false
-			params, err := keeper.GetParams(ctx)
-			if err != nil {
-				return err
-			}
-			endTime := proposal.VotingStartTime.Add(*params.VotingPeriod)
-			proposal.VotingEndTime = &endTime
+		// execute all messages
+		for idx, msg = range messages {
+			handler := keeper.Router().Handler(msg)
 
-			err = keeper.InsertActiveProposalQueue(ctx, proposal.Id, *proposal.VotingEndTime)
+			var res *sdk.Result
+			res, err = handler(cacheCtx, msg)
 			if err != nil {
-				return err
+				break
 			}
 
-			tagValue = types.AttributeValueExpeditedProposalRejected
-			logMsg = "expedited proposal converted to regular"
-		default:
-			proposal.Status = v1.StatusRejected
-			tagValue = types.AttributeValueProposalRejected
-			logMsg = "rejected"
+			events = append(events, res.GetEvents()...)
+			msgResponses = append(msgResponses, res.MsgResponses...)
 		}
 
-		proposal.FinalTallyResult = &tallyResults
+		// `err == nil` when all handlers passed.
+		// Or else, `idx` and `err` are populated with the msg index and error.
+		if err == nil {
+			proposal.Status = v1.StatusPassed
+			tagValue = types.AttributeValueProposalPassed
+			logMsg = "passed"
 
-		err = keeper.SetProposal(ctx, proposal)
+			// write state to the underlying multi-store
+			writeCache()
+
+			// propagate the msg events to the current context
+			ctx.EventManager().EmitEvents(events)
+		} else {
+			msgResponses = nil
+			proposal.Status = v1.StatusFailed
+			proposal.ExecutionFailure = &v1.ProposalExecutionFailure{
+				MsgIndex:   uint32(idx),
+				MsgTypeUrl: sdk.MsgTypeURL(msg),
+				Reason:     err.Error(),
+			}
+			tagValue = types.AttributeValueProposalFailed
+			logMsg = fmt.Sprintf("passed, but msg %d (%s) failed on execution: %s", idx, sdk.MsgTypeURL(msg), err)
+
+			// record why each parameter update of a failed batch was rejected
+			var paramsErr *v1.ParamsUpdateError
+			if errors.As(err, &paramsErr) {
+				proposal.ParamsUpdateFailures = paramsErr.Failures
+			}
+		}
+	case proposal.Expedited:
+		// When expedited proposal fails, it is converted
+		// to a regular proposal. As a result, the voting period is extended, and,
+		// once the regular voting period expires again, the tally is repeated
+		// according to the regular proposal rules.
+		proposal.Expedited = false
+		params, err := keeper.GetParams(ctx)
 		if err != nil {
 			return err
 		}
+		endTime := proposal.VotingStartTime.Add(*params.VotingPeriod)
+		proposal.VotingEndTime = &endTime
 
-		// when proposal become active
-		keeper.Hooks().AfterProposalVotingPeriodEnded(ctx, proposal.Id)
+		err = keeper.InsertActiveProposalQueue(ctx, proposal.Id, *proposal.VotingEndTime)
+		if err != nil {
+			return err
+		}
 
-		logger.Info(
-			"proposal tallied",
-			"proposal", proposal.Id,
-			"status", proposal.Status.String(),
-			"expedited", proposal.Expedited,
-			"title", proposal.Title,
-			"results", logMsg,
-		)
+		tagValue = types.AttributeValueExpeditedProposalRejected
+		logMsg = "expedited proposal converted to regular"
+	default:
+		proposal.Status = v1.StatusRejected
+		tagValue = types.AttributeValueProposalRejected
+		logMsg = "rejected"
+	}
 
-		ctx.EventManager().EmitEvent(
-			sdk.NewEvent(
-				types.EventTypeActiveProposal,
-				sdk.NewAttribute(types.AttributeKeyProposalID, fmt.Sprintf("%d", proposal.Id)),
-				sdk.NewAttribute(types.AttributeKeyProposalResult, tagValue),
-				sdk.NewAttribute(types.AttributeKeyProposalLog, logMsg),
-			),
-		)
+	proposal.FinalTallyResult = &tallyResults
 
-		return nil
-	})
+	err = keeper.SetProposal(ctx, proposal)
+	if err != nil {
+		return err
+	}
+
+	if passes {
+		receipt := v1.NewProposalExecutionReceipt(proposal.Id, ctx.BlockHeight(), ctx.BlockTime(), msgResponses)
+		if err := keeper.SetExecutionReceipt(ctx, receipt); err != nil {
+			return err
+		}
+	}
+
+	// when proposal become active
+	keeper.Hooks().AfterProposalVotingPeriodEnded(ctx, proposal.Id)
+
+	logger.Info(
+		"proposal tallied",
+		"proposal", proposal.Id,
+		"status", proposal.Status.String(),
+		"expedited", proposal.Expedited,
+		"title", proposal.Title,
+		"results", logMsg,
+	)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeActiveProposal,
+			sdk.NewAttribute(types.AttributeKeyProposalID, fmt.Sprintf("%d", proposal.Id)),
+			sdk.NewAttribute(types.AttributeKeyProposalResult, tagValue),
+			sdk.NewAttribute(types.AttributeKeyProposalLog, logMsg),
+		),
+	)
+
+	return nil
 }
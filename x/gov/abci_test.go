@@ -1,6 +1,8 @@
 package gov_test
 
 import (
+	"context"
+	"errors"
 	"testing"
 	"time"
 
@@ -224,6 +226,338 @@ func TestTickPassedDepositPeriod(t *testing.T) {
 	activeQueue.Close()
 }
 
+func TestTickPassedDiscussionPeriod(t *testing.T) {
+	suite := createTestSuite(t)
+	app := suite.App
+	ctx := app.BaseApp.NewContext(false, cmtproto.Header{})
+	addrs := simtestutil.AddTestAddrs(suite.BankKeeper, suite.StakingKeeper, ctx, 10, valTokens)
+
+	header := cmtproto.Header{Height: app.LastBlockHeight() + 1}
+	app.BeginBlock(abci.RequestBeginBlock{Header: header})
+
+	discussionPeriod := time.Hour
+	params, err := suite.GovKeeper.GetParams(ctx)
+	require.NoError(t, err)
+	params.DiscussionPeriod = &discussionPeriod
+	require.NoError(t, suite.GovKeeper.SetParams(ctx, params))
+
+	govMsgSvr := keeper.NewMsgServerImpl(suite.GovKeeper)
+
+	newProposalMsg, err := v1.NewMsgSubmitProposal(
+		[]sdk.Msg{mkTestLegacyContent(t)},
+		params.MinDeposit,
+		addrs[0].String(),
+		"",
+		"Proposal",
+		"description of proposal",
+		false,
+	)
+	require.NoError(t, err)
+
+	res, err := govMsgSvr.SubmitProposal(ctx, newProposalMsg)
+	require.NoError(t, err)
+	require.NotNil(t, res)
+
+	// reaching the minimum deposit starts the discussion period
+	proposal, err := suite.GovKeeper.GetProposal(ctx, res.ProposalId)
+	require.NoError(t, err)
+	require.Equal(t, v1.StatusDiscussion, proposal.Status)
+	require.Nil(t, proposal.VotingStartTime)
+
+	discussionQueue, _ := suite.GovKeeper.DiscussionProposalQueueIterator(ctx, ctx.BlockHeader().Time)
+	require.False(t, discussionQueue.Valid())
+	discussionQueue.Close()
+
+	newHeader := ctx.BlockHeader()
+	newHeader.Time = ctx.BlockHeader().Time.Add(discussionPeriod)
+	ctx = ctx.WithBlockHeader(newHeader)
+
+	discussionQueue, _ = suite.GovKeeper.DiscussionProposalQueueIterator(ctx, ctx.BlockHeader().Time)
+	require.True(t, discussionQueue.Valid())
+	discussionQueue.Close()
+
+	gov.EndBlocker(ctx, suite.GovKeeper)
+
+	// the voting period starts once the discussion period ends
+	discussionQueue, _ = suite.GovKeeper.DiscussionProposalQueueIterator(ctx, ctx.BlockHeader().Time)
+	require.False(t, discussionQueue.Valid())
+	discussionQueue.Close()
+
+	proposal, err = suite.GovKeeper.GetProposal(ctx, res.ProposalId)
+	require.NoError(t, err)
+	require.Equal(t, v1.StatusVotingPeriod, proposal.Status)
+	require.Equal(t, ctx.BlockHeader().Time, *proposal.VotingStartTime)
+
+	activeQueue, _ := suite.GovKeeper.ActiveProposalQueueIterator(ctx, *proposal.VotingEndTime)
+	require.True(t, activeQueue.Valid())
+	activeQueue.Close()
+}
+
+func TestTickInvalidatedDiscussionPeriod(t *testing.T) {
+	suite := createTestSuite(t)
+	app := suite.App
+	ctx := app.BaseApp.NewContext(false, cmtproto.Header{})
+	addrs := simtestutil.AddTestAddrs(suite.BankKeeper, suite.StakingKeeper, ctx, 10, valTokens)
+
+	header := cmtproto.Header{Height: app.LastBlockHeight() + 1}
+	app.BeginBlock(abci.RequestBeginBlock{Header: header})
+
+	discussionPeriod := time.Hour
+	params, err := suite.GovKeeper.GetParams(ctx)
+	require.NoError(t, err)
+	params.DiscussionPeriod = &discussionPeriod
+	require.NoError(t, suite.GovKeeper.SetParams(ctx, params))
+
+	// the message becomes invalid during the discussion period
+	invalid := false
+	msg := banktypes.NewMsgSend(authtypes.NewModuleAddress(types.ModuleName), addrs[1], sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, math.NewInt(1))))
+	suite.GovKeeper.SetProposalMsgValidators(types.ProposalMsgValidatorRoute{
+		MsgTypeURL: sdk.MsgTypeURL(msg),
+		Validator: func(_ context.Context, _ sdk.Msg) error {
+			if invalid {
+				return errors.New("recipient is no longer allowed")
+			}
+			return nil
+		},
+	})
+
+	balance := suite.BankKeeper.GetAllBalances(ctx, addrs[0])
+	proposal, err := suite.GovKeeper.SubmitProposal(ctx, []sdk.Msg{msg}, "", "title", "summary", addrs[0], false)
+	require.NoError(t, err)
+	_, err = suite.GovKeeper.AddDeposit(ctx, proposal.Id, addrs[0], params.MinDeposit)
+	require.NoError(t, err)
+
+	proposal, err = suite.GovKeeper.GetProposal(ctx, proposal.Id)
+	require.NoError(t, err)
+	require.Equal(t, v1.StatusDiscussion, proposal.Status)
+
+	invalid = true
+	newHeader := ctx.BlockHeader()
+	newHeader.Time = ctx.BlockHeader().Time.Add(discussionPeriod)
+	ctx = ctx.WithBlockHeader(newHeader).WithEventManager(sdk.NewEventManager())
+
+	gov.EndBlocker(ctx, suite.GovKeeper)
+
+	// the proposal failed instead of entering its voting period
+	proposal, err = suite.GovKeeper.GetProposal(ctx, proposal.Id)
+	require.NoError(t, err)
+	require.Equal(t, v1.StatusFailed, proposal.Status)
+	require.Nil(t, proposal.VotingStartTime)
+	require.NotNil(t, proposal.InvalidationFailure)
+	require.Equal(t, sdk.MsgTypeURL(msg), proposal.InvalidationFailure.MsgTypeUrl)
+	require.Contains(t, proposal.InvalidationFailure.Reason, "recipient is no longer allowed")
+
+	attrs, ok := ctx.EventManager().Events().GetAttributes(types.AttributeKeyReason)
+	require.True(t, ok)
+	require.Contains(t, attrs[0].Value, "recipient is no longer allowed")
+
+	discussionQueue, _ := suite.GovKeeper.DiscussionProposalQueueIterator(ctx, ctx.BlockHeader().Time)
+	require.False(t, discussionQueue.Valid())
+	discussionQueue.Close()
+
+	activeQueue, _ := suite.GovKeeper.ActiveProposalQueueIterator(ctx, ctx.BlockHeader().Time.Add(*params.VotingPeriod))
+	require.False(t, activeQueue.Valid())
+	activeQueue.Close()
+
+	// the deposits are refunded
+	require.Equal(t, balance, suite.BankKeeper.GetAllBalances(ctx, addrs[0]))
+}
+
+func TestTickMaxProposalsProcessedPerEndBlock(t *testing.T) {
+	suite := createTestSuite(t)
+	app := suite.App
+	ctx := app.BaseApp.NewContext(false, cmtproto.Header{})
+	addrs := simtestutil.AddTestAddrs(suite.BankKeeper, suite.StakingKeeper, ctx, 10, valTokens)
+
+	header := cmtproto.Header{Height: app.LastBlockHeight() + 1}
+	app.BeginBlock(abci.RequestBeginBlock{Header: header})
+
+	params, err := suite.GovKeeper.GetParams(ctx)
+	require.NoError(t, err)
+	params.MaxProposalsProcessedPerEndBlock = 2
+	require.NoError(t, suite.GovKeeper.SetParams(ctx, params))
+
+	govMsgSvr := keeper.NewMsgServerImpl(suite.GovKeeper)
+
+	var proposalIDs []uint64
+	for i := 0; i < 3; i++ {
+		newProposalMsg, err := v1.NewMsgSubmitProposal(
+			[]sdk.Msg{mkTestLegacyContent(t)},
+			params.MinDeposit,
+			addrs[0].String(),
+			"",
+			"Proposal",
+			"description of proposal",
+			false,
+		)
+		require.NoError(t, err)
+
+		res, err := govMsgSvr.SubmitProposal(ctx, newProposalMsg)
+		require.NoError(t, err)
+		require.NotNil(t, res)
+
+		proposalIDs = append(proposalIDs, res.ProposalId)
+	}
+
+	newHeader := ctx.BlockHeader()
+	newHeader.Time = ctx.BlockHeader().Time.Add(*params.VotingPeriod)
+	ctx = ctx.WithBlockHeader(newHeader)
+
+	requireStatuses := func(statuses ...v1.ProposalStatus) {
+		for i, proposalID := range proposalIDs {
+			proposal, err := suite.GovKeeper.GetProposal(ctx, proposalID)
+			require.NoError(t, err)
+			require.Equal(t, statuses[i], proposal.Status)
+		}
+	}
+
+	// only the first two proposals are tallied, the third one is carried over
+	gov.EndBlocker(ctx, suite.GovKeeper)
+	requireStatuses(v1.StatusRejected, v1.StatusRejected, v1.StatusVotingPeriod)
+
+	activeQueue, _ := suite.GovKeeper.ActiveProposalQueueIterator(ctx, ctx.BlockHeader().Time)
+	require.True(t, activeQueue.Valid())
+	require.Equal(t, proposalIDs[2], types.GetProposalIDFromBytes(activeQueue.Value()))
+	activeQueue.Close()
+
+	gov.EndBlocker(ctx, suite.GovKeeper)
+	requireStatuses(v1.StatusRejected, v1.StatusRejected, v1.StatusRejected)
+
+	activeQueue, _ = suite.GovKeeper.ActiveProposalQueueIterator(ctx, ctx.BlockHeader().Time)
+	require.False(t, activeQueue.Valid())
+	activeQueue.Close()
+}
+
+func TestTickCarriedOverExpeditedProposal(t *testing.T) {
+	suite := createTestSuite(t)
+	app := suite.App
+	ctx := app.BaseApp.NewContext(false, cmtproto.Header{})
+	depositMultiplier := getDepositMultiplier(true)
+	addrs := simtestutil.AddTestAddrs(suite.BankKeeper, suite.StakingKeeper, ctx, 1, valTokens.Mul(math.NewInt(depositMultiplier)))
+
+	header := cmtproto.Header{Height: app.LastBlockHeight() + 1}
+	app.BeginBlock(abci.RequestBeginBlock{Header: header})
+
+	params, err := suite.GovKeeper.GetParams(ctx)
+	require.NoError(t, err)
+
+	govMsgSvr := keeper.NewMsgServerImpl(suite.GovKeeper)
+
+	newProposalMsg, err := v1.NewMsgSubmitProposal(
+		[]sdk.Msg{mkTestLegacyContent(t)},
+		params.ExpeditedMinDeposit,
+		addrs[0].String(),
+		"",
+		"Proposal",
+		"description of proposal",
+		true,
+	)
+	require.NoError(t, err)
+
+	res, err := govMsgSvr.SubmitProposal(ctx, newProposalMsg)
+	require.NoError(t, err)
+	require.NotNil(t, res)
+
+	// the expedited proposal is only tallied once its regular voting period
+	// has ended too, e.g. after being carried over
+	newHeader := ctx.BlockHeader()
+	newHeader.Time = ctx.BlockHeader().Time.Add(*params.VotingPeriod)
+	ctx = ctx.WithBlockHeader(newHeader)
+
+	// the failed expedited proposal is converted to a regular proposal, which
+	// is not tallied again in the same block
+	gov.EndBlocker(ctx, suite.GovKeeper)
+
+	proposal, err := suite.GovKeeper.GetProposal(ctx, res.ProposalId)
+	require.NoError(t, err)
+	require.Equal(t, v1.StatusVotingPeriod, proposal.Status)
+	require.False(t, proposal.Expedited)
+
+	gov.EndBlocker(ctx, suite.GovKeeper)
+
+	proposal, err = suite.GovKeeper.GetProposal(ctx, res.ProposalId)
+	require.NoError(t, err)
+	require.Equal(t, v1.StatusRejected, proposal.Status)
+}
+
+func TestTickCarriedOverProposalsOrder(t *testing.T) {
+	suite := createTestSuite(t)
+	app := suite.App
+	ctx := app.BaseApp.NewContext(false, cmtproto.Header{})
+	depositMultiplier := getDepositMultiplier(true)
+	addrs := simtestutil.AddTestAddrs(suite.BankKeeper, suite.StakingKeeper, ctx, 1, valTokens.Mul(math.NewInt(depositMultiplier)))
+
+	header := cmtproto.Header{Height: app.LastBlockHeight() + 1}
+	app.BeginBlock(abci.RequestBeginBlock{Header: header})
+
+	params, err := suite.GovKeeper.GetParams(ctx)
+	require.NoError(t, err)
+	params.MaxProposalsProcessedPerEndBlock = 1
+	require.NoError(t, suite.GovKeeper.SetParams(ctx, params))
+
+	govMsgSvr := keeper.NewMsgServerImpl(suite.GovKeeper)
+
+	// an expedited proposal, then a regular one, both entering their voting
+	// period in the same block
+	var proposalIDs []uint64
+	for _, expedited := range []bool{true, false} {
+		deposit := params.MinDeposit
+		if expedited {
+			deposit = params.ExpeditedMinDeposit
+		}
+
+		newProposalMsg, err := v1.NewMsgSubmitProposal(
+			[]sdk.Msg{mkTestLegacyContent(t)},
+			deposit,
+			addrs[0].String(),
+			"",
+			"Proposal",
+			"description of proposal",
+			expedited,
+		)
+		require.NoError(t, err)
+
+		res, err := govMsgSvr.SubmitProposal(ctx, newProposalMsg)
+		require.NoError(t, err)
+		require.NotNil(t, res)
+
+		proposalIDs = append(proposalIDs, res.ProposalId)
+	}
+
+	newHeader := ctx.BlockHeader()
+	newHeader.Time = ctx.BlockHeader().Time.Add(*params.VotingPeriod)
+	ctx = ctx.WithBlockHeader(newHeader)
+
+	requireStatuses := func(statuses ...v1.ProposalStatus) {
+		for i, proposalID := range proposalIDs {
+			proposal, err := suite.GovKeeper.GetProposal(ctx, proposalID)
+			require.NoError(t, err)
+			require.Equal(t, statuses[i], proposal.Status)
+		}
+	}
+
+	// the expedited proposal ends first, and fails: it is converted to a
+	// regular proposal ending with the other one
+	gov.EndBlocker(ctx, suite.GovKeeper)
+	requireStatuses(v1.StatusVotingPeriod, v1.StatusVotingPeriod)
+
+	proposal, err := suite.GovKeeper.GetProposal(ctx, proposalIDs[0])
+	require.NoError(t, err)
+	require.False(t, proposal.Expedited)
+
+	// the carried over proposals ending at the same time are tallied in
+	// proposal ID order, one per block
+	gov.EndBlocker(ctx, suite.GovKeeper)
+	requireStatuses(v1.StatusRejected, v1.StatusVotingPeriod)
+
+	gov.EndBlocker(ctx, suite.GovKeeper)
+	requireStatuses(v1.StatusRejected, v1.StatusRejected)
+
+	activeQueue, _ := suite.GovKeeper.ActiveProposalQueueIterator(ctx, ctx.BlockHeader().Time)
+	require.False(t, activeQueue.Valid())
+	activeQueue.Close()
+}
+
 func TestTickPassedVotingPeriod(t *testing.T) {
 	testcases := []struct {
 		name      string
@@ -452,6 +786,12 @@ func TestEndBlockerProposalHandlerFailed(t *testing.T) {
 	proposal, err = suite.GovKeeper.GetProposal(ctx, proposal.Id)
 	require.Nil(t, err)
 	require.Equal(t, v1.StatusFailed, proposal.Status)
+
+	// the execution failure is recorded on the proposal
+	require.NotNil(t, proposal.ExecutionFailure)
+	require.Equal(t, uint32(0), proposal.ExecutionFailure.MsgIndex)
+	require.Equal(t, sdk.MsgTypeURL(msg), proposal.ExecutionFailure.MsgTypeUrl)
+	require.Contains(t, proposal.ExecutionFailure.Reason, "insufficient funds")
 }
 
 func TestExpeditedProposal_PassAndConversionToRegular(t *testing.T) {
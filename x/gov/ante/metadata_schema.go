@@ -0,0 +1,149 @@
+package ante
+
+import (
+	"context"
+
+	"cosmossdk.io/errors"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/gov/types"
+	v1 "github.com/cosmos/cosmos-sdk/x/gov/types/v1"
+)
+
+// GovKeeper defines the expected gov keeper, used to look up the metadata
+// schemas and the proposals whose metadata is updated.
+type GovKeeper interface {
+	GetMetadataSchema(ctx context.Context, schemaID uint64) (v1.MetadataSchema, error)
+	GetProposal(ctx context.Context, proposalID uint64) (v1.Proposal, error)
+}
+
+// MetadataValidator validates metadata against the registered metadata schema
+// it references, e.g. with a JSON schema library.
+type MetadataValidator interface {
+	ValidateMetadata(schema v1.MetadataSchema, metadata string) error
+}
+
+// MetadataSchemaDecorator is an AnteDecorator that rejects the transactions
+// containing a governance message whose metadata does not conform to the
+// metadata schema it references. As the validation depends on the validator
+// configured by each node, it is only performed in CheckTx and simulations,
+// never when the transactions are delivered. A nil validator allows all the
+// messages.
+//
+// The validated messages are MsgSubmitProposal, MsgVote, MsgVoteWeighted,
+// MsgRevealVote and MsgDeposit, which reference a schema, and
+// MsgUpdateProposalMetadata and MsgAmendProposal, whose metadata is validated
+// against the schema of the proposal. The messages nested in other messages,
+// e.g. in an authz MsgExec, are not validated.
+type MetadataSchemaDecorator struct {
+	govKeeper GovKeeper
+	validator MetadataValidator
+}
+
+// NewMetadataSchemaDecorator returns a new MetadataSchemaDecorator.
+func NewMetadataSchemaDecorator(govKeeper GovKeeper, validator MetadataValidator) MetadataSchemaDecorator {
+	return MetadataSchemaDecorator{govKeeper: govKeeper, validator: validator}
+}
+
+var _ sdk.AnteDecorator = MetadataSchemaDecorator{}
+
+// AnteHandle implements the AnteDecorator.AnteHandle method
+func (msd MetadataSchemaDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate bool, next sdk.AnteHandler) (sdk.Context, error) {
+	if msd.validator == nil || !(ctx.IsCheckTx() || simulate) {
+		return next(ctx, tx, simulate)
+	}
+
+	for _, msg := range tx.GetMsgs() {
+		schemaID, metadata, err := msd.metadataOf(ctx, msg)
+		if err != nil {
+			return ctx, err
+		}
+
+		if schemaID == 0 {
+			continue
+		}
+
+		schema, err := msd.govKeeper.GetMetadataSchema(ctx, schemaID)
+		if err != nil {
+			return ctx, err
+		}
+
+		if err := msd.validator.ValidateMetadata(schema, metadata); err != nil {
+			return ctx, errors.Wrapf(types.ErrMetadataNotConforming, "schema %d: %s", schemaID, err)
+		}
+	}
+
+	return next(ctx, tx, simulate)
+}
+
+// metadataOf returns the metadata of a governance message and the ID of the
+// metadata schema it must conform to, which is 0 if there is none.
+func (msd MetadataSchemaDecorator) metadataOf(ctx sdk.Context, msg sdk.Msg) (uint64, string, error) {
+	switch msg := msg.(type) {
+	case *v1.MsgSubmitProposal:
+		return msg.MetadataSchemaId, msg.Metadata, nil
+	case *v1.MsgVote:
+		return msg.MetadataSchemaId, msg.Metadata, nil
+	case *v1.MsgVoteWeighted:
+		return msg.MetadataSchemaId, msg.Metadata, nil
+	case *v1.MsgRevealVote:
+		return msg.MetadataSchemaId, msg.Metadata, nil
+	case *v1.MsgDeposit:
+		return msg.MetadataSchemaId, msg.Metadata, nil
+	case *v1.MsgUpdateProposalMetadata:
+		return msd.proposalMetadataSchema(ctx, msg.ProposalId, msg.Metadata)
+	case *v1.MsgAmendProposal:
+		return msd.proposalMetadataSchema(ctx, msg.ProposalId, msg.Metadata)
+	default:
+		return 0, "", nil
+	}
+}
+
+// proposalMetadataSchema returns the new metadata of a proposal and the ID of
+// the metadata schema of the proposal. A missing proposal is left to the
+// message handler to reject.
+func (msd MetadataSchemaDecorator) proposalMetadataSchema(ctx sdk.Context, proposalID uint64, metadata string) (uint64, string, error) {
+	proposal, err := msd.govKeeper.GetProposal(ctx, proposalID)
+	if err != nil {
+		if errors.IsOf(err, types.ErrProposalNotFound) {
+			return 0, "", nil
+		}
+		return 0, "", err
+	}
+
+	return proposal.MetadataSchemaId, metadata, nil
+}
+
+// SchemaDocumentValidator is a MetadataValidator validating the metadata
+// against the schema documents known by the node, with a validation function
+// such as a JSON schema library. A document is only used for the schemas whose
+// registered hash is the hash of the document, and the metadata referencing a
+// schema whose document is unknown are not validated.
+type SchemaDocumentValidator struct {
+	documents map[string][]byte
+	validate  func(document []byte, metadata string) error
+}
+
+var _ MetadataValidator = SchemaDocumentValidator{}
+
+// NewSchemaDocumentValidator returns a new SchemaDocumentValidator validating
+// the metadata against the given schema documents with the validation
+// function.
+func NewSchemaDocumentValidator(validate func(document []byte, metadata string) error, documents ...[]byte) SchemaDocumentValidator {
+	docs := make(map[string][]byte, len(documents))
+	for _, doc := range documents {
+		docs[v1.MetadataSchemaHash(doc)] = doc
+	}
+
+	return SchemaDocumentValidator{documents: docs, validate: validate}
+}
+
+// ValidateMetadata implements the MetadataValidator interface.
+func (v SchemaDocumentValidator) ValidateMetadata(schema v1.MetadataSchema, metadata string) error {
+	doc, ok := v.documents[schema.Hash]
+	if !ok {
+		return nil
+	}
+
+	return v.validate(doc, metadata)
+}
@@ -0,0 +1,156 @@
+package ante_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/gov/ante"
+	"github.com/cosmos/cosmos-sdk/x/gov/types"
+	v1 "github.com/cosmos/cosmos-sdk/x/gov/types/v1"
+)
+
+type mockGovKeeper struct {
+	schemas   map[uint64]v1.MetadataSchema
+	proposals map[uint64]v1.Proposal
+}
+
+func (gk mockGovKeeper) GetMetadataSchema(_ context.Context, schemaID uint64) (v1.MetadataSchema, error) {
+	schema, ok := gk.schemas[schemaID]
+	if !ok {
+		return v1.MetadataSchema{}, types.ErrMetadataSchemaNotFound
+	}
+	return schema, nil
+}
+
+func (gk mockGovKeeper) GetProposal(_ context.Context, proposalID uint64) (v1.Proposal, error) {
+	proposal, ok := gk.proposals[proposalID]
+	if !ok {
+		return v1.Proposal{}, types.ErrProposalNotFound
+	}
+	return proposal, nil
+}
+
+type mockTx struct {
+	msgs []sdk.Msg
+}
+
+func (tx mockTx) GetMsgs() []sdk.Msg { return tx.msgs }
+
+func (tx mockTx) ValidateBasic() error { return nil }
+
+func TestMetadataSchemaDecorator(t *testing.T) {
+	schemaDoc := []byte(`{"type":"object"}`)
+	otherDoc := []byte(`{"type":"string"}`)
+	gk := mockGovKeeper{
+		schemas: map[uint64]v1.MetadataSchema{
+			1: v1.NewMetadataSchema(1, "proposal", "ipfs://schema", v1.MetadataSchemaHash(schemaDoc)),
+			2: v1.NewMetadataSchema(2, "unknown", "ipfs://other", v1.MetadataSchemaHash(otherDoc)),
+		},
+		proposals: map[uint64]v1.Proposal{
+			1: {Id: 1, MetadataSchemaId: 1},
+			2: {Id: 2},
+		},
+	}
+
+	// the metadata conforms to the schema if it is a JSON object
+	validator := ante.NewSchemaDocumentValidator(func(document []byte, metadata string) error {
+		require.Equal(t, schemaDoc, document)
+		if len(metadata) == 0 || metadata[0] != '{' {
+			return errors.New("not an object")
+		}
+		return nil
+	}, schemaDoc)
+
+	checkCtx := sdk.Context{}.WithIsCheckTx(true)
+
+	testCases := []struct {
+		name     string
+		msg      sdk.Msg
+		ctx      sdk.Context
+		simulate bool
+		expErr   error
+	}{
+		{
+			name: "no schema",
+			msg:  &v1.MsgVote{ProposalId: 1, Metadata: "not an object"},
+			ctx:  checkCtx,
+		},
+		{
+			name: "conforming vote",
+			msg:  &v1.MsgVote{ProposalId: 1, Metadata: "{}", MetadataSchemaId: 1},
+			ctx:  checkCtx,
+		},
+		{
+			name:   "non conforming vote",
+			msg:    &v1.MsgVote{ProposalId: 1, Metadata: "not an object", MetadataSchemaId: 1},
+			ctx:    checkCtx,
+			expErr: types.ErrMetadataNotConforming,
+		},
+		{
+			name:   "non conforming deposit",
+			msg:    &v1.MsgDeposit{ProposalId: 1, Metadata: "not an object", MetadataSchemaId: 1},
+			ctx:    checkCtx,
+			expErr: types.ErrMetadataNotConforming,
+		},
+		{
+			name:     "non conforming proposal in simulation",
+			msg:      &v1.MsgSubmitProposal{Metadata: "not an object", MetadataSchemaId: 1},
+			ctx:      sdk.Context{},
+			simulate: true,
+			expErr:   types.ErrMetadataNotConforming,
+		},
+		{
+			name: "non conforming proposal in DeliverTx",
+			msg:  &v1.MsgSubmitProposal{Metadata: "not an object", MetadataSchemaId: 1},
+			ctx:  sdk.Context{},
+		},
+		{
+			name: "unknown schema document",
+			msg:  &v1.MsgVote{ProposalId: 1, Metadata: "not an object", MetadataSchemaId: 2},
+			ctx:  checkCtx,
+		},
+		{
+			name:   "schema not found",
+			msg:    &v1.MsgVote{ProposalId: 1, Metadata: "{}", MetadataSchemaId: 3},
+			ctx:    checkCtx,
+			expErr: types.ErrMetadataSchemaNotFound,
+		},
+		{
+			name:   "non conforming proposal metadata update",
+			msg:    &v1.MsgUpdateProposalMetadata{ProposalId: 1, Metadata: "not an object"},
+			ctx:    checkCtx,
+			expErr: types.ErrMetadataNotConforming,
+		},
+		{
+			name: "proposal metadata update without schema",
+			msg:  &v1.MsgUpdateProposalMetadata{ProposalId: 2, Metadata: "not an object"},
+			ctx:  checkCtx,
+		},
+		{
+			name: "proposal not found",
+			msg:  &v1.MsgUpdateProposalMetadata{ProposalId: 3, Metadata: "not an object"},
+			ctx:  checkCtx,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			antehandler := sdk.ChainAnteDecorators(ante.NewMetadataSchemaDecorator(gk, validator))
+			_, err := antehandler(tc.ctx, mockTx{msgs: []sdk.Msg{tc.msg}}, tc.simulate)
+			if tc.expErr != nil {
+				require.ErrorIs(t, err, tc.expErr)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+
+	// a nil validator allows all the messages
+	antehandler := sdk.ChainAnteDecorators(ante.NewMetadataSchemaDecorator(gk, nil))
+	_, err := antehandler(checkCtx, mockTx{msgs: []sdk.Msg{&v1.MsgVote{Metadata: "not an object", MetadataSchemaId: 1}}}, false)
+	require.NoError(t, err)
+}
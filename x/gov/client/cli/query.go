@@ -29,9 +29,11 @@ func GetQueryCmd(ac address.Codec) *cobra.Command {
 
 	govQueryCmd.AddCommand(
 		GetCmdQueryProposal(),
+		GetCmdQueryExecutionReceipt(),
 		GetCmdQueryProposals(ac),
 		GetCmdQueryVote(ac),
 		GetCmdQueryVotes(),
+		GetCmdQueryVoteCommitment(ac),
 		GetCmdQueryParams(),
 		GetCmdQueryParam(),
 		GetCmdQueryProposer(),
@@ -39,6 +41,7 @@ func GetQueryCmd(ac address.Codec) *cobra.Command {
 		GetCmdQueryDeposits(),
 		GetCmdQueryTally(),
 		GetCmdConstitution(),
+		GetCmdConstitutionAmendments(),
 	)
 
 	return govQueryCmd
@@ -91,6 +94,52 @@ $ %s query gov proposal 1
 	return cmd
 }
 
+// GetCmdQueryExecutionReceipt implements the query execution receipt command.
+func GetCmdQueryExecutionReceipt() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "execution-receipt [proposal-id]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Query the execution receipt of a passed proposal",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query the execution receipt of a passed proposal, with the height and
+time at which its messages were executed and their responses.
+
+Example:
+$ %s query gov execution-receipt 1
+`,
+				version.AppName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := v1.NewQueryClient(clientCtx)
+
+			// validate that the proposal id is a uint
+			proposalID, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return fmt.Errorf("proposal-id %s not a valid uint, please input a valid proposal-id", args[0])
+			}
+
+			res, err := queryClient.ExecutionReceipt(
+				cmd.Context(),
+				&v1.QueryExecutionReceiptRequest{ProposalId: proposalID},
+			)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res.Receipt)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
 // GetCmdQueryProposals implements a query proposals command. Command to Get
 // Proposals Information.
 func GetCmdQueryProposals(ac address.Codec) *cobra.Command {
@@ -253,6 +302,55 @@ $ %s query gov vote 1 cosmos1skjwj5whet0lpe65qaq4rpq03hjxlwd9nf39lk
 	return cmd
 }
 
+// GetCmdQueryVoteCommitment implements the query vote commitment command.
+func GetCmdQueryVoteCommitment(ac address.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "vote-commitment [proposal-id] [voter-addr]",
+		Args:  cobra.ExactArgs(2),
+		Short: "Query the unrevealed vote commitment of a voter on a commit-reveal proposal",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query the unrevealed vote commitment of a voter on a commit-reveal proposal.
+
+Example:
+$ %s query gov vote-commitment 1 cosmos1skjwj5whet0lpe65qaq4rpq03hjxlwd9nf39lk
+`,
+				version.AppName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := v1.NewQueryClient(clientCtx)
+
+			// validate that the proposal id is a uint
+			proposalID, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return fmt.Errorf("proposal-id %s not a valid int, please input a valid proposal-id", args[0])
+			}
+
+			if _, err := ac.StringToBytes(args[1]); err != nil {
+				return err
+			}
+
+			res, err := queryClient.VoteCommitment(
+				cmd.Context(),
+				&v1.QueryVoteCommitmentRequest{ProposalId: proposalID, Voter: args[1]},
+			)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
 // GetCmdQueryVotes implements the command to query for proposal votes.
 func GetCmdQueryVotes() *cobra.Command {
 	cmd := &cobra.Command{
@@ -548,6 +646,8 @@ $ %s query gov params
 			res.VotingParams = &vp
 
 			tp := v1.NewTallyParams(res.Params.Quorum, res.Params.Threshold, res.Params.VetoThreshold)
+			tp.VetoQuorum = res.Params.VetoQuorum
+			tp.ProposalKindQuorums = res.Params.ProposalKindQuorums
 			res.TallyParams = &tp
 
 			return clientCtx.PrintProto(res)
@@ -675,3 +775,49 @@ func GetCmdConstitution() *cobra.Command {
 		},
 	}
 }
+
+// GetCmdConstitutionAmendments implements the command to query the amendments
+// made to the constitution.
+func GetCmdConstitutionAmendments() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "constitution-amendments",
+		Short: "Query the amendments made to the constitution",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query the amendments made to the constitution, in the order they were made.
+
+Example:
+$ %s query gov constitution-amendments
+`,
+				version.AppName,
+			),
+		),
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := v1.NewQueryClient(clientCtx)
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.ConstitutionAmendments(
+				cmd.Context(),
+				&v1.QueryConstitutionAmendmentsRequest{Pagination: pageReq},
+			)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddPaginationFlagsToCmd(cmd, "constitution amendments")
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
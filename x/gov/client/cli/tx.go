@@ -1,6 +1,7 @@
 package cli
 
 import (
+	"encoding/hex"
 	"fmt"
 	"strconv"
 	"strings"
@@ -72,6 +73,12 @@ func NewTxCmd(legacyPropCmds []*cobra.Command) *cobra.Command {
 		NewCmdSubmitProposal(),
 		NewCmdDraftProposal(),
 		NewCmdCancelProposal(),
+		NewCmdUpdateProposalMetadata(),
+		NewCmdWithdrawDeposit(),
+		NewCmdRetractVote(),
+		NewCmdAmendProposal(),
+		NewCmdCommitVote(),
+		NewCmdRevealVote(),
 
 		// Deprecated
 		cmdSubmitLegacyProp,
@@ -111,7 +118,11 @@ Where proposal.json contains:
   "deposit": "10stake"
   "title: "My proposal"
   "summary": "A short summary of my proposal",
-  "expedited": false
+  "expedited": false,
+  // optional, an allowed authority executing the messages instead of the gov module account
+  "execution_authority": "cosmos1...",
+  // optional, votes are committed then revealed
+  "commit_reveal": false
 }
 
 metadata example: 
@@ -142,6 +153,8 @@ metadata example:
 			if err != nil {
 				return fmt.Errorf("invalid message: %w", err)
 			}
+			msg.ExecutionAuthority = proposal.ExecutionAuthority
+			msg.CommitReveal = proposal.CommitReveal
 
 			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
 		},
@@ -182,6 +195,256 @@ func NewCmdCancelProposal() *cobra.Command {
 	return cmd
 }
 
+// NewCmdUpdateProposalMetadata implements submitting a new metadata for a proposal transaction command.
+func NewCmdUpdateProposalMetadata() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "update-proposal-metadata [proposal-id] [metadata]",
+		Short:   "Amend the metadata of a governance proposal in its discussion period. Must be signed by the proposal creator.",
+		Args:    cobra.ExactArgs(2),
+		Example: fmt.Sprintf(`$ %s tx gov update-proposal-metadata 1 ipfs://CID --from mykey`, version.AppName),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			// validate that the proposal id is a uint
+			proposalID, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return fmt.Errorf("proposal-id %s not a valid uint, please input a valid proposal-id", args[0])
+			}
+
+			// Get proposer address
+			from := clientCtx.GetFromAddress()
+			msg := v1.NewMsgUpdateProposalMetadata(proposalID, from.String(), args[1])
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+// NewCmdAmendProposal implements amending a proposal in its discussion period transaction command.
+func NewCmdAmendProposal() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "amend-proposal [proposal-id] [path/to/proposal.json]",
+		Short: "Amend the messages and metadata of a governance proposal in its discussion period. Must be signed by the proposal creator.",
+		Args:  cobra.ExactArgs(2),
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Amend a governance proposal in its discussion period. The amended proposal
+is passed in a JSON file, in the same format as for submit-proposal, and replaces the
+messages, metadata, title and summary of the proposal. Its deposit field is ignored.
+
+Example:
+$ %s tx gov amend-proposal 1 path/to/proposal.json --from mykey
+`,
+				version.AppName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			// validate that the proposal id is a uint
+			proposalID, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return fmt.Errorf("proposal-id %s not a valid uint, please input a valid proposal-id", args[0])
+			}
+
+			proposal, msgs, _, err := parseSubmitProposal(clientCtx.Codec, args[1])
+			if err != nil {
+				return err
+			}
+
+			msg, err := v1.NewMsgAmendProposal(proposalID, clientCtx.GetFromAddress().String(), msgs, proposal.Metadata, proposal.Title, proposal.Summary)
+			if err != nil {
+				return fmt.Errorf("invalid message: %w", err)
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+// NewCmdWithdrawDeposit implements withdrawing a deposit from a proposal transaction command.
+func NewCmdWithdrawDeposit() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "withdraw-deposit [proposal-id]",
+		Short:   "Withdraw your deposit from a governance proposal in its discussion period.",
+		Args:    cobra.ExactArgs(1),
+		Example: fmt.Sprintf(`$ %s tx gov withdraw-deposit 1 --from mykey`, version.AppName),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			// validate that the proposal id is a uint
+			proposalID, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return fmt.Errorf("proposal-id %s not a valid uint, please input a valid proposal-id", args[0])
+			}
+
+			// Get depositor address
+			from := clientCtx.GetFromAddress()
+			msg := v1.NewMsgWithdrawDeposit(proposalID, from.String())
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+// NewCmdRetractVote implements retracting a vote from a proposal transaction command.
+func NewCmdRetractVote() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "retract-vote [proposal-id]",
+		Short:   "Retract your vote from a governance proposal in its voting period.",
+		Args:    cobra.ExactArgs(1),
+		Example: fmt.Sprintf(`$ %s tx gov retract-vote 1 --from mykey`, version.AppName),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			// validate that the proposal id is a uint
+			proposalID, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return fmt.Errorf("proposal-id %s not a valid uint, please input a valid proposal-id", args[0])
+			}
+
+			// Get voter address
+			from := clientCtx.GetFromAddress()
+			msg := v1.NewMsgRetractVote(proposalID, from.String())
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+// NewCmdCommitVote implements committing to a vote on a commit-reveal proposal
+// transaction command.
+func NewCmdCommitVote() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "commit-vote [proposal-id] [weighted-options] [salt]",
+		Args:  cobra.ExactArgs(3),
+		Short: "Commit to a vote on a commit-reveal proposal in its commit period",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Commit to a vote on a commit-reveal proposal in its commit period,
+without disclosing the vote. The salt is a hex-encoded random value which must be
+kept secret until the vote is revealed with the same options and salt, using
+"%s tx gov reveal-vote". Unrevealed commitments are counted as abstain votes.
+
+Example:
+$ %s tx gov commit-vote 1 yes=0.6,no=0.4 5f4dcc3b5aa765d61d8327deb882cf99 --from mykey
+`,
+				version.AppName, version.AppName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			proposalID, options, salt, err := parseCommitRevealArgs(args)
+			if err != nil {
+				return err
+			}
+
+			// Get voter address
+			from := clientCtx.GetFromAddress()
+			commitment, err := v1.VoteCommitmentHash(proposalID, from, options, salt)
+			if err != nil {
+				return err
+			}
+
+			msg := v1.NewMsgCommitVote(proposalID, from.String(), commitment)
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+// NewCmdRevealVote implements revealing a vote on a commit-reveal proposal
+// transaction command.
+func NewCmdRevealVote() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "reveal-vote [proposal-id] [weighted-options] [salt]",
+		Args:  cobra.ExactArgs(3),
+		Short: "Reveal a vote committed to on a commit-reveal proposal in its reveal period",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Reveal a vote committed to on a commit-reveal proposal in its reveal
+period, with the options and hex-encoded salt of the commitment.
+
+Example:
+$ %s tx gov reveal-vote 1 yes=0.6,no=0.4 5f4dcc3b5aa765d61d8327deb882cf99 --from mykey
+`,
+				version.AppName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			proposalID, options, salt, err := parseCommitRevealArgs(args)
+			if err != nil {
+				return err
+			}
+
+			metadata, err := cmd.Flags().GetString(FlagMetadata)
+			if err != nil {
+				return err
+			}
+
+			// Get voter address
+			from := clientCtx.GetFromAddress()
+			msg := v1.NewMsgRevealVote(proposalID, from.String(), options, salt, metadata)
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	cmd.Flags().String(FlagMetadata, "", "Specify metadata of the vote")
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+// parseCommitRevealArgs parses the proposal ID, weighted options and salt
+// arguments of the commit-vote and reveal-vote commands.
+func parseCommitRevealArgs(args []string) (uint64, v1.WeightedVoteOptions, []byte, error) {
+	// validate that the proposal id is a uint
+	proposalID, err := strconv.ParseUint(args[0], 10, 64)
+	if err != nil {
+		return 0, nil, nil, fmt.Errorf("proposal-id %s not a valid uint, please input a valid proposal-id", args[0])
+	}
+
+	options, err := v1.WeightedVoteOptionsFromString(govutils.NormalizeWeightedVoteOptions(args[1]))
+	if err != nil {
+		return 0, nil, nil, err
+	}
+
+	salt, err := hex.DecodeString(args[2])
+	if err != nil || len(salt) == 0 {
+		return 0, nil, nil, fmt.Errorf("salt %s is not a valid hex string", args[2])
+	}
+
+	return proposalID, options, salt, nil
+}
+
 // NewCmdSubmitLegacyProposal implements submitting a proposal transaction command.
 // Deprecated: please use NewCmdSubmitProposal instead.
 func NewCmdSubmitLegacyProposal() *cobra.Command {
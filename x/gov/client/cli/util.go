@@ -82,12 +82,14 @@ func parseSubmitLegacyProposal(fs *pflag.FlagSet) (*legacyProposal, error) {
 // proposal defines the new Msg-based proposal.
 type proposal struct {
 	// Msgs defines an array of sdk.Msgs proto-JSON-encoded as Anys.
-	Messages  []json.RawMessage `json:"messages,omitempty"`
-	Metadata  string            `json:"metadata"`
-	Deposit   string            `json:"deposit"`
-	Title     string            `json:"title"`
-	Summary   string            `json:"summary"`
-	Expedited bool              `json:"expedited"`
+	Messages           []json.RawMessage `json:"messages,omitempty"`
+	Metadata           string            `json:"metadata"`
+	Deposit            string            `json:"deposit"`
+	Title              string            `json:"title"`
+	Summary            string            `json:"summary"`
+	Expedited          bool              `json:"expedited"`
+	ExecutionAuthority string            `json:"execution_authority,omitempty"`
+	CommitReveal       bool              `json:"commit_reveal,omitempty"`
 }
 
 // parseSubmitProposal reads and parses the proposal.
@@ -26,6 +26,20 @@ func InitGenesis(ctx sdk.Context, ak types.AccountKeeper, bk types.BankKeeper, k
 		panic(err)
 	}
 
+	for _, amendment := range data.ConstitutionAmendments {
+		err = k.SetConstitutionAmendment(ctx, *amendment)
+		if err != nil {
+			panic(err)
+		}
+	}
+
+	for _, schema := range data.MetadataSchemas {
+		err = k.SetMetadataSchema(ctx, *schema)
+		if err != nil {
+			panic(err)
+		}
+	}
+
 	// check if the deposits pool account exists
 	moduleAcc := k.GetGovernanceAccount(ctx)
 	if moduleAcc == nil {
@@ -36,18 +50,44 @@ func InitGenesis(ctx sdk.Context, ak types.AccountKeeper, bk types.BankKeeper, k
 	for _, deposit := range data.Deposits {
 		k.SetDeposit(ctx, *deposit)
 		totalDeposits = totalDeposits.Add(deposit.Amount...)
+
+		// the escrows of the deposits aren't exported by x/bank
+		err = bk.AddModuleEscrow(ctx, types.ModuleName, types.DepositEscrowPurpose(deposit.ProposalId), deposit.Amount)
+		if err != nil {
+			panic(err)
+		}
 	}
 
 	for _, vote := range data.Votes {
 		k.SetVote(ctx, *vote)
 	}
 
+	for _, commitment := range data.VoteCommitments {
+		err = k.SetVoteCommitment(ctx, *commitment)
+		if err != nil {
+			panic(err)
+		}
+	}
+
+	for _, receipt := range data.ExecutionReceipts {
+		err = k.SetExecutionReceipt(ctx, *receipt)
+		if err != nil {
+			panic(err)
+		}
+	}
+
 	for _, proposal := range data.Proposals {
 		switch proposal.Status {
 		case v1.StatusDepositPeriod:
 			k.InsertInactiveProposalQueue(ctx, proposal.Id, *proposal.DepositEndTime)
+		case v1.StatusDiscussion:
+			k.InsertDiscussionProposalQueue(ctx, proposal.Id, *proposal.DiscussionEndTime)
 		case v1.StatusVotingPeriod:
 			k.InsertActiveProposalQueue(ctx, proposal.Id, *proposal.VotingEndTime)
+			err = k.RebuildVotedShares(ctx, proposal.Id)
+			if err != nil {
+				panic(err)
+			}
 		}
 		k.SetProposal(ctx, *proposal)
 	}
@@ -76,11 +116,39 @@ func ExportGenesis(ctx sdk.Context, k *keeper.Keeper) (*v1.GenesisState, error)
 		return nil, err
 	}
 
+	// the finished proposals and the execution receipts are only exported
+	// with the governance history
+	var receipts []*v1.ProposalExecutionReceipt
+	if k.ExportHistory() {
+		receipts, err = k.GetExecutionReceipts(ctx)
+		if err != nil {
+			return nil, err
+		}
+	} else {
+		activeProposals := make(v1.Proposals, 0, len(proposals))
+		for _, proposal := range proposals {
+			if !proposal.IsFinished() {
+				activeProposals = append(activeProposals, proposal)
+			}
+		}
+		proposals = activeProposals
+	}
+
 	constitution, err := k.GetConstitution(ctx)
 	if err != nil {
 		return nil, err
 	}
 
+	constitutionAmendments, err := k.GetConstitutionAmendments(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	metadataSchemas, err := k.GetMetadataSchemas(ctx)
+	if err != nil {
+		return nil, err
+	}
+
 	params, err := k.GetParams(ctx)
 	if err != nil {
 		return nil, err
@@ -88,6 +156,7 @@ func ExportGenesis(ctx sdk.Context, k *keeper.Keeper) (*v1.GenesisState, error)
 
 	var proposalsDeposits v1.Deposits
 	var proposalsVotes v1.Votes
+	var voteCommitments []*v1.VoteCommitment
 	for _, proposal := range proposals {
 		deposits, err := k.GetDeposits(ctx, proposal.Id)
 		if err != nil {
@@ -102,14 +171,27 @@ func ExportGenesis(ctx sdk.Context, k *keeper.Keeper) (*v1.GenesisState, error)
 		}
 
 		proposalsVotes = append(proposalsVotes, votes...)
+
+		commitments, err := k.GetVoteCommitments(ctx, proposal.Id)
+		if err != nil {
+			return nil, err
+		}
+
+		for i := range commitments {
+			voteCommitments = append(voteCommitments, &commitments[i])
+		}
 	}
 
 	return &v1.GenesisState{
-		StartingProposalId: startingProposalID,
-		Deposits:           proposalsDeposits,
-		Votes:              proposalsVotes,
-		Proposals:          proposals,
-		Params:             &params,
-		Constitution:       constitution,
+		StartingProposalId:     startingProposalID,
+		Deposits:               proposalsDeposits,
+		Votes:                  proposalsVotes,
+		Proposals:              proposals,
+		Params:                 &params,
+		Constitution:           constitution,
+		ConstitutionAmendments: constitutionAmendments,
+		VoteCommitments:        voteCommitments,
+		ExecutionReceipts:      receipts,
+		MetadataSchemas:        metadataSchemas,
 	}, nil
 }
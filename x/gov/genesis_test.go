@@ -37,3 +37,39 @@ func TestImportExportQueues_ErrorUnconsistentState(t *testing.T) {
 	require.NoError(t, err)
 	require.Equal(t, genState, v1.DefaultGenesisState())
 }
+
+func TestExportGenesisHistory(t *testing.T) {
+	suite := createTestSuite(t)
+	app := suite.App
+	ctx := app.BaseApp.NewContext(false, cmtproto.Header{})
+
+	gov.InitGenesis(ctx, suite.AccountKeeper, suite.BankKeeper, suite.GovKeeper, v1.DefaultGenesisState())
+
+	finished := v1.Proposal{Id: 1, Status: v1.StatusPassed, FinalTallyResult: &v1.TallyResult{YesCount: "10", NoCount: "0", AbstainCount: "0", NoWithVetoCount: "0"}}
+	require.NoError(t, suite.GovKeeper.SetProposal(ctx, finished))
+	require.NoError(t, suite.GovKeeper.SetExecutionReceipt(ctx, v1.NewProposalExecutionReceipt(1, 10, ctx.BlockTime(), nil)))
+
+	// the finished proposals and the execution receipts are only exported with
+	// the governance history
+	require.False(t, suite.GovKeeper.ExportHistory())
+	genState, err := gov.ExportGenesis(ctx, suite.GovKeeper)
+	require.NoError(t, err)
+	require.Empty(t, genState.Proposals)
+	require.Empty(t, genState.ExecutionReceipts)
+
+	receipts, err := suite.GovKeeper.GetExecutionReceipts(ctx)
+	require.NoError(t, err)
+	require.Len(t, receipts, 1)
+	require.Equal(t, int64(10), receipts[0].Height)
+
+	// the history is accepted at genesis
+	historyState := v1.DefaultGenesisState()
+	historyState.Proposals = []*v1.Proposal{&finished}
+	historyState.ExecutionReceipts = receipts
+	require.NoError(t, v1.ValidateGenesis(historyState))
+
+	gov.InitGenesis(ctx, suite.AccountKeeper, suite.BankKeeper, suite.GovKeeper, historyState)
+	receipt, err := suite.GovKeeper.GetExecutionReceipt(ctx, 1)
+	require.NoError(t, err)
+	require.Equal(t, *receipts[0], receipt)
+}
@@ -0,0 +1,226 @@
+package keeper
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"fmt"
+
+	"cosmossdk.io/errors"
+	storetypes "cosmossdk.io/store/types"
+
+	"github.com/cosmos/cosmos-sdk/runtime"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/gov/types"
+	v1 "github.com/cosmos/cosmos-sdk/x/gov/types/v1"
+)
+
+// validateCommitReveal returns an error if a proposal cannot be submitted with
+// commit-reveal voting.
+func (keeper Keeper) validateCommitReveal(ctx context.Context, expedited bool) error {
+	if expedited {
+		return types.ErrCommitRevealVoting.Wrap("expedited proposals cannot use commit-reveal voting")
+	}
+
+	params, err := keeper.GetParams(ctx)
+	if err != nil {
+		return err
+	}
+
+	if !params.CommitRevealEnabled() {
+		return types.ErrCommitRevealVoting.Wrap("commit-reveal voting is disabled")
+	}
+
+	return nil
+}
+
+// CommitVote stores the commitment of a voter to its vote on a commit-reveal
+// proposal in its commit period, replacing its previous commitment, if any.
+func (keeper Keeper) CommitVote(ctx context.Context, proposalID uint64, voterAddr sdk.AccAddress, commitment []byte) error {
+	proposal, err := keeper.commitRevealProposal(ctx, proposalID)
+	if err != nil {
+		return err
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	if !sdkCtx.BlockTime().Before(*proposal.CommitEndTime) {
+		return types.ErrCommitRevealVoting.Wrapf("commit period of proposal %d has ended", proposalID)
+	}
+
+	if len(commitment) != sha256.Size {
+		return types.ErrInvalidVoteCommitment.Wrapf("expected %d bytes, got %d", sha256.Size, len(commitment))
+	}
+
+	err = keeper.SetVoteCommitment(ctx, v1.NewVoteCommitment(proposalID, voterAddr, commitment))
+	if err != nil {
+		return err
+	}
+
+	sdkCtx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeCommitVote,
+			sdk.NewAttribute(types.AttributeKeyProposalID, fmt.Sprintf("%d", proposalID)),
+		),
+	)
+
+	return nil
+}
+
+// RevealVote casts the vote a voter committed to on a commit-reveal proposal
+// in its reveal period, given the options and salt of its commitment.
+func (keeper Keeper) RevealVote(ctx context.Context, proposalID uint64, voterAddr sdk.AccAddress, options v1.WeightedVoteOptions, salt []byte, metadata string) error {
+	proposal, err := keeper.commitRevealProposal(ctx, proposalID)
+	if err != nil {
+		return err
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	if sdkCtx.BlockTime().Before(*proposal.CommitEndTime) {
+		return types.ErrCommitRevealVoting.Wrapf("reveal period of proposal %d has not started", proposalID)
+	}
+
+	if err := keeper.assertVotingPeriodNotEnded(ctx, proposalID); err != nil {
+		return err
+	}
+
+	commitment, err := keeper.GetVoteCommitment(ctx, proposalID, voterAddr)
+	if err != nil {
+		return err
+	}
+
+	hash, err := v1.VoteCommitmentHash(proposalID, voterAddr, options, salt)
+	if err != nil {
+		return errors.Wrap(types.ErrInvalidVote, err.Error())
+	}
+
+	if !bytes.Equal(hash, commitment.Commitment) {
+		return types.ErrInvalidVoteCommitment.Wrap("revealed vote does not match the commitment")
+	}
+
+	if err := keeper.deleteVoteCommitment(ctx, proposalID, voterAddr); err != nil {
+		return err
+	}
+
+	return keeper.castVote(ctx, proposalID, voterAddr, options, metadata)
+}
+
+// AbstainUnrevealedVotes casts an abstain vote for each voter whose commitment
+// to a vote on the proposal was not revealed, and deletes the commitments.
+func (keeper Keeper) AbstainUnrevealedVotes(ctx context.Context, proposalID uint64) error {
+	commitments, err := keeper.GetVoteCommitments(ctx, proposalID)
+	if err != nil {
+		return err
+	}
+
+	for _, commitment := range commitments {
+		voterAddr, err := keeper.authKeeper.StringToBytes(commitment.Voter)
+		if err != nil {
+			return err
+		}
+
+		if err := keeper.deleteVoteCommitment(ctx, proposalID, voterAddr); err != nil {
+			return err
+		}
+
+		if err := keeper.castVote(ctx, proposalID, voterAddr, v1.NewNonSplitVoteOption(v1.OptionAbstain), ""); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// commitRevealProposal returns a commit-reveal proposal in its voting period.
+func (keeper Keeper) commitRevealProposal(ctx context.Context, proposalID uint64) (v1.Proposal, error) {
+	proposal, err := keeper.GetProposal(ctx, proposalID)
+	if err != nil {
+		return v1.Proposal{}, err
+	}
+
+	if proposal.Status != v1.StatusVotingPeriod {
+		return v1.Proposal{}, errors.Wrapf(types.ErrInactiveProposal, "%d", proposalID)
+	}
+
+	if !proposal.CommitReveal || proposal.CommitEndTime == nil {
+		return v1.Proposal{}, types.ErrCommitRevealVoting.Wrapf("proposal %d does not use commit-reveal voting", proposalID)
+	}
+
+	return proposal, nil
+}
+
+// GetVoteCommitment gets the commitment of a voter to its vote on a proposal
+func (keeper Keeper) GetVoteCommitment(ctx context.Context, proposalID uint64, voterAddr sdk.AccAddress) (commitment v1.VoteCommitment, err error) {
+	store := keeper.storeService.OpenKVStore(ctx)
+	bz, err := store.Get(types.VoteCommitmentKey(proposalID, voterAddr))
+	if err != nil {
+		return commitment, err
+	}
+
+	if bz == nil {
+		return commitment, types.ErrVoteCommitmentNotFound
+	}
+
+	err = keeper.cdc.Unmarshal(bz, &commitment)
+	return commitment, err
+}
+
+// SetVoteCommitment sets a VoteCommitment to the gov store
+func (keeper Keeper) SetVoteCommitment(ctx context.Context, commitment v1.VoteCommitment) error {
+	store := keeper.storeService.OpenKVStore(ctx)
+	bz, err := keeper.cdc.Marshal(&commitment)
+	if err != nil {
+		return err
+	}
+
+	addr, err := keeper.authKeeper.StringToBytes(commitment.Voter)
+	if err != nil {
+		return err
+	}
+
+	return store.Set(types.VoteCommitmentKey(commitment.ProposalId, addr), bz)
+}
+
+// GetVoteCommitments returns the unrevealed vote commitments of a proposal
+func (keeper Keeper) GetVoteCommitments(ctx context.Context, proposalID uint64) (commitments []v1.VoteCommitment, err error) {
+	store := keeper.storeService.OpenKVStore(ctx)
+	iterator := storetypes.KVStorePrefixIterator(runtime.KVStoreAdapter(store), types.VoteCommitmentsKey(proposalID))
+
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		var commitment v1.VoteCommitment
+		if err := keeper.cdc.Unmarshal(iterator.Value(), &commitment); err != nil {
+			return nil, err
+		}
+
+		commitments = append(commitments, commitment)
+	}
+
+	return commitments, nil
+}
+
+// deleteVoteCommitments deletes all the vote commitments of a proposal
+func (keeper Keeper) deleteVoteCommitments(ctx context.Context, proposalID uint64) error {
+	commitments, err := keeper.GetVoteCommitments(ctx, proposalID)
+	if err != nil {
+		return err
+	}
+
+	for _, commitment := range commitments {
+		voterAddr, err := keeper.authKeeper.StringToBytes(commitment.Voter)
+		if err != nil {
+			return err
+		}
+
+		if err := keeper.deleteVoteCommitment(ctx, proposalID, voterAddr); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// deleteVoteCommitment deletes the vote commitment of a voter on a proposal
+func (keeper Keeper) deleteVoteCommitment(ctx context.Context, proposalID uint64, voterAddr sdk.AccAddress) error {
+	store := keeper.storeService.OpenKVStore(ctx)
+	return store.Delete(types.VoteCommitmentKey(proposalID, voterAddr))
+}
@@ -0,0 +1,88 @@
+package keeper_test
+
+import (
+	"testing"
+	"time"
+
+	sdkmath "cosmossdk.io/math"
+	"github.com/stretchr/testify/require"
+
+	simtestutil "github.com/cosmos/cosmos-sdk/testutil/sims"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/gov/types"
+	v1 "github.com/cosmos/cosmos-sdk/x/gov/types/v1"
+)
+
+func TestCommitRevealVotes(t *testing.T) {
+	govKeeper, authKeeper, bankKeeper, stakingKeeper, _, _, ctx := setupGovKeeper(t)
+	addrs := simtestutil.AddTestAddrsIncremental(bankKeeper, stakingKeeper, ctx, 3, sdkmath.NewInt(10000000))
+	for _, addr := range addrs {
+		authKeeper.EXPECT().BytesToString(addr).Return(addr.String(), nil).AnyTimes()
+		authKeeper.EXPECT().StringToBytes(addr.String()).Return(addr, nil).AnyTimes()
+	}
+
+	params, err := govKeeper.GetParams(ctx)
+	require.NoError(t, err)
+	require.ErrorIs(t, govKeeper.ValidateCommitReveal(ctx, false), types.ErrCommitRevealVoting)
+
+	revealPeriod := time.Hour
+	params.RevealPeriod = &revealPeriod
+	require.NoError(t, govKeeper.SetParams(ctx, params))
+	require.ErrorIs(t, govKeeper.ValidateCommitReveal(ctx, true), types.ErrCommitRevealVoting)
+	require.NoError(t, govKeeper.ValidateCommitReveal(ctx, false))
+
+	proposal, err := govKeeper.SubmitProposal(ctx, TestProposal, "", "title", "description", sdk.AccAddress("cosmos1ghekyjucln7y67ntx7cf27m9dpuxxemn4c8g4r"), false)
+	require.NoError(t, err)
+	proposal.CommitReveal = true
+	require.NoError(t, govKeeper.ActivateVotingPeriod(ctx, proposal))
+	proposal, err = govKeeper.GetProposal(ctx, proposal.Id)
+	require.NoError(t, err)
+	require.Equal(t, ctx.BlockTime().Add(*params.VotingPeriod), *proposal.CommitEndTime)
+	require.Equal(t, proposal.CommitEndTime.Add(revealPeriod), *proposal.VotingEndTime)
+
+	// votes can only be committed to
+	yes := v1.NewNonSplitVoteOption(v1.OptionYes)
+	require.ErrorIs(t, govKeeper.AddVote(ctx, proposal.Id, addrs[0], yes, ""), types.ErrCommitRevealVoting)
+
+	salt := []byte("salt")
+	for _, addr := range addrs[:2] {
+		commitment, err := v1.VoteCommitmentHash(proposal.Id, addr, yes, salt)
+		require.NoError(t, err)
+		require.NoError(t, govKeeper.CommitVote(ctx, proposal.Id, addr, commitment))
+	}
+	require.ErrorIs(t, govKeeper.CommitVote(ctx, proposal.Id, addrs[2], []byte("short")), types.ErrInvalidVoteCommitment)
+
+	commitments, err := govKeeper.GetVoteCommitments(ctx, proposal.Id)
+	require.NoError(t, err)
+	require.Len(t, commitments, 2)
+
+	// votes cannot be revealed before the end of the commit period
+	require.ErrorIs(t, govKeeper.RevealVote(ctx, proposal.Id, addrs[0], yes, salt, ""), types.ErrCommitRevealVoting)
+
+	revealCtx := ctx.WithBlockTime(*proposal.CommitEndTime)
+	commitment, err := v1.VoteCommitmentHash(proposal.Id, addrs[2], yes, salt)
+	require.NoError(t, err)
+	require.ErrorIs(t, govKeeper.CommitVote(revealCtx, proposal.Id, addrs[2], commitment), types.ErrCommitRevealVoting)
+
+	// only the committed vote can be revealed
+	no := v1.NewNonSplitVoteOption(v1.OptionNo)
+	require.ErrorIs(t, govKeeper.RevealVote(revealCtx, proposal.Id, addrs[0], no, salt, ""), types.ErrInvalidVoteCommitment)
+	require.ErrorIs(t, govKeeper.RevealVote(revealCtx, proposal.Id, addrs[2], yes, salt, ""), types.ErrVoteCommitmentNotFound)
+	require.NoError(t, govKeeper.RevealVote(revealCtx, proposal.Id, addrs[0], yes, salt, "metadata"))
+
+	vote, err := govKeeper.GetVote(revealCtx, proposal.Id, addrs[0])
+	require.NoError(t, err)
+	require.Equal(t, v1.OptionYes, vote.Options[0].Option)
+	_, err = govKeeper.GetVoteCommitment(revealCtx, proposal.Id, addrs[0])
+	require.ErrorIs(t, err, types.ErrVoteCommitmentNotFound)
+
+	// the unrevealed commitments are counted as abstain votes
+	require.NoError(t, govKeeper.AbstainUnrevealedVotes(revealCtx, proposal.Id))
+	vote, err = govKeeper.GetVote(revealCtx, proposal.Id, addrs[1])
+	require.NoError(t, err)
+	require.Equal(t, v1.OptionAbstain, vote.Options[0].Option)
+
+	commitments, err = govKeeper.GetVoteCommitments(revealCtx, proposal.Id)
+	require.NoError(t, err)
+	require.Empty(t, commitments)
+}
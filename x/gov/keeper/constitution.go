@@ -2,8 +2,14 @@ package keeper
 
 import (
 	"context"
+	"fmt"
 
+	"cosmossdk.io/errors"
+	storetypes "cosmossdk.io/store/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/x/gov/types"
+	v1 "github.com/cosmos/cosmos-sdk/x/gov/types/v1"
 )
 
 // GetConstitution gets the chain's constitution.
@@ -19,3 +25,104 @@ func (keeper Keeper) SetConstitution(ctx context.Context, constitution string) e
 	store := keeper.storeService.OpenKVStore(ctx)
 	return store.Set(types.KeyConstitution, []byte(constitution))
 }
+
+// AmendConstitution replaces the chain's constitution with the one amended by
+// the given proposal and records the amendment in the amendment history.
+func (keeper Keeper) AmendConstitution(ctx context.Context, proposalID uint64, constitution string) (v1.ConstitutionAmendment, error) {
+	amendmentID, err := keeper.nextConstitutionAmendmentID(ctx)
+	if err != nil {
+		return v1.ConstitutionAmendment{}, err
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	amendment := v1.ConstitutionAmendment{
+		Id:           amendmentID,
+		ProposalId:   proposalID,
+		Constitution: constitution,
+		AmendedAt:    sdkCtx.BlockTime(),
+	}
+
+	if err := keeper.SetConstitutionAmendment(ctx, amendment); err != nil {
+		return v1.ConstitutionAmendment{}, err
+	}
+
+	if err := keeper.SetConstitution(ctx, constitution); err != nil {
+		return v1.ConstitutionAmendment{}, err
+	}
+
+	sdkCtx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeAmendConstitution,
+			sdk.NewAttribute(types.AttributeKeyProposalID, fmt.Sprintf("%d", proposalID)),
+			sdk.NewAttribute(types.AttributeKeyAmendmentID, fmt.Sprintf("%d", amendmentID)),
+		),
+	)
+
+	return amendment, nil
+}
+
+// SetConstitutionAmendment sets a constitution amendment to the store.
+func (keeper Keeper) SetConstitutionAmendment(ctx context.Context, amendment v1.ConstitutionAmendment) error {
+	bz, err := keeper.cdc.Marshal(&amendment)
+	if err != nil {
+		return err
+	}
+
+	store := keeper.storeService.OpenKVStore(ctx)
+	return store.Set(types.ConstitutionAmendmentKey(amendment.Id), bz)
+}
+
+// IterateConstitutionAmendments iterates over all the constitution amendments,
+// in the order they were made, and performs a callback function.
+func (keeper Keeper) IterateConstitutionAmendments(ctx context.Context, cb func(amendment v1.ConstitutionAmendment) error) error {
+	store := keeper.storeService.OpenKVStore(ctx)
+	iterator, err := store.Iterator(types.ConstitutionAmendmentsKeyPrefix, storetypes.PrefixEndBytes(types.ConstitutionAmendmentsKeyPrefix))
+	if err != nil {
+		return err
+	}
+
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		var amendment v1.ConstitutionAmendment
+		if err := keeper.cdc.Unmarshal(iterator.Value(), &amendment); err != nil {
+			return err
+		}
+
+		err = cb(amendment)
+		// exit early without error if cb returns ErrStopIterating
+		if errors.IsOf(err, errors.ErrStopIterating) {
+			return nil
+		} else if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// GetConstitutionAmendments returns all the constitution amendments, in the
+// order they were made.
+func (keeper Keeper) GetConstitutionAmendments(ctx context.Context) (amendments []*v1.ConstitutionAmendment, err error) {
+	err = keeper.IterateConstitutionAmendments(ctx, func(amendment v1.ConstitutionAmendment) error {
+		amendments = append(amendments, &amendment)
+		return nil
+	})
+	return
+}
+
+// nextConstitutionAmendmentID returns the ID following the one of the latest
+// constitution amendment.
+func (keeper Keeper) nextConstitutionAmendmentID(ctx context.Context) (uint64, error) {
+	store := keeper.storeService.OpenKVStore(ctx)
+	iterator, err := store.ReverseIterator(types.ConstitutionAmendmentsKeyPrefix, storetypes.PrefixEndBytes(types.ConstitutionAmendmentsKeyPrefix))
+	if err != nil {
+		return 0, err
+	}
+	defer iterator.Close()
+
+	if !iterator.Valid() {
+		return 1, nil
+	}
+
+	return sdk.BigEndianToUint64(iterator.Key()[len(types.ConstitutionAmendmentsKeyPrefix):]) + 1, nil
+}
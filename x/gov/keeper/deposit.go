@@ -90,7 +90,8 @@ func (keeper Keeper) DeleteAndBurnDeposits(ctx context.Context, proposalID uint6
 		if err != nil {
 			return err
 		}
-		return nil
+
+		return keeper.untrackDeposit(ctx, deposit)
 	})
 
 	return err
@@ -161,16 +162,32 @@ func (keeper Keeper) AddDeposit(ctx context.Context, proposalID uint64, deposito
 	}
 
 	// Check if proposal is still depositable
-	if (proposal.Status != v1.StatusDepositPeriod) && (proposal.Status != v1.StatusVotingPeriod) {
+	if (proposal.Status != v1.StatusDepositPeriod) && (proposal.Status != v1.StatusDiscussion) && (proposal.Status != v1.StatusVotingPeriod) {
 		return false, errors.Wrapf(types.ErrInactiveProposal, "%d", proposalID)
 	}
 
+	params, err := keeper.depositParams(ctx)
+	if err != nil {
+		return false, err
+	}
+
+	for _, coin := range depositAmount {
+		if !params.IsDepositDenom(coin.Denom) {
+			return false, errors.Wrapf(types.ErrInvalidDepositDenom, "%s is not accepted as a deposit", coin.Denom)
+		}
+	}
+
 	// update the governance module's account coins pool
 	err = keeper.bankKeeper.SendCoinsFromAccountToModule(ctx, depositorAddr, types.ModuleName, depositAmount)
 	if err != nil {
 		return false, err
 	}
 
+	err = keeper.bankKeeper.AddModuleEscrow(ctx, types.ModuleName, types.DepositEscrowPurpose(proposalID), depositAmount)
+	if err != nil {
+		return false, err
+	}
+
 	// Update proposal
 	proposal.TotalDeposit = sdk.NewCoins(proposal.TotalDeposit...).Add(depositAmount...)
 	err = keeper.SetProposal(ctx, proposal)
@@ -180,19 +197,31 @@ func (keeper Keeper) AddDeposit(ctx context.Context, proposalID uint64, deposito
 
 	// Check if deposit has provided sufficient total funds to transition the proposal into the voting period
 	activatedVotingPeriod := false
-	params, err := keeper.GetParams(ctx)
-	if err != nil {
-		return false, err
-	}
 	minDepositAmount := proposal.GetMinDepositFromParams(params)
 
-	if proposal.Status == v1.StatusDepositPeriod && sdk.NewCoins(proposal.TotalDeposit...).IsAllGTE(minDepositAmount) {
-		err = keeper.ActivateVotingPeriod(ctx, proposal)
+	// a proposal whose messages are no longer valid is failed instead of
+	// entering its voting period, once the deposit is recorded so that it is
+	// refunded or burned with the other deposits
+	var invalidation *v1.ProposalExecutionFailure
+
+	switch {
+	case proposal.Status == v1.StatusDepositPeriod && params.MeetsDeposit(proposal.TotalDeposit, minDepositAmount):
+		// the proposal is discussed before being voted on when the discussion
+		// period is enabled
+		if params.DiscussionEnabled() {
+			err = keeper.StartDiscussionPeriod(ctx, proposal)
+		} else if invalidation, err = keeper.RevalidateProposal(ctx, proposal); err == nil && invalidation == nil {
+			err = keeper.ActivateVotingPeriod(ctx, proposal)
+			activatedVotingPeriod = true
+		}
+
 		if err != nil {
 			return false, err
 		}
-
-		activatedVotingPeriod = true
+	case proposal.Status == v1.StatusDepositPeriod:
+		if err := keeper.extendDepositPeriod(ctx, proposal, params); err != nil {
+			return false, err
+		}
 	}
 
 	// Add or update deposit object
@@ -226,9 +255,145 @@ func (keeper Keeper) AddDeposit(ctx context.Context, proposalID uint64, deposito
 		return false, err
 	}
 
+	if invalidation != nil {
+		if err := keeper.failInvalidProposal(ctx, proposal, *invalidation); err != nil {
+			return false, err
+		}
+	}
+
 	return activatedVotingPeriod, nil
 }
 
+// extendDepositPeriod extends, once, the deposit period of a proposal whose
+// total deposit has reached the deposit extension ratio of the minimum deposit
+// less than the deposit extension period before its deposit end time. The
+// proposal is re-keyed in the inactive proposal queue.
+func (keeper Keeper) extendDepositPeriod(ctx context.Context, proposal v1.Proposal, params v1.Params) error {
+	if proposal.DepositPeriodExtended || !params.DepositExtensionEnabled() {
+		return nil
+	}
+
+	extensionPeriod := *params.DepositExtensionPeriod
+	if sdk.BlockTimeUntil(ctx, *proposal.DepositEndTime) > extensionPeriod {
+		return nil
+	}
+
+	ratio, err := sdkmath.LegacyNewDecFromStr(params.DepositExtensionRatio)
+	if err != nil {
+		return err
+	}
+
+	minDeposit := proposal.GetMinDepositFromParams(params)
+	threshold := make(sdk.Coins, len(minDeposit))
+	for i, coin := range minDeposit {
+		threshold[i] = sdk.NewCoin(coin.Denom, sdkmath.LegacyNewDecFromInt(coin.Amount).Mul(ratio).RoundInt())
+	}
+
+	if !params.MeetsDeposit(proposal.TotalDeposit, threshold) {
+		return nil
+	}
+
+	if err := keeper.RemoveFromInactiveProposalQueue(ctx, proposal.Id, *proposal.DepositEndTime); err != nil {
+		return err
+	}
+
+	depositEndTime := proposal.DepositEndTime.Add(extensionPeriod)
+	proposal.DepositEndTime = &depositEndTime
+	proposal.DepositPeriodExtended = true
+
+	if err := keeper.InsertInactiveProposalQueue(ctx, proposal.Id, depositEndTime); err != nil {
+		return err
+	}
+
+	if err := keeper.SetProposal(ctx, proposal); err != nil {
+		return err
+	}
+
+	sdk.UnwrapSDKContext(ctx).EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeDepositPeriodExtended,
+			sdk.NewAttribute(types.AttributeKeyProposalID, fmt.Sprintf("%d", proposal.Id)),
+			sdk.NewAttribute(types.AttributeKeyDepositEndTime, depositEndTime.String()),
+		),
+	)
+
+	return nil
+}
+
+// WithdrawDeposit refunds and deletes the deposit of a depositor on a proposal
+// in its discussion period. If the total deposit of the proposal falls below the
+// minimum deposit, the proposal returns to its deposit period, which ends at its
+// original deposit end time.
+func (keeper Keeper) WithdrawDeposit(ctx context.Context, proposalID uint64, depositorAddr sdk.AccAddress) error {
+	proposal, err := keeper.GetProposal(ctx, proposalID)
+	if err != nil {
+		return err
+	}
+
+	if proposal.Status != v1.StatusDiscussion {
+		return types.ErrInvalidProposal.Wrapf("proposal %d is not in the discussion period", proposalID)
+	}
+
+	deposit, err := keeper.GetDeposit(ctx, proposalID, depositorAddr)
+	if err != nil {
+		return err
+	}
+
+	err = keeper.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, depositorAddr, deposit.Amount)
+	if err != nil {
+		return err
+	}
+
+	store := keeper.storeService.OpenKVStore(ctx)
+	err = store.Delete(types.DepositKey(proposalID, depositorAddr))
+	if err != nil {
+		return err
+	}
+
+	err = keeper.untrackDeposit(ctx, deposit)
+	if err != nil {
+		return err
+	}
+
+	proposal.TotalDeposit = sdk.NewCoins(proposal.TotalDeposit...).Sub(deposit.Amount...)
+
+	params, err := keeper.depositParams(ctx)
+	if err != nil {
+		return err
+	}
+
+	if !params.MeetsDeposit(proposal.TotalDeposit, proposal.GetMinDepositFromParams(params)) {
+		err = keeper.RemoveFromDiscussionProposalQueue(ctx, proposal.Id, *proposal.DiscussionEndTime)
+		if err != nil {
+			return err
+		}
+
+		err = keeper.InsertInactiveProposalQueue(ctx, proposal.Id, *proposal.DepositEndTime)
+		if err != nil {
+			return err
+		}
+
+		proposal.Status = v1.StatusDepositPeriod
+		proposal.DiscussionEndTime = nil
+	}
+
+	err = keeper.SetProposal(ctx, proposal)
+	if err != nil {
+		return err
+	}
+
+	sdk.UnwrapSDKContext(ctx).EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeWithdrawDeposit,
+			sdk.NewAttribute(sdk.AttributeKeyAmount, sdk.NewCoins(deposit.Amount...).String()),
+			sdk.NewAttribute(types.AttributeKeyProposalID, fmt.Sprintf("%d", proposalID)),
+			sdk.NewAttribute(types.AttributeKeyDepositor, deposit.Depositor),
+		),
+	)
+
+	return nil
+}
+
 // ChargeDeposit will charge proposal cancellation fee (deposits * proposal_cancel_burn_rate)  and
 // send to a destAddress if defined or burn otherwise.
 // Remaining funds are send back to the depositor.
@@ -280,6 +445,11 @@ func (keeper Keeper) ChargeDeposit(ctx context.Context, proposalID uint64, destA
 		if err != nil {
 			return err
 		}
+
+		err = keeper.untrackDeposit(ctx, *deposit)
+		if err != nil {
+			return err
+		}
 	}
 
 	// burn the cancellation fee or sent the cancellation charges to destination address.
@@ -334,17 +504,24 @@ func (keeper Keeper) RefundAndDeleteDeposits(ctx context.Context, proposalID uin
 		if err != nil {
 			return err
 		}
-		return nil
+
+		return keeper.untrackDeposit(ctx, deposit)
 	})
 
 	return err
 }
 
-// validateInitialDeposit validates if initial deposit is greater than or equal to the minimum
-// required at the time of proposal submission. This threshold amount is determined by
-// the deposit parameters. Returns nil on success, error otherwise.
+// untrackDeposit removes a deleted deposit from the amount escrowed by the gov
+// module account for its proposal.
+func (keeper Keeper) untrackDeposit(ctx context.Context, deposit v1.Deposit) error {
+	return keeper.bankKeeper.SubModuleEscrow(ctx, types.ModuleName, types.DepositEscrowPurpose(deposit.ProposalId), deposit.Amount)
+}
+
+// validateInitialDeposit validates if the value of the initial deposit is greater than or
+// equal to the minimum required at the time of proposal submission. This threshold amount
+// is determined by the deposit parameters. Returns nil on success, error otherwise.
 func (keeper Keeper) validateInitialDeposit(ctx context.Context, initialDeposit sdk.Coins, expedited bool) error {
-	params, err := keeper.GetParams(ctx)
+	params, err := keeper.depositParams(ctx)
 	if err != nil {
 		return err
 	}
@@ -367,8 +544,34 @@ func (keeper Keeper) validateInitialDeposit(ctx context.Context, initialDeposit
 	for i := range minDepositCoins {
 		minDepositCoins[i].Amount = sdk.NewDecFromInt(minDepositCoins[i].Amount).Mul(minInitialDepositRatio).RoundInt()
 	}
-	if !initialDeposit.IsAllGTE(minDepositCoins) {
+	if !params.MeetsDeposit(initialDeposit, minDepositCoins) {
 		return errors.Wrapf(types.ErrMinDepositTooSmall, "was (%s), need (%s)", initialDeposit, minDepositCoins)
 	}
 	return nil
 }
+
+// depositParams returns the gov module's parameters with the ratios of the
+// accepted deposit denoms using an oracle ratio replaced by the ratios provided
+// by the deposit ratio oracle, when it has one.
+func (keeper Keeper) depositParams(ctx context.Context) (v1.Params, error) {
+	params, err := keeper.GetParams(ctx)
+	if err != nil || keeper.depositRatioOracle == nil {
+		return params, err
+	}
+
+	accepted := make([]v1.AcceptedDepositDenom, len(params.AcceptedDepositDenoms))
+	for i, denom := range params.AcceptedDepositDenoms {
+		accepted[i] = denom
+		if !denom.UseOracle {
+			continue
+		}
+
+		ratio, ok := keeper.depositRatioOracle.DepositRatio(ctx, denom.Denom, denom.BaseDenom)
+		if ok && ratio.IsPositive() {
+			accepted[i].Ratio = ratio.String()
+		}
+	}
+	params.AcceptedDepositDenoms = accepted
+
+	return params, nil
+}
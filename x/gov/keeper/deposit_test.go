@@ -1,8 +1,10 @@
 package keeper_test
 
 import (
+	"context"
 	"fmt"
 	"testing"
+	"time"
 
 	sdkmath "cosmossdk.io/math"
 	"github.com/stretchr/testify/require"
@@ -11,9 +13,11 @@ import (
 	simtestutil "github.com/cosmos/cosmos-sdk/testutil/sims"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
 	disttypes "github.com/cosmos/cosmos-sdk/x/distribution/types"
 	"github.com/cosmos/cosmos-sdk/x/gov/types"
 	v1 "github.com/cosmos/cosmos-sdk/x/gov/types/v1"
+	minttypes "github.com/cosmos/cosmos-sdk/x/mint/types"
 )
 
 const (
@@ -153,6 +157,343 @@ func TestDeposits(t *testing.T) {
 	}
 }
 
+func TestDepositPeriodExtension(t *testing.T) {
+	govKeeper, authKeeper, bankKeeper, stakingKeeper, distKeeper, _, ctx := setupGovKeeper(t)
+	trackMockBalances(bankKeeper, distKeeper)
+
+	TestAddrs := simtestutil.AddTestAddrsIncremental(bankKeeper, stakingKeeper, ctx, 1, sdkmath.NewInt(10000000))
+	authKeeper.EXPECT().BytesToString(TestAddrs[0]).Return(TestAddrs[0].String(), nil).AnyTimes()
+	authKeeper.EXPECT().StringToBytes(TestAddrs[0].String()).Return(TestAddrs[0], nil).AnyTimes()
+
+	extensionPeriod := time.Hour
+	params := v1.DefaultParams()
+	params.DepositExtensionPeriod = &extensionPeriod
+	require.NoError(t, govKeeper.SetParams(ctx, params))
+
+	proposal, err := govKeeper.SubmitProposal(ctx, TestProposal, "", "title", "summary", TestAddrs[0], false)
+	require.NoError(t, err)
+	depositEndTime := *proposal.DepositEndTime
+
+	proposalsInQueue := func(endTime time.Time) []uint64 {
+		var ids []uint64
+		err := govKeeper.IterateInactiveProposalsQueue(ctx, endTime, func(proposal v1.Proposal) error {
+			ids = append(ids, proposal.Id)
+			return nil
+		})
+		require.NoError(t, err)
+		return ids
+	}
+
+	oneStake := sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, stakingKeeper.TokensFromConsensusPower(ctx, 1)))
+	sevenStake := sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, stakingKeeper.TokensFromConsensusPower(ctx, 7)))
+
+	// below the extension ratio close to the deadline: no extension
+	ctx = ctx.WithBlockTime(depositEndTime.Add(-30 * time.Minute))
+	_, err = govKeeper.AddDeposit(ctx, proposal.Id, TestAddrs[0], sevenStake)
+	require.NoError(t, err)
+	proposal, err = govKeeper.GetProposal(ctx, proposal.Id)
+	require.NoError(t, err)
+	require.False(t, proposal.DepositPeriodExtended)
+	require.Equal(t, depositEndTime, *proposal.DepositEndTime)
+
+	// reaching the extension ratio close to the deadline extends the deposit period
+	_, err = govKeeper.AddDeposit(ctx, proposal.Id, TestAddrs[0], oneStake)
+	require.NoError(t, err)
+	proposal, err = govKeeper.GetProposal(ctx, proposal.Id)
+	require.NoError(t, err)
+	require.True(t, proposal.DepositPeriodExtended)
+	require.Equal(t, depositEndTime.Add(extensionPeriod), *proposal.DepositEndTime)
+	require.Empty(t, proposalsInQueue(depositEndTime))
+	require.Equal(t, []uint64{proposal.Id}, proposalsInQueue(depositEndTime.Add(extensionPeriod)))
+
+	events := ctx.EventManager().Events()
+	require.Equal(t, types.EventTypeDepositPeriodExtended, events[len(events)-2].Type)
+
+	// the deposit period is only extended once
+	ctx = ctx.WithBlockTime(depositEndTime.Add(extensionPeriod).Add(-time.Minute))
+	_, err = govKeeper.AddDeposit(ctx, proposal.Id, TestAddrs[0], oneStake)
+	require.NoError(t, err)
+	proposal, err = govKeeper.GetProposal(ctx, proposal.Id)
+	require.NoError(t, err)
+	require.Equal(t, depositEndTime.Add(extensionPeriod), *proposal.DepositEndTime)
+}
+
+func TestDiscussionPeriod(t *testing.T) {
+	govKeeper, authKeeper, bankKeeper, stakingKeeper, distKeeper, _, ctx := setupGovKeeper(t)
+	trackMockBalances(bankKeeper, distKeeper)
+
+	TestAddrs := simtestutil.AddTestAddrsIncremental(bankKeeper, stakingKeeper, ctx, 2, sdkmath.NewInt(10000000))
+	for _, addr := range TestAddrs {
+		authKeeper.EXPECT().BytesToString(addr).Return(addr.String(), nil).AnyTimes()
+		authKeeper.EXPECT().StringToBytes(addr.String()).Return(addr, nil).AnyTimes()
+	}
+
+	discussionPeriod := time.Hour
+	params := v1.DefaultParams()
+	params.DiscussionPeriod = &discussionPeriod
+	require.NoError(t, govKeeper.SetParams(ctx, params))
+
+	proposal, err := govKeeper.SubmitProposal(ctx, TestProposal, "", "title", "summary", TestAddrs[0], false)
+	require.NoError(t, err)
+
+	fiveStake := sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, stakingKeeper.TokensFromConsensusPower(ctx, 5)))
+
+	// the metadata can only be amended during the discussion period
+	require.ErrorIs(t, govKeeper.UpdateProposalMetadata(ctx, proposal.Id, TestAddrs[0].String(), "new metadata"), types.ErrInvalidProposal)
+
+	// reaching the minimum deposit starts the discussion period
+	for _, addr := range TestAddrs {
+		votingStarted, err := govKeeper.AddDeposit(ctx, proposal.Id, addr, fiveStake)
+		require.NoError(t, err)
+		require.False(t, votingStarted)
+	}
+
+	proposal, err = govKeeper.GetProposal(ctx, proposal.Id)
+	require.NoError(t, err)
+	require.Equal(t, v1.StatusDiscussion, proposal.Status)
+	require.Equal(t, ctx.BlockHeader().Time.Add(discussionPeriod), *proposal.DiscussionEndTime)
+
+	// only the proposer can amend the metadata
+	require.ErrorIs(t, govKeeper.UpdateProposalMetadata(ctx, proposal.Id, TestAddrs[1].String(), "new metadata"), types.ErrInvalidProposer)
+	require.NoError(t, govKeeper.UpdateProposalMetadata(ctx, proposal.Id, TestAddrs[0].String(), "new metadata"))
+
+	proposal, err = govKeeper.GetProposal(ctx, proposal.Id)
+	require.NoError(t, err)
+	require.Equal(t, "new metadata", proposal.Metadata)
+
+	// withdrawing below the minimum deposit returns the proposal to the deposit period
+	balance := bankKeeper.GetAllBalances(ctx, TestAddrs[1])
+	require.NoError(t, govKeeper.WithdrawDeposit(ctx, proposal.Id, TestAddrs[1]))
+	require.Equal(t, balance.Add(fiveStake...), bankKeeper.GetAllBalances(ctx, TestAddrs[1]))
+
+	_, err = govKeeper.GetDeposit(ctx, proposal.Id, TestAddrs[1])
+	require.ErrorIs(t, err, types.ErrDepositNotFound)
+
+	proposal, err = govKeeper.GetProposal(ctx, proposal.Id)
+	require.NoError(t, err)
+	require.Equal(t, v1.StatusDepositPeriod, proposal.Status)
+	require.Nil(t, proposal.DiscussionEndTime)
+	require.Equal(t, fiveStake, sdk.NewCoins(proposal.TotalDeposit...))
+
+	// deposits can only be withdrawn during the discussion period
+	require.ErrorIs(t, govKeeper.WithdrawDeposit(ctx, proposal.Id, TestAddrs[0]), types.ErrInvalidProposal)
+}
+
+func TestInvalidatedProposalDeposit(t *testing.T) {
+	govKeeper, authKeeper, bankKeeper, stakingKeeper, distKeeper, _, ctx := setupGovKeeper(t)
+	trackMockBalances(bankKeeper, distKeeper)
+
+	TestAddrs := simtestutil.AddTestAddrsIncremental(bankKeeper, stakingKeeper, ctx, 1, sdkmath.NewInt(10000000))
+	authKeeper.EXPECT().BytesToString(TestAddrs[0]).Return(TestAddrs[0].String(), nil).AnyTimes()
+	authKeeper.EXPECT().StringToBytes(TestAddrs[0].String()).Return(TestAddrs[0], nil).AnyTimes()
+
+	proposal, err := govKeeper.SubmitProposal(ctx, TestProposal, "", "title", "summary", TestAddrs[0], false)
+	require.NoError(t, err)
+
+	// the message became invalid after the proposal was submitted
+	govKeeper.SetProposalMsgValidators(types.ProposalMsgValidatorRoute{
+		MsgTypeURL: sdk.MsgTypeURL(TestProposal[0]),
+		Validator: func(_ context.Context, _ sdk.Msg) error {
+			return fmt.Errorf("recipient is no longer allowed")
+		},
+	})
+
+	balance := bankKeeper.GetAllBalances(ctx, TestAddrs[0])
+	votingStarted, err := govKeeper.AddDeposit(ctx, proposal.Id, TestAddrs[0], v1.DefaultParams().MinDeposit)
+	require.NoError(t, err)
+	require.False(t, votingStarted)
+
+	proposal, err = govKeeper.GetProposal(ctx, proposal.Id)
+	require.NoError(t, err)
+	require.Equal(t, v1.StatusFailed, proposal.Status)
+	require.Nil(t, proposal.VotingStartTime)
+	require.Equal(t, &v1.ProposalExecutionFailure{
+		MsgTypeUrl: sdk.MsgTypeURL(TestProposal[0]),
+		Reason:     "recipient is no longer allowed",
+	}, proposal.InvalidationFailure)
+
+	// the deposit, including the one which met the minimum deposit, is refunded
+	require.Equal(t, balance, bankKeeper.GetAllBalances(ctx, TestAddrs[0]))
+	deposits, err := govKeeper.GetDeposits(ctx, proposal.Id)
+	require.NoError(t, err)
+	require.Empty(t, deposits)
+
+	inactiveQueue, err := govKeeper.InactiveProposalQueueIterator(ctx, *proposal.DepositEndTime)
+	require.NoError(t, err)
+	require.False(t, inactiveQueue.Valid())
+	inactiveQueue.Close()
+}
+
+func TestAmendProposal(t *testing.T) {
+	govKeeper, authKeeper, bankKeeper, stakingKeeper, distKeeper, _, ctx := setupGovKeeper(t)
+	trackMockBalances(bankKeeper, distKeeper)
+
+	TestAddrs := simtestutil.AddTestAddrsIncremental(bankKeeper, stakingKeeper, ctx, 2, sdkmath.NewInt(10000000))
+	for _, addr := range TestAddrs {
+		authKeeper.EXPECT().BytesToString(addr).Return(addr.String(), nil).AnyTimes()
+		authKeeper.EXPECT().StringToBytes(addr.String()).Return(addr, nil).AnyTimes()
+	}
+
+	discussionPeriod, amendmentPeriod := time.Hour, 30*time.Minute
+	params := v1.DefaultParams()
+	params.DiscussionPeriod = &discussionPeriod
+	params.AmendmentPeriod = &amendmentPeriod
+	require.NoError(t, govKeeper.SetParams(ctx, params))
+
+	proposal, err := govKeeper.SubmitProposal(ctx, TestProposal, "", "title", "summary", TestAddrs[0], false)
+	require.NoError(t, err)
+
+	amendedMsgs := []sdk.Msg{banktypes.NewMsgSend(govAcct, addr, sdk.NewCoins(sdk.NewCoin("stake", sdkmath.NewInt(500))))}
+
+	// the proposal can only be amended during the discussion period
+	err = govKeeper.AmendProposal(ctx, proposal.Id, TestAddrs[0].String(), amendedMsgs, "new metadata", "new title", "new summary")
+	require.ErrorIs(t, err, types.ErrInvalidProposal)
+
+	fiveStake := sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, stakingKeeper.TokensFromConsensusPower(ctx, 5)))
+	for _, addr := range TestAddrs {
+		_, err := govKeeper.AddDeposit(ctx, proposal.Id, addr, fiveStake)
+		require.NoError(t, err)
+	}
+
+	proposal, err = govKeeper.GetProposal(ctx, proposal.Id)
+	require.NoError(t, err)
+	require.Equal(t, v1.StatusDiscussion, proposal.Status)
+	discussionEndTime := *proposal.DiscussionEndTime
+
+	// only the proposer can amend the proposal, with valid messages
+	err = govKeeper.AmendProposal(ctx, proposal.Id, TestAddrs[1].String(), amendedMsgs, "new metadata", "new title", "new summary")
+	require.ErrorIs(t, err, types.ErrInvalidProposer)
+
+	invalidMsgs := []sdk.Msg{banktypes.NewMsgSend(TestAddrs[0], addr, sdk.NewCoins(sdk.NewCoin("stake", sdkmath.NewInt(500))))}
+	err = govKeeper.AmendProposal(ctx, proposal.Id, TestAddrs[0].String(), invalidMsgs, "new metadata", "new title", "new summary")
+	require.ErrorIs(t, err, types.ErrInvalidSigner)
+
+	// an amendment early in the discussion period leaves its end time unchanged
+	require.NoError(t, govKeeper.AmendProposal(ctx, proposal.Id, TestAddrs[0].String(), amendedMsgs, "new metadata", "new title", "new summary"))
+
+	proposal, err = govKeeper.GetProposal(ctx, proposal.Id)
+	require.NoError(t, err)
+	require.Equal(t, discussionEndTime, *proposal.DiscussionEndTime)
+	require.Equal(t, "new metadata", proposal.Metadata)
+	require.Equal(t, "new title", proposal.Title)
+	require.Equal(t, "new summary", proposal.Summary)
+
+	msgs, err := proposal.GetMsgs()
+	require.NoError(t, err)
+	require.Len(t, msgs, 1)
+	require.Equal(t, amendedMsgs[0].String(), msgs[0].String())
+
+	// a late amendment extends the discussion period by the amendment period
+	ctx = ctx.WithBlockTime(ctx.BlockTime().Add(45 * time.Minute))
+	require.NoError(t, govKeeper.AmendProposal(ctx, proposal.Id, TestAddrs[0].String(), TestProposal, "", "title", "summary"))
+
+	proposal, err = govKeeper.GetProposal(ctx, proposal.Id)
+	require.NoError(t, err)
+	require.Equal(t, ctx.BlockTime().Add(amendmentPeriod), *proposal.DiscussionEndTime)
+
+	var queued []uint64
+	require.NoError(t, govKeeper.IterateDiscussionProposalsQueue(ctx, discussionEndTime, func(p v1.Proposal) error {
+		queued = append(queued, p.Id)
+		return nil
+	}))
+	require.Empty(t, queued)
+
+	require.NoError(t, govKeeper.IterateDiscussionProposalsQueue(ctx, *proposal.DiscussionEndTime, func(p v1.Proposal) error {
+		queued = append(queued, p.Id)
+		return nil
+	}))
+	require.Equal(t, []uint64{proposal.Id}, queued)
+}
+
+func TestMultiDenomDeposits(t *testing.T) {
+	govKeeper, authKeeper, bankKeeper, stakingKeeper, distKeeper, _, ctx := setupGovKeeper(t)
+	trackMockBalances(bankKeeper, distKeeper)
+
+	TestAddrs := simtestutil.AddTestAddrsIncremental(bankKeeper, stakingKeeper, ctx, 1, sdkmath.NewInt(10000000))
+	authKeeper.EXPECT().BytesToString(TestAddrs[0]).Return(TestAddrs[0].String(), nil).AnyTimes()
+	authKeeper.EXPECT().StringToBytes(TestAddrs[0].String()).Return(TestAddrs[0], nil).AnyTimes()
+
+	params := v1.DefaultParams()
+	params.AcceptedDepositDenoms = []v1.AcceptedDepositDenom{
+		{Denom: "lstake", BaseDenom: sdk.DefaultBondDenom, Ratio: "2"},
+	}
+	require.NoError(t, govKeeper.SetParams(ctx, params))
+
+	lstake := sdk.NewCoins(sdk.NewCoin("lstake", v1.DefaultMinDepositTokens.QuoRaw(4)))
+	require.NoError(t, bankKeeper.SendCoinsFromModuleToAccount(ctx, minttypes.ModuleName, TestAddrs[0], lstake.Add(lstake...)))
+	balance := bankKeeper.GetAllBalances(ctx, TestAddrs[0])
+
+	proposal, err := govKeeper.SubmitProposal(ctx, TestProposal, "", "title", "summary", TestAddrs[0], false)
+	require.NoError(t, err)
+
+	// half of the minimum deposit value
+	votingStarted, err := govKeeper.AddDeposit(ctx, proposal.Id, TestAddrs[0], lstake)
+	require.NoError(t, err)
+	require.False(t, votingStarted)
+
+	// the accepted denom deposits add up to the minimum deposit value
+	votingStarted, err = govKeeper.AddDeposit(ctx, proposal.Id, TestAddrs[0], lstake)
+	require.NoError(t, err)
+	require.True(t, votingStarted)
+
+	proposal, err = govKeeper.GetProposal(ctx, proposal.Id)
+	require.NoError(t, err)
+	require.Equal(t, v1.StatusVotingPeriod, proposal.Status)
+	require.Equal(t, lstake.Add(lstake...), sdk.NewCoins(proposal.TotalDeposit...))
+
+	deposit, err := govKeeper.GetDeposit(ctx, proposal.Id, TestAddrs[0])
+	require.NoError(t, err)
+	require.Equal(t, lstake.Add(lstake...), sdk.NewCoins(deposit.Amount...))
+
+	// deposits are refunded in the denom they were made in
+	require.NoError(t, govKeeper.RefundAndDeleteDeposits(ctx, proposal.Id))
+	require.Equal(t, balance, bankKeeper.GetAllBalances(ctx, TestAddrs[0]))
+}
+
+// depositRatioOracle is a deposit ratio oracle with fixed ratios, keyed by denom.
+type depositRatioOracle map[string]sdkmath.LegacyDec
+
+func (o depositRatioOracle) DepositRatio(_ context.Context, denom, _ string) (sdkmath.LegacyDec, bool) {
+	ratio, ok := o[denom]
+	return ratio, ok
+}
+
+func TestOracleDepositRatios(t *testing.T) {
+	govKeeper, authKeeper, bankKeeper, stakingKeeper, distKeeper, _, ctx := setupGovKeeper(t)
+	trackMockBalances(bankKeeper, distKeeper)
+	govKeeper.SetDepositRatioOracle(depositRatioOracle{"lstake": sdkmath.LegacyNewDec(4)})
+
+	TestAddrs := simtestutil.AddTestAddrsIncremental(bankKeeper, stakingKeeper, ctx, 1, sdkmath.NewInt(10000000))
+	authKeeper.EXPECT().BytesToString(TestAddrs[0]).Return(TestAddrs[0].String(), nil).AnyTimes()
+	authKeeper.EXPECT().StringToBytes(TestAddrs[0].String()).Return(TestAddrs[0], nil).AnyTimes()
+
+	params := v1.DefaultParams()
+	params.AcceptedDepositDenoms = []v1.AcceptedDepositDenom{
+		{Denom: "lstake", BaseDenom: sdk.DefaultBondDenom, Ratio: "1", UseOracle: true},
+	}
+	require.NoError(t, govKeeper.SetParams(ctx, params))
+
+	lstake := sdk.NewCoins(sdk.NewCoin("lstake", v1.DefaultMinDepositTokens.QuoRaw(4)))
+	other := sdk.NewCoins(sdk.NewCoin("other", v1.DefaultMinDepositTokens))
+	require.NoError(t, bankKeeper.SendCoinsFromModuleToAccount(ctx, minttypes.ModuleName, TestAddrs[0], lstake.Add(other...)))
+
+	proposal, err := govKeeper.SubmitProposal(ctx, TestProposal, "", "title", "summary", TestAddrs[0], false)
+	require.NoError(t, err)
+
+	// denoms which are neither minimum deposit nor accepted denoms are rejected
+	_, err = govKeeper.AddDeposit(ctx, proposal.Id, TestAddrs[0], other)
+	require.ErrorIs(t, err, types.ErrInvalidDepositDenom)
+
+	// the oracle ratio values the deposit at the minimum deposit
+	votingStarted, err := govKeeper.AddDeposit(ctx, proposal.Id, TestAddrs[0], lstake)
+	require.NoError(t, err)
+	require.True(t, votingStarted)
+
+	deposit, err := govKeeper.GetDeposit(ctx, proposal.Id, TestAddrs[0])
+	require.NoError(t, err)
+	require.Equal(t, lstake, sdk.NewCoins(deposit.Amount...))
+}
+
 func TestValidateInitialDeposit(t *testing.T) {
 	testcases := map[string]struct {
 		minDeposit               sdk.Coins
@@ -0,0 +1,80 @@
+package keeper
+
+import (
+	"context"
+
+	storetypes "cosmossdk.io/store/types"
+
+	"github.com/cosmos/cosmos-sdk/x/gov/types"
+	v1 "github.com/cosmos/cosmos-sdk/x/gov/types/v1"
+)
+
+// NextEarlyResolutionCandidates returns the next proposals in their voting
+// period to check for early resolution, at most MaxEarlyResolutionChecks of
+// them. The proposals are visited in a round-robin fashion by proposal ID, so
+// that every proposal in its voting period gets checked regularly while the
+// work done per block stays bounded.
+func (keeper Keeper) NextEarlyResolutionCandidates(ctx context.Context) ([]v1.Proposal, error) {
+	store := keeper.storeService.OpenKVStore(ctx)
+
+	bz, err := store.Get(types.EarlyResolutionCursorKey)
+	if err != nil {
+		return nil, err
+	}
+
+	var cursor uint64
+	if bz != nil {
+		cursor = types.GetProposalIDFromBytes(bz)
+	}
+
+	limit := int(keeper.config.MaxEarlyResolutionChecks)
+	proposalIDs := make([]uint64, 0, limit)
+
+	collect := func(start, end []byte) error {
+		iterator, err := store.Iterator(start, end)
+		if err != nil {
+			return err
+		}
+		defer iterator.Close()
+
+		for ; iterator.Valid() && len(proposalIDs) < limit; iterator.Next() {
+			proposalIDs = append(proposalIDs, types.GetProposalIDFromBytes(iterator.Key()[len(types.VotingPeriodProposalKeyPrefix):]))
+		}
+
+		return nil
+	}
+
+	// visit the proposals from the cursor, then wrap around
+	err = collect(types.VotingPeriodProposalKey(cursor), storetypes.PrefixEndBytes(types.VotingPeriodProposalKeyPrefix))
+	if err != nil {
+		return nil, err
+	}
+
+	if len(proposalIDs) < limit {
+		err = collect(types.VotingPeriodProposalKeyPrefix, types.VotingPeriodProposalKey(cursor))
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	if len(proposalIDs) == 0 {
+		return nil, nil
+	}
+
+	err = store.Set(types.EarlyResolutionCursorKey, types.GetProposalIDBytes(proposalIDs[len(proposalIDs)-1]+1))
+	if err != nil {
+		return nil, err
+	}
+
+	proposals := make([]v1.Proposal, 0, len(proposalIDs))
+	for _, proposalID := range proposalIDs {
+		proposal, err := keeper.GetProposal(ctx, proposalID)
+		if err != nil {
+			return nil, err
+		}
+
+		proposals = append(proposals, proposal)
+	}
+
+	return proposals, nil
+}
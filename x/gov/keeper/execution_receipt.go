@@ -0,0 +1,57 @@
+package keeper
+
+import (
+	"context"
+
+	storetypes "cosmossdk.io/store/types"
+
+	"github.com/cosmos/cosmos-sdk/runtime"
+	"github.com/cosmos/cosmos-sdk/x/gov/types"
+	v1 "github.com/cosmos/cosmos-sdk/x/gov/types/v1"
+)
+
+// GetExecutionReceipt gets the execution receipt of a passed proposal
+func (keeper Keeper) GetExecutionReceipt(ctx context.Context, proposalID uint64) (receipt v1.ProposalExecutionReceipt, err error) {
+	store := keeper.storeService.OpenKVStore(ctx)
+	bz, err := store.Get(types.ExecutionReceiptKey(proposalID))
+	if err != nil {
+		return receipt, err
+	}
+
+	if bz == nil {
+		return receipt, types.ErrExecutionReceiptNotFound
+	}
+
+	err = keeper.cdc.Unmarshal(bz, &receipt)
+	return receipt, err
+}
+
+// SetExecutionReceipt sets the execution receipt of a passed proposal
+func (keeper Keeper) SetExecutionReceipt(ctx context.Context, receipt v1.ProposalExecutionReceipt) error {
+	store := keeper.storeService.OpenKVStore(ctx)
+	bz, err := keeper.cdc.Marshal(&receipt)
+	if err != nil {
+		return err
+	}
+
+	return store.Set(types.ExecutionReceiptKey(receipt.ProposalId), bz)
+}
+
+// GetExecutionReceipts returns the execution receipts of all the passed
+// proposals, ordered by proposal ID
+func (keeper Keeper) GetExecutionReceipts(ctx context.Context) (receipts []*v1.ProposalExecutionReceipt, err error) {
+	store := keeper.storeService.OpenKVStore(ctx)
+	iterator := storetypes.KVStorePrefixIterator(runtime.KVStoreAdapter(store), types.ExecutionReceiptsKeyPrefix)
+
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		var receipt v1.ProposalExecutionReceipt
+		if err := keeper.cdc.Unmarshal(iterator.Value(), &receipt); err != nil {
+			return nil, err
+		}
+
+		receipts = append(receipts, &receipt)
+	}
+
+	return receipts, nil
+}
@@ -7,3 +7,9 @@ import sdk "github.com/cosmos/cosmos-sdk/types"
 func (k Keeper) ValidateInitialDeposit(ctx sdk.Context, initialDeposit sdk.Coins, expedited bool) error {
 	return k.validateInitialDeposit(ctx, initialDeposit, expedited)
 }
+
+// ValidateCommitReveal is a helper function used only in commit-reveal tests which returns the same
+// functionality of validateCommitReveal private function.
+func (k Keeper) ValidateCommitReveal(ctx sdk.Context, expedited bool) error {
+	return k.validateCommitReveal(ctx, expedited)
+}
@@ -29,6 +29,81 @@ func (q Keeper) Constitution(ctx context.Context, req *v1.QueryConstitutionReque
 	return &v1.QueryConstitutionResponse{Constitution: constitution}, nil
 }
 
+// ConstitutionAmendments returns the amendments made to the constitution
+func (q Keeper) ConstitutionAmendments(ctx context.Context, req *v1.QueryConstitutionAmendmentsRequest) (*v1.QueryConstitutionAmendmentsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	var amendments []*v1.ConstitutionAmendment
+
+	store := q.storeService.OpenKVStore(ctx)
+	amendmentStore := prefix.NewStore(runtime.KVStoreAdapter(store), types.ConstitutionAmendmentsKeyPrefix)
+
+	pageRes, err := query.Paginate(amendmentStore, req.Pagination, func(key, value []byte) error {
+		var amendment v1.ConstitutionAmendment
+		if err := q.cdc.Unmarshal(value, &amendment); err != nil {
+			return err
+		}
+
+		amendments = append(amendments, &amendment)
+		return nil
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &v1.QueryConstitutionAmendmentsResponse{Amendments: amendments, Pagination: pageRes}, nil
+}
+
+// MetadataSchema returns a registered metadata schema based on its ID
+func (q Keeper) MetadataSchema(ctx context.Context, req *v1.QueryMetadataSchemaRequest) (*v1.QueryMetadataSchemaResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	if req.SchemaId == 0 {
+		return nil, status.Error(codes.InvalidArgument, "metadata schema id can not be 0")
+	}
+
+	schema, err := q.GetMetadataSchema(ctx, req.SchemaId)
+	if err != nil {
+		if errors.IsOf(err, types.ErrMetadataSchemaNotFound) {
+			return nil, status.Errorf(codes.NotFound, "metadata schema %d doesn't exist", req.SchemaId)
+		}
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &v1.QueryMetadataSchemaResponse{Schema: &schema}, nil
+}
+
+// MetadataSchemas returns the registered metadata schemas
+func (q Keeper) MetadataSchemas(ctx context.Context, req *v1.QueryMetadataSchemasRequest) (*v1.QueryMetadataSchemasResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	var schemas []*v1.MetadataSchema
+
+	store := q.storeService.OpenKVStore(ctx)
+	schemaStore := prefix.NewStore(runtime.KVStoreAdapter(store), types.MetadataSchemasKeyPrefix)
+
+	pageRes, err := query.Paginate(schemaStore, req.Pagination, func(key, value []byte) error {
+		var schema v1.MetadataSchema
+		if err := q.cdc.Unmarshal(value, &schema); err != nil {
+			return err
+		}
+
+		schemas = append(schemas, &schema)
+		return nil
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &v1.QueryMetadataSchemasResponse{Schemas: schemas, Pagination: pageRes}, nil
+}
+
 // Proposal returns proposal details based on ProposalID
 func (q Keeper) Proposal(ctx context.Context, req *v1.QueryProposalRequest) (*v1.QueryProposalResponse, error) {
 	if req == nil {
@@ -135,6 +210,58 @@ func (q Keeper) Vote(ctx context.Context, req *v1.QueryVoteRequest) (*v1.QueryVo
 	return &v1.QueryVoteResponse{Vote: &vote}, nil
 }
 
+// VoteCommitment returns the unrevealed vote commitment of a voter on a proposal
+func (q Keeper) VoteCommitment(ctx context.Context, req *v1.QueryVoteCommitmentRequest) (*v1.QueryVoteCommitmentResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	if req.ProposalId == 0 {
+		return nil, status.Error(codes.InvalidArgument, "proposal id can not be 0")
+	}
+
+	if req.Voter == "" {
+		return nil, status.Error(codes.InvalidArgument, "empty voter address")
+	}
+
+	voter, err := q.authKeeper.StringToBytes(req.Voter)
+	if err != nil {
+		return nil, err
+	}
+
+	commitment, err := q.GetVoteCommitment(ctx, req.ProposalId, voter)
+	if err != nil {
+		if errors.IsOf(err, types.ErrVoteCommitmentNotFound) {
+			return nil, status.Errorf(codes.NotFound,
+				"vote commitment of voter: %v not found for proposal: %v", req.Voter, req.ProposalId)
+		}
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &v1.QueryVoteCommitmentResponse{Commitment: &commitment}, nil
+}
+
+// ExecutionReceipt returns the execution receipt of a passed proposal
+func (q Keeper) ExecutionReceipt(ctx context.Context, req *v1.QueryExecutionReceiptRequest) (*v1.QueryExecutionReceiptResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	if req.ProposalId == 0 {
+		return nil, status.Error(codes.InvalidArgument, "proposal id can not be 0")
+	}
+
+	receipt, err := q.GetExecutionReceipt(ctx, req.ProposalId)
+	if err != nil {
+		if errors.IsOf(err, types.ErrExecutionReceiptNotFound) {
+			return nil, status.Errorf(codes.NotFound, "execution receipt of proposal %d not found", req.ProposalId)
+		}
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &v1.QueryExecutionReceiptResponse{Receipt: &receipt}, nil
+}
+
 // Votes returns single proposal's votes
 func (q Keeper) Votes(ctx context.Context, req *v1.QueryVotesRequest) (*v1.QueryVotesResponse, error) {
 	if req == nil {
@@ -189,6 +316,8 @@ func (q Keeper) Params(ctx context.Context, req *v1.QueryParamsRequest) (*v1.Que
 
 	case v1.ParamTallying:
 		tallyParams := v1.NewTallyParams(params.Quorum, params.Threshold, params.VetoThreshold)
+		tallyParams.VetoQuorum = params.VetoQuorum
+		tallyParams.ProposalKindQuorums = params.ProposalKindQuorums
 		response.TallyParams = &tallyParams
 
 	default:
@@ -301,6 +430,95 @@ func (q Keeper) TallyResult(ctx context.Context, req *v1.QueryTallyResultRequest
 	return &v1.QueryTallyResultResponse{Tally: &tallyResult}, nil
 }
 
+// ValidatorVoteBreakdowns queries the per-validator voting power breakdown of a proposal in voting period
+func (q Keeper) ValidatorVoteBreakdowns(ctx context.Context, req *v1.QueryValidatorVoteBreakdownsRequest) (*v1.QueryValidatorVoteBreakdownsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	if req.ProposalId == 0 {
+		return nil, status.Error(codes.InvalidArgument, "proposal id can not be 0")
+	}
+
+	proposal, err := q.GetProposal(ctx, req.ProposalId)
+	if err != nil {
+		if errors.IsOf(err, types.ErrProposalNotFound) {
+			return nil, status.Errorf(codes.NotFound, "proposal %d doesn't exist", req.ProposalId)
+		}
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	// votes are deleted once a proposal is tallied at the end of its voting
+	// period, the breakdown of a finished proposal is only available through
+	// the events emitted in that block.
+	if proposal.Status != v1.StatusVotingPeriod {
+		return nil, status.Errorf(codes.FailedPrecondition, "proposal %d is not in voting period", req.ProposalId)
+	}
+
+	// the tally deletes the votes and records the breakdown in the transient
+	// store, run it in a branched context so that neither write is persisted.
+	cacheCtx, _ := sdk.UnwrapSDKContext(ctx).CacheContext()
+	if _, _, _, err := q.Tally(cacheCtx, proposal); err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	breakdowns, err := q.GetValidatorVoteBreakdowns(cacheCtx, req.ProposalId)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &v1.QueryValidatorVoteBreakdownsResponse{Breakdowns: breakdowns}, nil
+}
+
+// ParticipationExemptValidators queries the bonded validators currently exempt from participation tracking
+func (q Keeper) ParticipationExemptValidators(ctx context.Context, req *v1.QueryParticipationExemptValidatorsRequest) (*v1.QueryParticipationExemptValidatorsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	params, err := q.GetParams(ctx)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	valAddrs, err := q.GetParticipationExemptValidators(ctx)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &v1.QueryParticipationExemptValidatorsResponse{
+		Threshold:          params.ParticipationExemption().String(),
+		ValidatorAddresses: valAddrs,
+	}, nil
+}
+
+// SimulateProposal executes the messages of a candidate proposal against a branch of the current state
+func (q Keeper) SimulateProposal(ctx context.Context, req *v1.QuerySimulateProposalRequest) (*v1.QuerySimulateProposalResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	if len(req.Messages) == 0 {
+		return nil, status.Error(codes.InvalidArgument, "messages can not be empty")
+	}
+
+	msgs, err := req.GetMsgs()
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	gasUsed, events, failure, err := q.SimulateProposalMsgs(ctx, msgs, req.ExecutionAuthority)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	return &v1.QuerySimulateProposalResponse{
+		GasUsed: gasUsed,
+		Events:  events.ToABCIEvents(),
+		Failure: failure,
+	}, nil
+}
+
 var _ v1beta1.QueryServer = legacyQueryServer{}
 
 type legacyQueryServer struct {
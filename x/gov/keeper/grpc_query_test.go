@@ -12,6 +12,7 @@ import (
 
 	"github.com/cosmos/cosmos-sdk/codec/address"
 	"github.com/cosmos/cosmos-sdk/types/query"
+	sdktx "github.com/cosmos/cosmos-sdk/types/tx"
 	v3 "github.com/cosmos/cosmos-sdk/x/gov/migrations/v3"
 	v1 "github.com/cosmos/cosmos-sdk/x/gov/types/v1"
 	"github.com/cosmos/cosmos-sdk/x/gov/types/v1beta1"
@@ -1641,3 +1642,103 @@ func (suite *KeeperTestSuite) TestLegacyGRPCQueryTallyResult() {
 		})
 	}
 }
+
+func (suite *KeeperTestSuite) TestGRPCQuerySimulateProposal() {
+	suite.reset()
+	ctx, queryClient, addrs := suite.ctx, suite.queryClient, suite.addrs
+	govAcct := suite.govKeeper.GetGovernanceAccount(ctx).GetAddress().String()
+
+	params := v1.DefaultParams()
+	params.MinDeposit = sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, math.NewInt(42)))
+	updateParams := &v1.MsgUpdateParams{Authority: govAcct, Params: params}
+	amendConstitution := &v1.MsgAmendConstitution{Authority: govAcct, Constitution: "constitution"}
+
+	var (
+		req        *v1.QuerySimulateProposalRequest
+		expFailure *v1.ProposalExecutionFailure
+	)
+
+	testCases := []struct {
+		msg      string
+		malleate func()
+		expPass  bool
+	}{
+		{
+			"empty request",
+			func() {
+				req = &v1.QuerySimulateProposalRequest{}
+			},
+			false,
+		},
+		{
+			"invalid signer",
+			func() {
+				msgs, err := sdktx.SetMsgs([]sdk.Msg{&v1.MsgUpdateParams{Authority: addrs[0].String(), Params: params}})
+				suite.Require().NoError(err)
+				req = &v1.QuerySimulateProposalRequest{Messages: msgs}
+			},
+			false,
+		},
+		{
+			"invalid execution authority",
+			func() {
+				msgs, err := sdktx.SetMsgs([]sdk.Msg{updateParams})
+				suite.Require().NoError(err)
+				req = &v1.QuerySimulateProposalRequest{Messages: msgs, ExecutionAuthority: addrs[0].String()}
+			},
+			false,
+		},
+		{
+			"messages executed",
+			func() {
+				msgs, err := sdktx.SetMsgs([]sdk.Msg{updateParams})
+				suite.Require().NoError(err)
+				req = &v1.QuerySimulateProposalRequest{Messages: msgs}
+				expFailure = nil
+			},
+			true,
+		},
+		{
+			"message execution failed",
+			func() {
+				msgs, err := sdktx.SetMsgs([]sdk.Msg{updateParams, amendConstitution})
+				suite.Require().NoError(err)
+				req = &v1.QuerySimulateProposalRequest{Messages: msgs}
+				expFailure = &v1.ProposalExecutionFailure{
+					MsgIndex:   1,
+					MsgTypeUrl: sdk.MsgTypeURL(amendConstitution),
+				}
+			},
+			true,
+		},
+	}
+
+	for _, testCase := range testCases {
+		suite.Run(fmt.Sprintf("Case %s", testCase.msg), func() {
+			testCase.malleate()
+
+			simRes, err := queryClient.SimulateProposal(gocontext.Background(), req)
+
+			if testCase.expPass {
+				suite.Require().NoError(err)
+				suite.Require().NotZero(simRes.GasUsed)
+				if expFailure == nil {
+					suite.Require().Nil(simRes.Failure)
+				} else {
+					suite.Require().NotNil(simRes.Failure)
+					suite.Require().Equal(expFailure.MsgIndex, simRes.Failure.MsgIndex)
+					suite.Require().Equal(expFailure.MsgTypeUrl, simRes.Failure.MsgTypeUrl)
+					suite.Require().NotEmpty(simRes.Failure.Reason)
+				}
+			} else {
+				suite.Require().Error(err)
+				suite.Require().Nil(simRes)
+			}
+
+			// the simulated messages are never committed
+			govParams, err := suite.govKeeper.GetParams(ctx)
+			suite.Require().NoError(err)
+			suite.Require().Equal(v1.DefaultParams().MinDeposit, govParams.MinDeposit)
+		})
+	}
+}
@@ -1,8 +1,11 @@
 package keeper
 
 import (
+	"bytes"
 	"fmt"
 
+	storetypes "cosmossdk.io/store/types"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/x/gov/types"
 	v1 "github.com/cosmos/cosmos-sdk/x/gov/types/v1"
@@ -11,12 +14,18 @@ import (
 // RegisterInvariants registers all governance invariants
 func RegisterInvariants(ir sdk.InvariantRegistry, keeper *Keeper, bk types.BankKeeper) {
 	ir.RegisterRoute(types.ModuleName, "module-account", ModuleAccountInvariant(keeper, bk))
+	ir.RegisterRoute(types.ModuleName, "voted-shares", VotedSharesInvariant(keeper))
 }
 
 // AllInvariants runs all invariants of the governance module
 func AllInvariants(keeper *Keeper, bk types.BankKeeper) sdk.Invariant {
 	return func(ctx sdk.Context) (string, bool) {
-		return ModuleAccountInvariant(keeper, bk)(ctx)
+		res, stop := ModuleAccountInvariant(keeper, bk)(ctx)
+		if stop {
+			return res, stop
+		}
+
+		return VotedSharesInvariant(keeper)(ctx)
 	}
 }
 
@@ -44,3 +53,80 @@ func ModuleAccountInvariant(keeper *Keeper, bk types.BankKeeper) sdk.Invariant {
 				balances, expectedDeposits)), broken
 	}
 }
+
+// VotedSharesInvariant checks that the voted shares of the proposals in voting
+// period match the votes and the current delegations of the voters, which only
+// holds if the gov staking hooks are registered with the staking keeper.
+func VotedSharesInvariant(keeper *Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		var (
+			msg    string
+			broken bool
+		)
+
+		err := keeper.IterateProposals(ctx, func(proposal v1.Proposal) error {
+			if proposal.Status != v1.StatusVotingPeriod {
+				return nil
+			}
+
+			stored, err := keeper.votedSharesEntries(ctx, proposal.Id)
+			if err != nil {
+				return err
+			}
+
+			cacheCtx, _ := ctx.CacheContext()
+			if err := keeper.RebuildVotedShares(cacheCtx, proposal.Id); err != nil {
+				return err
+			}
+
+			rebuilt, err := keeper.votedSharesEntries(cacheCtx, proposal.Id)
+			if err != nil {
+				return err
+			}
+
+			if !votedSharesEntriesEqual(stored, rebuilt) {
+				broken = true
+				msg += fmt.Sprintf("\tproposal %d voted shares don't match its votes, are the gov staking hooks registered?\n", proposal.Id)
+			}
+
+			return nil
+		})
+		if err != nil {
+			broken = true
+			msg += fmt.Sprintf("\tfailed to check the voted shares: %v\n", err)
+		}
+
+		return sdk.FormatInvariant(types.ModuleName, "voted shares", msg), broken
+	}
+}
+
+// votedSharesEntries returns the raw voted shares entries of a proposal.
+func (keeper Keeper) votedSharesEntries(ctx sdk.Context, proposalID uint64) ([][2][]byte, error) {
+	store := keeper.storeService.OpenKVStore(ctx)
+	iterator, err := store.Iterator(types.VotedSharesKey(proposalID), storetypes.PrefixEndBytes(types.VotedSharesKey(proposalID)))
+	if err != nil {
+		return nil, err
+	}
+	defer iterator.Close()
+
+	var entries [][2][]byte
+	for ; iterator.Valid(); iterator.Next() {
+		entries = append(entries, [2][]byte{iterator.Key(), iterator.Value()})
+	}
+
+	return entries, nil
+}
+
+func votedSharesEntriesEqual(a, b [][2][]byte) bool {
+	if len(a) != len(b) {
+		return false
+	}
+
+	for i := range a {
+		if !bytes.Equal(a[i][0], b[i][0]) || !bytes.Equal(a[i][1], b[i][1]) {
+			return false
+		}
+	}
+
+	return true
+}
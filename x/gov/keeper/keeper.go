@@ -8,7 +8,6 @@ import (
 	corestoretypes "cosmossdk.io/core/store"
 	"cosmossdk.io/errors"
 	"cosmossdk.io/log"
-	storetypes "cosmossdk.io/store/types"
 
 	"github.com/cosmos/cosmos-sdk/baseapp"
 	"github.com/cosmos/cosmos-sdk/codec"
@@ -31,7 +30,8 @@ type Keeper struct {
 	hooks types.GovHooks
 
 	// The (unexposed) keys used to access the stores from the Context.
-	storeService corestoretypes.KVStoreService
+	storeService  corestoretypes.KVStoreService
+	tStoreService corestoretypes.TransientStoreService
 
 	// The codec for binary encoding/decoding.
 	cdc codec.BinaryCodec
@@ -42,6 +42,19 @@ type Keeper struct {
 	// Msg server router
 	router baseapp.MessageRouter
 
+	// Params validators used by MsgBatchUpdateParams, keyed by message type URL
+	paramsValidators map[string]types.ParamsValidator
+
+	// Proposal message validators run when the voting period of a proposal
+	// starts, keyed by message type URL
+	proposalMsgValidators map[string]types.ProposalMsgValidator
+
+	// Tally handlers overriding the default tally, keyed by proposal kind
+	tallyHandlers map[v1.ProposalKind]v1.TallyHandler
+
+	// Oracle providing the ratios of the accepted deposit denoms using one
+	depositRatioOracle types.DepositRatioOracle
+
 	config types.Config
 
 	// the address capable of executing a MsgUpdateParams message. Typically, this
@@ -54,6 +67,12 @@ func (k Keeper) GetAuthority() string {
 	return k.authority
 }
 
+// ExportHistory returns true if the finished proposals and the execution
+// receipts are included in the exported genesis state.
+func (k Keeper) ExportHistory() bool {
+	return k.config.ExportHistory
+}
+
 // NewKeeper returns a governance keeper. It handles:
 // - submitting governance proposals
 // - depositing funds into proposals, and activating upon sufficient funds being deposited
@@ -62,7 +81,7 @@ func (k Keeper) GetAuthority() string {
 //
 // CONTRACT: the parameter Subspace must have the param key table already initialized
 func NewKeeper(
-	cdc codec.BinaryCodec, storeService corestoretypes.KVStoreService, authKeeper types.AccountKeeper,
+	cdc codec.BinaryCodec, storeService corestoretypes.KVStoreService, tStoreService corestoretypes.TransientStoreService, authKeeper types.AccountKeeper,
 	bankKeeper types.BankKeeper, sk types.StakingKeeper, distrKeeper types.DistributionKeeper,
 	router baseapp.MessageRouter, config types.Config, authority string,
 ) *Keeper {
@@ -80,16 +99,22 @@ func NewKeeper(
 		config.MaxMetadataLen = types.DefaultConfig().MaxMetadataLen
 	}
 
+	// If MaxEarlyResolutionChecks not set by app developer, set to default value.
+	if config.MaxEarlyResolutionChecks == 0 {
+		config.MaxEarlyResolutionChecks = types.DefaultConfig().MaxEarlyResolutionChecks
+	}
+
 	return &Keeper{
-		storeService: storeService,
-		authKeeper:   authKeeper,
-		bankKeeper:   bankKeeper,
-		distrKeeper:  distrKeeper,
-		sk:           sk,
-		cdc:          cdc,
-		router:       router,
-		config:       config,
-		authority:    authority,
+		storeService:  storeService,
+		tStoreService: tStoreService,
+		authKeeper:    authKeeper,
+		bankKeeper:    bankKeeper,
+		distrKeeper:   distrKeeper,
+		sk:            sk,
+		cdc:           cdc,
+		router:        router,
+		config:        config,
+		authority:     authority,
 	}
 }
 
@@ -114,6 +139,18 @@ func (k *Keeper) SetHooks(gh types.GovHooks) *Keeper {
 	return k
 }
 
+// SetDepositRatioOracle sets the oracle providing the ratios of the accepted
+// deposit denoms which use an oracle ratio.
+func (k *Keeper) SetDepositRatioOracle(oracle types.DepositRatioOracle) *Keeper {
+	if k.depositRatioOracle != nil {
+		panic("cannot set deposit ratio oracle twice")
+	}
+
+	k.depositRatioOracle = oracle
+
+	return k
+}
+
 // SetLegacyRouter sets the legacy router for governance
 func (k *Keeper) SetLegacyRouter(router v1beta1.Router) {
 	// It is vital to seal the governance proposal router here as to not allow
@@ -148,28 +185,32 @@ func (k Keeper) GetGovernanceAccount(ctx context.Context) sdk.ModuleAccountI {
 
 // InsertActiveProposalQueue inserts a proposalID into the active proposal queue at endTime
 func (k Keeper) InsertActiveProposalQueue(ctx context.Context, proposalID uint64, endTime time.Time) error {
-	store := k.storeService.OpenKVStore(ctx)
-	bz := types.GetProposalIDBytes(proposalID)
-	return store.Set(types.ActiveProposalQueueKey(proposalID, endTime), bz)
+	return k.insertProposalQueueEntry(ctx, types.ActiveProposalQueueBucketKey(endTime), proposalID, endTime)
 }
 
 // RemoveFromActiveProposalQueue removes a proposalID from the Active Proposal Queue
 func (k Keeper) RemoveFromActiveProposalQueue(ctx context.Context, proposalID uint64, endTime time.Time) error {
-	store := k.storeService.OpenKVStore(ctx)
-	return store.Delete(types.ActiveProposalQueueKey(proposalID, endTime))
+	return k.removeProposalQueueEntry(ctx, types.ActiveProposalQueueBucketKey(endTime), proposalID, endTime)
 }
 
 // InsertInactiveProposalQueue inserts a proposalID into the inactive proposal queue at endTime
 func (k Keeper) InsertInactiveProposalQueue(ctx context.Context, proposalID uint64, endTime time.Time) error {
-	store := k.storeService.OpenKVStore(ctx)
-	bz := types.GetProposalIDBytes(proposalID)
-	return store.Set(types.InactiveProposalQueueKey(proposalID, endTime), bz)
+	return k.insertProposalQueueEntry(ctx, types.InactiveProposalQueueBucketKey(endTime), proposalID, endTime)
 }
 
 // RemoveFromInactiveProposalQueue removes a proposalID from the Inactive Proposal Queue
 func (k Keeper) RemoveFromInactiveProposalQueue(ctx context.Context, proposalID uint64, endTime time.Time) error {
-	store := k.storeService.OpenKVStore(ctx)
-	return store.Delete(types.InactiveProposalQueueKey(proposalID, endTime))
+	return k.removeProposalQueueEntry(ctx, types.InactiveProposalQueueBucketKey(endTime), proposalID, endTime)
+}
+
+// InsertDiscussionProposalQueue inserts a proposalID into the discussion proposal queue at endTime
+func (k Keeper) InsertDiscussionProposalQueue(ctx context.Context, proposalID uint64, endTime time.Time) error {
+	return k.insertProposalQueueEntry(ctx, types.DiscussionProposalQueueBucketKey(endTime), proposalID, endTime)
+}
+
+// RemoveFromDiscussionProposalQueue removes a proposalID from the Discussion Proposal Queue
+func (k Keeper) RemoveFromDiscussionProposalQueue(ctx context.Context, proposalID uint64, endTime time.Time) error {
+	return k.removeProposalQueueEntry(ctx, types.DiscussionProposalQueueBucketKey(endTime), proposalID, endTime)
 }
 
 // Iterators
@@ -230,16 +271,47 @@ func (k Keeper) IterateInactiveProposalsQueue(ctx context.Context, endTime time.
 	return nil
 }
 
+// IterateDiscussionProposalsQueue iterates over the proposals in the discussion proposal queue
+// and performs a callback function
+func (k Keeper) IterateDiscussionProposalsQueue(ctx context.Context, endTime time.Time, cb func(proposal v1.Proposal) error) error {
+	iterator, err := k.DiscussionProposalQueueIterator(ctx, endTime)
+	if err != nil {
+		return err
+	}
+
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		proposalID, _ := types.SplitDiscussionProposalQueueKey(iterator.Key())
+		proposal, err := k.GetProposal(ctx, proposalID)
+		if err != nil {
+			return err
+		}
+
+		err = cb(proposal)
+		// exit early without error if cb returns ErrStopIterating
+		if errors.IsOf(err, errors.ErrStopIterating) {
+			return nil
+		} else if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
 // ActiveProposalQueueIterator returns an corestoretypes.Iterator for all the proposals in the Active Queue that expire by endTime
 func (k Keeper) ActiveProposalQueueIterator(ctx context.Context, endTime time.Time) (corestoretypes.Iterator, error) {
-	store := k.storeService.OpenKVStore(ctx)
-	return store.Iterator(types.ActiveProposalQueuePrefix, storetypes.PrefixEndBytes(types.ActiveProposalByTimeKey(endTime)))
+	return k.proposalQueueIterator(ctx, types.ActiveProposalQueuePrefix, endTime)
 }
 
 // InactiveProposalQueueIterator returns an corestoretypes.Iterator for all the proposals in the Inactive Queue that expire by endTime
 func (k Keeper) InactiveProposalQueueIterator(ctx context.Context, endTime time.Time) (corestoretypes.Iterator, error) {
-	store := k.storeService.OpenKVStore(ctx)
-	return store.Iterator(types.InactiveProposalQueuePrefix, storetypes.PrefixEndBytes(types.InactiveProposalByTimeKey(endTime)))
+	return k.proposalQueueIterator(ctx, types.InactiveProposalQueuePrefix, endTime)
+}
+
+// DiscussionProposalQueueIterator returns an corestoretypes.Iterator for all the proposals in the Discussion Queue that expire by endTime
+func (k Keeper) DiscussionProposalQueueIterator(ctx context.Context, endTime time.Time) (corestoretypes.Iterator, error) {
+	return k.proposalQueueIterator(ctx, types.DiscussionProposalQueuePrefix, endTime)
 }
 
 // ModuleAccountAddress returns gov module account address
@@ -0,0 +1,143 @@
+package keeper
+
+import (
+	"context"
+	"fmt"
+
+	"cosmossdk.io/errors"
+	storetypes "cosmossdk.io/store/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/gov/types"
+	v1 "github.com/cosmos/cosmos-sdk/x/gov/types/v1"
+)
+
+// RegisterMetadataSchema registers a metadata schema under the ID following the
+// one of the latest registered schema.
+func (keeper Keeper) RegisterMetadataSchema(ctx context.Context, name, uri, hash string) (v1.MetadataSchema, error) {
+	schemaID, err := keeper.nextMetadataSchemaID(ctx)
+	if err != nil {
+		return v1.MetadataSchema{}, err
+	}
+
+	schema := v1.NewMetadataSchema(schemaID, name, uri, hash)
+	if err := schema.ValidateBasic(); err != nil {
+		return v1.MetadataSchema{}, err
+	}
+
+	if err := keeper.SetMetadataSchema(ctx, schema); err != nil {
+		return v1.MetadataSchema{}, err
+	}
+
+	sdk.UnwrapSDKContext(ctx).EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeRegisterMetadataSchema,
+			sdk.NewAttribute(types.AttributeKeyMetadataSchemaID, fmt.Sprintf("%d", schemaID)),
+			sdk.NewAttribute(types.AttributeKeyMetadataSchemaHash, hash),
+		),
+	)
+
+	return schema, nil
+}
+
+// SetMetadataSchema sets a metadata schema to the store.
+func (keeper Keeper) SetMetadataSchema(ctx context.Context, schema v1.MetadataSchema) error {
+	bz, err := keeper.cdc.Marshal(&schema)
+	if err != nil {
+		return err
+	}
+
+	store := keeper.storeService.OpenKVStore(ctx)
+	return store.Set(types.MetadataSchemaKey(schema.Id), bz)
+}
+
+// GetMetadataSchema gets a metadata schema from the store.
+func (keeper Keeper) GetMetadataSchema(ctx context.Context, schemaID uint64) (schema v1.MetadataSchema, err error) {
+	store := keeper.storeService.OpenKVStore(ctx)
+	bz, err := store.Get(types.MetadataSchemaKey(schemaID))
+	if err != nil {
+		return schema, err
+	}
+
+	if bz == nil {
+		return schema, errors.Wrapf(types.ErrMetadataSchemaNotFound, "%d", schemaID)
+	}
+
+	err = keeper.cdc.Unmarshal(bz, &schema)
+	return schema, err
+}
+
+// assertMetadataSchema returns an error if the given metadata schema ID is
+// neither 0, i.e. no schema, nor the ID of a registered schema.
+func (keeper Keeper) assertMetadataSchema(ctx context.Context, schemaID uint64) error {
+	if schemaID == 0 {
+		return nil
+	}
+
+	store := keeper.storeService.OpenKVStore(ctx)
+	found, err := store.Has(types.MetadataSchemaKey(schemaID))
+	if err != nil {
+		return err
+	}
+
+	if !found {
+		return errors.Wrapf(types.ErrMetadataSchemaNotFound, "%d", schemaID)
+	}
+
+	return nil
+}
+
+// IterateMetadataSchemas iterates over all the metadata schemas, in the order
+// they were registered, and performs a callback function.
+func (keeper Keeper) IterateMetadataSchemas(ctx context.Context, cb func(schema v1.MetadataSchema) error) error {
+	store := keeper.storeService.OpenKVStore(ctx)
+	iterator, err := store.Iterator(types.MetadataSchemasKeyPrefix, storetypes.PrefixEndBytes(types.MetadataSchemasKeyPrefix))
+	if err != nil {
+		return err
+	}
+
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		var schema v1.MetadataSchema
+		if err := keeper.cdc.Unmarshal(iterator.Value(), &schema); err != nil {
+			return err
+		}
+
+		err = cb(schema)
+		// exit early without error if cb returns ErrStopIterating
+		if errors.IsOf(err, errors.ErrStopIterating) {
+			return nil
+		} else if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// GetMetadataSchemas returns all the metadata schemas, in the order they were
+// registered.
+func (keeper Keeper) GetMetadataSchemas(ctx context.Context) (schemas []*v1.MetadataSchema, err error) {
+	err = keeper.IterateMetadataSchemas(ctx, func(schema v1.MetadataSchema) error {
+		schemas = append(schemas, &schema)
+		return nil
+	})
+	return
+}
+
+// nextMetadataSchemaID returns the ID following the one of the latest
+// registered metadata schema.
+func (keeper Keeper) nextMetadataSchemaID(ctx context.Context) (uint64, error) {
+	store := keeper.storeService.OpenKVStore(ctx)
+	iterator, err := store.ReverseIterator(types.MetadataSchemasKeyPrefix, storetypes.PrefixEndBytes(types.MetadataSchemasKeyPrefix))
+	if err != nil {
+		return 0, err
+	}
+	defer iterator.Close()
+
+	if !iterator.Valid() {
+		return 1, nil
+	}
+
+	return sdk.BigEndianToUint64(iterator.Key()[len(types.MetadataSchemasKeyPrefix):]) + 1, nil
+}
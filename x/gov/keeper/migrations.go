@@ -7,6 +7,9 @@ import (
 	v3 "github.com/cosmos/cosmos-sdk/x/gov/migrations/v3"
 	v4 "github.com/cosmos/cosmos-sdk/x/gov/migrations/v4"
 	v5 "github.com/cosmos/cosmos-sdk/x/gov/migrations/v5"
+	v6 "github.com/cosmos/cosmos-sdk/x/gov/migrations/v6"
+	"github.com/cosmos/cosmos-sdk/x/gov/types"
+	v1 "github.com/cosmos/cosmos-sdk/x/gov/types/v1"
 )
 
 // Migrator is a struct for handling in-place store migrations.
@@ -42,3 +45,29 @@ func (m Migrator) Migrate3to4(ctx sdk.Context) error {
 func (m Migrator) Migrate4to5(ctx sdk.Context) error {
 	return v5.MigrateStore(ctx, m.keeper.storeService, m.keeper.cdc)
 }
+
+// Migrate5to6 migrates from version 5 to 6, building the voted shares of the
+// proposals in voting period from their votes.
+func (m Migrator) Migrate5to6(ctx sdk.Context) error {
+	return m.keeper.IterateProposals(ctx, func(proposal v1.Proposal) error {
+		if proposal.Status != v1.StatusVotingPeriod {
+			return nil
+		}
+
+		return m.keeper.RebuildVotedShares(ctx, proposal.Id)
+	})
+}
+
+// Migrate6to7 migrates from version 6 to 7, migrating the proposal queues to
+// time-bucketed queues.
+func (m Migrator) Migrate6to7(ctx sdk.Context) error {
+	return v6.MigrateStore(ctx, m.keeper.storeService)
+}
+
+// Migrate7to8 migrates from version 7 to 8, tracking the deposits as escrows of
+// the gov module account in x/bank.
+func (m Migrator) Migrate7to8(ctx sdk.Context) error {
+	return m.keeper.IterateAllDeposits(ctx, func(deposit v1.Deposit) error {
+		return m.keeper.bankKeeper.AddModuleEscrow(ctx, types.ModuleName, types.DepositEscrowPurpose(deposit.ProposalId), deposit.Amount)
+	})
+}
@@ -68,11 +68,29 @@ func (k msgServer) SubmitProposal(goCtx context.Context, msg *v1.MsgSubmitPropos
 		return nil, err
 	}
 
-	proposal, err := k.Keeper.SubmitProposal(ctx, proposalMsgs, msg.Metadata, msg.Title, msg.Summary, proposer, msg.Expedited)
+	if msg.CommitReveal {
+		if err := k.validateCommitReveal(ctx, msg.Expedited); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := k.assertMetadataSchema(ctx, msg.MetadataSchemaId); err != nil {
+		return nil, err
+	}
+
+	proposal, err := k.Keeper.SubmitProposalWithAuthority(ctx, proposalMsgs, msg.Metadata, msg.Title, msg.Summary, proposer, msg.Expedited, msg.ExecutionAuthority)
 	if err != nil {
 		return nil, err
 	}
 
+	if msg.CommitReveal || msg.MetadataSchemaId != 0 {
+		proposal.CommitReveal = msg.CommitReveal
+		proposal.MetadataSchemaId = msg.MetadataSchemaId
+		if err := k.SetProposal(ctx, proposal); err != nil {
+			return nil, err
+		}
+	}
+
 	bytes, err := proposal.Marshal()
 	if err != nil {
 		return nil, err
@@ -131,6 +149,54 @@ func (k msgServer) CancelProposal(goCtx context.Context, msg *v1.MsgCancelPropos
 	}, nil
 }
 
+// UpdateProposalMetadata implements the MsgServer.UpdateProposalMetadata method.
+func (k msgServer) UpdateProposalMetadata(goCtx context.Context, msg *v1.MsgUpdateProposalMetadata) (*v1.MsgUpdateProposalMetadataResponse, error) {
+	if _, err := k.authKeeper.StringToBytes(msg.Proposer); err != nil {
+		return nil, sdkerrors.ErrInvalidAddress.Wrapf("invalid proposer address: %s", err)
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	if err := k.Keeper.UpdateProposalMetadata(ctx, msg.ProposalId, msg.Proposer, msg.Metadata); err != nil {
+		return nil, err
+	}
+
+	return &v1.MsgUpdateProposalMetadataResponse{}, nil
+}
+
+// AmendProposal implements the MsgServer.AmendProposal method.
+func (k msgServer) AmendProposal(goCtx context.Context, msg *v1.MsgAmendProposal) (*v1.MsgAmendProposalResponse, error) {
+	if _, err := k.authKeeper.StringToBytes(msg.Proposer); err != nil {
+		return nil, sdkerrors.ErrInvalidAddress.Wrapf("invalid proposer address: %s", err)
+	}
+
+	proposalMsgs, err := msg.GetMsgs()
+	if err != nil {
+		return nil, err
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	if err := k.Keeper.AmendProposal(ctx, msg.ProposalId, msg.Proposer, proposalMsgs, msg.Metadata, msg.Title, msg.Summary); err != nil {
+		return nil, err
+	}
+
+	return &v1.MsgAmendProposalResponse{}, nil
+}
+
+// WithdrawDeposit implements the MsgServer.WithdrawDeposit method.
+func (k msgServer) WithdrawDeposit(goCtx context.Context, msg *v1.MsgWithdrawDeposit) (*v1.MsgWithdrawDepositResponse, error) {
+	depositor, err := k.authKeeper.StringToBytes(msg.Depositor)
+	if err != nil {
+		return nil, sdkerrors.ErrInvalidAddress.Wrapf("invalid depositor address: %s", err)
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	if err := k.Keeper.WithdrawDeposit(ctx, msg.ProposalId, depositor); err != nil {
+		return nil, err
+	}
+
+	return &v1.MsgWithdrawDepositResponse{}, nil
+}
+
 // ExecLegacyContent implements the MsgServer.ExecLegacyContent method.
 func (k msgServer) ExecLegacyContent(goCtx context.Context, msg *v1.MsgExecLegacyContent) (*v1.MsgExecLegacyContentResponse, error) {
 	ctx := sdk.UnwrapSDKContext(goCtx)
@@ -170,11 +236,19 @@ func (k msgServer) Vote(goCtx context.Context, msg *v1.MsgVote) (*v1.MsgVoteResp
 	}
 
 	ctx := sdk.UnwrapSDKContext(goCtx)
+	if err := k.assertMetadataSchema(ctx, msg.MetadataSchemaId); err != nil {
+		return nil, err
+	}
+
 	err = k.Keeper.AddVote(ctx, msg.ProposalId, accAddr, v1.NewNonSplitVoteOption(msg.Option), msg.Metadata)
 	if err != nil {
 		return nil, err
 	}
 
+	if err := k.setVoteMetadataSchema(ctx, msg.ProposalId, accAddr, msg.MetadataSchemaId); err != nil {
+		return nil, err
+	}
+
 	defer telemetry.IncrCounterWithLabels(
 		[]string{govtypes.ModuleName, "vote"},
 		1,
@@ -186,48 +260,88 @@ func (k msgServer) Vote(goCtx context.Context, msg *v1.MsgVote) (*v1.MsgVoteResp
 	return &v1.MsgVoteResponse{}, nil
 }
 
-// VoteWeighted implements the MsgServer.VoteWeighted method.
-func (k msgServer) VoteWeighted(goCtx context.Context, msg *v1.MsgVoteWeighted) (*v1.MsgVoteWeightedResponse, error) {
-	accAddr, accErr := k.authKeeper.StringToBytes(msg.Voter)
-	if accErr != nil {
-		return nil, sdkerrors.ErrInvalidAddress.Wrapf("invalid voter address: %s", accErr)
+// CommitVote implements the MsgServer.CommitVote method.
+func (k msgServer) CommitVote(goCtx context.Context, msg *v1.MsgCommitVote) (*v1.MsgCommitVoteResponse, error) {
+	accAddr, err := k.authKeeper.StringToBytes(msg.Voter)
+	if err != nil {
+		return nil, sdkerrors.ErrInvalidAddress.Wrapf("invalid voter address: %s", err)
 	}
 
-	if len(msg.Options) == 0 {
-		return nil, errors.Wrap(sdkerrors.ErrInvalidRequest, v1.WeightedVoteOptions(msg.Options).String())
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	if err := k.Keeper.CommitVote(ctx, msg.ProposalId, accAddr, msg.Commitment); err != nil {
+		return nil, err
 	}
 
-	totalWeight := math.LegacyNewDec(0)
-	usedOptions := make(map[v1.VoteOption]bool)
-	for _, option := range msg.Options {
-		if !option.IsValid() {
-			return nil, errors.Wrap(govtypes.ErrInvalidVote, option.String())
-		}
-		weight, err := sdk.NewDecFromStr(option.Weight)
-		if err != nil {
-			return nil, errors.Wrapf(govtypes.ErrInvalidVote, "invalid weight: %s", err)
-		}
-		totalWeight = totalWeight.Add(weight)
-		if usedOptions[option.Option] {
-			return nil, errors.Wrap(govtypes.ErrInvalidVote, "duplicated vote option")
-		}
-		usedOptions[option.Option] = true
+	return &v1.MsgCommitVoteResponse{}, nil
+}
+
+// RevealVote implements the MsgServer.RevealVote method.
+func (k msgServer) RevealVote(goCtx context.Context, msg *v1.MsgRevealVote) (*v1.MsgRevealVoteResponse, error) {
+	accAddr, err := k.authKeeper.StringToBytes(msg.Voter)
+	if err != nil {
+		return nil, sdkerrors.ErrInvalidAddress.Wrapf("invalid voter address: %s", err)
 	}
 
-	if totalWeight.GT(math.LegacyNewDec(1)) {
-		return nil, errors.Wrap(govtypes.ErrInvalidVote, "total weight overflow 1.00")
+	if err := validateWeightedVoteOptions(msg.Options); err != nil {
+		return nil, err
 	}
 
-	if totalWeight.LT(math.LegacyNewDec(1)) {
-		return nil, errors.Wrap(govtypes.ErrInvalidVote, "total weight lower than 1.00")
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	if err := k.assertMetadataSchema(ctx, msg.MetadataSchemaId); err != nil {
+		return nil, err
+	}
+
+	if err := k.Keeper.RevealVote(ctx, msg.ProposalId, accAddr, msg.Options, msg.Salt, msg.Metadata); err != nil {
+		return nil, err
+	}
+
+	if err := k.setVoteMetadataSchema(ctx, msg.ProposalId, accAddr, msg.MetadataSchemaId); err != nil {
+		return nil, err
+	}
+
+	return &v1.MsgRevealVoteResponse{}, nil
+}
+
+// RetractVote implements the MsgServer.RetractVote method.
+func (k msgServer) RetractVote(goCtx context.Context, msg *v1.MsgRetractVote) (*v1.MsgRetractVoteResponse, error) {
+	accAddr, err := k.authKeeper.StringToBytes(msg.Voter)
+	if err != nil {
+		return nil, sdkerrors.ErrInvalidAddress.Wrapf("invalid voter address: %s", err)
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	if err := k.Keeper.RetractVote(ctx, msg.ProposalId, accAddr); err != nil {
+		return nil, err
+	}
+
+	return &v1.MsgRetractVoteResponse{}, nil
+}
+
+// VoteWeighted implements the MsgServer.VoteWeighted method.
+func (k msgServer) VoteWeighted(goCtx context.Context, msg *v1.MsgVoteWeighted) (*v1.MsgVoteWeightedResponse, error) {
+	accAddr, accErr := k.authKeeper.StringToBytes(msg.Voter)
+	if accErr != nil {
+		return nil, sdkerrors.ErrInvalidAddress.Wrapf("invalid voter address: %s", accErr)
+	}
+
+	if err := validateWeightedVoteOptions(msg.Options); err != nil {
+		return nil, err
 	}
 
 	ctx := sdk.UnwrapSDKContext(goCtx)
+	if err := k.assertMetadataSchema(ctx, msg.MetadataSchemaId); err != nil {
+		return nil, err
+	}
+
 	err := k.Keeper.AddVote(ctx, msg.ProposalId, accAddr, msg.Options, msg.Metadata)
 	if err != nil {
 		return nil, err
 	}
 
+	if err := k.setVoteMetadataSchema(ctx, msg.ProposalId, accAddr, msg.MetadataSchemaId); err != nil {
+		return nil, err
+	}
+
 	defer telemetry.IncrCounterWithLabels(
 		[]string{govtypes.ModuleName, "vote"},
 		1,
@@ -250,12 +364,33 @@ func (k msgServer) Deposit(goCtx context.Context, msg *v1.MsgDeposit) (*v1.MsgDe
 		return nil, err
 	}
 
+	if err := k.assertMetadataLength(msg.Metadata); err != nil {
+		return nil, err
+	}
+
 	ctx := sdk.UnwrapSDKContext(goCtx)
+	if err := k.assertMetadataSchema(ctx, msg.MetadataSchemaId); err != nil {
+		return nil, err
+	}
+
 	votingStarted, err := k.Keeper.AddDeposit(ctx, msg.ProposalId, accAddr, msg.Amount)
 	if err != nil {
 		return nil, err
 	}
 
+	if msg.Metadata != "" || msg.MetadataSchemaId != 0 {
+		deposit, err := k.GetDeposit(ctx, msg.ProposalId, accAddr)
+		if err != nil {
+			return nil, err
+		}
+
+		deposit.Metadata = msg.Metadata
+		deposit.MetadataSchemaId = msg.MetadataSchemaId
+		if err := k.SetDeposit(ctx, deposit); err != nil {
+			return nil, err
+		}
+	}
+
 	defer telemetry.IncrCounterWithLabels(
 		[]string{govtypes.ModuleName, "deposit"},
 		1,
@@ -294,6 +429,58 @@ func (k msgServer) UpdateParams(goCtx context.Context, msg *v1.MsgUpdateParams)
 	return &v1.MsgUpdateParamsResponse{}, nil
 }
 
+// BatchUpdateParams implements the MsgServer.BatchUpdateParams method.
+func (k msgServer) BatchUpdateParams(goCtx context.Context, msg *v1.MsgBatchUpdateParams) (*v1.MsgBatchUpdateParamsResponse, error) {
+	if k.authority != msg.Authority {
+		return nil, errors.Wrapf(govtypes.ErrInvalidSigner, "invalid authority; expected %s, got %s", k.authority, msg.Authority)
+	}
+
+	msgs, err := msg.GetMsgs()
+	if err != nil {
+		return nil, err
+	}
+
+	if len(msgs) == 0 {
+		return nil, errors.Wrap(govtypes.ErrNoProposalMsgs, "at least one params update message is required")
+	}
+
+	if err := k.ApplyParamsUpdates(goCtx, msgs); err != nil {
+		return nil, err
+	}
+
+	return &v1.MsgBatchUpdateParamsResponse{}, nil
+}
+
+// AmendConstitution implements the MsgServer.AmendConstitution method.
+func (k msgServer) AmendConstitution(goCtx context.Context, msg *v1.MsgAmendConstitution) (*v1.MsgAmendConstitutionResponse, error) {
+	if k.authority != msg.Authority {
+		return nil, errors.Wrapf(govtypes.ErrInvalidSigner, "invalid authority; expected %s, got %s", k.authority, msg.Authority)
+	}
+
+	// the constitution can only be amended by the execution of a constitution
+	// amendment proposal, which is tallied with its own threshold
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	proposalID, ok := govtypes.ExecutingProposal(ctx)
+	if !ok {
+		return nil, errors.Wrap(govtypes.ErrInvalidConstitutionAmendment, "the constitution can only be amended by a constitution amendment proposal")
+	}
+
+	proposal, err := k.GetProposal(ctx, proposalID)
+	if err != nil {
+		return nil, err
+	}
+
+	if !proposal.IsConstitutionAmendment() {
+		return nil, errors.Wrapf(govtypes.ErrInvalidConstitutionAmendment, "proposal %d is not a constitution amendment proposal", proposalID)
+	}
+
+	if _, err := k.Keeper.AmendConstitution(ctx, proposalID, msg.Constitution); err != nil {
+		return nil, err
+	}
+
+	return &v1.MsgAmendConstitutionResponse{}, nil
+}
+
 type legacyMsgServer struct {
 	govAcct string
 	server  v1.MsgServer
@@ -413,6 +600,41 @@ func validateDeposit(deposit sdk.Coins) error {
 	return nil
 }
 
+// validateWeightedVoteOptions validates the options of a weighted vote, whose
+// weights must be distinct and add up to 1.
+func validateWeightedVoteOptions(options []*v1.WeightedVoteOption) error {
+	if len(options) == 0 {
+		return errors.Wrap(sdkerrors.ErrInvalidRequest, v1.WeightedVoteOptions(options).String())
+	}
+
+	totalWeight := math.LegacyNewDec(0)
+	usedOptions := make(map[v1.VoteOption]bool)
+	for _, option := range options {
+		if !option.IsValid() {
+			return errors.Wrap(govtypes.ErrInvalidVote, option.String())
+		}
+		weight, err := sdk.NewDecFromStr(option.Weight)
+		if err != nil {
+			return errors.Wrapf(govtypes.ErrInvalidVote, "invalid weight: %s", err)
+		}
+		totalWeight = totalWeight.Add(weight)
+		if usedOptions[option.Option] {
+			return errors.Wrap(govtypes.ErrInvalidVote, "duplicated vote option")
+		}
+		usedOptions[option.Option] = true
+	}
+
+	if totalWeight.GT(math.LegacyNewDec(1)) {
+		return errors.Wrap(govtypes.ErrInvalidVote, "total weight overflow 1.00")
+	}
+
+	if totalWeight.LT(math.LegacyNewDec(1)) {
+		return errors.Wrap(govtypes.ErrInvalidVote, "total weight lower than 1.00")
+	}
+
+	return nil
+}
+
 func validateMsgs(msgs []sdk.Msg) error {
 	for idx, msg := range msgs {
 		m, ok := msg.(sdk.HasValidateBasic)
@@ -428,3 +650,33 @@ func validateMsgs(msgs []sdk.Msg) error {
 
 	return nil
 }
+
+// RegisterMetadataSchema implements the MsgServer.RegisterMetadataSchema method.
+func (k msgServer) RegisterMetadataSchema(goCtx context.Context, msg *v1.MsgRegisterMetadataSchema) (*v1.MsgRegisterMetadataSchemaResponse, error) {
+	if k.authority != msg.Authority {
+		return nil, errors.Wrapf(govtypes.ErrInvalidSigner, "invalid authority; expected %s, got %s", k.authority, msg.Authority)
+	}
+
+	schema, err := k.Keeper.RegisterMetadataSchema(goCtx, msg.Name, msg.Uri, msg.Hash)
+	if err != nil {
+		return nil, err
+	}
+
+	return &v1.MsgRegisterMetadataSchemaResponse{SchemaId: schema.Id}, nil
+}
+
+// setVoteMetadataSchema sets the metadata schema of the vote just cast by the
+// voter, if any.
+func (k msgServer) setVoteMetadataSchema(ctx context.Context, proposalID uint64, voter sdk.AccAddress, schemaID uint64) error {
+	if schemaID == 0 {
+		return nil
+	}
+
+	vote, err := k.GetVote(ctx, proposalID, voter)
+	if err != nil {
+		return err
+	}
+
+	vote.MetadataSchemaId = schemaID
+	return k.SetVote(ctx, vote)
+}
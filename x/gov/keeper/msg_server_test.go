@@ -1,6 +1,7 @@
 package keeper_test
 
 import (
+	"context"
 	"errors"
 	"strings"
 	"time"
@@ -11,6 +12,8 @@ import (
 	"github.com/cosmos/cosmos-sdk/testutil/testdata"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	"github.com/cosmos/cosmos-sdk/x/gov"
+	"github.com/cosmos/cosmos-sdk/x/gov/types"
 	v1 "github.com/cosmos/cosmos-sdk/x/gov/types/v1"
 	"github.com/cosmos/cosmos-sdk/x/gov/types/v1beta1"
 )
@@ -458,6 +461,84 @@ func (suite *KeeperTestSuite) TestVoteReq() {
 	}
 }
 
+func (suite *KeeperTestSuite) TestRetractVoteReq() {
+	suite.reset()
+	govAcct := suite.govKeeper.GetGovernanceAccount(suite.ctx).GetAddress()
+	addrs := suite.addrs
+	proposer := addrs[0]
+
+	coins := sdk.NewCoins(sdk.NewCoin("stake", sdkmath.NewInt(100)))
+	params, _ := suite.govKeeper.GetParams(suite.ctx)
+	bankMsg := &banktypes.MsgSend{
+		FromAddress: govAcct.String(),
+		ToAddress:   proposer.String(),
+		Amount:      coins,
+	}
+
+	msg, err := v1.NewMsgSubmitProposal(
+		[]sdk.Msg{bankMsg},
+		params.MinDeposit,
+		proposer.String(),
+		"",
+		"Proposal",
+		"description of proposal",
+		false,
+	)
+	suite.Require().NoError(err)
+
+	suite.acctKeeper.EXPECT().StringToBytes("").Return(nil, errors.New(emptyAddressError))
+
+	res, err := suite.msgSrvr.SubmitProposal(suite.ctx, msg)
+	suite.Require().NoError(err)
+	proposalID := res.ProposalId
+
+	_, err = suite.msgSrvr.Vote(suite.ctx, v1.NewMsgVote(proposer, proposalID, v1.OptionYes, ""))
+	suite.Require().NoError(err)
+
+	cases := []struct {
+		name      string
+		voter     string
+		expErr    bool
+		expErrMsg string
+	}{
+		{
+			name:      "empty voter",
+			voter:     "",
+			expErr:    true,
+			expErrMsg: "invalid voter address",
+		},
+		{
+			name:      "voter without vote",
+			voter:     addrs[1].String(),
+			expErr:    true,
+			expErrMsg: "vote is not found",
+		},
+		{
+			name:   "all good",
+			voter:  proposer.String(),
+			expErr: false,
+		},
+		{
+			name:      "vote already retracted",
+			voter:     proposer.String(),
+			expErr:    true,
+			expErrMsg: "vote is not found",
+		},
+	}
+
+	for _, tc := range cases {
+		suite.Run(tc.name, func() {
+			_, err := suite.msgSrvr.RetractVote(suite.ctx, v1.NewMsgRetractVote(proposalID, tc.voter))
+			if tc.expErr {
+				suite.Require().Error(err)
+				suite.Require().Contains(err.Error(), tc.expErrMsg)
+			} else {
+				suite.Require().NoError(err)
+			}
+		})
+	}
+}
+
 func (suite *KeeperTestSuite) TestVoteWeightedReq() {
 	suite.reset()
 	govAcct := suite.govKeeper.GetGovernanceAccount(suite.ctx).GetAddress()
@@ -1643,6 +1724,313 @@ func (suite *KeeperTestSuite) TestMsgUpdateParams() {
 	}
 }
 
+func (suite *KeeperTestSuite) TestMsgBatchUpdateParams() {
+	authority := suite.govKeeper.GetAuthority()
+
+	newParams := v1.DefaultParams()
+	newParams.Quorum = "0.5"
+	invalidParams := v1.DefaultParams()
+	invalidParams.Quorum = abc
+	acceptAll := types.ParamsValidatorRoute{
+		MsgTypeURL: sdk.MsgTypeURL(&v1.MsgUpdateParams{}),
+		Validator:  func(context.Context, sdk.Msg) error { return nil },
+	}
+
+	testCases := []struct {
+		name        string
+		routes      []types.ParamsValidatorRoute
+		msgs        []sdk.Msg
+		authority   string
+		expErrMsg   string
+		expFailures []uint32
+	}{
+		{
+			name:      "invalid authority",
+			routes:    []types.ParamsValidatorRoute{gov.NewParamsValidatorRoute()},
+			msgs:      []sdk.Msg{&v1.MsgUpdateParams{Authority: authority, Params: newParams}},
+			authority: "authority",
+			expErrMsg: "invalid authority",
+		},
+		{
+			name:      "no messages",
+			routes:    []types.ParamsValidatorRoute{gov.NewParamsValidatorRoute()},
+			authority: authority,
+			expErrMsg: "at least one params update message is required",
+		},
+		{
+			name:        "no params validator registered",
+			msgs:        []sdk.Msg{&v1.MsgUpdateParams{Authority: authority, Params: newParams}},
+			authority:   authority,
+			expErrMsg:   "no params validator registered for message",
+			expFailures: []uint32{0},
+		},
+		{
+			name:   "invalid signer",
+			routes: []types.ParamsValidatorRoute{gov.NewParamsValidatorRoute()},
+			msgs: []sdk.Msg{
+				&v1.MsgUpdateParams{Authority: authority, Params: newParams},
+				&v1.MsgUpdateParams{Authority: suite.addrs[0].String(), Params: newParams},
+			},
+			authority:   authority,
+			expErrMsg:   "expected gov account as only signer",
+			expFailures: []uint32{1},
+		},
+		{
+			name:   "all invalid params are reported",
+			routes: []types.ParamsValidatorRoute{gov.NewParamsValidatorRoute()},
+			msgs: []sdk.Msg{
+				&v1.MsgUpdateParams{Authority: authority, Params: invalidParams},
+				&v1.MsgUpdateParams{Authority: authority, Params: newParams},
+				&v1.MsgUpdateParams{Authority: authority, Params: invalidParams},
+			},
+			authority:   authority,
+			expErrMsg:   "invalid quorum string",
+			expFailures: []uint32{0, 2},
+		},
+		{
+			name:   "execution failure discards previous updates",
+			routes: []types.ParamsValidatorRoute{acceptAll},
+			msgs: []sdk.Msg{
+				&v1.MsgUpdateParams{Authority: authority, Params: newParams},
+				&v1.MsgUpdateParams{Authority: authority, Params: invalidParams},
+			},
+			authority:   authority,
+			expErrMsg:   "invalid quorum string",
+			expFailures: []uint32{1},
+		},
+		{
+			name:      "valid",
+			routes:    []types.ParamsValidatorRoute{gov.NewParamsValidatorRoute()},
+			msgs:      []sdk.Msg{&v1.MsgUpdateParams{Authority: authority, Params: newParams}},
+			authority: authority,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		suite.Run(tc.name, func() {
+			suite.reset()
+			suite.govKeeper.SetParamsValidators(tc.routes...)
+
+			msg, err := v1.NewMsgBatchUpdateParams(tc.msgs, tc.authority)
+			suite.Require().NoError(err)
+
+			_, err = suite.msgSrvr.BatchUpdateParams(suite.ctx, msg)
+			params, paramsErr := suite.govKeeper.GetParams(suite.ctx)
+			suite.Require().NoError(paramsErr)
+
+			if tc.expErrMsg == "" {
+				suite.Require().NoError(err)
+				suite.Require().Equal(newParams.Quorum, params.Quorum)
+				return
+			}
+
+			suite.Require().ErrorContains(err, tc.expErrMsg)
+			suite.Require().Equal(v1.DefaultParams().Quorum, params.Quorum)
+
+			var paramsUpdateErr *v1.ParamsUpdateError
+			if len(tc.expFailures) == 0 {
+				suite.Require().False(errors.As(err, &paramsUpdateErr))
+				return
+			}
+
+			suite.Require().ErrorIs(err, types.ErrParamsUpdateFailed)
+			suite.Require().True(errors.As(err, &paramsUpdateErr))
+			suite.Require().Len(paramsUpdateErr.Failures, len(tc.expFailures))
+			for i, failure := range paramsUpdateErr.Failures {
+				suite.Require().Equal(tc.expFailures[i], failure.Index)
+				suite.Require().Equal(sdk.MsgTypeURL(tc.msgs[failure.Index]), failure.MsgTypeUrl)
+			}
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestMsgAmendConstitution() {
+	authority := suite.govKeeper.GetAuthority()
+	proposer := suite.addrs[0]
+
+	amendMsg := v1.NewMsgAmendConstitution(authority, "amended constitution")
+
+	// a constitution amendment must be the only message of a regular proposal
+	_, err := suite.govKeeper.SubmitProposal(suite.ctx, []sdk.Msg{amendMsg, amendMsg}, "", "title", "summary", proposer, false)
+	suite.Require().ErrorIs(err, types.ErrInvalidConstitutionAmendment)
+	_, err = suite.govKeeper.SubmitProposal(suite.ctx, []sdk.Msg{amendMsg}, "", "title", "summary", proposer, true)
+	suite.Require().ErrorIs(err, types.ErrInvalidConstitutionAmendment)
+
+	constitutionProposal, err := suite.govKeeper.SubmitProposal(suite.ctx, []sdk.Msg{amendMsg}, "", "title", "summary", proposer, false)
+	suite.Require().NoError(err)
+	suite.Require().True(constitutionProposal.IsConstitutionAmendment())
+
+	otherProposal, err := suite.govKeeper.SubmitProposal(suite.ctx, TestProposal, "", "title", "summary", proposer, false)
+	suite.Require().NoError(err)
+	suite.Require().False(otherProposal.IsConstitutionAmendment())
+
+	testCases := []struct {
+		name       string
+		ctx        sdk.Context
+		msg        *v1.MsgAmendConstitution
+		expErrMsg  string
+		expVersion uint64
+	}{
+		{
+			name:      "invalid authority",
+			ctx:       types.WithExecutingProposal(suite.ctx, constitutionProposal.Id),
+			msg:       v1.NewMsgAmendConstitution(proposer.String(), "amended constitution"),
+			expErrMsg: "invalid authority",
+		},
+		{
+			name:      "not executed by a proposal",
+			ctx:       suite.ctx,
+			msg:       amendMsg,
+			expErrMsg: "the constitution can only be amended by a constitution amendment proposal",
+		},
+		{
+			name:      "executed by another proposal",
+			ctx:       types.WithExecutingProposal(suite.ctx, otherProposal.Id),
+			msg:       amendMsg,
+			expErrMsg: "is not a constitution amendment proposal",
+		},
+		{
+			name:       "executed by a constitution amendment proposal",
+			ctx:        types.WithExecutingProposal(suite.ctx, constitutionProposal.Id),
+			msg:        amendMsg,
+			expVersion: 1,
+		},
+		{
+			name:       "amended again",
+			ctx:        types.WithExecutingProposal(suite.ctx, constitutionProposal.Id),
+			msg:        v1.NewMsgAmendConstitution(authority, "amended constitution v2"),
+			expVersion: 2,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		suite.Run(tc.name, func() {
+			_, err := suite.msgSrvr.AmendConstitution(tc.ctx, tc.msg)
+			if tc.expErrMsg != "" {
+				suite.Require().ErrorContains(err, tc.expErrMsg)
+				return
+			}
+			suite.Require().NoError(err)
+
+			constitution, err := suite.govKeeper.GetConstitution(suite.ctx)
+			suite.Require().NoError(err)
+			suite.Require().Equal(tc.msg.Constitution, constitution)
+
+			res, err := suite.queryClient.ConstitutionAmendments(suite.ctx, &v1.QueryConstitutionAmendmentsRequest{})
+			suite.Require().NoError(err)
+			suite.Require().Len(res.Amendments, int(tc.expVersion))
+
+			amendment := res.Amendments[tc.expVersion-1]
+			suite.Require().Equal(tc.expVersion, amendment.Id)
+			suite.Require().Equal(constitutionProposal.Id, amendment.ProposalId)
+			suite.Require().Equal(tc.msg.Constitution, amendment.Constitution)
+			suite.Require().True(suite.ctx.BlockTime().Equal(amendment.AmendedAt))
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestMsgRegisterMetadataSchema() {
+	suite.reset()
+	authority := suite.govKeeper.GetAuthority()
+	proposer := suite.addrs[0]
+	hash := v1.MetadataSchemaHash([]byte(`{"type":"object"}`))
+
+	testCases := []struct {
+		name      string
+		msg       *v1.MsgRegisterMetadataSchema
+		expErrMsg string
+		expID     uint64
+	}{
+		{
+			name:      "invalid authority",
+			msg:       v1.NewMsgRegisterMetadataSchema(proposer.String(), "proposal", "ipfs://schema", hash),
+			expErrMsg: "invalid authority",
+		},
+		{
+			name:      "invalid hash",
+			msg:       v1.NewMsgRegisterMetadataSchema(authority, "proposal", "ipfs://schema", "hash"),
+			expErrMsg: "invalid metadata schema",
+		},
+		{
+			name:  "first schema",
+			msg:   v1.NewMsgRegisterMetadataSchema(authority, "proposal", "ipfs://schema", hash),
+			expID: 1,
+		},
+		{
+			name:  "second schema",
+			msg:   v1.NewMsgRegisterMetadataSchema(authority, "vote", "", hash),
+			expID: 2,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		suite.Run(tc.name, func() {
+			res, err := suite.msgSrvr.RegisterMetadataSchema(suite.ctx, tc.msg)
+			if tc.expErrMsg != "" {
+				suite.Require().ErrorContains(err, tc.expErrMsg)
+				return
+			}
+			suite.Require().NoError(err)
+			suite.Require().Equal(tc.expID, res.SchemaId)
+
+			schema, err := suite.govKeeper.GetMetadataSchema(suite.ctx, tc.expID)
+			suite.Require().NoError(err)
+			suite.Require().Equal(v1.NewMetadataSchema(tc.expID, tc.msg.Name, tc.msg.Uri, tc.msg.Hash), schema)
+		})
+	}
+
+	params, err := suite.govKeeper.GetParams(suite.ctx)
+	suite.Require().NoError(err)
+
+	// proposals, votes and deposits can only reference registered schemas
+	proposalMsg, err := v1.NewMsgSubmitProposal(TestProposal, params.MinDeposit, proposer.String(), "{}", "title", "summary", false)
+	suite.Require().NoError(err)
+	proposalMsg.MetadataSchemaId = 3
+	_, err = suite.msgSrvr.SubmitProposal(suite.ctx, proposalMsg)
+	suite.Require().ErrorIs(err, types.ErrMetadataSchemaNotFound)
+
+	proposalMsg.MetadataSchemaId = 1
+	res, err := suite.msgSrvr.SubmitProposal(suite.ctx, proposalMsg)
+	suite.Require().NoError(err)
+	proposal, err := suite.govKeeper.GetProposal(suite.ctx, res.ProposalId)
+	suite.Require().NoError(err)
+	suite.Require().Equal(uint64(1), proposal.MetadataSchemaId)
+
+	voteMsg := v1.NewMsgVote(proposer, res.ProposalId, v1.OptionYes, "{}")
+	voteMsg.MetadataSchemaId = 3
+	_, err = suite.msgSrvr.Vote(suite.ctx, voteMsg)
+	suite.Require().ErrorIs(err, types.ErrMetadataSchemaNotFound)
+
+	voteMsg.MetadataSchemaId = 2
+	_, err = suite.msgSrvr.Vote(suite.ctx, voteMsg)
+	suite.Require().NoError(err)
+	vote, err := suite.govKeeper.GetVote(suite.ctx, res.ProposalId, proposer)
+	suite.Require().NoError(err)
+	suite.Require().Equal(uint64(2), vote.MetadataSchemaId)
+
+	depositor := suite.addrs[1]
+	depositMsg := v1.NewMsgDeposit(depositor, res.ProposalId, params.MinDeposit)
+	depositMsg.Metadata = "{}"
+	depositMsg.MetadataSchemaId = 3
+	_, err = suite.msgSrvr.Deposit(suite.ctx, depositMsg)
+	suite.Require().ErrorIs(err, types.ErrMetadataSchemaNotFound)
+
+	depositMsg.MetadataSchemaId = 1
+	_, err = suite.msgSrvr.Deposit(suite.ctx, depositMsg)
+	suite.Require().NoError(err)
+	deposit, err := suite.govKeeper.GetDeposit(suite.ctx, res.ProposalId, depositor)
+	suite.Require().NoError(err)
+	suite.Require().Equal("{}", deposit.Metadata)
+	suite.Require().Equal(uint64(1), deposit.MetadataSchemaId)
+
+	schemas, err := suite.queryClient.MetadataSchemas(suite.ctx, &v1.QueryMetadataSchemasRequest{})
+	suite.Require().NoError(err)
+	suite.Require().Len(schemas.Schemas, 2)
+}
+
 func (suite *KeeperTestSuite) TestSubmitProposal_InitialDeposit() {
 	const meetsDepositValue = baseDepositTestAmount * baseDepositTestPercent / 100
 	baseDepositRatioDec := sdkmath.LegacyNewDec(baseDepositTestPercent).Quo(sdkmath.LegacyNewDec(100))
@@ -0,0 +1,98 @@
+package keeper
+
+import (
+	"context"
+	"fmt"
+
+	errorsmod "cosmossdk.io/errors"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/gov/types"
+	v1 "github.com/cosmos/cosmos-sdk/x/gov/types/v1"
+)
+
+// SetParamsValidators registers the params validators used to check the
+// messages of a MsgBatchUpdateParams. It panics if two validators are
+// registered for the same message type URL.
+func (k *Keeper) SetParamsValidators(routes ...types.ParamsValidatorRoute) {
+	if k.paramsValidators == nil {
+		k.paramsValidators = make(map[string]types.ParamsValidator, len(routes))
+	}
+
+	for _, r := range routes {
+		if _, ok := k.paramsValidators[r.MsgTypeURL]; ok {
+			panic(fmt.Sprintf("params validator for %s has already been registered", r.MsgTypeURL))
+		}
+
+		k.paramsValidators[r.MsgTypeURL] = r.Validator
+	}
+}
+
+// ValidateParamsUpdates checks every message of a parameters update batch
+// against the params validator registered by its module, without applying any
+// of them. It returns the failure of every invalid message.
+func (k Keeper) ValidateParamsUpdates(ctx context.Context, msgs []sdk.Msg) []v1.ParamsUpdateFailure {
+	var failures []v1.ParamsUpdateFailure
+	for i, msg := range msgs {
+		if err := k.validateParamsUpdate(ctx, msg); err != nil {
+			failures = append(failures, v1.ParamsUpdateFailure{
+				Index:      uint32(i),
+				MsgTypeUrl: sdk.MsgTypeURL(msg),
+				Reason:     err.Error(),
+			})
+		}
+	}
+
+	return failures
+}
+
+func (k Keeper) validateParamsUpdate(ctx context.Context, msg sdk.Msg) error {
+	if _, ok := msg.(*v1.MsgBatchUpdateParams); ok {
+		return errorsmod.Wrap(types.ErrInvalidProposalMsg, "params update batches cannot be nested")
+	}
+
+	signers := msg.GetSigners()
+	if len(signers) != 1 || signers[0].String() != k.authority {
+		return errorsmod.Wrapf(types.ErrInvalidSigner, "expected %s as only signer", k.authority)
+	}
+
+	typeURL := sdk.MsgTypeURL(msg)
+	validator, ok := k.paramsValidators[typeURL]
+	if !ok {
+		return errorsmod.Wrap(types.ErrNoParamsValidator, typeURL)
+	}
+
+	if k.router.Handler(msg) == nil {
+		return errorsmod.Wrap(types.ErrUnroutableProposalMsg, typeURL)
+	}
+
+	return validator(ctx, msg)
+}
+
+// ApplyParamsUpdates validates all messages of a parameters update batch and
+// then executes them in a cached context. The state changes are only committed
+// if every message succeeds; otherwise a *v1.ParamsUpdateError describing the
+// failures is returned and nothing is applied.
+func (k Keeper) ApplyParamsUpdates(ctx context.Context, msgs []sdk.Msg) error {
+	if failures := k.ValidateParamsUpdates(ctx, msgs); len(failures) > 0 {
+		return &v1.ParamsUpdateError{Failures: failures}
+	}
+
+	cacheCtx, writeCache := sdk.UnwrapSDKContext(ctx).CacheContext()
+	for i, msg := range msgs {
+		handler := k.router.Handler(msg)
+		if _, err := handler(cacheCtx, msg); err != nil {
+			return &v1.ParamsUpdateError{
+				Failures: []v1.ParamsUpdateFailure{{
+					Index:      uint32(i),
+					MsgTypeUrl: sdk.MsgTypeURL(msg),
+					Reason:     err.Error(),
+				}},
+			}
+		}
+	}
+
+	writeCache()
+
+	return nil
+}
@@ -4,20 +4,28 @@ import (
 	"context"
 	"errors"
 	"fmt"
-	"time"
 
 	errorsmod "cosmossdk.io/errors"
 	storetypes "cosmossdk.io/store/types"
 
+	"github.com/cosmos/cosmos-sdk/baseapp"
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/runtime"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdktx "github.com/cosmos/cosmos-sdk/types/tx"
 	"github.com/cosmos/cosmos-sdk/x/gov/types"
 	v1 "github.com/cosmos/cosmos-sdk/x/gov/types/v1"
 )
 
 // SubmitProposal creates a new proposal given an array of messages
 func (keeper Keeper) SubmitProposal(ctx context.Context, messages []sdk.Msg, metadata, title, summary string, proposer sdk.AccAddress, expedited bool) (v1.Proposal, error) {
+	return keeper.SubmitProposalWithAuthority(ctx, messages, metadata, title, summary, proposer, expedited, "")
+}
+
+// SubmitProposalWithAuthority creates a new proposal given an array of messages
+// executed on behalf of executionAuthority instead of the gov module account.
+// An empty executionAuthority defaults to the gov module account.
+func (keeper Keeper) SubmitProposalWithAuthority(ctx context.Context, messages []sdk.Msg, metadata, title, summary string, proposer sdk.AccAddress, expedited bool, executionAuthority string) (v1.Proposal, error) {
 	sdkCtx := sdk.UnwrapSDKContext(ctx)
 	err := keeper.assertMetadataLength(metadata)
 	if err != nil {
@@ -36,60 +44,22 @@ func (keeper Keeper) SubmitProposal(ctx context.Context, messages []sdk.Msg, met
 		return v1.Proposal{}, err
 	}
 
-	// Will hold a comma-separated string of all Msg type URLs.
-	msgsStr := ""
-
-	// Loop through all messages and confirm that each has a handler and the gov module account
-	// as the only signer
-	for _, msg := range messages {
-		msgsStr += fmt.Sprintf(",%s", sdk.MsgTypeURL(msg))
-
-		// perform a basic validation of the message
-		if m, ok := msg.(sdk.HasValidateBasic); ok {
-			if err := m.ValidateBasic(); err != nil {
-				return v1.Proposal{}, errorsmod.Wrap(types.ErrInvalidProposalMsg, err.Error())
-			}
-		}
-
-		signers := msg.GetSigners()
-		if len(signers) != 1 {
-			return v1.Proposal{}, types.ErrInvalidSigner
-		}
-
-		// assert that the governance module account is the only signer of the messages
-		if !signers[0].Equals(keeper.GetGovernanceAccount(ctx).GetAddress()) {
-			return v1.Proposal{}, errorsmod.Wrapf(types.ErrInvalidSigner, signers[0].String())
-		}
-
-		// use the msg service router to see that there is a valid route for that message.
-		handler := keeper.router.Handler(msg)
-		if handler == nil {
-			return v1.Proposal{}, errorsmod.Wrap(types.ErrUnroutableProposalMsg, sdk.MsgTypeURL(msg))
-		}
-
-		// Only if it's a MsgExecLegacyContent do we try to execute the
-		// proposal in a cached context.
-		// For other Msgs, we do not verify the proposal messages any further.
-		// They may fail upon execution.
-		// ref: https://github.com/cosmos/cosmos-sdk/pull/10868#discussion_r784872842
-		if msg, ok := msg.(*v1.MsgExecLegacyContent); ok {
-			cacheCtx, _ := sdkCtx.CacheContext()
-			if _, err := handler(cacheCtx, msg); err != nil {
-				if errors.Is(types.ErrNoProposalHandlerExists, err) {
-					return v1.Proposal{}, err
-				}
-				return v1.Proposal{}, errorsmod.Wrap(types.ErrInvalidProposalContent, err.Error())
-			}
-		}
+	params, err := keeper.GetParams(ctx)
+	if err != nil {
+		return v1.Proposal{}, err
+	}
 
+	authority, err := keeper.executionAuthorityAddress(ctx, params, executionAuthority)
+	if err != nil {
+		return v1.Proposal{}, err
 	}
 
-	proposalID, err := keeper.GetProposalID(ctx)
+	msgsStr, err := keeper.validateProposalMsgs(ctx, messages, authority, expedited)
 	if err != nil {
 		return v1.Proposal{}, err
 	}
 
-	params, err := keeper.GetParams(ctx)
+	proposalID, err := keeper.GetProposalID(ctx)
 	if err != nil {
 		return v1.Proposal{}, err
 	}
@@ -101,6 +71,7 @@ func (keeper Keeper) SubmitProposal(ctx context.Context, messages []sdk.Msg, met
 	if err != nil {
 		return v1.Proposal{}, err
 	}
+	proposal.ExecutionAuthority = executionAuthority
 
 	keeper.SetProposal(ctx, proposal)
 	keeper.InsertInactiveProposalQueue(ctx, proposalID, *proposal.DepositEndTime)
@@ -120,6 +91,200 @@ func (keeper Keeper) SubmitProposal(ctx context.Context, messages []sdk.Msg, met
 	return proposal, nil
 }
 
+// validateProposalMsgs validates the messages of a proposal executed on behalf
+// of authority and returns their comma-separated type URLs.
+func (keeper Keeper) validateProposalMsgs(ctx context.Context, messages []sdk.Msg, authority sdk.AccAddress, expedited bool) (string, error) {
+	// Will hold a comma-separated string of all Msg type URLs.
+	msgsStr := ""
+
+	// Loop through all messages and confirm that each has a handler and the execution authority
+	// as the only signer
+	for _, msg := range messages {
+		msgsStr += fmt.Sprintf(",%s", sdk.MsgTypeURL(msg))
+
+		if err := keeper.validateProposalMsg(ctx, msg, len(messages), authority, expedited); err != nil {
+			return "", err
+		}
+	}
+
+	return msgsStr, nil
+}
+
+// validateProposalMsg validates a message of a proposal of numMsgs messages
+// executed on behalf of authority.
+func (keeper Keeper) validateProposalMsg(ctx context.Context, msg sdk.Msg, numMsgs int, authority sdk.AccAddress, expedited bool) error {
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+
+	// Legacy content handlers act on behalf of the gov module account, so they
+	// cannot be executed on behalf of another execution authority.
+	isGovAuthority := authority.Equals(keeper.GetGovernanceAccount(ctx).GetAddress())
+
+	if _, ok := msg.(*v1.MsgExecLegacyContent); ok && !isGovAuthority {
+		return errorsmod.Wrap(types.ErrInvalidProposalMsg, "legacy content cannot be executed on behalf of an execution authority")
+	}
+
+	handler, err := keeper.proposalMsgHandler(msg, authority)
+	if err != nil {
+		return err
+	}
+
+	// Only if it's a MsgExecLegacyContent do we try to execute the
+	// proposal in a cached context.
+	// For other Msgs, we do not verify the proposal messages any further.
+	// They may fail upon execution.
+	// ref: https://github.com/cosmos/cosmos-sdk/pull/10868#discussion_r784872842
+	if msg, ok := msg.(*v1.MsgExecLegacyContent); ok {
+		cacheCtx, _ := sdkCtx.CacheContext()
+		if _, err := handler(cacheCtx, msg); err != nil {
+			if errors.Is(types.ErrNoProposalHandlerExists, err) {
+				return err
+			}
+			return errorsmod.Wrap(types.ErrInvalidProposalContent, err.Error())
+		}
+	}
+
+	// A MsgBatchUpdateParams is checked against the params validators
+	// upfront, so that an invalid batch is rejected at submission time.
+	if msg, ok := msg.(*v1.MsgBatchUpdateParams); ok {
+		batchMsgs, err := msg.GetMsgs()
+		if err != nil {
+			return err
+		}
+
+		if failures := keeper.ValidateParamsUpdates(ctx, batchMsgs); len(failures) > 0 {
+			return &v1.ParamsUpdateError{Failures: failures}
+		}
+	}
+
+	// A MsgAmendConstitution must be the only message of its proposal, so
+	// that the proposal is tallied with the constitution amendment threshold.
+	if _, ok := msg.(*v1.MsgAmendConstitution); ok {
+		if numMsgs != 1 {
+			return errorsmod.Wrap(types.ErrInvalidConstitutionAmendment, "constitution amendment proposals must contain a single message")
+		}
+
+		if expedited {
+			return errorsmod.Wrap(types.ErrInvalidConstitutionAmendment, "constitution amendment proposals cannot be expedited")
+		}
+	}
+
+	return nil
+}
+
+// proposalMsgHandler validates the given proposal message, executed on behalf
+// of authority, and returns its handler.
+func (keeper Keeper) proposalMsgHandler(msg sdk.Msg, authority sdk.AccAddress) (baseapp.MsgServiceHandler, error) {
+	// perform a basic validation of the message
+	if m, ok := msg.(sdk.HasValidateBasic); ok {
+		if err := m.ValidateBasic(); err != nil {
+			return nil, errorsmod.Wrap(types.ErrInvalidProposalMsg, err.Error())
+		}
+	}
+
+	signers := msg.GetSigners()
+	if len(signers) != 1 {
+		return nil, types.ErrInvalidSigner
+	}
+
+	// assert that the execution authority is the only signer of the messages
+	if !signers[0].Equals(authority) {
+		return nil, errorsmod.Wrapf(types.ErrInvalidSigner, signers[0].String())
+	}
+
+	// use the msg service router to see that there is a valid route for that message.
+	handler := keeper.router.Handler(msg)
+	if handler == nil {
+		return nil, errorsmod.Wrap(types.ErrUnroutableProposalMsg, sdk.MsgTypeURL(msg))
+	}
+
+	return handler, nil
+}
+
+// SimulateProposalMsgs executes the given proposal messages on behalf of
+// executionAuthority, as a passed proposal would, against a branch of the state
+// which is discarded afterwards. It returns the gas consumed by the execution,
+// the events it emitted, and the failure of the execution, if any. An error is
+// returned if the messages could not be submitted in a proposal.
+func (keeper Keeper) SimulateProposalMsgs(ctx context.Context, messages []sdk.Msg, executionAuthority string) (uint64, sdk.Events, *v1.ProposalExecutionFailure, error) {
+	params, err := keeper.GetParams(ctx)
+	if err != nil {
+		return 0, nil, nil, err
+	}
+
+	authority, err := keeper.executionAuthorityAddress(ctx, params, executionAuthority)
+	if err != nil {
+		return 0, nil, nil, err
+	}
+
+	handlers := make([]baseapp.MsgServiceHandler, len(messages))
+	for i, msg := range messages {
+		if handlers[i], err = keeper.proposalMsgHandler(msg, authority); err != nil {
+			return 0, nil, nil, err
+		}
+	}
+
+	// the messages are executed with a gas meter of their own, so that only
+	// the gas they consume is reported
+	cacheCtx, _ := sdk.UnwrapSDKContext(ctx).CacheContext()
+	cacheCtx = cacheCtx.WithGasMeter(storetypes.NewInfiniteGasMeter())
+
+	var events sdk.Events
+	for i, msg := range messages {
+		res, err := runProposalMsg(cacheCtx, handlers[i], msg)
+		if err != nil {
+			return cacheCtx.GasMeter().GasConsumed(), events, &v1.ProposalExecutionFailure{
+				MsgIndex:   uint32(i),
+				MsgTypeUrl: sdk.MsgTypeURL(msg),
+				Reason:     err.Error(),
+			}, nil
+		}
+
+		events = append(events, res.GetEvents()...)
+	}
+
+	return cacheCtx.GasMeter().GasConsumed(), events, nil, nil
+}
+
+// runProposalMsg executes msg with handler, turning a panic of the handler into
+// an error.
+func runProposalMsg(ctx sdk.Context, handler baseapp.MsgServiceHandler, msg sdk.Msg) (res *sdk.Result, err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			err = fmt.Errorf("panic while executing message: %v", r)
+		}
+	}()
+
+	return handler(ctx, msg)
+}
+
+// ValidateExecutionAuthority returns an error if the messages of the given
+// proposal may no longer be executed on behalf of its execution authority,
+// because it was removed from the execution authorities allowed by the params.
+func (keeper Keeper) ValidateExecutionAuthority(ctx context.Context, proposal v1.Proposal) error {
+	params, err := keeper.GetParams(ctx)
+	if err != nil {
+		return err
+	}
+
+	_, err = keeper.executionAuthorityAddress(ctx, params, proposal.ExecutionAuthority)
+	return err
+}
+
+// executionAuthorityAddress returns the address the proposal messages are
+// executed on behalf of: the gov module account if executionAuthority is
+// empty, executionAuthority if it is allowed by the params.
+func (keeper Keeper) executionAuthorityAddress(ctx context.Context, params v1.Params, executionAuthority string) (sdk.AccAddress, error) {
+	if executionAuthority == "" {
+		return keeper.GetGovernanceAccount(ctx).GetAddress(), nil
+	}
+
+	if !params.IsExecutionAuthority(executionAuthority) {
+		return nil, errorsmod.Wrapf(types.ErrInvalidExecutionAuthority, "%s is not an allowed execution authority", executionAuthority)
+	}
+
+	return keeper.authKeeper.StringToBytes(executionAuthority)
+}
+
 // CancelProposal will cancel proposal before the voting period ends
 func (keeper Keeper) CancelProposal(ctx context.Context, proposalID uint64, proposer string) error {
 	sdkCtx := sdk.UnwrapSDKContext(ctx)
@@ -140,8 +305,8 @@ func (keeper Keeper) CancelProposal(ctx context.Context, proposalID uint64, prop
 	}
 
 	// Check if proposal is active or not
-	if (proposal.Status != v1.StatusDepositPeriod) && (proposal.Status != v1.StatusVotingPeriod) {
-		return types.ErrInvalidProposal.Wrap("proposal should be in the deposit or voting period")
+	if (proposal.Status != v1.StatusDepositPeriod) && (proposal.Status != v1.StatusDiscussion) && (proposal.Status != v1.StatusVotingPeriod) {
+		return types.ErrInvalidProposal.Wrap("proposal should be in the deposit, discussion or voting period")
 	}
 
 	// Check proposal voting period is ended.
@@ -166,6 +331,16 @@ func (keeper Keeper) CancelProposal(ctx context.Context, proposalID uint64, prop
 		if err != nil {
 			return err
 		}
+
+		err = keeper.deleteVotedShares(ctx, proposal.Id)
+		if err != nil {
+			return err
+		}
+
+		err = keeper.deleteVoteCommitments(ctx, proposal.Id)
+		if err != nil {
+			return err
+		}
 	}
 
 	err = keeper.DeleteProposal(ctx, proposal.Id)
@@ -240,6 +415,12 @@ func (keeper Keeper) DeleteProposal(ctx context.Context, proposalID uint64) erro
 			return err
 		}
 	}
+	if proposal.DiscussionEndTime != nil {
+		err := keeper.RemoveFromDiscussionProposalQueue(ctx, proposalID, *proposal.DiscussionEndTime)
+		if err != nil {
+			return err
+		}
+	}
 	if proposal.VotingEndTime != nil {
 		err := keeper.RemoveFromActiveProposalQueue(ctx, proposalID, *proposal.VotingEndTime)
 		if err != nil {
@@ -369,20 +550,123 @@ func (keeper Keeper) ActivateVotingPeriod(ctx context.Context, proposal v1.Propo
 	sdkCtx := sdk.UnwrapSDKContext(ctx)
 	startTime := sdkCtx.BlockHeader().Time
 	proposal.VotingStartTime = &startTime
-	var votingPeriod *time.Duration
 	params, err := keeper.GetParams(ctx)
 	if err != nil {
 		return err
 	}
 
+	setVotingEndTime(params, &proposal)
+	proposal.Status = v1.StatusVotingPeriod
+	err = keeper.SetProposal(ctx, proposal)
+	if err != nil {
+		return err
+	}
+
+	err = keeper.RemoveFromInactiveProposalQueue(ctx, proposal.Id, *proposal.DepositEndTime)
+	if err != nil {
+		return err
+	}
+
+	if proposal.DiscussionEndTime != nil {
+		err = keeper.RemoveFromDiscussionProposalQueue(ctx, proposal.Id, *proposal.DiscussionEndTime)
+		if err != nil {
+			return err
+		}
+	}
+
+	return keeper.InsertActiveProposalQueue(ctx, proposal.Id, *proposal.VotingEndTime)
+}
+
+// setVotingEndTime sets the voting end time of a proposal from its voting start
+// time and the voting period matching its kind.
+func setVotingEndTime(params v1.Params, proposal *v1.Proposal) {
+	votingPeriod := params.VotingPeriod
 	if proposal.Expedited {
 		votingPeriod = params.ExpeditedVotingPeriod
-	} else {
-		votingPeriod = params.VotingPeriod
 	}
+
 	endTime := proposal.VotingStartTime.Add(*votingPeriod)
+	if proposal.CommitReveal {
+		// the votes are committed during the voting period of the proposal,
+		// then revealed during the reveal period
+		commitEndTime := endTime
+		proposal.CommitEndTime = &commitEndTime
+		if params.RevealPeriod != nil {
+			endTime = endTime.Add(*params.RevealPeriod)
+		}
+	}
 	proposal.VotingEndTime = &endTime
-	proposal.Status = v1.StatusVotingPeriod
+}
+
+// SetProposalExpedited sets whether a proposal in its discussion or voting
+// period is expedited. The voting end time of a proposal in its voting period
+// is recomputed from the voting period matching its new kind, so that an
+// expedited proposal may end in the next block. It returns an error once the
+// voting period of the proposal has ended, even if the proposal has not been
+// tallied yet, and if the proposal is a constitution amendment, which cannot
+// be expedited.
+func (keeper Keeper) SetProposalExpedited(ctx context.Context, proposalID uint64, expedited bool) error {
+	proposal, err := keeper.GetProposal(ctx, proposalID)
+	if err != nil {
+		return err
+	}
+
+	if proposal.Status != v1.StatusDiscussion && proposal.Status != v1.StatusVotingPeriod {
+		return errorsmod.Wrapf(types.ErrInactiveProposal, "proposal %d is not in its discussion or voting period", proposalID)
+	}
+
+	if proposal.VotingEndTime != nil && proposal.VotingEndTime.Before(sdk.UnwrapSDKContext(ctx).BlockTime()) {
+		return types.ErrVotingPeriodEnded.Wrapf("voting period is already ended for this proposal %d", proposalID)
+	}
+
+	if expedited {
+		msgs, err := proposal.GetMsgs()
+		if err != nil {
+			return err
+		}
+
+		for _, msg := range msgs {
+			if _, ok := msg.(*v1.MsgAmendConstitution); ok {
+				return errorsmod.Wrap(types.ErrInvalidConstitutionAmendment, "constitution amendment proposals cannot be expedited")
+			}
+		}
+	}
+
+	proposal.Expedited = expedited
+	if proposal.Status == v1.StatusDiscussion {
+		return keeper.SetProposal(ctx, proposal)
+	}
+
+	params, err := keeper.GetParams(ctx)
+	if err != nil {
+		return err
+	}
+
+	if err := keeper.RemoveFromActiveProposalQueue(ctx, proposal.Id, *proposal.VotingEndTime); err != nil {
+		return err
+	}
+
+	setVotingEndTime(params, &proposal)
+	if err := keeper.SetProposal(ctx, proposal); err != nil {
+		return err
+	}
+
+	return keeper.InsertActiveProposalQueue(ctx, proposal.Id, *proposal.VotingEndTime)
+}
+
+// StartDiscussionPeriod moves a proposal which reached the minimum deposit from
+// its deposit period to its discussion period. Its voting period is activated
+// by the EndBlocker once the discussion period ends.
+func (keeper Keeper) StartDiscussionPeriod(ctx context.Context, proposal v1.Proposal) error {
+	params, err := keeper.GetParams(ctx)
+	if err != nil {
+		return err
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	endTime := sdkCtx.BlockHeader().Time.Add(*params.DiscussionPeriod)
+	proposal.DiscussionEndTime = &endTime
+	proposal.Status = v1.StatusDiscussion
 	err = keeper.SetProposal(ctx, proposal)
 	if err != nil {
 		return err
@@ -393,7 +677,158 @@ func (keeper Keeper) ActivateVotingPeriod(ctx context.Context, proposal v1.Propo
 		return err
 	}
 
-	return keeper.InsertActiveProposalQueue(ctx, proposal.Id, *proposal.VotingEndTime)
+	err = keeper.InsertDiscussionProposalQueue(ctx, proposal.Id, endTime)
+	if err != nil {
+		return err
+	}
+
+	sdkCtx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeDiscussionPeriodStart,
+			sdk.NewAttribute(types.AttributeKeyProposalID, fmt.Sprintf("%d", proposal.Id)),
+			sdk.NewAttribute(types.AttributeKeyDiscussionEndTime, endTime.String()),
+		),
+	)
+
+	return nil
+}
+
+// UpdateProposalMetadata replaces the metadata of a proposal in its discussion
+// period. Only the proposer of the proposal can amend its metadata; its
+// messages cannot be changed.
+func (keeper Keeper) UpdateProposalMetadata(ctx context.Context, proposalID uint64, proposer, metadata string) error {
+	proposal, err := keeper.GetProposal(ctx, proposalID)
+	if err != nil {
+		return err
+	}
+
+	if proposal.Proposer != proposer {
+		return types.ErrInvalidProposer.Wrapf("invalid proposer %s", proposer)
+	}
+
+	if proposal.Status != v1.StatusDiscussion {
+		return types.ErrInvalidProposal.Wrapf("proposal %d is not in the discussion period", proposalID)
+	}
+
+	if err := keeper.assertMetadataLength(metadata); err != nil {
+		return err
+	}
+
+	proposal.Metadata = metadata
+	err = keeper.extendDiscussionPeriod(ctx, &proposal)
+	if err != nil {
+		return err
+	}
+
+	err = keeper.SetProposal(ctx, proposal)
+	if err != nil {
+		return err
+	}
+
+	sdk.UnwrapSDKContext(ctx).EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeUpdateProposalMetadata,
+			sdk.NewAttribute(types.AttributeKeyProposalID, fmt.Sprintf("%d", proposalID)),
+		),
+	)
+
+	return nil
+}
+
+// AmendProposal replaces the messages, metadata, title and summary of a
+// proposal in its discussion period. Only the proposer of the proposal can
+// amend it, and the amended messages are validated as on submission. The
+// proposal is frozen once its discussion period ends.
+func (keeper Keeper) AmendProposal(ctx context.Context, proposalID uint64, proposer string, messages []sdk.Msg, metadata, title, summary string) error {
+	proposal, err := keeper.GetProposal(ctx, proposalID)
+	if err != nil {
+		return err
+	}
+
+	if proposal.Proposer != proposer {
+		return types.ErrInvalidProposer.Wrapf("invalid proposer %s", proposer)
+	}
+
+	if proposal.Status != v1.StatusDiscussion {
+		return types.ErrInvalidProposal.Wrapf("proposal %d is not in the discussion period", proposalID)
+	}
+
+	for _, s := range []string{metadata, title, summary} {
+		if err := keeper.assertMetadataLength(s); err != nil {
+			return err
+		}
+	}
+
+	params, err := keeper.GetParams(ctx)
+	if err != nil {
+		return err
+	}
+
+	authority, err := keeper.executionAuthorityAddress(ctx, params, proposal.ExecutionAuthority)
+	if err != nil {
+		return err
+	}
+
+	msgsStr, err := keeper.validateProposalMsgs(ctx, messages, authority, proposal.Expedited)
+	if err != nil {
+		return err
+	}
+
+	proposal.Messages, err = sdktx.SetMsgs(messages)
+	if err != nil {
+		return err
+	}
+
+	proposal.Metadata = metadata
+	proposal.Title = title
+	proposal.Summary = summary
+	err = keeper.extendDiscussionPeriod(ctx, &proposal)
+	if err != nil {
+		return err
+	}
+
+	err = keeper.SetProposal(ctx, proposal)
+	if err != nil {
+		return err
+	}
+
+	sdk.UnwrapSDKContext(ctx).EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeAmendProposal,
+			sdk.NewAttribute(types.AttributeKeyProposalID, fmt.Sprintf("%d", proposalID)),
+			sdk.NewAttribute(types.AttributeKeyProposalMessages, msgsStr),
+			sdk.NewAttribute(types.AttributeKeyDiscussionEndTime, proposal.DiscussionEndTime.String()),
+		),
+	)
+
+	return nil
+}
+
+// extendDiscussionPeriod extends the discussion period of an amended proposal
+// so that it does not end sooner than the amendment period. The proposal is
+// re-keyed in the discussion proposal queue, but not stored.
+func (keeper Keeper) extendDiscussionPeriod(ctx context.Context, proposal *v1.Proposal) error {
+	params, err := keeper.GetParams(ctx)
+	if err != nil {
+		return err
+	}
+
+	if !params.AmendmentExtensionEnabled() {
+		return nil
+	}
+
+	endTime := sdk.UnwrapSDKContext(ctx).BlockHeader().Time.Add(*params.AmendmentPeriod)
+	if !endTime.After(*proposal.DiscussionEndTime) {
+		return nil
+	}
+
+	if err := keeper.RemoveFromDiscussionProposalQueue(ctx, proposal.Id, *proposal.DiscussionEndTime); err != nil {
+		return err
+	}
+
+	proposal.DiscussionEndTime = &endTime
+
+	return keeper.InsertDiscussionProposalQueue(ctx, proposal.Id, endTime)
 }
 
 // MarshalProposal marshals the proposal and returns binary encoded bytes.
@@ -0,0 +1,159 @@
+package keeper
+
+import (
+	"bytes"
+	"context"
+	"sort"
+	"time"
+
+	corestoretypes "cosmossdk.io/core/store"
+	storetypes "cosmossdk.io/store/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/gov/types"
+)
+
+// insertProposalQueueEntry inserts the entry of a proposal in the proposal
+// queue bucket stored at bucketKey, keeping the entries of the bucket sorted.
+func (k Keeper) insertProposalQueueEntry(ctx context.Context, bucketKey []byte, proposalID uint64, endTime time.Time) error {
+	store := k.storeService.OpenKVStore(ctx)
+	bz, err := store.Get(bucketKey)
+	if err != nil {
+		return err
+	}
+
+	entry := types.ProposalQueueEntry(proposalID, endTime)
+	entries := types.SplitProposalQueueBucket(bz)
+	i := sort.Search(len(entries), func(i int) bool {
+		return bytes.Compare(entries[i], entry) >= 0
+	})
+	if i < len(entries) && bytes.Equal(entries[i], entry) {
+		return nil
+	}
+
+	entries = append(entries, nil)
+	copy(entries[i+1:], entries[i:])
+	entries[i] = entry
+
+	return store.Set(bucketKey, bytes.Join(entries, nil))
+}
+
+// removeProposalQueueEntry removes the entry of a proposal from the proposal
+// queue bucket stored at bucketKey, deleting the bucket once empty.
+func (k Keeper) removeProposalQueueEntry(ctx context.Context, bucketKey []byte, proposalID uint64, endTime time.Time) error {
+	store := k.storeService.OpenKVStore(ctx)
+	bz, err := store.Get(bucketKey)
+	if err != nil {
+		return err
+	}
+
+	entry := types.ProposalQueueEntry(proposalID, endTime)
+	entries := types.SplitProposalQueueBucket(bz)
+	kept := make([][]byte, 0, len(entries))
+	for _, e := range entries {
+		if !bytes.Equal(e, entry) {
+			kept = append(kept, e)
+		}
+	}
+
+	switch {
+	case len(kept) == len(entries):
+		return nil
+	case len(kept) == 0:
+		return store.Delete(bucketKey)
+	default:
+		return store.Set(bucketKey, bytes.Join(kept, nil))
+	}
+}
+
+// proposalQueueIterator iterates over the entries of the buckets of a proposal
+// queue, in the order of their end times then proposal IDs, until an end time.
+// Its keys are the proposal queue keys of the proposals, e.g.
+// ActiveProposalQueueKey, and its values their proposal IDs.
+type proposalQueueIterator struct {
+	buckets corestoretypes.Iterator
+	prefix  []byte
+	endTime []byte
+	entries [][]byte
+}
+
+var _ corestoretypes.Iterator = (*proposalQueueIterator)(nil)
+
+// proposalQueueIterator returns an iterator over the proposals of the queue
+// stored under prefix which end by endTime, i.e. over the buckets up to the
+// bucket of endTime.
+func (k Keeper) proposalQueueIterator(ctx context.Context, prefix []byte, endTime time.Time) (corestoretypes.Iterator, error) {
+	store := k.storeService.OpenKVStore(ctx)
+	bucketKey := append(append([]byte{}, prefix...), sdk.FormatTimeBytes(types.ProposalQueueBucket(endTime))...)
+	buckets, err := store.Iterator(prefix, storetypes.PrefixEndBytes(bucketKey))
+	if err != nil {
+		return nil, err
+	}
+
+	it := &proposalQueueIterator{
+		buckets: buckets,
+		prefix:  prefix,
+		endTime: sdk.FormatTimeBytes(endTime),
+	}
+	it.loadEntries()
+
+	return it, nil
+}
+
+// loadEntries loads the entries ending by the end time of the next non-empty
+// bucket, once the entries of the current bucket are iterated.
+func (it *proposalQueueIterator) loadEntries() {
+	for len(it.entries) == 0 && it.buckets.Valid() {
+		// the entries are copied, as the value of the store iterator may be
+		// reused once advanced
+		bz := append([]byte{}, it.buckets.Value()...)
+		for _, entry := range types.SplitProposalQueueBucket(bz) {
+			if bytes.Compare(entry[:len(it.endTime)], it.endTime) <= 0 {
+				it.entries = append(it.entries, entry)
+			}
+		}
+		it.buckets.Next()
+	}
+}
+
+func (it *proposalQueueIterator) Domain() (start, end []byte) {
+	return it.buckets.Domain()
+}
+
+func (it *proposalQueueIterator) Valid() bool {
+	return len(it.entries) > 0
+}
+
+func (it *proposalQueueIterator) Next() {
+	if !it.Valid() {
+		panic("iterator is invalid")
+	}
+
+	it.entries = it.entries[1:]
+	it.loadEntries()
+}
+
+func (it *proposalQueueIterator) Key() []byte {
+	if !it.Valid() {
+		panic("iterator is invalid")
+	}
+
+	return append(append([]byte{}, it.prefix...), it.entries[0]...)
+}
+
+func (it *proposalQueueIterator) Value() []byte {
+	if !it.Valid() {
+		panic("iterator is invalid")
+	}
+
+	proposalID, _ := types.SplitProposalQueueEntry(it.entries[0])
+	return types.GetProposalIDBytes(proposalID)
+}
+
+func (it *proposalQueueIterator) Error() error {
+	return it.buckets.Error()
+}
+
+func (it *proposalQueueIterator) Close() error {
+	return it.buckets.Close()
+}
@@ -0,0 +1,79 @@
+package keeper_test
+
+import (
+	"bytes"
+	"math/rand"
+	"sort"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/cosmos-sdk/x/gov/types"
+)
+
+// TestProposalQueueEquivalence checks that the time-bucketed proposal queues
+// iterate over the same proposals, in the same order, as queues keyed by end
+// time and proposal ID.
+func TestProposalQueueEquivalence(t *testing.T) {
+	govKeeper, _, _, _, _, _, ctx := setupGovKeeper(t)
+	r := rand.New(rand.NewSource(1))
+	start := time.Date(2023, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	// the legacy keys of the proposals in the queue, keyed by proposal ID
+	legacyKeys := make(map[uint64][]byte)
+	endTimes := make(map[uint64]time.Time)
+	for i := 0; i < 500; i++ {
+		proposalID := uint64(r.Intn(100))
+		if endTime, ok := endTimes[proposalID]; ok {
+			require.NoError(t, govKeeper.RemoveFromActiveProposalQueue(ctx, proposalID, endTime))
+			delete(legacyKeys, proposalID)
+			delete(endTimes, proposalID)
+			continue
+		}
+
+		// the end times spread over a few buckets, some proposals ending at
+		// the same time
+		endTime := start.Add(time.Duration(r.Intn(600)) * time.Second)
+		if r.Intn(2) == 0 {
+			endTime = endTime.Add(time.Duration(r.Int63n(int64(time.Second))))
+		}
+		require.NoError(t, govKeeper.InsertActiveProposalQueue(ctx, proposalID, endTime))
+		legacyKeys[proposalID] = types.ActiveProposalQueueKey(proposalID, endTime)
+		endTimes[proposalID] = endTime
+	}
+
+	sorted := make([][]byte, 0, len(legacyKeys))
+	for _, key := range legacyKeys {
+		sorted = append(sorted, key)
+	}
+	sort.Slice(sorted, func(i, j int) bool { return bytes.Compare(sorted[i], sorted[j]) < 0 })
+
+	for _, endTime := range []time.Time{
+		start.Add(-time.Second),
+		start,
+		start.Add(90 * time.Second),
+		start.Add(5*time.Minute + 500*time.Millisecond),
+		start.Add(10 * time.Minute),
+	} {
+		var expected [][]byte
+		for _, key := range sorted {
+			_, keyEndTime := types.SplitActiveProposalQueueKey(key)
+			if !keyEndTime.After(endTime) {
+				expected = append(expected, key)
+			}
+		}
+
+		var actual [][]byte
+		iterator, err := govKeeper.ActiveProposalQueueIterator(ctx, endTime)
+		require.NoError(t, err)
+		for ; iterator.Valid(); iterator.Next() {
+			proposalID, _ := types.SplitActiveProposalQueueKey(iterator.Key())
+			require.Equal(t, proposalID, types.GetProposalIDFromBytes(iterator.Value()))
+			actual = append(actual, iterator.Key())
+		}
+		require.NoError(t, iterator.Close())
+
+		require.Equal(t, expected, actual, endTime)
+	}
+}
@@ -11,6 +11,8 @@ import (
 
 	"github.com/cosmos/cosmos-sdk/testutil/testdata"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
 	"github.com/cosmos/cosmos-sdk/x/gov/types"
 	v1 "github.com/cosmos/cosmos-sdk/x/gov/types/v1"
 	"github.com/cosmos/cosmos-sdk/x/gov/types/v1beta1"
@@ -100,6 +102,55 @@ func (suite *KeeperTestSuite) TestActivateVotingPeriod() {
 	}
 }
 
+func (suite *KeeperTestSuite) TestSetProposalExpedited() {
+	params, err := suite.govKeeper.GetParams(suite.ctx)
+	suite.Require().NoError(err)
+
+	tp := TestProposal
+	proposal, err := suite.govKeeper.SubmitProposal(suite.ctx, tp, "", "test", "summary", suite.addrs[0], false)
+	suite.Require().NoError(err)
+
+	// a proposal in its deposit period cannot be expedited
+	err = suite.govKeeper.SetProposalExpedited(suite.ctx, proposal.Id, true)
+	suite.Require().ErrorIs(err, types.ErrInactiveProposal)
+
+	suite.Require().NoError(suite.govKeeper.ActivateVotingPeriod(suite.ctx, proposal))
+
+	// expediting the proposal moves it in the active proposal queue
+	suite.Require().NoError(suite.govKeeper.SetProposalExpedited(suite.ctx, proposal.Id, true))
+
+	proposal, err = suite.govKeeper.GetProposal(suite.ctx, proposal.Id)
+	suite.Require().NoError(err)
+	suite.Require().True(proposal.Expedited)
+	suite.Require().Equal(proposal.VotingStartTime.Add(*params.ExpeditedVotingPeriod), *proposal.VotingEndTime)
+
+	activeIterator, err := suite.govKeeper.ActiveProposalQueueIterator(suite.ctx, *proposal.VotingEndTime)
+	suite.Require().NoError(err)
+	suite.Require().True(activeIterator.Valid())
+	suite.Require().Equal(proposal.Id, types.GetProposalIDFromBytes(activeIterator.Value()))
+	activeIterator.Close()
+
+	// and back to a regular proposal
+	suite.Require().NoError(suite.govKeeper.SetProposalExpedited(suite.ctx, proposal.Id, false))
+
+	proposal, err = suite.govKeeper.GetProposal(suite.ctx, proposal.Id)
+	suite.Require().NoError(err)
+	suite.Require().False(proposal.Expedited)
+	suite.Require().Equal(proposal.VotingStartTime.Add(*params.VotingPeriod), *proposal.VotingEndTime)
+
+	activeIterator, err = suite.govKeeper.ActiveProposalQueueIterator(suite.ctx, proposal.VotingStartTime.Add(*params.ExpeditedVotingPeriod))
+	suite.Require().NoError(err)
+	suite.Require().False(activeIterator.Valid())
+	activeIterator.Close()
+
+	// a proposal whose voting period ended is waiting for its tally
+	ctx := suite.ctx.WithBlockTime(proposal.VotingEndTime.Add(time.Second))
+	err = suite.govKeeper.SetProposalExpedited(ctx, proposal.Id, true)
+	suite.Require().ErrorIs(err, types.ErrVotingPeriodEnded)
+
+	suite.Require().NoError(suite.govKeeper.DeleteProposal(suite.ctx, proposal.Id))
+}
+
 func (suite *KeeperTestSuite) TestDeleteProposalInVotingPeriod() {
 	testCases := []struct {
 		name      string
@@ -183,6 +234,50 @@ func (suite *KeeperTestSuite) TestSubmitProposal() {
 	}
 }
 
+func (suite *KeeperTestSuite) TestSubmitProposalWithAuthority() {
+	govAcct := suite.govKeeper.GetGovernanceAccount(suite.ctx).GetAddress().String()
+	subDAO := authtypes.NewModuleAddress("subdao")
+	suite.acctKeeper.EXPECT().StringToBytes(subDAO.String()).Return(subDAO, nil).AnyTimes()
+
+	coins := sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 10))
+	subDAOMsg := banktypes.NewMsgSend(subDAO, suite.addrs[1], coins)
+	govMsg := banktypes.NewMsgSend(authtypes.NewModuleAddress(types.ModuleName), suite.addrs[1], coins)
+	suite.Require().Equal(govAcct, govMsg.FromAddress)
+
+	// the execution authority must be allowed by the params
+	_, err := suite.govKeeper.SubmitProposalWithAuthority(suite.ctx, []sdk.Msg{subDAOMsg}, "", "title", "summary", suite.addrs[0], false, subDAO.String())
+	suite.Require().ErrorIs(err, types.ErrInvalidExecutionAuthority)
+
+	params, err := suite.govKeeper.GetParams(suite.ctx)
+	suite.Require().NoError(err)
+	params.ExecutionAuthorities = []string{subDAO.String()}
+	suite.Require().NoError(suite.govKeeper.SetParams(suite.ctx, params))
+
+	// the messages must be signed by the execution authority
+	_, err = suite.govKeeper.SubmitProposalWithAuthority(suite.ctx, []sdk.Msg{govMsg}, "", "title", "summary", suite.addrs[0], false, subDAO.String())
+	suite.Require().ErrorIs(err, types.ErrInvalidSigner)
+
+	_, err = suite.govKeeper.SubmitProposal(suite.ctx, []sdk.Msg{subDAOMsg}, "", "title", "summary", suite.addrs[0], false)
+	suite.Require().ErrorIs(err, types.ErrInvalidSigner)
+
+	proposal, err := suite.govKeeper.SubmitProposalWithAuthority(suite.ctx, []sdk.Msg{subDAOMsg}, "", "title", "summary", suite.addrs[0], false, subDAO.String())
+	suite.Require().NoError(err)
+	suite.Require().Equal(subDAO.String(), proposal.ExecutionAuthority)
+	suite.Require().NoError(suite.govKeeper.ValidateExecutionAuthority(suite.ctx, proposal))
+
+	// legacy content always acts on behalf of the gov module account
+	tp := v1beta1.TextProposal{Title: "title", Description: "description"}
+	legacyMsg, err := v1.NewLegacyContent(&tp, subDAO.String())
+	suite.Require().NoError(err)
+	_, err = suite.govKeeper.SubmitProposalWithAuthority(suite.ctx, []sdk.Msg{legacyMsg}, "", "title", "summary", suite.addrs[0], false, subDAO.String())
+	suite.Require().ErrorIs(err, types.ErrInvalidProposalMsg)
+
+	// the execution authority is no longer valid once removed from the params
+	params.ExecutionAuthorities = nil
+	suite.Require().NoError(suite.govKeeper.SetParams(suite.ctx, params))
+	suite.Require().ErrorIs(suite.govKeeper.ValidateExecutionAuthority(suite.ctx, proposal), types.ErrInvalidExecutionAuthority)
+}
+
 func (suite *KeeperTestSuite) TestGetProposalsFiltered() {
 	proposalID := uint64(1)
 	status := []v1.ProposalStatus{v1.StatusDepositPeriod, v1.StatusVotingPeriod}
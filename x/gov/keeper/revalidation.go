@@ -0,0 +1,158 @@
+package keeper
+
+import (
+	"context"
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/gov/types"
+	v1 "github.com/cosmos/cosmos-sdk/x/gov/types/v1"
+)
+
+// SetProposalMsgValidators registers the validators run against the messages
+// of a proposal when its voting period starts. It panics if two validators are
+// registered for the same message type URL.
+func (k *Keeper) SetProposalMsgValidators(routes ...types.ProposalMsgValidatorRoute) {
+	if k.proposalMsgValidators == nil {
+		k.proposalMsgValidators = make(map[string]types.ProposalMsgValidator, len(routes))
+	}
+
+	for _, r := range routes {
+		if _, ok := k.proposalMsgValidators[r.MsgTypeURL]; ok {
+			panic(fmt.Sprintf("proposal msg validator for %s has already been registered", r.MsgTypeURL))
+		}
+
+		k.proposalMsgValidators[r.MsgTypeURL] = r.Validator
+	}
+}
+
+// RevalidateProposal re-runs the validation of the messages of a proposal done
+// at submission, together with the params validator of the parameters update
+// messages and the proposal msg validator registered for each message, if any.
+// It returns the failure of the first message which is no longer valid, or nil
+// if the proposal is still valid.
+func (k Keeper) RevalidateProposal(ctx context.Context, proposal v1.Proposal) (*v1.ProposalExecutionFailure, error) {
+	params, err := k.GetParams(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	// the execution authority of the proposal may have been removed from the
+	// allowed execution authorities since submission
+	authority, err := k.executionAuthorityAddress(ctx, params, proposal.ExecutionAuthority)
+	if err != nil {
+		return &v1.ProposalExecutionFailure{Reason: err.Error()}, nil
+	}
+
+	messages, err := proposal.GetMsgs()
+	if err != nil {
+		return &v1.ProposalExecutionFailure{Reason: err.Error()}, nil
+	}
+
+	for i, msg := range messages {
+		if err := k.revalidateProposalMsg(ctx, msg, len(messages), authority, proposal.Expedited); err != nil {
+			return &v1.ProposalExecutionFailure{
+				MsgIndex:   uint32(i),
+				MsgTypeUrl: sdk.MsgTypeURL(msg),
+				Reason:     err.Error(),
+			}, nil
+		}
+	}
+
+	return nil, nil
+}
+
+func (k Keeper) revalidateProposalMsg(ctx context.Context, msg sdk.Msg, numMsgs int, authority sdk.AccAddress, expedited bool) error {
+	if err := k.validateProposalMsg(ctx, msg, numMsgs, authority, expedited); err != nil {
+		return err
+	}
+
+	typeURL := sdk.MsgTypeURL(msg)
+
+	// the messages of a MsgBatchUpdateParams have already been checked against
+	// their params validators
+	if _, ok := msg.(*v1.MsgBatchUpdateParams); !ok {
+		if validator, ok := k.paramsValidators[typeURL]; ok {
+			if err := validator(ctx, msg); err != nil {
+				return err
+			}
+		}
+	}
+
+	if validator, ok := k.proposalMsgValidators[typeURL]; ok {
+		return validator(ctx, msg)
+	}
+
+	return nil
+}
+
+// ActivateVotingPeriodIfValid activates the voting period of a proposal if its
+// messages are still valid, and fails it otherwise. It returns true if the
+// voting period was activated.
+func (k Keeper) ActivateVotingPeriodIfValid(ctx context.Context, proposal v1.Proposal) (bool, error) {
+	failure, err := k.RevalidateProposal(ctx, proposal)
+	if err != nil {
+		return false, err
+	}
+
+	if failure == nil {
+		return true, k.ActivateVotingPeriod(ctx, proposal)
+	}
+
+	return false, k.failInvalidProposal(ctx, proposal, *failure)
+}
+
+// failInvalidProposal fails a proposal whose messages are no longer valid
+// before its voting period starts. Its deposits are refunded, or burned if the
+// params burn the deposits of proposals which do not enter their voting period.
+func (k Keeper) failInvalidProposal(ctx context.Context, proposal v1.Proposal, failure v1.ProposalExecutionFailure) error {
+	params, err := k.GetParams(ctx)
+	if err != nil {
+		return err
+	}
+
+	if err := k.RemoveFromInactiveProposalQueue(ctx, proposal.Id, *proposal.DepositEndTime); err != nil {
+		return err
+	}
+
+	if proposal.DiscussionEndTime != nil {
+		if err := k.RemoveFromDiscussionProposalQueue(ctx, proposal.Id, *proposal.DiscussionEndTime); err != nil {
+			return err
+		}
+	}
+
+	if params.BurnProposalDepositPrevote {
+		err = k.DeleteAndBurnDeposits(ctx, proposal.Id)
+	} else {
+		err = k.RefundAndDeleteDeposits(ctx, proposal.Id)
+	}
+	if err != nil {
+		return err
+	}
+
+	proposal.Status = v1.StatusFailed
+	proposal.InvalidationFailure = &failure
+	if err := k.SetProposal(ctx, proposal); err != nil {
+		return err
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	sdkCtx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeProposalInvalidated,
+			sdk.NewAttribute(types.AttributeKeyProposalID, fmt.Sprintf("%d", proposal.Id)),
+			sdk.NewAttribute(types.AttributeKeyMsgIndex, fmt.Sprintf("%d", failure.MsgIndex)),
+			sdk.NewAttribute(types.AttributeKeyMsgTypeURL, failure.MsgTypeUrl),
+			sdk.NewAttribute(types.AttributeKeyReason, failure.Reason),
+		),
+	)
+
+	k.Logger(ctx).Info(
+		"proposal is no longer valid; failed before its voting period",
+		"proposal", proposal.Id,
+		"msg_index", failure.MsgIndex,
+		"reason", failure.Reason,
+	)
+
+	return nil
+}
@@ -0,0 +1,80 @@
+package keeper
+
+import (
+	"cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+)
+
+// StakingHooks wraps the gov keeper to keep the voted shares of the proposals
+// in voting period up to date as the delegations of the voters change. They
+// must be registered with the staking keeper for the tally to be correct.
+type StakingHooks struct {
+	k Keeper
+}
+
+var _ stakingtypes.StakingHooks = StakingHooks{}
+
+// StakingHooks returns the staking hooks of the gov keeper.
+func (keeper Keeper) StakingHooks() StakingHooks {
+	return StakingHooks{keeper}
+}
+
+// BeforeDelegationSharesModified removes the shares of the delegation, before
+// they change, from the voted shares of the proposals its delegator voted on.
+func (h StakingHooks) BeforeDelegationSharesModified(ctx sdk.Context, delAddr sdk.AccAddress, valAddr sdk.ValAddress) error {
+	return h.k.addDelegationShares(ctx, delAddr, valAddr, true)
+}
+
+// AfterDelegationModified adds the shares of the delegation, once changed, to
+// the voted shares of the proposals its delegator voted on.
+func (h StakingHooks) AfterDelegationModified(ctx sdk.Context, delAddr sdk.AccAddress, valAddr sdk.ValAddress) error {
+	return h.k.addDelegationShares(ctx, delAddr, valAddr, false)
+}
+
+func (h StakingHooks) AfterValidatorCreated(_ sdk.Context, _ sdk.ValAddress) error {
+	return nil
+}
+
+func (h StakingHooks) BeforeValidatorModified(_ sdk.Context, _ sdk.ValAddress) error {
+	return nil
+}
+
+func (h StakingHooks) AfterValidatorRemoved(_ sdk.Context, _ sdk.ConsAddress, _ sdk.ValAddress) error {
+	return nil
+}
+
+func (h StakingHooks) AfterValidatorBonded(_ sdk.Context, _ sdk.ConsAddress, _ sdk.ValAddress) error {
+	return nil
+}
+
+func (h StakingHooks) AfterValidatorBeginUnbonding(_ sdk.Context, _ sdk.ConsAddress, _ sdk.ValAddress) error {
+	return nil
+}
+
+func (h StakingHooks) BeforeDelegationCreated(_ sdk.Context, _ sdk.AccAddress, _ sdk.ValAddress) error {
+	return nil
+}
+
+// BeforeDelegationRemoved is a no-op, the shares of the delegation were already
+// removed by BeforeDelegationSharesModified.
+func (h StakingHooks) BeforeDelegationRemoved(_ sdk.Context, _ sdk.AccAddress, _ sdk.ValAddress) error {
+	return nil
+}
+
+func (h StakingHooks) BeforeValidatorSlashed(_ sdk.Context, _ sdk.ValAddress, _ math.LegacyDec) error {
+	return nil
+}
+
+func (h StakingHooks) AfterValidatorSlashed(_ sdk.Context, _ sdk.ValAddress, _ math.LegacyDec, _ []stakingtypes.SlashedDelegation) error {
+	return nil
+}
+
+func (h StakingHooks) AfterUnbondingInitiated(_ sdk.Context, _ uint64) error {
+	return nil
+}
+
+func (h StakingHooks) AfterUnbondingCanceled(_ sdk.Context, _ uint64) error {
+	return nil
+}
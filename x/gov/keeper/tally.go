@@ -2,27 +2,211 @@ package keeper
 
 import (
 	"context"
+	"fmt"
 
+	"cosmossdk.io/errors"
 	"cosmossdk.io/math"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/gov/types"
 	v1 "github.com/cosmos/cosmos-sdk/x/gov/types/v1"
 	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
 )
 
-// TODO: Break into several smaller functions for clarity
+// SetTallyHandlers registers the tally handlers deciding the outcome of the
+// proposals of a given kind, instead of DefaultTally. It panics if two handlers
+// are registered for the same proposal kind.
+func (keeper *Keeper) SetTallyHandlers(routes ...v1.TallyHandlerRoute) {
+	if keeper.tallyHandlers == nil {
+		keeper.tallyHandlers = make(map[v1.ProposalKind]v1.TallyHandler, len(routes))
+	}
+
+	for _, r := range routes {
+		if _, ok := keeper.tallyHandlers[r.Kind]; ok {
+			panic(fmt.Sprintf("tally handler for %s proposals has already been registered", r.Kind))
+		}
+
+		keeper.tallyHandlers[r.Kind] = r.Handler
+	}
+}
 
-// Tally iterates over the votes and updates the tally of a proposal based on the voting power of the
-// voters
+// Tally computes the tally of a proposal based on the voting power of the voters.
+// The outcome is decided by the tally handler registered for the kind of the
+// proposal, or by DefaultTally if there is none.
 func (keeper Keeper) Tally(ctx context.Context, proposal v1.Proposal) (passes, burnDeposits bool, tallyResults v1.TallyResult, err error) {
-	results := make(map[v1.VoteOption]math.LegacyDec)
+	results, totalVotingPower, currValidators, err := keeper.tallyVotes(ctx, proposal, true)
+	if err != nil {
+		return false, false, tallyResults, err
+	}
+
+	params, err := keeper.GetParams(ctx)
+	if err != nil {
+		return false, false, tallyResults, err
+	}
+	totalBonded := keeper.sk.TotalBondedTokens(sdk.UnwrapSDKContext(ctx))
+
+	if err := keeper.recordValidatorVoteBreakdowns(ctx, proposal.Id, currValidators, params, totalBonded); err != nil {
+		return false, false, tallyResults, err
+	}
+	tallyResults = v1.NewTallyResultFromMap(results)
+
+	votes := v1.TallyVotes{
+		Results:          results,
+		TotalVotingPower: totalVotingPower,
+		TotalBonded:      totalBonded,
+	}
+
+	handler, ok := keeper.tallyHandlers[proposal.Kind()]
+	if !ok {
+		handler = DefaultTally
+	}
+
+	passes, burnDeposits, err = handler(ctx, proposal, params, votes)
+	if err != nil {
+		return false, false, tallyResults, err
+	}
+
+	return passes, burnDeposits, tallyResults, nil
+}
+
+// DefaultTally is the TallyHandler used for the proposals of the kinds without
+// a registered tally handler. It checks the quorum, the veto threshold and the
+// threshold of Yes votes set in the params. The Veto votes only count once the
+// veto quorum is reached, and count as No votes otherwise.
+func DefaultTally(_ context.Context, proposal v1.Proposal, params v1.Params, votes v1.TallyVotes) (passes, burnDeposits bool, err error) {
+	results, totalVotingPower := votes.Results, votes.TotalVotingPower
+
+	// TODO: Upgrade the spec to cover all of these cases & remove pseudocode.
+	// If there is no staked coins, the proposal fails
+	if votes.TotalBonded.IsZero() {
+		return false, false, nil
+	}
+
+	// The voting power counted toward the quorum and the veto threshold, and
+	// the voting power counted toward the threshold of Yes votes, depending on
+	// the abstain semantics
+	participatingPower, decidingPower := tallyPowers(params.AbstainSemantics, results, totalVotingPower)
+
+	// If there is not enough quorum of votes, the proposal fails
+	percentVoting := participatingPower.Quo(math.LegacyNewDecFromInt(votes.TotalBonded))
+	quorum, vetoQuorum := params.TallyQuorums(proposal.Kind())
+	if percentVoting.LT(quorum) {
+		return false, params.BurnVoteQuorum, nil
+	}
+
+	// If no one votes (everyone abstains), proposal fails
+	if totalVotingPower.Sub(results[v1.OptionAbstain]).Equal(math.LegacyZeroDec()) {
+		return false, false, nil
+	}
+
+	// If more than 1/3 of voters veto, proposal fails, unless too few voted
+	// for the Veto votes to count
+	vetoThreshold, _ := math.LegacyNewDecFromStr(params.VetoThreshold)
+	if percentVoting.GTE(vetoQuorum) && results[v1.OptionNoWithVeto].Quo(participatingPower).GT(vetoThreshold) {
+		return false, params.BurnVoteVeto, nil
+	}
+
+	// If more than 1/2 of non-abstaining voters vote Yes, proposal passes
+	// For expedited 2/3
+	threshold, _ := math.LegacyNewDecFromStr(proposalThreshold(proposal, params))
+
+	if results[v1.OptionYes].Quo(decidingPower).GT(threshold) {
+		return true, false, nil
+	}
+
+	// If more than 1/2 of non-abstaining voters vote No, proposal fails
+	return false, false, nil
+}
+
+// IsTallyDecided returns true if the outcome of the tally of a proposal in its
+// voting period cannot be changed anymore, whatever the bonded voting power
+// votes until the end of the voting period. The votes of the proposal are left
+// untouched.
+//
+// The cast votes are not taken for granted: they can still be changed or
+// retracted, and a delegator can override the vote of its validator, so the
+// worst case covers the whole bonded voting power and not only the voting power
+// which has not voted yet. As a vote can always be retracted, a proposal is
+// never certain to reach the quorum, so it is only decided once it is certain
+// to fail, i.e. when it cannot pass even if all the bonded voting power votes
+// Yes.
+//
+// An expedited proposal is never decided early, as it is converted to a
+// regular proposal when it fails. A proposal whose kind has a registered tally
+// handler is never decided before the end of its voting period either, as its
+// outcome cannot be predicted.
+func (keeper Keeper) IsTallyDecided(ctx context.Context, proposal v1.Proposal) (bool, error) {
+	if _, ok := keeper.tallyHandlers[proposal.Kind()]; ok {
+		return false, nil
+	}
+
+	if proposal.Expedited {
+		return false, nil
+	}
+
+	params, err := keeper.GetParams(ctx)
+	if err != nil {
+		return false, err
+	}
+
+	// without bonded tokens the proposal fails, but tokens can still be bonded
+	// before the end of the voting period
+	if keeper.sk.TotalBondedTokens(sdk.UnwrapSDKContext(ctx)).IsZero() {
+		return false, nil
+	}
+
+	// if all the bonded voting power votes Yes, the quorum is reached, no veto
+	// is cast and the share of Yes votes is one whatever the abstain semantics,
+	// so the proposal only fails if the threshold cannot be exceeded
+	threshold, _ := math.LegacyNewDecFromStr(proposalThreshold(proposal, params))
+	return !math.LegacyOneDec().GT(threshold), nil
+}
+
+// tallyPowers returns, depending on the abstain semantics, the voting power
+// counted toward the quorum and the veto threshold, and the voting power
+// counted toward the threshold of Yes votes.
+func tallyPowers(semantics v1.AbstainSemantics, results map[v1.VoteOption]math.LegacyDec, totalVotingPower math.LegacyDec) (participatingPower, decidingPower math.LegacyDec) {
+	nonAbstaining := totalVotingPower.Sub(results[v1.OptionAbstain])
+
+	switch semantics {
+	case v1.AbstainSemanticsThreshold:
+		return totalVotingPower, totalVotingPower
+	case v1.AbstainSemanticsIgnored:
+		return nonAbstaining, nonAbstaining
+	default:
+		// AbstainSemanticsQuorumOnly, and AbstainSemanticsUnspecified for the
+		// params set before the abstain semantics were configurable
+		return totalVotingPower, nonAbstaining
+	}
+}
+
+// proposalThreshold returns the threshold of Yes votes the proposal must exceed
+// to pass.
+func proposalThreshold(proposal v1.Proposal, params v1.Params) string {
+	if proposal.IsConstitutionAmendment() {
+		return params.ConstitutionThreshold()
+	}
+
+	if proposal.Expedited {
+		return params.GetExpeditedThreshold()
+	}
+
+	return params.GetThreshold()
+}
+
+// tallyVotes computes, from the voted shares of the bonded validators and their
+// own votes, the voting power of each vote option, the total voting power which
+// voted, and the bonded validators with their votes. If deleteVotes is true, the
+// votes and the voted shares of the proposal are deleted once counted.
+func (keeper Keeper) tallyVotes(ctx context.Context, proposal v1.Proposal, deleteVotes bool) (results map[v1.VoteOption]math.LegacyDec, totalVotingPower math.LegacyDec, currValidators map[string]v1.ValidatorGovInfo, err error) {
+	results = make(map[v1.VoteOption]math.LegacyDec)
 	results[v1.OptionYes] = math.LegacyZeroDec()
 	results[v1.OptionAbstain] = math.LegacyZeroDec()
 	results[v1.OptionNo] = math.LegacyZeroDec()
 	results[v1.OptionNoWithVeto] = math.LegacyZeroDec()
 
-	totalVotingPower := math.LegacyZeroDec()
-	currValidators := make(map[string]v1.ValidatorGovInfo)
+	totalVotingPower = math.LegacyZeroDec()
+	currValidators = make(map[string]v1.ValidatorGovInfo)
 
 	// fetch all the bonded validators, insert them into currValidators
 	sdkCtx := sdk.UnwrapSDKContext(ctx)
@@ -38,48 +222,43 @@ func (keeper Keeper) Tally(ctx context.Context, proposal v1.Proposal) (passes, b
 		return false
 	})
 
-	err = keeper.IterateVotes(ctx, proposal.Id, func(vote v1.Vote) error {
-		// if validator, just record it in the map
-		voter, err := keeper.authKeeper.StringToBytes(vote.Voter)
+	for valAddrStr, val := range currValidators {
+		// deduct the shares of the delegators who voted themselves from the
+		// validator, and tally their voting power
+		votedShares, voted, err := keeper.getVotedShares(ctx, proposal.Id, val.Address)
 		if err != nil {
-			return err
+			return nil, totalVotingPower, nil, err
 		}
 
-		valAddrStr := sdk.ValAddress(voter).String()
-		if val, ok := currValidators[valAddrStr]; ok {
-			val.Vote = vote.Options
-			currValidators[valAddrStr] = val
+		// There is no need to handle the special case that validator address equal to voter address.
+		// Because voter's voting power will tally again even if there will be deduction of voter's voting power from validator.
+		val.DelegatorDeductions = voted
+		if !voted.IsZero() {
+			// voted shares * bonded / total shares
+			for option, shares := range votedShares {
+				results[option] = results[option].Add(shares.MulInt(val.BondedTokens).Quo(val.DelegatorShares))
+			}
+			totalVotingPower = totalVotingPower.Add(voted.MulInt(val.BondedTokens).Quo(val.DelegatorShares))
 		}
 
-		// iterate over all delegations from voter, deduct from any delegated-to validators
-		keeper.sk.IterateDelegations(sdkCtx, voter, func(index int64, delegation stakingtypes.DelegationI) (stop bool) {
-			valAddrStr := delegation.GetValidatorAddr().String()
-
-			if val, ok := currValidators[valAddrStr]; ok {
-				// There is no need to handle the special case that validator address equal to voter address.
-				// Because voter's voting power will tally again even if there will be deduction of voter's voting power from validator.
-				val.DelegatorDeductions = val.DelegatorDeductions.Add(delegation.GetShares())
-				currValidators[valAddrStr] = val
-
-				// delegation shares * bonded / total shares
-				votingPower := delegation.GetShares().MulInt(val.BondedTokens).Quo(val.DelegatorShares)
-
-				for _, option := range vote.Options {
-					weight, _ := math.LegacyNewDecFromStr(option.Weight)
-					subPower := votingPower.Mul(weight)
-					results[option.Option] = results[option.Option].Add(subPower)
-				}
-				totalVotingPower = totalVotingPower.Add(votingPower)
-			}
+		vote, err := keeper.GetVote(ctx, proposal.Id, sdk.AccAddress(val.Address))
+		if err == nil {
+			val.Vote = vote.Options
+		} else if !errors.IsOf(err, types.ErrVoteNotFound) {
+			return nil, totalVotingPower, nil, err
+		}
 
-			return false
-		})
+		currValidators[valAddrStr] = val
+	}
 
-		return keeper.deleteVote(ctx, vote.ProposalId, voter)
-	})
+	if deleteVotes {
+		if err := keeper.deleteVotes(ctx, proposal.Id); err != nil {
+			return nil, totalVotingPower, nil, err
+		}
 
-	if err != nil {
-		return false, false, tallyResults, err
+		if err := keeper.deleteVotedShares(ctx, proposal.Id); err != nil {
+			return nil, totalVotingPower, nil, err
+		}
 	}
 
 	// iterate over the validators again to tally their voting power
@@ -99,51 +278,5 @@ func (keeper Keeper) Tally(ctx context.Context, proposal v1.Proposal) (passes, b
 		totalVotingPower = totalVotingPower.Add(votingPower)
 	}
 
-	params, err := keeper.GetParams(ctx)
-	if err != nil {
-		return false, false, tallyResults, err
-	}
-	tallyResults = v1.NewTallyResultFromMap(results)
-
-	// TODO: Upgrade the spec to cover all of these cases & remove pseudocode.
-	// If there is no staked coins, the proposal fails
-	if keeper.sk.TotalBondedTokens(sdkCtx).IsZero() {
-		return false, false, tallyResults, nil
-	}
-
-	// If there is not enough quorum of votes, the proposal fails
-	percentVoting := totalVotingPower.Quo(math.LegacyNewDecFromInt(keeper.sk.TotalBondedTokens(sdkCtx)))
-	quorum, _ := math.LegacyNewDecFromStr(params.Quorum)
-	if percentVoting.LT(quorum) {
-		return false, params.BurnVoteQuorum, tallyResults, nil
-	}
-
-	// If no one votes (everyone abstains), proposal fails
-	if totalVotingPower.Sub(results[v1.OptionAbstain]).Equal(math.LegacyZeroDec()) {
-		return false, false, tallyResults, nil
-	}
-
-	// If more than 1/3 of voters veto, proposal fails
-	vetoThreshold, _ := math.LegacyNewDecFromStr(params.VetoThreshold)
-	if results[v1.OptionNoWithVeto].Quo(totalVotingPower).GT(vetoThreshold) {
-		return false, params.BurnVoteVeto, tallyResults, nil
-	}
-
-	// If more than 1/2 of non-abstaining voters vote Yes, proposal passes
-	// For expedited 2/3
-	var thresholdStr string
-	if proposal.Expedited {
-		thresholdStr = params.GetExpeditedThreshold()
-	} else {
-		thresholdStr = params.GetThreshold()
-	}
-
-	threshold, _ := math.LegacyNewDecFromStr(thresholdStr)
-
-	if results[v1.OptionYes].Quo(totalVotingPower.Sub(results[v1.OptionAbstain])).GT(threshold) {
-		return true, false, tallyResults, nil
-	}
-
-	// If more than 1/2 of non-abstaining voters vote No, proposal fails
-	return false, false, tallyResults, nil
+	return results, totalVotingPower, currValidators, nil
 }
@@ -0,0 +1,33 @@
+package keeper
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	v1 "github.com/cosmos/cosmos-sdk/x/gov/types/v1"
+)
+
+var _ ViewKeeper = Keeper{}
+
+// ViewKeeper defines a module interface that facilitates read only access to
+// the governance state: the params, the constitution and the proposals with
+// their deposits and votes.
+type ViewKeeper interface {
+	GetAuthority() string
+	GetParams(ctx context.Context) (params v1.Params, err error)
+	GetConstitution(ctx context.Context) (string, error)
+
+	GetProposal(ctx context.Context, proposalID uint64) (proposal v1.Proposal, err error)
+	GetProposals(ctx context.Context) (proposals v1.Proposals, err error)
+	GetProposalsFiltered(ctx context.Context, params v1.QueryProposalsParams) (v1.Proposals, error)
+	GetProposalID(ctx context.Context) (proposalID uint64, err error)
+	IterateProposals(ctx context.Context, cb func(proposal v1.Proposal) error) error
+
+	GetDeposit(ctx context.Context, proposalID uint64, depositorAddr sdk.AccAddress) (deposit v1.Deposit, err error)
+	GetDeposits(ctx context.Context, proposalID uint64) (deposits v1.Deposits, err error)
+	IterateDeposits(ctx context.Context, proposalID uint64, cb func(deposit v1.Deposit) error) error
+
+	GetVote(ctx context.Context, proposalID uint64, voterAddr sdk.AccAddress) (vote v1.Vote, err error)
+	GetVotes(ctx context.Context, proposalID uint64) (votes v1.Votes, err error)
+	IterateVotes(ctx context.Context, proposalID uint64, cb func(vote v1.Vote) error) error
+}
@@ -5,12 +5,14 @@ import (
 	"fmt"
 
 	"cosmossdk.io/errors"
+	"cosmossdk.io/math"
 	storetypes "cosmossdk.io/store/types"
 
 	"github.com/cosmos/cosmos-sdk/runtime"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/x/gov/types"
 	v1 "github.com/cosmos/cosmos-sdk/x/gov/types/v1"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
 )
 
 // AddVote adds a vote on a specific proposal
@@ -26,7 +28,17 @@ func (keeper Keeper) AddVote(ctx context.Context, proposalID uint64, voterAddr s
 		return errors.Wrapf(types.ErrInactiveProposal, "%d", proposalID)
 	}
 
-	err = keeper.assertMetadataLength(metadata)
+	if err := keeper.assertNotCommitReveal(ctx, proposalID); err != nil {
+		return err
+	}
+
+	return keeper.castVote(ctx, proposalID, voterAddr, options, metadata)
+}
+
+// castVote casts the vote of a voter on a proposal in its voting period,
+// replacing its previous vote, if any.
+func (keeper Keeper) castVote(ctx context.Context, proposalID uint64, voterAddr sdk.AccAddress, options v1.WeightedVoteOptions, metadata string) error {
+	err := keeper.assertMetadataLength(metadata)
 	if err != nil {
 		return err
 	}
@@ -37,6 +49,21 @@ func (keeper Keeper) AddVote(ctx context.Context, proposalID uint64, voterAddr s
 		}
 	}
 
+	// replace the voted shares of a previous vote of the voter
+	prevVote, err := keeper.GetVote(ctx, proposalID, voterAddr)
+	switch {
+	case err == nil:
+		if err := keeper.addVoterShares(ctx, proposalID, voterAddr, prevVote.Options, true); err != nil {
+			return err
+		}
+	case !errors.IsOf(err, types.ErrVoteNotFound):
+		return err
+	}
+
+	if err := keeper.addVoterShares(ctx, proposalID, voterAddr, options, false); err != nil {
+		return err
+	}
+
 	vote := v1.NewVote(proposalID, voterAddr, options, metadata)
 	err = keeper.SetVote(ctx, vote)
 	if err != nil {
@@ -55,9 +82,110 @@ func (keeper Keeper) AddVote(ctx context.Context, proposalID uint64, voterAddr s
 		),
 	)
 
+	return sdkCtx.EventManager().EmitTypedEvent(&v1.EventVote{
+		ProposalId:  proposalID,
+		Voter:       voterAddr.String(),
+		Options:     options,
+		BondedStake: keeper.bondedStake(sdkCtx, voterAddr).String(),
+		Metadata:    metadata,
+	})
+}
+
+// RetractVote removes the vote of a voter on a proposal in its voting period,
+// as if it had never been cast. The delegators who had not voted inherit again
+// the vote of their validator.
+func (keeper Keeper) RetractVote(ctx context.Context, proposalID uint64, voterAddr sdk.AccAddress) error {
+	store := keeper.storeService.OpenKVStore(ctx)
+	inVotingPeriod, err := store.Has(types.VotingPeriodProposalKey(proposalID))
+	if err != nil {
+		return err
+	}
+
+	if !inVotingPeriod {
+		return errors.Wrapf(types.ErrInactiveProposal, "%d", proposalID)
+	}
+
+	// revealed votes cannot be retracted, as their commitments are gone
+	if err := keeper.assertNotCommitReveal(ctx, proposalID); err != nil {
+		return err
+	}
+
+	vote, err := keeper.GetVote(ctx, proposalID, voterAddr)
+	if err != nil {
+		return err
+	}
+
+	if err := keeper.addVoterShares(ctx, proposalID, voterAddr, vote.Options, true); err != nil {
+		return err
+	}
+
+	if err := keeper.deleteVote(ctx, proposalID, voterAddr); err != nil {
+		return err
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	sdkCtx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeRetractVote,
+			sdk.NewAttribute(types.AttributeKeyOption, v1.WeightedVoteOptions(vote.Options).String()),
+			sdk.NewAttribute(types.AttributeKeyProposalID, fmt.Sprintf("%d", proposalID)),
+		),
+	)
+
+	return sdkCtx.EventManager().EmitTypedEvent(&v1.EventRetractVote{
+		ProposalId: proposalID,
+		Voter:      voterAddr.String(),
+		Options:    vote.Options,
+	})
+}
+
+// assertVotingPeriodNotEnded returns an error if the voting period of the
+// proposal has ended, even if the proposal has not been tallied yet because
+// of the limit of proposals tallied per block.
+func (keeper Keeper) assertVotingPeriodNotEnded(ctx context.Context, proposalID uint64) error {
+	proposal, err := keeper.GetProposal(ctx, proposalID)
+	if err != nil {
+		return err
+	}
+
+	if proposal.VotingEndTime != nil && proposal.VotingEndTime.Before(sdk.UnwrapSDKContext(ctx).BlockTime()) {
+		return types.ErrVotingPeriodEnded.Wrapf("voting period is already ended for this proposal %d", proposalID)
+	}
+
 	return nil
 }
 
+// assertNotCommitReveal returns an error if the proposal uses commit-reveal
+// voting, whose votes can only be cast by revealing a commitment.
+func (keeper Keeper) assertNotCommitReveal(ctx context.Context, proposalID uint64) error {
+	proposal, err := keeper.GetProposal(ctx, proposalID)
+	if err != nil {
+		return err
+	}
+
+	if proposal.CommitReveal {
+		return types.ErrCommitRevealVoting.Wrapf("votes on proposal %d must be committed, then revealed", proposalID)
+	}
+
+	return nil
+}
+
+// bondedStake returns the amount of tokens the voter has delegated to bonded
+// validators.
+func (keeper Keeper) bondedStake(ctx sdk.Context, voter sdk.AccAddress) math.Int {
+	stake := math.ZeroInt()
+	keeper.sk.IterateDelegations(ctx, voter, func(index int64, delegation stakingtypes.DelegationI) (stop bool) {
+		validator := keeper.sk.Validator(ctx, delegation.GetValidatorAddr())
+		if validator != nil && validator.IsBonded() {
+			stake = stake.Add(validator.TokensFromShares(delegation.GetShares()).TruncateInt())
+		}
+
+		return false
+	})
+
+	return stake
+}
+
 // GetAllVotes returns all the votes from the store
 func (keeper Keeper) GetAllVotes(ctx context.Context) (votes v1.Votes, err error) {
 	err = keeper.IterateAllVotes(ctx, func(vote v1.Vote) error {
@@ -109,7 +237,11 @@ func (keeper Keeper) SetVote(ctx context.Context, vote v1.Vote) error {
 		return err
 	}
 
-	return store.Set(types.VoteKey(vote.ProposalId, addr), bz)
+	if err := store.Set(types.VoteKey(vote.ProposalId, addr), bz); err != nil {
+		return err
+	}
+
+	return store.Set(types.VoterProposalKey(addr, vote.ProposalId), []byte{1})
 }
 
 // IterateAllVotes iterates over all the stored votes and performs a callback function
@@ -164,12 +296,35 @@ func (keeper Keeper) IterateVotes(ctx context.Context, proposalID uint64, cb fun
 
 // deleteVotes deletes the all votes from a given proposalID.
 func (keeper Keeper) deleteVotes(ctx context.Context, proposalID uint64) error {
-	store := keeper.storeService.OpenKVStore(ctx)
-	return store.Delete(types.VotesKey(proposalID))
+	var voters []sdk.AccAddress
+	err := keeper.IterateVotes(ctx, proposalID, func(vote v1.Vote) error {
+		voter, err := keeper.authKeeper.StringToBytes(vote.Voter)
+		if err != nil {
+			return err
+		}
+
+		voters = append(voters, voter)
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+
+	for _, voter := range voters {
+		if err := keeper.deleteVote(ctx, proposalID, voter); err != nil {
+			return err
+		}
+	}
+
+	return nil
 }
 
 // deleteVote deletes a vote from a given proposalID and voter from the store
 func (keeper Keeper) deleteVote(ctx context.Context, proposalID uint64, voterAddr sdk.AccAddress) error {
 	store := keeper.storeService.OpenKVStore(ctx)
-	return store.Delete(types.VoteKey(proposalID, voterAddr))
+	if err := store.Delete(types.VoteKey(proposalID, voterAddr)); err != nil {
+		return err
+	}
+
+	return store.Delete(types.VoterProposalKey(voterAddr, proposalID))
 }
@@ -0,0 +1,126 @@
+package keeper
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	"cosmossdk.io/math"
+	storetypes "cosmossdk.io/store/types"
+
+	"github.com/cosmos/cosmos-sdk/runtime"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/gov/types"
+	v1 "github.com/cosmos/cosmos-sdk/x/gov/types/v1"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+)
+
+// recordValidatorVoteBreakdowns stores in the transient store, for every bonded
+// validator taking part in the tally of the given proposal, how its voting
+// power is split between inherited and overridden delegations. An event is
+// emitted for every validator whose voting power counted towards the tally,
+// unless its voting power is below the participation exemption threshold, in
+// which case its breakdown is flagged as exempt from participation tracking.
+func (keeper Keeper) recordValidatorVoteBreakdowns(ctx context.Context, proposalID uint64, validators map[string]v1.ValidatorGovInfo, params v1.Params, totalBonded math.Int) error {
+	// sort the validators to keep the emitted events deterministic
+	valAddrs := make([]string, 0, len(validators))
+	for valAddr := range validators {
+		valAddrs = append(valAddrs, valAddr)
+	}
+	sort.Strings(valAddrs)
+
+	store := keeper.tStoreService.OpenTransientStore(ctx)
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	for _, valAddr := range valAddrs {
+		val := validators[valAddr]
+		if val.DelegatorShares.IsZero() {
+			continue
+		}
+
+		inheritedPower := val.DelegatorShares.Sub(val.DelegatorDeductions).MulInt(val.BondedTokens).Quo(val.DelegatorShares)
+		overriddenPower := val.DelegatorDeductions.MulInt(val.BondedTokens).Quo(val.DelegatorShares)
+
+		breakdown := v1.ValidatorVoteBreakdown{
+			ProposalId:          proposalID,
+			ValidatorAddress:    valAddr,
+			Options:             val.Vote,
+			InheritedPower:      inheritedPower.TruncateInt().String(),
+			OverriddenPower:     overriddenPower.TruncateInt().String(),
+			ParticipationExempt: params.IsParticipationExempt(val.BondedTokens, totalBonded),
+		}
+
+		bz, err := keeper.cdc.Marshal(&breakdown)
+		if err != nil {
+			return err
+		}
+
+		if err := store.Set(types.ValidatorVoteBreakdownKey(proposalID, val.Address), bz); err != nil {
+			return err
+		}
+
+		// validators that did not vote and had no delegator voting did not
+		// take part in the tally, and the participation of exempt validators
+		// is not tracked
+		if (len(val.Vote) == 0 && val.DelegatorDeductions.IsZero()) || breakdown.ParticipationExempt {
+			continue
+		}
+
+		sdkCtx.EventManager().EmitEvent(
+			sdk.NewEvent(
+				types.EventTypeValidatorVoteBreakdown,
+				sdk.NewAttribute(types.AttributeKeyProposalID, fmt.Sprintf("%d", proposalID)),
+				sdk.NewAttribute(types.AttributeKeyValidator, valAddr),
+				sdk.NewAttribute(types.AttributeKeyOption, val.Vote.String()),
+				sdk.NewAttribute(types.AttributeKeyInheritedPower, breakdown.InheritedPower),
+				sdk.NewAttribute(types.AttributeKeyOverriddenPower, breakdown.OverriddenPower),
+			),
+		)
+	}
+
+	return nil
+}
+
+// GetValidatorVoteBreakdowns returns the validator vote breakdowns recorded in
+// the transient store by the last tally of the given proposal in the current
+// block.
+func (keeper Keeper) GetValidatorVoteBreakdowns(ctx context.Context, proposalID uint64) ([]*v1.ValidatorVoteBreakdown, error) {
+	store := runtime.KVStoreAdapter(keeper.tStoreService.OpenTransientStore(ctx))
+	iterator := storetypes.KVStorePrefixIterator(store, types.ValidatorVoteBreakdownsKey(proposalID))
+	defer iterator.Close()
+
+	var breakdowns []*v1.ValidatorVoteBreakdown
+	for ; iterator.Valid(); iterator.Next() {
+		var breakdown v1.ValidatorVoteBreakdown
+		if err := keeper.cdc.Unmarshal(iterator.Value(), &breakdown); err != nil {
+			return nil, err
+		}
+
+		breakdowns = append(breakdowns, &breakdown)
+	}
+
+	return breakdowns, nil
+}
+
+// GetParticipationExemptValidators returns the operator addresses of the
+// bonded validators, ordered by voting power, whose voting power is below the
+// participation exemption threshold given the current bonded tokens.
+func (keeper Keeper) GetParticipationExemptValidators(ctx context.Context) ([]string, error) {
+	params, err := keeper.GetParams(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	totalBonded := keeper.sk.TotalBondedTokens(sdkCtx)
+
+	valAddrs := []string{}
+	keeper.sk.IterateBondedValidatorsByPower(sdkCtx, func(_ int64, validator stakingtypes.ValidatorI) (stop bool) {
+		if params.IsParticipationExempt(validator.GetBondedTokens(), totalBonded) {
+			valAddrs = append(valAddrs, validator.GetOperator().String())
+		}
+
+		return false
+	})
+
+	return valAddrs, nil
+}
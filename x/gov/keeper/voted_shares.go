@@ -0,0 +1,216 @@
+package keeper
+
+import (
+	"context"
+
+	"cosmossdk.io/math"
+	storetypes "cosmossdk.io/store/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/gov/types"
+	v1 "github.com/cosmos/cosmos-sdk/x/gov/types/v1"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+)
+
+// The voted shares of a validator on a proposal are the delegation shares of
+// the validator whose delegators cast their own vote on the proposal, summed
+// per vote option. They are updated as votes are cast and, through the staking
+// hooks, as the delegations of the voters change, so that the tally only has to
+// visit the bonded validators instead of the delegations of every voter.
+
+// getVotedShares returns the voted shares of a validator on a proposal, per vote
+// option, and their total.
+func (keeper Keeper) getVotedShares(ctx context.Context, proposalID uint64, valAddr sdk.ValAddress) (map[v1.VoteOption]math.LegacyDec, math.LegacyDec, error) {
+	shares := map[v1.VoteOption]math.LegacyDec{
+		v1.OptionYes:        math.LegacyZeroDec(),
+		v1.OptionAbstain:    math.LegacyZeroDec(),
+		v1.OptionNo:         math.LegacyZeroDec(),
+		v1.OptionNoWithVeto: math.LegacyZeroDec(),
+	}
+
+	store := keeper.storeService.OpenKVStore(ctx)
+	bz, err := store.Get(types.ValidatorVotedSharesKey(proposalID, valAddr))
+	if err != nil || bz == nil {
+		return shares, math.LegacyZeroDec(), err
+	}
+
+	var votedShares v1.ValidatorVotedShares
+	if err := keeper.cdc.Unmarshal(bz, &votedShares); err != nil {
+		return nil, math.LegacyZeroDec(), err
+	}
+
+	for option, str := range map[v1.VoteOption]string{
+		v1.OptionYes:        votedShares.Yes,
+		v1.OptionAbstain:    votedShares.Abstain,
+		v1.OptionNo:         votedShares.No,
+		v1.OptionNoWithVeto: votedShares.NoWithVeto,
+	} {
+		shares[option], err = math.LegacyNewDecFromStr(str)
+		if err != nil {
+			return nil, math.LegacyZeroDec(), err
+		}
+	}
+
+	voted, err := math.LegacyNewDecFromStr(votedShares.Voted)
+	if err != nil {
+		return nil, math.LegacyZeroDec(), err
+	}
+
+	return shares, voted, nil
+}
+
+// addVotedShares adds the given delegation shares, voted with the given
+// options, to the voted shares of a validator on a proposal. The shares are
+// negative to remove a vote.
+func (keeper Keeper) addVotedShares(ctx context.Context, proposalID uint64, valAddr sdk.ValAddress, delegationShares math.LegacyDec, options v1.WeightedVoteOptions) error {
+	shares, voted, err := keeper.getVotedShares(ctx, proposalID, valAddr)
+	if err != nil {
+		return err
+	}
+
+	for _, option := range options {
+		weight, _ := math.LegacyNewDecFromStr(option.Weight)
+		shares[option.Option] = shares[option.Option].Add(delegationShares.Mul(weight))
+	}
+	voted = voted.Add(delegationShares)
+
+	store := keeper.storeService.OpenKVStore(ctx)
+	if voted.IsZero() {
+		return store.Delete(types.ValidatorVotedSharesKey(proposalID, valAddr))
+	}
+
+	bz, err := keeper.cdc.Marshal(&v1.ValidatorVotedShares{
+		Yes:        shares[v1.OptionYes].String(),
+		Abstain:    shares[v1.OptionAbstain].String(),
+		No:         shares[v1.OptionNo].String(),
+		NoWithVeto: shares[v1.OptionNoWithVeto].String(),
+		Voted:      voted.String(),
+	})
+	if err != nil {
+		return err
+	}
+
+	return store.Set(types.ValidatorVotedSharesKey(proposalID, valAddr), bz)
+}
+
+// addVoterShares adds the shares of all the delegations of a voter, voted with
+// the given options, to the voted shares of their validators on a proposal. If
+// remove is true, the shares are removed instead.
+func (keeper Keeper) addVoterShares(ctx context.Context, proposalID uint64, voter sdk.AccAddress, options v1.WeightedVoteOptions, remove bool) (err error) {
+	keeper.sk.IterateDelegations(sdk.UnwrapSDKContext(ctx), voter, func(index int64, delegation stakingtypes.DelegationI) (stop bool) {
+		shares := delegation.GetShares()
+		if remove {
+			shares = shares.Neg()
+		}
+
+		err = keeper.addVotedShares(ctx, proposalID, delegation.GetValidatorAddr(), shares, options)
+		return err != nil
+	})
+
+	return err
+}
+
+// addDelegationShares adds the shares of a delegation to the voted shares of
+// its validator on every proposal in voting period the delegator voted on. If
+// remove is true, the shares are removed instead. The proposals are found from
+// the votes of the delegator, so that the delegations of the delegators who
+// never voted cost a single empty iteration.
+func (keeper Keeper) addDelegationShares(ctx context.Context, delAddr sdk.AccAddress, valAddr sdk.ValAddress, remove bool) error {
+	store := keeper.storeService.OpenKVStore(ctx)
+	iterator, err := store.Iterator(types.VoterProposalsKey(delAddr), storetypes.PrefixEndBytes(types.VoterProposalsKey(delAddr)))
+	if err != nil {
+		return err
+	}
+
+	var proposalIDs []uint64
+	for ; iterator.Valid(); iterator.Next() {
+		proposalIDs = append(proposalIDs, types.GetProposalIDFromBytes(iterator.Key()[len(types.VoterProposalsKey(delAddr)):]))
+	}
+	iterator.Close()
+
+	if len(proposalIDs) == 0 {
+		return nil
+	}
+
+	delegation := keeper.sk.Delegation(sdk.UnwrapSDKContext(ctx), delAddr, valAddr)
+	if delegation == nil {
+		return nil
+	}
+
+	shares := delegation.GetShares()
+	if remove {
+		shares = shares.Neg()
+	}
+
+	for _, proposalID := range proposalIDs {
+		inVotingPeriod, err := store.Has(types.VotingPeriodProposalKey(proposalID))
+		if err != nil {
+			return err
+		}
+
+		if !inVotingPeriod {
+			continue
+		}
+
+		vote, err := keeper.GetVote(ctx, proposalID, delAddr)
+		if err != nil {
+			return err
+		}
+
+		if err := keeper.addVotedShares(ctx, proposalID, valAddr, shares, vote.Options); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// deleteVotedShares deletes the voted shares of all the validators on a
+// proposal.
+func (keeper Keeper) deleteVotedShares(ctx context.Context, proposalID uint64) error {
+	store := keeper.storeService.OpenKVStore(ctx)
+	iterator, err := store.Iterator(types.VotedSharesKey(proposalID), storetypes.PrefixEndBytes(types.VotedSharesKey(proposalID)))
+	if err != nil {
+		return err
+	}
+
+	var keys [][]byte
+	for ; iterator.Valid(); iterator.Next() {
+		keys = append(keys, iterator.Key())
+	}
+	iterator.Close()
+
+	for _, key := range keys {
+		if err := store.Delete(key); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// RebuildVotedShares recomputes the voted shares of all the validators on a
+// proposal from its votes and the current delegations of the voters. It is
+// used when the voted shares cannot have been kept up to date, such as on
+// genesis import and store migration.
+func (keeper Keeper) RebuildVotedShares(ctx context.Context, proposalID uint64) error {
+	if err := keeper.deleteVotedShares(ctx, proposalID); err != nil {
+		return err
+	}
+
+	store := keeper.storeService.OpenKVStore(ctx)
+	return keeper.IterateVotes(ctx, proposalID, func(vote v1.Vote) error {
+		voter, err := keeper.authKeeper.StringToBytes(vote.Voter)
+		if err != nil {
+			return err
+		}
+
+		// the votes cast before the voter proposals were indexed are indexed
+		// on store migration
+		if err := store.Set(types.VoterProposalKey(voter, proposalID), []byte{1}); err != nil {
+			return err
+		}
+
+		return keeper.addVoterShares(ctx, proposalID, voter, vote.Options, false)
+	})
+}
@@ -75,6 +75,7 @@ func TestMigrateJSON(t *testing.T) {
 	// - Proposals use MsgExecLegacyContent
 	expected := `{
 	"constitution": "",
+	"constitution_amendments": [],
 	"deposit_params": {
 		"max_deposit_period": "172800s",
 		"min_deposit": [
@@ -89,6 +90,10 @@ func TestMigrateJSON(t *testing.T) {
 	"proposals": [
 		{
 			"deposit_end_time": "2001-09-09T01:46:40Z",
+			"deposit_period_extended": false,
+			"discussion_end_time": null,
+			"execution_authority": "",
+			"execution_failure": null,
 			"expedited": false,
 			"final_tally_result": {
 				"abstain_count": "0",
@@ -109,6 +114,7 @@ func TestMigrateJSON(t *testing.T) {
 				}
 			],
 			"metadata": "",
+			"params_update_failures": [],
 			"proposer": "",
 			"status": "PROPOSAL_STATUS_DEPOSIT_PERIOD",
 			"submit_time": "2001-09-09T01:46:40Z",
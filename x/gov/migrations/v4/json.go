@@ -30,6 +30,17 @@ func MigrateJSON(oldState *v1.GenesisState) (*v1.GenesisState, error) {
 		defaultParams.BurnVoteQuorum,
 		defaultParams.BurnVoteVeto,
 	)
+	// params added since v4 take their default values
+	params.DepositExtensionRatio = defaultParams.DepositExtensionRatio
+	params.DepositExtensionPeriod = defaultParams.DepositExtensionPeriod
+	params.DiscussionPeriod = defaultParams.DiscussionPeriod
+	params.MaxProposalsProcessedPerEndBlock = defaultParams.MaxProposalsProcessedPerEndBlock
+	params.ConstitutionAmendmentThreshold = defaultParams.ConstitutionAmendmentThreshold
+	params.AbstainSemantics = defaultParams.AbstainSemantics
+	params.AmendmentPeriod = defaultParams.AmendmentPeriod
+	params.ParticipationExemptionThreshold = defaultParams.ParticipationExemptionThreshold
+	params.RevealPeriod = defaultParams.RevealPeriod
+	params.VetoQuorum = defaultParams.VetoQuorum
 
 	return &v1.GenesisState{
 		StartingProposalId: oldState.StartingProposalId,
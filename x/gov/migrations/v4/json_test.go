@@ -57,12 +57,23 @@ func TestMigrateJSON(t *testing.T) {
 	// - Proposals use MsgExecLegacyContent
 	expected := `{
 	"constitution": "",
+	"constitution_amendments": [],
 	"deposit_params": null,
 	"deposits": [],
+	"execution_receipts": [],
 	"params": {
+		"abstain_semantics": "ABSTAIN_SEMANTICS_QUORUM_ONLY",
+		"accepted_deposit_denoms": [],
+		"amendment_period": "0s",
 		"burn_proposal_deposit_prevote": false,
 		"burn_vote_quorum": false,
 		"burn_vote_veto": true,
+		"constitution_amendment_threshold": "0.900000000000000000",
+		"deposit_extension_period": "0s",
+		"deposit_extension_ratio": "0.800000000000000000",
+		"discussion_period": "0s",
+		"early_resolution": false,
+		"execution_authorities": [],
 		"expedited_min_deposit": [
 			{
 				"amount": "50000000",
@@ -72,23 +83,30 @@ func TestMigrateJSON(t *testing.T) {
 		"expedited_threshold": "0.667000000000000000",
 		"expedited_voting_period": "86400s",
 		"max_deposit_period": "172800s",
+		"max_proposals_processed_per_end_block": "0",
 		"min_deposit": [
 			{
 				"amount": "10000000",
 				"denom": "stake"
 			}
 		],
+		"min_deposit_any_denom": false,
 		"min_initial_deposit_ratio": "0.000000000000000000",
+		"participation_exemption_threshold": "0.000000000000000000",
 		"proposal_cancel_dest": "",
 		"proposal_cancel_ratio": "0.500000000000000000",
+		"proposal_kind_quorums": [],
 		"quorum": "0.334000000000000000",
+		"reveal_period": "0s",
 		"threshold": "0.500000000000000000",
+		"veto_quorum": "0.000000000000000000",
 		"veto_threshold": "0.334000000000000000",
 		"voting_period": "172800s"
 	},
 	"proposals": [],
 	"starting_proposal_id": "1",
 	"tally_params": null,
+	"vote_commitments": [],
 	"votes": [],
 	"voting_params": null
 }`
@@ -0,0 +1,83 @@
+package v6
+
+import (
+	"time"
+
+	corestoretypes "cosmossdk.io/core/store"
+	storetypes "cosmossdk.io/store/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/gov/types"
+)
+
+// legacyProposalQueueKeyLength is the length of the keys of the proposal queues
+// before v7, i.e. <prefix (1 Byte)><endTime_Bytes><proposalID (8 Bytes)>.
+var legacyProposalQueueKeyLength = len(types.ActiveProposalQueueKey(0, time.Time{}))
+
+// MigrateStore performs in-place store migrations from v6 to v7. The migration
+// includes:
+//
+// The proposal queues keyed by end time and proposal ID are migrated to
+// time-bucketed queues, the proposals ending within the same bucket being
+// stored under a single key.
+func MigrateStore(ctx sdk.Context, storeService corestoretypes.KVStoreService) error {
+	store := storeService.OpenKVStore(ctx)
+	for _, prefix := range [][]byte{
+		types.ActiveProposalQueuePrefix,
+		types.InactiveProposalQueuePrefix,
+		types.DiscussionProposalQueuePrefix,
+	} {
+		if err := migrateProposalQueue(store, prefix); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// migrateProposalQueue replaces the keys of a proposal queue with the buckets
+// of their entries. The keys are iterated in order, so that the entries of
+// each bucket are appended in order.
+func migrateProposalQueue(store corestoretypes.KVStore, prefix []byte) error {
+	iterator, err := store.Iterator(prefix, storetypes.PrefixEndBytes(prefix))
+	if err != nil {
+		return err
+	}
+
+	var (
+		keys       [][]byte
+		bucketKeys [][]byte
+		buckets    = make(map[string][]byte)
+	)
+	for ; iterator.Valid(); iterator.Next() {
+		key := iterator.Key()
+		if len(key) != legacyProposalQueueKeyLength {
+			continue
+		}
+
+		proposalID, endTime := types.SplitActiveProposalQueueKey(key)
+		bucketKey := append(append([]byte{}, prefix...), sdk.FormatTimeBytes(types.ProposalQueueBucket(endTime))...)
+		if _, ok := buckets[string(bucketKey)]; !ok {
+			bucketKeys = append(bucketKeys, bucketKey)
+		}
+		buckets[string(bucketKey)] = append(buckets[string(bucketKey)], types.ProposalQueueEntry(proposalID, endTime)...)
+		keys = append(keys, append([]byte{}, key...))
+	}
+	if err := iterator.Close(); err != nil {
+		return err
+	}
+
+	for _, key := range keys {
+		if err := store.Delete(key); err != nil {
+			return err
+		}
+	}
+
+	for _, bucketKey := range bucketKeys {
+		if err := store.Set(bucketKey, buckets[string(bucketKey)]); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,75 @@
+package v6_test
+
+import (
+	"bytes"
+	"sort"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	storetypes "cosmossdk.io/store/types"
+
+	"github.com/cosmos/cosmos-sdk/runtime"
+	"github.com/cosmos/cosmos-sdk/testutil"
+	v6 "github.com/cosmos/cosmos-sdk/x/gov/migrations/v6"
+	"github.com/cosmos/cosmos-sdk/x/gov/types"
+)
+
+func TestMigrateStore(t *testing.T) {
+	govKey := storetypes.NewKVStoreKey("gov")
+	ctx := testutil.DefaultContext(govKey, storetypes.NewTransientStoreKey("transient_test"))
+	store := ctx.KVStore(govKey)
+
+	start := time.Date(2023, 1, 1, 0, 0, 0, 0, time.UTC)
+	endTimes := []time.Time{
+		start,
+		start.Add(10 * time.Second),
+		start.Add(10 * time.Second),
+		start.Add(time.Minute - time.Nanosecond),
+		start.Add(time.Minute),
+		start.Add(time.Hour),
+	}
+
+	// the proposal queues keyed by end time and proposal ID
+	var legacyKeys [][]byte
+	for i, endTime := range endTimes {
+		proposalID := uint64(len(endTimes) - i)
+		for _, key := range [][]byte{
+			types.ActiveProposalQueueKey(proposalID, endTime),
+			types.InactiveProposalQueueKey(proposalID, endTime),
+			types.DiscussionProposalQueueKey(proposalID, endTime),
+		} {
+			store.Set(key, types.GetProposalIDBytes(proposalID))
+		}
+		legacyKeys = append(legacyKeys, types.InactiveProposalQueueKey(proposalID, endTime))
+	}
+	sort.Slice(legacyKeys, func(i, j int) bool { return bytes.Compare(legacyKeys[i], legacyKeys[j]) < 0 })
+
+	require.NoError(t, v6.MigrateStore(ctx, runtime.NewKVStoreService(govKey)))
+
+	for _, prefix := range [][]byte{
+		types.ActiveProposalQueuePrefix,
+		types.InactiveProposalQueuePrefix,
+		types.DiscussionProposalQueuePrefix,
+	} {
+		var bucketKeys, keys [][]byte
+		iterator := storetypes.KVStorePrefixIterator(store, prefix)
+		for ; iterator.Valid(); iterator.Next() {
+			bucketKeys = append(bucketKeys, iterator.Key()[len(prefix):])
+			for _, entry := range types.SplitProposalQueueBucket(iterator.Value()) {
+				keys = append(keys, append(append([]byte{}, types.InactiveProposalQueuePrefix...), entry...))
+			}
+		}
+		require.NoError(t, iterator.Close())
+
+		// the proposals ending within the same minute share a bucket, whose
+		// entries are in the order of the legacy keys
+		require.Equal(t, [][]byte{
+			types.InactiveProposalQueueBucketKey(start)[1:],
+			types.InactiveProposalQueueBucketKey(start.Add(time.Minute))[1:],
+			types.InactiveProposalQueueBucketKey(start.Add(time.Hour))[1:],
+		}, bucketKeys)
+		require.Equal(t, legacyKeys, keys)
+	}
+}
@@ -7,6 +7,7 @@ import (
 	"sort"
 
 	abci "github.com/cometbft/cometbft/abci/types"
+	"github.com/cosmos/gogoproto/proto"
 	gwruntime "github.com/grpc-ecosystem/grpc-gateway/runtime"
 	"github.com/spf13/cobra"
 	"golang.org/x/exp/maps"
@@ -16,6 +17,7 @@ import (
 	"cosmossdk.io/core/address"
 	"cosmossdk.io/core/appmodule"
 	"cosmossdk.io/depinject"
+	"cosmossdk.io/errors"
 
 	store "cosmossdk.io/core/store"
 
@@ -24,6 +26,7 @@ import (
 	"github.com/cosmos/cosmos-sdk/codec"
 	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	"github.com/cosmos/cosmos-sdk/types/module"
 	simtypes "github.com/cosmos/cosmos-sdk/types/simulation"
 	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
@@ -35,9 +38,10 @@ import (
 	v1 "github.com/cosmos/cosmos-sdk/x/gov/types/v1"
 	"github.com/cosmos/cosmos-sdk/x/gov/types/v1beta1"
 	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
 )
 
-const ConsensusVersion = 5
+const ConsensusVersion = 8
 
 var (
 	_ module.AppModuleBasic      = AppModuleBasic{}
@@ -162,7 +166,7 @@ func init() {
 	appmodule.Register(
 		&modulev1.Module{},
 		appmodule.Provide(ProvideModule, ProvideKeyTable),
-		appmodule.Invoke(InvokeAddRoutes, InvokeSetHooks))
+		appmodule.Invoke(InvokeAddRoutes, InvokeSetHooks, InvokeSetParamsValidators, InvokeSetProposalMsgValidators, InvokeSetTallyHandlers))
 }
 
 type ModuleInputs struct {
@@ -171,6 +175,7 @@ type ModuleInputs struct {
 	Config           *modulev1.Module
 	Cdc              codec.Codec
 	StoreService     store.KVStoreService
+	TStoreService    store.TransientStoreService
 	ModuleKey        depinject.OwnModuleKey
 	MsgServiceRouter baseapp.MessageRouter
 
@@ -186,9 +191,12 @@ type ModuleInputs struct {
 type ModuleOutputs struct {
 	depinject.Out
 
-	Module       appmodule.AppModule
-	Keeper       *keeper.Keeper
-	HandlerRoute v1beta1.HandlerRoute
+	Module               appmodule.AppModule
+	Keeper               *keeper.Keeper
+	ViewKeeper           keeper.ViewKeeper
+	HandlerRoute         v1beta1.HandlerRoute
+	ParamsValidatorRoute govtypes.ParamsValidatorRoute
+	StakingHooks         stakingtypes.StakingHooksWrapper
 }
 
 func ProvideModule(in ModuleInputs) ModuleOutputs {
@@ -196,6 +204,7 @@ func ProvideModule(in ModuleInputs) ModuleOutputs {
 	if in.Config.MaxMetadataLen != 0 {
 		defaultConfig.MaxMetadataLen = in.Config.MaxMetadataLen
 	}
+	defaultConfig.ExportHistory = in.Config.ExportHistory
 
 	// default to governance authority if not provided
 	authority := authtypes.NewModuleAddress(govtypes.ModuleName)
@@ -206,6 +215,7 @@ func ProvideModule(in ModuleInputs) ModuleOutputs {
 	k := keeper.NewKeeper(
 		in.Cdc,
 		in.StoreService,
+		in.TStoreService,
 		in.AccountKeeper,
 		in.BankKeeper,
 		in.StakingKeeper,
@@ -217,7 +227,30 @@ func ProvideModule(in ModuleInputs) ModuleOutputs {
 	m := NewAppModule(in.Cdc, k, in.AccountKeeper, in.BankKeeper, in.LegacySubspace)
 	hr := v1beta1.HandlerRoute{Handler: v1beta1.ProposalHandler, RouteKey: govtypes.RouterKey}
 
-	return ModuleOutputs{Module: m, Keeper: k, HandlerRoute: hr}
+	return ModuleOutputs{
+		Module:               m,
+		Keeper:               k,
+		ViewKeeper:           k,
+		HandlerRoute:         hr,
+		ParamsValidatorRoute: NewParamsValidatorRoute(),
+		StakingHooks:         stakingtypes.StakingHooksWrapper{StakingHooks: k.StakingHooks()},
+	}
+}
+
+// NewParamsValidatorRoute returns the params validator route of the x/gov
+// MsgUpdateParams message, used by MsgBatchUpdateParams.
+func NewParamsValidatorRoute() govtypes.ParamsValidatorRoute {
+	return govtypes.ParamsValidatorRoute{
+		MsgTypeURL: sdk.MsgTypeURL(&v1.MsgUpdateParams{}),
+		Validator: func(_ context.Context, msg sdk.Msg) error {
+			m, ok := msg.(*v1.MsgUpdateParams)
+			if !ok {
+				return errors.Wrapf(sdkerrors.ErrInvalidType, "expected %T, got %T", &v1.MsgUpdateParams{}, msg)
+			}
+
+			return m.Params.ValidateBasic()
+		},
+	}
 }
 
 func ProvideKeyTable() paramtypes.KeyTable {
@@ -242,6 +275,45 @@ func InvokeAddRoutes(keeper *keeper.Keeper, routes []v1beta1.HandlerRoute) {
 	keeper.SetLegacyRouter(router)
 }
 
+func InvokeSetParamsValidators(keeper *keeper.Keeper, routes []govtypes.ParamsValidatorRoute) {
+	if keeper == nil || routes == nil {
+		return
+	}
+
+	// Default route order is a lexical sort by message type URL.
+	slices.SortFunc(routes, func(x, y govtypes.ParamsValidatorRoute) bool {
+		return x.MsgTypeURL < y.MsgTypeURL
+	})
+
+	keeper.SetParamsValidators(routes...)
+}
+
+func InvokeSetProposalMsgValidators(keeper *keeper.Keeper, routes []govtypes.ProposalMsgValidatorRoute) {
+	if keeper == nil || routes == nil {
+		return
+	}
+
+	// Default route order is a lexical sort by message type URL.
+	slices.SortFunc(routes, func(x, y govtypes.ProposalMsgValidatorRoute) bool {
+		return x.MsgTypeURL < y.MsgTypeURL
+	})
+
+	keeper.SetProposalMsgValidators(routes...)
+}
+
+func InvokeSetTallyHandlers(keeper *keeper.Keeper, routes []v1.TallyHandlerRoute) {
+	if keeper == nil || routes == nil {
+		return
+	}
+
+	// Default route order is a lexical sort by proposal kind.
+	slices.SortFunc(routes, func(x, y v1.TallyHandlerRoute) bool {
+		return x.Kind < y.Kind
+	})
+
+	keeper.SetTallyHandlers(routes...)
+}
+
 func InvokeSetHooks(keeper *keeper.Keeper, govHooks map[string]govtypes.GovHooksWrapper) error {
 	if keeper == nil || govHooks == nil {
 		return nil
@@ -302,6 +374,18 @@ func (am AppModule) RegisterServices(cfg module.Configurator) {
 	if err := cfg.RegisterMigration(govtypes.ModuleName, 4, m.Migrate4to5); err != nil {
 		panic(fmt.Sprintf("failed to migrate x/gov from version 4 to 5: %v", err))
 	}
+
+	if err := cfg.RegisterMigration(govtypes.ModuleName, 5, m.Migrate5to6); err != nil {
+		panic(fmt.Sprintf("failed to migrate x/gov from version 5 to 6: %v", err))
+	}
+
+	if err := cfg.RegisterMigration(govtypes.ModuleName, 6, m.Migrate6to7); err != nil {
+		panic(fmt.Sprintf("failed to migrate x/gov from version 6 to 7: %v", err))
+	}
+
+	if err := cfg.RegisterMigration(govtypes.ModuleName, 7, m.Migrate7to8); err != nil {
+		panic(fmt.Sprintf("failed to migrate x/gov from version 7 to 8: %v", err))
+	}
 }
 
 // InitGenesis performs genesis initialization for the gov module. It returns
@@ -326,6 +410,18 @@ func (am AppModule) ExportGenesis(ctx sdk.Context, cdc codec.JSONCodec) json.Raw
 // ConsensusVersion implements AppModule/ConsensusVersion.
 func (AppModule) ConsensusVersion() uint64 { return ConsensusVersion }
 
+// UpdatableParams implements the module.HasUpdatableParams interface.
+func (am AppModule) UpdatableParams() module.UpdatableParams {
+	return module.UpdatableParams{
+		UpdateMsg: &v1.MsgUpdateParams{},
+		Authority: am.keeper.GetAuthority(),
+		GetParams: func(ctx context.Context) (proto.Message, error) {
+			params, err := am.keeper.GetParams(ctx)
+			return &params, err
+		},
+	}
+}
+
 // EndBlock returns the end blocker for the gov module. It returns no validator
 // updates.
 func (am AppModule) EndBlock(ctx context.Context) error {
@@ -0,0 +1,53 @@
+package gov
+
+import (
+	"github.com/cosmos/gogoproto/proto"
+
+	"github.com/cosmos/cosmos-sdk/types/retention"
+	v1 "github.com/cosmos/cosmos-sdk/x/gov/types/v1"
+)
+
+var _ retention.HasPurgeableData = AppModuleBasic{}
+
+// PurgeableData implements the retention.HasPurgeableData interface. The
+// metadata, titles and summaries of the proposals, and the metadata of the
+// votes and deposits, usually holding the rationales of the voters, are
+// purgeable.
+func (AppModuleBasic) PurgeableData() []retention.Category {
+	return []retention.Category{
+		{
+			Name:        "gov/proposal-metadata",
+			Description: "the metadata, titles and summaries of the proposals",
+			Purge: func(msg proto.Message, redact func(string) string) bool {
+				switch msg := msg.(type) {
+				case *v1.MsgSubmitProposal:
+					return retention.RedactFields(redact, &msg.Metadata, &msg.Title, &msg.Summary)
+				case *v1.MsgAmendProposal:
+					return retention.RedactFields(redact, &msg.Metadata, &msg.Title, &msg.Summary)
+				case *v1.MsgUpdateProposalMetadata:
+					return retention.RedactFields(redact, &msg.Metadata)
+				default:
+					return false
+				}
+			},
+		},
+		{
+			Name:        "gov/vote-metadata",
+			Description: "the metadata of the votes and deposits, e.g. the rationales of the voters",
+			Purge: func(msg proto.Message, redact func(string) string) bool {
+				switch msg := msg.(type) {
+				case *v1.MsgVote:
+					return retention.RedactFields(redact, &msg.Metadata)
+				case *v1.MsgVoteWeighted:
+					return retention.RedactFields(redact, &msg.Metadata)
+				case *v1.MsgRevealVote:
+					return retention.RedactFields(redact, &msg.Metadata)
+				case *v1.MsgDeposit:
+					return retention.RedactFields(redact, &msg.Metadata)
+				default:
+					return false
+				}
+			},
+		},
+	}
+}
@@ -41,7 +41,10 @@ func NewDecodeStore(cdc codec.Codec) func(kvA, kvB kv.Pair) string {
 			return fmt.Sprintf("%v\n%v", proposalA, proposalB)
 		case bytes.Equal(kvA.Key[:1], types.ActiveProposalQueuePrefix),
 			bytes.Equal(kvA.Key[:1], types.InactiveProposalQueuePrefix),
-			bytes.Equal(kvA.Key[:1], types.ProposalIDKey):
+			bytes.Equal(kvA.Key[:1], types.DiscussionProposalQueuePrefix):
+			return fmt.Sprintf("proposalIDsA: %v\nProposalIDsB: %v", proposalQueueIDs(kvA.Value), proposalQueueIDs(kvB.Value))
+
+		case bytes.Equal(kvA.Key[:1], types.ProposalIDKey):
 			proposalIDA := binary.LittleEndian.Uint64(kvA.Value)
 			proposalIDB := binary.LittleEndian.Uint64(kvB.Value)
 			return fmt.Sprintf("proposalIDA: %d\nProposalIDB: %d", proposalIDA, proposalIDB)
@@ -58,11 +61,36 @@ func NewDecodeStore(cdc codec.Codec) func(kvA, kvB kv.Pair) string {
 			cdc.MustUnmarshal(kvB.Value, &voteB)
 			return fmt.Sprintf("%v\n%v", voteA, voteB)
 
-		case bytes.Equal(kvA.Key[:1], types.VotingPeriodProposalKeyPrefix):
+		case bytes.Equal(kvA.Key[:1], types.VotingPeriodProposalKeyPrefix),
+			bytes.Equal(kvA.Key[:1], types.VoterProposalsKeyPrefix):
 			return fmt.Sprintf("%v\n%v", kvA.Value, kvB.Value)
 
+		case bytes.Equal(kvA.Key[:1], types.VotedSharesKeyPrefix):
+			var votedSharesA, votedSharesB v1.ValidatorVotedShares
+			cdc.MustUnmarshal(kvA.Value, &votedSharesA)
+			cdc.MustUnmarshal(kvB.Value, &votedSharesB)
+			return fmt.Sprintf("%v\n%v", votedSharesA, votedSharesB)
+
+		case bytes.Equal(kvA.Key[:1], types.ExecutionReceiptsKeyPrefix):
+			var receiptA, receiptB v1.ProposalExecutionReceipt
+			cdc.MustUnmarshal(kvA.Value, &receiptA)
+			cdc.MustUnmarshal(kvB.Value, &receiptB)
+			return fmt.Sprintf("%v\n%v", receiptA, receiptB)
+
 		default:
 			panic(fmt.Sprintf("invalid governance key prefix %X", kvA.Key[:1]))
 		}
 	}
 }
+
+// proposalQueueIDs returns the proposal IDs of the entries of a proposal queue
+// bucket.
+func proposalQueueIDs(bz []byte) []uint64 {
+	var proposalIDs []uint64
+	for _, entry := range types.SplitProposalQueueBucket(bz) {
+		proposalID, _ := types.SplitProposalQueueEntry(entry)
+		proposalIDs = append(proposalIDs, proposalID)
+	}
+
+	return proposalIDs
+}
@@ -66,10 +66,16 @@ func TestDecodeStore(t *testing.T) {
 		},
 		{
 			"proposal IDs",
-			kv.Pair{Key: types.InactiveProposalQueueKey(1, endTime), Value: proposalIDBz},
-			kv.Pair{Key: types.InactiveProposalQueueKey(1, endTime), Value: proposalIDBz},
+			kv.Pair{Key: types.ProposalIDKey, Value: proposalIDBz},
+			kv.Pair{Key: types.ProposalIDKey, Value: proposalIDBz},
 			"proposalIDA: 1\nProposalIDB: 1", false,
 		},
+		{
+			"proposal queues",
+			kv.Pair{Key: types.InactiveProposalQueueBucketKey(endTime), Value: types.ProposalQueueEntry(1, endTime)},
+			kv.Pair{Key: types.InactiveProposalQueueBucketKey(endTime), Value: append(types.ProposalQueueEntry(1, endTime), types.ProposalQueueEntry(2, endTime)...)},
+			"proposalIDsA: [1]\nProposalIDsB: [1 2]", false,
+		},
 		{
 			"deposits",
 			kv.Pair{Key: types.DepositKey(1, delAddr1), Value: cdc.MustMarshal(&deposit)},
@@ -31,7 +31,6 @@ type BankKeeper interface {
 type StakingKeeper interface {
 	types.StakingKeeper
 
-	BondDenom(ctx sdk.Context) string
 	TokensFromConsensusPower(ctx sdk.Context, power int64) math.Int
 }
 
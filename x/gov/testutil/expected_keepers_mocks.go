@@ -7,6 +7,7 @@ package testutil
 import (
 	context "context"
 	reflect "reflect"
+	time "time"
 
 	math "cosmossdk.io/math"
 	types "github.com/cosmos/cosmos-sdk/types"
@@ -159,6 +160,34 @@ func (m *MockBankKeeper) EXPECT() *MockBankKeeperMockRecorder {
 	return m.recorder
 }
 
+// AddModuleEscrow mocks base method.
+func (m *MockBankKeeper) AddModuleEscrow(ctx context.Context, moduleName, purpose string, amt types.Coins) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AddModuleEscrow", ctx, moduleName, purpose, amt)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// AddModuleEscrow indicates an expected call of AddModuleEscrow.
+func (mr *MockBankKeeperMockRecorder) AddModuleEscrow(ctx, moduleName, purpose, amt interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddModuleEscrow", reflect.TypeOf((*MockBankKeeper)(nil).AddModuleEscrow), ctx, moduleName, purpose, amt)
+}
+
+// AdvanceDemurrageEpochs mocks base method.
+func (m *MockBankKeeper) AdvanceDemurrageEpochs(ctx context.Context) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AdvanceDemurrageEpochs", ctx)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// AdvanceDemurrageEpochs indicates an expected call of AdvanceDemurrageEpochs.
+func (mr *MockBankKeeperMockRecorder) AdvanceDemurrageEpochs(ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AdvanceDemurrageEpochs", reflect.TypeOf((*MockBankKeeper)(nil).AdvanceDemurrageEpochs), ctx)
+}
+
 // AllBalances mocks base method.
 func (m *MockBankKeeper) AllBalances(arg0 context.Context, arg1 *types0.QueryAllBalancesRequest) (*types0.QueryAllBalancesResponse, error) {
 	m.ctrl.T.Helper()
@@ -174,6 +203,18 @@ func (mr *MockBankKeeperMockRecorder) AllBalances(arg0, arg1 interface{}) *gomoc
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AllBalances", reflect.TypeOf((*MockBankKeeper)(nil).AllBalances), arg0, arg1)
 }
 
+// AppendSendRestriction mocks base method.
+func (m *MockBankKeeper) AppendSendRestriction(restriction types0.SendRestrictionFn) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "AppendSendRestriction", restriction)
+}
+
+// AppendSendRestriction indicates an expected call of AppendSendRestriction.
+func (mr *MockBankKeeperMockRecorder) AppendSendRestriction(restriction interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AppendSendRestriction", reflect.TypeOf((*MockBankKeeper)(nil).AppendSendRestriction), restriction)
+}
+
 // Balance mocks base method.
 func (m *MockBankKeeper) Balance(arg0 context.Context, arg1 *types0.QueryBalanceRequest) (*types0.QueryBalanceResponse, error) {
 	m.ctrl.T.Helper()
@@ -189,6 +230,65 @@ func (mr *MockBankKeeperMockRecorder) Balance(arg0, arg1 interface{}) *gomock.Ca
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Balance", reflect.TypeOf((*MockBankKeeper)(nil).Balance), arg0, arg1)
 }
 
+// BalanceSnapshot mocks base method.
+func (m *MockBankKeeper) BalanceSnapshot(arg0 context.Context, arg1 *types0.QueryBalanceSnapshotRequest) (*types0.QueryBalanceSnapshotResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "BalanceSnapshot", arg0, arg1)
+	ret0, _ := ret[0].(*types0.QueryBalanceSnapshotResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// BalanceSnapshot indicates an expected call of BalanceSnapshot.
+func (mr *MockBankKeeperMockRecorder) BalanceSnapshot(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "BalanceSnapshot", reflect.TypeOf((*MockBankKeeper)(nil).BalanceSnapshot), arg0, arg1)
+}
+
+// BalanceSnapshotProof mocks base method.
+func (m *MockBankKeeper) BalanceSnapshotProof(arg0 context.Context, arg1 *types0.QueryBalanceSnapshotProofRequest) (*types0.QueryBalanceSnapshotProofResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "BalanceSnapshotProof", arg0, arg1)
+	ret0, _ := ret[0].(*types0.QueryBalanceSnapshotProofResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// BalanceSnapshotProof indicates an expected call of BalanceSnapshotProof.
+func (mr *MockBankKeeperMockRecorder) BalanceSnapshotProof(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "BalanceSnapshotProof", reflect.TypeOf((*MockBankKeeper)(nil).BalanceSnapshotProof), arg0, arg1)
+}
+
+// BalanceSnapshots mocks base method.
+func (m *MockBankKeeper) BalanceSnapshots(arg0 context.Context, arg1 *types0.QueryBalanceSnapshotsRequest) (*types0.QueryBalanceSnapshotsResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "BalanceSnapshots", arg0, arg1)
+	ret0, _ := ret[0].(*types0.QueryBalanceSnapshotsResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// BalanceSnapshots indicates an expected call of BalanceSnapshots.
+func (mr *MockBankKeeperMockRecorder) BalanceSnapshots(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "BalanceSnapshots", reflect.TypeOf((*MockBankKeeper)(nil).BalanceSnapshots), arg0, arg1)
+}
+
+// BatchSendCoins mocks base method.
+func (m *MockBankKeeper) BatchSendCoins(ctx context.Context, fromAddr types.AccAddress, outputs []types0.BatchOutput) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "BatchSendCoins", ctx, fromAddr, outputs)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// BatchSendCoins indicates an expected call of BatchSendCoins.
+func (mr *MockBankKeeperMockRecorder) BatchSendCoins(ctx, fromAddr, outputs interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "BatchSendCoins", reflect.TypeOf((*MockBankKeeper)(nil).BatchSendCoins), ctx, fromAddr, outputs)
+}
+
 // BlockedAddr mocks base method.
 func (m *MockBankKeeper) BlockedAddr(addr types.AccAddress) bool {
 	m.ctrl.T.Helper()
@@ -217,6 +317,47 @@ func (mr *MockBankKeeperMockRecorder) BurnCoins(ctx, moduleName, amt interface{}
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "BurnCoins", reflect.TypeOf((*MockBankKeeper)(nil).BurnCoins), ctx, moduleName, amt)
 }
 
+// ClaimTimelockedTransfer mocks base method.
+func (m *MockBankKeeper) ClaimTimelockedTransfer(ctx context.Context, recipient types.AccAddress, id uint64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ClaimTimelockedTransfer", ctx, recipient, id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ClaimTimelockedTransfer indicates an expected call of ClaimTimelockedTransfer.
+func (mr *MockBankKeeperMockRecorder) ClaimTimelockedTransfer(ctx, recipient, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ClaimTimelockedTransfer", reflect.TypeOf((*MockBankKeeper)(nil).ClaimTimelockedTransfer), ctx, recipient, id)
+}
+
+// ClearSendRestriction mocks base method.
+func (m *MockBankKeeper) ClearSendRestriction() {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "ClearSendRestriction")
+}
+
+// ClearSendRestriction indicates an expected call of ClearSendRestriction.
+func (mr *MockBankKeeperMockRecorder) ClearSendRestriction() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ClearSendRestriction", reflect.TypeOf((*MockBankKeeper)(nil).ClearSendRestriction))
+}
+
+// CreateBalanceSnapshot mocks base method.
+func (m *MockBankKeeper) CreateBalanceSnapshot(ctx context.Context, denom string, height int64) (uint64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateBalanceSnapshot", ctx, denom, height)
+	ret0, _ := ret[0].(uint64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateBalanceSnapshot indicates an expected call of CreateBalanceSnapshot.
+func (mr *MockBankKeeperMockRecorder) CreateBalanceSnapshot(ctx, denom, height interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateBalanceSnapshot", reflect.TypeOf((*MockBankKeeper)(nil).CreateBalanceSnapshot), ctx, denom, height)
+}
+
 // DelegateCoins mocks base method.
 func (m *MockBankKeeper) DelegateCoins(ctx context.Context, delegatorAddr, moduleAccAddr types.AccAddress, amt types.Coins) error {
 	m.ctrl.T.Helper()
@@ -245,6 +386,37 @@ func (mr *MockBankKeeperMockRecorder) DelegateCoinsFromAccountToModule(ctx, send
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DelegateCoinsFromAccountToModule", reflect.TypeOf((*MockBankKeeper)(nil).DelegateCoinsFromAccountToModule), ctx, senderAddr, recipientModule, amt)
 }
 
+// DeleteBalanceSnapshot mocks base method.
+func (m *MockBankKeeper) DeleteBalanceSnapshot(ctx context.Context, id uint64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteBalanceSnapshot", ctx, id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteBalanceSnapshot indicates an expected call of DeleteBalanceSnapshot.
+func (mr *MockBankKeeperMockRecorder) DeleteBalanceSnapshot(ctx, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteBalanceSnapshot", reflect.TypeOf((*MockBankKeeper)(nil).DeleteBalanceSnapshot), ctx, id)
+}
+
+// DeleteMintBurnPermissions mocks base method.
+func (m *MockBankKeeper) DeleteMintBurnPermissions(ctx context.Context, denoms ...string) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx}
+	for _, a := range denoms {
+		varargs = append(varargs, a)
+	}
+	m.ctrl.Call(m, "DeleteMintBurnPermissions", varargs...)
+}
+
+// DeleteMintBurnPermissions indicates an expected call of DeleteMintBurnPermissions.
+func (mr *MockBankKeeperMockRecorder) DeleteMintBurnPermissions(ctx interface{}, denoms ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx}, denoms...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteMintBurnPermissions", reflect.TypeOf((*MockBankKeeper)(nil).DeleteMintBurnPermissions), varargs...)
+}
+
 // DeleteSendEnabled mocks base method.
 func (m *MockBankKeeper) DeleteSendEnabled(ctx context.Context, denoms ...string) {
 	m.ctrl.T.Helper()
@@ -262,6 +434,21 @@ func (mr *MockBankKeeperMockRecorder) DeleteSendEnabled(ctx interface{}, denoms
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteSendEnabled", reflect.TypeOf((*MockBankKeeper)(nil).DeleteSendEnabled), varargs...)
 }
 
+// DemurrageSchedules mocks base method.
+func (m *MockBankKeeper) DemurrageSchedules(arg0 context.Context, arg1 *types0.QueryDemurrageSchedulesRequest) (*types0.QueryDemurrageSchedulesResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DemurrageSchedules", arg0, arg1)
+	ret0, _ := ret[0].(*types0.QueryDemurrageSchedulesResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DemurrageSchedules indicates an expected call of DemurrageSchedules.
+func (mr *MockBankKeeperMockRecorder) DemurrageSchedules(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DemurrageSchedules", reflect.TypeOf((*MockBankKeeper)(nil).DemurrageSchedules), arg0, arg1)
+}
+
 // DenomMetadata mocks base method.
 func (m *MockBankKeeper) DenomMetadata(arg0 context.Context, arg1 *types0.QueryDenomMetadataRequest) (*types0.QueryDenomMetadataResponse, error) {
 	m.ctrl.T.Helper()
@@ -307,6 +494,35 @@ func (mr *MockBankKeeperMockRecorder) DenomsMetadata(arg0, arg1 interface{}) *go
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DenomsMetadata", reflect.TypeOf((*MockBankKeeper)(nil).DenomsMetadata), arg0, arg1)
 }
 
+// DisplayAmount mocks base method.
+func (m *MockBankKeeper) DisplayAmount(arg0 context.Context, arg1 *types0.QueryDisplayAmountRequest) (*types0.QueryDisplayAmountResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DisplayAmount", arg0, arg1)
+	ret0, _ := ret[0].(*types0.QueryDisplayAmountResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DisplayAmount indicates an expected call of DisplayAmount.
+func (mr *MockBankKeeperMockRecorder) DisplayAmount(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DisplayAmount", reflect.TypeOf((*MockBankKeeper)(nil).DisplayAmount), arg0, arg1)
+}
+
+// EscrowedCoins mocks base method.
+func (m *MockBankKeeper) EscrowedCoins(ctx context.Context, moduleName string) types.Coins {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "EscrowedCoins", ctx, moduleName)
+	ret0, _ := ret[0].(types.Coins)
+	return ret0
+}
+
+// EscrowedCoins indicates an expected call of EscrowedCoins.
+func (mr *MockBankKeeperMockRecorder) EscrowedCoins(ctx, moduleName interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "EscrowedCoins", reflect.TypeOf((*MockBankKeeper)(nil).EscrowedCoins), ctx, moduleName)
+}
+
 // ExportGenesis mocks base method.
 func (m *MockBankKeeper) ExportGenesis(arg0 context.Context) *types0.GenesisState {
 	m.ctrl.T.Helper()
@@ -363,6 +579,34 @@ func (mr *MockBankKeeperMockRecorder) GetAllDenomMetaData(ctx interface{}) *gomo
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAllDenomMetaData", reflect.TypeOf((*MockBankKeeper)(nil).GetAllDenomMetaData), ctx)
 }
 
+// GetAllDustSweepOptIns mocks base method.
+func (m *MockBankKeeper) GetAllDustSweepOptIns(ctx context.Context) []types.AccAddress {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAllDustSweepOptIns", ctx)
+	ret0, _ := ret[0].([]types.AccAddress)
+	return ret0
+}
+
+// GetAllDustSweepOptIns indicates an expected call of GetAllDustSweepOptIns.
+func (mr *MockBankKeeperMockRecorder) GetAllDustSweepOptIns(ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAllDustSweepOptIns", reflect.TypeOf((*MockBankKeeper)(nil).GetAllDustSweepOptIns), ctx)
+}
+
+// GetAllMintBurnPermissions mocks base method.
+func (m *MockBankKeeper) GetAllMintBurnPermissions(ctx context.Context) []types0.MintBurnPermissions {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAllMintBurnPermissions", ctx)
+	ret0, _ := ret[0].([]types0.MintBurnPermissions)
+	return ret0
+}
+
+// GetAllMintBurnPermissions indicates an expected call of GetAllMintBurnPermissions.
+func (mr *MockBankKeeperMockRecorder) GetAllMintBurnPermissions(ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAllMintBurnPermissions", reflect.TypeOf((*MockBankKeeper)(nil).GetAllMintBurnPermissions), ctx)
+}
+
 // GetAllSendEnabledEntries mocks base method.
 func (m *MockBankKeeper) GetAllSendEnabledEntries(ctx context.Context) []types0.SendEnabled {
 	m.ctrl.T.Helper()
@@ -405,6 +649,37 @@ func (mr *MockBankKeeperMockRecorder) GetBalance(ctx, addr, denom interface{}) *
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetBalance", reflect.TypeOf((*MockBankKeeper)(nil).GetBalance), ctx, addr, denom)
 }
 
+// GetBalanceSnapshot mocks base method.
+func (m *MockBankKeeper) GetBalanceSnapshot(ctx context.Context, id uint64) (types0.BalanceSnapshot, bool) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetBalanceSnapshot", ctx, id)
+	ret0, _ := ret[0].(types0.BalanceSnapshot)
+	ret1, _ := ret[1].(bool)
+	return ret0, ret1
+}
+
+// GetBalanceSnapshot indicates an expected call of GetBalanceSnapshot.
+func (mr *MockBankKeeperMockRecorder) GetBalanceSnapshot(ctx, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetBalanceSnapshot", reflect.TypeOf((*MockBankKeeper)(nil).GetBalanceSnapshot), ctx, id)
+}
+
+// GetBalanceSnapshotProof mocks base method.
+func (m *MockBankKeeper) GetBalanceSnapshotProof(ctx context.Context, id uint64, addr types.AccAddress) (math.Int, types0.BalanceSnapshotProof, bool) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetBalanceSnapshotProof", ctx, id, addr)
+	ret0, _ := ret[0].(math.Int)
+	ret1, _ := ret[1].(types0.BalanceSnapshotProof)
+	ret2, _ := ret[2].(bool)
+	return ret0, ret1, ret2
+}
+
+// GetBalanceSnapshotProof indicates an expected call of GetBalanceSnapshotProof.
+func (mr *MockBankKeeperMockRecorder) GetBalanceSnapshotProof(ctx, id, addr interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetBalanceSnapshotProof", reflect.TypeOf((*MockBankKeeper)(nil).GetBalanceSnapshotProof), ctx, id, addr)
+}
+
 // GetBlockedAddresses mocks base method.
 func (m *MockBankKeeper) GetBlockedAddresses() map[string]bool {
 	m.ctrl.T.Helper()
@@ -419,6 +694,21 @@ func (mr *MockBankKeeperMockRecorder) GetBlockedAddresses() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetBlockedAddresses", reflect.TypeOf((*MockBankKeeper)(nil).GetBlockedAddresses))
 }
 
+// GetDemurrageSchedule mocks base method.
+func (m *MockBankKeeper) GetDemurrageSchedule(ctx context.Context, denom string) (types0.DemurrageSchedule, bool) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetDemurrageSchedule", ctx, denom)
+	ret0, _ := ret[0].(types0.DemurrageSchedule)
+	ret1, _ := ret[1].(bool)
+	return ret0, ret1
+}
+
+// GetDemurrageSchedule indicates an expected call of GetDemurrageSchedule.
+func (mr *MockBankKeeperMockRecorder) GetDemurrageSchedule(ctx, denom interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetDemurrageSchedule", reflect.TypeOf((*MockBankKeeper)(nil).GetDemurrageSchedule), ctx, denom)
+}
+
 // GetDenomMetaData mocks base method.
 func (m *MockBankKeeper) GetDenomMetaData(ctx context.Context, denom string) (types0.Metadata, bool) {
 	m.ctrl.T.Helper()
@@ -434,6 +724,78 @@ func (mr *MockBankKeeperMockRecorder) GetDenomMetaData(ctx, denom interface{}) *
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetDenomMetaData", reflect.TypeOf((*MockBankKeeper)(nil).GetDenomMetaData), ctx, denom)
 }
 
+// GetHold mocks base method.
+func (m *MockBankKeeper) GetHold(ctx context.Context, addr types.AccAddress, holder string) (types0.Hold, bool) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetHold", ctx, addr, holder)
+	ret0, _ := ret[0].(types0.Hold)
+	ret1, _ := ret[1].(bool)
+	return ret0, ret1
+}
+
+// GetHold indicates an expected call of GetHold.
+func (mr *MockBankKeeperMockRecorder) GetHold(ctx, addr, holder interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetHold", reflect.TypeOf((*MockBankKeeper)(nil).GetHold), ctx, addr, holder)
+}
+
+// GetHolds mocks base method.
+func (m *MockBankKeeper) GetHolds(ctx context.Context, addr types.AccAddress) []types0.Hold {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetHolds", ctx, addr)
+	ret0, _ := ret[0].([]types0.Hold)
+	return ret0
+}
+
+// GetHolds indicates an expected call of GetHolds.
+func (mr *MockBankKeeperMockRecorder) GetHolds(ctx, addr interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetHolds", reflect.TypeOf((*MockBankKeeper)(nil).GetHolds), ctx, addr)
+}
+
+// GetMintBurnPermissions mocks base method.
+func (m *MockBankKeeper) GetMintBurnPermissions(ctx context.Context, denom string) (types0.MintBurnPermissions, bool) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetMintBurnPermissions", ctx, denom)
+	ret0, _ := ret[0].(types0.MintBurnPermissions)
+	ret1, _ := ret[1].(bool)
+	return ret0, ret1
+}
+
+// GetMintBurnPermissions indicates an expected call of GetMintBurnPermissions.
+func (mr *MockBankKeeperMockRecorder) GetMintBurnPermissions(ctx, denom interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetMintBurnPermissions", reflect.TypeOf((*MockBankKeeper)(nil).GetMintBurnPermissions), ctx, denom)
+}
+
+// GetModuleEscrow mocks base method.
+func (m *MockBankKeeper) GetModuleEscrow(ctx context.Context, moduleName, purpose string) types.Coins {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetModuleEscrow", ctx, moduleName, purpose)
+	ret0, _ := ret[0].(types.Coins)
+	return ret0
+}
+
+// GetModuleEscrow indicates an expected call of GetModuleEscrow.
+func (mr *MockBankKeeperMockRecorder) GetModuleEscrow(ctx, moduleName, purpose interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetModuleEscrow", reflect.TypeOf((*MockBankKeeper)(nil).GetModuleEscrow), ctx, moduleName, purpose)
+}
+
+// GetModuleEscrows mocks base method.
+func (m *MockBankKeeper) GetModuleEscrows(ctx context.Context, moduleName string) []types0.ModuleEscrow {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetModuleEscrows", ctx, moduleName)
+	ret0, _ := ret[0].([]types0.ModuleEscrow)
+	return ret0
+}
+
+// GetModuleEscrows indicates an expected call of GetModuleEscrows.
+func (mr *MockBankKeeperMockRecorder) GetModuleEscrows(ctx, moduleName interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetModuleEscrows", reflect.TypeOf((*MockBankKeeper)(nil).GetModuleEscrows), ctx, moduleName)
+}
+
 // GetPaginatedTotalSupply mocks base method.
 func (m *MockBankKeeper) GetPaginatedTotalSupply(ctx context.Context, pagination *query.PageRequest) (types.Coins, *query.PageResponse, error) {
 	m.ctrl.T.Helper()
@@ -479,6 +841,21 @@ func (mr *MockBankKeeperMockRecorder) GetSendEnabledEntry(ctx, denom interface{}
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSendEnabledEntry", reflect.TypeOf((*MockBankKeeper)(nil).GetSendEnabledEntry), ctx, denom)
 }
 
+// GetSpendingLimit mocks base method.
+func (m *MockBankKeeper) GetSpendingLimit(ctx context.Context, addr types.AccAddress) (types0.SpendingLimit, bool) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetSpendingLimit", ctx, addr)
+	ret0, _ := ret[0].(types0.SpendingLimit)
+	ret1, _ := ret[1].(bool)
+	return ret0, ret1
+}
+
+// GetSpendingLimit indicates an expected call of GetSpendingLimit.
+func (mr *MockBankKeeperMockRecorder) GetSpendingLimit(ctx, addr interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSpendingLimit", reflect.TypeOf((*MockBankKeeper)(nil).GetSpendingLimit), ctx, addr)
+}
+
 // GetSupply mocks base method.
 func (m *MockBankKeeper) GetSupply(ctx context.Context, denom string) types.Coin {
 	m.ctrl.T.Helper()
@@ -493,6 +870,21 @@ func (mr *MockBankKeeperMockRecorder) GetSupply(ctx, denom interface{}) *gomock.
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSupply", reflect.TypeOf((*MockBankKeeper)(nil).GetSupply), ctx, denom)
 }
 
+// GetTimelockedTransfer mocks base method.
+func (m *MockBankKeeper) GetTimelockedTransfer(ctx context.Context, id uint64) (types0.TimelockedTransfer, bool) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetTimelockedTransfer", ctx, id)
+	ret0, _ := ret[0].(types0.TimelockedTransfer)
+	ret1, _ := ret[1].(bool)
+	return ret0, ret1
+}
+
+// GetTimelockedTransfer indicates an expected call of GetTimelockedTransfer.
+func (mr *MockBankKeeperMockRecorder) GetTimelockedTransfer(ctx, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTimelockedTransfer", reflect.TypeOf((*MockBankKeeper)(nil).GetTimelockedTransfer), ctx, id)
+}
+
 // HasBalance mocks base method.
 func (m *MockBankKeeper) HasBalance(ctx context.Context, addr types.AccAddress, amt types.Coin) bool {
 	m.ctrl.T.Helper()
@@ -535,6 +927,49 @@ func (mr *MockBankKeeperMockRecorder) HasSupply(ctx, denom interface{}) *gomock.
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "HasSupply", reflect.TypeOf((*MockBankKeeper)(nil).HasSupply), ctx, denom)
 }
 
+// HeldCoins mocks base method.
+func (m *MockBankKeeper) HeldCoins(ctx context.Context, addr types.AccAddress) types.Coins {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "HeldCoins", ctx, addr)
+	ret0, _ := ret[0].(types.Coins)
+	return ret0
+}
+
+// HeldCoins indicates an expected call of HeldCoins.
+func (mr *MockBankKeeperMockRecorder) HeldCoins(ctx, addr interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "HeldCoins", reflect.TypeOf((*MockBankKeeper)(nil).HeldCoins), ctx, addr)
+}
+
+// HoldCoins mocks base method.
+func (m *MockBankKeeper) HoldCoins(ctx context.Context, addr types.AccAddress, holder string, amt types.Coins, expiration *time.Time) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "HoldCoins", ctx, addr, holder, amt, expiration)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// HoldCoins indicates an expected call of HoldCoins.
+func (mr *MockBankKeeperMockRecorder) HoldCoins(ctx, addr, holder, amt, expiration interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "HoldCoins", reflect.TypeOf((*MockBankKeeper)(nil).HoldCoins), ctx, addr, holder, amt, expiration)
+}
+
+// Holds mocks base method.
+func (m *MockBankKeeper) Holds(arg0 context.Context, arg1 *types0.QueryHoldsRequest) (*types0.QueryHoldsResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Holds", arg0, arg1)
+	ret0, _ := ret[0].(*types0.QueryHoldsResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Holds indicates an expected call of Holds.
+func (mr *MockBankKeeperMockRecorder) Holds(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Holds", reflect.TypeOf((*MockBankKeeper)(nil).Holds), arg0, arg1)
+}
+
 // InitGenesis mocks base method.
 func (m *MockBankKeeper) InitGenesis(arg0 context.Context, arg1 *types0.GenesisState) {
 	m.ctrl.T.Helper()
@@ -561,6 +996,20 @@ func (mr *MockBankKeeperMockRecorder) InputOutputCoins(ctx, inputs, outputs inte
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "InputOutputCoins", reflect.TypeOf((*MockBankKeeper)(nil).InputOutputCoins), ctx, inputs, outputs)
 }
 
+// IsDustSweepOptedIn mocks base method.
+func (m *MockBankKeeper) IsDustSweepOptedIn(ctx context.Context, addr types.AccAddress) bool {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "IsDustSweepOptedIn", ctx, addr)
+	ret0, _ := ret[0].(bool)
+	return ret0
+}
+
+// IsDustSweepOptedIn indicates an expected call of IsDustSweepOptedIn.
+func (mr *MockBankKeeperMockRecorder) IsDustSweepOptedIn(ctx, addr interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IsDustSweepOptedIn", reflect.TypeOf((*MockBankKeeper)(nil).IsDustSweepOptedIn), ctx, addr)
+}
+
 // IsSendEnabledCoin mocks base method.
 func (m *MockBankKeeper) IsSendEnabledCoin(ctx context.Context, coin types.Coin) bool {
 	m.ctrl.T.Helper()
@@ -644,31 +1093,103 @@ func (mr *MockBankKeeperMockRecorder) IterateAllDenomMetaData(ctx, cb interface{
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IterateAllDenomMetaData", reflect.TypeOf((*MockBankKeeper)(nil).IterateAllDenomMetaData), ctx, cb)
 }
 
-// IterateSendEnabledEntries mocks base method.
-func (m *MockBankKeeper) IterateSendEnabledEntries(ctx context.Context, cb func(string, bool) bool) {
+// IterateAllHolds mocks base method.
+func (m *MockBankKeeper) IterateAllHolds(ctx context.Context, cb func(types0.Hold) bool) {
 	m.ctrl.T.Helper()
-	m.ctrl.Call(m, "IterateSendEnabledEntries", ctx, cb)
+	m.ctrl.Call(m, "IterateAllHolds", ctx, cb)
 }
 
-// IterateSendEnabledEntries indicates an expected call of IterateSendEnabledEntries.
-func (mr *MockBankKeeperMockRecorder) IterateSendEnabledEntries(ctx, cb interface{}) *gomock.Call {
+// IterateAllHolds indicates an expected call of IterateAllHolds.
+func (mr *MockBankKeeperMockRecorder) IterateAllHolds(ctx, cb interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IterateSendEnabledEntries", reflect.TypeOf((*MockBankKeeper)(nil).IterateSendEnabledEntries), ctx, cb)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IterateAllHolds", reflect.TypeOf((*MockBankKeeper)(nil).IterateAllHolds), ctx, cb)
 }
 
-// IterateTotalSupply mocks base method.
-func (m *MockBankKeeper) IterateTotalSupply(ctx context.Context, cb func(types.Coin) bool) {
+// IterateDemurrageSchedules mocks base method.
+func (m *MockBankKeeper) IterateDemurrageSchedules(ctx context.Context, cb func(types0.DemurrageSchedule) bool) {
 	m.ctrl.T.Helper()
-	m.ctrl.Call(m, "IterateTotalSupply", ctx, cb)
+	m.ctrl.Call(m, "IterateDemurrageSchedules", ctx, cb)
 }
 
-// IterateTotalSupply indicates an expected call of IterateTotalSupply.
-func (mr *MockBankKeeperMockRecorder) IterateTotalSupply(ctx, cb interface{}) *gomock.Call {
+// IterateDemurrageSchedules indicates an expected call of IterateDemurrageSchedules.
+func (mr *MockBankKeeperMockRecorder) IterateDemurrageSchedules(ctx, cb interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IterateTotalSupply", reflect.TypeOf((*MockBankKeeper)(nil).IterateTotalSupply), ctx, cb)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IterateDemurrageSchedules", reflect.TypeOf((*MockBankKeeper)(nil).IterateDemurrageSchedules), ctx, cb)
 }
 
-// LockedCoins mocks base method.
+// IterateMintBurnPermissions mocks base method.
+func (m *MockBankKeeper) IterateMintBurnPermissions(ctx context.Context, cb func(types0.MintBurnPermissions) bool) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "IterateMintBurnPermissions", ctx, cb)
+}
+
+// IterateMintBurnPermissions indicates an expected call of IterateMintBurnPermissions.
+func (mr *MockBankKeeperMockRecorder) IterateMintBurnPermissions(ctx, cb interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IterateMintBurnPermissions", reflect.TypeOf((*MockBankKeeper)(nil).IterateMintBurnPermissions), ctx, cb)
+}
+
+// IterateSendEnabledEntries mocks base method.
+func (m *MockBankKeeper) IterateSendEnabledEntries(ctx context.Context, cb func(string, bool) bool) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "IterateSendEnabledEntries", ctx, cb)
+}
+
+// IterateSendEnabledEntries indicates an expected call of IterateSendEnabledEntries.
+func (mr *MockBankKeeperMockRecorder) IterateSendEnabledEntries(ctx, cb interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IterateSendEnabledEntries", reflect.TypeOf((*MockBankKeeper)(nil).IterateSendEnabledEntries), ctx, cb)
+}
+
+// IterateSnapshotBalances mocks base method.
+func (m *MockBankKeeper) IterateSnapshotBalances(ctx context.Context, id uint64, cb func(types.AccAddress, math.Int) bool) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "IterateSnapshotBalances", ctx, id, cb)
+}
+
+// IterateSnapshotBalances indicates an expected call of IterateSnapshotBalances.
+func (mr *MockBankKeeperMockRecorder) IterateSnapshotBalances(ctx, id, cb interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IterateSnapshotBalances", reflect.TypeOf((*MockBankKeeper)(nil).IterateSnapshotBalances), ctx, id, cb)
+}
+
+// IterateSpendingLimits mocks base method.
+func (m *MockBankKeeper) IterateSpendingLimits(ctx context.Context, cb func(types0.SpendingLimit) bool) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "IterateSpendingLimits", ctx, cb)
+}
+
+// IterateSpendingLimits indicates an expected call of IterateSpendingLimits.
+func (mr *MockBankKeeperMockRecorder) IterateSpendingLimits(ctx, cb interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IterateSpendingLimits", reflect.TypeOf((*MockBankKeeper)(nil).IterateSpendingLimits), ctx, cb)
+}
+
+// IterateTimelockedTransfers mocks base method.
+func (m *MockBankKeeper) IterateTimelockedTransfers(ctx context.Context, cb func(types0.TimelockedTransfer) bool) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "IterateTimelockedTransfers", ctx, cb)
+}
+
+// IterateTimelockedTransfers indicates an expected call of IterateTimelockedTransfers.
+func (mr *MockBankKeeperMockRecorder) IterateTimelockedTransfers(ctx, cb interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IterateTimelockedTransfers", reflect.TypeOf((*MockBankKeeper)(nil).IterateTimelockedTransfers), ctx, cb)
+}
+
+// IterateTotalSupply mocks base method.
+func (m *MockBankKeeper) IterateTotalSupply(ctx context.Context, cb func(types.Coin) bool) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "IterateTotalSupply", ctx, cb)
+}
+
+// IterateTotalSupply indicates an expected call of IterateTotalSupply.
+func (mr *MockBankKeeperMockRecorder) IterateTotalSupply(ctx, cb interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IterateTotalSupply", reflect.TypeOf((*MockBankKeeper)(nil).IterateTotalSupply), ctx, cb)
+}
+
+// LockedCoins mocks base method.
 func (m *MockBankKeeper) LockedCoins(ctx context.Context, addr types.AccAddress) types.Coins {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "LockedCoins", ctx, addr)
@@ -682,6 +1203,21 @@ func (mr *MockBankKeeperMockRecorder) LockedCoins(ctx, addr interface{}) *gomock
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "LockedCoins", reflect.TypeOf((*MockBankKeeper)(nil).LockedCoins), ctx, addr)
 }
 
+// MintBurnPermissions mocks base method.
+func (m *MockBankKeeper) MintBurnPermissions(arg0 context.Context, arg1 *types0.QueryMintBurnPermissionsRequest) (*types0.QueryMintBurnPermissionsResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "MintBurnPermissions", arg0, arg1)
+	ret0, _ := ret[0].(*types0.QueryMintBurnPermissionsResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// MintBurnPermissions indicates an expected call of MintBurnPermissions.
+func (mr *MockBankKeeperMockRecorder) MintBurnPermissions(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MintBurnPermissions", reflect.TypeOf((*MockBankKeeper)(nil).MintBurnPermissions), arg0, arg1)
+}
+
 // MintCoins mocks base method.
 func (m *MockBankKeeper) MintCoins(ctx context.Context, moduleName string, amt types.Coins) error {
 	m.ctrl.T.Helper()
@@ -696,6 +1232,21 @@ func (mr *MockBankKeeperMockRecorder) MintCoins(ctx, moduleName, amt interface{}
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MintCoins", reflect.TypeOf((*MockBankKeeper)(nil).MintCoins), ctx, moduleName, amt)
 }
 
+// ModuleEscrows mocks base method.
+func (m *MockBankKeeper) ModuleEscrows(arg0 context.Context, arg1 *types0.QueryModuleEscrowsRequest) (*types0.QueryModuleEscrowsResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ModuleEscrows", arg0, arg1)
+	ret0, _ := ret[0].(*types0.QueryModuleEscrowsResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ModuleEscrows indicates an expected call of ModuleEscrows.
+func (mr *MockBankKeeperMockRecorder) ModuleEscrows(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ModuleEscrows", reflect.TypeOf((*MockBankKeeper)(nil).ModuleEscrows), arg0, arg1)
+}
+
 // Params mocks base method.
 func (m *MockBankKeeper) Params(arg0 context.Context, arg1 *types0.QueryParamsRequest) (*types0.QueryParamsResponse, error) {
 	m.ctrl.T.Helper()
@@ -711,6 +1262,62 @@ func (mr *MockBankKeeperMockRecorder) Params(arg0, arg1 interface{}) *gomock.Cal
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Params", reflect.TypeOf((*MockBankKeeper)(nil).Params), arg0, arg1)
 }
 
+// PrependSendRestriction mocks base method.
+func (m *MockBankKeeper) PrependSendRestriction(restriction types0.SendRestrictionFn) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "PrependSendRestriction", restriction)
+}
+
+// PrependSendRestriction indicates an expected call of PrependSendRestriction.
+func (mr *MockBankKeeperMockRecorder) PrependSendRestriction(restriction interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PrependSendRestriction", reflect.TypeOf((*MockBankKeeper)(nil).PrependSendRestriction), restriction)
+}
+
+// ProjectedSpendableCoins mocks base method.
+func (m *MockBankKeeper) ProjectedSpendableCoins(ctx context.Context, addr types.AccAddress, t time.Time) (types.Coins, types.Coins, types.Coins) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ProjectedSpendableCoins", ctx, addr, t)
+	ret0, _ := ret[0].(types.Coins)
+	ret1, _ := ret[1].(types.Coins)
+	ret2, _ := ret[2].(types.Coins)
+	return ret0, ret1, ret2
+}
+
+// ProjectedSpendableCoins indicates an expected call of ProjectedSpendableCoins.
+func (mr *MockBankKeeperMockRecorder) ProjectedSpendableCoins(ctx, addr, t interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ProjectedSpendableCoins", reflect.TypeOf((*MockBankKeeper)(nil).ProjectedSpendableCoins), ctx, addr, t)
+}
+
+// ReclaimTimelockedTransfer mocks base method.
+func (m *MockBankKeeper) ReclaimTimelockedTransfer(ctx context.Context, sender types.AccAddress, id uint64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReclaimTimelockedTransfer", ctx, sender, id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ReclaimTimelockedTransfer indicates an expected call of ReclaimTimelockedTransfer.
+func (mr *MockBankKeeperMockRecorder) ReclaimTimelockedTransfer(ctx, sender, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReclaimTimelockedTransfer", reflect.TypeOf((*MockBankKeeper)(nil).ReclaimTimelockedTransfer), ctx, sender, id)
+}
+
+// ReleaseHold mocks base method.
+func (m *MockBankKeeper) ReleaseHold(ctx context.Context, addr types.AccAddress, holder string, amt types.Coins) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReleaseHold", ctx, addr, holder, amt)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ReleaseHold indicates an expected call of ReleaseHold.
+func (mr *MockBankKeeperMockRecorder) ReleaseHold(ctx, addr, holder, amt interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReleaseHold", reflect.TypeOf((*MockBankKeeper)(nil).ReleaseHold), ctx, addr, holder, amt)
+}
+
 // SendCoins mocks base method.
 func (m *MockBankKeeper) SendCoins(ctx context.Context, fromAddr, toAddr types.AccAddress, amt types.Coins) error {
 	m.ctrl.T.Helper()
@@ -782,6 +1389,35 @@ func (mr *MockBankKeeperMockRecorder) SendEnabled(arg0, arg1 interface{}) *gomoc
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SendEnabled", reflect.TypeOf((*MockBankKeeper)(nil).SendEnabled), arg0, arg1)
 }
 
+// SendHeldCoins mocks base method.
+func (m *MockBankKeeper) SendHeldCoins(ctx context.Context, fromAddr types.AccAddress, holder string, toAddr types.AccAddress, amt types.Coins) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SendHeldCoins", ctx, fromAddr, holder, toAddr, amt)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SendHeldCoins indicates an expected call of SendHeldCoins.
+func (mr *MockBankKeeperMockRecorder) SendHeldCoins(ctx, fromAddr, holder, toAddr, amt interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SendHeldCoins", reflect.TypeOf((*MockBankKeeper)(nil).SendHeldCoins), ctx, fromAddr, holder, toAddr, amt)
+}
+
+// SendWithTimelock mocks base method.
+func (m *MockBankKeeper) SendWithTimelock(ctx context.Context, fromAddr, toAddr types.AccAddress, amt types.Coins, unlockTime, expiryTime time.Time) (uint64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SendWithTimelock", ctx, fromAddr, toAddr, amt, unlockTime, expiryTime)
+	ret0, _ := ret[0].(uint64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SendWithTimelock indicates an expected call of SendWithTimelock.
+func (mr *MockBankKeeperMockRecorder) SendWithTimelock(ctx, fromAddr, toAddr, amt, unlockTime, expiryTime interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SendWithTimelock", reflect.TypeOf((*MockBankKeeper)(nil).SendWithTimelock), ctx, fromAddr, toAddr, amt, unlockTime, expiryTime)
+}
+
 // SetAllSendEnabled mocks base method.
 func (m *MockBankKeeper) SetAllSendEnabled(ctx context.Context, sendEnableds []*types0.SendEnabled) {
 	m.ctrl.T.Helper()
@@ -794,6 +1430,33 @@ func (mr *MockBankKeeperMockRecorder) SetAllSendEnabled(ctx, sendEnableds interf
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetAllSendEnabled", reflect.TypeOf((*MockBankKeeper)(nil).SetAllSendEnabled), ctx, sendEnableds)
 }
 
+// SetCommunityPoolKeeper mocks base method.
+func (m *MockBankKeeper) SetCommunityPoolKeeper(cpk types0.CommunityPoolKeeper) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "SetCommunityPoolKeeper", cpk)
+}
+
+// SetCommunityPoolKeeper indicates an expected call of SetCommunityPoolKeeper.
+func (mr *MockBankKeeperMockRecorder) SetCommunityPoolKeeper(cpk interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetCommunityPoolKeeper", reflect.TypeOf((*MockBankKeeper)(nil).SetCommunityPoolKeeper), cpk)
+}
+
+// SetDemurrageSchedule mocks base method.
+func (m *MockBankKeeper) SetDemurrageSchedule(ctx context.Context, denom string, rate math.LegacyDec, epochLength int64) (types0.DemurrageSchedule, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetDemurrageSchedule", ctx, denom, rate, epochLength)
+	ret0, _ := ret[0].(types0.DemurrageSchedule)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SetDemurrageSchedule indicates an expected call of SetDemurrageSchedule.
+func (mr *MockBankKeeperMockRecorder) SetDemurrageSchedule(ctx, denom, rate, epochLength interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetDemurrageSchedule", reflect.TypeOf((*MockBankKeeper)(nil).SetDemurrageSchedule), ctx, denom, rate, epochLength)
+}
+
 // SetDenomMetaData mocks base method.
 func (m *MockBankKeeper) SetDenomMetaData(ctx context.Context, denomMetaData types0.Metadata) {
 	m.ctrl.T.Helper()
@@ -806,6 +1469,58 @@ func (mr *MockBankKeeperMockRecorder) SetDenomMetaData(ctx, denomMetaData interf
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetDenomMetaData", reflect.TypeOf((*MockBankKeeper)(nil).SetDenomMetaData), ctx, denomMetaData)
 }
 
+// SetDustSweepOptIn mocks base method.
+func (m *MockBankKeeper) SetDustSweepOptIn(ctx context.Context, addr types.AccAddress, optIn bool) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetDustSweepOptIn", ctx, addr, optIn)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SetDustSweepOptIn indicates an expected call of SetDustSweepOptIn.
+func (mr *MockBankKeeperMockRecorder) SetDustSweepOptIn(ctx, addr, optIn interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetDustSweepOptIn", reflect.TypeOf((*MockBankKeeper)(nil).SetDustSweepOptIn), ctx, addr, optIn)
+}
+
+// SetHooks mocks base method.
+func (m *MockBankKeeper) SetHooks(hooks types0.BankHooks) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "SetHooks", hooks)
+}
+
+// SetHooks indicates an expected call of SetHooks.
+func (mr *MockBankKeeperMockRecorder) SetHooks(hooks interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetHooks", reflect.TypeOf((*MockBankKeeper)(nil).SetHooks), hooks)
+}
+
+// SetMintBurnPermissions mocks base method.
+func (m *MockBankKeeper) SetMintBurnPermissions(ctx context.Context, p types0.MintBurnPermissions) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "SetMintBurnPermissions", ctx, p)
+}
+
+// SetMintBurnPermissions indicates an expected call of SetMintBurnPermissions.
+func (mr *MockBankKeeperMockRecorder) SetMintBurnPermissions(ctx, p interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetMintBurnPermissions", reflect.TypeOf((*MockBankKeeper)(nil).SetMintBurnPermissions), ctx, p)
+}
+
+// SetModuleEscrow mocks base method.
+func (m *MockBankKeeper) SetModuleEscrow(ctx context.Context, moduleName, purpose string, amt types.Coins) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetModuleEscrow", ctx, moduleName, purpose, amt)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SetModuleEscrow indicates an expected call of SetModuleEscrow.
+func (mr *MockBankKeeperMockRecorder) SetModuleEscrow(ctx, moduleName, purpose, amt interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetModuleEscrow", reflect.TypeOf((*MockBankKeeper)(nil).SetModuleEscrow), ctx, moduleName, purpose, amt)
+}
+
 // SetParams mocks base method.
 func (m *MockBankKeeper) SetParams(ctx context.Context, params types0.Params) error {
 	m.ctrl.T.Helper()
@@ -832,6 +1547,21 @@ func (mr *MockBankKeeperMockRecorder) SetSendEnabled(ctx, denom, value interface
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetSendEnabled", reflect.TypeOf((*MockBankKeeper)(nil).SetSendEnabled), ctx, denom, value)
 }
 
+// SetSpendingLimit mocks base method.
+func (m *MockBankKeeper) SetSpendingLimit(ctx context.Context, addr types.AccAddress, limits types.Coins, window time.Duration) (time.Time, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetSpendingLimit", ctx, addr, limits, window)
+	ret0, _ := ret[0].(time.Time)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SetSpendingLimit indicates an expected call of SetSpendingLimit.
+func (mr *MockBankKeeperMockRecorder) SetSpendingLimit(ctx, addr, limits, window interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetSpendingLimit", reflect.TypeOf((*MockBankKeeper)(nil).SetSpendingLimit), ctx, addr, limits, window)
+}
+
 // SpendableBalanceByDenom mocks base method.
 func (m *MockBankKeeper) SpendableBalanceByDenom(arg0 context.Context, arg1 *types0.QuerySpendableBalanceByDenomRequest) (*types0.QuerySpendableBalanceByDenomResponse, error) {
 	m.ctrl.T.Helper()
@@ -847,6 +1577,21 @@ func (mr *MockBankKeeperMockRecorder) SpendableBalanceByDenom(arg0, arg1 interfa
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SpendableBalanceByDenom", reflect.TypeOf((*MockBankKeeper)(nil).SpendableBalanceByDenom), arg0, arg1)
 }
 
+// SpendableBalanceProjection mocks base method.
+func (m *MockBankKeeper) SpendableBalanceProjection(arg0 context.Context, arg1 *types0.QuerySpendableBalanceProjectionRequest) (*types0.QuerySpendableBalanceProjectionResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SpendableBalanceProjection", arg0, arg1)
+	ret0, _ := ret[0].(*types0.QuerySpendableBalanceProjectionResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SpendableBalanceProjection indicates an expected call of SpendableBalanceProjection.
+func (mr *MockBankKeeperMockRecorder) SpendableBalanceProjection(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SpendableBalanceProjection", reflect.TypeOf((*MockBankKeeper)(nil).SpendableBalanceProjection), arg0, arg1)
+}
+
 // SpendableBalances mocks base method.
 func (m *MockBankKeeper) SpendableBalances(arg0 context.Context, arg1 *types0.QuerySpendableBalancesRequest) (*types0.QuerySpendableBalancesResponse, error) {
 	m.ctrl.T.Helper()
@@ -890,6 +1635,35 @@ func (mr *MockBankKeeperMockRecorder) SpendableCoins(ctx, addr interface{}) *gom
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SpendableCoins", reflect.TypeOf((*MockBankKeeper)(nil).SpendableCoins), ctx, addr)
 }
 
+// SpendingLimit mocks base method.
+func (m *MockBankKeeper) SpendingLimit(arg0 context.Context, arg1 *types0.QuerySpendingLimitRequest) (*types0.QuerySpendingLimitResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SpendingLimit", arg0, arg1)
+	ret0, _ := ret[0].(*types0.QuerySpendingLimitResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SpendingLimit indicates an expected call of SpendingLimit.
+func (mr *MockBankKeeperMockRecorder) SpendingLimit(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SpendingLimit", reflect.TypeOf((*MockBankKeeper)(nil).SpendingLimit), arg0, arg1)
+}
+
+// SubModuleEscrow mocks base method.
+func (m *MockBankKeeper) SubModuleEscrow(ctx context.Context, moduleName, purpose string, amt types.Coins) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SubModuleEscrow", ctx, moduleName, purpose, amt)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SubModuleEscrow indicates an expected call of SubModuleEscrow.
+func (mr *MockBankKeeperMockRecorder) SubModuleEscrow(ctx, moduleName, purpose, amt interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SubModuleEscrow", reflect.TypeOf((*MockBankKeeper)(nil).SubModuleEscrow), ctx, moduleName, purpose, amt)
+}
+
 // SupplyOf mocks base method.
 func (m *MockBankKeeper) SupplyOf(arg0 context.Context, arg1 *types0.QuerySupplyOfRequest) (*types0.QuerySupplyOfResponse, error) {
 	m.ctrl.T.Helper()
@@ -905,6 +1679,79 @@ func (mr *MockBankKeeperMockRecorder) SupplyOf(arg0, arg1 interface{}) *gomock.C
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SupplyOf", reflect.TypeOf((*MockBankKeeper)(nil).SupplyOf), arg0, arg1)
 }
 
+// SweepDust mocks base method.
+func (m *MockBankKeeper) SweepDust(ctx context.Context) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SweepDust", ctx)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SweepDust indicates an expected call of SweepDust.
+func (mr *MockBankKeeperMockRecorder) SweepDust(ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SweepDust", reflect.TypeOf((*MockBankKeeper)(nil).SweepDust), ctx)
+}
+
+// TakeBalanceSnapshots mocks base method.
+func (m *MockBankKeeper) TakeBalanceSnapshots(ctx context.Context) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "TakeBalanceSnapshots", ctx)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// TakeBalanceSnapshots indicates an expected call of TakeBalanceSnapshots.
+func (mr *MockBankKeeperMockRecorder) TakeBalanceSnapshots(ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "TakeBalanceSnapshots", reflect.TypeOf((*MockBankKeeper)(nil).TakeBalanceSnapshots), ctx)
+}
+
+// TimelockedTransfer mocks base method.
+func (m *MockBankKeeper) TimelockedTransfer(arg0 context.Context, arg1 *types0.QueryTimelockedTransferRequest) (*types0.QueryTimelockedTransferResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "TimelockedTransfer", arg0, arg1)
+	ret0, _ := ret[0].(*types0.QueryTimelockedTransferResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// TimelockedTransfer indicates an expected call of TimelockedTransfer.
+func (mr *MockBankKeeperMockRecorder) TimelockedTransfer(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "TimelockedTransfer", reflect.TypeOf((*MockBankKeeper)(nil).TimelockedTransfer), arg0, arg1)
+}
+
+// TimelockedTransfersByRecipient mocks base method.
+func (m *MockBankKeeper) TimelockedTransfersByRecipient(arg0 context.Context, arg1 *types0.QueryTimelockedTransfersByRecipientRequest) (*types0.QueryTimelockedTransfersByRecipientResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "TimelockedTransfersByRecipient", arg0, arg1)
+	ret0, _ := ret[0].(*types0.QueryTimelockedTransfersByRecipientResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// TimelockedTransfersByRecipient indicates an expected call of TimelockedTransfersByRecipient.
+func (mr *MockBankKeeperMockRecorder) TimelockedTransfersByRecipient(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "TimelockedTransfersByRecipient", reflect.TypeOf((*MockBankKeeper)(nil).TimelockedTransfersByRecipient), arg0, arg1)
+}
+
+// TimelockedTransfersBySender mocks base method.
+func (m *MockBankKeeper) TimelockedTransfersBySender(arg0 context.Context, arg1 *types0.QueryTimelockedTransfersBySenderRequest) (*types0.QueryTimelockedTransfersBySenderResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "TimelockedTransfersBySender", arg0, arg1)
+	ret0, _ := ret[0].(*types0.QueryTimelockedTransfersBySenderResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// TimelockedTransfersBySender indicates an expected call of TimelockedTransfersBySender.
+func (mr *MockBankKeeperMockRecorder) TimelockedTransfersBySender(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "TimelockedTransfersBySender", reflect.TypeOf((*MockBankKeeper)(nil).TimelockedTransfersBySender), arg0, arg1)
+}
+
 // TotalSupply mocks base method.
 func (m *MockBankKeeper) TotalSupply(arg0 context.Context, arg1 *types0.QueryTotalSupplyRequest) (*types0.QueryTotalSupplyResponse, error) {
 	m.ctrl.T.Helper()
@@ -1013,28 +1860,364 @@ func (mr *MockStakingKeeperMockRecorder) BondDenom(ctx interface{}) *gomock.Call
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "BondDenom", reflect.TypeOf((*MockStakingKeeper)(nil).BondDenom), ctx)
 }
 
+// BondedRatio mocks base method.
+func (m *MockStakingKeeper) BondedRatio(ctx types.Context) math.LegacyDec {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "BondedRatio", ctx)
+	ret0, _ := ret[0].(math.LegacyDec)
+	return ret0
+}
+
+// BondedRatio indicates an expected call of BondedRatio.
+func (mr *MockStakingKeeperMockRecorder) BondedRatio(ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "BondedRatio", reflect.TypeOf((*MockStakingKeeper)(nil).BondedRatio), ctx)
+}
+
+// Delegation mocks base method.
+func (m *MockStakingKeeper) Delegation(ctx types.Context, delAddr types.AccAddress, valAddr types.ValAddress) types1.DelegationI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Delegation", ctx, delAddr, valAddr)
+	ret0, _ := ret[0].(types1.DelegationI)
+	return ret0
+}
+
+// Delegation indicates an expected call of Delegation.
+func (mr *MockStakingKeeperMockRecorder) Delegation(ctx, delAddr, valAddr interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delegation", reflect.TypeOf((*MockStakingKeeper)(nil).Delegation), ctx, delAddr, valAddr)
+}
+
+// GetAllValidators mocks base method.
+func (m *MockStakingKeeper) GetAllValidators(ctx types.Context) []types1.Validator {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAllValidators", ctx)
+	ret0, _ := ret[0].([]types1.Validator)
+	return ret0
+}
+
+// GetAllValidators indicates an expected call of GetAllValidators.
+func (mr *MockStakingKeeperMockRecorder) GetAllValidators(ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAllValidators", reflect.TypeOf((*MockStakingKeeper)(nil).GetAllValidators), ctx)
+}
+
+// GetBondedValidatorsByPower mocks base method.
+func (m *MockStakingKeeper) GetBondedValidatorsByPower(ctx types.Context) []types1.Validator {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetBondedValidatorsByPower", ctx)
+	ret0, _ := ret[0].([]types1.Validator)
+	return ret0
+}
+
+// GetBondedValidatorsByPower indicates an expected call of GetBondedValidatorsByPower.
+func (mr *MockStakingKeeperMockRecorder) GetBondedValidatorsByPower(ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetBondedValidatorsByPower", reflect.TypeOf((*MockStakingKeeper)(nil).GetBondedValidatorsByPower), ctx)
+}
+
+// GetDelegation mocks base method.
+func (m *MockStakingKeeper) GetDelegation(ctx types.Context, delAddr types.AccAddress, valAddr types.ValAddress) (types1.Delegation, bool) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetDelegation", ctx, delAddr, valAddr)
+	ret0, _ := ret[0].(types1.Delegation)
+	ret1, _ := ret[1].(bool)
+	return ret0, ret1
+}
+
+// GetDelegation indicates an expected call of GetDelegation.
+func (mr *MockStakingKeeperMockRecorder) GetDelegation(ctx, delAddr, valAddr interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetDelegation", reflect.TypeOf((*MockStakingKeeper)(nil).GetDelegation), ctx, delAddr, valAddr)
+}
+
+// GetDelegatorBonded mocks base method.
+func (m *MockStakingKeeper) GetDelegatorBonded(ctx types.Context, delegator types.AccAddress) math.Int {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetDelegatorBonded", ctx, delegator)
+	ret0, _ := ret[0].(math.Int)
+	return ret0
+}
+
+// GetDelegatorBonded indicates an expected call of GetDelegatorBonded.
+func (mr *MockStakingKeeperMockRecorder) GetDelegatorBonded(ctx, delegator interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetDelegatorBonded", reflect.TypeOf((*MockStakingKeeper)(nil).GetDelegatorBonded), ctx, delegator)
+}
+
+// GetDelegatorDelegations mocks base method.
+func (m *MockStakingKeeper) GetDelegatorDelegations(ctx types.Context, delegator types.AccAddress, maxRetrieve uint16) []types1.Delegation {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetDelegatorDelegations", ctx, delegator, maxRetrieve)
+	ret0, _ := ret[0].([]types1.Delegation)
+	return ret0
+}
+
+// GetDelegatorDelegations indicates an expected call of GetDelegatorDelegations.
+func (mr *MockStakingKeeperMockRecorder) GetDelegatorDelegations(ctx, delegator, maxRetrieve interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetDelegatorDelegations", reflect.TypeOf((*MockStakingKeeper)(nil).GetDelegatorDelegations), ctx, delegator, maxRetrieve)
+}
+
+// GetDelegatorUnbonding mocks base method.
+func (m *MockStakingKeeper) GetDelegatorUnbonding(ctx types.Context, delegator types.AccAddress) math.Int {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetDelegatorUnbonding", ctx, delegator)
+	ret0, _ := ret[0].(math.Int)
+	return ret0
+}
+
+// GetDelegatorUnbonding indicates an expected call of GetDelegatorUnbonding.
+func (mr *MockStakingKeeperMockRecorder) GetDelegatorUnbonding(ctx, delegator interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetDelegatorUnbonding", reflect.TypeOf((*MockStakingKeeper)(nil).GetDelegatorUnbonding), ctx, delegator)
+}
+
+// GetHistoricalInfo mocks base method.
+func (m *MockStakingKeeper) GetHistoricalInfo(ctx types.Context, height int64) (types1.HistoricalInfo, bool) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetHistoricalInfo", ctx, height)
+	ret0, _ := ret[0].(types1.HistoricalInfo)
+	ret1, _ := ret[1].(bool)
+	return ret0, ret1
+}
+
+// GetHistoricalInfo indicates an expected call of GetHistoricalInfo.
+func (mr *MockStakingKeeperMockRecorder) GetHistoricalInfo(ctx, height interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetHistoricalInfo", reflect.TypeOf((*MockStakingKeeper)(nil).GetHistoricalInfo), ctx, height)
+}
+
+// GetLastTotalPower mocks base method.
+func (m *MockStakingKeeper) GetLastTotalPower(ctx types.Context) math.Int {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetLastTotalPower", ctx)
+	ret0, _ := ret[0].(math.Int)
+	return ret0
+}
+
+// GetLastTotalPower indicates an expected call of GetLastTotalPower.
+func (mr *MockStakingKeeperMockRecorder) GetLastTotalPower(ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetLastTotalPower", reflect.TypeOf((*MockStakingKeeper)(nil).GetLastTotalPower), ctx)
+}
+
+// GetLastValidatorPower mocks base method.
+func (m *MockStakingKeeper) GetLastValidatorPower(ctx types.Context, operator types.ValAddress) int64 {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetLastValidatorPower", ctx, operator)
+	ret0, _ := ret[0].(int64)
+	return ret0
+}
+
+// GetLastValidatorPower indicates an expected call of GetLastValidatorPower.
+func (mr *MockStakingKeeperMockRecorder) GetLastValidatorPower(ctx, operator interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetLastValidatorPower", reflect.TypeOf((*MockStakingKeeper)(nil).GetLastValidatorPower), ctx, operator)
+}
+
+// GetLastValidators mocks base method.
+func (m *MockStakingKeeper) GetLastValidators(ctx types.Context) []types1.Validator {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetLastValidators", ctx)
+	ret0, _ := ret[0].([]types1.Validator)
+	return ret0
+}
+
+// GetLastValidators indicates an expected call of GetLastValidators.
+func (mr *MockStakingKeeperMockRecorder) GetLastValidators(ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetLastValidators", reflect.TypeOf((*MockStakingKeeper)(nil).GetLastValidators), ctx)
+}
+
+// GetParams mocks base method.
+func (m *MockStakingKeeper) GetParams(ctx types.Context) types1.Params {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetParams", ctx)
+	ret0, _ := ret[0].(types1.Params)
+	return ret0
+}
+
+// GetParams indicates an expected call of GetParams.
+func (mr *MockStakingKeeperMockRecorder) GetParams(ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetParams", reflect.TypeOf((*MockStakingKeeper)(nil).GetParams), ctx)
+}
+
+// GetRedelegation mocks base method.
+func (m *MockStakingKeeper) GetRedelegation(ctx types.Context, delAddr types.AccAddress, valSrcAddr, valDstAddr types.ValAddress) (types1.Redelegation, bool) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetRedelegation", ctx, delAddr, valSrcAddr, valDstAddr)
+	ret0, _ := ret[0].(types1.Redelegation)
+	ret1, _ := ret[1].(bool)
+	return ret0, ret1
+}
+
+// GetRedelegation indicates an expected call of GetRedelegation.
+func (mr *MockStakingKeeperMockRecorder) GetRedelegation(ctx, delAddr, valSrcAddr, valDstAddr interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetRedelegation", reflect.TypeOf((*MockStakingKeeper)(nil).GetRedelegation), ctx, delAddr, valSrcAddr, valDstAddr)
+}
+
+// GetRedelegations mocks base method.
+func (m *MockStakingKeeper) GetRedelegations(ctx types.Context, delegator types.AccAddress, maxRetrieve uint16) []types1.Redelegation {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetRedelegations", ctx, delegator, maxRetrieve)
+	ret0, _ := ret[0].([]types1.Redelegation)
+	return ret0
+}
+
+// GetRedelegations indicates an expected call of GetRedelegations.
+func (mr *MockStakingKeeperMockRecorder) GetRedelegations(ctx, delegator, maxRetrieve interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetRedelegations", reflect.TypeOf((*MockStakingKeeper)(nil).GetRedelegations), ctx, delegator, maxRetrieve)
+}
+
+// GetUnbondingDelegation mocks base method.
+func (m *MockStakingKeeper) GetUnbondingDelegation(ctx types.Context, delAddr types.AccAddress, valAddr types.ValAddress) (types1.UnbondingDelegation, bool) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetUnbondingDelegation", ctx, delAddr, valAddr)
+	ret0, _ := ret[0].(types1.UnbondingDelegation)
+	ret1, _ := ret[1].(bool)
+	return ret0, ret1
+}
+
+// GetUnbondingDelegation indicates an expected call of GetUnbondingDelegation.
+func (mr *MockStakingKeeperMockRecorder) GetUnbondingDelegation(ctx, delAddr, valAddr interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUnbondingDelegation", reflect.TypeOf((*MockStakingKeeper)(nil).GetUnbondingDelegation), ctx, delAddr, valAddr)
+}
+
+// GetUnbondingDelegations mocks base method.
+func (m *MockStakingKeeper) GetUnbondingDelegations(ctx types.Context, delegator types.AccAddress, maxRetrieve uint16) []types1.UnbondingDelegation {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetUnbondingDelegations", ctx, delegator, maxRetrieve)
+	ret0, _ := ret[0].([]types1.UnbondingDelegation)
+	return ret0
+}
+
+// GetUnbondingDelegations indicates an expected call of GetUnbondingDelegations.
+func (mr *MockStakingKeeperMockRecorder) GetUnbondingDelegations(ctx, delegator, maxRetrieve interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUnbondingDelegations", reflect.TypeOf((*MockStakingKeeper)(nil).GetUnbondingDelegations), ctx, delegator, maxRetrieve)
+}
+
+// GetValidator mocks base method.
+func (m *MockStakingKeeper) GetValidator(ctx types.Context, addr types.ValAddress) (types1.Validator, bool) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetValidator", ctx, addr)
+	ret0, _ := ret[0].(types1.Validator)
+	ret1, _ := ret[1].(bool)
+	return ret0, ret1
+}
+
+// GetValidator indicates an expected call of GetValidator.
+func (mr *MockStakingKeeperMockRecorder) GetValidator(ctx, addr interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetValidator", reflect.TypeOf((*MockStakingKeeper)(nil).GetValidator), ctx, addr)
+}
+
+// GetValidatorByConsAddr mocks base method.
+func (m *MockStakingKeeper) GetValidatorByConsAddr(ctx types.Context, consAddr types.ConsAddress) (types1.Validator, bool) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetValidatorByConsAddr", ctx, consAddr)
+	ret0, _ := ret[0].(types1.Validator)
+	ret1, _ := ret[1].(bool)
+	return ret0, ret1
+}
+
+// GetValidatorByConsAddr indicates an expected call of GetValidatorByConsAddr.
+func (mr *MockStakingKeeperMockRecorder) GetValidatorByConsAddr(ctx, consAddr interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetValidatorByConsAddr", reflect.TypeOf((*MockStakingKeeper)(nil).GetValidatorByConsAddr), ctx, consAddr)
+}
+
+// GetValidatorDelegations mocks base method.
+func (m *MockStakingKeeper) GetValidatorDelegations(ctx types.Context, valAddr types.ValAddress) []types1.Delegation {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetValidatorDelegations", ctx, valAddr)
+	ret0, _ := ret[0].([]types1.Delegation)
+	return ret0
+}
+
+// GetValidatorDelegations indicates an expected call of GetValidatorDelegations.
+func (mr *MockStakingKeeperMockRecorder) GetValidatorDelegations(ctx, valAddr interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetValidatorDelegations", reflect.TypeOf((*MockStakingKeeper)(nil).GetValidatorDelegations), ctx, valAddr)
+}
+
 // IterateBondedValidatorsByPower mocks base method.
-func (m *MockStakingKeeper) IterateBondedValidatorsByPower(arg0 types.Context, arg1 func(int64, types1.ValidatorI) bool) {
+func (m *MockStakingKeeper) IterateBondedValidatorsByPower(ctx types.Context, fn func(int64, types1.ValidatorI) bool) {
 	m.ctrl.T.Helper()
-	m.ctrl.Call(m, "IterateBondedValidatorsByPower", arg0, arg1)
+	m.ctrl.Call(m, "IterateBondedValidatorsByPower", ctx, fn)
 }
 
 // IterateBondedValidatorsByPower indicates an expected call of IterateBondedValidatorsByPower.
-func (mr *MockStakingKeeperMockRecorder) IterateBondedValidatorsByPower(arg0, arg1 interface{}) *gomock.Call {
+func (mr *MockStakingKeeperMockRecorder) IterateBondedValidatorsByPower(ctx, fn interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IterateBondedValidatorsByPower", reflect.TypeOf((*MockStakingKeeper)(nil).IterateBondedValidatorsByPower), arg0, arg1)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IterateBondedValidatorsByPower", reflect.TypeOf((*MockStakingKeeper)(nil).IterateBondedValidatorsByPower), ctx, fn)
 }
 
 // IterateDelegations mocks base method.
-func (m *MockStakingKeeper) IterateDelegations(ctx types.Context, delegator types.AccAddress, fn func(int64, types1.DelegationI) bool) {
+func (m *MockStakingKeeper) IterateDelegations(ctx types.Context, delAddr types.AccAddress, fn func(int64, types1.DelegationI) bool) {
 	m.ctrl.T.Helper()
-	m.ctrl.Call(m, "IterateDelegations", ctx, delegator, fn)
+	m.ctrl.Call(m, "IterateDelegations", ctx, delAddr, fn)
 }
 
 // IterateDelegations indicates an expected call of IterateDelegations.
-func (mr *MockStakingKeeperMockRecorder) IterateDelegations(ctx, delegator, fn interface{}) *gomock.Call {
+func (mr *MockStakingKeeperMockRecorder) IterateDelegations(ctx, delAddr, fn interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IterateDelegations", reflect.TypeOf((*MockStakingKeeper)(nil).IterateDelegations), ctx, delegator, fn)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IterateDelegations", reflect.TypeOf((*MockStakingKeeper)(nil).IterateDelegations), ctx, delAddr, fn)
+}
+
+// IterateDelegatorDelegations mocks base method.
+func (m *MockStakingKeeper) IterateDelegatorDelegations(ctx types.Context, delegator types.AccAddress, cb func(types1.Delegation) bool) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "IterateDelegatorDelegations", ctx, delegator, cb)
+}
+
+// IterateDelegatorDelegations indicates an expected call of IterateDelegatorDelegations.
+func (mr *MockStakingKeeperMockRecorder) IterateDelegatorDelegations(ctx, delegator, cb interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IterateDelegatorDelegations", reflect.TypeOf((*MockStakingKeeper)(nil).IterateDelegatorDelegations), ctx, delegator, cb)
+}
+
+// IterateLastValidators mocks base method.
+func (m *MockStakingKeeper) IterateLastValidators(ctx types.Context, fn func(int64, types1.ValidatorI) bool) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "IterateLastValidators", ctx, fn)
+}
+
+// IterateLastValidators indicates an expected call of IterateLastValidators.
+func (mr *MockStakingKeeperMockRecorder) IterateLastValidators(ctx, fn interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IterateLastValidators", reflect.TypeOf((*MockStakingKeeper)(nil).IterateLastValidators), ctx, fn)
+}
+
+// IterateValidators mocks base method.
+func (m *MockStakingKeeper) IterateValidators(ctx types.Context, fn func(int64, types1.ValidatorI) bool) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "IterateValidators", ctx, fn)
+}
+
+// IterateValidators indicates an expected call of IterateValidators.
+func (mr *MockStakingKeeperMockRecorder) IterateValidators(ctx, fn interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IterateValidators", reflect.TypeOf((*MockStakingKeeper)(nil).IterateValidators), ctx, fn)
+}
+
+// PowerReduction mocks base method.
+func (m *MockStakingKeeper) PowerReduction(ctx types.Context) math.Int {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PowerReduction", ctx)
+	ret0, _ := ret[0].(math.Int)
+	return ret0
+}
+
+// PowerReduction indicates an expected call of PowerReduction.
+func (mr *MockStakingKeeperMockRecorder) PowerReduction(ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PowerReduction", reflect.TypeOf((*MockStakingKeeper)(nil).PowerReduction), ctx)
 }
 
 // TokensFromConsensusPower mocks base method.
@@ -1052,17 +2235,73 @@ func (mr *MockStakingKeeperMockRecorder) TokensFromConsensusPower(ctx, power int
 }
 
 // TotalBondedTokens mocks base method.
-func (m *MockStakingKeeper) TotalBondedTokens(arg0 types.Context) math.Int {
+func (m *MockStakingKeeper) TotalBondedTokens(ctx types.Context) math.Int {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "TotalBondedTokens", arg0)
+	ret := m.ctrl.Call(m, "TotalBondedTokens", ctx)
 	ret0, _ := ret[0].(math.Int)
 	return ret0
 }
 
 // TotalBondedTokens indicates an expected call of TotalBondedTokens.
-func (mr *MockStakingKeeperMockRecorder) TotalBondedTokens(arg0 interface{}) *gomock.Call {
+func (mr *MockStakingKeeperMockRecorder) TotalBondedTokens(ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "TotalBondedTokens", reflect.TypeOf((*MockStakingKeeper)(nil).TotalBondedTokens), ctx)
+}
+
+// UnbondingTime mocks base method.
+func (m *MockStakingKeeper) UnbondingTime(ctx types.Context) time.Duration {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UnbondingTime", ctx)
+	ret0, _ := ret[0].(time.Duration)
+	return ret0
+}
+
+// UnbondingTime indicates an expected call of UnbondingTime.
+func (mr *MockStakingKeeperMockRecorder) UnbondingTime(ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UnbondingTime", reflect.TypeOf((*MockStakingKeeper)(nil).UnbondingTime), ctx)
+}
+
+// Validator mocks base method.
+func (m *MockStakingKeeper) Validator(ctx types.Context, addr types.ValAddress) types1.ValidatorI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Validator", ctx, addr)
+	ret0, _ := ret[0].(types1.ValidatorI)
+	return ret0
+}
+
+// Validator indicates an expected call of Validator.
+func (mr *MockStakingKeeperMockRecorder) Validator(ctx, addr interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Validator", reflect.TypeOf((*MockStakingKeeper)(nil).Validator), ctx, addr)
+}
+
+// ValidatorByConsAddr mocks base method.
+func (m *MockStakingKeeper) ValidatorByConsAddr(ctx types.Context, consAddr types.ConsAddress) types1.ValidatorI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ValidatorByConsAddr", ctx, consAddr)
+	ret0, _ := ret[0].(types1.ValidatorI)
+	return ret0
+}
+
+// ValidatorByConsAddr indicates an expected call of ValidatorByConsAddr.
+func (mr *MockStakingKeeperMockRecorder) ValidatorByConsAddr(ctx, consAddr interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ValidatorByConsAddr", reflect.TypeOf((*MockStakingKeeper)(nil).ValidatorByConsAddr), ctx, consAddr)
+}
+
+// ValidatorUnbondingTime mocks base method.
+func (m *MockStakingKeeper) ValidatorUnbondingTime(ctx types.Context, valAddr types.ValAddress) time.Duration {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ValidatorUnbondingTime", ctx, valAddr)
+	ret0, _ := ret[0].(time.Duration)
+	return ret0
+}
+
+// ValidatorUnbondingTime indicates an expected call of ValidatorUnbondingTime.
+func (mr *MockStakingKeeperMockRecorder) ValidatorUnbondingTime(ctx, valAddr interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "TotalBondedTokens", reflect.TypeOf((*MockStakingKeeper)(nil).TotalBondedTokens), arg0)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ValidatorUnbondingTime", reflect.TypeOf((*MockStakingKeeper)(nil).ValidatorUnbondingTime), ctx, valAddr)
 }
 
 // MockDistributionKeeper is a mock of DistributionKeeper interface.
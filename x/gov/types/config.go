@@ -4,11 +4,22 @@ package types
 type Config struct {
 	// MaxMetadataLen defines the maximum proposal metadata length.
 	MaxMetadataLen uint64
+
+	// MaxEarlyResolutionChecks defines the maximum number of proposals in their
+	// voting period checked for early resolution per block.
+	MaxEarlyResolutionChecks uint64
+
+	// ExportHistory includes the finished proposals and the execution receipts
+	// of the passed proposals in the exported genesis state, so that chain
+	// forks and migrations preserve the governance history. Only the proposals
+	// which are not finished are exported otherwise.
+	ExportHistory bool
 }
 
 // DefaultConfig returns the default config for gov.
 func DefaultConfig() Config {
 	return Config{
-		MaxMetadataLen: 255,
+		MaxMetadataLen:           255,
+		MaxEarlyResolutionChecks: 5,
 	}
 }
@@ -0,0 +1,22 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// executingProposalKey is the context key holding the ID of the proposal whose
+// messages are being executed.
+type executingProposalKey struct{}
+
+// WithExecutingProposal returns a copy of ctx recording that the messages of
+// the given proposal are being executed.
+func WithExecutingProposal(ctx sdk.Context, proposalID uint64) sdk.Context {
+	return ctx.WithValue(executingProposalKey{}, proposalID)
+}
+
+// ExecutingProposal returns the ID of the proposal whose messages are being
+// executed with ctx, if any.
+func ExecutingProposal(ctx sdk.Context) (uint64, bool) {
+	proposalID, ok := ctx.Value(executingProposalKey{}).(uint64)
+	return proposalID, ok
+}
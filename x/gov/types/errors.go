@@ -10,23 +10,35 @@ var (
 	ErrInactiveProposal      = errors.Register(ModuleName, 3, "inactive proposal")
 	ErrAlreadyActiveProposal = errors.Register(ModuleName, 4, "proposal already active")
 	// Errors 5 & 6 are legacy errors related to v1beta1.Proposal.
-	ErrInvalidProposalContent  = errors.Register(ModuleName, 5, "invalid proposal content")
-	ErrInvalidProposalType     = errors.Register(ModuleName, 6, "invalid proposal type")
-	ErrInvalidVote             = errors.Register(ModuleName, 7, "invalid vote option")
-	ErrInvalidGenesis          = errors.Register(ModuleName, 8, "invalid genesis state")
-	ErrNoProposalHandlerExists = errors.Register(ModuleName, 9, "no handler exists for proposal type")
-	ErrUnroutableProposalMsg   = errors.Register(ModuleName, 10, "proposal message not recognized by router")
-	ErrNoProposalMsgs          = errors.Register(ModuleName, 11, "no messages proposed")
-	ErrInvalidProposalMsg      = errors.Register(ModuleName, 12, "invalid proposal message")
-	ErrInvalidSigner           = errors.Register(ModuleName, 13, "expected gov account as only signer for proposal message")
-	ErrInvalidSignalMsg        = errors.Register(ModuleName, 14, "signal message is invalid")
-	ErrMetadataTooLong         = errors.Register(ModuleName, 15, "metadata too long")
-	ErrMinDepositTooSmall      = errors.Register(ModuleName, 16, "minimum deposit is too small")
-	ErrProposalNotFound        = errors.Register(ModuleName, 17, "proposal is not found")
-	ErrInvalidProposer         = errors.Register(ModuleName, 18, "invalid proposer")
-	ErrNoDeposits              = errors.Register(ModuleName, 19, "no deposits found")
-	ErrVotingPeriodEnded       = errors.Register(ModuleName, 20, "voting period already ended")
-	ErrInvalidProposal         = errors.Register(ModuleName, 21, "invalid proposal")
-	ErrDepositNotFound         = errors.Register(ModuleName, 22, "deposit is not found")
-	ErrVoteNotFound            = errors.Register(ModuleName, 23, "vote is not found")
+	ErrInvalidProposalContent       = errors.Register(ModuleName, 5, "invalid proposal content")
+	ErrInvalidProposalType          = errors.Register(ModuleName, 6, "invalid proposal type")
+	ErrInvalidVote                  = errors.Register(ModuleName, 7, "invalid vote option")
+	ErrInvalidGenesis               = errors.Register(ModuleName, 8, "invalid genesis state")
+	ErrNoProposalHandlerExists      = errors.Register(ModuleName, 9, "no handler exists for proposal type")
+	ErrUnroutableProposalMsg        = errors.Register(ModuleName, 10, "proposal message not recognized by router")
+	ErrNoProposalMsgs               = errors.Register(ModuleName, 11, "no messages proposed")
+	ErrInvalidProposalMsg           = errors.Register(ModuleName, 12, "invalid proposal message")
+	ErrInvalidSigner                = errors.Register(ModuleName, 13, "expected gov account as only signer for proposal message")
+	ErrInvalidSignalMsg             = errors.Register(ModuleName, 14, "signal message is invalid")
+	ErrMetadataTooLong              = errors.Register(ModuleName, 15, "metadata too long")
+	ErrMinDepositTooSmall           = errors.Register(ModuleName, 16, "minimum deposit is too small")
+	ErrProposalNotFound             = errors.Register(ModuleName, 17, "proposal is not found")
+	ErrInvalidProposer              = errors.Register(ModuleName, 18, "invalid proposer")
+	ErrNoDeposits                   = errors.Register(ModuleName, 19, "no deposits found")
+	ErrVotingPeriodEnded            = errors.Register(ModuleName, 20, "voting period already ended")
+	ErrInvalidProposal              = errors.Register(ModuleName, 21, "invalid proposal")
+	ErrDepositNotFound              = errors.Register(ModuleName, 22, "deposit is not found")
+	ErrVoteNotFound                 = errors.Register(ModuleName, 23, "vote is not found")
+	ErrNoParamsValidator            = errors.Register(ModuleName, 24, "no params validator registered for message")
+	ErrParamsUpdateFailed           = errors.Register(ModuleName, 25, "params update failed")
+	ErrInvalidExecutionAuthority    = errors.Register(ModuleName, 26, "invalid execution authority")
+	ErrInvalidConstitutionAmendment = errors.Register(ModuleName, 27, "invalid constitution amendment")
+	ErrInvalidDepositDenom          = errors.Register(ModuleName, 28, "invalid deposit denom")
+	ErrInvalidVoteCommitment        = errors.Register(ModuleName, 29, "invalid vote commitment")
+	ErrVoteCommitmentNotFound       = errors.Register(ModuleName, 30, "vote commitment is not found")
+	ErrCommitRevealVoting           = errors.Register(ModuleName, 31, "invalid commit-reveal voting")
+	ErrExecutionReceiptNotFound     = errors.Register(ModuleName, 32, "execution receipt is not found")
+	ErrInvalidMetadataSchema        = errors.Register(ModuleName, 33, "invalid metadata schema")
+	ErrMetadataSchemaNotFound       = errors.Register(ModuleName, 34, "metadata schema is not found")
+	ErrMetadataNotConforming        = errors.Register(ModuleName, 35, "metadata does not conform to its schema")
 )
@@ -5,15 +5,38 @@ const (
 	EventTypeSubmitProposal   = "submit_proposal"
 	EventTypeProposalDeposit  = "proposal_deposit"
 	EventTypeProposalVote     = "proposal_vote"
+	EventTypeRetractVote      = "retract_vote"
+	EventTypeCommitVote       = "commit_vote"
 	EventTypeInactiveProposal = "inactive_proposal"
 	EventTypeActiveProposal   = "active_proposal"
 	EventTypeCancelProposal   = "cancel_proposal"
 
+	EventTypeDiscussionPeriodStart  = "discussion_period_start"
+	EventTypeUpdateProposalMetadata = "update_proposal_metadata"
+	EventTypeAmendProposal          = "amend_proposal"
+	EventTypeWithdrawDeposit        = "withdraw_deposit"
+
+	EventTypeDepositPeriodExtended = "deposit_period_extended"
+
+	EventTypeValidatorVoteBreakdown = "validator_vote_breakdown"
+
+	EventTypeAmendConstitution = "amend_constitution"
+
+	EventTypeRegisterMetadataSchema = "register_metadata_schema"
+
+	EventTypeProposalInvalidated = "proposal_invalidated"
+
 	AttributeKeyProposalResult              = "proposal_result"
 	AttributeKeyOption                      = "option"
 	AttributeKeyProposalID                  = "proposal_id"
 	AttributeKeyProposalMessages            = "proposal_messages" // Msg type_urls in the proposal
 	AttributeKeyVotingPeriodStart           = "voting_period_start"
+	AttributeKeyDepositEndTime              = "deposit_end_time"
+	AttributeKeyDiscussionEndTime           = "discussion_end_time"
+	AttributeKeyDepositor                   = "depositor"
+	AttributeKeyAmendmentID                 = "amendment_id"
+	AttributeKeyMetadataSchemaID            = "metadata_schema_id"
+	AttributeKeyMetadataSchemaHash          = "metadata_schema_hash"
 	AttributeKeyProposalLog                 = "proposal_log"                // log of proposal execution
 	AttributeValueProposalDropped           = "proposal_dropped"            // didn't meet min deposit
 	AttributeValueProposalPassed            = "proposal_passed"             // met vote quorum
@@ -22,6 +45,14 @@ const (
 	AttributeValueProposalFailed            = "proposal_failed"             // error on proposal handler
 	AttributeValueProposalCanceled          = "proposal_canceled"           // error on proposal handler
 
+	AttributeKeyValidator       = "validator"
+	AttributeKeyInheritedPower  = "inherited_power"
+	AttributeKeyOverriddenPower = "overridden_power"
+
+	AttributeKeyMsgIndex   = "msg_index"
+	AttributeKeyMsgTypeURL = "msg_type_url"
+	AttributeKeyReason     = "reason"
+
 	AttributeKeyProposalType   = "proposal_type"
 	AttributeSignalTitle       = "signal_title"
 	AttributeSignalDescription = "signal_description"
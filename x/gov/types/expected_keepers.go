@@ -18,16 +18,7 @@ type ParamSubspace interface {
 
 // StakingKeeper expected staking keeper (Validator and Delegator sets) (noalias)
 type StakingKeeper interface {
-	// iterate through bonded validators by operator address, execute func for each validator
-	IterateBondedValidatorsByPower(
-		sdk.Context, func(index int64, validator stakingtypes.ValidatorI) (stop bool),
-	)
-
-	TotalBondedTokens(sdk.Context) math.Int // total bonded tokens within the validator set
-	IterateDelegations(
-		ctx sdk.Context, delegator sdk.AccAddress,
-		fn func(index int64, delegation stakingtypes.DelegationI) (stop bool),
-	)
+	stakingtypes.ViewKeeper
 }
 
 // DistributionKeeper defines the expected distribution keeper (noalias)
@@ -58,6 +49,17 @@ type BankKeeper interface {
 	SendCoinsFromModuleToAccount(ctx context.Context, senderModule string, recipientAddr sdk.AccAddress, amt sdk.Coins) error
 	SendCoinsFromAccountToModule(ctx context.Context, senderAddr sdk.AccAddress, recipientModule string, amt sdk.Coins) error
 	BurnCoins(ctx context.Context, name string, amt sdk.Coins) error
+
+	AddModuleEscrow(ctx context.Context, moduleName, purpose string, amt sdk.Coins) error
+	SubModuleEscrow(ctx context.Context, moduleName, purpose string, amt sdk.Coins) error
+}
+
+// DepositRatioOracle provides the exchange ratios of the accepted deposit
+// denoms which use an oracle ratio.
+type DepositRatioOracle interface {
+	// DepositRatio returns the number of base denom tokens one token of denom is
+	// worth. It returns false if no ratio is available.
+	DepositRatio(ctx context.Context, denom, baseDenom string) (math.LegacyDec, bool)
 }
 
 // Event Hooks
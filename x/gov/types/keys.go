@@ -2,6 +2,7 @@ package types
 
 import (
 	"encoding/binary"
+	"fmt"
 	"time"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -16,6 +17,9 @@ const (
 	// StoreKey is the store key string for gov
 	StoreKey = ModuleName
 
+	// TStoreKey is the transient store key string for gov
+	TStoreKey = "transient_" + ModuleName
+
 	// RouterKey is the message route for gov
 	RouterKey = ModuleName
 )
@@ -25,39 +29,84 @@ const (
 //
 // - 0x00<proposalID_Bytes>: Proposal
 //
-// - 0x01<endTime_Bytes><proposalID_Bytes>: activeProposalID
+// - 0x01<bucketTime_Bytes>: []<endTime_Bytes><proposalID_Bytes> of the active proposals
 //
-// - 0x02<endTime_Bytes><proposalID_Bytes>: inactiveProposalID
+// - 0x02<bucketTime_Bytes>: []<endTime_Bytes><proposalID_Bytes> of the inactive proposals
 //
 // - 0x03: nextProposalID
 //
 // - 0x04<proposalID_Bytes>: []byte{0x01} if proposalID is in the voting period
 //
+// - 0x05<bucketTime_Bytes>: []<endTime_Bytes><proposalID_Bytes> of the discussion proposals
+//
+// - 0x06: nextEarlyResolutionProposalID
+//
 // - 0x10<proposalID_Bytes><depositorAddrLen (1 Byte)><depositorAddr_Bytes>: Deposit
 //
 // - 0x20<proposalID_Bytes><voterAddrLen (1 Byte)><voterAddr_Bytes>: Voter
 //
+// - 0x21<proposalID_Bytes><voterAddrLen (1 Byte)><voterAddr_Bytes>: VoteCommitment
+//
+// - 0x22<voterAddrLen (1 Byte)><voterAddr_Bytes><proposalID_Bytes>: []byte{0x01} of the proposals a voter voted on
+//
 // - 0x30: Params
+//
+// - 0x40<amendmentID_Bytes>: ConstitutionAmendment
+//
+// - 0x50<proposalID_Bytes><valAddrLen (1 Byte)><valAddr_Bytes>: ValidatorVotedShares
+//
+// - 0x60<proposalID_Bytes>: ProposalExecutionReceipt
+//
+// - 0x70<schemaID_Bytes>: MetadataSchema
 var (
 	ProposalsKeyPrefix            = []byte{0x00}
 	ActiveProposalQueuePrefix     = []byte{0x01}
 	InactiveProposalQueuePrefix   = []byte{0x02}
 	ProposalIDKey                 = []byte{0x03}
 	VotingPeriodProposalKeyPrefix = []byte{0x04}
+	DiscussionProposalQueuePrefix = []byte{0x05}
+	EarlyResolutionCursorKey      = []byte{0x06}
 
 	DepositsKeyPrefix = []byte{0x10}
 
-	VotesKeyPrefix = []byte{0x20}
+	VotesKeyPrefix           = []byte{0x20}
+	VoteCommitmentsKeyPrefix = []byte{0x21}
+	VoterProposalsKeyPrefix  = []byte{0x22}
 
 	// ParamsKey is the key to query all gov params
 	ParamsKey = []byte{0x30}
 
+	ConstitutionAmendmentsKeyPrefix = []byte{0x40}
+
+	VotedSharesKeyPrefix = []byte{0x50}
+
+	ExecutionReceiptsKeyPrefix = []byte{0x60}
+
+	MetadataSchemasKeyPrefix = []byte{0x70}
+
 	// KeyConstitution is the key string used to store the chain's constitution
 	KeyConstitution = []byte("constitution")
 )
 
+// Keys for governance transient store
+// Items are stored with the following key: values
+//
+// - 0x00<proposalID_Bytes><valAddrLen (1 Byte)><valAddr_Bytes>: ValidatorVoteBreakdown
+var (
+	ValidatorVoteBreakdownsKeyPrefix = []byte{0x00}
+)
+
+// ProposalQueueBucketDuration is the duration of the time buckets of the
+// proposal queues. The proposals of a queue ending within the same bucket are
+// stored under a single key, so that the EndBlocker scans one key per bucket
+// instead of one key per proposal.
+const ProposalQueueBucketDuration = time.Minute
+
 var lenTime = len(sdk.FormatTimeBytes(time.Now()))
 
+// lenProposalQueueEntry is the length of an entry of a proposal queue bucket.
+var lenProposalQueueEntry = lenTime + 8
+
 // GetProposalIDBytes returns the byte representation of the proposalID
 func GetProposalIDBytes(proposalID uint64) (proposalIDBz []byte) {
 	proposalIDBz = make([]byte, 8)
@@ -100,6 +149,60 @@ func InactiveProposalQueueKey(proposalID uint64, endTime time.Time) []byte {
 	return append(InactiveProposalByTimeKey(endTime), GetProposalIDBytes(proposalID)...)
 }
 
+// DiscussionProposalByTimeKey gets the discussion proposal queue key by endTime
+func DiscussionProposalByTimeKey(endTime time.Time) []byte {
+	return append(DiscussionProposalQueuePrefix, sdk.FormatTimeBytes(endTime)...)
+}
+
+// DiscussionProposalQueueKey returns the key for a proposalID in the discussionProposalQueue
+func DiscussionProposalQueueKey(proposalID uint64, endTime time.Time) []byte {
+	return append(DiscussionProposalByTimeKey(endTime), GetProposalIDBytes(proposalID)...)
+}
+
+// ProposalQueueBucket returns the start of the time bucket of the proposal
+// queues containing endTime.
+func ProposalQueueBucket(endTime time.Time) time.Time {
+	return endTime.UTC().Truncate(ProposalQueueBucketDuration)
+}
+
+// ActiveProposalQueueBucketKey returns the key of the bucket of the active proposal queue containing endTime
+func ActiveProposalQueueBucketKey(endTime time.Time) []byte {
+	return ActiveProposalByTimeKey(ProposalQueueBucket(endTime))
+}
+
+// InactiveProposalQueueBucketKey returns the key of the bucket of the inactive proposal queue containing endTime
+func InactiveProposalQueueBucketKey(endTime time.Time) []byte {
+	return InactiveProposalByTimeKey(ProposalQueueBucket(endTime))
+}
+
+// DiscussionProposalQueueBucketKey returns the key of the bucket of the discussion proposal queue containing endTime
+func DiscussionProposalQueueBucketKey(endTime time.Time) []byte {
+	return DiscussionProposalByTimeKey(ProposalQueueBucket(endTime))
+}
+
+// ProposalQueueEntry returns the entry of a proposal in a proposal queue
+// bucket, i.e. its end time followed by its ID, so that the entries sort by end
+// time then ID. The entry prefixed with the queue prefix is the proposal queue
+// key of the proposal, e.g. ActiveProposalQueueKey.
+func ProposalQueueEntry(proposalID uint64, endTime time.Time) []byte {
+	return append(sdk.FormatTimeBytes(endTime), GetProposalIDBytes(proposalID)...)
+}
+
+// SplitProposalQueueBucket splits the value of a proposal queue bucket into its
+// entries, in order.
+func SplitProposalQueueBucket(bz []byte) [][]byte {
+	if len(bz)%lenProposalQueueEntry != 0 {
+		panic(fmt.Sprintf("invalid proposal queue bucket length %d", len(bz)))
+	}
+
+	entries := make([][]byte, 0, len(bz)/lenProposalQueueEntry)
+	for i := 0; i < len(bz); i += lenProposalQueueEntry {
+		entries = append(entries, bz[i:i+lenProposalQueueEntry])
+	}
+
+	return entries
+}
+
 // DepositsKey gets the first part of the deposits key based on the proposalID
 func DepositsKey(proposalID uint64) []byte {
 	return append(DepositsKeyPrefix, GetProposalIDBytes(proposalID)...)
@@ -110,6 +213,12 @@ func DepositKey(proposalID uint64, depositorAddr sdk.AccAddress) []byte {
 	return append(DepositsKey(proposalID), address.MustLengthPrefix(depositorAddr.Bytes())...)
 }
 
+// DepositEscrowPurpose returns the purpose the deposits on a proposal are
+// escrowed for by the gov module account, as tracked by x/bank.
+func DepositEscrowPurpose(proposalID uint64) string {
+	return fmt.Sprintf("proposal/%d", proposalID)
+}
+
 // VotesKey gets the first part of the votes key based on the proposalID
 func VotesKey(proposalID uint64) []byte {
 	return append(VotesKeyPrefix, GetProposalIDBytes(proposalID)...)
@@ -120,6 +229,61 @@ func VoteKey(proposalID uint64, voterAddr sdk.AccAddress) []byte {
 	return append(VotesKey(proposalID), address.MustLengthPrefix(voterAddr.Bytes())...)
 }
 
+// VoterProposalsKey gets the first part of the voter proposals key based on the voter address
+func VoterProposalsKey(voterAddr sdk.AccAddress) []byte {
+	return append(VoterProposalsKeyPrefix, address.MustLengthPrefix(voterAddr.Bytes())...)
+}
+
+// VoterProposalKey key of a specific proposal a voter voted on from the store
+func VoterProposalKey(voterAddr sdk.AccAddress, proposalID uint64) []byte {
+	return append(VoterProposalsKey(voterAddr), GetProposalIDBytes(proposalID)...)
+}
+
+// VoteCommitmentsKey gets the first part of the vote commitments key based on the proposalID
+func VoteCommitmentsKey(proposalID uint64) []byte {
+	return append(VoteCommitmentsKeyPrefix, GetProposalIDBytes(proposalID)...)
+}
+
+// VoteCommitmentKey key of a specific vote commitment from the store
+func VoteCommitmentKey(proposalID uint64, voterAddr sdk.AccAddress) []byte {
+	return append(VoteCommitmentsKey(proposalID), address.MustLengthPrefix(voterAddr.Bytes())...)
+}
+
+// ValidatorVoteBreakdownsKey gets the first part of the validator vote breakdowns key based on the proposalID
+func ValidatorVoteBreakdownsKey(proposalID uint64) []byte {
+	return append(ValidatorVoteBreakdownsKeyPrefix, GetProposalIDBytes(proposalID)...)
+}
+
+// ValidatorVoteBreakdownKey key of a specific validator vote breakdown from the transient store
+func ValidatorVoteBreakdownKey(proposalID uint64, valAddr sdk.ValAddress) []byte {
+	return append(ValidatorVoteBreakdownsKey(proposalID), address.MustLengthPrefix(valAddr.Bytes())...)
+}
+
+// ConstitutionAmendmentKey key of a specific constitution amendment from the store
+func ConstitutionAmendmentKey(amendmentID uint64) []byte {
+	return append(ConstitutionAmendmentsKeyPrefix, sdk.Uint64ToBigEndian(amendmentID)...)
+}
+
+// VotedSharesKey gets the first part of the validator voted shares key based on the proposalID
+func VotedSharesKey(proposalID uint64) []byte {
+	return append(VotedSharesKeyPrefix, GetProposalIDBytes(proposalID)...)
+}
+
+// ValidatorVotedSharesKey key of the voted shares of a specific validator from the store
+func ValidatorVotedSharesKey(proposalID uint64, valAddr sdk.ValAddress) []byte {
+	return append(VotedSharesKey(proposalID), address.MustLengthPrefix(valAddr.Bytes())...)
+}
+
+// ExecutionReceiptKey gets the key of the execution receipt of a proposal
+func ExecutionReceiptKey(proposalID uint64) []byte {
+	return append(ExecutionReceiptsKeyPrefix, GetProposalIDBytes(proposalID)...)
+}
+
+// MetadataSchemaKey key of a specific metadata schema from the store
+func MetadataSchemaKey(schemaID uint64) []byte {
+	return append(MetadataSchemasKeyPrefix, sdk.Uint64ToBigEndian(schemaID)...)
+}
+
 // Split keys function; used for iterators
 
 // SplitProposalKey split the proposal key and returns the proposal id
@@ -139,6 +303,18 @@ func SplitInactiveProposalQueueKey(key []byte) (proposalID uint64, endTime time.
 	return splitKeyWithTime(key)
 }
 
+// SplitDiscussionProposalQueueKey split the discussion proposal key and returns the proposal id and endTime
+func SplitDiscussionProposalQueueKey(key []byte) (proposalID uint64, endTime time.Time) {
+	return splitKeyWithTime(key)
+}
+
+// SplitProposalQueueEntry split a proposal queue entry and returns the proposal id and endTime
+func SplitProposalQueueEntry(entry []byte) (proposalID uint64, endTime time.Time) {
+	kv.AssertKeyLength(entry, lenProposalQueueEntry)
+
+	return splitTimeWithID(entry)
+}
+
 // SplitKeyDeposit split the deposits key and returns the proposal id and depositor address
 func SplitKeyDeposit(key []byte) (proposalID uint64, depositorAddr sdk.AccAddress) {
 	return splitKeyWithAddress(key)
@@ -154,12 +330,16 @@ func SplitKeyVote(key []byte) (proposalID uint64, voterAddr sdk.AccAddress) {
 func splitKeyWithTime(key []byte) (proposalID uint64, endTime time.Time) {
 	kv.AssertKeyLength(key[1:], 8+lenTime)
 
-	endTime, err := sdk.ParseTimeBytes(key[1 : 1+lenTime])
+	return splitTimeWithID(key[1:])
+}
+
+func splitTimeWithID(bz []byte) (proposalID uint64, endTime time.Time) {
+	endTime, err := sdk.ParseTimeBytes(bz[:lenTime])
 	if err != nil {
 		panic(err)
 	}
 
-	proposalID = GetProposalIDFromBytes(key[1+lenTime:])
+	proposalID = GetProposalIDFromBytes(bz[lenTime:])
 	return
 }
 
@@ -31,6 +31,21 @@ func TestProposalKeys(t *testing.T) {
 	require.Equal(t, int(proposalID), 3)
 	require.True(t, now.Equal(expTime))
 
+	// proposal queue buckets
+	bucket := ProposalQueueBucket(now)
+	require.True(t, !bucket.After(now) && now.Sub(bucket) < ProposalQueueBucketDuration)
+	require.Equal(t, ActiveProposalQueueBucketKey(bucket), ActiveProposalQueueBucketKey(bucket.Add(ProposalQueueBucketDuration-1)))
+	require.NotEqual(t, ActiveProposalQueueBucketKey(bucket), ActiveProposalQueueBucketKey(bucket.Add(ProposalQueueBucketDuration)))
+
+	entry := ProposalQueueEntry(3, now)
+	require.Equal(t, ActiveProposalQueueKey(3, now), append(ActiveProposalQueuePrefix, entry...))
+	entries := SplitProposalQueueBucket(append(ProposalQueueEntry(3, now), ProposalQueueEntry(4, now)...))
+	require.Len(t, entries, 2)
+	proposalID, expTime = SplitProposalQueueEntry(entries[1])
+	require.Equal(t, int(proposalID), 4)
+	require.True(t, now.Equal(expTime))
+	require.Panics(t, func() { SplitProposalQueueBucket(entry[1:]) })
+
 	// invalid key
 	require.Panics(t, func() { SplitProposalKey([]byte("test")) })
 	require.Panics(t, func() { SplitInactiveProposalQueueKey([]byte("test")) })
@@ -0,0 +1,22 @@
+package types
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// ParamsValidator validates a module parameters update message without
+// applying it. It is used by MsgBatchUpdateParams to check every update of a
+// batch before any of them is executed, and therefore must not write to state.
+type ParamsValidator func(ctx context.Context, msg sdk.Msg) error
+
+// ParamsValidatorRoute registers a ParamsValidator for the parameters update
+// message identified by MsgTypeURL.
+type ParamsValidatorRoute struct {
+	MsgTypeURL string
+	Validator  ParamsValidator
+}
+
+// IsManyPerContainerType implements the depinject.ManyPerContainerType interface.
+func (ParamsValidatorRoute) IsManyPerContainerType() {}
@@ -0,0 +1,23 @@
+package types
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// ProposalMsgValidator checks that a proposal message can still be executed,
+// e.g. that the validator it targets still exists. It is run against the
+// messages of a proposal when its voting period is about to start, and must
+// therefore be cheap and must not write to state.
+type ProposalMsgValidator func(ctx context.Context, msg sdk.Msg) error
+
+// ProposalMsgValidatorRoute registers a ProposalMsgValidator for the proposal
+// message identified by MsgTypeURL.
+type ProposalMsgValidatorRoute struct {
+	MsgTypeURL string
+	Validator  ProposalMsgValidator
+}
+
+// IsManyPerContainerType implements the depinject.ManyPerContainerType interface.
+func (ProposalMsgValidatorRoute) IsManyPerContainerType() {}
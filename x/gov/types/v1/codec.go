@@ -20,6 +20,15 @@ func RegisterLegacyAminoCodec(cdc *codec.LegacyAmino) {
 	legacy.RegisterAminoMsg(cdc, &MsgVoteWeighted{}, "cosmos-sdk/v1/MsgVoteWeighted")
 	legacy.RegisterAminoMsg(cdc, &MsgExecLegacyContent{}, "cosmos-sdk/v1/MsgExecLegacyContent")
 	legacy.RegisterAminoMsg(cdc, &MsgUpdateParams{}, "cosmos-sdk/x/gov/v1/MsgUpdateParams")
+	legacy.RegisterAminoMsg(cdc, &MsgBatchUpdateParams{}, "cosmos-sdk/v1/MsgBatchUpdateParams")
+	legacy.RegisterAminoMsg(cdc, &MsgUpdateProposalMetadata{}, "cosmos-sdk/v1/MsgUpdateProposalMetadata")
+	legacy.RegisterAminoMsg(cdc, &MsgWithdrawDeposit{}, "cosmos-sdk/v1/MsgWithdrawDeposit")
+	legacy.RegisterAminoMsg(cdc, &MsgAmendConstitution{}, "cosmos-sdk/v1/MsgAmendConstitution")
+	legacy.RegisterAminoMsg(cdc, &MsgRetractVote{}, "cosmos-sdk/v1/MsgRetractVote")
+	legacy.RegisterAminoMsg(cdc, &MsgCommitVote{}, "cosmos-sdk/v1/MsgCommitVote")
+	legacy.RegisterAminoMsg(cdc, &MsgRevealVote{}, "cosmos-sdk/v1/MsgRevealVote")
+	legacy.RegisterAminoMsg(cdc, &MsgAmendProposal{}, "cosmos-sdk/v1/MsgAmendProposal")
+	legacy.RegisterAminoMsg(cdc, &MsgRegisterMetadataSchema{}, "cosmos-sdk/v1/MsgRegisterMetadataSchema")
 }
 
 // RegisterInterfaces registers the interfaces types with the Interface Registry.
@@ -31,6 +40,15 @@ func RegisterInterfaces(registry codectypes.InterfaceRegistry) {
 		&MsgDeposit{},
 		&MsgExecLegacyContent{},
 		&MsgUpdateParams{},
+		&MsgBatchUpdateParams{},
+		&MsgUpdateProposalMetadata{},
+		&MsgWithdrawDeposit{},
+		&MsgAmendConstitution{},
+		&MsgRetractVote{},
+		&MsgCommitVote{},
+		&MsgRevealVote{},
+		&MsgAmendProposal{},
+		&MsgRegisterMetadataSchema{},
 	)
 
 	msgservice.RegisterMsgServiceDesc(registry, &_Msg_serviceDesc)
@@ -0,0 +1,42 @@
+package v1
+
+import (
+	"crypto/sha256"
+	"encoding/binary"
+	"fmt"
+
+	"cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/address"
+)
+
+// NewVoteCommitment creates a new VoteCommitment instance
+func NewVoteCommitment(proposalID uint64, voter sdk.AccAddress, commitment []byte) VoteCommitment {
+	return VoteCommitment{ProposalId: proposalID, Voter: voter.String(), Commitment: commitment}
+}
+
+// VoteCommitmentHash returns the commitment to a vote on a commit-reveal
+// proposal: the SHA-256 hash of the big-endian proposal ID, the
+// length-prefixed voter address, each option as a big-endian uint32 followed
+// by its length-prefixed canonical weight, and the salt.
+func VoteCommitmentHash(proposalID uint64, voter sdk.AccAddress, options WeightedVoteOptions, salt []byte) ([]byte, error) {
+	hasher := sha256.New()
+	hasher.Write(sdk.Uint64ToBigEndian(proposalID))
+	hasher.Write(address.MustLengthPrefix(voter))
+
+	for _, option := range options {
+		weight, err := math.LegacyNewDecFromStr(option.Weight)
+		if err != nil {
+			return nil, fmt.Errorf("invalid weight %s of option %s: %w", option.Weight, option.Option, err)
+		}
+
+		bz := make([]byte, 4)
+		binary.BigEndian.PutUint32(bz, uint32(option.Option))
+		hasher.Write(bz)
+		hasher.Write(address.MustLengthPrefix([]byte(weight.String())))
+	}
+
+	hasher.Write(salt)
+	return hasher.Sum(nil), nil
+}
@@ -1,7 +1,9 @@
 package v1
 
 import (
+	"crypto/sha256"
 	"errors"
+	"fmt"
 
 	"github.com/cosmos/cosmos-sdk/codec/types"
 )
@@ -33,6 +35,75 @@ func ValidateGenesis(data *GenesisState) error {
 		return errors.New("starting proposal id must be greater than 0")
 	}
 
+	amendmentIDs := make(map[uint64]bool, len(data.ConstitutionAmendments))
+	for _, amendment := range data.ConstitutionAmendments {
+		if amendmentIDs[amendment.Id] {
+			return fmt.Errorf("duplicate constitution amendment id: %d", amendment.Id)
+		}
+		amendmentIDs[amendment.Id] = true
+	}
+
+	for _, commitment := range data.VoteCommitments {
+		if len(commitment.Commitment) != sha256.Size {
+			return fmt.Errorf("invalid vote commitment length of voter %s on proposal %d: %d", commitment.Voter, commitment.ProposalId, len(commitment.Commitment))
+		}
+	}
+
+	proposals := make(map[uint64]*Proposal, len(data.Proposals))
+	for _, proposal := range data.Proposals {
+		proposals[proposal.Id] = proposal
+	}
+
+	receiptIDs := make(map[uint64]bool, len(data.ExecutionReceipts))
+	for _, receipt := range data.ExecutionReceipts {
+		if receiptIDs[receipt.ProposalId] {
+			return fmt.Errorf("duplicate execution receipt for proposal: %d", receipt.ProposalId)
+		}
+		receiptIDs[receipt.ProposalId] = true
+
+		proposal, ok := proposals[receipt.ProposalId]
+		if !ok || (proposal.Status != StatusPassed && proposal.Status != StatusFailed) {
+			return fmt.Errorf("execution receipt of proposal %d which is not a passed proposal", receipt.ProposalId)
+		}
+	}
+
+	schemaIDs := make(map[uint64]bool, len(data.MetadataSchemas))
+	for _, schema := range data.MetadataSchemas {
+		if schema.Id == 0 {
+			return errors.New("metadata schema id must be greater than 0")
+		}
+		if schemaIDs[schema.Id] {
+			return fmt.Errorf("duplicate metadata schema id: %d", schema.Id)
+		}
+		schemaIDs[schema.Id] = true
+
+		if err := schema.ValidateBasic(); err != nil {
+			return fmt.Errorf("invalid metadata schema %d: %w", schema.Id, err)
+		}
+	}
+
+	assertSchema := func(schemaID uint64, kind string, proposalID uint64) error {
+		if schemaID != 0 && !schemaIDs[schemaID] {
+			return fmt.Errorf("%s of proposal %d references unknown metadata schema %d", kind, proposalID, schemaID)
+		}
+		return nil
+	}
+	for _, proposal := range data.Proposals {
+		if err := assertSchema(proposal.MetadataSchemaId, "metadata", proposal.Id); err != nil {
+			return err
+		}
+	}
+	for _, vote := range data.Votes {
+		if err := assertSchema(vote.MetadataSchemaId, "vote", vote.ProposalId); err != nil {
+			return err
+		}
+	}
+	for _, deposit := range data.Deposits {
+		if err := assertSchema(deposit.MetadataSchemaId, "deposit", deposit.ProposalId); err != nil {
+			return err
+		}
+	}
+
 	return data.Params.ValidateBasic()
 }
 
@@ -46,12 +46,28 @@ type GenesisState struct {
 	// Since: cosmos-sdk 0.47
 	Params *Params `protobuf:"bytes,8,opt,name=params,proto3" json:"params,omitempty"`
 	// The constitution allows builders to lay a foundation and define purpose.
-	// This is an immutable string set in genesis.
-	// There are no amendments, to go outside of scope, just fork.
-	// constitution is an immutable string in genesis for a chain builder to lay out their vision, ideas and ideals.
+	// constitution is a string set in genesis for a chain builder to lay out their vision, ideas and ideals.
+	// It can only be amended by constitution amendment proposals.
 	//
 	// Since: cosmos-sdk 0.48
 	Constitution string `protobuf:"bytes,9,opt,name=constitution,proto3" json:"constitution,omitempty"`
+	// constitution_amendments defines the amendments made to the constitution.
+	//
+	// Since: cosmos-sdk 0.48
+	ConstitutionAmendments []*ConstitutionAmendment `protobuf:"bytes,10,rep,name=constitution_amendments,json=constitutionAmendments,proto3" json:"constitution_amendments,omitempty"`
+	// vote_commitments defines the unrevealed vote commitments present at genesis.
+	//
+	// Since: cosmos-sdk 0.48
+	VoteCommitments []*VoteCommitment `protobuf:"bytes,11,rep,name=vote_commitments,json=voteCommitments,proto3" json:"vote_commitments,omitempty"`
+	// execution_receipts defines the execution receipts of the passed proposals
+	// present at genesis.
+	//
+	// Since: cosmos-sdk 0.48
+	ExecutionReceipts []*ProposalExecutionReceipt `protobuf:"bytes,12,rep,name=execution_receipts,json=executionReceipts,proto3" json:"execution_receipts,omitempty"`
+	// metadata_schemas defines the registered metadata schemas.
+	//
+	// Since: cosmos-sdk 0.48
+	MetadataSchemas []*MetadataSchema `protobuf:"bytes,13,rep,name=metadata_schemas,json=metadataSchemas,proto3" json:"metadata_schemas,omitempty"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -153,6 +169,34 @@ func (m *GenesisState) GetConstitution() string {
 	return ""
 }
 
+func (m *GenesisState) GetConstitutionAmendments() []*ConstitutionAmendment {
+	if m != nil {
+		return m.ConstitutionAmendments
+	}
+	return nil
+}
+
+func (m *GenesisState) GetVoteCommitments() []*VoteCommitment {
+	if m != nil {
+		return m.VoteCommitments
+	}
+	return nil
+}
+
+func (m *GenesisState) GetExecutionReceipts() []*ProposalExecutionReceipt {
+	if m != nil {
+		return m.ExecutionReceipts
+	}
+	return nil
+}
+
+func (m *GenesisState) GetMetadataSchemas() []*MetadataSchema {
+	if m != nil {
+		return m.MetadataSchemas
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*GenesisState)(nil), "cosmos.gov.v1.GenesisState")
 }
@@ -160,31 +204,39 @@ func init() {
 func init() { proto.RegisterFile("cosmos/gov/v1/genesis.proto", fileDescriptor_ef7cfd15e3ded621) }
 
 var fileDescriptor_ef7cfd15e3ded621 = []byte{
-	// 379 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x6c, 0x92, 0xcd, 0x4e, 0xfa, 0x40,
-	0x14, 0xc5, 0x19, 0xbe, 0xfe, 0x30, 0x94, 0xff, 0x62, 0xfc, 0xa0, 0x01, 0xd3, 0x34, 0xac, 0x6a,
-	0x0c, 0xad, 0x60, 0x7c, 0x00, 0x09, 0x86, 0xb8, 0x23, 0xd5, 0xb8, 0x70, 0x43, 0x0a, 0x6d, 0x6a,
-	0x23, 0xf4, 0x36, 0x9d, 0x61, 0x22, 0x6f, 0xe1, 0x63, 0xb9, 0x64, 0xe9, 0xd2, 0xc0, 0x63, 0xb8,
-	0x31, 0xcc, 0xb4, 0x02, 0xd5, 0xd5, 0x24, 0xf7, 0xfc, 0xce, 0x99, 0x93, 0x9b, 0x8b, 0x5b, 0x53,
-	0xa0, 0x73, 0xa0, 0x96, 0x0f, 0xdc, 0xe2, 0x5d, 0xcb, 0xf7, 0x42, 0x8f, 0x06, 0xd4, 0x8c, 0x62,
-	0x60, 0x40, 0xea, 0x52, 0x34, 0x7d, 0xe0, 0x26, 0xef, 0x36, 0x1b, 0x19, 0x16, 0xb8, 0xe4, 0xda,
-	0x5f, 0x05, 0xac, 0x0c, 0xa5, 0xf3, 0x9e, 0x39, 0xcc, 0x23, 0x97, 0xf8, 0x98, 0x32, 0x27, 0x66,
-	0x41, 0xe8, 0x8f, 0xa3, 0x18, 0x22, 0xa0, 0xce, 0x6c, 0x1c, 0xb8, 0x2a, 0xd2, 0x91, 0x51, 0xb4,
-	0x49, 0xaa, 0x8d, 0x12, 0xe9, 0xce, 0x25, 0x3d, 0x5c, 0x71, 0xbd, 0x08, 0x68, 0xc0, 0xa8, 0x9a,
-	0xd7, 0x0b, 0x46, 0xad, 0x77, 0x6a, 0x1e, 0xfc, 0x6e, 0x0e, 0xa4, 0x6c, 0xff, 0x70, 0xe4, 0x1c,
-	0x97, 0x38, 0x30, 0x8f, 0xaa, 0x05, 0x61, 0x38, 0xca, 0x18, 0x1e, 0x81, 0x79, 0xb6, 0x24, 0xc8,
-	0x35, 0xae, 0xa6, 0x3d, 0xa8, 0x5a, 0x14, 0x78, 0x23, 0x83, 0xa7, 0x65, 0xec, 0x1d, 0x49, 0x86,
-	0xf8, 0x7f, 0xf2, 0xdb, 0x38, 0x72, 0x62, 0x67, 0x4e, 0xd5, 0x92, 0x8e, 0x8c, 0x5a, 0xef, 0xec,
-	0xef, 0x6e, 0x23, 0xc1, 0xf4, 0xf3, 0x2a, 0xb2, 0xeb, 0xee, 0xfe, 0x88, 0x0c, 0x70, 0x9d, 0x83,
-	0x5c, 0x87, 0xcc, 0x29, 0x8b, 0x9c, 0xd6, 0xef, 0xca, 0xdb, 0xb5, 0xec, 0x62, 0x14, 0xbe, 0x37,
-	0x21, 0x37, 0x58, 0x61, 0xce, 0x6c, 0xb6, 0x4c, 0x43, 0xfe, 0x89, 0x90, 0x66, 0x26, 0xe4, 0x61,
-	0x8b, 0xec, 0x65, 0xd4, 0xd8, 0x6e, 0x40, 0x3a, 0xb8, 0x9c, 0x98, 0x2b, 0xc2, 0x7c, 0x92, 0xdd,
-	0x82, 0x10, 0xed, 0x04, 0x22, 0x6d, 0xac, 0x4c, 0x21, 0xa4, 0x2c, 0x60, 0x0b, 0x16, 0x40, 0xa8,
-	0x56, 0x75, 0x64, 0x54, 0xed, 0x83, 0x59, 0xff, 0xf6, 0x7d, 0xad, 0xa1, 0xd5, 0x5a, 0x43, 0x9f,
-	0x6b, 0x0d, 0xbd, 0x6d, 0xb4, 0xdc, 0x6a, 0xa3, 0xe5, 0x3e, 0x36, 0x5a, 0xee, 0xe9, 0xc2, 0x0f,
-	0xd8, 0xf3, 0x62, 0x62, 0x4e, 0x61, 0x6e, 0x25, 0xb7, 0x23, 0x9f, 0x0e, 0x75, 0x5f, 0xac, 0x57,
-	0x71, 0x48, 0x6c, 0x19, 0x79, 0xd4, 0xe2, 0xdd, 0x49, 0x59, 0xdc, 0xd2, 0xd5, 0x77, 0x00, 0x00,
-	0x00, 0xff, 0xff, 0x1b, 0x69, 0x07, 0x35, 0x92, 0x02, 0x00, 0x00,
+	// 497 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x74, 0x93, 0x5d, 0x6f, 0xd3, 0x3e,
+	0x14, 0xc6, 0x9b, 0xbd, 0xf4, 0xbf, 0xba, 0xed, 0x7f, 0x60, 0x60, 0xb5, 0x36, 0x88, 0xaa, 0x09,
+	0x89, 0x22, 0xb4, 0x84, 0x16, 0xf1, 0x01, 0xf6, 0xa6, 0xc1, 0x05, 0xd2, 0xe4, 0xa1, 0x5d, 0x20,
+	0xa1, 0xc8, 0x4b, 0xac, 0x2e, 0xa2, 0x8e, 0xa3, 0x9e, 0x33, 0x6b, 0xfb, 0x16, 0x7c, 0x2a, 0xc4,
+	0xe5, 0x2e, 0xb9, 0x44, 0xed, 0x17, 0x41, 0xb5, 0x93, 0xb5, 0x0d, 0xe5, 0x2a, 0xf2, 0x39, 0xbf,
+	0xe7, 0xf1, 0xa3, 0xe3, 0x1c, 0xb2, 0x17, 0x6b, 0x50, 0x1a, 0xc2, 0xa1, 0x36, 0xa1, 0xe9, 0x87,
+	0x43, 0x99, 0x49, 0x48, 0x21, 0xc8, 0xc7, 0x1a, 0x35, 0x6d, 0xbb, 0x66, 0x30, 0xd4, 0x26, 0x30,
+	0xfd, 0xdd, 0x4e, 0x85, 0xd5, 0xc6, 0x71, 0xfb, 0x3f, 0xea, 0xa4, 0x75, 0xe6, 0x94, 0x17, 0x28,
+	0x50, 0xd2, 0xb7, 0xe4, 0x29, 0xa0, 0x18, 0x63, 0x9a, 0x0d, 0xa3, 0x7c, 0xac, 0x73, 0x0d, 0x62,
+	0x14, 0xa5, 0x09, 0xf3, 0xba, 0x5e, 0x6f, 0x83, 0xd3, 0xb2, 0x77, 0x5e, 0xb4, 0x3e, 0x26, 0x74,
+	0x40, 0xb6, 0x12, 0x99, 0x6b, 0x48, 0x11, 0xd8, 0x5a, 0x77, 0xbd, 0xd7, 0x1c, 0xec, 0x04, 0x4b,
+	0xb7, 0x07, 0x27, 0xae, 0xcd, 0x1f, 0x38, 0xfa, 0x9a, 0x6c, 0x1a, 0x8d, 0x12, 0xd8, 0xba, 0x15,
+	0x3c, 0xa9, 0x08, 0x2e, 0x35, 0x4a, 0xee, 0x08, 0xfa, 0x9e, 0x34, 0xca, 0x1c, 0xc0, 0x36, 0x2c,
+	0xde, 0xa9, 0xe0, 0x65, 0x18, 0x3e, 0x27, 0xe9, 0x19, 0xf9, 0xbf, 0xb8, 0x2d, 0xca, 0xc5, 0x58,
+	0x28, 0x60, 0x9b, 0x5d, 0xaf, 0xd7, 0x1c, 0x3c, 0x5f, 0x9d, 0xed, 0xdc, 0x32, 0x47, 0x6b, 0xcc,
+	0xe3, 0xed, 0x64, 0xb1, 0x44, 0x4f, 0x48, 0xdb, 0x68, 0x37, 0x0e, 0xe7, 0x53, 0xb7, 0x3e, 0x7b,
+	0x7f, 0x47, 0x9e, 0x8d, 0x65, 0x6e, 0xd3, 0x32, 0x0b, 0x15, 0x7a, 0x48, 0x5a, 0x28, 0x46, 0xa3,
+	0xbb, 0xd2, 0xe4, 0x3f, 0x6b, 0xb2, 0x5b, 0x31, 0xf9, 0x3c, 0x43, 0x16, 0x3c, 0x9a, 0x38, 0x2f,
+	0xd0, 0x03, 0x52, 0x2f, 0xc4, 0x5b, 0x56, 0xfc, 0xac, 0x3a, 0x05, 0xdb, 0xe4, 0x05, 0x44, 0xf7,
+	0x49, 0x2b, 0xd6, 0x19, 0x60, 0x8a, 0x37, 0x98, 0xea, 0x8c, 0x35, 0xba, 0x5e, 0xaf, 0xc1, 0x97,
+	0x6a, 0xf4, 0x2b, 0xe9, 0x2c, 0x9e, 0x23, 0xa1, 0x64, 0x96, 0x28, 0x99, 0x21, 0x30, 0x62, 0x27,
+	0xfd, 0xb2, 0x72, 0xc7, 0xf1, 0x02, 0x7d, 0x58, 0xc2, 0x7c, 0x27, 0x5e, 0x55, 0x06, 0xfa, 0x81,
+	0x3c, 0x9a, 0xbd, 0x61, 0x14, 0x6b, 0xa5, 0x52, 0x74, 0xbe, 0x4d, 0xeb, 0xfb, 0x62, 0xc5, 0x83,
+	0x1f, 0x3f, 0x50, 0x7c, 0xdb, 0x2c, 0x9d, 0x81, 0x5e, 0x12, 0x2a, 0x6f, 0x65, 0xec, 0x52, 0x8e,
+	0x65, 0x2c, 0xd3, 0x1c, 0x81, 0xb5, 0xac, 0xd7, 0xab, 0x7f, 0xfc, 0x0d, 0xa7, 0xa5, 0x80, 0x3b,
+	0x9e, 0x3f, 0x96, 0x95, 0x8a, 0x4d, 0xa8, 0x24, 0x8a, 0x44, 0xa0, 0x88, 0x20, 0xbe, 0x96, 0x4a,
+	0x00, 0x6b, 0xaf, 0x4c, 0xf8, 0xa9, 0xc0, 0x2e, 0x2c, 0xc5, 0xb7, 0xd5, 0xd2, 0x19, 0x8e, 0x4e,
+	0x7f, 0x4e, 0x7c, 0xef, 0x7e, 0xe2, 0x7b, 0xbf, 0x27, 0xbe, 0xf7, 0x7d, 0xea, 0xd7, 0xee, 0xa7,
+	0x7e, 0xed, 0xd7, 0xd4, 0xaf, 0x7d, 0x79, 0x33, 0x4c, 0xf1, 0xfa, 0xe6, 0x2a, 0x88, 0xb5, 0x0a,
+	0x8b, 0x35, 0x74, 0x9f, 0x03, 0x48, 0xbe, 0x85, 0xb7, 0x76, 0x27, 0xf1, 0x2e, 0x97, 0x10, 0x9a,
+	0xfe, 0x55, 0xdd, 0xae, 0xe5, 0xbb, 0x3f, 0x03, 0x00, 0x86, 0x66, 0x4f, 0xcd, 0xdd, 0x03, 0x00,
+	0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -207,6 +259,62 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.MetadataSchemas) > 0 {
+		for iNdEx := len(m.MetadataSchemas) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.MetadataSchemas[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x6a
+		}
+	}
+	if len(m.ExecutionReceipts) > 0 {
+		for iNdEx := len(m.ExecutionReceipts) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ExecutionReceipts[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x62
+		}
+	}
+	if len(m.VoteCommitments) > 0 {
+		for iNdEx := len(m.VoteCommitments) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.VoteCommitments[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x5a
+		}
+	}
+	if len(m.ConstitutionAmendments) > 0 {
+		for iNdEx := len(m.ConstitutionAmendments) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ConstitutionAmendments[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x52
+		}
+	}
 	if len(m.Constitution) > 0 {
 		i -= len(m.Constitution)
 		copy(dAtA[i:], m.Constitution)
@@ -370,6 +478,30 @@ func (m *GenesisState) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovGenesis(uint64(l))
 	}
+	if len(m.ConstitutionAmendments) > 0 {
+		for _, e := range m.ConstitutionAmendments {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.VoteCommitments) > 0 {
+		for _, e := range m.VoteCommitments {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.ExecutionReceipts) > 0 {
+		for _, e := range m.ExecutionReceipts {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.MetadataSchemas) > 0 {
+		for _, e := range m.MetadataSchemas {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -705,6 +837,142 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 			}
 			m.Constitution = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConstitutionAmendments", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ConstitutionAmendments = append(m.ConstitutionAmendments, &ConstitutionAmendment{})
+			if err := m.ConstitutionAmendments[len(m.ConstitutionAmendments)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VoteCommitments", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.VoteCommitments = append(m.VoteCommitments, &VoteCommitment{})
+			if err := m.VoteCommitments[len(m.VoteCommitments)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExecutionReceipts", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ExecutionReceipts = append(m.ExecutionReceipts, &ProposalExecutionReceipt{})
+			if err := m.ExecutionReceipts[len(m.ExecutionReceipts)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 13:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MetadataSchemas", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MetadataSchemas = append(m.MetadataSchemas, &MetadataSchema{})
+			if err := m.MetadataSchemas[len(m.MetadataSchemas)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
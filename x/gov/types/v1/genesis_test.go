@@ -92,6 +92,97 @@ func TestValidateGenesis(t *testing.T) {
 			},
 			expErr: true,
 		},
+		{
+			name: "invalid abstain semantics",
+			genesisState: func() *v1.GenesisState {
+				params1 := params
+				params1.AbstainSemantics = v1.AbstainSemantics(42)
+
+				return v1.NewGenesisState(0, params1)
+			},
+			expErr: true,
+		},
+		{
+			name: "valid accepted deposit denoms",
+			genesisState: func() *v1.GenesisState {
+				params1 := params
+				params1.AcceptedDepositDenoms = []v1.AcceptedDepositDenom{{Denom: "stake2", BaseDenom: sdk.DefaultBondDenom, Ratio: "0.5"}, {Denom: "stake3", BaseDenom: sdk.DefaultBondDenom, Ratio: "2"}}
+
+				return v1.NewGenesisState(v1.DefaultStartingProposalID, params1)
+			},
+		},
+		{
+			name: "accepted deposit denom is a min deposit denom",
+			genesisState: func() *v1.GenesisState {
+				params1 := params
+				params1.AcceptedDepositDenoms = []v1.AcceptedDepositDenom{{Denom: sdk.DefaultBondDenom, BaseDenom: sdk.DefaultBondDenom, Ratio: "1"}}
+
+				return v1.NewGenesisState(v1.DefaultStartingProposalID, params1)
+			},
+			expErr: true,
+		},
+		{
+			name: "duplicate accepted deposit denom",
+			genesisState: func() *v1.GenesisState {
+				params1 := params
+				params1.AcceptedDepositDenoms = []v1.AcceptedDepositDenom{{Denom: "stake2", BaseDenom: sdk.DefaultBondDenom, Ratio: "1"}, {Denom: "stake2", BaseDenom: sdk.DefaultBondDenom, Ratio: "2"}}
+
+				return v1.NewGenesisState(v1.DefaultStartingProposalID, params1)
+			},
+			expErr: true,
+		},
+		{
+			name: "invalid accepted deposit base denom",
+			genesisState: func() *v1.GenesisState {
+				params1 := params
+				params1.AcceptedDepositDenoms = []v1.AcceptedDepositDenom{{Denom: "stake2", BaseDenom: "stake3", Ratio: "1"}}
+
+				return v1.NewGenesisState(v1.DefaultStartingProposalID, params1)
+			},
+			expErr: true,
+		},
+		{
+			name: "non-positive accepted deposit ratio",
+			genesisState: func() *v1.GenesisState {
+				params1 := params
+				params1.AcceptedDepositDenoms = []v1.AcceptedDepositDenom{{Denom: "stake2", BaseDenom: sdk.DefaultBondDenom, Ratio: "0"}}
+
+				return v1.NewGenesisState(v1.DefaultStartingProposalID, params1)
+			},
+			expErr: true,
+		},
+		{
+			name: "execution receipt of a passed proposal",
+			genesisState: func() *v1.GenesisState {
+				state := v1.NewGenesisState(v1.DefaultStartingProposalID, params)
+				state.Proposals = []*v1.Proposal{{Id: 1, Status: v1.StatusPassed}}
+				state.ExecutionReceipts = []*v1.ProposalExecutionReceipt{{ProposalId: 1, Height: 10}}
+
+				return state
+			},
+		},
+		{
+			name: "execution receipt of a rejected proposal",
+			genesisState: func() *v1.GenesisState {
+				state := v1.NewGenesisState(v1.DefaultStartingProposalID, params)
+				state.Proposals = []*v1.Proposal{{Id: 1, Status: v1.StatusRejected}}
+				state.ExecutionReceipts = []*v1.ProposalExecutionReceipt{{ProposalId: 1, Height: 10}}
+
+				return state
+			},
+			expErr: true,
+		},
+		{
+			name: "duplicate execution receipt",
+			genesisState: func() *v1.GenesisState {
+				state := v1.NewGenesisState(v1.DefaultStartingProposalID, params)
+				state.Proposals = []*v1.Proposal{{Id: 1, Status: v1.StatusFailed}}
+				state.ExecutionReceipts = []*v1.ProposalExecutionReceipt{{ProposalId: 1, Height: 10}, {ProposalId: 1, Height: 11}}
+
+				return state
+			},
+			expErr: true,
+		},
 	}
 
 	for _, tc := range testCases {
@@ -93,6 +93,11 @@ const (
 	// PROPOSAL_STATUS_FAILED defines a proposal status of a proposal that has
 	// failed.
 	ProposalStatus_PROPOSAL_STATUS_FAILED ProposalStatus = 5
+	// PROPOSAL_STATUS_DISCUSSION_PERIOD defines a proposal status during the
+	// discussion period, between the deposit period and the voting period.
+	//
+	// Since: cosmos-sdk 0.48
+	ProposalStatus_PROPOSAL_STATUS_DISCUSSION_PERIOD ProposalStatus = 6
 )
 
 var ProposalStatus_name = map[int32]string{
@@ -102,15 +107,17 @@ var ProposalStatus_name = map[int32]string{
 	3: "PROPOSAL_STATUS_PASSED",
 	4: "PROPOSAL_STATUS_REJECTED",
 	5: "PROPOSAL_STATUS_FAILED",
+	6: "PROPOSAL_STATUS_DISCUSSION_PERIOD",
 }
 
 var ProposalStatus_value = map[string]int32{
-	"PROPOSAL_STATUS_UNSPECIFIED":    0,
-	"PROPOSAL_STATUS_DEPOSIT_PERIOD": 1,
-	"PROPOSAL_STATUS_VOTING_PERIOD":  2,
-	"PROPOSAL_STATUS_PASSED":         3,
-	"PROPOSAL_STATUS_REJECTED":       4,
-	"PROPOSAL_STATUS_FAILED":         5,
+	"PROPOSAL_STATUS_UNSPECIFIED":       0,
+	"PROPOSAL_STATUS_DEPOSIT_PERIOD":    1,
+	"PROPOSAL_STATUS_VOTING_PERIOD":     2,
+	"PROPOSAL_STATUS_PASSED":            3,
+	"PROPOSAL_STATUS_REJECTED":          4,
+	"PROPOSAL_STATUS_FAILED":            5,
+	"PROPOSAL_STATUS_DISCUSSION_PERIOD": 6,
 }
 
 func (x ProposalStatus) String() string {
@@ -121,6 +128,49 @@ func (ProposalStatus) EnumDescriptor() ([]byte, []int) {
 	return fileDescriptor_e05cb1c0d030febb, []int{1}
 }
 
+// AbstainSemantics enumerates the ways Abstain votes are counted in the tally
+// of a proposal.
+//
+// Since: cosmos-sdk 0.48
+type AbstainSemantics int32
+
+const (
+	// ABSTAIN_SEMANTICS_UNSPECIFIED defines a no-op semantics, treated as
+	// ABSTAIN_SEMANTICS_QUORUM_ONLY.
+	AbstainSemantics_ABSTAIN_SEMANTICS_UNSPECIFIED AbstainSemantics = 0
+	// ABSTAIN_SEMANTICS_QUORUM_ONLY counts Abstain votes toward the quorum and
+	// the veto threshold, but not toward the threshold of Yes votes.
+	AbstainSemantics_ABSTAIN_SEMANTICS_QUORUM_ONLY AbstainSemantics = 1
+	// ABSTAIN_SEMANTICS_THRESHOLD counts Abstain votes toward the quorum, the
+	// veto threshold and the threshold of Yes votes.
+	AbstainSemantics_ABSTAIN_SEMANTICS_THRESHOLD AbstainSemantics = 2
+	// ABSTAIN_SEMANTICS_IGNORED does not count Abstain votes at all, as if they
+	// had not been cast.
+	AbstainSemantics_ABSTAIN_SEMANTICS_IGNORED AbstainSemantics = 3
+)
+
+var AbstainSemantics_name = map[int32]string{
+	0: "ABSTAIN_SEMANTICS_UNSPECIFIED",
+	1: "ABSTAIN_SEMANTICS_QUORUM_ONLY",
+	2: "ABSTAIN_SEMANTICS_THRESHOLD",
+	3: "ABSTAIN_SEMANTICS_IGNORED",
+}
+
+var AbstainSemantics_value = map[string]int32{
+	"ABSTAIN_SEMANTICS_UNSPECIFIED": 0,
+	"ABSTAIN_SEMANTICS_QUORUM_ONLY": 1,
+	"ABSTAIN_SEMANTICS_THRESHOLD":   2,
+	"ABSTAIN_SEMANTICS_IGNORED":     3,
+}
+
+func (x AbstainSemantics) String() string {
+	return proto.EnumName(AbstainSemantics_name, int32(x))
+}
+
+func (AbstainSemantics) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_e05cb1c0d030febb, []int{2}
+}
+
 // WeightedVoteOption defines a unit of vote for vote split.
 type WeightedVoteOption struct {
 	// option defines the valid vote options, it must not contain duplicate vote options.
@@ -185,6 +235,17 @@ type Deposit struct {
 	Depositor string `protobuf:"bytes,2,opt,name=depositor,proto3" json:"depositor,omitempty"`
 	// amount to be deposited by depositor.
 	Amount []types.Coin `protobuf:"bytes,3,rep,name=amount,proto3" json:"amount"`
+	// metadata is any arbitrary metadata attached to the deposits of the
+	// depositor. It is replaced by the metadata of a later deposit, unless that
+	// deposit has none.
+	//
+	// Since: cosmos-sdk 0.48
+	Metadata string `protobuf:"bytes,4,opt,name=metadata,proto3" json:"metadata,omitempty"`
+	// metadata_schema_id is the id of the registered metadata schema the
+	// metadata of the deposit conforms to, or 0 if none.
+	//
+	// Since: cosmos-sdk 0.48
+	MetadataSchemaId uint64 `protobuf:"varint,5,opt,name=metadata_schema_id,json=metadataSchemaId,proto3" json:"metadata_schema_id,omitempty"`
 }
 
 func (m *Deposit) Reset()         { *m = Deposit{} }
@@ -241,6 +302,20 @@ func (m *Deposit) GetAmount() []types.Coin {
 	return nil
 }
 
+func (m *Deposit) GetMetadata() string {
+	if m != nil {
+		return m.Metadata
+	}
+	return ""
+}
+
+func (m *Deposit) GetMetadataSchemaId() uint64 {
+	if m != nil {
+		return m.MetadataSchemaId
+	}
+	return 0
+}
+
 // Proposal defines the core field members of a governance proposal.
 type Proposal struct {
 	// id defines the unique id of the proposal.
@@ -281,6 +356,54 @@ type Proposal struct {
 	//
 	// Since: cosmos-sdk 0.48
 	Expedited bool `protobuf:"varint,14,opt,name=expedited,proto3" json:"expedited,omitempty"`
+	// params_update_failures records, for a proposal executing a
+	// MsgBatchUpdateParams, why each parameter update could not be applied.
+	//
+	// Since: cosmos-sdk 0.48
+	ParamsUpdateFailures []ParamsUpdateFailure `protobuf:"bytes,15,rep,name=params_update_failures,json=paramsUpdateFailures,proto3" json:"params_update_failures"`
+	// deposit_period_extended defines if the deposit end time of the proposal
+	// has already been extended because its deposit neared the minimum deposit.
+	//
+	// Since: cosmos-sdk 0.48
+	DepositPeriodExtended bool `protobuf:"varint,16,opt,name=deposit_period_extended,json=depositPeriodExtended,proto3" json:"deposit_period_extended,omitempty"`
+	// execution_authority is the address the messages of the proposal are
+	// executed on behalf of. It must be one of the execution authorities allowed
+	// by the params. If empty, the messages are executed by the gov module account.
+	//
+	// Since: cosmos-sdk 0.48
+	ExecutionAuthority string `protobuf:"bytes,17,opt,name=execution_authority,json=executionAuthority,proto3" json:"execution_authority,omitempty"`
+	// discussion_end_time is the end time of the discussion period of the
+	// proposal, after which its voting period starts.
+	//
+	// Since: cosmos-sdk 0.48
+	DiscussionEndTime *time.Time `protobuf:"bytes,18,opt,name=discussion_end_time,json=discussionEndTime,proto3,stdtime" json:"discussion_end_time,omitempty"`
+	// execution_failure records why the execution of the proposal failed. It is
+	// only set for passed proposals whose execution failed.
+	//
+	// Since: cosmos-sdk 0.48
+	ExecutionFailure *ProposalExecutionFailure `protobuf:"bytes,19,opt,name=execution_failure,json=executionFailure,proto3" json:"execution_failure,omitempty"`
+	// invalidation_failure records why the proposal failed when its voting
+	// period was about to start, because one of its messages was no longer
+	// valid.
+	//
+	// Since: cosmos-sdk 0.48
+	InvalidationFailure *ProposalExecutionFailure `protobuf:"bytes,20,opt,name=invalidation_failure,json=invalidationFailure,proto3" json:"invalidation_failure,omitempty"`
+	// commit_reveal defines if the proposal uses commit-reveal voting: voters
+	// first commit to a hash of their vote, then reveal it after the commit
+	// period. Unrevealed commitments are counted as abstain votes.
+	//
+	// Since: cosmos-sdk 0.48
+	CommitReveal bool `protobuf:"varint,21,opt,name=commit_reveal,json=commitReveal,proto3" json:"commit_reveal,omitempty"`
+	// commit_end_time is the end time of the commit period of a commit-reveal
+	// proposal, after which its votes are revealed until the voting end time.
+	//
+	// Since: cosmos-sdk 0.48
+	CommitEndTime *time.Time `protobuf:"bytes,22,opt,name=commit_end_time,json=commitEndTime,proto3,stdtime" json:"commit_end_time,omitempty"`
+	// metadata_schema_id is the id of the registered metadata schema the
+	// metadata of the proposal conforms to, or 0 if none.
+	//
+	// Since: cosmos-sdk 0.48
+	MetadataSchemaId uint64 `protobuf:"varint,23,opt,name=metadata_schema_id,json=metadataSchemaId,proto3" json:"metadata_schema_id,omitempty"`
 }
 
 func (m *Proposal) Reset()         { *m = Proposal{} }
@@ -414,6 +537,282 @@ func (m *Proposal) GetExpedited() bool {
 	return false
 }
 
+func (m *Proposal) GetParamsUpdateFailures() []ParamsUpdateFailure {
+	if m != nil {
+		return m.ParamsUpdateFailures
+	}
+	return nil
+}
+
+func (m *Proposal) GetDepositPeriodExtended() bool {
+	if m != nil {
+		return m.DepositPeriodExtended
+	}
+	return false
+}
+
+func (m *Proposal) GetExecutionAuthority() string {
+	if m != nil {
+		return m.ExecutionAuthority
+	}
+	return ""
+}
+
+func (m *Proposal) GetDiscussionEndTime() *time.Time {
+	if m != nil {
+		return m.DiscussionEndTime
+	}
+	return nil
+}
+
+func (m *Proposal) GetExecutionFailure() *ProposalExecutionFailure {
+	if m != nil {
+		return m.ExecutionFailure
+	}
+	return nil
+}
+
+func (m *Proposal) GetInvalidationFailure() *ProposalExecutionFailure {
+	if m != nil {
+		return m.InvalidationFailure
+	}
+	return nil
+}
+
+func (m *Proposal) GetCommitReveal() bool {
+	if m != nil {
+		return m.CommitReveal
+	}
+	return false
+}
+
+func (m *Proposal) GetCommitEndTime() *time.Time {
+	if m != nil {
+		return m.CommitEndTime
+	}
+	return nil
+}
+
+func (m *Proposal) GetMetadataSchemaId() uint64 {
+	if m != nil {
+		return m.MetadataSchemaId
+	}
+	return 0
+}
+
+// ProposalExecutionFailure defines why the execution of a passed proposal
+// failed.
+//
+// Since: cosmos-sdk 0.48
+type ProposalExecutionFailure struct {
+	// msg_index is the position of the failing message in the proposal messages.
+	// It is only meaningful if msg_type_url is set.
+	MsgIndex uint32 `protobuf:"varint,1,opt,name=msg_index,json=msgIndex,proto3" json:"msg_index,omitempty"`
+	// msg_type_url is the type URL of the failing message. It is empty if the
+	// proposal failed before any of its messages was executed.
+	MsgTypeUrl string `protobuf:"bytes,2,opt,name=msg_type_url,json=msgTypeUrl,proto3" json:"msg_type_url,omitempty"`
+	// reason is the error which made the execution fail.
+	Reason string `protobuf:"bytes,3,opt,name=reason,proto3" json:"reason,omitempty"`
+}
+
+func (m *ProposalExecutionFailure) Reset()         { *m = ProposalExecutionFailure{} }
+func (m *ProposalExecutionFailure) String() string { return proto.CompactTextString(m) }
+func (*ProposalExecutionFailure) ProtoMessage()    {}
+func (*ProposalExecutionFailure) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e05cb1c0d030febb, []int{3}
+}
+func (m *ProposalExecutionFailure) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ProposalExecutionFailure) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ProposalExecutionFailure.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ProposalExecutionFailure) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ProposalExecutionFailure.Merge(m, src)
+}
+func (m *ProposalExecutionFailure) XXX_Size() int {
+	return m.Size()
+}
+func (m *ProposalExecutionFailure) XXX_DiscardUnknown() {
+	xxx_messageInfo_ProposalExecutionFailure.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ProposalExecutionFailure proto.InternalMessageInfo
+
+func (m *ProposalExecutionFailure) GetMsgIndex() uint32 {
+	if m != nil {
+		return m.MsgIndex
+	}
+	return 0
+}
+
+func (m *ProposalExecutionFailure) GetMsgTypeUrl() string {
+	if m != nil {
+		return m.MsgTypeUrl
+	}
+	return ""
+}
+
+func (m *ProposalExecutionFailure) GetReason() string {
+	if m != nil {
+		return m.Reason
+	}
+	return ""
+}
+
+// ProposalExecutionReceipt records the execution of the messages of a passed
+// proposal.
+//
+// Since: cosmos-sdk 0.48
+type ProposalExecutionReceipt struct {
+	// proposal_id is the id of the executed proposal.
+	ProposalId uint64 `protobuf:"varint,1,opt,name=proposal_id,json=proposalId,proto3" json:"proposal_id,omitempty"`
+	// height is the block height at which the proposal was executed.
+	Height int64 `protobuf:"varint,2,opt,name=height,proto3" json:"height,omitempty"`
+	// time is the block time at which the proposal was executed.
+	Time time.Time `protobuf:"bytes,3,opt,name=time,proto3,stdtime" json:"time"`
+	// msg_responses are the responses of the executed messages. It is empty if
+	// the execution failed, see the execution_failure of the proposal.
+	MsgResponses []*types1.Any `protobuf:"bytes,4,rep,name=msg_responses,json=msgResponses,proto3" json:"msg_responses,omitempty"`
+}
+
+func (m *ProposalExecutionReceipt) Reset()         { *m = ProposalExecutionReceipt{} }
+func (m *ProposalExecutionReceipt) String() string { return proto.CompactTextString(m) }
+func (*ProposalExecutionReceipt) ProtoMessage()    {}
+func (*ProposalExecutionReceipt) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e05cb1c0d030febb, []int{4}
+}
+func (m *ProposalExecutionReceipt) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ProposalExecutionReceipt) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ProposalExecutionReceipt.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ProposalExecutionReceipt) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ProposalExecutionReceipt.Merge(m, src)
+}
+func (m *ProposalExecutionReceipt) XXX_Size() int {
+	return m.Size()
+}
+func (m *ProposalExecutionReceipt) XXX_DiscardUnknown() {
+	xxx_messageInfo_ProposalExecutionReceipt.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ProposalExecutionReceipt proto.InternalMessageInfo
+
+func (m *ProposalExecutionReceipt) GetProposalId() uint64 {
+	if m != nil {
+		return m.ProposalId
+	}
+	return 0
+}
+
+func (m *ProposalExecutionReceipt) GetHeight() int64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+func (m *ProposalExecutionReceipt) GetTime() time.Time {
+	if m != nil {
+		return m.Time
+	}
+	return time.Time{}
+}
+
+func (m *ProposalExecutionReceipt) GetMsgResponses() []*types1.Any {
+	if m != nil {
+		return m.MsgResponses
+	}
+	return nil
+}
+
+// ParamsUpdateFailure defines the reason a single parameter update message of
+// a MsgBatchUpdateParams failed.
+//
+// Since: cosmos-sdk 0.48
+type ParamsUpdateFailure struct {
+	// index is the position of the failing message in the batch.
+	Index uint32 `protobuf:"varint,1,opt,name=index,proto3" json:"index,omitempty"`
+	// msg_type_url is the type URL of the failing message.
+	MsgTypeUrl string `protobuf:"bytes,2,opt,name=msg_type_url,json=msgTypeUrl,proto3" json:"msg_type_url,omitempty"`
+	// reason is the error returned by the module params validator or handler.
+	Reason string `protobuf:"bytes,3,opt,name=reason,proto3" json:"reason,omitempty"`
+}
+
+func (m *ParamsUpdateFailure) Reset()         { *m = ParamsUpdateFailure{} }
+func (m *ParamsUpdateFailure) String() string { return proto.CompactTextString(m) }
+func (*ParamsUpdateFailure) ProtoMessage()    {}
+func (*ParamsUpdateFailure) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e05cb1c0d030febb, []int{5}
+}
+func (m *ParamsUpdateFailure) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ParamsUpdateFailure) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ParamsUpdateFailure.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ParamsUpdateFailure) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ParamsUpdateFailure.Merge(m, src)
+}
+func (m *ParamsUpdateFailure) XXX_Size() int {
+	return m.Size()
+}
+func (m *ParamsUpdateFailure) XXX_DiscardUnknown() {
+	xxx_messageInfo_ParamsUpdateFailure.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ParamsUpdateFailure proto.InternalMessageInfo
+
+func (m *ParamsUpdateFailure) GetIndex() uint32 {
+	if m != nil {
+		return m.Index
+	}
+	return 0
+}
+
+func (m *ParamsUpdateFailure) GetMsgTypeUrl() string {
+	if m != nil {
+		return m.MsgTypeUrl
+	}
+	return ""
+}
+
+func (m *ParamsUpdateFailure) GetReason() string {
+	if m != nil {
+		return m.Reason
+	}
+	return ""
+}
+
 // TallyResult defines a standard tally for a governance proposal.
 type TallyResult struct {
 	// yes_count is the number of yes votes on a proposal.
@@ -430,7 +829,7 @@ func (m *TallyResult) Reset()         { *m = TallyResult{} }
 func (m *TallyResult) String() string { return proto.CompactTextString(m) }
 func (*TallyResult) ProtoMessage()    {}
 func (*TallyResult) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e05cb1c0d030febb, []int{3}
+	return fileDescriptor_e05cb1c0d030febb, []int{6}
 }
 func (m *TallyResult) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -498,13 +897,18 @@ type Vote struct {
 	Options []*WeightedVoteOption `protobuf:"bytes,4,rep,name=options,proto3" json:"options,omitempty"`
 	// metadata is any  arbitrary metadata to attached to the vote.
 	Metadata string `protobuf:"bytes,5,opt,name=metadata,proto3" json:"metadata,omitempty"`
+	// metadata_schema_id is the id of the registered metadata schema the
+	// metadata of the vote conforms to, or 0 if none.
+	//
+	// Since: cosmos-sdk 0.48
+	MetadataSchemaId uint64 `protobuf:"varint,6,opt,name=metadata_schema_id,json=metadataSchemaId,proto3" json:"metadata_schema_id,omitempty"`
 }
 
 func (m *Vote) Reset()         { *m = Vote{} }
 func (m *Vote) String() string { return proto.CompactTextString(m) }
 func (*Vote) ProtoMessage()    {}
 func (*Vote) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e05cb1c0d030febb, []int{4}
+	return fileDescriptor_e05cb1c0d030febb, []int{7}
 }
 func (m *Vote) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -561,29 +965,39 @@ func (m *Vote) GetMetadata() string {
 	return ""
 }
 
-// DepositParams defines the params for deposits on governance proposals.
+func (m *Vote) GetMetadataSchemaId() uint64 {
+	if m != nil {
+		return m.MetadataSchemaId
+	}
+	return 0
+}
+
+// VoteCommitment defines the commitment of a voter to its vote on a
+// commit-reveal proposal.
 //
-// Deprecated: Do not use.
-type DepositParams struct {
-	// Minimum deposit for a proposal to enter voting period.
-	MinDeposit []types.Coin `protobuf:"bytes,1,rep,name=min_deposit,json=minDeposit,proto3" json:"min_deposit,omitempty"`
-	// Maximum period for Atom holders to deposit on a proposal. Initial value: 2
-	// months.
-	MaxDepositPeriod *time.Duration `protobuf:"bytes,2,opt,name=max_deposit_period,json=maxDepositPeriod,proto3,stdduration" json:"max_deposit_period,omitempty"`
+// Since: cosmos-sdk 0.48
+type VoteCommitment struct {
+	// proposal_id defines the unique id of the proposal.
+	ProposalId uint64 `protobuf:"varint,1,opt,name=proposal_id,json=proposalId,proto3" json:"proposal_id,omitempty"`
+	// voter is the voter address of the proposal.
+	Voter string `protobuf:"bytes,2,opt,name=voter,proto3" json:"voter,omitempty"`
+	// commitment is the SHA-256 hash of the proposal id, the voter, the vote
+	// options and the salt of the vote.
+	Commitment []byte `protobuf:"bytes,3,opt,name=commitment,proto3" json:"commitment,omitempty"`
 }
 
-func (m *DepositParams) Reset()         { *m = DepositParams{} }
-func (m *DepositParams) String() string { return proto.CompactTextString(m) }
-func (*DepositParams) ProtoMessage()    {}
-func (*DepositParams) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e05cb1c0d030febb, []int{5}
+func (m *VoteCommitment) Reset()         { *m = VoteCommitment{} }
+func (m *VoteCommitment) String() string { return proto.CompactTextString(m) }
+func (*VoteCommitment) ProtoMessage()    {}
+func (*VoteCommitment) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e05cb1c0d030febb, []int{8}
 }
-func (m *DepositParams) XXX_Unmarshal(b []byte) error {
+func (m *VoteCommitment) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *DepositParams) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *VoteCommitment) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_DepositParams.Marshal(b, m, deterministic)
+		return xxx_messageInfo_VoteCommitment.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -593,52 +1007,77 @@ func (m *DepositParams) XXX_Marshal(b []byte, deterministic bool) ([]byte, error
 		return b[:n], nil
 	}
 }
-func (m *DepositParams) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_DepositParams.Merge(m, src)
+func (m *VoteCommitment) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_VoteCommitment.Merge(m, src)
 }
-func (m *DepositParams) XXX_Size() int {
+func (m *VoteCommitment) XXX_Size() int {
 	return m.Size()
 }
-func (m *DepositParams) XXX_DiscardUnknown() {
-	xxx_messageInfo_DepositParams.DiscardUnknown(m)
+func (m *VoteCommitment) XXX_DiscardUnknown() {
+	xxx_messageInfo_VoteCommitment.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_DepositParams proto.InternalMessageInfo
+var xxx_messageInfo_VoteCommitment proto.InternalMessageInfo
 
-func (m *DepositParams) GetMinDeposit() []types.Coin {
+func (m *VoteCommitment) GetProposalId() uint64 {
 	if m != nil {
-		return m.MinDeposit
+		return m.ProposalId
 	}
-	return nil
+	return 0
 }
 
-func (m *DepositParams) GetMaxDepositPeriod() *time.Duration {
+func (m *VoteCommitment) GetVoter() string {
 	if m != nil {
-		return m.MaxDepositPeriod
+		return m.Voter
 	}
-	return nil
+	return ""
 }
 
-// VotingParams defines the params for voting on governance proposals.
-//
-// Deprecated: Do not use.
-type VotingParams struct {
-	// Duration of the voting period.
-	VotingPeriod *time.Duration `protobuf:"bytes,1,opt,name=voting_period,json=votingPeriod,proto3,stdduration" json:"voting_period,omitempty"`
+func (m *VoteCommitment) GetCommitment() []byte {
+	if m != nil {
+		return m.Commitment
+	}
+	return nil
 }
 
-func (m *VotingParams) Reset()         { *m = VotingParams{} }
-func (m *VotingParams) String() string { return proto.CompactTextString(m) }
-func (*VotingParams) ProtoMessage()    {}
-func (*VotingParams) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e05cb1c0d030febb, []int{6}
+// ValidatorVoteBreakdown defines, for a given proposal, how the voting power of
+// a bonded validator is split between the delegations inheriting the
+// validator's vote and the delegations whose delegators voted themselves.
+type ValidatorVoteBreakdown struct {
+	// proposal_id defines the unique id of the proposal.
+	ProposalId uint64 `protobuf:"varint,1,opt,name=proposal_id,json=proposalId,proto3" json:"proposal_id,omitempty"`
+	// validator_address is the operator address of the validator.
+	ValidatorAddress string `protobuf:"bytes,2,opt,name=validator_address,json=validatorAddress,proto3" json:"validator_address,omitempty"`
+	// options is the weighted vote options of the validator. It is empty if the
+	// validator did not vote.
+	Options []*WeightedVoteOption `protobuf:"bytes,3,rep,name=options,proto3" json:"options,omitempty"`
+	// inherited_power is the voting power of the delegations which did not
+	// override the validator's vote. It only counts towards the tally when the
+	// validator voted.
+	InheritedPower string `protobuf:"bytes,4,opt,name=inherited_power,json=inheritedPower,proto3" json:"inherited_power,omitempty"`
+	// overridden_power is the voting power of the delegations whose delegators
+	// cast their own vote.
+	OverriddenPower string `protobuf:"bytes,5,opt,name=overridden_power,json=overriddenPower,proto3" json:"overridden_power,omitempty"`
+	// participation_exempt is true if the voting power of the validator was
+	// below the participation exemption threshold at tally time, in which case
+	// its participation is not tracked.
+	//
+	// Since: cosmos-sdk 0.48
+	ParticipationExempt bool `protobuf:"varint,6,opt,name=participation_exempt,json=participationExempt,proto3" json:"participation_exempt,omitempty"`
 }
-func (m *VotingParams) XXX_Unmarshal(b []byte) error {
+
+func (m *ValidatorVoteBreakdown) Reset()         { *m = ValidatorVoteBreakdown{} }
+func (m *ValidatorVoteBreakdown) String() string { return proto.CompactTextString(m) }
+func (*ValidatorVoteBreakdown) ProtoMessage()    {}
+func (*ValidatorVoteBreakdown) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e05cb1c0d030febb, []int{9}
+}
+func (m *ValidatorVoteBreakdown) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *VotingParams) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *ValidatorVoteBreakdown) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_VotingParams.Marshal(b, m, deterministic)
+		return xxx_messageInfo_ValidatorVoteBreakdown.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -648,51 +1087,93 @@ func (m *VotingParams) XXX_Marshal(b []byte, deterministic bool) ([]byte, error)
 		return b[:n], nil
 	}
 }
-func (m *VotingParams) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_VotingParams.Merge(m, src)
+func (m *ValidatorVoteBreakdown) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ValidatorVoteBreakdown.Merge(m, src)
 }
-func (m *VotingParams) XXX_Size() int {
+func (m *ValidatorVoteBreakdown) XXX_Size() int {
 	return m.Size()
 }
-func (m *VotingParams) XXX_DiscardUnknown() {
-	xxx_messageInfo_VotingParams.DiscardUnknown(m)
+func (m *ValidatorVoteBreakdown) XXX_DiscardUnknown() {
+	xxx_messageInfo_ValidatorVoteBreakdown.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_VotingParams proto.InternalMessageInfo
+var xxx_messageInfo_ValidatorVoteBreakdown proto.InternalMessageInfo
 
-func (m *VotingParams) GetVotingPeriod() *time.Duration {
+func (m *ValidatorVoteBreakdown) GetProposalId() uint64 {
 	if m != nil {
-		return m.VotingPeriod
+		return m.ProposalId
+	}
+	return 0
+}
+
+func (m *ValidatorVoteBreakdown) GetValidatorAddress() string {
+	if m != nil {
+		return m.ValidatorAddress
+	}
+	return ""
+}
+
+func (m *ValidatorVoteBreakdown) GetOptions() []*WeightedVoteOption {
+	if m != nil {
+		return m.Options
 	}
 	return nil
 }
 
-// TallyParams defines the params for tallying votes on governance proposals.
-//
-// Deprecated: Do not use.
-type TallyParams struct {
-	// Minimum percentage of total stake needed to vote for a result to be
-	// considered valid.
-	Quorum string `protobuf:"bytes,1,opt,name=quorum,proto3" json:"quorum,omitempty"`
-	// Minimum proportion of Yes votes for proposal to pass. Default value: 0.5.
-	Threshold string `protobuf:"bytes,2,opt,name=threshold,proto3" json:"threshold,omitempty"`
-	// Minimum value of Veto votes to Total votes ratio for proposal to be
-	// vetoed. Default value: 1/3.
-	VetoThreshold string `protobuf:"bytes,3,opt,name=veto_threshold,json=vetoThreshold,proto3" json:"veto_threshold,omitempty"`
+func (m *ValidatorVoteBreakdown) GetInheritedPower() string {
+	if m != nil {
+		return m.InheritedPower
+	}
+	return ""
 }
 
-func (m *TallyParams) Reset()         { *m = TallyParams{} }
-func (m *TallyParams) String() string { return proto.CompactTextString(m) }
-func (*TallyParams) ProtoMessage()    {}
-func (*TallyParams) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e05cb1c0d030febb, []int{7}
+func (m *ValidatorVoteBreakdown) GetOverriddenPower() string {
+	if m != nil {
+		return m.OverriddenPower
+	}
+	return ""
 }
-func (m *TallyParams) XXX_Unmarshal(b []byte) error {
+
+func (m *ValidatorVoteBreakdown) GetParticipationExempt() bool {
+	if m != nil {
+		return m.ParticipationExempt
+	}
+	return false
+}
+
+// ValidatorVotedShares defines, for a given proposal in its voting period, the
+// delegation shares of a validator whose delegators cast their own vote. It is
+// kept up to date as votes are cast and delegations change, so that the tally
+// does not need to iterate over the delegations of the voters.
+//
+// Since: cosmos-sdk 0.48
+type ValidatorVotedShares struct {
+	// yes is the sum of the voted shares weighted by their Yes option weight.
+	Yes string `protobuf:"bytes,1,opt,name=yes,proto3" json:"yes,omitempty"`
+	// abstain is the sum of the voted shares weighted by their Abstain option
+	// weight.
+	Abstain string `protobuf:"bytes,2,opt,name=abstain,proto3" json:"abstain,omitempty"`
+	// no is the sum of the voted shares weighted by their No option weight.
+	No string `protobuf:"bytes,3,opt,name=no,proto3" json:"no,omitempty"`
+	// no_with_veto is the sum of the voted shares weighted by their NoWithVeto
+	// option weight.
+	NoWithVeto string `protobuf:"bytes,4,opt,name=no_with_veto,json=noWithVeto,proto3" json:"no_with_veto,omitempty"`
+	// voted is the sum of the voted shares.
+	Voted string `protobuf:"bytes,5,opt,name=voted,proto3" json:"voted,omitempty"`
+}
+
+func (m *ValidatorVotedShares) Reset()         { *m = ValidatorVotedShares{} }
+func (m *ValidatorVotedShares) String() string { return proto.CompactTextString(m) }
+func (*ValidatorVotedShares) ProtoMessage()    {}
+func (*ValidatorVotedShares) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e05cb1c0d030febb, []int{10}
+}
+func (m *ValidatorVotedShares) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *TallyParams) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *ValidatorVotedShares) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_TallyParams.Marshal(b, m, deterministic)
+		return xxx_messageInfo_ValidatorVotedShares.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -702,99 +1183,81 @@ func (m *TallyParams) XXX_Marshal(b []byte, deterministic bool) ([]byte, error)
 		return b[:n], nil
 	}
 }
-func (m *TallyParams) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_TallyParams.Merge(m, src)
+func (m *ValidatorVotedShares) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ValidatorVotedShares.Merge(m, src)
 }
-func (m *TallyParams) XXX_Size() int {
+func (m *ValidatorVotedShares) XXX_Size() int {
 	return m.Size()
 }
-func (m *TallyParams) XXX_DiscardUnknown() {
-	xxx_messageInfo_TallyParams.DiscardUnknown(m)
+func (m *ValidatorVotedShares) XXX_DiscardUnknown() {
+	xxx_messageInfo_ValidatorVotedShares.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_TallyParams proto.InternalMessageInfo
+var xxx_messageInfo_ValidatorVotedShares proto.InternalMessageInfo
 
-func (m *TallyParams) GetQuorum() string {
+func (m *ValidatorVotedShares) GetYes() string {
 	if m != nil {
-		return m.Quorum
+		return m.Yes
 	}
 	return ""
 }
 
-func (m *TallyParams) GetThreshold() string {
+func (m *ValidatorVotedShares) GetAbstain() string {
 	if m != nil {
-		return m.Threshold
+		return m.Abstain
 	}
 	return ""
 }
 
-func (m *TallyParams) GetVetoThreshold() string {
+func (m *ValidatorVotedShares) GetNo() string {
 	if m != nil {
-		return m.VetoThreshold
+		return m.No
 	}
 	return ""
 }
 
-// Params defines the parameters for the x/gov module.
+func (m *ValidatorVotedShares) GetNoWithVeto() string {
+	if m != nil {
+		return m.NoWithVeto
+	}
+	return ""
+}
+
+func (m *ValidatorVotedShares) GetVoted() string {
+	if m != nil {
+		return m.Voted
+	}
+	return ""
+}
+
+// ConstitutionAmendment defines an amendment of the chain's constitution made by
+// a constitution amendment proposal.
 //
-// Since: cosmos-sdk 0.47
-type Params struct {
-	// Minimum deposit for a proposal to enter voting period.
-	MinDeposit []types.Coin `protobuf:"bytes,1,rep,name=min_deposit,json=minDeposit,proto3" json:"min_deposit"`
-	// Maximum period for Atom holders to deposit on a proposal. Initial value: 2
-	// months.
-	MaxDepositPeriod *time.Duration `protobuf:"bytes,2,opt,name=max_deposit_period,json=maxDepositPeriod,proto3,stdduration" json:"max_deposit_period,omitempty"`
-	// Duration of the voting period.
-	VotingPeriod *time.Duration `protobuf:"bytes,3,opt,name=voting_period,json=votingPeriod,proto3,stdduration" json:"voting_period,omitempty"`
-	// Minimum percentage of total stake needed to vote for a result to be
-	// considered valid.
-	Quorum string `protobuf:"bytes,4,opt,name=quorum,proto3" json:"quorum,omitempty"`
-	// Minimum proportion of Yes votes for proposal to pass. Default value: 0.5.
-	Threshold string `protobuf:"bytes,5,opt,name=threshold,proto3" json:"threshold,omitempty"`
-	// Minimum value of Veto votes to Total votes ratio for proposal to be
-	// vetoed. Default value: 1/3.
-	VetoThreshold string `protobuf:"bytes,6,opt,name=veto_threshold,json=vetoThreshold,proto3" json:"veto_threshold,omitempty"`
-	// The ratio representing the proportion of the deposit value that must be paid at proposal submission.
-	MinInitialDepositRatio string `protobuf:"bytes,7,opt,name=min_initial_deposit_ratio,json=minInitialDepositRatio,proto3" json:"min_initial_deposit_ratio,omitempty"`
-	// The cancel ratio which will not be returned back to the depositors when a proposal is cancelled.
-	//
-	// Since: cosmos-sdk 0.48
-	ProposalCancelRatio string `protobuf:"bytes,8,opt,name=proposal_cancel_ratio,json=proposalCancelRatio,proto3" json:"proposal_cancel_ratio,omitempty"`
-	// The address which will receive (proposal_cancel_ratio * deposit) proposal deposits.
-	// If empty, the (proposal_cancel_ratio * deposit) proposal deposits will be burned.
-	//
-	// Since: cosmos-sdk 0.48
-	ProposalCancelDest string `protobuf:"bytes,9,opt,name=proposal_cancel_dest,json=proposalCancelDest,proto3" json:"proposal_cancel_dest,omitempty"`
-	// Duration of the voting period of an expedited proposal.
-	//
-	// Since: cosmos-sdk 0.48
-	ExpeditedVotingPeriod *time.Duration `protobuf:"bytes,10,opt,name=expedited_voting_period,json=expeditedVotingPeriod,proto3,stdduration" json:"expedited_voting_period,omitempty"`
-	// Minimum proportion of Yes votes for proposal to pass. Default value: 0.67.
-	//
-	// Since: cosmos-sdk 0.48
-	ExpeditedThreshold string `protobuf:"bytes,11,opt,name=expedited_threshold,json=expeditedThreshold,proto3" json:"expedited_threshold,omitempty"`
-	// Minimum expedited deposit for a proposal to enter voting period.
-	ExpeditedMinDeposit []types.Coin `protobuf:"bytes,12,rep,name=expedited_min_deposit,json=expeditedMinDeposit,proto3" json:"expedited_min_deposit"`
-	// burn deposits if a proposal does not meet quorum
-	BurnVoteQuorum bool `protobuf:"varint,13,opt,name=burn_vote_quorum,json=burnVoteQuorum,proto3" json:"burn_vote_quorum,omitempty"`
-	// burn deposits if the proposal does not enter voting period
-	BurnProposalDepositPrevote bool `protobuf:"varint,14,opt,name=burn_proposal_deposit_prevote,json=burnProposalDepositPrevote,proto3" json:"burn_proposal_deposit_prevote,omitempty"`
-	// burn deposits if quorum with vote type no_veto is met
-	BurnVoteVeto bool `protobuf:"varint,15,opt,name=burn_vote_veto,json=burnVoteVeto,proto3" json:"burn_vote_veto,omitempty"`
+// Since: cosmos-sdk 0.48
+type ConstitutionAmendment struct {
+	// id defines the unique id of the amendment, amendments are numbered in the
+	// order they were made.
+	Id uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	// proposal_id defines the unique id of the proposal which made the amendment.
+	ProposalId uint64 `protobuf:"varint,2,opt,name=proposal_id,json=proposalId,proto3" json:"proposal_id,omitempty"`
+	// constitution is the text of the constitution after the amendment.
+	Constitution string `protobuf:"bytes,3,opt,name=constitution,proto3" json:"constitution,omitempty"`
+	// amended_at is the block time at which the amendment was made.
+	AmendedAt time.Time `protobuf:"bytes,4,opt,name=amended_at,json=amendedAt,proto3,stdtime" json:"amended_at"`
 }
 
-func (m *Params) Reset()         { *m = Params{} }
-func (m *Params) String() string { return proto.CompactTextString(m) }
-func (*Params) ProtoMessage()    {}
-func (*Params) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e05cb1c0d030febb, []int{8}
+func (m *ConstitutionAmendment) Reset()         { *m = ConstitutionAmendment{} }
+func (m *ConstitutionAmendment) String() string { return proto.CompactTextString(m) }
+func (*ConstitutionAmendment) ProtoMessage()    {}
+func (*ConstitutionAmendment) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e05cb1c0d030febb, []int{11}
 }
-func (m *Params) XXX_Unmarshal(b []byte) error {
+func (m *ConstitutionAmendment) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *Params) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *ConstitutionAmendment) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_Params.Marshal(b, m, deterministic)
+		return xxx_messageInfo_ConstitutionAmendment.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -804,843 +1267,1112 @@ func (m *Params) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 		return b[:n], nil
 	}
 }
-func (m *Params) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_Params.Merge(m, src)
+func (m *ConstitutionAmendment) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ConstitutionAmendment.Merge(m, src)
 }
-func (m *Params) XXX_Size() int {
+func (m *ConstitutionAmendment) XXX_Size() int {
 	return m.Size()
 }
-func (m *Params) XXX_DiscardUnknown() {
-	xxx_messageInfo_Params.DiscardUnknown(m)
+func (m *ConstitutionAmendment) XXX_DiscardUnknown() {
+	xxx_messageInfo_ConstitutionAmendment.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_Params proto.InternalMessageInfo
+var xxx_messageInfo_ConstitutionAmendment proto.InternalMessageInfo
 
-func (m *Params) GetMinDeposit() []types.Coin {
+func (m *ConstitutionAmendment) GetId() uint64 {
 	if m != nil {
-		return m.MinDeposit
+		return m.Id
 	}
-	return nil
+	return 0
 }
 
-func (m *Params) GetMaxDepositPeriod() *time.Duration {
+func (m *ConstitutionAmendment) GetProposalId() uint64 {
 	if m != nil {
-		return m.MaxDepositPeriod
+		return m.ProposalId
 	}
-	return nil
+	return 0
 }
 
-func (m *Params) GetVotingPeriod() *time.Duration {
+func (m *ConstitutionAmendment) GetConstitution() string {
 	if m != nil {
-		return m.VotingPeriod
+		return m.Constitution
 	}
-	return nil
+	return ""
 }
 
-func (m *Params) GetQuorum() string {
+func (m *ConstitutionAmendment) GetAmendedAt() time.Time {
 	if m != nil {
-		return m.Quorum
+		return m.AmendedAt
 	}
-	return ""
+	return time.Time{}
 }
 
-func (m *Params) GetThreshold() string {
-	if m != nil {
-		return m.Threshold
+// MetadataSchema defines a schema registered on chain, which the metadata of
+// the proposals, votes and deposits can reference so that governance frontends
+// agree on its structure. Only the hash of the schema document is stored on
+// chain, the document itself is published at its uri.
+//
+// Since: cosmos-sdk 0.48
+type MetadataSchema struct {
+	// id defines the unique id of the schema, schemas are numbered in the order
+	// they were registered.
+	Id uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	// name is the human readable name of the schema, e.g. "proposal-v1".
+	Name string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	// uri is the location the schema document is published at.
+	Uri string `protobuf:"bytes,3,opt,name=uri,proto3" json:"uri,omitempty"`
+	// hash is the hex encoded SHA-256 hash of the schema document, typically a
+	// JSON schema.
+	Hash string `protobuf:"bytes,4,opt,name=hash,proto3" json:"hash,omitempty"`
+}
+
+func (m *MetadataSchema) Reset()         { *m = MetadataSchema{} }
+func (m *MetadataSchema) String() string { return proto.CompactTextString(m) }
+func (*MetadataSchema) ProtoMessage()    {}
+func (*MetadataSchema) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e05cb1c0d030febb, []int{12}
+}
+func (m *MetadataSchema) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MetadataSchema) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MetadataSchema.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return ""
+}
+func (m *MetadataSchema) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MetadataSchema.Merge(m, src)
+}
+func (m *MetadataSchema) XXX_Size() int {
+	return m.Size()
+}
+func (m *MetadataSchema) XXX_DiscardUnknown() {
+	xxx_messageInfo_MetadataSchema.DiscardUnknown(m)
 }
 
-func (m *Params) GetVetoThreshold() string {
+var xxx_messageInfo_MetadataSchema proto.InternalMessageInfo
+
+func (m *MetadataSchema) GetId() uint64 {
 	if m != nil {
-		return m.VetoThreshold
+		return m.Id
 	}
-	return ""
+	return 0
 }
 
-func (m *Params) GetMinInitialDepositRatio() string {
+func (m *MetadataSchema) GetName() string {
 	if m != nil {
-		return m.MinInitialDepositRatio
+		return m.Name
 	}
 	return ""
 }
 
-func (m *Params) GetProposalCancelRatio() string {
+func (m *MetadataSchema) GetUri() string {
 	if m != nil {
-		return m.ProposalCancelRatio
+		return m.Uri
 	}
 	return ""
 }
 
-func (m *Params) GetProposalCancelDest() string {
+func (m *MetadataSchema) GetHash() string {
 	if m != nil {
-		return m.ProposalCancelDest
+		return m.Hash
 	}
 	return ""
 }
 
-func (m *Params) GetExpeditedVotingPeriod() *time.Duration {
-	if m != nil {
-		return m.ExpeditedVotingPeriod
+// DepositParams defines the params for deposits on governance proposals.
+//
+// Deprecated: Do not use.
+type DepositParams struct {
+	// Minimum deposit for a proposal to enter voting period.
+	MinDeposit []types.Coin `protobuf:"bytes,1,rep,name=min_deposit,json=minDeposit,proto3" json:"min_deposit,omitempty"`
+	// Maximum period for Atom holders to deposit on a proposal. Initial value: 2
+	// months.
+	MaxDepositPeriod *time.Duration `protobuf:"bytes,2,opt,name=max_deposit_period,json=maxDepositPeriod,proto3,stdduration" json:"max_deposit_period,omitempty"`
+}
+
+func (m *DepositParams) Reset()         { *m = DepositParams{} }
+func (m *DepositParams) String() string { return proto.CompactTextString(m) }
+func (*DepositParams) ProtoMessage()    {}
+func (*DepositParams) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e05cb1c0d030febb, []int{13}
+}
+func (m *DepositParams) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DepositParams) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DepositParams.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return nil
+}
+func (m *DepositParams) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DepositParams.Merge(m, src)
+}
+func (m *DepositParams) XXX_Size() int {
+	return m.Size()
+}
+func (m *DepositParams) XXX_DiscardUnknown() {
+	xxx_messageInfo_DepositParams.DiscardUnknown(m)
 }
 
-func (m *Params) GetExpeditedThreshold() string {
+var xxx_messageInfo_DepositParams proto.InternalMessageInfo
+
+func (m *DepositParams) GetMinDeposit() []types.Coin {
 	if m != nil {
-		return m.ExpeditedThreshold
+		return m.MinDeposit
 	}
-	return ""
+	return nil
 }
 
-func (m *Params) GetExpeditedMinDeposit() []types.Coin {
+func (m *DepositParams) GetMaxDepositPeriod() *time.Duration {
 	if m != nil {
-		return m.ExpeditedMinDeposit
+		return m.MaxDepositPeriod
 	}
 	return nil
 }
 
-func (m *Params) GetBurnVoteQuorum() bool {
-	if m != nil {
-		return m.BurnVoteQuorum
-	}
-	return false
+// VotingParams defines the params for voting on governance proposals.
+//
+// Deprecated: Do not use.
+type VotingParams struct {
+	// Duration of the voting period.
+	VotingPeriod *time.Duration `protobuf:"bytes,1,opt,name=voting_period,json=votingPeriod,proto3,stdduration" json:"voting_period,omitempty"`
 }
 
-func (m *Params) GetBurnProposalDepositPrevote() bool {
-	if m != nil {
-		return m.BurnProposalDepositPrevote
+func (m *VotingParams) Reset()         { *m = VotingParams{} }
+func (m *VotingParams) String() string { return proto.CompactTextString(m) }
+func (*VotingParams) ProtoMessage()    {}
+func (*VotingParams) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e05cb1c0d030febb, []int{14}
+}
+func (m *VotingParams) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *VotingParams) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_VotingParams.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return false
+}
+func (m *VotingParams) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_VotingParams.Merge(m, src)
+}
+func (m *VotingParams) XXX_Size() int {
+	return m.Size()
+}
+func (m *VotingParams) XXX_DiscardUnknown() {
+	xxx_messageInfo_VotingParams.DiscardUnknown(m)
 }
 
-func (m *Params) GetBurnVoteVeto() bool {
+var xxx_messageInfo_VotingParams proto.InternalMessageInfo
+
+func (m *VotingParams) GetVotingPeriod() *time.Duration {
 	if m != nil {
-		return m.BurnVoteVeto
+		return m.VotingPeriod
 	}
-	return false
+	return nil
 }
 
-func init() {
-	proto.RegisterEnum("cosmos.gov.v1.VoteOption", VoteOption_name, VoteOption_value)
-	proto.RegisterEnum("cosmos.gov.v1.ProposalStatus", ProposalStatus_name, ProposalStatus_value)
-	proto.RegisterType((*WeightedVoteOption)(nil), "cosmos.gov.v1.WeightedVoteOption")
-	proto.RegisterType((*Deposit)(nil), "cosmos.gov.v1.Deposit")
-	proto.RegisterType((*Proposal)(nil), "cosmos.gov.v1.Proposal")
-	proto.RegisterType((*TallyResult)(nil), "cosmos.gov.v1.TallyResult")
-	proto.RegisterType((*Vote)(nil), "cosmos.gov.v1.Vote")
-	proto.RegisterType((*DepositParams)(nil), "cosmos.gov.v1.DepositParams")
-	proto.RegisterType((*VotingParams)(nil), "cosmos.gov.v1.VotingParams")
-	proto.RegisterType((*TallyParams)(nil), "cosmos.gov.v1.TallyParams")
-	proto.RegisterType((*Params)(nil), "cosmos.gov.v1.Params")
+// TallyParams defines the params for tallying votes on governance proposals.
+//
+// Deprecated: Do not use.
+type TallyParams struct {
+	// Minimum percentage of total stake needed to vote for a result to be
+	// considered valid.
+	Quorum string `protobuf:"bytes,1,opt,name=quorum,proto3" json:"quorum,omitempty"`
+	// Minimum proportion of Yes votes for proposal to pass. Default value: 0.5.
+	Threshold string `protobuf:"bytes,2,opt,name=threshold,proto3" json:"threshold,omitempty"`
+	// Minimum value of Veto votes to Total votes ratio for proposal to be
+	// vetoed. Default value: 1/3.
+	VetoThreshold string `protobuf:"bytes,3,opt,name=veto_threshold,json=vetoThreshold,proto3" json:"veto_threshold,omitempty"`
+	// Minimum percentage of total stake needed to vote for the Veto votes to
+	// count. Default value: 0.
+	//
+	// Since: cosmos-sdk 0.48
+	VetoQuorum string `protobuf:"bytes,4,opt,name=veto_quorum,json=vetoQuorum,proto3" json:"veto_quorum,omitempty"`
+	// The quorums overriding quorum and veto_quorum for the proposals of given
+	// kinds.
+	//
+	// Since: cosmos-sdk 0.48
+	ProposalKindQuorums []ProposalKindQuorum `protobuf:"bytes,5,rep,name=proposal_kind_quorums,json=proposalKindQuorums,proto3" json:"proposal_kind_quorums"`
 }
 
-func init() { proto.RegisterFile("cosmos/gov/v1/gov.proto", fileDescriptor_e05cb1c0d030febb) }
-
-var fileDescriptor_e05cb1c0d030febb = []byte{
-	// 1389 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x57, 0xcb, 0x6e, 0xdb, 0x46,
-	0x17, 0x36, 0x75, 0xd7, 0xd1, 0xc5, 0xca, 0xd8, 0x89, 0x69, 0x27, 0x96, 0x1c, 0x21, 0x08, 0xfc,
-	0xe7, 0x22, 0xfd, 0x4e, 0x9a, 0x2e, 0x9a, 0x02, 0x85, 0x64, 0x31, 0x0d, 0x8d, 0xc4, 0x52, 0x29,
-	0x45, 0x4e, 0xba, 0x21, 0x28, 0x71, 0x22, 0x13, 0x15, 0x39, 0x2a, 0x39, 0x52, 0xac, 0x47, 0xe8,
-	0x2e, 0xcb, 0xae, 0x8a, 0x2e, 0xbb, 0xec, 0x22, 0xe8, 0x33, 0x64, 0x55, 0x04, 0xe9, 0xa2, 0xdd,
-	0x34, 0x29, 0x92, 0x45, 0x81, 0x3c, 0x45, 0xc1, 0xe1, 0x50, 0x94, 0x64, 0xb5, 0xb6, 0xb3, 0xb1,
-	0xc5, 0x73, 0xbe, 0xef, 0x9b, 0x33, 0xe7, 0x32, 0x43, 0xc2, 0x5a, 0x97, 0x38, 0x26, 0x71, 0xca,
-	0x3d, 0x32, 0x2a, 0x8f, 0x76, 0xdc, 0x7f, 0xa5, 0x81, 0x4d, 0x28, 0x41, 0x19, 0xcf, 0x51, 0x72,
-	0x2d, 0xa3, 0x9d, 0x8d, 0x3c, 0xc7, 0x75, 0x34, 0x07, 0x97, 0x47, 0x3b, 0x1d, 0x4c, 0xb5, 0x9d,
-	0x72, 0x97, 0x18, 0x96, 0x07, 0xdf, 0x58, 0xed, 0x91, 0x1e, 0x61, 0x3f, 0xcb, 0xee, 0x2f, 0x6e,
-	0x2d, 0xf4, 0x08, 0xe9, 0xf5, 0x71, 0x99, 0x3d, 0x75, 0x86, 0x4f, 0xcb, 0xd4, 0x30, 0xb1, 0x43,
-	0x35, 0x73, 0xc0, 0x01, 0xeb, 0xf3, 0x00, 0xcd, 0x1a, 0x73, 0x57, 0x7e, 0xde, 0xa5, 0x0f, 0x6d,
-	0x8d, 0x1a, 0xc4, 0x5f, 0x71, 0xdd, 0x8b, 0x48, 0xf5, 0x16, 0xe5, 0xd1, 0x7a, 0xae, 0x73, 0x9a,
-	0x69, 0x58, 0xa4, 0xcc, 0xfe, 0x7a, 0xa6, 0x22, 0x01, 0x74, 0x80, 0x8d, 0xde, 0x21, 0xc5, 0x7a,
-	0x9b, 0x50, 0x5c, 0x1f, 0xb8, 0x4a, 0x68, 0x07, 0x62, 0x84, 0xfd, 0x12, 0x85, 0x2d, 0x61, 0x3b,
-	0x7b, 0x6b, 0xbd, 0x34, 0xb3, 0xeb, 0x52, 0x00, 0x55, 0x38, 0x10, 0x5d, 0x85, 0xd8, 0x33, 0x26,
-	0x24, 0x86, 0xb6, 0x84, 0xed, 0x64, 0x35, 0xfb, 0xfa, 0xc5, 0x4d, 0xe0, 0xac, 0x1a, 0xee, 0x2a,
-	0xdc, 0x5b, 0xfc, 0x51, 0x80, 0x78, 0x0d, 0x0f, 0x88, 0x63, 0x50, 0x54, 0x80, 0xd4, 0xc0, 0x26,
-	0x03, 0xe2, 0x68, 0x7d, 0xd5, 0xd0, 0xd9, 0x5a, 0x11, 0x05, 0x7c, 0x93, 0xac, 0xa3, 0x4f, 0x21,
-	0xa9, 0x7b, 0x58, 0x62, 0x73, 0x5d, 0xf1, 0xf5, 0x8b, 0x9b, 0xab, 0x5c, 0xb7, 0xa2, 0xeb, 0x36,
-	0x76, 0x9c, 0x26, 0xb5, 0x0d, 0xab, 0xa7, 0x04, 0x50, 0xf4, 0x39, 0xc4, 0x34, 0x93, 0x0c, 0x2d,
-	0x2a, 0x86, 0xb7, 0xc2, 0xdb, 0xa9, 0x20, 0x7e, 0xb7, 0x4c, 0x25, 0x5e, 0xa6, 0xd2, 0x2e, 0x31,
-	0xac, 0x6a, 0xf2, 0xe5, 0x9b, 0xc2, 0xd2, 0x4f, 0x7f, 0xff, 0x7c, 0x4d, 0x50, 0x38, 0xa7, 0xf8,
-	0x36, 0x0a, 0x89, 0x06, 0x0f, 0x02, 0x65, 0x21, 0x34, 0x09, 0x2d, 0x64, 0xe8, 0xe8, 0xff, 0x90,
-	0x30, 0xb1, 0xe3, 0x68, 0x3d, 0xec, 0x88, 0x21, 0x26, 0xbe, 0x5a, 0xf2, 0x2a, 0x52, 0xf2, 0x2b,
-	0x52, 0xaa, 0x58, 0x63, 0x65, 0x82, 0x42, 0x77, 0x20, 0xe6, 0x50, 0x8d, 0x0e, 0x1d, 0x31, 0xcc,
-	0x92, 0xb9, 0x39, 0x97, 0x4c, 0x7f, 0xa9, 0x26, 0x03, 0x29, 0x1c, 0x8c, 0xee, 0x03, 0x7a, 0x6a,
-	0x58, 0x5a, 0x5f, 0xa5, 0x5a, 0xbf, 0x3f, 0x56, 0x6d, 0xec, 0x0c, 0xfb, 0x54, 0x8c, 0x6c, 0x09,
-	0xdb, 0xa9, 0x5b, 0x1b, 0x73, 0x12, 0x2d, 0x17, 0xa2, 0x30, 0x84, 0x92, 0x63, 0xac, 0x29, 0x0b,
-	0xaa, 0x40, 0xca, 0x19, 0x76, 0x4c, 0x83, 0xaa, 0x6e, 0x9b, 0x89, 0x51, 0x2e, 0x31, 0x1f, 0x75,
-	0xcb, 0xef, 0xc1, 0x6a, 0xe4, 0xf9, 0xdb, 0x82, 0xa0, 0x80, 0x47, 0x72, 0xcd, 0x68, 0x0f, 0x72,
-	0x3c, 0xbb, 0x2a, 0xb6, 0x74, 0x4f, 0x27, 0x76, 0x4a, 0x9d, 0x2c, 0x67, 0x4a, 0x96, 0xce, 0xb4,
-	0x64, 0xc8, 0x50, 0x42, 0xb5, 0xbe, 0xca, 0xed, 0x62, 0xfc, 0x0c, 0x35, 0x4a, 0x33, 0xaa, 0xdf,
-	0x40, 0x0f, 0xe0, 0xdc, 0x88, 0x50, 0xc3, 0xea, 0xa9, 0x0e, 0xd5, 0x6c, 0xbe, 0xbf, 0xc4, 0x29,
-	0xe3, 0x5a, 0xf6, 0xa8, 0x4d, 0x97, 0xc9, 0x02, 0xbb, 0x0f, 0xdc, 0x14, 0xec, 0x31, 0x79, 0x4a,
-	0xad, 0x8c, 0x47, 0xf4, 0xb7, 0xb8, 0xe1, 0x36, 0x09, 0xd5, 0x74, 0x8d, 0x6a, 0x22, 0xb8, 0x6d,
-	0xab, 0x4c, 0x9e, 0xd1, 0x2a, 0x44, 0xa9, 0x41, 0xfb, 0x58, 0x4c, 0x31, 0x87, 0xf7, 0x80, 0x44,
-	0x88, 0x3b, 0x43, 0xd3, 0xd4, 0xec, 0xb1, 0x98, 0x66, 0x76, 0xff, 0x11, 0x7d, 0x02, 0x09, 0x6f,
-	0x22, 0xb0, 0x2d, 0x66, 0x4e, 0x18, 0x81, 0x09, 0x12, 0x5d, 0x82, 0x24, 0x3e, 0x1a, 0x60, 0xdd,
-	0xa0, 0x58, 0x17, 0xb3, 0x5b, 0xc2, 0x76, 0x42, 0x09, 0x0c, 0xc5, 0xdf, 0x05, 0x48, 0x4d, 0x77,
-	0xc8, 0x75, 0x48, 0x8e, 0xb1, 0xa3, 0x76, 0xd9, 0xc8, 0x08, 0xc7, 0xe6, 0x57, 0xb6, 0xa8, 0x92,
-	0x18, 0x63, 0x67, 0xd7, 0xf5, 0xa3, 0xdb, 0x90, 0xd1, 0x3a, 0x0e, 0xd5, 0x0c, 0x8b, 0x13, 0x42,
-	0x0b, 0x09, 0x69, 0x0e, 0xf2, 0x48, 0xff, 0x83, 0x84, 0x45, 0x38, 0x3e, 0xbc, 0x10, 0x1f, 0xb7,
-	0x88, 0x07, 0xbd, 0x0b, 0xc8, 0x22, 0xea, 0x33, 0x83, 0x1e, 0xaa, 0x23, 0x4c, 0x7d, 0x52, 0x64,
-	0x21, 0x69, 0xd9, 0x22, 0x07, 0x06, 0x3d, 0x6c, 0x63, 0xea, 0x91, 0x8b, 0xbf, 0x08, 0x10, 0x71,
-	0x4f, 0xa7, 0x93, 0xcf, 0x96, 0x12, 0x44, 0x47, 0x84, 0xe2, 0x93, 0xcf, 0x15, 0x0f, 0x86, 0xee,
-	0x42, 0xdc, 0x3b, 0xea, 0x1c, 0x31, 0xc2, 0x1a, 0xf6, 0xf2, 0xdc, 0x10, 0x1e, 0x3f, 0x47, 0x15,
-	0x9f, 0x31, 0xd3, 0x10, 0xd1, 0xd9, 0x86, 0xd8, 0x8b, 0x24, 0xc2, 0xb9, 0x48, 0xf1, 0x4f, 0x01,
-	0x32, 0xbc, 0xad, 0x1b, 0x9a, 0xad, 0x99, 0x0e, 0x7a, 0x02, 0x29, 0xd3, 0xb0, 0x26, 0x53, 0x22,
-	0x9c, 0x34, 0x25, 0x9b, 0xee, 0x94, 0x7c, 0x78, 0x53, 0x38, 0x3f, 0xc5, 0xba, 0x41, 0x4c, 0x83,
-	0x62, 0x73, 0x40, 0xc7, 0x0a, 0x98, 0x86, 0xe5, 0xcf, 0x8d, 0x09, 0xc8, 0xd4, 0x8e, 0x7c, 0x90,
-	0x3a, 0xc0, 0xb6, 0x41, 0x74, 0x96, 0x08, 0x77, 0x85, 0xf9, 0x66, 0xaf, 0xf1, 0x0b, 0xa6, 0x7a,
-	0xe5, 0xc3, 0x9b, 0xc2, 0xa5, 0xe3, 0xc4, 0x60, 0x91, 0xef, 0xdd, 0x59, 0xc8, 0x99, 0xda, 0x91,
-	0xbf, 0x13, 0xe6, 0xff, 0x2c, 0x24, 0x0a, 0xc5, 0xc7, 0x90, 0x6e, 0xb3, 0x19, 0xe1, 0xbb, 0xab,
-	0x01, 0x9f, 0x19, 0x7f, 0x75, 0xe1, 0xa4, 0xd5, 0x23, 0x4c, 0x3d, 0xed, 0xb1, 0xa6, 0x94, 0x7f,
-	0xf0, 0x9b, 0x99, 0x2b, 0x5f, 0x85, 0xd8, 0xb7, 0x43, 0x62, 0x0f, 0xcd, 0x05, 0x9d, 0xcc, 0x6e,
-	0x22, 0xcf, 0x8b, 0x6e, 0x40, 0x92, 0x1e, 0xda, 0xd8, 0x39, 0x24, 0x7d, 0xfd, 0x5f, 0x2e, 0xad,
-	0x00, 0x80, 0xee, 0x40, 0x96, 0x75, 0x63, 0x40, 0x09, 0x2f, 0xa4, 0x64, 0x5c, 0x54, 0xcb, 0x07,
-	0xb1, 0x00, 0x7f, 0x8b, 0x43, 0x8c, 0xc7, 0x26, 0x9d, 0xb1, 0xa6, 0x53, 0x27, 0xdf, 0x74, 0xfd,
-	0x1e, 0x7e, 0x5c, 0xfd, 0x22, 0x8b, 0xeb, 0x73, 0xbc, 0x16, 0xe1, 0x8f, 0xa8, 0xc5, 0x54, 0xde,
-	0x23, 0xa7, 0xcf, 0x7b, 0xf4, 0xec, 0x79, 0x8f, 0x9d, 0x22, 0xef, 0x48, 0x86, 0x75, 0x37, 0xd1,
-	0x86, 0x65, 0x50, 0x23, 0xb8, 0x6a, 0x54, 0x16, 0xbe, 0x18, 0x5f, 0xa8, 0x70, 0xc1, 0x34, 0x2c,
-	0xd9, 0xc3, 0xf3, 0xf4, 0x28, 0x2e, 0x1a, 0x55, 0xe1, 0xfc, 0xe4, 0x24, 0xe9, 0x6a, 0x56, 0x17,
-	0xf7, 0xb9, 0x4c, 0x62, 0xa1, 0xcc, 0x8a, 0x0f, 0xde, 0x65, 0x58, 0x4f, 0x63, 0x0f, 0x56, 0xe7,
-	0x35, 0x74, 0xec, 0x50, 0x76, 0xbf, 0xfc, 0xd7, 0xd9, 0x83, 0x66, 0xc5, 0x6a, 0xd8, 0xa1, 0xe8,
-	0x00, 0xd6, 0x26, 0x27, 0xb9, 0x3a, 0x5b, 0x37, 0x38, 0x5d, 0xdd, 0xce, 0x4f, 0xf8, 0xed, 0xe9,
-	0x02, 0x7e, 0x01, 0x2b, 0x81, 0x70, 0x90, 0xef, 0xd4, 0xc2, 0x6d, 0xa2, 0x09, 0x34, 0x48, 0xfa,
-	0x63, 0x08, 0x94, 0xd5, 0xe9, 0x3e, 0x4f, 0x9f, 0xa1, 0xcf, 0x83, 0x18, 0x1e, 0x06, 0x0d, 0xbf,
-	0x0d, 0xb9, 0xce, 0xd0, 0xb6, 0xdc, 0xed, 0x62, 0x95, 0x77, 0x59, 0x86, 0xdd, 0x6a, 0x59, 0xd7,
-	0xee, 0x1e, 0xb9, 0x5f, 0x79, 0xdd, 0x55, 0x81, 0x4d, 0x86, 0x9c, 0xa4, 0x7b, 0x32, 0x24, 0x36,
-	0x76, 0xd9, 0xfc, 0x32, 0xdc, 0x70, 0x41, 0xfe, 0x9b, 0x97, 0x3f, 0x0d, 0x1e, 0x02, 0x5d, 0x81,
-	0x6c, 0xb0, 0x98, 0xdb, 0x56, 0xe2, 0x32, 0xe3, 0xa4, 0xfd, 0xa5, 0xdc, 0xeb, 0xe6, 0xda, 0x77,
-	0x02, 0xc0, 0xd4, 0x2b, 0xf3, 0x45, 0x58, 0x6b, 0xd7, 0x5b, 0x92, 0x5a, 0x6f, 0xb4, 0xe4, 0xfa,
-	0xbe, 0xfa, 0x68, 0xbf, 0xd9, 0x90, 0x76, 0xe5, 0x7b, 0xb2, 0x54, 0xcb, 0x2d, 0xa1, 0x15, 0x58,
-	0x9e, 0x76, 0x3e, 0x91, 0x9a, 0x39, 0x01, 0xad, 0xc1, 0xca, 0xb4, 0xb1, 0x52, 0x6d, 0xb6, 0x2a,
-	0xf2, 0x7e, 0x2e, 0x84, 0x10, 0x64, 0xa7, 0x1d, 0xfb, 0xf5, 0x5c, 0x18, 0x5d, 0x02, 0x71, 0xd6,
-	0xa6, 0x1e, 0xc8, 0xad, 0xfb, 0x6a, 0x5b, 0x6a, 0xd5, 0x73, 0x91, 0x6b, 0xbf, 0x0a, 0x90, 0x9d,
-	0x7d, 0x8d, 0x44, 0x05, 0xb8, 0xd8, 0x50, 0xea, 0x8d, 0x7a, 0xb3, 0xf2, 0x40, 0x6d, 0xb6, 0x2a,
-	0xad, 0x47, 0xcd, 0xb9, 0x98, 0x8a, 0x90, 0x9f, 0x07, 0xd4, 0xa4, 0x46, 0xbd, 0x29, 0xb7, 0xd4,
-	0x86, 0xa4, 0xc8, 0xf5, 0x5a, 0x4e, 0x40, 0x97, 0x61, 0x73, 0x1e, 0xd3, 0xae, 0xb7, 0xe4, 0xfd,
-	0x2f, 0x7d, 0x48, 0x08, 0x6d, 0xc0, 0x85, 0x79, 0x48, 0xa3, 0xd2, 0x6c, 0x4a, 0x35, 0x2f, 0xe8,
-	0x79, 0x9f, 0x22, 0xed, 0x49, 0xbb, 0x2d, 0xa9, 0x96, 0x8b, 0x2c, 0x62, 0xde, 0xab, 0xc8, 0x0f,
-	0xa4, 0x5a, 0x2e, 0x5a, 0x95, 0x5e, 0xbe, 0xcb, 0x0b, 0xaf, 0xde, 0xe5, 0x85, 0xbf, 0xde, 0xe5,
-	0x85, 0xe7, 0xef, 0xf3, 0x4b, 0xaf, 0xde, 0xe7, 0x97, 0xfe, 0x78, 0x9f, 0x5f, 0xfa, 0xfa, 0x7a,
-	0xcf, 0xa0, 0x87, 0xc3, 0x4e, 0xa9, 0x4b, 0x4c, 0xfe, 0x71, 0xc3, 0xff, 0xdd, 0x74, 0xf4, 0x6f,
-	0xca, 0x47, 0xec, 0x83, 0x8d, 0x8e, 0x07, 0xd8, 0x71, 0xbf, 0xc6, 0x62, 0x6c, 0x02, 0x6e, 0xff,
-	0x13, 0x00, 0x00, 0xff, 0xff, 0xba, 0xe0, 0x97, 0xe3, 0xce, 0x0d, 0x00, 0x00,
+func (m *TallyParams) Reset()         { *m = TallyParams{} }
+func (m *TallyParams) String() string { return proto.CompactTextString(m) }
+func (*TallyParams) ProtoMessage()    {}
+func (*TallyParams) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e05cb1c0d030febb, []int{15}
 }
-
-func (m *WeightedVoteOption) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *TallyParams) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TallyParams) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TallyParams.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
 }
-
-func (m *WeightedVoteOption) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *TallyParams) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TallyParams.Merge(m, src)
 }
-
-func (m *WeightedVoteOption) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Weight) > 0 {
-		i -= len(m.Weight)
-		copy(dAtA[i:], m.Weight)
-		i = encodeVarintGov(dAtA, i, uint64(len(m.Weight)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if m.Option != 0 {
-		i = encodeVarintGov(dAtA, i, uint64(m.Option))
-		i--
-		dAtA[i] = 0x8
-	}
-	return len(dAtA) - i, nil
+func (m *TallyParams) XXX_Size() int {
+	return m.Size()
+}
+func (m *TallyParams) XXX_DiscardUnknown() {
+	xxx_messageInfo_TallyParams.DiscardUnknown(m)
 }
 
-func (m *Deposit) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_TallyParams proto.InternalMessageInfo
+
+func (m *TallyParams) GetQuorum() string {
+	if m != nil {
+		return m.Quorum
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *Deposit) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *TallyParams) GetThreshold() string {
+	if m != nil {
+		return m.Threshold
+	}
+	return ""
 }
 
-func (m *Deposit) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Amount) > 0 {
-		for iNdEx := len(m.Amount) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Amount[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintGov(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x1a
-		}
-	}
-	if len(m.Depositor) > 0 {
-		i -= len(m.Depositor)
-		copy(dAtA[i:], m.Depositor)
-		i = encodeVarintGov(dAtA, i, uint64(len(m.Depositor)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if m.ProposalId != 0 {
-		i = encodeVarintGov(dAtA, i, uint64(m.ProposalId))
-		i--
-		dAtA[i] = 0x8
+func (m *TallyParams) GetVetoThreshold() string {
+	if m != nil {
+		return m.VetoThreshold
 	}
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func (m *Proposal) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *TallyParams) GetVetoQuorum() string {
+	if m != nil {
+		return m.VetoQuorum
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *Proposal) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *TallyParams) GetProposalKindQuorums() []ProposalKindQuorum {
+	if m != nil {
+		return m.ProposalKindQuorums
+	}
+	return nil
 }
 
-func (m *Proposal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Expedited {
-		i--
-		if m.Expedited {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
-		i--
-		dAtA[i] = 0x70
-	}
-	if len(m.Proposer) > 0 {
-		i -= len(m.Proposer)
-		copy(dAtA[i:], m.Proposer)
-		i = encodeVarintGov(dAtA, i, uint64(len(m.Proposer)))
-		i--
-		dAtA[i] = 0x6a
-	}
-	if len(m.Summary) > 0 {
-		i -= len(m.Summary)
-		copy(dAtA[i:], m.Summary)
-		i = encodeVarintGov(dAtA, i, uint64(len(m.Summary)))
-		i--
-		dAtA[i] = 0x62
-	}
-	if len(m.Title) > 0 {
-		i -= len(m.Title)
-		copy(dAtA[i:], m.Title)
-		i = encodeVarintGov(dAtA, i, uint64(len(m.Title)))
-		i--
-		dAtA[i] = 0x5a
-	}
-	if len(m.Metadata) > 0 {
-		i -= len(m.Metadata)
-		copy(dAtA[i:], m.Metadata)
-		i = encodeVarintGov(dAtA, i, uint64(len(m.Metadata)))
-		i--
-		dAtA[i] = 0x52
-	}
-	if m.VotingEndTime != nil {
-		n1, err1 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(*m.VotingEndTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.VotingEndTime):])
-		if err1 != nil {
-			return 0, err1
-		}
-		i -= n1
-		i = encodeVarintGov(dAtA, i, uint64(n1))
-		i--
-		dAtA[i] = 0x4a
-	}
-	if m.VotingStartTime != nil {
-		n2, err2 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(*m.VotingStartTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.VotingStartTime):])
-		if err2 != nil {
-			return 0, err2
-		}
-		i -= n2
-		i = encodeVarintGov(dAtA, i, uint64(n2))
-		i--
-		dAtA[i] = 0x42
-	}
-	if len(m.TotalDeposit) > 0 {
-		for iNdEx := len(m.TotalDeposit) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.TotalDeposit[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintGov(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x3a
-		}
-	}
-	if m.DepositEndTime != nil {
-		n3, err3 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(*m.DepositEndTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.DepositEndTime):])
-		if err3 != nil {
-			return 0, err3
-		}
-		i -= n3
-		i = encodeVarintGov(dAtA, i, uint64(n3))
-		i--
-		dAtA[i] = 0x32
-	}
-	if m.SubmitTime != nil {
-		n4, err4 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(*m.SubmitTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.SubmitTime):])
-		if err4 != nil {
-			return 0, err4
-		}
-		i -= n4
-		i = encodeVarintGov(dAtA, i, uint64(n4))
-		i--
-		dAtA[i] = 0x2a
-	}
-	if m.FinalTallyResult != nil {
-		{
-			size, err := m.FinalTallyResult.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintGov(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x22
-	}
-	if m.Status != 0 {
-		i = encodeVarintGov(dAtA, i, uint64(m.Status))
-		i--
-		dAtA[i] = 0x18
-	}
-	if len(m.Messages) > 0 {
-		for iNdEx := len(m.Messages) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Messages[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintGov(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x12
+// Params defines the parameters for the x/gov module.
+//
+// Since: cosmos-sdk 0.47
+type Params struct {
+	// Minimum deposit for a proposal to enter voting period.
+	MinDeposit []types.Coin `protobuf:"bytes,1,rep,name=min_deposit,json=minDeposit,proto3" json:"min_deposit"`
+	// Maximum period for Atom holders to deposit on a proposal. Initial value: 2
+	// months.
+	MaxDepositPeriod *time.Duration `protobuf:"bytes,2,opt,name=max_deposit_period,json=maxDepositPeriod,proto3,stdduration" json:"max_deposit_period,omitempty"`
+	// Duration of the voting period.
+	VotingPeriod *time.Duration `protobuf:"bytes,3,opt,name=voting_period,json=votingPeriod,proto3,stdduration" json:"voting_period,omitempty"`
+	//  Minimum percentage of total stake needed to vote for a result to be
+	//  considered valid.
+	Quorum string `protobuf:"bytes,4,opt,name=quorum,proto3" json:"quorum,omitempty"`
+	//  Minimum proportion of Yes votes for proposal to pass. Default value: 0.5.
+	Threshold string `protobuf:"bytes,5,opt,name=threshold,proto3" json:"threshold,omitempty"`
+	//  Minimum value of Veto votes to Total votes ratio for proposal to be
+	//  vetoed. Default value: 1/3.
+	VetoThreshold string `protobuf:"bytes,6,opt,name=veto_threshold,json=vetoThreshold,proto3" json:"veto_threshold,omitempty"`
+	//  The ratio representing the proportion of the deposit value that must be paid at proposal submission.
+	MinInitialDepositRatio string `protobuf:"bytes,7,opt,name=min_initial_deposit_ratio,json=minInitialDepositRatio,proto3" json:"min_initial_deposit_ratio,omitempty"`
+	// The cancel ratio which will not be returned back to the depositors when a proposal is cancelled.
+	//
+	// Since: cosmos-sdk 0.48
+	ProposalCancelRatio string `protobuf:"bytes,8,opt,name=proposal_cancel_ratio,json=proposalCancelRatio,proto3" json:"proposal_cancel_ratio,omitempty"`
+	// The address which will receive (proposal_cancel_ratio * deposit) proposal deposits.
+	// If empty, the (proposal_cancel_ratio * deposit) proposal deposits will be burned.
+	//
+	// Since: cosmos-sdk 0.48
+	ProposalCancelDest string `protobuf:"bytes,9,opt,name=proposal_cancel_dest,json=proposalCancelDest,proto3" json:"proposal_cancel_dest,omitempty"`
+	// Duration of the voting period of an expedited proposal.
+	//
+	// Since: cosmos-sdk 0.48
+	ExpeditedVotingPeriod *time.Duration `protobuf:"bytes,10,opt,name=expedited_voting_period,json=expeditedVotingPeriod,proto3,stdduration" json:"expedited_voting_period,omitempty"`
+	// Minimum proportion of Yes votes for proposal to pass. Default value: 0.67.
+	//
+	// Since: cosmos-sdk 0.48
+	ExpeditedThreshold string `protobuf:"bytes,11,opt,name=expedited_threshold,json=expeditedThreshold,proto3" json:"expedited_threshold,omitempty"`
+	//  Minimum expedited deposit for a proposal to enter voting period.
+	ExpeditedMinDeposit []types.Coin `protobuf:"bytes,12,rep,name=expedited_min_deposit,json=expeditedMinDeposit,proto3" json:"expedited_min_deposit"`
+	// burn deposits if a proposal does not meet quorum
+	BurnVoteQuorum bool `protobuf:"varint,13,opt,name=burn_vote_quorum,json=burnVoteQuorum,proto3" json:"burn_vote_quorum,omitempty"`
+	// burn deposits if the proposal does not enter voting period
+	BurnProposalDepositPrevote bool `protobuf:"varint,14,opt,name=burn_proposal_deposit_prevote,json=burnProposalDepositPrevote,proto3" json:"burn_proposal_deposit_prevote,omitempty"`
+	// burn deposits if quorum with vote type no_veto is met
+	BurnVoteVeto bool `protobuf:"varint,15,opt,name=burn_vote_veto,json=burnVoteVeto,proto3" json:"burn_vote_veto,omitempty"`
+	// The proportion of the minimum deposit that a proposal must reach for its
+	// deposit period to be extended when it is about to end.
+	//
+	// Since: cosmos-sdk 0.48
+	DepositExtensionRatio string `protobuf:"bytes,16,opt,name=deposit_extension_ratio,json=depositExtensionRatio,proto3" json:"deposit_extension_ratio,omitempty"`
+	// Duration by which the deposit period of a proposal is extended, once, when
+	// its deposit reaches deposit_extension_ratio of the minimum deposit less
+	// than this duration before the deposit period ends. Zero disables extensions.
+	//
+	// Since: cosmos-sdk 0.48
+	DepositExtensionPeriod *time.Duration `protobuf:"bytes,17,opt,name=deposit_extension_period,json=depositExtensionPeriod,proto3,stdduration" json:"deposit_extension_period,omitempty"`
+	// The addresses, other than the gov module account, which proposals may
+	// designate as the authority executing their messages, e.g. the module
+	// account of a subDAO.
+	//
+	// Since: cosmos-sdk 0.48
+	ExecutionAuthorities []string `protobuf:"bytes,18,rep,name=execution_authorities,json=executionAuthorities,proto3" json:"execution_authorities,omitempty"`
+	// Duration of the discussion period between the deposit period and the
+	// voting period of a proposal, during which the proposer can amend the
+	// proposal metadata and depositors can withdraw their deposits. Zero disables
+	// the discussion period.
+	//
+	// Since: cosmos-sdk 0.48
+	DiscussionPeriod *time.Duration `protobuf:"bytes,19,opt,name=discussion_period,json=discussionPeriod,proto3,stdduration" json:"discussion_period,omitempty"`
+	// early_resolution enables finalizing proposals before the end of their
+	// voting period once the voting power which has not voted yet cannot change
+	// their outcome anymore.
+	//
+	// Since: cosmos-sdk 0.48
+	EarlyResolution bool `protobuf:"varint,20,opt,name=early_resolution,json=earlyResolution,proto3" json:"early_resolution,omitempty"`
+	// The maximum number of proposals whose voting period ended which are
+	// tallied in a single EndBlocker. The remaining proposals are carried over to
+	// the following blocks, in the order of their voting end time and proposal
+	// ID. Zero means no limit.
+	//
+	// Since: cosmos-sdk 0.48
+	MaxProposalsProcessedPerEndBlock uint64 `protobuf:"varint,21,opt,name=max_proposals_processed_per_end_block,json=maxProposalsProcessedPerEndBlock,proto3" json:"max_proposals_processed_per_end_block,omitempty"`
+	// Minimum proportion of Yes votes for a constitution amendment proposal to
+	// pass. It must be at least the regular threshold.
+	//
+	// Since: cosmos-sdk 0.48
+	ConstitutionAmendmentThreshold string `protobuf:"bytes,22,opt,name=constitution_amendment_threshold,json=constitutionAmendmentThreshold,proto3" json:"constitution_amendment_threshold,omitempty"`
+	// The way Abstain votes are counted in the tally of proposals.
+	//
+	// Since: cosmos-sdk 0.48
+	AbstainSemantics AbstainSemantics `protobuf:"varint,23,opt,name=abstain_semantics,json=abstainSemantics,proto3,enum=cosmos.gov.v1.AbstainSemantics" json:"abstain_semantics,omitempty"`
+	// The denoms, other than the ones of the minimum deposits, accepted for
+	// proposal deposits, with their exchange ratio to a minimum deposit denom.
+	//
+	// Since: cosmos-sdk 0.48
+	AcceptedDepositDenoms []AcceptedDepositDenom `protobuf:"bytes,24,rep,name=accepted_deposit_denoms,json=acceptedDepositDenoms,proto3" json:"accepted_deposit_denoms"`
+	// Minimum duration left in the discussion period of a proposal after it is
+	// amended by its proposer. Amending a proposal extends its discussion period
+	// so that it does not end sooner than amendment_period after the amendment.
+	// Zero disables the extension.
+	//
+	// Since: cosmos-sdk 0.48
+	AmendmentPeriod *time.Duration `protobuf:"bytes,25,opt,name=amendment_period,json=amendmentPeriod,proto3,stdduration" json:"amendment_period,omitempty"`
+	// Fraction of the total bonded tokens below which a validator's voting power
+	// exempts it from governance participation tracking, evaluated at tally
+	// time. The votes of exempt validators still count towards the tally. Zero
+	// disables the exemption.
+	//
+	// Since: cosmos-sdk 0.48
+	ParticipationExemptionThreshold string `protobuf:"bytes,26,opt,name=participation_exemption_threshold,json=participationExemptionThreshold,proto3" json:"participation_exemption_threshold,omitempty"`
+	// min_deposit_any_denom makes a deposit threshold met once the value of the
+	// deposit reaches the amount of any one of its denoms, instead of all of
+	// them, for chains whose users hold different native assets.
+	//
+	// Since: cosmos-sdk 0.48
+	MinDepositAnyDenom bool `protobuf:"varint,27,opt,name=min_deposit_any_denom,json=minDepositAnyDenom,proto3" json:"min_deposit_any_denom,omitempty"`
+	// Duration of the reveal period following the commit period of commit-reveal
+	// proposals, during which voters reveal the votes they committed to. The
+	// commit period lasts the voting period. Zero disables commit-reveal voting.
+	//
+	// Since: cosmos-sdk 0.48
+	RevealPeriod *time.Duration `protobuf:"bytes,28,opt,name=reveal_period,json=revealPeriod,proto3,stdduration" json:"reveal_period,omitempty"`
+	// Minimum percentage of total stake needed to vote for the Veto votes to
+	// count, so that a small turnout cannot veto a proposal. Below it, the Veto
+	// votes count as No votes. Zero makes the Veto votes always count.
+	//
+	// Since: cosmos-sdk 0.48
+	VetoQuorum string `protobuf:"bytes,29,opt,name=veto_quorum,json=vetoQuorum,proto3" json:"veto_quorum,omitempty"`
+	// The quorums overriding quorum and veto_quorum for the proposals of given
+	// kinds, e.g. "expedited".
+	//
+	// Since: cosmos-sdk 0.48
+	ProposalKindQuorums []ProposalKindQuorum `protobuf:"bytes,30,rep,name=proposal_kind_quorums,json=proposalKindQuorums,proto3" json:"proposal_kind_quorums"`
+}
+
+func (m *Params) Reset()         { *m = Params{} }
+func (m *Params) String() string { return proto.CompactTextString(m) }
+func (*Params) ProtoMessage()    {}
+func (*Params) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e05cb1c0d030febb, []int{16}
+}
+func (m *Params) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Params) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Params.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if m.Id != 0 {
-		i = encodeVarintGov(dAtA, i, uint64(m.Id))
-		i--
-		dAtA[i] = 0x8
-	}
-	return len(dAtA) - i, nil
+}
+func (m *Params) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Params.Merge(m, src)
+}
+func (m *Params) XXX_Size() int {
+	return m.Size()
+}
+func (m *Params) XXX_DiscardUnknown() {
+	xxx_messageInfo_Params.DiscardUnknown(m)
 }
 
-func (m *TallyResult) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_Params proto.InternalMessageInfo
+
+func (m *Params) GetMinDeposit() []types.Coin {
+	if m != nil {
+		return m.MinDeposit
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *TallyResult) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *Params) GetMaxDepositPeriod() *time.Duration {
+	if m != nil {
+		return m.MaxDepositPeriod
+	}
+	return nil
 }
 
-func (m *TallyResult) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.NoWithVetoCount) > 0 {
-		i -= len(m.NoWithVetoCount)
-		copy(dAtA[i:], m.NoWithVetoCount)
-		i = encodeVarintGov(dAtA, i, uint64(len(m.NoWithVetoCount)))
-		i--
-		dAtA[i] = 0x22
-	}
-	if len(m.NoCount) > 0 {
-		i -= len(m.NoCount)
-		copy(dAtA[i:], m.NoCount)
-		i = encodeVarintGov(dAtA, i, uint64(len(m.NoCount)))
-		i--
-		dAtA[i] = 0x1a
-	}
-	if len(m.AbstainCount) > 0 {
-		i -= len(m.AbstainCount)
-		copy(dAtA[i:], m.AbstainCount)
-		i = encodeVarintGov(dAtA, i, uint64(len(m.AbstainCount)))
-		i--
-		dAtA[i] = 0x12
+func (m *Params) GetVotingPeriod() *time.Duration {
+	if m != nil {
+		return m.VotingPeriod
 	}
-	if len(m.YesCount) > 0 {
-		i -= len(m.YesCount)
-		copy(dAtA[i:], m.YesCount)
-		i = encodeVarintGov(dAtA, i, uint64(len(m.YesCount)))
-		i--
-		dAtA[i] = 0xa
+	return nil
+}
+
+func (m *Params) GetQuorum() string {
+	if m != nil {
+		return m.Quorum
 	}
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func (m *Vote) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *Params) GetThreshold() string {
+	if m != nil {
+		return m.Threshold
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *Vote) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *Params) GetVetoThreshold() string {
+	if m != nil {
+		return m.VetoThreshold
+	}
+	return ""
 }
 
-func (m *Vote) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Metadata) > 0 {
-		i -= len(m.Metadata)
-		copy(dAtA[i:], m.Metadata)
-		i = encodeVarintGov(dAtA, i, uint64(len(m.Metadata)))
-		i--
-		dAtA[i] = 0x2a
+func (m *Params) GetMinInitialDepositRatio() string {
+	if m != nil {
+		return m.MinInitialDepositRatio
 	}
-	if len(m.Options) > 0 {
-		for iNdEx := len(m.Options) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Options[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintGov(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x22
-		}
+	return ""
+}
+
+func (m *Params) GetProposalCancelRatio() string {
+	if m != nil {
+		return m.ProposalCancelRatio
 	}
-	if len(m.Voter) > 0 {
-		i -= len(m.Voter)
-		copy(dAtA[i:], m.Voter)
-		i = encodeVarintGov(dAtA, i, uint64(len(m.Voter)))
-		i--
-		dAtA[i] = 0x12
+	return ""
+}
+
+func (m *Params) GetProposalCancelDest() string {
+	if m != nil {
+		return m.ProposalCancelDest
 	}
-	if m.ProposalId != 0 {
-		i = encodeVarintGov(dAtA, i, uint64(m.ProposalId))
-		i--
-		dAtA[i] = 0x8
+	return ""
+}
+
+func (m *Params) GetExpeditedVotingPeriod() *time.Duration {
+	if m != nil {
+		return m.ExpeditedVotingPeriod
 	}
-	return len(dAtA) - i, nil
+	return nil
 }
 
-func (m *DepositParams) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *Params) GetExpeditedThreshold() string {
+	if m != nil {
+		return m.ExpeditedThreshold
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *DepositParams) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *Params) GetExpeditedMinDeposit() []types.Coin {
+	if m != nil {
+		return m.ExpeditedMinDeposit
+	}
+	return nil
 }
 
-func (m *DepositParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.MaxDepositPeriod != nil {
-		n6, err6 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(*m.MaxDepositPeriod, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(*m.MaxDepositPeriod):])
-		if err6 != nil {
-			return 0, err6
-		}
-		i -= n6
-		i = encodeVarintGov(dAtA, i, uint64(n6))
-		i--
-		dAtA[i] = 0x12
+func (m *Params) GetBurnVoteQuorum() bool {
+	if m != nil {
+		return m.BurnVoteQuorum
 	}
-	if len(m.MinDeposit) > 0 {
-		for iNdEx := len(m.MinDeposit) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.MinDeposit[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintGov(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
-		}
+	return false
+}
+
+func (m *Params) GetBurnProposalDepositPrevote() bool {
+	if m != nil {
+		return m.BurnProposalDepositPrevote
 	}
-	return len(dAtA) - i, nil
+	return false
 }
 
-func (m *VotingParams) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *Params) GetBurnVoteVeto() bool {
+	if m != nil {
+		return m.BurnVoteVeto
 	}
-	return dAtA[:n], nil
+	return false
 }
 
-func (m *VotingParams) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *Params) GetDepositExtensionRatio() string {
+	if m != nil {
+		return m.DepositExtensionRatio
+	}
+	return ""
 }
 
-func (m *VotingParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.VotingPeriod != nil {
-		n7, err7 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(*m.VotingPeriod, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(*m.VotingPeriod):])
-		if err7 != nil {
-			return 0, err7
-		}
-		i -= n7
-		i = encodeVarintGov(dAtA, i, uint64(n7))
-		i--
-		dAtA[i] = 0xa
+func (m *Params) GetDepositExtensionPeriod() *time.Duration {
+	if m != nil {
+		return m.DepositExtensionPeriod
 	}
-	return len(dAtA) - i, nil
+	return nil
 }
 
-func (m *TallyParams) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *Params) GetExecutionAuthorities() []string {
+	if m != nil {
+		return m.ExecutionAuthorities
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *TallyParams) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *Params) GetDiscussionPeriod() *time.Duration {
+	if m != nil {
+		return m.DiscussionPeriod
+	}
+	return nil
 }
 
-func (m *TallyParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.VetoThreshold) > 0 {
-		i -= len(m.VetoThreshold)
-		copy(dAtA[i:], m.VetoThreshold)
-		i = encodeVarintGov(dAtA, i, uint64(len(m.VetoThreshold)))
-		i--
-		dAtA[i] = 0x1a
+func (m *Params) GetEarlyResolution() bool {
+	if m != nil {
+		return m.EarlyResolution
 	}
-	if len(m.Threshold) > 0 {
-		i -= len(m.Threshold)
-		copy(dAtA[i:], m.Threshold)
-		i = encodeVarintGov(dAtA, i, uint64(len(m.Threshold)))
-		i--
-		dAtA[i] = 0x12
+	return false
+}
+
+func (m *Params) GetMaxProposalsProcessedPerEndBlock() uint64 {
+	if m != nil {
+		return m.MaxProposalsProcessedPerEndBlock
 	}
-	if len(m.Quorum) > 0 {
-		i -= len(m.Quorum)
-		copy(dAtA[i:], m.Quorum)
-		i = encodeVarintGov(dAtA, i, uint64(len(m.Quorum)))
-		i--
-		dAtA[i] = 0xa
+	return 0
+}
+
+func (m *Params) GetConstitutionAmendmentThreshold() string {
+	if m != nil {
+		return m.ConstitutionAmendmentThreshold
 	}
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func (m *Params) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *Params) GetAbstainSemantics() AbstainSemantics {
+	if m != nil {
+		return m.AbstainSemantics
 	}
-	return dAtA[:n], nil
+	return AbstainSemantics_ABSTAIN_SEMANTICS_UNSPECIFIED
 }
 
-func (m *Params) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *Params) GetAcceptedDepositDenoms() []AcceptedDepositDenom {
+	if m != nil {
+		return m.AcceptedDepositDenoms
+	}
+	return nil
 }
 
-func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.BurnVoteVeto {
-		i--
-		if m.BurnVoteVeto {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
-		i--
-		dAtA[i] = 0x78
+func (m *Params) GetAmendmentPeriod() *time.Duration {
+	if m != nil {
+		return m.AmendmentPeriod
 	}
-	if m.BurnProposalDepositPrevote {
-		i--
-		if m.BurnProposalDepositPrevote {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
-		i--
-		dAtA[i] = 0x70
+	return nil
+}
+
+func (m *Params) GetParticipationExemptionThreshold() string {
+	if m != nil {
+		return m.ParticipationExemptionThreshold
 	}
-	if m.BurnVoteQuorum {
-		i--
-		if m.BurnVoteQuorum {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
-		i--
-		dAtA[i] = 0x68
+	return ""
+}
+
+func (m *Params) GetMinDepositAnyDenom() bool {
+	if m != nil {
+		return m.MinDepositAnyDenom
 	}
-	if len(m.ExpeditedMinDeposit) > 0 {
-		for iNdEx := len(m.ExpeditedMinDeposit) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.ExpeditedMinDeposit[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintGov(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x62
+	return false
+}
+
+func (m *Params) GetRevealPeriod() *time.Duration {
+	if m != nil {
+		return m.RevealPeriod
+	}
+	return nil
+}
+
+func (m *Params) GetVetoQuorum() string {
+	if m != nil {
+		return m.VetoQuorum
+	}
+	return ""
+}
+
+func (m *Params) GetProposalKindQuorums() []ProposalKindQuorum {
+	if m != nil {
+		return m.ProposalKindQuorums
+	}
+	return nil
+}
+
+// ProposalKindQuorum defines the quorums of the proposals of a given kind.
+//
+// Since: cosmos-sdk 0.48
+type ProposalKindQuorum struct {
+	// kind is the kind of the proposals, e.g. "standard" or "expedited".
+	Kind string `protobuf:"bytes,1,opt,name=kind,proto3" json:"kind,omitempty"`
+	// quorum is the minimum percentage of total stake needed to vote for a
+	// result to be considered valid. The quorum of the params applies if empty.
+	Quorum string `protobuf:"bytes,2,opt,name=quorum,proto3" json:"quorum,omitempty"`
+	// veto_quorum is the minimum percentage of total stake needed to vote for
+	// the Veto votes to count. The veto quorum of the params applies if empty.
+	VetoQuorum string `protobuf:"bytes,3,opt,name=veto_quorum,json=vetoQuorum,proto3" json:"veto_quorum,omitempty"`
+}
+
+func (m *ProposalKindQuorum) Reset()         { *m = ProposalKindQuorum{} }
+func (m *ProposalKindQuorum) String() string { return proto.CompactTextString(m) }
+func (*ProposalKindQuorum) ProtoMessage()    {}
+func (*ProposalKindQuorum) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e05cb1c0d030febb, []int{17}
+}
+func (m *ProposalKindQuorum) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ProposalKindQuorum) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ProposalKindQuorum.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if len(m.ExpeditedThreshold) > 0 {
-		i -= len(m.ExpeditedThreshold)
-		copy(dAtA[i:], m.ExpeditedThreshold)
-		i = encodeVarintGov(dAtA, i, uint64(len(m.ExpeditedThreshold)))
-		i--
-		dAtA[i] = 0x5a
+}
+func (m *ProposalKindQuorum) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ProposalKindQuorum.Merge(m, src)
+}
+func (m *ProposalKindQuorum) XXX_Size() int {
+	return m.Size()
+}
+func (m *ProposalKindQuorum) XXX_DiscardUnknown() {
+	xxx_messageInfo_ProposalKindQuorum.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ProposalKindQuorum proto.InternalMessageInfo
+
+func (m *ProposalKindQuorum) GetKind() string {
+	if m != nil {
+		return m.Kind
 	}
-	if m.ExpeditedVotingPeriod != nil {
-		n8, err8 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(*m.ExpeditedVotingPeriod, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(*m.ExpeditedVotingPeriod):])
-		if err8 != nil {
-			return 0, err8
+	return ""
+}
+
+func (m *ProposalKindQuorum) GetQuorum() string {
+	if m != nil {
+		return m.Quorum
+	}
+	return ""
+}
+
+func (m *ProposalKindQuorum) GetVetoQuorum() string {
+	if m != nil {
+		return m.VetoQuorum
+	}
+	return ""
+}
+
+// AcceptedDepositDenom defines a denom accepted for proposal deposits in place
+// of a minimum deposit denom.
+//
+// Since: cosmos-sdk 0.48
+type AcceptedDepositDenom struct {
+	// denom is the denom accepted for deposits.
+	Denom string `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty"`
+	// base_denom is the minimum deposit denom towards which deposits of denom
+	// count.
+	BaseDenom string `protobuf:"bytes,2,opt,name=base_denom,json=baseDenom,proto3" json:"base_denom,omitempty"`
+	// ratio is the amount of base_denom which one unit of denom is worth.
+	Ratio string `protobuf:"bytes,3,opt,name=ratio,proto3" json:"ratio,omitempty"`
+	// use_oracle makes the ratio read from the deposit ratio oracle set by the
+	// application, if any, falling back to ratio when the oracle has no ratio
+	// for the denom.
+	UseOracle bool `protobuf:"varint,4,opt,name=use_oracle,json=useOracle,proto3" json:"use_oracle,omitempty"`
+}
+
+func (m *AcceptedDepositDenom) Reset()         { *m = AcceptedDepositDenom{} }
+func (m *AcceptedDepositDenom) String() string { return proto.CompactTextString(m) }
+func (*AcceptedDepositDenom) ProtoMessage()    {}
+func (*AcceptedDepositDenom) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e05cb1c0d030febb, []int{18}
+}
+func (m *AcceptedDepositDenom) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *AcceptedDepositDenom) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_AcceptedDepositDenom.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i -= n8
-		i = encodeVarintGov(dAtA, i, uint64(n8))
-		i--
-		dAtA[i] = 0x52
+		return b[:n], nil
 	}
-	if len(m.ProposalCancelDest) > 0 {
-		i -= len(m.ProposalCancelDest)
-		copy(dAtA[i:], m.ProposalCancelDest)
-		i = encodeVarintGov(dAtA, i, uint64(len(m.ProposalCancelDest)))
-		i--
-		dAtA[i] = 0x4a
+}
+func (m *AcceptedDepositDenom) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AcceptedDepositDenom.Merge(m, src)
+}
+func (m *AcceptedDepositDenom) XXX_Size() int {
+	return m.Size()
+}
+func (m *AcceptedDepositDenom) XXX_DiscardUnknown() {
+	xxx_messageInfo_AcceptedDepositDenom.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AcceptedDepositDenom proto.InternalMessageInfo
+
+func (m *AcceptedDepositDenom) GetDenom() string {
+	if m != nil {
+		return m.Denom
 	}
-	if len(m.ProposalCancelRatio) > 0 {
-		i -= len(m.ProposalCancelRatio)
-		copy(dAtA[i:], m.ProposalCancelRatio)
-		i = encodeVarintGov(dAtA, i, uint64(len(m.ProposalCancelRatio)))
-		i--
-		dAtA[i] = 0x42
+	return ""
+}
+
+func (m *AcceptedDepositDenom) GetBaseDenom() string {
+	if m != nil {
+		return m.BaseDenom
 	}
-	if len(m.MinInitialDepositRatio) > 0 {
-		i -= len(m.MinInitialDepositRatio)
-		copy(dAtA[i:], m.MinInitialDepositRatio)
-		i = encodeVarintGov(dAtA, i, uint64(len(m.MinInitialDepositRatio)))
-		i--
-		dAtA[i] = 0x3a
+	return ""
+}
+
+func (m *AcceptedDepositDenom) GetRatio() string {
+	if m != nil {
+		return m.Ratio
 	}
-	if len(m.VetoThreshold) > 0 {
-		i -= len(m.VetoThreshold)
-		copy(dAtA[i:], m.VetoThreshold)
-		i = encodeVarintGov(dAtA, i, uint64(len(m.VetoThreshold)))
-		i--
-		dAtA[i] = 0x32
+	return ""
+}
+
+func (m *AcceptedDepositDenom) GetUseOracle() bool {
+	if m != nil {
+		return m.UseOracle
 	}
-	if len(m.Threshold) > 0 {
-		i -= len(m.Threshold)
-		copy(dAtA[i:], m.Threshold)
-		i = encodeVarintGov(dAtA, i, uint64(len(m.Threshold)))
+	return false
+}
+
+func init() {
+	proto.RegisterEnum("cosmos.gov.v1.VoteOption", VoteOption_name, VoteOption_value)
+	proto.RegisterEnum("cosmos.gov.v1.ProposalStatus", ProposalStatus_name, ProposalStatus_value)
+	proto.RegisterEnum("cosmos.gov.v1.AbstainSemantics", AbstainSemantics_name, AbstainSemantics_value)
+	proto.RegisterType((*WeightedVoteOption)(nil), "cosmos.gov.v1.WeightedVoteOption")
+	proto.RegisterType((*Deposit)(nil), "cosmos.gov.v1.Deposit")
+	proto.RegisterType((*Proposal)(nil), "cosmos.gov.v1.Proposal")
+	proto.RegisterType((*ProposalExecutionFailure)(nil), "cosmos.gov.v1.ProposalExecutionFailure")
+	proto.RegisterType((*ProposalExecutionReceipt)(nil), "cosmos.gov.v1.ProposalExecutionReceipt")
+	proto.RegisterType((*ParamsUpdateFailure)(nil), "cosmos.gov.v1.ParamsUpdateFailure")
+	proto.RegisterType((*TallyResult)(nil), "cosmos.gov.v1.TallyResult")
+	proto.RegisterType((*Vote)(nil), "cosmos.gov.v1.Vote")
+	proto.RegisterType((*VoteCommitment)(nil), "cosmos.gov.v1.VoteCommitment")
+	proto.RegisterType((*ValidatorVoteBreakdown)(nil), "cosmos.gov.v1.ValidatorVoteBreakdown")
+	proto.RegisterType((*ValidatorVotedShares)(nil), "cosmos.gov.v1.ValidatorVotedShares")
+	proto.RegisterType((*ConstitutionAmendment)(nil), "cosmos.gov.v1.ConstitutionAmendment")
+	proto.RegisterType((*MetadataSchema)(nil), "cosmos.gov.v1.MetadataSchema")
+	proto.RegisterType((*DepositParams)(nil), "cosmos.gov.v1.DepositParams")
+	proto.RegisterType((*VotingParams)(nil), "cosmos.gov.v1.VotingParams")
+	proto.RegisterType((*TallyParams)(nil), "cosmos.gov.v1.TallyParams")
+	proto.RegisterType((*Params)(nil), "cosmos.gov.v1.Params")
+	proto.RegisterType((*ProposalKindQuorum)(nil), "cosmos.gov.v1.ProposalKindQuorum")
+	proto.RegisterType((*AcceptedDepositDenom)(nil), "cosmos.gov.v1.AcceptedDepositDenom")
+}
+
+func init() { proto.RegisterFile("cosmos/gov/v1/gov.proto", fileDescriptor_e05cb1c0d030febb) }
+
+var fileDescriptor_e05cb1c0d030febb = []byte{
+	// 2559 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x59, 0xcd, 0x6f, 0x1b, 0xc7,
+	0x15, 0xf7, 0x92, 0x14, 0x25, 0x3e, 0x49, 0x14, 0x35, 0xfa, 0x5a, 0xcb, 0x16, 0x25, 0x33, 0x4e,
+	0xea, 0x38, 0xb1, 0x14, 0x25, 0x4d, 0x8a, 0x34, 0x2d, 0x0a, 0x4a, 0xa4, 0x63, 0xba, 0xb6, 0xc8,
+	0x2c, 0x29, 0x39, 0x76, 0x81, 0x2e, 0x46, 0xdc, 0x09, 0xb9, 0x30, 0x77, 0x97, 0xd9, 0x19, 0xca,
+	0xe2, 0xb1, 0xc7, 0xde, 0x72, 0xcc, 0x9f, 0xd0, 0x43, 0x0f, 0x3d, 0x04, 0xfd, 0x1b, 0xd2, 0x43,
+	0x81, 0x20, 0x97, 0x16, 0x05, 0x9a, 0x16, 0x71, 0x81, 0x02, 0x01, 0x7a, 0xec, 0xbd, 0x98, 0x8f,
+	0xe5, 0x2e, 0x97, 0xab, 0x88, 0x76, 0x7a, 0x91, 0x76, 0xe7, 0xfd, 0xde, 0x9b, 0xf7, 0x39, 0xef,
+	0xcd, 0x12, 0x36, 0xda, 0x1e, 0x75, 0x3c, 0xba, 0xd7, 0xf1, 0xce, 0xf6, 0xce, 0xf6, 0xf9, 0xbf,
+	0xdd, 0xbe, 0xef, 0x31, 0x0f, 0x2d, 0x4a, 0xc2, 0x2e, 0x5f, 0x39, 0xdb, 0xdf, 0x2c, 0x2a, 0xdc,
+	0x29, 0xa6, 0x64, 0xef, 0x6c, 0xff, 0x94, 0x30, 0xbc, 0xbf, 0xd7, 0xf6, 0x6c, 0x57, 0xc2, 0x37,
+	0x57, 0x3b, 0x5e, 0xc7, 0x13, 0x8f, 0x7b, 0xfc, 0x49, 0xad, 0x6e, 0x77, 0x3c, 0xaf, 0xd3, 0x23,
+	0x7b, 0xe2, 0xed, 0x74, 0xf0, 0xc9, 0x1e, 0xb3, 0x1d, 0x42, 0x19, 0x76, 0xfa, 0x0a, 0x70, 0x35,
+	0x0e, 0xc0, 0xee, 0x50, 0x91, 0x8a, 0x71, 0x92, 0x35, 0xf0, 0x31, 0xb3, 0xbd, 0x60, 0xc7, 0xab,
+	0x52, 0x23, 0x53, 0x6e, 0xaa, 0xb4, 0x95, 0xa4, 0x65, 0xec, 0xd8, 0xae, 0xb7, 0x27, 0xfe, 0xca,
+	0xa5, 0x92, 0x07, 0xe8, 0x11, 0xb1, 0x3b, 0x5d, 0x46, 0xac, 0x13, 0x8f, 0x91, 0x7a, 0x9f, 0x4b,
+	0x42, 0xfb, 0x90, 0xf5, 0xc4, 0x93, 0xae, 0xed, 0x68, 0xb7, 0xf2, 0x6f, 0x5f, 0xdd, 0x1d, 0xb3,
+	0x7a, 0x37, 0x84, 0x1a, 0x0a, 0x88, 0x5e, 0x83, 0xec, 0x33, 0x21, 0x48, 0x4f, 0xed, 0x68, 0xb7,
+	0x72, 0x07, 0xf9, 0xaf, 0xbf, 0xb8, 0x03, 0x8a, 0xab, 0x42, 0xda, 0x86, 0xa2, 0x96, 0xbe, 0xd3,
+	0x60, 0xb6, 0x42, 0xfa, 0x1e, 0xb5, 0x19, 0xda, 0x86, 0xf9, 0xbe, 0xef, 0xf5, 0x3d, 0x8a, 0x7b,
+	0xa6, 0x6d, 0x89, 0xbd, 0x32, 0x06, 0x04, 0x4b, 0x35, 0x0b, 0xbd, 0x07, 0x39, 0x4b, 0x62, 0x3d,
+	0x5f, 0xc9, 0xd5, 0xbf, 0xfe, 0xe2, 0xce, 0xaa, 0x92, 0x5b, 0xb6, 0x2c, 0x9f, 0x50, 0xda, 0x64,
+	0xbe, 0xed, 0x76, 0x8c, 0x10, 0x8a, 0x7e, 0x06, 0x59, 0xec, 0x78, 0x03, 0x97, 0xe9, 0xe9, 0x9d,
+	0xf4, 0xad, 0xf9, 0x50, 0x7f, 0x1e, 0xa6, 0x5d, 0x15, 0xa6, 0xdd, 0x43, 0xcf, 0x76, 0x0f, 0x72,
+	0x5f, 0x7e, 0xb3, 0x7d, 0xe5, 0x77, 0xff, 0xfe, 0xc3, 0x6d, 0xcd, 0x50, 0x3c, 0x68, 0x13, 0xe6,
+	0x1c, 0xc2, 0xb0, 0x85, 0x19, 0xd6, 0x33, 0x7c, 0x53, 0x63, 0xf4, 0x8e, 0xde, 0x04, 0x14, 0x3c,
+	0x9b, 0xb4, 0xdd, 0x25, 0x0e, 0xe6, 0x9a, 0xcf, 0x08, 0xcd, 0x0b, 0x01, 0xa5, 0x29, 0x08, 0x35,
+	0xab, 0xf4, 0x1c, 0x60, 0xae, 0xa1, 0xcc, 0x41, 0x79, 0x48, 0x8d, 0x8c, 0x4c, 0xd9, 0x16, 0x7a,
+	0x8b, 0x6f, 0x43, 0x29, 0xee, 0x10, 0xaa, 0xa7, 0x84, 0x9a, 0xab, 0xbb, 0x32, 0xb6, 0xbb, 0x41,
+	0x6c, 0x77, 0xcb, 0xee, 0xd0, 0x18, 0xa1, 0xd0, 0xbb, 0x90, 0xa5, 0x0c, 0xb3, 0x01, 0xd5, 0xd3,
+	0x22, 0x2c, 0x5b, 0xb1, 0xb0, 0x04, 0x5b, 0x35, 0x05, 0xc8, 0x50, 0x60, 0x74, 0x0f, 0xd0, 0x27,
+	0xb6, 0x8b, 0x7b, 0x26, 0xc3, 0xbd, 0xde, 0xd0, 0xf4, 0x09, 0x1d, 0xf4, 0x98, 0xb0, 0x6c, 0xfe,
+	0xed, 0xcd, 0x98, 0x88, 0x16, 0x87, 0x18, 0x02, 0x61, 0x14, 0x04, 0x57, 0x64, 0x05, 0x95, 0x61,
+	0x9e, 0x0e, 0x4e, 0x1d, 0x9b, 0x99, 0x3c, 0x61, 0xf5, 0x19, 0x25, 0x22, 0xae, 0x75, 0x2b, 0xc8,
+	0xe6, 0x83, 0xcc, 0x67, 0xff, 0xd8, 0xd6, 0x0c, 0x90, 0x4c, 0x7c, 0x19, 0xdd, 0x87, 0x82, 0x8a,
+	0x93, 0x49, 0x5c, 0x4b, 0xca, 0xc9, 0x4e, 0x29, 0x27, 0xaf, 0x38, 0xab, 0xae, 0x25, 0x64, 0xd5,
+	0x60, 0x91, 0x79, 0x0c, 0xf7, 0x4c, 0xb5, 0xae, 0xcf, 0xbe, 0x40, 0xb4, 0x17, 0x04, 0x6b, 0x90,
+	0x8a, 0x0f, 0x60, 0xf9, 0xcc, 0x63, 0xb6, 0xdb, 0x31, 0x29, 0xc3, 0xbe, 0xb2, 0x6f, 0x6e, 0x4a,
+	0xbd, 0x96, 0x24, 0x6b, 0x93, 0x73, 0x0a, 0xc5, 0xee, 0x81, 0x5a, 0x0a, 0x6d, 0xcc, 0x4d, 0x29,
+	0x6b, 0x51, 0x32, 0x06, 0x26, 0x46, 0x73, 0x11, 0x62, 0xb9, 0xb8, 0x0a, 0x33, 0xcc, 0x66, 0x3d,
+	0xa2, 0xcf, 0x0b, 0x82, 0x7c, 0x41, 0x3a, 0xcc, 0xd2, 0x81, 0xe3, 0x60, 0x7f, 0xa8, 0x2f, 0x88,
+	0xf5, 0xe0, 0x15, 0xfd, 0x18, 0xe6, 0x64, 0x6d, 0x11, 0x5f, 0x5f, 0xbc, 0xa4, 0x98, 0x46, 0x48,
+	0x74, 0x1d, 0x72, 0xe4, 0xbc, 0x4f, 0x2c, 0x9b, 0x11, 0x4b, 0xcf, 0xef, 0x68, 0xb7, 0xe6, 0x8c,
+	0x70, 0x01, 0xfd, 0x1a, 0xd6, 0xfb, 0xd8, 0xc7, 0x0e, 0x35, 0x07, 0x7d, 0x0b, 0x33, 0x62, 0x7e,
+	0x82, 0xed, 0xde, 0xc0, 0x27, 0x54, 0x5f, 0x12, 0xb1, 0x28, 0xc5, 0x53, 0x54, 0x80, 0x8f, 0x05,
+	0xf6, 0xae, 0x84, 0x1e, 0x64, 0x78, 0x50, 0x8c, 0xd5, 0xfe, 0x24, 0x89, 0xa2, 0xf7, 0x60, 0x23,
+	0x48, 0x97, 0x3e, 0xf1, 0x6d, 0xcf, 0x32, 0xc9, 0x39, 0x23, 0xae, 0x45, 0x2c, 0xbd, 0x20, 0x74,
+	0x59, 0x53, 0xe4, 0x86, 0xa0, 0x56, 0x15, 0x11, 0xd5, 0x60, 0x85, 0x9c, 0x93, 0xf6, 0x80, 0x9f,
+	0x4d, 0x26, 0x1e, 0xb0, 0xae, 0xe7, 0xdb, 0x6c, 0xa8, 0x2f, 0x5f, 0x62, 0x36, 0x1a, 0x31, 0x95,
+	0x03, 0x1e, 0xd4, 0x80, 0x15, 0xcb, 0xa6, 0xed, 0x01, 0xa5, 0x5c, 0xd6, 0x28, 0xa0, 0x68, 0xca,
+	0x80, 0x2e, 0x87, 0xcc, 0x41, 0x50, 0x5b, 0xb0, 0x1c, 0x2a, 0xa7, 0x1c, 0xa6, 0xaf, 0x08, 0x79,
+	0x3f, 0xba, 0xa0, 0xa4, 0xab, 0x01, 0x5e, 0x79, 0xc6, 0x28, 0x90, 0xd8, 0x0a, 0x7a, 0x02, 0xab,
+	0xb6, 0x7b, 0x86, 0x7b, 0xb6, 0x85, 0xc7, 0x04, 0xaf, 0xbe, 0x98, 0xe0, 0x95, 0xa8, 0x90, 0x40,
+	0xf6, 0x2b, 0xb0, 0xd8, 0xf6, 0x1c, 0x5e, 0xf8, 0x3e, 0x39, 0x23, 0xb8, 0xa7, 0xaf, 0x09, 0xe7,
+	0x2f, 0xc8, 0x45, 0x43, 0xac, 0xf1, 0xac, 0x57, 0xa0, 0x91, 0x93, 0xd6, 0xa7, 0xcd, 0x7a, 0xc9,
+	0x18, 0x38, 0x28, 0xf9, 0x94, 0xdd, 0xb8, 0xe0, 0x94, 0xfd, 0x14, 0xf4, 0x8b, 0xac, 0x41, 0xd7,
+	0x20, 0xe7, 0xd0, 0x8e, 0x69, 0xbb, 0x16, 0x39, 0x17, 0x67, 0xef, 0xa2, 0x31, 0xe7, 0xd0, 0x4e,
+	0x8d, 0xbf, 0xa3, 0x1d, 0x58, 0xe0, 0x44, 0x36, 0xec, 0x13, 0x73, 0xe0, 0xf7, 0x64, 0x87, 0x31,
+	0xc0, 0xa1, 0x9d, 0xd6, 0xb0, 0x4f, 0x8e, 0xfd, 0x1e, 0x5a, 0x87, 0xac, 0x4f, 0x30, 0xf5, 0x5c,
+	0x71, 0xe2, 0xe6, 0x0c, 0xf5, 0x56, 0xfa, 0xb3, 0x96, 0xb0, 0xa7, 0x41, 0xda, 0xc4, 0xee, 0x4f,
+	0xd1, 0xd6, 0xd6, 0x21, 0xdb, 0x0d, 0x7b, 0x65, 0xda, 0x50, 0x6f, 0xe8, 0xe7, 0x90, 0x11, 0x5e,
+	0x4b, 0x5f, 0xea, 0xb5, 0x45, 0x5e, 0x32, 0xdc, 0x73, 0xf2, 0x2c, 0x13, 0x6c, 0xe8, 0x7d, 0x58,
+	0xe4, 0xe6, 0xf8, 0x84, 0xf6, 0x3d, 0x97, 0x12, 0xaa, 0x67, 0xbe, 0xa7, 0xab, 0x70, 0xcb, 0x8d,
+	0x00, 0x59, 0x22, 0xb0, 0x92, 0x50, 0x99, 0xfc, 0x84, 0x89, 0x7a, 0x6e, 0xc6, 0xfe, 0x81, 0x6e,
+	0xfb, 0x8b, 0x06, 0xf3, 0xd1, 0x7e, 0xf2, 0x06, 0xe4, 0x86, 0x84, 0x9a, 0x6d, 0xd1, 0xaa, 0xb5,
+	0x89, 0xb9, 0xa1, 0xe6, 0x32, 0x63, 0x6e, 0x48, 0xe8, 0xa1, 0x68, 0xcb, 0xef, 0xc0, 0x22, 0x3e,
+	0xa5, 0x0c, 0xdb, 0xae, 0x62, 0x48, 0x25, 0x32, 0x2c, 0x28, 0x90, 0x64, 0x7a, 0x1d, 0xe6, 0x5c,
+	0x4f, 0xe1, 0xd3, 0x89, 0xf8, 0x59, 0xd7, 0x93, 0xd0, 0x0f, 0x00, 0xb9, 0x9e, 0xf9, 0xcc, 0x66,
+	0x5d, 0xf3, 0x8c, 0xb0, 0x80, 0x29, 0x93, 0xc8, 0xb4, 0xe4, 0x7a, 0x8f, 0x6c, 0xd6, 0x3d, 0x21,
+	0x4c, 0x32, 0x97, 0xfe, 0xa5, 0x41, 0x86, 0x4f, 0x45, 0x97, 0x07, 0x7f, 0x17, 0x66, 0xce, 0x3c,
+	0x46, 0x2e, 0x9f, 0x67, 0x24, 0x0c, 0x7d, 0x00, 0xb3, 0x72, 0xc4, 0x0a, 0xe2, 0x79, 0x23, 0x56,
+	0xc9, 0x93, 0xf3, 0x9b, 0x11, 0x70, 0x8c, 0xb5, 0x8f, 0x99, 0xa9, 0x46, 0x99, 0x6c, 0x72, 0x91,
+	0xdd, 0xcf, 0xcc, 0xa5, 0x0b, 0x99, 0xd2, 0x6f, 0x34, 0xc8, 0xf3, 0x7d, 0x0e, 0x45, 0xb9, 0x3a,
+	0xc4, 0x65, 0xff, 0x7f, 0x83, 0x8b, 0x00, 0xed, 0x91, 0x78, 0x11, 0xb4, 0x05, 0x23, 0xb2, 0x52,
+	0xfa, 0x4f, 0x0a, 0xd6, 0x4f, 0xe4, 0x09, 0xe5, 0xf9, 0x5c, 0x99, 0x03, 0x9f, 0xe0, 0xa7, 0x96,
+	0xf7, 0xcc, 0xbd, 0x5c, 0x97, 0x23, 0x58, 0x3e, 0x0b, 0x58, 0x4d, 0x2c, 0x77, 0x57, 0x7a, 0xdd,
+	0xf8, 0xfa, 0x8b, 0x3b, 0x5b, 0x4a, 0xaf, 0x91, 0xf8, 0x71, 0x05, 0x0b, 0x67, 0xb1, 0xf5, 0x68,
+	0x70, 0xd2, 0x2f, 0x1c, 0x9c, 0x9f, 0xc0, 0x92, 0xed, 0x76, 0x89, 0xcf, 0x1b, 0xa9, 0xd9, 0xf7,
+	0x9e, 0x11, 0xff, 0x82, 0x6c, 0xcb, 0x8f, 0x60, 0x0d, 0x8e, 0x42, 0xef, 0x43, 0xc1, 0x3b, 0x23,
+	0xbe, 0x6f, 0x5b, 0x16, 0x71, 0x15, 0xe7, 0x4c, 0x72, 0x9e, 0x86, 0x38, 0xc9, 0xba, 0x0f, 0xbc,
+	0xcf, 0x32, 0xbb, 0x6d, 0xf7, 0x65, 0x97, 0x20, 0xe7, 0xc4, 0xe9, 0x33, 0x11, 0xf6, 0x39, 0x63,
+	0x65, 0x8c, 0x56, 0x15, 0xa4, 0xd2, 0xdf, 0x34, 0x58, 0x1d, 0xf3, 0xb7, 0xd5, 0xec, 0x62, 0xde,
+	0x9b, 0x77, 0x20, 0x3d, 0x24, 0x54, 0xd7, 0x12, 0xe7, 0x7d, 0x4e, 0x42, 0xb7, 0x60, 0x56, 0x55,
+	0xe3, 0x05, 0xb7, 0x82, 0x80, 0x8c, 0x8a, 0x90, 0x72, 0x3d, 0x3d, 0x9d, 0x08, 0x4a, 0xb9, 0x1e,
+	0x7a, 0x0b, 0x16, 0xa2, 0xc5, 0xa9, 0x67, 0x12, 0x91, 0x10, 0x96, 0x25, 0xba, 0x29, 0xd3, 0xce,
+	0xd2, 0x67, 0x12, 0xa1, 0x92, 0x58, 0xfa, 0xa3, 0x06, 0x6b, 0x87, 0x9e, 0x4b, 0x99, 0xcd, 0x64,
+	0xdb, 0x77, 0x88, 0x6b, 0x89, 0xbc, 0x8e, 0x8f, 0xeb, 0xb1, 0xdc, 0x4a, 0x4d, 0xe4, 0x56, 0x09,
+	0x16, 0xda, 0x11, 0x49, 0xea, 0xe8, 0x1b, 0x5b, 0x43, 0xf7, 0x00, 0xb0, 0x23, 0x26, 0x14, 0x13,
+	0x87, 0x23, 0xf8, 0xd4, 0xe7, 0x7c, 0x4e, 0x31, 0x97, 0x59, 0xe9, 0x09, 0xe4, 0x1f, 0x8e, 0xd5,
+	0xe8, 0x84, 0xc2, 0x08, 0x32, 0x2e, 0x76, 0x88, 0x3a, 0x9e, 0xc5, 0x33, 0x2a, 0x40, 0x7a, 0xe0,
+	0xdb, 0x4a, 0x35, 0xfe, 0xc8, 0x51, 0x5d, 0x4c, 0xbb, 0xea, 0xa2, 0x23, 0x9e, 0x4b, 0x7f, 0xd7,
+	0x60, 0x51, 0x0d, 0xc6, 0xb2, 0x2b, 0xa0, 0xc7, 0x30, 0xef, 0xd8, 0xee, 0x68, 0xce, 0xd6, 0x2e,
+	0x9b, 0xb3, 0xb7, 0xb8, 0xde, 0xdf, 0x7d, 0xb3, 0xbd, 0x16, 0xe1, 0x7a, 0xd3, 0x73, 0x6c, 0xc6,
+	0x33, 0x6a, 0x68, 0x80, 0x63, 0xbb, 0xc1, 0xe4, 0xed, 0x00, 0x72, 0xf0, 0xb9, 0x39, 0x3e, 0xe5,
+	0x09, 0xa5, 0xf9, 0x0e, 0x71, 0xd7, 0x54, 0xd4, 0x65, 0xf7, 0xe0, 0xe6, 0x77, 0xdf, 0x6c, 0x5f,
+	0x9f, 0x64, 0x0c, 0x37, 0xf9, 0x9c, 0xcf, 0x15, 0x05, 0x07, 0x9f, 0x57, 0xa2, 0x03, 0xe2, 0x4f,
+	0x53, 0xba, 0x56, 0xfa, 0x18, 0x16, 0x4e, 0xc4, 0x94, 0xad, 0xac, 0xab, 0x80, 0x9a, 0xba, 0x83,
+	0xdd, 0xb5, 0xcb, 0x76, 0xcf, 0x08, 0xe9, 0x0b, 0x92, 0x2b, 0x22, 0xf9, 0xf7, 0x29, 0xd5, 0xe0,
+	0x94, 0xe4, 0xd7, 0x20, 0xfb, 0xe9, 0xc0, 0xf3, 0x07, 0xce, 0x05, 0x55, 0xa2, 0xa8, 0xe8, 0x4d,
+	0xc8, 0xb1, 0xae, 0x4f, 0x68, 0xd7, 0xeb, 0x59, 0x17, 0x94, 0x4a, 0x08, 0x40, 0xef, 0x42, 0x5e,
+	0x74, 0xa8, 0x90, 0x25, 0xb9, 0x70, 0x16, 0x39, 0xaa, 0x35, 0x62, 0xdb, 0x83, 0x79, 0xc1, 0xa6,
+	0x34, 0xba, 0xa0, 0x84, 0x38, 0xe4, 0x23, 0xa9, 0xd5, 0xaf, 0x60, 0x6d, 0x94, 0xf2, 0x4f, 0x6d,
+	0xd7, 0x52, 0x9c, 0x54, 0x9f, 0x49, 0x3c, 0xeb, 0x82, 0x81, 0xe8, 0x97, 0xb6, 0x6b, 0x49, 0x09,
+	0x6a, 0xb4, 0x5f, 0xe9, 0x4f, 0x50, 0xa8, 0x70, 0xd7, 0x9f, 0x0a, 0x90, 0x55, 0x9e, 0xaa, 0xbe,
+	0x60, 0x86, 0x45, 0x6e, 0x72, 0xd1, 0x6c, 0x7a, 0xf8, 0x72, 0xd9, 0x94, 0x49, 0xce, 0x96, 0xc9,
+	0xcc, 0x48, 0xbf, 0x44, 0x66, 0x44, 0xb2, 0x20, 0x33, 0x7d, 0x16, 0xcc, 0xbc, 0x78, 0x16, 0x64,
+	0xa7, 0xc9, 0x82, 0x1a, 0x5c, 0xe5, 0x8e, 0xb6, 0x5d, 0x9b, 0xd9, 0xe1, 0xd5, 0xd9, 0x14, 0xea,
+	0xeb, 0xb3, 0x89, 0x12, 0xd6, 0x1d, 0xdb, 0xad, 0x49, 0xbc, 0x72, 0x8f, 0xc1, 0xd1, 0xe8, 0x20,
+	0x92, 0x1f, 0x6d, 0xec, 0xb6, 0x49, 0x4f, 0x89, 0x99, 0x4b, 0x14, 0x33, 0x4a, 0x83, 0x43, 0x81,
+	0x95, 0x32, 0xee, 0xc3, 0x6a, 0x5c, 0x86, 0x45, 0x28, 0xd3, 0x73, 0x97, 0x0c, 0x0b, 0x68, 0x5c,
+	0x58, 0x85, 0x50, 0x86, 0x1e, 0xc1, 0xc6, 0xe8, 0x66, 0x6a, 0x8e, 0xc7, 0x0d, 0xa6, 0x8b, 0xdb,
+	0xda, 0x88, 0xff, 0x24, 0x1a, 0xc0, 0x5f, 0xc0, 0xca, 0x88, 0x10, 0xf1, 0xf7, 0x7c, 0xa2, 0x99,
+	0x68, 0x04, 0x0d, 0x9d, 0xfe, 0x31, 0x84, 0x92, 0xcd, 0x68, 0x9e, 0x2f, 0xbc, 0x40, 0x9e, 0x87,
+	0x3a, 0x3c, 0x0c, 0x13, 0xfe, 0x16, 0x14, 0x4e, 0x07, 0xbe, 0xcb, 0xcd, 0x25, 0x41, 0x65, 0x2f,
+	0x8a, 0x66, 0x9e, 0xe7, 0xeb, 0xbc, 0x5f, 0xab, 0x6a, 0x2e, 0xc3, 0x96, 0x40, 0x8e, 0xdc, 0x3d,
+	0x2a, 0x12, 0x9f, 0x70, 0x6e, 0x75, 0xb9, 0xdf, 0xe4, 0xa0, 0xa0, 0x94, 0x83, 0x6a, 0x90, 0x08,
+	0x74, 0x13, 0xf2, 0xe1, 0x66, 0xa2, 0x0f, 0x2f, 0xc9, 0x7b, 0x60, 0xb0, 0x95, 0xe8, 0xbc, 0x77,
+	0xc3, 0x3b, 0xbb, 0xb8, 0xac, 0x8b, 0x7b, 0xb3, 0x4c, 0x8c, 0x42, 0xa2, 0xc7, 0x82, 0x3b, 0x7c,
+	0x35, 0x40, 0xcb, 0xd4, 0x78, 0x0c, 0xfa, 0xa4, 0x1c, 0x15, 0xcf, 0xe5, 0xe9, 0xe2, 0xb9, 0x1e,
+	0x97, 0xac, 0x02, 0xfa, 0x90, 0xc7, 0x23, 0xfe, 0x79, 0xc0, 0x26, 0x54, 0x47, 0x3b, 0xe9, 0xef,
+	0x4d, 0xbb, 0xd5, 0x89, 0x0f, 0x04, 0x36, 0xa1, 0xfc, 0xeb, 0x51, 0xe4, 0x13, 0x81, 0x52, 0x71,
+	0x65, 0xca, 0x43, 0x27, 0xe4, 0x54, 0xca, 0xbd, 0x0e, 0x05, 0x82, 0x7d, 0xf9, 0xa5, 0xce, 0xeb,
+	0xc9, 0x61, 0x62, 0x55, 0xf8, 0x79, 0x49, 0xac, 0x1b, 0xa3, 0x65, 0x54, 0x87, 0x57, 0xf9, 0x71,
+	0x17, 0x84, 0x54, 0x7c, 0xf5, 0x6d, 0x13, 0x4a, 0xf9, 0x40, 0x49, 0x7c, 0x71, 0x0f, 0x3f, 0xed,
+	0x79, 0xed, 0xa7, 0xe2, 0xbe, 0x9e, 0x31, 0x76, 0x1c, 0x7c, 0x1e, 0x84, 0x96, 0x36, 0x02, 0x68,
+	0x83, 0xf8, 0x55, 0xd7, 0x3a, 0xe0, 0x38, 0xf4, 0x31, 0xec, 0x44, 0x07, 0x16, 0x13, 0x07, 0xf3,
+	0x50, 0x24, 0xed, 0xd7, 0x13, 0x83, 0x58, 0x6c, 0x27, 0x8d, 0x51, 0x61, 0x09, 0x3c, 0x80, 0xe5,
+	0xe0, 0xfa, 0x46, 0x89, 0x83, 0x5d, 0x66, 0xb7, 0xa9, 0xb8, 0xd2, 0xe7, 0xdf, 0xde, 0x8e, 0x35,
+	0x92, 0xb2, 0xc4, 0x35, 0x03, 0x98, 0x51, 0xc0, 0xb1, 0x15, 0x84, 0x61, 0x03, 0xb7, 0xdb, 0xa4,
+	0xcf, 0xeb, 0x29, 0x48, 0x12, 0x8b, 0xb8, 0x9e, 0x43, 0x75, 0x5d, 0x94, 0xd4, 0x2b, 0x71, 0x99,
+	0x0a, 0xad, 0x32, 0xba, 0xc2, 0xb1, 0xaa, 0x3d, 0xad, 0xe1, 0x04, 0x1a, 0xe5, 0x5f, 0x2a, 0x43,
+	0xeb, 0x55, 0x4c, 0xaf, 0x4e, 0x17, 0xd3, 0xa5, 0x11, 0xa3, 0x0a, 0xe9, 0x13, 0xb8, 0x91, 0x34,
+	0x76, 0xf3, 0xa7, 0xd0, 0xaf, 0x9b, 0x89, 0x7e, 0xdd, 0x4e, 0x98, 0xc9, 0x6d, 0xcf, 0x0d, 0x1d,
+	0xbb, 0x0f, 0xd1, 0x29, 0xcb, 0xc4, 0xee, 0x50, 0x7a, 0x42, 0xbf, 0x26, 0x72, 0x06, 0x85, 0xdd,
+	0xb1, 0xec, 0x0e, 0x85, 0x6d, 0xbc, 0xad, 0xc9, 0xef, 0x38, 0x81, 0x5d, 0xd7, 0xa7, 0x6c, 0x6b,
+	0x92, 0x4b, 0x19, 0x15, 0x9b, 0x27, 0xb6, 0x5e, 0x7e, 0x9e, 0x28, 0xfe, 0xf0, 0x79, 0x82, 0x5f,
+	0x4d, 0xd1, 0x24, 0x07, 0x9f, 0x6f, 0xf9, 0x56, 0x72, 0xfe, 0x32, 0xc4, 0x73, 0xa4, 0x1f, 0xa7,
+	0xbe, 0xb7, 0x1f, 0xc7, 0x0c, 0x4c, 0x5f, 0x66, 0x60, 0xe9, 0x33, 0x0d, 0x56, 0x93, 0x12, 0x8d,
+	0x7f, 0x48, 0x91, 0x31, 0x91, 0x6a, 0xc8, 0x17, 0xb4, 0x05, 0xc0, 0x0f, 0x7c, 0x15, 0x2e, 0x39,
+	0xa7, 0xe7, 0xf8, 0x8a, 0x64, 0xba, 0x09, 0x33, 0xf2, 0xd4, 0x4c, 0xde, 0x58, 0x12, 0xb9, 0x90,
+	0x01, 0x25, 0xa6, 0xe7, 0xe3, 0x76, 0x8f, 0x88, 0x01, 0x63, 0xce, 0xc8, 0x0d, 0x28, 0xa9, 0x8b,
+	0x85, 0xdb, 0xbf, 0xd5, 0x00, 0x22, 0xbf, 0xec, 0x5c, 0x83, 0x8d, 0x93, 0x7a, 0xab, 0x6a, 0xd6,
+	0x1b, 0xad, 0x5a, 0xfd, 0xc8, 0x3c, 0x3e, 0x6a, 0x36, 0xaa, 0x87, 0xb5, 0xbb, 0xb5, 0x6a, 0xa5,
+	0x70, 0x05, 0xad, 0xc0, 0x52, 0x94, 0xf8, 0xb8, 0xda, 0x2c, 0x68, 0x68, 0x03, 0x56, 0xa2, 0x8b,
+	0xe5, 0x83, 0x66, 0xab, 0x5c, 0x3b, 0x2a, 0xa4, 0x10, 0x82, 0x7c, 0x94, 0x70, 0x54, 0x2f, 0xa4,
+	0xd1, 0x75, 0xd0, 0xc7, 0xd7, 0xcc, 0x47, 0xb5, 0xd6, 0x3d, 0xf3, 0xa4, 0xda, 0xaa, 0x17, 0x32,
+	0xb7, 0xff, 0xab, 0x41, 0x7e, 0xfc, 0x37, 0x0a, 0xb4, 0x0d, 0xd7, 0x1a, 0x46, 0xbd, 0x51, 0x6f,
+	0x96, 0x1f, 0x98, 0xcd, 0x56, 0xb9, 0x75, 0xdc, 0x8c, 0xe9, 0x54, 0x82, 0x62, 0x1c, 0x50, 0xa9,
+	0x36, 0xea, 0xcd, 0x5a, 0xcb, 0x6c, 0x54, 0x8d, 0x5a, 0xbd, 0x52, 0xd0, 0xd0, 0x0d, 0xd8, 0x8a,
+	0x63, 0x4e, 0xea, 0xad, 0xda, 0xd1, 0x87, 0x01, 0x24, 0x85, 0x36, 0x61, 0x3d, 0x0e, 0x69, 0x94,
+	0x9b, 0xcd, 0x6a, 0x45, 0x2a, 0x1d, 0xa7, 0x19, 0xd5, 0xfb, 0xd5, 0xc3, 0x56, 0xb5, 0x52, 0xc8,
+	0x24, 0x71, 0xde, 0x2d, 0xd7, 0x1e, 0x54, 0x2b, 0x85, 0x19, 0xf4, 0x2a, 0xdc, 0x98, 0x50, 0xae,
+	0xd6, 0x3c, 0x3c, 0x6e, 0x36, 0xb9, 0xf5, 0x6a, 0xf3, 0xec, 0xed, 0xcf, 0x35, 0x28, 0xc4, 0xcf,
+	0x34, 0xae, 0xb4, 0xf2, 0xa5, 0xd9, 0xac, 0x3e, 0x2c, 0x1f, 0xb5, 0x6a, 0x87, 0x71, 0xdb, 0x13,
+	0x21, 0x1f, 0x1d, 0xd7, 0x8d, 0xe3, 0x87, 0x66, 0xfd, 0xe8, 0xc1, 0xe3, 0x82, 0xc6, 0xfd, 0x37,
+	0x09, 0x69, 0xdd, 0x33, 0xaa, 0xcd, 0x7b, 0xf5, 0x07, 0xdc, 0xf0, 0x2d, 0xb8, 0x3a, 0x09, 0xa8,
+	0x7d, 0x78, 0x54, 0x37, 0xb8, 0xed, 0x07, 0xd5, 0x2f, 0xbf, 0x2d, 0x6a, 0x5f, 0x7d, 0x5b, 0xd4,
+	0xfe, 0xf9, 0x6d, 0x51, 0xfb, 0xec, 0x79, 0xf1, 0xca, 0x57, 0xcf, 0x8b, 0x57, 0xfe, 0xfa, 0xbc,
+	0x78, 0xe5, 0xc9, 0x1b, 0x1d, 0x9b, 0x75, 0x07, 0xa7, 0xbb, 0x6d, 0xcf, 0x51, 0xbf, 0x22, 0xaa,
+	0x7f, 0x77, 0xa8, 0xf5, 0x74, 0xef, 0x5c, 0xfc, 0x32, 0xca, 0x3f, 0x01, 0x52, 0xfe, 0xb3, 0x67,
+	0x56, 0x9c, 0x18, 0xef, 0xfc, 0x6f, 0x00, 0x22, 0x47, 0x3b, 0xae, 0x37, 0x1d, 0x00, 0x00,
+}
+
+func (m *WeightedVoteOption) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *WeightedVoteOption) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *WeightedVoteOption) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Weight) > 0 {
+		i -= len(m.Weight)
+		copy(dAtA[i:], m.Weight)
+		i = encodeVarintGov(dAtA, i, uint64(len(m.Weight)))
 		i--
-		dAtA[i] = 0x2a
+		dAtA[i] = 0x12
 	}
-	if len(m.Quorum) > 0 {
-		i -= len(m.Quorum)
-		copy(dAtA[i:], m.Quorum)
-		i = encodeVarintGov(dAtA, i, uint64(len(m.Quorum)))
+	if m.Option != 0 {
+		i = encodeVarintGov(dAtA, i, uint64(m.Option))
 		i--
-		dAtA[i] = 0x22
+		dAtA[i] = 0x8
 	}
-	if m.VotingPeriod != nil {
-		n9, err9 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(*m.VotingPeriod, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(*m.VotingPeriod):])
-		if err9 != nil {
-			return 0, err9
-		}
-		i -= n9
-		i = encodeVarintGov(dAtA, i, uint64(n9))
+	return len(dAtA) - i, nil
+}
+
+func (m *Deposit) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Deposit) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Deposit) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.MetadataSchemaId != 0 {
+		i = encodeVarintGov(dAtA, i, uint64(m.MetadataSchemaId))
 		i--
-		dAtA[i] = 0x1a
+		dAtA[i] = 0x28
 	}
-	if m.MaxDepositPeriod != nil {
-		n10, err10 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(*m.MaxDepositPeriod, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(*m.MaxDepositPeriod):])
-		if err10 != nil {
-			return 0, err10
-		}
-		i -= n10
-		i = encodeVarintGov(dAtA, i, uint64(n10))
+	if len(m.Metadata) > 0 {
+		i -= len(m.Metadata)
+		copy(dAtA[i:], m.Metadata)
+		i = encodeVarintGov(dAtA, i, uint64(len(m.Metadata)))
 		i--
-		dAtA[i] = 0x12
+		dAtA[i] = 0x22
 	}
-	if len(m.MinDeposit) > 0 {
-		for iNdEx := len(m.MinDeposit) - 1; iNdEx >= 0; iNdEx-- {
+	if len(m.Amount) > 0 {
+		for iNdEx := len(m.Amount) - 1; iNdEx >= 0; iNdEx-- {
 			{
-				size, err := m.MinDeposit[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				size, err := m.Amount[iNdEx].MarshalToSizedBuffer(dAtA[:i])
 				if err != nil {
 					return 0, err
 				}
@@ -1648,341 +2380,4326 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 				i = encodeVarintGov(dAtA, i, uint64(size))
 			}
 			i--
-			dAtA[i] = 0xa
+			dAtA[i] = 0x1a
 		}
 	}
+	if len(m.Depositor) > 0 {
+		i -= len(m.Depositor)
+		copy(dAtA[i:], m.Depositor)
+		i = encodeVarintGov(dAtA, i, uint64(len(m.Depositor)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.ProposalId != 0 {
+		i = encodeVarintGov(dAtA, i, uint64(m.ProposalId))
+		i--
+		dAtA[i] = 0x8
+	}
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintGov(dAtA []byte, offset int, v uint64) int {
-	offset -= sovGov(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *Proposal) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *WeightedVoteOption) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Option != 0 {
-		n += 1 + sovGov(uint64(m.Option))
-	}
-	l = len(m.Weight)
-	if l > 0 {
-		n += 1 + l + sovGov(uint64(l))
-	}
-	return n
+
+func (m *Proposal) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *Deposit) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *Proposal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.ProposalId != 0 {
-		n += 1 + sovGov(uint64(m.ProposalId))
+	if m.MetadataSchemaId != 0 {
+		i = encodeVarintGov(dAtA, i, uint64(m.MetadataSchemaId))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xb8
 	}
-	l = len(m.Depositor)
-	if l > 0 {
-		n += 1 + l + sovGov(uint64(l))
+	if m.CommitEndTime != nil {
+		n1, err1 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(*m.CommitEndTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.CommitEndTime):])
+		if err1 != nil {
+			return 0, err1
+		}
+		i -= n1
+		i = encodeVarintGov(dAtA, i, uint64(n1))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xb2
 	}
-	if len(m.Amount) > 0 {
-		for _, e := range m.Amount {
-			l = e.Size()
-			n += 1 + l + sovGov(uint64(l))
+	if m.CommitReveal {
+		i--
+		if m.CommitReveal {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
 		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xa8
 	}
-	return n
-}
-
-func (m *Proposal) Size() (n int) {
-	if m == nil {
-		return 0
+	if m.InvalidationFailure != nil {
+		{
+			size, err := m.InvalidationFailure.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGov(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xa2
 	}
-	var l int
-	_ = l
-	if m.Id != 0 {
-		n += 1 + sovGov(uint64(m.Id))
+	if m.ExecutionFailure != nil {
+		{
+			size, err := m.ExecutionFailure.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGov(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x9a
 	}
-	if len(m.Messages) > 0 {
-		for _, e := range m.Messages {
-			l = e.Size()
-			n += 1 + l + sovGov(uint64(l))
+	if m.DiscussionEndTime != nil {
+		n4, err4 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(*m.DiscussionEndTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.DiscussionEndTime):])
+		if err4 != nil {
+			return 0, err4
 		}
+		i -= n4
+		i = encodeVarintGov(dAtA, i, uint64(n4))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x92
 	}
-	if m.Status != 0 {
-		n += 1 + sovGov(uint64(m.Status))
+	if len(m.ExecutionAuthority) > 0 {
+		i -= len(m.ExecutionAuthority)
+		copy(dAtA[i:], m.ExecutionAuthority)
+		i = encodeVarintGov(dAtA, i, uint64(len(m.ExecutionAuthority)))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x8a
 	}
-	if m.FinalTallyResult != nil {
-		l = m.FinalTallyResult.Size()
-		n += 1 + l + sovGov(uint64(l))
+	if m.DepositPeriodExtended {
+		i--
+		if m.DepositPeriodExtended {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x80
 	}
-	if m.SubmitTime != nil {
-		l = github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.SubmitTime)
-		n += 1 + l + sovGov(uint64(l))
+	if len(m.ParamsUpdateFailures) > 0 {
+		for iNdEx := len(m.ParamsUpdateFailures) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ParamsUpdateFailures[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGov(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x7a
+		}
 	}
-	if m.DepositEndTime != nil {
-		l = github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.DepositEndTime)
-		n += 1 + l + sovGov(uint64(l))
+	if m.Expedited {
+		i--
+		if m.Expedited {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x70
 	}
-	if len(m.TotalDeposit) > 0 {
-		for _, e := range m.TotalDeposit {
-			l = e.Size()
-			n += 1 + l + sovGov(uint64(l))
+	if len(m.Proposer) > 0 {
+		i -= len(m.Proposer)
+		copy(dAtA[i:], m.Proposer)
+		i = encodeVarintGov(dAtA, i, uint64(len(m.Proposer)))
+		i--
+		dAtA[i] = 0x6a
+	}
+	if len(m.Summary) > 0 {
+		i -= len(m.Summary)
+		copy(dAtA[i:], m.Summary)
+		i = encodeVarintGov(dAtA, i, uint64(len(m.Summary)))
+		i--
+		dAtA[i] = 0x62
+	}
+	if len(m.Title) > 0 {
+		i -= len(m.Title)
+		copy(dAtA[i:], m.Title)
+		i = encodeVarintGov(dAtA, i, uint64(len(m.Title)))
+		i--
+		dAtA[i] = 0x5a
+	}
+	if len(m.Metadata) > 0 {
+		i -= len(m.Metadata)
+		copy(dAtA[i:], m.Metadata)
+		i = encodeVarintGov(dAtA, i, uint64(len(m.Metadata)))
+		i--
+		dAtA[i] = 0x52
+	}
+	if m.VotingEndTime != nil {
+		n5, err5 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(*m.VotingEndTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.VotingEndTime):])
+		if err5 != nil {
+			return 0, err5
 		}
+		i -= n5
+		i = encodeVarintGov(dAtA, i, uint64(n5))
+		i--
+		dAtA[i] = 0x4a
 	}
 	if m.VotingStartTime != nil {
-		l = github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.VotingStartTime)
-		n += 1 + l + sovGov(uint64(l))
+		n6, err6 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(*m.VotingStartTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.VotingStartTime):])
+		if err6 != nil {
+			return 0, err6
+		}
+		i -= n6
+		i = encodeVarintGov(dAtA, i, uint64(n6))
+		i--
+		dAtA[i] = 0x42
 	}
-	if m.VotingEndTime != nil {
-		l = github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.VotingEndTime)
-		n += 1 + l + sovGov(uint64(l))
+	if len(m.TotalDeposit) > 0 {
+		for iNdEx := len(m.TotalDeposit) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.TotalDeposit[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGov(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x3a
+		}
 	}
-	l = len(m.Metadata)
-	if l > 0 {
-		n += 1 + l + sovGov(uint64(l))
+	if m.DepositEndTime != nil {
+		n7, err7 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(*m.DepositEndTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.DepositEndTime):])
+		if err7 != nil {
+			return 0, err7
+		}
+		i -= n7
+		i = encodeVarintGov(dAtA, i, uint64(n7))
+		i--
+		dAtA[i] = 0x32
 	}
-	l = len(m.Title)
-	if l > 0 {
-		n += 1 + l + sovGov(uint64(l))
+	if m.SubmitTime != nil {
+		n8, err8 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(*m.SubmitTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.SubmitTime):])
+		if err8 != nil {
+			return 0, err8
+		}
+		i -= n8
+		i = encodeVarintGov(dAtA, i, uint64(n8))
+		i--
+		dAtA[i] = 0x2a
 	}
-	l = len(m.Summary)
-	if l > 0 {
-		n += 1 + l + sovGov(uint64(l))
+	if m.FinalTallyResult != nil {
+		{
+			size, err := m.FinalTallyResult.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGov(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
 	}
-	l = len(m.Proposer)
-	if l > 0 {
-		n += 1 + l + sovGov(uint64(l))
+	if m.Status != 0 {
+		i = encodeVarintGov(dAtA, i, uint64(m.Status))
+		i--
+		dAtA[i] = 0x18
 	}
-	if m.Expedited {
-		n += 2
+	if len(m.Messages) > 0 {
+		for iNdEx := len(m.Messages) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Messages[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGov(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
 	}
-	return n
+	if m.Id != 0 {
+		i = encodeVarintGov(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *TallyResult) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *ProposalExecutionFailure) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = len(m.YesCount)
-	if l > 0 {
-		n += 1 + l + sovGov(uint64(l))
-	}
-	l = len(m.AbstainCount)
-	if l > 0 {
-		n += 1 + l + sovGov(uint64(l))
-	}
-	l = len(m.NoCount)
-	if l > 0 {
-		n += 1 + l + sovGov(uint64(l))
-	}
-	l = len(m.NoWithVetoCount)
-	if l > 0 {
-		n += 1 + l + sovGov(uint64(l))
-	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *Vote) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *ProposalExecutionFailure) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ProposalExecutionFailure) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.ProposalId != 0 {
-		n += 1 + sovGov(uint64(m.ProposalId))
-	}
-	l = len(m.Voter)
-	if l > 0 {
-		n += 1 + l + sovGov(uint64(l))
+	if len(m.Reason) > 0 {
+		i -= len(m.Reason)
+		copy(dAtA[i:], m.Reason)
+		i = encodeVarintGov(dAtA, i, uint64(len(m.Reason)))
+		i--
+		dAtA[i] = 0x1a
 	}
-	if len(m.Options) > 0 {
-		for _, e := range m.Options {
-			l = e.Size()
-			n += 1 + l + sovGov(uint64(l))
-		}
+	if len(m.MsgTypeUrl) > 0 {
+		i -= len(m.MsgTypeUrl)
+		copy(dAtA[i:], m.MsgTypeUrl)
+		i = encodeVarintGov(dAtA, i, uint64(len(m.MsgTypeUrl)))
+		i--
+		dAtA[i] = 0x12
 	}
-	l = len(m.Metadata)
-	if l > 0 {
-		n += 1 + l + sovGov(uint64(l))
+	if m.MsgIndex != 0 {
+		i = encodeVarintGov(dAtA, i, uint64(m.MsgIndex))
+		i--
+		dAtA[i] = 0x8
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *DepositParams) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *ProposalExecutionReceipt) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *ProposalExecutionReceipt) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ProposalExecutionReceipt) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if len(m.MinDeposit) > 0 {
-		for _, e := range m.MinDeposit {
-			l = e.Size()
-			n += 1 + l + sovGov(uint64(l))
+	if len(m.MsgResponses) > 0 {
+		for iNdEx := len(m.MsgResponses) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.MsgResponses[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGov(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
 		}
 	}
-	if m.MaxDepositPeriod != nil {
-		l = github_com_cosmos_gogoproto_types.SizeOfStdDuration(*m.MaxDepositPeriod)
-		n += 1 + l + sovGov(uint64(l))
+	n10, err10 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.Time, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.Time):])
+	if err10 != nil {
+		return 0, err10
 	}
-	return n
+	i -= n10
+	i = encodeVarintGov(dAtA, i, uint64(n10))
+	i--
+	dAtA[i] = 0x1a
+	if m.Height != 0 {
+		i = encodeVarintGov(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.ProposalId != 0 {
+		i = encodeVarintGov(dAtA, i, uint64(m.ProposalId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *VotingParams) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *ParamsUpdateFailure) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *ParamsUpdateFailure) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ParamsUpdateFailure) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.VotingPeriod != nil {
-		l = github_com_cosmos_gogoproto_types.SizeOfStdDuration(*m.VotingPeriod)
-		n += 1 + l + sovGov(uint64(l))
+	if len(m.Reason) > 0 {
+		i -= len(m.Reason)
+		copy(dAtA[i:], m.Reason)
+		i = encodeVarintGov(dAtA, i, uint64(len(m.Reason)))
+		i--
+		dAtA[i] = 0x1a
 	}
-	return n
+	if len(m.MsgTypeUrl) > 0 {
+		i -= len(m.MsgTypeUrl)
+		copy(dAtA[i:], m.MsgTypeUrl)
+		i = encodeVarintGov(dAtA, i, uint64(len(m.MsgTypeUrl)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Index != 0 {
+		i = encodeVarintGov(dAtA, i, uint64(m.Index))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *TallyParams) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *TallyResult) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *TallyResult) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TallyResult) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Quorum)
-	if l > 0 {
-		n += 1 + l + sovGov(uint64(l))
+	if len(m.NoWithVetoCount) > 0 {
+		i -= len(m.NoWithVetoCount)
+		copy(dAtA[i:], m.NoWithVetoCount)
+		i = encodeVarintGov(dAtA, i, uint64(len(m.NoWithVetoCount)))
+		i--
+		dAtA[i] = 0x22
 	}
-	l = len(m.Threshold)
-	if l > 0 {
-		n += 1 + l + sovGov(uint64(l))
+	if len(m.NoCount) > 0 {
+		i -= len(m.NoCount)
+		copy(dAtA[i:], m.NoCount)
+		i = encodeVarintGov(dAtA, i, uint64(len(m.NoCount)))
+		i--
+		dAtA[i] = 0x1a
 	}
-	l = len(m.VetoThreshold)
-	if l > 0 {
-		n += 1 + l + sovGov(uint64(l))
+	if len(m.AbstainCount) > 0 {
+		i -= len(m.AbstainCount)
+		copy(dAtA[i:], m.AbstainCount)
+		i = encodeVarintGov(dAtA, i, uint64(len(m.AbstainCount)))
+		i--
+		dAtA[i] = 0x12
 	}
-	return n
+	if len(m.YesCount) > 0 {
+		i -= len(m.YesCount)
+		copy(dAtA[i:], m.YesCount)
+		i = encodeVarintGov(dAtA, i, uint64(len(m.YesCount)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *Params) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *Vote) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *Vote) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Vote) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if len(m.MinDeposit) > 0 {
-		for _, e := range m.MinDeposit {
-			l = e.Size()
-			n += 1 + l + sovGov(uint64(l))
-		}
+	if m.MetadataSchemaId != 0 {
+		i = encodeVarintGov(dAtA, i, uint64(m.MetadataSchemaId))
+		i--
+		dAtA[i] = 0x30
 	}
-	if m.MaxDepositPeriod != nil {
-		l = github_com_cosmos_gogoproto_types.SizeOfStdDuration(*m.MaxDepositPeriod)
-		n += 1 + l + sovGov(uint64(l))
+	if len(m.Metadata) > 0 {
+		i -= len(m.Metadata)
+		copy(dAtA[i:], m.Metadata)
+		i = encodeVarintGov(dAtA, i, uint64(len(m.Metadata)))
+		i--
+		dAtA[i] = 0x2a
 	}
-	if m.VotingPeriod != nil {
-		l = github_com_cosmos_gogoproto_types.SizeOfStdDuration(*m.VotingPeriod)
-		n += 1 + l + sovGov(uint64(l))
+	if len(m.Options) > 0 {
+		for iNdEx := len(m.Options) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Options[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGov(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
 	}
-	l = len(m.Quorum)
-	if l > 0 {
-		n += 1 + l + sovGov(uint64(l))
+	if len(m.Voter) > 0 {
+		i -= len(m.Voter)
+		copy(dAtA[i:], m.Voter)
+		i = encodeVarintGov(dAtA, i, uint64(len(m.Voter)))
+		i--
+		dAtA[i] = 0x12
 	}
-	l = len(m.Threshold)
-	if l > 0 {
-		n += 1 + l + sovGov(uint64(l))
+	if m.ProposalId != 0 {
+		i = encodeVarintGov(dAtA, i, uint64(m.ProposalId))
+		i--
+		dAtA[i] = 0x8
 	}
-	l = len(m.VetoThreshold)
-	if l > 0 {
-		n += 1 + l + sovGov(uint64(l))
+	return len(dAtA) - i, nil
+}
+
+func (m *VoteCommitment) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	l = len(m.MinInitialDepositRatio)
-	if l > 0 {
-		n += 1 + l + sovGov(uint64(l))
+	return dAtA[:n], nil
+}
+
+func (m *VoteCommitment) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *VoteCommitment) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Commitment) > 0 {
+		i -= len(m.Commitment)
+		copy(dAtA[i:], m.Commitment)
+		i = encodeVarintGov(dAtA, i, uint64(len(m.Commitment)))
+		i--
+		dAtA[i] = 0x1a
 	}
-	l = len(m.ProposalCancelRatio)
-	if l > 0 {
-		n += 1 + l + sovGov(uint64(l))
+	if len(m.Voter) > 0 {
+		i -= len(m.Voter)
+		copy(dAtA[i:], m.Voter)
+		i = encodeVarintGov(dAtA, i, uint64(len(m.Voter)))
+		i--
+		dAtA[i] = 0x12
 	}
-	l = len(m.ProposalCancelDest)
-	if l > 0 {
-		n += 1 + l + sovGov(uint64(l))
+	if m.ProposalId != 0 {
+		i = encodeVarintGov(dAtA, i, uint64(m.ProposalId))
+		i--
+		dAtA[i] = 0x8
 	}
-	if m.ExpeditedVotingPeriod != nil {
-		l = github_com_cosmos_gogoproto_types.SizeOfStdDuration(*m.ExpeditedVotingPeriod)
-		n += 1 + l + sovGov(uint64(l))
+	return len(dAtA) - i, nil
+}
+
+func (m *ValidatorVoteBreakdown) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	l = len(m.ExpeditedThreshold)
-	if l > 0 {
-		n += 1 + l + sovGov(uint64(l))
+	return dAtA[:n], nil
+}
+
+func (m *ValidatorVoteBreakdown) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ValidatorVoteBreakdown) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ParticipationExempt {
+		i--
+		if m.ParticipationExempt {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x30
 	}
-	if len(m.ExpeditedMinDeposit) > 0 {
-		for _, e := range m.ExpeditedMinDeposit {
-			l = e.Size()
-			n += 1 + l + sovGov(uint64(l))
+	if len(m.OverriddenPower) > 0 {
+		i -= len(m.OverriddenPower)
+		copy(dAtA[i:], m.OverriddenPower)
+		i = encodeVarintGov(dAtA, i, uint64(len(m.OverriddenPower)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.InheritedPower) > 0 {
+		i -= len(m.InheritedPower)
+		copy(dAtA[i:], m.InheritedPower)
+		i = encodeVarintGov(dAtA, i, uint64(len(m.InheritedPower)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Options) > 0 {
+		for iNdEx := len(m.Options) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Options[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGov(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
 		}
 	}
-	if m.BurnVoteQuorum {
-		n += 2
+	if len(m.ValidatorAddress) > 0 {
+		i -= len(m.ValidatorAddress)
+		copy(dAtA[i:], m.ValidatorAddress)
+		i = encodeVarintGov(dAtA, i, uint64(len(m.ValidatorAddress)))
+		i--
+		dAtA[i] = 0x12
 	}
-	if m.BurnProposalDepositPrevote {
-		n += 2
+	if m.ProposalId != 0 {
+		i = encodeVarintGov(dAtA, i, uint64(m.ProposalId))
+		i--
+		dAtA[i] = 0x8
 	}
-	if m.BurnVoteVeto {
-		n += 2
+	return len(dAtA) - i, nil
+}
+
+func (m *ValidatorVotedShares) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func sovGov(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
+func (m *ValidatorVotedShares) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
-func sozGov(x uint64) (n int) {
-	return sovGov(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+
+func (m *ValidatorVotedShares) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Voted) > 0 {
+		i -= len(m.Voted)
+		copy(dAtA[i:], m.Voted)
+		i = encodeVarintGov(dAtA, i, uint64(len(m.Voted)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.NoWithVeto) > 0 {
+		i -= len(m.NoWithVeto)
+		copy(dAtA[i:], m.NoWithVeto)
+		i = encodeVarintGov(dAtA, i, uint64(len(m.NoWithVeto)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.No) > 0 {
+		i -= len(m.No)
+		copy(dAtA[i:], m.No)
+		i = encodeVarintGov(dAtA, i, uint64(len(m.No)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Abstain) > 0 {
+		i -= len(m.Abstain)
+		copy(dAtA[i:], m.Abstain)
+		i = encodeVarintGov(dAtA, i, uint64(len(m.Abstain)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Yes) > 0 {
+		i -= len(m.Yes)
+		copy(dAtA[i:], m.Yes)
+		i = encodeVarintGov(dAtA, i, uint64(len(m.Yes)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
 }
-func (m *WeightedVoteOption) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowGov
+
+func (m *ConstitutionAmendment) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ConstitutionAmendment) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ConstitutionAmendment) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	n11, err11 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.AmendedAt, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.AmendedAt):])
+	if err11 != nil {
+		return 0, err11
+	}
+	i -= n11
+	i = encodeVarintGov(dAtA, i, uint64(n11))
+	i--
+	dAtA[i] = 0x22
+	if len(m.Constitution) > 0 {
+		i -= len(m.Constitution)
+		copy(dAtA[i:], m.Constitution)
+		i = encodeVarintGov(dAtA, i, uint64(len(m.Constitution)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.ProposalId != 0 {
+		i = encodeVarintGov(dAtA, i, uint64(m.ProposalId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Id != 0 {
+		i = encodeVarintGov(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MetadataSchema) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MetadataSchema) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MetadataSchema) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Hash) > 0 {
+		i -= len(m.Hash)
+		copy(dAtA[i:], m.Hash)
+		i = encodeVarintGov(dAtA, i, uint64(len(m.Hash)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Uri) > 0 {
+		i -= len(m.Uri)
+		copy(dAtA[i:], m.Uri)
+		i = encodeVarintGov(dAtA, i, uint64(len(m.Uri)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintGov(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Id != 0 {
+		i = encodeVarintGov(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *DepositParams) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DepositParams) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DepositParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.MaxDepositPeriod != nil {
+		n12, err12 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(*m.MaxDepositPeriod, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(*m.MaxDepositPeriod):])
+		if err12 != nil {
+			return 0, err12
+		}
+		i -= n12
+		i = encodeVarintGov(dAtA, i, uint64(n12))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.MinDeposit) > 0 {
+		for iNdEx := len(m.MinDeposit) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.MinDeposit[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGov(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *VotingParams) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *VotingParams) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *VotingParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.VotingPeriod != nil {
+		n13, err13 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(*m.VotingPeriod, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(*m.VotingPeriod):])
+		if err13 != nil {
+			return 0, err13
+		}
+		i -= n13
+		i = encodeVarintGov(dAtA, i, uint64(n13))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *TallyParams) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TallyParams) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TallyParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ProposalKindQuorums) > 0 {
+		for iNdEx := len(m.ProposalKindQuorums) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ProposalKindQuorums[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGov(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if len(m.VetoQuorum) > 0 {
+		i -= len(m.VetoQuorum)
+		copy(dAtA[i:], m.VetoQuorum)
+		i = encodeVarintGov(dAtA, i, uint64(len(m.VetoQuorum)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.VetoThreshold) > 0 {
+		i -= len(m.VetoThreshold)
+		copy(dAtA[i:], m.VetoThreshold)
+		i = encodeVarintGov(dAtA, i, uint64(len(m.VetoThreshold)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Threshold) > 0 {
+		i -= len(m.Threshold)
+		copy(dAtA[i:], m.Threshold)
+		i = encodeVarintGov(dAtA, i, uint64(len(m.Threshold)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Quorum) > 0 {
+		i -= len(m.Quorum)
+		copy(dAtA[i:], m.Quorum)
+		i = encodeVarintGov(dAtA, i, uint64(len(m.Quorum)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *Params) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Params) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ProposalKindQuorums) > 0 {
+		for iNdEx := len(m.ProposalKindQuorums) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ProposalKindQuorums[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGov(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0xf2
+		}
+	}
+	if len(m.VetoQuorum) > 0 {
+		i -= len(m.VetoQuorum)
+		copy(dAtA[i:], m.VetoQuorum)
+		i = encodeVarintGov(dAtA, i, uint64(len(m.VetoQuorum)))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xea
+	}
+	if m.RevealPeriod != nil {
+		n14, err14 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(*m.RevealPeriod, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(*m.RevealPeriod):])
+		if err14 != nil {
+			return 0, err14
+		}
+		i -= n14
+		i = encodeVarintGov(dAtA, i, uint64(n14))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xe2
+	}
+	if m.MinDepositAnyDenom {
+		i--
+		if m.MinDepositAnyDenom {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xd8
+	}
+	if len(m.ParticipationExemptionThreshold) > 0 {
+		i -= len(m.ParticipationExemptionThreshold)
+		copy(dAtA[i:], m.ParticipationExemptionThreshold)
+		i = encodeVarintGov(dAtA, i, uint64(len(m.ParticipationExemptionThreshold)))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xd2
+	}
+	if m.AmendmentPeriod != nil {
+		n15, err15 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(*m.AmendmentPeriod, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(*m.AmendmentPeriod):])
+		if err15 != nil {
+			return 0, err15
+		}
+		i -= n15
+		i = encodeVarintGov(dAtA, i, uint64(n15))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xca
+	}
+	if len(m.AcceptedDepositDenoms) > 0 {
+		for iNdEx := len(m.AcceptedDepositDenoms) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.AcceptedDepositDenoms[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGov(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0xc2
+		}
+	}
+	if m.AbstainSemantics != 0 {
+		i = encodeVarintGov(dAtA, i, uint64(m.AbstainSemantics))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xb8
+	}
+	if len(m.ConstitutionAmendmentThreshold) > 0 {
+		i -= len(m.ConstitutionAmendmentThreshold)
+		copy(dAtA[i:], m.ConstitutionAmendmentThreshold)
+		i = encodeVarintGov(dAtA, i, uint64(len(m.ConstitutionAmendmentThreshold)))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xb2
+	}
+	if m.MaxProposalsProcessedPerEndBlock != 0 {
+		i = encodeVarintGov(dAtA, i, uint64(m.MaxProposalsProcessedPerEndBlock))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xa8
+	}
+	if m.EarlyResolution {
+		i--
+		if m.EarlyResolution {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xa0
+	}
+	if m.DiscussionPeriod != nil {
+		n16, err16 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(*m.DiscussionPeriod, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(*m.DiscussionPeriod):])
+		if err16 != nil {
+			return 0, err16
+		}
+		i -= n16
+		i = encodeVarintGov(dAtA, i, uint64(n16))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x9a
+	}
+	if len(m.ExecutionAuthorities) > 0 {
+		for iNdEx := len(m.ExecutionAuthorities) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.ExecutionAuthorities[iNdEx])
+			copy(dAtA[i:], m.ExecutionAuthorities[iNdEx])
+			i = encodeVarintGov(dAtA, i, uint64(len(m.ExecutionAuthorities[iNdEx])))
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0x92
+		}
+	}
+	if m.DepositExtensionPeriod != nil {
+		n17, err17 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(*m.DepositExtensionPeriod, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(*m.DepositExtensionPeriod):])
+		if err17 != nil {
+			return 0, err17
+		}
+		i -= n17
+		i = encodeVarintGov(dAtA, i, uint64(n17))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x8a
+	}
+	if len(m.DepositExtensionRatio) > 0 {
+		i -= len(m.DepositExtensionRatio)
+		copy(dAtA[i:], m.DepositExtensionRatio)
+		i = encodeVarintGov(dAtA, i, uint64(len(m.DepositExtensionRatio)))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x82
+	}
+	if m.BurnVoteVeto {
+		i--
+		if m.BurnVoteVeto {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x78
+	}
+	if m.BurnProposalDepositPrevote {
+		i--
+		if m.BurnProposalDepositPrevote {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x70
+	}
+	if m.BurnVoteQuorum {
+		i--
+		if m.BurnVoteQuorum {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x68
+	}
+	if len(m.ExpeditedMinDeposit) > 0 {
+		for iNdEx := len(m.ExpeditedMinDeposit) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ExpeditedMinDeposit[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGov(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x62
+		}
+	}
+	if len(m.ExpeditedThreshold) > 0 {
+		i -= len(m.ExpeditedThreshold)
+		copy(dAtA[i:], m.ExpeditedThreshold)
+		i = encodeVarintGov(dAtA, i, uint64(len(m.ExpeditedThreshold)))
+		i--
+		dAtA[i] = 0x5a
+	}
+	if m.ExpeditedVotingPeriod != nil {
+		n18, err18 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(*m.ExpeditedVotingPeriod, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(*m.ExpeditedVotingPeriod):])
+		if err18 != nil {
+			return 0, err18
+		}
+		i -= n18
+		i = encodeVarintGov(dAtA, i, uint64(n18))
+		i--
+		dAtA[i] = 0x52
+	}
+	if len(m.ProposalCancelDest) > 0 {
+		i -= len(m.ProposalCancelDest)
+		copy(dAtA[i:], m.ProposalCancelDest)
+		i = encodeVarintGov(dAtA, i, uint64(len(m.ProposalCancelDest)))
+		i--
+		dAtA[i] = 0x4a
+	}
+	if len(m.ProposalCancelRatio) > 0 {
+		i -= len(m.ProposalCancelRatio)
+		copy(dAtA[i:], m.ProposalCancelRatio)
+		i = encodeVarintGov(dAtA, i, uint64(len(m.ProposalCancelRatio)))
+		i--
+		dAtA[i] = 0x42
+	}
+	if len(m.MinInitialDepositRatio) > 0 {
+		i -= len(m.MinInitialDepositRatio)
+		copy(dAtA[i:], m.MinInitialDepositRatio)
+		i = encodeVarintGov(dAtA, i, uint64(len(m.MinInitialDepositRatio)))
+		i--
+		dAtA[i] = 0x3a
+	}
+	if len(m.VetoThreshold) > 0 {
+		i -= len(m.VetoThreshold)
+		copy(dAtA[i:], m.VetoThreshold)
+		i = encodeVarintGov(dAtA, i, uint64(len(m.VetoThreshold)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if len(m.Threshold) > 0 {
+		i -= len(m.Threshold)
+		copy(dAtA[i:], m.Threshold)
+		i = encodeVarintGov(dAtA, i, uint64(len(m.Threshold)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.Quorum) > 0 {
+		i -= len(m.Quorum)
+		copy(dAtA[i:], m.Quorum)
+		i = encodeVarintGov(dAtA, i, uint64(len(m.Quorum)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.VotingPeriod != nil {
+		n19, err19 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(*m.VotingPeriod, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(*m.VotingPeriod):])
+		if err19 != nil {
+			return 0, err19
+		}
+		i -= n19
+		i = encodeVarintGov(dAtA, i, uint64(n19))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.MaxDepositPeriod != nil {
+		n20, err20 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(*m.MaxDepositPeriod, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(*m.MaxDepositPeriod):])
+		if err20 != nil {
+			return 0, err20
+		}
+		i -= n20
+		i = encodeVarintGov(dAtA, i, uint64(n20))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.MinDeposit) > 0 {
+		for iNdEx := len(m.MinDeposit) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.MinDeposit[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGov(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ProposalKindQuorum) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ProposalKindQuorum) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ProposalKindQuorum) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.VetoQuorum) > 0 {
+		i -= len(m.VetoQuorum)
+		copy(dAtA[i:], m.VetoQuorum)
+		i = encodeVarintGov(dAtA, i, uint64(len(m.VetoQuorum)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Quorum) > 0 {
+		i -= len(m.Quorum)
+		copy(dAtA[i:], m.Quorum)
+		i = encodeVarintGov(dAtA, i, uint64(len(m.Quorum)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Kind) > 0 {
+		i -= len(m.Kind)
+		copy(dAtA[i:], m.Kind)
+		i = encodeVarintGov(dAtA, i, uint64(len(m.Kind)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *AcceptedDepositDenom) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AcceptedDepositDenom) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AcceptedDepositDenom) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.UseOracle {
+		i--
+		if m.UseOracle {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.Ratio) > 0 {
+		i -= len(m.Ratio)
+		copy(dAtA[i:], m.Ratio)
+		i = encodeVarintGov(dAtA, i, uint64(len(m.Ratio)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.BaseDenom) > 0 {
+		i -= len(m.BaseDenom)
+		copy(dAtA[i:], m.BaseDenom)
+		i = encodeVarintGov(dAtA, i, uint64(len(m.BaseDenom)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintGov(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintGov(dAtA []byte, offset int, v uint64) int {
+	offset -= sovGov(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *WeightedVoteOption) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Option != 0 {
+		n += 1 + sovGov(uint64(m.Option))
+	}
+	l = len(m.Weight)
+	if l > 0 {
+		n += 1 + l + sovGov(uint64(l))
+	}
+	return n
+}
+
+func (m *Deposit) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ProposalId != 0 {
+		n += 1 + sovGov(uint64(m.ProposalId))
+	}
+	l = len(m.Depositor)
+	if l > 0 {
+		n += 1 + l + sovGov(uint64(l))
+	}
+	if len(m.Amount) > 0 {
+		for _, e := range m.Amount {
+			l = e.Size()
+			n += 1 + l + sovGov(uint64(l))
+		}
+	}
+	l = len(m.Metadata)
+	if l > 0 {
+		n += 1 + l + sovGov(uint64(l))
+	}
+	if m.MetadataSchemaId != 0 {
+		n += 1 + sovGov(uint64(m.MetadataSchemaId))
+	}
+	return n
+}
+
+func (m *Proposal) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovGov(uint64(m.Id))
+	}
+	if len(m.Messages) > 0 {
+		for _, e := range m.Messages {
+			l = e.Size()
+			n += 1 + l + sovGov(uint64(l))
+		}
+	}
+	if m.Status != 0 {
+		n += 1 + sovGov(uint64(m.Status))
+	}
+	if m.FinalTallyResult != nil {
+		l = m.FinalTallyResult.Size()
+		n += 1 + l + sovGov(uint64(l))
+	}
+	if m.SubmitTime != nil {
+		l = github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.SubmitTime)
+		n += 1 + l + sovGov(uint64(l))
+	}
+	if m.DepositEndTime != nil {
+		l = github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.DepositEndTime)
+		n += 1 + l + sovGov(uint64(l))
+	}
+	if len(m.TotalDeposit) > 0 {
+		for _, e := range m.TotalDeposit {
+			l = e.Size()
+			n += 1 + l + sovGov(uint64(l))
+		}
+	}
+	if m.VotingStartTime != nil {
+		l = github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.VotingStartTime)
+		n += 1 + l + sovGov(uint64(l))
+	}
+	if m.VotingEndTime != nil {
+		l = github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.VotingEndTime)
+		n += 1 + l + sovGov(uint64(l))
+	}
+	l = len(m.Metadata)
+	if l > 0 {
+		n += 1 + l + sovGov(uint64(l))
+	}
+	l = len(m.Title)
+	if l > 0 {
+		n += 1 + l + sovGov(uint64(l))
+	}
+	l = len(m.Summary)
+	if l > 0 {
+		n += 1 + l + sovGov(uint64(l))
+	}
+	l = len(m.Proposer)
+	if l > 0 {
+		n += 1 + l + sovGov(uint64(l))
+	}
+	if m.Expedited {
+		n += 2
+	}
+	if len(m.ParamsUpdateFailures) > 0 {
+		for _, e := range m.ParamsUpdateFailures {
+			l = e.Size()
+			n += 1 + l + sovGov(uint64(l))
+		}
+	}
+	if m.DepositPeriodExtended {
+		n += 3
+	}
+	l = len(m.ExecutionAuthority)
+	if l > 0 {
+		n += 2 + l + sovGov(uint64(l))
+	}
+	if m.DiscussionEndTime != nil {
+		l = github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.DiscussionEndTime)
+		n += 2 + l + sovGov(uint64(l))
+	}
+	if m.ExecutionFailure != nil {
+		l = m.ExecutionFailure.Size()
+		n += 2 + l + sovGov(uint64(l))
+	}
+	if m.InvalidationFailure != nil {
+		l = m.InvalidationFailure.Size()
+		n += 2 + l + sovGov(uint64(l))
+	}
+	if m.CommitReveal {
+		n += 3
+	}
+	if m.CommitEndTime != nil {
+		l = github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.CommitEndTime)
+		n += 2 + l + sovGov(uint64(l))
+	}
+	if m.MetadataSchemaId != 0 {
+		n += 2 + sovGov(uint64(m.MetadataSchemaId))
+	}
+	return n
+}
+
+func (m *ProposalExecutionFailure) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.MsgIndex != 0 {
+		n += 1 + sovGov(uint64(m.MsgIndex))
+	}
+	l = len(m.MsgTypeUrl)
+	if l > 0 {
+		n += 1 + l + sovGov(uint64(l))
+	}
+	l = len(m.Reason)
+	if l > 0 {
+		n += 1 + l + sovGov(uint64(l))
+	}
+	return n
+}
+
+func (m *ProposalExecutionReceipt) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ProposalId != 0 {
+		n += 1 + sovGov(uint64(m.ProposalId))
+	}
+	if m.Height != 0 {
+		n += 1 + sovGov(uint64(m.Height))
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.Time)
+	n += 1 + l + sovGov(uint64(l))
+	if len(m.MsgResponses) > 0 {
+		for _, e := range m.MsgResponses {
+			l = e.Size()
+			n += 1 + l + sovGov(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *ParamsUpdateFailure) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Index != 0 {
+		n += 1 + sovGov(uint64(m.Index))
+	}
+	l = len(m.MsgTypeUrl)
+	if l > 0 {
+		n += 1 + l + sovGov(uint64(l))
+	}
+	l = len(m.Reason)
+	if l > 0 {
+		n += 1 + l + sovGov(uint64(l))
+	}
+	return n
+}
+
+func (m *TallyResult) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.YesCount)
+	if l > 0 {
+		n += 1 + l + sovGov(uint64(l))
+	}
+	l = len(m.AbstainCount)
+	if l > 0 {
+		n += 1 + l + sovGov(uint64(l))
+	}
+	l = len(m.NoCount)
+	if l > 0 {
+		n += 1 + l + sovGov(uint64(l))
+	}
+	l = len(m.NoWithVetoCount)
+	if l > 0 {
+		n += 1 + l + sovGov(uint64(l))
+	}
+	return n
+}
+
+func (m *Vote) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ProposalId != 0 {
+		n += 1 + sovGov(uint64(m.ProposalId))
+	}
+	l = len(m.Voter)
+	if l > 0 {
+		n += 1 + l + sovGov(uint64(l))
+	}
+	if len(m.Options) > 0 {
+		for _, e := range m.Options {
+			l = e.Size()
+			n += 1 + l + sovGov(uint64(l))
+		}
+	}
+	l = len(m.Metadata)
+	if l > 0 {
+		n += 1 + l + sovGov(uint64(l))
+	}
+	if m.MetadataSchemaId != 0 {
+		n += 1 + sovGov(uint64(m.MetadataSchemaId))
+	}
+	return n
+}
+
+func (m *VoteCommitment) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ProposalId != 0 {
+		n += 1 + sovGov(uint64(m.ProposalId))
+	}
+	l = len(m.Voter)
+	if l > 0 {
+		n += 1 + l + sovGov(uint64(l))
+	}
+	l = len(m.Commitment)
+	if l > 0 {
+		n += 1 + l + sovGov(uint64(l))
+	}
+	return n
+}
+
+func (m *ValidatorVoteBreakdown) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ProposalId != 0 {
+		n += 1 + sovGov(uint64(m.ProposalId))
+	}
+	l = len(m.ValidatorAddress)
+	if l > 0 {
+		n += 1 + l + sovGov(uint64(l))
+	}
+	if len(m.Options) > 0 {
+		for _, e := range m.Options {
+			l = e.Size()
+			n += 1 + l + sovGov(uint64(l))
+		}
+	}
+	l = len(m.InheritedPower)
+	if l > 0 {
+		n += 1 + l + sovGov(uint64(l))
+	}
+	l = len(m.OverriddenPower)
+	if l > 0 {
+		n += 1 + l + sovGov(uint64(l))
+	}
+	if m.ParticipationExempt {
+		n += 2
+	}
+	return n
+}
+
+func (m *ValidatorVotedShares) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Yes)
+	if l > 0 {
+		n += 1 + l + sovGov(uint64(l))
+	}
+	l = len(m.Abstain)
+	if l > 0 {
+		n += 1 + l + sovGov(uint64(l))
+	}
+	l = len(m.No)
+	if l > 0 {
+		n += 1 + l + sovGov(uint64(l))
+	}
+	l = len(m.NoWithVeto)
+	if l > 0 {
+		n += 1 + l + sovGov(uint64(l))
+	}
+	l = len(m.Voted)
+	if l > 0 {
+		n += 1 + l + sovGov(uint64(l))
+	}
+	return n
+}
+
+func (m *ConstitutionAmendment) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovGov(uint64(m.Id))
+	}
+	if m.ProposalId != 0 {
+		n += 1 + sovGov(uint64(m.ProposalId))
+	}
+	l = len(m.Constitution)
+	if l > 0 {
+		n += 1 + l + sovGov(uint64(l))
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.AmendedAt)
+	n += 1 + l + sovGov(uint64(l))
+	return n
+}
+
+func (m *MetadataSchema) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovGov(uint64(m.Id))
+	}
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovGov(uint64(l))
+	}
+	l = len(m.Uri)
+	if l > 0 {
+		n += 1 + l + sovGov(uint64(l))
+	}
+	l = len(m.Hash)
+	if l > 0 {
+		n += 1 + l + sovGov(uint64(l))
+	}
+	return n
+}
+
+func (m *DepositParams) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.MinDeposit) > 0 {
+		for _, e := range m.MinDeposit {
+			l = e.Size()
+			n += 1 + l + sovGov(uint64(l))
+		}
+	}
+	if m.MaxDepositPeriod != nil {
+		l = github_com_cosmos_gogoproto_types.SizeOfStdDuration(*m.MaxDepositPeriod)
+		n += 1 + l + sovGov(uint64(l))
+	}
+	return n
+}
+
+func (m *VotingParams) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.VotingPeriod != nil {
+		l = github_com_cosmos_gogoproto_types.SizeOfStdDuration(*m.VotingPeriod)
+		n += 1 + l + sovGov(uint64(l))
+	}
+	return n
+}
+
+func (m *TallyParams) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Quorum)
+	if l > 0 {
+		n += 1 + l + sovGov(uint64(l))
+	}
+	l = len(m.Threshold)
+	if l > 0 {
+		n += 1 + l + sovGov(uint64(l))
+	}
+	l = len(m.VetoThreshold)
+	if l > 0 {
+		n += 1 + l + sovGov(uint64(l))
+	}
+	l = len(m.VetoQuorum)
+	if l > 0 {
+		n += 1 + l + sovGov(uint64(l))
+	}
+	if len(m.ProposalKindQuorums) > 0 {
+		for _, e := range m.ProposalKindQuorums {
+			l = e.Size()
+			n += 1 + l + sovGov(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *Params) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.MinDeposit) > 0 {
+		for _, e := range m.MinDeposit {
+			l = e.Size()
+			n += 1 + l + sovGov(uint64(l))
+		}
+	}
+	if m.MaxDepositPeriod != nil {
+		l = github_com_cosmos_gogoproto_types.SizeOfStdDuration(*m.MaxDepositPeriod)
+		n += 1 + l + sovGov(uint64(l))
+	}
+	if m.VotingPeriod != nil {
+		l = github_com_cosmos_gogoproto_types.SizeOfStdDuration(*m.VotingPeriod)
+		n += 1 + l + sovGov(uint64(l))
+	}
+	l = len(m.Quorum)
+	if l > 0 {
+		n += 1 + l + sovGov(uint64(l))
+	}
+	l = len(m.Threshold)
+	if l > 0 {
+		n += 1 + l + sovGov(uint64(l))
+	}
+	l = len(m.VetoThreshold)
+	if l > 0 {
+		n += 1 + l + sovGov(uint64(l))
+	}
+	l = len(m.MinInitialDepositRatio)
+	if l > 0 {
+		n += 1 + l + sovGov(uint64(l))
+	}
+	l = len(m.ProposalCancelRatio)
+	if l > 0 {
+		n += 1 + l + sovGov(uint64(l))
+	}
+	l = len(m.ProposalCancelDest)
+	if l > 0 {
+		n += 1 + l + sovGov(uint64(l))
+	}
+	if m.ExpeditedVotingPeriod != nil {
+		l = github_com_cosmos_gogoproto_types.SizeOfStdDuration(*m.ExpeditedVotingPeriod)
+		n += 1 + l + sovGov(uint64(l))
+	}
+	l = len(m.ExpeditedThreshold)
+	if l > 0 {
+		n += 1 + l + sovGov(uint64(l))
+	}
+	if len(m.ExpeditedMinDeposit) > 0 {
+		for _, e := range m.ExpeditedMinDeposit {
+			l = e.Size()
+			n += 1 + l + sovGov(uint64(l))
+		}
+	}
+	if m.BurnVoteQuorum {
+		n += 2
+	}
+	if m.BurnProposalDepositPrevote {
+		n += 2
+	}
+	if m.BurnVoteVeto {
+		n += 2
+	}
+	l = len(m.DepositExtensionRatio)
+	if l > 0 {
+		n += 2 + l + sovGov(uint64(l))
+	}
+	if m.DepositExtensionPeriod != nil {
+		l = github_com_cosmos_gogoproto_types.SizeOfStdDuration(*m.DepositExtensionPeriod)
+		n += 2 + l + sovGov(uint64(l))
+	}
+	if len(m.ExecutionAuthorities) > 0 {
+		for _, s := range m.ExecutionAuthorities {
+			l = len(s)
+			n += 2 + l + sovGov(uint64(l))
+		}
+	}
+	if m.DiscussionPeriod != nil {
+		l = github_com_cosmos_gogoproto_types.SizeOfStdDuration(*m.DiscussionPeriod)
+		n += 2 + l + sovGov(uint64(l))
+	}
+	if m.EarlyResolution {
+		n += 3
+	}
+	if m.MaxProposalsProcessedPerEndBlock != 0 {
+		n += 2 + sovGov(uint64(m.MaxProposalsProcessedPerEndBlock))
+	}
+	l = len(m.ConstitutionAmendmentThreshold)
+	if l > 0 {
+		n += 2 + l + sovGov(uint64(l))
+	}
+	if m.AbstainSemantics != 0 {
+		n += 2 + sovGov(uint64(m.AbstainSemantics))
+	}
+	if len(m.AcceptedDepositDenoms) > 0 {
+		for _, e := range m.AcceptedDepositDenoms {
+			l = e.Size()
+			n += 2 + l + sovGov(uint64(l))
+		}
+	}
+	if m.AmendmentPeriod != nil {
+		l = github_com_cosmos_gogoproto_types.SizeOfStdDuration(*m.AmendmentPeriod)
+		n += 2 + l + sovGov(uint64(l))
+	}
+	l = len(m.ParticipationExemptionThreshold)
+	if l > 0 {
+		n += 2 + l + sovGov(uint64(l))
+	}
+	if m.MinDepositAnyDenom {
+		n += 3
+	}
+	if m.RevealPeriod != nil {
+		l = github_com_cosmos_gogoproto_types.SizeOfStdDuration(*m.RevealPeriod)
+		n += 2 + l + sovGov(uint64(l))
+	}
+	l = len(m.VetoQuorum)
+	if l > 0 {
+		n += 2 + l + sovGov(uint64(l))
+	}
+	if len(m.ProposalKindQuorums) > 0 {
+		for _, e := range m.ProposalKindQuorums {
+			l = e.Size()
+			n += 2 + l + sovGov(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *ProposalKindQuorum) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Kind)
+	if l > 0 {
+		n += 1 + l + sovGov(uint64(l))
+	}
+	l = len(m.Quorum)
+	if l > 0 {
+		n += 1 + l + sovGov(uint64(l))
+	}
+	l = len(m.VetoQuorum)
+	if l > 0 {
+		n += 1 + l + sovGov(uint64(l))
+	}
+	return n
+}
+
+func (m *AcceptedDepositDenom) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovGov(uint64(l))
+	}
+	l = len(m.BaseDenom)
+	if l > 0 {
+		n += 1 + l + sovGov(uint64(l))
+	}
+	l = len(m.Ratio)
+	if l > 0 {
+		n += 1 + l + sovGov(uint64(l))
+	}
+	if m.UseOracle {
+		n += 2
+	}
+	return n
+}
+
+func sovGov(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozGov(x uint64) (n int) {
+	return sovGov(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *WeightedVoteOption) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGov
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: WeightedVoteOption: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: WeightedVoteOption: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Option", wireType)
+			}
+			m.Option = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Option |= VoteOption(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Weight", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Weight = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGov(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGov
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Deposit) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGov
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Deposit: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Deposit: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProposalId", wireType)
+			}
+			m.ProposalId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ProposalId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Depositor", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Depositor = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Amount = append(m.Amount, types.Coin{})
+			if err := m.Amount[len(m.Amount)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Metadata", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Metadata = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MetadataSchemaId", wireType)
+			}
+			m.MetadataSchemaId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MetadataSchemaId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGov(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGov
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Proposal) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGov
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Proposal: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Proposal: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Messages", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Messages = append(m.Messages, &types1.Any{})
+			if err := m.Messages[len(m.Messages)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			}
+			m.Status = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Status |= ProposalStatus(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FinalTallyResult", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.FinalTallyResult == nil {
+				m.FinalTallyResult = &TallyResult{}
+			}
+			if err := m.FinalTallyResult.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SubmitTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.SubmitTime == nil {
+				m.SubmitTime = new(time.Time)
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(m.SubmitTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DepositEndTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.DepositEndTime == nil {
+				m.DepositEndTime = new(time.Time)
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(m.DepositEndTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalDeposit", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TotalDeposit = append(m.TotalDeposit, types.Coin{})
+			if err := m.TotalDeposit[len(m.TotalDeposit)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VotingStartTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.VotingStartTime == nil {
+				m.VotingStartTime = new(time.Time)
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(m.VotingStartTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VotingEndTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.VotingEndTime == nil {
+				m.VotingEndTime = new(time.Time)
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(m.VotingEndTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Metadata", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Metadata = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Title", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Title = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Summary", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Summary = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 13:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Proposer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Proposer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 14:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Expedited", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Expedited = bool(v != 0)
+		case 15:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ParamsUpdateFailures", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ParamsUpdateFailures = append(m.ParamsUpdateFailures, ParamsUpdateFailure{})
+			if err := m.ParamsUpdateFailures[len(m.ParamsUpdateFailures)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 16:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DepositPeriodExtended", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.DepositPeriodExtended = bool(v != 0)
+		case 17:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExecutionAuthority", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ExecutionAuthority = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 18:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DiscussionEndTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.DiscussionEndTime == nil {
+				m.DiscussionEndTime = new(time.Time)
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(m.DiscussionEndTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 19:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExecutionFailure", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ExecutionFailure == nil {
+				m.ExecutionFailure = &ProposalExecutionFailure{}
+			}
+			if err := m.ExecutionFailure.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 20:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InvalidationFailure", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.InvalidationFailure == nil {
+				m.InvalidationFailure = &ProposalExecutionFailure{}
+			}
+			if err := m.InvalidationFailure.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 21:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CommitReveal", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.CommitReveal = bool(v != 0)
+		case 22:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CommitEndTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.CommitEndTime == nil {
+				m.CommitEndTime = new(time.Time)
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(m.CommitEndTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 23:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MetadataSchemaId", wireType)
+			}
+			m.MetadataSchemaId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MetadataSchemaId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGov(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGov
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ProposalExecutionFailure) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGov
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ProposalExecutionFailure: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ProposalExecutionFailure: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MsgIndex", wireType)
+			}
+			m.MsgIndex = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MsgIndex |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MsgTypeUrl", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MsgTypeUrl = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Reason = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGov(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGov
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ProposalExecutionReceipt) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGov
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ProposalExecutionReceipt: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ProposalExecutionReceipt: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProposalId", wireType)
+			}
+			m.ProposalId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ProposalId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Time", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.Time, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MsgResponses", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MsgResponses = append(m.MsgResponses, &types1.Any{})
+			if err := m.MsgResponses[len(m.MsgResponses)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGov(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGov
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ParamsUpdateFailure) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGov
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ParamsUpdateFailure: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ParamsUpdateFailure: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Index", wireType)
+			}
+			m.Index = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Index |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MsgTypeUrl", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MsgTypeUrl = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Reason = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGov(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGov
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *TallyResult) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGov
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TallyResult: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TallyResult: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field YesCount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.YesCount = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AbstainCount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AbstainCount = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NoCount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.NoCount = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NoWithVetoCount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.NoWithVetoCount = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGov(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGov
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Vote) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGov
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Vote: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Vote: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProposalId", wireType)
+			}
+			m.ProposalId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ProposalId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Voter", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Voter = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Options", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Options = append(m.Options, &WeightedVoteOption{})
+			if err := m.Options[len(m.Options)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Metadata", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Metadata = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MetadataSchemaId", wireType)
+			}
+			m.MetadataSchemaId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MetadataSchemaId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGov(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGov
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *VoteCommitment) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGov
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: VoteCommitment: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: VoteCommitment: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProposalId", wireType)
+			}
+			m.ProposalId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ProposalId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Voter", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Voter = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Commitment", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Commitment = append(m.Commitment[:0], dAtA[iNdEx:postIndex]...)
+			if m.Commitment == nil {
+				m.Commitment = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGov(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGov
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ValidatorVoteBreakdown) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGov
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ValidatorVoteBreakdown: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ValidatorVoteBreakdown: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProposalId", wireType)
+			}
+			m.ProposalId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ProposalId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ValidatorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Options", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Options = append(m.Options, &WeightedVoteOption{})
+			if err := m.Options[len(m.Options)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InheritedPower", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.InheritedPower = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OverriddenPower", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OverriddenPower = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ParticipationExempt", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.ParticipationExempt = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGov(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGov
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ValidatorVotedShares) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGov
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ValidatorVotedShares: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ValidatorVotedShares: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Yes", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Yes = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Abstain", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
 			}
-			if iNdEx >= l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			m.Abstain = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field No", wireType)
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: WeightedVoteOption: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: WeightedVoteOption: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Option", wireType)
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			m.Option = 0
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.No = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NoWithVeto", wireType)
+			}
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGov
@@ -1992,14 +6709,27 @@ func (m *WeightedVoteOption) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Option |= VoteOption(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 2:
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.NoWithVeto = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Weight", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Voted", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2027,7 +6757,7 @@ func (m *WeightedVoteOption) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Weight = string(dAtA[iNdEx:postIndex])
+			m.Voted = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -2050,7 +6780,7 @@ func (m *WeightedVoteOption) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Deposit) Unmarshal(dAtA []byte) error {
+func (m *ConstitutionAmendment) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2073,13 +6803,32 @@ func (m *Deposit) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Deposit: wiretype end group for non-group")
+			return fmt.Errorf("proto: ConstitutionAmendment: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Deposit: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ConstitutionAmendment: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
 			if wireType != 0 {
 				return fmt.Errorf("proto: wrong wireType = %d for field ProposalId", wireType)
 			}
@@ -2098,9 +6847,9 @@ func (m *Deposit) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
-		case 2:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Depositor", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Constitution", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2128,11 +6877,11 @@ func (m *Deposit) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Depositor = string(dAtA[iNdEx:postIndex])
+			m.Constitution = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 3:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field AmendedAt", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -2159,8 +6908,7 @@ func (m *Deposit) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Amount = append(m.Amount, types.Coin{})
-			if err := m.Amount[len(m.Amount)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.AmendedAt, dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -2185,7 +6933,7 @@ func (m *Deposit) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Proposal) Unmarshal(dAtA []byte) error {
+func (m *MetadataSchema) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2208,10 +6956,10 @@ func (m *Proposal) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Proposal: wiretype end group for non-group")
+			return fmt.Errorf("proto: MetadataSchema: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Proposal: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MetadataSchema: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -2235,9 +6983,9 @@ func (m *Proposal) Unmarshal(dAtA []byte) error {
 			}
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Messages", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGov
@@ -2247,50 +6995,29 @@ func (m *Proposal) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGov
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGov
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Messages = append(m.Messages, &types1.Any{})
-			if err := m.Messages[len(m.Messages)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Name = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
-			}
-			m.Status = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGov
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Status |= ProposalStatus(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field FinalTallyResult", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Uri", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGov
@@ -2300,33 +7027,29 @@ func (m *Proposal) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGov
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGov
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.FinalTallyResult == nil {
-				m.FinalTallyResult = &TallyResult{}
-			}
-			if err := m.FinalTallyResult.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Uri = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 5:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SubmitTime", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Hash", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGov
@@ -2336,67 +7059,77 @@ func (m *Proposal) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGov
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGov
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.SubmitTime == nil {
-				m.SubmitTime = new(time.Time)
-			}
-			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(m.SubmitTime, dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Hash = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 6:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DepositEndTime", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGov
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthGov
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGov(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthGov
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.DepositEndTime == nil {
-				m.DepositEndTime = new(time.Time)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DepositParams) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGov
 			}
-			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(m.DepositEndTime, dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
 			}
-			iNdEx = postIndex
-		case 7:
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DepositParams: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DepositParams: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TotalDeposit", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MinDeposit", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -2423,14 +7156,14 @@ func (m *Proposal) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.TotalDeposit = append(m.TotalDeposit, types.Coin{})
-			if err := m.TotalDeposit[len(m.TotalDeposit)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.MinDeposit = append(m.MinDeposit, types.Coin{})
+			if err := m.MinDeposit[len(m.MinDeposit)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 8:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field VotingStartTime", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxDepositPeriod", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -2457,16 +7190,66 @@ func (m *Proposal) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.VotingStartTime == nil {
-				m.VotingStartTime = new(time.Time)
+			if m.MaxDepositPeriod == nil {
+				m.MaxDepositPeriod = new(time.Duration)
 			}
-			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(m.VotingStartTime, dAtA[iNdEx:postIndex]); err != nil {
+			if err := github_com_cosmos_gogoproto_types.StdDurationUnmarshal(m.MaxDepositPeriod, dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 9:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGov(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGov
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *VotingParams) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGov
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: VotingParams: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: VotingParams: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field VotingEndTime", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field VotingPeriod", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -2493,16 +7276,66 @@ func (m *Proposal) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.VotingEndTime == nil {
-				m.VotingEndTime = new(time.Time)
+			if m.VotingPeriod == nil {
+				m.VotingPeriod = new(time.Duration)
 			}
-			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(m.VotingEndTime, dAtA[iNdEx:postIndex]); err != nil {
+			if err := github_com_cosmos_gogoproto_types.StdDurationUnmarshal(m.VotingPeriod, dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 10:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGov(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGov
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *TallyParams) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGov
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TallyParams: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TallyParams: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Metadata", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Quorum", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2530,11 +7363,11 @@ func (m *Proposal) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Metadata = string(dAtA[iNdEx:postIndex])
+			m.Quorum = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 11:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Title", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Threshold", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2562,11 +7395,11 @@ func (m *Proposal) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Title = string(dAtA[iNdEx:postIndex])
+			m.Threshold = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 12:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Summary", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field VetoThreshold", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2594,11 +7427,11 @@ func (m *Proposal) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Summary = string(dAtA[iNdEx:postIndex])
+			m.VetoThreshold = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 13:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Proposer", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field VetoQuorum", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2626,13 +7459,13 @@ func (m *Proposal) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Proposer = string(dAtA[iNdEx:postIndex])
+			m.VetoQuorum = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 14:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Expedited", wireType)
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProposalKindQuorums", wireType)
 			}
-			var v int
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGov
@@ -2642,12 +7475,26 @@ func (m *Proposal) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.Expedited = bool(v != 0)
+			if msglen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ProposalKindQuorums = append(m.ProposalKindQuorums, ProposalKindQuorum{})
+			if err := m.ProposalKindQuorums[len(m.ProposalKindQuorums)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGov(dAtA[iNdEx:])
@@ -2669,7 +7516,7 @@ func (m *Proposal) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *TallyResult) Unmarshal(dAtA []byte) error {
+func (m *Params) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2692,17 +7539,17 @@ func (m *TallyResult) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: TallyResult: wiretype end group for non-group")
+			return fmt.Errorf("proto: Params: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: TallyResult: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: Params: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field YesCount", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MinDeposit", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGov
@@ -2712,27 +7559,101 @@ func (m *TallyResult) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGov
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MinDeposit = append(m.MinDeposit, types.Coin{})
+			if err := m.MinDeposit[len(m.MinDeposit)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxDepositPeriod", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.MaxDepositPeriod == nil {
+				m.MaxDepositPeriod = new(time.Duration)
+			}
+			if err := github_com_cosmos_gogoproto_types.StdDurationUnmarshal(m.MaxDepositPeriod, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VotingPeriod", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGov
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.YesCount = string(dAtA[iNdEx:postIndex])
+			if m.VotingPeriod == nil {
+				m.VotingPeriod = new(time.Duration)
+			}
+			if err := github_com_cosmos_gogoproto_types.StdDurationUnmarshal(m.VotingPeriod, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 2:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AbstainCount", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Quorum", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2760,11 +7681,11 @@ func (m *TallyResult) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.AbstainCount = string(dAtA[iNdEx:postIndex])
+			m.Quorum = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 3:
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field NoCount", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Threshold", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2792,11 +7713,11 @@ func (m *TallyResult) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.NoCount = string(dAtA[iNdEx:postIndex])
+			m.Threshold = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 4:
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field NoWithVetoCount", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field VetoThreshold", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2824,80 +7745,11 @@ func (m *TallyResult) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.NoWithVetoCount = string(dAtA[iNdEx:postIndex])
+			m.VetoThreshold = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipGov(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthGov
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *Vote) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowGov
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: Vote: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Vote: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ProposalId", wireType)
-			}
-			m.ProposalId = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGov
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.ProposalId |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 2:
+		case 7:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Voter", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MinInitialDepositRatio", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2925,13 +7777,13 @@ func (m *Vote) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Voter = string(dAtA[iNdEx:postIndex])
+			m.MinInitialDepositRatio = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 4:
+		case 8:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Options", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ProposalCancelRatio", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGov
@@ -2941,29 +7793,27 @@ func (m *Vote) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGov
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGov
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Options = append(m.Options, &WeightedVoteOption{})
-			if err := m.Options[len(m.Options)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.ProposalCancelRatio = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 5:
+		case 9:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Metadata", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ProposalCancelDest", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2991,61 +7841,11 @@ func (m *Vote) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Metadata = string(dAtA[iNdEx:postIndex])
+			m.ProposalCancelDest = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipGov(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthGov
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *DepositParams) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowGov
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: DepositParams: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: DepositParams: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		case 10:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MinDeposit", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ExpeditedVotingPeriod", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -3072,16 +7872,18 @@ func (m *DepositParams) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.MinDeposit = append(m.MinDeposit, types.Coin{})
-			if err := m.MinDeposit[len(m.MinDeposit)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.ExpeditedVotingPeriod == nil {
+				m.ExpeditedVotingPeriod = new(time.Duration)
+			}
+			if err := github_com_cosmos_gogoproto_types.StdDurationUnmarshal(m.ExpeditedVotingPeriod, dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 2:
+		case 11:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MaxDepositPeriod", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ExpeditedThreshold", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGov
@@ -3091,81 +7893,27 @@ func (m *DepositParams) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthGov
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthGov
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.MaxDepositPeriod == nil {
-				m.MaxDepositPeriod = new(time.Duration)
-			}
-			if err := github_com_cosmos_gogoproto_types.StdDurationUnmarshal(m.MaxDepositPeriod, dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipGov(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthGov
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *VotingParams) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowGov
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: VotingParams: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: VotingParams: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ExpeditedThreshold = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 12:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field VotingPeriod", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ExpeditedMinDeposit", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -3192,66 +7940,74 @@ func (m *VotingParams) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.VotingPeriod == nil {
-				m.VotingPeriod = new(time.Duration)
-			}
-			if err := github_com_cosmos_gogoproto_types.StdDurationUnmarshal(m.VotingPeriod, dAtA[iNdEx:postIndex]); err != nil {
+			m.ExpeditedMinDeposit = append(m.ExpeditedMinDeposit, types.Coin{})
+			if err := m.ExpeditedMinDeposit[len(m.ExpeditedMinDeposit)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipGov(dAtA[iNdEx:])
-			if err != nil {
-				return err
+		case 13:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BurnVoteQuorum", wireType)
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthGov
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
+			m.BurnVoteQuorum = bool(v != 0)
+		case 14:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BurnProposalDepositPrevote", wireType)
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *TallyParams) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowGov
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
+			m.BurnProposalDepositPrevote = bool(v != 0)
+		case 15:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BurnVoteVeto", wireType)
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: TallyParams: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: TallyParams: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			m.BurnVoteVeto = bool(v != 0)
+		case 16:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Quorum", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DepositExtensionRatio", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3279,13 +8035,13 @@ func (m *TallyParams) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Quorum = string(dAtA[iNdEx:postIndex])
+			m.DepositExtensionRatio = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
+		case 17:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Threshold", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DepositExtensionPeriod", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGov
@@ -3295,27 +8051,31 @@ func (m *TallyParams) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGov
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGov
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Threshold = string(dAtA[iNdEx:postIndex])
+			if m.DepositExtensionPeriod == nil {
+				m.DepositExtensionPeriod = new(time.Duration)
+			}
+			if err := github_com_cosmos_gogoproto_types.StdDurationUnmarshal(m.DepositExtensionPeriod, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 3:
+		case 18:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field VetoThreshold", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ExecutionAuthorities", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3343,63 +8103,88 @@ func (m *TallyParams) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.VetoThreshold = string(dAtA[iNdEx:postIndex])
+			m.ExecutionAuthorities = append(m.ExecutionAuthorities, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipGov(dAtA[iNdEx:])
-			if err != nil {
-				return err
+		case 19:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DiscussionPeriod", wireType)
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
 				return ErrInvalidLengthGov
 			}
-			if (iNdEx + skippy) > l {
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *Params) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowGov
+			if m.DiscussionPeriod == nil {
+				m.DiscussionPeriod = new(time.Duration)
 			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
+			if err := github_com_cosmos_gogoproto_types.StdDurationUnmarshal(m.DiscussionPeriod, dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			iNdEx = postIndex
+		case 20:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EarlyResolution", wireType)
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: Params: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Params: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.EarlyResolution = bool(v != 0)
+		case 21:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxProposalsProcessedPerEndBlock", wireType)
+			}
+			m.MaxProposalsProcessedPerEndBlock = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxProposalsProcessedPerEndBlock |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 22:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MinDeposit", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ConstitutionAmendmentThreshold", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGov
@@ -3409,29 +8194,46 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGov
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGov
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.MinDeposit = append(m.MinDeposit, types.Coin{})
-			if err := m.MinDeposit[len(m.MinDeposit)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.ConstitutionAmendmentThreshold = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 23:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AbstainSemantics", wireType)
+			}
+			m.AbstainSemantics = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.AbstainSemantics |= AbstainSemantics(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			iNdEx = postIndex
-		case 2:
+		case 24:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MaxDepositPeriod", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field AcceptedDepositDenoms", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -3458,16 +8260,14 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.MaxDepositPeriod == nil {
-				m.MaxDepositPeriod = new(time.Duration)
-			}
-			if err := github_com_cosmos_gogoproto_types.StdDurationUnmarshal(m.MaxDepositPeriod, dAtA[iNdEx:postIndex]); err != nil {
+			m.AcceptedDepositDenoms = append(m.AcceptedDepositDenoms, AcceptedDepositDenom{})
+			if err := m.AcceptedDepositDenoms[len(m.AcceptedDepositDenoms)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 3:
+		case 25:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field VotingPeriod", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field AmendmentPeriod", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -3494,16 +8294,16 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.VotingPeriod == nil {
-				m.VotingPeriod = new(time.Duration)
+			if m.AmendmentPeriod == nil {
+				m.AmendmentPeriod = new(time.Duration)
 			}
-			if err := github_com_cosmos_gogoproto_types.StdDurationUnmarshal(m.VotingPeriod, dAtA[iNdEx:postIndex]); err != nil {
+			if err := github_com_cosmos_gogoproto_types.StdDurationUnmarshal(m.AmendmentPeriod, dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 4:
+		case 26:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Quorum", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ParticipationExemptionThreshold", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3531,13 +8331,33 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Quorum = string(dAtA[iNdEx:postIndex])
+			m.ParticipationExemptionThreshold = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 5:
+		case 27:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinDepositAnyDenom", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.MinDepositAnyDenom = bool(v != 0)
+		case 28:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Threshold", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RevealPeriod", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGov
@@ -3547,27 +8367,31 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGov
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGov
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Threshold = string(dAtA[iNdEx:postIndex])
+			if m.RevealPeriod == nil {
+				m.RevealPeriod = new(time.Duration)
+			}
+			if err := github_com_cosmos_gogoproto_types.StdDurationUnmarshal(m.RevealPeriod, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 6:
+		case 29:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field VetoThreshold", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field VetoQuorum", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3595,13 +8419,13 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.VetoThreshold = string(dAtA[iNdEx:postIndex])
+			m.VetoQuorum = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 7:
+		case 30:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MinInitialDepositRatio", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ProposalKindQuorums", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGov
@@ -3611,27 +8435,79 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGov
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGov
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.MinInitialDepositRatio = string(dAtA[iNdEx:postIndex])
+			m.ProposalKindQuorums = append(m.ProposalKindQuorums, ProposalKindQuorum{})
+			if err := m.ProposalKindQuorums[len(m.ProposalKindQuorums)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 8:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGov(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGov
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ProposalKindQuorum) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGov
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ProposalKindQuorum: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ProposalKindQuorum: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ProposalCancelRatio", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Kind", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3659,11 +8535,11 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ProposalCancelRatio = string(dAtA[iNdEx:postIndex])
+			m.Kind = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 9:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ProposalCancelDest", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Quorum", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3691,13 +8567,13 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ProposalCancelDest = string(dAtA[iNdEx:postIndex])
+			m.Quorum = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 10:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ExpeditedVotingPeriod", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field VetoQuorum", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGov
@@ -3707,31 +8583,77 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGov
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGov
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.ExpeditedVotingPeriod == nil {
-				m.ExpeditedVotingPeriod = new(time.Duration)
-			}
-			if err := github_com_cosmos_gogoproto_types.StdDurationUnmarshal(m.ExpeditedVotingPeriod, dAtA[iNdEx:postIndex]); err != nil {
+			m.VetoQuorum = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGov(dAtA[iNdEx:])
+			if err != nil {
 				return err
 			}
-			iNdEx = postIndex
-		case 11:
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGov
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *AcceptedDepositDenom) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGov
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AcceptedDepositDenom: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AcceptedDepositDenom: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ExpeditedThreshold", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3759,13 +8681,13 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ExpeditedThreshold = string(dAtA[iNdEx:postIndex])
+			m.Denom = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 12:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ExpeditedMinDeposit", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field BaseDenom", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGov
@@ -3775,31 +8697,29 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGov
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGov
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ExpeditedMinDeposit = append(m.ExpeditedMinDeposit, types.Coin{})
-			if err := m.ExpeditedMinDeposit[len(m.ExpeditedMinDeposit)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.BaseDenom = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 13:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BurnVoteQuorum", wireType)
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Ratio", wireType)
 			}
-			var v int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGov
@@ -3809,35 +8729,27 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.BurnVoteQuorum = bool(v != 0)
-		case 14:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BurnProposalDepositPrevote", wireType)
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGov
 			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGov
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
 			}
-			m.BurnProposalDepositPrevote = bool(v != 0)
-		case 15:
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Ratio = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BurnVoteVeto", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field UseOracle", wireType)
 			}
 			var v int
 			for shift := uint(0); ; shift += 7 {
@@ -3854,7 +8766,7 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
-			m.BurnVoteVeto = bool(v != 0)
+			m.UseOracle = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGov(dAtA[iNdEx:])
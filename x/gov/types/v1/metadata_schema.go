@@ -0,0 +1,43 @@
+package v1
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"strings"
+
+	"cosmossdk.io/errors"
+
+	"github.com/cosmos/cosmos-sdk/x/gov/types"
+)
+
+// NewMetadataSchema creates a new MetadataSchema instance
+func NewMetadataSchema(id uint64, name, uri, hash string) MetadataSchema {
+	return MetadataSchema{
+		Id:   id,
+		Name: name,
+		Uri:  uri,
+		Hash: hash,
+	}
+}
+
+// MetadataSchemaHash returns the hex encoded SHA-256 hash of a schema
+// document, as registered on chain.
+func MetadataSchemaHash(document []byte) string {
+	hash := sha256.Sum256(document)
+	return hex.EncodeToString(hash[:])
+}
+
+// ValidateBasic performs basic validation of the metadata schema, its id
+// excepted.
+func (s MetadataSchema) ValidateBasic() error {
+	if strings.TrimSpace(s.Name) == "" {
+		return errors.Wrap(types.ErrInvalidMetadataSchema, "name cannot be blank")
+	}
+
+	hash, err := hex.DecodeString(s.Hash)
+	if err != nil || len(hash) != sha256.Size || s.Hash != strings.ToLower(s.Hash) {
+		return errors.Wrapf(types.ErrInvalidMetadataSchema, "hash must be a lowercase hex encoded SHA-256 hash, got %q", s.Hash)
+	}
+
+	return nil
+}
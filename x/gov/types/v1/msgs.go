@@ -10,9 +10,9 @@ import (
 )
 
 var (
-	_, _, _, _, _, _, _ sdk.Msg                            = &MsgSubmitProposal{}, &MsgDeposit{}, &MsgVote{}, &MsgVoteWeighted{}, &MsgExecLegacyContent{}, &MsgUpdateParams{}, &MsgCancelProposal{}
-	_, _, _, _, _, _, _ legacytx.LegacyMsg                 = &MsgSubmitProposal{}, &MsgDeposit{}, &MsgVote{}, &MsgVoteWeighted{}, &MsgExecLegacyContent{}, &MsgUpdateParams{}, &MsgCancelProposal{}
-	_, _                codectypes.UnpackInterfacesMessage = &MsgSubmitProposal{}, &MsgExecLegacyContent{}
+	_, _, _, _, _, _, _, _, _, _, _, _, _, _, _, _ sdk.Msg                            = &MsgSubmitProposal{}, &MsgDeposit{}, &MsgVote{}, &MsgVoteWeighted{}, &MsgExecLegacyContent{}, &MsgUpdateParams{}, &MsgCancelProposal{}, &MsgBatchUpdateParams{}, &MsgUpdateProposalMetadata{}, &MsgWithdrawDeposit{}, &MsgAmendConstitution{}, &MsgRetractVote{}, &MsgAmendProposal{}, &MsgCommitVote{}, &MsgRevealVote{}, &MsgRegisterMetadataSchema{}
+	_, _, _, _, _, _, _, _, _, _, _, _, _, _, _, _ legacytx.LegacyMsg                 = &MsgSubmitProposal{}, &MsgDeposit{}, &MsgVote{}, &MsgVoteWeighted{}, &MsgExecLegacyContent{}, &MsgUpdateParams{}, &MsgCancelProposal{}, &MsgBatchUpdateParams{}, &MsgUpdateProposalMetadata{}, &MsgWithdrawDeposit{}, &MsgAmendConstitution{}, &MsgRetractVote{}, &MsgAmendProposal{}, &MsgCommitVote{}, &MsgRevealVote{}, &MsgRegisterMetadataSchema{}
+	_, _, _, _                                     codectypes.UnpackInterfacesMessage = &MsgSubmitProposal{}, &MsgExecLegacyContent{}, &MsgBatchUpdateParams{}, &MsgAmendProposal{}
 )
 
 // NewMsgSubmitProposal creates a new MsgSubmitProposal.
@@ -77,7 +77,7 @@ func (m MsgSubmitProposal) UnpackInterfaces(unpacker codectypes.AnyUnpacker) err
 
 // NewMsgDeposit creates a new MsgDeposit instance
 func NewMsgDeposit(depositor sdk.AccAddress, proposalID uint64, amount sdk.Coins) *MsgDeposit {
-	return &MsgDeposit{proposalID, depositor.String(), amount}
+	return &MsgDeposit{ProposalId: proposalID, Depositor: depositor.String(), Amount: amount}
 }
 
 // GetSignBytes returns the message bytes to sign over.
@@ -94,7 +94,7 @@ func (msg MsgDeposit) GetSigners() []sdk.AccAddress {
 
 // NewMsgVote creates a message to cast a vote on an active proposal
 func NewMsgVote(voter sdk.AccAddress, proposalID uint64, option VoteOption, metadata string) *MsgVote {
-	return &MsgVote{proposalID, voter.String(), option, metadata}
+	return &MsgVote{ProposalId: proposalID, Voter: voter.String(), Option: option, Metadata: metadata}
 }
 
 // GetSignBytes returns the message bytes to sign over.
@@ -111,7 +111,7 @@ func (msg MsgVote) GetSigners() []sdk.AccAddress {
 
 // NewMsgVoteWeighted creates a message to cast a vote on an active proposal
 func NewMsgVoteWeighted(voter sdk.AccAddress, proposalID uint64, options WeightedVoteOptions, metadata string) *MsgVoteWeighted {
-	return &MsgVoteWeighted{proposalID, voter.String(), options, metadata}
+	return &MsgVoteWeighted{ProposalId: proposalID, Voter: voter.String(), Options: options, Metadata: metadata}
 }
 
 // GetSignBytes returns the message bytes to sign over.
@@ -193,3 +193,227 @@ func (msg MsgCancelProposal) GetSigners() []sdk.AccAddress {
 	proposer, _ := sdk.AccAddressFromBech32(msg.Proposer)
 	return []sdk.AccAddress{proposer}
 }
+
+// NewMsgBatchUpdateParams creates a new MsgBatchUpdateParams instance.
+func NewMsgBatchUpdateParams(messages []sdk.Msg, authority string) (*MsgBatchUpdateParams, error) {
+	anys, err := sdktx.SetMsgs(messages)
+	if err != nil {
+		return nil, err
+	}
+
+	return &MsgBatchUpdateParams{
+		Authority: authority,
+		Messages:  anys,
+	}, nil
+}
+
+// GetMsgs unpacks m.Messages Any's into sdk.Msg's
+func (m *MsgBatchUpdateParams) GetMsgs() ([]sdk.Msg, error) {
+	return sdktx.GetMsgs(m.Messages, "sdk.MsgBatchUpdateParams")
+}
+
+// GetSignBytes returns the message bytes to sign over.
+func (m MsgBatchUpdateParams) GetSignBytes() []byte {
+	bz := codec.Amino.MustMarshalJSON(&m)
+	return sdk.MustSortJSON(bz)
+}
+
+// GetSigners returns the expected signers for a MsgBatchUpdateParams.
+func (m MsgBatchUpdateParams) GetSigners() []sdk.AccAddress {
+	authority, _ := sdk.AccAddressFromBech32(m.Authority)
+	return []sdk.AccAddress{authority}
+}
+
+// UnpackInterfaces implements UnpackInterfacesMessage.UnpackInterfaces
+func (m MsgBatchUpdateParams) UnpackInterfaces(unpacker codectypes.AnyUnpacker) error {
+	return sdktx.UnpackInterfaces(unpacker, m.Messages)
+}
+
+// NewMsgUpdateProposalMetadata creates a new MsgUpdateProposalMetadata instance.
+func NewMsgUpdateProposalMetadata(proposalID uint64, proposer, metadata string) *MsgUpdateProposalMetadata {
+	return &MsgUpdateProposalMetadata{
+		ProposalId: proposalID,
+		Proposer:   proposer,
+		Metadata:   metadata,
+	}
+}
+
+// GetSignBytes implements Msg
+func (msg MsgUpdateProposalMetadata) GetSignBytes() []byte {
+	bz := codec.Amino.MustMarshalJSON(&msg)
+	return sdk.MustSortJSON(bz)
+}
+
+// GetSigners implements Msg
+func (msg MsgUpdateProposalMetadata) GetSigners() []sdk.AccAddress {
+	proposer, _ := sdk.AccAddressFromBech32(msg.Proposer)
+	return []sdk.AccAddress{proposer}
+}
+
+// NewMsgWithdrawDeposit creates a new MsgWithdrawDeposit instance.
+func NewMsgWithdrawDeposit(proposalID uint64, depositor string) *MsgWithdrawDeposit {
+	return &MsgWithdrawDeposit{
+		ProposalId: proposalID,
+		Depositor:  depositor,
+	}
+}
+
+// GetSignBytes implements Msg
+func (msg MsgWithdrawDeposit) GetSignBytes() []byte {
+	bz := codec.Amino.MustMarshalJSON(&msg)
+	return sdk.MustSortJSON(bz)
+}
+
+// GetSigners implements Msg
+func (msg MsgWithdrawDeposit) GetSigners() []sdk.AccAddress {
+	depositor, _ := sdk.AccAddressFromBech32(msg.Depositor)
+	return []sdk.AccAddress{depositor}
+}
+
+// NewMsgAmendConstitution creates a new MsgAmendConstitution instance.
+func NewMsgAmendConstitution(authority, constitution string) *MsgAmendConstitution {
+	return &MsgAmendConstitution{
+		Authority:    authority,
+		Constitution: constitution,
+	}
+}
+
+// GetSignBytes implements Msg
+func (msg MsgAmendConstitution) GetSignBytes() []byte {
+	bz := codec.Amino.MustMarshalJSON(&msg)
+	return sdk.MustSortJSON(bz)
+}
+
+// GetSigners implements Msg
+func (msg MsgAmendConstitution) GetSigners() []sdk.AccAddress {
+	authority, _ := sdk.AccAddressFromBech32(msg.Authority)
+	return []sdk.AccAddress{authority}
+}
+
+// NewMsgRetractVote creates a new MsgRetractVote instance.
+func NewMsgRetractVote(proposalID uint64, voter string) *MsgRetractVote {
+	return &MsgRetractVote{
+		ProposalId: proposalID,
+		Voter:      voter,
+	}
+}
+
+// GetSignBytes implements Msg
+func (msg MsgRetractVote) GetSignBytes() []byte {
+	bz := codec.Amino.MustMarshalJSON(&msg)
+	return sdk.MustSortJSON(bz)
+}
+
+// GetSigners implements Msg
+func (msg MsgRetractVote) GetSigners() []sdk.AccAddress {
+	voter, _ := sdk.AccAddressFromBech32(msg.Voter)
+	return []sdk.AccAddress{voter}
+}
+
+// NewMsgCommitVote creates a new MsgCommitVote instance.
+func NewMsgCommitVote(proposalID uint64, voter string, commitment []byte) *MsgCommitVote {
+	return &MsgCommitVote{
+		ProposalId: proposalID,
+		Voter:      voter,
+		Commitment: commitment,
+	}
+}
+
+// GetSignBytes implements Msg
+func (msg MsgCommitVote) GetSignBytes() []byte {
+	bz := codec.Amino.MustMarshalJSON(&msg)
+	return sdk.MustSortJSON(bz)
+}
+
+// GetSigners implements Msg
+func (msg MsgCommitVote) GetSigners() []sdk.AccAddress {
+	voter, _ := sdk.AccAddressFromBech32(msg.Voter)
+	return []sdk.AccAddress{voter}
+}
+
+// NewMsgRevealVote creates a new MsgRevealVote instance.
+func NewMsgRevealVote(proposalID uint64, voter string, options WeightedVoteOptions, salt []byte, metadata string) *MsgRevealVote {
+	return &MsgRevealVote{
+		ProposalId: proposalID,
+		Voter:      voter,
+		Options:    options,
+		Salt:       salt,
+		Metadata:   metadata,
+	}
+}
+
+// GetSignBytes implements Msg
+func (msg MsgRevealVote) GetSignBytes() []byte {
+	bz := codec.Amino.MustMarshalJSON(&msg)
+	return sdk.MustSortJSON(bz)
+}
+
+// GetSigners implements Msg
+func (msg MsgRevealVote) GetSigners() []sdk.AccAddress {
+	voter, _ := sdk.AccAddressFromBech32(msg.Voter)
+	return []sdk.AccAddress{voter}
+}
+
+// NewMsgAmendProposal creates a new MsgAmendProposal instance.
+func NewMsgAmendProposal(proposalID uint64, proposer string, messages []sdk.Msg, metadata, title, summary string) (*MsgAmendProposal, error) {
+	m := &MsgAmendProposal{
+		ProposalId: proposalID,
+		Proposer:   proposer,
+		Metadata:   metadata,
+		Title:      title,
+		Summary:    summary,
+	}
+
+	anys, err := sdktx.SetMsgs(messages)
+	if err != nil {
+		return nil, err
+	}
+
+	m.Messages = anys
+
+	return m, nil
+}
+
+// GetMsgs unpacks m.Messages Any's into sdk.Msg's
+func (m *MsgAmendProposal) GetMsgs() ([]sdk.Msg, error) {
+	return sdktx.GetMsgs(m.Messages, "sdk.MsgProposal")
+}
+
+// GetSignBytes implements Msg
+func (m MsgAmendProposal) GetSignBytes() []byte {
+	bz := codec.Amino.MustMarshalJSON(&m)
+	return sdk.MustSortJSON(bz)
+}
+
+// GetSigners implements Msg
+func (m MsgAmendProposal) GetSigners() []sdk.AccAddress {
+	proposer, _ := sdk.AccAddressFromBech32(m.Proposer)
+	return []sdk.AccAddress{proposer}
+}
+
+// UnpackInterfaces implements UnpackInterfacesMessage.UnpackInterfaces
+func (m MsgAmendProposal) UnpackInterfaces(unpacker codectypes.AnyUnpacker) error {
+	return sdktx.UnpackInterfaces(unpacker, m.Messages)
+}
+
+// NewMsgRegisterMetadataSchema creates a new MsgRegisterMetadataSchema instance.
+func NewMsgRegisterMetadataSchema(authority, name, uri, hash string) *MsgRegisterMetadataSchema {
+	return &MsgRegisterMetadataSchema{
+		Authority: authority,
+		Name:      name,
+		Uri:       uri,
+		Hash:      hash,
+	}
+}
+
+// GetSignBytes implements Msg
+func (msg MsgRegisterMetadataSchema) GetSignBytes() []byte {
+	bz := codec.Amino.MustMarshalJSON(&msg)
+	return sdk.MustSortJSON(bz)
+}
+
+// GetSigners implements Msg
+func (msg MsgRegisterMetadataSchema) GetSigners() []sdk.AccAddress {
+	authority, _ := sdk.AccAddressFromBech32(msg.Authority)
+	return []sdk.AccAddress{authority}
+}
@@ -16,6 +16,13 @@ const (
 	DefaultMinExpeditedDepositTokensRatio               = 5
 )
 
+const (
+	AbstainSemanticsUnspecified = AbstainSemantics_ABSTAIN_SEMANTICS_UNSPECIFIED
+	AbstainSemanticsQuorumOnly  = AbstainSemantics_ABSTAIN_SEMANTICS_QUORUM_ONLY
+	AbstainSemanticsThreshold   = AbstainSemantics_ABSTAIN_SEMANTICS_THRESHOLD
+	AbstainSemanticsIgnored     = AbstainSemantics_ABSTAIN_SEMANTICS_IGNORED
+)
+
 // Default governance params
 var (
 	DefaultMinDepositTokens          = sdkmath.NewInt(10000000)
@@ -24,12 +31,22 @@ var (
 	DefaultThreshold                 = sdkmath.LegacyNewDecWithPrec(5, 1)
 	DefaultExpeditedThreshold        = sdkmath.LegacyNewDecWithPrec(667, 3)
 	DefaultVetoThreshold             = sdkmath.LegacyNewDecWithPrec(334, 3)
+	DefaultConstitutionThreshold     = sdkmath.LegacyNewDecWithPrec(9, 1)
 	DefaultMinInitialDepositRatio    = sdkmath.LegacyZeroDec()
 	DefaultProposalCancelRatio       = sdkmath.LegacyMustNewDecFromStr("0.5")
 	DefaultProposalCancelDestAddress = ""
 	DefaultBurnProposalPrevote       = false // set to false to replicate behavior of when this change was made (0.47)
 	DefaultBurnVoteQuorom            = false // set to false to  replicate behavior of when this change was made (0.47)
 	DefaultBurnVoteVeto              = true  // set to true to replicate behavior of when this change was made (0.47)
+	DefaultDepositExtensionRatio     = sdkmath.LegacyNewDecWithPrec(8, 1)
+	DefaultDepositExtensionPeriod    = time.Duration(0) // deposit period extensions are disabled by default
+	DefaultDiscussionPeriod          = time.Duration(0) // the discussion period is disabled by default
+	DefaultAmendmentPeriod           = time.Duration(0) // amendments do not extend the discussion period by default
+	DefaultRevealPeriod              = time.Duration(0) // commit-reveal voting is disabled by default
+	DefaultMaxProposalsPerEndBlock   = uint64(0)        // the number of proposals tallied per block is unlimited by default
+	DefaultAbstainSemantics          = AbstainSemanticsQuorumOnly
+	DefaultParticipationExemption    = sdkmath.LegacyZeroDec() // no validator is exempt from participation tracking by default
+	DefaultVetoQuorum                = sdkmath.LegacyZeroDec() // the Veto votes always count by default
 )
 
 // Deprecated: NewDepositParams creates a new DepositParams object
@@ -82,7 +99,7 @@ func NewParams(
 
 // DefaultParams returns the default governance params
 func DefaultParams() Params {
-	return NewParams(
+	params := NewParams(
 		sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, DefaultMinDepositTokens)),
 		sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, DefaultMinExpeditedDepositTokens)),
 		DefaultPeriod,
@@ -99,6 +116,22 @@ func DefaultParams() Params {
 		DefaultBurnVoteQuorom,
 		DefaultBurnVoteVeto,
 	)
+	depositExtensionPeriod := DefaultDepositExtensionPeriod
+	params.DepositExtensionRatio = DefaultDepositExtensionRatio.String()
+	params.DepositExtensionPeriod = &depositExtensionPeriod
+	discussionPeriod := DefaultDiscussionPeriod
+	params.DiscussionPeriod = &discussionPeriod
+	amendmentPeriod := DefaultAmendmentPeriod
+	params.AmendmentPeriod = &amendmentPeriod
+	revealPeriod := DefaultRevealPeriod
+	params.RevealPeriod = &revealPeriod
+	params.MaxProposalsProcessedPerEndBlock = DefaultMaxProposalsPerEndBlock
+	params.ConstitutionAmendmentThreshold = DefaultConstitutionThreshold.String()
+	params.AbstainSemantics = DefaultAbstainSemantics
+	params.ParticipationExemptionThreshold = DefaultParticipationExemption.String()
+	params.VetoQuorum = DefaultVetoQuorum.String()
+
+	return params
 }
 
 // ValidateBasic performs basic validation on governance parameters.
@@ -215,5 +248,324 @@ func (p Params) ValidateBasic() error {
 		}
 	}
 
+	if p.DepositExtensionPeriod != nil && p.DepositExtensionPeriod.Seconds() < 0 {
+		return fmt.Errorf("deposit extension period must not be negative: %d", p.DepositExtensionPeriod)
+	}
+
+	if len(p.DepositExtensionRatio) != 0 || p.DepositExtensionEnabled() {
+		depositExtensionRatio, err := sdkmath.LegacyNewDecFromStr(p.DepositExtensionRatio)
+		if err != nil {
+			return fmt.Errorf("invalid deposit extension ratio string: %w", err)
+		}
+		if !depositExtensionRatio.IsPositive() {
+			return fmt.Errorf("deposit extension ratio must be positive: %s", depositExtensionRatio)
+		}
+		if depositExtensionRatio.GTE(sdkmath.LegacyOneDec()) {
+			return fmt.Errorf("deposit extension ratio must be less than 1: %s", depositExtensionRatio)
+		}
+	}
+
+	if p.DiscussionPeriod != nil && p.DiscussionPeriod.Seconds() < 0 {
+		return fmt.Errorf("discussion period must not be negative: %d", p.DiscussionPeriod)
+	}
+
+	if p.AmendmentPeriod != nil && p.AmendmentPeriod.Seconds() < 0 {
+		return fmt.Errorf("amendment period must not be negative: %d", p.AmendmentPeriod)
+	}
+
+	if p.RevealPeriod != nil && p.RevealPeriod.Seconds() < 0 {
+		return fmt.Errorf("reveal period must not be negative: %d", p.RevealPeriod)
+	}
+
+	if len(p.ConstitutionAmendmentThreshold) != 0 {
+		constitutionThreshold, err := sdkmath.LegacyNewDecFromStr(p.ConstitutionAmendmentThreshold)
+		if err != nil {
+			return fmt.Errorf("invalid constitution amendment threshold string: %w", err)
+		}
+		if constitutionThreshold.GT(sdkmath.LegacyOneDec()) {
+			return fmt.Errorf("constitution amendment threshold too large: %s", constitutionThreshold)
+		}
+		if constitutionThreshold.LT(threshold) {
+			return fmt.Errorf("constitution amendment threshold %s must not be less than the regular threshold %s", constitutionThreshold, threshold)
+		}
+	}
+
+	if len(p.ParticipationExemptionThreshold) != 0 {
+		exemptionThreshold, err := sdkmath.LegacyNewDecFromStr(p.ParticipationExemptionThreshold)
+		if err != nil {
+			return fmt.Errorf("invalid participation exemption threshold string: %w", err)
+		}
+		if exemptionThreshold.IsNegative() {
+			return fmt.Errorf("participation exemption threshold must not be negative: %s", exemptionThreshold)
+		}
+		if exemptionThreshold.GT(sdkmath.LegacyOneDec()) {
+			return fmt.Errorf("participation exemption threshold too large: %s", exemptionThreshold)
+		}
+	}
+
+	if err := validateOptionalQuorum("veto quorum", p.VetoQuorum); err != nil {
+		return err
+	}
+
+	seenKinds := make(map[string]bool, len(p.ProposalKindQuorums))
+	for _, q := range p.ProposalKindQuorums {
+		if len(q.Kind) == 0 {
+			return fmt.Errorf("proposal kind quorum kind cannot be empty")
+		}
+		if seenKinds[q.Kind] {
+			return fmt.Errorf("duplicate proposal kind quorum: %s", q.Kind)
+		}
+		seenKinds[q.Kind] = true
+
+		if err := validateOptionalQuorum(fmt.Sprintf("%s quorum", q.Kind), q.Quorum); err != nil {
+			return err
+		}
+		if err := validateOptionalQuorum(fmt.Sprintf("%s veto quorum", q.Kind), q.VetoQuorum); err != nil {
+			return err
+		}
+	}
+
+	if _, ok := AbstainSemantics_name[int32(p.AbstainSemantics)]; !ok {
+		return fmt.Errorf("invalid abstain semantics: %s", p.AbstainSemantics)
+	}
+
+	if err := p.validateAcceptedDepositDenoms(); err != nil {
+		return err
+	}
+
+	seenAuthorities := make(map[string]bool, len(p.ExecutionAuthorities))
+	for _, authority := range p.ExecutionAuthorities {
+		if _, err := sdk.AccAddressFromBech32(authority); err != nil {
+			return fmt.Errorf("execution authority address is invalid: %s", authority)
+		}
+		if seenAuthorities[authority] {
+			return fmt.Errorf("duplicate execution authority: %s", authority)
+		}
+		seenAuthorities[authority] = true
+	}
+
 	return nil
 }
+
+// validateOptionalQuorum checks that a quorum, if set, is between 0 and 1.
+func validateOptionalQuorum(name, quorum string) error {
+	if len(quorum) == 0 {
+		return nil
+	}
+
+	q, err := sdkmath.LegacyNewDecFromStr(quorum)
+	if err != nil {
+		return fmt.Errorf("invalid %s string: %w", name, err)
+	}
+	if q.IsNegative() {
+		return fmt.Errorf("%s cannot be negative: %s", name, q)
+	}
+	if q.GT(sdkmath.LegacyOneDec()) {
+		return fmt.Errorf("%s too large: %s", name, q)
+	}
+
+	return nil
+}
+
+// validateAcceptedDepositDenoms checks that the accepted deposit denoms are
+// distinct from each other and from the minimum deposit denoms, and that their
+// base denoms are minimum deposit denoms.
+func (p Params) validateAcceptedDepositDenoms() error {
+	minDepositDenoms := make(map[string]bool)
+	for _, coin := range p.MinDeposit {
+		minDepositDenoms[coin.Denom] = true
+	}
+	for _, coin := range p.ExpeditedMinDeposit {
+		minDepositDenoms[coin.Denom] = true
+	}
+
+	seenDenoms := make(map[string]bool, len(p.AcceptedDepositDenoms))
+	for _, accepted := range p.AcceptedDepositDenoms {
+		if err := sdk.ValidateDenom(accepted.Denom); err != nil {
+			return fmt.Errorf("invalid accepted deposit denom: %w", err)
+		}
+		if minDepositDenoms[accepted.Denom] {
+			return fmt.Errorf("accepted deposit denom %s must not be a minimum deposit denom", accepted.Denom)
+		}
+		if seenDenoms[accepted.Denom] {
+			return fmt.Errorf("duplicate accepted deposit denom: %s", accepted.Denom)
+		}
+		seenDenoms[accepted.Denom] = true
+
+		if !minDepositDenoms[accepted.BaseDenom] {
+			return fmt.Errorf("base denom %s of accepted deposit denom %s must be a minimum deposit denom", accepted.BaseDenom, accepted.Denom)
+		}
+
+		ratio, err := sdkmath.LegacyNewDecFromStr(accepted.Ratio)
+		if err != nil {
+			return fmt.Errorf("invalid ratio string of accepted deposit denom %s: %w", accepted.Denom, err)
+		}
+		if !ratio.IsPositive() {
+			return fmt.Errorf("ratio of accepted deposit denom %s must be positive: %s", accepted.Denom, ratio)
+		}
+	}
+
+	return nil
+}
+
+// DepositValue returns the value of a deposit in minimum deposit denoms: the
+// amounts of the accepted deposit denoms are converted to their base denom,
+// truncating, and added to it, while the amounts of the other denoms are left
+// as is.
+func (p Params) DepositValue(deposit sdk.Coins) sdk.Coins {
+	value := sdk.NewCoins()
+	for _, coin := range deposit {
+		accepted, ok := p.acceptedDepositDenom(coin.Denom)
+		if !ok {
+			value = value.Add(coin)
+			continue
+		}
+
+		ratio := sdkmath.LegacyMustNewDecFromStr(accepted.Ratio)
+		value = value.Add(sdk.NewCoin(accepted.BaseDenom, ratio.MulInt(coin.Amount).TruncateInt()))
+	}
+
+	return value
+}
+
+// MeetsDeposit returns true if the value of a deposit reaches a threshold
+// expressed in minimum deposit denoms. The value must reach the amounts of all
+// the threshold denoms, or of any one of them if MinDepositAnyDenom is set.
+func (p Params) MeetsDeposit(deposit, threshold sdk.Coins) bool {
+	value := p.DepositValue(deposit)
+	if !p.MinDepositAnyDenom || threshold.Empty() {
+		return value.IsAllGTE(threshold)
+	}
+
+	for _, coin := range threshold {
+		if value.AmountOf(coin.Denom).GTE(coin.Amount) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// IsDepositDenom returns true if the denom can be deposited on proposals, that
+// is if it is a minimum deposit denom or an accepted deposit denom.
+func (p Params) IsDepositDenom(denom string) bool {
+	for _, coin := range p.MinDeposit {
+		if coin.Denom == denom {
+			return true
+		}
+	}
+	for _, coin := range p.ExpeditedMinDeposit {
+		if coin.Denom == denom {
+			return true
+		}
+	}
+
+	_, ok := p.acceptedDepositDenom(denom)
+	return ok
+}
+
+func (p Params) acceptedDepositDenom(denom string) (AcceptedDepositDenom, bool) {
+	for _, accepted := range p.AcceptedDepositDenoms {
+		if accepted.Denom == denom {
+			return accepted, true
+		}
+	}
+
+	return AcceptedDepositDenom{}, false
+}
+
+// ConstitutionThreshold returns the threshold of constitution amendment
+// proposals, falling back to DefaultConstitutionThreshold if it is not set.
+func (p Params) ConstitutionThreshold() string {
+	if len(p.ConstitutionAmendmentThreshold) == 0 {
+		return DefaultConstitutionThreshold.String()
+	}
+
+	return p.ConstitutionAmendmentThreshold
+}
+
+// TallyQuorums returns the quorum, and the veto quorum, of the proposals of
+// the given kind: the ones set for the kind, falling back to the ones of the
+// params. An unset veto quorum is zero, i.e. the Veto votes always count.
+func (p Params) TallyQuorums(kind ProposalKind) (quorum, vetoQuorum sdkmath.LegacyDec) {
+	quorumStr, vetoQuorumStr := p.Quorum, p.VetoQuorum
+	for _, q := range p.ProposalKindQuorums {
+		if q.Kind != string(kind) {
+			continue
+		}
+		if len(q.Quorum) != 0 {
+			quorumStr = q.Quorum
+		}
+		if len(q.VetoQuorum) != 0 {
+			vetoQuorumStr = q.VetoQuorum
+		}
+		break
+	}
+
+	quorum, _ = sdkmath.LegacyNewDecFromStr(quorumStr)
+	vetoQuorum = sdkmath.LegacyZeroDec()
+	if len(vetoQuorumStr) != 0 {
+		vetoQuorum, _ = sdkmath.LegacyNewDecFromStr(vetoQuorumStr)
+	}
+
+	return quorum, vetoQuorum
+}
+
+// DiscussionEnabled returns true if proposals reaching the minimum deposit
+// enter a discussion period before their voting period.
+func (p Params) DiscussionEnabled() bool {
+	return p.DiscussionPeriod != nil && *p.DiscussionPeriod > 0
+}
+
+// AmendmentExtensionEnabled returns true if amending a proposal extends its
+// discussion period to last at least the amendment period.
+func (p Params) AmendmentExtensionEnabled() bool {
+	return p.AmendmentPeriod != nil && *p.AmendmentPeriod > 0
+}
+
+// CommitRevealEnabled returns true if proposals can be submitted with
+// commit-reveal voting.
+func (p Params) CommitRevealEnabled() bool {
+	return p.RevealPeriod != nil && *p.RevealPeriod > 0
+}
+
+// ParticipationExemption returns the fraction of the total bonded tokens
+// below which a validator is exempt from governance participation tracking,
+// falling back to DefaultParticipationExemption if it is not set.
+func (p Params) ParticipationExemption() sdkmath.LegacyDec {
+	if len(p.ParticipationExemptionThreshold) == 0 {
+		return DefaultParticipationExemption
+	}
+
+	return sdkmath.LegacyMustNewDecFromStr(p.ParticipationExemptionThreshold)
+}
+
+// IsParticipationExempt returns true if a validator with the given bonded
+// tokens is exempt from governance participation tracking, given the total
+// bonded tokens.
+func (p Params) IsParticipationExempt(bondedTokens, totalBonded sdkmath.Int) bool {
+	threshold := p.ParticipationExemption()
+	if !threshold.IsPositive() || !totalBonded.IsPositive() {
+		return false
+	}
+
+	return sdkmath.LegacyNewDecFromInt(bondedTokens).Quo(sdkmath.LegacyNewDecFromInt(totalBonded)).LT(threshold)
+}
+
+// IsExecutionAuthority returns true if proposals may designate the given
+// address as the authority executing their messages.
+func (p Params) IsExecutionAuthority(authority string) bool {
+	for _, a := range p.ExecutionAuthorities {
+		if a == authority {
+			return true
+		}
+	}
+
+	return false
+}
+
+// DepositExtensionEnabled returns true if the deposit period of proposals
+// nearing the minimum deposit can be extended.
+func (p Params) DepositExtensionEnabled() bool {
+	return p.DepositExtensionPeriod != nil && *p.DepositExtensionPeriod > 0
+}
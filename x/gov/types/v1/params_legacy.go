@@ -80,7 +80,7 @@ func validateTallyParams(i interface{}) error {
 		return fmt.Errorf("veto threshold too large: %s", v)
 	}
 
-	return nil
+	return validateOptionalQuorum("veto quorum", v.VetoQuorum)
 }
 
 func validateVotingParams(i interface{}) error {
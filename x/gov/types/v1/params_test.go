@@ -0,0 +1,152 @@
+package v1_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	sdkmath "cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	v1 "github.com/cosmos/cosmos-sdk/x/gov/types/v1"
+)
+
+func TestParamsDepositValue(t *testing.T) {
+	params := v1.DefaultParams()
+	params.AcceptedDepositDenoms = []v1.AcceptedDepositDenom{
+		{Denom: "lstake", BaseDenom: sdk.DefaultBondDenom, Ratio: "1.5"},
+		{Denom: "usd", BaseDenom: sdk.DefaultBondDenom, Ratio: "0.3"},
+	}
+	require.NoError(t, params.ValidateBasic())
+
+	testCases := []struct {
+		name    string
+		deposit sdk.Coins
+		expect  sdk.Coins
+	}{
+		{
+			name:    "empty",
+			deposit: sdk.NewCoins(),
+			expect:  sdk.NewCoins(),
+		},
+		{
+			name:    "base denom only",
+			deposit: sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 10)),
+			expect:  sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 10)),
+		},
+		{
+			name:    "accepted denoms are converted and truncated",
+			deposit: sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 10), sdk.NewInt64Coin("lstake", 10), sdk.NewInt64Coin("usd", 9)),
+			expect:  sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 27)),
+		},
+		{
+			name:    "other denoms are left as is",
+			deposit: sdk.NewCoins(sdk.NewInt64Coin("lstake", 2), sdk.NewInt64Coin("other", 5)),
+			expect:  sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 3), sdk.NewInt64Coin("other", 5)),
+		},
+		{
+			name:    "amount converted to zero",
+			deposit: sdk.NewCoins(sdk.NewCoin("usd", sdkmath.NewInt(3))),
+			expect:  sdk.NewCoins(),
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			require.Equal(t, tc.expect.String(), params.DepositValue(tc.deposit).String())
+		})
+	}
+}
+
+func TestParamsMeetsDeposit(t *testing.T) {
+	params := v1.DefaultParams()
+	params.AcceptedDepositDenoms = []v1.AcceptedDepositDenom{
+		{Denom: "lstake", BaseDenom: sdk.DefaultBondDenom, Ratio: "2"},
+	}
+	threshold := sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 10), sdk.NewInt64Coin("uatom", 10))
+
+	testCases := []struct {
+		name     string
+		anyDenom bool
+		deposit  sdk.Coins
+		expect   bool
+	}{
+		{
+			name:    "all denoms reached",
+			deposit: sdk.NewCoins(sdk.NewInt64Coin("lstake", 5), sdk.NewInt64Coin("uatom", 10)),
+			expect:  true,
+		},
+		{
+			name:    "one denom reached",
+			deposit: sdk.NewCoins(sdk.NewInt64Coin("lstake", 5)),
+			expect:  false,
+		},
+		{
+			name:     "one denom reached with any denom",
+			anyDenom: true,
+			deposit:  sdk.NewCoins(sdk.NewInt64Coin("lstake", 5)),
+			expect:   true,
+		},
+		{
+			name:     "no denom reached with any denom",
+			anyDenom: true,
+			deposit:  sdk.NewCoins(sdk.NewInt64Coin("lstake", 4), sdk.NewInt64Coin("uatom", 9)),
+			expect:   false,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			params.MinDepositAnyDenom = tc.anyDenom
+			require.Equal(t, tc.expect, params.MeetsDeposit(tc.deposit, threshold))
+		})
+	}
+}
+
+func TestParamsIsDepositDenom(t *testing.T) {
+	params := v1.DefaultParams()
+	params.ExpeditedMinDeposit = sdk.NewCoins(sdk.NewInt64Coin("uatom", 10))
+	params.AcceptedDepositDenoms = []v1.AcceptedDepositDenom{
+		{Denom: "lstake", BaseDenom: sdk.DefaultBondDenom, Ratio: "2"},
+	}
+
+	require.True(t, params.IsDepositDenom(sdk.DefaultBondDenom))
+	require.True(t, params.IsDepositDenom("uatom"))
+	require.True(t, params.IsDepositDenom("lstake"))
+	require.False(t, params.IsDepositDenom("other"))
+}
+
+func TestParamsTallyQuorums(t *testing.T) {
+	params := v1.DefaultParams()
+	params.VetoQuorum = "0.4"
+	params.ProposalKindQuorums = []v1.ProposalKindQuorum{
+		{Kind: string(v1.ProposalKindExpedited), Quorum: "0.5"},
+		{Kind: string(v1.ProposalKindOptimistic), VetoQuorum: "0.1"},
+	}
+	require.NoError(t, params.ValidateBasic())
+
+	quorum, vetoQuorum := params.TallyQuorums(v1.ProposalKindStandard)
+	require.Equal(t, v1.DefaultQuorum.String(), quorum.String())
+	require.Equal(t, sdkmath.LegacyMustNewDecFromStr("0.4").String(), vetoQuorum.String())
+
+	quorum, vetoQuorum = params.TallyQuorums(v1.ProposalKindExpedited)
+	require.Equal(t, sdkmath.LegacyMustNewDecFromStr("0.5").String(), quorum.String())
+	require.Equal(t, sdkmath.LegacyMustNewDecFromStr("0.4").String(), vetoQuorum.String())
+
+	quorum, vetoQuorum = params.TallyQuorums(v1.ProposalKindOptimistic)
+	require.Equal(t, v1.DefaultQuorum.String(), quorum.String())
+	require.Equal(t, sdkmath.LegacyMustNewDecFromStr("0.1").String(), vetoQuorum.String())
+
+	// an unset veto quorum makes the Veto votes always count
+	params.VetoQuorum = ""
+	_, vetoQuorum = params.TallyQuorums(v1.ProposalKindStandard)
+	require.True(t, vetoQuorum.IsZero())
+
+	params.ProposalKindQuorums = append(params.ProposalKindQuorums, v1.ProposalKindQuorum{Kind: string(v1.ProposalKindExpedited)})
+	require.ErrorContains(t, params.ValidateBasic(), "duplicate proposal kind quorum")
+
+	params.ProposalKindQuorums = []v1.ProposalKindQuorum{{Kind: string(v1.ProposalKindStandard), VetoQuorum: "1.5"}}
+	require.ErrorContains(t, params.ValidateBasic(), "too large")
+}
@@ -0,0 +1,31 @@
+package v1
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/cosmos/cosmos-sdk/x/gov/types"
+)
+
+// ParamsUpdateError is returned when one or more messages of a
+// MsgBatchUpdateParams could not be validated or applied. It carries the
+// failure of each message so that it can be recorded on the proposal.
+type ParamsUpdateError struct {
+	Failures []ParamsUpdateFailure
+}
+
+// Error implements the error interface.
+func (e *ParamsUpdateError) Error() string {
+	reasons := make([]string, 0, len(e.Failures))
+	for _, f := range e.Failures {
+		reasons = append(reasons, fmt.Sprintf("message %d (%s): %s", f.Index, f.MsgTypeUrl, f.Reason))
+	}
+
+	return fmt.Sprintf("%s: %s", types.ErrParamsUpdateFailed, strings.Join(reasons, "; "))
+}
+
+// Unwrap returns the registered ErrParamsUpdateFailed error so that the
+// ABCI error code is preserved.
+func (e *ParamsUpdateError) Unwrap() error {
+	return types.ErrParamsUpdateFailed
+}
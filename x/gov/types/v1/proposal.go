@@ -20,6 +20,7 @@ const (
 	StatusPassed        = ProposalStatus_PROPOSAL_STATUS_PASSED
 	StatusRejected      = ProposalStatus_PROPOSAL_STATUS_REJECTED
 	StatusFailed        = ProposalStatus_PROPOSAL_STATUS_FAILED
+	StatusDiscussion    = ProposalStatus_PROPOSAL_STATUS_DISCUSSION_PERIOD
 )
 
 // NewProposal creates a new Proposal instance
@@ -63,6 +64,12 @@ func (p Proposal) GetMinDepositFromParams(params Params) sdk.Coins {
 	return params.MinDeposit
 }
 
+// IsConstitutionAmendment returns true if the proposal is a constitution
+// amendment proposal, i.e. its only message is a MsgAmendConstitution.
+func (p Proposal) IsConstitutionAmendment() bool {
+	return len(p.Messages) == 1 && p.Messages[0].TypeUrl == sdk.MsgTypeURL(&MsgAmendConstitution{})
+}
+
 // UnpackInterfaces implements UnpackInterfacesMessage.UnpackInterfaces
 func (p Proposal) UnpackInterfaces(unpacker types.AnyUnpacker) error {
 	return sdktx.UnpackInterfaces(unpacker, p.Messages)
@@ -126,7 +133,8 @@ func ValidProposalStatus(status ProposalStatus) bool {
 		status == StatusVotingPeriod ||
 		status == StatusPassed ||
 		status == StatusRejected ||
-		status == StatusFailed {
+		status == StatusFailed ||
+		status == StatusDiscussion {
 		return true
 	}
 	return false
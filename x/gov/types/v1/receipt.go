@@ -0,0 +1,28 @@
+package v1
+
+import (
+	"time"
+
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+)
+
+// NewProposalExecutionReceipt creates a new ProposalExecutionReceipt instance
+func NewProposalExecutionReceipt(proposalID uint64, height int64, execTime time.Time, msgResponses []*codectypes.Any) ProposalExecutionReceipt {
+	return ProposalExecutionReceipt{
+		ProposalId:   proposalID,
+		Height:       height,
+		Time:         execTime,
+		MsgResponses: msgResponses,
+	}
+}
+
+// IsFinished returns true if the proposal is no longer in its deposit,
+// discussion or voting period.
+func (p Proposal) IsFinished() bool {
+	switch p.Status {
+	case StatusPassed, StatusRejected, StatusFailed:
+		return true
+	default:
+		return false
+	}
+}
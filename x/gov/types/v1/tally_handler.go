@@ -0,0 +1,55 @@
+package v1
+
+import (
+	"context"
+
+	"cosmossdk.io/math"
+)
+
+// ProposalKind identifies the kind of a proposal, which selects the tally
+// handler used to count its votes.
+type ProposalKind string
+
+const (
+	ProposalKindStandard       ProposalKind = "standard"
+	ProposalKindExpedited      ProposalKind = "expedited"
+	ProposalKindOptimistic     ProposalKind = "optimistic"
+	ProposalKindMultipleChoice ProposalKind = "multiple-choice"
+)
+
+// Kind returns the kind of the proposal. The module itself only creates
+// standard and expedited proposals; the other kinds are reserved for the
+// proposals defined by the chains.
+func (p Proposal) Kind() ProposalKind {
+	if p.Expedited {
+		return ProposalKindExpedited
+	}
+
+	return ProposalKindStandard
+}
+
+// TallyVotes holds the votes counted for a proposal at the end of its voting
+// period, as passed to a TallyHandler.
+type TallyVotes struct {
+	// Results is the voting power cast for each vote option.
+	Results map[VoteOption]math.LegacyDec
+	// TotalVotingPower is the voting power which voted.
+	TotalVotingPower math.LegacyDec
+	// TotalBonded is the bonded voting power of the chain.
+	TotalBonded math.Int
+}
+
+// TallyHandler decides, from the counted votes, whether a proposal passes and
+// whether its deposits are burned. It must not write to state, as it is also
+// used by the tally queries.
+type TallyHandler func(ctx context.Context, proposal Proposal, params Params, votes TallyVotes) (passes, burnDeposits bool, err error)
+
+// TallyHandlerRoute registers a TallyHandler for the proposals of the given
+// kind.
+type TallyHandlerRoute struct {
+	Kind    ProposalKind
+	Handler TallyHandler
+}
+
+// IsManyPerContainerType implements the depinject.ManyPerContainerType interface.
+func (TallyHandlerRoute) IsManyPerContainerType() {}
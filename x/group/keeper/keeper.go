@@ -364,13 +364,18 @@ func (k Keeper) votesByProposal(ctx sdk.Context, proposalID uint64) ([]group.Vot
 
 // PruneProposals prunes all proposals that are expired, i.e. whose
 // `voting_period + max_execution_period` is greater than the current block
-// time.
+// time. It stops once the blocker gas limit of the module, if any, is reached,
+// the remaining proposals being pruned in the following blocks.
 func (k Keeper) PruneProposals(ctx sdk.Context) error {
 	proposals, err := k.proposalsByVPEnd(ctx, ctx.BlockTime().Add(-k.config.MaxExecutionPeriod))
 	if err != nil {
 		return nil
 	}
-	for _, proposal := range proposals {
+	for i, proposal := range proposals {
+		if i > 0 && ctx.IsBlockerGasExhausted() {
+			break
+		}
+
 		err := k.pruneProposal(ctx, proposal.Id)
 		if err != nil {
 			return err
@@ -382,14 +387,20 @@ func (k Keeper) PruneProposals(ctx sdk.Context) error {
 
 // TallyProposalsAtVPEnd iterates over all proposals whose voting period
 // has ended, tallies their votes, prunes them, and updates the proposal's
-// `FinalTallyResult` field.
+// `FinalTallyResult` field. It stops once the blocker gas limit of the module,
+// if any, is reached, the remaining proposals being tallied in the following
+// blocks.
 func (k Keeper) TallyProposalsAtVPEnd(ctx sdk.Context) error {
 	proposals, err := k.proposalsByVPEnd(ctx, ctx.BlockTime())
 	if err != nil {
 		return nil
 	}
 	//nolint:gosec // "implicit memory aliasing in the for loop (because of the pointers in the loop)"
-	for _, proposal := range proposals {
+	for i, proposal := range proposals {
+		if i > 0 && ctx.IsBlockerGasExhausted() {
+			break
+		}
+
 		policyInfo, err := k.getGroupPolicyInfo(ctx, proposal.GroupPolicyAddress)
 		if err != nil {
 			return errorsmod.Wrap(err, "group policy")
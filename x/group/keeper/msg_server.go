@@ -78,10 +78,11 @@ func (k Keeper) CreateGroup(goCtx context.Context, msg *group.MsgCreateGroup) (*
 		err := k.groupMemberTable.Create(ctx.KVStore(k.key), &group.GroupMember{
 			GroupId: groupID,
 			Member: &group.Member{
-				Address:  m.Address,
-				Weight:   m.Weight,
-				Metadata: m.Metadata,
-				AddedAt:  ctx.BlockTime(),
+				Address:        m.Address,
+				Weight:         m.Weight,
+				Metadata:       m.Metadata,
+				AddedAt:        ctx.BlockTime(),
+				WeightSchedule: m.WeightSchedule,
 			},
 		})
 		if err != nil {
@@ -123,9 +124,10 @@ func (k Keeper) UpdateGroupMembers(goCtx context.Context, msg *group.MsgUpdateGr
 			groupMember := group.GroupMember{
 				GroupId: msg.GroupId,
 				Member: &group.Member{
-					Address:  member.Address,
-					Weight:   member.Weight,
-					Metadata: member.Metadata,
+					Address:        member.Address,
+					Weight:         member.Weight,
+					Metadata:       member.Metadata,
+					WeightSchedule: member.WeightSchedule,
 				},
 			}
 
@@ -722,6 +724,16 @@ func (k Keeper) Vote(goCtx context.Context, msg *group.MsgVote) (*group.MsgVoteR
 	if err := k.groupMemberTable.GetOne(ctx.KVStore(k.key), orm.PrimaryKey(&voter), &voter); err != nil {
 		return nil, errorsmod.Wrapf(err, "voter address: %s", msg.Voter)
 	}
+
+	// A member whose weight decayed to zero following its weight schedule
+	// cannot vote anymore.
+	voterWeight, err := voter.Member.WeightAt(ctx.BlockTime())
+	if err != nil {
+		return nil, err
+	}
+	if voterWeight.IsZero() {
+		return nil, errorsmod.Wrapf(errors.ErrUnauthorized, "voter %s has no voting weight", msg.Voter)
+	}
 	newVote := group.Vote{
 		ProposalId: msg.ProposalId,
 		Voter:      msg.Voter,
@@ -765,7 +777,12 @@ func (k Keeper) doTallyAndUpdate(ctx sdk.Context, p *group.Proposal, groupInfo g
 		return err
 	}
 
-	result, err := policy.Allow(tallyResult, groupInfo.TotalWeight)
+	totalWeight, err := k.groupTotalWeightAt(ctx, groupInfo, ctx.BlockTime())
+	if err != nil {
+		return err
+	}
+
+	result, err := policy.Allow(tallyResult, totalWeight.String())
 	if err != nil {
 		return errorsmod.Wrap(err, "policy allow")
 	}
@@ -1103,6 +1120,12 @@ func (k Keeper) validateMembers(members []group.MemberRequest) error {
 			return errorsmod.Wrap(err, "weight must be non negative")
 		}
 
+		if member.WeightSchedule != nil {
+			if err := member.WeightSchedule.ValidateBasic(); err != nil {
+				return errorsmod.Wrapf(err, "weight schedule of member %s", member.Address)
+			}
+		}
+
 		index[member.Address] = struct{}{}
 	}
 
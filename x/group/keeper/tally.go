@@ -1,12 +1,15 @@
 package keeper
 
 import (
+	"time"
+
 	errorsmod "cosmossdk.io/errors"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	"github.com/cosmos/cosmos-sdk/x/group"
 	"github.com/cosmos/cosmos-sdk/x/group/errors"
+	"github.com/cosmos/cosmos-sdk/x/group/internal/math"
 	"github.com/cosmos/cosmos-sdk/x/group/internal/orm"
 )
 
@@ -56,10 +59,54 @@ func (k Keeper) Tally(ctx sdk.Context, p group.Proposal, groupID uint64) (group.
 			return group.TallyResult{}, err
 		}
 
-		if err := tallyResult.Add(vote, member.Member.Weight); err != nil {
+		// The weight of the member follows its weight schedule, and a member
+		// whose weight decayed to zero after voting is skipped.
+		weight, err := member.Member.WeightAt(ctx.BlockTime())
+		if err != nil {
+			return group.TallyResult{}, err
+		}
+		if weight.IsZero() {
+			continue
+		}
+
+		if err := tallyResult.Add(vote, weight.String()); err != nil {
 			return group.TallyResult{}, errorsmod.Wrap(err, "add new vote")
 		}
 	}
 
 	return tallyResult, nil
 }
+
+// groupTotalWeightAt returns the total weight of a group at the given time,
+// following the weight schedules of its members.
+func (k Keeper) groupTotalWeightAt(ctx sdk.Context, groupInfo group.GroupInfo, t time.Time) (math.Dec, error) {
+	it, err := k.groupMemberByGroupIndex.Get(ctx.KVStore(k.key), groupInfo.Id)
+	if err != nil {
+		return math.Dec{}, err
+	}
+	defer it.Close()
+
+	totalWeight := math.NewDecFromInt64(0)
+	for {
+		var member group.GroupMember
+		_, err := it.LoadNext(&member)
+		if errors.ErrORMIteratorDone.Is(err) {
+			break
+		}
+		if err != nil {
+			return math.Dec{}, err
+		}
+
+		weight, err := member.Member.WeightAt(t)
+		if err != nil {
+			return math.Dec{}, err
+		}
+
+		totalWeight, err = totalWeight.Add(weight)
+		if err != nil {
+			return math.Dec{}, err
+		}
+	}
+
+	return totalWeight, nil
+}
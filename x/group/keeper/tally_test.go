@@ -7,6 +7,7 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
 	"github.com/cosmos/cosmos-sdk/x/group"
+	"github.com/cosmos/cosmos-sdk/x/group/errors"
 )
 
 func (s *TestSuite) TestTally() {
@@ -86,3 +87,64 @@ func (s *TestSuite) TestTally() {
 		})
 	}
 }
+
+func (s *TestSuite) TestMemberWeightSchedule() {
+	addrs := s.addrs
+	start := s.sdkCtx.BlockTime()
+	end := start.Add(10 * time.Hour)
+	votingPeriod := 24 * time.Hour
+
+	schedule := func(target string) *group.WeightSchedule {
+		return &group.WeightSchedule{TargetWeight: target, StartTime: start, EndTime: end}
+	}
+
+	_, err := s.groupKeeper.CreateGroup(s.ctx, &group.MsgCreateGroup{
+		Admin:   addrs[0].String(),
+		Members: []group.MemberRequest{{Address: addrs[1].String(), Weight: "1", WeightSchedule: &group.WeightSchedule{TargetWeight: "3", StartTime: end, EndTime: start}}},
+	})
+	s.Require().ErrorIs(err, errors.ErrInvalid)
+
+	// addrs[1] vests up from 1 to 3, addrs[2] decays from 2 to 1 and addrs[3]
+	// decays from 4 to 0, so that the total weight decays from 7 to 4
+	policyAddr, _ := s.createGroupAndGroupPolicy(addrs[0], []group.MemberRequest{
+		{Address: addrs[1].String(), Weight: "1", WeightSchedule: schedule("3")},
+		{Address: addrs[2].String(), Weight: "2", WeightSchedule: schedule("1")},
+		{Address: addrs[3].String(), Weight: "4", WeightSchedule: schedule("0")},
+	}, group.NewPercentageDecisionPolicy("0.7", votingPeriod, 0))
+
+	proposalRes, err := s.groupKeeper.SubmitProposal(s.ctx, &group.MsgSubmitProposal{
+		GroupPolicyAddress: policyAddr,
+		Proposers:          []string{addrs[1].String()},
+	})
+	s.Require().NoError(err)
+	proposalID := proposalRes.ProposalId
+
+	ctx := s.sdkCtx.WithBlockTime(start.Add(5 * time.Hour))
+	for _, voter := range addrs[1:3] {
+		_, err = s.groupKeeper.Vote(ctx, &group.MsgVote{ProposalId: proposalID, Voter: voter.String(), Option: group.VOTE_OPTION_YES})
+		s.Require().NoError(err)
+	}
+
+	// the weights are interpolated at tally time
+	result, err := s.groupKeeper.TallyResult(ctx, &group.QueryTallyResultRequest{ProposalId: proposalID})
+	s.Require().NoError(err)
+	s.Require().Equal("3.5", result.Tally.YesCount)
+
+	// a member whose weight decayed to zero cannot vote anymore
+	ctx = s.sdkCtx.WithBlockTime(end)
+	_, err = s.groupKeeper.Vote(ctx, &group.MsgVote{ProposalId: proposalID, Voter: addrs[3].String(), Option: group.VOTE_OPTION_NO})
+	s.Require().ErrorIs(err, errors.ErrUnauthorized)
+
+	result, err = s.groupKeeper.TallyResult(ctx, &group.QueryTallyResultRequest{ProposalId: proposalID})
+	s.Require().NoError(err)
+	s.Require().Equal("4", result.Tally.YesCount)
+
+	// the proposal passes with 4 yes out of a total weight of 4, while it
+	// would be rejected with the total weight of 7 stored in the group
+	ctx = s.sdkCtx.WithBlockTime(start.Add(votingPeriod + 1))
+	s.Require().NoError(s.groupKeeper.TallyProposalsAtVPEnd(ctx))
+
+	proposal, err := s.groupKeeper.Proposal(ctx, &group.QueryProposalRequest{ProposalId: proposalID})
+	s.Require().NoError(err)
+	s.Require().Equal(group.PROPOSAL_STATUS_ACCEPTED, proposal.Proposal.Status)
+}
@@ -0,0 +1,42 @@
+package module
+
+import (
+	"github.com/cosmos/gogoproto/proto"
+
+	"github.com/cosmos/cosmos-sdk/types/retention"
+	"github.com/cosmos/cosmos-sdk/x/group"
+)
+
+var _ retention.HasPurgeableData = AppModuleBasic{}
+
+// PurgeableData implements the retention.HasPurgeableData interface. The
+// metadata of the groups, group policies, proposals and votes, and the titles
+// and summaries of the proposals, are purgeable.
+func (AppModuleBasic) PurgeableData() []retention.Category {
+	return []retention.Category{
+		{
+			Name:        "group/metadata",
+			Description: "the metadata of the groups, group policies, proposals and votes",
+			Purge: func(msg proto.Message, redact func(string) string) bool {
+				switch msg := msg.(type) {
+				case *group.MsgCreateGroup:
+					return retention.RedactFields(redact, &msg.Metadata)
+				case *group.MsgUpdateGroupMetadata:
+					return retention.RedactFields(redact, &msg.Metadata)
+				case *group.MsgCreateGroupPolicy:
+					return retention.RedactFields(redact, &msg.Metadata)
+				case *group.MsgCreateGroupWithPolicy:
+					return retention.RedactFields(redact, &msg.GroupMetadata, &msg.GroupPolicyMetadata)
+				case *group.MsgUpdateGroupPolicyMetadata:
+					return retention.RedactFields(redact, &msg.Metadata)
+				case *group.MsgSubmitProposal:
+					return retention.RedactFields(redact, &msg.Metadata, &msg.Title, &msg.Summary)
+				case *group.MsgVote:
+					return retention.RedactFields(redact, &msg.Metadata)
+				default:
+					return false
+				}
+			},
+		},
+	}
+}
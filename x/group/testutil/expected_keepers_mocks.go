@@ -141,6 +141,66 @@ func (m *MockBankKeeper) EXPECT() *MockBankKeeperMockRecorder {
 	return m.recorder
 }
 
+// BatchSend mocks base method.
+func (m *MockBankKeeper) BatchSend(arg0 context.Context, arg1 *types0.MsgBatchSend) (*types0.MsgBatchSendResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "BatchSend", arg0, arg1)
+	ret0, _ := ret[0].(*types0.MsgBatchSendResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// BatchSend indicates an expected call of BatchSend.
+func (mr *MockBankKeeperMockRecorder) BatchSend(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "BatchSend", reflect.TypeOf((*MockBankKeeper)(nil).BatchSend), arg0, arg1)
+}
+
+// ClaimTimelockedTransfer mocks base method.
+func (m *MockBankKeeper) ClaimTimelockedTransfer(arg0 context.Context, arg1 *types0.MsgClaimTimelockedTransfer) (*types0.MsgClaimTimelockedTransferResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ClaimTimelockedTransfer", arg0, arg1)
+	ret0, _ := ret[0].(*types0.MsgClaimTimelockedTransferResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ClaimTimelockedTransfer indicates an expected call of ClaimTimelockedTransfer.
+func (mr *MockBankKeeperMockRecorder) ClaimTimelockedTransfer(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ClaimTimelockedTransfer", reflect.TypeOf((*MockBankKeeper)(nil).ClaimTimelockedTransfer), arg0, arg1)
+}
+
+// CreateBalanceSnapshot mocks base method.
+func (m *MockBankKeeper) CreateBalanceSnapshot(arg0 context.Context, arg1 *types0.MsgCreateBalanceSnapshot) (*types0.MsgCreateBalanceSnapshotResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateBalanceSnapshot", arg0, arg1)
+	ret0, _ := ret[0].(*types0.MsgCreateBalanceSnapshotResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateBalanceSnapshot indicates an expected call of CreateBalanceSnapshot.
+func (mr *MockBankKeeperMockRecorder) CreateBalanceSnapshot(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateBalanceSnapshot", reflect.TypeOf((*MockBankKeeper)(nil).CreateBalanceSnapshot), arg0, arg1)
+}
+
+// DeleteBalanceSnapshot mocks base method.
+func (m *MockBankKeeper) DeleteBalanceSnapshot(arg0 context.Context, arg1 *types0.MsgDeleteBalanceSnapshot) (*types0.MsgDeleteBalanceSnapshotResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteBalanceSnapshot", arg0, arg1)
+	ret0, _ := ret[0].(*types0.MsgDeleteBalanceSnapshotResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteBalanceSnapshot indicates an expected call of DeleteBalanceSnapshot.
+func (mr *MockBankKeeperMockRecorder) DeleteBalanceSnapshot(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteBalanceSnapshot", reflect.TypeOf((*MockBankKeeper)(nil).DeleteBalanceSnapshot), arg0, arg1)
+}
+
 // GetAllBalances mocks base method.
 func (m *MockBankKeeper) GetAllBalances(ctx context.Context, addr types.AccAddress) types.Coins {
 	m.ctrl.T.Helper()
@@ -184,6 +244,21 @@ func (mr *MockBankKeeperMockRecorder) MultiSend(arg0, arg1 interface{}) *gomock.
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MultiSend", reflect.TypeOf((*MockBankKeeper)(nil).MultiSend), arg0, arg1)
 }
 
+// ReclaimTimelockedTransfer mocks base method.
+func (m *MockBankKeeper) ReclaimTimelockedTransfer(arg0 context.Context, arg1 *types0.MsgReclaimTimelockedTransfer) (*types0.MsgReclaimTimelockedTransferResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReclaimTimelockedTransfer", arg0, arg1)
+	ret0, _ := ret[0].(*types0.MsgReclaimTimelockedTransferResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ReclaimTimelockedTransfer indicates an expected call of ReclaimTimelockedTransfer.
+func (mr *MockBankKeeperMockRecorder) ReclaimTimelockedTransfer(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReclaimTimelockedTransfer", reflect.TypeOf((*MockBankKeeper)(nil).ReclaimTimelockedTransfer), arg0, arg1)
+}
+
 // Send mocks base method.
 func (m *MockBankKeeper) Send(arg0 context.Context, arg1 *types0.MsgSend) (*types0.MsgSendResponse, error) {
 	m.ctrl.T.Helper()
@@ -213,6 +288,66 @@ func (mr *MockBankKeeperMockRecorder) SendCoinsFromModuleToAccount(ctx, senderMo
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SendCoinsFromModuleToAccount", reflect.TypeOf((*MockBankKeeper)(nil).SendCoinsFromModuleToAccount), ctx, senderModule, recipientAddr, amt)
 }
 
+// SendWithTimelock mocks base method.
+func (m *MockBankKeeper) SendWithTimelock(arg0 context.Context, arg1 *types0.MsgSendWithTimelock) (*types0.MsgSendWithTimelockResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SendWithTimelock", arg0, arg1)
+	ret0, _ := ret[0].(*types0.MsgSendWithTimelockResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SendWithTimelock indicates an expected call of SendWithTimelock.
+func (mr *MockBankKeeperMockRecorder) SendWithTimelock(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SendWithTimelock", reflect.TypeOf((*MockBankKeeper)(nil).SendWithTimelock), arg0, arg1)
+}
+
+// SetDemurrageSchedule mocks base method.
+func (m *MockBankKeeper) SetDemurrageSchedule(arg0 context.Context, arg1 *types0.MsgSetDemurrageSchedule) (*types0.MsgSetDemurrageScheduleResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetDemurrageSchedule", arg0, arg1)
+	ret0, _ := ret[0].(*types0.MsgSetDemurrageScheduleResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SetDemurrageSchedule indicates an expected call of SetDemurrageSchedule.
+func (mr *MockBankKeeperMockRecorder) SetDemurrageSchedule(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetDemurrageSchedule", reflect.TypeOf((*MockBankKeeper)(nil).SetDemurrageSchedule), arg0, arg1)
+}
+
+// SetDustSweepOptIn mocks base method.
+func (m *MockBankKeeper) SetDustSweepOptIn(arg0 context.Context, arg1 *types0.MsgSetDustSweepOptIn) (*types0.MsgSetDustSweepOptInResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetDustSweepOptIn", arg0, arg1)
+	ret0, _ := ret[0].(*types0.MsgSetDustSweepOptInResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SetDustSweepOptIn indicates an expected call of SetDustSweepOptIn.
+func (mr *MockBankKeeperMockRecorder) SetDustSweepOptIn(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetDustSweepOptIn", reflect.TypeOf((*MockBankKeeper)(nil).SetDustSweepOptIn), arg0, arg1)
+}
+
+// SetMintBurnPermissions mocks base method.
+func (m *MockBankKeeper) SetMintBurnPermissions(arg0 context.Context, arg1 *types0.MsgSetMintBurnPermissions) (*types0.MsgSetMintBurnPermissionsResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetMintBurnPermissions", arg0, arg1)
+	ret0, _ := ret[0].(*types0.MsgSetMintBurnPermissionsResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SetMintBurnPermissions indicates an expected call of SetMintBurnPermissions.
+func (mr *MockBankKeeperMockRecorder) SetMintBurnPermissions(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetMintBurnPermissions", reflect.TypeOf((*MockBankKeeper)(nil).SetMintBurnPermissions), arg0, arg1)
+}
+
 // SetSendEnabled mocks base method.
 func (m *MockBankKeeper) SetSendEnabled(arg0 context.Context, arg1 *types0.MsgSetSendEnabled) (*types0.MsgSetSendEnabledResponse, error) {
 	m.ctrl.T.Helper()
@@ -228,6 +363,21 @@ func (mr *MockBankKeeperMockRecorder) SetSendEnabled(arg0, arg1 interface{}) *go
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetSendEnabled", reflect.TypeOf((*MockBankKeeper)(nil).SetSendEnabled), arg0, arg1)
 }
 
+// SetSpendingLimit mocks base method.
+func (m *MockBankKeeper) SetSpendingLimit(arg0 context.Context, arg1 *types0.MsgSetSpendingLimit) (*types0.MsgSetSpendingLimitResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetSpendingLimit", arg0, arg1)
+	ret0, _ := ret[0].(*types0.MsgSetSpendingLimitResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SetSpendingLimit indicates an expected call of SetSpendingLimit.
+func (mr *MockBankKeeperMockRecorder) SetSpendingLimit(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetSpendingLimit", reflect.TypeOf((*MockBankKeeper)(nil).SetSpendingLimit), arg0, arg1)
+}
+
 // SpendableCoins mocks base method.
 func (m *MockBankKeeper) SpendableCoins(ctx context.Context, addr types.AccAddress) types.Coins {
 	m.ctrl.T.Helper()
@@ -242,6 +392,21 @@ func (mr *MockBankKeeperMockRecorder) SpendableCoins(ctx, addr interface{}) *gom
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SpendableCoins", reflect.TypeOf((*MockBankKeeper)(nil).SpendableCoins), ctx, addr)
 }
 
+// UpdateDenomMetadata mocks base method.
+func (m *MockBankKeeper) UpdateDenomMetadata(arg0 context.Context, arg1 *types0.MsgUpdateDenomMetadata) (*types0.MsgUpdateDenomMetadataResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateDenomMetadata", arg0, arg1)
+	ret0, _ := ret[0].(*types0.MsgUpdateDenomMetadataResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateDenomMetadata indicates an expected call of UpdateDenomMetadata.
+func (mr *MockBankKeeperMockRecorder) UpdateDenomMetadata(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateDenomMetadata", reflect.TypeOf((*MockBankKeeper)(nil).UpdateDenomMetadata), arg0, arg1)
+}
+
 // UpdateParams mocks base method.
 func (m *MockBankKeeper) UpdateParams(arg0 context.Context, arg1 *types0.MsgUpdateParams) (*types0.MsgUpdateParamsResponse, error) {
 	m.ctrl.T.Helper()
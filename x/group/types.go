@@ -372,18 +372,79 @@ func (g GroupMember) ValidateBasic() error {
 		return errorsmod.Wrap(err, "weight must be non negative")
 	}
 
+	if g.Member.WeightSchedule != nil {
+		if err := g.Member.WeightSchedule.ValidateBasic(); err != nil {
+			return errorsmod.Wrap(err, "group member's weight schedule")
+		}
+	}
+
+	return nil
+}
+
+// ValidateBasic performs stateless validation on a weight schedule.
+func (s WeightSchedule) ValidateBasic() error {
+	if _, err := math.NewNonNegativeDecFromString(s.TargetWeight); err != nil {
+		return errorsmod.Wrap(err, "target weight must be non negative")
+	}
+
+	if !s.EndTime.After(s.StartTime) {
+		return errorsmod.Wrap(errors.ErrInvalid, "end time must be after start time")
+	}
+
 	return nil
 }
 
+// WeightAt returns the member's voting weight at the given time, following
+// its weight schedule if any: the weight is `Weight` until the start time of
+// the schedule, its target weight from its end time, and interpolated linearly
+// in between.
+func (m Member) WeightAt(t time.Time) (math.Dec, error) {
+	weight, err := math.NewNonNegativeDecFromString(m.Weight)
+	if err != nil {
+		return math.Dec{}, err
+	}
+
+	s := m.WeightSchedule
+	if s == nil || !t.After(s.StartTime) {
+		return weight, nil
+	}
+
+	target, err := math.NewNonNegativeDecFromString(s.TargetWeight)
+	if err != nil {
+		return math.Dec{}, err
+	}
+	if !t.Before(s.EndTime) {
+		return target, nil
+	}
+
+	elapsed, err := math.NewDecFromInt64(t.Sub(s.StartTime).Nanoseconds()).Quo(math.NewDecFromInt64(s.EndTime.Sub(s.StartTime).Nanoseconds()))
+	if err != nil {
+		return math.Dec{}, err
+	}
+
+	delta, err := target.Sub(weight)
+	if err != nil {
+		return math.Dec{}, err
+	}
+
+	delta, err = delta.Mul(elapsed)
+	if err != nil {
+		return math.Dec{}, err
+	}
+
+	return weight.Add(delta)
+}
+
 // MemberToMemberRequest converts a `Member` (used for storage)
 // to a `MemberRequest` (used in requests). The only difference
 // between the two is that `MemberRequest` doesn't have any `AddedAt` field
 // since it cannot be set as part of requests.
 func MemberToMemberRequest(m *Member) MemberRequest {
 	return MemberRequest{
-		Address:  m.Address,
-		Weight:   m.Weight,
-		Metadata: m.Metadata,
+		Address:        m.Address,
+		Weight:         m.Weight,
+		Metadata:       m.Metadata,
+		WeightSchedule: m.WeightSchedule,
 	}
 }
 
@@ -167,6 +167,11 @@ type Member struct {
 	Metadata string `protobuf:"bytes,3,opt,name=metadata,proto3" json:"metadata,omitempty"`
 	// added_at is a timestamp specifying when a member was added.
 	AddedAt time.Time `protobuf:"bytes,4,opt,name=added_at,json=addedAt,proto3,stdtime" json:"added_at"`
+	// weight_schedule is an optional schedule changing the member's voting
+	// weight over time, from `weight` to its target weight.
+	//
+	// Since: cosmos-sdk 0.48
+	WeightSchedule *WeightSchedule `protobuf:"bytes,5,opt,name=weight_schedule,json=weightSchedule,proto3" json:"weight_schedule,omitempty"`
 }
 
 func (m *Member) Reset()         { *m = Member{} }
@@ -230,6 +235,13 @@ func (m *Member) GetAddedAt() time.Time {
 	return time.Time{}
 }
 
+func (m *Member) GetWeightSchedule() *WeightSchedule {
+	if m != nil {
+		return m.WeightSchedule
+	}
+	return nil
+}
+
 // MemberRequest represents a group member to be used in Msg server requests.
 // Contrary to `Member`, it doesn't have any `added_at` field
 // since this field cannot be set as part of requests.
@@ -240,6 +252,11 @@ type MemberRequest struct {
 	Weight string `protobuf:"bytes,2,opt,name=weight,proto3" json:"weight,omitempty"`
 	// metadata is any arbitrary metadata attached to the member.
 	Metadata string `protobuf:"bytes,3,opt,name=metadata,proto3" json:"metadata,omitempty"`
+	// weight_schedule is an optional schedule changing the member's voting
+	// weight over time, from `weight` to its target weight.
+	//
+	// Since: cosmos-sdk 0.48
+	WeightSchedule *WeightSchedule `protobuf:"bytes,4,opt,name=weight_schedule,json=weightSchedule,proto3" json:"weight_schedule,omitempty"`
 }
 
 func (m *MemberRequest) Reset()         { *m = MemberRequest{} }
@@ -296,6 +313,86 @@ func (m *MemberRequest) GetMetadata() string {
 	return ""
 }
 
+func (m *MemberRequest) GetWeightSchedule() *WeightSchedule {
+	if m != nil {
+		return m.WeightSchedule
+	}
+	return nil
+}
+
+// WeightSchedule defines a schedule changing the voting weight of a group
+// member linearly over time, e.g. to ramp up the weight of a new contributor
+// or to decay the weight of a leaving one. The weight is `weight` until
+// `start_time`, `target_weight` from `end_time`, and interpolated linearly in
+// between. The schedule is applied lazily when votes are cast and tallied, so
+// it doesn't require any membership update.
+//
+// Since: cosmos-sdk 0.48
+type WeightSchedule struct {
+	// target_weight is the member's voting weight at the end of the schedule.
+	// It is greater than `weight` to vest weight up, or lower to decay it down,
+	// and can be zero.
+	TargetWeight string `protobuf:"bytes,1,opt,name=target_weight,json=targetWeight,proto3" json:"target_weight,omitempty"`
+	// start_time is the time from which the weight starts changing.
+	StartTime time.Time `protobuf:"bytes,2,opt,name=start_time,json=startTime,proto3,stdtime" json:"start_time"`
+	// end_time is the time from which the weight is the target weight.
+	EndTime time.Time `protobuf:"bytes,3,opt,name=end_time,json=endTime,proto3,stdtime" json:"end_time"`
+}
+
+func (m *WeightSchedule) Reset()         { *m = WeightSchedule{} }
+func (m *WeightSchedule) String() string { return proto.CompactTextString(m) }
+func (*WeightSchedule) ProtoMessage()    {}
+func (*WeightSchedule) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f5bddd15d7a54a9d, []int{2}
+}
+func (m *WeightSchedule) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *WeightSchedule) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_WeightSchedule.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *WeightSchedule) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_WeightSchedule.Merge(m, src)
+}
+func (m *WeightSchedule) XXX_Size() int {
+	return m.Size()
+}
+func (m *WeightSchedule) XXX_DiscardUnknown() {
+	xxx_messageInfo_WeightSchedule.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_WeightSchedule proto.InternalMessageInfo
+
+func (m *WeightSchedule) GetTargetWeight() string {
+	if m != nil {
+		return m.TargetWeight
+	}
+	return ""
+}
+
+func (m *WeightSchedule) GetStartTime() time.Time {
+	if m != nil {
+		return m.StartTime
+	}
+	return time.Time{}
+}
+
+func (m *WeightSchedule) GetEndTime() time.Time {
+	if m != nil {
+		return m.EndTime
+	}
+	return time.Time{}
+}
+
 // ThresholdDecisionPolicy is a decision policy where a proposal passes when it
 // satisfies the two following conditions:
 //  1. The sum of all `YES` voter's weights is greater or equal than the defined
@@ -314,7 +411,7 @@ func (m *ThresholdDecisionPolicy) Reset()         { *m = ThresholdDecisionPolicy
 func (m *ThresholdDecisionPolicy) String() string { return proto.CompactTextString(m) }
 func (*ThresholdDecisionPolicy) ProtoMessage()    {}
 func (*ThresholdDecisionPolicy) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f5bddd15d7a54a9d, []int{2}
+	return fileDescriptor_f5bddd15d7a54a9d, []int{3}
 }
 func (m *ThresholdDecisionPolicy) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -375,7 +472,7 @@ func (m *PercentageDecisionPolicy) Reset()         { *m = PercentageDecisionPoli
 func (m *PercentageDecisionPolicy) String() string { return proto.CompactTextString(m) }
 func (*PercentageDecisionPolicy) ProtoMessage()    {}
 func (*PercentageDecisionPolicy) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f5bddd15d7a54a9d, []int{3}
+	return fileDescriptor_f5bddd15d7a54a9d, []int{4}
 }
 func (m *PercentageDecisionPolicy) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -441,7 +538,7 @@ func (m *DecisionPolicyWindows) Reset()         { *m = DecisionPolicyWindows{} }
 func (m *DecisionPolicyWindows) String() string { return proto.CompactTextString(m) }
 func (*DecisionPolicyWindows) ProtoMessage()    {}
 func (*DecisionPolicyWindows) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f5bddd15d7a54a9d, []int{4}
+	return fileDescriptor_f5bddd15d7a54a9d, []int{5}
 }
 func (m *DecisionPolicyWindows) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -507,7 +604,7 @@ func (m *GroupInfo) Reset()         { *m = GroupInfo{} }
 func (m *GroupInfo) String() string { return proto.CompactTextString(m) }
 func (*GroupInfo) ProtoMessage()    {}
 func (*GroupInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f5bddd15d7a54a9d, []int{5}
+	return fileDescriptor_f5bddd15d7a54a9d, []int{6}
 }
 func (m *GroupInfo) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -590,7 +687,7 @@ func (m *GroupMember) Reset()         { *m = GroupMember{} }
 func (m *GroupMember) String() string { return proto.CompactTextString(m) }
 func (*GroupMember) ProtoMessage()    {}
 func (*GroupMember) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f5bddd15d7a54a9d, []int{6}
+	return fileDescriptor_f5bddd15d7a54a9d, []int{7}
 }
 func (m *GroupMember) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -656,7 +753,7 @@ func (m *GroupPolicyInfo) Reset()         { *m = GroupPolicyInfo{} }
 func (m *GroupPolicyInfo) String() string { return proto.CompactTextString(m) }
 func (*GroupPolicyInfo) ProtoMessage()    {}
 func (*GroupPolicyInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f5bddd15d7a54a9d, []int{7}
+	return fileDescriptor_f5bddd15d7a54a9d, []int{8}
 }
 func (m *GroupPolicyInfo) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -739,7 +836,7 @@ func (m *Proposal) Reset()         { *m = Proposal{} }
 func (m *Proposal) String() string { return proto.CompactTextString(m) }
 func (*Proposal) ProtoMessage()    {}
 func (*Proposal) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f5bddd15d7a54a9d, []int{8}
+	return fileDescriptor_f5bddd15d7a54a9d, []int{9}
 }
 func (m *Proposal) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -784,7 +881,7 @@ func (m *TallyResult) Reset()         { *m = TallyResult{} }
 func (m *TallyResult) String() string { return proto.CompactTextString(m) }
 func (*TallyResult) ProtoMessage()    {}
 func (*TallyResult) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f5bddd15d7a54a9d, []int{9}
+	return fileDescriptor_f5bddd15d7a54a9d, []int{10}
 }
 func (m *TallyResult) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -831,7 +928,7 @@ func (m *Vote) Reset()         { *m = Vote{} }
 func (m *Vote) String() string { return proto.CompactTextString(m) }
 func (*Vote) ProtoMessage()    {}
 func (*Vote) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f5bddd15d7a54a9d, []int{10}
+	return fileDescriptor_f5bddd15d7a54a9d, []int{11}
 }
 func (m *Vote) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -901,6 +998,7 @@ func init() {
 	proto.RegisterEnum("cosmos.group.v1.ProposalExecutorResult", ProposalExecutorResult_name, ProposalExecutorResult_value)
 	proto.RegisterType((*Member)(nil), "cosmos.group.v1.Member")
 	proto.RegisterType((*MemberRequest)(nil), "cosmos.group.v1.MemberRequest")
+	proto.RegisterType((*WeightSchedule)(nil), "cosmos.group.v1.WeightSchedule")
 	proto.RegisterType((*ThresholdDecisionPolicy)(nil), "cosmos.group.v1.ThresholdDecisionPolicy")
 	proto.RegisterType((*PercentageDecisionPolicy)(nil), "cosmos.group.v1.PercentageDecisionPolicy")
 	proto.RegisterType((*DecisionPolicyWindows)(nil), "cosmos.group.v1.DecisionPolicyWindows")
@@ -915,93 +1013,98 @@ func init() {
 func init() { proto.RegisterFile("cosmos/group/v1/types.proto", fileDescriptor_f5bddd15d7a54a9d) }
 
 var fileDescriptor_f5bddd15d7a54a9d = []byte{
-	// 1369 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x57, 0x3b, 0x6f, 0x1b, 0xc7,
-	0x16, 0xd6, 0x92, 0x14, 0x1f, 0x87, 0x12, 0x49, 0x8f, 0x75, 0xad, 0x95, 0xe4, 0x4b, 0xea, 0xd2,
-	0xc6, 0xbd, 0x82, 0x2e, 0x4c, 0xda, 0x32, 0x90, 0x00, 0x2e, 0x82, 0x90, 0xd4, 0x3a, 0xa6, 0x60,
-	0x93, 0xc4, 0x72, 0x29, 0xc5, 0x6e, 0x16, 0x2b, 0xee, 0x98, 0x5a, 0x84, 0xbb, 0xc3, 0xec, 0x0e,
-	0x25, 0xf3, 0x1f, 0x18, 0x69, 0xe2, 0x32, 0x4d, 0x00, 0x03, 0x69, 0x52, 0xba, 0x30, 0x52, 0xa4,
-	0x0c, 0x52, 0x18, 0x29, 0x02, 0x23, 0x55, 0xaa, 0x24, 0xb0, 0x0b, 0xa7, 0x4a, 0x95, 0x36, 0x41,
-	0xb0, 0x33, 0xb3, 0x14, 0x1f, 0x12, 0x1d, 0x19, 0x46, 0x1a, 0x41, 0x33, 0xdf, 0x77, 0xce, 0x9c,
-	0xef, 0xbc, 0x48, 0xc2, 0x5a, 0x9b, 0x78, 0x36, 0xf1, 0x8a, 0x1d, 0x97, 0xf4, 0x7b, 0xc5, 0xc3,
-	0x6b, 0x45, 0x3a, 0xe8, 0x61, 0xaf, 0xd0, 0x73, 0x09, 0x25, 0x28, 0xcd, 0xc1, 0x02, 0x03, 0x0b,
-	0x87, 0xd7, 0x56, 0x97, 0x3a, 0xa4, 0x43, 0x18, 0x56, 0xf4, 0xff, 0xe3, 0xb4, 0xd5, 0x6c, 0x87,
-	0x90, 0x4e, 0x17, 0x17, 0xd9, 0x69, 0xbf, 0x7f, 0xbf, 0x68, 0xf6, 0x5d, 0x83, 0x5a, 0xc4, 0x11,
-	0x78, 0x6e, 0x12, 0xa7, 0x96, 0x8d, 0x3d, 0x6a, 0xd8, 0x3d, 0x41, 0x58, 0xe1, 0xef, 0xe8, 0xdc,
-	0xb3, 0x78, 0x54, 0x40, 0x93, 0xb6, 0x86, 0x33, 0x10, 0xd0, 0x39, 0xc3, 0xb6, 0x1c, 0x52, 0x64,
-	0x7f, 0xf9, 0x55, 0xfe, 0x2b, 0x09, 0xa2, 0x77, 0xb0, 0xbd, 0x8f, 0x5d, 0xb4, 0x05, 0x31, 0xc3,
-	0x34, 0x5d, 0xec, 0x79, 0xb2, 0xb4, 0x2e, 0x6d, 0x24, 0xca, 0xf2, 0x0f, 0x4f, 0xaf, 0x2c, 0x09,
-	0xdf, 0x25, 0x8e, 0x34, 0xa9, 0x6b, 0x39, 0x1d, 0x35, 0x20, 0xa2, 0x0b, 0x10, 0x3d, 0xc2, 0x56,
-	0xe7, 0x80, 0xca, 0x21, 0xdf, 0x44, 0x15, 0x27, 0xb4, 0x0a, 0x71, 0x1b, 0x53, 0xc3, 0x34, 0xa8,
-	0x21, 0x87, 0x19, 0x32, 0x3c, 0xa3, 0x6d, 0x88, 0x1b, 0xa6, 0x89, 0x4d, 0xdd, 0xa0, 0x72, 0x64,
-	0x5d, 0xda, 0x48, 0x6e, 0xad, 0x16, 0x78, 0xcc, 0x85, 0x20, 0xe6, 0x82, 0x16, 0xe8, 0x2d, 0x2f,
-	0x3e, 0xfb, 0x29, 0x37, 0xf7, 0xe8, 0xe7, 0x9c, 0xf4, 0xe5, 0xab, 0x27, 0x9b, 0x12, 0x7b, 0x19,
-	0x9b, 0x25, 0x9a, 0x3f, 0x82, 0x45, 0x1e, 0xb7, 0x8a, 0x3f, 0xee, 0x63, 0x8f, 0xfe, 0x53, 0xe1,
-	0xe7, 0xbf, 0x95, 0x60, 0x59, 0x3b, 0x70, 0xb1, 0x77, 0x40, 0xba, 0xe6, 0x36, 0x6e, 0x5b, 0x9e,
-	0x45, 0x9c, 0x06, 0xe9, 0x5a, 0xed, 0x01, 0xba, 0x08, 0x09, 0x1a, 0x40, 0x3c, 0x0a, 0xf5, 0xf8,
-	0x02, 0xbd, 0x0f, 0xb1, 0x23, 0xcb, 0x31, 0xc9, 0x91, 0xc7, 0x9e, 0x4b, 0x6e, 0xfd, 0xb7, 0x30,
-	0xd1, 0x2e, 0x85, 0x71, 0x7f, 0x7b, 0x9c, 0xad, 0x06, 0x66, 0x37, 0xaa, 0xdf, 0x3d, 0xbd, 0x92,
-	0x9d, 0x6d, 0xf3, 0xc9, 0xab, 0x27, 0x9b, 0x79, 0x4e, 0xb9, 0xe2, 0x99, 0x1f, 0x15, 0x4f, 0x09,
-	0x35, 0xff, 0x4c, 0x02, 0xb9, 0x81, 0xdd, 0x36, 0x76, 0xa8, 0xd1, 0xc1, 0x13, 0x3a, 0xb2, 0x00,
-	0xbd, 0x21, 0x26, 0x84, 0x8c, 0xdc, 0xbc, 0x05, 0x25, 0x3b, 0x7f, 0x4f, 0xc9, 0xa5, 0x11, 0x25,
-	0xa7, 0x45, 0x9b, 0xff, 0x46, 0x82, 0x7f, 0x9d, 0xf8, 0x1c, 0xba, 0x03, 0x8b, 0x87, 0x84, 0x5a,
-	0x4e, 0x47, 0xef, 0x61, 0xd7, 0x22, 0xbc, 0x26, 0xc9, 0xad, 0x95, 0xa9, 0x7e, 0xdb, 0x16, 0xf3,
-	0xc7, 0xdb, 0xed, 0xb3, 0x61, 0xbb, 0x2d, 0x70, 0xf3, 0x06, 0xb3, 0x46, 0xf7, 0x60, 0xc9, 0xb6,
-	0x1c, 0x1d, 0x3f, 0xc0, 0xed, 0xbe, 0xcf, 0x0e, 0xbc, 0x86, 0xce, 0xe8, 0x15, 0xd9, 0x96, 0xa3,
-	0x04, 0x4e, 0xb8, 0xef, 0xfc, 0x6f, 0x12, 0x24, 0x3e, 0xf0, 0x13, 0x51, 0x75, 0xee, 0x13, 0x94,
-	0x82, 0x90, 0xc5, 0xa3, 0x8d, 0xa8, 0x21, 0xcb, 0x44, 0x05, 0x98, 0x37, 0x4c, 0xdb, 0x72, 0x78,
-	0x9f, 0xce, 0x68, 0x6d, 0x4e, 0x9b, 0x39, 0x7f, 0x32, 0xc4, 0x0e, 0xb1, 0xeb, 0x27, 0x8b, 0x8d,
-	0x5f, 0x44, 0x0d, 0x8e, 0xe8, 0x3f, 0xb0, 0x40, 0x09, 0x35, 0xba, 0xba, 0x18, 0x8a, 0x79, 0x66,
-	0x99, 0x64, 0x77, 0x7b, 0x7c, 0x32, 0x6e, 0x01, 0xb4, 0x5d, 0x6c, 0x50, 0x3e, 0xbe, 0xd1, 0xb3,
-	0x8e, 0x6f, 0x42, 0x18, 0x97, 0x68, 0xfe, 0x2e, 0x24, 0x99, 0x5e, 0xb1, 0x7d, 0x56, 0x20, 0xce,
-	0xfa, 0x40, 0x1f, 0xea, 0x8e, 0xb1, 0x73, 0xd5, 0x44, 0x45, 0x88, 0xda, 0x8c, 0x24, 0x12, 0xbd,
-	0x3c, 0xd5, 0x6c, 0x62, 0x13, 0x08, 0x5a, 0xfe, 0x8f, 0x10, 0xa4, 0x99, 0x6f, 0xde, 0x0d, 0x2c,
-	0xa3, 0x6f, 0xb2, 0x1e, 0x46, 0x63, 0x0a, 0x8d, 0xc7, 0x34, 0x2c, 0x48, 0xf8, 0xec, 0x05, 0x89,
-	0x9c, 0x5e, 0x90, 0xf9, 0xf1, 0x82, 0x18, 0x90, 0x36, 0x45, 0x63, 0xeb, 0x3d, 0xa6, 0x45, 0xa4,
-	0x7c, 0x69, 0x2a, 0xe5, 0x25, 0x67, 0x50, 0xce, 0xbf, 0x7e, 0xa8, 0xd4, 0x94, 0x39, 0x3e, 0xea,
-	0xe3, 0x05, 0x8d, 0xbd, 0x79, 0x41, 0x6f, 0xc4, 0x1f, 0x3e, 0xce, 0xcd, 0xfd, 0xfa, 0x38, 0x27,
-	0xe5, 0xff, 0x9c, 0x87, 0x78, 0xc3, 0x25, 0x3d, 0xe2, 0x19, 0xdd, 0xa9, 0x56, 0xde, 0x81, 0x25,
-	0x9e, 0x54, 0x2e, 0x48, 0x0f, 0xaa, 0xf2, 0xba, 0xce, 0x46, 0x9d, 0xe3, 0x8a, 0x0a, 0x64, 0x66,
-	0x9b, 0xbf, 0x03, 0x89, 0x1e, 0x8b, 0x01, 0xbb, 0x9e, 0x1c, 0x59, 0x0f, 0xcf, 0x74, 0x7e, 0x4c,
-	0x45, 0x3b, 0x90, 0xf4, 0xfa, 0xfb, 0xb6, 0x45, 0x75, 0xff, 0x43, 0x97, 0x55, 0xe4, 0x4c, 0x19,
-	0x01, 0x6e, 0xed, 0xe3, 0xe8, 0x12, 0x2c, 0x72, 0xad, 0x41, 0x7d, 0xa3, 0x2c, 0x0d, 0x0b, 0xec,
-	0x72, 0x57, 0x14, 0xf9, 0xea, 0x44, 0x42, 0x02, 0x6e, 0x8c, 0x71, 0x47, 0x65, 0x07, 0x16, 0xef,
-	0x42, 0xd4, 0xa3, 0x06, 0xed, 0x7b, 0x72, 0x7c, 0x5d, 0xda, 0x48, 0x6d, 0xe5, 0xa6, 0x06, 0x22,
-	0xc8, 0x7e, 0x93, 0xd1, 0x54, 0x41, 0x47, 0x2d, 0x40, 0xf7, 0x2d, 0xc7, 0xe8, 0xea, 0xd4, 0xe8,
-	0x76, 0x07, 0xba, 0x8b, 0xbd, 0x7e, 0x97, 0xca, 0x09, 0x26, 0xf1, 0xe2, 0x94, 0x13, 0xcd, 0x27,
-	0xa9, 0x8c, 0x53, 0x4e, 0xf8, 0x22, 0xb9, 0xc0, 0x0c, 0x73, 0x31, 0x02, 0xa2, 0x16, 0x9c, 0x1b,
-	0x5b, 0xb3, 0x3a, 0x76, 0x4c, 0x19, 0xce, 0x9a, 0xb8, 0xf4, 0xe8, 0xae, 0x55, 0x1c, 0x13, 0x35,
-	0x20, 0xcd, 0x57, 0x2d, 0x71, 0x83, 0x50, 0x93, 0x4c, 0xef, 0xff, 0x4e, 0xd5, 0xab, 0x08, 0x3e,
-	0x0f, 0x4c, 0x4d, 0xe1, 0xb1, 0x33, 0xba, 0xea, 0xf7, 0x8b, 0xe7, 0x19, 0x1d, 0xec, 0xc9, 0x0b,
-	0xeb, 0xe1, 0xd3, 0x06, 0x49, 0x1d, 0xb2, 0xd0, 0x12, 0xcc, 0x53, 0x8b, 0x76, 0xb1, 0xbc, 0xc8,
-	0xda, 0x8b, 0x1f, 0xfc, 0x89, 0xf5, 0xfa, 0xb6, 0x6d, 0xb8, 0x03, 0x39, 0xc5, 0xee, 0x83, 0xe3,
-	0x8d, 0x88, 0x3f, 0x04, 0xf9, 0xcf, 0x25, 0x48, 0x8e, 0x26, 0x68, 0x0d, 0x12, 0x03, 0xec, 0xe9,
-	0x6d, 0xd2, 0x77, 0xa8, 0xf8, 0x38, 0x8d, 0x0f, 0xb0, 0x57, 0xf1, 0xcf, 0x7e, 0x93, 0x18, 0xfb,
-	0x1e, 0x35, 0x2c, 0x47, 0x10, 0xf8, 0x77, 0x91, 0x05, 0x71, 0xc9, 0x49, 0x2b, 0x10, 0x77, 0x88,
-	0xc0, 0x79, 0xa7, 0xc7, 0x1c, 0xc2, 0xa1, 0xff, 0x03, 0x72, 0x88, 0x7e, 0x64, 0xd1, 0x03, 0xfd,
-	0x10, 0xd3, 0x80, 0xc4, 0x97, 0x4c, 0xda, 0x21, 0x7b, 0x16, 0x3d, 0xd8, 0xc5, 0x94, 0x93, 0x45,
-	0x7c, 0xbf, 0x4b, 0x10, 0xd9, 0x25, 0x14, 0xa3, 0x1c, 0x24, 0x7b, 0x22, 0x75, 0xc7, 0x8b, 0x17,
-	0x82, 0x2b, 0xbe, 0xe7, 0x0e, 0x09, 0x15, 0xab, 0x77, 0xe6, 0x9e, 0x63, 0x34, 0x74, 0x1d, 0xa2,
-	0xa4, 0xe7, 0x7f, 0xac, 0xb1, 0x28, 0x53, 0x5b, 0x6b, 0x53, 0xa5, 0xf2, 0xdf, 0xad, 0x33, 0x8a,
-	0x2a, 0xa8, 0x33, 0x97, 0xe3, 0x5b, 0x1c, 0xc7, 0xcd, 0x4f, 0x25, 0x80, 0xe3, 0xe7, 0xd1, 0x1a,
-	0x2c, 0xef, 0xd6, 0x35, 0x45, 0xaf, 0x37, 0xb4, 0x6a, 0xbd, 0xa6, 0xb7, 0x6a, 0xcd, 0x86, 0x52,
-	0xa9, 0xde, 0xac, 0x2a, 0xdb, 0x99, 0x39, 0x74, 0x1e, 0xd2, 0xa3, 0xe0, 0x5d, 0xa5, 0x99, 0x91,
-	0xd0, 0x32, 0x9c, 0x1f, 0xbd, 0x2c, 0x95, 0x9b, 0x5a, 0xa9, 0x5a, 0xcb, 0x84, 0x10, 0x82, 0xd4,
-	0x28, 0x50, 0xab, 0x67, 0xc2, 0xe8, 0x22, 0xc8, 0xe3, 0x77, 0xfa, 0x5e, 0x55, 0xbb, 0xa5, 0xef,
-	0x2a, 0x5a, 0x3d, 0x13, 0x59, 0x8d, 0x3c, 0xfc, 0x22, 0x3b, 0xb7, 0xf9, 0xbd, 0x04, 0xa9, 0xf1,
-	0x59, 0x45, 0x39, 0x58, 0x6b, 0xa8, 0xf5, 0x46, 0xbd, 0x59, 0xba, 0xad, 0x37, 0xb5, 0x92, 0xd6,
-	0x6a, 0x4e, 0x44, 0xf6, 0x6f, 0x58, 0x99, 0x24, 0x34, 0x5b, 0xe5, 0x3b, 0x55, 0x4d, 0x53, 0xb6,
-	0x33, 0x92, 0xff, 0xec, 0x24, 0x5c, 0xaa, 0x54, 0x94, 0x86, 0x8f, 0x86, 0x4e, 0x42, 0x55, 0x65,
-	0x47, 0xa9, 0xf8, 0x68, 0xd8, 0xcf, 0xc8, 0x94, 0x6d, 0xb9, 0xae, 0xfa, 0x60, 0xe4, 0xa4, 0x77,
-	0x7d, 0x41, 0xdb, 0x6a, 0x69, 0xaf, 0x96, 0x99, 0x17, 0x82, 0xbe, 0x96, 0xe0, 0xc2, 0xc9, 0xc3,
-	0x88, 0x36, 0xe0, 0xf2, 0xd0, 0x5e, 0xf9, 0x50, 0xa9, 0xb4, 0xb4, 0xba, 0xaa, 0xab, 0x4a, 0xb3,
-	0x75, 0x5b, 0x9b, 0x50, 0x78, 0x19, 0xd6, 0x4f, 0x65, 0xd6, 0xea, 0x9a, 0xae, 0xb6, 0x6a, 0x19,
-	0x69, 0x26, 0xab, 0xd9, 0xaa, 0x54, 0x94, 0x66, 0x33, 0x13, 0x9a, 0xc9, 0xba, 0x59, 0xaa, 0xde,
-	0x6e, 0xa9, 0x4a, 0x26, 0xcc, 0x83, 0x2f, 0xbf, 0xf7, 0xec, 0x45, 0x56, 0x7a, 0xfe, 0x22, 0x2b,
-	0xfd, 0xf2, 0x22, 0x2b, 0x3d, 0x7a, 0x99, 0x9d, 0x7b, 0xfe, 0x32, 0x3b, 0xf7, 0xe3, 0xcb, 0xec,
-	0xdc, 0xbd, 0xcb, 0x1d, 0x8b, 0x1e, 0xf4, 0xf7, 0x0b, 0x6d, 0x62, 0x8b, 0x5f, 0x5b, 0xc5, 0x91,
-	0x6f, 0xa6, 0x0f, 0xf8, 0x8f, 0xc1, 0xfd, 0x28, 0x6b, 0xc7, 0xeb, 0x7f, 0x05, 0x00, 0x00, 0xff,
-	0xff, 0x98, 0xe9, 0x54, 0xdb, 0x23, 0x0e, 0x00, 0x00,
+	// 1441 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x57, 0x4d, 0x6c, 0x1b, 0xc5,
+	0x17, 0xcf, 0xfa, 0x2b, 0xf6, 0x73, 0x62, 0xbb, 0xd3, 0xfc, 0x9b, 0x4d, 0xd2, 0xbf, 0x1d, 0xdc,
+	0x0a, 0xa2, 0xa0, 0xda, 0x6d, 0x2a, 0x81, 0xd4, 0x03, 0xc2, 0x76, 0xb6, 0xd4, 0x51, 0x1b, 0x5b,
+	0xeb, 0x75, 0x42, 0x7b, 0x59, 0x6d, 0xbc, 0x53, 0x67, 0x85, 0x77, 0xc7, 0xec, 0x8e, 0x93, 0xfa,
+	0xc6, 0xb1, 0xe2, 0x42, 0x8f, 0x5c, 0x90, 0x2a, 0x71, 0xe1, 0xd8, 0x43, 0x4f, 0xdc, 0x40, 0x20,
+	0x55, 0x1c, 0x50, 0xc5, 0x89, 0x13, 0xa0, 0xf6, 0x50, 0x4e, 0x9c, 0xb8, 0x82, 0xd0, 0xce, 0xcc,
+	0x26, 0xfe, 0x48, 0x5c, 0x5c, 0x55, 0x5c, 0xaa, 0xce, 0xfc, 0x7e, 0xef, 0xcd, 0xfb, 0xbd, 0xaf,
+	0x75, 0x60, 0xa5, 0x45, 0x3c, 0x9b, 0x78, 0xc5, 0xb6, 0x4b, 0x7a, 0xdd, 0xe2, 0xc1, 0x95, 0x22,
+	0xed, 0x77, 0xb1, 0x57, 0xe8, 0xba, 0x84, 0x12, 0x94, 0xe6, 0x60, 0x81, 0x81, 0x85, 0x83, 0x2b,
+	0xcb, 0x0b, 0x6d, 0xd2, 0x26, 0x0c, 0x2b, 0xfa, 0xff, 0xe3, 0xb4, 0xe5, 0x6c, 0x9b, 0x90, 0x76,
+	0x07, 0x17, 0xd9, 0x69, 0xaf, 0x77, 0xb7, 0x68, 0xf6, 0x5c, 0x83, 0x5a, 0xc4, 0x11, 0x78, 0x6e,
+	0x14, 0xa7, 0x96, 0x8d, 0x3d, 0x6a, 0xd8, 0x5d, 0x41, 0x58, 0xe2, 0xef, 0xe8, 0xdc, 0xb3, 0x78,
+	0x54, 0x40, 0xa3, 0xb6, 0x86, 0xd3, 0x17, 0xd0, 0x19, 0xc3, 0xb6, 0x1c, 0x52, 0x64, 0xff, 0xf2,
+	0xab, 0xfc, 0x27, 0x21, 0x88, 0xdd, 0xc2, 0xf6, 0x1e, 0x76, 0xd1, 0x06, 0xcc, 0x1a, 0xa6, 0xe9,
+	0x62, 0xcf, 0x93, 0xa5, 0x55, 0x69, 0x2d, 0x51, 0x96, 0x7f, 0x7a, 0x7c, 0x69, 0x41, 0xf8, 0x2e,
+	0x71, 0xa4, 0x41, 0x5d, 0xcb, 0x69, 0xab, 0x01, 0x11, 0x9d, 0x83, 0xd8, 0x21, 0xb6, 0xda, 0xfb,
+	0x54, 0x0e, 0xf9, 0x26, 0xaa, 0x38, 0xa1, 0x65, 0x88, 0xdb, 0x98, 0x1a, 0xa6, 0x41, 0x0d, 0x39,
+	0xcc, 0x90, 0xa3, 0x33, 0xda, 0x84, 0xb8, 0x61, 0x9a, 0xd8, 0xd4, 0x0d, 0x2a, 0x47, 0x56, 0xa5,
+	0xb5, 0xe4, 0xc6, 0x72, 0x81, 0xc7, 0x5c, 0x08, 0x62, 0x2e, 0x68, 0x81, 0xde, 0xf2, 0xfc, 0x93,
+	0x5f, 0x72, 0x33, 0x0f, 0x7e, 0xcd, 0x49, 0x5f, 0xbd, 0x78, 0xb4, 0x2e, 0xb1, 0x97, 0xb1, 0x59,
+	0xa2, 0xe8, 0x06, 0xa4, 0xf9, 0x5b, 0xba, 0xd7, 0xda, 0xc7, 0x66, 0xaf, 0x83, 0xe5, 0x28, 0x73,
+	0x96, 0x2b, 0x8c, 0xd4, 0xa0, 0xb0, 0xcb, 0x78, 0x0d, 0x41, 0x53, 0x53, 0x87, 0x43, 0xe7, 0xfc,
+	0x37, 0x12, 0xcc, 0xf3, 0x14, 0xa8, 0xf8, 0xe3, 0x1e, 0xf6, 0xe8, 0x7f, 0x96, 0x89, 0x13, 0x34,
+	0x44, 0x5e, 0x4d, 0xc3, 0xf7, 0x12, 0xa4, 0x86, 0x29, 0xe8, 0x02, 0xcc, 0x53, 0xc3, 0x6d, 0x63,
+	0xaa, 0x8b, 0xb8, 0x98, 0x14, 0x75, 0x8e, 0x5f, 0x72, 0x32, 0xba, 0x01, 0xe0, 0x51, 0xc3, 0xa5,
+	0xba, 0xdf, 0x60, 0x72, 0x68, 0xda, 0x6a, 0x24, 0x98, 0xb1, 0x0f, 0xfb, 0x55, 0xc5, 0x8e, 0xc9,
+	0xfd, 0x84, 0xa7, 0xae, 0x2a, 0x76, 0x4c, 0x1f, 0xcc, 0x7f, 0x27, 0xc1, 0xa2, 0xb6, 0xef, 0x62,
+	0x6f, 0x9f, 0x74, 0xcc, 0x4d, 0xdc, 0xb2, 0x3c, 0x8b, 0x38, 0x75, 0xd2, 0xb1, 0x5a, 0x7d, 0x74,
+	0x1e, 0x12, 0x34, 0x80, 0x84, 0x98, 0xe3, 0x0b, 0xf4, 0x3e, 0xcc, 0x1e, 0x5a, 0x8e, 0x49, 0x0e,
+	0x3d, 0x21, 0xe3, 0xcd, 0xb1, 0x1c, 0x0e, 0xfb, 0xdb, 0xe5, 0x6c, 0x35, 0x30, 0xbb, 0x56, 0xfd,
+	0xe1, 0xf1, 0xa5, 0xec, 0x64, 0x9b, 0x4f, 0x5f, 0x3c, 0x5a, 0xcf, 0x73, 0xca, 0x25, 0xcf, 0xfc,
+	0xa8, 0x78, 0x4a, 0xa8, 0xf9, 0x27, 0x12, 0xc8, 0x75, 0xec, 0xb6, 0xb0, 0x43, 0x8d, 0x36, 0x1e,
+	0xd1, 0x91, 0x05, 0xe8, 0x1e, 0x61, 0x42, 0xc8, 0xc0, 0xcd, 0x6b, 0x50, 0xb2, 0xf5, 0xef, 0x94,
+	0x5c, 0x18, 0x50, 0x72, 0x5a, 0xb4, 0xf9, 0x6f, 0x25, 0xf8, 0xdf, 0x89, 0xcf, 0xa1, 0x5b, 0x30,
+	0x7f, 0x40, 0xa8, 0xe5, 0xb4, 0xf5, 0x2e, 0x76, 0x2d, 0xc2, 0x6b, 0x92, 0xdc, 0x58, 0x1a, 0x2b,
+	0xfb, 0xa6, 0x58, 0x6e, 0xbc, 0xea, 0x9f, 0x1f, 0x55, 0x7d, 0x8e, 0x9b, 0xd7, 0x99, 0x35, 0xba,
+	0x03, 0x0b, 0xb6, 0xe5, 0xe8, 0xf8, 0x1e, 0x6e, 0xf5, 0x7c, 0x76, 0xe0, 0x35, 0x34, 0xa5, 0x57,
+	0x64, 0x5b, 0x8e, 0x12, 0x38, 0xe1, 0xbe, 0xf3, 0x7f, 0x48, 0x90, 0xf8, 0xc0, 0x4f, 0x44, 0xd5,
+	0xb9, 0x4b, 0x50, 0x0a, 0x42, 0x16, 0x8f, 0x36, 0xa2, 0x86, 0x2c, 0x13, 0x15, 0x20, 0x6a, 0x98,
+	0xb6, 0xe5, 0xc8, 0xa1, 0x97, 0x0c, 0x3b, 0xa7, 0x4d, 0x1c, 0x69, 0x19, 0x66, 0x0f, 0xb0, 0xeb,
+	0x27, 0x8b, 0x8d, 0x72, 0x44, 0x0d, 0x8e, 0xe8, 0x0d, 0x98, 0xa3, 0x84, 0x1a, 0x9d, 0x60, 0x1c,
+	0xa3, 0xcc, 0x32, 0xc9, 0xee, 0x8e, 0xa7, 0xb1, 0xe5, 0x62, 0x83, 0xf2, 0xdd, 0x18, 0x9b, 0x7a,
+	0x1a, 0x85, 0x71, 0x89, 0xe6, 0x6f, 0x43, 0x92, 0xe9, 0x15, 0xab, 0x7d, 0x09, 0xe2, 0xac, 0x0f,
+	0xf4, 0x23, 0xdd, 0xb3, 0xec, 0x5c, 0x35, 0x51, 0x11, 0x62, 0x36, 0x23, 0x89, 0x44, 0x2f, 0x8e,
+	0x35, 0x9b, 0xd8, 0x8d, 0x82, 0x96, 0xff, 0x2b, 0x04, 0x69, 0xe6, 0x9b, 0x77, 0x03, 0xcb, 0xe8,
+	0xab, 0x2c, 0xcc, 0xc1, 0x98, 0x42, 0xc3, 0x31, 0x1d, 0x15, 0x24, 0x3c, 0x7d, 0x41, 0x22, 0xa7,
+	0x17, 0x24, 0x3a, 0x5c, 0x10, 0x03, 0xd2, 0xa6, 0x68, 0x6c, 0xbd, 0xcb, 0xb4, 0x88, 0x94, 0x2f,
+	0x8c, 0xa5, 0xbc, 0xe4, 0xf4, 0xcb, 0xf9, 0x97, 0x0f, 0x95, 0x9a, 0x32, 0x87, 0x47, 0x7d, 0xb8,
+	0xa0, 0xb3, 0xaf, 0x5e, 0xd0, 0x6b, 0xf1, 0xfb, 0x0f, 0x73, 0x33, 0xbf, 0x3f, 0xcc, 0x49, 0xf9,
+	0xbf, 0xa3, 0x10, 0xaf, 0xbb, 0xa4, 0x4b, 0x3c, 0xa3, 0x33, 0xd6, 0xca, 0x5b, 0xb0, 0xc0, 0x93,
+	0xca, 0x05, 0xe9, 0x41, 0x55, 0x5e, 0xd6, 0xd9, 0xa8, 0x7d, 0x5c, 0x51, 0x81, 0x4c, 0x6c, 0xf3,
+	0x77, 0x20, 0xd1, 0x65, 0x31, 0x60, 0xd7, 0x93, 0x23, 0xab, 0xe1, 0x89, 0xce, 0x8f, 0xa9, 0x68,
+	0x0b, 0x92, 0x5e, 0x6f, 0xcf, 0xb6, 0xc4, 0x07, 0x27, 0x3a, 0x6d, 0x46, 0x80, 0x5b, 0xb3, 0x2f,
+	0xce, 0x05, 0x98, 0xe7, 0x5a, 0x83, 0xfa, 0xc6, 0x58, 0x1a, 0xe6, 0xd8, 0xe5, 0x8e, 0x28, 0xf2,
+	0xe5, 0x91, 0x84, 0x04, 0xdc, 0x59, 0xc6, 0x1d, 0x94, 0x1d, 0x58, 0xbc, 0x0b, 0x31, 0x8f, 0x1a,
+	0xb4, 0xe7, 0xc9, 0xf1, 0x55, 0x69, 0x2d, 0x75, 0xc2, 0xb7, 0x38, 0xc8, 0x7e, 0x83, 0xd1, 0x54,
+	0x41, 0x47, 0x4d, 0x40, 0x77, 0x2d, 0xc7, 0xe8, 0xe8, 0xd4, 0xe8, 0x74, 0xfa, 0xba, 0x8b, 0xbd,
+	0x5e, 0x87, 0xca, 0x09, 0x26, 0xf1, 0xfc, 0x98, 0x13, 0xcd, 0x27, 0xa9, 0x8c, 0x53, 0x4e, 0xf8,
+	0x22, 0xb9, 0xc0, 0x0c, 0x73, 0x31, 0x00, 0xa2, 0x26, 0x9c, 0x19, 0x5a, 0xb3, 0x3a, 0x76, 0x4c,
+	0x19, 0xa6, 0x4d, 0x5c, 0x7a, 0x70, 0xd7, 0x2a, 0x8e, 0x89, 0xea, 0x90, 0xe6, 0xab, 0x96, 0xb8,
+	0x41, 0xa8, 0x49, 0xa6, 0xf7, 0xad, 0x53, 0xf5, 0x2a, 0x82, 0xcf, 0x03, 0x53, 0x53, 0x78, 0xe8,
+	0x8c, 0x2e, 0xfb, 0xfd, 0xe2, 0x79, 0x46, 0x1b, 0x7b, 0xf2, 0xdc, 0x6a, 0xf8, 0xb4, 0x41, 0x52,
+	0x8f, 0x58, 0x68, 0x01, 0xa2, 0xd4, 0xa2, 0x1d, 0x2c, 0xcf, 0xb3, 0xf6, 0xe2, 0x07, 0x7f, 0x62,
+	0xbd, 0x9e, 0x6d, 0x1b, 0x6e, 0x5f, 0x4e, 0xb1, 0xfb, 0xe0, 0x78, 0x2d, 0xe2, 0x0f, 0x41, 0xfe,
+	0x0b, 0x09, 0x92, 0x83, 0x09, 0x5a, 0x81, 0x44, 0x1f, 0x7b, 0x7a, 0x8b, 0xf4, 0x9c, 0xe0, 0x47,
+	0x4e, 0xbc, 0x8f, 0xbd, 0x8a, 0x7f, 0xf6, 0x9b, 0xc4, 0xd8, 0xf3, 0xa8, 0x61, 0x39, 0x82, 0xc0,
+	0x7f, 0x9d, 0xcd, 0x89, 0x4b, 0x4e, 0x5a, 0x82, 0xb8, 0x43, 0x04, 0xce, 0x3b, 0x7d, 0xd6, 0x21,
+	0x1c, 0x7a, 0x1b, 0x90, 0x43, 0xf4, 0x43, 0x8b, 0xee, 0xeb, 0x07, 0x98, 0x06, 0x24, 0xbe, 0x64,
+	0xd2, 0x0e, 0xd9, 0xb5, 0xe8, 0xfe, 0x0e, 0xa6, 0x9c, 0x2c, 0xe2, 0xfb, 0x53, 0x82, 0xc8, 0x0e,
+	0xa1, 0x18, 0xe5, 0x20, 0xd9, 0x15, 0xa9, 0x3b, 0x5e, 0xbc, 0x10, 0x5c, 0xf1, 0x3d, 0x77, 0x40,
+	0xa8, 0x58, 0xbd, 0x13, 0xf7, 0x1c, 0xa3, 0xa1, 0xab, 0x10, 0x23, 0x5d, 0xff, 0xb3, 0xc6, 0xa2,
+	0x4c, 0x6d, 0xac, 0x8c, 0x95, 0xca, 0x7f, 0xb7, 0xc6, 0x28, 0xaa, 0xa0, 0x4e, 0x5c, 0x8e, 0xaf,
+	0x71, 0x1c, 0xd7, 0x3f, 0x93, 0x00, 0x8e, 0x9f, 0x47, 0x2b, 0xb0, 0xb8, 0x53, 0xd3, 0x14, 0xbd,
+	0x56, 0xd7, 0xaa, 0xb5, 0x6d, 0xbd, 0xb9, 0xdd, 0xa8, 0x2b, 0x95, 0xea, 0xf5, 0xaa, 0xb2, 0x99,
+	0x99, 0x41, 0x67, 0x21, 0x3d, 0x08, 0xde, 0x56, 0x1a, 0x19, 0x09, 0x2d, 0xc2, 0xd9, 0xc1, 0xcb,
+	0x52, 0xb9, 0xa1, 0x95, 0xaa, 0xdb, 0x99, 0x10, 0x42, 0x90, 0x1a, 0x04, 0xb6, 0x6b, 0x99, 0x30,
+	0x3a, 0x0f, 0xf2, 0xf0, 0x9d, 0xbe, 0x5b, 0xd5, 0x6e, 0xe8, 0x3b, 0x8a, 0x56, 0xcb, 0x44, 0x96,
+	0x23, 0xf7, 0xbf, 0xcc, 0xce, 0xac, 0xff, 0x28, 0x41, 0x6a, 0x78, 0x56, 0x51, 0x0e, 0x56, 0xea,
+	0x6a, 0xad, 0x5e, 0x6b, 0x94, 0x6e, 0xea, 0x0d, 0xad, 0xa4, 0x35, 0x1b, 0x23, 0x91, 0xfd, 0x1f,
+	0x96, 0x46, 0x09, 0x8d, 0x66, 0xf9, 0x56, 0x55, 0xd3, 0x94, 0xcd, 0x8c, 0xe4, 0x3f, 0x3b, 0x0a,
+	0x97, 0x2a, 0x15, 0xa5, 0xee, 0xa3, 0xa1, 0x93, 0x50, 0x55, 0xd9, 0x52, 0x2a, 0x3e, 0x1a, 0xf6,
+	0x33, 0x32, 0x66, 0x5b, 0xae, 0xa9, 0x3e, 0x18, 0x39, 0xe9, 0x5d, 0x5f, 0xd0, 0xa6, 0x5a, 0xda,
+	0xdd, 0xce, 0x44, 0x85, 0xa0, 0xaf, 0x25, 0x38, 0x77, 0xf2, 0x30, 0xa2, 0x35, 0xb8, 0x78, 0x64,
+	0xaf, 0x7c, 0xa8, 0x54, 0x9a, 0x5a, 0x4d, 0xd5, 0x55, 0xa5, 0xd1, 0xbc, 0xa9, 0x8d, 0x28, 0xbc,
+	0x08, 0xab, 0xa7, 0x32, 0xb7, 0x6b, 0x9a, 0xae, 0x36, 0xb7, 0x33, 0xd2, 0x44, 0x56, 0xa3, 0x59,
+	0xa9, 0x28, 0x8d, 0x46, 0x26, 0x34, 0x91, 0x75, 0xbd, 0x54, 0xbd, 0xd9, 0x54, 0x95, 0x4c, 0x98,
+	0x07, 0x5f, 0x7e, 0xef, 0xc9, 0xb3, 0xac, 0xf4, 0xf4, 0x59, 0x56, 0xfa, 0xed, 0x59, 0x56, 0x7a,
+	0xf0, 0x3c, 0x3b, 0xf3, 0xf4, 0x79, 0x76, 0xe6, 0xe7, 0xe7, 0xd9, 0x99, 0x3b, 0x17, 0xdb, 0x16,
+	0xdd, 0xef, 0xed, 0x15, 0x5a, 0xc4, 0x16, 0x7f, 0xca, 0x16, 0x07, 0x7e, 0x99, 0xde, 0xe3, 0x7f,
+	0x69, 0xef, 0xc5, 0x58, 0x3b, 0x5e, 0xfd, 0x67, 0x00, 0x4b, 0x6d, 0x93, 0xf6, 0x80, 0x0f, 0x00,
+	0x00,
 }
 
 func (this *GroupPolicyInfo) Equal(that interface{}) bool {
@@ -1066,12 +1169,24 @@ func (m *Member) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
-	n1, err1 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.AddedAt, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.AddedAt):])
-	if err1 != nil {
-		return 0, err1
+	if m.WeightSchedule != nil {
+		{
+			size, err := m.WeightSchedule.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTypes(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x2a
 	}
-	i -= n1
-	i = encodeVarintTypes(dAtA, i, uint64(n1))
+	n2, err2 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.AddedAt, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.AddedAt):])
+	if err2 != nil {
+		return 0, err2
+	}
+	i -= n2
+	i = encodeVarintTypes(dAtA, i, uint64(n2))
 	i--
 	dAtA[i] = 0x22
 	if len(m.Metadata) > 0 {
@@ -1118,6 +1233,18 @@ func (m *MemberRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.WeightSchedule != nil {
+		{
+			size, err := m.WeightSchedule.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTypes(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
+	}
 	if len(m.Metadata) > 0 {
 		i -= len(m.Metadata)
 		copy(dAtA[i:], m.Metadata)
@@ -1142,6 +1269,52 @@ func (m *MemberRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *WeightSchedule) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *WeightSchedule) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *WeightSchedule) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	n4, err4 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.EndTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.EndTime):])
+	if err4 != nil {
+		return 0, err4
+	}
+	i -= n4
+	i = encodeVarintTypes(dAtA, i, uint64(n4))
+	i--
+	dAtA[i] = 0x1a
+	n5, err5 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.StartTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.StartTime):])
+	if err5 != nil {
+		return 0, err5
+	}
+	i -= n5
+	i = encodeVarintTypes(dAtA, i, uint64(n5))
+	i--
+	dAtA[i] = 0x12
+	if len(m.TargetWeight) > 0 {
+		i -= len(m.TargetWeight)
+		copy(dAtA[i:], m.TargetWeight)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.TargetWeight)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *ThresholdDecisionPolicy) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -1246,20 +1419,20 @@ func (m *DecisionPolicyWindows) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
-	n4, err4 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.MinExecutionPeriod, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.MinExecutionPeriod):])
-	if err4 != nil {
-		return 0, err4
+	n8, err8 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.MinExecutionPeriod, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.MinExecutionPeriod):])
+	if err8 != nil {
+		return 0, err8
 	}
-	i -= n4
-	i = encodeVarintTypes(dAtA, i, uint64(n4))
+	i -= n8
+	i = encodeVarintTypes(dAtA, i, uint64(n8))
 	i--
 	dAtA[i] = 0x12
-	n5, err5 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.VotingPeriod, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.VotingPeriod):])
-	if err5 != nil {
-		return 0, err5
+	n9, err9 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.VotingPeriod, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.VotingPeriod):])
+	if err9 != nil {
+		return 0, err9
 	}
-	i -= n5
-	i = encodeVarintTypes(dAtA, i, uint64(n5))
+	i -= n9
+	i = encodeVarintTypes(dAtA, i, uint64(n9))
 	i--
 	dAtA[i] = 0xa
 	return len(dAtA) - i, nil
@@ -1285,12 +1458,12 @@ func (m *GroupInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
-	n6, err6 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.CreatedAt, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.CreatedAt):])
-	if err6 != nil {
-		return 0, err6
+	n10, err10 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.CreatedAt, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.CreatedAt):])
+	if err10 != nil {
+		return 0, err10
 	}
-	i -= n6
-	i = encodeVarintTypes(dAtA, i, uint64(n6))
+	i -= n10
+	i = encodeVarintTypes(dAtA, i, uint64(n10))
 	i--
 	dAtA[i] = 0x32
 	if len(m.TotalWeight) > 0 {
@@ -1387,12 +1560,12 @@ func (m *GroupPolicyInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
-	n8, err8 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.CreatedAt, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.CreatedAt):])
-	if err8 != nil {
-		return 0, err8
+	n12, err12 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.CreatedAt, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.CreatedAt):])
+	if err12 != nil {
+		return 0, err12
 	}
-	i -= n8
-	i = encodeVarintTypes(dAtA, i, uint64(n8))
+	i -= n12
+	i = encodeVarintTypes(dAtA, i, uint64(n12))
 	i--
 	dAtA[i] = 0x3a
 	if m.DecisionPolicy != nil {
@@ -1494,12 +1667,12 @@ func (m *Proposal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i--
 		dAtA[i] = 0x58
 	}
-	n10, err10 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.VotingPeriodEnd, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.VotingPeriodEnd):])
-	if err10 != nil {
-		return 0, err10
+	n14, err14 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.VotingPeriodEnd, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.VotingPeriodEnd):])
+	if err14 != nil {
+		return 0, err14
 	}
-	i -= n10
-	i = encodeVarintTypes(dAtA, i, uint64(n10))
+	i -= n14
+	i = encodeVarintTypes(dAtA, i, uint64(n14))
 	i--
 	dAtA[i] = 0x52
 	{
@@ -1527,12 +1700,12 @@ func (m *Proposal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i--
 		dAtA[i] = 0x30
 	}
-	n12, err12 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.SubmitTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.SubmitTime):])
-	if err12 != nil {
-		return 0, err12
+	n16, err16 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.SubmitTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.SubmitTime):])
+	if err16 != nil {
+		return 0, err16
 	}
-	i -= n12
-	i = encodeVarintTypes(dAtA, i, uint64(n12))
+	i -= n16
+	i = encodeVarintTypes(dAtA, i, uint64(n16))
 	i--
 	dAtA[i] = 0x2a
 	if len(m.Proposers) > 0 {
@@ -1637,12 +1810,12 @@ func (m *Vote) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
-	n13, err13 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.SubmitTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.SubmitTime):])
-	if err13 != nil {
-		return 0, err13
+	n17, err17 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.SubmitTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.SubmitTime):])
+	if err17 != nil {
+		return 0, err17
 	}
-	i -= n13
-	i = encodeVarintTypes(dAtA, i, uint64(n13))
+	i -= n17
+	i = encodeVarintTypes(dAtA, i, uint64(n17))
 	i--
 	dAtA[i] = 0x2a
 	if len(m.Metadata) > 0 {
@@ -1703,6 +1876,10 @@ func (m *Member) Size() (n int) {
 	}
 	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.AddedAt)
 	n += 1 + l + sovTypes(uint64(l))
+	if m.WeightSchedule != nil {
+		l = m.WeightSchedule.Size()
+		n += 1 + l + sovTypes(uint64(l))
+	}
 	return n
 }
 
@@ -1724,6 +1901,27 @@ func (m *MemberRequest) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovTypes(uint64(l))
 	}
+	if m.WeightSchedule != nil {
+		l = m.WeightSchedule.Size()
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	return n
+}
+
+func (m *WeightSchedule) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.TargetWeight)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.StartTime)
+	n += 1 + l + sovTypes(uint64(l))
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.EndTime)
+	n += 1 + l + sovTypes(uint64(l))
 	return n
 }
 
@@ -2124,6 +2322,42 @@ func (m *Member) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WeightSchedule", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.WeightSchedule == nil {
+				m.WeightSchedule = &WeightSchedule{}
+			}
+			if err := m.WeightSchedule.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTypes(dAtA[iNdEx:])
@@ -2270,6 +2504,190 @@ func (m *MemberRequest) Unmarshal(dAtA []byte) error {
 			}
 			m.Metadata = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WeightSchedule", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.WeightSchedule == nil {
+				m.WeightSchedule = &WeightSchedule{}
+			}
+			if err := m.WeightSchedule.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *WeightSchedule) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: WeightSchedule: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: WeightSchedule: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TargetWeight", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TargetWeight = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.StartTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EndTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.EndTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTypes(dAtA[iNdEx:])
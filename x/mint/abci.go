@@ -33,8 +33,9 @@ func BeginBlocker(ctx sdk.Context, k keeper.Keeper, ic types.InflationCalculatio
 		return err
 	}
 
-	// send the minted coins to the fee collector account
-	err = k.AddCollectedFees(ctx, mintedCoins)
+	// send the minted coins to the mint destinations, or the fee collector
+	// account if none are set
+	err = k.DistributeMintedCoins(ctx, params, mintedCoins)
 	if err != nil {
 		return err
 	}
@@ -45,13 +45,14 @@ func TestGetCmdQueryParams(t *testing.T) {
 			"json output",
 			[]string{fmt.Sprintf("--%s=1", flags.FlagHeight), fmt.Sprintf("--%s=json", flags.FlagOutput)},
 			`[--height=1 --output=json]`,
-			`{"mint_denom":"","inflation_rate_change":"0","inflation_max":"0","inflation_min":"0","goal_bonded":"0","blocks_per_year":"0"}`,
+			`{"mint_denom":"","inflation_rate_change":"0","inflation_max":"0","inflation_min":"0","goal_bonded":"0","blocks_per_year":"0","destinations":[]}`,
 		},
 		{
 			"text output",
 			[]string{fmt.Sprintf("--%s=1", flags.FlagHeight), fmt.Sprintf("--%s=text", flags.FlagOutput)},
 			`[--height=1 --output=text]`,
 			`blocks_per_year: "0"
+destinations: []
 goal_bonded: "0"
 inflation_max: "0"
 inflation_min: "0"
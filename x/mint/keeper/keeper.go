@@ -1,6 +1,7 @@
 package keeper
 
 import (
+	"errors"
 	"fmt"
 
 	"cosmossdk.io/log"
@@ -18,7 +19,9 @@ type Keeper struct {
 	cdc              codec.BinaryCodec
 	storeKey         storetypes.StoreKey
 	stakingKeeper    types.StakingKeeper
+	accountKeeper    types.AccountKeeper
 	bankKeeper       types.BankKeeper
+	distrKeeper      types.DistributionKeeper
 	feeCollectorName string
 
 	// the address capable of executing a MsgUpdateParams message. Typically, this
@@ -33,6 +36,7 @@ func NewKeeper(
 	sk types.StakingKeeper,
 	ak types.AccountKeeper,
 	bk types.BankKeeper,
+	dk types.DistributionKeeper,
 	feeCollectorName string,
 	authority string,
 ) Keeper {
@@ -45,7 +49,9 @@ func NewKeeper(
 		cdc:              cdc,
 		storeKey:         key,
 		stakingKeeper:    sk,
+		accountKeeper:    ak,
 		bankKeeper:       bk,
+		distrKeeper:      dk,
 		feeCollectorName: feeCollectorName,
 		authority:        authority,
 	}
@@ -129,3 +135,60 @@ func (k Keeper) MintCoins(ctx sdk.Context, newCoins sdk.Coins) error {
 func (k Keeper) AddCollectedFees(ctx sdk.Context, fees sdk.Coins) error {
 	return k.bankKeeper.SendCoinsFromModuleToModule(ctx, types.ModuleName, k.feeCollectorName, fees)
 }
+
+// DistributeMintedCoins sends the minted coins to the mint destinations in
+// the params, or to the fee collector when no destinations are set.
+func (k Keeper) DistributeMintedCoins(ctx sdk.Context, params types.Params, mintedCoins sdk.Coins) error {
+	if len(params.Destinations) == 0 {
+		return k.AddCollectedFees(ctx, mintedCoins)
+	}
+
+	split := params.SplitMintedCoins(mintedCoins)
+	for i, d := range params.Destinations {
+		if split[i].IsZero() {
+			continue
+		}
+
+		if err := k.sendToDestination(ctx, d.ModuleName, split[i]); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// sendToDestination sends coins from the mint module account to a mint
+// destination.
+func (k Keeper) sendToDestination(ctx sdk.Context, destination string, coins sdk.Coins) error {
+	if destination == types.CommunityPoolDestination {
+		if k.distrKeeper == nil {
+			return errors.New("cannot fund the community pool: the distribution keeper is not set")
+		}
+
+		return k.distrKeeper.FundCommunityPool(ctx, coins, k.accountKeeper.GetModuleAddress(types.ModuleName))
+	}
+
+	if k.accountKeeper.GetModuleAddress(destination) == nil {
+		return fmt.Errorf("mint destination module account %s does not exist", destination)
+	}
+
+	return k.bankKeeper.SendCoinsFromModuleToModule(ctx, types.ModuleName, destination, coins)
+}
+
+// ValidateDestinations checks that the mint destinations can receive coins.
+func (k Keeper) ValidateDestinations(destinations []types.MintDestination) error {
+	for _, d := range destinations {
+		if d.ModuleName == types.CommunityPoolDestination {
+			if k.distrKeeper == nil {
+				return errors.New("cannot fund the community pool: the distribution keeper is not set")
+			}
+			continue
+		}
+
+		if k.accountKeeper.GetModuleAddress(d.ModuleName) == nil {
+			return fmt.Errorf("mint destination module account %s does not exist", d.ModuleName)
+		}
+	}
+
+	return nil
+}
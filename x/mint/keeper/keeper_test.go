@@ -27,7 +27,9 @@ type IntegrationTestSuite struct {
 	ctx           sdk.Context
 	msgServer     types.MsgServer
 	stakingKeeper *minttestutil.MockStakingKeeper
+	accountKeeper *minttestutil.MockAccountKeeper
 	bankKeeper    *minttestutil.MockBankKeeper
+	distrKeeper   *minttestutil.MockDistributionKeeper
 }
 
 func TestKeeperTestSuite(t *testing.T) {
@@ -45,6 +47,7 @@ func (s *IntegrationTestSuite) SetupTest() {
 	accountKeeper := minttestutil.NewMockAccountKeeper(ctrl)
 	bankKeeper := minttestutil.NewMockBankKeeper(ctrl)
 	stakingKeeper := minttestutil.NewMockStakingKeeper(ctrl)
+	distrKeeper := minttestutil.NewMockDistributionKeeper(ctrl)
 
 	accountKeeper.EXPECT().GetModuleAddress(types.ModuleName).Return(sdk.AccAddress{})
 
@@ -54,11 +57,14 @@ func (s *IntegrationTestSuite) SetupTest() {
 		stakingKeeper,
 		accountKeeper,
 		bankKeeper,
+		distrKeeper,
 		authtypes.FeeCollectorName,
 		authtypes.NewModuleAddress(govtypes.ModuleName).String(),
 	)
 	s.stakingKeeper = stakingKeeper
+	s.accountKeeper = accountKeeper
 	s.bankKeeper = bankKeeper
+	s.distrKeeper = distrKeeper
 
 	s.Require().Equal(testCtx.Ctx.Logger().With("module", "x/"+types.ModuleName),
 		s.mintKeeper.Logger(testCtx.Ctx))
@@ -139,3 +145,35 @@ func (s *IntegrationTestSuite) TestAliasFunctions() {
 	s.bankKeeper.EXPECT().SendCoinsFromModuleToModule(s.ctx, types.ModuleName, authtypes.FeeCollectorName, fees).Return(nil)
 	s.Require().Nil(s.mintKeeper.AddCollectedFees(s.ctx, fees))
 }
+
+func (s *IntegrationTestSuite) TestDistributeMintedCoins() {
+	minted := sdk.NewCoins(sdk.NewCoin("stake", math.NewInt(1001)))
+
+	// without destinations all the minted coins go to the fee collector
+	params := types.DefaultParams()
+	s.bankKeeper.EXPECT().SendCoinsFromModuleToModule(s.ctx, types.ModuleName, authtypes.FeeCollectorName, minted).Return(nil)
+	s.Require().NoError(s.mintKeeper.DistributeMintedCoins(s.ctx, params, minted))
+
+	// the minted coins are split by ratio, with the remainder sent to the
+	// first destination
+	mintAddr := authtypes.NewModuleAddress(types.ModuleName)
+	params.Destinations = []types.MintDestination{
+		{ModuleName: authtypes.FeeCollectorName, Ratio: math.LegacyNewDecWithPrec(6, 1)},
+		{ModuleName: types.CommunityPoolDestination, Ratio: math.LegacyNewDecWithPrec(3, 1)},
+		{ModuleName: "incentives", Ratio: math.LegacyNewDecWithPrec(1, 1)},
+	}
+	s.Require().NoError(params.Validate())
+
+	s.accountKeeper.EXPECT().GetModuleAddress(authtypes.FeeCollectorName).Return(authtypes.NewModuleAddress(authtypes.FeeCollectorName))
+	s.bankKeeper.EXPECT().SendCoinsFromModuleToModule(s.ctx, types.ModuleName, authtypes.FeeCollectorName, sdk.NewCoins(sdk.NewCoin("stake", math.NewInt(601)))).Return(nil)
+	s.accountKeeper.EXPECT().GetModuleAddress(types.ModuleName).Return(mintAddr)
+	s.distrKeeper.EXPECT().FundCommunityPool(s.ctx, sdk.NewCoins(sdk.NewCoin("stake", math.NewInt(300))), mintAddr).Return(nil)
+	s.accountKeeper.EXPECT().GetModuleAddress("incentives").Return(authtypes.NewModuleAddress("incentives"))
+	s.bankKeeper.EXPECT().SendCoinsFromModuleToModule(s.ctx, types.ModuleName, "incentives", sdk.NewCoins(sdk.NewCoin("stake", math.NewInt(100)))).Return(nil)
+	s.Require().NoError(s.mintKeeper.DistributeMintedCoins(s.ctx, params, minted))
+
+	// unknown module accounts are rejected
+	params.Destinations = []types.MintDestination{{ModuleName: "unknown", Ratio: math.LegacyOneDec()}}
+	s.accountKeeper.EXPECT().GetModuleAddress("unknown").Return(nil)
+	s.Require().Error(s.mintKeeper.DistributeMintedCoins(s.ctx, params, minted))
+}
@@ -13,6 +13,10 @@ const (
 	// StoreKey is the default store key for mint
 	StoreKey = ModuleName
 
+	// CommunityPoolDestination is the mint destination funding the community
+	// pool
+	CommunityPoolDestination = "community_pool"
+
 	// Query endpoints supported by the minting querier
 	QueryParameters       = "parameters"
 	QueryInflation        = "inflation"
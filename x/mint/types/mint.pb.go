@@ -81,6 +81,11 @@ type Params struct {
 	GoalBonded github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,5,opt,name=goal_bonded,json=goalBonded,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"goal_bonded"`
 	// expected blocks per year
 	BlocksPerYear uint64 `protobuf:"varint,6,opt,name=blocks_per_year,json=blocksPerYear,proto3" json:"blocks_per_year,omitempty"`
+	// destinations the minted coins are split across. When empty, all the minted
+	// coins are sent to the fee collector.
+	//
+	// Since: cosmos-sdk 0.48
+	Destinations []MintDestination `protobuf:"bytes,7,rep,name=destinations,proto3" json:"destinations"`
 }
 
 func (m *Params) Reset()         { *m = Params{} }
@@ -130,42 +135,106 @@ func (m *Params) GetBlocksPerYear() uint64 {
 	return 0
 }
 
+func (m *Params) GetDestinations() []MintDestination {
+	if m != nil {
+		return m.Destinations
+	}
+	return nil
+}
+
+// MintDestination defines a recipient of a share of the coins minted each
+// block.
+//
+// Since: cosmos-sdk 0.48
+type MintDestination struct {
+	// module_name is the name of the module account receiving the coins, or
+	// "community_pool" to fund the community pool.
+	ModuleName string `protobuf:"bytes,1,opt,name=module_name,json=moduleName,proto3" json:"module_name,omitempty"`
+	// ratio is the share of the minted coins sent to the destination.
+	Ratio github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,2,opt,name=ratio,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"ratio"`
+}
+
+func (m *MintDestination) Reset()         { *m = MintDestination{} }
+func (m *MintDestination) String() string { return proto.CompactTextString(m) }
+func (*MintDestination) ProtoMessage()    {}
+func (*MintDestination) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2df116d183c1e223, []int{2}
+}
+func (m *MintDestination) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MintDestination) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MintDestination.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MintDestination) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MintDestination.Merge(m, src)
+}
+func (m *MintDestination) XXX_Size() int {
+	return m.Size()
+}
+func (m *MintDestination) XXX_DiscardUnknown() {
+	xxx_messageInfo_MintDestination.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MintDestination proto.InternalMessageInfo
+
+func (m *MintDestination) GetModuleName() string {
+	if m != nil {
+		return m.ModuleName
+	}
+	return ""
+}
+
 func init() {
 	proto.RegisterType((*Minter)(nil), "cosmos.mint.v1beta1.Minter")
 	proto.RegisterType((*Params)(nil), "cosmos.mint.v1beta1.Params")
+	proto.RegisterType((*MintDestination)(nil), "cosmos.mint.v1beta1.MintDestination")
 }
 
 func init() { proto.RegisterFile("cosmos/mint/v1beta1/mint.proto", fileDescriptor_2df116d183c1e223) }
 
 var fileDescriptor_2df116d183c1e223 = []byte{
-	// 423 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x93, 0x4f, 0x8f, 0xd2, 0x40,
-	0x18, 0xc6, 0x3b, 0x8a, 0x4d, 0x18, 0xdd, 0xe8, 0xce, 0x6a, 0x52, 0x37, 0xd9, 0xee, 0x66, 0x0f,
-	0x04, 0x49, 0x68, 0x43, 0xbc, 0x19, 0x2f, 0x02, 0x57, 0x12, 0xd2, 0x9b, 0x5c, 0x9a, 0x69, 0x3b,
-	0x94, 0x09, 0xed, 0x0c, 0x99, 0x19, 0x08, 0x7c, 0x05, 0x4f, 0x5e, 0xfd, 0x06, 0x1e, 0x39, 0xf8,
-	0x21, 0xb8, 0x49, 0x3c, 0x19, 0x0f, 0xc4, 0xc0, 0x81, 0xaf, 0x61, 0x3a, 0xd3, 0x14, 0xe3, 0xc1,
-	0x8b, 0x78, 0xe9, 0x9f, 0xe7, 0x79, 0xfb, 0x7b, 0x9e, 0x36, 0x7d, 0xa1, 0x1b, 0x73, 0x99, 0x73,
-	0xe9, 0xe7, 0x94, 0x29, 0x7f, 0xd1, 0x89, 0x88, 0xc2, 0x1d, 0x7d, 0xe3, 0xcd, 0x04, 0x57, 0x1c,
-	0x5d, 0x19, 0xdf, 0xd3, 0x52, 0xe9, 0x5f, 0x3f, 0x4f, 0x79, 0xca, 0xb5, 0xef, 0x17, 0x57, 0x66,
-	0xf4, 0xfa, 0xa5, 0x19, 0x0d, 0x8d, 0x51, 0x3e, 0x67, 0xac, 0x4b, 0x9c, 0x53, 0xc6, 0x7d, 0x7d,
-	0x34, 0xd2, 0xfd, 0x57, 0x00, 0xed, 0x01, 0x65, 0x8a, 0x08, 0x34, 0x82, 0x75, 0xca, 0xc6, 0x19,
-	0x56, 0x94, 0x33, 0x07, 0xdc, 0x81, 0x66, 0xbd, 0xfb, 0x76, 0xb3, 0xbb, 0xb5, 0x7e, 0xec, 0x6e,
-	0x1b, 0x29, 0x55, 0x93, 0x79, 0xe4, 0xc5, 0x3c, 0x2f, 0x89, 0xe5, 0xa9, 0x2d, 0x93, 0xa9, 0xaf,
-	0x56, 0x33, 0x22, 0xbd, 0x3e, 0x89, 0xbf, 0x7d, 0x69, 0xc3, 0x32, 0xb0, 0x4f, 0xe2, 0xe0, 0x84,
-	0x43, 0x14, 0x5e, 0x62, 0xc6, 0xe6, 0x38, 0x2b, 0x6a, 0x2d, 0xa8, 0xa4, 0x9c, 0x49, 0xe7, 0xc1,
-	0x19, 0x32, 0x9e, 0x19, 0xec, 0xb0, 0xa2, 0xde, 0x7f, 0xaa, 0x41, 0x7b, 0x88, 0x05, 0xce, 0x25,
-	0xba, 0x81, 0xb0, 0xf8, 0x60, 0x61, 0x42, 0x18, 0xcf, 0xcd, 0x2b, 0x05, 0xf5, 0x42, 0xe9, 0x17,
-	0x02, 0x9a, 0xc3, 0x17, 0x55, 0xc3, 0x50, 0x60, 0x45, 0xc2, 0x78, 0x82, 0x59, 0x4a, 0xca, 0x62,
-	0xef, 0xfe, 0xa5, 0xd8, 0xe7, 0xe3, 0xba, 0x05, 0x82, 0xab, 0x8a, 0x1f, 0x60, 0x45, 0x7a, 0x9a,
-	0x8e, 0xc6, 0xf0, 0xe2, 0x14, 0x9b, 0xe3, 0xa5, 0xf3, 0xf0, 0x5c, 0x71, 0x4f, 0x2a, 0xee, 0x00,
-	0x2f, 0xff, 0xc8, 0xa1, 0xcc, 0xa9, 0xfd, 0x87, 0x1c, 0xca, 0x50, 0x04, 0x1f, 0xa7, 0x1c, 0x67,
-	0x61, 0xc4, 0x59, 0x42, 0x12, 0xe7, 0xd1, 0xb9, 0x52, 0x60, 0x41, 0xed, 0x6a, 0x28, 0x6a, 0xc0,
-	0xa7, 0x51, 0xc6, 0xe3, 0xa9, 0x0c, 0x67, 0x44, 0x84, 0x2b, 0x82, 0x85, 0x63, 0xdf, 0x81, 0x66,
-	0x2d, 0xb8, 0x30, 0xf2, 0x90, 0x88, 0xf7, 0x04, 0x8b, 0x37, 0x37, 0x1f, 0x8e, 0xeb, 0x96, 0xf3,
-	0x5b, 0xc2, 0xd2, 0xac, 0x94, 0xf9, 0x21, 0xba, 0xbd, 0xcd, 0xde, 0x05, 0xdb, 0xbd, 0x0b, 0x7e,
-	0xee, 0x5d, 0xf0, 0xf1, 0xe0, 0x5a, 0xdb, 0x83, 0x6b, 0x7d, 0x3f, 0xb8, 0xd6, 0xe8, 0xd5, 0x5f,
-	0x7b, 0x96, 0x14, 0x5d, 0x37, 0xb2, 0xf5, 0xe6, 0xbc, 0xfe, 0x15, 0x00, 0x00, 0xff, 0xff, 0xe5,
-	0xf6, 0x61, 0x3e, 0xb4, 0x03, 0x00, 0x00,
+	// 494 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x94, 0x31, 0x6f, 0xd3, 0x40,
+	0x14, 0xc7, 0x63, 0x9a, 0x06, 0xe5, 0xd2, 0xaa, 0xf4, 0x0a, 0x92, 0xa9, 0x54, 0x27, 0x8a, 0x50,
+	0x15, 0x2a, 0xd5, 0x56, 0x61, 0x43, 0x2c, 0xa4, 0x59, 0x83, 0x22, 0x33, 0x20, 0xba, 0x58, 0x67,
+	0xfb, 0xd5, 0x3d, 0xd5, 0x77, 0x17, 0xf9, 0x2e, 0x55, 0xfa, 0x15, 0x60, 0xe1, 0x63, 0x30, 0x76,
+	0xe0, 0x43, 0x74, 0xa3, 0x62, 0x42, 0x0c, 0x15, 0x4a, 0x86, 0x6e, 0x7c, 0x06, 0xe4, 0xbb, 0x93,
+	0x5b, 0x45, 0x88, 0x85, 0x74, 0x49, 0xec, 0xff, 0x7b, 0xfe, 0xfd, 0xff, 0xcf, 0x7a, 0x67, 0xe4,
+	0x25, 0x42, 0x32, 0x21, 0x03, 0x46, 0xb9, 0x0a, 0xce, 0x0e, 0x62, 0x50, 0xe4, 0x40, 0xdf, 0xf8,
+	0xe3, 0x42, 0x28, 0x81, 0xb7, 0x4c, 0xdd, 0xd7, 0x92, 0xad, 0x6f, 0x3f, 0xce, 0x44, 0x26, 0x74,
+	0x3d, 0x28, 0xaf, 0x4c, 0xeb, 0xf6, 0x53, 0xd3, 0x1a, 0x99, 0x82, 0x7d, 0xce, 0x94, 0x36, 0x09,
+	0xa3, 0x5c, 0x04, 0xfa, 0xd7, 0x48, 0xdd, 0x6f, 0x0e, 0x6a, 0x0c, 0x29, 0x57, 0x50, 0xe0, 0x23,
+	0xd4, 0xa4, 0xfc, 0x38, 0x27, 0x8a, 0x0a, 0xee, 0x3a, 0x1d, 0xa7, 0xd7, 0xec, 0xbf, 0xbe, 0xbc,
+	0x6e, 0xd7, 0x7e, 0x5e, 0xb7, 0x77, 0x33, 0xaa, 0x4e, 0x26, 0xb1, 0x9f, 0x08, 0x66, 0x89, 0xf6,
+	0x6f, 0x5f, 0xa6, 0xa7, 0x81, 0x3a, 0x1f, 0x83, 0xf4, 0x07, 0x90, 0x7c, 0xff, 0xba, 0x8f, 0xac,
+	0xe1, 0x00, 0x92, 0xf0, 0x16, 0x87, 0x29, 0xda, 0x24, 0x9c, 0x4f, 0x48, 0x5e, 0xc6, 0x3a, 0xa3,
+	0x92, 0x0a, 0x2e, 0xdd, 0x07, 0x4b, 0xf0, 0x78, 0x64, 0xb0, 0xa3, 0x8a, 0xda, 0xfd, 0x5d, 0x47,
+	0x8d, 0x11, 0x29, 0x08, 0x93, 0x78, 0x07, 0xa1, 0xf2, 0x85, 0x45, 0x29, 0x70, 0xc1, 0xcc, 0x48,
+	0x61, 0xb3, 0x54, 0x06, 0xa5, 0x80, 0x27, 0xe8, 0x49, 0x95, 0x30, 0x2a, 0x88, 0x82, 0x28, 0x39,
+	0x21, 0x3c, 0x03, 0x1b, 0xec, 0xcd, 0xff, 0x04, 0xfb, 0x72, 0x73, 0xb1, 0xe7, 0x84, 0x5b, 0x15,
+	0x3f, 0x24, 0x0a, 0x0e, 0x35, 0x1d, 0x1f, 0xa3, 0xf5, 0x5b, 0x5b, 0x46, 0xa6, 0xee, 0xca, 0xb2,
+	0xec, 0xd6, 0x2a, 0xee, 0x90, 0x4c, 0x17, 0x7c, 0x28, 0x77, 0xeb, 0xf7, 0xe0, 0x43, 0x39, 0x8e,
+	0x51, 0x2b, 0x13, 0x24, 0x8f, 0x62, 0xc1, 0x53, 0x48, 0xdd, 0xd5, 0x65, 0xb9, 0xa0, 0x92, 0xda,
+	0xd7, 0x50, 0xbc, 0x8b, 0x36, 0xe2, 0x5c, 0x24, 0xa7, 0x32, 0x1a, 0x43, 0x11, 0x9d, 0x03, 0x29,
+	0xdc, 0x46, 0xc7, 0xe9, 0xd5, 0xc3, 0x75, 0x23, 0x8f, 0xa0, 0xf8, 0x00, 0xa4, 0xc0, 0xef, 0xd0,
+	0x5a, 0x0a, 0x52, 0x51, 0xae, 0xd3, 0x49, 0xf7, 0x61, 0x67, 0xa5, 0xd7, 0x7a, 0xf1, 0xcc, 0xff,
+	0xcb, 0xf1, 0xf1, 0x87, 0x7a, 0x11, 0xaa, 0xe6, 0x7e, 0xb3, 0x8c, 0x6c, 0x07, 0xbc, 0x0b, 0x79,
+	0xb5, 0xf3, 0xf1, 0xe6, 0x62, 0xcf, 0xbd, 0x13, 0x7b, 0x6a, 0xce, 0xa9, 0xd9, 0xb2, 0xee, 0x27,
+	0x07, 0x6d, 0x2c, 0xb0, 0x70, 0x1b, 0xb5, 0x98, 0x48, 0x27, 0x39, 0x44, 0x9c, 0x30, 0xb0, 0xab,
+	0x87, 0x8c, 0xf4, 0x96, 0x30, 0xc0, 0xef, 0xd1, 0x6a, 0x51, 0xb6, 0x2e, 0x6f, 0xd7, 0x0c, 0xaf,
+	0x7f, 0x78, 0x39, 0xf3, 0x9c, 0xab, 0x99, 0xe7, 0xfc, 0x9a, 0x79, 0xce, 0xe7, 0xb9, 0x57, 0xbb,
+	0x9a, 0x7b, 0xb5, 0x1f, 0x73, 0xaf, 0x76, 0xf4, 0xfc, 0x9f, 0x6c, 0x3b, 0x93, 0xb6, 0x88, 0x1b,
+	0xfa, 0xe3, 0xf0, 0xf2, 0xcf, 0x00, 0xc5, 0xa2, 0x02, 0xc0, 0x97, 0x04, 0x00, 0x00,
 }
 
 func (m *Minter) Marshal() (dAtA []byte, err error) {
@@ -231,6 +300,20 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.Destinations) > 0 {
+		for iNdEx := len(m.Destinations) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Destinations[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintMint(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x3a
+		}
+	}
 	if m.BlocksPerYear != 0 {
 		i = encodeVarintMint(dAtA, i, uint64(m.BlocksPerYear))
 		i--
@@ -286,6 +369,46 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *MintDestination) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MintDestination) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MintDestination) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.Ratio.Size()
+		i -= size
+		if _, err := m.Ratio.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintMint(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.ModuleName) > 0 {
+		i -= len(m.ModuleName)
+		copy(dAtA[i:], m.ModuleName)
+		i = encodeVarintMint(dAtA, i, uint64(len(m.ModuleName)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintMint(dAtA []byte, offset int, v uint64) int {
 	offset -= sovMint(v)
 	base := offset
@@ -331,6 +454,27 @@ func (m *Params) Size() (n int) {
 	if m.BlocksPerYear != 0 {
 		n += 1 + sovMint(uint64(m.BlocksPerYear))
 	}
+	if len(m.Destinations) > 0 {
+		for _, e := range m.Destinations {
+			l = e.Size()
+			n += 1 + l + sovMint(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MintDestination) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ModuleName)
+	if l > 0 {
+		n += 1 + l + sovMint(uint64(l))
+	}
+	l = m.Ratio.Size()
+	n += 1 + l + sovMint(uint64(l))
 	return n
 }
 
@@ -674,6 +818,156 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Destinations", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMint
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMint
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMint
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Destinations = append(m.Destinations, MintDestination{})
+			if err := m.Destinations[len(m.Destinations)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMint(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMint
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MintDestination) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMint
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MintDestination: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MintDestination: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ModuleName", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMint
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMint
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMint
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ModuleName = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Ratio", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMint
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMint
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMint
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Ratio.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipMint(dAtA[iNdEx:])
@@ -54,6 +54,9 @@ func (p Params) Validate() error {
 	if err := validateBlocksPerYear(p.BlocksPerYear); err != nil {
 		return err
 	}
+	if err := validateDestinations(p.Destinations); err != nil {
+		return err
+	}
 	if p.InflationMax.LT(p.InflationMin) {
 		return fmt.Errorf(
 			"max inflation (%s) must be greater than or equal to min inflation (%s)",
@@ -156,6 +159,57 @@ func validateGoalBonded(i interface{}) error {
 	return nil
 }
 
+func validateDestinations(destinations []MintDestination) error {
+	if len(destinations) == 0 {
+		return nil
+	}
+
+	total := math.LegacyZeroDec()
+	seen := make(map[string]bool, len(destinations))
+	for _, d := range destinations {
+		if strings.TrimSpace(d.ModuleName) == "" {
+			return errors.New("mint destination module name cannot be blank")
+		}
+		if seen[d.ModuleName] {
+			return fmt.Errorf("duplicate mint destination: %s", d.ModuleName)
+		}
+		seen[d.ModuleName] = true
+
+		if d.Ratio.IsNil() || !d.Ratio.IsPositive() {
+			return fmt.Errorf("mint destination %s ratio must be positive: %s", d.ModuleName, d.Ratio)
+		}
+		total = total.Add(d.Ratio)
+	}
+
+	if !total.Equal(math.LegacyOneDec()) {
+		return fmt.Errorf("mint destination ratios must sum to one: %s", total)
+	}
+
+	return nil
+}
+
+// SplitMintedCoins splits the minted coins across the destinations by their
+// ratios. The amounts are truncated and the remainder is added to the first
+// destination, so that all the minted coins are distributed.
+func (p Params) SplitMintedCoins(minted sdk.Coins) []sdk.Coins {
+	split := make([]sdk.Coins, len(p.Destinations))
+	remainder := minted
+	for i, d := range p.Destinations {
+		share := sdk.NewCoins()
+		for _, coin := range minted {
+			share = share.Add(sdk.NewCoin(coin.Denom, math.LegacyNewDecFromInt(coin.Amount).Mul(d.Ratio).TruncateInt()))
+		}
+		split[i] = share
+		remainder = remainder.Sub(share...)
+	}
+
+	if len(split) > 0 {
+		split[0] = split[0].Add(remainder...)
+	}
+
+	return split
+}
+
 func validateBlocksPerYear(i interface{}) error {
 	v, ok := i.(uint64)
 	if !ok {
@@ -0,0 +1,46 @@
+package payments
+
+import (
+	"fmt"
+
+	autocliv1 "cosmossdk.io/api/cosmos/autocli/v1"
+	paymentsv1 "cosmossdk.io/api/cosmos/payments/v1"
+
+	"github.com/cosmos/cosmos-sdk/version"
+	"github.com/cosmos/cosmos-sdk/x/payments/types"
+)
+
+// AutoCLIOptions implements the autocli.HasAutoCLIConfig interface.
+func (am AppModule) AutoCLIOptions() *autocliv1.ModuleOptions {
+	return &autocliv1.ModuleOptions{
+		Query: &autocliv1.ServiceCommandDescriptor{
+			Service: paymentsv1.Query_ServiceDesc.ServiceName,
+			RpcCommandOptions: []*autocliv1.RpcCommandOptions{
+				{
+					RpcMethod:      "Stream",
+					Use:            "stream [stream-id]",
+					Short:          "Query a payment stream, along with the amount its payee can withdraw.",
+					Example:        fmt.Sprintf(`%s query %s stream 1`, version.AppName, types.ModuleName),
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "stream_id"}},
+				},
+				{
+					RpcMethod:      "StreamsByPayer",
+					Use:            "streams-by-payer [payer]",
+					Short:          "Query the payment streams funded by an account.",
+					Example:        fmt.Sprintf(`%s query %s streams-by-payer cosmos1...`, version.AppName, types.ModuleName),
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "payer"}},
+				},
+				{
+					RpcMethod:      "StreamsByPayee",
+					Use:            "streams-by-payee [payee]",
+					Short:          "Query the payment streams received by an account.",
+					Example:        fmt.Sprintf(`%s query %s streams-by-payee cosmos1...`, version.AppName, types.ModuleName),
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "payee"}},
+				},
+			},
+		},
+		Tx: &autocliv1.ServiceCommandDescriptor{
+			Service: paymentsv1.Msg_ServiceDesc.ServiceName,
+		},
+	}
+}
@@ -0,0 +1,42 @@
+package keeper
+
+import (
+	"context"
+
+	"github.com/cosmos/cosmos-sdk/x/payments/types"
+)
+
+// InitGenesis initializes the payments module's state from a given genesis
+// state.
+func (k Keeper) InitGenesis(ctx context.Context, gs *types.GenesisState) error {
+	if err := k.NextStreamID.Set(ctx, gs.NextStreamId); err != nil {
+		return err
+	}
+
+	for _, stream := range gs.Streams {
+		if err := k.setStream(ctx, stream); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// ExportGenesis returns the payments module's exported genesis.
+func (k Keeper) ExportGenesis(ctx context.Context) (*types.GenesisState, error) {
+	nextStreamID, err := k.NextStreamID.Peek(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	gs := &types.GenesisState{NextStreamId: nextStreamID}
+	err = k.Streams.Walk(ctx, nil, func(_ uint64, stream types.Stream) bool {
+		gs.Streams = append(gs.Streams, stream)
+		return false
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return gs, nil
+}
@@ -0,0 +1,109 @@
+package keeper
+
+import (
+	"context"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"cosmossdk.io/collections"
+	errorsmod "cosmossdk.io/errors"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"github.com/cosmos/cosmos-sdk/x/payments/types"
+)
+
+var _ types.QueryServer = Querier{}
+
+// Querier is used as Keeper will have duplicate methods if used directly, and gRPC names take precedence over keeper.
+type Querier struct {
+	*Keeper
+}
+
+// NewQuerier returns an implementation of the payments QueryServer
+// interface for the provided Keeper.
+func NewQuerier(keeper *Keeper) Querier {
+	return Querier{Keeper: keeper}
+}
+
+// Stream queries a stream, along with the amount its payee can withdraw.
+func (k Querier) Stream(ctx context.Context, req *types.QueryStreamRequest) (*types.QueryStreamResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	stream, err := k.GetStream(ctx, req.StreamId)
+	if err != nil {
+		if errorsmod.IsOf(err, types.ErrStreamNotFound) {
+			return nil, status.Errorf(codes.NotFound, "stream %d not found", req.StreamId)
+		}
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	withdrawable := stream.Withdrawable(sdk.UnwrapSDKContext(ctx).BlockTime())
+
+	return &types.QueryStreamResponse{Stream: stream, Withdrawable: withdrawable}, nil
+}
+
+// StreamsByPayer queries the streams funded by an account.
+func (k Querier) StreamsByPayer(ctx context.Context, req *types.QueryStreamsByPayerRequest) (*types.QueryStreamsByPayerResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	payer, err := sdk.AccAddressFromBech32(req.Payer)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid payer address: %s", err)
+	}
+
+	streams, pageRes, err := k.paginateStreams(ctx, k.PayerStreams, payer, req.Pagination)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryStreamsByPayerResponse{Streams: streams, Pagination: pageRes}, nil
+}
+
+// StreamsByPayee queries the streams received by an account.
+func (k Querier) StreamsByPayee(ctx context.Context, req *types.QueryStreamsByPayeeRequest) (*types.QueryStreamsByPayeeResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	payee, err := sdk.AccAddressFromBech32(req.Payee)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid payee address: %s", err)
+	}
+
+	streams, pageRes, err := k.paginateStreams(ctx, k.PayeeStreams, payee, req.Pagination)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryStreamsByPayeeResponse{Streams: streams, Pagination: pageRes}, nil
+}
+
+// paginateStreams returns a page of the streams of an account, as indexed by
+// the given index.
+func (k Querier) paginateStreams(
+	ctx context.Context, index collections.KeySet[collections.Pair[sdk.AccAddress, uint64]], addr sdk.AccAddress, pageReq *query.PageRequest,
+) ([]types.Stream, *query.PageResponse, error) {
+	results, pageRes, err := query.CollectionFilteredPaginate[collections.Pair[sdk.AccAddress, uint64], collections.NoValue](
+		ctx, index, pageReq, nil, query.WithCollectionPaginationPairPrefix[sdk.AccAddress, uint64](addr),
+	)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	streams := make([]types.Stream, 0, len(results))
+	for _, r := range results {
+		stream, err := k.Streams.Get(ctx, r.Key.K2())
+		if err != nil {
+			return nil, nil, err
+		}
+		streams = append(streams, stream)
+	}
+
+	return streams, pageRes, nil
+}
@@ -0,0 +1,272 @@
+package keeper
+
+import (
+	"context"
+	"strconv"
+	"time"
+
+	"cosmossdk.io/collections"
+	"cosmossdk.io/core/event"
+	storetypes "cosmossdk.io/core/store"
+	errorsmod "cosmossdk.io/errors"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	"github.com/cosmos/cosmos-sdk/x/payments/types"
+)
+
+// Keeper of the payments store
+type Keeper struct {
+	storeService storetypes.KVStoreService
+	event        event.Service
+	bankKeeper   types.BankKeeper
+	distrKeeper  types.DistributionKeeper
+
+	// the address capable of opening and cancelling the streams paid from the
+	// community pool. Typically, this should be the x/gov module account.
+	authority string
+
+	Schema       collections.Schema
+	NextStreamID collections.Sequence
+	Streams      collections.Map[uint64, types.Stream]
+	PayerStreams collections.KeySet[collections.Pair[sdk.AccAddress, uint64]]
+	PayeeStreams collections.KeySet[collections.Pair[sdk.AccAddress, uint64]]
+}
+
+// NewKeeper returns a new payments Keeper.
+func NewKeeper(
+	cdc codec.BinaryCodec, storeService storetypes.KVStoreService, bk types.BankKeeper,
+	dk types.DistributionKeeper, authority string, em event.Service,
+) *Keeper {
+	sb := collections.NewSchemaBuilder(storeService)
+	k := &Keeper{
+		storeService: storeService,
+		event:        em,
+		bankKeeper:   bk,
+		distrKeeper:  dk,
+		authority:    authority,
+		NextStreamID: collections.NewSequence(sb, types.NextStreamIDKey, "next_stream_id"),
+		Streams:      collections.NewMap(sb, types.StreamsPrefix, "streams", collections.Uint64Key, codec.CollValue[types.Stream](cdc)),
+		PayerStreams: collections.NewKeySet(sb, types.PayerStreamsPrefix, "payer_streams", collections.PairKeyCodec(sdk.AccAddressKey, collections.Uint64Key)),
+		PayeeStreams: collections.NewKeySet(sb, types.PayeeStreamsPrefix, "payee_streams", collections.PairKeyCodec(sdk.AccAddressKey, collections.Uint64Key)),
+	}
+
+	schema, err := sb.Build()
+	if err != nil {
+		panic(err)
+	}
+	k.Schema = schema
+
+	return k
+}
+
+// GetAuthority returns the x/payments module's authority.
+func (k Keeper) GetAuthority() string {
+	return k.authority
+}
+
+// GetStream returns a stream by identifier.
+func (k Keeper) GetStream(ctx context.Context, id uint64) (types.Stream, error) {
+	stream, err := k.Streams.Get(ctx, id)
+	if err != nil {
+		if errorsmod.IsOf(err, collections.ErrNotFound) {
+			return types.Stream{}, errorsmod.Wrapf(types.ErrStreamNotFound, "%d", id)
+		}
+		return types.Stream{}, err
+	}
+
+	return stream, nil
+}
+
+// setStream stores a stream, indexed by its payer and its payee.
+func (k Keeper) setStream(ctx context.Context, stream types.Stream) error {
+	payer, err := sdk.AccAddressFromBech32(stream.Payer)
+	if err != nil {
+		return err
+	}
+
+	payee, err := sdk.AccAddressFromBech32(stream.Payee)
+	if err != nil {
+		return err
+	}
+
+	if err := k.Streams.Set(ctx, stream.Id, stream); err != nil {
+		return err
+	}
+
+	if err := k.PayerStreams.Set(ctx, collections.Join(payer, stream.Id)); err != nil {
+		return err
+	}
+
+	return k.PayeeStreams.Set(ctx, collections.Join(payee, stream.Id))
+}
+
+// removeStream removes a stream and its indexes.
+func (k Keeper) removeStream(ctx context.Context, stream types.Stream) error {
+	if err := k.Streams.Remove(ctx, stream.Id); err != nil {
+		return err
+	}
+
+	if err := k.PayerStreams.Remove(ctx, collections.Join(sdk.MustAccAddressFromBech32(stream.Payer), stream.Id)); err != nil {
+		return err
+	}
+
+	return k.PayeeStreams.Remove(ctx, collections.Join(sdk.MustAccAddressFromBech32(stream.Payee), stream.Id))
+}
+
+// CreateStream opens a stream from the payer to the payee, accruing the rate
+// per second from the block time until the end time, and escrows its deposit.
+// The deposit of a community pool stream is distributed from the community
+// pool to the payer, i.e. the module authority, before being escrowed.
+func (k Keeper) CreateStream(
+	ctx context.Context, payer, payee sdk.AccAddress, ratePerSecond sdk.Coin, endTime time.Time, cancelable, communityPool bool,
+) (uint64, error) {
+	startTime := sdk.UnwrapSDKContext(ctx).BlockTime()
+	if err := types.ValidateStreamTerms(ratePerSecond, startTime, endTime); err != nil {
+		return 0, err
+	}
+
+	if k.bankKeeper.BlockedAddr(payee) {
+		return 0, errorsmod.Wrapf(sdkerrors.ErrUnauthorized, "%s is not allowed to receive funds", payee)
+	}
+
+	id, err := k.NextStreamID.Next(ctx)
+	if err != nil {
+		return 0, err
+	}
+
+	stream := types.NewStream(id, payer.String(), payee.String(), ratePerSecond, startTime, endTime, cancelable, communityPool)
+	deposit := sdk.NewCoins(stream.Deposit())
+	if communityPool {
+		if err := k.distrKeeper.DistributeFromFeePool(ctx, deposit, payer); err != nil {
+			return 0, err
+		}
+	}
+
+	if err := k.bankKeeper.SendCoinsFromAccountToModule(ctx, payer, types.ModuleName, deposit); err != nil {
+		return 0, err
+	}
+
+	if err := k.setStream(ctx, stream); err != nil {
+		return 0, err
+	}
+
+	if err := k.event.EventManager(ctx).EmitKV(
+		ctx,
+		types.EventTypeCreateStream,
+		event.Attribute{Key: types.AttributeKeyStreamID, Value: strconv.FormatUint(id, 10)},
+		event.Attribute{Key: types.AttributeKeyPayer, Value: stream.Payer},
+		event.Attribute{Key: types.AttributeKeyPayee, Value: stream.Payee},
+		event.Attribute{Key: types.AttributeKeyRatePerSecond, Value: ratePerSecond.String()},
+		event.Attribute{Key: types.AttributeKeyEndTime, Value: endTime.String()},
+		event.Attribute{Key: types.AttributeKeyDeposit, Value: deposit.String()},
+	); err != nil {
+		return 0, err
+	}
+
+	return id, nil
+}
+
+// WithdrawStream pays the funds accrued by a stream since the last withdrawal
+// to its payee. The stream is removed once it ended and was fully withdrawn.
+func (k Keeper) WithdrawStream(ctx context.Context, payee sdk.AccAddress, id uint64) (sdk.Coin, error) {
+	stream, err := k.GetStream(ctx, id)
+	if err != nil {
+		return sdk.Coin{}, err
+	}
+
+	if stream.Payee != payee.String() {
+		return sdk.Coin{}, errorsmod.Wrapf(types.ErrUnauthorized, "%s is not the payee of stream %d", payee, id)
+	}
+
+	now := sdk.UnwrapSDKContext(ctx).BlockTime()
+	amount := stream.Withdrawable(now)
+	if amount.IsZero() {
+		return sdk.Coin{}, errorsmod.Wrapf(types.ErrNothingToWithdraw, "stream %d", id)
+	}
+
+	if err := k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, payee, sdk.NewCoins(amount)); err != nil {
+		return sdk.Coin{}, err
+	}
+
+	stream.Withdrawn = stream.Withdrawn.Add(amount)
+	if stream.IsCompleted(now) {
+		err = k.removeStream(ctx, stream)
+	} else {
+		err = k.Streams.Set(ctx, id, stream)
+	}
+	if err != nil {
+		return sdk.Coin{}, err
+	}
+
+	if err := k.event.EventManager(ctx).EmitKV(
+		ctx,
+		types.EventTypeWithdrawStream,
+		event.Attribute{Key: types.AttributeKeyStreamID, Value: strconv.FormatUint(id, 10)},
+		event.Attribute{Key: types.AttributeKeyPayee, Value: stream.Payee},
+		event.Attribute{Key: sdk.AttributeKeyAmount, Value: amount.String()},
+	); err != nil {
+		return sdk.Coin{}, err
+	}
+
+	return amount, nil
+}
+
+// CancelStream cancels a cancelable stream. The funds accrued and not withdrawn
+// yet are paid to the payee, and the unaccrued funds are returned to the payer,
+// or to the community pool for the community pool streams.
+func (k Keeper) CancelStream(ctx context.Context, payer sdk.AccAddress, id uint64) (paid, refunded sdk.Coin, err error) {
+	stream, err := k.GetStream(ctx, id)
+	if err != nil {
+		return sdk.Coin{}, sdk.Coin{}, err
+	}
+
+	if stream.Payer != payer.String() {
+		return sdk.Coin{}, sdk.Coin{}, errorsmod.Wrapf(types.ErrUnauthorized, "%s is not the payer of stream %d", payer, id)
+	}
+
+	if !stream.Cancelable {
+		return sdk.Coin{}, sdk.Coin{}, errorsmod.Wrapf(types.ErrNotCancelable, "stream %d", id)
+	}
+
+	now := sdk.UnwrapSDKContext(ctx).BlockTime()
+	paid = stream.Withdrawable(now)
+	refunded = stream.Deposit().Sub(stream.Accrued(now))
+
+	if paid.IsPositive() {
+		if err := k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, sdk.MustAccAddressFromBech32(stream.Payee), sdk.NewCoins(paid)); err != nil {
+			return sdk.Coin{}, sdk.Coin{}, err
+		}
+	}
+
+	if refunded.IsPositive() {
+		if stream.CommunityPool {
+			err = k.distrKeeper.FundCommunityPool(ctx, sdk.NewCoins(refunded), authtypes.NewModuleAddress(types.ModuleName))
+		} else {
+			err = k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, payer, sdk.NewCoins(refunded))
+		}
+		if err != nil {
+			return sdk.Coin{}, sdk.Coin{}, err
+		}
+	}
+
+	if err := k.removeStream(ctx, stream); err != nil {
+		return sdk.Coin{}, sdk.Coin{}, err
+	}
+
+	if err := k.event.EventManager(ctx).EmitKV(
+		ctx,
+		types.EventTypeCancelStream,
+		event.Attribute{Key: types.AttributeKeyStreamID, Value: strconv.FormatUint(id, 10)},
+		event.Attribute{Key: types.AttributeKeyPayer, Value: stream.Payer},
+		event.Attribute{Key: types.AttributeKeyPayee, Value: stream.Payee},
+		event.Attribute{Key: sdk.AttributeKeyAmount, Value: paid.String()},
+		event.Attribute{Key: types.AttributeKeyRefunded, Value: refunded.String()},
+	); err != nil {
+		return sdk.Coin{}, sdk.Coin{}, err
+	}
+
+	return paid, refunded, nil
+}
@@ -0,0 +1,254 @@
+package keeper_test
+
+import (
+	"testing"
+	"time"
+
+	cmtproto "github.com/cometbft/cometbft/proto/tendermint/types"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/suite"
+
+	storetypes "cosmossdk.io/store/types"
+
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	"github.com/cosmos/cosmos-sdk/runtime"
+	"github.com/cosmos/cosmos-sdk/testutil"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	moduletestutil "github.com/cosmos/cosmos-sdk/types/module/testutil"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+	"github.com/cosmos/cosmos-sdk/x/payments/keeper"
+	paymentstestutil "github.com/cosmos/cosmos-sdk/x/payments/testutil"
+	"github.com/cosmos/cosmos-sdk/x/payments/types"
+)
+
+type KeeperTestSuite struct {
+	suite.Suite
+
+	ctx         sdk.Context
+	keeper      *keeper.Keeper
+	bankKeeper  *paymentstestutil.MockBankKeeper
+	distrKeeper *paymentstestutil.MockDistributionKeeper
+	msgServer   types.MsgServer
+	queryClient types.QueryClient
+	authority   sdk.AccAddress
+	payer       sdk.AccAddress
+	payee       sdk.AccAddress
+	startTime   time.Time
+}
+
+func (s *KeeperTestSuite) SetupTest() {
+	key := storetypes.NewKVStoreKey(types.StoreKey)
+	testCtx := testutil.DefaultContextWithDB(s.T(), key, storetypes.NewTransientStoreKey("transient_test"))
+	encCfg := moduletestutil.MakeTestEncodingConfig()
+
+	ctrl := gomock.NewController(s.T())
+	s.bankKeeper = paymentstestutil.NewMockBankKeeper(ctrl)
+	s.distrKeeper = paymentstestutil.NewMockDistributionKeeper(ctrl)
+	s.bankKeeper.EXPECT().BlockedAddr(gomock.Any()).Return(false).AnyTimes()
+
+	s.authority = authtypes.NewModuleAddress(govtypes.ModuleName)
+	s.payer = sdk.AccAddress("payer")
+	s.payee = sdk.AccAddress("payee")
+	s.startTime = time.Date(2023, 1, 1, 0, 0, 0, 0, time.UTC)
+	s.ctx = testCtx.Ctx.WithBlockHeader(cmtproto.Header{Height: 10, Time: s.startTime})
+	s.keeper = keeper.NewKeeper(encCfg.Codec, runtime.NewKVStoreService(key), s.bankKeeper, s.distrKeeper, s.authority.String(), runtime.EventService{})
+	s.msgServer = keeper.NewMsgServerImpl(s.keeper)
+	s.Require().NoError(s.keeper.InitGenesis(s.ctx, types.DefaultGenesisState()))
+
+	types.RegisterInterfaces(encCfg.InterfaceRegistry)
+	queryHelper := baseapp.NewQueryServerTestHelper(s.ctx, encCfg.InterfaceRegistry)
+	types.RegisterQueryServer(queryHelper, keeper.NewQuerier(s.keeper))
+	s.queryClient = types.NewQueryClient(queryHelper)
+}
+
+func TestKeeperTestSuite(t *testing.T) {
+	suite.Run(t, new(KeeperTestSuite))
+}
+
+// at returns the context of a block the given number of seconds after the
+// start time.
+func (s *KeeperTestSuite) at(seconds int64) sdk.Context {
+	return s.ctx.WithBlockTime(s.startTime.Add(time.Duration(seconds) * time.Second))
+}
+
+// createStream opens a stream of 10 stake per second, for 100 seconds, from
+// the payer to the payee.
+func (s *KeeperTestSuite) createStream(cancelable bool) uint64 {
+	deposit := sdk.NewCoins(sdk.NewInt64Coin("stake", 1000))
+	s.bankKeeper.EXPECT().SendCoinsFromAccountToModule(gomock.Any(), s.payer, types.ModuleName, deposit).Return(nil)
+
+	res, err := s.msgServer.CreateStream(s.ctx, &types.MsgCreateStream{
+		Payer:         s.payer.String(),
+		Payee:         s.payee.String(),
+		RatePerSecond: sdk.NewInt64Coin("stake", 10),
+		EndTime:       s.startTime.Add(100 * time.Second),
+		Cancelable:    cancelable,
+	})
+	s.Require().NoError(err)
+
+	return res.StreamId
+}
+
+func (s *KeeperTestSuite) TestCreateStream() {
+	require := s.Require()
+
+	msg := &types.MsgCreateStream{
+		Payer:         s.payer.String(),
+		Payee:         s.payee.String(),
+		RatePerSecond: sdk.NewInt64Coin("stake", 10),
+		EndTime:       s.startTime.Add(500 * time.Millisecond),
+	}
+	_, err := s.msgServer.CreateStream(s.ctx, msg)
+	require.ErrorIs(err, types.ErrInvalidStream)
+
+	msg.EndTime = s.startTime.Add(time.Minute)
+	msg.RatePerSecond = sdk.NewInt64Coin("stake", 0)
+	_, err = s.msgServer.CreateStream(s.ctx, msg)
+	require.ErrorIs(err, types.ErrInvalidStream)
+
+	id := s.createStream(true)
+	require.Equal(uint64(1), id)
+	require.Equal(uint64(2), s.createStream(false))
+
+	res, err := s.queryClient.Stream(s.ctx, &types.QueryStreamRequest{StreamId: id})
+	require.NoError(err)
+	require.Equal(s.payer.String(), res.Stream.Payer)
+	require.Equal(sdk.NewInt64Coin("stake", 1000), res.Stream.Deposit())
+	require.True(res.Withdrawable.IsZero())
+
+	byPayer, err := s.queryClient.StreamsByPayer(s.ctx, &types.QueryStreamsByPayerRequest{Payer: s.payer.String()})
+	require.NoError(err)
+	require.Len(byPayer.Streams, 2)
+
+	byPayee, err := s.queryClient.StreamsByPayee(s.ctx, &types.QueryStreamsByPayeeRequest{Payee: s.payer.String()})
+	require.NoError(err)
+	require.Empty(byPayee.Streams)
+}
+
+func (s *KeeperTestSuite) TestWithdrawStream() {
+	require := s.Require()
+	id := s.createStream(false)
+
+	// only the payee can withdraw
+	_, err := s.msgServer.WithdrawStream(s.at(10), &types.MsgWithdrawStream{Payee: s.payer.String(), StreamId: id})
+	require.ErrorIs(err, types.ErrUnauthorized)
+
+	_, err = s.msgServer.WithdrawStream(s.ctx, &types.MsgWithdrawStream{Payee: s.payee.String(), StreamId: id})
+	require.ErrorIs(err, types.ErrNothingToWithdraw)
+
+	// the funds accrue every whole second
+	ctx := s.ctx.WithBlockTime(s.startTime.Add(10*time.Second + 500*time.Millisecond))
+	s.bankKeeper.EXPECT().SendCoinsFromModuleToAccount(gomock.Any(), types.ModuleName, s.payee, sdk.NewCoins(sdk.NewInt64Coin("stake", 100))).Return(nil)
+	res, err := s.msgServer.WithdrawStream(ctx, &types.MsgWithdrawStream{Payee: s.payee.String(), StreamId: id})
+	require.NoError(err)
+	require.Equal(sdk.NewInt64Coin("stake", 100), res.Amount)
+
+	// the funds stop accruing at the end time, and the stream is removed once
+	// fully withdrawn
+	s.bankKeeper.EXPECT().SendCoinsFromModuleToAccount(gomock.Any(), types.ModuleName, s.payee, sdk.NewCoins(sdk.NewInt64Coin("stake", 900))).Return(nil)
+	res, err = s.msgServer.WithdrawStream(s.at(200), &types.MsgWithdrawStream{Payee: s.payee.String(), StreamId: id})
+	require.NoError(err)
+	require.Equal(sdk.NewInt64Coin("stake", 900), res.Amount)
+
+	_, err = s.keeper.GetStream(s.ctx, id)
+	require.ErrorIs(err, types.ErrStreamNotFound)
+}
+
+func (s *KeeperTestSuite) TestCancelStream() {
+	require := s.Require()
+
+	id := s.createStream(false)
+	_, err := s.msgServer.CancelStream(s.at(10), &types.MsgCancelStream{Payer: s.payer.String(), StreamId: id})
+	require.ErrorIs(err, types.ErrNotCancelable)
+
+	id = s.createStream(true)
+	_, err = s.msgServer.CancelStream(s.at(10), &types.MsgCancelStream{Payer: s.payee.String(), StreamId: id})
+	require.ErrorIs(err, types.ErrUnauthorized)
+
+	s.bankKeeper.EXPECT().SendCoinsFromModuleToAccount(gomock.Any(), types.ModuleName, s.payee, sdk.NewCoins(sdk.NewInt64Coin("stake", 30))).Return(nil)
+	_, err = s.msgServer.WithdrawStream(s.at(3), &types.MsgWithdrawStream{Payee: s.payee.String(), StreamId: id})
+	require.NoError(err)
+
+	// the accrued funds are paid to the payee, and the unaccrued funds are
+	// returned to the payer
+	s.bankKeeper.EXPECT().SendCoinsFromModuleToAccount(gomock.Any(), types.ModuleName, s.payee, sdk.NewCoins(sdk.NewInt64Coin("stake", 70))).Return(nil)
+	s.bankKeeper.EXPECT().SendCoinsFromModuleToAccount(gomock.Any(), types.ModuleName, s.payer, sdk.NewCoins(sdk.NewInt64Coin("stake", 900))).Return(nil)
+	res, err := s.msgServer.CancelStream(s.at(10), &types.MsgCancelStream{Payer: s.payer.String(), StreamId: id})
+	require.NoError(err)
+	require.Equal(sdk.NewInt64Coin("stake", 70), res.Paid)
+	require.Equal(sdk.NewInt64Coin("stake", 900), res.Refunded)
+
+	_, err = s.queryClient.Stream(s.ctx, &types.QueryStreamRequest{StreamId: id})
+	require.Error(err)
+}
+
+func (s *KeeperTestSuite) TestCommunityPoolStream() {
+	require := s.Require()
+
+	msg := &types.MsgCreateCommunityPoolStream{
+		Authority:     s.payer.String(),
+		Payee:         s.payee.String(),
+		RatePerSecond: sdk.NewInt64Coin("stake", 5),
+		EndTime:       s.startTime.Add(10 * time.Second),
+		Cancelable:    true,
+	}
+	_, err := s.msgServer.CreateCommunityPoolStream(s.ctx, msg)
+	require.ErrorIs(err, govtypes.ErrInvalidSigner)
+
+	// the deposit is distributed from the community pool to the authority
+	// before being escrowed
+	deposit := sdk.NewCoins(sdk.NewInt64Coin("stake", 50))
+	msg.Authority = s.authority.String()
+	gomock.InOrder(
+		s.distrKeeper.EXPECT().DistributeFromFeePool(gomock.Any(), deposit, s.authority).Return(nil),
+		s.bankKeeper.EXPECT().SendCoinsFromAccountToModule(gomock.Any(), s.authority, types.ModuleName, deposit).Return(nil),
+	)
+	res, err := s.msgServer.CreateCommunityPoolStream(s.ctx, msg)
+	require.NoError(err)
+
+	// the unaccrued funds return to the community pool on cancellation
+	s.bankKeeper.EXPECT().SendCoinsFromModuleToAccount(gomock.Any(), types.ModuleName, s.payee, sdk.NewCoins(sdk.NewInt64Coin("stake", 20))).Return(nil)
+	s.distrKeeper.EXPECT().FundCommunityPool(gomock.Any(), sdk.NewCoins(sdk.NewInt64Coin("stake", 30)), authtypes.NewModuleAddress(types.ModuleName)).Return(nil)
+	_, err = s.msgServer.CancelStream(s.at(4), &types.MsgCancelStream{Payer: s.authority.String(), StreamId: res.StreamId})
+	require.NoError(err)
+}
+
+func (s *KeeperTestSuite) TestBlockedPayee() {
+	ctrl := gomock.NewController(s.T())
+	bankKeeper := paymentstestutil.NewMockBankKeeper(ctrl)
+	bankKeeper.EXPECT().BlockedAddr(s.payee).Return(true)
+	k := keeper.NewKeeper(moduletestutil.MakeTestEncodingConfig().Codec, runtime.NewKVStoreService(storetypes.NewKVStoreKey(types.StoreKey)), bankKeeper, s.distrKeeper, s.authority.String(), runtime.EventService{})
+
+	_, err := k.CreateStream(s.ctx, s.payer, s.payee, sdk.NewInt64Coin("stake", 1), s.startTime.Add(time.Minute), false, false)
+	s.Require().ErrorIs(err, sdkerrors.ErrUnauthorized)
+}
+
+func (s *KeeperTestSuite) TestGenesis() {
+	require := s.Require()
+	s.createStream(true)
+	s.bankKeeper.EXPECT().SendCoinsFromModuleToAccount(gomock.Any(), types.ModuleName, s.payee, gomock.Any()).Return(nil)
+	_, err := s.keeper.WithdrawStream(s.at(5), s.payee, 1)
+	require.NoError(err)
+
+	gs, err := s.keeper.ExportGenesis(s.ctx)
+	require.NoError(err)
+	require.NoError(gs.Validate())
+	require.Equal(uint64(2), gs.NextStreamId)
+	require.Len(gs.Streams, 1)
+	require.Equal(sdk.NewInt64Coin("stake", 50), gs.Streams[0].Withdrawn)
+
+	s.SetupTest()
+	require.NoError(s.keeper.InitGenesis(s.ctx, gs))
+	exported, err := s.keeper.ExportGenesis(s.ctx)
+	require.NoError(err)
+	require.Equal(gs, exported)
+
+	byPayee, err := s.queryClient.StreamsByPayee(s.ctx, &types.QueryStreamsByPayeeRequest{Payee: s.payee.String()})
+	require.NoError(err)
+	require.Len(byPayee.Streams, 1)
+
+	gs.Streams = append(gs.Streams, gs.Streams[0])
+	require.ErrorIs(gs.Validate(), types.ErrInvalidStream)
+}
@@ -0,0 +1,93 @@
+package keeper
+
+import (
+	"context"
+
+	errorsmod "cosmossdk.io/errors"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+	"github.com/cosmos/cosmos-sdk/x/payments/types"
+)
+
+type msgServer struct {
+	*Keeper
+}
+
+var _ types.MsgServer = msgServer{}
+
+// NewMsgServerImpl returns an implementation of the payments MsgServer
+// interface for the provided Keeper.
+func NewMsgServerImpl(keeper *Keeper) types.MsgServer {
+	return &msgServer{Keeper: keeper}
+}
+
+// CreateStream implements the Msg/CreateStream method.
+func (k msgServer) CreateStream(ctx context.Context, msg *types.MsgCreateStream) (*types.MsgCreateStreamResponse, error) {
+	payer, err := sdk.AccAddressFromBech32(msg.Payer)
+	if err != nil {
+		return nil, errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "invalid payer address: %s", err)
+	}
+
+	payee, err := sdk.AccAddressFromBech32(msg.Payee)
+	if err != nil {
+		return nil, errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "invalid payee address: %s", err)
+	}
+
+	id, err := k.Keeper.CreateStream(ctx, payer, payee, msg.RatePerSecond, msg.EndTime, msg.Cancelable, false)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.MsgCreateStreamResponse{StreamId: id}, nil
+}
+
+// CreateCommunityPoolStream implements the Msg/CreateCommunityPoolStream method.
+func (k msgServer) CreateCommunityPoolStream(ctx context.Context, msg *types.MsgCreateCommunityPoolStream) (*types.MsgCreateCommunityPoolStreamResponse, error) {
+	if k.authority != msg.Authority {
+		return nil, errorsmod.Wrapf(govtypes.ErrInvalidSigner, "invalid authority; expected %s, got %s", k.authority, msg.Authority)
+	}
+
+	payee, err := sdk.AccAddressFromBech32(msg.Payee)
+	if err != nil {
+		return nil, errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "invalid payee address: %s", err)
+	}
+
+	id, err := k.Keeper.CreateStream(ctx, sdk.MustAccAddressFromBech32(msg.Authority), payee, msg.RatePerSecond, msg.EndTime, msg.Cancelable, true)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.MsgCreateCommunityPoolStreamResponse{StreamId: id}, nil
+}
+
+// WithdrawStream implements the Msg/WithdrawStream method.
+func (k msgServer) WithdrawStream(ctx context.Context, msg *types.MsgWithdrawStream) (*types.MsgWithdrawStreamResponse, error) {
+	payee, err := sdk.AccAddressFromBech32(msg.Payee)
+	if err != nil {
+		return nil, errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "invalid payee address: %s", err)
+	}
+
+	amount, err := k.Keeper.WithdrawStream(ctx, payee, msg.StreamId)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.MsgWithdrawStreamResponse{Amount: amount}, nil
+}
+
+// CancelStream implements the Msg/CancelStream method.
+func (k msgServer) CancelStream(ctx context.Context, msg *types.MsgCancelStream) (*types.MsgCancelStreamResponse, error) {
+	payer, err := sdk.AccAddressFromBech32(msg.Payer)
+	if err != nil {
+		return nil, errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "invalid payer address: %s", err)
+	}
+
+	paid, refunded, err := k.Keeper.CancelStream(ctx, payer, msg.StreamId)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.MsgCancelStreamResponse{Paid: paid, Refunded: refunded}, nil
+}
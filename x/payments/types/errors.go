@@ -0,0 +1,12 @@
+package types
+
+import "cosmossdk.io/errors"
+
+// x/payments module sentinel errors
+var (
+	ErrInvalidStream     = errors.Register(ModuleName, 2, "invalid stream")
+	ErrStreamNotFound    = errors.Register(ModuleName, 3, "stream not found")
+	ErrUnauthorized      = errors.Register(ModuleName, 4, "unauthorized account")
+	ErrNotCancelable     = errors.Register(ModuleName, 5, "stream is not cancelable")
+	ErrNothingToWithdraw = errors.Register(ModuleName, 6, "no funds to withdraw")
+)
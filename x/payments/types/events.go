@@ -0,0 +1,16 @@
+package types
+
+// payments module event types
+const (
+	EventTypeCreateStream   = "create_stream"
+	EventTypeWithdrawStream = "withdraw_stream"
+	EventTypeCancelStream   = "cancel_stream"
+
+	AttributeKeyStreamID      = "stream_id"
+	AttributeKeyPayer         = "payer"
+	AttributeKeyPayee         = "payee"
+	AttributeKeyRatePerSecond = "rate_per_second"
+	AttributeKeyEndTime       = "end_time"
+	AttributeKeyDeposit       = "deposit"
+	AttributeKeyRefunded      = "refunded"
+)
@@ -0,0 +1,31 @@
+package types
+
+import errorsmod "cosmossdk.io/errors"
+
+// DefaultGenesisState returns the default genesis state, which has no streams.
+func DefaultGenesisState() *GenesisState {
+	return &GenesisState{
+		NextStreamId: 1,
+	}
+}
+
+// Validate performs basic genesis state validation.
+func (gs GenesisState) Validate() error {
+	ids := make(map[uint64]struct{}, len(gs.Streams))
+	for _, s := range gs.Streams {
+		if err := s.Validate(); err != nil {
+			return err
+		}
+
+		if s.Id == 0 || s.Id >= gs.NextStreamId {
+			return errorsmod.Wrapf(ErrInvalidStream, "stream id %d must be positive and lower than the next stream id %d", s.Id, gs.NextStreamId)
+		}
+
+		if _, ok := ids[s.Id]; ok {
+			return errorsmod.Wrapf(ErrInvalidStream, "duplicate stream id %d", s.Id)
+		}
+		ids[s.Id] = struct{}{}
+	}
+
+	return nil
+}
@@ -0,0 +1,29 @@
+package types
+
+import "cosmossdk.io/collections"
+
+const (
+	// ModuleName defines the name of the x/payments module.
+	ModuleName = "payments"
+
+	// StoreKey defines the module's store key.
+	StoreKey = ModuleName
+)
+
+var (
+	// NextStreamIDKey is the key under which the identifier of the next opened
+	// stream is stored.
+	NextStreamIDKey = collections.NewPrefix(0)
+
+	// StreamsPrefix is the prefix under which the streams are stored, indexed
+	// by identifier.
+	StreamsPrefix = collections.NewPrefix(1)
+
+	// PayerStreamsPrefix is the prefix under which the streams are indexed by
+	// payer.
+	PayerStreamsPrefix = collections.NewPrefix(2)
+
+	// PayeeStreamsPrefix is the prefix under which the streams are indexed by
+	// payee.
+	PayeeStreamsPrefix = collections.NewPrefix(3)
+)
@@ -0,0 +1,69 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/auth/migrations/legacytx"
+)
+
+var (
+	_ sdk.Msg            = &MsgCreateStream{}
+	_ legacytx.LegacyMsg = &MsgCreateStream{}
+	_ sdk.Msg            = &MsgCreateCommunityPoolStream{}
+	_ legacytx.LegacyMsg = &MsgCreateCommunityPoolStream{}
+	_ sdk.Msg            = &MsgWithdrawStream{}
+	_ legacytx.LegacyMsg = &MsgWithdrawStream{}
+	_ sdk.Msg            = &MsgCancelStream{}
+	_ legacytx.LegacyMsg = &MsgCancelStream{}
+)
+
+// GetSigners returns the signer addresses that are expected to sign the result
+// of GetSignBytes.
+func (msg MsgCreateStream) GetSigners() []sdk.AccAddress {
+	payer, _ := sdk.AccAddressFromBech32(msg.Payer)
+	return []sdk.AccAddress{payer}
+}
+
+// GetSignBytes returns the raw bytes for a MsgCreateStream message that
+// the expected signer needs to sign.
+func (msg MsgCreateStream) GetSignBytes() []byte {
+	return sdk.MustSortJSON(amino.MustMarshalJSON(&msg))
+}
+
+// GetSigners returns the signer addresses that are expected to sign the result
+// of GetSignBytes.
+func (msg MsgCreateCommunityPoolStream) GetSigners() []sdk.AccAddress {
+	authority, _ := sdk.AccAddressFromBech32(msg.Authority)
+	return []sdk.AccAddress{authority}
+}
+
+// GetSignBytes returns the raw bytes for a MsgCreateCommunityPoolStream message that
+// the expected signer needs to sign.
+func (msg MsgCreateCommunityPoolStream) GetSignBytes() []byte {
+	return sdk.MustSortJSON(amino.MustMarshalJSON(&msg))
+}
+
+// GetSigners returns the signer addresses that are expected to sign the result
+// of GetSignBytes.
+func (msg MsgWithdrawStream) GetSigners() []sdk.AccAddress {
+	payee, _ := sdk.AccAddressFromBech32(msg.Payee)
+	return []sdk.AccAddress{payee}
+}
+
+// GetSignBytes returns the raw bytes for a MsgWithdrawStream message that
+// the expected signer needs to sign.
+func (msg MsgWithdrawStream) GetSignBytes() []byte {
+	return sdk.MustSortJSON(amino.MustMarshalJSON(&msg))
+}
+
+// GetSigners returns the signer addresses that are expected to sign the result
+// of GetSignBytes.
+func (msg MsgCancelStream) GetSigners() []sdk.AccAddress {
+	payer, _ := sdk.AccAddressFromBech32(msg.Payer)
+	return []sdk.AccAddress{payer}
+}
+
+// GetSignBytes returns the raw bytes for a MsgCancelStream message that
+// the expected signer needs to sign.
+func (msg MsgCancelStream) GetSignBytes() []byte {
+	return sdk.MustSortJSON(amino.MustMarshalJSON(&msg))
+}
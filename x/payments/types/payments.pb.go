@@ -0,0 +1,732 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: cosmos/payments/v1/payments.proto
+
+package types
+
+import (
+	fmt "fmt"
+	_ "github.com/cosmos/cosmos-proto"
+	types "github.com/cosmos/cosmos-sdk/types"
+	_ "github.com/cosmos/cosmos-sdk/types/tx/amino"
+	_ "github.com/cosmos/gogoproto/gogoproto"
+	proto "github.com/cosmos/gogoproto/proto"
+	github_com_cosmos_gogoproto_types "github.com/cosmos/gogoproto/types"
+	_ "google.golang.org/protobuf/types/known/timestamppb"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+	time "time"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+var _ = time.Kitchen
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// Stream defines a payment stream from a payer to a payee. The deposit of the
+// stream, i.e. its rate per second times its duration in seconds, is escrowed
+// by the module when the stream is opened, and accrues to the payee every
+// second until the end time.
+type Stream struct {
+	// id is the unique identifier of the stream.
+	Id uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	// payer is the address of the account funding the stream, or the module
+	// authority for the streams funded by the community pool.
+	Payer string `protobuf:"bytes,2,opt,name=payer,proto3" json:"payer,omitempty"`
+	// payee is the address of the account receiving the stream.
+	Payee string `protobuf:"bytes,3,opt,name=payee,proto3" json:"payee,omitempty"`
+	// rate_per_second is the amount accrued to the payee every second.
+	RatePerSecond types.Coin `protobuf:"bytes,4,opt,name=rate_per_second,json=ratePerSecond,proto3" json:"rate_per_second"`
+	// start_time is the time the stream was opened at.
+	StartTime time.Time `protobuf:"bytes,5,opt,name=start_time,json=startTime,proto3,stdtime" json:"start_time"`
+	// end_time is the time the stream stops accruing at.
+	EndTime time.Time `protobuf:"bytes,6,opt,name=end_time,json=endTime,proto3,stdtime" json:"end_time"`
+	// cancelable defines whether the payer can cancel the stream before its end
+	// time, the unaccrued funds returning to the payer.
+	Cancelable bool `protobuf:"varint,7,opt,name=cancelable,proto3" json:"cancelable,omitempty"`
+	// withdrawn is the amount already withdrawn by the payee.
+	Withdrawn types.Coin `protobuf:"bytes,8,opt,name=withdrawn,proto3" json:"withdrawn"`
+	// community_pool defines whether the stream is funded by the community pool,
+	// to which the unaccrued funds return on cancellation.
+	CommunityPool bool `protobuf:"varint,9,opt,name=community_pool,json=communityPool,proto3" json:"community_pool,omitempty"`
+}
+
+func (m *Stream) Reset()         { *m = Stream{} }
+func (m *Stream) String() string { return proto.CompactTextString(m) }
+func (*Stream) ProtoMessage()    {}
+func (*Stream) Descriptor() ([]byte, []int) {
+	return fileDescriptor_323e0ee884e63640, []int{0}
+}
+func (m *Stream) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Stream) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Stream.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Stream) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Stream.Merge(m, src)
+}
+func (m *Stream) XXX_Size() int {
+	return m.Size()
+}
+func (m *Stream) XXX_DiscardUnknown() {
+	xxx_messageInfo_Stream.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Stream proto.InternalMessageInfo
+
+func (m *Stream) GetId() uint64 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
+}
+
+func (m *Stream) GetPayer() string {
+	if m != nil {
+		return m.Payer
+	}
+	return ""
+}
+
+func (m *Stream) GetPayee() string {
+	if m != nil {
+		return m.Payee
+	}
+	return ""
+}
+
+func (m *Stream) GetRatePerSecond() types.Coin {
+	if m != nil {
+		return m.RatePerSecond
+	}
+	return types.Coin{}
+}
+
+func (m *Stream) GetStartTime() time.Time {
+	if m != nil {
+		return m.StartTime
+	}
+	return time.Time{}
+}
+
+func (m *Stream) GetEndTime() time.Time {
+	if m != nil {
+		return m.EndTime
+	}
+	return time.Time{}
+}
+
+func (m *Stream) GetCancelable() bool {
+	if m != nil {
+		return m.Cancelable
+	}
+	return false
+}
+
+func (m *Stream) GetWithdrawn() types.Coin {
+	if m != nil {
+		return m.Withdrawn
+	}
+	return types.Coin{}
+}
+
+func (m *Stream) GetCommunityPool() bool {
+	if m != nil {
+		return m.CommunityPool
+	}
+	return false
+}
+
+func init() {
+	proto.RegisterType((*Stream)(nil), "cosmos.payments.v1.Stream")
+}
+
+func init() { proto.RegisterFile("cosmos/payments/v1/payments.proto", fileDescriptor_323e0ee884e63640) }
+
+var fileDescriptor_323e0ee884e63640 = []byte{
+	// 444 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x92, 0x3d, 0x6f, 0xd3, 0x40,
+	0x18, 0xc7, 0x73, 0x69, 0x9b, 0xc6, 0x87, 0x52, 0x84, 0xd5, 0xc1, 0xcd, 0xe0, 0x04, 0x24, 0xa4,
+	0x08, 0x29, 0x67, 0x05, 0x3e, 0x01, 0x06, 0x09, 0x06, 0x86, 0x2a, 0x61, 0x62, 0xb1, 0xce, 0xf6,
+	0x83, 0x7b, 0xc2, 0xbe, 0xc7, 0xba, 0xbb, 0xa4, 0xe4, 0x5b, 0xf4, 0x63, 0x30, 0x32, 0xf0, 0x21,
+	0x3a, 0x56, 0x4c, 0x4c, 0xbc, 0x24, 0x03, 0x5f, 0x03, 0xf9, 0xce, 0x49, 0x19, 0xdb, 0xc5, 0xbe,
+	0x7b, 0xfe, 0x2f, 0x8f, 0x7e, 0xd2, 0xd1, 0xc7, 0x19, 0xea, 0x0a, 0x75, 0x54, 0xf3, 0x75, 0x05,
+	0xd2, 0xe8, 0x68, 0x35, 0xdb, 0x9f, 0x59, 0xad, 0xd0, 0xa0, 0xef, 0x3b, 0x0b, 0xdb, 0x8f, 0x57,
+	0xb3, 0xe1, 0x69, 0x81, 0x05, 0x5a, 0x39, 0x6a, 0x4e, 0xce, 0x39, 0x7c, 0xc4, 0x2b, 0x21, 0x31,
+	0xb2, 0xdf, 0x76, 0x74, 0xe6, 0xc2, 0x89, 0xf3, 0xee, 0x9a, 0xac, 0x14, 0xb6, 0xab, 0x53, 0xae,
+	0x21, 0x5a, 0xcd, 0x52, 0x30, 0x7c, 0x16, 0x65, 0x28, 0x64, 0xab, 0x8f, 0x0a, 0xc4, 0xa2, 0x84,
+	0xc8, 0xde, 0xd2, 0xe5, 0xc7, 0xc8, 0x88, 0x0a, 0xb4, 0xe1, 0x55, 0xed, 0x0c, 0x4f, 0xfe, 0x1c,
+	0xd0, 0xde, 0xc2, 0x28, 0xe0, 0x95, 0x7f, 0x42, 0xbb, 0x22, 0x0f, 0xc8, 0x98, 0x4c, 0x0e, 0xe7,
+	0x5d, 0x91, 0xfb, 0x8c, 0x1e, 0xd5, 0x7c, 0x0d, 0x2a, 0xe8, 0x8e, 0xc9, 0xc4, 0x8b, 0x83, 0xef,
+	0xdf, 0xa6, 0xa7, 0xed, 0xf2, 0x97, 0x79, 0xae, 0x40, 0xeb, 0x85, 0x51, 0x42, 0x16, 0x73, 0x67,
+	0xdb, 0xf9, 0x21, 0x38, 0xb8, 0x8b, 0x1f, 0xfc, 0x77, 0xf4, 0xa1, 0xe2, 0x06, 0x92, 0x1a, 0x54,
+	0xa2, 0x21, 0x43, 0x99, 0x07, 0x87, 0x63, 0x32, 0x79, 0xf0, 0xfc, 0x8c, 0xb5, 0xb1, 0x86, 0x8a,
+	0xb5, 0x54, 0xec, 0x15, 0x0a, 0x19, 0x7b, 0xd7, 0x3f, 0x47, 0x9d, 0x2f, 0x7f, 0xbf, 0x3e, 0x23,
+	0xf3, 0x41, 0x13, 0x3e, 0x07, 0xb5, 0xb0, 0x51, 0xff, 0x2d, 0xa5, 0xda, 0x70, 0x65, 0x92, 0x86,
+	0x30, 0x38, 0xb2, 0x45, 0x43, 0xe6, 0xf0, 0xd9, 0x0e, 0x9f, 0xbd, 0xdf, 0xe1, 0xc7, 0x83, 0xa6,
+	0xe9, 0xea, 0xd7, 0x88, 0xb8, 0x36, 0xcf, 0x86, 0x1b, 0xd9, 0x7f, 0x4d, 0xfb, 0x20, 0x73, 0xd7,
+	0xd3, 0xbb, 0x6f, 0xcf, 0x31, 0xc8, 0xdc, 0xb6, 0x84, 0x94, 0x66, 0x5c, 0x66, 0x50, 0xf2, 0xb4,
+	0x84, 0xe0, 0x78, 0x4c, 0x26, 0xfd, 0xf9, 0x7f, 0x13, 0x3f, 0xa6, 0xde, 0xa5, 0x30, 0x17, 0xb9,
+	0xe2, 0x97, 0x32, 0xe8, 0xdf, 0x83, 0xfb, 0x36, 0xe6, 0x3f, 0xa5, 0x27, 0x19, 0x56, 0xd5, 0x52,
+	0x0a, 0xb3, 0x4e, 0x6a, 0xc4, 0x32, 0xf0, 0xec, 0x9e, 0xc1, 0x7e, 0x7a, 0x8e, 0x58, 0xc6, 0x6f,
+	0xae, 0x37, 0x21, 0xb9, 0xd9, 0x84, 0xe4, 0xf7, 0x26, 0x24, 0x57, 0xdb, 0xb0, 0x73, 0xb3, 0x0d,
+	0x3b, 0x3f, 0xb6, 0x61, 0xe7, 0xc3, 0xb4, 0x10, 0xe6, 0x62, 0x99, 0xb2, 0x0c, 0xab, 0xf6, 0x5d,
+	0xb5, 0xbf, 0xa9, 0xce, 0x3f, 0x45, 0x9f, 0x6f, 0x5f, 0xb4, 0x59, 0xd7, 0xa0, 0xd3, 0x9e, 0xe5,
+	0x7f, 0xf1, 0x6f, 0x00, 0xa1, 0x02, 0x23, 0xfc, 0xf1, 0x02, 0x00, 0x00,
+}
+
+func (m *Stream) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Stream) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Stream) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.CommunityPool {
+		i--
+		if m.CommunityPool {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x48
+	}
+	{
+		size, err := m.Withdrawn.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintPayments(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x42
+	if m.Cancelable {
+		i--
+		if m.Cancelable {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x38
+	}
+	n2, err2 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.EndTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.EndTime):])
+	if err2 != nil {
+		return 0, err2
+	}
+	i -= n2
+	i = encodeVarintPayments(dAtA, i, uint64(n2))
+	i--
+	dAtA[i] = 0x32
+	n3, err3 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.StartTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.StartTime):])
+	if err3 != nil {
+		return 0, err3
+	}
+	i -= n3
+	i = encodeVarintPayments(dAtA, i, uint64(n3))
+	i--
+	dAtA[i] = 0x2a
+	{
+		size, err := m.RatePerSecond.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintPayments(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	if len(m.Payee) > 0 {
+		i -= len(m.Payee)
+		copy(dAtA[i:], m.Payee)
+		i = encodeVarintPayments(dAtA, i, uint64(len(m.Payee)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Payer) > 0 {
+		i -= len(m.Payer)
+		copy(dAtA[i:], m.Payer)
+		i = encodeVarintPayments(dAtA, i, uint64(len(m.Payer)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Id != 0 {
+		i = encodeVarintPayments(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintPayments(dAtA []byte, offset int, v uint64) int {
+	offset -= sovPayments(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *Stream) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovPayments(uint64(m.Id))
+	}
+	l = len(m.Payer)
+	if l > 0 {
+		n += 1 + l + sovPayments(uint64(l))
+	}
+	l = len(m.Payee)
+	if l > 0 {
+		n += 1 + l + sovPayments(uint64(l))
+	}
+	l = m.RatePerSecond.Size()
+	n += 1 + l + sovPayments(uint64(l))
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.StartTime)
+	n += 1 + l + sovPayments(uint64(l))
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.EndTime)
+	n += 1 + l + sovPayments(uint64(l))
+	if m.Cancelable {
+		n += 2
+	}
+	l = m.Withdrawn.Size()
+	n += 1 + l + sovPayments(uint64(l))
+	if m.CommunityPool {
+		n += 2
+	}
+	return n
+}
+
+func sovPayments(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozPayments(x uint64) (n int) {
+	return sovPayments(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *Stream) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPayments
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Stream: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Stream: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPayments
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Payer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPayments
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPayments
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPayments
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Payer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Payee", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPayments
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPayments
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPayments
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Payee = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RatePerSecond", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPayments
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPayments
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPayments
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.RatePerSecond.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPayments
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPayments
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPayments
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.StartTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EndTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPayments
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPayments
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPayments
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.EndTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Cancelable", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPayments
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Cancelable = bool(v != 0)
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Withdrawn", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPayments
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPayments
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPayments
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Withdrawn.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CommunityPool", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPayments
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.CommunityPool = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPayments(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthPayments
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipPayments(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowPayments
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowPayments
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowPayments
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthPayments
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupPayments
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthPayments
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthPayments        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowPayments          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupPayments = fmt.Errorf("proto: unexpected end of group")
+)
@@ -0,0 +1,1683 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: cosmos/payments/v1/query.proto
+
+package types
+
+import (
+	context "context"
+	fmt "fmt"
+	_ "github.com/cosmos/cosmos-proto"
+	types "github.com/cosmos/cosmos-sdk/types"
+	query "github.com/cosmos/cosmos-sdk/types/query"
+	_ "github.com/cosmos/cosmos-sdk/types/tx/amino"
+	_ "github.com/cosmos/gogoproto/gogoproto"
+	grpc1 "github.com/cosmos/gogoproto/grpc"
+	proto "github.com/cosmos/gogoproto/proto"
+	_ "google.golang.org/genproto/googleapis/api/annotations"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// QueryStreamRequest is the request type for the Query/Stream RPC method.
+type QueryStreamRequest struct {
+	// stream_id is the identifier of the stream.
+	StreamId uint64 `protobuf:"varint,1,opt,name=stream_id,json=streamId,proto3" json:"stream_id,omitempty"`
+}
+
+func (m *QueryStreamRequest) Reset()         { *m = QueryStreamRequest{} }
+func (m *QueryStreamRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryStreamRequest) ProtoMessage()    {}
+func (*QueryStreamRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4af907b1f7667bc6, []int{0}
+}
+func (m *QueryStreamRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryStreamRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryStreamRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryStreamRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryStreamRequest.Merge(m, src)
+}
+func (m *QueryStreamRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryStreamRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryStreamRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryStreamRequest proto.InternalMessageInfo
+
+func (m *QueryStreamRequest) GetStreamId() uint64 {
+	if m != nil {
+		return m.StreamId
+	}
+	return 0
+}
+
+// QueryStreamResponse is the response type for the Query/Stream RPC method.
+type QueryStreamResponse struct {
+	// stream is the payment stream.
+	Stream Stream `protobuf:"bytes,1,opt,name=stream,proto3" json:"stream"`
+	// withdrawable is the amount accrued to the payee and not withdrawn yet.
+	Withdrawable types.Coin `protobuf:"bytes,2,opt,name=withdrawable,proto3" json:"withdrawable"`
+}
+
+func (m *QueryStreamResponse) Reset()         { *m = QueryStreamResponse{} }
+func (m *QueryStreamResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryStreamResponse) ProtoMessage()    {}
+func (*QueryStreamResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4af907b1f7667bc6, []int{1}
+}
+func (m *QueryStreamResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryStreamResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryStreamResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryStreamResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryStreamResponse.Merge(m, src)
+}
+func (m *QueryStreamResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryStreamResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryStreamResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryStreamResponse proto.InternalMessageInfo
+
+func (m *QueryStreamResponse) GetStream() Stream {
+	if m != nil {
+		return m.Stream
+	}
+	return Stream{}
+}
+
+func (m *QueryStreamResponse) GetWithdrawable() types.Coin {
+	if m != nil {
+		return m.Withdrawable
+	}
+	return types.Coin{}
+}
+
+// QueryStreamsByPayerRequest is the request type for the Query/StreamsByPayer
+// RPC method.
+type QueryStreamsByPayerRequest struct {
+	// payer is the address of the payer of the streams.
+	Payer string `protobuf:"bytes,1,opt,name=payer,proto3" json:"payer,omitempty"`
+	// pagination defines an optional pagination for the request.
+	Pagination *query.PageRequest `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryStreamsByPayerRequest) Reset()         { *m = QueryStreamsByPayerRequest{} }
+func (m *QueryStreamsByPayerRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryStreamsByPayerRequest) ProtoMessage()    {}
+func (*QueryStreamsByPayerRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4af907b1f7667bc6, []int{2}
+}
+func (m *QueryStreamsByPayerRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryStreamsByPayerRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryStreamsByPayerRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryStreamsByPayerRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryStreamsByPayerRequest.Merge(m, src)
+}
+func (m *QueryStreamsByPayerRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryStreamsByPayerRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryStreamsByPayerRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryStreamsByPayerRequest proto.InternalMessageInfo
+
+func (m *QueryStreamsByPayerRequest) GetPayer() string {
+	if m != nil {
+		return m.Payer
+	}
+	return ""
+}
+
+func (m *QueryStreamsByPayerRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+// QueryStreamsByPayerResponse is the response type for the
+// Query/StreamsByPayer RPC method.
+type QueryStreamsByPayerResponse struct {
+	// streams are the payment streams funded by the account.
+	Streams []Stream `protobuf:"bytes,1,rep,name=streams,proto3" json:"streams"`
+	// pagination defines the pagination in the response.
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryStreamsByPayerResponse) Reset()         { *m = QueryStreamsByPayerResponse{} }
+func (m *QueryStreamsByPayerResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryStreamsByPayerResponse) ProtoMessage()    {}
+func (*QueryStreamsByPayerResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4af907b1f7667bc6, []int{3}
+}
+func (m *QueryStreamsByPayerResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryStreamsByPayerResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryStreamsByPayerResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryStreamsByPayerResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryStreamsByPayerResponse.Merge(m, src)
+}
+func (m *QueryStreamsByPayerResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryStreamsByPayerResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryStreamsByPayerResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryStreamsByPayerResponse proto.InternalMessageInfo
+
+func (m *QueryStreamsByPayerResponse) GetStreams() []Stream {
+	if m != nil {
+		return m.Streams
+	}
+	return nil
+}
+
+func (m *QueryStreamsByPayerResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+// QueryStreamsByPayeeRequest is the request type for the Query/StreamsByPayee
+// RPC method.
+type QueryStreamsByPayeeRequest struct {
+	// payee is the address of the payee of the streams.
+	Payee string `protobuf:"bytes,1,opt,name=payee,proto3" json:"payee,omitempty"`
+	// pagination defines an optional pagination for the request.
+	Pagination *query.PageRequest `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryStreamsByPayeeRequest) Reset()         { *m = QueryStreamsByPayeeRequest{} }
+func (m *QueryStreamsByPayeeRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryStreamsByPayeeRequest) ProtoMessage()    {}
+func (*QueryStreamsByPayeeRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4af907b1f7667bc6, []int{4}
+}
+func (m *QueryStreamsByPayeeRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryStreamsByPayeeRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryStreamsByPayeeRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryStreamsByPayeeRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryStreamsByPayeeRequest.Merge(m, src)
+}
+func (m *QueryStreamsByPayeeRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryStreamsByPayeeRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryStreamsByPayeeRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryStreamsByPayeeRequest proto.InternalMessageInfo
+
+func (m *QueryStreamsByPayeeRequest) GetPayee() string {
+	if m != nil {
+		return m.Payee
+	}
+	return ""
+}
+
+func (m *QueryStreamsByPayeeRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+// QueryStreamsByPayeeResponse is the response type for the
+// Query/StreamsByPayee RPC method.
+type QueryStreamsByPayeeResponse struct {
+	// streams are the payment streams received by the account.
+	Streams []Stream `protobuf:"bytes,1,rep,name=streams,proto3" json:"streams"`
+	// pagination defines the pagination in the response.
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryStreamsByPayeeResponse) Reset()         { *m = QueryStreamsByPayeeResponse{} }
+func (m *QueryStreamsByPayeeResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryStreamsByPayeeResponse) ProtoMessage()    {}
+func (*QueryStreamsByPayeeResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4af907b1f7667bc6, []int{5}
+}
+func (m *QueryStreamsByPayeeResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryStreamsByPayeeResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryStreamsByPayeeResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryStreamsByPayeeResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryStreamsByPayeeResponse.Merge(m, src)
+}
+func (m *QueryStreamsByPayeeResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryStreamsByPayeeResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryStreamsByPayeeResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryStreamsByPayeeResponse proto.InternalMessageInfo
+
+func (m *QueryStreamsByPayeeResponse) GetStreams() []Stream {
+	if m != nil {
+		return m.Streams
+	}
+	return nil
+}
+
+func (m *QueryStreamsByPayeeResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterType((*QueryStreamRequest)(nil), "cosmos.payments.v1.QueryStreamRequest")
+	proto.RegisterType((*QueryStreamResponse)(nil), "cosmos.payments.v1.QueryStreamResponse")
+	proto.RegisterType((*QueryStreamsByPayerRequest)(nil), "cosmos.payments.v1.QueryStreamsByPayerRequest")
+	proto.RegisterType((*QueryStreamsByPayerResponse)(nil), "cosmos.payments.v1.QueryStreamsByPayerResponse")
+	proto.RegisterType((*QueryStreamsByPayeeRequest)(nil), "cosmos.payments.v1.QueryStreamsByPayeeRequest")
+	proto.RegisterType((*QueryStreamsByPayeeResponse)(nil), "cosmos.payments.v1.QueryStreamsByPayeeResponse")
+}
+
+func init() { proto.RegisterFile("cosmos/payments/v1/query.proto", fileDescriptor_4af907b1f7667bc6) }
+
+var fileDescriptor_4af907b1f7667bc6 = []byte{
+	// 574 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x94, 0xb1, 0x6f, 0xd3, 0x4e,
+	0x14, 0xc7, 0x73, 0xed, 0xaf, 0xf9, 0x91, 0x03, 0x21, 0x71, 0x74, 0x48, 0x5d, 0x64, 0x4a, 0x86,
+	0x06, 0x2a, 0x72, 0xa7, 0x14, 0x56, 0x84, 0x08, 0x12, 0x85, 0xad, 0xa4, 0x1b, 0x4b, 0x74, 0x8e,
+	0x9f, 0x5c, 0x8b, 0xc6, 0xe7, 0xfa, 0x2e, 0x29, 0x56, 0xd5, 0x85, 0x91, 0x09, 0x09, 0x66, 0xd6,
+	0x32, 0x32, 0xf0, 0x47, 0x74, 0xac, 0x60, 0x61, 0x42, 0x28, 0x41, 0x82, 0x3f, 0x03, 0xe5, 0xee,
+	0x4c, 0xe3, 0xd6, 0x22, 0x01, 0x09, 0x89, 0x25, 0x76, 0xee, 0x7d, 0xdf, 0x7b, 0x9f, 0xf7, 0xd5,
+	0x3d, 0x63, 0xb7, 0x2b, 0x64, 0x4f, 0x48, 0x16, 0xf3, 0xb4, 0x07, 0x91, 0x92, 0x6c, 0xd0, 0x64,
+	0xbb, 0x7d, 0x48, 0x52, 0x1a, 0x27, 0x42, 0x09, 0x42, 0x4c, 0x9c, 0x66, 0x71, 0x3a, 0x68, 0x3a,
+	0x8b, 0x81, 0x08, 0x84, 0x0e, 0xb3, 0xf1, 0x9b, 0x51, 0x3a, 0x97, 0x78, 0x2f, 0x8c, 0x04, 0xd3,
+	0xbf, 0xf6, 0xe8, 0x4a, 0x20, 0x44, 0xb0, 0x03, 0x8c, 0xc7, 0x21, 0xe3, 0x51, 0x24, 0x14, 0x57,
+	0xa1, 0x88, 0xa4, 0x8d, 0x2e, 0x99, 0xd2, 0x1d, 0x53, 0x29, 0xeb, 0xa3, 0x43, 0x6b, 0x96, 0xca,
+	0xe3, 0x12, 0x0c, 0x0e, 0x1b, 0x34, 0x3d, 0x50, 0xbc, 0xc9, 0x62, 0x1e, 0x84, 0x91, 0xae, 0x63,
+	0xb5, 0xee, 0xa4, 0x36, 0x53, 0x75, 0x45, 0x98, 0xc5, 0xaf, 0x15, 0x4c, 0x98, 0xbd, 0x1b, 0x49,
+	0xad, 0x89, 0xc9, 0xe3, 0x71, 0x93, 0x2d, 0x95, 0x00, 0xef, 0xb5, 0x61, 0xb7, 0x0f, 0x52, 0x91,
+	0x65, 0x5c, 0x91, 0xfa, 0xa0, 0x13, 0xfa, 0x55, 0xb4, 0x82, 0xae, 0xff, 0xd7, 0x3e, 0x67, 0x0e,
+	0x1e, 0xf9, 0xb5, 0x37, 0x08, 0x5f, 0xce, 0xe5, 0xc8, 0x58, 0x44, 0x12, 0xc8, 0x1d, 0x5c, 0x36,
+	0x1a, 0x9d, 0x71, 0x7e, 0xdd, 0xa1, 0x67, 0x0d, 0xa4, 0x26, 0xa7, 0x55, 0x39, 0xfa, 0x7c, 0xb5,
+	0xf4, 0xf6, 0xdb, 0xbb, 0x35, 0xd4, 0xb6, 0x49, 0xe4, 0x21, 0xbe, 0xb0, 0x17, 0xaa, 0x6d, 0x3f,
+	0xe1, 0x7b, 0xdc, 0xdb, 0x81, 0xea, 0x9c, 0x2e, 0xb2, 0x94, 0x15, 0x19, 0xcf, 0x48, 0xed, 0x8c,
+	0xf4, 0xbe, 0x08, 0xa3, 0xc9, 0x1a, 0xb9, 0xcc, 0xda, 0x6b, 0x84, 0x9d, 0x09, 0x40, 0xd9, 0x4a,
+	0x37, 0x79, 0x0a, 0x49, 0x36, 0x1c, 0xc5, 0x0b, 0xf1, 0xf8, 0xbf, 0xc6, 0xac, 0xb4, 0xaa, 0x1f,
+	0xde, 0x37, 0x16, 0x6d, 0x93, 0x7b, 0xbe, 0x9f, 0x80, 0x94, 0x5b, 0x2a, 0x09, 0xa3, 0xa0, 0x6d,
+	0x64, 0xe4, 0x01, 0xc6, 0x27, 0xce, 0x5b, 0xac, 0xd5, 0x1c, 0x96, 0xb9, 0x35, 0x19, 0xdc, 0x26,
+	0x0f, 0xc0, 0xf6, 0x6a, 0x4f, 0x64, 0xd6, 0x0e, 0x11, 0x5e, 0x2e, 0xc4, 0xb2, 0xfe, 0xdd, 0xc5,
+	0xff, 0x1b, 0x2b, 0x64, 0x15, 0xad, 0xcc, 0xcf, 0x6e, 0x60, 0x96, 0x45, 0x36, 0x0a, 0x40, 0xeb,
+	0x53, 0x41, 0x4d, 0xf7, 0x1c, 0x69, 0xb1, 0x81, 0x70, 0xca, 0x40, 0x98, 0xcd, 0x40, 0xf8, 0xcb,
+	0x06, 0xc2, 0xbf, 0x67, 0xe0, 0xfa, 0xf7, 0x79, 0xbc, 0xa0, 0x49, 0xc9, 0x0b, 0x84, 0xcb, 0xa6,
+	0x23, 0x59, 0x2d, 0xa2, 0x39, 0xbb, 0x7c, 0x4e, 0x7d, 0xaa, 0xce, 0x74, 0xac, 0xb1, 0xe7, 0x1f,
+	0xbf, 0xbe, 0x9a, 0xbb, 0x41, 0xea, 0xac, 0x60, 0xcf, 0xed, 0x4c, 0x6c, 0xff, 0xe7, 0x22, 0x1f,
+	0x90, 0x43, 0x84, 0x2f, 0xe6, 0x2f, 0x1f, 0xa1, 0x53, 0x9a, 0x9d, 0x5a, 0x1e, 0x87, 0xcd, 0xac,
+	0xb7, 0x90, 0xb7, 0x35, 0x24, 0x25, 0x37, 0x7f, 0x05, 0xe9, 0xa5, 0x1d, 0xbd, 0x6b, 0x6c, 0x5f,
+	0x3f, 0x0a, 0x48, 0x61, 0x56, 0x52, 0xf8, 0x4d, 0x52, 0xf8, 0x23, 0x52, 0x30, 0xa4, 0x70, 0xd0,
+	0xda, 0x38, 0x1a, 0xba, 0xe8, 0x78, 0xe8, 0xa2, 0x2f, 0x43, 0x17, 0xbd, 0x1c, 0xb9, 0xa5, 0xe3,
+	0x91, 0x5b, 0xfa, 0x34, 0x72, 0x4b, 0x4f, 0x1a, 0x41, 0xa8, 0xb6, 0xfb, 0x1e, 0xed, 0x8a, 0x5e,
+	0x56, 0xd1, 0x3c, 0x1a, 0xd2, 0x7f, 0xca, 0x9e, 0x9d, 0x94, 0x57, 0x69, 0x0c, 0xd2, 0x2b, 0xeb,
+	0x0f, 0xf2, 0xad, 0x1f, 0x03, 0x00, 0x4d, 0xd4, 0xbf, 0x0d, 0x97, 0x06, 0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// QueryClient is the client API for Query service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type QueryClient interface {
+	// Stream queries a payment stream, along with the amount its payee can
+	// withdraw.
+	Stream(ctx context.Context, in *QueryStreamRequest, opts ...grpc.CallOption) (*QueryStreamResponse, error)
+	// StreamsByPayer queries the payment streams funded by an account.
+	StreamsByPayer(ctx context.Context, in *QueryStreamsByPayerRequest, opts ...grpc.CallOption) (*QueryStreamsByPayerResponse, error)
+	// StreamsByPayee queries the payment streams received by an account.
+	StreamsByPayee(ctx context.Context, in *QueryStreamsByPayeeRequest, opts ...grpc.CallOption) (*QueryStreamsByPayeeResponse, error)
+}
+
+type queryClient struct {
+	cc grpc1.ClientConn
+}
+
+func NewQueryClient(cc grpc1.ClientConn) QueryClient {
+	return &queryClient{cc}
+}
+
+func (c *queryClient) Stream(ctx context.Context, in *QueryStreamRequest, opts ...grpc.CallOption) (*QueryStreamResponse, error) {
+	out := new(QueryStreamResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.payments.v1.Query/Stream", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) StreamsByPayer(ctx context.Context, in *QueryStreamsByPayerRequest, opts ...grpc.CallOption) (*QueryStreamsByPayerResponse, error) {
+	out := new(QueryStreamsByPayerResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.payments.v1.Query/StreamsByPayer", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) StreamsByPayee(ctx context.Context, in *QueryStreamsByPayeeRequest, opts ...grpc.CallOption) (*QueryStreamsByPayeeResponse, error) {
+	out := new(QueryStreamsByPayeeResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.payments.v1.Query/StreamsByPayee", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// QueryServer is the server API for Query service.
+type QueryServer interface {
+	// Stream queries a payment stream, along with the amount its payee can
+	// withdraw.
+	Stream(context.Context, *QueryStreamRequest) (*QueryStreamResponse, error)
+	// StreamsByPayer queries the payment streams funded by an account.
+	StreamsByPayer(context.Context, *QueryStreamsByPayerRequest) (*QueryStreamsByPayerResponse, error)
+	// StreamsByPayee queries the payment streams received by an account.
+	StreamsByPayee(context.Context, *QueryStreamsByPayeeRequest) (*QueryStreamsByPayeeResponse, error)
+}
+
+// UnimplementedQueryServer can be embedded to have forward compatible implementations.
+type UnimplementedQueryServer struct {
+}
+
+func (*UnimplementedQueryServer) Stream(ctx context.Context, req *QueryStreamRequest) (*QueryStreamResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Stream not implemented")
+}
+func (*UnimplementedQueryServer) StreamsByPayer(ctx context.Context, req *QueryStreamsByPayerRequest) (*QueryStreamsByPayerResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method StreamsByPayer not implemented")
+}
+func (*UnimplementedQueryServer) StreamsByPayee(ctx context.Context, req *QueryStreamsByPayeeRequest) (*QueryStreamsByPayeeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method StreamsByPayee not implemented")
+}
+
+func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
+	s.RegisterService(&_Query_serviceDesc, srv)
+}
+
+func _Query_Stream_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryStreamRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Stream(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.payments.v1.Query/Stream",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Stream(ctx, req.(*QueryStreamRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_StreamsByPayer_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryStreamsByPayerRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).StreamsByPayer(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.payments.v1.Query/StreamsByPayer",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).StreamsByPayer(ctx, req.(*QueryStreamsByPayerRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_StreamsByPayee_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryStreamsByPayeeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).StreamsByPayee(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.payments.v1.Query/StreamsByPayee",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).StreamsByPayee(ctx, req.(*QueryStreamsByPayeeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _Query_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "cosmos.payments.v1.Query",
+	HandlerType: (*QueryServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Stream",
+			Handler:    _Query_Stream_Handler,
+		},
+		{
+			MethodName: "StreamsByPayer",
+			Handler:    _Query_StreamsByPayer_Handler,
+		},
+		{
+			MethodName: "StreamsByPayee",
+			Handler:    _Query_StreamsByPayee_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "cosmos/payments/v1/query.proto",
+}
+
+func (m *QueryStreamRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryStreamRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryStreamRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.StreamId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.StreamId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryStreamResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryStreamResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryStreamResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Withdrawable.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	{
+		size, err := m.Stream.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryStreamsByPayerRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryStreamsByPayerRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryStreamsByPayerRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Payer) > 0 {
+		i -= len(m.Payer)
+		copy(dAtA[i:], m.Payer)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Payer)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryStreamsByPayerResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryStreamsByPayerResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryStreamsByPayerResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Streams) > 0 {
+		for iNdEx := len(m.Streams) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Streams[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryStreamsByPayeeRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryStreamsByPayeeRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryStreamsByPayeeRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Payee) > 0 {
+		i -= len(m.Payee)
+		copy(dAtA[i:], m.Payee)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Payee)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryStreamsByPayeeResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryStreamsByPayeeResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryStreamsByPayeeResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Streams) > 0 {
+		for iNdEx := len(m.Streams) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Streams[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
+	offset -= sovQuery(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *QueryStreamRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.StreamId != 0 {
+		n += 1 + sovQuery(uint64(m.StreamId))
+	}
+	return n
+}
+
+func (m *QueryStreamResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Stream.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.Withdrawable.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryStreamsByPayerRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Payer)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryStreamsByPayerResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Streams) > 0 {
+		for _, e := range m.Streams {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryStreamsByPayeeRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Payee)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryStreamsByPayeeResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Streams) > 0 {
+		for _, e := range m.Streams {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func sovQuery(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozQuery(x uint64) (n int) {
+	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *QueryStreamRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryStreamRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryStreamRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StreamId", wireType)
+			}
+			m.StreamId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.StreamId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryStreamResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryStreamResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryStreamResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Stream", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Stream.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Withdrawable", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Withdrawable.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryStreamsByPayerRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryStreamsByPayerRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryStreamsByPayerRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Payer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Payer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryStreamsByPayerResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryStreamsByPayerResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryStreamsByPayerResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Streams", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Streams = append(m.Streams, Stream{})
+			if err := m.Streams[len(m.Streams)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryStreamsByPayeeRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryStreamsByPayeeRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryStreamsByPayeeRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Payee", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Payee = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryStreamsByPayeeResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryStreamsByPayeeResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryStreamsByPayeeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Streams", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Streams = append(m.Streams, Stream{})
+			if err := m.Streams[len(m.Streams)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipQuery(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthQuery
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupQuery
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthQuery
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthQuery        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowQuery          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupQuery = fmt.Errorf("proto: unexpected end of group")
+)
@@ -0,0 +1,94 @@
+package types
+
+import (
+	"time"
+
+	errorsmod "cosmossdk.io/errors"
+	"cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// NewStream returns a stream opened at the given start time.
+func NewStream(id uint64, payer, payee string, ratePerSecond sdk.Coin, startTime, endTime time.Time, cancelable, communityPool bool) Stream {
+	return Stream{
+		Id:            id,
+		Payer:         payer,
+		Payee:         payee,
+		RatePerSecond: ratePerSecond,
+		StartTime:     startTime,
+		EndTime:       endTime,
+		Cancelable:    cancelable,
+		Withdrawn:     sdk.NewCoin(ratePerSecond.Denom, math.ZeroInt()),
+		CommunityPool: communityPool,
+	}
+}
+
+// Accrued returns the amount accrued to the payee by the given time, i.e. the
+// rate per second times the number of whole seconds elapsed since the start
+// time, up to the end time.
+func (s Stream) Accrued(t time.Time) sdk.Coin {
+	if t.After(s.EndTime) {
+		t = s.EndTime
+	}
+
+	elapsed := int64(t.Sub(s.StartTime) / time.Second)
+	if elapsed <= 0 {
+		return sdk.NewCoin(s.RatePerSecond.Denom, math.ZeroInt())
+	}
+
+	return sdk.NewCoin(s.RatePerSecond.Denom, s.RatePerSecond.Amount.MulRaw(elapsed))
+}
+
+// Deposit returns the amount escrowed when the stream is opened, i.e. the
+// amount accrued by the end time.
+func (s Stream) Deposit() sdk.Coin {
+	return s.Accrued(s.EndTime)
+}
+
+// Withdrawable returns the amount accrued by the given time which isn't
+// withdrawn yet.
+func (s Stream) Withdrawable(t time.Time) sdk.Coin {
+	return s.Accrued(t).Sub(s.Withdrawn)
+}
+
+// IsCompleted returns whether the stream ended and its deposit was fully
+// withdrawn by the given time.
+func (s Stream) IsCompleted(t time.Time) bool {
+	return !t.Before(s.EndTime) && s.Withdrawn.IsGTE(s.Deposit())
+}
+
+// ValidateStreamTerms validates the rate and the duration of a stream opened
+// at the given start time.
+func ValidateStreamTerms(ratePerSecond sdk.Coin, startTime, endTime time.Time) error {
+	if !ratePerSecond.IsValid() || !ratePerSecond.IsPositive() {
+		return errorsmod.Wrapf(ErrInvalidStream, "invalid rate per second %s", ratePerSecond)
+	}
+
+	if endTime.Sub(startTime) < time.Second {
+		return errorsmod.Wrapf(ErrInvalidStream, "end time %s must be at least a second after start time %s", endTime, startTime)
+	}
+
+	return nil
+}
+
+// Validate performs basic validation of a stream.
+func (s Stream) Validate() error {
+	if _, err := sdk.AccAddressFromBech32(s.Payer); err != nil {
+		return errorsmod.Wrapf(err, "invalid payer address of stream %d", s.Id)
+	}
+
+	if _, err := sdk.AccAddressFromBech32(s.Payee); err != nil {
+		return errorsmod.Wrapf(err, "invalid payee address of stream %d", s.Id)
+	}
+
+	if err := ValidateStreamTerms(s.RatePerSecond, s.StartTime, s.EndTime); err != nil {
+		return errorsmod.Wrapf(err, "stream %d", s.Id)
+	}
+
+	if !s.Withdrawn.IsValid() || s.Withdrawn.Denom != s.RatePerSecond.Denom || s.Deposit().IsLT(s.Withdrawn) {
+		return errorsmod.Wrapf(ErrInvalidStream, "invalid withdrawn amount %s of stream %d", s.Withdrawn, s.Id)
+	}
+
+	return nil
+}
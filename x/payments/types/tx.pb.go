@@ -0,0 +1,2282 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: cosmos/payments/v1/tx.proto
+
+package types
+
+import (
+	context "context"
+	fmt "fmt"
+	_ "github.com/cosmos/cosmos-proto"
+	types "github.com/cosmos/cosmos-sdk/types"
+	_ "github.com/cosmos/cosmos-sdk/types/msgservice"
+	_ "github.com/cosmos/cosmos-sdk/types/tx/amino"
+	_ "github.com/cosmos/gogoproto/gogoproto"
+	grpc1 "github.com/cosmos/gogoproto/grpc"
+	proto "github.com/cosmos/gogoproto/proto"
+	github_com_cosmos_gogoproto_types "github.com/cosmos/gogoproto/types"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+	_ "google.golang.org/protobuf/types/known/timestamppb"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+	time "time"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+var _ = time.Kitchen
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// MsgCreateStream is the Msg/CreateStream request type.
+type MsgCreateStream struct {
+	// payer is the address of the account funding the stream.
+	Payer string `protobuf:"bytes,1,opt,name=payer,proto3" json:"payer,omitempty"`
+	// payee is the address of the account receiving the stream.
+	Payee string `protobuf:"bytes,2,opt,name=payee,proto3" json:"payee,omitempty"`
+	// rate_per_second is the amount accrued to the payee every second.
+	RatePerSecond types.Coin `protobuf:"bytes,3,opt,name=rate_per_second,json=ratePerSecond,proto3" json:"rate_per_second"`
+	// end_time is the time the stream stops accruing at. It must be at least a
+	// second after the time the stream is opened at.
+	EndTime time.Time `protobuf:"bytes,4,opt,name=end_time,json=endTime,proto3,stdtime" json:"end_time"`
+	// cancelable defines whether the payer can cancel the stream.
+	Cancelable bool `protobuf:"varint,5,opt,name=cancelable,proto3" json:"cancelable,omitempty"`
+}
+
+func (m *MsgCreateStream) Reset()         { *m = MsgCreateStream{} }
+func (m *MsgCreateStream) String() string { return proto.CompactTextString(m) }
+func (*MsgCreateStream) ProtoMessage()    {}
+func (*MsgCreateStream) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1d605759852e501a, []int{0}
+}
+func (m *MsgCreateStream) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgCreateStream) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgCreateStream.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgCreateStream) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgCreateStream.Merge(m, src)
+}
+func (m *MsgCreateStream) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgCreateStream) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgCreateStream.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgCreateStream proto.InternalMessageInfo
+
+func (m *MsgCreateStream) GetPayer() string {
+	if m != nil {
+		return m.Payer
+	}
+	return ""
+}
+
+func (m *MsgCreateStream) GetPayee() string {
+	if m != nil {
+		return m.Payee
+	}
+	return ""
+}
+
+func (m *MsgCreateStream) GetRatePerSecond() types.Coin {
+	if m != nil {
+		return m.RatePerSecond
+	}
+	return types.Coin{}
+}
+
+func (m *MsgCreateStream) GetEndTime() time.Time {
+	if m != nil {
+		return m.EndTime
+	}
+	return time.Time{}
+}
+
+func (m *MsgCreateStream) GetCancelable() bool {
+	if m != nil {
+		return m.Cancelable
+	}
+	return false
+}
+
+// MsgCreateStreamResponse defines the response structure for executing a
+// MsgCreateStream message.
+type MsgCreateStreamResponse struct {
+	// stream_id is the identifier of the opened stream.
+	StreamId uint64 `protobuf:"varint,1,opt,name=stream_id,json=streamId,proto3" json:"stream_id,omitempty"`
+}
+
+func (m *MsgCreateStreamResponse) Reset()         { *m = MsgCreateStreamResponse{} }
+func (m *MsgCreateStreamResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgCreateStreamResponse) ProtoMessage()    {}
+func (*MsgCreateStreamResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1d605759852e501a, []int{1}
+}
+func (m *MsgCreateStreamResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgCreateStreamResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgCreateStreamResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgCreateStreamResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgCreateStreamResponse.Merge(m, src)
+}
+func (m *MsgCreateStreamResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgCreateStreamResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgCreateStreamResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgCreateStreamResponse proto.InternalMessageInfo
+
+func (m *MsgCreateStreamResponse) GetStreamId() uint64 {
+	if m != nil {
+		return m.StreamId
+	}
+	return 0
+}
+
+// MsgCreateCommunityPoolStream is the Msg/CreateCommunityPoolStream request
+// type.
+type MsgCreateCommunityPoolStream struct {
+	// authority is the address that controls the module (defaults to x/gov unless overwritten).
+	Authority string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
+	// payee is the address of the account receiving the stream.
+	Payee string `protobuf:"bytes,2,opt,name=payee,proto3" json:"payee,omitempty"`
+	// rate_per_second is the amount accrued to the payee every second.
+	RatePerSecond types.Coin `protobuf:"bytes,3,opt,name=rate_per_second,json=ratePerSecond,proto3" json:"rate_per_second"`
+	// end_time is the time the stream stops accruing at.
+	EndTime time.Time `protobuf:"bytes,4,opt,name=end_time,json=endTime,proto3,stdtime" json:"end_time"`
+	// cancelable defines whether the authority can cancel the stream.
+	Cancelable bool `protobuf:"varint,5,opt,name=cancelable,proto3" json:"cancelable,omitempty"`
+}
+
+func (m *MsgCreateCommunityPoolStream) Reset()         { *m = MsgCreateCommunityPoolStream{} }
+func (m *MsgCreateCommunityPoolStream) String() string { return proto.CompactTextString(m) }
+func (*MsgCreateCommunityPoolStream) ProtoMessage()    {}
+func (*MsgCreateCommunityPoolStream) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1d605759852e501a, []int{2}
+}
+func (m *MsgCreateCommunityPoolStream) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgCreateCommunityPoolStream) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgCreateCommunityPoolStream.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgCreateCommunityPoolStream) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgCreateCommunityPoolStream.Merge(m, src)
+}
+func (m *MsgCreateCommunityPoolStream) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgCreateCommunityPoolStream) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgCreateCommunityPoolStream.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgCreateCommunityPoolStream proto.InternalMessageInfo
+
+func (m *MsgCreateCommunityPoolStream) GetAuthority() string {
+	if m != nil {
+		return m.Authority
+	}
+	return ""
+}
+
+func (m *MsgCreateCommunityPoolStream) GetPayee() string {
+	if m != nil {
+		return m.Payee
+	}
+	return ""
+}
+
+func (m *MsgCreateCommunityPoolStream) GetRatePerSecond() types.Coin {
+	if m != nil {
+		return m.RatePerSecond
+	}
+	return types.Coin{}
+}
+
+func (m *MsgCreateCommunityPoolStream) GetEndTime() time.Time {
+	if m != nil {
+		return m.EndTime
+	}
+	return time.Time{}
+}
+
+func (m *MsgCreateCommunityPoolStream) GetCancelable() bool {
+	if m != nil {
+		return m.Cancelable
+	}
+	return false
+}
+
+// MsgCreateCommunityPoolStreamResponse defines the response structure for
+// executing a MsgCreateCommunityPoolStream message.
+type MsgCreateCommunityPoolStreamResponse struct {
+	// stream_id is the identifier of the opened stream.
+	StreamId uint64 `protobuf:"varint,1,opt,name=stream_id,json=streamId,proto3" json:"stream_id,omitempty"`
+}
+
+func (m *MsgCreateCommunityPoolStreamResponse) Reset()         { *m = MsgCreateCommunityPoolStreamResponse{} }
+func (m *MsgCreateCommunityPoolStreamResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgCreateCommunityPoolStreamResponse) ProtoMessage()    {}
+func (*MsgCreateCommunityPoolStreamResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1d605759852e501a, []int{3}
+}
+func (m *MsgCreateCommunityPoolStreamResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgCreateCommunityPoolStreamResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgCreateCommunityPoolStreamResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgCreateCommunityPoolStreamResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgCreateCommunityPoolStreamResponse.Merge(m, src)
+}
+func (m *MsgCreateCommunityPoolStreamResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgCreateCommunityPoolStreamResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgCreateCommunityPoolStreamResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgCreateCommunityPoolStreamResponse proto.InternalMessageInfo
+
+func (m *MsgCreateCommunityPoolStreamResponse) GetStreamId() uint64 {
+	if m != nil {
+		return m.StreamId
+	}
+	return 0
+}
+
+// MsgWithdrawStream is the Msg/WithdrawStream request type.
+type MsgWithdrawStream struct {
+	// payee is the address of the payee of the stream.
+	Payee string `protobuf:"bytes,1,opt,name=payee,proto3" json:"payee,omitempty"`
+	// stream_id is the identifier of the stream.
+	StreamId uint64 `protobuf:"varint,2,opt,name=stream_id,json=streamId,proto3" json:"stream_id,omitempty"`
+}
+
+func (m *MsgWithdrawStream) Reset()         { *m = MsgWithdrawStream{} }
+func (m *MsgWithdrawStream) String() string { return proto.CompactTextString(m) }
+func (*MsgWithdrawStream) ProtoMessage()    {}
+func (*MsgWithdrawStream) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1d605759852e501a, []int{4}
+}
+func (m *MsgWithdrawStream) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgWithdrawStream) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgWithdrawStream.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgWithdrawStream) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgWithdrawStream.Merge(m, src)
+}
+func (m *MsgWithdrawStream) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgWithdrawStream) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgWithdrawStream.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgWithdrawStream proto.InternalMessageInfo
+
+func (m *MsgWithdrawStream) GetPayee() string {
+	if m != nil {
+		return m.Payee
+	}
+	return ""
+}
+
+func (m *MsgWithdrawStream) GetStreamId() uint64 {
+	if m != nil {
+		return m.StreamId
+	}
+	return 0
+}
+
+// MsgWithdrawStreamResponse defines the response structure for executing a
+// MsgWithdrawStream message.
+type MsgWithdrawStreamResponse struct {
+	// amount is the amount withdrawn.
+	Amount types.Coin `protobuf:"bytes,1,opt,name=amount,proto3" json:"amount"`
+}
+
+func (m *MsgWithdrawStreamResponse) Reset()         { *m = MsgWithdrawStreamResponse{} }
+func (m *MsgWithdrawStreamResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgWithdrawStreamResponse) ProtoMessage()    {}
+func (*MsgWithdrawStreamResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1d605759852e501a, []int{5}
+}
+func (m *MsgWithdrawStreamResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgWithdrawStreamResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgWithdrawStreamResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgWithdrawStreamResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgWithdrawStreamResponse.Merge(m, src)
+}
+func (m *MsgWithdrawStreamResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgWithdrawStreamResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgWithdrawStreamResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgWithdrawStreamResponse proto.InternalMessageInfo
+
+func (m *MsgWithdrawStreamResponse) GetAmount() types.Coin {
+	if m != nil {
+		return m.Amount
+	}
+	return types.Coin{}
+}
+
+// MsgCancelStream is the Msg/CancelStream request type.
+type MsgCancelStream struct {
+	// payer is the address of the payer of the stream, i.e. the module authority
+	// for the streams funded by the community pool.
+	Payer string `protobuf:"bytes,1,opt,name=payer,proto3" json:"payer,omitempty"`
+	// stream_id is the identifier of the stream.
+	StreamId uint64 `protobuf:"varint,2,opt,name=stream_id,json=streamId,proto3" json:"stream_id,omitempty"`
+}
+
+func (m *MsgCancelStream) Reset()         { *m = MsgCancelStream{} }
+func (m *MsgCancelStream) String() string { return proto.CompactTextString(m) }
+func (*MsgCancelStream) ProtoMessage()    {}
+func (*MsgCancelStream) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1d605759852e501a, []int{6}
+}
+func (m *MsgCancelStream) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgCancelStream) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgCancelStream.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgCancelStream) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgCancelStream.Merge(m, src)
+}
+func (m *MsgCancelStream) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgCancelStream) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgCancelStream.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgCancelStream proto.InternalMessageInfo
+
+func (m *MsgCancelStream) GetPayer() string {
+	if m != nil {
+		return m.Payer
+	}
+	return ""
+}
+
+func (m *MsgCancelStream) GetStreamId() uint64 {
+	if m != nil {
+		return m.StreamId
+	}
+	return 0
+}
+
+// MsgCancelStreamResponse defines the response structure for executing a
+// MsgCancelStream message.
+type MsgCancelStreamResponse struct {
+	// paid is the amount paid to the payee on cancellation.
+	Paid types.Coin `protobuf:"bytes,1,opt,name=paid,proto3" json:"paid"`
+	// refunded is the amount returned to the payer, or to the community pool.
+	Refunded types.Coin `protobuf:"bytes,2,opt,name=refunded,proto3" json:"refunded"`
+}
+
+func (m *MsgCancelStreamResponse) Reset()         { *m = MsgCancelStreamResponse{} }
+func (m *MsgCancelStreamResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgCancelStreamResponse) ProtoMessage()    {}
+func (*MsgCancelStreamResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1d605759852e501a, []int{7}
+}
+func (m *MsgCancelStreamResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgCancelStreamResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgCancelStreamResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgCancelStreamResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgCancelStreamResponse.Merge(m, src)
+}
+func (m *MsgCancelStreamResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgCancelStreamResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgCancelStreamResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgCancelStreamResponse proto.InternalMessageInfo
+
+func (m *MsgCancelStreamResponse) GetPaid() types.Coin {
+	if m != nil {
+		return m.Paid
+	}
+	return types.Coin{}
+}
+
+func (m *MsgCancelStreamResponse) GetRefunded() types.Coin {
+	if m != nil {
+		return m.Refunded
+	}
+	return types.Coin{}
+}
+
+func init() {
+	proto.RegisterType((*MsgCreateStream)(nil), "cosmos.payments.v1.MsgCreateStream")
+	proto.RegisterType((*MsgCreateStreamResponse)(nil), "cosmos.payments.v1.MsgCreateStreamResponse")
+	proto.RegisterType((*MsgCreateCommunityPoolStream)(nil), "cosmos.payments.v1.MsgCreateCommunityPoolStream")
+	proto.RegisterType((*MsgCreateCommunityPoolStreamResponse)(nil), "cosmos.payments.v1.MsgCreateCommunityPoolStreamResponse")
+	proto.RegisterType((*MsgWithdrawStream)(nil), "cosmos.payments.v1.MsgWithdrawStream")
+	proto.RegisterType((*MsgWithdrawStreamResponse)(nil), "cosmos.payments.v1.MsgWithdrawStreamResponse")
+	proto.RegisterType((*MsgCancelStream)(nil), "cosmos.payments.v1.MsgCancelStream")
+	proto.RegisterType((*MsgCancelStreamResponse)(nil), "cosmos.payments.v1.MsgCancelStreamResponse")
+}
+
+func init() { proto.RegisterFile("cosmos/payments/v1/tx.proto", fileDescriptor_1d605759852e501a) }
+
+var fileDescriptor_1d605759852e501a = []byte{
+	// 716 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe4, 0x55, 0xc1, 0x4f, 0x13, 0x4d,
+	0x14, 0xef, 0x50, 0xe0, 0x6b, 0xe7, 0xfb, 0xf8, 0x08, 0x1b, 0x12, 0xb6, 0x8b, 0x59, 0xc8, 0x2a,
+	0x91, 0x40, 0xba, 0x6b, 0xd1, 0x10, 0x42, 0x3c, 0x68, 0xab, 0x31, 0x26, 0x92, 0x90, 0x62, 0x62,
+	0xf4, 0x52, 0xa7, 0xdd, 0xc7, 0xb2, 0xb1, 0x3b, 0xb3, 0xd9, 0x99, 0x22, 0xbd, 0xa9, 0x17, 0x13,
+	0x4f, 0x1c, 0x34, 0xf1, 0x4f, 0xf0, 0xc8, 0xc1, 0xbf, 0xc0, 0x13, 0x47, 0xe2, 0xc9, 0xc4, 0x44,
+	0x0d, 0x1c, 0xf8, 0x37, 0xcc, 0xee, 0x6c, 0x97, 0xb6, 0x50, 0x58, 0xbc, 0x7a, 0x21, 0xf4, 0xbd,
+	0xdf, 0xef, 0xbd, 0xf7, 0x9b, 0xdf, 0xcc, 0x5b, 0x3c, 0xdd, 0x60, 0xdc, 0x63, 0xdc, 0xf2, 0x49,
+	0xdb, 0x03, 0x2a, 0xb8, 0xb5, 0x5d, 0xb2, 0xc4, 0x8e, 0xe9, 0x07, 0x4c, 0x30, 0x45, 0x91, 0x49,
+	0xb3, 0x93, 0x34, 0xb7, 0x4b, 0xda, 0xa4, 0xc3, 0x1c, 0x16, 0xa5, 0xad, 0xf0, 0x3f, 0x89, 0xd4,
+	0x26, 0x88, 0xe7, 0x52, 0x66, 0x45, 0x7f, 0xe3, 0x50, 0x41, 0x92, 0x6b, 0x12, 0xdb, 0xa9, 0x14,
+	0xa5, 0xa6, 0xe2, 0xa6, 0x1e, 0x77, 0xc2, 0x7e, 0x1e, 0x77, 0xe2, 0x84, 0x1e, 0x27, 0xea, 0x84,
+	0x83, 0xb5, 0x5d, 0xaa, 0x83, 0x20, 0x25, 0xab, 0xc1, 0x5c, 0x1a, 0xe7, 0x67, 0x1c, 0xc6, 0x9c,
+	0x26, 0x58, 0xd1, 0xaf, 0x7a, 0x6b, 0xd3, 0x12, 0xae, 0x07, 0x5c, 0x10, 0xcf, 0x97, 0x00, 0xe3,
+	0xfb, 0x10, 0x1e, 0x5f, 0xe3, 0x4e, 0x25, 0x00, 0x22, 0x60, 0x43, 0x04, 0x40, 0x3c, 0xc5, 0xc4,
+	0x23, 0x3e, 0x69, 0x43, 0xa0, 0xa2, 0x59, 0x34, 0x9f, 0x2f, 0xab, 0x5f, 0x3f, 0x17, 0x27, 0xe3,
+	0x71, 0xee, 0xda, 0x76, 0x00, 0x9c, 0x6f, 0x88, 0xc0, 0xa5, 0x4e, 0x55, 0xc2, 0x3a, 0x78, 0x50,
+	0x87, 0xd2, 0xe0, 0x41, 0x79, 0x84, 0xc7, 0x03, 0x22, 0xa0, 0xe6, 0x43, 0x50, 0xe3, 0xd0, 0x60,
+	0xd4, 0x56, 0xb3, 0xb3, 0x68, 0xfe, 0xdf, 0xa5, 0x82, 0x19, 0xd3, 0x42, 0x39, 0x66, 0x2c, 0xc7,
+	0xac, 0x30, 0x97, 0x96, 0xf3, 0xfb, 0x3f, 0x66, 0x32, 0x9f, 0x8e, 0xf7, 0x16, 0x50, 0x75, 0x2c,
+	0x24, 0xaf, 0x43, 0xb0, 0x11, 0x51, 0x95, 0x7b, 0x38, 0x07, 0xd4, 0xae, 0x85, 0xc2, 0xd4, 0xe1,
+	0xa8, 0x8c, 0x66, 0x4a, 0xd5, 0x66, 0x47, 0xb5, 0xf9, 0xb8, 0xa3, 0xba, 0x3c, 0x16, 0xd6, 0xd9,
+	0xfd, 0x39, 0x83, 0x64, 0xad, 0x7f, 0x80, 0xda, 0x61, 0x52, 0xd1, 0x31, 0x6e, 0x10, 0xda, 0x80,
+	0x26, 0xa9, 0x37, 0x41, 0x1d, 0x99, 0x45, 0xf3, 0xb9, 0x6a, 0x57, 0x64, 0xf5, 0xd6, 0x9b, 0xe3,
+	0xbd, 0x05, 0xa9, 0xf7, 0xdd, 0xf1, 0xde, 0xc2, 0x9c, 0x1c, 0xb4, 0xc8, 0xed, 0x17, 0xd6, 0xce,
+	0xc9, 0x5d, 0xe8, 0x3b, 0x49, 0x63, 0x19, 0x4f, 0xf5, 0x85, 0xaa, 0xc0, 0x7d, 0x46, 0x39, 0x28,
+	0xd3, 0x38, 0xcf, 0xa3, 0x48, 0xcd, 0xb5, 0xa3, 0x83, 0x1e, 0xae, 0xe6, 0x64, 0xe0, 0xa1, 0x6d,
+	0xbc, 0xce, 0xe2, 0x2b, 0x09, 0xb1, 0xc2, 0x3c, 0xaf, 0x45, 0x5d, 0xd1, 0x5e, 0x67, 0xac, 0x19,
+	0x5b, 0xb4, 0x8c, 0xf3, 0xa4, 0x25, 0xb6, 0x58, 0xe0, 0x8a, 0xf6, 0x85, 0x36, 0x9d, 0x40, 0xff,
+	0x0a, 0xab, 0xee, 0x87, 0x56, 0x9d, 0x68, 0x0e, 0xed, 0x5a, 0xba, 0xc0, 0xae, 0x33, 0x8e, 0xd8,
+	0xa8, 0xe0, 0x6b, 0xe7, 0xe5, 0xd3, 0x19, 0xf9, 0x11, 0xe1, 0x89, 0x35, 0xee, 0x3c, 0x71, 0xc5,
+	0x96, 0x1d, 0x90, 0x97, 0xbd, 0x0f, 0x0c, 0xd2, 0x3d, 0xb0, 0xbe, 0x16, 0x43, 0xbd, 0x2d, 0x56,
+	0x97, 0x93, 0x9b, 0x09, 0xa1, 0xd4, 0xeb, 0x03, 0xa5, 0xf6, 0x0e, 0x61, 0x3c, 0xc5, 0x85, 0x53,
+	0xc1, 0x44, 0xd4, 0x6d, 0x3c, 0x4a, 0x3c, 0xd6, 0xa2, 0x42, 0x45, 0x97, 0xb0, 0x3b, 0xe6, 0x18,
+	0xef, 0x91, 0x5c, 0x2a, 0x91, 0x27, 0x7f, 0xb8, 0x54, 0xce, 0xd5, 0x9c, 0xfe, 0x35, 0x76, 0x8d,
+	0x60, 0x7c, 0x40, 0x78, 0xaa, 0x2f, 0x96, 0x08, 0x5e, 0xc1, 0xc3, 0x3e, 0x71, 0xed, 0x4b, 0xc9,
+	0x8d, 0x18, 0xca, 0x1d, 0x9c, 0x0b, 0x60, 0xb3, 0x45, 0x6d, 0x90, 0x73, 0xa6, 0x65, 0x27, 0xac,
+	0xa5, 0x2f, 0x59, 0x9c, 0x5d, 0xe3, 0x8e, 0xf2, 0x1c, 0xff, 0xd7, 0xb3, 0x87, 0xaf, 0x9a, 0xa7,
+	0x3f, 0x27, 0x66, 0xdf, 0x3e, 0xd1, 0x16, 0x53, 0x80, 0x12, 0x95, 0x6f, 0x11, 0x2e, 0x0c, 0x5e,
+	0x2a, 0x37, 0xce, 0x2d, 0x75, 0x06, 0x43, 0x5b, 0xb9, 0x2c, 0x23, 0x99, 0x64, 0x13, 0xff, 0xdf,
+	0xf7, 0x28, 0xe6, 0x06, 0xd4, 0xea, 0x85, 0x69, 0xc5, 0x54, 0xb0, 0xa4, 0x4f, 0x78, 0xa6, 0xdd,
+	0xd7, 0x70, 0xe0, 0x99, 0x76, 0x81, 0xb4, 0xc5, 0x14, 0xa0, 0x4e, 0x07, 0x6d, 0xe4, 0x55, 0x68,
+	0x67, 0xf9, 0xc1, 0xfe, 0xa1, 0x8e, 0x0e, 0x0e, 0x75, 0xf4, 0xeb, 0x50, 0x47, 0xbb, 0x47, 0x7a,
+	0xe6, 0xe0, 0x48, 0xcf, 0x7c, 0x3b, 0xd2, 0x33, 0xcf, 0x8a, 0x8e, 0x2b, 0xb6, 0x5a, 0x75, 0xb3,
+	0xc1, 0xbc, 0xf8, 0xab, 0x6e, 0x9d, 0x7d, 0x5f, 0x45, 0xdb, 0x07, 0x5e, 0x1f, 0x8d, 0x56, 0xe1,
+	0xcd, 0xdf, 0x03, 0x00, 0xb2, 0x2c, 0xde, 0x96, 0x69, 0x08, 0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// MsgClient is the client API for Msg service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type MsgClient interface {
+	// CreateStream defines an operation for a payer to open a payment stream to
+	// a payee, escrowing its deposit.
+	CreateStream(ctx context.Context, in *MsgCreateStream, opts ...grpc.CallOption) (*MsgCreateStreamResponse, error)
+	// CreateCommunityPoolStream defines a governance operation for opening a
+	// payment stream funded by the community pool, e.g. to pay a grant. The
+	// authority is defined in the keeper.
+	CreateCommunityPoolStream(ctx context.Context, in *MsgCreateCommunityPoolStream, opts ...grpc.CallOption) (*MsgCreateCommunityPoolStreamResponse, error)
+	// WithdrawStream defines an operation for the payee of a stream to withdraw
+	// its accrued funds.
+	WithdrawStream(ctx context.Context, in *MsgWithdrawStream, opts ...grpc.CallOption) (*MsgWithdrawStreamResponse, error)
+	// CancelStream defines an operation for the payer of a cancelable stream to
+	// cancel it. The accrued funds are paid to the payee, and the unaccrued
+	// funds return to the payer.
+	CancelStream(ctx context.Context, in *MsgCancelStream, opts ...grpc.CallOption) (*MsgCancelStreamResponse, error)
+}
+
+type msgClient struct {
+	cc grpc1.ClientConn
+}
+
+func NewMsgClient(cc grpc1.ClientConn) MsgClient {
+	return &msgClient{cc}
+}
+
+func (c *msgClient) CreateStream(ctx context.Context, in *MsgCreateStream, opts ...grpc.CallOption) (*MsgCreateStreamResponse, error) {
+	out := new(MsgCreateStreamResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.payments.v1.Msg/CreateStream", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) CreateCommunityPoolStream(ctx context.Context, in *MsgCreateCommunityPoolStream, opts ...grpc.CallOption) (*MsgCreateCommunityPoolStreamResponse, error) {
+	out := new(MsgCreateCommunityPoolStreamResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.payments.v1.Msg/CreateCommunityPoolStream", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) WithdrawStream(ctx context.Context, in *MsgWithdrawStream, opts ...grpc.CallOption) (*MsgWithdrawStreamResponse, error) {
+	out := new(MsgWithdrawStreamResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.payments.v1.Msg/WithdrawStream", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) CancelStream(ctx context.Context, in *MsgCancelStream, opts ...grpc.CallOption) (*MsgCancelStreamResponse, error) {
+	out := new(MsgCancelStreamResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.payments.v1.Msg/CancelStream", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// MsgServer is the server API for Msg service.
+type MsgServer interface {
+	// CreateStream defines an operation for a payer to open a payment stream to
+	// a payee, escrowing its deposit.
+	CreateStream(context.Context, *MsgCreateStream) (*MsgCreateStreamResponse, error)
+	// CreateCommunityPoolStream defines a governance operation for opening a
+	// payment stream funded by the community pool, e.g. to pay a grant. The
+	// authority is defined in the keeper.
+	CreateCommunityPoolStream(context.Context, *MsgCreateCommunityPoolStream) (*MsgCreateCommunityPoolStreamResponse, error)
+	// WithdrawStream defines an operation for the payee of a stream to withdraw
+	// its accrued funds.
+	WithdrawStream(context.Context, *MsgWithdrawStream) (*MsgWithdrawStreamResponse, error)
+	// CancelStream defines an operation for the payer of a cancelable stream to
+	// cancel it. The accrued funds are paid to the payee, and the unaccrued
+	// funds return to the payer.
+	CancelStream(context.Context, *MsgCancelStream) (*MsgCancelStreamResponse, error)
+}
+
+// UnimplementedMsgServer can be embedded to have forward compatible implementations.
+type UnimplementedMsgServer struct {
+}
+
+func (*UnimplementedMsgServer) CreateStream(ctx context.Context, req *MsgCreateStream) (*MsgCreateStreamResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateStream not implemented")
+}
+func (*UnimplementedMsgServer) CreateCommunityPoolStream(ctx context.Context, req *MsgCreateCommunityPoolStream) (*MsgCreateCommunityPoolStreamResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateCommunityPoolStream not implemented")
+}
+func (*UnimplementedMsgServer) WithdrawStream(ctx context.Context, req *MsgWithdrawStream) (*MsgWithdrawStreamResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method WithdrawStream not implemented")
+}
+func (*UnimplementedMsgServer) CancelStream(ctx context.Context, req *MsgCancelStream) (*MsgCancelStreamResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CancelStream not implemented")
+}
+
+func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
+	s.RegisterService(&_Msg_serviceDesc, srv)
+}
+
+func _Msg_CreateStream_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgCreateStream)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).CreateStream(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.payments.v1.Msg/CreateStream",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).CreateStream(ctx, req.(*MsgCreateStream))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_CreateCommunityPoolStream_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgCreateCommunityPoolStream)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).CreateCommunityPoolStream(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.payments.v1.Msg/CreateCommunityPoolStream",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).CreateCommunityPoolStream(ctx, req.(*MsgCreateCommunityPoolStream))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_WithdrawStream_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgWithdrawStream)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).WithdrawStream(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.payments.v1.Msg/WithdrawStream",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).WithdrawStream(ctx, req.(*MsgWithdrawStream))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_CancelStream_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgCancelStream)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).CancelStream(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.payments.v1.Msg/CancelStream",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).CancelStream(ctx, req.(*MsgCancelStream))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _Msg_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "cosmos.payments.v1.Msg",
+	HandlerType: (*MsgServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "CreateStream",
+			Handler:    _Msg_CreateStream_Handler,
+		},
+		{
+			MethodName: "CreateCommunityPoolStream",
+			Handler:    _Msg_CreateCommunityPoolStream_Handler,
+		},
+		{
+			MethodName: "WithdrawStream",
+			Handler:    _Msg_WithdrawStream_Handler,
+		},
+		{
+			MethodName: "CancelStream",
+			Handler:    _Msg_CancelStream_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "cosmos/payments/v1/tx.proto",
+}
+
+func (m *MsgCreateStream) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCreateStream) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCreateStream) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Cancelable {
+		i--
+		if m.Cancelable {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x28
+	}
+	n1, err1 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.EndTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.EndTime):])
+	if err1 != nil {
+		return 0, err1
+	}
+	i -= n1
+	i = encodeVarintTx(dAtA, i, uint64(n1))
+	i--
+	dAtA[i] = 0x22
+	{
+		size, err := m.RatePerSecond.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if len(m.Payee) > 0 {
+		i -= len(m.Payee)
+		copy(dAtA[i:], m.Payee)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Payee)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Payer) > 0 {
+		i -= len(m.Payer)
+		copy(dAtA[i:], m.Payer)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Payer)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgCreateStreamResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCreateStreamResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCreateStreamResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.StreamId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.StreamId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgCreateCommunityPoolStream) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCreateCommunityPoolStream) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCreateCommunityPoolStream) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Cancelable {
+		i--
+		if m.Cancelable {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x28
+	}
+	n3, err3 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.EndTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.EndTime):])
+	if err3 != nil {
+		return 0, err3
+	}
+	i -= n3
+	i = encodeVarintTx(dAtA, i, uint64(n3))
+	i--
+	dAtA[i] = 0x22
+	{
+		size, err := m.RatePerSecond.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if len(m.Payee) > 0 {
+		i -= len(m.Payee)
+		copy(dAtA[i:], m.Payee)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Payee)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgCreateCommunityPoolStreamResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCreateCommunityPoolStreamResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCreateCommunityPoolStreamResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.StreamId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.StreamId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgWithdrawStream) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgWithdrawStream) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgWithdrawStream) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.StreamId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.StreamId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Payee) > 0 {
+		i -= len(m.Payee)
+		copy(dAtA[i:], m.Payee)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Payee)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgWithdrawStreamResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgWithdrawStreamResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgWithdrawStreamResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Amount.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgCancelStream) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCancelStream) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCancelStream) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.StreamId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.StreamId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Payer) > 0 {
+		i -= len(m.Payer)
+		copy(dAtA[i:], m.Payer)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Payer)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgCancelStreamResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCancelStreamResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCancelStreamResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Refunded.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	{
+		size, err := m.Paid.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
+	offset -= sovTx(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *MsgCreateStream) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Payer)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Payee)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.RatePerSecond.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.EndTime)
+	n += 1 + l + sovTx(uint64(l))
+	if m.Cancelable {
+		n += 2
+	}
+	return n
+}
+
+func (m *MsgCreateStreamResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.StreamId != 0 {
+		n += 1 + sovTx(uint64(m.StreamId))
+	}
+	return n
+}
+
+func (m *MsgCreateCommunityPoolStream) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Payee)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.RatePerSecond.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.EndTime)
+	n += 1 + l + sovTx(uint64(l))
+	if m.Cancelable {
+		n += 2
+	}
+	return n
+}
+
+func (m *MsgCreateCommunityPoolStreamResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.StreamId != 0 {
+		n += 1 + sovTx(uint64(m.StreamId))
+	}
+	return n
+}
+
+func (m *MsgWithdrawStream) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Payee)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.StreamId != 0 {
+		n += 1 + sovTx(uint64(m.StreamId))
+	}
+	return n
+}
+
+func (m *MsgWithdrawStreamResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Amount.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgCancelStream) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Payer)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.StreamId != 0 {
+		n += 1 + sovTx(uint64(m.StreamId))
+	}
+	return n
+}
+
+func (m *MsgCancelStreamResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Paid.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = m.Refunded.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func sovTx(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozTx(x uint64) (n int) {
+	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *MsgCreateStream) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgCreateStream: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgCreateStream: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Payer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Payer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Payee", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Payee = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RatePerSecond", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.RatePerSecond.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EndTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.EndTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Cancelable", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Cancelable = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgCreateStreamResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgCreateStreamResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgCreateStreamResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StreamId", wireType)
+			}
+			m.StreamId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.StreamId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgCreateCommunityPoolStream) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgCreateCommunityPoolStream: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgCreateCommunityPoolStream: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Authority = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Payee", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Payee = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RatePerSecond", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.RatePerSecond.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EndTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.EndTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Cancelable", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Cancelable = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgCreateCommunityPoolStreamResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgCreateCommunityPoolStreamResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgCreateCommunityPoolStreamResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StreamId", wireType)
+			}
+			m.StreamId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.StreamId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgWithdrawStream) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgWithdrawStream: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgWithdrawStream: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Payee", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Payee = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StreamId", wireType)
+			}
+			m.StreamId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.StreamId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgWithdrawStreamResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgWithdrawStreamResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgWithdrawStreamResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgCancelStream) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgCancelStream: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgCancelStream: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Payer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Payer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StreamId", wireType)
+			}
+			m.StreamId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.StreamId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgCancelStreamResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgCancelStreamResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgCancelStreamResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Paid", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Paid.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Refunded", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Refunded.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipTx(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthTx
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupTx
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthTx
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthTx        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowTx          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupTx = fmt.Errorf("proto: unexpected end of group")
+)
@@ -0,0 +1,43 @@
+package safety
+
+import (
+	"fmt"
+
+	autocliv1 "cosmossdk.io/api/cosmos/autocli/v1"
+	safetyv1 "cosmossdk.io/api/cosmos/safety/v1"
+
+	"github.com/cosmos/cosmos-sdk/version"
+	"github.com/cosmos/cosmos-sdk/x/safety/types"
+)
+
+// AutoCLIOptions implements the autocli.HasAutoCLIConfig interface.
+func (am AppModule) AutoCLIOptions() *autocliv1.ModuleOptions {
+	return &autocliv1.ModuleOptions{
+		Query: &autocliv1.ServiceCommandDescriptor{
+			Service: safetyv1.Query_ServiceDesc.ServiceName,
+			RpcCommandOptions: []*autocliv1.RpcCommandOptions{
+				{
+					RpcMethod: "Params",
+					Use:       "params",
+					Short:     "Query the parameters of the safety module.",
+					Example:   fmt.Sprintf(`%s query %s params`, version.AppName, types.ModuleName),
+				},
+				{
+					RpcMethod: "ScheduledHalt",
+					Use:       "scheduled-halt",
+					Short:     "Query the chain halt scheduled at a future height, if any.",
+					Example:   fmt.Sprintf(`%s query %s scheduled-halt`, version.AppName, types.ModuleName),
+				},
+				{
+					RpcMethod: "PastHalts",
+					Use:       "past-halts",
+					Short:     "Query the chain halts the chain resumed from.",
+					Example:   fmt.Sprintf(`%s query %s past-halts`, version.AppName, types.ModuleName),
+				},
+			},
+		},
+		Tx: &autocliv1.ServiceCommandDescriptor{
+			Service: safetyv1.Msg_ServiceDesc.ServiceName,
+		},
+	}
+}
@@ -0,0 +1,51 @@
+package keeper
+
+import (
+	"context"
+	"strconv"
+
+	"cosmossdk.io/core/event"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/safety/types"
+)
+
+// BeginBlocker halts the chain once the height of the scheduled halt is
+// reached, by panicking before any state transition of the block, so that
+// every node stops at the same height. A node started with the halt height in
+// its skip halt heights resumes block production instead: the halt is then
+// recorded among the past halts.
+func (k Keeper) BeginBlocker(ctx context.Context) error {
+	halt, found, err := k.GetScheduledHalt(ctx)
+	if err != nil || !found {
+		return err
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	if sdkCtx.BlockHeight() < halt.Height {
+		return nil
+	}
+
+	if !k.IsSkipHaltHeight(halt.Height) {
+		haltMsg := halt.HaltMsg()
+		sdkCtx.Logger().Error(haltMsg)
+		panic(haltMsg)
+	}
+
+	sdkCtx.Logger().Info("CHAIN RESUMED", "height", halt.Height, "reason", halt.Reason)
+
+	if err := k.ScheduledHalt.Remove(ctx); err != nil {
+		return err
+	}
+
+	if err := k.PastHalts.Set(ctx, halt.Height, halt); err != nil {
+		return err
+	}
+
+	return k.event.EventManager(ctx).EmitKV(
+		ctx,
+		types.EventTypeResumeHalt,
+		event.Attribute{Key: types.AttributeKeyHeight, Value: strconv.FormatInt(halt.Height, 10)},
+		event.Attribute{Key: types.AttributeKeyReason, Value: halt.Reason},
+	)
+}
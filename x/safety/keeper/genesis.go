@@ -0,0 +1,56 @@
+package keeper
+
+import (
+	"context"
+
+	"github.com/cosmos/cosmos-sdk/x/safety/types"
+)
+
+// InitGenesis initializes the safety module's state from a given genesis state.
+func (k Keeper) InitGenesis(ctx context.Context, gs *types.GenesisState) error {
+	if err := k.Params.Set(ctx, gs.Params); err != nil {
+		return err
+	}
+
+	if gs.ScheduledHalt != nil {
+		if err := k.ScheduledHalt.Set(ctx, *gs.ScheduledHalt); err != nil {
+			return err
+		}
+	}
+
+	for _, h := range gs.PastHalts {
+		if err := k.PastHalts.Set(ctx, h.Height, h); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// ExportGenesis returns the safety module's exported genesis.
+func (k Keeper) ExportGenesis(ctx context.Context) (*types.GenesisState, error) {
+	params, err := k.Params.Get(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	gs := &types.GenesisState{Params: params}
+
+	halt, found, err := k.GetScheduledHalt(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if found {
+		gs.ScheduledHalt = &halt
+	}
+
+	err = k.PastHalts.Walk(ctx, nil, func(_ int64, h types.Halt) bool {
+		gs.PastHalts = append(gs.PastHalts, h)
+		return false
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return gs, nil
+}
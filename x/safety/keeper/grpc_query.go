@@ -0,0 +1,74 @@
+package keeper
+
+import (
+	"context"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"github.com/cosmos/cosmos-sdk/x/safety/types"
+)
+
+var _ types.QueryServer = Querier{}
+
+// Querier is used as Keeper will have duplicate methods if used directly, and gRPC names take precedence over keeper.
+type Querier struct {
+	Keeper
+}
+
+// NewQuerier returns an implementation of the safety QueryServer interface
+// for the provided Keeper.
+func NewQuerier(keeper Keeper) Querier {
+	return Querier{Keeper: keeper}
+}
+
+// Params queries the parameters of the module.
+func (k Querier) Params(ctx context.Context, req *types.QueryParamsRequest) (*types.QueryParamsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	params, err := k.Keeper.Params.Get(ctx)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryParamsResponse{Params: params}, nil
+}
+
+// ScheduledHalt queries the scheduled halt, if any.
+func (k Querier) ScheduledHalt(ctx context.Context, req *types.QueryScheduledHaltRequest) (*types.QueryScheduledHaltResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	halt, found, err := k.GetScheduledHalt(ctx)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	if !found {
+		return &types.QueryScheduledHaltResponse{}, nil
+	}
+
+	return &types.QueryScheduledHaltResponse{Halt: &halt}, nil
+}
+
+// PastHalts queries the halts the chain resumed from.
+func (k Querier) PastHalts(ctx context.Context, req *types.QueryPastHaltsRequest) (*types.QueryPastHaltsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	results, pageRes, err := query.CollectionPaginate[int64, types.Halt](ctx, k.Keeper.PastHalts, req.Pagination)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	halts := make([]types.Halt, 0, len(results))
+	for _, r := range results {
+		halts = append(halts, r.Value)
+	}
+
+	return &types.QueryPastHaltsResponse{Halts: halts, Pagination: pageRes}, nil
+}
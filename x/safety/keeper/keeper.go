@@ -0,0 +1,80 @@
+package keeper
+
+import (
+	"context"
+	"errors"
+
+	"cosmossdk.io/collections"
+	"cosmossdk.io/core/event"
+	storetypes "cosmossdk.io/core/store"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/x/safety/types"
+)
+
+// Keeper of the safety store
+type Keeper struct {
+	storeService storetypes.KVStoreService
+	event        event.Service
+
+	// the address capable of executing a MsgUpdateParams message and of
+	// scheduling or cancelling any halt. Typically, this should be the x/gov
+	// module account.
+	authority string
+
+	// the halt heights past which this node resumes block production
+	skipHaltHeights map[int64]bool
+
+	Schema        collections.Schema
+	Params        collections.Item[types.Params]
+	ScheduledHalt collections.Item[types.Halt]
+	PastHalts     collections.Map[int64, types.Halt]
+}
+
+// NewKeeper returns a new safety Keeper. skipHaltHeights is the set of halt
+// heights, typically provided with the --unsafe-skip-halt-heights flag, at
+// which the node resumes block production instead of halting.
+func NewKeeper(cdc codec.BinaryCodec, storeService storetypes.KVStoreService, authority string, skipHaltHeights map[int64]bool, em event.Service) Keeper {
+	sb := collections.NewSchemaBuilder(storeService)
+	k := Keeper{
+		storeService:    storeService,
+		event:           em,
+		authority:       authority,
+		skipHaltHeights: skipHaltHeights,
+		Params:          collections.NewItem(sb, types.ParamsKey, "params", codec.CollValue[types.Params](cdc)),
+		ScheduledHalt:   collections.NewItem(sb, types.ScheduledHaltKey, "scheduled_halt", codec.CollValue[types.Halt](cdc)),
+		PastHalts:       collections.NewMap(sb, types.PastHaltsPrefix, "past_halts", collections.Int64Key, codec.CollValue[types.Halt](cdc)),
+	}
+
+	schema, err := sb.Build()
+	if err != nil {
+		panic(err)
+	}
+	k.Schema = schema
+
+	return k
+}
+
+// GetAuthority returns the x/safety module's authority.
+func (k Keeper) GetAuthority() string {
+	return k.authority
+}
+
+// IsSkipHaltHeight returns true if the node resumes block production at the
+// given halt height.
+func (k Keeper) IsSkipHaltHeight(height int64) bool {
+	return k.skipHaltHeights[height]
+}
+
+// GetScheduledHalt returns the scheduled halt, if any.
+func (k Keeper) GetScheduledHalt(ctx context.Context) (types.Halt, bool, error) {
+	halt, err := k.ScheduledHalt.Get(ctx)
+	if err != nil {
+		if errors.Is(err, collections.ErrNotFound) {
+			return types.Halt{}, false, nil
+		}
+		return types.Halt{}, false, err
+	}
+
+	return halt, true, nil
+}
@@ -0,0 +1,219 @@
+package keeper_test
+
+import (
+	"testing"
+
+	cmtproto "github.com/cometbft/cometbft/proto/tendermint/types"
+	"github.com/stretchr/testify/suite"
+
+	storetypes "cosmossdk.io/store/types"
+
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	"github.com/cosmos/cosmos-sdk/runtime"
+	"github.com/cosmos/cosmos-sdk/testutil"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	moduletestutil "github.com/cosmos/cosmos-sdk/types/module/testutil"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+	"github.com/cosmos/cosmos-sdk/x/safety/keeper"
+	"github.com/cosmos/cosmos-sdk/x/safety/types"
+)
+
+type KeeperTestSuite struct {
+	suite.Suite
+
+	ctx         sdk.Context
+	keeper      keeper.Keeper
+	msgServer   types.MsgServer
+	queryClient types.QueryClient
+	authority   string
+	council     string
+}
+
+func (s *KeeperTestSuite) SetupTest() {
+	key := storetypes.NewKVStoreKey(types.StoreKey)
+	testCtx := testutil.DefaultContextWithDB(s.T(), key, storetypes.NewTransientStoreKey("transient_test"))
+	encCfg := moduletestutil.MakeTestEncodingConfig()
+
+	s.authority = authtypes.NewModuleAddress(govtypes.ModuleName).String()
+	s.council = sdk.AccAddress("council").String()
+	s.ctx = testCtx.Ctx.WithBlockHeader(cmtproto.Header{Height: 10})
+	s.keeper = keeper.NewKeeper(encCfg.Codec, runtime.NewKVStoreService(key), s.authority, map[int64]bool{30: true}, runtime.EventService{})
+	s.msgServer = keeper.NewMsgServerImpl(s.keeper)
+	s.Require().NoError(s.keeper.Params.Set(s.ctx, types.Params{EmergencyCouncil: s.council}))
+
+	types.RegisterInterfaces(encCfg.InterfaceRegistry)
+	queryHelper := baseapp.NewQueryServerTestHelper(s.ctx, encCfg.InterfaceRegistry)
+	types.RegisterQueryServer(queryHelper, keeper.NewQuerier(s.keeper))
+	s.queryClient = types.NewQueryClient(queryHelper)
+}
+
+func TestKeeperTestSuite(t *testing.T) {
+	suite.Run(t, new(KeeperTestSuite))
+}
+
+func (s *KeeperTestSuite) TestScheduleHalt() {
+	testCases := []struct {
+		name   string
+		msg    *types.MsgScheduleHalt
+		expErr error
+	}{
+		{
+			name:   "invalid authority",
+			msg:    &types.MsgScheduleHalt{Authority: "invalid", Height: 20, Reason: "security fix"},
+			expErr: types.ErrUnauthorized,
+		},
+		{
+			name:   "empty reason",
+			msg:    &types.MsgScheduleHalt{Authority: s.authority, Height: 20, Reason: ""},
+			expErr: types.ErrInvalidHaltReason,
+		},
+		{
+			name:   "halt height in the past",
+			msg:    &types.MsgScheduleHalt{Authority: s.authority, Height: 10, Reason: "security fix"},
+			expErr: types.ErrInvalidHaltHeight,
+		},
+		{
+			name: "success by the authority",
+			msg:  &types.MsgScheduleHalt{Authority: s.authority, Height: 20, Reason: "security fix"},
+		},
+		{
+			name: "success by the council",
+			msg:  &types.MsgScheduleHalt{Authority: s.council, Height: 20, Reason: "security fix"},
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			s.SetupTest()
+
+			_, err := s.msgServer.ScheduleHalt(s.ctx, tc.msg)
+			if tc.expErr != nil {
+				s.Require().ErrorIs(err, tc.expErr)
+				return
+			}
+
+			s.Require().NoError(err)
+			halt, found, err := s.keeper.GetScheduledHalt(s.ctx)
+			s.Require().NoError(err)
+			s.Require().True(found)
+			s.Require().Equal(types.Halt{Height: tc.msg.Height, Reason: tc.msg.Reason, Authority: tc.msg.Authority}, halt)
+		})
+	}
+}
+
+func (s *KeeperTestSuite) TestCouncilCannotOverrideAuthority() {
+	_, err := s.msgServer.ScheduleHalt(s.ctx, &types.MsgScheduleHalt{Authority: s.authority, Height: 20, Reason: "security fix"})
+	s.Require().NoError(err)
+
+	_, err = s.msgServer.ScheduleHalt(s.ctx, &types.MsgScheduleHalt{Authority: s.council, Height: 15, Reason: "other"})
+	s.Require().ErrorIs(err, types.ErrUnauthorized)
+	_, err = s.msgServer.CancelHalt(s.ctx, &types.MsgCancelHalt{Authority: s.council})
+	s.Require().ErrorIs(err, types.ErrUnauthorized)
+
+	// the authority can override a halt scheduled by the council
+	_, err = s.msgServer.CancelHalt(s.ctx, &types.MsgCancelHalt{Authority: s.authority})
+	s.Require().NoError(err)
+	_, err = s.msgServer.ScheduleHalt(s.ctx, &types.MsgScheduleHalt{Authority: s.council, Height: 15, Reason: "other"})
+	s.Require().NoError(err)
+	_, err = s.msgServer.ScheduleHalt(s.ctx, &types.MsgScheduleHalt{Authority: s.authority, Height: 20, Reason: "security fix"})
+	s.Require().NoError(err)
+}
+
+func (s *KeeperTestSuite) TestCancelHalt() {
+	_, err := s.msgServer.CancelHalt(s.ctx, &types.MsgCancelHalt{Authority: s.authority})
+	s.Require().ErrorIs(err, types.ErrNoScheduledHalt)
+
+	_, err = s.msgServer.ScheduleHalt(s.ctx, &types.MsgScheduleHalt{Authority: s.council, Height: 20, Reason: "security fix"})
+	s.Require().NoError(err)
+
+	_, err = s.msgServer.CancelHalt(s.ctx, &types.MsgCancelHalt{Authority: "invalid"})
+	s.Require().ErrorIs(err, types.ErrUnauthorized)
+
+	_, err = s.msgServer.CancelHalt(s.ctx, &types.MsgCancelHalt{Authority: s.council})
+	s.Require().NoError(err)
+	_, found, err := s.keeper.GetScheduledHalt(s.ctx)
+	s.Require().NoError(err)
+	s.Require().False(found)
+}
+
+func (s *KeeperTestSuite) TestUpdateParams() {
+	_, err := s.msgServer.UpdateParams(s.ctx, &types.MsgUpdateParams{Authority: s.council, Params: types.DefaultParams()})
+	s.Require().ErrorIs(err, govtypes.ErrInvalidSigner)
+
+	_, err = s.msgServer.UpdateParams(s.ctx, &types.MsgUpdateParams{Authority: s.authority, Params: types.DefaultParams()})
+	s.Require().NoError(err)
+
+	// the council is removed
+	_, err = s.msgServer.ScheduleHalt(s.ctx, &types.MsgScheduleHalt{Authority: s.council, Height: 20, Reason: "security fix"})
+	s.Require().ErrorIs(err, types.ErrUnauthorized)
+}
+
+func (s *KeeperTestSuite) TestBeginBlocker() {
+	_, err := s.msgServer.ScheduleHalt(s.ctx, &types.MsgScheduleHalt{Authority: s.authority, Height: 20, Reason: "security fix"})
+	s.Require().NoError(err)
+
+	s.Require().NoError(s.keeper.BeginBlocker(s.ctx.WithBlockHeight(19)))
+	s.Require().PanicsWithValue("CHAIN HALTED at height 20: security fix", func() {
+		_ = s.keeper.BeginBlocker(s.ctx.WithBlockHeight(20))
+	})
+
+	// the node resumes from a halt height it skips
+	_, err = s.msgServer.ScheduleHalt(s.ctx, &types.MsgScheduleHalt{Authority: s.authority, Height: 30, Reason: "security fix"})
+	s.Require().NoError(err)
+	s.Require().NoError(s.keeper.BeginBlocker(s.ctx.WithBlockHeight(30)))
+
+	_, found, err := s.keeper.GetScheduledHalt(s.ctx)
+	s.Require().NoError(err)
+	s.Require().False(found)
+	halt, err := s.keeper.PastHalts.Get(s.ctx, 30)
+	s.Require().NoError(err)
+	s.Require().Equal(types.Halt{Height: 30, Reason: "security fix", Authority: s.authority}, halt)
+
+	s.Require().NoError(s.keeper.BeginBlocker(s.ctx.WithBlockHeight(31)))
+}
+
+func (s *KeeperTestSuite) TestGRPCQueries() {
+	res, err := s.queryClient.ScheduledHalt(s.ctx, &types.QueryScheduledHaltRequest{})
+	s.Require().NoError(err)
+	s.Require().Nil(res.Halt)
+
+	_, err = s.msgServer.ScheduleHalt(s.ctx, &types.MsgScheduleHalt{Authority: s.authority, Height: 20, Reason: "security fix"})
+	s.Require().NoError(err)
+	s.Require().NoError(s.keeper.PastHalts.Set(s.ctx, 5, types.Halt{Height: 5, Reason: "past", Authority: s.authority}))
+
+	res, err = s.queryClient.ScheduledHalt(s.ctx, &types.QueryScheduledHaltRequest{})
+	s.Require().NoError(err)
+	s.Require().Equal(&types.Halt{Height: 20, Reason: "security fix", Authority: s.authority}, res.Halt)
+
+	past, err := s.queryClient.PastHalts(s.ctx, &types.QueryPastHaltsRequest{})
+	s.Require().NoError(err)
+	s.Require().Len(past.Halts, 1)
+
+	params, err := s.queryClient.Params(s.ctx, &types.QueryParamsRequest{})
+	s.Require().NoError(err)
+	s.Require().Equal(s.council, params.Params.EmergencyCouncil)
+}
+
+func (s *KeeperTestSuite) TestGenesis() {
+	gs := &types.GenesisState{
+		Params:        types.Params{EmergencyCouncil: s.council},
+		ScheduledHalt: &types.Halt{Height: 20, Reason: "security fix", Authority: s.authority},
+		PastHalts: []types.Halt{
+			{Height: 5, Reason: "past", Authority: s.council},
+		},
+	}
+	s.Require().NoError(gs.Validate())
+	s.Require().NoError(s.keeper.InitGenesis(s.ctx, gs))
+
+	exported, err := s.keeper.ExportGenesis(s.ctx)
+	s.Require().NoError(err)
+	s.Require().Equal(gs, exported)
+
+	gs.ScheduledHalt.Height = 5
+	s.Require().ErrorIs(gs.Validate(), types.ErrInvalidHaltHeight)
+
+	gs.ScheduledHalt = nil
+	gs.PastHalts = append(gs.PastHalts, types.Halt{Height: 5, Reason: "past", Authority: s.council})
+	s.Require().ErrorIs(gs.Validate(), types.ErrDuplicateGenesisHalt)
+}
@@ -0,0 +1,140 @@
+package keeper
+
+import (
+	"context"
+	"strconv"
+
+	"cosmossdk.io/core/event"
+	errorsmod "cosmossdk.io/errors"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+	"github.com/cosmos/cosmos-sdk/x/safety/types"
+)
+
+type msgServer struct {
+	Keeper
+}
+
+var _ types.MsgServer = msgServer{}
+
+// NewMsgServerImpl returns an implementation of the safety MsgServer interface
+// for the provided Keeper.
+func NewMsgServerImpl(keeper Keeper) types.MsgServer {
+	return &msgServer{Keeper: keeper}
+}
+
+// checkHaltSigner checks that the signer of a halt operation is the module
+// authority or the emergency council, and returns true for the former.
+func (k msgServer) checkHaltSigner(ctx context.Context, signer string) (bool, error) {
+	if signer == k.authority {
+		return true, nil
+	}
+
+	params, err := k.Params.Get(ctx)
+	if err != nil {
+		return false, err
+	}
+
+	if params.EmergencyCouncil == "" || signer != params.EmergencyCouncil {
+		return false, errorsmod.Wrapf(types.ErrUnauthorized, "expected %s or the emergency council, got %s", k.authority, signer)
+	}
+
+	return false, nil
+}
+
+// ScheduleHalt implements the Msg/ScheduleHalt method.
+func (k msgServer) ScheduleHalt(ctx context.Context, msg *types.MsgScheduleHalt) (*types.MsgScheduleHaltResponse, error) {
+	isAuthority, err := k.checkHaltSigner(ctx, msg.Authority)
+	if err != nil {
+		return nil, err
+	}
+
+	halt := types.Halt{Height: msg.Height, Reason: msg.Reason, Authority: msg.Authority}
+	if err := halt.Validate(); err != nil {
+		return nil, err
+	}
+
+	height := sdk.UnwrapSDKContext(ctx).BlockHeight()
+	if msg.Height <= height {
+		return nil, errorsmod.Wrapf(types.ErrInvalidHaltHeight, "halt height must be greater than current height %d", height)
+	}
+
+	// the emergency council cannot override a halt scheduled by the module
+	// authority
+	existing, found, err := k.GetScheduledHalt(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if found && !isAuthority && existing.Authority == k.authority {
+		return nil, errorsmod.Wrap(types.ErrUnauthorized, "cannot replace a halt scheduled by the module authority")
+	}
+
+	if err := k.ScheduledHalt.Set(ctx, halt); err != nil {
+		return nil, err
+	}
+
+	if err := k.event.EventManager(ctx).EmitKV(
+		ctx,
+		types.EventTypeScheduleHalt,
+		event.Attribute{Key: types.AttributeKeyHeight, Value: strconv.FormatInt(msg.Height, 10)},
+		event.Attribute{Key: types.AttributeKeyReason, Value: msg.Reason},
+		event.Attribute{Key: types.AttributeKeyAuthority, Value: msg.Authority},
+	); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgScheduleHaltResponse{}, nil
+}
+
+// CancelHalt implements the Msg/CancelHalt method.
+func (k msgServer) CancelHalt(ctx context.Context, msg *types.MsgCancelHalt) (*types.MsgCancelHaltResponse, error) {
+	isAuthority, err := k.checkHaltSigner(ctx, msg.Authority)
+	if err != nil {
+		return nil, err
+	}
+
+	existing, found, err := k.GetScheduledHalt(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if !found {
+		return nil, types.ErrNoScheduledHalt
+	}
+
+	if !isAuthority && existing.Authority == k.authority {
+		return nil, errorsmod.Wrap(types.ErrUnauthorized, "cannot cancel a halt scheduled by the module authority")
+	}
+
+	if err := k.ScheduledHalt.Remove(ctx); err != nil {
+		return nil, err
+	}
+
+	if err := k.event.EventManager(ctx).EmitKV(
+		ctx,
+		types.EventTypeCancelHalt,
+		event.Attribute{Key: types.AttributeKeyHeight, Value: strconv.FormatInt(existing.Height, 10)},
+		event.Attribute{Key: types.AttributeKeyAuthority, Value: msg.Authority},
+	); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgCancelHaltResponse{}, nil
+}
+
+// UpdateParams implements the Msg/UpdateParams method.
+func (k msgServer) UpdateParams(ctx context.Context, msg *types.MsgUpdateParams) (*types.MsgUpdateParamsResponse, error) {
+	if k.authority != msg.Authority {
+		return nil, errorsmod.Wrapf(govtypes.ErrInvalidSigner, "invalid authority; expected %s, got %s", k.authority, msg.Authority)
+	}
+
+	if err := msg.Params.Validate(); err != nil {
+		return nil, err
+	}
+
+	if err := k.Params.Set(ctx, msg.Params); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgUpdateParamsResponse{}, nil
+}
@@ -0,0 +1,12 @@
+package types
+
+import "cosmossdk.io/errors"
+
+// x/safety module sentinel errors
+var (
+	ErrInvalidHaltHeight    = errors.Register(ModuleName, 2, "invalid halt height")
+	ErrInvalidHaltReason    = errors.Register(ModuleName, 3, "invalid halt reason")
+	ErrNoScheduledHalt      = errors.Register(ModuleName, 4, "no scheduled halt")
+	ErrUnauthorized         = errors.Register(ModuleName, 5, "unauthorized halt operation")
+	ErrDuplicateGenesisHalt = errors.Register(ModuleName, 6, "duplicate halt in genesis")
+)
@@ -0,0 +1,12 @@
+package types
+
+// safety module event types
+const (
+	EventTypeScheduleHalt = "schedule_halt"
+	EventTypeCancelHalt   = "cancel_halt"
+	EventTypeResumeHalt   = "resume_halt"
+
+	AttributeKeyHeight    = "height"
+	AttributeKeyReason    = "reason"
+	AttributeKeyAuthority = "authority"
+)
@@ -0,0 +1,48 @@
+package types
+
+import (
+	errorsmod "cosmossdk.io/errors"
+)
+
+// DefaultGenesisState returns the default genesis state, which has no halts.
+func DefaultGenesisState() *GenesisState {
+	return &GenesisState{
+		Params: DefaultParams(),
+	}
+}
+
+// Validate performs basic genesis state validation.
+func (gs GenesisState) Validate() error {
+	if err := gs.Params.Validate(); err != nil {
+		return err
+	}
+
+	var lastHeight int64
+	seen := make(map[int64]struct{}, len(gs.PastHalts))
+	for _, h := range gs.PastHalts {
+		if err := h.Validate(); err != nil {
+			return err
+		}
+
+		if _, ok := seen[h.Height]; ok {
+			return errorsmod.Wrapf(ErrDuplicateGenesisHalt, "height %d", h.Height)
+		}
+		seen[h.Height] = struct{}{}
+
+		if h.Height > lastHeight {
+			lastHeight = h.Height
+		}
+	}
+
+	if gs.ScheduledHalt != nil {
+		if err := gs.ScheduledHalt.Validate(); err != nil {
+			return err
+		}
+
+		if gs.ScheduledHalt.Height <= lastHeight {
+			return errorsmod.Wrapf(ErrInvalidHaltHeight, "scheduled halt height %d must be greater than the past halt height %d", gs.ScheduledHalt.Height, lastHeight)
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,48 @@
+package types
+
+import (
+	"fmt"
+
+	errorsmod "cosmossdk.io/errors"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// MaxHaltReasonLength is the maximum length of the reason of a halt.
+const MaxHaltReasonLength = 512
+
+// ValidateHaltReason checks that the reason of a halt is non-empty and not too
+// long.
+func ValidateHaltReason(reason string) error {
+	if len(reason) == 0 {
+		return errorsmod.Wrap(ErrInvalidHaltReason, "reason cannot be empty")
+	}
+
+	if len(reason) > MaxHaltReasonLength {
+		return errorsmod.Wrapf(ErrInvalidHaltReason, "reason is longer than %d characters", MaxHaltReasonLength)
+	}
+
+	return nil
+}
+
+// Validate performs a stateless validation of the halt.
+func (h Halt) Validate() error {
+	if h.Height <= 0 {
+		return errorsmod.Wrapf(ErrInvalidHaltHeight, "height must be positive, got %d", h.Height)
+	}
+
+	if err := ValidateHaltReason(h.Reason); err != nil {
+		return err
+	}
+
+	if _, err := sdk.AccAddressFromBech32(h.Authority); err != nil {
+		return errorsmod.Wrapf(err, "invalid halt authority %s", h.Authority)
+	}
+
+	return nil
+}
+
+// HaltMsg returns the message logged when the chain halts.
+func (h Halt) HaltMsg() string {
+	return fmt.Sprintf("CHAIN HALTED at height %d: %s", h.Height, h.Reason)
+}
@@ -0,0 +1,23 @@
+package types
+
+import "cosmossdk.io/collections"
+
+const (
+	// ModuleName defines the name of the x/safety module.
+	ModuleName = "safety"
+
+	// StoreKey defines the module's store key.
+	StoreKey = ModuleName
+)
+
+var (
+	// ParamsKey is the key under which the module parameters are stored.
+	ParamsKey = collections.NewPrefix(0)
+
+	// ScheduledHaltKey is the key under which the scheduled halt is stored.
+	ScheduledHaltKey = collections.NewPrefix(1)
+
+	// PastHaltsPrefix is the prefix under which the halts the chain resumed
+	// from are stored, indexed by height.
+	PastHaltsPrefix = collections.NewPrefix(2)
+)
@@ -0,0 +1,26 @@
+package types
+
+import (
+	errorsmod "cosmossdk.io/errors"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// DefaultParams returns the default parameters of the module, which have no
+// emergency council.
+func DefaultParams() Params {
+	return Params{}
+}
+
+// Validate performs basic validation of the parameters.
+func (p Params) Validate() error {
+	if p.EmergencyCouncil == "" {
+		return nil
+	}
+
+	if _, err := sdk.AccAddressFromBech32(p.EmergencyCouncil); err != nil {
+		return errorsmod.Wrapf(err, "invalid emergency council address %s", p.EmergencyCouncil)
+	}
+
+	return nil
+}
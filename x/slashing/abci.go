@@ -20,4 +20,9 @@ func BeginBlocker(ctx sdk.Context, k keeper.Keeper) {
 	for _, voteInfo := range ctx.VoteInfos() {
 		k.HandleValidatorSignature(ctx, voteInfo.Validator.Address, voteInfo.Validator.Power, voteInfo.SignedLastBlock)
 	}
+
+	// count the block towards the performance of its proposer
+	if proposer := ctx.BlockHeader().ProposerAddress; len(proposer) > 0 {
+		k.AddValidatorPerformance(ctx, sdk.ConsAddress(proposer), types.ValidatorPerformance{ProposedBlocks: 1})
+	}
 }
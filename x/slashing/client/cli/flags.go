@@ -3,3 +3,8 @@ package cli
 const (
 	FlagAddressValidator = "validator"
 )
+
+const (
+	FlagStartHeight = "start-height"
+	FlagEndHeight   = "end-height"
+)
@@ -1,6 +1,7 @@
 package cli
 
 import (
+	"fmt"
 	"strings"
 
 	"github.com/spf13/cobra"
@@ -27,6 +28,8 @@ func GetQueryCmd() *cobra.Command {
 		GetCmdQuerySigningInfo(),
 		GetCmdQueryParams(),
 		GetCmdQuerySigningInfos(),
+		GetCmdQueryValidatorPerformance(),
+		GetCmdQueryValidatorsPerformance(),
 	)
 
 	return slashingQueryCmd
@@ -108,6 +111,118 @@ $ <appd> query slashing signing-infos
 	return cmd
 }
 
+// GetCmdQueryValidatorPerformance implements the command to query the
+// performance of a validator over a range of heights.
+func GetCmdQueryValidatorPerformance() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "validator-performance [cons-address]",
+		Short: "Query the performance of a validator over a range of heights",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query the blocks signed, missed and proposed, the governance votes and the slash events of a validator over a range of heights:
+
+$ <appd> query slashing validator-performance cosmosvalcons1nrqsld3aw6lh6t082frdqc84uwxn0t958c5l8f --%s=1000 --%s=5000
+`, FlagStartHeight, FlagEndHeight),
+		),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			startHeight, endHeight, err := readHeightRange(cmd)
+			if err != nil {
+				return err
+			}
+
+			params := &types.QueryValidatorPerformanceRequest{
+				ConsAddress: args[0],
+				StartHeight: startHeight,
+				EndHeight:   endHeight,
+			}
+			res, err := queryClient.ValidatorPerformance(cmd.Context(), params)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	cmd.Flags().Int64(FlagStartHeight, 0, "The first height of the range")
+	cmd.Flags().Int64(FlagEndHeight, 0, "The last height of the range, defaults to the latest height")
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// GetCmdQueryValidatorsPerformance implements the command to query the
+// performance of all validators over a range of heights.
+func GetCmdQueryValidatorsPerformance() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "validators-performance",
+		Short: "Query the performance of all validators over a range of heights",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query the blocks signed, missed and proposed, the governance votes and the slash events of all validators over a range of heights:
+
+$ <appd> query slashing validators-performance --%s=1000 --%s=5000
+`, FlagStartHeight, FlagEndHeight),
+		),
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			startHeight, endHeight, err := readHeightRange(cmd)
+			if err != nil {
+				return err
+			}
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			params := &types.QueryValidatorsPerformanceRequest{
+				StartHeight: startHeight,
+				EndHeight:   endHeight,
+				Pagination:  pageReq,
+			}
+			res, err := queryClient.ValidatorsPerformance(cmd.Context(), params)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	cmd.Flags().Int64(FlagStartHeight, 0, "The first height of the range")
+	cmd.Flags().Int64(FlagEndHeight, 0, "The last height of the range, defaults to the latest height")
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "validators performance")
+
+	return cmd
+}
+
+func readHeightRange(cmd *cobra.Command) (int64, int64, error) {
+	startHeight, err := cmd.Flags().GetInt64(FlagStartHeight)
+	if err != nil {
+		return 0, 0, err
+	}
+
+	endHeight, err := cmd.Flags().GetInt64(FlagEndHeight)
+	if err != nil {
+		return 0, 0, err
+	}
+
+	return startHeight, endHeight, nil
+}
+
 // GetCmdQueryParams implements a command to fetch slashing parameters.
 func GetCmdQueryParams() *cobra.Command {
 	cmd := &cobra.Command{
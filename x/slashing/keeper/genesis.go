@@ -42,6 +42,14 @@ func (keeper Keeper) InitGenesis(ctx sdk.Context, stakingKeeper types.StakingKee
 		}
 	}
 
+	for _, performance := range data.ValidatorPerformances {
+		address, err := sdk.ConsAddressFromBech32(performance.Address)
+		if err != nil {
+			panic(err)
+		}
+		keeper.SetValidatorEpochPerformance(ctx, address, performance.EpochStartHeight, performance.Performance)
+	}
+
 	if err := keeper.SetParams(ctx, data.Params); err != nil {
 		panic(err)
 	}
@@ -71,5 +79,13 @@ func (keeper Keeper) ExportGenesis(ctx sdk.Context) (data *types.GenesisState) {
 		return false
 	})
 
-	return types.NewGenesisState(params, signingInfos, missedBlocks)
+	performances := make([]types.ValidatorEpochPerformance, 0)
+	keeper.IterateValidatorPerformances(ctx, func(address sdk.ConsAddress, epochStartHeight int64, performance types.ValidatorPerformance) (stop bool) {
+		performances = append(performances, types.NewValidatorEpochPerformance(address, epochStartHeight, performance))
+		return false
+	})
+
+	data = types.NewGenesisState(params, signingInfos, missedBlocks)
+	data.ValidatorPerformances = performances
+	return data
 }
@@ -0,0 +1,47 @@
+package keeper
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+	"github.com/cosmos/cosmos-sdk/x/slashing/types"
+)
+
+var _ govtypes.GovHooks = GovHooks{}
+
+// GovHooks wrapper struct for slashing keeper, counting the governance
+// participation of validators
+type GovHooks struct {
+	k Keeper
+}
+
+// Return the slashing governance hooks
+func (k Keeper) GovHooks() GovHooks {
+	return GovHooks{k}
+}
+
+// AfterProposalVote counts the vote towards the performance of the validator
+// operated by the voter, if any
+func (h GovHooks) AfterProposalVote(c context.Context, _ uint64, voterAddr sdk.AccAddress) {
+	ctx := sdk.UnwrapSDKContext(c)
+	validator := h.k.sk.Validator(ctx, sdk.ValAddress(voterAddr))
+	if validator == nil {
+		return
+	}
+
+	consAddr, err := validator.GetConsAddr()
+	if err != nil {
+		return
+	}
+
+	h.k.AddValidatorPerformance(ctx, consAddr, types.ValidatorPerformance{GovVotes: 1})
+}
+
+func (h GovHooks) AfterProposalSubmission(_ context.Context, _ uint64) {}
+
+func (h GovHooks) AfterProposalDeposit(_ context.Context, _ uint64, _ sdk.AccAddress) {}
+
+func (h GovHooks) AfterProposalFailedMinDeposit(_ context.Context, _ uint64) {}
+
+func (h GovHooks) AfterProposalVotingPeriodEnded(_ context.Context, _ uint64) {}
@@ -84,3 +84,90 @@ func (k Keeper) SigningInfos(c context.Context, req *types.QuerySigningInfosRequ
 	}
 	return &types.QuerySigningInfosResponse{Info: signInfos, Pagination: pageRes}, nil
 }
+
+// ValidatorPerformance returns the performance counters of a validator over a
+// range of heights.
+func (k Keeper) ValidatorPerformance(c context.Context, req *types.QueryValidatorPerformanceRequest) (*types.QueryValidatorPerformanceResponse, error) {
+	if req == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "empty request")
+	}
+
+	if req.ConsAddress == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid request")
+	}
+
+	consAddr, err := sdk.ConsAddressFromBech32(req.ConsAddress)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	startHeight, endHeight, err := k.performanceQueryRange(ctx, req.StartHeight, req.EndHeight)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryValidatorPerformanceResponse{
+		Performance: k.GetValidatorPerformance(ctx, consAddr, startHeight, endHeight),
+		StartHeight: startHeight,
+		EndHeight:   endHeight,
+	}, nil
+}
+
+// ValidatorsPerformance returns the performance counters of all validators
+// over a range of heights.
+func (k Keeper) ValidatorsPerformance(c context.Context, req *types.QueryValidatorsPerformanceRequest) (*types.QueryValidatorsPerformanceResponse, error) {
+	if req == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	startHeight, endHeight, err := k.performanceQueryRange(ctx, req.StartHeight, req.EndHeight)
+	if err != nil {
+		return nil, err
+	}
+
+	store := ctx.KVStore(k.storeKey)
+	var performances []types.ValidatorRangePerformance
+
+	sigInfoStore := prefix.NewStore(store, types.ValidatorSigningInfoKeyPrefix)
+	pageRes, err := query.Paginate(sigInfoStore, req.Pagination, func(key, _ []byte) error {
+		// the key is the length-prefixed consensus address of the validator
+		consAddr := sdk.ConsAddress(key[1:])
+		performances = append(performances, types.ValidatorRangePerformance{
+			Address:     consAddr.String(),
+			Performance: k.GetValidatorPerformance(ctx, consAddr, startHeight, endHeight),
+		})
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryValidatorsPerformanceResponse{
+		Performances: performances,
+		StartHeight:  startHeight,
+		EndHeight:    endHeight,
+		Pagination:   pageRes,
+	}, nil
+}
+
+// performanceQueryRange validates the range of heights of a performance query
+// and returns the range covered by the performance counters. A zero end height
+// defaults to the current height.
+func (k Keeper) performanceQueryRange(ctx sdk.Context, startHeight, endHeight int64) (int64, int64, error) {
+	if k.PerformanceEpochLength(ctx) == 0 {
+		return 0, 0, status.Errorf(codes.FailedPrecondition, "validator performance tracking is disabled")
+	}
+
+	if endHeight == 0 {
+		endHeight = ctx.BlockHeight()
+	}
+
+	if startHeight < 0 || endHeight < startHeight {
+		return 0, 0, status.Errorf(codes.InvalidArgument, "invalid height range [%d, %d]", startHeight, endHeight)
+	}
+
+	startHeight, endHeight = k.PerformanceRange(ctx, startHeight, endHeight)
+	return startHeight, endHeight, nil
+}
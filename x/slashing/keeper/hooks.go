@@ -8,6 +8,7 @@ import (
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/x/slashing/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
 )
 
 var _ types.StakingHooks = Hooks{}
@@ -27,6 +28,7 @@ func (h Hooks) AfterValidatorBonded(ctx sdk.Context, consAddr sdk.ConsAddress, v
 	signingInfo, found := h.k.GetValidatorSigningInfo(ctx, consAddr)
 	if found {
 		signingInfo.StartHeight = ctx.BlockHeight()
+		signingInfo.ConsecutiveMissedBlocks = 0
 	} else {
 		signingInfo = types.NewValidatorSigningInfo(
 			consAddr,
@@ -88,6 +90,26 @@ func (h Hooks) BeforeValidatorSlashed(_ sdk.Context, _ sdk.ValAddress, _ sdkmath
 	return nil
 }
 
+// AfterValidatorSlashed counts the slash towards the performance of the validator
+func (h Hooks) AfterValidatorSlashed(ctx sdk.Context, valAddr sdk.ValAddress, _ sdkmath.LegacyDec, _ []stakingtypes.SlashedDelegation) error {
+	validator := h.k.sk.Validator(ctx, valAddr)
+	if validator == nil {
+		return nil
+	}
+
+	consAddr, err := validator.GetConsAddr()
+	if err != nil {
+		return err
+	}
+
+	h.k.AddValidatorPerformance(ctx, consAddr, types.ValidatorPerformance{SlashEvents: 1})
+	return nil
+}
+
 func (h Hooks) AfterUnbondingInitiated(_ sdk.Context, _ uint64) error {
 	return nil
 }
+
+func (h Hooks) AfterUnbondingCanceled(_ sdk.Context, _ uint64) error {
+	return nil
+}
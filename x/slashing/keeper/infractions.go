@@ -19,11 +19,23 @@ func (k Keeper) HandleValidatorSignature(ctx sdk.Context, addr cryptotypes.Addre
 	// fetch the validator public key
 	consAddr := sdk.ConsAddress(addr)
 
+	// the votes of a consensus key replaced by a standby consensus key are
+	// ignored until it leaves the validator set
+	if k.IsReplacedConsAddr(ctx, consAddr) {
+		return
+	}
+
 	// don't update missed blocks when validator's jailed
 	if k.sk.IsValidatorJailed(ctx, consAddr) {
 		return
 	}
 
+	if signed {
+		k.AddValidatorPerformance(ctx, consAddr, types.ValidatorPerformance{SignedBlocks: 1})
+	} else {
+		k.AddValidatorPerformance(ctx, consAddr, types.ValidatorPerformance{MissedBlocks: 1})
+	}
+
 	// fetch signing info
 	signInfo, found := k.GetValidatorSigningInfo(ctx, consAddr)
 	if !found {
@@ -68,6 +80,12 @@ func (k Keeper) HandleValidatorSignature(ctx sdk.Context, addr cryptotypes.Addre
 		// bitmap value at this index has not changed, no need to update counter
 	}
 
+	if missed {
+		signInfo.ConsecutiveMissedBlocks++
+	} else {
+		signInfo.ConsecutiveMissedBlocks = 0
+	}
+
 	minSignedPerWindow := k.MinSignedPerWindow(ctx)
 
 	if missed {
@@ -89,6 +107,16 @@ func (k Keeper) HandleValidatorSignature(ctx sdk.Context, addr cryptotypes.Addre
 		)
 	}
 
+	// once the validator missed enough consecutive blocks, its standby
+	// consensus key, if any, replaces its consensus key instead of the
+	// validator being punished for downtime
+	activationBlocks := k.StandbyKeyActivationBlocks(ctx)
+	if missed && activationBlocks > 0 && signInfo.ConsecutiveMissedBlocks >= activationBlocks &&
+		k.activateStandbyKey(ctx, consAddr, signInfo) {
+		k.SetValidatorSigningInfo(ctx, consAddr, signInfo)
+		return
+	}
+
 	minHeight := signInfo.StartHeight + k.SignedBlocksWindow(ctx)
 	maxMissed := k.SignedBlocksWindow(ctx) - minSignedPerWindow
 
@@ -115,13 +143,14 @@ func (k Keeper) HandleValidatorSignature(ctx sdk.Context, addr cryptotypes.Addre
 					sdk.NewAttribute(types.AttributeKeyBurnedCoins, coinsBurned.String()),
 				),
 			)
-			k.sk.Jail(ctx, consAddr)
+			k.sk.JailWithReason(ctx, consAddr, stakingtypes.JailReason_JAIL_REASON_DOWNTIME, "")
 
 			signInfo.JailedUntil = ctx.BlockHeader().Time.Add(k.DowntimeJailDuration(ctx))
 
 			// We need to reset the counter & bitmap so that the validator won't be
 			// immediately slashed for downtime upon re-bonding.
 			signInfo.MissedBlocksCounter = 0
+			signInfo.ConsecutiveMissedBlocks = 0
 			signInfo.IndexOffset = 0
 			k.DeleteMissedBlockBitmap(ctx, consAddr)
 
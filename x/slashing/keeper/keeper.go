@@ -95,7 +95,13 @@ func (k Keeper) SlashWithInfractionReason(ctx sdk.Context, consAddr sdk.ConsAddr
 // Jail attempts to jail a validator. The slash is delegated to the staking module
 // to make the necessary validator changes.
 func (k Keeper) Jail(ctx sdk.Context, consAddr sdk.ConsAddress) {
-	k.sk.Jail(ctx, consAddr)
+	k.JailWithReason(ctx, consAddr, stakingtypes.JailReason_JAIL_REASON_UNSPECIFIED, "")
+}
+
+// JailWithReason attempts to jail a validator, recording the reason it was
+// jailed for along with an optional reference to what caused the jailing.
+func (k Keeper) JailWithReason(ctx sdk.Context, consAddr sdk.ConsAddress, reason stakingtypes.JailReason, reference string) {
+	k.sk.JailWithReason(ctx, consAddr, reason, reference)
 	ctx.EventManager().EmitEvent(
 		sdk.NewEvent(
 			types.EventTypeSlash,
@@ -95,7 +95,7 @@ func (s *KeeperTestSuite) TestJailAndSlash() {
 		s.ctx.BlockHeight(),
 	)
 
-	s.stakingKeeper.EXPECT().Jail(s.ctx, consAddr).Return()
+	s.stakingKeeper.EXPECT().JailWithReason(s.ctx, consAddr, stakingtypes.JailReason_JAIL_REASON_UNSPECIFIED, "").Return()
 	s.slashingKeeper.Jail(s.ctx, consAddr)
 }
 
@@ -117,8 +117,8 @@ func (s *KeeperTestSuite) TestJailAndSlashWithInfractionReason() {
 		stakingtypes.Infraction_INFRACTION_DOUBLE_SIGN,
 	)
 
-	s.stakingKeeper.EXPECT().Jail(s.ctx, consAddr).Return()
-	s.slashingKeeper.Jail(s.ctx, consAddr)
+	s.stakingKeeper.EXPECT().JailWithReason(s.ctx, consAddr, stakingtypes.JailReason_JAIL_REASON_DOUBLE_SIGN, "evidence").Return()
+	s.slashingKeeper.JailWithReason(s.ctx, consAddr, stakingtypes.JailReason_JAIL_REASON_DOUBLE_SIGN, "evidence")
 }
 
 func TestKeeperTestSuite(t *testing.T) {
@@ -39,6 +39,16 @@ func (k Keeper) SlashFractionDowntime(ctx sdk.Context) (res sdkmath.LegacyDec) {
 	return k.GetParams(ctx).SlashFractionDowntime
 }
 
+// PerformanceEpochLength - number of blocks aggregated by each validator performance record
+func (k Keeper) PerformanceEpochLength(ctx sdk.Context) (res int64) {
+	return k.GetParams(ctx).PerformanceEpochLength
+}
+
+// StandbyKeyActivationBlocks - number of consecutive missed blocks activating the standby consensus key of a validator
+func (k Keeper) StandbyKeyActivationBlocks(ctx sdk.Context) (res int64) {
+	return k.GetParams(ctx).StandbyKeyActivationBlocks
+}
+
 // GetParams returns the current x/slashing module parameters.
 func (k Keeper) GetParams(ctx sdk.Context) (params types.Params) {
 	store := ctx.KVStore(k.storeKey)
@@ -0,0 +1,103 @@
+package keeper
+
+import (
+	storetypes "cosmossdk.io/store/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/slashing/types"
+)
+
+// GetValidatorEpochPerformance returns the performance counters of a validator
+// for the epoch starting at the given height.
+func (k Keeper) GetValidatorEpochPerformance(ctx sdk.Context, consAddr sdk.ConsAddress, epochStartHeight int64) (types.ValidatorPerformance, bool) {
+	store := ctx.KVStore(k.storeKey)
+
+	var performance types.ValidatorPerformance
+	bz := store.Get(types.ValidatorPerformanceKey(consAddr, epochStartHeight))
+	if bz == nil {
+		return performance, false
+	}
+
+	k.cdc.MustUnmarshal(bz, &performance)
+	return performance, true
+}
+
+// SetValidatorEpochPerformance sets the performance counters of a validator for
+// the epoch starting at the given height.
+func (k Keeper) SetValidatorEpochPerformance(ctx sdk.Context, consAddr sdk.ConsAddress, epochStartHeight int64, performance types.ValidatorPerformance) {
+	store := ctx.KVStore(k.storeKey)
+	bz := k.cdc.MustMarshal(&performance)
+	store.Set(types.ValidatorPerformanceKey(consAddr, epochStartHeight), bz)
+}
+
+// AddValidatorPerformance adds the given counters to the performance of a
+// validator for the current epoch. It is a no-op if performance tracking is
+// disabled.
+func (k Keeper) AddValidatorPerformance(ctx sdk.Context, consAddr sdk.ConsAddress, delta types.ValidatorPerformance) {
+	epochLength := k.PerformanceEpochLength(ctx)
+	if epochLength <= 0 {
+		return
+	}
+
+	epochStartHeight := types.EpochStartHeight(ctx.BlockHeight(), epochLength)
+	performance, _ := k.GetValidatorEpochPerformance(ctx, consAddr, epochStartHeight)
+	k.SetValidatorEpochPerformance(ctx, consAddr, epochStartHeight, performance.Add(delta))
+}
+
+// IterateValidatorPerformances iterates over the stored per-epoch performance
+// counters of all validators.
+func (k Keeper) IterateValidatorPerformances(ctx sdk.Context,
+	handler func(address sdk.ConsAddress, epochStartHeight int64, performance types.ValidatorPerformance) (stop bool),
+) {
+	store := ctx.KVStore(k.storeKey)
+	iter := storetypes.KVStorePrefixIterator(store, types.ValidatorPerformanceKeyPrefix)
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		address, epochStartHeight := types.ParseValidatorPerformanceKey(iter.Key())
+		var performance types.ValidatorPerformance
+		k.cdc.MustUnmarshal(iter.Value(), &performance)
+		if handler(address, epochStartHeight, performance) {
+			break
+		}
+	}
+}
+
+// PerformanceRange returns the range of heights covered by the performance
+// counters aggregated for the given range, i.e. from the start of the epoch
+// containing startHeight to the end of the epoch containing endHeight, capped
+// at the current height.
+func (k Keeper) PerformanceRange(ctx sdk.Context, startHeight, endHeight int64) (int64, int64) {
+	epochLength := k.PerformanceEpochLength(ctx)
+	start := types.EpochStartHeight(startHeight, epochLength)
+	end := types.EpochStartHeight(endHeight, epochLength) + epochLength - 1
+	if end > ctx.BlockHeight() {
+		end = ctx.BlockHeight()
+	}
+
+	return start, end
+}
+
+// GetValidatorPerformance returns the sum of the performance counters of a
+// validator over the epochs overlapping the given range of heights.
+func (k Keeper) GetValidatorPerformance(ctx sdk.Context, consAddr sdk.ConsAddress, startHeight, endHeight int64) types.ValidatorPerformance {
+	var total types.ValidatorPerformance
+	if endHeight < startHeight {
+		return total
+	}
+
+	epochStartHeight := types.EpochStartHeight(startHeight, k.PerformanceEpochLength(ctx))
+
+	store := ctx.KVStore(k.storeKey)
+	iter := store.Iterator(
+		types.ValidatorPerformanceKey(consAddr, epochStartHeight),
+		types.ValidatorPerformanceKey(consAddr, endHeight+1),
+	)
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		var performance types.ValidatorPerformance
+		k.cdc.MustUnmarshal(iter.Value(), &performance)
+		total = total.Add(performance)
+	}
+
+	return total
+}
@@ -0,0 +1,96 @@
+package keeper_test
+
+import (
+	"github.com/golang/mock/gomock"
+
+	"github.com/cosmos/cosmos-sdk/testutil/testdata"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	slashingtypes "github.com/cosmos/cosmos-sdk/x/slashing/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+)
+
+func (s *KeeperTestSuite) TestValidatorPerformance() {
+	ctx, keeper := s.ctx, s.slashingKeeper
+	require := s.Require()
+
+	params := keeper.GetParams(ctx)
+	params.PerformanceEpochLength = 100
+	require.NoError(keeper.SetParams(ctx, params))
+
+	_, pubKey, addr := testdata.KeyTestPubAddr()
+	valAddr := sdk.ValAddress(addr)
+	valConsAddr := sdk.ConsAddress(pubKey.Address())
+	validator, err := stakingtypes.NewValidator(valAddr, pubKey, stakingtypes.Description{})
+	require.NoError(err)
+	s.stakingKeeper.EXPECT().Validator(gomock.Any(), valAddr).Return(validator).AnyTimes()
+
+	// first epoch
+	ctx = ctx.WithBlockHeight(150)
+	keeper.AddValidatorPerformance(ctx, valConsAddr, slashingtypes.ValidatorPerformance{SignedBlocks: 1})
+	keeper.AddValidatorPerformance(ctx, valConsAddr, slashingtypes.ValidatorPerformance{ProposedBlocks: 1})
+
+	// second epoch
+	ctx = ctx.WithBlockHeight(250)
+	keeper.AddValidatorPerformance(ctx, valConsAddr, slashingtypes.ValidatorPerformance{MissedBlocks: 1})
+	keeper.GovHooks().AfterProposalVote(ctx, 1, sdk.AccAddress(valAddr))
+	require.NoError(keeper.Hooks().AfterValidatorSlashed(ctx, valAddr, params.SlashFractionDowntime, nil))
+
+	// votes of accounts not operating a validator are ignored
+	otherAddr := sdk.AccAddress([]byte("addr2_______________"))
+	s.stakingKeeper.EXPECT().Validator(gomock.Any(), sdk.ValAddress(otherAddr)).Return(nil)
+	keeper.GovHooks().AfterProposalVote(ctx, 1, otherAddr)
+
+	performance, found := keeper.GetValidatorEpochPerformance(ctx, valConsAddr, 100)
+	require.True(found)
+	require.Equal(slashingtypes.ValidatorPerformance{SignedBlocks: 1, ProposedBlocks: 1}, performance)
+
+	performance, found = keeper.GetValidatorEpochPerformance(ctx, valConsAddr, 200)
+	require.True(found)
+	require.Equal(slashingtypes.ValidatorPerformance{MissedBlocks: 1, GovVotes: 1, SlashEvents: 1}, performance)
+
+	// the range is extended to the epochs containing its bounds
+	res, err := keeper.ValidatorPerformance(ctx, &slashingtypes.QueryValidatorPerformanceRequest{
+		ConsAddress: valConsAddr.String(),
+		StartHeight: 120,
+		EndHeight:   180,
+	})
+	require.NoError(err)
+	require.Equal(slashingtypes.ValidatorPerformance{SignedBlocks: 1, ProposedBlocks: 1}, res.Performance)
+	require.Equal(int64(100), res.StartHeight)
+	require.Equal(int64(199), res.EndHeight)
+
+	// the end height defaults to the current height
+	res, err = keeper.ValidatorPerformance(ctx, &slashingtypes.QueryValidatorPerformanceRequest{
+		ConsAddress: valConsAddr.String(),
+	})
+	require.NoError(err)
+	require.Equal(slashingtypes.ValidatorPerformance{SignedBlocks: 1, MissedBlocks: 1, ProposedBlocks: 1, GovVotes: 1, SlashEvents: 1}, res.Performance)
+	require.Equal(int64(0), res.StartHeight)
+	require.Equal(int64(250), res.EndHeight)
+
+	_, err = keeper.ValidatorPerformance(ctx, &slashingtypes.QueryValidatorPerformanceRequest{
+		ConsAddress: valConsAddr.String(),
+		StartHeight: 200,
+		EndHeight:   100,
+	})
+	require.ErrorContains(err, "invalid height range")
+
+	// all the validators with signing info are returned
+	keeper.SetValidatorSigningInfo(ctx, valConsAddr, slashingtypes.NewValidatorSigningInfo(valConsAddr, 0, 0, ctx.BlockTime(), false, 0))
+	allRes, err := keeper.ValidatorsPerformance(ctx, &slashingtypes.QueryValidatorsPerformanceRequest{StartHeight: 200})
+	require.NoError(err)
+	require.Equal([]slashingtypes.ValidatorRangePerformance{{
+		Address:     valConsAddr.String(),
+		Performance: slashingtypes.ValidatorPerformance{MissedBlocks: 1, GovVotes: 1, SlashEvents: 1},
+	}}, allRes.Performances)
+
+	// no counters are kept once performance tracking is disabled
+	params.PerformanceEpochLength = 0
+	require.NoError(keeper.SetParams(ctx, params))
+	keeper.AddValidatorPerformance(ctx.WithBlockHeight(350), valConsAddr, slashingtypes.ValidatorPerformance{SignedBlocks: 1})
+	_, found = keeper.GetValidatorEpochPerformance(ctx, valConsAddr, 300)
+	require.False(found)
+
+	_, err = keeper.ValidatorsPerformance(ctx, &slashingtypes.QueryValidatorsPerformanceRequest{})
+	require.ErrorContains(err, "disabled")
+}
@@ -59,6 +59,8 @@ func (k Keeper) IterateValidatorSigningInfos(ctx sdk.Context,
 
 // JailUntil attempts to set a validator's JailedUntil attribute in its signing
 // info. It will panic if the signing info does not exist for the validator.
+// The jailing of a consensus address replaced by a standby consensus key
+// applies to the current consensus address of the validator.
 func (k Keeper) JailUntil(ctx sdk.Context, consAddr sdk.ConsAddress, jailTime time.Time) {
 	signInfo, ok := k.GetValidatorSigningInfo(ctx, consAddr)
 	if !ok {
@@ -67,10 +69,16 @@ func (k Keeper) JailUntil(ctx sdk.Context, consAddr sdk.ConsAddress, jailTime ti
 
 	signInfo.JailedUntil = jailTime
 	k.SetValidatorSigningInfo(ctx, consAddr, signInfo)
+
+	if current := k.currentConsAddr(ctx, consAddr); !current.Equals(consAddr) {
+		k.JailUntil(ctx, current, jailTime)
+	}
 }
 
 // Tombstone attempts to tombstone a validator. It will panic if signing info for
-// the given validator does not exist.
+// the given validator does not exist. The tombstoning of a consensus address
+// replaced by a standby consensus key applies to the current consensus address
+// of the validator.
 func (k Keeper) Tombstone(ctx sdk.Context, consAddr sdk.ConsAddress) {
 	signInfo, ok := k.GetValidatorSigningInfo(ctx, consAddr)
 	if !ok {
@@ -83,16 +91,29 @@ func (k Keeper) Tombstone(ctx sdk.Context, consAddr sdk.ConsAddress) {
 
 	signInfo.Tombstoned = true
 	k.SetValidatorSigningInfo(ctx, consAddr, signInfo)
+
+	if current := k.currentConsAddr(ctx, consAddr); !current.Equals(consAddr) && !k.IsTombstoned(ctx, current) {
+		k.Tombstone(ctx, current)
+	}
 }
 
-// IsTombstoned returns if a given validator by consensus address is tombstoned.
+// IsTombstoned returns if a given validator by consensus address is tombstoned,
+// either through the consensus address or, if it was replaced by a standby
+// consensus key, through the current consensus address of the validator.
 func (k Keeper) IsTombstoned(ctx sdk.Context, consAddr sdk.ConsAddress) bool {
 	signInfo, ok := k.GetValidatorSigningInfo(ctx, consAddr)
 	if !ok {
 		return false
 	}
+	if signInfo.Tombstoned {
+		return true
+	}
+
+	if current := k.currentConsAddr(ctx, consAddr); !current.Equals(consAddr) {
+		return k.IsTombstoned(ctx, current)
+	}
 
-	return signInfo.Tombstoned
+	return false
 }
 
 // getMissedBlockBitmapChunk gets the bitmap chunk at the given chunk index for
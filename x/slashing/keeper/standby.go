@@ -0,0 +1,84 @@
+package keeper
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/slashing/types"
+)
+
+// activateStandbyKey activates the standby consensus key, if any, of the
+// validator of a consensus address, which replaces the consensus key at the
+// end of the block. It returns whether a standby consensus key was activated.
+func (k Keeper) activateStandbyKey(ctx sdk.Context, consAddr sdk.ConsAddress, signInfo types.ValidatorSigningInfo) bool {
+	validator := k.sk.ValidatorByConsAddr(ctx, consAddr)
+	if validator == nil || validator.IsJailed() {
+		return false
+	}
+
+	pk, err := k.sk.ActivateStandbyConsPubKey(ctx, validator.GetOperator())
+	if err != nil {
+		// the validator has no standby consensus key
+		return false
+	}
+
+	standbyAddr := sdk.GetConsAddress(pk)
+	if err := k.AddPubkey(ctx, pk); err != nil {
+		panic(err)
+	}
+
+	// the liveness of the standby consensus key is tracked from its
+	// activation, the jailing of the validator being carried over
+	k.SetValidatorSigningInfo(ctx, standbyAddr, types.NewValidatorSigningInfo(
+		standbyAddr,
+		ctx.BlockHeight(),
+		0,
+		signInfo.JailedUntil,
+		false,
+		0,
+	))
+
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.ReplacedConsAddrKey(consAddr), standbyAddr)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeStandbyKeyActivated,
+			sdk.NewAttribute(types.AttributeKeyAddress, consAddr.String()),
+			sdk.NewAttribute(types.AttributeKeyStandbyAddress, standbyAddr.String()),
+			sdk.NewAttribute(types.AttributeKeyMissedBlocks, fmt.Sprintf("%d", signInfo.ConsecutiveMissedBlocks)),
+			sdk.NewAttribute(types.AttributeKeyHeight, fmt.Sprintf("%d", ctx.BlockHeight())),
+		),
+	)
+
+	k.Logger(ctx).Info(
+		"activating standby consensus key of validator",
+		"height", ctx.BlockHeight(),
+		"validator", consAddr.String(),
+		"standby", standbyAddr.String(),
+		"consecutive_missed", signInfo.ConsecutiveMissedBlocks,
+	)
+
+	return true
+}
+
+// IsReplacedConsAddr returns whether a consensus address was replaced by a
+// standby consensus key.
+func (k Keeper) IsReplacedConsAddr(ctx sdk.Context, consAddr sdk.ConsAddress) bool {
+	store := ctx.KVStore(k.storeKey)
+	return store.Has(types.ReplacedConsAddrKey(consAddr))
+}
+
+// currentConsAddr returns the consensus address replacing a consensus
+// address, following the successive standby consensus key activations, or
+// the consensus address itself if it was not replaced.
+func (k Keeper) currentConsAddr(ctx sdk.Context, consAddr sdk.ConsAddress) sdk.ConsAddress {
+	store := ctx.KVStore(k.storeKey)
+	for {
+		bz := store.Get(types.ReplacedConsAddrKey(consAddr))
+		if bz == nil {
+			return consAddr
+		}
+		consAddr = sdk.ConsAddress(bz)
+	}
+}
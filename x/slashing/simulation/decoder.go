@@ -37,6 +37,12 @@ func NewDecodeStore(cdc codec.BinaryCodec) func(kvA, kvB kv.Pair) string {
 			}
 			return fmt.Sprintf("PubKeyA: %s\nPubKeyB: %s", pubKeyA, pubKeyB)
 
+		case bytes.Equal(kvA.Key[:1], types.ValidatorPerformanceKeyPrefix):
+			var performanceA, performanceB types.ValidatorPerformance
+			cdc.MustUnmarshal(kvA.Value, &performanceA)
+			cdc.MustUnmarshal(kvB.Value, &performanceB)
+			return fmt.Sprintf("%v\n%v", performanceA, performanceB)
+
 		default:
 			panic(fmt.Sprintf("invalid slashing key prefix %X", kvA.Key[:1]))
 		}
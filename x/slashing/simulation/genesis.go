@@ -80,7 +80,8 @@ func RandomizedGenState(simState *module.SimulationState) {
 
 	params := types.NewParams(
 		signedBlocksWindow, minSignedPerWindow, downtimeJailDuration,
-		slashFractionDoubleSign, slashFractionDowntime,
+		slashFractionDoubleSign, slashFractionDowntime, types.DefaultPerformanceEpochLength,
+		types.DefaultStandbyKeyActivationBlocks,
 	)
 
 	slashingGenesis := types.NewGenesisState(params, []types.SigningInfo{}, []types.ValidatorMissedBlocks{})
@@ -2,16 +2,18 @@ package types
 
 // Slashing module event types
 const (
-	EventTypeSlash    = "slash"
-	EventTypeLiveness = "liveness"
+	EventTypeSlash               = "slash"
+	EventTypeLiveness            = "liveness"
+	EventTypeStandbyKeyActivated = "standby_key_activated"
 
-	AttributeKeyAddress      = "address"
-	AttributeKeyHeight       = "height"
-	AttributeKeyPower        = "power"
-	AttributeKeyReason       = "reason"
-	AttributeKeyJailed       = "jailed"
-	AttributeKeyMissedBlocks = "missed_blocks"
-	AttributeKeyBurnedCoins  = "burned_coins"
+	AttributeKeyAddress        = "address"
+	AttributeKeyHeight         = "height"
+	AttributeKeyPower          = "power"
+	AttributeKeyReason         = "reason"
+	AttributeKeyJailed         = "jailed"
+	AttributeKeyMissedBlocks   = "missed_blocks"
+	AttributeKeyBurnedCoins    = "burned_coins"
+	AttributeKeyStandbyAddress = "standby_address"
 
 	AttributeValueDoubleSign       = "double_sign"
 	AttributeValueMissingSignature = "missing_signature"
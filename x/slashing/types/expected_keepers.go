@@ -5,6 +5,7 @@ import (
 
 	"cosmossdk.io/math"
 
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
 	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
@@ -35,29 +36,24 @@ type ParamSubspace interface {
 
 // StakingKeeper expected staking keeper
 type StakingKeeper interface {
-	// iterate through validators by operator address, execute func for each validator
-	IterateValidators(sdk.Context,
-		func(index int64, validator stakingtypes.ValidatorI) (stop bool))
-
-	Validator(sdk.Context, sdk.ValAddress) stakingtypes.ValidatorI            // get a particular validator by operator address
-	ValidatorByConsAddr(sdk.Context, sdk.ConsAddress) stakingtypes.ValidatorI // get a particular validator by consensus address
+	stakingtypes.ViewKeeper
 
 	// slash the validator and delegators of the validator, specifying offense height, offense power, and slash fraction
 	Slash(sdk.Context, sdk.ConsAddress, int64, int64, math.LegacyDec) math.Int
 	SlashWithInfractionReason(sdk.Context, sdk.ConsAddress, int64, int64, math.LegacyDec, stakingtypes.Infraction) math.Int
-	Jail(sdk.Context, sdk.ConsAddress)   // jail a validator
-	Unjail(sdk.Context, sdk.ConsAddress) // unjail a validator
-
-	// Delegation allows for getting a particular delegation for a given validator
-	// and delegator outside the scope of the staking module.
-	Delegation(sdk.Context, sdk.AccAddress, sdk.ValAddress) stakingtypes.DelegationI
-	GetAllValidators(ctx sdk.Context) (validators []stakingtypes.Validator)
+	Jail(sdk.Context, sdk.ConsAddress)                                            // jail a validator
+	JailWithReason(sdk.Context, sdk.ConsAddress, stakingtypes.JailReason, string) // jail a validator, recording why
+	Unjail(sdk.Context, sdk.ConsAddress)                                          // unjail a validator
 
 	// MaxValidators returns the maximum amount of bonded validators
 	MaxValidators(sdk.Context) uint32
 
 	// IsValidatorJailed returns if the validator is jailed.
 	IsValidatorJailed(ctx sdk.Context, addr sdk.ConsAddress) bool
+
+	// ActivateStandbyConsPubKey activates the standby consensus pubkey of a
+	// validator, replacing its consensus pubkey at the end of the block.
+	ActivateStandbyConsPubKey(ctx sdk.Context, valAddr sdk.ValAddress) (cryptotypes.PubKey, error)
 }
 
 // StakingHooks event hooks for staking validator object (noalias)
@@ -5,6 +5,8 @@ import (
 	"time"
 
 	"cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
 // NewGenesisState creates a new GenesisState object
@@ -29,9 +31,10 @@ func NewMissedBlock(index int64, missed bool) MissedBlock {
 // DefaultGenesisState - default GenesisState used by Cosmos Hub
 func DefaultGenesisState() *GenesisState {
 	return &GenesisState{
-		Params:       DefaultParams(),
-		SigningInfos: []SigningInfo{},
-		MissedBlocks: []ValidatorMissedBlocks{},
+		Params:                DefaultParams(),
+		SigningInfos:          []SigningInfo{},
+		MissedBlocks:          []ValidatorMissedBlocks{},
+		ValidatorPerformances: []ValidatorEpochPerformance{},
 	}
 }
 
@@ -62,5 +65,23 @@ func ValidateGenesis(data GenesisState) error {
 		return fmt.Errorf("signed blocks window must be at least 10, is %d", signedWindow)
 	}
 
+	if err := validatePerformanceEpochLength(data.Params.PerformanceEpochLength); err != nil {
+		return err
+	}
+
+	if err := validateStandbyKeyActivationBlocks(data.Params.StandbyKeyActivationBlocks); err != nil {
+		return err
+	}
+
+	for _, performance := range data.ValidatorPerformances {
+		if _, err := sdk.ConsAddressFromBech32(performance.Address); err != nil {
+			return fmt.Errorf("invalid validator performance address %s: %w", performance.Address, err)
+		}
+
+		if performance.EpochStartHeight < 0 {
+			return fmt.Errorf("validator performance epoch start height cannot be negative, is %d", performance.EpochStartHeight)
+		}
+	}
+
 	return nil
 }
@@ -35,6 +35,11 @@ type GenesisState struct {
 	// missed_blocks represents a map between validator addresses and their
 	// missed blocks.
 	MissedBlocks []ValidatorMissedBlocks `protobuf:"bytes,3,rep,name=missed_blocks,json=missedBlocks,proto3" json:"missed_blocks"`
+	// validator_performances represents the performance counters of the
+	// validators per epoch.
+	//
+	// Since: cosmos-sdk 0.48
+	ValidatorPerformances []ValidatorEpochPerformance `protobuf:"bytes,4,rep,name=validator_performances,json=validatorPerformances,proto3" json:"validator_performances"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -91,6 +96,80 @@ func (m *GenesisState) GetMissedBlocks() []ValidatorMissedBlocks {
 	return nil
 }
 
+func (m *GenesisState) GetValidatorPerformances() []ValidatorEpochPerformance {
+	if m != nil {
+		return m.ValidatorPerformances
+	}
+	return nil
+}
+
+// ValidatorEpochPerformance contains the performance counters of a validator
+// over an epoch.
+//
+// Since: cosmos-sdk 0.48
+type ValidatorEpochPerformance struct {
+	// address is the validator consensus address.
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	// epoch_start_height is the height of the first block of the epoch.
+	EpochStartHeight int64 `protobuf:"varint,2,opt,name=epoch_start_height,json=epochStartHeight,proto3" json:"epoch_start_height,omitempty"`
+	// performance is the performance counters of the validator over the epoch.
+	Performance ValidatorPerformance `protobuf:"bytes,3,opt,name=performance,proto3" json:"performance"`
+}
+
+func (m *ValidatorEpochPerformance) Reset()         { *m = ValidatorEpochPerformance{} }
+func (m *ValidatorEpochPerformance) String() string { return proto.CompactTextString(m) }
+func (*ValidatorEpochPerformance) ProtoMessage()    {}
+func (*ValidatorEpochPerformance) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1923b9188b635394, []int{1}
+}
+func (m *ValidatorEpochPerformance) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ValidatorEpochPerformance) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ValidatorEpochPerformance.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ValidatorEpochPerformance) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ValidatorEpochPerformance.Merge(m, src)
+}
+func (m *ValidatorEpochPerformance) XXX_Size() int {
+	return m.Size()
+}
+func (m *ValidatorEpochPerformance) XXX_DiscardUnknown() {
+	xxx_messageInfo_ValidatorEpochPerformance.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ValidatorEpochPerformance proto.InternalMessageInfo
+
+func (m *ValidatorEpochPerformance) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+func (m *ValidatorEpochPerformance) GetEpochStartHeight() int64 {
+	if m != nil {
+		return m.EpochStartHeight
+	}
+	return 0
+}
+
+func (m *ValidatorEpochPerformance) GetPerformance() ValidatorPerformance {
+	if m != nil {
+		return m.Performance
+	}
+	return ValidatorPerformance{}
+}
+
 // SigningInfo stores validator signing info of corresponding address.
 type SigningInfo struct {
 	// address is the validator address.
@@ -103,7 +182,7 @@ func (m *SigningInfo) Reset()         { *m = SigningInfo{} }
 func (m *SigningInfo) String() string { return proto.CompactTextString(m) }
 func (*SigningInfo) ProtoMessage()    {}
 func (*SigningInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_1923b9188b635394, []int{1}
+	return fileDescriptor_1923b9188b635394, []int{2}
 }
 func (m *SigningInfo) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -159,7 +238,7 @@ func (m *ValidatorMissedBlocks) Reset()         { *m = ValidatorMissedBlocks{} }
 func (m *ValidatorMissedBlocks) String() string { return proto.CompactTextString(m) }
 func (*ValidatorMissedBlocks) ProtoMessage()    {}
 func (*ValidatorMissedBlocks) Descriptor() ([]byte, []int) {
-	return fileDescriptor_1923b9188b635394, []int{2}
+	return fileDescriptor_1923b9188b635394, []int{3}
 }
 func (m *ValidatorMissedBlocks) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -214,7 +293,7 @@ func (m *MissedBlock) Reset()         { *m = MissedBlock{} }
 func (m *MissedBlock) String() string { return proto.CompactTextString(m) }
 func (*MissedBlock) ProtoMessage()    {}
 func (*MissedBlock) Descriptor() ([]byte, []int) {
-	return fileDescriptor_1923b9188b635394, []int{3}
+	return fileDescriptor_1923b9188b635394, []int{4}
 }
 func (m *MissedBlock) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -259,6 +338,7 @@ func (m *MissedBlock) GetMissed() bool {
 
 func init() {
 	proto.RegisterType((*GenesisState)(nil), "cosmos.slashing.v1beta1.GenesisState")
+	proto.RegisterType((*ValidatorEpochPerformance)(nil), "cosmos.slashing.v1beta1.ValidatorEpochPerformance")
 	proto.RegisterType((*SigningInfo)(nil), "cosmos.slashing.v1beta1.SigningInfo")
 	proto.RegisterType((*ValidatorMissedBlocks)(nil), "cosmos.slashing.v1beta1.ValidatorMissedBlocks")
 	proto.RegisterType((*MissedBlock)(nil), "cosmos.slashing.v1beta1.MissedBlock")
@@ -269,35 +349,41 @@ func init() {
 }
 
 var fileDescriptor_1923b9188b635394 = []byte{
-	// 444 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x93, 0xc1, 0x6e, 0xd3, 0x30,
-	0x18, 0xc7, 0xe3, 0x56, 0x14, 0xe6, 0x6c, 0x07, 0xac, 0x32, 0xc2, 0x24, 0xb2, 0x51, 0x01, 0x9a,
-	0x90, 0x92, 0x68, 0xe3, 0xb8, 0x13, 0xe1, 0x30, 0x71, 0x40, 0x42, 0xe9, 0xc4, 0x81, 0x03, 0x91,
-	0xd3, 0x78, 0x9e, 0xb5, 0xc6, 0xae, 0xf2, 0x79, 0x55, 0x79, 0x03, 0x8e, 0x3c, 0x06, 0xe2, 0xc4,
-	0x81, 0x13, 0x4f, 0xd0, 0x63, 0xc5, 0x89, 0x13, 0x42, 0xed, 0x81, 0xd7, 0x40, 0xd8, 0x29, 0x0d,
-	0xa8, 0x51, 0x0f, 0xbb, 0x24, 0xb1, 0xfd, 0xfb, 0xff, 0xbf, 0xcf, 0xff, 0xd8, 0xf8, 0xd1, 0x40,
-	0x41, 0xa1, 0x20, 0x82, 0x21, 0x85, 0x0b, 0x21, 0x79, 0x34, 0x3e, 0xca, 0x98, 0xa6, 0x47, 0x11,
-	0x67, 0x92, 0x81, 0x80, 0x70, 0x54, 0x2a, 0xad, 0xc8, 0x5d, 0x8b, 0x85, 0x4b, 0x2c, 0xac, 0xb0,
-	0xbd, 0x2e, 0x57, 0x5c, 0x19, 0x26, 0xfa, 0xf3, 0x65, 0xf1, 0xbd, 0xc7, 0x4d, 0xae, 0x7f, 0xf5,
-	0x96, 0xbb, 0x67, 0xb9, 0xd4, 0x1a, 0x54, 0x35, 0xec, 0xd2, 0x6d, 0x5a, 0x08, 0xa9, 0x22, 0xf3,
-	0xb4, 0x53, 0xbd, 0xf7, 0x2d, 0xbc, 0x7d, 0x6a, 0xdb, 0xea, 0x6b, 0xaa, 0x19, 0x89, 0x71, 0x67,
-	0x44, 0x4b, 0x5a, 0x80, 0x87, 0x0e, 0xd0, 0xa1, 0x7b, 0xbc, 0x1f, 0x36, 0xb4, 0x19, 0xbe, 0x32,
-	0x58, 0xbc, 0x35, 0xfd, 0xb1, 0xef, 0x7c, 0xfc, 0xf5, 0xf9, 0x09, 0x4a, 0x2a, 0x25, 0x39, 0xc3,
-	0x3b, 0x20, 0xb8, 0x14, 0x92, 0xa7, 0x42, 0x9e, 0x2b, 0xf0, 0x5a, 0x07, 0xed, 0x43, 0xf7, 0xf8,
-	0x61, 0xa3, 0x55, 0xdf, 0xd2, 0x2f, 0xe4, 0xb9, 0xaa, 0xfb, 0x6d, 0xc3, 0x6a, 0x1e, 0xc8, 0x5b,
-	0xbc, 0x53, 0x08, 0x00, 0x96, 0xa7, 0xd9, 0x50, 0x0d, 0x2e, 0xc1, 0x6b, 0x1b, 0xd7, 0xb0, 0xd1,
-	0xf5, 0x35, 0x1d, 0x8a, 0x9c, 0x6a, 0x55, 0xbe, 0x34, 0xb2, 0xd8, 0xa8, 0xfe, 0xf1, 0x2f, 0x6a,
-	0x0b, 0xbd, 0xaf, 0x08, 0xbb, 0xb5, 0x46, 0xc8, 0x09, 0xbe, 0x49, 0xf3, 0xbc, 0x64, 0x60, 0xa3,
-	0xd8, 0x8a, 0x1f, 0x7c, 0xfb, 0x12, 0xdc, 0xaf, 0x8a, 0x3d, 0x57, 0x12, 0x98, 0x84, 0x2b, 0x78,
-	0x66, 0x91, 0xbe, 0x2e, 0x85, 0xe4, 0xc9, 0x52, 0x41, 0x24, 0xde, 0x1d, 0x2f, 0xcb, 0xa7, 0xf5,
-	0x30, 0xbc, 0x96, 0x89, 0x35, 0xd8, 0xdc, 0x75, 0x43, 0x28, 0xdd, 0xf1, 0x1a, 0xa0, 0xf7, 0x09,
-	0xe1, 0x3b, 0x6b, 0xf7, 0x7b, 0xbd, 0x6d, 0x9c, 0xfd, 0x9f, 0xf9, 0xa6, 0x3f, 0x59, 0x2b, 0xdd,
-	0x9c, 0xf4, 0x09, 0x76, 0x6b, 0x1c, 0xe9, 0xe2, 0x1b, 0x42, 0xe6, 0x6c, 0x62, 0xfa, 0x6b, 0x27,
-	0x76, 0x40, 0x76, 0x71, 0xc7, 0x8a, 0x4c, 0x62, 0xb7, 0x92, 0x6a, 0x14, 0x9f, 0x4e, 0xe7, 0x3e,
-	0x9a, 0xcd, 0x7d, 0xf4, 0x73, 0xee, 0xa3, 0x0f, 0x0b, 0xdf, 0x99, 0x2d, 0x7c, 0xe7, 0xfb, 0xc2,
-	0x77, 0xde, 0x04, 0x5c, 0xe8, 0x8b, 0xab, 0x2c, 0x1c, 0xa8, 0xa2, 0x3a, 0xf7, 0xd5, 0x2b, 0x80,
-	0xfc, 0x32, 0x9a, 0xac, 0x6e, 0x8e, 0x7e, 0x37, 0x62, 0x90, 0x75, 0xcc, 0x0d, 0x78, 0xfa, 0x3b,
-	0x00, 0x00, 0xff, 0xff, 0xc1, 0xf1, 0x72, 0x89, 0xaf, 0x03, 0x00, 0x00,
+	// 529 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x94, 0x41, 0x6b, 0x13, 0x41,
+	0x14, 0xc7, 0x33, 0x89, 0x46, 0x3b, 0x69, 0x41, 0x87, 0xb4, 0x6e, 0x0b, 0x6e, 0x63, 0x50, 0x09,
+	0x62, 0x76, 0x69, 0x3c, 0xf6, 0x64, 0x44, 0xaa, 0x07, 0xa1, 0x24, 0xc5, 0x43, 0x0f, 0x2e, 0x93,
+	0xec, 0x64, 0x77, 0x68, 0x76, 0x66, 0xd9, 0x37, 0x0d, 0xf5, 0x5b, 0xf8, 0x31, 0xc4, 0x93, 0x07,
+	0x4f, 0x7e, 0x82, 0x1e, 0x8b, 0x27, 0x4f, 0x22, 0xc9, 0xc1, 0x6f, 0x21, 0x92, 0x99, 0x4d, 0x33,
+	0x4a, 0x97, 0x08, 0xbd, 0x24, 0xd9, 0x79, 0xbf, 0xff, 0xff, 0xbd, 0x97, 0xf7, 0x76, 0xf0, 0xa3,
+	0xa1, 0x84, 0x44, 0x82, 0x0f, 0x63, 0x0a, 0x31, 0x17, 0x91, 0x3f, 0xd9, 0x1b, 0x30, 0x45, 0xf7,
+	0xfc, 0x88, 0x09, 0x06, 0x1c, 0xbc, 0x34, 0x93, 0x4a, 0x92, 0x7b, 0x06, 0xf3, 0x16, 0x98, 0x97,
+	0x63, 0x3b, 0xf5, 0x48, 0x46, 0x52, 0x33, 0xfe, 0xfc, 0x97, 0xc1, 0x77, 0x1e, 0x17, 0xb9, 0x5e,
+	0xea, 0x0d, 0xb7, 0x6d, 0xb8, 0xc0, 0x18, 0xe4, 0x39, 0x4c, 0xe8, 0x2e, 0x4d, 0xb8, 0x90, 0xbe,
+	0xfe, 0x34, 0x47, 0xcd, 0xdf, 0x65, 0xbc, 0x7e, 0x60, 0xca, 0xea, 0x2b, 0xaa, 0x18, 0xe9, 0xe2,
+	0x6a, 0x4a, 0x33, 0x9a, 0x80, 0x83, 0x1a, 0xa8, 0x55, 0xeb, 0xec, 0x7a, 0x05, 0x65, 0x7a, 0x87,
+	0x1a, 0xeb, 0xae, 0x9d, 0xff, 0xd8, 0x2d, 0x7d, 0xfc, 0xf5, 0xf9, 0x09, 0xea, 0xe5, 0x4a, 0x72,
+	0x84, 0x37, 0x80, 0x47, 0x82, 0x8b, 0x28, 0xe0, 0x62, 0x24, 0xc1, 0x29, 0x37, 0x2a, 0xad, 0x5a,
+	0xe7, 0x61, 0xa1, 0x55, 0xdf, 0xd0, 0xaf, 0xc5, 0x48, 0xda, 0x7e, 0xeb, 0xb0, 0x3c, 0x07, 0xf2,
+	0x0e, 0x6f, 0x24, 0x1c, 0x80, 0x85, 0xc1, 0x60, 0x2c, 0x87, 0x27, 0xe0, 0x54, 0xb4, 0xab, 0x57,
+	0xe8, 0xfa, 0x96, 0x8e, 0x79, 0x48, 0x95, 0xcc, 0xde, 0x68, 0x59, 0x57, 0xab, 0xfe, 0xf2, 0x4f,
+	0xac, 0x00, 0x51, 0x78, 0x6b, 0xb2, 0x50, 0x04, 0x29, 0xcb, 0x46, 0x32, 0x4b, 0xa8, 0x18, 0x32,
+	0x70, 0x6e, 0xe8, 0x44, 0x9d, 0xd5, 0x89, 0x5e, 0xa6, 0x72, 0x18, 0x1f, 0x2e, 0xa5, 0x76, 0xb2,
+	0xcd, 0x4b, 0x73, 0x0b, 0x80, 0xe6, 0x14, 0xe1, 0xed, 0x42, 0x3d, 0xd9, 0xc7, 0xb7, 0x68, 0x18,
+	0x66, 0x0c, 0xcc, 0x38, 0xd6, 0xba, 0x0f, 0xbe, 0x7d, 0x69, 0xdf, 0xcf, 0xeb, 0x78, 0x21, 0x05,
+	0x30, 0x01, 0xa7, 0xf0, 0xdc, 0x20, 0x7d, 0x95, 0x71, 0x11, 0xf5, 0x16, 0x0a, 0xf2, 0x14, 0x13,
+	0x36, 0x37, 0x0c, 0x40, 0xd1, 0x4c, 0x05, 0x31, 0xe3, 0x51, 0xac, 0x9c, 0x72, 0x03, 0xb5, 0x2a,
+	0xbd, 0x3b, 0x3a, 0xd2, 0x9f, 0x07, 0x5e, 0xe9, 0x73, 0x72, 0x8c, 0x6b, 0x56, 0xd3, 0x4e, 0x45,
+	0x4f, 0xbf, 0xbd, 0xba, 0xe7, 0x82, 0x76, 0x6d, 0xb3, 0xe6, 0x57, 0x84, 0x6b, 0xd6, 0x8c, 0xaf,
+	0xd7, 0x96, 0xb0, 0xe7, 0x64, 0xef, 0x99, 0x53, 0xfe, 0xdf, 0x9a, 0x0b, 0xf6, 0xad, 0x3e, 0xb9,
+	0x02, 0x68, 0x7e, 0x42, 0x78, 0xf3, 0xca, 0x55, 0xba, 0x5e, 0x1b, 0x47, 0xff, 0xae, 0xf3, 0xaa,
+	0x97, 0xc4, 0x4a, 0x5d, 0xb8, 0xc4, 0xcd, 0x7d, 0x5c, 0xb3, 0x38, 0x52, 0xc7, 0x37, 0xb9, 0x08,
+	0xd9, 0x99, 0xae, 0xaf, 0xd2, 0x33, 0x0f, 0x64, 0x0b, 0x57, 0x8d, 0x48, 0xff, 0x63, 0xb7, 0x7b,
+	0xf9, 0x53, 0xf7, 0xe0, 0x7c, 0xea, 0xa2, 0x8b, 0xa9, 0x8b, 0x7e, 0x4e, 0x5d, 0xf4, 0x61, 0xe6,
+	0x96, 0x2e, 0x66, 0x6e, 0xe9, 0xfb, 0xcc, 0x2d, 0x1d, 0xb7, 0x23, 0xae, 0xe2, 0xd3, 0x81, 0x37,
+	0x94, 0x49, 0x7e, 0xa5, 0xe4, 0x5f, 0x6d, 0x08, 0x4f, 0xfc, 0xb3, 0xe5, 0xa5, 0xa4, 0xde, 0xa7,
+	0x0c, 0x06, 0x55, 0x7d, 0xb9, 0x3c, 0xfb, 0x33, 0x00, 0xef, 0x1b, 0x01, 0x09, 0x0a, 0x05, 0x00,
+	0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -320,6 +406,20 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.ValidatorPerformances) > 0 {
+		for iNdEx := len(m.ValidatorPerformances) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ValidatorPerformances[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
 	if len(m.MissedBlocks) > 0 {
 		for iNdEx := len(m.MissedBlocks) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -361,6 +461,51 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *ValidatorEpochPerformance) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ValidatorEpochPerformance) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ValidatorEpochPerformance) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Performance.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if m.EpochStartHeight != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.EpochStartHeight))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *SigningInfo) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -514,6 +659,30 @@ func (m *GenesisState) Size() (n int) {
 			n += 1 + l + sovGenesis(uint64(l))
 		}
 	}
+	if len(m.ValidatorPerformances) > 0 {
+		for _, e := range m.ValidatorPerformances {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *ValidatorEpochPerformance) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	if m.EpochStartHeight != 0 {
+		n += 1 + sovGenesis(uint64(m.EpochStartHeight))
+	}
+	l = m.Performance.Size()
+	n += 1 + l + sovGenesis(uint64(l))
 	return n
 }
 
@@ -702,6 +871,174 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorPerformances", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ValidatorPerformances = append(m.ValidatorPerformances, ValidatorEpochPerformance{})
+			if err := m.ValidatorPerformances[len(m.ValidatorPerformances)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ValidatorEpochPerformance) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ValidatorEpochPerformance: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ValidatorEpochPerformance: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EpochStartHeight", wireType)
+			}
+			m.EpochStartHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EpochStartHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Performance", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Performance.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
@@ -46,12 +46,18 @@ const (
 // - 0x02<consAddrLen (1 Byte)><consAddress_Bytes><chunk_index>: bitmap_chunk
 //
 // - 0x03<accAddrLen (1 Byte)><accAddr_Bytes>: cryptotypes.PubKey
+//
+// - 0x04<consAddrLen (1 Byte)><consAddress_Bytes><epochStartHeight_Bytes>: ValidatorPerformance
+//
+// - 0x05<consAddrLen (1 Byte)><consAddress_Bytes>: replacing consAddress_Bytes
 
 var (
 	ParamsKey                           = []byte{0x00} // Prefix for params key
 	ValidatorSigningInfoKeyPrefix       = []byte{0x01} // Prefix for signing info
 	ValidatorMissedBlockBitmapKeyPrefix = []byte{0x02} // Prefix for missed block bitmap
 	AddrPubkeyRelationKeyPrefix         = []byte{0x03} // Prefix for address-pubkey relation
+	ValidatorPerformanceKeyPrefix       = []byte{0x04} // Prefix for per-epoch validator performance
+	ReplacedConsAddrKeyPrefix           = []byte{0x05} // Prefix for consensus addresses replaced by a standby consensus key
 )
 
 // ValidatorSigningInfoKey - stored by *Consensus* address (not operator address)
@@ -68,6 +74,12 @@ func ValidatorSigningInfoAddress(key []byte) (v sdk.ConsAddress) {
 	return sdk.ConsAddress(addr)
 }
 
+// ReplacedConsAddrKey returns the key for a consensus address replaced by a
+// standby consensus key.
+func ReplacedConsAddrKey(v sdk.ConsAddress) []byte {
+	return append(ReplacedConsAddrKeyPrefix, address.MustLengthPrefix(v.Bytes())...)
+}
+
 // ValidatorMissedBlockBitmapPrefixKey returns the key prefix for a validator's
 // missed block bitmap.
 func ValidatorMissedBlockBitmapPrefixKey(v sdk.ConsAddress) []byte {
@@ -87,3 +99,32 @@ func ValidatorMissedBlockBitmapKey(v sdk.ConsAddress, chunkIndex int64) []byte {
 func AddrPubkeyRelationKey(addr []byte) []byte {
 	return append(AddrPubkeyRelationKeyPrefix, address.MustLengthPrefix(addr)...)
 }
+
+// ValidatorPerformancePrefixKey returns the key prefix for a validator's
+// per-epoch performance counters.
+func ValidatorPerformancePrefixKey(v sdk.ConsAddress) []byte {
+	return append(ValidatorPerformanceKeyPrefix, address.MustLengthPrefix(v.Bytes())...)
+}
+
+// ValidatorPerformanceKey returns the key for a validator's performance
+// counters of the epoch starting at the given height.
+func ValidatorPerformanceKey(v sdk.ConsAddress, epochStartHeight int64) []byte {
+	bz := make([]byte, 8)
+	binary.BigEndian.PutUint64(bz, uint64(epochStartHeight))
+
+	return append(ValidatorPerformancePrefixKey(v), bz...)
+}
+
+// ParseValidatorPerformanceKey returns the consensus address and epoch start
+// height of a validator performance key.
+func ParseValidatorPerformanceKey(key []byte) (sdk.ConsAddress, int64) {
+	// Remove prefix, then read the length-prefixed address.
+	kv.AssertKeyAtLeastLength(key, 2)
+	addrLen := int(key[1])
+	kv.AssertKeyLength(key, 2+addrLen+8)
+
+	addr := sdk.ConsAddress(key[2 : 2+addrLen])
+	height := int64(binary.BigEndian.Uint64(key[2+addrLen:]))
+
+	return addr, height
+}
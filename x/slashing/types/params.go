@@ -11,6 +11,15 @@ import (
 const (
 	DefaultSignedBlocksWindow   = int64(100)
 	DefaultDowntimeJailDuration = 60 * 10 * time.Second
+
+	// DefaultPerformanceEpochLength aggregates the validator performance
+	// counters every 1000 blocks.
+	DefaultPerformanceEpochLength = int64(1000)
+
+	// DefaultStandbyKeyActivationBlocks activates the standby consensus key of
+	// a validator after 10 consecutive missed blocks, well before the default
+	// downtime jailing after 50 missed blocks.
+	DefaultStandbyKeyActivationBlocks = int64(10)
 )
 
 var (
@@ -22,14 +31,16 @@ var (
 // NewParams creates a new Params object
 func NewParams(
 	signedBlocksWindow int64, minSignedPerWindow math.LegacyDec, downtimeJailDuration time.Duration,
-	slashFractionDoubleSign, slashFractionDowntime math.LegacyDec,
+	slashFractionDoubleSign, slashFractionDowntime math.LegacyDec, performanceEpochLength, standbyKeyActivationBlocks int64,
 ) Params {
 	return Params{
-		SignedBlocksWindow:      signedBlocksWindow,
-		MinSignedPerWindow:      minSignedPerWindow,
-		DowntimeJailDuration:    downtimeJailDuration,
-		SlashFractionDoubleSign: slashFractionDoubleSign,
-		SlashFractionDowntime:   slashFractionDowntime,
+		SignedBlocksWindow:         signedBlocksWindow,
+		MinSignedPerWindow:         minSignedPerWindow,
+		DowntimeJailDuration:       downtimeJailDuration,
+		SlashFractionDoubleSign:    slashFractionDoubleSign,
+		SlashFractionDowntime:      slashFractionDowntime,
+		PerformanceEpochLength:     performanceEpochLength,
+		StandbyKeyActivationBlocks: standbyKeyActivationBlocks,
 	}
 }
 
@@ -41,6 +52,8 @@ func DefaultParams() Params {
 		DefaultDowntimeJailDuration,
 		DefaultSlashFractionDoubleSign,
 		DefaultSlashFractionDowntime,
+		DefaultPerformanceEpochLength,
+		DefaultStandbyKeyActivationBlocks,
 	)
 }
 
@@ -61,6 +74,12 @@ func (p Params) Validate() error {
 	if err := validateSlashFractionDowntime(p.SlashFractionDowntime); err != nil {
 		return err
 	}
+	if err := validatePerformanceEpochLength(p.PerformanceEpochLength); err != nil {
+		return err
+	}
+	if err := validateStandbyKeyActivationBlocks(p.StandbyKeyActivationBlocks); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -146,3 +165,29 @@ func validateSlashFractionDowntime(i interface{}) error {
 
 	return nil
 }
+
+func validatePerformanceEpochLength(i interface{}) error {
+	v, ok := i.(int64)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if v < 0 {
+		return fmt.Errorf("performance epoch length cannot be negative: %d", v)
+	}
+
+	return nil
+}
+
+func validateStandbyKeyActivationBlocks(i interface{}) error {
+	v, ok := i.(int64)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if v < 0 {
+		return fmt.Errorf("standby key activation blocks cannot be negative: %d", v)
+	}
+
+	return nil
+}
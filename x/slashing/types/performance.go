@@ -0,0 +1,35 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// NewValidatorEpochPerformance creates a new ValidatorEpochPerformance instance
+func NewValidatorEpochPerformance(consAddr sdk.ConsAddress, epochStartHeight int64, performance ValidatorPerformance) ValidatorEpochPerformance {
+	return ValidatorEpochPerformance{
+		Address:          consAddr.String(),
+		EpochStartHeight: epochStartHeight,
+		Performance:      performance,
+	}
+}
+
+// Add returns the sum of the counters of two performance records.
+func (p ValidatorPerformance) Add(other ValidatorPerformance) ValidatorPerformance {
+	return ValidatorPerformance{
+		SignedBlocks:   p.SignedBlocks + other.SignedBlocks,
+		MissedBlocks:   p.MissedBlocks + other.MissedBlocks,
+		ProposedBlocks: p.ProposedBlocks + other.ProposedBlocks,
+		GovVotes:       p.GovVotes + other.GovVotes,
+		SlashEvents:    p.SlashEvents + other.SlashEvents,
+	}
+}
+
+// EpochStartHeight returns the first height of the performance epoch
+// containing the given height, for epochs of the given length.
+func EpochStartHeight(height, epochLength int64) int64 {
+	if epochLength <= 0 {
+		return height
+	}
+
+	return height - height%epochLength
+}
@@ -309,6 +309,340 @@ func (m *QuerySigningInfosResponse) GetPagination() *query.PageResponse {
 	return nil
 }
 
+// QueryValidatorPerformanceRequest is the request type for the
+// Query/ValidatorPerformance RPC method
+//
+// Since: cosmos-sdk 0.48
+type QueryValidatorPerformanceRequest struct {
+	// cons_address is the address to query the performance of
+	ConsAddress string `protobuf:"bytes,1,opt,name=cons_address,json=consAddress,proto3" json:"cons_address,omitempty"`
+	// start_height is the first height of the range
+	StartHeight int64 `protobuf:"varint,2,opt,name=start_height,json=startHeight,proto3" json:"start_height,omitempty"`
+	// end_height is the last height of the range
+	EndHeight int64 `protobuf:"varint,3,opt,name=end_height,json=endHeight,proto3" json:"end_height,omitempty"`
+}
+
+func (m *QueryValidatorPerformanceRequest) Reset()         { *m = QueryValidatorPerformanceRequest{} }
+func (m *QueryValidatorPerformanceRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryValidatorPerformanceRequest) ProtoMessage()    {}
+func (*QueryValidatorPerformanceRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_791b11d41a861ed0, []int{6}
+}
+func (m *QueryValidatorPerformanceRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryValidatorPerformanceRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryValidatorPerformanceRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryValidatorPerformanceRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryValidatorPerformanceRequest.Merge(m, src)
+}
+func (m *QueryValidatorPerformanceRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryValidatorPerformanceRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryValidatorPerformanceRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryValidatorPerformanceRequest proto.InternalMessageInfo
+
+func (m *QueryValidatorPerformanceRequest) GetConsAddress() string {
+	if m != nil {
+		return m.ConsAddress
+	}
+	return ""
+}
+
+func (m *QueryValidatorPerformanceRequest) GetStartHeight() int64 {
+	if m != nil {
+		return m.StartHeight
+	}
+	return 0
+}
+
+func (m *QueryValidatorPerformanceRequest) GetEndHeight() int64 {
+	if m != nil {
+		return m.EndHeight
+	}
+	return 0
+}
+
+// QueryValidatorPerformanceResponse is the response type for the
+// Query/ValidatorPerformance RPC method
+//
+// Since: cosmos-sdk 0.48
+type QueryValidatorPerformanceResponse struct {
+	// performance is the performance counters of the validator over the range
+	Performance ValidatorPerformance `protobuf:"bytes,1,opt,name=performance,proto3" json:"performance"`
+	// start_height is the first height of the range covered by the counters,
+	// i.e. the start of the epoch of the requested start height
+	StartHeight int64 `protobuf:"varint,2,opt,name=start_height,json=startHeight,proto3" json:"start_height,omitempty"`
+	// end_height is the last height of the range covered by the counters
+	EndHeight int64 `protobuf:"varint,3,opt,name=end_height,json=endHeight,proto3" json:"end_height,omitempty"`
+}
+
+func (m *QueryValidatorPerformanceResponse) Reset()         { *m = QueryValidatorPerformanceResponse{} }
+func (m *QueryValidatorPerformanceResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryValidatorPerformanceResponse) ProtoMessage()    {}
+func (*QueryValidatorPerformanceResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_791b11d41a861ed0, []int{7}
+}
+func (m *QueryValidatorPerformanceResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryValidatorPerformanceResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryValidatorPerformanceResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryValidatorPerformanceResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryValidatorPerformanceResponse.Merge(m, src)
+}
+func (m *QueryValidatorPerformanceResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryValidatorPerformanceResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryValidatorPerformanceResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryValidatorPerformanceResponse proto.InternalMessageInfo
+
+func (m *QueryValidatorPerformanceResponse) GetPerformance() ValidatorPerformance {
+	if m != nil {
+		return m.Performance
+	}
+	return ValidatorPerformance{}
+}
+
+func (m *QueryValidatorPerformanceResponse) GetStartHeight() int64 {
+	if m != nil {
+		return m.StartHeight
+	}
+	return 0
+}
+
+func (m *QueryValidatorPerformanceResponse) GetEndHeight() int64 {
+	if m != nil {
+		return m.EndHeight
+	}
+	return 0
+}
+
+// QueryValidatorsPerformanceRequest is the request type for the
+// Query/ValidatorsPerformance RPC method
+//
+// Since: cosmos-sdk 0.48
+type QueryValidatorsPerformanceRequest struct {
+	// start_height is the first height of the range
+	StartHeight int64 `protobuf:"varint,1,opt,name=start_height,json=startHeight,proto3" json:"start_height,omitempty"`
+	// end_height is the last height of the range
+	EndHeight  int64              `protobuf:"varint,2,opt,name=end_height,json=endHeight,proto3" json:"end_height,omitempty"`
+	Pagination *query.PageRequest `protobuf:"bytes,3,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryValidatorsPerformanceRequest) Reset()         { *m = QueryValidatorsPerformanceRequest{} }
+func (m *QueryValidatorsPerformanceRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryValidatorsPerformanceRequest) ProtoMessage()    {}
+func (*QueryValidatorsPerformanceRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_791b11d41a861ed0, []int{8}
+}
+func (m *QueryValidatorsPerformanceRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryValidatorsPerformanceRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryValidatorsPerformanceRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryValidatorsPerformanceRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryValidatorsPerformanceRequest.Merge(m, src)
+}
+func (m *QueryValidatorsPerformanceRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryValidatorsPerformanceRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryValidatorsPerformanceRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryValidatorsPerformanceRequest proto.InternalMessageInfo
+
+func (m *QueryValidatorsPerformanceRequest) GetStartHeight() int64 {
+	if m != nil {
+		return m.StartHeight
+	}
+	return 0
+}
+
+func (m *QueryValidatorsPerformanceRequest) GetEndHeight() int64 {
+	if m != nil {
+		return m.EndHeight
+	}
+	return 0
+}
+
+func (m *QueryValidatorsPerformanceRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+// QueryValidatorsPerformanceResponse is the response type for the
+// Query/ValidatorsPerformance RPC method
+//
+// Since: cosmos-sdk 0.48
+type QueryValidatorsPerformanceResponse struct {
+	// performances is the performance counters of the validators over the range
+	Performances []ValidatorRangePerformance `protobuf:"bytes,1,rep,name=performances,proto3" json:"performances"`
+	// start_height is the first height of the range covered by the counters
+	StartHeight int64 `protobuf:"varint,2,opt,name=start_height,json=startHeight,proto3" json:"start_height,omitempty"`
+	// end_height is the last height of the range covered by the counters
+	EndHeight  int64               `protobuf:"varint,3,opt,name=end_height,json=endHeight,proto3" json:"end_height,omitempty"`
+	Pagination *query.PageResponse `protobuf:"bytes,4,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryValidatorsPerformanceResponse) Reset()         { *m = QueryValidatorsPerformanceResponse{} }
+func (m *QueryValidatorsPerformanceResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryValidatorsPerformanceResponse) ProtoMessage()    {}
+func (*QueryValidatorsPerformanceResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_791b11d41a861ed0, []int{9}
+}
+func (m *QueryValidatorsPerformanceResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryValidatorsPerformanceResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryValidatorsPerformanceResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryValidatorsPerformanceResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryValidatorsPerformanceResponse.Merge(m, src)
+}
+func (m *QueryValidatorsPerformanceResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryValidatorsPerformanceResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryValidatorsPerformanceResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryValidatorsPerformanceResponse proto.InternalMessageInfo
+
+func (m *QueryValidatorsPerformanceResponse) GetPerformances() []ValidatorRangePerformance {
+	if m != nil {
+		return m.Performances
+	}
+	return nil
+}
+
+func (m *QueryValidatorsPerformanceResponse) GetStartHeight() int64 {
+	if m != nil {
+		return m.StartHeight
+	}
+	return 0
+}
+
+func (m *QueryValidatorsPerformanceResponse) GetEndHeight() int64 {
+	if m != nil {
+		return m.EndHeight
+	}
+	return 0
+}
+
+func (m *QueryValidatorsPerformanceResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+// ValidatorRangePerformance contains the performance counters of a validator
+// over a height range.
+//
+// Since: cosmos-sdk 0.48
+type ValidatorRangePerformance struct {
+	// address is the validator consensus address
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	// performance is the performance counters of the validator over the range
+	Performance ValidatorPerformance `protobuf:"bytes,2,opt,name=performance,proto3" json:"performance"`
+}
+
+func (m *ValidatorRangePerformance) Reset()         { *m = ValidatorRangePerformance{} }
+func (m *ValidatorRangePerformance) String() string { return proto.CompactTextString(m) }
+func (*ValidatorRangePerformance) ProtoMessage()    {}
+func (*ValidatorRangePerformance) Descriptor() ([]byte, []int) {
+	return fileDescriptor_791b11d41a861ed0, []int{10}
+}
+func (m *ValidatorRangePerformance) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ValidatorRangePerformance) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ValidatorRangePerformance.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ValidatorRangePerformance) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ValidatorRangePerformance.Merge(m, src)
+}
+func (m *ValidatorRangePerformance) XXX_Size() int {
+	return m.Size()
+}
+func (m *ValidatorRangePerformance) XXX_DiscardUnknown() {
+	xxx_messageInfo_ValidatorRangePerformance.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ValidatorRangePerformance proto.InternalMessageInfo
+
+func (m *ValidatorRangePerformance) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+func (m *ValidatorRangePerformance) GetPerformance() ValidatorPerformance {
+	if m != nil {
+		return m.Performance
+	}
+	return ValidatorPerformance{}
+}
+
 func init() {
 	proto.RegisterType((*QueryParamsRequest)(nil), "cosmos.slashing.v1beta1.QueryParamsRequest")
 	proto.RegisterType((*QueryParamsResponse)(nil), "cosmos.slashing.v1beta1.QueryParamsResponse")
@@ -316,6 +650,11 @@ func init() {
 	proto.RegisterType((*QuerySigningInfoResponse)(nil), "cosmos.slashing.v1beta1.QuerySigningInfoResponse")
 	proto.RegisterType((*QuerySigningInfosRequest)(nil), "cosmos.slashing.v1beta1.QuerySigningInfosRequest")
 	proto.RegisterType((*QuerySigningInfosResponse)(nil), "cosmos.slashing.v1beta1.QuerySigningInfosResponse")
+	proto.RegisterType((*QueryValidatorPerformanceRequest)(nil), "cosmos.slashing.v1beta1.QueryValidatorPerformanceRequest")
+	proto.RegisterType((*QueryValidatorPerformanceResponse)(nil), "cosmos.slashing.v1beta1.QueryValidatorPerformanceResponse")
+	proto.RegisterType((*QueryValidatorsPerformanceRequest)(nil), "cosmos.slashing.v1beta1.QueryValidatorsPerformanceRequest")
+	proto.RegisterType((*QueryValidatorsPerformanceResponse)(nil), "cosmos.slashing.v1beta1.QueryValidatorsPerformanceResponse")
+	proto.RegisterType((*ValidatorRangePerformance)(nil), "cosmos.slashing.v1beta1.ValidatorRangePerformance")
 }
 
 func init() {
@@ -323,43 +662,58 @@ func init() {
 }
 
 var fileDescriptor_791b11d41a861ed0 = []byte{
-	// 566 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x94, 0x41, 0x6b, 0x13, 0x41,
-	0x14, 0xc7, 0x33, 0xad, 0x16, 0x3a, 0x29, 0xa2, 0x63, 0xa0, 0x69, 0x90, 0x8d, 0xae, 0x90, 0x96,
-	0x6a, 0x76, 0x4c, 0x44, 0x3c, 0x88, 0x07, 0x73, 0xb0, 0x08, 0x1e, 0x34, 0x85, 0x82, 0x5e, 0xc2,
-	0x6c, 0x32, 0x9d, 0x0e, 0x6e, 0x66, 0xb6, 0x3b, 0x9b, 0x60, 0x11, 0x3d, 0x78, 0xf6, 0x20, 0xf8,
-	0x19, 0x04, 0x8f, 0x2a, 0x7e, 0x88, 0x1e, 0x8b, 0x5e, 0x3c, 0x89, 0x24, 0x82, 0x77, 0x3f, 0x81,
-	0x64, 0x66, 0x92, 0x4c, 0x89, 0xab, 0x29, 0x5e, 0x92, 0xe5, 0xbd, 0xf7, 0x7f, 0xff, 0xdf, 0x7b,
-	0x79, 0x1b, 0x78, 0xb9, 0x2d, 0x55, 0x57, 0x2a, 0xac, 0x22, 0xa2, 0xf6, 0xb8, 0x60, 0xb8, 0x5f,
-	0x0b, 0x69, 0x4a, 0x6a, 0x78, 0xbf, 0x47, 0x93, 0x83, 0x20, 0x4e, 0x64, 0x2a, 0xd1, 0xaa, 0x29,
-	0x0a, 0xc6, 0x45, 0x81, 0x2d, 0x2a, 0x6d, 0x5a, 0x75, 0x48, 0x14, 0x35, 0x8a, 0x89, 0x3e, 0x26,
-	0x8c, 0x0b, 0x92, 0x72, 0x29, 0x4c, 0x93, 0x52, 0x81, 0x49, 0x26, 0xf5, 0x23, 0x1e, 0x3d, 0xd9,
-	0xe8, 0x05, 0x26, 0x25, 0x8b, 0x28, 0x26, 0x31, 0xc7, 0x44, 0x08, 0x99, 0x6a, 0x89, 0xb2, 0xd9,
-	0x4a, 0x16, 0xdd, 0x84, 0xc4, 0xd4, 0xad, 0x99, 0xba, 0x96, 0x69, 0x6f, 0x69, 0x4d, 0xea, 0x1c,
-	0xe9, 0x72, 0x21, 0xb1, 0xfe, 0x34, 0x21, 0xbf, 0x00, 0xd1, 0xc3, 0x11, 0xeb, 0x03, 0x92, 0x90,
-	0xae, 0x6a, 0xd2, 0xfd, 0x1e, 0x55, 0xa9, 0xff, 0x08, 0x9e, 0x3f, 0x16, 0x55, 0xb1, 0x14, 0x8a,
-	0xa2, 0x06, 0x5c, 0x8a, 0x75, 0xa4, 0x08, 0x2e, 0x82, 0x8d, 0x7c, 0xbd, 0x1c, 0x64, 0x2c, 0x23,
-	0x30, 0xc2, 0xc6, 0xf2, 0xe1, 0xb7, 0x72, 0xee, 0xdd, 0xcf, 0xf7, 0x9b, 0xa0, 0x69, 0x95, 0xfe,
-	0x0e, 0x5c, 0xd5, 0xad, 0xb7, 0x39, 0x13, 0x5c, 0xb0, 0x7b, 0x62, 0x57, 0x5a, 0x57, 0x74, 0x0b,
-	0xae, 0xb4, 0xa5, 0x50, 0x2d, 0xd2, 0xe9, 0x24, 0x54, 0x19, 0x93, 0xe5, 0x46, 0xf1, 0xf3, 0xa7,
-	0x6a, 0xc1, 0xfa, 0xdc, 0x31, 0x99, 0xed, 0x34, 0xe1, 0x82, 0x35, 0xf3, 0xa3, 0x6a, 0x1b, 0xf2,
-	0x5f, 0xc0, 0xe2, 0x6c, 0x5f, 0xcb, 0x1d, 0xc2, 0xb3, 0x7d, 0x12, 0xb5, 0x94, 0x49, 0xb5, 0xb8,
-	0xd8, 0x95, 0x76, 0x82, 0x6a, 0xe6, 0x04, 0x3b, 0x24, 0xe2, 0x1d, 0x92, 0xca, 0xc4, 0x69, 0xe8,
-	0xce, 0x73, 0xa6, 0x4f, 0x22, 0x27, 0xe5, 0x87, 0xb3, 0xfe, 0xe3, 0x75, 0xa2, 0xbb, 0x10, 0x4e,
-	0x4f, 0xc0, 0x3a, 0x57, 0xc6, 0xce, 0xa3, 0x7b, 0x09, 0xcc, 0x85, 0x4d, 0xb7, 0xc7, 0xa8, 0xd5,
-	0x36, 0x1d, 0xa5, 0xff, 0x11, 0xc0, 0xb5, 0x3f, 0x98, 0xd8, 0x29, 0xef, 0xc3, 0x53, 0x76, 0xb2,
-	0xc5, 0xff, 0x9a, 0x4c, 0x77, 0x41, 0x5b, 0xc7, 0x98, 0x17, 0x34, 0xf3, 0xfa, 0x3f, 0x99, 0x0d,
-	0x8a, 0x0b, 0x5d, 0xff, 0xb5, 0x08, 0x4f, 0x6b, 0x68, 0xf4, 0x0a, 0xc0, 0x25, 0x73, 0x18, 0xe8,
-	0x4a, 0x26, 0xdd, 0xec, 0x35, 0x96, 0xae, 0xce, 0x57, 0x6c, 0xbc, 0xfd, 0xf5, 0x97, 0x5f, 0x7e,
-	0xbc, 0x59, 0xb8, 0x84, 0xca, 0x38, 0xeb, 0x85, 0x31, 0x97, 0x88, 0x3e, 0x00, 0x98, 0x77, 0x56,
-	0x80, 0xae, 0xfd, 0xdd, 0x66, 0xf6, 0x60, 0x4b, 0xb5, 0x13, 0x28, 0x2c, 0xdd, 0x6d, 0x4d, 0x77,
-	0x13, 0xdd, 0xc8, 0xa4, 0x73, 0xaf, 0x54, 0xe1, 0x67, 0xee, 0x1b, 0xf1, 0x1c, 0xbd, 0x05, 0x70,
-	0xc5, 0xfd, 0xf1, 0xd1, 0xfc, 0x08, 0x93, 0x75, 0xd6, 0x4f, 0x22, 0xb1, 0xd8, 0x81, 0xc6, 0xde,
-	0x40, 0x95, 0xf9, 0xb0, 0x1b, 0x5b, 0x87, 0x03, 0x0f, 0x1c, 0x0d, 0x3c, 0xf0, 0x7d, 0xe0, 0x81,
-	0xd7, 0x43, 0x2f, 0x77, 0x34, 0xf4, 0x72, 0x5f, 0x87, 0x5e, 0xee, 0x71, 0x95, 0xf1, 0x74, 0xaf,
-	0x17, 0x06, 0x6d, 0xd9, 0x1d, 0xf7, 0x32, 0x5f, 0x55, 0xd5, 0x79, 0x82, 0x9f, 0x4e, 0x1b, 0xa7,
-	0x07, 0x31, 0x55, 0xe1, 0x92, 0xfe, 0x9b, 0xba, 0xfe, 0x3b, 0x00, 0x00, 0xff, 0xff, 0x5f, 0xae,
-	0x17, 0x98, 0x9c, 0x05, 0x00, 0x00,
+	// 808 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x56, 0xcf, 0x6b, 0x13, 0x4d,
+	0x18, 0xce, 0x24, 0xfd, 0x5a, 0x3a, 0x09, 0x1f, 0xdf, 0x37, 0x5f, 0x3e, 0xda, 0x06, 0x9b, 0x36,
+	0xab, 0xa4, 0xa5, 0x36, 0xbb, 0x36, 0xa5, 0x88, 0x06, 0x0f, 0x46, 0xb0, 0x0a, 0x1e, 0x6a, 0x0a,
+	0x05, 0x7b, 0x09, 0x93, 0x64, 0xba, 0x59, 0x4c, 0x66, 0xb6, 0x3b, 0x9b, 0x62, 0x11, 0x45, 0x3c,
+	0x7b, 0x10, 0xfd, 0x0b, 0x3c, 0x08, 0x5e, 0x04, 0x95, 0x9e, 0x05, 0x6f, 0xc5, 0x53, 0xa9, 0x17,
+	0x4f, 0x22, 0xad, 0xe0, 0xbf, 0x21, 0x99, 0x99, 0x24, 0x9b, 0x1f, 0x9b, 0xa6, 0x69, 0x2f, 0xc9,
+	0xf2, 0xce, 0xfb, 0x3e, 0xcf, 0xf3, 0x3e, 0xfb, 0xce, 0xcc, 0xc2, 0x8b, 0x45, 0xc6, 0xab, 0x8c,
+	0x1b, 0xbc, 0x82, 0x79, 0xd9, 0xa2, 0xa6, 0xb1, 0xb3, 0x54, 0x20, 0x2e, 0x5e, 0x32, 0xb6, 0x6b,
+	0xc4, 0xd9, 0xd5, 0x6d, 0x87, 0xb9, 0x0c, 0x4d, 0xc8, 0x24, 0xbd, 0x91, 0xa4, 0xab, 0xa4, 0xd8,
+	0x82, 0xaa, 0x2e, 0x60, 0x4e, 0x64, 0x45, 0xb3, 0xde, 0xc6, 0xa6, 0x45, 0xb1, 0x6b, 0x31, 0x2a,
+	0x41, 0x62, 0x51, 0x93, 0x99, 0x4c, 0x3c, 0x1a, 0xf5, 0x27, 0x15, 0xbd, 0x60, 0x32, 0x66, 0x56,
+	0x88, 0x81, 0x6d, 0xcb, 0xc0, 0x94, 0x32, 0x57, 0x94, 0x70, 0xb5, 0x9a, 0xf4, 0x53, 0xd7, 0x54,
+	0x22, 0xf3, 0xa6, 0x64, 0x5e, 0x5e, 0xc2, 0x2b, 0xb5, 0x72, 0xe9, 0x5f, 0x5c, 0xb5, 0x28, 0x33,
+	0xc4, 0xaf, 0x0c, 0x69, 0x51, 0x88, 0xee, 0xd7, 0xb5, 0xae, 0x61, 0x07, 0x57, 0x79, 0x8e, 0x6c,
+	0xd7, 0x08, 0x77, 0xb5, 0x07, 0xf0, 0xbf, 0xb6, 0x28, 0xb7, 0x19, 0xe5, 0x04, 0x65, 0xe1, 0xa8,
+	0x2d, 0x22, 0x93, 0x60, 0x16, 0xcc, 0x87, 0xd3, 0x33, 0xba, 0x8f, 0x19, 0xba, 0x2c, 0xcc, 0x8e,
+	0xef, 0xff, 0x98, 0x09, 0xbc, 0xfb, 0xfd, 0x61, 0x01, 0xe4, 0x54, 0xa5, 0xb6, 0x01, 0x27, 0x04,
+	0xf4, 0xba, 0x65, 0x52, 0x8b, 0x9a, 0x77, 0xe9, 0x16, 0x53, 0xac, 0x28, 0x03, 0x23, 0x45, 0x46,
+	0x79, 0x1e, 0x97, 0x4a, 0x0e, 0xe1, 0x92, 0x64, 0x3c, 0x3b, 0x79, 0xb8, 0x97, 0x8a, 0x2a, 0x9e,
+	0x9b, 0x72, 0x65, 0xdd, 0x75, 0x2c, 0x6a, 0xe6, 0xc2, 0xf5, 0x6c, 0x15, 0xd2, 0x9e, 0xc2, 0xc9,
+	0x6e, 0x5c, 0xa5, 0xbb, 0x00, 0xff, 0xd9, 0xc1, 0x95, 0x3c, 0x97, 0x4b, 0x79, 0x8b, 0x6e, 0x31,
+	0xd5, 0x41, 0xca, 0xb7, 0x83, 0x0d, 0x5c, 0xb1, 0x4a, 0xd8, 0x65, 0x8e, 0x07, 0xd0, 0xdb, 0xcf,
+	0xdf, 0x3b, 0xb8, 0xe2, 0x59, 0xd2, 0x0a, 0xdd, 0xfc, 0x0d, 0x3b, 0xd1, 0x6d, 0x08, 0x5b, 0x23,
+	0xa0, 0x98, 0x93, 0x0d, 0xe6, 0xfa, 0xbc, 0xe8, 0x72, 0xc2, 0x5a, 0xee, 0x99, 0x44, 0xd5, 0xe6,
+	0x3c, 0x95, 0xda, 0x27, 0x00, 0xa7, 0x7a, 0x90, 0xa8, 0x2e, 0xef, 0xc1, 0x11, 0xd5, 0x59, 0xe8,
+	0x4c, 0x9d, 0x09, 0x14, 0xb4, 0xda, 0xa6, 0x39, 0x28, 0x34, 0xcf, 0x9d, 0xa8, 0x59, 0x4a, 0x69,
+	0x13, 0xfd, 0x06, 0xc0, 0x59, 0x21, 0xba, 0xc9, 0xbb, 0x46, 0x9c, 0x2d, 0xe6, 0x54, 0x31, 0x2d,
+	0x92, 0xf3, 0x78, 0xf5, 0x28, 0x01, 0x23, 0xdc, 0xc5, 0x8e, 0x9b, 0x2f, 0x13, 0xcb, 0x2c, 0xbb,
+	0x42, 0x6c, 0x28, 0x17, 0x16, 0xb1, 0x3b, 0x22, 0x84, 0xa6, 0x21, 0x24, 0xb4, 0xd4, 0x48, 0x08,
+	0x89, 0x84, 0x71, 0x42, 0x4b, 0x72, 0x59, 0xfb, 0x02, 0x60, 0xa2, 0x8f, 0x46, 0x65, 0xf0, 0x26,
+	0x0c, 0xdb, 0xad, 0xf0, 0xe0, 0x13, 0xe4, 0xc1, 0xf2, 0xfa, 0xec, 0x05, 0x3b, 0x87, 0x1e, 0xde,
+	0x77, 0xf5, 0xc0, 0x7b, 0x18, 0xdd, 0xc9, 0x03, 0x4e, 0xe2, 0x09, 0x76, 0xf0, 0x74, 0x0c, 0x73,
+	0x68, 0xe8, 0x61, 0x7e, 0x15, 0x84, 0x5a, 0x3f, 0xbd, 0xca, 0x74, 0x0c, 0x23, 0x1e, 0x9f, 0xb8,
+	0x9a, 0xee, 0xf4, 0xc9, 0xae, 0xe7, 0x30, 0x35, 0x89, 0x8f, 0xf5, 0x6d, 0x90, 0x67, 0xf7, 0xbe,
+	0x63, 0xb3, 0x8c, 0x0c, 0xbf, 0x59, 0xf6, 0x00, 0x9c, 0xf2, 0xed, 0x00, 0x65, 0xe0, 0x58, 0xfb,
+	0x06, 0x49, 0x1c, 0xee, 0xa5, 0xa6, 0x15, 0xcd, 0xad, 0x3a, 0x18, 0xe5, 0x35, 0xde, 0xbe, 0x53,
+	0x1a, 0x15, 0x9d, 0xd3, 0x1b, 0x3c, 0xc7, 0xe9, 0x4d, 0x3f, 0x1b, 0x83, 0x7f, 0x89, 0x77, 0x89,
+	0x5e, 0x00, 0x38, 0x2a, 0x0f, 0x7f, 0x74, 0xd9, 0x17, 0xbb, 0xfb, 0xc6, 0x89, 0x2d, 0x0e, 0x96,
+	0x2c, 0x2d, 0xd3, 0xe6, 0x9e, 0x7f, 0xfb, 0xf5, 0x3a, 0x98, 0x40, 0x33, 0x86, 0xdf, 0xa5, 0x28,
+	0x6f, 0x1b, 0xf4, 0x11, 0xc0, 0xb0, 0xe7, 0x98, 0x43, 0x57, 0xfa, 0xd3, 0x74, 0x5f, 0x4a, 0xb1,
+	0xa5, 0x53, 0x54, 0x28, 0x75, 0x37, 0x84, 0xba, 0xab, 0x68, 0xc5, 0x57, 0x9d, 0xf7, 0x26, 0xe2,
+	0xc6, 0x63, 0xef, 0xd1, 0xf7, 0x04, 0xbd, 0x05, 0x30, 0xe2, 0x81, 0xe5, 0x68, 0x70, 0x09, 0x4d,
+	0x3b, 0xd3, 0xa7, 0x29, 0x51, 0xb2, 0x75, 0x21, 0x7b, 0x1e, 0x25, 0x07, 0x93, 0x8d, 0xbe, 0x02,
+	0x18, 0xed, 0x35, 0x25, 0xe8, 0x5a, 0x7f, 0xf2, 0x3e, 0xf7, 0x40, 0xec, 0xfa, 0x30, 0xa5, 0x4a,
+	0x7f, 0x46, 0xe8, 0x5f, 0x41, 0xcb, 0xfe, 0x43, 0xd1, 0xaa, 0xea, 0x34, 0xfd, 0x33, 0x80, 0xff,
+	0xf7, 0x3c, 0x88, 0xd0, 0xa0, 0x92, 0x7a, 0x9c, 0xb6, 0xb1, 0xcc, 0x50, 0xb5, 0xaa, 0x9f, 0x45,
+	0xd1, 0x4f, 0x12, 0x5d, 0x1a, 0xa4, 0x9f, 0xec, 0xea, 0xfe, 0x51, 0x1c, 0x1c, 0x1c, 0xc5, 0xc1,
+	0xcf, 0xa3, 0x38, 0x78, 0x79, 0x1c, 0x0f, 0x1c, 0x1c, 0xc7, 0x03, 0xdf, 0x8f, 0xe3, 0x81, 0xcd,
+	0x94, 0x69, 0xb9, 0xe5, 0x5a, 0x41, 0x2f, 0xb2, 0x6a, 0x03, 0x49, 0xfe, 0xa5, 0x78, 0xe9, 0xa1,
+	0xf1, 0xa8, 0x05, 0xeb, 0xee, 0xda, 0x84, 0x17, 0x46, 0xc5, 0x87, 0xe1, 0xf2, 0x9f, 0x01, 0x00,
+	0x92, 0xe5, 0xce, 0x3f, 0x0e, 0x0b, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -380,6 +734,16 @@ type QueryClient interface {
 	SigningInfo(ctx context.Context, in *QuerySigningInfoRequest, opts ...grpc.CallOption) (*QuerySigningInfoResponse, error)
 	// SigningInfos queries signing info of all validators
 	SigningInfos(ctx context.Context, in *QuerySigningInfosRequest, opts ...grpc.CallOption) (*QuerySigningInfosResponse, error)
+	// ValidatorPerformance queries the performance counters of a validator
+	// aggregated over a height range.
+	//
+	// Since: cosmos-sdk 0.48
+	ValidatorPerformance(ctx context.Context, in *QueryValidatorPerformanceRequest, opts ...grpc.CallOption) (*QueryValidatorPerformanceResponse, error)
+	// ValidatorsPerformance queries the performance counters of all validators
+	// aggregated over a height range.
+	//
+	// Since: cosmos-sdk 0.48
+	ValidatorsPerformance(ctx context.Context, in *QueryValidatorsPerformanceRequest, opts ...grpc.CallOption) (*QueryValidatorsPerformanceResponse, error)
 }
 
 type queryClient struct {
@@ -417,6 +781,24 @@ func (c *queryClient) SigningInfos(ctx context.Context, in *QuerySigningInfosReq
 	return out, nil
 }
 
+func (c *queryClient) ValidatorPerformance(ctx context.Context, in *QueryValidatorPerformanceRequest, opts ...grpc.CallOption) (*QueryValidatorPerformanceResponse, error) {
+	out := new(QueryValidatorPerformanceResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.slashing.v1beta1.Query/ValidatorPerformance", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) ValidatorsPerformance(ctx context.Context, in *QueryValidatorsPerformanceRequest, opts ...grpc.CallOption) (*QueryValidatorsPerformanceResponse, error) {
+	out := new(QueryValidatorsPerformanceResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.slashing.v1beta1.Query/ValidatorsPerformance", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 type QueryServer interface {
 	// Params queries the parameters of slashing module
@@ -425,6 +807,16 @@ type QueryServer interface {
 	SigningInfo(context.Context, *QuerySigningInfoRequest) (*QuerySigningInfoResponse, error)
 	// SigningInfos queries signing info of all validators
 	SigningInfos(context.Context, *QuerySigningInfosRequest) (*QuerySigningInfosResponse, error)
+	// ValidatorPerformance queries the performance counters of a validator
+	// aggregated over a height range.
+	//
+	// Since: cosmos-sdk 0.48
+	ValidatorPerformance(context.Context, *QueryValidatorPerformanceRequest) (*QueryValidatorPerformanceResponse, error)
+	// ValidatorsPerformance queries the performance counters of all validators
+	// aggregated over a height range.
+	//
+	// Since: cosmos-sdk 0.48
+	ValidatorsPerformance(context.Context, *QueryValidatorsPerformanceRequest) (*QueryValidatorsPerformanceResponse, error)
 }
 
 // UnimplementedQueryServer can be embedded to have forward compatible implementations.
@@ -440,6 +832,12 @@ func (*UnimplementedQueryServer) SigningInfo(ctx context.Context, req *QuerySign
 func (*UnimplementedQueryServer) SigningInfos(ctx context.Context, req *QuerySigningInfosRequest) (*QuerySigningInfosResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method SigningInfos not implemented")
 }
+func (*UnimplementedQueryServer) ValidatorPerformance(ctx context.Context, req *QueryValidatorPerformanceRequest) (*QueryValidatorPerformanceResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ValidatorPerformance not implemented")
+}
+func (*UnimplementedQueryServer) ValidatorsPerformance(ctx context.Context, req *QueryValidatorsPerformanceRequest) (*QueryValidatorsPerformanceResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ValidatorsPerformance not implemented")
+}
 
 func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
 	s.RegisterService(&_Query_serviceDesc, srv)
@@ -499,6 +897,42 @@ func _Query_SigningInfos_Handler(srv interface{}, ctx context.Context, dec func(
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_ValidatorPerformance_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryValidatorPerformanceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ValidatorPerformance(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.slashing.v1beta1.Query/ValidatorPerformance",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ValidatorPerformance(ctx, req.(*QueryValidatorPerformanceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ValidatorsPerformance_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryValidatorsPerformanceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ValidatorsPerformance(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.slashing.v1beta1.Query/ValidatorsPerformance",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ValidatorsPerformance(ctx, req.(*QueryValidatorsPerformanceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Query_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "cosmos.slashing.v1beta1.Query",
 	HandlerType: (*QueryServer)(nil),
@@ -515,6 +949,14 @@ var _Query_serviceDesc = grpc.ServiceDesc{
 			MethodName: "SigningInfos",
 			Handler:    _Query_SigningInfos_Handler,
 		},
+		{
+			MethodName: "ValidatorPerformance",
+			Handler:    _Query_ValidatorPerformance_Handler,
+		},
+		{
+			MethodName: "ValidatorsPerformance",
+			Handler:    _Query_ValidatorsPerformance_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "cosmos/slashing/v1beta1/query.proto",
@@ -723,45 +1165,272 @@ func (m *QuerySigningInfosResponse) MarshalToSizedBuffer(dAtA []byte) (int, erro
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
-	offset -= sovQuery(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
-	}
-	dAtA[offset] = uint8(v)
-	return base
-}
-func (m *QueryParamsRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryValidatorPerformanceRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *QueryParamsResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = m.Params.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
+func (m *QueryValidatorPerformanceRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QuerySigningInfoRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *QueryValidatorPerformanceRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.ConsAddress)
-	if l > 0 {
+	if m.EndHeight != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.EndHeight))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.StartHeight != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.StartHeight))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.ConsAddress) > 0 {
+		i -= len(m.ConsAddress)
+		copy(dAtA[i:], m.ConsAddress)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ConsAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryValidatorPerformanceResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryValidatorPerformanceResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryValidatorPerformanceResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.EndHeight != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.EndHeight))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.StartHeight != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.StartHeight))
+		i--
+		dAtA[i] = 0x10
+	}
+	{
+		size, err := m.Performance.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryValidatorsPerformanceRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryValidatorsPerformanceRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryValidatorsPerformanceRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.EndHeight != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.EndHeight))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.StartHeight != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.StartHeight))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryValidatorsPerformanceResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryValidatorsPerformanceResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryValidatorsPerformanceResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.EndHeight != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.EndHeight))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.StartHeight != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.StartHeight))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Performances) > 0 {
+		for iNdEx := len(m.Performances) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Performances[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ValidatorRangePerformance) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ValidatorRangePerformance) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ValidatorRangePerformance) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Performance.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
+	offset -= sovQuery(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *QueryParamsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryParamsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Params.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QuerySigningInfoRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ConsAddress)
+	if l > 0 {
 		n += 1 + l + sovQuery(uint64(l))
 	}
 	return n
@@ -810,41 +1479,590 @@ func (m *QuerySigningInfosResponse) Size() (n int) {
 	return n
 }
 
-func sovQuery(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
+func (m *QueryValidatorPerformanceRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ConsAddress)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.StartHeight != 0 {
+		n += 1 + sovQuery(uint64(m.StartHeight))
+	}
+	if m.EndHeight != 0 {
+		n += 1 + sovQuery(uint64(m.EndHeight))
+	}
+	return n
 }
-func sozQuery(x uint64) (n int) {
-	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+
+func (m *QueryValidatorPerformanceResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Performance.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	if m.StartHeight != 0 {
+		n += 1 + sovQuery(uint64(m.StartHeight))
+	}
+	if m.EndHeight != 0 {
+		n += 1 + sovQuery(uint64(m.EndHeight))
+	}
+	return n
 }
-func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowQuery
+
+func (m *QueryValidatorsPerformanceRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.StartHeight != 0 {
+		n += 1 + sovQuery(uint64(m.StartHeight))
+	}
+	if m.EndHeight != 0 {
+		n += 1 + sovQuery(uint64(m.EndHeight))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryValidatorsPerformanceResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Performances) > 0 {
+		for _, e := range m.Performances {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.StartHeight != 0 {
+		n += 1 + sovQuery(uint64(m.StartHeight))
+	}
+	if m.EndHeight != 0 {
+		n += 1 + sovQuery(uint64(m.EndHeight))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *ValidatorRangePerformance) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = m.Performance.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func sovQuery(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozQuery(x uint64) (n int) {
+	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryParamsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryParamsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QuerySigningInfoRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QuerySigningInfoRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QuerySigningInfoRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConsAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ConsAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QuerySigningInfoResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QuerySigningInfoResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QuerySigningInfoResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValSigningInfo", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ValSigningInfo.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QuerySigningInfosRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QuerySigningInfosRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QuerySigningInfosRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QuerySigningInfosResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QuerySigningInfosResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QuerySigningInfosResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Info", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Info = append(m.Info, ValidatorSigningInfo{})
+			if err := m.Info[len(m.Info)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if iNdEx >= l {
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: QueryParamsRequest: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -866,7 +2084,7 @@ func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryValidatorPerformanceRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -889,17 +2107,17 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryParamsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryValidatorPerformanceRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryValidatorPerformanceRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ConsAddress", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -909,25 +2127,62 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.ConsAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartHeight", wireType)
+			}
+			m.StartHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.StartHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EndHeight", wireType)
+			}
+			m.EndHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EndHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -949,7 +2204,7 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QuerySigningInfoRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryValidatorPerformanceResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -972,17 +2227,17 @@ func (m *QuerySigningInfoRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QuerySigningInfoRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryValidatorPerformanceResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QuerySigningInfoRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryValidatorPerformanceResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ConsAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Performance", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -992,24 +2247,63 @@ func (m *QuerySigningInfoRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ConsAddress = string(dAtA[iNdEx:postIndex])
+			if err := m.Performance.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartHeight", wireType)
+			}
+			m.StartHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.StartHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EndHeight", wireType)
+			}
+			m.EndHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EndHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -1031,7 +2325,7 @@ func (m *QuerySigningInfoRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QuerySigningInfoResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryValidatorsPerformanceRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1054,15 +2348,53 @@ func (m *QuerySigningInfoResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QuerySigningInfoResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryValidatorsPerformanceRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QuerySigningInfoResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryValidatorsPerformanceRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartHeight", wireType)
+			}
+			m.StartHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.StartHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EndHeight", wireType)
+			}
+			m.EndHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EndHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ValSigningInfo", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1089,7 +2421,10 @@ func (m *QuerySigningInfoResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.ValSigningInfo.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -1114,7 +2449,7 @@ func (m *QuerySigningInfoResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QuerySigningInfosRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryValidatorsPerformanceResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1137,13 +2472,85 @@ func (m *QuerySigningInfosRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QuerySigningInfosRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryValidatorsPerformanceResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QuerySigningInfosRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryValidatorsPerformanceResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Performances", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Performances = append(m.Performances, ValidatorRangePerformance{})
+			if err := m.Performances[len(m.Performances)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartHeight", wireType)
+			}
+			m.StartHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.StartHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EndHeight", wireType)
+			}
+			m.EndHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EndHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
 			}
@@ -1173,7 +2580,7 @@ func (m *QuerySigningInfosRequest) Unmarshal(dAtA []byte) error {
 				return io.ErrUnexpectedEOF
 			}
 			if m.Pagination == nil {
-				m.Pagination = &query.PageRequest{}
+				m.Pagination = &query.PageResponse{}
 			}
 			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
@@ -1200,7 +2607,7 @@ func (m *QuerySigningInfosRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QuerySigningInfosResponse) Unmarshal(dAtA []byte) error {
+func (m *ValidatorRangePerformance) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1223,17 +2630,17 @@ func (m *QuerySigningInfosResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QuerySigningInfosResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: ValidatorRangePerformance: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QuerySigningInfosResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ValidatorRangePerformance: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Info", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -1243,29 +2650,27 @@ func (m *QuerySigningInfosResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Info = append(m.Info, ValidatorSigningInfo{})
-			if err := m.Info[len(m.Info)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Address = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Performance", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1292,10 +2697,7 @@ func (m *QuerySigningInfosResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Pagination == nil {
-				m.Pagination = &query.PageResponse{}
-			}
-			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Performance.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -50,6 +50,11 @@ type ValidatorSigningInfo struct {
 	// A counter of missed (unsigned) blocks. It is used to avoid unnecessary
 	// reads in the missed block bitmap.
 	MissedBlocksCounter int64 `protobuf:"varint,6,opt,name=missed_blocks_counter,json=missedBlocksCounter,proto3" json:"missed_blocks_counter,omitempty"`
+	// consecutive_missed_blocks is the number of blocks missed in a row up to
+	// the last block.
+	//
+	// Since: cosmos-sdk 0.48
+	ConsecutiveMissedBlocks int64 `protobuf:"varint,7,opt,name=consecutive_missed_blocks,json=consecutiveMissedBlocks,proto3" json:"consecutive_missed_blocks,omitempty"`
 }
 
 func (m *ValidatorSigningInfo) Reset()         { *m = ValidatorSigningInfo{} }
@@ -127,6 +132,13 @@ func (m *ValidatorSigningInfo) GetMissedBlocksCounter() int64 {
 	return 0
 }
 
+func (m *ValidatorSigningInfo) GetConsecutiveMissedBlocks() int64 {
+	if m != nil {
+		return m.ConsecutiveMissedBlocks
+	}
+	return 0
+}
+
 // Params represents the parameters used for by the slashing module.
 type Params struct {
 	SignedBlocksWindow      int64                                  `protobuf:"varint,1,opt,name=signed_blocks_window,json=signedBlocksWindow,proto3" json:"signed_blocks_window,omitempty"`
@@ -134,6 +146,19 @@ type Params struct {
 	DowntimeJailDuration    time.Duration                          `protobuf:"bytes,3,opt,name=downtime_jail_duration,json=downtimeJailDuration,proto3,stdduration" json:"downtime_jail_duration"`
 	SlashFractionDoubleSign github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,4,opt,name=slash_fraction_double_sign,json=slashFractionDoubleSign,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"slash_fraction_double_sign"`
 	SlashFractionDowntime   github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,5,opt,name=slash_fraction_downtime,json=slashFractionDowntime,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"slash_fraction_downtime"`
+	// performance_epoch_length is the number of blocks of the epochs for which
+	// the performance counters of the validators are aggregated. A zero value
+	// disables the performance tracking.
+	//
+	// Since: cosmos-sdk 0.48
+	PerformanceEpochLength int64 `protobuf:"varint,6,opt,name=performance_epoch_length,json=performanceEpochLength,proto3" json:"performance_epoch_length,omitempty"`
+	// standby_key_activation_blocks is the number of consecutive blocks a
+	// validator must miss for its standby consensus key, if any, to replace its
+	// consensus key. It should be lower than the number of blocks missed in a
+	// window leading to jailing. A zero value disables the standby keys.
+	//
+	// Since: cosmos-sdk 0.48
+	StandbyKeyActivationBlocks int64 `protobuf:"varint,7,opt,name=standby_key_activation_blocks,json=standbyKeyActivationBlocks,proto3" json:"standby_key_activation_blocks,omitempty"`
 }
 
 func (m *Params) Reset()         { *m = Params{} }
@@ -183,9 +208,111 @@ func (m *Params) GetDowntimeJailDuration() time.Duration {
 	return 0
 }
 
+func (m *Params) GetPerformanceEpochLength() int64 {
+	if m != nil {
+		return m.PerformanceEpochLength
+	}
+	return 0
+}
+
+func (m *Params) GetStandbyKeyActivationBlocks() int64 {
+	if m != nil {
+		return m.StandbyKeyActivationBlocks
+	}
+	return 0
+}
+
+// ValidatorPerformance defines the performance counters of a validator,
+// aggregated over an epoch or over a height range.
+//
+// Since: cosmos-sdk 0.48
+type ValidatorPerformance struct {
+	// signed_blocks is the number of blocks signed by the validator.
+	SignedBlocks uint64 `protobuf:"varint,1,opt,name=signed_blocks,json=signedBlocks,proto3" json:"signed_blocks,omitempty"`
+	// missed_blocks is the number of blocks the validator should have signed but
+	// did not.
+	MissedBlocks uint64 `protobuf:"varint,2,opt,name=missed_blocks,json=missedBlocks,proto3" json:"missed_blocks,omitempty"`
+	// proposed_blocks is the number of blocks proposed by the validator.
+	ProposedBlocks uint64 `protobuf:"varint,3,opt,name=proposed_blocks,json=proposedBlocks,proto3" json:"proposed_blocks,omitempty"`
+	// gov_votes is the number of governance votes cast by the operator account
+	// of the validator.
+	GovVotes uint64 `protobuf:"varint,4,opt,name=gov_votes,json=govVotes,proto3" json:"gov_votes,omitempty"`
+	// slash_events is the number of times the validator was slashed.
+	SlashEvents uint64 `protobuf:"varint,5,opt,name=slash_events,json=slashEvents,proto3" json:"slash_events,omitempty"`
+}
+
+func (m *ValidatorPerformance) Reset()         { *m = ValidatorPerformance{} }
+func (m *ValidatorPerformance) String() string { return proto.CompactTextString(m) }
+func (*ValidatorPerformance) ProtoMessage()    {}
+func (*ValidatorPerformance) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1078e5d96a74cc52, []int{2}
+}
+func (m *ValidatorPerformance) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ValidatorPerformance) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ValidatorPerformance.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ValidatorPerformance) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ValidatorPerformance.Merge(m, src)
+}
+func (m *ValidatorPerformance) XXX_Size() int {
+	return m.Size()
+}
+func (m *ValidatorPerformance) XXX_DiscardUnknown() {
+	xxx_messageInfo_ValidatorPerformance.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ValidatorPerformance proto.InternalMessageInfo
+
+func (m *ValidatorPerformance) GetSignedBlocks() uint64 {
+	if m != nil {
+		return m.SignedBlocks
+	}
+	return 0
+}
+
+func (m *ValidatorPerformance) GetMissedBlocks() uint64 {
+	if m != nil {
+		return m.MissedBlocks
+	}
+	return 0
+}
+
+func (m *ValidatorPerformance) GetProposedBlocks() uint64 {
+	if m != nil {
+		return m.ProposedBlocks
+	}
+	return 0
+}
+
+func (m *ValidatorPerformance) GetGovVotes() uint64 {
+	if m != nil {
+		return m.GovVotes
+	}
+	return 0
+}
+
+func (m *ValidatorPerformance) GetSlashEvents() uint64 {
+	if m != nil {
+		return m.SlashEvents
+	}
+	return 0
+}
+
 func init() {
 	proto.RegisterType((*ValidatorSigningInfo)(nil), "cosmos.slashing.v1beta1.ValidatorSigningInfo")
 	proto.RegisterType((*Params)(nil), "cosmos.slashing.v1beta1.Params")
+	proto.RegisterType((*ValidatorPerformance)(nil), "cosmos.slashing.v1beta1.ValidatorPerformance")
 }
 
 func init() {
@@ -193,47 +320,58 @@ func init() {
 }
 
 var fileDescriptor_1078e5d96a74cc52 = []byte{
-	// 639 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x54, 0x3f, 0x4f, 0x14, 0x4f,
-	0x18, 0xbe, 0x01, 0x7e, 0xfc, 0x74, 0x0e, 0x13, 0x5d, 0x0f, 0x59, 0x2e, 0xba, 0x77, 0x50, 0x90,
-	0x0b, 0x09, 0xbb, 0x82, 0x1d, 0x56, 0x1e, 0xc4, 0xe0, 0x9f, 0x44, 0x72, 0xf8, 0x27, 0xb1, 0x70,
-	0x32, 0xbb, 0x33, 0xb7, 0x37, 0xb2, 0x3b, 0x73, 0xd9, 0x99, 0x15, 0x88, 0x8d, 0x8d, 0x8d, 0x15,
-	0xa5, 0xb1, 0xb2, 0xa4, 0xa4, 0xf0, 0x0b, 0x58, 0x98, 0x50, 0x12, 0x2b, 0x63, 0x81, 0xe6, 0x28,
-	0xf0, 0x63, 0x98, 0x9d, 0x99, 0x05, 0x02, 0x89, 0x15, 0xcd, 0xdd, 0xee, 0xf3, 0x3c, 0xef, 0xfb,
-	0xbc, 0xf3, 0xcc, 0x9b, 0x85, 0x33, 0x91, 0x90, 0xa9, 0x90, 0x81, 0x4c, 0xb0, 0xec, 0x31, 0x1e,
-	0x07, 0x6f, 0xe6, 0x43, 0xaa, 0xf0, 0xfc, 0x31, 0xe0, 0xf7, 0x33, 0xa1, 0x84, 0x33, 0x61, 0x74,
-	0xfe, 0x31, 0x6c, 0x75, 0xf5, 0x5a, 0x2c, 0x62, 0xa1, 0x35, 0x41, 0xf1, 0x64, 0xe4, 0x75, 0x2f,
-	0x16, 0x22, 0x4e, 0x68, 0xa0, 0xdf, 0xc2, 0xbc, 0x1b, 0x90, 0x3c, 0xc3, 0x8a, 0x09, 0x6e, 0xf9,
-	0xc6, 0x59, 0x5e, 0xb1, 0x94, 0x4a, 0x85, 0xd3, 0xbe, 0x15, 0x4c, 0x1a, 0x3f, 0x64, 0x3a, 0x5b,
-	0x73, 0x43, 0x5d, 0xc3, 0x29, 0xe3, 0x22, 0xd0, 0xbf, 0x06, 0x9a, 0xfe, 0x3a, 0x04, 0x6b, 0xcf,
-	0x71, 0xc2, 0x08, 0x56, 0x22, 0x5b, 0x63, 0x31, 0x67, 0x3c, 0x7e, 0xc0, 0xbb, 0xc2, 0xb9, 0x0b,
-	0xff, 0xc7, 0x84, 0x64, 0x54, 0x4a, 0x17, 0x34, 0x41, 0xeb, 0x72, 0x7b, 0xea, 0xfb, 0x97, 0xb9,
-	0x5b, 0xb6, 0xdd, 0x92, 0xe0, 0x92, 0x72, 0x99, 0xcb, 0x7b, 0x46, 0xb2, 0xa6, 0x32, 0xc6, 0xe3,
-	0x4e, 0x59, 0xe1, 0x4c, 0xc1, 0x31, 0xa9, 0x70, 0xa6, 0x50, 0x8f, 0xb2, 0xb8, 0xa7, 0xdc, 0xa1,
-	0x26, 0x68, 0x0d, 0x77, 0xaa, 0x1a, 0x5b, 0xd1, 0x50, 0x21, 0x61, 0x9c, 0xd0, 0x4d, 0x24, 0xba,
-	0x5d, 0x49, 0x95, 0x3b, 0x6c, 0x24, 0x1a, 0x7b, 0xa2, 0x21, 0xe7, 0x31, 0x1c, 0x7b, 0x8d, 0x59,
-	0x42, 0x09, 0xca, 0xb9, 0x62, 0x89, 0x3b, 0xd2, 0x04, 0xad, 0xea, 0x42, 0xdd, 0x37, 0x09, 0xf8,
-	0x65, 0x02, 0xfe, 0xd3, 0x32, 0x81, 0xf6, 0x95, 0xbd, 0x83, 0x46, 0x65, 0xfb, 0x57, 0x03, 0xec,
-	0x1c, 0xed, 0xce, 0x82, 0x4e, 0xd5, 0x94, 0x3f, 0x2b, 0xaa, 0x1d, 0x0f, 0x42, 0x25, 0xd2, 0x50,
-	0x2a, 0xc1, 0x29, 0x71, 0xff, 0x6b, 0x82, 0xd6, 0xa5, 0xce, 0x29, 0xc4, 0x59, 0x80, 0xe3, 0x29,
-	0x93, 0x92, 0x12, 0x14, 0x26, 0x22, 0x5a, 0x97, 0x28, 0x12, 0x39, 0x57, 0x34, 0x73, 0x47, 0xf5,
-	0x64, 0xd7, 0x0d, 0xd9, 0xd6, 0xdc, 0x92, 0xa1, 0x16, 0x47, 0xfe, 0x7c, 0x6e, 0x80, 0xe9, 0x6f,
-	0x23, 0x70, 0x74, 0x15, 0x67, 0x38, 0x95, 0xce, 0x6d, 0x58, 0x93, 0x2c, 0xe6, 0x27, 0x4d, 0x36,
-	0x18, 0x27, 0x62, 0x43, 0x47, 0x38, 0xdc, 0x71, 0x0c, 0x67, 0x7a, 0xbc, 0xd0, 0x8c, 0xf3, 0xb6,
-	0xb0, 0xe5, 0xc8, 0x56, 0xf5, 0x69, 0x56, 0x96, 0x14, 0x99, 0x8d, 0xb5, 0x57, 0x8a, 0x13, 0xfd,
-	0x3c, 0x68, 0xcc, 0xc4, 0x4c, 0xf5, 0xf2, 0xd0, 0x8f, 0x44, 0x6a, 0xef, 0xd4, 0xfe, 0xcd, 0x49,
-	0xb2, 0x1e, 0xa8, 0xad, 0x3e, 0x95, 0xfe, 0x32, 0x8d, 0x3e, 0x1d, 0xed, 0xce, 0x5e, 0xb5, 0x0b,
-	0x40, 0x68, 0x84, 0xc2, 0x2d, 0x45, 0xa5, 0x09, 0xc3, 0x49, 0x19, 0x5f, 0xd3, 0x2e, 0xab, 0x34,
-	0xb3, 0xe6, 0xaf, 0xe0, 0x0d, 0x22, 0x36, 0x78, 0xb1, 0x42, 0xa8, 0xc8, 0x0a, 0x95, 0xcb, 0xa6,
-	0xaf, 0xa3, 0xba, 0x30, 0x79, 0x2e, 0xeb, 0x65, 0x2b, 0x30, 0x51, 0x7f, 0x3c, 0x8e, 0xba, 0x56,
-	0xf6, 0x79, 0x88, 0x59, 0x52, 0x8a, 0x9c, 0xf7, 0x00, 0xd6, 0xf5, 0xde, 0xa3, 0x6e, 0x86, 0xa3,
-	0x02, 0x42, 0x44, 0xe4, 0x61, 0x42, 0xf5, 0x79, 0xf5, 0x85, 0x5e, 0xe4, 0x11, 0x27, 0xb4, 0xd7,
-	0x7d, 0x6b, 0xb5, 0xac, 0x9d, 0x8a, 0x23, 0x3b, 0xef, 0x00, 0x9c, 0x38, 0x37, 0x87, 0x99, 0x57,
-	0x6f, 0xc2, 0x45, 0x0e, 0x31, 0x7e, 0x66, 0x08, 0x63, 0xb3, 0x38, 0xf5, 0xe1, 0x68, 0x77, 0xf6,
-	0xe6, 0xa9, 0x5e, 0x9b, 0x27, 0x5f, 0x0e, 0xb3, 0x3c, 0xed, 0x47, 0x3b, 0x03, 0x0f, 0xec, 0x0d,
-	0x3c, 0xb0, 0x3f, 0xf0, 0xc0, 0xef, 0x81, 0x07, 0xb6, 0x0f, 0xbd, 0xca, 0xfe, 0xa1, 0x57, 0xf9,
-	0x71, 0xe8, 0x55, 0x5e, 0xce, 0xfd, 0x73, 0xb2, 0x53, 0xdd, 0xf4, 0x90, 0xe1, 0xa8, 0xbe, 0xb2,
-	0x3b, 0x7f, 0x03, 0x00, 0x00, 0xff, 0xff, 0x3d, 0x8c, 0xa5, 0x44, 0xa7, 0x04, 0x00, 0x00,
+	// 810 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x54, 0xcf, 0x6f, 0xe3, 0x44,
+	0x18, 0x8d, 0x9b, 0xb4, 0xbb, 0x3b, 0xc9, 0xf2, 0x63, 0xc8, 0x6e, 0xdc, 0xc0, 0x3a, 0x69, 0x91,
+	0x96, 0xa8, 0x52, 0x13, 0xb6, 0x5c, 0xd0, 0x72, 0x6a, 0xda, 0xa2, 0x42, 0x8b, 0xa8, 0x5c, 0x28,
+	0x12, 0x07, 0x46, 0x63, 0x7b, 0xe2, 0x0c, 0xb5, 0x67, 0x2c, 0xcf, 0x38, 0x6d, 0xc4, 0x85, 0x0b,
+	0x17, 0x4e, 0x3d, 0x22, 0x4e, 0x1c, 0x7b, 0xec, 0x81, 0xbf, 0x80, 0x53, 0xb9, 0x55, 0x9c, 0x10,
+	0x87, 0x82, 0xd2, 0x43, 0xf9, 0x27, 0x90, 0x90, 0x67, 0xec, 0xd4, 0x69, 0xa5, 0x3d, 0xf5, 0xd2,
+	0xc6, 0xef, 0xbd, 0x6f, 0xbe, 0x6f, 0xde, 0xf7, 0x6c, 0xf0, 0xdc, 0xe5, 0x22, 0xe4, 0xa2, 0x27,
+	0x02, 0x2c, 0x86, 0x94, 0xf9, 0xbd, 0xd1, 0x0b, 0x87, 0x48, 0xfc, 0x62, 0x0a, 0x74, 0xa3, 0x98,
+	0x4b, 0x0e, 0x1b, 0x5a, 0xd7, 0x9d, 0xc2, 0x99, 0xae, 0x59, 0xf7, 0xb9, 0xcf, 0x95, 0xa6, 0x97,
+	0xfe, 0xd2, 0xf2, 0xa6, 0xe5, 0x73, 0xee, 0x07, 0xa4, 0xa7, 0x9e, 0x9c, 0x64, 0xd0, 0xf3, 0x92,
+	0x18, 0x4b, 0xca, 0x59, 0xc6, 0xb7, 0x6e, 0xf3, 0x92, 0x86, 0x44, 0x48, 0x1c, 0x46, 0x99, 0x60,
+	0x51, 0xf7, 0x43, 0xfa, 0xe4, 0xac, 0xb9, 0xa6, 0xde, 0xc4, 0x21, 0x65, 0xbc, 0xa7, 0xfe, 0x6a,
+	0x68, 0xf9, 0xbf, 0x39, 0x50, 0x3f, 0xc0, 0x01, 0xf5, 0xb0, 0xe4, 0xf1, 0x3e, 0xf5, 0x19, 0x65,
+	0xfe, 0x27, 0x6c, 0xc0, 0xe1, 0x47, 0xe0, 0x01, 0xf6, 0xbc, 0x98, 0x08, 0x61, 0x1a, 0x6d, 0xa3,
+	0xf3, 0xa8, 0xbf, 0xf4, 0xc7, 0xaf, 0xab, 0xcf, 0xb2, 0xe3, 0x36, 0x38, 0x13, 0x84, 0x89, 0x44,
+	0xac, 0x6b, 0xc9, 0xbe, 0x8c, 0x29, 0xf3, 0xed, 0xbc, 0x02, 0x2e, 0x81, 0x9a, 0x90, 0x38, 0x96,
+	0x68, 0x48, 0xa8, 0x3f, 0x94, 0xe6, 0x5c, 0xdb, 0xe8, 0x94, 0xed, 0xaa, 0xc2, 0xb6, 0x15, 0x94,
+	0x4a, 0x28, 0xf3, 0xc8, 0x31, 0xe2, 0x83, 0x81, 0x20, 0xd2, 0x2c, 0x6b, 0x89, 0xc2, 0x3e, 0x57,
+	0x10, 0xdc, 0x05, 0xb5, 0x6f, 0x31, 0x0d, 0x88, 0x87, 0x12, 0x26, 0x69, 0x60, 0x56, 0xda, 0x46,
+	0xa7, 0xba, 0xd6, 0xec, 0x6a, 0x07, 0xba, 0xb9, 0x03, 0xdd, 0x2f, 0x72, 0x07, 0xfa, 0x8f, 0xcf,
+	0x2f, 0x5b, 0xa5, 0x93, 0xbf, 0x5b, 0xc6, 0xe9, 0xf5, 0xd9, 0x8a, 0x61, 0x57, 0x75, 0xf9, 0x97,
+	0x69, 0x35, 0xb4, 0x00, 0x90, 0x3c, 0x74, 0x84, 0xe4, 0x8c, 0x78, 0xe6, 0x7c, 0xdb, 0xe8, 0x3c,
+	0xb4, 0x0b, 0x08, 0x5c, 0x03, 0x4f, 0x42, 0x2a, 0x04, 0xf1, 0x90, 0x13, 0x70, 0xf7, 0x50, 0x20,
+	0x97, 0x27, 0x4c, 0x92, 0xd8, 0x5c, 0x50, 0x93, 0xbd, 0xa5, 0xc9, 0xbe, 0xe2, 0x36, 0x34, 0x05,
+	0x5f, 0x82, 0x45, 0x37, 0xb5, 0xc2, 0x4d, 0x24, 0x1d, 0x11, 0x34, 0x53, 0x6f, 0x3e, 0x50, 0x75,
+	0x8d, 0x82, 0xe0, 0xb3, 0xc2, 0x11, 0x2f, 0x2b, 0xff, 0xfe, 0xd2, 0x32, 0x96, 0x7f, 0x9b, 0x07,
+	0x0b, 0x7b, 0x38, 0xc6, 0xa1, 0x80, 0xef, 0x83, 0xba, 0xa0, 0x3e, 0xbb, 0x19, 0xe0, 0x88, 0x32,
+	0x8f, 0x1f, 0x29, 0xfb, 0xcb, 0x36, 0xd4, 0x9c, 0x2e, 0xfe, 0x4a, 0x31, 0xf0, 0xbb, 0x74, 0x64,
+	0x86, 0xb2, 0xaa, 0x88, 0xc4, 0x79, 0x49, 0xea, 0x77, 0xad, 0xbf, 0x9d, 0xba, 0xf1, 0xd7, 0x65,
+	0xeb, 0xb9, 0x4f, 0xe5, 0x30, 0x71, 0xba, 0x2e, 0x0f, 0xb3, 0x3c, 0x64, 0xff, 0x56, 0x85, 0x77,
+	0xd8, 0x93, 0xe3, 0x88, 0x88, 0xee, 0x26, 0x71, 0x7f, 0xbe, 0x3e, 0x5b, 0x79, 0x43, 0x13, 0xc8,
+	0x23, 0x2e, 0x72, 0xc6, 0x92, 0x08, 0x6d, 0x24, 0x0c, 0x29, 0xdb, 0x57, 0x5d, 0xf6, 0x48, 0x9c,
+	0x35, 0xff, 0x06, 0x3c, 0xf5, 0xf8, 0x11, 0x4b, 0xe3, 0x87, 0x52, 0x9f, 0x51, 0x1e, 0x54, 0xb5,
+	0xca, 0xea, 0xda, 0xe2, 0x9d, 0x3d, 0x6d, 0x66, 0x02, 0xbd, 0xa6, 0x9f, 0xa6, 0x6b, 0xaa, 0xe7,
+	0xe7, 0x7c, 0x8a, 0x69, 0x90, 0x8b, 0xe0, 0x0f, 0x06, 0x68, 0xaa, 0x77, 0x06, 0x0d, 0x62, 0xec,
+	0xa6, 0x10, 0xf2, 0x78, 0xe2, 0x04, 0x44, 0xdd, 0xd7, 0xac, 0xdc, 0xf3, 0x15, 0x1b, 0xaa, 0xd7,
+	0xc7, 0x59, 0xab, 0x4d, 0xd5, 0x29, 0xbd, 0x32, 0xfc, 0xde, 0x00, 0x8d, 0x3b, 0x73, 0xe8, 0x79,
+	0xcd, 0xf9, 0x7b, 0x1e, 0xe2, 0xc9, 0xad, 0x21, 0x74, 0x1b, 0xf8, 0x21, 0x30, 0x23, 0x12, 0x0f,
+	0x78, 0x1c, 0x62, 0xe6, 0x12, 0x44, 0x22, 0xee, 0x0e, 0x51, 0x40, 0x98, 0x2f, 0x87, 0x59, 0x3a,
+	0x9f, 0x16, 0xf8, 0xad, 0x94, 0xde, 0x55, 0x2c, 0x5c, 0x07, 0xcf, 0x84, 0xc4, 0xcc, 0x73, 0xc6,
+	0xe8, 0x90, 0x8c, 0x51, 0x7a, 0xee, 0x48, 0xd9, 0x3b, 0x1b, 0xd2, 0x66, 0x26, 0xda, 0x21, 0xe3,
+	0xf5, 0xa9, 0x24, 0xcb, 0xe9, 0xd2, 0x8f, 0xd7, 0x67, 0x2b, 0xef, 0x14, 0x2e, 0x72, 0x7c, 0xf3,
+	0xc9, 0xd3, 0xc9, 0x5d, 0xfe, 0xdd, 0x28, 0x7c, 0x44, 0xf6, 0x6e, 0x26, 0x81, 0xef, 0x82, 0xc7,
+	0x33, 0x91, 0x56, 0x59, 0xae, 0xd8, 0xb5, 0x62, 0x96, 0x53, 0xd1, 0xec, 0x8b, 0x33, 0xa7, 0x45,
+	0xc5, 0x17, 0x0e, 0xbe, 0x07, 0x5e, 0x8f, 0x62, 0x1e, 0xf1, 0x82, 0xac, 0xac, 0x64, 0xaf, 0xe5,
+	0x70, 0x26, 0x7c, 0x1b, 0x3c, 0xf2, 0xf9, 0x08, 0x8d, 0xb8, 0x24, 0x42, 0x85, 0xa4, 0x62, 0x3f,
+	0xf4, 0xf9, 0xe8, 0x20, 0x7d, 0x56, 0xdf, 0x25, 0xb5, 0x4a, 0x32, 0x22, 0x4c, 0x0a, 0xb5, 0xbf,
+	0x8a, 0x5d, 0x55, 0xd8, 0x96, 0x82, 0xfa, 0x3b, 0xa7, 0x13, 0xcb, 0x38, 0x9f, 0x58, 0xc6, 0xc5,
+	0xc4, 0x32, 0xfe, 0x99, 0x58, 0xc6, 0xc9, 0x95, 0x55, 0xba, 0xb8, 0xb2, 0x4a, 0x7f, 0x5e, 0x59,
+	0xa5, 0xaf, 0x57, 0x5f, 0xb9, 0xe2, 0x82, 0x33, 0x6a, 0xdb, 0xce, 0x82, 0xca, 0xfe, 0x07, 0xff,
+	0x0f, 0x00, 0xb6, 0x05, 0xee, 0x4c, 0x2c, 0x06, 0x00, 0x00,
 }
 
 func (this *ValidatorSigningInfo) Equal(that interface{}) bool {
@@ -273,6 +411,9 @@ func (this *ValidatorSigningInfo) Equal(that interface{}) bool {
 	if this.MissedBlocksCounter != that1.MissedBlocksCounter {
 		return false
 	}
+	if this.ConsecutiveMissedBlocks != that1.ConsecutiveMissedBlocks {
+		return false
+	}
 	return true
 }
 func (this *Params) Equal(that interface{}) bool {
@@ -309,6 +450,48 @@ func (this *Params) Equal(that interface{}) bool {
 	if !this.SlashFractionDowntime.Equal(that1.SlashFractionDowntime) {
 		return false
 	}
+	if this.PerformanceEpochLength != that1.PerformanceEpochLength {
+		return false
+	}
+	if this.StandbyKeyActivationBlocks != that1.StandbyKeyActivationBlocks {
+		return false
+	}
+	return true
+}
+func (this *ValidatorPerformance) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*ValidatorPerformance)
+	if !ok {
+		that2, ok := that.(ValidatorPerformance)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.SignedBlocks != that1.SignedBlocks {
+		return false
+	}
+	if this.MissedBlocks != that1.MissedBlocks {
+		return false
+	}
+	if this.ProposedBlocks != that1.ProposedBlocks {
+		return false
+	}
+	if this.GovVotes != that1.GovVotes {
+		return false
+	}
+	if this.SlashEvents != that1.SlashEvents {
+		return false
+	}
 	return true
 }
 func (m *ValidatorSigningInfo) Marshal() (dAtA []byte, err error) {
@@ -331,6 +514,11 @@ func (m *ValidatorSigningInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.ConsecutiveMissedBlocks != 0 {
+		i = encodeVarintSlashing(dAtA, i, uint64(m.ConsecutiveMissedBlocks))
+		i--
+		dAtA[i] = 0x38
+	}
 	if m.MissedBlocksCounter != 0 {
 		i = encodeVarintSlashing(dAtA, i, uint64(m.MissedBlocksCounter))
 		i--
@@ -394,6 +582,16 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.StandbyKeyActivationBlocks != 0 {
+		i = encodeVarintSlashing(dAtA, i, uint64(m.StandbyKeyActivationBlocks))
+		i--
+		dAtA[i] = 0x38
+	}
+	if m.PerformanceEpochLength != 0 {
+		i = encodeVarintSlashing(dAtA, i, uint64(m.PerformanceEpochLength))
+		i--
+		dAtA[i] = 0x30
+	}
 	{
 		size := m.SlashFractionDowntime.Size()
 		i -= size
@@ -440,6 +638,54 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *ValidatorPerformance) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ValidatorPerformance) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ValidatorPerformance) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.SlashEvents != 0 {
+		i = encodeVarintSlashing(dAtA, i, uint64(m.SlashEvents))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.GovVotes != 0 {
+		i = encodeVarintSlashing(dAtA, i, uint64(m.GovVotes))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.ProposedBlocks != 0 {
+		i = encodeVarintSlashing(dAtA, i, uint64(m.ProposedBlocks))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.MissedBlocks != 0 {
+		i = encodeVarintSlashing(dAtA, i, uint64(m.MissedBlocks))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.SignedBlocks != 0 {
+		i = encodeVarintSlashing(dAtA, i, uint64(m.SignedBlocks))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintSlashing(dAtA []byte, offset int, v uint64) int {
 	offset -= sovSlashing(v)
 	base := offset
@@ -475,6 +721,9 @@ func (m *ValidatorSigningInfo) Size() (n int) {
 	if m.MissedBlocksCounter != 0 {
 		n += 1 + sovSlashing(uint64(m.MissedBlocksCounter))
 	}
+	if m.ConsecutiveMissedBlocks != 0 {
+		n += 1 + sovSlashing(uint64(m.ConsecutiveMissedBlocks))
+	}
 	return n
 }
 
@@ -495,6 +744,36 @@ func (m *Params) Size() (n int) {
 	n += 1 + l + sovSlashing(uint64(l))
 	l = m.SlashFractionDowntime.Size()
 	n += 1 + l + sovSlashing(uint64(l))
+	if m.PerformanceEpochLength != 0 {
+		n += 1 + sovSlashing(uint64(m.PerformanceEpochLength))
+	}
+	if m.StandbyKeyActivationBlocks != 0 {
+		n += 1 + sovSlashing(uint64(m.StandbyKeyActivationBlocks))
+	}
+	return n
+}
+
+func (m *ValidatorPerformance) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.SignedBlocks != 0 {
+		n += 1 + sovSlashing(uint64(m.SignedBlocks))
+	}
+	if m.MissedBlocks != 0 {
+		n += 1 + sovSlashing(uint64(m.MissedBlocks))
+	}
+	if m.ProposedBlocks != 0 {
+		n += 1 + sovSlashing(uint64(m.ProposedBlocks))
+	}
+	if m.GovVotes != 0 {
+		n += 1 + sovSlashing(uint64(m.GovVotes))
+	}
+	if m.SlashEvents != 0 {
+		n += 1 + sovSlashing(uint64(m.SlashEvents))
+	}
 	return n
 }
 
@@ -675,6 +954,25 @@ func (m *ValidatorSigningInfo) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConsecutiveMissedBlocks", wireType)
+			}
+			m.ConsecutiveMissedBlocks = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSlashing
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ConsecutiveMissedBlocks |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipSlashing(dAtA[iNdEx:])
@@ -876,6 +1174,189 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PerformanceEpochLength", wireType)
+			}
+			m.PerformanceEpochLength = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSlashing
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PerformanceEpochLength |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StandbyKeyActivationBlocks", wireType)
+			}
+			m.StandbyKeyActivationBlocks = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSlashing
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.StandbyKeyActivationBlocks |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipSlashing(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthSlashing
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ValidatorPerformance) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowSlashing
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ValidatorPerformance: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ValidatorPerformance: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SignedBlocks", wireType)
+			}
+			m.SignedBlocks = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSlashing
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SignedBlocks |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MissedBlocks", wireType)
+			}
+			m.MissedBlocks = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSlashing
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MissedBlocks |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProposedBlocks", wireType)
+			}
+			m.ProposedBlocks = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSlashing
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ProposedBlocks |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GovVotes", wireType)
+			}
+			m.GovVotes = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSlashing
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.GovVotes |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SlashEvents", wireType)
+			}
+			m.SlashEvents = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSlashing
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SlashEvents |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipSlashing(dAtA[iNdEx:])
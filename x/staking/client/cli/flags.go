@@ -21,12 +21,18 @@ const (
 	FlagWebsite         = "website"
 	FlagSecurityContact = "security-contact"
 	FlagDetails         = "details"
+	FlagLogoURI         = "logo-uri"
+	FlagSecurityPolicy  = "security-policy"
+	FlagMEVPolicy       = "mev-policy"
+	FlagRegion          = "region"
+	FlagWebsiteProof    = "website-proof"
 
 	FlagCommissionRate          = "commission-rate"
 	FlagCommissionMaxRate       = "commission-max-rate"
 	FlagCommissionMaxChangeRate = "commission-max-change-rate"
 
 	FlagMinSelfDelegation = "min-self-delegation"
+	FlagMargin            = "margin"
 
 	FlagGenesisFormat = "genesis-format"
 	FlagNodeID        = "node-id"
@@ -89,6 +95,11 @@ func flagSetDescriptionEdit() *flag.FlagSet {
 	fs.String(FlagWebsite, types.DoNotModifyDesc, "The validator's (optional) website")
 	fs.String(FlagSecurityContact, types.DoNotModifyDesc, "The validator's (optional) security contact email")
 	fs.String(FlagDetails, types.DoNotModifyDesc, "The validator's (optional) details")
+	fs.String(FlagLogoURI, types.DoNotModifyDesc, "The validator's (optional) https or ipfs logo uri")
+	fs.String(FlagSecurityPolicy, types.DoNotModifyDesc, "The validator's (optional) security contact policy")
+	fs.String(FlagMEVPolicy, types.DoNotModifyDesc, "The validator's (optional) MEV policy")
+	fs.String(FlagRegion, types.DoNotModifyDesc, "The validator's (optional) infrastructure region (ex. eu-west)")
+	fs.String(FlagWebsiteProof, types.DoNotModifyDesc, "The validator's (optional) website proof (ex. a token published in a DNS TXT record)")
 
 	return fs
 }
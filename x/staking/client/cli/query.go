@@ -6,6 +6,7 @@ import (
 	"strings"
 
 	"cosmossdk.io/core/address"
+	"cosmossdk.io/math"
 	"github.com/spf13/cobra"
 
 	"github.com/cosmos/cosmos-sdk/client"
@@ -40,6 +41,19 @@ func GetQueryCmd(ac address.Codec) *cobra.Command {
 		GetCmdQueryHistoricalInfo(),
 		GetCmdQueryParams(),
 		GetCmdQueryPool(),
+		GetCmdQueryTokenizeShareRecord(),
+		GetCmdQueryTokenizeShareRecordsOwned(ac),
+		GetCmdQueryTotalLiquidStaked(),
+		GetCmdQueryPendingCommissionChange(),
+		GetCmdQueryValidatorsNearMinSelfDelegation(),
+		GetCmdQueryEpochQueue(),
+		GetCmdQueryDelegationLock(ac),
+		GetCmdQueryDelegationLocks(ac),
+		GetCmdQueryValidatorProfile(),
+		GetCmdQueryValidatorRiskTier(),
+		GetCmdQueryValidatorSetChanges(),
+		GetCmdQueryStakingPaused(),
+		GetCmdQueryStandbyConsPubKey(),
 	)
 
 	return stakingQueryCmd
@@ -736,3 +750,602 @@ $ %s query staking params
 
 	return cmd
 }
+
+// GetCmdQueryTokenizeShareRecord implements the query tokenize share record command.
+func GetCmdQueryTokenizeShareRecord() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "tokenize-share-record [id]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Query a tokenize share record by its id",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query a tokenize share record by its id.
+
+Example:
+$ %s query staking tokenize-share-record 1
+`,
+				version.AppName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			id, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.TokenizeShareRecord(cmd.Context(), &types.QueryTokenizeShareRecordRequest{Id: id})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(&res.Record)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// GetCmdQueryTokenizeShareRecordsOwned implements the query tokenize share records owned command.
+func GetCmdQueryTokenizeShareRecordsOwned(ac address.Codec) *cobra.Command {
+	bech32PrefixAccAddr := sdk.GetConfig().GetBech32AccountAddrPrefix()
+
+	cmd := &cobra.Command{
+		Use:   "tokenize-share-records-owned [owner]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Query the tokenize share records owned by an address",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query the tokenize share records owned by an address.
+
+Example:
+$ %s query staking tokenize-share-records-owned %s1gghjut3ccd8ay0zduzj64hwre2fxs9ld75ru9p
+`,
+				version.AppName, bech32PrefixAccAddr,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			if _, err := ac.StringToBytes(args[0]); err != nil {
+				return err
+			}
+
+			res, err := queryClient.TokenizeShareRecordsOwned(cmd.Context(), &types.QueryTokenizeShareRecordsOwnedRequest{Owner: args[0]})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// GetCmdQueryTotalLiquidStaked implements the query total liquid staked command.
+func GetCmdQueryTotalLiquidStaked() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "total-liquid-staked",
+		Args:  cobra.NoArgs,
+		Short: "Query the total amount of liquid staked tokens",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query the total amount of tokens delegated through tokenize share records.
+
+Example:
+$ %s query staking total-liquid-staked
+`,
+				version.AppName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.TotalLiquidStaked(cmd.Context(), &types.QueryTotalLiquidStakedRequest{})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// GetCmdQueryPendingCommissionChange implements the query of the pending
+// commission change of a validator command.
+func GetCmdQueryPendingCommissionChange() *cobra.Command {
+	bech32PrefixValAddr := sdk.GetConfig().GetBech32ValidatorAddrPrefix()
+
+	cmd := &cobra.Command{
+		Use:   "pending-commission-change [validator-addr]",
+		Short: "Query the pending commission change of a validator",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query the commission rate change announced by a validator which has not taken effect yet.
+
+Example:
+$ %s query staking pending-commission-change %s1gghjut3ccd8ay0zduzj64hwre2fxs9ldmqhffj
+`,
+				version.AppName, bech32PrefixValAddr,
+			),
+		),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			addr, err := sdk.ValAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+
+			params := &types.QueryPendingCommissionChangeRequest{ValidatorAddr: addr.String()}
+			res, err := queryClient.PendingCommissionChange(cmd.Context(), params)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(&res.PendingCommissionChange)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// GetCmdQueryValidatorsNearMinSelfDelegation implements the query of the
+// validators near their minimum self delegation command.
+func GetCmdQueryValidatorsNearMinSelfDelegation() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "validators-near-min-self-delegation",
+		Short: "Query the validators whose self delegation is near or below their minimum",
+		Args:  cobra.NoArgs,
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query the validators whose self delegation is below the greater of their
+minimum self delegation and the chain-wide floor, or within the given margin above it.
+
+Example:
+$ %s query staking validators-near-min-self-delegation --%s=0.1
+`,
+				version.AppName, FlagMargin,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			margin := math.LegacyZeroDec()
+			if marginStr, _ := cmd.Flags().GetString(FlagMargin); marginStr != "" {
+				margin, err = math.LegacyNewDecFromStr(marginStr)
+				if err != nil {
+					return err
+				}
+			}
+
+			result, err := queryClient.ValidatorsNearMinSelfDelegation(cmd.Context(), &types.QueryValidatorsNearMinSelfDelegationRequest{
+				Margin:     margin,
+				Pagination: pageReq,
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(result)
+		},
+	}
+
+	cmd.Flags().String(FlagMargin, "", "The fraction above the minimum self delegation within which validators are returned")
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "validators near their minimum self delegation")
+
+	return cmd
+}
+
+// GetCmdQueryEpochQueue implements the query of the staking messages queued
+// until the end of the epoch command.
+func GetCmdQueryEpochQueue() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "epoch-queue",
+		Short: "Query the staking messages queued until the end of the current epoch",
+		Args:  cobra.NoArgs,
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query the delegation, undelegation and redelegation messages queued until
+the end of the current epoch, in the order they are applied.
+
+Example:
+$ %s query staking epoch-queue
+`,
+				version.AppName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			result, err := queryClient.EpochQueue(cmd.Context(), &types.QueryEpochQueueRequest{Pagination: pageReq})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(result)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "queued epoch messages")
+
+	return cmd
+}
+
+// GetCmdQueryDelegationLock implements the command to query the lock of a
+// delegation.
+func GetCmdQueryDelegationLock(ac address.Codec) *cobra.Command {
+	bech32PrefixAccAddr := sdk.GetConfig().GetBech32AccountAddrPrefix()
+	bech32PrefixValAddr := sdk.GetConfig().GetBech32ValidatorAddrPrefix()
+
+	cmd := &cobra.Command{
+		Use:   "delegation-lock [delegator-addr] [validator-addr]",
+		Short: "Query the lock of a delegation",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query the lockup term, reward multiplier and end time of the lock of a
+delegation.
+
+Example:
+$ %s query staking delegation-lock %s1gghjut3ccd8ay0zduzj64hwre2fxs9ld75ru9p %s1gghjut3ccd8ay0zduzj64hwre2fxs9ldmqhffj
+`,
+				version.AppName, bech32PrefixAccAddr, bech32PrefixValAddr,
+			),
+		),
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			_, err = ac.StringToBytes(args[0])
+			if err != nil {
+				return err
+			}
+
+			valAddr, err := sdk.ValAddressFromBech32(args[1])
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.DelegationLock(cmd.Context(), &types.QueryDelegationLockRequest{
+				DelegatorAddr: args[0],
+				ValidatorAddr: valAddr.String(),
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// GetCmdQueryDelegationLocks implements the command to query the locks of all
+// the delegations of a delegator.
+func GetCmdQueryDelegationLocks(ac address.Codec) *cobra.Command {
+	bech32PrefixAccAddr := sdk.GetConfig().GetBech32AccountAddrPrefix()
+
+	cmd := &cobra.Command{
+		Use:   "delegation-locks [delegator-addr]",
+		Short: "Query the locks of all the delegations of a delegator",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query the locks of the delegations of an individual delegator on all validators.
+
+Example:
+$ %s query staking delegation-locks %s1gghjut3ccd8ay0zduzj64hwre2fxs9ld75ru9p
+`,
+				version.AppName, bech32PrefixAccAddr,
+			),
+		),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			_, err = ac.StringToBytes(args[0])
+			if err != nil {
+				return err
+			}
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.DelegatorDelegationLocks(cmd.Context(), &types.QueryDelegatorDelegationLocksRequest{
+				DelegatorAddr: args[0],
+				Pagination:    pageReq,
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "delegation locks")
+
+	return cmd
+}
+
+// GetCmdQueryValidatorProfile implements the command to query the description
+// and profile metadata of a validator.
+func GetCmdQueryValidatorProfile() *cobra.Command {
+	bech32PrefixValAddr := sdk.GetConfig().GetBech32ValidatorAddrPrefix()
+
+	cmd := &cobra.Command{
+		Use:   "validator-profile [validator-addr]",
+		Short: "Query the profile of a validator",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query the description and profile metadata (logo uri, security policy,
+MEV policy, region and website proof) of a validator, with the attestations of
+the profile fields.
+
+Example:
+$ %s query staking validator-profile %s1gghjut3ccd8ay0zduzj64hwre2fxs9ldmqhffj
+`,
+				version.AppName, bech32PrefixValAddr,
+			),
+		),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			addr, err := sdk.ValAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.ValidatorProfile(cmd.Context(), &types.QueryValidatorProfileRequest{ValidatorAddr: addr.String()})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// GetCmdQueryValidatorRiskTier implements the command to query the unbonding
+// tier of a validator.
+func GetCmdQueryValidatorRiskTier() *cobra.Command {
+	bech32PrefixValAddr := sdk.GetConfig().GetBech32ValidatorAddrPrefix()
+
+	cmd := &cobra.Command{
+		Use:   "validator-risk-tier [validator-addr]",
+		Short: "Query the unbonding tier of a validator",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query the unbonding tier a validator is assigned to by governance, and the
+unbonding time applied to its unbonding delegations and redelegations.
+
+Example:
+$ %s query staking validator-risk-tier %s1gghjut3ccd8ay0zduzj64hwre2fxs9ldmqhffj
+`,
+				version.AppName, bech32PrefixValAddr,
+			),
+		),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			addr, err := sdk.ValAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.ValidatorRiskTier(cmd.Context(), &types.QueryValidatorRiskTierRequest{ValidatorAddr: addr.String()})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// GetCmdQueryValidatorSetChanges implements the command to query the validator
+// set changes over a range of heights.
+func GetCmdQueryValidatorSetChanges() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "validator-set-changes [start-height] [end-height]",
+		Args:  cobra.RangeArgs(1, 2),
+		Short: "Query the validator set changes over a range of heights",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query the validators joining and leaving the validator set, and the voting
+power changes, between the historical info entries stored over a range of
+heights. The end height defaults to the current height.
+
+Example:
+$ %s query staking validator-set-changes 100 200
+`,
+				version.AppName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			startHeight, err := strconv.ParseInt(args[0], 10, 64)
+			if err != nil || startHeight < 0 {
+				return fmt.Errorf("start height argument provided must be a non-negative-integer: %v", err)
+			}
+
+			var endHeight int64
+			if len(args) > 1 {
+				endHeight, err = strconv.ParseInt(args[1], 10, 64)
+				if err != nil || endHeight < 0 {
+					return fmt.Errorf("end height argument provided must be a non-negative-integer: %v", err)
+				}
+			}
+
+			res, err := queryClient.ValidatorSetChanges(cmd.Context(), &types.QueryValidatorSetChangesRequest{
+				StartHeight: startHeight,
+				EndHeight:   endHeight,
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// GetCmdQueryStakingPaused implements the command to query whether staking is
+// paused.
+func GetCmdQueryStakingPaused() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "paused",
+		Args:  cobra.NoArgs,
+		Short: "Query whether staking is paused",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query whether the delegations, undelegations and redelegations are paused by governance.
+
+Example:
+$ %s query staking paused
+`,
+				version.AppName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.StakingPaused(cmd.Context(), &types.QueryStakingPausedRequest{})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// GetCmdQueryStandbyConsPubKey implements the command to query the standby
+// consensus pubkey of a validator.
+func GetCmdQueryStandbyConsPubKey() *cobra.Command {
+	bech32PrefixValAddr := sdk.GetConfig().GetBech32ValidatorAddrPrefix()
+
+	cmd := &cobra.Command{
+		Use:   "standby-cons-pubkey [validator-addr]",
+		Short: "Query the standby consensus pubkey of a validator",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query the standby consensus pubkey of a validator, which replaces its
+consensus pubkey once the latter has missed too many consecutive blocks.
+
+Example:
+$ %s query staking standby-cons-pubkey %s1gghjut3ccd8ay0zduzj64hwre2fxs9ldmqhffj
+`,
+				version.AppName, bech32PrefixValAddr,
+			),
+		),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			addr, err := sdk.ValAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.StandbyConsPubKey(cmd.Context(), &types.QueryStandbyConsPubKeyRequest{ValidatorAddr: addr.String()})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
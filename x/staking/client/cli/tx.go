@@ -5,6 +5,7 @@ import (
 	"os"
 	"strconv"
 	"strings"
+	"time"
 
 	"cosmossdk.io/math"
 
@@ -48,8 +49,18 @@ func NewTxCmd() *cobra.Command {
 		NewEditValidatorCmd(),
 		NewDelegateCmd(),
 		NewRedelegateCmd(),
+		NewRedelegateMultiCmd(),
 		NewUnbondCmd(),
+		NewInstantUnbondCmd(),
 		NewCancelUnbondingDelegation(),
+		NewCancelRedelegationCmd(),
+		NewTokenizeSharesCmd(),
+		NewRedeemTokensForSharesCmd(),
+		NewTransferTokenizeShareRecordCmd(),
+		NewValidatorBondCmd(),
+		NewLockDelegationCmd(),
+		NewAttestValidatorProfileCmd(),
+		NewSetStandbyConsPubKeyCmd(),
 	)
 
 	return stakingTxCmd
@@ -76,6 +87,11 @@ Where validator.json contains:
 	"website": "validator's (optional) website",
 	"security": "validator's (optional) security contact email",
 	"details": "validator's (optional) details",
+	"logo-uri": "validator's (optional) https or ipfs logo uri",
+	"security-policy": "validator's (optional) security contact policy",
+	"mev-policy": "validator's (optional) MEV policy",
+	"region": "validator's (optional) infrastructure region (ex. eu-west)",
+	"website-proof": "validator's (optional) website proof (ex. a token published in a DNS TXT record)",
 	"commission-rate": "0.1",
 	"commission-max-rate": "0.2",
 	"commission-max-change-rate": "0.01",
@@ -136,6 +152,13 @@ func NewEditValidatorCmd() *cobra.Command {
 			details, _ := cmd.Flags().GetString(FlagDetails)
 			description := types.NewDescription(moniker, identity, website, security, details)
 
+			logoURI, _ := cmd.Flags().GetString(FlagLogoURI)
+			securityPolicy, _ := cmd.Flags().GetString(FlagSecurityPolicy)
+			mevPolicy, _ := cmd.Flags().GetString(FlagMEVPolicy)
+			region, _ := cmd.Flags().GetString(FlagRegion)
+			websiteProof, _ := cmd.Flags().GetString(FlagWebsiteProof)
+			description.Profile = types.NewValidatorProfile(logoURI, securityPolicy, mevPolicy, region, websiteProof)
+
 			var newRate *math.LegacyDec
 
 			commissionRate, _ := cmd.Flags().GetString(FlagCommissionRate)
@@ -267,6 +290,66 @@ $ %s tx staking redelegate %s1gghjut3ccd8ay0zduzj64hwre2fxs9ldmqhffj %s1l2rsakp3
 	return cmd
 }
 
+// NewRedelegateMultiCmd returns a CLI command handler for creating a MsgRedelegateMulti transaction.
+func NewRedelegateMultiCmd() *cobra.Command {
+	bech32PrefixValAddr := sdk.GetConfig().GetBech32ValidatorAddrPrefix()
+
+	cmd := &cobra.Command{
+		Use:   "redelegate-multi [src-validator-addr] [dst-validator-addr:amount]...",
+		Short: "Redelegate illiquid tokens from one validator to several others",
+		Args:  cobra.MinimumNArgs(2),
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Redelegate amounts of illiquid staking tokens from one validator to several others at once.
+Either all the redelegations succeed or none of them is performed.
+
+Example:
+$ %s tx staking redelegate-multi %s1gghjut3ccd8ay0zduzj64hwre2fxs9ldmqhffj %s1l2rsakp388kuv9k8qzq6lrm9taddae7fpx59wm:100stake %s1ey69r37gfxvxg62sh4r0ktpuc46pzjrm873ae8:50stake --from mykey
+`,
+				version.AppName, bech32PrefixValAddr, bech32PrefixValAddr, bech32PrefixValAddr,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+			delAddr := clientCtx.GetFromAddress()
+			valSrcAddr, err := sdk.ValAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+
+			destinations := make([]types.RedelegationDestination, 0, len(args)-1)
+			for _, arg := range args[1:] {
+				addr, amt, ok := strings.Cut(arg, ":")
+				if !ok {
+					return fmt.Errorf("invalid destination %q; expected dst-validator-addr:amount", arg)
+				}
+
+				valDstAddr, err := sdk.ValAddressFromBech32(addr)
+				if err != nil {
+					return err
+				}
+
+				amount, err := sdk.ParseCoinNormalized(amt)
+				if err != nil {
+					return err
+				}
+
+				destinations = append(destinations, types.NewRedelegationDestination(valDstAddr, amount))
+			}
+
+			msg := types.NewMsgRedelegateMulti(delAddr, valSrcAddr, destinations...)
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
 // NewUnbondCmd returns a CLI command handler for creating a MsgUndelegate transaction.
 func NewUnbondCmd() *cobra.Command {
 	bech32PrefixValAddr := sdk.GetConfig().GetBech32ValidatorAddrPrefix()
@@ -312,6 +395,51 @@ $ %s tx staking unbond %s1gghjut3ccd8ay0zduzj64hwre2fxs9ldmqhffj 100stake --from
 	return cmd
 }
 
+// NewInstantUnbondCmd returns a CLI command handler for creating a MsgInstantUndelegate transaction.
+func NewInstantUnbondCmd() *cobra.Command {
+	bech32PrefixValAddr := sdk.GetConfig().GetBech32ValidatorAddrPrefix()
+
+	cmd := &cobra.Command{
+		Use:   "instant-unbond [validator-addr] [amount]",
+		Short: "Unbond shares from a validator without waiting for the unbonding period, for a fee",
+		Args:  cobra.ExactArgs(2),
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Unbond an amount of bonded shares from a validator and receive the unbonded tokens right away.
+The instant undelegation fee set by governance is deducted from the unbonded tokens.
+
+Example:
+$ %s tx staking instant-unbond %s1gghjut3ccd8ay0zduzj64hwre2fxs9ldmqhffj 100stake --from mykey
+`,
+				version.AppName, bech32PrefixValAddr,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+			delAddr := clientCtx.GetFromAddress()
+			valAddr, err := sdk.ValAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+
+			amount, err := sdk.ParseCoinNormalized(args[1])
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgInstantUndelegate(delAddr, valAddr, amount)
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
 // NewCancelUnbondingDelegation returns a CLI command handler for creating a MsgCancelUnbondingDelegation transaction.
 func NewCancelUnbondingDelegation() *cobra.Command {
 	bech32PrefixValAddr := sdk.GetConfig().GetBech32ValidatorAddrPrefix()
@@ -363,6 +491,379 @@ $ %s tx staking cancel-unbond %s1gghjut3ccd8ay0zduzj64hwre2fxs9ldmqhffj 100stake
 	return cmd
 }
 
+// NewCancelRedelegationCmd returns a CLI command handler for creating a MsgCancelRedelegation transaction.
+func NewCancelRedelegationCmd() *cobra.Command {
+	bech32PrefixValAddr := sdk.GetConfig().GetBech32ValidatorAddrPrefix()
+
+	cmd := &cobra.Command{
+		Use:   "cancel-redelegate [src-validator-addr] [dst-validator-addr] [amount] [creation-height]",
+		Short: "Cancel redelegation and delegate back to the source validator",
+		Args:  cobra.ExactArgs(4),
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Cancel an in-progress redelegation entry and delegate back to the source validator.
+
+Example:
+$ %s tx staking cancel-redelegate %s1gghjut3ccd8ay0zduzj64hwre2fxs9ldmqhffj %s1l2rsakp388kuv9k8qzq6lrm9taddae7fpx59wm 100stake 2 --from mykey
+`,
+				version.AppName, bech32PrefixValAddr, bech32PrefixValAddr,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+			delAddr := clientCtx.GetFromAddress()
+			valSrcAddr, err := sdk.ValAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+
+			valDstAddr, err := sdk.ValAddressFromBech32(args[1])
+			if err != nil {
+				return err
+			}
+
+			amount, err := sdk.ParseCoinNormalized(args[2])
+			if err != nil {
+				return err
+			}
+
+			creationHeight, err := strconv.ParseInt(args[3], 10, 64)
+			if err != nil {
+				return errorsmod.Wrap(err, "invalid height")
+			}
+
+			msg := types.NewMsgCancelRedelegation(delAddr, valSrcAddr, valDstAddr, creationHeight, amount)
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// NewTokenizeSharesCmd returns a CLI command handler for creating a MsgTokenizeShares transaction.
+func NewTokenizeSharesCmd() *cobra.Command {
+	bech32PrefixValAddr := sdk.GetConfig().GetBech32ValidatorAddrPrefix()
+	bech32PrefixAccAddr := sdk.GetConfig().GetBech32AccountAddrPrefix()
+
+	cmd := &cobra.Command{
+		Use:   "tokenize-share [validator-addr] [amount] [rewards-owner]",
+		Short: "Tokenize delegation to share tokens",
+		Args:  cobra.ExactArgs(3),
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Tokenize an amount of a delegation to a validator into share tokens.
+The rewards of the tokenized shares are owned by the rewards owner.
+
+Example:
+$ %s tx staking tokenize-share %s1gghjut3ccd8ay0zduzj64hwre2fxs9ldmqhffj 100stake %s1gghjut3ccd8ay0zduzj64hwre2fxs9ld75ru9p --from mykey
+`,
+				version.AppName, bech32PrefixValAddr, bech32PrefixAccAddr,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			delAddr := clientCtx.GetFromAddress()
+			valAddr, err := sdk.ValAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+
+			amount, err := sdk.ParseCoinNormalized(args[1])
+			if err != nil {
+				return err
+			}
+
+			owner, err := sdk.AccAddressFromBech32(args[2])
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgTokenizeShares(delAddr, valAddr, amount, owner)
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// NewRedeemTokensForSharesCmd returns a CLI command handler for creating a MsgRedeemTokensForShares transaction.
+func NewRedeemTokensForSharesCmd() *cobra.Command {
+	bech32PrefixValAddr := sdk.GetConfig().GetBech32ValidatorAddrPrefix()
+
+	cmd := &cobra.Command{
+		Use:   "redeem-tokens [amount]",
+		Short: "Redeem share tokens into a delegation",
+		Args:  cobra.ExactArgs(1),
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Redeem an amount of share tokens into a delegation to the validator of the share tokens.
+
+Example:
+$ %s tx staking redeem-tokens 100%s1gghjut3ccd8ay0zduzj64hwre2fxs9ldmqhffj/1 --from mykey
+`,
+				version.AppName, bech32PrefixValAddr,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			delAddr := clientCtx.GetFromAddress()
+
+			amount, err := sdk.ParseCoinNormalized(args[0])
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgRedeemTokensForShares(delAddr, amount)
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// NewTransferTokenizeShareRecordCmd returns a CLI command handler for creating a MsgTransferTokenizeShareRecord transaction.
+func NewTransferTokenizeShareRecordCmd() *cobra.Command {
+	bech32PrefixAccAddr := sdk.GetConfig().GetBech32AccountAddrPrefix()
+
+	cmd := &cobra.Command{
+		Use:   "transfer-tokenize-share-record [record-id] [new-owner]",
+		Short: "Transfer the ownership of a tokenize share record",
+		Args:  cobra.ExactArgs(2),
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Transfer the ownership of a tokenize share record, and with it the rewards of its shares.
+
+Example:
+$ %s tx staking transfer-tokenize-share-record 1 %s1gghjut3ccd8ay0zduzj64hwre2fxs9ld75ru9p --from mykey
+`,
+				version.AppName, bech32PrefixAccAddr,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			recordID, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			newOwner, err := sdk.AccAddressFromBech32(args[1])
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgTransferTokenizeShareRecord(recordID, clientCtx.GetFromAddress(), newOwner)
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// NewValidatorBondCmd returns a CLI command handler for creating a MsgValidatorBond transaction.
+func NewValidatorBondCmd() *cobra.Command {
+	bech32PrefixValAddr := sdk.GetConfig().GetBech32ValidatorAddrPrefix()
+
+	cmd := &cobra.Command{
+		Use:   "validator-bond [validator-addr]",
+		Short: "Mark a delegation as a validator bond",
+		Args:  cobra.ExactArgs(1),
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Mark a delegation to a validator as a validator bond, which allows the
+validator to issue liquid shares.
+
+Example:
+$ %s tx staking validator-bond %s1gghjut3ccd8ay0zduzj64hwre2fxs9ldmqhffj --from mykey
+`,
+				version.AppName, bech32PrefixValAddr,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			valAddr, err := sdk.ValAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgValidatorBond(clientCtx.GetFromAddress(), valAddr)
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// NewLockDelegationCmd returns a CLI command handler for creating a MsgLockDelegation transaction.
+func NewLockDelegationCmd() *cobra.Command {
+	bech32PrefixValAddr := sdk.GetConfig().GetBech32ValidatorAddrPrefix()
+
+	cmd := &cobra.Command{
+		Use:   "lock-delegation [validator-addr] [duration]",
+		Short: "Lock a delegation for a lockup term in exchange for a reward multiplier",
+		Args:  cobra.ExactArgs(2),
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Lock a delegation to a validator for one of the lockup terms of the staking
+params. The delegation cannot be undelegated, redelegated or tokenized before
+the lock ends, and its rewards are multiplied by the reward multiplier of the
+term in the meantime.
+
+Example:
+$ %s tx staking lock-delegation %s1gghjut3ccd8ay0zduzj64hwre2fxs9ldmqhffj 720h --from mykey
+`,
+				version.AppName, bech32PrefixValAddr,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			valAddr, err := sdk.ValAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+
+			duration, err := time.ParseDuration(args[1])
+			if err != nil {
+				return fmt.Errorf("invalid lock duration: %w", err)
+			}
+
+			msg := types.NewMsgLockDelegation(clientCtx.GetFromAddress(), valAddr, duration)
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// NewAttestValidatorProfileCmd returns a CLI command handler for creating a MsgAttestValidatorProfile transaction.
+func NewAttestValidatorProfileCmd() *cobra.Command {
+	bech32PrefixValAddr := sdk.GetConfig().GetBech32ValidatorAddrPrefix()
+
+	cmd := &cobra.Command{
+		Use:   "attest-profile [validator-addr] [field] [value] [verified]",
+		Short: "Attest a field of the profile of a validator",
+		Args:  cobra.ExactArgs(4),
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Attest that the current value of a field of the profile of a validator was
+verified, or found invalid, by the attester, e.g. a website proof checked
+against the DNS TXT records of the validator website. The attestation must be
+accepted by the verifier registered for the field.
+
+Fields: %s
+
+Example:
+$ %s tx staking attest-profile %s1gghjut3ccd8ay0zduzj64hwre2fxs9ldmqhffj website_proof cosmos-validator-proof=8f14e45f true --from mykey
+`,
+				strings.Join(types.ProfileFields, ", "), version.AppName, bech32PrefixValAddr,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			valAddr, err := sdk.ValAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+
+			verified, err := strconv.ParseBool(args[3])
+			if err != nil {
+				return fmt.Errorf("invalid verified flag %s: %w", args[3], err)
+			}
+
+			msg := types.NewMsgAttestValidatorProfile(clientCtx.GetFromAddress(), valAddr, args[1], args[2], verified)
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// NewSetStandbyConsPubKeyCmd returns a CLI command handler for creating a MsgSetStandbyConsPubKey transaction.
+func NewSetStandbyConsPubKeyCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "set-standby-cons-pubkey [pubkey]",
+		Short: "Set or remove the standby consensus pubkey of your validator",
+		Args:  cobra.MaximumNArgs(1),
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Set the standby consensus pubkey of your validator, which replaces its
+consensus pubkey once the latter has missed the number of consecutive blocks
+defined by the standby_key_activation_blocks param of x/slashing. The standby
+node must not sign with the pubkey before it replaces the consensus pubkey.
+Without pubkey, the standby consensus pubkey is removed.
+
+Example:
+$ %s tx staking set-standby-cons-pubkey '{"@type":"/cosmos.crypto.ed25519.PubKey","key":"oWg2ISpLF405Jcm2vXV+2v4fnjodh6aafuIdeoW+rUw="}' --from mykey
+`,
+				version.AppName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			var pk cryptotypes.PubKey
+			if len(args) > 0 {
+				if err := clientCtx.Codec.UnmarshalInterfaceJSON([]byte(args[0]), &pk); err != nil {
+					return fmt.Errorf("invalid pubkey: %w", err)
+				}
+			}
+
+			msg, err := types.NewMsgSetStandbyConsPubKey(sdk.ValAddress(clientCtx.GetFromAddress()), pk)
+			if err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
 func newBuildCreateValidatorMsg(clientCtx client.Context, txf tx.Factory, fs *flag.FlagSet, val validator) (tx.Factory, *types.MsgCreateValidator, error) {
 	valAddr := clientCtx.GetFromAddress()
 
@@ -374,6 +875,11 @@ func newBuildCreateValidatorMsg(clientCtx client.Context, txf tx.Factory, fs *fl
 		val.Details,
 	)
 
+	profile := types.NewValidatorProfile(val.LogoURI, val.SecurityPolicy, val.MEVPolicy, val.Region, val.WebsiteProof)
+	if !profile.IsEmpty() {
+		description.Profile = profile
+	}
+
 	msg, err := types.NewMsgCreateValidator(
 		sdk.ValAddress(valAddr), val.PubKey, val.Amount, description, val.CommissionRates, val.MinSelfDelegation,
 	)
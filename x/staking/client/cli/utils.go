@@ -25,6 +25,11 @@ type validator struct {
 	Website           string
 	Security          string
 	Details           string
+	LogoURI           string
+	SecurityPolicy    string
+	MEVPolicy         string
+	Region            string
+	WebsiteProof      string
 	CommissionRates   types.CommissionRates
 	MinSelfDelegation math.Int
 }
@@ -38,6 +43,11 @@ func parseAndValidateValidatorJSON(cdc codec.Codec, path string) (validator, err
 		Website             string          `json:"website,omitempty"`
 		Security            string          `json:"security,omitempty"`
 		Details             string          `json:"details,omitempty"`
+		LogoURI             string          `json:"logo-uri,omitempty"`
+		SecurityPolicy      string          `json:"security-policy,omitempty"`
+		MEVPolicy           string          `json:"mev-policy,omitempty"`
+		Region              string          `json:"region,omitempty"`
+		WebsiteProof        string          `json:"website-proof,omitempty"`
 		CommissionRate      string          `json:"commission-rate"`
 		CommissionMaxRate   string          `json:"commission-max-rate"`
 		CommissionMaxChange string          `json:"commission-max-change-rate"`
@@ -96,6 +106,11 @@ func parseAndValidateValidatorJSON(cdc codec.Codec, path string) (validator, err
 		Website:           v.Website,
 		Security:          v.Security,
 		Details:           v.Details,
+		LogoURI:           v.LogoURI,
+		SecurityPolicy:    v.SecurityPolicy,
+		MEVPolicy:         v.MEVPolicy,
+		Region:            v.Region,
+		WebsiteProof:      v.WebsiteProof,
 		CommissionRates:   commissionRates,
 		MinSelfDelegation: minSelfDelegation,
 	}, nil
@@ -3,6 +3,8 @@ package staking
 import (
 	"fmt"
 
+	"cosmossdk.io/math"
+
 	cmttypes "github.com/cometbft/cometbft/types"
 
 	cryptocodec "github.com/cosmos/cosmos-sdk/crypto/codec"
@@ -45,9 +47,174 @@ func ValidateGenesis(data *types.GenesisState) error {
 		return err
 	}
 
+	if err := validateGenesisStateTokenizeShareRecords(data.TokenizeShareRecords, data.LastTokenizeShareRecordId); err != nil {
+		return err
+	}
+
+	if !data.TotalLiquidStakedTokens.IsNil() && data.TotalLiquidStakedTokens.IsNegative() {
+		return fmt.Errorf("total liquid staked tokens cannot be negative: %s", data.TotalLiquidStakedTokens)
+	}
+
+	if err := validateGenesisStatePendingCommissionChanges(data.PendingCommissionChanges); err != nil {
+		return err
+	}
+
+	if err := validateGenesisStateBondDenoms(data); err != nil {
+		return err
+	}
+
+	if err := validateGenesisStateQueuedEpochMsgs(data.QueuedEpochMsgs); err != nil {
+		return err
+	}
+
+	if err := validateGenesisStateDelegationLocks(data.DelegationLocks); err != nil {
+		return err
+	}
+
+	if err := validateGenesisStateValidatorRiskTiers(data.ValidatorRiskTiers, data.Params); err != nil {
+		return err
+	}
+
+	if err := validateGenesisStateValidatorJailInfos(data.ValidatorJailInfos, data.Validators); err != nil {
+		return err
+	}
+
+	if err := validateGenesisStateProfileAttestations(data.ProfileAttestations, data.Validators); err != nil {
+		return err
+	}
+
+	if err := validateGenesisStateStandbyConsPubkeys(data.StandbyConsPubkeys, data.Validators); err != nil {
+		return err
+	}
+
 	return data.Params.Validate()
 }
 
+// validateGenesisStateBondDenoms checks that the delegations and unbonding
+// delegations made in additional bond denoms use denoms which can be bonded.
+func validateGenesisStateBondDenoms(data *types.GenesisState) error {
+	for _, delegation := range data.Delegations {
+		if _, found := data.Params.DenomWeight(delegation.Denom); delegation.Denom != "" && !found {
+			return fmt.Errorf("delegation of %s to %s in a denom which cannot be bonded: %s",
+				delegation.DelegatorAddress, delegation.ValidatorAddress, delegation.Denom)
+		}
+	}
+
+	for _, ubd := range data.UnbondingDelegations {
+		for _, entry := range ubd.Entries {
+			if _, found := data.Params.DenomWeight(entry.Denom); entry.Denom != "" && !found {
+				return fmt.Errorf("unbonding delegation of %s from %s in a denom which cannot be bonded: %s",
+					ubd.DelegatorAddress, ubd.ValidatorAddress, entry.Denom)
+			}
+		}
+	}
+
+	return nil
+}
+
+func validateGenesisStatePendingCommissionChanges(changes []types.PendingCommissionChange) error {
+	addrs := make(map[string]bool, len(changes))
+
+	for _, change := range changes {
+		if addrs[change.ValidatorAddress] {
+			return fmt.Errorf("duplicate pending commission change in genesis state: validator %s", change.ValidatorAddress)
+		}
+
+		if _, err := sdk.ValAddressFromBech32(change.ValidatorAddress); err != nil {
+			return fmt.Errorf("invalid pending commission change validator %s: %w", change.ValidatorAddress, err)
+		}
+
+		if change.Rate.IsNil() || change.Rate.IsNegative() || change.Rate.GT(math.LegacyOneDec()) {
+			return fmt.Errorf("pending commission change rate must be between 0 and 1 (inclusive): %s", change.Rate)
+		}
+
+		addrs[change.ValidatorAddress] = true
+	}
+
+	return nil
+}
+
+// validateGenesisStateQueuedEpochMsgs checks that the queued epoch messages are
+// delegation, undelegation or redelegation messages with unique ids.
+func validateGenesisStateQueuedEpochMsgs(msgs []types.QueuedEpochMsg) error {
+	ids := make(map[uint64]bool, len(msgs))
+
+	for _, queued := range msgs {
+		if ids[queued.Id] {
+			return fmt.Errorf("duplicate queued epoch message in genesis state: id %d", queued.Id)
+		}
+
+		msg, err := queued.SdkMsg()
+		if err != nil {
+			return err
+		}
+
+		if !types.IsEpochMsg(msg) {
+			return fmt.Errorf("queued epoch message %d cannot be queued: %s", queued.Id, sdk.MsgTypeURL(msg))
+		}
+
+		ids[queued.Id] = true
+	}
+
+	return nil
+}
+
+// validateGenesisStateDelegationLocks checks that there is at most one lock per
+// delegation and that the locks do not reduce rewards.
+func validateGenesisStateDelegationLocks(locks []types.DelegationLock) error {
+	pairs := make(map[string]bool, len(locks))
+
+	for _, lock := range locks {
+		if _, err := sdk.AccAddressFromBech32(lock.DelegatorAddress); err != nil {
+			return fmt.Errorf("invalid delegation lock delegator %s: %w", lock.DelegatorAddress, err)
+		}
+
+		if _, err := sdk.ValAddressFromBech32(lock.ValidatorAddress); err != nil {
+			return fmt.Errorf("invalid delegation lock validator %s: %w", lock.ValidatorAddress, err)
+		}
+
+		pair := lock.DelegatorAddress + "/" + lock.ValidatorAddress
+		if pairs[pair] {
+			return fmt.Errorf("duplicate delegation lock in genesis state: delegator %s, validator %s",
+				lock.DelegatorAddress, lock.ValidatorAddress)
+		}
+
+		if lock.RewardMultiplier.IsNil() || lock.RewardMultiplier.LT(math.LegacyOneDec()) {
+			return fmt.Errorf("delegation lock reward multiplier must be at least one: %s", lock.RewardMultiplier)
+		}
+
+		pairs[pair] = true
+	}
+
+	return nil
+}
+
+func validateGenesisStateTokenizeShareRecords(records []types.TokenizeShareRecord, lastRecordID uint64) error {
+	ids := make(map[uint64]bool, len(records))
+
+	for _, record := range records {
+		if ids[record.Id] {
+			return fmt.Errorf("duplicate tokenize share record in genesis state: id %d", record.Id)
+		}
+
+		if record.Id > lastRecordID {
+			return fmt.Errorf("tokenize share record id %d is greater than the last tokenize share record id %d", record.Id, lastRecordID)
+		}
+
+		if _, err := sdk.AccAddressFromBech32(record.Owner); err != nil {
+			return fmt.Errorf("invalid tokenize share record owner %s: %w", record.Owner, err)
+		}
+
+		if _, err := sdk.ValAddressFromBech32(record.Validator); err != nil {
+			return fmt.Errorf("invalid tokenize share record validator %s: %w", record.Validator, err)
+		}
+
+		ids[record.Id] = true
+	}
+
+	return nil
+}
+
 func validateGenesisStateValidators(validators []types.Validator) error {
 	addrMap := make(map[string]bool, len(validators))
 
@@ -85,3 +252,134 @@ func validateGenesisStateValidators(validators []types.Validator) error {
 
 	return nil
 }
+
+// validateGenesisStateValidatorRiskTiers checks that the validators are
+// assigned to at most one of the unbonding tiers of the params.
+func validateGenesisStateValidatorRiskTiers(tiers []types.ValidatorRiskTier, params types.Params) error {
+	addrs := make(map[string]bool, len(tiers))
+
+	for _, tier := range tiers {
+		if _, err := sdk.ValAddressFromBech32(tier.ValidatorAddress); err != nil {
+			return fmt.Errorf("invalid validator risk tier validator %s: %w", tier.ValidatorAddress, err)
+		}
+
+		if addrs[tier.ValidatorAddress] {
+			return fmt.Errorf("duplicate validator risk tier in genesis state: validator %s", tier.ValidatorAddress)
+		}
+
+		if _, found := params.UnbondingTier(tier.Tier); !found {
+			return fmt.Errorf("validator %s is assigned to an unknown unbonding tier: %s", tier.ValidatorAddress, tier.Tier)
+		}
+
+		addrs[tier.ValidatorAddress] = true
+	}
+
+	return nil
+}
+
+// validateGenesisStateValidatorJailInfos checks that the jail infos are unique
+// and refer to jailed validators.
+func validateGenesisStateValidatorJailInfos(infos []types.ValidatorJailInfo, validators []types.Validator) error {
+	jailed := make(map[string]bool, len(validators))
+	for _, val := range validators {
+		jailed[val.OperatorAddress] = val.Jailed
+	}
+
+	addrs := make(map[string]bool, len(infos))
+	for _, info := range infos {
+		if addrs[info.ValidatorAddress] {
+			return fmt.Errorf("duplicate validator jail info in genesis state: validator %s", info.ValidatorAddress)
+		}
+
+		if !jailed[info.ValidatorAddress] {
+			return fmt.Errorf("validator jail info for a validator which is not jailed: %s", info.ValidatorAddress)
+		}
+
+		if _, ok := types.JailReason_name[int32(info.Reason)]; !ok {
+			return fmt.Errorf("invalid jail reason of validator %s: %d", info.ValidatorAddress, info.Reason)
+		}
+
+		addrs[info.ValidatorAddress] = true
+	}
+
+	return nil
+}
+
+// validateGenesisStateProfileAttestations checks that the profile attestations
+// are unique and attest the current profile values of existing validators.
+func validateGenesisStateProfileAttestations(attestations []types.ProfileAttestation, validators []types.Validator) error {
+	profiles := make(map[string]*types.ValidatorProfile, len(validators))
+	for _, val := range validators {
+		profiles[val.OperatorAddress] = val.Description.Profile
+	}
+
+	keys := make(map[string]bool, len(attestations))
+	for _, attestation := range attestations {
+		if _, err := sdk.AccAddressFromBech32(attestation.Attester); err != nil {
+			return fmt.Errorf("invalid profile attestation attester %s: %w", attestation.Attester, err)
+		}
+
+		key := attestation.ValidatorAddress + "/" + attestation.Field + "/" + attestation.Attester
+		if keys[key] {
+			return fmt.Errorf("duplicate profile attestation in genesis state: validator %s, field %s, attester %s",
+				attestation.ValidatorAddress, attestation.Field, attestation.Attester)
+		}
+
+		profile, found := profiles[attestation.ValidatorAddress]
+		if !found {
+			return fmt.Errorf("profile attestation for an unknown validator: %s", attestation.ValidatorAddress)
+		}
+
+		if !types.IsProfileField(attestation.Field) {
+			return fmt.Errorf("profile attestation of validator %s for an unknown field: %s", attestation.ValidatorAddress, attestation.Field)
+		}
+
+		if attestation.Value == "" || attestation.Value != profile.FieldValue(attestation.Field) {
+			return fmt.Errorf("profile attestation of validator %s does not attest the current value of field %s", attestation.ValidatorAddress, attestation.Field)
+		}
+
+		keys[key] = true
+	}
+
+	return nil
+}
+
+// validateGenesisStateStandbyConsPubkeys checks that the standby consensus
+// pubkeys are unique, belong to existing validators and are not the consensus
+// pubkey of any validator.
+func validateGenesisStateStandbyConsPubkeys(standbys []types.StandbyConsPubKey, validators []types.Validator) error {
+	consAddrs := make(map[string]bool, len(validators)+len(standbys))
+	valAddrs := make(map[string]bool, len(validators))
+	for _, val := range validators {
+		consAddr, err := val.GetConsAddr()
+		if err != nil {
+			return err
+		}
+		consAddrs[consAddr.String()] = true
+		valAddrs[val.OperatorAddress] = true
+	}
+
+	withStandby := make(map[string]bool, len(standbys))
+	for _, standby := range standbys {
+		if !valAddrs[standby.ValidatorAddress] {
+			return fmt.Errorf("standby consensus pubkey for an unknown validator: %s", standby.ValidatorAddress)
+		}
+		if withStandby[standby.ValidatorAddress] {
+			return fmt.Errorf("duplicate standby consensus pubkey for validator %s in genesis state", standby.ValidatorAddress)
+		}
+
+		pk, err := standby.ConsPubKey()
+		if err != nil {
+			return fmt.Errorf("invalid standby consensus pubkey of validator %s: %w", standby.ValidatorAddress, err)
+		}
+		consAddr := sdk.GetConsAddress(pk).String()
+		if consAddrs[consAddr] {
+			return fmt.Errorf("standby consensus pubkey of validator %s is already used: %s", standby.ValidatorAddress, consAddr)
+		}
+
+		consAddrs[consAddr] = true
+		withStandby[standby.ValidatorAddress] = true
+	}
+
+	return nil
+}
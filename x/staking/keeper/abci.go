@@ -11,16 +11,34 @@ import (
 )
 
 // BeginBlocker will persist the current header and validator set as a historical entry
-// and prune the oldest entry based on the HistoricalEntries parameter
+// and prune the oldest entry based on the HistoricalEntries parameter, and reset the
+// instant undelegation budget of the block
 func (k *Keeper) BeginBlocker(ctx sdk.Context) {
 	defer telemetry.ModuleMeasureSince(types.ModuleName, time.Now(), telemetry.MetricKeyBeginBlocker)
 
 	k.TrackHistoricalInfo(ctx)
+	k.ResetInstantUndelegationUsed(ctx)
 }
 
-// Called every block, update validator set
+// Called every block, apply the staking messages queued until the end of the
+// epoch and mature commission changes, release the expired delegation locks
+// and update validator set
 func (k *Keeper) EndBlocker(ctx context.Context) ([]abci.ValidatorUpdate, error) {
 	defer telemetry.ModuleMeasureSince(types.ModuleName, time.Now(), telemetry.MetricKeyEndBlocker)
 
-	return k.BlockValidatorUpdates(sdk.UnwrapSDKContext(ctx)), nil
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+
+	// apply the staking messages queued during the epoch before computing the
+	// validator set updates
+	k.ApplyEpochQueue(sdkCtx)
+
+	// apply the commission changes whose notice period has elapsed before
+	// computing the validator set updates
+	k.ApplyMatureCommissionChanges(sdkCtx)
+
+	// release the delegation locks which have ended, settling the rewards
+	// accrued under them
+	k.ReleaseExpiredDelegationLocks(sdkCtx)
+
+	return k.BlockValidatorUpdates(sdkCtx), nil
 }
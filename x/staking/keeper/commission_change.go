@@ -0,0 +1,190 @@
+package keeper
+
+import (
+	"time"
+
+	"cosmossdk.io/math"
+	storetypes "cosmossdk.io/store/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/staking/types"
+)
+
+// GetPendingCommissionChange returns the commission rate change announced by
+// a validator which has not taken effect yet.
+func (k Keeper) GetPendingCommissionChange(ctx sdk.Context, valAddr sdk.ValAddress) (change types.PendingCommissionChange, found bool) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.GetPendingCommissionChangeKey(valAddr))
+	if bz == nil {
+		return change, false
+	}
+
+	k.cdc.MustUnmarshal(bz, &change)
+	return change, true
+}
+
+// SetPendingCommissionChange sets the pending commission change of a validator
+// and inserts it into the commission change queue.
+func (k Keeper) SetPendingCommissionChange(ctx sdk.Context, change types.PendingCommissionChange) {
+	valAddr, err := sdk.ValAddressFromBech32(change.ValidatorAddress)
+	if err != nil {
+		panic(err)
+	}
+
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.GetPendingCommissionChangeKey(valAddr), k.cdc.MustMarshal(&change))
+
+	timeSlice := k.GetCommissionChangeQueueTimeSlice(ctx, change.EffectiveTime)
+	timeSlice = append(timeSlice, change.ValidatorAddress)
+	k.SetCommissionChangeQueueTimeSlice(ctx, change.EffectiveTime, timeSlice)
+}
+
+// RemovePendingCommissionChange removes the pending commission change of a
+// validator, if any, together with its entry in the commission change queue.
+func (k Keeper) RemovePendingCommissionChange(ctx sdk.Context, valAddr sdk.ValAddress) {
+	change, found := k.GetPendingCommissionChange(ctx, valAddr)
+	if !found {
+		return
+	}
+
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.GetPendingCommissionChangeKey(valAddr))
+
+	timeSlice := k.GetCommissionChangeQueueTimeSlice(ctx, change.EffectiveTime)
+	for i, addr := range timeSlice {
+		if addr == change.ValidatorAddress {
+			timeSlice = append(timeSlice[:i], timeSlice[i+1:]...)
+			break
+		}
+	}
+
+	if len(timeSlice) == 0 {
+		store.Delete(types.GetCommissionChangeTimeKey(change.EffectiveTime))
+	} else {
+		k.SetCommissionChangeQueueTimeSlice(ctx, change.EffectiveTime, timeSlice)
+	}
+}
+
+// GetAllPendingCommissionChanges returns all the pending commission changes.
+func (k Keeper) GetAllPendingCommissionChanges(ctx sdk.Context) (changes []types.PendingCommissionChange) {
+	store := ctx.KVStore(k.storeKey)
+	iterator := storetypes.KVStorePrefixIterator(store, types.PendingCommissionChangeKey)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var change types.PendingCommissionChange
+		k.cdc.MustUnmarshal(iterator.Value(), &change)
+		changes = append(changes, change)
+	}
+
+	return changes
+}
+
+// GetCommissionChangeQueueTimeSlice gets a specific commission change queue
+// timeslice. A timeslice is a slice of validator addresses whose pending
+// commission changes take effect at a certain time.
+func (k Keeper) GetCommissionChangeQueueTimeSlice(ctx sdk.Context, timestamp time.Time) []string {
+	store := ctx.KVStore(k.storeKey)
+
+	bz := store.Get(types.GetCommissionChangeTimeKey(timestamp))
+	if bz == nil {
+		return []string{}
+	}
+
+	addrs := types.ValAddresses{}
+	k.cdc.MustUnmarshal(bz, &addrs)
+
+	return addrs.Addresses
+}
+
+// SetCommissionChangeQueueTimeSlice sets a specific commission change queue
+// timeslice.
+func (k Keeper) SetCommissionChangeQueueTimeSlice(ctx sdk.Context, timestamp time.Time, addrs []string) {
+	store := ctx.KVStore(k.storeKey)
+	bz := k.cdc.MustMarshal(&types.ValAddresses{Addresses: addrs})
+	store.Set(types.GetCommissionChangeTimeKey(timestamp), bz)
+}
+
+// CommissionChangeQueueIterator returns all the commission change queue
+// timeslices from time 0 until endTime.
+func (k Keeper) CommissionChangeQueueIterator(ctx sdk.Context, endTime time.Time) storetypes.Iterator {
+	store := ctx.KVStore(k.storeKey)
+	return store.Iterator(types.CommissionChangeQueueKey, storetypes.InclusiveEndBytes(types.GetCommissionChangeTimeKey(endTime)))
+}
+
+// ScheduleCommissionChange validates a new commission rate of a validator and
+// schedules it to take effect once the commission change notice period has
+// elapsed. A commission change already pending for the validator is replaced.
+func (k Keeper) ScheduleCommissionChange(ctx sdk.Context, validator types.Validator, newRate math.LegacyDec) (types.PendingCommissionChange, error) {
+	if _, err := k.UpdateValidatorCommission(ctx, validator, newRate); err != nil {
+		return types.PendingCommissionChange{}, err
+	}
+
+	k.RemovePendingCommissionChange(ctx, validator.GetOperator())
+
+	change := types.PendingCommissionChange{
+		ValidatorAddress: validator.OperatorAddress,
+		Rate:             newRate,
+		EffectiveTime:    ctx.BlockHeader().Time.Add(k.CommissionChangeNoticePeriod(ctx)),
+	}
+	k.SetPendingCommissionChange(ctx, change)
+
+	return change, nil
+}
+
+// ApplyMatureCommissionChanges applies all the pending commission changes
+// whose effective time is at or before the current block time, and removes
+// them from the commission change queue.
+func (k Keeper) ApplyMatureCommissionChanges(ctx sdk.Context) {
+	store := ctx.KVStore(k.storeKey)
+	blockTime := ctx.BlockHeader().Time
+
+	// gets an iterator for all timeslices from time 0 until the current Blockheader time
+	iterator := k.CommissionChangeQueueIterator(ctx, blockTime)
+	defer iterator.Close()
+
+	var addrs []string
+	for ; iterator.Valid(); iterator.Next() {
+		timeSlice := types.ValAddresses{}
+		k.cdc.MustUnmarshal(iterator.Value(), &timeSlice)
+
+		addrs = append(addrs, timeSlice.Addresses...)
+
+		store.Delete(iterator.Key())
+	}
+
+	for _, addr := range addrs {
+		valAddr, err := sdk.ValAddressFromBech32(addr)
+		if err != nil {
+			panic(err)
+		}
+
+		change, found := k.GetPendingCommissionChange(ctx, valAddr)
+		if !found {
+			continue
+		}
+		store.Delete(types.GetPendingCommissionChangeKey(valAddr))
+
+		validator, found := k.GetValidator(ctx, valAddr)
+		if !found {
+			continue
+		}
+
+		// call the before-modification hook since we're about to update the commission
+		if err := k.Hooks().BeforeValidatorModified(ctx, valAddr); err != nil {
+			k.Logger(ctx).Error("failed to call before validator modified hook", "error", err)
+		}
+
+		validator.Commission.Rate = change.Rate
+		validator.Commission.UpdateTime = blockTime
+		k.SetValidator(ctx, validator)
+
+		ctx.EventManager().EmitEvent(
+			sdk.NewEvent(
+				types.EventTypeCommissionChange,
+				sdk.NewAttribute(types.AttributeKeyValidator, addr),
+				sdk.NewAttribute(types.AttributeKeyCommissionRate, change.Rate.String()),
+			),
+		)
+	}
+}
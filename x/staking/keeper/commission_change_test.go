@@ -0,0 +1,89 @@
+package keeper_test
+
+import (
+	"time"
+
+	"cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/staking/testutil"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+)
+
+func (s *KeeperTestSuite) TestScheduleCommissionChange() {
+	ctx, keeper, msgServer := s.ctx, s.stakingKeeper, s.msgServer
+	require := s.Require()
+
+	noticePeriod := 24 * time.Hour
+	params := keeper.GetParams(ctx)
+	params.CommissionChangeNoticePeriod = noticePeriod
+	require.NoError(keeper.SetParams(ctx, params))
+
+	valAddr := sdk.ValAddress(PKs[0].Address().Bytes())
+	validator := testutil.NewValidator(s.T(), valAddr, PKs[0])
+	validator, err := validator.SetInitialCommission(stakingtypes.NewCommissionWithTime(
+		math.LegacyNewDecWithPrec(1, 1), math.LegacyNewDecWithPrec(3, 1),
+		math.LegacyNewDecWithPrec(1, 1), ctx.BlockHeader().Time.Add(-48*time.Hour),
+	))
+	require.NoError(err)
+	keeper.SetValidator(ctx, validator)
+
+	description := stakingtypes.NewDescription("moniker", "", "", "", "")
+
+	// a rate exceeding the max change rate is rejected when it is announced
+	newRate := math.LegacyNewDecWithPrec(3, 1)
+	_, err = msgServer.EditValidator(ctx, stakingtypes.NewMsgEditValidator(valAddr, description, &newRate, nil))
+	require.Error(err)
+	_, found := keeper.GetPendingCommissionChange(ctx, valAddr)
+	require.False(found)
+
+	// the rate is announced and the commission is left unchanged
+	newRate = math.LegacyNewDecWithPrec(15, 2)
+	_, err = msgServer.EditValidator(ctx, stakingtypes.NewMsgEditValidator(valAddr, description, &newRate, nil))
+	require.NoError(err)
+
+	validator, found = keeper.GetValidator(ctx, valAddr)
+	require.True(found)
+	require.Equal(math.LegacyNewDecWithPrec(1, 1), validator.Commission.Rate)
+
+	change, found := keeper.GetPendingCommissionChange(ctx, valAddr)
+	require.True(found)
+	require.Equal(newRate, change.Rate)
+	require.Equal(ctx.BlockHeader().Time.Add(noticePeriod), change.EffectiveTime)
+
+	// announcing another rate replaces the pending change
+	newRate = math.LegacyNewDecWithPrec(2, 1)
+	_, err = msgServer.EditValidator(ctx, stakingtypes.NewMsgEditValidator(valAddr, description, &newRate, nil))
+	require.NoError(err)
+
+	changes := keeper.GetAllPendingCommissionChanges(ctx)
+	require.Len(changes, 1)
+	require.Equal(newRate, changes[0].Rate)
+	require.Equal([]string{valAddr.String()}, keeper.GetCommissionChangeQueueTimeSlice(ctx, changes[0].EffectiveTime))
+
+	res, err := s.queryClient.PendingCommissionChange(ctx, &stakingtypes.QueryPendingCommissionChangeRequest{ValidatorAddr: valAddr.String()})
+	require.NoError(err)
+	require.Equal(changes[0], res.PendingCommissionChange)
+
+	// the change is not applied before the notice period has elapsed
+	keeper.ApplyMatureCommissionChanges(ctx.WithBlockTime(ctx.BlockHeader().Time.Add(noticePeriod - time.Second)))
+	validator, found = keeper.GetValidator(ctx, valAddr)
+	require.True(found)
+	require.Equal(math.LegacyNewDecWithPrec(1, 1), validator.Commission.Rate)
+
+	// the change is applied once the notice period has elapsed
+	applyCtx := ctx.WithBlockTime(ctx.BlockHeader().Time.Add(noticePeriod))
+	keeper.ApplyMatureCommissionChanges(applyCtx)
+
+	validator, found = keeper.GetValidator(ctx, valAddr)
+	require.True(found)
+	require.Equal(newRate, validator.Commission.Rate)
+	require.Equal(applyCtx.BlockHeader().Time, validator.Commission.UpdateTime)
+
+	_, found = keeper.GetPendingCommissionChange(ctx, valAddr)
+	require.False(found)
+	require.Empty(keeper.GetCommissionChangeQueueTimeSlice(ctx, change.EffectiveTime))
+
+	_, err = s.queryClient.PendingCommissionChange(ctx, &stakingtypes.QueryPendingCommissionChangeRequest{ValidatorAddr: valAddr.String()})
+	require.Error(err)
+}
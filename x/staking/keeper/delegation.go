@@ -328,18 +328,22 @@ func (k Keeper) RemoveUnbondingDelegation(ctx sdk.Context, ubd types.UnbondingDe
 }
 
 // SetUnbondingDelegationEntry adds an entry to the unbonding delegation at
-// the given addresses. It creates the unbonding delegation if it does not exist.
+// the given addresses, with a balance in the given additional bond denom or,
+// if empty, in the bond denom. It creates the unbonding delegation if it does
+// not exist.
 func (k Keeper) SetUnbondingDelegationEntry(
 	ctx sdk.Context, delegatorAddr sdk.AccAddress, validatorAddr sdk.ValAddress,
-	creationHeight int64, minTime time.Time, balance math.Int,
+	creationHeight int64, minTime time.Time, balance math.Int, denom string,
 ) types.UnbondingDelegation {
 	ubd, found := k.GetUnbondingDelegation(ctx, delegatorAddr, validatorAddr)
 	id := k.IncrementUnbondingID(ctx)
-	if found {
-		ubd.AddEntry(creationHeight, minTime, balance, id)
-	} else {
-		ubd = types.NewUnbondingDelegation(delegatorAddr, validatorAddr, creationHeight, minTime, balance, id)
+	if !found {
+		ubd = types.UnbondingDelegation{
+			DelegatorAddress: delegatorAddr.String(),
+			ValidatorAddress: validatorAddr.String(),
+		}
 	}
+	ubd.AddDenomEntry(creationHeight, minTime, balance, denom, id)
 
 	k.SetUnbondingDelegation(ctx, ubd)
 
@@ -403,8 +407,22 @@ func (k Keeper) UBDQueueIterator(ctx sdk.Context, endTime time.Time) storetypes.
 // DequeueAllMatureUBDQueue returns a concatenated list of all the timeslices inclusively previous to
 // currTime, and deletes the timeslices from the queue.
 func (k Keeper) DequeueAllMatureUBDQueue(ctx sdk.Context, currTime time.Time) (matureUnbonds []types.DVPair) {
+	return k.DequeueMatureUBDQueue(ctx, currTime, 0)
+}
+
+// DequeueMatureUBDQueue returns at most limit entries of the timeslices
+// inclusively previous to currTime, in queue order, and removes them from the
+// queue. The entries beyond the limit remain at the head of the queue so that
+// they are dequeued first in a later call. A zero limit dequeues all the mature
+// entries.
+func (k Keeper) DequeueMatureUBDQueue(ctx sdk.Context, currTime time.Time, limit uint32) (matureUnbonds []types.DVPair) {
 	store := ctx.KVStore(k.storeKey)
 
+	var (
+		remainderKey []byte
+		remainder    []types.DVPair
+	)
+
 	// gets an iterator for all timeslices from time 0 until the current Blockheader time
 	unbondingTimesliceIterator := k.UBDQueueIterator(ctx, currTime)
 	defer unbondingTimesliceIterator.Close()
@@ -414,11 +432,22 @@ func (k Keeper) DequeueAllMatureUBDQueue(ctx sdk.Context, currTime time.Time) (m
 		value := unbondingTimesliceIterator.Value()
 		k.cdc.MustUnmarshal(value, &timeslice)
 
+		if limit > 0 && len(matureUnbonds)+len(timeslice.Pairs) > int(limit) {
+			n := int(limit) - len(matureUnbonds)
+			matureUnbonds = append(matureUnbonds, timeslice.Pairs[:n]...)
+			remainderKey, remainder = unbondingTimesliceIterator.Key(), timeslice.Pairs[n:]
+			break
+		}
+
 		matureUnbonds = append(matureUnbonds, timeslice.Pairs...)
 
 		store.Delete(unbondingTimesliceIterator.Key())
 	}
 
+	if remainderKey != nil {
+		store.Set(remainderKey, k.cdc.MustMarshal(&types.DVPairs{Pairs: remainder}))
+	}
+
 	return matureUnbonds
 }
 
@@ -630,6 +659,28 @@ func (k Keeper) InsertRedelegationQueue(ctx sdk.Context, red types.Redelegation,
 	}
 }
 
+// RemoveRedelegationFromQueue removes a single occurrence of the redelegation
+// from the redelegation queue timeslice at completionTime, deleting the
+// timeslice once it is empty.
+func (k Keeper) RemoveRedelegationFromQueue(ctx sdk.Context, red types.Redelegation, completionTime time.Time) {
+	timeSlice := k.GetRedelegationQueueTimeSlice(ctx, completionTime)
+	for i, dvvTriplet := range timeSlice {
+		if dvvTriplet.DelegatorAddress == red.DelegatorAddress &&
+			dvvTriplet.ValidatorSrcAddress == red.ValidatorSrcAddress &&
+			dvvTriplet.ValidatorDstAddress == red.ValidatorDstAddress {
+			timeSlice = append(timeSlice[:i], timeSlice[i+1:]...)
+			break
+		}
+	}
+
+	if len(timeSlice) == 0 {
+		store := ctx.KVStore(k.storeKey)
+		store.Delete(types.GetRedelegationTimeKey(completionTime))
+	} else {
+		k.SetRedelegationQueueTimeSlice(ctx, completionTime, timeSlice)
+	}
+}
+
 // RedelegationQueueIterator returns all the redelegation queue timeslices from
 // time 0 until endTime.
 func (k Keeper) RedelegationQueueIterator(ctx sdk.Context, endTime time.Time) storetypes.Iterator {
@@ -641,8 +692,22 @@ func (k Keeper) RedelegationQueueIterator(ctx sdk.Context, endTime time.Time) st
 // timeslices inclusively previous to currTime, and deletes the timeslices from
 // the queue.
 func (k Keeper) DequeueAllMatureRedelegationQueue(ctx sdk.Context, currTime time.Time) (matureRedelegations []types.DVVTriplet) {
+	return k.DequeueMatureRedelegationQueue(ctx, currTime, 0)
+}
+
+// DequeueMatureRedelegationQueue returns at most limit entries of the
+// timeslices inclusively previous to currTime, in queue order, and removes them
+// from the queue. The entries beyond the limit remain at the head of the queue
+// so that they are dequeued first in a later call. A zero limit dequeues all
+// the mature entries.
+func (k Keeper) DequeueMatureRedelegationQueue(ctx sdk.Context, currTime time.Time, limit uint32) (matureRedelegations []types.DVVTriplet) {
 	store := ctx.KVStore(k.storeKey)
 
+	var (
+		remainderKey []byte
+		remainder    []types.DVVTriplet
+	)
+
 	// gets an iterator for all timeslices from time 0 until the current Blockheader time
 	redelegationTimesliceIterator := k.RedelegationQueueIterator(ctx, ctx.BlockHeader().Time)
 	defer redelegationTimesliceIterator.Close()
@@ -652,11 +717,22 @@ func (k Keeper) DequeueAllMatureRedelegationQueue(ctx sdk.Context, currTime time
 		value := redelegationTimesliceIterator.Value()
 		k.cdc.MustUnmarshal(value, &timeslice)
 
+		if limit > 0 && len(matureRedelegations)+len(timeslice.Triplets) > int(limit) {
+			n := int(limit) - len(matureRedelegations)
+			matureRedelegations = append(matureRedelegations, timeslice.Triplets[:n]...)
+			remainderKey, remainder = redelegationTimesliceIterator.Key(), timeslice.Triplets[n:]
+			break
+		}
+
 		matureRedelegations = append(matureRedelegations, timeslice.Triplets...)
 
 		store.Delete(redelegationTimesliceIterator.Key())
 	}
 
+	if remainderKey != nil {
+		store.Set(remainderKey, k.cdc.MustMarshal(&types.DVVTriplets{Triplets: remainder}))
+	}
+
 	return matureRedelegations
 }
 
@@ -665,6 +741,42 @@ func (k Keeper) DequeueAllMatureRedelegationQueue(ctx sdk.Context, currTime time
 func (k Keeper) Delegate(
 	ctx sdk.Context, delAddr sdk.AccAddress, bondAmt math.Int, tokenSrc types.BondStatus,
 	validator types.Validator, subtractAccount bool,
+) (newShares math.LegacyDec, err error) {
+	return k.delegate(ctx, delAddr, bondAmt, "", bondAmt, tokenSrc, validator, subtractAccount)
+}
+
+// DelegateCoin performs a delegation of coins of either the bond denom or an
+// additional bond denom. The validator is credited the tokens weighted by the
+// bond denom weight of the coins.
+func (k Keeper) DelegateCoin(
+	ctx sdk.Context, delAddr sdk.AccAddress, coin sdk.Coin, tokenSrc types.BondStatus,
+	validator types.Validator, subtractAccount bool,
+) (newShares math.LegacyDec, err error) {
+	params := k.GetParams(ctx)
+	if coin.Denom == params.BondDenom {
+		return k.Delegate(ctx, delAddr, coin.Amount, tokenSrc, validator, subtractAccount)
+	}
+
+	weight, found := params.DenomWeight(coin.Denom)
+	if !found {
+		return math.LegacyZeroDec(), errorsmod.Wrapf(
+			sdkerrors.ErrInvalidRequest, "invalid coin denomination: got %s, expected %s or an additional bond denom", coin.Denom, params.BondDenom,
+		)
+	}
+
+	tokens := weight.MulInt(coin.Amount).TruncateInt()
+	if !tokens.IsPositive() {
+		return math.LegacyZeroDec(), errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "delegation amount is too small to be credited any tokens")
+	}
+
+	return k.delegate(ctx, delAddr, tokens, coin.Denom, coin.Amount, tokenSrc, validator, subtractAccount)
+}
+
+// delegate performs a delegation of bondAmt tokens backed by an amount of the
+// given additional bond denom, an empty denom standing for the bond denom.
+func (k Keeper) delegate(
+	ctx sdk.Context, delAddr sdk.AccAddress, bondAmt math.Int, denom string, amount math.Int,
+	tokenSrc types.BondStatus, validator types.Validator, subtractAccount bool,
 ) (newShares math.LegacyDec, err error) {
 	// In some situations, the exchange rate becomes invalid, e.g. if
 	// Validator loses all tokens due to slashing. In this case,
@@ -677,6 +789,14 @@ func (k Keeper) Delegate(
 	delegation, found := k.GetDelegation(ctx, delAddr, validator.GetOperator())
 	if !found {
 		delegation = types.NewDelegation(delAddr, validator.GetOperator(), math.LegacyZeroDec())
+		delegation.Denom = denom
+	}
+
+	// the shares of a delegation are backed by a single denom
+	if delegation.Denom != denom {
+		return math.LegacyZeroDec(), errorsmod.Wrapf(
+			types.ErrDelegationDenomMismatch, "got %q, expected %q", denom, delegation.Denom,
+		)
 	}
 
 	// call the appropriate hook if present
@@ -695,6 +815,15 @@ func (k Keeper) Delegate(
 		panic(err)
 	}
 
+	// delegations of liquid staking providers are bounded by the liquid
+	// staking caps and the validator bond
+	isLiquidStaker := k.DelegatorIsLiquidStaker(delegatorAddress)
+	if isLiquidStaker {
+		if err := k.checkLiquidDelegation(ctx, validator, bondAmt); err != nil {
+			return math.LegacyZeroDec(), err
+		}
+	}
+
 	// if subtractAccount is true then we are
 	// performing a delegation and not a redelegation, thus the source tokens are
 	// all non bonded
@@ -714,7 +843,7 @@ func (k Keeper) Delegate(
 			panic("invalid validator status")
 		}
 
-		coins := sdk.NewCoins(sdk.NewCoin(k.BondDenom(ctx), bondAmt))
+		coins := delegationCoins(k.BondDenom(ctx), denom, amount)
 		if err := k.bankKeeper.DelegateCoinsFromAccountToModule(ctx, delegatorAddress, sendName, coins); err != nil {
 			return math.LegacyDec{}, err
 		}
@@ -727,16 +856,32 @@ func (k Keeper) Delegate(
 			// do nothing
 		case (tokenSrc == types.Unbonded || tokenSrc == types.Unbonding) && validator.IsBonded():
 			// transfer pools
-			k.notBondedTokensToBonded(ctx, bondAmt)
+			k.notBondedCoinsToBonded(ctx, delegationCoins(k.BondDenom(ctx), denom, amount))
 		case tokenSrc == types.Bonded && !validator.IsBonded():
 			// transfer pools
-			k.bondedTokensToNotBonded(ctx, bondAmt)
+			k.bondedCoinsToNotBonded(ctx, delegationCoins(k.BondDenom(ctx), denom, amount))
 		default:
 			panic("unknown token source bond status")
 		}
 	}
 
-	_, newShares = k.AddValidatorTokensAndShares(ctx, validator, bondAmt)
+	if denom != "" {
+		validator = validator.AddAsset(denom, amount, bondAmt)
+	}
+
+	validator, newShares = k.AddValidatorTokensAndShares(ctx, validator, bondAmt)
+
+	// shares added to a validator bond delegation count towards the validator bond
+	if delegation.ValidatorBond {
+		validator.ValidatorBondShares = validator.ValidatorBondShares.Add(newShares)
+		k.SetValidator(ctx, validator)
+	}
+
+	if isLiquidStaker {
+		validator.LiquidShares = validator.LiquidShares.Add(newShares)
+		k.SetValidator(ctx, validator)
+		k.SetTotalLiquidStakedTokens(ctx, k.GetTotalLiquidStakedTokens(ctx).Add(bondAmt))
+	}
 
 	// Update delegation
 	delegation.Shares = delegation.Shares.Add(newShares)
@@ -754,26 +899,36 @@ func (k Keeper) Delegate(
 func (k Keeper) Unbond(
 	ctx sdk.Context, delAddr sdk.AccAddress, valAddr sdk.ValAddress, shares math.LegacyDec,
 ) (amount math.Int, err error) {
+	amount, _, _, err = k.unbond(ctx, delAddr, valAddr, shares)
+	return amount, err
+}
+
+// unbond unbonds a particular delegation and returns, along with the unbonded
+// tokens, the denom of the delegation and the amount of it backing the tokens.
+// An empty denom stands for the bond denom.
+func (k Keeper) unbond(
+	ctx sdk.Context, delAddr sdk.AccAddress, valAddr sdk.ValAddress, shares math.LegacyDec,
+) (amount math.Int, denom string, denomAmount math.Int, err error) {
 	// check if a delegation object exists in the store
 	delegation, found := k.GetDelegation(ctx, delAddr, valAddr)
 	if !found {
-		return amount, types.ErrNoDelegatorForAddress
+		return amount, denom, denomAmount, types.ErrNoDelegatorForAddress
 	}
 
 	// call the before-delegation-modified hook
 	if err := k.Hooks().BeforeDelegationSharesModified(ctx, delAddr, valAddr); err != nil {
-		return amount, err
+		return amount, denom, denomAmount, err
 	}
 
 	// ensure that we have enough shares to remove
 	if delegation.Shares.LT(shares) {
-		return amount, errorsmod.Wrap(types.ErrNotEnoughDelegationShares, delegation.Shares.String())
+		return amount, denom, denomAmount, errorsmod.Wrap(types.ErrNotEnoughDelegationShares, delegation.Shares.String())
 	}
 
 	// get validator
 	validator, found := k.GetValidator(ctx, valAddr)
 	if !found {
-		return amount, types.ErrNoValidatorFound
+		return amount, denom, denomAmount, types.ErrNoValidatorFound
 	}
 
 	// subtract shares from delegation
@@ -781,16 +936,16 @@ func (k Keeper) Unbond(
 
 	delegatorAddress, err := k.authKeeper.StringToBytes(delegation.DelegatorAddress)
 	if err != nil {
-		return amount, err
+		return amount, denom, denomAmount, err
 	}
 
 	isValidatorOperator := bytes.Equal(delegatorAddress, validator.GetOperator())
 
 	// If the delegation is the operator of the validator and undelegating will decrease the validator's
-	// self-delegation below their minimum, we jail the validator.
+	// self-delegation below their minimum, or the chain-wide floor, we jail the validator.
 	if isValidatorOperator && !validator.Jailed &&
-		validator.TokensFromShares(delegation.Shares).TruncateInt().LT(validator.MinSelfDelegation) {
-		k.jailValidator(ctx, validator)
+		validator.TokensFromShares(delegation.Shares).TruncateInt().LT(validator.EffectiveMinSelfDelegation(k.MinSelfDelegationFloor(ctx))) {
+		k.jailValidator(ctx, validator, types.JailReason_JAIL_REASON_SELF_BOND_SHORTFALL, "")
 		validator = k.mustGetValidator(ctx, validator.GetOperator())
 	}
 
@@ -803,18 +958,36 @@ func (k Keeper) Unbond(
 	}
 
 	if err != nil {
-		return amount, err
+		return amount, denom, denomAmount, err
 	}
 
 	// remove the shares and coins from the validator
 	// NOTE that the amount is later (in keeper.Delegation) moved between staking module pools
 	validator, amount = k.RemoveValidatorTokensAndShares(ctx, validator, shares)
+
+	denom, denomAmount = delegation.Denom, amount
+	if denom != "" {
+		validator, denomAmount = validator.RemoveAssetTokens(denom, amount)
+		k.SetValidator(ctx, validator)
+	}
+
+	if delegation.ValidatorBond {
+		validator.ValidatorBondShares = math.LegacyMaxDec(validator.ValidatorBondShares.Sub(shares), math.LegacyZeroDec())
+		k.SetValidator(ctx, validator)
+	}
+
+	if k.DelegatorIsLiquidStaker(delegatorAddress) {
+		validator.LiquidShares = math.LegacyMaxDec(validator.LiquidShares.Sub(shares), math.LegacyZeroDec())
+		k.SetValidator(ctx, validator)
+		k.SetTotalLiquidStakedTokens(ctx, math.MaxInt(k.GetTotalLiquidStakedTokens(ctx).Sub(amount), math.ZeroInt()))
+	}
+
 	if validator.DelegatorShares.IsZero() && validator.IsUnbonded() {
 		// if not unbonded, we must instead remove validator in EndBlocker once it finishes its unbonding period
 		k.RemoveValidator(ctx, validator.GetOperator())
 	}
 
-	return amount, nil
+	return amount, denom, denomAmount, nil
 }
 
 // getBeginInfo returns the completion time and height of a redelegation, along
@@ -828,8 +1001,8 @@ func (k Keeper) getBeginInfo(
 	// TODO: When would the validator not be found?
 	switch {
 	case !found || validator.IsBonded():
-		// the longest wait - just unbonding period from now
-		completionTime = ctx.BlockHeader().Time.Add(k.UnbondingTime(ctx))
+		// the longest wait - just the unbonding period of the validator from now
+		completionTime = ctx.BlockHeader().Time.Add(k.ValidatorUnbondingTime(ctx, valSrcAddr))
 		height = ctx.BlockHeight()
 
 		return completionTime, height, false
@@ -862,18 +1035,26 @@ func (k Keeper) Undelegate(
 		return time.Time{}, math.Int{}, types.ErrMaxUnbondingDelegationEntries
 	}
 
-	returnAmount, err := k.Unbond(ctx, delAddr, valAddr, sharesAmount)
+	if err := k.checkDelegationUnlocked(ctx, delAddr, valAddr); err != nil {
+		return time.Time{}, math.Int{}, err
+	}
+
+	if err := k.checkUnbondValidatorBond(ctx, delAddr, validator, sharesAmount); err != nil {
+		return time.Time{}, math.Int{}, err
+	}
+
+	_, denom, returnAmount, err := k.unbond(ctx, delAddr, valAddr, sharesAmount)
 	if err != nil {
 		return time.Time{}, math.Int{}, err
 	}
 
 	// transfer the validator tokens to the not bonded pool
 	if validator.IsBonded() {
-		k.bondedTokensToNotBonded(ctx, returnAmount)
+		k.bondedCoinsToNotBonded(ctx, delegationCoins(k.BondDenom(ctx), denom, returnAmount))
 	}
 
-	completionTime := ctx.BlockHeader().Time.Add(k.UnbondingTime(ctx))
-	ubd := k.SetUnbondingDelegationEntry(ctx, delAddr, valAddr, ctx.BlockHeight(), completionTime, returnAmount)
+	completionTime := ctx.BlockHeader().Time.Add(k.ValidatorUnbondingTime(ctx, valAddr))
+	ubd := k.SetUnbondingDelegationEntry(ctx, delAddr, valAddr, ctx.BlockHeight(), completionTime, returnAmount, denom)
 	k.InsertUBDQueue(ctx, ubd, completionTime)
 
 	return completionTime, returnAmount, nil
@@ -907,14 +1088,14 @@ func (k Keeper) CompleteUnbonding(ctx sdk.Context, delAddr sdk.AccAddress, valAd
 
 			// track undelegation only when remaining or truncated shares are non-zero
 			if !entry.Balance.IsZero() {
-				amt := sdk.NewCoin(bondDenom, entry.Balance)
+				amt := delegationCoins(bondDenom, entry.Denom, entry.Balance)
 				if err := k.bankKeeper.UndelegateCoinsFromModuleToAccount(
-					ctx, types.NotBondedPoolName, delegatorAddress, sdk.NewCoins(amt),
+					ctx, types.NotBondedPoolName, delegatorAddress, amt,
 				); err != nil {
 					return nil, err
 				}
 
-				balances = balances.Add(amt)
+				balances = balances.Add(amt...)
 			}
 		}
 	}
@@ -957,7 +1138,15 @@ func (k Keeper) BeginRedelegation(
 		return time.Time{}, types.ErrMaxRedelegationEntries
 	}
 
-	returnAmount, err := k.Unbond(ctx, delAddr, valSrcAddr, sharesAmount)
+	if err := k.checkDelegationUnlocked(ctx, delAddr, valSrcAddr); err != nil {
+		return time.Time{}, err
+	}
+
+	if err := k.checkUnbondValidatorBond(ctx, delAddr, srcValidator, sharesAmount); err != nil {
+		return time.Time{}, err
+	}
+
+	returnAmount, denom, denomAmount, err := k.unbond(ctx, delAddr, valSrcAddr, sharesAmount)
 	if err != nil {
 		return time.Time{}, err
 	}
@@ -966,7 +1155,7 @@ func (k Keeper) BeginRedelegation(
 		return time.Time{}, types.ErrTinyRedelegationAmount
 	}
 
-	sharesCreated, err := k.Delegate(ctx, delAddr, returnAmount, srcValidator.GetStatus(), dstValidator, false)
+	sharesCreated, err := k.delegate(ctx, delAddr, returnAmount, denom, denomAmount, srcValidator.GetStatus(), dstValidator, false)
 	if err != nil {
 		return time.Time{}, err
 	}
@@ -1026,6 +1215,153 @@ func (k Keeper) CompleteRedelegation(
 	return balances, nil
 }
 
+// CancelRedelegation cancels amount of the in-progress redelegation entry
+// created at creationHeight and delegates the corresponding destination
+// shares back to the source validator. The entry is removed from the
+// redelegation and the redelegation queue once its whole initial balance is
+// canceled.
+func (k Keeper) CancelRedelegation(
+	ctx sdk.Context, delAddr sdk.AccAddress, valSrcAddr, valDstAddr sdk.ValAddress, creationHeight int64, amount math.Int,
+) error {
+	srcValidator, found := k.GetValidator(ctx, valSrcAddr)
+	if !found {
+		return types.ErrNoValidatorFound
+	}
+
+	// In some situations, the exchange rate becomes invalid, e.g. if
+	// Validator loses all tokens due to slashing. In this case,
+	// make all future delegations invalid.
+	if srcValidator.InvalidExRate() {
+		return types.ErrDelegatorShareExRateInvalid
+	}
+
+	if srcValidator.IsJailed() {
+		return types.ErrValidatorJailed
+	}
+
+	dstValidator, found := k.GetValidator(ctx, valDstAddr)
+	if !found {
+		return types.ErrBadRedelegationDst
+	}
+
+	red, found := k.GetRedelegation(ctx, delAddr, valSrcAddr, valDstAddr)
+	if !found {
+		return types.ErrNoRedelegation
+	}
+
+	var (
+		entry      types.RedelegationEntry
+		entryIndex int64 = -1
+	)
+
+	for i, e := range red.Entries {
+		if e.CreationHeight == creationHeight {
+			entry = e
+			entryIndex = int64(i)
+			break
+		}
+	}
+	if entryIndex == -1 {
+		return sdkerrors.ErrNotFound.Wrapf("redelegation entry is not found at block height %d", creationHeight)
+	}
+
+	if entry.InitialBalance.LT(amount) {
+		return sdkerrors.ErrInvalidRequest.Wrap("amount is greater than the redelegation entry balance")
+	}
+
+	if entry.IsMature(ctx.BlockTime()) {
+		return sdkerrors.ErrInvalidRequest.Wrap("redelegation is already processed")
+	}
+
+	if entry.OnHold() {
+		return sdkerrors.ErrInvalidRequest.Wrap("redelegation entry is on hold")
+	}
+
+	// the canceled destination shares are proportional to the canceled part of
+	// the entry
+	cancelAll := amount.Equal(entry.InitialBalance)
+	shares := entry.SharesDst
+	if !cancelAll {
+		shares = entry.SharesDst.MulInt(amount).QuoInt(entry.InitialBalance)
+	}
+
+	if err := k.checkDelegationUnlocked(ctx, delAddr, valDstAddr); err != nil {
+		return err
+	}
+
+	if err := k.checkUnbondValidatorBond(ctx, delAddr, dstValidator, shares); err != nil {
+		return err
+	}
+
+	returnAmount, denom, denomAmount, err := k.unbond(ctx, delAddr, valDstAddr, shares)
+	if err != nil {
+		return err
+	}
+
+	if returnAmount.IsZero() {
+		return types.ErrTinyRedelegationAmount
+	}
+
+	if _, err := k.delegate(ctx, delAddr, returnAmount, denom, denomAmount, dstValidator.GetStatus(), srcValidator, false); err != nil {
+		return err
+	}
+
+	if cancelAll {
+		red.RemoveEntry(entryIndex)
+		k.RemoveRedelegationFromQueue(ctx, red, entry.CompletionTime)
+		k.DeleteUnbondingIndex(ctx, entry.UnbondingId)
+
+		if err := k.Hooks().AfterUnbondingCanceled(ctx, entry.UnbondingId); err != nil {
+			k.Logger(ctx).Error("failed to call after unbonding canceled hook", "error", err)
+		}
+	} else {
+		entry.InitialBalance = entry.InitialBalance.Sub(amount)
+		entry.SharesDst = entry.SharesDst.Sub(shares)
+		red.Entries[entryIndex] = entry
+	}
+
+	// set the redelegation or remove it if there are no more entries
+	if len(red.Entries) == 0 {
+		k.RemoveRedelegation(ctx, red)
+	} else {
+		k.SetRedelegation(ctx, red)
+	}
+
+	return nil
+}
+
+// DelegationTokens converts an amount of the denom of a delegation, as given by
+// an unbonding or a redelegation, to staking tokens by applying the bond denom
+// weight of the denom. An error is returned if the denom is not the one of
+// the delegation, or the bond denom when there is no such delegation.
+func (k Keeper) DelegationTokens(
+	ctx sdk.Context, delAddr sdk.AccAddress, valAddr sdk.ValAddress, amt sdk.Coin,
+) (math.Int, error) {
+	params := k.GetParams(ctx)
+
+	denom := params.BondDenom
+	if del, found := k.GetDelegation(ctx, delAddr, valAddr); found && del.Denom != "" {
+		denom = del.Denom
+	}
+
+	if amt.Denom != denom {
+		return math.Int{}, errorsmod.Wrapf(
+			sdkerrors.ErrInvalidRequest, "invalid coin denomination: got %s, expected %s", amt.Denom, denom,
+		)
+	}
+
+	if denom == params.BondDenom {
+		return amt.Amount, nil
+	}
+
+	weight, found := params.DenomWeight(denom)
+	if !found {
+		return math.Int{}, errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "denom %s can no longer be bonded", denom)
+	}
+
+	return weight.MulInt(amt.Amount).TruncateInt(), nil
+}
+
 // ValidateUnbondAmount validates that a given unbond or redelegation amount is
 // valied based on upon the converted shares. If the amount is valid, the total
 // amount of respective shares is returned, otherwise an error is returned.
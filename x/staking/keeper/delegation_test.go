@@ -1063,3 +1063,106 @@ func (s *KeeperTestSuite) TestRedelegateFromUnbondedValidator() {
 	red, found := keeper.GetRedelegation(ctx, addrDels[0], addrVals[0], addrVals[1])
 	require.False(found, "%v", red)
 }
+
+func (s *KeeperTestSuite) TestDelegateCoinInAdditionalBondDenom() {
+	ctx, keeper := s.ctx, s.stakingKeeper
+	require := s.Require()
+
+	params := keeper.GetParams(ctx)
+	params.BondDenomWeights = []stakingtypes.BondDenomWeight{{Denom: "uatom", Weight: math.LegacyNewDec(2)}}
+	require.NoError(keeper.SetParams(ctx, params))
+
+	delAddrs, valAddrs := createValAddrs(1)
+	s.accountKeeper.EXPECT().StringToBytes(delAddrs[0].String()).Return(delAddrs[0], nil).AnyTimes()
+	s.accountKeeper.EXPECT().BytesToString(delAddrs[0]).Return(delAddrs[0].String(), nil).AnyTimes()
+
+	validator := testutil.NewValidator(s.T(), valAddrs[0], PKs[0])
+	keeper.SetValidator(ctx, validator)
+
+	// a denom which is neither the bond denom nor an additional bond denom
+	// cannot be delegated
+	_, err := keeper.DelegateCoin(ctx, delAddrs[0], sdk.NewInt64Coin("uosmo", 100), stakingtypes.Unbonded, validator, true)
+	require.Error(err)
+
+	coin := sdk.NewInt64Coin("uatom", 100)
+	s.bankKeeper.EXPECT().DelegateCoinsFromAccountToModule(gomock.Any(), delAddrs[0], stakingtypes.NotBondedPoolName, sdk.NewCoins(coin)).Return(nil)
+	shares, err := keeper.DelegateCoin(ctx, delAddrs[0], coin, stakingtypes.Unbonded, validator, true)
+	require.NoError(err)
+	require.Equal(math.LegacyNewDec(200), shares)
+
+	// the validator is credited the weighted tokens
+	validator, found := keeper.GetValidator(ctx, valAddrs[0])
+	require.True(found)
+	require.Equal(math.NewInt(200), validator.Tokens)
+	require.Equal([]stakingtypes.ValidatorAsset{{Denom: "uatom", Amount: math.NewInt(100), Tokens: math.NewInt(200)}}, validator.Assets)
+	require.True(validator.BondDenomTokens().IsZero())
+
+	delegation, found := keeper.GetDelegation(ctx, delAddrs[0], valAddrs[0])
+	require.True(found)
+	require.Equal("uatom", delegation.Denom)
+
+	// the delegation cannot be topped up in another denom
+	_, err = keeper.Delegate(ctx, delAddrs[0], math.NewInt(100), stakingtypes.Unbonded, validator, true)
+	require.ErrorIs(err, stakingtypes.ErrDelegationDenomMismatch)
+
+	// the amounts of an unbonding are given in the denom of the delegation
+	tokens, err := keeper.DelegationTokens(ctx, delAddrs[0], valAddrs[0], sdk.NewInt64Coin("uatom", 30))
+	require.NoError(err)
+	require.Equal(math.NewInt(60), tokens)
+
+	_, err = keeper.DelegationTokens(ctx, delAddrs[0], valAddrs[0], sdk.NewInt64Coin(sdk.DefaultBondDenom, 30))
+	require.Error(err)
+
+	_, amount, err := keeper.Undelegate(ctx, delAddrs[0], valAddrs[0], math.LegacyNewDecFromInt(tokens))
+	require.NoError(err)
+	require.Equal(math.NewInt(30), amount)
+
+	ubd, found := keeper.GetUnbondingDelegation(ctx, delAddrs[0], valAddrs[0])
+	require.True(found)
+	require.Len(ubd.Entries, 1)
+	require.Equal("uatom", ubd.Entries[0].Denom)
+	require.Equal(math.NewInt(30), ubd.Entries[0].Balance)
+
+	validator, found = keeper.GetValidator(ctx, valAddrs[0])
+	require.True(found)
+	require.Equal(math.NewInt(140), validator.Tokens)
+	require.Equal([]stakingtypes.ValidatorAsset{{Denom: "uatom", Amount: math.NewInt(70), Tokens: math.NewInt(140)}}, validator.Assets)
+}
+
+// tests that DequeueMatureUBDQueue and DequeueMatureRedelegationQueue carry
+// the mature entries beyond the limit over to the following calls
+func (s *KeeperTestSuite) TestDequeueMatureQueuesWithLimit() {
+	ctx, keeper := s.ctx, s.stakingKeeper
+	require := s.Require()
+
+	delAddrs, valAddrs := createValAddrs(3)
+	completionTimes := []time.Time{time.Unix(10, 0).UTC(), time.Unix(10, 0).UTC(), time.Unix(20, 0).UTC()}
+	ctx = ctx.WithBlockTime(time.Unix(30, 0).UTC())
+
+	var (
+		expUnbonds       []stakingtypes.DVPair
+		expRedelegations []stakingtypes.DVVTriplet
+	)
+	for i, completionTime := range completionTimes {
+		ubd := stakingtypes.NewUnbondingDelegation(delAddrs[i], valAddrs[0], 0, completionTime, math.NewInt(5), 0)
+		keeper.InsertUBDQueue(ctx, ubd, completionTime)
+		expUnbonds = append(expUnbonds, stakingtypes.DVPair{DelegatorAddress: ubd.DelegatorAddress, ValidatorAddress: ubd.ValidatorAddress})
+
+		red := stakingtypes.NewRedelegation(delAddrs[i], valAddrs[0], valAddrs[1], 0, completionTime, math.NewInt(5), math.LegacyNewDec(5), 0)
+		keeper.InsertRedelegationQueue(ctx, red, completionTime)
+		expRedelegations = append(expRedelegations, stakingtypes.DVVTriplet{
+			DelegatorAddress:    red.DelegatorAddress,
+			ValidatorSrcAddress: red.ValidatorSrcAddress,
+			ValidatorDstAddress: red.ValidatorDstAddress,
+		})
+	}
+
+	// the first timeslice is split and its remaining entry dequeued first
+	require.Equal(expUnbonds[:1], keeper.DequeueMatureUBDQueue(ctx, ctx.BlockTime(), 1))
+	require.Equal(expUnbonds[1:], keeper.DequeueMatureUBDQueue(ctx, ctx.BlockTime(), 2))
+	require.Empty(keeper.DequeueAllMatureUBDQueue(ctx, ctx.BlockTime()))
+
+	require.Equal(expRedelegations[:1], keeper.DequeueMatureRedelegationQueue(ctx, ctx.BlockTime(), 1))
+	require.Equal(expRedelegations[1:], keeper.DequeueMatureRedelegationQueue(ctx, ctx.BlockTime(), 2))
+	require.Empty(keeper.DequeueAllMatureRedelegationQueue(ctx, ctx.BlockTime()))
+}
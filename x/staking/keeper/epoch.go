@@ -0,0 +1,158 @@
+package keeper
+
+import (
+	"fmt"
+	"strconv"
+
+	storetypes "cosmossdk.io/store/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/staking/types"
+)
+
+// GetLastEpochMsgID returns the id of the last staking message queued until
+// the end of the epoch.
+func (k Keeper) GetLastEpochMsgID(ctx sdk.Context) uint64 {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.LastEpochMsgIDKey)
+	if bz == nil {
+		return 0
+	}
+
+	return sdk.BigEndianToUint64(bz)
+}
+
+// SetLastEpochMsgID sets the id of the last staking message queued until the
+// end of the epoch.
+func (k Keeper) SetLastEpochMsgID(ctx sdk.Context, id uint64) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.LastEpochMsgIDKey, sdk.Uint64ToBigEndian(id))
+}
+
+// SetQueuedEpochMsg sets a staking message queued until the end of the epoch.
+func (k Keeper) SetQueuedEpochMsg(ctx sdk.Context, msg types.QueuedEpochMsg) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.GetEpochMsgKey(msg.Id), k.cdc.MustMarshal(&msg))
+}
+
+// GetAllQueuedEpochMsgs returns all the staking messages queued until the end
+// of the epoch, in queue order.
+func (k Keeper) GetAllQueuedEpochMsgs(ctx sdk.Context) (msgs []types.QueuedEpochMsg) {
+	store := ctx.KVStore(k.storeKey)
+	iterator := storetypes.KVStorePrefixIterator(store, types.EpochMsgQueueKey)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var msg types.QueuedEpochMsg
+		k.cdc.MustUnmarshal(iterator.Value(), &msg)
+		msgs = append(msgs, msg)
+	}
+
+	return msgs
+}
+
+// NextEpochHeight returns the height of the block at the end of which the
+// staking messages queued in the current block are applied.
+func (k Keeper) NextEpochHeight(ctx sdk.Context) int64 {
+	return types.NextEpochHeight(ctx.BlockHeight(), k.EpochLength(ctx))
+}
+
+// ApplyEpochQueue applies the queued staking messages, in queue order, at the
+// end of the last block of an epoch. The messages queued while epochs were
+// enabled are applied at the end of the next block once they are disabled, and
+// the messages stay queued while staking is paused. A message which fails is
+// dropped without affecting the other messages.
+func (k *Keeper) ApplyEpochQueue(ctx sdk.Context) {
+	if k.IsStakingPaused(ctx) {
+		return
+	}
+
+	epochLength := k.EpochLength(ctx)
+	if epochLength > 0 && ctx.BlockHeight()%int64(epochLength) != 0 {
+		return
+	}
+
+	msgs := k.GetAllQueuedEpochMsgs(ctx)
+	if len(msgs) == 0 {
+		return
+	}
+
+	store := ctx.KVStore(k.storeKey)
+	ms := msgServer{Keeper: k}
+	for _, queued := range msgs {
+		store.Delete(types.GetEpochMsgKey(queued.Id))
+
+		msg, err := queued.SdkMsg()
+		if err == nil {
+			cacheCtx, write := ctx.CacheContext()
+			if err = ms.executeEpochMsg(cacheCtx, msg); err == nil {
+				write()
+				continue
+			}
+		}
+
+		k.Logger(ctx).Error("failed to apply queued epoch message", "id", queued.Id, "error", err)
+		ctx.EventManager().EmitEvent(
+			sdk.NewEvent(
+				types.EventTypeEpochMsgFailed,
+				sdk.NewAttribute(types.AttributeKeyEpochMsgID, strconv.FormatUint(queued.Id, 10)),
+				sdk.NewAttribute(types.AttributeKeyEpochMsgType, queued.Msg.GetTypeUrl()),
+				sdk.NewAttribute(types.AttributeKeyError, err.Error()),
+			),
+		)
+	}
+}
+
+// queueEpochMsg queues a staking message until the end of the epoch. The
+// message is executed against a branch of the state which is discarded, so
+// that a message failing at the time it is sent is rejected right away. It
+// can still fail at the end of the epoch, e.g. if the delegator spent the
+// coins in the meantime.
+func (k msgServer) queueEpochMsg(ctx sdk.Context, msg sdk.Msg) error {
+	cacheCtx, _ := ctx.CacheContext()
+	if err := k.executeEpochMsg(cacheCtx, msg); err != nil {
+		return err
+	}
+
+	id := k.GetLastEpochMsgID(ctx) + 1
+	queued, err := types.NewQueuedEpochMsg(id, msg)
+	if err != nil {
+		return err
+	}
+
+	k.SetQueuedEpochMsg(ctx, queued)
+	k.SetLastEpochMsgID(ctx, id)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeEpochMsgQueued,
+			sdk.NewAttribute(types.AttributeKeyEpochMsgID, strconv.FormatUint(id, 10)),
+			sdk.NewAttribute(types.AttributeKeyEpochMsgType, sdk.MsgTypeURL(msg)),
+			sdk.NewAttribute(types.AttributeKeyEpochHeight, strconv.FormatInt(k.NextEpochHeight(ctx), 10)),
+		),
+	)
+
+	return nil
+}
+
+// executeEpochMsg executes a staking message queued until the end of the
+// epoch.
+func (k msgServer) executeEpochMsg(ctx sdk.Context, msg sdk.Msg) error {
+	var err error
+	switch msg := msg.(type) {
+	case *types.MsgDelegate:
+		_, err = k.delegate(ctx, msg)
+	case *types.MsgUndelegate:
+		_, err = k.undelegate(ctx, msg)
+	case *types.MsgBeginRedelegate:
+		_, err = k.beginRedelegate(ctx, msg)
+	case *types.MsgRedelegateMulti:
+		_, err = k.redelegateMulti(ctx, msg)
+	case *types.MsgInstantUndelegate:
+		_, err = k.instantUndelegate(ctx, msg)
+	default:
+		err = fmt.Errorf("unexpected epoch message type: %T", msg)
+	}
+
+	return err
+}
@@ -0,0 +1,94 @@
+package keeper_test
+
+import (
+	sdkmath "cosmossdk.io/math"
+
+	"github.com/cosmos/cosmos-sdk/crypto/keys/ed25519"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+)
+
+func (s *KeeperTestSuite) TestEpochQueue() {
+	ctx, keeper, msgServer := s.ctx, s.stakingKeeper, s.msgServer
+	require := s.Require()
+	s.execExpectCalls()
+
+	comm := stakingtypes.NewCommissionRates(sdkmath.LegacyNewDec(0), sdkmath.LegacyNewDec(0), sdkmath.LegacyNewDec(0))
+	createMsg, err := stakingtypes.NewMsgCreateValidator(ValAddr, ed25519.GenPrivKey().PubKey(), sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(10)), stakingtypes.Description{Moniker: "NewVal"}, comm, sdkmath.OneInt())
+	require.NoError(err)
+	_, err = msgServer.CreateValidator(ctx, createMsg)
+	require.NoError(err)
+
+	params := keeper.GetParams(ctx)
+	params.EpochLength = 10
+	require.NoError(keeper.SetParams(ctx, params))
+	ctx = ctx.WithBlockHeight(5)
+
+	// a message which fails is rejected right away
+	_, err = msgServer.Delegate(ctx, stakingtypes.NewMsgDelegate(Addr, sdk.ValAddress([]byte("val")), sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(100))))
+	require.ErrorIs(err, stakingtypes.ErrNoValidatorFound)
+	require.Empty(keeper.GetAllQueuedEpochMsgs(ctx))
+
+	// a valid message is queued without being applied
+	amt := sdk.NewCoin(sdk.DefaultBondDenom, keeper.TokensFromConsensusPower(ctx, 100))
+	_, err = msgServer.Delegate(ctx, stakingtypes.NewMsgDelegate(Addr, ValAddr, amt))
+	require.NoError(err)
+
+	validator, found := keeper.GetValidator(ctx, ValAddr)
+	require.True(found)
+	require.Equal(sdk.NewInt(10), validator.Tokens)
+
+	res, err := s.queryClient.EpochQueue(ctx, &stakingtypes.QueryEpochQueueRequest{})
+	require.NoError(err)
+	require.Len(res.Msgs, 1)
+	require.Equal(uint64(1), res.Msgs[0].Id)
+	require.Equal(int64(10), res.NextEpochHeight)
+
+	// a queued message failing at the end of the epoch is dropped without
+	// affecting the others
+	failing, err := stakingtypes.NewQueuedEpochMsg(2, stakingtypes.NewMsgDelegate(Addr, sdk.ValAddress([]byte("val")), amt))
+	require.NoError(err)
+	keeper.SetQueuedEpochMsg(ctx, failing)
+	keeper.SetLastEpochMsgID(ctx, 2)
+
+	// the messages are not applied before the end of the epoch
+	keeper.ApplyEpochQueue(ctx.WithBlockHeight(9))
+	require.Len(keeper.GetAllQueuedEpochMsgs(ctx), 2)
+
+	epochCtx := ctx.WithBlockHeight(10).WithEventManager(sdk.NewEventManager())
+	keeper.ApplyEpochQueue(epochCtx)
+	require.Empty(keeper.GetAllQueuedEpochMsgs(ctx))
+
+	validator, found = keeper.GetValidator(ctx, ValAddr)
+	require.True(found)
+	require.Equal(sdk.NewInt(10).Add(amt.Amount), validator.Tokens)
+
+	var failed int
+	for _, event := range epochCtx.EventManager().Events() {
+		if event.Type == stakingtypes.EventTypeEpochMsgFailed {
+			failed++
+		}
+	}
+	require.Equal(1, failed)
+
+	// the messages queued while epochs were enabled are applied at the end of
+	// the next block once they are disabled
+	_, err = msgServer.Delegate(ctx, stakingtypes.NewMsgDelegate(Addr, ValAddr, amt))
+	require.NoError(err)
+	require.Len(keeper.GetAllQueuedEpochMsgs(ctx), 1)
+	require.Equal(uint64(3), keeper.GetLastEpochMsgID(ctx))
+
+	params.EpochLength = 0
+	require.NoError(keeper.SetParams(ctx, params))
+	keeper.ApplyEpochQueue(ctx.WithBlockHeight(11))
+	require.Empty(keeper.GetAllQueuedEpochMsgs(ctx))
+}
+
+func (s *KeeperTestSuite) TestNextEpochHeight() {
+	require := s.Require()
+
+	require.Equal(int64(5), stakingtypes.NextEpochHeight(5, 0))
+	require.Equal(int64(10), stakingtypes.NextEpochHeight(1, 10))
+	require.Equal(int64(10), stakingtypes.NextEpochHeight(10, 10))
+	require.Equal(int64(20), stakingtypes.NextEpochHeight(11, 10))
+}
@@ -5,8 +5,6 @@ import (
 
 	abci "github.com/cometbft/cometbft/abci/types"
 
-	"cosmossdk.io/math"
-
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/x/staking/types"
 )
@@ -17,8 +15,8 @@ import (
 // data. Finally, it updates the bonded validators.
 // Returns final validator set after applying all declaration and delegations
 func (k Keeper) InitGenesis(ctx sdk.Context, data *types.GenesisState) (res []abci.ValidatorUpdate) {
-	bondedTokens := math.ZeroInt()
-	notBondedTokens := math.ZeroInt()
+	bondedCoins := sdk.NewCoins()
+	notBondedCoins := sdk.NewCoins()
 
 	// We need to pretend to be "n blocks before genesis", where "n" is the
 	// validator update delay, so that e.g. slashing periods are correctly
@@ -53,10 +51,10 @@ func (k Keeper) InitGenesis(ctx sdk.Context, data *types.GenesisState) (res []ab
 
 		switch validator.GetStatus() {
 		case types.Bonded:
-			bondedTokens = bondedTokens.Add(validator.GetTokens())
+			bondedCoins = bondedCoins.Add(validator.StakedCoins(data.Params.BondDenom)...)
 
 		case types.Unbonding, types.Unbonded:
-			notBondedTokens = notBondedTokens.Add(validator.GetTokens())
+			notBondedCoins = notBondedCoins.Add(validator.StakedCoins(data.Params.BondDenom)...)
 
 		default:
 			panic("invalid validator status")
@@ -91,7 +89,7 @@ func (k Keeper) InitGenesis(ctx sdk.Context, data *types.GenesisState) (res []ab
 
 		for _, entry := range ubd.Entries {
 			k.InsertUBDQueue(ctx, ubd, entry.CompletionTime)
-			notBondedTokens = notBondedTokens.Add(entry.Balance)
+			notBondedCoins = notBondedCoins.Add(delegationCoins(data.Params.BondDenom, entry.Denom, entry.Balance)...)
 		}
 	}
 
@@ -103,8 +101,63 @@ func (k Keeper) InitGenesis(ctx sdk.Context, data *types.GenesisState) (res []ab
 		}
 	}
 
-	bondedCoins := sdk.NewCoins(sdk.NewCoin(data.Params.BondDenom, bondedTokens))
-	notBondedCoins := sdk.NewCoins(sdk.NewCoin(data.Params.BondDenom, notBondedTokens))
+	for _, record := range data.TokenizeShareRecords {
+		if err := k.AddTokenizeShareRecord(ctx, record); err != nil {
+			panic(err)
+		}
+	}
+
+	k.SetLastTokenizeShareRecordID(ctx, data.LastTokenizeShareRecordId)
+	k.SetTotalLiquidStakedTokens(ctx, data.TotalLiquidStakedTokens)
+
+	for _, change := range data.PendingCommissionChanges {
+		k.SetPendingCommissionChange(ctx, change)
+	}
+
+	var lastEpochMsgID uint64
+	for _, msg := range data.QueuedEpochMsgs {
+		k.SetQueuedEpochMsg(ctx, msg)
+		if msg.Id > lastEpochMsgID {
+			lastEpochMsgID = msg.Id
+		}
+	}
+	k.SetLastEpochMsgID(ctx, lastEpochMsgID)
+
+	for _, lock := range data.DelegationLocks {
+		k.SetDelegationLock(ctx, lock)
+	}
+
+	for _, tier := range data.ValidatorRiskTiers {
+		valAddr, err := sdk.ValAddressFromBech32(tier.ValidatorAddress)
+		if err != nil {
+			panic(err)
+		}
+		k.SetValidatorRiskTier(ctx, valAddr, tier.Tier)
+	}
+
+	for _, info := range data.ValidatorJailInfos {
+		k.SetValidatorJailInfo(ctx, info)
+	}
+
+	k.SetStakingPaused(ctx, data.StakingPaused)
+
+	for _, attestation := range data.ProfileAttestations {
+		k.SetProfileAttestation(ctx, attestation)
+	}
+
+	for _, standby := range data.StandbyConsPubkeys {
+		valAddr, err := sdk.ValAddressFromBech32(standby.ValidatorAddress)
+		if err != nil {
+			panic(err)
+		}
+		pk, err := standby.ConsPubKey()
+		if err != nil {
+			panic(err)
+		}
+		if err := k.SetStandbyConsPubKey(ctx, valAddr, pk); err != nil {
+			panic(err)
+		}
+	}
 
 	// check if the unbonded and bonded pools accounts exists
 	bondedPool := k.GetBondedPool(ctx)
@@ -197,13 +250,24 @@ func (k Keeper) ExportGenesis(ctx sdk.Context) *types.GenesisState {
 	})
 
 	return &types.GenesisState{
-		Params:               k.GetParams(ctx),
-		LastTotalPower:       k.GetLastTotalPower(ctx),
-		LastValidatorPowers:  lastValidatorPowers,
-		Validators:           k.GetAllValidators(ctx),
-		Delegations:          k.GetAllDelegations(ctx),
-		UnbondingDelegations: unbondingDelegations,
-		Redelegations:        redelegations,
-		Exported:             true,
+		Params:                    k.GetParams(ctx),
+		LastTotalPower:            k.GetLastTotalPower(ctx),
+		LastValidatorPowers:       lastValidatorPowers,
+		Validators:                k.GetAllValidators(ctx),
+		Delegations:               k.GetAllDelegations(ctx),
+		UnbondingDelegations:      unbondingDelegations,
+		Redelegations:             redelegations,
+		Exported:                  true,
+		TokenizeShareRecords:      k.GetAllTokenizeShareRecords(ctx),
+		LastTokenizeShareRecordId: k.GetLastTokenizeShareRecordID(ctx),
+		TotalLiquidStakedTokens:   k.GetTotalLiquidStakedTokens(ctx),
+		PendingCommissionChanges:  k.GetAllPendingCommissionChanges(ctx),
+		QueuedEpochMsgs:           k.GetAllQueuedEpochMsgs(ctx),
+		DelegationLocks:           k.GetAllDelegationLocks(ctx),
+		ValidatorRiskTiers:        k.GetAllValidatorRiskTiers(ctx),
+		ValidatorJailInfos:        k.GetAllValidatorJailInfos(ctx),
+		StakingPaused:             k.IsStakingPaused(ctx),
+		ProfileAttestations:       k.GetAllProfileAttestations(ctx),
+		StandbyConsPubkeys:        k.GetAllStandbyConsPubKeys(ctx),
 	}
 }
@@ -7,6 +7,7 @@ import (
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 
+	"cosmossdk.io/math"
 	"cosmossdk.io/store/prefix"
 	storetypes "cosmossdk.io/store/types"
 
@@ -84,7 +85,12 @@ func (k Querier) Validator(c context.Context, req *types.QueryValidatorRequest)
 		return nil, status.Errorf(codes.NotFound, "validator %s not found", req.ValidatorAddr)
 	}
 
-	return &types.QueryValidatorResponse{Validator: validator}, nil
+	res := &types.QueryValidatorResponse{Validator: validator}
+	if info, found := k.GetValidatorJailInfo(ctx, valAddr); found {
+		res.JailInfo = &info
+	}
+
+	return res, nil
 }
 
 // ValidatorDelegations queries delegate info for given validator
@@ -585,11 +591,19 @@ func DelegationToDelegationResponse(ctx sdk.Context, k *Keeper, del types.Delega
 		return types.DelegationResponse{}, err
 	}
 
+	balance := sdk.NewCoin(k.BondDenom(ctx), val.TokensFromShares(del.Shares).TruncateInt())
+
+	// the balance of a delegation in an additional bond denom is given in
+	// that denom
+	if del.Denom != "" {
+		balance = sdk.NewCoin(del.Denom, val.AssetAmountFromTokens(del.Denom, balance.Amount))
+	}
+
 	return types.NewDelegationResp(
 		delegatorAddress,
 		del.GetValidatorAddr(),
 		del.Shares,
-		sdk.NewCoin(k.BondDenom(ctx), val.TokensFromShares(del.Shares).TruncateInt()),
+		balance,
 	), nil
 }
 
@@ -653,3 +667,351 @@ func RedelegationsToRedelegationResponses(ctx sdk.Context, k *Keeper, redels typ
 
 	return resp, nil
 }
+
+// TokenizeShareRecord queries a tokenize share record by its id
+func (k Querier) TokenizeShareRecord(c context.Context, req *types.QueryTokenizeShareRecordRequest) (*types.QueryTokenizeShareRecordResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	record, found := k.GetTokenizeShareRecord(ctx, req.Id)
+	if !found {
+		return nil, status.Errorf(codes.NotFound, "tokenize share record %d not found", req.Id)
+	}
+
+	return &types.QueryTokenizeShareRecordResponse{Record: record}, nil
+}
+
+// TokenizeShareRecordsOwned queries the tokenize share records owned by an address
+func (k Querier) TokenizeShareRecordsOwned(c context.Context, req *types.QueryTokenizeShareRecordsOwnedRequest) (*types.QueryTokenizeShareRecordsOwnedResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	owner, err := k.authKeeper.StringToBytes(req.Owner)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	records := k.GetTokenizeShareRecordsByOwner(ctx, owner)
+
+	return &types.QueryTokenizeShareRecordsOwnedResponse{Records: records}, nil
+}
+
+// TotalLiquidStaked queries the total amount of liquid staked tokens
+func (k Querier) TotalLiquidStaked(c context.Context, _ *types.QueryTotalLiquidStakedRequest) (*types.QueryTotalLiquidStakedResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+
+	return &types.QueryTotalLiquidStakedResponse{Tokens: k.GetTotalLiquidStakedTokens(ctx)}, nil
+}
+
+// PendingCommissionChange queries the commission rate change announced by a
+// validator which has not taken effect yet
+func (k Querier) PendingCommissionChange(c context.Context, req *types.QueryPendingCommissionChangeRequest) (*types.QueryPendingCommissionChangeResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	if req.ValidatorAddr == "" {
+		return nil, status.Error(codes.InvalidArgument, "validator address cannot be empty")
+	}
+
+	valAddr, err := sdk.ValAddressFromBech32(req.ValidatorAddr)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	change, found := k.GetPendingCommissionChange(ctx, valAddr)
+	if !found {
+		return nil, status.Errorf(codes.NotFound, "pending commission change of validator %s not found", req.ValidatorAddr)
+	}
+
+	return &types.QueryPendingCommissionChangeResponse{PendingCommissionChange: change}, nil
+}
+
+// ValidatorsNearMinSelfDelegation queries the validators whose self delegation
+// is within the given margin above their effective minimum self delegation, or
+// below it
+func (k Querier) ValidatorsNearMinSelfDelegation(c context.Context, req *types.QueryValidatorsNearMinSelfDelegationRequest) (*types.QueryValidatorsNearMinSelfDelegationResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	margin := req.Margin
+	if margin.IsNil() {
+		margin = math.LegacyZeroDec()
+	}
+	if margin.IsNegative() {
+		return nil, status.Errorf(codes.InvalidArgument, "margin cannot be negative: %s", margin)
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	floor := k.MinSelfDelegationFloor(ctx)
+
+	store := ctx.KVStore(k.storeKey)
+	valStore := prefix.NewStore(store, types.ValidatorsKey)
+
+	validators, pageRes, err := query.GenericFilteredPaginate(k.cdc, valStore, req.Pagination, func(key []byte, val *types.Validator) (*types.ValidatorSelfDelegation, error) {
+		minSelfDelegation := val.EffectiveMinSelfDelegation(floor)
+		selfDelegation := k.ValidatorSelfDelegation(ctx, *val)
+
+		// the validators at or above their minimum are only returned when
+		// within the margin
+		if selfDelegation.GTE(minSelfDelegation) {
+			threshold := math.LegacyNewDecFromInt(minSelfDelegation).Mul(math.LegacyOneDec().Add(margin))
+			if margin.IsZero() || math.LegacyNewDecFromInt(selfDelegation).GT(threshold) {
+				return nil, nil
+			}
+		}
+
+		return &types.ValidatorSelfDelegation{
+			ValidatorAddress:  val.OperatorAddress,
+			Jailed:            val.Jailed,
+			SelfDelegation:    selfDelegation,
+			MinSelfDelegation: minSelfDelegation,
+		}, nil
+	}, func() *types.Validator {
+		return &types.Validator{}
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	res := make([]types.ValidatorSelfDelegation, 0, len(validators))
+	for _, val := range validators {
+		res = append(res, *val)
+	}
+
+	return &types.QueryValidatorsNearMinSelfDelegationResponse{Validators: res, Pagination: pageRes}, nil
+}
+
+// EpochQueue queries the staking messages queued until the end of the current
+// epoch
+func (k Querier) EpochQueue(c context.Context, req *types.QueryEpochQueueRequest) (*types.QueryEpochQueueResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	store := ctx.KVStore(k.storeKey)
+	queueStore := prefix.NewStore(store, types.EpochMsgQueueKey)
+
+	var msgs []types.QueuedEpochMsg
+	pageRes, err := query.Paginate(queueStore, req.Pagination, func(_, value []byte) error {
+		var msg types.QueuedEpochMsg
+		if err := k.cdc.Unmarshal(value, &msg); err != nil {
+			return err
+		}
+
+		msgs = append(msgs, msg)
+		return nil
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	// queries are served from the state of the last committed block, so the
+	// messages queued from now on are applied at the end of the next epoch
+	// ending after it
+	nextEpochHeight := types.NextEpochHeight(ctx.BlockHeight()+1, k.EpochLength(ctx))
+
+	return &types.QueryEpochQueueResponse{Msgs: msgs, NextEpochHeight: nextEpochHeight, Pagination: pageRes}, nil
+}
+
+// DelegationLock queries the lock of a delegation
+func (k Querier) DelegationLock(c context.Context, req *types.QueryDelegationLockRequest) (*types.QueryDelegationLockResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	if req.DelegatorAddr == "" {
+		return nil, status.Error(codes.InvalidArgument, "delegator address cannot be empty")
+	}
+	if req.ValidatorAddr == "" {
+		return nil, status.Error(codes.InvalidArgument, "validator address cannot be empty")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	delAddr, err := k.authKeeper.StringToBytes(req.DelegatorAddr)
+	if err != nil {
+		return nil, err
+	}
+
+	valAddr, err := sdk.ValAddressFromBech32(req.ValidatorAddr)
+	if err != nil {
+		return nil, err
+	}
+
+	lock, found := k.GetDelegationLock(ctx, delAddr, valAddr)
+	if !found {
+		return nil, status.Errorf(
+			codes.NotFound,
+			"delegation lock with delegator %s not found for validator %s",
+			req.DelegatorAddr, req.ValidatorAddr)
+	}
+
+	return &types.QueryDelegationLockResponse{
+		Lock:    lock,
+		Expired: !ctx.BlockHeader().Time.Before(lock.EndTime),
+	}, nil
+}
+
+// DelegatorDelegationLocks queries the locks of all the delegations of a
+// delegator
+func (k Querier) DelegatorDelegationLocks(c context.Context, req *types.QueryDelegatorDelegationLocksRequest) (*types.QueryDelegatorDelegationLocksResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	if req.DelegatorAddr == "" {
+		return nil, status.Error(codes.InvalidArgument, "delegator address cannot be empty")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	delAddr, err := k.authKeeper.StringToBytes(req.DelegatorAddr)
+	if err != nil {
+		return nil, err
+	}
+
+	store := ctx.KVStore(k.storeKey)
+	lockStore := prefix.NewStore(store, types.GetDelegationLocksKey(delAddr))
+
+	var locks []types.DelegationLock
+	pageRes, err := query.Paginate(lockStore, req.Pagination, func(_, value []byte) error {
+		var lock types.DelegationLock
+		if err := k.cdc.Unmarshal(value, &lock); err != nil {
+			return err
+		}
+
+		locks = append(locks, lock)
+		return nil
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryDelegatorDelegationLocksResponse{Locks: locks, Pagination: pageRes}, nil
+}
+
+// ValidatorProfile queries the description and profile metadata of a validator
+func (k Querier) ValidatorProfile(c context.Context, req *types.QueryValidatorProfileRequest) (*types.QueryValidatorProfileResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	if req.ValidatorAddr == "" {
+		return nil, status.Error(codes.InvalidArgument, "validator address cannot be empty")
+	}
+
+	valAddr, err := sdk.ValAddressFromBech32(req.ValidatorAddr)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	validator, found := k.GetValidator(ctx, valAddr)
+	if !found {
+		return nil, status.Errorf(codes.NotFound, "validator %s not found", req.ValidatorAddr)
+	}
+
+	return &types.QueryValidatorProfileResponse{
+		Description:  validator.Description,
+		Attestations: k.GetProfileAttestations(ctx, valAddr),
+	}, nil
+}
+
+// ValidatorRiskTier queries the unbonding tier of a validator and the
+// unbonding time applied to it
+func (k Querier) ValidatorRiskTier(c context.Context, req *types.QueryValidatorRiskTierRequest) (*types.QueryValidatorRiskTierResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	if req.ValidatorAddr == "" {
+		return nil, status.Error(codes.InvalidArgument, "validator address cannot be empty")
+	}
+
+	valAddr, err := sdk.ValAddressFromBech32(req.ValidatorAddr)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	if _, found := k.GetValidator(ctx, valAddr); !found {
+		return nil, status.Errorf(codes.NotFound, "validator %s not found", req.ValidatorAddr)
+	}
+
+	tier, _ := k.GetValidatorRiskTier(ctx, valAddr)
+
+	return &types.QueryValidatorRiskTierResponse{
+		Tier:          tier,
+		UnbondingTime: k.ValidatorUnbondingTime(ctx, valAddr),
+	}, nil
+}
+
+// ValidatorSetChanges queries the validator set changes over a range of heights
+func (k Querier) ValidatorSetChanges(c context.Context, req *types.QueryValidatorSetChangesRequest) (*types.QueryValidatorSetChangesResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	endHeight := req.EndHeight
+	if endHeight == 0 {
+		endHeight = ctx.BlockHeight()
+	}
+
+	if req.StartHeight < 0 || endHeight < req.StartHeight {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid height range [%d, %d]", req.StartHeight, endHeight)
+	}
+
+	if endHeight-req.StartHeight >= types.MaxValidatorSetChangesRange {
+		return nil, status.Errorf(codes.InvalidArgument, "height range cannot span more than %d heights", types.MaxValidatorSetChangesRange)
+	}
+
+	first, last, changes, found := k.GetValidatorSetChanges(ctx, req.StartHeight, endHeight)
+	if !found {
+		return nil, status.Errorf(codes.NotFound, "no historical info found for heights [%d, %d]", req.StartHeight, endHeight)
+	}
+
+	return &types.QueryValidatorSetChangesResponse{FirstHeight: first, LastHeight: last, Changes: changes}, nil
+}
+
+// StakingPaused queries whether the delegations, undelegations and
+// redelegations are paused
+func (k Querier) StakingPaused(c context.Context, req *types.QueryStakingPausedRequest) (*types.QueryStakingPausedResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	return &types.QueryStakingPausedResponse{Paused: k.IsStakingPaused(ctx)}, nil
+}
+
+// StandbyConsPubKey queries the standby consensus pubkey of a validator
+func (k Querier) StandbyConsPubKey(c context.Context, req *types.QueryStandbyConsPubKeyRequest) (*types.QueryStandbyConsPubKeyResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	if req.ValidatorAddr == "" {
+		return nil, status.Error(codes.InvalidArgument, "validator address cannot be empty")
+	}
+
+	valAddr, err := sdk.ValAddressFromBech32(req.ValidatorAddr)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	standby, found := k.GetStandbyConsPubKey(ctx, valAddr)
+	if !found {
+		return nil, status.Errorf(codes.NotFound, "validator %s has no standby consensus pubkey", req.ValidatorAddr)
+	}
+
+	return &types.QueryStandbyConsPubKeyResponse{StandbyConsPubkey: standby}, nil
+}
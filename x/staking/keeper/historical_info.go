@@ -67,6 +67,34 @@ func (k Keeper) GetAllHistoricalInfo(ctx sdk.Context) []types.HistoricalInfo {
 	return infos
 }
 
+// GetValidatorSetChanges returns the validator set changes between the
+// historical info entries stored from start to end heights, inclusive, along
+// with the heights of the first and last entries. It returns false if no
+// entry is stored in the range.
+func (k Keeper) GetValidatorSetChanges(ctx sdk.Context, start, end int64) (first, last int64, changes []types.ValidatorSetChange, found bool) {
+	store := ctx.KVStore(k.storeKey)
+
+	iterator := store.Iterator(types.GetHistoricalInfoKey(start), types.GetHistoricalInfoKey(end+1))
+	defer iterator.Close()
+
+	powerReduction := k.PowerReduction(ctx)
+	var prev types.Validators
+	for ; iterator.Valid(); iterator.Next() {
+		histInfo := types.MustUnmarshalHistoricalInfo(k.cdc, iterator.Value())
+		height := types.ParseHistoricalInfoKey(iterator.Key())
+
+		if !found {
+			first, found = height, true
+		} else {
+			changes = append(changes, types.ValidatorSetChanges(height, prev, histInfo.Valset, powerReduction)...)
+		}
+
+		last, prev = height, histInfo.Valset
+	}
+
+	return first, last, changes, found
+}
+
 // TrackHistoricalInfo saves the latest historical-info and deletes the oldest
 // heights that are below pruning height
 func (k Keeper) TrackHistoricalInfo(ctx sdk.Context) {
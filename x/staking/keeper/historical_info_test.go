@@ -153,3 +153,66 @@ func (s *KeeperTestSuite) TestGetAllHistoricalInfo() {
 	infos := keeper.GetAllHistoricalInfo(ctx)
 	require.Equal(expHistInfos, infos)
 }
+
+func (s *KeeperTestSuite) TestValidatorSetChanges() {
+	ctx, keeper := s.ctx, s.stakingKeeper
+	require := s.Require()
+
+	_, addrVals := createValAddrs(3)
+	powerReduction := keeper.PowerReduction(ctx)
+
+	newVal := func(i int, power int64) stakingtypes.Validator {
+		val := testutil.NewValidator(s.T(), addrVals[i], PKs[i])
+		val.Tokens = keeper.TokensFromConsensusPower(ctx, power)
+		return val
+	}
+
+	// val0 changes power at height 6, val1 leaves and val2 joins at height 8
+	valSets := map[int64][]stakingtypes.Validator{
+		5: {newVal(0, 10), newVal(1, 20)},
+		6: {newVal(0, 30), newVal(1, 20)},
+		8: {newVal(0, 30), newVal(2, 40)},
+	}
+	for height, valSet := range valSets {
+		hi := stakingtypes.NewHistoricalInfo(cmtproto.Header{ChainID: "HelloChain", Height: height}, valSet, powerReduction)
+		keeper.SetHistoricalInfo(ctx, height, &hi)
+	}
+
+	res, err := s.queryClient.ValidatorSetChanges(ctx, &stakingtypes.QueryValidatorSetChangesRequest{StartHeight: 1, EndHeight: 10})
+	require.NoError(err)
+	require.Equal(int64(5), res.FirstHeight)
+	require.Equal(int64(8), res.LastHeight)
+
+	type change struct {
+		height    int64
+		operator  string
+		typ       stakingtypes.ValidatorSetChangeType
+		prevPower int64
+		power     int64
+	}
+	var changes []change
+	for _, c := range res.Changes {
+		changes = append(changes, change{c.Height, c.OperatorAddress, c.Type, c.PreviousPower, c.Power})
+	}
+	require.Equal([]change{
+		{6, addrVals[0].String(), stakingtypes.ValidatorSetChangeType_VALIDATOR_SET_CHANGE_TYPE_POWER, 10, 30},
+		{8, addrVals[2].String(), stakingtypes.ValidatorSetChangeType_VALIDATOR_SET_CHANGE_TYPE_JOIN, 0, 40},
+		{8, addrVals[1].String(), stakingtypes.ValidatorSetChangeType_VALIDATOR_SET_CHANGE_TYPE_EXIT, 20, 0},
+	}, changes)
+
+	// the range is inclusive
+	res, err = s.queryClient.ValidatorSetChanges(ctx, &stakingtypes.QueryValidatorSetChangesRequest{StartHeight: 6, EndHeight: 6})
+	require.NoError(err)
+	require.Equal(int64(6), res.FirstHeight)
+	require.Equal(int64(6), res.LastHeight)
+	require.Empty(res.Changes)
+
+	_, err = s.queryClient.ValidatorSetChanges(ctx, &stakingtypes.QueryValidatorSetChangesRequest{StartHeight: 9, EndHeight: 20})
+	require.ErrorContains(err, "no historical info found")
+
+	_, err = s.queryClient.ValidatorSetChanges(ctx, &stakingtypes.QueryValidatorSetChangesRequest{StartHeight: 8, EndHeight: 7})
+	require.ErrorContains(err, "invalid height range")
+
+	_, err = s.queryClient.ValidatorSetChanges(ctx, &stakingtypes.QueryValidatorSetChangesRequest{StartHeight: 1, EndHeight: 1 + stakingtypes.MaxValidatorSetChangesRange})
+	require.ErrorContains(err, "cannot span more than")
+}
@@ -0,0 +1,125 @@
+package keeper
+
+import (
+	"cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/staking/types"
+)
+
+// GetInstantUndelegationUsed returns the staking tokens instantly undelegated
+// in the current block.
+func (k Keeper) GetInstantUndelegationUsed(ctx sdk.Context) math.Int {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.InstantUndelegationUsedKey)
+	if bz == nil {
+		return math.ZeroInt()
+	}
+
+	ip := sdk.IntProto{}
+	k.cdc.MustUnmarshal(bz, &ip)
+
+	return ip.Int
+}
+
+// SetInstantUndelegationUsed sets the staking tokens instantly undelegated in
+// the current block.
+func (k Keeper) SetInstantUndelegationUsed(ctx sdk.Context, used math.Int) {
+	store := ctx.KVStore(k.storeKey)
+	bz := k.cdc.MustMarshal(&sdk.IntProto{Int: used})
+	store.Set(types.InstantUndelegationUsedKey, bz)
+}
+
+// ResetInstantUndelegationUsed resets the instant undelegation budget at the
+// beginning of a block.
+func (k Keeper) ResetInstantUndelegationUsed(ctx sdk.Context) {
+	store := ctx.KVStore(k.storeKey)
+	if store.Has(types.InstantUndelegationUsedKey) {
+		store.Delete(types.InstantUndelegationUsedKey)
+	}
+}
+
+// InstantUndelegationBudget returns the staking tokens which can still be
+// instantly undelegated in the current block.
+func (k Keeper) InstantUndelegationBudget(ctx sdk.Context) math.Int {
+	budget := k.GetParams(ctx).InstantUndelegationBudgetPerBlock
+	return math.MaxInt(budget.Sub(k.GetInstantUndelegationUsed(ctx)), math.ZeroInt())
+}
+
+// InstantUndelegate unbonds an amount of delegator shares from a given
+// validator and returns the unbonded coins to the delegator right away,
+// without an unbonding delegation entry. The instant undelegation fee is
+// deducted from the returned coins, and is either burned or sent to the
+// community pool. The staking tokens unbonded count towards the instant
+// undelegation budget of the block. It returns the coins received by the
+// delegator and the fee.
+func (k Keeper) InstantUndelegate(
+	ctx sdk.Context, delAddr sdk.AccAddress, valAddr sdk.ValAddress, sharesAmount math.LegacyDec,
+) (amount, fee sdk.Coin, err error) {
+	params := k.GetParams(ctx)
+	if !params.InstantUndelegationBudgetPerBlock.IsPositive() {
+		return amount, fee, types.ErrInstantUndelegationDisabled
+	}
+
+	if params.InstantUndelegationFeeToCommunityPool && k.communityPoolKeeper == nil {
+		return amount, fee, types.ErrInstantUndelegationDisabled.Wrap("no community pool to send the fees to")
+	}
+
+	validator, found := k.GetValidator(ctx, valAddr)
+	if !found {
+		return amount, fee, types.ErrNoDelegatorForAddress
+	}
+
+	budget := k.InstantUndelegationBudget(ctx)
+	if tokens := validator.TokensFromShares(sharesAmount).TruncateInt(); tokens.GT(budget) {
+		return amount, fee, types.ErrInstantUndelegationBudgetExceeded.Wrapf("%s tokens, %s left in the block", tokens, budget)
+	}
+
+	if err := k.checkDelegationUnlocked(ctx, delAddr, valAddr); err != nil {
+		return amount, fee, err
+	}
+
+	if err := k.checkUnbondValidatorBond(ctx, delAddr, validator, sharesAmount); err != nil {
+		return amount, fee, err
+	}
+
+	tokens, denom, returnAmount, err := k.unbond(ctx, delAddr, valAddr, sharesAmount)
+	if err != nil {
+		return amount, fee, err
+	}
+
+	k.SetInstantUndelegationUsed(ctx, k.GetInstantUndelegationUsed(ctx).Add(tokens))
+
+	if validator.IsBonded() {
+		k.bondedCoinsToNotBonded(ctx, delegationCoins(params.BondDenom, denom, returnAmount))
+	}
+
+	if denom == "" {
+		denom = params.BondDenom
+	}
+
+	feeAmount := params.InstantUndelegationFeeRate.MulInt(returnAmount).TruncateInt()
+	fee = sdk.NewCoin(denom, feeAmount)
+	amount = sdk.NewCoin(denom, returnAmount.Sub(feeAmount))
+
+	if fee.IsPositive() {
+		if params.InstantUndelegationFeeToCommunityPool {
+			notBondedPool := k.GetNotBondedPool(ctx).GetAddress()
+			err = k.communityPoolKeeper.FundCommunityPool(ctx, sdk.NewCoins(fee), notBondedPool)
+		} else {
+			err = k.bankKeeper.BurnCoins(ctx, types.NotBondedPoolName, sdk.NewCoins(fee))
+		}
+
+		if err != nil {
+			return sdk.Coin{}, sdk.Coin{}, err
+		}
+	}
+
+	if amount.IsPositive() {
+		if err := k.bankKeeper.UndelegateCoinsFromModuleToAccount(ctx, types.NotBondedPoolName, delAddr, sdk.NewCoins(amount)); err != nil {
+			return sdk.Coin{}, sdk.Coin{}, err
+		}
+	}
+
+	return amount, fee, nil
+}
@@ -0,0 +1,89 @@
+package keeper_test
+
+import (
+	sdkmath "cosmossdk.io/math"
+	"github.com/golang/mock/gomock"
+
+	"github.com/cosmos/cosmos-sdk/crypto/keys/ed25519"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	stakingtestutil "github.com/cosmos/cosmos-sdk/x/staking/testutil"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+)
+
+func (s *KeeperTestSuite) TestInstantUndelegate() {
+	ctx, keeper, msgServer := s.ctx, s.stakingKeeper, s.msgServer
+	require := s.Require()
+	s.execExpectCalls()
+
+	delAddr := sdk.AccAddress(PKS[1].Address())
+	s.accountKeeper.EXPECT().StringToBytes(delAddr.String()).Return(delAddr, nil).AnyTimes()
+	s.accountKeeper.EXPECT().BytesToString(delAddr).Return(delAddr.String(), nil).AnyTimes()
+	s.bankKeeper.EXPECT().DelegateCoinsFromAccountToModule(gomock.Any(), delAddr, stakingtypes.NotBondedPoolName, gomock.Any()).AnyTimes()
+
+	comm := stakingtypes.NewCommissionRates(sdkmath.LegacyNewDec(0), sdkmath.LegacyNewDec(0), sdkmath.LegacyNewDec(0))
+	createMsg, err := stakingtypes.NewMsgCreateValidator(ValAddr, ed25519.GenPrivKey().PubKey(), sdk.NewInt64Coin(sdk.DefaultBondDenom, 10), stakingtypes.Description{Moniker: "NewVal"}, comm, sdkmath.OneInt())
+	require.NoError(err)
+	_, err = msgServer.CreateValidator(ctx, createMsg)
+	require.NoError(err)
+
+	_, err = msgServer.Delegate(ctx, stakingtypes.NewMsgDelegate(delAddr, ValAddr, sdk.NewInt64Coin(sdk.DefaultBondDenom, 200)))
+	require.NoError(err)
+
+	coins := func(amount int64) sdk.Coins { return sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, amount)) }
+	instantUndelegate := func(amount int64) (*stakingtypes.MsgInstantUndelegateResponse, error) {
+		return msgServer.InstantUndelegate(ctx, stakingtypes.NewMsgInstantUndelegate(delAddr, ValAddr, sdk.NewInt64Coin(sdk.DefaultBondDenom, amount)))
+	}
+
+	// instant undelegations are disabled by default
+	_, err = instantUndelegate(100)
+	require.ErrorIs(err, stakingtypes.ErrInstantUndelegationDisabled)
+
+	params := keeper.GetParams(ctx)
+	params.InstantUndelegationFeeRate = sdkmath.LegacyNewDecWithPrec(1, 1)
+	params.InstantUndelegationBudgetPerBlock = sdkmath.NewInt(150)
+	require.NoError(keeper.SetParams(ctx, params))
+
+	_, err = instantUndelegate(160)
+	require.ErrorIs(err, stakingtypes.ErrInstantUndelegationBudgetExceeded)
+
+	// the fee is burned
+	s.bankKeeper.EXPECT().BurnCoins(gomock.Any(), stakingtypes.NotBondedPoolName, coins(10)).Return(nil)
+	s.bankKeeper.EXPECT().UndelegateCoinsFromModuleToAccount(gomock.Any(), stakingtypes.NotBondedPoolName, delAddr, coins(90)).Return(nil)
+
+	res, err := instantUndelegate(100)
+	require.NoError(err)
+	require.Equal(sdk.NewInt64Coin(sdk.DefaultBondDenom, 90), res.Amount)
+	require.Equal(sdk.NewInt64Coin(sdk.DefaultBondDenom, 10), res.Fee)
+	require.Equal(sdkmath.NewInt(50), keeper.InstantUndelegationBudget(ctx))
+
+	del, found := keeper.GetDelegation(ctx, delAddr, ValAddr)
+	require.True(found)
+	require.Equal(sdkmath.LegacyNewDec(100), del.Shares)
+	_, found = keeper.GetUnbondingDelegation(ctx, delAddr, ValAddr)
+	require.False(found)
+
+	// the fee is sent to the community pool
+	params.InstantUndelegationFeeToCommunityPool = true
+	require.NoError(keeper.SetParams(ctx, params))
+
+	_, err = instantUndelegate(50)
+	require.ErrorIs(err, stakingtypes.ErrInstantUndelegationDisabled)
+
+	communityPoolKeeper := stakingtestutil.NewMockCommunityPoolKeeper(gomock.NewController(s.T()))
+	keeper.SetCommunityPoolKeeper(communityPoolKeeper)
+
+	s.accountKeeper.EXPECT().GetModuleAccount(gomock.Any(), stakingtypes.NotBondedPoolName).Return(notBondedAcc)
+	communityPoolKeeper.EXPECT().FundCommunityPool(gomock.Any(), coins(5), notBondedAcc.GetAddress()).Return(nil)
+	s.bankKeeper.EXPECT().UndelegateCoinsFromModuleToAccount(gomock.Any(), stakingtypes.NotBondedPoolName, delAddr, coins(45)).Return(nil)
+
+	_, err = instantUndelegate(50)
+	require.NoError(err)
+	require.True(keeper.InstantUndelegationBudget(ctx).IsZero())
+
+	_, err = instantUndelegate(1)
+	require.ErrorIs(err, stakingtypes.ErrInstantUndelegationBudgetExceeded)
+
+	// the budget is reset every block
+	keeper.ResetInstantUndelegationUsed(ctx)
+	require.Equal(sdkmath.NewInt(150), keeper.InstantUndelegationBudget(ctx))
+}
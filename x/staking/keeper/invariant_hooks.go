@@ -0,0 +1,92 @@
+package keeper
+
+import (
+	"cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/staking/types"
+)
+
+// InvariantHooks schedule the checks of the targeted staking invariants for the
+// validators whose delegations or tokens change. They must be registered with
+// the staking keeper for the targeted invariants to be checked.
+type InvariantHooks struct {
+	ir sdk.TargetedInvariantRegistry
+}
+
+var _ types.StakingHooks = InvariantHooks{}
+
+// NewInvariantHooks returns the staking hooks scheduling the checks of the
+// targeted staking invariants with the given registry.
+func NewInvariantHooks(ir sdk.TargetedInvariantRegistry) InvariantHooks {
+	return InvariantHooks{ir: ir}
+}
+
+// scheduleChecks schedules the checks of the targeted invariants of a
+// validator whose delegations or tokens change.
+func (h InvariantHooks) scheduleChecks(ctx sdk.Context, valAddr sdk.ValAddress) {
+	h.ir.ScheduleInvariantCheck(ctx, types.ModuleName, ValidatorDelegatorSharesRoute, valAddr)
+	h.ir.ScheduleInvariantCheck(ctx, types.ModuleName, BondedPoolRoute, nil)
+}
+
+// AfterDelegationModified schedules the checks of the validator of the
+// delegation.
+func (h InvariantHooks) AfterDelegationModified(ctx sdk.Context, _ sdk.AccAddress, valAddr sdk.ValAddress) error {
+	h.scheduleChecks(ctx, valAddr)
+	return nil
+}
+
+// BeforeDelegationRemoved schedules the checks of the validator of the
+// delegation.
+func (h InvariantHooks) BeforeDelegationRemoved(ctx sdk.Context, _ sdk.AccAddress, valAddr sdk.ValAddress) error {
+	h.scheduleChecks(ctx, valAddr)
+	return nil
+}
+
+// AfterValidatorSlashed schedules the checks of the slashed validator.
+func (h InvariantHooks) AfterValidatorSlashed(ctx sdk.Context, valAddr sdk.ValAddress, _ math.LegacyDec, _ []types.SlashedDelegation) error {
+	h.scheduleChecks(ctx, valAddr)
+	return nil
+}
+
+func (h InvariantHooks) AfterValidatorCreated(_ sdk.Context, _ sdk.ValAddress) error {
+	return nil
+}
+
+func (h InvariantHooks) BeforeValidatorModified(_ sdk.Context, _ sdk.ValAddress) error {
+	return nil
+}
+
+func (h InvariantHooks) AfterValidatorRemoved(_ sdk.Context, _ sdk.ConsAddress, _ sdk.ValAddress) error {
+	return nil
+}
+
+// AfterValidatorBonded is a no-op, the validator set updates happen in the
+// staking end blocker and are covered by the full invariant checks.
+func (h InvariantHooks) AfterValidatorBonded(_ sdk.Context, _ sdk.ConsAddress, _ sdk.ValAddress) error {
+	return nil
+}
+
+func (h InvariantHooks) AfterValidatorBeginUnbonding(_ sdk.Context, _ sdk.ConsAddress, _ sdk.ValAddress) error {
+	return nil
+}
+
+func (h InvariantHooks) BeforeDelegationCreated(_ sdk.Context, _ sdk.AccAddress, _ sdk.ValAddress) error {
+	return nil
+}
+
+func (h InvariantHooks) BeforeDelegationSharesModified(_ sdk.Context, _ sdk.AccAddress, _ sdk.ValAddress) error {
+	return nil
+}
+
+func (h InvariantHooks) BeforeValidatorSlashed(_ sdk.Context, _ sdk.ValAddress, _ math.LegacyDec) error {
+	return nil
+}
+
+func (h InvariantHooks) AfterUnbondingInitiated(_ sdk.Context, _ uint64) error {
+	return nil
+}
+
+func (h InvariantHooks) AfterUnbondingCanceled(_ sdk.Context, _ uint64) error {
+	return nil
+}
@@ -9,6 +9,12 @@ import (
 	"github.com/cosmos/cosmos-sdk/x/staking/types"
 )
 
+// Routes of the targeted staking invariants
+const (
+	ValidatorDelegatorSharesRoute = "validator-delegator-shares"
+	BondedPoolRoute               = "bonded-pool"
+)
+
 // RegisterInvariants registers all staking invariants
 func RegisterInvariants(ir sdk.InvariantRegistry, k *Keeper) {
 	ir.RegisterRoute(types.ModuleName, "module-accounts",
@@ -19,6 +25,13 @@ func RegisterInvariants(ir sdk.InvariantRegistry, k *Keeper) {
 		PositiveDelegationInvariant(k))
 	ir.RegisterRoute(types.ModuleName, "delegator-shares",
 		DelegatorSharesInvariant(k))
+
+	if tir, ok := ir.(sdk.TargetedInvariantRegistry); ok {
+		tir.RegisterTargetedRoute(types.ModuleName, ValidatorDelegatorSharesRoute,
+			ValidatorDelegatorSharesInvariant(k))
+		tir.RegisterTargetedRoute(types.ModuleName, BondedPoolRoute,
+			BondedPoolInvariant(k))
+	}
 }
 
 // AllInvariants runs all invariants of the staking module.
@@ -47,18 +60,20 @@ func AllInvariants(k *Keeper) sdk.Invariant {
 // reflects the tokens actively bonded and not bonded
 func ModuleAccountInvariants(k *Keeper) sdk.Invariant {
 	return func(ctx sdk.Context) (string, bool) {
-		bonded := math.ZeroInt()
-		notBonded := math.ZeroInt()
+		bonded := sdk.NewCoins()
+		notBonded := sdk.NewCoins()
 		bondedPool := k.GetBondedPool(ctx)
 		notBondedPool := k.GetNotBondedPool(ctx)
-		bondDenom := k.BondDenom(ctx)
+		params := k.GetParams(ctx)
+		bondDenom := params.BondDenom
 
 		k.IterateValidators(ctx, func(_ int64, validator types.ValidatorI) bool {
+			coins := validator.(types.Validator).StakedCoins(bondDenom)
 			switch validator.GetStatus() {
 			case types.Bonded:
-				bonded = bonded.Add(validator.GetTokens())
+				bonded = bonded.Add(coins...)
 			case types.Unbonding, types.Unbonded:
-				notBonded = notBonded.Add(validator.GetTokens())
+				notBonded = notBonded.Add(coins...)
 			default:
 				panic("invalid validator status")
 			}
@@ -67,14 +82,26 @@ func ModuleAccountInvariants(k *Keeper) sdk.Invariant {
 
 		k.IterateUnbondingDelegations(ctx, func(_ int64, ubd types.UnbondingDelegation) bool {
 			for _, entry := range ubd.Entries {
-				notBonded = notBonded.Add(entry.Balance)
+				notBonded = notBonded.Add(delegationCoins(bondDenom, entry.Denom, entry.Balance)...)
 			}
 			return false
 		})
 
-		poolBonded := k.bankKeeper.GetBalance(ctx, bondedPool.GetAddress(), bondDenom)
-		poolNotBonded := k.bankKeeper.GetBalance(ctx, notBondedPool.GetAddress(), bondDenom)
-		broken := !poolBonded.Amount.Equal(bonded) || !poolNotBonded.Amount.Equal(notBonded)
+		// the pools are checked for the bond denom and each additional bond denom
+		poolBonded, poolNotBonded := sdk.NewCoins(), sdk.NewCoins()
+		broken := false
+		denoms := []string{bondDenom}
+		for _, w := range params.BondDenomWeights {
+			denoms = append(denoms, w.Denom)
+		}
+
+		for _, denom := range denoms {
+			poolBonded = poolBonded.Add(k.bankKeeper.GetBalance(ctx, bondedPool.GetAddress(), denom))
+			poolNotBonded = poolNotBonded.Add(k.bankKeeper.GetBalance(ctx, notBondedPool.GetAddress(), denom))
+			broken = broken ||
+				!poolBonded.AmountOf(denom).Equal(bonded.AmountOf(denom)) ||
+				!poolNotBonded.AmountOf(denom).Equal(notBonded.AmountOf(denom))
+		}
 
 		// Bonded tokens should equal sum of tokens with bonded validators
 		// Not-bonded tokens should equal unbonding delegations	plus tokens on unbonded validators
@@ -87,7 +114,7 @@ func ModuleAccountInvariants(k *Keeper) sdk.Invariant {
 				"module accounts total (bonded + not bonded):\n"+
 				"\tModule Accounts' tokens: %v\n"+
 				"\tsum tokens:              %v\n",
-			poolBonded, bonded, poolNotBonded, notBonded, poolBonded.Add(poolNotBonded), bonded.Add(notBonded))), broken
+			poolBonded, bonded, poolNotBonded, notBonded, poolBonded.Add(poolNotBonded...), bonded.Add(notBonded...))), broken
 	}
 }
 
@@ -195,3 +222,62 @@ func DelegatorSharesInvariant(k *Keeper) sdk.Invariant {
 		return sdk.FormatInvariant(types.ModuleName, "delegator shares", msg), broken
 	}
 }
+
+// ValidatorDelegatorSharesInvariant checks, for the validator given as subject,
+// that the shares of its delegations add up to its total delegator shares.
+func ValidatorDelegatorSharesInvariant(k *Keeper) sdk.TargetedInvariant {
+	return func(ctx sdk.Context, subject []byte) (string, bool) {
+		validator, found := k.GetValidator(ctx, subject)
+		if !found {
+			// the delegations of a removed validator were all removed
+			return sdk.FormatInvariant(types.ModuleName, "validator delegator shares", ""), false
+		}
+
+		shares := math.LegacyZeroDec()
+		for _, delegation := range k.GetValidatorDelegations(ctx, validator.GetOperator()) {
+			shares = shares.Add(delegation.Shares)
+		}
+
+		broken := !shares.Equal(validator.GetDelegatorShares())
+		return sdk.FormatInvariant(types.ModuleName, "validator delegator shares", fmt.Sprintf(
+			"\tvalidator: %s\n"+
+				"\tvalidator.DelegatorShares: %v\n"+
+				"\tsum of Delegator.Shares: %v\n",
+			validator.GetOperator(), validator.GetDelegatorShares(), shares)), broken
+	}
+}
+
+// BondedPoolInvariant checks that the bonded pool module account holds the
+// tokens of the bonded validators. It spans the staking and bank modules, and
+// only iterates over the active validator set.
+func BondedPoolInvariant(k *Keeper) sdk.TargetedInvariant {
+	return func(ctx sdk.Context, _ []byte) (string, bool) {
+		bonded := sdk.NewCoins()
+		bondedPool := k.GetBondedPool(ctx)
+		params := k.GetParams(ctx)
+
+		k.IterateLastValidators(ctx, func(_ int64, validator types.ValidatorI) bool {
+			if validator.GetStatus() == types.Bonded {
+				bonded = bonded.Add(validator.(types.Validator).StakedCoins(params.BondDenom)...)
+			}
+			return false
+		})
+
+		poolBonded := sdk.NewCoins()
+		broken := false
+		denoms := []string{params.BondDenom}
+		for _, w := range params.BondDenomWeights {
+			denoms = append(denoms, w.Denom)
+		}
+
+		for _, denom := range denoms {
+			poolBonded = poolBonded.Add(k.bankKeeper.GetBalance(ctx, bondedPool.GetAddress(), denom))
+			broken = broken || !poolBonded.AmountOf(denom).Equal(bonded.AmountOf(denom))
+		}
+
+		return sdk.FormatInvariant(types.ModuleName, "bonded pool", fmt.Sprintf(
+			"\tPool's bonded tokens: %v\n"+
+				"\tsum of bonded tokens: %v\n",
+			poolBonded, bonded)), broken
+	}
+}
@@ -0,0 +1,53 @@
+package keeper
+
+import (
+	storetypes "cosmossdk.io/store/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/staking/types"
+)
+
+// GetValidatorJailInfo returns why and when a jailed validator was jailed.
+func (k Keeper) GetValidatorJailInfo(ctx sdk.Context, valAddr sdk.ValAddress) (info types.ValidatorJailInfo, found bool) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.GetValidatorJailInfoKey(valAddr))
+	if bz == nil {
+		return info, false
+	}
+
+	k.cdc.MustUnmarshal(bz, &info)
+	return info, true
+}
+
+// SetValidatorJailInfo sets the jail info of a validator.
+func (k Keeper) SetValidatorJailInfo(ctx sdk.Context, info types.ValidatorJailInfo) {
+	valAddr, err := sdk.ValAddressFromBech32(info.ValidatorAddress)
+	if err != nil {
+		panic(err)
+	}
+
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.GetValidatorJailInfoKey(valAddr), k.cdc.MustMarshal(&info))
+}
+
+// DeleteValidatorJailInfo removes the jail info of a validator.
+func (k Keeper) DeleteValidatorJailInfo(ctx sdk.Context, valAddr sdk.ValAddress) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.GetValidatorJailInfoKey(valAddr))
+}
+
+// GetAllValidatorJailInfos returns the jail info of all the jailed validators
+// whose jail reason was recorded.
+func (k Keeper) GetAllValidatorJailInfos(ctx sdk.Context) (infos []types.ValidatorJailInfo) {
+	store := ctx.KVStore(k.storeKey)
+	iterator := storetypes.KVStorePrefixIterator(store, types.ValidatorJailInfoKey)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var info types.ValidatorJailInfo
+		k.cdc.MustUnmarshal(iterator.Value(), &info)
+		infos = append(infos, info)
+	}
+
+	return infos
+}
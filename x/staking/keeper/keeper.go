@@ -27,6 +27,9 @@ type Keeper struct {
 	bankKeeper types.BankKeeper
 	hooks      types.StakingHooks
 	authority  string
+
+	communityPoolKeeper types.CommunityPoolKeeper
+	profileVerifiers    map[string]types.ProfileVerifier
 }
 
 // NewKeeper creates a new staking Keeper instance
@@ -58,6 +61,8 @@ func NewKeeper(
 		bankKeeper: bk,
 		hooks:      nil,
 		authority:  authority,
+
+		profileVerifiers: make(map[string]types.ProfileVerifier),
 	}
 }
 
@@ -86,6 +91,31 @@ func (k *Keeper) SetHooks(sh types.StakingHooks) {
 	k.hooks = sh
 }
 
+// SetCommunityPoolKeeper sets the keeper funding the community pool with the
+// instant undelegation fees. In contrast to other receivers, this method must
+// take a pointer, like SetHooks.
+func (k *Keeper) SetCommunityPoolKeeper(cpk types.CommunityPoolKeeper) {
+	if k.communityPoolKeeper != nil {
+		panic("cannot set community pool keeper twice")
+	}
+
+	k.communityPoolKeeper = cpk
+}
+
+// SetProfileVerifier registers the verifier of the attestations of a
+// validator profile field. The fields without a verifier cannot be attested.
+func (k Keeper) SetProfileVerifier(field string, verifier types.ProfileVerifier) {
+	if !types.IsProfileField(field) {
+		panic(fmt.Sprintf("unknown validator profile field %s", field))
+	}
+
+	if _, ok := k.profileVerifiers[field]; ok {
+		panic(fmt.Sprintf("cannot set the verifier of validator profile field %s twice", field))
+	}
+
+	k.profileVerifiers[field] = verifier
+}
+
 // GetLastTotalPower Load the last total validator power.
 func (k Keeper) GetLastTotalPower(ctx sdk.Context) math.Int {
 	store := ctx.KVStore(k.storeKey)
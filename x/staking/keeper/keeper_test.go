@@ -57,6 +57,7 @@ func (s *KeeperTestSuite) SetupTest() {
 	accountKeeper.EXPECT().StringToBytes("invalid").Return(nil, errors.New("invalid bech32 string")).AnyTimes()
 
 	bankKeeper := stakingtestutil.NewMockBankKeeper(ctrl)
+	bankKeeper.EXPECT().SetModuleEscrow(gomock.Any(), stakingtypes.BondedPoolName, gomock.Any(), gomock.Any()).AnyTimes()
 
 	keeper := stakingkeeper.NewKeeper(
 		encCfg.Codec,
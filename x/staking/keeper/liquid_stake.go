@@ -0,0 +1,228 @@
+package keeper
+
+import (
+	errorsmod "cosmossdk.io/errors"
+	"cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/staking/types"
+)
+
+// GetTotalLiquidStakedTokens returns the total amount of tokens delegated by
+// the tokenize share record accounts.
+func (k Keeper) GetTotalLiquidStakedTokens(ctx sdk.Context) math.Int {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.TotalLiquidStakedTokensKey)
+
+	if bz == nil {
+		return math.ZeroInt()
+	}
+
+	ip := sdk.IntProto{}
+	k.cdc.MustUnmarshal(bz, &ip)
+
+	return ip.Int
+}
+
+// SetTotalLiquidStakedTokens sets the total amount of tokens delegated by the
+// tokenize share record accounts.
+func (k Keeper) SetTotalLiquidStakedTokens(ctx sdk.Context, tokens math.Int) {
+	store := ctx.KVStore(k.storeKey)
+	bz := k.cdc.MustMarshal(&sdk.IntProto{Int: tokens})
+	store.Set(types.TotalLiquidStakedTokensKey, bz)
+}
+
+// CheckExceedsGlobalLiquidStakingCap returns true if liquid staking the given
+// amount of tokens would exceed the global liquid staking cap, as a fraction of
+// the total bonded tokens.
+func (k Keeper) CheckExceedsGlobalLiquidStakingCap(ctx sdk.Context, tokens math.Int) bool {
+	liquidStakingCap := k.GlobalLiquidStakingCap(ctx)
+	totalBonded := k.TotalBondedTokens(ctx)
+	if totalBonded.IsZero() {
+		return liquidStakingCap.LT(math.LegacyOneDec())
+	}
+
+	totalLiquidStaked := k.GetTotalLiquidStakedTokens(ctx).Add(tokens)
+	liquidStakePercent := math.LegacyNewDecFromInt(totalLiquidStaked).QuoInt(totalBonded)
+
+	return liquidStakePercent.GT(liquidStakingCap)
+}
+
+// CheckExceedsValidatorLiquidStakingCap returns true if liquid staking the
+// given amount of shares of the validator would exceed the validator liquid
+// staking cap, as a fraction of the validator's delegator shares.
+func (k Keeper) CheckExceedsValidatorLiquidStakingCap(ctx sdk.Context, validator types.Validator, shares math.LegacyDec) bool {
+	if validator.DelegatorShares.IsZero() {
+		return true
+	}
+
+	liquidShares := validator.LiquidShares.Add(shares)
+	liquidSharePercent := liquidShares.Quo(validator.DelegatorShares)
+
+	return liquidSharePercent.GT(k.ValidatorLiquidStakingCap(ctx))
+}
+
+// CheckExceedsValidatorBondCap returns true if liquid staking the given amount
+// of shares of the validator would exceed the liquid shares allowed by its
+// validator bond shares. The check is disabled when the validator bond factor
+// is negative.
+func (k Keeper) CheckExceedsValidatorBondCap(ctx sdk.Context, validator types.Validator, shares math.LegacyDec) bool {
+	validatorBondFactor := k.ValidatorBondFactor(ctx)
+	if validatorBondFactor.IsNegative() {
+		return false
+	}
+
+	maxValLiquidShares := validator.ValidatorBondShares.Mul(validatorBondFactor)
+
+	return validator.LiquidShares.Add(shares).GT(maxValLiquidShares)
+}
+
+// SafelyIncreaseTotalLiquidStakedTokens increases the total liquid staked
+// tokens, failing if the global liquid staking cap would be exceeded.
+func (k Keeper) SafelyIncreaseTotalLiquidStakedTokens(ctx sdk.Context, amount math.Int) error {
+	if k.CheckExceedsGlobalLiquidStakingCap(ctx, amount) {
+		return types.ErrGlobalLiquidStakingCapExceeded
+	}
+
+	k.SetTotalLiquidStakedTokens(ctx, k.GetTotalLiquidStakedTokens(ctx).Add(amount))
+	return nil
+}
+
+// DecreaseTotalLiquidStakedTokens decreases the total liquid staked tokens.
+func (k Keeper) DecreaseTotalLiquidStakedTokens(ctx sdk.Context, amount math.Int) error {
+	totalLiquidStake := k.GetTotalLiquidStakedTokens(ctx)
+	if amount.GT(totalLiquidStake) {
+		return errorsmod.Wrapf(types.ErrNotEnoughBalance, "total liquid staked tokens %s is less than %s", totalLiquidStake, amount)
+	}
+
+	k.SetTotalLiquidStakedTokens(ctx, totalLiquidStake.Sub(amount))
+	return nil
+}
+
+// SafelyIncreaseValidatorLiquidShares increases the liquid shares of a
+// validator, failing if the validator bond or the validator liquid staking cap
+// would be exceeded.
+func (k Keeper) SafelyIncreaseValidatorLiquidShares(ctx sdk.Context, valAddr sdk.ValAddress, shares math.LegacyDec) (types.Validator, error) {
+	validator, found := k.GetValidator(ctx, valAddr)
+	if !found {
+		return validator, types.ErrNoValidatorFound
+	}
+
+	if k.CheckExceedsValidatorBondCap(ctx, validator, shares) {
+		return validator, types.ErrInsufficientValidatorBondShares
+	}
+
+	if k.CheckExceedsValidatorLiquidStakingCap(ctx, validator, shares) {
+		return validator, types.ErrValidatorLiquidStakingCapExceeded
+	}
+
+	validator.LiquidShares = validator.LiquidShares.Add(shares)
+	k.SetValidator(ctx, validator)
+
+	return validator, nil
+}
+
+// DecreaseValidatorLiquidShares decreases the liquid shares of a validator.
+func (k Keeper) DecreaseValidatorLiquidShares(ctx sdk.Context, valAddr sdk.ValAddress, shares math.LegacyDec) (types.Validator, error) {
+	validator, found := k.GetValidator(ctx, valAddr)
+	if !found {
+		return validator, types.ErrNoValidatorFound
+	}
+
+	if shares.GT(validator.LiquidShares) {
+		return validator, errorsmod.Wrapf(types.ErrNotEnoughDelegationShares, "validator liquid shares %s is less than %s", validator.LiquidShares, shares)
+	}
+
+	validator.LiquidShares = validator.LiquidShares.Sub(shares)
+	k.SetValidator(ctx, validator)
+
+	return validator, nil
+}
+
+// DelegatorIsLiquidStaker returns true if the delegator is a liquid staking
+// provider, whose delegations count towards the liquid shares of the
+// validators. These are the accounts with 32 bytes addresses, i.e. the
+// accounts derived from a module or contract address, such as the interchain
+// accounts or the smart contracts holding delegations on behalf of their users.
+func (k Keeper) DelegatorIsLiquidStaker(delAddr sdk.AccAddress) bool {
+	return len(delAddr) == 32
+}
+
+// checkLiquidDelegation fails if a liquid staking provider delegating the given
+// amount of tokens to the validator would exceed the global or validator
+// liquid staking caps, or the liquid shares allowed by the validator bond.
+func (k Keeper) checkLiquidDelegation(ctx sdk.Context, validator types.Validator, tokens math.Int) error {
+	// the first delegation to a validator is issued one share per token
+	shares := math.LegacyNewDecFromInt(tokens)
+	if !validator.Tokens.IsZero() {
+		shares = validator.GetDelegatorShares().MulInt(tokens).QuoInt(validator.GetTokens())
+	}
+
+	// the delegated tokens and shares are not staked yet, they are counted in
+	// the totals the caps are fractions of, a cap of 100% disabling the check
+	if liquidStakingCap := k.GlobalLiquidStakingCap(ctx); liquidStakingCap.LT(math.LegacyOneDec()) {
+		totalStaked := k.TotalBondedTokens(ctx).Add(tokens)
+		totalLiquidStaked := k.GetTotalLiquidStakedTokens(ctx).Add(tokens)
+		if math.LegacyNewDecFromInt(totalLiquidStaked).QuoInt(totalStaked).GT(liquidStakingCap) {
+			return types.ErrGlobalLiquidStakingCapExceeded
+		}
+	}
+
+	if k.CheckExceedsValidatorBondCap(ctx, validator, shares) {
+		return types.ErrInsufficientValidatorBondShares
+	}
+
+	validator.DelegatorShares = validator.DelegatorShares.Add(shares)
+	if k.CheckExceedsValidatorLiquidStakingCap(ctx, validator, shares) {
+		return types.ErrValidatorLiquidStakingCapExceeded
+	}
+
+	return nil
+}
+
+// addLiquidStakerDelegations adds the delegations of the liquid staking
+// providers to the liquid shares of their validators and to the total liquid
+// staked tokens.
+func (k Keeper) addLiquidStakerDelegations(ctx sdk.Context) {
+	totalLiquidStaked := k.GetTotalLiquidStakedTokens(ctx)
+	k.IterateAllDelegations(ctx, func(delegation types.Delegation) bool {
+		if !k.DelegatorIsLiquidStaker(delegation.GetDelegatorAddr()) {
+			return false
+		}
+
+		validator, found := k.GetValidator(ctx, delegation.GetValidatorAddr())
+		if !found {
+			return false
+		}
+
+		validator.LiquidShares = validator.LiquidShares.Add(delegation.Shares)
+		k.SetValidator(ctx, validator)
+		totalLiquidStaked = totalLiquidStaked.Add(validator.TokensFromShares(delegation.Shares).TruncateInt())
+
+		return false
+	})
+
+	k.SetTotalLiquidStakedTokens(ctx, totalLiquidStaked)
+}
+
+// checkUnbondValidatorBond fails if the delegation is a validator bond and
+// unbonding the given amount of its shares would leave the validator with more
+// liquid shares than its remaining validator bond allows.
+func (k Keeper) checkUnbondValidatorBond(ctx sdk.Context, delAddr sdk.AccAddress, validator types.Validator, shares math.LegacyDec) error {
+	delegation, found := k.GetDelegation(ctx, delAddr, validator.GetOperator())
+	if !found || !delegation.ValidatorBond {
+		return nil
+	}
+
+	validatorBondFactor := k.ValidatorBondFactor(ctx)
+	if validatorBondFactor.IsNegative() {
+		return nil
+	}
+
+	remainingBondShares := validator.ValidatorBondShares.Sub(shares)
+	if validator.LiquidShares.GT(remainingBondShares.Mul(validatorBondFactor)) {
+		return types.ErrInsufficientValidatorBondShares
+	}
+
+	return nil
+}
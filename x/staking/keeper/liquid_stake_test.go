@@ -0,0 +1,132 @@
+package keeper_test
+
+import (
+	"bytes"
+
+	"cosmossdk.io/math"
+	"github.com/golang/mock/gomock"
+
+	"github.com/cosmos/cosmos-sdk/crypto/keys/ed25519"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/staking/testutil"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+)
+
+func (s *KeeperTestSuite) TestTotalLiquidStakedTokens() {
+	ctx, keeper := s.ctx, s.stakingKeeper
+	require := s.Require()
+
+	require.Equal(math.ZeroInt(), keeper.GetTotalLiquidStakedTokens(ctx))
+
+	keeper.SetTotalLiquidStakedTokens(ctx, math.NewInt(100))
+	require.Equal(math.NewInt(100), keeper.GetTotalLiquidStakedTokens(ctx))
+
+	require.NoError(keeper.DecreaseTotalLiquidStakedTokens(ctx, math.NewInt(40)))
+	require.Equal(math.NewInt(60), keeper.GetTotalLiquidStakedTokens(ctx))
+
+	require.Error(keeper.DecreaseTotalLiquidStakedTokens(ctx, math.NewInt(61)))
+}
+
+func (s *KeeperTestSuite) TestValidatorLiquidShares() {
+	ctx, keeper := s.ctx, s.stakingKeeper
+	require := s.Require()
+
+	_, valAddrs := createValAddrs(1)
+	validator := testutil.NewValidator(s.T(), valAddrs[0], PKs[0])
+	validator.DelegatorShares = math.LegacyNewDec(100)
+	validator.ValidatorBondShares = math.LegacyNewDec(10)
+	keeper.SetValidator(ctx, validator)
+
+	params := stakingtypes.DefaultParams()
+	params.ValidatorBondFactor = math.LegacyNewDec(2)
+	params.ValidatorLiquidStakingCap = math.LegacyNewDecWithPrec(50, 2)
+	require.NoError(keeper.SetParams(ctx, params))
+
+	// the validator bond allows 20 liquid shares
+	_, err := keeper.SafelyIncreaseValidatorLiquidShares(ctx, valAddrs[0], math.LegacyNewDec(21))
+	require.ErrorIs(err, stakingtypes.ErrInsufficientValidatorBondShares)
+
+	validator, err = keeper.SafelyIncreaseValidatorLiquidShares(ctx, valAddrs[0], math.LegacyNewDec(20))
+	require.NoError(err)
+	require.Equal(math.LegacyNewDec(20), validator.LiquidShares)
+
+	// without the validator bond check, the validator cap allows 50 liquid shares
+	params.ValidatorBondFactor = stakingtypes.DefaultValidatorBondFactor
+	require.NoError(keeper.SetParams(ctx, params))
+
+	_, err = keeper.SafelyIncreaseValidatorLiquidShares(ctx, valAddrs[0], math.LegacyNewDec(31))
+	require.ErrorIs(err, stakingtypes.ErrValidatorLiquidStakingCapExceeded)
+
+	validator, err = keeper.SafelyIncreaseValidatorLiquidShares(ctx, valAddrs[0], math.LegacyNewDec(30))
+	require.NoError(err)
+	require.Equal(math.LegacyNewDec(50), validator.LiquidShares)
+
+	_, err = keeper.DecreaseValidatorLiquidShares(ctx, valAddrs[0], math.LegacyNewDec(51))
+	require.Error(err)
+
+	validator, err = keeper.DecreaseValidatorLiquidShares(ctx, valAddrs[0], math.LegacyNewDec(50))
+	require.NoError(err)
+	require.True(validator.LiquidShares.IsZero())
+}
+
+func (s *KeeperTestSuite) TestLiquidStakerDelegations() {
+	ctx, keeper, msgServer := s.ctx, s.stakingKeeper, s.msgServer
+	require := s.Require()
+	s.execExpectCalls()
+
+	// liquid staking providers are the accounts with 32 bytes addresses
+	liquidStaker := sdk.AccAddress(bytes.Repeat([]byte{1}, 32))
+	require.True(keeper.DelegatorIsLiquidStaker(liquidStaker))
+	require.False(keeper.DelegatorIsLiquidStaker(Addr))
+	s.accountKeeper.EXPECT().StringToBytes(liquidStaker.String()).Return(liquidStaker, nil).AnyTimes()
+	s.bankKeeper.EXPECT().DelegateCoinsFromAccountToModule(gomock.Any(), liquidStaker, stakingtypes.NotBondedPoolName, gomock.Any()).AnyTimes()
+
+	comm := stakingtypes.NewCommissionRates(math.LegacyNewDec(0), math.LegacyNewDec(0), math.LegacyNewDec(0))
+	createMsg, err := stakingtypes.NewMsgCreateValidator(ValAddr, ed25519.GenPrivKey().PubKey(), sdk.NewInt64Coin(sdk.DefaultBondDenom, 10), stakingtypes.Description{Moniker: "NewVal"}, comm, math.OneInt())
+	require.NoError(err)
+	_, err = msgServer.CreateValidator(ctx, createMsg)
+	require.NoError(err)
+
+	params := keeper.GetParams(ctx)
+	params.ValidatorBondFactor = math.LegacyNewDec(2)
+	require.NoError(keeper.SetParams(ctx, params))
+
+	coin := func(amt int64) sdk.Coin { return sdk.NewInt64Coin(sdk.DefaultBondDenom, amt) }
+
+	// the liquid delegations are bounded by the validator bond
+	_, err = msgServer.Delegate(ctx, stakingtypes.NewMsgDelegate(liquidStaker, ValAddr, coin(1)))
+	require.ErrorIs(err, stakingtypes.ErrInsufficientValidatorBondShares)
+
+	_, err = msgServer.ValidatorBond(ctx, stakingtypes.NewMsgValidatorBond(Addr, ValAddr))
+	require.NoError(err)
+
+	_, err = msgServer.Delegate(ctx, stakingtypes.NewMsgDelegate(liquidStaker, ValAddr, coin(21)))
+	require.ErrorIs(err, stakingtypes.ErrInsufficientValidatorBondShares)
+
+	_, err = msgServer.Delegate(ctx, stakingtypes.NewMsgDelegate(liquidStaker, ValAddr, coin(20)))
+	require.NoError(err)
+
+	validator, found := keeper.GetValidator(ctx, ValAddr)
+	require.True(found)
+	require.Equal(math.LegacyNewDec(20), validator.LiquidShares)
+	require.Equal(math.NewInt(20), keeper.GetTotalLiquidStakedTokens(ctx))
+
+	// the liquid staker cannot back the validator bond
+	_, err = msgServer.ValidatorBond(ctx, stakingtypes.NewMsgValidatorBond(liquidStaker, ValAddr))
+	require.ErrorIs(err, stakingtypes.ErrValidatorBondNotAllowedFromLiquidStaker)
+
+	// the validator bond cannot be unbonded below the liquid delegations
+	_, err = msgServer.Undelegate(ctx, stakingtypes.NewMsgUndelegate(Addr, ValAddr, coin(1)))
+	require.ErrorIs(err, stakingtypes.ErrInsufficientValidatorBondShares)
+
+	_, err = msgServer.Undelegate(ctx, stakingtypes.NewMsgUndelegate(liquidStaker, ValAddr, coin(12)))
+	require.NoError(err)
+
+	validator, found = keeper.GetValidator(ctx, ValAddr)
+	require.True(found)
+	require.Equal(math.LegacyNewDec(8), validator.LiquidShares)
+	require.Equal(math.NewInt(8), keeper.GetTotalLiquidStakedTokens(ctx))
+
+	_, err = msgServer.Undelegate(ctx, stakingtypes.NewMsgUndelegate(Addr, ValAddr, coin(6)))
+	require.NoError(err)
+}
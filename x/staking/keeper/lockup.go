@@ -0,0 +1,248 @@
+package keeper
+
+import (
+	"time"
+
+	"cosmossdk.io/math"
+	storetypes "cosmossdk.io/store/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/staking/types"
+)
+
+// GetDelegationLock returns the lock of a delegation.
+func (k Keeper) GetDelegationLock(ctx sdk.Context, delAddr sdk.AccAddress, valAddr sdk.ValAddress) (lock types.DelegationLock, found bool) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.GetDelegationLockKey(delAddr, valAddr))
+	if bz == nil {
+		return lock, false
+	}
+
+	k.cdc.MustUnmarshal(bz, &lock)
+	return lock, true
+}
+
+// SetDelegationLock sets the lock of a delegation and inserts it into the
+// delegation lock queue.
+func (k Keeper) SetDelegationLock(ctx sdk.Context, lock types.DelegationLock) {
+	delAddr, err := k.authKeeper.StringToBytes(lock.DelegatorAddress)
+	if err != nil {
+		panic(err)
+	}
+	valAddr, err := sdk.ValAddressFromBech32(lock.ValidatorAddress)
+	if err != nil {
+		panic(err)
+	}
+
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.GetDelegationLockKey(delAddr, valAddr), k.cdc.MustMarshal(&lock))
+
+	timeSlice := k.GetDelegationLockQueueTimeSlice(ctx, lock.EndTime)
+	timeSlice = append(timeSlice, types.DVPair{DelegatorAddress: lock.DelegatorAddress, ValidatorAddress: lock.ValidatorAddress})
+	k.SetDelegationLockQueueTimeSlice(ctx, lock.EndTime, timeSlice)
+}
+
+// RemoveDelegationLock removes the lock of a delegation, if any, together with
+// its entry in the delegation lock queue.
+func (k Keeper) RemoveDelegationLock(ctx sdk.Context, delAddr sdk.AccAddress, valAddr sdk.ValAddress) {
+	lock, found := k.GetDelegationLock(ctx, delAddr, valAddr)
+	if !found {
+		return
+	}
+
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.GetDelegationLockKey(delAddr, valAddr))
+
+	timeSlice := k.GetDelegationLockQueueTimeSlice(ctx, lock.EndTime)
+	for i, pair := range timeSlice {
+		if pair.DelegatorAddress == lock.DelegatorAddress && pair.ValidatorAddress == lock.ValidatorAddress {
+			timeSlice = append(timeSlice[:i], timeSlice[i+1:]...)
+			break
+		}
+	}
+
+	if len(timeSlice) == 0 {
+		store.Delete(types.GetDelegationLockTimeKey(lock.EndTime))
+	} else {
+		k.SetDelegationLockQueueTimeSlice(ctx, lock.EndTime, timeSlice)
+	}
+}
+
+// GetAllDelegationLocks returns all the delegation locks.
+func (k Keeper) GetAllDelegationLocks(ctx sdk.Context) (locks []types.DelegationLock) {
+	store := ctx.KVStore(k.storeKey)
+	iterator := storetypes.KVStorePrefixIterator(store, types.DelegationLockKey)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var lock types.DelegationLock
+		k.cdc.MustUnmarshal(iterator.Value(), &lock)
+		locks = append(locks, lock)
+	}
+
+	return locks
+}
+
+// GetDelegationLockQueueTimeSlice gets a specific delegation lock queue
+// timeslice. A timeslice is a slice of DVPairs corresponding to the locks
+// which end at a certain time.
+func (k Keeper) GetDelegationLockQueueTimeSlice(ctx sdk.Context, timestamp time.Time) (dvPairs []types.DVPair) {
+	store := ctx.KVStore(k.storeKey)
+
+	bz := store.Get(types.GetDelegationLockTimeKey(timestamp))
+	if bz == nil {
+		return []types.DVPair{}
+	}
+
+	pairs := types.DVPairs{}
+	k.cdc.MustUnmarshal(bz, &pairs)
+
+	return pairs.Pairs
+}
+
+// SetDelegationLockQueueTimeSlice sets a specific delegation lock queue
+// timeslice.
+func (k Keeper) SetDelegationLockQueueTimeSlice(ctx sdk.Context, timestamp time.Time, keys []types.DVPair) {
+	store := ctx.KVStore(k.storeKey)
+	bz := k.cdc.MustMarshal(&types.DVPairs{Pairs: keys})
+	store.Set(types.GetDelegationLockTimeKey(timestamp), bz)
+}
+
+// DelegationLockQueueIterator returns all the delegation lock queue
+// timeslices from time 0 until endTime.
+func (k Keeper) DelegationLockQueueIterator(ctx sdk.Context, endTime time.Time) storetypes.Iterator {
+	store := ctx.KVStore(k.storeKey)
+	return store.Iterator(types.DelegationLockQueueKey, storetypes.InclusiveEndBytes(types.GetDelegationLockTimeKey(endTime)))
+}
+
+// IsDelegationLocked returns true if the delegation has a lock which has not
+// ended yet.
+func (k Keeper) IsDelegationLocked(ctx sdk.Context, delAddr sdk.AccAddress, valAddr sdk.ValAddress) bool {
+	lock, found := k.GetDelegationLock(ctx, delAddr, valAddr)
+	return found && ctx.BlockHeader().Time.Before(lock.EndTime)
+}
+
+// checkDelegationUnlocked returns an error if shares cannot be removed from
+// the delegation because it is locked.
+func (k Keeper) checkDelegationUnlocked(ctx sdk.Context, delAddr sdk.AccAddress, valAddr sdk.ValAddress) error {
+	lock, found := k.GetDelegationLock(ctx, delAddr, valAddr)
+	if found && ctx.BlockHeader().Time.Before(lock.EndTime) {
+		return types.ErrDelegationLocked.Wrapf("locked until %s", lock.EndTime)
+	}
+
+	return nil
+}
+
+// DelegationRewardMultiplier returns the factor applied by the distribution
+// module to the rewards of a delegation. It is the reward multiplier of the
+// lockup term of the delegation lock until the lock is released, and one
+// otherwise.
+func (k Keeper) DelegationRewardMultiplier(ctx sdk.Context, delAddr sdk.AccAddress, valAddr sdk.ValAddress) math.LegacyDec {
+	lock, found := k.GetDelegationLock(ctx, delAddr, valAddr)
+	if !found {
+		return math.LegacyOneDec()
+	}
+
+	return lock.RewardMultiplier
+}
+
+// LockDelegation locks a delegation for one of the lockup terms of the params.
+// The rewards accrued so far are settled at the current multiplier. A
+// delegation which is already locked can only be locked again if the new lock
+// does not end before the current one.
+func (k Keeper) LockDelegation(
+	ctx sdk.Context, delAddr sdk.AccAddress, valAddr sdk.ValAddress, duration time.Duration,
+) (types.DelegationLock, error) {
+	term, found := k.GetParams(ctx).LockupTerm(duration)
+	if !found {
+		return types.DelegationLock{}, types.ErrInvalidLockupTerm.Wrapf("no lockup term of %s", duration)
+	}
+
+	if _, found := k.GetDelegation(ctx, delAddr, valAddr); !found {
+		return types.DelegationLock{}, types.ErrNoDelegation
+	}
+
+	endTime := ctx.BlockHeader().Time.Add(term.Duration)
+	if current, found := k.GetDelegationLock(ctx, delAddr, valAddr); found && endTime.Before(current.EndTime) {
+		return types.DelegationLock{}, types.ErrInvalidLockupTerm.Wrapf(
+			"new lock ends at %s, before the current lock at %s", endTime, current.EndTime,
+		)
+	}
+
+	if err := k.settleDelegationRewards(ctx, delAddr, valAddr); err != nil {
+		return types.DelegationLock{}, err
+	}
+
+	k.RemoveDelegationLock(ctx, delAddr, valAddr)
+
+	lock := types.NewDelegationLock(delAddr, valAddr, term, endTime)
+	k.SetDelegationLock(ctx, lock)
+
+	return lock, nil
+}
+
+// ReleaseExpiredDelegationLocks releases all the delegation locks whose end
+// time is at or before the current block time, settling the rewards accrued
+// under the lock at its multiplier.
+func (k Keeper) ReleaseExpiredDelegationLocks(ctx sdk.Context) {
+	store := ctx.KVStore(k.storeKey)
+	blockTime := ctx.BlockHeader().Time
+
+	// gets an iterator for all timeslices from time 0 until the current Blockheader time
+	iterator := k.DelegationLockQueueIterator(ctx, blockTime)
+	defer iterator.Close()
+
+	var pairs []types.DVPair
+	for ; iterator.Valid(); iterator.Next() {
+		timeSlice := types.DVPairs{}
+		k.cdc.MustUnmarshal(iterator.Value(), &timeSlice)
+
+		pairs = append(pairs, timeSlice.Pairs...)
+
+		store.Delete(iterator.Key())
+	}
+
+	for _, pair := range pairs {
+		valAddr, err := sdk.ValAddressFromBech32(pair.ValidatorAddress)
+		if err != nil {
+			panic(err)
+		}
+		delAddr, err := k.authKeeper.StringToBytes(pair.DelegatorAddress)
+		if err != nil {
+			panic(err)
+		}
+
+		lock, found := k.GetDelegationLock(ctx, delAddr, valAddr)
+		if !found || lock.EndTime.After(blockTime) {
+			continue
+		}
+
+		if err := k.settleDelegationRewards(ctx, delAddr, valAddr); err != nil {
+			k.Logger(ctx).Error("failed to settle the rewards of an expired delegation lock", "error", err)
+		}
+		store.Delete(types.GetDelegationLockKey(delAddr, valAddr))
+
+		ctx.EventManager().EmitEvent(
+			sdk.NewEvent(
+				types.EventTypeDelegationLockExpired,
+				sdk.NewAttribute(types.AttributeKeyDelegator, pair.DelegatorAddress),
+				sdk.NewAttribute(types.AttributeKeyValidator, pair.ValidatorAddress),
+			),
+		)
+	}
+}
+
+// settleDelegationRewards calls the delegation modification hooks without
+// modifying the delegation, so that the rewards accrued so far are withdrawn
+// at the current reward multiplier.
+func (k Keeper) settleDelegationRewards(ctx sdk.Context, delAddr sdk.AccAddress, valAddr sdk.ValAddress) error {
+	if _, found := k.GetDelegation(ctx, delAddr, valAddr); !found {
+		return nil
+	}
+
+	if err := k.Hooks().BeforeDelegationSharesModified(ctx, delAddr, valAddr); err != nil {
+		return err
+	}
+
+	return k.Hooks().AfterDelegationModified(ctx, delAddr, valAddr)
+}
@@ -0,0 +1,84 @@
+package keeper_test
+
+import (
+	"time"
+
+	sdkmath "cosmossdk.io/math"
+
+	"github.com/cosmos/cosmos-sdk/crypto/keys/ed25519"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+)
+
+func (s *KeeperTestSuite) TestLockDelegation() {
+	ctx, keeper, msgServer := s.ctx, s.stakingKeeper, s.msgServer
+	require := s.Require()
+	s.execExpectCalls()
+
+	month := 30 * 24 * time.Hour
+	ctx = ctx.WithBlockTime(time.Unix(1_000_000, 0).UTC())
+
+	comm := stakingtypes.NewCommissionRates(sdkmath.LegacyNewDec(0), sdkmath.LegacyNewDec(0), sdkmath.LegacyNewDec(0))
+	createMsg, err := stakingtypes.NewMsgCreateValidator(ValAddr, ed25519.GenPrivKey().PubKey(), sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(10)), stakingtypes.Description{Moniker: "NewVal"}, comm, sdkmath.OneInt())
+	require.NoError(err)
+	_, err = msgServer.CreateValidator(ctx, createMsg)
+	require.NoError(err)
+
+	// lockups are disabled by default
+	_, err = msgServer.LockDelegation(ctx, stakingtypes.NewMsgLockDelegation(Addr, ValAddr, month))
+	require.ErrorIs(err, stakingtypes.ErrInvalidLockupTerm)
+
+	params := keeper.GetParams(ctx)
+	params.LockupTerms = []stakingtypes.LockupTerm{
+		{Duration: month, RewardMultiplier: sdkmath.LegacyNewDecWithPrec(11, 1)},
+		{Duration: 12 * month, RewardMultiplier: sdkmath.LegacyNewDecWithPrec(15, 1)},
+	}
+	require.NoError(keeper.SetParams(ctx, params))
+
+	// only an existing delegation can be locked
+	_, err = msgServer.LockDelegation(ctx, stakingtypes.NewMsgLockDelegation(Addr, ValAddr, month))
+	require.ErrorIs(err, stakingtypes.ErrNoDelegation)
+
+	amt := sdk.NewCoin(sdk.DefaultBondDenom, keeper.TokensFromConsensusPower(ctx, 100))
+	_, err = msgServer.Delegate(ctx, stakingtypes.NewMsgDelegate(Addr, ValAddr, amt))
+	require.NoError(err)
+	require.Equal(sdkmath.LegacyOneDec(), keeper.DelegationRewardMultiplier(ctx, Addr, ValAddr))
+
+	res, err := msgServer.LockDelegation(ctx, stakingtypes.NewMsgLockDelegation(Addr, ValAddr, 12*month))
+	require.NoError(err)
+	require.Equal(ctx.BlockTime().Add(12*month), res.EndTime)
+	require.Equal(sdkmath.LegacyNewDecWithPrec(15, 1), keeper.DelegationRewardMultiplier(ctx, Addr, ValAddr))
+
+	// a lock cannot be shortened
+	_, err = msgServer.LockDelegation(ctx, stakingtypes.NewMsgLockDelegation(Addr, ValAddr, month))
+	require.ErrorIs(err, stakingtypes.ErrInvalidLockupTerm)
+
+	lockRes, err := s.queryClient.DelegationLock(ctx, &stakingtypes.QueryDelegationLockRequest{DelegatorAddr: Addr.String(), ValidatorAddr: ValAddr.String()})
+	require.NoError(err)
+	require.Equal(res.EndTime, lockRes.Lock.EndTime)
+	require.False(lockRes.Expired)
+
+	locksRes, err := s.queryClient.DelegatorDelegationLocks(ctx, &stakingtypes.QueryDelegatorDelegationLocksRequest{DelegatorAddr: Addr.String()})
+	require.NoError(err)
+	require.Len(locksRes.Locks, 1)
+
+	// the delegation cannot be undelegated before the lock ends
+	shares := sdkmath.LegacyNewDecFromInt(amt.Amount)
+	_, _, err = keeper.Undelegate(ctx, Addr, ValAddr, shares)
+	require.ErrorIs(err, stakingtypes.ErrDelegationLocked)
+
+	// the lock is kept until its end time
+	keeper.ReleaseExpiredDelegationLocks(ctx.WithBlockTime(res.EndTime.Add(-time.Second)))
+	require.True(keeper.IsDelegationLocked(ctx, Addr, ValAddr))
+
+	releaseCtx := ctx.WithBlockTime(res.EndTime).WithEventManager(sdk.NewEventManager())
+	keeper.ReleaseExpiredDelegationLocks(releaseCtx)
+	_, found := keeper.GetDelegationLock(releaseCtx, Addr, ValAddr)
+	require.False(found)
+	require.Empty(keeper.GetAllDelegationLocks(releaseCtx))
+	require.Equal(sdkmath.LegacyOneDec(), keeper.DelegationRewardMultiplier(releaseCtx, Addr, ValAddr))
+
+	// the delegation can be undelegated once released
+	_, _, err = keeper.Undelegate(releaseCtx, Addr, ValAddr, shares)
+	require.NoError(err)
+}
@@ -7,6 +7,8 @@ import (
 	v3 "github.com/cosmos/cosmos-sdk/x/staking/migrations/v3"
 	v4 "github.com/cosmos/cosmos-sdk/x/staking/migrations/v4"
 	v5 "github.com/cosmos/cosmos-sdk/x/staking/migrations/v5"
+	v6 "github.com/cosmos/cosmos-sdk/x/staking/migrations/v6"
+	v7 "github.com/cosmos/cosmos-sdk/x/staking/migrations/v7"
 )
 
 // Migrator is a struct for handling in-place store migrations.
@@ -42,3 +44,36 @@ func (m Migrator) Migrate3to4(ctx sdk.Context) error {
 func (m Migrator) Migrate4to5(ctx sdk.Context) error {
 	return v5.MigrateStore(ctx, m.keeper.storeKey, m.keeper.cdc)
 }
+
+// Migrate5to6 migrates x/staking state from consensus version 5 to 6.
+func (m Migrator) Migrate5to6(ctx sdk.Context) error {
+	return v6.MigrateStore(ctx, m.keeper.storeKey, m.keeper.cdc)
+}
+
+// Migrate6to7 migrates x/staking state from consensus version 6 to 7. It
+// sweeps the dust delegations if the min delegation amount is set.
+func (m Migrator) Migrate6to7(ctx sdk.Context) error {
+	if err := v7.MigrateStore(ctx, m.keeper.storeKey, m.keeper.cdc); err != nil {
+		return err
+	}
+
+	m.keeper.SweepDustDelegations(ctx)
+	return nil
+}
+
+// Migrate7to8 migrates x/staking state from consensus version 7 to 8. It adds
+// the delegations of the liquid staking providers to the liquid shares of
+// their validators.
+func (m Migrator) Migrate7to8(ctx sdk.Context) error {
+	m.keeper.addLiquidStakerDelegations(ctx)
+	return nil
+}
+
+// Migrate8to9 migrates x/staking state from consensus version 8 to 9. It tracks
+// the tokens of the bonded validators as escrows of the bonded pool in x/bank.
+func (m Migrator) Migrate8to9(ctx sdk.Context) error {
+	for _, validator := range m.keeper.GetAllValidators(ctx) {
+		m.keeper.setValidatorEscrow(ctx, validator)
+	}
+	return nil
+}
@@ -0,0 +1,108 @@
+package keeper
+
+import (
+	"cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/staking/types"
+)
+
+// checkMinDelegationAmount returns an error if a delegation of the given
+// amount of staking tokens is below the minimum delegation amount.
+func (k Keeper) checkMinDelegationAmount(ctx sdk.Context, tokens math.Int) error {
+	minAmount := k.MinDelegationAmount(ctx)
+	if tokens.LT(minAmount) {
+		return types.ErrDelegationBelowMinimum.Wrapf("got %s, minimum %s", tokens, minAmount)
+	}
+
+	return nil
+}
+
+// checkMinUnbondAmount returns an error if the undelegation or redelegation of
+// the given amount of staking tokens, backed by the given shares, is below the
+// minimum delegation amount or would leave a delegation below it. Withdrawing
+// the whole delegation is always allowed.
+func (k Keeper) checkMinUnbondAmount(
+	ctx sdk.Context, delAddr sdk.AccAddress, valAddr sdk.ValAddress, tokens math.Int, shares math.LegacyDec,
+) error {
+	minAmount := k.MinDelegationAmount(ctx)
+	if !minAmount.IsPositive() {
+		return nil
+	}
+
+	validator, found := k.GetValidator(ctx, valAddr)
+	if !found {
+		return types.ErrNoValidatorFound
+	}
+
+	delegation, found := k.GetDelegation(ctx, delAddr, valAddr)
+	if !found {
+		return types.ErrNoDelegation
+	}
+
+	if shares.GTE(delegation.Shares) {
+		return nil
+	}
+
+	if tokens.LT(minAmount) {
+		return types.ErrUndelegationBelowMinimum.Wrapf("got %s, minimum %s", tokens, minAmount)
+	}
+
+	remaining := validator.TokensFromShares(delegation.Shares.Sub(shares)).TruncateInt()
+	if remaining.LT(minAmount) {
+		return types.ErrDustDelegationRemainder.Wrapf("remaining %s, minimum %s", remaining, minAmount)
+	}
+
+	return nil
+}
+
+// SweepDustDelegations undelegates the delegations worth less than the minimum
+// delegation amount, except the self delegations of the validators. The
+// delegations which cannot be undelegated, e.g. because they are locked or
+// have too many unbonding entries, are kept. It returns the number of swept
+// delegations.
+func (k Keeper) SweepDustDelegations(ctx sdk.Context) int {
+	minAmount := k.MinDelegationAmount(ctx)
+	if !minAmount.IsPositive() {
+		return 0
+	}
+
+	var dust []types.Delegation
+	k.IterateAllDelegations(ctx, func(delegation types.Delegation) bool {
+		delAddr := delegation.GetDelegatorAddr()
+		valAddr := delegation.GetValidatorAddr()
+		if sdk.ValAddress(delAddr).Equals(valAddr) {
+			return false
+		}
+
+		validator, found := k.GetValidator(ctx, valAddr)
+		if !found {
+			return false
+		}
+
+		if validator.TokensFromShares(delegation.Shares).TruncateInt().LT(minAmount) {
+			dust = append(dust, delegation)
+		}
+
+		return false
+	})
+
+	swept := 0
+	for _, delegation := range dust {
+		cacheCtx, write := ctx.CacheContext()
+		if _, _, err := k.Undelegate(cacheCtx, delegation.GetDelegatorAddr(), delegation.GetValidatorAddr(), delegation.Shares); err != nil {
+			k.Logger(ctx).Info(
+				"keeping dust delegation",
+				"delegator", delegation.DelegatorAddress,
+				"validator", delegation.ValidatorAddress,
+				"err", err,
+			)
+			continue
+		}
+
+		write()
+		swept++
+	}
+
+	return swept
+}
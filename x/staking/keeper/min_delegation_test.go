@@ -0,0 +1,68 @@
+package keeper_test
+
+import (
+	sdkmath "cosmossdk.io/math"
+	"github.com/golang/mock/gomock"
+
+	"github.com/cosmos/cosmos-sdk/crypto/keys/ed25519"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+)
+
+func (s *KeeperTestSuite) TestMinDelegationAmount() {
+	ctx, keeper, msgServer := s.ctx, s.stakingKeeper, s.msgServer
+	require := s.Require()
+	s.execExpectCalls()
+
+	delAddr := sdk.AccAddress(PKS[1].Address())
+	s.accountKeeper.EXPECT().StringToBytes(delAddr.String()).Return(delAddr, nil).AnyTimes()
+	s.bankKeeper.EXPECT().DelegateCoinsFromAccountToModule(gomock.Any(), delAddr, stakingtypes.NotBondedPoolName, gomock.Any()).AnyTimes()
+
+	comm := stakingtypes.NewCommissionRates(sdkmath.LegacyNewDec(0), sdkmath.LegacyNewDec(0), sdkmath.LegacyNewDec(0))
+	createMsg, err := stakingtypes.NewMsgCreateValidator(ValAddr, ed25519.GenPrivKey().PubKey(), sdk.NewInt64Coin(sdk.DefaultBondDenom, 10), stakingtypes.Description{Moniker: "NewVal"}, comm, sdkmath.OneInt())
+	require.NoError(err)
+	_, err = msgServer.CreateValidator(ctx, createMsg)
+	require.NoError(err)
+
+	params := keeper.GetParams(ctx)
+	params.MinDelegationAmount = sdkmath.NewInt(100)
+	require.NoError(keeper.SetParams(ctx, params))
+
+	coin := func(amt int64) sdk.Coin { return sdk.NewInt64Coin(sdk.DefaultBondDenom, amt) }
+
+	_, err = msgServer.Delegate(ctx, stakingtypes.NewMsgDelegate(delAddr, ValAddr, coin(50)))
+	require.ErrorIs(err, stakingtypes.ErrDelegationBelowMinimum)
+
+	_, err = msgServer.Delegate(ctx, stakingtypes.NewMsgDelegate(delAddr, ValAddr, coin(300)))
+	require.NoError(err)
+
+	// undelegations must be above the minimum and leave no dust behind
+	_, err = msgServer.Undelegate(ctx, stakingtypes.NewMsgUndelegate(delAddr, ValAddr, coin(50)))
+	require.ErrorIs(err, stakingtypes.ErrUndelegationBelowMinimum)
+
+	_, err = msgServer.Undelegate(ctx, stakingtypes.NewMsgUndelegate(delAddr, ValAddr, coin(250)))
+	require.ErrorIs(err, stakingtypes.ErrDustDelegationRemainder)
+
+	_, err = msgServer.Undelegate(ctx, stakingtypes.NewMsgUndelegate(delAddr, ValAddr, coin(150)))
+	require.NoError(err)
+
+	// the whole delegation can always be withdrawn
+	_, err = msgServer.Undelegate(ctx, stakingtypes.NewMsgUndelegate(delAddr, ValAddr, coin(150)))
+	require.NoError(err)
+	_, found := keeper.GetDelegation(ctx, delAddr, ValAddr)
+	require.False(found)
+
+	// raising the minimum sweeps the delegations below it, but the self
+	// delegations
+	_, err = msgServer.Delegate(ctx, stakingtypes.NewMsgDelegate(delAddr, ValAddr, coin(300)))
+	require.NoError(err)
+
+	params.MinDelegationAmount = sdkmath.NewInt(500)
+	require.NoError(keeper.SetParams(ctx, params))
+	require.Equal(1, keeper.SweepDustDelegations(ctx))
+
+	_, found = keeper.GetDelegation(ctx, delAddr, ValAddr)
+	require.False(found)
+	_, found = keeper.GetDelegation(ctx, Addr, ValAddr)
+	require.True(found)
+}
@@ -1,6 +1,7 @@
 package keeper
 
 import (
+	"bytes"
 	"context"
 	"strconv"
 	"time"
@@ -16,6 +17,8 @@ import (
 	"github.com/cosmos/cosmos-sdk/telemetry"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	vestexported "github.com/cosmos/cosmos-sdk/x/auth/vesting/exported"
 	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
 	"github.com/cosmos/cosmos-sdk/x/staking/types"
 )
@@ -44,6 +47,9 @@ func (k msgServer) CreateValidator(goCtx context.Context, msg *types.MsgCreateVa
 	}
 
 	ctx := sdk.UnwrapSDKContext(goCtx)
+	if err := k.checkStakingNotPaused(ctx); err != nil {
+		return nil, err
+	}
 
 	if msg.Commission.Rate.LT(k.MinCommissionRate(ctx)) {
 		return nil, errorsmod.Wrapf(types.ErrCommissionLTMinRate, "cannot set validator commission to less than minimum rate of %s", k.MinCommissionRate(ctx))
@@ -62,6 +68,9 @@ func (k msgServer) CreateValidator(goCtx context.Context, msg *types.MsgCreateVa
 	if _, found := k.GetValidatorByConsAddr(ctx, sdk.GetConsAddress(pk)); found {
 		return nil, types.ErrValidatorPubKeyExists
 	}
+	if k.IsStandbyConsAddr(ctx, sdk.GetConsAddress(pk)) {
+		return nil, errorsmod.Wrap(types.ErrValidatorPubKeyExists, "pubkey is the standby consensus pubkey of a validator")
+	}
 
 	bondDenom := k.BondDenom(ctx)
 	if msg.Value.Denom != bondDenom {
@@ -70,26 +79,18 @@ func (k msgServer) CreateValidator(goCtx context.Context, msg *types.MsgCreateVa
 		)
 	}
 
+	if floor := k.MinSelfDelegationFloor(ctx); msg.Value.Amount.LT(floor) {
+		return nil, errorsmod.Wrapf(
+			types.ErrSelfDelegationBelowMinimum, "self delegation %s is below the minimum self delegation floor %s", msg.Value.Amount, floor,
+		)
+	}
+
 	if _, err := msg.Description.EnsureLength(); err != nil {
 		return nil, err
 	}
 
-	cp := ctx.ConsensusParams()
-	if cp.Validator != nil {
-		pkType := pk.Type()
-		hasKeyType := false
-		for _, keyType := range cp.Validator.PubKeyTypes {
-			if pkType == keyType {
-				hasKeyType = true
-				break
-			}
-		}
-		if !hasKeyType {
-			return nil, errorsmod.Wrapf(
-				types.ErrValidatorPubKeyTypeNotSupported,
-				"got: %s, expected: %s", pk.Type(), cp.Validator.PubKeyTypes,
-			)
-		}
+	if err := checkPubKeyType(ctx, pk); err != nil {
+		return nil, err
 	}
 
 	validator, err := types.NewValidator(valAddr, pk, msg.Description)
@@ -177,12 +178,31 @@ func (k msgServer) EditValidator(goCtx context.Context, msg *types.MsgEditValida
 
 	validator.Description = description
 
-	if msg.CommissionRate != nil {
+	// with a commission change notice period the new rate is announced and
+	// applied by the EndBlocker once the notice period has elapsed
+	if msg.CommissionRate != nil && k.CommissionChangeNoticePeriod(ctx) > 0 {
+		change, err := k.ScheduleCommissionChange(ctx, validator, *msg.CommissionRate)
+		if err != nil {
+			return nil, err
+		}
+
+		ctx.EventManager().EmitEvent(
+			sdk.NewEvent(
+				types.EventTypeScheduleCommissionChange,
+				sdk.NewAttribute(types.AttributeKeyValidator, msg.ValidatorAddress),
+				sdk.NewAttribute(types.AttributeKeyCommissionRate, change.Rate.String()),
+				sdk.NewAttribute(types.AttributeKeyEffectiveTime, change.EffectiveTime.Format(time.RFC3339)),
+			),
+		)
+	} else if msg.CommissionRate != nil {
 		commission, err := k.UpdateValidatorCommission(ctx, validator, *msg.CommissionRate)
 		if err != nil {
 			return nil, err
 		}
 
+		// an immediate change supersedes any previously announced one
+		k.RemovePendingCommissionChange(ctx, valAddr)
+
 		// call the before-modification hook since we're about to update the commission
 		if err := k.Hooks().BeforeValidatorModified(ctx, valAddr); err != nil {
 			return nil, err
@@ -205,6 +225,9 @@ func (k msgServer) EditValidator(goCtx context.Context, msg *types.MsgEditValida
 
 	k.SetValidator(ctx, validator)
 
+	// the attestations of the replaced profile values no longer hold
+	k.PruneStaleProfileAttestations(ctx, validator)
+
 	ctx.EventManager().EmitEvents(sdk.Events{
 		sdk.NewEvent(
 			types.EventTypeEditValidator,
@@ -217,7 +240,27 @@ func (k msgServer) EditValidator(goCtx context.Context, msg *types.MsgEditValida
 }
 
 // Delegate defines a method for performing a delegation of coins from a delegator to a validator
+// When epochs are enabled, the message is queued until the end of the epoch.
 func (k msgServer) Delegate(goCtx context.Context, msg *types.MsgDelegate) (*types.MsgDelegateResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	if err := k.checkStakingNotPaused(ctx); err != nil {
+		return nil, err
+	}
+
+	if k.EpochLength(ctx) > 0 {
+		if err := k.queueEpochMsg(ctx, msg); err != nil {
+			return nil, err
+		}
+
+		return &types.MsgDelegateResponse{}, nil
+	}
+
+	return k.delegate(ctx, msg)
+}
+
+// delegate performs the delegation, either right away or at the end of the
+// epoch.
+func (k msgServer) delegate(goCtx context.Context, msg *types.MsgDelegate) (*types.MsgDelegateResponse, error) {
 	valAddr, valErr := sdk.ValAddressFromBech32(msg.ValidatorAddress)
 	if valErr != nil {
 		return nil, sdkerrors.ErrInvalidAddress.Wrapf("invalid validator address: %s", valErr)
@@ -242,15 +285,14 @@ func (k msgServer) Delegate(goCtx context.Context, msg *types.MsgDelegate) (*typ
 		return nil, types.ErrNoValidatorFound
 	}
 
-	bondDenom := k.BondDenom(ctx)
-	if msg.Amount.Denom != bondDenom {
-		return nil, errorsmod.Wrapf(
-			sdkerrors.ErrInvalidRequest, "invalid coin denomination: got %s, expected %s", msg.Amount.Denom, bondDenom,
-		)
+	if weight, found := k.GetParams(ctx).DenomWeight(msg.Amount.Denom); found {
+		if err := k.checkMinDelegationAmount(ctx, weight.MulInt(msg.Amount.Amount).TruncateInt()); err != nil {
+			return nil, err
+		}
 	}
 
 	// NOTE: source funds are always unbonded
-	newShares, err := k.Keeper.Delegate(ctx, delegatorAddress, msg.Amount.Amount, types.Unbonded, validator, true)
+	newShares, err := k.Keeper.DelegateCoin(ctx, delegatorAddress, msg.Amount, types.Unbonded, validator, true)
 	if err != nil {
 		return nil, err
 	}
@@ -279,7 +321,27 @@ func (k msgServer) Delegate(goCtx context.Context, msg *types.MsgDelegate) (*typ
 }
 
 // BeginRedelegate defines a method for performing a redelegation of coins from a delegator and source validator to a destination validator
+// When epochs are enabled, the message is queued until the end of the epoch.
 func (k msgServer) BeginRedelegate(goCtx context.Context, msg *types.MsgBeginRedelegate) (*types.MsgBeginRedelegateResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	if err := k.checkStakingNotPaused(ctx); err != nil {
+		return nil, err
+	}
+
+	if k.EpochLength(ctx) > 0 {
+		if err := k.queueEpochMsg(ctx, msg); err != nil {
+			return nil, err
+		}
+
+		return &types.MsgBeginRedelegateResponse{}, nil
+	}
+
+	return k.beginRedelegate(ctx, msg)
+}
+
+// beginRedelegate performs the redelegation, either right away or at the end
+// of the epoch.
+func (k msgServer) beginRedelegate(goCtx context.Context, msg *types.MsgBeginRedelegate) (*types.MsgBeginRedelegateResponse, error) {
 	valSrcAddr, err := sdk.ValAddressFromBech32(msg.ValidatorSrcAddress)
 	if err != nil {
 		return nil, sdkerrors.ErrInvalidAddress.Wrapf("invalid source validator address: %s", err)
@@ -304,18 +366,20 @@ func (k msgServer) BeginRedelegate(goCtx context.Context, msg *types.MsgBeginRed
 
 	ctx := sdk.UnwrapSDKContext(goCtx)
 
+	tokens, err := k.DelegationTokens(ctx, delegatorAddress, valSrcAddr, msg.Amount)
+	if err != nil {
+		return nil, err
+	}
+
 	shares, err := k.ValidateUnbondAmount(
-		ctx, delegatorAddress, valSrcAddr, msg.Amount.Amount,
+		ctx, delegatorAddress, valSrcAddr, tokens,
 	)
 	if err != nil {
 		return nil, err
 	}
 
-	bondDenom := k.BondDenom(ctx)
-	if msg.Amount.Denom != bondDenom {
-		return nil, errorsmod.Wrapf(
-			sdkerrors.ErrInvalidRequest, "invalid coin denomination: got %s, expected %s", msg.Amount.Denom, bondDenom,
-		)
+	if err := k.checkMinUnbondAmount(ctx, delegatorAddress, valSrcAddr, tokens, shares); err != nil {
+		return nil, err
 	}
 
 	completionTime, err := k.BeginRedelegation(
@@ -352,7 +416,27 @@ func (k msgServer) BeginRedelegate(goCtx context.Context, msg *types.MsgBeginRed
 }
 
 // Undelegate defines a method for performing an undelegation from a delegate and a validator
+// When epochs are enabled, the message is queued until the end of the epoch.
 func (k msgServer) Undelegate(goCtx context.Context, msg *types.MsgUndelegate) (*types.MsgUndelegateResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	if err := k.checkStakingNotPaused(ctx); err != nil {
+		return nil, err
+	}
+
+	if k.EpochLength(ctx) > 0 {
+		if err := k.queueEpochMsg(ctx, msg); err != nil {
+			return nil, err
+		}
+
+		return &types.MsgUndelegateResponse{}, nil
+	}
+
+	return k.undelegate(ctx, msg)
+}
+
+// undelegate performs the undelegation, either right away or at the end of
+// the epoch.
+func (k msgServer) undelegate(goCtx context.Context, msg *types.MsgUndelegate) (*types.MsgUndelegateResponse, error) {
 	addr, err := sdk.ValAddressFromBech32(msg.ValidatorAddress)
 	if err != nil {
 		return nil, sdkerrors.ErrInvalidAddress.Wrapf("invalid validator address: %s", err)
@@ -372,18 +456,20 @@ func (k msgServer) Undelegate(goCtx context.Context, msg *types.MsgUndelegate) (
 
 	ctx := sdk.UnwrapSDKContext(goCtx)
 
+	tokens, err := k.DelegationTokens(ctx, delegatorAddress, addr, msg.Amount)
+	if err != nil {
+		return nil, err
+	}
+
 	shares, err := k.ValidateUnbondAmount(
-		ctx, delegatorAddress, addr, msg.Amount.Amount,
+		ctx, delegatorAddress, addr, tokens,
 	)
 	if err != nil {
 		return nil, err
 	}
 
-	bondDenom := k.BondDenom(ctx)
-	if msg.Amount.Denom != bondDenom {
-		return nil, errorsmod.Wrapf(
-			sdkerrors.ErrInvalidRequest, "invalid coin denomination: got %s, expected %s", msg.Amount.Denom, bondDenom,
-		)
+	if err := k.checkMinUnbondAmount(ctx, delegatorAddress, addr, tokens, shares); err != nil {
+		return nil, err
 	}
 
 	completionTime, undelegatedAmt, err := k.Keeper.Undelegate(ctx, delegatorAddress, addr, shares)
@@ -447,11 +533,14 @@ func (k msgServer) CancelUnbondingDelegation(goCtx context.Context, msg *types.M
 	}
 
 	ctx := sdk.UnwrapSDKContext(goCtx)
+	if err := k.checkStakingNotPaused(ctx); err != nil {
+		return nil, err
+	}
 
-	bondDenom := k.BondDenom(ctx)
-	if msg.Amount.Denom != bondDenom {
+	params := k.GetParams(ctx)
+	if _, found := params.DenomWeight(msg.Amount.Denom); !found {
 		return nil, errorsmod.Wrapf(
-			sdkerrors.ErrInvalidRequest, "invalid coin denomination: got %s, expected %s", msg.Amount.Denom, bondDenom,
+			sdkerrors.ErrInvalidRequest, "invalid coin denomination: got %s, expected %s or an additional bond denom", msg.Amount.Denom, params.BondDenom,
 		)
 	}
 
@@ -496,6 +585,15 @@ func (k msgServer) CancelUnbondingDelegation(goCtx context.Context, msg *types.M
 		return nil, sdkerrors.ErrNotFound.Wrapf("unbonding delegation entry is not found at block height %d", msg.CreationHeight)
 	}
 
+	entryDenom := unbondEntry.Denom
+	if entryDenom == "" {
+		entryDenom = params.BondDenom
+	}
+
+	if msg.Amount.Denom != entryDenom {
+		return nil, errorsmod.Wrapf(types.ErrDelegationDenomMismatch, "got %s, expected %s", msg.Amount.Denom, entryDenom)
+	}
+
 	if unbondEntry.Balance.LT(msg.Amount.Amount) {
 		return nil, sdkerrors.ErrInvalidRequest.Wrap("amount is greater than the unbonding delegation entry balance")
 	}
@@ -505,7 +603,7 @@ func (k msgServer) CancelUnbondingDelegation(goCtx context.Context, msg *types.M
 	}
 
 	// delegate back the unbonding delegation amount to the validator
-	_, err = k.Keeper.Delegate(ctx, delegatorAddress, msg.Amount.Amount, types.Unbonding, validator, false)
+	_, err = k.Keeper.DelegateCoin(ctx, delegatorAddress, msg.Amount, types.Unbonding, validator, false)
 	if err != nil {
 		return nil, err
 	}
@@ -551,6 +649,14 @@ func (k msgServer) UpdateParams(goCtx context.Context, msg *types.MsgUpdateParam
 
 	ctx := sdk.UnwrapSDKContext(goCtx)
 
+	// the bond denom weights are applied when bonding, thus the weights of the
+	// denoms which can already be bonded cannot be changed or removed
+	for _, w := range k.GetParams(ctx).BondDenomWeights {
+		if weight, found := msg.Params.DenomWeight(w.Denom); !found || !weight.Equal(w.Weight) {
+			return nil, errorsmod.Wrapf(types.ErrBondDenomWeightChanged, "bond denom %s", w.Denom)
+		}
+	}
+
 	// store params
 	if err := k.SetParams(ctx, msg.Params); err != nil {
 		return nil, err
@@ -558,3 +664,834 @@ func (k msgServer) UpdateParams(goCtx context.Context, msg *types.MsgUpdateParam
 
 	return &types.MsgUpdateParamsResponse{}, nil
 }
+
+// TokenizeShares defines a method for tokenizing the shares of a delegation
+// into share tokens backed by a tokenize share record
+func (k msgServer) TokenizeShares(goCtx context.Context, msg *types.MsgTokenizeShares) (*types.MsgTokenizeSharesResponse, error) {
+	valAddr, valErr := sdk.ValAddressFromBech32(msg.ValidatorAddress)
+	if valErr != nil {
+		return nil, sdkerrors.ErrInvalidAddress.Wrapf("invalid validator address: %s", valErr)
+	}
+
+	delegatorAddress, err := k.authKeeper.StringToBytes(msg.DelegatorAddress)
+	if err != nil {
+		return nil, sdkerrors.ErrInvalidAddress.Wrapf("invalid delegator address: %s", err)
+	}
+
+	if _, err := k.authKeeper.StringToBytes(msg.TokenizedShareOwner); err != nil {
+		return nil, sdkerrors.ErrInvalidAddress.Wrapf("invalid tokenized share owner address: %s", err)
+	}
+
+	if !msg.Amount.IsValid() || !msg.Amount.Amount.IsPositive() {
+		return nil, errorsmod.Wrap(
+			sdkerrors.ErrInvalidRequest,
+			"invalid shares amount",
+		)
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	if err := k.checkStakingNotPaused(ctx); err != nil {
+		return nil, err
+	}
+
+	validator, found := k.GetValidator(ctx, valAddr)
+	if !found {
+		return nil, types.ErrNoValidatorFound
+	}
+
+	delegation, found := k.GetDelegation(ctx, delegatorAddress, valAddr)
+	if !found {
+		return nil, types.ErrNoDelegatorForAddress
+	}
+
+	if delegation.ValidatorBond {
+		return nil, types.ErrValidatorBondNotAllowedForTokenizeShare
+	}
+
+	if delegation.Denom != "" {
+		return nil, types.ErrAssetDelegationNotTokenizable
+	}
+
+	bondDenom := k.BondDenom(ctx)
+	if msg.Amount.Denom != bondDenom {
+		return nil, errorsmod.Wrapf(
+			sdkerrors.ErrInvalidRequest, "invalid coin denomination: got %s, expected %s", msg.Amount.Denom, bondDenom,
+		)
+	}
+
+	// the shares received through a redelegation can still be slashed for an
+	// infraction of the source validator, which the share tokens cannot follow
+	if k.HasReceivingRedelegation(ctx, delegatorAddress, valAddr) {
+		return nil, types.ErrRedelegationInProgress
+	}
+
+	if err := k.checkDelegationUnlocked(ctx, delegatorAddress, valAddr); err != nil {
+		return nil, err
+	}
+
+	// a vesting account can only tokenize the delegation of its free coins
+	if acc, ok := k.authKeeper.GetAccount(ctx, delegatorAddress).(vestexported.VestingAccount); ok {
+		if acc.GetDelegatedFree().AmountOf(bondDenom).LT(msg.Amount.Amount) {
+			return nil, types.ErrExceedingFreeVestingDelegations
+		}
+	}
+
+	shares, err := k.ValidateUnbondAmount(
+		ctx, delegatorAddress, valAddr, msg.Amount.Amount,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	// the liquid staking caps are checked before any state change
+	tokens := validator.TokensFromShares(shares).TruncateInt()
+	if k.DelegatorIsLiquidStaker(delegatorAddress) {
+		// the shares of a liquid staking provider already count as liquid,
+		// they are only moved to the record by the unbonding below
+		k.SetTotalLiquidStakedTokens(ctx, k.GetTotalLiquidStakedTokens(ctx).Add(tokens))
+		validator.LiquidShares = validator.LiquidShares.Add(shares)
+		k.SetValidator(ctx, validator)
+	} else {
+		if k.CheckExceedsGlobalLiquidStakingCap(ctx, tokens) {
+			return nil, types.ErrGlobalLiquidStakingCapExceeded
+		}
+
+		if k.CheckExceedsValidatorBondCap(ctx, validator, shares) {
+			return nil, types.ErrInsufficientValidatorBondShares
+		}
+
+		if k.CheckExceedsValidatorLiquidStakingCap(ctx, validator, shares) {
+			return nil, types.ErrValidatorLiquidStakingCapExceeded
+		}
+
+		if err := k.SafelyIncreaseTotalLiquidStakedTokens(ctx, tokens); err != nil {
+			return nil, err
+		}
+
+		if _, err := k.SafelyIncreaseValidatorLiquidShares(ctx, valAddr, shares); err != nil {
+			return nil, err
+		}
+	}
+
+	// skip the record IDs whose module account address is already taken, in
+	// the rare case an account was created at it beforehand
+	recordID := k.GetLastTokenizeShareRecordID(ctx) + 1
+	for k.authKeeper.HasAccount(ctx, authtypes.NewModuleAddress(types.GetTokenizeShareModuleAccountName(recordID))) {
+		recordID++
+	}
+	k.SetLastTokenizeShareRecordID(ctx, recordID)
+
+	record := types.TokenizeShareRecord{
+		Id:            recordID,
+		Owner:         msg.TokenizedShareOwner,
+		ModuleAccount: types.GetTokenizeShareModuleAccountName(recordID),
+		Validator:     msg.ValidatorAddress,
+	}
+	recordAddress := record.GetModuleAddress()
+
+	// create the module account delegating the tokenized shares
+	k.authKeeper.SetAccount(ctx, k.authKeeper.NewAccount(ctx, authtypes.NewModuleAccount(
+		authtypes.NewBaseAccountWithAddress(recordAddress), record.ModuleAccount,
+	)))
+
+	if err := k.AddTokenizeShareRecord(ctx, record); err != nil {
+		return nil, err
+	}
+
+	returnAmount, err := k.Unbond(ctx, delegatorAddress, valAddr, shares)
+	if err != nil {
+		return nil, err
+	}
+
+	if validator.IsBonded() {
+		k.bondedTokensToNotBonded(ctx, returnAmount)
+	}
+
+	// the tokens go through the delegator's account to keep its vesting
+	// accounting
+	returnCoins := sdk.NewCoins(sdk.NewCoin(bondDenom, returnAmount))
+	if err := k.bankKeeper.UndelegateCoinsFromModuleToAccount(ctx, types.NotBondedPoolName, delegatorAddress, returnCoins); err != nil {
+		return nil, err
+	}
+
+	if err := k.bankKeeper.SendCoins(ctx, delegatorAddress, recordAddress, returnCoins); err != nil {
+		return nil, err
+	}
+
+	// the validator is updated by the unbonding
+	validator, found = k.GetValidator(ctx, valAddr)
+	if !found {
+		return nil, types.ErrNoValidatorFound
+	}
+
+	if _, err := k.Keeper.Delegate(ctx, recordAddress, returnAmount, types.Unbonded, validator, true); err != nil {
+		return nil, err
+	}
+
+	shareToken := sdk.NewCoin(record.GetShareTokenDenom(), shares.TruncateInt())
+	if err := k.bankKeeper.MintCoins(ctx, types.ModuleName, sdk.NewCoins(shareToken)); err != nil {
+		return nil, err
+	}
+
+	if err := k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, delegatorAddress, sdk.NewCoins(shareToken)); err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeTokenizeShares,
+			sdk.NewAttribute(types.AttributeKeyDelegator, msg.DelegatorAddress),
+			sdk.NewAttribute(types.AttributeKeyValidator, msg.ValidatorAddress),
+			sdk.NewAttribute(types.AttributeKeyShareOwner, msg.TokenizedShareOwner),
+			sdk.NewAttribute(types.AttributeKeyShareRecordID, strconv.FormatUint(record.Id, 10)),
+			sdk.NewAttribute(sdk.AttributeKeyAmount, msg.Amount.String()),
+			sdk.NewAttribute(types.AttributeKeyTokenizedShares, shareToken.String()),
+		),
+	})
+
+	return &types.MsgTokenizeSharesResponse{
+		Amount: shareToken,
+	}, nil
+}
+
+// RedeemTokensForShares defines a method for redeeming share tokens into a
+// delegation of the redeemer
+func (k msgServer) RedeemTokensForShares(goCtx context.Context, msg *types.MsgRedeemTokensForShares) (*types.MsgRedeemTokensForSharesResponse, error) {
+	delegatorAddress, err := k.authKeeper.StringToBytes(msg.DelegatorAddress)
+	if err != nil {
+		return nil, sdkerrors.ErrInvalidAddress.Wrapf("invalid delegator address: %s", err)
+	}
+
+	if !msg.Amount.IsValid() || !msg.Amount.Amount.IsPositive() {
+		return nil, errorsmod.Wrap(
+			sdkerrors.ErrInvalidRequest,
+			"invalid shares amount",
+		)
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	if err := k.checkStakingNotPaused(ctx); err != nil {
+		return nil, err
+	}
+
+	balance := k.bankKeeper.GetBalance(ctx, delegatorAddress, msg.Amount.Denom)
+	if balance.Amount.LT(msg.Amount.Amount) {
+		return nil, types.ErrNotEnoughBalance
+	}
+
+	record, found := k.GetTokenizeShareRecordByDenom(ctx, msg.Amount.Denom)
+	if !found {
+		return nil, types.ErrTokenizeShareRecordNotExists
+	}
+
+	valAddr, err := sdk.ValAddressFromBech32(record.Validator)
+	if err != nil {
+		return nil, err
+	}
+
+	validator, found := k.GetValidator(ctx, valAddr)
+	if !found {
+		return nil, types.ErrNoValidatorFound
+	}
+
+	recordAddress := record.GetModuleAddress()
+	delegation, found := k.GetDelegation(ctx, recordAddress, valAddr)
+	if !found {
+		return nil, types.ErrNoDelegation
+	}
+
+	// the share tokens are minted from the truncated shares, the last of them
+	// redeem all the remaining shares of the record
+	shares := math.LegacyNewDecFromInt(msg.Amount.Amount)
+	if msg.Amount.Amount.Equal(k.bankKeeper.GetSupply(ctx, msg.Amount.Denom).Amount) {
+		shares = delegation.Shares
+	}
+
+	if shares.GT(delegation.Shares) {
+		return nil, errorsmod.Wrap(types.ErrNotEnoughDelegationShares, delegation.Shares.String())
+	}
+
+	tokens := validator.TokensFromShares(shares).TruncateInt()
+	if tokens.IsZero() {
+		return nil, types.ErrTinyRedemptionAmount
+	}
+
+	if _, err := k.DecreaseValidatorLiquidShares(ctx, valAddr, shares); err != nil {
+		return nil, err
+	}
+
+	if err := k.DecreaseTotalLiquidStakedTokens(ctx, tokens); err != nil {
+		return nil, err
+	}
+
+	returnAmount, err := k.Unbond(ctx, recordAddress, valAddr, shares)
+	if err != nil {
+		return nil, err
+	}
+
+	if validator.IsBonded() {
+		k.bondedTokensToNotBonded(ctx, returnAmount)
+	}
+
+	// burn the redeemed share tokens
+	if err := k.bankKeeper.SendCoinsFromAccountToModule(ctx, delegatorAddress, types.ModuleName, sdk.NewCoins(msg.Amount)); err != nil {
+		return nil, err
+	}
+
+	if err := k.bankKeeper.BurnCoins(ctx, types.ModuleName, sdk.NewCoins(msg.Amount)); err != nil {
+		return nil, err
+	}
+
+	returnCoin := sdk.NewCoin(k.BondDenom(ctx), returnAmount)
+	if err := k.bankKeeper.UndelegateCoinsFromModuleToAccount(ctx, types.NotBondedPoolName, recordAddress, sdk.NewCoins(returnCoin)); err != nil {
+		return nil, err
+	}
+
+	if err := k.bankKeeper.SendCoins(ctx, recordAddress, delegatorAddress, sdk.NewCoins(returnCoin)); err != nil {
+		return nil, err
+	}
+
+	// the validator is updated by the unbonding
+	validator, found = k.GetValidator(ctx, valAddr)
+	if !found {
+		return nil, types.ErrNoValidatorFound
+	}
+
+	if _, err := k.Keeper.Delegate(ctx, delegatorAddress, returnAmount, types.Unbonded, validator, true); err != nil {
+		return nil, err
+	}
+
+	// the rewards withdrawn to the record account belong to the record owner
+	owner, err := k.authKeeper.StringToBytes(record.Owner)
+	if err != nil {
+		return nil, err
+	}
+
+	if rewards := k.bankKeeper.GetAllBalances(ctx, recordAddress); !rewards.IsZero() {
+		if err := k.bankKeeper.SendCoins(ctx, recordAddress, owner, rewards); err != nil {
+			return nil, err
+		}
+	}
+
+	// the record is removed along with its last shares
+	if _, found := k.GetDelegation(ctx, recordAddress, valAddr); !found {
+		if err := k.DeleteTokenizeShareRecord(ctx, record.Id); err != nil {
+			return nil, err
+		}
+	}
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeRedeemShares,
+			sdk.NewAttribute(types.AttributeKeyDelegator, msg.DelegatorAddress),
+			sdk.NewAttribute(types.AttributeKeyValidator, record.Validator),
+			sdk.NewAttribute(types.AttributeKeyShareRecordID, strconv.FormatUint(record.Id, 10)),
+			sdk.NewAttribute(sdk.AttributeKeyAmount, returnCoin.String()),
+			sdk.NewAttribute(types.AttributeKeyTokenizedShares, msg.Amount.String()),
+		),
+	})
+
+	return &types.MsgRedeemTokensForSharesResponse{
+		Amount: returnCoin,
+	}, nil
+}
+
+// TransferTokenizeShareRecord defines a method for transferring the ownership
+// of a tokenize share record
+func (k msgServer) TransferTokenizeShareRecord(goCtx context.Context, msg *types.MsgTransferTokenizeShareRecord) (*types.MsgTransferTokenizeShareRecordResponse, error) {
+	sender, err := k.authKeeper.StringToBytes(msg.Sender)
+	if err != nil {
+		return nil, sdkerrors.ErrInvalidAddress.Wrapf("invalid sender address: %s", err)
+	}
+
+	newOwner, err := k.authKeeper.StringToBytes(msg.NewOwner)
+	if err != nil {
+		return nil, sdkerrors.ErrInvalidAddress.Wrapf("invalid new owner address: %s", err)
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	record, found := k.GetTokenizeShareRecord(ctx, msg.TokenizeShareRecordId)
+	if !found {
+		return nil, types.ErrTokenizeShareRecordNotExists
+	}
+
+	owner, err := k.authKeeper.StringToBytes(record.Owner)
+	if err != nil {
+		return nil, err
+	}
+
+	if !bytes.Equal(owner, sender) {
+		return nil, types.ErrNotTokenizeShareRecordOwner
+	}
+
+	if err := k.Keeper.TransferTokenizeShareRecord(ctx, record.Id, sender, newOwner); err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeTransferTokenizeShareRecord,
+			sdk.NewAttribute(types.AttributeKeyShareRecordID, strconv.FormatUint(record.Id, 10)),
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.Sender),
+			sdk.NewAttribute(types.AttributeKeyShareOwner, msg.NewOwner),
+		),
+	})
+
+	return &types.MsgTransferTokenizeShareRecordResponse{}, nil
+}
+
+// ValidatorBond defines a method for designating a delegation as a validator
+// bond, which allows the validator to issue liquid shares
+func (k msgServer) ValidatorBond(goCtx context.Context, msg *types.MsgValidatorBond) (*types.MsgValidatorBondResponse, error) {
+	valAddr, valErr := sdk.ValAddressFromBech32(msg.ValidatorAddress)
+	if valErr != nil {
+		return nil, sdkerrors.ErrInvalidAddress.Wrapf("invalid validator address: %s", valErr)
+	}
+
+	delegatorAddress, err := k.authKeeper.StringToBytes(msg.DelegatorAddress)
+	if err != nil {
+		return nil, sdkerrors.ErrInvalidAddress.Wrapf("invalid delegator address: %s", err)
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	if err := k.checkStakingNotPaused(ctx); err != nil {
+		return nil, err
+	}
+
+	// the validator bond is only backed by the delegations of its operators,
+	// not by the ones intermediated by liquid staking providers
+	if k.DelegatorIsLiquidStaker(delegatorAddress) {
+		return nil, types.ErrValidatorBondNotAllowedFromLiquidStaker
+	}
+
+	validator, found := k.GetValidator(ctx, valAddr)
+	if !found {
+		return nil, types.ErrNoValidatorFound
+	}
+
+	delegation, found := k.GetDelegation(ctx, delegatorAddress, valAddr)
+	if !found {
+		return nil, types.ErrNoDelegation
+	}
+
+	// the shares received through a redelegation can still be slashed for an
+	// infraction of the source validator
+	if k.HasReceivingRedelegation(ctx, delegatorAddress, valAddr) {
+		return nil, types.ErrRedelegationInProgress
+	}
+
+	if !delegation.ValidatorBond {
+		delegation.ValidatorBond = true
+		k.SetDelegation(ctx, delegation)
+
+		validator.ValidatorBondShares = validator.ValidatorBondShares.Add(delegation.Shares)
+		k.SetValidator(ctx, validator)
+
+		ctx.EventManager().EmitEvents(sdk.Events{
+			sdk.NewEvent(
+				types.EventTypeValidatorBondDelegation,
+				sdk.NewAttribute(types.AttributeKeyDelegator, msg.DelegatorAddress),
+				sdk.NewAttribute(types.AttributeKeyValidator, msg.ValidatorAddress),
+			),
+		})
+	}
+
+	return &types.MsgValidatorBondResponse{}, nil
+}
+
+// CancelRedelegation defines a method for canceling an in-progress redelegation
+// entry and delegating the shares back to the source validator.
+func (k msgServer) CancelRedelegation(goCtx context.Context, msg *types.MsgCancelRedelegation) (*types.MsgCancelRedelegationResponse, error) {
+	valSrcAddr, err := sdk.ValAddressFromBech32(msg.ValidatorSrcAddress)
+	if err != nil {
+		return nil, sdkerrors.ErrInvalidAddress.Wrapf("invalid source validator address: %s", err)
+	}
+
+	valDstAddr, err := sdk.ValAddressFromBech32(msg.ValidatorDstAddress)
+	if err != nil {
+		return nil, sdkerrors.ErrInvalidAddress.Wrapf("invalid destination validator address: %s", err)
+	}
+
+	delegatorAddress, err := k.authKeeper.StringToBytes(msg.DelegatorAddress)
+	if err != nil {
+		return nil, sdkerrors.ErrInvalidAddress.Wrapf("invalid delegator address: %s", err)
+	}
+
+	if !msg.Amount.IsValid() || !msg.Amount.Amount.IsPositive() {
+		return nil, errorsmod.Wrap(
+			sdkerrors.ErrInvalidRequest,
+			"invalid amount",
+		)
+	}
+
+	if msg.CreationHeight <= 0 {
+		return nil, errorsmod.Wrap(
+			sdkerrors.ErrInvalidRequest,
+			"invalid height",
+		)
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	if err := k.checkStakingNotPaused(ctx); err != nil {
+		return nil, err
+	}
+
+	// the redelegated shares are delegated to the destination validator
+	tokens, err := k.DelegationTokens(ctx, delegatorAddress, valDstAddr, msg.Amount)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := k.Keeper.CancelRedelegation(
+		ctx, delegatorAddress, valSrcAddr, valDstAddr, msg.CreationHeight, tokens,
+	); err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeCancelRedelegation,
+			sdk.NewAttribute(sdk.AttributeKeyAmount, msg.Amount.String()),
+			sdk.NewAttribute(types.AttributeKeySrcValidator, msg.ValidatorSrcAddress),
+			sdk.NewAttribute(types.AttributeKeyDstValidator, msg.ValidatorDstAddress),
+			sdk.NewAttribute(types.AttributeKeyDelegator, msg.DelegatorAddress),
+			sdk.NewAttribute(types.AttributeKeyCreationHeight, strconv.FormatInt(msg.CreationHeight, 10)),
+		),
+	)
+
+	return &types.MsgCancelRedelegationResponse{}, nil
+}
+
+// LockDelegation defines a method for locking a delegation for one of the
+// lockup terms in exchange for a reward multiplier.
+func (k msgServer) LockDelegation(goCtx context.Context, msg *types.MsgLockDelegation) (*types.MsgLockDelegationResponse, error) {
+	valAddr, err := sdk.ValAddressFromBech32(msg.ValidatorAddress)
+	if err != nil {
+		return nil, sdkerrors.ErrInvalidAddress.Wrapf("invalid validator address: %s", err)
+	}
+
+	delegatorAddress, err := k.authKeeper.StringToBytes(msg.DelegatorAddress)
+	if err != nil {
+		return nil, sdkerrors.ErrInvalidAddress.Wrapf("invalid delegator address: %s", err)
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	if err := k.checkStakingNotPaused(ctx); err != nil {
+		return nil, err
+	}
+
+	lock, err := k.Keeper.LockDelegation(ctx, delegatorAddress, valAddr, msg.Duration)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeLockDelegation,
+			sdk.NewAttribute(types.AttributeKeyDelegator, msg.DelegatorAddress),
+			sdk.NewAttribute(types.AttributeKeyValidator, msg.ValidatorAddress),
+			sdk.NewAttribute(types.AttributeKeyLockDuration, lock.Duration.String()),
+			sdk.NewAttribute(types.AttributeKeyRewardMultiplier, lock.RewardMultiplier.String()),
+			sdk.NewAttribute(types.AttributeKeyLockEndTime, lock.EndTime.Format(time.RFC3339)),
+		),
+	})
+
+	return &types.MsgLockDelegationResponse{EndTime: lock.EndTime}, nil
+}
+
+// RedelegateMulti defines a method for atomically redelegating coins from a
+// source validator to several destination validators.
+// When epochs are enabled, the message is queued until the end of the epoch.
+func (k msgServer) RedelegateMulti(goCtx context.Context, msg *types.MsgRedelegateMulti) (*types.MsgRedelegateMultiResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	if err := k.checkStakingNotPaused(ctx); err != nil {
+		return nil, err
+	}
+
+	if k.EpochLength(ctx) > 0 {
+		if err := k.queueEpochMsg(ctx, msg); err != nil {
+			return nil, err
+		}
+
+		return &types.MsgRedelegateMultiResponse{}, nil
+	}
+
+	return k.redelegateMulti(ctx, msg)
+}
+
+// redelegateMulti performs the redelegations to every destination, either
+// right away or at the end of the epoch. An error on any destination fails the
+// whole message.
+func (k msgServer) redelegateMulti(ctx sdk.Context, msg *types.MsgRedelegateMulti) (*types.MsgRedelegateMultiResponse, error) {
+	if len(msg.Destinations) == 0 {
+		return nil, errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "no redelegation destinations")
+	}
+
+	seen := make(map[string]bool, len(msg.Destinations))
+	for _, dst := range msg.Destinations {
+		if seen[dst.ValidatorDstAddress] {
+			return nil, errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "duplicate redelegation destination %s", dst.ValidatorDstAddress)
+		}
+		seen[dst.ValidatorDstAddress] = true
+	}
+
+	completionTimes := make([]time.Time, len(msg.Destinations))
+	for i, dst := range msg.Destinations {
+		res, err := k.beginRedelegate(ctx, &types.MsgBeginRedelegate{
+			DelegatorAddress:    msg.DelegatorAddress,
+			ValidatorSrcAddress: msg.ValidatorSrcAddress,
+			ValidatorDstAddress: dst.ValidatorDstAddress,
+			Amount:              dst.Amount,
+		})
+		if err != nil {
+			return nil, errorsmod.Wrapf(err, "redelegation to %s", dst.ValidatorDstAddress)
+		}
+
+		completionTimes[i] = res.CompletionTime
+	}
+
+	return &types.MsgRedelegateMultiResponse{CompletionTimes: completionTimes}, nil
+}
+
+// InstantUndelegate defines a method for performing an undelegation from a
+// delegator and a validator which skips the unbonding period, for a fee.
+// When epochs are enabled, the message is queued until the end of the epoch.
+func (k msgServer) InstantUndelegate(goCtx context.Context, msg *types.MsgInstantUndelegate) (*types.MsgInstantUndelegateResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	if err := k.checkStakingNotPaused(ctx); err != nil {
+		return nil, err
+	}
+
+	if k.EpochLength(ctx) > 0 {
+		if err := k.queueEpochMsg(ctx, msg); err != nil {
+			return nil, err
+		}
+
+		return &types.MsgInstantUndelegateResponse{}, nil
+	}
+
+	return k.instantUndelegate(ctx, msg)
+}
+
+// instantUndelegate performs the instant undelegation, either right away or at
+// the end of the epoch.
+func (k msgServer) instantUndelegate(ctx sdk.Context, msg *types.MsgInstantUndelegate) (*types.MsgInstantUndelegateResponse, error) {
+	valAddr, err := sdk.ValAddressFromBech32(msg.ValidatorAddress)
+	if err != nil {
+		return nil, sdkerrors.ErrInvalidAddress.Wrapf("invalid validator address: %s", err)
+	}
+
+	delegatorAddress, err := k.authKeeper.StringToBytes(msg.DelegatorAddress)
+	if err != nil {
+		return nil, sdkerrors.ErrInvalidAddress.Wrapf("invalid delegator address: %s", err)
+	}
+
+	if !msg.Amount.IsValid() || !msg.Amount.Amount.IsPositive() {
+		return nil, errorsmod.Wrap(
+			sdkerrors.ErrInvalidRequest,
+			"invalid shares amount",
+		)
+	}
+
+	tokens, err := k.DelegationTokens(ctx, delegatorAddress, valAddr, msg.Amount)
+	if err != nil {
+		return nil, err
+	}
+
+	shares, err := k.ValidateUnbondAmount(ctx, delegatorAddress, valAddr, tokens)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := k.checkMinUnbondAmount(ctx, delegatorAddress, valAddr, tokens, shares); err != nil {
+		return nil, err
+	}
+
+	amount, fee, err := k.Keeper.InstantUndelegate(ctx, delegatorAddress, valAddr, shares)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeInstantUnbond,
+			sdk.NewAttribute(types.AttributeKeyValidator, msg.ValidatorAddress),
+			sdk.NewAttribute(types.AttributeKeyDelegator, msg.DelegatorAddress),
+			sdk.NewAttribute(sdk.AttributeKeyAmount, amount.String()),
+			sdk.NewAttribute(types.AttributeKeyFee, fee.String()),
+		),
+	})
+
+	return &types.MsgInstantUndelegateResponse{Amount: amount, Fee: fee}, nil
+}
+
+// SetValidatorRiskTier defines a governance operation for assigning a
+// validator to an unbonding tier.
+func (k msgServer) SetValidatorRiskTier(goCtx context.Context, msg *types.MsgSetValidatorRiskTier) (*types.MsgSetValidatorRiskTierResponse, error) {
+	if k.authority != msg.Authority {
+		return nil, errorsmod.Wrapf(govtypes.ErrInvalidSigner, "invalid authority; expected %s, got %s", k.authority, msg.Authority)
+	}
+
+	valAddr, err := sdk.ValAddressFromBech32(msg.ValidatorAddress)
+	if err != nil {
+		return nil, sdkerrors.ErrInvalidAddress.Wrapf("invalid validator address: %s", err)
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if _, found := k.GetValidator(ctx, valAddr); !found {
+		return nil, types.ErrNoValidatorFound
+	}
+
+	if msg.Tier != "" {
+		if _, found := k.GetParams(ctx).UnbondingTier(msg.Tier); !found {
+			return nil, types.ErrUnknownUnbondingTier.Wrapf("tier %s", msg.Tier)
+		}
+	}
+
+	k.Keeper.SetValidatorRiskTier(ctx, valAddr, msg.Tier)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeSetValidatorRiskTier,
+			sdk.NewAttribute(types.AttributeKeyValidator, msg.ValidatorAddress),
+			sdk.NewAttribute(types.AttributeKeyTier, msg.Tier),
+		),
+	)
+
+	return &types.MsgSetValidatorRiskTierResponse{}, nil
+}
+
+// SetStakingPaused defines a governance operation for pausing or resuming the
+// delegations, undelegations and redelegations.
+func (k msgServer) SetStakingPaused(goCtx context.Context, msg *types.MsgSetStakingPaused) (*types.MsgSetStakingPausedResponse, error) {
+	if k.authority != msg.Authority {
+		return nil, errorsmod.Wrapf(govtypes.ErrInvalidSigner, "invalid authority; expected %s, got %s", k.authority, msg.Authority)
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	k.Keeper.SetStakingPaused(ctx, msg.Paused)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeSetStakingPaused,
+			sdk.NewAttribute(types.AttributeKeyPaused, strconv.FormatBool(msg.Paused)),
+		),
+	)
+
+	return &types.MsgSetStakingPausedResponse{}, nil
+}
+
+// AttestValidatorProfile defines a method for attesting a field of the profile
+// of a validator, accepted by the profile verifier registered for the field.
+func (k msgServer) AttestValidatorProfile(goCtx context.Context, msg *types.MsgAttestValidatorProfile) (*types.MsgAttestValidatorProfileResponse, error) {
+	if _, err := sdk.AccAddressFromBech32(msg.Attester); err != nil {
+		return nil, sdkerrors.ErrInvalidAddress.Wrapf("invalid attester address: %s", err)
+	}
+
+	valAddr, err := sdk.ValAddressFromBech32(msg.ValidatorAddress)
+	if err != nil {
+		return nil, sdkerrors.ErrInvalidAddress.Wrapf("invalid validator address: %s", err)
+	}
+
+	if !types.IsProfileField(msg.Field) {
+		return nil, errorsmod.Wrapf(types.ErrInvalidProfileAttestation, "unknown profile field %s", msg.Field)
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	validator, found := k.GetValidator(ctx, valAddr)
+	if !found {
+		return nil, types.ErrNoValidatorFound
+	}
+
+	attestation := types.ProfileAttestation{
+		ValidatorAddress: msg.ValidatorAddress,
+		Field:            msg.Field,
+		Attester:         msg.Attester,
+		Value:            msg.Value,
+		Verified:         msg.Verified,
+	}
+	if err := k.Keeper.AttestValidatorProfile(ctx, validator, attestation); err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeAttestValidatorProfile,
+			sdk.NewAttribute(types.AttributeKeyValidator, msg.ValidatorAddress),
+			sdk.NewAttribute(types.AttributeKeyProfileField, msg.Field),
+			sdk.NewAttribute(types.AttributeKeyAttester, msg.Attester),
+			sdk.NewAttribute(types.AttributeKeyVerified, strconv.FormatBool(msg.Verified)),
+		),
+	)
+
+	return &types.MsgAttestValidatorProfileResponse{}, nil
+}
+
+// SetStandbyConsPubKey defines a method for setting the standby consensus
+// pubkey of a validator
+func (k msgServer) SetStandbyConsPubKey(goCtx context.Context, msg *types.MsgSetStandbyConsPubKey) (*types.MsgSetStandbyConsPubKeyResponse, error) {
+	valAddr, err := sdk.ValAddressFromBech32(msg.ValidatorAddress)
+	if err != nil {
+		return nil, sdkerrors.ErrInvalidAddress.Wrapf("invalid validator address: %s", err)
+	}
+
+	var pk cryptotypes.PubKey
+	if msg.Pubkey != nil {
+		var ok bool
+		if pk, ok = msg.Pubkey.GetCachedValue().(cryptotypes.PubKey); !ok {
+			return nil, errorsmod.Wrapf(sdkerrors.ErrInvalidType, "Expecting cryptotypes.PubKey, got %T", msg.Pubkey.GetCachedValue())
+		}
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if _, found := k.GetValidator(ctx, valAddr); !found {
+		return nil, types.ErrNoValidatorFound
+	}
+
+	consAddr := ""
+	if pk != nil {
+		if err := checkPubKeyType(ctx, pk); err != nil {
+			return nil, err
+		}
+		consAddr = sdk.GetConsAddress(pk).String()
+	}
+
+	if err := k.Keeper.SetStandbyConsPubKey(ctx, valAddr, pk); err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeSetStandbyConsPubKey,
+			sdk.NewAttribute(types.AttributeKeyValidator, msg.ValidatorAddress),
+			sdk.NewAttribute(types.AttributeKeyConsAddress, consAddr),
+		),
+	)
+
+	return &types.MsgSetStandbyConsPubKeyResponse{}, nil
+}
+
+// checkPubKeyType checks that the type of a consensus pubkey is supported by
+// the consensus params.
+func checkPubKeyType(ctx sdk.Context, pk cryptotypes.PubKey) error {
+	cp := ctx.ConsensusParams()
+	if cp.Validator == nil {
+		return nil
+	}
+
+	pkType := pk.Type()
+	for _, keyType := range cp.Validator.PubKeyTypes {
+		if pkType == keyType {
+			return nil
+		}
+	}
+
+	return errorsmod.Wrapf(
+		types.ErrValidatorPubKeyTypeNotSupported,
+		"got: %s, expected: %s", pk.Type(), cp.Validator.PubKeyTypes,
+	)
+}
@@ -705,6 +705,68 @@ func (s *KeeperTestSuite) TestMsgBeginRedelegate() {
 	}
 }
 
+func (s *KeeperTestSuite) TestMsgRedelegateMulti() {
+	ctx, keeper, msgServer := s.ctx, s.stakingKeeper, s.msgServer
+	require := s.Require()
+	s.execExpectCalls()
+
+	comm := stakingtypes.NewCommissionRates(math.LegacyNewDec(0), math.LegacyNewDec(0), math.LegacyNewDec(0))
+	amt := sdk.Coin{Denom: sdk.DefaultBondDenom, Amount: keeper.TokensFromConsensusPower(s.ctx, int64(100))}
+
+	valAddrs := make([]sdk.ValAddress, len(PKS))
+	for i, pk := range PKS {
+		addr := sdk.AccAddress(pk.Address())
+		valAddrs[i] = sdk.ValAddress(addr)
+
+		s.accountKeeper.EXPECT().StringToBytes(addr.String()).Return(addr, nil).AnyTimes()
+		s.accountKeeper.EXPECT().BytesToString(addr).Return(addr.String(), nil).AnyTimes()
+		s.bankKeeper.EXPECT().DelegateCoinsFromAccountToModule(gomock.Any(), addr, stakingtypes.NotBondedPoolName, gomock.Any()).AnyTimes()
+
+		msg, err := stakingtypes.NewMsgCreateValidator(valAddrs[i], ed25519.GenPrivKey().PubKey(), amt, stakingtypes.Description{Moniker: "NewVal"}, comm, math.OneInt())
+		require.NoError(err)
+		_, err = msgServer.CreateValidator(ctx, msg)
+		require.NoError(err)
+	}
+
+	srcValAddr := valAddrs[0]
+	keeper.SetDelegation(ctx, stakingtypes.NewDelegation(Addr, srcValAddr, math.LegacyNewDec(100)))
+
+	coin := func(amount int64) sdk.Coin { return sdk.NewInt64Coin(sdk.DefaultBondDenom, amount) }
+
+	_, err := msgServer.RedelegateMulti(ctx, stakingtypes.NewMsgRedelegateMulti(Addr, srcValAddr))
+	require.ErrorContains(err, "no redelegation destinations")
+
+	_, err = msgServer.RedelegateMulti(ctx, stakingtypes.NewMsgRedelegateMulti(Addr, srcValAddr,
+		stakingtypes.NewRedelegationDestination(valAddrs[1], coin(10)),
+		stakingtypes.NewRedelegationDestination(valAddrs[1], coin(20)),
+	))
+	require.ErrorContains(err, "duplicate redelegation destination")
+
+	_, err = msgServer.RedelegateMulti(ctx, stakingtypes.NewMsgRedelegateMulti(Addr, srcValAddr,
+		stakingtypes.NewRedelegationDestination(valAddrs[1], coin(10)),
+		stakingtypes.NewRedelegationDestination(srcValAddr, coin(20)),
+	))
+	require.ErrorContains(err, "cannot redelegate to the same validator")
+
+	res, err := msgServer.RedelegateMulti(ctx, stakingtypes.NewMsgRedelegateMulti(Addr, srcValAddr,
+		stakingtypes.NewRedelegationDestination(valAddrs[1], coin(40)),
+		stakingtypes.NewRedelegationDestination(valAddrs[2], coin(60)),
+	))
+	require.NoError(err)
+	require.Len(res.CompletionTimes, 2)
+
+	_, found := keeper.GetDelegation(ctx, Addr, srcValAddr)
+	require.False(found)
+	for i, shares := range []int64{40, 60} {
+		del, found := keeper.GetDelegation(ctx, Addr, valAddrs[i+1])
+		require.True(found)
+		require.Equal(math.LegacyNewDec(shares), del.Shares)
+
+		_, found = keeper.GetRedelegation(ctx, Addr, srcValAddr, valAddrs[i+1])
+		require.True(found)
+	}
+}
+
 func (s *KeeperTestSuite) TestMsgUndelegate() {
 	ctx, keeper, msgServer := s.ctx, s.stakingKeeper, s.msgServer
 	require := s.Require()
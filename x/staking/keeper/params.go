@@ -49,6 +49,63 @@ func (k Keeper) MinCommissionRate(ctx sdk.Context) math.LegacyDec {
 	return k.GetParams(ctx).MinCommissionRate
 }
 
+// ValidatorBondFactor - The number of shares a validator can issue as liquid
+// shares per validator bond share, negative if the requirement is disabled
+func (k Keeper) ValidatorBondFactor(ctx sdk.Context) math.LegacyDec {
+	return k.GetParams(ctx).ValidatorBondFactor
+}
+
+// GlobalLiquidStakingCap - Maximum fraction of the total bonded tokens which
+// can be liquid staked
+func (k Keeper) GlobalLiquidStakingCap(ctx sdk.Context) math.LegacyDec {
+	return k.GetParams(ctx).GlobalLiquidStakingCap
+}
+
+// ValidatorLiquidStakingCap - Maximum fraction of the shares of a validator
+// which can be liquid staked
+func (k Keeper) ValidatorLiquidStakingCap(ctx sdk.Context) math.LegacyDec {
+	return k.GetParams(ctx).ValidatorLiquidStakingCap
+}
+
+// CommissionChangeNoticePeriod - The time duration between a validator
+// announcing a commission rate change and the change taking effect
+func (k Keeper) CommissionChangeNoticePeriod(ctx sdk.Context) time.Duration {
+	return k.GetParams(ctx).CommissionChangeNoticePeriod
+}
+
+// MaxMatureUnbondingsPerBlock - Maximum number of mature unbonding delegation
+// and redelegation queue entries completed per block
+func (k Keeper) MaxMatureUnbondingsPerBlock(ctx sdk.Context) uint32 {
+	return k.GetParams(ctx).MaxMatureUnbondingsPerBlock
+}
+
+// MinSelfDelegationFloor - Chain-wide minimum self delegation of the validators
+func (k Keeper) MinSelfDelegationFloor(ctx sdk.Context) math.Int {
+	floor := k.GetParams(ctx).MinSelfDelegationFloor
+	if floor.IsNil() {
+		return math.ZeroInt()
+	}
+
+	return floor
+}
+
+// MinDelegationAmount - Smallest amount of staking tokens which can be
+// delegated, undelegated or left delegated, zero if there is no minimum
+func (k Keeper) MinDelegationAmount(ctx sdk.Context) math.Int {
+	minAmount := k.GetParams(ctx).MinDelegationAmount
+	if minAmount.IsNil() {
+		return math.ZeroInt()
+	}
+
+	return minAmount
+}
+
+// EpochLength - Number of blocks of a staking epoch, zero if the staking
+// messages are applied immediately
+func (k Keeper) EpochLength(ctx sdk.Context) uint64 {
+	return k.GetParams(ctx).EpochLength
+}
+
 // SetParams sets the x/staking module parameters.
 // CONTRACT: This method performs no validation of the parameters.
 func (k Keeper) SetParams(ctx sdk.Context, params types.Params) error {
@@ -0,0 +1,33 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/staking/types"
+)
+
+// IsStakingPaused returns true if the delegations, undelegations and
+// redelegations are paused.
+func (k Keeper) IsStakingPaused(ctx sdk.Context) bool {
+	return ctx.KVStore(k.storeKey).Has(types.StakingPausedKey)
+}
+
+// SetStakingPaused pauses or resumes the delegations, undelegations and
+// redelegations.
+func (k Keeper) SetStakingPaused(ctx sdk.Context, paused bool) {
+	store := ctx.KVStore(k.storeKey)
+	if !paused {
+		store.Delete(types.StakingPausedKey)
+		return
+	}
+
+	store.Set(types.StakingPausedKey, []byte{1})
+}
+
+// checkStakingNotPaused returns an error if staking is paused.
+func (k Keeper) checkStakingNotPaused(ctx sdk.Context) error {
+	if k.IsStakingPaused(ctx) {
+		return types.ErrStakingPaused
+	}
+
+	return nil
+}
@@ -0,0 +1,75 @@
+package keeper_test
+
+import (
+	"time"
+
+	sdkmath "cosmossdk.io/math"
+	"github.com/golang/mock/gomock"
+
+	"github.com/cosmos/cosmos-sdk/crypto/keys/ed25519"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+)
+
+func (s *KeeperTestSuite) TestSetStakingPaused() {
+	ctx, keeper, msgServer := s.ctx, s.stakingKeeper, s.msgServer
+	require := s.Require()
+	s.execExpectCalls()
+
+	authority := authtypes.NewModuleAddress(govtypes.ModuleName).String()
+	ctx = ctx.WithBlockTime(time.Unix(1_000_000, 0).UTC())
+
+	delAddr := sdk.AccAddress(PKS[1].Address())
+	s.accountKeeper.EXPECT().StringToBytes(delAddr.String()).Return(delAddr, nil).AnyTimes()
+	s.bankKeeper.EXPECT().DelegateCoinsFromAccountToModule(gomock.Any(), delAddr, stakingtypes.NotBondedPoolName, gomock.Any()).AnyTimes()
+
+	comm := stakingtypes.NewCommissionRates(sdkmath.LegacyNewDec(0), sdkmath.LegacyNewDec(0), sdkmath.LegacyNewDec(0))
+	createMsg, err := stakingtypes.NewMsgCreateValidator(ValAddr, ed25519.GenPrivKey().PubKey(), sdk.NewInt64Coin(sdk.DefaultBondDenom, 10), stakingtypes.Description{Moniker: "NewVal"}, comm, sdkmath.OneInt())
+	require.NoError(err)
+	_, err = msgServer.CreateValidator(ctx, createMsg)
+	require.NoError(err)
+
+	coin := func(amt int64) sdk.Coin { return sdk.NewInt64Coin(sdk.DefaultBondDenom, amt) }
+
+	_, err = msgServer.Delegate(ctx, stakingtypes.NewMsgDelegate(delAddr, ValAddr, coin(200)))
+	require.NoError(err)
+	res, err := msgServer.Undelegate(ctx, stakingtypes.NewMsgUndelegate(delAddr, ValAddr, coin(50)))
+	require.NoError(err)
+
+	// only the authority can pause staking
+	_, err = msgServer.SetStakingPaused(ctx, stakingtypes.NewMsgSetStakingPaused(Addr.String(), true))
+	require.ErrorIs(err, govtypes.ErrInvalidSigner)
+
+	_, err = msgServer.SetStakingPaused(ctx, stakingtypes.NewMsgSetStakingPaused(authority, true))
+	require.NoError(err)
+	require.True(keeper.IsStakingPaused(ctx))
+
+	pausedRes, err := s.queryClient.StakingPaused(ctx, &stakingtypes.QueryStakingPausedRequest{})
+	require.NoError(err)
+	require.True(pausedRes.Paused)
+
+	_, err = msgServer.Delegate(ctx, stakingtypes.NewMsgDelegate(delAddr, ValAddr, coin(100)))
+	require.ErrorIs(err, stakingtypes.ErrStakingPaused)
+	_, err = msgServer.Undelegate(ctx, stakingtypes.NewMsgUndelegate(delAddr, ValAddr, coin(50)))
+	require.ErrorIs(err, stakingtypes.ErrStakingPaused)
+	_, err = msgServer.BeginRedelegate(ctx, stakingtypes.NewMsgBeginRedelegate(delAddr, ValAddr, sdk.ValAddress(PKS[2].Address()), coin(50)))
+	require.ErrorIs(err, stakingtypes.ErrStakingPaused)
+	_, err = msgServer.CancelUnbondingDelegation(ctx, stakingtypes.NewMsgCancelUnbondingDelegation(delAddr, ValAddr, ctx.BlockHeight(), coin(50)))
+	require.ErrorIs(err, stakingtypes.ErrStakingPaused)
+
+	// the unbonding delegations keep maturing
+	s.bankKeeper.EXPECT().UndelegateCoinsFromModuleToAccount(gomock.Any(), stakingtypes.NotBondedPoolName, delAddr, sdk.NewCoins(coin(50))).Return(nil)
+
+	balances, err := keeper.CompleteUnbonding(ctx.WithBlockTime(res.CompletionTime), delAddr, ValAddr)
+	require.NoError(err)
+	require.Equal(sdk.NewCoins(coin(50)), balances)
+
+	_, err = msgServer.SetStakingPaused(ctx, stakingtypes.NewMsgSetStakingPaused(authority, false))
+	require.NoError(err)
+	require.False(keeper.IsStakingPaused(ctx))
+
+	_, err = msgServer.Delegate(ctx, stakingtypes.NewMsgDelegate(delAddr, ValAddr, coin(100)))
+	require.NoError(err)
+}
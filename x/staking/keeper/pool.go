@@ -19,48 +19,70 @@ func (k Keeper) GetNotBondedPool(ctx sdk.Context) (notBondedPool sdk.ModuleAccou
 
 // bondedTokensToNotBonded transfers coins from the bonded to the not bonded pool within staking
 func (k Keeper) bondedTokensToNotBonded(ctx sdk.Context, tokens math.Int) {
-	coins := sdk.NewCoins(sdk.NewCoin(k.BondDenom(ctx), tokens))
+	k.bondedCoinsToNotBonded(ctx, sdk.NewCoins(sdk.NewCoin(k.BondDenom(ctx), tokens)))
+}
+
+// bondedCoinsToNotBonded transfers coins of the bond denom and of additional
+// bond denoms from the bonded to the not bonded pool within staking
+func (k Keeper) bondedCoinsToNotBonded(ctx sdk.Context, coins sdk.Coins) {
 	if err := k.bankKeeper.SendCoinsFromModuleToModule(ctx, types.BondedPoolName, types.NotBondedPoolName, coins); err != nil {
 		panic(err)
 	}
 }
 
-// notBondedTokensToBonded transfers coins from the not bonded to the bonded pool within staking
-func (k Keeper) notBondedTokensToBonded(ctx sdk.Context, tokens math.Int) {
-	coins := sdk.NewCoins(sdk.NewCoin(k.BondDenom(ctx), tokens))
+// notBondedCoinsToBonded transfers coins of the bond denom and of additional
+// bond denoms from the not bonded to the bonded pool within staking
+func (k Keeper) notBondedCoinsToBonded(ctx sdk.Context, coins sdk.Coins) {
 	if err := k.bankKeeper.SendCoinsFromModuleToModule(ctx, types.NotBondedPoolName, types.BondedPoolName, coins); err != nil {
 		panic(err)
 	}
 }
 
-// burnBondedTokens removes coins from the bonded pool module account
-func (k Keeper) burnBondedTokens(ctx sdk.Context, amt math.Int) error {
-	if !amt.IsPositive() {
+// burnBondedCoins removes coins of the bond denom and of additional bond
+// denoms from the bonded pool module account
+func (k Keeper) burnBondedCoins(ctx sdk.Context, coins sdk.Coins) error {
+	if coins.IsZero() {
 		// skip as no coins need to be burned
 		return nil
 	}
 
-	coins := sdk.NewCoins(sdk.NewCoin(k.BondDenom(ctx), amt))
-
 	return k.bankKeeper.BurnCoins(ctx, types.BondedPoolName, coins)
 }
 
-// burnNotBondedTokens removes coins from the not bonded pool module account
-func (k Keeper) burnNotBondedTokens(ctx sdk.Context, amt math.Int) error {
-	if !amt.IsPositive() {
+// burnNotBondedCoins removes coins of the bond denom and of additional bond
+// denoms from the not bonded pool module account
+func (k Keeper) burnNotBondedCoins(ctx sdk.Context, coins sdk.Coins) error {
+	if coins.IsZero() {
 		// skip as no coins need to be burned
 		return nil
 	}
 
-	coins := sdk.NewCoins(sdk.NewCoin(k.BondDenom(ctx), amt))
-
 	return k.bankKeeper.BurnCoins(ctx, types.NotBondedPoolName, coins)
 }
 
-// TotalBondedTokens total staking tokens supply which is bonded
+// delegationCoins returns the coins of an amount delegated in the given
+// additional bond denom, an empty denom standing for the bond denom
+func delegationCoins(bondDenom, denom string, amount math.Int) sdk.Coins {
+	if denom == "" {
+		denom = bondDenom
+	}
+
+	return sdk.NewCoins(sdk.NewCoin(denom, amount))
+}
+
+// TotalBondedTokens total staking tokens supply which is bonded, including
+// the weighted tokens bonded in additional bond denoms
 func (k Keeper) TotalBondedTokens(ctx sdk.Context) math.Int {
+	params := k.GetParams(ctx)
 	bondedPool := k.GetBondedPool(ctx)
-	return k.bankKeeper.GetBalance(ctx, bondedPool.GetAddress(), k.BondDenom(ctx)).Amount
+	tokens := k.bankKeeper.GetBalance(ctx, bondedPool.GetAddress(), params.BondDenom).Amount
+
+	for _, w := range params.BondDenomWeights {
+		amount := k.bankKeeper.GetBalance(ctx, bondedPool.GetAddress(), w.Denom).Amount
+		tokens = tokens.Add(w.Weight.MulInt(amount).TruncateInt())
+	}
+
+	return tokens
 }
 
 // StakingTokenSupply staking tokens from the total supply
@@ -72,7 +94,11 @@ func (k Keeper) StakingTokenSupply(ctx sdk.Context) math.Int {
 func (k Keeper) BondedRatio(ctx sdk.Context) math.LegacyDec {
 	stakeSupply := k.StakingTokenSupply(ctx)
 	if stakeSupply.IsPositive() {
-		return math.LegacyNewDecFromInt(k.TotalBondedTokens(ctx)).QuoInt(stakeSupply)
+		// the ratio only accounts for the bond denom, which the supply is
+		// expressed in
+		bondedPool := k.GetBondedPool(ctx)
+		bonded := k.bankKeeper.GetBalance(ctx, bondedPool.GetAddress(), k.BondDenom(ctx)).Amount
+		return math.LegacyNewDecFromInt(bonded).QuoInt(stakeSupply)
 	}
 
 	return math.LegacyZeroDec()
@@ -0,0 +1,126 @@
+package keeper
+
+import (
+	errorsmod "cosmossdk.io/errors"
+	storetypes "cosmossdk.io/store/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/staking/types"
+)
+
+// SetProfileAttestation sets an attestation of a profile field of a validator.
+func (k Keeper) SetProfileAttestation(ctx sdk.Context, attestation types.ProfileAttestation) {
+	valAddr, err := sdk.ValAddressFromBech32(attestation.ValidatorAddress)
+	if err != nil {
+		panic(err)
+	}
+
+	attester, err := sdk.AccAddressFromBech32(attestation.Attester)
+	if err != nil {
+		panic(err)
+	}
+
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.GetProfileAttestationKey(valAddr, attestation.Field, attester), k.cdc.MustMarshal(&attestation))
+}
+
+// GetProfileAttestations returns the attestations of the profile fields of a
+// validator, ordered by field and attester.
+func (k Keeper) GetProfileAttestations(ctx sdk.Context, valAddr sdk.ValAddress) (attestations []types.ProfileAttestation) {
+	store := ctx.KVStore(k.storeKey)
+	iterator := storetypes.KVStorePrefixIterator(store, types.GetProfileAttestationsKey(valAddr))
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var attestation types.ProfileAttestation
+		k.cdc.MustUnmarshal(iterator.Value(), &attestation)
+		attestations = append(attestations, attestation)
+	}
+
+	return attestations
+}
+
+// GetAllProfileAttestations returns the attestations of the profile fields of
+// all the validators.
+func (k Keeper) GetAllProfileAttestations(ctx sdk.Context) (attestations []types.ProfileAttestation) {
+	store := ctx.KVStore(k.storeKey)
+	iterator := storetypes.KVStorePrefixIterator(store, types.ProfileAttestationKey)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var attestation types.ProfileAttestation
+		k.cdc.MustUnmarshal(iterator.Value(), &attestation)
+		attestations = append(attestations, attestation)
+	}
+
+	return attestations
+}
+
+// DeleteProfileAttestations removes the attestations of the profile fields of
+// a validator.
+func (k Keeper) DeleteProfileAttestations(ctx sdk.Context, valAddr sdk.ValAddress) {
+	store := ctx.KVStore(k.storeKey)
+	iterator := storetypes.KVStorePrefixIterator(store, types.GetProfileAttestationsKey(valAddr))
+	defer iterator.Close()
+
+	var keys [][]byte
+	for ; iterator.Valid(); iterator.Next() {
+		keys = append(keys, iterator.Key())
+	}
+
+	for _, key := range keys {
+		store.Delete(key)
+	}
+}
+
+// PruneStaleProfileAttestations removes the attestations of the profile fields
+// of a validator whose attested value is no longer the current value of the
+// field, e.g. after the validator edited its description.
+func (k Keeper) PruneStaleProfileAttestations(ctx sdk.Context, validator types.Validator) {
+	valAddr, err := sdk.ValAddressFromBech32(validator.OperatorAddress)
+	if err != nil {
+		panic(err)
+	}
+
+	store := ctx.KVStore(k.storeKey)
+	for _, attestation := range k.GetProfileAttestations(ctx, valAddr) {
+		if attestation.Value == validator.Description.Profile.FieldValue(attestation.Field) {
+			continue
+		}
+
+		attester, err := sdk.AccAddressFromBech32(attestation.Attester)
+		if err != nil {
+			panic(err)
+		}
+		store.Delete(types.GetProfileAttestationKey(valAddr, attestation.Field, attester))
+	}
+}
+
+// AttestValidatorProfile records the attestation of a profile field of a
+// validator once accepted by the verifier registered for the field. The
+// attested value must be the current, non-empty value of the field, and
+// replaces any previous attestation of the field by the same attester.
+func (k Keeper) AttestValidatorProfile(ctx sdk.Context, validator types.Validator, attestation types.ProfileAttestation) error {
+	verifier, ok := k.profileVerifiers[attestation.Field]
+	if !ok {
+		return errorsmod.Wrapf(types.ErrNoProfileVerifier, "field %s", attestation.Field)
+	}
+
+	current := validator.Description.Profile.FieldValue(attestation.Field)
+	if current == "" {
+		return errorsmod.Wrapf(types.ErrInvalidProfileAttestation, "field %s of validator %s is not set", attestation.Field, validator.OperatorAddress)
+	}
+
+	if attestation.Value != current {
+		return errorsmod.Wrapf(types.ErrInvalidProfileAttestation, "attested value %q is not the current value %q of field %s", attestation.Value, current, attestation.Field)
+	}
+
+	if err := verifier.VerifyProfileAttestation(ctx, validator, attestation); err != nil {
+		return errorsmod.Wrap(types.ErrInvalidProfileAttestation, err.Error())
+	}
+
+	attestation.Height = ctx.BlockHeight()
+	k.SetProfileAttestation(ctx, attestation)
+
+	return nil
+}
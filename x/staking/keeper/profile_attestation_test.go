@@ -0,0 +1,85 @@
+package keeper_test
+
+import (
+	"context"
+	"errors"
+
+	sdkmath "cosmossdk.io/math"
+	"github.com/golang/mock/gomock"
+
+	"github.com/cosmos/cosmos-sdk/crypto/keys/ed25519"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	stakingtestutil "github.com/cosmos/cosmos-sdk/x/staking/testutil"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+)
+
+func (s *KeeperTestSuite) TestAttestValidatorProfile() {
+	ctx, keeper, msgServer := s.ctx, s.stakingKeeper, s.msgServer
+	require := s.Require()
+	s.execExpectCalls()
+
+	const proof = "cosmos-validator-proof=8f14e45f"
+	attester := sdk.AccAddress(PKS[1].Address())
+	untrusted := sdk.AccAddress(PKS[2].Address())
+
+	description := stakingtypes.Description{
+		Moniker: "NewVal",
+		Profile: stakingtypes.NewValidatorProfile("", "", "", "eu-west", proof),
+	}
+	comm := stakingtypes.NewCommissionRates(sdkmath.LegacyNewDec(0), sdkmath.LegacyNewDec(0), sdkmath.LegacyNewDec(0))
+	createMsg, err := stakingtypes.NewMsgCreateValidator(ValAddr, ed25519.GenPrivKey().PubKey(), sdk.NewInt64Coin(sdk.DefaultBondDenom, 10), description, comm, sdkmath.OneInt())
+	require.NoError(err)
+	_, err = msgServer.CreateValidator(ctx, createMsg)
+	require.NoError(err)
+
+	// the fields without a verifier cannot be attested
+	_, err = msgServer.AttestValidatorProfile(ctx, stakingtypes.NewMsgAttestValidatorProfile(attester, ValAddr, stakingtypes.ProfileFieldWebsiteProof, proof, true))
+	require.ErrorIs(err, stakingtypes.ErrNoProfileVerifier)
+
+	verifier := stakingtestutil.NewMockProfileVerifier(gomock.NewController(s.T()))
+	verifier.EXPECT().VerifyProfileAttestation(gomock.Any(), gomock.Any(), gomock.Any()).DoAndReturn(
+		func(_ context.Context, _ stakingtypes.Validator, attestation stakingtypes.ProfileAttestation) error {
+			if attestation.Attester != attester.String() {
+				return errors.New("untrusted attester")
+			}
+			return nil
+		}).AnyTimes()
+	keeper.SetProfileVerifier(stakingtypes.ProfileFieldWebsiteProof, verifier)
+	require.Panics(func() { keeper.SetProfileVerifier(stakingtypes.ProfileFieldWebsiteProof, verifier) })
+	require.Panics(func() { keeper.SetProfileVerifier("website", verifier) })
+
+	_, err = msgServer.AttestValidatorProfile(ctx, stakingtypes.NewMsgAttestValidatorProfile(attester, ValAddr, "website", proof, true))
+	require.ErrorIs(err, stakingtypes.ErrInvalidProfileAttestation)
+	_, err = msgServer.AttestValidatorProfile(ctx, stakingtypes.NewMsgAttestValidatorProfile(attester, ValAddr, stakingtypes.ProfileFieldWebsiteProof, "stale-proof", true))
+	require.ErrorIs(err, stakingtypes.ErrInvalidProfileAttestation)
+	_, err = msgServer.AttestValidatorProfile(ctx, stakingtypes.NewMsgAttestValidatorProfile(untrusted, ValAddr, stakingtypes.ProfileFieldWebsiteProof, proof, true))
+	require.ErrorIs(err, stakingtypes.ErrInvalidProfileAttestation)
+
+	_, err = msgServer.AttestValidatorProfile(ctx, stakingtypes.NewMsgAttestValidatorProfile(attester, ValAddr, stakingtypes.ProfileFieldWebsiteProof, proof, true))
+	require.NoError(err)
+
+	res, err := s.queryClient.ValidatorProfile(ctx, &stakingtypes.QueryValidatorProfileRequest{ValidatorAddr: ValAddr.String()})
+	require.NoError(err)
+	require.Equal(description.Profile, res.Description.Profile)
+	require.Equal([]stakingtypes.ProfileAttestation{{
+		ValidatorAddress: ValAddr.String(),
+		Field:            stakingtypes.ProfileFieldWebsiteProof,
+		Attester:         attester.String(),
+		Value:            proof,
+		Verified:         true,
+		Height:           ctx.BlockHeight(),
+	}}, res.Attestations)
+	require.Equal(res.Attestations, keeper.GetAllProfileAttestations(ctx))
+
+	// editing another field keeps the attestation, replacing the proof prunes it
+	edit := stakingtypes.NewDescription(stakingtypes.DoNotModifyDesc, stakingtypes.DoNotModifyDesc, stakingtypes.DoNotModifyDesc, stakingtypes.DoNotModifyDesc, stakingtypes.DoNotModifyDesc)
+	edit.Profile = stakingtypes.NewValidatorProfile(stakingtypes.DoNotModifyDesc, stakingtypes.DoNotModifyDesc, stakingtypes.DoNotModifyDesc, "us-east", stakingtypes.DoNotModifyDesc)
+	_, err = msgServer.EditValidator(ctx, stakingtypes.NewMsgEditValidator(ValAddr, edit, nil, nil))
+	require.NoError(err)
+	require.Len(keeper.GetProfileAttestations(ctx, ValAddr), 1)
+
+	edit.Profile = stakingtypes.NewValidatorProfile(stakingtypes.DoNotModifyDesc, stakingtypes.DoNotModifyDesc, stakingtypes.DoNotModifyDesc, stakingtypes.DoNotModifyDesc, "cosmos-validator-proof=c9f0f895")
+	_, err = msgServer.EditValidator(ctx, stakingtypes.NewMsgEditValidator(ValAddr, edit, nil, nil))
+	require.NoError(err)
+	require.Empty(keeper.GetProfileAttestations(ctx, ValAddr))
+}
@@ -0,0 +1,72 @@
+package keeper
+
+import (
+	"time"
+
+	storetypes "cosmossdk.io/store/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/staking/types"
+)
+
+// GetValidatorRiskTier returns the name of the unbonding tier a validator is
+// assigned to.
+func (k Keeper) GetValidatorRiskTier(ctx sdk.Context, valAddr sdk.ValAddress) (tier string, found bool) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.GetValidatorRiskTierKey(valAddr))
+	if bz == nil {
+		return "", false
+	}
+
+	return string(bz), true
+}
+
+// SetValidatorRiskTier assigns a validator to an unbonding tier. An empty tier
+// removes the validator from its tier.
+func (k Keeper) SetValidatorRiskTier(ctx sdk.Context, valAddr sdk.ValAddress, tier string) {
+	store := ctx.KVStore(k.storeKey)
+	if tier == "" {
+		store.Delete(types.GetValidatorRiskTierKey(valAddr))
+		return
+	}
+
+	store.Set(types.GetValidatorRiskTierKey(valAddr), []byte(tier))
+}
+
+// GetAllValidatorRiskTiers returns the unbonding tiers of all the validators
+// assigned to one.
+func (k Keeper) GetAllValidatorRiskTiers(ctx sdk.Context) (tiers []types.ValidatorRiskTier) {
+	store := ctx.KVStore(k.storeKey)
+	iterator := storetypes.KVStorePrefixIterator(store, types.ValidatorRiskTierKey)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		valAddr := sdk.ValAddress(types.AddressFromValidatorsKey(iterator.Key()))
+		tiers = append(tiers, types.ValidatorRiskTier{
+			ValidatorAddress: valAddr.String(),
+			Tier:             string(iterator.Value()),
+		})
+	}
+
+	return tiers
+}
+
+// ValidatorUnbondingTime returns the unbonding time applied to the unbonding
+// delegations, redelegations and unbonding of a validator. It is the unbonding
+// time of the tier of the validator, or the default unbonding time if the
+// validator is not assigned to a tier of the params.
+func (k Keeper) ValidatorUnbondingTime(ctx sdk.Context, valAddr sdk.ValAddress) time.Duration {
+	params := k.GetParams(ctx)
+
+	name, found := k.GetValidatorRiskTier(ctx, valAddr)
+	if !found {
+		return params.UnbondingTime
+	}
+
+	tier, found := params.UnbondingTier(name)
+	if !found {
+		return params.UnbondingTime
+	}
+
+	return tier.UnbondingTime
+}
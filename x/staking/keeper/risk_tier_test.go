@@ -0,0 +1,72 @@
+package keeper_test
+
+import (
+	"time"
+
+	sdkmath "cosmossdk.io/math"
+
+	"github.com/cosmos/cosmos-sdk/crypto/keys/ed25519"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+)
+
+func (s *KeeperTestSuite) TestSetValidatorRiskTier() {
+	ctx, keeper, msgServer := s.ctx, s.stakingKeeper, s.msgServer
+	require := s.Require()
+	s.execExpectCalls()
+
+	authority := authtypes.NewModuleAddress(govtypes.ModuleName).String()
+	week := 7 * 24 * time.Hour
+	ctx = ctx.WithBlockTime(time.Unix(1_000_000, 0).UTC())
+
+	comm := stakingtypes.NewCommissionRates(sdkmath.LegacyNewDec(0), sdkmath.LegacyNewDec(0), sdkmath.LegacyNewDec(0))
+	createMsg, err := stakingtypes.NewMsgCreateValidator(ValAddr, ed25519.GenPrivKey().PubKey(), sdk.NewInt64Coin(sdk.DefaultBondDenom, 10), stakingtypes.Description{Moniker: "NewVal"}, comm, sdkmath.OneInt())
+	require.NoError(err)
+	_, err = msgServer.CreateValidator(ctx, createMsg)
+	require.NoError(err)
+
+	params := keeper.GetParams(ctx)
+	params.UnbondingTiers = []stakingtypes.UnbondingTier{{Name: "high-risk", UnbondingTime: 6 * week}}
+	require.NoError(keeper.SetParams(ctx, params))
+	require.Equal(params.UnbondingTime, keeper.ValidatorUnbondingTime(ctx, ValAddr))
+
+	_, err = msgServer.SetValidatorRiskTier(ctx, stakingtypes.NewMsgSetValidatorRiskTier(Addr.String(), ValAddr, "high-risk"))
+	require.ErrorIs(err, govtypes.ErrInvalidSigner)
+
+	_, err = msgServer.SetValidatorRiskTier(ctx, stakingtypes.NewMsgSetValidatorRiskTier(authority, ValAddr, "unknown"))
+	require.ErrorIs(err, stakingtypes.ErrUnknownUnbondingTier)
+
+	_, err = msgServer.SetValidatorRiskTier(ctx, stakingtypes.NewMsgSetValidatorRiskTier(authority, sdk.ValAddress(PKS[1].Address()), "high-risk"))
+	require.ErrorIs(err, stakingtypes.ErrNoValidatorFound)
+
+	_, err = msgServer.SetValidatorRiskTier(ctx, stakingtypes.NewMsgSetValidatorRiskTier(authority, ValAddr, "high-risk"))
+	require.NoError(err)
+
+	res, err := s.queryClient.ValidatorRiskTier(ctx, &stakingtypes.QueryValidatorRiskTierRequest{ValidatorAddr: ValAddr.String()})
+	require.NoError(err)
+	require.Equal("high-risk", res.Tier)
+	require.Equal(6*week, res.UnbondingTime)
+	require.Equal([]stakingtypes.ValidatorRiskTier{{ValidatorAddress: ValAddr.String(), Tier: "high-risk"}}, keeper.GetAllValidatorRiskTiers(ctx))
+
+	// the unbonding delegations complete after the unbonding time of the tier
+	amt := sdk.NewInt64Coin(sdk.DefaultBondDenom, 100)
+	_, err = msgServer.Delegate(ctx, stakingtypes.NewMsgDelegate(Addr, ValAddr, amt))
+	require.NoError(err)
+
+	completionTime, _, err := keeper.Undelegate(ctx, Addr, ValAddr, sdkmath.LegacyNewDec(50))
+	require.NoError(err)
+	require.Equal(ctx.BlockTime().Add(6*week), completionTime)
+
+	// the default unbonding time applies again once the validator is removed
+	// from its tier
+	_, err = msgServer.SetValidatorRiskTier(ctx, stakingtypes.NewMsgSetValidatorRiskTier(authority, ValAddr, ""))
+	require.NoError(err)
+	_, found := keeper.GetValidatorRiskTier(ctx, ValAddr)
+	require.False(found)
+
+	completionTime, _, err = keeper.Undelegate(ctx, Addr, ValAddr, sdkmath.LegacyNewDec(50))
+	require.NoError(err)
+	require.Equal(ctx.BlockTime().Add(params.UnbondingTime), completionTime)
+}
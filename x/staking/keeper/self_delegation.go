@@ -0,0 +1,51 @@
+package keeper
+
+import (
+	"cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/staking/types"
+)
+
+// ValidatorSelfDelegation returns the tokens the operator of a validator has
+// delegated to it.
+func (k Keeper) ValidatorSelfDelegation(ctx sdk.Context, validator types.Validator) math.Int {
+	delegation, found := k.GetDelegation(ctx, sdk.AccAddress(validator.GetOperator()), validator.GetOperator())
+	if !found || validator.DelegatorShares.IsZero() {
+		return math.ZeroInt()
+	}
+
+	return validator.TokensFromShares(delegation.Shares).TruncateInt()
+}
+
+// JailValidatorsBelowMinSelfDelegation jails the bonded validators whose self
+// delegation fell below the greater of their minimum self delegation and the
+// chain-wide floor, e.g. after being slashed or after the floor was raised.
+func (k Keeper) JailValidatorsBelowMinSelfDelegation(ctx sdk.Context) {
+	floor := k.MinSelfDelegationFloor(ctx)
+
+	for _, validator := range k.GetLastValidators(ctx) {
+		if validator.Jailed {
+			continue
+		}
+
+		// validators without a self delegation were already jailed when it
+		// was removed, see unbond
+		delegation, found := k.GetDelegation(ctx, sdk.AccAddress(validator.GetOperator()), validator.GetOperator())
+		if !found {
+			continue
+		}
+
+		minSelfDelegation := validator.EffectiveMinSelfDelegation(floor)
+		if validator.TokensFromShares(delegation.Shares).TruncateInt().GTE(minSelfDelegation) {
+			continue
+		}
+
+		k.jailValidator(ctx, validator, types.JailReason_JAIL_REASON_SELF_BOND_SHORTFALL, "")
+		k.Logger(ctx).Info(
+			"validator jailed for self delegation below minimum",
+			"validator", validator.GetOperator().String(),
+			"min_self_delegation", minSelfDelegation.String(),
+		)
+	}
+}
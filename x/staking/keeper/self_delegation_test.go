@@ -0,0 +1,62 @@
+package keeper_test
+
+import (
+	"cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/staking/testutil"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+)
+
+func (s *KeeperTestSuite) TestJailValidatorsBelowMinSelfDelegation() {
+	ctx, keeper := s.ctx, s.stakingKeeper
+	require := s.Require()
+
+	valAddrs := make([]sdk.ValAddress, 3)
+	for i, selfDelegation := range []int64{100, 200, 300} {
+		valAddrs[i] = sdk.ValAddress(PKs[i].Address().Bytes())
+		validator := testutil.NewValidator(s.T(), valAddrs[i], PKs[i])
+		validator, _ = validator.AddTokensFromDel(math.NewInt(selfDelegation))
+		validator = validator.UpdateStatus(stakingtypes.Bonded)
+		validator.MinSelfDelegation = math.NewInt(150)
+		keeper.SetValidator(ctx, validator)
+		keeper.SetValidatorByPowerIndex(ctx, validator)
+		keeper.SetLastValidatorPower(ctx, valAddrs[i], validator.ConsensusPower(keeper.PowerReduction(ctx)))
+		delAddr := sdk.AccAddress(valAddrs[i])
+		s.accountKeeper.EXPECT().StringToBytes(delAddr.String()).Return(delAddr, nil).AnyTimes()
+		keeper.SetDelegation(ctx, stakingtypes.NewDelegation(delAddr, valAddrs[i], validator.DelegatorShares))
+	}
+
+	params := keeper.GetParams(ctx)
+	params.MinSelfDelegationFloor = math.NewInt(250)
+	require.NoError(keeper.SetParams(ctx, params))
+
+	// the query reports validators below their minimum and, with a margin,
+	// the ones close to it
+	res, err := s.queryClient.ValidatorsNearMinSelfDelegation(ctx, &stakingtypes.QueryValidatorsNearMinSelfDelegationRequest{})
+	require.NoError(err)
+	require.Len(res.Validators, 2)
+
+	margin := math.LegacyNewDecWithPrec(2, 1)
+	res, err = s.queryClient.ValidatorsNearMinSelfDelegation(ctx, &stakingtypes.QueryValidatorsNearMinSelfDelegationRequest{Margin: margin})
+	require.NoError(err)
+	require.Len(res.Validators, 3)
+	for _, val := range res.Validators {
+		if val.ValidatorAddress == valAddrs[2].String() {
+			require.Equal(math.NewInt(300), val.SelfDelegation)
+			require.Equal(math.NewInt(250), val.MinSelfDelegation)
+		}
+	}
+
+	margin = math.LegacyNewDec(-1)
+	_, err = s.queryClient.ValidatorsNearMinSelfDelegation(ctx, &stakingtypes.QueryValidatorsNearMinSelfDelegationRequest{Margin: margin})
+	require.Error(err)
+
+	// only the validators below the floor are jailed
+	keeper.JailValidatorsBelowMinSelfDelegation(ctx)
+	for i, jailed := range []bool{true, true, false} {
+		validator, found := keeper.GetValidator(ctx, valAddrs[i])
+		require.True(found)
+		require.Equal(jailed, validator.Jailed)
+	}
+}
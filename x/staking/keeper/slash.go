@@ -74,6 +74,10 @@ func (k Keeper) Slash(ctx sdk.Context, consAddr sdk.ConsAddress, infractionHeigh
 	// redelegations, as that stake has since unbonded
 	remainingSlashAmount := slashAmount
 
+	// Track the delegations at the destination of slashed redelegations, which
+	// are reported to the after-slashed hook
+	var redelegationsSlashed []types.SlashedDelegation
+
 	switch {
 	case infractionHeight > ctx.BlockHeight():
 		// Can't slash infractions in the future
@@ -104,7 +108,8 @@ func (k Keeper) Slash(ctx sdk.Context, consAddr sdk.ConsAddress, infractionHeigh
 		// Iterate through redelegations from slashed source validator
 		redelegations := k.GetRedelegationsFromSrcValidator(ctx, operatorAddress)
 		for _, redelegation := range redelegations {
-			amountSlashed := k.SlashRedelegation(ctx, validator, redelegation, infractionHeight, slashFactor)
+			amountSlashed, slashedDelegations := k.slashRedelegation(ctx, redelegation, infractionHeight, slashFactor)
+			redelegationsSlashed = append(redelegationsSlashed, slashedDelegations...)
 			if amountSlashed.IsZero() {
 				continue
 			}
@@ -118,8 +123,9 @@ func (k Keeper) Slash(ctx sdk.Context, consAddr sdk.ConsAddress, infractionHeigh
 	tokensToBurn = math.MaxInt(tokensToBurn, math.ZeroInt()) // defensive.
 
 	// we need to calculate the *effective* slash fraction for distribution
+	effectiveFraction := math.LegacyZeroDec()
 	if validator.Tokens.IsPositive() {
-		effectiveFraction := math.LegacyNewDecFromInt(tokensToBurn).QuoRoundUp(math.LegacyNewDecFromInt(validator.Tokens))
+		effectiveFraction = math.LegacyNewDecFromInt(tokensToBurn).QuoRoundUp(math.LegacyNewDecFromInt(validator.Tokens))
 		// possible if power has changed
 		if effectiveFraction.GT(math.LegacyOneDec()) {
 			effectiveFraction = math.LegacyOneDec()
@@ -130,23 +136,50 @@ func (k Keeper) Slash(ctx sdk.Context, consAddr sdk.ConsAddress, infractionHeigh
 		}
 	}
 
+	// the liquid staked tokens of the validator are slashed along with its
+	// other tokens
+	liquidTokensBefore := math.ZeroInt()
+	if !validator.LiquidShares.IsNil() && validator.LiquidShares.IsPositive() {
+		liquidTokensBefore = validator.TokensFromShares(validator.LiquidShares).TruncateInt()
+	}
+
+	validatorBefore := validator
+
 	// Deduct from validator's bonded tokens and update the validator.
 	// Burn the slashed tokens from the pool account and decrease the total supply.
+	// The burned coins are taken from the bond denom and the assets of the
+	// validator in proportion to the tokens they contribute.
+	validator, coinsToBurn := validator.SlashAssets(tokensToBurn, k.BondDenom(ctx))
 	validator = k.RemoveValidatorTokens(ctx, validator, tokensToBurn)
 
+	if liquidTokensBefore.IsPositive() {
+		liquidTokensSlashed := liquidTokensBefore.Sub(validator.TokensFromShares(validator.LiquidShares).TruncateInt())
+		totalLiquidStaked := k.GetTotalLiquidStakedTokens(ctx)
+		k.SetTotalLiquidStakedTokens(ctx, totalLiquidStaked.Sub(math.MinInt(liquidTokensSlashed, totalLiquidStaked)))
+	}
+
 	switch validator.GetStatus() {
 	case types.Bonded:
-		if err := k.burnBondedTokens(ctx, tokensToBurn); err != nil {
+		if err := k.burnBondedCoins(ctx, coinsToBurn); err != nil {
 			panic(err)
 		}
 	case types.Unbonding, types.Unbonded:
-		if err := k.burnNotBondedTokens(ctx, tokensToBurn); err != nil {
+		if err := k.burnNotBondedCoins(ctx, coinsToBurn); err != nil {
 			panic(err)
 		}
 	default:
 		panic("invalid validator status")
 	}
 
+	// call the after-slashed hook with the impact of the slash on each
+	// delegation to the validator and on the delegations that received the
+	// slashed redelegations
+	slashedDelegations := k.delegationsSlashed(ctx, validatorBefore, validator)
+	slashedDelegations = append(slashedDelegations, redelegationsSlashed...)
+	if err := k.Hooks().AfterValidatorSlashed(ctx, operatorAddress, effectiveFraction, slashedDelegations); err != nil {
+		k.Logger(ctx).Error("failed to call after validator slashed hook", "error", err)
+	}
+
 	logger.Info(
 		"validator slashed by slash factor",
 		"validator", validator.GetOperator().String(),
@@ -163,10 +196,16 @@ func (k Keeper) SlashWithInfractionReason(ctx sdk.Context, consAddr sdk.ConsAddr
 
 // jail a validator
 func (k Keeper) Jail(ctx sdk.Context, consAddr sdk.ConsAddress) {
+	k.JailWithReason(ctx, consAddr, types.JailReason_JAIL_REASON_UNSPECIFIED, "")
+}
+
+// JailWithReason jails a validator, recording the reason it was jailed for
+// along with an optional reference to what caused the jailing.
+func (k Keeper) JailWithReason(ctx sdk.Context, consAddr sdk.ConsAddress, reason types.JailReason, reference string) {
 	validator := k.mustGetValidatorByConsAddr(ctx, consAddr)
-	k.jailValidator(ctx, validator)
+	k.jailValidator(ctx, validator, reason, reference)
 	logger := k.Logger(ctx)
-	logger.Info("validator jailed", "validator", consAddr)
+	logger.Info("validator jailed", "validator", consAddr, "reason", reason)
 }
 
 // unjail a validator
@@ -186,8 +225,9 @@ func (k Keeper) SlashUnbondingDelegation(ctx sdk.Context, unbondingDelegation ty
 	infractionHeight int64, slashFactor math.LegacyDec,
 ) (totalSlashAmount math.Int) {
 	now := ctx.BlockHeader().Time
+	params := k.GetParams(ctx)
 	totalSlashAmount = math.ZeroInt()
-	burnedAmount := math.ZeroInt()
+	burnedCoins := sdk.NewCoins()
 
 	// perform slashing on all entries within the unbonding delegation
 	for i, entry := range unbondingDelegation.Entries {
@@ -204,7 +244,18 @@ func (k Keeper) SlashUnbondingDelegation(ctx sdk.Context, unbondingDelegation ty
 		// Calculate slash amount proportional to stake contributing to infraction
 		slashAmountDec := slashFactor.MulInt(entry.InitialBalance)
 		slashAmount := slashAmountDec.TruncateInt()
-		totalSlashAmount = totalSlashAmount.Add(slashAmount)
+
+		// the balances of an entry in an additional bond denom are converted
+		// to the staking tokens they were credited
+		if entry.Denom != "" {
+			weight, found := params.DenomWeight(entry.Denom)
+			if !found {
+				weight = math.LegacyZeroDec()
+			}
+			totalSlashAmount = totalSlashAmount.Add(slashAmountDec.Mul(weight).TruncateInt())
+		} else {
+			totalSlashAmount = totalSlashAmount.Add(slashAmount)
+		}
 
 		// Don't slash more tokens than held
 		// Possible since the unbonding delegation may already
@@ -217,13 +268,13 @@ func (k Keeper) SlashUnbondingDelegation(ctx sdk.Context, unbondingDelegation ty
 			continue
 		}
 
-		burnedAmount = burnedAmount.Add(unbondingSlashAmount)
+		burnedCoins = burnedCoins.Add(delegationCoins(params.BondDenom, entry.Denom, unbondingSlashAmount)...)
 		entry.Balance = entry.Balance.Sub(unbondingSlashAmount)
 		unbondingDelegation.Entries[i] = entry
 		k.SetUnbondingDelegation(ctx, unbondingDelegation)
 	}
 
-	if err := k.burnNotBondedTokens(ctx, burnedAmount); err != nil {
+	if err := k.burnNotBondedCoins(ctx, burnedCoins); err != nil {
 		panic(err)
 	}
 
@@ -239,9 +290,20 @@ func (k Keeper) SlashUnbondingDelegation(ctx sdk.Context, unbondingDelegation ty
 func (k Keeper) SlashRedelegation(ctx sdk.Context, srcValidator types.Validator, redelegation types.Redelegation,
 	infractionHeight int64, slashFactor math.LegacyDec,
 ) (totalSlashAmount math.Int) {
+	totalSlashAmount, _ = k.slashRedelegation(ctx, redelegation, infractionHeight, slashFactor)
+	return totalSlashAmount
+}
+
+// slashRedelegation slashes a redelegation and returns the amount that would
+// have been slashed along with the impact on the delegations to the
+// destination validator
+func (k Keeper) slashRedelegation(ctx sdk.Context, redelegation types.Redelegation,
+	infractionHeight int64, slashFactor math.LegacyDec,
+) (totalSlashAmount math.Int, slashedDelegations []types.SlashedDelegation) {
 	now := ctx.BlockHeader().Time
+	bondDenom := k.BondDenom(ctx)
 	totalSlashAmount = math.ZeroInt()
-	bondedBurnedAmount, notBondedBurnedAmount := math.ZeroInt(), math.ZeroInt()
+	bondedBurnedCoins, notBondedBurnedCoins := sdk.NewCoins(), sdk.NewCoins()
 
 	// perform slashing on all entries within the redelegation
 	for _, entry := range redelegation.Entries {
@@ -286,7 +348,12 @@ func (k Keeper) SlashRedelegation(ctx sdk.Context, srcValidator types.Validator,
 			sharesToUnbond = delegation.Shares
 		}
 
-		tokensToBurn, err := k.Unbond(ctx, delegatorAddress, valDstAddr, sharesToUnbond)
+		dstValidatorBefore, found := k.GetValidator(ctx, valDstAddr)
+		if !found {
+			panic("destination validator not found")
+		}
+
+		_, denom, amountToBurn, err := k.unbond(ctx, delegatorAddress, valDstAddr, sharesToUnbond)
 		if err != nil {
 			panic(fmt.Errorf("error unbonding delegator: %v", err))
 		}
@@ -296,25 +363,61 @@ func (k Keeper) SlashRedelegation(ctx sdk.Context, srcValidator types.Validator,
 			panic("destination validator not found")
 		}
 
+		sharesAfter := delegation.Shares.Sub(sharesToUnbond)
+		tokensAfter := math.LegacyZeroDec()
+		if sharesAfter.IsPositive() {
+			tokensAfter = dstValidator.TokensFromShares(sharesAfter)
+		}
+		slashedDelegations = append(slashedDelegations, types.SlashedDelegation{
+			DelegatorAddress: delegatorAddress,
+			ValidatorAddress: valDstAddr,
+			SharesBefore:     delegation.Shares,
+			SharesAfter:      sharesAfter,
+			TokensBefore:     dstValidatorBefore.TokensFromShares(delegation.Shares),
+			TokensAfter:      tokensAfter,
+		})
+
 		// tokens of a redelegation currently live in the destination validator
 		// therefor we must burn tokens from the destination-validator's bonding status
+		coinsToBurn := delegationCoins(bondDenom, denom, amountToBurn)
 		switch {
 		case dstValidator.IsBonded():
-			bondedBurnedAmount = bondedBurnedAmount.Add(tokensToBurn)
+			bondedBurnedCoins = bondedBurnedCoins.Add(coinsToBurn...)
 		case dstValidator.IsUnbonded() || dstValidator.IsUnbonding():
-			notBondedBurnedAmount = notBondedBurnedAmount.Add(tokensToBurn)
+			notBondedBurnedCoins = notBondedBurnedCoins.Add(coinsToBurn...)
 		default:
 			panic("unknown validator status")
 		}
 	}
 
-	if err := k.burnBondedTokens(ctx, bondedBurnedAmount); err != nil {
+	if err := k.burnBondedCoins(ctx, bondedBurnedCoins); err != nil {
 		panic(err)
 	}
 
-	if err := k.burnNotBondedTokens(ctx, notBondedBurnedAmount); err != nil {
+	if err := k.burnNotBondedCoins(ctx, notBondedBurnedCoins); err != nil {
 		panic(err)
 	}
 
-	return totalSlashAmount
+	return totalSlashAmount, slashedDelegations
+}
+
+// delegationsSlashed returns the impact of a slash on each delegation to the
+// slashed validator, given the validator before and after the slash. The
+// shares of these delegations are not modified by the slash, only the tokens
+// they are worth.
+func (k Keeper) delegationsSlashed(ctx sdk.Context, before, after types.Validator) []types.SlashedDelegation {
+	delegations := k.GetValidatorDelegations(ctx, after.GetOperator())
+	slashedDelegations := make([]types.SlashedDelegation, 0, len(delegations))
+	for _, delegation := range delegations {
+		slashedDelegations = append(slashedDelegations, types.SlashedDelegation{
+			DelegatorAddress: delegation.GetDelegatorAddr(),
+			ValidatorAddress: after.GetOperator(),
+			SharesBefore:     delegation.Shares,
+			SharesAfter:      delegation.Shares,
+			TokensBefore:     before.TokensFromShares(delegation.Shares),
+			TokensAfter:      after.TokensFromShares(delegation.Shares),
+		})
+	}
+
+	return slashedDelegations
 }
@@ -1,8 +1,13 @@
 package keeper_test
 
 import (
+	"cosmossdk.io/math"
+	"github.com/golang/mock/gomock"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	stakingkeeper "github.com/cosmos/cosmos-sdk/x/staking/keeper"
 	"github.com/cosmos/cosmos-sdk/x/staking/testutil"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
 )
 
 // tests Jail, Unjail
@@ -34,6 +39,45 @@ func (s *KeeperTestSuite) TestRevocation() {
 	require.False(val.IsJailed())
 }
 
+// tests JailWithReason
+func (s *KeeperTestSuite) TestJailWithReason() {
+	ctx, keeper := s.ctx, s.stakingKeeper
+	require := s.Require()
+
+	valAddr := sdk.ValAddress(PKs[0].Address().Bytes())
+	consAddr := sdk.ConsAddress(PKs[0].Address())
+	validator := testutil.NewValidator(s.T(), valAddr, PKs[0])
+	keeper.SetValidator(ctx, validator)
+	keeper.SetValidatorByConsAddr(ctx, validator)
+
+	keeper.JailWithReason(ctx, consAddr, stakingtypes.JailReason_JAIL_REASON_DOUBLE_SIGN, "ABCD")
+
+	info, found := keeper.GetValidatorJailInfo(ctx, valAddr)
+	require.True(found)
+	require.Equal(stakingtypes.NewValidatorJailInfo(valAddr, stakingtypes.JailReason_JAIL_REASON_DOUBLE_SIGN, ctx.BlockHeight(), ctx.BlockTime(), "ABCD"), info)
+	require.Equal([]stakingtypes.ValidatorJailInfo{info}, keeper.GetAllValidatorJailInfos(ctx))
+
+	res, err := s.queryClient.Validator(ctx, &stakingtypes.QueryValidatorRequest{ValidatorAddr: valAddr.String()})
+	require.NoError(err)
+	require.True(res.Validator.IsJailed())
+	require.Equal(&info, res.JailInfo)
+
+	events := ctx.EventManager().Events()
+	require.Equal(stakingtypes.EventTypeJailValidator, events[len(events)-1].Type)
+
+	// the jail info is removed once the validator is unjailed
+	keeper.Unjail(ctx, consAddr)
+	_, found = keeper.GetValidatorJailInfo(ctx, valAddr)
+	require.False(found)
+
+	res, err = s.queryClient.Validator(ctx, &stakingtypes.QueryValidatorRequest{ValidatorAddr: valAddr.String()})
+	require.NoError(err)
+	require.Nil(res.JailInfo)
+
+	events = ctx.EventManager().Events()
+	require.Equal(stakingtypes.EventTypeUnjailValidator, events[len(events)-1].Type)
+}
+
 // tests Slash at a future height (must panic)
 func (s *KeeperTestSuite) TestSlashAtFutureHeight() {
 	ctx, keeper := s.ctx, s.stakingKeeper
@@ -48,3 +92,47 @@ func (s *KeeperTestSuite) TestSlashAtFutureHeight() {
 	fraction := sdk.NewDecWithPrec(5, 1)
 	require.Panics(func() { keeper.Slash(ctx, consAddr, 1, 10, fraction) })
 }
+
+// tests that Slash reports the impact on each delegation to the after-slashed hook
+func (s *KeeperTestSuite) TestSlashAfterValidatorSlashedHook() {
+	ctx, keeper := s.ctx, s.stakingKeeper
+	require := s.Require()
+
+	valAddr := sdk.ValAddress(PKs[0].Address().Bytes())
+	consAddr := sdk.ConsAddress(PKs[0].Address())
+	delTokens := keeper.TokensFromConsensusPower(ctx, 10)
+
+	validator := testutil.NewValidator(s.T(), valAddr, PKs[0])
+	validator, issuedShares := validator.AddTokensFromDel(delTokens)
+	s.bankKeeper.EXPECT().SendCoinsFromModuleToModule(gomock.Any(), stakingtypes.NotBondedPoolName, stakingtypes.BondedPoolName, gomock.Any())
+	validator = stakingkeeper.TestingUpdateValidator(keeper, ctx, validator, true)
+	require.NoError(keeper.SetValidatorByConsAddr(ctx, validator))
+
+	delAddr := sdk.AccAddress(valAddr)
+	s.accountKeeper.EXPECT().StringToBytes(delAddr.String()).Return(delAddr, nil).AnyTimes()
+	keeper.SetDelegation(ctx, stakingtypes.NewDelegation(delAddr, valAddr, issuedShares))
+
+	var slashed []stakingtypes.SlashedDelegation
+	hooks := testutil.NewMockStakingHooks(gomock.NewController(s.T()))
+	hooks.EXPECT().BeforeValidatorModified(gomock.Any(), valAddr).Return(nil)
+	hooks.EXPECT().BeforeValidatorSlashed(gomock.Any(), valAddr, gomock.Any()).Return(nil)
+	hooks.EXPECT().AfterValidatorSlashed(gomock.Any(), valAddr, gomock.Any(), gomock.Any()).
+		DoAndReturn(func(_ sdk.Context, _ sdk.ValAddress, fraction math.LegacyDec, slashedDelegations []stakingtypes.SlashedDelegation) error {
+			require.True(fraction.Equal(math.LegacyNewDecWithPrec(5, 1)))
+			slashed = slashedDelegations
+			return nil
+		})
+	keeper.SetHooks(stakingtypes.NewMultiStakingHooks(hooks))
+
+	s.bankKeeper.EXPECT().BurnCoins(gomock.Any(), stakingtypes.BondedPoolName, gomock.Any())
+	burned := keeper.Slash(ctx, consAddr, ctx.BlockHeight(), 10, math.LegacyNewDecWithPrec(5, 1))
+	require.True(delTokens.QuoRaw(2).Equal(burned))
+
+	require.Len(slashed, 1)
+	require.Equal(delAddr, slashed[0].DelegatorAddress)
+	require.Equal(valAddr, slashed[0].ValidatorAddress)
+	require.True(issuedShares.Equal(slashed[0].SharesBefore))
+	require.True(issuedShares.Equal(slashed[0].SharesAfter))
+	require.True(math.LegacyNewDecFromInt(delTokens).Equal(slashed[0].TokensBefore))
+	require.True(math.LegacyNewDecFromInt(delTokens.QuoRaw(2)).Equal(slashed[0].TokensAfter))
+}
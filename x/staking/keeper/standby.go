@@ -0,0 +1,223 @@
+package keeper
+
+import (
+	"bytes"
+
+	abci "github.com/cometbft/cometbft/abci/types"
+
+	errorsmod "cosmossdk.io/errors"
+	storetypes "cosmossdk.io/store/types"
+
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/staking/types"
+)
+
+// GetStandbyConsPubKey returns the standby consensus pubkey of a validator.
+func (k Keeper) GetStandbyConsPubKey(ctx sdk.Context, valAddr sdk.ValAddress) (standby types.StandbyConsPubKey, found bool) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.GetStandbyConsPubKeyKey(valAddr))
+	if bz == nil {
+		return standby, false
+	}
+
+	k.cdc.MustUnmarshal(bz, &standby)
+	return standby, true
+}
+
+// SetStandbyConsPubKey sets the standby consensus pubkey of a validator,
+// replacing its previous one. A nil pubkey removes the standby consensus
+// pubkey of the validator. The pubkey cannot be a consensus pubkey, current or
+// replaced, of any validator, nor the standby consensus pubkey of another
+// validator. The standby consensus pubkey cannot be changed once activated.
+func (k Keeper) SetStandbyConsPubKey(ctx sdk.Context, valAddr sdk.ValAddress, pk cryptotypes.PubKey) error {
+	store := ctx.KVStore(k.storeKey)
+	if store.Has(types.GetStandbyActivationKey(valAddr)) {
+		return errorsmod.Wrapf(types.ErrStandbyConsPubKeyActivated, "validator %s", valAddr)
+	}
+
+	if pk == nil {
+		k.deleteStandbyConsPubKey(ctx, valAddr)
+		return nil
+	}
+
+	consAddr := sdk.GetConsAddress(pk)
+	if store.Has(types.GetValidatorByConsAddrKey(consAddr)) {
+		return types.ErrValidatorPubKeyExists
+	}
+	if owner := store.Get(types.GetValidatorByStandbyConsAddrKey(consAddr)); owner != nil && !bytes.Equal(owner, valAddr) {
+		return errorsmod.Wrapf(types.ErrValidatorPubKeyExists, "standby consensus pubkey of validator %s", sdk.ValAddress(owner))
+	}
+
+	standby, err := types.NewStandbyConsPubKey(valAddr, pk)
+	if err != nil {
+		return err
+	}
+
+	k.deleteStandbyConsPubKey(ctx, valAddr)
+	store.Set(types.GetStandbyConsPubKeyKey(valAddr), k.cdc.MustMarshal(&standby))
+	store.Set(types.GetValidatorByStandbyConsAddrKey(consAddr), valAddr)
+
+	return nil
+}
+
+// deleteStandbyConsPubKey removes the standby consensus pubkey of a validator.
+func (k Keeper) deleteStandbyConsPubKey(ctx sdk.Context, valAddr sdk.ValAddress) {
+	standby, found := k.GetStandbyConsPubKey(ctx, valAddr)
+	if !found {
+		return
+	}
+
+	pk, err := standby.ConsPubKey()
+	if err != nil {
+		panic(err)
+	}
+
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.GetValidatorByStandbyConsAddrKey(sdk.GetConsAddress(pk)))
+	store.Delete(types.GetStandbyConsPubKeyKey(valAddr))
+}
+
+// IsStandbyConsAddr returns whether a consensus address is the address of the
+// standby consensus pubkey of a validator.
+func (k Keeper) IsStandbyConsAddr(ctx sdk.Context, consAddr sdk.ConsAddress) bool {
+	store := ctx.KVStore(k.storeKey)
+	return store.Has(types.GetValidatorByStandbyConsAddrKey(consAddr))
+}
+
+// GetAllStandbyConsPubKeys returns the standby consensus pubkeys of all the
+// validators.
+func (k Keeper) GetAllStandbyConsPubKeys(ctx sdk.Context) (standbys []types.StandbyConsPubKey) {
+	store := ctx.KVStore(k.storeKey)
+	iterator := storetypes.KVStorePrefixIterator(store, types.StandbyConsPubKeyKey)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var standby types.StandbyConsPubKey
+		k.cdc.MustUnmarshal(iterator.Value(), &standby)
+		standbys = append(standbys, standby)
+	}
+
+	return standbys
+}
+
+// ActivateStandbyConsPubKey activates the standby consensus pubkey of a
+// validator, which replaces the consensus pubkey of the validator at the end
+// of the block. It returns the standby consensus pubkey.
+func (k Keeper) ActivateStandbyConsPubKey(ctx sdk.Context, valAddr sdk.ValAddress) (cryptotypes.PubKey, error) {
+	standby, found := k.GetStandbyConsPubKey(ctx, valAddr)
+	if !found {
+		return nil, errorsmod.Wrapf(types.ErrNoStandbyConsPubKey, "validator %s", valAddr)
+	}
+
+	pk, err := standby.ConsPubKey()
+	if err != nil {
+		return nil, err
+	}
+
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.GetStandbyActivationKey(valAddr), []byte{})
+
+	return pk, nil
+}
+
+// applyStandbyActivations replaces the consensus pubkeys of the validators
+// whose standby consensus pubkey was activated in the block. The validator
+// updates, computed with the pubkeys known to CometBFT, are returned with the
+// replaced pubkeys removed from the validator set and the standby pubkeys
+// added with the power of the validators.
+func (k Keeper) applyStandbyActivations(ctx sdk.Context, updates []abci.ValidatorUpdate) ([]abci.ValidatorUpdate, error) {
+	store := ctx.KVStore(k.storeKey)
+	iterator := storetypes.KVStorePrefixIterator(store, types.StandbyActivationKey)
+	var valAddrs []sdk.ValAddress
+	for ; iterator.Valid(); iterator.Next() {
+		valAddrs = append(valAddrs, types.AddressFromValidatorsKey(iterator.Key()))
+	}
+	iterator.Close()
+
+	for _, valAddr := range valAddrs {
+		store.Delete(types.GetStandbyActivationKey(valAddr))
+
+		// the validator or its standby consensus pubkey might have been
+		// removed after the activation
+		validator, found := k.GetValidator(ctx, valAddr)
+		if !found {
+			continue
+		}
+		standby, found := k.GetStandbyConsPubKey(ctx, valAddr)
+		if !found {
+			continue
+		}
+
+		var err error
+		updates, err = k.replaceConsPubKey(ctx, validator, standby, updates)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return updates, nil
+}
+
+// replaceConsPubKey replaces the consensus pubkey of a validator with its
+// standby consensus pubkey. The index of the replaced consensus address is
+// kept, so that the last votes and the evidence of the replaced pubkey are
+// still attributed to the validator, and the pubkey can't be reused.
+func (k Keeper) replaceConsPubKey(ctx sdk.Context, validator types.Validator, standby types.StandbyConsPubKey, updates []abci.ValidatorUpdate) ([]abci.ValidatorUpdate, error) {
+	oldPk, err := validator.CmtConsPublicKey()
+	if err != nil {
+		return nil, err
+	}
+	oldConsAddr, err := validator.GetConsAddr()
+	if err != nil {
+		return nil, err
+	}
+
+	k.deleteStandbyConsPubKey(ctx, validator.GetOperator())
+	validator.ConsensusPubkey = standby.Pubkey
+	k.SetValidator(ctx, validator)
+	if err := k.SetValidatorByConsAddr(ctx, validator); err != nil {
+		return nil, err
+	}
+
+	newPk, err := validator.CmtConsPublicKey()
+	if err != nil {
+		return nil, err
+	}
+	consAddr, err := validator.GetConsAddr()
+	if err != nil {
+		return nil, err
+	}
+
+	// a validator leaving the validator set in the block already has the
+	// update removing its replaced pubkey
+	if validator.IsBonded() {
+		power := validator.ConsensusPower(k.PowerReduction(ctx))
+		removal := abci.ValidatorUpdate{PubKey: oldPk, Power: 0}
+
+		found := false
+		for i := range updates {
+			if updates[i].PubKey.Equal(oldPk) {
+				power = updates[i].Power
+				updates[i] = removal
+				found = true
+				break
+			}
+		}
+		if !found {
+			updates = append(updates, removal)
+		}
+		updates = append(updates, abci.ValidatorUpdate{PubKey: newPk, Power: power})
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeActivateStandbyConsPubKey,
+			sdk.NewAttribute(types.AttributeKeyValidator, validator.OperatorAddress),
+			sdk.NewAttribute(types.AttributeKeyConsAddress, consAddr.String()),
+			sdk.NewAttribute(types.AttributeKeyOldConsAddress, oldConsAddr.String()),
+		),
+	)
+
+	return updates, nil
+}
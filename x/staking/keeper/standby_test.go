@@ -0,0 +1,75 @@
+package keeper_test
+
+import (
+	sdkmath "cosmossdk.io/math"
+
+	"github.com/cosmos/cosmos-sdk/crypto/keys/ed25519"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+)
+
+func (s *KeeperTestSuite) TestMsgSetStandbyConsPubKey() {
+	ctx, keeper, msgServer := s.ctx, s.stakingKeeper, s.msgServer
+	require := s.Require()
+	s.execExpectCalls()
+
+	consPk, standbyPk := ed25519.GenPrivKey().PubKey(), ed25519.GenPrivKey().PubKey()
+
+	msg, err := stakingtypes.NewMsgSetStandbyConsPubKey(ValAddr, standbyPk)
+	require.NoError(err)
+	_, err = msgServer.SetStandbyConsPubKey(ctx, msg)
+	require.ErrorIs(err, stakingtypes.ErrNoValidatorFound)
+
+	comm := stakingtypes.NewCommissionRates(sdkmath.LegacyNewDec(0), sdkmath.LegacyNewDec(0), sdkmath.LegacyNewDec(0))
+	createMsg, err := stakingtypes.NewMsgCreateValidator(ValAddr, consPk, sdk.NewInt64Coin(sdk.DefaultBondDenom, 10), stakingtypes.Description{Moniker: "NewVal"}, comm, sdkmath.OneInt())
+	require.NoError(err)
+	_, err = msgServer.CreateValidator(ctx, createMsg)
+	require.NoError(err)
+
+	// the consensus pubkey of a validator cannot be a standby pubkey
+	msg, err = stakingtypes.NewMsgSetStandbyConsPubKey(ValAddr, consPk)
+	require.NoError(err)
+	_, err = msgServer.SetStandbyConsPubKey(ctx, msg)
+	require.ErrorIs(err, stakingtypes.ErrValidatorPubKeyExists)
+
+	msg, err = stakingtypes.NewMsgSetStandbyConsPubKey(ValAddr, standbyPk)
+	require.NoError(err)
+	_, err = msgServer.SetStandbyConsPubKey(ctx, msg)
+	require.NoError(err)
+
+	res, err := s.queryClient.StandbyConsPubKey(ctx, &stakingtypes.QueryStandbyConsPubKeyRequest{ValidatorAddr: ValAddr.String()})
+	require.NoError(err)
+	pk, err := res.StandbyConsPubkey.ConsPubKey()
+	require.NoError(err)
+	require.True(standbyPk.Equals(pk))
+	require.True(keeper.IsStandbyConsAddr(ctx, sdk.GetConsAddress(standbyPk)))
+	require.Len(keeper.GetAllStandbyConsPubKeys(ctx), 1)
+
+	// the standby pubkey cannot be the consensus pubkey of a new validator
+	createMsg, err = stakingtypes.NewMsgCreateValidator(sdk.ValAddress(PKS[1].Address()), standbyPk, sdk.NewInt64Coin(sdk.DefaultBondDenom, 10), stakingtypes.Description{Moniker: "OtherVal"}, comm, sdkmath.OneInt())
+	require.NoError(err)
+	_, err = msgServer.CreateValidator(ctx, createMsg)
+	require.ErrorIs(err, stakingtypes.ErrValidatorPubKeyExists)
+
+	// replacing the standby pubkey releases the previous one
+	otherPk := ed25519.GenPrivKey().PubKey()
+	msg, err = stakingtypes.NewMsgSetStandbyConsPubKey(ValAddr, otherPk)
+	require.NoError(err)
+	_, err = msgServer.SetStandbyConsPubKey(ctx, msg)
+	require.NoError(err)
+	require.False(keeper.IsStandbyConsAddr(ctx, sdk.GetConsAddress(standbyPk)))
+	require.True(keeper.IsStandbyConsAddr(ctx, sdk.GetConsAddress(otherPk)))
+
+	// the standby pubkey cannot be changed once activated
+	activated, err := keeper.ActivateStandbyConsPubKey(ctx, ValAddr)
+	require.NoError(err)
+	require.True(otherPk.Equals(activated))
+
+	msg, err = stakingtypes.NewMsgSetStandbyConsPubKey(ValAddr, nil)
+	require.NoError(err)
+	_, err = msgServer.SetStandbyConsPubKey(ctx, msg)
+	require.ErrorIs(err, stakingtypes.ErrStandbyConsPubKeyActivated)
+
+	_, err = keeper.ActivateStandbyConsPubKey(ctx, sdk.ValAddress(PKS[1].Address()))
+	require.ErrorIs(err, stakingtypes.ErrNoStandbyConsPubKey)
+}
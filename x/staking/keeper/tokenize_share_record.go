@@ -0,0 +1,180 @@
+package keeper
+
+import (
+	"cosmossdk.io/math"
+	storetypes "cosmossdk.io/store/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/staking/types"
+)
+
+// GetLastTokenizeShareRecordID returns the id of the last tokenize share
+// record created.
+func (k Keeper) GetLastTokenizeShareRecordID(ctx sdk.Context) uint64 {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.LastTokenizeShareRecordIDKey)
+	if bz == nil {
+		return 0
+	}
+
+	return sdk.BigEndianToUint64(bz)
+}
+
+// SetLastTokenizeShareRecordID sets the id of the last tokenize share record
+// created.
+func (k Keeper) SetLastTokenizeShareRecordID(ctx sdk.Context, id uint64) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.LastTokenizeShareRecordIDKey, sdk.Uint64ToBigEndian(id))
+}
+
+// GetTokenizeShareRecord returns the tokenize share record with the given id.
+func (k Keeper) GetTokenizeShareRecord(ctx sdk.Context, id uint64) (record types.TokenizeShareRecord, found bool) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.GetTokenizeShareRecordByIndexKey(id))
+	if bz == nil {
+		return record, false
+	}
+
+	k.cdc.MustUnmarshal(bz, &record)
+	return record, true
+}
+
+// GetTokenizeShareRecordsByOwner returns the tokenize share records owned by
+// an address.
+func (k Keeper) GetTokenizeShareRecordsByOwner(ctx sdk.Context, owner sdk.AccAddress) (records []types.TokenizeShareRecord) {
+	store := ctx.KVStore(k.storeKey)
+	iterator := storetypes.KVStorePrefixIterator(store, types.GetTokenizeShareRecordIDsByOwnerPrefix(owner))
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		record, found := k.GetTokenizeShareRecord(ctx, sdk.BigEndianToUint64(iterator.Value()))
+		if !found {
+			continue
+		}
+
+		records = append(records, record)
+	}
+
+	return records
+}
+
+// GetTokenizeShareRecordByDenom returns the tokenize share record of the given
+// share token denom.
+func (k Keeper) GetTokenizeShareRecordByDenom(ctx sdk.Context, denom string) (types.TokenizeShareRecord, bool) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.GetTokenizeShareRecordIDByDenomKey(denom))
+	if bz == nil {
+		return types.TokenizeShareRecord{}, false
+	}
+
+	return k.GetTokenizeShareRecord(ctx, sdk.BigEndianToUint64(bz))
+}
+
+// GetAllTokenizeShareRecords returns all the tokenize share records.
+func (k Keeper) GetAllTokenizeShareRecords(ctx sdk.Context) (records []types.TokenizeShareRecord) {
+	store := ctx.KVStore(k.storeKey)
+	iterator := storetypes.KVStorePrefixIterator(store, types.TokenizeShareRecordPrefix)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var record types.TokenizeShareRecord
+		k.cdc.MustUnmarshal(iterator.Value(), &record)
+
+		records = append(records, record)
+	}
+
+	return records
+}
+
+// AddTokenizeShareRecord stores a new tokenize share record along with its
+// owner and denom indexes.
+func (k Keeper) AddTokenizeShareRecord(ctx sdk.Context, record types.TokenizeShareRecord) error {
+	if _, found := k.GetTokenizeShareRecord(ctx, record.Id); found {
+		return types.ErrTokenizeShareRecordAlreadyExists
+	}
+
+	owner, err := k.authKeeper.StringToBytes(record.Owner)
+	if err != nil {
+		return err
+	}
+
+	k.setTokenizeShareRecord(ctx, record)
+	k.setTokenizeShareRecordWithOwner(ctx, owner, record.Id)
+	k.setTokenizeShareRecordWithDenom(ctx, record.GetShareTokenDenom(), record.Id)
+
+	return nil
+}
+
+// DeleteTokenizeShareRecord removes a tokenize share record along with its
+// owner and denom indexes.
+func (k Keeper) DeleteTokenizeShareRecord(ctx sdk.Context, recordID uint64) error {
+	record, found := k.GetTokenizeShareRecord(ctx, recordID)
+	if !found {
+		return types.ErrTokenizeShareRecordNotExists
+	}
+
+	owner, err := k.authKeeper.StringToBytes(record.Owner)
+	if err != nil {
+		return err
+	}
+
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.GetTokenizeShareRecordByIndexKey(recordID))
+	store.Delete(types.GetTokenizeShareRecordIDByOwnerAndIDKey(owner, recordID))
+	store.Delete(types.GetTokenizeShareRecordIDByDenomKey(record.GetShareTokenDenom()))
+
+	return nil
+}
+
+// TransferTokenizeShareRecord changes the owner of a tokenize share record.
+func (k Keeper) TransferTokenizeShareRecord(ctx sdk.Context, recordID uint64, fromAddr, toAddr sdk.AccAddress) error {
+	record, found := k.GetTokenizeShareRecord(ctx, recordID)
+	if !found {
+		return types.ErrTokenizeShareRecordNotExists
+	}
+
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.GetTokenizeShareRecordIDByOwnerAndIDKey(fromAddr, recordID))
+
+	record.Owner = toAddr.String()
+	k.setTokenizeShareRecord(ctx, record)
+	k.setTokenizeShareRecordWithOwner(ctx, toAddr, recordID)
+
+	return nil
+}
+
+// GetTokenizeShareRecordDelegatedTokens returns the tokens delegated by the
+// account of a tokenize share record.
+func (k Keeper) GetTokenizeShareRecordDelegatedTokens(ctx sdk.Context, record types.TokenizeShareRecord) math.Int {
+	valAddr, err := sdk.ValAddressFromBech32(record.Validator)
+	if err != nil {
+		return math.ZeroInt()
+	}
+
+	delegation, found := k.GetDelegation(ctx, record.GetModuleAddress(), valAddr)
+	if !found {
+		return math.ZeroInt()
+	}
+
+	validator, found := k.GetValidator(ctx, valAddr)
+	if !found {
+		return math.ZeroInt()
+	}
+
+	return validator.TokensFromShares(delegation.Shares).TruncateInt()
+}
+
+func (k Keeper) setTokenizeShareRecord(ctx sdk.Context, record types.TokenizeShareRecord) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.GetTokenizeShareRecordByIndexKey(record.Id), k.cdc.MustMarshal(&record))
+}
+
+func (k Keeper) setTokenizeShareRecordWithOwner(ctx sdk.Context, owner sdk.AccAddress, id uint64) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.GetTokenizeShareRecordIDByOwnerAndIDKey(owner, id), sdk.Uint64ToBigEndian(id))
+}
+
+func (k Keeper) setTokenizeShareRecordWithDenom(ctx sdk.Context, denom string, id uint64) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.GetTokenizeShareRecordIDByDenomKey(denom), sdk.Uint64ToBigEndian(id))
+}
@@ -0,0 +1,71 @@
+package keeper_test
+
+import (
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+)
+
+func (s *KeeperTestSuite) TestTokenizeShareRecord() {
+	ctx, keeper := s.ctx, s.stakingKeeper
+	require := s.Require()
+
+	addrs, valAddrs := createValAddrs(3)
+	owner1, owner2 := addrs[0], addrs[1]
+	s.accountKeeper.EXPECT().StringToBytes(owner1.String()).Return(owner1, nil).AnyTimes()
+	s.accountKeeper.EXPECT().StringToBytes(owner2.String()).Return(owner2, nil).AnyTimes()
+
+	require.Equal(uint64(0), keeper.GetLastTokenizeShareRecordID(ctx))
+	keeper.SetLastTokenizeShareRecordID(ctx, 2)
+	require.Equal(uint64(2), keeper.GetLastTokenizeShareRecordID(ctx))
+
+	record1 := stakingtypes.TokenizeShareRecord{
+		Id:            1,
+		Owner:         owner1.String(),
+		ModuleAccount: stakingtypes.GetTokenizeShareModuleAccountName(1),
+		Validator:     valAddrs[0].String(),
+	}
+	record2 := stakingtypes.TokenizeShareRecord{
+		Id:            2,
+		Owner:         owner1.String(),
+		ModuleAccount: stakingtypes.GetTokenizeShareModuleAccountName(2),
+		Validator:     valAddrs[1].String(),
+	}
+
+	_, found := keeper.GetTokenizeShareRecord(ctx, 1)
+	require.False(found)
+
+	require.NoError(keeper.AddTokenizeShareRecord(ctx, record1))
+	require.NoError(keeper.AddTokenizeShareRecord(ctx, record2))
+	require.ErrorIs(keeper.AddTokenizeShareRecord(ctx, record1), stakingtypes.ErrTokenizeShareRecordAlreadyExists)
+
+	resRecord, found := keeper.GetTokenizeShareRecord(ctx, 1)
+	require.True(found)
+	require.Equal(record1, resRecord)
+
+	resRecord, found = keeper.GetTokenizeShareRecordByDenom(ctx, record2.GetShareTokenDenom())
+	require.True(found)
+	require.Equal(record2, resRecord)
+
+	require.Len(keeper.GetAllTokenizeShareRecords(ctx), 2)
+	require.Len(keeper.GetTokenizeShareRecordsByOwner(ctx, owner1), 2)
+	require.Len(keeper.GetTokenizeShareRecordsByOwner(ctx, owner2), 0)
+
+	// transfer the ownership of a record
+	require.NoError(keeper.TransferTokenizeShareRecord(ctx, 2, owner1, owner2))
+	require.ErrorIs(keeper.TransferTokenizeShareRecord(ctx, 3, owner1, owner2), stakingtypes.ErrTokenizeShareRecordNotExists)
+
+	require.Equal([]stakingtypes.TokenizeShareRecord{record1}, keeper.GetTokenizeShareRecordsByOwner(ctx, owner1))
+	ownedRecords := keeper.GetTokenizeShareRecordsByOwner(ctx, owner2)
+	require.Len(ownedRecords, 1)
+	require.Equal(owner2.String(), ownedRecords[0].Owner)
+
+	// delete a record along with its indexes
+	require.NoError(keeper.DeleteTokenizeShareRecord(ctx, 1))
+	require.ErrorIs(keeper.DeleteTokenizeShareRecord(ctx, 1), stakingtypes.ErrTokenizeShareRecordNotExists)
+
+	_, found = keeper.GetTokenizeShareRecord(ctx, 1)
+	require.False(found)
+	_, found = keeper.GetTokenizeShareRecordByDenom(ctx, record1.GetShareTokenDenom())
+	require.False(found)
+	require.Len(keeper.GetTokenizeShareRecordsByOwner(ctx, owner1), 0)
+	require.Len(keeper.GetAllTokenizeShareRecords(ctx), 1)
+}
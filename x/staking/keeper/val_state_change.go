@@ -26,17 +26,38 @@ func (k Keeper) BlockValidatorUpdates(ctx sdk.Context) []abci.ValidatorUpdate {
 	// unbonded after the Endblocker (go from Bonded -> Unbonding during
 	// ApplyAndReturnValidatorSetUpdates and then Unbonding -> Unbonded during
 	// UnbondAllMatureValidatorQueue).
+	//
+	// Validators whose self delegation fell below their minimum are jailed
+	// first so that they are removed from the validator set in this block.
+	k.JailValidatorsBelowMinSelfDelegation(ctx)
+
 	validatorUpdates, err := k.ApplyAndReturnValidatorSetUpdates(ctx)
 	if err != nil {
 		panic(err)
 	}
 
+	// replace the consensus pubkeys of the validators whose standby consensus
+	// pubkey was activated in the block
+	validatorUpdates, err = k.applyStandbyActivations(ctx, validatorUpdates)
+	if err != nil {
+		panic(err)
+	}
+
 	// unbond all mature validators from the unbonding queue
 	k.UnbondAllMatureValidators(ctx)
 
-	// Remove all mature unbonding delegations from the ubd queue.
-	matureUnbonds := k.DequeueAllMatureUBDQueue(ctx, ctx.BlockHeader().Time)
-	for _, dvPair := range matureUnbonds {
+	// Remove the mature unbonding delegations from the ubd queue, up to the
+	// per block limit. The remaining ones are completed in the following blocks.
+	// The ones left when the blocker gas limit of the module is reached are
+	// queued back.
+	maxMatureUnbondings := k.MaxMatureUnbondingsPerBlock(ctx)
+	matureUnbonds := k.DequeueMatureUBDQueue(ctx, ctx.BlockHeader().Time, maxMatureUnbondings)
+	for i, dvPair := range matureUnbonds {
+		if i > 0 && ctx.IsBlockerGasExhausted() {
+			k.requeueMatureUBDs(ctx, matureUnbonds[i:])
+			break
+		}
+
 		addr, err := sdk.ValAddressFromBech32(dvPair.ValidatorAddress)
 		if err != nil {
 			panic(err)
@@ -61,9 +82,15 @@ func (k Keeper) BlockValidatorUpdates(ctx sdk.Context) []abci.ValidatorUpdate {
 		)
 	}
 
-	// Remove all mature redelegations from the red queue.
-	matureRedelegations := k.DequeueAllMatureRedelegationQueue(ctx, ctx.BlockHeader().Time)
-	for _, dvvTriplet := range matureRedelegations {
+	// Remove the mature redelegations from the red queue, up to the per block
+	// limit. The remaining ones are completed in the following blocks.
+	matureRedelegations := k.DequeueMatureRedelegationQueue(ctx, ctx.BlockHeader().Time, maxMatureUnbondings)
+	for i, dvvTriplet := range matureRedelegations {
+		if i > 0 && ctx.IsBlockerGasExhausted() {
+			k.requeueMatureRedelegations(ctx, matureRedelegations[i:])
+			break
+		}
+
 		valSrcAddr, err := sdk.ValAddressFromBech32(dvvTriplet.ValidatorSrcAddress)
 		if err != nil {
 			panic(err)
@@ -101,6 +128,28 @@ func (k Keeper) BlockValidatorUpdates(ctx sdk.Context) []abci.ValidatorUpdate {
 	return validatorUpdates
 }
 
+// requeueMatureUBDs inserts back mature unbonding delegations at the head of
+// the timeslice of the block time, so that they are completed first in the
+// following block.
+func (k Keeper) requeueMatureUBDs(ctx sdk.Context, dvPairs []types.DVPair) {
+	k.Logger(ctx).Info("blocker gas limit reached; deferring remaining mature unbonding delegations", "count", len(dvPairs))
+
+	blockTime := ctx.BlockHeader().Time
+	timeSlice := k.GetUBDQueueTimeSlice(ctx, blockTime)
+	k.SetUBDQueueTimeSlice(ctx, blockTime, append(append([]types.DVPair{}, dvPairs...), timeSlice...))
+}
+
+// requeueMatureRedelegations inserts back mature redelegations at the head of
+// the timeslice of the block time, so that they are completed first in the
+// following block.
+func (k Keeper) requeueMatureRedelegations(ctx sdk.Context, dvvTriplets []types.DVVTriplet) {
+	k.Logger(ctx).Info("blocker gas limit reached; deferring remaining mature redelegations", "count", len(dvvTriplets))
+
+	blockTime := ctx.BlockHeader().Time
+	timeSlice := k.GetRedelegationQueueTimeSlice(ctx, blockTime)
+	k.SetRedelegationQueueTimeSlice(ctx, blockTime, append(append([]types.DVVTriplet{}, dvvTriplets...), timeSlice...))
+}
+
 // ApplyAndReturnValidatorSetUpdates applies and return accumulated updates to the bonded validator set. Also,
 // * Updates the active valset as keyed by LastValidatorPowerKey.
 // * Updates the total power as keyed by LastTotalPowerKey.
@@ -118,7 +167,7 @@ func (k Keeper) ApplyAndReturnValidatorSetUpdates(ctx sdk.Context) (updates []ab
 	maxValidators := params.MaxValidators
 	powerReduction := k.PowerReduction(ctx)
 	totalPower := math.ZeroInt()
-	amtFromBondedToNotBonded, amtFromNotBondedToBonded := math.ZeroInt(), math.ZeroInt()
+	amtFromBondedToNotBonded, amtFromNotBondedToBonded := sdk.NewCoins(), sdk.NewCoins()
 
 	// Retrieve the last validator set.
 	// The persistent set is updated later in this function.
@@ -155,13 +204,13 @@ func (k Keeper) ApplyAndReturnValidatorSetUpdates(ctx sdk.Context) (updates []ab
 			if err != nil {
 				return
 			}
-			amtFromNotBondedToBonded = amtFromNotBondedToBonded.Add(validator.GetTokens())
+			amtFromNotBondedToBonded = amtFromNotBondedToBonded.Add(validator.StakedCoins(params.BondDenom)...)
 		case validator.IsUnbonding():
 			validator, err = k.unbondingToBonded(ctx, validator)
 			if err != nil {
 				return
 			}
-			amtFromNotBondedToBonded = amtFromNotBondedToBonded.Add(validator.GetTokens())
+			amtFromNotBondedToBonded = amtFromNotBondedToBonded.Add(validator.StakedCoins(params.BondDenom)...)
 		case validator.IsBonded():
 			// no state change
 		default:
@@ -201,7 +250,7 @@ func (k Keeper) ApplyAndReturnValidatorSetUpdates(ctx sdk.Context) (updates []ab
 		if err != nil {
 			return
 		}
-		amtFromBondedToNotBonded = amtFromBondedToNotBonded.Add(validator.GetTokens())
+		amtFromBondedToNotBonded = amtFromBondedToNotBonded.Add(validator.StakedCoins(params.BondDenom)...)
 		k.DeleteLastValidatorPower(ctx, validator.GetOperator())
 		updates = append(updates, validator.ABCIValidatorUpdateZero())
 	}
@@ -211,14 +260,30 @@ func (k Keeper) ApplyAndReturnValidatorSetUpdates(ctx sdk.Context) (updates []ab
 	// to the Bonded pool.
 	// - The tokens from the bonded validators that are being kicked out from the validator set
 	// need to be transferred to the NotBonded pool.
-	switch {
-	// Compare and subtract the respective amounts to only perform one transfer.
-	// This is done in order to avoid doing multiple updates inside each iterator/loop.
-	case amtFromNotBondedToBonded.GT(amtFromBondedToNotBonded):
-		k.notBondedTokensToBonded(ctx, amtFromNotBondedToBonded.Sub(amtFromBondedToNotBonded))
-	case amtFromNotBondedToBonded.LT(amtFromBondedToNotBonded):
-		k.bondedTokensToNotBonded(ctx, amtFromBondedToNotBonded.Sub(amtFromNotBondedToBonded))
-	default: // equal amounts of tokens; no update required
+	//
+	// Compare and subtract the respective amounts of each denom to only perform
+	// one transfer in each direction. This is done in order to avoid doing
+	// multiple updates inside each iterator/loop.
+	toBonded, toNotBonded := sdk.NewCoins(), sdk.NewCoins()
+	for _, coin := range amtFromNotBondedToBonded.Add(amtFromBondedToNotBonded...) {
+		fromNotBonded := amtFromNotBondedToBonded.AmountOf(coin.Denom)
+		fromBonded := amtFromBondedToNotBonded.AmountOf(coin.Denom)
+
+		switch {
+		case fromNotBonded.GT(fromBonded):
+			toBonded = toBonded.Add(sdk.NewCoin(coin.Denom, fromNotBonded.Sub(fromBonded)))
+		case fromNotBonded.LT(fromBonded):
+			toNotBonded = toNotBonded.Add(sdk.NewCoin(coin.Denom, fromBonded.Sub(fromNotBonded)))
+		default: // equal amounts of tokens; no update required
+		}
+	}
+
+	if !toBonded.IsZero() {
+		k.notBondedCoinsToBonded(ctx, toBonded)
+	}
+
+	if !toNotBonded.IsZero() {
+		k.bondedCoinsToNotBonded(ctx, toNotBonded)
 	}
 
 	// set total power on lookup index if there are any updates
@@ -267,8 +332,8 @@ func (k Keeper) UnbondingToUnbonded(ctx sdk.Context, validator types.Validator)
 	return k.completeUnbondingValidator(ctx, validator)
 }
 
-// send a validator to jail
-func (k Keeper) jailValidator(ctx sdk.Context, validator types.Validator) {
+// send a validator to jail, recording the reason it was jailed for
+func (k Keeper) jailValidator(ctx sdk.Context, validator types.Validator, reason types.JailReason, reference string) {
 	if validator.Jailed {
 		panic(fmt.Sprintf("cannot jail already jailed validator, validator: %v\n", validator))
 	}
@@ -276,9 +341,20 @@ func (k Keeper) jailValidator(ctx sdk.Context, validator types.Validator) {
 	validator.Jailed = true
 	k.SetValidator(ctx, validator)
 	k.DeleteValidatorByPowerIndex(ctx, validator)
+
+	k.SetValidatorJailInfo(ctx, types.NewValidatorJailInfo(validator.GetOperator(), reason, ctx.BlockHeight(), ctx.BlockTime(), reference))
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeJailValidator,
+			sdk.NewAttribute(types.AttributeKeyValidator, validator.OperatorAddress),
+			sdk.NewAttribute(types.AttributeKeyJailReason, reason.String()),
+			sdk.NewAttribute(types.AttributeKeyReference, reference),
+		),
+	)
 }
 
-// remove a validator from jail
+// remove a validator from jail, along with the reason it was jailed for
 func (k Keeper) unjailValidator(ctx sdk.Context, validator types.Validator) {
 	if !validator.Jailed {
 		panic(fmt.Sprintf("cannot unjail already unjailed validator, validator: %v\n", validator))
@@ -287,6 +363,20 @@ func (k Keeper) unjailValidator(ctx sdk.Context, validator types.Validator) {
 	validator.Jailed = false
 	k.SetValidator(ctx, validator)
 	k.SetValidatorByPowerIndex(ctx, validator)
+
+	reason := types.JailReason_JAIL_REASON_UNSPECIFIED
+	if info, found := k.GetValidatorJailInfo(ctx, validator.GetOperator()); found {
+		reason = info.Reason
+	}
+	k.DeleteValidatorJailInfo(ctx, validator.GetOperator())
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeUnjailValidator,
+			sdk.NewAttribute(types.AttributeKeyValidator, validator.OperatorAddress),
+			sdk.NewAttribute(types.AttributeKeyJailReason, reason.String()),
+		),
+	)
 }
 
 // perform all the store operations for when a validator status becomes bonded
@@ -318,8 +408,6 @@ func (k Keeper) bondValidator(ctx sdk.Context, validator types.Validator) (types
 
 // BeginUnbondingValidator performs all the store operations for when a validator begins unbonding
 func (k Keeper) BeginUnbondingValidator(ctx sdk.Context, validator types.Validator) (types.Validator, error) {
-	params := k.GetParams(ctx)
-
 	// delete the validator by power index, as the key will change
 	k.DeleteValidatorByPowerIndex(ctx, validator)
 
@@ -333,7 +421,7 @@ func (k Keeper) BeginUnbondingValidator(ctx sdk.Context, validator types.Validat
 	validator = validator.UpdateStatus(types.Unbonding)
 
 	// set the unbonding completion time and completion height appropriately
-	validator.UnbondingTime = ctx.BlockHeader().Time.Add(params.UnbondingTime)
+	validator.UnbondingTime = ctx.BlockHeader().Time.Add(k.ValidatorUnbondingTime(ctx, validator.GetOperator()))
 	validator.UnbondingHeight = ctx.BlockHeader().Height
 
 	validator.UnbondingIds = append(validator.UnbondingIds, id)
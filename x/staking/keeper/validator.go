@@ -61,6 +61,22 @@ func (k Keeper) SetValidator(ctx sdk.Context, validator types.Validator) {
 	store := ctx.KVStore(k.storeKey)
 	bz := types.MustMarshalValidator(k.cdc, &validator)
 	store.Set(types.GetValidatorKey(validator.GetOperator()), bz)
+
+	k.setValidatorEscrow(ctx, validator)
+}
+
+// setValidatorEscrow tracks the tokens of a bonded validator as escrowed by the
+// bonded pool for the validator, clearing the escrow once it is not bonded.
+func (k Keeper) setValidatorEscrow(ctx sdk.Context, validator types.Validator) {
+	escrowed := sdk.NewCoins()
+	if validator.IsBonded() {
+		escrowed = sdk.NewCoins(sdk.NewCoin(k.BondDenom(ctx), validator.Tokens))
+	}
+
+	err := k.bankKeeper.SetModuleEscrow(ctx, types.BondedPoolName, types.ValidatorEscrowPurpose(validator.GetOperator()), escrowed)
+	if err != nil {
+		panic(err)
+	}
 }
 
 // validator index
@@ -183,6 +199,11 @@ func (k Keeper) RemoveValidator(ctx sdk.Context, address sdk.ValAddress) {
 	store.Delete(types.GetValidatorKey(address))
 	store.Delete(types.GetValidatorByConsAddrKey(valConsAddr))
 	store.Delete(types.GetValidatorsByPowerIndexKey(validator, k.PowerReduction(ctx)))
+	k.RemovePendingCommissionChange(ctx, address)
+	k.SetValidatorRiskTier(ctx, address, "")
+	k.DeleteValidatorJailInfo(ctx, address)
+	k.DeleteProfileAttestations(ctx, address)
+	k.deleteStandbyConsPubKey(ctx, address)
 
 	if err := k.Hooks().AfterValidatorRemoved(ctx, valConsAddr, validator.GetOperator()); err != nil {
 		k.Logger(ctx).Error("error in after validator removed hook", "error", err)
@@ -0,0 +1,11 @@
+package keeper
+
+import (
+	"github.com/cosmos/cosmos-sdk/x/staking/types"
+)
+
+var _ ViewKeeper = Keeper{}
+
+// ViewKeeper defines a module interface that facilitates read only access to
+// the staking state, see types.ViewKeeper.
+type ViewKeeper = types.ViewKeeper
@@ -36,20 +36,44 @@ func TestMigrateJSON(t *testing.T) {
 
 	// Make sure about new param MinCommissionRate.
 	expected := `{
+	"delegation_locks": [],
 	"delegations": [],
 	"exported": false,
+	"last_tokenize_share_record_id": "0",
 	"last_total_power": "0",
 	"last_validator_powers": [],
 	"params": {
 		"bond_denom": "stake",
+		"bond_denom_weights": [],
+		"commission_change_notice_period": "0s",
+		"epoch_length": "0",
+		"global_liquid_staking_cap": "1.000000000000000000",
 		"historical_entries": 10000,
+		"instant_undelegation_budget_per_block": "0",
+		"instant_undelegation_fee_rate": "0.000000000000000000",
+		"instant_undelegation_fee_to_community_pool": false,
+		"lockup_terms": [],
 		"max_entries": 7,
+		"max_mature_unbondings_per_block": 0,
 		"max_validators": 100,
 		"min_commission_rate": "0.000000000000000000",
-		"unbonding_time": "1814400s"
+		"min_delegation_amount": "0",
+		"min_self_delegation_floor": "0",
+		"unbonding_tiers": [],
+		"unbonding_time": "1814400s",
+		"validator_bond_factor": "-1.000000000000000000",
+		"validator_liquid_staking_cap": "1.000000000000000000"
 	},
+	"pending_commission_changes": [],
+	"profile_attestations": [],
+	"queued_epoch_msgs": [],
 	"redelegations": [],
+	"staking_paused": false,
+	"tokenize_share_records": [],
+	"total_liquid_staked_tokens": "0",
 	"unbonding_delegations": [],
+	"validator_jail_infos": [],
+	"validator_risk_tiers": [],
 	"validators": []
 }`
 
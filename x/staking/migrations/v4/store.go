@@ -30,7 +30,9 @@ func MigrateStore(ctx sdk.Context, storeKey storetypes.StoreKey, cdc codec.Binar
 
 // migrateParams will set the params to store from legacySubspace
 func migrateParams(ctx sdk.Context, store storetypes.KVStore, cdc codec.BinaryCodec, legacySubspace exported.Subspace) error {
-	var legacyParams types.Params
+	// the params added after the legacy subspace was deprecated keep their
+	// default values
+	legacyParams := types.DefaultParams()
 	legacySubspace.GetParamSet(ctx, &legacyParams)
 
 	if err := legacyParams.Validate(); err != nil {
@@ -0,0 +1,74 @@
+package v6_test
+
+import (
+	"testing"
+	"time"
+
+	"cosmossdk.io/math"
+	storetypes "cosmossdk.io/store/types"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/encoding/protowire"
+
+	"github.com/cosmos/cosmos-sdk/crypto/keys/ed25519"
+	"github.com/cosmos/cosmos-sdk/testutil"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	moduletestutil "github.com/cosmos/cosmos-sdk/types/module/testutil"
+	"github.com/cosmos/cosmos-sdk/x/staking"
+	v6 "github.com/cosmos/cosmos-sdk/x/staking/migrations/v6"
+	"github.com/cosmos/cosmos-sdk/x/staking/types"
+)
+
+func TestMigrate(t *testing.T) {
+	cdc := moduletestutil.MakeTestEncodingConfig(staking.AppModuleBasic{}).Codec
+	storeKey := storetypes.NewKVStoreKey(v6.ModuleName)
+	tKey := storetypes.NewTransientStoreKey("transient_test")
+	ctx := testutil.DefaultContext(storeKey, tKey)
+	store := ctx.KVStore(storeKey)
+
+	// params and validators stored before the v6 fields existed
+	oldParams := types.DefaultParams()
+	oldParams.CommissionChangeNoticePeriod = time.Hour
+	oldParams.MaxMatureUnbondingsPerBlock = 10
+	store.Set(v6.ParamsKey, v5Fields(t, cdc.MustMarshal(&oldParams)))
+
+	valAddr := sdk.ValAddress(ed25519.GenPrivKey().PubKey().Address())
+	validator, err := types.NewValidator(valAddr, ed25519.GenPrivKey().PubKey(), types.Description{})
+	require.NoError(t, err)
+	validator.ValidatorBondShares = math.LegacyDec{}
+	validator.LiquidShares = math.LegacyDec{}
+	store.Set(types.GetValidatorKey(valAddr), types.MustMarshalValidator(cdc, &validator))
+
+	require.NoError(t, v6.MigrateStore(ctx, storeKey, cdc))
+
+	var params types.Params
+	cdc.MustUnmarshal(store.Get(v6.ParamsKey), &params)
+	require.Equal(t, types.DefaultParams(), params)
+
+	validator = types.MustUnmarshalValidator(cdc, store.Get(types.GetValidatorKey(valAddr)))
+	require.Equal(t, math.LegacyZeroDec(), validator.ValidatorBondShares)
+	require.Equal(t, math.LegacyZeroDec(), validator.LiquidShares)
+
+	var totalLiquidStaked sdk.IntProto
+	cdc.MustUnmarshal(store.Get(v6.TotalLiquidStakedTokensKey), &totalLiquidStaked)
+	require.Equal(t, math.ZeroInt(), totalLiquidStaked.Int)
+}
+
+// v5Fields strips the fields added in v6 from the encoded params, returning
+// them as they are stored by v5.
+func v5Fields(t *testing.T, bz []byte) []byte {
+	t.Helper()
+
+	var out []byte
+	for len(bz) > 0 {
+		num, typ, n := protowire.ConsumeTag(bz)
+		require.GreaterOrEqual(t, n, 0)
+		m := protowire.ConsumeFieldValue(num, typ, bz[n:])
+		require.GreaterOrEqual(t, m, 0)
+		if num <= 6 {
+			out = append(out, bz[:n+m]...)
+		}
+		bz = bz[n+m:]
+	}
+
+	return out
+}
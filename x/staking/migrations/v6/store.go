@@ -0,0 +1,66 @@
+package v6
+
+import (
+	"cosmossdk.io/math"
+	storetypes "cosmossdk.io/store/types"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/staking/types"
+)
+
+// MigrateStore performs in-place store migrations from v5 to v6. It sets the
+// params added in v6 to their defaults, zeroes the validator bond and liquid
+// shares of the validators and the total liquid staked tokens.
+func MigrateStore(ctx sdk.Context, storeKey storetypes.StoreKey, cdc codec.BinaryCodec) error {
+	store := ctx.KVStore(storeKey)
+
+	if err := migrateParams(store, cdc); err != nil {
+		return err
+	}
+
+	migrateValidators(store, cdc)
+
+	bz := cdc.MustMarshal(&sdk.IntProto{Int: math.ZeroInt()})
+	store.Set(TotalLiquidStakedTokensKey, bz)
+
+	return nil
+}
+
+// migrateParams sets the params added in v6 to their default values
+func migrateParams(store storetypes.KVStore, cdc codec.BinaryCodec) error {
+	var params types.Params
+	cdc.MustUnmarshal(store.Get(ParamsKey), &params)
+
+	params.ValidatorBondFactor = types.DefaultValidatorBondFactor
+	params.GlobalLiquidStakingCap = types.DefaultGlobalLiquidStakingCap
+	params.ValidatorLiquidStakingCap = types.DefaultValidatorLiquidStakingCap
+	params.CommissionChangeNoticePeriod = types.DefaultCommissionChangeNoticePeriod
+	params.MaxMatureUnbondingsPerBlock = types.DefaultMaxMatureUnbondingsPerBlock
+	params.MinSelfDelegationFloor = types.DefaultMinSelfDelegationFloor
+	params.InstantUndelegationFeeRate = types.DefaultInstantUndelegationFeeRate
+	params.InstantUndelegationBudgetPerBlock = types.DefaultInstantUndelegationBudgetPerBlock
+
+	if err := params.Validate(); err != nil {
+		return err
+	}
+
+	store.Set(ParamsKey, cdc.MustMarshal(&params))
+	return nil
+}
+
+// migrateValidators sets the validator bond and liquid shares of all the
+// validators to zero
+func migrateValidators(store storetypes.KVStore, cdc codec.BinaryCodec) {
+	iterator := storetypes.KVStorePrefixIterator(store, ValidatorsKey)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		validator := types.MustUnmarshalValidator(cdc, iterator.Value())
+
+		validator.ValidatorBondShares = math.LegacyZeroDec()
+		validator.LiquidShares = math.LegacyZeroDec()
+
+		store.Set(iterator.Key(), types.MustMarshalValidator(cdc, &validator))
+	}
+}
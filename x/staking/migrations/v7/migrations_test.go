@@ -0,0 +1,63 @@
+package v7_test
+
+import (
+	"testing"
+
+	"cosmossdk.io/math"
+	storetypes "cosmossdk.io/store/types"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/encoding/protowire"
+
+	"github.com/cosmos/cosmos-sdk/testutil"
+	moduletestutil "github.com/cosmos/cosmos-sdk/types/module/testutil"
+	"github.com/cosmos/cosmos-sdk/x/staking"
+	v7 "github.com/cosmos/cosmos-sdk/x/staking/migrations/v7"
+	"github.com/cosmos/cosmos-sdk/x/staking/types"
+)
+
+func TestMigrate(t *testing.T) {
+	cdc := moduletestutil.MakeTestEncodingConfig(staking.AppModuleBasic{}).Codec
+	storeKey := storetypes.NewKVStoreKey(v7.ModuleName)
+	tKey := storetypes.NewTransientStoreKey("transient_test")
+	ctx := testutil.DefaultContext(storeKey, tKey)
+	store := ctx.KVStore(storeKey)
+
+	// params stored before the min delegation amount existed
+	oldParams := types.DefaultParams()
+	store.Set(v7.ParamsKey, v6Fields(t, cdc.MustMarshal(&oldParams)))
+
+	require.NoError(t, v7.MigrateStore(ctx, storeKey, cdc))
+
+	var params types.Params
+	cdc.MustUnmarshal(store.Get(v7.ParamsKey), &params)
+	require.Equal(t, types.DefaultParams(), params)
+
+	// a min delegation amount set before the migration is kept
+	params.MinDelegationAmount = math.NewInt(100)
+	store.Set(v7.ParamsKey, cdc.MustMarshal(&params))
+
+	require.NoError(t, v7.MigrateStore(ctx, storeKey, cdc))
+
+	cdc.MustUnmarshal(store.Get(v7.ParamsKey), &params)
+	require.Equal(t, math.NewInt(100), params.MinDelegationAmount)
+}
+
+// v6Fields strips the min delegation amount from the encoded params, returning
+// them as they are stored by v6.
+func v6Fields(t *testing.T, bz []byte) []byte {
+	t.Helper()
+
+	var out []byte
+	for len(bz) > 0 {
+		num, typ, n := protowire.ConsumeTag(bz)
+		require.GreaterOrEqual(t, n, 0)
+		m := protowire.ConsumeFieldValue(num, typ, bz[n:])
+		require.GreaterOrEqual(t, m, 0)
+		if num != 20 {
+			out = append(out, bz[:n+m]...)
+		}
+		bz = bz[n+m:]
+	}
+
+	return out
+}
@@ -0,0 +1,30 @@
+package v7
+
+import (
+	storetypes "cosmossdk.io/store/types"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/staking/types"
+)
+
+// MigrateStore performs in-place store migrations from v6 to v7. It sets the
+// min delegation amount param to its default, unless it was already set, e.g.
+// by the upgrade handler in order to sweep the dust delegations.
+func MigrateStore(ctx sdk.Context, storeKey storetypes.StoreKey, cdc codec.BinaryCodec) error {
+	store := ctx.KVStore(storeKey)
+
+	var params types.Params
+	cdc.MustUnmarshal(store.Get(ParamsKey), &params)
+
+	if params.MinDelegationAmount.IsNil() {
+		params.MinDelegationAmount = types.DefaultMinDelegationAmount
+	}
+
+	if err := params.Validate(); err != nil {
+		return err
+	}
+
+	store.Set(ParamsKey, cdc.MustMarshal(&params))
+	return nil
+}
@@ -11,6 +11,9 @@ import (
 	simtestutil "github.com/cosmos/cosmos-sdk/testutil/sims"
 	authKeeper "github.com/cosmos/cosmos-sdk/x/auth/keeper"
 	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	bankkeeper "github.com/cosmos/cosmos-sdk/x/bank/keeper"
+	distrkeeper "github.com/cosmos/cosmos-sdk/x/distribution/keeper"
+	stakingkeeper "github.com/cosmos/cosmos-sdk/x/staking/keeper"
 	"github.com/cosmos/cosmos-sdk/x/staking/testutil"
 	"github.com/cosmos/cosmos-sdk/x/staking/types"
 )
@@ -31,3 +34,27 @@ func TestItCreatesModuleAccountOnInitBlock(t *testing.T) {
 	acc = accountKeeper.GetAccount(ctx, authtypes.NewModuleAddress(types.NotBondedPoolName))
 	require.NotNil(t, acc)
 }
+
+func TestItProvidesViewKeepers(t *testing.T) {
+	var (
+		accountViewKeeper authKeeper.ViewKeeper
+		bankViewKeeper    bankkeeper.ViewKeeper
+		stakingKeeper     *stakingkeeper.Keeper
+		stakingViewKeeper stakingkeeper.ViewKeeper
+		distrViewKeeper   distrkeeper.ViewKeeper
+	)
+	app, err := simtestutil.SetupAtGenesis(
+		depinject.Configs(
+			testutil.AppConfig,
+			depinject.Supply(log.NewNopLogger()),
+		), &accountViewKeeper, &bankViewKeeper, &stakingKeeper, &stakingViewKeeper, &distrViewKeeper)
+	require.NoError(t, err)
+	require.NotNil(t, distrViewKeeper)
+
+	ctx := app.BaseApp.NewContext(false, cmtproto.Header{})
+	require.Equal(t, stakingKeeper.GetParams(ctx), stakingViewKeeper.GetParams(ctx))
+
+	bondedPool := authtypes.NewModuleAddress(types.BondedPoolName)
+	require.True(t, accountViewKeeper.HasAccount(ctx, bondedPool))
+	require.True(t, bankViewKeeper.GetBalance(ctx, bondedPool, stakingViewKeeper.BondDenom(ctx)).IsPositive())
+}
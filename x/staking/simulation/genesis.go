@@ -64,7 +64,13 @@ func RandomizedGenState(simState *module.SimulationState) {
 	// NOTE: the slashing module need to be defined after the staking module on the
 	// NewSimulationManager constructor for this to work
 	simState.UnbondTime = unbondTime
-	params := types.NewParams(simState.UnbondTime, maxVals, 7, histEntries, simState.BondDenom, minCommissionRate)
+	params := types.NewParams(
+		simState.UnbondTime, maxVals, 7, histEntries, simState.BondDenom, minCommissionRate,
+		types.DefaultValidatorBondFactor, types.DefaultGlobalLiquidStakingCap, types.DefaultValidatorLiquidStakingCap,
+		types.DefaultCommissionChangeNoticePeriod, types.DefaultMaxMatureUnbondingsPerBlock,
+		types.DefaultMinSelfDelegationFloor, types.DefaultEpochLength,
+		types.DefaultInstantUndelegationFeeRate, types.DefaultInstantUndelegationBudgetPerBlock,
+	)
 
 	// validators & delegations
 	var (
@@ -7,6 +7,7 @@ package testutil
 import (
 	context "context"
 	reflect "reflect"
+	time "time"
 
 	math "cosmossdk.io/math"
 	types "github.com/cosmos/cosmos-sdk/types"
@@ -65,6 +66,80 @@ func (mr *MockDistributionKeeperMockRecorder) GetValidatorOutstandingRewardsCoin
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetValidatorOutstandingRewardsCoins", reflect.TypeOf((*MockDistributionKeeper)(nil).GetValidatorOutstandingRewardsCoins), ctx, val)
 }
 
+// MockCommunityPoolKeeper is a mock of CommunityPoolKeeper interface.
+type MockCommunityPoolKeeper struct {
+	ctrl     *gomock.Controller
+	recorder *MockCommunityPoolKeeperMockRecorder
+}
+
+// MockCommunityPoolKeeperMockRecorder is the mock recorder for MockCommunityPoolKeeper.
+type MockCommunityPoolKeeperMockRecorder struct {
+	mock *MockCommunityPoolKeeper
+}
+
+// NewMockCommunityPoolKeeper creates a new mock instance.
+func NewMockCommunityPoolKeeper(ctrl *gomock.Controller) *MockCommunityPoolKeeper {
+	mock := &MockCommunityPoolKeeper{ctrl: ctrl}
+	mock.recorder = &MockCommunityPoolKeeperMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockCommunityPoolKeeper) EXPECT() *MockCommunityPoolKeeperMockRecorder {
+	return m.recorder
+}
+
+// FundCommunityPool mocks base method.
+func (m *MockCommunityPoolKeeper) FundCommunityPool(ctx context.Context, amount types.Coins, sender types.AccAddress) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FundCommunityPool", ctx, amount, sender)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// FundCommunityPool indicates an expected call of FundCommunityPool.
+func (mr *MockCommunityPoolKeeperMockRecorder) FundCommunityPool(ctx, amount, sender interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FundCommunityPool", reflect.TypeOf((*MockCommunityPoolKeeper)(nil).FundCommunityPool), ctx, amount, sender)
+}
+
+// MockProfileVerifier is a mock of ProfileVerifier interface.
+type MockProfileVerifier struct {
+	ctrl     *gomock.Controller
+	recorder *MockProfileVerifierMockRecorder
+}
+
+// MockProfileVerifierMockRecorder is the mock recorder for MockProfileVerifier.
+type MockProfileVerifierMockRecorder struct {
+	mock *MockProfileVerifier
+}
+
+// NewMockProfileVerifier creates a new mock instance.
+func NewMockProfileVerifier(ctrl *gomock.Controller) *MockProfileVerifier {
+	mock := &MockProfileVerifier{ctrl: ctrl}
+	mock.recorder = &MockProfileVerifierMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockProfileVerifier) EXPECT() *MockProfileVerifierMockRecorder {
+	return m.recorder
+}
+
+// VerifyProfileAttestation mocks base method.
+func (m *MockProfileVerifier) VerifyProfileAttestation(ctx context.Context, validator types0.Validator, attestation types0.ProfileAttestation) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "VerifyProfileAttestation", ctx, validator, attestation)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// VerifyProfileAttestation indicates an expected call of VerifyProfileAttestation.
+func (mr *MockProfileVerifierMockRecorder) VerifyProfileAttestation(ctx, validator, attestation interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "VerifyProfileAttestation", reflect.TypeOf((*MockProfileVerifier)(nil).VerifyProfileAttestation), ctx, validator, attestation)
+}
+
 // MockAccountKeeper is a mock of AccountKeeper interface.
 type MockAccountKeeper struct {
 	ctrl     *gomock.Controller
@@ -145,6 +220,20 @@ func (mr *MockAccountKeeperMockRecorder) GetModuleAddress(name interface{}) *gom
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetModuleAddress", reflect.TypeOf((*MockAccountKeeper)(nil).GetModuleAddress), name)
 }
 
+// HasAccount mocks base method.
+func (m *MockAccountKeeper) HasAccount(ctx context.Context, addr types.AccAddress) bool {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "HasAccount", ctx, addr)
+	ret0, _ := ret[0].(bool)
+	return ret0
+}
+
+// HasAccount indicates an expected call of HasAccount.
+func (mr *MockAccountKeeperMockRecorder) HasAccount(ctx, addr interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "HasAccount", reflect.TypeOf((*MockAccountKeeper)(nil).HasAccount), ctx, addr)
+}
+
 // IterateAccounts mocks base method.
 func (m *MockAccountKeeper) IterateAccounts(ctx context.Context, process func(types.AccountI) bool) {
 	m.ctrl.T.Helper()
@@ -157,6 +246,32 @@ func (mr *MockAccountKeeperMockRecorder) IterateAccounts(ctx, process interface{
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IterateAccounts", reflect.TypeOf((*MockAccountKeeper)(nil).IterateAccounts), ctx, process)
 }
 
+// NewAccount mocks base method.
+func (m *MockAccountKeeper) NewAccount(arg0 context.Context, arg1 types.AccountI) types.AccountI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "NewAccount", arg0, arg1)
+	ret0, _ := ret[0].(types.AccountI)
+	return ret0
+}
+
+// NewAccount indicates an expected call of NewAccount.
+func (mr *MockAccountKeeperMockRecorder) NewAccount(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NewAccount", reflect.TypeOf((*MockAccountKeeper)(nil).NewAccount), arg0, arg1)
+}
+
+// SetAccount mocks base method.
+func (m *MockAccountKeeper) SetAccount(arg0 context.Context, arg1 types.AccountI) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "SetAccount", arg0, arg1)
+}
+
+// SetAccount indicates an expected call of SetAccount.
+func (mr *MockAccountKeeperMockRecorder) SetAccount(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetAccount", reflect.TypeOf((*MockAccountKeeper)(nil).SetAccount), arg0, arg1)
+}
+
 // SetModuleAccount mocks base method.
 func (m *MockAccountKeeper) SetModuleAccount(arg0 context.Context, arg1 types.ModuleAccountI) {
 	m.ctrl.T.Helper()
@@ -291,6 +406,62 @@ func (mr *MockBankKeeperMockRecorder) LockedCoins(ctx, addr interface{}) *gomock
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "LockedCoins", reflect.TypeOf((*MockBankKeeper)(nil).LockedCoins), ctx, addr)
 }
 
+// MintCoins mocks base method.
+func (m *MockBankKeeper) MintCoins(ctx context.Context, moduleName string, amt types.Coins) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "MintCoins", ctx, moduleName, amt)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// MintCoins indicates an expected call of MintCoins.
+func (mr *MockBankKeeperMockRecorder) MintCoins(ctx, moduleName, amt interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MintCoins", reflect.TypeOf((*MockBankKeeper)(nil).MintCoins), ctx, moduleName, amt)
+}
+
+// SendCoins mocks base method.
+func (m *MockBankKeeper) SendCoins(ctx context.Context, fromAddr, toAddr types.AccAddress, amt types.Coins) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SendCoins", ctx, fromAddr, toAddr, amt)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SendCoins indicates an expected call of SendCoins.
+func (mr *MockBankKeeperMockRecorder) SendCoins(ctx, fromAddr, toAddr, amt interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SendCoins", reflect.TypeOf((*MockBankKeeper)(nil).SendCoins), ctx, fromAddr, toAddr, amt)
+}
+
+// SendCoinsFromAccountToModule mocks base method.
+func (m *MockBankKeeper) SendCoinsFromAccountToModule(ctx context.Context, senderAddr types.AccAddress, recipientModule string, amt types.Coins) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SendCoinsFromAccountToModule", ctx, senderAddr, recipientModule, amt)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SendCoinsFromAccountToModule indicates an expected call of SendCoinsFromAccountToModule.
+func (mr *MockBankKeeperMockRecorder) SendCoinsFromAccountToModule(ctx, senderAddr, recipientModule, amt interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SendCoinsFromAccountToModule", reflect.TypeOf((*MockBankKeeper)(nil).SendCoinsFromAccountToModule), ctx, senderAddr, recipientModule, amt)
+}
+
+// SendCoinsFromModuleToAccount mocks base method.
+func (m *MockBankKeeper) SendCoinsFromModuleToAccount(ctx context.Context, senderModule string, recipientAddr types.AccAddress, amt types.Coins) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SendCoinsFromModuleToAccount", ctx, senderModule, recipientAddr, amt)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SendCoinsFromModuleToAccount indicates an expected call of SendCoinsFromModuleToAccount.
+func (mr *MockBankKeeperMockRecorder) SendCoinsFromModuleToAccount(ctx, senderModule, recipientAddr, amt interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SendCoinsFromModuleToAccount", reflect.TypeOf((*MockBankKeeper)(nil).SendCoinsFromModuleToAccount), ctx, senderModule, recipientAddr, amt)
+}
+
 // SendCoinsFromModuleToModule mocks base method.
 func (m *MockBankKeeper) SendCoinsFromModuleToModule(ctx context.Context, senderPool, recipientPool string, amt types.Coins) error {
 	m.ctrl.T.Helper()
@@ -305,6 +476,20 @@ func (mr *MockBankKeeperMockRecorder) SendCoinsFromModuleToModule(ctx, senderPoo
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SendCoinsFromModuleToModule", reflect.TypeOf((*MockBankKeeper)(nil).SendCoinsFromModuleToModule), ctx, senderPool, recipientPool, amt)
 }
 
+// SetModuleEscrow mocks base method.
+func (m *MockBankKeeper) SetModuleEscrow(ctx context.Context, moduleName, purpose string, amt types.Coins) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetModuleEscrow", ctx, moduleName, purpose, amt)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SetModuleEscrow indicates an expected call of SetModuleEscrow.
+func (mr *MockBankKeeperMockRecorder) SetModuleEscrow(ctx, moduleName, purpose, amt interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetModuleEscrow", reflect.TypeOf((*MockBankKeeper)(nil).SetModuleEscrow), ctx, moduleName, purpose, amt)
+}
+
 // SpendableCoins mocks base method.
 func (m *MockBankKeeper) SpendableCoins(ctx context.Context, addr types.AccAddress) types.Coins {
 	m.ctrl.T.Helper()
@@ -418,6 +603,18 @@ func (mr *MockValidatorSetMockRecorder) Jail(arg0, arg1 interface{}) *gomock.Cal
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Jail", reflect.TypeOf((*MockValidatorSet)(nil).Jail), arg0, arg1)
 }
 
+// JailWithReason mocks base method.
+func (m *MockValidatorSet) JailWithReason(arg0 types.Context, arg1 types.ConsAddress, arg2 types0.JailReason, arg3 string) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "JailWithReason", arg0, arg1, arg2, arg3)
+}
+
+// JailWithReason indicates an expected call of JailWithReason.
+func (mr *MockValidatorSetMockRecorder) JailWithReason(arg0, arg1, arg2, arg3 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "JailWithReason", reflect.TypeOf((*MockValidatorSet)(nil).JailWithReason), arg0, arg1, arg2, arg3)
+}
+
 // MaxValidators mocks base method.
 func (m *MockValidatorSet) MaxValidators(arg0 types.Context) uint32 {
 	m.ctrl.T.Helper()
@@ -577,6 +774,473 @@ func (mr *MockDelegationSetMockRecorder) IterateDelegations(ctx, delegator, fn i
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IterateDelegations", reflect.TypeOf((*MockDelegationSet)(nil).IterateDelegations), ctx, delegator, fn)
 }
 
+// MockViewKeeper is a mock of ViewKeeper interface.
+type MockViewKeeper struct {
+	ctrl     *gomock.Controller
+	recorder *MockViewKeeperMockRecorder
+}
+
+// MockViewKeeperMockRecorder is the mock recorder for MockViewKeeper.
+type MockViewKeeperMockRecorder struct {
+	mock *MockViewKeeper
+}
+
+// NewMockViewKeeper creates a new mock instance.
+func NewMockViewKeeper(ctrl *gomock.Controller) *MockViewKeeper {
+	mock := &MockViewKeeper{ctrl: ctrl}
+	mock.recorder = &MockViewKeeperMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockViewKeeper) EXPECT() *MockViewKeeperMockRecorder {
+	return m.recorder
+}
+
+// BondDenom mocks base method.
+func (m *MockViewKeeper) BondDenom(ctx types.Context) string {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "BondDenom", ctx)
+	ret0, _ := ret[0].(string)
+	return ret0
+}
+
+// BondDenom indicates an expected call of BondDenom.
+func (mr *MockViewKeeperMockRecorder) BondDenom(ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "BondDenom", reflect.TypeOf((*MockViewKeeper)(nil).BondDenom), ctx)
+}
+
+// BondedRatio mocks base method.
+func (m *MockViewKeeper) BondedRatio(ctx types.Context) math.LegacyDec {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "BondedRatio", ctx)
+	ret0, _ := ret[0].(math.LegacyDec)
+	return ret0
+}
+
+// BondedRatio indicates an expected call of BondedRatio.
+func (mr *MockViewKeeperMockRecorder) BondedRatio(ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "BondedRatio", reflect.TypeOf((*MockViewKeeper)(nil).BondedRatio), ctx)
+}
+
+// Delegation mocks base method.
+func (m *MockViewKeeper) Delegation(ctx types.Context, delAddr types.AccAddress, valAddr types.ValAddress) types0.DelegationI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Delegation", ctx, delAddr, valAddr)
+	ret0, _ := ret[0].(types0.DelegationI)
+	return ret0
+}
+
+// Delegation indicates an expected call of Delegation.
+func (mr *MockViewKeeperMockRecorder) Delegation(ctx, delAddr, valAddr interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delegation", reflect.TypeOf((*MockViewKeeper)(nil).Delegation), ctx, delAddr, valAddr)
+}
+
+// GetAllValidators mocks base method.
+func (m *MockViewKeeper) GetAllValidators(ctx types.Context) []types0.Validator {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAllValidators", ctx)
+	ret0, _ := ret[0].([]types0.Validator)
+	return ret0
+}
+
+// GetAllValidators indicates an expected call of GetAllValidators.
+func (mr *MockViewKeeperMockRecorder) GetAllValidators(ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAllValidators", reflect.TypeOf((*MockViewKeeper)(nil).GetAllValidators), ctx)
+}
+
+// GetBondedValidatorsByPower mocks base method.
+func (m *MockViewKeeper) GetBondedValidatorsByPower(ctx types.Context) []types0.Validator {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetBondedValidatorsByPower", ctx)
+	ret0, _ := ret[0].([]types0.Validator)
+	return ret0
+}
+
+// GetBondedValidatorsByPower indicates an expected call of GetBondedValidatorsByPower.
+func (mr *MockViewKeeperMockRecorder) GetBondedValidatorsByPower(ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetBondedValidatorsByPower", reflect.TypeOf((*MockViewKeeper)(nil).GetBondedValidatorsByPower), ctx)
+}
+
+// GetDelegation mocks base method.
+func (m *MockViewKeeper) GetDelegation(ctx types.Context, delAddr types.AccAddress, valAddr types.ValAddress) (types0.Delegation, bool) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetDelegation", ctx, delAddr, valAddr)
+	ret0, _ := ret[0].(types0.Delegation)
+	ret1, _ := ret[1].(bool)
+	return ret0, ret1
+}
+
+// GetDelegation indicates an expected call of GetDelegation.
+func (mr *MockViewKeeperMockRecorder) GetDelegation(ctx, delAddr, valAddr interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetDelegation", reflect.TypeOf((*MockViewKeeper)(nil).GetDelegation), ctx, delAddr, valAddr)
+}
+
+// GetDelegatorBonded mocks base method.
+func (m *MockViewKeeper) GetDelegatorBonded(ctx types.Context, delegator types.AccAddress) math.Int {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetDelegatorBonded", ctx, delegator)
+	ret0, _ := ret[0].(math.Int)
+	return ret0
+}
+
+// GetDelegatorBonded indicates an expected call of GetDelegatorBonded.
+func (mr *MockViewKeeperMockRecorder) GetDelegatorBonded(ctx, delegator interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetDelegatorBonded", reflect.TypeOf((*MockViewKeeper)(nil).GetDelegatorBonded), ctx, delegator)
+}
+
+// GetDelegatorDelegations mocks base method.
+func (m *MockViewKeeper) GetDelegatorDelegations(ctx types.Context, delegator types.AccAddress, maxRetrieve uint16) []types0.Delegation {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetDelegatorDelegations", ctx, delegator, maxRetrieve)
+	ret0, _ := ret[0].([]types0.Delegation)
+	return ret0
+}
+
+// GetDelegatorDelegations indicates an expected call of GetDelegatorDelegations.
+func (mr *MockViewKeeperMockRecorder) GetDelegatorDelegations(ctx, delegator, maxRetrieve interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetDelegatorDelegations", reflect.TypeOf((*MockViewKeeper)(nil).GetDelegatorDelegations), ctx, delegator, maxRetrieve)
+}
+
+// GetDelegatorUnbonding mocks base method.
+func (m *MockViewKeeper) GetDelegatorUnbonding(ctx types.Context, delegator types.AccAddress) math.Int {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetDelegatorUnbonding", ctx, delegator)
+	ret0, _ := ret[0].(math.Int)
+	return ret0
+}
+
+// GetDelegatorUnbonding indicates an expected call of GetDelegatorUnbonding.
+func (mr *MockViewKeeperMockRecorder) GetDelegatorUnbonding(ctx, delegator interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetDelegatorUnbonding", reflect.TypeOf((*MockViewKeeper)(nil).GetDelegatorUnbonding), ctx, delegator)
+}
+
+// GetHistoricalInfo mocks base method.
+func (m *MockViewKeeper) GetHistoricalInfo(ctx types.Context, height int64) (types0.HistoricalInfo, bool) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetHistoricalInfo", ctx, height)
+	ret0, _ := ret[0].(types0.HistoricalInfo)
+	ret1, _ := ret[1].(bool)
+	return ret0, ret1
+}
+
+// GetHistoricalInfo indicates an expected call of GetHistoricalInfo.
+func (mr *MockViewKeeperMockRecorder) GetHistoricalInfo(ctx, height interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetHistoricalInfo", reflect.TypeOf((*MockViewKeeper)(nil).GetHistoricalInfo), ctx, height)
+}
+
+// GetLastTotalPower mocks base method.
+func (m *MockViewKeeper) GetLastTotalPower(ctx types.Context) math.Int {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetLastTotalPower", ctx)
+	ret0, _ := ret[0].(math.Int)
+	return ret0
+}
+
+// GetLastTotalPower indicates an expected call of GetLastTotalPower.
+func (mr *MockViewKeeperMockRecorder) GetLastTotalPower(ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetLastTotalPower", reflect.TypeOf((*MockViewKeeper)(nil).GetLastTotalPower), ctx)
+}
+
+// GetLastValidatorPower mocks base method.
+func (m *MockViewKeeper) GetLastValidatorPower(ctx types.Context, operator types.ValAddress) int64 {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetLastValidatorPower", ctx, operator)
+	ret0, _ := ret[0].(int64)
+	return ret0
+}
+
+// GetLastValidatorPower indicates an expected call of GetLastValidatorPower.
+func (mr *MockViewKeeperMockRecorder) GetLastValidatorPower(ctx, operator interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetLastValidatorPower", reflect.TypeOf((*MockViewKeeper)(nil).GetLastValidatorPower), ctx, operator)
+}
+
+// GetLastValidators mocks base method.
+func (m *MockViewKeeper) GetLastValidators(ctx types.Context) []types0.Validator {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetLastValidators", ctx)
+	ret0, _ := ret[0].([]types0.Validator)
+	return ret0
+}
+
+// GetLastValidators indicates an expected call of GetLastValidators.
+func (mr *MockViewKeeperMockRecorder) GetLastValidators(ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetLastValidators", reflect.TypeOf((*MockViewKeeper)(nil).GetLastValidators), ctx)
+}
+
+// GetParams mocks base method.
+func (m *MockViewKeeper) GetParams(ctx types.Context) types0.Params {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetParams", ctx)
+	ret0, _ := ret[0].(types0.Params)
+	return ret0
+}
+
+// GetParams indicates an expected call of GetParams.
+func (mr *MockViewKeeperMockRecorder) GetParams(ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetParams", reflect.TypeOf((*MockViewKeeper)(nil).GetParams), ctx)
+}
+
+// GetRedelegation mocks base method.
+func (m *MockViewKeeper) GetRedelegation(ctx types.Context, delAddr types.AccAddress, valSrcAddr, valDstAddr types.ValAddress) (types0.Redelegation, bool) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetRedelegation", ctx, delAddr, valSrcAddr, valDstAddr)
+	ret0, _ := ret[0].(types0.Redelegation)
+	ret1, _ := ret[1].(bool)
+	return ret0, ret1
+}
+
+// GetRedelegation indicates an expected call of GetRedelegation.
+func (mr *MockViewKeeperMockRecorder) GetRedelegation(ctx, delAddr, valSrcAddr, valDstAddr interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetRedelegation", reflect.TypeOf((*MockViewKeeper)(nil).GetRedelegation), ctx, delAddr, valSrcAddr, valDstAddr)
+}
+
+// GetRedelegations mocks base method.
+func (m *MockViewKeeper) GetRedelegations(ctx types.Context, delegator types.AccAddress, maxRetrieve uint16) []types0.Redelegation {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetRedelegations", ctx, delegator, maxRetrieve)
+	ret0, _ := ret[0].([]types0.Redelegation)
+	return ret0
+}
+
+// GetRedelegations indicates an expected call of GetRedelegations.
+func (mr *MockViewKeeperMockRecorder) GetRedelegations(ctx, delegator, maxRetrieve interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetRedelegations", reflect.TypeOf((*MockViewKeeper)(nil).GetRedelegations), ctx, delegator, maxRetrieve)
+}
+
+// GetUnbondingDelegation mocks base method.
+func (m *MockViewKeeper) GetUnbondingDelegation(ctx types.Context, delAddr types.AccAddress, valAddr types.ValAddress) (types0.UnbondingDelegation, bool) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetUnbondingDelegation", ctx, delAddr, valAddr)
+	ret0, _ := ret[0].(types0.UnbondingDelegation)
+	ret1, _ := ret[1].(bool)
+	return ret0, ret1
+}
+
+// GetUnbondingDelegation indicates an expected call of GetUnbondingDelegation.
+func (mr *MockViewKeeperMockRecorder) GetUnbondingDelegation(ctx, delAddr, valAddr interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUnbondingDelegation", reflect.TypeOf((*MockViewKeeper)(nil).GetUnbondingDelegation), ctx, delAddr, valAddr)
+}
+
+// GetUnbondingDelegations mocks base method.
+func (m *MockViewKeeper) GetUnbondingDelegations(ctx types.Context, delegator types.AccAddress, maxRetrieve uint16) []types0.UnbondingDelegation {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetUnbondingDelegations", ctx, delegator, maxRetrieve)
+	ret0, _ := ret[0].([]types0.UnbondingDelegation)
+	return ret0
+}
+
+// GetUnbondingDelegations indicates an expected call of GetUnbondingDelegations.
+func (mr *MockViewKeeperMockRecorder) GetUnbondingDelegations(ctx, delegator, maxRetrieve interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUnbondingDelegations", reflect.TypeOf((*MockViewKeeper)(nil).GetUnbondingDelegations), ctx, delegator, maxRetrieve)
+}
+
+// GetValidator mocks base method.
+func (m *MockViewKeeper) GetValidator(ctx types.Context, addr types.ValAddress) (types0.Validator, bool) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetValidator", ctx, addr)
+	ret0, _ := ret[0].(types0.Validator)
+	ret1, _ := ret[1].(bool)
+	return ret0, ret1
+}
+
+// GetValidator indicates an expected call of GetValidator.
+func (mr *MockViewKeeperMockRecorder) GetValidator(ctx, addr interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetValidator", reflect.TypeOf((*MockViewKeeper)(nil).GetValidator), ctx, addr)
+}
+
+// GetValidatorByConsAddr mocks base method.
+func (m *MockViewKeeper) GetValidatorByConsAddr(ctx types.Context, consAddr types.ConsAddress) (types0.Validator, bool) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetValidatorByConsAddr", ctx, consAddr)
+	ret0, _ := ret[0].(types0.Validator)
+	ret1, _ := ret[1].(bool)
+	return ret0, ret1
+}
+
+// GetValidatorByConsAddr indicates an expected call of GetValidatorByConsAddr.
+func (mr *MockViewKeeperMockRecorder) GetValidatorByConsAddr(ctx, consAddr interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetValidatorByConsAddr", reflect.TypeOf((*MockViewKeeper)(nil).GetValidatorByConsAddr), ctx, consAddr)
+}
+
+// GetValidatorDelegations mocks base method.
+func (m *MockViewKeeper) GetValidatorDelegations(ctx types.Context, valAddr types.ValAddress) []types0.Delegation {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetValidatorDelegations", ctx, valAddr)
+	ret0, _ := ret[0].([]types0.Delegation)
+	return ret0
+}
+
+// GetValidatorDelegations indicates an expected call of GetValidatorDelegations.
+func (mr *MockViewKeeperMockRecorder) GetValidatorDelegations(ctx, valAddr interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetValidatorDelegations", reflect.TypeOf((*MockViewKeeper)(nil).GetValidatorDelegations), ctx, valAddr)
+}
+
+// IterateBondedValidatorsByPower mocks base method.
+func (m *MockViewKeeper) IterateBondedValidatorsByPower(ctx types.Context, fn func(int64, types0.ValidatorI) bool) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "IterateBondedValidatorsByPower", ctx, fn)
+}
+
+// IterateBondedValidatorsByPower indicates an expected call of IterateBondedValidatorsByPower.
+func (mr *MockViewKeeperMockRecorder) IterateBondedValidatorsByPower(ctx, fn interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IterateBondedValidatorsByPower", reflect.TypeOf((*MockViewKeeper)(nil).IterateBondedValidatorsByPower), ctx, fn)
+}
+
+// IterateDelegations mocks base method.
+func (m *MockViewKeeper) IterateDelegations(ctx types.Context, delAddr types.AccAddress, fn func(int64, types0.DelegationI) bool) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "IterateDelegations", ctx, delAddr, fn)
+}
+
+// IterateDelegations indicates an expected call of IterateDelegations.
+func (mr *MockViewKeeperMockRecorder) IterateDelegations(ctx, delAddr, fn interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IterateDelegations", reflect.TypeOf((*MockViewKeeper)(nil).IterateDelegations), ctx, delAddr, fn)
+}
+
+// IterateDelegatorDelegations mocks base method.
+func (m *MockViewKeeper) IterateDelegatorDelegations(ctx types.Context, delegator types.AccAddress, cb func(types0.Delegation) bool) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "IterateDelegatorDelegations", ctx, delegator, cb)
+}
+
+// IterateDelegatorDelegations indicates an expected call of IterateDelegatorDelegations.
+func (mr *MockViewKeeperMockRecorder) IterateDelegatorDelegations(ctx, delegator, cb interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IterateDelegatorDelegations", reflect.TypeOf((*MockViewKeeper)(nil).IterateDelegatorDelegations), ctx, delegator, cb)
+}
+
+// IterateLastValidators mocks base method.
+func (m *MockViewKeeper) IterateLastValidators(ctx types.Context, fn func(int64, types0.ValidatorI) bool) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "IterateLastValidators", ctx, fn)
+}
+
+// IterateLastValidators indicates an expected call of IterateLastValidators.
+func (mr *MockViewKeeperMockRecorder) IterateLastValidators(ctx, fn interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IterateLastValidators", reflect.TypeOf((*MockViewKeeper)(nil).IterateLastValidators), ctx, fn)
+}
+
+// IterateValidators mocks base method.
+func (m *MockViewKeeper) IterateValidators(ctx types.Context, fn func(int64, types0.ValidatorI) bool) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "IterateValidators", ctx, fn)
+}
+
+// IterateValidators indicates an expected call of IterateValidators.
+func (mr *MockViewKeeperMockRecorder) IterateValidators(ctx, fn interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IterateValidators", reflect.TypeOf((*MockViewKeeper)(nil).IterateValidators), ctx, fn)
+}
+
+// PowerReduction mocks base method.
+func (m *MockViewKeeper) PowerReduction(ctx types.Context) math.Int {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PowerReduction", ctx)
+	ret0, _ := ret[0].(math.Int)
+	return ret0
+}
+
+// PowerReduction indicates an expected call of PowerReduction.
+func (mr *MockViewKeeperMockRecorder) PowerReduction(ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PowerReduction", reflect.TypeOf((*MockViewKeeper)(nil).PowerReduction), ctx)
+}
+
+// TotalBondedTokens mocks base method.
+func (m *MockViewKeeper) TotalBondedTokens(ctx types.Context) math.Int {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "TotalBondedTokens", ctx)
+	ret0, _ := ret[0].(math.Int)
+	return ret0
+}
+
+// TotalBondedTokens indicates an expected call of TotalBondedTokens.
+func (mr *MockViewKeeperMockRecorder) TotalBondedTokens(ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "TotalBondedTokens", reflect.TypeOf((*MockViewKeeper)(nil).TotalBondedTokens), ctx)
+}
+
+// UnbondingTime mocks base method.
+func (m *MockViewKeeper) UnbondingTime(ctx types.Context) time.Duration {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UnbondingTime", ctx)
+	ret0, _ := ret[0].(time.Duration)
+	return ret0
+}
+
+// UnbondingTime indicates an expected call of UnbondingTime.
+func (mr *MockViewKeeperMockRecorder) UnbondingTime(ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UnbondingTime", reflect.TypeOf((*MockViewKeeper)(nil).UnbondingTime), ctx)
+}
+
+// Validator mocks base method.
+func (m *MockViewKeeper) Validator(ctx types.Context, addr types.ValAddress) types0.ValidatorI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Validator", ctx, addr)
+	ret0, _ := ret[0].(types0.ValidatorI)
+	return ret0
+}
+
+// Validator indicates an expected call of Validator.
+func (mr *MockViewKeeperMockRecorder) Validator(ctx, addr interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Validator", reflect.TypeOf((*MockViewKeeper)(nil).Validator), ctx, addr)
+}
+
+// ValidatorByConsAddr mocks base method.
+func (m *MockViewKeeper) ValidatorByConsAddr(ctx types.Context, consAddr types.ConsAddress) types0.ValidatorI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ValidatorByConsAddr", ctx, consAddr)
+	ret0, _ := ret[0].(types0.ValidatorI)
+	return ret0
+}
+
+// ValidatorByConsAddr indicates an expected call of ValidatorByConsAddr.
+func (mr *MockViewKeeperMockRecorder) ValidatorByConsAddr(ctx, consAddr interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ValidatorByConsAddr", reflect.TypeOf((*MockViewKeeper)(nil).ValidatorByConsAddr), ctx, consAddr)
+}
+
+// ValidatorUnbondingTime mocks base method.
+func (m *MockViewKeeper) ValidatorUnbondingTime(ctx types.Context, valAddr types.ValAddress) time.Duration {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ValidatorUnbondingTime", ctx, valAddr)
+	ret0, _ := ret[0].(time.Duration)
+	return ret0
+}
+
+// ValidatorUnbondingTime indicates an expected call of ValidatorUnbondingTime.
+func (mr *MockViewKeeperMockRecorder) ValidatorUnbondingTime(ctx, valAddr interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ValidatorUnbondingTime", reflect.TypeOf((*MockViewKeeper)(nil).ValidatorUnbondingTime), ctx, valAddr)
+}
+
 // MockStakingHooks is a mock of StakingHooks interface.
 type MockStakingHooks struct {
 	ctrl     *gomock.Controller
@@ -614,6 +1278,20 @@ func (mr *MockStakingHooksMockRecorder) AfterDelegationModified(ctx, delAddr, va
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AfterDelegationModified", reflect.TypeOf((*MockStakingHooks)(nil).AfterDelegationModified), ctx, delAddr, valAddr)
 }
 
+// AfterUnbondingCanceled mocks base method.
+func (m *MockStakingHooks) AfterUnbondingCanceled(ctx types.Context, id uint64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AfterUnbondingCanceled", ctx, id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// AfterUnbondingCanceled indicates an expected call of AfterUnbondingCanceled.
+func (mr *MockStakingHooksMockRecorder) AfterUnbondingCanceled(ctx, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AfterUnbondingCanceled", reflect.TypeOf((*MockStakingHooks)(nil).AfterUnbondingCanceled), ctx, id)
+}
+
 // AfterUnbondingInitiated mocks base method.
 func (m *MockStakingHooks) AfterUnbondingInitiated(ctx types.Context, id uint64) error {
 	m.ctrl.T.Helper()
@@ -684,6 +1362,20 @@ func (mr *MockStakingHooksMockRecorder) AfterValidatorRemoved(ctx, consAddr, val
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AfterValidatorRemoved", reflect.TypeOf((*MockStakingHooks)(nil).AfterValidatorRemoved), ctx, consAddr, valAddr)
 }
 
+// AfterValidatorSlashed mocks base method.
+func (m *MockStakingHooks) AfterValidatorSlashed(ctx types.Context, valAddr types.ValAddress, fraction math.LegacyDec, slashedDelegations []types0.SlashedDelegation) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AfterValidatorSlashed", ctx, valAddr, fraction, slashedDelegations)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// AfterValidatorSlashed indicates an expected call of AfterValidatorSlashed.
+func (mr *MockStakingHooksMockRecorder) AfterValidatorSlashed(ctx, valAddr, fraction, slashedDelegations interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AfterValidatorSlashed", reflect.TypeOf((*MockStakingHooks)(nil).AfterValidatorSlashed), ctx, valAddr, fraction, slashedDelegations)
+}
+
 // BeforeDelegationCreated mocks base method.
 func (m *MockStakingHooks) BeforeDelegationCreated(ctx types.Context, delAddr types.AccAddress, valAddr types.ValAddress) error {
 	m.ctrl.T.Helper()
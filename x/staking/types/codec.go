@@ -23,6 +23,18 @@ func RegisterLegacyAminoCodec(cdc *codec.LegacyAmino) {
 	legacy.RegisterAminoMsg(cdc, &MsgBeginRedelegate{}, "cosmos-sdk/MsgBeginRedelegate")
 	legacy.RegisterAminoMsg(cdc, &MsgCancelUnbondingDelegation{}, "cosmos-sdk/MsgCancelUnbondingDelegation")
 	legacy.RegisterAminoMsg(cdc, &MsgUpdateParams{}, "cosmos-sdk/x/staking/MsgUpdateParams")
+	legacy.RegisterAminoMsg(cdc, &MsgTokenizeShares{}, "cosmos-sdk/MsgTokenizeShares")
+	legacy.RegisterAminoMsg(cdc, &MsgRedeemTokensForShares{}, "cosmos-sdk/MsgRedeemTokensForShares")
+	legacy.RegisterAminoMsg(cdc, &MsgTransferTokenizeShareRecord{}, "cosmos-sdk/MsgTransferTokenizeRecord")
+	legacy.RegisterAminoMsg(cdc, &MsgValidatorBond{}, "cosmos-sdk/MsgValidatorBond")
+	legacy.RegisterAminoMsg(cdc, &MsgCancelRedelegation{}, "cosmos-sdk/MsgCancelRedelegation")
+	legacy.RegisterAminoMsg(cdc, &MsgLockDelegation{}, "cosmos-sdk/MsgLockDelegation")
+	legacy.RegisterAminoMsg(cdc, &MsgRedelegateMulti{}, "cosmos-sdk/MsgRedelegateMulti")
+	legacy.RegisterAminoMsg(cdc, &MsgInstantUndelegate{}, "cosmos-sdk/MsgInstantUndelegate")
+	legacy.RegisterAminoMsg(cdc, &MsgSetValidatorRiskTier{}, "cosmos-sdk/x/staking/MsgSetValidatorRiskTier")
+	legacy.RegisterAminoMsg(cdc, &MsgSetStakingPaused{}, "cosmos-sdk/x/staking/MsgSetStakingPaused")
+	legacy.RegisterAminoMsg(cdc, &MsgAttestValidatorProfile{}, "cosmos-sdk/MsgAttestValidatorProfile")
+	legacy.RegisterAminoMsg(cdc, &MsgSetStandbyConsPubKey{}, "cosmos-sdk/MsgSetStandbyConsPubKey")
 
 	cdc.RegisterInterface((*isStakeAuthorization_Validators)(nil), nil)
 	cdc.RegisterConcrete(&StakeAuthorization_AllowList{}, "cosmos-sdk/StakeAuthorization/AllowList", nil)
@@ -41,6 +53,18 @@ func RegisterInterfaces(registry types.InterfaceRegistry) {
 		&MsgBeginRedelegate{},
 		&MsgCancelUnbondingDelegation{},
 		&MsgUpdateParams{},
+		&MsgTokenizeShares{},
+		&MsgRedeemTokensForShares{},
+		&MsgTransferTokenizeShareRecord{},
+		&MsgValidatorBond{},
+		&MsgCancelRedelegation{},
+		&MsgLockDelegation{},
+		&MsgRedelegateMulti{},
+		&MsgInstantUndelegate{},
+		&MsgSetValidatorRiskTier{},
+		&MsgSetStakingPaused{},
+		&MsgAttestValidatorProfile{},
+		&MsgSetStandbyConsPubKey{},
 	)
 	registry.RegisterImplementations(
 		(*authz.Authorization)(nil),
@@ -128,10 +128,16 @@ func NewUnbondingDelegation(
 
 // AddEntry - append entry to the unbonding delegation
 func (ubd *UnbondingDelegation) AddEntry(creationHeight int64, minTime time.Time, balance math.Int, unbondingID uint64) {
-	// Check the entries exists with creation_height and complete_time
+	ubd.AddDenomEntry(creationHeight, minTime, balance, "", unbondingID)
+}
+
+// AddDenomEntry - append entry with a balance in the given additional bond
+// denom, empty for the bond denom, to the unbonding delegation
+func (ubd *UnbondingDelegation) AddDenomEntry(creationHeight int64, minTime time.Time, balance math.Int, denom string, unbondingID uint64) {
+	// Check the entries exists with creation_height, complete_time and denom
 	entryIndex := -1
 	for index, ubdEntry := range ubd.Entries {
-		if ubdEntry.CreationHeight == creationHeight && ubdEntry.CompletionTime.Equal(minTime) {
+		if ubdEntry.CreationHeight == creationHeight && ubdEntry.CompletionTime.Equal(minTime) && ubdEntry.Denom == denom {
 			entryIndex = index
 			break
 		}
@@ -147,6 +153,7 @@ func (ubd *UnbondingDelegation) AddEntry(creationHeight int64, minTime time.Time
 	} else {
 		// append the new unbond delegation entry
 		entry := NewUnbondingDelegationEntry(creationHeight, minTime, balance, unbondingID)
+		entry.Denom = denom
 		ubd.Entries = append(ubd.Entries, entry)
 	}
 }
@@ -353,3 +360,27 @@ func (r RedelegationResponses) String() (out string) {
 
 	return strings.TrimSpace(out)
 }
+
+// SlashedDelegation describes the impact of a slash on a single delegation at
+// the time the slash was applied. Delegations to the slashed validator keep
+// their shares and lose tokens, while delegations that received a slashed
+// redelegation have part of their shares unbonded and burned.
+type SlashedDelegation struct {
+	DelegatorAddress sdk.AccAddress
+	ValidatorAddress sdk.ValAddress
+	SharesBefore     math.LegacyDec
+	SharesAfter      math.LegacyDec
+	TokensBefore     math.LegacyDec
+	TokensAfter      math.LegacyDec
+}
+
+// NewDelegationLock creates a new DelegationLock instance
+func NewDelegationLock(delAddr sdk.AccAddress, valAddr sdk.ValAddress, term LockupTerm, endTime time.Time) DelegationLock {
+	return DelegationLock{
+		DelegatorAddress: delAddr.String(),
+		ValidatorAddress: valAddr.String(),
+		Duration:         term.Duration,
+		RewardMultiplier: term.RewardMultiplier,
+		EndTime:          endTime,
+	}
+}
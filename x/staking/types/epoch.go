@@ -0,0 +1,73 @@
+package types
+
+import (
+	"fmt"
+
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+var _ codectypes.UnpackInterfacesMessage = QueuedEpochMsg{}
+
+// NewQueuedEpochMsg creates a new QueuedEpochMsg instance
+func NewQueuedEpochMsg(id uint64, msg sdk.Msg) (QueuedEpochMsg, error) {
+	anyMsg, err := codectypes.NewAnyWithValue(msg)
+	if err != nil {
+		return QueuedEpochMsg{}, err
+	}
+
+	return QueuedEpochMsg{Id: id, Msg: anyMsg}, nil
+}
+
+// SdkMsg returns the queued message.
+func (m QueuedEpochMsg) SdkMsg() (sdk.Msg, error) {
+	if m.Msg == nil {
+		return nil, fmt.Errorf("queued epoch message %d is empty", m.Id)
+	}
+
+	msg, ok := m.Msg.GetCachedValue().(sdk.Msg)
+	if !ok {
+		return nil, fmt.Errorf("queued epoch message %d is not a sdk.Msg: %T", m.Id, m.Msg.GetCachedValue())
+	}
+
+	return msg, nil
+}
+
+// UnpackInterfaces implements UnpackInterfacesMessage.UnpackInterfaces
+func (m QueuedEpochMsg) UnpackInterfaces(unpacker codectypes.AnyUnpacker) error {
+	var msg sdk.Msg
+	return unpacker.UnpackAny(m.Msg, &msg)
+}
+
+// IsEpochMsg returns true for the messages which are queued until the end of
+// the epoch when epochs are enabled.
+func IsEpochMsg(msg sdk.Msg) bool {
+	switch msg.(type) {
+	case *MsgDelegate, *MsgUndelegate, *MsgBeginRedelegate, *MsgRedelegateMulti, *MsgInstantUndelegate:
+		return true
+	default:
+		return false
+	}
+}
+
+// NextEpochHeight returns the height of the first block at or after the given
+// height which ends an epoch of the given length.
+func NextEpochHeight(height int64, epochLength uint64) int64 {
+	if epochLength == 0 {
+		return height
+	}
+
+	length := int64(epochLength)
+	return ((height + length - 1) / length) * length
+}
+
+// UnpackInterfaces implements UnpackInterfacesMessage.UnpackInterfaces
+func (r QueryEpochQueueResponse) UnpackInterfaces(unpacker codectypes.AnyUnpacker) error {
+	for _, m := range r.Msgs {
+		if err := m.UnpackInterfaces(unpacker); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
@@ -2,17 +2,37 @@ package types
 
 // staking module event types
 const (
-	EventTypeCompleteUnbonding         = "complete_unbonding"
-	EventTypeCompleteRedelegation      = "complete_redelegation"
-	EventTypeCreateValidator           = "create_validator"
-	EventTypeEditValidator             = "edit_validator"
-	EventTypeDelegate                  = "delegate"
-	EventTypeUnbond                    = "unbond"
-	EventTypeCancelUnbondingDelegation = "cancel_unbonding_delegation"
-	EventTypeRedelegate                = "redelegate"
+	EventTypeCompleteUnbonding           = "complete_unbonding"
+	EventTypeCompleteRedelegation        = "complete_redelegation"
+	EventTypeCreateValidator             = "create_validator"
+	EventTypeEditValidator               = "edit_validator"
+	EventTypeScheduleCommissionChange    = "schedule_commission_change"
+	EventTypeCommissionChange            = "commission_change"
+	EventTypeDelegate                    = "delegate"
+	EventTypeUnbond                      = "unbond"
+	EventTypeCancelUnbondingDelegation   = "cancel_unbonding_delegation"
+	EventTypeRedelegate                  = "redelegate"
+	EventTypeCancelRedelegation          = "cancel_redelegation"
+	EventTypeTokenizeShares              = "tokenize_shares"
+	EventTypeRedeemShares                = "redeem_tokens_for_shares"
+	EventTypeTransferTokenizeShareRecord = "transfer_tokenize_share_record"
+	EventTypeValidatorBondDelegation     = "validator_bond_delegation"
+	EventTypeEpochMsgQueued              = "epoch_msg_queued"
+	EventTypeEpochMsgFailed              = "epoch_msg_failed"
+	EventTypeLockDelegation              = "lock_delegation"
+	EventTypeDelegationLockExpired       = "delegation_lock_expired"
+	EventTypeInstantUnbond               = "instant_unbond"
+	EventTypeSetValidatorRiskTier        = "set_validator_risk_tier"
+	EventTypeJailValidator               = "jail_validator"
+	EventTypeUnjailValidator             = "unjail_validator"
+	EventTypeSetStakingPaused            = "set_staking_paused"
+	EventTypeAttestValidatorProfile      = "attest_validator_profile"
+	EventTypeSetStandbyConsPubKey        = "set_standby_cons_pubkey"
+	EventTypeActivateStandbyConsPubKey   = "activate_standby_cons_pubkey"
 
 	AttributeKeyValidator         = "validator"
 	AttributeKeyCommissionRate    = "commission_rate"
+	AttributeKeyEffectiveTime     = "effective_time"
 	AttributeKeyMinSelfDelegation = "min_self_delegation"
 	AttributeKeySrcValidator      = "source_validator"
 	AttributeKeyDstValidator      = "destination_validator"
@@ -20,4 +40,24 @@ const (
 	AttributeKeyCreationHeight    = "creation_height"
 	AttributeKeyCompletionTime    = "completion_time"
 	AttributeKeyNewShares         = "new_shares"
+	AttributeKeyShareOwner        = "share_owner"
+	AttributeKeyShareRecordID     = "share_record_id"
+	AttributeKeyTokenizedShares   = "tokenized_shares"
+	AttributeKeyEpochMsgID        = "epoch_msg_id"
+	AttributeKeyEpochMsgType      = "epoch_msg_type"
+	AttributeKeyEpochHeight       = "epoch_height"
+	AttributeKeyError             = "error"
+	AttributeKeyLockDuration      = "lock_duration"
+	AttributeKeyRewardMultiplier  = "reward_multiplier"
+	AttributeKeyLockEndTime       = "lock_end_time"
+	AttributeKeyFee               = "fee"
+	AttributeKeyTier              = "tier"
+	AttributeKeyJailReason        = "jail_reason"
+	AttributeKeyReference         = "reference"
+	AttributeKeyPaused            = "paused"
+	AttributeKeyAttester          = "attester"
+	AttributeKeyProfileField      = "profile_field"
+	AttributeKeyVerified          = "verified"
+	AttributeKeyConsAddress       = "consensus_address"
+	AttributeKeyOldConsAddress    = "old_consensus_address"
 )
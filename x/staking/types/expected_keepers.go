@@ -2,6 +2,7 @@ package types
 
 import (
 	context "context"
+	"time"
 
 	"cosmossdk.io/core/address"
 	"cosmossdk.io/math"
@@ -15,15 +16,32 @@ type DistributionKeeper interface {
 	GetValidatorOutstandingRewardsCoins(ctx context.Context, val sdk.ValAddress) sdk.DecCoins
 }
 
+// CommunityPoolKeeper defines the expected keeper funding the community pool
+// with the instant undelegation fees (noalias)
+type CommunityPoolKeeper interface {
+	FundCommunityPool(ctx context.Context, amount sdk.Coins, sender sdk.AccAddress) error
+}
+
+// ProfileVerifier defines the verifier of the attestations of a validator
+// profile field, e.g. accepting the DNS TXT proofs of the website proofs
+// checked off-chain by a set of trusted attesters. It returns an error if the
+// attestation must be rejected. (noalias)
+type ProfileVerifier interface {
+	VerifyProfileAttestation(ctx context.Context, validator Validator, attestation ProfileAttestation) error
+}
+
 // AccountKeeper defines the expected account keeper (noalias)
 type AccountKeeper interface {
 	address.Codec
 
 	IterateAccounts(ctx context.Context, process func(sdk.AccountI) (stop bool))
 	GetAccount(ctx context.Context, addr sdk.AccAddress) sdk.AccountI // only used for simulation
+	HasAccount(ctx context.Context, addr sdk.AccAddress) bool
 
 	GetModuleAddress(name string) sdk.AccAddress
 	GetModuleAccount(ctx context.Context, moduleName string) sdk.ModuleAccountI
+	NewAccount(context.Context, sdk.AccountI) sdk.AccountI
+	SetAccount(context.Context, sdk.AccountI)
 
 	// TODO remove with genesis 2-phases refactor https://github.com/cosmos/cosmos-sdk/issues/2862
 	SetModuleAccount(context.Context, sdk.ModuleAccountI)
@@ -38,11 +56,17 @@ type BankKeeper interface {
 
 	GetSupply(ctx context.Context, denom string) sdk.Coin
 
+	SendCoins(ctx context.Context, fromAddr, toAddr sdk.AccAddress, amt sdk.Coins) error
 	SendCoinsFromModuleToModule(ctx context.Context, senderPool, recipientPool string, amt sdk.Coins) error
+	SendCoinsFromModuleToAccount(ctx context.Context, senderModule string, recipientAddr sdk.AccAddress, amt sdk.Coins) error
+	SendCoinsFromAccountToModule(ctx context.Context, senderAddr sdk.AccAddress, recipientModule string, amt sdk.Coins) error
 	UndelegateCoinsFromModuleToAccount(ctx context.Context, senderModule string, recipientAddr sdk.AccAddress, amt sdk.Coins) error
 	DelegateCoinsFromAccountToModule(ctx context.Context, senderAddr sdk.AccAddress, recipientModule string, amt sdk.Coins) error
 
+	MintCoins(ctx context.Context, moduleName string, amt sdk.Coins) error
 	BurnCoins(ctx context.Context, name string, amt sdk.Coins) error
+
+	SetModuleEscrow(ctx context.Context, moduleName, purpose string, amt sdk.Coins) error
 }
 
 // ValidatorSet expected properties for the set of all validators (noalias)
@@ -67,8 +91,9 @@ type ValidatorSet interface {
 	// slash the validator and delegators of the validator, specifying offense height, offense power, and slash fraction
 	Slash(sdk.Context, sdk.ConsAddress, int64, int64, math.LegacyDec) math.Int
 	SlashWithInfractionReason(sdk.Context, sdk.ConsAddress, int64, int64, math.LegacyDec, Infraction) math.Int
-	Jail(sdk.Context, sdk.ConsAddress)   // jail a validator
-	Unjail(sdk.Context, sdk.ConsAddress) // unjail a validator
+	Jail(sdk.Context, sdk.ConsAddress)                               // jail a validator
+	JailWithReason(sdk.Context, sdk.ConsAddress, JailReason, string) // jail a validator, recording why
+	Unjail(sdk.Context, sdk.ConsAddress)                             // unjail a validator
 
 	// Delegation allows for getting a particular delegation for a given validator
 	// and delegator outside the scope of the staking module.
@@ -88,6 +113,49 @@ type DelegationSet interface {
 		fn func(index int64, delegation DelegationI) (stop bool))
 }
 
+// ViewKeeper defines a module interface that facilitates read only access to
+// the staking state. Modules which only read the validators, delegations or
+// params can embed it in their expected keepers instead of listing the methods
+// of the full keeper. (noalias)
+type ViewKeeper interface {
+	GetParams(ctx sdk.Context) Params
+	BondDenom(ctx sdk.Context) string
+	PowerReduction(ctx sdk.Context) math.Int
+	UnbondingTime(ctx sdk.Context) time.Duration
+	ValidatorUnbondingTime(ctx sdk.Context, valAddr sdk.ValAddress) time.Duration
+
+	Validator(ctx sdk.Context, addr sdk.ValAddress) ValidatorI                              // get a particular validator by operator address, nil if not found
+	ValidatorByConsAddr(ctx sdk.Context, consAddr sdk.ConsAddress) ValidatorI               // get a particular validator by consensus address, nil if not found
+	Delegation(ctx sdk.Context, delAddr sdk.AccAddress, valAddr sdk.ValAddress) DelegationI // get a particular delegation, nil if not found
+
+	GetValidator(ctx sdk.Context, addr sdk.ValAddress) (validator Validator, found bool)
+	GetValidatorByConsAddr(ctx sdk.Context, consAddr sdk.ConsAddress) (validator Validator, found bool)
+	GetAllValidators(ctx sdk.Context) (validators []Validator)
+	GetBondedValidatorsByPower(ctx sdk.Context) []Validator
+	GetLastValidators(ctx sdk.Context) (validators []Validator)
+	GetLastValidatorPower(ctx sdk.Context, operator sdk.ValAddress) (power int64)
+	GetLastTotalPower(ctx sdk.Context) math.Int
+	GetHistoricalInfo(ctx sdk.Context, height int64) (HistoricalInfo, bool)
+	TotalBondedTokens(ctx sdk.Context) math.Int
+	BondedRatio(ctx sdk.Context) math.LegacyDec
+
+	GetDelegation(ctx sdk.Context, delAddr sdk.AccAddress, valAddr sdk.ValAddress) (delegation Delegation, found bool)
+	GetValidatorDelegations(ctx sdk.Context, valAddr sdk.ValAddress) (delegations []Delegation)
+	GetDelegatorDelegations(ctx sdk.Context, delegator sdk.AccAddress, maxRetrieve uint16) (delegations []Delegation)
+	GetDelegatorBonded(ctx sdk.Context, delegator sdk.AccAddress) math.Int
+	GetUnbondingDelegation(ctx sdk.Context, delAddr sdk.AccAddress, valAddr sdk.ValAddress) (ubd UnbondingDelegation, found bool)
+	GetUnbondingDelegations(ctx sdk.Context, delegator sdk.AccAddress, maxRetrieve uint16) (unbondingDelegations []UnbondingDelegation)
+	GetDelegatorUnbonding(ctx sdk.Context, delegator sdk.AccAddress) math.Int
+	GetRedelegation(ctx sdk.Context, delAddr sdk.AccAddress, valSrcAddr, valDstAddr sdk.ValAddress) (red Redelegation, found bool)
+	GetRedelegations(ctx sdk.Context, delegator sdk.AccAddress, maxRetrieve uint16) (redelegations []Redelegation)
+
+	IterateValidators(ctx sdk.Context, fn func(index int64, validator ValidatorI) (stop bool))
+	IterateBondedValidatorsByPower(ctx sdk.Context, fn func(index int64, validator ValidatorI) (stop bool))
+	IterateLastValidators(ctx sdk.Context, fn func(index int64, validator ValidatorI) (stop bool))
+	IterateDelegations(ctx sdk.Context, delAddr sdk.AccAddress, fn func(index int64, del DelegationI) (stop bool))
+	IterateDelegatorDelegations(ctx sdk.Context, delegator sdk.AccAddress, cb func(delegation Delegation) (stop bool))
+}
+
 // Event Hooks
 // These can be utilized to communicate between a staking keeper and another
 // keeper which must take particular actions when validators/delegators change
@@ -108,7 +176,9 @@ type StakingHooks interface {
 	BeforeDelegationRemoved(ctx sdk.Context, delAddr sdk.AccAddress, valAddr sdk.ValAddress) error        // Must be called when a delegation is removed
 	AfterDelegationModified(ctx sdk.Context, delAddr sdk.AccAddress, valAddr sdk.ValAddress) error
 	BeforeValidatorSlashed(ctx sdk.Context, valAddr sdk.ValAddress, fraction math.LegacyDec) error
+	AfterValidatorSlashed(ctx sdk.Context, valAddr sdk.ValAddress, fraction math.LegacyDec, slashedDelegations []SlashedDelegation) error // Must be called after a validator is slashed, with the per-delegation impact of the slash
 	AfterUnbondingInitiated(ctx sdk.Context, id uint64) error
+	AfterUnbondingCanceled(ctx sdk.Context, id uint64) error // Must be called when an unbonding operation is canceled before maturity
 }
 
 // StakingHooksWrapper is a wrapper for modules to inject StakingHooks using depinject.
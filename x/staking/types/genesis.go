@@ -3,6 +3,8 @@ package types
 import (
 	"encoding/json"
 
+	"cosmossdk.io/math"
+
 	"github.com/cosmos/cosmos-sdk/codec"
 	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
 )
@@ -19,7 +21,8 @@ func NewGenesisState(params Params, validators []Validator, delegations []Delega
 // DefaultGenesisState gets the raw genesis raw message for testing
 func DefaultGenesisState() *GenesisState {
 	return &GenesisState{
-		Params: DefaultParams(),
+		Params:                  DefaultParams(),
+		TotalLiquidStakedTokens: math.ZeroInt(),
 	}
 }
 
@@ -42,5 +45,15 @@ func (g GenesisState) UnpackInterfaces(c codectypes.AnyUnpacker) error {
 			return err
 		}
 	}
+	for i := range g.QueuedEpochMsgs {
+		if err := g.QueuedEpochMsgs[i].UnpackInterfaces(c); err != nil {
+			return err
+		}
+	}
+	for i := range g.StandbyConsPubkeys {
+		if err := g.StandbyConsPubkeys[i].UnpackInterfaces(c); err != nil {
+			return err
+		}
+	}
 	return nil
 }
@@ -45,6 +45,58 @@ type GenesisState struct {
 	// redelegations defines the redelegations active at genesis.
 	Redelegations []Redelegation `protobuf:"bytes,7,rep,name=redelegations,proto3" json:"redelegations"`
 	Exported      bool           `protobuf:"varint,8,opt,name=exported,proto3" json:"exported,omitempty"`
+	// tokenize_share_records defines the tokenize share records at genesis.
+	//
+	// Since: cosmos-sdk 0.48
+	TokenizeShareRecords []TokenizeShareRecord `protobuf:"bytes,9,rep,name=tokenize_share_records,json=tokenizeShareRecords,proto3" json:"tokenize_share_records"`
+	// last_tokenize_share_record_id is the id of the last created tokenize share
+	// record.
+	//
+	// Since: cosmos-sdk 0.48
+	LastTokenizeShareRecordId uint64 `protobuf:"varint,10,opt,name=last_tokenize_share_record_id,json=lastTokenizeShareRecordId,proto3" json:"last_tokenize_share_record_id,omitempty"`
+	// total_liquid_staked_tokens is the total amount of liquid staked tokens.
+	//
+	// Since: cosmos-sdk 0.48
+	TotalLiquidStakedTokens github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,11,opt,name=total_liquid_staked_tokens,json=totalLiquidStakedTokens,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"total_liquid_staked_tokens"`
+	// pending_commission_changes defines the commission rate changes announced
+	// by validators which have not taken effect yet.
+	//
+	// Since: cosmos-sdk 0.48
+	PendingCommissionChanges []PendingCommissionChange `protobuf:"bytes,12,rep,name=pending_commission_changes,json=pendingCommissionChanges,proto3" json:"pending_commission_changes"`
+	// queued_epoch_msgs defines the staking messages queued until the end of the
+	// current epoch.
+	//
+	// Since: cosmos-sdk 0.48
+	QueuedEpochMsgs []QueuedEpochMsg `protobuf:"bytes,13,rep,name=queued_epoch_msgs,json=queuedEpochMsgs,proto3" json:"queued_epoch_msgs"`
+	// delegation_locks defines the delegations locked in exchange for a reward
+	// multiplier.
+	//
+	// Since: cosmos-sdk 0.48
+	DelegationLocks []DelegationLock `protobuf:"bytes,14,rep,name=delegation_locks,json=delegationLocks,proto3" json:"delegation_locks"`
+	// validator_risk_tiers defines the unbonding tiers the validators are
+	// assigned to.
+	//
+	// Since: cosmos-sdk 0.48
+	ValidatorRiskTiers []ValidatorRiskTier `protobuf:"bytes,15,rep,name=validator_risk_tiers,json=validatorRiskTiers,proto3" json:"validator_risk_tiers"`
+	// validator_jail_infos defines why and when the jailed validators were
+	// jailed.
+	//
+	// Since: cosmos-sdk 0.48
+	ValidatorJailInfos []ValidatorJailInfo `protobuf:"bytes,16,rep,name=validator_jail_infos,json=validatorJailInfos,proto3" json:"validator_jail_infos"`
+	// staking_paused defines whether the delegations, undelegations and
+	// redelegations are paused.
+	//
+	// Since: cosmos-sdk 0.48
+	StakingPaused bool `protobuf:"varint,17,opt,name=staking_paused,json=stakingPaused,proto3" json:"staking_paused,omitempty"`
+	// profile_attestations defines the attestations of the fields of the
+	// validator profiles.
+	//
+	// Since: cosmos-sdk 0.48
+	ProfileAttestations []ProfileAttestation `protobuf:"bytes,18,rep,name=profile_attestations,json=profileAttestations,proto3" json:"profile_attestations"`
+	// standby_cons_pubkeys defines the standby consensus keys of the validators.
+	//
+	// Since: cosmos-sdk 0.48
+	StandbyConsPubkeys []StandbyConsPubKey `protobuf:"bytes,19,rep,name=standby_cons_pubkeys,json=standbyConsPubkeys,proto3" json:"standby_cons_pubkeys"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -129,6 +181,76 @@ func (m *GenesisState) GetExported() bool {
 	return false
 }
 
+func (m *GenesisState) GetTokenizeShareRecords() []TokenizeShareRecord {
+	if m != nil {
+		return m.TokenizeShareRecords
+	}
+	return nil
+}
+
+func (m *GenesisState) GetLastTokenizeShareRecordId() uint64 {
+	if m != nil {
+		return m.LastTokenizeShareRecordId
+	}
+	return 0
+}
+
+func (m *GenesisState) GetPendingCommissionChanges() []PendingCommissionChange {
+	if m != nil {
+		return m.PendingCommissionChanges
+	}
+	return nil
+}
+
+func (m *GenesisState) GetQueuedEpochMsgs() []QueuedEpochMsg {
+	if m != nil {
+		return m.QueuedEpochMsgs
+	}
+	return nil
+}
+
+func (m *GenesisState) GetDelegationLocks() []DelegationLock {
+	if m != nil {
+		return m.DelegationLocks
+	}
+	return nil
+}
+
+func (m *GenesisState) GetValidatorRiskTiers() []ValidatorRiskTier {
+	if m != nil {
+		return m.ValidatorRiskTiers
+	}
+	return nil
+}
+
+func (m *GenesisState) GetValidatorJailInfos() []ValidatorJailInfo {
+	if m != nil {
+		return m.ValidatorJailInfos
+	}
+	return nil
+}
+
+func (m *GenesisState) GetStakingPaused() bool {
+	if m != nil {
+		return m.StakingPaused
+	}
+	return false
+}
+
+func (m *GenesisState) GetProfileAttestations() []ProfileAttestation {
+	if m != nil {
+		return m.ProfileAttestations
+	}
+	return nil
+}
+
+func (m *GenesisState) GetStandbyConsPubkeys() []StandbyConsPubKey {
+	if m != nil {
+		return m.StandbyConsPubkeys
+	}
+	return nil
+}
+
 // LastValidatorPower required for validator set update logic.
 type LastValidatorPower struct {
 	// address is the address of the validator.
@@ -180,39 +302,60 @@ func init() {
 }
 
 var fileDescriptor_9b3dec8894f2831b = []byte{
-	// 503 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x7c, 0x93, 0x31, 0x6f, 0xd3, 0x40,
-	0x1c, 0xc5, 0x6d, 0x42, 0xd2, 0xf4, 0x52, 0x10, 0x1c, 0x29, 0x32, 0x19, 0x9c, 0x10, 0x55, 0x28,
-	0x2a, 0xd4, 0x56, 0xd3, 0x8d, 0xad, 0x11, 0x02, 0x21, 0x55, 0xa2, 0x72, 0x29, 0x03, 0x12, 0x8a,
-	0x2e, 0xf5, 0xc9, 0x3d, 0xc5, 0xb9, 0xb3, 0x7c, 0xff, 0x94, 0xf2, 0x0d, 0x18, 0x99, 0x99, 0x3a,
-	0x32, 0x32, 0xf0, 0x21, 0x3a, 0x56, 0x4c, 0x88, 0xa1, 0x42, 0xc9, 0x00, 0x1f, 0x03, 0xf9, 0xce,
-	0x31, 0x87, 0x52, 0x77, 0x49, 0x7c, 0xfe, 0xbf, 0xf7, 0x7b, 0x4f, 0xf2, 0xff, 0xd0, 0xc6, 0x91,
-	0x90, 0x13, 0x21, 0x7d, 0x09, 0x64, 0xcc, 0x78, 0xe4, 0x9f, 0x6c, 0x8f, 0x28, 0x90, 0x6d, 0x3f,
-	0xa2, 0x9c, 0x4a, 0x26, 0xbd, 0x24, 0x15, 0x20, 0xf0, 0x7d, 0xad, 0xf2, 0x72, 0x95, 0x97, 0xab,
-	0x5a, 0xcd, 0x48, 0x44, 0x42, 0x49, 0xfc, 0xec, 0x49, 0xab, 0x5b, 0x65, 0xcc, 0x85, 0x5b, 0xab,
-	0x1e, 0x68, 0xd5, 0x50, 0xdb, 0xf3, 0x00, 0x3d, 0xba, 0x4b, 0x26, 0x8c, 0x0b, 0x5f, 0xfd, 0xea,
-	0x57, 0xdd, 0xcf, 0x55, 0xb4, 0xf6, 0x42, 0x77, 0x3a, 0x00, 0x02, 0x14, 0xef, 0xa2, 0x5a, 0x42,
-	0x52, 0x32, 0x91, 0x8e, 0xdd, 0xb1, 0x7b, 0x8d, 0xbe, 0xeb, 0x5d, 0xdd, 0xd1, 0xdb, 0x57, 0xaa,
-	0xc1, 0xea, 0xf9, 0x65, 0xdb, 0xfa, 0xf2, 0xfb, 0xeb, 0xa6, 0x1d, 0xe4, 0x46, 0xfc, 0x0e, 0xdd,
-	0x89, 0x89, 0x84, 0x21, 0x08, 0x20, 0xf1, 0x30, 0x11, 0xef, 0x69, 0xea, 0xdc, 0xe8, 0xd8, 0xbd,
-	0xb5, 0xc1, 0x4e, 0x26, 0xfe, 0x79, 0xd9, 0x7e, 0x14, 0x31, 0x38, 0x9e, 0x8e, 0xbc, 0x23, 0x31,
-	0xc9, 0x1b, 0xe6, 0x7f, 0x5b, 0x32, 0x1c, 0xfb, 0xf0, 0x21, 0xa1, 0xd2, 0x7b, 0xc9, 0x41, 0x63,
-	0x6f, 0x67, 0xb0, 0xd7, 0x19, 0x6b, 0x3f, 0x43, 0x61, 0x86, 0xd6, 0x15, 0xfe, 0x84, 0xc4, 0x2c,
-	0x24, 0x20, 0x52, 0x1d, 0x21, 0x9d, 0x4a, 0xa7, 0xd2, 0x6b, 0xf4, 0x37, 0xcb, 0x0a, 0xef, 0x11,
-	0x09, 0x6f, 0x16, 0x1e, 0x85, 0x32, 0xcb, 0xdf, 0x8b, 0x97, 0xc6, 0x12, 0xef, 0x21, 0x54, 0xa4,
-	0x48, 0xe7, 0xa6, 0xe2, 0x3f, 0x2c, 0xe3, 0x17, 0x66, 0x13, 0x6b, 0xf8, 0xf1, 0x2b, 0xd4, 0x08,
-	0x69, 0x4c, 0x23, 0x02, 0x4c, 0x70, 0xe9, 0x54, 0x15, 0xae, 0x5b, 0x86, 0x7b, 0x56, 0x48, 0x4d,
-	0x9e, 0x49, 0xc0, 0x63, 0xb4, 0x3e, 0xe5, 0x23, 0xc1, 0x43, 0xc6, 0xa3, 0xa1, 0x89, 0xae, 0x29,
-	0xf4, 0xe3, 0x32, 0xf4, 0xe1, 0xc2, 0x74, 0x75, 0x46, 0x73, 0xba, 0x3c, 0x97, 0xf8, 0x10, 0xdd,
-	0x4a, 0xa9, 0x19, 0xb2, 0xa2, 0x42, 0x36, 0xca, 0x42, 0x02, 0x43, 0x6c, 0xd2, 0xff, 0xa7, 0xe0,
-	0x16, 0xaa, 0xd3, 0xd3, 0x44, 0xa4, 0x40, 0x43, 0xa7, 0xde, 0xb1, 0x7b, 0xf5, 0xa0, 0x38, 0x77,
-	0x8f, 0x11, 0x5e, 0xfe, 0x68, 0xb8, 0x8f, 0x56, 0x48, 0x18, 0xa6, 0x54, 0xea, 0x15, 0x5d, 0x1d,
-	0x38, 0xdf, 0xbf, 0x6d, 0x35, 0xf3, 0x16, 0xbb, 0x7a, 0x72, 0x00, 0x29, 0xe3, 0x51, 0xb0, 0x10,
-	0xe2, 0x26, 0xaa, 0xfe, 0xdb, 0xc3, 0x4a, 0xa0, 0x0f, 0x4f, 0xeb, 0x1f, 0xcf, 0xda, 0xd6, 0x9f,
-	0xb3, 0xb6, 0x35, 0x78, 0x7e, 0x3e, 0x73, 0xed, 0x8b, 0x99, 0x6b, 0xff, 0x9a, 0xb9, 0xf6, 0xa7,
-	0xb9, 0x6b, 0x5d, 0xcc, 0x5d, 0xeb, 0xc7, 0xdc, 0xb5, 0xde, 0x3e, 0xb9, 0x76, 0x55, 0x4f, 0x8b,
-	0xcb, 0xa8, 0x96, 0x76, 0x54, 0x53, 0xb7, 0x6a, 0xe7, 0x6f, 0x00, 0x00, 0x00, 0xff, 0xff, 0xe5,
-	0x05, 0x1a, 0xb6, 0xff, 0x03, 0x00, 0x00,
+	// 847 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x95, 0x4d, 0x73, 0x1b, 0x35,
+	0x18, 0xc7, 0xbd, 0xb4, 0x4d, 0x13, 0xe5, 0xa5, 0x89, 0xea, 0x16, 0xd5, 0x33, 0x38, 0x26, 0x53,
+	0x3a, 0xa6, 0x50, 0x7b, 0x9a, 0xde, 0x38, 0x91, 0x84, 0x97, 0x09, 0x84, 0xc1, 0xd8, 0x29, 0x07,
+	0x06, 0x46, 0x23, 0xaf, 0x94, 0xb5, 0xd8, 0xb5, 0xb4, 0xd9, 0x47, 0x1b, 0x12, 0x3e, 0x01, 0x47,
+	0x3e, 0x42, 0x8f, 0x1c, 0x39, 0x70, 0xe4, 0x03, 0xf4, 0xd8, 0xe1, 0xc4, 0x70, 0xe8, 0x30, 0xc9,
+	0x01, 0x3e, 0x06, 0xb3, 0xd2, 0xda, 0xde, 0xad, 0x5f, 0x60, 0xb8, 0x24, 0xd9, 0x7d, 0xfe, 0xff,
+	0xdf, 0xff, 0xd1, 0x46, 0x8f, 0x84, 0xee, 0xfb, 0x1a, 0x86, 0x1a, 0xda, 0x60, 0x58, 0x28, 0x55,
+	0xd0, 0x3e, 0x7b, 0xdc, 0x17, 0x86, 0x3d, 0x6e, 0x07, 0x42, 0x09, 0x90, 0xd0, 0x8a, 0x13, 0x6d,
+	0x34, 0xbe, 0xeb, 0x54, 0xad, 0x5c, 0xd5, 0xca, 0x55, 0xb5, 0x6a, 0xa0, 0x03, 0x6d, 0x25, 0xed,
+	0xec, 0x2f, 0xa7, 0xae, 0xcd, 0x63, 0x8e, 0xdc, 0x4e, 0x75, 0xcf, 0xa9, 0xa8, 0xb3, 0xe7, 0x01,
+	0xae, 0xb4, 0xc5, 0x86, 0x52, 0xe9, 0xb6, 0xfd, 0xe9, 0x5e, 0xed, 0xfc, 0xba, 0x8e, 0xd6, 0x3e,
+	0x76, 0x3d, 0xf5, 0x0c, 0x33, 0x02, 0xef, 0xa1, 0xa5, 0x98, 0x25, 0x6c, 0x08, 0xc4, 0x6b, 0x78,
+	0xcd, 0xd5, 0xdd, 0x7a, 0x6b, 0x76, 0x8f, 0xad, 0x8e, 0x55, 0xed, 0xaf, 0x3c, 0x7f, 0xb9, 0x5d,
+	0xf9, 0xe9, 0xaf, 0x9f, 0x1f, 0x7a, 0xdd, 0xdc, 0x88, 0xbf, 0x41, 0x9b, 0x11, 0x03, 0x43, 0x8d,
+	0x36, 0x2c, 0xa2, 0xb1, 0xfe, 0x4e, 0x24, 0xe4, 0xb5, 0x86, 0xd7, 0x5c, 0xdb, 0x7f, 0x92, 0x89,
+	0xff, 0x78, 0xb9, 0xfd, 0x20, 0x90, 0x66, 0x90, 0xf6, 0x5b, 0xbe, 0x1e, 0xe6, 0x1d, 0xe6, 0xbf,
+	0x1e, 0x01, 0x0f, 0xdb, 0xe6, 0x22, 0x16, 0xd0, 0x3a, 0x54, 0xc6, 0x61, 0x37, 0x32, 0xd8, 0x71,
+	0xc6, 0xea, 0x64, 0x28, 0x2c, 0xd1, 0x1d, 0x8b, 0x3f, 0x63, 0x91, 0xe4, 0xcc, 0xe8, 0xc4, 0x45,
+	0x00, 0xb9, 0xd6, 0xb8, 0xd6, 0x5c, 0xdd, 0x7d, 0x38, 0xaf, 0xe1, 0x23, 0x06, 0xe6, 0xcb, 0x91,
+	0xc7, 0xa2, 0x8a, 0xcd, 0xdf, 0x8e, 0xa6, 0xca, 0x80, 0x8f, 0x10, 0x1a, 0xa7, 0x00, 0xb9, 0x6e,
+	0xf9, 0x6f, 0xce, 0xe3, 0x8f, 0xcd, 0x45, 0x6c, 0xc1, 0x8f, 0x3f, 0x47, 0xab, 0x5c, 0x44, 0x22,
+	0x60, 0x46, 0x6a, 0x05, 0xe4, 0x86, 0xc5, 0xed, 0xcc, 0xc3, 0x7d, 0x30, 0x96, 0x16, 0x79, 0x45,
+	0x02, 0x0e, 0xd1, 0x9d, 0x54, 0xf5, 0xb5, 0xe2, 0x52, 0x05, 0xb4, 0x88, 0x5e, 0xb2, 0xe8, 0x77,
+	0xe6, 0xa1, 0x9f, 0x8e, 0x4c, 0xb3, 0x33, 0xaa, 0xe9, 0x74, 0x1d, 0xf0, 0x53, 0xb4, 0x9e, 0x88,
+	0x62, 0xc8, 0x4d, 0x1b, 0x72, 0x7f, 0x5e, 0x48, 0x57, 0xf0, 0x99, 0xf4, 0x32, 0x05, 0xd7, 0xd0,
+	0xb2, 0x38, 0x8f, 0x75, 0x62, 0x04, 0x27, 0xcb, 0x0d, 0xaf, 0xb9, 0xdc, 0x1d, 0x3f, 0xe3, 0x08,
+	0xdd, 0x35, 0x3a, 0x14, 0x4a, 0x7e, 0x2f, 0x28, 0x0c, 0x58, 0x22, 0x68, 0x22, 0x7c, 0x9d, 0x70,
+	0x20, 0x2b, 0x8b, 0x17, 0x78, 0x9c, 0xbb, 0x7a, 0x99, 0xa9, 0x6b, 0x3d, 0xa5, 0x05, 0x9a, 0xe9,
+	0x3a, 0xe0, 0xf7, 0xd1, 0x1b, 0xf9, 0xb6, 0x9d, 0x11, 0x49, 0x25, 0x27, 0xa8, 0xe1, 0x35, 0xaf,
+	0x77, 0xef, 0xb9, 0xed, 0x38, 0x05, 0x38, 0xe4, 0x38, 0x46, 0x35, 0xb7, 0xe7, 0x23, 0x79, 0x9a,
+	0x4a, 0x4e, 0xb3, 0xb6, 0x04, 0x77, 0x40, 0x20, 0xab, 0xff, 0x7f, 0x04, 0x5e, 0xb7, 0xd8, 0x23,
+	0x4b, 0xed, 0x59, 0xa8, 0x6d, 0x00, 0xf0, 0x39, 0xaa, 0xc5, 0xc2, 0xfd, 0xff, 0x7d, 0x3d, 0x1c,
+	0x4a, 0x00, 0xa9, 0x15, 0xf5, 0x07, 0x4c, 0x05, 0x02, 0xc8, 0x9a, 0xfd, 0x4a, 0xed, 0xb9, 0x13,
+	0xec, 0x9c, 0x07, 0x63, 0xe3, 0x81, 0xf5, 0x15, 0xbf, 0x14, 0x89, 0x67, 0x6b, 0xb2, 0x21, 0xdf,
+	0x3a, 0x4d, 0x45, 0x2a, 0x38, 0x15, 0xb1, 0xf6, 0x07, 0x74, 0x08, 0x01, 0x90, 0x75, 0x1b, 0xf8,
+	0x60, 0x5e, 0xe0, 0x17, 0xd6, 0xf0, 0x61, 0xa6, 0xff, 0x0c, 0x82, 0x62, 0xce, 0xad, 0xd3, 0x52,
+	0x09, 0xf0, 0xd7, 0x68, 0x73, 0xb2, 0x4b, 0x68, 0xa4, 0xfd, 0x10, 0xc8, 0xc6, 0x62, 0xfa, 0x64,
+	0xb3, 0x1e, 0x69, 0x3f, 0x2c, 0xd1, 0x79, 0xa9, 0x04, 0xf8, 0x04, 0x55, 0x27, 0xa7, 0x47, 0x22,
+	0x21, 0xa4, 0x46, 0x66, 0x27, 0xc8, 0x2d, 0x9b, 0xf0, 0xf6, 0xbf, 0x4e, 0x78, 0x57, 0x42, 0x78,
+	0x2c, 0xcb, 0x07, 0x08, 0x3e, 0x7b, 0xb5, 0xfa, 0x4a, 0xce, 0xb7, 0x4c, 0x46, 0x54, 0xaa, 0x13,
+	0x0d, 0x64, 0xf3, 0x3f, 0xe6, 0x7c, 0xc2, 0x64, 0x74, 0xa8, 0x4e, 0xf4, 0xec, 0x9c, 0x51, 0x15,
+	0xf0, 0x5b, 0x68, 0x23, 0x67, 0xd0, 0x98, 0xa5, 0x20, 0x38, 0xd9, 0xb2, 0xa3, 0xb4, 0x9e, 0xbf,
+	0xed, 0xd8, 0x97, 0x78, 0x80, 0xaa, 0x71, 0xa2, 0x4f, 0x64, 0x24, 0x28, 0x33, 0x46, 0x80, 0xc9,
+	0x27, 0x19, 0x2f, 0x3e, 0x38, 0x3b, 0xce, 0xb3, 0x37, 0xb1, 0x94, 0x0e, 0xce, 0x78, 0xaa, 0x6c,
+	0x17, 0x0e, 0x86, 0x29, 0xde, 0xbf, 0xa0, 0xbe, 0x56, 0x40, 0xe3, 0xb4, 0x1f, 0x8a, 0x0b, 0x20,
+	0xb7, 0x17, 0x2f, 0xbc, 0xe7, 0x3c, 0x07, 0x5a, 0x41, 0x27, 0xed, 0x7f, 0x2a, 0x2e, 0x4a, 0x0b,
+	0x87, 0x52, 0x35, 0xe3, 0xed, 0x0c, 0x10, 0x9e, 0x3e, 0xd6, 0xf1, 0x2e, 0xba, 0xc9, 0x38, 0x4f,
+	0x04, 0xb8, 0x4b, 0x6c, 0x65, 0x9f, 0xfc, 0xf6, 0xcb, 0xa3, 0x6a, 0x9e, 0xb9, 0xe7, 0x2a, 0x3d,
+	0x93, 0x48, 0x15, 0x74, 0x47, 0x42, 0x5c, 0x45, 0x37, 0x26, 0x37, 0xd5, 0xb5, 0xae, 0x7b, 0x78,
+	0x6f, 0xf9, 0x87, 0x67, 0xdb, 0x95, 0xbf, 0x9f, 0x6d, 0x57, 0xf6, 0x3f, 0x7a, 0x7e, 0x59, 0xf7,
+	0x5e, 0x5c, 0xd6, 0xbd, 0x3f, 0x2f, 0xeb, 0xde, 0x8f, 0x57, 0xf5, 0xca, 0x8b, 0xab, 0x7a, 0xe5,
+	0xf7, 0xab, 0x7a, 0xe5, 0xab, 0x77, 0x17, 0x4e, 0xf2, 0xf9, 0xf8, 0xba, 0xb6, 0x33, 0xdd, 0x5f,
+	0xb2, 0xf7, 0xee, 0x93, 0x7f, 0x06, 0x00, 0x42, 0x51, 0x99, 0xca, 0x21, 0x08, 0x00, 0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -235,6 +378,151 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.StandbyConsPubkeys) > 0 {
+		for iNdEx := len(m.StandbyConsPubkeys) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.StandbyConsPubkeys[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0x9a
+		}
+	}
+	if len(m.ProfileAttestations) > 0 {
+		for iNdEx := len(m.ProfileAttestations) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ProfileAttestations[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0x92
+		}
+	}
+	if m.StakingPaused {
+		i--
+		if m.StakingPaused {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x88
+	}
+	if len(m.ValidatorJailInfos) > 0 {
+		for iNdEx := len(m.ValidatorJailInfos) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ValidatorJailInfos[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0x82
+		}
+	}
+	if len(m.ValidatorRiskTiers) > 0 {
+		for iNdEx := len(m.ValidatorRiskTiers) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ValidatorRiskTiers[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x7a
+		}
+	}
+	if len(m.DelegationLocks) > 0 {
+		for iNdEx := len(m.DelegationLocks) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.DelegationLocks[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x72
+		}
+	}
+	if len(m.QueuedEpochMsgs) > 0 {
+		for iNdEx := len(m.QueuedEpochMsgs) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.QueuedEpochMsgs[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x6a
+		}
+	}
+	if len(m.PendingCommissionChanges) > 0 {
+		for iNdEx := len(m.PendingCommissionChanges) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.PendingCommissionChanges[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x62
+		}
+	}
+	{
+		size := m.TotalLiquidStakedTokens.Size()
+		i -= size
+		if _, err := m.TotalLiquidStakedTokens.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x5a
+	if m.LastTokenizeShareRecordId != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.LastTokenizeShareRecordId))
+		i--
+		dAtA[i] = 0x50
+	}
+	if len(m.TokenizeShareRecords) > 0 {
+		for iNdEx := len(m.TokenizeShareRecords) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.TokenizeShareRecords[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x4a
+		}
+	}
 	if m.Exported {
 		i--
 		if m.Exported {
@@ -427,6 +715,62 @@ func (m *GenesisState) Size() (n int) {
 	if m.Exported {
 		n += 2
 	}
+	if len(m.TokenizeShareRecords) > 0 {
+		for _, e := range m.TokenizeShareRecords {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if m.LastTokenizeShareRecordId != 0 {
+		n += 1 + sovGenesis(uint64(m.LastTokenizeShareRecordId))
+	}
+	l = m.TotalLiquidStakedTokens.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	if len(m.PendingCommissionChanges) > 0 {
+		for _, e := range m.PendingCommissionChanges {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.QueuedEpochMsgs) > 0 {
+		for _, e := range m.QueuedEpochMsgs {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.DelegationLocks) > 0 {
+		for _, e := range m.DelegationLocks {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.ValidatorRiskTiers) > 0 {
+		for _, e := range m.ValidatorRiskTiers {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.ValidatorJailInfos) > 0 {
+		for _, e := range m.ValidatorJailInfos {
+			l = e.Size()
+			n += 2 + l + sovGenesis(uint64(l))
+		}
+	}
+	if m.StakingPaused {
+		n += 3
+	}
+	if len(m.ProfileAttestations) > 0 {
+		for _, e := range m.ProfileAttestations {
+			l = e.Size()
+			n += 2 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.StandbyConsPubkeys) > 0 {
+		for _, e := range m.StandbyConsPubkeys {
+			l = e.Size()
+			n += 2 + l + sovGenesis(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -737,6 +1081,350 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				}
 			}
 			m.Exported = bool(v != 0)
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TokenizeShareRecords", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TokenizeShareRecords = append(m.TokenizeShareRecords, TokenizeShareRecord{})
+			if err := m.TokenizeShareRecords[len(m.TokenizeShareRecords)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastTokenizeShareRecordId", wireType)
+			}
+			m.LastTokenizeShareRecordId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LastTokenizeShareRecordId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalLiquidStakedTokens", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.TotalLiquidStakedTokens.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PendingCommissionChanges", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PendingCommissionChanges = append(m.PendingCommissionChanges, PendingCommissionChange{})
+			if err := m.PendingCommissionChanges[len(m.PendingCommissionChanges)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 13:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field QueuedEpochMsgs", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.QueuedEpochMsgs = append(m.QueuedEpochMsgs, QueuedEpochMsg{})
+			if err := m.QueuedEpochMsgs[len(m.QueuedEpochMsgs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 14:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DelegationLocks", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DelegationLocks = append(m.DelegationLocks, DelegationLock{})
+			if err := m.DelegationLocks[len(m.DelegationLocks)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 15:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorRiskTiers", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ValidatorRiskTiers = append(m.ValidatorRiskTiers, ValidatorRiskTier{})
+			if err := m.ValidatorRiskTiers[len(m.ValidatorRiskTiers)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 16:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorJailInfos", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ValidatorJailInfos = append(m.ValidatorJailInfos, ValidatorJailInfo{})
+			if err := m.ValidatorJailInfos[len(m.ValidatorJailInfos)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 17:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StakingPaused", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.StakingPaused = bool(v != 0)
+		case 18:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProfileAttestations", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ProfileAttestations = append(m.ProfileAttestations, ProfileAttestation{})
+			if err := m.ProfileAttestations[len(m.ProfileAttestations)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 19:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StandbyConsPubkeys", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.StandbyConsPubkeys = append(m.StandbyConsPubkeys, StandbyConsPubKey{})
+			if err := m.StandbyConsPubkeys[len(m.StandbyConsPubkeys)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
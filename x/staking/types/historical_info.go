@@ -12,8 +12,13 @@ import (
 
 	"github.com/cosmos/cosmos-sdk/codec"
 	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
 )
 
+// MaxValidatorSetChangesRange is the maximum number of heights spanned by a
+// validator set changes query.
+const MaxValidatorSetChangesRange = 1000
+
 // NewHistoricalInfo will create a historical information struct from header and valset
 // it will first sort valset before inclusion into historical info
 func NewHistoricalInfo(header cmtproto.Header, valSet Validators, powerReduction math.Int) HistoricalInfo {
@@ -82,3 +87,64 @@ func (hi HistoricalInfo) UnpackInterfaces(c codectypes.AnyUnpacker) error {
 	}
 	return nil
 }
+
+// NewValidatorSetChange creates a new ValidatorSetChange instance.
+func NewValidatorSetChange(height int64, validator Validator, changeType ValidatorSetChangeType, previousPower, power int64) ValidatorSetChange {
+	return ValidatorSetChange{
+		Height:          height,
+		OperatorAddress: validator.OperatorAddress,
+		ConsensusPubkey: validator.ConsensusPubkey,
+		Type:            changeType,
+		PreviousPower:   previousPower,
+		Power:           power,
+	}
+}
+
+// ValidatorSetChanges returns the changes from the prev validator set to the
+// next one, observed at the given height. The joins and power changes follow
+// the order of next, and are followed by the exits in the order of prev.
+func ValidatorSetChanges(height int64, prev, next Validators, powerReduction math.Int) []ValidatorSetChange {
+	prevPowers := make(map[string]int64, len(prev))
+	for _, val := range prev {
+		prevPowers[val.OperatorAddress] = val.PotentialConsensusPower(powerReduction)
+	}
+
+	var changes []ValidatorSetChange
+	nextVals := make(map[string]bool, len(next))
+	for _, val := range next {
+		nextVals[val.OperatorAddress] = true
+		power := val.PotentialConsensusPower(powerReduction)
+
+		prevPower, found := prevPowers[val.OperatorAddress]
+		switch {
+		case !found:
+			changes = append(changes, NewValidatorSetChange(height, val, ValidatorSetChangeType_VALIDATOR_SET_CHANGE_TYPE_JOIN, 0, power))
+		case prevPower != power:
+			changes = append(changes, NewValidatorSetChange(height, val, ValidatorSetChangeType_VALIDATOR_SET_CHANGE_TYPE_POWER, prevPower, power))
+		}
+	}
+
+	for _, val := range prev {
+		if !nextVals[val.OperatorAddress] {
+			changes = append(changes, NewValidatorSetChange(height, val, ValidatorSetChangeType_VALIDATOR_SET_CHANGE_TYPE_EXIT, prevPowers[val.OperatorAddress], 0))
+		}
+	}
+
+	return changes
+}
+
+// UnpackInterfaces implements UnpackInterfacesMessage.UnpackInterfaces
+func (c ValidatorSetChange) UnpackInterfaces(unpacker codectypes.AnyUnpacker) error {
+	var pk cryptotypes.PubKey
+	return unpacker.UnpackAny(c.ConsensusPubkey, &pk)
+}
+
+// UnpackInterfaces implements UnpackInterfacesMessage.UnpackInterfaces
+func (r QueryValidatorSetChangesResponse) UnpackInterfaces(unpacker codectypes.AnyUnpacker) error {
+	for _, c := range r.Changes {
+		if err := c.UnpackInterfaces(unpacker); err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -106,6 +106,15 @@ func (h MultiStakingHooks) BeforeValidatorSlashed(ctx sdk.Context, valAddr sdk.V
 	return nil
 }
 
+func (h MultiStakingHooks) AfterValidatorSlashed(ctx sdk.Context, valAddr sdk.ValAddress, fraction sdkmath.LegacyDec, slashedDelegations []SlashedDelegation) error {
+	for i := range h {
+		if err := h[i].AfterValidatorSlashed(ctx, valAddr, fraction, slashedDelegations); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
 func (h MultiStakingHooks) AfterUnbondingInitiated(ctx sdk.Context, id uint64) error {
 	for i := range h {
 		if err := h[i].AfterUnbondingInitiated(ctx, id); err != nil {
@@ -114,3 +123,12 @@ func (h MultiStakingHooks) AfterUnbondingInitiated(ctx sdk.Context, id uint64) e
 	}
 	return nil
 }
+
+func (h MultiStakingHooks) AfterUnbondingCanceled(ctx sdk.Context, id uint64) error {
+	for i := range h {
+		if err := h[i].AfterUnbondingCanceled(ctx, id); err != nil {
+			return err
+		}
+	}
+	return nil
+}
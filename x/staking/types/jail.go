@@ -0,0 +1,18 @@
+package types
+
+import (
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// NewValidatorJailInfo creates a new ValidatorJailInfo instance.
+func NewValidatorJailInfo(valAddr sdk.ValAddress, reason JailReason, height int64, jailTime time.Time, reference string) ValidatorJailInfo {
+	return ValidatorJailInfo{
+		ValidatorAddress: valAddr.String(),
+		Reason:           reason,
+		Height:           height,
+		Time:             jailTime,
+		Reference:        reference,
+	}
+}
@@ -30,9 +30,16 @@ var (
 	LastValidatorPowerKey = []byte{0x11} // prefix for each key to a validator index, for bonded validators
 	LastTotalPowerKey     = []byte{0x12} // prefix for the total power
 
-	ValidatorsKey             = []byte{0x21} // prefix for each key to a validator
-	ValidatorsByConsAddrKey   = []byte{0x22} // prefix for each key to a validator index, by pubkey
-	ValidatorsByPowerIndexKey = []byte{0x23} // prefix for each key to a validator index, sorted by power
+	ValidatorsKey              = []byte{0x21} // prefix for each key to a validator
+	ValidatorsByConsAddrKey    = []byte{0x22} // prefix for each key to a validator index, by pubkey
+	ValidatorsByPowerIndexKey  = []byte{0x23} // prefix for each key to a validator index, sorted by power
+	PendingCommissionChangeKey = []byte{0x24} // prefix for each key to a validator pending commission change
+	ValidatorRiskTierKey       = []byte{0x25} // prefix for each key to the unbonding tier of a validator
+	ValidatorJailInfoKey       = []byte{0x26} // prefix for each key to the jail info of a validator
+	ProfileAttestationKey      = []byte{0x27} // prefix for each key to an attestation of a validator profile field
+	StandbyConsPubKeyKey       = []byte{0x28} // prefix for each key to the standby consensus pubkey of a validator
+	ValidatorsByStandbyConsKey = []byte{0x29} // prefix for each key to a validator index, by standby consensus address
+	StandbyActivationKey       = []byte{0x2a} // prefix for each key to a validator whose standby consensus pubkey is activated in the current block
 
 	DelegationKey                    = []byte{0x31} // key for a delegation
 	UnbondingDelegationKey           = []byte{0x32} // key for an unbonding-delegation
@@ -46,14 +53,30 @@ var (
 	UnbondingIndexKey = []byte{0x38} // prefix for an index for looking up unbonding operations by their IDs
 	UnbondingTypeKey  = []byte{0x39} // prefix for an index containing the type of unbonding operations
 
-	UnbondingQueueKey    = []byte{0x41} // prefix for the timestamps in unbonding queue
-	RedelegationQueueKey = []byte{0x42} // prefix for the timestamps in redelegations queue
-	ValidatorQueueKey    = []byte{0x43} // prefix for the timestamps in validator queue
+	UnbondingQueueKey        = []byte{0x41} // prefix for the timestamps in unbonding queue
+	RedelegationQueueKey     = []byte{0x42} // prefix for the timestamps in redelegations queue
+	ValidatorQueueKey        = []byte{0x43} // prefix for the timestamps in validator queue
+	CommissionChangeQueueKey = []byte{0x44} // prefix for the timestamps in commission change queue
 
 	HistoricalInfoKey   = []byte{0x50} // prefix for the historical info
 	ValidatorUpdatesKey = []byte{0x61} // prefix for the end block validator updates key
 
 	ParamsKey = []byte{0x51} // prefix for parameters for module x/staking
+
+	TotalLiquidStakedTokensKey = []byte{0x65} // key for the total liquid staked tokens
+	InstantUndelegationUsedKey = []byte{0x66} // key for the staking tokens instantly undelegated in the current block
+	StakingPausedKey           = []byte{0x67} // key for the flag pausing the delegations, undelegations and redelegations
+
+	EpochMsgQueueKey  = []byte{0x71} // prefix for the staking messages queued until the end of the epoch
+	LastEpochMsgIDKey = []byte{0x72} // key for the id of the last queued epoch message
+
+	TokenizeShareRecordPrefix          = []byte{0x81} // key for tokenize share record prefix
+	TokenizeShareRecordIDByOwnerPrefix = []byte{0x82} // key for tokenize share record id by owner prefix
+	TokenizeShareRecordIDByDenomPrefix = []byte{0x83} // key for tokenize share record id by denom prefix
+	LastTokenizeShareRecordIDKey       = []byte{0x84} // key for last tokenize share record id
+
+	DelegationLockKey      = []byte{0x91} // prefix for each key to a delegation lock
+	DelegationLockQueueKey = []byte{0x92} // prefix for the timestamps in delegation lock queue
 )
 
 // UnbondingType defines the type of unbonding operation
@@ -87,6 +110,12 @@ func GetValidatorKey(operatorAddr sdk.ValAddress) []byte {
 	return append(ValidatorsKey, address.MustLengthPrefix(operatorAddr)...)
 }
 
+// ValidatorEscrowPurpose returns the purpose the tokens of a bonded validator
+// are escrowed for by the bonded pool, as tracked by x/bank.
+func ValidatorEscrowPurpose(operatorAddr sdk.ValAddress) string {
+	return fmt.Sprintf("validator/%s", operatorAddr)
+}
+
 // GetValidatorByConsAddrKey creates the key for the validator with pubkey
 // VALUE: validator operator address ([]byte)
 func GetValidatorByConsAddrKey(addr sdk.ConsAddress) []byte {
@@ -142,6 +171,87 @@ func GetValidatorsByPowerIndexKey(validator Validator, powerReduction math.Int)
 	return key
 }
 
+// GetPendingCommissionChangeKey creates the key for the pending commission
+// change of the validator with address
+// VALUE: staking/PendingCommissionChange
+func GetPendingCommissionChangeKey(operatorAddr sdk.ValAddress) []byte {
+	return append(PendingCommissionChangeKey, address.MustLengthPrefix(operatorAddr)...)
+}
+
+// GetValidatorRiskTierKey creates the key for the unbonding tier of the
+// validator with address
+// VALUE: tier name ([]byte)
+func GetValidatorRiskTierKey(operatorAddr sdk.ValAddress) []byte {
+	return append(ValidatorRiskTierKey, address.MustLengthPrefix(operatorAddr)...)
+}
+
+// GetValidatorJailInfoKey creates the key for the jail info of the validator
+// with address
+// VALUE: staking/ValidatorJailInfo
+func GetValidatorJailInfoKey(operatorAddr sdk.ValAddress) []byte {
+	return append(ValidatorJailInfoKey, address.MustLengthPrefix(operatorAddr)...)
+}
+
+// GetStandbyConsPubKeyKey creates the key for the standby consensus pubkey of
+// the validator with address
+// VALUE: staking/StandbyConsPubKey
+func GetStandbyConsPubKeyKey(operatorAddr sdk.ValAddress) []byte {
+	return append(StandbyConsPubKeyKey, address.MustLengthPrefix(operatorAddr)...)
+}
+
+// GetValidatorByStandbyConsAddrKey creates the key for the validator with the
+// standby consensus address
+// VALUE: validator operator address ([]byte)
+func GetValidatorByStandbyConsAddrKey(addr sdk.ConsAddress) []byte {
+	return append(ValidatorsByStandbyConsKey, address.MustLengthPrefix(addr)...)
+}
+
+// GetStandbyActivationKey creates the key for the activation of the standby
+// consensus pubkey of the validator with address
+func GetStandbyActivationKey(operatorAddr sdk.ValAddress) []byte {
+	return append(StandbyActivationKey, address.MustLengthPrefix(operatorAddr)...)
+}
+
+// GetProfileAttestationsKey creates the prefix for the attestations of the
+// profile of the validator with address
+func GetProfileAttestationsKey(operatorAddr sdk.ValAddress) []byte {
+	return append(ProfileAttestationKey, address.MustLengthPrefix(operatorAddr)...)
+}
+
+// GetProfileAttestationKey creates the key for the attestation of a profile
+// field of a validator by an attester
+// VALUE: staking/ProfileAttestation
+func GetProfileAttestationKey(operatorAddr sdk.ValAddress, field string, attester sdk.AccAddress) []byte {
+	key := append(GetProfileAttestationsKey(operatorAddr), address.MustLengthPrefix([]byte(field))...)
+	return append(key, address.MustLengthPrefix(attester)...)
+}
+
+// GetCommissionChangeTimeKey returns a key prefix for indexing pending
+// commission changes based on their effective time.
+func GetCommissionChangeTimeKey(timestamp time.Time) []byte {
+	bz := sdk.FormatTimeBytes(timestamp)
+	return append(CommissionChangeQueueKey, bz...)
+}
+
+// GetDelegationLocksKey creates the prefix for the delegation locks of a
+// delegator.
+func GetDelegationLocksKey(delAddr sdk.AccAddress) []byte {
+	return append(DelegationLockKey, address.MustLengthPrefix(delAddr)...)
+}
+
+// GetDelegationLockKey creates the key for the lock of a delegation.
+// VALUE: staking/DelegationLock
+func GetDelegationLockKey(delAddr sdk.AccAddress, valAddr sdk.ValAddress) []byte {
+	return append(GetDelegationLocksKey(delAddr), address.MustLengthPrefix(valAddr)...)
+}
+
+// GetDelegationLockTimeKey returns a key prefix for indexing delegation locks
+// based on their end time.
+func GetDelegationLockTimeKey(timestamp time.Time) []byte {
+	bz := sdk.FormatTimeBytes(timestamp)
+	return append(DelegationLockQueueKey, bz...)
+}
+
 // GetLastValidatorPowerKey creates the bonded validator index key for an operator address
 func GetLastValidatorPowerKey(operator sdk.ValAddress) []byte {
 	return append(LastValidatorPowerKey, address.MustLengthPrefix(operator)...)
@@ -421,3 +531,39 @@ func GetHistoricalInfoKey(height int64) []byte {
 	binary.BigEndian.PutUint64(heightBytes, uint64(height))
 	return append(HistoricalInfoKey, heightBytes...)
 }
+
+// ParseHistoricalInfoKey returns the height of a HistoricalInfo key.
+func ParseHistoricalInfoKey(key []byte) int64 {
+	kv.AssertKeyLength(key, 1+8)
+	return int64(binary.BigEndian.Uint64(key[1:]))
+}
+
+// GetEpochMsgKey returns the key of a staking message queued until the end of
+// the epoch.
+func GetEpochMsgKey(id uint64) []byte {
+	return append(EpochMsgQueueKey, sdk.Uint64ToBigEndian(id)...)
+}
+
+// GetTokenizeShareRecordByIndexKey returns the key of a tokenize share record
+// by its id.
+func GetTokenizeShareRecordByIndexKey(id uint64) []byte {
+	return append(TokenizeShareRecordPrefix, sdk.Uint64ToBigEndian(id)...)
+}
+
+// GetTokenizeShareRecordIDsByOwnerPrefix returns the key prefix of the
+// tokenize share record ids owned by an address.
+func GetTokenizeShareRecordIDsByOwnerPrefix(owner sdk.AccAddress) []byte {
+	return append(TokenizeShareRecordIDByOwnerPrefix, address.MustLengthPrefix(owner)...)
+}
+
+// GetTokenizeShareRecordIDByOwnerAndIDKey returns the key indexing a tokenize
+// share record id by its owner.
+func GetTokenizeShareRecordIDByOwnerAndIDKey(owner sdk.AccAddress, id uint64) []byte {
+	return append(GetTokenizeShareRecordIDsByOwnerPrefix(owner), sdk.Uint64ToBigEndian(id)...)
+}
+
+// GetTokenizeShareRecordIDByDenomKey returns the key indexing a tokenize share
+// record id by the denom of its share tokens.
+func GetTokenizeShareRecordIDByDenomKey(denom string) []byte {
+	return append(TokenizeShareRecordIDByDenomPrefix, []byte(denom)...)
+}
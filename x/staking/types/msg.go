@@ -1,6 +1,8 @@
 package types
 
 import (
+	"time"
+
 	errorsmod "cosmossdk.io/errors"
 	"cosmossdk.io/math"
 
@@ -20,6 +22,19 @@ var (
 	_ sdk.Msg                            = &MsgBeginRedelegate{}
 	_ sdk.Msg                            = &MsgCancelUnbondingDelegation{}
 	_ sdk.Msg                            = &MsgUpdateParams{}
+	_ sdk.Msg                            = &MsgTokenizeShares{}
+	_ sdk.Msg                            = &MsgRedeemTokensForShares{}
+	_ sdk.Msg                            = &MsgTransferTokenizeShareRecord{}
+	_ sdk.Msg                            = &MsgValidatorBond{}
+	_ sdk.Msg                            = &MsgCancelRedelegation{}
+	_ sdk.Msg                            = &MsgLockDelegation{}
+	_ sdk.Msg                            = &MsgRedelegateMulti{}
+	_ sdk.Msg                            = &MsgInstantUndelegate{}
+	_ sdk.Msg                            = &MsgSetValidatorRiskTier{}
+	_ sdk.Msg                            = &MsgSetStakingPaused{}
+	_ sdk.Msg                            = &MsgAttestValidatorProfile{}
+	_ sdk.Msg                            = &MsgSetStandbyConsPubKey{}
+	_ codectypes.UnpackInterfacesMessage = (*MsgSetStandbyConsPubKey)(nil)
 
 	_ legacytx.LegacyMsg = &MsgCreateValidator{}
 	_ legacytx.LegacyMsg = &MsgEditValidator{}
@@ -28,6 +43,18 @@ var (
 	_ legacytx.LegacyMsg = &MsgBeginRedelegate{}
 	_ legacytx.LegacyMsg = &MsgCancelUnbondingDelegation{}
 	_ legacytx.LegacyMsg = &MsgUpdateParams{}
+	_ legacytx.LegacyMsg = &MsgTokenizeShares{}
+	_ legacytx.LegacyMsg = &MsgRedeemTokensForShares{}
+	_ legacytx.LegacyMsg = &MsgTransferTokenizeShareRecord{}
+	_ legacytx.LegacyMsg = &MsgValidatorBond{}
+	_ legacytx.LegacyMsg = &MsgCancelRedelegation{}
+	_ legacytx.LegacyMsg = &MsgLockDelegation{}
+	_ legacytx.LegacyMsg = &MsgRedelegateMulti{}
+	_ legacytx.LegacyMsg = &MsgInstantUndelegate{}
+	_ legacytx.LegacyMsg = &MsgSetValidatorRiskTier{}
+	_ legacytx.LegacyMsg = &MsgSetStakingPaused{}
+	_ legacytx.LegacyMsg = &MsgAttestValidatorProfile{}
+	_ legacytx.LegacyMsg = &MsgSetStandbyConsPubKey{}
 )
 
 // NewMsgCreateValidator creates a new MsgCreateValidator instance.
@@ -240,3 +267,268 @@ func (m MsgUpdateParams) GetSigners() []sdk.AccAddress {
 	addr, _ := sdk.AccAddressFromBech32(m.Authority)
 	return []sdk.AccAddress{addr}
 }
+
+// NewMsgTokenizeShares creates a new MsgTokenizeShares instance.
+func NewMsgTokenizeShares(delAddr sdk.AccAddress, valAddr sdk.ValAddress, amount sdk.Coin, owner sdk.AccAddress) *MsgTokenizeShares {
+	return &MsgTokenizeShares{
+		DelegatorAddress:    delAddr.String(),
+		ValidatorAddress:    valAddr.String(),
+		Amount:              amount,
+		TokenizedShareOwner: owner.String(),
+	}
+}
+
+// GetSigners implements the sdk.Msg interface.
+func (msg MsgTokenizeShares) GetSigners() []sdk.AccAddress {
+	delegator, _ := sdk.AccAddressFromBech32(msg.DelegatorAddress)
+	return []sdk.AccAddress{delegator}
+}
+
+// GetSignBytes implements the sdk.Msg interface.
+func (msg MsgTokenizeShares) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&msg))
+}
+
+// NewMsgRedeemTokensForShares creates a new MsgRedeemTokensForShares instance.
+func NewMsgRedeemTokensForShares(delAddr sdk.AccAddress, amount sdk.Coin) *MsgRedeemTokensForShares {
+	return &MsgRedeemTokensForShares{
+		DelegatorAddress: delAddr.String(),
+		Amount:           amount,
+	}
+}
+
+// GetSigners implements the sdk.Msg interface.
+func (msg MsgRedeemTokensForShares) GetSigners() []sdk.AccAddress {
+	delegator, _ := sdk.AccAddressFromBech32(msg.DelegatorAddress)
+	return []sdk.AccAddress{delegator}
+}
+
+// GetSignBytes implements the sdk.Msg interface.
+func (msg MsgRedeemTokensForShares) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&msg))
+}
+
+// NewMsgTransferTokenizeShareRecord creates a new MsgTransferTokenizeShareRecord instance.
+func NewMsgTransferTokenizeShareRecord(recordID uint64, sender, newOwner sdk.AccAddress) *MsgTransferTokenizeShareRecord {
+	return &MsgTransferTokenizeShareRecord{
+		TokenizeShareRecordId: recordID,
+		Sender:                sender.String(),
+		NewOwner:              newOwner.String(),
+	}
+}
+
+// GetSigners implements the sdk.Msg interface.
+func (msg MsgTransferTokenizeShareRecord) GetSigners() []sdk.AccAddress {
+	sender, _ := sdk.AccAddressFromBech32(msg.Sender)
+	return []sdk.AccAddress{sender}
+}
+
+// GetSignBytes implements the sdk.Msg interface.
+func (msg MsgTransferTokenizeShareRecord) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&msg))
+}
+
+// NewMsgValidatorBond creates a new MsgValidatorBond instance.
+func NewMsgValidatorBond(delAddr sdk.AccAddress, valAddr sdk.ValAddress) *MsgValidatorBond {
+	return &MsgValidatorBond{
+		DelegatorAddress: delAddr.String(),
+		ValidatorAddress: valAddr.String(),
+	}
+}
+
+// GetSigners implements the sdk.Msg interface.
+func (msg MsgValidatorBond) GetSigners() []sdk.AccAddress {
+	delegator, _ := sdk.AccAddressFromBech32(msg.DelegatorAddress)
+	return []sdk.AccAddress{delegator}
+}
+
+// GetSignBytes implements the sdk.Msg interface.
+func (msg MsgValidatorBond) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&msg))
+}
+
+// NewMsgCancelRedelegation creates a new MsgCancelRedelegation instance.
+func NewMsgCancelRedelegation(
+	delAddr sdk.AccAddress, valSrcAddr, valDstAddr sdk.ValAddress, creationHeight int64, amount sdk.Coin,
+) *MsgCancelRedelegation {
+	return &MsgCancelRedelegation{
+		DelegatorAddress:    delAddr.String(),
+		ValidatorSrcAddress: valSrcAddr.String(),
+		ValidatorDstAddress: valDstAddr.String(),
+		Amount:              amount,
+		CreationHeight:      creationHeight,
+	}
+}
+
+// GetSigners implements the sdk.Msg interface.
+func (msg MsgCancelRedelegation) GetSigners() []sdk.AccAddress {
+	delegator, _ := sdk.AccAddressFromBech32(msg.DelegatorAddress)
+	return []sdk.AccAddress{delegator}
+}
+
+// GetSignBytes implements the sdk.Msg interface.
+func (msg MsgCancelRedelegation) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&msg))
+}
+
+// NewMsgLockDelegation creates a new MsgLockDelegation instance.
+func NewMsgLockDelegation(delAddr sdk.AccAddress, valAddr sdk.ValAddress, duration time.Duration) *MsgLockDelegation {
+	return &MsgLockDelegation{
+		DelegatorAddress: delAddr.String(),
+		ValidatorAddress: valAddr.String(),
+		Duration:         duration,
+	}
+}
+
+// GetSigners implements the sdk.Msg interface.
+func (msg MsgLockDelegation) GetSigners() []sdk.AccAddress {
+	delegator, _ := sdk.AccAddressFromBech32(msg.DelegatorAddress)
+	return []sdk.AccAddress{delegator}
+}
+
+// GetSignBytes implements the sdk.Msg interface.
+func (msg MsgLockDelegation) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&msg))
+}
+
+// NewMsgRedelegateMulti creates a new MsgRedelegateMulti instance.
+func NewMsgRedelegateMulti(delAddr sdk.AccAddress, valSrcAddr sdk.ValAddress, destinations ...RedelegationDestination) *MsgRedelegateMulti {
+	return &MsgRedelegateMulti{
+		DelegatorAddress:    delAddr.String(),
+		ValidatorSrcAddress: valSrcAddr.String(),
+		Destinations:        destinations,
+	}
+}
+
+// NewRedelegationDestination creates a new RedelegationDestination instance.
+func NewRedelegationDestination(valDstAddr sdk.ValAddress, amount sdk.Coin) RedelegationDestination {
+	return RedelegationDestination{
+		ValidatorDstAddress: valDstAddr.String(),
+		Amount:              amount,
+	}
+}
+
+// GetSigners implements the sdk.Msg interface.
+func (msg MsgRedelegateMulti) GetSigners() []sdk.AccAddress {
+	delegator, _ := sdk.AccAddressFromBech32(msg.DelegatorAddress)
+	return []sdk.AccAddress{delegator}
+}
+
+// GetSignBytes implements the sdk.Msg interface.
+func (msg MsgRedelegateMulti) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&msg))
+}
+
+// NewMsgInstantUndelegate creates a new MsgInstantUndelegate instance.
+func NewMsgInstantUndelegate(delAddr sdk.AccAddress, valAddr sdk.ValAddress, amount sdk.Coin) *MsgInstantUndelegate {
+	return &MsgInstantUndelegate{
+		DelegatorAddress: delAddr.String(),
+		ValidatorAddress: valAddr.String(),
+		Amount:           amount,
+	}
+}
+
+// GetSigners implements the sdk.Msg interface.
+func (msg MsgInstantUndelegate) GetSigners() []sdk.AccAddress {
+	delegator, _ := sdk.AccAddressFromBech32(msg.DelegatorAddress)
+	return []sdk.AccAddress{delegator}
+}
+
+// GetSignBytes implements the sdk.Msg interface.
+func (msg MsgInstantUndelegate) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&msg))
+}
+
+// NewMsgSetValidatorRiskTier creates a new MsgSetValidatorRiskTier instance.
+func NewMsgSetValidatorRiskTier(authority string, valAddr sdk.ValAddress, tier string) *MsgSetValidatorRiskTier {
+	return &MsgSetValidatorRiskTier{
+		Authority:        authority,
+		ValidatorAddress: valAddr.String(),
+		Tier:             tier,
+	}
+}
+
+// GetSigners implements the sdk.Msg interface.
+func (msg MsgSetValidatorRiskTier) GetSigners() []sdk.AccAddress {
+	addr, _ := sdk.AccAddressFromBech32(msg.Authority)
+	return []sdk.AccAddress{addr}
+}
+
+// GetSignBytes implements the sdk.Msg interface.
+func (msg MsgSetValidatorRiskTier) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&msg))
+}
+
+// NewMsgSetStakingPaused creates a new MsgSetStakingPaused instance.
+func NewMsgSetStakingPaused(authority string, paused bool) *MsgSetStakingPaused {
+	return &MsgSetStakingPaused{
+		Authority: authority,
+		Paused:    paused,
+	}
+}
+
+// GetSigners implements the sdk.Msg interface.
+func (msg MsgSetStakingPaused) GetSigners() []sdk.AccAddress {
+	addr, _ := sdk.AccAddressFromBech32(msg.Authority)
+	return []sdk.AccAddress{addr}
+}
+
+// GetSignBytes implements the sdk.Msg interface.
+func (msg MsgSetStakingPaused) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&msg))
+}
+
+// NewMsgAttestValidatorProfile creates a new MsgAttestValidatorProfile instance.
+func NewMsgAttestValidatorProfile(attester sdk.AccAddress, valAddr sdk.ValAddress, field, value string, verified bool) *MsgAttestValidatorProfile {
+	return &MsgAttestValidatorProfile{
+		Attester:         attester.String(),
+		ValidatorAddress: valAddr.String(),
+		Field:            field,
+		Value:            value,
+		Verified:         verified,
+	}
+}
+
+// GetSigners implements the sdk.Msg interface.
+func (msg MsgAttestValidatorProfile) GetSigners() []sdk.AccAddress {
+	attester, _ := sdk.AccAddressFromBech32(msg.Attester)
+	return []sdk.AccAddress{attester}
+}
+
+// GetSignBytes implements the sdk.Msg interface.
+func (msg MsgAttestValidatorProfile) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&msg))
+}
+
+// NewMsgSetStandbyConsPubKey creates a new MsgSetStandbyConsPubKey instance. A
+// nil pubkey removes the standby consensus pubkey of the validator.
+func NewMsgSetStandbyConsPubKey(valAddr sdk.ValAddress, pubKey cryptotypes.PubKey) (*MsgSetStandbyConsPubKey, error) {
+	var pkAny *codectypes.Any
+	if pubKey != nil {
+		var err error
+		if pkAny, err = codectypes.NewAnyWithValue(pubKey); err != nil {
+			return nil, err
+		}
+	}
+	return &MsgSetStandbyConsPubKey{
+		ValidatorAddress: valAddr.String(),
+		Pubkey:           pkAny,
+	}, nil
+}
+
+// GetSigners implements the sdk.Msg interface.
+func (msg MsgSetStandbyConsPubKey) GetSigners() []sdk.AccAddress {
+	valAddr, _ := sdk.ValAddressFromBech32(msg.ValidatorAddress)
+	return []sdk.AccAddress{sdk.AccAddress(valAddr)}
+}
+
+// GetSignBytes implements the sdk.Msg interface.
+func (msg MsgSetStandbyConsPubKey) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&msg))
+}
+
+// UnpackInterfaces implements UnpackInterfacesMessage.UnpackInterfaces
+func (msg MsgSetStandbyConsPubKey) UnpackInterfaces(unpacker codectypes.AnyUnpacker) error {
+	var pubKey cryptotypes.PubKey
+	return unpacker.UnpackAny(msg.Pubkey, &pubKey)
+}
@@ -29,20 +29,81 @@ const (
 	// value by not adding the staking module to the application module manager's
 	// SetOrderBeginBlockers.
 	DefaultHistoricalEntries uint32 = 10000
+
+	// DefaultCommissionChangeNoticePeriod is zero, which applies commission
+	// rate changes immediately
+	DefaultCommissionChangeNoticePeriod time.Duration = 0
+
+	// DefaultMaxMatureUnbondingsPerBlock is zero, which completes all mature
+	// unbonding delegations and redelegations every block
+	DefaultMaxMatureUnbondingsPerBlock uint32 = 0
+
+	// DefaultEpochLength is zero, which applies the delegation, undelegation
+	// and redelegation messages immediately
+	DefaultEpochLength uint64 = 0
 )
 
-// DefaultMinCommissionRate is set to 0%
-var DefaultMinCommissionRate = math.LegacyZeroDec()
+var (
+	// DefaultMinCommissionRate is set to 0%
+	DefaultMinCommissionRate = math.LegacyZeroDec()
+
+	// DefaultValidatorBondFactor is set to -1, which disables the validator
+	// bond requirement
+	DefaultValidatorBondFactor = math.LegacyNewDec(-1)
+
+	// DefaultGlobalLiquidStakingCap is set to 100%, which disables the cap
+	DefaultGlobalLiquidStakingCap = math.LegacyOneDec()
+
+	// DefaultValidatorLiquidStakingCap is set to 100%, which disables the cap
+	DefaultValidatorLiquidStakingCap = math.LegacyOneDec()
+
+	// DefaultMinSelfDelegationFloor is zero, which only enforces the minimum
+	// self delegation declared by each validator
+	DefaultMinSelfDelegationFloor = math.ZeroInt()
+
+	// DefaultInstantUndelegationFeeRate is set to 0%
+	DefaultInstantUndelegationFeeRate = math.LegacyZeroDec()
+
+	// DefaultInstantUndelegationBudgetPerBlock is zero, which disables instant
+	// undelegations
+	DefaultInstantUndelegationBudgetPerBlock = math.ZeroInt()
+
+	// DefaultMinDelegationAmount is zero, which disables the minimum
+	// delegation amount
+	DefaultMinDelegationAmount = math.ZeroInt()
+)
 
 // NewParams creates a new Params instance
-func NewParams(unbondingTime time.Duration, maxValidators, maxEntries, historicalEntries uint32, bondDenom string, minCommissionRate math.LegacyDec) Params {
+func NewParams(
+	unbondingTime time.Duration,
+	maxValidators, maxEntries, historicalEntries uint32,
+	bondDenom string,
+	minCommissionRate, validatorBondFactor, globalLiquidStakingCap, validatorLiquidStakingCap math.LegacyDec,
+	commissionChangeNoticePeriod time.Duration,
+	maxMatureUnbondingsPerBlock uint32,
+	minSelfDelegationFloor math.Int,
+	epochLength uint64,
+	instantUndelegationFeeRate math.LegacyDec,
+	instantUndelegationBudgetPerBlock math.Int,
+) Params {
 	return Params{
-		UnbondingTime:     unbondingTime,
-		MaxValidators:     maxValidators,
-		MaxEntries:        maxEntries,
-		HistoricalEntries: historicalEntries,
-		BondDenom:         bondDenom,
-		MinCommissionRate: minCommissionRate,
+		UnbondingTime:                unbondingTime,
+		MaxValidators:                maxValidators,
+		MaxEntries:                   maxEntries,
+		HistoricalEntries:            historicalEntries,
+		BondDenom:                    bondDenom,
+		MinCommissionRate:            minCommissionRate,
+		ValidatorBondFactor:          validatorBondFactor,
+		GlobalLiquidStakingCap:       globalLiquidStakingCap,
+		ValidatorLiquidStakingCap:    validatorLiquidStakingCap,
+		CommissionChangeNoticePeriod: commissionChangeNoticePeriod,
+		MaxMatureUnbondingsPerBlock:  maxMatureUnbondingsPerBlock,
+		MinSelfDelegationFloor:       minSelfDelegationFloor,
+		EpochLength:                  epochLength,
+
+		InstantUndelegationFeeRate:        instantUndelegationFeeRate,
+		InstantUndelegationBudgetPerBlock: instantUndelegationBudgetPerBlock,
+		MinDelegationAmount:               DefaultMinDelegationAmount,
 	}
 }
 
@@ -55,6 +116,15 @@ func DefaultParams() Params {
 		DefaultHistoricalEntries,
 		sdk.DefaultBondDenom,
 		DefaultMinCommissionRate,
+		DefaultValidatorBondFactor,
+		DefaultGlobalLiquidStakingCap,
+		DefaultValidatorLiquidStakingCap,
+		DefaultCommissionChangeNoticePeriod,
+		DefaultMaxMatureUnbondingsPerBlock,
+		DefaultMinSelfDelegationFloor,
+		DefaultEpochLength,
+		DefaultInstantUndelegationFeeRate,
+		DefaultInstantUndelegationBudgetPerBlock,
 	)
 }
 
@@ -104,9 +174,94 @@ func (p Params) Validate() error {
 		return err
 	}
 
+	if err := validateValidatorBondFactor(p.ValidatorBondFactor); err != nil {
+		return err
+	}
+
+	if err := validateLiquidStakingCap(p.GlobalLiquidStakingCap); err != nil {
+		return err
+	}
+
+	if err := validateLiquidStakingCap(p.ValidatorLiquidStakingCap); err != nil {
+		return err
+	}
+
+	if err := validateCommissionChangeNoticePeriod(p.CommissionChangeNoticePeriod); err != nil {
+		return err
+	}
+
+	if err := validateBondDenomWeights(p.BondDenomWeights, p.BondDenom); err != nil {
+		return err
+	}
+
+	if err := validateMinSelfDelegationFloor(p.MinSelfDelegationFloor); err != nil {
+		return err
+	}
+
+	if err := validateLockupTerms(p.LockupTerms); err != nil {
+		return err
+	}
+
+	if err := validateInstantUndelegationFeeRate(p.InstantUndelegationFeeRate); err != nil {
+		return err
+	}
+
+	if err := validateInstantUndelegationBudgetPerBlock(p.InstantUndelegationBudgetPerBlock); err != nil {
+		return err
+	}
+
+	if err := validateUnbondingTiers(p.UnbondingTiers); err != nil {
+		return err
+	}
+
+	if err := validateMinDelegationAmount(p.MinDelegationAmount); err != nil {
+		return err
+	}
+
 	return nil
 }
 
+// DenomWeight returns the number of staking tokens credited per unit of the
+// given denom, and whether the denom can be bonded. The bond denom has a
+// weight of one.
+func (p Params) DenomWeight(denom string) (math.LegacyDec, bool) {
+	if denom == p.BondDenom {
+		return math.LegacyOneDec(), true
+	}
+
+	for _, w := range p.BondDenomWeights {
+		if w.Denom == denom {
+			return w.Weight, true
+		}
+	}
+
+	return math.LegacyDec{}, false
+}
+
+// LockupTerm returns the lockup term with the given duration, and whether
+// delegations can be locked for that duration.
+func (p Params) LockupTerm(duration time.Duration) (LockupTerm, bool) {
+	for _, term := range p.LockupTerms {
+		if term.Duration == duration {
+			return term, true
+		}
+	}
+
+	return LockupTerm{}, false
+}
+
+// UnbondingTier returns the unbonding tier with the given name, and whether it
+// exists.
+func (p Params) UnbondingTier(name string) (UnbondingTier, bool) {
+	for _, tier := range p.UnbondingTiers {
+		if tier.Name == name {
+			return tier, true
+		}
+	}
+
+	return UnbondingTier{}, false
+}
+
 func validateUnbondingTime(i interface{}) error {
 	v, ok := i.(time.Duration)
 	if !ok {
@@ -120,6 +275,19 @@ func validateUnbondingTime(i interface{}) error {
 	return nil
 }
 
+func validateCommissionChangeNoticePeriod(i interface{}) error {
+	v, ok := i.(time.Duration)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if v < 0 {
+		return fmt.Errorf("commission change notice period cannot be negative: %d", v)
+	}
+
+	return nil
+}
+
 func validateMaxValidators(i interface{}) error {
 	v, ok := i.(uint32)
 	if !ok {
@@ -203,3 +371,165 @@ func validateMinCommissionRate(i interface{}) error {
 
 	return nil
 }
+
+func validateValidatorBondFactor(i interface{}) error {
+	v, ok := i.(math.LegacyDec)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if v.IsNil() {
+		return fmt.Errorf("validator bond factor cannot be nil: %s", v)
+	}
+	if v.IsNegative() && !v.Equal(math.LegacyNewDec(-1)) {
+		return fmt.Errorf("invalid validator bond factor: %s, must be either -1 or non-negative", v)
+	}
+
+	return nil
+}
+
+func validateLiquidStakingCap(i interface{}) error {
+	v, ok := i.(math.LegacyDec)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if v.IsNil() {
+		return fmt.Errorf("liquid staking cap cannot be nil: %s", v)
+	}
+	if v.IsNegative() {
+		return fmt.Errorf("liquid staking cap cannot be negative: %s", v)
+	}
+	if v.GT(math.LegacyOneDec()) {
+		return fmt.Errorf("liquid staking cap cannot be greater than 100%%: %s", v)
+	}
+
+	return nil
+}
+
+func validateMinSelfDelegationFloor(i interface{}) error {
+	v, ok := i.(math.Int)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if v.IsNil() {
+		return fmt.Errorf("min self delegation floor cannot be nil: %s", v)
+	}
+	if v.IsNegative() {
+		return fmt.Errorf("min self delegation floor cannot be negative: %s", v)
+	}
+
+	return nil
+}
+
+func validateInstantUndelegationFeeRate(i interface{}) error {
+	v, ok := i.(math.LegacyDec)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if v.IsNil() {
+		return fmt.Errorf("instant undelegation fee rate cannot be nil: %s", v)
+	}
+	if v.IsNegative() {
+		return fmt.Errorf("instant undelegation fee rate cannot be negative: %s", v)
+	}
+	if v.GT(math.LegacyOneDec()) {
+		return fmt.Errorf("instant undelegation fee rate cannot be greater than 100%%: %s", v)
+	}
+
+	return nil
+}
+
+func validateInstantUndelegationBudgetPerBlock(i interface{}) error {
+	v, ok := i.(math.Int)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if v.IsNil() {
+		return fmt.Errorf("instant undelegation budget per block cannot be nil: %s", v)
+	}
+	if v.IsNegative() {
+		return fmt.Errorf("instant undelegation budget per block cannot be negative: %s", v)
+	}
+
+	return nil
+}
+
+func validateMinDelegationAmount(i interface{}) error {
+	v, ok := i.(math.Int)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if v.IsNil() {
+		return fmt.Errorf("min delegation amount cannot be nil: %s", v)
+	}
+	if v.IsNegative() {
+		return fmt.Errorf("min delegation amount cannot be negative: %s", v)
+	}
+
+	return nil
+}
+
+func validateBondDenomWeights(weights []BondDenomWeight, bondDenom string) error {
+	seen := make(map[string]bool, len(weights))
+	for _, w := range weights {
+		if err := sdk.ValidateDenom(w.Denom); err != nil {
+			return err
+		}
+		if w.Denom == bondDenom {
+			return fmt.Errorf("bond denom weight cannot be set for the bond denom: %s", w.Denom)
+		}
+		if seen[w.Denom] {
+			return fmt.Errorf("duplicate bond denom weight: %s", w.Denom)
+		}
+		seen[w.Denom] = true
+
+		if w.Weight.IsNil() || !w.Weight.IsPositive() {
+			return fmt.Errorf("bond denom weight must be positive: %s", w.Weight)
+		}
+	}
+
+	return nil
+}
+
+func validateLockupTerms(terms []LockupTerm) error {
+	seen := make(map[time.Duration]bool, len(terms))
+	for _, term := range terms {
+		if term.Duration <= 0 {
+			return fmt.Errorf("lockup term duration must be positive: %s", term.Duration)
+		}
+		if seen[term.Duration] {
+			return fmt.Errorf("duplicate lockup term: %s", term.Duration)
+		}
+		seen[term.Duration] = true
+
+		if term.RewardMultiplier.IsNil() || term.RewardMultiplier.LT(math.LegacyOneDec()) {
+			return fmt.Errorf("lockup term reward multiplier must be at least one: %s", term.RewardMultiplier)
+		}
+	}
+
+	return nil
+}
+
+func validateUnbondingTiers(tiers []UnbondingTier) error {
+	seen := make(map[string]bool, len(tiers))
+	for _, tier := range tiers {
+		if strings.TrimSpace(tier.Name) == "" {
+			return fmt.Errorf("unbonding tier name cannot be blank")
+		}
+		if seen[tier.Name] {
+			return fmt.Errorf("duplicate unbonding tier: %s", tier.Name)
+		}
+		seen[tier.Name] = true
+
+		if tier.UnbondingTime <= 0 {
+			return fmt.Errorf("unbonding tier %s unbonding time must be positive: %s", tier.Name, tier.UnbondingTime)
+		}
+	}
+
+	return nil
+}
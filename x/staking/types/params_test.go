@@ -2,6 +2,7 @@ package types_test
 
 import (
 	"testing"
+	"time"
 
 	"cosmossdk.io/math"
 	"github.com/stretchr/testify/require"
@@ -35,4 +36,96 @@ func TestValidateParams(t *testing.T) {
 
 	params.MinCommissionRate = math.LegacyNewDec(2)
 	require.Error(t, params.Validate())
+
+	// validate min self delegation floor
+	params = types.DefaultParams()
+	params.MinSelfDelegationFloor = math.NewInt(-1)
+	require.Error(t, params.Validate())
+}
+
+func TestValidateLiquidStakingParams(t *testing.T) {
+	params := types.DefaultParams()
+
+	// validator bond factor is either disabled (-1) or non negative
+	params.ValidatorBondFactor = math.LegacyNewDec(250)
+	require.NoError(t, params.Validate())
+
+	params.ValidatorBondFactor = math.LegacyNewDecWithPrec(-5, 1)
+	require.Error(t, params.Validate())
+
+	params = types.DefaultParams()
+
+	// liquid staking caps are fractions
+	params.GlobalLiquidStakingCap = math.LegacyNewDecWithPrec(25, 2)
+	params.ValidatorLiquidStakingCap = math.LegacyZeroDec()
+	require.NoError(t, params.Validate())
+
+	params.GlobalLiquidStakingCap = math.LegacyNewDecWithPrec(11, 1)
+	require.Error(t, params.Validate())
+
+	params = types.DefaultParams()
+	params.ValidatorLiquidStakingCap = math.LegacyNewDec(-1)
+	require.Error(t, params.Validate())
+}
+
+func TestValidateBondDenomWeights(t *testing.T) {
+	params := types.DefaultParams()
+	params.BondDenomWeights = []types.BondDenomWeight{
+		{Denom: "uatom", Weight: math.LegacyNewDecWithPrec(5, 1)},
+		{Denom: "uosmo", Weight: math.LegacyNewDec(2)},
+	}
+	require.NoError(t, params.Validate())
+
+	weight, found := params.DenomWeight("uosmo")
+	require.True(t, found)
+	require.Equal(t, math.LegacyNewDec(2), weight)
+
+	weight, found = params.DenomWeight(params.BondDenom)
+	require.True(t, found)
+	require.Equal(t, math.LegacyOneDec(), weight)
+
+	_, found = params.DenomWeight("ujuno")
+	require.False(t, found)
+
+	// the bond denom cannot be weighted
+	params.BondDenomWeights[1].Denom = params.BondDenom
+	require.Error(t, params.Validate())
+
+	// the denoms are distinct
+	params.BondDenomWeights[1].Denom = "uatom"
+	require.Error(t, params.Validate())
+
+	// the weights are positive
+	params.BondDenomWeights[1] = types.BondDenomWeight{Denom: "uosmo", Weight: math.LegacyZeroDec()}
+	require.Error(t, params.Validate())
+}
+
+func TestValidateLockupTerms(t *testing.T) {
+	month := 30 * 24 * time.Hour
+
+	params := types.DefaultParams()
+	params.LockupTerms = []types.LockupTerm{
+		{Duration: month, RewardMultiplier: math.LegacyNewDecWithPrec(11, 1)},
+		{Duration: 12 * month, RewardMultiplier: math.LegacyNewDec(2)},
+	}
+	require.NoError(t, params.Validate())
+
+	term, found := params.LockupTerm(12 * month)
+	require.True(t, found)
+	require.Equal(t, math.LegacyNewDec(2), term.RewardMultiplier)
+
+	_, found = params.LockupTerm(3 * month)
+	require.False(t, found)
+
+	// the durations are distinct
+	params.LockupTerms[1].Duration = month
+	require.Error(t, params.Validate())
+
+	// the durations are positive
+	params.LockupTerms[1].Duration = 0
+	require.Error(t, params.Validate())
+
+	// the multipliers do not reduce rewards
+	params.LockupTerms[1] = types.LockupTerm{Duration: 12 * month, RewardMultiplier: math.LegacyNewDecWithPrec(9, 1)}
+	require.Error(t, params.Validate())
 }
@@ -0,0 +1,173 @@
+package types
+
+import (
+	"net/url"
+	"regexp"
+
+	"cosmossdk.io/errors"
+
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+const (
+	MaxLogoURILength        = 256
+	MaxSecurityPolicyLength = 280
+	MaxMEVPolicyLength      = 280
+	MaxRegionLength         = 64
+	MaxWebsiteProofLength   = 140
+)
+
+// Names of the fields of a validator profile, as attested by the profile
+// attestations.
+const (
+	ProfileFieldLogoURI        = "logo_uri"
+	ProfileFieldSecurityPolicy = "security_policy"
+	ProfileFieldMEVPolicy      = "mev_policy"
+	ProfileFieldRegion         = "region"
+	ProfileFieldWebsiteProof   = "website_proof"
+)
+
+// ProfileFields are the names of the fields of a validator profile.
+var ProfileFields = []string{
+	ProfileFieldLogoURI,
+	ProfileFieldSecurityPolicy,
+	ProfileFieldMEVPolicy,
+	ProfileFieldRegion,
+	ProfileFieldWebsiteProof,
+}
+
+// IsProfileField returns true if field is the name of a field of a validator
+// profile.
+func IsProfileField(field string) bool {
+	for _, f := range ProfileFields {
+		if f == field {
+			return true
+		}
+	}
+
+	return false
+}
+
+// regionRegex matches the regions made of lowercase letters and digits,
+// separated by single dashes.
+var regionRegex = regexp.MustCompile(`^[a-z0-9]+(-[a-z0-9]+)*$`)
+
+// NewValidatorProfile creates a new ValidatorProfile.
+func NewValidatorProfile(logoURI, securityPolicy, mevPolicy, region, websiteProof string) *ValidatorProfile {
+	return &ValidatorProfile{
+		LogoUri:        logoURI,
+		SecurityPolicy: securityPolicy,
+		MevPolicy:      mevPolicy,
+		Region:         region,
+		WebsiteProof:   websiteProof,
+	}
+}
+
+// IsEmpty returns true if none of the fields of the profile is set.
+func (p *ValidatorProfile) IsEmpty() bool {
+	return p == nil || (p.LogoUri == "" && p.SecurityPolicy == "" && p.MevPolicy == "" && p.Region == "" && p.WebsiteProof == "")
+}
+
+// FieldValue returns the value of the given field of the profile, empty if the
+// profile is nil.
+func (p *ValidatorProfile) FieldValue(field string) string {
+	if p == nil {
+		return ""
+	}
+
+	switch field {
+	case ProfileFieldLogoURI:
+		return p.LogoUri
+	case ProfileFieldSecurityPolicy:
+		return p.SecurityPolicy
+	case ProfileFieldMEVPolicy:
+		return p.MevPolicy
+	case ProfileFieldRegion:
+		return p.Region
+	case ProfileFieldWebsiteProof:
+		return p.WebsiteProof
+	default:
+		return ""
+	}
+}
+
+// UpdateProfile updates the fields of a given profile, keeping the ones of p2
+// set to DoNotModifyDesc. A nil p2 leaves the profile unmodified, and nil is
+// returned if the resulting profile is empty.
+func (p *ValidatorProfile) UpdateProfile(p2 *ValidatorProfile) *ValidatorProfile {
+	if p2 == nil {
+		return p
+	}
+
+	current := ValidatorProfile{}
+	if p != nil {
+		current = *p
+	}
+
+	updated := *p2
+	if updated.LogoUri == DoNotModifyDesc {
+		updated.LogoUri = current.LogoUri
+	}
+
+	if updated.SecurityPolicy == DoNotModifyDesc {
+		updated.SecurityPolicy = current.SecurityPolicy
+	}
+
+	if updated.MevPolicy == DoNotModifyDesc {
+		updated.MevPolicy = current.MevPolicy
+	}
+
+	if updated.Region == DoNotModifyDesc {
+		updated.Region = current.Region
+	}
+
+	if updated.WebsiteProof == DoNotModifyDesc {
+		updated.WebsiteProof = current.WebsiteProof
+	}
+
+	if updated.IsEmpty() {
+		return nil
+	}
+
+	return &updated
+}
+
+// Validate checks the length and format of the fields of a validator profile.
+func (p *ValidatorProfile) Validate() error {
+	if p == nil {
+		return nil
+	}
+
+	if len(p.LogoUri) > MaxLogoURILength {
+		return errors.Wrapf(sdkerrors.ErrInvalidRequest, "invalid logo uri length; got: %d, max: %d", len(p.LogoUri), MaxLogoURILength)
+	}
+
+	if p.LogoUri != "" {
+		uri, err := url.Parse(p.LogoUri)
+		if err != nil || uri.Host == "" || (uri.Scheme != "https" && uri.Scheme != "ipfs") {
+			return errors.Wrapf(sdkerrors.ErrInvalidRequest, "invalid logo uri %q; must be an https or ipfs uri", p.LogoUri)
+		}
+	}
+
+	if len(p.SecurityPolicy) > MaxSecurityPolicyLength {
+		return errors.Wrapf(sdkerrors.ErrInvalidRequest, "invalid security policy length; got: %d, max: %d", len(p.SecurityPolicy), MaxSecurityPolicyLength)
+	}
+
+	if len(p.MevPolicy) > MaxMEVPolicyLength {
+		return errors.Wrapf(sdkerrors.ErrInvalidRequest, "invalid mev policy length; got: %d, max: %d", len(p.MevPolicy), MaxMEVPolicyLength)
+	}
+
+	if len(p.Region) > MaxRegionLength {
+		return errors.Wrapf(sdkerrors.ErrInvalidRequest, "invalid region length; got: %d, max: %d", len(p.Region), MaxRegionLength)
+	}
+
+	if p.Region != "" && !regionRegex.MatchString(p.Region) {
+		return errors.Wrapf(sdkerrors.ErrInvalidRequest, "invalid region %q; must be lowercase letters, digits and dashes", p.Region)
+	}
+
+	if len(p.WebsiteProof) > MaxWebsiteProofLength {
+		return errors.Wrapf(sdkerrors.ErrInvalidRequest, "invalid website proof length; got: %d, max: %d", len(p.WebsiteProof), MaxWebsiteProofLength)
+	}
+
+	return nil
+}
@@ -108,6 +108,88 @@ func (Infraction) EnumDescriptor() ([]byte, []int) {
 	return fileDescriptor_64c30c6cf92913c9, []int{1}
 }
 
+// JailReason indicates why a validator was jailed.
+//
+// Since: cosmos-sdk 0.48
+type JailReason int32
+
+const (
+	// UNSPECIFIED defines a validator jailed for an unspecified reason.
+	JailReason_JAIL_REASON_UNSPECIFIED JailReason = 0
+	// DOWNTIME defines a validator which missed signing too many blocks.
+	JailReason_JAIL_REASON_DOWNTIME JailReason = 1
+	// DOUBLE_SIGN defines a validator which double-signed a block.
+	JailReason_JAIL_REASON_DOUBLE_SIGN JailReason = 2
+	// SELF_BOND_SHORTFALL defines a validator whose self delegation fell below
+	// its minimum self delegation.
+	JailReason_JAIL_REASON_SELF_BOND_SHORTFALL JailReason = 3
+	// GOVERNANCE defines a validator jailed by a governance action.
+	JailReason_JAIL_REASON_GOVERNANCE JailReason = 4
+)
+
+var JailReason_name = map[int32]string{
+	0: "JAIL_REASON_UNSPECIFIED",
+	1: "JAIL_REASON_DOWNTIME",
+	2: "JAIL_REASON_DOUBLE_SIGN",
+	3: "JAIL_REASON_SELF_BOND_SHORTFALL",
+	4: "JAIL_REASON_GOVERNANCE",
+}
+
+var JailReason_value = map[string]int32{
+	"JAIL_REASON_UNSPECIFIED":         0,
+	"JAIL_REASON_DOWNTIME":            1,
+	"JAIL_REASON_DOUBLE_SIGN":         2,
+	"JAIL_REASON_SELF_BOND_SHORTFALL": 3,
+	"JAIL_REASON_GOVERNANCE":          4,
+}
+
+func (x JailReason) String() string {
+	return proto.EnumName(JailReason_name, int32(x))
+}
+
+func (JailReason) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_64c30c6cf92913c9, []int{2}
+}
+
+// ValidatorSetChangeType indicates how a validator set changed between two
+// heights.
+//
+// Since: cosmos-sdk 0.48
+type ValidatorSetChangeType int32
+
+const (
+	// UNSPECIFIED defines a no-op change type.
+	ValidatorSetChangeType_VALIDATOR_SET_CHANGE_TYPE_UNSPECIFIED ValidatorSetChangeType = 0
+	// JOIN defines a validator which joined the validator set.
+	ValidatorSetChangeType_VALIDATOR_SET_CHANGE_TYPE_JOIN ValidatorSetChangeType = 1
+	// EXIT defines a validator which left the validator set.
+	ValidatorSetChangeType_VALIDATOR_SET_CHANGE_TYPE_EXIT ValidatorSetChangeType = 2
+	// POWER defines a validator of the validator set whose voting power changed.
+	ValidatorSetChangeType_VALIDATOR_SET_CHANGE_TYPE_POWER ValidatorSetChangeType = 3
+)
+
+var ValidatorSetChangeType_name = map[int32]string{
+	0: "VALIDATOR_SET_CHANGE_TYPE_UNSPECIFIED",
+	1: "VALIDATOR_SET_CHANGE_TYPE_JOIN",
+	2: "VALIDATOR_SET_CHANGE_TYPE_EXIT",
+	3: "VALIDATOR_SET_CHANGE_TYPE_POWER",
+}
+
+var ValidatorSetChangeType_value = map[string]int32{
+	"VALIDATOR_SET_CHANGE_TYPE_UNSPECIFIED": 0,
+	"VALIDATOR_SET_CHANGE_TYPE_JOIN":        1,
+	"VALIDATOR_SET_CHANGE_TYPE_EXIT":        2,
+	"VALIDATOR_SET_CHANGE_TYPE_POWER":       3,
+}
+
+func (x ValidatorSetChangeType) String() string {
+	return proto.EnumName(ValidatorSetChangeType_name, int32(x))
+}
+
+func (ValidatorSetChangeType) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_64c30c6cf92913c9, []int{3}
+}
+
 // HistoricalInfo contains header and validator information for a given block.
 // It is stored as part of staking module's state, which persists the `n` most
 // recent HistoricalInfo
@@ -268,6 +350,10 @@ type Description struct {
 	SecurityContact string `protobuf:"bytes,4,opt,name=security_contact,json=securityContact,proto3" json:"security_contact,omitempty"`
 	// details define other optional details.
 	Details string `protobuf:"bytes,5,opt,name=details,proto3" json:"details,omitempty"`
+	// profile defines the optional structured profile of the validator.
+	//
+	// Since: cosmos-sdk 0.48
+	Profile *ValidatorProfile `protobuf:"bytes,6,opt,name=profile,proto3" json:"profile,omitempty"`
 }
 
 func (m *Description) Reset()         { *m = Description{} }
@@ -338,6 +424,198 @@ func (m *Description) GetDetails() string {
 	return ""
 }
 
+func (m *Description) GetProfile() *ValidatorProfile {
+	if m != nil {
+		return m.Profile
+	}
+	return nil
+}
+
+// ValidatorProfile defines the structured, size-limited profile metadata of a
+// validator, validated when the validator is created or edited.
+//
+// Since: cosmos-sdk 0.48
+type ValidatorProfile struct {
+	// logo_uri defines the https or ipfs URI of the validator logo.
+	LogoUri string `protobuf:"bytes,1,opt,name=logo_uri,json=logoUri,proto3" json:"logo_uri,omitempty"`
+	// security_policy defines the security contact policy of the validator,
+	// e.g. its disclosure process or a link to it.
+	SecurityPolicy string `protobuf:"bytes,2,opt,name=security_policy,json=securityPolicy,proto3" json:"security_policy,omitempty"`
+	// mev_policy defines the MEV policy of the validator.
+	MevPolicy string `protobuf:"bytes,3,opt,name=mev_policy,json=mevPolicy,proto3" json:"mev_policy,omitempty"`
+	// region defines the region the validator infrastructure runs in, made of
+	// lowercase letters, digits and dashes (ex. eu-west).
+	Region string `protobuf:"bytes,4,opt,name=region,proto3" json:"region,omitempty"`
+	// website_proof defines a proof of the control of the website by the
+	// validator, e.g. a token also published in a DNS TXT record of its domain,
+	// checked off-chain by the profile attesters.
+	WebsiteProof string `protobuf:"bytes,5,opt,name=website_proof,json=websiteProof,proto3" json:"website_proof,omitempty"`
+}
+
+func (m *ValidatorProfile) Reset()         { *m = ValidatorProfile{} }
+func (m *ValidatorProfile) String() string { return proto.CompactTextString(m) }
+func (*ValidatorProfile) ProtoMessage()    {}
+func (*ValidatorProfile) Descriptor() ([]byte, []int) {
+	return fileDescriptor_64c30c6cf92913c9, []int{4}
+}
+func (m *ValidatorProfile) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ValidatorProfile) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ValidatorProfile.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ValidatorProfile) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ValidatorProfile.Merge(m, src)
+}
+func (m *ValidatorProfile) XXX_Size() int {
+	return m.Size()
+}
+func (m *ValidatorProfile) XXX_DiscardUnknown() {
+	xxx_messageInfo_ValidatorProfile.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ValidatorProfile proto.InternalMessageInfo
+
+func (m *ValidatorProfile) GetLogoUri() string {
+	if m != nil {
+		return m.LogoUri
+	}
+	return ""
+}
+
+func (m *ValidatorProfile) GetSecurityPolicy() string {
+	if m != nil {
+		return m.SecurityPolicy
+	}
+	return ""
+}
+
+func (m *ValidatorProfile) GetMevPolicy() string {
+	if m != nil {
+		return m.MevPolicy
+	}
+	return ""
+}
+
+func (m *ValidatorProfile) GetRegion() string {
+	if m != nil {
+		return m.Region
+	}
+	return ""
+}
+
+func (m *ValidatorProfile) GetWebsiteProof() string {
+	if m != nil {
+		return m.WebsiteProof
+	}
+	return ""
+}
+
+// ProfileAttestation defines the attestation of a field of the profile of a
+// validator by an attester, e.g. an off-chain DNS TXT proof checker, accepted
+// by the profile verifier registered for the field.
+//
+// Since: cosmos-sdk 0.48
+type ProfileAttestation struct {
+	// validator_address is the address of the validator.
+	ValidatorAddress string `protobuf:"bytes,1,opt,name=validator_address,json=validatorAddress,proto3" json:"validator_address,omitempty"`
+	// field is the name of the attested profile field (ex. website_proof).
+	Field string `protobuf:"bytes,2,opt,name=field,proto3" json:"field,omitempty"`
+	// attester is the address of the attester.
+	Attester string `protobuf:"bytes,3,opt,name=attester,proto3" json:"attester,omitempty"`
+	// value is the value of the field at the time of the attestation.
+	Value string `protobuf:"bytes,4,opt,name=value,proto3" json:"value,omitempty"`
+	// verified is true if the attester verified the value, false if it found it
+	// invalid.
+	Verified bool `protobuf:"varint,5,opt,name=verified,proto3" json:"verified,omitempty"`
+	// height is the height at which the attestation was submitted.
+	Height int64 `protobuf:"varint,6,opt,name=height,proto3" json:"height,omitempty"`
+}
+
+func (m *ProfileAttestation) Reset()         { *m = ProfileAttestation{} }
+func (m *ProfileAttestation) String() string { return proto.CompactTextString(m) }
+func (*ProfileAttestation) ProtoMessage()    {}
+func (*ProfileAttestation) Descriptor() ([]byte, []int) {
+	return fileDescriptor_64c30c6cf92913c9, []int{5}
+}
+func (m *ProfileAttestation) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ProfileAttestation) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ProfileAttestation.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ProfileAttestation) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ProfileAttestation.Merge(m, src)
+}
+func (m *ProfileAttestation) XXX_Size() int {
+	return m.Size()
+}
+func (m *ProfileAttestation) XXX_DiscardUnknown() {
+	xxx_messageInfo_ProfileAttestation.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ProfileAttestation proto.InternalMessageInfo
+
+func (m *ProfileAttestation) GetValidatorAddress() string {
+	if m != nil {
+		return m.ValidatorAddress
+	}
+	return ""
+}
+
+func (m *ProfileAttestation) GetField() string {
+	if m != nil {
+		return m.Field
+	}
+	return ""
+}
+
+func (m *ProfileAttestation) GetAttester() string {
+	if m != nil {
+		return m.Attester
+	}
+	return ""
+}
+
+func (m *ProfileAttestation) GetValue() string {
+	if m != nil {
+		return m.Value
+	}
+	return ""
+}
+
+func (m *ProfileAttestation) GetVerified() bool {
+	if m != nil {
+		return m.Verified
+	}
+	return false
+}
+
+func (m *ProfileAttestation) GetHeight() int64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
 // Validator defines a validator, together with the total amount of the
 // Validator's bond shares and their exchange rate to coins. Slashing results in
 // a decrease in the exchange rate, allowing correct calculation of future
@@ -375,13 +653,29 @@ type Validator struct {
 	UnbondingOnHoldRefCount int64 `protobuf:"varint,12,opt,name=unbonding_on_hold_ref_count,json=unbondingOnHoldRefCount,proto3" json:"unbonding_on_hold_ref_count,omitempty"`
 	// list of unbonding ids, each uniquely identifing an unbonding of this validator
 	UnbondingIds []uint64 `protobuf:"varint,13,rep,packed,name=unbonding_ids,json=unbondingIds,proto3" json:"unbonding_ids,omitempty"`
+	// validator_bond_shares is the number of shares of the delegations flagged as
+	// validator bond.
+	//
+	// Since: cosmos-sdk 0.48
+	ValidatorBondShares github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,14,opt,name=validator_bond_shares,json=validatorBondShares,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"validator_bond_shares"`
+	// liquid_shares is the number of shares of the validator held by tokenize
+	// share records.
+	//
+	// Since: cosmos-sdk 0.48
+	LiquidShares github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,15,opt,name=liquid_shares,json=liquidShares,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"liquid_shares"`
+	// assets are the tokens delegated to the validator in the additional bond
+	// denoms. The tokens of the validator include their weighted contribution,
+	// the remainder being delegated in the bond denom.
+	//
+	// Since: cosmos-sdk 0.48
+	Assets []ValidatorAsset `protobuf:"bytes,16,rep,name=assets,proto3" json:"assets"`
 }
 
 func (m *Validator) Reset()         { *m = Validator{} }
 func (m *Validator) String() string { return proto.CompactTextString(m) }
 func (*Validator) ProtoMessage()    {}
 func (*Validator) Descriptor() ([]byte, []int) {
-	return fileDescriptor_64c30c6cf92913c9, []int{4}
+	return fileDescriptor_64c30c6cf92913c9, []int{6}
 }
 func (m *Validator) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -410,6 +704,60 @@ func (m *Validator) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_Validator proto.InternalMessageInfo
 
+// ValidatorAsset defines the tokens delegated to a validator in an additional
+// bond denom.
+//
+// Since: cosmos-sdk 0.48
+type ValidatorAsset struct {
+	// denom is the additional bond denom.
+	Denom string `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty"`
+	// amount is the amount of the denom delegated to the validator.
+	Amount github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,2,opt,name=amount,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"amount"`
+	// tokens is the weighted contribution of the amount to the tokens of the
+	// validator.
+	Tokens github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,3,opt,name=tokens,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"tokens"`
+}
+
+func (m *ValidatorAsset) Reset()         { *m = ValidatorAsset{} }
+func (m *ValidatorAsset) String() string { return proto.CompactTextString(m) }
+func (*ValidatorAsset) ProtoMessage()    {}
+func (*ValidatorAsset) Descriptor() ([]byte, []int) {
+	return fileDescriptor_64c30c6cf92913c9, []int{7}
+}
+func (m *ValidatorAsset) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ValidatorAsset) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ValidatorAsset.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ValidatorAsset) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ValidatorAsset.Merge(m, src)
+}
+func (m *ValidatorAsset) XXX_Size() int {
+	return m.Size()
+}
+func (m *ValidatorAsset) XXX_DiscardUnknown() {
+	xxx_messageInfo_ValidatorAsset.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ValidatorAsset proto.InternalMessageInfo
+
+func (m *ValidatorAsset) GetDenom() string {
+	if m != nil {
+		return m.Denom
+	}
+	return ""
+}
+
 // ValAddresses defines a repeated set of validator addresses.
 type ValAddresses struct {
 	Addresses []string `protobuf:"bytes,1,rep,name=addresses,proto3" json:"addresses,omitempty"`
@@ -419,7 +767,7 @@ func (m *ValAddresses) Reset()         { *m = ValAddresses{} }
 func (m *ValAddresses) String() string { return proto.CompactTextString(m) }
 func (*ValAddresses) ProtoMessage()    {}
 func (*ValAddresses) Descriptor() ([]byte, []int) {
-	return fileDescriptor_64c30c6cf92913c9, []int{5}
+	return fileDescriptor_64c30c6cf92913c9, []int{8}
 }
 func (m *ValAddresses) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -467,7 +815,7 @@ func (m *DVPair) Reset()         { *m = DVPair{} }
 func (m *DVPair) String() string { return proto.CompactTextString(m) }
 func (*DVPair) ProtoMessage()    {}
 func (*DVPair) Descriptor() ([]byte, []int) {
-	return fileDescriptor_64c30c6cf92913c9, []int{6}
+	return fileDescriptor_64c30c6cf92913c9, []int{9}
 }
 func (m *DVPair) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -505,7 +853,7 @@ func (m *DVPairs) Reset()         { *m = DVPairs{} }
 func (m *DVPairs) String() string { return proto.CompactTextString(m) }
 func (*DVPairs) ProtoMessage()    {}
 func (*DVPairs) Descriptor() ([]byte, []int) {
-	return fileDescriptor_64c30c6cf92913c9, []int{7}
+	return fileDescriptor_64c30c6cf92913c9, []int{10}
 }
 func (m *DVPairs) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -555,7 +903,7 @@ func (m *DVVTriplet) Reset()         { *m = DVVTriplet{} }
 func (m *DVVTriplet) String() string { return proto.CompactTextString(m) }
 func (*DVVTriplet) ProtoMessage()    {}
 func (*DVVTriplet) Descriptor() ([]byte, []int) {
-	return fileDescriptor_64c30c6cf92913c9, []int{8}
+	return fileDescriptor_64c30c6cf92913c9, []int{11}
 }
 func (m *DVVTriplet) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -593,7 +941,7 @@ func (m *DVVTriplets) Reset()         { *m = DVVTriplets{} }
 func (m *DVVTriplets) String() string { return proto.CompactTextString(m) }
 func (*DVVTriplets) ProtoMessage()    {}
 func (*DVVTriplets) Descriptor() ([]byte, []int) {
-	return fileDescriptor_64c30c6cf92913c9, []int{9}
+	return fileDescriptor_64c30c6cf92913c9, []int{12}
 }
 func (m *DVVTriplets) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -639,13 +987,22 @@ type Delegation struct {
 	ValidatorAddress string `protobuf:"bytes,2,opt,name=validator_address,json=validatorAddress,proto3" json:"validator_address,omitempty"`
 	// shares define the delegation shares received.
 	Shares github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,3,opt,name=shares,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"shares"`
+	// validator_bond is true if the delegation is flagged as validator bond.
+	//
+	// Since: cosmos-sdk 0.48
+	ValidatorBond bool `protobuf:"varint,4,opt,name=validator_bond,json=validatorBond,proto3" json:"validator_bond,omitempty"`
+	// denom is the additional bond denom the delegation is made in, empty for a
+	// delegation in the bond denom.
+	//
+	// Since: cosmos-sdk 0.48
+	Denom string `protobuf:"bytes,5,opt,name=denom,proto3" json:"denom,omitempty"`
 }
 
 func (m *Delegation) Reset()         { *m = Delegation{} }
 func (m *Delegation) String() string { return proto.CompactTextString(m) }
 func (*Delegation) ProtoMessage()    {}
 func (*Delegation) Descriptor() ([]byte, []int) {
-	return fileDescriptor_64c30c6cf92913c9, []int{10}
+	return fileDescriptor_64c30c6cf92913c9, []int{13}
 }
 func (m *Delegation) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -689,7 +1046,7 @@ func (m *UnbondingDelegation) Reset()         { *m = UnbondingDelegation{} }
 func (m *UnbondingDelegation) String() string { return proto.CompactTextString(m) }
 func (*UnbondingDelegation) ProtoMessage()    {}
 func (*UnbondingDelegation) Descriptor() ([]byte, []int) {
-	return fileDescriptor_64c30c6cf92913c9, []int{11}
+	return fileDescriptor_64c30c6cf92913c9, []int{14}
 }
 func (m *UnbondingDelegation) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -732,13 +1089,18 @@ type UnbondingDelegationEntry struct {
 	UnbondingId uint64 `protobuf:"varint,5,opt,name=unbonding_id,json=unbondingId,proto3" json:"unbonding_id,omitempty"`
 	// Strictly positive if this entry's unbonding has been stopped by external modules
 	UnbondingOnHoldRefCount int64 `protobuf:"varint,6,opt,name=unbonding_on_hold_ref_count,json=unbondingOnHoldRefCount,proto3" json:"unbonding_on_hold_ref_count,omitempty"`
+	// denom is the additional bond denom the balances are expressed in, empty
+	// for the bond denom.
+	//
+	// Since: cosmos-sdk 0.48
+	Denom string `protobuf:"bytes,7,opt,name=denom,proto3" json:"denom,omitempty"`
 }
 
 func (m *UnbondingDelegationEntry) Reset()         { *m = UnbondingDelegationEntry{} }
 func (m *UnbondingDelegationEntry) String() string { return proto.CompactTextString(m) }
 func (*UnbondingDelegationEntry) ProtoMessage()    {}
 func (*UnbondingDelegationEntry) Descriptor() ([]byte, []int) {
-	return fileDescriptor_64c30c6cf92913c9, []int{12}
+	return fileDescriptor_64c30c6cf92913c9, []int{15}
 }
 func (m *UnbondingDelegationEntry) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -795,6 +1157,13 @@ func (m *UnbondingDelegationEntry) GetUnbondingOnHoldRefCount() int64 {
 	return 0
 }
 
+func (m *UnbondingDelegationEntry) GetDenom() string {
+	if m != nil {
+		return m.Denom
+	}
+	return ""
+}
+
 // RedelegationEntry defines a redelegation object with relevant metadata.
 type RedelegationEntry struct {
 	// creation_height  defines the height which the redelegation took place.
@@ -815,7 +1184,7 @@ func (m *RedelegationEntry) Reset()         { *m = RedelegationEntry{} }
 func (m *RedelegationEntry) String() string { return proto.CompactTextString(m) }
 func (*RedelegationEntry) ProtoMessage()    {}
 func (*RedelegationEntry) Descriptor() ([]byte, []int) {
-	return fileDescriptor_64c30c6cf92913c9, []int{13}
+	return fileDescriptor_64c30c6cf92913c9, []int{16}
 }
 func (m *RedelegationEntry) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -889,7 +1258,7 @@ func (m *Redelegation) Reset()         { *m = Redelegation{} }
 func (m *Redelegation) String() string { return proto.CompactTextString(m) }
 func (*Redelegation) ProtoMessage()    {}
 func (*Redelegation) Descriptor() ([]byte, []int) {
-	return fileDescriptor_64c30c6cf92913c9, []int{14}
+	return fileDescriptor_64c30c6cf92913c9, []int{17}
 }
 func (m *Redelegation) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -932,13 +1301,97 @@ type Params struct {
 	BondDenom string `protobuf:"bytes,5,opt,name=bond_denom,json=bondDenom,proto3" json:"bond_denom,omitempty"`
 	// min_commission_rate is the chain-wide minimum commission rate that a validator can charge their delegators
 	MinCommissionRate github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,6,opt,name=min_commission_rate,json=minCommissionRate,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"min_commission_rate" yaml:"min_commission_rate"`
+	// validator_bond_factor is the number of shares a validator can have
+	// tokenized per share of validator bond. A negative value disables the
+	// validator bond requirement.
+	//
+	// Since: cosmos-sdk 0.48
+	ValidatorBondFactor github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,7,opt,name=validator_bond_factor,json=validatorBondFactor,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"validator_bond_factor"`
+	// global_liquid_staking_cap is the maximum fraction of the total bonded
+	// tokens that can be liquid staked.
+	//
+	// Since: cosmos-sdk 0.48
+	GlobalLiquidStakingCap github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,8,opt,name=global_liquid_staking_cap,json=globalLiquidStakingCap,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"global_liquid_staking_cap"`
+	// validator_liquid_staking_cap is the maximum fraction of the shares of a
+	// validator that can be liquid staked.
+	//
+	// Since: cosmos-sdk 0.48
+	ValidatorLiquidStakingCap github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,9,opt,name=validator_liquid_staking_cap,json=validatorLiquidStakingCap,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"validator_liquid_staking_cap"`
+	// commission_change_notice_period is the duration between a validator
+	// announcing a commission rate change and the change taking effect. A zero
+	// value applies commission rate changes immediately.
+	//
+	// Since: cosmos-sdk 0.48
+	CommissionChangeNoticePeriod time.Duration `protobuf:"bytes,10,opt,name=commission_change_notice_period,json=commissionChangeNoticePeriod,proto3,stdduration" json:"commission_change_notice_period"`
+	// bond_denom_weights are the additional denoms which can be bonded, along
+	// with the staking tokens credited per unit of each of them.
+	//
+	// Since: cosmos-sdk 0.48
+	BondDenomWeights []BondDenomWeight `protobuf:"bytes,11,rep,name=bond_denom_weights,json=bondDenomWeights,proto3" json:"bond_denom_weights"`
+	// max_mature_unbondings_per_block is the maximum number of mature unbonding
+	// delegation and redelegation queue entries completed per block, each. Mature
+	// entries beyond the limit are carried over to the following blocks in queue
+	// order. A zero value completes all mature entries every block.
+	//
+	// Since: cosmos-sdk 0.48
+	MaxMatureUnbondingsPerBlock uint32 `protobuf:"varint,12,opt,name=max_mature_unbondings_per_block,json=maxMatureUnbondingsPerBlock,proto3" json:"max_mature_unbondings_per_block,omitempty"`
+	// min_self_delegation_floor is the chain-wide minimum self delegation of the
+	// validators. A validator whose self delegation falls below the greater of
+	// its declared minimum self delegation and the floor is jailed at the end of
+	// the block.
+	//
+	// Since: cosmos-sdk 0.48
+	MinSelfDelegationFloor github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,13,opt,name=min_self_delegation_floor,json=minSelfDelegationFloor,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"min_self_delegation_floor"`
+	// epoch_length is the number of blocks of a staking epoch. When non-zero, the
+	// delegation, undelegation and redelegation messages are queued and applied
+	// all at once at the end of the last block of the epoch, i.e. of the blocks
+	// whose height is a multiple of the epoch length. A zero value applies them
+	// immediately.
+	//
+	// Since: cosmos-sdk 0.48
+	EpochLength uint64 `protobuf:"varint,14,opt,name=epoch_length,json=epochLength,proto3" json:"epoch_length,omitempty"`
+	// lockup_terms are the terms for which a delegation can be locked, along with
+	// the reward multiplier earned by the delegations locked for them. An empty
+	// list disables delegation lockups.
+	//
+	// Since: cosmos-sdk 0.48
+	LockupTerms []LockupTerm `protobuf:"bytes,15,rep,name=lockup_terms,json=lockupTerms,proto3" json:"lockup_terms"`
+	// instant_undelegation_fee_rate is the fraction of the undelegated coins
+	// paid as fee by the instant undelegations, which skip the unbonding period.
+	//
+	// Since: cosmos-sdk 0.48
+	InstantUndelegationFeeRate github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,16,opt,name=instant_undelegation_fee_rate,json=instantUndelegationFeeRate,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"instant_undelegation_fee_rate"`
+	// instant_undelegation_budget_per_block is the maximum amount of staking
+	// tokens which can be instantly undelegated in a block. A zero value
+	// disables instant undelegations.
+	//
+	// Since: cosmos-sdk 0.48
+	InstantUndelegationBudgetPerBlock github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,17,opt,name=instant_undelegation_budget_per_block,json=instantUndelegationBudgetPerBlock,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"instant_undelegation_budget_per_block"`
+	// instant_undelegation_fee_to_community_pool sends the instant undelegation
+	// fees to the community pool instead of burning them.
+	//
+	// Since: cosmos-sdk 0.48
+	InstantUndelegationFeeToCommunityPool bool `protobuf:"varint,18,opt,name=instant_undelegation_fee_to_community_pool,json=instantUndelegationFeeToCommunityPool,proto3" json:"instant_undelegation_fee_to_community_pool,omitempty"`
+	// unbonding_tiers are the risk tiers governance can assign validators to,
+	// along with the unbonding time applied to the validators of each tier
+	// instead of unbonding_time.
+	//
+	// Since: cosmos-sdk 0.48
+	UnbondingTiers []UnbondingTier `protobuf:"bytes,19,rep,name=unbonding_tiers,json=unbondingTiers,proto3" json:"unbonding_tiers"`
+	// min_delegation_amount is the smallest amount of staking tokens which can
+	// be delegated, undelegated or redelegated, and left delegated to a
+	// validator after an undelegation or redelegation. A zero value disables
+	// the minimum.
+	//
+	// Since: cosmos-sdk 0.48
+	MinDelegationAmount github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,20,opt,name=min_delegation_amount,json=minDelegationAmount,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"min_delegation_amount"`
 }
 
 func (m *Params) Reset()         { *m = Params{} }
 func (m *Params) String() string { return proto.CompactTextString(m) }
 func (*Params) ProtoMessage()    {}
 func (*Params) Descriptor() ([]byte, []int) {
-	return fileDescriptor_64c30c6cf92913c9, []int{15}
+	return fileDescriptor_64c30c6cf92913c9, []int{18}
 }
 func (m *Params) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1002,25 +1455,79 @@ func (m *Params) GetBondDenom() string {
 	return ""
 }
 
-// DelegationResponse is equivalent to Delegation except that it contains a
-// balance in addition to shares which is more suitable for client responses.
-type DelegationResponse struct {
-	Delegation Delegation  `protobuf:"bytes,1,opt,name=delegation,proto3" json:"delegation"`
-	Balance    types2.Coin `protobuf:"bytes,2,opt,name=balance,proto3" json:"balance"`
+func (m *Params) GetCommissionChangeNoticePeriod() time.Duration {
+	if m != nil {
+		return m.CommissionChangeNoticePeriod
+	}
+	return 0
 }
 
-func (m *DelegationResponse) Reset()         { *m = DelegationResponse{} }
-func (m *DelegationResponse) String() string { return proto.CompactTextString(m) }
-func (*DelegationResponse) ProtoMessage()    {}
-func (*DelegationResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_64c30c6cf92913c9, []int{16}
+func (m *Params) GetBondDenomWeights() []BondDenomWeight {
+	if m != nil {
+		return m.BondDenomWeights
+	}
+	return nil
 }
-func (m *DelegationResponse) XXX_Unmarshal(b []byte) error {
+
+func (m *Params) GetMaxMatureUnbondingsPerBlock() uint32 {
+	if m != nil {
+		return m.MaxMatureUnbondingsPerBlock
+	}
+	return 0
+}
+
+func (m *Params) GetEpochLength() uint64 {
+	if m != nil {
+		return m.EpochLength
+	}
+	return 0
+}
+
+func (m *Params) GetLockupTerms() []LockupTerm {
+	if m != nil {
+		return m.LockupTerms
+	}
+	return nil
+}
+
+func (m *Params) GetInstantUndelegationFeeToCommunityPool() bool {
+	if m != nil {
+		return m.InstantUndelegationFeeToCommunityPool
+	}
+	return false
+}
+
+func (m *Params) GetUnbondingTiers() []UnbondingTier {
+	if m != nil {
+		return m.UnbondingTiers
+	}
+	return nil
+}
+
+// UnbondingTier defines a validator risk tier and the unbonding time of the
+// validators assigned to it.
+//
+// Since: cosmos-sdk 0.48
+type UnbondingTier struct {
+	// name is the unique name of the tier.
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// unbonding_time is the time duration of unbonding from the validators of
+	// the tier.
+	UnbondingTime time.Duration `protobuf:"bytes,2,opt,name=unbonding_time,json=unbondingTime,proto3,stdduration" json:"unbonding_time"`
+}
+
+func (m *UnbondingTier) Reset()         { *m = UnbondingTier{} }
+func (m *UnbondingTier) String() string { return proto.CompactTextString(m) }
+func (*UnbondingTier) ProtoMessage()    {}
+func (*UnbondingTier) Descriptor() ([]byte, []int) {
+	return fileDescriptor_64c30c6cf92913c9, []int{19}
+}
+func (m *UnbondingTier) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *DelegationResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *UnbondingTier) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_DelegationResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_UnbondingTier.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1030,21 +1537,294 @@ func (m *DelegationResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte,
 		return b[:n], nil
 	}
 }
-func (m *DelegationResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_DelegationResponse.Merge(m, src)
+func (m *UnbondingTier) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UnbondingTier.Merge(m, src)
 }
-func (m *DelegationResponse) XXX_Size() int {
+func (m *UnbondingTier) XXX_Size() int {
 	return m.Size()
 }
-func (m *DelegationResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_DelegationResponse.DiscardUnknown(m)
+func (m *UnbondingTier) XXX_DiscardUnknown() {
+	xxx_messageInfo_UnbondingTier.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_DelegationResponse proto.InternalMessageInfo
+var xxx_messageInfo_UnbondingTier proto.InternalMessageInfo
 
-func (m *DelegationResponse) GetDelegation() Delegation {
+func (m *UnbondingTier) GetName() string {
 	if m != nil {
-		return m.Delegation
+		return m.Name
+	}
+	return ""
+}
+
+func (m *UnbondingTier) GetUnbondingTime() time.Duration {
+	if m != nil {
+		return m.UnbondingTime
+	}
+	return 0
+}
+
+// ValidatorRiskTier defines the risk tier a validator is assigned to.
+//
+// Since: cosmos-sdk 0.48
+type ValidatorRiskTier struct {
+	// validator_address is the address of the validator.
+	ValidatorAddress string `protobuf:"bytes,1,opt,name=validator_address,json=validatorAddress,proto3" json:"validator_address,omitempty"`
+	// tier is the name of the unbonding tier of the validator.
+	Tier string `protobuf:"bytes,2,opt,name=tier,proto3" json:"tier,omitempty"`
+}
+
+func (m *ValidatorRiskTier) Reset()         { *m = ValidatorRiskTier{} }
+func (m *ValidatorRiskTier) String() string { return proto.CompactTextString(m) }
+func (*ValidatorRiskTier) ProtoMessage()    {}
+func (*ValidatorRiskTier) Descriptor() ([]byte, []int) {
+	return fileDescriptor_64c30c6cf92913c9, []int{20}
+}
+func (m *ValidatorRiskTier) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ValidatorRiskTier) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ValidatorRiskTier.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ValidatorRiskTier) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ValidatorRiskTier.Merge(m, src)
+}
+func (m *ValidatorRiskTier) XXX_Size() int {
+	return m.Size()
+}
+func (m *ValidatorRiskTier) XXX_DiscardUnknown() {
+	xxx_messageInfo_ValidatorRiskTier.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ValidatorRiskTier proto.InternalMessageInfo
+
+func (m *ValidatorRiskTier) GetValidatorAddress() string {
+	if m != nil {
+		return m.ValidatorAddress
+	}
+	return ""
+}
+
+func (m *ValidatorRiskTier) GetTier() string {
+	if m != nil {
+		return m.Tier
+	}
+	return ""
+}
+
+// StandbyConsPubKey defines the standby consensus key of a validator, which
+// replaces its consensus key once the latter has missed the number of
+// consecutive blocks defined by the x/slashing params.
+//
+// Since: cosmos-sdk 0.48
+type StandbyConsPubKey struct {
+	// validator_address is the address of the validator.
+	ValidatorAddress string `protobuf:"bytes,1,opt,name=validator_address,json=validatorAddress,proto3" json:"validator_address,omitempty"`
+	// pubkey is the standby consensus public key.
+	Pubkey *types1.Any `protobuf:"bytes,2,opt,name=pubkey,proto3" json:"pubkey,omitempty"`
+}
+
+func (m *StandbyConsPubKey) Reset()         { *m = StandbyConsPubKey{} }
+func (m *StandbyConsPubKey) String() string { return proto.CompactTextString(m) }
+func (*StandbyConsPubKey) ProtoMessage()    {}
+func (*StandbyConsPubKey) Descriptor() ([]byte, []int) {
+	return fileDescriptor_64c30c6cf92913c9, []int{21}
+}
+func (m *StandbyConsPubKey) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *StandbyConsPubKey) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_StandbyConsPubKey.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *StandbyConsPubKey) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_StandbyConsPubKey.Merge(m, src)
+}
+func (m *StandbyConsPubKey) XXX_Size() int {
+	return m.Size()
+}
+func (m *StandbyConsPubKey) XXX_DiscardUnknown() {
+	xxx_messageInfo_StandbyConsPubKey.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_StandbyConsPubKey proto.InternalMessageInfo
+
+func (m *StandbyConsPubKey) GetValidatorAddress() string {
+	if m != nil {
+		return m.ValidatorAddress
+	}
+	return ""
+}
+
+func (m *StandbyConsPubKey) GetPubkey() *types1.Any {
+	if m != nil {
+		return m.Pubkey
+	}
+	return nil
+}
+
+// LockupTerm defines a term for which a delegation can be locked, and the
+// multiplier applied to the rewards of the delegations locked for it.
+//
+// Since: cosmos-sdk 0.48
+type LockupTerm struct {
+	// duration is the time a delegation stays locked for.
+	Duration time.Duration `protobuf:"bytes,1,opt,name=duration,proto3,stdduration" json:"duration"`
+	// reward_multiplier is the factor applied to the rewards of the locked
+	// delegations.
+	RewardMultiplier github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,2,opt,name=reward_multiplier,json=rewardMultiplier,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"reward_multiplier"`
+}
+
+func (m *LockupTerm) Reset()         { *m = LockupTerm{} }
+func (m *LockupTerm) String() string { return proto.CompactTextString(m) }
+func (*LockupTerm) ProtoMessage()    {}
+func (*LockupTerm) Descriptor() ([]byte, []int) {
+	return fileDescriptor_64c30c6cf92913c9, []int{22}
+}
+func (m *LockupTerm) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *LockupTerm) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_LockupTerm.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *LockupTerm) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_LockupTerm.Merge(m, src)
+}
+func (m *LockupTerm) XXX_Size() int {
+	return m.Size()
+}
+func (m *LockupTerm) XXX_DiscardUnknown() {
+	xxx_messageInfo_LockupTerm.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_LockupTerm proto.InternalMessageInfo
+
+func (m *LockupTerm) GetDuration() time.Duration {
+	if m != nil {
+		return m.Duration
+	}
+	return 0
+}
+
+// BondDenomWeight defines an additional bond denom and the staking tokens, and
+// thus the consensus power, credited per unit of it.
+//
+// Since: cosmos-sdk 0.48
+type BondDenomWeight struct {
+	// denom is the additional bond denom.
+	Denom string `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty"`
+	// weight is the number of staking tokens credited per unit of the denom.
+	Weight github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,2,opt,name=weight,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"weight"`
+}
+
+func (m *BondDenomWeight) Reset()         { *m = BondDenomWeight{} }
+func (m *BondDenomWeight) String() string { return proto.CompactTextString(m) }
+func (*BondDenomWeight) ProtoMessage()    {}
+func (*BondDenomWeight) Descriptor() ([]byte, []int) {
+	return fileDescriptor_64c30c6cf92913c9, []int{23}
+}
+func (m *BondDenomWeight) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *BondDenomWeight) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_BondDenomWeight.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *BondDenomWeight) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BondDenomWeight.Merge(m, src)
+}
+func (m *BondDenomWeight) XXX_Size() int {
+	return m.Size()
+}
+func (m *BondDenomWeight) XXX_DiscardUnknown() {
+	xxx_messageInfo_BondDenomWeight.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BondDenomWeight proto.InternalMessageInfo
+
+func (m *BondDenomWeight) GetDenom() string {
+	if m != nil {
+		return m.Denom
+	}
+	return ""
+}
+
+// DelegationResponse is equivalent to Delegation except that it contains a
+// balance in addition to shares which is more suitable for client responses.
+type DelegationResponse struct {
+	Delegation Delegation  `protobuf:"bytes,1,opt,name=delegation,proto3" json:"delegation"`
+	Balance    types2.Coin `protobuf:"bytes,2,opt,name=balance,proto3" json:"balance"`
+}
+
+func (m *DelegationResponse) Reset()         { *m = DelegationResponse{} }
+func (m *DelegationResponse) String() string { return proto.CompactTextString(m) }
+func (*DelegationResponse) ProtoMessage()    {}
+func (*DelegationResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_64c30c6cf92913c9, []int{24}
+}
+func (m *DelegationResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DelegationResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DelegationResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *DelegationResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DelegationResponse.Merge(m, src)
+}
+func (m *DelegationResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *DelegationResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_DelegationResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DelegationResponse proto.InternalMessageInfo
+
+func (m *DelegationResponse) GetDelegation() Delegation {
+	if m != nil {
+		return m.Delegation
 	}
 	return Delegation{}
 }
@@ -1068,7 +1848,7 @@ func (m *RedelegationEntryResponse) Reset()         { *m = RedelegationEntryResp
 func (m *RedelegationEntryResponse) String() string { return proto.CompactTextString(m) }
 func (*RedelegationEntryResponse) ProtoMessage()    {}
 func (*RedelegationEntryResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_64c30c6cf92913c9, []int{17}
+	return fileDescriptor_64c30c6cf92913c9, []int{25}
 }
 func (m *RedelegationEntryResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1116,7 +1896,7 @@ func (m *RedelegationResponse) Reset()         { *m = RedelegationResponse{} }
 func (m *RedelegationResponse) String() string { return proto.CompactTextString(m) }
 func (*RedelegationResponse) ProtoMessage()    {}
 func (*RedelegationResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_64c30c6cf92913c9, []int{18}
+	return fileDescriptor_64c30c6cf92913c9, []int{26}
 }
 func (m *RedelegationResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1170,7 +1950,7 @@ func (m *Pool) Reset()         { *m = Pool{} }
 func (m *Pool) String() string { return proto.CompactTextString(m) }
 func (*Pool) ProtoMessage()    {}
 func (*Pool) Descriptor() ([]byte, []int) {
-	return fileDescriptor_64c30c6cf92913c9, []int{19}
+	return fileDescriptor_64c30c6cf92913c9, []int{27}
 }
 func (m *Pool) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1199,24 +1979,35 @@ func (m *Pool) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_Pool proto.InternalMessageInfo
 
-// ValidatorUpdates defines an array of abci.ValidatorUpdate objects.
-// TODO: explore moving this to proto/cosmos/base to separate modules from tendermint dependence
-type ValidatorUpdates struct {
-	Updates []types3.ValidatorUpdate `protobuf:"bytes,1,rep,name=updates,proto3" json:"updates"`
-}
-
-func (m *ValidatorUpdates) Reset()         { *m = ValidatorUpdates{} }
-func (m *ValidatorUpdates) String() string { return proto.CompactTextString(m) }
-func (*ValidatorUpdates) ProtoMessage()    {}
-func (*ValidatorUpdates) Descriptor() ([]byte, []int) {
-	return fileDescriptor_64c30c6cf92913c9, []int{20}
-}
-func (m *ValidatorUpdates) XXX_Unmarshal(b []byte) error {
+// ValidatorJailInfo defines why and when a jailed validator was jailed.
+//
+// Since: cosmos-sdk 0.48
+type ValidatorJailInfo struct {
+	// validator_address is the address of the validator.
+	ValidatorAddress string `protobuf:"bytes,1,opt,name=validator_address,json=validatorAddress,proto3" json:"validator_address,omitempty"`
+	// reason is the reason the validator was jailed for.
+	Reason JailReason `protobuf:"varint,2,opt,name=reason,proto3,enum=cosmos.staking.v1beta1.JailReason" json:"reason,omitempty"`
+	// height is the height at which the validator was jailed.
+	Height int64 `protobuf:"varint,3,opt,name=height,proto3" json:"height,omitempty"`
+	// time is the block time at which the validator was jailed.
+	Time time.Time `protobuf:"bytes,4,opt,name=time,proto3,stdtime" json:"time"`
+	// reference optionally refers to what caused the jailing, e.g. the hash of
+	// the double sign evidence or the id of the governance proposal.
+	Reference string `protobuf:"bytes,5,opt,name=reference,proto3" json:"reference,omitempty"`
+}
+
+func (m *ValidatorJailInfo) Reset()         { *m = ValidatorJailInfo{} }
+func (m *ValidatorJailInfo) String() string { return proto.CompactTextString(m) }
+func (*ValidatorJailInfo) ProtoMessage()    {}
+func (*ValidatorJailInfo) Descriptor() ([]byte, []int) {
+	return fileDescriptor_64c30c6cf92913c9, []int{28}
+}
+func (m *ValidatorJailInfo) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *ValidatorUpdates) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *ValidatorJailInfo) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_ValidatorUpdates.Marshal(b, m, deterministic)
+		return xxx_messageInfo_ValidatorJailInfo.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1226,1026 +2017,1794 @@ func (m *ValidatorUpdates) XXX_Marshal(b []byte, deterministic bool) ([]byte, er
 		return b[:n], nil
 	}
 }
-func (m *ValidatorUpdates) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_ValidatorUpdates.Merge(m, src)
+func (m *ValidatorJailInfo) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ValidatorJailInfo.Merge(m, src)
 }
-func (m *ValidatorUpdates) XXX_Size() int {
+func (m *ValidatorJailInfo) XXX_Size() int {
 	return m.Size()
 }
-func (m *ValidatorUpdates) XXX_DiscardUnknown() {
-	xxx_messageInfo_ValidatorUpdates.DiscardUnknown(m)
+func (m *ValidatorJailInfo) XXX_DiscardUnknown() {
+	xxx_messageInfo_ValidatorJailInfo.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_ValidatorUpdates proto.InternalMessageInfo
+var xxx_messageInfo_ValidatorJailInfo proto.InternalMessageInfo
 
-func (m *ValidatorUpdates) GetUpdates() []types3.ValidatorUpdate {
+func (m *ValidatorJailInfo) GetValidatorAddress() string {
 	if m != nil {
-		return m.Updates
+		return m.ValidatorAddress
 	}
-	return nil
+	return ""
 }
 
-func init() {
-	proto.RegisterEnum("cosmos.staking.v1beta1.BondStatus", BondStatus_name, BondStatus_value)
-	proto.RegisterEnum("cosmos.staking.v1beta1.Infraction", Infraction_name, Infraction_value)
-	proto.RegisterType((*HistoricalInfo)(nil), "cosmos.staking.v1beta1.HistoricalInfo")
-	proto.RegisterType((*CommissionRates)(nil), "cosmos.staking.v1beta1.CommissionRates")
-	proto.RegisterType((*Commission)(nil), "cosmos.staking.v1beta1.Commission")
-	proto.RegisterType((*Description)(nil), "cosmos.staking.v1beta1.Description")
-	proto.RegisterType((*Validator)(nil), "cosmos.staking.v1beta1.Validator")
-	proto.RegisterType((*ValAddresses)(nil), "cosmos.staking.v1beta1.ValAddresses")
-	proto.RegisterType((*DVPair)(nil), "cosmos.staking.v1beta1.DVPair")
-	proto.RegisterType((*DVPairs)(nil), "cosmos.staking.v1beta1.DVPairs")
-	proto.RegisterType((*DVVTriplet)(nil), "cosmos.staking.v1beta1.DVVTriplet")
-	proto.RegisterType((*DVVTriplets)(nil), "cosmos.staking.v1beta1.DVVTriplets")
-	proto.RegisterType((*Delegation)(nil), "cosmos.staking.v1beta1.Delegation")
-	proto.RegisterType((*UnbondingDelegation)(nil), "cosmos.staking.v1beta1.UnbondingDelegation")
-	proto.RegisterType((*UnbondingDelegationEntry)(nil), "cosmos.staking.v1beta1.UnbondingDelegationEntry")
-	proto.RegisterType((*RedelegationEntry)(nil), "cosmos.staking.v1beta1.RedelegationEntry")
-	proto.RegisterType((*Redelegation)(nil), "cosmos.staking.v1beta1.Redelegation")
-	proto.RegisterType((*Params)(nil), "cosmos.staking.v1beta1.Params")
-	proto.RegisterType((*DelegationResponse)(nil), "cosmos.staking.v1beta1.DelegationResponse")
-	proto.RegisterType((*RedelegationEntryResponse)(nil), "cosmos.staking.v1beta1.RedelegationEntryResponse")
-	proto.RegisterType((*RedelegationResponse)(nil), "cosmos.staking.v1beta1.RedelegationResponse")
-	proto.RegisterType((*Pool)(nil), "cosmos.staking.v1beta1.Pool")
-	proto.RegisterType((*ValidatorUpdates)(nil), "cosmos.staking.v1beta1.ValidatorUpdates")
+func (m *ValidatorJailInfo) GetReason() JailReason {
+	if m != nil {
+		return m.Reason
+	}
+	return JailReason_JAIL_REASON_UNSPECIFIED
 }
 
-func init() {
-	proto.RegisterFile("cosmos/staking/v1beta1/staking.proto", fileDescriptor_64c30c6cf92913c9)
+func (m *ValidatorJailInfo) GetHeight() int64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
 }
 
-var fileDescriptor_64c30c6cf92913c9 = []byte{
-	// 1883 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe4, 0x58, 0x3d, 0x6c, 0x23, 0xc7,
-	0x15, 0xd6, 0x92, 0x32, 0x25, 0x3d, 0x4a, 0x22, 0x35, 0x27, 0xdf, 0xf1, 0x78, 0x89, 0x48, 0xd3,
-	0x17, 0x5b, 0x3e, 0xf8, 0xa8, 0x9c, 0x02, 0xa4, 0x50, 0x8c, 0x04, 0xa2, 0xa8, 0xf3, 0xd1, 0xb1,
-	0x25, 0x61, 0x29, 0x29, 0x71, 0x7e, 0xb0, 0x18, 0xee, 0x8e, 0xa8, 0x89, 0xb8, 0xb3, 0xc4, 0xce,
-	0xf0, 0x2c, 0xb6, 0x41, 0x0a, 0x43, 0x45, 0x62, 0x20, 0x4d, 0x9a, 0x03, 0x0e, 0x48, 0x11, 0xa7,
-	0x73, 0x61, 0xc4, 0x45, 0x90, 0x22, 0x9d, 0x93, 0x34, 0x07, 0x57, 0x41, 0x0a, 0x25, 0xb8, 0x2b,
-	0x6c, 0xa4, 0x0a, 0xd2, 0x25, 0x55, 0x30, 0xb3, 0xb3, 0x3f, 0x14, 0xc5, 0x93, 0x74, 0x50, 0x0c,
-	0x03, 0x6e, 0xc8, 0x9d, 0x99, 0xf7, 0xbe, 0x99, 0xf7, 0xbd, 0x9f, 0xf9, 0x81, 0x9b, 0xb6, 0xc7,
-	0x5d, 0x8f, 0x2f, 0x71, 0x81, 0x0f, 0x28, 0x6b, 0x2f, 0xdd, 0xbf, 0xd3, 0x22, 0x02, 0xdf, 0x09,
-	0xdb, 0xd5, 0xae, 0xef, 0x09, 0x0f, 0x5d, 0x0d, 0xa4, 0xaa, 0x61, 0xaf, 0x96, 0x2a, 0xce, 0xb7,
-	0xbd, 0xb6, 0xa7, 0x44, 0x96, 0xe4, 0x57, 0x20, 0x5d, 0xbc, 0xde, 0xf6, 0xbc, 0x76, 0x87, 0x2c,
-	0xa9, 0x56, 0xab, 0xb7, 0xb7, 0x84, 0x59, 0x5f, 0x0f, 0x2d, 0x9c, 0x1c, 0x72, 0x7a, 0x3e, 0x16,
-	0xd4, 0x63, 0x7a, 0xbc, 0x74, 0x72, 0x5c, 0x50, 0x97, 0x70, 0x81, 0xdd, 0x6e, 0x88, 0x1d, 0xac,
-	0xc4, 0x0a, 0x26, 0xd5, 0xcb, 0xd2, 0xd8, 0xda, 0x94, 0x16, 0xe6, 0x24, 0xb2, 0xc3, 0xf6, 0x68,
-	0x88, 0x3d, 0x87, 0x5d, 0xca, 0xbc, 0x25, 0xf5, 0xab, 0xbb, 0xbe, 0x22, 0x08, 0x73, 0x88, 0xef,
-	0x52, 0x26, 0x96, 0x44, 0xbf, 0x4b, 0x78, 0xf0, 0xab, 0x47, 0x6f, 0x24, 0x46, 0x71, 0xcb, 0xa6,
-	0xc9, 0xc1, 0xca, 0x2f, 0x0d, 0x98, 0xbd, 0x47, 0xb9, 0xf0, 0x7c, 0x6a, 0xe3, 0x4e, 0x83, 0xed,
-	0x79, 0xe8, 0x5b, 0x90, 0xd9, 0x27, 0xd8, 0x21, 0x7e, 0xc1, 0x28, 0x1b, 0x8b, 0xd9, 0xe5, 0x42,
-	0x35, 0x06, 0xa8, 0x06, 0xba, 0xf7, 0xd4, 0x78, 0x6d, 0xea, 0xe3, 0xe3, 0xd2, 0xd8, 0xfb, 0x9f,
-	0x7e, 0x70, 0xcb, 0x30, 0xb5, 0x0a, 0xaa, 0x43, 0xe6, 0x3e, 0xee, 0x70, 0x22, 0x0a, 0xa9, 0x72,
-	0x7a, 0x31, 0xbb, 0xfc, 0x42, 0xf5, 0x74, 0xce, 0xab, 0xbb, 0xb8, 0x43, 0x1d, 0x2c, 0xbc, 0x41,
-	0x94, 0x40, 0xb7, 0xf2, 0x51, 0x0a, 0x72, 0x6b, 0x9e, 0xeb, 0x52, 0xce, 0xa9, 0xc7, 0x4c, 0x2c,
-	0x08, 0x47, 0x3b, 0x30, 0xee, 0x63, 0x41, 0xd4, 0xa2, 0xa6, 0x6a, 0xab, 0x52, 0xe9, 0x6f, 0xc7,
-	0xa5, 0x97, 0xda, 0x54, 0xec, 0xf7, 0x5a, 0x55, 0xdb, 0x73, 0x35, 0x8d, 0xfa, 0xef, 0x36, 0x77,
-	0x0e, 0xb4, 0xa5, 0x75, 0x62, 0x7f, 0xf2, 0xe1, 0x6d, 0xd0, 0x0b, 0xa9, 0x13, 0x3b, 0x98, 0x4c,
-	0xc1, 0xa1, 0x1f, 0xc1, 0xa4, 0x8b, 0x0f, 0x2d, 0x05, 0x9d, 0xba, 0x2c, 0xe8, 0x09, 0x17, 0x1f,
-	0xca, 0x55, 0x23, 0x0a, 0x39, 0x89, 0x6e, 0xef, 0x63, 0xd6, 0x26, 0xc1, 0x24, 0xe9, 0xcb, 0x9a,
-	0x64, 0xc6, 0xc5, 0x87, 0x6b, 0x0a, 0x58, 0x4e, 0xb5, 0x32, 0xfe, 0xd9, 0xc3, 0x92, 0x51, 0xf9,
-	0xa3, 0x01, 0x10, 0x33, 0x87, 0x30, 0xe4, 0xed, 0xa8, 0xa5, 0xe6, 0xe7, 0xda, 0xab, 0x2f, 0x8f,
-	0x72, 0xcc, 0x09, 0xde, 0x6b, 0x33, 0x72, 0xa5, 0x8f, 0x8e, 0x4b, 0x46, 0x30, 0x6b, 0xce, 0x3e,
-	0xe1, 0x97, 0x37, 0x20, 0xdb, 0xeb, 0x3a, 0x58, 0x10, 0x4b, 0x06, 0xb9, 0xe2, 0x30, 0xbb, 0x5c,
-	0xac, 0x06, 0x19, 0x50, 0x0d, 0x33, 0xa0, 0xba, 0x1d, 0x66, 0x40, 0x00, 0xf8, 0xde, 0xdf, 0x43,
-	0x40, 0x08, 0xb4, 0xe5, 0xb8, 0xb6, 0xe1, 0x7d, 0x03, 0xb2, 0x75, 0xc2, 0x6d, 0x9f, 0x76, 0x65,
-	0x4e, 0xa1, 0x02, 0x4c, 0xb8, 0x1e, 0xa3, 0x07, 0x3a, 0x22, 0xa7, 0xcc, 0xb0, 0x89, 0x8a, 0x30,
-	0x49, 0x1d, 0xc2, 0x04, 0x15, 0xfd, 0xc0, 0x79, 0x66, 0xd4, 0x96, 0x5a, 0xef, 0x90, 0x16, 0xa7,
-	0x21, 0xe5, 0x66, 0xd8, 0x44, 0xaf, 0x40, 0x9e, 0x13, 0xbb, 0xe7, 0x53, 0xd1, 0xb7, 0x6c, 0x8f,
-	0x09, 0x6c, 0x8b, 0xc2, 0xb8, 0x12, 0xc9, 0x85, 0xfd, 0x6b, 0x41, 0xb7, 0x04, 0x71, 0x88, 0xc0,
-	0xb4, 0xc3, 0x0b, 0xcf, 0x05, 0x20, 0xba, 0xa9, 0x97, 0xfa, 0xd1, 0x04, 0x4c, 0x45, 0x91, 0x8c,
-	0xd6, 0x20, 0xef, 0x75, 0x89, 0x2f, 0xbf, 0x2d, 0xec, 0x38, 0x3e, 0xe1, 0x5c, 0x87, 0x6b, 0xe1,
-	0x93, 0x0f, 0x6f, 0xcf, 0x6b, 0xc2, 0x57, 0x83, 0x91, 0xa6, 0xf0, 0x29, 0x6b, 0x9b, 0xb9, 0x50,
-	0x43, 0x77, 0xa3, 0xb7, 0xa5, 0xcb, 0x18, 0x27, 0x8c, 0xf7, 0xb8, 0xd5, 0xed, 0xb5, 0x0e, 0x48,
-	0x5f, 0x93, 0x3a, 0x3f, 0x44, 0xea, 0x2a, 0xeb, 0xd7, 0x0a, 0x7f, 0x8e, 0xa1, 0x6d, 0xbf, 0xdf,
-	0x15, 0x5e, 0x75, 0xab, 0xd7, 0xfa, 0x2e, 0xe9, 0x4b, 0x57, 0x69, 0x9c, 0x2d, 0x05, 0x83, 0xae,
-	0x42, 0xe6, 0x27, 0x98, 0x76, 0x88, 0xa3, 0x18, 0x99, 0x34, 0x75, 0x0b, 0xad, 0x40, 0x86, 0x0b,
-	0x2c, 0x7a, 0x5c, 0xd1, 0x30, 0xbb, 0x5c, 0x19, 0x15, 0x1b, 0x35, 0x8f, 0x39, 0x4d, 0x25, 0x69,
-	0x6a, 0x0d, 0xb4, 0x0d, 0x19, 0xe1, 0x1d, 0x10, 0xa6, 0x09, 0xaa, 0xbd, 0x76, 0x81, 0xc0, 0x6e,
-	0x30, 0x91, 0x08, 0xec, 0x06, 0x13, 0xa6, 0xc6, 0x42, 0x6d, 0xc8, 0x3b, 0xa4, 0x43, 0xda, 0x8a,
-	0x4a, 0xbe, 0x8f, 0x7d, 0xc2, 0x0b, 0x99, 0x0b, 0xe3, 0x0f, 0x25, 0x8e, 0x99, 0x8b, 0x50, 0x9b,
-	0x0a, 0x14, 0x6d, 0x41, 0xd6, 0x89, 0x43, 0xad, 0x30, 0xa1, 0x88, 0x7e, 0x71, 0x94, 0xfd, 0x89,
-	0xa8, 0x4c, 0x96, 0xad, 0x24, 0x84, 0x8c, 0xae, 0x1e, 0x6b, 0x79, 0xcc, 0xa1, 0xac, 0x6d, 0xed,
-	0x13, 0xda, 0xde, 0x17, 0x85, 0xc9, 0xb2, 0xb1, 0x98, 0x36, 0x73, 0x51, 0xff, 0x3d, 0xd5, 0x8d,
-	0xb6, 0x60, 0x36, 0x16, 0x55, 0xd9, 0x33, 0x75, 0xd1, 0xec, 0x99, 0x89, 0x00, 0xa4, 0x08, 0x7a,
-	0x0b, 0x20, 0xce, 0xcf, 0x02, 0x28, 0xb4, 0xca, 0xd9, 0x99, 0x9e, 0x34, 0x26, 0x01, 0x80, 0x3a,
-	0x70, 0xc5, 0xa5, 0xcc, 0xe2, 0xa4, 0xb3, 0x67, 0x69, 0xe6, 0x24, 0x6e, 0xf6, 0x12, 0x3c, 0x3d,
-	0xe7, 0x52, 0xd6, 0x24, 0x9d, 0xbd, 0x7a, 0x04, 0x8b, 0x5e, 0x83, 0x1b, 0x31, 0x1d, 0x1e, 0xb3,
-	0xf6, 0xbd, 0x8e, 0x63, 0xf9, 0x64, 0xcf, 0xb2, 0xbd, 0x1e, 0x13, 0x85, 0x69, 0x45, 0xe2, 0xb5,
-	0x48, 0x64, 0x93, 0xdd, 0xf3, 0x3a, 0x8e, 0x49, 0xf6, 0xd6, 0xe4, 0x30, 0x7a, 0x11, 0x62, 0x2e,
-	0x2c, 0xea, 0xf0, 0xc2, 0x4c, 0x39, 0xbd, 0x38, 0x6e, 0x4e, 0x47, 0x9d, 0x0d, 0x87, 0xaf, 0x4c,
-	0xbe, 0xfb, 0xb0, 0x34, 0xf6, 0xd9, 0xc3, 0xd2, 0x58, 0xe5, 0x2e, 0x4c, 0xef, 0xe2, 0x8e, 0x4e,
-	0x3a, 0xc2, 0xd1, 0x37, 0x61, 0x0a, 0x87, 0x8d, 0x82, 0x51, 0x4e, 0x3f, 0x35, 0x69, 0x63, 0xd1,
-	0xca, 0x43, 0x03, 0x32, 0xf5, 0xdd, 0x2d, 0x4c, 0x7d, 0xb4, 0x0e, 0x73, 0x71, 0xd0, 0x9e, 0x37,
-	0xff, 0xe3, 0x38, 0x0f, 0x0b, 0xc0, 0x3a, 0xcc, 0xdd, 0x0f, 0x4b, 0x4a, 0x04, 0x93, 0x3a, 0x0b,
-	0x26, 0x52, 0xd1, 0xfd, 0x09, 0x53, 0xdf, 0x80, 0x89, 0x60, 0x85, 0x1c, 0x7d, 0x07, 0x9e, 0xeb,
-	0xca, 0x0f, 0x65, 0x61, 0x76, 0x79, 0x61, 0x64, 0xa0, 0x2b, 0xf9, 0x64, 0x58, 0x04, 0x7a, 0x95,
-	0xff, 0x18, 0x00, 0xf5, 0xdd, 0xdd, 0x6d, 0x9f, 0x76, 0x3b, 0x44, 0x5c, 0x96, 0xc9, 0x6f, 0xc2,
-	0xf3, 0xb1, 0xc9, 0xdc, 0xb7, 0xcf, 0x6d, 0xf6, 0x95, 0x48, 0xad, 0xe9, 0xdb, 0xa7, 0xa2, 0x39,
-	0x5c, 0x44, 0x68, 0xe9, 0x73, 0xa3, 0xd5, 0xb9, 0x18, 0xe6, 0xf1, 0xfb, 0x90, 0x8d, 0x4d, 0xe7,
-	0xa8, 0x01, 0x93, 0x42, 0x7f, 0x6b, 0x3a, 0x2b, 0xa3, 0xe9, 0x0c, 0xd5, 0x92, 0x94, 0x46, 0xea,
-	0x95, 0xff, 0x4a, 0x56, 0xe3, 0x44, 0xf8, 0x42, 0x05, 0x92, 0xac, 0xf0, 0xba, 0x02, 0xa7, 0x2f,
-	0xa1, 0x02, 0x6b, 0xac, 0x04, 0xad, 0x3f, 0x4b, 0xc1, 0x95, 0x9d, 0x30, 0x49, 0xbf, 0xb0, 0x2c,
-	0xec, 0xc0, 0x04, 0x61, 0xc2, 0xa7, 0x8a, 0x06, 0xe9, 0xec, 0xaf, 0x8f, 0x72, 0xf6, 0x29, 0xb6,
-	0xac, 0x33, 0xe1, 0xf7, 0x93, 0xae, 0x0f, 0xb1, 0x12, 0x34, 0xfc, 0x21, 0x0d, 0x85, 0x51, 0xaa,
-	0xe8, 0x65, 0xc8, 0xd9, 0x3e, 0x51, 0x1d, 0xe1, 0x9e, 0x62, 0xa8, 0x72, 0x38, 0x1b, 0x76, 0xeb,
-	0x2d, 0xc5, 0x04, 0x79, 0x40, 0x93, 0x51, 0x25, 0x45, 0x9f, 0xed, 0x44, 0x36, 0x1b, 0x23, 0xa8,
-	0x4d, 0x85, 0x40, 0x8e, 0x32, 0x2a, 0x28, 0xee, 0x58, 0x2d, 0xdc, 0xc1, 0xcc, 0x26, 0xcf, 0x10,
-	0x09, 0xc3, 0x3b, 0xc0, 0xac, 0x06, 0xad, 0x05, 0x98, 0x68, 0x17, 0x26, 0x42, 0xf8, 0xf1, 0x4b,
-	0x80, 0x0f, 0xc1, 0xd0, 0x0b, 0x30, 0x9d, 0xdc, 0x18, 0xd4, 0x39, 0x65, 0xdc, 0xcc, 0x26, 0xf6,
-	0x85, 0xb3, 0x76, 0x9e, 0xcc, 0x53, 0x77, 0x1e, 0x7d, 0x14, 0xfc, 0x7d, 0x1a, 0xe6, 0x4c, 0xe2,
-	0x7c, 0x09, 0x1d, 0xf7, 0x43, 0x80, 0x20, 0xa9, 0x65, 0xb1, 0x7d, 0x06, 0xdf, 0x0d, 0x17, 0x89,
-	0xa9, 0x00, 0xaf, 0xce, 0xc5, 0xe7, 0xe5, 0xbd, 0xbf, 0xa4, 0x60, 0x3a, 0xe9, 0xbd, 0x2f, 0xc1,
-	0xce, 0x86, 0x36, 0xe2, 0x92, 0x36, 0xae, 0x4a, 0xda, 0x2b, 0xa3, 0x4a, 0xda, 0x50, 0x5c, 0x9f,
-	0x51, 0xcb, 0x7e, 0x93, 0x86, 0xcc, 0x16, 0xf6, 0xb1, 0xcb, 0xd1, 0xe6, 0xd0, 0x19, 0x37, 0xb8,
-	0x7f, 0x5e, 0x1f, 0x0a, 0xeb, 0xba, 0x7e, 0x43, 0x09, 0xa2, 0xfa, 0x57, 0xa3, 0x8e, 0xb8, 0x5f,
-	0x83, 0x59, 0x79, 0xa5, 0x8e, 0x0c, 0x0a, 0xa8, 0x9c, 0x51, 0xd7, 0xe1, 0xe8, 0x2a, 0xc6, 0x51,
-	0x09, 0xb2, 0x52, 0x2c, 0xae, 0xd9, 0x52, 0x06, 0x5c, 0x7c, 0xb8, 0x1e, 0xf4, 0xa0, 0xdb, 0x80,
-	0xf6, 0xa3, 0x87, 0x0f, 0x2b, 0x26, 0x42, 0xca, 0xcd, 0xc5, 0x23, 0xa1, 0xf8, 0x57, 0x01, 0xe4,
-	0x2a, 0x2c, 0x87, 0x30, 0xcf, 0xd5, 0x97, 0xc1, 0x29, 0xd9, 0x53, 0x97, 0x1d, 0xe8, 0x17, 0x46,
-	0x70, 0x54, 0x3e, 0x71, 0xdb, 0xd6, 0x97, 0x16, 0xeb, 0x62, 0xd9, 0xf0, 0xef, 0xe3, 0x52, 0xb1,
-	0x8f, 0xdd, 0xce, 0x4a, 0xe5, 0x14, 0xc8, 0xca, 0x69, 0x6f, 0x01, 0xf2, 0x34, 0x3d, 0x78, 0x71,
-	0x5f, 0xb9, 0x29, 0xe3, 0xfa, 0xe8, 0xd3, 0x0f, 0x6e, 0xdd, 0x48, 0x4c, 0x71, 0x18, 0xbd, 0x90,
-	0x05, 0xee, 0xa9, 0xfc, 0xd6, 0x00, 0x14, 0x6f, 0x36, 0x26, 0xe1, 0x5d, 0x79, 0x67, 0x94, 0xf7,
-	0x88, 0xc4, 0x79, 0xdf, 0x78, 0xfa, 0x3d, 0x22, 0xd6, 0x1f, 0xb8, 0x47, 0x24, 0x92, 0xe9, 0xdb,
-	0x71, 0x69, 0x4f, 0x69, 0xef, 0x6b, 0xac, 0x16, 0xe6, 0x24, 0x71, 0x21, 0xa1, 0x03, 0x10, 0xa1,
-	0x92, 0xca, 0xd1, 0xb1, 0xca, 0xb1, 0x01, 0xd7, 0x87, 0x22, 0x31, 0x5a, 0xb2, 0x0d, 0xc8, 0x4f,
-	0x0c, 0x2a, 0x8f, 0xf6, 0xf5, 0xd2, 0x9f, 0x2d, 0xb0, 0xe7, 0xfc, 0xa1, 0x72, 0xfe, 0x7f, 0xda,
-	0xa3, 0x74, 0x11, 0xfa, 0x93, 0x01, 0xf3, 0xc9, 0x15, 0x45, 0xb6, 0x35, 0x61, 0x3a, 0xb9, 0x16,
-	0x6d, 0xd5, 0xcd, 0xf3, 0x58, 0x95, 0x34, 0x68, 0x00, 0x44, 0xda, 0x12, 0x46, 0x7d, 0xf0, 0x56,
-	0x77, 0xe7, 0xdc, 0x2c, 0x85, 0x0b, 0x3b, 0xb5, 0x0c, 0x04, 0xce, 0xfa, 0x79, 0x0a, 0xc6, 0xb7,
-	0x3c, 0xaf, 0x83, 0x7e, 0x6a, 0xc0, 0x1c, 0xf3, 0x84, 0x25, 0x73, 0x85, 0x38, 0x96, 0x7e, 0x2c,
-	0x08, 0x2a, 0xe9, 0xee, 0xc5, 0xd8, 0xfb, 0xe7, 0x71, 0x69, 0x18, 0x6a, 0x90, 0x52, 0xfd, 0x48,
-	0xc5, 0x3c, 0x51, 0x53, 0x42, 0xdb, 0xc1, 0x7b, 0xc2, 0x3b, 0x30, 0x33, 0x38, 0x7f, 0x50, 0x7e,
-	0xcd, 0x0b, 0xcf, 0x3f, 0x73, 0xe6, 0xdc, 0xd3, 0xad, 0xc4, 0xc4, 0x2b, 0x93, 0xd2, 0xb1, 0xff,
-	0x92, 0xce, 0x7d, 0x1b, 0xf2, 0x51, 0x79, 0xda, 0x51, 0x4f, 0x5e, 0xf2, 0x6c, 0x3a, 0x11, 0xbc,
-	0x7e, 0x85, 0x37, 0x88, 0x72, 0xf2, 0xad, 0x15, 0xb7, 0x6c, 0x5a, 0x3d, 0xa1, 0x33, 0xc0, 0xb8,
-	0xd6, 0xbd, 0xf5, 0x3b, 0x03, 0x20, 0x7e, 0x9a, 0x41, 0xaf, 0xc2, 0xb5, 0xda, 0xe6, 0x46, 0xdd,
-	0x6a, 0x6e, 0xaf, 0x6e, 0xef, 0x34, 0xad, 0x9d, 0x8d, 0xe6, 0xd6, 0xfa, 0x5a, 0xe3, 0x6e, 0x63,
-	0xbd, 0x9e, 0x1f, 0x2b, 0xe6, 0x8e, 0x1e, 0x94, 0xb3, 0x3b, 0x8c, 0x77, 0x89, 0x4d, 0xf7, 0x28,
-	0x71, 0xd0, 0x4b, 0x30, 0x3f, 0x28, 0x2d, 0x5b, 0xeb, 0xf5, 0xbc, 0x51, 0x9c, 0x3e, 0x7a, 0x50,
-	0x9e, 0x0c, 0x8e, 0xa4, 0xc4, 0x41, 0x8b, 0xf0, 0xfc, 0xb0, 0x5c, 0x63, 0xe3, 0xf5, 0x7c, 0xaa,
-	0x38, 0x73, 0xf4, 0xa0, 0x3c, 0x15, 0x9d, 0x5d, 0x51, 0x05, 0x50, 0x52, 0x52, 0xe3, 0xa5, 0x8b,
-	0x70, 0xf4, 0xa0, 0x9c, 0x09, 0xdc, 0x52, 0x1c, 0x7f, 0xf7, 0xd7, 0x0b, 0x63, 0xb7, 0x7e, 0x0c,
-	0xd0, 0x60, 0x7b, 0x3e, 0xb6, 0x55, 0x40, 0x16, 0xe1, 0x6a, 0x63, 0xe3, 0xae, 0xb9, 0xba, 0xb6,
-	0xdd, 0xd8, 0xdc, 0x18, 0x5c, 0xf6, 0x89, 0xb1, 0xfa, 0xe6, 0x4e, 0xed, 0xcd, 0x75, 0xab, 0xd9,
-	0x78, 0x7d, 0x23, 0x6f, 0xa0, 0x6b, 0x70, 0x65, 0x60, 0xec, 0x7b, 0x1b, 0xdb, 0x8d, 0xb7, 0xd6,
-	0xf3, 0xa9, 0xda, 0xdd, 0x8f, 0x1f, 0x2f, 0x18, 0x8f, 0x1e, 0x2f, 0x18, 0xff, 0x78, 0xbc, 0x60,
-	0xbc, 0xf7, 0x64, 0x61, 0xec, 0xd1, 0x93, 0x85, 0xb1, 0xbf, 0x3e, 0x59, 0x18, 0xfb, 0xc1, 0xab,
-	0x4f, 0x75, 0x78, 0x5c, 0x25, 0x95, 0xeb, 0x5b, 0x19, 0xb5, 0x47, 0x7d, 0xe3, 0x7f, 0x01, 0x00,
-	0x00, 0xff, 0xff, 0x93, 0xd7, 0xc2, 0x0d, 0x66, 0x18, 0x00, 0x00,
+func (m *ValidatorJailInfo) GetTime() time.Time {
+	if m != nil {
+		return m.Time
+	}
+	return time.Time{}
 }
 
-func (this *Pool) Description() (desc *github_com_cosmos_gogoproto_protoc_gen_gogo_descriptor.FileDescriptorSet) {
-	return StakingDescription()
-}
-func StakingDescription() (desc *github_com_cosmos_gogoproto_protoc_gen_gogo_descriptor.FileDescriptorSet) {
-	d := &github_com_cosmos_gogoproto_protoc_gen_gogo_descriptor.FileDescriptorSet{}
-	var gzipped = []byte{
-		// 10925 bytes of a gzipped FileDescriptorSet
-		0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe4, 0x7d, 0x69, 0x90, 0x1c, 0xd7,
-		0x79, 0xd8, 0xce, 0xb1, 0x73, 0x7c, 0x73, 0xf5, 0xbe, 0x5d, 0x00, 0x8b, 0x01, 0xb9, 0xbb, 0x6c,
-		0x1e, 0x38, 0x48, 0x2e, 0x48, 0x90, 0x00, 0xc9, 0x85, 0x28, 0x7a, 0x66, 0x76, 0xb0, 0x18, 0x60,
-		0x2f, 0xf5, 0xcc, 0x82, 0x87, 0x6d, 0xb5, 0x7b, 0x7b, 0xde, 0xee, 0x36, 0x31, 0xd3, 0x3d, 0x9a,
-		0xee, 0x01, 0x76, 0x59, 0xa9, 0x14, 0x6d, 0xd9, 0x8e, 0x8c, 0x38, 0xb6, 0x1c, 0xa7, 0x6c, 0xf9,
-		0x80, 0x43, 0xd9, 0xb1, 0x25, 0x3b, 0x97, 0xaf, 0x58, 0x76, 0x54, 0x76, 0xd9, 0xa9, 0x1c, 0xb2,
-		0x93, 0x4a, 0xc9, 0xae, 0x54, 0xe2, 0x4a, 0x25, 0x8c, 0x4d, 0xb9, 0x2c, 0x45, 0x92, 0x63, 0x5b,
-		0xa1, 0x93, 0x38, 0xaa, 0x1c, 0xf5, 0xae, 0x3e, 0xe6, 0xd8, 0x9e, 0x85, 0x48, 0x46, 0x29, 0xfd,
-		0x01, 0xe6, 0xbd, 0xf7, 0x7d, 0xdf, 0x7b, 0xef, 0x7b, 0xdf, 0xfb, 0xae, 0xf7, 0xfa, 0x2d, 0xfc,
-		0x61, 0x19, 0x16, 0x76, 0x2d, 0x6b, 0xb7, 0x85, 0xcf, 0x77, 0xba, 0x96, 0x63, 0x6d, 0xf7, 0x76,
-		0xce, 0x37, 0xb1, 0xad, 0x77, 0x8d, 0x8e, 0x63, 0x75, 0x17, 0x69, 0x1d, 0x2a, 0x30, 0x88, 0x45,
-		0x01, 0x21, 0xaf, 0xc1, 0xd4, 0x15, 0xa3, 0x85, 0x97, 0x5d, 0xc0, 0x3a, 0x76, 0xd0, 0xb3, 0x10,
-		0xdf, 0x31, 0x5a, 0x78, 0x36, 0xb2, 0x10, 0x3b, 0x93, 0xb9, 0xf0, 0xd0, 0x62, 0x1f, 0xd2, 0x62,
-		0x10, 0x63, 0x93, 0x54, 0x2b, 0x14, 0x43, 0xfe, 0xdf, 0x71, 0x98, 0x1e, 0xd2, 0x8a, 0x10, 0xc4,
-		0x4d, 0xad, 0x4d, 0x28, 0x46, 0xce, 0xa4, 0x15, 0xfa, 0x1b, 0xcd, 0x42, 0xb2, 0xa3, 0xe9, 0x37,
-		0xb5, 0x5d, 0x3c, 0x1b, 0xa5, 0xd5, 0xa2, 0x88, 0xe6, 0x00, 0x9a, 0xb8, 0x83, 0xcd, 0x26, 0x36,
-		0xf5, 0x83, 0xd9, 0xd8, 0x42, 0xec, 0x4c, 0x5a, 0xf1, 0xd5, 0xa0, 0x47, 0x61, 0xaa, 0xd3, 0xdb,
-		0x6e, 0x19, 0xba, 0xea, 0x03, 0x83, 0x85, 0xd8, 0x99, 0x49, 0x45, 0x62, 0x0d, 0xcb, 0x1e, 0xf0,
-		0x69, 0x28, 0xdc, 0xc6, 0xda, 0x4d, 0x3f, 0x68, 0x86, 0x82, 0xe6, 0x49, 0xb5, 0x0f, 0xb0, 0x02,
-		0xd9, 0x36, 0xb6, 0x6d, 0x6d, 0x17, 0xab, 0xce, 0x41, 0x07, 0xcf, 0xc6, 0xe9, 0xec, 0x17, 0x06,
-		0x66, 0xdf, 0x3f, 0xf3, 0x0c, 0xc7, 0x6a, 0x1c, 0x74, 0x30, 0x2a, 0x41, 0x1a, 0x9b, 0xbd, 0x36,
-		0xa3, 0x30, 0x39, 0x82, 0x7f, 0x55, 0xb3, 0xd7, 0xee, 0xa7, 0x92, 0x22, 0x68, 0x9c, 0x44, 0xd2,
-		0xc6, 0xdd, 0x5b, 0x86, 0x8e, 0x67, 0x13, 0x94, 0xc0, 0xe9, 0x01, 0x02, 0x75, 0xd6, 0xde, 0x4f,
-		0x43, 0xe0, 0xa1, 0x0a, 0xa4, 0xf1, 0xbe, 0x83, 0x4d, 0xdb, 0xb0, 0xcc, 0xd9, 0x24, 0x25, 0xf2,
-		0xf0, 0x90, 0x55, 0xc4, 0xad, 0x66, 0x3f, 0x09, 0x0f, 0x0f, 0x5d, 0x82, 0xa4, 0xd5, 0x71, 0x0c,
-		0xcb, 0xb4, 0x67, 0x53, 0x0b, 0x91, 0x33, 0x99, 0x0b, 0xf7, 0x0d, 0x15, 0x84, 0x0d, 0x06, 0xa3,
-		0x08, 0x60, 0x54, 0x03, 0xc9, 0xb6, 0x7a, 0x5d, 0x1d, 0xab, 0xba, 0xd5, 0xc4, 0xaa, 0x61, 0xee,
-		0x58, 0xb3, 0x69, 0x4a, 0x60, 0x7e, 0x70, 0x22, 0x14, 0xb0, 0x62, 0x35, 0x71, 0xcd, 0xdc, 0xb1,
-		0x94, 0xbc, 0x1d, 0x28, 0xa3, 0xe3, 0x90, 0xb0, 0x0f, 0x4c, 0x47, 0xdb, 0x9f, 0xcd, 0x52, 0x09,
-		0xe1, 0x25, 0x22, 0x3a, 0xb8, 0x69, 0x90, 0xee, 0x66, 0x73, 0x4c, 0x74, 0x78, 0x51, 0xfe, 0xb5,
-		0x04, 0x14, 0xc6, 0x11, 0xbe, 0xcb, 0x30, 0xb9, 0x43, 0xe6, 0x3f, 0x1b, 0x3d, 0x0a, 0x77, 0x18,
-		0x4e, 0x90, 0xbd, 0x89, 0x7b, 0x64, 0x6f, 0x09, 0x32, 0x26, 0xb6, 0x1d, 0xdc, 0x64, 0xb2, 0x12,
-		0x1b, 0x53, 0xda, 0x80, 0x21, 0x0d, 0x0a, 0x5b, 0xfc, 0x9e, 0x84, 0xed, 0x25, 0x28, 0xb8, 0x43,
-		0x52, 0xbb, 0x9a, 0xb9, 0x2b, 0xa4, 0xf6, 0x7c, 0xd8, 0x48, 0x16, 0xab, 0x02, 0x4f, 0x21, 0x68,
-		0x4a, 0x1e, 0x07, 0xca, 0x68, 0x19, 0xc0, 0x32, 0xb1, 0xb5, 0xa3, 0x36, 0xb1, 0xde, 0x9a, 0x4d,
-		0x8d, 0xe0, 0xd2, 0x06, 0x01, 0x19, 0xe0, 0x92, 0xc5, 0x6a, 0xf5, 0x16, 0x7a, 0xce, 0x13, 0xc2,
-		0xe4, 0x08, 0x19, 0x5a, 0x63, 0xdb, 0x6f, 0x40, 0x0e, 0xb7, 0x20, 0xdf, 0xc5, 0x64, 0x47, 0xe0,
-		0x26, 0x9f, 0x59, 0x9a, 0x0e, 0x62, 0x31, 0x74, 0x66, 0x0a, 0x47, 0x63, 0x13, 0xcb, 0x75, 0xfd,
-		0x45, 0xf4, 0x20, 0xb8, 0x15, 0x2a, 0x15, 0x2b, 0xa0, 0xfa, 0x29, 0x2b, 0x2a, 0xd7, 0xb5, 0x36,
-		0x2e, 0xbe, 0x06, 0xf9, 0x20, 0x7b, 0xd0, 0x0c, 0x4c, 0xda, 0x8e, 0xd6, 0x75, 0xa8, 0x14, 0x4e,
-		0x2a, 0xac, 0x80, 0x24, 0x88, 0x61, 0xb3, 0x49, 0xf5, 0xdf, 0xa4, 0x42, 0x7e, 0xa2, 0x6f, 0xf2,
-		0x26, 0x1c, 0xa3, 0x13, 0x7e, 0x64, 0x70, 0x45, 0x03, 0x94, 0xfb, 0xe7, 0x5d, 0x7c, 0x06, 0x72,
-		0x81, 0x09, 0x8c, 0xdb, 0xb5, 0xfc, 0x57, 0xe0, 0xd8, 0x50, 0xd2, 0xe8, 0x25, 0x98, 0xe9, 0x99,
-		0x86, 0xe9, 0xe0, 0x6e, 0xa7, 0x8b, 0x89, 0xc4, 0xb2, 0xae, 0x66, 0x3f, 0x9f, 0x1c, 0x21, 0x73,
-		0x5b, 0x7e, 0x68, 0x46, 0x45, 0x99, 0xee, 0x0d, 0x56, 0x9e, 0x4b, 0xa7, 0xbe, 0x90, 0x94, 0x5e,
-		0x7f, 0xfd, 0xf5, 0xd7, 0xa3, 0xf2, 0x6f, 0x25, 0x60, 0x66, 0xd8, 0x9e, 0x19, 0xba, 0x7d, 0x8f,
-		0x43, 0xc2, 0xec, 0xb5, 0xb7, 0x71, 0x97, 0x32, 0x69, 0x52, 0xe1, 0x25, 0x54, 0x82, 0xc9, 0x96,
-		0xb6, 0x8d, 0x5b, 0xb3, 0xf1, 0x85, 0xc8, 0x99, 0xfc, 0x85, 0x47, 0xc7, 0xda, 0x95, 0x8b, 0xab,
-		0x04, 0x45, 0x61, 0x98, 0xe8, 0xfd, 0x10, 0xe7, 0xca, 0x9b, 0x50, 0x38, 0x37, 0x1e, 0x05, 0xb2,
-		0x97, 0x14, 0x8a, 0x87, 0x4e, 0x41, 0x9a, 0xfc, 0xcf, 0x64, 0x23, 0x41, 0xc7, 0x9c, 0x22, 0x15,
-		0x44, 0x2e, 0x50, 0x11, 0x52, 0x74, 0x9b, 0x34, 0xb1, 0x30, 0x7a, 0x6e, 0x99, 0x08, 0x56, 0x13,
-		0xef, 0x68, 0xbd, 0x96, 0xa3, 0xde, 0xd2, 0x5a, 0x3d, 0x4c, 0x05, 0x3e, 0xad, 0x64, 0x79, 0xe5,
-		0x0d, 0x52, 0x87, 0xe6, 0x21, 0xc3, 0x76, 0x95, 0x61, 0x36, 0xf1, 0x3e, 0xd5, 0xab, 0x93, 0x0a,
-		0xdb, 0x68, 0x35, 0x52, 0x43, 0xba, 0x7f, 0xd5, 0xb6, 0x4c, 0x21, 0x9a, 0xb4, 0x0b, 0x52, 0x41,
-		0xbb, 0x7f, 0xa6, 0x5f, 0xa5, 0xdf, 0x3f, 0x7c, 0x7a, 0x03, 0x7b, 0xe9, 0x34, 0x14, 0x28, 0xc4,
-		0x53, 0x7c, 0xe9, 0xb5, 0xd6, 0xec, 0xd4, 0x42, 0xe4, 0x4c, 0x4a, 0xc9, 0xb3, 0xea, 0x0d, 0x5e,
-		0x2b, 0xff, 0x4a, 0x14, 0xe2, 0x54, 0xb1, 0x14, 0x20, 0xd3, 0x78, 0x79, 0xb3, 0xaa, 0x2e, 0x6f,
-		0x6c, 0x95, 0x57, 0xab, 0x52, 0x04, 0xe5, 0x01, 0x68, 0xc5, 0x95, 0xd5, 0x8d, 0x52, 0x43, 0x8a,
-		0xba, 0xe5, 0xda, 0x7a, 0xe3, 0xd2, 0xd3, 0x52, 0xcc, 0x45, 0xd8, 0x62, 0x15, 0x71, 0x3f, 0xc0,
-		0x53, 0x17, 0xa4, 0x49, 0x24, 0x41, 0x96, 0x11, 0xa8, 0xbd, 0x54, 0x5d, 0xbe, 0xf4, 0xb4, 0x94,
-		0x08, 0xd6, 0x3c, 0x75, 0x41, 0x4a, 0xa2, 0x1c, 0xa4, 0x69, 0x4d, 0x79, 0x63, 0x63, 0x55, 0x4a,
-		0xb9, 0x34, 0xeb, 0x0d, 0xa5, 0xb6, 0xbe, 0x22, 0xa5, 0x5d, 0x9a, 0x2b, 0xca, 0xc6, 0xd6, 0xa6,
-		0x04, 0x2e, 0x85, 0xb5, 0x6a, 0xbd, 0x5e, 0x5a, 0xa9, 0x4a, 0x19, 0x17, 0xa2, 0xfc, 0x72, 0xa3,
-		0x5a, 0x97, 0xb2, 0x81, 0x61, 0x3d, 0x75, 0x41, 0xca, 0xb9, 0x5d, 0x54, 0xd7, 0xb7, 0xd6, 0xa4,
-		0x3c, 0x9a, 0x82, 0x1c, 0xeb, 0x42, 0x0c, 0xa2, 0xd0, 0x57, 0x75, 0xe9, 0x69, 0x49, 0xf2, 0x06,
-		0xc2, 0xa8, 0x4c, 0x05, 0x2a, 0x2e, 0x3d, 0x2d, 0x21, 0xb9, 0x02, 0x93, 0x54, 0x0c, 0x11, 0x82,
-		0xfc, 0x6a, 0xa9, 0x5c, 0x5d, 0x55, 0x37, 0x36, 0x1b, 0xb5, 0x8d, 0xf5, 0xd2, 0xaa, 0x14, 0xf1,
-		0xea, 0x94, 0xea, 0x07, 0xb6, 0x6a, 0x4a, 0x75, 0x59, 0x8a, 0xfa, 0xeb, 0x36, 0xab, 0xa5, 0x46,
-		0x75, 0x59, 0x8a, 0xc9, 0x3a, 0xcc, 0x0c, 0x53, 0xa8, 0x43, 0xb7, 0x90, 0x4f, 0x16, 0xa2, 0x23,
-		0x64, 0x81, 0xd2, 0xea, 0x97, 0x05, 0xf9, 0x73, 0x51, 0x98, 0x1e, 0x62, 0x54, 0x86, 0x76, 0xf2,
-		0x02, 0x4c, 0x32, 0x59, 0x66, 0x66, 0xf6, 0xec, 0x50, 0xeb, 0x44, 0x25, 0x7b, 0xc0, 0xd4, 0x52,
-		0x3c, 0xbf, 0x13, 0x12, 0x1b, 0xe1, 0x84, 0x10, 0x12, 0x03, 0x02, 0xfb, 0xad, 0x03, 0xca, 0x9f,
-		0xd9, 0xc7, 0x4b, 0xe3, 0xd8, 0x47, 0x5a, 0x77, 0x34, 0x23, 0x30, 0x39, 0xc4, 0x08, 0x5c, 0x86,
-		0xa9, 0x01, 0x42, 0x63, 0x2b, 0xe3, 0x0f, 0x47, 0x60, 0x76, 0x14, 0x73, 0x42, 0x54, 0x62, 0x34,
-		0xa0, 0x12, 0x2f, 0xf7, 0x73, 0xf0, 0x81, 0xd1, 0x8b, 0x30, 0xb0, 0xd6, 0x9f, 0x88, 0xc0, 0xf1,
-		0xe1, 0xce, 0xe6, 0xd0, 0x31, 0xbc, 0x1f, 0x12, 0x6d, 0xec, 0xec, 0x59, 0xc2, 0xad, 0x7a, 0x64,
-		0x88, 0xb1, 0x26, 0xcd, 0xfd, 0x8b, 0xcd, 0xb1, 0xfc, 0xd6, 0x3e, 0x36, 0xca, 0x63, 0x64, 0xa3,
-		0x19, 0x18, 0xe9, 0xf7, 0x44, 0xe1, 0xd8, 0x50, 0xe2, 0x43, 0x07, 0x7a, 0x3f, 0x80, 0x61, 0x76,
-		0x7a, 0x0e, 0x73, 0x9d, 0x98, 0x26, 0x4e, 0xd3, 0x1a, 0xaa, 0xbc, 0x88, 0x96, 0xed, 0x39, 0x6e,
-		0x7b, 0x8c, 0xb6, 0x03, 0xab, 0xa2, 0x00, 0xcf, 0x7a, 0x03, 0x8d, 0xd3, 0x81, 0xce, 0x8d, 0x98,
-		0xe9, 0x80, 0x60, 0x3e, 0x01, 0x92, 0xde, 0x32, 0xb0, 0xe9, 0xa8, 0xb6, 0xd3, 0xc5, 0x5a, 0xdb,
-		0x30, 0x77, 0xa9, 0xa9, 0x49, 0x2d, 0x4d, 0xee, 0x68, 0x2d, 0x1b, 0x2b, 0x05, 0xd6, 0x5c, 0x17,
-		0xad, 0x04, 0x83, 0x0a, 0x50, 0xd7, 0x87, 0x91, 0x08, 0x60, 0xb0, 0x66, 0x17, 0x43, 0xfe, 0x81,
-		0x34, 0x64, 0x7c, 0xae, 0x39, 0x7a, 0x00, 0xb2, 0xaf, 0x6a, 0xb7, 0x34, 0x55, 0x84, 0x5b, 0x8c,
-		0x13, 0x19, 0x52, 0xb7, 0xc9, 0x43, 0xae, 0x27, 0x60, 0x86, 0x82, 0x58, 0x3d, 0x07, 0x77, 0x55,
-		0xbd, 0xa5, 0xd9, 0x36, 0x65, 0x5a, 0x8a, 0x82, 0x22, 0xd2, 0xb6, 0x41, 0x9a, 0x2a, 0xa2, 0x05,
-		0x5d, 0x84, 0x69, 0x8a, 0xd1, 0xee, 0xb5, 0x1c, 0xa3, 0xd3, 0xc2, 0x2a, 0x09, 0x00, 0x6d, 0x6a,
-		0x72, 0xdc, 0x91, 0x4d, 0x11, 0x88, 0x35, 0x0e, 0x40, 0x46, 0x64, 0xa3, 0x65, 0xb8, 0x9f, 0xa2,
-		0xed, 0x62, 0x13, 0x77, 0x35, 0x07, 0xab, 0xf8, 0x43, 0x3d, 0xad, 0x65, 0xab, 0x9a, 0xd9, 0x54,
-		0xf7, 0x34, 0x7b, 0x6f, 0x76, 0x86, 0x10, 0x28, 0x47, 0x67, 0x23, 0xca, 0x49, 0x02, 0xb8, 0xc2,
-		0xe1, 0xaa, 0x14, 0xac, 0x64, 0x36, 0xaf, 0x6a, 0xf6, 0x1e, 0x5a, 0x82, 0xe3, 0x94, 0x8a, 0xed,
-		0x74, 0x0d, 0x73, 0x57, 0xd5, 0xf7, 0xb0, 0x7e, 0x53, 0xed, 0x39, 0x3b, 0xcf, 0xce, 0x9e, 0xf2,
-		0xf7, 0x4f, 0x47, 0x58, 0xa7, 0x30, 0x15, 0x02, 0xb2, 0xe5, 0xec, 0x3c, 0x8b, 0xea, 0x90, 0x25,
-		0x8b, 0xd1, 0x36, 0x5e, 0xc3, 0xea, 0x8e, 0xd5, 0xa5, 0x36, 0x34, 0x3f, 0x44, 0x35, 0xf9, 0x38,
-		0xb8, 0xb8, 0xc1, 0x11, 0xd6, 0xac, 0x26, 0x5e, 0x9a, 0xac, 0x6f, 0x56, 0xab, 0xcb, 0x4a, 0x46,
-		0x50, 0xb9, 0x62, 0x75, 0x89, 0x40, 0xed, 0x5a, 0x2e, 0x83, 0x33, 0x4c, 0xa0, 0x76, 0x2d, 0xc1,
-		0xde, 0x8b, 0x30, 0xad, 0xeb, 0x6c, 0xce, 0x86, 0xae, 0xf2, 0x30, 0xcd, 0x9e, 0x95, 0x02, 0xcc,
-		0xd2, 0xf5, 0x15, 0x06, 0xc0, 0x65, 0xdc, 0x46, 0xcf, 0xc1, 0x31, 0x8f, 0x59, 0x7e, 0xc4, 0xa9,
-		0x81, 0x59, 0xf6, 0xa3, 0x5e, 0x84, 0xe9, 0xce, 0xc1, 0x20, 0x22, 0x0a, 0xf4, 0xd8, 0x39, 0xe8,
-		0x47, 0x7b, 0x06, 0x66, 0x3a, 0x7b, 0x9d, 0x41, 0xbc, 0x73, 0x7e, 0x3c, 0xd4, 0xd9, 0xeb, 0xf4,
-		0x23, 0x3e, 0x4c, 0x63, 0xf6, 0x2e, 0xd6, 0x35, 0x07, 0x37, 0x67, 0x4f, 0xf8, 0xc1, 0x7d, 0x0d,
-		0x68, 0x11, 0x24, 0x5d, 0x57, 0xb1, 0xa9, 0x6d, 0xb7, 0xb0, 0xaa, 0x75, 0xb1, 0xa9, 0xd9, 0xb3,
-		0xf3, 0x14, 0x38, 0xee, 0x74, 0x7b, 0x58, 0xc9, 0xeb, 0x7a, 0x95, 0x36, 0x96, 0x68, 0x1b, 0x3a,
-		0x07, 0x53, 0xd6, 0xf6, 0xab, 0x3a, 0x93, 0x48, 0xb5, 0xd3, 0xc5, 0x3b, 0xc6, 0xfe, 0xec, 0x43,
-		0x94, 0xbd, 0x05, 0xd2, 0x40, 0xe5, 0x71, 0x93, 0x56, 0xa3, 0xb3, 0x20, 0xe9, 0xf6, 0x9e, 0xd6,
-		0xed, 0x50, 0x95, 0x6c, 0x77, 0x34, 0x1d, 0xcf, 0x3e, 0xcc, 0x40, 0x59, 0xfd, 0xba, 0xa8, 0x26,
-		0x3b, 0xc2, 0xbe, 0x6d, 0xec, 0x38, 0x82, 0xe2, 0x69, 0xb6, 0x23, 0x68, 0x1d, 0xa7, 0x76, 0x06,
-		0x24, 0xc2, 0x89, 0x40, 0xc7, 0x67, 0x28, 0x58, 0xbe, 0xb3, 0xd7, 0xf1, 0xf7, 0xfb, 0x20, 0xe4,
-		0x08, 0xa4, 0xd7, 0xe9, 0x59, 0xe6, 0xb8, 0x75, 0xf6, 0x7c, 0x3d, 0x3e, 0x0d, 0xc7, 0x09, 0x50,
-		0x1b, 0x3b, 0x5a, 0x53, 0x73, 0x34, 0x1f, 0xf4, 0x63, 0x14, 0x9a, 0xb0, 0x7d, 0x8d, 0x37, 0x06,
-		0xc6, 0xd9, 0xed, 0x6d, 0x1f, 0xb8, 0x82, 0xf5, 0x38, 0x1b, 0x27, 0xa9, 0x13, 0xa2, 0xf5, 0xae,
-		0x39, 0xe7, 0xf2, 0x12, 0x64, 0xfd, 0x72, 0x8f, 0xd2, 0xc0, 0x24, 0x5f, 0x8a, 0x10, 0x27, 0xa8,
-		0xb2, 0xb1, 0x4c, 0xdc, 0x97, 0x57, 0xaa, 0x52, 0x94, 0xb8, 0x51, 0xab, 0xb5, 0x46, 0x55, 0x55,
-		0xb6, 0xd6, 0x1b, 0xb5, 0xb5, 0xaa, 0x14, 0xf3, 0x39, 0xf6, 0xd7, 0xe2, 0xa9, 0x47, 0xa4, 0xd3,
-		0xf2, 0xa7, 0x63, 0x90, 0x0f, 0x46, 0x6a, 0xe8, 0x7d, 0x70, 0x42, 0x24, 0x5c, 0x6c, 0xec, 0xa8,
-		0xb7, 0x8d, 0x2e, 0xdd, 0x90, 0x6d, 0x8d, 0x19, 0x47, 0x57, 0x7e, 0x66, 0x38, 0x54, 0x1d, 0x3b,
-		0x2f, 0x1a, 0x5d, 0xb2, 0xdd, 0xda, 0x9a, 0x83, 0x56, 0x61, 0xde, 0xb4, 0x54, 0xdb, 0xd1, 0xcc,
-		0xa6, 0xd6, 0x6d, 0xaa, 0x5e, 0xaa, 0x4b, 0xd5, 0x74, 0x1d, 0xdb, 0xb6, 0xc5, 0x0c, 0xa1, 0x4b,
-		0xe5, 0x3e, 0xd3, 0xaa, 0x73, 0x60, 0xcf, 0x42, 0x94, 0x38, 0x68, 0x9f, 0xf8, 0xc6, 0x46, 0x89,
-		0xef, 0x29, 0x48, 0xb7, 0xb5, 0x8e, 0x8a, 0x4d, 0xa7, 0x7b, 0x40, 0xfd, 0xf3, 0x94, 0x92, 0x6a,
-		0x6b, 0x9d, 0x2a, 0x29, 0xa3, 0x1b, 0xf0, 0x88, 0x07, 0xaa, 0xb6, 0xf0, 0xae, 0xa6, 0x1f, 0xa8,
-		0xd4, 0x19, 0xa7, 0x69, 0x03, 0x55, 0xb7, 0xcc, 0x9d, 0x96, 0xa1, 0x3b, 0x36, 0xd5, 0x0f, 0x4c,
-		0xc7, 0xc9, 0x1e, 0xc6, 0x2a, 0x45, 0xb8, 0x66, 0x5b, 0x26, 0xf5, 0xc1, 0x2b, 0x02, 0xfa, 0x3d,
-		0x09, 0xbf, 0xae, 0xc5, 0x53, 0x71, 0x69, 0xf2, 0x5a, 0x3c, 0x35, 0x29, 0x25, 0xae, 0xc5, 0x53,
-		0x09, 0x29, 0x79, 0x2d, 0x9e, 0x4a, 0x49, 0xe9, 0x6b, 0xf1, 0x54, 0x5a, 0x02, 0xf9, 0x53, 0x29,
-		0xc8, 0xfa, 0x23, 0x03, 0x12, 0x68, 0xe9, 0xd4, 0x36, 0x46, 0xa8, 0xf6, 0x7c, 0xf0, 0xd0, 0x38,
-		0x62, 0xb1, 0x42, 0x8c, 0xe6, 0x52, 0x82, 0xb9, 0xe1, 0x0a, 0xc3, 0x24, 0x0e, 0x0b, 0x11, 0x6b,
-		0xcc, 0xdc, 0x9e, 0x94, 0xc2, 0x4b, 0x68, 0x05, 0x12, 0xaf, 0xda, 0x94, 0x76, 0x82, 0xd2, 0x7e,
-		0xe8, 0x70, 0xda, 0xd7, 0xea, 0x94, 0x78, 0xfa, 0x5a, 0x5d, 0x5d, 0xdf, 0x50, 0xd6, 0x4a, 0xab,
-		0x0a, 0x47, 0x47, 0x27, 0x21, 0xde, 0xd2, 0x5e, 0x3b, 0x08, 0x9a, 0x57, 0x5a, 0x85, 0x16, 0xa1,
-		0xd0, 0x33, 0x6f, 0xe1, 0xae, 0xb1, 0x63, 0x90, 0xa5, 0x22, 0x50, 0x05, 0x3f, 0x54, 0xde, 0x6b,
-		0x5d, 0x25, 0xf0, 0x63, 0x8a, 0xc7, 0x49, 0x88, 0xdf, 0xc6, 0xda, 0xcd, 0xa0, 0x11, 0xa4, 0x55,
-		0xe8, 0x0c, 0x64, 0x9b, 0x78, 0xbb, 0xb7, 0xab, 0x76, 0x71, 0x53, 0xd3, 0x9d, 0xa0, 0xea, 0xcf,
-		0xd0, 0x26, 0x85, 0xb6, 0xa0, 0xeb, 0x90, 0x26, 0x6b, 0x64, 0xd2, 0x35, 0x9e, 0xa2, 0x2c, 0x78,
-		0xfc, 0x70, 0x16, 0xf0, 0x25, 0x16, 0x48, 0x8a, 0x87, 0x8f, 0xae, 0x40, 0xc2, 0xd1, 0xba, 0xbb,
-		0xd8, 0xa1, 0x9a, 0x3f, 0x3f, 0x24, 0xf9, 0x31, 0x84, 0x52, 0x83, 0x62, 0xd0, 0x98, 0x96, 0x63,
-		0xbf, 0x8b, 0x5a, 0xe6, 0x3c, 0x4c, 0x52, 0xf1, 0x40, 0x00, 0x5c, 0x40, 0xa4, 0x09, 0x94, 0x82,
-		0x78, 0x65, 0x43, 0x21, 0x9a, 0x46, 0x82, 0x2c, 0xab, 0x55, 0x37, 0x6b, 0xd5, 0x4a, 0x55, 0x8a,
-		0xca, 0x17, 0x21, 0xc1, 0xd6, 0x9c, 0x68, 0x21, 0x77, 0xd5, 0xa5, 0x09, 0x5e, 0xe4, 0x34, 0x22,
-		0xa2, 0x75, 0x6b, 0xad, 0x5c, 0x55, 0xa4, 0xa8, 0xbc, 0x05, 0x85, 0x3e, 0x3e, 0xa1, 0x63, 0x30,
-		0xa5, 0x54, 0x1b, 0xd5, 0x75, 0x12, 0x67, 0xa9, 0x5b, 0xeb, 0xd7, 0xd7, 0x37, 0x5e, 0x5c, 0x97,
-		0x26, 0x82, 0xd5, 0x42, 0xa5, 0x45, 0xd0, 0x0c, 0x48, 0x5e, 0x75, 0x7d, 0x63, 0x4b, 0xa1, 0xa3,
-		0xf9, 0xde, 0x28, 0x48, 0xfd, 0x5c, 0x43, 0x27, 0x60, 0xba, 0x51, 0x52, 0x56, 0xaa, 0x0d, 0x95,
-		0xc5, 0x8e, 0x2e, 0xe9, 0x19, 0x90, 0xfc, 0x0d, 0x57, 0x6a, 0x34, 0x34, 0x9e, 0x87, 0x53, 0xfe,
-		0xda, 0xea, 0x4b, 0x8d, 0xea, 0x7a, 0x9d, 0x76, 0x5e, 0x5a, 0x5f, 0x21, 0xfa, 0xb5, 0x8f, 0x9e,
-		0x88, 0x56, 0x63, 0x64, 0xa8, 0x41, 0x7a, 0xd5, 0xd5, 0x65, 0x29, 0xde, 0x5f, 0xbd, 0xb1, 0x5e,
-		0xdd, 0xb8, 0x22, 0x4d, 0xf6, 0xf7, 0x4e, 0x23, 0xd8, 0x04, 0x2a, 0xc2, 0xf1, 0xfe, 0x5a, 0xb5,
-		0xba, 0xde, 0x50, 0x5e, 0x96, 0x92, 0xfd, 0x1d, 0xd7, 0xab, 0xca, 0x8d, 0x5a, 0xa5, 0x2a, 0xa5,
-		0xd0, 0x71, 0x40, 0xc1, 0x11, 0x35, 0xae, 0x6e, 0x2c, 0x4b, 0xe9, 0x01, 0x8d, 0x22, 0xdb, 0x90,
-		0xf5, 0x87, 0x91, 0xef, 0x4d, 0x2e, 0xe9, 0x63, 0x51, 0xc8, 0xf8, 0xc2, 0x42, 0xe2, 0xcf, 0x6b,
-		0xad, 0x96, 0x75, 0x5b, 0xd5, 0x5a, 0x86, 0x66, 0x73, 0x7d, 0x03, 0xb4, 0xaa, 0x44, 0x6a, 0xc6,
-		0xdd, 0xdf, 0xe3, 0x6b, 0xf8, 0xc4, 0xd7, 0xa3, 0x86, 0x9f, 0x94, 0x12, 0xf2, 0x4f, 0x44, 0x40,
-		0xea, 0x8f, 0xf7, 0xfa, 0xa6, 0x1f, 0x19, 0x35, 0xfd, 0xf7, 0x64, 0xed, 0x7e, 0x3c, 0x02, 0xf9,
-		0x60, 0x90, 0xd7, 0x37, 0xbc, 0x07, 0xfe, 0x9f, 0x0e, 0xef, 0x0f, 0xa2, 0x90, 0x0b, 0x84, 0x76,
-		0xe3, 0x8e, 0xee, 0x43, 0x30, 0x65, 0x34, 0x71, 0xbb, 0x63, 0x39, 0xd8, 0xd4, 0x0f, 0xd4, 0x16,
-		0xbe, 0x85, 0x5b, 0xb3, 0x32, 0x55, 0xca, 0xe7, 0x0f, 0x0f, 0x1e, 0x17, 0x6b, 0x1e, 0xde, 0x2a,
-		0x41, 0x5b, 0x9a, 0xae, 0x2d, 0x57, 0xd7, 0x36, 0x37, 0x1a, 0xd5, 0xf5, 0xca, 0xcb, 0x42, 0xbb,
-		0x28, 0x92, 0xd1, 0x07, 0xf6, 0x2e, 0x2a, 0xed, 0x4d, 0x90, 0xfa, 0x07, 0x45, 0x74, 0xc5, 0x90,
-		0x61, 0x49, 0x13, 0x68, 0x1a, 0x0a, 0xeb, 0x1b, 0x6a, 0xbd, 0xb6, 0x5c, 0x55, 0xab, 0x57, 0xae,
-		0x54, 0x2b, 0x8d, 0x3a, 0x4b, 0x07, 0xba, 0xd0, 0x0d, 0x29, 0xea, 0x67, 0xf1, 0x8f, 0xc6, 0x60,
-		0x7a, 0xc8, 0x48, 0x50, 0x89, 0x07, 0xf2, 0x2c, 0xb7, 0xf0, 0xf8, 0x38, 0xa3, 0x5f, 0x24, 0xae,
-		0xf4, 0xa6, 0xd6, 0x75, 0x78, 0xdc, 0x7f, 0x16, 0x08, 0x97, 0x4c, 0x87, 0x58, 0xf6, 0x2e, 0x4f,
-		0xb3, 0xb2, 0xe8, 0xbe, 0xe0, 0xd5, 0xb3, 0x4c, 0xeb, 0x63, 0x80, 0x3a, 0x96, 0x6d, 0x38, 0xc6,
-		0x2d, 0xac, 0x1a, 0xa6, 0xc8, 0xc9, 0x92, 0x68, 0x3f, 0xae, 0x48, 0xa2, 0xa5, 0x66, 0x3a, 0x2e,
-		0xb4, 0x89, 0x77, 0xb5, 0x3e, 0x68, 0xe2, 0x79, 0xc4, 0x14, 0x49, 0xb4, 0xb8, 0xd0, 0x0f, 0x40,
-		0xb6, 0x69, 0xf5, 0x48, 0x08, 0xc4, 0xe0, 0x88, 0xb6, 0x88, 0x28, 0x19, 0x56, 0xe7, 0x82, 0xf0,
-		0xe0, 0xd6, 0x4b, 0x06, 0x67, 0x95, 0x0c, 0xab, 0x63, 0x20, 0xa7, 0xa1, 0xa0, 0xed, 0xee, 0x76,
-		0x09, 0x71, 0x41, 0x88, 0x85, 0xeb, 0x79, 0xb7, 0x9a, 0x02, 0x16, 0xaf, 0x41, 0x4a, 0xf0, 0x81,
-		0x78, 0xb0, 0x84, 0x13, 0x6a, 0x87, 0xe5, 0xa0, 0xa2, 0x67, 0xd2, 0x4a, 0xca, 0x14, 0x8d, 0x0f,
-		0x40, 0xd6, 0xb0, 0x55, 0xef, 0x6c, 0x2b, 0xba, 0x10, 0x3d, 0x93, 0x52, 0x32, 0x86, 0xed, 0x9e,
-		0x0b, 0xc8, 0x9f, 0x88, 0x42, 0x3e, 0x78, 0x6a, 0x87, 0x96, 0x21, 0xd5, 0xb2, 0x74, 0x8d, 0x8a,
-		0x16, 0x3b, 0x32, 0x3e, 0x13, 0x72, 0xd0, 0xb7, 0xb8, 0xca, 0xe1, 0x15, 0x17, 0xb3, 0xf8, 0xaf,
-		0x23, 0x90, 0x12, 0xd5, 0xe8, 0x38, 0xc4, 0x3b, 0x9a, 0xb3, 0x47, 0xc9, 0x4d, 0x96, 0xa3, 0x52,
-		0x44, 0xa1, 0x65, 0x52, 0x6f, 0x77, 0x34, 0x93, 0x8a, 0x00, 0xaf, 0x27, 0x65, 0xb2, 0xae, 0x2d,
-		0xac, 0x35, 0x69, 0x2e, 0xc0, 0x6a, 0xb7, 0xb1, 0xe9, 0xd8, 0x62, 0x5d, 0x79, 0x7d, 0x85, 0x57,
-		0xa3, 0x47, 0x61, 0xca, 0xe9, 0x6a, 0x46, 0x2b, 0x00, 0x1b, 0xa7, 0xb0, 0x92, 0x68, 0x70, 0x81,
-		0x97, 0xe0, 0xa4, 0xa0, 0xdb, 0xc4, 0x8e, 0xa6, 0xef, 0xe1, 0xa6, 0x87, 0x94, 0xa0, 0x39, 0xbf,
-		0x13, 0x1c, 0x60, 0x99, 0xb7, 0x0b, 0x5c, 0xf9, 0xb3, 0x51, 0x98, 0x12, 0xd9, 0x8b, 0xa6, 0xcb,
-		0xac, 0x35, 0x00, 0xcd, 0x34, 0x2d, 0xc7, 0xcf, 0xae, 0x41, 0x51, 0x1e, 0xc0, 0x5b, 0x2c, 0xb9,
-		0x48, 0x8a, 0x8f, 0x40, 0xf1, 0x4b, 0x11, 0x00, 0xaf, 0x69, 0x24, 0xdf, 0xe6, 0x21, 0xc3, 0xcf,
-		0x64, 0xe9, 0xc1, 0x3e, 0x4b, 0x78, 0x01, 0xab, 0xba, 0x62, 0xb4, 0x68, 0x5a, 0x72, 0x1b, 0xef,
-		0x1a, 0x26, 0x3f, 0x4f, 0x61, 0x05, 0x91, 0x96, 0x8c, 0x7b, 0xc7, 0x53, 0x0a, 0xa4, 0x6c, 0xdc,
-		0xd6, 0x4c, 0xc7, 0xd0, 0xf9, 0x09, 0xc9, 0xa5, 0x23, 0x0d, 0x7e, 0xb1, 0xce, 0xb1, 0x15, 0x97,
-		0x8e, 0x7c, 0x06, 0x52, 0xa2, 0x96, 0x38, 0x7e, 0xeb, 0x1b, 0xeb, 0x55, 0x69, 0x02, 0x25, 0x21,
-		0x56, 0xaf, 0x36, 0xa4, 0x08, 0x09, 0x3b, 0x4b, 0xab, 0xb5, 0x52, 0x5d, 0x8a, 0x96, 0xff, 0x2a,
-		0x4c, 0xeb, 0x56, 0xbb, 0xbf, 0xc3, 0xb2, 0xd4, 0x97, 0xf2, 0xb3, 0xaf, 0x46, 0x5e, 0x79, 0x9c,
-		0x03, 0xed, 0x5a, 0x2d, 0xcd, 0xdc, 0x5d, 0xb4, 0xba, 0xbb, 0xde, 0xb5, 0x08, 0x12, 0x1d, 0xd8,
-		0xbe, 0xcb, 0x11, 0x9d, 0xed, 0xff, 0x11, 0x89, 0xfc, 0x64, 0x34, 0xb6, 0xb2, 0x59, 0xfe, 0xb9,
-		0x68, 0x71, 0x85, 0x21, 0x6e, 0x8a, 0xe9, 0x28, 0x78, 0xa7, 0x85, 0x75, 0x32, 0x78, 0xf8, 0x93,
-		0x47, 0x61, 0x66, 0xd7, 0xda, 0xb5, 0x28, 0xa5, 0xf3, 0xe4, 0x17, 0xbf, 0x57, 0x91, 0x76, 0x6b,
-		0x8b, 0xa1, 0x97, 0x30, 0x96, 0xd6, 0x61, 0x9a, 0x03, 0xab, 0xf4, 0xf8, 0x96, 0x25, 0x17, 0xd0,
-		0xa1, 0x99, 0xed, 0xd9, 0x5f, 0xf8, 0x23, 0xea, 0x95, 0x28, 0x53, 0x1c, 0x95, 0xb4, 0xb1, 0xfc,
-		0xc3, 0x92, 0x02, 0xc7, 0x02, 0xf4, 0x98, 0x8e, 0xc0, 0xdd, 0x10, 0x8a, 0xff, 0x8c, 0x53, 0x9c,
-		0xf6, 0x51, 0xac, 0x73, 0xd4, 0xa5, 0x0a, 0xe4, 0x8e, 0x42, 0xeb, 0x9f, 0x73, 0x5a, 0x59, 0xec,
-		0x27, 0xb2, 0x02, 0x05, 0x4a, 0x44, 0xef, 0xd9, 0x8e, 0xd5, 0xa6, 0x0a, 0xf8, 0x70, 0x32, 0xff,
-		0xe2, 0x8f, 0xd8, 0xa6, 0xcd, 0x13, 0xb4, 0x8a, 0x8b, 0xb5, 0xb4, 0x04, 0xf4, 0xc4, 0xba, 0x89,
-		0xf5, 0x56, 0x08, 0x85, 0xcf, 0xf0, 0x81, 0xb8, 0xf0, 0x4b, 0x37, 0x60, 0x86, 0xfc, 0xa6, 0xfa,
-		0xd1, 0x3f, 0x92, 0xf0, 0x34, 0xf8, 0xec, 0xef, 0x7e, 0x98, 0xe9, 0x85, 0x69, 0x97, 0x80, 0x6f,
-		0x4c, 0xbe, 0x55, 0xdc, 0xc5, 0x8e, 0x83, 0xbb, 0xb6, 0xaa, 0xb5, 0x86, 0x0d, 0xcf, 0x97, 0x47,
-		0x9c, 0xfd, 0x91, 0x2f, 0x07, 0x57, 0x71, 0x85, 0x61, 0x96, 0x5a, 0xad, 0xa5, 0x2d, 0x38, 0x31,
-		0x44, 0x2a, 0xc6, 0xa0, 0xf9, 0xa3, 0x9c, 0xe6, 0xcc, 0x80, 0x64, 0x10, 0xb2, 0x9b, 0x20, 0xea,
-		0xdd, 0xb5, 0x1c, 0x83, 0xe6, 0x8f, 0x71, 0x9a, 0x88, 0xe3, 0x8a, 0x25, 0x25, 0x14, 0xaf, 0xc1,
-		0xd4, 0x2d, 0xdc, 0xdd, 0xb6, 0x6c, 0x9e, 0xbb, 0x1d, 0x83, 0xdc, 0x8f, 0x73, 0x72, 0x05, 0x8e,
-		0x48, 0x93, 0xb9, 0x84, 0xd6, 0x73, 0x90, 0xda, 0xd1, 0x74, 0x3c, 0x06, 0x89, 0xbb, 0x9c, 0x44,
-		0x92, 0xc0, 0x13, 0xd4, 0x12, 0x64, 0x77, 0x2d, 0x6e, 0x22, 0xc3, 0xd1, 0x7f, 0x82, 0xa3, 0x67,
-		0x04, 0x0e, 0x27, 0xd1, 0xb1, 0x3a, 0xbd, 0x16, 0xb1, 0x9f, 0xe1, 0x24, 0xfe, 0xb6, 0x20, 0x21,
-		0x70, 0x38, 0x89, 0x23, 0xb0, 0xf5, 0x0d, 0x41, 0xc2, 0xf6, 0xf1, 0xf3, 0x05, 0xc8, 0x58, 0x66,
-		0xeb, 0xc0, 0x32, 0xc7, 0x19, 0xc4, 0xc7, 0x39, 0x05, 0xe0, 0x28, 0x84, 0xc0, 0x65, 0x48, 0x8f,
-		0xbb, 0x10, 0x3f, 0xfd, 0x65, 0xb1, 0x3d, 0xc4, 0x0a, 0xac, 0x40, 0x41, 0x28, 0x28, 0xc3, 0x32,
-		0xc7, 0x20, 0xf1, 0x33, 0x9c, 0x44, 0xde, 0x87, 0xc6, 0xa7, 0xe1, 0x60, 0xdb, 0xd9, 0xc5, 0xe3,
-		0x10, 0xf9, 0x84, 0x98, 0x06, 0x47, 0xe1, 0xac, 0xdc, 0xc6, 0xa6, 0xbe, 0x37, 0x1e, 0x85, 0x4f,
-		0x0a, 0x56, 0x0a, 0x1c, 0x42, 0xa2, 0x02, 0xb9, 0xb6, 0xd6, 0xb5, 0xf7, 0xb4, 0xd6, 0x58, 0xcb,
-		0xf1, 0xb3, 0x9c, 0x46, 0xd6, 0x45, 0xe2, 0x1c, 0xe9, 0x99, 0x47, 0x21, 0xf3, 0x73, 0x82, 0x23,
-		0x3e, 0x34, 0xbe, 0xf5, 0x6c, 0x87, 0x26, 0xba, 0x8f, 0x42, 0xed, 0xef, 0x8a, 0xad, 0xc7, 0x70,
-		0xd7, 0xfc, 0x14, 0x2f, 0x43, 0xda, 0x36, 0x5e, 0x1b, 0x8b, 0xcc, 0xdf, 0x13, 0x2b, 0x4d, 0x11,
-		0x08, 0xf2, 0xcb, 0x70, 0x72, 0xa8, 0x99, 0x18, 0x83, 0xd8, 0xdf, 0xe7, 0xc4, 0x8e, 0x0f, 0x31,
-		0x15, 0x5c, 0x25, 0x1c, 0x95, 0xe4, 0x3f, 0x10, 0x2a, 0x01, 0xf7, 0xd1, 0xda, 0x24, 0x41, 0x8b,
-		0xad, 0xed, 0x1c, 0x8d, 0x6b, 0xff, 0x50, 0x70, 0x8d, 0xe1, 0x06, 0xb8, 0xd6, 0x80, 0xe3, 0x9c,
-		0xe2, 0xd1, 0xd6, 0xf5, 0xe7, 0x85, 0x62, 0x65, 0xd8, 0x5b, 0xc1, 0xd5, 0xfd, 0x66, 0x28, 0xba,
-		0xec, 0x14, 0xde, 0xb1, 0xad, 0xb6, 0xb5, 0xce, 0x18, 0x94, 0x7f, 0x81, 0x53, 0x16, 0x1a, 0xdf,
-		0x75, 0xaf, 0xed, 0x35, 0xad, 0x43, 0x88, 0xbf, 0x04, 0xb3, 0x82, 0x78, 0xcf, 0xec, 0x62, 0xdd,
-		0xda, 0x35, 0x8d, 0xd7, 0x70, 0x73, 0x0c, 0xd2, 0xbf, 0xd8, 0xb7, 0x54, 0x5b, 0x3e, 0x74, 0x42,
-		0xb9, 0x06, 0x92, 0xeb, 0xab, 0xa8, 0x46, 0xbb, 0x63, 0x75, 0x9d, 0x10, 0x8a, 0xbf, 0x24, 0x56,
-		0xca, 0xc5, 0xab, 0x51, 0xb4, 0xa5, 0x2a, 0xb0, 0xdb, 0x1f, 0xe3, 0x8a, 0xe4, 0x2f, 0x73, 0x42,
-		0x39, 0x0f, 0x8b, 0x2b, 0x0e, 0xdd, 0x6a, 0x77, 0xb4, 0xee, 0x38, 0xfa, 0xef, 0x1f, 0x09, 0xc5,
-		0xc1, 0x51, 0xb8, 0xe2, 0x20, 0x1e, 0x1d, 0xb1, 0xf6, 0x63, 0x50, 0xf8, 0x15, 0xa1, 0x38, 0x04,
-		0x0e, 0x27, 0x21, 0x1c, 0x86, 0x31, 0x48, 0x7c, 0x4a, 0x90, 0x10, 0x38, 0x84, 0xc4, 0x07, 0x3c,
-		0x43, 0xdb, 0xc5, 0xbb, 0x86, 0xed, 0x74, 0x99, 0x4b, 0x7e, 0x38, 0xa9, 0x5f, 0xfd, 0x72, 0xd0,
-		0x09, 0x53, 0x7c, 0xa8, 0x44, 0x13, 0xf1, 0xa3, 0x0f, 0x1a, 0xb2, 0x85, 0x0f, 0xec, 0xd7, 0x84,
-		0x26, 0xf2, 0xa1, 0x91, 0xb1, 0xf9, 0x3c, 0x44, 0xc2, 0x76, 0x9d, 0x04, 0x2a, 0x63, 0x90, 0xfb,
-		0xc7, 0x7d, 0x83, 0xab, 0x0b, 0x5c, 0x42, 0xd3, 0xe7, 0xff, 0xf4, 0xcc, 0x9b, 0xf8, 0x60, 0x2c,
-		0xe9, 0xfc, 0x74, 0x9f, 0xff, 0xb3, 0xc5, 0x30, 0x99, 0x0e, 0x29, 0xf4, 0xf9, 0x53, 0x28, 0xec,
-		0xae, 0xdf, 0xec, 0xb7, 0xbf, 0xcd, 0xe7, 0x1b, 0x74, 0xa7, 0x96, 0x56, 0x89, 0x90, 0x07, 0x9d,
-		0x9e, 0x70, 0x62, 0x1f, 0x7e, 0xdb, 0x95, 0xf3, 0x80, 0xcf, 0xb3, 0x74, 0x05, 0x72, 0x01, 0x87,
-		0x27, 0x9c, 0xd4, 0x77, 0x72, 0x52, 0x59, 0xbf, 0xbf, 0xb3, 0x74, 0x11, 0xe2, 0xc4, 0x79, 0x09,
-		0x47, 0xff, 0x2e, 0x8e, 0x4e, 0xc1, 0x97, 0x9e, 0x87, 0x94, 0x70, 0x5a, 0xc2, 0x51, 0xbf, 0x9b,
-		0xa3, 0xba, 0x28, 0x04, 0x5d, 0x38, 0x2c, 0xe1, 0xe8, 0x7f, 0x4d, 0xa0, 0x0b, 0x14, 0x82, 0x3e,
-		0x3e, 0x0b, 0x7f, 0xf3, 0xaf, 0xc7, 0xb9, 0xd1, 0x11, 0xbc, 0xbb, 0x0c, 0x49, 0xee, 0xa9, 0x84,
-		0x63, 0x7f, 0x0f, 0xef, 0x5c, 0x60, 0x2c, 0x3d, 0x03, 0x93, 0x63, 0x32, 0xfc, 0x6f, 0x70, 0x54,
-		0x06, 0xbf, 0x54, 0x81, 0x8c, 0xcf, 0x3b, 0x09, 0x47, 0xff, 0x3e, 0x8e, 0xee, 0xc7, 0x22, 0x43,
-		0xe7, 0xde, 0x49, 0x38, 0x81, 0xef, 0x17, 0x43, 0xe7, 0x18, 0x84, 0x6d, 0xc2, 0x31, 0x09, 0xc7,
-		0xfe, 0xa8, 0xe0, 0xba, 0x40, 0x59, 0x7a, 0x01, 0xd2, 0xae, 0xb1, 0x09, 0xc7, 0xff, 0x01, 0x8e,
-		0xef, 0xe1, 0x10, 0x0e, 0xf8, 0x8c, 0x5d, 0x38, 0x89, 0xbf, 0x29, 0x38, 0xe0, 0xc3, 0x22, 0xdb,
-		0xa8, 0xdf, 0x81, 0x09, 0xa7, 0xf4, 0x83, 0x62, 0x1b, 0xf5, 0xf9, 0x2f, 0x64, 0x35, 0xa9, 0xce,
-		0x0f, 0x27, 0xf1, 0xb7, 0xc4, 0x6a, 0x52, 0x78, 0x32, 0x8c, 0x7e, 0x8f, 0x20, 0x9c, 0xc6, 0x0f,
-		0x8b, 0x61, 0xf4, 0x39, 0x04, 0x4b, 0x9b, 0x80, 0x06, 0xbd, 0x81, 0x70, 0x7a, 0x1f, 0xe3, 0xf4,
-		0xa6, 0x06, 0x9c, 0x81, 0xa5, 0x17, 0xe1, 0xf8, 0x70, 0x4f, 0x20, 0x9c, 0xea, 0x8f, 0xbc, 0xdd,
-		0x17, 0xbb, 0xf9, 0x1d, 0x81, 0xa5, 0x86, 0x67, 0x52, 0xfc, 0x5e, 0x40, 0x38, 0xd9, 0x1f, 0x7d,
-		0x3b, 0xa8, 0xb8, 0xfd, 0x4e, 0xc0, 0x52, 0x09, 0xc0, 0x33, 0xc0, 0xe1, 0xb4, 0x7e, 0x9c, 0xd3,
-		0xf2, 0x21, 0x91, 0xad, 0xc1, 0xed, 0x6f, 0x38, 0xfe, 0x5d, 0xb1, 0x35, 0x38, 0x06, 0xd9, 0x1a,
-		0xc2, 0xf4, 0x86, 0x63, 0xff, 0x84, 0xd8, 0x1a, 0x02, 0x85, 0x48, 0xb6, 0xcf, 0xba, 0x85, 0x53,
-		0xf8, 0xb8, 0x90, 0x6c, 0x1f, 0xd6, 0xd2, 0x3a, 0x4c, 0x0d, 0x18, 0xc4, 0x70, 0x52, 0x3f, 0xc9,
-		0x49, 0x49, 0xfd, 0xf6, 0xd0, 0x6f, 0xbc, 0xb8, 0x31, 0x0c, 0xa7, 0xf6, 0x53, 0x7d, 0xc6, 0x8b,
-		0xdb, 0xc2, 0xa5, 0xcb, 0x90, 0x32, 0x7b, 0xad, 0x16, 0xd9, 0x3c, 0xe8, 0xf0, 0xfb, 0xb9, 0xb3,
-		0xff, 0xf9, 0xab, 0x9c, 0x3b, 0x02, 0x61, 0xe9, 0x22, 0x4c, 0xe2, 0xf6, 0x36, 0x6e, 0x86, 0x61,
-		0x7e, 0xf1, 0xab, 0x42, 0x61, 0x12, 0xe8, 0xa5, 0x17, 0x00, 0x58, 0x6a, 0x84, 0x1e, 0x9c, 0x87,
-		0xe0, 0x7e, 0xe9, 0xab, 0xfc, 0x42, 0x9c, 0x87, 0xe2, 0x11, 0x60, 0xd7, 0xeb, 0x0e, 0x27, 0xf0,
-		0xe5, 0x20, 0x01, 0xba, 0x22, 0xcf, 0x41, 0xf2, 0x55, 0xdb, 0x32, 0x1d, 0x6d, 0x37, 0x0c, 0xfb,
-		0x4f, 0x38, 0xb6, 0x80, 0x27, 0x0c, 0x6b, 0x5b, 0x5d, 0xec, 0x68, 0xbb, 0x76, 0x18, 0xee, 0x7f,
-		0xe1, 0xb8, 0x2e, 0x02, 0x41, 0xd6, 0x35, 0xdb, 0x19, 0x67, 0xde, 0x7f, 0x2a, 0x90, 0x05, 0x02,
-		0x19, 0x34, 0xf9, 0x7d, 0x13, 0x1f, 0x84, 0xe1, 0xfe, 0x99, 0x18, 0x34, 0x87, 0x5f, 0x7a, 0x1e,
-		0xd2, 0xe4, 0x27, 0xbb, 0xe5, 0x1a, 0x82, 0xfc, 0xe7, 0x1c, 0xd9, 0xc3, 0x20, 0x3d, 0xdb, 0x4e,
-		0xd3, 0x31, 0xc2, 0x99, 0xfd, 0x15, 0xbe, 0xd2, 0x02, 0x7e, 0xa9, 0x04, 0x19, 0xdb, 0x69, 0x36,
-		0x7b, 0xdc, 0x3f, 0x0d, 0x41, 0xff, 0xaf, 0x5f, 0x75, 0x53, 0x16, 0x2e, 0x0e, 0x59, 0xed, 0xdb,
-		0x37, 0x9d, 0x8e, 0x45, 0xcf, 0x5b, 0xc2, 0x28, 0xbc, 0xcd, 0x29, 0xf8, 0x50, 0x96, 0x2a, 0x90,
-		0x25, 0x73, 0xe9, 0xe2, 0x0e, 0xa6, 0x87, 0x63, 0x21, 0x24, 0xfe, 0x82, 0x33, 0x20, 0x80, 0x54,
-		0xfe, 0xb6, 0xcf, 0xbc, 0x35, 0x17, 0xf9, 0xec, 0x5b, 0x73, 0x91, 0x3f, 0x78, 0x6b, 0x2e, 0xf2,
-		0xd1, 0xcf, 0xcd, 0x4d, 0x7c, 0xf6, 0x73, 0x73, 0x13, 0xbf, 0xff, 0xb9, 0xb9, 0x89, 0xe1, 0x59,
-		0x62, 0x58, 0xb1, 0x56, 0x2c, 0x96, 0x1f, 0x7e, 0xe5, 0xe1, 0x5d, 0xc3, 0xd9, 0xeb, 0x6d, 0x2f,
-		0xea, 0x56, 0xfb, 0xbc, 0x6e, 0xd9, 0x6d, 0xcb, 0x3e, 0x1f, 0xcc, 0xeb, 0xd2, 0x5f, 0xf0, 0xbf,
-		0x22, 0x24, 0x66, 0x0e, 0xa6, 0x73, 0x35, 0xf3, 0x60, 0xd4, 0xc7, 0x74, 0x97, 0x20, 0x56, 0x32,
-		0x0f, 0xd0, 0x49, 0xa6, 0xe0, 0xd4, 0x5e, 0xb7, 0xc5, 0xaf, 0x5a, 0x26, 0x49, 0x79, 0xab, 0xdb,
-		0x42, 0x33, 0xde, 0x7d, 0xe8, 0xc8, 0x99, 0x2c, 0xbf, 0xe4, 0x5c, 0xfe, 0xbe, 0xc8, 0xd1, 0x66,
-		0x92, 0x2a, 0x99, 0x07, 0x74, 0x22, 0x9b, 0x91, 0x57, 0x1e, 0x0b, 0xcd, 0x73, 0xdf, 0x34, 0xad,
-		0xdb, 0x26, 0x19, 0x76, 0x67, 0x5b, 0xe4, 0xb8, 0xe7, 0xfa, 0x73, 0xdc, 0x2f, 0xe2, 0x56, 0xeb,
-		0x3a, 0x81, 0x6b, 0x10, 0x94, 0xed, 0x04, 0xbb, 0xd5, 0x0f, 0x3f, 0x18, 0x85, 0xb9, 0x81, 0x74,
-		0x36, 0x17, 0x82, 0x51, 0x4c, 0x58, 0x82, 0xd4, 0xb2, 0x90, 0xad, 0x59, 0x48, 0xda, 0x58, 0xb7,
-		0xcc, 0xa6, 0x4d, 0x19, 0x11, 0x53, 0x44, 0x91, 0x30, 0xc2, 0xd4, 0x4c, 0xcb, 0xe6, 0x97, 0x95,
-		0x59, 0xa1, 0xfc, 0x63, 0x47, 0x64, 0x44, 0x4e, 0xf4, 0x24, 0xb8, 0xf1, 0xe4, 0x98, 0xdc, 0x10,
-		0x93, 0x08, 0x64, 0xfe, 0xc7, 0xe5, 0xca, 0x0f, 0x47, 0x61, 0xbe, 0x9f, 0x2b, 0x64, 0x67, 0xd9,
-		0x8e, 0xd6, 0xee, 0x8c, 0x62, 0xcb, 0x65, 0x48, 0x37, 0x04, 0xcc, 0x91, 0xf9, 0x72, 0xf7, 0x88,
-		0x7c, 0xc9, 0xbb, 0x5d, 0x09, 0xc6, 0x5c, 0x18, 0x93, 0x31, 0xee, 0x3c, 0xee, 0x89, 0x33, 0x7f,
-		0x99, 0x80, 0x93, 0x6c, 0x3b, 0xa9, 0x6c, 0x2b, 0xb1, 0x02, 0xe7, 0x49, 0xd6, 0xdf, 0x14, 0x7e,
-		0x4e, 0x22, 0x5f, 0x87, 0xe9, 0x1a, 0xd1, 0x16, 0x24, 0x0a, 0xf2, 0x4e, 0x78, 0x86, 0xde, 0xe7,
-		0x5e, 0x08, 0x38, 0xfc, 0xfc, 0x7c, 0xcb, 0x5f, 0x25, 0x7f, 0x7b, 0x04, 0xa4, 0xba, 0xae, 0xb5,
-		0xb4, 0xee, 0xd7, 0x4a, 0x0a, 0x3d, 0x03, 0xc0, 0xae, 0x7b, 0xb8, 0x1f, 0xee, 0xe5, 0x2f, 0xcc,
-		0x2e, 0xfa, 0x27, 0xb7, 0xc8, 0x7a, 0xa2, 0x37, 0xa8, 0xd2, 0x14, 0x96, 0xfc, 0x3c, 0xf7, 0x12,
-		0x80, 0xd7, 0x80, 0x4e, 0xc1, 0x89, 0x7a, 0xa5, 0xb4, 0x5a, 0x52, 0xc4, 0x25, 0xa1, 0xfa, 0x66,
-		0xb5, 0x52, 0xbb, 0x52, 0xab, 0x2e, 0x4b, 0x13, 0xe8, 0x38, 0x20, 0x7f, 0xa3, 0x7b, 0xa9, 0xe9,
-		0x18, 0x4c, 0xf9, 0xeb, 0xd9, 0x57, 0x2a, 0x51, 0xe2, 0x29, 0x1a, 0xed, 0x4e, 0x0b, 0xd3, 0x93,
-		0x47, 0xd5, 0x10, 0x5c, 0x0b, 0x77, 0x42, 0x7e, 0xfb, 0xdf, 0xb2, 0x2f, 0x17, 0xa6, 0x3d, 0x74,
-		0x97, 0xe7, 0x4b, 0xab, 0x30, 0xa5, 0xe9, 0x3a, 0xee, 0x04, 0x48, 0x86, 0xa8, 0x6a, 0x42, 0x90,
-		0x9e, 0xa5, 0x72, 0x4c, 0x8f, 0xda, 0x33, 0x90, 0xb0, 0xe9, 0xec, 0xc3, 0x48, 0xfc, 0x0e, 0x27,
-		0xc1, 0xc1, 0x97, 0x4c, 0x98, 0x22, 0x9e, 0x9f, 0xd6, 0xc5, 0xbe, 0x61, 0x1c, 0x9e, 0x67, 0xf8,
-		0xf5, 0x5f, 0x7a, 0x82, 0x9e, 0xac, 0x3e, 0x10, 0x5c, 0x96, 0x21, 0xe2, 0xa4, 0x48, 0x9c, 0xb6,
-		0x37, 0x50, 0x0c, 0x79, 0xd1, 0x1f, 0x1f, 0xf0, 0xe1, 0x9d, 0xfd, 0x06, 0xef, 0x6c, 0x6e, 0x98,
-		0x0c, 0xf8, 0x7a, 0xca, 0x71, 0xaa, 0xac, 0xa1, 0x5c, 0x1d, 0xb5, 0xa7, 0x5f, 0x79, 0x74, 0xd0,
-		0x3a, 0xb1, 0xff, 0x1e, 0xa7, 0x94, 0x2f, 0xfb, 0xbb, 0x71, 0xf7, 0xde, 0x8f, 0xc5, 0x61, 0x4a,
-		0x6b, 0x1b, 0xa6, 0x75, 0x9e, 0xfe, 0xcb, 0xf7, 0xdc, 0x24, 0x2d, 0x8c, 0x71, 0x28, 0x79, 0x89,
-		0x6d, 0x85, 0x70, 0x89, 0xf9, 0xf3, 0xef, 0xfd, 0x99, 0x49, 0x6f, 0xbb, 0x2c, 0xad, 0x81, 0x24,
-		0x2e, 0xf1, 0x62, 0x53, 0xb7, 0x9a, 0x63, 0x65, 0x29, 0xbe, 0x22, 0x68, 0x88, 0xfc, 0x56, 0x95,
-		0xa3, 0x2e, 0xbd, 0x0f, 0x52, 0x2e, 0x99, 0x30, 0xcf, 0x44, 0x10, 0x71, 0x31, 0x88, 0x5f, 0xc2,
-		0x76, 0xe6, 0x38, 0x5e, 0xe8, 0xdb, 0x02, 0x9f, 0xed, 0xd0, 0x75, 0x32, 0x9b, 0x15, 0xc8, 0x37,
-		0x2d, 0xd3, 0x51, 0xad, 0xb6, 0xe1, 0xe0, 0x76, 0xc7, 0x09, 0xf5, 0xeb, 0xfe, 0x82, 0x11, 0x49,
-		0x29, 0x39, 0x82, 0xb7, 0x21, 0xd0, 0xc8, 0x48, 0xd8, 0x77, 0x7a, 0xe3, 0x8c, 0xe4, 0xbf, 0xb9,
-		0x23, 0xa1, 0x38, 0x64, 0x24, 0xf7, 0x24, 0x1d, 0x76, 0xf3, 0x26, 0x57, 0xe6, 0xce, 0x3e, 0x93,
-		0x02, 0x57, 0x3a, 0xfe, 0x34, 0x06, 0x73, 0x1c, 0x78, 0x5b, 0xb3, 0xf1, 0xf9, 0x5b, 0x4f, 0x6e,
-		0x63, 0x47, 0x7b, 0xf2, 0xbc, 0x6e, 0x19, 0xc2, 0x92, 0x4f, 0x73, 0x65, 0x4d, 0xda, 0x17, 0x79,
-		0x7b, 0x71, 0xe8, 0x71, 0x77, 0x71, 0xb4, 0x92, 0x2f, 0x0e, 0xca, 0xa0, 0xfc, 0x0a, 0xc4, 0x2b,
-		0x96, 0x61, 0x12, 0xdb, 0xd6, 0xc4, 0xa6, 0xd5, 0xe6, 0xea, 0x96, 0x15, 0xd0, 0x25, 0x48, 0x68,
-		0x6d, 0xab, 0x67, 0x3a, 0x4c, 0xd5, 0x96, 0xe7, 0x3e, 0xf3, 0xe6, 0xfc, 0xc4, 0xbf, 0x7f, 0x73,
-		0x3e, 0x56, 0x33, 0x9d, 0xdf, 0xfb, 0xe5, 0xc7, 0x81, 0x53, 0xaf, 0x99, 0xce, 0x27, 0x3f, 0xff,
-		0xf3, 0xe7, 0x22, 0x0a, 0x87, 0x5e, 0x8a, 0x7f, 0xe1, 0x8d, 0xf9, 0x88, 0xfc, 0x12, 0x24, 0x97,
-		0xb1, 0x7e, 0x08, 0xf9, 0x27, 0xfb, 0xc8, 0x9f, 0x14, 0xe4, 0x97, 0xb1, 0xee, 0x23, 0xbf, 0x8c,
-		0xf5, 0x3e, 0xca, 0xcf, 0x40, 0xaa, 0x66, 0x3a, 0xec, 0x13, 0xa2, 0x47, 0x21, 0x66, 0x98, 0xec,
-		0x56, 0xba, 0x8f, 0xc2, 0xc0, 0x00, 0x15, 0x02, 0x45, 0x10, 0x97, 0xb1, 0xee, 0x22, 0x36, 0xb1,
-		0xde, 0x8f, 0x38, 0xd8, 0x35, 0x81, 0x2a, 0x2f, 0xff, 0xfe, 0x1f, 0xce, 0x4d, 0xbc, 0xfe, 0xd6,
-		0xdc, 0xc4, 0xc8, 0xa5, 0x97, 0xc3, 0x97, 0xde, 0x5d, 0xf1, 0x9f, 0x89, 0xc3, 0xfd, 0xf4, 0xcb,
-		0xd2, 0x6e, 0xdb, 0x30, 0x9d, 0xf3, 0x7a, 0xf7, 0xa0, 0xe3, 0x58, 0x44, 0x01, 0x58, 0x3b, 0x7c,
-		0xc1, 0xa7, 0xbc, 0xe6, 0x45, 0xd6, 0x3c, 0x7c, 0xb9, 0xe5, 0x1d, 0x98, 0xdc, 0x24, 0x78, 0x84,
-		0xc5, 0x8e, 0xe5, 0x68, 0x2d, 0xee, 0xb5, 0xb0, 0x02, 0xa9, 0x65, 0x5f, 0xa3, 0x46, 0x59, 0xad,
-		0x21, 0x3e, 0x44, 0x6d, 0x61, 0x6d, 0x87, 0x7d, 0xd4, 0x13, 0xa3, 0xee, 0x6e, 0x8a, 0x54, 0xd0,
-		0xef, 0x77, 0x66, 0x60, 0x52, 0xeb, 0xb1, 0x8b, 0x37, 0x31, 0xe2, 0x07, 0xd3, 0x82, 0x7c, 0x1d,
-		0x92, 0xfc, 0xfc, 0x1d, 0x49, 0x10, 0xbb, 0x89, 0x0f, 0x68, 0x3f, 0x59, 0x85, 0xfc, 0x44, 0x8b,
-		0x30, 0x49, 0x07, 0xcf, 0xbf, 0x56, 0x9c, 0x5d, 0x1c, 0x18, 0xfd, 0x22, 0x1d, 0xa4, 0xc2, 0xc0,
-		0xe4, 0x6b, 0x90, 0x5a, 0xb6, 0x88, 0x14, 0x06, 0xa9, 0xa5, 0x19, 0x35, 0x3a, 0xe6, 0x4e, 0x8f,
-		0x4b, 0x85, 0xc2, 0x0a, 0xe8, 0x38, 0x24, 0xd8, 0x47, 0x5e, 0xfc, 0xf2, 0x10, 0x2f, 0xc9, 0x15,
-		0x48, 0x52, 0xda, 0x1b, 0x1d, 0xe2, 0x32, 0xb8, 0xf7, 0xde, 0xd3, 0xfc, 0x93, 0x5f, 0x4e, 0x3e,
-		0xea, 0x0d, 0x16, 0x41, 0xbc, 0xa9, 0x39, 0x1a, 0x9f, 0x37, 0xfd, 0x2d, 0xbf, 0x1f, 0x52, 0x9c,
-		0x88, 0x8d, 0x2e, 0x40, 0xcc, 0xea, 0xd8, 0xfc, 0xfa, 0x4f, 0x71, 0xd4, 0x54, 0x36, 0x3a, 0xe5,
-		0x38, 0x91, 0x19, 0x85, 0x00, 0x97, 0xd7, 0x47, 0x8a, 0xc5, 0xd3, 0x01, 0xb1, 0x68, 0x63, 0x67,
-		0x7b, 0xc7, 0xf1, 0x7e, 0xb0, 0xe5, 0x1c, 0x10, 0x05, 0x57, 0x50, 0xee, 0x46, 0x61, 0xce, 0xd7,
-		0x7a, 0x0b, 0x77, 0x6d, 0xc3, 0x32, 0x99, 0x34, 0x71, 0x49, 0x41, 0xbe, 0x01, 0xf2, 0xf6, 0x11,
-		0xa2, 0xf2, 0x3c, 0xc4, 0x4a, 0x9d, 0x0e, 0x2a, 0x42, 0x8a, 0x96, 0x75, 0x8b, 0xc9, 0x4a, 0x5c,
-		0x71, 0xcb, 0xa4, 0xcd, 0xb6, 0x76, 0x9c, 0xdb, 0x5a, 0xd7, 0xfd, 0x06, 0x5a, 0x94, 0xe5, 0xe7,
-		0x20, 0x5d, 0xb1, 0x4c, 0x1b, 0x9b, 0x76, 0x8f, 0xfa, 0xc2, 0xdb, 0x2d, 0x4b, 0xbf, 0xc9, 0x29,
-		0xb0, 0x02, 0x61, 0xb6, 0xd6, 0xe9, 0x50, 0xcc, 0xb8, 0x42, 0x7e, 0xb2, 0xfd, 0x5a, 0xde, 0x18,
-		0xc9, 0x9e, 0x8b, 0x47, 0x63, 0x0f, 0x9f, 0xa0, 0xe7, 0xd4, 0x46, 0xe0, 0xbe, 0xc1, 0x8d, 0x74,
-		0x13, 0x1f, 0xd8, 0x47, 0xdd, 0x47, 0x2f, 0x41, 0x7a, 0x93, 0x3e, 0x4f, 0x72, 0x1d, 0x1f, 0xa0,
-		0x22, 0x24, 0x71, 0xf3, 0xc2, 0xc5, 0x8b, 0x4f, 0x3e, 0xc7, 0xa4, 0xfc, 0xea, 0x84, 0x22, 0x2a,
-		0xd0, 0x1c, 0xa4, 0x6d, 0xac, 0x77, 0x2e, 0x5c, 0xbc, 0x74, 0xf3, 0x49, 0x26, 0x56, 0x57, 0x27,
-		0x14, 0xaf, 0x6a, 0x29, 0x45, 0x66, 0xfc, 0x85, 0x8f, 0xcf, 0x47, 0xca, 0x93, 0x10, 0xb3, 0x7b,
-		0xed, 0x77, 0x4d, 0x36, 0x7e, 0x68, 0x12, 0x16, 0x7c, 0xad, 0xcc, 0xb8, 0xdc, 0xd2, 0x5a, 0x46,
-		0x53, 0xf3, 0x1e, 0x95, 0x91, 0x7c, 0xf3, 0xa7, 0x10, 0x23, 0xac, 0xc6, 0xa1, 0x5c, 0x94, 0x7f,
-		0x31, 0x02, 0xd9, 0x1b, 0x82, 0x72, 0x1d, 0x3b, 0xe8, 0x32, 0x80, 0xdb, 0x93, 0xd8, 0x2a, 0xa7,
-		0x16, 0xfb, 0xfb, 0x5a, 0x74, 0x71, 0x14, 0x1f, 0x38, 0x7a, 0x86, 0x0a, 0x60, 0xc7, 0xb2, 0xf9,
-		0xf7, 0xb0, 0x21, 0xa8, 0x2e, 0x30, 0x7a, 0x0c, 0x10, 0xd5, 0x6a, 0xea, 0x2d, 0xcb, 0x31, 0xcc,
-		0x5d, 0xb5, 0x63, 0xdd, 0xe6, 0xaf, 0x0c, 0xc4, 0x14, 0x89, 0xb6, 0xdc, 0xa0, 0x0d, 0x9b, 0xa4,
-		0x9e, 0x0c, 0x3a, 0xed, 0x52, 0x21, 0x61, 0x9d, 0xd6, 0x6c, 0x76, 0xb1, 0x6d, 0x73, 0xc5, 0x25,
-		0x8a, 0xe8, 0x32, 0x24, 0x3b, 0xbd, 0x6d, 0x55, 0x68, 0x89, 0xcc, 0x85, 0xfb, 0x86, 0xed, 0x79,
-		0x21, 0x1b, 0x7c, 0xd7, 0x27, 0x3a, 0xbd, 0x6d, 0x22, 0x29, 0x0f, 0x40, 0x76, 0xc8, 0x60, 0x32,
-		0xb7, 0xbc, 0x71, 0xd0, 0x17, 0x71, 0xf8, 0x0c, 0xd4, 0x4e, 0xd7, 0xb0, 0xba, 0x86, 0x73, 0x40,
-		0xaf, 0xed, 0xc5, 0x14, 0x49, 0x34, 0x6c, 0xf2, 0x7a, 0xf9, 0x26, 0x14, 0xea, 0xd4, 0xdd, 0xf7,
-		0x46, 0x7e, 0xd1, 0x1b, 0x5f, 0x24, 0x7c, 0x7c, 0x23, 0x47, 0x16, 0x1d, 0x18, 0x59, 0x79, 0x6d,
-		0xa4, 0x64, 0x3e, 0x75, 0x34, 0xc9, 0x0c, 0x5a, 0xb7, 0x2f, 0x9d, 0x0c, 0x6c, 0x4a, 0xee, 0xf5,
-		0xf8, 0x54, 0xd6, 0xb8, 0x42, 0x19, 0x16, 0xc9, 0x17, 0x0f, 0x37, 0xa2, 0xc5, 0x10, 0xd5, 0x59,
-		0x0c, 0xdd, 0x3e, 0xf2, 0x73, 0x90, 0xdb, 0xd4, 0xba, 0x4e, 0x1d, 0x3b, 0x57, 0xb1, 0xd6, 0xc4,
-		0xdd, 0xa0, 0x95, 0xcd, 0x09, 0x2b, 0x8b, 0x20, 0x4e, 0x4d, 0x29, 0xb3, 0x32, 0xf4, 0xb7, 0xbc,
-		0x07, 0x71, 0x7a, 0x6f, 0xd7, 0xb5, 0xc0, 0x1c, 0x83, 0x59, 0x60, 0xa2, 0x3f, 0x0f, 0x1c, 0x6c,
-		0x8b, 0x64, 0x13, 0x2d, 0xa0, 0xa7, 0x85, 0x1d, 0x8d, 0x1d, 0x6e, 0x47, 0xb9, 0x10, 0x72, 0x6b,
-		0xda, 0x82, 0x64, 0x99, 0xa8, 0xdf, 0xda, 0xb2, 0x3b, 0x90, 0x88, 0x37, 0x10, 0xb4, 0x06, 0x85,
-		0x8e, 0xd6, 0x75, 0xe8, 0x77, 0x7c, 0x7b, 0x74, 0x16, 0x5c, 0xce, 0xe7, 0x07, 0x77, 0x5d, 0x60,
-		0xb2, 0xbc, 0x97, 0x5c, 0xc7, 0x5f, 0x29, 0xff, 0x71, 0x1c, 0x12, 0x9c, 0x19, 0xcf, 0x43, 0x92,
-		0xb3, 0x95, 0x4b, 0xe6, 0xfd, 0x8b, 0x83, 0xc6, 0x68, 0xd1, 0x35, 0x1a, 0x9c, 0x9e, 0xc0, 0x41,
-		0x8f, 0x40, 0x4a, 0xdf, 0xd3, 0x0c, 0x53, 0x35, 0x9a, 0xdc, 0x01, 0xcc, 0xbc, 0xf5, 0xe6, 0x7c,
-		0xb2, 0x42, 0xea, 0x6a, 0xcb, 0x4a, 0x92, 0x36, 0xd6, 0x9a, 0xc4, 0xf2, 0xef, 0x61, 0x63, 0x77,
-		0xcf, 0xe1, 0xbb, 0x8b, 0x97, 0xd0, 0xb3, 0x10, 0x27, 0x02, 0xc1, 0xbf, 0xf2, 0x2e, 0x0e, 0xf8,
-		0xf1, 0x6e, 0xa2, 0xa5, 0x9c, 0x22, 0x1d, 0x7f, 0xf4, 0x3f, 0xcd, 0x47, 0x14, 0x8a, 0x81, 0x2a,
-		0x90, 0x6b, 0x69, 0xb6, 0xa3, 0x52, 0xab, 0x45, 0xba, 0x9f, 0xa4, 0x24, 0x4e, 0x0e, 0x32, 0x84,
-		0x33, 0x96, 0x0f, 0x3d, 0x43, 0xb0, 0x58, 0x55, 0x13, 0x9d, 0x01, 0x89, 0x12, 0xd1, 0xad, 0x76,
-		0xdb, 0x70, 0x98, 0x2f, 0x95, 0xa0, 0x7c, 0xcf, 0x93, 0xfa, 0x0a, 0xad, 0xa6, 0x1e, 0xd5, 0x29,
-		0x48, 0xd3, 0xef, 0x4a, 0x29, 0x08, 0xbb, 0x2c, 0x9e, 0x22, 0x15, 0xb4, 0xf1, 0x34, 0x14, 0x3c,
-		0xdd, 0xc8, 0x40, 0x52, 0x8c, 0x8a, 0x57, 0x4d, 0x01, 0x9f, 0x80, 0x19, 0x13, 0xef, 0xd3, 0xeb,
-		0xeb, 0x01, 0xe8, 0x34, 0x85, 0x46, 0xa4, 0xed, 0x46, 0x10, 0xe3, 0x61, 0xc8, 0xeb, 0x82, 0xf9,
-		0x0c, 0x16, 0x28, 0x6c, 0xce, 0xad, 0xa5, 0x60, 0x27, 0x21, 0xa5, 0x75, 0x3a, 0x0c, 0x20, 0xc3,
-		0x75, 0x63, 0xa7, 0x43, 0x9b, 0xce, 0xc1, 0x14, 0x9d, 0x63, 0x17, 0xdb, 0xbd, 0x96, 0xc3, 0x89,
-		0x64, 0x29, 0x4c, 0x81, 0x34, 0x28, 0xac, 0x9e, 0xc2, 0x3e, 0x08, 0x39, 0x7c, 0xcb, 0x68, 0x62,
-		0x53, 0xc7, 0x0c, 0x2e, 0x47, 0xe1, 0xb2, 0xa2, 0x92, 0x02, 0x9d, 0x05, 0x57, 0xe7, 0xa9, 0x42,
-		0x1f, 0xe7, 0x19, 0x3d, 0x51, 0x5f, 0x62, 0xd5, 0xf2, 0x2c, 0xc4, 0x97, 0x35, 0x47, 0x23, 0x4e,
-		0x85, 0xb3, 0xcf, 0x8c, 0x4c, 0x56, 0x21, 0x3f, 0xe5, 0x2f, 0x44, 0x21, 0x7e, 0xc3, 0x72, 0x30,
-		0x7a, 0xca, 0xe7, 0xf0, 0xe5, 0x87, 0xc9, 0x73, 0xdd, 0xd8, 0x35, 0x71, 0x73, 0xcd, 0xde, 0xf5,
-		0x3d, 0x02, 0xe3, 0x89, 0x53, 0x34, 0x20, 0x4e, 0x33, 0x30, 0xd9, 0xb5, 0x7a, 0x66, 0x53, 0x5c,
-		0xb3, 0xa6, 0x05, 0x54, 0x85, 0x94, 0x2b, 0x25, 0xf1, 0x30, 0x29, 0x29, 0x10, 0x29, 0x21, 0x32,
-		0xcc, 0x2b, 0x94, 0xe4, 0x36, 0x17, 0x96, 0x32, 0xa4, 0x5d, 0xe5, 0xc5, 0xa5, 0x6d, 0x3c, 0x81,
-		0xf5, 0xd0, 0x88, 0x21, 0x71, 0xd7, 0xde, 0x65, 0x1e, 0x93, 0x38, 0xc9, 0x6d, 0xe0, 0xdc, 0x0b,
-		0x88, 0x15, 0x7f, 0x90, 0x26, 0x49, 0xe7, 0xe5, 0x89, 0x15, 0x7b, 0x94, 0xe6, 0x3e, 0x48, 0xdb,
-		0xc6, 0xae, 0xa9, 0x39, 0xbd, 0x2e, 0xe6, 0x92, 0xe7, 0x55, 0xc8, 0xbf, 0x19, 0x81, 0x04, 0x93,
-		0x64, 0x1f, 0xdf, 0x22, 0xc3, 0xf9, 0x16, 0x1d, 0xc5, 0xb7, 0xd8, 0xbd, 0xf3, 0xad, 0x04, 0xe0,
-		0x0e, 0xc6, 0xe6, 0xef, 0x84, 0x0c, 0xf1, 0x16, 0xd8, 0x10, 0xeb, 0xc6, 0x2e, 0xdf, 0xa8, 0x3e,
-		0x24, 0xf9, 0x3f, 0x46, 0x88, 0xe3, 0xca, 0xdb, 0x51, 0x09, 0x72, 0x62, 0x5c, 0xea, 0x4e, 0x4b,
-		0xdb, 0xe5, 0xb2, 0x73, 0xff, 0xc8, 0xc1, 0x5d, 0x69, 0x69, 0xbb, 0x4a, 0x86, 0x8f, 0x87, 0x14,
-		0x86, 0xaf, 0x43, 0x74, 0xc4, 0x3a, 0x04, 0x16, 0x3e, 0x76, 0x6f, 0x0b, 0x1f, 0x58, 0xa2, 0x78,
-		0xff, 0x12, 0xfd, 0x52, 0x94, 0x06, 0x2f, 0x1d, 0xcb, 0xd6, 0x5a, 0xef, 0xc5, 0x8e, 0x38, 0x05,
-		0xe9, 0x8e, 0xd5, 0x52, 0x59, 0x0b, 0xfb, 0xfc, 0x20, 0xd5, 0xb1, 0x5a, 0xca, 0xc0, 0xb2, 0x4f,
-		0xbe, 0x43, 0xdb, 0x25, 0xf1, 0x0e, 0x70, 0x2d, 0xd9, 0xcf, 0xb5, 0x2e, 0x64, 0x19, 0x2b, 0xb8,
-		0x2d, 0x7b, 0x82, 0xf0, 0x80, 0x1a, 0xc7, 0xc8, 0xa0, 0xed, 0x65, 0xc3, 0x66, 0x90, 0x0a, 0x87,
-		0x23, 0x18, 0x4c, 0xf5, 0x0f, 0x8b, 0x7a, 0xfd, 0x62, 0xa9, 0x70, 0x38, 0xf9, 0x87, 0x22, 0x00,
-		0xab, 0x84, 0xb3, 0x74, 0xbe, 0xc4, 0x0a, 0xd9, 0x74, 0x08, 0x6a, 0xa0, 0xe7, 0xb9, 0x51, 0x8b,
-		0xc6, 0xfb, 0xcf, 0xda, 0xfe, 0x71, 0x57, 0x20, 0xe7, 0x09, 0xa3, 0x8d, 0xc5, 0x60, 0xe6, 0x0e,
-		0xf1, 0xa8, 0xeb, 0xd8, 0x51, 0xb2, 0xb7, 0x7c, 0x25, 0xf9, 0x9f, 0x44, 0x20, 0x4d, 0xc7, 0xb4,
-		0x86, 0x1d, 0x2d, 0xb0, 0x86, 0x91, 0x7b, 0x5f, 0xc3, 0xfb, 0x01, 0x18, 0x19, 0xdb, 0x78, 0x0d,
-		0x73, 0xc9, 0x4a, 0xd3, 0x9a, 0xba, 0xf1, 0x1a, 0x46, 0x97, 0x5c, 0x86, 0xc7, 0x0e, 0x67, 0xb8,
-		0xf0, 0xb8, 0x39, 0xdb, 0x4f, 0x40, 0x92, 0xbe, 0xab, 0xb7, 0x6f, 0x73, 0x27, 0x3a, 0x61, 0xf6,
-		0xda, 0x8d, 0x7d, 0x5b, 0x7e, 0x15, 0x92, 0x8d, 0x7d, 0x96, 0x0b, 0x39, 0x05, 0xe9, 0xae, 0x65,
-		0x71, 0x9b, 0xcc, 0x7c, 0xa1, 0x14, 0xa9, 0xa0, 0x26, 0x48, 0xc4, 0xff, 0x51, 0x2f, 0xfe, 0xf7,
-		0x12, 0x18, 0xb1, 0xb1, 0x12, 0x18, 0xe7, 0xfe, 0x5d, 0x04, 0x32, 0x3e, 0xfd, 0x80, 0x9e, 0x84,
-		0x63, 0xe5, 0xd5, 0x8d, 0xca, 0x75, 0xb5, 0xb6, 0xac, 0x5e, 0x59, 0x2d, 0xad, 0x78, 0x5f, 0xd8,
-		0x15, 0x8f, 0xdf, 0xb9, 0xbb, 0x80, 0x7c, 0xb0, 0x5b, 0x26, 0x3d, 0xcd, 0x41, 0xe7, 0x61, 0x26,
-		0x88, 0x52, 0x2a, 0xd7, 0xab, 0xeb, 0x0d, 0x29, 0x52, 0x3c, 0x76, 0xe7, 0xee, 0xc2, 0x94, 0x0f,
-		0xa3, 0xb4, 0x6d, 0x63, 0xd3, 0x19, 0x44, 0xa8, 0x6c, 0xac, 0xad, 0xd5, 0x1a, 0x52, 0x74, 0x00,
-		0x81, 0x2b, 0xec, 0xb3, 0x30, 0x15, 0x44, 0x58, 0xaf, 0xad, 0x4a, 0xb1, 0x22, 0xba, 0x73, 0x77,
-		0x21, 0xef, 0x83, 0x5e, 0x37, 0x5a, 0xc5, 0xd4, 0x47, 0x7e, 0x6a, 0x6e, 0xe2, 0x93, 0x7f, 0x67,
-		0x2e, 0x42, 0x66, 0x96, 0x0b, 0xe8, 0x08, 0xf4, 0x18, 0x9c, 0xa8, 0xd7, 0x56, 0xd6, 0xab, 0xcb,
-		0xea, 0x5a, 0x7d, 0xa5, 0xef, 0xa3, 0xe9, 0x62, 0xe1, 0xce, 0xdd, 0x85, 0x0c, 0x9f, 0xd2, 0x28,
-		0xe8, 0x4d, 0xa5, 0x7a, 0x63, 0xa3, 0x51, 0x95, 0x22, 0x0c, 0x7a, 0xb3, 0x8b, 0x6f, 0x59, 0x0e,
-		0x7b, 0x92, 0xf3, 0x09, 0x38, 0x39, 0x04, 0xda, 0x9d, 0xd8, 0xd4, 0x9d, 0xbb, 0x0b, 0xb9, 0xcd,
-		0x2e, 0x66, 0xfb, 0x87, 0x62, 0x2c, 0xc2, 0xec, 0x20, 0xc6, 0xc6, 0xe6, 0x46, 0xbd, 0xb4, 0x2a,
-		0x2d, 0x14, 0xa5, 0x3b, 0x77, 0x17, 0xb2, 0x42, 0x19, 0x12, 0x78, 0x6f, 0x66, 0xef, 0x56, 0xb4,
-		0xf3, 0xdd, 0xc9, 0x40, 0x2e, 0x8f, 0xc5, 0x11, 0x1d, 0xad, 0xab, 0xb5, 0x8f, 0x1a, 0xee, 0x84,
-		0x1c, 0xe7, 0xca, 0xaf, 0x47, 0xa1, 0xe0, 0x3a, 0xd3, 0x9b, 0xb4, 0x07, 0xf4, 0x94, 0x3f, 0x0f,
-		0x93, 0x19, 0x69, 0xc6, 0x18, 0xb4, 0x48, 0xd3, 0xbc, 0x0f, 0x52, 0xc2, 0x29, 0xe3, 0xea, 0x62,
-		0x61, 0x10, 0xaf, 0xca, 0x21, 0x38, 0xaa, 0x8b, 0x81, 0x5e, 0x80, 0xb4, 0xab, 0x3c, 0xdc, 0x67,
-		0xab, 0x46, 0x6b, 0x1b, 0x8e, 0xef, 0xe1, 0xa0, 0xe7, 0xbc, 0xb0, 0x21, 0x3e, 0x2a, 0x10, 0xb9,
-		0xc1, 0x00, 0x38, 0xb2, 0x80, 0x97, 0x6b, 0x7c, 0xdb, 0xf1, 0xd9, 0xd3, 0x27, 0x3f, 0xf6, 0x55,
-		0x16, 0x49, 0x31, 0xaf, 0x24, 0xd5, 0xd6, 0xf6, 0xcb, 0x34, 0x98, 0x3a, 0x01, 0x49, 0xd2, 0xb8,
-		0xcb, 0x3f, 0x2a, 0x8f, 0x29, 0x89, 0xb6, 0xb6, 0xbf, 0xa2, 0xd9, 0xd7, 0xe2, 0xa9, 0x98, 0x14,
-		0x97, 0x7f, 0x36, 0x02, 0xf9, 0xe0, 0x1c, 0xd1, 0xa3, 0x80, 0x08, 0x86, 0xb6, 0x8b, 0x55, 0xa2,
-		0x62, 0x28, 0xb3, 0x04, 0xdd, 0x42, 0x5b, 0xdb, 0x2f, 0xed, 0xe2, 0xf5, 0x5e, 0x9b, 0x0e, 0xc0,
-		0x46, 0x6b, 0x20, 0x09, 0x60, 0xb1, 0x4e, 0x9c, 0x99, 0x27, 0x07, 0x1f, 0xb1, 0xe4, 0x00, 0xcc,
-		0x50, 0x7d, 0x8c, 0x18, 0xaa, 0x3c, 0xa3, 0xe7, 0x1e, 0xc7, 0x07, 0xa6, 0x12, 0x0b, 0x4e, 0x45,
-		0x7e, 0x01, 0x0a, 0x7d, 0xfc, 0x44, 0x32, 0xe4, 0x78, 0x56, 0x80, 0x1e, 0x75, 0x32, 0xff, 0x38,
-		0xad, 0x64, 0x58, 0xf4, 0x4f, 0x8f, 0x7e, 0x97, 0x52, 0xbf, 0xfa, 0xc6, 0x7c, 0x84, 0x26, 0xcc,
-		0x1f, 0x85, 0x5c, 0x80, 0xa3, 0x22, 0x53, 0x17, 0xf1, 0x32, 0x75, 0x1e, 0xf0, 0x2b, 0x90, 0x25,
-		0x8a, 0x12, 0x37, 0x39, 0xec, 0x23, 0x50, 0x60, 0x8a, 0xbc, 0x9f, 0xd7, 0xcc, 0x93, 0x5a, 0x13,
-		0x0c, 0x97, 0x85, 0x6b, 0x15, 0x64, 0x7b, 0x46, 0x40, 0xad, 0x68, 0x76, 0xf9, 0x03, 0x9f, 0x7c,
-		0x6b, 0x2e, 0xf2, 0xee, 0x6c, 0xc4, 0xdf, 0xfd, 0x00, 0x9c, 0xf2, 0x35, 0x6a, 0xdb, 0xba, 0x11,
-		0xc8, 0x3a, 0x14, 0x7c, 0x42, 0x46, 0x1a, 0xc3, 0xb2, 0x07, 0x87, 0xe6, 0x30, 0x0e, 0x4f, 0x98,
-		0x15, 0x0f, 0xd7, 0x08, 0xe1, 0x89, 0x8d, 0xe1, 0x39, 0xca, 0xff, 0x93, 0x82, 0xa4, 0x82, 0x3f,
-		0xd4, 0xc3, 0xb6, 0x83, 0x2e, 0x40, 0x1c, 0xeb, 0x7b, 0xd6, 0xb0, 0x94, 0x10, 0x99, 0xdc, 0x22,
-		0x87, 0xab, 0xea, 0x7b, 0xd6, 0xd5, 0x09, 0x85, 0xc2, 0xa2, 0x8b, 0x30, 0xb9, 0xd3, 0xea, 0xf1,
-		0x3c, 0x45, 0x9f, 0xb2, 0xf0, 0x23, 0x5d, 0x21, 0x40, 0x57, 0x27, 0x14, 0x06, 0x4d, 0xba, 0xa2,
-		0x0f, 0x05, 0xc7, 0x0e, 0xef, 0xaa, 0x66, 0xee, 0xd0, 0xae, 0x08, 0x2c, 0x2a, 0x03, 0x18, 0xa6,
-		0xe1, 0xa8, 0x34, 0x86, 0xe7, 0x9e, 0xe0, 0x03, 0xa3, 0x31, 0x0d, 0x87, 0x46, 0xfd, 0x57, 0x27,
-		0x94, 0xb4, 0x21, 0x0a, 0x64, 0xb8, 0x1f, 0xea, 0xe1, 0xee, 0x01, 0x77, 0x00, 0x47, 0x0e, 0xf7,
-		0x03, 0x04, 0x88, 0x0c, 0x97, 0x42, 0xa3, 0x2a, 0x64, 0xe8, 0x17, 0xb2, 0x6c, 0xff, 0xf2, 0xa7,
-		0x69, 0xe5, 0x51, 0xc8, 0x65, 0x02, 0x4a, 0xb7, 0xf4, 0xd5, 0x09, 0x05, 0xb6, 0xdd, 0x12, 0x51,
-		0x92, 0xec, 0xe9, 0x32, 0x67, 0x9f, 0x3f, 0xc8, 0x39, 0x3f, 0x8a, 0x06, 0x7d, 0xbf, 0xac, 0xb1,
-		0x7f, 0x75, 0x42, 0x49, 0xea, 0xec, 0x27, 0x99, 0x7f, 0x13, 0xb7, 0x8c, 0x5b, 0xb8, 0x4b, 0xf0,
-		0xd3, 0x87, 0xcf, 0x7f, 0x99, 0x41, 0x52, 0x0a, 0xe9, 0xa6, 0x28, 0x10, 0x45, 0x8b, 0xcd, 0x26,
-		0x9f, 0x06, 0x0c, 0xea, 0xe9, 0xc0, 0x3a, 0x9b, 0x4d, 0x31, 0x89, 0x14, 0xe6, 0xbf, 0xd1, 0xb3,
-		0xae, 0x87, 0x9a, 0x19, 0x74, 0x0a, 0x03, 0x13, 0x60, 0xb9, 0x8a, 0x09, 0xe1, 0xa9, 0xa2, 0x75,
-		0xc8, 0xb7, 0x0c, 0xdb, 0x51, 0x6d, 0x53, 0xeb, 0xd8, 0x7b, 0x96, 0x63, 0xd3, 0xa0, 0x3f, 0x73,
-		0xe1, 0xe1, 0x51, 0x14, 0x56, 0x0d, 0xdb, 0xa9, 0x0b, 0xe0, 0xab, 0x13, 0x4a, 0xae, 0xe5, 0xaf,
-		0x20, 0xf4, 0xac, 0x9d, 0x1d, 0xdc, 0x75, 0x09, 0xd2, 0xe4, 0xc0, 0x21, 0xf4, 0x36, 0x08, 0xb4,
-		0xc0, 0x27, 0xf4, 0x2c, 0x7f, 0x05, 0xfa, 0x66, 0x98, 0x6e, 0x59, 0x5a, 0xd3, 0x25, 0xa7, 0xea,
-		0x7b, 0x3d, 0xf3, 0x26, 0xcd, 0x24, 0x64, 0x2e, 0x9c, 0x1d, 0x39, 0x48, 0x4b, 0x6b, 0x0a, 0x12,
-		0x15, 0x82, 0x70, 0x75, 0x42, 0x99, 0x6a, 0xf5, 0x57, 0xa2, 0x0f, 0xc2, 0x8c, 0xd6, 0xe9, 0xb4,
-		0x0e, 0xfa, 0xa9, 0x17, 0x28, 0xf5, 0x73, 0xa3, 0xa8, 0x97, 0x08, 0x4e, 0x3f, 0x79, 0xa4, 0x0d,
-		0xd4, 0xa2, 0x06, 0x48, 0x9d, 0x2e, 0xa6, 0x1f, 0xd5, 0x74, 0xb8, 0xab, 0x42, 0x9f, 0x1c, 0xca,
-		0x5c, 0x38, 0x3d, 0x8a, 0xf6, 0x26, 0x83, 0x17, 0x9e, 0xcd, 0xd5, 0x09, 0xa5, 0xd0, 0x09, 0x56,
-		0x31, 0xaa, 0x96, 0x8e, 0xe9, 0x8b, 0x68, 0x9c, 0xea, 0x54, 0x18, 0x55, 0x0a, 0x1f, 0xa4, 0x1a,
-		0xa8, 0x2a, 0x27, 0xf9, 0xa5, 0x38, 0xfe, 0x62, 0xcc, 0x69, 0xc8, 0xf8, 0x14, 0x0b, 0x9a, 0x85,
-		0x24, 0xbf, 0x24, 0x20, 0x2e, 0xd3, 0xf1, 0xa2, 0x9c, 0x87, 0xac, 0x5f, 0x99, 0xc8, 0x1f, 0x8d,
-		0xb8, 0x98, 0xf4, 0x53, 0xfb, 0xd9, 0x60, 0xea, 0x30, 0xed, 0x65, 0x05, 0x1f, 0x14, 0x56, 0x44,
-		0xb4, 0xb3, 0xd3, 0xa4, 0x2c, 0xad, 0xe4, 0x46, 0x0c, 0xcd, 0x43, 0xa6, 0x73, 0xa1, 0xe3, 0x82,
-		0xc4, 0x28, 0x08, 0x74, 0x2e, 0x74, 0x04, 0xc0, 0x03, 0x90, 0x25, 0x33, 0x55, 0xfd, 0x8e, 0x46,
-		0x5a, 0xc9, 0x90, 0x3a, 0x0e, 0x22, 0xff, 0xab, 0x28, 0x48, 0xfd, 0x0a, 0xc8, 0xcd, 0x29, 0x46,
-		0x8e, 0x9c, 0x53, 0x3c, 0xd9, 0x9f, 0xcd, 0xf4, 0x12, 0x98, 0xab, 0x20, 0x79, 0x79, 0x38, 0x66,
-		0x08, 0x46, 0x3b, 0x4e, 0x7d, 0x1e, 0x9e, 0x52, 0xd0, 0xfb, 0x5c, 0xbe, 0x2b, 0x81, 0xb3, 0x17,
-		0xf1, 0x12, 0x7e, 0xff, 0x12, 0xbb, 0xfe, 0xc2, 0x56, 0xa7, 0xa9, 0x39, 0x58, 0xe4, 0x45, 0x7c,
-		0xc7, 0x30, 0x8f, 0x40, 0x41, 0xeb, 0x74, 0x54, 0xdb, 0xd1, 0x1c, 0xcc, 0xcd, 0xfa, 0x24, 0x4b,
-		0x0f, 0x6a, 0x9d, 0x4e, 0x9d, 0xd4, 0x32, 0xb3, 0xfe, 0x30, 0xe4, 0x89, 0x4e, 0x36, 0xb4, 0x96,
-		0xca, 0xb3, 0x04, 0x09, 0x66, 0xfd, 0x79, 0xed, 0x55, 0x5a, 0x29, 0x37, 0xdd, 0x15, 0xa7, 0xfa,
-		0xd8, 0x0d, 0xb3, 0x22, 0xbe, 0x30, 0x0b, 0xf1, 0x27, 0x10, 0x18, 0x7f, 0xc4, 0xb3, 0x11, 0xc3,
-		0xb3, 0xbb, 0x33, 0x34, 0x24, 0xbb, 0xc5, 0x12, 0x1e, 0x29, 0x85, 0x15, 0xe4, 0x0f, 0x47, 0x61,
-		0x6a, 0x40, 0x73, 0x0f, 0x4d, 0x7b, 0x7b, 0xf1, 0x65, 0xf4, 0x48, 0xf1, 0xe5, 0xf5, 0x60, 0x5a,
-		0xd7, 0x67, 0xf9, 0x4e, 0x0d, 0x30, 0x99, 0xe9, 0x4d, 0x22, 0xd0, 0x9c, 0x88, 0x2f, 0xf3, 0x4b,
-		0xc5, 0x7c, 0x0b, 0x66, 0xb6, 0x0f, 0x5e, 0xd3, 0x4c, 0xc7, 0x30, 0xb1, 0x3a, 0xb0, 0x6a, 0x83,
-		0xa6, 0x74, 0xcd, 0xb0, 0xb7, 0xf1, 0x9e, 0x76, 0xcb, 0xb0, 0xc4, 0xb0, 0xa6, 0x5d, 0x7c, 0x2f,
-		0xb9, 0x2b, 0x2b, 0x90, 0x0f, 0x9a, 0x1e, 0x94, 0x87, 0xa8, 0xb3, 0xcf, 0xe7, 0x1f, 0x75, 0xf6,
-		0xd1, 0x13, 0x3c, 0x0f, 0x14, 0xa5, 0x79, 0xa0, 0xc1, 0x8e, 0x38, 0x9e, 0x97, 0x04, 0x92, 0x65,
-		0x77, 0x37, 0xb8, 0xe6, 0xa8, 0x9f, 0xaa, 0x7c, 0x16, 0x0a, 0x7d, 0xf6, 0x66, 0x54, 0x56, 0x50,
-		0x2e, 0x40, 0x2e, 0x60, 0x5c, 0xe4, 0xe3, 0x30, 0x33, 0xcc, 0x56, 0xc8, 0x7b, 0x6e, 0x7d, 0x40,
-		0xe7, 0xa3, 0x8b, 0x90, 0x72, 0x8d, 0xc5, 0x90, 0x2c, 0x04, 0x9d, 0x85, 0x00, 0x56, 0x5c, 0xd0,
-		0x40, 0x32, 0x3b, 0x1a, 0x48, 0x66, 0xcb, 0xdf, 0x06, 0xb3, 0xa3, 0x0c, 0x41, 0xdf, 0x34, 0xe2,
-		0xae, 0x14, 0x1e, 0x87, 0x04, 0x7f, 0xf6, 0x30, 0x4a, 0x8f, 0x6f, 0x78, 0x89, 0x48, 0x27, 0x33,
-		0x0a, 0x31, 0x76, 0xaa, 0x43, 0x0b, 0xb2, 0x0a, 0x27, 0x47, 0x1a, 0x83, 0xd1, 0x07, 0x41, 0x8c,
-		0x10, 0x3f, 0x08, 0xd2, 0xc5, 0x70, 0x6c, 0x3a, 0x57, 0x71, 0xd9, 0x81, 0x95, 0xe4, 0x8f, 0xc5,
-		0xe0, 0xf8, 0x70, 0x93, 0x80, 0x16, 0x20, 0x4b, 0xfc, 0x6e, 0x27, 0xe8, 0xa2, 0x43, 0x5b, 0xdb,
-		0x6f, 0x70, 0xff, 0x9c, 0x27, 0xd2, 0xa3, 0x6e, 0x22, 0x1d, 0x6d, 0xc1, 0x54, 0xcb, 0xd2, 0xb5,
-		0x96, 0xea, 0x93, 0x78, 0x2e, 0xec, 0x0f, 0x0e, 0x30, 0xbb, 0xca, 0x1e, 0x43, 0x6f, 0x0e, 0x08,
-		0x7d, 0x81, 0xd2, 0x58, 0x75, 0x25, 0x1f, 0x2d, 0x43, 0xa6, 0xed, 0x09, 0xf2, 0x11, 0x84, 0xdd,
-		0x8f, 0xe6, 0x5b, 0x92, 0xc9, 0xa1, 0xc7, 0x3e, 0x89, 0x23, 0xab, 0xe8, 0x51, 0x27, 0x28, 0xc9,
-		0x91, 0x27, 0x28, 0xc3, 0x8e, 0x2b, 0x52, 0xc3, 0x8f, 0x2b, 0x3e, 0xe2, 0x5f, 0x9a, 0x80, 0x11,
-		0x1d, 0x3c, 0xc1, 0x40, 0x75, 0x98, 0xe1, 0xf8, 0xcd, 0x00, 0xef, 0xa3, 0xe3, 0x2a, 0x1a, 0x24,
-		0xd0, 0x47, 0xb3, 0x3d, 0x76, 0x6f, 0x6c, 0x17, 0xba, 0x34, 0xee, 0xd3, 0xa5, 0xff, 0x9f, 0x2d,
-		0xc5, 0xbf, 0x49, 0x43, 0x4a, 0xc1, 0x76, 0x87, 0x18, 0x4e, 0x54, 0x86, 0x34, 0xde, 0xd7, 0x71,
-		0xc7, 0xf1, 0x8e, 0x29, 0x87, 0x05, 0x03, 0x0c, 0xba, 0x2a, 0x20, 0x89, 0x27, 0xee, 0xa2, 0xa1,
-		0xa7, 0x78, 0xb0, 0x35, 0x3a, 0x6e, 0xe2, 0xe8, 0xfe, 0x68, 0xeb, 0x92, 0x88, 0xb6, 0x62, 0x23,
-		0x9d, 0x6f, 0x86, 0xd5, 0x17, 0x6e, 0x3d, 0xc5, 0xc3, 0xad, 0x78, 0x48, 0x67, 0x81, 0x78, 0xab,
-		0x12, 0x88, 0xb7, 0x12, 0x21, 0xd3, 0x1c, 0x11, 0x70, 0x5d, 0x12, 0x01, 0x57, 0x32, 0x64, 0xc4,
-		0x7d, 0x11, 0xd7, 0x95, 0x60, 0xc4, 0x95, 0x1a, 0xa1, 0x40, 0x04, 0xf6, 0xc8, 0x90, 0xeb, 0x79,
-		0x5f, 0xc8, 0x95, 0x1e, 0x19, 0xef, 0x30, 0x22, 0x43, 0x62, 0xae, 0x4a, 0x20, 0xe6, 0x82, 0x10,
-		0x1e, 0x8c, 0x08, 0xba, 0xbe, 0xc9, 0x1f, 0x74, 0x65, 0x46, 0xc6, 0x6d, 0x7c, 0xbd, 0x87, 0x45,
-		0x5d, 0xcf, 0xb9, 0x51, 0x57, 0x76, 0x64, 0xd8, 0xc8, 0xe7, 0xd0, 0x1f, 0x76, 0x6d, 0x0c, 0x84,
-		0x5d, 0x39, 0xfe, 0x77, 0x46, 0x46, 0x91, 0x08, 0x89, 0xbb, 0x36, 0x06, 0xe2, 0xae, 0x7c, 0x08,
-		0xc1, 0x90, 0xc0, 0xeb, 0x5b, 0x86, 0x07, 0x5e, 0xa3, 0x43, 0x23, 0x3e, 0xcc, 0xf1, 0x22, 0x2f,
-		0x75, 0x44, 0xe4, 0xc5, 0xa2, 0xa3, 0x47, 0x47, 0x92, 0x1f, 0x3b, 0xf4, 0xda, 0x1a, 0x12, 0x7a,
-		0xb1, 0x20, 0xe9, 0xcc, 0x48, 0xe2, 0x63, 0xc4, 0x5e, 0x5b, 0x43, 0x62, 0x2f, 0x14, 0x4a, 0xf6,
-		0x28, 0xc1, 0xd7, 0xa4, 0x94, 0x90, 0xcf, 0x12, 0xd7, 0xb7, 0x4f, 0x4f, 0x11, 0xff, 0x01, 0x77,
-		0xbb, 0x56, 0x57, 0xdc, 0xac, 0xa5, 0x05, 0xf9, 0x0c, 0x71, 0xc6, 0x3d, 0x9d, 0x74, 0x48, 0xa0,
-		0x46, 0xfd, 0x34, 0x9f, 0x1e, 0x92, 0x7f, 0x35, 0xe2, 0xe1, 0x52, 0x1f, 0xd6, 0xef, 0xc8, 0xa7,
-		0xb9, 0x23, 0xef, 0x0b, 0xdf, 0xa2, 0xc1, 0xf0, 0x6d, 0x1e, 0x32, 0xc4, 0xff, 0xea, 0x8b, 0xcc,
-		0xb4, 0x8e, 0x1b, 0x99, 0x89, 0x2b, 0x05, 0x2c, 0xc8, 0xe3, 0x66, 0x85, 0x9d, 0xe4, 0x14, 0xdc,
-		0xeb, 0x15, 0x2c, 0xa6, 0x40, 0x8f, 0xc3, 0xb4, 0x0f, 0xd6, 0xf5, 0xeb, 0x58, 0x98, 0x22, 0xb9,
-		0xd0, 0x25, 0xee, 0xe0, 0xfd, 0xd3, 0x88, 0xc7, 0x21, 0x2f, 0xa4, 0x1b, 0x16, 0x7d, 0x45, 0xde,
-		0xa1, 0xe8, 0x2b, 0x7a, 0xcf, 0xd1, 0x97, 0xdf, 0x4f, 0x8d, 0x05, 0xfd, 0xd4, 0xff, 0x1e, 0xf1,
-		0xd6, 0xc4, 0x8d, 0xa5, 0x74, 0xab, 0x89, 0xb9, 0xe7, 0x48, 0x7f, 0x13, 0xa7, 0xa2, 0x65, 0xed,
-		0x72, 0xff, 0x90, 0xfc, 0x24, 0x50, 0xae, 0xe1, 0x48, 0x73, 0xbb, 0xe0, 0x3a, 0x9d, 0x93, 0xfe,
-		0xfb, 0xbf, 0xfc, 0x52, 0x6c, 0xc2, 0xbb, 0x14, 0xeb, 0x7e, 0xfc, 0x96, 0xf4, 0x7d, 0xfc, 0x86,
-		0x9e, 0x85, 0x34, 0xcd, 0x88, 0xaa, 0x56, 0x47, 0xfc, 0x55, 0x9a, 0x53, 0xa3, 0x2f, 0xc4, 0xda,
-		0xf4, 0xaa, 0x1e, 0xbb, 0x44, 0xeb, 0x79, 0x0c, 0xe9, 0x80, 0xc7, 0x70, 0x1f, 0xa4, 0xc9, 0xe8,
-		0xd9, 0xeb, 0xea, 0xc0, 0xbf, 0x9c, 0x14, 0x15, 0xf2, 0x07, 0x01, 0x0d, 0x1a, 0x09, 0x74, 0x15,
-		0x12, 0xf8, 0x16, 0x7d, 0xd5, 0x8f, 0x5d, 0x34, 0x3c, 0x3e, 0xe8, 0x9a, 0x92, 0xe6, 0xf2, 0x2c,
-		0x61, 0xf2, 0x17, 0xdf, 0x9c, 0x97, 0x18, 0xf4, 0x63, 0xee, 0x87, 0x02, 0x0a, 0xc7, 0x97, 0xff,
-		0x43, 0x94, 0x04, 0x30, 0x01, 0x03, 0x32, 0x94, 0xb7, 0xc3, 0x8e, 0x08, 0xc7, 0xe3, 0xf7, 0x1c,
-		0xc0, 0xae, 0x66, 0xab, 0xb7, 0x35, 0xd3, 0xc1, 0x4d, 0xce, 0x74, 0x5f, 0x0d, 0x2a, 0x42, 0x8a,
-		0x94, 0x7a, 0x36, 0x6e, 0xf2, 0x30, 0xda, 0x2d, 0xfb, 0xe6, 0x99, 0xfc, 0xda, 0xe6, 0x19, 0xe4,
-		0x72, 0xaa, 0x8f, 0xcb, 0xbe, 0xe0, 0x22, 0xed, 0x0f, 0x2e, 0xd8, 0xc5, 0x60, 0x7e, 0x3f, 0x11,
-		0xd8, 0xd8, 0x44, 0x19, 0x3d, 0x08, 0xb9, 0x36, 0x6e, 0x77, 0x2c, 0xab, 0xa5, 0x32, 0x75, 0xc3,
-		0xfe, 0x8c, 0x42, 0x96, 0x57, 0x56, 0xa9, 0xd6, 0xf9, 0xae, 0xa8, 0xb7, 0xff, 0xbc, 0x20, 0xf2,
-		0x1b, 0x8e, 0xc1, 0xf4, 0xdd, 0xe9, 0x7e, 0x17, 0x01, 0xd5, 0xfd, 0xb7, 0x46, 0x7a, 0x54, 0x2d,
-		0x08, 0x81, 0x1e, 0x57, 0x7f, 0x78, 0xb7, 0x4b, 0x58, 0xb5, 0x8d, 0x5e, 0x86, 0x13, 0x7d, 0xba,
-		0xcd, 0x25, 0x1d, 0x1d, 0x57, 0xc5, 0x1d, 0x0b, 0xaa, 0x38, 0x41, 0xda, 0x63, 0x56, 0xec, 0x6b,
-		0xdc, 0x75, 0x35, 0xc8, 0x07, 0x3d, 0x9e, 0xa1, 0xcb, 0x4f, 0xff, 0x6c, 0x8f, 0xa3, 0x19, 0xa6,
-		0x1a, 0x48, 0x07, 0x65, 0x59, 0x25, 0x4f, 0x32, 0x6d, 0xc2, 0xb1, 0xa1, 0x9e, 0x0f, 0x7a, 0x06,
-		0xd2, 0x9e, 0xd3, 0xc4, 0xb8, 0x7a, 0x48, 0xba, 0xc0, 0x83, 0x95, 0x7f, 0x23, 0xe2, 0x91, 0x0c,
-		0x26, 0x20, 0xaa, 0x90, 0x60, 0xd7, 0xde, 0xf8, 0x65, 0x9a, 0xc7, 0xc7, 0xf3, 0x99, 0x16, 0xd9,
-		0x9d, 0x38, 0x85, 0x23, 0xcb, 0x1f, 0x84, 0x04, 0xab, 0x41, 0x19, 0x48, 0x7a, 0x0f, 0xef, 0x02,
-		0x24, 0x4a, 0x95, 0x4a, 0x75, 0x53, 0xbc, 0xa2, 0x59, 0xde, 0x50, 0x1a, 0x52, 0x94, 0x54, 0x2b,
-		0xd5, 0x6b, 0xd5, 0x4a, 0x43, 0x8a, 0xa1, 0x29, 0xc8, 0xb1, 0xdf, 0xea, 0x95, 0x0d, 0x65, 0xad,
-		0xd4, 0x90, 0xe2, 0xbe, 0xaa, 0x7a, 0x75, 0x7d, 0xb9, 0xaa, 0x48, 0x93, 0xf2, 0x93, 0x70, 0x72,
-		0xa4, 0x97, 0xe5, 0x65, 0x17, 0x22, 0xbe, 0xec, 0x82, 0xfc, 0xb1, 0x28, 0x14, 0x47, 0xbb, 0x4e,
-		0xe8, 0x5a, 0xdf, 0xc4, 0x2f, 0x1c, 0xc1, 0xef, 0xea, 0x9b, 0x3d, 0x7a, 0x18, 0xf2, 0x5d, 0xbc,
-		0x83, 0x1d, 0x7d, 0x8f, 0xb9, 0x72, 0xcc, 0x64, 0xe6, 0x94, 0x1c, 0xaf, 0xa5, 0x48, 0x36, 0x03,
-		0x7b, 0x15, 0xeb, 0x8e, 0xca, 0x74, 0x91, 0xcd, 0xff, 0xb2, 0x68, 0x8e, 0xd5, 0xd6, 0x59, 0xa5,
-		0xfc, 0x6d, 0x47, 0xe2, 0x65, 0x1a, 0x26, 0x95, 0x6a, 0x43, 0x79, 0x59, 0x8a, 0x21, 0x04, 0x79,
-		0xfa, 0x53, 0xad, 0xaf, 0x97, 0x36, 0xeb, 0x57, 0x37, 0x08, 0x2f, 0xa7, 0xa1, 0x20, 0x78, 0x29,
-		0x2a, 0x27, 0xe5, 0x47, 0xe1, 0xc4, 0x08, 0xbf, 0x6f, 0xc8, 0x3d, 0xc4, 0x8f, 0x47, 0xfc, 0xd0,
-		0xc1, 0x98, 0x7f, 0x03, 0x12, 0xb6, 0xa3, 0x39, 0x3d, 0x9b, 0x33, 0xf1, 0x99, 0x71, 0x1d, 0xc1,
-		0x45, 0xf1, 0xa3, 0x4e, 0xd1, 0x15, 0x4e, 0x46, 0xbe, 0x08, 0xf9, 0x60, 0xcb, 0x68, 0x1e, 0x78,
-		0x42, 0x14, 0x95, 0x5f, 0x06, 0xf0, 0xe5, 0x23, 0xdd, 0x1b, 0x5d, 0x11, 0xff, 0x8d, 0xae, 0x8b,
-		0x30, 0x79, 0xcb, 0x62, 0x3a, 0x63, 0xf8, 0xc6, 0xb9, 0x61, 0x39, 0xd8, 0x97, 0x7c, 0x60, 0xd0,
-		0xb2, 0x01, 0x68, 0x30, 0x27, 0x34, 0xa2, 0x8b, 0xe7, 0x83, 0x5d, 0x3c, 0x30, 0x32, 0xbb, 0x34,
-		0xbc, 0xab, 0xd7, 0x60, 0x92, 0x6a, 0x9b, 0xa1, 0x9f, 0xf8, 0x7c, 0x2b, 0x80, 0xe6, 0x38, 0x5d,
-		0x63, 0xbb, 0xe7, 0x75, 0x30, 0x3f, 0x5c, 0x5b, 0x95, 0x04, 0x5c, 0xf9, 0x3e, 0xae, 0xb6, 0x66,
-		0x3c, 0x54, 0x9f, 0xea, 0xf2, 0x11, 0x94, 0xd7, 0x21, 0x1f, 0xc4, 0x1d, 0xfe, 0xc9, 0x92, 0xf7,
-		0x76, 0x40, 0x5a, 0xb8, 0x4f, 0xae, 0xf3, 0xc5, 0xdf, 0xf3, 0xa0, 0x05, 0xf9, 0x4e, 0x04, 0x52,
-		0x8d, 0x7d, 0x2e, 0xc7, 0x87, 0x5c, 0xaa, 0xf4, 0xbe, 0xdb, 0x72, 0x93, 0x85, 0x2c, 0x1f, 0x1b,
-		0x73, 0xb3, 0xbc, 0xdf, 0xe4, 0xee, 0xd4, 0xf8, 0xb8, 0xd1, 0xae, 0xc8, 0x76, 0x73, 0xed, 0x74,
-		0x79, 0xbc, 0x6f, 0x24, 0x66, 0x60, 0xd2, 0xff, 0x7d, 0x03, 0x2b, 0xc8, 0x4d, 0xdf, 0xb5, 0x04,
-		0x66, 0x36, 0xfc, 0x1f, 0x53, 0x44, 0x8e, 0xfc, 0x31, 0x85, 0xdb, 0x4b, 0xd4, 0xdf, 0xcb, 0x2d,
-		0x48, 0x09, 0xa1, 0x40, 0xef, 0xf7, 0xdf, 0x3d, 0x11, 0x67, 0x34, 0x23, 0x8d, 0x27, 0x27, 0xef,
-		0xbb, 0x7a, 0x72, 0x0e, 0xa6, 0xf8, 0x95, 0x3b, 0x2f, 0xae, 0xe0, 0x7f, 0x72, 0xa0, 0xc0, 0x1a,
-		0x56, 0x45, 0x50, 0x21, 0xff, 0x74, 0x04, 0xa4, 0x7e, 0xa9, 0x7c, 0x2f, 0x07, 0x40, 0x94, 0x22,
-		0x91, 0x7e, 0xdf, 0x9b, 0xdf, 0x6c, 0xe5, 0x73, 0xa4, 0xd6, 0x7b, 0xf5, 0xfb, 0xc3, 0x51, 0xc8,
-		0xf8, 0x72, 0x7a, 0xe8, 0xe9, 0xc0, 0x15, 0xd0, 0x85, 0xc3, 0xf2, 0x7f, 0xbe, 0x3b, 0xa0, 0x81,
-		0x89, 0x45, 0x8f, 0x3e, 0xb1, 0x77, 0xfe, 0x92, 0xfe, 0xf0, 0xaf, 0x7d, 0x26, 0x47, 0x7c, 0xed,
-		0xf3, 0x1d, 0x11, 0x48, 0xb9, 0xa6, 0xfb, 0xa8, 0xd9, 0xfc, 0xe3, 0x90, 0xe0, 0xd6, 0x89, 0xa5,
-		0xf3, 0x79, 0x69, 0x68, 0x2e, 0xb4, 0x08, 0x29, 0xf1, 0x87, 0xa2, 0x78, 0x20, 0xea, 0x96, 0xcf,
-		0x3d, 0x07, 0x19, 0xdf, 0xc1, 0x0a, 0xd1, 0x13, 0xeb, 0xd5, 0x17, 0xa5, 0x89, 0x62, 0xf2, 0xce,
-		0xdd, 0x85, 0xd8, 0x3a, 0xbe, 0x4d, 0x76, 0x98, 0x52, 0xad, 0x5c, 0xad, 0x56, 0xae, 0x4b, 0x91,
-		0x62, 0xe6, 0xce, 0xdd, 0x85, 0xa4, 0x82, 0x69, 0xfa, 0xea, 0xdc, 0x75, 0x28, 0xf4, 0x2d, 0x4c,
-		0x50, 0xbf, 0x23, 0xc8, 0x2f, 0x6f, 0x6d, 0xae, 0xd6, 0x2a, 0xa5, 0x46, 0x55, 0x65, 0xd7, 0xe9,
-		0xd0, 0x09, 0x98, 0x5e, 0xad, 0xad, 0x5c, 0x6d, 0xa8, 0x95, 0xd5, 0x5a, 0x75, 0xbd, 0xa1, 0x96,
-		0x1a, 0x8d, 0x52, 0xe5, 0xba, 0x14, 0xbd, 0xf0, 0x55, 0x80, 0x42, 0xa9, 0x5c, 0xa9, 0x11, 0xfb,
-		0x6c, 0xf0, 0x67, 0xdb, 0x2b, 0x10, 0xa7, 0xa9, 0x80, 0x43, 0xaf, 0x8a, 0x14, 0x0f, 0xcf, 0x6d,
-		0xa2, 0x2b, 0x30, 0x49, 0xb3, 0x04, 0xe8, 0xf0, 0xbb, 0x23, 0xc5, 0x90, 0x64, 0x27, 0x19, 0x0c,
-		0xdd, 0x4e, 0x87, 0x5e, 0x26, 0x29, 0x1e, 0x9e, 0xfb, 0x44, 0x0a, 0xa4, 0xbd, 0x28, 0x23, 0xfc,
-		0x72, 0x45, 0x71, 0x0c, 0xed, 0x88, 0x56, 0x21, 0x29, 0x02, 0xc3, 0xb0, 0xeb, 0x1e, 0xc5, 0xd0,
-		0xe4, 0x24, 0x61, 0x17, 0x0b, 0xe0, 0x0f, 0xbf, 0xbb, 0x52, 0x0c, 0xc9, 0xb4, 0xa2, 0x9a, 0x7b,
-		0x09, 0x3f, 0xe4, 0x0a, 0x47, 0x31, 0x2c, 0xd9, 0x48, 0x98, 0xe6, 0xa5, 0x46, 0xc2, 0x6f, 0xe4,
-		0x14, 0xc7, 0x48, 0x22, 0xa3, 0x2d, 0x00, 0x5f, 0xb8, 0x3e, 0xc6, 0x55, 0x9b, 0xe2, 0x38, 0xc9,
-		0x61, 0xb4, 0x01, 0x29, 0x37, 0x7a, 0x0a, 0xbd, 0xf8, 0x52, 0x0c, 0xcf, 0xd2, 0xa2, 0x0f, 0x42,
-		0x2e, 0x18, 0x35, 0x8c, 0x77, 0x9d, 0xa5, 0x38, 0x66, 0xfa, 0x95, 0xd0, 0x0f, 0x86, 0x10, 0xe3,
-		0x5d, 0x6f, 0x29, 0x8e, 0x99, 0x8d, 0x45, 0xaf, 0xc2, 0xd4, 0xa0, 0x8b, 0x3f, 0xfe, 0x6d, 0x97,
-		0xe2, 0x11, 0xf2, 0xb3, 0xa8, 0x0d, 0x68, 0x48, 0x68, 0x70, 0x84, 0xcb, 0x2f, 0xc5, 0xa3, 0xa4,
-		0x6b, 0x51, 0x13, 0x0a, 0xfd, 0xfe, 0xf6, 0xb8, 0x97, 0x61, 0x8a, 0x63, 0xa7, 0x6e, 0x59, 0x2f,
-		0x41, 0x3f, 0x7d, 0xdc, 0xcb, 0x31, 0xc5, 0xb1, 0x33, 0xb9, 0xe5, 0xd2, 0xc8, 0xfb, 0x8d, 0xa7,
-		0x0f, 0xbd, 0xdf, 0xe8, 0xdd, 0x58, 0x74, 0xef, 0x34, 0xfe, 0xf1, 0x13, 0xf0, 0x10, 0x7f, 0x4c,
-		0xc0, 0x76, 0xb4, 0x9b, 0x86, 0xb9, 0xeb, 0xbe, 0x0e, 0xc1, 0xcb, 0xfc, 0x72, 0xe3, 0x71, 0xfe,
-		0x60, 0x81, 0xa8, 0x0d, 0x79, 0x23, 0x62, 0xe4, 0xc3, 0x59, 0x61, 0x97, 0x90, 0xc3, 0xaf, 0x2e,
-		0x1e, 0xf2, 0xfe, 0x44, 0xc8, 0x2b, 0x17, 0x43, 0xde, 0xa7, 0x08, 0xb9, 0x84, 0x79, 0xd8, 0x7d,
-		0x4f, 0xf9, 0x07, 0x23, 0x90, 0xbf, 0x6a, 0xd8, 0x8e, 0xd5, 0x35, 0x74, 0xad, 0x45, 0x2d, 0xc6,
-		0xe5, 0x71, 0x3f, 0xf2, 0x28, 0xa7, 0x89, 0x33, 0xc2, 0x1f, 0xb5, 0xe0, 0x17, 0x43, 0x96, 0x21,
-		0x71, 0x4b, 0x6b, 0xb1, 0x4f, 0x2c, 0xfc, 0xef, 0xd7, 0xf4, 0xf3, 0xdc, 0xe7, 0x25, 0xf9, 0xa9,
-		0x30, 0x5c, 0xf9, 0x53, 0xf4, 0x12, 0x77, 0xbb, 0x6d, 0xd8, 0xec, 0x4f, 0xb9, 0x3b, 0xd8, 0x46,
-		0x5b, 0x10, 0xef, 0x6a, 0x0e, 0x0f, 0x6a, 0xca, 0x25, 0xfe, 0x14, 0xc5, 0x23, 0xe1, 0x0f, 0x4a,
-		0x2c, 0x0e, 0xbc, 0x56, 0xc1, 0x3a, 0xa3, 0xe4, 0xd0, 0xb7, 0x40, 0xaa, 0xad, 0xed, 0xab, 0x94,
-		0x74, 0xf4, 0x9d, 0x22, 0x9d, 0x6c, 0x6b, 0xfb, 0x64, 0xd4, 0xc8, 0x80, 0x02, 0xa1, 0xae, 0xef,
-		0x69, 0xe6, 0x2e, 0x66, 0x9d, 0xc4, 0xde, 0xa9, 0x4e, 0x72, 0x6d, 0x6d, 0xbf, 0x42, 0x09, 0x93,
-		0xae, 0xf8, 0xa3, 0x1f, 0xbf, 0x15, 0xe1, 0xa1, 0x2c, 0xe5, 0x1c, 0xd2, 0x40, 0xd2, 0xdd, 0x12,
-		0xed, 0x5f, 0xa4, 0xf5, 0x4f, 0x8f, 0x5a, 0x98, 0x3e, 0xbe, 0x97, 0x73, 0x64, 0xa4, 0x9f, 0x7d,
-		0x73, 0x3e, 0xc2, 0x7a, 0x2d, 0xe8, 0x7d, 0xeb, 0x72, 0x0d, 0x32, 0x2c, 0x9b, 0xa6, 0x52, 0x17,
-		0x36, 0x1a, 0xea, 0xc2, 0xe6, 0x84, 0x0b, 0xcb, 0x08, 0x02, 0xc3, 0x26, 0xed, 0x7c, 0x0e, 0x9f,
-		0x8c, 0x40, 0x66, 0xd9, 0xf7, 0x5c, 0xd5, 0x2c, 0x24, 0xdb, 0x96, 0x69, 0xdc, 0xc4, 0x5d, 0xf7,
-		0x5c, 0x86, 0x15, 0x89, 0x9b, 0xc9, 0xfe, 0xea, 0x91, 0x73, 0x20, 0x5e, 0x62, 0x10, 0x65, 0x82,
-		0x75, 0x1b, 0x6f, 0xdb, 0x86, 0x60, 0xb9, 0x22, 0x8a, 0xe8, 0x2c, 0x48, 0x36, 0xd6, 0x7b, 0x5d,
-		0xc3, 0x39, 0x50, 0x75, 0xcb, 0x74, 0x34, 0xdd, 0xe1, 0xd9, 0xd3, 0x82, 0xa8, 0xaf, 0xb0, 0x6a,
-		0x42, 0xa4, 0x89, 0x1d, 0xcd, 0x68, 0xb1, 0x6b, 0x5f, 0x69, 0x45, 0x14, 0xf9, 0x50, 0x3f, 0x95,
-		0xf4, 0x87, 0x86, 0x15, 0x90, 0xac, 0x0e, 0xee, 0x06, 0x3e, 0x79, 0x63, 0xe2, 0x3a, 0xfb, 0x7b,
-		0xbf, 0xfc, 0xf8, 0x0c, 0x67, 0x38, 0x3f, 0x80, 0x67, 0xaf, 0x33, 0x2b, 0x05, 0x81, 0x21, 0xbe,
-		0x85, 0x7b, 0x39, 0x70, 0x12, 0xd3, 0xdb, 0xf6, 0x3e, 0xb9, 0x9f, 0x19, 0x60, 0x6a, 0xc9, 0x3c,
-		0x28, 0xcf, 0xfe, 0x8e, 0x47, 0xda, 0x0b, 0x1d, 0xaf, 0xe3, 0x03, 0xff, 0xb1, 0x0c, 0x25, 0x43,
-		0x3c, 0xf8, 0x57, 0x35, 0xa3, 0x25, 0xfe, 0x40, 0x9c, 0xc2, 0x4b, 0x68, 0xc9, 0x4d, 0xc3, 0xc4,
-		0x69, 0x38, 0x24, 0x8f, 0x92, 0x8d, 0xb2, 0x65, 0x36, 0x83, 0x19, 0x17, 0xd4, 0x80, 0x84, 0x63,
-		0xdd, 0xc4, 0x26, 0x67, 0x50, 0xf9, 0x7d, 0x47, 0x10, 0xec, 0xc1, 0xf7, 0x67, 0x38, 0x2d, 0xb4,
-		0x0b, 0x52, 0x13, 0xb7, 0xf0, 0x2e, 0xfb, 0x60, 0x6b, 0x4f, 0xeb, 0x62, 0xf6, 0x11, 0xe7, 0xd1,
-		0xe8, 0x0f, 0x3e, 0x53, 0x53, 0x70, 0xa9, 0xd6, 0x29, 0x51, 0xb4, 0x19, 0x7c, 0x2c, 0x2d, 0xc9,
-		0x4f, 0xe5, 0x47, 0xcc, 0xdf, 0x27, 0x95, 0x7e, 0xb5, 0x15, 0x78, 0x5c, 0xed, 0x2c, 0x48, 0x3d,
-		0x73, 0xdb, 0x32, 0xe9, 0xdf, 0x5c, 0xe2, 0x01, 0x56, 0x8a, 0x9d, 0xdc, 0xb9, 0xf5, 0xfc, 0xe4,
-		0x6e, 0x13, 0xf2, 0x1e, 0x28, 0xdd, 0x3d, 0xe9, 0xa3, 0xee, 0x9e, 0x9c, 0x4b, 0x80, 0x80, 0xa0,
-		0x35, 0x00, 0x6f, 0x7f, 0xba, 0xa7, 0xfb, 0xa1, 0x3b, 0xdd, 0x3f, 0x19, 0x1f, 0x01, 0xd4, 0x82,
-		0xe9, 0xb6, 0x61, 0xaa, 0x36, 0x6e, 0xed, 0xa8, 0x9c, 0x73, 0x84, 0x6e, 0xe6, 0x1d, 0x58, 0xe9,
-		0xa9, 0xb6, 0x61, 0xd6, 0x71, 0x6b, 0x67, 0xd9, 0x25, 0x8b, 0xde, 0x07, 0xa7, 0x3c, 0x76, 0x58,
-		0xa6, 0xba, 0x67, 0xb5, 0x9a, 0x6a, 0x17, 0xef, 0xa8, 0x3a, 0x7d, 0xfe, 0x28, 0x4b, 0x99, 0x78,
-		0xc2, 0x05, 0xd9, 0x30, 0xaf, 0x5a, 0xad, 0xa6, 0x82, 0x77, 0x2a, 0xa4, 0x19, 0x3d, 0x08, 0x1e,
-		0x2f, 0x54, 0xa3, 0x69, 0xcf, 0xe6, 0x16, 0x62, 0x67, 0xe2, 0x4a, 0xd6, 0xad, 0xac, 0x35, 0xed,
-		0xa5, 0xd4, 0x47, 0xde, 0x98, 0x9f, 0xf8, 0xc2, 0x1b, 0xf3, 0x13, 0xf2, 0x15, 0xfa, 0x58, 0x07,
-		0xdf, 0x74, 0xd8, 0x46, 0x97, 0x20, 0xad, 0x89, 0x02, 0xfb, 0x4c, 0xe4, 0x90, 0x4d, 0xeb, 0x81,
-		0xca, 0x6f, 0x44, 0x20, 0xb1, 0x7c, 0x63, 0x53, 0x33, 0xba, 0xa8, 0x0a, 0x53, 0x9e, 0xd0, 0x8e,
-		0xbb, 0xff, 0x3d, 0x39, 0x17, 0x0a, 0xa0, 0x3a, 0xea, 0xcb, 0xd9, 0x43, 0xc9, 0xf4, 0x7f, 0x53,
-		0xeb, 0x9b, 0xea, 0x35, 0x48, 0xb2, 0x11, 0xda, 0xe8, 0x05, 0x98, 0xec, 0x90, 0x1f, 0x3c, 0xfb,
-		0x3f, 0x37, 0x52, 0xd0, 0x29, 0xbc, 0x5f, 0x2c, 0x18, 0x9e, 0xfc, 0x97, 0x11, 0x80, 0xe5, 0x1b,
-		0x37, 0x1a, 0x5d, 0xa3, 0xd3, 0xc2, 0xce, 0x3b, 0x35, 0xe5, 0x55, 0x38, 0xe6, 0xfb, 0x3e, 0xb3,
-		0xab, 0x8f, 0x3d, 0xed, 0x69, 0xef, 0x0b, 0xcd, 0xae, 0x3e, 0x94, 0x5a, 0xd3, 0x76, 0x5c, 0x6a,
-		0xb1, 0xb1, 0xa9, 0x2d, 0xdb, 0xce, 0x20, 0x1f, 0x5f, 0x82, 0x8c, 0x37, 0x75, 0x1b, 0xd5, 0x20,
-		0xe5, 0xf0, 0xdf, 0x9c, 0x9d, 0xf2, 0x68, 0x76, 0x0a, 0x34, 0x3f, 0x4b, 0x5d, 0x74, 0xf9, 0x7f,
-		0x12, 0xae, 0x7a, 0x1b, 0xe1, 0xeb, 0x4a, 0x90, 0x88, 0x86, 0xe7, 0x1a, 0x38, 0xf6, 0x0e, 0x68,
-		0x60, 0x4e, 0xcb, 0xc7, 0xd6, 0xef, 0x8c, 0xc2, 0xf4, 0x96, 0xd8, 0xa4, 0x5f, 0xb7, 0x5c, 0xd8,
-		0x82, 0x24, 0x36, 0x9d, 0xae, 0x81, 0xc5, 0x51, 0xdf, 0x13, 0xa3, 0x16, 0x7b, 0xc8, 0x5c, 0xe8,
-		0x9f, 0xd7, 0xf6, 0x2f, 0xbd, 0xa0, 0xe5, 0x63, 0xc3, 0xaf, 0xc7, 0x60, 0x76, 0x14, 0x2a, 0x3a,
-		0x0d, 0x05, 0xbd, 0x8b, 0x69, 0x85, 0x1a, 0x48, 0x85, 0xe7, 0x45, 0x35, 0x37, 0x29, 0x0a, 0x10,
-		0x07, 0x8d, 0x48, 0x15, 0x01, 0xbd, 0x37, 0x8f, 0x2c, 0xef, 0x51, 0xa0, 0x46, 0x05, 0x43, 0x41,
-		0xdc, 0x6d, 0xdf, 0xd6, 0x5a, 0x9a, 0xa9, 0xe3, 0x7b, 0x90, 0x84, 0x41, 0x0b, 0x20, 0x2e, 0xcc,
-		0x97, 0x19, 0x4d, 0x74, 0x03, 0x92, 0x82, 0x7c, 0xfc, 0x1d, 0x20, 0x2f, 0x88, 0xa1, 0x07, 0x20,
-		0xeb, 0x37, 0x0c, 0xd4, 0x4f, 0x89, 0x2b, 0x19, 0x9f, 0x5d, 0x08, 0xb3, 0x3c, 0x89, 0x43, 0x2d,
-		0x0f, 0x77, 0x05, 0x3f, 0x1d, 0x83, 0x29, 0x05, 0x37, 0xbf, 0x01, 0x17, 0xee, 0x9b, 0x01, 0xd8,
-		0xa6, 0x26, 0xca, 0xf6, 0x1e, 0xd6, 0x6e, 0x50, 0x49, 0xa4, 0x19, 0xbd, 0x65, 0xdb, 0x79, 0xaf,
-		0x56, 0xef, 0x5f, 0x46, 0x21, 0xeb, 0x5f, 0xbd, 0x6f, 0x00, 0xcb, 0x86, 0xd6, 0x3d, 0x95, 0xc6,
-		0x6e, 0x9f, 0x9f, 0x1d, 0xa5, 0xd2, 0x06, 0xe4, 0x3a, 0x44, 0x97, 0x7d, 0x22, 0x06, 0x09, 0x7e,
-		0x15, 0x6c, 0x63, 0xc0, 0xc7, 0x8d, 0x84, 0x7d, 0xff, 0x9b, 0x13, 0xdf, 0xff, 0x0e, 0x75, 0x71,
-		0x1f, 0x86, 0x3c, 0x09, 0xa9, 0x03, 0xf7, 0xcb, 0x22, 0x67, 0x72, 0x34, 0x1c, 0xf6, 0x6e, 0x43,
-		0xa3, 0x79, 0xc8, 0x10, 0x30, 0x4f, 0x67, 0x13, 0x18, 0x68, 0x6b, 0xfb, 0x55, 0x56, 0x83, 0x1e,
-		0x07, 0xb4, 0xe7, 0x26, 0x3e, 0x54, 0x8f, 0x11, 0x04, 0x6e, 0xca, 0x6b, 0x11, 0xe0, 0xf7, 0x03,
-		0x90, 0x51, 0xa8, 0xec, 0x85, 0x4e, 0xfe, 0xda, 0x29, 0xa9, 0x59, 0xa6, 0xaf, 0x74, 0x7e, 0x7f,
-		0x84, 0xb9, 0xca, 0x7d, 0xd1, 0x36, 0x0f, 0x5a, 0xd4, 0xa3, 0xed, 0x86, 0xaf, 0xbc, 0x39, 0x5f,
-		0x3c, 0xd0, 0xda, 0xad, 0x25, 0x79, 0x08, 0x49, 0x79, 0x58, 0x2e, 0x80, 0x78, 0xd3, 0xc1, 0xc0,
-		0x7d, 0xe9, 0x21, 0x22, 0xd7, 0x77, 0x3e, 0xff, 0xf3, 0xe7, 0x4e, 0xf9, 0xba, 0xd8, 0x77, 0x33,
-		0x64, 0x6c, 0x79, 0xe4, 0x9f, 0x8d, 0x00, 0xf2, 0x8c, 0x8d, 0x7b, 0x1f, 0x7c, 0x8d, 0xde, 0x12,
-		0x16, 0xfe, 0x7e, 0xe4, 0xf0, 0x38, 0xc2, 0xc3, 0x0f, 0xc4, 0x11, 0xbe, 0xcd, 0xf4, 0x7e, 0x4f,
-		0xb5, 0x8b, 0xaf, 0xbf, 0x87, 0xbc, 0xd5, 0xba, 0x58, 0xb1, 0x8c, 0x00, 0x09, 0x81, 0x44, 0xf7,
-		0xe8, 0x84, 0xfc, 0x66, 0x04, 0x4e, 0x0e, 0x48, 0xa2, 0x3b, 0x64, 0x1d, 0x50, 0xd7, 0xd7, 0x48,
-		0x57, 0x54, 0x9c, 0xaf, 0xde, 0x9b, 0x60, 0x4f, 0x75, 0x07, 0xd4, 0xf9, 0xbb, 0x64, 0xa3, 0xb8,
-		0x12, 0xfa, 0xed, 0x08, 0xcc, 0xf8, 0x47, 0xe4, 0xce, 0xad, 0x0e, 0x59, 0xff, 0x58, 0xf8, 0xac,
-		0x1e, 0x1a, 0x67, 0x56, 0xfe, 0x09, 0x05, 0x88, 0x90, 0xb9, 0x08, 0xa9, 0x67, 0xb9, 0xba, 0x27,
-		0xc7, 0xe6, 0x92, 0x7b, 0x0e, 0x31, 0x4c, 0x0d, 0xb0, 0xc5, 0xfa, 0xbe, 0x28, 0xc4, 0x37, 0x2d,
-		0xab, 0x85, 0xbe, 0x23, 0x02, 0x53, 0xa6, 0xe5, 0xa8, 0x64, 0xaf, 0xe0, 0xa6, 0xca, 0x93, 0x05,
-		0x4c, 0x93, 0xde, 0x38, 0x1a, 0xf7, 0xbe, 0xf8, 0xe6, 0xfc, 0x20, 0xa9, 0x61, 0x4f, 0xec, 0x16,
-		0x4c, 0xcb, 0x29, 0x53, 0xa0, 0x06, 0xcb, 0x27, 0xdc, 0x86, 0x5c, 0xb0, 0x7f, 0xa6, 0x7e, 0x95,
-		0x23, 0xf7, 0x9f, 0x0b, 0xed, 0x3b, 0xbb, 0xed, 0xeb, 0x98, 0x3d, 0x74, 0xf9, 0x67, 0x64, 0x71,
-		0x5f, 0x06, 0xe9, 0x46, 0xff, 0xcd, 0xb4, 0x2a, 0x24, 0x8f, 0x7a, 0xc9, 0xcd, 0xcf, 0x71, 0x8e,
-		0x7b, 0xee, 0x57, 0x22, 0x00, 0x5e, 0x6a, 0x06, 0x3d, 0x06, 0x27, 0xca, 0x1b, 0xeb, 0xcb, 0x6a,
-		0xbd, 0x51, 0x6a, 0x6c, 0xd5, 0x83, 0xef, 0xb2, 0x8b, 0xb7, 0x48, 0xec, 0x0e, 0xd6, 0x8d, 0x1d,
-		0x03, 0x37, 0xd1, 0x23, 0x30, 0x13, 0x84, 0x26, 0xa5, 0xea, 0xb2, 0x14, 0x29, 0x66, 0xef, 0xdc,
-		0x5d, 0x48, 0x31, 0x97, 0x14, 0x37, 0xd1, 0x19, 0x38, 0x36, 0x08, 0x57, 0x5b, 0x5f, 0x91, 0xa2,
-		0xc5, 0xdc, 0x9d, 0xbb, 0x0b, 0x69, 0xd7, 0x77, 0x45, 0x32, 0x20, 0x3f, 0x24, 0xa7, 0x17, 0x2b,
-		0xc2, 0x9d, 0xbb, 0x0b, 0x09, 0xb6, 0x2c, 0xc5, 0xf8, 0x47, 0x7e, 0x6a, 0x6e, 0xe2, 0xdc, 0xb7,
-		0x02, 0xd4, 0xcc, 0x9d, 0xae, 0x46, 0xff, 0x38, 0x31, 0x2a, 0xc2, 0xf1, 0xda, 0xfa, 0x15, 0xa5,
-		0x54, 0x69, 0xd4, 0x36, 0xd6, 0xfb, 0x9e, 0x93, 0x0f, 0xb6, 0x2d, 0x6f, 0x6c, 0x95, 0x57, 0xab,
-		0x6a, 0xbd, 0xb6, 0xb2, 0xce, 0x4e, 0x78, 0x03, 0x6d, 0x2f, 0xae, 0x37, 0x6a, 0x6b, 0x55, 0x29,
-		0x5a, 0xbe, 0x32, 0xf2, 0x8c, 0xe1, 0xb1, 0x43, 0x17, 0xdc, 0xd3, 0x92, 0x81, 0x83, 0x86, 0xff,
-		0x1b, 0x00, 0x00, 0xff, 0xff, 0xd9, 0xcb, 0xff, 0x85, 0xa2, 0x9a, 0x00, 0x00,
-	}
-	r := bytes.NewReader(gzipped)
-	gzipr, err := compress_gzip.NewReader(r)
-	if err != nil {
-		panic(err)
-	}
-	ungzipped, err := io_ioutil.ReadAll(gzipr)
-	if err != nil {
-		panic(err)
-	}
-	if err := github_com_cosmos_gogoproto_proto.Unmarshal(ungzipped, d); err != nil {
-		panic(err)
+func (m *ValidatorJailInfo) GetReference() string {
+	if m != nil {
+		return m.Reference
 	}
-	return d
+	return ""
 }
-func (this *CommissionRates) Equal(that interface{}) bool {
-	if that == nil {
-		return this == nil
-	}
 
-	that1, ok := that.(*CommissionRates)
-	if !ok {
-		that2, ok := that.(CommissionRates)
-		if ok {
-			that1 = &that2
-		} else {
-			return false
+// ValidatorSetChange defines the change of a validator in the validator set
+// between the historical info of a height and the one of the previous stored
+// height.
+//
+// Since: cosmos-sdk 0.48
+type ValidatorSetChange struct {
+	// height is the height at which the change is observed.
+	Height int64 `protobuf:"varint,1,opt,name=height,proto3" json:"height,omitempty"`
+	// operator_address is the address of the validator's operator.
+	OperatorAddress string `protobuf:"bytes,2,opt,name=operator_address,json=operatorAddress,proto3" json:"operator_address,omitempty"`
+	// consensus_pubkey is the consensus public key of the validator, as a Protobuf Any.
+	ConsensusPubkey *types1.Any `protobuf:"bytes,3,opt,name=consensus_pubkey,json=consensusPubkey,proto3" json:"consensus_pubkey,omitempty"`
+	// type is the type of the change.
+	Type ValidatorSetChangeType `protobuf:"varint,4,opt,name=type,proto3,enum=cosmos.staking.v1beta1.ValidatorSetChangeType" json:"type,omitempty"`
+	// previous_power is the consensus power of the validator before the change,
+	// zero if it joined the validator set.
+	PreviousPower int64 `protobuf:"varint,5,opt,name=previous_power,json=previousPower,proto3" json:"previous_power,omitempty"`
+	// power is the consensus power of the validator after the change, zero if
+	// it left the validator set.
+	Power int64 `protobuf:"varint,6,opt,name=power,proto3" json:"power,omitempty"`
+}
+
+func (m *ValidatorSetChange) Reset()         { *m = ValidatorSetChange{} }
+func (m *ValidatorSetChange) String() string { return proto.CompactTextString(m) }
+func (*ValidatorSetChange) ProtoMessage()    {}
+func (*ValidatorSetChange) Descriptor() ([]byte, []int) {
+	return fileDescriptor_64c30c6cf92913c9, []int{29}
+}
+func (m *ValidatorSetChange) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ValidatorSetChange) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ValidatorSetChange.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if that1 == nil {
-		return this == nil
-	} else if this == nil {
-		return false
-	}
-	if !this.Rate.Equal(that1.Rate) {
-		return false
-	}
-	if !this.MaxRate.Equal(that1.MaxRate) {
-		return false
-	}
-	if !this.MaxChangeRate.Equal(that1.MaxChangeRate) {
-		return false
-	}
-	return true
 }
-func (this *Commission) Equal(that interface{}) bool {
-	if that == nil {
-		return this == nil
+func (m *ValidatorSetChange) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ValidatorSetChange.Merge(m, src)
+}
+func (m *ValidatorSetChange) XXX_Size() int {
+	return m.Size()
+}
+func (m *ValidatorSetChange) XXX_DiscardUnknown() {
+	xxx_messageInfo_ValidatorSetChange.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ValidatorSetChange proto.InternalMessageInfo
+
+func (m *ValidatorSetChange) GetHeight() int64 {
+	if m != nil {
+		return m.Height
 	}
+	return 0
+}
 
-	that1, ok := that.(*Commission)
-	if !ok {
-		that2, ok := that.(Commission)
-		if ok {
-			that1 = &that2
-		} else {
-			return false
-		}
+func (m *ValidatorSetChange) GetOperatorAddress() string {
+	if m != nil {
+		return m.OperatorAddress
 	}
-	if that1 == nil {
-		return this == nil
-	} else if this == nil {
-		return false
+	return ""
+}
+
+func (m *ValidatorSetChange) GetConsensusPubkey() *types1.Any {
+	if m != nil {
+		return m.ConsensusPubkey
 	}
-	if !this.CommissionRates.Equal(&that1.CommissionRates) {
-		return false
+	return nil
+}
+
+func (m *ValidatorSetChange) GetType() ValidatorSetChangeType {
+	if m != nil {
+		return m.Type
 	}
-	if !this.UpdateTime.Equal(that1.UpdateTime) {
-		return false
+	return ValidatorSetChangeType_VALIDATOR_SET_CHANGE_TYPE_UNSPECIFIED
+}
+
+func (m *ValidatorSetChange) GetPreviousPower() int64 {
+	if m != nil {
+		return m.PreviousPower
 	}
-	return true
+	return 0
 }
-func (this *Description) Equal(that interface{}) bool {
-	if that == nil {
-		return this == nil
+
+func (m *ValidatorSetChange) GetPower() int64 {
+	if m != nil {
+		return m.Power
 	}
+	return 0
+}
 
-	that1, ok := that.(*Description)
-	if !ok {
-		that2, ok := that.(Description)
-		if ok {
-			that1 = &that2
-		} else {
-			return false
+// ValidatorUpdates defines an array of abci.ValidatorUpdate objects.
+// TODO: explore moving this to proto/cosmos/base to separate modules from tendermint dependence
+type ValidatorUpdates struct {
+	Updates []types3.ValidatorUpdate `protobuf:"bytes,1,rep,name=updates,proto3" json:"updates"`
+}
+
+func (m *ValidatorUpdates) Reset()         { *m = ValidatorUpdates{} }
+func (m *ValidatorUpdates) String() string { return proto.CompactTextString(m) }
+func (*ValidatorUpdates) ProtoMessage()    {}
+func (*ValidatorUpdates) Descriptor() ([]byte, []int) {
+	return fileDescriptor_64c30c6cf92913c9, []int{30}
+}
+func (m *ValidatorUpdates) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ValidatorUpdates) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ValidatorUpdates.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if that1 == nil {
-		return this == nil
-	} else if this == nil {
-		return false
-	}
-	if this.Moniker != that1.Moniker {
-		return false
-	}
-	if this.Identity != that1.Identity {
-		return false
-	}
-	if this.Website != that1.Website {
-		return false
-	}
-	if this.SecurityContact != that1.SecurityContact {
-		return false
-	}
-	if this.Details != that1.Details {
-		return false
-	}
-	return true
 }
-func (this *UnbondingDelegationEntry) Equal(that interface{}) bool {
-	if that == nil {
-		return this == nil
+func (m *ValidatorUpdates) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ValidatorUpdates.Merge(m, src)
+}
+func (m *ValidatorUpdates) XXX_Size() int {
+	return m.Size()
+}
+func (m *ValidatorUpdates) XXX_DiscardUnknown() {
+	xxx_messageInfo_ValidatorUpdates.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ValidatorUpdates proto.InternalMessageInfo
+
+func (m *ValidatorUpdates) GetUpdates() []types3.ValidatorUpdate {
+	if m != nil {
+		return m.Updates
 	}
+	return nil
+}
 
-	that1, ok := that.(*UnbondingDelegationEntry)
-	if !ok {
-		that2, ok := that.(UnbondingDelegationEntry)
-		if ok {
-			that1 = &that2
-		} else {
-			return false
+// TokenizeShareRecord represents the shares of a delegation tokenized by
+// MsgTokenizeShares. The shares are delegated by the module account of the
+// record, and are represented by the share tokens of the record.
+//
+// Since: cosmos-sdk 0.48
+type TokenizeShareRecord struct {
+	// id is the unique id of the record.
+	Id uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	// owner is the address receiving the rewards of the tokenized shares.
+	Owner string `protobuf:"bytes,2,opt,name=owner,proto3" json:"owner,omitempty"`
+	// module_account is the name of the module account delegating the tokenized
+	// shares.
+	ModuleAccount string `protobuf:"bytes,3,opt,name=module_account,json=moduleAccount,proto3" json:"module_account,omitempty"`
+	// validator is the operator address of the validator of the tokenized shares.
+	Validator string `protobuf:"bytes,4,opt,name=validator,proto3" json:"validator,omitempty"`
+}
+
+func (m *TokenizeShareRecord) Reset()         { *m = TokenizeShareRecord{} }
+func (m *TokenizeShareRecord) String() string { return proto.CompactTextString(m) }
+func (*TokenizeShareRecord) ProtoMessage()    {}
+func (*TokenizeShareRecord) Descriptor() ([]byte, []int) {
+	return fileDescriptor_64c30c6cf92913c9, []int{31}
+}
+func (m *TokenizeShareRecord) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TokenizeShareRecord) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TokenizeShareRecord.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if that1 == nil {
-		return this == nil
-	} else if this == nil {
-		return false
-	}
-	if this.CreationHeight != that1.CreationHeight {
-		return false
+}
+func (m *TokenizeShareRecord) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TokenizeShareRecord.Merge(m, src)
+}
+func (m *TokenizeShareRecord) XXX_Size() int {
+	return m.Size()
+}
+func (m *TokenizeShareRecord) XXX_DiscardUnknown() {
+	xxx_messageInfo_TokenizeShareRecord.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TokenizeShareRecord proto.InternalMessageInfo
+
+func (m *TokenizeShareRecord) GetId() uint64 {
+	if m != nil {
+		return m.Id
 	}
-	if !this.CompletionTime.Equal(that1.CompletionTime) {
-		return false
+	return 0
+}
+
+func (m *TokenizeShareRecord) GetOwner() string {
+	if m != nil {
+		return m.Owner
 	}
-	if !this.InitialBalance.Equal(that1.InitialBalance) {
-		return false
+	return ""
+}
+
+func (m *TokenizeShareRecord) GetModuleAccount() string {
+	if m != nil {
+		return m.ModuleAccount
 	}
-	if !this.Balance.Equal(that1.Balance) {
-		return false
+	return ""
+}
+
+func (m *TokenizeShareRecord) GetValidator() string {
+	if m != nil {
+		return m.Validator
 	}
-	if this.UnbondingId != that1.UnbondingId {
-		return false
+	return ""
+}
+
+// PendingCommissionChange defines a commission rate change announced by a
+// validator which takes effect at the given time.
+//
+// Since: cosmos-sdk 0.48
+type PendingCommissionChange struct {
+	// validator_address is the operator address of the validator.
+	ValidatorAddress string `protobuf:"bytes,1,opt,name=validator_address,json=validatorAddress,proto3" json:"validator_address,omitempty"`
+	// rate is the commission rate which will be applied.
+	Rate github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,2,opt,name=rate,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"rate"`
+	// effective_time is the time at which the rate will be applied.
+	EffectiveTime time.Time `protobuf:"bytes,3,opt,name=effective_time,json=effectiveTime,proto3,stdtime" json:"effective_time"`
+}
+
+func (m *PendingCommissionChange) Reset()         { *m = PendingCommissionChange{} }
+func (m *PendingCommissionChange) String() string { return proto.CompactTextString(m) }
+func (*PendingCommissionChange) ProtoMessage()    {}
+func (*PendingCommissionChange) Descriptor() ([]byte, []int) {
+	return fileDescriptor_64c30c6cf92913c9, []int{32}
+}
+func (m *PendingCommissionChange) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PendingCommissionChange) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PendingCommissionChange.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	if this.UnbondingOnHoldRefCount != that1.UnbondingOnHoldRefCount {
-		return false
+}
+func (m *PendingCommissionChange) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PendingCommissionChange.Merge(m, src)
+}
+func (m *PendingCommissionChange) XXX_Size() int {
+	return m.Size()
+}
+func (m *PendingCommissionChange) XXX_DiscardUnknown() {
+	xxx_messageInfo_PendingCommissionChange.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PendingCommissionChange proto.InternalMessageInfo
+
+func (m *PendingCommissionChange) GetValidatorAddress() string {
+	if m != nil {
+		return m.ValidatorAddress
 	}
-	return true
+	return ""
 }
-func (this *RedelegationEntry) Equal(that interface{}) bool {
-	if that == nil {
-		return this == nil
+
+func (m *PendingCommissionChange) GetEffectiveTime() time.Time {
+	if m != nil {
+		return m.EffectiveTime
 	}
+	return time.Time{}
+}
 
-	that1, ok := that.(*RedelegationEntry)
-	if !ok {
-		that2, ok := that.(RedelegationEntry)
-		if ok {
-			that1 = &that2
-		} else {
-			return false
+// QueuedEpochMsg defines a staking message queued until the end of the current
+// epoch.
+//
+// Since: cosmos-sdk 0.48
+type QueuedEpochMsg struct {
+	// id is the sequence number of the message, in queue order.
+	Id uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	// msg is the queued MsgDelegate, MsgUndelegate or MsgBeginRedelegate.
+	Msg *types1.Any `protobuf:"bytes,2,opt,name=msg,proto3" json:"msg,omitempty"`
+}
+
+func (m *QueuedEpochMsg) Reset()         { *m = QueuedEpochMsg{} }
+func (m *QueuedEpochMsg) String() string { return proto.CompactTextString(m) }
+func (*QueuedEpochMsg) ProtoMessage()    {}
+func (*QueuedEpochMsg) Descriptor() ([]byte, []int) {
+	return fileDescriptor_64c30c6cf92913c9, []int{33}
+}
+func (m *QueuedEpochMsg) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueuedEpochMsg) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueuedEpochMsg.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueuedEpochMsg) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueuedEpochMsg.Merge(m, src)
+}
+func (m *QueuedEpochMsg) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueuedEpochMsg) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueuedEpochMsg.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueuedEpochMsg proto.InternalMessageInfo
+
+func (m *QueuedEpochMsg) GetId() uint64 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
+}
+
+func (m *QueuedEpochMsg) GetMsg() *types1.Any {
+	if m != nil {
+		return m.Msg
+	}
+	return nil
+}
+
+// DelegationLock defines a delegation locked by its delegator until the given
+// time in exchange for a reward multiplier. A locked delegation cannot be
+// undelegated, redelegated or tokenized before the lock expires.
+//
+// Since: cosmos-sdk 0.48
+type DelegationLock struct {
+	// delegator_address is the encoded address of the delegator.
+	DelegatorAddress string `protobuf:"bytes,1,opt,name=delegator_address,json=delegatorAddress,proto3" json:"delegator_address,omitempty"`
+	// validator_address is the encoded address of the validator.
+	ValidatorAddress string `protobuf:"bytes,2,opt,name=validator_address,json=validatorAddress,proto3" json:"validator_address,omitempty"`
+	// duration is the lockup term the delegation is locked for.
+	Duration time.Duration `protobuf:"bytes,3,opt,name=duration,proto3,stdduration" json:"duration"`
+	// reward_multiplier is the factor applied to the rewards of the delegation
+	// while it is locked.
+	RewardMultiplier github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,4,opt,name=reward_multiplier,json=rewardMultiplier,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"reward_multiplier"`
+	// end_time is the time at which the lock expires.
+	EndTime time.Time `protobuf:"bytes,5,opt,name=end_time,json=endTime,proto3,stdtime" json:"end_time"`
+}
+
+func (m *DelegationLock) Reset()         { *m = DelegationLock{} }
+func (m *DelegationLock) String() string { return proto.CompactTextString(m) }
+func (*DelegationLock) ProtoMessage()    {}
+func (*DelegationLock) Descriptor() ([]byte, []int) {
+	return fileDescriptor_64c30c6cf92913c9, []int{34}
+}
+func (m *DelegationLock) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DelegationLock) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DelegationLock.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *DelegationLock) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DelegationLock.Merge(m, src)
+}
+func (m *DelegationLock) XXX_Size() int {
+	return m.Size()
+}
+func (m *DelegationLock) XXX_DiscardUnknown() {
+	xxx_messageInfo_DelegationLock.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DelegationLock proto.InternalMessageInfo
+
+func (m *DelegationLock) GetDelegatorAddress() string {
+	if m != nil {
+		return m.DelegatorAddress
+	}
+	return ""
+}
+
+func (m *DelegationLock) GetValidatorAddress() string {
+	if m != nil {
+		return m.ValidatorAddress
+	}
+	return ""
+}
+
+func (m *DelegationLock) GetDuration() time.Duration {
+	if m != nil {
+		return m.Duration
+	}
+	return 0
+}
+
+func (m *DelegationLock) GetEndTime() time.Time {
+	if m != nil {
+		return m.EndTime
+	}
+	return time.Time{}
+}
+
+func init() {
+	proto.RegisterEnum("cosmos.staking.v1beta1.BondStatus", BondStatus_name, BondStatus_value)
+	proto.RegisterEnum("cosmos.staking.v1beta1.Infraction", Infraction_name, Infraction_value)
+	proto.RegisterEnum("cosmos.staking.v1beta1.JailReason", JailReason_name, JailReason_value)
+	proto.RegisterEnum("cosmos.staking.v1beta1.ValidatorSetChangeType", ValidatorSetChangeType_name, ValidatorSetChangeType_value)
+	proto.RegisterType((*HistoricalInfo)(nil), "cosmos.staking.v1beta1.HistoricalInfo")
+	proto.RegisterType((*CommissionRates)(nil), "cosmos.staking.v1beta1.CommissionRates")
+	proto.RegisterType((*Commission)(nil), "cosmos.staking.v1beta1.Commission")
+	proto.RegisterType((*Description)(nil), "cosmos.staking.v1beta1.Description")
+	proto.RegisterType((*ValidatorProfile)(nil), "cosmos.staking.v1beta1.ValidatorProfile")
+	proto.RegisterType((*ProfileAttestation)(nil), "cosmos.staking.v1beta1.ProfileAttestation")
+	proto.RegisterType((*Validator)(nil), "cosmos.staking.v1beta1.Validator")
+	proto.RegisterType((*ValidatorAsset)(nil), "cosmos.staking.v1beta1.ValidatorAsset")
+	proto.RegisterType((*ValAddresses)(nil), "cosmos.staking.v1beta1.ValAddresses")
+	proto.RegisterType((*DVPair)(nil), "cosmos.staking.v1beta1.DVPair")
+	proto.RegisterType((*DVPairs)(nil), "cosmos.staking.v1beta1.DVPairs")
+	proto.RegisterType((*DVVTriplet)(nil), "cosmos.staking.v1beta1.DVVTriplet")
+	proto.RegisterType((*DVVTriplets)(nil), "cosmos.staking.v1beta1.DVVTriplets")
+	proto.RegisterType((*Delegation)(nil), "cosmos.staking.v1beta1.Delegation")
+	proto.RegisterType((*UnbondingDelegation)(nil), "cosmos.staking.v1beta1.UnbondingDelegation")
+	proto.RegisterType((*UnbondingDelegationEntry)(nil), "cosmos.staking.v1beta1.UnbondingDelegationEntry")
+	proto.RegisterType((*RedelegationEntry)(nil), "cosmos.staking.v1beta1.RedelegationEntry")
+	proto.RegisterType((*Redelegation)(nil), "cosmos.staking.v1beta1.Redelegation")
+	proto.RegisterType((*Params)(nil), "cosmos.staking.v1beta1.Params")
+	proto.RegisterType((*UnbondingTier)(nil), "cosmos.staking.v1beta1.UnbondingTier")
+	proto.RegisterType((*ValidatorRiskTier)(nil), "cosmos.staking.v1beta1.ValidatorRiskTier")
+	proto.RegisterType((*StandbyConsPubKey)(nil), "cosmos.staking.v1beta1.StandbyConsPubKey")
+	proto.RegisterType((*LockupTerm)(nil), "cosmos.staking.v1beta1.LockupTerm")
+	proto.RegisterType((*BondDenomWeight)(nil), "cosmos.staking.v1beta1.BondDenomWeight")
+	proto.RegisterType((*DelegationResponse)(nil), "cosmos.staking.v1beta1.DelegationResponse")
+	proto.RegisterType((*RedelegationEntryResponse)(nil), "cosmos.staking.v1beta1.RedelegationEntryResponse")
+	proto.RegisterType((*RedelegationResponse)(nil), "cosmos.staking.v1beta1.RedelegationResponse")
+	proto.RegisterType((*Pool)(nil), "cosmos.staking.v1beta1.Pool")
+	proto.RegisterType((*ValidatorJailInfo)(nil), "cosmos.staking.v1beta1.ValidatorJailInfo")
+	proto.RegisterType((*ValidatorSetChange)(nil), "cosmos.staking.v1beta1.ValidatorSetChange")
+	proto.RegisterType((*ValidatorUpdates)(nil), "cosmos.staking.v1beta1.ValidatorUpdates")
+	proto.RegisterType((*TokenizeShareRecord)(nil), "cosmos.staking.v1beta1.TokenizeShareRecord")
+	proto.RegisterType((*PendingCommissionChange)(nil), "cosmos.staking.v1beta1.PendingCommissionChange")
+	proto.RegisterType((*QueuedEpochMsg)(nil), "cosmos.staking.v1beta1.QueuedEpochMsg")
+	proto.RegisterType((*DelegationLock)(nil), "cosmos.staking.v1beta1.DelegationLock")
+}
+
+func init() {
+	proto.RegisterFile("cosmos/staking/v1beta1/staking.proto", fileDescriptor_64c30c6cf92913c9)
+}
+
+var fileDescriptor_64c30c6cf92913c9 = []byte{
+	// 3167 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe4, 0x5a, 0x4b, 0x6c, 0x1b, 0xc7,
+	0xf9, 0xd7, 0x92, 0x34, 0x25, 0x7e, 0x12, 0x29, 0x6a, 0xac, 0xd8, 0xb4, 0x6c, 0x4b, 0x32, 0x13,
+	0x27, 0x8e, 0x11, 0xcb, 0xff, 0xf8, 0x5f, 0xf4, 0xe0, 0xa6, 0x0d, 0x48, 0x91, 0xb2, 0xe9, 0xca,
+	0x12, 0xbb, 0xa4, 0x94, 0xb8, 0x0f, 0x6c, 0x97, 0xbb, 0x43, 0x6a, 0xea, 0xe5, 0x0e, 0xbb, 0xbb,
+	0x94, 0xad, 0xa0, 0xa7, 0x20, 0x87, 0xc0, 0x87, 0x36, 0x68, 0x7a, 0xe8, 0xc5, 0x80, 0x8b, 0x5c,
+	0x5a, 0xf4, 0x92, 0x83, 0xd1, 0x16, 0x28, 0x7a, 0xe8, 0x2d, 0x4d, 0x2f, 0x81, 0x0b, 0xb4, 0x45,
+	0x0f, 0x6e, 0x91, 0x1c, 0x12, 0x14, 0x3d, 0x14, 0xbd, 0x14, 0xed, 0xa9, 0x98, 0xc7, 0x3e, 0xf8,
+	0xd2, 0x23, 0x61, 0x80, 0x00, 0xb9, 0x48, 0x9c, 0x99, 0x6f, 0x7e, 0xf3, 0xbd, 0xe6, 0x9b, 0xf9,
+	0xbe, 0x59, 0x78, 0xca, 0xa0, 0x6e, 0x9b, 0xba, 0x97, 0x5d, 0x4f, 0xbf, 0x4d, 0xec, 0xd6, 0xe5,
+	0xdd, 0xe7, 0x1b, 0xd8, 0xd3, 0x9f, 0xf7, 0xdb, 0x2b, 0x1d, 0x87, 0x7a, 0x14, 0x9d, 0x10, 0x54,
+	0x2b, 0x7e, 0xaf, 0xa4, 0x5a, 0x98, 0x6f, 0xd1, 0x16, 0xe5, 0x24, 0x97, 0xd9, 0x2f, 0x41, 0xbd,
+	0x70, 0xaa, 0x45, 0x69, 0xcb, 0xc2, 0x97, 0x79, 0xab, 0xd1, 0x6d, 0x5e, 0xd6, 0xed, 0x3d, 0x39,
+	0xb4, 0xd8, 0x3f, 0x64, 0x76, 0x1d, 0xdd, 0x23, 0xd4, 0x96, 0xe3, 0x4b, 0xfd, 0xe3, 0x1e, 0x69,
+	0x63, 0xd7, 0xd3, 0xdb, 0x1d, 0x1f, 0x5b, 0x70, 0xa2, 0x89, 0x45, 0x25, 0x5b, 0x12, 0x5b, 0x8a,
+	0xd2, 0xd0, 0x5d, 0x1c, 0xc8, 0x61, 0x50, 0xe2, 0x63, 0xcf, 0xe9, 0x6d, 0x62, 0xd3, 0xcb, 0xfc,
+	0xaf, 0xec, 0x3a, 0xe3, 0x61, 0xdb, 0xc4, 0x4e, 0x9b, 0xd8, 0xde, 0x65, 0x6f, 0xaf, 0x83, 0x5d,
+	0xf1, 0x57, 0x8e, 0x9e, 0x8e, 0x8c, 0xea, 0x0d, 0x83, 0x44, 0x07, 0xf3, 0x6f, 0x2a, 0x90, 0xb9,
+	0x4e, 0x5c, 0x8f, 0x3a, 0xc4, 0xd0, 0xad, 0x8a, 0xdd, 0xa4, 0xe8, 0x4b, 0x90, 0xdc, 0xc1, 0xba,
+	0x89, 0x9d, 0x9c, 0xb2, 0xac, 0x5c, 0x98, 0xbe, 0x92, 0x5b, 0x09, 0x01, 0x56, 0xc4, 0xdc, 0xeb,
+	0x7c, 0xbc, 0x98, 0x7a, 0xe7, 0xf1, 0xd2, 0xc4, 0x4f, 0x3f, 0x7c, 0xfb, 0xa2, 0xa2, 0xca, 0x29,
+	0xa8, 0x04, 0xc9, 0x5d, 0xdd, 0x72, 0xb1, 0x97, 0x8b, 0x2d, 0xc7, 0x2f, 0x4c, 0x5f, 0x39, 0xb7,
+	0x32, 0x5c, 0xe7, 0x2b, 0xdb, 0xba, 0x45, 0x4c, 0xdd, 0xa3, 0xbd, 0x28, 0x62, 0x6e, 0xfe, 0x97,
+	0x31, 0x98, 0x5d, 0xa5, 0xed, 0x36, 0x71, 0x5d, 0x42, 0x6d, 0x55, 0xf7, 0xb0, 0x8b, 0xb6, 0x20,
+	0xe1, 0xe8, 0x1e, 0xe6, 0x4c, 0xa5, 0x8a, 0x05, 0x36, 0xe9, 0x2f, 0x8f, 0x97, 0x9e, 0x6e, 0x11,
+	0x6f, 0xa7, 0xdb, 0x58, 0x31, 0x68, 0x5b, 0xaa, 0x51, 0xfe, 0xbb, 0xe4, 0x9a, 0xb7, 0xa5, 0xa4,
+	0x25, 0x6c, 0x3c, 0x7a, 0x78, 0x09, 0x24, 0x23, 0x25, 0x6c, 0x88, 0xc5, 0x38, 0x1c, 0xfa, 0x26,
+	0x4c, 0xb5, 0xf5, 0xbb, 0x1a, 0x87, 0x8e, 0x8d, 0x0b, 0x7a, 0xb2, 0xad, 0xdf, 0x65, 0x5c, 0x23,
+	0x02, 0xb3, 0x0c, 0xdd, 0xd8, 0xd1, 0xed, 0x16, 0x16, 0x8b, 0xc4, 0xc7, 0xb5, 0x48, 0xba, 0xad,
+	0xdf, 0x5d, 0xe5, 0xc0, 0x6c, 0xa9, 0xab, 0x89, 0x8f, 0x1e, 0x2c, 0x29, 0xf9, 0xdf, 0x2a, 0x00,
+	0xa1, 0xe6, 0x90, 0x0e, 0x59, 0x23, 0x68, 0xf1, 0xf5, 0x5d, 0x69, 0xd5, 0x67, 0x46, 0x19, 0xa6,
+	0x4f, 0xef, 0xc5, 0x34, 0xe3, 0xf4, 0xbd, 0xc7, 0x4b, 0x8a, 0x58, 0x75, 0xd6, 0xe8, 0xb3, 0xcb,
+	0x0d, 0x98, 0xee, 0x76, 0x4c, 0xdd, 0xc3, 0x1a, 0x73, 0x72, 0xae, 0xc3, 0xe9, 0x2b, 0x0b, 0x2b,
+	0x62, 0x07, 0xac, 0xf8, 0x3b, 0x60, 0xa5, 0xee, 0xef, 0x00, 0x01, 0xf8, 0xc6, 0x5f, 0x7d, 0x40,
+	0x10, 0xb3, 0xd9, 0xb8, 0x94, 0xe1, 0x1f, 0x0a, 0x4c, 0x97, 0xb0, 0x6b, 0x38, 0xa4, 0xc3, 0xf6,
+	0x14, 0xca, 0xc1, 0x64, 0x9b, 0xda, 0xe4, 0xb6, 0xf4, 0xc8, 0x94, 0xea, 0x37, 0xd1, 0x02, 0x4c,
+	0x11, 0x13, 0xdb, 0x1e, 0xf1, 0xf6, 0x84, 0xf1, 0xd4, 0xa0, 0xcd, 0x66, 0xdd, 0xc1, 0x0d, 0x97,
+	0xf8, 0x2a, 0x57, 0xfd, 0x26, 0x7a, 0x16, 0xb2, 0x2e, 0x36, 0xba, 0x0e, 0xf1, 0xf6, 0x34, 0x83,
+	0xda, 0x9e, 0x6e, 0x78, 0xb9, 0x04, 0x27, 0x99, 0xf5, 0xfb, 0x57, 0x45, 0x37, 0x03, 0x31, 0xb1,
+	0xa7, 0x13, 0xcb, 0xcd, 0x1d, 0x13, 0x20, 0xb2, 0x89, 0x8a, 0x30, 0xd9, 0x71, 0x68, 0x93, 0x58,
+	0x38, 0x97, 0xe4, 0x22, 0x5f, 0x38, 0xd0, 0xd3, 0xab, 0x82, 0x5e, 0xf5, 0x27, 0x4a, 0x71, 0x7f,
+	0xa5, 0x40, 0xb6, 0x9f, 0x06, 0x9d, 0x82, 0x29, 0x8b, 0xb6, 0xa8, 0xd6, 0x75, 0x88, 0x2f, 0x34,
+	0x6b, 0x6f, 0x39, 0x04, 0x3d, 0x03, 0x01, 0x9b, 0x5a, 0x87, 0x5a, 0xc4, 0xf0, 0x65, 0xcf, 0xf8,
+	0xdd, 0x55, 0xde, 0x8b, 0xce, 0x02, 0xb4, 0xf1, 0xae, 0x4f, 0x23, 0x94, 0x90, 0x6a, 0xe3, 0x5d,
+	0x39, 0x7c, 0x02, 0x92, 0x0e, 0x6e, 0x11, 0x6a, 0x4b, 0xe1, 0x65, 0x0b, 0x3d, 0x09, 0x69, 0xa9,
+	0x29, 0x16, 0x9e, 0x68, 0x53, 0x4a, 0x3e, 0x23, 0x3b, 0xab, 0xac, 0x4f, 0xb2, 0xfe, 0x5f, 0x05,
+	0x90, 0xe4, 0xb8, 0xe0, 0x79, 0xcc, 0xbe, 0xdc, 0x60, 0x1b, 0x30, 0xb7, 0xeb, 0x0b, 0xa4, 0xe9,
+	0xa6, 0xe9, 0x60, 0xd7, 0x95, 0xfb, 0xf6, 0xdc, 0xa3, 0x87, 0x97, 0xce, 0x4a, 0x45, 0x05, 0x42,
+	0x17, 0x04, 0x49, 0xcd, 0x73, 0x88, 0xdd, 0x52, 0xb3, 0xbb, 0x7d, 0xfd, 0x68, 0x1e, 0x8e, 0x35,
+	0x09, 0xb6, 0x4c, 0x29, 0xa7, 0x68, 0xa0, 0x2f, 0xc0, 0x94, 0xce, 0x17, 0xc5, 0x8e, 0xdc, 0x54,
+	0xb9, 0x47, 0x0f, 0x2f, 0xcd, 0x4b, 0xf0, 0x5e, 0xcc, 0x80, 0x92, 0x61, 0xed, 0xea, 0x56, 0x17,
+	0x4b, 0xa1, 0x45, 0x83, 0x39, 0xd2, 0x2e, 0x76, 0x48, 0x93, 0x60, 0x93, 0x8b, 0x3b, 0xa5, 0x06,
+	0x6d, 0xa6, 0xa7, 0x1d, 0x4c, 0x5a, 0x3b, 0x1e, 0x37, 0x74, 0x5c, 0x95, 0xad, 0xfc, 0x9f, 0x52,
+	0x90, 0x0a, 0x44, 0x40, 0xab, 0x90, 0xa5, 0x1d, 0xec, 0x0c, 0x11, 0x79, 0x34, 0x57, 0xb3, 0xfe,
+	0x0c, 0x5f, 0xd0, 0x5b, 0x6c, 0xbb, 0xda, 0x2e, 0xb6, 0xdd, 0xae, 0xab, 0x75, 0xba, 0x8d, 0xdb,
+	0x78, 0x4f, 0x6e, 0xa8, 0xf9, 0x81, 0x0d, 0x55, 0xb0, 0xf7, 0x8a, 0xb9, 0x77, 0x43, 0x68, 0xc3,
+	0xd9, 0xeb, 0x78, 0x74, 0xa5, 0xda, 0x6d, 0x7c, 0x15, 0xef, 0xa9, 0xb3, 0x01, 0x4e, 0x95, 0xc3,
+	0x30, 0x29, 0xbe, 0xa3, 0x13, 0x0b, 0x9b, 0x5c, 0x57, 0x53, 0xaa, 0x6c, 0xa1, 0xab, 0x90, 0x64,
+	0x66, 0xeb, 0xba, 0x5c, 0x21, 0x99, 0x2b, 0xf9, 0x51, 0x6e, 0x5c, 0xa4, 0xb6, 0x59, 0xe3, 0x94,
+	0xaa, 0x9c, 0x81, 0xea, 0x90, 0xf4, 0xe8, 0x6d, 0x6c, 0xcb, 0xcd, 0x51, 0x7c, 0xe1, 0x08, 0x41,
+	0xad, 0x62, 0x7b, 0x91, 0xa0, 0x56, 0xb1, 0x3d, 0x55, 0x62, 0xa1, 0x16, 0x64, 0x4d, 0x6c, 0xe1,
+	0x16, 0x57, 0xa5, 0xbb, 0xa3, 0x3b, 0xd8, 0xcd, 0x25, 0x8f, 0x8c, 0x3f, 0x10, 0x34, 0xd5, 0xd9,
+	0x00, 0xb5, 0xc6, 0x41, 0x51, 0x15, 0xa6, 0xcd, 0x30, 0xcc, 0xe4, 0x26, 0xb9, 0xa2, 0x9f, 0x1c,
+	0x25, 0x7f, 0x24, 0x22, 0x45, 0x8f, 0xac, 0x28, 0x04, 0x8b, 0x2c, 0x5d, 0xbb, 0x41, 0x6d, 0x93,
+	0xd8, 0x2d, 0x4d, 0x3a, 0xcd, 0x14, 0x77, 0x9a, 0xd9, 0xa0, 0xff, 0x3a, 0xef, 0x46, 0x55, 0xc8,
+	0x84, 0xa4, 0x3c, 0x72, 0xa6, 0x8e, 0x1a, 0x39, 0xd3, 0x01, 0x00, 0x23, 0x41, 0x37, 0x01, 0xc2,
+	0xd8, 0x9c, 0x03, 0x8e, 0x96, 0x3f, 0x38, 0xca, 0x47, 0x85, 0x89, 0x00, 0x20, 0x0b, 0x8e, 0xb7,
+	0x89, 0xad, 0xb9, 0xd8, 0x6a, 0x6a, 0x52, 0x73, 0x0c, 0x77, 0x7a, 0x0c, 0x96, 0x9e, 0x6b, 0x13,
+	0xbb, 0x86, 0xad, 0x66, 0x29, 0x80, 0x45, 0x2f, 0xc0, 0xe9, 0x50, 0x1d, 0xd4, 0xd6, 0x76, 0xa8,
+	0x65, 0x6a, 0x0e, 0x6e, 0x6a, 0x06, 0xed, 0xda, 0x5e, 0x6e, 0x86, 0x2b, 0xf1, 0x64, 0x40, 0xb2,
+	0x69, 0x5f, 0xa7, 0x96, 0xa9, 0xe2, 0xe6, 0x2a, 0x1b, 0x66, 0x21, 0x2b, 0x9c, 0x4d, 0x4c, 0x37,
+	0x97, 0x5e, 0x8e, 0x5f, 0x48, 0xa8, 0x33, 0x41, 0x67, 0xc5, 0x74, 0x51, 0x07, 0x9e, 0x08, 0xa3,
+	0x12, 0xeb, 0xf7, 0x9d, 0x2b, 0x33, 0x06, 0xe7, 0x3a, 0x1e, 0x40, 0xf3, 0x7d, 0x22, 0x1c, 0x4c,
+	0x87, 0xb4, 0x45, 0xbe, 0xdb, 0x25, 0xc1, 0x4a, 0xb3, 0x63, 0x58, 0x69, 0x46, 0x40, 0xca, 0x25,
+	0x2a, 0x90, 0xd4, 0x5d, 0x17, 0x7b, 0x6e, 0x2e, 0xcb, 0xef, 0x5b, 0x4f, 0x1f, 0x78, 0x0a, 0x15,
+	0x18, 0x79, 0xcf, 0xa5, 0x4b, 0x00, 0x5c, 0x9d, 0x7a, 0xfd, 0xc1, 0xd2, 0xc4, 0x47, 0x0f, 0x96,
+	0x26, 0xf2, 0x8f, 0x14, 0xc8, 0xf4, 0xd2, 0xb3, 0xb0, 0x69, 0x62, 0x9b, 0xb6, 0xe5, 0x61, 0x24,
+	0x1a, 0x2c, 0x00, 0xe8, 0x6d, 0x6e, 0xa0, 0xd8, 0x38, 0x02, 0x80, 0xc0, 0x8a, 0x84, 0x95, 0xf8,
+	0xf8, 0xc2, 0x4a, 0x7e, 0x0d, 0x66, 0xb6, 0x75, 0x4b, 0x46, 0x5a, 0xec, 0xa2, 0x2f, 0x42, 0x4a,
+	0xf7, 0x1b, 0x39, 0x65, 0x39, 0xbe, 0x6f, 0xa4, 0x0e, 0x49, 0xf3, 0x0f, 0x14, 0x48, 0x96, 0xb6,
+	0xab, 0x3a, 0x71, 0x50, 0x19, 0xe6, 0xc2, 0x48, 0x75, 0xd8, 0xa0, 0x1f, 0x06, 0x37, 0xd9, 0xcf,
+	0x60, 0x06, 0x8f, 0xcb, 0xd8, 0x41, 0x30, 0xfd, 0xa7, 0x64, 0xc4, 0x7e, 0x37, 0x60, 0x52, 0x70,
+	0xe8, 0xa2, 0x17, 0xe1, 0x58, 0x87, 0xfd, 0xe0, 0x12, 0x4e, 0x5f, 0x59, 0x1c, 0x19, 0xdd, 0x38,
+	0x7d, 0xd4, 0x2d, 0xc4, 0xbc, 0xfc, 0x7f, 0x14, 0x80, 0xd2, 0xf6, 0x76, 0xdd, 0x21, 0x1d, 0x0b,
+	0x7b, 0xe3, 0x12, 0x79, 0x3d, 0xba, 0x17, 0x5d, 0xc7, 0x38, 0xb4, 0xd8, 0xe1, 0x3e, 0xab, 0x39,
+	0xc6, 0x50, 0x34, 0xd3, 0xf5, 0x02, 0xb4, 0xf8, 0xa1, 0xd1, 0x4a, 0xae, 0x37, 0xa8, 0xc7, 0x97,
+	0x61, 0x3a, 0x14, 0x9d, 0xed, 0xb5, 0x29, 0x4f, 0xfe, 0x96, 0xea, 0xcc, 0x8f, 0x56, 0xa7, 0x3f,
+	0x2d, 0xaa, 0xd2, 0x60, 0x3a, 0x4b, 0x70, 0x20, 0x12, 0xfd, 0x3e, 0x53, 0x8e, 0xc4, 0xf6, 0x9f,
+	0x8c, 0x57, 0xf1, 0x31, 0xc4, 0x2b, 0x89, 0x85, 0xce, 0x43, 0xa6, 0x37, 0xfc, 0xf2, 0x0b, 0xc7,
+	0x94, 0x9a, 0xee, 0x89, 0x9c, 0x61, 0xa0, 0x39, 0x16, 0x09, 0x34, 0x11, 0x9b, 0xbc, 0x16, 0x83,
+	0xe3, 0x5b, 0x7e, 0x58, 0xff, 0xcc, 0xaa, 0x70, 0x0b, 0x26, 0xb1, 0xed, 0x39, 0x84, 0xeb, 0x90,
+	0x79, 0xca, 0xff, 0x8d, 0xf2, 0x94, 0x21, 0xb2, 0x94, 0x6d, 0xcf, 0xd9, 0x8b, 0xfa, 0x8d, 0x8f,
+	0x15, 0x51, 0xc3, 0x1f, 0xe2, 0x90, 0x1b, 0x35, 0x95, 0x65, 0x08, 0x86, 0x83, 0x79, 0x87, 0x7f,
+	0x0b, 0x51, 0xf8, 0x01, 0x9a, 0xf1, 0xbb, 0xe5, 0x25, 0x44, 0x05, 0x96, 0xce, 0x31, 0x97, 0x64,
+	0xa4, 0x1f, 0x2f, 0x7f, 0xcb, 0x84, 0x08, 0x8c, 0x06, 0x61, 0x98, 0x25, 0x36, 0xf1, 0x88, 0x6e,
+	0x69, 0x0d, 0xdd, 0xd2, 0x6d, 0x03, 0x8f, 0x25, 0x8c, 0x67, 0x24, 0x68, 0x51, 0x60, 0xa2, 0x6d,
+	0x98, 0xf4, 0xe1, 0x13, 0x63, 0x80, 0xf7, 0xc1, 0xd0, 0x39, 0x98, 0x89, 0x5e, 0x25, 0xb8, 0x1b,
+	0x26, 0xd4, 0xe9, 0xc8, 0x4d, 0xe2, 0xa0, 0xbb, 0x4a, 0x72, 0xff, 0xbb, 0x4a, 0xe0, 0xe0, 0x93,
+	0x51, 0x07, 0x17, 0xf9, 0xd4, 0xaf, 0xe3, 0x30, 0xa7, 0x62, 0xf3, 0x73, 0x68, 0xce, 0x6f, 0x00,
+	0x88, 0x38, 0xc1, 0xe2, 0x77, 0x2e, 0x31, 0x86, 0xb8, 0x93, 0x12, 0x78, 0x25, 0xd7, 0xfb, 0xd4,
+	0x6d, 0x2a, 0xad, 0xf7, 0xfb, 0x18, 0xcc, 0x44, 0xad, 0xf7, 0x39, 0x38, 0x2c, 0xd1, 0x46, 0x18,
+	0xe8, 0x12, 0x3c, 0xd0, 0x3d, 0x3b, 0x2a, 0xd0, 0x0d, 0xf8, 0xf5, 0x01, 0x11, 0xee, 0xdf, 0x19,
+	0x48, 0x56, 0x75, 0x47, 0x6f, 0xbb, 0x68, 0x73, 0x20, 0x57, 0x12, 0x35, 0xac, 0x53, 0x03, 0x6e,
+	0x5d, 0x92, 0x75, 0x58, 0xe1, 0xd5, 0x3f, 0x1e, 0x95, 0x2a, 0x9d, 0x87, 0x0c, 0x2b, 0xcb, 0x05,
+	0x02, 0x09, 0x55, 0xa6, 0x79, 0x49, 0x2d, 0xb8, 0xf8, 0xba, 0x68, 0x09, 0xa6, 0x19, 0x59, 0x18,
+	0xc9, 0x19, 0x0d, 0xb4, 0xf5, 0xbb, 0x65, 0xd1, 0x83, 0x2e, 0x01, 0xda, 0x09, 0x8a, 0xa7, 0x5a,
+	0xa8, 0x08, 0x46, 0x37, 0x17, 0x8e, 0xf8, 0xe4, 0x67, 0x01, 0x78, 0xde, 0x11, 0x3d, 0xe0, 0x52,
+	0xac, 0xa7, 0xc4, 0x3a, 0xd0, 0x0f, 0x14, 0x91, 0x72, 0xf5, 0x55, 0xec, 0x64, 0xf2, 0xab, 0x1d,
+	0x6d, 0x37, 0xfc, 0xeb, 0xf1, 0xd2, 0xc2, 0x9e, 0xde, 0xb6, 0xae, 0xe6, 0x87, 0x40, 0xe6, 0x87,
+	0xd5, 0x13, 0x59, 0x56, 0xd6, 0x5b, 0xfc, 0x43, 0xdd, 0x81, 0x94, 0xa9, 0xa9, 0x1b, 0x1e, 0x75,
+	0x72, 0x93, 0xe3, 0x2a, 0x62, 0xf6, 0xe6, 0x4d, 0x6b, 0x1c, 0x1d, 0x7d, 0x0f, 0x4e, 0xb5, 0x2c,
+	0xda, 0xd0, 0x2d, 0xcd, 0x4f, 0x9f, 0x84, 0x2f, 0x69, 0x86, 0xde, 0xc9, 0x4d, 0x8d, 0x6b, 0xe9,
+	0x13, 0x62, 0x8d, 0x75, 0x91, 0x4e, 0x89, 0x15, 0x56, 0xf5, 0x0e, 0x7a, 0x55, 0x81, 0x33, 0xa1,
+	0xd4, 0x43, 0x38, 0x48, 0x8d, 0x8b, 0x83, 0x53, 0xc1, 0x32, 0x03, 0x4c, 0x50, 0x58, 0x8a, 0xd8,
+	0x4c, 0xd6, 0x8f, 0x6d, 0xea, 0x11, 0x03, 0x6b, 0x1d, 0xec, 0x10, 0x6a, 0xe6, 0xe0, 0x88, 0x7b,
+	0xe0, 0x4c, 0x08, 0x28, 0xca, 0xc6, 0x1b, 0x1c, 0xae, 0xca, 0xd1, 0xd0, 0xb7, 0x01, 0x85, 0xbe,
+	0xa9, 0xdd, 0xe1, 0x07, 0x8a, 0x9b, 0x9b, 0x5e, 0x8e, 0xef, 0x57, 0x2b, 0x2e, 0xfa, 0xbe, 0xfb,
+	0x12, 0xa7, 0x8f, 0xee, 0xe8, 0x6c, 0xa3, 0x77, 0xcc, 0x45, 0x25, 0x58, 0x62, 0xbb, 0xa9, 0xad,
+	0x7b, 0x5d, 0x07, 0x6b, 0xc1, 0x86, 0x74, 0x99, 0x3c, 0x5a, 0xc3, 0xa2, 0xc6, 0x6d, 0x9e, 0xe6,
+	0xa7, 0xd5, 0xd3, 0x6d, 0xfd, 0xee, 0x4d, 0x4e, 0x15, 0xdc, 0x70, 0xdc, 0x2a, 0x76, 0x8a, 0x8c,
+	0x84, 0xf9, 0xc6, 0x90, 0xb2, 0x84, 0xd6, 0xb4, 0x28, 0x75, 0x72, 0xe9, 0x23, 0x5b, 0x66, 0xe0,
+	0x64, 0x92, 0xbe, 0x31, 0x50, 0xa1, 0x58, 0x63, 0x0b, 0xb0, 0x93, 0x04, 0x77, 0xa8, 0xb1, 0xa3,
+	0x59, 0xd8, 0x6e, 0x79, 0x3b, 0xbc, 0x74, 0x90, 0x50, 0xa7, 0x79, 0xdf, 0x3a, 0xef, 0x42, 0x55,
+	0x98, 0x61, 0x8c, 0x76, 0x3b, 0x9a, 0x87, 0x9d, 0x36, 0xcb, 0xf9, 0xf7, 0xcd, 0x14, 0xd6, 0x39,
+	0x6d, 0x1d, 0x3b, 0xed, 0x9e, 0xaa, 0x92, 0x15, 0x74, 0xbb, 0xe8, 0x35, 0x05, 0xce, 0x12, 0xdb,
+	0xf5, 0x74, 0xdb, 0xd3, 0xba, 0x76, 0x54, 0x68, 0x2c, 0xdf, 0x14, 0xb2, 0xe3, 0xf2, 0xc8, 0x05,
+	0xb9, 0xce, 0x56, 0x64, 0x99, 0x35, 0xcc, 0x1f, 0x18, 0xd0, 0x9b, 0x0a, 0x9c, 0x1f, 0xca, 0x46,
+	0xa3, 0x6b, 0xb6, 0xb0, 0x17, 0x31, 0xe3, 0xdc, 0xb8, 0xcc, 0x70, 0x6e, 0x08, 0x3b, 0x45, 0xbe,
+	0x5a, 0xe0, 0x0f, 0xb7, 0xe0, 0xe2, 0x48, 0xdd, 0x78, 0x94, 0x07, 0xbe, 0xae, 0x2d, 0x4a, 0xe5,
+	0xd4, 0xca, 0x21, 0x9e, 0x72, 0x9c, 0x1f, 0x2e, 0x65, 0x9d, 0xae, 0xfa, 0xd4, 0x55, 0x4a, 0x2d,
+	0x74, 0x0b, 0x66, 0xa3, 0xc7, 0x0e, 0x76, 0xdc, 0xdc, 0x71, 0x6e, 0xcc, 0xf3, 0x07, 0x5e, 0xe6,
+	0xeb, 0xa4, 0xf7, 0x79, 0x2c, 0xd3, 0x8d, 0x8e, 0xb8, 0x2c, 0xb0, 0x32, 0x2f, 0x8e, 0xf0, 0x2b,
+	0xeb, 0x28, 0xf3, 0xe3, 0x52, 0x1d, 0x3b, 0x49, 0x42, 0xef, 0x2d, 0x70, 0xf4, 0xab, 0x4f, 0xb1,
+	0x7b, 0xca, 0xbd, 0x0f, 0xdf, 0xbe, 0x78, 0x3a, 0x02, 0x73, 0x37, 0x78, 0x35, 0x15, 0xc7, 0x6d,
+	0xfe, 0x15, 0x48, 0xf7, 0x08, 0x82, 0x10, 0x24, 0x6c, 0x5d, 0x9e, 0xba, 0x29, 0x95, 0xff, 0x1e,
+	0x72, 0x26, 0xc7, 0x3e, 0xd1, 0x99, 0x2c, 0xef, 0x50, 0x77, 0x60, 0x2e, 0x38, 0x80, 0x55, 0xe2,
+	0xde, 0xe6, 0xeb, 0x8f, 0xfb, 0x3d, 0x01, 0x41, 0x82, 0x99, 0x53, 0x3e, 0x27, 0xf0, 0xdf, 0xf9,
+	0x9f, 0x2b, 0x30, 0x57, 0xf3, 0x74, 0xdb, 0x6c, 0xb0, 0xc7, 0x1f, 0x57, 0x94, 0xd1, 0xc7, 0xbe,
+	0xf2, 0x1a, 0x24, 0x3f, 0x51, 0x59, 0x5f, 0xce, 0xce, 0xbf, 0xa3, 0x00, 0x84, 0x91, 0x03, 0x95,
+	0x60, 0xca, 0x7f, 0x81, 0x3e, 0xf2, 0xd5, 0x28, 0x98, 0x89, 0x6c, 0x98, 0x73, 0xf0, 0x1d, 0xdd,
+	0x31, 0xb5, 0x76, 0xd7, 0xf2, 0x48, 0xc7, 0x0a, 0x74, 0x34, 0x8e, 0xd0, 0x92, 0x15, 0xd8, 0x37,
+	0x03, 0x68, 0x69, 0xf1, 0xd7, 0x15, 0x98, 0xed, 0x3b, 0x47, 0x46, 0x54, 0x1b, 0x6f, 0x41, 0x52,
+	0x9c, 0x4b, 0xe3, 0x63, 0x4a, 0x02, 0x4a, 0x56, 0x7e, 0xa6, 0x00, 0x0a, 0xf7, 0x8c, 0x8a, 0xdd,
+	0x0e, 0xb5, 0x5d, 0x5e, 0x58, 0x8f, 0x14, 0xc0, 0x95, 0xfd, 0x0b, 0xeb, 0xe1, 0xfc, 0x9e, 0xc2,
+	0x7a, 0x08, 0x80, 0xbe, 0x12, 0x66, 0xae, 0xfe, 0x96, 0x91, 0x58, 0xec, 0xc9, 0x3f, 0x52, 0xa1,
+	0x27, 0x3d, 0x10, 0xfe, 0x24, 0xce, 0xeb, 0x44, 0xfe, 0xb1, 0x02, 0xa7, 0x06, 0xae, 0xd4, 0x01,
+	0xcb, 0x06, 0x20, 0x27, 0x32, 0xc8, 0xaf, 0xa6, 0x7b, 0x92, 0xf5, 0x8f, 0x77, 0x43, 0x9f, 0x73,
+	0xfa, 0x47, 0x3f, 0xad, 0x14, 0x5c, 0x1a, 0xe3, 0x77, 0x0a, 0xcc, 0x47, 0x39, 0x0a, 0x64, 0xab,
+	0xc1, 0x4c, 0x94, 0x17, 0x29, 0xd5, 0x53, 0x87, 0x91, 0x2a, 0x2a, 0x50, 0x0f, 0x08, 0x93, 0xc5,
+	0xbf, 0xbe, 0x8b, 0x0f, 0x17, 0x9e, 0x3f, 0xb4, 0x96, 0x7c, 0xc6, 0x86, 0xe6, 0x33, 0xc2, 0x58,
+	0xdf, 0x8f, 0x41, 0x82, 0x1f, 0x29, 0xaf, 0x2a, 0x30, 0x67, 0x53, 0x8f, 0xdf, 0xa5, 0xb1, 0xa9,
+	0xc9, 0x32, 0xb7, 0x08, 0x28, 0xdb, 0x47, 0xd3, 0xde, 0xdf, 0x1f, 0x2f, 0x0d, 0x42, 0x0d, 0x3b,
+	0x09, 0x66, 0x6d, 0xea, 0x15, 0x39, 0x51, 0x9d, 0xd3, 0xa0, 0x3b, 0x90, 0xee, 0x5d, 0x5f, 0x6c,
+	0x27, 0xf5, 0xc8, 0xeb, 0xa7, 0x0f, 0x5c, 0x7b, 0xa6, 0x11, 0x59, 0xf8, 0xea, 0x14, 0x33, 0xec,
+	0x3f, 0x99, 0x71, 0x7f, 0x14, 0x8b, 0xc4, 0xf9, 0x1b, 0x3a, 0x11, 0x5f, 0x9e, 0x8c, 0x3b, 0xda,
+	0x5e, 0x65, 0x2f, 0xdc, 0xba, 0x4b, 0xed, 0x5c, 0x6c, 0xff, 0xb7, 0x4d, 0xc6, 0x81, 0xca, 0x29,
+	0x55, 0x39, 0x23, 0xf2, 0xea, 0x1b, 0x8f, 0xbe, 0xfa, 0xa2, 0x2f, 0xb3, 0xb3, 0xa3, 0x2d, 0x3c,
+	0xfe, 0x48, 0x85, 0x15, 0x3e, 0x0d, 0x9d, 0x81, 0x94, 0x83, 0x9b, 0xd8, 0xc1, 0x6c, 0xd7, 0xc8,
+	0x0c, 0x30, 0xe8, 0xc8, 0xff, 0x31, 0x06, 0x28, 0x90, 0xae, 0x86, 0x3d, 0x71, 0x4f, 0x8f, 0xf0,
+	0xa2, 0xf4, 0xf0, 0xb2, 0x3e, 0xe4, 0xcd, 0x39, 0x76, 0x58, 0x75, 0x1d, 0xea, 0xf1, 0x39, 0x3e,
+	0x9e, 0xc7, 0xe7, 0x22, 0x24, 0x98, 0xdf, 0xc8, 0x27, 0xe6, 0x95, 0x03, 0xdf, 0xa8, 0x02, 0xd1,
+	0xeb, 0x7b, 0x1d, 0xac, 0xf2, 0xb9, 0x2c, 0x67, 0xef, 0x38, 0x78, 0x97, 0x50, 0xc6, 0x1d, 0xbd,
+	0x83, 0x1d, 0xae, 0xbe, 0xb8, 0x9a, 0xf6, 0x7b, 0xab, 0xac, 0x93, 0x1d, 0x1d, 0x62, 0x54, 0x94,
+	0x6c, 0x44, 0x23, 0x7f, 0x2b, 0xf2, 0x89, 0xc5, 0x16, 0xff, 0xde, 0x84, 0x95, 0x7a, 0x27, 0xc5,
+	0xa7, 0x27, 0x7e, 0x35, 0x7f, 0x39, 0xfa, 0xa1, 0x13, 0xfb, 0x52, 0x6a, 0xa5, 0x6f, 0x4e, 0xcf,
+	0x0e, 0x97, 0x73, 0xf3, 0xbf, 0x51, 0xe0, 0x38, 0xf7, 0x6f, 0xf2, 0x0a, 0xe6, 0x8f, 0x72, 0x2a,
+	0x36, 0xa8, 0x63, 0xa2, 0x0c, 0xc4, 0x88, 0xc9, 0x0d, 0x96, 0x50, 0x63, 0xc4, 0x44, 0x2b, 0x70,
+	0x8c, 0xde, 0xb1, 0x83, 0x13, 0x75, 0x74, 0x9d, 0x45, 0x90, 0x31, 0x79, 0xdb, 0xd4, 0xec, 0x5a,
+	0x58, 0xd3, 0x0d, 0x51, 0x84, 0x12, 0x5f, 0x70, 0xa4, 0x45, 0x6f, 0x41, 0x74, 0xa2, 0x17, 0x21,
+	0x15, 0xf8, 0x7d, 0x2e, 0x71, 0x58, 0xe3, 0x87, 0x73, 0xf2, 0x3f, 0x8c, 0xc1, 0xc9, 0x2a, 0xe6,
+	0xf7, 0xb0, 0xd5, 0xbe, 0x04, 0x71, 0xec, 0x1b, 0xd2, 0xff, 0x86, 0x2b, 0x36, 0xde, 0x6f, 0xb8,
+	0xaa, 0x90, 0xc1, 0xcd, 0x26, 0x36, 0x3c, 0xb2, 0x2b, 0xbf, 0x42, 0x8a, 0x1f, 0xf9, 0x2d, 0x3d,
+	0x00, 0x60, 0x24, 0x79, 0x0c, 0x99, 0xaf, 0x75, 0x71, 0x17, 0x9b, 0x65, 0x96, 0xd9, 0xdd, 0x74,
+	0x5b, 0x03, 0xe6, 0x5c, 0x85, 0x78, 0xdb, 0x6d, 0xed, 0x7b, 0x8d, 0x3b, 0xfd, 0xee, 0xc3, 0x4b,
+	0x27, 0x87, 0x1d, 0xed, 0x37, 0xdd, 0x96, 0xca, 0x66, 0xe7, 0xdf, 0x8a, 0x43, 0x26, 0xbc, 0x30,
+	0xb0, 0x0b, 0xdd, 0xb8, 0x6a, 0x86, 0x1b, 0xa3, 0xdf, 0x31, 0x3e, 0x96, 0xe5, 0xa2, 0x37, 0xcc,
+	0xf8, 0x78, 0x6f, 0x98, 0x89, 0x4f, 0xed, 0x86, 0xc9, 0xb8, 0xc6, 0xb6, 0x29, 0x5c, 0xe2, 0xd8,
+	0x51, 0x5d, 0x62, 0x12, 0xdb, 0x26, 0x1b, 0xbc, 0xf8, 0x0b, 0x05, 0x20, 0xfc, 0xfa, 0x05, 0x3d,
+	0x07, 0x27, 0x8b, 0x9b, 0x1b, 0x25, 0xad, 0x56, 0x2f, 0xd4, 0xb7, 0x6a, 0xda, 0xd6, 0x46, 0xad,
+	0x5a, 0x5e, 0xad, 0xac, 0x55, 0xca, 0xa5, 0xec, 0xc4, 0xc2, 0xec, 0xbd, 0xfb, 0xcb, 0xd3, 0x5b,
+	0xb6, 0xdb, 0xc1, 0x86, 0xf8, 0x7a, 0xe8, 0x69, 0x98, 0xef, 0xa5, 0x66, 0xad, 0x72, 0x29, 0xab,
+	0x2c, 0xcc, 0xdc, 0xbb, 0xbf, 0x3c, 0x25, 0x12, 0x2d, 0x6c, 0xa2, 0x0b, 0xf0, 0xc4, 0x20, 0x5d,
+	0x65, 0xe3, 0x5a, 0x36, 0xb6, 0x90, 0xbe, 0x77, 0x7f, 0x39, 0x15, 0x64, 0x64, 0x28, 0x0f, 0x28,
+	0x4a, 0x29, 0xf1, 0xe2, 0x0b, 0x70, 0xef, 0xfe, 0x72, 0x52, 0x1c, 0xf4, 0x0b, 0x89, 0xd7, 0xdf,
+	0x5a, 0x9c, 0xb8, 0xf8, 0x2d, 0x80, 0x8a, 0xdd, 0x74, 0x74, 0x83, 0x2b, 0x7f, 0x01, 0x4e, 0x54,
+	0x36, 0xd6, 0xd4, 0xc2, 0x6a, 0xbd, 0xb2, 0xb9, 0xd1, 0xcb, 0x76, 0xdf, 0x58, 0x69, 0x73, 0xab,
+	0xb8, 0x5e, 0xd6, 0x6a, 0x95, 0x6b, 0x1b, 0x59, 0x05, 0x9d, 0x84, 0xe3, 0x3d, 0x63, 0x2f, 0x6d,
+	0xd4, 0x2b, 0x37, 0xcb, 0xd9, 0xd8, 0xc5, 0x9f, 0x28, 0x00, 0xe1, 0xc9, 0x89, 0x4e, 0xc3, 0xc9,
+	0x1b, 0x85, 0xca, 0xba, 0xa6, 0x96, 0x0b, 0xb5, 0x81, 0x05, 0x72, 0x30, 0x1f, 0x1d, 0x0c, 0x50,
+	0x94, 0xfe, 0x69, 0xd1, 0xb5, 0x63, 0xe8, 0x49, 0x58, 0x8a, 0x0e, 0xd6, 0xca, 0xeb, 0x6b, 0x9a,
+	0x10, 0xfe, 0xfa, 0xa6, 0x5a, 0x5f, 0x2b, 0xac, 0xaf, 0x67, 0xe3, 0x8c, 0xf9, 0x28, 0xd1, 0xb5,
+	0xcd, 0xed, 0xb2, 0xba, 0x51, 0xd8, 0x58, 0x2d, 0x67, 0x13, 0x17, 0xdf, 0x56, 0xe0, 0xc4, 0xf0,
+	0x63, 0x05, 0x3d, 0x0b, 0xe7, 0xb7, 0x0b, 0xeb, 0x95, 0x52, 0xa1, 0xbe, 0xa9, 0x6a, 0xb5, 0x72,
+	0x5d, 0x5b, 0xbd, 0x5e, 0xd8, 0xb8, 0x56, 0xd6, 0xea, 0xb7, 0xaa, 0xe5, 0x3e, 0xee, 0xf3, 0xb0,
+	0x38, 0x9a, 0xf4, 0xc6, 0x66, 0x85, 0xa9, 0x69, 0x5f, 0x9a, 0xf2, 0xcb, 0x95, 0xba, 0x10, 0x67,
+	0x34, 0x4d, 0x75, 0xf3, 0xa5, 0xb2, 0x9a, 0x8d, 0x17, 0xd7, 0xde, 0x79, 0x7f, 0x51, 0x79, 0xef,
+	0xfd, 0x45, 0xe5, 0x6f, 0xef, 0x2f, 0x2a, 0x6f, 0x7c, 0xb0, 0x38, 0xf1, 0xde, 0x07, 0x8b, 0x13,
+	0x7f, 0xfe, 0x60, 0x71, 0xe2, 0xeb, 0xcf, 0xed, 0xbb, 0x37, 0xc2, 0x3c, 0x9e, 0xef, 0x92, 0x46,
+	0x92, 0xbb, 0xf8, 0xff, 0xff, 0x6f, 0x00, 0x59, 0x9d, 0x04, 0x96, 0x1c, 0x2d, 0x00, 0x00,
+}
+
+func (this *Pool) Description() (desc *github_com_cosmos_gogoproto_protoc_gen_gogo_descriptor.FileDescriptorSet) {
+	return StakingDescription()
+}
+func StakingDescription() (desc *github_com_cosmos_gogoproto_protoc_gen_gogo_descriptor.FileDescriptorSet) {
+	d := &github_com_cosmos_gogoproto_protoc_gen_gogo_descriptor.FileDescriptorSet{}
+	var gzipped = []byte{
+		// 16630 bytes of a gzipped FileDescriptorSet
+		0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe4, 0xbd, 0x6b, 0x98, 0x24, 0xd9,
+		0x55, 0x18, 0xd8, 0x91, 0x99, 0x95, 0x95, 0x79, 0xea, 0x95, 0x75, 0xab, 0xba, 0x3b, 0x3b, 0xfb,
+		0x51, 0x35, 0x31, 0xaf, 0x9e, 0xee, 0xe9, 0xaa, 0x99, 0x9e, 0xe9, 0xee, 0x99, 0xea, 0x79, 0x28,
+		0xab, 0x2a, 0xbb, 0xbb, 0x7a, 0xea, 0xa5, 0xa8, 0xaa, 0x9e, 0x07, 0x92, 0x52, 0x51, 0x99, 0x51,
+		0x59, 0xa9, 0xce, 0x8c, 0xc8, 0xc9, 0x88, 0xec, 0xc7, 0x08, 0x79, 0x65, 0x09, 0xb4, 0x62, 0x96,
+		0x05, 0x6c, 0x61, 0x1b, 0x03, 0x23, 0x4b, 0x60, 0x23, 0xc0, 0x60, 0xcb, 0x46, 0x06, 0xb1, 0x60,
+		0x3e, 0xb3, 0x9f, 0xf1, 0x82, 0xcc, 0xc7, 0x87, 0x61, 0xc1, 0x2c, 0xdf, 0x7a, 0x00, 0x89, 0x6f,
+		0xa5, 0x05, 0xb1, 0x06, 0x56, 0x3c, 0xbc, 0xda, 0xf5, 0xee, 0x77, 0xee, 0x23, 0xde, 0x91, 0x91,
+		0x59, 0x9d, 0xad, 0x1d, 0xe0, 0x4f, 0x55, 0xc6, 0x8d, 0x73, 0xce, 0xbd, 0xf7, 0xdc, 0x73, 0xcf,
+		0x3d, 0xe7, 0xdc, 0x73, 0x6f, 0xc0, 0xef, 0x2d, 0xc2, 0x6c, 0xcd, 0x30, 0x6a, 0x0d, 0x6d, 0xbe,
+		0xd5, 0x36, 0x2c, 0x63, 0xb7, 0xb3, 0x37, 0x5f, 0xd5, 0xcc, 0x4a, 0xbb, 0xde, 0xb2, 0x8c, 0xf6,
+		0x1c, 0x2d, 0x23, 0x13, 0x0c, 0x62, 0x4e, 0x40, 0xc8, 0x6b, 0x30, 0x79, 0xa5, 0xde, 0xd0, 0x96,
+		0x6d, 0xc0, 0x2d, 0xcd, 0x22, 0xcf, 0x40, 0x6a, 0xaf, 0xde, 0xd0, 0xf2, 0xd2, 0x6c, 0xf2, 0xf4,
+		0xc8, 0xf9, 0x87, 0xe6, 0x7c, 0x48, 0x73, 0x5e, 0x8c, 0x4d, 0x2c, 0x56, 0x28, 0x86, 0xfc, 0x5f,
+		0x53, 0x30, 0x15, 0xf2, 0x96, 0x10, 0x48, 0xe9, 0x6a, 0x13, 0x29, 0x4a, 0xa7, 0xb3, 0x0a, 0xfd,
+		0x4d, 0xf2, 0x30, 0xdc, 0x52, 0x2b, 0x37, 0xd5, 0x9a, 0x96, 0x4f, 0xd0, 0x62, 0xf1, 0x48, 0x4e,
+		0x01, 0x54, 0xb5, 0x96, 0xa6, 0x57, 0x35, 0xbd, 0x72, 0x37, 0x9f, 0x9c, 0x4d, 0x9e, 0xce, 0x2a,
+		0xae, 0x12, 0x72, 0x16, 0x26, 0x5b, 0x9d, 0xdd, 0x46, 0xbd, 0x52, 0x76, 0x81, 0xc1, 0x6c, 0xf2,
+		0xf4, 0x90, 0x92, 0x63, 0x2f, 0x96, 0x1d, 0xe0, 0x47, 0x61, 0xe2, 0xb6, 0xa6, 0xde, 0x74, 0x83,
+		0x8e, 0x50, 0xd0, 0x71, 0x2c, 0x76, 0x01, 0x2e, 0xc1, 0x68, 0x53, 0x33, 0x4d, 0xb5, 0xa6, 0x95,
+		0xad, 0xbb, 0x2d, 0x2d, 0x9f, 0xa2, 0xbd, 0x9f, 0x0d, 0xf4, 0xde, 0xdf, 0xf3, 0x11, 0x8e, 0xb5,
+		0x7d, 0xb7, 0xa5, 0x91, 0x22, 0x64, 0x35, 0xbd, 0xd3, 0x64, 0x14, 0x86, 0x22, 0xf8, 0x57, 0xd2,
+		0x3b, 0x4d, 0x3f, 0x95, 0x0c, 0xa2, 0x71, 0x12, 0xc3, 0xa6, 0xd6, 0xbe, 0x55, 0xaf, 0x68, 0xf9,
+		0x34, 0x25, 0xf0, 0x68, 0x80, 0xc0, 0x16, 0x7b, 0xef, 0xa7, 0x21, 0xf0, 0xc8, 0x12, 0x64, 0xb5,
+		0x3b, 0x96, 0xa6, 0x9b, 0x75, 0x43, 0xcf, 0x0f, 0x53, 0x22, 0x0f, 0x87, 0x8c, 0xa2, 0xd6, 0xa8,
+		0xfa, 0x49, 0x38, 0x78, 0xe4, 0x22, 0x0c, 0x1b, 0x2d, 0xab, 0x6e, 0xe8, 0x66, 0x3e, 0x33, 0x2b,
+		0x9d, 0x1e, 0x39, 0x7f, 0x22, 0x54, 0x10, 0x36, 0x18, 0x8c, 0x22, 0x80, 0xc9, 0x0a, 0xe4, 0x4c,
+		0xa3, 0xd3, 0xae, 0x68, 0xe5, 0x8a, 0x51, 0xd5, 0xca, 0x75, 0x7d, 0xcf, 0xc8, 0x67, 0x29, 0x81,
+		0x99, 0x60, 0x47, 0x28, 0xe0, 0x92, 0x51, 0xd5, 0x56, 0xf4, 0x3d, 0x43, 0x19, 0x37, 0x3d, 0xcf,
+		0xe4, 0x08, 0xa4, 0xcd, 0xbb, 0xba, 0xa5, 0xde, 0xc9, 0x8f, 0x52, 0x09, 0xe1, 0x4f, 0x28, 0x3a,
+		0x5a, 0xb5, 0x8e, 0xd5, 0xe5, 0xc7, 0x98, 0xe8, 0xf0, 0x47, 0xf9, 0xa7, 0xd3, 0x30, 0xd1, 0x8b,
+		0xf0, 0x5d, 0x86, 0xa1, 0x3d, 0xec, 0x7f, 0x3e, 0xd1, 0x0f, 0x77, 0x18, 0x8e, 0x97, 0xbd, 0xe9,
+		0x03, 0xb2, 0xb7, 0x08, 0x23, 0xba, 0x66, 0x5a, 0x5a, 0x95, 0xc9, 0x4a, 0xb2, 0x47, 0x69, 0x03,
+		0x86, 0x14, 0x14, 0xb6, 0xd4, 0x81, 0x84, 0xed, 0x15, 0x98, 0xb0, 0x9b, 0x54, 0x6e, 0xab, 0x7a,
+		0x4d, 0x48, 0xed, 0x7c, 0x5c, 0x4b, 0xe6, 0x4a, 0x02, 0x4f, 0x41, 0x34, 0x65, 0x5c, 0xf3, 0x3c,
+		0x93, 0x65, 0x00, 0x43, 0xd7, 0x8c, 0xbd, 0x72, 0x55, 0xab, 0x34, 0xf2, 0x99, 0x08, 0x2e, 0x6d,
+		0x20, 0x48, 0x80, 0x4b, 0x06, 0x2b, 0xad, 0x34, 0xc8, 0xb3, 0x8e, 0x10, 0x0e, 0x47, 0xc8, 0xd0,
+		0x1a, 0x9b, 0x7e, 0x01, 0x39, 0xdc, 0x81, 0xf1, 0xb6, 0x86, 0x33, 0x42, 0xab, 0xf2, 0x9e, 0x65,
+		0x69, 0x23, 0xe6, 0x62, 0x7b, 0xa6, 0x70, 0x34, 0xd6, 0xb1, 0xb1, 0xb6, 0xfb, 0x91, 0x3c, 0x08,
+		0x76, 0x41, 0x99, 0x8a, 0x15, 0x50, 0xfd, 0x34, 0x2a, 0x0a, 0xd7, 0xd5, 0xa6, 0x56, 0x78, 0x03,
+		0xc6, 0xbd, 0xec, 0x21, 0xd3, 0x30, 0x64, 0x5a, 0x6a, 0xdb, 0xa2, 0x52, 0x38, 0xa4, 0xb0, 0x07,
+		0x92, 0x83, 0xa4, 0xa6, 0x57, 0xa9, 0xfe, 0x1b, 0x52, 0xf0, 0x27, 0x79, 0x97, 0xd3, 0xe1, 0x24,
+		0xed, 0xf0, 0x23, 0xc1, 0x11, 0xf5, 0x50, 0xf6, 0xf7, 0xbb, 0x70, 0x09, 0xc6, 0x3c, 0x1d, 0xe8,
+		0xb5, 0x6a, 0xf9, 0x9b, 0xe1, 0x70, 0x28, 0x69, 0xf2, 0x0a, 0x4c, 0x77, 0xf4, 0xba, 0x6e, 0x69,
+		0xed, 0x56, 0x5b, 0x43, 0x89, 0x65, 0x55, 0xe5, 0xbf, 0x3c, 0x1c, 0x21, 0x73, 0x3b, 0x6e, 0x68,
+		0x46, 0x45, 0x99, 0xea, 0x04, 0x0b, 0xcf, 0x64, 0x33, 0x5f, 0x19, 0xce, 0x7d, 0xf8, 0xc3, 0x1f,
+		0xfe, 0x70, 0x42, 0xfe, 0xf9, 0x34, 0x4c, 0x87, 0xcd, 0x99, 0xd0, 0xe9, 0x7b, 0x04, 0xd2, 0x7a,
+		0xa7, 0xb9, 0xab, 0xb5, 0x29, 0x93, 0x86, 0x14, 0xfe, 0x44, 0x8a, 0x30, 0xd4, 0x50, 0x77, 0xb5,
+		0x46, 0x3e, 0x35, 0x2b, 0x9d, 0x1e, 0x3f, 0x7f, 0xb6, 0xa7, 0x59, 0x39, 0xb7, 0x8a, 0x28, 0x0a,
+		0xc3, 0x24, 0x2f, 0x40, 0x8a, 0x2b, 0x6f, 0xa4, 0x70, 0xa6, 0x37, 0x0a, 0x38, 0x97, 0x14, 0x8a,
+		0x47, 0x8e, 0x43, 0x16, 0xff, 0x33, 0xd9, 0x48, 0xd3, 0x36, 0x67, 0xb0, 0x00, 0xe5, 0x82, 0x14,
+		0x20, 0x43, 0xa7, 0x49, 0x55, 0x13, 0x8b, 0x9e, 0xfd, 0x8c, 0x82, 0x55, 0xd5, 0xf6, 0xd4, 0x4e,
+		0xc3, 0x2a, 0xdf, 0x52, 0x1b, 0x1d, 0x8d, 0x0a, 0x7c, 0x56, 0x19, 0xe5, 0x85, 0x37, 0xb0, 0x8c,
+		0xcc, 0xc0, 0x08, 0x9b, 0x55, 0x75, 0xbd, 0xaa, 0xdd, 0xa1, 0x7a, 0x75, 0x48, 0x61, 0x13, 0x6d,
+		0x05, 0x4b, 0xb0, 0xfa, 0x0f, 0x98, 0x86, 0x2e, 0x44, 0x93, 0x56, 0x81, 0x05, 0xb4, 0xfa, 0x4b,
+		0x7e, 0x95, 0x7e, 0x32, 0xbc, 0x7b, 0x81, 0xb9, 0xf4, 0x28, 0x4c, 0x50, 0x88, 0xa7, 0xf8, 0xd0,
+		0xab, 0x8d, 0xfc, 0xe4, 0xac, 0x74, 0x3a, 0xa3, 0x8c, 0xb3, 0xe2, 0x0d, 0x5e, 0x2a, 0xff, 0x64,
+		0x02, 0x52, 0x54, 0xb1, 0x4c, 0xc0, 0xc8, 0xf6, 0xab, 0x9b, 0xa5, 0xf2, 0xf2, 0xc6, 0xce, 0xe2,
+		0x6a, 0x29, 0x27, 0x91, 0x71, 0x00, 0x5a, 0x70, 0x65, 0x75, 0xa3, 0xb8, 0x9d, 0x4b, 0xd8, 0xcf,
+		0x2b, 0xeb, 0xdb, 0x17, 0x9f, 0xce, 0x25, 0x6d, 0x84, 0x1d, 0x56, 0x90, 0x72, 0x03, 0x3c, 0x75,
+		0x3e, 0x37, 0x44, 0x72, 0x30, 0xca, 0x08, 0xac, 0xbc, 0x52, 0x5a, 0xbe, 0xf8, 0x74, 0x2e, 0xed,
+		0x2d, 0x79, 0xea, 0x7c, 0x6e, 0x98, 0x8c, 0x41, 0x96, 0x96, 0x2c, 0x6e, 0x6c, 0xac, 0xe6, 0x32,
+		0x36, 0xcd, 0xad, 0x6d, 0x65, 0x65, 0xfd, 0x6a, 0x2e, 0x6b, 0xd3, 0xbc, 0xaa, 0x6c, 0xec, 0x6c,
+		0xe6, 0xc0, 0xa6, 0xb0, 0x56, 0xda, 0xda, 0x2a, 0x5e, 0x2d, 0xe5, 0x46, 0x6c, 0x88, 0xc5, 0x57,
+		0xb7, 0x4b, 0x5b, 0xb9, 0x51, 0x4f, 0xb3, 0x9e, 0x3a, 0x9f, 0x1b, 0xb3, 0xab, 0x28, 0xad, 0xef,
+		0xac, 0xe5, 0xc6, 0xc9, 0x24, 0x8c, 0xb1, 0x2a, 0x44, 0x23, 0x26, 0x7c, 0x45, 0x17, 0x9f, 0xce,
+		0xe5, 0x9c, 0x86, 0x30, 0x2a, 0x93, 0x9e, 0x82, 0x8b, 0x4f, 0xe7, 0x88, 0xbc, 0x04, 0x43, 0x54,
+		0x0c, 0x09, 0x81, 0xf1, 0xd5, 0xe2, 0x62, 0x69, 0xb5, 0xbc, 0xb1, 0xb9, 0xbd, 0xb2, 0xb1, 0x5e,
+		0x5c, 0xcd, 0x49, 0x4e, 0x99, 0x52, 0x7a, 0xf7, 0xce, 0x8a, 0x52, 0x5a, 0xce, 0x25, 0xdc, 0x65,
+		0x9b, 0xa5, 0xe2, 0x76, 0x69, 0x39, 0x97, 0x94, 0x2b, 0x30, 0x1d, 0xa6, 0x50, 0x43, 0xa7, 0x90,
+		0x4b, 0x16, 0x12, 0x11, 0xb2, 0x40, 0x69, 0xf9, 0x65, 0x41, 0xfe, 0x52, 0x02, 0xa6, 0x42, 0x16,
+		0x95, 0xd0, 0x4a, 0x5e, 0x84, 0x21, 0x26, 0xcb, 0x6c, 0x99, 0x7d, 0x2c, 0x74, 0x75, 0xa2, 0x92,
+		0x1d, 0x58, 0x6a, 0x29, 0x9e, 0xdb, 0x08, 0x49, 0x46, 0x18, 0x21, 0x48, 0x22, 0x20, 0xb0, 0xef,
+		0x0d, 0x28, 0x7f, 0xb6, 0x3e, 0x5e, 0xec, 0x65, 0x7d, 0xa4, 0x65, 0xfd, 0x2d, 0x02, 0x43, 0x21,
+		0x8b, 0xc0, 0x65, 0x98, 0x0c, 0x10, 0xea, 0x59, 0x19, 0x7f, 0x54, 0x82, 0x7c, 0x14, 0x73, 0x62,
+		0x54, 0x62, 0xc2, 0xa3, 0x12, 0x2f, 0xfb, 0x39, 0xf8, 0x40, 0xf4, 0x20, 0x04, 0xc6, 0xfa, 0x33,
+		0x12, 0x1c, 0x09, 0x37, 0x36, 0x43, 0xdb, 0xf0, 0x02, 0xa4, 0x9b, 0x9a, 0xb5, 0x6f, 0x08, 0xb3,
+		0xea, 0x91, 0x90, 0xc5, 0x1a, 0x5f, 0xfb, 0x07, 0x9b, 0x63, 0x91, 0x67, 0xfd, 0x6d, 0x9d, 0x89,
+		0x32, 0x7d, 0x03, 0x2d, 0xfd, 0xb6, 0x04, 0x1c, 0x0e, 0x25, 0x1e, 0xda, 0xd0, 0x93, 0x00, 0x75,
+		0xbd, 0xd5, 0xb1, 0x98, 0xe9, 0xc4, 0x34, 0x71, 0x96, 0x96, 0x50, 0xe5, 0x85, 0x5a, 0xb6, 0x63,
+		0xd9, 0xef, 0x93, 0xf4, 0x3d, 0xb0, 0x22, 0x0a, 0xf0, 0x8c, 0xd3, 0xd0, 0x14, 0x6d, 0xe8, 0xa9,
+		0x88, 0x9e, 0x06, 0x04, 0xf3, 0x09, 0xc8, 0x55, 0x1a, 0x75, 0x4d, 0xb7, 0xca, 0xa6, 0xd5, 0xd6,
+		0xd4, 0x66, 0x5d, 0xaf, 0xd1, 0xa5, 0x26, 0xb3, 0x30, 0xb4, 0xa7, 0x36, 0x4c, 0x4d, 0x99, 0x60,
+		0xaf, 0xb7, 0xc4, 0x5b, 0xc4, 0xa0, 0x02, 0xd4, 0x76, 0x61, 0xa4, 0x3d, 0x18, 0xec, 0xb5, 0x8d,
+		0x21, 0xff, 0x9d, 0x2c, 0x8c, 0xb8, 0x4c, 0x73, 0xf2, 0x00, 0x8c, 0x7e, 0x40, 0xbd, 0xa5, 0x96,
+		0x85, 0xbb, 0xc5, 0x38, 0x31, 0x82, 0x65, 0x9b, 0xac, 0x88, 0x3c, 0x01, 0xd3, 0x14, 0xc4, 0xe8,
+		0x58, 0x5a, 0xbb, 0x5c, 0x69, 0xa8, 0xa6, 0x49, 0x99, 0x96, 0xa1, 0xa0, 0x04, 0xdf, 0x6d, 0xe0,
+		0xab, 0x25, 0xf1, 0x86, 0x5c, 0x80, 0x29, 0x8a, 0xd1, 0xec, 0x34, 0xac, 0x7a, 0xab, 0xa1, 0x95,
+		0xd1, 0x01, 0x34, 0xf3, 0xe0, 0x6e, 0xd9, 0x24, 0x42, 0xac, 0x71, 0x00, 0x6c, 0x91, 0x49, 0x96,
+		0xe1, 0x24, 0x45, 0xab, 0x69, 0xba, 0xd6, 0x56, 0x2d, 0xad, 0xac, 0xbd, 0xde, 0x51, 0x1b, 0x66,
+		0x59, 0xd5, 0xab, 0xe5, 0x7d, 0xd5, 0xdc, 0xcf, 0x4f, 0x23, 0x81, 0xc5, 0x44, 0x5e, 0x52, 0x8e,
+		0x21, 0xe0, 0x55, 0x0e, 0x57, 0xa2, 0x60, 0x45, 0xbd, 0x7a, 0x4d, 0x35, 0xf7, 0xc9, 0x02, 0x1c,
+		0xa1, 0x54, 0x4c, 0xab, 0x5d, 0xd7, 0x6b, 0xe5, 0xca, 0xbe, 0x56, 0xb9, 0x59, 0xee, 0x58, 0x7b,
+		0xcf, 0xe4, 0x8f, 0xbb, 0xeb, 0xa7, 0x2d, 0xdc, 0xa2, 0x30, 0x4b, 0x08, 0xb2, 0x63, 0xed, 0x3d,
+		0x43, 0xb6, 0x60, 0x14, 0x07, 0xa3, 0x59, 0x7f, 0x43, 0x2b, 0xef, 0x19, 0x6d, 0xba, 0x86, 0x8e,
+		0x87, 0xa8, 0x26, 0x17, 0x07, 0xe7, 0x36, 0x38, 0xc2, 0x9a, 0x51, 0xd5, 0x16, 0x86, 0xb6, 0x36,
+		0x4b, 0xa5, 0x65, 0x65, 0x44, 0x50, 0xb9, 0x62, 0xb4, 0x51, 0xa0, 0x6a, 0x86, 0xcd, 0xe0, 0x11,
+		0x26, 0x50, 0x35, 0x43, 0xb0, 0xf7, 0x02, 0x4c, 0x55, 0x2a, 0xac, 0xcf, 0xf5, 0x4a, 0x99, 0xbb,
+		0x69, 0x66, 0x3e, 0xe7, 0x61, 0x56, 0xa5, 0x72, 0x95, 0x01, 0x70, 0x19, 0x37, 0xc9, 0xb3, 0x70,
+		0xd8, 0x61, 0x96, 0x1b, 0x71, 0x32, 0xd0, 0x4b, 0x3f, 0xea, 0x05, 0x98, 0x6a, 0xdd, 0x0d, 0x22,
+		0x12, 0x4f, 0x8d, 0xad, 0xbb, 0x7e, 0xb4, 0x4b, 0x30, 0xdd, 0xda, 0x6f, 0x05, 0xf1, 0xce, 0xb8,
+		0xf1, 0x48, 0x6b, 0xbf, 0xe5, 0x47, 0x7c, 0x98, 0xfa, 0xec, 0x6d, 0xad, 0xa2, 0x5a, 0x5a, 0x35,
+		0x7f, 0xd4, 0x0d, 0xee, 0x7a, 0x41, 0xe6, 0x20, 0x57, 0xa9, 0x94, 0x35, 0x5d, 0xdd, 0x6d, 0x68,
+		0x65, 0xb5, 0xad, 0xe9, 0xaa, 0x99, 0x9f, 0xa1, 0xc0, 0x29, 0xab, 0xdd, 0xd1, 0x94, 0xf1, 0x4a,
+		0xa5, 0x44, 0x5f, 0x16, 0xe9, 0x3b, 0x72, 0x06, 0x26, 0x8d, 0xdd, 0x0f, 0x54, 0x98, 0x44, 0x96,
+		0x5b, 0x6d, 0x6d, 0xaf, 0x7e, 0x27, 0xff, 0x10, 0x65, 0xef, 0x04, 0xbe, 0xa0, 0xf2, 0xb8, 0x49,
+		0x8b, 0xc9, 0x63, 0x90, 0xab, 0x98, 0xfb, 0x6a, 0xbb, 0x45, 0x55, 0xb2, 0xd9, 0x52, 0x2b, 0x5a,
+		0xfe, 0x61, 0x06, 0xca, 0xca, 0xd7, 0x45, 0x31, 0xce, 0x08, 0xf3, 0x76, 0x7d, 0xcf, 0x12, 0x14,
+		0x1f, 0x65, 0x33, 0x82, 0x96, 0x71, 0x6a, 0xa7, 0x21, 0x87, 0x9c, 0xf0, 0x54, 0x7c, 0x9a, 0x82,
+		0x8d, 0xb7, 0xf6, 0x5b, 0xee, 0x7a, 0x1f, 0x84, 0xb1, 0xd6, 0xbe, 0xbb, 0xd2, 0xc7, 0x98, 0xe1,
+		0xd6, 0xda, 0x77, 0xd5, 0xf8, 0x34, 0x1c, 0x41, 0xa0, 0xa6, 0x66, 0xa9, 0x55, 0xd5, 0x52, 0x5d,
+		0xd0, 0x8f, 0x53, 0x68, 0x64, 0xfb, 0x1a, 0x7f, 0xe9, 0x69, 0x67, 0xbb, 0xb3, 0x7b, 0xd7, 0x16,
+		0xac, 0x73, 0xac, 0x9d, 0x58, 0x26, 0x44, 0xeb, 0xbe, 0x19, 0xe7, 0xf2, 0x02, 0x8c, 0xba, 0xe5,
+		0x9e, 0x64, 0x81, 0x49, 0x7e, 0x4e, 0x42, 0x23, 0x68, 0x69, 0x63, 0x19, 0xcd, 0x97, 0xd7, 0x4a,
+		0xb9, 0x04, 0x9a, 0x51, 0xab, 0x2b, 0xdb, 0xa5, 0xb2, 0xb2, 0xb3, 0xbe, 0xbd, 0xb2, 0x56, 0xca,
+		0x25, 0x5d, 0x86, 0xfd, 0xf5, 0x54, 0xe6, 0x91, 0xdc, 0xa3, 0xf2, 0xcf, 0x24, 0x61, 0xdc, 0xeb,
+		0xa9, 0x91, 0xe7, 0xe0, 0xa8, 0x08, 0xb8, 0x98, 0x9a, 0x55, 0xbe, 0x5d, 0x6f, 0xd3, 0x09, 0xd9,
+		0x54, 0xd9, 0xe2, 0x68, 0xcb, 0xcf, 0x34, 0x87, 0xda, 0xd2, 0xac, 0x97, 0xeb, 0x6d, 0x9c, 0x6e,
+		0x4d, 0xd5, 0x22, 0xab, 0x30, 0xa3, 0x1b, 0x65, 0xd3, 0x52, 0xf5, 0xaa, 0xda, 0xae, 0x96, 0x9d,
+		0x50, 0x57, 0x59, 0xad, 0x54, 0x34, 0xd3, 0x34, 0xd8, 0x42, 0x68, 0x53, 0x39, 0xa1, 0x1b, 0x5b,
+		0x1c, 0xd8, 0x59, 0x21, 0x8a, 0x1c, 0xd4, 0x27, 0xbe, 0xc9, 0x28, 0xf1, 0x3d, 0x0e, 0xd9, 0xa6,
+		0xda, 0x2a, 0x6b, 0xba, 0xd5, 0xbe, 0x4b, 0xed, 0xf3, 0x8c, 0x92, 0x69, 0xaa, 0xad, 0x12, 0x3e,
+		0x93, 0x1b, 0xf0, 0x88, 0x03, 0x5a, 0x6e, 0x68, 0x35, 0xb5, 0x72, 0xb7, 0x4c, 0x8d, 0x71, 0x1a,
+		0x36, 0x28, 0x57, 0x0c, 0x7d, 0xaf, 0x51, 0xaf, 0x58, 0x66, 0x7e, 0xc4, 0xd6, 0x71, 0xb2, 0x83,
+		0xb1, 0x4a, 0x11, 0xae, 0x9b, 0x86, 0x4e, 0x6d, 0xf0, 0x25, 0x01, 0xfd, 0x0d, 0x71, 0xbf, 0xae,
+		0xa7, 0x32, 0xa9, 0xdc, 0xd0, 0xf5, 0x54, 0x66, 0x28, 0x97, 0xbe, 0x9e, 0xca, 0xa4, 0x73, 0xc3,
+		0xd7, 0x53, 0x99, 0x4c, 0x2e, 0x7b, 0x3d, 0x95, 0xc9, 0xe6, 0x40, 0xfe, 0xa9, 0x0c, 0x8c, 0xba,
+		0x3d, 0x03, 0x74, 0xb4, 0x2a, 0x74, 0x6d, 0x94, 0xa8, 0xf6, 0x7c, 0xb0, 0xab, 0x1f, 0x31, 0xb7,
+		0x84, 0x8b, 0xe6, 0x42, 0x9a, 0x99, 0xe1, 0x0a, 0xc3, 0x44, 0x83, 0x05, 0xc5, 0x5a, 0x63, 0x66,
+		0x4f, 0x46, 0xe1, 0x4f, 0xe4, 0x2a, 0xa4, 0x3f, 0x60, 0x52, 0xda, 0x69, 0x4a, 0xfb, 0xa1, 0xee,
+		0xb4, 0xaf, 0x6f, 0x51, 0xe2, 0xd9, 0xeb, 0x5b, 0xe5, 0xf5, 0x0d, 0x65, 0xad, 0xb8, 0xaa, 0x70,
+		0x74, 0x72, 0x0c, 0x52, 0x0d, 0xf5, 0x8d, 0xbb, 0xde, 0xe5, 0x95, 0x16, 0x91, 0x39, 0x98, 0xe8,
+		0xe8, 0xb7, 0xb4, 0x76, 0x7d, 0xaf, 0x8e, 0x43, 0x85, 0x50, 0x13, 0x6e, 0xa8, 0x71, 0xe7, 0xed,
+		0x2a, 0xc2, 0xf7, 0x28, 0x1e, 0xc7, 0x20, 0x85, 0x41, 0x45, 0xef, 0x22, 0x48, 0x8b, 0xc8, 0x69,
+		0x18, 0xad, 0x6a, 0xbb, 0x9d, 0x5a, 0xb9, 0xad, 0x55, 0xd5, 0x8a, 0xe5, 0x55, 0xfd, 0x23, 0xf4,
+		0x95, 0x42, 0xdf, 0x90, 0x97, 0x20, 0x8b, 0x63, 0xa4, 0xd3, 0x31, 0x9e, 0xa4, 0x2c, 0x38, 0xd7,
+		0x9d, 0x05, 0x7c, 0x88, 0x05, 0x92, 0xe2, 0xe0, 0x93, 0x2b, 0x90, 0xb6, 0xd4, 0x76, 0x4d, 0xb3,
+		0xa8, 0xe6, 0x1f, 0x3f, 0x3f, 0xd7, 0x0b, 0xa5, 0x6d, 0x8a, 0x41, 0x7d, 0x5a, 0x8e, 0x7d, 0x1f,
+		0xb5, 0xcc, 0x3c, 0x0c, 0x51, 0xf1, 0x20, 0x00, 0x5c, 0x40, 0x72, 0x87, 0x48, 0x06, 0x52, 0x4b,
+		0x1b, 0x0a, 0x6a, 0x9a, 0x1c, 0x8c, 0xb2, 0xd2, 0xf2, 0xe6, 0x4a, 0x69, 0xa9, 0x94, 0x4b, 0xc8,
+		0x17, 0x20, 0xcd, 0xc6, 0x1c, 0xb5, 0x90, 0x3d, 0xea, 0xb9, 0x43, 0xfc, 0x91, 0xd3, 0x90, 0xc4,
+		0xdb, 0x9d, 0xb5, 0xc5, 0x92, 0x92, 0x4b, 0xc8, 0x3b, 0x30, 0xe1, 0xe3, 0x13, 0x39, 0x0c, 0x93,
+		0x4a, 0x69, 0xbb, 0xb4, 0x8e, 0x7e, 0x56, 0x79, 0x67, 0xfd, 0xa5, 0xf5, 0x8d, 0x97, 0xd7, 0x73,
+		0x87, 0xbc, 0xc5, 0x42, 0xa5, 0x49, 0x64, 0x1a, 0x72, 0x4e, 0xf1, 0xd6, 0xc6, 0x8e, 0x42, 0x5b,
+		0xf3, 0xed, 0x09, 0xc8, 0xf9, 0xb9, 0x46, 0x8e, 0xc2, 0xd4, 0x76, 0x51, 0xb9, 0x5a, 0xda, 0x2e,
+		0x33, 0xdf, 0xd1, 0x26, 0x3d, 0x0d, 0x39, 0xf7, 0x8b, 0x2b, 0x2b, 0xd4, 0x35, 0x9e, 0x81, 0xe3,
+		0xee, 0xd2, 0xd2, 0x2b, 0xdb, 0xa5, 0xf5, 0x2d, 0x5a, 0x79, 0x71, 0xfd, 0x2a, 0xea, 0x57, 0x1f,
+		0x3d, 0xe1, 0xad, 0x26, 0xb1, 0xa9, 0x5e, 0x7a, 0xa5, 0xd5, 0xe5, 0x5c, 0xca, 0x5f, 0xbc, 0xb1,
+		0x5e, 0xda, 0xb8, 0x92, 0x1b, 0xf2, 0xd7, 0x4e, 0x3d, 0xd8, 0x34, 0x29, 0xc0, 0x11, 0x7f, 0x69,
+		0xb9, 0xb4, 0xbe, 0xad, 0xbc, 0x9a, 0x1b, 0xf6, 0x57, 0xbc, 0x55, 0x52, 0x6e, 0xac, 0x2c, 0x95,
+		0x72, 0x19, 0x72, 0x04, 0x88, 0xb7, 0x45, 0xdb, 0xd7, 0x36, 0x96, 0x73, 0xd9, 0x80, 0x46, 0x91,
+		0x4d, 0x18, 0x75, 0xbb, 0x91, 0xdf, 0x98, 0x58, 0xd2, 0xf7, 0x24, 0x60, 0xc4, 0xe5, 0x16, 0xa2,
+		0x3d, 0xaf, 0x36, 0x1a, 0xc6, 0xed, 0xb2, 0xda, 0xa8, 0xab, 0x26, 0xd7, 0x37, 0x40, 0x8b, 0x8a,
+		0x58, 0xd2, 0xeb, 0xfc, 0xee, 0x5d, 0xc3, 0xa7, 0xdf, 0x89, 0x1a, 0x7e, 0x28, 0x97, 0x96, 0x3f,
+		0x29, 0x41, 0xce, 0xef, 0xef, 0xf9, 0xba, 0x2f, 0x45, 0x75, 0xff, 0x1b, 0x32, 0x76, 0xdf, 0x2f,
+		0xc1, 0xb8, 0xd7, 0xc9, 0xf3, 0x35, 0xef, 0x81, 0xff, 0x5f, 0x9b, 0xf7, 0xbb, 0x09, 0x18, 0xf3,
+		0xb8, 0x76, 0xbd, 0xb6, 0xee, 0x75, 0x98, 0xac, 0x57, 0xb5, 0x66, 0xcb, 0xb0, 0x70, 0xb7, 0xa9,
+		0xdc, 0xd0, 0x6e, 0x69, 0x8d, 0xbc, 0x4c, 0x95, 0xf2, 0x7c, 0x77, 0xe7, 0x71, 0x6e, 0xc5, 0xc1,
+		0x5b, 0x45, 0xb4, 0x85, 0xa9, 0x95, 0xe5, 0xd2, 0xda, 0xe6, 0xc6, 0x76, 0x69, 0x7d, 0xe9, 0x55,
+		0xa1, 0x5d, 0x94, 0x5c, 0xdd, 0x07, 0x76, 0x1f, 0x95, 0xf6, 0x26, 0xe4, 0xfc, 0x8d, 0x42, 0x5d,
+		0x11, 0xd2, 0xac, 0xdc, 0x21, 0x32, 0x05, 0x13, 0xeb, 0x1b, 0xe5, 0xad, 0x95, 0xe5, 0x52, 0xb9,
+		0x74, 0xe5, 0x4a, 0x69, 0x69, 0x7b, 0x8b, 0x85, 0x03, 0x6d, 0xe8, 0xed, 0x5c, 0xc2, 0xcd, 0xe2,
+		0xef, 0x4d, 0xc2, 0x54, 0x48, 0x4b, 0x48, 0x91, 0x3b, 0xf2, 0x2c, 0xb6, 0x70, 0xae, 0x97, 0xd6,
+		0xcf, 0xa1, 0x29, 0xbd, 0xa9, 0xb6, 0x2d, 0xee, 0xf7, 0x3f, 0x06, 0xc8, 0x25, 0xdd, 0xc2, 0x95,
+		0xbd, 0xcd, 0xc3, 0xac, 0xcc, 0xbb, 0x9f, 0x70, 0xca, 0x59, 0xa4, 0xf5, 0x71, 0x20, 0x2d, 0xc3,
+		0xac, 0x5b, 0xf5, 0x5b, 0xb8, 0x87, 0x25, 0x62, 0xb2, 0xe8, 0xed, 0xa7, 0x94, 0x9c, 0x78, 0xb3,
+		0xa2, 0x5b, 0x36, 0xb4, 0xae, 0xd5, 0x54, 0x1f, 0x34, 0x5a, 0x1e, 0x49, 0x25, 0x27, 0xde, 0xd8,
+		0xd0, 0x0f, 0xc0, 0x68, 0xd5, 0xe8, 0xa0, 0x0b, 0xc4, 0xe0, 0x50, 0x5b, 0x48, 0xca, 0x08, 0x2b,
+		0xb3, 0x41, 0xb8, 0x73, 0xeb, 0x04, 0x83, 0x47, 0x95, 0x11, 0x56, 0xc6, 0x40, 0x1e, 0x85, 0x09,
+		0xb5, 0x56, 0x6b, 0x23, 0x71, 0x41, 0x88, 0xb9, 0xeb, 0xe3, 0x76, 0x31, 0x05, 0x2c, 0x5c, 0x87,
+		0x8c, 0xe0, 0x03, 0x5a, 0xb0, 0xc8, 0x89, 0x72, 0x8b, 0xc5, 0xa0, 0x12, 0x18, 0x1f, 0xd6, 0xc5,
+		0xcb, 0x07, 0x60, 0xb4, 0x6e, 0x96, 0x9d, 0xbd, 0xad, 0xc4, 0x6c, 0xe2, 0x74, 0x46, 0x19, 0xa9,
+		0x9b, 0xf6, 0xbe, 0x80, 0xfc, 0x99, 0x04, 0x8c, 0x7b, 0x77, 0xed, 0xc8, 0x32, 0x64, 0x1a, 0x46,
+		0x45, 0xa5, 0xa2, 0xc5, 0xb6, 0x8c, 0x4f, 0xc7, 0x6c, 0xf4, 0xcd, 0xad, 0x72, 0x78, 0xc5, 0xc6,
+		0x2c, 0xfc, 0x8a, 0x04, 0x19, 0x51, 0x4c, 0x8e, 0x40, 0xaa, 0xa5, 0x5a, 0xfb, 0x94, 0xdc, 0xd0,
+		0x62, 0x22, 0x27, 0x29, 0xf4, 0x19, 0xcb, 0xcd, 0x96, 0xaa, 0xe7, 0x13, 0x4e, 0x39, 0x3e, 0xe3,
+		0xb8, 0x36, 0x34, 0xb5, 0x4a, 0x63, 0x01, 0x46, 0xb3, 0xa9, 0xe9, 0x96, 0x29, 0xc6, 0x95, 0x97,
+		0x2f, 0xf1, 0x62, 0xdc, 0x3c, 0xb6, 0xda, 0x6a, 0xbd, 0xe1, 0x81, 0x4d, 0x51, 0xd8, 0x9c, 0x78,
+		0x61, 0x03, 0x2f, 0xc0, 0x31, 0x41, 0xb7, 0xaa, 0x59, 0x6a, 0x65, 0x5f, 0xab, 0x3a, 0x48, 0x69,
+		0x1a, 0xf3, 0x3b, 0xca, 0x01, 0x96, 0xf9, 0x7b, 0x81, 0x2b, 0xff, 0x6a, 0x02, 0x26, 0x45, 0xf4,
+		0xa2, 0x6a, 0x33, 0x6b, 0x0d, 0x40, 0xd5, 0x75, 0xc3, 0x72, 0xb3, 0x2b, 0x28, 0xca, 0x01, 0xbc,
+		0xb9, 0xa2, 0x8d, 0xa4, 0xb8, 0x08, 0x14, 0xfe, 0x50, 0x02, 0x70, 0x5e, 0x45, 0xf2, 0x6d, 0x06,
+		0x46, 0xf8, 0x9e, 0x2c, 0xdd, 0xd8, 0x67, 0x01, 0x2f, 0x60, 0x45, 0x18, 0xe7, 0xc0, 0xb0, 0xe4,
+		0xae, 0x56, 0xab, 0xeb, 0x7c, 0x3f, 0x85, 0x3d, 0x88, 0xb0, 0x64, 0xca, 0xd9, 0x9e, 0x52, 0x20,
+		0x63, 0x6a, 0x4d, 0x55, 0xb7, 0xea, 0x15, 0xbe, 0x43, 0x72, 0xb1, 0xaf, 0xc6, 0xcf, 0x6d, 0x71,
+		0x6c, 0xc5, 0xa6, 0x23, 0x9f, 0x86, 0x8c, 0x28, 0x45, 0xc3, 0x6f, 0x7d, 0x63, 0xbd, 0x94, 0x3b,
+		0x44, 0x86, 0x21, 0xb9, 0x55, 0xda, 0xce, 0x49, 0xe8, 0x76, 0x16, 0x57, 0x57, 0x8a, 0x5b, 0xb9,
+		0xc4, 0xe2, 0xdf, 0x82, 0xa9, 0x8a, 0xd1, 0xf4, 0x57, 0xb8, 0x98, 0xf3, 0x85, 0xfc, 0xcc, 0x6b,
+		0xd2, 0x6b, 0xe7, 0x38, 0x50, 0xcd, 0x68, 0xa8, 0x7a, 0x6d, 0xce, 0x68, 0xd7, 0x9c, 0xb4, 0x08,
+		0xf4, 0x0e, 0x4c, 0x57, 0x72, 0x44, 0x6b, 0xf7, 0x2f, 0x25, 0xe9, 0x07, 0x12, 0xc9, 0xab, 0x9b,
+		0x8b, 0x3f, 0x9a, 0x28, 0x5c, 0x65, 0x88, 0x9b, 0xa2, 0x3b, 0x8a, 0xb6, 0xd7, 0xd0, 0x2a, 0xd8,
+		0x78, 0xf8, 0xa3, 0xb3, 0x30, 0x5d, 0x33, 0x6a, 0x06, 0xa5, 0x34, 0x8f, 0xbf, 0x58, 0x23, 0x48,
+		0xd6, 0x2e, 0x2d, 0xc4, 0x26, 0x61, 0x2c, 0xac, 0xc3, 0x14, 0x07, 0x2e, 0xd3, 0xed, 0x5b, 0x16,
+		0x5c, 0x20, 0x5d, 0x23, 0xdb, 0xf9, 0x7f, 0xf1, 0xfb, 0xd4, 0x2a, 0x51, 0x26, 0x39, 0x2a, 0xbe,
+		0x63, 0xf1, 0x87, 0x05, 0x05, 0x0e, 0x7b, 0xe8, 0x31, 0x1d, 0xa1, 0xb5, 0x63, 0x28, 0xfe, 0x02,
+		0xa7, 0x38, 0xe5, 0xa2, 0xb8, 0xc5, 0x51, 0x17, 0x96, 0x60, 0xac, 0x1f, 0x5a, 0xff, 0x8e, 0xd3,
+		0x1a, 0xd5, 0xdc, 0x44, 0xae, 0xc2, 0x04, 0x25, 0x52, 0xe9, 0x98, 0x96, 0xd1, 0xa4, 0x0a, 0xb8,
+		0x3b, 0x99, 0xff, 0xe9, 0xf7, 0xd9, 0xa4, 0x1d, 0x47, 0xb4, 0x25, 0x1b, 0x6b, 0x61, 0x01, 0xe8,
+		0x8e, 0x35, 0xee, 0x24, 0xc7, 0x50, 0xf8, 0x45, 0xde, 0x10, 0x1b, 0x7e, 0xe1, 0x06, 0x4c, 0xe3,
+		0x6f, 0xaa, 0x1f, 0xdd, 0x2d, 0x89, 0x0f, 0x83, 0xe7, 0xff, 0xc3, 0x47, 0x99, 0x5e, 0x98, 0xb2,
+		0x09, 0xb8, 0xda, 0xe4, 0x1a, 0xc5, 0x9a, 0x66, 0x59, 0x5a, 0xdb, 0x2c, 0xab, 0x8d, 0xb0, 0xe6,
+		0xb9, 0xe2, 0x88, 0xf9, 0x7f, 0xf8, 0x55, 0xef, 0x28, 0x5e, 0x65, 0x98, 0xc5, 0x46, 0x63, 0x61,
+		0x07, 0x8e, 0x86, 0x48, 0x45, 0x0f, 0x34, 0xbf, 0x97, 0xd3, 0x9c, 0x0e, 0x48, 0x06, 0x92, 0xdd,
+		0x04, 0x51, 0x6e, 0x8f, 0x65, 0x0f, 0x34, 0xbf, 0x8f, 0xd3, 0x24, 0x1c, 0x57, 0x0c, 0x29, 0x52,
+		0xbc, 0x0e, 0x93, 0xb7, 0xb4, 0xf6, 0xae, 0x61, 0xf2, 0xd8, 0x6d, 0x0f, 0xe4, 0xbe, 0x9f, 0x93,
+		0x9b, 0xe0, 0x88, 0x34, 0x98, 0x8b, 0xb4, 0x9e, 0x85, 0xcc, 0x9e, 0x5a, 0xd1, 0x7a, 0x20, 0xf1,
+		0x16, 0x27, 0x31, 0x8c, 0xf0, 0x88, 0x5a, 0x84, 0xd1, 0x9a, 0xc1, 0x97, 0xc8, 0x78, 0xf4, 0x4f,
+		0x72, 0xf4, 0x11, 0x81, 0xc3, 0x49, 0xb4, 0x8c, 0x56, 0xa7, 0x81, 0xeb, 0x67, 0x3c, 0x89, 0x7f,
+		0x24, 0x48, 0x08, 0x1c, 0x4e, 0xa2, 0x0f, 0xb6, 0x7e, 0x4a, 0x90, 0x30, 0x5d, 0xfc, 0x7c, 0x11,
+		0xb7, 0x74, 0x1b, 0x77, 0x0d, 0xbd, 0x97, 0x46, 0x7c, 0x9a, 0x53, 0x00, 0x8e, 0x82, 0x04, 0x2e,
+		0x43, 0xb6, 0xd7, 0x81, 0xf8, 0x27, 0x5f, 0x15, 0xd3, 0x43, 0x8c, 0xc0, 0x55, 0x98, 0x10, 0x0a,
+		0x0a, 0x53, 0x40, 0xe2, 0x49, 0xfc, 0x10, 0x27, 0x31, 0xee, 0x42, 0xe3, 0xdd, 0xb0, 0x34, 0xd3,
+		0xaa, 0x69, 0xbd, 0x10, 0xf9, 0x8c, 0xe8, 0x06, 0x47, 0xe1, 0xac, 0xdc, 0xd5, 0xf4, 0xca, 0x7e,
+		0x6f, 0x14, 0x7e, 0x58, 0xb0, 0x52, 0xe0, 0x20, 0x89, 0x25, 0x18, 0x6b, 0xaa, 0x6d, 0x73, 0x5f,
+		0x6d, 0xf4, 0x34, 0x1c, 0x3f, 0xc2, 0x69, 0x8c, 0xda, 0x48, 0x9c, 0x23, 0x1d, 0xbd, 0x1f, 0x32,
+		0x3f, 0x2a, 0x38, 0xd2, 0xd1, 0x3d, 0x84, 0x36, 0x61, 0xda, 0xb4, 0x68, 0xa0, 0xbb, 0x1f, 0x6a,
+		0xff, 0x54, 0x4c, 0x3d, 0x86, 0xbb, 0xe6, 0xa6, 0x78, 0x19, 0xb2, 0x66, 0xfd, 0x8d, 0x9e, 0xc8,
+		0xfc, 0x98, 0x18, 0x69, 0x8a, 0x80, 0xc8, 0xaf, 0xc2, 0xb1, 0xd0, 0x65, 0xa2, 0x07, 0x62, 0x3f,
+		0xce, 0x89, 0x1d, 0x09, 0x59, 0x2a, 0xb8, 0x4a, 0xe8, 0x97, 0xe4, 0x3f, 0x13, 0x2a, 0x41, 0xf3,
+		0xd1, 0xda, 0x44, 0xa7, 0xc5, 0x54, 0xf7, 0xfa, 0xe3, 0xda, 0x3f, 0x17, 0x5c, 0x63, 0xb8, 0x1e,
+		0xae, 0x6d, 0xc3, 0x11, 0x4e, 0xb1, 0xbf, 0x71, 0xfd, 0xac, 0x50, 0xac, 0x0c, 0x7b, 0xc7, 0x3b,
+		0xba, 0xdf, 0x04, 0x05, 0x9b, 0x9d, 0xc2, 0x3a, 0x36, 0xcb, 0x18, 0x1d, 0x8e, 0xa7, 0xfc, 0x2f,
+		0x38, 0x65, 0xa1, 0xf1, 0x6d, 0xf3, 0xda, 0x5c, 0x53, 0x5b, 0x48, 0xfc, 0x15, 0xc8, 0x0b, 0xe2,
+		0x1d, 0xbd, 0xad, 0x55, 0x8c, 0x9a, 0x5e, 0x7f, 0x43, 0xab, 0xf6, 0x40, 0xfa, 0x5f, 0xfa, 0x86,
+		0x6a, 0xc7, 0x85, 0x8e, 0x94, 0x57, 0x20, 0x67, 0xdb, 0x2a, 0xe5, 0x7a, 0xb3, 0x65, 0xb4, 0xad,
+		0x18, 0x8a, 0x3f, 0x21, 0x46, 0xca, 0xc6, 0x5b, 0xa1, 0x68, 0x0b, 0x25, 0x60, 0xd9, 0x1f, 0xbd,
+		0x8a, 0xe4, 0xe7, 0x38, 0xa1, 0x31, 0x07, 0x8b, 0x2b, 0x8e, 0x8a, 0xd1, 0x6c, 0xa9, 0xed, 0x5e,
+		0xf4, 0xdf, 0xbf, 0x12, 0x8a, 0x83, 0xa3, 0x70, 0xc5, 0x81, 0x16, 0x1d, 0xae, 0xf6, 0x3d, 0x50,
+		0xf8, 0x49, 0xa1, 0x38, 0x04, 0x0e, 0x27, 0x21, 0x0c, 0x86, 0x1e, 0x48, 0xfc, 0x94, 0x20, 0x21,
+		0x70, 0x90, 0xc4, 0xbb, 0x9d, 0x85, 0xb6, 0xad, 0xd5, 0xea, 0xa6, 0xd5, 0x66, 0x26, 0x79, 0x77,
+		0x52, 0x9f, 0xff, 0xaa, 0xd7, 0x08, 0x53, 0x5c, 0xa8, 0xa8, 0x89, 0xf8, 0xd6, 0x07, 0x75, 0xd9,
+		0xe2, 0x1b, 0xf6, 0xd3, 0x42, 0x13, 0xb9, 0xd0, 0xb0, 0x6d, 0x2e, 0x0b, 0x11, 0xd9, 0x5e, 0x41,
+		0x47, 0xa5, 0x07, 0x72, 0xff, 0x83, 0xaf, 0x71, 0x5b, 0x02, 0x17, 0x69, 0xba, 0xec, 0x9f, 0x8e,
+		0x7e, 0x53, 0xbb, 0xdb, 0x93, 0x74, 0xfe, 0x8c, 0xcf, 0xfe, 0xd9, 0x61, 0x98, 0x4c, 0x87, 0x4c,
+		0xf8, 0xec, 0x29, 0x12, 0x97, 0xeb, 0x97, 0xff, 0xdb, 0x5f, 0xe3, 0xfd, 0xf5, 0x9a, 0x53, 0x0b,
+		0xab, 0x90, 0xe3, 0x25, 0x8e, 0x01, 0x1b, 0x4b, 0xec, 0xa3, 0x5f, 0xb3, 0xe5, 0xdc, 0x63, 0xf3,
+		0x2c, 0x5c, 0x81, 0x31, 0x8f, 0xc1, 0x13, 0x4f, 0xea, 0x5b, 0x38, 0xa9, 0x51, 0xb7, 0xbd, 0xb3,
+		0x70, 0x01, 0x52, 0x68, 0xbc, 0xc4, 0xa3, 0x7f, 0x2b, 0x47, 0xa7, 0xe0, 0x0b, 0xcf, 0x43, 0x46,
+		0x18, 0x2d, 0xf1, 0xa8, 0x1f, 0xe3, 0xa8, 0x36, 0x0a, 0xa2, 0x0b, 0x83, 0x25, 0x1e, 0xfd, 0xbf,
+		0x15, 0xe8, 0x02, 0x05, 0xd1, 0x7b, 0x67, 0xe1, 0xbf, 0xf9, 0xef, 0x52, 0x0c, 0x5d, 0xa0, 0x2c,
+		0x60, 0xf6, 0x09, 0xb3, 0x54, 0xe2, 0xb1, 0xbf, 0x8d, 0x57, 0x2e, 0x30, 0x16, 0x2e, 0xc1, 0x50,
+		0x8f, 0x0c, 0xff, 0xef, 0x39, 0x2a, 0x83, 0x5f, 0x58, 0x82, 0x11, 0x97, 0x75, 0x12, 0x8f, 0xfe,
+		0x1d, 0x1c, 0xdd, 0x8d, 0x85, 0x4d, 0xe7, 0xd6, 0x49, 0x3c, 0x81, 0xef, 0x14, 0x4d, 0xe7, 0x18,
+		0xc8, 0x36, 0x61, 0x98, 0xc4, 0x63, 0x7f, 0x97, 0xe0, 0xba, 0x40, 0x59, 0x78, 0x11, 0xb2, 0xf6,
+		0x62, 0x13, 0x8f, 0xff, 0x77, 0x38, 0xbe, 0x83, 0x83, 0x1c, 0xe8, 0xe8, 0x7d, 0x90, 0xf8, 0xbb,
+		0x82, 0x03, 0x2e, 0x2c, 0x9c, 0x46, 0x7e, 0x03, 0x26, 0x9e, 0xd2, 0x27, 0xc4, 0x34, 0xf2, 0xd9,
+		0x2f, 0x38, 0x9a, 0x54, 0xe7, 0xc7, 0x93, 0xf8, 0x6e, 0x31, 0x9a, 0x14, 0x1e, 0x9b, 0xe1, 0xb7,
+		0x08, 0xe2, 0x69, 0xfc, 0x03, 0xd1, 0x0c, 0x9f, 0x41, 0xb0, 0xb0, 0x09, 0x24, 0x68, 0x0d, 0xc4,
+		0xd3, 0xfb, 0x1e, 0x4e, 0x6f, 0x32, 0x60, 0x0c, 0x2c, 0xbc, 0x0c, 0x47, 0xc2, 0x2d, 0x81, 0x78,
+		0xaa, 0xff, 0xf0, 0x6b, 0x3e, 0xdf, 0xcd, 0x6d, 0x08, 0x2c, 0x6c, 0xc3, 0x74, 0x98, 0x15, 0x10,
+		0x4f, 0xf6, 0x7b, 0xbf, 0xe6, 0x55, 0xdc, 0x6e, 0x23, 0x60, 0xa1, 0x08, 0xe0, 0x2c, 0xc0, 0xf1,
+		0xb4, 0xbe, 0x9f, 0xd3, 0x72, 0x21, 0xe1, 0xd4, 0xe0, 0xeb, 0x6f, 0x3c, 0xfe, 0x5b, 0x62, 0x6a,
+		0x70, 0x0c, 0x9c, 0x1a, 0x62, 0xe9, 0x8d, 0xc7, 0xfe, 0xa4, 0x98, 0x1a, 0x02, 0x05, 0x25, 0xdb,
+		0xb5, 0xba, 0xc5, 0x53, 0xf8, 0xb4, 0x90, 0x6c, 0x17, 0xd6, 0xc2, 0x3a, 0x4c, 0x06, 0x16, 0xc4,
+		0x78, 0x52, 0x3f, 0xc0, 0x49, 0xe5, 0xfc, 0xeb, 0xa1, 0x7b, 0xf1, 0xe2, 0x8b, 0x61, 0x3c, 0xb5,
+		0x1f, 0xf4, 0x2d, 0x5e, 0x7c, 0x2d, 0x5c, 0xb8, 0x0c, 0x19, 0xbd, 0xd3, 0x68, 0xe0, 0xe4, 0x21,
+		0xdd, 0xf3, 0x73, 0xf3, 0xff, 0xfb, 0xd7, 0x39, 0x77, 0x04, 0xc2, 0xc2, 0x05, 0x18, 0xd2, 0x9a,
+		0xbb, 0x5a, 0x35, 0x0e, 0xf3, 0x0f, 0xbe, 0x2e, 0x14, 0x26, 0x42, 0x2f, 0xbc, 0x08, 0xc0, 0x42,
+		0x23, 0x74, 0xe3, 0x3c, 0x06, 0xf7, 0x0f, 0xbf, 0xce, 0x13, 0xe2, 0x1c, 0x14, 0x87, 0x00, 0x4b,
+		0xaf, 0xeb, 0x4e, 0xe0, 0xab, 0x5e, 0x02, 0x74, 0x44, 0x9e, 0x85, 0x61, 0xdc, 0x48, 0xb3, 0xd4,
+		0x5a, 0x1c, 0xf6, 0x1f, 0x71, 0x6c, 0x01, 0x8f, 0x0c, 0x6b, 0x1a, 0x6d, 0xcd, 0x52, 0x6b, 0x66,
+		0x1c, 0xee, 0xff, 0xc1, 0x71, 0x6d, 0x04, 0x44, 0xae, 0xa8, 0xa6, 0xd5, 0x4b, 0xbf, 0xff, 0xb3,
+		0x40, 0x16, 0x08, 0xd8, 0x68, 0xfc, 0x7d, 0x53, 0xbb, 0x1b, 0x87, 0xfb, 0xc7, 0xa2, 0xd1, 0x1c,
+		0x7e, 0xe1, 0x79, 0xc8, 0xe2, 0x4f, 0x96, 0xe5, 0x1a, 0x83, 0xfc, 0x27, 0x1c, 0xd9, 0xc1, 0xc0,
+		0x9a, 0x4d, 0xab, 0x6a, 0xd5, 0xe3, 0x99, 0xfd, 0xa7, 0x7c, 0xa4, 0x05, 0xfc, 0x42, 0x11, 0x46,
+		0x4c, 0xab, 0x5a, 0xed, 0x70, 0xfb, 0x34, 0x06, 0xfd, 0xff, 0xfc, 0xba, 0x1d, 0xb2, 0xb0, 0x71,
+		0x70, 0xb4, 0x6f, 0xdf, 0xb4, 0x5a, 0x06, 0xdd, 0x6f, 0x89, 0xa3, 0xf0, 0x35, 0x4e, 0xc1, 0x85,
+		0xb2, 0xb0, 0x04, 0xa3, 0xd8, 0x97, 0xb6, 0xd6, 0xd2, 0xe8, 0xe6, 0x58, 0x0c, 0x89, 0x3f, 0xe3,
+		0x0c, 0xf0, 0x20, 0x2d, 0xbe, 0xff, 0x17, 0xbf, 0x78, 0x4a, 0xfa, 0xd5, 0x2f, 0x9e, 0x92, 0x7e,
+		0xf7, 0x8b, 0xa7, 0xa4, 0xef, 0xfa, 0xd2, 0xa9, 0x43, 0xbf, 0xfa, 0xa5, 0x53, 0x87, 0x7e, 0xeb,
+		0x4b, 0xa7, 0x0e, 0x85, 0x47, 0x89, 0xe1, 0xaa, 0x71, 0xd5, 0x60, 0xf1, 0xe1, 0xd7, 0x1e, 0xae,
+		0xd5, 0xad, 0xfd, 0xce, 0xee, 0x5c, 0xc5, 0x68, 0xce, 0x57, 0x0c, 0xb3, 0x69, 0x98, 0xf3, 0xde,
+		0xb8, 0x2e, 0xfd, 0x05, 0xff, 0x8f, 0x04, 0xc7, 0x18, 0x19, 0x27, 0x9c, 0xab, 0xea, 0x77, 0xa3,
+		0x0e, 0xd3, 0x5d, 0x84, 0x64, 0x51, 0xbf, 0x4b, 0x8e, 0x31, 0x05, 0x57, 0xee, 0xb4, 0x1b, 0x3c,
+		0xd5, 0x72, 0x18, 0x9f, 0x77, 0xda, 0x0d, 0x0c, 0xb3, 0x8b, 0x7c, 0x68, 0xdc, 0xce, 0x61, 0x0f,
+		0x8b, 0xdf, 0x21, 0xf5, 0xd7, 0x93, 0x4c, 0x51, 0xbf, 0x4b, 0x3b, 0xb2, 0x29, 0xbd, 0xf6, 0x78,
+		0x6c, 0x9c, 0xfb, 0xa6, 0x6e, 0xdc, 0xd6, 0xb1, 0xd9, 0xad, 0x5d, 0x11, 0xe3, 0x3e, 0xe5, 0x8f,
+		0x71, 0xbf, 0xac, 0x35, 0x1a, 0x2f, 0x21, 0x1c, 0xa6, 0x2f, 0x98, 0xbb, 0x69, 0x96, 0xd5, 0x0f,
+		0x9f, 0x48, 0xc0, 0xa9, 0x40, 0x38, 0x9b, 0x0b, 0x41, 0x14, 0x13, 0x16, 0x20, 0xb3, 0x2c, 0x64,
+		0x2b, 0x8f, 0x47, 0xd9, 0x2a, 0x86, 0x5e, 0x35, 0x29, 0x23, 0x92, 0x8a, 0x78, 0x44, 0x46, 0xe8,
+		0xaa, 0x6e, 0x98, 0x3c, 0x59, 0x99, 0x3d, 0x2c, 0x7e, 0x5f, 0x9f, 0x8c, 0x18, 0x13, 0x35, 0x09,
+		0x6e, 0x3c, 0xd9, 0x23, 0x37, 0x44, 0x27, 0x3c, 0x91, 0xff, 0x5e, 0xb9, 0xf2, 0x0f, 0x12, 0x30,
+		0xe3, 0xe7, 0x0a, 0xce, 0x2c, 0xd3, 0x52, 0x9b, 0xad, 0x28, 0xb6, 0x5c, 0x86, 0xec, 0xb6, 0x80,
+		0xe9, 0x9b, 0x2f, 0x6f, 0xf5, 0xc9, 0x97, 0x71, 0xbb, 0x2a, 0xc1, 0x98, 0xf3, 0x3d, 0x32, 0xc6,
+		0xee, 0xc7, 0x81, 0x38, 0xf3, 0x5f, 0xd2, 0x70, 0x8c, 0x4d, 0xa7, 0x32, 0x9b, 0x4a, 0xec, 0x81,
+		0xf3, 0x64, 0xd4, 0xfd, 0x2a, 0x7e, 0x9f, 0x44, 0x7e, 0x09, 0xa6, 0x56, 0x50, 0x5b, 0xa0, 0x17,
+		0xe4, 0xec, 0xf0, 0x84, 0xe6, 0x73, 0xcf, 0x7a, 0x0c, 0x7e, 0xbe, 0xbf, 0xe5, 0x2e, 0x92, 0xff,
+		0xb6, 0x04, 0xb9, 0xad, 0x8a, 0xda, 0x50, 0xdb, 0xf7, 0x4a, 0x8a, 0x5c, 0x02, 0x60, 0xe9, 0x1e,
+		0xf6, 0xc1, 0xbd, 0xf1, 0xf3, 0xf9, 0x39, 0x77, 0xe7, 0xe6, 0x58, 0x4d, 0x34, 0x83, 0x2a, 0x4b,
+		0x61, 0xf1, 0xe7, 0x99, 0x57, 0x00, 0x9c, 0x17, 0xe4, 0x38, 0x1c, 0xdd, 0x5a, 0x2a, 0xae, 0x16,
+		0x15, 0x91, 0x24, 0xb4, 0xb5, 0x59, 0x5a, 0x5a, 0xb9, 0xb2, 0x52, 0x5a, 0xce, 0x1d, 0xc2, 0xfc,
+		0x1a, 0xf7, 0x4b, 0x3b, 0xa9, 0xe9, 0x30, 0x4c, 0xba, 0xcb, 0xd9, 0x29, 0x95, 0x04, 0x5a, 0x8a,
+		0xf5, 0x66, 0xab, 0xa1, 0xd1, 0x9d, 0xc7, 0x72, 0x5d, 0x70, 0x2d, 0xde, 0x08, 0xf9, 0xa5, 0xdf,
+		0x64, 0x27, 0x17, 0xa6, 0x1c, 0x74, 0x9b, 0xe7, 0x0b, 0xab, 0x30, 0x89, 0xb9, 0x94, 0x2d, 0x0f,
+		0xc9, 0x18, 0x55, 0x8d, 0x04, 0xe9, 0x5e, 0x2a, 0xc7, 0x74, 0xa8, 0x5d, 0x82, 0xb4, 0x49, 0x7b,
+		0x1f, 0x47, 0xe2, 0x0b, 0x9c, 0x04, 0x07, 0x5f, 0xd0, 0x61, 0x12, 0x2d, 0x3f, 0x0c, 0x10, 0x39,
+		0xcd, 0xe8, 0x1e, 0x67, 0xf8, 0xd7, 0x3f, 0xf1, 0x04, 0xdd, 0x59, 0x7d, 0xc0, 0x3b, 0x2c, 0x21,
+		0xe2, 0xa4, 0xe4, 0x38, 0x6d, 0xa7, 0xa1, 0x1a, 0x8c, 0x8b, 0xfa, 0x78, 0x83, 0xbb, 0x57, 0xf6,
+		0x73, 0xbc, 0xb2, 0x53, 0x61, 0x32, 0xe0, 0xaa, 0x69, 0x8c, 0x53, 0x65, 0x2f, 0x16, 0x4b, 0x51,
+		0x73, 0xfa, 0xb5, 0xb3, 0xc1, 0xd5, 0x89, 0xfd, 0x3b, 0x47, 0x29, 0x5f, 0x76, 0x57, 0x63, 0xcf,
+		0xbd, 0xef, 0x4b, 0xc1, 0x24, 0xa6, 0xff, 0x1b, 0xf3, 0xf4, 0x2f, 0x9f, 0x73, 0x43, 0xf4, 0xa1,
+		0x87, 0x4d, 0xc9, 0x8b, 0x6c, 0x2a, 0xc4, 0x4b, 0xcc, 0x9f, 0x7c, 0xfb, 0x0f, 0x0d, 0x39, 0xd3,
+		0x65, 0x61, 0x0d, 0x72, 0x22, 0x89, 0x57, 0xd3, 0x2b, 0x46, 0xb5, 0xa7, 0x28, 0xc5, 0x9f, 0x0a,
+		0x1a, 0x22, 0xbe, 0x55, 0xe2, 0xa8, 0x0b, 0xcf, 0xe1, 0x4e, 0x1f, 0x27, 0x13, 0x67, 0x99, 0x08,
+		0x22, 0x36, 0x06, 0xda, 0x25, 0x6c, 0x66, 0xf6, 0x62, 0x85, 0x7e, 0x4d, 0xe0, 0xb3, 0x19, 0x8a,
+		0x19, 0x12, 0x0b, 0x57, 0x61, 0xbc, 0x6a, 0xe8, 0x56, 0xd9, 0x68, 0xd6, 0x2d, 0xad, 0xd9, 0xb2,
+		0x62, 0xed, 0xba, 0x3f, 0x63, 0x44, 0x32, 0xca, 0x18, 0xe2, 0x6d, 0x08, 0x34, 0x6c, 0x09, 0x3b,
+		0xa7, 0xd7, 0x4b, 0x4b, 0xfe, 0xdc, 0x6e, 0x09, 0xc5, 0xc1, 0x96, 0x1c, 0x48, 0x3a, 0xcc, 0xea,
+		0x4d, 0xae, 0xcc, 0xad, 0x3b, 0x4c, 0x0a, 0x6c, 0xe9, 0xf8, 0xcf, 0x49, 0x38, 0xc5, 0x81, 0x77,
+		0x55, 0x53, 0x9b, 0xbf, 0xf5, 0xe4, 0xae, 0x66, 0xa9, 0x4f, 0xce, 0x57, 0x8c, 0xba, 0x58, 0xc9,
+		0xa7, 0xd8, 0xfb, 0x39, 0x7c, 0x3f, 0xc7, 0xdf, 0x17, 0x42, 0xb7, 0xbb, 0x0b, 0xd1, 0x4a, 0xbe,
+		0x10, 0x94, 0x41, 0xf9, 0x35, 0x48, 0x2d, 0x19, 0x75, 0x1d, 0xd7, 0xb6, 0xaa, 0xa6, 0x1b, 0x4d,
+		0xae, 0x6e, 0xd9, 0x03, 0xb9, 0x08, 0x69, 0xb5, 0x69, 0x74, 0x74, 0x8b, 0xa9, 0xda, 0xc5, 0x53,
+		0xbf, 0xf8, 0xf6, 0xcc, 0xa1, 0xdf, 0x7e, 0x7b, 0x26, 0xb9, 0xa2, 0x5b, 0xbf, 0xf6, 0xb9, 0x73,
+		0xc0, 0xa9, 0xaf, 0xe8, 0xd6, 0x0f, 0x7f, 0xf9, 0xb3, 0x67, 0x24, 0x85, 0x43, 0x2f, 0xa4, 0xbe,
+		0xf2, 0xa9, 0x19, 0x49, 0x7e, 0x05, 0x86, 0x97, 0xb5, 0x4a, 0x17, 0xf2, 0x4f, 0xfa, 0xc8, 0x1f,
+		0x13, 0xe4, 0x97, 0xb5, 0x8a, 0x8b, 0xfc, 0xb2, 0x56, 0xf1, 0x51, 0xbe, 0x04, 0x99, 0x15, 0xdd,
+		0x62, 0x47, 0x88, 0xce, 0x42, 0xb2, 0xae, 0xb3, 0xac, 0x74, 0x17, 0x85, 0x40, 0x03, 0x15, 0x84,
+		0x42, 0xc4, 0x65, 0xad, 0x62, 0x23, 0x56, 0xb5, 0x4a, 0x5e, 0x8a, 0xab, 0x1a, 0xa1, 0x16, 0x97,
+		0x7f, 0xeb, 0xf7, 0x4e, 0x1d, 0xfa, 0xf0, 0x17, 0x4f, 0x1d, 0x8a, 0x1c, 0x7a, 0x39, 0x7e, 0xe8,
+		0xed, 0x11, 0xff, 0xa1, 0x14, 0x9c, 0xa4, 0x27, 0x4b, 0xdb, 0xcd, 0xba, 0x6e, 0xcd, 0x57, 0xda,
+		0x77, 0x5b, 0x96, 0x81, 0x0a, 0xc0, 0xd8, 0xe3, 0x03, 0x3e, 0xe9, 0xbc, 0x9e, 0x63, 0xaf, 0xc3,
+		0x87, 0x5b, 0xde, 0x83, 0xa1, 0x4d, 0xc4, 0x43, 0x16, 0x5b, 0x86, 0xa5, 0x36, 0xb8, 0xd5, 0xc2,
+		0x1e, 0xb0, 0x94, 0x9d, 0x46, 0x4d, 0xb0, 0xd2, 0xba, 0x38, 0x88, 0xda, 0xd0, 0xd4, 0x3d, 0x76,
+		0xa8, 0x27, 0x49, 0xcd, 0xdd, 0x0c, 0x16, 0xd0, 0xf3, 0x3b, 0xd3, 0x30, 0xa4, 0x76, 0x58, 0xe2,
+		0x4d, 0x12, 0xed, 0x60, 0xfa, 0x20, 0xbf, 0x04, 0xc3, 0x7c, 0xff, 0x1d, 0x33, 0x4f, 0x6e, 0x6a,
+		0x77, 0x69, 0x3d, 0xa3, 0x0a, 0xfe, 0x24, 0x73, 0x30, 0x44, 0x1b, 0xcf, 0x4f, 0x2b, 0xe6, 0xe7,
+		0x02, 0xad, 0x9f, 0xa3, 0x8d, 0x54, 0x18, 0x98, 0x7c, 0x1d, 0x32, 0xcb, 0x06, 0x4a, 0xa1, 0x97,
+		0x5a, 0x96, 0x51, 0xa3, 0x6d, 0x6e, 0x75, 0xb8, 0x54, 0x28, 0xec, 0x01, 0x53, 0xd2, 0xd9, 0x21,
+		0x2f, 0x9e, 0x3c, 0xc4, 0x9f, 0xe4, 0x25, 0x18, 0xa6, 0xb4, 0x37, 0x5a, 0x68, 0x32, 0xd8, 0x79,
+		0xef, 0x59, 0x7e, 0xe4, 0x97, 0x93, 0x4f, 0x38, 0x8d, 0x25, 0x90, 0xc2, 0x83, 0x1c, 0xbc, 0xdf,
+		0xf4, 0xb7, 0xfc, 0x02, 0x64, 0x38, 0x11, 0x93, 0x9c, 0x87, 0xa4, 0xd1, 0x32, 0x79, 0xfa, 0x4f,
+		0x21, 0xaa, 0x2b, 0x1b, 0xad, 0xc5, 0x14, 0xca, 0x8c, 0x82, 0xc0, 0x8b, 0xeb, 0x91, 0x62, 0xf1,
+		0xb4, 0x47, 0x2c, 0x9a, 0x9a, 0xb5, 0xbb, 0x67, 0x39, 0x3f, 0xd8, 0x70, 0x06, 0x44, 0xc1, 0x16,
+		0x94, 0xb7, 0x12, 0x70, 0xca, 0xf5, 0xf6, 0x96, 0xd6, 0xc6, 0x00, 0x14, 0x93, 0x26, 0x2e, 0x29,
+		0xc4, 0xd5, 0x40, 0xfe, 0x3e, 0x42, 0x54, 0x9e, 0x87, 0x64, 0xb1, 0xd5, 0xc2, 0x73, 0xce, 0xf4,
+		0xb9, 0x62, 0x30, 0x59, 0x49, 0x29, 0xf6, 0x33, 0xbe, 0x33, 0x8d, 0x3d, 0xeb, 0xb6, 0xda, 0xb6,
+		0xcf, 0x40, 0x8b, 0x67, 0xf9, 0x59, 0xc8, 0x2e, 0x19, 0xba, 0xa9, 0xe9, 0x66, 0x87, 0xda, 0xc2,
+		0xbb, 0x0d, 0xa3, 0x72, 0x93, 0x53, 0x60, 0x0f, 0xc8, 0x6c, 0xb5, 0xd5, 0xa2, 0x98, 0x29, 0x05,
+		0x7f, 0xb2, 0xf9, 0xba, 0xb8, 0x11, 0xc9, 0x9e, 0x0b, 0xfd, 0xb1, 0x87, 0x77, 0xd0, 0x31, 0x6a,
+		0x25, 0x38, 0x11, 0x9c, 0x48, 0x37, 0xb5, 0xbb, 0x66, 0xbf, 0xf3, 0xe8, 0x15, 0xc8, 0x6e, 0xd2,
+		0xeb, 0x49, 0x5e, 0xd2, 0xee, 0x92, 0x02, 0xde, 0x61, 0x71, 0xfe, 0xc2, 0x85, 0x27, 0x9f, 0x65,
+		0x52, 0x7e, 0xed, 0x90, 0x22, 0x0a, 0xc8, 0x29, 0xc8, 0x9a, 0x5a, 0xa5, 0x75, 0xfe, 0xc2, 0xc5,
+		0x9b, 0x4f, 0x32, 0xb1, 0xba, 0x76, 0x48, 0x71, 0x8a, 0x16, 0x32, 0xd8, 0xe3, 0xaf, 0x7c, 0x7a,
+		0x46, 0x5a, 0x1c, 0x82, 0xa4, 0xd9, 0x69, 0xde, 0x37, 0xd9, 0xf8, 0xfb, 0x43, 0x30, 0xeb, 0x7a,
+		0xcb, 0x16, 0x97, 0x5b, 0x6a, 0xa3, 0x5e, 0x55, 0x9d, 0x4b, 0x65, 0x72, 0xae, 0xfe, 0x53, 0x88,
+		0x88, 0x55, 0xa3, 0x2b, 0x17, 0xe5, 0x7f, 0x29, 0xc1, 0xe8, 0x0d, 0x41, 0x19, 0x6f, 0xa1, 0xb9,
+		0x0c, 0x60, 0xd7, 0x24, 0xa6, 0xca, 0xf1, 0x39, 0x7f, 0x5d, 0x73, 0x36, 0x8e, 0xe2, 0x02, 0x27,
+		0x97, 0xa8, 0x00, 0xb6, 0x0c, 0x93, 0x9f, 0x87, 0x8d, 0x41, 0xb5, 0x81, 0x31, 0x91, 0x93, 0x6a,
+		0xb5, 0xf2, 0x2d, 0xc3, 0xc2, 0xd4, 0x92, 0x96, 0x71, 0x9b, 0xdf, 0x32, 0x90, 0x54, 0x72, 0xf4,
+		0xcd, 0x0d, 0xfa, 0x62, 0x13, 0xcb, 0xb1, 0xd1, 0x59, 0x9b, 0x0a, 0xba, 0x75, 0x6a, 0xb5, 0xda,
+		0xd6, 0x4c, 0x93, 0x2b, 0x2e, 0xf1, 0x88, 0x87, 0x70, 0x5b, 0x9d, 0xdd, 0xb2, 0xd0, 0x12, 0x78,
+		0x8c, 0x39, 0x64, 0xce, 0x0b, 0xd9, 0xe0, 0xb3, 0x3e, 0xdd, 0xea, 0xec, 0xa2, 0xa4, 0x3c, 0x00,
+		0xa3, 0x21, 0x8d, 0x19, 0xb9, 0xe5, 0xb4, 0x83, 0xde, 0x88, 0xc3, 0x7b, 0x50, 0x6e, 0xb5, 0xeb,
+		0x46, 0xbb, 0x6e, 0xdd, 0xa5, 0x69, 0x7b, 0x49, 0x25, 0x27, 0x5e, 0x6c, 0xf2, 0x72, 0xf9, 0x26,
+		0x4c, 0x6c, 0x51, 0x73, 0xdf, 0x69, 0xf9, 0x05, 0xa7, 0x7d, 0x52, 0x7c, 0xfb, 0x22, 0x5b, 0x96,
+		0x08, 0xb4, 0x6c, 0x71, 0x2d, 0x52, 0x32, 0x9f, 0xea, 0x4f, 0x32, 0xbd, 0xab, 0xdb, 0x1f, 0x1e,
+		0x83, 0x13, 0xfe, 0x97, 0x1e, 0x95, 0xd5, 0xab, 0x50, 0xc6, 0x79, 0xf2, 0x85, 0xee, 0x8b, 0x68,
+		0x21, 0x46, 0x75, 0x16, 0x62, 0xa7, 0x8f, 0xfc, 0x2c, 0x8c, 0x61, 0xf2, 0xed, 0x96, 0x66, 0x5d,
+		0xd3, 0xd4, 0xaa, 0xd6, 0xf6, 0xae, 0xb2, 0x63, 0x62, 0x95, 0x25, 0x90, 0xa2, 0x4b, 0x29, 0x5b,
+		0x65, 0xe8, 0x6f, 0x79, 0x1f, 0x52, 0x88, 0xea, 0xac, 0xc0, 0x1c, 0x83, 0x3e, 0x60, 0xe9, 0xee,
+		0x5d, 0x4b, 0x33, 0x39, 0x0a, 0x7b, 0x20, 0x4f, 0x8b, 0x75, 0x34, 0xd9, 0x7d, 0x1d, 0xe5, 0x42,
+		0xc8, 0x57, 0xd3, 0x06, 0x0c, 0x2f, 0xa2, 0xfa, 0x5d, 0x59, 0xb6, 0x1b, 0x22, 0x39, 0x0d, 0x21,
+		0x6b, 0x30, 0xd1, 0x52, 0xdb, 0x16, 0x3d, 0xc7, 0xb7, 0x4f, 0x7b, 0xc1, 0xe5, 0x7c, 0x26, 0x38,
+		0xeb, 0x3c, 0x9d, 0xe5, 0xb5, 0x8c, 0xb5, 0xdc, 0x85, 0xf2, 0xff, 0x96, 0x82, 0x34, 0xfb, 0x49,
+		0x9e, 0x87, 0x61, 0xce, 0x56, 0x2e, 0x99, 0x27, 0xe7, 0x82, 0x8b, 0xd1, 0x9c, 0xbd, 0x68, 0x70,
+		0x7a, 0x02, 0x87, 0x3c, 0x02, 0x99, 0xca, 0xbe, 0x5a, 0xd7, 0xcb, 0xf5, 0x2a, 0x37, 0x00, 0x47,
+		0xbe, 0xf8, 0xf6, 0xcc, 0xf0, 0x12, 0x96, 0xad, 0x2c, 0x2b, 0xc3, 0xf4, 0xe5, 0x4a, 0x15, 0x57,
+		0xfe, 0x7d, 0xad, 0x5e, 0xdb, 0xb7, 0xf8, 0xec, 0xe2, 0x4f, 0x78, 0x15, 0x16, 0x0a, 0x04, 0x3f,
+		0xe5, 0x5d, 0x08, 0xd8, 0xf1, 0x76, 0xa0, 0x65, 0x31, 0x83, 0x15, 0x7f, 0xd7, 0xef, 0xcc, 0x48,
+		0x0a, 0xc5, 0x20, 0x4b, 0x30, 0xd6, 0x50, 0x4d, 0xab, 0x4c, 0x57, 0x2d, 0xac, 0x7e, 0x88, 0x92,
+		0x38, 0x16, 0x64, 0x08, 0x67, 0x2c, 0x6f, 0xfa, 0x08, 0x62, 0xb1, 0xa2, 0x2a, 0x1e, 0x42, 0xa5,
+		0x44, 0x30, 0xe7, 0xb8, 0x6e, 0x31, 0x5b, 0x2a, 0x4d, 0xf9, 0x3e, 0x8e, 0xe5, 0x4b, 0xb4, 0x98,
+		0x5a, 0x54, 0xc7, 0x21, 0x4b, 0xcf, 0x95, 0x52, 0x10, 0x96, 0x2c, 0x9e, 0xc1, 0x02, 0xfa, 0xf2,
+		0x51, 0x98, 0x70, 0x74, 0x23, 0x03, 0xc9, 0x30, 0x2a, 0x4e, 0x31, 0x05, 0x7c, 0x02, 0xa6, 0x75,
+		0xed, 0x8e, 0x55, 0x76, 0x8a, 0x19, 0x74, 0x96, 0x42, 0x13, 0x7c, 0x77, 0xc3, 0x8b, 0xf1, 0x30,
+		0x8c, 0x57, 0x04, 0xf3, 0x19, 0x2c, 0x50, 0xd8, 0x31, 0xbb, 0x94, 0x82, 0x1d, 0x83, 0x8c, 0xda,
+		0x6a, 0x31, 0x80, 0x11, 0xae, 0x1b, 0x5b, 0x2d, 0xfa, 0xea, 0x0c, 0x4c, 0xd2, 0x3e, 0xb6, 0x35,
+		0xb3, 0xd3, 0xb0, 0x38, 0x91, 0x51, 0x0a, 0x33, 0x81, 0x2f, 0x14, 0x56, 0x4e, 0x61, 0x1f, 0x84,
+		0x31, 0xed, 0x16, 0x66, 0xea, 0x57, 0x34, 0x06, 0x37, 0x46, 0xe1, 0x46, 0x45, 0x21, 0x05, 0x7a,
+		0x0c, 0x6c, 0x9d, 0x57, 0x16, 0xfa, 0x78, 0x9c, 0xd1, 0x13, 0xe5, 0x45, 0x56, 0x2c, 0xe7, 0x21,
+		0xb5, 0xac, 0x5a, 0x2a, 0x1a, 0x15, 0xd6, 0x1d, 0xb6, 0xc8, 0x8c, 0x2a, 0xf8, 0x53, 0xfe, 0x4a,
+		0x02, 0x52, 0x37, 0x0c, 0x4b, 0x23, 0x4f, 0xb9, 0x0c, 0xbe, 0xf1, 0x30, 0x79, 0xde, 0xaa, 0xd7,
+		0x74, 0xad, 0xba, 0x66, 0xd6, 0x5c, 0x97, 0xc0, 0x38, 0xe2, 0x94, 0xf0, 0x88, 0xd3, 0x34, 0x0c,
+		0xb5, 0x8d, 0x8e, 0x5e, 0x15, 0x69, 0xd6, 0xf4, 0x81, 0x94, 0x20, 0x63, 0x4b, 0x49, 0x2a, 0x4e,
+		0x4a, 0x26, 0x50, 0x4a, 0x50, 0x86, 0x79, 0x81, 0x32, 0xbc, 0xcb, 0x85, 0x65, 0x11, 0xb2, 0xb6,
+		0xf2, 0xca, 0x0f, 0xf5, 0x21, 0xb0, 0x0e, 0x1a, 0x2e, 0x24, 0xf6, 0xd8, 0xdb, 0xcc, 0x63, 0x12,
+		0x97, 0xb3, 0x5f, 0x70, 0xee, 0x79, 0xc4, 0x8a, 0x5f, 0x48, 0x33, 0x4c, 0xfb, 0xe5, 0x88, 0x15,
+		0xbb, 0x94, 0xe6, 0x04, 0xe6, 0xad, 0xd5, 0x74, 0xd5, 0xea, 0xb4, 0x35, 0x2e, 0x79, 0x4e, 0x81,
+		0xfc, 0x6f, 0x24, 0x48, 0x33, 0x49, 0x76, 0xf1, 0x4d, 0x0a, 0xe7, 0x5b, 0x22, 0x8a, 0x6f, 0xc9,
+		0x83, 0xf3, 0xad, 0x08, 0x60, 0x37, 0xc6, 0xe4, 0xf7, 0x84, 0x84, 0x58, 0x0b, 0xac, 0x89, 0x5b,
+		0xf5, 0x1a, 0x9f, 0xa8, 0x2e, 0x24, 0xf9, 0x3f, 0x49, 0x90, 0xb5, 0xdf, 0x93, 0x22, 0x8c, 0x89,
+		0x76, 0x95, 0xf7, 0x1a, 0x6a, 0x8d, 0xcb, 0xce, 0xc9, 0xc8, 0xc6, 0x5d, 0x69, 0xa8, 0x35, 0x65,
+		0x84, 0xb7, 0x07, 0x1f, 0xc2, 0xc7, 0x21, 0x11, 0x31, 0x0e, 0x9e, 0x81, 0x4f, 0x1e, 0x6c, 0xe0,
+		0x3d, 0x43, 0x94, 0xf2, 0x0f, 0xd1, 0x4f, 0x24, 0xa8, 0xf3, 0xd2, 0x32, 0x4c, 0xb5, 0xf1, 0x8d,
+		0x98, 0x11, 0xc7, 0x21, 0xdb, 0x32, 0x1a, 0x65, 0xf6, 0x86, 0x1d, 0x3f, 0xc8, 0xb4, 0x8c, 0x86,
+		0x12, 0x18, 0xf6, 0xa1, 0x01, 0x4d, 0x97, 0xf4, 0x00, 0xb8, 0x36, 0xec, 0xe7, 0x5a, 0x1b, 0x46,
+		0x19, 0x2b, 0xf8, 0x5a, 0xf6, 0x04, 0xf2, 0x00, 0x7f, 0xe5, 0xa5, 0xe0, 0xda, 0xcb, 0x9a, 0xcd,
+		0x20, 0x95, 0xf4, 0xbe, 0x8d, 0xc1, 0x54, 0x7f, 0x3e, 0x11, 0x85, 0xc1, 0xc4, 0x4e, 0xe1, 0x70,
+		0xf2, 0xdf, 0x97, 0x00, 0x56, 0x91, 0xb3, 0xb4, 0xbf, 0xb8, 0x0a, 0x99, 0xb4, 0x09, 0x65, 0x4f,
+		0xcd, 0xa7, 0xa2, 0x06, 0x8d, 0xd7, 0x3f, 0x6a, 0xba, 0xdb, 0xbd, 0x04, 0x63, 0x8e, 0x30, 0x9a,
+		0x9a, 0x68, 0xcc, 0xa9, 0x2e, 0x16, 0xf5, 0x96, 0x66, 0x29, 0xa3, 0xb7, 0x5c, 0x4f, 0xf2, 0xff,
+		0x28, 0x41, 0x96, 0xb6, 0x09, 0x6f, 0x39, 0xf0, 0x8c, 0xa1, 0x74, 0xf0, 0x31, 0x3c, 0x09, 0xc0,
+		0xc8, 0xe0, 0x2e, 0x3e, 0x97, 0xac, 0x2c, 0x2d, 0xc1, 0xbd, 0x79, 0x8c, 0x2d, 0xf1, 0x6e, 0x27,
+		0xbb, 0x33, 0x5c, 0x58, 0xdc, 0x9c, 0xed, 0x47, 0x61, 0x98, 0xde, 0xab, 0x77, 0xc7, 0xe4, 0x46,
+		0x34, 0x5e, 0xa6, 0xb3, 0x7d, 0xc7, 0x94, 0x3f, 0x00, 0xc3, 0xdb, 0x77, 0x58, 0x2c, 0xe4, 0x38,
+		0x64, 0xdb, 0x86, 0xc1, 0xd7, 0x64, 0x66, 0x0b, 0x65, 0xb0, 0x80, 0x2e, 0x41, 0xc2, 0xff, 0x4f,
+		0x38, 0xfe, 0xbf, 0x13, 0xc0, 0x48, 0xf6, 0x14, 0xc0, 0x38, 0xf3, 0x1f, 0x25, 0x18, 0x71, 0xe9,
+		0x07, 0xf2, 0x24, 0x1c, 0x5e, 0x5c, 0xdd, 0x58, 0x7a, 0xa9, 0xbc, 0xb2, 0x5c, 0xbe, 0xb2, 0x5a,
+		0xbc, 0xea, 0x9c, 0xb0, 0x2b, 0x1c, 0x79, 0xf3, 0xad, 0x59, 0xe2, 0x82, 0xdd, 0xd1, 0xe9, 0x6e,
+		0x0e, 0x99, 0x87, 0x69, 0x2f, 0x4a, 0x71, 0x71, 0x0b, 0x8f, 0xdb, 0x49, 0x85, 0xc3, 0x6f, 0xbe,
+		0x35, 0x3b, 0xe9, 0xc2, 0x28, 0xee, 0x9a, 0x9a, 0x6e, 0x05, 0x11, 0x96, 0x36, 0xd6, 0xd6, 0x56,
+		0xb6, 0x73, 0x89, 0x00, 0x02, 0x57, 0xd8, 0x8f, 0xc1, 0xa4, 0x17, 0x61, 0x7d, 0x65, 0x35, 0x97,
+		0x2c, 0x90, 0x37, 0xdf, 0x9a, 0x1d, 0x77, 0x41, 0xaf, 0xd7, 0x1b, 0x85, 0xcc, 0xc7, 0x7f, 0xf0,
+		0xd4, 0xa1, 0x1f, 0xfe, 0xc7, 0xa7, 0x24, 0xec, 0xd9, 0x98, 0x47, 0x47, 0x90, 0xc7, 0xe1, 0xe8,
+		0xd6, 0xca, 0xd5, 0xf5, 0xd2, 0x72, 0x79, 0x6d, 0xeb, 0xaa, 0xef, 0xd0, 0x74, 0x61, 0xe2, 0xcd,
+		0xb7, 0x66, 0x47, 0x78, 0x97, 0xa2, 0xa0, 0x37, 0x95, 0xd2, 0x8d, 0x8d, 0xed, 0x52, 0x4e, 0x62,
+		0xd0, 0x9b, 0x6d, 0xed, 0x96, 0x61, 0xb1, 0x2b, 0x39, 0x9f, 0x80, 0x63, 0x21, 0xd0, 0x76, 0xc7,
+		0x26, 0xdf, 0x7c, 0x6b, 0x76, 0x6c, 0xb3, 0xad, 0xb1, 0xf9, 0x43, 0x31, 0xe6, 0x20, 0x1f, 0xc4,
+		0xd8, 0xd8, 0xdc, 0xd8, 0x2a, 0xae, 0xe6, 0x66, 0x0b, 0xb9, 0x37, 0xdf, 0x9a, 0x1d, 0x15, 0xca,
+		0x10, 0xe1, 0x9d, 0x9e, 0xdd, 0x2f, 0x6f, 0xe7, 0x63, 0xc3, 0x70, 0xd2, 0xff, 0x72, 0xbe, 0xa5,
+		0xb6, 0xd5, 0x66, 0xbf, 0xee, 0x4e, 0xcc, 0x76, 0xae, 0xfc, 0xe1, 0x04, 0x4c, 0xd8, 0xc6, 0xf4,
+		0x26, 0xad, 0x81, 0x3c, 0xe5, 0x8e, 0xc3, 0x8c, 0x44, 0x2e, 0x63, 0x0c, 0x5a, 0x84, 0x69, 0x30,
+		0x90, 0xcf, 0x8d, 0x32, 0xae, 0x2e, 0x66, 0x83, 0x78, 0x25, 0x0e, 0xc1, 0x51, 0x6d, 0x0c, 0xf2,
+		0x22, 0x64, 0x6d, 0xe5, 0x61, 0x5f, 0x5b, 0x15, 0xad, 0x6d, 0x38, 0xbe, 0x83, 0x83, 0x37, 0x49,
+		0x09, 0xb7, 0x21, 0x15, 0xe5, 0x88, 0xdc, 0x60, 0x00, 0x1c, 0x59, 0xc0, 0xcb, 0x2b, 0x7c, 0xda,
+		0xf1, 0xde, 0xd3, 0x2b, 0x3f, 0xee, 0x94, 0x99, 0x27, 0xc5, 0xac, 0x92, 0x4c, 0x53, 0xbd, 0xb3,
+		0x88, 0xcf, 0xa8, 0x28, 0xf0, 0x65, 0x8d, 0x1f, 0x2a, 0x4f, 0x2a, 0xe9, 0xa6, 0x7a, 0xe7, 0xaa,
+		0x6a, 0x5e, 0x4f, 0x65, 0x92, 0xb9, 0x94, 0xfc, 0x23, 0x12, 0x8c, 0x7b, 0xfb, 0x48, 0xce, 0x02,
+		0x41, 0x0c, 0xdc, 0x2f, 0x41, 0x15, 0x43, 0x99, 0x25, 0xe8, 0x4e, 0x34, 0xd5, 0x3b, 0xc5, 0x9a,
+		0xb6, 0xde, 0x69, 0xd2, 0x06, 0x98, 0x04, 0x37, 0x57, 0x38, 0xb0, 0x18, 0x27, 0xce, 0xcc, 0x63,
+		0xc1, 0x4b, 0x2c, 0x39, 0x00, 0x5b, 0xa8, 0xbe, 0x07, 0x17, 0xaa, 0x71, 0x46, 0x4f, 0xbc, 0xf1,
+		0x76, 0x25, 0xe9, 0xed, 0x8a, 0xfc, 0x22, 0x4c, 0xf8, 0xf8, 0x49, 0x64, 0x18, 0xe3, 0x51, 0x01,
+		0xba, 0xd5, 0xc9, 0xec, 0xe3, 0xac, 0x32, 0xc2, 0xbc, 0x7f, 0xba, 0xf5, 0xbb, 0x90, 0xf9, 0x3c,
+		0x86, 0xa2, 0x30, 0x60, 0x7e, 0x16, 0xc6, 0x3c, 0x1c, 0x15, 0x91, 0x3a, 0xc9, 0x89, 0xd4, 0x39,
+		0xc0, 0xaf, 0xc1, 0x28, 0x2a, 0x4a, 0xad, 0xca, 0x61, 0x1f, 0x81, 0x09, 0xa6, 0xc8, 0xfd, 0xbc,
+		0x66, 0x96, 0xd4, 0x9a, 0x60, 0xb8, 0x0c, 0x63, 0x0e, 0x9c, 0xc3, 0xf6, 0x11, 0x01, 0x75, 0x55,
+		0x35, 0x17, 0xdf, 0xfd, 0xc3, 0x5f, 0x3c, 0x25, 0xdd, 0x9f, 0x89, 0xf8, 0x1f, 0xde, 0x0d, 0xc7,
+		0x5d, 0x2f, 0xd5, 0xdd, 0x4a, 0xdd, 0x13, 0x75, 0x98, 0x70, 0x5e, 0xce, 0xe1, 0xcb, 0xb8, 0xe8,
+		0x41, 0xd7, 0x18, 0x46, 0xf7, 0x80, 0x59, 0xa1, 0xbb, 0x46, 0x88, 0x0f, 0x6c, 0x84, 0xc7, 0x28,
+		0xff, 0xdf, 0x0c, 0x0c, 0x2b, 0xda, 0xeb, 0x1d, 0xcd, 0xb4, 0xc8, 0x79, 0x48, 0x69, 0x95, 0x7d,
+		0x23, 0x2c, 0x24, 0x84, 0x9d, 0x9b, 0xe3, 0x70, 0xa5, 0xca, 0xbe, 0x71, 0xed, 0x90, 0x42, 0x61,
+		0xc9, 0x05, 0x18, 0xda, 0x6b, 0x74, 0x78, 0x9c, 0xc2, 0xa7, 0x2c, 0xdc, 0x48, 0x57, 0x10, 0xe8,
+		0xda, 0x21, 0x85, 0x41, 0x63, 0x55, 0xf4, 0xa2, 0xe0, 0x64, 0xf7, 0xaa, 0xf0, 0x34, 0x29, 0x56,
+		0x85, 0xb0, 0x64, 0x11, 0xa0, 0xae, 0xd7, 0xad, 0x32, 0xf5, 0xe1, 0xf3, 0x43, 0x41, 0x2d, 0xe1,
+		0xc5, 0xac, 0x5b, 0xd4, 0xeb, 0xc7, 0x48, 0x6a, 0x5d, 0x3c, 0x60, 0x73, 0x5f, 0xef, 0x68, 0xed,
+		0xbb, 0xf9, 0x74, 0xf7, 0xe6, 0xbe, 0x1b, 0x81, 0xb0, 0xb9, 0x14, 0x9a, 0x94, 0x60, 0x84, 0x9e,
+		0x90, 0x65, 0xf3, 0x97, 0x5f, 0x4d, 0x2b, 0x47, 0x21, 0x2f, 0x22, 0x28, 0x9d, 0xd2, 0xd7, 0x0e,
+		0x29, 0xb0, 0x6b, 0x3f, 0xa1, 0x92, 0x64, 0x57, 0x97, 0x59, 0x77, 0xf2, 0x99, 0xa0, 0x9a, 0x72,
+		0xd3, 0xa0, 0xf7, 0x97, 0x6d, 0xdf, 0xc1, 0x28, 0x71, 0x85, 0xfd, 0xc4, 0xfe, 0x57, 0xb5, 0x46,
+		0x1d, 0x6f, 0x86, 0xb3, 0xee, 0xe4, 0xb3, 0xdd, 0xfb, 0xbf, 0xcc, 0x20, 0x29, 0x85, 0x6c, 0x55,
+		0x3c, 0xa0, 0xa2, 0xd5, 0xf4, 0x2a, 0xef, 0x06, 0x04, 0xf5, 0xb4, 0x67, 0x9c, 0xf5, 0xaa, 0xe8,
+		0x44, 0x46, 0xe3, 0xbf, 0xc9, 0x33, 0xb6, 0x85, 0x3a, 0x12, 0x34, 0x0a, 0x3d, 0x1d, 0x60, 0xb1,
+		0x8a, 0x43, 0xc2, 0x52, 0x25, 0xeb, 0x30, 0xde, 0xa8, 0x9b, 0x56, 0xd9, 0xd4, 0xd5, 0x96, 0xb9,
+		0x6f, 0x58, 0x26, 0x75, 0xfa, 0xf1, 0x92, 0xe0, 0x08, 0x0a, 0xab, 0x75, 0xd3, 0xda, 0x12, 0xc0,
+		0xd7, 0x0e, 0x29, 0x63, 0x0d, 0x77, 0x01, 0xd2, 0x33, 0xf6, 0xf6, 0xb4, 0xb6, 0x4d, 0x30, 0x3f,
+		0xd6, 0x9d, 0xde, 0x06, 0x42, 0x0b, 0x7c, 0xa4, 0x67, 0xb8, 0x0b, 0xc8, 0x37, 0xc1, 0x54, 0xc3,
+		0x50, 0xab, 0x36, 0xb9, 0x72, 0x65, 0xbf, 0xa3, 0xdf, 0xa4, 0x91, 0x04, 0xbc, 0xc9, 0x32, 0xaa,
+		0x91, 0x86, 0x5a, 0x15, 0x24, 0x96, 0x10, 0xe1, 0xda, 0x21, 0x65, 0xb2, 0xe1, 0x2f, 0x24, 0xef,
+		0x83, 0x69, 0xb5, 0xd5, 0x6a, 0xdc, 0xf5, 0x53, 0x9f, 0xa0, 0xd4, 0xcf, 0x44, 0x51, 0x2f, 0x22,
+		0x8e, 0x9f, 0x3c, 0x51, 0x03, 0xa5, 0x64, 0x1b, 0x63, 0x20, 0x1a, 0x3d, 0x54, 0xd3, 0xe2, 0xa6,
+		0x0a, 0xbd, 0x72, 0x08, 0x6f, 0x13, 0x8f, 0xa0, 0xbd, 0xc9, 0xe0, 0x85, 0x65, 0x73, 0xed, 0x10,
+		0x86, 0x4b, 0x3c, 0x45, 0x8c, 0xaa, 0x51, 0xd1, 0xe8, 0x8d, 0x68, 0x9c, 0xea, 0x64, 0x1c, 0x55,
+		0x0a, 0xef, 0xa5, 0xea, 0x29, 0x5a, 0x1c, 0xe6, 0x49, 0x71, 0xfc, 0xc6, 0x98, 0x47, 0x61, 0xc4,
+		0xa5, 0x58, 0x30, 0xa8, 0xce, 0x93, 0x04, 0x44, 0x32, 0x1d, 0x7f, 0x94, 0xc7, 0x61, 0xd4, 0xad,
+		0x4c, 0xe4, 0xef, 0x92, 0x60, 0xc4, 0xa5, 0x27, 0x10, 0xd3, 0x1d, 0x3a, 0xcc, 0x3a, 0x51, 0xc1,
+		0x07, 0xc5, 0x2a, 0x22, 0xde, 0xb3, 0xdd, 0xa4, 0x51, 0x5a, 0xc8, 0x17, 0x31, 0x3c, 0x33, 0xdf,
+		0x3a, 0xdf, 0xb2, 0x41, 0x92, 0x14, 0x04, 0x5a, 0xe7, 0x5b, 0x02, 0xe0, 0x01, 0x18, 0xc5, 0x9e,
+		0x96, 0xdd, 0x86, 0x46, 0x56, 0x19, 0xc1, 0x32, 0x0e, 0x22, 0xff, 0x72, 0x02, 0x72, 0x7e, 0x05,
+		0x64, 0xc7, 0x14, 0xa5, 0xbe, 0x63, 0x8a, 0xc7, 0xfc, 0xd1, 0x4c, 0x27, 0x80, 0xb9, 0x0a, 0x39,
+		0x27, 0x0e, 0xc7, 0x16, 0x82, 0x68, 0xc3, 0xc9, 0x67, 0xe1, 0x29, 0x13, 0x15, 0x6f, 0x01, 0xb9,
+		0xe2, 0xd9, 0x7b, 0x11, 0x37, 0xe1, 0xfb, 0x87, 0xd8, 0xb6, 0x17, 0x76, 0x5a, 0x55, 0xd5, 0xd2,
+		0x44, 0x5c, 0xc4, 0xc1, 0xc4, 0x65, 0x1d, 0xc3, 0x7e, 0xa6, 0xa5, 0x5a, 0x1a, 0x5f, 0xd6, 0x87,
+		0x58, 0x78, 0x50, 0x6d, 0xb5, 0xb6, 0xb0, 0x94, 0x2d, 0xeb, 0x0f, 0xc3, 0x38, 0xea, 0xe4, 0xba,
+		0xda, 0x28, 0xf3, 0x28, 0x41, 0x9a, 0xad, 0xfe, 0xbc, 0xf4, 0x1a, 0x2d, 0x94, 0xab, 0x30, 0xea,
+		0xd6, 0xc7, 0xb6, 0x9b, 0x25, 0xb9, 0xdc, 0x2c, 0xc2, 0xaf, 0x40, 0x60, 0xfc, 0x11, 0xd7, 0x46,
+		0x84, 0x47, 0x77, 0xa7, 0xa9, 0x4b, 0x76, 0x8b, 0x05, 0x3c, 0x32, 0x0a, 0x7b, 0x90, 0x3f, 0x9a,
+		0x80, 0xc9, 0x80, 0xe6, 0x0e, 0x0d, 0x7b, 0x3b, 0xfe, 0x65, 0xa2, 0x2f, 0xff, 0xf2, 0x25, 0x6f,
+		0x58, 0xd7, 0xb5, 0xf2, 0x1d, 0x0f, 0x30, 0x99, 0xe9, 0x4d, 0x14, 0x68, 0x4e, 0xc4, 0x15, 0xf9,
+		0xc5, 0x52, 0xb2, 0x03, 0xd3, 0xbb, 0x77, 0xdf, 0x50, 0x75, 0xab, 0xae, 0x6b, 0xe5, 0xc0, 0xa8,
+		0x05, 0x97, 0xd2, 0xb5, 0xba, 0xb9, 0xab, 0xed, 0xab, 0xb7, 0xea, 0x86, 0x68, 0xd6, 0x94, 0x8d,
+		0xef, 0x04, 0x77, 0x65, 0x05, 0xc6, 0xbd, 0x4b, 0x0f, 0x19, 0x87, 0x84, 0x75, 0x87, 0xf7, 0x3f,
+		0x61, 0xdd, 0x21, 0x4f, 0xf0, 0x38, 0x50, 0x82, 0xc6, 0x81, 0x82, 0x15, 0x71, 0x3c, 0x27, 0x08,
+		0x24, 0xcb, 0x90, 0xf3, 0x2f, 0x47, 0x7e, 0xaa, 0xf2, 0x63, 0x30, 0xe1, 0x5b, 0x6f, 0xa2, 0xa2,
+		0x82, 0xf2, 0x04, 0x8c, 0x71, 0x50, 0xc6, 0x0e, 0xf9, 0x08, 0x4c, 0x87, 0xad, 0x15, 0xf2, 0x3e,
+		0x4c, 0x87, 0xe9, 0x7c, 0x72, 0x01, 0x32, 0xf6, 0x62, 0x11, 0x12, 0x85, 0xa0, 0xbd, 0x10, 0xc0,
+		0x8a, 0x0d, 0xea, 0x09, 0x66, 0x27, 0x3c, 0xc1, 0x6c, 0xf9, 0xfd, 0x90, 0x8f, 0x5a, 0x08, 0x7c,
+		0xdd, 0x48, 0xd9, 0x52, 0x78, 0x04, 0xd2, 0xfc, 0xda, 0xc3, 0x04, 0xdd, 0xbe, 0xe1, 0x4f, 0x28,
+		0x9d, 0x6c, 0x51, 0x48, 0xd2, 0x62, 0xf6, 0x20, 0x97, 0xe1, 0x58, 0xe4, 0x62, 0x10, 0xbd, 0x11,
+		0xc4, 0x08, 0xf1, 0x8d, 0xa0, 0x8a, 0x68, 0x8e, 0x49, 0xfb, 0x2a, 0x92, 0x1d, 0xd8, 0x93, 0xfc,
+		0x3d, 0x49, 0x38, 0x12, 0xbe, 0x24, 0x90, 0x59, 0x18, 0x45, 0xbb, 0xdb, 0xf2, 0x9a, 0xe8, 0xd0,
+		0x54, 0xef, 0x6c, 0x73, 0xfb, 0x9c, 0x07, 0xd2, 0x13, 0x76, 0x20, 0x9d, 0xec, 0xc0, 0x64, 0xc3,
+		0xa8, 0xa8, 0x8d, 0xb2, 0x4b, 0xe2, 0xb9, 0xb0, 0x3f, 0x18, 0x60, 0x76, 0x89, 0x5d, 0x86, 0x5e,
+		0x0d, 0x08, 0xfd, 0x04, 0xa5, 0xb1, 0x6a, 0x4b, 0x3e, 0x59, 0x86, 0x91, 0xa6, 0x23, 0xc8, 0x7d,
+		0x08, 0xbb, 0x1b, 0xcd, 0x35, 0x24, 0x43, 0xa1, 0xdb, 0x3e, 0xe9, 0xbe, 0x55, 0x74, 0xd4, 0x0e,
+		0xca, 0x70, 0xe4, 0x0e, 0x4a, 0xd8, 0x76, 0x45, 0x26, 0x7c, 0xbb, 0xe2, 0xe3, 0xee, 0xa1, 0xf1,
+		0x2c, 0xa2, 0xc1, 0x1d, 0x0c, 0xb2, 0x05, 0xd3, 0x1c, 0xbf, 0xea, 0xe1, 0x7d, 0xa2, 0x57, 0x45,
+		0x43, 0x04, 0x7a, 0x34, 0xdb, 0x93, 0x07, 0x63, 0xbb, 0xd0, 0xa5, 0x29, 0x97, 0x2e, 0xfd, 0x2b,
+		0x36, 0x14, 0xff, 0x73, 0x16, 0x32, 0x8a, 0x66, 0xb6, 0x70, 0xe1, 0xc4, 0x50, 0xb2, 0x76, 0xa7,
+		0xa2, 0xb5, 0x2c, 0x67, 0x9b, 0x32, 0xcc, 0x19, 0x60, 0xd0, 0x25, 0x01, 0x89, 0x96, 0xb8, 0x8d,
+		0x86, 0x51, 0x75, 0xea, 0x6c, 0x45, 0xfb, 0x4d, 0x1c, 0xdd, 0xed, 0x6d, 0x5d, 0x14, 0xde, 0x56,
+		0x32, 0xd2, 0xf8, 0x66, 0x58, 0x3e, 0x77, 0xeb, 0x29, 0xee, 0x6e, 0xa5, 0x62, 0x2a, 0xf3, 0xf8,
+		0x5b, 0x4b, 0x1e, 0x7f, 0x2b, 0x1d, 0xd3, 0xcd, 0x08, 0x87, 0xeb, 0xa2, 0x70, 0xb8, 0x86, 0x63,
+		0x5a, 0xec, 0xf3, 0xb8, 0xae, 0x78, 0x3d, 0xae, 0x4c, 0x84, 0x02, 0x11, 0xd8, 0x91, 0x2e, 0xd7,
+		0xf3, 0x2e, 0x97, 0x2b, 0x1b, 0xe9, 0xef, 0x30, 0x22, 0x21, 0x3e, 0xd7, 0x92, 0xc7, 0xe7, 0x82,
+		0x18, 0x1e, 0x44, 0x38, 0x5d, 0xef, 0x72, 0x3b, 0x5d, 0x23, 0x91, 0x7e, 0x1b, 0x1f, 0xef, 0x30,
+		0xaf, 0xeb, 0x59, 0xdb, 0xeb, 0x1a, 0x8d, 0x74, 0x1b, 0x79, 0x1f, 0xfc, 0x6e, 0xd7, 0x46, 0xc0,
+		0xed, 0x1a, 0xe3, 0xdf, 0x19, 0x89, 0x22, 0x11, 0xe3, 0x77, 0x6d, 0x04, 0xfc, 0xae, 0xf1, 0x18,
+		0x82, 0x31, 0x8e, 0xd7, 0x7b, 0xc2, 0x1d, 0xaf, 0x68, 0xd7, 0x88, 0x37, 0xb3, 0x37, 0xcf, 0xab,
+		0x1c, 0xe1, 0x79, 0x31, 0xef, 0xe8, 0x6c, 0x24, 0xf9, 0x9e, 0x5d, 0xaf, 0x9d, 0x10, 0xd7, 0x8b,
+		0x39, 0x49, 0xa7, 0x23, 0x89, 0xf7, 0xe0, 0x7b, 0xed, 0x84, 0xf8, 0x5e, 0x24, 0x96, 0x6c, 0x3f,
+		0xce, 0x17, 0x5e, 0x0f, 0xf9, 0x18, 0x4c, 0x0a, 0x64, 0x5b, 0x4f, 0xa1, 0xfd, 0xa0, 0xb5, 0xdb,
+		0x46, 0x5b, 0x64, 0xd6, 0xd2, 0x07, 0xf9, 0x34, 0x8c, 0xda, 0xa0, 0xdd, 0x1d, 0x35, 0x6a, 0xa7,
+		0xb9, 0xf4, 0x90, 0xfc, 0x79, 0x09, 0x46, 0xdd, 0x2a, 0xc6, 0x63, 0xc8, 0x67, 0xb9, 0x21, 0xef,
+		0x72, 0xdf, 0x12, 0x5e, 0xf7, 0x0d, 0xaf, 0xf3, 0x6c, 0x05, 0x3c, 0x33, 0xb5, 0x65, 0x7b, 0x66,
+		0x22, 0xa5, 0x80, 0x39, 0x79, 0x7c, 0x59, 0x61, 0x3b, 0x39, 0x13, 0x76, 0x7a, 0x05, 0xf3, 0x29,
+		0xc8, 0x39, 0x98, 0x72, 0xc1, 0xda, 0x76, 0x1d, 0x73, 0x53, 0x72, 0x36, 0x74, 0x91, 0x1b, 0x78,
+		0xff, 0x56, 0x82, 0xc9, 0x80, 0x8a, 0x0b, 0xf5, 0xbe, 0xa4, 0x01, 0x79, 0x5f, 0x89, 0x03, 0x7b,
+		0x5f, 0x6e, 0x3b, 0x35, 0xe9, 0xb5, 0x53, 0xff, 0x42, 0x82, 0x31, 0x8f, 0xa6, 0xc5, 0x21, 0xa8,
+		0x18, 0x55, 0x8d, 0x5b, 0x8e, 0xf4, 0x37, 0x1a, 0x15, 0x0d, 0xa3, 0xc6, 0xed, 0x43, 0xfc, 0x89,
+		0x50, 0xf6, 0xc2, 0x91, 0xe5, 0xeb, 0x82, 0x6d, 0x74, 0x0e, 0xb9, 0xf3, 0x7f, 0x79, 0x52, 0x6c,
+		0xda, 0x49, 0x8a, 0xb5, 0x0f, 0xbf, 0x0d, 0xbb, 0x0e, 0xbf, 0x91, 0x67, 0x20, 0x4b, 0x23, 0xa2,
+		0x65, 0x4c, 0x88, 0xcd, 0x04, 0x6d, 0x13, 0x6f, 0x42, 0xac, 0x49, 0x53, 0xf5, 0xe8, 0x2f, 0x97,
+		0xc5, 0x90, 0xf5, 0x58, 0x0c, 0x27, 0x20, 0x8b, 0xad, 0x67, 0xb7, 0xab, 0x03, 0x3f, 0x39, 0x29,
+		0x0a, 0xe4, 0xf7, 0x01, 0x09, 0x2e, 0x12, 0xe4, 0x1a, 0xa4, 0xb5, 0x5b, 0xf4, 0x56, 0x3f, 0x96,
+		0x68, 0x78, 0x24, 0x68, 0x9a, 0xe2, 0xeb, 0xc5, 0x3c, 0x32, 0xf9, 0x0f, 0xde, 0x9e, 0xc9, 0x31,
+		0xe8, 0xc7, 0xed, 0x83, 0x02, 0x0a, 0xc7, 0x97, 0xff, 0xd7, 0x04, 0x4c, 0x88, 0x0a, 0x84, 0xe7,
+		0x14, 0xc6, 0xdb, 0xb0, 0x2d, 0xc2, 0xde, 0xf8, 0x7d, 0x0a, 0xa0, 0xa6, 0x9a, 0xe5, 0xdb, 0xaa,
+		0x6e, 0x69, 0x55, 0xce, 0x74, 0x57, 0x09, 0x26, 0xd8, 0xe2, 0x53, 0xc7, 0xd4, 0xaa, 0xdc, 0x8d,
+		0xb6, 0x9f, 0x5d, 0xfd, 0x1c, 0xbe, 0xb7, 0x7e, 0x7a, 0xb9, 0x9c, 0xf1, 0x71, 0xd9, 0xe5, 0x5c,
+		0x64, 0xdd, 0xce, 0x05, 0x4b, 0x0c, 0xe6, 0xf9, 0x89, 0xc0, 0xda, 0x26, 0x9e, 0x31, 0x2a, 0xd3,
+		0xd4, 0x9a, 0x2d, 0xc3, 0x68, 0x94, 0x99, 0xba, 0x61, 0x9f, 0x51, 0x18, 0xe5, 0x85, 0x25, 0xaa,
+		0x75, 0xbe, 0x35, 0x01, 0x93, 0x81, 0xe5, 0xf5, 0x6f, 0x1e, 0x83, 0xe9, 0xbd, 0xd3, 0x7e, 0x13,
+		0x81, 0x6c, 0xb9, 0xb3, 0x46, 0x3a, 0x54, 0x2d, 0x08, 0x81, 0xee, 0x55, 0x7f, 0xe4, 0x6e, 0x79,
+		0x8b, 0x4d, 0xf2, 0x2a, 0x1c, 0xf5, 0xe9, 0x36, 0x9b, 0x74, 0xa2, 0x57, 0x15, 0x77, 0xd8, 0xab,
+		0xe2, 0x04, 0x69, 0x87, 0x59, 0xc9, 0x7b, 0x9c, 0x75, 0x2b, 0x30, 0x2e, 0xb8, 0xc1, 0x3d, 0x95,
+		0xb0, 0xe1, 0xa7, 0x9f, 0xed, 0xb1, 0x30, 0x80, 0xe6, 0x09, 0x07, 0x8d, 0xb2, 0x42, 0x1e, 0x64,
+		0xda, 0x84, 0xc3, 0xa1, 0x96, 0x0f, 0xb9, 0x04, 0x59, 0xc7, 0x68, 0x62, 0x5c, 0xed, 0x12, 0x2e,
+		0x70, 0x60, 0xe5, 0x9f, 0x93, 0xe0, 0x70, 0xa8, 0xed, 0x43, 0x4a, 0x90, 0x66, 0x69, 0x6f, 0x3c,
+		0x99, 0xe6, 0x5c, 0x6f, 0x36, 0xd3, 0x1c, 0xcb, 0x89, 0x53, 0x38, 0xb2, 0xfc, 0x3e, 0x48, 0xb3,
+		0x12, 0x32, 0x02, 0xc3, 0xce, 0xc5, 0xbb, 0x00, 0xe9, 0xe2, 0xd2, 0x52, 0x69, 0x53, 0xdc, 0xa2,
+		0xb9, 0xb8, 0xa1, 0xe0, 0x97, 0xb7, 0x00, 0xd2, 0x4a, 0xe9, 0x7a, 0x69, 0x69, 0x3b, 0x97, 0xc4,
+		0x6f, 0x55, 0xb1, 0xdf, 0xe5, 0x2b, 0x78, 0x8d, 0xfa, 0x76, 0x2e, 0xe5, 0x2a, 0xda, 0x2a, 0xad,
+		0x2f, 0x97, 0x94, 0xdc, 0x90, 0xfc, 0x24, 0x1c, 0x13, 0xed, 0x08, 0x86, 0x35, 0xec, 0xe8, 0x82,
+		0xe4, 0x8a, 0x2e, 0xe0, 0x15, 0xdc, 0x85, 0x68, 0xd3, 0x89, 0x5c, 0xf7, 0x75, 0xfc, 0x7c, 0x1f,
+		0x76, 0x97, 0xaf, 0xf7, 0x18, 0x3c, 0x6c, 0x6b, 0x7b, 0x9a, 0x55, 0xd9, 0x67, 0xa6, 0x1c, 0x5b,
+		0x32, 0xc7, 0x94, 0x31, 0x5e, 0x4a, 0x91, 0x4c, 0x06, 0xf6, 0x01, 0xad, 0x62, 0x95, 0x99, 0x2e,
+		0x32, 0xf9, 0x97, 0x45, 0xc7, 0x58, 0xe9, 0x16, 0x2b, 0x94, 0xdf, 0xdf, 0x17, 0x2f, 0xb3, 0x30,
+		0xa4, 0x94, 0xf0, 0xae, 0xf4, 0x24, 0x7e, 0x90, 0x8b, 0xfe, 0x2c, 0x6f, 0xad, 0x17, 0x37, 0xb7,
+		0xae, 0x6d, 0x20, 0x2f, 0xa7, 0x60, 0x42, 0xf0, 0x52, 0x14, 0x0e, 0xc9, 0x67, 0xe1, 0x68, 0x84,
+		0xdd, 0x17, 0x92, 0x87, 0xf8, 0x69, 0xc9, 0x0d, 0xed, 0xf5, 0xf9, 0x37, 0x20, 0x6d, 0x5a, 0xaa,
+		0xd5, 0x31, 0x39, 0x13, 0x2f, 0xf5, 0x6a, 0x08, 0xce, 0x89, 0x1f, 0x5b, 0x14, 0x5d, 0xe1, 0x64,
+		0xe4, 0x0b, 0x30, 0xee, 0x7d, 0x13, 0xcd, 0x03, 0x47, 0x88, 0x12, 0xf2, 0xab, 0x00, 0xae, 0x78,
+		0xa4, 0x9d, 0xd1, 0x25, 0xb9, 0x33, 0xba, 0x2e, 0xc0, 0xd0, 0x2d, 0x83, 0xe9, 0x8c, 0xf0, 0x89,
+		0x83, 0xc9, 0x96, 0xae, 0xe0, 0x03, 0x83, 0x96, 0xeb, 0x40, 0x82, 0x31, 0xa1, 0x88, 0x2a, 0x9e,
+		0xf7, 0x56, 0xf1, 0x40, 0x64, 0x74, 0x29, 0xbc, 0xaa, 0x37, 0x60, 0x88, 0x6a, 0x9b, 0xd0, 0x23,
+		0x3e, 0xef, 0x05, 0x50, 0x2d, 0xab, 0x5d, 0xdf, 0xed, 0x38, 0x15, 0xcc, 0x84, 0x6b, 0xab, 0xa2,
+		0x80, 0x5b, 0x3c, 0xc1, 0xd5, 0xd6, 0xb4, 0x83, 0xea, 0x52, 0x5d, 0x2e, 0x82, 0xf2, 0x3a, 0x8c,
+		0x7b, 0x71, 0xc3, 0x8f, 0x2c, 0x39, 0x77, 0x07, 0x64, 0x85, 0xf9, 0x64, 0x1b, 0x5f, 0xfc, 0x3e,
+		0x0f, 0xfa, 0x20, 0xbf, 0x29, 0x41, 0x66, 0xfb, 0x0e, 0x97, 0xe3, 0x2e, 0x49, 0x95, 0xce, 0xb9,
+		0x2d, 0x3b, 0x58, 0xc8, 0xe2, 0xb1, 0x49, 0x3b, 0xca, 0xfb, 0x2e, 0x7b, 0xa6, 0xa6, 0x7a, 0xf5,
+		0x76, 0x45, 0xb4, 0x9b, 0x6b, 0xa7, 0xcb, 0xbd, 0x9d, 0x91, 0xc0, 0x60, 0xbc, 0xeb, 0x7c, 0x03,
+		0x7b, 0x90, 0xab, 0xae, 0xb4, 0x04, 0xb6, 0x6c, 0xb8, 0x0f, 0x53, 0x48, 0x7d, 0x1f, 0xa6, 0xb0,
+		0x6b, 0x49, 0xb8, 0x6b, 0xb9, 0x05, 0x19, 0x21, 0x14, 0xe4, 0x05, 0x77, 0xee, 0x89, 0xd8, 0xa3,
+		0x89, 0x5c, 0x3c, 0x39, 0x79, 0x07, 0x05, 0x9d, 0x0f, 0x9e, 0x72, 0xe7, 0xf8, 0x15, 0xfc, 0x93,
+		0x03, 0x13, 0xec, 0xc5, 0xaa, 0x70, 0x2a, 0xe4, 0x7f, 0x82, 0xb7, 0xf1, 0xfb, 0xa4, 0xf2, 0x1b,
+		0xd9, 0x00, 0x54, 0x8a, 0x28, 0xfd, 0xae, 0x3b, 0xbf, 0xd9, 0xc8, 0x8f, 0x61, 0xa9, 0x73, 0xeb,
+		0xf7, 0x47, 0x13, 0x30, 0xe2, 0x8a, 0xe9, 0x91, 0xa7, 0x3d, 0x29, 0xa0, 0xb3, 0xdd, 0xe2, 0x7f,
+		0xae, 0x1c, 0x50, 0x4f, 0xc7, 0x12, 0xfd, 0x77, 0x6c, 0xf0, 0x49, 0xfa, 0xe1, 0xa7, 0x7d, 0x86,
+		0x22, 0x4e, 0xfb, 0x7c, 0x44, 0x82, 0x8c, 0xbd, 0x74, 0xf7, 0x1b, 0xcd, 0x3f, 0x02, 0x69, 0xbe,
+		0x3a, 0xb1, 0x70, 0x3e, 0x7f, 0x0a, 0x8d, 0x85, 0x16, 0x20, 0x23, 0x3e, 0x14, 0xc5, 0x1d, 0x51,
+		0xfb, 0xf9, 0xcc, 0xb3, 0x30, 0xe2, 0xda, 0x58, 0x41, 0x3d, 0xb1, 0x5e, 0x7a, 0x39, 0x77, 0xa8,
+		0x30, 0xfc, 0xe6, 0x5b, 0xb3, 0xc9, 0x75, 0xed, 0x36, 0xce, 0x30, 0xa5, 0xb4, 0x74, 0xad, 0xb4,
+		0xf4, 0x52, 0x4e, 0x2a, 0x8c, 0xbc, 0xf9, 0xd6, 0xec, 0xb0, 0xa2, 0xd1, 0xf0, 0xd5, 0x99, 0x97,
+		0x60, 0xc2, 0x37, 0x30, 0x5e, 0xfd, 0x4e, 0x60, 0x7c, 0x79, 0x67, 0x73, 0x75, 0x65, 0xa9, 0xb8,
+		0x5d, 0x2a, 0xb3, 0x74, 0x3a, 0xbc, 0xd5, 0x7f, 0x75, 0xe5, 0xea, 0xb5, 0xed, 0xf2, 0xd2, 0xea,
+		0x4a, 0x69, 0x7d, 0xbb, 0x5c, 0xdc, 0xde, 0x2e, 0x2e, 0xbd, 0x94, 0x4b, 0x9c, 0xff, 0x3a, 0xc0,
+		0x44, 0x71, 0x71, 0x69, 0x05, 0xd7, 0xe7, 0x3a, 0xbf, 0xb6, 0x7d, 0x09, 0x52, 0x34, 0x14, 0xd0,
+		0x35, 0x55, 0xa4, 0xd0, 0x3d, 0xb6, 0x49, 0xae, 0xc0, 0x10, 0x8d, 0x12, 0x90, 0xee, 0xb9, 0x23,
+		0x85, 0x98, 0x60, 0x27, 0x36, 0x86, 0x4e, 0xa7, 0xae, 0xc9, 0x24, 0x85, 0xee, 0xb1, 0x4f, 0xa2,
+		0x40, 0xd6, 0xf1, 0x32, 0xe2, 0x93, 0x2b, 0x0a, 0x3d, 0x68, 0x47, 0xb2, 0x0a, 0xc3, 0xc2, 0x31,
+		0x8c, 0x4b, 0xf7, 0x28, 0xc4, 0x06, 0x27, 0x91, 0x5d, 0xcc, 0x81, 0xef, 0x9e, 0xbb, 0x52, 0x88,
+		0x89, 0xb4, 0x92, 0x15, 0x3b, 0x09, 0x3f, 0x26, 0x85, 0xa3, 0x10, 0x17, 0x6c, 0x44, 0xa6, 0x39,
+		0xa1, 0x91, 0xf8, 0x8c, 0x9c, 0x42, 0x0f, 0x41, 0x64, 0xb2, 0x03, 0xe0, 0x72, 0xd7, 0x7b, 0x48,
+		0xb5, 0x29, 0xf4, 0x12, 0x1c, 0x26, 0x1b, 0x90, 0xb1, 0xbd, 0xa7, 0xd8, 0xc4, 0x97, 0x42, 0x7c,
+		0x94, 0x96, 0xbc, 0x0f, 0xc6, 0xbc, 0x5e, 0x43, 0x6f, 0xe9, 0x2c, 0x85, 0x1e, 0xc3, 0xaf, 0x48,
+		0xdf, 0xeb, 0x42, 0xf4, 0x96, 0xde, 0x52, 0xe8, 0x31, 0x1a, 0x4b, 0x3e, 0x00, 0x93, 0x41, 0x13,
+		0xbf, 0xf7, 0x6c, 0x97, 0x42, 0x1f, 0xf1, 0x59, 0xd2, 0x04, 0x12, 0xe2, 0x1a, 0xf4, 0x91, 0xfc,
+		0x52, 0xe8, 0x27, 0x5c, 0x4b, 0xaa, 0x30, 0xe1, 0xb7, 0xb7, 0x7b, 0x4d, 0x86, 0x29, 0xf4, 0x1c,
+		0xba, 0x65, 0xb5, 0x78, 0xed, 0xf4, 0x5e, 0x93, 0x63, 0x0a, 0x3d, 0x47, 0x72, 0x17, 0x8b, 0x91,
+		0xf9, 0x8d, 0x8f, 0x76, 0xcd, 0x6f, 0x74, 0x32, 0x16, 0xed, 0x9c, 0xc6, 0xef, 0x7f, 0x0d, 0x1e,
+		0xe2, 0x97, 0x09, 0x98, 0x96, 0x7a, 0xb3, 0xae, 0xd7, 0xec, 0xdb, 0x21, 0xf8, 0x33, 0x4f, 0x6e,
+		0x3c, 0xc2, 0xa0, 0xe6, 0x44, 0x69, 0xcc, 0x1d, 0x11, 0x91, 0x17, 0x67, 0xc5, 0x25, 0x21, 0xc7,
+		0xa7, 0x2e, 0x76, 0xb9, 0x7f, 0x22, 0xe6, 0x96, 0x8b, 0x90, 0xfb, 0x29, 0x62, 0x92, 0x30, 0xbb,
+		0xe5, 0x7b, 0xca, 0x9f, 0x90, 0x60, 0xfc, 0x5a, 0xdd, 0xb4, 0x8c, 0x76, 0xbd, 0xa2, 0x36, 0xe8,
+		0x8a, 0x71, 0xb9, 0xd7, 0x43, 0x1e, 0x8b, 0x59, 0x34, 0x46, 0xf8, 0xa5, 0x16, 0x0c, 0x85, 0x2c,
+		0x43, 0xfa, 0x96, 0xda, 0x60, 0x47, 0x2c, 0xdc, 0xf7, 0xd7, 0xf8, 0x79, 0xee, 0xb2, 0x92, 0xdc,
+		0x54, 0x18, 0xae, 0xfc, 0x53, 0x34, 0x89, 0xbb, 0xd9, 0xac, 0x9b, 0xec, 0x53, 0xee, 0x96, 0x86,
+		0xdb, 0xf0, 0xa9, 0xb6, 0x6a, 0x71, 0xa7, 0x66, 0xb1, 0xc8, 0xaf, 0xa2, 0x78, 0x24, 0xfe, 0x42,
+		0x89, 0xb9, 0xc0, 0x6d, 0x15, 0xac, 0x32, 0x4a, 0x8e, 0xbc, 0x07, 0x30, 0x83, 0xb8, 0x4c, 0x49,
+		0x27, 0x06, 0x45, 0x1a, 0x73, 0xaa, 0xb1, 0xd5, 0xa4, 0x0e, 0x98, 0x12, 0x8d, 0x1b, 0x8e, 0x7a,
+		0x4d, 0x63, 0x95, 0x24, 0x07, 0x55, 0xc9, 0x58, 0x53, 0xbd, 0xb3, 0x44, 0x09, 0x63, 0x55, 0xfc,
+		0xd2, 0x8f, 0x9f, 0x97, 0x00, 0x1c, 0xce, 0x11, 0x15, 0xc3, 0xfa, 0xe2, 0x89, 0xd6, 0x2f, 0xc2,
+		0xfa, 0x8f, 0x46, 0x0d, 0x8c, 0x8f, 0xef, 0x8b, 0x63, 0xd8, 0xd2, 0x5f, 0x7d, 0x7b, 0x46, 0x62,
+		0xb5, 0x4e, 0x54, 0x7c, 0xe3, 0x72, 0x1d, 0x46, 0x58, 0x34, 0xad, 0x4c, 0x4d, 0xd8, 0x44, 0xac,
+		0x09, 0x3b, 0x26, 0x4c, 0x58, 0x46, 0x10, 0x18, 0x36, 0xbe, 0xe7, 0x7d, 0xf8, 0xaa, 0x04, 0x23,
+		0xcb, 0xae, 0xeb, 0xaa, 0x70, 0x5f, 0xc6, 0xd0, 0xeb, 0x37, 0xb5, 0xb6, 0xbd, 0x2f, 0xc3, 0x1e,
+		0xd1, 0xcc, 0x64, 0x5f, 0x3d, 0xb2, 0xee, 0x8a, 0x9b, 0x18, 0xc4, 0x33, 0x62, 0xdd, 0xd6, 0x76,
+		0xcd, 0xba, 0x60, 0xb9, 0x22, 0x1e, 0x71, 0x93, 0xdc, 0xd4, 0x2a, 0x1d, 0x0c, 0xd9, 0xe2, 0x07,
+		0xcf, 0x2c, 0xfc, 0x9a, 0x21, 0x8b, 0x9e, 0x4e, 0x88, 0xf2, 0x25, 0x56, 0x8c, 0x44, 0xaa, 0x9a,
+		0xa5, 0xd6, 0x1b, 0x2c, 0xed, 0x2b, 0xab, 0x88, 0x47, 0xb2, 0x08, 0xc3, 0xad, 0xb6, 0x41, 0x3f,
+		0x46, 0x93, 0xe6, 0x9b, 0x58, 0x71, 0x92, 0xbe, 0xc9, 0xe0, 0x15, 0x81, 0xc8, 0xbb, 0xfb, 0x79,
+		0x09, 0x72, 0x7e, 0x18, 0xdc, 0xf9, 0x68, 0x18, 0x35, 0xa3, 0xdc, 0x69, 0xd7, 0x45, 0xa7, 0xf1,
+		0x79, 0xa7, 0x5d, 0xc7, 0x43, 0x8b, 0x76, 0xf3, 0x5b, 0x46, 0xa3, 0x5e, 0x11, 0x7d, 0x1f, 0x17,
+		0xc5, 0x9b, 0xb4, 0x14, 0xcf, 0x16, 0x35, 0xb5, 0x5b, 0x02, 0x86, 0xdf, 0xc0, 0xd8, 0xd4, 0x6e,
+		0xf1, 0xd7, 0x47, 0xd0, 0x2f, 0xae, 0x39, 0x79, 0x7f, 0xfc, 0x09, 0x63, 0x8c, 0x9c, 0x53, 0x65,
+		0x76, 0xdc, 0x87, 0xf5, 0x7c, 0x94, 0x17, 0xd2, 0x7d, 0x0c, 0xde, 0xf4, 0xff, 0x4b, 0x02, 0xc2,
+		0x5b, 0x5c, 0xb4, 0x2c, 0x1c, 0x5f, 0x3a, 0x60, 0xeb, 0x61, 0x67, 0xff, 0xd8, 0xbc, 0x7d, 0xe0,
+		0xd7, 0x3e, 0x77, 0xee, 0x24, 0x67, 0xd4, 0x0d, 0xdf, 0x31, 0x40, 0x76, 0x5f, 0x75, 0xc8, 0xf1,
+		0xc0, 0x69, 0x18, 0xa2, 0x37, 0x1c, 0x89, 0xc0, 0x01, 0x7d, 0x20, 0x4f, 0x43, 0x46, 0xa5, 0x95,
+		0x8a, 0x04, 0xa0, 0xc5, 0xfc, 0xaf, 0x7d, 0xee, 0xdc, 0x34, 0x27, 0xee, 0xa5, 0x69, 0x43, 0x3a,
+		0x41, 0x88, 0x94, 0x3b, 0x08, 0x51, 0x80, 0x8c, 0xf8, 0x5c, 0x66, 0x9e, 0x5d, 0x87, 0x64, 0x3f,
+		0xbb, 0xfc, 0xa4, 0xb4, 0x27, 0x79, 0xeb, 0x3f, 0x66, 0xdd, 0x61, 0x81, 0x25, 0xc8, 0x19, 0x2d,
+		0xad, 0x1d, 0xd2, 0xe5, 0xe8, 0x56, 0x4d, 0x08, 0x0c, 0xd1, 0xd1, 0x57, 0x3d, 0xbb, 0x70, 0x9d,
+		0x5d, 0xe7, 0xba, 0x85, 0xe9, 0xc0, 0x84, 0x2a, 0xea, 0x77, 0x17, 0xf3, 0x5f, 0x70, 0x48, 0x3b,
+		0x61, 0x03, 0xbc, 0xe0, 0xc0, 0xb5, 0x25, 0x47, 0xc9, 0x60, 0x2f, 0x3e, 0xa0, 0xd6, 0x1b, 0xe2,
+		0xe3, 0x80, 0x0a, 0x7f, 0x22, 0x0b, 0x76, 0x08, 0x2e, 0x45, 0x5d, 0x61, 0x39, 0x4a, 0x8c, 0x17,
+		0x0d, 0xbd, 0xea, 0x8d, 0xb6, 0x91, 0x6d, 0x48, 0x5b, 0xc6, 0x4d, 0x4d, 0xe7, 0x93, 0x63, 0xf1,
+		0xb9, 0x3e, 0x94, 0x5a, 0xf0, 0xee, 0x21, 0x4e, 0x8b, 0xd4, 0x20, 0x57, 0xd5, 0x1a, 0x5a, 0x8d,
+		0xb2, 0x12, 0x3f, 0x7b, 0xac, 0xb1, 0x03, 0xbc, 0xfd, 0xd1, 0x0f, 0x5e, 0x51, 0x34, 0x61, 0x53,
+		0xdd, 0xa2, 0x44, 0xc9, 0xa6, 0xf7, 0xa2, 0xbc, 0x61, 0x9e, 0x91, 0x11, 0xd1, 0x7f, 0x97, 0x46,
+		0x72, 0x2f, 0x59, 0x6e, 0x12, 0xa8, 0x59, 0x3a, 0xfa, 0xae, 0xa1, 0xd3, 0xef, 0x6d, 0x71, 0xa1,
+		0xc9, 0xb0, 0x5d, 0x5b, 0xbb, 0x9c, 0xef, 0xda, 0x6e, 0xc2, 0xb8, 0x03, 0x4a, 0x35, 0x67, 0xb6,
+		0x5f, 0xcd, 0x39, 0x66, 0x13, 0x40, 0x10, 0xfc, 0x30, 0x97, 0xa3, 0x9b, 0xed, 0xcc, 0x8e, 0x58,
+		0x2d, 0xef, 0xee, 0x8c, 0x8b, 0x00, 0x69, 0xc0, 0x54, 0xb3, 0xae, 0x97, 0x4d, 0xad, 0xb1, 0x57,
+		0xe6, 0x9c, 0x43, 0xba, 0x23, 0x03, 0x18, 0xe9, 0xc9, 0x66, 0x5d, 0xdf, 0xd2, 0x1a, 0x7b, 0xcb,
+		0x36, 0x59, 0xf2, 0x1c, 0x1c, 0x77, 0xd8, 0x61, 0xe8, 0xe5, 0x7d, 0xa3, 0x51, 0x2d, 0xb7, 0xb5,
+		0xbd, 0x72, 0x85, 0x5e, 0x7d, 0x35, 0x4a, 0x99, 0x78, 0xd4, 0x06, 0xd9, 0xd0, 0xaf, 0x19, 0x8d,
+		0xaa, 0xa2, 0xed, 0x2d, 0xe1, 0x6b, 0x54, 0x59, 0x0e, 0x76, 0xbd, 0x8a, 0x19, 0x21, 0x49, 0x4c,
+		0x87, 0xb6, 0x0b, 0x57, 0xaa, 0x26, 0x69, 0xc1, 0x61, 0x47, 0x2b, 0x61, 0xb9, 0x10, 0xae, 0xf1,
+		0x01, 0x08, 0xd7, 0x94, 0x4d, 0x9a, 0xce, 0x13, 0x26, 0x60, 0x2a, 0x8c, 0x35, 0xea, 0xaf, 0x77,
+		0xea, 0x76, 0x4d, 0x13, 0x03, 0xa8, 0x69, 0x94, 0x91, 0xe4, 0x55, 0xac, 0x40, 0x5a, 0x35, 0x4d,
+		0xcd, 0xc2, 0x4f, 0xb1, 0x27, 0x69, 0xce, 0x4a, 0xdc, 0x2a, 0x54, 0x44, 0x70, 0x8f, 0xd1, 0xc5,
+		0x08, 0x2c, 0x64, 0x3e, 0xfe, 0xa9, 0x99, 0x43, 0x5f, 0xf9, 0xd4, 0xcc, 0x21, 0xf9, 0xd7, 0x24,
+		0x18, 0xf7, 0xc2, 0x47, 0xdc, 0x4d, 0xb6, 0xed, 0xbb, 0x9b, 0xec, 0x1e, 0x15, 0x00, 0xa3, 0xe5,
+		0x52, 0x2b, 0xc9, 0xc1, 0xa9, 0x15, 0xf9, 0x0a, 0xbd, 0x9d, 0x87, 0x6b, 0x5a, 0xcd, 0x24, 0x17,
+		0x21, 0xab, 0x8a, 0x07, 0x76, 0x2e, 0xac, 0x8b, 0xa6, 0x76, 0x40, 0xe5, 0x4f, 0x49, 0x90, 0x5e,
+		0xbe, 0xb1, 0xa9, 0xd6, 0xdb, 0xa4, 0x84, 0xf7, 0x3a, 0x0a, 0x4d, 0xd5, 0xab, 0xd2, 0x77, 0x94,
+		0x1b, 0x2f, 0x47, 0x32, 0xe1, 0x47, 0xe5, 0xbb, 0x92, 0xf1, 0xaf, 0x92, 0xae, 0xf1, 0xbb, 0x0e,
+		0xc3, 0xac, 0x85, 0x26, 0x79, 0x11, 0x86, 0x5a, 0xf8, 0x23, 0x2f, 0x79, 0x6e, 0x78, 0x0c, 0x6a,
+		0x37, 0x0a, 0xef, 0x16, 0x0b, 0x86, 0x27, 0xff, 0x17, 0x09, 0x60, 0xf9, 0xc6, 0x8d, 0xed, 0x76,
+		0xbd, 0xd5, 0xd0, 0xac, 0x41, 0x75, 0x79, 0xd5, 0x3d, 0x17, 0xcd, 0x76, 0xa5, 0xe7, 0x6e, 0x3b,
+		0xf3, 0x6c, 0xab, 0x5d, 0x09, 0xa5, 0x56, 0x35, 0x2d, 0x9b, 0x5a, 0xb2, 0x67, 0x6a, 0xcb, 0xa6,
+		0x15, 0xe4, 0xe3, 0x2b, 0x30, 0xe2, 0x74, 0x1d, 0xe7, 0x5a, 0xc6, 0xe2, 0xbf, 0x39, 0x3b, 0xe5,
+		0x68, 0x76, 0x0a, 0x34, 0x37, 0x4b, 0x6d, 0x74, 0x74, 0x70, 0xc0, 0xa5, 0xfd, 0xde, 0x51, 0x82,
+		0x84, 0xf3, 0x8f, 0xeb, 0xab, 0xe4, 0x00, 0xf4, 0x15, 0xa7, 0x45, 0x03, 0xf5, 0x1e, 0xf5, 0xcb,
+		0xcf, 0x2c, 0x8c, 0x79, 0x34, 0xa7, 0xa3, 0x68, 0x86, 0x5c, 0x8a, 0xc6, 0x35, 0x26, 0xdf, 0x92,
+		0xc0, 0x6f, 0xab, 0x72, 0xb5, 0xfe, 0x8e, 0x65, 0xe1, 0x0e, 0x0c, 0x6b, 0xba, 0xd5, 0xae, 0x6b,
+		0x22, 0x31, 0xe0, 0x89, 0x28, 0x49, 0x09, 0xe9, 0x0b, 0xfd, 0x18, 0xbf, 0x5b, 0x6e, 0x04, 0x2d,
+		0x17, 0x1b, 0x7e, 0x3d, 0x09, 0xf9, 0x28, 0x54, 0xf4, 0x10, 0x2a, 0x6d, 0x8d, 0x16, 0x94, 0x3d,
+		0x1b, 0x67, 0xe3, 0xa2, 0x98, 0x1b, 0x21, 0x0a, 0xa0, 0x3b, 0x87, 0x22, 0x89, 0xa0, 0x07, 0xf3,
+		0xdf, 0xc6, 0x1d, 0x0a, 0x08, 0x43, 0x34, 0x98, 0x10, 0x27, 0x61, 0x76, 0xd5, 0x86, 0x8a, 0xc7,
+		0xa7, 0x07, 0xa1, 0xc6, 0xc5, 0xf1, 0x9a, 0x45, 0x46, 0x93, 0xdc, 0x80, 0x61, 0x41, 0x3e, 0x35,
+		0x00, 0xf2, 0x82, 0x18, 0x9e, 0x89, 0x72, 0x9b, 0x12, 0x54, 0x0c, 0x53, 0xca, 0x88, 0x5d, 0xb6,
+		0x52, 0x8d, 0xb3, 0x55, 0xd2, 0xdd, 0x6d, 0x15, 0x5b, 0xc0, 0x87, 0xdd, 0x02, 0xce, 0xfc, 0xa9,
+		0x9f, 0x49, 0x62, 0x6e, 0x50, 0xf5, 0x6f, 0xe0, 0x70, 0x7e, 0x13, 0x00, 0xd3, 0x13, 0xa8, 0xbf,
+		0xf3, 0xa9, 0x01, 0xe8, 0x9d, 0x2c, 0xa3, 0xb7, 0x6c, 0x5a, 0xf7, 0x7d, 0x4c, 0xf9, 0xe8, 0xfd,
+		0xfb, 0x04, 0x8c, 0xba, 0x47, 0xef, 0x6f, 0xc0, 0x62, 0x49, 0xd6, 0x1d, 0x45, 0xc7, 0x4e, 0xb0,
+		0x3c, 0x16, 0xa5, 0xe8, 0x02, 0x72, 0x1d, 0xa3, 0xe1, 0xfe, 0x62, 0x1c, 0xd2, 0x3c, 0x9d, 0x74,
+		0x23, 0xe0, 0x2b, 0x49, 0x71, 0x77, 0x08, 0x8c, 0x89, 0x3b, 0x04, 0x42, 0x5d, 0xa5, 0x87, 0x01,
+		0x6f, 0x16, 0x28, 0x7b, 0x72, 0x54, 0x71, 0x4b, 0x13, 0x43, 0x6a, 0xce, 0x89, 0x0a, 0x4c, 0xd3,
+		0x45, 0x30, 0x47, 0x93, 0x23, 0x0c, 0x1e, 0x16, 0x2a, 0xb1, 0x12, 0x72, 0x0e, 0xc8, 0xbe, 0x1d,
+		0x3c, 0x2d, 0x3b, 0x8c, 0x40, 0xb8, 0x49, 0xe7, 0x8d, 0x00, 0xc7, 0xcb, 0x5e, 0xd0, 0xef, 0x70,
+		0x2f, 0x70, 0x59, 0x2c, 0x59, 0xc6, 0x02, 0xf2, 0x9d, 0x12, 0x73, 0xb9, 0x7c, 0x11, 0x3b, 0xee,
+		0xfc, 0x96, 0xfb, 0x9b, 0x0d, 0x7f, 0xfa, 0xf6, 0x4c, 0xe1, 0xae, 0xda, 0x6c, 0x2c, 0xc8, 0x21,
+		0x24, 0xe5, 0xb0, 0x78, 0x22, 0x7a, 0x65, 0xde, 0xe0, 0x1f, 0xe9, 0x04, 0x5c, 0xa6, 0x3d, 0xb5,
+		0x82, 0x7b, 0xdf, 0xc3, 0x83, 0x0a, 0x62, 0x7a, 0xfd, 0xa6, 0x2b, 0x94, 0x3a, 0xf9, 0x66, 0x38,
+		0x56, 0x6b, 0x18, 0xbb, 0x78, 0xe4, 0x8a, 0xbb, 0x4f, 0x4c, 0x96, 0xca, 0x15, 0xb5, 0x95, 0xcf,
+		0x0c, 0xaa, 0xea, 0x23, 0xac, 0x8e, 0x55, 0xe6, 0x4e, 0xb1, 0x1a, 0x96, 0xd4, 0x16, 0xf9, 0x88,
+		0x04, 0x27, 0x9c, 0x5e, 0x87, 0xb4, 0x20, 0x3b, 0xa8, 0x16, 0x1c, 0xb3, 0xab, 0x09, 0x34, 0xc2,
+		0x80, 0x19, 0xd7, 0x98, 0xf1, 0xf8, 0xb1, 0x6e, 0x58, 0xf5, 0x8a, 0x56, 0x6e, 0x69, 0xed, 0xba,
+		0x51, 0xcd, 0x43, 0x9f, 0x73, 0xe0, 0x84, 0x43, 0x90, 0x85, 0x8d, 0xd7, 0x29, 0xb9, 0x4d, 0x4a,
+		0x8d, 0xbc, 0x1f, 0x88, 0x23, 0x9b, 0xe5, 0xdb, 0x74, 0x41, 0x31, 0xf3, 0x23, 0xb3, 0xc9, 0x6e,
+		0xb1, 0xe2, 0x45, 0x21, 0xbb, 0x2f, 0x53, 0x78, 0xf7, 0x8c, 0xce, 0xed, 0x7a, 0xdf, 0x99, 0x64,
+		0x19, 0x66, 0x70, 0x36, 0x35, 0xe9, 0xd5, 0x52, 0x65, 0x7b, 0x42, 0x9a, 0xd8, 0x1f, 0x9e, 0xe3,
+		0x31, 0x4a, 0x67, 0xce, 0xf1, 0xa6, 0x7a, 0x67, 0x8d, 0x42, 0xd9, 0x16, 0x8e, 0xb9, 0xa9, 0xb5,
+		0xd9, 0xf6, 0xe4, 0x37, 0xc3, 0xb1, 0x90, 0xb0, 0x44, 0x79, 0xaf, 0x61, 0x18, 0xed, 0xfc, 0x58,
+		0xdf, 0x23, 0x13, 0x71, 0x47, 0xf7, 0x91, 0x40, 0x84, 0xe2, 0x0a, 0x56, 0x80, 0x2b, 0x89, 0xd6,
+		0x32, 0x2a, 0xfb, 0xe5, 0x86, 0xa6, 0xd7, 0xac, 0x7d, 0x1a, 0x3a, 0x48, 0x29, 0x23, 0xb4, 0x6c,
+		0x95, 0x16, 0x91, 0x4d, 0x18, 0xc5, 0x86, 0x76, 0x5a, 0x65, 0x4b, 0x6b, 0x37, 0xd1, 0xe7, 0xef,
+		0xea, 0x29, 0xac, 0x52, 0xd8, 0x6d, 0xad, 0xdd, 0xf4, 0x44, 0x95, 0x1a, 0x76, 0xb1, 0x49, 0xbe,
+		0x45, 0x82, 0x93, 0x75, 0xdd, 0xb4, 0x54, 0xdd, 0x2a, 0x77, 0x74, 0x77, 0xa7, 0x35, 0xbe, 0xa7,
+		0x90, 0x1b, 0x94, 0x44, 0x16, 0x78, 0x3d, 0x3b, 0xae, 0x6a, 0xae, 0x68, 0x74, 0x83, 0x81, 0x7c,
+		0x42, 0x82, 0x87, 0x43, 0x9b, 0xb1, 0xdb, 0xa9, 0xd6, 0x34, 0xcb, 0x35, 0x8c, 0x93, 0x83, 0x1a,
+		0x86, 0x07, 0x42, 0x9a, 0xb3, 0x48, 0x6b, 0xb3, 0xe5, 0xe1, 0x55, 0x38, 0x13, 0xc9, 0x1b, 0xcb,
+		0xa0, 0x8a, 0xaf, 0xa3, 0xb3, 0x50, 0xb9, 0xc1, 0xce, 0x9b, 0x64, 0x94, 0x87, 0xc3, 0x7b, 0xb9,
+		0x6d, 0x2c, 0x09, 0xe8, 0x4d, 0xc3, 0x68, 0x90, 0x57, 0x61, 0xc2, 0xbd, 0xec, 0x60, 0xc2, 0xe5,
+		0x14, 0x1d, 0xcc, 0x87, 0x63, 0x8d, 0xf9, 0xed, 0xba, 0x77, 0x7b, 0x6c, 0xbc, 0xe3, 0x7e, 0x63,
+		0xa2, 0x62, 0x45, 0x29, 0x76, 0xb5, 0x97, 0xc7, 0x51, 0xa6, 0x07, 0xc5, 0x3a, 0x5c, 0x49, 0x1c,
+		0xe9, 0x2d, 0x52, 0xea, 0x0b, 0x0f, 0xa1, 0x9d, 0xf2, 0xe6, 0x97, 0x3f, 0x7b, 0xe6, 0xb8, 0x8b,
+		0xcc, 0x1d, 0x7b, 0xd7, 0x94, 0x2d, 0xb7, 0xf2, 0x1b, 0x30, 0xe6, 0xe9, 0x48, 0xe8, 0x57, 0x46,
+		0x82, 0x6b, 0x72, 0xe2, 0x9e, 0xd6, 0x64, 0x6e, 0x43, 0xdd, 0x86, 0x49, 0xe7, 0x52, 0xe3, 0xba,
+		0x79, 0x93, 0xd6, 0x3f, 0xe8, 0xfd, 0x04, 0xcc, 0x8f, 0xac, 0xf3, 0x0c, 0x3a, 0xcc, 0x8f, 0xac,
+		0x6b, 0x6d, 0xf9, 0x9f, 0x4a, 0x30, 0xb9, 0x65, 0xa9, 0x7a, 0x75, 0x17, 0x37, 0x7f, 0x4c, 0x16,
+		0x46, 0x1f, 0x78, 0xcd, 0x57, 0x20, 0x7d, 0x4f, 0x61, 0x7d, 0x8e, 0x2d, 0xff, 0x22, 0x5e, 0x92,
+		0x67, 0xab, 0x08, 0xb2, 0x0c, 0x19, 0xfb, 0x7a, 0xa5, 0x7e, 0x4d, 0x23, 0x1b, 0x93, 0xe8, 0x30,
+		0xd9, 0xd6, 0x6e, 0xab, 0xed, 0x6a, 0xb9, 0xd9, 0x69, 0x58, 0xf5, 0x56, 0xc3, 0xe6, 0xd1, 0x20,
+		0x54, 0x4b, 0x8e, 0xd1, 0x5e, 0xb3, 0x49, 0xf3, 0x11, 0xff, 0xb8, 0x04, 0x13, 0xbe, 0x75, 0x24,
+		0x22, 0xda, 0xf8, 0x2a, 0xa4, 0x6f, 0x3b, 0x37, 0x30, 0x0e, 0xa4, 0x51, 0x9c, 0x20, 0x6f, 0xca,
+		0x8f, 0x48, 0x40, 0x9c, 0x39, 0x63, 0x1f, 0x8e, 0x5d, 0xa3, 0x47, 0x26, 0x79, 0xa9, 0x7d, 0x3a,
+		0x36, 0x72, 0x9b, 0xc0, 0x56, 0x54, 0xee, 0xc0, 0xba, 0x43, 0x80, 0xbc, 0xe0, 0x78, 0xae, 0x62,
+		0xca, 0x84, 0x7c, 0xb8, 0x62, 0x0e, 0x3f, 0x09, 0xe1, 0x31, 0x91, 0x39, 0x12, 0x6d, 0xeb, 0x21,
+		0xf9, 0x6d, 0x09, 0x53, 0xda, 0x7d, 0x26, 0xb5, 0xdd, 0xe4, 0x0a, 0x90, 0xb6, 0xeb, 0x25, 0x35,
+		0x4d, 0x45, 0xb2, 0xe9, 0xc1, 0x2c, 0xf4, 0xc9, 0xb6, 0xff, 0xed, 0xfd, 0x72, 0xc1, 0xf9, 0x60,
+		0xfc, 0x92, 0x84, 0x77, 0x1e, 0x54, 0x83, 0xc3, 0xb1, 0x05, 0xa3, 0xee, 0xb6, 0xf0, 0x5e, 0x3d,
+		0xd4, 0x4b, 0xaf, 0xdc, 0x1d, 0xf2, 0x10, 0xc1, 0xbe, 0x08, 0xf3, 0x9d, 0x25, 0x2e, 0x3c, 0xd9,
+		0x33, 0x97, 0xec, 0xa4, 0xac, 0x30, 0x7f, 0x86, 0x0d, 0xd6, 0x77, 0x24, 0x20, 0x45, 0x97, 0x94,
+		0x8f, 0x48, 0x30, 0xa9, 0x1b, 0x16, 0xb5, 0xa5, 0xb5, 0x6a, 0x99, 0x87, 0xb9, 0x99, 0x42, 0xb9,
+		0xd1, 0x1f, 0xf7, 0xfe, 0xe0, 0xed, 0x99, 0x20, 0xa9, 0xb0, 0x95, 0x60, 0x42, 0x37, 0xac, 0x45,
+		0x0a, 0xb4, 0x4d, 0x61, 0xc8, 0x6d, 0x18, 0xf3, 0xd6, 0xcf, 0xa6, 0x93, 0xd2, 0x77, 0xfd, 0x63,
+		0xb1, 0x75, 0x8f, 0xee, 0xba, 0x2a, 0x66, 0xb7, 0xfe, 0xff, 0x31, 0x0e, 0xee, 0x77, 0x27, 0x5c,
+		0x7a, 0xfe, 0xba, 0x5a, 0x67, 0x99, 0x27, 0x83, 0xd6, 0xb6, 0x0b, 0xb8, 0xc3, 0xad, 0x9a, 0xfc,
+		0xfc, 0x65, 0x97, 0xbd, 0x4d, 0x6c, 0x81, 0x42, 0x21, 0x15, 0x8e, 0x11, 0x99, 0xaa, 0xfb, 0x7c,
+		0xcf, 0xa9, 0xba, 0xbe, 0xc0, 0x0a, 0x45, 0xc3, 0x33, 0x51, 0x6d, 0x6d, 0x4f, 0x6b, 0xd3, 0x6b,
+		0x05, 0xb9, 0x07, 0x68, 0x17, 0xc8, 0xbf, 0x99, 0x00, 0xe2, 0xbe, 0x81, 0x94, 0xd9, 0xe9, 0x91,
+		0xf9, 0xef, 0xab, 0x21, 0x7b, 0xce, 0x89, 0x5e, 0xd9, 0xd5, 0xd3, 0xe6, 0x73, 0x72, 0x30, 0x9b,
+		0xcf, 0x8b, 0x3c, 0xdb, 0x9a, 0x6d, 0x31, 0xcf, 0xc5, 0xee, 0x51, 0xd9, 0x5d, 0x77, 0xe5, 0x5e,
+		0x3f, 0x8c, 0x1f, 0x6b, 0xd7, 0x6e, 0xd5, 0x0d, 0x6c, 0x9d, 0x2b, 0xcb, 0x79, 0x4c, 0x94, 0xb2,
+		0x0f, 0x09, 0xd8, 0xe9, 0xf1, 0x69, 0x77, 0x7a, 0xfc, 0xab, 0x90, 0xf3, 0x25, 0xe1, 0x63, 0xa8,
+		0x77, 0xb8, 0xdf, 0x13, 0x66, 0xee, 0x19, 0xce, 0x71, 0xe5, 0x7f, 0x2d, 0xc1, 0x14, 0x95, 0xef,
+		0xfa, 0x1b, 0x1a, 0xdd, 0x94, 0x53, 0xb4, 0x8a, 0xd1, 0xae, 0xe2, 0x31, 0x04, 0x7e, 0x35, 0x6c,
+		0x4a, 0x49, 0xd4, 0xab, 0x78, 0x7b, 0xaa, 0x71, 0x5b, 0xb7, 0x57, 0xd4, 0xe8, 0x38, 0x0b, 0x03,
+		0xa3, 0x31, 0x0a, 0xa3, 0xda, 0x69, 0x68, 0x65, 0xb5, 0xc2, 0x82, 0x50, 0x2c, 0x83, 0x63, 0x8c,
+		0x95, 0x16, 0x59, 0xa1, 0xf7, 0xa2, 0xc9, 0x54, 0xaf, 0x83, 0xef, 0xe0, 0xc8, 0x7f, 0x37, 0x01,
+		0x47, 0x37, 0x35, 0x6a, 0x87, 0x2d, 0xf9, 0x1c, 0xc4, 0x81, 0x4f, 0x48, 0x91, 0xc3, 0x95, 0x18,
+		0x6c, 0x0e, 0xd7, 0x26, 0x8c, 0x6b, 0x7b, 0x7b, 0x5a, 0xc5, 0xaa, 0xdf, 0xe2, 0x59, 0x48, 0xc9,
+		0xbe, 0xf7, 0xd2, 0x6d, 0x02, 0x08, 0x22, 0x6b, 0x30, 0xfe, 0xee, 0x8e, 0xd6, 0xd1, 0xaa, 0x25,
+		0xf4, 0xec, 0xd6, 0xcc, 0x5a, 0x60, 0x38, 0x97, 0x20, 0xd9, 0x34, 0x6b, 0x5d, 0xcd, 0xb8, 0xe3,
+		0x5f, 0xf8, 0xdc, 0xb9, 0xa3, 0x61, 0x4b, 0xfb, 0x9a, 0x59, 0x53, 0x10, 0x5b, 0xfe, 0xc1, 0x24,
+		0x8c, 0x3b, 0x06, 0x03, 0x1a, 0x74, 0x83, 0x8a, 0x19, 0xae, 0x47, 0xef, 0x63, 0x1c, 0x68, 0xe4,
+		0xdc, 0x16, 0x66, 0x72, 0xb0, 0x16, 0x66, 0xea, 0xbe, 0x59, 0x98, 0xd8, 0x6a, 0xbc, 0xa7, 0x82,
+		0x8a, 0xc4, 0x50, 0xbf, 0x22, 0x31, 0xac, 0xe9, 0x55, 0x7c, 0x79, 0xe6, 0x27, 0x25, 0x00, 0x27,
+		0xfb, 0x05, 0x2f, 0xef, 0x5d, 0xdc, 0x58, 0x5f, 0x2e, 0x6f, 0x6d, 0x17, 0xb7, 0x77, 0xb6, 0xbc,
+		0x9f, 0x3d, 0x14, 0x57, 0xfd, 0x9a, 0x2d, 0xad, 0xc2, 0xb2, 0x87, 0x1e, 0x81, 0x69, 0x2f, 0x34,
+		0x3e, 0x95, 0x96, 0x73, 0x52, 0x61, 0xf4, 0xcd, 0xb7, 0x66, 0x33, 0xcc, 0xd1, 0xd2, 0xf0, 0x43,
+		0x09, 0x87, 0x83, 0x70, 0xf8, 0xc9, 0xc4, 0x44, 0x61, 0xec, 0xcd, 0xb7, 0x66, 0xb3, 0xb6, 0x47,
+		0x46, 0x64, 0x20, 0x6e, 0x48, 0x4e, 0x2f, 0x59, 0x80, 0x37, 0xdf, 0x9a, 0x4d, 0xb3, 0x85, 0xbe,
+		0x90, 0xc2, 0x0b, 0x7d, 0xcf, 0xbc, 0x17, 0x60, 0x45, 0xdf, 0x6b, 0xab, 0x15, 0xca, 0xfc, 0x02,
+		0x1c, 0x59, 0x59, 0xbf, 0xa2, 0x14, 0x97, 0xb6, 0x57, 0x36, 0xd6, 0xbd, 0xcd, 0xf6, 0xbd, 0x5b,
+		0xde, 0xd8, 0x59, 0x5c, 0x2d, 0x95, 0xf1, 0x0a, 0x61, 0x76, 0x80, 0xc2, 0xf3, 0xee, 0xe5, 0xf5,
+		0xed, 0x95, 0xb5, 0x52, 0x2e, 0x71, 0xe6, 0xd3, 0x12, 0x80, 0xb3, 0x72, 0xe2, 0xe7, 0x20, 0xaf,
+		0x17, 0x57, 0x56, 0xcb, 0x4a, 0xa9, 0xb8, 0x15, 0xa8, 0x20, 0x0f, 0xd3, 0xee, 0x97, 0x36, 0x15,
+		0xc9, 0x8f, 0xe6, 0xae, 0x3b, 0x41, 0x1e, 0x84, 0x19, 0xf7, 0xcb, 0xad, 0xd2, 0xea, 0x95, 0x32,
+		0xeb, 0xfc, 0xb5, 0x0d, 0x65, 0xfb, 0x4a, 0x71, 0x75, 0x35, 0x97, 0xc4, 0xc6, 0xbb, 0x81, 0xae,
+		0x6e, 0xdc, 0x28, 0x29, 0xeb, 0xc5, 0xf5, 0xa5, 0x52, 0x2e, 0x75, 0xe6, 0xb3, 0x12, 0x1c, 0x09,
+		0x5f, 0x56, 0xc8, 0x63, 0xf0, 0xf0, 0x8d, 0xe2, 0xea, 0xca, 0x72, 0x71, 0x7b, 0x43, 0x29, 0x6f,
+		0x95, 0xb6, 0xcb, 0x4b, 0xd7, 0x8a, 0xeb, 0x57, 0x4b, 0x61, 0x1f, 0xb3, 0x94, 0xe1, 0x54, 0x34,
+		0xe8, 0xf5, 0x8d, 0x15, 0x64, 0x53, 0x57, 0x98, 0xd2, 0x2b, 0x2b, 0xdb, 0xac, 0x3b, 0xd1, 0x30,
+		0x9b, 0x1b, 0x2f, 0x97, 0x94, 0x5c, 0x72, 0xf1, 0x4a, 0x64, 0x66, 0xf4, 0xe3, 0x5d, 0xe7, 0x86,
+		0xe3, 0xc7, 0x7b, 0xd3, 0xa3, 0x3f, 0x9d, 0x8b, 0x4c, 0x8f, 0xae, 0x69, 0xba, 0x66, 0xd6, 0xcd,
+		0x03, 0xa5, 0x47, 0xf7, 0x94, 0x72, 0xdd, 0xe7, 0x87, 0xf6, 0x7e, 0x76, 0x0c, 0x46, 0xaf, 0xb2,
+		0x36, 0xe1, 0x64, 0xd3, 0x48, 0x11, 0xd2, 0x9e, 0xcb, 0x28, 0x22, 0xf3, 0x17, 0x58, 0xe8, 0xc2,
+		0x93, 0xd6, 0xc2, 0x10, 0xc9, 0x7b, 0xf9, 0x55, 0x75, 0xec, 0x98, 0x94, 0x73, 0x74, 0x6e, 0x74,
+		0xf1, 0xa9, 0xfe, 0x0c, 0x5e, 0x1e, 0xc9, 0x41, 0x62, 0xdb, 0x48, 0x8b, 0xd9, 0x1b, 0x75, 0x38,
+		0x4c, 0xc9, 0x3b, 0xda, 0x96, 0x56, 0x21, 0xf6, 0x7d, 0xcf, 0x44, 0xc6, 0xfd, 0x54, 0xd3, 0xb9,
+		0xbc, 0x89, 0x92, 0x72, 0x37, 0x7e, 0xaa, 0x11, 0x78, 0x8d, 0x3b, 0x37, 0xc1, 0x3b, 0x0d, 0xfb,
+		0xcb, 0xaf, 0x76, 0xe1, 0x93, 0x0d, 0x18, 0xe1, 0xab, 0x06, 0xfd, 0x84, 0xe3, 0x50, 0x4c, 0x42,
+		0x43, 0xa8, 0x0f, 0xe5, 0xa6, 0x40, 0x6e, 0xc2, 0x61, 0x27, 0x22, 0xe4, 0x26, 0x9d, 0xa6, 0xa4,
+		0xcf, 0xf6, 0xb1, 0x03, 0xee, 0xae, 0x63, 0xba, 0x13, 0x7c, 0x8f, 0x96, 0xc4, 0x98, 0xdb, 0x7f,
+		0x13, 0x97, 0x15, 0xf4, 0xed, 0x05, 0x7a, 0xa9, 0x60, 0x1e, 0xa8, 0x76, 0xa7, 0x65, 0xb4, 0x2d,
+		0xad, 0x4a, 0x37, 0x1a, 0x32, 0x8a, 0xfd, 0x4c, 0x1a, 0x70, 0xc4, 0xe2, 0x76, 0x1e, 0xcb, 0xe7,
+		0x2a, 0xb7, 0xa9, 0xa5, 0x67, 0xe6, 0xb3, 0xdd, 0x3b, 0x18, 0x62, 0x1d, 0x7a, 0x3a, 0x68, 0x05,
+		0xdf, 0x9b, 0xe4, 0x5d, 0x70, 0x92, 0x8b, 0x6d, 0x48, 0x95, 0xe5, 0x3a, 0x0b, 0xff, 0xa7, 0x94,
+		0x63, 0x4c, 0x1c, 0x03, 0x04, 0x56, 0xaa, 0xa4, 0x05, 0x05, 0x26, 0xf3, 0xae, 0x2d, 0x0c, 0xc7,
+		0xe7, 0x1b, 0x39, 0xf8, 0x14, 0x38, 0x4a, 0xc9, 0x3a, 0x5b, 0x16, 0xb6, 0x63, 0x79, 0x07, 0x0a,
+		0x2d, 0x66, 0x49, 0x96, 0x03, 0x9b, 0x17, 0x78, 0x39, 0x2e, 0x72, 0x69, 0x3e, 0x72, 0x06, 0x87,
+		0xdb, 0xa0, 0x6e, 0x4e, 0xe5, 0x5b, 0xe1, 0x30, 0x38, 0xc9, 0x27, 0x5f, 0xa7, 0xf6, 0x5a, 0x99,
+		0x85, 0xe7, 0x9b, 0x66, 0x8d, 0x25, 0x01, 0x76, 0xc9, 0x88, 0xf3, 0x1a, 0x78, 0xee, 0x7a, 0x26,
+		0x5e, 0xf7, 0xbc, 0x32, 0xc9, 0x7b, 0xec, 0x94, 0x54, 0xec, 0x10, 0xbb, 0xf1, 0x7c, 0xbc, 0x3b,
+		0x75, 0xaf, 0x59, 0xe7, 0xa1, 0x5e, 0xf5, 0xbc, 0x32, 0xc9, 0x1e, 0x4c, 0x3b, 0xda, 0xa3, 0x5d,
+		0x37, 0x6f, 0xf2, 0x60, 0xf3, 0x44, 0xf7, 0x0d, 0xd5, 0x40, 0x9c, 0xd4, 0x5d, 0x09, 0xb9, 0xe5,
+		0x7f, 0xeb, 0xab, 0x07, 0xd3, 0x7f, 0xe9, 0xbd, 0x9d, 0x22, 0x73, 0x30, 0xbe, 0x1e, 0xe1, 0xa7,
+		0x87, 0xd7, 0x23, 0xde, 0xd2, 0x4c, 0x1f, 0x4e, 0xa3, 0xdc, 0x52, 0xe9, 0x1d, 0x23, 0x93, 0x2c,
+		0xd3, 0x87, 0x97, 0x6e, 0xd2, 0x42, 0xb2, 0x4f, 0xaf, 0xf7, 0xc3, 0xdc, 0xf1, 0xb2, 0xea, 0x24,
+		0x8f, 0x9b, 0x79, 0xd2, 0x5d, 0x71, 0x06, 0xf3, 0xcd, 0x3d, 0x8a, 0xb3, 0x15, 0x78, 0x4d, 0x3b,
+		0x6e, 0xb2, 0xd0, 0x2e, 0xe6, 0xfb, 0x0b, 0xe7, 0x56, 0x44, 0xf3, 0x23, 0x3b, 0x1e, 0x08, 0x07,
+		0x7b, 0x3a, 0x6e, 0x7a, 0xde, 0x22, 0x3d, 0x79, 0x1f, 0x48, 0x50, 0xad, 0x93, 0xf3, 0x30, 0xdc,
+		0xab, 0x1d, 0x1f, 0x3c, 0x4a, 0xee, 0x3e, 0xe4, 0xed, 0x6c, 0x8e, 0x0f, 0xdc, 0x46, 0xf8, 0x7b,
+		0x09, 0xe0, 0x9e, 0xca, 0x7c, 0xd3, 0xc4, 0xb5, 0x1c, 0xff, 0x71, 0xb3, 0x60, 0x8c, 0x37, 0x15,
+		0x4b, 0x6e, 0x3d, 0xd9, 0xc3, 0x97, 0x98, 0x9f, 0xc3, 0x8f, 0xc3, 0xb7, 0x6f, 0xd5, 0x43, 0x3f,
+		0xdf, 0xbd, 0xc5, 0xde, 0x88, 0x2f, 0x07, 0xff, 0xb1, 0xf8, 0xfc, 0xb0, 0x40, 0x59, 0xc0, 0x84,
+		0xf4, 0x7a, 0x0d, 0x3d, 0xe0, 0xd8, 0xaf, 0x30, 0x53, 0xe4, 0x24, 0xbd, 0x9c, 0x87, 0x62, 0x2c,
+		0x5e, 0x8d, 0x64, 0xcb, 0xb9, 0x1e, 0xbe, 0x39, 0x8c, 0x4a, 0x83, 0x35, 0xc2, 0xe6, 0xcb, 0x8f,
+		0x6f, 0xc0, 0x0c, 0x83, 0x0b, 0xd8, 0x39, 0xd6, 0x9d, 0x18, 0xb3, 0xe9, 0x7e, 0x9e, 0x1f, 0xeb,
+		0xfb, 0xab, 0xc6, 0x71, 0xa7, 0xca, 0x7a, 0xb3, 0xe6, 0xa2, 0x64, 0x24, 0xcc, 0x96, 0xfb, 0xf6,
+		0x21, 0x20, 0x6b, 0x66, 0x6d, 0xa9, 0xad, 0xa9, 0x96, 0xeb, 0x43, 0x8d, 0xbe, 0xa4, 0x7b, 0xe9,
+		0xde, 0x93, 0xee, 0x15, 0x4f, 0xde, 0x7b, 0xa2, 0xbf, 0xd3, 0x4d, 0x11, 0xc9, 0xef, 0xaf, 0x87,
+		0x27, 0xbf, 0x27, 0x07, 0xb5, 0x3b, 0x17, 0x92, 0x01, 0x7f, 0x35, 0xcc, 0xf1, 0x67, 0xbe, 0x71,
+		0x21, 0x4a, 0x61, 0xe4, 0xa5, 0x5e, 0x5d, 0xff, 0xa1, 0x41, 0xec, 0x59, 0xa5, 0xef, 0x65, 0xcf,
+		0x8a, 0x2c, 0xb8, 0x6f, 0x4f, 0xeb, 0x75, 0xd7, 0x83, 0xa1, 0x2c, 0xbc, 0x4b, 0x68, 0xbe, 0x8f,
+		0x7c, 0xf9, 0xb3, 0x67, 0x82, 0xdd, 0xc3, 0x2d, 0xcd, 0x93, 0x2e, 0xde, 0x07, 0xe5, 0x4e, 0x3e,
+		0x01, 0x85, 0x60, 0xa9, 0x08, 0xdd, 0xcb, 0x3f, 0x9f, 0x84, 0xdc, 0x9a, 0x59, 0x2b, 0x55, 0xeb,
+		0xd6, 0xfd, 0x14, 0xd5, 0x41, 0x47, 0x65, 0x54, 0x98, 0xf0, 0x27, 0x0b, 0x31, 0x11, 0x7d, 0xe6,
+		0xc0, 0x69, 0x73, 0xe3, 0xde, 0xf3, 0x7d, 0x64, 0x3f, 0x7c, 0x26, 0xa4, 0xfa, 0xaa, 0xa6, 0x97,
+		0x23, 0x20, 0x0b, 0x2f, 0xc4, 0x8f, 0xf1, 0x71, 0xef, 0x18, 0x7b, 0x86, 0x4b, 0x2e, 0x40, 0xde,
+		0x5f, 0x66, 0x8f, 0xef, 0x5b, 0x78, 0xfd, 0x87, 0x29, 0x3c, 0x08, 0xed, 0x9d, 0x1a, 0x65, 0x7b,
+		0xce, 0x3e, 0x4f, 0x91, 0xec, 0x63, 0x8e, 0x70, 0x9c, 0x85, 0x67, 0x3d, 0x0c, 0x0c, 0xf4, 0x0f,
+		0x19, 0x78, 0xc4, 0xcb, 0x40, 0xc1, 0x0f, 0xf9, 0x30, 0x4c, 0xb9, 0x1e, 0x6d, 0xb6, 0x7d, 0x5b,
+		0x92, 0xea, 0x70, 0x7a, 0xdd, 0x80, 0xed, 0x41, 0x0d, 0x8c, 0x7b, 0x3b, 0xdd, 0xf3, 0x1a, 0x7b,
+		0xe0, 0x60, 0x68, 0x82, 0xe3, 0x4e, 0xf7, 0x04, 0xc7, 0xbe, 0xc8, 0xba, 0x32, 0x1d, 0x9d, 0xb1,
+		0x49, 0x1d, 0x60, 0x6c, 0xde, 0x15, 0x3f, 0x36, 0x3e, 0x05, 0xe6, 0x63, 0xba, 0xdc, 0x82, 0x42,
+		0xb0, 0xd4, 0xde, 0x14, 0x0d, 0x49, 0xfd, 0x95, 0xee, 0x31, 0xf5, 0x57, 0xfe, 0x74, 0x02, 0xc6,
+		0xd6, 0xcc, 0xda, 0x8e, 0x3e, 0xe8, 0x81, 0x7f, 0x67, 0x4d, 0x9b, 0xcb, 0xf1, 0x43, 0x93, 0xf7,
+		0x0e, 0x8d, 0xc3, 0x11, 0x4c, 0x19, 0x38, 0xec, 0x29, 0xb9, 0x9f, 0x23, 0xe2, 0xea, 0x68, 0xa2,
+		0xff, 0x8e, 0xe2, 0x17, 0x61, 0x4f, 0xe0, 0x1a, 0x88, 0x9b, 0xec, 0x8d, 0xfb, 0x78, 0x86, 0xe2,
+		0x1d, 0x35, 0xbc, 0x61, 0xd9, 0xf3, 0xa9, 0xb0, 0xec, 0xf9, 0x85, 0xeb, 0xf1, 0x72, 0xf0, 0xa8,
+		0xcf, 0xc6, 0x88, 0xe2, 0xa4, 0xfc, 0x08, 0x3c, 0xd4, 0xed, 0xbd, 0xad, 0x60, 0x7f, 0x41, 0x82,
+		0x09, 0x14, 0x1f, 0xba, 0x97, 0xc8, 0xb3, 0x9d, 0xf1, 0x60, 0x5a, 0xc7, 0xda, 0x67, 0x57, 0x8c,
+		0xc6, 0x71, 0xdf, 0x01, 0x75, 0xc5, 0x4a, 0x13, 0x07, 0x8c, 0x95, 0x2e, 0x5c, 0xc2, 0xae, 0x3b,
+		0x24, 0xb1, 0xcb, 0x0f, 0x85, 0x7a, 0x8f, 0xbe, 0x36, 0xcb, 0xc7, 0xe0, 0xa8, 0xaf, 0xc8, 0xee,
+		0xe2, 0x5f, 0x24, 0x60, 0x12, 0xbf, 0x92, 0xe8, 0x8e, 0x51, 0x99, 0x7f, 0x3d, 0x45, 0x6d, 0x15,
+		0x0e, 0x8b, 0x70, 0x1d, 0x3f, 0xf2, 0x59, 0x66, 0x5b, 0xbe, 0xa9, 0xb8, 0xd4, 0x7a, 0x1b, 0x8d,
+		0xb2, 0x67, 0x03, 0x91, 0x16, 0x5e, 0x8c, 0x97, 0xc7, 0x13, 0x5e, 0x79, 0xf4, 0xf2, 0x58, 0x7e,
+		0x15, 0x8e, 0x05, 0x0a, 0x6d, 0xf5, 0xe4, 0xf4, 0x54, 0x3a, 0x80, 0x2a, 0xf9, 0xb2, 0x44, 0x8d,
+		0x2d, 0x5c, 0x88, 0xb4, 0x26, 0xad, 0xc1, 0xbc, 0x62, 0xb4, 0x07, 0x3b, 0xb6, 0xf7, 0xa4, 0xec,
+		0x16, 0xae, 0xc4, 0x73, 0xef, 0x41, 0x2f, 0xf7, 0x42, 0x3b, 0x23, 0xbf, 0x1f, 0x66, 0xa3, 0xde,
+		0x0d, 0x88, 0x97, 0x7f, 0x29, 0xc1, 0x29, 0x1c, 0xa7, 0xb6, 0xaa, 0x9b, 0x7b, 0x5a, 0x3b, 0x2c,
+		0x97, 0xe0, 0x12, 0xe4, 0x23, 0xe3, 0xc0, 0x6c, 0x4b, 0xfa, 0xb0, 0x15, 0x1a, 0x03, 0x7e, 0xc2,
+		0xbe, 0xc5, 0x38, 0x2e, 0xeb, 0x80, 0xc3, 0x91, 0x0b, 0x90, 0xd5, 0xb5, 0xdb, 0x5c, 0x6e, 0x63,
+		0xaf, 0x55, 0xd0, 0xb5, 0xdb, 0x4c, 0x58, 0x9f, 0x46, 0x36, 0x73, 0x1a, 0x7e, 0xb5, 0x11, 0xd2,
+		0x39, 0xd6, 0x40, 0xf9, 0x34, 0x3c, 0xd2, 0xbd, 0xe7, 0xb6, 0x16, 0xf9, 0x3d, 0x89, 0x3a, 0x68,
+		0x37, 0x3c, 0x67, 0x05, 0xdf, 0x99, 0x4a, 0x64, 0xe1, 0x62, 0xb4, 0xc8, 0xf9, 0x1c, 0x18, 0x4f,
+		0x77, 0xb8, 0x03, 0xe3, 0x29, 0xb3, 0xfb, 0xff, 0xf9, 0x24, 0x1c, 0xb6, 0x57, 0x94, 0xfb, 0x71,
+		0xc8, 0xe8, 0x6f, 0x90, 0x31, 0x1e, 0x66, 0x12, 0x0c, 0x85, 0x9a, 0x04, 0x4b, 0xf1, 0x4a, 0x64,
+		0x36, 0xcc, 0x24, 0x70, 0x0f, 0x90, 0x3c, 0x03, 0x27, 0x43, 0x5f, 0xd8, 0x63, 0xfb, 0x23, 0x6c,
+		0x85, 0xc4, 0xcd, 0x80, 0x77, 0xbe, 0x31, 0x36, 0x90, 0x44, 0x10, 0x66, 0x68, 0xf4, 0xb4, 0xa6,
+		0x79, 0xb9, 0x22, 0xab, 0x70, 0x2c, 0x50, 0x68, 0xeb, 0x61, 0x77, 0xba, 0x87, 0x74, 0xd0, 0x74,
+		0x0f, 0xf9, 0x9f, 0xd1, 0x0b, 0x8b, 0x9d, 0x71, 0x5a, 0xd6, 0x4c, 0xab, 0xae, 0xd3, 0x9f, 0xd1,
+		0xe2, 0x2c, 0x0d, 0x48, 0x9c, 0x0f, 0x62, 0xd7, 0xff, 0x72, 0x82, 0x7a, 0xe9, 0x76, 0x9b, 0x35,
+		0x9a, 0x01, 0xf3, 0x0e, 0x57, 0x0c, 0xef, 0x83, 0xd1, 0xaa, 0xc3, 0x58, 0xb1, 0x5d, 0x3e, 0xdf,
+		0xcb, 0xfe, 0xad, 0x6b, 0x40, 0x3c, 0x09, 0xbd, 0x6e, 0x7a, 0x0b, 0xcf, 0xf4, 0xec, 0x68, 0xfb,
+		0xf8, 0x26, 0xb7, 0xa1, 0x10, 0x2c, 0xb5, 0x65, 0x6c, 0x1b, 0x72, 0x8e, 0x53, 0x46, 0x45, 0x4d,
+		0xa4, 0x0f, 0xf6, 0x21, 0x6b, 0x13, 0x5e, 0xbf, 0xce, 0x94, 0x3f, 0x93, 0x80, 0xe9, 0x35, 0xb3,
+		0xb6, 0xe2, 0x3b, 0x97, 0xf2, 0xd7, 0xd4, 0xe3, 0x5e, 0x88, 0x1e, 0x9b, 0x19, 0xef, 0xd8, 0x04,
+		0x18, 0x22, 0x7f, 0xb7, 0x04, 0x27, 0xc2, 0x5e, 0x0c, 0xc6, 0x18, 0x23, 0x17, 0x21, 0xb9, 0xa7,
+		0xf5, 0x97, 0x98, 0x8f, 0x08, 0xf2, 0x9f, 0x4b, 0xd4, 0x03, 0xda, 0xd2, 0xac, 0xe0, 0xf1, 0x95,
+		0x83, 0x3a, 0x74, 0x83, 0x1e, 0x34, 0x71, 0xec, 0x25, 0xe9, 0x1c, 0x7b, 0x59, 0x78, 0x31, 0xe8,
+		0xf1, 0x3d, 0x1e, 0xe5, 0xf1, 0x85, 0x75, 0x4e, 0x7e, 0x00, 0x66, 0x22, 0x5e, 0xd9, 0xeb, 0xdb,
+		0x0f, 0x48, 0x34, 0xba, 0xb8, 0xa5, 0x59, 0x5b, 0x9e, 0x0d, 0xe0, 0x83, 0xf2, 0xe5, 0x08, 0x3a,
+		0xba, 0x48, 0x81, 0xdf, 0x09, 0xcd, 0x9f, 0x16, 0x2e, 0x07, 0xfb, 0x72, 0xba, 0x4b, 0x5f, 0x3c,
+		0x8d, 0x91, 0x4f, 0xc2, 0xf1, 0x90, 0x62, 0xbb, 0x0f, 0x9f, 0x4e, 0xd0, 0x85, 0x87, 0x6d, 0x2b,
+		0x07, 0x6e, 0x6b, 0x73, 0x5f, 0x45, 0x26, 0xf5, 0x7c, 0x15, 0xd9, 0xa0, 0xc7, 0xd7, 0xbe, 0x26,
+		0x2d, 0xe9, 0xbe, 0x26, 0xad, 0xef, 0x0b, 0xcf, 0x98, 0xfd, 0x6a, 0x37, 0x33, 0xc4, 0x9a, 0x0f,
+		0xe7, 0x82, 0xfc, 0x20, 0x3c, 0x10, 0xf9, 0xd2, 0x66, 0xe4, 0x1f, 0xdb, 0x13, 0xe5, 0xaf, 0xcc,
+		0x69, 0xab, 0x85, 0xe7, 0xa3, 0x77, 0x24, 0x64, 0x2f, 0x67, 0xc2, 0xba, 0xe5, 0x4c, 0x91, 0xc0,
+		0x2b, 0xc1, 0x95, 0xf3, 0x7f, 0x36, 0x09, 0x49, 0xcc, 0x32, 0x7e, 0x1d, 0x26, 0xfc, 0x1b, 0xa6,
+		0x91, 0x89, 0x10, 0xc1, 0xed, 0xac, 0xc2, 0xf9, 0xde, 0x61, 0x6d, 0x7d, 0x79, 0x13, 0xc6, 0xbc,
+		0xdb, 0x5e, 0xa7, 0xbb, 0x10, 0xf1, 0x40, 0x16, 0x9e, 0xe8, 0x15, 0xd2, 0xae, 0xec, 0x3d, 0x90,
+		0xb1, 0xf7, 0x60, 0x1e, 0xec, 0x82, 0x2d, 0x80, 0x0a, 0x67, 0x7b, 0x00, 0xb2, 0xa9, 0xbf, 0x0e,
+		0x13, 0xfe, 0xad, 0x8a, 0x6e, 0xdc, 0xf3, 0xc1, 0x16, 0xce, 0xf7, 0x0e, 0x6b, 0x57, 0xb9, 0x0b,
+		0xe0, 0x5a, 0xad, 0x1f, 0xee, 0x42, 0xc1, 0x01, 0x2b, 0x9c, 0xeb, 0x09, 0xcc, 0xae, 0xe3, 0x3b,
+		0x25, 0x38, 0x16, 0x1d, 0xb4, 0x7d, 0xba, 0xdb, 0x98, 0x47, 0x61, 0x15, 0x9e, 0x3b, 0x08, 0x96,
+		0xdd, 0xa2, 0x7d, 0x18, 0xf5, 0x84, 0x2c, 0x1f, 0xed, 0xd6, 0x21, 0x17, 0x60, 0x61, 0xbe, 0x47,
+		0x40, 0xbb, 0x26, 0x1d, 0xc6, 0x7d, 0x91, 0xc3, 0xc7, 0xba, 0x90, 0xf0, 0x82, 0x16, 0x9e, 0xec,
+		0x19, 0xd4, 0xae, 0xef, 0xa3, 0xf4, 0x4b, 0x37, 0x61, 0x51, 0xad, 0x6e, 0xc2, 0x1e, 0x8a, 0x51,
+		0x78, 0xa6, 0x5f, 0x0c, 0xbb, 0x15, 0x7f, 0x4f, 0x82, 0xe3, 0xdd, 0xe2, 0x41, 0x17, 0xbb, 0x75,
+		0x2c, 0x1a, 0xaf, 0xf0, 0xc2, 0xc1, 0xf0, 0xdc, 0xba, 0xc2, 0x1b, 0x81, 0xe9, 0xa6, 0x2b, 0x3c,
+		0x90, 0x85, 0x27, 0x7a, 0x85, 0xb4, 0x2b, 0x7b, 0x03, 0x48, 0x48, 0xb8, 0xe3, 0x5c, 0xac, 0xe0,
+		0xba, 0xc1, 0x0b, 0x17, 0xfa, 0x02, 0x77, 0x8b, 0x9d, 0xcf, 0x1d, 0xef, 0x26, 0x76, 0x5e, 0xd0,
+		0xc2, 0x93, 0x3d, 0x83, 0xba, 0x35, 0x97, 0xdf, 0x7d, 0x3b, 0x13, 0x23, 0x3d, 0x2e, 0xd8, 0xc2,
+		0xf9, 0xde, 0x61, 0xed, 0x2a, 0x6f, 0xc3, 0x64, 0xd0, 0xdd, 0x78, 0xbc, 0x0b, 0xa1, 0x00, 0x74,
+		0xe1, 0xe9, 0x7e, 0xa0, 0xed, 0x8a, 0x3f, 0x2c, 0xc1, 0x74, 0xa8, 0x9d, 0xdc, 0x4d, 0x39, 0x84,
+		0x21, 0x14, 0x2e, 0xf5, 0x89, 0x60, 0x37, 0xc1, 0x82, 0x5c, 0xc0, 0x1a, 0x3d, 0xdb, 0x9d, 0x98,
+		0x07, 0xb8, 0xf0, 0x54, 0x1f, 0xc0, 0x76, 0xad, 0x1f, 0x93, 0xe0, 0x48, 0x84, 0x01, 0xd9, 0x4d,
+		0x64, 0xc2, 0x51, 0x0a, 0xcf, 0xf6, 0x8d, 0xe2, 0x1f, 0x81, 0xa0, 0x01, 0x36, 0x1f, 0xdb, 0x2d,
+		0x2f, 0x42, 0xe1, 0x52, 0x9f, 0x08, 0xa2, 0x09, 0x85, 0xa1, 0x0f, 0xa3, 0xef, 0x34, 0xf0, 0x3c,
+		0xc6, 0xdf, 0x49, 0xc0, 0x19, 0x77, 0xa6, 0x1b, 0xfd, 0xf4, 0xae, 0x9d, 0xcc, 0xd6, 0x52, 0x6b,
+		0x3c, 0x3e, 0xc0, 0x53, 0xf7, 0x8e, 0xb9, 0xfd, 0x3b, 0x0a, 0x2b, 0x3a, 0x80, 0xc7, 0xd2, 0x47,
+		0x36, 0xd5, 0x9a, 0xc6, 0x2f, 0xba, 0x77, 0x7f, 0xce, 0x88, 0x7f, 0x0d, 0xf2, 0x08, 0xa4, 0x8d,
+		0xbd, 0x3d, 0x76, 0xd5, 0x39, 0xfd, 0x7e, 0x0a, 0x7b, 0x42, 0x83, 0xbb, 0x51, 0x17, 0xdf, 0x08,
+		0x4f, 0x29, 0xec, 0x01, 0xef, 0x12, 0xa2, 0x07, 0xf6, 0xd8, 0x39, 0x04, 0x7e, 0xf7, 0x1d, 0xd0,
+		0x22, 0x7a, 0x9a, 0x00, 0xaf, 0x9a, 0x6e, 0x6b, 0xf8, 0x45, 0x50, 0x8d, 0x1b, 0xe4, 0xe2, 0x51,
+		0x7e, 0x11, 0x46, 0x59, 0x4b, 0xf8, 0xf0, 0x1d, 0x83, 0x0c, 0xfd, 0x2c, 0xb4, 0xd3, 0x9e, 0x61,
+		0x7c, 0xe6, 0x1f, 0x07, 0x62, 0xf4, 0x59, 0x93, 0xd8, 0xc3, 0xe2, 0x62, 0x24, 0x97, 0x4f, 0xf7,
+		0x90, 0x16, 0x49, 0xf9, 0x62, 0x73, 0xf8, 0x17, 0x86, 0xe0, 0x30, 0xcf, 0x4e, 0x54, 0x5b, 0xf5,
+		0xf9, 0x7d, 0xcb, 0xe2, 0x39, 0x89, 0x04, 0x58, 0xf1, 0x9c, 0xda, 0xaa, 0xcb, 0x77, 0x21, 0x75,
+		0xcd, 0xb2, 0x5a, 0xe4, 0x0c, 0x0c, 0xb5, 0x3b, 0x0d, 0x3b, 0x44, 0x62, 0x1b, 0xe0, 0x6a, 0xab,
+		0x3e, 0x87, 0x00, 0x4a, 0xa7, 0xa1, 0x29, 0x0c, 0x84, 0x94, 0x60, 0x66, 0xaf, 0xd3, 0x68, 0xdc,
+		0x2d, 0x57, 0xb5, 0x8a, 0x51, 0xc5, 0x2d, 0x0e, 0xcc, 0xc6, 0xc4, 0x94, 0xee, 0x3b, 0x2d, 0x55,
+		0xb7, 0x93, 0xfb, 0x32, 0xca, 0x09, 0x0a, 0xb6, 0x4c, 0xa1, 0x14, 0x0e, 0x54, 0x12, 0x30, 0xf2,
+		0x6f, 0x27, 0x20, 0x23, 0x48, 0xa3, 0x9b, 0x63, 0x6a, 0x0d, 0xad, 0x62, 0xd9, 0xdf, 0x7e, 0xb5,
+		0x9f, 0x09, 0x81, 0x64, 0x8d, 0x0f, 0x5e, 0xf6, 0xda, 0x21, 0x05, 0x1f, 0xb0, 0xac, 0xd5, 0xe1,
+		0x27, 0x2d, 0xb1, 0xac, 0xd5, 0xc1, 0xf1, 0x4c, 0xb5, 0x0c, 0x71, 0x29, 0x19, 0x7e, 0x4b, 0x1a,
+		0x9f, 0x48, 0x1e, 0xd2, 0xa8, 0xd9, 0x2c, 0x7e, 0x8a, 0x17, 0xbf, 0x4f, 0xcc, 0x9e, 0xc9, 0x11,
+		0xbc, 0x72, 0xd3, 0xaa, 0xec, 0xb3, 0x7b, 0x9b, 0xf0, 0x03, 0xd0, 0xf4, 0x91, 0x5c, 0x82, 0x74,
+		0xa5, 0x63, 0x5a, 0x46, 0x93, 0x7f, 0x24, 0xf4, 0xa4, 0x9b, 0x19, 0x4b, 0xf4, 0x0d, 0xb6, 0x7b,
+		0x53, 0xb5, 0x2c, 0xad, 0x8d, 0x1f, 0x9d, 0xe6, 0xe0, 0xe8, 0xb7, 0xef, 0x1a, 0xd5, 0xbb, 0xfc,
+		0xc2, 0x38, 0xfa, 0x9b, 0x7d, 0x08, 0x90, 0xc9, 0x43, 0x99, 0xbe, 0x1c, 0xa5, 0x2f, 0x47, 0x45,
+		0xe1, 0x22, 0x02, 0x95, 0x60, 0x4a, 0xad, 0x56, 0xeb, 0x28, 0xf0, 0x78, 0x7d, 0x5b, 0x9d, 0x5d,
+		0xa5, 0x93, 0x1f, 0xe9, 0x32, 0x16, 0xc4, 0x41, 0x58, 0xe4, 0xf0, 0x8b, 0x59, 0x18, 0x6e, 0xb1,
+		0x46, 0xc9, 0x97, 0x61, 0x32, 0xd0, 0x52, 0x6c, 0xdf, 0xcd, 0x3a, 0xff, 0x96, 0x59, 0x56, 0xa1,
+		0xbf, 0xb1, 0xac, 0xa5, 0x5a, 0xfb, 0xe2, 0x8a, 0x0d, 0xfc, 0xbd, 0xf8, 0x11, 0x29, 0x4a, 0x0e,
+		0x01, 0x13, 0x1d, 0x5c, 0xed, 0x59, 0xcc, 0x52, 0xfa, 0x28, 0x56, 0x9b, 0xd2, 0x6b, 0x45, 0xfe,
+		0xa2, 0x66, 0x34, 0x54, 0xbd, 0x36, 0x67, 0xb4, 0x6b, 0x78, 0x70, 0x49, 0x64, 0xbf, 0xe2, 0x2b,
+		0xb5, 0x55, 0x37, 0xa9, 0x38, 0xaa, 0xba, 0x6e, 0xf0, 0xac, 0xef, 0xcb, 0xae, 0xdf, 0x7f, 0x29,
+		0x49, 0x3f, 0x90, 0x48, 0x5d, 0x2d, 0x6e, 0xae, 0xd8, 0x72, 0xfc, 0xf3, 0x09, 0x38, 0xe1, 0x92,
+		0x63, 0x17, 0x70, 0x50, 0x9c, 0x0b, 0xe1, 0x12, 0xdf, 0x43, 0x2a, 0xf4, 0x4b, 0x90, 0x42, 0x78,
+		0x72, 0x2a, 0x24, 0x95, 0xd9, 0xda, 0x37, 0xaa, 0x22, 0x93, 0xf9, 0xb3, 0x5f, 0xf8, 0x59, 0x79,
+		0x56, 0x8a, 0x1c, 0x15, 0x4a, 0x64, 0xf1, 0x63, 0xbd, 0xf3, 0x2f, 0x57, 0x74, 0xfa, 0x33, 0x38,
+		0x36, 0xfa, 0x79, 0xf8, 0x87, 0x12, 0xf0, 0x0d, 0x2d, 0x5b, 0xd1, 0xb2, 0x1f, 0x9c, 0x85, 0x13,
+		0xec, 0xa5, 0xad, 0x59, 0x7b, 0x60, 0xd8, 0x1a, 0x4c, 0xf2, 0x53, 0xcf, 0x14, 0xa9, 0x6c, 0xaa,
+		0x7b, 0x5a, 0x2c, 0xf7, 0xfe, 0x44, 0x24, 0x91, 0x4f, 0x30, 0x5c, 0xfa, 0x3d, 0xa0, 0x2d, 0x75,
+		0x4f, 0x1b, 0xa8, 0xe6, 0xfb, 0xed, 0x26, 0xc8, 0x11, 0x59, 0xd2, 0xee, 0x4e, 0x47, 0xa5, 0x83,
+		0xf7, 0xb1, 0x2e, 0x45, 0xa4, 0x77, 0x77, 0x95, 0xd7, 0x7b, 0x3f, 0x8f, 0xd7, 0x6d, 0x28, 0xc3,
+		0xbe, 0x3a, 0x12, 0x93, 0xc4, 0x2e, 0xdf, 0x81, 0x23, 0x74, 0x20, 0x9c, 0x0b, 0xf5, 0xc4, 0x5a,
+		0x7a, 0xc4, 0xf3, 0xd9, 0xc7, 0xac, 0x7d, 0x9f, 0xfc, 0x15, 0x00, 0x87, 0x15, 0x3c, 0x66, 0xf3,
+		0xc8, 0x5c, 0xe4, 0x1a, 0x3d, 0xe7, 0x5a, 0x9f, 0x15, 0x17, 0xa6, 0xfc, 0x59, 0x09, 0x8e, 0x06,
+		0xaa, 0xe6, 0x8b, 0xa7, 0xf7, 0x10, 0x9d, 0x74, 0x8f, 0x87, 0xe8, 0xae, 0x86, 0xb4, 0xf8, 0xd1,
+		0xd8, 0x16, 0xb3, 0xa6, 0x78, 0x9a, 0xfc, 0x0a, 0x1c, 0xf6, 0xb6, 0x58, 0xf0, 0xea, 0x45, 0x18,
+		0xf7, 0x06, 0x9e, 0x62, 0x03, 0x8c, 0x63, 0x9e, 0x38, 0x98, 0xfc, 0x63, 0x92, 0x7f, 0x1c, 0x6c,
+		0x5e, 0x5c, 0x0f, 0x7e, 0xa7, 0xaf, 0x3f, 0x56, 0x38, 0xe8, 0xe4, 0x0a, 0x64, 0xed, 0x23, 0x45,
+		0x9c, 0x11, 0xbd, 0x9f, 0x28, 0x52, 0x32, 0x1f, 0xe0, 0xbf, 0xf0, 0x1e, 0xa6, 0x59, 0x6f, 0x73,
+		0x5d, 0xc7, 0xfe, 0x06, 0xc5, 0x94, 0x81, 0x49, 0xda, 0x1f, 0x49, 0xf0, 0x40, 0x97, 0xd6, 0x72,
+		0x3e, 0xff, 0x37, 0x30, 0xed, 0x3a, 0x3e, 0x26, 0x96, 0x68, 0x21, 0x7d, 0x67, 0xe2, 0x8f, 0x90,
+		0x09, 0x4a, 0x8b, 0xb3, 0xc8, 0xfb, 0x1f, 0xfd, 0x9d, 0x99, 0xa9, 0xe0, 0x3b, 0x93, 0x1f, 0x80,
+		0x0a, 0x7a, 0xb3, 0x03, 0x14, 0xd3, 0xcf, 0x49, 0xf0, 0x98, 0xb7, 0xbf, 0x21, 0x61, 0xa2, 0x77,
+		0xde, 0x30, 0xbd, 0x2d, 0xc1, 0x99, 0x5e, 0x9a, 0xcd, 0xc7, 0xab, 0x06, 0x53, 0xce, 0x49, 0x56,
+		0xff, 0x70, 0x1d, 0xf4, 0x1c, 0x2b, 0xb1, 0x49, 0xde, 0x87, 0x71, 0xf9, 0xc7, 0x62, 0x92, 0xbb,
+		0xe5, 0xc2, 0x1e, 0x04, 0xef, 0x4e, 0x5b, 0xfc, 0x20, 0x78, 0xb6, 0x0f, 0x43, 0x46, 0x31, 0xd1,
+		0xd7, 0x28, 0xba, 0xee, 0x74, 0xbd, 0x05, 0x47, 0x03, 0xad, 0xe4, 0x3c, 0xff, 0x26, 0x98, 0x0a,
+		0x99, 0x23, 0x5c, 0x2b, 0xf5, 0x31, 0x45, 0x14, 0x12, 0x92, 0x61, 0xf1, 0xe3, 0x12, 0xcc, 0xd0,
+		0x8a, 0x43, 0x83, 0x9a, 0xef, 0x3c, 0x3e, 0xb5, 0x61, 0x36, 0xba, 0xb9, 0x9c, 0x61, 0xeb, 0x90,
+		0x66, 0x12, 0xc5, 0x79, 0x74, 0x50, 0xb9, 0xe4, 0x54, 0xe4, 0x7f, 0x25, 0x14, 0xef, 0xb2, 0xe8,
+		0x55, 0xf8, 0x8c, 0xbe, 0x37, 0x26, 0x0d, 0x68, 0x46, 0xbb, 0x78, 0xf5, 0x9b, 0x42, 0x05, 0x87,
+		0xb7, 0xdb, 0x8e, 0x58, 0x0f, 0x4a, 0x05, 0xbb, 0x0f, 0x9b, 0xde, 0x57, 0x5d, 0xfb, 0x73, 0x42,
+		0xd7, 0xda, 0x1d, 0x8b, 0xd1, 0xb5, 0xef, 0xb4, 0x91, 0xb1, 0xb5, 0x6e, 0x4c, 0x07, 0xfe, 0xca,
+		0x6a, 0xdd, 0x9f, 0x4b, 0xc0, 0x31, 0xda, 0x41, 0x77, 0x0c, 0x79, 0x90, 0x23, 0x42, 0x30, 0xdf,
+		0xa6, 0x4f, 0xa5, 0x92, 0x33, 0xdb, 0x95, 0x1b, 0xbe, 0x55, 0x94, 0x54, 0x4d, 0xcb, 0x4f, 0x27,
+		0x2e, 0xb7, 0x33, 0x57, 0x75, 0x45, 0x10, 0x43, 0x24, 0x24, 0x35, 0x00, 0x09, 0xf9, 0x0d, 0x09,
+		0x0a, 0x61, 0x0c, 0xb4, 0xa3, 0xf0, 0x47, 0x3c, 0xb7, 0x18, 0xfa, 0x85, 0xe2, 0xf1, 0x5e, 0xb2,
+		0x85, 0xc2, 0x26, 0xee, 0xe1, 0xb6, 0x76, 0xbf, 0xcd, 0xa4, 0x19, 0xaf, 0xe4, 0x07, 0x9d, 0xa0,
+		0x77, 0xe0, 0x84, 0xfd, 0xe9, 0xc0, 0x12, 0xf0, 0x57, 0xc7, 0x81, 0xfa, 0x31, 0x09, 0x4e, 0x45,
+		0xb4, 0xfd, 0x9d, 0xb8, 0xc2, 0x37, 0x23, 0x05, 0xe4, 0x7e, 0x78, 0x67, 0xf2, 0xd3, 0x7c, 0x9e,
+		0x79, 0x3f, 0xf5, 0xe9, 0xf2, 0xc7, 0xc3, 0xee, 0x17, 0x94, 0x5f, 0x85, 0xe3, 0xa1, 0x58, 0xbc,
+		0x81, 0x0b, 0x90, 0xda, 0xaf, 0x9b, 0x22, 0xcf, 0x2a, 0xf2, 0x26, 0x0c, 0x1f, 0x36, 0xc5, 0x91,
+		0x09, 0xe4, 0x28, 0xe9, 0x4d, 0xc3, 0x68, 0xf0, 0x66, 0xc8, 0x9b, 0x30, 0xe9, 0x2a, 0xe3, 0x95,
+		0x5c, 0xc6, 0xe8, 0xab, 0xd1, 0xb0, 0x3f, 0x94, 0x1f, 0x51, 0x09, 0xe2, 0xb8, 0xfb, 0x4e, 0x91,
+		0xe4, 0x69, 0x20, 0x8c, 0x22, 0xdf, 0x54, 0x66, 0xf5, 0xbc, 0x02, 0x53, 0x9e, 0x52, 0x5e, 0xd3,
+		0xbd, 0xdf, 0x35, 0x24, 0x3f, 0xc9, 0x47, 0x35, 0x74, 0x0f, 0x95, 0xf1, 0xda, 0x77, 0x8f, 0x9c,
+		0x6d, 0xea, 0x75, 0xdb, 0x76, 0x5d, 0xc7, 0x7b, 0x2c, 0xb1, 0x24, 0xce, 0xd4, 0x8b, 0xb9, 0x69,
+		0x86, 0x53, 0x91, 0x5f, 0x86, 0x87, 0xa3, 0xea, 0x34, 0x31, 0x9d, 0xdf, 0x6e, 0xac, 0x7d, 0x67,
+		0xa1, 0xd4, 0xd3, 0x9d, 0x85, 0xf2, 0x1b, 0xf0, 0x48, 0x1c, 0x61, 0xde, 0xa5, 0x4d, 0xdc, 0xed,
+		0xa0, 0xe5, 0x71, 0x0b, 0x7c, 0x4c, 0x9f, 0x04, 0x19, 0x4c, 0xb3, 0xe6, 0x75, 0xfb, 0x2e, 0xa7,
+		0x11, 0xc3, 0x7e, 0x0b, 0x4e, 0x45, 0x01, 0xd8, 0xb9, 0x9d, 0x69, 0xcf, 0x8d, 0xac, 0x83, 0xf9,
+		0xf0, 0x98, 0x01, 0x0f, 0x32, 0x71, 0x0b, 0xbf, 0xbc, 0x46, 0xf0, 0xfa, 0x5a, 0x84, 0xb3, 0xdc,
+		0x43, 0xe6, 0x93, 0x2f, 0xe2, 0xf3, 0x49, 0x09, 0x1e, 0xea, 0x5e, 0x23, 0xef, 0xef, 0x2d, 0x38,
+		0x16, 0x79, 0x5f, 0x0f, 0x17, 0xb5, 0x7b, 0xb9, 0xae, 0xe7, 0x68, 0xc4, 0x75, 0x3d, 0xf2, 0xbf,
+		0x97, 0xe0, 0xac, 0x2f, 0x3e, 0xb7, 0xae, 0xa9, 0xed, 0x35, 0xff, 0x91, 0x70, 0xc1, 0x9a, 0x6d,
+		0x48, 0x37, 0xd5, 0x76, 0xad, 0xae, 0x1f, 0x60, 0x5c, 0x42, 0x3e, 0x48, 0xc5, 0x68, 0x0d, 0x2c,
+		0xb8, 0xf0, 0xeb, 0x12, 0x3c, 0xde, 0x5b, 0x6f, 0x38, 0xdb, 0x5f, 0x0b, 0x59, 0x41, 0xe7, 0x7b,
+		0xb8, 0x13, 0xd5, 0x4d, 0xec, 0xbe, 0xaf, 0xa7, 0xbf, 0x99, 0x80, 0xa3, 0x11, 0x75, 0x0f, 0x3c,
+		0x4f, 0xcf, 0xf9, 0x36, 0x69, 0xc2, 0xf3, 0x6d, 0x52, 0x0d, 0x26, 0xfc, 0xf7, 0x0e, 0x0c, 0xe4,
+		0xdb, 0x43, 0xa6, 0xb7, 0x3b, 0x8d, 0x6e, 0x57, 0x1c, 0x0c, 0xfa, 0x4b, 0x97, 0xf2, 0xfb, 0x79,
+		0xa4, 0x86, 0xde, 0x2e, 0x45, 0xef, 0xa0, 0x12, 0x62, 0xee, 0x15, 0x48, 0xe9, 0xc0, 0x02, 0xf9,
+		0x1b, 0x22, 0xfc, 0xed, 0xae, 0x82, 0xcb, 0x5e, 0x09, 0x52, 0xf4, 0x6e, 0x2c, 0xe9, 0xa0, 0x77,
+		0x63, 0x51, 0x74, 0x72, 0x06, 0x26, 0xe9, 0x16, 0x34, 0xbb, 0x6d, 0x6b, 0xdf, 0xb9, 0x95, 0x3d,
+		0xa9, 0x4c, 0xe0, 0x0b, 0x8a, 0xc9, 0xbf, 0x4a, 0xe5, 0x15, 0xc9, 0xe4, 0xc1, 0x45, 0xf2, 0x33,
+		0xc2, 0x5b, 0xf0, 0xde, 0xad, 0x35, 0x30, 0xf3, 0xee, 0x5a, 0x84, 0x79, 0xd7, 0xbf, 0x06, 0xfe,
+		0x5b, 0x70, 0x3c, 0xb4, 0xa1, 0xce, 0x20, 0xd0, 0xef, 0x64, 0xc4, 0x18, 0x4e, 0xd1, 0x57, 0x88,
+		0x51, 0x74, 0xcc, 0x18, 0xd0, 0xee, 0xb4, 0xea, 0x6d, 0x7b, 0xde, 0x88, 0x47, 0xf9, 0x9f, 0x8b,
+		0x15, 0x20, 0x24, 0x26, 0x82, 0xb4, 0xde, 0x71, 0x4e, 0x08, 0xba, 0x1e, 0x0f, 0xc7, 0xb4, 0x98,
+		0x33, 0xef, 0x2a, 0x0c, 0xb1, 0x0b, 0xd8, 0xa4, 0x83, 0x5e, 0xc0, 0xc6, 0xf0, 0x07, 0xa7, 0x2a,
+		0xf7, 0xe1, 0x84, 0x57, 0xff, 0xdb, 0xf9, 0x36, 0x83, 0x5e, 0xd9, 0x7f, 0x59, 0xe2, 0x46, 0x4e,
+		0x64, 0x6a, 0xcf, 0xe0, 0xef, 0xac, 0x79, 0x15, 0x46, 0x3d, 0xd7, 0xb3, 0x25, 0xee, 0xe5, 0x7a,
+		0x36, 0x0f, 0x29, 0xb9, 0xee, 0xef, 0x8d, 0x93, 0xa8, 0x35, 0x68, 0xce, 0x7d, 0xab, 0x70, 0x0f,
+		0xa3, 0x93, 0xc2, 0xc4, 0xf1, 0x08, 0xc9, 0x39, 0x1e, 0x31, 0xf0, 0xaf, 0x9c, 0xc8, 0x15, 0x98,
+		0xf1, 0x36, 0xc3, 0xbe, 0x93, 0xd6, 0x9e, 0x93, 0x0f, 0xc0, 0xa8, 0x69, 0xa9, 0x6d, 0xcb, 0xfb,
+		0xad, 0xbf, 0x11, 0x5a, 0xc6, 0x55, 0xea, 0x49, 0x00, 0x3c, 0xe8, 0xe6, 0xd1, 0xbb, 0x59, 0x4d,
+		0xaf, 0xb2, 0xd7, 0xf2, 0x4f, 0x05, 0xf6, 0xd0, 0xdc, 0xb5, 0xf0, 0xee, 0x3e, 0x00, 0xa3, 0x7b,
+		0xf5, 0xb6, 0xe9, 0xaf, 0x86, 0x96, 0xf1, 0x6a, 0x66, 0x60, 0xa4, 0xa1, 0x3a, 0x10, 0xac, 0x1e,
+		0x68, 0xa8, 0x36, 0xc0, 0x06, 0x0c, 0x8b, 0xdb, 0x1d, 0x63, 0xae, 0x3b, 0x0d, 0xb6, 0xc4, 0x63,
+		0xc4, 0x73, 0x2a, 0xf2, 0x71, 0x1e, 0x50, 0xf3, 0x25, 0xd0, 0x31, 0x03, 0x5e, 0x38, 0xb1, 0xe1,
+		0xd9, 0x75, 0xce, 0xa9, 0x10, 0xc9, 0x7d, 0x2a, 0xc4, 0x16, 0xb2, 0x90, 0x5c, 0xb4, 0x41, 0x0b,
+		0xd9, 0xc7, 0x84, 0x90, 0x45, 0xe6, 0xbd, 0x91, 0x2a, 0x4c, 0x85, 0x5c, 0x45, 0x18, 0xf7, 0x65,
+		0x8e, 0xae, 0x37, 0x11, 0x4e, 0x06, 0x6e, 0x22, 0x3c, 0xff, 0x3b, 0x67, 0x61, 0x88, 0x36, 0x84,
+		0x7c, 0x52, 0x02, 0x70, 0x7d, 0xd1, 0x6e, 0xae, 0xcb, 0x82, 0x1f, 0xb2, 0x53, 0x5f, 0x98, 0xef,
+		0x19, 0x9e, 0xef, 0xb4, 0xcc, 0x7f, 0x1c, 0xdb, 0xf4, 0x91, 0x5f, 0xff, 0xfd, 0x4f, 0x24, 0x1e,
+		0x22, 0xf2, 0x7c, 0x44, 0x4e, 0x82, 0xcb, 0x60, 0xfd, 0x31, 0xc9, 0xfd, 0x15, 0xfd, 0x73, 0xbd,
+		0xd5, 0x27, 0x9a, 0x37, 0xd7, 0x2b, 0x38, 0x6f, 0xdd, 0xbb, 0x9c, 0xd6, 0x5d, 0x20, 0x4f, 0xc5,
+		0xb7, 0x6e, 0xfe, 0x83, 0x5e, 0xc9, 0xf8, 0x10, 0xf9, 0x5f, 0x24, 0x98, 0x0e, 0xdb, 0xeb, 0x25,
+		0xcf, 0xf4, 0xd6, 0x94, 0x60, 0xe4, 0xbe, 0xf0, 0xec, 0x01, 0x30, 0x79, 0x7f, 0x56, 0x9d, 0xfe,
+		0x14, 0xc9, 0x8b, 0x07, 0xe8, 0xcf, 0xbc, 0xfb, 0xf2, 0xdb, 0xff, 0x2a, 0xc1, 0xc9, 0xae, 0x1b,
+		0xa4, 0xa4, 0xd8, 0x5b, 0x53, 0xbb, 0xec, 0x53, 0x14, 0x16, 0xef, 0x85, 0x04, 0xef, 0xf6, 0x0d,
+		0xa7, 0xdb, 0x2f, 0x91, 0x95, 0x83, 0x74, 0x3b, 0xf4, 0xa2, 0x62, 0xf2, 0xcb, 0x92, 0xe7, 0xab,
+		0xcc, 0xdd, 0xa5, 0x2b, 0xe0, 0xa6, 0x16, 0xe6, 0x7b, 0x86, 0xe7, 0xfd, 0x78, 0xaf, 0xd3, 0x0f,
+		0x85, 0x6c, 0xde, 0xe3, 0xf0, 0xcd, 0x7f, 0xd0, 0x6b, 0xc9, 0x7d, 0x88, 0xfc, 0xdf, 0x52, 0xf8,
+		0xa7, 0x92, 0x2f, 0x75, 0x6d, 0x67, 0xf4, 0x16, 0x69, 0xe1, 0x99, 0xfe, 0x11, 0x79, 0x4f, 0xdb,
+		0x4e, 0x4f, 0x6b, 0x44, 0x1b, 0x74, 0x4f, 0x43, 0x87, 0x93, 0xfc, 0x8a, 0x04, 0xd3, 0x61, 0x7b,
+		0x82, 0x31, 0x53, 0xb5, 0xcb, 0xf6, 0x67, 0xcc, 0x54, 0xed, 0xb6, 0x01, 0x29, 0x17, 0x1d, 0x0e,
+		0x5c, 0x24, 0x4f, 0x47, 0x71, 0xa0, 0xeb, 0x78, 0xe2, 0xfc, 0xec, 0xba, 0x95, 0x16, 0x33, 0x3f,
+		0x7b, 0xd9, 0x47, 0x8c, 0x99, 0x9f, 0x3d, 0xed, 0xe4, 0xf5, 0x38, 0x3f, 0xed, 0xee, 0xf5, 0x38,
+		0xa0, 0x26, 0xf9, 0x77, 0x12, 0x8c, 0x79, 0x76, 0x8a, 0xc8, 0x93, 0x5d, 0x5b, 0x1b, 0xb6, 0x2d,
+		0x57, 0x38, 0xdf, 0x0f, 0x0a, 0xef, 0xd0, 0xba, 0xd3, 0xa1, 0x25, 0x52, 0x3c, 0x48, 0x87, 0xbc,
+		0xd7, 0x8c, 0xff, 0x86, 0x04, 0x53, 0x21, 0x7b, 0x2c, 0x31, 0x33, 0x33, 0x7a, 0x33, 0xa9, 0xf0,
+		0x4c, 0xff, 0x88, 0xbc, 0x6b, 0x2f, 0x39, 0x5d, 0x7b, 0x17, 0x79, 0xe1, 0x20, 0x5d, 0x73, 0x2d,
+		0xe6, 0x5f, 0x72, 0x3e, 0xa0, 0xe6, 0xaa, 0x8c, 0x5c, 0xec, 0xb3, 0x75, 0xa2, 0x57, 0x97, 0xfa,
+		0xc6, 0xe3, 0x9d, 0x7a, 0x8f, 0xd3, 0xa9, 0x77, 0x93, 0x8d, 0x7b, 0xeb, 0x54, 0xd0, 0x06, 0xf8,
+		0xbc, 0x04, 0xe3, 0xde, 0x4d, 0x0d, 0xd2, 0x5d, 0xa8, 0x42, 0x77, 0x5d, 0x0a, 0x4f, 0xf5, 0x85,
+		0xc3, 0x7b, 0xf6, 0xbc, 0xd3, 0xb3, 0xf3, 0xe4, 0x89, 0xa8, 0x9e, 0xb9, 0x3e, 0x4a, 0x8c, 0xf9,
+		0x78, 0xf3, 0x1f, 0x64, 0x16, 0xfc, 0x87, 0xf0, 0x08, 0x0a, 0xfb, 0x10, 0xd9, 0xe9, 0xae, 0x95,
+		0xbb, 0x76, 0x65, 0x0a, 0x8f, 0xf5, 0x00, 0xc9, 0x1b, 0xf7, 0x98, 0xd3, 0xb8, 0x53, 0xe4, 0x44,
+		0x54, 0xe3, 0x70, 0x67, 0x86, 0x7c, 0x87, 0x64, 0x7f, 0xdd, 0xf9, 0x4c, 0xf7, 0x0a, 0xdc, 0x5b,
+		0x37, 0x85, 0xb3, 0x3d, 0xc1, 0xf2, 0xe6, 0x9c, 0x75, 0x9a, 0x33, 0x4b, 0x4e, 0x45, 0x36, 0x87,
+		0xb5, 0xe2, 0x0b, 0x11, 0x9f, 0x71, 0xea, 0x2e, 0x83, 0xd1, 0x1b, 0x3d, 0x85, 0x67, 0xfa, 0x47,
+		0xec, 0xcb, 0x4a, 0x0d, 0xff, 0x16, 0xc1, 0xfc, 0x07, 0xeb, 0xd5, 0x0f, 0x91, 0xdf, 0x95, 0xe0,
+		0x58, 0xe4, 0x1e, 0x0c, 0x79, 0xbe, 0xdf, 0x96, 0x79, 0x36, 0x85, 0x0a, 0x2f, 0x1c, 0x14, 0x9d,
+		0x77, 0x6f, 0x99, 0xf6, 0xec, 0x05, 0xf2, 0x5c, 0x9f, 0x3d, 0xa3, 0x5b, 0x4c, 0xf3, 0x1f, 0xa4,
+		0xff, 0x3e, 0x44, 0x7e, 0x56, 0x82, 0xc9, 0xc0, 0x4e, 0x0e, 0xb9, 0x10, 0xd3, 0xb6, 0xf0, 0xad,
+		0xa1, 0xc2, 0xc5, 0x7e, 0xd1, 0x78, 0x57, 0x9e, 0x71, 0x46, 0xea, 0x1c, 0x39, 0x1b, 0xdd, 0x9f,
+		0xc0, 0x57, 0x18, 0x08, 0x1e, 0x83, 0x8f, 0xfa, 0xea, 0xd6, 0xe5, 0xee, 0x42, 0xde, 0x75, 0x1b,
+		0xa9, 0xf0, 0xdc, 0xc1, 0x90, 0x79, 0x87, 0x5e, 0x73, 0x3a, 0xb4, 0x41, 0xd6, 0x0e, 0x62, 0xa7,
+		0x45, 0x6e, 0x28, 0x91, 0x3f, 0x97, 0x60, 0x26, 0x66, 0x8b, 0x84, 0x2c, 0xf5, 0xe8, 0x6f, 0x76,
+		0xdb, 0x2e, 0x2a, 0x2c, 0xdf, 0x1b, 0x11, 0xce, 0x8a, 0x6b, 0x0e, 0x2b, 0x9e, 0x27, 0x97, 0xe3,
+		0x59, 0x51, 0xd6, 0x35, 0xb5, 0x5d, 0x0e, 0xd9, 0x48, 0x20, 0xff, 0x48, 0x02, 0x70, 0x42, 0xf1,
+		0x31, 0x6e, 0x45, 0x60, 0x5b, 0xa0, 0x30, 0xdf, 0x33, 0x3c, 0x6f, 0xf9, 0x13, 0x4e, 0xcb, 0x1f,
+		0x26, 0x0f, 0x46, 0xb5, 0x9c, 0x85, 0xee, 0xe9, 0x97, 0x2e, 0xc8, 0x7f, 0x92, 0x02, 0xdf, 0x21,
+		0x3b, 0xdf, 0xa3, 0x33, 0xe3, 0x8a, 0xc0, 0x17, 0x9e, 0xea, 0x0b, 0x87, 0xb7, 0x76, 0xd7, 0x69,
+		0xed, 0xcb, 0x64, 0xe7, 0x20, 0x6b, 0xb5, 0xff, 0xd3, 0x1c, 0xc1, 0x15, 0xfb, 0x0f, 0x24, 0xc8,
+		0x47, 0x05, 0x96, 0xc9, 0x73, 0xfd, 0x1a, 0xf5, 0xee, 0x08, 0x7a, 0xe1, 0xf9, 0x03, 0x62, 0xf3,
+		0xde, 0xbf, 0xdb, 0xe9, 0xfd, 0x15, 0xb2, 0x3c, 0x88, 0xde, 0x93, 0x5f, 0x92, 0x5c, 0x1f, 0x3b,
+		0xb4, 0x6f, 0x28, 0xe9, 0x6d, 0x4e, 0x78, 0x23, 0xd7, 0x85, 0x0b, 0x7d, 0x62, 0x1d, 0x70, 0xea,
+		0x84, 0x68, 0x11, 0xde, 0xec, 0x5f, 0x91, 0xc2, 0xbe, 0x07, 0xdd, 0x63, 0xb3, 0x7c, 0xd1, 0xe4,
+		0xc2, 0xc5, 0x7e, 0xd1, 0x78, 0x77, 0xae, 0x3b, 0xdd, 0x79, 0x91, 0x3c, 0x7f, 0x90, 0xee, 0xd8,
+		0x1f, 0x75, 0x21, 0xff, 0x56, 0x82, 0xa9, 0x90, 0xa8, 0x6c, 0x8c, 0x99, 0x11, 0x1d, 0x2d, 0x2e,
+		0x3c, 0xd3, 0x3f, 0x22, 0xef, 0xd6, 0x82, 0xd3, 0xad, 0x79, 0x72, 0x2e, 0xb6, 0x5b, 0x65, 0x53,
+		0xb3, 0xb8, 0x2a, 0x37, 0xc9, 0x0f, 0x4a, 0x30, 0xe6, 0x3d, 0x3e, 0xdd, 0xdd, 0x13, 0x0b, 0x8b,
+		0xe7, 0x16, 0xce, 0xf7, 0x83, 0xd2, 0xa7, 0x4d, 0x47, 0xdb, 0xf4, 0x5b, 0xa1, 0xdf, 0xf4, 0xbe,
+		0x10, 0x57, 0x6d, 0x68, 0x98, 0xb8, 0x70, 0xb1, 0x5f, 0x34, 0xde, 0xe2, 0x6d, 0xa7, 0xc5, 0x2b,
+		0xe4, 0xea, 0x41, 0xa4, 0x27, 0x24, 0x60, 0x3c, 0xe8, 0x83, 0xd3, 0xff, 0xdf, 0x00, 0x19, 0xb7,
+		0x22, 0x56, 0x7d, 0x1d, 0x01, 0x00,
+	}
+	r := bytes.NewReader(gzipped)
+	gzipr, err := compress_gzip.NewReader(r)
+	if err != nil {
+		panic(err)
+	}
+	ungzipped, err := io_ioutil.ReadAll(gzipr)
+	if err != nil {
+		panic(err)
+	}
+	if err := github_com_cosmos_gogoproto_proto.Unmarshal(ungzipped, d); err != nil {
+		panic(err)
+	}
+	return d
+}
+func (this *CommissionRates) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*CommissionRates)
+	if !ok {
+		that2, ok := that.(CommissionRates)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
 		}
 	}
 	if that1 == nil {
@@ -2253,34 +3812,52 @@ func (this *RedelegationEntry) Equal(that interface{}) bool {
 	} else if this == nil {
 		return false
 	}
-	if this.CreationHeight != that1.CreationHeight {
+	if !this.Rate.Equal(that1.Rate) {
 		return false
 	}
-	if !this.CompletionTime.Equal(that1.CompletionTime) {
+	if !this.MaxRate.Equal(that1.MaxRate) {
 		return false
 	}
-	if !this.InitialBalance.Equal(that1.InitialBalance) {
+	if !this.MaxChangeRate.Equal(that1.MaxChangeRate) {
 		return false
 	}
-	if !this.SharesDst.Equal(that1.SharesDst) {
+	return true
+}
+func (this *Commission) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*Commission)
+	if !ok {
+		that2, ok := that.(Commission)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
 		return false
 	}
-	if this.UnbondingId != that1.UnbondingId {
+	if !this.CommissionRates.Equal(&that1.CommissionRates) {
 		return false
 	}
-	if this.UnbondingOnHoldRefCount != that1.UnbondingOnHoldRefCount {
+	if !this.UpdateTime.Equal(that1.UpdateTime) {
 		return false
 	}
 	return true
 }
-func (this *Params) Equal(that interface{}) bool {
+func (this *Description) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
 	}
 
-	that1, ok := that.(*Params)
+	that1, ok := that.(*Description)
 	if !ok {
-		that2, ok := that.(Params)
+		that2, ok := that.(Description)
 		if ok {
 			that1 = &that2
 		} else {
@@ -2292,34 +3869,34 @@ func (this *Params) Equal(that interface{}) bool {
 	} else if this == nil {
 		return false
 	}
-	if this.UnbondingTime != that1.UnbondingTime {
+	if this.Moniker != that1.Moniker {
 		return false
 	}
-	if this.MaxValidators != that1.MaxValidators {
+	if this.Identity != that1.Identity {
 		return false
 	}
-	if this.MaxEntries != that1.MaxEntries {
+	if this.Website != that1.Website {
 		return false
 	}
-	if this.HistoricalEntries != that1.HistoricalEntries {
+	if this.SecurityContact != that1.SecurityContact {
 		return false
 	}
-	if this.BondDenom != that1.BondDenom {
+	if this.Details != that1.Details {
 		return false
 	}
-	if !this.MinCommissionRate.Equal(that1.MinCommissionRate) {
+	if !this.Profile.Equal(that1.Profile) {
 		return false
 	}
 	return true
 }
-func (this *RedelegationEntryResponse) Equal(that interface{}) bool {
+func (this *ValidatorProfile) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
 	}
 
-	that1, ok := that.(*RedelegationEntryResponse)
+	that1, ok := that.(*ValidatorProfile)
 	if !ok {
-		that2, ok := that.(RedelegationEntryResponse)
+		that2, ok := that.(ValidatorProfile)
 		if ok {
 			that1 = &that2
 		} else {
@@ -2331,22 +3908,31 @@ func (this *RedelegationEntryResponse) Equal(that interface{}) bool {
 	} else if this == nil {
 		return false
 	}
-	if !this.RedelegationEntry.Equal(&that1.RedelegationEntry) {
+	if this.LogoUri != that1.LogoUri {
 		return false
 	}
-	if !this.Balance.Equal(that1.Balance) {
+	if this.SecurityPolicy != that1.SecurityPolicy {
+		return false
+	}
+	if this.MevPolicy != that1.MevPolicy {
+		return false
+	}
+	if this.Region != that1.Region {
+		return false
+	}
+	if this.WebsiteProof != that1.WebsiteProof {
 		return false
 	}
 	return true
 }
-func (this *Pool) Equal(that interface{}) bool {
+func (this *UnbondingDelegationEntry) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
 	}
 
-	that1, ok := that.(*Pool)
+	that1, ok := that.(*UnbondingDelegationEntry)
 	if !ok {
-		that2, ok := that.(Pool)
+		that2, ok := that.(UnbondingDelegationEntry)
 		if ok {
 			that1 = &that2
 		} else {
@@ -2358,115 +3944,300 @@ func (this *Pool) Equal(that interface{}) bool {
 	} else if this == nil {
 		return false
 	}
-	if !this.NotBondedTokens.Equal(that1.NotBondedTokens) {
+	if this.CreationHeight != that1.CreationHeight {
 		return false
 	}
-	if !this.BondedTokens.Equal(that1.BondedTokens) {
+	if !this.CompletionTime.Equal(that1.CompletionTime) {
+		return false
+	}
+	if !this.InitialBalance.Equal(that1.InitialBalance) {
+		return false
+	}
+	if !this.Balance.Equal(that1.Balance) {
+		return false
+	}
+	if this.UnbondingId != that1.UnbondingId {
+		return false
+	}
+	if this.UnbondingOnHoldRefCount != that1.UnbondingOnHoldRefCount {
+		return false
+	}
+	if this.Denom != that1.Denom {
 		return false
 	}
 	return true
 }
-func (m *HistoricalInfo) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (this *RedelegationEntry) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
 	}
-	return dAtA[:n], nil
-}
-
-func (m *HistoricalInfo) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
 
-func (m *HistoricalInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Valset) > 0 {
-		for iNdEx := len(m.Valset) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Valset[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintStaking(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x12
-		}
-	}
-	{
-		size, err := m.Header.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
+	that1, ok := that.(*RedelegationEntry)
+	if !ok {
+		that2, ok := that.(RedelegationEntry)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
 		}
-		i -= size
-		i = encodeVarintStaking(dAtA, i, uint64(size))
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.CreationHeight != that1.CreationHeight {
+		return false
+	}
+	if !this.CompletionTime.Equal(that1.CompletionTime) {
+		return false
+	}
+	if !this.InitialBalance.Equal(that1.InitialBalance) {
+		return false
+	}
+	if !this.SharesDst.Equal(that1.SharesDst) {
+		return false
+	}
+	if this.UnbondingId != that1.UnbondingId {
+		return false
+	}
+	if this.UnbondingOnHoldRefCount != that1.UnbondingOnHoldRefCount {
+		return false
+	}
+	return true
 }
+func (this *Params) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
 
-func (m *CommissionRates) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+	that1, ok := that.(*Params)
+	if !ok {
+		that2, ok := that.(Params)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
 	}
-	return dAtA[:n], nil
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.UnbondingTime != that1.UnbondingTime {
+		return false
+	}
+	if this.MaxValidators != that1.MaxValidators {
+		return false
+	}
+	if this.MaxEntries != that1.MaxEntries {
+		return false
+	}
+	if this.HistoricalEntries != that1.HistoricalEntries {
+		return false
+	}
+	if this.BondDenom != that1.BondDenom {
+		return false
+	}
+	if !this.MinCommissionRate.Equal(that1.MinCommissionRate) {
+		return false
+	}
+	if !this.ValidatorBondFactor.Equal(that1.ValidatorBondFactor) {
+		return false
+	}
+	if !this.GlobalLiquidStakingCap.Equal(that1.GlobalLiquidStakingCap) {
+		return false
+	}
+	if !this.ValidatorLiquidStakingCap.Equal(that1.ValidatorLiquidStakingCap) {
+		return false
+	}
+	if this.CommissionChangeNoticePeriod != that1.CommissionChangeNoticePeriod {
+		return false
+	}
+	if len(this.BondDenomWeights) != len(that1.BondDenomWeights) {
+		return false
+	}
+	for i := range this.BondDenomWeights {
+		if !this.BondDenomWeights[i].Equal(&that1.BondDenomWeights[i]) {
+			return false
+		}
+	}
+	if this.MaxMatureUnbondingsPerBlock != that1.MaxMatureUnbondingsPerBlock {
+		return false
+	}
+	if !this.MinSelfDelegationFloor.Equal(that1.MinSelfDelegationFloor) {
+		return false
+	}
+	if this.EpochLength != that1.EpochLength {
+		return false
+	}
+	if len(this.LockupTerms) != len(that1.LockupTerms) {
+		return false
+	}
+	for i := range this.LockupTerms {
+		if !this.LockupTerms[i].Equal(&that1.LockupTerms[i]) {
+			return false
+		}
+	}
+	if !this.InstantUndelegationFeeRate.Equal(that1.InstantUndelegationFeeRate) {
+		return false
+	}
+	if !this.InstantUndelegationBudgetPerBlock.Equal(that1.InstantUndelegationBudgetPerBlock) {
+		return false
+	}
+	if this.InstantUndelegationFeeToCommunityPool != that1.InstantUndelegationFeeToCommunityPool {
+		return false
+	}
+	if len(this.UnbondingTiers) != len(that1.UnbondingTiers) {
+		return false
+	}
+	for i := range this.UnbondingTiers {
+		if !this.UnbondingTiers[i].Equal(&that1.UnbondingTiers[i]) {
+			return false
+		}
+	}
+	if !this.MinDelegationAmount.Equal(that1.MinDelegationAmount) {
+		return false
+	}
+	return true
 }
+func (this *UnbondingTier) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
 
-func (m *CommissionRates) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+	that1, ok := that.(*UnbondingTier)
+	if !ok {
+		that2, ok := that.(UnbondingTier)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Name != that1.Name {
+		return false
+	}
+	if this.UnbondingTime != that1.UnbondingTime {
+		return false
+	}
+	return true
 }
+func (this *LockupTerm) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
 
-func (m *CommissionRates) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size := m.MaxChangeRate.Size()
-		i -= size
-		if _, err := m.MaxChangeRate.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
+	that1, ok := that.(*LockupTerm)
+	if !ok {
+		that2, ok := that.(LockupTerm)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
 		}
-		i = encodeVarintStaking(dAtA, i, uint64(size))
 	}
-	i--
-	dAtA[i] = 0x1a
-	{
-		size := m.MaxRate.Size()
-		i -= size
-		if _, err := m.MaxRate.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Duration != that1.Duration {
+		return false
+	}
+	if !this.RewardMultiplier.Equal(that1.RewardMultiplier) {
+		return false
+	}
+	return true
+}
+func (this *BondDenomWeight) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*BondDenomWeight)
+	if !ok {
+		that2, ok := that.(BondDenomWeight)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
 		}
-		i = encodeVarintStaking(dAtA, i, uint64(size))
 	}
-	i--
-	dAtA[i] = 0x12
-	{
-		size := m.Rate.Size()
-		i -= size
-		if _, err := m.Rate.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Denom != that1.Denom {
+		return false
+	}
+	if !this.Weight.Equal(that1.Weight) {
+		return false
+	}
+	return true
+}
+func (this *RedelegationEntryResponse) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*RedelegationEntryResponse)
+	if !ok {
+		that2, ok := that.(RedelegationEntryResponse)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
 		}
-		i = encodeVarintStaking(dAtA, i, uint64(size))
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if !this.RedelegationEntry.Equal(&that1.RedelegationEntry) {
+		return false
+	}
+	if !this.Balance.Equal(that1.Balance) {
+		return false
+	}
+	return true
 }
+func (this *Pool) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
 
-func (m *Commission) Marshal() (dAtA []byte, err error) {
+	that1, ok := that.(*Pool)
+	if !ok {
+		that2, ok := that.(Pool)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if !this.NotBondedTokens.Equal(that1.NotBondedTokens) {
+		return false
+	}
+	if !this.BondedTokens.Equal(that1.BondedTokens) {
+		return false
+	}
+	return true
+}
+func (m *HistoricalInfo) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -2476,17 +4247,117 @@ func (m *Commission) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *Commission) MarshalTo(dAtA []byte) (int, error) {
+func (m *HistoricalInfo) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *Commission) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *HistoricalInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	n2, err2 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.UpdateTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.UpdateTime):])
+	if len(m.Valset) > 0 {
+		for iNdEx := len(m.Valset) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Valset[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintStaking(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	{
+		size, err := m.Header.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintStaking(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *CommissionRates) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CommissionRates) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CommissionRates) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.MaxChangeRate.Size()
+		i -= size
+		if _, err := m.MaxChangeRate.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintStaking(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	{
+		size := m.MaxRate.Size()
+		i -= size
+		if _, err := m.MaxRate.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintStaking(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	{
+		size := m.Rate.Size()
+		i -= size
+		if _, err := m.Rate.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintStaking(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *Commission) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Commission) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Commission) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	n2, err2 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.UpdateTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.UpdateTime):])
 	if err2 != nil {
 		return 0, err2
 	}
@@ -2527,6 +4398,18 @@ func (m *Description) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.Profile != nil {
+		{
+			size, err := m.Profile.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintStaking(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x32
+	}
 	if len(m.Details) > 0 {
 		i -= len(m.Details)
 		copy(dAtA[i:], m.Details)
@@ -2565,6 +4448,130 @@ func (m *Description) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *ValidatorProfile) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ValidatorProfile) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ValidatorProfile) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.WebsiteProof) > 0 {
+		i -= len(m.WebsiteProof)
+		copy(dAtA[i:], m.WebsiteProof)
+		i = encodeVarintStaking(dAtA, i, uint64(len(m.WebsiteProof)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.Region) > 0 {
+		i -= len(m.Region)
+		copy(dAtA[i:], m.Region)
+		i = encodeVarintStaking(dAtA, i, uint64(len(m.Region)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.MevPolicy) > 0 {
+		i -= len(m.MevPolicy)
+		copy(dAtA[i:], m.MevPolicy)
+		i = encodeVarintStaking(dAtA, i, uint64(len(m.MevPolicy)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.SecurityPolicy) > 0 {
+		i -= len(m.SecurityPolicy)
+		copy(dAtA[i:], m.SecurityPolicy)
+		i = encodeVarintStaking(dAtA, i, uint64(len(m.SecurityPolicy)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.LogoUri) > 0 {
+		i -= len(m.LogoUri)
+		copy(dAtA[i:], m.LogoUri)
+		i = encodeVarintStaking(dAtA, i, uint64(len(m.LogoUri)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ProfileAttestation) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ProfileAttestation) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ProfileAttestation) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Height != 0 {
+		i = encodeVarintStaking(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.Verified {
+		i--
+		if m.Verified {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x28
+	}
+	if len(m.Value) > 0 {
+		i -= len(m.Value)
+		copy(dAtA[i:], m.Value)
+		i = encodeVarintStaking(dAtA, i, uint64(len(m.Value)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Attester) > 0 {
+		i -= len(m.Attester)
+		copy(dAtA[i:], m.Attester)
+		i = encodeVarintStaking(dAtA, i, uint64(len(m.Attester)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Field) > 0 {
+		i -= len(m.Field)
+		copy(dAtA[i:], m.Field)
+		i = encodeVarintStaking(dAtA, i, uint64(len(m.Field)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ValidatorAddress) > 0 {
+		i -= len(m.ValidatorAddress)
+		copy(dAtA[i:], m.ValidatorAddress)
+		i = encodeVarintStaking(dAtA, i, uint64(len(m.ValidatorAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *Validator) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -2585,21 +4592,57 @@ func (m *Validator) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.Assets) > 0 {
+		for iNdEx := len(m.Assets) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Assets[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintStaking(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0x82
+		}
+	}
+	{
+		size := m.LiquidShares.Size()
+		i -= size
+		if _, err := m.LiquidShares.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintStaking(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x7a
+	{
+		size := m.ValidatorBondShares.Size()
+		i -= size
+		if _, err := m.ValidatorBondShares.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintStaking(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x72
 	if len(m.UnbondingIds) > 0 {
-		dAtA5 := make([]byte, len(m.UnbondingIds)*10)
-		var j4 int
+		dAtA6 := make([]byte, len(m.UnbondingIds)*10)
+		var j5 int
 		for _, num := range m.UnbondingIds {
 			for num >= 1<<7 {
-				dAtA5[j4] = uint8(uint64(num)&0x7f | 0x80)
+				dAtA6[j5] = uint8(uint64(num)&0x7f | 0x80)
 				num >>= 7
-				j4++
+				j5++
 			}
-			dAtA5[j4] = uint8(num)
-			j4++
+			dAtA6[j5] = uint8(num)
+			j5++
 		}
-		i -= j4
-		copy(dAtA[i:], dAtA5[:j4])
-		i = encodeVarintStaking(dAtA, i, uint64(j4))
+		i -= j5
+		copy(dAtA[i:], dAtA6[:j5])
+		i = encodeVarintStaking(dAtA, i, uint64(j5))
 		i--
 		dAtA[i] = 0x6a
 	}
@@ -2628,12 +4671,12 @@ func (m *Validator) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	}
 	i--
 	dAtA[i] = 0x52
-	n7, err7 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.UnbondingTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.UnbondingTime):])
-	if err7 != nil {
-		return 0, err7
+	n8, err8 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.UnbondingTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.UnbondingTime):])
+	if err8 != nil {
+		return 0, err8
 	}
-	i -= n7
-	i = encodeVarintStaking(dAtA, i, uint64(n7))
+	i -= n8
+	i = encodeVarintStaking(dAtA, i, uint64(n8))
 	i--
 	dAtA[i] = 0x4a
 	if m.UnbondingHeight != 0 {
@@ -2708,7 +4751,7 @@ func (m *Validator) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func (m *ValAddresses) Marshal() (dAtA []byte, err error) {
+func (m *ValidatorAsset) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -2718,29 +4761,47 @@ func (m *ValAddresses) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *ValAddresses) MarshalTo(dAtA []byte) (int, error) {
+func (m *ValidatorAsset) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *ValAddresses) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *ValidatorAsset) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.Addresses) > 0 {
-		for iNdEx := len(m.Addresses) - 1; iNdEx >= 0; iNdEx-- {
-			i -= len(m.Addresses[iNdEx])
-			copy(dAtA[i:], m.Addresses[iNdEx])
-			i = encodeVarintStaking(dAtA, i, uint64(len(m.Addresses[iNdEx])))
-			i--
-			dAtA[i] = 0xa
+	{
+		size := m.Tokens.Size()
+		i -= size
+		if _, err := m.Tokens.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintStaking(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	{
+		size := m.Amount.Size()
+		i -= size
+		if _, err := m.Amount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
 		}
+		i = encodeVarintStaking(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintStaking(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *DVPair) Marshal() (dAtA []byte, err error) {
+func (m *ValAddresses) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -2750,20 +4811,52 @@ func (m *DVPair) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *DVPair) MarshalTo(dAtA []byte) (int, error) {
+func (m *ValAddresses) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *DVPair) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *ValAddresses) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.ValidatorAddress) > 0 {
-		i -= len(m.ValidatorAddress)
-		copy(dAtA[i:], m.ValidatorAddress)
-		i = encodeVarintStaking(dAtA, i, uint64(len(m.ValidatorAddress)))
+	if len(m.Addresses) > 0 {
+		for iNdEx := len(m.Addresses) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Addresses[iNdEx])
+			copy(dAtA[i:], m.Addresses[iNdEx])
+			i = encodeVarintStaking(dAtA, i, uint64(len(m.Addresses[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *DVPair) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DVPair) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DVPair) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ValidatorAddress) > 0 {
+		i -= len(m.ValidatorAddress)
+		copy(dAtA[i:], m.ValidatorAddress)
+		i = encodeVarintStaking(dAtA, i, uint64(len(m.ValidatorAddress)))
 		i--
 		dAtA[i] = 0x12
 	}
@@ -2915,6 +5008,23 @@ func (m *Delegation) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintStaking(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.ValidatorBond {
+		i--
+		if m.ValidatorBond {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x20
+	}
 	{
 		size := m.Shares.Size()
 		i -= size
@@ -3013,6 +5123,13 @@ func (m *UnbondingDelegationEntry) MarshalToSizedBuffer(dAtA []byte) (int, error
 	_ = i
 	var l int
 	_ = l
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintStaking(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0x3a
+	}
 	if m.UnbondingOnHoldRefCount != 0 {
 		i = encodeVarintStaking(dAtA, i, uint64(m.UnbondingOnHoldRefCount))
 		i--
@@ -3043,12 +5160,12 @@ func (m *UnbondingDelegationEntry) MarshalToSizedBuffer(dAtA []byte) (int, error
 	}
 	i--
 	dAtA[i] = 0x1a
-	n10, err10 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.CompletionTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.CompletionTime):])
-	if err10 != nil {
-		return 0, err10
+	n11, err11 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.CompletionTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.CompletionTime):])
+	if err11 != nil {
+		return 0, err11
 	}
-	i -= n10
-	i = encodeVarintStaking(dAtA, i, uint64(n10))
+	i -= n11
+	i = encodeVarintStaking(dAtA, i, uint64(n11))
 	i--
 	dAtA[i] = 0x12
 	if m.CreationHeight != 0 {
@@ -3109,12 +5226,12 @@ func (m *RedelegationEntry) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	}
 	i--
 	dAtA[i] = 0x1a
-	n11, err11 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.CompletionTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.CompletionTime):])
-	if err11 != nil {
-		return 0, err11
+	n12, err12 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.CompletionTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.CompletionTime):])
+	if err12 != nil {
+		return 0, err12
 	}
-	i -= n11
-	i = encodeVarintStaking(dAtA, i, uint64(n11))
+	i -= n12
+	i = encodeVarintStaking(dAtA, i, uint64(n12))
 	i--
 	dAtA[i] = 0x12
 	if m.CreationHeight != 0 {
@@ -3203,6 +5320,156 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	{
+		size := m.MinDelegationAmount.Size()
+		i -= size
+		if _, err := m.MinDelegationAmount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintStaking(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1
+	i--
+	dAtA[i] = 0xa2
+	if len(m.UnbondingTiers) > 0 {
+		for iNdEx := len(m.UnbondingTiers) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.UnbondingTiers[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintStaking(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0x9a
+		}
+	}
+	if m.InstantUndelegationFeeToCommunityPool {
+		i--
+		if m.InstantUndelegationFeeToCommunityPool {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x90
+	}
+	{
+		size := m.InstantUndelegationBudgetPerBlock.Size()
+		i -= size
+		if _, err := m.InstantUndelegationBudgetPerBlock.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintStaking(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1
+	i--
+	dAtA[i] = 0x8a
+	{
+		size := m.InstantUndelegationFeeRate.Size()
+		i -= size
+		if _, err := m.InstantUndelegationFeeRate.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintStaking(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1
+	i--
+	dAtA[i] = 0x82
+	if len(m.LockupTerms) > 0 {
+		for iNdEx := len(m.LockupTerms) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.LockupTerms[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintStaking(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x7a
+		}
+	}
+	if m.EpochLength != 0 {
+		i = encodeVarintStaking(dAtA, i, uint64(m.EpochLength))
+		i--
+		dAtA[i] = 0x70
+	}
+	{
+		size := m.MinSelfDelegationFloor.Size()
+		i -= size
+		if _, err := m.MinSelfDelegationFloor.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintStaking(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x6a
+	if m.MaxMatureUnbondingsPerBlock != 0 {
+		i = encodeVarintStaking(dAtA, i, uint64(m.MaxMatureUnbondingsPerBlock))
+		i--
+		dAtA[i] = 0x60
+	}
+	if len(m.BondDenomWeights) > 0 {
+		for iNdEx := len(m.BondDenomWeights) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.BondDenomWeights[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintStaking(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x5a
+		}
+	}
+	n13, err13 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.CommissionChangeNoticePeriod, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.CommissionChangeNoticePeriod):])
+	if err13 != nil {
+		return 0, err13
+	}
+	i -= n13
+	i = encodeVarintStaking(dAtA, i, uint64(n13))
+	i--
+	dAtA[i] = 0x52
+	{
+		size := m.ValidatorLiquidStakingCap.Size()
+		i -= size
+		if _, err := m.ValidatorLiquidStakingCap.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintStaking(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x4a
+	{
+		size := m.GlobalLiquidStakingCap.Size()
+		i -= size
+		if _, err := m.GlobalLiquidStakingCap.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintStaking(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x42
+	{
+		size := m.ValidatorBondFactor.Size()
+		i -= size
+		if _, err := m.ValidatorBondFactor.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintStaking(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x3a
 	{
 		size := m.MinCommissionRate.Size()
 		i -= size
@@ -3235,18 +5502,18 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i--
 		dAtA[i] = 0x10
 	}
-	n12, err12 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.UnbondingTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.UnbondingTime):])
-	if err12 != nil {
-		return 0, err12
+	n14, err14 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.UnbondingTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.UnbondingTime):])
+	if err14 != nil {
+		return 0, err14
 	}
-	i -= n12
-	i = encodeVarintStaking(dAtA, i, uint64(n12))
+	i -= n14
+	i = encodeVarintStaking(dAtA, i, uint64(n14))
 	i--
 	dAtA[i] = 0xa
 	return len(dAtA) - i, nil
 }
 
-func (m *DelegationResponse) Marshal() (dAtA []byte, err error) {
+func (m *UnbondingTier) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -3256,40 +5523,35 @@ func (m *DelegationResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *DelegationResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *UnbondingTier) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *DelegationResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *UnbondingTier) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	{
-		size, err := m.Balance.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
-		}
-		i -= size
-		i = encodeVarintStaking(dAtA, i, uint64(size))
+	n15, err15 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.UnbondingTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.UnbondingTime):])
+	if err15 != nil {
+		return 0, err15
 	}
+	i -= n15
+	i = encodeVarintStaking(dAtA, i, uint64(n15))
 	i--
 	dAtA[i] = 0x12
-	{
-		size, err := m.Delegation.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
-		}
-		i -= size
-		i = encodeVarintStaking(dAtA, i, uint64(size))
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintStaking(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0xa
 	}
-	i--
-	dAtA[i] = 0xa
 	return len(dAtA) - i, nil
 }
 
-func (m *RedelegationEntryResponse) Marshal() (dAtA []byte, err error) {
+func (m *ValidatorRiskTier) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -3299,40 +5561,34 @@ func (m *RedelegationEntryResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *RedelegationEntryResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *ValidatorRiskTier) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *RedelegationEntryResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *ValidatorRiskTier) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	{
-		size := m.Balance.Size()
-		i -= size
-		if _, err := m.Balance.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintStaking(dAtA, i, uint64(size))
+	if len(m.Tier) > 0 {
+		i -= len(m.Tier)
+		copy(dAtA[i:], m.Tier)
+		i = encodeVarintStaking(dAtA, i, uint64(len(m.Tier)))
+		i--
+		dAtA[i] = 0x12
 	}
-	i--
-	dAtA[i] = 0x22
-	{
-		size, err := m.RedelegationEntry.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
-		}
-		i -= size
-		i = encodeVarintStaking(dAtA, i, uint64(size))
+	if len(m.ValidatorAddress) > 0 {
+		i -= len(m.ValidatorAddress)
+		copy(dAtA[i:], m.ValidatorAddress)
+		i = encodeVarintStaking(dAtA, i, uint64(len(m.ValidatorAddress)))
+		i--
+		dAtA[i] = 0xa
 	}
-	i--
-	dAtA[i] = 0xa
 	return len(dAtA) - i, nil
 }
 
-func (m *RedelegationResponse) Marshal() (dAtA []byte, err error) {
+func (m *StandbyConsPubKey) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -3342,44 +5598,39 @@ func (m *RedelegationResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *RedelegationResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *StandbyConsPubKey) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *RedelegationResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *StandbyConsPubKey) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.Entries) > 0 {
-		for iNdEx := len(m.Entries) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Entries[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintStaking(dAtA, i, uint64(size))
+	if m.Pubkey != nil {
+		{
+			size, err := m.Pubkey.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
 			}
-			i--
-			dAtA[i] = 0x12
+			i -= size
+			i = encodeVarintStaking(dAtA, i, uint64(size))
 		}
+		i--
+		dAtA[i] = 0x12
 	}
-	{
-		size, err := m.Redelegation.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
-		}
-		i -= size
-		i = encodeVarintStaking(dAtA, i, uint64(size))
+	if len(m.ValidatorAddress) > 0 {
+		i -= len(m.ValidatorAddress)
+		copy(dAtA[i:], m.ValidatorAddress)
+		i = encodeVarintStaking(dAtA, i, uint64(len(m.ValidatorAddress)))
+		i--
+		dAtA[i] = 0xa
 	}
-	i--
-	dAtA[i] = 0xa
 	return len(dAtA) - i, nil
 }
 
-func (m *Pool) Marshal() (dAtA []byte, err error) {
+func (m *LockupTerm) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -3389,32 +5640,113 @@ func (m *Pool) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *Pool) MarshalTo(dAtA []byte) (int, error) {
+func (m *LockupTerm) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *Pool) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *LockupTerm) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
 	{
-		size := m.BondedTokens.Size()
+		size := m.RewardMultiplier.Size()
 		i -= size
-		if _, err := m.BondedTokens.MarshalTo(dAtA[i:]); err != nil {
+		if _, err := m.RewardMultiplier.MarshalTo(dAtA[i:]); err != nil {
 			return 0, err
 		}
 		i = encodeVarintStaking(dAtA, i, uint64(size))
 	}
 	i--
 	dAtA[i] = 0x12
+	n17, err17 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.Duration, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.Duration):])
+	if err17 != nil {
+		return 0, err17
+	}
+	i -= n17
+	i = encodeVarintStaking(dAtA, i, uint64(n17))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *BondDenomWeight) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *BondDenomWeight) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *BondDenomWeight) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
 	{
-		size := m.NotBondedTokens.Size()
+		size := m.Weight.Size()
 		i -= size
-		if _, err := m.NotBondedTokens.MarshalTo(dAtA[i:]); err != nil {
+		if _, err := m.Weight.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintStaking(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintStaking(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *DelegationResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DelegationResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DelegationResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Balance.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintStaking(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	{
+		size, err := m.Delegation.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
 			return 0, err
 		}
+		i -= size
 		i = encodeVarintStaking(dAtA, i, uint64(size))
 	}
 	i--
@@ -3422,7 +5754,7 @@ func (m *Pool) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func (m *ValidatorUpdates) Marshal() (dAtA []byte, err error) {
+func (m *RedelegationEntryResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -3432,20 +5764,63 @@ func (m *ValidatorUpdates) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *ValidatorUpdates) MarshalTo(dAtA []byte) (int, error) {
+func (m *RedelegationEntryResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *ValidatorUpdates) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *RedelegationEntryResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.Updates) > 0 {
-		for iNdEx := len(m.Updates) - 1; iNdEx >= 0; iNdEx-- {
+	{
+		size := m.Balance.Size()
+		i -= size
+		if _, err := m.Balance.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintStaking(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	{
+		size, err := m.RedelegationEntry.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintStaking(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *RedelegationResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RedelegationResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RedelegationResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Entries) > 0 {
+		for iNdEx := len(m.Entries) - 1; iNdEx >= 0; iNdEx-- {
 			{
-				size, err := m.Updates[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				size, err := m.Entries[iNdEx].MarshalToSizedBuffer(dAtA[:i])
 				if err != nil {
 					return 0, err
 				}
@@ -3453,263 +5828,440 @@ func (m *ValidatorUpdates) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 				i = encodeVarintStaking(dAtA, i, uint64(size))
 			}
 			i--
-			dAtA[i] = 0xa
+			dAtA[i] = 0x12
+		}
+	}
+	{
+		size, err := m.Redelegation.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
 		}
+		i -= size
+		i = encodeVarintStaking(dAtA, i, uint64(size))
 	}
+	i--
+	dAtA[i] = 0xa
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintStaking(dAtA []byte, offset int, v uint64) int {
-	offset -= sovStaking(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *Pool) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *HistoricalInfo) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+
+func (m *Pool) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Pool) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = m.Header.Size()
-	n += 1 + l + sovStaking(uint64(l))
-	if len(m.Valset) > 0 {
-		for _, e := range m.Valset {
-			l = e.Size()
-			n += 1 + l + sovStaking(uint64(l))
+	{
+		size := m.BondedTokens.Size()
+		i -= size
+		if _, err := m.BondedTokens.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
 		}
+		i = encodeVarintStaking(dAtA, i, uint64(size))
 	}
-	return n
+	i--
+	dAtA[i] = 0x12
+	{
+		size := m.NotBondedTokens.Size()
+		i -= size
+		if _, err := m.NotBondedTokens.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintStaking(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
 }
 
-func (m *CommissionRates) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *ValidatorJailInfo) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = m.Rate.Size()
-	n += 1 + l + sovStaking(uint64(l))
-	l = m.MaxRate.Size()
-	n += 1 + l + sovStaking(uint64(l))
-	l = m.MaxChangeRate.Size()
-	n += 1 + l + sovStaking(uint64(l))
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *Commission) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = m.CommissionRates.Size()
-	n += 1 + l + sovStaking(uint64(l))
-	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.UpdateTime)
-	n += 1 + l + sovStaking(uint64(l))
-	return n
+func (m *ValidatorJailInfo) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *Description) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *ValidatorJailInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Moniker)
-	if l > 0 {
-		n += 1 + l + sovStaking(uint64(l))
+	if len(m.Reference) > 0 {
+		i -= len(m.Reference)
+		copy(dAtA[i:], m.Reference)
+		i = encodeVarintStaking(dAtA, i, uint64(len(m.Reference)))
+		i--
+		dAtA[i] = 0x2a
 	}
-	l = len(m.Identity)
-	if l > 0 {
-		n += 1 + l + sovStaking(uint64(l))
+	n22, err22 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.Time, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.Time):])
+	if err22 != nil {
+		return 0, err22
 	}
-	l = len(m.Website)
-	if l > 0 {
-		n += 1 + l + sovStaking(uint64(l))
+	i -= n22
+	i = encodeVarintStaking(dAtA, i, uint64(n22))
+	i--
+	dAtA[i] = 0x22
+	if m.Height != 0 {
+		i = encodeVarintStaking(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x18
 	}
-	l = len(m.SecurityContact)
-	if l > 0 {
-		n += 1 + l + sovStaking(uint64(l))
+	if m.Reason != 0 {
+		i = encodeVarintStaking(dAtA, i, uint64(m.Reason))
+		i--
+		dAtA[i] = 0x10
 	}
-	l = len(m.Details)
-	if l > 0 {
-		n += 1 + l + sovStaking(uint64(l))
+	if len(m.ValidatorAddress) > 0 {
+		i -= len(m.ValidatorAddress)
+		copy(dAtA[i:], m.ValidatorAddress)
+		i = encodeVarintStaking(dAtA, i, uint64(len(m.ValidatorAddress)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *Validator) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *ValidatorSetChange) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *ValidatorSetChange) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ValidatorSetChange) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.OperatorAddress)
-	if l > 0 {
-		n += 1 + l + sovStaking(uint64(l))
-	}
-	if m.ConsensusPubkey != nil {
-		l = m.ConsensusPubkey.Size()
-		n += 1 + l + sovStaking(uint64(l))
+	if m.Power != 0 {
+		i = encodeVarintStaking(dAtA, i, uint64(m.Power))
+		i--
+		dAtA[i] = 0x30
 	}
-	if m.Jailed {
-		n += 2
+	if m.PreviousPower != 0 {
+		i = encodeVarintStaking(dAtA, i, uint64(m.PreviousPower))
+		i--
+		dAtA[i] = 0x28
 	}
-	if m.Status != 0 {
-		n += 1 + sovStaking(uint64(m.Status))
+	if m.Type != 0 {
+		i = encodeVarintStaking(dAtA, i, uint64(m.Type))
+		i--
+		dAtA[i] = 0x20
 	}
-	l = m.Tokens.Size()
-	n += 1 + l + sovStaking(uint64(l))
-	l = m.DelegatorShares.Size()
-	n += 1 + l + sovStaking(uint64(l))
-	l = m.Description.Size()
-	n += 1 + l + sovStaking(uint64(l))
-	if m.UnbondingHeight != 0 {
-		n += 1 + sovStaking(uint64(m.UnbondingHeight))
+	if m.ConsensusPubkey != nil {
+		{
+			size, err := m.ConsensusPubkey.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintStaking(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
 	}
-	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.UnbondingTime)
-	n += 1 + l + sovStaking(uint64(l))
-	l = m.Commission.Size()
-	n += 1 + l + sovStaking(uint64(l))
-	l = m.MinSelfDelegation.Size()
-	n += 1 + l + sovStaking(uint64(l))
-	if m.UnbondingOnHoldRefCount != 0 {
-		n += 1 + sovStaking(uint64(m.UnbondingOnHoldRefCount))
+	if len(m.OperatorAddress) > 0 {
+		i -= len(m.OperatorAddress)
+		copy(dAtA[i:], m.OperatorAddress)
+		i = encodeVarintStaking(dAtA, i, uint64(len(m.OperatorAddress)))
+		i--
+		dAtA[i] = 0x12
 	}
-	if len(m.UnbondingIds) > 0 {
-		l = 0
-		for _, e := range m.UnbondingIds {
-			l += sovStaking(uint64(e))
-		}
-		n += 1 + sovStaking(uint64(l)) + l
+	if m.Height != 0 {
+		i = encodeVarintStaking(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x8
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *ValAddresses) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *ValidatorUpdates) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *ValidatorUpdates) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ValidatorUpdates) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if len(m.Addresses) > 0 {
-		for _, s := range m.Addresses {
-			l = len(s)
-			n += 1 + l + sovStaking(uint64(l))
+	if len(m.Updates) > 0 {
+		for iNdEx := len(m.Updates) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Updates[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintStaking(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
 		}
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *DVPair) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *TokenizeShareRecord) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *TokenizeShareRecord) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TokenizeShareRecord) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.DelegatorAddress)
-	if l > 0 {
-		n += 1 + l + sovStaking(uint64(l))
+	if len(m.Validator) > 0 {
+		i -= len(m.Validator)
+		copy(dAtA[i:], m.Validator)
+		i = encodeVarintStaking(dAtA, i, uint64(len(m.Validator)))
+		i--
+		dAtA[i] = 0x22
 	}
-	l = len(m.ValidatorAddress)
-	if l > 0 {
-		n += 1 + l + sovStaking(uint64(l))
+	if len(m.ModuleAccount) > 0 {
+		i -= len(m.ModuleAccount)
+		copy(dAtA[i:], m.ModuleAccount)
+		i = encodeVarintStaking(dAtA, i, uint64(len(m.ModuleAccount)))
+		i--
+		dAtA[i] = 0x1a
 	}
-	return n
-}
-
-func (m *DVPairs) Size() (n int) {
-	if m == nil {
-		return 0
+	if len(m.Owner) > 0 {
+		i -= len(m.Owner)
+		copy(dAtA[i:], m.Owner)
+		i = encodeVarintStaking(dAtA, i, uint64(len(m.Owner)))
+		i--
+		dAtA[i] = 0x12
 	}
-	var l int
-	_ = l
-	if len(m.Pairs) > 0 {
-		for _, e := range m.Pairs {
-			l = e.Size()
-			n += 1 + l + sovStaking(uint64(l))
-		}
+	if m.Id != 0 {
+		i = encodeVarintStaking(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *DVVTriplet) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *PendingCommissionChange) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *PendingCommissionChange) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PendingCommissionChange) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.DelegatorAddress)
-	if l > 0 {
-		n += 1 + l + sovStaking(uint64(l))
+	n24, err24 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.EffectiveTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.EffectiveTime):])
+	if err24 != nil {
+		return 0, err24
 	}
-	l = len(m.ValidatorSrcAddress)
-	if l > 0 {
-		n += 1 + l + sovStaking(uint64(l))
+	i -= n24
+	i = encodeVarintStaking(dAtA, i, uint64(n24))
+	i--
+	dAtA[i] = 0x1a
+	{
+		size := m.Rate.Size()
+		i -= size
+		if _, err := m.Rate.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintStaking(dAtA, i, uint64(size))
 	}
-	l = len(m.ValidatorDstAddress)
-	if l > 0 {
-		n += 1 + l + sovStaking(uint64(l))
+	i--
+	dAtA[i] = 0x12
+	if len(m.ValidatorAddress) > 0 {
+		i -= len(m.ValidatorAddress)
+		copy(dAtA[i:], m.ValidatorAddress)
+		i = encodeVarintStaking(dAtA, i, uint64(len(m.ValidatorAddress)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *DVVTriplets) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueuedEpochMsg) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *QueuedEpochMsg) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueuedEpochMsg) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if len(m.Triplets) > 0 {
-		for _, e := range m.Triplets {
-			l = e.Size()
-			n += 1 + l + sovStaking(uint64(l))
+	if m.Msg != nil {
+		{
+			size, err := m.Msg.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintStaking(dAtA, i, uint64(size))
 		}
+		i--
+		dAtA[i] = 0x12
 	}
-	return n
+	if m.Id != 0 {
+		i = encodeVarintStaking(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *Delegation) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *DelegationLock) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *DelegationLock) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DelegationLock) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.DelegatorAddress)
-	if l > 0 {
-		n += 1 + l + sovStaking(uint64(l))
+	n26, err26 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.EndTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.EndTime):])
+	if err26 != nil {
+		return 0, err26
 	}
-	l = len(m.ValidatorAddress)
-	if l > 0 {
-		n += 1 + l + sovStaking(uint64(l))
+	i -= n26
+	i = encodeVarintStaking(dAtA, i, uint64(n26))
+	i--
+	dAtA[i] = 0x2a
+	{
+		size := m.RewardMultiplier.Size()
+		i -= size
+		if _, err := m.RewardMultiplier.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintStaking(dAtA, i, uint64(size))
 	}
-	l = m.Shares.Size()
-	n += 1 + l + sovStaking(uint64(l))
-	return n
+	i--
+	dAtA[i] = 0x22
+	n27, err27 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.Duration, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.Duration):])
+	if err27 != nil {
+		return 0, err27
+	}
+	i -= n27
+	i = encodeVarintStaking(dAtA, i, uint64(n27))
+	i--
+	dAtA[i] = 0x1a
+	if len(m.ValidatorAddress) > 0 {
+		i -= len(m.ValidatorAddress)
+		copy(dAtA[i:], m.ValidatorAddress)
+		i = encodeVarintStaking(dAtA, i, uint64(len(m.ValidatorAddress)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.DelegatorAddress) > 0 {
+		i -= len(m.DelegatorAddress)
+		copy(dAtA[i:], m.DelegatorAddress)
+		i = encodeVarintStaking(dAtA, i, uint64(len(m.DelegatorAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *UnbondingDelegation) Size() (n int) {
+func encodeVarintStaking(dAtA []byte, offset int, v uint64) int {
+	offset -= sovStaking(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *HistoricalInfo) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	l = len(m.DelegatorAddress)
-	if l > 0 {
-		n += 1 + l + sovStaking(uint64(l))
-	}
-	l = len(m.ValidatorAddress)
-	if l > 0 {
-		n += 1 + l + sovStaking(uint64(l))
-	}
-	if len(m.Entries) > 0 {
-		for _, e := range m.Entries {
+	l = m.Header.Size()
+	n += 1 + l + sovStaking(uint64(l))
+	if len(m.Valset) > 0 {
+		for _, e := range m.Valset {
 			l = e.Size()
 			n += 1 + l + sovStaking(uint64(l))
 		}
@@ -3717,185 +6269,3651 @@ func (m *UnbondingDelegation) Size() (n int) {
 	return n
 }
 
-func (m *UnbondingDelegationEntry) Size() (n int) {
+func (m *CommissionRates) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	if m.CreationHeight != 0 {
-		n += 1 + sovStaking(uint64(m.CreationHeight))
-	}
-	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.CompletionTime)
+	l = m.Rate.Size()
 	n += 1 + l + sovStaking(uint64(l))
-	l = m.InitialBalance.Size()
+	l = m.MaxRate.Size()
 	n += 1 + l + sovStaking(uint64(l))
-	l = m.Balance.Size()
+	l = m.MaxChangeRate.Size()
 	n += 1 + l + sovStaking(uint64(l))
-	if m.UnbondingId != 0 {
-		n += 1 + sovStaking(uint64(m.UnbondingId))
-	}
-	if m.UnbondingOnHoldRefCount != 0 {
-		n += 1 + sovStaking(uint64(m.UnbondingOnHoldRefCount))
-	}
 	return n
 }
 
-func (m *RedelegationEntry) Size() (n int) {
+func (m *Commission) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	if m.CreationHeight != 0 {
-		n += 1 + sovStaking(uint64(m.CreationHeight))
-	}
-	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.CompletionTime)
-	n += 1 + l + sovStaking(uint64(l))
-	l = m.InitialBalance.Size()
+	l = m.CommissionRates.Size()
 	n += 1 + l + sovStaking(uint64(l))
-	l = m.SharesDst.Size()
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.UpdateTime)
 	n += 1 + l + sovStaking(uint64(l))
-	if m.UnbondingId != 0 {
-		n += 1 + sovStaking(uint64(m.UnbondingId))
+	return n
+}
+
+func (m *Description) Size() (n int) {
+	if m == nil {
+		return 0
 	}
-	if m.UnbondingOnHoldRefCount != 0 {
-		n += 1 + sovStaking(uint64(m.UnbondingOnHoldRefCount))
+	var l int
+	_ = l
+	l = len(m.Moniker)
+	if l > 0 {
+		n += 1 + l + sovStaking(uint64(l))
+	}
+	l = len(m.Identity)
+	if l > 0 {
+		n += 1 + l + sovStaking(uint64(l))
+	}
+	l = len(m.Website)
+	if l > 0 {
+		n += 1 + l + sovStaking(uint64(l))
+	}
+	l = len(m.SecurityContact)
+	if l > 0 {
+		n += 1 + l + sovStaking(uint64(l))
+	}
+	l = len(m.Details)
+	if l > 0 {
+		n += 1 + l + sovStaking(uint64(l))
+	}
+	if m.Profile != nil {
+		l = m.Profile.Size()
+		n += 1 + l + sovStaking(uint64(l))
 	}
 	return n
 }
 
-func (m *Redelegation) Size() (n int) {
+func (m *ValidatorProfile) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	l = len(m.DelegatorAddress)
+	l = len(m.LogoUri)
 	if l > 0 {
 		n += 1 + l + sovStaking(uint64(l))
 	}
-	l = len(m.ValidatorSrcAddress)
+	l = len(m.SecurityPolicy)
 	if l > 0 {
 		n += 1 + l + sovStaking(uint64(l))
 	}
-	l = len(m.ValidatorDstAddress)
+	l = len(m.MevPolicy)
 	if l > 0 {
 		n += 1 + l + sovStaking(uint64(l))
 	}
-	if len(m.Entries) > 0 {
-		for _, e := range m.Entries {
-			l = e.Size()
-			n += 1 + l + sovStaking(uint64(l))
-		}
+	l = len(m.Region)
+	if l > 0 {
+		n += 1 + l + sovStaking(uint64(l))
+	}
+	l = len(m.WebsiteProof)
+	if l > 0 {
+		n += 1 + l + sovStaking(uint64(l))
 	}
 	return n
 }
 
-func (m *Params) Size() (n int) {
+func (m *ProfileAttestation) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	l = github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.UnbondingTime)
-	n += 1 + l + sovStaking(uint64(l))
-	if m.MaxValidators != 0 {
-		n += 1 + sovStaking(uint64(m.MaxValidators))
+	l = len(m.ValidatorAddress)
+	if l > 0 {
+		n += 1 + l + sovStaking(uint64(l))
 	}
-	if m.MaxEntries != 0 {
-		n += 1 + sovStaking(uint64(m.MaxEntries))
+	l = len(m.Field)
+	if l > 0 {
+		n += 1 + l + sovStaking(uint64(l))
 	}
-	if m.HistoricalEntries != 0 {
-		n += 1 + sovStaking(uint64(m.HistoricalEntries))
+	l = len(m.Attester)
+	if l > 0 {
+		n += 1 + l + sovStaking(uint64(l))
 	}
-	l = len(m.BondDenom)
+	l = len(m.Value)
 	if l > 0 {
 		n += 1 + l + sovStaking(uint64(l))
 	}
-	l = m.MinCommissionRate.Size()
-	n += 1 + l + sovStaking(uint64(l))
+	if m.Verified {
+		n += 2
+	}
+	if m.Height != 0 {
+		n += 1 + sovStaking(uint64(m.Height))
+	}
 	return n
 }
 
-func (m *DelegationResponse) Size() (n int) {
+func (m *Validator) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	l = m.Delegation.Size()
+	l = len(m.OperatorAddress)
+	if l > 0 {
+		n += 1 + l + sovStaking(uint64(l))
+	}
+	if m.ConsensusPubkey != nil {
+		l = m.ConsensusPubkey.Size()
+		n += 1 + l + sovStaking(uint64(l))
+	}
+	if m.Jailed {
+		n += 2
+	}
+	if m.Status != 0 {
+		n += 1 + sovStaking(uint64(m.Status))
+	}
+	l = m.Tokens.Size()
 	n += 1 + l + sovStaking(uint64(l))
-	l = m.Balance.Size()
+	l = m.DelegatorShares.Size()
+	n += 1 + l + sovStaking(uint64(l))
+	l = m.Description.Size()
+	n += 1 + l + sovStaking(uint64(l))
+	if m.UnbondingHeight != 0 {
+		n += 1 + sovStaking(uint64(m.UnbondingHeight))
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.UnbondingTime)
+	n += 1 + l + sovStaking(uint64(l))
+	l = m.Commission.Size()
+	n += 1 + l + sovStaking(uint64(l))
+	l = m.MinSelfDelegation.Size()
+	n += 1 + l + sovStaking(uint64(l))
+	if m.UnbondingOnHoldRefCount != 0 {
+		n += 1 + sovStaking(uint64(m.UnbondingOnHoldRefCount))
+	}
+	if len(m.UnbondingIds) > 0 {
+		l = 0
+		for _, e := range m.UnbondingIds {
+			l += sovStaking(uint64(e))
+		}
+		n += 1 + sovStaking(uint64(l)) + l
+	}
+	l = m.ValidatorBondShares.Size()
+	n += 1 + l + sovStaking(uint64(l))
+	l = m.LiquidShares.Size()
 	n += 1 + l + sovStaking(uint64(l))
+	if len(m.Assets) > 0 {
+		for _, e := range m.Assets {
+			l = e.Size()
+			n += 2 + l + sovStaking(uint64(l))
+		}
+	}
 	return n
 }
 
-func (m *RedelegationEntryResponse) Size() (n int) {
+func (m *ValidatorAsset) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	l = m.RedelegationEntry.Size()
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovStaking(uint64(l))
+	}
+	l = m.Amount.Size()
 	n += 1 + l + sovStaking(uint64(l))
-	l = m.Balance.Size()
+	l = m.Tokens.Size()
 	n += 1 + l + sovStaking(uint64(l))
 	return n
 }
 
-func (m *RedelegationResponse) Size() (n int) {
+func (m *ValAddresses) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	l = m.Redelegation.Size()
-	n += 1 + l + sovStaking(uint64(l))
-	if len(m.Entries) > 0 {
-		for _, e := range m.Entries {
-			l = e.Size()
+	if len(m.Addresses) > 0 {
+		for _, s := range m.Addresses {
+			l = len(s)
 			n += 1 + l + sovStaking(uint64(l))
 		}
 	}
-	return n
-}
-
-func (m *Pool) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = m.NotBondedTokens.Size()
-	n += 1 + l + sovStaking(uint64(l))
-	l = m.BondedTokens.Size()
-	n += 1 + l + sovStaking(uint64(l))
-	return n
-}
+	return n
+}
+
+func (m *DVPair) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.DelegatorAddress)
+	if l > 0 {
+		n += 1 + l + sovStaking(uint64(l))
+	}
+	l = len(m.ValidatorAddress)
+	if l > 0 {
+		n += 1 + l + sovStaking(uint64(l))
+	}
+	return n
+}
+
+func (m *DVPairs) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Pairs) > 0 {
+		for _, e := range m.Pairs {
+			l = e.Size()
+			n += 1 + l + sovStaking(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *DVVTriplet) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.DelegatorAddress)
+	if l > 0 {
+		n += 1 + l + sovStaking(uint64(l))
+	}
+	l = len(m.ValidatorSrcAddress)
+	if l > 0 {
+		n += 1 + l + sovStaking(uint64(l))
+	}
+	l = len(m.ValidatorDstAddress)
+	if l > 0 {
+		n += 1 + l + sovStaking(uint64(l))
+	}
+	return n
+}
+
+func (m *DVVTriplets) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Triplets) > 0 {
+		for _, e := range m.Triplets {
+			l = e.Size()
+			n += 1 + l + sovStaking(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *Delegation) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.DelegatorAddress)
+	if l > 0 {
+		n += 1 + l + sovStaking(uint64(l))
+	}
+	l = len(m.ValidatorAddress)
+	if l > 0 {
+		n += 1 + l + sovStaking(uint64(l))
+	}
+	l = m.Shares.Size()
+	n += 1 + l + sovStaking(uint64(l))
+	if m.ValidatorBond {
+		n += 2
+	}
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovStaking(uint64(l))
+	}
+	return n
+}
+
+func (m *UnbondingDelegation) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.DelegatorAddress)
+	if l > 0 {
+		n += 1 + l + sovStaking(uint64(l))
+	}
+	l = len(m.ValidatorAddress)
+	if l > 0 {
+		n += 1 + l + sovStaking(uint64(l))
+	}
+	if len(m.Entries) > 0 {
+		for _, e := range m.Entries {
+			l = e.Size()
+			n += 1 + l + sovStaking(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *UnbondingDelegationEntry) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.CreationHeight != 0 {
+		n += 1 + sovStaking(uint64(m.CreationHeight))
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.CompletionTime)
+	n += 1 + l + sovStaking(uint64(l))
+	l = m.InitialBalance.Size()
+	n += 1 + l + sovStaking(uint64(l))
+	l = m.Balance.Size()
+	n += 1 + l + sovStaking(uint64(l))
+	if m.UnbondingId != 0 {
+		n += 1 + sovStaking(uint64(m.UnbondingId))
+	}
+	if m.UnbondingOnHoldRefCount != 0 {
+		n += 1 + sovStaking(uint64(m.UnbondingOnHoldRefCount))
+	}
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovStaking(uint64(l))
+	}
+	return n
+}
+
+func (m *RedelegationEntry) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.CreationHeight != 0 {
+		n += 1 + sovStaking(uint64(m.CreationHeight))
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.CompletionTime)
+	n += 1 + l + sovStaking(uint64(l))
+	l = m.InitialBalance.Size()
+	n += 1 + l + sovStaking(uint64(l))
+	l = m.SharesDst.Size()
+	n += 1 + l + sovStaking(uint64(l))
+	if m.UnbondingId != 0 {
+		n += 1 + sovStaking(uint64(m.UnbondingId))
+	}
+	if m.UnbondingOnHoldRefCount != 0 {
+		n += 1 + sovStaking(uint64(m.UnbondingOnHoldRefCount))
+	}
+	return n
+}
+
+func (m *Redelegation) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.DelegatorAddress)
+	if l > 0 {
+		n += 1 + l + sovStaking(uint64(l))
+	}
+	l = len(m.ValidatorSrcAddress)
+	if l > 0 {
+		n += 1 + l + sovStaking(uint64(l))
+	}
+	l = len(m.ValidatorDstAddress)
+	if l > 0 {
+		n += 1 + l + sovStaking(uint64(l))
+	}
+	if len(m.Entries) > 0 {
+		for _, e := range m.Entries {
+			l = e.Size()
+			n += 1 + l + sovStaking(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *Params) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.UnbondingTime)
+	n += 1 + l + sovStaking(uint64(l))
+	if m.MaxValidators != 0 {
+		n += 1 + sovStaking(uint64(m.MaxValidators))
+	}
+	if m.MaxEntries != 0 {
+		n += 1 + sovStaking(uint64(m.MaxEntries))
+	}
+	if m.HistoricalEntries != 0 {
+		n += 1 + sovStaking(uint64(m.HistoricalEntries))
+	}
+	l = len(m.BondDenom)
+	if l > 0 {
+		n += 1 + l + sovStaking(uint64(l))
+	}
+	l = m.MinCommissionRate.Size()
+	n += 1 + l + sovStaking(uint64(l))
+	l = m.ValidatorBondFactor.Size()
+	n += 1 + l + sovStaking(uint64(l))
+	l = m.GlobalLiquidStakingCap.Size()
+	n += 1 + l + sovStaking(uint64(l))
+	l = m.ValidatorLiquidStakingCap.Size()
+	n += 1 + l + sovStaking(uint64(l))
+	l = github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.CommissionChangeNoticePeriod)
+	n += 1 + l + sovStaking(uint64(l))
+	if len(m.BondDenomWeights) > 0 {
+		for _, e := range m.BondDenomWeights {
+			l = e.Size()
+			n += 1 + l + sovStaking(uint64(l))
+		}
+	}
+	if m.MaxMatureUnbondingsPerBlock != 0 {
+		n += 1 + sovStaking(uint64(m.MaxMatureUnbondingsPerBlock))
+	}
+	l = m.MinSelfDelegationFloor.Size()
+	n += 1 + l + sovStaking(uint64(l))
+	if m.EpochLength != 0 {
+		n += 1 + sovStaking(uint64(m.EpochLength))
+	}
+	if len(m.LockupTerms) > 0 {
+		for _, e := range m.LockupTerms {
+			l = e.Size()
+			n += 1 + l + sovStaking(uint64(l))
+		}
+	}
+	l = m.InstantUndelegationFeeRate.Size()
+	n += 2 + l + sovStaking(uint64(l))
+	l = m.InstantUndelegationBudgetPerBlock.Size()
+	n += 2 + l + sovStaking(uint64(l))
+	if m.InstantUndelegationFeeToCommunityPool {
+		n += 3
+	}
+	if len(m.UnbondingTiers) > 0 {
+		for _, e := range m.UnbondingTiers {
+			l = e.Size()
+			n += 2 + l + sovStaking(uint64(l))
+		}
+	}
+	l = m.MinDelegationAmount.Size()
+	n += 2 + l + sovStaking(uint64(l))
+	return n
+}
+
+func (m *UnbondingTier) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovStaking(uint64(l))
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.UnbondingTime)
+	n += 1 + l + sovStaking(uint64(l))
+	return n
+}
+
+func (m *ValidatorRiskTier) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ValidatorAddress)
+	if l > 0 {
+		n += 1 + l + sovStaking(uint64(l))
+	}
+	l = len(m.Tier)
+	if l > 0 {
+		n += 1 + l + sovStaking(uint64(l))
+	}
+	return n
+}
+
+func (m *StandbyConsPubKey) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ValidatorAddress)
+	if l > 0 {
+		n += 1 + l + sovStaking(uint64(l))
+	}
+	if m.Pubkey != nil {
+		l = m.Pubkey.Size()
+		n += 1 + l + sovStaking(uint64(l))
+	}
+	return n
+}
+
+func (m *LockupTerm) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.Duration)
+	n += 1 + l + sovStaking(uint64(l))
+	l = m.RewardMultiplier.Size()
+	n += 1 + l + sovStaking(uint64(l))
+	return n
+}
+
+func (m *BondDenomWeight) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovStaking(uint64(l))
+	}
+	l = m.Weight.Size()
+	n += 1 + l + sovStaking(uint64(l))
+	return n
+}
+
+func (m *DelegationResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Delegation.Size()
+	n += 1 + l + sovStaking(uint64(l))
+	l = m.Balance.Size()
+	n += 1 + l + sovStaking(uint64(l))
+	return n
+}
+
+func (m *RedelegationEntryResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.RedelegationEntry.Size()
+	n += 1 + l + sovStaking(uint64(l))
+	l = m.Balance.Size()
+	n += 1 + l + sovStaking(uint64(l))
+	return n
+}
+
+func (m *RedelegationResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Redelegation.Size()
+	n += 1 + l + sovStaking(uint64(l))
+	if len(m.Entries) > 0 {
+		for _, e := range m.Entries {
+			l = e.Size()
+			n += 1 + l + sovStaking(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *Pool) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.NotBondedTokens.Size()
+	n += 1 + l + sovStaking(uint64(l))
+	l = m.BondedTokens.Size()
+	n += 1 + l + sovStaking(uint64(l))
+	return n
+}
+
+func (m *ValidatorJailInfo) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ValidatorAddress)
+	if l > 0 {
+		n += 1 + l + sovStaking(uint64(l))
+	}
+	if m.Reason != 0 {
+		n += 1 + sovStaking(uint64(m.Reason))
+	}
+	if m.Height != 0 {
+		n += 1 + sovStaking(uint64(m.Height))
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.Time)
+	n += 1 + l + sovStaking(uint64(l))
+	l = len(m.Reference)
+	if l > 0 {
+		n += 1 + l + sovStaking(uint64(l))
+	}
+	return n
+}
+
+func (m *ValidatorSetChange) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Height != 0 {
+		n += 1 + sovStaking(uint64(m.Height))
+	}
+	l = len(m.OperatorAddress)
+	if l > 0 {
+		n += 1 + l + sovStaking(uint64(l))
+	}
+	if m.ConsensusPubkey != nil {
+		l = m.ConsensusPubkey.Size()
+		n += 1 + l + sovStaking(uint64(l))
+	}
+	if m.Type != 0 {
+		n += 1 + sovStaking(uint64(m.Type))
+	}
+	if m.PreviousPower != 0 {
+		n += 1 + sovStaking(uint64(m.PreviousPower))
+	}
+	if m.Power != 0 {
+		n += 1 + sovStaking(uint64(m.Power))
+	}
+	return n
+}
+
+func (m *ValidatorUpdates) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Updates) > 0 {
+		for _, e := range m.Updates {
+			l = e.Size()
+			n += 1 + l + sovStaking(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *TokenizeShareRecord) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovStaking(uint64(m.Id))
+	}
+	l = len(m.Owner)
+	if l > 0 {
+		n += 1 + l + sovStaking(uint64(l))
+	}
+	l = len(m.ModuleAccount)
+	if l > 0 {
+		n += 1 + l + sovStaking(uint64(l))
+	}
+	l = len(m.Validator)
+	if l > 0 {
+		n += 1 + l + sovStaking(uint64(l))
+	}
+	return n
+}
+
+func (m *PendingCommissionChange) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ValidatorAddress)
+	if l > 0 {
+		n += 1 + l + sovStaking(uint64(l))
+	}
+	l = m.Rate.Size()
+	n += 1 + l + sovStaking(uint64(l))
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.EffectiveTime)
+	n += 1 + l + sovStaking(uint64(l))
+	return n
+}
+
+func (m *QueuedEpochMsg) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovStaking(uint64(m.Id))
+	}
+	if m.Msg != nil {
+		l = m.Msg.Size()
+		n += 1 + l + sovStaking(uint64(l))
+	}
+	return n
+}
+
+func (m *DelegationLock) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.DelegatorAddress)
+	if l > 0 {
+		n += 1 + l + sovStaking(uint64(l))
+	}
+	l = len(m.ValidatorAddress)
+	if l > 0 {
+		n += 1 + l + sovStaking(uint64(l))
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.Duration)
+	n += 1 + l + sovStaking(uint64(l))
+	l = m.RewardMultiplier.Size()
+	n += 1 + l + sovStaking(uint64(l))
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.EndTime)
+	n += 1 + l + sovStaking(uint64(l))
+	return n
+}
+
+func sovStaking(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozStaking(x uint64) (n int) {
+	return sovStaking(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *HistoricalInfo) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowStaking
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: HistoricalInfo: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: HistoricalInfo: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Header", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthStaking
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Header.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Valset", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthStaking
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Valset = append(m.Valset, Validator{})
+			if err := m.Valset[len(m.Valset)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipStaking(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *CommissionRates) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowStaking
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CommissionRates: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CommissionRates: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Rate", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStaking
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Rate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxRate", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStaking
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MaxRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxChangeRate", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStaking
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MaxChangeRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipStaking(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Commission) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowStaking
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Commission: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Commission: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CommissionRates", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthStaking
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.CommissionRates.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UpdateTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthStaking
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.UpdateTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipStaking(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Description) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowStaking
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Description: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Description: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Moniker", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStaking
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Moniker = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Identity", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStaking
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Identity = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Website", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStaking
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Website = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SecurityContact", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStaking
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SecurityContact = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Details", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStaking
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Details = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Profile", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthStaking
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Profile == nil {
+				m.Profile = &ValidatorProfile{}
+			}
+			if err := m.Profile.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipStaking(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ValidatorProfile) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowStaking
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ValidatorProfile: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ValidatorProfile: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LogoUri", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStaking
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.LogoUri = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SecurityPolicy", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStaking
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SecurityPolicy = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MevPolicy", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStaking
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MevPolicy = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Region", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStaking
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Region = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WebsiteProof", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStaking
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.WebsiteProof = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipStaking(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ProfileAttestation) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowStaking
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ProfileAttestation: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ProfileAttestation: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStaking
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ValidatorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Field", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStaking
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Field = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Attester", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStaking
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Attester = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStaking
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Value = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Verified", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Verified = bool(v != 0)
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipStaking(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Validator) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowStaking
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Validator: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Validator: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OperatorAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStaking
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OperatorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConsensusPubkey", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthStaking
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ConsensusPubkey == nil {
+				m.ConsensusPubkey = &types1.Any{}
+			}
+			if err := m.ConsensusPubkey.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Jailed", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Jailed = bool(v != 0)
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			}
+			m.Status = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Status |= BondStatus(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Tokens", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStaking
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Tokens.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DelegatorShares", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStaking
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.DelegatorShares.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthStaking
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Description.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UnbondingHeight", wireType)
+			}
+			m.UnbondingHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.UnbondingHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UnbondingTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthStaking
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.UnbondingTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Commission", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthStaking
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Commission.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinSelfDelegation", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStaking
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MinSelfDelegation.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 12:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UnbondingOnHoldRefCount", wireType)
+			}
+			m.UnbondingOnHoldRefCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.UnbondingOnHoldRefCount |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 13:
+			if wireType == 0 {
+				var v uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowStaking
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.UnbondingIds = append(m.UnbondingIds, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowStaking
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthStaking
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthStaking
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.UnbondingIds) == 0 {
+					m.UnbondingIds = make([]uint64, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowStaking
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.UnbondingIds = append(m.UnbondingIds, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field UnbondingIds", wireType)
+			}
+		case 14:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorBondShares", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStaking
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ValidatorBondShares.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 15:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LiquidShares", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStaking
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.LiquidShares.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 16:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Assets", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthStaking
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Assets = append(m.Assets, ValidatorAsset{})
+			if err := m.Assets[len(m.Assets)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipStaking(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ValidatorAsset) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowStaking
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ValidatorAsset: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ValidatorAsset: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStaking
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStaking
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Tokens", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStaking
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Tokens.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipStaking(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ValAddresses) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowStaking
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ValAddresses: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ValAddresses: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Addresses", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStaking
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Addresses = append(m.Addresses, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipStaking(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DVPair) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowStaking
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DVPair: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DVPair: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DelegatorAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStaking
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DelegatorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStaking
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ValidatorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipStaking(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DVPairs) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowStaking
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DVPairs: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DVPairs: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pairs", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthStaking
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Pairs = append(m.Pairs, DVPair{})
+			if err := m.Pairs[len(m.Pairs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipStaking(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DVVTriplet) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowStaking
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DVVTriplet: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DVVTriplet: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DelegatorAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStaking
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DelegatorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorSrcAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStaking
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ValidatorSrcAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorDstAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStaking
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ValidatorDstAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipStaking(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DVVTriplets) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowStaking
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DVVTriplets: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DVVTriplets: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Triplets", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthStaking
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Triplets = append(m.Triplets, DVVTriplet{})
+			if err := m.Triplets[len(m.Triplets)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipStaking(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Delegation) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowStaking
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Delegation: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Delegation: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DelegatorAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStaking
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DelegatorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStaking
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ValidatorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Shares", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStaking
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Shares.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorBond", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.ValidatorBond = bool(v != 0)
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStaking
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipStaking(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *UnbondingDelegation) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowStaking
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: UnbondingDelegation: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: UnbondingDelegation: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DelegatorAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStaking
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DelegatorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStaking
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ValidatorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Entries", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthStaking
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Entries = append(m.Entries, UnbondingDelegationEntry{})
+			if err := m.Entries[len(m.Entries)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipStaking(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *UnbondingDelegationEntry) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowStaking
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: UnbondingDelegationEntry: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: UnbondingDelegationEntry: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CreationHeight", wireType)
+			}
+			m.CreationHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CreationHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CompletionTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthStaking
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.CompletionTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InitialBalance", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStaking
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.InitialBalance.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Balance", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStaking
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Balance.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UnbondingId", wireType)
+			}
+			m.UnbondingId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.UnbondingId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UnbondingOnHoldRefCount", wireType)
+			}
+			m.UnbondingOnHoldRefCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.UnbondingOnHoldRefCount |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStaking
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipStaking(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
 
-func (m *ValidatorUpdates) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if len(m.Updates) > 0 {
-		for _, e := range m.Updates {
-			l = e.Size()
-			n += 1 + l + sovStaking(uint64(l))
-		}
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	return n
-}
-
-func sovStaking(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozStaking(x uint64) (n int) {
-	return sovStaking(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	return nil
 }
-func (m *HistoricalInfo) Unmarshal(dAtA []byte) error {
+func (m *RedelegationEntry) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3918,15 +9936,34 @@ func (m *HistoricalInfo) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: HistoricalInfo: wiretype end group for non-group")
+			return fmt.Errorf("proto: RedelegationEntry: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: HistoricalInfo: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: RedelegationEntry: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CreationHeight", wireType)
+			}
+			m.CreationHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CreationHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Header", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CompletionTime", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -3953,15 +9990,15 @@ func (m *HistoricalInfo) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Header.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.CompletionTime, dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 2:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Valset", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field InitialBalance", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowStaking
@@ -3971,26 +10008,98 @@ func (m *HistoricalInfo) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthStaking
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthStaking
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Valset = append(m.Valset, Validator{})
-			if err := m.Valset[len(m.Valset)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.InitialBalance.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SharesDst", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStaking
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.SharesDst.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UnbondingId", wireType)
+			}
+			m.UnbondingId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.UnbondingId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UnbondingOnHoldRefCount", wireType)
+			}
+			m.UnbondingOnHoldRefCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.UnbondingOnHoldRefCount |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipStaking(dAtA[iNdEx:])
@@ -4012,7 +10121,7 @@ func (m *HistoricalInfo) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *CommissionRates) Unmarshal(dAtA []byte) error {
+func (m *Redelegation) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4035,15 +10144,15 @@ func (m *CommissionRates) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: CommissionRates: wiretype end group for non-group")
+			return fmt.Errorf("proto: Redelegation: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: CommissionRates: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: Redelegation: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Rate", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DelegatorAddress", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -4071,13 +10180,11 @@ func (m *CommissionRates) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Rate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.DelegatorAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MaxRate", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorSrcAddress", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -4105,13 +10212,11 @@ func (m *CommissionRates) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.MaxRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.ValidatorSrcAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MaxChangeRate", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorDstAddress", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -4139,7 +10244,39 @@ func (m *CommissionRates) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.MaxChangeRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.ValidatorDstAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Entries", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthStaking
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Entries = append(m.Entries, RedelegationEntry{})
+			if err := m.Entries[len(m.Entries)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -4164,7 +10301,7 @@ func (m *CommissionRates) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Commission) Unmarshal(dAtA []byte) error {
+func (m *Params) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4187,15 +10324,15 @@ func (m *Commission) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Commission: wiretype end group for non-group")
+			return fmt.Errorf("proto: Params: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Commission: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: Params: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CommissionRates", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field UnbondingTime", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -4222,15 +10359,15 @@ func (m *Commission) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.CommissionRates.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := github_com_cosmos_gogoproto_types.StdDurationUnmarshal(&m.UnbondingTime, dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field UpdateTime", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxValidators", wireType)
 			}
-			var msglen int
+			m.MaxValidators = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowStaking
@@ -4240,80 +10377,16 @@ func (m *Commission) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.MaxValidators |= uint32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthStaking
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthStaking
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.UpdateTime, dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipStaking(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthStaking
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *Description) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowStaking
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: Description: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Description: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Moniker", wireType)
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxEntries", wireType)
 			}
-			var stringLen uint64
+			m.MaxEntries = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowStaking
@@ -4323,27 +10396,33 @@ func (m *Description) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.MaxEntries |= uint32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthStaking
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthStaking
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HistoricalEntries", wireType)
 			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+			m.HistoricalEntries = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.HistoricalEntries |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			m.Moniker = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 2:
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Identity", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field BondDenom", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -4371,11 +10450,11 @@ func (m *Description) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Identity = string(dAtA[iNdEx:postIndex])
+			m.BondDenom = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 3:
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Website", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MinCommissionRate", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -4403,11 +10482,13 @@ func (m *Description) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Website = string(dAtA[iNdEx:postIndex])
+			if err := m.MinCommissionRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 4:
+		case 7:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SecurityContact", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorBondFactor", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -4435,11 +10516,13 @@ func (m *Description) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.SecurityContact = string(dAtA[iNdEx:postIndex])
+			if err := m.ValidatorBondFactor.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 5:
+		case 8:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Details", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field GlobalLiquidStakingCap", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -4459,69 +10542,21 @@ func (m *Description) Unmarshal(dAtA []byte) error {
 			intStringLen := int(stringLen)
 			if intStringLen < 0 {
 				return ErrInvalidLengthStaking
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthStaking
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Details = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipStaking(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthStaking
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *Validator) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowStaking
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: Validator: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Validator: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.GlobalLiquidStakingCap.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field OperatorAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorLiquidStakingCap", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -4549,11 +10584,13 @@ func (m *Validator) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.OperatorAddress = string(dAtA[iNdEx:postIndex])
+			if err := m.ValidatorLiquidStakingCap.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 2:
+		case 10:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ConsensusPubkey", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CommissionChangeNoticePeriod", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -4580,18 +10617,15 @@ func (m *Validator) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.ConsensusPubkey == nil {
-				m.ConsensusPubkey = &types1.Any{}
-			}
-			if err := m.ConsensusPubkey.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := github_com_cosmos_gogoproto_types.StdDurationUnmarshal(&m.CommissionChangeNoticePeriod, dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Jailed", wireType)
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BondDenomWeights", wireType)
 			}
-			var v int
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowStaking
@@ -4601,17 +10635,31 @@ func (m *Validator) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.Jailed = bool(v != 0)
-		case 4:
+			if msglen < 0 {
+				return ErrInvalidLengthStaking
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BondDenomWeights = append(m.BondDenomWeights, BondDenomWeight{})
+			if err := m.BondDenomWeights[len(m.BondDenomWeights)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 12:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxMatureUnbondingsPerBlock", wireType)
 			}
-			m.Status = 0
+			m.MaxMatureUnbondingsPerBlock = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowStaking
@@ -4621,14 +10669,14 @@ func (m *Validator) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Status |= BondStatus(b&0x7F) << shift
+				m.MaxMatureUnbondingsPerBlock |= uint32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 5:
+		case 13:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Tokens", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MinSelfDelegationFloor", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -4656,15 +10704,15 @@ func (m *Validator) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Tokens.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.MinSelfDelegationFloor.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 6:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DelegatorShares", wireType)
+		case 14:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EpochLength", wireType)
 			}
-			var stringLen uint64
+			m.EpochLength = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowStaking
@@ -4674,29 +10722,14 @@ func (m *Validator) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.EpochLength |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthStaking
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthStaking
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := m.DelegatorShares.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 7:
+		case 15:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field LockupTerms", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -4723,15 +10756,16 @@ func (m *Validator) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Description.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.LockupTerms = append(m.LockupTerms, LockupTerm{})
+			if err := m.LockupTerms[len(m.LockupTerms)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 8:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field UnbondingHeight", wireType)
+		case 16:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InstantUndelegationFeeRate", wireType)
 			}
-			m.UnbondingHeight = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowStaking
@@ -4741,16 +10775,31 @@ func (m *Validator) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.UnbondingHeight |= int64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 9:
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStaking
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.InstantUndelegationFeeRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 17:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field UnbondingTime", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field InstantUndelegationBudgetPerBlock", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowStaking
@@ -4760,28 +10809,49 @@ func (m *Validator) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthStaking
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthStaking
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.UnbondingTime, dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.InstantUndelegationBudgetPerBlock.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 10:
+		case 18:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InstantUndelegationFeeToCommunityPool", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.InstantUndelegationFeeToCommunityPool = bool(v != 0)
+		case 19:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Commission", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field UnbondingTiers", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -4808,13 +10878,14 @@ func (m *Validator) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Commission.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.UnbondingTiers = append(m.UnbondingTiers, UnbondingTier{})
+			if err := m.UnbondingTiers[len(m.UnbondingTiers)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 11:
+		case 20:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MinSelfDelegation", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MinDelegationAmount", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -4842,15 +10913,65 @@ func (m *Validator) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.MinSelfDelegation.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.MinDelegationAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 12:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field UnbondingOnHoldRefCount", wireType)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipStaking(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			m.UnbondingOnHoldRefCount = 0
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *UnbondingTier) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowStaking
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: UnbondingTier: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: UnbondingTier: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowStaking
@@ -4860,87 +10981,57 @@ func (m *Validator) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.UnbondingOnHoldRefCount |= int64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 13:
-			if wireType == 0 {
-				var v uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowStaking
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					v |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				m.UnbondingIds = append(m.UnbondingIds, v)
-			} else if wireType == 2 {
-				var packedLen int
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowStaking
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					packedLen |= int(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				if packedLen < 0 {
-					return ErrInvalidLengthStaking
-				}
-				postIndex := iNdEx + packedLen
-				if postIndex < 0 {
-					return ErrInvalidLengthStaking
-				}
-				if postIndex > l {
-					return io.ErrUnexpectedEOF
-				}
-				var elementCount int
-				var count int
-				for _, integer := range dAtA[iNdEx:postIndex] {
-					if integer < 128 {
-						count++
-					}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStaking
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UnbondingTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
 				}
-				elementCount = count
-				if elementCount != 0 && len(m.UnbondingIds) == 0 {
-					m.UnbondingIds = make([]uint64, 0, elementCount)
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
 				}
-				for iNdEx < postIndex {
-					var v uint64
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowStaking
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						v |= uint64(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					m.UnbondingIds = append(m.UnbondingIds, v)
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
 				}
-			} else {
-				return fmt.Errorf("proto: wrong wireType = %d for field UnbondingIds", wireType)
 			}
+			if msglen < 0 {
+				return ErrInvalidLengthStaking
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdDurationUnmarshal(&m.UnbondingTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipStaking(dAtA[iNdEx:])
@@ -4962,7 +11053,7 @@ func (m *Validator) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ValAddresses) Unmarshal(dAtA []byte) error {
+func (m *ValidatorRiskTier) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4985,15 +11076,15 @@ func (m *ValAddresses) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ValAddresses: wiretype end group for non-group")
+			return fmt.Errorf("proto: ValidatorRiskTier: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ValAddresses: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ValidatorRiskTier: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Addresses", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddress", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -5021,7 +11112,39 @@ func (m *ValAddresses) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Addresses = append(m.Addresses, string(dAtA[iNdEx:postIndex]))
+			m.ValidatorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Tier", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStaking
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Tier = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -5044,7 +11167,7 @@ func (m *ValAddresses) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *DVPair) Unmarshal(dAtA []byte) error {
+func (m *StandbyConsPubKey) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5067,15 +11190,15 @@ func (m *DVPair) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: DVPair: wiretype end group for non-group")
+			return fmt.Errorf("proto: StandbyConsPubKey: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: DVPair: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: StandbyConsPubKey: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DelegatorAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddress", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -5103,13 +11226,13 @@ func (m *DVPair) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.DelegatorAddress = string(dAtA[iNdEx:postIndex])
+			m.ValidatorAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Pubkey", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowStaking
@@ -5119,23 +11242,27 @@ func (m *DVPair) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthStaking
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthStaking
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ValidatorAddress = string(dAtA[iNdEx:postIndex])
+			if m.Pubkey == nil {
+				m.Pubkey = &types1.Any{}
+			}
+			if err := m.Pubkey.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -5158,7 +11285,7 @@ func (m *DVPair) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *DVPairs) Unmarshal(dAtA []byte) error {
+func (m *LockupTerm) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5181,15 +11308,15 @@ func (m *DVPairs) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: DVPairs: wiretype end group for non-group")
+			return fmt.Errorf("proto: LockupTerm: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: DVPairs: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: LockupTerm: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pairs", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Duration", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -5216,8 +11343,41 @@ func (m *DVPairs) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Pairs = append(m.Pairs, DVPair{})
-			if err := m.Pairs[len(m.Pairs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := github_com_cosmos_gogoproto_types.StdDurationUnmarshal(&m.Duration, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RewardMultiplier", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStaking
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.RewardMultiplier.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -5242,7 +11402,7 @@ func (m *DVPairs) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *DVVTriplet) Unmarshal(dAtA []byte) error {
+func (m *BondDenomWeight) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5265,15 +11425,15 @@ func (m *DVVTriplet) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: DVVTriplet: wiretype end group for non-group")
+			return fmt.Errorf("proto: BondDenomWeight: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: DVVTriplet: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: BondDenomWeight: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DelegatorAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -5301,11 +11461,11 @@ func (m *DVVTriplet) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.DelegatorAddress = string(dAtA[iNdEx:postIndex])
+			m.Denom = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorSrcAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Weight", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -5333,13 +11493,65 @@ func (m *DVVTriplet) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ValidatorSrcAddress = string(dAtA[iNdEx:postIndex])
+			if err := m.Weight.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 3:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipStaking(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DelegationResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowStaking
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DelegationResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DelegationResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorDstAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Delegation", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowStaking
@@ -5349,23 +11561,57 @@ func (m *DVVTriplet) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthStaking
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthStaking
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ValidatorDstAddress = string(dAtA[iNdEx:postIndex])
+			if err := m.Delegation.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Balance", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthStaking
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Balance.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -5388,7 +11634,7 @@ func (m *DVVTriplet) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *DVVTriplets) Unmarshal(dAtA []byte) error {
+func (m *RedelegationEntryResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5411,15 +11657,15 @@ func (m *DVVTriplets) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: DVVTriplets: wiretype end group for non-group")
+			return fmt.Errorf("proto: RedelegationEntryResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: DVVTriplets: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: RedelegationEntryResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Triplets", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RedelegationEntry", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -5446,8 +11692,41 @@ func (m *DVVTriplets) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Triplets = append(m.Triplets, DVVTriplet{})
-			if err := m.Triplets[len(m.Triplets)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.RedelegationEntry.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Balance", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStaking
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Balance.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -5472,7 +11751,7 @@ func (m *DVVTriplets) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Delegation) Unmarshal(dAtA []byte) error {
+func (m *RedelegationResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5495,17 +11774,17 @@ func (m *Delegation) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Delegation: wiretype end group for non-group")
+			return fmt.Errorf("proto: RedelegationResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Delegation: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: RedelegationResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DelegatorAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Redelegation", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowStaking
@@ -5515,61 +11794,30 @@ func (m *Delegation) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthStaking
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthStaking
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.DelegatorAddress = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddress", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowStaking
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthStaking
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthStaking
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+			if err := m.Redelegation.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			m.ValidatorAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 3:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Shares", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Entries", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowStaking
@@ -5579,23 +11827,23 @@ func (m *Delegation) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthStaking
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthStaking
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Shares.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Entries = append(m.Entries, RedelegationEntryResponse{})
+			if err := m.Entries[len(m.Entries)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -5620,7 +11868,7 @@ func (m *Delegation) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *UnbondingDelegation) Unmarshal(dAtA []byte) error {
+func (m *Pool) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5643,15 +11891,15 @@ func (m *UnbondingDelegation) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: UnbondingDelegation: wiretype end group for non-group")
+			return fmt.Errorf("proto: Pool: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: UnbondingDelegation: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: Pool: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DelegatorAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field NotBondedTokens", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -5679,11 +11927,13 @@ func (m *UnbondingDelegation) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.DelegatorAddress = string(dAtA[iNdEx:postIndex])
+			if err := m.NotBondedTokens.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field BondedTokens", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -5711,39 +11961,7 @@ func (m *UnbondingDelegation) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ValidatorAddress = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Entries", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowStaking
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthStaking
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthStaking
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Entries = append(m.Entries, UnbondingDelegationEntry{})
-			if err := m.Entries[len(m.Entries)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.BondedTokens.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -5768,7 +11986,7 @@ func (m *UnbondingDelegation) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *UnbondingDelegationEntry) Unmarshal(dAtA []byte) error {
+func (m *ValidatorJailInfo) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5791,17 +12009,17 @@ func (m *UnbondingDelegationEntry) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: UnbondingDelegationEntry: wiretype end group for non-group")
+			return fmt.Errorf("proto: ValidatorJailInfo: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: UnbondingDelegationEntry: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ValidatorJailInfo: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CreationHeight", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddress", wireType)
 			}
-			m.CreationHeight = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowStaking
@@ -5811,16 +12029,29 @@ func (m *UnbondingDelegationEntry) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.CreationHeight |= int64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStaking
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ValidatorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CompletionTime", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
 			}
-			var msglen int
+			m.Reason = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowStaking
@@ -5830,30 +12061,16 @@ func (m *UnbondingDelegationEntry) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.Reason |= JailReason(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthStaking
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthStaking
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.CompletionTime, dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field InitialBalance", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
 			}
-			var stringLen uint64
+			m.Height = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowStaking
@@ -5863,31 +12080,16 @@ func (m *UnbondingDelegationEntry) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.Height |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthStaking
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthStaking
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := m.InitialBalance.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Balance", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Time", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowStaking
@@ -5897,50 +12099,30 @@ func (m *UnbondingDelegationEntry) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthStaking
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthStaking
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Balance.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.Time, dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 5:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field UnbondingId", wireType)
-			}
-			m.UnbondingId = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowStaking
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.UnbondingId |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 6:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field UnbondingOnHoldRefCount", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Reference", wireType)
 			}
-			m.UnbondingOnHoldRefCount = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowStaking
@@ -5950,11 +12132,24 @@ func (m *UnbondingDelegationEntry) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.UnbondingOnHoldRefCount |= int64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStaking
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Reference = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipStaking(dAtA[iNdEx:])
@@ -5976,7 +12171,7 @@ func (m *UnbondingDelegationEntry) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *RedelegationEntry) Unmarshal(dAtA []byte) error {
+func (m *ValidatorSetChange) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5999,17 +12194,17 @@ func (m *RedelegationEntry) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: RedelegationEntry: wiretype end group for non-group")
+			return fmt.Errorf("proto: ValidatorSetChange: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: RedelegationEntry: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ValidatorSetChange: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CreationHeight", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
 			}
-			m.CreationHeight = 0
+			m.Height = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowStaking
@@ -6019,16 +12214,16 @@ func (m *RedelegationEntry) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.CreationHeight |= int64(b&0x7F) << shift
+				m.Height |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CompletionTime", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field OperatorAddress", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowStaking
@@ -6038,30 +12233,29 @@ func (m *RedelegationEntry) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthStaking
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthStaking
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.CompletionTime, dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.OperatorAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field InitialBalance", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ConsensusPubkey", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowStaking
@@ -6071,31 +12265,33 @@ func (m *RedelegationEntry) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthStaking
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthStaking
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.InitialBalance.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.ConsensusPubkey == nil {
+				m.ConsensusPubkey = &types1.Any{}
+			}
+			if err := m.ConsensusPubkey.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SharesDst", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
 			}
-			var stringLen uint64
+			m.Type = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowStaking
@@ -6105,31 +12301,16 @@ func (m *RedelegationEntry) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.Type |= ValidatorSetChangeType(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthStaking
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthStaking
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := m.SharesDst.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		case 5:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field UnbondingId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PreviousPower", wireType)
 			}
-			m.UnbondingId = 0
+			m.PreviousPower = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowStaking
@@ -6139,16 +12320,16 @@ func (m *RedelegationEntry) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.UnbondingId |= uint64(b&0x7F) << shift
+				m.PreviousPower |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
 		case 6:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field UnbondingOnHoldRefCount", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Power", wireType)
 			}
-			m.UnbondingOnHoldRefCount = 0
+			m.Power = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowStaking
@@ -6158,7 +12339,7 @@ func (m *RedelegationEntry) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.UnbondingOnHoldRefCount |= int64(b&0x7F) << shift
+				m.Power |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -6184,7 +12365,7 @@ func (m *RedelegationEntry) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Redelegation) Unmarshal(dAtA []byte) error {
+func (m *ValidatorUpdates) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6207,111 +12388,15 @@ func (m *Redelegation) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Redelegation: wiretype end group for non-group")
+			return fmt.Errorf("proto: ValidatorUpdates: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Redelegation: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ValidatorUpdates: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DelegatorAddress", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowStaking
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthStaking
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthStaking
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.DelegatorAddress = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorSrcAddress", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowStaking
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthStaking
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthStaking
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.ValidatorSrcAddress = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorDstAddress", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowStaking
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthStaking
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthStaking
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.ValidatorDstAddress = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Entries", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Updates", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -6338,8 +12423,8 @@ func (m *Redelegation) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Entries = append(m.Entries, RedelegationEntry{})
-			if err := m.Entries[len(m.Entries)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Updates = append(m.Updates, types3.ValidatorUpdate{})
+			if err := m.Updates[len(m.Updates)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -6364,7 +12449,7 @@ func (m *Redelegation) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Params) Unmarshal(dAtA []byte) error {
+func (m *TokenizeShareRecord) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6387,17 +12472,17 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Params: wiretype end group for non-group")
+			return fmt.Errorf("proto: TokenizeShareRecord: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Params: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: TokenizeShareRecord: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field UnbondingTime", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
 			}
-			var msglen int
+			m.Id = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowStaking
@@ -6407,30 +12492,16 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.Id |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthStaking
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthStaking
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := github_com_cosmos_gogoproto_types.StdDurationUnmarshal(&m.UnbondingTime, dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MaxValidators", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
 			}
-			m.MaxValidators = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowStaking
@@ -6440,52 +12511,27 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.MaxValidators |= uint32(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MaxEntries", wireType)
-			}
-			m.MaxEntries = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowStaking
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.MaxEntries |= uint32(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStaking
 			}
-		case 4:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field HistoricalEntries", wireType)
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStaking
 			}
-			m.HistoricalEntries = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowStaking
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.HistoricalEntries |= uint32(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
 			}
-		case 5:
+			m.Owner = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BondDenom", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ModuleAccount", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -6513,11 +12559,11 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.BondDenom = string(dAtA[iNdEx:postIndex])
+			m.ModuleAccount = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 6:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MinCommissionRate", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Validator", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -6545,9 +12591,7 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.MinCommissionRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Validator = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -6570,7 +12614,7 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *DelegationResponse) Unmarshal(dAtA []byte) error {
+func (m *PendingCommissionChange) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6593,17 +12637,17 @@ func (m *DelegationResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: DelegationResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: PendingCommissionChange: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: DelegationResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: PendingCommissionChange: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Delegation", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddress", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowStaking
@@ -6613,28 +12657,61 @@ func (m *DelegationResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthStaking
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthStaking
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Delegation.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.ValidatorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Rate", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStaking
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Rate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 2:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Balance", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field EffectiveTime", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -6661,7 +12738,7 @@ func (m *DelegationResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Balance.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.EffectiveTime, dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -6686,7 +12763,7 @@ func (m *DelegationResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *RedelegationEntryResponse) Unmarshal(dAtA []byte) error {
+func (m *QueuedEpochMsg) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6709,17 +12786,17 @@ func (m *RedelegationEntryResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: RedelegationEntryResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueuedEpochMsg: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: RedelegationEntryResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueuedEpochMsg: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RedelegationEntry", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
 			}
-			var msglen int
+			m.Id = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowStaking
@@ -6729,30 +12806,16 @@ func (m *RedelegationEntryResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.Id |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthStaking
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthStaking
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := m.RedelegationEntry.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 4:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Balance", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Msg", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowStaking
@@ -6762,23 +12825,25 @@ func (m *RedelegationEntryResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthStaking
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthStaking
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Balance.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.Msg == nil {
+				m.Msg = &types1.Any{}
+			}
+			if err := m.Msg.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -6803,7 +12868,7 @@ func (m *RedelegationEntryResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *RedelegationResponse) Unmarshal(dAtA []byte) error {
+func (m *DelegationLock) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6826,17 +12891,17 @@ func (m *RedelegationResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: RedelegationResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: DelegationLock: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: RedelegationResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: DelegationLock: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Redelegation", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DelegatorAddress", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowStaking
@@ -6846,30 +12911,29 @@ func (m *RedelegationResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthStaking
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthStaking
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Redelegation.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.DelegatorAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Entries", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddress", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowStaking
@@ -6879,81 +12943,29 @@ func (m *RedelegationResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthStaking
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthStaking
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Entries = append(m.Entries, RedelegationEntryResponse{})
-			if err := m.Entries[len(m.Entries)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.ValidatorAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipStaking(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthStaking
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *Pool) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowStaking
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: Pool: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Pool: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field NotBondedTokens", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Duration", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowStaking
@@ -6963,29 +12975,28 @@ func (m *Pool) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthStaking
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthStaking
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.NotBondedTokens.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := github_com_cosmos_gogoproto_types.StdDurationUnmarshal(&m.Duration, dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 2:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BondedTokens", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RewardMultiplier", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -7013,63 +13024,13 @@ func (m *Pool) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.BondedTokens.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.RewardMultiplier.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipStaking(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthStaking
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *ValidatorUpdates) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowStaking
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: ValidatorUpdates: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ValidatorUpdates: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Updates", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field EndTime", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -7096,8 +13057,7 @@ func (m *ValidatorUpdates) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Updates = append(m.Updates, types3.ValidatorUpdate{})
-			if err := m.Updates[len(m.Updates)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.EndTime, dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -0,0 +1,41 @@
+package types
+
+import (
+	"cosmossdk.io/errors"
+
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+var _ codectypes.UnpackInterfacesMessage = StandbyConsPubKey{}
+
+// NewStandbyConsPubKey creates a new StandbyConsPubKey instance.
+func NewStandbyConsPubKey(valAddr sdk.ValAddress, pubKey cryptotypes.PubKey) (StandbyConsPubKey, error) {
+	pkAny, err := codectypes.NewAnyWithValue(pubKey)
+	if err != nil {
+		return StandbyConsPubKey{}, err
+	}
+
+	return StandbyConsPubKey{
+		ValidatorAddress: valAddr.String(),
+		Pubkey:           pkAny,
+	}, nil
+}
+
+// ConsPubKey returns the standby consensus pubkey.
+func (s StandbyConsPubKey) ConsPubKey() (cryptotypes.PubKey, error) {
+	pk, ok := s.Pubkey.GetCachedValue().(cryptotypes.PubKey)
+	if !ok {
+		return nil, errors.Wrapf(sdkerrors.ErrInvalidType, "expecting cryptotypes.PubKey, got %T", pk)
+	}
+
+	return pk, nil
+}
+
+// UnpackInterfaces implements UnpackInterfacesMessage.UnpackInterfaces
+func (s StandbyConsPubKey) UnpackInterfaces(unpacker codectypes.AnyUnpacker) error {
+	var pk cryptotypes.PubKey
+	return unpacker.UnpackAny(s.Pubkey, &pk)
+}
@@ -0,0 +1,32 @@
+package types
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+)
+
+// TokenizeShareModuleAccountPrefix is the prefix of the names of the module
+// accounts delegating tokenized shares.
+const TokenizeShareModuleAccountPrefix = "tokenizeshare_"
+
+// GetTokenizeShareModuleAccountName returns the name of the module account
+// delegating the shares of the tokenize share record with the given id.
+func GetTokenizeShareModuleAccountName(id uint64) string {
+	return TokenizeShareModuleAccountPrefix + strconv.FormatUint(id, 10)
+}
+
+// GetModuleAddress returns the address of the module account delegating the
+// tokenized shares of the record.
+func (r TokenizeShareRecord) GetModuleAddress() sdk.AccAddress {
+	return authtypes.NewModuleAddress(r.ModuleAccount)
+}
+
+// GetShareTokenDenom returns the denom of the share tokens minted for the
+// record.
+func (r TokenizeShareRecord) GetShareTokenDenom() string {
+	return fmt.Sprintf("%s/%d", strings.ToLower(r.Validator), r.Id)
+}
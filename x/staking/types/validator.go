@@ -58,6 +58,8 @@ func NewValidator(operator sdk.ValAddress, pubKey cryptotypes.PubKey, descriptio
 		Commission:              NewCommission(math.LegacyZeroDec(), math.LegacyZeroDec(), math.LegacyZeroDec()),
 		MinSelfDelegation:       math.OneInt(),
 		UnbondingOnHoldRefCount: 0,
+		ValidatorBondShares:     math.LegacyZeroDec(),
+		LiquidShares:            math.LegacyZeroDec(),
 	}, nil
 }
 
@@ -208,16 +210,20 @@ func (d Description) UpdateDescription(d2 Description) (Description, error) {
 		d2.Details = d.Details
 	}
 
-	return NewDescription(
+	description := NewDescription(
 		d2.Moniker,
 		d2.Identity,
 		d2.Website,
 		d2.SecurityContact,
 		d2.Details,
-	).EnsureLength()
+	)
+	description.Profile = d.Profile.UpdateProfile(d2.Profile)
+
+	return description.EnsureLength()
 }
 
-// EnsureLength ensures the length of a validator's description.
+// EnsureLength ensures the length of a validator's description, and the length
+// and format of its profile.
 func (d Description) EnsureLength() (Description, error) {
 	if len(d.Moniker) > MaxMonikerLength {
 		return d, errors.Wrapf(sdkerrors.ErrInvalidRequest, "invalid moniker length; got: %d, max: %d", len(d.Moniker), MaxMonikerLength)
@@ -239,6 +245,10 @@ func (d Description) EnsureLength() (Description, error) {
 		return d, errors.Wrapf(sdkerrors.ErrInvalidRequest, "invalid details length; got: %d, max: %d", len(d.Details), MaxDetailsLength)
 	}
 
+	if err := d.Profile.Validate(); err != nil {
+		return d, err
+	}
+
 	return d, nil
 }
 
@@ -301,28 +311,65 @@ func (v Validator) TokensFromSharesTruncated(shares math.LegacyDec) math.LegacyD
 
 // TokensFromSharesRoundUp returns the token worth of provided shares, rounded
 // up.
+//
+// NOTE: unlike SharesFromTokens, the token conversions keep the LegacyDec
+// arithmetic, as the shares converted are those of existing delegations, which
+// are bounded by the delegator shares of the validator.
 func (v Validator) TokensFromSharesRoundUp(shares math.LegacyDec) math.LegacyDec {
 	return (shares.MulInt(v.Tokens)).QuoRoundUp(v.DelegatorShares)
 }
 
 // SharesFromTokens returns the shares of a delegation given a bond amount. It
-// returns an error if the validator has no tokens.
+// returns an error if the validator has no tokens, or if the shares overflow.
 func (v Validator) SharesFromTokens(amt math.Int) (math.LegacyDec, error) {
 	if v.Tokens.IsZero() {
 		return math.LegacyZeroDec(), ErrInsufficientShares
 	}
 
-	return v.GetDelegatorShares().MulInt(amt).QuoInt(v.GetTokens()), nil
+	return v.sharesFromTokens(amt)
 }
 
 // SharesFromTokensTruncated returns the truncated shares of a delegation given
-// a bond amount. It returns an error if the validator has no tokens.
+// a bond amount. It returns an error if the validator has no tokens, or if the
+// shares overflow.
 func (v Validator) SharesFromTokensTruncated(amt math.Int) (math.LegacyDec, error) {
 	if v.Tokens.IsZero() {
 		return math.LegacyZeroDec(), ErrInsufficientShares
 	}
 
-	return v.GetDelegatorShares().MulInt(amt).QuoTruncate(math.LegacyNewDecFromInt(v.GetTokens())), nil
+	return v.sharesFromTokens(amt)
+}
+
+// sharesFromTokens returns delegator shares * amt / tokens, truncated, using
+// FixedDec so that an amount overflowing the shares returns an error instead of
+// panicking.
+func (v Validator) sharesFromTokens(amt math.Int) (math.LegacyDec, error) {
+	shares, err := sdk.NewFixedDecFromLegacyDec(v.DelegatorShares, math.LegacyPrecision)
+	if err != nil {
+		return math.LegacyZeroDec(), err
+	}
+
+	amount, err := sdk.NewFixedDecFromInt(amt, math.LegacyPrecision)
+	if err != nil {
+		return math.LegacyZeroDec(), err
+	}
+
+	tokens, err := sdk.NewFixedDecFromInt(v.Tokens, math.LegacyPrecision)
+	if err != nil {
+		return math.LegacyZeroDec(), err
+	}
+
+	shares, err = shares.CheckedMul(amount)
+	if err != nil {
+		return math.LegacyZeroDec(), err
+	}
+
+	shares, err = shares.CheckedQuoTruncate(tokens)
+	if err != nil {
+		return math.LegacyZeroDec(), err
+	}
+
+	return shares.ToLegacyDec()
 }
 
 // get the bonded tokens which the validator holds
@@ -421,6 +468,132 @@ func (v Validator) RemoveDelShares(delShares math.LegacyDec) (Validator, math.In
 	return v, issuedTokens
 }
 
+// BondDenomTokens returns the tokens of the validator delegated in the bond
+// denom, that is its tokens without the contribution of its assets.
+func (v Validator) BondDenomTokens() math.Int {
+	tokens := v.Tokens
+	for _, asset := range v.Assets {
+		tokens = tokens.Sub(asset.Tokens)
+	}
+
+	return math.MaxInt(tokens, math.ZeroInt())
+}
+
+// StakedCoins returns the coins backing the tokens of the validator, in the
+// bond denom and in the denoms of its assets.
+func (v Validator) StakedCoins(bondDenom string) sdk.Coins {
+	coins := sdk.NewCoins(sdk.NewCoin(bondDenom, v.BondDenomTokens()))
+	for _, asset := range v.Assets {
+		coins = coins.Add(sdk.NewCoin(asset.Denom, asset.Amount))
+	}
+
+	return coins
+}
+
+// AddAsset adds an amount of an additional bond denom, along with the tokens
+// it contributes, to the assets of the validator. The tokens of the validator
+// are not updated.
+func (v Validator) AddAsset(denom string, amount, tokens math.Int) Validator {
+	assets := make([]ValidatorAsset, 0, len(v.Assets)+1)
+	found := false
+	for _, asset := range v.Assets {
+		if asset.Denom == denom {
+			asset.Amount = asset.Amount.Add(amount)
+			asset.Tokens = asset.Tokens.Add(tokens)
+			found = true
+		}
+		assets = append(assets, asset)
+	}
+
+	if !found {
+		assets = append(assets, ValidatorAsset{Denom: denom, Amount: amount, Tokens: tokens})
+	}
+
+	v.Assets = assets
+	return v
+}
+
+// AssetAmountFromTokens returns the amount of an additional bond denom which
+// backs the given tokens contributed by the denom to the validator.
+func (v Validator) AssetAmountFromTokens(denom string, tokens math.Int) math.Int {
+	for _, asset := range v.Assets {
+		if asset.Denom == denom {
+			if tokens.GTE(asset.Tokens) {
+				return asset.Amount
+			}
+
+			return asset.Amount.Mul(tokens).Quo(asset.Tokens)
+		}
+	}
+
+	return math.ZeroInt()
+}
+
+// RemoveAssetTokens removes tokens contributed by an additional bond denom
+// from the assets of the validator and returns the amount of the denom they
+// correspond to. The tokens of the validator are not updated.
+func (v Validator) RemoveAssetTokens(denom string, tokens math.Int) (Validator, math.Int) {
+	amount := math.ZeroInt()
+	assets := make([]ValidatorAsset, 0, len(v.Assets))
+	for _, asset := range v.Assets {
+		if asset.Denom == denom {
+			// the last tokens of the asset get any trimmings
+			amount = v.AssetAmountFromTokens(denom, tokens)
+			if tokens.GTE(asset.Tokens) {
+				continue
+			}
+
+			asset.Amount = asset.Amount.Sub(amount)
+			asset.Tokens = asset.Tokens.Sub(tokens)
+		}
+		assets = append(assets, asset)
+	}
+
+	v.Assets = assets
+	return v, amount
+}
+
+// SlashAssets removes the share of the tokens to burn contributed by each
+// asset of the validator, and returns the coins to burn for them in the bond
+// denom and the denoms of the assets. The tokens of the validator are not
+// updated.
+func (v Validator) SlashAssets(tokensToBurn math.Int, bondDenom string) (Validator, sdk.Coins) {
+	if !v.Tokens.IsPositive() {
+		return v, sdk.NewCoins()
+	}
+
+	coins := sdk.NewCoins()
+	bondDenomTokens := v.BondDenomTokens()
+	bondDenomBurned := tokensToBurn
+
+	assets := make([]ValidatorAsset, 0, len(v.Assets))
+	for _, asset := range v.Assets {
+		tokens := asset.Tokens.Mul(tokensToBurn).Quo(v.Tokens)
+		amount := asset.Amount.Mul(tokensToBurn).Quo(v.Tokens)
+
+		asset.Tokens = asset.Tokens.Sub(tokens)
+		asset.Amount = asset.Amount.Sub(amount)
+		bondDenomBurned = bondDenomBurned.Sub(tokens)
+		coins = coins.Add(sdk.NewCoin(asset.Denom, amount))
+
+		if asset.Tokens.IsPositive() || asset.Amount.IsPositive() {
+			assets = append(assets, asset)
+		}
+	}
+
+	bondDenomBurned = math.MinInt(math.MaxInt(bondDenomBurned, math.ZeroInt()), bondDenomTokens)
+	coins = coins.Add(sdk.NewCoin(bondDenom, bondDenomBurned))
+
+	v.Assets = assets
+	return v, coins
+}
+
+// EffectiveMinSelfDelegation returns the minimum self delegation enforced on
+// the validator, the greater of its declared minimum and the chain-wide floor.
+func (v Validator) EffectiveMinSelfDelegation(floor math.Int) math.Int {
+	return math.MaxInt(v.MinSelfDelegation, floor)
+}
+
 // MinEqual defines a more minimum set of equality conditions when comparing two
 // validators.
 func (v *Validator) MinEqual(other *Validator) bool {
@@ -3,6 +3,7 @@ package types_test
 import (
 	"math/rand"
 	"sort"
+	"strings"
 	"testing"
 
 	"cosmossdk.io/math"
@@ -57,6 +58,69 @@ func TestUpdateDescription(t *testing.T) {
 	require.Equal(t, d, d3)
 }
 
+func TestUpdateDescriptionProfile(t *testing.T) {
+	d1 := types.Description{
+		Moniker: "validator",
+		Profile: types.NewValidatorProfile("https://validator.cosmos/logo.png", "", "no sandwiching", "eu-west", ""),
+	}
+
+	// a description without a profile leaves the profile unmodified
+	d, err := d1.UpdateDescription(types.Description{Moniker: types.DoNotModifyDesc})
+	require.NoError(t, err)
+	require.Equal(t, d1, d)
+
+	d, err = d1.UpdateDescription(types.Description{
+		Moniker: types.DoNotModifyDesc,
+		Profile: types.NewValidatorProfile(types.DoNotModifyDesc, "disclose@validator.cosmos", types.DoNotModifyDesc, "us-east", ""),
+	})
+	require.NoError(t, err)
+	require.Equal(t, types.NewValidatorProfile("https://validator.cosmos/logo.png", "disclose@validator.cosmos", "no sandwiching", "us-east", ""), d.Profile)
+
+	// clearing all the fields removes the profile
+	d, err = d1.UpdateDescription(types.Description{Moniker: types.DoNotModifyDesc, Profile: &types.ValidatorProfile{}})
+	require.NoError(t, err)
+	require.Nil(t, d.Profile)
+
+	_, err = d1.UpdateDescription(types.Description{
+		Moniker: types.DoNotModifyDesc,
+		Profile: types.NewValidatorProfile(types.DoNotModifyDesc, types.DoNotModifyDesc, types.DoNotModifyDesc, "EU West", ""),
+	})
+	require.Error(t, err)
+}
+
+func TestValidatorProfileValidate(t *testing.T) {
+	testCases := []struct {
+		name    string
+		profile *types.ValidatorProfile
+		expErr  bool
+	}{
+		{"nil profile", nil, false},
+		{"empty profile", &types.ValidatorProfile{}, false},
+		{"valid profile", types.NewValidatorProfile("ipfs://bafybeigdyrzt5sfp7udm7hu76uh7y26nf3efuylqabf3oclgtqy55fbzdi", "policy", "none", "ap-southeast-1", "cosmos-validator-proof=8f14e45f"), false},
+		{"http logo uri", types.NewValidatorProfile("http://validator.cosmos/logo.png", "", "", "", ""), true},
+		{"relative logo uri", types.NewValidatorProfile("logo.png", "", "", "", ""), true},
+		{"logo uri too long", types.NewValidatorProfile("https://validator.cosmos/"+strings.Repeat("a", types.MaxLogoURILength), "", "", "", ""), true},
+		{"security policy too long", types.NewValidatorProfile("", strings.Repeat("a", types.MaxSecurityPolicyLength+1), "", "", ""), true},
+		{"mev policy too long", types.NewValidatorProfile("", "", strings.Repeat("a", types.MaxMEVPolicyLength+1), "", ""), true},
+		{"region with uppercase letters", types.NewValidatorProfile("", "", "", "EU-west", ""), true},
+		{"region with a trailing dash", types.NewValidatorProfile("", "", "", "eu-", ""), true},
+		{"region too long", types.NewValidatorProfile("", "", "", strings.Repeat("a", types.MaxRegionLength+1), ""), true},
+		{"website proof too long", types.NewValidatorProfile("", "", "", "", strings.Repeat("a", types.MaxWebsiteProofLength+1)), true},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.profile.Validate()
+			if tc.expErr {
+				require.Error(t, err)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
 func TestABCIValidatorUpdate(t *testing.T) {
 	validator := newValidator(t, valAddr1, pk1)
 	abciVal := validator.ABCIValidatorUpdate(sdk.DefaultPowerReduction)
@@ -84,6 +148,30 @@ func TestShareTokens(t *testing.T) {
 	assert.True(math.LegacyDecEq(t, math.LegacyNewDec(5), validator.TokensFromShares(math.LegacyNewDec(10))))
 }
 
+func TestSharesFromTokens(t *testing.T) {
+	delShares := math.LegacyNewDec(391432570689183511).Quo(math.LegacyNewDec(40113011844664))
+	validator := mkValidator(2159, delShares)
+
+	// the shares are the same as with the LegacyDec arithmetic
+	shares, err := validator.SharesFromTokens(math.NewInt(71))
+	require.NoError(t, err)
+	require.True(math.LegacyDecEq(t, delShares.MulInt(math.NewInt(71)).QuoInt(math.NewInt(2159)), shares))
+
+	shares, err = validator.SharesFromTokensTruncated(math.NewInt(71))
+	require.NoError(t, err)
+	require.True(math.LegacyDecEq(t, delShares.MulInt(math.NewInt(71)).QuoTruncate(math.LegacyNewDec(2159)), shares))
+
+	// an overflow returns an error instead of panicking
+	amount, ok := math.NewIntFromString("1" + strings.Repeat("0", 75))
+	require.True(t, ok)
+	_, err = validator.SharesFromTokens(amount)
+	require.Error(t, err)
+
+	validator.Tokens = math.ZeroInt()
+	_, err = validator.SharesFromTokens(math.NewInt(71))
+	require.ErrorIs(t, err, types.ErrInsufficientShares)
+}
+
 func TestRemoveTokens(t *testing.T) {
 	validator := mkValidator(100, math.LegacyNewDec(100))
 
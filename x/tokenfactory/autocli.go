@@ -0,0 +1,59 @@
+package tokenfactory
+
+import (
+	"fmt"
+
+	autocliv1 "cosmossdk.io/api/cosmos/autocli/v1"
+	tokenfactoryv1 "cosmossdk.io/api/cosmos/tokenfactory/v1"
+
+	"github.com/cosmos/cosmos-sdk/version"
+	"github.com/cosmos/cosmos-sdk/x/tokenfactory/types"
+)
+
+// AutoCLIOptions implements the autocli.HasAutoCLIConfig interface.
+func (am AppModule) AutoCLIOptions() *autocliv1.ModuleOptions {
+	return &autocliv1.ModuleOptions{
+		Query: &autocliv1.ServiceCommandDescriptor{
+			Service: tokenfactoryv1.Query_ServiceDesc.ServiceName,
+			RpcCommandOptions: []*autocliv1.RpcCommandOptions{
+				{
+					RpcMethod: "Params",
+					Use:       "params",
+					Short:     "Query the parameters of the tokenfactory module, including the denom creation fee.",
+					Example:   fmt.Sprintf(`%s query %s params`, version.AppName, types.ModuleName),
+				},
+				{
+					RpcMethod:      "DenomAuthorityMetadata",
+					Use:            "denom-authority-metadata [denom]",
+					Short:          "Query the admin of a denom created with the token factory.",
+					Example:        fmt.Sprintf(`%s query %s denom-authority-metadata factory/cosmos1.../mytoken`, version.AppName, types.ModuleName),
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "denom"}},
+				},
+				{
+					RpcMethod:      "DenomsFromCreator",
+					Use:            "denoms-from-creator [creator]",
+					Short:          "Query the denoms created by an account with the token factory.",
+					Example:        fmt.Sprintf(`%s query %s denoms-from-creator cosmos1...`, version.AppName, types.ModuleName),
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "creator"}},
+				},
+				{
+					RpcMethod:      "TransferList",
+					Use:            "transfer-list [denom]",
+					Short:          "Query the transfer list of a denom, i.e. its allowlist or blocklist.",
+					Example:        fmt.Sprintf(`%s query %s transfer-list factory/cosmos1.../mytoken`, version.AppName, types.ModuleName),
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "denom"}},
+				},
+				{
+					RpcMethod:      "CanTransfer",
+					Use:            "can-transfer [denom] [sender] [recipient]",
+					Short:          "Query whether the transfer list of a denom allows a transfer from the sender to the recipient.",
+					Example:        fmt.Sprintf(`%s query %s can-transfer factory/cosmos1.../mytoken cosmos1... cosmos1...`, version.AppName, types.ModuleName),
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "denom"}, {ProtoField: "sender"}, {ProtoField: "recipient"}},
+				},
+			},
+		},
+		Tx: &autocliv1.ServiceCommandDescriptor{
+			Service: tokenfactoryv1.Msg_ServiceDesc.ServiceName,
+		},
+	}
+}
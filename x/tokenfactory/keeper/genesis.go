@@ -0,0 +1,109 @@
+package keeper
+
+import (
+	"context"
+	"sort"
+
+	"cosmossdk.io/collections"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/tokenfactory/types"
+)
+
+// InitGenesis initializes the tokenfactory module's state from a given genesis
+// state.
+func (k Keeper) InitGenesis(ctx context.Context, gs *types.GenesisState) error {
+	if err := k.Params.Set(ctx, gs.Params); err != nil {
+		return err
+	}
+
+	for _, d := range gs.FactoryDenoms {
+		if err := k.setDenom(ctx, d.Denom, d.AuthorityMetadata); err != nil {
+			return err
+		}
+	}
+
+	for _, l := range gs.TransferLists {
+		if l.Mode != types.TransferListModeUnspecified {
+			if err := k.TransferListModes.Set(ctx, l.Denom, int32(l.Mode)); err != nil {
+				return err
+			}
+		}
+
+		for _, addr := range l.Addresses {
+			if err := k.TransferLists.Set(ctx, collections.Join(l.Denom, sdk.MustAccAddressFromBech32(addr))); err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+// ExportGenesis returns the tokenfactory module's exported genesis.
+func (k Keeper) ExportGenesis(ctx context.Context) (*types.GenesisState, error) {
+	params, err := k.Params.Get(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	gs := &types.GenesisState{Params: params}
+	err = k.DenomAuthorities.Walk(ctx, nil, func(denom string, metadata types.DenomAuthorityMetadata) bool {
+		gs.FactoryDenoms = append(gs.FactoryDenoms, types.GenesisDenom{Denom: denom, AuthorityMetadata: metadata})
+		return false
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	gs.TransferLists, err = k.exportTransferLists(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return gs, nil
+}
+
+// exportTransferLists returns the transfer lists of the denoms, in denom order,
+// including the ones which have addresses but no mode.
+func (k Keeper) exportTransferLists(ctx context.Context) ([]types.GenesisTransferList, error) {
+	lists := make(map[string]*types.GenesisTransferList)
+	get := func(denom string) *types.GenesisTransferList {
+		l, ok := lists[denom]
+		if !ok {
+			l = &types.GenesisTransferList{Denom: denom}
+			lists[denom] = l
+		}
+		return l
+	}
+
+	err := k.TransferListModes.Walk(ctx, nil, func(denom string, mode int32) bool {
+		get(denom).Mode = types.TransferListMode(mode)
+		return false
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	err = k.TransferLists.Walk(ctx, nil, func(key collections.Pair[string, sdk.AccAddress]) bool {
+		l := get(key.K1())
+		l.Addresses = append(l.Addresses, key.K2().String())
+		return false
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	denoms := make([]string, 0, len(lists))
+	for denom := range lists {
+		denoms = append(denoms, denom)
+	}
+	sort.Strings(denoms)
+
+	var result []types.GenesisTransferList
+	for _, denom := range denoms {
+		result = append(result, *lists[denom])
+	}
+
+	return result, nil
+}
@@ -0,0 +1,146 @@
+package keeper
+
+import (
+	"context"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"cosmossdk.io/collections"
+	errorsmod "cosmossdk.io/errors"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"github.com/cosmos/cosmos-sdk/x/tokenfactory/types"
+)
+
+var _ types.QueryServer = Querier{}
+
+// Querier is used as Keeper will have duplicate methods if used directly, and gRPC names take precedence over keeper.
+type Querier struct {
+	*Keeper
+}
+
+// NewQuerier returns an implementation of the tokenfactory QueryServer
+// interface for the provided Keeper.
+func NewQuerier(keeper *Keeper) Querier {
+	return Querier{Keeper: keeper}
+}
+
+// Params queries the parameters of the module.
+func (k Querier) Params(ctx context.Context, req *types.QueryParamsRequest) (*types.QueryParamsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	params, err := k.Keeper.Params.Get(ctx)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryParamsResponse{Params: params}, nil
+}
+
+// DenomAuthorityMetadata queries the authority of a denom created with the
+// token factory.
+func (k Querier) DenomAuthorityMetadata(ctx context.Context, req *types.QueryDenomAuthorityMetadataRequest) (*types.QueryDenomAuthorityMetadataResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	metadata, err := k.GetDenomAuthorityMetadata(ctx, req.Denom)
+	if err != nil {
+		if errorsmod.IsOf(err, types.ErrDenomNotFound) {
+			return nil, status.Errorf(codes.NotFound, "denom %s not found", req.Denom)
+		}
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryDenomAuthorityMetadataResponse{AuthorityMetadata: metadata}, nil
+}
+
+// DenomsFromCreator queries the denoms created by an account.
+func (k Querier) DenomsFromCreator(ctx context.Context, req *types.QueryDenomsFromCreatorRequest) (*types.QueryDenomsFromCreatorResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	creator, err := sdk.AccAddressFromBech32(req.Creator)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid creator address: %s", err)
+	}
+
+	results, pageRes, err := query.CollectionFilteredPaginate[collections.Pair[sdk.AccAddress, string], collections.NoValue](
+		ctx, k.CreatorDenoms, req.Pagination, nil, query.WithCollectionPaginationPairPrefix[sdk.AccAddress, string](creator),
+	)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	denoms := make([]string, 0, len(results))
+	for _, r := range results {
+		denoms = append(denoms, r.Key.K2())
+	}
+
+	return &types.QueryDenomsFromCreatorResponse{Denoms: denoms, Pagination: pageRes}, nil
+}
+
+// TransferList queries the transfer list of a denom.
+func (k Querier) TransferList(ctx context.Context, req *types.QueryTransferListRequest) (*types.QueryTransferListResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	if err := sdk.ValidateDenom(req.Denom); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	mode, err := k.GetTransferListMode(ctx, req.Denom)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	results, pageRes, err := query.CollectionFilteredPaginate[collections.Pair[string, sdk.AccAddress], collections.NoValue](
+		ctx, k.TransferLists, req.Pagination, nil, query.WithCollectionPaginationPairPrefix[string, sdk.AccAddress](req.Denom),
+	)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	addrs := make([]string, 0, len(results))
+	for _, r := range results {
+		addrs = append(addrs, r.Key.K2().String())
+	}
+
+	return &types.QueryTransferListResponse{Mode: mode, Addresses: addrs, Pagination: pageRes}, nil
+}
+
+// CanTransfer queries whether the transfer list of a denom allows a transfer.
+func (k Querier) CanTransfer(ctx context.Context, req *types.QueryCanTransferRequest) (*types.QueryCanTransferResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	if err := sdk.ValidateDenom(req.Denom); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	sender, err := sdk.AccAddressFromBech32(req.Sender)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid sender address: %s", err)
+	}
+
+	recipient, err := sdk.AccAddressFromBech32(req.Recipient)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid recipient address: %s", err)
+	}
+
+	if err := k.CheckTransferAllowed(ctx, req.Denom, sender, recipient); err != nil {
+		if errorsmod.IsOf(err, types.ErrTransferRestricted) {
+			return &types.QueryCanTransferResponse{Allowed: false, Reason: err.Error()}, nil
+		}
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryCanTransferResponse{Allowed: true}, nil
+}
@@ -0,0 +1,209 @@
+package keeper
+
+import (
+	"context"
+
+	"cosmossdk.io/collections"
+	"cosmossdk.io/core/event"
+	storetypes "cosmossdk.io/core/store"
+	errorsmod "cosmossdk.io/errors"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/tokenfactory/types"
+)
+
+// Keeper of the tokenfactory store
+type Keeper struct {
+	storeService  storetypes.KVStoreService
+	event         event.Service
+	accountKeeper types.AccountKeeper
+	bankKeeper    types.BankKeeper
+	distrKeeper   types.DistributionKeeper
+	hooks         types.TokenFactoryHooks
+
+	// the address capable of executing a MsgUpdateParams message, and of
+	// changing the admin of any denom. Typically, this should be the x/gov
+	// module account.
+	authority string
+
+	Schema           collections.Schema
+	Params           collections.Item[types.Params]
+	DenomAuthorities collections.Map[string, types.DenomAuthorityMetadata]
+	CreatorDenoms    collections.KeySet[collections.Pair[sdk.AccAddress, string]]
+
+	TransferListModes collections.Map[string, int32]
+	TransferLists     collections.KeySet[collections.Pair[string, sdk.AccAddress]]
+}
+
+// NewKeeper returns a new tokenfactory Keeper.
+func NewKeeper(
+	cdc codec.BinaryCodec, storeService storetypes.KVStoreService, ak types.AccountKeeper, bk types.BankKeeper,
+	dk types.DistributionKeeper, authority string, em event.Service,
+) *Keeper {
+	sb := collections.NewSchemaBuilder(storeService)
+	k := &Keeper{
+		storeService:     storeService,
+		event:            em,
+		accountKeeper:    ak,
+		bankKeeper:       bk,
+		distrKeeper:      dk,
+		authority:        authority,
+		Params:           collections.NewItem(sb, types.ParamsKey, "params", codec.CollValue[types.Params](cdc)),
+		DenomAuthorities: collections.NewMap(sb, types.DenomAuthoritiesPrefix, "denom_authorities", collections.StringKey, codec.CollValue[types.DenomAuthorityMetadata](cdc)),
+		CreatorDenoms:    collections.NewKeySet(sb, types.CreatorDenomsPrefix, "creator_denoms", collections.PairKeyCodec(sdk.AccAddressKey, collections.StringKey)),
+
+		TransferListModes: collections.NewMap(sb, types.TransferListModesPrefix, "transfer_list_modes", collections.StringKey, collections.Int32Value),
+		TransferLists:     collections.NewKeySet(sb, types.TransferListsPrefix, "transfer_lists", collections.PairKeyCodec(collections.StringKey, sdk.AccAddressKey)),
+	}
+
+	schema, err := sb.Build()
+	if err != nil {
+		panic(err)
+	}
+	k.Schema = schema
+
+	return k
+}
+
+// GetAuthority returns the x/tokenfactory module's authority.
+func (k Keeper) GetAuthority() string {
+	return k.authority
+}
+
+// SetHooks sets the token factory hooks.
+func (k *Keeper) SetHooks(h types.TokenFactoryHooks) *Keeper {
+	if k.hooks != nil {
+		panic("cannot set tokenfactory hooks twice")
+	}
+
+	k.hooks = h
+	return k
+}
+
+// GetDenomAuthorityMetadata returns the authority of a denom created with the
+// token factory.
+func (k Keeper) GetDenomAuthorityMetadata(ctx context.Context, denom string) (types.DenomAuthorityMetadata, error) {
+	metadata, err := k.DenomAuthorities.Get(ctx, denom)
+	if err != nil {
+		if errorsmod.IsOf(err, collections.ErrNotFound) {
+			return types.DenomAuthorityMetadata{}, errorsmod.Wrapf(types.ErrDenomNotFound, "%s", denom)
+		}
+		return types.DenomAuthorityMetadata{}, err
+	}
+
+	return metadata, nil
+}
+
+// GetDenomsFromCreator returns the denoms created by an account.
+func (k Keeper) GetDenomsFromCreator(ctx context.Context, creator sdk.AccAddress) ([]string, error) {
+	var denoms []string
+	err := k.CreatorDenoms.Walk(ctx, collections.NewPrefixedPairRange[sdk.AccAddress, string](creator), func(key collections.Pair[sdk.AccAddress, string]) bool {
+		denoms = append(denoms, key.K2())
+		return false
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return denoms, nil
+}
+
+// setDenom stores the authority of a denom, indexed by its creator.
+func (k Keeper) setDenom(ctx context.Context, denom string, metadata types.DenomAuthorityMetadata) error {
+	creator, _, err := types.DeconstructDenom(denom)
+	if err != nil {
+		return err
+	}
+
+	creatorAddr, err := sdk.AccAddressFromBech32(creator)
+	if err != nil {
+		return err
+	}
+
+	if err := k.DenomAuthorities.Set(ctx, denom, metadata); err != nil {
+		return err
+	}
+
+	return k.CreatorDenoms.Set(ctx, collections.Join(creatorAddr, denom))
+}
+
+// CreateDenom creates the denom factory/{creator}/{subdenom}, of which the
+// creator becomes the admin, after charging the denom creation fee. The bank
+// metadata of the denom is initialized with its base unit.
+func (k Keeper) CreateDenom(ctx context.Context, creator sdk.AccAddress, subdenom string) (string, error) {
+	denom, err := types.GetTokenDenom(creator.String(), subdenom)
+	if err != nil {
+		return "", err
+	}
+
+	exists, err := k.DenomAuthorities.Has(ctx, denom)
+	if err != nil {
+		return "", err
+	}
+	if _, found := k.bankKeeper.GetDenomMetaData(ctx, denom); exists || found || k.bankKeeper.HasSupply(ctx, denom) {
+		return "", errorsmod.Wrapf(types.ErrDenomExists, "%s", denom)
+	}
+
+	params, err := k.Params.Get(ctx)
+	if err != nil {
+		return "", err
+	}
+	if !params.DenomCreationFee.IsZero() {
+		if err := k.distrKeeper.FundCommunityPool(ctx, params.DenomCreationFee, creator); err != nil {
+			return "", err
+		}
+	}
+
+	if err := k.setDenom(ctx, denom, types.DenomAuthorityMetadata{Admin: creator.String()}); err != nil {
+		return "", err
+	}
+
+	k.bankKeeper.SetDenomMetaData(ctx, types.NewDenomMetadata(denom))
+
+	if k.hooks != nil {
+		if err := k.hooks.AfterDenomCreated(ctx, denom, creator.String()); err != nil {
+			return "", err
+		}
+	}
+
+	if err := k.event.EventManager(ctx).EmitKV(
+		ctx,
+		types.EventTypeCreateDenom,
+		event.Attribute{Key: types.AttributeKeyCreator, Value: creator.String()},
+		event.Attribute{Key: types.AttributeKeyDenom, Value: denom},
+	); err != nil {
+		return "", err
+	}
+
+	return denom, nil
+}
+
+// ChangeAdmin transfers the admin role of a denom to another account, or
+// renounces it if the new admin is empty.
+func (k Keeper) ChangeAdmin(ctx context.Context, denom, newAdmin string) error {
+	metadata, err := k.GetDenomAuthorityMetadata(ctx, denom)
+	if err != nil {
+		return err
+	}
+
+	oldAdmin := metadata.Admin
+	metadata.Admin = newAdmin
+	if err := k.DenomAuthorities.Set(ctx, denom, metadata); err != nil {
+		return err
+	}
+
+	if k.hooks != nil {
+		if err := k.hooks.AfterAdminChanged(ctx, denom, oldAdmin, newAdmin); err != nil {
+			return err
+		}
+	}
+
+	return k.event.EventManager(ctx).EmitKV(
+		ctx,
+		types.EventTypeChangeAdmin,
+		event.Attribute{Key: types.AttributeKeyDenom, Value: denom},
+		event.Attribute{Key: types.AttributeKeyAdmin, Value: oldAdmin},
+		event.Attribute{Key: types.AttributeKeyNewAdmin, Value: newAdmin},
+	)
+}
@@ -0,0 +1,342 @@
+package keeper_test
+
+import (
+	"context"
+	"testing"
+
+	cmtproto "github.com/cometbft/cometbft/proto/tendermint/types"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/suite"
+
+	storetypes "cosmossdk.io/store/types"
+
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	"github.com/cosmos/cosmos-sdk/runtime"
+	"github.com/cosmos/cosmos-sdk/testutil"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	moduletestutil "github.com/cosmos/cosmos-sdk/types/module/testutil"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+	"github.com/cosmos/cosmos-sdk/x/tokenfactory/keeper"
+	tokenfactorytestutil "github.com/cosmos/cosmos-sdk/x/tokenfactory/testutil"
+	"github.com/cosmos/cosmos-sdk/x/tokenfactory/types"
+)
+
+type KeeperTestSuite struct {
+	suite.Suite
+
+	ctx           sdk.Context
+	keeper        *keeper.Keeper
+	accountKeeper *tokenfactorytestutil.MockAccountKeeper
+	bankKeeper    *tokenfactorytestutil.MockBankKeeper
+	distrKeeper   *tokenfactorytestutil.MockDistributionKeeper
+	hooks         *tokenfactorytestutil.MockTokenFactoryHooks
+	msgServer     types.MsgServer
+	queryClient   types.QueryClient
+	authority     string
+	creator       sdk.AccAddress
+	holder        sdk.AccAddress
+}
+
+func (s *KeeperTestSuite) SetupTest() {
+	key := storetypes.NewKVStoreKey(types.StoreKey)
+	testCtx := testutil.DefaultContextWithDB(s.T(), key, storetypes.NewTransientStoreKey("transient_test"))
+	encCfg := moduletestutil.MakeTestEncodingConfig()
+
+	ctrl := gomock.NewController(s.T())
+	s.accountKeeper = tokenfactorytestutil.NewMockAccountKeeper(ctrl)
+	s.bankKeeper = tokenfactorytestutil.NewMockBankKeeper(ctrl)
+	s.distrKeeper = tokenfactorytestutil.NewMockDistributionKeeper(ctrl)
+	s.hooks = tokenfactorytestutil.NewMockTokenFactoryHooks(ctrl)
+
+	s.authority = authtypes.NewModuleAddress(govtypes.ModuleName).String()
+	s.creator = sdk.AccAddress("creator")
+	s.holder = sdk.AccAddress("holder")
+	s.ctx = testCtx.Ctx.WithBlockHeader(cmtproto.Header{Height: 10})
+	s.keeper = keeper.NewKeeper(encCfg.Codec, runtime.NewKVStoreService(key), s.accountKeeper, s.bankKeeper, s.distrKeeper, s.authority, runtime.EventService{})
+	s.keeper.SetHooks(s.hooks)
+	s.msgServer = keeper.NewMsgServerImpl(s.keeper)
+	s.Require().NoError(s.keeper.Params.Set(s.ctx, types.DefaultParams()))
+
+	types.RegisterInterfaces(encCfg.InterfaceRegistry)
+	queryHelper := baseapp.NewQueryServerTestHelper(s.ctx, encCfg.InterfaceRegistry)
+	types.RegisterQueryServer(queryHelper, keeper.NewQuerier(s.keeper))
+	s.queryClient = types.NewQueryClient(queryHelper)
+}
+
+func TestKeeperTestSuite(t *testing.T) {
+	suite.Run(t, new(KeeperTestSuite))
+}
+
+// createDenom creates the denom of the given subdenom by the creator.
+func (s *KeeperTestSuite) createDenom(subdenom string) string {
+	denom := "factory/" + s.creator.String() + "/" + subdenom
+	s.bankKeeper.EXPECT().GetDenomMetaData(s.ctx, denom).Return(banktypes.Metadata{}, false)
+	s.bankKeeper.EXPECT().HasSupply(s.ctx, denom).Return(false)
+	s.bankKeeper.EXPECT().SetDenomMetaData(s.ctx, types.NewDenomMetadata(denom))
+	s.hooks.EXPECT().AfterDenomCreated(s.ctx, denom, s.creator.String()).Return(nil)
+
+	res, err := s.msgServer.CreateDenom(s.ctx, &types.MsgCreateDenom{Sender: s.creator.String(), Subdenom: subdenom})
+	s.Require().NoError(err)
+	s.Require().Equal(denom, res.NewTokenDenom)
+
+	return denom
+}
+
+func (s *KeeperTestSuite) TestCreateDenom() {
+	require := s.Require()
+
+	_, err := s.msgServer.CreateDenom(s.ctx, &types.MsgCreateDenom{Sender: s.creator.String(), Subdenom: "a-subdenom-which-is-longer-than-the-maximum-length"})
+	require.ErrorIs(err, types.ErrInvalidDenom)
+
+	// the denom creation fee is paid to the community pool
+	fee := sdk.NewCoins(sdk.NewInt64Coin("stake", 100))
+	require.NoError(s.keeper.Params.Set(s.ctx, types.Params{DenomCreationFee: fee}))
+	s.distrKeeper.EXPECT().FundCommunityPool(s.ctx, fee, s.creator).Return(nil)
+	denom := s.createDenom("mytoken")
+
+	metadata, err := s.queryClient.DenomAuthorityMetadata(s.ctx, &types.QueryDenomAuthorityMetadataRequest{Denom: denom})
+	require.NoError(err)
+	require.Equal(s.creator.String(), metadata.AuthorityMetadata.Admin)
+
+	// a denom cannot be created twice
+	s.bankKeeper.EXPECT().GetDenomMetaData(s.ctx, denom).Return(types.NewDenomMetadata(denom), true)
+	s.bankKeeper.EXPECT().HasSupply(s.ctx, denom).Return(false).AnyTimes()
+	_, err = s.msgServer.CreateDenom(s.ctx, &types.MsgCreateDenom{Sender: s.creator.String(), Subdenom: "mytoken"})
+	require.ErrorIs(err, types.ErrDenomExists)
+
+	require.NoError(s.keeper.Params.Set(s.ctx, types.DefaultParams()))
+	other := s.createDenom("other")
+
+	denoms, err := s.queryClient.DenomsFromCreator(s.ctx, &types.QueryDenomsFromCreatorRequest{Creator: s.creator.String()})
+	require.NoError(err)
+	require.ElementsMatch([]string{denom, other}, denoms.Denoms)
+}
+
+func (s *KeeperTestSuite) TestMintBurnForceTransfer() {
+	require := s.Require()
+	denom := s.createDenom("mytoken")
+	coin := sdk.NewInt64Coin(denom, 100)
+	coins := sdk.NewCoins(coin)
+
+	// only the admin can mint, burn and force transfer
+	_, err := s.msgServer.Mint(s.ctx, &types.MsgMint{Sender: s.holder.String(), Amount: coin})
+	require.ErrorIs(err, types.ErrUnauthorized)
+	_, err = s.msgServer.Burn(s.ctx, &types.MsgBurn{Sender: s.holder.String(), Amount: coin})
+	require.ErrorIs(err, types.ErrUnauthorized)
+	_, err = s.msgServer.ForceTransfer(s.ctx, &types.MsgForceTransfer{Sender: s.holder.String(), Amount: coin, TransferFromAddress: s.holder.String(), TransferToAddress: s.creator.String()})
+	require.ErrorIs(err, types.ErrUnauthorized)
+	_, err = s.msgServer.Mint(s.ctx, &types.MsgMint{Sender: s.creator.String(), Amount: sdk.NewInt64Coin("stake", 100)})
+	require.ErrorIs(err, types.ErrDenomNotFound)
+
+	s.bankKeeper.EXPECT().MintCoins(gomock.Any(), types.ModuleName, coins).Return(nil)
+	s.bankKeeper.EXPECT().SendCoinsFromModuleToAccount(gomock.Any(), types.ModuleName, s.holder, coins).Return(nil)
+	_, err = s.msgServer.Mint(s.ctx, &types.MsgMint{Sender: s.creator.String(), Amount: coin, MintToAddress: s.holder.String()})
+	require.NoError(err)
+
+	s.accountKeeper.EXPECT().GetAccount(s.ctx, s.holder).Return(authtypes.NewBaseAccountWithAddress(s.holder)).Times(2)
+	s.bankKeeper.EXPECT().SendCoinsFromAccountToModule(gomock.Any(), s.holder, types.ModuleName, coins).Return(nil)
+	s.bankKeeper.EXPECT().BurnCoins(gomock.Any(), types.ModuleName, coins).Return(nil)
+	_, err = s.msgServer.Burn(s.ctx, &types.MsgBurn{Sender: s.creator.String(), Amount: coin, BurnFromAddress: s.holder.String()})
+	require.NoError(err)
+
+	s.bankKeeper.EXPECT().BlockedAddr(s.creator).Return(false)
+	s.bankKeeper.EXPECT().SendCoins(gomock.Any(), s.holder, s.creator, coins).Return(nil)
+	_, err = s.msgServer.ForceTransfer(s.ctx, &types.MsgForceTransfer{Sender: s.creator.String(), Amount: coin, TransferFromAddress: s.holder.String(), TransferToAddress: s.creator.String()})
+	require.NoError(err)
+
+	// the coins cannot be burnt nor force transferred from a module account
+	moduleAcc := authtypes.NewEmptyModuleAccount("pool")
+	s.accountKeeper.EXPECT().GetAccount(s.ctx, moduleAcc.GetAddress()).Return(moduleAcc).Times(2)
+	_, err = s.msgServer.Burn(s.ctx, &types.MsgBurn{Sender: s.creator.String(), Amount: coin, BurnFromAddress: moduleAcc.Address})
+	require.ErrorIs(err, types.ErrModuleAccount)
+	_, err = s.msgServer.ForceTransfer(s.ctx, &types.MsgForceTransfer{Sender: s.creator.String(), Amount: coin, TransferFromAddress: moduleAcc.Address, TransferToAddress: s.creator.String()})
+	require.ErrorIs(err, types.ErrModuleAccount)
+}
+
+func (s *KeeperTestSuite) TestChangeAdmin() {
+	require := s.Require()
+	denom := s.createDenom("mytoken")
+
+	_, err := s.msgServer.ChangeAdmin(s.ctx, &types.MsgChangeAdmin{Sender: s.holder.String(), Denom: denom, NewAdmin: s.holder.String()})
+	require.ErrorIs(err, types.ErrUnauthorized)
+	_, err = s.msgServer.ChangeAdmin(s.ctx, &types.MsgChangeAdmin{Sender: s.creator.String(), Denom: denom, NewAdmin: "invalid"})
+	require.ErrorIs(err, sdkerrors.ErrInvalidAddress)
+
+	// the admin transfers the admin role
+	s.hooks.EXPECT().AfterAdminChanged(s.ctx, denom, s.creator.String(), s.holder.String()).Return(nil)
+	_, err = s.msgServer.ChangeAdmin(s.ctx, &types.MsgChangeAdmin{Sender: s.creator.String(), Denom: denom, NewAdmin: s.holder.String()})
+	require.NoError(err)
+
+	_, err = s.msgServer.Mint(s.ctx, &types.MsgMint{Sender: s.creator.String(), Amount: sdk.NewInt64Coin(denom, 100)})
+	require.ErrorIs(err, types.ErrUnauthorized)
+
+	// the new admin renounces the admin role
+	s.hooks.EXPECT().AfterAdminChanged(s.ctx, denom, s.holder.String(), "").Return(nil)
+	_, err = s.msgServer.ChangeAdmin(s.ctx, &types.MsgChangeAdmin{Sender: s.holder.String(), Denom: denom})
+	require.NoError(err)
+
+	_, err = s.msgServer.ChangeAdmin(s.ctx, &types.MsgChangeAdmin{Sender: s.holder.String(), Denom: denom, NewAdmin: s.holder.String()})
+	require.ErrorIs(err, types.ErrUnauthorized)
+
+	// governance assigns the admin role again
+	s.hooks.EXPECT().AfterAdminChanged(s.ctx, denom, "", s.creator.String()).Return(nil)
+	_, err = s.msgServer.ChangeAdmin(s.ctx, &types.MsgChangeAdmin{Sender: s.authority, Denom: denom, NewAdmin: s.creator.String()})
+	require.NoError(err)
+
+	metadata, err := s.keeper.GetDenomAuthorityMetadata(s.ctx, denom)
+	require.NoError(err)
+	require.Equal(s.creator.String(), metadata.Admin)
+}
+
+func (s *KeeperTestSuite) TestSetDenomMetadata() {
+	require := s.Require()
+	denom := s.createDenom("mytoken")
+
+	metadata := banktypes.Metadata{
+		Description: "My token",
+		Base:        denom,
+		Display:     "mytoken",
+		Name:        "My Token",
+		Symbol:      "MTK",
+		DenomUnits: []*banktypes.DenomUnit{
+			{Denom: denom, Exponent: 0},
+			{Denom: "mytoken", Exponent: 6},
+		},
+	}
+
+	_, err := s.msgServer.SetDenomMetadata(s.ctx, &types.MsgSetDenomMetadata{Sender: s.holder.String(), Metadata: metadata})
+	require.ErrorIs(err, types.ErrUnauthorized)
+
+	invalid := metadata
+	invalid.Display = "unknown"
+	_, err = s.msgServer.SetDenomMetadata(s.ctx, &types.MsgSetDenomMetadata{Sender: s.creator.String(), Metadata: invalid})
+	require.ErrorIs(err, types.ErrInvalidMetadata)
+
+	s.bankKeeper.EXPECT().SetDenomMetaData(s.ctx, metadata)
+	_, err = s.msgServer.SetDenomMetadata(s.ctx, &types.MsgSetDenomMetadata{Sender: s.creator.String(), Metadata: metadata})
+	require.NoError(err)
+}
+
+func (s *KeeperTestSuite) TestUpdateParams() {
+	require := s.Require()
+	params := types.Params{DenomCreationFee: sdk.NewCoins(sdk.NewInt64Coin("stake", 100))}
+
+	_, err := s.msgServer.UpdateParams(s.ctx, &types.MsgUpdateParams{Authority: s.creator.String(), Params: params})
+	require.ErrorIs(err, govtypes.ErrInvalidSigner)
+
+	_, err = s.msgServer.UpdateParams(s.ctx, &types.MsgUpdateParams{Authority: s.authority, Params: params})
+	require.NoError(err)
+
+	res, err := s.queryClient.Params(s.ctx, &types.QueryParamsRequest{})
+	require.NoError(err)
+	require.Equal(params, res.Params)
+}
+
+func (s *KeeperTestSuite) TestGenesis() {
+	require := s.Require()
+	denom := s.createDenom("mytoken")
+
+	gs, err := s.keeper.ExportGenesis(s.ctx)
+	require.NoError(err)
+	require.NoError(gs.Validate())
+	require.Equal([]types.GenesisDenom{{Denom: denom, AuthorityMetadata: types.DenomAuthorityMetadata{Admin: s.creator.String()}}}, gs.FactoryDenoms)
+
+	s.SetupTest()
+	require.NoError(s.keeper.InitGenesis(s.ctx, gs))
+
+	denoms, err := s.keeper.GetDenomsFromCreator(s.ctx, s.creator)
+	require.NoError(err)
+	require.Equal([]string{denom}, denoms)
+
+	exported, err := s.keeper.ExportGenesis(s.ctx)
+	require.NoError(err)
+	require.Equal(gs, exported)
+}
+
+func (s *KeeperTestSuite) TestTransferLists() {
+	require := s.Require()
+	denom := s.createDenom("mytoken")
+	outsider := sdk.AccAddress("outsider")
+	moduleAddr := authtypes.NewModuleAddress(types.ModuleName)
+
+	checkTransfer := func(from, to sdk.AccAddress, allowed bool) {
+		_, err := s.keeper.SendRestrictionFn(s.ctx, from, to, sdk.NewCoins(sdk.NewInt64Coin(denom, 1), sdk.NewInt64Coin("stake", 1)))
+		res, qErr := s.queryClient.CanTransfer(s.ctx, &types.QueryCanTransferRequest{Denom: denom, Sender: from.String(), Recipient: to.String()})
+		require.NoError(qErr)
+		require.Equal(allowed, res.Allowed)
+		if allowed {
+			require.NoError(err)
+			require.Empty(res.Reason)
+		} else {
+			require.ErrorIs(err, types.ErrTransferRestricted)
+			require.Equal(err.Error(), res.Reason)
+		}
+	}
+
+	// only the admin can configure the transfer list of its denom, and the
+	// authority the one of any denom
+	_, err := s.msgServer.SetTransferListMode(s.ctx, &types.MsgSetTransferListMode{Sender: s.holder.String(), Denom: denom, Mode: types.TransferListModeBlocklist})
+	require.ErrorIs(err, types.ErrUnauthorized)
+	_, err = s.msgServer.SetTransferListMode(s.ctx, &types.MsgSetTransferListMode{Sender: s.creator.String(), Denom: "stake", Mode: types.TransferListModeBlocklist})
+	require.ErrorIs(err, types.ErrDenomNotFound)
+	_, err = s.msgServer.SetTransferListMode(s.ctx, &types.MsgSetTransferListMode{Sender: s.creator.String(), Denom: denom, Mode: 5})
+	require.ErrorIs(err, types.ErrInvalidTransferList)
+	_, err = s.msgServer.UpdateTransferList(s.ctx, &types.MsgUpdateTransferList{Sender: s.creator.String(), Denom: denom})
+	require.ErrorIs(err, types.ErrInvalidTransferList)
+	_, err = s.msgServer.SetTransferListMode(s.ctx, &types.MsgSetTransferListMode{Sender: s.authority, Denom: "stake", Mode: types.TransferListModeUnspecified})
+	require.NoError(err)
+
+	checkTransfer(s.holder, s.creator, true)
+
+	// the blocklisted addresses cannot send nor receive the coins
+	_, err = s.msgServer.SetTransferListMode(s.ctx, &types.MsgSetTransferListMode{Sender: s.creator.String(), Denom: denom, Mode: types.TransferListModeBlocklist})
+	require.NoError(err)
+	_, err = s.msgServer.UpdateTransferList(s.ctx, &types.MsgUpdateTransferList{Sender: s.creator.String(), Denom: denom, AddAddresses: []string{s.holder.String()}})
+	require.NoError(err)
+
+	checkTransfer(s.holder, s.creator, false)
+	checkTransfer(s.creator, s.holder, false)
+	checkTransfer(s.creator, outsider, true)
+
+	list, err := s.queryClient.TransferList(s.ctx, &types.QueryTransferListRequest{Denom: denom})
+	require.NoError(err)
+	require.Equal(types.TransferListModeBlocklist, list.Mode)
+	require.Equal([]string{s.holder.String()}, list.Addresses)
+
+	// the admin can still force transfer the coins of a blocklisted address
+	coin := sdk.NewInt64Coin(denom, 100)
+	s.accountKeeper.EXPECT().GetAccount(s.ctx, s.holder).Return(authtypes.NewBaseAccountWithAddress(s.holder))
+	s.bankKeeper.EXPECT().BlockedAddr(s.creator).Return(false)
+	s.bankKeeper.EXPECT().SendCoins(gomock.Any(), s.holder, s.creator, sdk.NewCoins(coin)).DoAndReturn(
+		func(ctx context.Context, from, to sdk.AccAddress, amt sdk.Coins) error {
+			_, err := s.keeper.SendRestrictionFn(ctx, from, to, amt)
+			return err
+		})
+	_, err = s.msgServer.ForceTransfer(s.ctx, &types.MsgForceTransfer{Sender: s.creator.String(), Amount: coin, TransferFromAddress: s.holder.String(), TransferToAddress: s.creator.String()})
+	require.NoError(err)
+
+	// the coins can only be transferred between allowlisted addresses, or
+	// minted and burnt by the module account
+	_, err = s.msgServer.SetTransferListMode(s.ctx, &types.MsgSetTransferListMode{Sender: s.authority, Denom: denom, Mode: types.TransferListModeAllowlist})
+	require.NoError(err)
+
+	checkTransfer(s.holder, s.creator, false)
+	checkTransfer(moduleAddr, s.holder, true)
+	checkTransfer(moduleAddr, outsider, false)
+
+	_, err = s.msgServer.UpdateTransferList(s.ctx, &types.MsgUpdateTransferList{Sender: s.creator.String(), Denom: denom, AddAddresses: []string{s.creator.String()}})
+	require.NoError(err)
+	checkTransfer(s.holder, s.creator, true)
+
+	gs, err := s.keeper.ExportGenesis(s.ctx)
+	require.NoError(err)
+	require.NoError(gs.Validate())
+	require.Equal([]types.GenesisTransferList{{Denom: denom, Mode: types.TransferListModeAllowlist, Addresses: []string{s.creator.String(), s.holder.String()}}}, gs.TransferLists)
+
+	_, err = s.msgServer.UpdateTransferList(s.ctx, &types.MsgUpdateTransferList{Sender: s.creator.String(), Denom: denom, RemoveAddresses: []string{s.holder.String()}})
+	require.NoError(err)
+	checkTransfer(s.holder, s.creator, false)
+}
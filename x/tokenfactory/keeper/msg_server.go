@@ -0,0 +1,348 @@
+package keeper
+
+import (
+	"context"
+
+	"cosmossdk.io/core/event"
+	errorsmod "cosmossdk.io/errors"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+	"github.com/cosmos/cosmos-sdk/x/tokenfactory/types"
+)
+
+type msgServer struct {
+	*Keeper
+}
+
+var _ types.MsgServer = msgServer{}
+
+// NewMsgServerImpl returns an implementation of the tokenfactory MsgServer
+// interface for the provided Keeper.
+func NewMsgServerImpl(keeper *Keeper) types.MsgServer {
+	return &msgServer{Keeper: keeper}
+}
+
+// checkAdmin checks that the sender is the admin of the denom.
+func (k msgServer) checkAdmin(ctx context.Context, sender, denom string) error {
+	metadata, err := k.GetDenomAuthorityMetadata(ctx, denom)
+	if err != nil {
+		return err
+	}
+
+	if metadata.Admin == "" || metadata.Admin != sender {
+		return errorsmod.Wrapf(types.ErrUnauthorized, "%s is not the admin of %s", sender, denom)
+	}
+
+	return nil
+}
+
+// addressOrDefault returns the account address of addr, or the default address
+// if it is empty.
+func addressOrDefault(addr string, defaultAddr sdk.AccAddress) (sdk.AccAddress, error) {
+	if addr == "" {
+		return defaultAddr, nil
+	}
+
+	accAddr, err := sdk.AccAddressFromBech32(addr)
+	if err != nil {
+		return nil, errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "invalid address %s: %s", addr, err)
+	}
+
+	return accAddr, nil
+}
+
+// checkNotModuleAccount checks that the coins are not burnt or force
+// transferred from a module account.
+func (k msgServer) checkNotModuleAccount(ctx context.Context, addr sdk.AccAddress) error {
+	if _, ok := k.accountKeeper.GetAccount(ctx, addr).(sdk.ModuleAccountI); ok {
+		return errorsmod.Wrapf(types.ErrModuleAccount, "%s", addr)
+	}
+
+	return nil
+}
+
+// CreateDenom implements the Msg/CreateDenom method.
+func (k msgServer) CreateDenom(ctx context.Context, msg *types.MsgCreateDenom) (*types.MsgCreateDenomResponse, error) {
+	sender, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return nil, errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "invalid sender address: %s", err)
+	}
+
+	denom, err := k.Keeper.CreateDenom(ctx, sender, msg.Subdenom)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.MsgCreateDenomResponse{NewTokenDenom: denom}, nil
+}
+
+// Mint implements the Msg/Mint method.
+func (k msgServer) Mint(ctx context.Context, msg *types.MsgMint) (*types.MsgMintResponse, error) {
+	sender, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return nil, errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "invalid sender address: %s", err)
+	}
+
+	if !msg.Amount.IsValid() || msg.Amount.IsZero() {
+		return nil, errorsmod.Wrap(sdkerrors.ErrInvalidCoins, msg.Amount.String())
+	}
+
+	if err := k.checkAdmin(ctx, msg.Sender, msg.Amount.Denom); err != nil {
+		return nil, err
+	}
+
+	mintTo, err := addressOrDefault(msg.MintToAddress, sender)
+	if err != nil {
+		return nil, err
+	}
+
+	// the transfers of the admin are not restricted by the transfer lists
+	ctx = withBypassTransferLists(ctx)
+
+	coins := sdk.NewCoins(msg.Amount)
+	if err := k.bankKeeper.MintCoins(ctx, types.ModuleName, coins); err != nil {
+		return nil, err
+	}
+
+	if err := k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, mintTo, coins); err != nil {
+		return nil, err
+	}
+
+	if err := k.event.EventManager(ctx).EmitKV(
+		ctx,
+		types.EventTypeMint,
+		event.Attribute{Key: types.AttributeKeyMintToAddress, Value: mintTo.String()},
+		event.Attribute{Key: sdk.AttributeKeyAmount, Value: msg.Amount.String()},
+	); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgMintResponse{}, nil
+}
+
+// Burn implements the Msg/Burn method.
+func (k msgServer) Burn(ctx context.Context, msg *types.MsgBurn) (*types.MsgBurnResponse, error) {
+	sender, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return nil, errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "invalid sender address: %s", err)
+	}
+
+	if !msg.Amount.IsValid() || msg.Amount.IsZero() {
+		return nil, errorsmod.Wrap(sdkerrors.ErrInvalidCoins, msg.Amount.String())
+	}
+
+	if err := k.checkAdmin(ctx, msg.Sender, msg.Amount.Denom); err != nil {
+		return nil, err
+	}
+
+	burnFrom, err := addressOrDefault(msg.BurnFromAddress, sender)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := k.checkNotModuleAccount(ctx, burnFrom); err != nil {
+		return nil, err
+	}
+
+	ctx = withBypassTransferLists(ctx)
+
+	coins := sdk.NewCoins(msg.Amount)
+	if err := k.bankKeeper.SendCoinsFromAccountToModule(ctx, burnFrom, types.ModuleName, coins); err != nil {
+		return nil, err
+	}
+
+	if err := k.bankKeeper.BurnCoins(ctx, types.ModuleName, coins); err != nil {
+		return nil, err
+	}
+
+	if err := k.event.EventManager(ctx).EmitKV(
+		ctx,
+		types.EventTypeBurn,
+		event.Attribute{Key: types.AttributeKeyBurnFromAddress, Value: burnFrom.String()},
+		event.Attribute{Key: sdk.AttributeKeyAmount, Value: msg.Amount.String()},
+	); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgBurnResponse{}, nil
+}
+
+// ForceTransfer implements the Msg/ForceTransfer method.
+func (k msgServer) ForceTransfer(ctx context.Context, msg *types.MsgForceTransfer) (*types.MsgForceTransferResponse, error) {
+	if !msg.Amount.IsValid() || msg.Amount.IsZero() {
+		return nil, errorsmod.Wrap(sdkerrors.ErrInvalidCoins, msg.Amount.String())
+	}
+
+	if err := k.checkAdmin(ctx, msg.Sender, msg.Amount.Denom); err != nil {
+		return nil, err
+	}
+
+	from, err := sdk.AccAddressFromBech32(msg.TransferFromAddress)
+	if err != nil {
+		return nil, errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "invalid transfer from address: %s", err)
+	}
+
+	to, err := sdk.AccAddressFromBech32(msg.TransferToAddress)
+	if err != nil {
+		return nil, errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "invalid transfer to address: %s", err)
+	}
+
+	if err := k.checkNotModuleAccount(ctx, from); err != nil {
+		return nil, err
+	}
+
+	if k.bankKeeper.BlockedAddr(to) {
+		return nil, errorsmod.Wrapf(sdkerrors.ErrUnauthorized, "%s is not allowed to receive funds", to)
+	}
+
+	ctx = withBypassTransferLists(ctx)
+	if err := k.bankKeeper.SendCoins(ctx, from, to, sdk.NewCoins(msg.Amount)); err != nil {
+		return nil, err
+	}
+
+	if err := k.event.EventManager(ctx).EmitKV(
+		ctx,
+		types.EventTypeForceTransfer,
+		event.Attribute{Key: types.AttributeKeyTransferFromAddress, Value: from.String()},
+		event.Attribute{Key: types.AttributeKeyTransferToAddress, Value: to.String()},
+		event.Attribute{Key: sdk.AttributeKeyAmount, Value: msg.Amount.String()},
+	); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgForceTransferResponse{}, nil
+}
+
+// ChangeAdmin implements the Msg/ChangeAdmin method. The authority can change
+// the admin of any denom, including a denom whose admin role was renounced.
+func (k msgServer) ChangeAdmin(ctx context.Context, msg *types.MsgChangeAdmin) (*types.MsgChangeAdminResponse, error) {
+	if msg.Sender != k.authority {
+		if err := k.checkAdmin(ctx, msg.Sender, msg.Denom); err != nil {
+			return nil, err
+		}
+	}
+
+	if msg.NewAdmin != "" {
+		if _, err := sdk.AccAddressFromBech32(msg.NewAdmin); err != nil {
+			return nil, errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "invalid new admin address: %s", err)
+		}
+	}
+
+	if err := k.Keeper.ChangeAdmin(ctx, msg.Denom, msg.NewAdmin); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgChangeAdminResponse{}, nil
+}
+
+// SetDenomMetadata implements the Msg/SetDenomMetadata method.
+func (k msgServer) SetDenomMetadata(ctx context.Context, msg *types.MsgSetDenomMetadata) (*types.MsgSetDenomMetadataResponse, error) {
+	if err := k.checkAdmin(ctx, msg.Sender, msg.Metadata.Base); err != nil {
+		return nil, err
+	}
+
+	if err := msg.Metadata.Validate(); err != nil {
+		return nil, errorsmod.Wrap(types.ErrInvalidMetadata, err.Error())
+	}
+
+	k.bankKeeper.SetDenomMetaData(ctx, msg.Metadata)
+
+	if err := k.event.EventManager(ctx).EmitKV(
+		ctx,
+		types.EventTypeSetDenomMetadata,
+		event.Attribute{Key: types.AttributeKeyDenom, Value: msg.Metadata.Base},
+	); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgSetDenomMetadataResponse{}, nil
+}
+
+// checkTransferListAuthority checks that the sender can configure the transfer
+// list of the denom: the authority can configure the transfer list of any
+// denom, and the admin of a denom created with the token factory the one of
+// its denom.
+func (k msgServer) checkTransferListAuthority(ctx context.Context, sender, denom string) error {
+	if err := sdk.ValidateDenom(denom); err != nil {
+		return errorsmod.Wrap(types.ErrInvalidDenom, err.Error())
+	}
+
+	if sender == k.authority {
+		return nil
+	}
+
+	return k.checkAdmin(ctx, sender, denom)
+}
+
+// SetTransferListMode implements the Msg/SetTransferListMode method.
+func (k msgServer) SetTransferListMode(ctx context.Context, msg *types.MsgSetTransferListMode) (*types.MsgSetTransferListModeResponse, error) {
+	if err := k.checkTransferListAuthority(ctx, msg.Sender, msg.Denom); err != nil {
+		return nil, err
+	}
+
+	if err := k.Keeper.SetTransferListMode(ctx, msg.Denom, msg.Mode); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgSetTransferListModeResponse{}, nil
+}
+
+// UpdateTransferList implements the Msg/UpdateTransferList method.
+func (k msgServer) UpdateTransferList(ctx context.Context, msg *types.MsgUpdateTransferList) (*types.MsgUpdateTransferListResponse, error) {
+	if err := k.checkTransferListAuthority(ctx, msg.Sender, msg.Denom); err != nil {
+		return nil, err
+	}
+
+	if len(msg.AddAddresses) == 0 && len(msg.RemoveAddresses) == 0 {
+		return nil, errorsmod.Wrap(types.ErrInvalidTransferList, "no address to add nor remove")
+	}
+
+	add, err := toAccAddresses(msg.AddAddresses)
+	if err != nil {
+		return nil, err
+	}
+
+	remove, err := toAccAddresses(msg.RemoveAddresses)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := k.Keeper.UpdateTransferList(ctx, msg.Denom, add, remove); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgUpdateTransferListResponse{}, nil
+}
+
+// toAccAddresses returns the account addresses of the bech32 addresses.
+func toAccAddresses(addrs []string) ([]sdk.AccAddress, error) {
+	accAddrs := make([]sdk.AccAddress, len(addrs))
+	for i, addr := range addrs {
+		accAddr, err := sdk.AccAddressFromBech32(addr)
+		if err != nil {
+			return nil, errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "invalid address %s: %s", addr, err)
+		}
+		accAddrs[i] = accAddr
+	}
+
+	return accAddrs, nil
+}
+
+// UpdateParams implements the Msg/UpdateParams method.
+func (k msgServer) UpdateParams(ctx context.Context, msg *types.MsgUpdateParams) (*types.MsgUpdateParamsResponse, error) {
+	if k.authority != msg.Authority {
+		return nil, errorsmod.Wrapf(govtypes.ErrInvalidSigner, "invalid authority; expected %s, got %s", k.authority, msg.Authority)
+	}
+
+	if err := msg.Params.Validate(); err != nil {
+		return nil, err
+	}
+
+	if err := k.Params.Set(ctx, msg.Params); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgUpdateParamsResponse{}, nil
+}
@@ -0,0 +1,146 @@
+package keeper
+
+import (
+	"context"
+	"strings"
+
+	"cosmossdk.io/collections"
+	"cosmossdk.io/core/event"
+	errorsmod "cosmossdk.io/errors"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	"github.com/cosmos/cosmos-sdk/x/tokenfactory/types"
+)
+
+// bypassTransferListsKey is the context key under which the transfer lists are
+// bypassed, for the transfers of the denom admins.
+type bypassTransferListsKey struct{}
+
+// withBypassTransferLists returns a context in which the transfers are not
+// restricted by the transfer lists.
+func withBypassTransferLists(ctx context.Context) context.Context {
+	return sdk.UnwrapSDKContext(ctx).WithValue(bypassTransferListsKey{}, true)
+}
+
+// GetTransferListMode returns the mode of the transfer list of a denom, which
+// is unspecified if the denom has no transfer list.
+func (k Keeper) GetTransferListMode(ctx context.Context, denom string) (types.TransferListMode, error) {
+	mode, err := k.TransferListModes.Get(ctx, denom)
+	if err != nil {
+		if errorsmod.IsOf(err, collections.ErrNotFound) {
+			return types.TransferListModeUnspecified, nil
+		}
+		return types.TransferListModeUnspecified, err
+	}
+
+	return types.TransferListMode(mode), nil
+}
+
+// SetTransferListMode sets the mode of the transfer list of a denom. The
+// addresses of the transfer list are kept when its mode changes.
+func (k Keeper) SetTransferListMode(ctx context.Context, denom string, mode types.TransferListMode) error {
+	if err := types.ValidateTransferListMode(mode); err != nil {
+		return err
+	}
+
+	var err error
+	if mode == types.TransferListModeUnspecified {
+		err = k.TransferListModes.Remove(ctx, denom)
+	} else {
+		err = k.TransferListModes.Set(ctx, denom, int32(mode))
+	}
+	if err != nil {
+		return err
+	}
+
+	return k.event.EventManager(ctx).EmitKV(
+		ctx,
+		types.EventTypeSetTransferListMode,
+		event.Attribute{Key: types.AttributeKeyDenom, Value: denom},
+		event.Attribute{Key: types.AttributeKeyMode, Value: mode.String()},
+	)
+}
+
+// UpdateTransferList adds addresses to and removes addresses from the transfer
+// list of a denom.
+func (k Keeper) UpdateTransferList(ctx context.Context, denom string, add, remove []sdk.AccAddress) error {
+	for _, addr := range add {
+		if err := k.TransferLists.Set(ctx, collections.Join(denom, addr)); err != nil {
+			return err
+		}
+	}
+
+	for _, addr := range remove {
+		if err := k.TransferLists.Remove(ctx, collections.Join(denom, addr)); err != nil {
+			return err
+		}
+	}
+
+	return k.event.EventManager(ctx).EmitKV(
+		ctx,
+		types.EventTypeUpdateTransferList,
+		event.Attribute{Key: types.AttributeKeyDenom, Value: denom},
+		event.Attribute{Key: types.AttributeKeyAddedAddresses, Value: joinAddresses(add)},
+		event.Attribute{Key: types.AttributeKeyRemovedAddresses, Value: joinAddresses(remove)},
+	)
+}
+
+// joinAddresses returns the comma-separated bech32 addresses.
+func joinAddresses(addrs []sdk.AccAddress) string {
+	strs := make([]string, len(addrs))
+	for i, addr := range addrs {
+		strs[i] = addr.String()
+	}
+
+	return strings.Join(strs, ",")
+}
+
+// CheckTransferAllowed returns an error if the transfer list of a denom does
+// not allow a transfer of its coins between the two addresses. The
+// tokenfactory module account, which mints and burns the coins, is never
+// restricted.
+func (k Keeper) CheckTransferAllowed(ctx context.Context, denom string, fromAddr, toAddr sdk.AccAddress) error {
+	mode, err := k.GetTransferListMode(ctx, denom)
+	if err != nil || mode == types.TransferListModeUnspecified {
+		return err
+	}
+
+	moduleAddr := authtypes.NewModuleAddress(types.ModuleName)
+	for _, addr := range []sdk.AccAddress{fromAddr, toAddr} {
+		if addr.Equals(moduleAddr) {
+			continue
+		}
+
+		listed, err := k.TransferLists.Has(ctx, collections.Join(denom, addr))
+		if err != nil {
+			return err
+		}
+
+		switch {
+		case mode == types.TransferListModeAllowlist && !listed:
+			return errorsmod.Wrapf(types.ErrTransferRestricted, "%s is not in the allowlist of %s", addr, denom)
+		case mode == types.TransferListModeBlocklist && listed:
+			return errorsmod.Wrapf(types.ErrTransferRestricted, "%s is in the blocklist of %s", addr, denom)
+		}
+	}
+
+	return nil
+}
+
+// SendRestrictionFn is the x/bank send restriction enforcing the transfer
+// lists of the denoms. The transfers of the denom admins, i.e. mints, burns
+// and force transfers, are not restricted.
+func (k Keeper) SendRestrictionFn(ctx context.Context, fromAddr, toAddr sdk.AccAddress, amt sdk.Coins) (sdk.AccAddress, error) {
+	if bypass, ok := ctx.Value(bypassTransferListsKey{}).(bool); ok && bypass {
+		return toAddr, nil
+	}
+
+	for _, coin := range amt {
+		if err := k.CheckTransferAllowed(ctx, coin.Denom, fromAddr, toAddr); err != nil {
+			return toAddr, err
+		}
+	}
+
+	return toAddr, nil
+}
@@ -0,0 +1,62 @@
+package types
+
+import (
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/codec/legacy"
+	"github.com/cosmos/cosmos-sdk/codec/types"
+	cryptocodec "github.com/cosmos/cosmos-sdk/crypto/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/msgservice"
+	authzcodec "github.com/cosmos/cosmos-sdk/x/authz/codec"
+	govcodec "github.com/cosmos/cosmos-sdk/x/gov/codec"
+	groupcodec "github.com/cosmos/cosmos-sdk/x/group/codec"
+)
+
+func RegisterInterfaces(registry types.InterfaceRegistry) {
+	registry.RegisterImplementations(
+		(*sdk.Msg)(nil),
+		&MsgCreateDenom{},
+		&MsgMint{},
+		&MsgBurn{},
+		&MsgForceTransfer{},
+		&MsgChangeAdmin{},
+		&MsgSetDenomMetadata{},
+		&MsgSetTransferListMode{},
+		&MsgUpdateTransferList{},
+		&MsgUpdateParams{},
+	)
+
+	msgservice.RegisterMsgServiceDesc(registry, &_Msg_serviceDesc)
+}
+
+// RegisterLegacyAminoCodec registers the necessary x/tokenfactory interfaces and concrete types
+// on the provided LegacyAmino codec. These types are used for Amino JSON serialization.
+func RegisterLegacyAminoCodec(cdc *codec.LegacyAmino) {
+	legacy.RegisterAminoMsg(cdc, &MsgCreateDenom{}, "cosmos-sdk/MsgCreateDenom")
+	legacy.RegisterAminoMsg(cdc, &MsgMint{}, "cosmos-sdk/x/tokenfactory/MsgMint")
+	legacy.RegisterAminoMsg(cdc, &MsgBurn{}, "cosmos-sdk/x/tokenfactory/MsgBurn")
+	legacy.RegisterAminoMsg(cdc, &MsgForceTransfer{}, "cosmos-sdk/x/tokenfactory/MsgForceTransfer")
+	legacy.RegisterAminoMsg(cdc, &MsgChangeAdmin{}, "cosmos-sdk/x/tokenfactory/MsgChangeAdmin")
+	legacy.RegisterAminoMsg(cdc, &MsgSetDenomMetadata{}, "cosmos-sdk/x/tokenfactory/MsgSetDenomMetadata")
+	legacy.RegisterAminoMsg(cdc, &MsgSetTransferListMode{}, "cosmos-sdk/x/tokenfactory/MsgSetTransferListMode")
+	legacy.RegisterAminoMsg(cdc, &MsgUpdateTransferList{}, "cosmos-sdk/x/tokenfactory/MsgUpdateTransferList")
+	legacy.RegisterAminoMsg(cdc, &MsgUpdateParams{}, "cosmos-sdk/x/tokenfactory/MsgUpdateParams")
+	cdc.RegisterConcrete(Params{}, "cosmos-sdk/x/tokenfactory/Params", nil)
+}
+
+var (
+	amino     = codec.NewLegacyAmino()
+	ModuleCdc = codec.NewAminoCodec(amino)
+)
+
+func init() {
+	RegisterLegacyAminoCodec(amino)
+	cryptocodec.RegisterCrypto(amino)
+	sdk.RegisterLegacyAminoCodec(amino)
+
+	// Register all Amino interfaces and concrete types on the authz and gov Amino codec so that this can later be
+	// used to properly serialize MsgUpdate instances
+	RegisterLegacyAminoCodec(authzcodec.Amino)
+	RegisterLegacyAminoCodec(govcodec.Amino)
+	RegisterLegacyAminoCodec(groupcodec.Amino)
+}
@@ -0,0 +1,79 @@
+package types
+
+import (
+	"strings"
+
+	errorsmod "cosmossdk.io/errors"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+)
+
+const (
+	// ModuleDenomPrefix is the prefix of the denoms created with the token
+	// factory.
+	ModuleDenomPrefix = "factory"
+
+	// MaxSubdenomLength is the maximum length of a subdenom.
+	MaxSubdenomLength = 44
+
+	// MaxCreatorLength is the maximum length of the bech32 address of a denom
+	// creator, allowing for a human readable part of up to 16 characters.
+	MaxCreatorLength = 59 + 16
+)
+
+// GetTokenDenom returns the denom of the form factory/{creator}/{subdenom}
+// created by the creator with the given subdenom.
+func GetTokenDenom(creator, subdenom string) (string, error) {
+	if len(subdenom) > MaxSubdenomLength {
+		return "", errorsmod.Wrapf(ErrInvalidDenom, "subdenom is longer than %d characters", MaxSubdenomLength)
+	}
+	if len(creator) > MaxCreatorLength {
+		return "", errorsmod.Wrapf(ErrInvalidDenom, "creator is longer than %d characters", MaxCreatorLength)
+	}
+	if strings.Contains(creator, "/") {
+		return "", errorsmod.Wrapf(ErrInvalidDenom, "creator %s contains a slash", creator)
+	}
+
+	denom := strings.Join([]string{ModuleDenomPrefix, creator, subdenom}, "/")
+	if err := sdk.ValidateDenom(denom); err != nil {
+		return "", errorsmod.Wrap(ErrInvalidDenom, err.Error())
+	}
+
+	return denom, nil
+}
+
+// DeconstructDenom returns the creator and the subdenom of a denom created
+// with the token factory, or an error if the denom is not of the form
+// factory/{creator}/{subdenom}. The subdenom may contain slashes.
+func DeconstructDenom(denom string) (creator, subdenom string, err error) {
+	if err := sdk.ValidateDenom(denom); err != nil {
+		return "", "", errorsmod.Wrap(ErrInvalidDenom, err.Error())
+	}
+
+	parts := strings.SplitN(denom, "/", 3)
+	if len(parts) < 3 || parts[0] != ModuleDenomPrefix {
+		return "", "", errorsmod.Wrapf(ErrInvalidDenom, "denom %s is not of the form %s/{creator}/{subdenom}", denom, ModuleDenomPrefix)
+	}
+
+	creator = parts[1]
+	if _, err := sdk.AccAddressFromBech32(creator); err != nil {
+		return "", "", errorsmod.Wrapf(ErrInvalidDenom, "invalid creator address %s: %s", creator, err)
+	}
+
+	return creator, parts[2], nil
+}
+
+// NewDenomMetadata returns the initial bank metadata of a denom created with
+// the token factory, which has a single unit of exponent 0.
+func NewDenomMetadata(denom string) banktypes.Metadata {
+	return banktypes.Metadata{
+		Base:    denom,
+		Display: denom,
+		Name:    denom,
+		Symbol:  denom,
+		DenomUnits: []*banktypes.DenomUnit{
+			{Denom: denom, Exponent: 0},
+		},
+	}
+}
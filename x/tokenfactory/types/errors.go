@@ -0,0 +1,16 @@
+package types
+
+import "cosmossdk.io/errors"
+
+// x/tokenfactory module sentinel errors
+var (
+	ErrInvalidDenom          = errors.Register(ModuleName, 2, "invalid denom")
+	ErrDenomExists           = errors.Register(ModuleName, 3, "denom already exists")
+	ErrDenomNotFound         = errors.Register(ModuleName, 4, "denom not found")
+	ErrUnauthorized          = errors.Register(ModuleName, 5, "unauthorized account")
+	ErrInvalidMetadata       = errors.Register(ModuleName, 6, "invalid denom metadata")
+	ErrModuleAccount         = errors.Register(ModuleName, 7, "cannot burn or transfer from a module account")
+	ErrDuplicateGenesisDenom = errors.Register(ModuleName, 8, "duplicate denom in genesis")
+	ErrInvalidTransferList   = errors.Register(ModuleName, 9, "invalid transfer list")
+	ErrTransferRestricted    = errors.Register(ModuleName, 10, "transfer restricted by the transfer list of the denom")
+)
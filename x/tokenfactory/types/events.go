@@ -0,0 +1,25 @@
+package types
+
+// tokenfactory module event types
+const (
+	EventTypeCreateDenom         = "create_denom"
+	EventTypeMint                = "tf_mint"
+	EventTypeBurn                = "tf_burn"
+	EventTypeForceTransfer       = "force_transfer"
+	EventTypeChangeAdmin         = "change_admin"
+	EventTypeSetDenomMetadata    = "set_denom_metadata"
+	EventTypeSetTransferListMode = "set_transfer_list_mode"
+	EventTypeUpdateTransferList  = "update_transfer_list"
+
+	AttributeKeyCreator             = "creator"
+	AttributeKeyDenom               = "denom"
+	AttributeKeyAdmin               = "admin"
+	AttributeKeyNewAdmin            = "new_admin"
+	AttributeKeyMintToAddress       = "mint_to_address"
+	AttributeKeyBurnFromAddress     = "burn_from_address"
+	AttributeKeyTransferFromAddress = "transfer_from_address"
+	AttributeKeyTransferToAddress   = "transfer_to_address"
+	AttributeKeyMode                = "mode"
+	AttributeKeyAddedAddresses      = "added_addresses"
+	AttributeKeyRemovedAddresses    = "removed_addresses"
+)
@@ -0,0 +1,53 @@
+package types
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+)
+
+// AccountKeeper defines the expected account keeper, used to prevent burning
+// and force transferring coins from module accounts.
+type AccountKeeper interface {
+	GetAccount(ctx context.Context, addr sdk.AccAddress) sdk.AccountI
+}
+
+// BankKeeper defines the expected bank keeper, used to mint, burn and
+// transfer the coins of the denoms and to set their metadata.
+type BankKeeper interface {
+	MintCoins(ctx context.Context, moduleName string, amt sdk.Coins) error
+	BurnCoins(ctx context.Context, moduleName string, amt sdk.Coins) error
+	SendCoinsFromModuleToAccount(ctx context.Context, senderModule string, recipientAddr sdk.AccAddress, amt sdk.Coins) error
+	SendCoinsFromAccountToModule(ctx context.Context, senderAddr sdk.AccAddress, recipientModule string, amt sdk.Coins) error
+	SendCoins(ctx context.Context, fromAddr, toAddr sdk.AccAddress, amt sdk.Coins) error
+	BlockedAddr(addr sdk.AccAddress) bool
+
+	HasSupply(ctx context.Context, denom string) bool
+	GetDenomMetaData(ctx context.Context, denom string) (banktypes.Metadata, bool)
+	SetDenomMetaData(ctx context.Context, denomMetaData banktypes.Metadata)
+}
+
+// DistributionKeeper defines the expected distribution keeper, used to pay the
+// denom creation fee to the community pool.
+type DistributionKeeper interface {
+	FundCommunityPool(ctx context.Context, amount sdk.Coins, sender sdk.AccAddress) error
+}
+
+// TokenFactoryHooks defines the hooks called by the token factory, e.g. to
+// let other modules track the denoms and their admins.
+type TokenFactoryHooks interface {
+	// AfterDenomCreated is called after a denom is created.
+	AfterDenomCreated(ctx context.Context, denom, creator string) error
+	// AfterAdminChanged is called after the admin role of a denom is
+	// transferred, either by the admin or by governance. The new admin is
+	// empty if the admin role is renounced.
+	AfterAdminChanged(ctx context.Context, denom, oldAdmin, newAdmin string) error
+}
+
+// TokenFactoryHooksWrapper is a wrapper for modules to inject TokenFactoryHooks
+// using depinject.
+type TokenFactoryHooksWrapper struct{ TokenFactoryHooks }
+
+// IsOnePerModuleType implements the depinject.OnePerModuleType interface.
+func (TokenFactoryHooksWrapper) IsOnePerModuleType() {}
@@ -0,0 +1,69 @@
+package types
+
+import (
+	errorsmod "cosmossdk.io/errors"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// DefaultGenesisState returns the default genesis state, which has no denoms.
+func DefaultGenesisState() *GenesisState {
+	return &GenesisState{
+		Params: DefaultParams(),
+	}
+}
+
+// Validate performs basic genesis state validation.
+func (gs GenesisState) Validate() error {
+	if err := gs.Params.Validate(); err != nil {
+		return err
+	}
+
+	denoms := make(map[string]struct{}, len(gs.FactoryDenoms))
+	for _, d := range gs.FactoryDenoms {
+		if _, _, err := DeconstructDenom(d.Denom); err != nil {
+			return err
+		}
+
+		if _, ok := denoms[d.Denom]; ok {
+			return errorsmod.Wrapf(ErrDuplicateGenesisDenom, "%s", d.Denom)
+		}
+		denoms[d.Denom] = struct{}{}
+
+		if d.AuthorityMetadata.Admin != "" {
+			if _, err := sdk.AccAddressFromBech32(d.AuthorityMetadata.Admin); err != nil {
+				return errorsmod.Wrapf(err, "invalid admin address of denom %s", d.Denom)
+			}
+		}
+	}
+
+	transferLists := make(map[string]struct{}, len(gs.TransferLists))
+	for _, l := range gs.TransferLists {
+		if err := ValidateTransferListMode(l.Mode); err != nil {
+			return errorsmod.Wrapf(err, "denom %s", l.Denom)
+		}
+
+		if err := sdk.ValidateDenom(l.Denom); err != nil {
+			return errorsmod.Wrap(ErrInvalidTransferList, err.Error())
+		}
+
+		if _, ok := transferLists[l.Denom]; ok {
+			return errorsmod.Wrapf(ErrInvalidTransferList, "duplicate transfer list of denom %s", l.Denom)
+		}
+		transferLists[l.Denom] = struct{}{}
+
+		addrs := make(map[string]struct{}, len(l.Addresses))
+		for _, addr := range l.Addresses {
+			if _, err := sdk.AccAddressFromBech32(addr); err != nil {
+				return errorsmod.Wrapf(err, "invalid address in the transfer list of denom %s", l.Denom)
+			}
+
+			if _, ok := addrs[addr]; ok {
+				return errorsmod.Wrapf(ErrInvalidTransferList, "duplicate address %s in the transfer list of denom %s", addr, l.Denom)
+			}
+			addrs[addr] = struct{}{}
+		}
+	}
+
+	return nil
+}
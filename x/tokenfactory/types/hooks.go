@@ -0,0 +1,34 @@
+package types
+
+import "context"
+
+var _ TokenFactoryHooks = MultiTokenFactoryHooks{}
+
+// MultiTokenFactoryHooks combines multiple token factory hooks, all hook
+// functions are run in array sequence.
+type MultiTokenFactoryHooks []TokenFactoryHooks
+
+// NewMultiTokenFactoryHooks returns the combination of the given hooks.
+func NewMultiTokenFactoryHooks(hooks ...TokenFactoryHooks) MultiTokenFactoryHooks {
+	return hooks
+}
+
+func (h MultiTokenFactoryHooks) AfterDenomCreated(ctx context.Context, denom, creator string) error {
+	for i := range h {
+		if err := h[i].AfterDenomCreated(ctx, denom, creator); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (h MultiTokenFactoryHooks) AfterAdminChanged(ctx context.Context, denom, oldAdmin, newAdmin string) error {
+	for i := range h {
+		if err := h[i].AfterAdminChanged(ctx, denom, oldAdmin, newAdmin); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
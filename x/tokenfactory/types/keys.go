@@ -0,0 +1,32 @@
+package types
+
+import "cosmossdk.io/collections"
+
+const (
+	// ModuleName defines the name of the x/tokenfactory module.
+	ModuleName = "tokenfactory"
+
+	// StoreKey defines the module's store key.
+	StoreKey = ModuleName
+)
+
+var (
+	// ParamsKey is the key under which the module parameters are stored.
+	ParamsKey = collections.NewPrefix(0)
+
+	// DenomAuthoritiesPrefix is the prefix under which the authorities of the
+	// denoms created with the token factory are stored, indexed by denom.
+	DenomAuthoritiesPrefix = collections.NewPrefix(1)
+
+	// CreatorDenomsPrefix is the prefix under which the denoms created with the
+	// token factory are indexed by creator.
+	CreatorDenomsPrefix = collections.NewPrefix(2)
+
+	// TransferListModesPrefix is the prefix under which the transfer list modes
+	// of the denoms are stored, indexed by denom.
+	TransferListModesPrefix = collections.NewPrefix(3)
+
+	// TransferListsPrefix is the prefix under which the addresses of the
+	// transfer lists are stored, indexed by denom and address.
+	TransferListsPrefix = collections.NewPrefix(4)
+)
@@ -0,0 +1,144 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/auth/migrations/legacytx"
+)
+
+var (
+	_ sdk.Msg            = &MsgCreateDenom{}
+	_ legacytx.LegacyMsg = &MsgCreateDenom{}
+	_ sdk.Msg            = &MsgMint{}
+	_ legacytx.LegacyMsg = &MsgMint{}
+	_ sdk.Msg            = &MsgBurn{}
+	_ legacytx.LegacyMsg = &MsgBurn{}
+	_ sdk.Msg            = &MsgForceTransfer{}
+	_ legacytx.LegacyMsg = &MsgForceTransfer{}
+	_ sdk.Msg            = &MsgChangeAdmin{}
+	_ legacytx.LegacyMsg = &MsgChangeAdmin{}
+	_ sdk.Msg            = &MsgSetDenomMetadata{}
+	_ legacytx.LegacyMsg = &MsgSetDenomMetadata{}
+	_ sdk.Msg            = &MsgSetTransferListMode{}
+	_ legacytx.LegacyMsg = &MsgSetTransferListMode{}
+	_ sdk.Msg            = &MsgUpdateTransferList{}
+	_ legacytx.LegacyMsg = &MsgUpdateTransferList{}
+	_ sdk.Msg            = &MsgUpdateParams{}
+	_ legacytx.LegacyMsg = &MsgUpdateParams{}
+)
+
+// GetSigners returns the signer addresses that are expected to sign the result
+// of GetSignBytes.
+func (msg MsgCreateDenom) GetSigners() []sdk.AccAddress {
+	sender, _ := sdk.AccAddressFromBech32(msg.Sender)
+	return []sdk.AccAddress{sender}
+}
+
+// GetSignBytes returns the raw bytes for a MsgCreateDenom message that
+// the expected signer needs to sign.
+func (msg MsgCreateDenom) GetSignBytes() []byte {
+	return sdk.MustSortJSON(amino.MustMarshalJSON(&msg))
+}
+
+// GetSigners returns the signer addresses that are expected to sign the result
+// of GetSignBytes.
+func (msg MsgMint) GetSigners() []sdk.AccAddress {
+	sender, _ := sdk.AccAddressFromBech32(msg.Sender)
+	return []sdk.AccAddress{sender}
+}
+
+// GetSignBytes returns the raw bytes for a MsgMint message that
+// the expected signer needs to sign.
+func (msg MsgMint) GetSignBytes() []byte {
+	return sdk.MustSortJSON(amino.MustMarshalJSON(&msg))
+}
+
+// GetSigners returns the signer addresses that are expected to sign the result
+// of GetSignBytes.
+func (msg MsgBurn) GetSigners() []sdk.AccAddress {
+	sender, _ := sdk.AccAddressFromBech32(msg.Sender)
+	return []sdk.AccAddress{sender}
+}
+
+// GetSignBytes returns the raw bytes for a MsgBurn message that
+// the expected signer needs to sign.
+func (msg MsgBurn) GetSignBytes() []byte {
+	return sdk.MustSortJSON(amino.MustMarshalJSON(&msg))
+}
+
+// GetSigners returns the signer addresses that are expected to sign the result
+// of GetSignBytes.
+func (msg MsgForceTransfer) GetSigners() []sdk.AccAddress {
+	sender, _ := sdk.AccAddressFromBech32(msg.Sender)
+	return []sdk.AccAddress{sender}
+}
+
+// GetSignBytes returns the raw bytes for a MsgForceTransfer message that
+// the expected signer needs to sign.
+func (msg MsgForceTransfer) GetSignBytes() []byte {
+	return sdk.MustSortJSON(amino.MustMarshalJSON(&msg))
+}
+
+// GetSigners returns the signer addresses that are expected to sign the result
+// of GetSignBytes.
+func (msg MsgChangeAdmin) GetSigners() []sdk.AccAddress {
+	sender, _ := sdk.AccAddressFromBech32(msg.Sender)
+	return []sdk.AccAddress{sender}
+}
+
+// GetSignBytes returns the raw bytes for a MsgChangeAdmin message that
+// the expected signer needs to sign.
+func (msg MsgChangeAdmin) GetSignBytes() []byte {
+	return sdk.MustSortJSON(amino.MustMarshalJSON(&msg))
+}
+
+// GetSigners returns the signer addresses that are expected to sign the result
+// of GetSignBytes.
+func (msg MsgSetDenomMetadata) GetSigners() []sdk.AccAddress {
+	sender, _ := sdk.AccAddressFromBech32(msg.Sender)
+	return []sdk.AccAddress{sender}
+}
+
+// GetSignBytes returns the raw bytes for a MsgSetDenomMetadata message that
+// the expected signer needs to sign.
+func (msg MsgSetDenomMetadata) GetSignBytes() []byte {
+	return sdk.MustSortJSON(amino.MustMarshalJSON(&msg))
+}
+
+// GetSigners returns the signer addresses that are expected to sign the result
+// of GetSignBytes.
+func (msg MsgSetTransferListMode) GetSigners() []sdk.AccAddress {
+	sender, _ := sdk.AccAddressFromBech32(msg.Sender)
+	return []sdk.AccAddress{sender}
+}
+
+// GetSignBytes returns the raw bytes for a MsgSetTransferListMode message that
+// the expected signer needs to sign.
+func (msg MsgSetTransferListMode) GetSignBytes() []byte {
+	return sdk.MustSortJSON(amino.MustMarshalJSON(&msg))
+}
+
+// GetSigners returns the signer addresses that are expected to sign the result
+// of GetSignBytes.
+func (msg MsgUpdateTransferList) GetSigners() []sdk.AccAddress {
+	sender, _ := sdk.AccAddressFromBech32(msg.Sender)
+	return []sdk.AccAddress{sender}
+}
+
+// GetSignBytes returns the raw bytes for a MsgUpdateTransferList message that
+// the expected signer needs to sign.
+func (msg MsgUpdateTransferList) GetSignBytes() []byte {
+	return sdk.MustSortJSON(amino.MustMarshalJSON(&msg))
+}
+
+// GetSigners returns the signer addresses that are expected to sign the result
+// of GetSignBytes.
+func (msg MsgUpdateParams) GetSigners() []sdk.AccAddress {
+	authority, _ := sdk.AccAddressFromBech32(msg.Authority)
+	return []sdk.AccAddress{authority}
+}
+
+// GetSignBytes returns the raw bytes for a MsgUpdateParams message that
+// the expected signer needs to sign.
+func (msg MsgUpdateParams) GetSignBytes() []byte {
+	return sdk.MustSortJSON(amino.MustMarshalJSON(&msg))
+}
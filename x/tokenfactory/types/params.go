@@ -0,0 +1,25 @@
+package types
+
+import (
+	errorsmod "cosmossdk.io/errors"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// DefaultParams returns the default parameters of the module, which have no
+// denom creation fee.
+func DefaultParams() Params {
+	return Params{
+		DenomCreationFee: sdk.NewCoins(),
+	}
+}
+
+// Validate performs basic validation of the parameters.
+func (p Params) Validate() error {
+	if err := p.DenomCreationFee.Validate(); err != nil {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidCoins, "invalid denom creation fee: %s", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,592 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: cosmos/tokenfactory/v1/tokenfactory.proto
+
+package types
+
+import (
+	fmt "fmt"
+	_ "github.com/cosmos/cosmos-proto"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	types "github.com/cosmos/cosmos-sdk/types"
+	_ "github.com/cosmos/cosmos-sdk/types/tx/amino"
+	_ "github.com/cosmos/gogoproto/gogoproto"
+	proto "github.com/cosmos/gogoproto/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// TransferListMode defines how the transfer list of a denom restricts the
+// transfers of its coins.
+type TransferListMode int32
+
+const (
+	// TRANSFER_LIST_MODE_UNSPECIFIED defines a denom without transfer list,
+	// whose transfers are not restricted.
+	TransferListModeUnspecified TransferListMode = 0
+	// TRANSFER_LIST_MODE_ALLOWLIST defines a denom whose coins can only be
+	// transferred between the addresses of its transfer list.
+	TransferListModeAllowlist TransferListMode = 1
+	// TRANSFER_LIST_MODE_BLOCKLIST defines a denom whose coins cannot be
+	// transferred from nor to the addresses of its transfer list.
+	TransferListModeBlocklist TransferListMode = 2
+)
+
+var TransferListMode_name = map[int32]string{
+	0: "TRANSFER_LIST_MODE_UNSPECIFIED",
+	1: "TRANSFER_LIST_MODE_ALLOWLIST",
+	2: "TRANSFER_LIST_MODE_BLOCKLIST",
+}
+
+var TransferListMode_value = map[string]int32{
+	"TRANSFER_LIST_MODE_UNSPECIFIED": 0,
+	"TRANSFER_LIST_MODE_ALLOWLIST":   1,
+	"TRANSFER_LIST_MODE_BLOCKLIST":   2,
+}
+
+func (x TransferListMode) String() string {
+	return proto.EnumName(TransferListMode_name, int32(x))
+}
+
+func (TransferListMode) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_952cd16e50e5fdfd, []int{0}
+}
+
+// DenomAuthorityMetadata defines the authority of a denom created with the
+// token factory.
+type DenomAuthorityMetadata struct {
+	// admin is the address which can mint, burn and force transfer the denom,
+	// set its metadata and transfer its admin role. An empty admin means that
+	// the admin role was renounced, and only governance can assign it again.
+	Admin string `protobuf:"bytes,1,opt,name=admin,proto3" json:"admin,omitempty"`
+}
+
+func (m *DenomAuthorityMetadata) Reset()         { *m = DenomAuthorityMetadata{} }
+func (m *DenomAuthorityMetadata) String() string { return proto.CompactTextString(m) }
+func (*DenomAuthorityMetadata) ProtoMessage()    {}
+func (*DenomAuthorityMetadata) Descriptor() ([]byte, []int) {
+	return fileDescriptor_952cd16e50e5fdfd, []int{0}
+}
+func (m *DenomAuthorityMetadata) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DenomAuthorityMetadata) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DenomAuthorityMetadata.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *DenomAuthorityMetadata) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DenomAuthorityMetadata.Merge(m, src)
+}
+func (m *DenomAuthorityMetadata) XXX_Size() int {
+	return m.Size()
+}
+func (m *DenomAuthorityMetadata) XXX_DiscardUnknown() {
+	xxx_messageInfo_DenomAuthorityMetadata.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DenomAuthorityMetadata proto.InternalMessageInfo
+
+func (m *DenomAuthorityMetadata) GetAdmin() string {
+	if m != nil {
+		return m.Admin
+	}
+	return ""
+}
+
+// Params defines the parameters of the tokenfactory module.
+type Params struct {
+	// denom_creation_fee is the fee paid to the community pool to create a
+	// denom. No fee is paid if it is empty.
+	DenomCreationFee github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,1,rep,name=denom_creation_fee,json=denomCreationFee,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"denom_creation_fee"`
+}
+
+func (m *Params) Reset()         { *m = Params{} }
+func (m *Params) String() string { return proto.CompactTextString(m) }
+func (*Params) ProtoMessage()    {}
+func (*Params) Descriptor() ([]byte, []int) {
+	return fileDescriptor_952cd16e50e5fdfd, []int{1}
+}
+func (m *Params) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Params) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Params.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Params) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Params.Merge(m, src)
+}
+func (m *Params) XXX_Size() int {
+	return m.Size()
+}
+func (m *Params) XXX_DiscardUnknown() {
+	xxx_messageInfo_Params.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Params proto.InternalMessageInfo
+
+func (m *Params) GetDenomCreationFee() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.DenomCreationFee
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterEnum("cosmos.tokenfactory.v1.TransferListMode", TransferListMode_name, TransferListMode_value)
+	proto.RegisterType((*DenomAuthorityMetadata)(nil), "cosmos.tokenfactory.v1.DenomAuthorityMetadata")
+	proto.RegisterType((*Params)(nil), "cosmos.tokenfactory.v1.Params")
+}
+
+func init() {
+	proto.RegisterFile("cosmos/tokenfactory/v1/tokenfactory.proto", fileDescriptor_952cd16e50e5fdfd)
+}
+
+var fileDescriptor_952cd16e50e5fdfd = []byte{
+	// 484 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x7c, 0x92, 0xbf, 0x6f, 0xd3, 0x40,
+	0x14, 0xc7, 0x7d, 0x50, 0x2a, 0x61, 0x96, 0x60, 0x55, 0x55, 0x62, 0xc0, 0xb1, 0x2a, 0x21, 0x85,
+	0x48, 0xb5, 0x65, 0x10, 0x4b, 0x17, 0x64, 0x3b, 0x89, 0x14, 0xd5, 0x49, 0xaa, 0x24, 0x15, 0x12,
+	0x8b, 0x75, 0xb1, 0x2f, 0xe9, 0x29, 0xf1, 0xbd, 0xc8, 0x77, 0x0d, 0x64, 0x61, 0x46, 0x99, 0xf8,
+	0x07, 0x2a, 0x21, 0xb1, 0x20, 0x16, 0x3a, 0xf0, 0x47, 0x74, 0xac, 0x98, 0x98, 0x28, 0x4a, 0x86,
+	0xf2, 0x67, 0x20, 0xff, 0x18, 0x48, 0x54, 0xba, 0xd8, 0xf7, 0xee, 0x7d, 0xdf, 0xe7, 0x7d, 0xef,
+	0xdd, 0xc9, 0xcf, 0x02, 0xe0, 0x11, 0x70, 0x53, 0xc0, 0x98, 0xb0, 0x21, 0x0e, 0x04, 0xc4, 0x73,
+	0x73, 0x66, 0xad, 0xc5, 0xc6, 0x34, 0x06, 0x01, 0xca, 0x6e, 0x26, 0x35, 0xd6, 0x52, 0x33, 0x4b,
+	0xdd, 0x19, 0xc1, 0x08, 0x52, 0x89, 0x99, 0xac, 0x32, 0xb5, 0xfa, 0x10, 0x47, 0x94, 0x81, 0x99,
+	0x7e, 0xf3, 0xad, 0x52, 0x06, 0xf0, 0x33, 0x6d, 0x4e, 0xcb, 0x52, 0x5a, 0x6e, 0x63, 0x80, 0x39,
+	0x31, 0x67, 0xd6, 0x80, 0x08, 0x6c, 0x99, 0x01, 0x50, 0x96, 0xe5, 0xf7, 0xda, 0xf2, 0x6e, 0x8d,
+	0x30, 0x88, 0xec, 0x53, 0x71, 0x02, 0x31, 0x15, 0xf3, 0x16, 0x11, 0x38, 0xc4, 0x02, 0x2b, 0x86,
+	0x7c, 0x0f, 0x87, 0x11, 0x65, 0x45, 0xa4, 0xa3, 0xca, 0x7d, 0xa7, 0xf8, 0xe3, 0xfb, 0xfe, 0x4e,
+	0x8e, 0xb6, 0xc3, 0x30, 0x26, 0x9c, 0xf7, 0x44, 0x4c, 0xd9, 0xa8, 0x9b, 0xc9, 0x0e, 0xb6, 0xfe,
+	0x7c, 0x2a, 0xa3, 0xbd, 0x6f, 0x48, 0xde, 0x3e, 0xc2, 0x31, 0x8e, 0xb8, 0xf2, 0x5e, 0x56, 0xc2,
+	0x04, 0xed, 0x07, 0x31, 0xc1, 0x82, 0x02, 0xf3, 0x87, 0x84, 0x14, 0x91, 0x7e, 0xb7, 0xf2, 0xe0,
+	0x79, 0xc9, 0xc8, 0x51, 0x89, 0x2f, 0x23, 0xf7, 0x65, 0xb8, 0x40, 0x99, 0xf3, 0xf2, 0xe2, 0x57,
+	0x59, 0xfa, 0x7a, 0x55, 0xae, 0x8c, 0xa8, 0x38, 0x39, 0x1d, 0x18, 0x01, 0x44, 0xf9, 0x91, 0xf2,
+	0xdf, 0x3e, 0x0f, 0xc7, 0xa6, 0x98, 0x4f, 0x09, 0x4f, 0x0b, 0xf8, 0x97, 0xeb, 0xf3, 0x2a, 0xea,
+	0x16, 0xd2, 0x5e, 0x6e, 0xde, 0xaa, 0x41, 0xc8, 0xc1, 0xd3, 0xc5, 0xf5, 0x79, 0x55, 0xff, 0xa7,
+	0xe6, 0xdd, 0xfa, 0x65, 0x64, 0x36, 0xab, 0x57, 0x48, 0x2e, 0xf4, 0x63, 0xcc, 0xf8, 0x90, 0xc4,
+	0x1e, 0xe5, 0xa2, 0x05, 0x21, 0x51, 0x5c, 0x59, 0xeb, 0x77, 0xed, 0x76, 0xaf, 0x51, 0xef, 0xfa,
+	0x5e, 0xb3, 0xd7, 0xf7, 0x5b, 0x9d, 0x5a, 0xdd, 0x3f, 0x6e, 0xf7, 0x8e, 0xea, 0x6e, 0xb3, 0xd1,
+	0xac, 0xd7, 0x0a, 0x92, 0x5a, 0x5e, 0x9c, 0xe9, 0x8f, 0x36, 0x2b, 0x8f, 0x19, 0x9f, 0x92, 0x80,
+	0x0e, 0x29, 0x09, 0x95, 0x57, 0xf2, 0xe3, 0x1b, 0x20, 0xb6, 0xe7, 0x75, 0x5e, 0x27, 0x61, 0x01,
+	0xa9, 0x4f, 0x16, 0x67, 0x7a, 0x69, 0x13, 0x61, 0x4f, 0x26, 0xf0, 0x76, 0x42, 0xb9, 0xf8, 0x0f,
+	0xc0, 0xf1, 0x3a, 0xee, 0x61, 0x0a, 0xb8, 0x73, 0x33, 0xc0, 0x99, 0x40, 0x30, 0x4e, 0x00, 0xea,
+	0xd6, 0x87, 0xcf, 0x9a, 0xe4, 0x1c, 0x5e, 0x2c, 0x35, 0x74, 0xb9, 0xd4, 0xd0, 0xef, 0xa5, 0x86,
+	0x3e, 0xae, 0x34, 0xe9, 0x72, 0xa5, 0x49, 0x3f, 0x57, 0x9a, 0xf4, 0xc6, 0xba, 0x75, 0xc6, 0x1b,
+	0xf3, 0x4a, 0x47, 0x3e, 0xd8, 0x4e, 0xdf, 0xcd, 0x8b, 0xbf, 0x03, 0x00, 0x67, 0x9f, 0xfd, 0xb4,
+	0xe0, 0x02, 0x00, 0x00,
+}
+
+func (this *DenomAuthorityMetadata) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*DenomAuthorityMetadata)
+	if !ok {
+		that2, ok := that.(DenomAuthorityMetadata)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Admin != that1.Admin {
+		return false
+	}
+	return true
+}
+func (m *DenomAuthorityMetadata) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DenomAuthorityMetadata) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DenomAuthorityMetadata) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Admin) > 0 {
+		i -= len(m.Admin)
+		copy(dAtA[i:], m.Admin)
+		i = encodeVarintTokenfactory(dAtA, i, uint64(len(m.Admin)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *Params) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Params) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.DenomCreationFee) > 0 {
+		for iNdEx := len(m.DenomCreationFee) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.DenomCreationFee[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTokenfactory(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintTokenfactory(dAtA []byte, offset int, v uint64) int {
+	offset -= sovTokenfactory(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *DenomAuthorityMetadata) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Admin)
+	if l > 0 {
+		n += 1 + l + sovTokenfactory(uint64(l))
+	}
+	return n
+}
+
+func (m *Params) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.DenomCreationFee) > 0 {
+		for _, e := range m.DenomCreationFee {
+			l = e.Size()
+			n += 1 + l + sovTokenfactory(uint64(l))
+		}
+	}
+	return n
+}
+
+func sovTokenfactory(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozTokenfactory(x uint64) (n int) {
+	return sovTokenfactory(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *DenomAuthorityMetadata) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTokenfactory
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DenomAuthorityMetadata: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DenomAuthorityMetadata: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Admin", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTokenfactory
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTokenfactory
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTokenfactory
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Admin = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTokenfactory(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTokenfactory
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Params) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTokenfactory
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Params: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Params: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DenomCreationFee", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTokenfactory
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTokenfactory
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTokenfactory
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DenomCreationFee = append(m.DenomCreationFee, types.Coin{})
+			if err := m.DenomCreationFee[len(m.DenomCreationFee)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTokenfactory(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTokenfactory
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipTokenfactory(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowTokenfactory
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowTokenfactory
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowTokenfactory
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthTokenfactory
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupTokenfactory
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthTokenfactory
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthTokenfactory        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowTokenfactory          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupTokenfactory = fmt.Errorf("proto: unexpected end of group")
+)
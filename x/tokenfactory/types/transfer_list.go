@@ -0,0 +1,13 @@
+package types
+
+import errorsmod "cosmossdk.io/errors"
+
+// ValidateTransferListMode returns an error if the transfer list mode is not
+// a known mode.
+func ValidateTransferListMode(mode TransferListMode) error {
+	if _, ok := TransferListMode_name[int32(mode)]; !ok {
+		return errorsmod.Wrapf(ErrInvalidTransferList, "unknown mode %d", mode)
+	}
+
+	return nil
+}
@@ -349,6 +349,23 @@ func (k Keeper) GetUpgradePlan(ctx sdk.Context) (plan types.Plan, havePlan bool)
 	return plan, true
 }
 
+// WhatIfGuard refuses to simulate a block with BaseApp.WhatIf if the
+// BeginBlocker would act on the node when executing it: at the height of the
+// upgrade plan, it would apply the upgrade or write the upgrade info to disk,
+// which Cosmovisor watches, and before the first block since the node started,
+// it would mark the binary as verified.
+func (k Keeper) WhatIfGuard(ctx sdk.Context) error {
+	if !k.DowngradeVerified() {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "cannot simulate blocks before the binary is verified")
+	}
+
+	if plan, found := k.GetUpgradePlan(ctx); found && plan.ShouldExecute(ctx) {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "cannot simulate past the height %d of upgrade %s", plan.Height, plan.Name)
+	}
+
+	return nil
+}
+
 // setDone marks this upgrade name as being done so the name can't be reused accidentally
 func (k Keeper) setDone(ctx sdk.Context, name string) {
 	store := ctx.KVStore(k.storeKey)
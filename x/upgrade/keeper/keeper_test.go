@@ -18,6 +18,7 @@ import (
 	"github.com/cosmos/cosmos-sdk/testutil"
 	simtestutil "github.com/cosmos/cosmos-sdk/testutil/sims"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	"github.com/cosmos/cosmos-sdk/types/module"
 	moduletestutil "github.com/cosmos/cosmos-sdk/types/module/testutil"
 	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
@@ -248,6 +249,18 @@ func (s *KeeperTestSuite) TestDowngradeVerified() {
 	s.Require().True(ok)
 }
 
+func (s *KeeperTestSuite) TestWhatIfGuard() {
+	s.upgradeKeeper.SetDowngradeVerified(false)
+	s.Require().ErrorIs(s.upgradeKeeper.WhatIfGuard(s.ctx), sdkerrors.ErrInvalidRequest)
+
+	s.upgradeKeeper.SetDowngradeVerified(true)
+	s.Require().NoError(s.upgradeKeeper.WhatIfGuard(s.ctx))
+
+	s.Require().NoError(s.upgradeKeeper.ScheduleUpgrade(s.ctx, types.Plan{Name: "all-good", Height: 12}))
+	s.Require().NoError(s.upgradeKeeper.WhatIfGuard(s.ctx.WithBlockHeight(11)))
+	s.Require().ErrorIs(s.upgradeKeeper.WhatIfGuard(s.ctx.WithBlockHeight(12)), sdkerrors.ErrInvalidRequest)
+}
+
 // Test that the protocol version successfully increments after an
 // upgrade and is successfully set on BaseApp's appVersion.
 func (s *KeeperTestSuite) TestIncrementProtocolVersion() {